@@ -207,11 +207,14 @@ func main() {
 		// Create OAuth2 client
 		localdev.WithOAuth2Repository(func(ctx context.Context, repo oauth.Repository, logger logging.Logger, tracerProvider tracing.TracerProvider) error {
 			_, err = repo.CreateOAuth2Client(ctx, &oauth.OAuth2ClientDatabaseCreationInput{
-				ID:           strings.Repeat("b", 20),
-				Name:         "localdev_admin_client",
-				Description:  "localdev admin client",
-				ClientID:     strings.Repeat("A", oauth.ClientIDSize),
-				ClientSecret: strings.Repeat("A", oauth.ClientSecretSize),
+				ID:                strings.Repeat("b", 20),
+				Name:              "localdev_admin_client",
+				Description:       "localdev admin client",
+				ClientID:          strings.Repeat("A", oauth.ClientIDSize),
+				ClientSecret:      strings.Repeat("A", oauth.ClientSecretSize),
+				BelongsToUser:     &adminUserID,
+				AllowedScopes:     authorization.OAuth2Scopes,
+				AllowedGrantTypes: oauth.OAuth2GrantTypes,
 			})
 			return err
 		}),
@@ -0,0 +1,170 @@
+package main
+
+import (
+	"context"
+	"crypto/ed25519"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"time"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/audit"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/repositories/postgres/auditlogentries"
+
+	"github.com/primandproper/platform/database"
+	databasecfg "github.com/primandproper/platform/database/config"
+	"github.com/primandproper/platform/database/postgres"
+	"github.com/primandproper/platform/observability/logging"
+	loggingnoop "github.com/primandproper/platform/observability/logging/noop"
+	"github.com/primandproper/platform/observability/tracing"
+	tracingnoop "github.com/primandproper/platform/observability/tracing/noop"
+
+	"github.com/spf13/cobra"
+)
+
+// errBrokenChain is returned when at least one verified chain is broken.
+var errBrokenChain = errors.New("audit log chain is broken")
+
+func main() {
+	var (
+		dbHost          string
+		dbPort          uint16
+		dbUser          string
+		dbPassword      string
+		dbName          string
+		dbSSLDisable    bool
+		accountIDs      []string
+		verificationKey string
+	)
+
+	root := &cobra.Command{
+		Use:   "audit_log_verifier",
+		Short: "Walk account audit log hash chains and report the first broken link in each",
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return runVerification(dbHost, dbPort, dbUser, dbPassword, dbName, dbSSLDisable, accountIDs, verificationKey)
+		},
+		SilenceUsage: true,
+	}
+
+	root.Flags().StringVar(&dbHost, "db-host", "", "Postgres host")
+	root.Flags().Uint16Var(&dbPort, "db-port", 5432, "Postgres port")
+	root.Flags().StringVar(&dbUser, "db-user", "", "Postgres username")
+	root.Flags().StringVar(&dbPassword, "db-password", "", "Postgres password")
+	root.Flags().StringVar(&dbName, "db-name", "", "Postgres database name")
+	root.Flags().BoolVar(&dbSSLDisable, "db-ssl-disable", true, "Disable SSL for DB connection")
+	root.Flags().StringArrayVar(&accountIDs, "account", nil, "ID of an account whose audit log to verify (repeatable)")
+	root.Flags().StringVar(&verificationKey, "verification-key", "", "Base64-encoded Ed25519 public key to check checkpoint signatures with")
+
+	for _, flag := range []string{"db-host", "db-user", "db-password", "db-name", "account"} {
+		if err := root.MarkFlagRequired(flag); err != nil {
+			log.Fatalln(err)
+		}
+	}
+
+	if err := root.Execute(); err != nil {
+		log.Fatalln(err)
+	}
+}
+
+func runVerification(dbHost string, dbPort uint16, dbUser, dbPassword, dbName string, dbSSLDisable bool, accountIDs []string, encodedVerificationKey string) error {
+	ctx := context.Background()
+	logger := loggingnoop.NewLogger()
+	tracerProvider := tracingnoop.NewTracerProvider()
+
+	var verificationKey ed25519.PublicKey
+	if encodedVerificationKey != "" {
+		var err error
+		if verificationKey, err = audit.ParseCheckpointVerificationKey(encodedVerificationKey); err != nil {
+			return fmt.Errorf("parsing verification key: %w", err)
+		}
+	} else {
+		log.Println("No verification key provided, checkpoint signatures will not be checked")
+	}
+
+	client, err := connectDB(ctx, logger, tracerProvider, dbHost, dbPort, dbUser, dbPassword, dbName, dbSSLDisable)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := client.Close(); closeErr != nil {
+			log.Println("error closing database:", closeErr)
+		}
+	}()
+
+	auditRepo := auditlogentries.ProvideAuditLogRepository(logger, tracerProvider, client)
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+
+	broken := 0
+	for _, accountID := range accountIDs {
+		result, verifyErr := audit.VerifyChain(ctx, auditRepo, accountID, verificationKey)
+		if verifyErr != nil {
+			return fmt.Errorf("verifying audit log chain for account %s: %w", accountID, verifyErr)
+		}
+
+		if err = encoder.Encode(result); err != nil {
+			return fmt.Errorf("writing result for account %s: %w", accountID, err)
+		}
+
+		if !result.Intact() {
+			broken++
+		}
+	}
+
+	if broken > 0 {
+		return fmt.Errorf("%w: %d of %d accounts", errBrokenChain, broken, len(accountIDs))
+	}
+
+	log.Printf("Verified %d audit log chains", len(accountIDs))
+
+	return nil
+}
+
+func connectDB(ctx context.Context, logger logging.Logger, tracerProvider tracing.TracerProvider, dbHost string, dbPort uint16, dbUser, dbPassword, dbName string, dbSSLDisable bool) (database.Client, error) {
+	if dbHost == "" || dbUser == "" || dbPassword == "" || dbName == "" {
+		return nil, errors.New("database connection requires --db-host, --db-user, --db-password, --db-name")
+	}
+
+	connDetails := databasecfg.ConnectionDetails{
+		Host:       dbHost,
+		Port:       dbPort,
+		Username:   dbUser,
+		Password:   dbPassword,
+		Database:   dbName,
+		DisableSSL: dbSSLDisable,
+	}
+
+	clientConfig := &verifierClientConfig{connDetails: connDetails}
+	return postgres.ProvideDatabaseClient(ctx, logger, tracerProvider, clientConfig, nil)
+}
+
+// verifierClientConfig implements database.ClientConfig.
+type verifierClientConfig struct {
+	connDetails databasecfg.ConnectionDetails
+}
+
+var _ database.ClientConfig = (*verifierClientConfig)(nil)
+
+func (c *verifierClientConfig) GetReadConnectionString() string {
+	if c.connDetails.DisableSSL {
+		return c.connDetails.URI()
+	}
+	return c.connDetails.String()
+}
+
+func (c *verifierClientConfig) GetWriteConnectionString() string {
+	return c.GetReadConnectionString()
+}
+
+func (c *verifierClientConfig) GetMaxPingAttempts() uint64 { return 10 }
+
+func (c *verifierClientConfig) GetPingWaitPeriod() time.Duration { return time.Second }
+
+func (c *verifierClientConfig) GetMaxIdleConns() int { return 5 }
+
+func (c *verifierClientConfig) GetMaxOpenConns() int { return 7 }
+
+func (c *verifierClientConfig) GetConnMaxLifetime() time.Duration { return 30 * time.Minute }
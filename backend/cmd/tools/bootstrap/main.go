@@ -281,14 +281,17 @@ func runInit(db *dbFlags, adminUsername, adminPassword, adminEmail string) error
 	}
 
 	// --- OAuth2 clients (idempotent) ---
+	userGrantTypes := []string{oauth.AuthorizationCodeGrantType, oauth.RefreshTokenGrantType}
 	wantClients := []*struct {
-		name string
-		desc string
+		name       string
+		desc       string
+		scopes     []string
+		grantTypes []string
 	}{
-		{"Admin Webapp", "Admin web application OAuth2 client"},
-		{"Consumer Webapp", "Consumer web application OAuth2 client"},
-		{"iOS App", "iOS mobile application OAuth2 client"},
-		{"MCP Server", "MCP server OAuth2 client"},
+		{"Admin Webapp", "Admin web application OAuth2 client", authorization.OAuth2Scopes, userGrantTypes},
+		{"Consumer Webapp", "Consumer web application OAuth2 client", []string{authorization.ReadOAuth2Scope, authorization.WriteOAuth2Scope}, userGrantTypes},
+		{"iOS App", "iOS mobile application OAuth2 client", []string{authorization.ReadOAuth2Scope, authorization.WriteOAuth2Scope}, userGrantTypes},
+		{"MCP Server", "MCP server OAuth2 client", []string{authorization.ReadOAuth2Scope, authorization.WriteOAuth2Scope}, []string{oauth.AuthorizationCodeGrantType, oauth.RefreshTokenGrantType, oauth.ClientCredentialsGrantType}},
 	}
 
 	existingClients, err := oauthRepo.GetOAuth2Clients(ctx, nil)
@@ -320,11 +323,14 @@ func runInit(db *dbFlags, adminUsername, adminPassword, adminEmail string) error
 		}
 
 		created, creationErr := oauthRepo.CreateOAuth2Client(ctx, &oauth.OAuth2ClientDatabaseCreationInput{
-			ID:           identifiers.New(),
-			Name:         want.name,
-			Description:  want.desc,
-			ClientID:     clientID,
-			ClientSecret: clientSecret,
+			ID:                identifiers.New(),
+			Name:              want.name,
+			Description:       want.desc,
+			ClientID:          clientID,
+			ClientSecret:      clientSecret,
+			BelongsToUser:     &user.ID,
+			AllowedScopes:     want.scopes,
+			AllowedGrantTypes: want.grantTypes,
 		})
 		if creationErr != nil {
 			return fmt.Errorf("creating OAuth2 client %s: %w", want.name, creationErr)
@@ -152,6 +152,11 @@ func buildIntegrationTestsConfig() *config.APIServiceConfig {
 					RefreshTokenLifespan: time.Hour,
 					Debug:                false,
 				},
+				OIDC: authservice.OIDCConfig{
+					Issuer:                     "http://localhost:8000",
+					IDTokenLifespan:            time.Hour,
+					SigningKeyRotationInterval: 30 * 24 * time.Hour,
+				},
 				Debug:                 false,
 				EnableUserSignup:      true,
 				MinimumUsernameLength: 3,
@@ -204,6 +204,11 @@ func buildLocalDevConfig() *config.APIServiceConfig {
 					RefreshTokenLifespan: time.Hour,
 					Debug:                false,
 				},
+				OIDC: authservice.OIDCConfig{
+					Issuer:                     "http://localhost:8000",
+					IDTokenLifespan:            time.Hour,
+					SigningKeyRotationInterval: 30 * 24 * time.Hour,
+				},
 				Debug:                 true,
 				EnableUserSignup:      true,
 				MinimumUsernameLength: 3,
@@ -58,8 +58,11 @@ func main() {
 				"meal_plan_finalizer":                "meal_plan_finalizer",
 				"meal_plan_grocery_list_initializer": "meal_plan_grocery_list_initializer",
 				"meal_plan_task_creator":             "meal_plan_task_creator",
+				"meal_plan_template_instantiator":    "meal_plan_template_instantiator",
 				"search_data_index_scheduler":        "search_data_index_scheduler",
 				"mobile_notification_scheduler":      "mobile_notification_scheduler",
+				"notification_digest_sender":         "notification_digest_sender",
+				"webhook_delivery_retrier":           "webhook_delivery_retrier",
 				"async_message_handler":              "async_message_handler",
 				"queue_test":                         "queue_test",
 				"dinner_done_better_mcp_server":      "mcp_server",
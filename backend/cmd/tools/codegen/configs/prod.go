@@ -12,6 +12,7 @@
 	identitycfg "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/services/identity/config"
 	mealplanningcfg "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/services/mealplanning/config"
 	oauthcfg "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/services/oauth/config"
+	paymentscfg "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/services/payments/config"
 	uploadedmediacfg "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/services/uploadedmedia/config"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/testutils"
 
@@ -56,6 +57,7 @@
 	prodOtelCollectorEndpoint = "otel-collector-svc.prod.svc.cluster.local:4317"
 	prodOAuth2Domain          = "https://dinnerdonebetter.com"
 	prodTokensAudience        = "https://http-api.dinnerdonebetter.com" //nolint:gosec // G101: audience URL, not a credential
+	prodOIDCIssuer            = "https://http-api.dinnerdonebetter.com"
 	iosTeamID                 = "K8R2Q5UWQS"
 	iosBundleID               = "com.dinnerdonebetter.ios"
 )
@@ -275,6 +277,11 @@ func buildProdConfig() *config.APIServiceConfig {
 					RefreshTokenLifespan: time.Hour,
 					Debug:                false,
 				},
+				OIDC: authservice.OIDCConfig{
+					Issuer:                     prodOIDCIssuer,
+					IDTokenLifespan:            time.Hour,
+					SigningKeyRotationInterval: 30 * 24 * time.Hour,
+				},
 				Debug:                 false,
 				EnableUserSignup:      true,
 				MinimumUsernameLength: 3,
@@ -312,6 +319,14 @@ func buildProdConfig() *config.APIServiceConfig {
 			OAuth2Clients: oauthcfg.Config{
 				OAuth2ClientCreationDisabled: true,
 			},
+			Payments: paymentscfg.Config{
+				Entitlements: paymentscfg.EntitlementsConfig{
+					Enabled:                     true,
+					GracePeriod:                 72 * time.Hour,
+					FreeTierMaxMealPlansPerWeek: 2,
+					FreeTierMaxAccountMembers:   2,
+				},
+			},
 		},
 		PushNotifications: notificationscfg.Config{
 			Provider: notificationscfg.ProviderAPNsFCM,
@@ -0,0 +1,114 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cristalhq/builq"
+)
+
+const (
+	auditLogCheckpointsTableName = "audit_log_checkpoints"
+)
+
+func init() {
+	registerTableName(auditLogCheckpointsTableName)
+}
+
+var (
+	auditLogCheckpointsColumns = []string{
+		idColumn,
+		belongsToAccountColumn,
+		auditLogSequenceColumn,
+		"entry_hash",
+		"signature",
+		"key_id",
+		createdAtColumn,
+	}
+)
+
+func buildAuditLogCheckpointQueries(database string) []*Query {
+	switch database {
+	case postgres:
+		insertColumns := filterForInsert(auditLogCheckpointsColumns)
+
+		return []*Query{
+			{
+				Annotation: QueryAnnotation{
+					Name: "CreateAuditLogCheckpoint",
+					Type: ExecType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`INSERT INTO %s (
+	%s
+) VALUES (
+	%s
+);`,
+					auditLogCheckpointsTableName,
+					strings.Join(insertColumns, ",\n\t"),
+					strings.Join(applyToEach(insertColumns, func(_ int, s string) string {
+						return fmt.Sprintf("sqlc.arg(%s)", s)
+					}), ",\n\t"),
+				)),
+			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "GetAuditLogCheckpointsForAccount",
+					Type: ManyType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT
+	%s
+FROM %s
+WHERE %s.%s = sqlc.arg(%s)
+ORDER BY %s.%s ASC;`,
+					strings.Join(applyToEach(auditLogCheckpointsColumns, func(_ int, s string) string {
+						return fullColumnName(auditLogCheckpointsTableName, s)
+					}), ",\n\t"),
+					auditLogCheckpointsTableName,
+					auditLogCheckpointsTableName, belongsToAccountColumn, belongsToAccountColumn,
+					auditLogCheckpointsTableName, auditLogSequenceColumn,
+				)),
+			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "GetUncheckpointedAuditLogChainHeads",
+					Type: ManyType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT
+	heads.%s,
+	heads.%s,
+	heads.%s
+FROM (
+	SELECT DISTINCT ON (%s.%s)
+		%s.%s,
+		%s.%s,
+		%s.%s
+	FROM %s
+	WHERE %s.%s IS NOT NULL
+	ORDER BY %s.%s, %s.%s DESC
+) AS heads
+WHERE heads.%s > COALESCE((
+	SELECT MAX(%s.%s)
+	FROM %s
+	WHERE %s.%s = heads.%s
+), 0);`,
+					belongsToAccountColumn,
+					auditLogSequenceColumn,
+					auditLogHashColumn,
+					auditLogsTableName, belongsToAccountColumn,
+					auditLogsTableName, belongsToAccountColumn,
+					auditLogsTableName, auditLogSequenceColumn,
+					auditLogsTableName, auditLogHashColumn,
+					auditLogsTableName,
+					auditLogsTableName, auditLogSequenceColumn,
+					auditLogsTableName, belongsToAccountColumn, auditLogsTableName, auditLogSequenceColumn,
+					auditLogSequenceColumn,
+					auditLogCheckpointsTableName, auditLogSequenceColumn,
+					auditLogCheckpointsTableName,
+					auditLogCheckpointsTableName, belongsToAccountColumn, belongsToAccountColumn,
+				)),
+			},
+		}
+	default:
+		return nil
+	}
+}
@@ -8,9 +8,12 @@
 )
 
 const (
-	auditLogsTableName = "audit_log_entries"
-	resourceTypeColumn = "resource_type"
-	eventTypeColumn    = "event_type"
+	auditLogsTableName       = "audit_log_entries"
+	resourceTypeColumn       = "resource_type"
+	eventTypeColumn          = "event_type"
+	auditLogSequenceColumn   = "sequence"
+	auditLogHashColumn       = "hash"
+	auditLogRedactedAtColumn = "redacted_at"
 )
 
 func init() {
@@ -28,17 +31,47 @@ func init() {
 		belongsToAccountColumn,
 		createdAtColumn,
 	}
+
+	// auditLogChainColumns link an account's audit log entries together; they're only read for single entries and when walking the chain.
+	auditLogChainColumns = []string{
+		auditLogSequenceColumn,
+		"previous_hash",
+		"changes_hash",
+		auditLogHashColumn,
+		auditLogRedactedAtColumn,
+	}
+)
+
+var (
+	// auditLogSearchConditions are the optional filters of SearchAuditLogEntriesForAccount and ExportAuditLogEntriesForAccount;
+	// an empty array or NULL argument matches everything.
+	auditLogSearchConditions = []string{
+		fmt.Sprintf("(cardinality(sqlc.arg(resource_types)::text[]) = 0 OR %s.%s = ANY(sqlc.arg(resource_types)::text[]))", auditLogsTableName, resourceTypeColumn),
+		fmt.Sprintf("(cardinality(sqlc.arg(event_types)::text[]) = 0 OR %s.%s::text = ANY(sqlc.arg(event_types)::text[]))", auditLogsTableName, eventTypeColumn),
+		fmt.Sprintf("(sqlc.narg(%s)::text IS NULL OR %s.%s = sqlc.narg(%s))", belongsToUserColumn, auditLogsTableName, belongsToUserColumn, belongsToUserColumn),
+		fmt.Sprintf("(sqlc.narg(relevant_id)::text IS NULL OR %s.relevant_id = sqlc.narg(relevant_id))", auditLogsTableName),
+		fmt.Sprintf("(sqlc.narg(changed_field)::text IS NULL OR %s.changes ? sqlc.narg(changed_field))", auditLogsTableName),
+	}
 )
 
 func buildAuditLogEntryQueries(database string) []*Query {
 	switch database {
 	case postgres:
+		belongsToAccountCondition := fmt.Sprintf("%s.%s = sqlc.arg(%s)", auditLogsTableName, belongsToAccountColumn, belongsToAccountColumn)
+		searchConditions := append([]string{belongsToAccountCondition}, auditLogSearchConditions...)
 
 		insertColumns := filterForInsert(auditLogsColumns)
 		fullSelectColumns := applyToEach(auditLogsColumns, func(_ int, s string) string {
 			return fullColumnName(auditLogsTableName, s)
 		})
 
+		// chained entries are hashed before they're written, so they supply their own creation time.
+		chainedInsertColumns := append(append([]string{}, insertColumns...), createdAtColumn)
+		chainedInsertColumns = append(chainedInsertColumns, filterFromSlice(auditLogChainColumns, auditLogRedactedAtColumn)...)
+		chainSelectColumns := applyToEach(append(append([]string{}, auditLogsColumns...), auditLogChainColumns...), func(_ int, s string) string {
+			return fullColumnName(auditLogsTableName, s)
+		})
+
 		return []*Query{
 			{
 				Annotation: QueryAnnotation{
@@ -60,6 +93,76 @@ func buildAuditLogEntryQueries(database string) []*Query {
 					}), ",\n\t"),
 				)),
 			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "CreateChainedAuditLogEntry",
+					Type: ExecType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`INSERT INTO %s (
+	%s
+) VALUES (
+	%s
+);`,
+					auditLogsTableName,
+					strings.Join(chainedInsertColumns, ",\n\t"),
+					strings.Join(applyToEach(chainedInsertColumns, func(_ int, s string) string {
+						if s == belongsToUserColumn {
+							return fmt.Sprintf("sqlc.narg(%s)", s)
+						}
+						return fmt.Sprintf("sqlc.arg(%s)", s)
+					}), ",\n\t"),
+				)),
+			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "LockAuditLogChain",
+					Type: ExecType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT pg_advisory_xact_lock(hashtext('%s'), hashtext(sqlc.arg(%s)::text));`,
+					auditLogsTableName,
+					belongsToAccountColumn,
+				)),
+			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "GetAuditLogChainHead",
+					Type: OneType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT
+	%s.%s,
+	%s.%s
+FROM %s
+WHERE %s
+	AND %s.%s IS NOT NULL
+ORDER BY %s.%s DESC
+LIMIT 1;`,
+					auditLogsTableName, auditLogSequenceColumn,
+					auditLogsTableName, auditLogHashColumn,
+					auditLogsTableName,
+					belongsToAccountCondition,
+					auditLogsTableName, auditLogSequenceColumn,
+					auditLogsTableName, auditLogSequenceColumn,
+				)),
+			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "GetAuditLogChainEntries",
+					Type: ManyType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT
+	%s
+FROM %s
+WHERE %s
+	AND %s.%s > sqlc.arg(after_sequence)
+ORDER BY %s.%s ASC
+LIMIT sqlc.arg(result_limit);`,
+					strings.Join(chainSelectColumns, ",\n\t"),
+					auditLogsTableName,
+					belongsToAccountCondition,
+					auditLogsTableName, auditLogSequenceColumn,
+					auditLogsTableName, auditLogSequenceColumn,
+				)),
+			},
 			{
 				Annotation: QueryAnnotation{
 					Name: "GetAuditLogEntry",
@@ -69,7 +172,7 @@ func buildAuditLogEntryQueries(database string) []*Query {
 	%s
 FROM %s
 WHERE %s.%s = sqlc.arg(%s);`,
-					strings.Join(fullSelectColumns, ",\n\t"),
+					strings.Join(chainSelectColumns, ",\n\t"),
 					auditLogsTableName,
 					auditLogsTableName, idColumn, idColumn,
 				)),
@@ -186,6 +289,49 @@ func buildAuditLogEntryQueries(database string) []*Query {
 					buildCursorLimitClause(auditLogsTableName),
 				)),
 			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "SearchAuditLogEntriesForAccount",
+					Type: ManyType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT
+	%s,
+	%s,
+	%s
+FROM %s
+WHERE %s
+%s;`,
+					strings.Join(fullSelectColumns, ",\n\t"),
+					buildFilterCountSelect(auditLogsTableName, false, false, nil, searchConditions...),
+					buildTotalCountSelect(auditLogsTableName, false, nil, belongsToAccountCondition),
+					auditLogsTableName,
+					strings.TrimPrefix(buildFilterConditions(auditLogsTableName, false, false, searchConditions...), "AND "),
+					buildCursorLimitClause(auditLogsTableName),
+				)),
+			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "ExportAuditLogEntriesForAccount",
+					Type: ManyType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT
+	%s
+FROM %s
+WHERE %s
+	AND %s.%s > COALESCE(sqlc.narg(created_after), (SELECT %s - '999 years'::INTERVAL))
+	AND %s.%s < COALESCE(sqlc.narg(created_before), (SELECT %s + '999 years'::INTERVAL))
+	AND %s.%s > sqlc.arg(cursor)
+ORDER BY %s.%s ASC
+LIMIT sqlc.arg(result_limit);`,
+					strings.Join(fullSelectColumns, ",\n\t"),
+					auditLogsTableName,
+					strings.Join(searchConditions, "\n\tAND "),
+					auditLogsTableName, createdAtColumn, currentTimeExpression,
+					auditLogsTableName, createdAtColumn, currentTimeExpression,
+					auditLogsTableName, idColumn,
+					auditLogsTableName, idColumn,
+				)),
+			},
 		}
 	default:
 		return nil
@@ -12,6 +12,9 @@
 
 	/* #nosec G101 */
 	webhookHMACSecretColumn = "webhook_hmac_secret"
+	/* #nosec G101 */
+	previousWebhookHMACSecretColumn  = "previous_webhook_hmac_secret"
+	webhookHMACSecretRotatedAtColumn = "webhook_hmac_secret_rotated_at"
 )
 
 func init() {
@@ -246,20 +249,44 @@ func(_ int, s string) string {
 			},
 			{
 				Annotation: QueryAnnotation{
-					Name: "UpdateAccountWebhookEncryptionKey",
+					Name: "GetAccountWebhookEncryptionKeys",
+					Type: OneType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT
+	%s.%s,
+	%s.%s,
+	%s.%s
+FROM %s
+WHERE %s.%s IS NULL
+	AND %s.%s = sqlc.arg(%s);`,
+					accountsTableName, webhookHMACSecretColumn,
+					accountsTableName, previousWebhookHMACSecretColumn,
+					accountsTableName, webhookHMACSecretRotatedAtColumn,
+					accountsTableName,
+					accountsTableName, archivedAtColumn,
+					accountsTableName, idColumn, idColumn,
+				)),
+			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "RotateAccountWebhookEncryptionKey",
 					Type: ExecRowsType,
 				},
 				Content: buildRawQuery((&builq.Builder{}).Addf(`UPDATE %s SET
+	%s = %s,
 	%s = sqlc.arg(%s),
+	%s = %s,
 	%s = %s
 WHERE %s IS NULL
-	AND %s = sqlc.arg(%s)
+	AND (%s IS NULL OR %s <= sqlc.arg(rotated_before))
 	AND %s = sqlc.arg(%s);`,
 					accountsTableName,
+					previousWebhookHMACSecretColumn, webhookHMACSecretColumn,
 					webhookHMACSecretColumn, webhookHMACSecretColumn,
+					webhookHMACSecretRotatedAtColumn, currentTimeExpression,
 					lastUpdatedAtColumn, currentTimeExpression,
 					archivedAtColumn,
-					belongsToUserColumn, belongsToUserColumn,
+					webhookHMACSecretRotatedAtColumn, webhookHMACSecretRotatedAtColumn,
 					idColumn, idColumn,
 				)),
 			},
@@ -97,6 +97,20 @@ func buildUserRoleAssignmentsQueries(database string) []*Query {
 					accountIDColumn, accountIDColumn,
 				)),
 			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "ReassignRoleAssignments",
+					Type: ExecType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`UPDATE %s SET %s = sqlc.arg(new_role_id)
+WHERE %s IS NULL
+	AND %s = sqlc.arg(%s);`,
+					userRoleAssignmentsTableName,
+					roleIDColumn,
+					archivedAtColumn,
+					roleIDColumn, roleIDColumn,
+				)),
+			},
 			// Recursive CTE: get all effective service-level permissions for a user
 			{
 				Annotation: QueryAnnotation{
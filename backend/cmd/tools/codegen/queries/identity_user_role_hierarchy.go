@@ -64,6 +64,55 @@ func buildUserRoleHierarchyQueries(database string) []*Query {
 					userRoleHierarchyTableName, archivedAtColumn,
 				)),
 			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "AddParentToRole",
+					Type: ExecType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`INSERT INTO %s (
+	%s
+) VALUES (
+	%s
+) ON CONFLICT (%s, %s) DO UPDATE SET %s = NULL;`,
+					userRoleHierarchyTableName,
+					strings.Join(filterForInsert(userRoleHierarchyColumns), ",\n\t"),
+					strings.Join(applyToEach(filterForInsert(userRoleHierarchyColumns), func(i int, s string) string {
+						return fmt.Sprintf("sqlc.arg(%s)", s)
+					}), ",\n\t"),
+					parentRoleIDColumn, childRoleIDColumn, archivedAtColumn,
+				)),
+			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "GetParentRoleIDsForRole",
+					Type: ManyType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT %s.%s
+FROM %s
+WHERE %s.%s IS NULL
+	AND %s.%s = sqlc.arg(%s)
+ORDER BY %s.%s;`,
+					userRoleHierarchyTableName, parentRoleIDColumn,
+					userRoleHierarchyTableName,
+					userRoleHierarchyTableName, archivedAtColumn,
+					userRoleHierarchyTableName, childRoleIDColumn, childRoleIDColumn,
+					userRoleHierarchyTableName, parentRoleIDColumn,
+				)),
+			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "ArchiveUserRoleHierarchyForChild",
+					Type: ExecType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`UPDATE %s SET %s = %s
+WHERE %s IS NULL
+	AND %s = sqlc.arg(%s);`,
+					userRoleHierarchyTableName,
+					archivedAtColumn, currentTimeExpression,
+					archivedAtColumn,
+					childRoleIDColumn, childRoleIDColumn,
+				)),
+			},
 			{
 				Annotation: QueryAnnotation{
 					Name: "ArchiveUserRoleHierarchy",
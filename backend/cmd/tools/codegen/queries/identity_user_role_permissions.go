@@ -66,6 +66,71 @@ func buildUserRolePermissionsQueries(database string) []*Query {
 					userRolePermissionsTableName, roleIDColumn, roleIDColumn,
 				)),
 			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "AddPermissionToRoleByName",
+					Type: ExecRowsType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`INSERT INTO %s (
+	%s,
+	%s,
+	%s
+) SELECT
+	sqlc.arg(%s),
+	sqlc.arg(%s),
+	%s.%s
+FROM %s
+WHERE %s.%s IS NULL
+	AND %s.%s = sqlc.arg(permission_name)
+ON CONFLICT (%s, %s) DO UPDATE SET %s = NULL;`,
+					userRolePermissionsTableName,
+					idColumn,
+					roleIDColumn,
+					permissionIDColumn,
+					idColumn,
+					roleIDColumn,
+					permissionsTableName, idColumn,
+					permissionsTableName,
+					permissionsTableName, archivedAtColumn,
+					permissionsTableName, nameColumn,
+					roleIDColumn, permissionIDColumn, archivedAtColumn,
+				)),
+			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "GetPermissionNamesForRole",
+					Type: ManyType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT %s.%s
+FROM %s
+JOIN %s ON %s.%s = %s.%s
+WHERE %s.%s IS NULL
+	AND %s.%s IS NULL
+	AND %s.%s = sqlc.arg(%s)
+ORDER BY %s.%s;`,
+					permissionsTableName, nameColumn,
+					userRolePermissionsTableName,
+					permissionsTableName, permissionsTableName, idColumn, userRolePermissionsTableName, permissionIDColumn,
+					userRolePermissionsTableName, archivedAtColumn,
+					permissionsTableName, archivedAtColumn,
+					userRolePermissionsTableName, roleIDColumn, roleIDColumn,
+					permissionsTableName, nameColumn,
+				)),
+			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "ArchiveUserRolePermissionsForRole",
+					Type: ExecType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`UPDATE %s SET %s = %s
+WHERE %s IS NULL
+	AND %s = sqlc.arg(%s);`,
+					userRolePermissionsTableName,
+					archivedAtColumn, currentTimeExpression,
+					archivedAtColumn,
+					roleIDColumn, roleIDColumn,
+				)),
+			},
 			{
 				Annotation: QueryAnnotation{
 					Name: "ArchiveUserRolePermission",
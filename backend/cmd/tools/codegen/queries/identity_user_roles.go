@@ -11,6 +11,8 @@
 	userRolesTableName = "user_roles"
 
 	scopeColumn = "scope"
+
+	accountRoleScope = "account"
 )
 
 func init() {
@@ -25,8 +27,16 @@ func init() {
 	createdAtColumn,
 	lastUpdatedAtColumn,
 	archivedAtColumn,
+	belongsToAccountColumn,
 }
 
+// accountRolesVisibleToAccountCondition restricts a query to the built-in account roles and the roles a given account defined.
+var accountRolesVisibleToAccountCondition = fmt.Sprintf(`%s.%s = '%s'
+	AND (%s.%s IS NULL OR %s.%s = sqlc.arg(%s))`,
+	userRolesTableName, scopeColumn, accountRoleScope,
+	userRolesTableName, belongsToAccountColumn, userRolesTableName, belongsToAccountColumn, belongsToAccountColumn,
+)
+
 func buildUserRolesQueries(database string) []*Query {
 	switch database {
 	case postgres:
@@ -76,12 +86,14 @@ func buildUserRolesQueries(database string) []*Query {
 	%s
 FROM %s
 WHERE %s.%s IS NULL
+	AND %s.%s IS NULL
 	AND %s.%s = sqlc.arg(%s);`,
 					strings.Join(applyToEach(userRolesColumns, func(i int, s string) string {
 						return fmt.Sprintf("%s.%s", userRolesTableName, s)
 					}), ",\n\t"),
 					userRolesTableName,
 					userRolesTableName, archivedAtColumn,
+					userRolesTableName, belongsToAccountColumn,
 					userRolesTableName, nameColumn, nameColumn,
 				)),
 			},
@@ -109,6 +121,100 @@ func buildUserRolesQueries(database string) []*Query {
 					buildCursorLimitClause(userRolesTableName),
 				)),
 			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "GetAccountRoles",
+					Type: ManyType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT
+	%s
+FROM %s
+WHERE %s.%s IS NULL
+	AND %s
+ORDER BY %s.%s NULLS FIRST, %s.%s;`,
+					strings.Join(applyToEach(userRolesColumns, func(i int, s string) string {
+						return fmt.Sprintf("%s.%s", userRolesTableName, s)
+					}), ",\n\t"),
+					userRolesTableName,
+					userRolesTableName, archivedAtColumn,
+					accountRolesVisibleToAccountCondition,
+					userRolesTableName, belongsToAccountColumn, userRolesTableName, nameColumn,
+				)),
+			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "GetAccountRole",
+					Type: OneType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT
+	%s
+FROM %s
+WHERE %s.%s IS NULL
+	AND %s
+	AND %s.%s = sqlc.arg(%s);`,
+					strings.Join(applyToEach(userRolesColumns, func(i int, s string) string {
+						return fmt.Sprintf("%s.%s", userRolesTableName, s)
+					}), ",\n\t"),
+					userRolesTableName,
+					userRolesTableName, archivedAtColumn,
+					accountRolesVisibleToAccountCondition,
+					userRolesTableName, idColumn, idColumn,
+				)),
+			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "GetAccountRoleByName",
+					Type: OneType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT
+	%s
+FROM %s
+WHERE %s.%s IS NULL
+	AND %s
+	AND %s.%s = sqlc.arg(%s);`,
+					strings.Join(applyToEach(userRolesColumns, func(i int, s string) string {
+						return fmt.Sprintf("%s.%s", userRolesTableName, s)
+					}), ",\n\t"),
+					userRolesTableName,
+					userRolesTableName, archivedAtColumn,
+					accountRolesVisibleToAccountCondition,
+					userRolesTableName, nameColumn, nameColumn,
+				)),
+			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "UpdateAccountRole",
+					Type: ExecRowsType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`UPDATE %s SET
+	%s = sqlc.arg(%s),
+	%s = sqlc.arg(%s),
+	%s = %s
+WHERE %s IS NULL
+	AND %s = sqlc.arg(%s)
+	AND %s = sqlc.arg(%s);`,
+					userRolesTableName,
+					nameColumn, nameColumn,
+					descriptionColumn, descriptionColumn,
+					lastUpdatedAtColumn, currentTimeExpression,
+					archivedAtColumn,
+					idColumn, idColumn,
+					belongsToAccountColumn, belongsToAccountColumn,
+				)),
+			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "ArchiveAccountRole",
+					Type: ExecRowsType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`UPDATE %s SET %s = %s WHERE %s IS NULL AND %s = sqlc.arg(%s) AND %s = sqlc.arg(%s);`,
+					userRolesTableName,
+					archivedAtColumn, currentTimeExpression,
+					archivedAtColumn,
+					idColumn, idColumn,
+					belongsToAccountColumn, belongsToAccountColumn,
+				)),
+			},
 			{
 				Annotation: QueryAnnotation{
 					Name: "ArchiveUserRole",
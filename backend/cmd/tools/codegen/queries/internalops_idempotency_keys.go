@@ -0,0 +1,70 @@
+package main
+
+import (
+	"fmt"
+)
+
+const (
+	idempotencyKeysTableName = "idempotency_keys"
+
+	// abandonedIdempotencyKeyCutoff is when a claimed key that was never completed or released is given up on,
+	// e.g. because the server handling it went away mid-call.
+	abandonedIdempotencyKeyCutoff = `(NOW() - interval '5 minutes')`
+)
+
+func init() {
+	registerTableName(idempotencyKeysTableName)
+}
+
+func buildIdempotencyKeysQueries(database string) []*Query {
+	switch database {
+	case postgres:
+		return []*Query{
+			{
+				Annotation: QueryAnnotation{
+					Name: "ClaimIdempotencyKey",
+					Type: ExecRowsType,
+				},
+				Content: fmt.Sprintf(`INSERT INTO %s (id, idempotency_key, method, scope, request_fingerprint, expires_at)
+VALUES (sqlc.arg(id), sqlc.arg(idempotency_key), sqlc.arg(method), sqlc.arg(scope), sqlc.arg(request_fingerprint), sqlc.arg(expires_at))
+ON CONFLICT (scope, method, idempotency_key) DO UPDATE SET
+	id = EXCLUDED.id,
+	request_fingerprint = EXCLUDED.request_fingerprint,
+	response_type = NULL,
+	response = NULL,
+	created_at = NOW(),
+	completed_at = NULL,
+	expires_at = EXCLUDED.expires_at
+WHERE %s.expires_at < NOW()
+	OR (%s.completed_at IS NULL AND %s.created_at < %s);`, idempotencyKeysTableName, idempotencyKeysTableName, idempotencyKeysTableName, idempotencyKeysTableName, abandonedIdempotencyKeyCutoff),
+			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "GetIdempotencyKey",
+					Type: OneType,
+				},
+				Content: fmt.Sprintf(`SELECT id, idempotency_key, method, scope, request_fingerprint, response_type, response, created_at, completed_at, expires_at FROM %s
+WHERE scope = sqlc.arg(scope)
+	AND method = sqlc.arg(method)
+	AND idempotency_key = sqlc.arg(idempotency_key)
+	AND expires_at > NOW();`, idempotencyKeysTableName),
+			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "CompleteIdempotencyKey",
+					Type: ExecType,
+				},
+				Content: fmt.Sprintf(`UPDATE %s SET response_type = sqlc.arg(response_type), response = sqlc.arg(response), completed_at = NOW() WHERE id = sqlc.arg(id) AND completed_at IS NULL;`, idempotencyKeysTableName),
+			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "ReleaseIdempotencyKey",
+					Type: ExecType,
+				},
+				Content: fmt.Sprintf(`DELETE FROM %s WHERE id = sqlc.arg(id) AND completed_at IS NULL;`, idempotencyKeysTableName),
+			},
+		}
+	default:
+		return nil
+	}
+}
@@ -41,14 +41,21 @@ func main() {
 		"mealplanning/sqlc_queries/valid_preparation_vessels":                    buildValidPreparationVesselsQueries(databaseToUse),
 		"mealplanning/sqlc_queries/valid_ingredient_measurement_units":           buildValidIngredientMeasurementUnitsQueries(databaseToUse),
 		"mealplanning/sqlc_queries/valid_measurement_unit_conversions":           buildValidMeasurementUnitConversionsQueries(databaseToUse),
+		"mealplanning/sqlc_queries/valid_ingredient_nutrition_facts":             buildValidIngredientNutritionFactsQueries(databaseToUse),
 		"mealplanning/sqlc_queries/valid_ingredient_state_ingredients":           buildValidIngredientStateIngredientsQueries(databaseToUse),
 		"mealplanning/sqlc_queries/valid_preparation_instruments":                buildValidPreparationInstrumentsQueries(databaseToUse),
 		"mealplanning/sqlc_queries/account_instrument_ownerships":                buildAccountInstrumentOwnershipQueries(databaseToUse),
 		"mealplanning/sqlc_queries/meal_components":                              buildMealComponentsQueries(databaseToUse),
 		"mealplanning/sqlc_queries/meal_plan_events":                             buildMealPlanEventsQueries(databaseToUse),
+		"mealplanning/sqlc_queries/meal_plan_event_tally_reports":                buildMealPlanEventTallyReportsQueries(databaseToUse),
+		"mealplanning/sqlc_queries/meal_plan_activities":                         buildMealPlanActivitiesQueries(databaseToUse),
+		"mealplanning/sqlc_queries/meal_plan_calendar_feeds":                     buildMealPlanCalendarFeedsQueries(databaseToUse),
+		"mealplanning/sqlc_queries/meal_plan_templates":                          buildMealPlanTemplatesQueries(databaseToUse),
+		"mealplanning/sqlc_queries/pantry_items":                                 buildPantryItemsQueries(databaseToUse),
 		"mealplanning/sqlc_queries/recipe_media":                                 buildRecipeMediaQueries(databaseToUse),
 		"mealplanning/sqlc_queries/recipe_prep_task_steps":                       buildRecipePrepTaskStepsQueries(databaseToUse),
 		"mealplanning/sqlc_queries/recipe_ratings":                               buildRecipeRatingsQueries(databaseToUse),
+		"mealplanning/sqlc_queries/recipe_revisions":                             buildRecipeRevisionsQueries(databaseToUse),
 		"mealplanning/sqlc_queries/recipe_step_completion_condition_ingredients": buildRecipeStepCompletionConditionIngredientsQueries(databaseToUse),
 		"mealplanning/sqlc_queries/recipe_prep_tasks":                            buildRecipePrepTasksQueries(databaseToUse),
 		"mealplanning/sqlc_queries/meals":                                        buildMealsQueries(databaseToUse),
@@ -72,10 +79,12 @@ func main() {
 		"mealplanning/sqlc_queries/recipe_list_items":                            buildRecipeListItemsQueries(databaseToUse),
 		"oauth/sqlc_queries/oauth2_client_tokens":                                buildOAuth2ClientTokensQueries(databaseToUse),
 		"oauth/sqlc_queries/oauth2_clients":                                      buildOAuth2ClientsQueries(databaseToUse),
+		"oauth/sqlc_queries/oidc_signing_keys":                                   buildOIDCSigningKeysQueries(databaseToUse),
 		"identity/sqlc_queries/account_invitations":                              buildAccountInvitationsQueries(databaseToUse),
 		"identity/sqlc_queries/account_user_memberships":                         buildAccountUserMembershipsQueries(databaseToUse),
 		"identity/sqlc_queries/accounts":                                         buildAccountsQueries(databaseToUse),
 		"auditlogentries/sqlc_queries/audit_logs":                                buildAuditLogEntryQueries(databaseToUse),
+		"auditlogentries/sqlc_queries/audit_log_checkpoints":                     buildAuditLogCheckpointQueries(databaseToUse),
 		"identity/sqlc_queries/admin":                                            buildAdminQueries(databaseToUse),
 		"auth/sqlc_queries/password_reset_tokens":                                buildPasswordResetTokensQueries(databaseToUse),
 		"auth/sqlc_queries/user_sessions":                                        buildUserSessionsQueries(databaseToUse),
@@ -85,7 +94,10 @@ func main() {
 		"webhooks/sqlc_queries/webhooks":                                         buildWebhooksQueries(databaseToUse),
 		"webhooks/sqlc_queries/webhook_trigger_events":                           buildWebhookTriggerEventsQueries(databaseToUse),
 		"webhooks/sqlc_queries/webhook_trigger_configs":                          buildWebhookTriggerConfigsQueries(databaseToUse),
+		"webhooks/sqlc_queries/webhook_deliveries":                               buildWebhookDeliveriesQueries(databaseToUse),
 		"notifications/sqlc_queries/user_notifications":                          buildUserNotificationQueries(databaseToUse),
+		"notifications/sqlc_queries/notification_preferences":                    buildNotificationPreferencesQueries(databaseToUse),
+		"notifications/sqlc_queries/queued_notifications":                        buildQueuedNotificationsQueries(databaseToUse),
 		"waitlists/sqlc_queries/waitlists":                                       buildWaitlistsQueries(databaseToUse),
 		"waitlists/sqlc_queries/waitlist_signups":                                buildWaitlistSignupsQueries(databaseToUse),
 		"issuereports/sqlc_queries/issue_reports":                                buildIssueReportsQueries(databaseToUse),
@@ -95,6 +107,7 @@ func main() {
 		"payments/sqlc_queries/subscriptions":                                    buildPaymentsSubscriptionsQueries(databaseToUse),
 		"payments/sqlc_queries/purchases":                                        buildPaymentsPurchasesQueries(databaseToUse),
 		"payments/sqlc_queries/payment_transactions":                             buildPaymentsTransactionsQueries(databaseToUse),
+		"payments/sqlc_queries/payment_provider_events":                          buildPaymentsProviderEventsQueries(databaseToUse),
 		"comments/sqlc_queries/comments":                                         buildCommentsQueries(databaseToUse),
 		"identity/sqlc_queries/user_roles":                                       buildUserRolesQueries(databaseToUse),
 		"identity/sqlc_queries/permissions":                                      buildPermissionsQueries(databaseToUse),
@@ -44,6 +44,99 @@ func buildMaintenanceQueries(database string) []*Query {
 				},
 				Content: fmt.Sprintf(`DELETE FROM %s WHERE %s < %s AND %s < %s AND %s < %s;`, oauth2ClientTokensTableName, codeExpiresAtColumn, oneDayAgo, accessExpiresAtColumn, oneDayAgo, refreshExpiresAtColumn, oneDayAgo),
 			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "DeleteExpiredIdempotencyKeys",
+					Type: ExecRowsType,
+				},
+				Content: fmt.Sprintf(`DELETE FROM %s WHERE expires_at < NOW();`, idempotencyKeysTableName),
+			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "DeleteExpiredUserNotifications",
+					Type: ExecRowsType,
+				},
+				Content: fmt.Sprintf(`DELETE FROM %s WHERE (status != '%s' AND COALESCE(%s, %s) < %s) OR %s < %s;`,
+					userNotificationsTableName,
+					userNotificationStatusUnread, lastUpdatedAtColumn, createdAtColumn, readUserNotificationRetentionCutoff,
+					createdAtColumn, userNotificationRetentionCutoff,
+				),
+			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "DeleteExpiredWebhookDeliveries",
+					Type: ExecRowsType,
+				},
+				Content: fmt.Sprintf(`DELETE FROM %s WHERE %s < %s AND %s IS NULL;`,
+					webhookDeliveriesTableName,
+					createdAtColumn, webhookDeliveryRetentionCutoff,
+					nextAttemptAtColumn,
+				),
+			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "DeleteExpiredMealPlanActivities",
+					Type: ExecRowsType,
+				},
+				Content: fmt.Sprintf(`DELETE FROM %s WHERE %s < %s;`,
+					mealPlanActivitiesTableName,
+					createdAtColumn, mealPlanActivityRetentionCutoff,
+				),
+			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "DeleteExpiredAuditLogEntries",
+					Type: ExecRowsType,
+				},
+				Content: fmt.Sprintf(`DELETE FROM %s WHERE %s < sqlc.arg(cutoff) AND %s IS NULL AND NOT (%s = ANY(sqlc.arg(excluded_resource_types)::text[]));`,
+					auditLogsTableName,
+					createdAtColumn,
+					auditLogSequenceColumn,
+					resourceTypeColumn,
+				),
+			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "DeleteExpiredAuditLogEntriesForResourceType",
+					Type: ExecRowsType,
+				},
+				Content: fmt.Sprintf(`DELETE FROM %s WHERE %s < sqlc.arg(cutoff) AND %s IS NULL AND %s = sqlc.arg(%s);`,
+					auditLogsTableName,
+					createdAtColumn,
+					auditLogSequenceColumn,
+					resourceTypeColumn,
+					resourceTypeColumn,
+				),
+			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "RedactExpiredAuditLogEntries",
+					Type: ExecRowsType,
+				},
+				Content: fmt.Sprintf(`UPDATE %s SET changes = '{}', %s = %s WHERE %s < sqlc.arg(cutoff) AND %s IS NOT NULL AND %s IS NULL AND NOT (%s = ANY(sqlc.arg(excluded_resource_types)::text[]));`,
+					auditLogsTableName,
+					auditLogRedactedAtColumn, currentTimeExpression,
+					createdAtColumn,
+					auditLogSequenceColumn,
+					auditLogRedactedAtColumn,
+					resourceTypeColumn,
+				),
+			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "RedactExpiredAuditLogEntriesForResourceType",
+					Type: ExecRowsType,
+				},
+				Content: fmt.Sprintf(`UPDATE %s SET changes = '{}', %s = %s WHERE %s < sqlc.arg(cutoff) AND %s IS NOT NULL AND %s IS NULL AND %s = sqlc.arg(%s);`,
+					auditLogsTableName,
+					auditLogRedactedAtColumn, currentTimeExpression,
+					createdAtColumn,
+					auditLogSequenceColumn,
+					auditLogRedactedAtColumn,
+					resourceTypeColumn,
+					resourceTypeColumn,
+				),
+			},
 			{
 				Annotation: QueryAnnotation{
 					Name: "DestroyAllData",
@@ -52,7 +145,8 @@ func buildMaintenanceQueries(database string) []*Query {
 				Content: fmt.Sprintf(`TRUNCATE %s CASCADE;`, strings.Join(getAllTables(), ", ")),
 			},
 		}
-		return append(queries, buildQueueTestMessagesQueries(database)...)
+		queries = append(queries, buildQueueTestMessagesQueries(database)...)
+		return append(queries, buildIdempotencyKeysQueries(database)...)
 	default:
 		return nil
 	}
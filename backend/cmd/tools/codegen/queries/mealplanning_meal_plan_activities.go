@@ -0,0 +1,106 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cristalhq/builq"
+)
+
+const (
+	mealPlanActivitiesTableName = "meal_plan_activities"
+
+	mealPlanActivitySequenceColumn      = "sequence"
+	mealPlanActivityNotificationChannel = "meal_plan_activity"
+	// mealPlanActivityRetentionCutoff is when recorded activity is pruned.
+	// Keep in step with mealplanning.MealPlanActivityRetentionPeriod.
+	mealPlanActivityRetentionCutoff = `(NOW() - interval '30 days')`
+)
+
+func init() {
+	registerTableName(mealPlanActivitiesTableName)
+}
+
+var mealPlanActivitiesColumns = []string{
+	idColumn,
+	"event_type",
+	mealPlanEventIDColumn,
+	mealPlanOptionIDColumn,
+	mealPlanOptionVoteIDColumn,
+	mealPlanTaskIDColumn,
+	"by_user",
+	createdAtColumn,
+	belongsToMealPlanColumn,
+	mealPlanActivitySequenceColumn,
+}
+
+func buildMealPlanActivitiesQueries(database string) []*Query {
+	switch database {
+	case postgres:
+
+		insertColumns := filterForInsert(mealPlanActivitiesColumns, mealPlanActivitySequenceColumn)
+
+		return []*Query{
+			{
+				Annotation: QueryAnnotation{
+					Name: "LockMealPlanActivityFeed",
+					Type: ExecType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT pg_advisory_xact_lock(hashtext('%s'), hashtext(sqlc.arg(%s)::text));`,
+					mealPlanActivitiesTableName,
+					belongsToMealPlanColumn,
+				)),
+			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "CreateMealPlanActivity",
+					Type: ExecType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`INSERT INTO %s (
+	%s
+) VALUES (
+	%s
+);`,
+					mealPlanActivitiesTableName,
+					strings.Join(insertColumns, ",\n\t"),
+					strings.Join(applyToEach(insertColumns, func(i int, s string) string {
+						return fmt.Sprintf("sqlc.arg(%s)", s)
+					}), ",\n\t"),
+				)),
+			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "GetMealPlanActivitiesAfterCursor",
+					Type: ManyType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT
+	%s
+FROM %s
+WHERE %s.%s = sqlc.arg(%s)
+	AND %s.%s > sqlc.arg(after_sequence)
+ORDER BY %s.%s ASC
+LIMIT sqlc.arg(result_limit);`,
+					strings.Join(applyToEach(mealPlanActivitiesColumns, func(i int, s string) string {
+						return fmt.Sprintf("%s.%s", mealPlanActivitiesTableName, s)
+					}), ",\n\t"),
+					mealPlanActivitiesTableName,
+					mealPlanActivitiesTableName, belongsToMealPlanColumn, mealPlanIDColumn,
+					mealPlanActivitiesTableName, mealPlanActivitySequenceColumn,
+					mealPlanActivitiesTableName, mealPlanActivitySequenceColumn,
+				)),
+			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "NotifyMealPlanActivity",
+					Type: ExecType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT pg_notify('%s', sqlc.arg(%s));`,
+					mealPlanActivityNotificationChannel,
+					belongsToMealPlanColumn,
+				)),
+			},
+		}
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cristalhq/builq"
+)
+
+const (
+	mealPlanCalendarFeedsTableName = "meal_plan_calendar_feeds"
+
+	calendarFeedTokenHashColumn      = "token_hash"
+	calendarFeedLastAccessedAtColumn = "last_accessed_at"
+)
+
+func init() {
+	registerTableName(mealPlanCalendarFeedsTableName)
+}
+
+var mealPlanCalendarFeedsColumns = []string{
+	idColumn,
+	calendarFeedTokenHashColumn,
+	belongsToUserColumn,
+	belongsToAccountColumn,
+	createdAtColumn,
+	calendarFeedLastAccessedAtColumn,
+	archivedAtColumn,
+}
+
+func buildMealPlanCalendarFeedsQueries(database string) []*Query {
+	switch database {
+	case postgres:
+
+		insertColumns := filterForInsert(mealPlanCalendarFeedsColumns, calendarFeedLastAccessedAtColumn)
+
+		fullSelectColumns := applyToEach(mealPlanCalendarFeedsColumns, func(i int, s string) string {
+			return fullColumnName(mealPlanCalendarFeedsTableName, s)
+		})
+
+		return []*Query{
+			{
+				Annotation: QueryAnnotation{
+					Name: "CreateMealPlanCalendarFeed",
+					Type: ExecType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`INSERT INTO %s (
+	%s
+) VALUES (
+	%s
+);`,
+					mealPlanCalendarFeedsTableName,
+					strings.Join(insertColumns, ",\n\t"),
+					strings.Join(applyToEach(insertColumns, func(i int, s string) string {
+						return fmt.Sprintf("sqlc.arg(%s)", s)
+					}), ",\n\t"),
+				)),
+			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "GetMealPlanCalendarFeedByTokenHash",
+					Type: OneType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT
+	%s
+FROM %s
+WHERE %s.%s = sqlc.arg(%s)
+	AND %s.%s IS NULL;`,
+					strings.Join(fullSelectColumns, ",\n\t"),
+					mealPlanCalendarFeedsTableName,
+					mealPlanCalendarFeedsTableName, calendarFeedTokenHashColumn, calendarFeedTokenHashColumn,
+					mealPlanCalendarFeedsTableName, archivedAtColumn,
+				)),
+			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "ArchiveMealPlanCalendarFeedsForUser",
+					Type: ExecRowsType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`UPDATE %s SET
+	%s = %s
+WHERE %s.%s = sqlc.arg(%s)
+	AND %s.%s = sqlc.arg(%s)
+	AND %s.%s IS NULL;`,
+					mealPlanCalendarFeedsTableName,
+					archivedAtColumn, currentTimeExpression,
+					mealPlanCalendarFeedsTableName, belongsToUserColumn, belongsToUserColumn,
+					mealPlanCalendarFeedsTableName, belongsToAccountColumn, belongsToAccountColumn,
+					mealPlanCalendarFeedsTableName, archivedAtColumn,
+				)),
+			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "MarkMealPlanCalendarFeedAsAccessed",
+					Type: ExecType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`UPDATE %s SET
+	%s = %s
+WHERE %s.%s = sqlc.arg(%s);`,
+					mealPlanCalendarFeedsTableName,
+					calendarFeedLastAccessedAtColumn, currentTimeExpression,
+					mealPlanCalendarFeedsTableName, idColumn, idColumn,
+				)),
+			},
+		}
+	default:
+		return nil
+	}
+}
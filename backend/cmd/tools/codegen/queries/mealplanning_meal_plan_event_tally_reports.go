@@ -0,0 +1,85 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cristalhq/builq"
+)
+
+const (
+	mealPlanEventTallyReportsTableName = "meal_plan_event_tally_reports"
+)
+
+func init() {
+	registerTableName(mealPlanEventTallyReportsTableName)
+}
+
+var mealPlanEventTallyReportsColumns = []string{
+	idColumn,
+	belongsToMealPlanEventColumn,
+	electionMethodColumn,
+	"winner",
+	"tie_broken",
+	"ballot_count",
+	"details",
+	createdAtColumn,
+	archivedAtColumn,
+}
+
+func buildMealPlanEventTallyReportsQueries(database string) []*Query {
+	switch database {
+	case postgres:
+
+		insertColumns := filterForInsert(mealPlanEventTallyReportsColumns)
+
+		return []*Query{
+			{
+				Annotation: QueryAnnotation{
+					Name: "CreateMealPlanEventTallyReport",
+					Type: ExecType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`INSERT INTO %s (
+	%s
+) VALUES (
+	%s
+);`,
+					mealPlanEventTallyReportsTableName,
+					strings.Join(insertColumns, ",\n\t"),
+					strings.Join(applyToEach(insertColumns, func(i int, s string) string {
+						return fmt.Sprintf("sqlc.arg(%s)", s)
+					}), ",\n\t"),
+				)),
+			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "GetMealPlanEventTallyReport",
+					Type: OneType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT
+	%s
+FROM %s
+	JOIN %s ON %s.%s = %s.%s
+WHERE %s.%s IS NULL
+	AND %s.%s IS NULL
+	AND %s.%s = sqlc.arg(%s)
+	AND %s.%s = sqlc.arg(%s)
+ORDER BY %s.%s DESC
+LIMIT 1;`,
+					strings.Join(applyToEach(mealPlanEventTallyReportsColumns, func(i int, s string) string {
+						return fmt.Sprintf("%s.%s", mealPlanEventTallyReportsTableName, s)
+					}), ",\n\t"),
+					mealPlanEventTallyReportsTableName,
+					mealPlanEventsTableName, mealPlanEventTallyReportsTableName, belongsToMealPlanEventColumn, mealPlanEventsTableName, idColumn,
+					mealPlanEventTallyReportsTableName, archivedAtColumn,
+					mealPlanEventsTableName, archivedAtColumn,
+					mealPlanEventsTableName, belongsToMealPlanColumn, mealPlanIDColumn,
+					mealPlanEventTallyReportsTableName, belongsToMealPlanEventColumn, mealPlanEventIDColumn,
+					mealPlanEventTallyReportsTableName, createdAtColumn,
+				)),
+			},
+		}
+	default:
+		return nil
+	}
+}
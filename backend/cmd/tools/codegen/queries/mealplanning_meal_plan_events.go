@@ -71,6 +71,26 @@ func buildMealPlanEventsQueries(database string) []*Query {
 					}), ",\n\t"),
 				)),
 			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "GetAccountIDForMealPlanEvent",
+					Type: OneType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT
+	%s.%s
+FROM %s
+	JOIN %s ON %s.%s = %s.%s
+WHERE %s.%s IS NULL
+	AND %s.%s IS NULL
+	AND %s.%s = sqlc.arg(%s);`,
+					mealPlansTableName, belongsToAccountColumn,
+					mealPlanEventsTableName,
+					mealPlansTableName, mealPlanEventsTableName, belongsToMealPlanColumn, mealPlansTableName, idColumn,
+					mealPlanEventsTableName, archivedAtColumn,
+					mealPlansTableName, archivedAtColumn,
+					mealPlanEventsTableName, idColumn, idColumn,
+				)),
+			},
 			{
 				Annotation: QueryAnnotation{
 					Name: "MealPlanEventIsEligibleForVoting",
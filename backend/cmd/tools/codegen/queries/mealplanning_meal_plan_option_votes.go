@@ -21,6 +21,7 @@ func init() {
 var mealPlanOptionVotesColumns = []string{
 	idColumn,
 	"rank",
+	"score",
 	"abstain",
 	notesColumn,
 	"by_user",
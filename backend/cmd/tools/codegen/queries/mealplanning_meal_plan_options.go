@@ -10,10 +10,13 @@
 const (
 	mealPlanOptionsTableName = "meal_plan_options"
 
-	mealPlanOptionIDColumn         = "meal_plan_option_id"
-	mealPlanOptionsChosenColumn    = "chosen"
-	mealPlanOptionsTiebrokenColumn = "tiebroken"
-	mealPlanOptionsMealScaleColumn = "meal_scale"
+	mealPlanOptionIDColumn           = "meal_plan_option_id"
+	mealPlanOptionsChosenColumn      = "chosen"
+	mealPlanOptionsTiebrokenColumn   = "tiebroken"
+	mealPlanOptionsMealScaleColumn   = "meal_scale"
+	mealPlanOptionsCompletedAtColumn = "completed_at"
+
+	mealPlanOptionAllergenPolicySettingName = "meal_plan_option_allergen_policy"
 )
 
 func init() {
@@ -33,6 +36,7 @@ func init() {
 	lastUpdatedAtColumn,
 	archivedAtColumn,
 	belongsToMealPlanEventColumn,
+	mealPlanOptionsCompletedAtColumn,
 }
 
 func buildMealPlanOptionsQueries(database string) []*Query {
@@ -41,6 +45,7 @@ func buildMealPlanOptionsQueries(database string) []*Query {
 
 		insertColumns := filterForInsert(mealPlanOptionsColumns,
 			mealPlanOptionsTiebrokenColumn,
+			mealPlanOptionsCompletedAtColumn,
 		)
 
 		fullSelectColumns := append(
@@ -130,6 +135,29 @@ func buildMealPlanOptionsQueries(database string) []*Query {
 					mealPlanOptionsTableName, archivedAtColumn,
 				)),
 			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "CompleteMealPlanOption",
+					Type: ExecRowsType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`UPDATE %s SET
+	%s = %s,
+	%s = %s
+WHERE %s IS NULL
+	AND %s IS NULL
+	AND %s
+	AND %s = sqlc.arg(%s)
+	AND %s = sqlc.arg(%s);`,
+					mealPlanOptionsTableName,
+					mealPlanOptionsCompletedAtColumn, currentTimeExpression,
+					lastUpdatedAtColumn, currentTimeExpression,
+					archivedAtColumn,
+					mealPlanOptionsCompletedAtColumn,
+					mealPlanOptionsChosenColumn,
+					belongsToMealPlanEventColumn, mealPlanEventIDColumn,
+					idColumn, mealPlanOptionIDColumn,
+				)),
+			},
 			{
 				Annotation: QueryAnnotation{
 					Name: "FinalizeMealPlanOption",
@@ -171,6 +199,29 @@ func buildMealPlanOptionsQueries(database string) []*Query {
 					mealPlanOptionsTableName,
 				)),
 			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "GetMealPlanOptionAllergenPolicyForAccount",
+					Type: OneType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT
+	COALESCE(%s.%s, %s.default_value)::TEXT AS policy
+FROM %s
+	LEFT JOIN %s ON %s.%s = %s.%s
+		AND %s.%s = sqlc.arg(%s)
+		AND %s.%s IS NULL
+WHERE %s.%s IS NULL
+	AND %s.%s = '%s'
+LIMIT 1;`,
+					serviceSettingConfigurationsTableName, serviceSettingValueColumn, serviceSettingsTableName,
+					serviceSettingsTableName,
+					serviceSettingConfigurationsTableName, serviceSettingConfigurationsTableName, serviceSettingIDColumn, serviceSettingsTableName, idColumn,
+					serviceSettingConfigurationsTableName, belongsToAccountColumn, belongsToAccountColumn,
+					serviceSettingConfigurationsTableName, archivedAtColumn,
+					serviceSettingsTableName, archivedAtColumn,
+					serviceSettingsTableName, nameColumn, mealPlanOptionAllergenPolicySettingName,
+				)),
+			},
 			{
 				Annotation: QueryAnnotation{
 					Name: "GetMealPlanOptions",
@@ -267,7 +318,7 @@ func buildMealPlanOptionsQueries(database string) []*Query {
 	AND %s = sqlc.arg(%s)
 	AND %s = sqlc.arg(%s);`,
 					mealPlanOptionsTableName,
-					strings.Join(applyToEach(filterForUpdate(mealPlanOptionsColumns, mealPlanOptionsChosenColumn, mealPlanOptionsTiebrokenColumn, belongsToMealPlanEventColumn), func(i int, s string) string {
+					strings.Join(applyToEach(filterForUpdate(mealPlanOptionsColumns, mealPlanOptionsChosenColumn, mealPlanOptionsTiebrokenColumn, belongsToMealPlanEventColumn, mealPlanOptionsCompletedAtColumn), func(i int, s string) string {
 						return fmt.Sprintf("%s = sqlc.arg(%s)", s, s)
 					}), ",\n\t"),
 					lastUpdatedAtColumn,
@@ -0,0 +1,233 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cristalhq/builq"
+)
+
+const (
+	mealPlanTemplatesTableName = "meal_plan_templates"
+
+	mealPlanTemplateRecurrenceRuleColumn                = "recurrence_rule"
+	mealPlanTemplateTimezoneColumn                      = "timezone"
+	mealPlanTemplateVotingDeadlineOffsetInSecondsColumn = "voting_deadline_offset_in_seconds"
+	mealPlanTemplateCandidatesPerSlotColumn             = "candidates_per_slot"
+	mealPlanTemplateAvoidMealsFromLastPlansColumn       = "avoid_meals_from_last_plans"
+	mealPlanTemplateSlotsColumn                         = "slots"
+	mealPlanTemplateNextInstantiationAtColumn           = "next_instantiation_at"
+	mealPlanTemplateLastInstantiatedAtColumn            = "last_instantiated_at"
+	mealPlanTemplateInstantiationClaimedUntilColumn     = "instantiation_claimed_until"
+)
+
+func init() {
+	registerTableName(mealPlanTemplatesTableName)
+}
+
+var mealPlanTemplatesColumns = []string{
+	idColumn,
+	nameColumn,
+	notesColumn,
+	electionMethodColumn,
+	mealPlanTemplateRecurrenceRuleColumn,
+	mealPlanTemplateTimezoneColumn,
+	mealPlanTemplateVotingDeadlineOffsetInSecondsColumn,
+	mealPlanTemplateCandidatesPerSlotColumn,
+	mealPlanTemplateAvoidMealsFromLastPlansColumn,
+	mealPlanTemplateSlotsColumn,
+	mealPlanTemplateNextInstantiationAtColumn,
+	mealPlanTemplateLastInstantiatedAtColumn,
+	mealPlanTemplateInstantiationClaimedUntilColumn,
+	createdAtColumn,
+	lastUpdatedAtColumn,
+	archivedAtColumn,
+	belongsToAccountColumn,
+	createdByUserColumn,
+}
+
+func buildMealPlanTemplatesQueries(database string) []*Query {
+	switch database {
+	case postgres:
+
+		insertColumns := filterForInsert(mealPlanTemplatesColumns, mealPlanTemplateLastInstantiatedAtColumn, mealPlanTemplateInstantiationClaimedUntilColumn)
+
+		fullSelectColumns := applyToEach(mealPlanTemplatesColumns, func(i int, s string) string {
+			return fullColumnName(mealPlanTemplatesTableName, s)
+		})
+
+		belongsToAccountCondition := fmt.Sprintf("%s.%s = sqlc.arg(%s)", mealPlanTemplatesTableName, belongsToAccountColumn, belongsToAccountColumn)
+
+		return []*Query{
+			{
+				Annotation: QueryAnnotation{
+					Name: "ArchiveMealPlanTemplate",
+					Type: ExecRowsType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`UPDATE %s SET %s = %s WHERE %s IS NULL AND %s = sqlc.arg(%s) AND %s = sqlc.arg(%s);`,
+					mealPlanTemplatesTableName,
+					archivedAtColumn,
+					currentTimeExpression,
+					archivedAtColumn,
+					belongsToAccountColumn,
+					belongsToAccountColumn,
+					idColumn,
+					idColumn,
+				)),
+			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "CreateMealPlanTemplate",
+					Type: ExecType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`INSERT INTO %s (
+	%s
+) VALUES (
+	%s
+);`,
+					mealPlanTemplatesTableName,
+					strings.Join(insertColumns, ",\n\t"),
+					strings.Join(applyToEach(insertColumns, func(i int, s string) string {
+						return fmt.Sprintf("sqlc.arg(%s)", s)
+					}), ",\n\t"),
+				)),
+			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "GetMealPlanTemplate",
+					Type: OneType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT
+	%s
+FROM %s
+WHERE %s.%s IS NULL
+	AND %s.%s = sqlc.arg(%s)
+	AND %s;`,
+					strings.Join(fullSelectColumns, ",\n\t"),
+					mealPlanTemplatesTableName,
+					mealPlanTemplatesTableName, archivedAtColumn,
+					mealPlanTemplatesTableName, idColumn, idColumn,
+					belongsToAccountCondition,
+				)),
+			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "GetMealPlanTemplatesForAccount",
+					Type: ManyType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT
+	%s,
+	%s,
+	%s
+FROM %s
+WHERE %s.%s IS NULL
+	%s
+%s;`,
+					strings.Join(fullSelectColumns, ",\n\t"),
+					buildFilterCountSelect(mealPlanTemplatesTableName, true, true, []string{}, belongsToAccountCondition),
+					buildTotalCountSelect(mealPlanTemplatesTableName, true, []string{}, belongsToAccountCondition),
+					mealPlanTemplatesTableName,
+					mealPlanTemplatesTableName, archivedAtColumn,
+					buildFilterConditions(mealPlanTemplatesTableName, true, true, belongsToAccountCondition),
+					buildCursorLimitClause(mealPlanTemplatesTableName),
+				)),
+			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "GetMealPlanTemplatesDueForInstantiation",
+					Type: ManyType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT
+	%s
+FROM %s
+WHERE %s.%s IS NULL
+	AND %s.%s <= sqlc.arg(as_of)
+ORDER BY %s.%s;`,
+					strings.Join(fullSelectColumns, ",\n\t"),
+					mealPlanTemplatesTableName,
+					mealPlanTemplatesTableName, archivedAtColumn,
+					mealPlanTemplatesTableName, mealPlanTemplateNextInstantiationAtColumn,
+					mealPlanTemplatesTableName, mealPlanTemplateNextInstantiationAtColumn,
+				)),
+			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "ClaimMealPlanTemplateInstantiation",
+					Type: ExecRowsType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`UPDATE %s SET
+	%s = sqlc.arg(claimed_until)
+WHERE %s IS NULL
+	AND %s = sqlc.arg(%s)
+	AND %s = sqlc.arg(scheduled_at)
+	AND (%s IS NULL OR %s < %s);`,
+					mealPlanTemplatesTableName,
+					mealPlanTemplateInstantiationClaimedUntilColumn,
+					archivedAtColumn,
+					idColumn, idColumn,
+					mealPlanTemplateNextInstantiationAtColumn,
+					mealPlanTemplateInstantiationClaimedUntilColumn, mealPlanTemplateInstantiationClaimedUntilColumn, currentTimeExpression,
+				)),
+			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "ReleaseMealPlanTemplateInstantiationClaim",
+					Type: ExecType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`UPDATE %s SET
+	%s = NULL
+WHERE %s IS NULL
+	AND %s = sqlc.arg(%s);`,
+					mealPlanTemplatesTableName,
+					mealPlanTemplateInstantiationClaimedUntilColumn,
+					archivedAtColumn,
+					idColumn, idColumn,
+				)),
+			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "MarkMealPlanTemplateAsInstantiated",
+					Type: ExecRowsType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`UPDATE %s SET
+	%s = sqlc.arg(%s),
+	%s = sqlc.arg(%s),
+	%s = NULL
+WHERE %s IS NULL
+	AND %s = sqlc.arg(%s)
+	AND %s = sqlc.arg(scheduled_at);`,
+					mealPlanTemplatesTableName,
+					mealPlanTemplateLastInstantiatedAtColumn, mealPlanTemplateLastInstantiatedAtColumn,
+					mealPlanTemplateNextInstantiationAtColumn, mealPlanTemplateNextInstantiationAtColumn,
+					mealPlanTemplateInstantiationClaimedUntilColumn,
+					archivedAtColumn,
+					idColumn, idColumn,
+					mealPlanTemplateNextInstantiationAtColumn,
+				)),
+			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "UpdateMealPlanTemplate",
+					Type: ExecRowsType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`UPDATE %s SET
+	%s,
+	%s = %s
+WHERE %s IS NULL
+	AND %s = sqlc.arg(%s)
+	AND %s = sqlc.arg(%s);`,
+					mealPlanTemplatesTableName,
+					strings.Join(applyToEach(filterForUpdate(mealPlanTemplatesColumns, mealPlanTemplateLastInstantiatedAtColumn, mealPlanTemplateInstantiationClaimedUntilColumn, belongsToAccountColumn, createdByUserColumn), func(i int, s string) string {
+						return fmt.Sprintf("%s = sqlc.arg(%s)", s, s)
+					}), ",\n\t"),
+					lastUpdatedAtColumn, currentTimeExpression,
+					archivedAtColumn,
+					belongsToAccountColumn, belongsToAccountColumn,
+					idColumn, idColumn,
+				)),
+			},
+		}
+	default:
+		return nil
+	}
+}
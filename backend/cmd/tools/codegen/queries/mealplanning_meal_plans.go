@@ -16,6 +16,10 @@
 	mealPlanGroceryListInitializedColumn = "grocery_list_initialized"
 	mealPlanTasksCreatedColumn           = "tasks_created"
 	electionMethodColumn                 = "election_method"
+
+	mealPlanVotingDeadlineReminderSentAtColumn = "voting_deadline_reminder_sent_at"
+	// votingDeadlineReminderWindow is how close a voting deadline has to be before members are reminded to vote.
+	votingDeadlineReminderWindow = `(NOW() + interval '2 hours')`
 )
 
 func init() {
@@ -135,6 +139,32 @@ func buildMealPlansQueries(database string) []*Query {
 					mealPlansTableName, idColumn,
 				)),
 			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "GetMealPlansWithApproachingVotingDeadlines",
+					Type: ManyType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT
+	%s
+FROM %s
+WHERE %s.%s IS NULL
+	AND %s.%s = 'awaiting_votes'
+	AND %s.%s IS NULL
+	AND %s > %s
+	AND %s < %s
+ORDER BY %s.%s;`,
+					strings.Join(applyToEach(mealPlansColumns, func(i int, s string) string {
+						return fmt.Sprintf("%s.%s", mealPlansTableName, s)
+					}), ",\n\t"),
+					mealPlansTableName,
+					mealPlansTableName, archivedAtColumn,
+					mealPlansTableName, mealPlanStatusColumn,
+					mealPlansTableName, mealPlanVotingDeadlineReminderSentAtColumn,
+					mealPlanVotingDeadlineColumn, currentTimeExpression,
+					mealPlanVotingDeadlineColumn, votingDeadlineReminderWindow,
+					mealPlansTableName, idColumn,
+				)),
+			},
 			{
 				Annotation: QueryAnnotation{
 					Name: "GetFinalizedMealPlansForPlanning",
@@ -227,6 +257,21 @@ func buildMealPlansQueries(database string) []*Query {
 					mealPlansTableName, belongsToAccountColumn, belongsToAccountColumn,
 				)),
 			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "GetAccountIDForMealPlan",
+					Type: OneType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT %s.%s
+FROM %s
+WHERE %s.%s IS NULL
+	AND %s.%s = sqlc.arg(%s);`,
+					mealPlansTableName, belongsToAccountColumn,
+					mealPlansTableName,
+					mealPlansTableName, archivedAtColumn,
+					mealPlansTableName, idColumn, idColumn,
+				)),
+			},
 			{
 				Annotation: QueryAnnotation{
 					Name: "GetMealPlansForAccount",
@@ -251,6 +296,58 @@ func buildMealPlansQueries(database string) []*Query {
 					buildCursorLimitClause(mealPlansTableName),
 				)),
 			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "GetMealPlanCountForAccountSince",
+					Type: OneType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT COUNT(%s.%s)
+FROM %s
+WHERE %s.%s IS NULL
+	AND %s.%s = sqlc.arg(%s)
+	AND %s.%s >= sqlc.arg(since);`,
+					mealPlansTableName, idColumn,
+					mealPlansTableName,
+					mealPlansTableName, archivedAtColumn,
+					mealPlansTableName, belongsToAccountColumn, belongsToAccountColumn,
+					mealPlansTableName, createdAtColumn,
+				)),
+			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "GetRecentlyChosenMealIDsForAccount",
+					Type: ManyType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT DISTINCT %s.%s
+FROM %s
+	JOIN %s ON %s.%s = %s.%s
+WHERE %s.%s IS NULL
+	AND %s.%s IS TRUE
+	AND %s.%s IS NULL
+	AND %s.%s IN (
+		SELECT %s.%s
+		FROM %s
+		WHERE %s.%s IS NULL
+			AND %s.%s = sqlc.arg(%s)
+			AND %s.%s = 'finalized'
+		ORDER BY %s.%s DESC
+		LIMIT sqlc.arg(meal_plan_count)
+	);`,
+					mealPlanOptionsTableName, mealIDColumn,
+					mealPlanOptionsTableName,
+					mealPlanEventsTableName, mealPlanOptionsTableName, belongsToMealPlanEventColumn, mealPlanEventsTableName, idColumn,
+					mealPlanOptionsTableName, archivedAtColumn,
+					mealPlanOptionsTableName, mealPlanOptionsChosenColumn,
+					mealPlanEventsTableName, archivedAtColumn,
+					mealPlanEventsTableName, belongsToMealPlanColumn,
+					mealPlansTableName, idColumn,
+					mealPlansTableName,
+					mealPlansTableName, archivedAtColumn,
+					mealPlansTableName, belongsToAccountColumn, belongsToAccountColumn,
+					mealPlansTableName, mealPlanStatusColumn,
+					mealPlansTableName, mealPlanVotingDeadlineColumn,
+				)),
+			},
 			{
 				Annotation: QueryAnnotation{
 					Name: "GetMealPlanPastVotingDeadline",
@@ -337,6 +434,21 @@ func buildMealPlansQueries(database string) []*Query {
 					idColumn, idColumn,
 				)),
 			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "MarkMealPlanVotingDeadlineReminderSent",
+					Type: ExecType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`UPDATE %s SET
+	%s = %s
+WHERE %s IS NULL
+	AND %s = sqlc.arg(%s);`,
+					mealPlansTableName,
+					mealPlanVotingDeadlineReminderSentAtColumn, currentTimeExpression,
+					archivedAtColumn,
+					idColumn, idColumn,
+				)),
+			},
 			{
 				Annotation: QueryAnnotation{
 					Name: "UpdateMealPlan",
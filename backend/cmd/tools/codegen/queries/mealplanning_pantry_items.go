@@ -0,0 +1,233 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cristalhq/builq"
+)
+
+const (
+	pantryItemsTableName = "pantry_items"
+
+	pantryItemsQuantityColumn  = "quantity"
+	pantryItemsExpiresAtColumn = "expires_at"
+)
+
+func init() {
+	registerTableName(pantryItemsTableName)
+}
+
+var pantryItemsColumns = []string{
+	idColumn,
+	notesColumn,
+	pantryItemsQuantityColumn,
+	"storage_location",
+	pantryItemsExpiresAtColumn,
+	validIngredientIDColumn,
+	validMeasurementUnitIDColumn,
+	belongsToAccountColumn,
+	createdAtColumn,
+	lastUpdatedAtColumn,
+	archivedAtColumn,
+}
+
+func buildPantryItemsQueries(database string) []*Query {
+	switch database {
+	case postgres:
+
+		insertColumns := filterForInsert(pantryItemsColumns)
+
+		fullSelectColumns := mergeColumns(
+			applyToEach(filterFromSlice(pantryItemsColumns, validIngredientIDColumn, validMeasurementUnitIDColumn), func(i int, s string) string {
+				return fmt.Sprintf("%s.%s", pantryItemsTableName, s)
+			}),
+			append(
+				applyToEach(validIngredientsColumns, func(i int, s string) string {
+					return fmt.Sprintf("%s.%s as valid_ingredient_%s", validIngredientsTableName, s, s)
+				}),
+				applyToEach(validMeasurementUnitsColumns, func(i int, s string) string {
+					return fmt.Sprintf("%s.%s as valid_measurement_unit_%s", validMeasurementUnitsTableName, s, s)
+				})...,
+			),
+			5,
+		)
+
+		joins := fmt.Sprintf(`INNER JOIN %s ON %s.%s = %s.%s
+INNER JOIN %s ON %s.%s = %s.%s`,
+			validIngredientsTableName, pantryItemsTableName, validIngredientIDColumn, validIngredientsTableName, idColumn,
+			validMeasurementUnitsTableName, pantryItemsTableName, validMeasurementUnitIDColumn, validMeasurementUnitsTableName, idColumn,
+		)
+
+		return []*Query{
+			{
+				Annotation: QueryAnnotation{
+					Name: "ArchivePantryItem",
+					Type: ExecRowsType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`UPDATE %s SET
+	%s = %s
+WHERE %s IS NULL
+	AND %s = sqlc.arg(%s)
+	AND %s = sqlc.arg(%s);`,
+					pantryItemsTableName,
+					archivedAtColumn, currentTimeExpression,
+					archivedAtColumn,
+					idColumn, idColumn,
+					belongsToAccountColumn, belongsToAccountColumn,
+				)),
+			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "CreatePantryItem",
+					Type: ExecType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`INSERT INTO %s (
+	%s
+) VALUES (
+	%s
+);`,
+					pantryItemsTableName,
+					strings.Join(insertColumns, ",\n\t"),
+					strings.Join(applyToEach(insertColumns, func(i int, s string) string {
+						return fmt.Sprintf("sqlc.arg(%s)", s)
+					}), ",\n\t"),
+				)),
+			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "CheckPantryItemExistence",
+					Type: OneType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT EXISTS (
+	SELECT %s.id
+	FROM %s
+	WHERE %s.%s IS NULL
+		AND %s.%s = sqlc.arg(%s)
+		AND %s.%s = sqlc.arg(%s)
+);`,
+					pantryItemsTableName,
+					pantryItemsTableName,
+					pantryItemsTableName, archivedAtColumn,
+					pantryItemsTableName, idColumn, idColumn,
+					pantryItemsTableName, belongsToAccountColumn, belongsToAccountColumn,
+				)),
+			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "GetAvailablePantryItemsForAccount",
+					Type: ManyType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT
+	%s
+FROM %s
+%s
+WHERE %s.%s IS NULL
+	AND %s.%s > 0
+	AND (%s.%s IS NULL OR %s.%s > %s)
+	AND %s.%s = sqlc.arg(%s)
+ORDER BY %s.%s ASC NULLS LAST, %s.%s ASC;`,
+					strings.Join(fullSelectColumns, ",\n\t"),
+					pantryItemsTableName,
+					joins,
+					pantryItemsTableName, archivedAtColumn,
+					pantryItemsTableName, pantryItemsQuantityColumn,
+					pantryItemsTableName, pantryItemsExpiresAtColumn, pantryItemsTableName, pantryItemsExpiresAtColumn, currentTimeExpression,
+					pantryItemsTableName, belongsToAccountColumn, belongsToAccountColumn,
+					pantryItemsTableName, pantryItemsExpiresAtColumn,
+					pantryItemsTableName, createdAtColumn,
+				)),
+			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "GetPantryItems",
+					Type: ManyType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT
+	%s,
+	%s,
+	%s
+FROM %s
+%s
+WHERE
+	%s.%s IS NULL
+	%s
+%s;`,
+					strings.Join(fullSelectColumns, ",\n\t"),
+					buildFilterCountSelect(pantryItemsTableName, true, true, []string{}, "pantry_items.belongs_to_account = sqlc.arg(account_id)"),
+					buildTotalCountSelect(pantryItemsTableName, true, []string{}, "pantry_items.belongs_to_account = sqlc.arg(account_id)"),
+					pantryItemsTableName,
+					joins,
+					pantryItemsTableName, archivedAtColumn,
+					buildFilterConditions(pantryItemsTableName, true, true, "pantry_items.belongs_to_account = sqlc.arg(account_id)"),
+					buildCursorLimitClause(pantryItemsTableName),
+				)),
+			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "GetPantryItem",
+					Type: OneType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT
+	%s
+FROM %s
+%s
+WHERE %s.%s IS NULL
+	AND %s.%s = sqlc.arg(%s)
+	AND %s.%s = sqlc.arg(%s);`,
+					strings.Join(fullSelectColumns, ",\n\t"),
+					pantryItemsTableName,
+					joins,
+					pantryItemsTableName, archivedAtColumn,
+					pantryItemsTableName, idColumn, idColumn,
+					pantryItemsTableName, belongsToAccountColumn, belongsToAccountColumn,
+				)),
+			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "UpdatePantryItem",
+					Type: ExecRowsType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`UPDATE %s SET
+	%s,
+	%s = %s
+WHERE %s IS NULL
+	AND %s = sqlc.arg(%s)
+	AND %s.%s = sqlc.arg(%s);`,
+					pantryItemsTableName,
+					strings.Join(applyToEach(filterForUpdate(pantryItemsColumns, belongsToAccountColumn), func(i int, s string) string {
+						return fmt.Sprintf("%s = sqlc.arg(%s)", s, s)
+					}), ",\n\t"),
+					lastUpdatedAtColumn,
+					currentTimeExpression,
+					archivedAtColumn,
+					idColumn, idColumn,
+					pantryItemsTableName, belongsToAccountColumn, belongsToAccountColumn,
+				)),
+			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "DrawDownPantryItem",
+					Type: OneType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`UPDATE %s SET
+	%s = GREATEST(%s - sqlc.arg(amount)::NUMERIC, 0),
+	%s = %s
+WHERE %s IS NULL
+	AND %s = sqlc.arg(%s)
+	AND %s.%s = sqlc.arg(%s)
+RETURNING %s;`,
+					pantryItemsTableName,
+					pantryItemsQuantityColumn, pantryItemsQuantityColumn,
+					lastUpdatedAtColumn, currentTimeExpression,
+					archivedAtColumn,
+					idColumn, idColumn,
+					pantryItemsTableName, belongsToAccountColumn, belongsToAccountColumn,
+					pantryItemsQuantityColumn,
+				)),
+			},
+		}
+	default:
+		return nil
+	}
+}
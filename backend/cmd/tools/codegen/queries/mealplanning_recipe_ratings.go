@@ -161,6 +161,37 @@ func buildRecipeRatingsQueries(database string) []*Query {
 					recipeRatingsTableName, idColumn, idColumn,
 				)),
 			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "GetRecipeRatingSummariesForAccount",
+					Type: ManyType,
+				},
+				// summaries only count ratings left by the account's current members.
+				Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT
+	%s.%s AS recipe_id,
+	AVG(%s.overall)::REAL AS average_overall,
+	COUNT(%s.%s) AS rating_count
+FROM %s
+	JOIN %s ON %s.%s = %s.%s
+WHERE %s.%s IS NULL
+	AND %s.%s IS NULL
+	AND %s.%s = sqlc.arg(%s)
+	AND %s.%s = ANY(sqlc.arg(recipe_ids)::text[])
+GROUP BY %s.%s
+ORDER BY %s.%s;`,
+					recipeRatingsTableName, belongsToRecipeColumn,
+					recipeRatingsTableName,
+					recipeRatingsTableName, idColumn,
+					recipeRatingsTableName,
+					accountUserMembershipsTableName, accountUserMembershipsTableName, belongsToUserColumn, recipeRatingsTableName, createdByUserColumn,
+					recipeRatingsTableName, archivedAtColumn,
+					accountUserMembershipsTableName, archivedAtColumn,
+					accountUserMembershipsTableName, belongsToAccountColumn, belongsToAccountColumn,
+					recipeRatingsTableName, belongsToRecipeColumn,
+					recipeRatingsTableName, belongsToRecipeColumn,
+					recipeRatingsTableName, belongsToRecipeColumn,
+				)),
+			},
 			{
 				Annotation: QueryAnnotation{
 					Name: "UpdateRecipeRating",
@@ -0,0 +1,184 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cristalhq/builq"
+)
+
+const (
+	recipeRevisionsTableName               = "recipe_revisions"
+	mealPlanOptionRecipeRevisionsTableName = "meal_plan_option_recipe_revisions"
+	recipeRevisionNumberColumn             = "revision_number"
+	recipeRevisionSnapshotColumn           = "snapshot"
+	belongsToRecipeRevisionColumn          = "belongs_to_recipe_revision"
+	recipeRevisionCreatedByUserColumn      = "created_by_user"
+)
+
+func init() {
+	registerTableName(recipeRevisionsTableName)
+	registerTableName(mealPlanOptionRecipeRevisionsTableName)
+}
+
+var recipeRevisionsColumns = []string{
+	idColumn,
+	belongsToRecipeColumn,
+	recipeRevisionNumberColumn,
+	recipeRevisionSnapshotColumn,
+	recipeRevisionCreatedByUserColumn,
+	createdAtColumn,
+}
+
+func buildRecipeRevisionsQueries(database string) []*Query {
+	switch database {
+	case postgres:
+
+		fullSelectColumns := applyToEach(recipeRevisionsColumns, func(i int, s string) string {
+			return fullColumnName(recipeRevisionsTableName, s)
+		})
+
+		// revision listings leave out snapshots, which are fetched one revision at a time.
+		listSelectColumns := applyToEach(filterFromSlice(recipeRevisionsColumns, recipeRevisionSnapshotColumn), func(i int, s string) string {
+			return fullColumnName(recipeRevisionsTableName, s)
+		})
+
+		belongsToRecipeCondition := fmt.Sprintf("%s.%s = sqlc.arg(%s)", recipeRevisionsTableName, belongsToRecipeColumn, belongsToRecipeColumn)
+
+		return []*Query{
+			{
+				Annotation: QueryAnnotation{
+					Name: "CreateRecipeRevision",
+					Type: OneType,
+				},
+				// revision numbers are assigned here, so that they're sequential per recipe no matter who records them. callers
+				// must hold the recipe's row lock (see LockRecipe) so that two changes can't be handed the same number.
+				Content: buildRawQuery((&builq.Builder{}).Addf(`INSERT INTO %s (
+	%s,
+	%s,
+	%s,
+	%s,
+	%s
+)
+SELECT
+	sqlc.arg(%s)::TEXT,
+	sqlc.arg(%s)::TEXT,
+	COALESCE(MAX(%s.%s), 0) + 1,
+	sqlc.arg(%s)::JSONB,
+	sqlc.narg(%s)::TEXT
+FROM %s
+WHERE %s
+RETURNING *;`,
+					recipeRevisionsTableName,
+					idColumn,
+					belongsToRecipeColumn,
+					recipeRevisionNumberColumn,
+					recipeRevisionSnapshotColumn,
+					recipeRevisionCreatedByUserColumn,
+					idColumn,
+					belongsToRecipeColumn,
+					recipeRevisionsTableName, recipeRevisionNumberColumn,
+					recipeRevisionSnapshotColumn,
+					recipeRevisionCreatedByUserColumn,
+					recipeRevisionsTableName,
+					belongsToRecipeCondition,
+				)),
+			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "GetRecipeRevision",
+					Type: OneType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT
+	%s
+FROM %s
+WHERE %s
+	AND %s.%s = sqlc.arg(%s);`,
+					strings.Join(fullSelectColumns, ",\n\t"),
+					recipeRevisionsTableName,
+					belongsToRecipeCondition,
+					recipeRevisionsTableName, recipeRevisionNumberColumn, recipeRevisionNumberColumn,
+				)),
+			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "GetRecipeRevisionsForRecipe",
+					Type: ManyType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT
+	%s,
+	%s,
+	%s
+FROM %s
+WHERE %s
+	%s
+%s;`,
+					strings.Join(listSelectColumns, ",\n\t"),
+					buildFilterCountSelect(recipeRevisionsTableName, false, false, []string{}, belongsToRecipeCondition),
+					buildTotalCountSelect(recipeRevisionsTableName, false, []string{}, belongsToRecipeCondition),
+					recipeRevisionsTableName,
+					belongsToRecipeCondition,
+					buildFilterConditions(recipeRevisionsTableName, false, false),
+					buildCursorLimitClause(recipeRevisionsTableName),
+				)),
+			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "GetRecipeRevisionsForMealPlanOption",
+					Type: ManyType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT
+	%s.%s AS %s,
+	%s.%s,
+	%s.%s,
+	%s.%s
+FROM %s
+	JOIN %s ON %s.%s = %s.%s
+WHERE %s.%s = sqlc.arg(%s)
+ORDER BY %s.%s;`,
+					recipeRevisionsTableName, belongsToRecipeColumn, recipeIDColumn,
+					recipeRevisionsTableName, idColumn,
+					recipeRevisionsTableName, recipeRevisionNumberColumn,
+					recipeRevisionsTableName, recipeRevisionSnapshotColumn,
+					mealPlanOptionRecipeRevisionsTableName,
+					recipeRevisionsTableName, mealPlanOptionRecipeRevisionsTableName, belongsToRecipeRevisionColumn, recipeRevisionsTableName, idColumn,
+					mealPlanOptionRecipeRevisionsTableName, belongsToMealPlanOptionColumn, belongsToMealPlanOptionColumn,
+					recipeRevisionsTableName, belongsToRecipeColumn,
+				)),
+			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "PinLatestRecipeRevisionsForMealPlanOption",
+					Type: ExecType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`INSERT INTO %s (
+	%s,
+	%s
+)
+SELECT DISTINCT ON (%s.%s)
+	sqlc.arg(%s)::TEXT,
+	%s.%s
+FROM %s
+	JOIN %s ON %s.%s = %s.%s
+WHERE %s.%s = sqlc.arg(%s)
+	AND %s.%s IS NULL
+ORDER BY %s.%s, %s.%s DESC
+ON CONFLICT DO NOTHING;`,
+					mealPlanOptionRecipeRevisionsTableName,
+					belongsToMealPlanOptionColumn,
+					belongsToRecipeRevisionColumn,
+					recipeRevisionsTableName, belongsToRecipeColumn,
+					belongsToMealPlanOptionColumn,
+					recipeRevisionsTableName, idColumn,
+					recipeRevisionsTableName,
+					mealComponentsTableName, mealComponentsTableName, recipeIDColumn, recipeRevisionsTableName, belongsToRecipeColumn,
+					mealComponentsTableName, belongsToMealColumn, belongsToMealColumn,
+					mealComponentsTableName, archivedAtColumn,
+					recipeRevisionsTableName, belongsToRecipeColumn, recipeRevisionsTableName, recipeRevisionNumberColumn,
+				)),
+			},
+		}
+	default:
+		return nil
+	}
+}
@@ -71,6 +71,20 @@ func buildRecipeStepsQueries(database string) []*Query {
 					idColumn,
 				)),
 			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "ArchiveRecipeStepsForRecipe",
+					Type: ExecType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`UPDATE %s SET %s = %s WHERE %s IS NULL AND %s = sqlc.arg(%s);`,
+					recipeStepsTableName,
+					archivedAtColumn,
+					currentTimeExpression,
+					archivedAtColumn,
+					belongsToRecipeColumn,
+					belongsToRecipeColumn,
+				)),
+			},
 			{
 				Annotation: QueryAnnotation{
 					Name: "CreateRecipeStep",
@@ -375,6 +375,38 @@ func buildRecipesQueries(database string) []*Query {
 					recipesTableName, idColumn,
 				)),
 			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "LockRecipe",
+					Type: OneType,
+				},
+				// changes to a recipe hold this lock until they commit, so that its revisions are recorded in order.
+				Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT %s.%s
+FROM %s
+WHERE %s.%s = sqlc.arg(%s)
+FOR UPDATE;`,
+					recipesTableName, idColumn,
+					recipesTableName,
+					recipesTableName, idColumn, idColumn,
+				)),
+			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "LockRecipeForRecipeStep",
+					Type: OneType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT %s.%s
+FROM %s
+	JOIN %s ON %s.%s = %s.%s
+WHERE %s.%s = sqlc.arg(%s)
+FOR UPDATE OF %s;`,
+					recipesTableName, idColumn,
+					recipesTableName,
+					recipeStepsTableName, recipeStepsTableName, belongsToRecipeColumn, recipesTableName, idColumn,
+					recipeStepsTableName, idColumn, recipeStepIDColumn,
+					recipesTableName,
+				)),
+			},
 			{
 				Annotation: QueryAnnotation{
 					Name: "UpdateRecipe",
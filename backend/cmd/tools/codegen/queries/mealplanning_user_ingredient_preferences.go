@@ -126,6 +126,34 @@ func buildUserIngredientPreferencesQueries(database string) []*Query {
 					buildCursorLimitClause(userIngredientPreferencesTableName),
 				)),
 			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "GetAllergyUserIngredientPreferencesForAccount",
+					Type: ManyType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT
+	%s
+FROM %s
+	JOIN %s ON %s.%s = %s.%s
+	JOIN %s ON %s.%s = %s.%s
+WHERE %s.%s IS NULL
+	AND %s.%s IS NULL
+	AND %s.%s IS NULL
+	AND %s.allergy = TRUE
+	AND %s.%s = sqlc.arg(%s)
+ORDER BY %s.%s, %s.%s;`,
+					strings.Join(fullSelectColumns, ",\n\t"),
+					userIngredientPreferencesTableName,
+					validIngredientsTableName, validIngredientsTableName, idColumn, userIngredientPreferencesTableName, userIngredientPreferencesIngredientColumn,
+					accountUserMembershipsTableName, accountUserMembershipsTableName, belongsToUserColumn, userIngredientPreferencesTableName, belongsToUserColumn,
+					userIngredientPreferencesTableName, archivedAtColumn,
+					validIngredientsTableName, archivedAtColumn,
+					accountUserMembershipsTableName, archivedAtColumn,
+					userIngredientPreferencesTableName,
+					accountUserMembershipsTableName, belongsToAccountColumn, belongsToAccountColumn,
+					userIngredientPreferencesTableName, belongsToUserColumn, userIngredientPreferencesTableName, idColumn,
+				)),
+			},
 			{
 				Annotation: QueryAnnotation{
 					Name: "GetUserIngredientPreference",
@@ -0,0 +1,198 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cristalhq/builq"
+)
+
+const (
+	validIngredientNutritionFactsTableName = "valid_ingredient_nutrition_facts"
+
+	validIngredientNutritionFactsValidIngredientIDColumn      = "valid_ingredient_id"
+	validIngredientNutritionFactsBasisMeasurementUnitIDColumn = "basis_measurement_unit_id"
+)
+
+func init() {
+	registerTableName(validIngredientNutritionFactsTableName)
+}
+
+var validIngredientNutritionFactsColumns = []string{
+	idColumn,
+	validIngredientNutritionFactsValidIngredientIDColumn,
+	validIngredientNutritionFactsBasisMeasurementUnitIDColumn,
+	"calories",
+	"protein_in_grams",
+	"total_fat_in_grams",
+	"saturated_fat_in_grams",
+	"carbohydrates_in_grams",
+	"sugar_in_grams",
+	"fiber_in_grams",
+	"sodium_in_milligrams",
+	"cholesterol_in_milligrams",
+	notesColumn,
+	createdAtColumn,
+	lastUpdatedAtColumn,
+	archivedAtColumn,
+}
+
+func buildValidIngredientNutritionFactsQueries(database string) []*Query {
+	switch database {
+	case postgres:
+
+		insertColumns := filterForInsert(validIngredientNutritionFactsColumns)
+
+		fullSelectColumns := append(
+			applyToEach(validIngredientNutritionFactsColumns, func(i int, s string) string {
+				return fmt.Sprintf("%s.%s as valid_ingredient_nutrition_facts_%s", validIngredientNutritionFactsTableName, s, s)
+			}),
+			applyToEach(validMeasurementUnitsColumns, func(i int, s string) string {
+				return fmt.Sprintf("%s.%s as basis_measurement_unit_%s", validMeasurementUnitsTableName, s, s)
+			})...,
+		)
+
+		joinStatement := fmt.Sprintf("JOIN %s ON %s.%s = %s.%s",
+			validMeasurementUnitsTableName,
+			validIngredientNutritionFactsTableName, validIngredientNutritionFactsBasisMeasurementUnitIDColumn,
+			validMeasurementUnitsTableName, idColumn,
+		)
+
+		return []*Query{
+			{
+				Annotation: QueryAnnotation{
+					Name: "ArchiveValidIngredientNutritionFacts",
+					Type: ExecRowsType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`UPDATE %s SET %s = %s WHERE %s IS NULL AND %s = sqlc.arg(%s);`,
+					validIngredientNutritionFactsTableName,
+					archivedAtColumn,
+					currentTimeExpression,
+					archivedAtColumn,
+					idColumn,
+					idColumn,
+				)),
+			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "CreateValidIngredientNutritionFacts",
+					Type: ExecType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`INSERT INTO %s (
+	%s
+) VALUES (
+	%s
+);`,
+					validIngredientNutritionFactsTableName,
+					strings.Join(insertColumns, ",\n\t"),
+					strings.Join(applyToEach(insertColumns, func(i int, s string) string {
+						return fmt.Sprintf("sqlc.arg(%s)", s)
+					}), ",\n\t"),
+				)),
+			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "CheckValidIngredientNutritionFactsExistence",
+					Type: OneType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT EXISTS (
+	SELECT %s.%s
+	FROM %s
+	WHERE %s.%s IS NULL
+		AND %s.%s = sqlc.arg(%s)
+);`,
+					validIngredientNutritionFactsTableName, idColumn,
+					validIngredientNutritionFactsTableName,
+					validIngredientNutritionFactsTableName, archivedAtColumn,
+					validIngredientNutritionFactsTableName, idColumn, idColumn,
+				)),
+			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "GetValidIngredientNutritionFacts",
+					Type: OneType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT
+	%s
+FROM %s
+	%s
+WHERE
+	%s.%s = sqlc.arg(%s)
+	AND %s.%s IS NULL
+	AND %s.%s IS NULL;`,
+					strings.Join(fullSelectColumns, ",\n\t"),
+					validIngredientNutritionFactsTableName,
+					joinStatement,
+					validIngredientNutritionFactsTableName, idColumn, idColumn,
+					validIngredientNutritionFactsTableName, archivedAtColumn,
+					validMeasurementUnitsTableName, archivedAtColumn,
+				)),
+			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "GetValidIngredientNutritionFactsForIngredient",
+					Type: OneType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT
+	%s
+FROM %s
+	%s
+WHERE
+	%s.%s = sqlc.arg(%s)
+	AND %s.%s IS NULL
+	AND %s.%s IS NULL;`,
+					strings.Join(fullSelectColumns, ",\n\t"),
+					validIngredientNutritionFactsTableName,
+					joinStatement,
+					validIngredientNutritionFactsTableName, validIngredientNutritionFactsValidIngredientIDColumn, validIngredientNutritionFactsValidIngredientIDColumn,
+					validIngredientNutritionFactsTableName, archivedAtColumn,
+					validMeasurementUnitsTableName, archivedAtColumn,
+				)),
+			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "GetValidIngredientNutritionFactsForIngredients",
+					Type: ManyType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT
+	%s
+FROM %s
+	%s
+WHERE
+	%s.%s = ANY(sqlc.arg(valid_ingredient_ids)::text[])
+	AND %s.%s IS NULL
+	AND %s.%s IS NULL;`,
+					strings.Join(fullSelectColumns, ",\n\t"),
+					validIngredientNutritionFactsTableName,
+					joinStatement,
+					validIngredientNutritionFactsTableName, validIngredientNutritionFactsValidIngredientIDColumn,
+					validIngredientNutritionFactsTableName, archivedAtColumn,
+					validMeasurementUnitsTableName, archivedAtColumn,
+				)),
+			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "UpdateValidIngredientNutritionFacts",
+					Type: ExecRowsType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`UPDATE %s SET
+	%s,
+	%s = %s
+WHERE %s IS NULL
+	AND %s = sqlc.arg(%s);`,
+					validIngredientNutritionFactsTableName,
+					strings.Join(applyToEach(filterForUpdate(validIngredientNutritionFactsColumns, validIngredientNutritionFactsValidIngredientIDColumn), func(i int, s string) string {
+						return fmt.Sprintf("%s = sqlc.arg(%s)", s, s)
+					}), ",\n\t"),
+					lastUpdatedAtColumn,
+					currentTimeExpression,
+					archivedAtColumn,
+					idColumn,
+					idColumn,
+				)),
+			},
+		}
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cristalhq/builq"
+)
+
+const (
+	notificationPreferencesTableName = "notification_preferences"
+	notificationTypeColumn           = "notification_type"
+)
+
+func init() {
+	registerTableName(notificationPreferencesTableName)
+}
+
+var (
+	notificationPreferencesColumns = []string{
+		idColumn,
+		belongsToUserColumn,
+		notificationTypeColumn,
+		"push_enabled",
+		"email_enabled",
+		"in_app_enabled",
+		"digest_mode",
+		"timezone",
+		"quiet_hours_start",
+		"quiet_hours_end",
+		createdAtColumn,
+		lastUpdatedAtColumn,
+	}
+)
+
+func buildNotificationPreferencesQueries(database string) []*Query {
+	switch database {
+	case postgres:
+
+		insertColumns := filterForInsert(notificationPreferencesColumns)
+		updateColumns := filterForUpdate(notificationPreferencesColumns, belongsToUserColumn, notificationTypeColumn)
+		fullSelectColumns := applyToEach(notificationPreferencesColumns, func(_ int, s string) string {
+			return fullColumnName(notificationPreferencesTableName, s)
+		})
+
+		return []*Query{
+			{
+				Annotation: QueryAnnotation{
+					Name: "UpsertNotificationPreference",
+					Type: OneType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`INSERT INTO %s (
+	%s
+) VALUES (
+	%s
+)
+ON CONFLICT (%s, %s)
+DO UPDATE SET
+	%s,
+	%s = %s
+RETURNING %s;`,
+					notificationPreferencesTableName,
+					strings.Join(insertColumns, ",\n\t"),
+					strings.Join(applyToEach(insertColumns, func(_ int, s string) string {
+						return fmt.Sprintf("sqlc.arg(%s)", s)
+					}), ",\n\t"),
+					belongsToUserColumn, notificationTypeColumn,
+					strings.Join(applyToEach(updateColumns, func(_ int, s string) string {
+						return fmt.Sprintf("%s = EXCLUDED.%s", s, s)
+					}), ",\n\t"),
+					lastUpdatedAtColumn, currentTimeExpression,
+					strings.Join(notificationPreferencesColumns, ", "),
+				)),
+			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "GetNotificationPreference",
+					Type: OneType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT
+	%s
+FROM %s
+WHERE %s.%s = sqlc.arg(%s)
+	AND %s.%s = sqlc.arg(%s);`,
+					strings.Join(fullSelectColumns, ",\n\t"),
+					notificationPreferencesTableName,
+					notificationPreferencesTableName, belongsToUserColumn, belongsToUserColumn,
+					notificationPreferencesTableName, notificationTypeColumn, notificationTypeColumn,
+				)),
+			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "GetNotificationPreferencesForUser",
+					Type: ManyType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT
+	%s
+FROM %s
+WHERE %s.%s = sqlc.arg(%s)
+ORDER BY %s.%s ASC;`,
+					strings.Join(fullSelectColumns, ",\n\t"),
+					notificationPreferencesTableName,
+					notificationPreferencesTableName, belongsToUserColumn, belongsToUserColumn,
+					notificationPreferencesTableName, notificationTypeColumn,
+				)),
+			},
+		}
+	default:
+		return nil
+	}
+}
@@ -0,0 +1,116 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cristalhq/builq"
+)
+
+const (
+	queuedNotificationsTableName = "queued_notifications"
+	deliverAfterColumn           = "deliver_after"
+	deliveredAtColumn            = "delivered_at"
+)
+
+func init() {
+	registerTableName(queuedNotificationsTableName)
+}
+
+var (
+	queuedNotificationsColumns = []string{
+		idColumn,
+		belongsToUserColumn,
+		notificationTypeColumn,
+		contentColumn,
+		"action_url",
+		deliverAfterColumn,
+		deliveredAtColumn,
+		createdAtColumn,
+	}
+)
+
+func buildQueuedNotificationsQueries(database string) []*Query {
+	switch database {
+	case postgres:
+
+		insertColumns := filterForInsert(queuedNotificationsColumns, deliveredAtColumn)
+		fullSelectColumns := applyToEach(queuedNotificationsColumns, func(_ int, s string) string {
+			return fullColumnName(queuedNotificationsTableName, s)
+		})
+
+		return []*Query{
+			{
+				Annotation: QueryAnnotation{
+					Name: "CreateQueuedNotification",
+					Type: ExecType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`INSERT INTO %s (
+	%s
+) VALUES (
+	%s
+);`,
+					queuedNotificationsTableName,
+					strings.Join(insertColumns, ",\n\t"),
+					strings.Join(applyToEach(insertColumns, func(_ int, s string) string {
+						return fmt.Sprintf("sqlc.arg(%s)", s)
+					}), ",\n\t"),
+				)),
+			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "GetUserIDsWithDueQueuedNotifications",
+					Type: ManyType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT DISTINCT %s.%s
+FROM %s
+WHERE %s.%s IS NULL
+	AND %s.%s <= sqlc.arg(now);`,
+					queuedNotificationsTableName, belongsToUserColumn,
+					queuedNotificationsTableName,
+					queuedNotificationsTableName, deliveredAtColumn,
+					queuedNotificationsTableName, deliverAfterColumn,
+				)),
+			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "GetDueQueuedNotificationsForUser",
+					Type: ManyType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT
+	%s
+FROM %s
+WHERE %s.%s = sqlc.arg(%s)
+	AND %s.%s IS NULL
+	AND %s.%s <= sqlc.arg(now)
+ORDER BY %s.%s ASC;`,
+					strings.Join(fullSelectColumns, ",\n\t"),
+					queuedNotificationsTableName,
+					queuedNotificationsTableName, belongsToUserColumn, belongsToUserColumn,
+					queuedNotificationsTableName, deliveredAtColumn,
+					queuedNotificationsTableName, deliverAfterColumn,
+					queuedNotificationsTableName, createdAtColumn,
+				)),
+			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "MarkQueuedNotificationsAsDelivered",
+					Type: ExecRowsType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`UPDATE %s SET
+	%s = %s
+WHERE %s = sqlc.arg(%s)
+	AND %s IS NULL
+	AND %s = ANY(sqlc.arg(ids)::text[]);`,
+					queuedNotificationsTableName,
+					deliveredAtColumn, currentTimeExpression,
+					belongsToUserColumn, belongsToUserColumn,
+					deliveredAtColumn,
+					idColumn,
+				)),
+			},
+		}
+	default:
+		return nil
+	}
+}
@@ -11,6 +11,16 @@
 	userNotificationsTableName      = "user_notifications"
 	contentColumn                   = "content"
 	userNotificationStatusDismissed = "dismissed"
+	userNotificationStatusUnread    = "unread"
+	userNotificationStatusRead      = "read"
+	sourceMessageIDColumn           = "source_message_id"
+
+	// readUserNotificationRetentionCutoff is when read and dismissed notifications are pruned, counting from when they were read.
+	// Keep in step with notifications.ReadUserNotificationRetentionPeriod.
+	readUserNotificationRetentionCutoff = `(NOW() - interval '30 days')`
+	// userNotificationRetentionCutoff is when every notification is pruned, read or not.
+	// Keep in step with notifications.UserNotificationRetentionPeriod.
+	userNotificationRetentionCutoff = `(NOW() - interval '180 days')`
 )
 
 func init() {
@@ -25,6 +35,10 @@ func init() {
 		belongsToUserColumn,
 		createdAtColumn,
 		lastUpdatedAtColumn,
+		notificationTypeColumn,
+		"target_type",
+		"target_id",
+		"deep_link",
 	}
 )
 
@@ -32,7 +46,8 @@ func buildUserNotificationQueries(database string) []*Query {
 	switch database {
 	case postgres:
 
-		insertColumns := filterForInsert(userNotificationsColumns, "status")
+		// source_message_id is only ever written, to keep a redelivered data change message from notifying anyone twice.
+		insertColumns := append(filterForInsert(userNotificationsColumns, "status"), sourceMessageIDColumn)
 		fullSelectColumns := applyToEach(userNotificationsColumns, func(_ int, s string) string {
 			return fullColumnName(userNotificationsTableName, s)
 		})
@@ -41,18 +56,19 @@ func buildUserNotificationQueries(database string) []*Query {
 			{
 				Annotation: QueryAnnotation{
 					Name: "CreateUserNotification",
-					Type: ExecType,
+					Type: ExecRowsType,
 				},
 				Content: buildRawQuery((&builq.Builder{}).Addf(`INSERT INTO %s (
 	%s
 ) VALUES (
 	%s
-);`,
+) ON CONFLICT (%s, %s) DO NOTHING;`,
 					userNotificationsTableName,
 					strings.Join(insertColumns, ",\n\t"),
 					strings.Join(applyToEach(insertColumns, func(_ int, s string) string {
 						return fmt.Sprintf("sqlc.arg(%s)", s)
 					}), ",\n\t"),
+					sourceMessageIDColumn, belongsToUserColumn,
 				)),
 			},
 			{
@@ -134,13 +150,45 @@ func buildUserNotificationQueries(database string) []*Query {
 	%s = %s
 WHERE %s = sqlc.arg(%s);`,
 					userNotificationsTableName,
-					strings.Join(applyToEach(filterForUpdate(userNotificationsColumns, contentColumn, belongsToUserColumn), func(i int, s string) string {
+					strings.Join(applyToEach(filterForUpdate(userNotificationsColumns, contentColumn, notificationTypeColumn, "target_type", "target_id", "deep_link", belongsToUserColumn), func(i int, s string) string {
 						return fmt.Sprintf("%s = sqlc.arg(%s)", s, s)
 					}), ",\n\t"),
 					lastUpdatedAtColumn, currentTimeExpression,
 					idColumn, idColumn,
 				)),
 			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "MarkAllUserNotificationsAsRead",
+					Type: ExecRowsType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`UPDATE %s SET
+	status = '%s',
+	%s = %s
+WHERE %s = sqlc.arg(%s)
+	AND status = '%s';`,
+					userNotificationsTableName,
+					userNotificationStatusRead,
+					lastUpdatedAtColumn, currentTimeExpression,
+					belongsToUserColumn, belongsToUserColumn,
+					userNotificationStatusUnread,
+				)),
+			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "GetUnreadUserNotificationCount",
+					Type: OneType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT COUNT(%s.%s)
+FROM %s
+WHERE %s.%s = sqlc.arg(%s)
+	AND %s.status = '%s';`,
+					userNotificationsTableName, idColumn,
+					userNotificationsTableName,
+					userNotificationsTableName, belongsToUserColumn, belongsToUserColumn,
+					userNotificationsTableName, userNotificationStatusUnread,
+				)),
+			},
 		}
 	default:
 		return nil
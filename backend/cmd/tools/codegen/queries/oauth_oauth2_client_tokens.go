@@ -28,6 +28,8 @@ func init() {
 	"client_id",
 	belongsToUserColumn,
 	"redirect_uri",
+	"scope",
+	"nonce",
 	codeColumn,
 	"code_challenge",
 	"code_challenge_method",
@@ -22,6 +22,10 @@ func init() {
 	descriptionColumn,
 	clientIDColumn,
 	"client_secret",
+	"allowed_scopes",
+	"redirect_uris",
+	"allowed_grant_types",
+	belongsToUserColumn,
 	createdAtColumn,
 	archivedAtColumn,
 }
@@ -0,0 +1,72 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cristalhq/builq"
+)
+
+const (
+	oidcSigningKeysTableName = "oidc_signing_keys"
+)
+
+func init() {
+	registerTableName(oidcSigningKeysTableName)
+}
+
+var oidcSigningKeysColumns = []string{
+	idColumn,
+	"algorithm",
+	"private_key",
+	createdAtColumn,
+	"retires_at",
+	expiresAtColumn,
+}
+
+func buildOIDCSigningKeysQueries(database string) []*Query {
+	switch database {
+	case postgres:
+		insertColumns := filterForInsert(oidcSigningKeysColumns)
+
+		return []*Query{
+			{
+				Annotation: QueryAnnotation{
+					Name: "CreateOIDCSigningKey",
+					Type: ExecType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`INSERT INTO %s (
+	%s
+) VALUES (
+	%s
+);`,
+					oidcSigningKeysTableName,
+					strings.Join(insertColumns, ",\n\t"),
+					strings.Join(applyToEach(insertColumns, func(i int, s string) string {
+						return fmt.Sprintf("sqlc.arg(%s)", s)
+					}), ",\n\t"),
+				)),
+			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "GetUnexpiredOIDCSigningKeys",
+					Type: ManyType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT
+	%s
+FROM %s
+WHERE %s.%s > %s
+ORDER BY %s.%s DESC;`,
+					strings.Join(applyToEach(oidcSigningKeysColumns, func(i int, s string) string {
+						return fmt.Sprintf("%s.%s", oidcSigningKeysTableName, s)
+					}), ",\n\t"),
+					oidcSigningKeysTableName,
+					oidcSigningKeysTableName, expiresAtColumn, currentTimeExpression,
+					oidcSigningKeysTableName, createdAtColumn,
+				)),
+			},
+		}
+	default:
+		return nil
+	}
+}
@@ -24,6 +24,7 @@ func init() {
 	"currency",
 	"billing_interval_months",
 	"external_product_id",
+	"entitlements",
 	createdAtColumn,
 	lastUpdatedAtColumn,
 	archivedAtColumn,
@@ -0,0 +1,186 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cristalhq/builq"
+)
+
+const (
+	paymentProviderEventsTableName = "payment_provider_events"
+
+	providerColumn = "provider"
+
+	// paymentProviderEventClaimLease is how long a claimed event stays claimed before another delivery or a replay may reclaim it.
+	paymentProviderEventClaimLease = "interval '10 minutes'"
+)
+
+func init() {
+	registerTableName(paymentProviderEventsTableName)
+}
+
+var paymentProviderEventsColumns = []string{
+	idColumn,
+	providerColumn,
+	"provider_event_id",
+	"event_type",
+	externalSubscriptionIDColumn,
+	"account_id",
+	"payload",
+	statusColumn,
+	"processing_error",
+	"occurred_at",
+	"processed_at",
+	createdAtColumn,
+}
+
+func buildPaymentsProviderEventsQueries(database string) []*Query {
+	switch database {
+	case postgres:
+		insertColumns := filterForInsert(paymentProviderEventsColumns, statusColumn, "processing_error", "processed_at")
+		fullSelectColumns := applyToEach(paymentProviderEventsColumns, func(_ int, s string) string {
+			return fullColumnName(paymentProviderEventsTableName, s)
+		})
+		providerCondition := fmt.Sprintf("%s.%s = COALESCE(sqlc.narg(%s), %s.%s)", paymentProviderEventsTableName, providerColumn, providerColumn, paymentProviderEventsTableName, providerColumn)
+
+		return []*Query{
+			{
+				Annotation: QueryAnnotation{
+					Name: "CreatePaymentProviderEvent",
+					Type: ExecRowsType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`INSERT INTO %s (
+	%s
+) VALUES (
+	%s
+)
+ON CONFLICT (%s, provider_event_id) DO NOTHING;`,
+					paymentProviderEventsTableName,
+					strings.Join(insertColumns, ",\n\t"),
+					strings.Join(applyToEach(insertColumns, func(_ int, s string) string {
+						return fmt.Sprintf("sqlc.arg(%s)", s)
+					}), ",\n\t"),
+					providerColumn,
+				)),
+			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "GetPaymentProviderEvent",
+					Type: OneType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT
+	%s
+FROM %s
+WHERE %s.%s = sqlc.arg(%s);`,
+					strings.Join(fullSelectColumns, ",\n\t"),
+					paymentProviderEventsTableName,
+					paymentProviderEventsTableName, idColumn, idColumn,
+				)),
+			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "GetPaymentProviderEventByProviderEventID",
+					Type: OneType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT
+	%s
+FROM %s
+WHERE %s.%s = sqlc.arg(%s)
+AND %s.provider_event_id = sqlc.arg(provider_event_id);`,
+					strings.Join(fullSelectColumns, ",\n\t"),
+					paymentProviderEventsTableName,
+					paymentProviderEventsTableName, providerColumn, providerColumn,
+					paymentProviderEventsTableName,
+				)),
+			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "GetPaymentProviderEvents",
+					Type: ManyType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT
+	%s,
+	%s,
+	%s
+FROM %s
+WHERE %s
+	%s
+%s;`,
+					strings.Join(fullSelectColumns, ",\n\t"),
+					buildFilterCountSelect(paymentProviderEventsTableName, false, false, nil, providerCondition),
+					buildTotalCountSelect(paymentProviderEventsTableName, false, nil, providerCondition),
+					paymentProviderEventsTableName,
+					providerCondition,
+					buildFilterConditions(paymentProviderEventsTableName, false, false),
+					buildCursorLimitClause(paymentProviderEventsTableName),
+				)),
+			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "GetLatestAppliedPaymentProviderEventTime",
+					Type: OneType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT
+	%s.occurred_at
+FROM %s
+WHERE %s.%s = sqlc.arg(%s)
+AND %s.%s = sqlc.arg(%s)
+AND %s.%s = 'applied'
+ORDER BY %s.occurred_at DESC
+LIMIT 1;`,
+					paymentProviderEventsTableName,
+					paymentProviderEventsTableName,
+					paymentProviderEventsTableName, providerColumn, providerColumn,
+					paymentProviderEventsTableName, externalSubscriptionIDColumn, externalSubscriptionIDColumn,
+					paymentProviderEventsTableName, statusColumn,
+					paymentProviderEventsTableName,
+				)),
+			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "ClaimPaymentProviderEvent",
+					Type: OneType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`UPDATE %s SET
+	%s = 'processing',
+	processed_at = %s
+WHERE %s.%s = sqlc.arg(%s)
+AND (
+	%s.%s IN ('received', 'failed', 'ignored')
+	OR (sqlc.arg(include_finished)::BOOLEAN AND %s.%s IN ('applied', 'stale'))
+	OR (%s.%s = 'processing' AND %s.processed_at < %s - %s)
+)
+RETURNING
+	%s;`,
+					paymentProviderEventsTableName,
+					statusColumn,
+					currentTimeExpression,
+					paymentProviderEventsTableName, idColumn, idColumn,
+					paymentProviderEventsTableName, statusColumn,
+					paymentProviderEventsTableName, statusColumn,
+					paymentProviderEventsTableName, statusColumn, paymentProviderEventsTableName, currentTimeExpression, paymentProviderEventClaimLease,
+					strings.Join(fullSelectColumns, ",\n\t"),
+				)),
+			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "UpdatePaymentProviderEventStatus",
+					Type: ExecRowsType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`UPDATE %s SET
+	%s = sqlc.arg(%s),
+	processing_error = sqlc.arg(processing_error),
+	processed_at = %s
+WHERE %s = sqlc.arg(%s);`,
+					paymentProviderEventsTableName,
+					statusColumn, statusColumn,
+					currentTimeExpression,
+					idColumn, idColumn,
+				)),
+			},
+		}
+	default:
+		return nil
+	}
+}
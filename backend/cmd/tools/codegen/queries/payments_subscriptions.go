@@ -101,6 +101,43 @@ func buildPaymentsSubscriptionsQueries(database string) []*Query {
 					subscriptionsTableName, externalSubscriptionIDColumn, externalSubscriptionIDColumn,
 				)),
 			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "GetSubscriptionByExternalIDForUpdate",
+					Type: OneType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT
+	%s
+FROM %s
+WHERE %s.%s IS NULL
+AND %s.%s = sqlc.arg(%s)
+FOR UPDATE;`,
+					strings.Join(fullSelectColumns, ",\n\t"),
+					subscriptionsTableName,
+					subscriptionsTableName, archivedAtColumn,
+					subscriptionsTableName, externalSubscriptionIDColumn, externalSubscriptionIDColumn,
+				)),
+			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "GetSubscriptionsForAccountEndingAfter",
+					Type: ManyType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT
+	%s
+FROM %s
+WHERE %s.%s IS NULL
+	AND %s
+	AND %s.current_period_end > sqlc.arg(period_ends_after)
+ORDER BY %s.%s;`,
+					strings.Join(fullSelectColumns, ",\n\t"),
+					subscriptionsTableName,
+					subscriptionsTableName, archivedAtColumn,
+					accountCondition,
+					subscriptionsTableName,
+					subscriptionsTableName, createdAtColumn,
+				)),
+			},
 			{
 				Annotation: QueryAnnotation{
 					Name: "GetSubscriptionsForAccount",
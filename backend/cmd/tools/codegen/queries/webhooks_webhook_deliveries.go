@@ -0,0 +1,171 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cristalhq/builq"
+)
+
+const (
+	webhookDeliveriesTableName  = "webhook_deliveries"
+	webhookDeliveryStatusColumn = "status"
+	responseStatusCodeColumn    = "response_status_code"
+	nextAttemptAtColumn         = "next_attempt_at"
+	webhookDeliveryStatusFailed = "failed"
+
+	// webhookDeliveryRetryLease is how long a claimed retry is held before it's considered lost and claimed again.
+	// Keep in step with webhooks.WebhookDeliveryRetryLease.
+	webhookDeliveryRetryLease = `interval '15 minutes'`
+	// webhookDeliveryRetentionCutoff is when delivery attempts with no retry pending are pruned.
+	// Keep in step with webhooks.WebhookDeliveryRetentionPeriod.
+	webhookDeliveryRetentionCutoff = `(NOW() - interval '30 days')`
+)
+
+func init() {
+	registerTableName(webhookDeliveriesTableName)
+}
+
+var (
+	webhookDeliveriesColumns = []string{
+		idColumn,
+		belongsToWebhookColumn,
+		belongsToAccountColumn,
+		"request_id",
+		"event_id",
+		triggerEventColumn,
+		"attempt_number",
+		webhookDeliveryStatusColumn,
+		"request_body",
+		responseStatusCodeColumn,
+		"response_body",
+		"latency_in_milliseconds",
+		"error_message",
+		nextAttemptAtColumn,
+		createdAtColumn,
+	}
+)
+
+func buildWebhookDeliveriesQueries(database string) []*Query {
+	switch database {
+	case postgres:
+		insertColumns := filterForInsert(webhookDeliveriesColumns)
+		fullSelectColumns := applyToEach(webhookDeliveriesColumns, func(_ int, s string) string {
+			return fullColumnName(webhookDeliveriesTableName, s)
+		})
+
+		belongsToWebhookCondition := fmt.Sprintf("%s.%s = sqlc.arg(%s)", webhookDeliveriesTableName, belongsToWebhookColumn, belongsToWebhookColumn)
+		belongsToAccountCondition := fmt.Sprintf("%s.%s = sqlc.arg(%s)", webhookDeliveriesTableName, belongsToAccountColumn, belongsToAccountColumn)
+
+		return []*Query{
+			{
+				Annotation: QueryAnnotation{
+					Name: "CreateWebhookDelivery",
+					Type: ExecType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`INSERT INTO %s (
+	%s
+) VALUES (
+	%s
+);`,
+					webhookDeliveriesTableName,
+					strings.Join(insertColumns, ",\n\t"),
+					strings.Join(applyToEach(insertColumns, func(_ int, s string) string {
+						if s == responseStatusCodeColumn || s == nextAttemptAtColumn {
+							return fmt.Sprintf("sqlc.narg(%s)", s)
+						}
+						return fmt.Sprintf("sqlc.arg(%s)", s)
+					}), ",\n\t"),
+				)),
+			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "GetWebhookDelivery",
+					Type: OneType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT
+	%s
+FROM %s
+WHERE %s.%s = sqlc.arg(%s)
+	AND %s.%s = sqlc.arg(%s)
+	AND %s.%s = sqlc.arg(%s);`,
+					strings.Join(fullSelectColumns, ",\n\t"),
+					webhookDeliveriesTableName,
+					webhookDeliveriesTableName, idColumn, idColumn,
+					webhookDeliveriesTableName, belongsToWebhookColumn, belongsToWebhookColumn,
+					webhookDeliveriesTableName, belongsToAccountColumn, belongsToAccountColumn,
+				)),
+			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "GetWebhookDeliveriesForWebhook",
+					Type: ManyType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`SELECT
+	%s,
+	%s,
+	%s
+FROM %s
+WHERE %s
+%s;`,
+					strings.Join(fullSelectColumns, ",\n\t"),
+					buildFilterCountSelect(webhookDeliveriesTableName, false, false, nil, belongsToWebhookCondition, belongsToAccountCondition),
+					buildTotalCountSelect(webhookDeliveriesTableName, false, nil, belongsToWebhookCondition, belongsToAccountCondition),
+					webhookDeliveriesTableName,
+					strings.TrimPrefix(buildFilterConditions(webhookDeliveriesTableName, false, false, belongsToWebhookCondition, belongsToAccountCondition), "AND "),
+					buildCursorLimitClause(webhookDeliveriesTableName),
+				)),
+			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "SupersedeWebhookDeliveryRetries",
+					Type: ExecType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`UPDATE %s SET
+	%s = NULL
+WHERE %s.%s = sqlc.arg(%s)
+	AND %s.request_id = sqlc.arg(request_id)
+	AND %s.attempt_number < sqlc.arg(attempt_number)
+	AND %s.%s IS NOT NULL;`,
+					webhookDeliveriesTableName,
+					nextAttemptAtColumn,
+					webhookDeliveriesTableName, belongsToWebhookColumn, belongsToWebhookColumn,
+					webhookDeliveriesTableName,
+					webhookDeliveriesTableName,
+					webhookDeliveriesTableName, nextAttemptAtColumn,
+				)),
+			},
+			{
+				Annotation: QueryAnnotation{
+					Name: "ClaimDueWebhookDeliveries",
+					Type: ManyType,
+				},
+				Content: buildRawQuery((&builq.Builder{}).Addf(`UPDATE %s SET
+	%s = %s + %s
+WHERE %s.%s IN (
+	SELECT %s.%s
+	FROM %s
+	WHERE %s.%s = '%s'
+		AND %s.%s <= %s
+	ORDER BY %s.%s
+	LIMIT sqlc.arg(result_limit)
+	FOR UPDATE SKIP LOCKED
+)
+RETURNING
+	%s;`,
+					webhookDeliveriesTableName,
+					nextAttemptAtColumn, currentTimeExpression, webhookDeliveryRetryLease,
+					webhookDeliveriesTableName, idColumn,
+					webhookDeliveriesTableName, idColumn,
+					webhookDeliveriesTableName,
+					webhookDeliveriesTableName, webhookDeliveryStatusColumn, webhookDeliveryStatusFailed,
+					webhookDeliveriesTableName, nextAttemptAtColumn, currentTimeExpression,
+					webhookDeliveriesTableName, nextAttemptAtColumn,
+					strings.Join(fullSelectColumns, ",\n\t"),
+				)),
+			},
+		}
+	default:
+		return nil
+	}
+}
@@ -55,7 +55,10 @@ func main() {
 		"internal/config.MealPlanFinalizerConfig",
 		"internal/config.MealPlanGroceryListInitializerConfig",
 		"internal/config.MealPlanTaskCreatorConfig",
+		"internal/config.MealPlanTemplateInstantiatorConfig",
 		"internal/config.SearchDataIndexSchedulerConfig",
+		"internal/config.NotificationDigestSenderConfig",
+		"internal/config.WebhookDeliveryRetrierConfig",
 		"internal/config.AsyncMessageHandlerConfig",
 		"internal/config.EmailDeliverabilityTestConfig",
 		"internal/config.QueueTestJobConfig",
@@ -0,0 +1,111 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/recipeimport"
+
+	"github.com/spf13/cobra"
+)
+
+// ExportData is the subset of the data exporter's output the importer resolves entities against.
+type ExportData struct {
+	Enumerations ExportedEnumerations `json:"enumerations"`
+}
+
+// ExportedEnumerations is the subset of exported valid enumerations the importer resolves entities against.
+type ExportedEnumerations struct {
+	ValidIngredients                []*mealplanning.ValidIngredient                `json:"validIngredients"`
+	ValidPreparations               []*mealplanning.ValidPreparation               `json:"validPreparations"`
+	ValidMeasurementUnits           []*mealplanning.ValidMeasurementUnit           `json:"validMeasurementUnits"`
+	ValidIngredientMeasurementUnits []*mealplanning.ValidIngredientMeasurementUnit `json:"validIngredientMeasurementUnits"`
+}
+
+func main() {
+	var (
+		inputFile        string
+		format           string
+		enumerationsFile string
+		outputFile       string
+	)
+
+	root := &cobra.Command{
+		Use:   "recipe_importer",
+		Short: "Convert a schema.org Recipe (JSON-LD or saved HTML) or plain-text recipe into a draft recipe, without network access",
+		RunE: func(_ *cobra.Command, _ []string) error {
+			return runImport(inputFile, recipeimport.Format(format), enumerationsFile, outputFile)
+		},
+	}
+
+	root.Flags().StringVar(&inputFile, "input", "", "Recipe file to import (HTML, JSON-LD, or plain text)")
+	root.Flags().StringVar(&format, "format", string(recipeimport.FormatAuto), "Input format: auto, jsonld, or text")
+	root.Flags().StringVar(&enumerationsFile, "enumerations", "seed_data.json", "Data exporter output to resolve ingredients, units, and preparations against")
+	root.Flags().StringVar(&outputFile, "output", "", "Output file path for the draft recipe and report (defaults to stdout)")
+
+	if err := root.MarkFlagRequired("input"); err != nil {
+		log.Fatalln(err)
+	}
+
+	if err := root.Execute(); err != nil {
+		log.Fatalln(err)
+	}
+}
+
+func runImport(inputFile string, format recipeimport.Format, enumerationsFile, outputFile string) error {
+	ctx := context.Background()
+
+	content, err := os.ReadFile(inputFile)
+	if err != nil {
+		return fmt.Errorf("reading input file: %w", err)
+	}
+
+	enumerationsData, err := os.ReadFile(enumerationsFile)
+	if err != nil {
+		return fmt.Errorf("reading enumerations file: %w", err)
+	}
+
+	var export ExportData
+	if err = json.Unmarshal(enumerationsData, &export); err != nil {
+		return fmt.Errorf("unmarshaling enumerations file: %w", err)
+	}
+
+	searcher := recipeimport.NewInMemoryEntitySearcher(
+		export.Enumerations.ValidIngredients,
+		export.Enumerations.ValidMeasurementUnits,
+		export.Enumerations.ValidPreparations,
+		export.Enumerations.ValidIngredientMeasurementUnits,
+	)
+
+	result, err := recipeimport.NewImporter(searcher).Import(ctx, content, format)
+	if err != nil {
+		return fmt.Errorf("importing recipe: %w", err)
+	}
+
+	output, err := json.MarshalIndent(result, "", "  ")
+	if err != nil {
+		return fmt.Errorf("marshaling result: %w", err)
+	}
+
+	if outputFile == "" {
+		fmt.Println(string(output))
+	} else if err = os.WriteFile(outputFile, output, 0o600); err != nil {
+		return fmt.Errorf("writing output file: %w", err)
+	}
+
+	for _, unresolved := range result.Report.Unresolved {
+		log.Printf("unresolved %s: %q (from %q)", unresolved.Kind, unresolved.Text, unresolved.Context)
+	}
+
+	for _, warning := range result.Report.Warnings {
+		log.Printf("warning: %s", warning)
+	}
+
+	log.Printf("imported %q with %d steps, %d unresolved entities", result.Recipe.Name, len(result.Recipe.Steps), len(result.Report.Unresolved))
+
+	return nil
+}
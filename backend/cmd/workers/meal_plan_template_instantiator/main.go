@@ -0,0 +1,39 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	mealplantemplateinstantiator "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/build/jobs/meal_plan_template_instantiator"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/config"
+
+	_ "go.uber.org/automaxprocs"
+)
+
+func doTheThing(ctx context.Context) error {
+	config.ConditionallyCease()
+
+	cfg, err := config.LoadConfigFromEnvironment[config.MealPlanTemplateInstantiatorConfig]()
+	if err != nil {
+		return fmt.Errorf("error getting config: %w", err)
+	}
+	cfg.Database.RunMigrations = false
+
+	worker, err := mealplantemplateinstantiator.Build(ctx, cfg)
+	if err != nil {
+		return fmt.Errorf("error building meal plan template instantiator: %w", err)
+	}
+
+	if _, err = worker.Work(ctx); err != nil {
+		return fmt.Errorf("error running meal plan template instantiator: %w", err)
+	}
+
+	return nil
+}
+
+func main() {
+	if err := doTheThing(context.Background()); err != nil {
+		log.Fatal(err)
+	}
+}
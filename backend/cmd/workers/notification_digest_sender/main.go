@@ -0,0 +1,39 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	notificationdigestsender "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/build/jobs/notification_digest_sender"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/config"
+
+	_ "go.uber.org/automaxprocs"
+)
+
+func doTheThing(ctx context.Context) error {
+	config.ConditionallyCease()
+
+	cfg, err := config.LoadConfigFromEnvironment[config.NotificationDigestSenderConfig]()
+	if err != nil {
+		return fmt.Errorf("error getting config: %w", err)
+	}
+	cfg.Database.RunMigrations = false
+
+	sender, err := notificationdigestsender.Build(ctx, cfg)
+	if err != nil {
+		return fmt.Errorf("error building sender: %w", err)
+	}
+
+	if err = sender.SendDigests(ctx); err != nil {
+		return fmt.Errorf("error sending notification digests: %w", err)
+	}
+
+	return nil
+}
+
+func main() {
+	if err := doTheThing(context.Background()); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,39 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	webhookdeliveryretrier "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/build/jobs/webhook_delivery_retrier"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/config"
+
+	_ "go.uber.org/automaxprocs"
+)
+
+func doTheThing(ctx context.Context) error {
+	config.ConditionallyCease()
+
+	cfg, err := config.LoadConfigFromEnvironment[config.WebhookDeliveryRetrierConfig]()
+	if err != nil {
+		return fmt.Errorf("error getting config: %w", err)
+	}
+	cfg.Database.RunMigrations = false
+
+	worker, err := webhookdeliveryretrier.Build(ctx, cfg)
+	if err != nil {
+		return fmt.Errorf("error building webhook delivery retrier: %w", err)
+	}
+
+	if _, err = worker.Work(ctx); err != nil {
+		return fmt.Errorf("error running webhook delivery retrier: %w", err)
+	}
+
+	return nil
+}
+
+func main() {
+	if err := doTheThing(context.Background()); err != nil {
+		log.Fatal(err)
+	}
+}
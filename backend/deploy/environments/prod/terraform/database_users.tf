@@ -5,8 +5,11 @@ locals {
   meal_plan_finalizer_username                = "meal_plan_finalizer"
   meal_plan_grocery_list_initializer_username = "meal_plan_grocery_list_initializer"
   meal_plan_task_creator_username             = "meal_plan_task_creator"
+  meal_plan_template_instantiator_username    = "meal_plan_template_instantiator"
   search_data_index_scheduler_username        = "search_data_index_scheduler"
   mobile_notification_scheduler_username      = "mobile_notification_scheduler"
+  notification_digest_sender_username         = "notification_digest_sender"
+  webhook_delivery_retrier_username           = "webhook_delivery_retrier"
   queue_test_username                         = "queue_test"
 }
 
@@ -80,6 +83,20 @@ resource "google_sql_user" "meal_plan_grocery_list_initializer_user" {
   password = random_password.meal_plan_grocery_list_initializer_user_database_password.result
 }
 
+# meal_plan_template_instantiator_username
+
+resource "random_password" "meal_plan_template_instantiator_user_database_password" {
+  length           = 64
+  special          = true
+  override_special = "#$*-_=+[]"
+}
+
+resource "google_sql_user" "meal_plan_template_instantiator_user" {
+  name     = local.meal_plan_template_instantiator_username
+  instance = google_sql_database_instance.prod.name
+  password = random_password.meal_plan_template_instantiator_user_database_password.result
+}
+
 # meal_plan_task_creator_username
 
 resource "random_password" "meal_plan_task_creator_user_database_password" {
@@ -122,6 +139,34 @@ resource "google_sql_user" "mobile_notification_scheduler_user" {
   password = random_password.mobile_notification_scheduler_user_database_password.result
 }
 
+# notification_digest_sender_username
+
+resource "random_password" "notification_digest_sender_user_database_password" {
+  length           = 64
+  special          = true
+  override_special = "#$*-_=+[]"
+}
+
+resource "google_sql_user" "notification_digest_sender_user" {
+  name     = local.notification_digest_sender_username
+  instance = google_sql_database_instance.prod.name
+  password = random_password.notification_digest_sender_user_database_password.result
+}
+
+# webhook_delivery_retrier_username
+
+resource "random_password" "webhook_delivery_retrier_user_database_password" {
+  length           = 64
+  special          = true
+  override_special = "#$*-_=+[]"
+}
+
+resource "google_sql_user" "webhook_delivery_retrier_user" {
+  name     = local.webhook_delivery_retrier_username
+  instance = google_sql_database_instance.prod.name
+  password = random_password.webhook_delivery_retrier_user_database_password.result
+}
+
 # queue_test_username
 
 resource "random_password" "queue_test_user_database_password" {
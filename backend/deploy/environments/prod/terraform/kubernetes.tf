@@ -134,8 +134,11 @@ resource "kubernetes_secret_v1" "api_service_config" {
     DATABASE_MEAL_PLAN_FINALIZER_PASSWORD                = random_password.meal_plan_finalizer_user_database_password.result
     DATABASE_MEAL_PLAN_GROCERY_LIST_INITIALIZER_PASSWORD = random_password.meal_plan_grocery_list_initializer_user_database_password.result
     DATABASE_MEAL_PLAN_TASK_CREATOR_PASSWORD             = random_password.meal_plan_task_creator_user_database_password.result
+    DATABASE_MEAL_PLAN_TEMPLATE_INSTANTIATOR_PASSWORD    = random_password.meal_plan_template_instantiator_user_database_password.result
     DATABASE_SEARCH_DATA_INDEX_SCHEDULER_PASSWORD        = random_password.search_data_index_scheduler_user_database_password.result
     DATABASE_MOBILE_NOTIFICATION_SCHEDULER_PASSWORD      = random_password.mobile_notification_scheduler_user_database_password.result
+    DATABASE_NOTIFICATION_DIGEST_SENDER_PASSWORD         = random_password.notification_digest_sender_user_database_password.result
+    DATABASE_WEBHOOK_DELIVERY_RETRIER_PASSWORD           = random_password.webhook_delivery_retrier_user_database_password.result
     DATABASE_QUEUE_TEST_PASSWORD                         = random_password.queue_test_user_database_password.result
   }
 }
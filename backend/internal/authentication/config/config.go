@@ -4,6 +4,7 @@
 	"context"
 	"time"
 
+	ratelimitingcfg "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/authentication/ratelimiting/config"
 	webauthncfg "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/authentication/webauthn/config"
 
 	tokenscfg "github.com/primandproper/platform/authentication/tokens/config"
@@ -27,14 +28,15 @@
 
 	// Config is our configuration.
 	Config struct {
-		_                     struct{}           `json:"-"`
-		SessionStore          webauthncfg.Config `envPrefix:"SESSION_STORE_"    json:"sessionStore"`
-		Passkey               PasskeyConfig      `envPrefix:"PASSKEY_"          json:"passkey"`
-		Tokens                tokenscfg.Config   `envPrefix:"TOKENS_"           json:"tokens"`
-		Debug                 bool               `env:"DEBUG"                   json:"debug,omitempty"`
-		EnableUserSignup      bool               `env:"ENABLE_USER_SIGNUP"      json:"enableUserSignup,omitempty"`
-		MinimumUsernameLength uint8              `env:"MINIMUM_USERNAME_LENGTH" json:"minimumUsernameLength,omitempty"`
-		MinimumPasswordLength uint8              `env:"MINIMUM_PASSWORD_LENGTH" json:"minimumPasswordLength,omitempty"`
+		_                     struct{}               `json:"-"`
+		SessionStore          webauthncfg.Config     `envPrefix:"SESSION_STORE_"    json:"sessionStore"`
+		RateLimiting          ratelimitingcfg.Config `envPrefix:"RATE_LIMITING_"    json:"rateLimiting"`
+		Passkey               PasskeyConfig          `envPrefix:"PASSKEY_"          json:"passkey"`
+		Tokens                tokenscfg.Config       `envPrefix:"TOKENS_"           json:"tokens"`
+		Debug                 bool                   `env:"DEBUG"                   json:"debug,omitempty"`
+		EnableUserSignup      bool                   `env:"ENABLE_USER_SIGNUP"      json:"enableUserSignup,omitempty"`
+		MinimumUsernameLength uint8                  `env:"MINIMUM_USERNAME_LENGTH" json:"minimumUsernameLength,omitempty"`
+		MinimumPasswordLength uint8                  `env:"MINIMUM_PASSWORD_LENGTH" json:"minimumPasswordLength,omitempty"`
 	}
 )
 
@@ -52,5 +54,11 @@ func (cfg *Config) ValidateWithContext(ctx context.Context) error {
 			}
 			return nil
 		})),
+		validation.Field(&cfg.RateLimiting, validation.By(func(value any) error {
+			if c, ok := value.(ratelimitingcfg.Config); ok {
+				return (&c).ValidateWithContext(ctx)
+			}
+			return nil
+		})),
 	)
 }
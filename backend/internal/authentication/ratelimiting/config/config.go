@@ -0,0 +1,117 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"net/netip"
+	"strings"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/authentication/ratelimiting"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	// ProviderMemory is the in-memory rate limit store provider.
+	ProviderMemory = "memory"
+	// ProviderRedis is the Redis rate limit store provider.
+	ProviderRedis = "redis"
+)
+
+type (
+	// RedisConfig configures the Redis rate limit store.
+	RedisConfig struct {
+		Username  string   `env:"USERNAME"  json:"username,omitempty"`
+		Password  string   `env:"PASSWORD"  json:"password,omitempty"`
+		Addresses []string `env:"ADDRESSES" json:"addresses,omitempty"`
+	}
+
+	// Config is the configuration for gRPC rate limiting.
+	Config struct {
+		// Policies replaces the built-in policy for a method, keyed by full gRPC method name.
+		Policies map[string]*ratelimiting.Policy `json:"policies,omitempty"`
+		Redis    RedisConfig                     `envPrefix:"REDIS_" json:"redis"`
+		Provider string                          `env:"PROVIDER"     json:"provider"`
+		// TrustedProxies lists the addresses or CIDR ranges of proxies whose X-Forwarded-For headers are believed.
+		TrustedProxies []string `env:"TRUSTED_PROXIES" json:"trustedProxies,omitempty"`
+		Disabled       bool     `env:"DISABLED"        json:"disabled,omitempty"`
+	}
+)
+
+var _ validation.ValidatableWithContext = (*Config)(nil)
+
+// ValidateWithContext validates a Config struct.
+func (cfg *Config) ValidateWithContext(ctx context.Context) error {
+	return validation.ValidateStructWithContext(ctx, cfg,
+		validation.Field(&cfg.Provider, validation.In("", ProviderMemory, ProviderRedis)),
+		validation.Field(&cfg.Redis, validation.When(strings.EqualFold(strings.TrimSpace(cfg.Provider), ProviderRedis), validation.By(func(value any) error {
+			if c, ok := value.(RedisConfig); ok && len(c.Addresses) == 0 {
+				return fmt.Errorf("at least one redis address is required")
+			}
+			return nil
+		}))),
+		validation.Field(&cfg.Policies, validation.By(func(value any) error {
+			policies, _ := value.(map[string]*ratelimiting.Policy)
+			for method, policy := range policies {
+				if policy == nil || policy.Limit.Burst == 0 || policy.Limit.Interval <= 0 {
+					return fmt.Errorf("policy for %q needs a burst and an interval", method)
+				}
+			}
+			return nil
+		})),
+		validation.Field(&cfg.TrustedProxies, validation.By(func(any) error {
+			_, err := cfg.TrustedProxyPrefixes()
+			return err
+		})),
+	)
+}
+
+// TrustedProxyPrefixes parses TrustedProxies, treating bare addresses as single-address ranges.
+func (cfg *Config) TrustedProxyPrefixes() ([]netip.Prefix, error) {
+	prefixes := make([]netip.Prefix, 0, len(cfg.TrustedProxies))
+	for _, proxy := range cfg.TrustedProxies {
+		proxy = strings.TrimSpace(proxy)
+		if !strings.Contains(proxy, "/") {
+			addr, err := netip.ParseAddr(proxy)
+			if err != nil {
+				return nil, fmt.Errorf("invalid trusted proxy %q: %w", proxy, err)
+			}
+			addr = addr.Unmap()
+			prefixes = append(prefixes, netip.PrefixFrom(addr, addr.BitLen()))
+			continue
+		}
+
+		prefix, err := netip.ParsePrefix(proxy)
+		if err != nil {
+			return nil, fmt.Errorf("invalid trusted proxy %q: %w", proxy, err)
+		}
+		prefixes = append(prefixes, prefix.Masked())
+	}
+
+	return prefixes, nil
+}
+
+// ProvideStore provides a Store based on the configured provider.
+func ProvideStore(cfg *Config) (ratelimiting.Store, error) {
+	provider := strings.TrimSpace(strings.ToLower(cfg.Provider))
+	if provider == "" {
+		provider = ProviderMemory
+	}
+
+	switch provider {
+	case ProviderMemory:
+		return ratelimiting.NewInMemoryStore(), nil
+	case ProviderRedis:
+		if len(cfg.Redis.Addresses) == 0 {
+			return nil, fmt.Errorf("redis addresses required for redis rate limit store provider")
+		}
+		return ratelimiting.NewRedisStore(redis.NewUniversalClient(&redis.UniversalOptions{
+			Addrs:    cfg.Redis.Addresses,
+			Username: cfg.Redis.Username,
+			Password: cfg.Redis.Password,
+		})), nil
+	default:
+		return nil, fmt.Errorf("invalid rate limit store provider: %q", cfg.Provider)
+	}
+}
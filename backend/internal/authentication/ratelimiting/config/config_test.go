@@ -0,0 +1,50 @@
+package config
+
+import (
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestConfig_TrustedProxyPrefixes(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := &Config{TrustedProxies: []string{"10.0.0.0/8", " 192.0.2.10 ", "2001:db8::/32", "10.1.2.3/8"}}
+
+		actual, err := cfg.TrustedProxyPrefixes()
+		require.NoError(t, err)
+		assert.Equal(t, []netip.Prefix{
+			netip.MustParsePrefix("10.0.0.0/8"),
+			netip.MustParsePrefix("192.0.2.10/32"),
+			netip.MustParsePrefix("2001:db8::/32"),
+			netip.MustParsePrefix("10.0.0.0/8"),
+		}, actual)
+	})
+
+	T.Run("with invalid proxy", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := &Config{TrustedProxies: []string{"10.0.0.0/8", "proxy.example.com"}}
+
+		actual, err := cfg.TrustedProxyPrefixes()
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
+
+func TestConfig_ValidateWithContext(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid trusted proxy", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := &Config{TrustedProxies: []string{"10.0.0.0/33"}}
+
+		assert.Error(t, cfg.ValidateWithContext(t.Context()))
+	})
+}
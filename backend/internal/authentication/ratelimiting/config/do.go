@@ -0,0 +1,14 @@
+package config
+
+import (
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/authentication/ratelimiting"
+
+	"github.com/samber/do/v2"
+)
+
+// RegisterRateLimitStore registers the configured rate limit store with the injector.
+func RegisterRateLimitStore(i do.Injector) {
+	do.Provide[ratelimiting.Store](i, func(i do.Injector) (ratelimiting.Store, error) {
+		return ProvideStore(do.MustInvoke[*Config](i))
+	})
+}
@@ -0,0 +1,141 @@
+package ratelimiting
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+const (
+	// memorySweepEvery is how many calls the in-memory store handles between sweeps for stale entries.
+	memorySweepEvery = 1024
+)
+
+var _ Store = (*memoryStore)(nil)
+
+type (
+	bucket struct {
+		updatedAt time.Time
+		interval  time.Duration
+		tokens    float64
+	}
+
+	failureRecord struct {
+		lastFailureAt time.Time
+		lockedUntil   time.Time
+		window        time.Duration
+		count         uint32
+	}
+
+	memoryStore struct {
+		now      func() time.Time
+		buckets  map[string]*bucket
+		failures map[string]*failureRecord
+		calls    uint64
+		hat      sync.Mutex
+	}
+)
+
+// NewInMemoryStore returns a Store that keeps rate limit state in memory.
+// Every server instance enforces its own limits, so it's best suited to single-instance deployments and local development.
+func NewInMemoryStore() Store {
+	return &memoryStore{
+		now:      time.Now,
+		buckets:  map[string]*bucket{},
+		failures: map[string]*failureRecord{},
+	}
+}
+
+// Allow implements our Store interface.
+func (s *memoryStore) Allow(_ context.Context, key string, limit Limit) (*Decision, error) {
+	s.hat.Lock()
+	defer s.hat.Unlock()
+
+	now := s.now()
+	s.maybeSweep(now)
+
+	b, ok := s.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(limit.Burst), updatedAt: now}
+		s.buckets[key] = b
+	}
+
+	tokens, decision := limit.take(b.tokens, b.updatedAt, now)
+	b.tokens, b.updatedAt, b.interval = tokens, now, limit.Interval
+
+	return decision, nil
+}
+
+// LockedOutFor implements our Store interface.
+func (s *memoryStore) LockedOutFor(_ context.Context, key string) (time.Duration, error) {
+	s.hat.Lock()
+	defer s.hat.Unlock()
+
+	record, ok := s.failures[key]
+	if !ok {
+		return 0, nil
+	}
+
+	if remaining := record.lockedUntil.Sub(s.now()); remaining > 0 {
+		return remaining, nil
+	}
+
+	return 0, nil
+}
+
+// RecordFailure implements our Store interface.
+func (s *memoryStore) RecordFailure(_ context.Context, key string, lockout *Lockout) (time.Duration, error) {
+	s.hat.Lock()
+	defer s.hat.Unlock()
+
+	now := s.now()
+	s.maybeSweep(now)
+
+	record, ok := s.failures[key]
+	if !ok || now.Sub(record.lastFailureAt) > record.window {
+		record = &failureRecord{}
+		s.failures[key] = record
+	}
+
+	record.count++
+	record.lastFailureAt = now
+	record.window = lockout.Window
+
+	backoff := lockout.backoffFor(record.count)
+	if backoff > 0 {
+		record.lockedUntil = now.Add(backoff)
+	}
+
+	return backoff, nil
+}
+
+// ResetFailures implements our Store interface.
+func (s *memoryStore) ResetFailures(_ context.Context, key string) error {
+	s.hat.Lock()
+	defer s.hat.Unlock()
+
+	delete(s.failures, key)
+
+	return nil
+}
+
+// maybeSweep periodically drops buckets that have refilled and failures that have been forgotten,
+// since either is indistinguishable from having no entry at all. It must be called with the lock held.
+func (s *memoryStore) maybeSweep(now time.Time) {
+	s.calls++
+	if s.calls%memorySweepEvery != 0 {
+		return
+	}
+
+	for key, b := range s.buckets {
+		if now.Sub(b.updatedAt) >= b.interval {
+			delete(s.buckets, key)
+		}
+	}
+
+	for key, record := range s.failures {
+		if now.Sub(record.lastFailureAt) > record.window && now.After(record.lockedUntil) {
+			delete(s.failures, key)
+		}
+	}
+}
@@ -0,0 +1,187 @@
+package ratelimiting
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func buildMemoryStoreForTest(t *testing.T) (*memoryStore, *time.Time) {
+	t.Helper()
+
+	now := time.Date(2026, time.January, 1, 12, 0, 0, 0, time.UTC)
+	s, ok := NewInMemoryStore().(*memoryStore)
+	require.True(t, ok)
+	s.now = func() time.Time { return now }
+
+	return s, &now
+}
+
+func TestMemoryStore_Allow(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		s, _ := buildMemoryStoreForTest(t)
+		limit := Limit{Burst: 3, Interval: time.Minute}
+
+		for i := range 3 {
+			decision, err := s.Allow(ctx, "key", limit)
+			require.NoError(t, err)
+			assert.True(t, decision.Allowed)
+			assert.Equal(t, uint32(2-i), decision.Remaining)
+		}
+
+		decision, err := s.Allow(ctx, "key", limit)
+		require.NoError(t, err)
+		assert.False(t, decision.Allowed)
+		assert.Equal(t, 20*time.Second, decision.RetryAfter)
+	})
+
+	T.Run("refills over time", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		s, now := buildMemoryStoreForTest(t)
+		limit := Limit{Burst: 2, Interval: time.Minute}
+
+		for range 2 {
+			decision, err := s.Allow(ctx, "key", limit)
+			require.NoError(t, err)
+			require.True(t, decision.Allowed)
+		}
+
+		*now = now.Add(30 * time.Second)
+
+		decision, err := s.Allow(ctx, "key", limit)
+		require.NoError(t, err)
+		assert.True(t, decision.Allowed)
+
+		decision, err = s.Allow(ctx, "key", limit)
+		require.NoError(t, err)
+		assert.False(t, decision.Allowed)
+	})
+
+	T.Run("keys are independent", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		s, _ := buildMemoryStoreForTest(t)
+		limit := Limit{Burst: 1, Interval: time.Minute}
+
+		decision, err := s.Allow(ctx, "first", limit)
+		require.NoError(t, err)
+		assert.True(t, decision.Allowed)
+
+		decision, err = s.Allow(ctx, "second", limit)
+		require.NoError(t, err)
+		assert.True(t, decision.Allowed)
+	})
+}
+
+func TestMemoryStore_RecordFailure(T *testing.T) {
+	T.Parallel()
+
+	lockout := &Lockout{
+		MaxFailures: 3,
+		Window:      10 * time.Minute,
+		BaseBackoff: time.Minute,
+		MaxBackoff:  5 * time.Minute,
+	}
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		s, now := buildMemoryStoreForTest(t)
+
+		for range 2 {
+			backoff, err := s.RecordFailure(ctx, "key", lockout)
+			require.NoError(t, err)
+			assert.Zero(t, backoff)
+		}
+
+		lockedOutFor, err := s.LockedOutFor(ctx, "key")
+		require.NoError(t, err)
+		assert.Zero(t, lockedOutFor)
+
+		backoff, err := s.RecordFailure(ctx, "key", lockout)
+		require.NoError(t, err)
+		assert.Equal(t, time.Minute, backoff)
+
+		lockedOutFor, err = s.LockedOutFor(ctx, "key")
+		require.NoError(t, err)
+		assert.Equal(t, time.Minute, lockedOutFor)
+
+		*now = now.Add(time.Minute)
+
+		lockedOutFor, err = s.LockedOutFor(ctx, "key")
+		require.NoError(t, err)
+		assert.Zero(t, lockedOutFor)
+
+		backoff, err = s.RecordFailure(ctx, "key", lockout)
+		require.NoError(t, err)
+		assert.Equal(t, 2*time.Minute, backoff)
+	})
+
+	T.Run("failures are forgotten after the window", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		s, now := buildMemoryStoreForTest(t)
+
+		for range 2 {
+			_, err := s.RecordFailure(ctx, "key", lockout)
+			require.NoError(t, err)
+		}
+
+		*now = now.Add(lockout.Window + time.Second)
+
+		backoff, err := s.RecordFailure(ctx, "key", lockout)
+		require.NoError(t, err)
+		assert.Zero(t, backoff)
+	})
+
+	T.Run("reset", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		s, _ := buildMemoryStoreForTest(t)
+
+		for range 3 {
+			_, err := s.RecordFailure(ctx, "key", lockout)
+			require.NoError(t, err)
+		}
+
+		require.NoError(t, s.ResetFailures(ctx, "key"))
+
+		lockedOutFor, err := s.LockedOutFor(ctx, "key")
+		require.NoError(t, err)
+		assert.Zero(t, lockedOutFor)
+	})
+}
+
+func TestLockout_backoffFor(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		lockout := &Lockout{
+			MaxFailures: 2,
+			BaseBackoff: time.Second,
+			MaxBackoff:  5 * time.Second,
+		}
+
+		assert.Zero(t, lockout.backoffFor(1))
+		assert.Equal(t, time.Second, lockout.backoffFor(2))
+		assert.Equal(t, 2*time.Second, lockout.backoffFor(3))
+		assert.Equal(t, 4*time.Second, lockout.backoffFor(4))
+		assert.Equal(t, 5*time.Second, lockout.backoffFor(5))
+		assert.Equal(t, 5*time.Second, lockout.backoffFor(500))
+	})
+}
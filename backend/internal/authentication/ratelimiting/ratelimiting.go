@@ -0,0 +1,124 @@
+// Package ratelimiting provides token bucket rate limits and failure lockouts, backed by memory or Redis.
+package ratelimiting
+
+import (
+	"context"
+	"math"
+	"time"
+)
+
+// KeyType determines who a rate limit applies to.
+type KeyType string
+
+const (
+	// KeyByUser limits each requesting user separately.
+	KeyByUser KeyType = "user"
+	// KeyByAccount limits each active account separately, so all of an account's members share a limit.
+	KeyByAccount KeyType = "account"
+	// KeyByOAuth2Client limits each OAuth2 client separately, across all the users it acts for.
+	KeyByOAuth2Client KeyType = "oauth2_client"
+	// KeyByIP limits each client IP address separately. It's the only option for unauthenticated methods.
+	KeyByIP KeyType = "ip"
+)
+
+type (
+	// Limit describes a token bucket.
+	Limit struct {
+		// Burst is how many requests can be made back to back, i.e. the bucket size.
+		Burst uint32 `json:"burst"`
+		// Interval is how long an empty bucket takes to refill.
+		Interval time.Duration `json:"interval"`
+	}
+
+	// Lockout describes how repeated failures lock a key out.
+	Lockout struct {
+		// MaxFailures is how many failures are tolerated before the key is locked out.
+		MaxFailures uint32 `json:"maxFailures"`
+		// Window is how long failures are remembered after the most recent one.
+		Window time.Duration `json:"window"`
+		// BaseBackoff is how long the first lockout lasts. Each failure after that doubles it.
+		BaseBackoff time.Duration `json:"baseBackoff"`
+		// MaxBackoff caps how long a lockout can last.
+		MaxBackoff time.Duration `json:"maxBackoff"`
+	}
+
+	// Policy is the rate limit for a method.
+	Policy struct {
+		// Subject, when set, narrows the key with something from the request, like the username being logged in as.
+		// It receives nil for streaming methods.
+		Subject func(req any) string `json:"-"`
+		// Lockout, when set, locks the key out after repeated failed calls.
+		Lockout *Lockout `json:"lockout,omitempty"`
+		Key     KeyType  `json:"key"`
+		Limit   Limit    `json:"limit"`
+	}
+
+	// Decision is the outcome of asking for a token.
+	Decision struct {
+		// RetryAfter is how long until a token is available, when one wasn't.
+		RetryAfter time.Duration
+		Remaining  uint32
+		Allowed    bool
+	}
+
+	// Store keeps rate limit state.
+	Store interface {
+		// Allow takes a token from the key's bucket, if it has one.
+		Allow(ctx context.Context, key string, limit Limit) (*Decision, error)
+		// LockedOutFor returns how much longer the key is locked out for, or zero if it isn't.
+		LockedOutFor(ctx context.Context, key string) (time.Duration, error)
+		// RecordFailure counts a failure against the key and returns how long it's now locked out for, if at all.
+		RecordFailure(ctx context.Context, key string, lockout *Lockout) (time.Duration, error)
+		// ResetFailures forgets the key's failures.
+		ResetFailures(ctx context.Context, key string) error
+	}
+)
+
+// refillPerNanosecond is how many tokens the bucket regains every nanosecond.
+func (l Limit) refillPerNanosecond() float64 {
+	if l.Interval <= 0 {
+		return math.Inf(1)
+	}
+
+	return float64(l.Burst) / float64(l.Interval)
+}
+
+// take refills a bucket that last had the given number of tokens at the given time, then tries to take one from it.
+// It returns the bucket's new token count alongside the decision.
+func (l Limit) take(tokens float64, updatedAt, now time.Time) (float64, *Decision) {
+	rate := l.refillPerNanosecond()
+	if elapsed := now.Sub(updatedAt); elapsed > 0 {
+		tokens = math.Min(float64(l.Burst), tokens+float64(elapsed)*rate)
+	}
+
+	if tokens >= 1 {
+		tokens--
+		return tokens, &Decision{Allowed: true, Remaining: uint32(tokens)}
+	}
+
+	return tokens, &Decision{
+		Allowed:    false,
+		RetryAfter: time.Duration(math.Ceil((1 - tokens) / rate)),
+	}
+}
+
+// backoffFor returns how long a key with the given number of failures should be locked out for.
+func (l *Lockout) backoffFor(failures uint32) time.Duration {
+	if failures < l.MaxFailures || l.BaseBackoff <= 0 {
+		return 0
+	}
+
+	backoff := l.BaseBackoff
+	for range failures - l.MaxFailures {
+		backoff *= 2
+		if l.MaxBackoff > 0 && backoff >= l.MaxBackoff {
+			return l.MaxBackoff
+		}
+	}
+
+	if l.MaxBackoff > 0 && backoff > l.MaxBackoff {
+		return l.MaxBackoff
+	}
+
+	return backoff
+}
@@ -0,0 +1,145 @@
+package ratelimiting
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	redisKeyPrefix = "ratelimit:"
+)
+
+var (
+	_ Store = (*redisStore)(nil)
+
+	// takeTokenScript refills and takes from a token bucket atomically.
+	// KEYS[1] is the bucket; ARGV is the burst, the refill interval in milliseconds, and the current time in milliseconds.
+	// It returns whether a token was taken, how many are left, and how many milliseconds until one is available.
+	takeTokenScript = redis.NewScript(`
+local burst = tonumber(ARGV[1])
+local interval = tonumber(ARGV[2])
+local now = tonumber(ARGV[3])
+
+local state = redis.call('HMGET', KEYS[1], 'tokens', 'updated_at')
+local tokens = tonumber(state[1])
+local updated_at = tonumber(state[2])
+if tokens == nil or updated_at == nil then
+	tokens = burst
+	updated_at = now
+end
+
+local rate = burst / interval
+if now > updated_at then
+	tokens = math.min(burst, tokens + (now - updated_at) * rate)
+end
+
+local allowed = 0
+local retry_after = 0
+if tokens >= 1 then
+	tokens = tokens - 1
+	allowed = 1
+else
+	retry_after = math.ceil((1 - tokens) / rate)
+end
+
+redis.call('HSET', KEYS[1], 'tokens', tostring(tokens), 'updated_at', now)
+redis.call('PEXPIRE', KEYS[1], interval)
+
+return {allowed, math.floor(tokens), retry_after}
+`)
+
+	// recordFailureScript counts a failure and remembers it for the lockout window.
+	// KEYS[1] is the failure counter; ARGV[1] is the window in milliseconds.
+	recordFailureScript = redis.NewScript(`
+local count = redis.call('INCR', KEYS[1])
+redis.call('PEXPIRE', KEYS[1], ARGV[1])
+return count
+`)
+)
+
+type redisStore struct {
+	client redis.UniversalClient
+	now    func() time.Time
+}
+
+// NewRedisStore returns a Store that keeps rate limit state in Redis, so that limits hold across every server instance.
+func NewRedisStore(client redis.UniversalClient) Store {
+	return &redisStore{
+		client: client,
+		now:    time.Now,
+	}
+}
+
+func bucketKey(key string) string {
+	return redisKeyPrefix + key + ":bucket"
+}
+
+func failuresKey(key string) string {
+	return redisKeyPrefix + key + ":failures"
+}
+
+func lockKey(key string) string {
+	return redisKeyPrefix + key + ":lock"
+}
+
+// Allow implements our Store interface.
+func (s *redisStore) Allow(ctx context.Context, key string, limit Limit) (*Decision, error) {
+	interval := max(limit.Interval.Milliseconds(), 1)
+
+	result, err := takeTokenScript.Run(ctx, s.client, []string{bucketKey(key)}, limit.Burst, interval, s.now().UnixMilli()).Int64Slice()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(result) != 3 {
+		return nil, errors.New("unexpected rate limit script result")
+	}
+
+	return &Decision{
+		Allowed:    result[0] == 1,
+		Remaining:  uint32(result[1]),
+		RetryAfter: time.Duration(result[2]) * time.Millisecond,
+	}, nil
+}
+
+// LockedOutFor implements our Store interface.
+func (s *redisStore) LockedOutFor(ctx context.Context, key string) (time.Duration, error) {
+	ttl, err := s.client.PTTL(ctx, lockKey(key)).Result()
+	if err != nil {
+		return 0, err
+	}
+
+	// PTTL reports a missing key, or one without an expiry, as a negative duration.
+	if ttl < 0 {
+		return 0, nil
+	}
+
+	return ttl, nil
+}
+
+// RecordFailure implements our Store interface.
+func (s *redisStore) RecordFailure(ctx context.Context, key string, lockout *Lockout) (time.Duration, error) {
+	window := max(lockout.Window.Milliseconds(), 1)
+
+	count, err := recordFailureScript.Run(ctx, s.client, []string{failuresKey(key)}, window).Int64()
+	if err != nil {
+		return 0, err
+	}
+
+	backoff := lockout.backoffFor(uint32(count))
+	if backoff > 0 {
+		if err = s.client.Set(ctx, lockKey(key), count, backoff).Err(); err != nil {
+			return 0, err
+		}
+	}
+
+	return backoff, nil
+}
+
+// ResetFailures implements our Store interface.
+func (s *redisStore) ResetFailures(ctx context.Context, key string) error {
+	return s.client.Del(ctx, failuresKey(key)).Err()
+}
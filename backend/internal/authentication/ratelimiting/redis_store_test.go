@@ -0,0 +1,179 @@
+package ratelimiting
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/testcontainers/testcontainers-go"
+	rediscontainers "github.com/testcontainers/testcontainers-go/modules/redis"
+	"github.com/testcontainers/testcontainers-go/wait"
+)
+
+const (
+	redisContainerImage = "redis:7-bullseye"
+)
+
+var runContainerTests = strings.ToLower(os.Getenv("RUN_CONTAINER_TESTS")) != "false" // on by default
+
+// buildRedisStoreForTest returns a store backed by a fresh Redis container, with a clock the test controls.
+func buildRedisStoreForTest(t *testing.T) (*redisStore, *time.Time) {
+	t.Helper()
+
+	ctx := t.Context()
+	container, err := rediscontainers.Run(
+		ctx,
+		redisContainerImage,
+		testcontainers.WithWaitStrategyAndDeadline(30*time.Second, wait.ForListeningPort("6379/tcp")),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() {
+		assert.NoError(t, container.Terminate(context.WithoutCancel(ctx)))
+	})
+
+	connectionString, err := container.ConnectionString(ctx)
+	require.NoError(t, err)
+
+	opts, err := redis.ParseURL(connectionString)
+	require.NoError(t, err)
+
+	client := redis.NewClient(opts)
+	t.Cleanup(func() {
+		assert.NoError(t, client.Close())
+	})
+
+	s, ok := NewRedisStore(client).(*redisStore)
+	require.True(t, ok)
+
+	now := time.Now().Truncate(time.Millisecond)
+	s.now = func() time.Time { return now }
+
+	return s, &now
+}
+
+func TestRedisStore_Allow(T *testing.T) {
+	if !runContainerTests {
+		T.SkipNow()
+	}
+
+	s, now := buildRedisStoreForTest(T)
+
+	T.Run("standard", func(t *testing.T) {
+		ctx := t.Context()
+		limit := Limit{Burst: 3, Interval: time.Minute}
+
+		for i := range 3 {
+			decision, err := s.Allow(ctx, t.Name(), limit)
+			require.NoError(t, err)
+			assert.True(t, decision.Allowed)
+			assert.Equal(t, uint32(2-i), decision.Remaining)
+		}
+
+		decision, err := s.Allow(ctx, t.Name(), limit)
+		require.NoError(t, err)
+		assert.False(t, decision.Allowed)
+		assert.Equal(t, 20*time.Second, decision.RetryAfter)
+	})
+
+	T.Run("refills over time", func(t *testing.T) {
+		ctx := t.Context()
+		limit := Limit{Burst: 2, Interval: time.Minute}
+
+		for range 2 {
+			decision, err := s.Allow(ctx, t.Name(), limit)
+			require.NoError(t, err)
+			require.True(t, decision.Allowed)
+		}
+
+		*now = now.Add(30 * time.Second)
+
+		decision, err := s.Allow(ctx, t.Name(), limit)
+		require.NoError(t, err)
+		assert.True(t, decision.Allowed)
+
+		decision, err = s.Allow(ctx, t.Name(), limit)
+		require.NoError(t, err)
+		assert.False(t, decision.Allowed)
+	})
+
+	T.Run("keys are independent", func(t *testing.T) {
+		ctx := t.Context()
+		limit := Limit{Burst: 1, Interval: time.Minute}
+
+		decision, err := s.Allow(ctx, t.Name()+"first", limit)
+		require.NoError(t, err)
+		assert.True(t, decision.Allowed)
+
+		decision, err = s.Allow(ctx, t.Name()+"second", limit)
+		require.NoError(t, err)
+		assert.True(t, decision.Allowed)
+	})
+}
+
+func TestRedisStore_RecordFailure(T *testing.T) {
+	if !runContainerTests {
+		T.SkipNow()
+	}
+
+	s, _ := buildRedisStoreForTest(T)
+
+	lockout := &Lockout{
+		MaxFailures: 3,
+		Window:      10 * time.Minute,
+		BaseBackoff: time.Minute,
+		MaxBackoff:  5 * time.Minute,
+	}
+
+	T.Run("standard", func(t *testing.T) {
+		ctx := t.Context()
+
+		for range 2 {
+			backoff, err := s.RecordFailure(ctx, t.Name(), lockout)
+			require.NoError(t, err)
+			assert.Zero(t, backoff)
+		}
+
+		lockedOutFor, err := s.LockedOutFor(ctx, t.Name())
+		require.NoError(t, err)
+		assert.Zero(t, lockedOutFor)
+
+		backoff, err := s.RecordFailure(ctx, t.Name(), lockout)
+		require.NoError(t, err)
+		assert.Equal(t, time.Minute, backoff)
+
+		// the lock expires in Redis's time, so only check that it's in place for about as long as the backoff.
+		lockedOutFor, err = s.LockedOutFor(ctx, t.Name())
+		require.NoError(t, err)
+		assert.Greater(t, lockedOutFor, 50*time.Second)
+		assert.LessOrEqual(t, lockedOutFor, time.Minute)
+
+		backoff, err = s.RecordFailure(ctx, t.Name(), lockout)
+		require.NoError(t, err)
+		assert.Equal(t, 2*time.Minute, backoff)
+	})
+
+	T.Run("reset", func(t *testing.T) {
+		ctx := t.Context()
+
+		for range 2 {
+			_, err := s.RecordFailure(ctx, t.Name(), lockout)
+			require.NoError(t, err)
+		}
+
+		require.NoError(t, s.ResetFailures(ctx, t.Name()))
+
+		// the count starts over, so the next failure doesn't lock the caller out.
+		backoff, err := s.RecordFailure(ctx, t.Name(), lockout)
+		require.NoError(t, err)
+		assert.Zero(t, backoff)
+
+		lockedOutFor, err := s.LockedOutFor(ctx, t.Name())
+		require.NoError(t, err)
+		assert.Zero(t, lockedOutFor)
+	})
+}
@@ -48,6 +48,7 @@ type ContextData struct {
 	Requester          RequesterInfo                                          `json:"-"`
 	ActiveAccountID    string                                                 `json:"-"`
 	SessionID          string                                                 `json:"-"`
+	OAuth2ClientID     string                                                 `json:"-"`
 }
 
 // RequesterInfo contains data relevant to the user making a request.
@@ -82,6 +83,11 @@ func (x *ContextData) GetSessionID() string {
 	return x.SessionID
 }
 
+// GetOAuth2ClientID is a simple getter.
+func (x *ContextData) GetOAuth2ClientID() string {
+	return x.OAuth2ClientID
+}
+
 // AccountRolePermissionsChecker returns the relevant AccountRolePermissionsChecker.
 func (x *ContextData) AccountRolePermissionsChecker() authorization.AccountRolePermissionsChecker {
 	if checker, ok := x.AccountPermissions[x.ActiveAccountID]; ok {
@@ -95,6 +101,14 @@ func (x *ContextData) ServiceRolePermissionChecker() authorization.ServiceRolePe
 	return x.Requester.ServicePermissions
 }
 
+// RestrictToOAuth2Scopes limits every permission checker to what the given OAuth2 scopes allow.
+func (x *ContextData) RestrictToOAuth2Scopes(scopes []string) {
+	x.Requester.ServicePermissions = authorization.NewOAuth2ScopedServiceRolePermissionChecker(x.Requester.ServicePermissions, scopes)
+	for accountID, checker := range x.AccountPermissions {
+		x.AccountPermissions[accountID] = authorization.NewOAuth2ScopedAccountRolePermissionChecker(checker, scopes)
+	}
+}
+
 // AttachToLogger provides a consistent way to attach a ContextData object to a logger.
 func (x *ContextData) AttachToLogger(logger logging.Logger) logging.Logger {
 	if x != nil {
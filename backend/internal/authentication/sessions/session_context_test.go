@@ -3,8 +3,12 @@
 import (
 	"context"
 	"net/http"
+	"slices"
 	"testing"
 
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/authorization"
+
+	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
 
@@ -36,3 +40,27 @@ func TestFetchContextFromRequest(T *testing.T) {
 		require.Nil(t, actual)
 	})
 }
+
+func TestContextData_RestrictToOAuth2Scopes(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		x := &ContextData{
+			ActiveAccountID: "account",
+			Requester: RequesterInfo{
+				ServicePermissions: authorization.NewServiceRolePermissionChecker(nil, authorization.ServiceAdminPermissions),
+			},
+			AccountPermissions: map[string]authorization.AccountRolePermissionsChecker{
+				"account": authorization.NewAccountRolePermissionChecker(slices.Concat(authorization.AccountAdminPermissions, authorization.AccountMemberPermissions)),
+			},
+		}
+
+		x.RestrictToOAuth2Scopes([]string{authorization.ReadOAuth2Scope})
+
+		assert.True(t, x.AccountRolePermissionsChecker().HasPermission(authorization.ReadWebhooksPermission))
+		assert.False(t, x.AccountRolePermissionsChecker().HasPermission(authorization.CreateWebhooksPermission))
+		assert.False(t, x.ServiceRolePermissionChecker().CanImpersonateUsers())
+	})
+}
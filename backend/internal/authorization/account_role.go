@@ -30,6 +30,12 @@
 	AccountMemberRoleID = "role_account_member"
 )
 
+// IsAccountRoleAssignablePermission returns whether a permission may be granted by an account-scoped role.
+// Custom account roles can only be composed from permissions the built-in account roles already grant.
+func IsAccountRoleAssignablePermission(p Permission) bool {
+	return slices.Contains(AccountAdminPermissions, p) || slices.Contains(AccountMemberPermissions, p)
+}
+
 type accountRoleCollection struct {
 	Permissions map[Permission]bool
 	RoleNames   []string
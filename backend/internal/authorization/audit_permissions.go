@@ -3,11 +3,14 @@
 const (
 	// ReadAuditLogEntriesPermission is a service permission.
 	ReadAuditLogEntriesPermission Permission = "read.audit_log_entries"
+	// VerifyAuditLogChainPermission is a service admin permission.
+	VerifyAuditLogChainPermission Permission = "verify.audit_log_chain"
 )
 
 var (
 	// AuditPermissions contains all audit-related permissions.
 	AuditPermissions = []Permission{
 		ReadAuditLogEntriesPermission,
+		VerifyAuditLogChainPermission,
 	}
 )
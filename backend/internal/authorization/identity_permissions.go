@@ -13,6 +13,14 @@
 	RemoveMemberAccountPermission Permission = "remove_member.account"
 	// TransferAccountPermission is an account admin permission.
 	TransferAccountPermission Permission = "transfer.account"
+	// CreateAccountRolesPermission is an account admin permission.
+	CreateAccountRolesPermission Permission = "create.account_roles"
+	// ReadAccountRolesPermission is an account member permission.
+	ReadAccountRolesPermission Permission = "read.account_roles"
+	// UpdateAccountRolesPermission is an account admin permission.
+	UpdateAccountRolesPermission Permission = "update.account_roles"
+	// ArchiveAccountRolesPermission is an account admin permission.
+	ArchiveAccountRolesPermission Permission = "archive.account_roles"
 )
 
 var (
@@ -24,5 +32,9 @@
 		ModifyMemberPermissionsForAccountPermission,
 		RemoveMemberAccountPermission,
 		TransferAccountPermission,
+		CreateAccountRolesPermission,
+		ReadAccountRolesPermission,
+		UpdateAccountRolesPermission,
+		ArchiveAccountRolesPermission,
 	}
 )
@@ -149,6 +149,15 @@
 	// ArchiveValidIngredientMeasurementUnitsPermission is a permission.
 	ArchiveValidIngredientMeasurementUnitsPermission Permission = "archive.valid_ingredient_measurement_units"
 
+	// CreateValidIngredientNutritionFactsPermission is a permission.
+	CreateValidIngredientNutritionFactsPermission Permission = "create.valid_ingredient_nutrition_facts"
+	// ReadValidIngredientNutritionFactsPermission is a permission.
+	ReadValidIngredientNutritionFactsPermission Permission = "read.valid_ingredient_nutrition_facts"
+	// UpdateValidIngredientNutritionFactsPermission is a permission.
+	UpdateValidIngredientNutritionFactsPermission Permission = "update.valid_ingredient_nutrition_facts"
+	// ArchiveValidIngredientNutritionFactsPermission is a permission.
+	ArchiveValidIngredientNutritionFactsPermission Permission = "archive.valid_ingredient_nutrition_facts"
+
 	// CreateMealsPermission is a permission.
 	CreateMealsPermission Permission = "create.meals"
 	// ReadMealsPermission is a permission.
@@ -351,6 +360,15 @@
 	// ArchiveAccountInstrumentOwnershipsPermission is a permission.
 	ArchiveAccountInstrumentOwnershipsPermission Permission = "archive.account_instrument_ownerships"
 
+	// CreatePantryItemsPermission is a permission.
+	CreatePantryItemsPermission Permission = "create.pantry_items"
+	// ReadPantryItemsPermission is a permission.
+	ReadPantryItemsPermission Permission = "read.pantry_items"
+	// UpdatePantryItemsPermission is a permission.
+	UpdatePantryItemsPermission Permission = "update.pantry_items"
+	// ArchivePantryItemsPermission is a permission.
+	ArchivePantryItemsPermission Permission = "archive.pantry_items"
+
 	// CreateRecipeRatingsPermission is a permission.
 	CreateRecipeRatingsPermission Permission = "create.recipe_ratings"
 	// ReadRecipeRatingsPermission is a permission.
@@ -431,6 +449,10 @@
 		SearchValidIngredientMeasurementUnitsPermission,
 		UpdateValidIngredientMeasurementUnitsPermission,
 		ArchiveValidIngredientMeasurementUnitsPermission,
+		CreateValidIngredientNutritionFactsPermission,
+		ReadValidIngredientNutritionFactsPermission,
+		UpdateValidIngredientNutritionFactsPermission,
+		ArchiveValidIngredientNutritionFactsPermission,
 		CreateMealsPermission,
 		ReadMealsPermission,
 		UpdateMealsPermission,
@@ -512,6 +534,10 @@
 		ReadAccountInstrumentOwnershipsPermission,
 		UpdateAccountInstrumentOwnershipsPermission,
 		ArchiveAccountInstrumentOwnershipsPermission,
+		CreatePantryItemsPermission,
+		ReadPantryItemsPermission,
+		UpdatePantryItemsPermission,
+		ArchivePantryItemsPermission,
 		CreateRecipeRatingsPermission,
 		ReadRecipeRatingsPermission,
 		UpdateRecipeRatingsPermission,
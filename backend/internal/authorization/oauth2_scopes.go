@@ -0,0 +1,150 @@
+package authorization
+
+import (
+	"slices"
+	"strings"
+)
+
+const (
+	// ReadOAuth2Scope grants OAuth2 tokens the read and search permissions of the user they act for.
+	ReadOAuth2Scope = "read"
+	// WriteOAuth2Scope grants OAuth2 tokens the create, update, archive, and other mutating account-level permissions of the user they act for.
+	WriteOAuth2Scope = "write"
+	// AdminOAuth2Scope grants OAuth2 tokens the service-level permissions of the user they act for.
+	AdminOAuth2Scope = "admin"
+	// OpenIDOAuth2Scope asks for an OpenID Connect ID token identifying the user a token acts for. It grants no permissions.
+	OpenIDOAuth2Scope = "openid"
+	// ProfileOAuth2Scope asks for the user's name, username, and birthdate claims. It grants no permissions.
+	ProfileOAuth2Scope = "profile"
+	// EmailOAuth2Scope asks for the user's email address claims. It grants no permissions.
+	EmailOAuth2Scope = "email"
+)
+
+var (
+	// OAuth2Scopes is every scope an OAuth2 client may be allowed to request.
+	OAuth2Scopes = []string{
+		ReadOAuth2Scope,
+		WriteOAuth2Scope,
+		AdminOAuth2Scope,
+		OpenIDOAuth2Scope,
+		ProfileOAuth2Scope,
+		EmailOAuth2Scope,
+	}
+
+	// OpenIDConnectScopes are the scopes that govern ID token and userinfo claims rather than permissions.
+	OpenIDConnectScopes = []string{
+		OpenIDOAuth2Scope,
+		ProfileOAuth2Scope,
+		EmailOAuth2Scope,
+	}
+
+	accountLevelPermissions = buildPermissionSet(AccountAdminPermissions, AccountMemberPermissions)
+	serviceLevelPermissions = buildPermissionSet(ServiceAdminPermissions, ServiceDataAdminPermissions)
+)
+
+func buildPermissionSet(permissionSets ...[]Permission) map[Permission]bool {
+	out := map[Permission]bool{}
+	for _, perms := range permissionSets {
+		for _, p := range perms {
+			out[p] = true
+		}
+	}
+
+	return out
+}
+
+// IsValidOAuth2Scope returns whether a scope is one we know about.
+func IsValidOAuth2Scope(scope string) bool {
+	return slices.Contains(OAuth2Scopes, scope)
+}
+
+// OAuth2ScopeForPermission returns the scope an OAuth2 token must carry to exercise a given permission.
+// Permissions only service roles hold require the admin scope; otherwise, reads and searches require
+// the read scope and everything else requires the write scope.
+func OAuth2ScopeForPermission(p Permission) string {
+	if serviceLevelPermissions[p] && !accountLevelPermissions[p] {
+		return AdminOAuth2Scope
+	}
+
+	verb, _, _ := strings.Cut(string(p), ".")
+	switch verb {
+	case "read", "search":
+		return ReadOAuth2Scope
+	default:
+		return WriteOAuth2Scope
+	}
+}
+
+// PermissionsForOAuth2Scopes returns every known permission the given scopes allow.
+func PermissionsForOAuth2Scopes(scopes []string) []Permission {
+	out := []Permission{}
+	for p := range buildPermissionSet(AccountAdminPermissions, AccountMemberPermissions, ServiceAdminPermissions, ServiceDataAdminPermissions) {
+		if slices.Contains(scopes, OAuth2ScopeForPermission(p)) {
+			out = append(out, p)
+		}
+	}
+
+	slices.Sort(out)
+
+	return out
+}
+
+type (
+	scopedServiceRoleChecker struct {
+		inner  ServiceRolePermissionChecker
+		scopes []string
+	}
+
+	scopedAccountRoleChecker struct {
+		inner  AccountRolePermissionsChecker
+		scopes []string
+	}
+)
+
+// NewOAuth2ScopedServiceRolePermissionChecker restricts a service role checker to the permissions the given OAuth2 scopes allow.
+func NewOAuth2ScopedServiceRolePermissionChecker(inner ServiceRolePermissionChecker, scopes []string) ServiceRolePermissionChecker {
+	return &scopedServiceRoleChecker{inner: inner, scopes: scopes}
+}
+
+// NewOAuth2ScopedAccountRolePermissionChecker restricts an account role checker to the permissions the given OAuth2 scopes allow.
+func NewOAuth2ScopedAccountRolePermissionChecker(inner AccountRolePermissionsChecker, scopes []string) AccountRolePermissionsChecker {
+	return &scopedAccountRoleChecker{inner: inner, scopes: scopes}
+}
+
+// HasPermission returns whether the underlying role has a permission and the scopes allow it.
+func (r *scopedServiceRoleChecker) HasPermission(p Permission) bool {
+	return r.inner != nil && r.inner.HasPermission(p) && slices.Contains(r.scopes, OAuth2ScopeForPermission(p))
+}
+
+func (r *scopedServiceRoleChecker) AsAccountRolePermissionChecker() AccountRolePermissionsChecker {
+	if r.inner == nil {
+		return NewAccountRolePermissionChecker(nil)
+	}
+
+	return NewOAuth2ScopedAccountRolePermissionChecker(r.inner.AsAccountRolePermissionChecker(), r.scopes)
+}
+
+// IsServiceAdmin returns whether the underlying role is an admin and the scopes include admin.
+func (r *scopedServiceRoleChecker) IsServiceAdmin() bool {
+	return r.inner != nil && r.inner.IsServiceAdmin() && slices.Contains(r.scopes, AdminOAuth2Scope)
+}
+
+// CanUpdateUserAccountStatuses returns whether a user can update user account statuses.
+func (r *scopedServiceRoleChecker) CanUpdateUserAccountStatuses() bool {
+	return r.HasPermission(UpdateUserStatusPermission)
+}
+
+// CanImpersonateUsers returns whether a user can impersonate others.
+func (r *scopedServiceRoleChecker) CanImpersonateUsers() bool {
+	return r.HasPermission(ImpersonateUserPermission)
+}
+
+// CanManageUserSessions returns whether a user can manage other users' sessions.
+func (r *scopedServiceRoleChecker) CanManageUserSessions() bool {
+	return r.HasPermission(ManageUserSessionsPermission)
+}
+
+// HasPermission returns whether the underlying role has a permission and the scopes allow it.
+func (r *scopedAccountRoleChecker) HasPermission(p Permission) bool {
+	return r.inner != nil && r.inner.HasPermission(p) && slices.Contains(r.scopes, OAuth2ScopeForPermission(p))
+}
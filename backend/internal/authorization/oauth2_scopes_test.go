@@ -0,0 +1,103 @@
+package authorization
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOAuth2ScopeForPermission(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, ReadOAuth2Scope, OAuth2ScopeForPermission(ReadWebhooksPermission))
+		assert.Equal(t, ReadOAuth2Scope, OAuth2ScopeForPermission(SearchValidIngredientsPermission))
+		assert.Equal(t, WriteOAuth2Scope, OAuth2ScopeForPermission(CreateWebhooksPermission))
+		assert.Equal(t, WriteOAuth2Scope, OAuth2ScopeForPermission(TransferAccountPermission))
+		assert.Equal(t, AdminOAuth2Scope, OAuth2ScopeForPermission(ReadUserPermission))
+		assert.Equal(t, AdminOAuth2Scope, OAuth2ScopeForPermission(CreateValidIngredientsPermission))
+		assert.Equal(t, AdminOAuth2Scope, OAuth2ScopeForPermission(ImpersonateUserPermission))
+	})
+}
+
+func TestPermissionsForOAuth2Scopes(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		actual := PermissionsForOAuth2Scopes([]string{ReadOAuth2Scope})
+
+		assert.True(t, slices.Contains(actual, ReadWebhooksPermission))
+		assert.False(t, slices.Contains(actual, CreateWebhooksPermission))
+		assert.False(t, slices.Contains(actual, ReadUserPermission))
+	})
+
+	T.Run("without scopes", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Empty(t, PermissionsForOAuth2Scopes(nil))
+	})
+
+	T.Run("with only OpenID Connect scopes", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Empty(t, PermissionsForOAuth2Scopes(OpenIDConnectScopes))
+	})
+}
+
+func TestNewOAuth2ScopedServiceRolePermissionChecker(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		inner := NewServiceRolePermissionChecker([]string{serviceAdminRoleName}, ServiceAdminPermissions)
+		checker := NewOAuth2ScopedServiceRolePermissionChecker(inner, []string{ReadOAuth2Scope})
+
+		assert.False(t, checker.IsServiceAdmin())
+		assert.False(t, checker.CanImpersonateUsers())
+		assert.False(t, checker.HasPermission(ReadUserPermission))
+
+		checker = NewOAuth2ScopedServiceRolePermissionChecker(inner, []string{AdminOAuth2Scope})
+
+		assert.True(t, checker.IsServiceAdmin())
+		assert.True(t, checker.CanImpersonateUsers())
+		assert.True(t, checker.CanManageUserSessions())
+		assert.True(t, checker.CanUpdateUserAccountStatuses())
+		assert.True(t, checker.HasPermission(ReadUserPermission))
+		assert.True(t, checker.AsAccountRolePermissionChecker().HasPermission(ReadUserPermission))
+	})
+
+	T.Run("with nil inner checker", func(t *testing.T) {
+		t.Parallel()
+
+		checker := NewOAuth2ScopedServiceRolePermissionChecker(nil, OAuth2Scopes)
+
+		assert.False(t, checker.IsServiceAdmin())
+		assert.False(t, checker.HasPermission(ReadUserPermission))
+		assert.False(t, checker.AsAccountRolePermissionChecker().HasPermission(ReadUserPermission))
+	})
+}
+
+func TestNewOAuth2ScopedAccountRolePermissionChecker(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		inner := NewAccountRolePermissionChecker(slices.Concat(AccountAdminPermissions, AccountMemberPermissions))
+		checker := NewOAuth2ScopedAccountRolePermissionChecker(inner, []string{ReadOAuth2Scope})
+
+		assert.True(t, checker.HasPermission(ReadWebhooksPermission))
+		assert.False(t, checker.HasPermission(CreateWebhooksPermission))
+
+		checker = NewOAuth2ScopedAccountRolePermissionChecker(inner, []string{ReadOAuth2Scope, WriteOAuth2Scope})
+
+		assert.True(t, checker.HasPermission(CreateWebhooksPermission))
+		assert.False(t, checker.HasPermission(CreateValidIngredientsPermission))
+	})
+}
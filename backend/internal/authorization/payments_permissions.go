@@ -16,6 +16,11 @@
 
 	ReadPurchasesPermission      Permission = "read.purchases"
 	ReadPaymentHistoryPermission Permission = "read.payment_history"
+
+	ReadEntitlementsPermission Permission = "read.entitlements"
+
+	ReadPaymentProviderEventsPermission   Permission = "read.payment_provider_events"
+	ReplayPaymentProviderEventsPermission Permission = "replay.payment_provider_events"
 )
 
 var (
@@ -32,5 +37,8 @@
 		CancelSubscriptionPermission,
 		ReadPurchasesPermission,
 		ReadPaymentHistoryPermission,
+		ReadEntitlementsPermission,
+		ReadPaymentProviderEventsPermission,
+		ReplayPaymentProviderEventsPermission,
 	}
 )
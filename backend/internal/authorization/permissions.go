@@ -38,6 +38,10 @@
 		ReadSubscriptionsPermission,
 		UpdateSubscriptionsPermission,
 		ArchiveSubscriptionsPermission,
+		ReadEntitlementsPermission,
+		ReadPaymentProviderEventsPermission,
+		ReplayPaymentProviderEventsPermission,
+		VerifyAuditLogChainPermission,
 	}
 
 	// ServiceDataAdminPermissions is every service data admin permission.
@@ -81,6 +85,9 @@
 		CreateValidIngredientMeasurementUnitsPermission,
 		UpdateValidIngredientMeasurementUnitsPermission,
 		ArchiveValidIngredientMeasurementUnitsPermission,
+		CreateValidIngredientNutritionFactsPermission,
+		UpdateValidIngredientNutritionFactsPermission,
+		ArchiveValidIngredientNutritionFactsPermission,
 		CreateValidIngredientStatesPermission,
 		UpdateValidIngredientStatesPermission,
 		ArchiveValidIngredientStatesPermission,
@@ -94,6 +101,9 @@
 		InviteUserToAccountPermission,
 		ModifyMemberPermissionsForAccountPermission,
 		RemoveMemberAccountPermission,
+		CreateAccountRolesPermission,
+		UpdateAccountRolesPermission,
+		ArchiveAccountRolesPermission,
 		CreateWebhooksPermission,
 		UpdateWebhooksPermission,
 		ArchiveWebhooksPermission,
@@ -112,12 +122,16 @@
 		CreateAccountInstrumentOwnershipsPermission,
 		UpdateAccountInstrumentOwnershipsPermission,
 		ArchiveAccountInstrumentOwnershipsPermission,
+		CreatePantryItemsPermission,
+		UpdatePantryItemsPermission,
+		ArchivePantryItemsPermission,
 		CreateWebhookTriggerConfigsPermission,
 		ArchiveWebhookTriggerConfigsPermission,
 		CreateWebhookTriggerEventsPermission,
 		ReadWebhookTriggerEventsPermission,
 		UpdateWebhookTriggerEventsPermission,
 		ArchiveWebhookTriggerEventsPermission,
+		RedeliverWebhookDeliveriesPermission,
 		CreateMealListsPermission,
 		ReadMealListsPermission,
 		UpdateMealListsPermission,
@@ -136,7 +150,9 @@
 	// AccountMemberPermissions is every account member permission.
 	AccountMemberPermissions = []Permission{
 		ReportAnalyticsEventsPermission,
+		ReadAccountRolesPermission,
 		ReadWebhooksPermission,
+		ReadWebhookDeliveriesPermission,
 		ReadIssueReportsPermission,
 		ReadAuditLogEntriesPermission,
 		ReadOAuth2ClientsPermission,
@@ -212,6 +228,7 @@
 		SearchValidPreparationVesselsPermission,
 		ReadValidIngredientMeasurementUnitsPermission,
 		SearchValidIngredientMeasurementUnitsPermission,
+		ReadValidIngredientNutritionFactsPermission,
 		ReadMealPlansPermission,
 		SearchMealPlansPermission,
 		ReadMealPlanEventsPermission,
@@ -241,6 +258,7 @@
 		UpdateUserIngredientPreferencesPermission,
 		ArchiveUserIngredientPreferencesPermission,
 		ReadAccountInstrumentOwnershipsPermission,
+		ReadPantryItemsPermission,
 		CreateRecipeRatingsPermission,
 		ReadRecipeRatingsPermission,
 		CreateCommentsPermission,
@@ -265,5 +283,6 @@
 		ReadPurchasesPermission,
 		ReadPaymentHistoryPermission,
 		ReadSubscriptionsPermission,
+		ReadEntitlementsPermission,
 	}
 )
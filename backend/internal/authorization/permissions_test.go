@@ -26,6 +26,10 @@ func TestPermissions(T *testing.T) {
 		assert.True(t, permissionChecker.HasPermission(ModifyMemberPermissionsForAccountPermission))
 		assert.True(t, permissionChecker.HasPermission(RemoveMemberAccountPermission))
 		assert.True(t, permissionChecker.HasPermission(TransferAccountPermission))
+		assert.True(t, permissionChecker.HasPermission(CreateAccountRolesPermission))
+		assert.True(t, permissionChecker.HasPermission(ReadAccountRolesPermission))
+		assert.True(t, permissionChecker.HasPermission(UpdateAccountRolesPermission))
+		assert.True(t, permissionChecker.HasPermission(ArchiveAccountRolesPermission))
 		assert.True(t, permissionChecker.HasPermission(CreateWebhooksPermission))
 		assert.True(t, permissionChecker.HasPermission(ReadWebhooksPermission))
 		assert.True(t, permissionChecker.HasPermission(UpdateWebhooksPermission))
@@ -73,6 +77,10 @@ func TestPermissions(T *testing.T) {
 		assert.True(t, permissionChecker.HasPermission(SearchValidIngredientMeasurementUnitsPermission))
 		assert.False(t, permissionChecker.HasPermission(UpdateValidIngredientMeasurementUnitsPermission))
 		assert.False(t, permissionChecker.HasPermission(ArchiveValidIngredientMeasurementUnitsPermission))
+		assert.False(t, permissionChecker.HasPermission(CreateValidIngredientNutritionFactsPermission))
+		assert.True(t, permissionChecker.HasPermission(ReadValidIngredientNutritionFactsPermission))
+		assert.False(t, permissionChecker.HasPermission(UpdateValidIngredientNutritionFactsPermission))
+		assert.False(t, permissionChecker.HasPermission(ArchiveValidIngredientNutritionFactsPermission))
 		assert.True(t, permissionChecker.HasPermission(CreateMealsPermission))
 		assert.True(t, permissionChecker.HasPermission(ReadMealsPermission))
 		assert.True(t, permissionChecker.HasPermission(UpdateMealsPermission))
@@ -137,6 +145,9 @@ func TestPermissions(T *testing.T) {
 		assert.True(t, permissionChecker.HasPermission(ReadMealPlanTasksPermission))
 		assert.False(t, permissionChecker.HasPermission(CreateMealPlanTasksPermission))
 		assert.True(t, permissionChecker.HasPermission(UpdateMealPlanTasksPermission))
+		assert.True(t, permissionChecker.HasPermission(ReadEntitlementsPermission))
+		assert.False(t, permissionChecker.HasPermission(ReadPaymentProviderEventsPermission))
+		assert.False(t, permissionChecker.HasPermission(ReplayPaymentProviderEventsPermission))
 	})
 
 	T.Run("account member", func(t *testing.T) {
@@ -151,6 +162,10 @@ func TestPermissions(T *testing.T) {
 		assert.False(t, permissionChecker.HasPermission(ArchiveAccountPermission))
 		assert.False(t, permissionChecker.HasPermission(InviteUserToAccountPermission))
 		assert.False(t, permissionChecker.HasPermission(ModifyMemberPermissionsForAccountPermission))
+		assert.False(t, permissionChecker.HasPermission(CreateAccountRolesPermission))
+		assert.True(t, permissionChecker.HasPermission(ReadAccountRolesPermission))
+		assert.False(t, permissionChecker.HasPermission(UpdateAccountRolesPermission))
+		assert.False(t, permissionChecker.HasPermission(ArchiveAccountRolesPermission))
 		assert.False(t, permissionChecker.HasPermission(RemoveMemberAccountPermission))
 		assert.False(t, permissionChecker.HasPermission(TransferAccountPermission))
 		assert.False(t, permissionChecker.HasPermission(CreateWebhooksPermission))
@@ -200,6 +215,10 @@ func TestPermissions(T *testing.T) {
 		assert.True(t, permissionChecker.HasPermission(SearchValidIngredientMeasurementUnitsPermission))
 		assert.False(t, permissionChecker.HasPermission(UpdateValidIngredientMeasurementUnitsPermission))
 		assert.False(t, permissionChecker.HasPermission(ArchiveValidIngredientMeasurementUnitsPermission))
+		assert.False(t, permissionChecker.HasPermission(CreateValidIngredientNutritionFactsPermission))
+		assert.True(t, permissionChecker.HasPermission(ReadValidIngredientNutritionFactsPermission))
+		assert.False(t, permissionChecker.HasPermission(UpdateValidIngredientNutritionFactsPermission))
+		assert.False(t, permissionChecker.HasPermission(ArchiveValidIngredientNutritionFactsPermission))
 		assert.True(t, permissionChecker.HasPermission(CreateMealsPermission))
 		assert.True(t, permissionChecker.HasPermission(ReadMealsPermission))
 		assert.True(t, permissionChecker.HasPermission(UpdateMealsPermission))
@@ -264,5 +283,19 @@ func TestPermissions(T *testing.T) {
 		assert.True(t, permissionChecker.HasPermission(ReadMealPlanTasksPermission))
 		assert.False(t, permissionChecker.HasPermission(CreateMealPlanTasksPermission))
 		assert.True(t, permissionChecker.HasPermission(UpdateMealPlanTasksPermission))
+		assert.True(t, permissionChecker.HasPermission(ReadEntitlementsPermission))
+		assert.False(t, permissionChecker.HasPermission(ReadPaymentProviderEventsPermission))
+		assert.False(t, permissionChecker.HasPermission(ReplayPaymentProviderEventsPermission))
+	})
+	T.Run("account role assignable permissions", func(t *testing.T) {
+		t.Parallel()
+
+		assert.True(t, IsAccountRoleAssignablePermission(UpdateMealPlanTasksPermission))
+		assert.True(t, IsAccountRoleAssignablePermission(UpdateMealPlanGroceryListItemsPermission))
+		assert.True(t, IsAccountRoleAssignablePermission(ReadMealPlansPermission))
+		assert.False(t, IsAccountRoleAssignablePermission(ImpersonateUserPermission))
+		assert.False(t, IsAccountRoleAssignablePermission(CreateMealPlanTasksPermission))
+		assert.False(t, IsAccountRoleAssignablePermission(CreateValidInstrumentsPermission))
+		assert.False(t, IsAccountRoleAssignablePermission(Permission("not.a.permission")))
 	})
 }
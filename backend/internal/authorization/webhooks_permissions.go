@@ -21,6 +21,10 @@
 	UpdateWebhookTriggerEventsPermission Permission = "update.webhook_trigger_events"
 	// ArchiveWebhookTriggerEventsPermission is a permission for archiving a catalog trigger event.
 	ArchiveWebhookTriggerEventsPermission Permission = "archive.webhook_trigger_events"
+	// ReadWebhookDeliveriesPermission is an account member permission for reading webhook delivery attempts.
+	ReadWebhookDeliveriesPermission Permission = "read.webhook_deliveries"
+	// RedeliverWebhookDeliveriesPermission is an account admin permission for manually redelivering a webhook.
+	RedeliverWebhookDeliveriesPermission Permission = "redeliver.webhook_deliveries"
 )
 
 var (
@@ -36,5 +40,7 @@
 		ReadWebhookTriggerEventsPermission,
 		UpdateWebhookTriggerEventsPermission,
 		ArchiveWebhookTriggerEventsPermission,
+		ReadWebhookDeliveriesPermission,
+		RedeliverWebhookDeliveriesPermission,
 	}
 )
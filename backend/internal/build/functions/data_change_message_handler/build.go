@@ -11,6 +11,7 @@
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/functions/datachangemessagehandler"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/repositories/postgres/auditlogentries"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/repositories/postgres/auth"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/repositories/postgres/comments"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/repositories/postgres/dataprivacy"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/repositories/postgres/identity"
 	internalopsrepo "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/repositories/postgres/internalops"
@@ -70,6 +71,7 @@ func BuildInjector(
 	// repos
 	auditlogentries.RegisterAuditLogRepository(i)
 	auth.RegisterAuthRepository(i)
+	comments.RegisterCommentsRepository(i)
 	dataprivacy.RegisterDataPrivacyRepository(i)
 	identity.RegisterIdentityRepository(i)
 	issue_reports.RegisterIssueReportsRepository(i)
@@ -4,6 +4,7 @@
 	"context"
 
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/config"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/repositories/postgres/auditlogentries"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/repositories/postgres/internalops"
 	dbcleaner "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/services/oauth/workers/db_cleaner"
 
@@ -36,6 +37,7 @@ func BuildInjector(
 	databasecfg.RegisterClientConfig(i)
 	postgres.RegisterDatabaseClient(i)
 	internalops.RegisterInternalOpsRepository(i)
+	auditlogentries.RegisterAuditLogRepository(i)
 	dbcleaner.RegisterDBCleaner(i)
 
 	return i
@@ -2,6 +2,7 @@
 
 import (
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/config"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/audit"
 
 	databasecfg "github.com/primandproper/platform/database/config"
 	"github.com/primandproper/platform/observability"
@@ -19,4 +20,12 @@ func RegisterConfigs(i do.Injector) {
 		cfg := do.MustInvoke[*config.DBCleanerConfig](i)
 		return &cfg.Database, nil
 	})
+	do.Provide[*audit.RetentionConfig](i, func(i do.Injector) (*audit.RetentionConfig, error) {
+		cfg := do.MustInvoke[*config.DBCleanerConfig](i)
+		return &cfg.AuditLogRetention, nil
+	})
+	do.Provide[*audit.ChainConfig](i, func(i do.Injector) (*audit.ChainConfig, error) {
+		cfg := do.MustInvoke[*config.DBCleanerConfig](i)
+		return &cfg.AuditLogChain, nil
+	})
 }
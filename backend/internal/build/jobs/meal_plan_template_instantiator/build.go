@@ -0,0 +1,62 @@
+package mealplantemplateinstantiator
+
+import (
+	"context"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/config"
+	mealplanningregistration "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/registration"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/payments/entitlements"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/repositories/postgres/auditlogentries"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/repositories/postgres/identity"
+	paymentsrepo "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/repositories/postgres/payments"
+	mealplantemplateinstantiator "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/services/mealplanning/workers/meal_plan_template_instantiator"
+
+	databasecfg "github.com/primandproper/platform/database/config"
+	"github.com/primandproper/platform/database/postgres"
+	msgconfig "github.com/primandproper/platform/messagequeue/config"
+	"github.com/primandproper/platform/observability"
+	loggingcfg "github.com/primandproper/platform/observability/logging/config"
+	metricscfg "github.com/primandproper/platform/observability/metrics/config"
+	tracingcfg "github.com/primandproper/platform/observability/tracing/config"
+
+	"github.com/samber/do/v2"
+)
+
+// BuildInjector creates and configures the dependency injection container.
+func BuildInjector(
+	ctx context.Context,
+	cfg *config.MealPlanTemplateInstantiatorConfig,
+) *do.RootScope {
+	i := do.New()
+
+	do.ProvideValue(i, ctx)
+	do.ProvideValue(i, cfg)
+
+	RegisterConfigs(i)
+
+	observability.RegisterO11yConfigs(i)
+	tracingcfg.RegisterTracerProvider(i)
+	loggingcfg.RegisterLogger(i)
+	metricscfg.RegisterMetricsProvider(i)
+	databasecfg.RegisterClientConfig(i)
+	postgres.RegisterDatabaseClient(i)
+	msgconfig.RegisterMessageQueue(i)
+	auditlogentries.RegisterAuditLogRepository(i)
+	identity.RegisterIdentityRepository(i)
+	paymentsrepo.RegisterPaymentsRepository(i)
+	entitlements.RegisterEntitlementsChecker(i)
+
+	// Domain: mealplanning
+	mealplanningregistration.RegisterForMealPlanTemplateInstantiator(i)
+
+	return i
+}
+
+// Build builds a server.
+func Build(
+	ctx context.Context,
+	cfg *config.MealPlanTemplateInstantiatorConfig,
+) (*mealplantemplateinstantiator.Worker, error) {
+	i := BuildInjector(ctx, cfg)
+	return do.MustInvoke[*mealplantemplateinstantiator.Worker](i), nil
+}
@@ -0,0 +1,41 @@
+package mealplantemplateinstantiator
+
+import (
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/config"
+	paymentscfg "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/services/payments/config"
+
+	databasecfg "github.com/primandproper/platform/database/config"
+	msgconfig "github.com/primandproper/platform/messagequeue/config"
+	"github.com/primandproper/platform/observability"
+	textsearchcfg "github.com/primandproper/platform/search/text/config"
+
+	"github.com/samber/do/v2"
+)
+
+// RegisterConfigs registers all config sub-fields with the injector.
+func RegisterConfigs(i do.Injector) {
+	do.Provide[*msgconfig.QueuesConfig](i, func(i do.Injector) (*msgconfig.QueuesConfig, error) {
+		cfg := do.MustInvoke[*config.MealPlanTemplateInstantiatorConfig](i)
+		return &cfg.Queues, nil
+	})
+	do.Provide[*msgconfig.Config](i, func(i do.Injector) (*msgconfig.Config, error) {
+		cfg := do.MustInvoke[*config.MealPlanTemplateInstantiatorConfig](i)
+		return &cfg.Events, nil
+	})
+	do.Provide[*observability.Config](i, func(i do.Injector) (*observability.Config, error) {
+		cfg := do.MustInvoke[*config.MealPlanTemplateInstantiatorConfig](i)
+		return &cfg.Observability, nil
+	})
+	do.Provide[*textsearchcfg.Config](i, func(i do.Injector) (*textsearchcfg.Config, error) {
+		cfg := do.MustInvoke[*config.MealPlanTemplateInstantiatorConfig](i)
+		return &cfg.Search, nil
+	})
+	do.Provide[*paymentscfg.Config](i, func(i do.Injector) (*paymentscfg.Config, error) {
+		cfg := do.MustInvoke[*config.MealPlanTemplateInstantiatorConfig](i)
+		return &cfg.Payments, nil
+	})
+	do.Provide[*databasecfg.Config](i, func(i do.Injector) (*databasecfg.Config, error) {
+		cfg := do.MustInvoke[*config.MealPlanTemplateInstantiatorConfig](i)
+		return &cfg.Database, nil
+	})
+}
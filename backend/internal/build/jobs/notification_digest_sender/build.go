@@ -0,0 +1,55 @@
+package notificationdigestsender
+
+import (
+	"context"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/config"
+	notificationsmanager "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/notifications/manager"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/repositories/postgres/auditlogentries"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/repositories/postgres/identity"
+
+	databasecfg "github.com/primandproper/platform/database/config"
+	"github.com/primandproper/platform/database/postgres"
+	msgconfig "github.com/primandproper/platform/messagequeue/config"
+	"github.com/primandproper/platform/observability"
+	loggingcfg "github.com/primandproper/platform/observability/logging/config"
+	metricscfg "github.com/primandproper/platform/observability/metrics/config"
+	tracingcfg "github.com/primandproper/platform/observability/tracing/config"
+
+	"github.com/samber/do/v2"
+)
+
+// BuildInjector creates and configures the dependency injection container.
+func BuildInjector(
+	ctx context.Context,
+	cfg *config.NotificationDigestSenderConfig,
+) *do.RootScope {
+	i := do.New()
+
+	do.ProvideValue(i, ctx)
+	do.ProvideValue(i, cfg)
+
+	RegisterConfigs(i)
+
+	observability.RegisterO11yConfigs(i)
+	tracingcfg.RegisterTracerProvider(i)
+	loggingcfg.RegisterLogger(i)
+	metricscfg.RegisterMetricsProvider(i)
+	msgconfig.RegisterMessageQueue(i)
+	databasecfg.RegisterClientConfig(i)
+	postgres.RegisterDatabaseClient(i)
+	auditlogentries.RegisterAuditLogRepository(i)
+	identity.RegisterIdentityRepository(i)
+	notificationsmanager.RegisterNotificationsDataManager(i)
+
+	return i
+}
+
+// Build builds a notification digest sender.
+func Build(
+	ctx context.Context,
+	cfg *config.NotificationDigestSenderConfig,
+) (*Sender, error) {
+	i := BuildInjector(ctx, cfg)
+	return do.MustInvoke[*Sender](i), nil
+}
@@ -0,0 +1,72 @@
+package notificationdigestsender
+
+import (
+	"context"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/config"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/notifications"
+
+	databasecfg "github.com/primandproper/platform/database/config"
+	"github.com/primandproper/platform/messagequeue"
+	msgconfig "github.com/primandproper/platform/messagequeue/config"
+	"github.com/primandproper/platform/observability"
+	"github.com/primandproper/platform/observability/logging"
+	"github.com/primandproper/platform/observability/tracing"
+
+	"github.com/samber/do/v2"
+)
+
+// RegisterConfigs registers all config sub-fields with the injector.
+func RegisterConfigs(i do.Injector) {
+	do.Provide[*msgconfig.QueuesConfig](i, func(i do.Injector) (*msgconfig.QueuesConfig, error) {
+		cfg := do.MustInvoke[*config.NotificationDigestSenderConfig](i)
+		return &cfg.Queues, nil
+	})
+	do.Provide[*databasecfg.Config](i, func(i do.Injector) (*databasecfg.Config, error) {
+		cfg := do.MustInvoke[*config.NotificationDigestSenderConfig](i)
+		return &cfg.Database, nil
+	})
+	do.Provide[*observability.Config](i, func(i do.Injector) (*observability.Config, error) {
+		return ProvideObservabilityConfig(do.MustInvoke[*config.NotificationDigestSenderConfig](i)), nil
+	})
+	do.Provide[*msgconfig.Config](i, func(i do.Injector) (*msgconfig.Config, error) {
+		return ProvideEventsConfig(do.MustInvoke[*config.NotificationDigestSenderConfig](i)), nil
+	})
+	do.Provide[messagequeue.Publisher](i, func(i do.Injector) (messagequeue.Publisher, error) {
+		ctx := do.MustInvoke[context.Context](i)
+		publisherProvider := do.MustInvoke[messagequeue.PublisherProvider](i)
+		queues := do.MustInvoke[*msgconfig.QueuesConfig](i)
+		return ProvideOutboundEmailsPublisher(ctx, publisherProvider, queues)
+	})
+	do.Provide[*Sender](i, func(i do.Injector) (*Sender, error) {
+		cfg := do.MustInvoke[*config.NotificationDigestSenderConfig](i)
+		return NewSender(
+			do.MustInvoke[logging.Logger](i),
+			do.MustInvoke[tracing.TracerProvider](i),
+			do.MustInvoke[notifications.Repository](i),
+			do.MustInvoke[identity.Repository](i),
+			do.MustInvoke[messagequeue.Publisher](i),
+			cfg.BaseURL,
+		), nil
+	})
+}
+
+// ProvideObservabilityConfig provides the observability config.
+func ProvideObservabilityConfig(cfg *config.NotificationDigestSenderConfig) *observability.Config {
+	return &cfg.Observability
+}
+
+// ProvideEventsConfig provides the message queue events config.
+func ProvideEventsConfig(cfg *config.NotificationDigestSenderConfig) *msgconfig.Config {
+	return &cfg.Events
+}
+
+// ProvideOutboundEmailsPublisher provides a publisher for the outbound_emails topic.
+func ProvideOutboundEmailsPublisher(
+	ctx context.Context,
+	messageQueuePublisherProvider messagequeue.PublisherProvider,
+	queues *msgconfig.QueuesConfig,
+) (messagequeue.Publisher, error) {
+	return messageQueuePublisherProvider.ProvidePublisher(ctx, queues.OutboundEmailsTopicName)
+}
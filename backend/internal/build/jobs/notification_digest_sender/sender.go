@@ -0,0 +1,124 @@
+package notificationdigestsender
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity"
+	identitykeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity/keys"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/notifications"
+	notificationemails "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/notifications/emails"
+
+	"github.com/primandproper/platform/messagequeue"
+	"github.com/primandproper/platform/observability"
+	"github.com/primandproper/platform/observability/logging"
+	"github.com/primandproper/platform/observability/tracing"
+
+	"github.com/hashicorp/go-multierror"
+)
+
+const senderTracerName = "notification_digest_sender"
+
+// Sender coalesces queued notifications into one digest email per user.
+type Sender struct {
+	logger                  logging.Logger
+	tracer                  tracing.Tracer
+	notificationsRepo       notifications.Repository
+	identityRepo            identity.Repository
+	outboundEmailsPublisher messagequeue.Publisher
+	baseURL                 string
+}
+
+// NewSender creates a new notification digest sender.
+func NewSender(
+	logger logging.Logger,
+	tracerProvider tracing.TracerProvider,
+	notificationsRepo notifications.Repository,
+	identityRepo identity.Repository,
+	outboundEmailsPublisher messagequeue.Publisher,
+	baseURL string,
+) *Sender {
+	return &Sender{
+		logger:                  logging.NewNamedLogger(logger, senderTracerName),
+		tracer:                  tracing.NewNamedTracer(tracerProvider, senderTracerName),
+		notificationsRepo:       notificationsRepo,
+		identityRepo:            identityRepo,
+		outboundEmailsPublisher: outboundEmailsPublisher,
+		baseURL:                 baseURL,
+	}
+}
+
+// SendDigests finds every user with queued notifications that are due and sends each of them a single digest email.
+func (s *Sender) SendDigests(ctx context.Context) error {
+	ctx, span := s.tracer.StartSpan(ctx)
+	defer span.End()
+
+	now := time.Now()
+
+	userIDs, err := s.notificationsRepo.GetUserIDsWithDueQueuedNotifications(ctx, now)
+	if err != nil {
+		return observability.PrepareAndLogError(err, s.logger, span, "getting users with due queued notifications")
+	}
+
+	if len(userIDs) == 0 {
+		return nil
+	}
+
+	s.logger.WithValue("count", len(userIDs)).Info("sending notification digests")
+
+	errs := &multierror.Error{}
+	for _, userID := range userIDs {
+		if err = s.sendDigestForUser(ctx, userID, now); err != nil {
+			errs = multierror.Append(errs, fmt.Errorf("sending notification digest for %s: %w", userID, err))
+		}
+	}
+
+	return errs.ErrorOrNil()
+}
+
+func (s *Sender) sendDigestForUser(ctx context.Context, userID string, now time.Time) error {
+	ctx, span := s.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := s.logger.WithValue(identitykeys.UserIDKey, userID)
+
+	queued, err := s.notificationsRepo.GetDueQueuedNotificationsForUser(ctx, userID, now)
+	if err != nil {
+		return fmt.Errorf("fetching queued notifications: %w", err)
+	}
+
+	if len(queued) == 0 {
+		return nil
+	}
+
+	user, err := s.identityRepo.GetUser(ctx, userID)
+	if err != nil {
+		return fmt.Errorf("fetching user: %w", err)
+	}
+
+	msg, err := notificationemails.BuildNotificationDigestEmail(user, queued, s.baseURL)
+	switch {
+	case errors.Is(err, notificationemails.ErrUnverifiedEmailRecipient):
+		// we can't email this user, so there's no sense in letting their queue grow forever.
+		logger.Info("dropping queued notifications for user without a verified email address")
+	case err != nil:
+		return fmt.Errorf("building digest email: %w", err)
+	default:
+		if err = s.outboundEmailsPublisher.Publish(ctx, msg); err != nil {
+			return fmt.Errorf("publishing digest email: %w", err)
+		}
+	}
+
+	ids := make([]string, 0, len(queued))
+	for _, x := range queued {
+		ids = append(ids, x.ID)
+	}
+
+	if err = s.notificationsRepo.MarkQueuedNotificationsAsDelivered(ctx, userID, ids); err != nil {
+		return fmt.Errorf("marking queued notifications as delivered: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,152 @@
+package notificationdigestsender
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	identityfakes "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity/fakes"
+	identitymock "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity/mock"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/notifications/fakes"
+	notificationsmock "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/notifications/mock"
+
+	"github.com/primandproper/platform/email"
+	msgqueuemock "github.com/primandproper/platform/messagequeue/mock"
+	loggingnoop "github.com/primandproper/platform/observability/logging/noop"
+	tracingnoop "github.com/primandproper/platform/observability/tracing/noop"
+	"github.com/primandproper/platform/reflection"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+const exampleBaseURL = "https://dinnerdonebetter.dev"
+
+func TestSender_SendDigests(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+
+		user := identityfakes.BuildFakeUser()
+		user.EmailAddressVerifiedAt = new(time.Now())
+		queued := fakes.BuildFakeQueuedNotificationsList()
+		ids := make([]string, 0, len(queued))
+		for _, x := range queued {
+			x.BelongsToUser = user.ID
+			ids = append(ids, x.ID)
+		}
+
+		notificationsRepo := &notificationsmock.Repository{}
+		notificationsRepo.On(reflection.GetMethodName(notificationsRepo.GetUserIDsWithDueQueuedNotifications), mock.Anything, mock.AnythingOfType("time.Time")).Return([]string{user.ID}, nil).Once()
+		notificationsRepo.On(reflection.GetMethodName(notificationsRepo.GetDueQueuedNotificationsForUser), mock.Anything, user.ID, mock.AnythingOfType("time.Time")).Return(queued, nil).Once()
+		notificationsRepo.On(reflection.GetMethodName(notificationsRepo.MarkQueuedNotificationsAsDelivered), mock.Anything, user.ID, ids).Return(nil).Once()
+
+		identityRepo := &identitymock.RepositoryMock{}
+		identityRepo.On(reflection.GetMethodName(identityRepo.GetUser), mock.Anything, user.ID).Return(user, nil).Once()
+
+		var publishedPayload any
+		publisher := &msgqueuemock.PublisherMock{}
+		publisher.PublishFunc = func(_ context.Context, data any) error {
+			publishedPayload = data
+			return nil
+		}
+
+		sender := NewSender(loggingnoop.NewLogger(), tracingnoop.NewTracerProvider(), notificationsRepo, identityRepo, publisher, exampleBaseURL)
+
+		require.NoError(t, sender.SendDigests(ctx))
+		mock.AssertExpectationsForObjects(t, notificationsRepo, identityRepo)
+
+		msg, ok := publishedPayload.(*email.OutboundEmailMessage)
+		require.True(t, ok, "expected OutboundEmailMessage to be published")
+		assert.Equal(t, user.EmailAddress, msg.ToAddress)
+	})
+
+	T.Run("with nothing due", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+
+		notificationsRepo := &notificationsmock.Repository{}
+		notificationsRepo.On(reflection.GetMethodName(notificationsRepo.GetUserIDsWithDueQueuedNotifications), mock.Anything, mock.AnythingOfType("time.Time")).Return([]string{}, nil).Once()
+
+		published := false
+		publisher := &msgqueuemock.PublisherMock{}
+		publisher.PublishFunc = func(context.Context, any) error {
+			published = true
+			return nil
+		}
+
+		sender := NewSender(loggingnoop.NewLogger(), tracingnoop.NewTracerProvider(), notificationsRepo, &identitymock.RepositoryMock{}, publisher, exampleBaseURL)
+
+		require.NoError(t, sender.SendDigests(ctx))
+		mock.AssertExpectationsForObjects(t, notificationsRepo)
+		assert.False(t, published)
+	})
+
+	T.Run("drops notifications for unverified users", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+
+		user := identityfakes.BuildFakeUser()
+		user.EmailAddressVerifiedAt = nil
+		queued := fakes.BuildFakeQueuedNotificationsList()
+		ids := make([]string, 0, len(queued))
+		for _, x := range queued {
+			ids = append(ids, x.ID)
+		}
+
+		notificationsRepo := &notificationsmock.Repository{}
+		notificationsRepo.On(reflection.GetMethodName(notificationsRepo.GetUserIDsWithDueQueuedNotifications), mock.Anything, mock.AnythingOfType("time.Time")).Return([]string{user.ID}, nil).Once()
+		notificationsRepo.On(reflection.GetMethodName(notificationsRepo.GetDueQueuedNotificationsForUser), mock.Anything, user.ID, mock.AnythingOfType("time.Time")).Return(queued, nil).Once()
+		notificationsRepo.On(reflection.GetMethodName(notificationsRepo.MarkQueuedNotificationsAsDelivered), mock.Anything, user.ID, ids).Return(nil).Once()
+
+		identityRepo := &identitymock.RepositoryMock{}
+		identityRepo.On(reflection.GetMethodName(identityRepo.GetUser), mock.Anything, user.ID).Return(user, nil).Once()
+
+		published := false
+		publisher := &msgqueuemock.PublisherMock{}
+		publisher.PublishFunc = func(context.Context, any) error {
+			published = true
+			return nil
+		}
+
+		sender := NewSender(loggingnoop.NewLogger(), tracingnoop.NewTracerProvider(), notificationsRepo, identityRepo, publisher, exampleBaseURL)
+
+		require.NoError(t, sender.SendDigests(ctx))
+		mock.AssertExpectationsForObjects(t, notificationsRepo, identityRepo)
+		assert.False(t, published)
+	})
+
+	T.Run("does not mark notifications delivered when publishing fails", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+
+		user := identityfakes.BuildFakeUser()
+		user.EmailAddressVerifiedAt = new(time.Now())
+		queued := fakes.BuildFakeQueuedNotificationsList()
+
+		notificationsRepo := &notificationsmock.Repository{}
+		notificationsRepo.On(reflection.GetMethodName(notificationsRepo.GetUserIDsWithDueQueuedNotifications), mock.Anything, mock.AnythingOfType("time.Time")).Return([]string{user.ID}, nil).Once()
+		notificationsRepo.On(reflection.GetMethodName(notificationsRepo.GetDueQueuedNotificationsForUser), mock.Anything, user.ID, mock.AnythingOfType("time.Time")).Return(queued, nil).Once()
+
+		identityRepo := &identitymock.RepositoryMock{}
+		identityRepo.On(reflection.GetMethodName(identityRepo.GetUser), mock.Anything, user.ID).Return(user, nil).Once()
+
+		publisher := &msgqueuemock.PublisherMock{}
+		publisher.PublishFunc = func(context.Context, any) error {
+			return errors.New("blah")
+		}
+
+		sender := NewSender(loggingnoop.NewLogger(), tracingnoop.NewTracerProvider(), notificationsRepo, identityRepo, publisher, exampleBaseURL)
+
+		assert.Error(t, sender.SendDigests(ctx))
+		mock.AssertExpectationsForObjects(t, notificationsRepo, identityRepo)
+	})
+}
@@ -0,0 +1,55 @@
+package webhookdeliveryretrier
+
+import (
+	"context"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/config"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/repositories/postgres/auditlogentries"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/repositories/postgres/webhooks"
+	webhookdeliveryretrier "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/services/webhooks/workers/webhook_delivery_retrier"
+
+	databasecfg "github.com/primandproper/platform/database/config"
+	"github.com/primandproper/platform/database/postgres"
+	msgconfig "github.com/primandproper/platform/messagequeue/config"
+	"github.com/primandproper/platform/observability"
+	loggingcfg "github.com/primandproper/platform/observability/logging/config"
+	metricscfg "github.com/primandproper/platform/observability/metrics/config"
+	tracingcfg "github.com/primandproper/platform/observability/tracing/config"
+
+	"github.com/samber/do/v2"
+)
+
+// BuildInjector creates and configures the dependency injection container.
+func BuildInjector(
+	ctx context.Context,
+	cfg *config.WebhookDeliveryRetrierConfig,
+) *do.RootScope {
+	i := do.New()
+
+	do.ProvideValue(i, ctx)
+	do.ProvideValue(i, cfg)
+
+	RegisterConfigs(i)
+
+	observability.RegisterO11yConfigs(i)
+	tracingcfg.RegisterTracerProvider(i)
+	loggingcfg.RegisterLogger(i)
+	metricscfg.RegisterMetricsProvider(i)
+	databasecfg.RegisterClientConfig(i)
+	postgres.RegisterDatabaseClient(i)
+	msgconfig.RegisterMessageQueue(i)
+	auditlogentries.RegisterAuditLogRepository(i)
+	webhooks.RegisterWebhooksRepository(i)
+	webhookdeliveryretrier.RegisterWebhookDeliveryRetrier(i)
+
+	return i
+}
+
+// Build builds a webhook delivery retrier.
+func Build(
+	ctx context.Context,
+	cfg *config.WebhookDeliveryRetrierConfig,
+) (*webhookdeliveryretrier.Worker, error) {
+	i := BuildInjector(ctx, cfg)
+	return do.MustInvoke[*webhookdeliveryretrier.Worker](i), nil
+}
@@ -0,0 +1,31 @@
+package webhookdeliveryretrier
+
+import (
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/config"
+
+	databasecfg "github.com/primandproper/platform/database/config"
+	msgconfig "github.com/primandproper/platform/messagequeue/config"
+	"github.com/primandproper/platform/observability"
+
+	"github.com/samber/do/v2"
+)
+
+// RegisterConfigs registers all config sub-fields with the injector.
+func RegisterConfigs(i do.Injector) {
+	do.Provide[*msgconfig.QueuesConfig](i, func(i do.Injector) (*msgconfig.QueuesConfig, error) {
+		cfg := do.MustInvoke[*config.WebhookDeliveryRetrierConfig](i)
+		return &cfg.Queues, nil
+	})
+	do.Provide[*msgconfig.Config](i, func(i do.Injector) (*msgconfig.Config, error) {
+		cfg := do.MustInvoke[*config.WebhookDeliveryRetrierConfig](i)
+		return &cfg.Events, nil
+	})
+	do.Provide[*observability.Config](i, func(i do.Injector) (*observability.Config, error) {
+		cfg := do.MustInvoke[*config.WebhookDeliveryRetrierConfig](i)
+		return &cfg.Observability, nil
+	})
+	do.Provide[*databasecfg.Config](i, func(i do.Injector) (*databasecfg.Config, error) {
+		cfg := do.MustInvoke[*config.WebhookDeliveryRetrierConfig](i)
+		return &cfg.Database, nil
+	})
+}
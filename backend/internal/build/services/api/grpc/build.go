@@ -4,6 +4,7 @@
 	"context"
 
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/authentication"
+	ratelimitingcfg "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/authentication/ratelimiting/config"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/authentication/sessions"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/config"
 	auditmanager "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/audit/manager"
@@ -15,6 +16,7 @@
 	mealplanningregistration "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/registration"
 	notificationsmanager "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/notifications/manager"
 	oauthmgr "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/oauth/manager"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/payments/entitlements"
 	paymentsmanager "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/payments/manager"
 	settingsmanager "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/settings/manager"
 	uploadedmediamanager "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/uploadedmedia/manager"
@@ -107,6 +109,10 @@ func BuildInjector(
 	sessions.RegisterSessionProviders(i)
 	tokenscfg.RegisterTokenIssuer(i)
 	interceptors.RegisterAuthInterceptor(i)
+	interceptors.RegisterEntitlementsInterceptor(i)
+	ratelimitingcfg.RegisterRateLimitStore(i)
+	interceptors.RegisterRateLimitInterceptor(i)
+	interceptors.RegisterIdempotencyInterceptor(i)
 
 	// repositories (core)
 	auditrepo.RegisterAuditLogRepository(i)
@@ -128,6 +134,7 @@ func BuildInjector(
 	identitymgr.RegisterIdentityDataManager(i)
 	notificationsmanager.RegisterNotificationsDataManager(i)
 	settingsmanager.RegisterSettingsDataManager(i)
+	entitlements.RegisterEntitlementsChecker(i)
 	paymentsmanager.RegisterPaymentsDataManager(i)
 	oauthmgr.RegisterOAuth2Manager(i)
 	webhooksmanager.RegisterWebhookDataManager(i)
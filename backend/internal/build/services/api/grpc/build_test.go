@@ -5,6 +5,7 @@
 	"testing"
 
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/config"
+	mealplanningsvcpb "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/grpc/generated/services/mealplanning"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -21,3 +22,10 @@ func TestBuildInjector_RegistersAllProviders(t *testing.T) {
 	assert.NotEmpty(t, services, "expected providers to be registered")
 	assert.Greater(t, len(services), 10, "expected many providers to be registered")
 }
+
+func TestProvideMethodFeatureRequirements(t *testing.T) {
+	t.Parallel()
+
+	// the deterministic recipe importer is available on every plan; only AI-assisted import is a plan feature.
+	assert.NotContains(t, ProvideMethodFeatureRequirements(), mealplanningsvcpb.MealPlanningService_ImportRecipe_FullMethodName)
+}
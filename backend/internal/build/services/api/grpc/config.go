@@ -2,7 +2,9 @@
 
 import (
 	authcfg "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/authentication/config"
+	ratelimitingcfg "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/authentication/ratelimiting/config"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/config"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/audit"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/services/auth/handlers/authentication"
 	dataprivacycfg "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/services/dataprivacy/config"
 	identitycfg "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/services/identity/config"
@@ -35,6 +37,10 @@ func RegisterConfigs(i do.Injector) {
 		cfg := do.MustInvoke[*config.APIServiceConfig](i)
 		return &cfg.Auth, nil
 	})
+	do.Provide[*ratelimitingcfg.Config](i, func(i do.Injector) (*ratelimitingcfg.Config, error) {
+		cfg := do.MustInvoke[*authcfg.Config](i)
+		return &cfg.RateLimiting, nil
+	})
 	do.Provide[*msgconfig.QueuesConfig](i, func(i do.Injector) (*msgconfig.QueuesConfig, error) {
 		cfg := do.MustInvoke[*config.APIServiceConfig](i)
 		return &cfg.Queues, nil
@@ -135,4 +141,8 @@ func RegisterConfigs(i do.Injector) {
 		svc := do.MustInvoke[*config.ServicesConfig](i)
 		return &svc.Payments, nil
 	})
+	do.Provide[*audit.ChainConfig](i, func(i do.Injector) (*audit.ChainConfig, error) {
+		svc := do.MustInvoke[*config.ServicesConfig](i)
+		return &svc.AuditLogChain, nil
+	})
 }
@@ -3,7 +3,10 @@
 import (
 	"context"
 	"maps"
+	"time"
 
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/authentication/ratelimiting"
+	ratelimitingcfg "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/authentication/ratelimiting/config"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/config"
 	analyticspb "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/grpc/generated/services/analytics"
 	auditsvcpb "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/grpc/generated/services/audit"
@@ -87,14 +90,35 @@ func RegisterExtras(i do.Injector) {
 		), nil
 	})
 
+	do.Provide(i, func(i do.Injector) (interceptors.MethodFeatureRequirementsMap, error) {
+		return ProvideMethodFeatureRequirements(), nil
+	})
+
+	do.Provide(i, func(i do.Injector) (interceptors.MethodRateLimitPoliciesMap, error) {
+		return ProvideMethodRateLimitPolicies(do.MustInvoke[*ratelimitingcfg.Config](i)), nil
+	})
+
+	do.Provide(i, func(i do.Injector) (interceptors.TrustedProxiesList, error) {
+		return ProvideTrustedProxies(do.MustInvoke[*ratelimitingcfg.Config](i))
+	})
+
+	do.Provide(i, func(i do.Injector) (interceptors.MethodIdempotencyTTLsMap, error) {
+		return ProvideMethodIdempotencyTTLs(), nil
+	})
+
 	do.Provide(i, func(i do.Injector) ([]grpc.UnaryServerInterceptor, error) {
 		authInterceptor := do.MustInvoke[*interceptors.AuthInterceptor](i)
-		return BuildUnaryServerInterceptors(authInterceptor), nil
+		rateLimitInterceptor := do.MustInvoke[*interceptors.RateLimitInterceptor](i)
+		entitlementsInterceptor := do.MustInvoke[*interceptors.EntitlementsInterceptor](i)
+		idempotencyInterceptor := do.MustInvoke[*interceptors.IdempotencyInterceptor](i)
+		return BuildUnaryServerInterceptors(authInterceptor, rateLimitInterceptor, entitlementsInterceptor, idempotencyInterceptor), nil
 	})
 
 	do.Provide(i, func(i do.Injector) ([]grpc.StreamServerInterceptor, error) {
 		authInterceptor := do.MustInvoke[*interceptors.AuthInterceptor](i)
-		return BuildStreamServerInterceptors(authInterceptor), nil
+		rateLimitInterceptor := do.MustInvoke[*interceptors.RateLimitInterceptor](i)
+		entitlementsInterceptor := do.MustInvoke[*interceptors.EntitlementsInterceptor](i)
+		return BuildStreamServerInterceptors(authInterceptor, rateLimitInterceptor, entitlementsInterceptor), nil
 	})
 
 	do.Provide(i, func(i do.Injector) ([]platformgrpc.RegistrationFunc, error) {
@@ -179,16 +203,141 @@ func(server *grpc.Server) {
 	}
 }
 
-func BuildUnaryServerInterceptors(authInterceptor *interceptors.AuthInterceptor) []grpc.UnaryServerInterceptor {
+// ProvideMethodFeatureRequirements lists the gRPC methods that require a plan feature, keyed by full method name.
+// ImportRecipe isn't gated: it's a deterministic parser available on every plan. AI-assisted recipe import RPCs
+// belong here under payments.FeatureAIRecipeImport once they are exposed.
+func ProvideMethodFeatureRequirements() interceptors.MethodFeatureRequirementsMap {
+	return interceptors.MethodFeatureRequirementsMap{}
+}
+
+// ProvideMethodRateLimitPolicies lists the gRPC methods that are rate limited, keyed by full method name.
+// Policies in the config replace the built-in ones for the same method, and disabling rate limiting in the config
+// leaves every method unlimited.
+func ProvideMethodRateLimitPolicies(cfg *ratelimitingcfg.Config) interceptors.MethodRateLimitPoliciesMap {
+	if cfg.Disabled {
+		return interceptors.MethodRateLimitPoliciesMap{}
+	}
+
+	// repeated failures lock a caller out of an auth method for 30 seconds, doubling with each failure after that.
+	authLockout := &ratelimiting.Lockout{
+		MaxFailures: 5,
+		Window:      15 * time.Minute,
+		BaseBackoff: 30 * time.Second,
+		MaxBackoff:  15 * time.Minute,
+	}
+	loginUsername := func(req any) string {
+		type loginRequest interface {
+			GetInput() *authsvcpb.UserLoginInput
+		}
+		if x, ok := req.(loginRequest); ok {
+			return x.GetInput().GetUsername()
+		}
+		return ""
+	}
+	searchLimit := ratelimiting.Limit{Burst: 60, Interval: time.Minute}
+
+	policies := interceptors.MethodRateLimitPoliciesMap{
+		// Auth
+		authsvcpb.AuthService_LoginForToken_FullMethodName: {
+			Key:     ratelimiting.KeyByIP,
+			Limit:   ratelimiting.Limit{Burst: 10, Interval: time.Minute},
+			Lockout: authLockout,
+			Subject: loginUsername,
+		},
+		authsvcpb.AuthService_AdminLoginForToken_FullMethodName: {
+			Key:     ratelimiting.KeyByIP,
+			Limit:   ratelimiting.Limit{Burst: 10, Interval: time.Minute},
+			Lockout: authLockout,
+			Subject: loginUsername,
+		},
+		authsvcpb.AuthService_RequestPasswordResetToken_FullMethodName: {
+			Key:   ratelimiting.KeyByIP,
+			Limit: ratelimiting.Limit{Burst: 5, Interval: 15 * time.Minute},
+		},
+		authsvcpb.AuthService_RedeemPasswordResetToken_FullMethodName: {
+			Key:     ratelimiting.KeyByIP,
+			Limit:   ratelimiting.Limit{Burst: 10, Interval: time.Minute},
+			Lockout: authLockout,
+		},
+		authsvcpb.AuthService_VerifyTOTPSecret_FullMethodName: {
+			Key:     ratelimiting.KeyByIP,
+			Limit:   ratelimiting.Limit{Burst: 10, Interval: time.Minute},
+			Lockout: authLockout,
+		},
+		authsvcpb.AuthService_FinishPasskeyAuthentication_FullMethodName: {
+			Key:     ratelimiting.KeyByIP,
+			Limit:   ratelimiting.Limit{Burst: 10, Interval: time.Minute},
+			Lockout: authLockout,
+		},
+
+		// Waitlists
+		waitlistssvcpb.WaitlistsService_CreateWaitlistSignup_FullMethodName: {
+			Key:   ratelimiting.KeyByUser,
+			Limit: ratelimiting.Limit{Burst: 5, Interval: time.Hour},
+		},
+
+		// Search
+		identitysvcpb.IdentityService_SearchForUsers_FullMethodName:                                     {Key: ratelimiting.KeyByUser, Limit: searchLimit},
+		settingssvcpb.SettingsService_SearchForServiceSettings_FullMethodName:                           {Key: ratelimiting.KeyByUser, Limit: searchLimit},
+		mealplanningsvcpb.MealPlanningService_SearchForMeals_FullMethodName:                             {Key: ratelimiting.KeyByUser, Limit: searchLimit},
+		mealplanningsvcpb.MealPlanningService_SearchForRecipes_FullMethodName:                           {Key: ratelimiting.KeyByUser, Limit: searchLimit},
+		mealplanningsvcpb.MealPlanningService_SearchForMealEligibleRecipes_FullMethodName:               {Key: ratelimiting.KeyByUser, Limit: searchLimit},
+		mealplanningsvcpb.MealPlanningService_SearchForRecipesWithInstrumentOwnership_FullMethodName:    {Key: ratelimiting.KeyByUser, Limit: searchLimit},
+		mealplanningsvcpb.MealPlanningService_SearchForValidIngredientGroups_FullMethodName:             {Key: ratelimiting.KeyByUser, Limit: searchLimit},
+		mealplanningsvcpb.MealPlanningService_SearchForValidIngredientStates_FullMethodName:             {Key: ratelimiting.KeyByUser, Limit: searchLimit},
+		mealplanningsvcpb.MealPlanningService_SearchForValidIngredients_FullMethodName:                  {Key: ratelimiting.KeyByUser, Limit: searchLimit},
+		mealplanningsvcpb.MealPlanningService_SearchForValidInstruments_FullMethodName:                  {Key: ratelimiting.KeyByUser, Limit: searchLimit},
+		mealplanningsvcpb.MealPlanningService_SearchForValidMeasurementUnits_FullMethodName:             {Key: ratelimiting.KeyByUser, Limit: searchLimit},
+		mealplanningsvcpb.MealPlanningService_SearchForValidPreparations_FullMethodName:                 {Key: ratelimiting.KeyByUser, Limit: searchLimit},
+		mealplanningsvcpb.MealPlanningService_SearchForValidVessels_FullMethodName:                      {Key: ratelimiting.KeyByUser, Limit: searchLimit},
+		mealplanningsvcpb.MealPlanningService_SearchValidIngredientsByPreparation_FullMethodName:        {Key: ratelimiting.KeyByUser, Limit: searchLimit},
+		mealplanningsvcpb.MealPlanningService_SearchValidMeasurementUnitsByIngredient_FullMethodName:    {Key: ratelimiting.KeyByUser, Limit: searchLimit},
+		mealplanningsvcpb.MealPlanningService_SearchForValidInstrumentsNotOwnedByAccount_FullMethodName: {Key: ratelimiting.KeyByAccount, Limit: searchLimit},
+	}
+
+	maps.Copy(policies, cfg.Policies)
+
+	return policies
+}
+
+// ProvideTrustedProxies parses the proxies whose X-Forwarded-For headers the rate limiter believes.
+// Without any, callers are always limited by the address they connect from.
+func ProvideTrustedProxies(cfg *ratelimitingcfg.Config) (interceptors.TrustedProxiesList, error) {
+	prefixes, err := cfg.TrustedProxyPrefixes()
+	if err != nil {
+		return nil, err
+	}
+
+	return prefixes, nil
+}
+
+// ProvideMethodIdempotencyTTLs lists the gRPC methods that honor idempotency keys, keyed by full method name,
+// along with how long a key is remembered for. Mobile clients retry these on flaky networks.
+func ProvideMethodIdempotencyTTLs() interceptors.MethodIdempotencyTTLsMap {
+	const day = 24 * time.Hour
+
+	return interceptors.MethodIdempotencyTTLsMap{
+		mealplanningsvcpb.MealPlanningService_CreateMealPlan_FullMethodName:           day,
+		mealplanningsvcpb.MealPlanningService_CreateMealPlanOptionVote_FullMethodName: day,
+		mealplanningsvcpb.MealPlanningService_CreateRecipe_FullMethodName:             day,
+	}
+}
+
+func BuildUnaryServerInterceptors(authInterceptor *interceptors.AuthInterceptor, rateLimitInterceptor *interceptors.RateLimitInterceptor, entitlementsInterceptor *interceptors.EntitlementsInterceptor, idempotencyInterceptor *interceptors.IdempotencyInterceptor) []grpc.UnaryServerInterceptor {
 	return []grpc.UnaryServerInterceptor{
 		authInterceptor.UnaryServerInterceptor(),
+		rateLimitInterceptor.UnaryServerInterceptor(),
+		entitlementsInterceptor.UnaryServerInterceptor(),
+		idempotencyInterceptor.UnaryServerInterceptor(),
 		errorsgrpc.UnaryErrorEncodingInterceptor(),
 	}
 }
 
-func BuildStreamServerInterceptors(authInterceptor *interceptors.AuthInterceptor) []grpc.StreamServerInterceptor {
+func BuildStreamServerInterceptors(authInterceptor *interceptors.AuthInterceptor, rateLimitInterceptor *interceptors.RateLimitInterceptor, entitlementsInterceptor *interceptors.EntitlementsInterceptor) []grpc.StreamServerInterceptor {
 	return []grpc.StreamServerInterceptor{
 		authInterceptor.StreamServerInterceptor(),
+		rateLimitInterceptor.StreamServerInterceptor(),
+		entitlementsInterceptor.StreamServerInterceptor(),
 		errorsgrpc.StreamErrorEncodingInterceptor(),
 	}
 }
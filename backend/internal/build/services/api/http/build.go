@@ -7,6 +7,8 @@
 	authcfg "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/authentication/config"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/config"
 	identitymgr "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity/manager"
+	mealplanningregistration "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/registration"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/payments/entitlements"
 	paymentsmanager "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/payments/manager"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/repositories"
 	auditrepo "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/repositories/postgres/auditlogentries"
@@ -80,6 +82,7 @@ func BuildInjector(
 	paymentsrepo.RegisterPaymentsRepository(i)
 
 	// managers
+	entitlements.RegisterEntitlementsChecker(i)
 	identitymgr.RegisterIdentityDataManager(i)
 	paymentsmanager.RegisterPaymentsDataManager(i)
 	paymentsadapters.RegisterPaymentProcessorRegistry(i)
@@ -88,6 +91,9 @@ func BuildInjector(
 	authservice.RegisterAuthHTTPService(i)
 	paymentshttp.RegisterPaymentsHTTP(i)
 
+	// Domain: mealplanning
+	mealplanningregistration.RegisterForHTTPAPI(i)
+
 	// searchers & routes
 	RegisterSearchers(i)
 	RegisterAPIRouter(i)
@@ -4,6 +4,8 @@
 	"net/http"
 
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/auth"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/icalendar"
+	mealplanninghttp "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/services/mealplanning/http"
 	paymentswebhook "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/services/payments/http"
 
 	"github.com/primandproper/platform/encoding"
@@ -23,6 +25,7 @@ func ProvideAPIRouter(
 	metricsProvider metrics.Provider,
 	authService auth.AuthDataService,
 	paymentsWebhookHandler *paymentswebhook.WebhookHandler,
+	calendarFeedHandler *mealplanninghttp.CalendarFeedHandler,
 	healthRegistry healthcheck.Registry,
 ) (routing.Router, error) {
 	router, err := routingConfig.ProvideRouter(logger, tracerProvider, metricsProvider)
@@ -53,15 +56,22 @@ func ProvideAPIRouter(
 		})
 	})
 
+	router.Get("/.well-known/openid-configuration", authService.OpenIDConfigurationHandler)
+
 	router.Route("/oauth2", func(userRouter routing.Router) {
 		userRouter.Get("/authorize", authService.AuthorizeHandler)
 		userRouter.Post("/token", authService.TokenHandler)
 		userRouter.Post("/revoke", authService.RevokeHandler)
+		userRouter.Get("/jwks", authService.JWKSHandler)
+		userRouter.Get("/userinfo", authService.UserInfoHandler)
+		userRouter.Post("/userinfo", authService.UserInfoHandler)
 	})
 
 	router.Route("/api/payments/webhooks", func(paymentsRouter routing.Router) {
 		paymentsRouter.Post("/{provider}", paymentsWebhookHandler.Handle)
 	})
 
+	router.Get(icalendar.FeedPathPrefix+"{"+mealplanninghttp.CalendarFeedTokenURIParamKey+"}"+icalendar.FeedPathSuffix, calendarFeedHandler.Handle)
+
 	return router, nil
 }
@@ -2,6 +2,7 @@
 
 import (
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/auth"
+	mealplanninghttp "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/services/mealplanning/http"
 	paymentswebhook "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/services/payments/http"
 
 	"github.com/primandproper/platform/healthcheck"
@@ -24,6 +25,7 @@ func RegisterAPIRouter(i do.Injector) {
 			do.MustInvoke[metrics.Provider](i),
 			do.MustInvoke[auth.AuthDataService](i),
 			do.MustInvoke[*paymentswebhook.WebhookHandler](i),
+			do.MustInvoke[*mealplanninghttp.CalendarFeedHandler](i),
 			do.MustInvoke[healthcheck.Registry](i),
 		)
 	})
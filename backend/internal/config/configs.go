@@ -14,6 +14,7 @@
 
 	authcfg "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/authentication/config"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/branding"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/audit"
 
 	analyticscfg "github.com/primandproper/platform/analytics/config"
 	databasecfg "github.com/primandproper/platform/database/config"
@@ -68,8 +69,11 @@
 			MealPlanFinalizerConfig |
 			MealPlanGroceryListInitializerConfig |
 			MealPlanTaskCreatorConfig |
+			MealPlanTemplateInstantiatorConfig |
 			SearchDataIndexSchedulerConfig |
 			MobileNotificationSchedulerConfig |
+			NotificationDigestSenderConfig |
+			WebhookDeliveryRetrierConfig |
 			AsyncMessageHandlerConfig |
 			EmailDeliverabilityTestConfig |
 			QueueTestJobConfig |
@@ -104,8 +108,10 @@
 	DBCleanerConfig struct {
 		_ struct{} `json:"-"`
 
-		Observability observability.Config `envPrefix:"OBSERVABILITY_" json:"observability"`
-		Database      databasecfg.Config   `envPrefix:"DATABASE_"      json:"database"`
+		AuditLogRetention audit.RetentionConfig `envPrefix:"AUDIT_LOG_RETENTION_" json:"auditLogRetention"`
+		AuditLogChain     audit.ChainConfig     `envPrefix:"AUDIT_LOG_CHAIN_"     json:"auditLogChain"`
+		Observability     observability.Config  `envPrefix:"OBSERVABILITY_"       json:"observability"`
+		Database          databasecfg.Config    `envPrefix:"DATABASE_"            json:"database"`
 	}
 
 	// SearchDataIndexSchedulerConfig configures an instance of the search data index scheduler job.
@@ -128,6 +134,27 @@
 		Database      databasecfg.Config     `envPrefix:"DATABASE_"      json:"database"`
 	}
 
+	// NotificationDigestSenderConfig configures an instance of the notification digest sender job.
+	NotificationDigestSenderConfig struct {
+		_ struct{} `json:"-"`
+
+		Queues        msgconfig.QueuesConfig `envPrefix:"QUEUES_"        json:"queues"`
+		BaseURL       string                 `env:"BASE_URL"             json:"baseURL"`
+		Events        msgconfig.Config       `envPrefix:"EVENTS_"        json:"events"`
+		Observability observability.Config   `envPrefix:"OBSERVABILITY_" json:"observability"`
+		Database      databasecfg.Config     `envPrefix:"DATABASE_"      json:"database"`
+	}
+
+	// WebhookDeliveryRetrierConfig configures an instance of the webhook delivery retrier job.
+	WebhookDeliveryRetrierConfig struct {
+		_ struct{} `json:"-"`
+
+		Queues        msgconfig.QueuesConfig `envPrefix:"QUEUES_"        json:"queues"`
+		Events        msgconfig.Config       `envPrefix:"EVENTS_"        json:"events"`
+		Observability observability.Config   `envPrefix:"OBSERVABILITY_" json:"observability"`
+		Database      databasecfg.Config     `envPrefix:"DATABASE_"      json:"database"`
+	}
+
 	// AsyncMessageHandlerConfig configures an instance of the search data index scheduler job.
 	AsyncMessageHandlerConfig struct {
 		_                 struct{}                `json:"-"`
@@ -270,8 +297,10 @@ func (cfg *DBCleanerConfig) ValidateWithContext(ctx context.Context) error {
 	result := &multierror.Error{}
 
 	validators := map[string]func(context.Context) error{
-		"Observability": cfg.Observability.ValidateWithContext,
-		"Database":      cfg.Database.ValidateWithContext,
+		"Observability":     cfg.Observability.ValidateWithContext,
+		"Database":          cfg.Database.ValidateWithContext,
+		"AuditLogRetention": cfg.AuditLogRetention.ValidateWithContext,
+		"AuditLogChain":     cfg.AuditLogChain.ValidateWithContext,
 	}
 
 	for name, validator := range validators {
@@ -325,6 +354,48 @@ func (cfg *MobileNotificationSchedulerConfig) ValidateWithContext(ctx context.Co
 	return result.ErrorOrNil()
 }
 
+var _ validation.ValidatableWithContext = (*NotificationDigestSenderConfig)(nil)
+
+// ValidateWithContext validates a NotificationDigestSenderConfig struct.
+func (cfg *NotificationDigestSenderConfig) ValidateWithContext(ctx context.Context) error {
+	result := &multierror.Error{}
+
+	validators := map[string]func(context.Context) error{
+		"Observability": cfg.Observability.ValidateWithContext,
+		"Database":      cfg.Database.ValidateWithContext,
+		"Queues":        cfg.Queues.ValidateWithContext,
+	}
+
+	for name, validator := range validators {
+		if err := validator(ctx); err != nil {
+			result = multierror.Append(fmt.Errorf("error validating %s config: %w", name, err), result)
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+var _ validation.ValidatableWithContext = (*WebhookDeliveryRetrierConfig)(nil)
+
+// ValidateWithContext validates a WebhookDeliveryRetrierConfig struct.
+func (cfg *WebhookDeliveryRetrierConfig) ValidateWithContext(ctx context.Context) error {
+	result := &multierror.Error{}
+
+	validators := map[string]func(context.Context) error{
+		"Observability": cfg.Observability.ValidateWithContext,
+		"Database":      cfg.Database.ValidateWithContext,
+		"Queues":        cfg.Queues.ValidateWithContext,
+	}
+
+	for name, validator := range validators {
+		if err := validator(ctx); err != nil {
+			result = multierror.Append(fmt.Errorf("error validating %s config: %w", name, err), result)
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
 var _ validation.ValidatableWithContext = (*AsyncMessageHandlerConfig)(nil)
 
 // ValidateWithContext validates a AsyncMessageHandlerConfig struct.
@@ -9,6 +9,7 @@
 	"time"
 
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/config/envvars"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/audit"
 
 	databasecfg "github.com/primandproper/platform/database/config"
 	"github.com/primandproper/platform/encoding"
@@ -406,6 +407,54 @@ func TestDBCleanerConfig_ValidateWithContext(T *testing.T) {
 		err := cfg.ValidateWithContext(ctx)
 		assert.NoError(t, err)
 	})
+
+	T.Run("with negative audit log retention", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		cfg := &DBCleanerConfig{
+			Observability: observability.Config{},
+			Database: databasecfg.Config{
+				Debug: true,
+				ReadConnection: databasecfg.ConnectionDetails{
+					Username: "user",
+					Password: "pass",
+					Database: "db",
+					Host:     "host",
+				},
+			},
+			AuditLogRetention: audit.RetentionConfig{
+				Default: -time.Hour,
+			},
+		}
+
+		err := cfg.ValidateWithContext(ctx)
+		assert.Error(t, err)
+	})
+
+	T.Run("with invalid audit log checkpoint signing key", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		cfg := &DBCleanerConfig{
+			Observability: observability.Config{},
+			Database: databasecfg.Config{
+				Debug: true,
+				ReadConnection: databasecfg.ConnectionDetails{
+					Username: "user",
+					Password: "pass",
+					Database: "db",
+					Host:     "host",
+				},
+			},
+			AuditLogChain: audit.ChainConfig{
+				CheckpointSigningKey: "not a key",
+			},
+		}
+
+		err := cfg.ValidateWithContext(ctx)
+		assert.Error(t, err)
+	})
 }
 
 func TestMealPlanFinalizerConfig_ValidateWithContext(T *testing.T) {
@@ -486,6 +535,32 @@ func TestMealPlanTaskCreatorConfig_ValidateWithContext(T *testing.T) {
 	})
 }
 
+func TestMealPlanTemplateInstantiatorConfig_ValidateWithContext(T *testing.T) {
+	T.Parallel()
+
+	T.Run("valid config", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		cfg := &MealPlanTemplateInstantiatorConfig{
+			Observability: observability.Config{},
+			Database: databasecfg.Config{
+				Debug: true,
+				ReadConnection: databasecfg.ConnectionDetails{
+					Username: "user",
+					Password: "pass",
+					Database: "db",
+					Host:     "host",
+				},
+			},
+		}
+
+		err := cfg.ValidateWithContext(ctx)
+		// May have validation errors in queues config
+		_ = err
+	})
+}
+
 func TestSearchDataIndexSchedulerConfig_ValidateWithContext(T *testing.T) {
 	T.Parallel()
 
@@ -512,6 +587,59 @@ func TestSearchDataIndexSchedulerConfig_ValidateWithContext(T *testing.T) {
 	})
 }
 
+func TestNotificationDigestSenderConfig_ValidateWithContext(T *testing.T) {
+	T.Parallel()
+
+	T.Run("valid config", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		cfg := &NotificationDigestSenderConfig{
+			Observability: observability.Config{},
+			BaseURL:       "https://dinnerdonebetter.dev",
+			Database: databasecfg.Config{
+				Debug: true,
+				ReadConnection: databasecfg.ConnectionDetails{
+					Username: "user",
+					Password: "pass",
+					Database: "db",
+					Host:     "host",
+				},
+			},
+		}
+
+		err := cfg.ValidateWithContext(ctx)
+		// May have validation errors in queues config
+		_ = err
+	})
+}
+
+func TestWebhookDeliveryRetrierConfig_ValidateWithContext(T *testing.T) {
+	T.Parallel()
+
+	T.Run("valid config", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		cfg := &WebhookDeliveryRetrierConfig{
+			Observability: observability.Config{},
+			Database: databasecfg.Config{
+				Debug: true,
+				ReadConnection: databasecfg.ConnectionDetails{
+					Username: "user",
+					Password: "pass",
+					Database: "db",
+					Host:     "host",
+				},
+			},
+		}
+
+		err := cfg.ValidateWithContext(ctx)
+		// May have validation errors in queues config
+		_ = err
+	})
+}
+
 func TestAsyncMessageHandlerConfig_ValidateWithContext(T *testing.T) {
 	T.Parallel()
 
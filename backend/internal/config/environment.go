@@ -24,8 +24,11 @@ type EnvironmentConfigSet struct {
 	MealPlanFinalizerConfigPath              string // Domain: mealplanning
 	MealPlanGroceryListInitializerConfigPath string // Domain: mealplanning
 	MealPlanTaskCreatorConfigPath            string // Domain: mealplanning
+	MealPlanTemplateInstantiatorConfigPath   string // Domain: mealplanning
 	DBCleanerConfigPath                      string
 	MobileNotificationSchedulerConfigPath    string
+	NotificationDigestSenderConfigPath       string
+	WebhookDeliveryRetrierConfigPath         string
 	AsyncMessageHandlerConfigPath            string
 	EmailDeliverabilityTestConfigPath        string
 	QueueTestJobConfigPath                   string
@@ -91,6 +94,8 @@ func databaseConfigForService(cfg *databasecfg.Config, users map[string]string,
 	dbcConfigObservabilityServiceName  = "db_cleaner"
 	sdisConfigObservabilityServiceName = "search_data_index_scheduler"
 	mnsConfigObservabilityServiceName  = "mobile_notification_scheduler"
+	ndsConfigObservabilityServiceName  = "notification_digest_sender"
+	wdrConfigObservabilityServiceName  = "webhook_delivery_retrier"
 	amhConfigObservabilityServiceName  = "async_message_handler"
 	edtConfigObservabilityServiceName  = "email_deliverability_test"
 	qtConfigObservabilityServiceName   = "queue_test"
@@ -157,6 +162,31 @@ func (s *EnvironmentConfigSet) Render(outputDir string, pretty, validate bool) e
 	mnsConfig.Observability.Profiling.ServiceName = mnsConfigObservabilityServiceName
 	disableWorkerOtelMetrics(&mnsConfig.Observability)
 
+	ndsConfig := &NotificationDigestSenderConfig{
+		Observability: s.RootConfig.Observability,
+		Events:        s.RootConfig.Events,
+		Database:      databaseConfigForService(&s.RootConfig.Database, s.ServiceDatabaseUsers, ndsConfigObservabilityServiceName),
+		Queues:        s.RootConfig.Queues,
+		BaseURL:       s.RootConfig.BaseURL,
+	}
+	ndsConfig.Observability.Tracing.ServiceName = ndsConfigObservabilityServiceName
+	ndsConfig.Observability.Metrics.ServiceName = ndsConfigObservabilityServiceName
+	ndsConfig.Observability.Logging.ServiceName = ndsConfigObservabilityServiceName
+	ndsConfig.Observability.Profiling.ServiceName = ndsConfigObservabilityServiceName
+	disableWorkerOtelMetrics(&ndsConfig.Observability)
+
+	wdrConfig := &WebhookDeliveryRetrierConfig{
+		Observability: s.RootConfig.Observability,
+		Events:        s.RootConfig.Events,
+		Database:      databaseConfigForService(&s.RootConfig.Database, s.ServiceDatabaseUsers, wdrConfigObservabilityServiceName),
+		Queues:        s.RootConfig.Queues,
+	}
+	wdrConfig.Observability.Tracing.ServiceName = wdrConfigObservabilityServiceName
+	wdrConfig.Observability.Metrics.ServiceName = wdrConfigObservabilityServiceName
+	wdrConfig.Observability.Logging.ServiceName = wdrConfigObservabilityServiceName
+	wdrConfig.Observability.Profiling.ServiceName = wdrConfigObservabilityServiceName
+	disableWorkerOtelMetrics(&wdrConfig.Observability)
+
 	amhConfig := &AsyncMessageHandlerConfig{
 		Storage:           s.RootConfig.Services.DataPrivacy.Uploads.Storage,
 		Queues:            s.RootConfig.Queues,
@@ -233,6 +263,8 @@ func (s *EnvironmentConfigSet) Render(outputDir string, pretty, validate bool) e
 			dbcConfig,
 			sdisConfig,
 			mnsConfig,
+			ndsConfig,
+			wdrConfig,
 			amhConfig,
 			edtConfig,
 			qtConfig,
@@ -255,6 +287,8 @@ func (s *EnvironmentConfigSet) Render(outputDir string, pretty, validate bool) e
 		path.Join(outputDir, stringOrDefault(s.DBCleanerConfigPath, "job_db_cleaner_config.json")):                                      renderJSON(dbcConfig, pretty),
 		path.Join(outputDir, stringOrDefault(s.SearchDataIndexSchedulerConfigPath, "job_search_data_index_scheduler_config.json")):      renderJSON(sdisConfig, pretty),
 		path.Join(outputDir, stringOrDefault(s.MobileNotificationSchedulerConfigPath, "job_mobile_notification_scheduler_config.json")): renderJSON(mnsConfig, pretty),
+		path.Join(outputDir, stringOrDefault(s.NotificationDigestSenderConfigPath, "job_notification_digest_sender_config.json")):       renderJSON(ndsConfig, pretty),
+		path.Join(outputDir, stringOrDefault(s.WebhookDeliveryRetrierConfigPath, "job_webhook_delivery_retrier_config.json")):           renderJSON(wdrConfig, pretty),
 		path.Join(outputDir, stringOrDefault(s.AsyncMessageHandlerConfigPath, "async_message_handler_config.json")):                     renderJSON(amhConfig, pretty),
 		path.Join(outputDir, stringOrDefault(s.EmailDeliverabilityTestConfigPath, "job_email_deliverability_test_config.json")):         renderJSON(edtConfig, pretty),
 		path.Join(outputDir, stringOrDefault(s.QueueTestJobConfigPath, "job_queue_test_config.json")):                                   renderJSON(qtConfig, pretty),
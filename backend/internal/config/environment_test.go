@@ -185,8 +185,11 @@ func TestEnvironmentConfigSet_Render(T *testing.T) {
 			"job_meal_plan_finalizer_config.json",
 			"job_meal_plan_grocery_list_initializer_config.json",
 			"job_meal_plan_task_creator_config.json",
+			"job_meal_plan_template_instantiator_config.json",
 			"job_search_data_index_scheduler_config.json",
 			"job_mobile_notification_scheduler_config.json",
+			"job_notification_digest_sender_config.json",
+			"job_webhook_delivery_retrier_config.json",
 			"async_message_handler_config.json",
 		}
 
@@ -55,6 +55,18 @@
 	// AnalyticsProxySourcesWebSegmentAPITokenEnvVarKey is the environment variable name to set to override `APIServiceConfig.Analytics.ProxySources.Web.Segment.APIToken`.
 	AnalyticsProxySourcesWebSegmentAPITokenEnvVarKey = "DINNER_DONE_BETTER_ANALYTICS_PROXY_SOURCES_WEB_SEGMENT_API_TOKEN"
 
+	// AuditLogChainCheckpointSigningKeyEnvVarKey is the environment variable name to set to override `DBCleanerConfig.AuditLogChain.CheckpointSigningKey`.
+	AuditLogChainCheckpointSigningKeyEnvVarKey = "DINNER_DONE_BETTER_AUDIT_LOG_CHAIN_CHECKPOINT_SIGNING_KEY"
+
+	// AuditLogChainCheckpointVerificationKeyEnvVarKey is the environment variable name to set to override `DBCleanerConfig.AuditLogChain.CheckpointVerificationKey`.
+	AuditLogChainCheckpointVerificationKeyEnvVarKey = "DINNER_DONE_BETTER_AUDIT_LOG_CHAIN_CHECKPOINT_VERIFICATION_KEY"
+
+	// AuditLogRetentionDefaultEnvVarKey is the environment variable name to set to override `DBCleanerConfig.AuditLogRetention.Default`.
+	AuditLogRetentionDefaultEnvVarKey = "DINNER_DONE_BETTER_AUDIT_LOG_RETENTION_DEFAULT"
+
+	// AuditLogRetentionResourceTypesEnvVarKey is the environment variable name to set to override `DBCleanerConfig.AuditLogRetention.ResourceTypes`.
+	AuditLogRetentionResourceTypesEnvVarKey = "DINNER_DONE_BETTER_AUDIT_LOG_RETENTION_RESOURCE_TYPES"
+
 	// AuthDebugEnvVarKey is the environment variable name to set to override `APIServiceConfig.Auth.Debug`.
 	AuthDebugEnvVarKey = "DINNER_DONE_BETTER_AUTH_DEBUG"
 
@@ -76,6 +88,24 @@
 	// AuthPasskeyRpOriginsEnvVarKey is the environment variable name to set to override `APIServiceConfig.Auth.Passkey.RPOrigins`.
 	AuthPasskeyRpOriginsEnvVarKey = "DINNER_DONE_BETTER_AUTH_PASSKEY_RP_ORIGINS"
 
+	// AuthRateLimitingDisabledEnvVarKey is the environment variable name to set to override `APIServiceConfig.Auth.RateLimiting.Disabled`.
+	AuthRateLimitingDisabledEnvVarKey = "DINNER_DONE_BETTER_AUTH_RATE_LIMITING_DISABLED"
+
+	// AuthRateLimitingProviderEnvVarKey is the environment variable name to set to override `APIServiceConfig.Auth.RateLimiting.Provider`.
+	AuthRateLimitingProviderEnvVarKey = "DINNER_DONE_BETTER_AUTH_RATE_LIMITING_PROVIDER"
+
+	// AuthRateLimitingRedisAddressesEnvVarKey is the environment variable name to set to override `APIServiceConfig.Auth.RateLimiting.Redis.Addresses`.
+	AuthRateLimitingRedisAddressesEnvVarKey = "DINNER_DONE_BETTER_AUTH_RATE_LIMITING_REDIS_ADDRESSES"
+
+	// AuthRateLimitingRedisPasswordEnvVarKey is the environment variable name to set to override `APIServiceConfig.Auth.RateLimiting.Redis.Password`.
+	AuthRateLimitingRedisPasswordEnvVarKey = "DINNER_DONE_BETTER_AUTH_RATE_LIMITING_REDIS_PASSWORD"
+
+	// AuthRateLimitingRedisUsernameEnvVarKey is the environment variable name to set to override `APIServiceConfig.Auth.RateLimiting.Redis.Username`.
+	AuthRateLimitingRedisUsernameEnvVarKey = "DINNER_DONE_BETTER_AUTH_RATE_LIMITING_REDIS_USERNAME"
+
+	// AuthRateLimitingTrustedProxiesEnvVarKey is the environment variable name to set to override `APIServiceConfig.Auth.RateLimiting.TrustedProxies`.
+	AuthRateLimitingTrustedProxiesEnvVarKey = "DINNER_DONE_BETTER_AUTH_RATE_LIMITING_TRUSTED_PROXIES"
+
 	// AuthSessionStoreProviderEnvVarKey is the environment variable name to set to override `APIServiceConfig.Auth.SessionStore.Provider`.
 	AuthSessionStoreProviderEnvVarKey = "DINNER_DONE_BETTER_AUTH_SESSION_STORE_PROVIDER"
 
@@ -460,6 +490,33 @@
 	// ObservabilityTracingTracingSpanCollectionProbabilityEnvVarKey is the environment variable name to set to override `APIServiceConfig.Observability.Tracing.SpanCollectionProbability`.
 	ObservabilityTracingTracingSpanCollectionProbabilityEnvVarKey = "DINNER_DONE_BETTER_OBSERVABILITY_TRACING_TRACING_SPAN_COLLECTION_PROBABILITY"
 
+	// PaymentsEntitlementsEnabledEnvVarKey is the environment variable name to set to override `MealPlanTemplateInstantiatorConfig.Payments.Entitlements.Enabled`.
+	PaymentsEntitlementsEnabledEnvVarKey = "DINNER_DONE_BETTER_PAYMENTS_ENTITLEMENTS_ENABLED"
+
+	// PaymentsEntitlementsFreeTierFeaturesEnvVarKey is the environment variable name to set to override `MealPlanTemplateInstantiatorConfig.Payments.Entitlements.FreeTierFeatures`.
+	PaymentsEntitlementsFreeTierFeaturesEnvVarKey = "DINNER_DONE_BETTER_PAYMENTS_ENTITLEMENTS_FREE_TIER_FEATURES"
+
+	// PaymentsEntitlementsFreeTierMaxAccountMembersEnvVarKey is the environment variable name to set to override `MealPlanTemplateInstantiatorConfig.Payments.Entitlements.FreeTierMaxAccountMembers`.
+	PaymentsEntitlementsFreeTierMaxAccountMembersEnvVarKey = "DINNER_DONE_BETTER_PAYMENTS_ENTITLEMENTS_FREE_TIER_MAX_ACCOUNT_MEMBERS"
+
+	// PaymentsEntitlementsFreeTierMaxMealPlansPerWeekEnvVarKey is the environment variable name to set to override `MealPlanTemplateInstantiatorConfig.Payments.Entitlements.FreeTierMaxMealPlansPerWeek`.
+	PaymentsEntitlementsFreeTierMaxMealPlansPerWeekEnvVarKey = "DINNER_DONE_BETTER_PAYMENTS_ENTITLEMENTS_FREE_TIER_MAX_MEAL_PLANS_PER_WEEK"
+
+	// PaymentsEntitlementsGracePeriodEnvVarKey is the environment variable name to set to override `MealPlanTemplateInstantiatorConfig.Payments.Entitlements.GracePeriod`.
+	PaymentsEntitlementsGracePeriodEnvVarKey = "DINNER_DONE_BETTER_PAYMENTS_ENTITLEMENTS_GRACE_PERIOD"
+
+	// PaymentsRevenuecatAPIKeyEnvVarKey is the environment variable name to set to override `MealPlanTemplateInstantiatorConfig.Payments.RevenueCat.APIKey`.
+	PaymentsRevenuecatAPIKeyEnvVarKey = "DINNER_DONE_BETTER_PAYMENTS_REVENUECAT_API_KEY"
+
+	// PaymentsRevenuecatWebhookAuthHeaderEnvVarKey is the environment variable name to set to override `MealPlanTemplateInstantiatorConfig.Payments.RevenueCat.WebhookAuthHeader`.
+	PaymentsRevenuecatWebhookAuthHeaderEnvVarKey = "DINNER_DONE_BETTER_PAYMENTS_REVENUECAT_WEBHOOK_AUTH_HEADER"
+
+	// PaymentsStripeAPIKeyEnvVarKey is the environment variable name to set to override `MealPlanTemplateInstantiatorConfig.Payments.Stripe.APIKey`.
+	PaymentsStripeAPIKeyEnvVarKey = "DINNER_DONE_BETTER_PAYMENTS_STRIPE_API_KEY"
+
+	// PaymentsStripeWebhookSecretEnvVarKey is the environment variable name to set to override `MealPlanTemplateInstantiatorConfig.Payments.Stripe.WebhookSecret`.
+	PaymentsStripeWebhookSecretEnvVarKey = "DINNER_DONE_BETTER_PAYMENTS_STRIPE_WEBHOOK_SECRET"
+
 	// PushNotificationsApnsAuthKeyPathEnvVarKey is the environment variable name to set to override `APIServiceConfig.PushNotifications.APNs.AuthKeyPath`.
 	PushNotificationsApnsAuthKeyPathEnvVarKey = "DINNER_DONE_BETTER_PUSH_NOTIFICATIONS_APNS_AUTH_KEY_PATH"
 
@@ -553,6 +610,12 @@
 	// SearchProviderEnvVarKey is the environment variable name to set to override `APIServiceConfig.TextSearch.Provider`.
 	SearchProviderEnvVarKey = "DINNER_DONE_BETTER_SEARCH_PROVIDER"
 
+	// ServiceAuditLogChainCheckpointSigningKeyEnvVarKey is the environment variable name to set to override `APIServiceConfig.Services.AuditLogChain.CheckpointSigningKey`.
+	ServiceAuditLogChainCheckpointSigningKeyEnvVarKey = "DINNER_DONE_BETTER_SERVICE_AUDIT_LOG_CHAIN_CHECKPOINT_SIGNING_KEY"
+
+	// ServiceAuditLogChainCheckpointVerificationKeyEnvVarKey is the environment variable name to set to override `APIServiceConfig.Services.AuditLogChain.CheckpointVerificationKey`.
+	ServiceAuditLogChainCheckpointVerificationKeyEnvVarKey = "DINNER_DONE_BETTER_SERVICE_AUDIT_LOG_CHAIN_CHECKPOINT_VERIFICATION_KEY"
+
 	// ServiceAuthDebugEnvVarKey is the environment variable name to set to override `APIServiceConfig.Services.Auth.Debug`.
 	ServiceAuthDebugEnvVarKey = "DINNER_DONE_BETTER_SERVICE_AUTH_DEBUG"
 
@@ -580,6 +643,15 @@
 	// ServiceAuthOauth2RefreshTokenLifespanEnvVarKey is the environment variable name to set to override `APIServiceConfig.Services.Auth.OAuth2.RefreshTokenLifespan`.
 	ServiceAuthOauth2RefreshTokenLifespanEnvVarKey = "DINNER_DONE_BETTER_SERVICE_AUTH_OAUTH2REFRESH_TOKEN_LIFESPAN"
 
+	// ServiceAuthOidcIDTokenLifespanEnvVarKey is the environment variable name to set to override `APIServiceConfig.Services.Auth.OIDC.IDTokenLifespan`.
+	ServiceAuthOidcIDTokenLifespanEnvVarKey = "DINNER_DONE_BETTER_SERVICE_AUTH_OIDC_ID_TOKEN_LIFESPAN"
+
+	// ServiceAuthOidcIssuerEnvVarKey is the environment variable name to set to override `APIServiceConfig.Services.Auth.OIDC.Issuer`.
+	ServiceAuthOidcIssuerEnvVarKey = "DINNER_DONE_BETTER_SERVICE_AUTH_OIDC_ISSUER"
+
+	// ServiceAuthOidcSigningKeyRotationIntervalEnvVarKey is the environment variable name to set to override `APIServiceConfig.Services.Auth.OIDC.SigningKeyRotationInterval`.
+	ServiceAuthOidcSigningKeyRotationIntervalEnvVarKey = "DINNER_DONE_BETTER_SERVICE_AUTH_OIDC_SIGNING_KEY_ROTATION_INTERVAL"
+
 	// ServiceAuthTokensAudienceEnvVarKey is the environment variable name to set to override `APIServiceConfig.Services.Auth.Tokens.Audience`.
 	ServiceAuthTokensAudienceEnvVarKey = "DINNER_DONE_BETTER_SERVICE_AUTH_TOKENS_AUDIENCE"
 
@@ -724,6 +796,21 @@
 	// ServiceOauth2ClientsCreationDisabledEnvVarKey is the environment variable name to set to override `APIServiceConfig.Services.OAuth2Clients.OAuth2ClientCreationDisabled`.
 	ServiceOauth2ClientsCreationDisabledEnvVarKey = "DINNER_DONE_BETTER_SERVICE_OAUTH2_CLIENTS_CREATION_DISABLED"
 
+	// ServicePaymentsEntitlementsEnabledEnvVarKey is the environment variable name to set to override `APIServiceConfig.Services.Payments.Entitlements.Enabled`.
+	ServicePaymentsEntitlementsEnabledEnvVarKey = "DINNER_DONE_BETTER_SERVICE_PAYMENTS_ENTITLEMENTS_ENABLED"
+
+	// ServicePaymentsEntitlementsFreeTierFeaturesEnvVarKey is the environment variable name to set to override `APIServiceConfig.Services.Payments.Entitlements.FreeTierFeatures`.
+	ServicePaymentsEntitlementsFreeTierFeaturesEnvVarKey = "DINNER_DONE_BETTER_SERVICE_PAYMENTS_ENTITLEMENTS_FREE_TIER_FEATURES"
+
+	// ServicePaymentsEntitlementsFreeTierMaxAccountMembersEnvVarKey is the environment variable name to set to override `APIServiceConfig.Services.Payments.Entitlements.FreeTierMaxAccountMembers`.
+	ServicePaymentsEntitlementsFreeTierMaxAccountMembersEnvVarKey = "DINNER_DONE_BETTER_SERVICE_PAYMENTS_ENTITLEMENTS_FREE_TIER_MAX_ACCOUNT_MEMBERS"
+
+	// ServicePaymentsEntitlementsFreeTierMaxMealPlansPerWeekEnvVarKey is the environment variable name to set to override `APIServiceConfig.Services.Payments.Entitlements.FreeTierMaxMealPlansPerWeek`.
+	ServicePaymentsEntitlementsFreeTierMaxMealPlansPerWeekEnvVarKey = "DINNER_DONE_BETTER_SERVICE_PAYMENTS_ENTITLEMENTS_FREE_TIER_MAX_MEAL_PLANS_PER_WEEK"
+
+	// ServicePaymentsEntitlementsGracePeriodEnvVarKey is the environment variable name to set to override `APIServiceConfig.Services.Payments.Entitlements.GracePeriod`.
+	ServicePaymentsEntitlementsGracePeriodEnvVarKey = "DINNER_DONE_BETTER_SERVICE_PAYMENTS_ENTITLEMENTS_GRACE_PERIOD"
+
 	// ServicePaymentsRevenuecatAPIKeyEnvVarKey is the environment variable name to set to override `APIServiceConfig.Services.Payments.RevenueCat.APIKey`.
 	ServicePaymentsRevenuecatAPIKeyEnvVarKey = "DINNER_DONE_BETTER_SERVICE_PAYMENTS_REVENUECAT_API_KEY"
 
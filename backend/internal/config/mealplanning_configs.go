@@ -7,10 +7,13 @@
 	"context"
 	"fmt"
 
+	paymentscfg "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/services/payments/config"
+
 	analyticscfg "github.com/primandproper/platform/analytics/config"
 	databasecfg "github.com/primandproper/platform/database/config"
 	msgconfig "github.com/primandproper/platform/messagequeue/config"
 	"github.com/primandproper/platform/observability"
+	textsearchcfg "github.com/primandproper/platform/search/text/config"
 
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 	"github.com/hashicorp/go-multierror"
@@ -38,6 +41,18 @@
 		Database      databasecfg.Config     `envPrefix:"DATABASE_"      json:"database"`
 	}
 
+	// MealPlanTemplateInstantiatorConfig configures an instance of the meal plan template instantiator job.
+	MealPlanTemplateInstantiatorConfig struct {
+		_ struct{} `json:"-"`
+
+		Queues        msgconfig.QueuesConfig `envPrefix:"QUEUES_"        json:"queues"`
+		Events        msgconfig.Config       `envPrefix:"EVENTS_"        json:"events"`
+		Observability observability.Config   `envPrefix:"OBSERVABILITY_" json:"observability"`
+		Search        textsearchcfg.Config   `envPrefix:"SEARCH_"        json:"search"`
+		Payments      paymentscfg.Config     `envPrefix:"PAYMENTS_"      json:"payments"`
+		Database      databasecfg.Config     `envPrefix:"DATABASE_"      json:"database"`
+	}
+
 	// MealPlanTaskCreatorConfig configures an instance of the meal plan task creator job.
 	MealPlanTaskCreatorConfig struct {
 		_ struct{} `json:"-"`
@@ -93,6 +108,29 @@ func (cfg *MealPlanGroceryListInitializerConfig) ValidateWithContext(ctx context
 	return result.ErrorOrNil()
 }
 
+var _ validation.ValidatableWithContext = (*MealPlanTemplateInstantiatorConfig)(nil)
+
+// ValidateWithContext validates a MealPlanTemplateInstantiatorConfig struct.
+func (cfg *MealPlanTemplateInstantiatorConfig) ValidateWithContext(ctx context.Context) error {
+	result := &multierror.Error{}
+
+	validators := map[string]func(context.Context) error{
+		"Observability": cfg.Observability.ValidateWithContext,
+		"Database":      cfg.Database.ValidateWithContext,
+		"Queues":        cfg.Queues.ValidateWithContext,
+		"TextSearch":    cfg.Search.ValidateWithContext,
+		"Payments":      cfg.Payments.ValidateWithContext,
+	}
+
+	for name, validator := range validators {
+		if err := validator(ctx); err != nil {
+			result = multierror.Append(fmt.Errorf("error validating %s config: %w", name, err), result)
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
 var _ validation.ValidatableWithContext = (*MealPlanTaskCreatorConfig)(nil)
 
 // ValidateWithContext validates a MealPlanTaskCreatorConfig struct.
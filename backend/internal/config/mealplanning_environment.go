@@ -13,6 +13,7 @@
 	mpfConfigObservabilityServiceName   = "meal_plan_finalizer"
 	mpgliConfigObservabilityServiceName = "meal_plan_grocery_list_initializer"
 	mptcConfigObservabilityServiceName  = "meal_plan_task_creator"
+	mptiConfigObservabilityServiceName  = "meal_plan_template_instantiator"
 )
 
 // renderMealPlanningConfigs creates, configures, and returns the meal planning worker configs
@@ -56,12 +57,27 @@ func (s *EnvironmentConfigSet) renderMealPlanningConfigs(outputDir string, prett
 	mptcConfig.Observability.Profiling.ServiceName = mptcConfigObservabilityServiceName
 	disableWorkerOtelMetrics(&mptcConfig.Observability)
 
-	configs = []validation.ValidatableWithContext{mpfConfig, mpgliConfig, mptcConfig}
+	mptiConfig := &MealPlanTemplateInstantiatorConfig{
+		Observability: s.RootConfig.Observability,
+		Events:        s.RootConfig.Events,
+		Search:        s.RootConfig.TextSearch,
+		Payments:      s.RootConfig.Services.Payments,
+		Database:      databaseConfigForService(&s.RootConfig.Database, s.ServiceDatabaseUsers, mptiConfigObservabilityServiceName),
+		Queues:        s.RootConfig.Queues,
+	}
+	mptiConfig.Observability.Tracing.ServiceName = mptiConfigObservabilityServiceName
+	mptiConfig.Observability.Metrics.ServiceName = mptiConfigObservabilityServiceName
+	mptiConfig.Observability.Logging.ServiceName = mptiConfigObservabilityServiceName
+	mptiConfig.Observability.Profiling.ServiceName = mptiConfigObservabilityServiceName
+	disableWorkerOtelMetrics(&mptiConfig.Observability)
+
+	configs = []validation.ValidatableWithContext{mpfConfig, mpgliConfig, mptcConfig, mptiConfig}
 
 	files = map[string][]byte{
 		path.Join(outputDir, stringOrDefault(s.MealPlanFinalizerConfigPath, "job_meal_plan_finalizer_config.json")):                             renderJSON(mpfConfig, pretty),
 		path.Join(outputDir, stringOrDefault(s.MealPlanGroceryListInitializerConfigPath, "job_meal_plan_grocery_list_initializer_config.json")): renderJSON(mpgliConfig, pretty),
 		path.Join(outputDir, stringOrDefault(s.MealPlanTaskCreatorConfigPath, "job_meal_plan_task_creator_config.json")):                        renderJSON(mptcConfig, pretty),
+		path.Join(outputDir, stringOrDefault(s.MealPlanTemplateInstantiatorConfigPath, "job_meal_plan_template_instantiator_config.json")):      renderJSON(mptiConfig, pretty),
 	}
 
 	return configs, files
@@ -4,6 +4,7 @@
 	"context"
 	"fmt"
 
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/audit"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/services/auth/handlers/authentication"
 	dataprivacycfg "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/services/dataprivacy/config"
 	identitycfg "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/services/identity/config"
@@ -20,13 +21,14 @@
 	ServicesConfig struct {
 		_ struct{} `json:"-"`
 
-		Payments      paymentscfg.Config      `envPrefix:"PAYMENTS_"       json:"payments"`
-		Users         identitycfg.Config      `envPrefix:"USERS_"          json:"users"`
-		DataPrivacy   dataprivacycfg.Config   `envPrefix:"DATA_PRIVACY_"   json:"dataPrivacy"`
-		UploadedMedia uploadedmediacfg.Config `envPrefix:"UPLOADED_MEDIA_" json:"uploadedMedia"`
-		MealPlanning  mealplanningcfg.Config  `envPrefix:"MEAL_PLANNING_"  json:"mealPlanning"`
-		Auth          authentication.Config   `envPrefix:"AUTH_"           json:"auth"`
-		OAuth2Clients oauthcfg.Config         `envPrefix:"OAUTH2_CLIENTS_" json:"oauth2Clients"`
+		Payments      paymentscfg.Config      `envPrefix:"PAYMENTS_"        json:"payments"`
+		Users         identitycfg.Config      `envPrefix:"USERS_"           json:"users"`
+		DataPrivacy   dataprivacycfg.Config   `envPrefix:"DATA_PRIVACY_"    json:"dataPrivacy"`
+		UploadedMedia uploadedmediacfg.Config `envPrefix:"UPLOADED_MEDIA_"  json:"uploadedMedia"`
+		MealPlanning  mealplanningcfg.Config  `envPrefix:"MEAL_PLANNING_"   json:"mealPlanning"`
+		Auth          authentication.Config   `envPrefix:"AUTH_"            json:"auth"`
+		OAuth2Clients oauthcfg.Config         `envPrefix:"OAUTH2_CLIENTS_"  json:"oauth2Clients"`
+		AuditLogChain audit.ChainConfig       `envPrefix:"AUDIT_LOG_CHAIN_" json:"auditLogChain"`
 	}
 )
 
@@ -39,6 +41,8 @@ func (cfg *ServicesConfig) ValidateWithContext(ctx context.Context) error {
 		"DataPrivacy":   cfg.DataPrivacy.ValidateWithContext,
 		"MealPlanning":  cfg.MealPlanning.ValidateWithContext,
 		"OAuth2Clients": cfg.OAuth2Clients.ValidateWithContext,
+		"Payments":      cfg.Payments.ValidateWithContext,
+		"AuditLogChain": cfg.AuditLogChain.ValidateWithContext,
 	}
 
 	for name, validator := range validatorsToRun {
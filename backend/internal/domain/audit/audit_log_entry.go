@@ -6,6 +6,8 @@
 
 	"github.com/primandproper/platform/database"
 	"github.com/primandproper/platform/database/filtering"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
 )
 
 const (
@@ -16,6 +18,16 @@
 	AuditLogEventTypeDeleted  = "deleted"
 )
 
+var (
+	auditLogEventTypes = []any{
+		AuditLogEventTypeOther,
+		AuditLogEventTypeCreated,
+		AuditLogEventTypeUpdated,
+		AuditLogEventTypeArchived,
+		AuditLogEventTypeDeleted,
+	}
+)
+
 type (
 	ChangeLog struct {
 		OldValue string `json:"oldValue"`
@@ -28,11 +40,16 @@
 		CreatedAt        time.Time             `json:"createdAt"`
 		Changes          map[string]*ChangeLog `json:"changes"`
 		BelongsToAccount *string               `json:"belongsToAccount"`
+		RedactedAt       *time.Time            `json:"redactedAt,omitempty"`
 		ID               string                `json:"id"`
 		ResourceType     string                `json:"resourceType"`
 		RelevantID       string                `json:"relevantID"`
 		EventType        string                `json:"eventType"`
 		BelongsToUser    string                `json:"belongsToUser"`
+		PreviousHash     string                `json:"previousHash,omitempty"`
+		ChangesHash      string                `json:"changesHash,omitempty"`
+		Hash             string                `json:"hash,omitempty"`
+		Sequence         uint64                `json:"sequence,omitempty"`
 	}
 
 	AuditLogEntryDatabaseCreationInput struct {
@@ -47,6 +64,18 @@
 		BelongsToUser    string                `json:"-"`
 	}
 
+	// AuditLogEntrySearchInput narrows a search of an account's audit log. Empty fields match everything;
+	// the time range comes from the accompanying query filter's CreatedAfter and CreatedBefore.
+	AuditLogEntrySearchInput struct {
+		_ struct{} `json:"-"`
+
+		ResourceTypes []string `json:"resourceTypes"`
+		EventTypes    []string `json:"eventTypes"`
+		BelongsToUser string   `json:"belongsToUser"`
+		RelevantID    string   `json:"relevantID"`
+		ChangedField  string   `json:"changedField"`
+	}
+
 	// AuditLogEntryDataManager describes a structure capable of storing audit log entries.
 	AuditLogEntryDataManager interface {
 		GetAuditLogEntry(ctx context.Context, auditLogID string) (*AuditLogEntry, error)
@@ -54,6 +83,17 @@
 		GetAuditLogEntriesForUserAndResourceTypes(ctx context.Context, userID string, resourceTypes []string, filter *filtering.QueryFilter) (*filtering.QueryFilteredResult[AuditLogEntry], error)
 		GetAuditLogEntriesForAccount(ctx context.Context, accountID string, filter *filtering.QueryFilter) (*filtering.QueryFilteredResult[AuditLogEntry], error)
 		GetAuditLogEntriesForAccountAndResourceTypes(ctx context.Context, accountID string, resourceTypes []string, filter *filtering.QueryFilter) (*filtering.QueryFilteredResult[AuditLogEntry], error)
+		SearchAuditLogEntriesForAccount(ctx context.Context, accountID string, input *AuditLogEntrySearchInput, filter *filtering.QueryFilter) (*filtering.QueryFilteredResult[AuditLogEntry], error)
+		ExportAuditLogEntriesForAccount(ctx context.Context, accountID string, input *AuditLogEntrySearchInput, filter *filtering.QueryFilter, afterID string, limit uint8) ([]*AuditLogEntry, error)
 		CreateAuditLogEntry(ctx context.Context, querier database.SQLQueryExecutor, input *AuditLogEntryDatabaseCreationInput) (*AuditLogEntry, error)
 	}
 )
+
+var _ validation.ValidatableWithContext = (*AuditLogEntrySearchInput)(nil)
+
+// ValidateWithContext validates an AuditLogEntrySearchInput.
+func (x *AuditLogEntrySearchInput) ValidateWithContext(ctx context.Context) error {
+	return validation.ValidateStructWithContext(ctx, x,
+		validation.Field(&x.EventTypes, validation.Each(validation.In(auditLogEventTypes...))),
+	)
+}
@@ -0,0 +1,231 @@
+package audit
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/primandproper/platform/pointer"
+)
+
+const (
+	// chainHashVersion is mixed into every hash and signature so the encoding can change without ambiguity.
+	chainHashVersion = "v1"
+	// chainVerificationPageSize is how many entries VerifyChain reads at once.
+	chainVerificationPageSize uint8 = 250
+
+	// ChainBreakReasonSequenceGap means an entry is missing from the chain.
+	ChainBreakReasonSequenceGap = "sequence_gap"
+	// ChainBreakReasonPreviousHashMismatch means an entry doesn't point at the entry before it.
+	ChainBreakReasonPreviousHashMismatch = "previous_hash_mismatch"
+	// ChainBreakReasonChangesHashMismatch means an entry's changes were altered after it was written.
+	ChainBreakReasonChangesHashMismatch = "changes_hash_mismatch"
+	// ChainBreakReasonHashMismatch means an entry's contents were altered after it was written.
+	ChainBreakReasonHashMismatch = "hash_mismatch"
+	// ChainBreakReasonCheckpointMismatch means the chain no longer matches a signed checkpoint.
+	ChainBreakReasonCheckpointMismatch = "checkpoint_mismatch"
+	// ChainBreakReasonCheckpointEntryMissing means a checkpointed entry is no longer in the chain.
+	ChainBreakReasonCheckpointEntryMissing = "checkpoint_entry_missing"
+	// ChainBreakReasonInvalidCheckpointSignature means a checkpoint wasn't signed by the verification key.
+	ChainBreakReasonInvalidCheckpointSignature = "invalid_checkpoint_signature"
+)
+
+type (
+	// AuditLogChainHead is the most recent entry in an account's audit log chain.
+	AuditLogChainHead struct {
+		_ struct{} `json:"-"`
+
+		BelongsToAccount string `json:"belongsToAccount"`
+		Hash             string `json:"hash"`
+		Sequence         uint64 `json:"sequence"`
+	}
+
+	// ChainBreak describes where an account's audit log chain first fails verification.
+	ChainBreak struct {
+		_ struct{} `json:"-"`
+
+		EntryID      string `json:"entryID,omitempty"`
+		CheckpointID string `json:"checkpointID,omitempty"`
+		Reason       string `json:"reason"`
+		Sequence     uint64 `json:"sequence"`
+	}
+
+	// ChainVerificationResult is the outcome of walking an account's audit log chain.
+	ChainVerificationResult struct {
+		_ struct{} `json:"-"`
+
+		FirstBrokenLink      *ChainBreak `json:"firstBrokenLink,omitempty"`
+		AccountID            string      `json:"accountID"`
+		EntriesChecked       uint64      `json:"entriesChecked"`
+		CheckpointsChecked   uint64      `json:"checkpointsChecked"`
+		LastVerifiedSequence uint64      `json:"lastVerifiedSequence"`
+		SignaturesVerified   bool        `json:"signaturesVerified"`
+	}
+
+	// AuditLogChainDataManager describes a structure capable of reading and checkpointing audit log chains.
+	AuditLogChainDataManager interface {
+		GetAuditLogChainEntries(ctx context.Context, accountID string, afterSequence uint64, limit uint8) ([]*AuditLogEntry, error)
+		GetAuditLogCheckpointsForAccount(ctx context.Context, accountID string) ([]*AuditLogCheckpoint, error)
+		GetUncheckpointedAuditLogChainHeads(ctx context.Context) ([]*AuditLogChainHead, error)
+		CreateAuditLogCheckpoint(ctx context.Context, input *AuditLogCheckpointDatabaseCreationInput) (*AuditLogCheckpoint, error)
+	}
+)
+
+// Intact returns whether the whole chain verified.
+func (x *ChainVerificationResult) Intact() bool {
+	return x.FirstBrokenLink == nil
+}
+
+// ComputeChangesHash returns the hex-encoded SHA-256 digest of a change set.
+// encoding/json sorts map keys, so equal change sets always hash the same.
+func ComputeChangesHash(changes map[string]*ChangeLog) (string, error) {
+	if changes == nil {
+		changes = map[string]*ChangeLog{}
+	}
+
+	encoded, err := json.Marshal(changes)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(encoded)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// ComputeHash returns the hash that links an entry to the one before it. It covers every field except the
+// changes themselves, which are represented by ChangesHash so that redacting them doesn't break the chain.
+func (x *AuditLogEntry) ComputeHash() string {
+	h := sha256.New()
+	for _, field := range []string{
+		chainHashVersion,
+		x.PreviousHash,
+		strconv.FormatUint(x.Sequence, 10),
+		x.ID,
+		x.CreatedAt.UTC().Format(time.RFC3339Nano),
+		x.ResourceType,
+		x.RelevantID,
+		x.EventType,
+		x.BelongsToUser,
+		pointer.Dereference(x.BelongsToAccount),
+		x.ChangesHash,
+	} {
+		// length prefixes keep one field from bleeding into the next.
+		fmt.Fprintf(h, "%d:%s", len(field), field)
+	}
+
+	return hex.EncodeToString(h.Sum(nil))
+}
+
+// VerifyChain walks an account's audit log chain from its first entry, checking every link against the one
+// before it and against the account's checkpoints, and stops at the first failure. Checkpoint signatures are
+// only checked when a verification key is provided.
+func VerifyChain(ctx context.Context, dataManager AuditLogChainDataManager, accountID string, verificationKey ed25519.PublicKey) (*ChainVerificationResult, error) {
+	result := &ChainVerificationResult{
+		AccountID:          accountID,
+		SignaturesVerified: verificationKey != nil,
+	}
+
+	checkpoints, err := dataManager.GetAuditLogCheckpointsForAccount(ctx, accountID)
+	if err != nil {
+		return nil, fmt.Errorf("fetching audit log checkpoints: %w", err)
+	}
+
+	checkpointsBySequence := map[uint64]*AuditLogCheckpoint{}
+	for _, checkpoint := range checkpoints {
+		if verificationKey != nil && !checkpoint.VerifySignature(verificationKey) {
+			result.FirstBrokenLink = &ChainBreak{
+				CheckpointID: checkpoint.ID,
+				Sequence:     checkpoint.Sequence,
+				Reason:       ChainBreakReasonInvalidCheckpointSignature,
+			}
+			return result, nil
+		}
+		checkpointsBySequence[checkpoint.Sequence] = checkpoint
+	}
+
+	var previous *AuditLogEntry
+	for {
+		entries, fetchErr := dataManager.GetAuditLogChainEntries(ctx, accountID, result.LastVerifiedSequence, chainVerificationPageSize)
+		if fetchErr != nil {
+			return nil, fmt.Errorf("fetching audit log chain entries: %w", fetchErr)
+		}
+
+		for _, entry := range entries {
+			reason := checkChainLink(previous, entry)
+			if checkpoint, ok := checkpointsBySequence[entry.Sequence]; reason == "" && ok {
+				if checkpoint.EntryHash != entry.Hash {
+					reason = ChainBreakReasonCheckpointMismatch
+				} else {
+					result.CheckpointsChecked++
+				}
+			}
+
+			if reason != "" {
+				result.FirstBrokenLink = &ChainBreak{
+					EntryID:  entry.ID,
+					Sequence: entry.Sequence,
+					Reason:   reason,
+				}
+				return result, nil
+			}
+
+			previous = entry
+			result.EntriesChecked++
+			result.LastVerifiedSequence = entry.Sequence
+		}
+
+		if len(entries) < int(chainVerificationPageSize) {
+			break
+		}
+	}
+
+	// a checkpoint beyond the end of the chain means entries were removed from its tail.
+	for _, checkpoint := range checkpoints {
+		if checkpoint.Sequence > result.LastVerifiedSequence {
+			result.FirstBrokenLink = &ChainBreak{
+				CheckpointID: checkpoint.ID,
+				Sequence:     checkpoint.Sequence,
+				Reason:       ChainBreakReasonCheckpointEntryMissing,
+			}
+			return result, nil
+		}
+	}
+
+	return result, nil
+}
+
+// checkChainLink returns why an entry doesn't follow the previous one, or an empty string if it does.
+func checkChainLink(previous, entry *AuditLogEntry) string {
+	expectedSequence, expectedPreviousHash := uint64(1), ""
+	if previous != nil {
+		expectedSequence, expectedPreviousHash = previous.Sequence+1, previous.Hash
+	}
+
+	if entry.Sequence != expectedSequence {
+		return ChainBreakReasonSequenceGap
+	}
+
+	if entry.PreviousHash != expectedPreviousHash {
+		return ChainBreakReasonPreviousHashMismatch
+	}
+
+	// redacted entries have had their changes dropped on purpose, so only their stored digest is left to check.
+	if entry.RedactedAt != nil {
+		if len(entry.Changes) > 0 {
+			return ChainBreakReasonChangesHashMismatch
+		}
+	} else if changesHash, err := ComputeChangesHash(entry.Changes); err != nil || changesHash != entry.ChangesHash {
+		return ChainBreakReasonChangesHashMismatch
+	}
+
+	if entry.ComputeHash() != entry.Hash {
+		return ChainBreakReasonHashMismatch
+	}
+
+	return ""
+}
@@ -0,0 +1,342 @@
+package audit
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// inMemoryChain is an AuditLogChainDataManager backed by slices.
+type inMemoryChain struct {
+	fetchErr    error
+	entries     []*AuditLogEntry
+	checkpoints []*AuditLogCheckpoint
+}
+
+func (c *inMemoryChain) GetAuditLogChainEntries(_ context.Context, _ string, afterSequence uint64, limit uint8) ([]*AuditLogEntry, error) {
+	if c.fetchErr != nil {
+		return nil, c.fetchErr
+	}
+
+	var out []*AuditLogEntry
+	for _, entry := range c.entries {
+		if entry.Sequence > afterSequence && len(out) < int(limit) {
+			out = append(out, entry)
+		}
+	}
+
+	return out, nil
+}
+
+func (c *inMemoryChain) GetAuditLogCheckpointsForAccount(context.Context, string) ([]*AuditLogCheckpoint, error) {
+	return c.checkpoints, nil
+}
+
+func (c *inMemoryChain) GetUncheckpointedAuditLogChainHeads(context.Context) ([]*AuditLogChainHead, error) {
+	return nil, nil
+}
+
+func (c *inMemoryChain) CreateAuditLogCheckpoint(context.Context, *AuditLogCheckpointDatabaseCreationInput) (*AuditLogCheckpoint, error) {
+	return nil, nil
+}
+
+func buildTestChain(t *testing.T, accountID string, length int) []*AuditLogEntry {
+	t.Helper()
+
+	var (
+		chain    []*AuditLogEntry
+		previous string
+	)
+
+	for i := range length {
+		entry := &AuditLogEntry{
+			CreatedAt:        time.Date(2024, time.March, 1, 12, 0, i, 0, time.UTC),
+			BelongsToAccount: &accountID,
+			Changes: map[string]*ChangeLog{
+				"name": {OldValue: fmt.Sprintf("old_%d", i), NewValue: fmt.Sprintf("new_%d", i)},
+			},
+			ID:            fmt.Sprintf("entry_%d", i),
+			ResourceType:  "recipes",
+			RelevantID:    "recipe_id",
+			EventType:     AuditLogEventTypeUpdated,
+			BelongsToUser: "user_id",
+			Sequence:      uint64(i + 1),
+			PreviousHash:  previous,
+		}
+
+		var err error
+		entry.ChangesHash, err = ComputeChangesHash(entry.Changes)
+		require.NoError(t, err)
+		entry.Hash = entry.ComputeHash()
+
+		previous = entry.Hash
+		chain = append(chain, entry)
+	}
+
+	return chain
+}
+
+func buildTestCheckpoint(t *testing.T, signer *CheckpointSigner, entry *AuditLogEntry) *AuditLogCheckpoint {
+	t.Helper()
+
+	input := signer.Sign(&AuditLogChainHead{
+		BelongsToAccount: *entry.BelongsToAccount,
+		Hash:             entry.Hash,
+		Sequence:         entry.Sequence,
+	})
+
+	return &AuditLogCheckpoint{
+		ID:               input.ID,
+		BelongsToAccount: input.BelongsToAccount,
+		EntryHash:        input.EntryHash,
+		Signature:        input.Signature,
+		KeyID:            input.KeyID,
+		Sequence:         input.Sequence,
+	}
+}
+
+func buildTestSigner(t *testing.T) (*CheckpointSigner, ed25519.PublicKey) {
+	t.Helper()
+
+	publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+
+	return NewCheckpointSigner(privateKey), publicKey
+}
+
+func TestComputeChangesHash(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		a, err := ComputeChangesHash(map[string]*ChangeLog{
+			"name":        {OldValue: "a", NewValue: "b"},
+			"description": {OldValue: "c", NewValue: "d"},
+		})
+		require.NoError(t, err)
+
+		b, err := ComputeChangesHash(map[string]*ChangeLog{
+			"description": {OldValue: "c", NewValue: "d"},
+			"name":        {OldValue: "a", NewValue: "b"},
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, a, b)
+	})
+
+	T.Run("with nil changes", func(t *testing.T) {
+		t.Parallel()
+
+		a, err := ComputeChangesHash(nil)
+		require.NoError(t, err)
+
+		b, err := ComputeChangesHash(map[string]*ChangeLog{})
+		require.NoError(t, err)
+
+		assert.Equal(t, a, b)
+	})
+}
+
+func TestAuditLogEntry_ComputeHash(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		entry := buildTestChain(t, "account_id", 1)[0]
+		expected := entry.ComputeHash()
+
+		entry.RelevantID = "some_other_recipe_id"
+		assert.NotEqual(t, expected, entry.ComputeHash())
+	})
+
+	T.Run("is unambiguous across field boundaries", func(t *testing.T) {
+		t.Parallel()
+
+		a := buildTestChain(t, "account_id", 1)[0]
+		b := buildTestChain(t, "account_id", 1)[0]
+		a.ResourceType, a.RelevantID = "recipes", "_id"
+		b.ResourceType, b.RelevantID = "recipes_", "id"
+
+		assert.NotEqual(t, a.ComputeHash(), b.ComputeHash())
+	})
+}
+
+func TestVerifyChain(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		signer, publicKey := buildTestSigner(t)
+		entries := buildTestChain(t, "account_id", int(chainVerificationPageSize)+3)
+		chain := &inMemoryChain{
+			entries: entries,
+			checkpoints: []*AuditLogCheckpoint{
+				buildTestCheckpoint(t, signer, entries[9]),
+				buildTestCheckpoint(t, signer, entries[len(entries)-1]),
+			},
+		}
+
+		result, err := VerifyChain(t.Context(), chain, "account_id", publicKey)
+		require.NoError(t, err)
+
+		assert.True(t, result.Intact())
+		assert.True(t, result.SignaturesVerified)
+		assert.Equal(t, uint64(len(entries)), result.EntriesChecked)
+		assert.Equal(t, uint64(len(entries)), result.LastVerifiedSequence)
+		assert.Equal(t, uint64(2), result.CheckpointsChecked)
+	})
+
+	T.Run("with empty chain", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := VerifyChain(t.Context(), &inMemoryChain{}, "account_id", nil)
+		require.NoError(t, err)
+
+		assert.True(t, result.Intact())
+		assert.False(t, result.SignaturesVerified)
+		assert.Zero(t, result.EntriesChecked)
+	})
+
+	T.Run("with redacted entry", func(t *testing.T) {
+		t.Parallel()
+
+		entries := buildTestChain(t, "account_id", 3)
+		redactedAt := time.Now()
+		entries[1].Changes = nil
+		entries[1].RedactedAt = &redactedAt
+
+		result, err := VerifyChain(t.Context(), &inMemoryChain{entries: entries}, "account_id", nil)
+		require.NoError(t, err)
+
+		assert.True(t, result.Intact())
+	})
+
+	T.Run("with altered changes", func(t *testing.T) {
+		t.Parallel()
+
+		entries := buildTestChain(t, "account_id", 3)
+		entries[1].Changes["name"].NewValue = "something else"
+
+		result, err := VerifyChain(t.Context(), &inMemoryChain{entries: entries}, "account_id", nil)
+		require.NoError(t, err)
+
+		require.False(t, result.Intact())
+		assert.Equal(t, ChainBreakReasonChangesHashMismatch, result.FirstBrokenLink.Reason)
+		assert.Equal(t, entries[1].ID, result.FirstBrokenLink.EntryID)
+		assert.Equal(t, uint64(1), result.LastVerifiedSequence)
+	})
+
+	T.Run("with altered entry", func(t *testing.T) {
+		t.Parallel()
+
+		entries := buildTestChain(t, "account_id", 3)
+		entries[2].BelongsToUser = "some_other_user"
+
+		result, err := VerifyChain(t.Context(), &inMemoryChain{entries: entries}, "account_id", nil)
+		require.NoError(t, err)
+
+		require.False(t, result.Intact())
+		assert.Equal(t, ChainBreakReasonHashMismatch, result.FirstBrokenLink.Reason)
+		assert.Equal(t, uint64(3), result.FirstBrokenLink.Sequence)
+	})
+
+	T.Run("with rehashed entry", func(t *testing.T) {
+		t.Parallel()
+
+		entries := buildTestChain(t, "account_id", 3)
+		entries[0].BelongsToUser = "some_other_user"
+		entries[0].Hash = entries[0].ComputeHash()
+
+		result, err := VerifyChain(t.Context(), &inMemoryChain{entries: entries}, "account_id", nil)
+		require.NoError(t, err)
+
+		require.False(t, result.Intact())
+		assert.Equal(t, ChainBreakReasonPreviousHashMismatch, result.FirstBrokenLink.Reason)
+		assert.Equal(t, entries[1].ID, result.FirstBrokenLink.EntryID)
+	})
+
+	T.Run("with deleted entry", func(t *testing.T) {
+		t.Parallel()
+
+		entries := buildTestChain(t, "account_id", 3)
+		entries = append(entries[:1], entries[2:]...)
+
+		result, err := VerifyChain(t.Context(), &inMemoryChain{entries: entries}, "account_id", nil)
+		require.NoError(t, err)
+
+		require.False(t, result.Intact())
+		assert.Equal(t, ChainBreakReasonSequenceGap, result.FirstBrokenLink.Reason)
+		assert.Equal(t, uint64(3), result.FirstBrokenLink.Sequence)
+	})
+
+	T.Run("with rewritten chain", func(t *testing.T) {
+		t.Parallel()
+
+		signer, publicKey := buildTestSigner(t)
+		entries := buildTestChain(t, "account_id", 3)
+		checkpoint := buildTestCheckpoint(t, signer, entries[2])
+
+		// rewriting every hash from the altered entry onwards hides the change from the links alone.
+		entries[1].BelongsToUser = "some_other_user"
+		entries[1].Hash = entries[1].ComputeHash()
+		entries[2].PreviousHash = entries[1].Hash
+		entries[2].Hash = entries[2].ComputeHash()
+
+		chain := &inMemoryChain{entries: entries, checkpoints: []*AuditLogCheckpoint{checkpoint}}
+		result, err := VerifyChain(t.Context(), chain, "account_id", publicKey)
+		require.NoError(t, err)
+
+		require.False(t, result.Intact())
+		assert.Equal(t, ChainBreakReasonCheckpointMismatch, result.FirstBrokenLink.Reason)
+		assert.Equal(t, uint64(3), result.FirstBrokenLink.Sequence)
+	})
+
+	T.Run("with truncated chain", func(t *testing.T) {
+		t.Parallel()
+
+		signer, publicKey := buildTestSigner(t)
+		entries := buildTestChain(t, "account_id", 3)
+		checkpoint := buildTestCheckpoint(t, signer, entries[2])
+
+		chain := &inMemoryChain{entries: entries[:2], checkpoints: []*AuditLogCheckpoint{checkpoint}}
+		result, err := VerifyChain(t.Context(), chain, "account_id", publicKey)
+		require.NoError(t, err)
+
+		require.False(t, result.Intact())
+		assert.Equal(t, ChainBreakReasonCheckpointEntryMissing, result.FirstBrokenLink.Reason)
+		assert.Equal(t, checkpoint.ID, result.FirstBrokenLink.CheckpointID)
+	})
+
+	T.Run("with forged checkpoint", func(t *testing.T) {
+		t.Parallel()
+
+		_, publicKey := buildTestSigner(t)
+		forger, _ := buildTestSigner(t)
+		entries := buildTestChain(t, "account_id", 3)
+
+		chain := &inMemoryChain{entries: entries, checkpoints: []*AuditLogCheckpoint{buildTestCheckpoint(t, forger, entries[2])}}
+		result, err := VerifyChain(t.Context(), chain, "account_id", publicKey)
+		require.NoError(t, err)
+
+		require.False(t, result.Intact())
+		assert.Equal(t, ChainBreakReasonInvalidCheckpointSignature, result.FirstBrokenLink.Reason)
+	})
+
+	T.Run("with error fetching entries", func(t *testing.T) {
+		t.Parallel()
+
+		result, err := VerifyChain(t.Context(), &inMemoryChain{fetchErr: errors.New("blah")}, "account_id", nil)
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
@@ -0,0 +1,184 @@
+package audit
+
+import (
+	"context"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/primandproper/platform/identifiers"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+var (
+	// ErrInvalidCheckpointKey is returned when a configured checkpoint key isn't a base64-encoded Ed25519 key.
+	ErrInvalidCheckpointKey = errors.New("checkpoint key must be a base64-encoded 32 byte Ed25519 key")
+)
+
+type (
+	// AuditLogCheckpoint is a signed record of an account's audit log chain head at a point in time.
+	// Anyone holding the verification key can confirm the chain up to Sequence hasn't been rewritten since.
+	AuditLogCheckpoint struct {
+		_ struct{} `json:"-"`
+
+		CreatedAt        time.Time `json:"createdAt"`
+		ID               string    `json:"id"`
+		BelongsToAccount string    `json:"belongsToAccount"`
+		EntryHash        string    `json:"entryHash"`
+		Signature        string    `json:"signature"`
+		KeyID            string    `json:"keyID"`
+		Sequence         uint64    `json:"sequence"`
+	}
+
+	AuditLogCheckpointDatabaseCreationInput struct {
+		_ struct{} `json:"-"`
+
+		ID               string `json:"-"`
+		BelongsToAccount string `json:"-"`
+		EntryHash        string `json:"-"`
+		Signature        string `json:"-"`
+		KeyID            string `json:"-"`
+		Sequence         uint64 `json:"-"`
+	}
+
+	// CheckpointSigner signs audit log chain heads.
+	CheckpointSigner struct {
+		privateKey ed25519.PrivateKey
+		keyID      string
+	}
+
+	// ChainConfig configures the keys used to sign and verify audit log checkpoints.
+	// Both keys are optional: without a signing key no checkpoints are written, and without
+	// a verification key chains are verified without checking checkpoint signatures.
+	ChainConfig struct {
+		_ struct{} `json:"-"`
+
+		// CheckpointSigningKey is a base64-encoded Ed25519 seed.
+		CheckpointSigningKey string `env:"CHECKPOINT_SIGNING_KEY"      json:"checkpointSigningKey,omitempty"`
+		// CheckpointVerificationKey is a base64-encoded Ed25519 public key.
+		CheckpointVerificationKey string `env:"CHECKPOINT_VERIFICATION_KEY" json:"checkpointVerificationKey,omitempty"`
+	}
+)
+
+// checkpointMessage is the message a checkpoint's signature covers.
+func checkpointMessage(accountID string, sequence uint64, entryHash string) []byte {
+	return fmt.Appendf(nil, "audit_log_checkpoint:%s:%s:%d:%s", chainHashVersion, accountID, sequence, entryHash)
+}
+
+// CheckpointKeyID returns a short, stable identifier for a verification key.
+func CheckpointKeyID(publicKey ed25519.PublicKey) string {
+	sum := sha256.Sum256(publicKey)
+	return hex.EncodeToString(sum[:8])
+}
+
+// NewCheckpointSigner builds a new CheckpointSigner.
+func NewCheckpointSigner(privateKey ed25519.PrivateKey) *CheckpointSigner {
+	publicKey, _ := privateKey.Public().(ed25519.PublicKey)
+
+	return &CheckpointSigner{
+		privateKey: privateKey,
+		keyID:      CheckpointKeyID(publicKey),
+	}
+}
+
+// Sign builds a checkpoint for the given chain head.
+func (s *CheckpointSigner) Sign(head *AuditLogChainHead) *AuditLogCheckpointDatabaseCreationInput {
+	signature := ed25519.Sign(s.privateKey, checkpointMessage(head.BelongsToAccount, head.Sequence, head.Hash))
+
+	return &AuditLogCheckpointDatabaseCreationInput{
+		ID:               identifiers.New(),
+		BelongsToAccount: head.BelongsToAccount,
+		EntryHash:        head.Hash,
+		Signature:        base64.StdEncoding.EncodeToString(signature),
+		KeyID:            s.keyID,
+		Sequence:         head.Sequence,
+	}
+}
+
+// VerifySignature returns whether the checkpoint was signed by the private half of the given key.
+func (x *AuditLogCheckpoint) VerifySignature(publicKey ed25519.PublicKey) bool {
+	signature, err := base64.StdEncoding.DecodeString(x.Signature)
+	if err != nil {
+		return false
+	}
+
+	return ed25519.Verify(publicKey, checkpointMessage(x.BelongsToAccount, x.Sequence, x.EntryHash), signature)
+}
+
+var _ validation.ValidatableWithContext = (*ChainConfig)(nil)
+
+// ValidateWithContext validates a ChainConfig.
+func (cfg *ChainConfig) ValidateWithContext(ctx context.Context) error {
+	return validation.ValidateStructWithContext(ctx, cfg,
+		validation.Field(&cfg.CheckpointSigningKey, validation.By(validateCheckpointKey)),
+		validation.Field(&cfg.CheckpointVerificationKey, validation.By(validateCheckpointKey)),
+	)
+}
+
+func validateCheckpointKey(value any) error {
+	if encoded, ok := value.(string); ok && encoded != "" {
+		if _, err := decodeCheckpointKey(encoded); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func decodeCheckpointKey(encoded string) ([]byte, error) {
+	decoded, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil || len(decoded) != ed25519.SeedSize {
+		return nil, ErrInvalidCheckpointKey
+	}
+
+	return decoded, nil
+}
+
+// SigningKey returns the configured checkpoint signing key, or nil if there isn't one.
+func (cfg *ChainConfig) SigningKey() (ed25519.PrivateKey, error) {
+	if cfg == nil || cfg.CheckpointSigningKey == "" {
+		return nil, nil
+	}
+
+	seed, err := decodeCheckpointKey(cfg.CheckpointSigningKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return ed25519.NewKeyFromSeed(seed), nil
+}
+
+// VerificationKey returns the configured checkpoint verification key, falling back to the public
+// half of the signing key, or nil if neither is configured.
+func (cfg *ChainConfig) VerificationKey() (ed25519.PublicKey, error) {
+	if cfg == nil {
+		return nil, nil
+	}
+
+	if cfg.CheckpointVerificationKey != "" {
+		return ParseCheckpointVerificationKey(cfg.CheckpointVerificationKey)
+	}
+
+	signingKey, err := cfg.SigningKey()
+	if err != nil || signingKey == nil {
+		return nil, err
+	}
+
+	publicKey, _ := signingKey.Public().(ed25519.PublicKey)
+	return publicKey, nil
+}
+
+// ParseCheckpointVerificationKey decodes a base64-encoded Ed25519 public key.
+func ParseCheckpointVerificationKey(encoded string) (ed25519.PublicKey, error) {
+	decoded, err := decodeCheckpointKey(encoded)
+	if err != nil {
+		return nil, err
+	}
+
+	return ed25519.PublicKey(decoded), nil
+}
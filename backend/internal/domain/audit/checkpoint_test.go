@@ -0,0 +1,119 @@
+package audit
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCheckpointSigner_Sign(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		signer, publicKey := buildTestSigner(t)
+		head := &AuditLogChainHead{BelongsToAccount: "account_id", Hash: "hash", Sequence: 123}
+
+		input := signer.Sign(head)
+		assert.NotEmpty(t, input.ID)
+		assert.Equal(t, CheckpointKeyID(publicKey), input.KeyID)
+
+		checkpoint := &AuditLogCheckpoint{
+			BelongsToAccount: input.BelongsToAccount,
+			EntryHash:        input.EntryHash,
+			Signature:        input.Signature,
+			Sequence:         input.Sequence,
+		}
+		assert.True(t, checkpoint.VerifySignature(publicKey))
+
+		checkpoint.Sequence++
+		assert.False(t, checkpoint.VerifySignature(publicKey))
+	})
+}
+
+func TestChainConfig_ValidateWithContext(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+
+		cfg := &ChainConfig{
+			CheckpointSigningKey:      base64.StdEncoding.EncodeToString(privateKey.Seed()),
+			CheckpointVerificationKey: base64.StdEncoding.EncodeToString(publicKey),
+		}
+
+		assert.NoError(t, cfg.ValidateWithContext(t.Context()))
+	})
+
+	T.Run("with zero value", func(t *testing.T) {
+		t.Parallel()
+
+		assert.NoError(t, (&ChainConfig{}).ValidateWithContext(t.Context()))
+	})
+
+	T.Run("with invalid signing key", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := &ChainConfig{
+			CheckpointSigningKey: base64.StdEncoding.EncodeToString([]byte("too short")),
+		}
+
+		assert.Error(t, cfg.ValidateWithContext(t.Context()))
+	})
+
+	T.Run("with invalid verification key", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := &ChainConfig{
+			CheckpointVerificationKey: "not base64!",
+		}
+
+		assert.Error(t, cfg.ValidateWithContext(t.Context()))
+	})
+}
+
+func TestChainConfig_VerificationKey(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		publicKey, _, err := ed25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+
+		cfg := &ChainConfig{CheckpointVerificationKey: base64.StdEncoding.EncodeToString(publicKey)}
+
+		actual, err := cfg.VerificationKey()
+		require.NoError(t, err)
+		assert.Equal(t, publicKey, actual)
+	})
+
+	T.Run("falls back to signing key", func(t *testing.T) {
+		t.Parallel()
+
+		publicKey, privateKey, err := ed25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+
+		cfg := &ChainConfig{CheckpointSigningKey: base64.StdEncoding.EncodeToString(privateKey.Seed())}
+
+		actual, err := cfg.VerificationKey()
+		require.NoError(t, err)
+		assert.Equal(t, publicKey, actual)
+	})
+
+	T.Run("with zero value", func(t *testing.T) {
+		t.Parallel()
+
+		actual, err := (&ChainConfig{}).VerificationKey()
+		assert.NoError(t, err)
+		assert.Nil(t, actual)
+	})
+}
@@ -11,6 +11,8 @@
 	DataChangeMessage struct {
 		_ struct{} `json:"-"`
 
+		// ID uniquely identifies the message, so consumers can recognize a redelivery of one they've already handled.
+		ID        string         `json:"id,omitempty"`
 		EventType string         `json:"eventType"`
 		Context   map[string]any `json:"context,omitempty"`
 		UserID    string         `json:"userID"`
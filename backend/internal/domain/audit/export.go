@@ -0,0 +1,131 @@
+package audit
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"io"
+	"time"
+
+	"github.com/primandproper/platform/pointer"
+)
+
+const (
+	// ExportFormatNDJSON writes one JSON-encoded audit log entry per line.
+	ExportFormatNDJSON ExportFormat = "ndjson"
+	// ExportFormatCSV writes a header row followed by one row per audit log entry.
+	ExportFormatCSV ExportFormat = "csv"
+)
+
+var (
+	// ErrUnsupportedExportFormat is returned when an export is requested in a format we can't write.
+	ErrUnsupportedExportFormat = errors.New("unsupported audit log export format")
+
+	auditLogEntryCSVHeader = []string{
+		"id",
+		"created_at",
+		"resource_type",
+		"event_type",
+		"relevant_id",
+		"belongs_to_user",
+		"belongs_to_account",
+		"changes",
+	}
+)
+
+type (
+	// ExportFormat is the encoding used for an audit log export.
+	ExportFormat string
+
+	// AuditLogEntryExportWriter encodes audit log entries to an underlying writer.
+	AuditLogEntryExportWriter interface {
+		Write(entry *AuditLogEntry) error
+		Flush() error
+	}
+
+	ndjsonExportWriter struct {
+		encoder *json.Encoder
+	}
+
+	csvExportWriter struct {
+		writer        *csv.Writer
+		headerWritten bool
+	}
+)
+
+// ContentType returns the MIME type for the export format.
+func (f ExportFormat) ContentType() string {
+	switch f {
+	case ExportFormatCSV:
+		return "text/csv"
+	case ExportFormatNDJSON:
+		return "application/x-ndjson"
+	default:
+		return "application/octet-stream"
+	}
+}
+
+// NewAuditLogEntryExportWriter returns an AuditLogEntryExportWriter for the given format.
+func NewAuditLogEntryExportWriter(format ExportFormat, w io.Writer) (AuditLogEntryExportWriter, error) {
+	switch format {
+	case ExportFormatNDJSON:
+		return &ndjsonExportWriter{encoder: json.NewEncoder(w)}, nil
+	case ExportFormatCSV:
+		return &csvExportWriter{writer: csv.NewWriter(w)}, nil
+	default:
+		return nil, ErrUnsupportedExportFormat
+	}
+}
+
+func (w *ndjsonExportWriter) Write(entry *AuditLogEntry) error {
+	return w.encoder.Encode(entry)
+}
+
+func (w *ndjsonExportWriter) Flush() error {
+	return nil
+}
+
+func (w *csvExportWriter) Write(entry *AuditLogEntry) error {
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+
+	changes, err := json.Marshal(entry.Changes)
+	if err != nil {
+		return err
+	}
+
+	return w.writer.Write([]string{
+		entry.ID,
+		entry.CreatedAt.UTC().Format(time.RFC3339Nano),
+		entry.ResourceType,
+		entry.EventType,
+		entry.RelevantID,
+		entry.BelongsToUser,
+		pointer.Dereference(entry.BelongsToAccount),
+		string(changes),
+	})
+}
+
+// Flush writes any buffered rows. An export with no matching entries still gets its header row.
+func (w *csvExportWriter) Flush() error {
+	if err := w.writeHeader(); err != nil {
+		return err
+	}
+
+	w.writer.Flush()
+	return w.writer.Error()
+}
+
+func (w *csvExportWriter) writeHeader() error {
+	if w.headerWritten {
+		return nil
+	}
+
+	if err := w.writer.Write(auditLogEntryCSVHeader); err != nil {
+		return err
+	}
+	w.headerWritten = true
+
+	return nil
+}
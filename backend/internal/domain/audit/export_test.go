@@ -0,0 +1,103 @@
+package audit
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func buildExportTestEntry() *AuditLogEntry {
+	accountID := "account_id"
+
+	return &AuditLogEntry{
+		CreatedAt: time.Date(2024, time.March, 1, 12, 0, 0, 0, time.UTC),
+		Changes: map[string]*ChangeLog{
+			"name": {OldValue: "old", NewValue: "new"},
+		},
+		BelongsToAccount: &accountID,
+		ID:               "entry_id",
+		ResourceType:     "recipes",
+		RelevantID:       "recipe_id",
+		EventType:        AuditLogEventTypeUpdated,
+		BelongsToUser:    "user_id",
+	}
+}
+
+func TestNewAuditLogEntryExportWriter(T *testing.T) {
+	T.Parallel()
+
+	T.Run("ndjson", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+		w, err := NewAuditLogEntryExportWriter(ExportFormatNDJSON, &buf)
+		require.NoError(t, err)
+
+		expected := buildExportTestEntry()
+		require.NoError(t, w.Write(expected))
+		require.NoError(t, w.Write(expected))
+		require.NoError(t, w.Flush())
+
+		lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+		require.Len(t, lines, 2)
+
+		var actual *AuditLogEntry
+		require.NoError(t, json.Unmarshal([]byte(lines[0]), &actual))
+		assert.Equal(t, expected, actual)
+	})
+
+	T.Run("csv", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+		w, err := NewAuditLogEntryExportWriter(ExportFormatCSV, &buf)
+		require.NoError(t, err)
+
+		require.NoError(t, w.Write(buildExportTestEntry()))
+		require.NoError(t, w.Flush())
+
+		records, err := csv.NewReader(&buf).ReadAll()
+		require.NoError(t, err)
+		require.Len(t, records, 2)
+
+		assert.Equal(t, auditLogEntryCSVHeader, records[0])
+		assert.Equal(t, []string{
+			"entry_id",
+			"2024-03-01T12:00:00Z",
+			"recipes",
+			AuditLogEventTypeUpdated,
+			"recipe_id",
+			"user_id",
+			"account_id",
+			`{"name":{"oldValue":"old","newValue":"new"}}`,
+		}, records[1])
+	})
+
+	T.Run("csv with no entries", func(t *testing.T) {
+		t.Parallel()
+
+		var buf bytes.Buffer
+		w, err := NewAuditLogEntryExportWriter(ExportFormatCSV, &buf)
+		require.NoError(t, err)
+
+		require.NoError(t, w.Flush())
+
+		records, err := csv.NewReader(&buf).ReadAll()
+		require.NoError(t, err)
+		assert.Equal(t, [][]string{auditLogEntryCSVHeader}, records)
+	})
+
+	T.Run("with unsupported format", func(t *testing.T) {
+		t.Parallel()
+
+		w, err := NewAuditLogEntryExportWriter("xml", &bytes.Buffer{})
+		assert.ErrorIs(t, err, ErrUnsupportedExportFormat)
+		assert.Nil(t, w)
+	})
+}
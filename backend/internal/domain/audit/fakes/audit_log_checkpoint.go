@@ -0,0 +1,27 @@
+package fakes
+
+import (
+	types "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/audit"
+)
+
+// BuildFakeAuditLogChainHead builds a faked AuditLogChainHead.
+func BuildFakeAuditLogChainHead() *types.AuditLogChainHead {
+	return &types.AuditLogChainHead{
+		BelongsToAccount: BuildFakeID(),
+		Hash:             BuildFakeID(),
+		Sequence:         uint64(buildFakeNumber()),
+	}
+}
+
+// BuildFakeAuditLogCheckpoint builds a faked AuditLogCheckpoint.
+func BuildFakeAuditLogCheckpoint() *types.AuditLogCheckpoint {
+	return &types.AuditLogCheckpoint{
+		CreatedAt:        BuildFakeTime(),
+		ID:               BuildFakeID(),
+		BelongsToAccount: BuildFakeID(),
+		EntryHash:        BuildFakeID(),
+		Signature:        BuildFakeID(),
+		KeyID:            BuildFakeID(),
+		Sequence:         uint64(buildFakeNumber()),
+	}
+}
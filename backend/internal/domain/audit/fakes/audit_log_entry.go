@@ -37,3 +37,39 @@ func BuildFakeAuditLogEntriesList() *filtering.QueryFilteredResult[types.AuditLo
 		Data: examples,
 	}
 }
+
+// BuildFakeAuditLogEntrySearchInput builds a faked AuditLogEntrySearchInput.
+func BuildFakeAuditLogEntrySearchInput() *types.AuditLogEntrySearchInput {
+	return &types.AuditLogEntrySearchInput{
+		ResourceTypes: []string{"example"},
+		EventTypes:    []string{types.AuditLogEventTypeUpdated},
+		BelongsToUser: BuildFakeID(),
+		RelevantID:    BuildFakeID(),
+		ChangedField:  "name",
+	}
+}
+
+// BuildFakeAuditLogChain builds a faked, correctly linked audit log chain for an account.
+func BuildFakeAuditLogChain(accountID string, length int) []*types.AuditLogEntry {
+	var (
+		chain    []*types.AuditLogEntry
+		previous string
+	)
+
+	for i := range length {
+		entry := BuildFakeAuditLogEntry()
+		entry.BelongsToAccount = &accountID
+		entry.Changes = map[string]*types.ChangeLog{
+			"name": {OldValue: BuildFakeID(), NewValue: BuildFakeID()},
+		}
+		entry.Sequence = uint64(i + 1)
+		entry.PreviousHash = previous
+		entry.ChangesHash, _ = types.ComputeChangesHash(entry.Changes)
+		entry.Hash = entry.ComputeHash()
+
+		previous = entry.Hash
+		chain = append(chain, entry)
+	}
+
+	return chain
+}
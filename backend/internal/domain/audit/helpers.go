@@ -5,6 +5,7 @@
 
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/authentication/sessions"
 
+	"github.com/primandproper/platform/identifiers"
 	"github.com/primandproper/platform/observability/logging"
 )
 
@@ -15,6 +16,7 @@ func BuildDataChangeMessageFromContext(ctx context.Context, logger logging.Logge
 	}
 
 	x := &DataChangeMessage{
+		ID:        identifiers.New(),
 		EventType: eventType,
 		Context:   metadata,
 	}
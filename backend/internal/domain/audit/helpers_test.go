@@ -37,6 +37,8 @@ func Test_buildDataChangeMessageFromContext(T *testing.T) {
 		}
 
 		actual := BuildDataChangeMessageFromContext(ctx, loggingnoop.NewLogger(), expected.EventType, expected.Context)
+		assert.NotEmpty(t, actual.ID)
+		expected.ID = actual.ID
 
 		assert.Equal(t, expected, actual)
 	})
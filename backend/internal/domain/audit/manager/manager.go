@@ -76,6 +76,20 @@ func (m *auditManager) GetAuditLogEntriesForAccountAndResourceTypes(ctx context.
 	return m.repo.GetAuditLogEntriesForAccountAndResourceTypes(ctx, accountID, resourceTypes, filter)
 }
 
+func (m *auditManager) SearchAuditLogEntriesForAccount(ctx context.Context, accountID string, input *audit.AuditLogEntrySearchInput, filter *filtering.QueryFilter) (*filtering.QueryFilteredResult[audit.AuditLogEntry], error) {
+	ctx, span := m.tracer.StartSpan(ctx)
+	defer span.End()
+
+	return m.repo.SearchAuditLogEntriesForAccount(ctx, accountID, input, filter)
+}
+
+func (m *auditManager) ExportAuditLogEntriesForAccount(ctx context.Context, accountID string, input *audit.AuditLogEntrySearchInput, filter *filtering.QueryFilter, afterID string, limit uint8) ([]*audit.AuditLogEntry, error) {
+	ctx, span := m.tracer.StartSpan(ctx)
+	defer span.End()
+
+	return m.repo.ExportAuditLogEntriesForAccount(ctx, accountID, input, filter, afterID, limit)
+}
+
 func (m *auditManager) CreateAuditLogEntry(ctx context.Context, querier database.SQLQueryExecutor, input *audit.AuditLogEntryDatabaseCreationInput) (*audit.AuditLogEntry, error) {
 	ctx, span := m.tracer.StartSpan(ctx)
 	defer span.End()
@@ -89,3 +103,38 @@ func (m *auditManager) CreateAuditLogEntry(ctx context.Context, querier database
 
 	return created, nil
 }
+
+func (m *auditManager) GetAuditLogChainEntries(ctx context.Context, accountID string, afterSequence uint64, limit uint8) ([]*audit.AuditLogEntry, error) {
+	ctx, span := m.tracer.StartSpan(ctx)
+	defer span.End()
+
+	return m.repo.GetAuditLogChainEntries(ctx, accountID, afterSequence, limit)
+}
+
+func (m *auditManager) GetAuditLogCheckpointsForAccount(ctx context.Context, accountID string) ([]*audit.AuditLogCheckpoint, error) {
+	ctx, span := m.tracer.StartSpan(ctx)
+	defer span.End()
+
+	return m.repo.GetAuditLogCheckpointsForAccount(ctx, accountID)
+}
+
+func (m *auditManager) GetUncheckpointedAuditLogChainHeads(ctx context.Context) ([]*audit.AuditLogChainHead, error) {
+	ctx, span := m.tracer.StartSpan(ctx)
+	defer span.End()
+
+	return m.repo.GetUncheckpointedAuditLogChainHeads(ctx)
+}
+
+func (m *auditManager) CreateAuditLogCheckpoint(ctx context.Context, input *audit.AuditLogCheckpointDatabaseCreationInput) (*audit.AuditLogCheckpoint, error) {
+	ctx, span := m.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := m.logger.WithSpan(span).WithValue(identitykeys.AccountIDKey, input.BelongsToAccount)
+
+	created, err := m.repo.CreateAuditLogCheckpoint(ctx, input)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "creating audit log checkpoint")
+	}
+
+	return created, nil
+}
@@ -1,6 +1,7 @@
 package manager
 
 import (
+	"errors"
 	"testing"
 
 	types "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/audit"
@@ -70,6 +71,53 @@ func TestAuditDataManager_GetAuditLogEntriesForAccount(t *testing.T) {
 	})
 }
 
+func TestAuditDataManager_SearchAuditLogEntriesForAccount(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		manager, repo := buildAuditManagerForTest(t)
+
+		accountID := fakes.BuildFakeID()
+		input := fakes.BuildFakeAuditLogEntrySearchInput()
+		filter := filtering.DefaultQueryFilter()
+		expected := fakes.BuildFakeAuditLogEntriesList()
+		repo.On(reflection.GetMethodName(repo.SearchAuditLogEntriesForAccount), testutils.ContextMatcher, accountID, input, filter).Return(expected, nil)
+
+		result, err := manager.SearchAuditLogEntriesForAccount(ctx, accountID, input, filter)
+
+		require.NoError(t, err)
+		assert.Equal(t, expected, result)
+		mock.AssertExpectationsForObjects(t, repo)
+	})
+}
+
+func TestAuditDataManager_ExportAuditLogEntriesForAccount(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		manager, repo := buildAuditManagerForTest(t)
+
+		accountID := fakes.BuildFakeID()
+		afterID := fakes.BuildFakeID()
+		input := fakes.BuildFakeAuditLogEntrySearchInput()
+		filter := filtering.DefaultQueryFilter()
+		expected := fakes.BuildFakeAuditLogEntriesList().Data
+		repo.On(reflection.GetMethodName(repo.ExportAuditLogEntriesForAccount), testutils.ContextMatcher, accountID, input, filter, afterID, uint8(50)).Return(expected, nil)
+
+		result, err := manager.ExportAuditLogEntriesForAccount(ctx, accountID, input, filter, afterID, 50)
+
+		require.NoError(t, err)
+		assert.Equal(t, expected, result)
+		mock.AssertExpectationsForObjects(t, repo)
+	})
+}
+
 func TestAuditDataManager_CreateAuditLogEntry(t *testing.T) {
 	t.Parallel()
 
@@ -95,3 +143,68 @@ func TestAuditDataManager_CreateAuditLogEntry(t *testing.T) {
 		mock.AssertExpectationsForObjects(t, repo)
 	})
 }
+
+func TestAuditDataManager_GetAuditLogChainEntries(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		manager, repo := buildAuditManagerForTest(t)
+
+		accountID := fakes.BuildFakeID()
+		expected := fakes.BuildFakeAuditLogChain(accountID, 3)
+		repo.On(reflection.GetMethodName(repo.GetAuditLogChainEntries), testutils.ContextMatcher, accountID, uint64(0), uint8(50)).Return(expected, nil)
+
+		result, err := manager.GetAuditLogChainEntries(ctx, accountID, 0, 50)
+
+		require.NoError(t, err)
+		assert.Equal(t, expected, result)
+		mock.AssertExpectationsForObjects(t, repo)
+	})
+}
+
+func TestAuditDataManager_CreateAuditLogCheckpoint(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		manager, repo := buildAuditManagerForTest(t)
+
+		expected := fakes.BuildFakeAuditLogCheckpoint()
+		input := &types.AuditLogCheckpointDatabaseCreationInput{
+			ID:               expected.ID,
+			BelongsToAccount: expected.BelongsToAccount,
+			EntryHash:        expected.EntryHash,
+			Signature:        expected.Signature,
+			KeyID:            expected.KeyID,
+			Sequence:         expected.Sequence,
+		}
+		repo.On(reflection.GetMethodName(repo.CreateAuditLogCheckpoint), testutils.ContextMatcher, input).Return(expected, nil)
+
+		created, err := manager.CreateAuditLogCheckpoint(ctx, input)
+
+		require.NoError(t, err)
+		assert.Equal(t, expected, created)
+		mock.AssertExpectationsForObjects(t, repo)
+	})
+
+	t.Run("with error", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		manager, repo := buildAuditManagerForTest(t)
+
+		input := &types.AuditLogCheckpointDatabaseCreationInput{ID: fakes.BuildFakeID()}
+		repo.On(reflection.GetMethodName(repo.CreateAuditLogCheckpoint), testutils.ContextMatcher, input).Return((*types.AuditLogCheckpoint)(nil), errors.New("blah"))
+
+		created, err := manager.CreateAuditLogCheckpoint(ctx, input)
+
+		assert.Error(t, err)
+		assert.Nil(t, created)
+		mock.AssertExpectationsForObjects(t, repo)
+	})
+}
@@ -47,8 +47,44 @@ func (m *Repository) GetAuditLogEntriesForAccountAndResourceTypes(ctx context.Co
 	return args.Get(0).(*filtering.QueryFilteredResult[audit.AuditLogEntry]), args.Error(1)
 }
 
+// SearchAuditLogEntriesForAccount is a mock function.
+func (m *Repository) SearchAuditLogEntriesForAccount(ctx context.Context, accountID string, input *audit.AuditLogEntrySearchInput, filter *filtering.QueryFilter) (*filtering.QueryFilteredResult[audit.AuditLogEntry], error) {
+	args := m.Called(ctx, accountID, input, filter)
+	return args.Get(0).(*filtering.QueryFilteredResult[audit.AuditLogEntry]), args.Error(1)
+}
+
+// ExportAuditLogEntriesForAccount is a mock function.
+func (m *Repository) ExportAuditLogEntriesForAccount(ctx context.Context, accountID string, input *audit.AuditLogEntrySearchInput, filter *filtering.QueryFilter, afterID string, limit uint8) ([]*audit.AuditLogEntry, error) {
+	args := m.Called(ctx, accountID, input, filter, afterID, limit)
+	return args.Get(0).([]*audit.AuditLogEntry), args.Error(1)
+}
+
 // CreateAuditLogEntry is a mock function.
 func (m *Repository) CreateAuditLogEntry(ctx context.Context, querier database.SQLQueryExecutor, input *audit.AuditLogEntryDatabaseCreationInput) (*audit.AuditLogEntry, error) {
 	args := m.Called(ctx, querier, input)
 	return args.Get(0).(*audit.AuditLogEntry), args.Error(1)
 }
+
+// GetAuditLogChainEntries is a mock function.
+func (m *Repository) GetAuditLogChainEntries(ctx context.Context, accountID string, afterSequence uint64, limit uint8) ([]*audit.AuditLogEntry, error) {
+	args := m.Called(ctx, accountID, afterSequence, limit)
+	return args.Get(0).([]*audit.AuditLogEntry), args.Error(1)
+}
+
+// GetAuditLogCheckpointsForAccount is a mock function.
+func (m *Repository) GetAuditLogCheckpointsForAccount(ctx context.Context, accountID string) ([]*audit.AuditLogCheckpoint, error) {
+	args := m.Called(ctx, accountID)
+	return args.Get(0).([]*audit.AuditLogCheckpoint), args.Error(1)
+}
+
+// GetUncheckpointedAuditLogChainHeads is a mock function.
+func (m *Repository) GetUncheckpointedAuditLogChainHeads(ctx context.Context) ([]*audit.AuditLogChainHead, error) {
+	args := m.Called(ctx)
+	return args.Get(0).([]*audit.AuditLogChainHead), args.Error(1)
+}
+
+// CreateAuditLogCheckpoint is a mock function.
+func (m *Repository) CreateAuditLogCheckpoint(ctx context.Context, input *audit.AuditLogCheckpointDatabaseCreationInput) (*audit.AuditLogCheckpoint, error) {
+	args := m.Called(ctx, input)
+	return args.Get(0).(*audit.AuditLogCheckpoint), args.Error(1)
+}
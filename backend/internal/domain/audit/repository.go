@@ -2,4 +2,5 @@
 
 type Repository interface {
 	AuditLogEntryDataManager
+	AuditLogChainDataManager
 }
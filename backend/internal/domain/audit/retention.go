@@ -0,0 +1,49 @@
+package audit
+
+import (
+	"context"
+	"errors"
+	"maps"
+	"slices"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+var (
+	// ErrNegativeRetentionPeriod is returned when a retention period is less than zero.
+	ErrNegativeRetentionPeriod = errors.New("retention period cannot be negative")
+)
+
+// RetentionConfig configures how long audit log entries are kept before the db_cleaner job deletes them.
+// A zero duration means entries are kept indefinitely.
+type RetentionConfig struct {
+	_ struct{} `json:"-"`
+
+	// ResourceTypes overrides Default for audit log entries with the given resource type.
+	ResourceTypes map[string]time.Duration `env:"RESOURCE_TYPES" json:"resourceTypes,omitempty"`
+	Default       time.Duration            `env:"DEFAULT"        json:"default,omitempty"`
+}
+
+var _ validation.ValidatableWithContext = (*RetentionConfig)(nil)
+
+// ValidateWithContext validates a RetentionConfig.
+func (cfg *RetentionConfig) ValidateWithContext(ctx context.Context) error {
+	return validation.ValidateStructWithContext(ctx, cfg,
+		validation.Field(&cfg.Default, validation.By(validateRetentionPeriod)),
+		validation.Field(&cfg.ResourceTypes, validation.Each(validation.By(validateRetentionPeriod))),
+	)
+}
+
+func validateRetentionPeriod(value any) error {
+	if period, ok := value.(time.Duration); ok && period < 0 {
+		return ErrNegativeRetentionPeriod
+	}
+
+	return nil
+}
+
+// OverriddenResourceTypes returns the resource types with their own retention period, in a stable order.
+func (cfg *RetentionConfig) OverriddenResourceTypes() []string {
+	return slices.Sorted(maps.Keys(cfg.ResourceTypes))
+}
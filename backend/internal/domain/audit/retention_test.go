@@ -0,0 +1,71 @@
+package audit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetentionConfig_ValidateWithContext(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := &RetentionConfig{
+			Default: 365 * 24 * time.Hour,
+			ResourceTypes: map[string]time.Duration{
+				"webhook_deliveries": 30 * 24 * time.Hour,
+			},
+		}
+
+		assert.NoError(t, cfg.ValidateWithContext(t.Context()))
+	})
+
+	T.Run("with zero value", func(t *testing.T) {
+		t.Parallel()
+
+		assert.NoError(t, (&RetentionConfig{}).ValidateWithContext(t.Context()))
+	})
+
+	T.Run("with negative default", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := &RetentionConfig{
+			Default: -time.Hour,
+		}
+
+		assert.Error(t, cfg.ValidateWithContext(t.Context()))
+	})
+
+	T.Run("with negative override", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := &RetentionConfig{
+			ResourceTypes: map[string]time.Duration{
+				"webhook_deliveries": -time.Hour,
+			},
+		}
+
+		assert.Error(t, cfg.ValidateWithContext(t.Context()))
+	})
+}
+
+func TestRetentionConfig_OverriddenResourceTypes(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		cfg := &RetentionConfig{
+			ResourceTypes: map[string]time.Duration{
+				"webhooks":           time.Hour,
+				"accounts":           time.Hour,
+				"webhook_deliveries": time.Hour,
+			},
+		}
+
+		assert.Equal(t, []string{"accounts", "webhook_deliveries", "webhooks"}, cfg.OverriddenResourceTypes())
+	})
+}
@@ -94,6 +94,9 @@
 		AuthorizeHandler(res http.ResponseWriter, req *http.Request)
 		TokenHandler(res http.ResponseWriter, req *http.Request)
 		RevokeHandler(res http.ResponseWriter, req *http.Request)
+		OpenIDConfigurationHandler(res http.ResponseWriter, req *http.Request)
+		JWKSHandler(res http.ResponseWriter, req *http.Request)
+		UserInfoHandler(res http.ResponseWriter, req *http.Request)
 	}
 )
 
@@ -2,6 +2,7 @@
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"time"
 
@@ -25,6 +26,12 @@
 	AccountSetAsDefaultServiceEventType = "account_set_as_default"
 	// AccountOwnershipTransferredServiceEventType indicates an account was transferred to another owner.
 	AccountOwnershipTransferredServiceEventType = "account_ownership_transferred"
+	// AccountWebhookEncryptionKeyRotatedServiceEventType indicates an account's webhook signing key was rotated.
+	AccountWebhookEncryptionKeyRotatedServiceEventType = "account_webhook_encryption_key_rotated"
+
+	// WebhookEncryptionKeyRotationGracePeriod is how long the previous webhook signing key stays active after a rotation.
+	// A key can't be rotated again until this has passed, so at most one replaced key is ever still active.
+	WebhookEncryptionKeyRotationGracePeriod = 24 * time.Hour
 
 	// UnpaidAccountBillingStatus indicates an account is not paid.
 	UnpaidAccountBillingStatus = "unpaid"
@@ -36,6 +43,11 @@
 	SuspendedAccountBillingStatus = "suspended"
 )
 
+var (
+	// ErrWebhookEncryptionKeyRotationInGracePeriod is returned when a webhook signing key is rotated again before the previous rotation's grace period has passed.
+	ErrWebhookEncryptionKeyRotationInGracePeriod = errors.New("webhook encryption key was rotated too recently")
+)
+
 type (
 	// Account represents an account.
 	Account struct {
@@ -116,6 +128,15 @@
 		BelongsToUser string   `json:"-"`
 	}
 
+	// AccountWebhookEncryptionKeys holds the keys used to sign an account's outbound webhooks.
+	AccountWebhookEncryptionKeys struct {
+		_ struct{} `json:"-"`
+
+		RotatedAt *time.Time `json:"-"`
+		Current   string     `json:"-"`
+		Previous  string     `json:"-"`
+	}
+
 	// AccountDataManager describes a structure capable of storing accounts permanently.
 	AccountDataManager interface {
 		GetAccount(ctx context.Context, accountID string) (*Account, error)
@@ -123,6 +144,8 @@
 		CreateAccount(ctx context.Context, input *AccountDatabaseCreationInput) (*Account, error)
 		UpdateAccount(ctx context.Context, updated *Account) error
 		UpdateAccountBillingFields(ctx context.Context, accountID string, billingStatus, subscriptionPlanID, paymentProcessorCustomerID *string, lastPaymentProviderSyncOccurredAt *time.Time) error
+		GetAccountWebhookEncryptionKeys(ctx context.Context, accountID string) (*AccountWebhookEncryptionKeys, error)
+		RotateAccountWebhookEncryptionKey(ctx context.Context, accountID, newKey string) error
 		ArchiveAccount(ctx context.Context, accountID string, userID string) error
 	}
 )
@@ -199,3 +222,19 @@ func AccountCreationInputForNewUser(u *User) *AccountDatabaseCreationInput {
 		BelongsToUser: u.ID,
 	}
 }
+
+// ActiveKeys returns the keys a webhook should currently be signed with, newest first.
+// The previous key is only included until the rotation grace period has elapsed.
+func (x *AccountWebhookEncryptionKeys) ActiveKeys(now time.Time) []string {
+	keys := []string{x.Current}
+	if x.Previous != "" && x.InRotationGracePeriod(now) {
+		keys = append(keys, x.Previous)
+	}
+
+	return keys
+}
+
+// InRotationGracePeriod reports whether the last rotation's grace period is still running, during which the key can't be rotated again.
+func (x *AccountWebhookEncryptionKeys) InRotationGracePeriod(now time.Time) bool {
+	return x.RotatedAt != nil && now.Before(x.RotatedAt.Add(WebhookEncryptionKeyRotationGracePeriod))
+}
@@ -0,0 +1,162 @@
+package identity
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"time"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/authorization"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+const (
+	// AccountRoleCreatedServiceEventType indicates an account role was created.
+	AccountRoleCreatedServiceEventType = "account_role_created"
+	// AccountRoleUpdatedServiceEventType indicates an account role was updated.
+	AccountRoleUpdatedServiceEventType = "account_role_updated"
+	// AccountRoleArchivedServiceEventType indicates an account role was archived.
+	AccountRoleArchivedServiceEventType = "account_role_archived"
+)
+
+var (
+	// ErrInvalidAccountRolePermission is returned when a role is given a permission that account roles cannot grant.
+	ErrInvalidAccountRolePermission = errors.New("permission cannot be granted by an account role")
+	// ErrAccountRoleCannotInheritFromItself is returned when a role names itself as a parent.
+	ErrAccountRoleCannotInheritFromItself = errors.New("account role cannot inherit from itself")
+
+	reservedAccountRoleNames = []any{authorization.AccountAdminRoleName, authorization.AccountMemberRoleName}
+)
+
+type (
+	// AccountRole represents a role that can be assigned to members of an account.
+	// Built-in roles are shared by every account and have no owning account.
+	AccountRole struct {
+		_ struct{} `json:"-"`
+
+		CreatedAt        time.Time  `json:"createdAt"`
+		LastUpdatedAt    *time.Time `json:"lastUpdatedAt"`
+		ArchivedAt       *time.Time `json:"archivedAt"`
+		BelongsToAccount *string    `json:"belongsToAccount"`
+		ID               string     `json:"id"`
+		Name             string     `json:"name"`
+		Description      string     `json:"description"`
+		Permissions      []string   `json:"permissions"`
+		ParentRoleIDs    []string   `json:"parentRoleIDs"`
+	}
+
+	// AccountRoleCreationRequestInput represents what a User could set as input for creating account roles.
+	AccountRoleCreationRequestInput struct {
+		_ struct{} `json:"-"`
+
+		Name          string   `json:"name"`
+		Description   string   `json:"description"`
+		Permissions   []string `json:"permissions"`
+		ParentRoleIDs []string `json:"parentRoleIDs"`
+	}
+
+	// AccountRoleDatabaseCreationInput represents what a User could set as input for creating account roles.
+	AccountRoleDatabaseCreationInput struct {
+		_ struct{} `json:"-"`
+
+		ID               string   `json:"-"`
+		Name             string   `json:"-"`
+		Description      string   `json:"-"`
+		BelongsToAccount string   `json:"-"`
+		Permissions      []string `json:"-"`
+		ParentRoleIDs    []string `json:"-"`
+	}
+
+	// AccountRoleUpdateRequestInput represents what a User could set as input for updating account roles.
+	// Nil permission and parent lists leave the role's current values untouched.
+	AccountRoleUpdateRequestInput struct {
+		_ struct{} `json:"-"`
+
+		Name          *string  `json:"name,omitempty"`
+		Description   *string  `json:"description,omitempty"`
+		Permissions   []string `json:"permissions,omitempty"`
+		ParentRoleIDs []string `json:"parentRoleIDs,omitempty"`
+	}
+
+	// AccountRoleDataManager describes a structure capable of storing account roles permanently.
+	AccountRoleDataManager interface {
+		CreateAccountRole(ctx context.Context, input *AccountRoleDatabaseCreationInput) (*AccountRole, error)
+		GetAccountRole(ctx context.Context, accountID, accountRoleID string) (*AccountRole, error)
+		GetAccountRoles(ctx context.Context, accountID string) ([]*AccountRole, error)
+		UpdateAccountRole(ctx context.Context, updated *AccountRole) error
+		ArchiveAccountRole(ctx context.Context, accountID, accountRoleID string) error
+	}
+)
+
+// IsBuiltIn returns whether the role is one of the roles shared by every account.
+func (x *AccountRole) IsBuiltIn() bool {
+	return x.BelongsToAccount == nil
+}
+
+// Update merges an AccountRoleUpdateRequestInput with an account role.
+func (x *AccountRole) Update(input *AccountRoleUpdateRequestInput) {
+	if input.Name != nil && *input.Name != x.Name {
+		x.Name = *input.Name
+	}
+
+	if input.Description != nil && *input.Description != x.Description {
+		x.Description = *input.Description
+	}
+
+	if input.Permissions != nil {
+		x.Permissions = input.Permissions
+	}
+
+	if input.ParentRoleIDs != nil {
+		x.ParentRoleIDs = input.ParentRoleIDs
+	}
+}
+
+// validateAccountRolePermission ensures a permission may be granted by an account role.
+func validateAccountRolePermission(value any) error {
+	p, _ := value.(string)
+	if !authorization.IsAccountRoleAssignablePermission(authorization.Permission(p)) {
+		return ErrInvalidAccountRolePermission
+	}
+
+	return nil
+}
+
+var _ validation.ValidatableWithContext = (*AccountRoleCreationRequestInput)(nil)
+
+// ValidateWithContext validates an AccountRoleCreationRequestInput.
+func (x *AccountRoleCreationRequestInput) ValidateWithContext(ctx context.Context) error {
+	return validation.ValidateStructWithContext(ctx, x,
+		validation.Field(&x.Name, validation.Required, validation.NotIn(reservedAccountRoleNames...)),
+		validation.Field(&x.Permissions, validation.Each(validation.By(validateAccountRolePermission))),
+	)
+}
+
+var _ validation.ValidatableWithContext = (*AccountRoleUpdateRequestInput)(nil)
+
+// ValidateWithContext validates an AccountRoleUpdateRequestInput.
+func (x *AccountRoleUpdateRequestInput) ValidateWithContext(ctx context.Context) error {
+	return validation.ValidateStructWithContext(ctx, x,
+		validation.Field(&x.Name, validation.NilOrNotEmpty, validation.NotIn(reservedAccountRoleNames...)),
+		validation.Field(&x.Permissions, validation.Each(validation.By(validateAccountRolePermission))),
+	)
+}
+
+var _ validation.ValidatableWithContext = (*AccountRoleDatabaseCreationInput)(nil)
+
+// ValidateWithContext validates an AccountRoleDatabaseCreationInput.
+func (x *AccountRoleDatabaseCreationInput) ValidateWithContext(ctx context.Context) error {
+	return validation.ValidateStructWithContext(ctx, x,
+		validation.Field(&x.ID, validation.Required),
+		validation.Field(&x.Name, validation.Required, validation.NotIn(reservedAccountRoleNames...)),
+		validation.Field(&x.BelongsToAccount, validation.Required),
+		validation.Field(&x.Permissions, validation.Each(validation.By(validateAccountRolePermission))),
+		validation.Field(&x.ParentRoleIDs, validation.By(func(value any) error {
+			if slices.Contains(x.ParentRoleIDs, x.ID) {
+				return ErrAccountRoleCannotInheritFromItself
+			}
+			return nil
+		})),
+	)
+}
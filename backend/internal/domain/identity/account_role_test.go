@@ -0,0 +1,151 @@
+package identity
+
+import (
+	"testing"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/authorization"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccountRole_Update(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		x := &AccountRole{
+			Name:        "cook",
+			Permissions: []string{string(authorization.ReadMealPlansPermission)},
+		}
+		name := "guest"
+		input := &AccountRoleUpdateRequestInput{
+			Name: &name,
+		}
+
+		x.Update(input)
+
+		assert.Equal(t, name, x.Name)
+		assert.Equal(t, []string{string(authorization.ReadMealPlansPermission)}, x.Permissions)
+	})
+
+	T.Run("replaces permissions and parents", func(t *testing.T) {
+		t.Parallel()
+
+		x := &AccountRole{
+			Permissions:   []string{string(authorization.ReadMealPlansPermission)},
+			ParentRoleIDs: []string{authorization.AccountMemberRoleID},
+		}
+		input := &AccountRoleUpdateRequestInput{
+			Permissions:   []string{},
+			ParentRoleIDs: []string{authorization.AccountAdminRoleID},
+		}
+
+		x.Update(input)
+
+		assert.Empty(t, x.Permissions)
+		assert.Equal(t, []string{authorization.AccountAdminRoleID}, x.ParentRoleIDs)
+	})
+}
+
+func TestAccountRoleCreationRequestInput_ValidateWithContext(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		x := &AccountRoleCreationRequestInput{
+			Name: "cook",
+			Permissions: []string{
+				string(authorization.UpdateMealPlanTasksPermission),
+				string(authorization.UpdateMealPlanGroceryListItemsPermission),
+			},
+		}
+
+		assert.NoError(t, x.ValidateWithContext(ctx))
+	})
+
+	T.Run("with reserved name", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		x := &AccountRoleCreationRequestInput{
+			Name: authorization.AccountAdminRoleName,
+		}
+
+		assert.Error(t, x.ValidateWithContext(ctx))
+	})
+
+	T.Run("with service permission", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		x := &AccountRoleCreationRequestInput{
+			Name:        "cook",
+			Permissions: []string{string(authorization.ImpersonateUserPermission)},
+		}
+
+		assert.Error(t, x.ValidateWithContext(ctx))
+	})
+}
+
+func TestAccountRoleUpdateRequestInput_ValidateWithContext(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		name := "guest"
+		x := &AccountRoleUpdateRequestInput{
+			Name:        &name,
+			Permissions: []string{string(authorization.ReadMealPlansPermission)},
+		}
+
+		assert.NoError(t, x.ValidateWithContext(ctx))
+	})
+
+	T.Run("with unknown permission", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		x := &AccountRoleUpdateRequestInput{
+			Permissions: []string{"fly.to_the_moon"},
+		}
+
+		assert.Error(t, x.ValidateWithContext(ctx))
+	})
+}
+
+func TestAccountRoleDatabaseCreationInput_ValidateWithContext(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		x := &AccountRoleDatabaseCreationInput{
+			ID:               "role",
+			Name:             "cook",
+			BelongsToAccount: "account",
+			ParentRoleIDs:    []string{authorization.AccountMemberRoleID},
+		}
+
+		assert.NoError(t, x.ValidateWithContext(ctx))
+	})
+
+	T.Run("inheriting from itself", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		x := &AccountRoleDatabaseCreationInput{
+			ID:               "role",
+			Name:             "cook",
+			BelongsToAccount: "account",
+			ParentRoleIDs:    []string{"role"},
+		}
+
+		assert.Error(t, x.ValidateWithContext(ctx))
+	})
+}
@@ -2,6 +2,7 @@
 
 import (
 	"testing"
+	"time"
 
 	fake "github.com/brianvoe/gofakeit/v7"
 	"github.com/stretchr/testify/assert"
@@ -63,3 +64,67 @@ func TestAccountCreationInputForNewUser(T *testing.T) {
 		assert.NotNil(t, AccountCreationInputForNewUser(&User{}))
 	})
 }
+
+func TestAccountWebhookEncryptionKeys_ActiveKeys(T *testing.T) {
+	T.Parallel()
+
+	T.Run("never rotated", func(t *testing.T) {
+		t.Parallel()
+
+		x := &AccountWebhookEncryptionKeys{Current: "current"}
+
+		assert.Equal(t, []string{"current"}, x.ActiveKeys(time.Now()))
+	})
+
+	T.Run("within grace period", func(t *testing.T) {
+		t.Parallel()
+
+		now := time.Now()
+		rotatedAt := now.Add(-time.Hour)
+		x := &AccountWebhookEncryptionKeys{Current: "current", Previous: "previous", RotatedAt: &rotatedAt}
+
+		assert.Equal(t, []string{"current", "previous"}, x.ActiveKeys(now))
+	})
+
+	T.Run("after grace period", func(t *testing.T) {
+		t.Parallel()
+
+		now := time.Now()
+		rotatedAt := now.Add(-WebhookEncryptionKeyRotationGracePeriod)
+		x := &AccountWebhookEncryptionKeys{Current: "current", Previous: "previous", RotatedAt: &rotatedAt}
+
+		assert.Equal(t, []string{"current"}, x.ActiveKeys(now))
+	})
+}
+
+func TestAccountWebhookEncryptionKeys_InRotationGracePeriod(T *testing.T) {
+	T.Parallel()
+
+	T.Run("never rotated", func(t *testing.T) {
+		t.Parallel()
+
+		x := &AccountWebhookEncryptionKeys{Current: "current"}
+
+		assert.False(t, x.InRotationGracePeriod(time.Now()))
+	})
+
+	T.Run("within grace period", func(t *testing.T) {
+		t.Parallel()
+
+		now := time.Now()
+		rotatedAt := now.Add(-time.Hour)
+		x := &AccountWebhookEncryptionKeys{Current: "current", Previous: "previous", RotatedAt: &rotatedAt}
+
+		assert.True(t, x.InRotationGracePeriod(now))
+	})
+
+	T.Run("after grace period", func(t *testing.T) {
+		t.Parallel()
+
+		now := time.Now()
+		rotatedAt := now.Add(-WebhookEncryptionKeyRotationGracePeriod)
+		x := &AccountWebhookEncryptionKeys{Current: "current", Previous: "previous", RotatedAt: &rotatedAt}
+
+		assert.False(t, x.InRotationGracePeriod(now))
+	})
+}
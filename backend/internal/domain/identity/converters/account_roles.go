@@ -0,0 +1,58 @@
+package converters
+
+import (
+	types "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity"
+
+	"github.com/primandproper/platform/identifiers"
+)
+
+// ConvertAccountRoleCreationInputToAccountRoleDatabaseCreationInput creates an AccountRoleDatabaseCreationInput from an AccountRoleCreationRequestInput.
+func ConvertAccountRoleCreationInputToAccountRoleDatabaseCreationInput(accountID string, input *types.AccountRoleCreationRequestInput) *types.AccountRoleDatabaseCreationInput {
+	x := &types.AccountRoleDatabaseCreationInput{
+		ID:               identifiers.New(),
+		Name:             input.Name,
+		Description:      input.Description,
+		BelongsToAccount: accountID,
+		Permissions:      input.Permissions,
+		ParentRoleIDs:    input.ParentRoleIDs,
+	}
+
+	return x
+}
+
+// ConvertAccountRoleToAccountRoleCreationRequestInput creates an AccountRoleCreationRequestInput from an AccountRole.
+func ConvertAccountRoleToAccountRoleCreationRequestInput(input *types.AccountRole) *types.AccountRoleCreationRequestInput {
+	return &types.AccountRoleCreationRequestInput{
+		Name:          input.Name,
+		Description:   input.Description,
+		Permissions:   input.Permissions,
+		ParentRoleIDs: input.ParentRoleIDs,
+	}
+}
+
+// ConvertAccountRoleToAccountRoleUpdateRequestInput creates an AccountRoleUpdateRequestInput from an AccountRole.
+func ConvertAccountRoleToAccountRoleUpdateRequestInput(input *types.AccountRole) *types.AccountRoleUpdateRequestInput {
+	return &types.AccountRoleUpdateRequestInput{
+		Name:          &input.Name,
+		Description:   &input.Description,
+		Permissions:   input.Permissions,
+		ParentRoleIDs: input.ParentRoleIDs,
+	}
+}
+
+// ConvertAccountRoleToAccountRoleDatabaseCreationInput creates an AccountRoleDatabaseCreationInput from an AccountRole.
+func ConvertAccountRoleToAccountRoleDatabaseCreationInput(input *types.AccountRole) *types.AccountRoleDatabaseCreationInput {
+	x := &types.AccountRoleDatabaseCreationInput{
+		ID:            input.ID,
+		Name:          input.Name,
+		Description:   input.Description,
+		Permissions:   input.Permissions,
+		ParentRoleIDs: input.ParentRoleIDs,
+	}
+
+	if input.BelongsToAccount != nil {
+		x.BelongsToAccount = *input.BelongsToAccount
+	}
+
+	return x
+}
@@ -0,0 +1,47 @@
+package fakes
+
+import (
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/authorization"
+	types "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity/converters"
+
+	fake "github.com/brianvoe/gofakeit/v7"
+)
+
+// BuildFakeAccountRole builds a faked account role.
+func BuildFakeAccountRole() *types.AccountRole {
+	return &types.AccountRole{
+		ID:               BuildFakeID(),
+		Name:             fake.UUID(),
+		Description:      buildUniqueString(),
+		BelongsToAccount: new(BuildFakeID()),
+		CreatedAt:        BuildFakeTime(),
+		Permissions: []string{
+			string(authorization.ReadMealPlansPermission),
+			string(authorization.UpdateMealPlanTasksPermission),
+		},
+		ParentRoleIDs: []string{authorization.AccountMemberRoleID},
+	}
+}
+
+// BuildFakeAccountRolesList builds a faked list of account roles.
+func BuildFakeAccountRolesList() []*types.AccountRole {
+	var examples []*types.AccountRole
+	for range exampleQuantity {
+		examples = append(examples, BuildFakeAccountRole())
+	}
+
+	return examples
+}
+
+// BuildFakeAccountRoleCreationRequestInput builds a faked AccountRoleCreationRequestInput.
+func BuildFakeAccountRoleCreationRequestInput() *types.AccountRoleCreationRequestInput {
+	accountRole := BuildFakeAccountRole()
+	return converters.ConvertAccountRoleToAccountRoleCreationRequestInput(accountRole)
+}
+
+// BuildFakeAccountRoleUpdateRequestInput builds a faked AccountRoleUpdateRequestInput.
+func BuildFakeAccountRoleUpdateRequestInput() *types.AccountRoleUpdateRequestInput {
+	accountRole := BuildFakeAccountRole()
+	return converters.ConvertAccountRoleToAccountRoleUpdateRequestInput(accountRole)
+}
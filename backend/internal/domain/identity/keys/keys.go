@@ -9,6 +9,8 @@
 	AccountInvitationKey = "account_invitation"
 	// AccountInvitationIDKey is the standard key for referring to an account ID.
 	AccountInvitationIDKey = AccountInvitationKey + idSuffix
+	// AccountRoleIDKey is the standard key for referring to an account role ID.
+	AccountRoleIDKey = "account_role" + idSuffix
 	// DestinationAccountIDKey is the context key for the destination account ID (e.g. in invitation events).
 	DestinationAccountIDKey = "destination_account"
 	// AccountInvitationTokenKey is the standard key for referring to an account invitation token.
@@ -0,0 +1,161 @@
+package manager
+
+import (
+	"context"
+	"slices"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/audit"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity/converters"
+	identitykeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity/keys"
+
+	"github.com/primandproper/platform/observability"
+)
+
+func (m *manager) CreateAccountRole(ctx context.Context, accountID string, input *identity.AccountRoleCreationRequestInput) (*identity.AccountRole, error) {
+	ctx, span := m.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if accountID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+
+	if input == nil {
+		return nil, ErrNilInputProvided
+	}
+
+	logger := observability.ObserveValues(map[string]any{
+		identitykeys.AccountIDKey: accountID,
+	}, span, m.logger)
+
+	if err := input.ValidateWithContext(ctx); err != nil {
+		return nil, observability.PrepareError(err, span, "validating account role creation input")
+	}
+
+	dbInput := converters.ConvertAccountRoleCreationInputToAccountRoleDatabaseCreationInput(accountID, input)
+	if err := dbInput.ValidateWithContext(ctx); err != nil {
+		return nil, observability.PrepareError(err, span, "validating account role database creation input")
+	}
+
+	created, err := m.identityRepo.CreateAccountRole(ctx, dbInput)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "creating account role")
+	}
+
+	m.dataChangesPublisher.PublishAsync(ctx, audit.BuildDataChangeMessageFromContext(ctx, logger, identity.AccountRoleCreatedServiceEventType, map[string]any{
+		identitykeys.AccountIDKey:     accountID,
+		identitykeys.AccountRoleIDKey: created.ID,
+	}))
+
+	return created, nil
+}
+
+func (m *manager) GetAccountRole(ctx context.Context, accountID, accountRoleID string) (*identity.AccountRole, error) {
+	ctx, span := m.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if accountID == "" || accountRoleID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+
+	logger := observability.ObserveValues(map[string]any{
+		identitykeys.AccountIDKey:     accountID,
+		identitykeys.AccountRoleIDKey: accountRoleID,
+	}, span, m.logger)
+
+	role, err := m.identityRepo.GetAccountRole(ctx, accountID, accountRoleID)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching account role")
+	}
+
+	return role, nil
+}
+
+func (m *manager) GetAccountRoles(ctx context.Context, accountID string) ([]*identity.AccountRole, error) {
+	ctx, span := m.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if accountID == "" {
+		return nil, ErrInvalidIDProvided
+	}
+
+	logger := observability.ObserveValues(map[string]any{
+		identitykeys.AccountIDKey: accountID,
+	}, span, m.logger)
+
+	roles, err := m.identityRepo.GetAccountRoles(ctx, accountID)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching account roles")
+	}
+
+	return roles, nil
+}
+
+func (m *manager) UpdateAccountRole(ctx context.Context, accountID, accountRoleID string, input *identity.AccountRoleUpdateRequestInput) error {
+	ctx, span := m.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if accountID == "" || accountRoleID == "" {
+		return ErrInvalidIDProvided
+	}
+
+	if input == nil {
+		return ErrNilInputProvided
+	}
+
+	logger := observability.ObserveValues(map[string]any{
+		identitykeys.AccountIDKey:     accountID,
+		identitykeys.AccountRoleIDKey: accountRoleID,
+	}, span, m.logger)
+
+	if err := input.ValidateWithContext(ctx); err != nil {
+		return observability.PrepareError(err, span, "validating account role update input")
+	}
+
+	if slices.Contains(input.ParentRoleIDs, accountRoleID) {
+		return observability.PrepareError(identity.ErrAccountRoleCannotInheritFromItself, span, "validating account role update input")
+	}
+
+	role, err := m.identityRepo.GetAccountRole(ctx, accountID, accountRoleID)
+	if err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "fetching account role")
+	}
+
+	role.Update(input)
+
+	if err = m.identityRepo.UpdateAccountRole(ctx, role); err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "updating account role")
+	}
+
+	m.dataChangesPublisher.PublishAsync(ctx, audit.BuildDataChangeMessageFromContext(ctx, logger, identity.AccountRoleUpdatedServiceEventType, map[string]any{
+		identitykeys.AccountIDKey:     accountID,
+		identitykeys.AccountRoleIDKey: accountRoleID,
+	}))
+
+	return nil
+}
+
+func (m *manager) ArchiveAccountRole(ctx context.Context, accountID, accountRoleID string) error {
+	ctx, span := m.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if accountID == "" || accountRoleID == "" {
+		return ErrInvalidIDProvided
+	}
+
+	logger := observability.ObserveValues(map[string]any{
+		identitykeys.AccountIDKey:     accountID,
+		identitykeys.AccountRoleIDKey: accountRoleID,
+	}, span, m.logger)
+
+	if err := m.identityRepo.ArchiveAccountRole(ctx, accountID, accountRoleID); err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "archiving account role")
+	}
+
+	m.dataChangesPublisher.PublishAsync(ctx, audit.BuildDataChangeMessageFromContext(ctx, logger, identity.AccountRoleArchivedServiceEventType, map[string]any{
+		identitykeys.AccountIDKey:     accountID,
+		identitykeys.AccountRoleIDKey: accountRoleID,
+	}))
+
+	return nil
+}
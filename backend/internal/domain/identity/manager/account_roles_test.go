@@ -0,0 +1,210 @@
+package manager
+
+import (
+	"testing"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/authorization"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity/fakes"
+	identitykeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity/keys"
+	identitymock "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity/mock"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/testutils"
+
+	"github.com/primandproper/platform/reflection"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestIdentityDataManager_CreateAccountRole(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		m := buildIdentityDataManagerForTest(t)
+
+		accountID := fakes.BuildFakeID()
+		input := fakes.BuildFakeAccountRoleCreationRequestInput()
+		expected := fakes.BuildFakeAccountRole()
+
+		expectations := setupExpectationsForIdentityDataManager(
+			m,
+			func(db *identitymock.RepositoryMock) {
+				db.On(reflection.GetMethodName(m.identityRepo.CreateAccountRole), testutils.ContextMatcher, testutils.MatchType[*identity.AccountRoleDatabaseCreationInput]()).Return(expected, nil)
+			},
+			nil,
+			nil,
+			nil,
+			nil,
+			map[string][]string{
+				identity.AccountRoleCreatedServiceEventType: {identitykeys.AccountIDKey, identitykeys.AccountRoleIDKey},
+			},
+		)
+
+		actual, err := m.CreateAccountRole(ctx, accountID, input)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, actual)
+
+		mock.AssertExpectationsForObjects(t, expectations...)
+	})
+
+	T.Run("with permission account roles cannot grant", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		m := buildIdentityDataManagerForTest(t)
+
+		input := fakes.BuildFakeAccountRoleCreationRequestInput()
+		input.Permissions = []string{string(authorization.ImpersonateUserPermission)}
+
+		actual, err := m.CreateAccountRole(ctx, fakes.BuildFakeID(), input)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
+
+func TestIdentityDataManager_GetAccountRole(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		m := buildIdentityDataManagerForTest(t)
+
+		accountID := fakes.BuildFakeID()
+		expected := fakes.BuildFakeAccountRole()
+
+		expectations := setupExpectationsForIdentityDataManager(
+			m,
+			func(db *identitymock.RepositoryMock) {
+				db.On(reflection.GetMethodName(m.identityRepo.GetAccountRole), testutils.ContextMatcher, accountID, expected.ID).Return(expected, nil)
+			},
+			nil,
+			nil,
+			nil,
+			nil,
+		)
+
+		actual, err := m.GetAccountRole(ctx, accountID, expected.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, actual)
+
+		mock.AssertExpectationsForObjects(t, expectations...)
+	})
+}
+
+func TestIdentityDataManager_GetAccountRoles(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		m := buildIdentityDataManagerForTest(t)
+
+		accountID := fakes.BuildFakeID()
+		expected := fakes.BuildFakeAccountRolesList()
+
+		expectations := setupExpectationsForIdentityDataManager(
+			m,
+			func(db *identitymock.RepositoryMock) {
+				db.On(reflection.GetMethodName(m.identityRepo.GetAccountRoles), testutils.ContextMatcher, accountID).Return(expected, nil)
+			},
+			nil,
+			nil,
+			nil,
+			nil,
+		)
+
+		actual, err := m.GetAccountRoles(ctx, accountID)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, actual)
+
+		mock.AssertExpectationsForObjects(t, expectations...)
+	})
+}
+
+func TestIdentityDataManager_UpdateAccountRole(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		m := buildIdentityDataManagerForTest(t)
+
+		accountID := fakes.BuildFakeID()
+		role := fakes.BuildFakeAccountRole()
+		input := fakes.BuildFakeAccountRoleUpdateRequestInput()
+
+		expectations := setupExpectationsForIdentityDataManager(
+			m,
+			func(db *identitymock.RepositoryMock) {
+				db.On(reflection.GetMethodName(m.identityRepo.GetAccountRole), testutils.ContextMatcher, accountID, role.ID).Return(role, nil)
+				db.On(reflection.GetMethodName(m.identityRepo.UpdateAccountRole), testutils.ContextMatcher, testutils.MatchType[*identity.AccountRole]()).Return(nil)
+			},
+			nil,
+			nil,
+			nil,
+			nil,
+			map[string][]string{
+				identity.AccountRoleUpdatedServiceEventType: {identitykeys.AccountIDKey, identitykeys.AccountRoleIDKey},
+			},
+		)
+
+		err := m.UpdateAccountRole(ctx, accountID, role.ID, input)
+		assert.NoError(t, err)
+
+		mock.AssertExpectationsForObjects(t, expectations...)
+	})
+
+	T.Run("inheriting from itself", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		m := buildIdentityDataManagerForTest(t)
+
+		roleID := fakes.BuildFakeID()
+		input := fakes.BuildFakeAccountRoleUpdateRequestInput()
+		input.ParentRoleIDs = []string{roleID}
+
+		err := m.UpdateAccountRole(ctx, fakes.BuildFakeID(), roleID, input)
+		assert.ErrorIs(t, err, identity.ErrAccountRoleCannotInheritFromItself)
+	})
+}
+
+func TestIdentityDataManager_ArchiveAccountRole(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		m := buildIdentityDataManagerForTest(t)
+
+		accountID := fakes.BuildFakeID()
+		accountRoleID := fakes.BuildFakeID()
+
+		expectations := setupExpectationsForIdentityDataManager(
+			m,
+			func(db *identitymock.RepositoryMock) {
+				db.On(reflection.GetMethodName(m.identityRepo.ArchiveAccountRole), testutils.ContextMatcher, accountID, accountRoleID).Return(nil)
+			},
+			nil,
+			nil,
+			nil,
+			nil,
+			map[string][]string{
+				identity.AccountRoleArchivedServiceEventType: {identitykeys.AccountIDKey, identitykeys.AccountRoleIDKey},
+			},
+		)
+
+		err := m.ArchiveAccountRole(ctx, accountID, accountRoleID)
+		assert.NoError(t, err)
+
+		mock.AssertExpectationsForObjects(t, expectations...)
+	})
+}
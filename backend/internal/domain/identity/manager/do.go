@@ -5,6 +5,7 @@
 
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/authentication"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/payments"
 	identityindexing "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/services/identity/indexing"
 
 	"github.com/primandproper/platform/messagequeue"
@@ -28,6 +29,7 @@ func RegisterIdentityDataManager(i do.Injector) {
 			do.MustInvoke[random.Generator](i),
 			do.MustInvoke[authentication.Hasher](i),
 			do.MustInvoke[identityindexing.UserTextSearcher](i),
+			do.MustInvoke[payments.EntitlementsChecker](i),
 			do.MustInvoke[*msgconfig.QueuesConfig](i),
 		)
 	})
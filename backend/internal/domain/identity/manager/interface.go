@@ -16,12 +16,16 @@
 		RejectAccountInvitation(ctx context.Context, accountID, accountInvitationID string, input *identity.AccountInvitationUpdateRequestInput) error
 		CancelAccountInvitation(ctx context.Context, accountID, accountInvitationID, note string) error
 		ArchiveAccount(ctx context.Context, accountID, ownerID string) error
+		ArchiveAccountRole(ctx context.Context, accountID, accountRoleID string) error
 		ArchiveUserMembership(ctx context.Context, userID, accountID string) error
 		ArchiveUser(ctx context.Context, userID string) error
 		CreateAccount(ctx context.Context, input *identity.AccountCreationRequestInput) (*identity.Account, error)
+		CreateAccountRole(ctx context.Context, accountID string, input *identity.AccountRoleCreationRequestInput) (*identity.AccountRole, error)
 		CreateAccountInvitation(ctx context.Context, userID, accountID string, input *identity.AccountInvitationCreationRequestInput) (*identity.AccountInvitation, error)
 		CreateUser(ctx context.Context, registrationInput *identity.UserRegistrationInput) (*identity.UserCreationResponse, error)
 		GetAccount(ctx context.Context, accountID string) (*identity.Account, error)
+		GetAccountRole(ctx context.Context, accountID, accountRoleID string) (*identity.AccountRole, error)
+		GetAccountRoles(ctx context.Context, accountID string) ([]*identity.AccountRole, error)
 		GetAccountInvitation(ctx context.Context, accountID, accountInvitationID string) (*identity.AccountInvitation, error)
 		GetAccounts(ctx context.Context, userID string, filter *filtering.QueryFilter) (*filtering.QueryFilteredResult[identity.Account], error)
 		GetReceivedAccountInvitations(ctx context.Context, userID string, filter *filtering.QueryFilter) (*filtering.QueryFilteredResult[identity.AccountInvitation], error)
@@ -36,7 +40,9 @@
 		SetDefaultAccount(ctx context.Context, userID, accountID string) error
 		TransferAccountOwnership(ctx context.Context, accountID string, input *identity.AccountOwnershipTransferInput) error
 		UpdateAccount(ctx context.Context, accountID string, input *identity.AccountUpdateRequestInput) error
+		RotateAccountWebhookEncryptionKey(ctx context.Context, accountID string) (string, error)
 		UpdateAccountBillingFields(ctx context.Context, accountID string, billingStatus, subscriptionPlanID, paymentProcessorCustomerID *string, lastPaymentProviderSyncOccurredAt *time.Time) error
+		UpdateAccountRole(ctx context.Context, accountID, accountRoleID string, input *identity.AccountRoleUpdateRequestInput) error
 		UpdateAccountMemberPermissions(ctx context.Context, userID, accountID string, input *identity.ModifyUserPermissionsInput) error
 		UpdateUserDetails(ctx context.Context, userID string, input *identity.UserDetailsUpdateRequestInput) error
 		UpdateUserEmailAddress(ctx context.Context, userID, newEmail string) error
@@ -170,6 +170,12 @@ func (m *IdentityDataManager) UpdateAccount(ctx context.Context, accountID strin
 	return m.Called(ctx, accountID, input).Error(0)
 }
 
+// RotateAccountWebhookEncryptionKey is a mock function.
+func (m *IdentityDataManager) RotateAccountWebhookEncryptionKey(ctx context.Context, accountID string) (string, error) {
+	args := m.Called(ctx, accountID)
+	return args.String(0), args.Error(1)
+}
+
 // UpdateAccountBillingFields is a mock function.
 func (m *IdentityDataManager) UpdateAccountBillingFields(ctx context.Context, accountID string, billingStatus, subscriptionPlanID, paymentProcessorCustomerID *string, lastPaymentProviderSyncOccurredAt *time.Time) error {
 	return m.Called(ctx, accountID, billingStatus, subscriptionPlanID, paymentProcessorCustomerID, lastPaymentProviderSyncOccurredAt).Error(0)
@@ -215,3 +221,31 @@ func (m *IdentityDataManager) UserRequiresPasswordChange(ctx context.Context, us
 	returnValues := m.Called(ctx, userID)
 	return returnValues.Bool(0), returnValues.Error(1)
 }
+
+// CreateAccountRole is a mock function.
+func (m *IdentityDataManager) CreateAccountRole(ctx context.Context, accountID string, input *identity.AccountRoleCreationRequestInput) (*identity.AccountRole, error) {
+	returnValues := m.Called(ctx, accountID, input)
+	return returnValues.Get(0).(*identity.AccountRole), returnValues.Error(1)
+}
+
+// GetAccountRole is a mock function.
+func (m *IdentityDataManager) GetAccountRole(ctx context.Context, accountID, accountRoleID string) (*identity.AccountRole, error) {
+	returnValues := m.Called(ctx, accountID, accountRoleID)
+	return returnValues.Get(0).(*identity.AccountRole), returnValues.Error(1)
+}
+
+// GetAccountRoles is a mock function.
+func (m *IdentityDataManager) GetAccountRoles(ctx context.Context, accountID string) ([]*identity.AccountRole, error) {
+	returnValues := m.Called(ctx, accountID)
+	return returnValues.Get(0).([]*identity.AccountRole), returnValues.Error(1)
+}
+
+// UpdateAccountRole is a mock function.
+func (m *IdentityDataManager) UpdateAccountRole(ctx context.Context, accountID, accountRoleID string, input *identity.AccountRoleUpdateRequestInput) error {
+	return m.Called(ctx, accountID, accountRoleID, input).Error(0)
+}
+
+// ArchiveAccountRole is a mock function.
+func (m *IdentityDataManager) ArchiveAccountRole(ctx context.Context, accountID, accountRoleID string) error {
+	return m.Called(ctx, accountID, accountRoleID).Error(0)
+}
@@ -17,6 +17,7 @@
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity/converters"
 	identitykeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity/keys"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/payments"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/services/identity/indexing"
 
 	"github.com/primandproper/platform/database"
@@ -44,6 +45,7 @@
 	base64ImagePrefix      = "data:image/jpeg;base64,"
 	minimumPasswordEntropy = 60
 	totpSecretSize         = 64
+	webhookKeySize         = 32
 )
 
 var (
@@ -64,6 +66,7 @@
 		secretGenerator      random.Generator
 		authenticator        authentication.Hasher
 		userSearchIndex      indexing.UserTextSearcher
+		entitlementsChecker  payments.EntitlementsChecker
 	}
 )
 
@@ -76,6 +79,7 @@ func NewIdentityDataManager(
 	secretGenerator random.Generator,
 	authenticator authentication.Hasher,
 	userSearchIndex indexing.UserTextSearcher,
+	entitlementsChecker payments.EntitlementsChecker,
 	cfg *msgconfig.QueuesConfig,
 ) (IdentityDataManager, error) {
 	publisher, err := publisherProvider.ProvidePublisher(ctx, cfg.DataChangesTopicName)
@@ -91,9 +95,38 @@ func NewIdentityDataManager(
 		secretGenerator:      secretGenerator,
 		authenticator:        authenticator,
 		userSearchIndex:      userSearchIndex,
+		entitlementsChecker:  entitlementsChecker,
 	}, nil
 }
 
+// checkAccountMemberEntitlements returns payments.ErrEntitlementLimitReached if the account cannot take on another member.
+func (m *manager) checkAccountMemberEntitlements(ctx context.Context, accountID string) error {
+	ctx, span := m.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := m.logger.WithSpan(span).WithValue(identitykeys.AccountIDKey, accountID)
+
+	entitlements, err := m.entitlementsChecker.GetEntitlementsForAccount(ctx, accountID)
+	if err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "fetching entitlements for account")
+	}
+
+	if entitlements.MaxAccountMembers == payments.UnlimitedQuantity {
+		return nil
+	}
+
+	account, err := m.identityRepo.GetAccount(ctx, accountID)
+	if err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "fetching account")
+	}
+
+	if !entitlements.AllowsAccountMembers(int64(len(account.Members))) {
+		return payments.ErrEntitlementLimitReached
+	}
+
+	return nil
+}
+
 func (m *manager) AcceptAccountInvitation(ctx context.Context, accountID, accountInvitationID string, input *identity.AccountInvitationUpdateRequestInput) error {
 	ctx, span := m.tracer.StartSpan(ctx)
 	defer span.End()
@@ -121,6 +154,10 @@ func (m *manager) AcceptAccountInvitation(ctx context.Context, accountID, accoun
 		return observability.PrepareError(err, span, "retrieving invitation")
 	}
 
+	if err = m.checkAccountMemberEntitlements(ctx, invitation.DestinationAccount.ID); err != nil {
+		return err
+	}
+
 	if err = m.identityRepo.AcceptAccountInvitation(ctx, accountID, accountInvitationID, input.Token, input.Note); err != nil {
 		return observability.PrepareAndLogError(err, logger, span, "accepting account invitation")
 	}
@@ -320,6 +357,10 @@ func (m *manager) CreateAccountInvitation(ctx context.Context, userID, accountID
 		identitykeys.AccountIDKey: accountID,
 	}, span, m.logger)
 
+	if err := m.checkAccountMemberEntitlements(ctx, accountID); err != nil {
+		return nil, err
+	}
+
 	token, err := m.secretGenerator.GenerateBase64EncodedString(ctx, 64)
 	if err != nil {
 		return nil, observability.PrepareError(err, span, "generating account invitation token")
@@ -826,6 +867,50 @@ func (m *manager) UpdateAccount(ctx context.Context, accountID string, input *id
 	return nil
 }
 
+// RotateAccountWebhookEncryptionKey generates a new webhook signing key for an account, and returns it.
+// The previous key remains active for identity.WebhookEncryptionKeyRotationGracePeriod, and the key can't
+// be rotated again until that has passed, so a receiver is never left holding a key that's already retired.
+func (m *manager) RotateAccountWebhookEncryptionKey(ctx context.Context, accountID string) (string, error) {
+	ctx, span := m.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if accountID == "" {
+		return "", ErrInvalidIDProvided
+	}
+
+	logger := observability.ObserveValues(map[string]any{
+		identitykeys.AccountIDKey: accountID,
+	}, span, m.logger)
+
+	currentKeys, err := m.identityRepo.GetAccountWebhookEncryptionKeys(ctx, accountID)
+	if err != nil {
+		return "", observability.PrepareAndLogError(err, logger, span, "fetching webhook encryption keys")
+	}
+
+	if currentKeys.InRotationGracePeriod(time.Now()) {
+		return "", observability.PrepareError(identity.ErrWebhookEncryptionKeyRotationInGracePeriod, span, "rotating webhook encryption key")
+	}
+
+	newKey, err := m.secretGenerator.GenerateHexEncodedString(ctx, webhookKeySize)
+	if err != nil {
+		return "", observability.PrepareAndLogError(err, logger, span, "generating webhook encryption key")
+	}
+
+	if err = m.identityRepo.RotateAccountWebhookEncryptionKey(ctx, accountID, newKey); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			// the account exists, so another rotation got there first.
+			return "", observability.PrepareError(identity.ErrWebhookEncryptionKeyRotationInGracePeriod, span, "rotating webhook encryption key")
+		}
+		return "", observability.PrepareAndLogError(err, logger, span, "rotating webhook encryption key")
+	}
+
+	m.dataChangesPublisher.PublishAsync(ctx, audit.BuildDataChangeMessageFromContext(ctx, logger, identity.AccountWebhookEncryptionKeyRotatedServiceEventType, map[string]any{
+		identitykeys.AccountIDKey: accountID,
+	}))
+
+	return newKey, nil
+}
+
 func (m *manager) UpdateAccountBillingFields(ctx context.Context, accountID string, billingStatus, subscriptionPlanID, paymentProcessorCustomerID *string, lastPaymentProviderSyncOccurredAt *time.Time) error {
 	ctx, span := m.tracer.StartSpan(ctx)
 	defer span.End()
@@ -2,13 +2,18 @@
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"testing"
+	"time"
 
 	mockauthn "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/authentication/mock"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity/fakes"
 	identitykeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity/keys"
 	identitymock "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity/mock"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/payments"
+	paymentsmock "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/payments/mock"
 	identityindexing "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/services/identity/indexing"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/testutils"
 
@@ -49,6 +54,7 @@ func buildIdentityDataManagerForTest(t *testing.T) *manager {
 		&randommock.GeneratorMock{},
 		&mockauthn.Authenticator{},
 		&mocksearch.IndexMock[identityindexing.UserSearchSubset]{},
+		&paymentsmock.EntitlementsChecker{},
 		queueCfg,
 	)
 	require.NoError(t, err)
@@ -100,6 +106,14 @@ func setupExpectationsForIdentityDataManager(
 	return []any{db, auth}
 }
 
+func setupEntitlementsForIdentityDataManager(manager *manager, accountID string, entitlements *payments.AccountEntitlements) *paymentsmock.EntitlementsChecker {
+	checker := &paymentsmock.EntitlementsChecker{}
+	checker.On(reflection.GetMethodName(checker.GetEntitlementsForAccount), testutils.ContextMatcher, accountID).Return(entitlements, nil)
+	manager.entitlementsChecker = checker
+
+	return checker
+}
+
 func TestIdentityDataManager_AcceptAccountInvitation(T *testing.T) {
 	T.Parallel()
 
@@ -131,10 +145,50 @@ func(db *identitymock.RepositoryMock) {
 			},
 		)
 
+		entitlementsChecker := setupEntitlementsForIdentityDataManager(m, invitation.DestinationAccount.ID, payments.UnrestrictedAccountEntitlements(invitation.DestinationAccount.ID))
+
 		err := m.AcceptAccountInvitation(ctx, accountID, accountInvitationID, input)
 		assert.NoError(t, err)
 
-		mock.AssertExpectationsForObjects(t, expectations...)
+		mock.AssertExpectationsForObjects(t, append(expectations, entitlementsChecker)...)
+	})
+
+	T.Run("with account member limit reached", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		m := buildIdentityDataManagerForTest(t)
+
+		accountID := fakes.BuildFakeID()
+		accountInvitationID := fakes.BuildFakeID()
+		input := fakes.BuildFakeAccountInvitationUpdateRequestInput()
+		invitation := fakes.BuildFakeAccountInvitation()
+		invitation.ID = accountInvitationID
+		invitation.Token = input.Token
+		destinationAccount := fakes.BuildFakeAccount()
+		destinationAccount.ID = invitation.DestinationAccount.ID
+
+		expectations := setupExpectationsForIdentityDataManager(
+			m,
+			func(db *identitymock.RepositoryMock) {
+				db.On(reflection.GetMethodName(m.identityRepo.GetAccountInvitationByTokenAndID), testutils.ContextMatcher, input.Token, accountInvitationID).Return(invitation, nil)
+				db.On(reflection.GetMethodName(m.identityRepo.GetAccount), testutils.ContextMatcher, destinationAccount.ID).Return(destinationAccount, nil)
+			},
+			nil,
+			nil,
+			nil,
+			nil,
+		)
+
+		entitlementsChecker := setupEntitlementsForIdentityDataManager(m, destinationAccount.ID, &payments.AccountEntitlements{
+			AccountID:         destinationAccount.ID,
+			MaxAccountMembers: int32(len(destinationAccount.Members)),
+		})
+
+		err := m.AcceptAccountInvitation(ctx, accountID, accountInvitationID, input)
+		assert.ErrorIs(t, err, payments.ErrEntitlementLimitReached)
+
+		mock.AssertExpectationsForObjects(t, append(expectations, entitlementsChecker)...)
 	})
 }
 
@@ -375,11 +429,86 @@ func(sg *randommock.GeneratorMock) {
 			},
 		)
 
+		entitlementsChecker := setupEntitlementsForIdentityDataManager(m, accountID, payments.UnrestrictedAccountEntitlements(accountID))
+
 		actual, err := m.CreateAccountInvitation(ctx, userID, accountID, input)
 		assert.NoError(t, err)
 		assert.Equal(t, expected, actual)
 
-		mock.AssertExpectationsForObjects(t, expectations...)
+		mock.AssertExpectationsForObjects(t, append(expectations, entitlementsChecker)...)
+	})
+
+	T.Run("within account member limit", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		m := buildIdentityDataManagerForTest(t)
+
+		userID := fakes.BuildFakeID()
+		account := fakes.BuildFakeAccount()
+		input := fakes.BuildFakeAccountInvitationCreationRequestInput()
+		expected := fakes.BuildFakeAccountInvitation()
+		token := "test-token"
+
+		expectations := setupExpectationsForIdentityDataManager(
+			m,
+			func(db *identitymock.RepositoryMock) {
+				db.On(reflection.GetMethodName(m.identityRepo.GetAccount), testutils.ContextMatcher, account.ID).Return(account, nil)
+				db.On(reflection.GetMethodName(m.identityRepo.CreateAccountInvitation), testutils.ContextMatcher, testutils.MatchType[*identity.AccountInvitationDatabaseCreationInput]()).Return(expected, nil)
+			},
+			nil,
+			func(sg *randommock.GeneratorMock) {
+				sg.GenerateBase64EncodedStringFunc = func(_ context.Context, _ int) (string, error) {
+					return token, nil
+				}
+			},
+			nil,
+			nil,
+		)
+
+		entitlementsChecker := setupEntitlementsForIdentityDataManager(m, account.ID, &payments.AccountEntitlements{
+			AccountID:         account.ID,
+			MaxAccountMembers: int32(len(account.Members) + 1),
+		})
+
+		actual, err := m.CreateAccountInvitation(ctx, userID, account.ID, input)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, actual)
+
+		mock.AssertExpectationsForObjects(t, append(expectations, entitlementsChecker)...)
+	})
+
+	T.Run("with account member limit reached", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		m := buildIdentityDataManagerForTest(t)
+
+		userID := fakes.BuildFakeID()
+		account := fakes.BuildFakeAccount()
+		input := fakes.BuildFakeAccountInvitationCreationRequestInput()
+
+		expectations := setupExpectationsForIdentityDataManager(
+			m,
+			func(db *identitymock.RepositoryMock) {
+				db.On(reflection.GetMethodName(m.identityRepo.GetAccount), testutils.ContextMatcher, account.ID).Return(account, nil)
+			},
+			nil,
+			nil,
+			nil,
+			nil,
+		)
+
+		entitlementsChecker := setupEntitlementsForIdentityDataManager(m, account.ID, &payments.AccountEntitlements{
+			AccountID:         account.ID,
+			MaxAccountMembers: int32(len(account.Members)),
+		})
+
+		actual, err := m.CreateAccountInvitation(ctx, userID, account.ID, input)
+		assert.ErrorIs(t, err, payments.ErrEntitlementLimitReached)
+		assert.Nil(t, actual)
+
+		mock.AssertExpectationsForObjects(t, append(expectations, entitlementsChecker)...)
 	})
 }
 
@@ -852,6 +981,145 @@ func(db *identitymock.RepositoryMock) {
 	})
 }
 
+func TestIdentityDataManager_RotateAccountWebhookEncryptionKey(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		m := buildIdentityDataManagerForTest(t)
+
+		accountID := fakes.BuildFakeID()
+		newKey := "deadbeefdeadbeefdeadbeefdeadbeef"
+
+		expectations := setupExpectationsForIdentityDataManager(
+			m,
+			func(db *identitymock.RepositoryMock) {
+				db.On(reflection.GetMethodName(m.identityRepo.GetAccountWebhookEncryptionKeys), testutils.ContextMatcher, accountID).Return(&identity.AccountWebhookEncryptionKeys{Current: "current"}, nil)
+				db.On(reflection.GetMethodName(m.identityRepo.RotateAccountWebhookEncryptionKey), testutils.ContextMatcher, accountID, newKey).Return(nil)
+			},
+			nil,
+			func(sg *randommock.GeneratorMock) {
+				sg.GenerateHexEncodedStringFunc = func(_ context.Context, _ int) (string, error) {
+					return newKey, nil
+				}
+			},
+			nil,
+			nil,
+			map[string][]string{
+				identity.AccountWebhookEncryptionKeyRotatedServiceEventType: {identitykeys.AccountIDKey},
+			},
+		)
+
+		actual, err := m.RotateAccountWebhookEncryptionKey(ctx, accountID)
+		assert.NoError(t, err)
+		assert.Equal(t, newKey, actual)
+
+		mock.AssertExpectationsForObjects(t, expectations...)
+	})
+
+	T.Run("with invalid account ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		m := buildIdentityDataManagerForTest(t)
+
+		actual, err := m.RotateAccountWebhookEncryptionKey(ctx, "")
+		assert.Error(t, err)
+		assert.Empty(t, actual)
+	})
+
+	T.Run("with error generating key", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		m := buildIdentityDataManagerForTest(t)
+
+		accountID := fakes.BuildFakeID()
+
+		expectations := setupExpectationsForIdentityDataManager(
+			m,
+			func(db *identitymock.RepositoryMock) {
+				db.On(reflection.GetMethodName(m.identityRepo.GetAccountWebhookEncryptionKeys), testutils.ContextMatcher, accountID).Return(&identity.AccountWebhookEncryptionKeys{Current: "current"}, nil)
+			},
+			nil,
+			func(sg *randommock.GeneratorMock) {
+				sg.GenerateHexEncodedStringFunc = func(_ context.Context, _ int) (string, error) {
+					return "", errors.New("blah")
+				}
+			},
+			nil,
+			nil,
+		)
+
+		actual, err := m.RotateAccountWebhookEncryptionKey(ctx, accountID)
+		assert.Error(t, err)
+		assert.Empty(t, actual)
+
+		mock.AssertExpectationsForObjects(t, expectations...)
+	})
+
+	T.Run("within previous rotation's grace period", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		m := buildIdentityDataManagerForTest(t)
+
+		accountID := fakes.BuildFakeID()
+		rotatedAt := time.Now().Add(-time.Hour)
+
+		expectations := setupExpectationsForIdentityDataManager(
+			m,
+			func(db *identitymock.RepositoryMock) {
+				db.On(reflection.GetMethodName(m.identityRepo.GetAccountWebhookEncryptionKeys), testutils.ContextMatcher, accountID).Return(&identity.AccountWebhookEncryptionKeys{Current: "current", Previous: "previous", RotatedAt: &rotatedAt}, nil)
+			},
+			nil,
+			nil,
+			nil,
+			nil,
+		)
+
+		actual, err := m.RotateAccountWebhookEncryptionKey(ctx, accountID)
+		assert.ErrorIs(t, err, identity.ErrWebhookEncryptionKeyRotationInGracePeriod)
+		assert.Empty(t, actual)
+
+		mock.AssertExpectationsForObjects(t, expectations...)
+	})
+
+	T.Run("with concurrent rotation", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		m := buildIdentityDataManagerForTest(t)
+
+		accountID := fakes.BuildFakeID()
+		newKey := "deadbeefdeadbeefdeadbeefdeadbeef"
+
+		expectations := setupExpectationsForIdentityDataManager(
+			m,
+			func(db *identitymock.RepositoryMock) {
+				db.On(reflection.GetMethodName(m.identityRepo.GetAccountWebhookEncryptionKeys), testutils.ContextMatcher, accountID).Return(&identity.AccountWebhookEncryptionKeys{Current: "current"}, nil)
+				db.On(reflection.GetMethodName(m.identityRepo.RotateAccountWebhookEncryptionKey), testutils.ContextMatcher, accountID, newKey).Return(sql.ErrNoRows)
+			},
+			nil,
+			func(sg *randommock.GeneratorMock) {
+				sg.GenerateHexEncodedStringFunc = func(_ context.Context, _ int) (string, error) {
+					return newKey, nil
+				}
+			},
+			nil,
+			nil,
+		)
+
+		actual, err := m.RotateAccountWebhookEncryptionKey(ctx, accountID)
+		assert.ErrorIs(t, err, identity.ErrWebhookEncryptionKeyRotationInGracePeriod)
+		assert.Empty(t, actual)
+
+		mock.AssertExpectationsForObjects(t, expectations...)
+	})
+}
+
 func TestIdentityDataManager_UpdateAccountMemberPermissions(T *testing.T) {
 	T.Parallel()
 
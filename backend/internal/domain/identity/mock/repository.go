@@ -73,6 +73,17 @@ func (m *RepositoryMock) UpdateAccountBillingFields(ctx context.Context, account
 	return m.Called(ctx, accountID, billingStatus, subscriptionPlanID, paymentProcessorCustomerID, lastPaymentProviderSyncOccurredAt).Error(0)
 }
 
+// GetAccountWebhookEncryptionKeys is a mock function.
+func (m *RepositoryMock) GetAccountWebhookEncryptionKeys(ctx context.Context, accountID string) (*identity.AccountWebhookEncryptionKeys, error) {
+	returnValues := m.Called(ctx, accountID)
+	return returnValues.Get(0).(*identity.AccountWebhookEncryptionKeys), returnValues.Error(1)
+}
+
+// RotateAccountWebhookEncryptionKey is a mock function.
+func (m *RepositoryMock) RotateAccountWebhookEncryptionKey(ctx context.Context, accountID, newKey string) error {
+	return m.Called(ctx, accountID, newKey).Error(0)
+}
+
 // ArchiveAccount is a mock function.
 func (m *RepositoryMock) ArchiveAccount(ctx context.Context, accountID, userID string) error {
 	return m.Called(ctx, accountID, userID).Error(0)
@@ -383,3 +394,31 @@ func (m *RepositoryMock) ArchiveWebAuthnCredential(ctx context.Context, id strin
 func (m *RepositoryMock) ArchiveWebAuthnCredentialForUser(ctx context.Context, id, userID string) error {
 	return m.Called(ctx, id, userID).Error(0)
 }
+
+// CreateAccountRole is a mock function.
+func (m *RepositoryMock) CreateAccountRole(ctx context.Context, input *identity.AccountRoleDatabaseCreationInput) (*identity.AccountRole, error) {
+	returnValues := m.Called(ctx, input)
+	return returnValues.Get(0).(*identity.AccountRole), returnValues.Error(1)
+}
+
+// GetAccountRole is a mock function.
+func (m *RepositoryMock) GetAccountRole(ctx context.Context, accountID, accountRoleID string) (*identity.AccountRole, error) {
+	returnValues := m.Called(ctx, accountID, accountRoleID)
+	return returnValues.Get(0).(*identity.AccountRole), returnValues.Error(1)
+}
+
+// GetAccountRoles is a mock function.
+func (m *RepositoryMock) GetAccountRoles(ctx context.Context, accountID string) ([]*identity.AccountRole, error) {
+	returnValues := m.Called(ctx, accountID)
+	return returnValues.Get(0).([]*identity.AccountRole), returnValues.Error(1)
+}
+
+// UpdateAccountRole is a mock function.
+func (m *RepositoryMock) UpdateAccountRole(ctx context.Context, updated *identity.AccountRole) error {
+	return m.Called(ctx, updated).Error(0)
+}
+
+// ArchiveAccountRole is a mock function.
+func (m *RepositoryMock) ArchiveAccountRole(ctx context.Context, accountID, accountRoleID string) error {
+	return m.Called(ctx, accountID, accountRoleID).Error(0)
+}
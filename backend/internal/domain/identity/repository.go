@@ -6,4 +6,5 @@ type Repository interface {
 	UserDataManager
 	AccountUserMembershipDataManager
 	WebAuthnCredentialDataManager
+	AccountRoleDataManager
 }
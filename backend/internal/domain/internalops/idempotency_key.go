@@ -0,0 +1,44 @@
+package internalops
+
+import (
+	"context"
+	"time"
+)
+
+type (
+	// IdempotencyKey records the outcome of a call made with an idempotency key.
+	// A key without a CompletedAt is still being handled.
+	IdempotencyKey struct {
+		CreatedAt          time.Time
+		ExpiresAt          time.Time
+		CompletedAt        *time.Time
+		ID                 string
+		Key                string
+		Method             string
+		Scope              string
+		RequestFingerprint string
+		ResponseType       string
+		Response           []byte
+	}
+
+	// IdempotencyKeyClaimInput is used to claim an idempotency key before handling a call.
+	IdempotencyKeyClaimInput struct {
+		ExpiresAt          time.Time
+		ID                 string
+		Key                string
+		Method             string
+		Scope              string
+		RequestFingerprint string
+	}
+
+	// IdempotencyKeyDataManager stores idempotency keys and the responses they were answered with.
+	IdempotencyKeyDataManager interface {
+		// ClaimIdempotencyKey claims a key, returning false when it's already claimed and hasn't expired.
+		ClaimIdempotencyKey(ctx context.Context, input *IdempotencyKeyClaimInput) (bool, error)
+		GetIdempotencyKey(ctx context.Context, scope, method, key string) (*IdempotencyKey, error)
+		CompleteIdempotencyKey(ctx context.Context, id, responseType string, response []byte) error
+		// ReleaseIdempotencyKey gives up a claim that was never completed, so the call can be retried.
+		ReleaseIdempotencyKey(ctx context.Context, id string) error
+		DeleteExpiredIdempotencyKeys(ctx context.Context) (int64, error)
+	}
+)
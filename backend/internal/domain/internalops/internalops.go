@@ -3,6 +3,8 @@
 import (
 	"context"
 	"time"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/audit"
 )
 
 type (
@@ -14,7 +16,12 @@
 	}
 
 	InternalOpsDataManager interface {
+		IdempotencyKeyDataManager
 		DeleteExpiredOAuth2ClientTokens(context.Context) (int64, error)
+		DeleteExpiredUserNotifications(context.Context) (int64, error)
+		DeleteExpiredWebhookDeliveries(context.Context) (int64, error)
+		DeleteExpiredMealPlanActivities(context.Context) (int64, error)
+		DeleteExpiredAuditLogEntries(ctx context.Context, retention *audit.RetentionConfig) (int64, error)
 		CreateQueueTestMessage(ctx context.Context, id, queueName string) error
 		AcknowledgeQueueTestMessage(ctx context.Context, id string) error
 		GetQueueTestMessage(ctx context.Context, id string) (*QueueTestMessage, error)
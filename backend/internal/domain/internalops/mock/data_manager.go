@@ -3,6 +3,7 @@
 import (
 	"context"
 
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/audit"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/internalops"
 
 	"github.com/stretchr/testify/mock"
@@ -19,6 +20,26 @@ func (m *InternalOpsDataManager) DeleteExpiredOAuth2ClientTokens(ctx context.Con
 	return args.Get(0).(int64), args.Error(1)
 }
 
+func (m *InternalOpsDataManager) DeleteExpiredUserNotifications(ctx context.Context) (int64, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *InternalOpsDataManager) DeleteExpiredWebhookDeliveries(ctx context.Context) (int64, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *InternalOpsDataManager) DeleteExpiredMealPlanActivities(ctx context.Context) (int64, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+func (m *InternalOpsDataManager) DeleteExpiredAuditLogEntries(ctx context.Context, retention *audit.RetentionConfig) (int64, error) {
+	args := m.Called(ctx, retention)
+	return args.Get(0).(int64), args.Error(1)
+}
+
 func (m *InternalOpsDataManager) CreateQueueTestMessage(ctx context.Context, id, queueName string) error {
 	return m.Called(ctx, id, queueName).Error(0)
 }
@@ -35,3 +56,26 @@ func (m *InternalOpsDataManager) GetQueueTestMessage(ctx context.Context, id str
 func (m *InternalOpsDataManager) PruneQueueTestMessages(ctx context.Context, queueName string) error {
 	return m.Called(ctx, queueName).Error(0)
 }
+
+func (m *InternalOpsDataManager) ClaimIdempotencyKey(ctx context.Context, input *internalops.IdempotencyKeyClaimInput) (bool, error) {
+	args := m.Called(ctx, input)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *InternalOpsDataManager) GetIdempotencyKey(ctx context.Context, scope, method, key string) (*internalops.IdempotencyKey, error) {
+	args := m.Called(ctx, scope, method, key)
+	return args.Get(0).(*internalops.IdempotencyKey), args.Error(1)
+}
+
+func (m *InternalOpsDataManager) CompleteIdempotencyKey(ctx context.Context, id, responseType string, response []byte) error {
+	return m.Called(ctx, id, responseType, response).Error(0)
+}
+
+func (m *InternalOpsDataManager) ReleaseIdempotencyKey(ctx context.Context, id string) error {
+	return m.Called(ctx, id).Error(0)
+}
+
+func (m *InternalOpsDataManager) DeleteExpiredIdempotencyKeys(ctx context.Context) (int64, error) {
+	args := m.Called(ctx)
+	return args.Get(0).(int64), args.Error(1)
+}
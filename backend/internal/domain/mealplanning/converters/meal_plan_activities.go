@@ -0,0 +1,19 @@
+package converters
+
+import (
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+)
+
+// ConvertMealPlanActivityToMealPlanActivityDatabaseCreationInput builds a MealPlanActivityDatabaseCreationInput from a MealPlanActivity.
+func ConvertMealPlanActivityToMealPlanActivityDatabaseCreationInput(input *mealplanning.MealPlanActivity) *mealplanning.MealPlanActivityDatabaseCreationInput {
+	return &mealplanning.MealPlanActivityDatabaseCreationInput{
+		ID:                   input.ID,
+		EventType:            input.EventType,
+		MealPlanEventID:      input.MealPlanEventID,
+		MealPlanOptionID:     input.MealPlanOptionID,
+		MealPlanOptionVoteID: input.MealPlanOptionVoteID,
+		MealPlanTaskID:       input.MealPlanTaskID,
+		ByUser:               input.ByUser,
+		BelongsToMealPlan:    input.BelongsToMealPlan,
+	}
+}
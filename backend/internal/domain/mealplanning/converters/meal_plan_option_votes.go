@@ -12,6 +12,7 @@ func ConvertMealPlanOptionVoteToMealPlanOptionVoteUpdateRequestInput(input *type
 		Notes:                   &input.Notes,
 		BelongsToMealPlanOption: input.BelongsToMealPlanOption,
 		Rank:                    &input.Rank,
+		Score:                   &input.Score,
 		Abstain:                 &input.Abstain,
 	}
 
@@ -39,6 +40,7 @@ func ConvertMealPlanOptionVoteCreationRequestInputToMealPlanOptionVoteDatabaseCr
 		ByUser:                  input.ByUser,
 		BelongsToMealPlanOption: input.BelongsToMealPlanOption,
 		Rank:                    input.Rank,
+		Score:                   input.Score,
 		Abstain:                 input.Abstain,
 	}
 }
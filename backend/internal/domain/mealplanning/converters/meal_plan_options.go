@@ -41,6 +41,7 @@ func ConvertMealPlanOptionVoteToMealPlanOptionVoteCreationRequestInput(mealPlanO
 			{
 				ID:                      mealPlanOptionVote.ID,
 				Rank:                    mealPlanOptionVote.Rank,
+				Score:                   mealPlanOptionVote.Score,
 				Abstain:                 mealPlanOptionVote.Abstain,
 				Notes:                   mealPlanOptionVote.Notes,
 				BelongsToMealPlanOption: mealPlanOptionVote.BelongsToMealPlanOption,
@@ -57,6 +58,7 @@ func ConvertMealPlanOptionVoteToMealPlanOptionVotesDatabaseCreationInput(mealPla
 			{
 				ID:                      mealPlanOptionVote.ID,
 				Rank:                    mealPlanOptionVote.Rank,
+				Score:                   mealPlanOptionVote.Score,
 				Abstain:                 mealPlanOptionVote.Abstain,
 				Notes:                   mealPlanOptionVote.Notes,
 				BelongsToMealPlanOption: mealPlanOptionVote.BelongsToMealPlanOption,
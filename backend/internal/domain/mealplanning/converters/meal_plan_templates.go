@@ -0,0 +1,131 @@
+package converters
+
+import (
+	"cmp"
+	"time"
+
+	types "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+
+	"github.com/primandproper/platform/identifiers"
+)
+
+// ConvertMealPlanTemplateCreationRequestInputToMealPlanTemplateDatabaseCreationInput creates a MealPlanTemplateDatabaseCreationInput from a MealPlanTemplateCreationRequestInput.
+func ConvertMealPlanTemplateCreationRequestInputToMealPlanTemplateDatabaseCreationInput(input *types.MealPlanTemplateCreationRequestInput) *types.MealPlanTemplateDatabaseCreationInput {
+	x := &types.MealPlanTemplateDatabaseCreationInput{
+		ID:                            identifiers.New(),
+		Name:                          input.Name,
+		Notes:                         input.Notes,
+		ElectionMethod:                cmp.Or(input.ElectionMethod, types.MealPlanElectionMethodSchulze),
+		RecurrenceRule:                input.RecurrenceRule,
+		Timezone:                      cmp.Or(input.Timezone, types.DefaultMealPlanTemplateTimezone),
+		Slots:                         input.Slots,
+		VotingDeadlineOffsetInSeconds: input.VotingDeadlineOffsetInSeconds,
+		CandidatesPerSlot:             cmp.Or(input.CandidatesPerSlot, types.DefaultMealPlanTemplateCandidatesPerSlot),
+		AvoidMealsFromLastPlans:       input.AvoidMealsFromLastPlans,
+	}
+
+	return x
+}
+
+// ConvertMealPlanTemplateToMealPlanTemplateCreationRequestInput builds a MealPlanTemplateCreationRequestInput from a MealPlanTemplate.
+func ConvertMealPlanTemplateToMealPlanTemplateCreationRequestInput(input *types.MealPlanTemplate) *types.MealPlanTemplateCreationRequestInput {
+	x := &types.MealPlanTemplateCreationRequestInput{
+		Name:                          input.Name,
+		Notes:                         input.Notes,
+		ElectionMethod:                input.ElectionMethod,
+		RecurrenceRule:                input.RecurrenceRule,
+		Timezone:                      input.Timezone,
+		Slots:                         input.Slots,
+		VotingDeadlineOffsetInSeconds: input.VotingDeadlineOffsetInSeconds,
+		CandidatesPerSlot:             input.CandidatesPerSlot,
+		AvoidMealsFromLastPlans:       input.AvoidMealsFromLastPlans,
+	}
+
+	return x
+}
+
+// ConvertMealPlanTemplateToMealPlanTemplateDatabaseCreationInput builds a MealPlanTemplateDatabaseCreationInput from a MealPlanTemplate.
+func ConvertMealPlanTemplateToMealPlanTemplateDatabaseCreationInput(input *types.MealPlanTemplate) *types.MealPlanTemplateDatabaseCreationInput {
+	x := &types.MealPlanTemplateDatabaseCreationInput{
+		NextInstantiationAt:           input.NextInstantiationAt,
+		ID:                            input.ID,
+		Name:                          input.Name,
+		Notes:                         input.Notes,
+		ElectionMethod:                input.ElectionMethod,
+		RecurrenceRule:                input.RecurrenceRule,
+		Timezone:                      input.Timezone,
+		BelongsToAccount:              input.BelongsToAccount,
+		CreatedByUser:                 input.CreatedByUser,
+		Slots:                         input.Slots,
+		VotingDeadlineOffsetInSeconds: input.VotingDeadlineOffsetInSeconds,
+		CandidatesPerSlot:             input.CandidatesPerSlot,
+		AvoidMealsFromLastPlans:       input.AvoidMealsFromLastPlans,
+	}
+
+	return x
+}
+
+// ConvertMealPlanTemplateToMealPlanTemplateUpdateRequestInput builds a MealPlanTemplateUpdateRequestInput from a MealPlanTemplate.
+func ConvertMealPlanTemplateToMealPlanTemplateUpdateRequestInput(input *types.MealPlanTemplate) *types.MealPlanTemplateUpdateRequestInput {
+	x := &types.MealPlanTemplateUpdateRequestInput{
+		Name:                          &input.Name,
+		Notes:                         &input.Notes,
+		ElectionMethod:                &input.ElectionMethod,
+		RecurrenceRule:                &input.RecurrenceRule,
+		Timezone:                      &input.Timezone,
+		VotingDeadlineOffsetInSeconds: &input.VotingDeadlineOffsetInSeconds,
+		CandidatesPerSlot:             &input.CandidatesPerSlot,
+		AvoidMealsFromLastPlans:       &input.AvoidMealsFromLastPlans,
+		Slots:                         input.Slots,
+	}
+
+	return x
+}
+
+// ConvertMealPlanTemplateToMealPlanCreationRequestInput builds the meal plan a template produces when it's instantiated
+// at the given time. candidates holds the meals to propose for each of the template's slots, in order; slots without
+// any are left out of the plan. The voting deadline falls the template's offset before the plan's first event.
+func ConvertMealPlanTemplateToMealPlanCreationRequestInput(template *types.MealPlanTemplate, instantiatedAt time.Time, candidates [][]string) (*types.MealPlanCreationRequestInput, error) {
+	eventTimes, err := template.EventTimes(instantiatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	x := &types.MealPlanCreationRequestInput{
+		Notes:          template.Notes,
+		ElectionMethod: template.ElectionMethod,
+		Events:         []*types.MealPlanEventCreationRequestInput{},
+	}
+
+	var firstEventStartsAt time.Time
+	for i, slot := range template.Slots {
+		if i >= len(candidates) || len(candidates[i]) == 0 {
+			continue
+		}
+
+		event := &types.MealPlanEventCreationRequestInput{
+			StartsAt: eventTimes[i].StartsAt,
+			EndsAt:   eventTimes[i].EndsAt,
+			Notes:    slot.Notes,
+			MealName: slot.MealName,
+			Options:  []*types.MealPlanOptionCreationRequestInput{},
+		}
+
+		for _, mealID := range candidates[i] {
+			event.Options = append(event.Options, &types.MealPlanOptionCreationRequestInput{
+				MealID:    mealID,
+				MealScale: 1,
+			})
+		}
+
+		if firstEventStartsAt.IsZero() || event.StartsAt.Before(firstEventStartsAt) {
+			firstEventStartsAt = event.StartsAt
+		}
+
+		x.Events = append(x.Events, event)
+	}
+
+	x.VotingDeadline = firstEventStartsAt.Add(-time.Duration(template.VotingDeadlineOffsetInSeconds) * time.Second)
+
+	return x, nil
+}
@@ -0,0 +1,67 @@
+package converters
+
+import (
+	types "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+
+	"github.com/primandproper/platform/identifiers"
+)
+
+// ConvertPantryItemToPantryItemUpdateRequestInput creates a PantryItemUpdateRequestInput from a PantryItem.
+func ConvertPantryItemToPantryItemUpdateRequestInput(x *types.PantryItem) *types.PantryItemUpdateRequestInput {
+	out := &types.PantryItemUpdateRequestInput{
+		ExpiresAt:              x.ExpiresAt,
+		Notes:                  &x.Notes,
+		StorageLocation:        &x.StorageLocation,
+		ValidIngredientID:      &x.Ingredient.ID,
+		ValidMeasurementUnitID: &x.MeasurementUnit.ID,
+		Quantity:               &x.Quantity,
+	}
+
+	return out
+}
+
+// ConvertPantryItemCreationRequestInputToPantryItemDatabaseCreationInput creates a DatabaseCreationInput from a CreationInput.
+func ConvertPantryItemCreationRequestInputToPantryItemDatabaseCreationInput(x *types.PantryItemCreationRequestInput) *types.PantryItemDatabaseCreationInput {
+	storageLocation := x.StorageLocation
+	if storageLocation == "" {
+		storageLocation = types.PantryStorageLocationPantry
+	}
+
+	out := &types.PantryItemDatabaseCreationInput{
+		ID:                     identifiers.New(),
+		ExpiresAt:              x.ExpiresAt,
+		Notes:                  x.Notes,
+		StorageLocation:        storageLocation,
+		ValidIngredientID:      x.ValidIngredientID,
+		ValidMeasurementUnitID: x.ValidMeasurementUnitID,
+		Quantity:               x.Quantity,
+	}
+
+	return out
+}
+
+// ConvertPantryItemToPantryItemCreationRequestInput builds a PantryItemCreationRequestInput from a PantryItem.
+func ConvertPantryItemToPantryItemCreationRequestInput(x *types.PantryItem) *types.PantryItemCreationRequestInput {
+	return &types.PantryItemCreationRequestInput{
+		ExpiresAt:              x.ExpiresAt,
+		Notes:                  x.Notes,
+		StorageLocation:        x.StorageLocation,
+		ValidIngredientID:      x.Ingredient.ID,
+		ValidMeasurementUnitID: x.MeasurementUnit.ID,
+		Quantity:               x.Quantity,
+	}
+}
+
+// ConvertPantryItemToPantryItemDatabaseCreationInput builds a PantryItemDatabaseCreationInput from a PantryItem.
+func ConvertPantryItemToPantryItemDatabaseCreationInput(x *types.PantryItem) *types.PantryItemDatabaseCreationInput {
+	return &types.PantryItemDatabaseCreationInput{
+		ID:                     x.ID,
+		ExpiresAt:              x.ExpiresAt,
+		Notes:                  x.Notes,
+		StorageLocation:        x.StorageLocation,
+		ValidIngredientID:      x.Ingredient.ID,
+		ValidMeasurementUnitID: x.MeasurementUnit.ID,
+		BelongsToAccount:       x.BelongsToAccount,
+		Quantity:               x.Quantity,
+	}
+}
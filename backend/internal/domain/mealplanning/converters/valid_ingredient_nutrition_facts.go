@@ -0,0 +1,52 @@
+package converters
+
+import (
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+
+	"github.com/primandproper/platform/identifiers"
+)
+
+// ConvertValidIngredientNutritionFactsToValidIngredientNutritionFactsUpdateRequestInput creates a ValidIngredientNutritionFactsUpdateRequestInput from a ValidIngredientNutritionFacts.
+func ConvertValidIngredientNutritionFactsToValidIngredientNutritionFactsUpdateRequestInput(input *mealplanning.ValidIngredientNutritionFacts) *mealplanning.ValidIngredientNutritionFactsUpdateRequestInput {
+	x := &mealplanning.ValidIngredientNutritionFactsUpdateRequestInput{
+		BasisMeasurementUnitID: &input.BasisMeasurementUnit.ID,
+		Notes:                  &input.Notes,
+		Nutrients:              &input.Nutrients,
+	}
+
+	return x
+}
+
+// ConvertValidIngredientNutritionFactsCreationRequestInputToValidIngredientNutritionFactsDatabaseCreationInput creates a ValidIngredientNutritionFactsDatabaseCreationInput from a ValidIngredientNutritionFactsCreationRequestInput.
+func ConvertValidIngredientNutritionFactsCreationRequestInputToValidIngredientNutritionFactsDatabaseCreationInput(input *mealplanning.ValidIngredientNutritionFactsCreationRequestInput) *mealplanning.ValidIngredientNutritionFactsDatabaseCreationInput {
+	x := &mealplanning.ValidIngredientNutritionFactsDatabaseCreationInput{
+		ID:                     identifiers.New(),
+		ValidIngredientID:      input.ValidIngredientID,
+		BasisMeasurementUnitID: input.BasisMeasurementUnitID,
+		Notes:                  input.Notes,
+		Nutrients:              input.Nutrients,
+	}
+
+	return x
+}
+
+// ConvertValidIngredientNutritionFactsToValidIngredientNutritionFactsCreationRequestInput builds a ValidIngredientNutritionFactsCreationRequestInput from a ValidIngredientNutritionFacts.
+func ConvertValidIngredientNutritionFactsToValidIngredientNutritionFactsCreationRequestInput(validIngredientNutritionFacts *mealplanning.ValidIngredientNutritionFacts) *mealplanning.ValidIngredientNutritionFactsCreationRequestInput {
+	return &mealplanning.ValidIngredientNutritionFactsCreationRequestInput{
+		ValidIngredientID:      validIngredientNutritionFacts.ValidIngredientID,
+		BasisMeasurementUnitID: validIngredientNutritionFacts.BasisMeasurementUnit.ID,
+		Notes:                  validIngredientNutritionFacts.Notes,
+		Nutrients:              validIngredientNutritionFacts.Nutrients,
+	}
+}
+
+// ConvertValidIngredientNutritionFactsToValidIngredientNutritionFactsDatabaseCreationInput builds a ValidIngredientNutritionFactsDatabaseCreationInput from a ValidIngredientNutritionFacts.
+func ConvertValidIngredientNutritionFactsToValidIngredientNutritionFactsDatabaseCreationInput(validIngredientNutritionFacts *mealplanning.ValidIngredientNutritionFacts) *mealplanning.ValidIngredientNutritionFactsDatabaseCreationInput {
+	return &mealplanning.ValidIngredientNutritionFactsDatabaseCreationInput{
+		ID:                     validIngredientNutritionFacts.ID,
+		ValidIngredientID:      validIngredientNutritionFacts.ValidIngredientID,
+		BasisMeasurementUnitID: validIngredientNutritionFacts.BasisMeasurementUnit.ID,
+		Notes:                  validIngredientNutritionFacts.Notes,
+		Nutrients:              validIngredientNutritionFacts.Nutrients,
+	}
+}
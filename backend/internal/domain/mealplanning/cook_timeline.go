@@ -0,0 +1,100 @@
+package mealplanning
+
+import (
+	"context"
+	"encoding/gob"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+const (
+	// maxCookTimelineCookCount is the most cooks a timeline can be scheduled for.
+	maxCookTimelineCookCount = 16
+)
+
+func init() {
+	gob.Register(new(CookTimeline))
+	gob.Register(new(CookTimelineRequestInput))
+}
+
+type (
+	// CookTimelineResourceConstraint limits how many of a given instrument or vessel can be in use at once.
+	CookTimelineResourceConstraint struct {
+		_ struct{} `json:"-"`
+
+		ID       string `json:"id"`
+		Quantity uint32 `json:"quantity"`
+	}
+
+	// CookTimelineRequestInput describes the kitchen a cook timeline should be scheduled for.
+	CookTimelineRequestInput struct {
+		_ struct{} `json:"-"`
+
+		// ServingTime defaults to the start of the meal plan event when omitted.
+		ServingTime           *time.Time                        `json:"servingTime,omitempty"`
+		InstrumentConstraints []*CookTimelineResourceConstraint `json:"instrumentConstraints"`
+		VesselConstraints     []*CookTimelineResourceConstraint `json:"vesselConstraints"`
+		CookCount             uint32                            `json:"cookCount"`
+	}
+
+	// CookTimelineStep is a recipe step placed on a cook timeline.
+	// Offsets are measured from the moment cooking has to begin, and Cook is zero for passive steps that run unattended.
+	CookTimelineStep struct {
+		_ struct{} `json:"-"`
+
+		StartsAt             time.Time `json:"startsAt"`
+		EndsAt               time.Time `json:"endsAt"`
+		RecipeStepID         string    `json:"recipeStepID"`
+		RecipeID             string    `json:"recipeID"`
+		RecipeName           string    `json:"recipeName"`
+		Label                string    `json:"label"`
+		StartOffsetInSeconds uint32    `json:"startOffsetInSeconds"`
+		EndOffsetInSeconds   uint32    `json:"endOffsetInSeconds"`
+		SlackInSeconds       uint32    `json:"slackInSeconds"`
+		StepIndex            uint32    `json:"stepIndex"`
+		Cook                 uint32    `json:"cook"`
+		Critical             bool      `json:"critical"`
+	}
+
+	// CookTimeline is a schedule for cooking every step of a meal so that it's ready at a given time.
+	CookTimeline struct {
+		_ struct{} `json:"-"`
+
+		StartsAt               time.Time           `json:"startsAt"`
+		ServingTime            time.Time           `json:"servingTime"`
+		MealID                 string              `json:"mealID"`
+		MealPlanOptionID       string              `json:"mealPlanOptionID"`
+		MermaidGanttChart      string              `json:"mermaidGanttChart"`
+		CriticalPath           []string            `json:"criticalPath"`
+		Steps                  []*CookTimelineStep `json:"steps"`
+		TotalDurationInSeconds uint32              `json:"totalDurationInSeconds"`
+		CriticalPathInSeconds  uint32              `json:"criticalPathInSeconds"`
+		CookCount              uint32              `json:"cookCount"`
+	}
+)
+
+var _ validation.ValidatableWithContext = (*CookTimelineResourceConstraint)(nil)
+
+// ValidateWithContext validates a CookTimelineResourceConstraint.
+func (x *CookTimelineResourceConstraint) ValidateWithContext(ctx context.Context) error {
+	return validation.ValidateStructWithContext(
+		ctx,
+		x,
+		validation.Field(&x.ID, validation.Required),
+		validation.Field(&x.Quantity, validation.Required),
+	)
+}
+
+var _ validation.ValidatableWithContext = (*CookTimelineRequestInput)(nil)
+
+// ValidateWithContext validates a CookTimelineRequestInput.
+func (x *CookTimelineRequestInput) ValidateWithContext(ctx context.Context) error {
+	return validation.ValidateStructWithContext(
+		ctx,
+		x,
+		validation.Field(&x.CookCount, validation.Max(uint32(maxCookTimelineCookCount))),
+		validation.Field(&x.InstrumentConstraints),
+		validation.Field(&x.VesselConstraints),
+	)
+}
@@ -0,0 +1,61 @@
+package mealplanning
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCookTimelineRequestInput_Validate(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		x := &CookTimelineRequestInput{
+			CookCount: 2,
+			InstrumentConstraints: []*CookTimelineResourceConstraint{
+				{ID: t.Name(), Quantity: 1},
+			},
+			VesselConstraints: []*CookTimelineResourceConstraint{
+				{ID: t.Name(), Quantity: 2},
+			},
+		}
+
+		actual := x.ValidateWithContext(t.Context())
+		assert.NoError(t, actual)
+	})
+
+	T.Run("with empty input", func(t *testing.T) {
+		t.Parallel()
+
+		x := &CookTimelineRequestInput{}
+
+		actual := x.ValidateWithContext(t.Context())
+		assert.NoError(t, actual)
+	})
+
+	T.Run("with too many cooks", func(t *testing.T) {
+		t.Parallel()
+
+		x := &CookTimelineRequestInput{
+			CookCount: maxCookTimelineCookCount + 1,
+		}
+
+		actual := x.ValidateWithContext(t.Context())
+		assert.Error(t, actual)
+	})
+
+	T.Run("with invalid constraint", func(t *testing.T) {
+		t.Parallel()
+
+		x := &CookTimelineRequestInput{
+			InstrumentConstraints: []*CookTimelineResourceConstraint{
+				{ID: t.Name()},
+			},
+		}
+
+		actual := x.ValidateWithContext(t.Context())
+		assert.Error(t, actual)
+	})
+}
@@ -8,5 +8,6 @@
 		UserIngredientPreferences   []UserIngredientPreference   `json:"userIngredientPreferences,omitempty"`
 		AccountInstrumentOwnerships []AccountInstrumentOwnership `json:"accountInstrumentOwnerships,omitempty"`
 		RecipeRatings               []RecipeRating               `json:"recipeRatings,omitempty"`
+		PantryItems                 []PantryItem                 `json:"pantryItems,omitempty"`
 	}
 )
@@ -0,0 +1,20 @@
+package elections
+
+import (
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+)
+
+// approvalMethod picks the candidate approved by the most voters, where every candidate a voter did not abstain on is approved.
+type approvalMethod struct{}
+
+func (approvalMethod) Tally(candidates []string, ballots []*Ballot) *mealplanning.MealPlanEventTallyReport {
+	approvals := map[string]float64{}
+
+	for _, ballot := range ballots {
+		for candidate := range ballot.Ranks {
+			approvals[candidate]++
+		}
+	}
+
+	return reportFromScores(candidates, approvals)
+}
@@ -0,0 +1,30 @@
+package elections
+
+import (
+	"testing"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApprovalMethod_Tally(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		candidates := []string{"a", "b"}
+		ballots := []*Ballot{
+			{Voter: "user1", Ranks: map[string]int{"a": 0, "b": 1}},
+			{Voter: "user2", Ranks: map[string]int{"b": 0}},
+		}
+
+		report := approvalMethod{}.Tally(candidates, ballots)
+		assert.Equal(t, "b", report.Winner)
+		assert.Equal(t, []*mealplanning.ElectionCandidateScore{
+			{Candidate: "a", Score: 1},
+			{Candidate: "b", Score: 2},
+		}, report.Scores)
+	})
+}
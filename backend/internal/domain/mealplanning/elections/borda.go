@@ -0,0 +1,29 @@
+package elections
+
+import (
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+)
+
+// bordaMethod awards each candidate a point for every candidate a ballot ranks below it. Unranked candidates are
+// treated as ranked below every ranked candidate.
+type bordaMethod struct{}
+
+func (bordaMethod) Tally(candidates []string, ballots []*Ballot) *mealplanning.MealPlanEventTallyReport {
+	points := map[string]float64{}
+
+	for _, ballot := range ballots {
+		for candidate, rank := range ballot.Ranks {
+			for _, other := range candidates {
+				if other == candidate {
+					continue
+				}
+
+				if otherRank, ok := ballot.Ranks[other]; !ok || otherRank > rank {
+					points[candidate]++
+				}
+			}
+		}
+	}
+
+	return reportFromScores(candidates, points)
+}
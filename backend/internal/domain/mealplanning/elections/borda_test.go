@@ -0,0 +1,45 @@
+package elections
+
+import (
+	"testing"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBordaMethod_Tally(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		candidates := []string{"a", "b", "c"}
+		ballots := []*Ballot{
+			{Voter: "user1", Ranks: map[string]int{"a": 0, "b": 1, "c": 2}},
+			{Voter: "user2", Ranks: map[string]int{"b": 0, "a": 1, "c": 2}},
+			{Voter: "user3", Ranks: map[string]int{"a": 0, "c": 1, "b": 2}},
+		}
+
+		report := bordaMethod{}.Tally(candidates, ballots)
+		assert.Equal(t, "a", report.Winner)
+		assert.Equal(t, []*mealplanning.ElectionCandidateScore{
+			{Candidate: "a", Score: 5},
+			{Candidate: "b", Score: 3},
+			{Candidate: "c", Score: 1},
+		}, report.Scores)
+	})
+
+	T.Run("with unranked candidates", func(t *testing.T) {
+		t.Parallel()
+
+		candidates := []string{"a", "b", "c"}
+		ballots := []*Ballot{
+			{Voter: "user1", Ranks: map[string]int{"c": 0}},
+		}
+
+		report := bordaMethod{}.Tally(candidates, ballots)
+		assert.Equal(t, "c", report.Winner)
+		assert.Equal(t, float64(2), report.Scores[2].Score)
+	})
+}
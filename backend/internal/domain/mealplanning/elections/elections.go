@@ -0,0 +1,165 @@
+// Package elections implements the ranked-choice election methods used to pick a meal plan event's option.
+//
+// Each method tallies the same ballots, built from a meal plan event's option votes, into a
+// MealPlanEventTallyReport that explains how the winner was chosen.
+package elections
+
+import (
+	"math/rand/v2"
+	"slices"
+	"strings"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+
+	platformerrors "github.com/primandproper/platform/errors"
+)
+
+// ErrUnknownElectionMethod is returned when a meal plan names an election method this package does not implement.
+var ErrUnknownElectionMethod = platformerrors.New("unknown election method")
+
+type (
+	// Ballot is one voter's ranking of the candidates they did not abstain on. Lower ranks are preferred.
+	// Scores holds the same voter's scores for those candidates, which only score elections read.
+	Ballot struct {
+		Ranks  map[string]int
+		Scores map[string]int
+		Voter  string
+	}
+
+	// Method decides a winner among candidates from a set of ballots.
+	Method interface {
+		// Tally returns a report describing the winner and how they were chosen.
+		Tally(candidates []string, ballots []*Ballot) *mealplanning.MealPlanEventTallyReport
+	}
+)
+
+var methods = map[string]Method{
+	mealplanning.MealPlanElectionMethodSchulze:       schulzeMethod{},
+	mealplanning.MealPlanElectionMethodInstantRunoff: instantRunoffMethod{},
+	mealplanning.MealPlanElectionMethodBorda:         bordaMethod{},
+	mealplanning.MealPlanElectionMethodApproval:      approvalMethod{},
+	mealplanning.MealPlanElectionMethodScore:         scoreMethod{},
+}
+
+// ForMethod returns the Method for a meal plan election method name.
+func ForMethod(electionMethod string) (Method, error) {
+	method, ok := methods[electionMethod]
+	if !ok {
+		return nil, ErrUnknownElectionMethod
+	}
+
+	return method, nil
+}
+
+// BallotsFromOptions builds ballots from a meal plan event's option votes. Candidates are the options that received
+// at least one vote, and abstentions leave an option off of the voter's ballot.
+func BallotsFromOptions(options []*mealplanning.MealPlanOption) (candidates []string, ballots []*Ballot) {
+	ballotsByVoter := map[string]*Ballot{}
+	candidateSet := map[string]struct{}{}
+
+	for _, option := range options {
+		for _, vote := range option.Votes {
+			ballot, ok := ballotsByVoter[vote.ByUser]
+			if !ok {
+				ballot = &Ballot{Voter: vote.ByUser, Ranks: map[string]int{}, Scores: map[string]int{}}
+				ballotsByVoter[vote.ByUser] = ballot
+			}
+
+			if !vote.Abstain {
+				ballot.Ranks[vote.BelongsToMealPlanOption] = int(vote.Rank)
+				ballot.Scores[vote.BelongsToMealPlanOption] = int(vote.Score)
+			}
+
+			candidateSet[vote.BelongsToMealPlanOption] = struct{}{}
+		}
+	}
+
+	candidates = []string{}
+	for candidate := range candidateSet {
+		candidates = append(candidates, candidate)
+	}
+	slices.Sort(candidates)
+
+	ballots = []*Ballot{}
+	for _, ballot := range ballotsByVoter {
+		ballots = append(ballots, ballot)
+	}
+	slices.SortFunc(ballots, func(a, b *Ballot) int {
+		return strings.Compare(a.Voter, b.Voter)
+	})
+
+	return candidates, ballots
+}
+
+// Tally decides a meal plan event's winning option with the named election method.
+// It returns a nil report when no option received any votes.
+func Tally(electionMethod string, options []*mealplanning.MealPlanOption) (*mealplanning.MealPlanEventTallyReport, error) {
+	method, err := ForMethod(electionMethod)
+	if err != nil {
+		return nil, err
+	}
+
+	candidates, ballots := BallotsFromOptions(options)
+	if len(candidates) == 0 {
+		return nil, nil
+	}
+
+	report := method.Tally(candidates, ballots)
+	report.ElectionMethod = electionMethod
+	report.Candidates = candidates
+	report.BallotCount = len(ballots)
+	report.TieBroken = report.TieBreak != nil
+
+	return report, nil
+}
+
+// breakTie chooses one of several tied candidates at random.
+func breakTie(tied []string) *mealplanning.ElectionTieBreak {
+	tiedCandidates := slices.Clone(tied)
+	slices.Sort(tiedCandidates)
+
+	return &mealplanning.ElectionTieBreak{
+		Strategy:       mealplanning.ElectionTieBreakStrategyRandom,
+		TiedCandidates: tiedCandidates,
+		/* #nosec: G404 */
+		Chosen: tiedCandidates[rand.N(len(tiedCandidates))],
+	}
+}
+
+// reportFromScores builds a report for methods where the candidate with the highest score wins.
+func reportFromScores(candidates []string, scores map[string]float64) *mealplanning.MealPlanEventTallyReport {
+	report := &mealplanning.MealPlanEventTallyReport{
+		Scores: scoresInCandidateOrder(candidates, scores),
+	}
+
+	leaders := []string{}
+	for _, candidate := range candidates {
+		switch {
+		case len(leaders) == 0 || scores[candidate] > scores[leaders[0]]:
+			leaders = []string{candidate}
+		case scores[candidate] == scores[leaders[0]]:
+			leaders = append(leaders, candidate)
+		}
+	}
+
+	if len(leaders) > 1 {
+		report.TieBreak = breakTie(leaders)
+		report.Winner = report.TieBreak.Chosen
+	} else {
+		report.Winner = leaders[0]
+	}
+
+	return report
+}
+
+func scoresInCandidateOrder(candidates []string, scores map[string]float64) []*mealplanning.ElectionCandidateScore {
+	results := make([]*mealplanning.ElectionCandidateScore, 0, len(candidates))
+	for _, candidate := range candidates {
+		results = append(results, &mealplanning.ElectionCandidateScore{
+			Candidate: candidate,
+			Score:     scores[candidate],
+		})
+	}
+
+	return results
+}
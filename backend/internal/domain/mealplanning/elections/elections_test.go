@@ -0,0 +1,150 @@
+package elections
+
+import (
+	"testing"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestForMethod(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		for _, electionMethod := range []string{
+			mealplanning.MealPlanElectionMethodSchulze,
+			mealplanning.MealPlanElectionMethodInstantRunoff,
+			mealplanning.MealPlanElectionMethodBorda,
+			mealplanning.MealPlanElectionMethodApproval,
+			mealplanning.MealPlanElectionMethodScore,
+		} {
+			method, err := ForMethod(electionMethod)
+			assert.NoError(t, err)
+			assert.NotNil(t, method)
+		}
+	})
+
+	T.Run("with unknown method", func(t *testing.T) {
+		t.Parallel()
+
+		method, err := ForMethod(t.Name())
+		assert.ErrorIs(t, err, ErrUnknownElectionMethod)
+		assert.Nil(t, method)
+	})
+}
+
+func TestBallotsFromOptions(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		options := []*mealplanning.MealPlanOption{
+			{
+				ID: "a",
+				Votes: []*mealplanning.MealPlanOptionVote{
+					{BelongsToMealPlanOption: "a", ByUser: "user2", Rank: 1, Score: 4},
+					{BelongsToMealPlanOption: "a", ByUser: "user1", Rank: 0, Score: 7},
+				},
+			},
+			{
+				ID: "b",
+				Votes: []*mealplanning.MealPlanOptionVote{
+					{BelongsToMealPlanOption: "b", ByUser: "user1", Abstain: true},
+				},
+			},
+			{
+				ID: "c",
+			},
+		}
+
+		candidates, ballots := BallotsFromOptions(options)
+		assert.Equal(t, []string{"a", "b"}, candidates)
+		assert.Equal(t, []*Ballot{
+			{Voter: "user1", Ranks: map[string]int{"a": 0}, Scores: map[string]int{"a": 7}},
+			{Voter: "user2", Ranks: map[string]int{"a": 1}, Scores: map[string]int{"a": 4}},
+		}, ballots)
+	})
+}
+
+func TestTally(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		options := []*mealplanning.MealPlanOption{
+			{ID: "a", Votes: []*mealplanning.MealPlanOptionVote{{BelongsToMealPlanOption: "a", ByUser: "user1", Rank: 0}}},
+			{ID: "b", Votes: []*mealplanning.MealPlanOptionVote{{BelongsToMealPlanOption: "b", ByUser: "user1", Rank: 1}}},
+		}
+
+		report, err := Tally(mealplanning.MealPlanElectionMethodSchulze, options)
+		require.NoError(t, err)
+		assert.Equal(t, "a", report.Winner)
+		assert.Equal(t, mealplanning.MealPlanElectionMethodSchulze, report.ElectionMethod)
+		assert.Equal(t, []string{"a", "b"}, report.Candidates)
+		assert.Equal(t, 1, report.BallotCount)
+		assert.False(t, report.TieBroken)
+	})
+
+	T.Run("with score method", func(t *testing.T) {
+		t.Parallel()
+
+		options := []*mealplanning.MealPlanOption{
+			{ID: "a", Votes: []*mealplanning.MealPlanOptionVote{{BelongsToMealPlanOption: "a", ByUser: "user1", Rank: 0, Score: 2}}},
+			{ID: "b", Votes: []*mealplanning.MealPlanOptionVote{{BelongsToMealPlanOption: "b", ByUser: "user1", Rank: 1, Score: 9}}},
+		}
+
+		report, err := Tally(mealplanning.MealPlanElectionMethodScore, options)
+		require.NoError(t, err)
+		assert.Equal(t, "b", report.Winner)
+	})
+
+	T.Run("without votes", func(t *testing.T) {
+		t.Parallel()
+
+		report, err := Tally(mealplanning.MealPlanElectionMethodSchulze, []*mealplanning.MealPlanOption{{ID: "a"}})
+		assert.NoError(t, err)
+		assert.Nil(t, report)
+	})
+
+	T.Run("with unknown method", func(t *testing.T) {
+		t.Parallel()
+
+		report, err := Tally(t.Name(), nil)
+		assert.ErrorIs(t, err, ErrUnknownElectionMethod)
+		assert.Nil(t, report)
+	})
+}
+
+func Test_reportFromScores(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		report := reportFromScores([]string{"a", "b", "c"}, map[string]float64{"a": 1, "b": 3, "c": 2})
+		assert.Equal(t, "b", report.Winner)
+		assert.Nil(t, report.TieBreak)
+		assert.Equal(t, []*mealplanning.ElectionCandidateScore{
+			{Candidate: "a", Score: 1},
+			{Candidate: "b", Score: 3},
+			{Candidate: "c", Score: 2},
+		}, report.Scores)
+	})
+
+	T.Run("with tie", func(t *testing.T) {
+		t.Parallel()
+
+		report := reportFromScores([]string{"a", "b", "c"}, map[string]float64{"a": 3, "b": 1, "c": 3})
+		require.NotNil(t, report.TieBreak)
+		assert.Equal(t, []string{"a", "c"}, report.TieBreak.TiedCandidates)
+		assert.Equal(t, mealplanning.ElectionTieBreakStrategyRandom, report.TieBreak.Strategy)
+		assert.Contains(t, []string{"a", "c"}, report.Winner)
+		assert.Equal(t, report.TieBreak.Chosen, report.Winner)
+	})
+}
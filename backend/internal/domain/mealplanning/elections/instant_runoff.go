@@ -0,0 +1,93 @@
+package elections
+
+import (
+	"slices"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+)
+
+// instantRunoffMethod repeatedly eliminates the candidate with the fewest first preferences until one holds a majority.
+// A ballot that ranks several remaining candidates equally splits its vote between them.
+type instantRunoffMethod struct{}
+
+func (instantRunoffMethod) Tally(candidates []string, ballots []*Ballot) *mealplanning.MealPlanEventTallyReport {
+	report := &mealplanning.MealPlanEventTallyReport{
+		Rounds: []*mealplanning.ElectionRound{},
+	}
+
+	remaining := slices.Clone(candidates)
+	for number := 1; ; number++ {
+		counts, active := firstPreferences(remaining, ballots)
+		round := &mealplanning.ElectionRound{
+			Number:     number,
+			Counts:     scoresInCandidateOrder(remaining, counts),
+			Eliminated: []string{},
+		}
+		report.Rounds = append(report.Rounds, round)
+		report.Scores = round.Counts
+
+		highest, lowest := counts[remaining[0]], counts[remaining[0]]
+		for _, candidate := range remaining {
+			highest = max(highest, counts[candidate])
+			lowest = min(lowest, counts[candidate])
+		}
+
+		for _, candidate := range remaining {
+			if counts[candidate] == highest && (highest*2 > active || len(remaining) == 1) {
+				report.Winner = candidate
+				return report
+			}
+		}
+
+		if highest == lowest {
+			report.TieBreak = breakTie(remaining)
+			report.Winner = report.TieBreak.Chosen
+			return report
+		}
+
+		for _, candidate := range remaining {
+			if counts[candidate] == lowest {
+				round.Eliminated = append(round.Eliminated, candidate)
+			}
+		}
+
+		remaining = slices.DeleteFunc(remaining, func(candidate string) bool {
+			return slices.Contains(round.Eliminated, candidate)
+		})
+	}
+}
+
+// firstPreferences counts each ballot's most preferred remaining candidates, and how many ballots still rank any of them.
+func firstPreferences(remaining []string, ballots []*Ballot) (counts map[string]float64, active float64) {
+	counts = map[string]float64{}
+
+	for _, ballot := range ballots {
+		var (
+			best      int
+			preferred []string
+		)
+
+		for _, candidate := range remaining {
+			rank, ok := ballot.Ranks[candidate]
+			switch {
+			case !ok:
+				continue
+			case len(preferred) == 0 || rank < best:
+				best, preferred = rank, []string{candidate}
+			case rank == best:
+				preferred = append(preferred, candidate)
+			}
+		}
+
+		if len(preferred) == 0 {
+			continue
+		}
+
+		active++
+		for _, candidate := range preferred {
+			counts[candidate] += 1 / float64(len(preferred))
+		}
+	}
+
+	return counts, active
+}
@@ -0,0 +1,65 @@
+package elections
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInstantRunoffMethod_Tally(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		candidates := []string{"a", "b", "c"}
+		ballots := []*Ballot{
+			{Voter: "user1", Ranks: map[string]int{"a": 0, "b": 1, "c": 2}},
+			{Voter: "user2", Ranks: map[string]int{"a": 0, "b": 1, "c": 2}},
+			{Voter: "user3", Ranks: map[string]int{"b": 0, "c": 1, "a": 2}},
+			{Voter: "user4", Ranks: map[string]int{"b": 0, "c": 1, "a": 2}},
+			{Voter: "user5", Ranks: map[string]int{"c": 0, "b": 1, "a": 2}},
+		}
+
+		report := instantRunoffMethod{}.Tally(candidates, ballots)
+		assert.Equal(t, "b", report.Winner)
+		assert.Nil(t, report.TieBreak)
+
+		require.Len(t, report.Rounds, 2)
+		assert.Equal(t, []string{"c"}, report.Rounds[0].Eliminated)
+		assert.Equal(t, 2, report.Rounds[1].Number)
+		assert.Empty(t, report.Rounds[1].Eliminated)
+		assert.Equal(t, report.Rounds[1].Counts, report.Scores)
+	})
+
+	T.Run("with majority in first round", func(t *testing.T) {
+		t.Parallel()
+
+		candidates := []string{"a", "b"}
+		ballots := []*Ballot{
+			{Voter: "user1", Ranks: map[string]int{"a": 0, "b": 1}},
+			{Voter: "user2", Ranks: map[string]int{"a": 0, "b": 1}},
+			{Voter: "user3", Ranks: map[string]int{"b": 0, "a": 1}},
+		}
+
+		report := instantRunoffMethod{}.Tally(candidates, ballots)
+		assert.Equal(t, "a", report.Winner)
+		assert.Len(t, report.Rounds, 1)
+	})
+
+	T.Run("with tie", func(t *testing.T) {
+		t.Parallel()
+
+		candidates := []string{"a", "b"}
+		ballots := []*Ballot{
+			{Voter: "user1", Ranks: map[string]int{"a": 0, "b": 1}},
+			{Voter: "user2", Ranks: map[string]int{"b": 0, "a": 1}},
+		}
+
+		report := instantRunoffMethod{}.Tally(candidates, ballots)
+		require.NotNil(t, report.TieBreak)
+		assert.Equal(t, candidates, report.TieBreak.TiedCandidates)
+		assert.Contains(t, candidates, report.Winner)
+	})
+}
@@ -0,0 +1,45 @@
+package elections
+
+import (
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+
+	"resenje.org/schulze"
+)
+
+// schulzeMethod picks the candidate that beats every other along the strongest paths of pairwise preferences.
+type schulzeMethod struct{}
+
+func (schulzeMethod) Tally(candidates []string, ballots []*Ballot) *mealplanning.MealPlanEventTallyReport {
+	voting := schulze.NewVoting(candidates)
+	for _, ballot := range ballots {
+		// candidates are derived from the ballots, so a ballot can never name an unknown choice.
+		_, _ = voting.Vote(schulze.Ballot[string](ballot.Ranks))
+	}
+
+	results, duels, _ := voting.Compute()
+
+	wins := map[string]float64{}
+	for _, result := range results {
+		wins[result.Choice] = float64(result.Wins)
+	}
+
+	report := reportFromScores(candidates, wins)
+
+	report.PairwisePreferences = []*mealplanning.ElectionPairwisePreference{}
+	for duel := duels(); duel != nil; duel = duels() {
+		report.PairwisePreferences = append(report.PairwisePreferences,
+			&mealplanning.ElectionPairwisePreference{
+				Candidate: duel.Left.Choice,
+				Opponent:  duel.Right.Choice,
+				Strength:  duel.Left.Strength,
+			},
+			&mealplanning.ElectionPairwisePreference{
+				Candidate: duel.Right.Choice,
+				Opponent:  duel.Left.Choice,
+				Strength:  duel.Right.Strength,
+			},
+		)
+	}
+
+	return report
+}
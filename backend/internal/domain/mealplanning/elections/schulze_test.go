@@ -0,0 +1,43 @@
+package elections
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSchulzeMethod_Tally(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		candidates := []string{"a", "b", "c"}
+		ballots := []*Ballot{
+			{Voter: "user1", Ranks: map[string]int{"a": 0, "b": 2, "c": 1}},
+			{Voter: "user2", Ranks: map[string]int{"a": 0, "b": 1, "c": 2}},
+			{Voter: "user3", Ranks: map[string]int{"a": 1, "b": 0, "c": 2}},
+			{Voter: "user4", Ranks: map[string]int{"a": 2, "b": 1, "c": 0}},
+		}
+
+		report := schulzeMethod{}.Tally(candidates, ballots)
+		assert.Equal(t, "a", report.Winner)
+		assert.Nil(t, report.TieBreak)
+		assert.Len(t, report.PairwisePreferences, len(candidates)*(len(candidates)-1))
+		assert.Len(t, report.Scores, len(candidates))
+	})
+
+	T.Run("with tie", func(t *testing.T) {
+		t.Parallel()
+
+		candidates := []string{"a", "b"}
+		ballots := []*Ballot{
+			{Voter: "user1", Ranks: map[string]int{"a": 0}},
+			{Voter: "user2", Ranks: map[string]int{"b": 0}},
+		}
+
+		report := schulzeMethod{}.Tally(candidates, ballots)
+		assert.NotNil(t, report.TieBreak)
+		assert.Contains(t, candidates, report.Winner)
+	})
+}
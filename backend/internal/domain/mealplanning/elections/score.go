@@ -0,0 +1,20 @@
+package elections
+
+import (
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+)
+
+// scoreMethod picks the candidate with the highest total score across all ballots.
+type scoreMethod struct{}
+
+func (scoreMethod) Tally(candidates []string, ballots []*Ballot) *mealplanning.MealPlanEventTallyReport {
+	totals := map[string]float64{}
+
+	for _, ballot := range ballots {
+		for candidate, score := range ballot.Scores {
+			totals[candidate] += float64(score)
+		}
+	}
+
+	return reportFromScores(candidates, totals)
+}
@@ -0,0 +1,30 @@
+package elections
+
+import (
+	"testing"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScoreMethod_Tally(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		candidates := []string{"a", "b"}
+		ballots := []*Ballot{
+			{Voter: "user1", Ranks: map[string]int{"a": 1, "b": 0}, Scores: map[string]int{"a": 3, "b": 5}},
+			{Voter: "user2", Ranks: map[string]int{"a": 0, "b": 1}, Scores: map[string]int{"a": 4, "b": 1}},
+		}
+
+		report := scoreMethod{}.Tally(candidates, ballots)
+		assert.Equal(t, "a", report.Winner)
+		assert.Equal(t, []*mealplanning.ElectionCandidateScore{
+			{Candidate: "a", Score: 7},
+			{Candidate: "b", Score: 6},
+		}, report.Scores)
+	})
+}
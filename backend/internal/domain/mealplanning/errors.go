@@ -11,6 +11,12 @@
 	ErrDuplicateMealInList = platformerrors.New("meal already exists in list")
 	// ErrDuplicateMealPlanOption is returned when adding a meal as an option to an event that already has it.
 	ErrDuplicateMealPlanOption = platformerrors.New("meal already exists as option for this event")
+	// ErrMealPlanNotFinalized is returned when an operation needs a meal plan's chosen options before they've been decided.
+	ErrMealPlanNotFinalized = platformerrors.New("meal plan is not finalized")
+	// ErrMealPlanOptionConflictsWithAllergies is returned when an account rejects meal plan options containing a member's allergens.
+	ErrMealPlanOptionConflictsWithAllergies = platformerrors.New("meal plan option conflicts with account member allergies")
+	// ErrMealPlanOptionNotChosen is returned when an operation only makes sense for the option chosen for a meal plan event.
+	ErrMealPlanOptionNotChosen = platformerrors.New("meal plan option was not chosen")
 
 	// ErrNoMatchingMeal is a sentinel returned when FindMealWithSameComponents finds no duplicate.
 	// It is not an error; callers should treat it as "no match found" and proceed.
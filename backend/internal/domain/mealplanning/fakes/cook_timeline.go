@@ -0,0 +1,64 @@
+package fakes
+
+import (
+	"time"
+
+	types "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+)
+
+// BuildFakeCookTimelineStep builds a faked cook timeline step.
+func BuildFakeCookTimelineStep() *types.CookTimelineStep {
+	startsAt := BuildFakeTime()
+
+	return &types.CookTimelineStep{
+		StartsAt:             startsAt,
+		EndsAt:               startsAt.Add(10 * time.Minute),
+		RecipeStepID:         BuildFakeID(),
+		RecipeID:             BuildFakeID(),
+		RecipeName:           buildUniqueString(),
+		Label:                buildUniqueString(),
+		StartOffsetInSeconds: 0,
+		EndOffsetInSeconds:   600,
+		StepIndex:            0,
+		Cook:                 1,
+		Critical:             true,
+	}
+}
+
+// BuildFakeCookTimeline builds a faked cook timeline.
+func BuildFakeCookTimeline() *types.CookTimeline {
+	var steps []*types.CookTimelineStep
+	var criticalPath []string
+	for range exampleQuantity {
+		step := BuildFakeCookTimelineStep()
+		steps = append(steps, step)
+		criticalPath = append(criticalPath, step.RecipeStepID)
+	}
+
+	return &types.CookTimeline{
+		StartsAt:               steps[0].StartsAt,
+		ServingTime:            steps[0].EndsAt,
+		MealID:                 BuildFakeID(),
+		MealPlanOptionID:       BuildFakeID(),
+		MermaidGanttChart:      "gantt\n",
+		CriticalPath:           criticalPath,
+		Steps:                  steps,
+		TotalDurationInSeconds: 600,
+		CriticalPathInSeconds:  600,
+		CookCount:              1,
+	}
+}
+
+// BuildFakeCookTimelineRequestInput builds a faked cook timeline request input.
+func BuildFakeCookTimelineRequestInput() *types.CookTimelineRequestInput {
+	return &types.CookTimelineRequestInput{
+		ServingTime: new(BuildFakeTime()),
+		CookCount:   2,
+		InstrumentConstraints: []*types.CookTimelineResourceConstraint{
+			{ID: BuildFakeID(), Quantity: 1},
+		},
+		VesselConstraints: []*types.CookTimelineResourceConstraint{
+			{ID: BuildFakeID(), Quantity: 2},
+		},
+	}
+}
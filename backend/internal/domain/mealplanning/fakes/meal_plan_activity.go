@@ -0,0 +1,20 @@
+package fakes
+
+import (
+	types "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+
+	fake "github.com/brianvoe/gofakeit/v7"
+)
+
+// BuildFakeMealPlanActivity builds a faked meal plan task activity.
+func BuildFakeMealPlanActivity() *types.MealPlanActivity {
+	return &types.MealPlanActivity{
+		CreatedAt:         BuildFakeTime(),
+		ID:                BuildFakeID(),
+		EventType:         types.MealPlanTaskStatusChangedServiceEventType,
+		MealPlanTaskID:    BuildFakeID(),
+		ByUser:            BuildFakeID(),
+		BelongsToMealPlan: BuildFakeID(),
+		Sequence:          fake.Uint64(),
+	}
+}
@@ -0,0 +1,15 @@
+package fakes
+
+import (
+	types "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+)
+
+// BuildFakeMealPlanCalendarFeed builds a faked meal plan calendar feed.
+func BuildFakeMealPlanCalendarFeed() *types.MealPlanCalendarFeed {
+	return &types.MealPlanCalendarFeed{
+		CreatedAt:        BuildFakeTime(),
+		ID:               BuildFakeID(),
+		BelongsToUser:    BuildFakeID(),
+		BelongsToAccount: BuildFakeID(),
+	}
+}
@@ -0,0 +1,30 @@
+package fakes
+
+import (
+	types "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+)
+
+// BuildFakeMealPlanEventTallyReport builds a faked Schulze meal plan event tally report between two options.
+func BuildFakeMealPlanEventTallyReport() *types.MealPlanEventTallyReport {
+	winner := BuildFakeID()
+	loser := BuildFakeID()
+
+	return &types.MealPlanEventTallyReport{
+		CreatedAt:              BuildFakeTime(),
+		ID:                     BuildFakeID(),
+		BelongsToMealPlanEvent: BuildFakeID(),
+		ElectionMethod:         types.MealPlanElectionMethodSchulze,
+		Winner:                 winner,
+		Candidates:             []string{winner, loser},
+		Scores: []*types.ElectionCandidateScore{
+			{Candidate: winner, Score: 1},
+			{Candidate: loser, Score: 0},
+		},
+		PairwisePreferences: []*types.ElectionPairwisePreference{
+			{Candidate: winner, Opponent: loser, Strength: 2},
+			{Candidate: loser, Opponent: winner, Strength: 1},
+		},
+		Rounds:      []*types.ElectionRound{},
+		BallotCount: 3,
+	}
+}
@@ -0,0 +1,27 @@
+package fakes
+
+import (
+	types "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+)
+
+// BuildFakeMealPlanOptionAllergenWarning builds a faked meal plan option allergen warning.
+func BuildFakeMealPlanOptionAllergenWarning() *types.MealPlanOptionAllergenWarning {
+	return &types.MealPlanOptionAllergenWarning{
+		UserID:            BuildFakeID(),
+		RecipeID:          BuildFakeID(),
+		RecipeStepID:      BuildFakeID(),
+		ValidIngredientID: BuildFakeID(),
+		IngredientName:    buildUniqueString(),
+		Reason:            types.AllergenWarningReasonSharedAllergen,
+		Allergens:         []string{types.AllergenPeanut},
+	}
+}
+
+// BuildFakeAccountAllergenProfile builds a faked account allergen profile with no allergies.
+func BuildFakeAccountAllergenProfile() *types.AccountAllergenProfile {
+	return &types.AccountAllergenProfile{
+		AccountID: BuildFakeID(),
+		Policy:    types.MealPlanOptionAllergenPolicyWarn,
+		Allergies: []*types.UserIngredientPreference{},
+	}
+}
@@ -16,6 +16,7 @@ func BuildFakeMealPlanOptionVote() *types.MealPlanOptionVote {
 	return &types.MealPlanOptionVote{
 		ID:                      BuildFakeID(),
 		Rank:                    uint8(fake.Number(1, math.MaxUint8)),
+		Score:                   uint8(fake.Number(0, types.MaxMealPlanOptionVoteScore)),
 		Abstain:                 fake.Bool(),
 		Notes:                   buildUniqueString(),
 		CreatedAt:               BuildFakeTime(),
@@ -46,6 +47,7 @@ func BuildFakeMealPlanOptionVoteUpdateRequestInput() *types.MealPlanOptionVoteUp
 	mealPlanOptionVote := BuildFakeMealPlanOptionVote()
 	return &types.MealPlanOptionVoteUpdateRequestInput{
 		Rank:                    &mealPlanOptionVote.Rank,
+		Score:                   &mealPlanOptionVote.Score,
 		Abstain:                 &mealPlanOptionVote.Abstain,
 		Notes:                   &mealPlanOptionVote.Notes,
 		BelongsToMealPlanOption: mealPlanOptionVote.BelongsToMealPlanOption,
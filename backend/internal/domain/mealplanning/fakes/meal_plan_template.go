@@ -0,0 +1,79 @@
+package fakes
+
+import (
+	"time"
+
+	types "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/converters"
+
+	"github.com/primandproper/platform/database/filtering"
+
+	fake "github.com/brianvoe/gofakeit/v7"
+)
+
+// BuildFakeMealPlanTemplateSlot builds a faked meal plan template slot.
+func BuildFakeMealPlanTemplateSlot() *types.MealPlanTemplateSlot {
+	return &types.MealPlanTemplateSlot{
+		DayOfWeek:         uint8(fake.Number(0, 6)),
+		StartTime:         "18:00",
+		DurationInSeconds: 3600,
+		MealName:          types.DinnerMealName,
+		Notes:             buildUniqueString(),
+		MealIDs:           []string{BuildFakeID(), BuildFakeID()},
+	}
+}
+
+// BuildFakeMealPlanTemplate builds a faked meal plan template.
+func BuildFakeMealPlanTemplate() *types.MealPlanTemplate {
+	var slots []*types.MealPlanTemplateSlot
+	for range exampleQuantity {
+		slots = append(slots, BuildFakeMealPlanTemplateSlot())
+	}
+
+	return &types.MealPlanTemplate{
+		ID:                            BuildFakeID(),
+		Name:                          buildUniqueString(),
+		Notes:                         buildUniqueString(),
+		ElectionMethod:                types.MealPlanElectionMethodSchulze,
+		RecurrenceRule:                "FREQ=WEEKLY;BYDAY=SU;BYHOUR=9",
+		Timezone:                      types.DefaultMealPlanTemplateTimezone,
+		VotingDeadlineOffsetInSeconds: 12 * 3600,
+		CandidatesPerSlot:             types.DefaultMealPlanTemplateCandidatesPerSlot,
+		AvoidMealsFromLastPlans:       2,
+		Slots:                         slots,
+		NextInstantiationAt:           time.Now().Add(24 * time.Hour).Truncate(time.Second).UTC(),
+		CreatedAt:                     BuildFakeTime(),
+		BelongsToAccount:              BuildFakeID(),
+		CreatedByUser:                 BuildFakeID(),
+	}
+}
+
+// BuildFakeMealPlanTemplatesList builds a faked MealPlanTemplateList.
+func BuildFakeMealPlanTemplatesList() *filtering.QueryFilteredResult[types.MealPlanTemplate] {
+	var examples []*types.MealPlanTemplate
+	for range exampleQuantity {
+		examples = append(examples, BuildFakeMealPlanTemplate())
+	}
+
+	return &filtering.QueryFilteredResult[types.MealPlanTemplate]{
+		Pagination: filtering.Pagination{
+			Cursor:          BuildFakeID(),
+			MaxResponseSize: 50,
+			FilteredCount:   exampleQuantity / 2,
+			TotalCount:      exampleQuantity,
+		},
+		Data: examples,
+	}
+}
+
+// BuildFakeMealPlanTemplateUpdateRequestInput builds a faked MealPlanTemplateUpdateRequestInput.
+func BuildFakeMealPlanTemplateUpdateRequestInput() *types.MealPlanTemplateUpdateRequestInput {
+	mealPlanTemplate := BuildFakeMealPlanTemplate()
+	return converters.ConvertMealPlanTemplateToMealPlanTemplateUpdateRequestInput(mealPlanTemplate)
+}
+
+// BuildFakeMealPlanTemplateCreationRequestInput builds a faked MealPlanTemplateCreationRequestInput.
+func BuildFakeMealPlanTemplateCreationRequestInput() *types.MealPlanTemplateCreationRequestInput {
+	mealPlanTemplate := BuildFakeMealPlanTemplate()
+	return converters.ConvertMealPlanTemplateToMealPlanTemplateCreationRequestInput(mealPlanTemplate)
+}
@@ -0,0 +1,94 @@
+package fakes
+
+import (
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/converters"
+)
+
+// BuildFakeNutrients builds faked nutrients.
+func BuildFakeNutrients() mealplanning.Nutrients {
+	return mealplanning.Nutrients{
+		Calories:                float32(buildFakeNumber()),
+		ProteinInGrams:          float32(buildFakeNumber()),
+		TotalFatInGrams:         float32(buildFakeNumber()),
+		SaturatedFatInGrams:     float32(buildFakeNumber()),
+		CarbohydratesInGrams:    float32(buildFakeNumber()),
+		SugarInGrams:            float32(buildFakeNumber()),
+		FiberInGrams:            float32(buildFakeNumber()),
+		SodiumInMilligrams:      float32(buildFakeNumber()),
+		CholesterolInMilligrams: float32(buildFakeNumber()),
+	}
+}
+
+// BuildFakeValidIngredientNutritionFacts builds faked valid ingredient nutrition facts.
+func BuildFakeValidIngredientNutritionFacts() *mealplanning.ValidIngredientNutritionFacts {
+	return &mealplanning.ValidIngredientNutritionFacts{
+		ID:                   BuildFakeID(),
+		ValidIngredientID:    BuildFakeID(),
+		Notes:                buildUniqueString(),
+		BasisMeasurementUnit: *BuildFakeValidMeasurementUnit(),
+		Nutrients:            BuildFakeNutrients(),
+		CreatedAt:            BuildFakeTime(),
+	}
+}
+
+// BuildFakeValidIngredientNutritionFactsUpdateRequestInput builds a faked ValidIngredientNutritionFactsUpdateRequestInput.
+func BuildFakeValidIngredientNutritionFactsUpdateRequestInput() *mealplanning.ValidIngredientNutritionFactsUpdateRequestInput {
+	validIngredientNutritionFacts := BuildFakeValidIngredientNutritionFacts()
+	return converters.ConvertValidIngredientNutritionFactsToValidIngredientNutritionFactsUpdateRequestInput(validIngredientNutritionFacts)
+}
+
+// BuildFakeValidIngredientNutritionFactsCreationRequestInput builds a faked ValidIngredientNutritionFactsCreationRequestInput.
+func BuildFakeValidIngredientNutritionFactsCreationRequestInput() *mealplanning.ValidIngredientNutritionFactsCreationRequestInput {
+	validIngredientNutritionFacts := BuildFakeValidIngredientNutritionFacts()
+	return converters.ConvertValidIngredientNutritionFactsToValidIngredientNutritionFactsCreationRequestInput(validIngredientNutritionFacts)
+}
+
+// BuildFakeNutritionRollup builds a faked nutrition rollup.
+func BuildFakeNutritionRollup() *mealplanning.NutritionRollup {
+	totals := BuildFakeNutrients()
+	perPortion := totals.Scale(0.5)
+
+	return &mealplanning.NutritionRollup{
+		Totals:            totals,
+		PerPortion:        &perPortion,
+		EstimatedPortions: 2,
+		Scale:             1,
+		Complete:          false,
+		MissingIngredients: []*mealplanning.NutritionMissingIngredient{
+			{
+				RecipeID:               BuildFakeID(),
+				RecipeStepID:           BuildFakeID(),
+				RecipeStepIngredientID: BuildFakeID(),
+				ValidIngredientID:      BuildFakeID(),
+				IngredientName:         buildUniqueString(),
+				MeasurementUnitID:      BuildFakeID(),
+				Reason:                 mealplanning.NutritionMissingReasonNoNutritionFacts,
+			},
+		},
+	}
+}
+
+// BuildFakeMealPlanNutritionRollup builds a faked meal plan nutrition rollup.
+func BuildFakeMealPlanNutritionRollup() *mealplanning.MealPlanNutritionRollup {
+	x := &mealplanning.MealPlanNutritionRollup{
+		MealPlanID: BuildFakeID(),
+	}
+
+	for range exampleQuantity {
+		event := &mealplanning.MealPlanEventNutritionRollup{
+			StartsAt:         BuildFakeTime(),
+			MealPlanEventID:  BuildFakeID(),
+			MealPlanOptionID: BuildFakeID(),
+			MealID:           BuildFakeID(),
+			MealName:         buildUniqueString(),
+			Nutrition:        *BuildFakeNutritionRollup(),
+		}
+
+		x.Events = append(x.Events, event)
+		x.Totals = x.Totals.Add(event.Nutrition.Totals)
+		x.MissingIngredients = append(x.MissingIngredients, event.Nutrition.MissingIngredients...)
+	}
+
+	return x
+}
@@ -0,0 +1,56 @@
+package fakes
+
+import (
+	types "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/converters"
+
+	"github.com/primandproper/platform/database/filtering"
+	"github.com/primandproper/platform/identifiers"
+)
+
+// BuildFakePantryItem builds a faked pantry item.
+func BuildFakePantryItem() *types.PantryItem {
+	expiresAt := BuildFakeTime()
+
+	return &types.PantryItem{
+		CreatedAt:        BuildFakeTime(),
+		ExpiresAt:        &expiresAt,
+		ID:               identifiers.New(),
+		Notes:            buildUniqueString(),
+		StorageLocation:  types.PantryStorageLocationRefrigerator,
+		BelongsToAccount: buildUniqueString(),
+		Ingredient:       *BuildFakeValidIngredient(),
+		MeasurementUnit:  *BuildFakeValidMeasurementUnit(),
+		Quantity:         float32(buildFakeNumber()),
+	}
+}
+
+// BuildFakePantryItemsList builds a faked PantryItemList.
+func BuildFakePantryItemsList() *filtering.QueryFilteredResult[types.PantryItem] {
+	var examples []*types.PantryItem
+	for range exampleQuantity {
+		examples = append(examples, BuildFakePantryItem())
+	}
+
+	return &filtering.QueryFilteredResult[types.PantryItem]{
+		Pagination: filtering.Pagination{
+			Cursor:          BuildFakeID(),
+			MaxResponseSize: 50,
+			FilteredCount:   exampleQuantity / 2,
+			TotalCount:      exampleQuantity,
+		},
+		Data: examples,
+	}
+}
+
+// BuildFakePantryItemUpdateRequestInput builds a faked PantryItemUpdateRequestInput from a pantry item.
+func BuildFakePantryItemUpdateRequestInput() *types.PantryItemUpdateRequestInput {
+	pantryItem := BuildFakePantryItem()
+	return converters.ConvertPantryItemToPantryItemUpdateRequestInput(pantryItem)
+}
+
+// BuildFakePantryItemCreationRequestInput builds a faked PantryItemCreationRequestInput.
+func BuildFakePantryItemCreationRequestInput() *types.PantryItemCreationRequestInput {
+	pantryItem := BuildFakePantryItem()
+	return converters.ConvertPantryItemToPantryItemCreationRequestInput(pantryItem)
+}
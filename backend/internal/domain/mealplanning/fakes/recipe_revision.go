@@ -0,0 +1,42 @@
+package fakes
+
+import (
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+
+	"github.com/primandproper/platform/database/filtering"
+)
+
+// BuildFakeRecipeRevision builds a faked recipe revision.
+func BuildFakeRecipeRevision() *mealplanning.RecipeRevision {
+	recipe := BuildFakeRecipe()
+
+	return &mealplanning.RecipeRevision{
+		ID:              BuildFakeID(),
+		BelongsToRecipe: recipe.ID,
+		RevisionNumber:  1,
+		Snapshot:        recipe,
+		CreatedByUser:   new(recipe.CreatedByUser),
+		CreatedAt:       BuildFakeTime(),
+	}
+}
+
+// BuildFakeRecipeRevisionsList builds a faked RecipeRevisionList.
+func BuildFakeRecipeRevisionsList() *filtering.QueryFilteredResult[mealplanning.RecipeRevision] {
+	var examples []*mealplanning.RecipeRevision
+	for i := range exampleQuantity {
+		example := BuildFakeRecipeRevision()
+		example.Snapshot = nil
+		example.RevisionNumber = uint32(i + 1)
+		examples = append(examples, example)
+	}
+
+	return &filtering.QueryFilteredResult[mealplanning.RecipeRevision]{
+		Pagination: filtering.Pagination{
+			Cursor:          BuildFakeID(),
+			MaxResponseSize: 50,
+			FilteredCount:   exampleQuantity / 2,
+			TotalCount:      exampleQuantity,
+		},
+		Data: examples,
+	}
+}
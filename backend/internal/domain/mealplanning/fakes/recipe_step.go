@@ -85,7 +85,7 @@ func BuildFakeRecipeStep() *types.RecipeStep {
 		BelongsToRecipe:           BuildFakeID(),
 		Ingredients:               ingredients,
 		ExplicitInstructions:      buildUniqueString(),
-		ConditionExpression:       buildUniqueString(),
+		ConditionExpression:       "scale > 0",
 		Instruments:               instruments,
 		Vessels:                   vessels,
 		CompletionConditions:      completionConditions,
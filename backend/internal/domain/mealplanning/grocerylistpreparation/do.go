@@ -1,6 +1,8 @@
 package grocerylistpreparation
 
 import (
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+
 	"github.com/primandproper/platform/observability/logging"
 	"github.com/primandproper/platform/observability/tracing"
 
@@ -13,6 +15,7 @@ func RegisterGroceryListCreator(i do.Injector) {
 		return NewGroceryListCreator(
 			do.MustInvoke[logging.Logger](i),
 			do.MustInvoke[tracing.TracerProvider](i),
+			do.MustInvoke[mealplanning.Repository](i),
 		), nil
 	})
 }
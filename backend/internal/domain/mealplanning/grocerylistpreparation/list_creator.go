@@ -3,12 +3,15 @@
 import (
 	"context"
 	"fmt"
+	"slices"
+	"strings"
 
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
 	mealplanningkeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/keys"
 
 	"github.com/primandproper/platform/identifiers"
 	"github.com/primandproper/platform/numbers"
+	"github.com/primandproper/platform/observability"
 	"github.com/primandproper/platform/observability/logging"
 	"github.com/primandproper/platform/observability/tracing"
 
@@ -21,20 +24,49 @@
 	"water": {},
 }
 
-// GroceryListCreator creates meal plan grocery lists for a given meal plan.
-type GroceryListCreator interface {
-	GenerateGroceryListInputs(ctx context.Context, mealPlan *mealplanning.MealPlan) ([]*mealplanning.MealPlanGroceryListItemDatabaseCreationInput, error)
-}
+// UnmergedGroceryListItemStatusExplanation is stored on grocery list items that couldn't be combined with
+// the other items for their ingredient because no measurement unit conversion was available.
+const UnmergedGroceryListItemStatusExplanation = "could not be combined with other entries for this ingredient, since no measurement unit conversion is available"
+
+type (
+	// GroceryListCreator creates meal plan grocery lists for a given meal plan.
+	GroceryListCreator interface {
+		GenerateGroceryListInputs(ctx context.Context, mealPlan *mealplanning.MealPlan) (*GroceryListInputs, error)
+		OffsetGroceryListWithPantry(ctx context.Context, inputs *GroceryListInputs, pantryItems []*mealplanning.PantryItem) ([]*PantryItemConsumption, error)
+		PantryConsumptionForMealPlanOption(ctx context.Context, mealPlan *mealplanning.MealPlan, mealPlanOption *mealplanning.MealPlanOption, pantryItems []*mealplanning.PantryItem) ([]*PantryItemConsumption, error)
+	}
+
+	// GroceryListInputs is the result of generating grocery list items for a meal plan.
+	GroceryListInputs struct {
+		Items []*mealplanning.MealPlanGroceryListItemDatabaseCreationInput
+		// UnmergedItems are items that share an ingredient with another item, but couldn't be converted
+		// into that ingredient's preferred measurement unit, and so appear as separate lines in Items
+		// with UnmergedGroceryListItemStatusExplanation set.
+		UnmergedItems []*UnmergedGroceryListItem
+	}
+
+	// UnmergedGroceryListItem describes a grocery list item that could not be merged with the other items for its ingredient.
+	UnmergedGroceryListItem struct {
+		Item                       *mealplanning.MealPlanGroceryListItemDatabaseCreationInput
+		PreferredMeasurementUnitID string
+	}
+)
 
 type groceryListCreator struct {
-	logger logging.Logger
-	tracer tracing.Tracer
+	logger            logging.Logger
+	tracer            tracing.Tracer
+	conversionManager mealplanning.ValidMeasurementUnitConversionDataManager
 }
 
-func NewGroceryListCreator(logger logging.Logger, tracerProvider tracing.TracerProvider) GroceryListCreator {
+func NewGroceryListCreator(
+	logger logging.Logger,
+	tracerProvider tracing.TracerProvider,
+	conversionManager mealplanning.ValidMeasurementUnitConversionDataManager,
+) GroceryListCreator {
 	return &groceryListCreator{
-		logger: logging.NewNamedLogger(logger, "grocery_list_creator"),
-		tracer: tracing.NewNamedTracer(tracerProvider, "grocery_list_creator"),
+		logger:            logging.NewNamedLogger(logger, "grocery_list_creator"),
+		tracer:            tracing.NewNamedTracer(tracerProvider, "grocery_list_creator"),
+		conversionManager: conversionManager,
 	}
 }
 
@@ -146,7 +178,7 @@ func (g *groceryListCreator) processRecipeIngredients(
 				})
 			} else {
 				// This ingredient is not part of an option group - aggregate by (ingredient, unit)
-				// Same ingredient with different units (e.g., salt in tsp vs grams) becomes separate line items
+				// Same ingredient with different units (e.g., salt in tsp vs grams) is merged after aggregation, where a conversion exists
 				aggregationKey := fmt.Sprintf("%s:%s", ingredient.Ingredient.ID, ingredient.MeasurementUnit.ID)
 				if existing, ok := aggregatedInputs[aggregationKey]; !ok {
 					aggregatedInputs[aggregationKey] = &mealplanning.MealPlanGroceryListItemDatabaseCreationInput{
@@ -175,8 +207,30 @@ func (g *groceryListCreator) processRecipeIngredients(
 	}
 }
 
-func (g *groceryListCreator) GenerateGroceryListInputs(ctx context.Context, mealPlan *mealplanning.MealPlan) ([]*mealplanning.MealPlanGroceryListItemDatabaseCreationInput, error) {
-	_, span := g.tracer.StartSpan(ctx)
+// fetchConversionsForMergeableItems fetches the measurement unit conversions for every ingredient that appears in more than one unit.
+func (g *groceryListCreator) fetchConversionsForMergeableItems(ctx context.Context, items []*mealplanning.MealPlanGroceryListItemDatabaseCreationInput) ([]*mealplanning.ValidMeasurementUnitConversion, error) {
+	itemCounts := map[string]int{}
+	for _, item := range items {
+		itemCounts[item.ValidIngredientID]++
+	}
+
+	ingredientIDs := []string{}
+	for ingredientID, count := range itemCounts {
+		if count > 1 {
+			ingredientIDs = append(ingredientIDs, ingredientID)
+		}
+	}
+
+	if len(ingredientIDs) == 0 {
+		return nil, nil
+	}
+	slices.Sort(ingredientIDs)
+
+	return g.conversionManager.GetValidMeasurementUnitConversionsForIngredients(ctx, ingredientIDs)
+}
+
+func (g *groceryListCreator) GenerateGroceryListInputs(ctx context.Context, mealPlan *mealplanning.MealPlan) (*GroceryListInputs, error) {
+	ctx, span := g.tracer.StartSpan(ctx)
 	defer span.End()
 
 	// Map to track option groups: key is (recipeStepID, ingredientIndex), value is count of options
@@ -198,11 +252,12 @@ func (g *groceryListCreator) GenerateGroceryListInputs(ctx context.Context, meal
 	}
 
 	// First pass: identify option groups (ingredients with multiple options at the same index)
-	// This includes both main recipes and their associated recipes
+	// This includes both main recipes and their associated recipes. Both passes read the recipe revisions each option
+	// was pinned to, so later edits to a recipe don't change what a plan's household has to buy.
 	for _, event := range mealPlan.Events {
 		for _, option := range event.Options {
 			if option.Chosen {
-				for _, component := range option.Meal.Components {
+				for _, component := range option.MealWithPinnedRecipes().Components {
 					// Process main recipe
 					for _, step := range component.Recipe.Steps {
 						// Track how many options exist for each (stepID, index) combination
@@ -251,7 +306,7 @@ func (g *groceryListCreator) GenerateGroceryListInputs(ctx context.Context, meal
 			if option.Chosen {
 				mealScale := decimal.NewFromFloat32(option.MealScale)
 				logger = logger.WithValue(mealplanningkeys.MealPlanOptionIDKey, option.ID)
-				for _, component := range option.Meal.Components {
+				for _, component := range option.MealWithPinnedRecipes().Components {
 					recipeScale := decimal.NewFromFloat32(component.RecipeScale).Mul(mealScale)
 					logger = logger.WithValue(mealplanningkeys.RecipeIDKey, component.Recipe.ID)
 
@@ -288,11 +343,33 @@ func (g *groceryListCreator) GenerateGroceryListInputs(ctx context.Context, meal
 		}
 	}
 
-	// Combine aggregated items and option items
-	dbInputs := make([]*mealplanning.MealPlanGroceryListItemDatabaseCreationInput, 0, len(aggregatedInputs)+len(optionInputs))
+	aggregatedItems := make([]*mealplanning.MealPlanGroceryListItemDatabaseCreationInput, 0, len(aggregatedInputs))
 	for _, i := range aggregatedInputs {
-		dbInputs = append(dbInputs, i)
+		aggregatedItems = append(aggregatedItems, i)
 	}
+	// sort so that merging picks the same base item regardless of map iteration order
+	slices.SortFunc(aggregatedItems, func(a, b *mealplanning.MealPlanGroceryListItemDatabaseCreationInput) int {
+		if a.ValidIngredientID != b.ValidIngredientID {
+			return strings.Compare(a.ValidIngredientID, b.ValidIngredientID)
+		}
+		return strings.Compare(a.ValidMeasurementUnitID, b.ValidMeasurementUnitID)
+	})
+
+	// Merge items for the same ingredient in different units (e.g. 2 tbsp butter and 100 g butter)
+	logger = g.logger.Clone().WithValue(mealplanningkeys.MealPlanIDKey, mealPlan.ID)
+	conversions, err := g.fetchConversionsForMergeableItems(ctx, aggregatedItems)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching measurement unit conversions")
+	}
+	mergedItems, unmergedItems := mergeAcrossMeasurementUnits(aggregatedItems, conversions)
+
+	if len(unmergedItems) > 0 {
+		logger.WithValue("unmerged_items", len(unmergedItems)).Info("some grocery list items could not be converted to a common measurement unit")
+	}
+
+	// Combine aggregated items and option items
+	dbInputs := make([]*mealplanning.MealPlanGroceryListItemDatabaseCreationInput, 0, len(mergedItems)+len(optionInputs))
+	dbInputs = append(dbInputs, mergedItems...)
 	dbInputs = append(dbInputs, optionInputs...)
 
 	// Round quantities to the nearest tenth for cleaner grocery list display
@@ -304,5 +381,8 @@ func (g *groceryListCreator) GenerateGroceryListInputs(ctx context.Context, meal
 		}
 	}
 
-	return dbInputs, nil
+	return &GroceryListInputs{
+		Items:         dbInputs,
+		UnmergedItems: unmergedItems,
+	}, nil
 }
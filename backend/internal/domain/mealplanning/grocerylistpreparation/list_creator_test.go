@@ -1,15 +1,20 @@
 package grocerylistpreparation
 
 import (
+	"errors"
 	"testing"
 
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/fakes"
+	mealplanningmock "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/mocks"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/testutils"
 
 	loggingnoop "github.com/primandproper/platform/observability/logging/noop"
 	"github.com/primandproper/platform/observability/tracing"
+	"github.com/primandproper/platform/reflection"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 )
 
@@ -281,8 +286,9 @@ func Test_groceryListCreator_GenerateGroceryListInputs(T *testing.T) {
 			},
 		}
 
-		actual, err := listGenerator.GenerateGroceryListInputs(ctx, expectedMealPlan)
-		assert.NoError(t, err)
+		result, err := listGenerator.GenerateGroceryListInputs(ctx, expectedMealPlan)
+		require.NoError(t, err)
+		actual := result.Items
 
 		actualMap := map[string]*mealplanning.MealPlanGroceryListItemDatabaseCreationInput{}
 		for i := range actual {
@@ -469,8 +475,9 @@ func Test_groceryListCreator_GenerateGroceryListInputs(T *testing.T) {
 			},
 		}
 
-		actual, err := listGenerator.GenerateGroceryListInputs(ctx, expectedMealPlan)
-		assert.NoError(t, err)
+		result, err := listGenerator.GenerateGroceryListInputs(ctx, expectedMealPlan)
+		require.NoError(t, err)
+		actual := result.Items
 
 		actualMap := map[string]*mealplanning.MealPlanGroceryListItemDatabaseCreationInput{}
 		for i := range actual {
@@ -540,14 +547,87 @@ func Test_groceryListCreator_GenerateGroceryListInputs(T *testing.T) {
 		}
 
 		ctx := t.Context()
-		actual, err := listGenerator.GenerateGroceryListInputs(ctx, expectedMealPlan)
-		assert.NoError(t, err)
+		result, err := listGenerator.GenerateGroceryListInputs(ctx, expectedMealPlan)
+		require.NoError(t, err)
+		actual := result.Items
 		require.Len(t, actual, 1)
 		// effectiveScale = 2.0 * 0.5 = 1.0, so 100 * 1.0 = 100
 		assert.Equal(t, float32(100), actual[0].MinQuantityNeeded)
 		assert.Equal(t, float32(100), *actual[0].MaxQuantityNeeded)
 	})
 
+	T.Run("with pinned recipe revisions", func(t *testing.T) {
+		t.Parallel()
+
+		listGenerator := &groceryListCreator{
+			logger: loggingnoop.NewLogger(),
+			tracer: tracing.NewTracerForTest(t.Name()),
+		}
+
+		onion := fakes.BuildFakeValidIngredient()
+		garlic := fakes.BuildFakeValidIngredient()
+		grams := fakes.BuildFakeValidMeasurementUnit()
+		recipeID := fakes.BuildFakeID()
+
+		recipeWithIngredient := func(ingredient *mealplanning.ValidIngredient, quantity float32) mealplanning.Recipe {
+			return mealplanning.Recipe{
+				ID: recipeID,
+				Steps: []*mealplanning.RecipeStep{
+					{
+						ID: fakes.BuildFakeID(),
+						Ingredients: []*mealplanning.RecipeStepIngredient{
+							{
+								Ingredient:      ingredient,
+								MinQuantity:     quantity,
+								MeasurementUnit: *grams,
+							},
+						},
+					},
+				},
+			}
+		}
+
+		// the recipe was changed to use garlic after the option was proposed, so the list should still call for onion.
+		pinned := recipeWithIngredient(onion, 100)
+		expectedMealPlan := &mealplanning.MealPlan{
+			ID: fakes.BuildFakeID(),
+			Events: []*mealplanning.MealPlanEvent{
+				{
+					Options: []*mealplanning.MealPlanOption{
+						{
+							ID:        fakes.BuildFakeID(),
+							Chosen:    true,
+							MealScale: 1.0,
+							Meal: mealplanning.Meal{
+								Components: []*mealplanning.MealComponent{
+									{
+										RecipeScale: 1.0,
+										Recipe:      recipeWithIngredient(garlic, 50),
+									},
+								},
+							},
+							RecipeRevisions: []*mealplanning.MealPlanOptionRecipeRevision{
+								{
+									RecipeID:       recipeID,
+									RevisionNumber: 1,
+									Snapshot:       &pinned,
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+
+		ctx := t.Context()
+		result, err := listGenerator.GenerateGroceryListInputs(ctx, expectedMealPlan)
+		require.NoError(t, err)
+		actual := result.Items
+		require.Len(t, actual, 1)
+		assert.Equal(t, onion.ID, actual[0].ValidIngredientID)
+		assert.Equal(t, float32(100), actual[0].MinQuantityNeeded)
+	})
+
 	T.Run("with option groups", func(t *testing.T) {
 		t.Parallel()
 
@@ -629,8 +709,9 @@ func Test_groceryListCreator_GenerateGroceryListInputs(T *testing.T) {
 
 		ctx := t.Context()
 
-		actual, err := listGenerator.GenerateGroceryListInputs(ctx, expectedMealPlan)
-		assert.NoError(t, err)
+		result, err := listGenerator.GenerateGroceryListInputs(ctx, expectedMealPlan)
+		require.NoError(t, err)
+		actual := result.Items
 
 		// Should have 2 items: spaghetti (default optionIndex=0) and onion (non-option)
 		// angelHair (optionIndex=1) is NOT included because no selection was made, so we default to optionIndex=0
@@ -793,8 +874,9 @@ func Test_groceryListCreator_GenerateGroceryListInputs(T *testing.T) {
 
 		ctx := t.Context()
 
-		actual, err := listGenerator.GenerateGroceryListInputs(ctx, expectedMealPlan)
-		assert.NoError(t, err)
+		result, err := listGenerator.GenerateGroceryListInputs(ctx, expectedMealPlan)
+		require.NoError(t, err)
+		actual := result.Items
 
 		// Should have 2 items: spaghetti (default optionIndex=0) and onion (aggregated)
 		// angelHair (optionIndex=1) is NOT included because no selection was made
@@ -913,8 +995,9 @@ func Test_groceryListCreator_GenerateGroceryListInputs(T *testing.T) {
 
 		ctx := t.Context()
 
-		actual, err := listGenerator.GenerateGroceryListInputs(ctx, expectedMealPlan)
-		assert.NoError(t, err)
+		result, err := listGenerator.GenerateGroceryListInputs(ctx, expectedMealPlan)
+		require.NoError(t, err)
+		actual := result.Items
 
 		// Should have 2 items: angelHair (selected optionIndex=1) and onion (non-option)
 		// spaghetti (optionIndex=0) is NOT included because user selected optionIndex=1
@@ -1053,8 +1136,9 @@ func Test_groceryListCreator_GenerateGroceryListInputs(T *testing.T) {
 
 		ctx := t.Context()
 
-		actual, err := listGenerator.GenerateGroceryListInputs(ctx, expectedMealPlan)
-		assert.NoError(t, err)
+		result, err := listGenerator.GenerateGroceryListInputs(ctx, expectedMealPlan)
+		require.NoError(t, err)
+		actual := result.Items
 
 		// Should have 3 items: chicken (from main recipe), oliveOil and lemon (from associated recipe)
 		assert.Len(t, actual, 3)
@@ -1178,8 +1262,9 @@ func Test_groceryListCreator_GenerateGroceryListInputs(T *testing.T) {
 
 		ctx := t.Context()
 
-		actual, err := listGenerator.GenerateGroceryListInputs(ctx, expectedMealPlan)
-		assert.NoError(t, err)
+		result, err := listGenerator.GenerateGroceryListInputs(ctx, expectedMealPlan)
+		require.NoError(t, err)
+		actual := result.Items
 
 		// Should have 2 items: chicken and oliveOil
 		assert.Len(t, actual, 2)
@@ -1294,8 +1379,9 @@ func Test_groceryListCreator_GenerateGroceryListInputs(T *testing.T) {
 
 		ctx := t.Context()
 
-		actual, err := listGenerator.GenerateGroceryListInputs(ctx, expectedMealPlan)
-		assert.NoError(t, err)
+		result, err := listGenerator.GenerateGroceryListInputs(ctx, expectedMealPlan)
+		require.NoError(t, err)
+		actual := result.Items
 
 		// Should have 2 items: chicken and salt (aggregated from main + associated)
 		assert.Len(t, actual, 2)
@@ -1386,8 +1472,9 @@ func Test_groceryListCreator_GenerateGroceryListInputs(T *testing.T) {
 
 		ctx := t.Context()
 
-		actual, err := listGenerator.GenerateGroceryListInputs(ctx, expectedMealPlan)
-		assert.NoError(t, err)
+		result, err := listGenerator.GenerateGroceryListInputs(ctx, expectedMealPlan)
+		require.NoError(t, err)
+		actual := result.Items
 
 		actualMap := make(map[string]*mealplanning.MealPlanGroceryListItemDatabaseCreationInput)
 		for i := range actual {
@@ -1408,4 +1495,192 @@ func Test_groceryListCreator_GenerateGroceryListInputs(T *testing.T) {
 		assert.NotNil(t, thymeItem.MaxQuantityNeeded)
 		assert.Equal(t, float32(2.0), *thymeItem.MaxQuantityNeeded)
 	})
+
+	T.Run("merges same ingredient across measurement units", func(t *testing.T) {
+		t.Parallel()
+
+		butter := fakes.BuildFakeValidIngredient()
+		grams := fakes.BuildFakeValidMeasurementUnit()
+		grams.Metric = true
+		tablespoons := fakes.BuildFakeValidMeasurementUnit()
+		tablespoons.Metric = false
+
+		conversion := fakes.BuildFakeValidMeasurementUnitConversion()
+		conversion.From = *tablespoons
+		conversion.To = *grams
+		conversion.Modifier = 14.2
+
+		mdm := &mealplanningmock.Repository{}
+		mdm.On(reflection.GetMethodName(mdm.GetValidMeasurementUnitConversionsForIngredients), testutils.ContextMatcher, []string{butter.ID}).Return([]*mealplanning.ValidMeasurementUnitConversion{conversion}, nil)
+
+		listGenerator := &groceryListCreator{
+			logger:            loggingnoop.NewLogger(),
+			tracer:            tracing.NewTracerForTest(t.Name()),
+			conversionManager: mdm,
+		}
+
+		mealPlan := buildMealPlanWithIngredientsInSeparateRecipes(
+			&mealplanning.RecipeStepIngredient{Ingredient: butter, MinQuantity: 2, MaxQuantity: new(float32(3)), MeasurementUnit: *tablespoons},
+			&mealplanning.RecipeStepIngredient{Ingredient: butter, MinQuantity: 100, MeasurementUnit: *grams},
+		)
+
+		result, err := listGenerator.GenerateGroceryListInputs(t.Context(), mealPlan)
+		require.NoError(t, err)
+		require.Len(t, result.Items, 1)
+		assert.Empty(t, result.UnmergedItems)
+
+		assert.Equal(t, grams.ID, result.Items[0].ValidMeasurementUnitID)
+		assert.Equal(t, float32(128.4), result.Items[0].MinQuantityNeeded)
+		require.NotNil(t, result.Items[0].MaxQuantityNeeded)
+		assert.Equal(t, float32(42.6), *result.Items[0].MaxQuantityNeeded)
+
+		mock.AssertExpectationsForObjects(t, mdm)
+	})
+
+	T.Run("prefers ingredient-specific conversions", func(t *testing.T) {
+		t.Parallel()
+
+		flour := fakes.BuildFakeValidIngredient()
+		grams := fakes.BuildFakeValidMeasurementUnit()
+		grams.Metric = true
+		cups := fakes.BuildFakeValidMeasurementUnit()
+		cups.Metric = false
+
+		universal := fakes.BuildFakeValidMeasurementUnitConversion()
+		universal.From = *cups
+		universal.To = *grams
+		universal.Modifier = 236.6
+
+		specific := fakes.BuildFakeValidMeasurementUnitConversion()
+		specific.From = *grams
+		specific.To = *cups
+		specific.Modifier = 0.008
+		specific.OnlyForIngredient = flour
+
+		mdm := &mealplanningmock.Repository{}
+		mdm.On(reflection.GetMethodName(mdm.GetValidMeasurementUnitConversionsForIngredients), testutils.ContextMatcher, []string{flour.ID}).Return([]*mealplanning.ValidMeasurementUnitConversion{universal, specific}, nil)
+
+		listGenerator := &groceryListCreator{
+			logger:            loggingnoop.NewLogger(),
+			tracer:            tracing.NewTracerForTest(t.Name()),
+			conversionManager: mdm,
+		}
+
+		mealPlan := buildMealPlanWithIngredientsInSeparateRecipes(
+			&mealplanning.RecipeStepIngredient{Ingredient: flour, MinQuantity: 2, MeasurementUnit: *cups},
+			&mealplanning.RecipeStepIngredient{Ingredient: flour, MinQuantity: 100, MeasurementUnit: *grams},
+		)
+
+		result, err := listGenerator.GenerateGroceryListInputs(t.Context(), mealPlan)
+		require.NoError(t, err)
+		require.Len(t, result.Items, 1)
+
+		// 2 cups of flour at 125 g/cup, rather than 2 cups of water at 236.6 g/cup
+		assert.Equal(t, grams.ID, result.Items[0].ValidMeasurementUnitID)
+		assert.Equal(t, float32(350), result.Items[0].MinQuantityNeeded)
+
+		mock.AssertExpectationsForObjects(t, mdm)
+	})
+
+	T.Run("reports items without a conversion path", func(t *testing.T) {
+		t.Parallel()
+
+		butter := fakes.BuildFakeValidIngredient()
+		grams := fakes.BuildFakeValidMeasurementUnit()
+		grams.Metric = true
+		sticks := fakes.BuildFakeValidMeasurementUnit()
+		sticks.Metric = false
+
+		mdm := &mealplanningmock.Repository{}
+		mdm.On(reflection.GetMethodName(mdm.GetValidMeasurementUnitConversionsForIngredients), testutils.ContextMatcher, []string{butter.ID}).Return([]*mealplanning.ValidMeasurementUnitConversion{}, nil)
+
+		listGenerator := &groceryListCreator{
+			logger:            loggingnoop.NewLogger(),
+			tracer:            tracing.NewTracerForTest(t.Name()),
+			conversionManager: mdm,
+		}
+
+		mealPlan := buildMealPlanWithIngredientsInSeparateRecipes(
+			&mealplanning.RecipeStepIngredient{Ingredient: butter, MinQuantity: 1, MeasurementUnit: *sticks},
+			&mealplanning.RecipeStepIngredient{Ingredient: butter, MinQuantity: 100, MeasurementUnit: *grams},
+		)
+
+		result, err := listGenerator.GenerateGroceryListInputs(t.Context(), mealPlan)
+		require.NoError(t, err)
+		assert.Len(t, result.Items, 2)
+		require.Len(t, result.UnmergedItems, 1)
+
+		assert.Equal(t, butter.ID, result.UnmergedItems[0].Item.ValidIngredientID)
+		assert.NotEqual(t, result.UnmergedItems[0].Item.ValidMeasurementUnitID, result.UnmergedItems[0].PreferredMeasurementUnitID)
+		assert.Equal(t, UnmergedGroceryListItemStatusExplanation, result.UnmergedItems[0].Item.StatusExplanation)
+
+		for _, item := range result.Items {
+			if item.ID == result.UnmergedItems[0].Item.ID {
+				continue
+			}
+			assert.Empty(t, item.StatusExplanation)
+		}
+
+		mock.AssertExpectationsForObjects(t, mdm)
+	})
+
+	T.Run("with error fetching conversions", func(t *testing.T) {
+		t.Parallel()
+
+		butter := fakes.BuildFakeValidIngredient()
+
+		mdm := &mealplanningmock.Repository{}
+		mdm.On(reflection.GetMethodName(mdm.GetValidMeasurementUnitConversionsForIngredients), testutils.ContextMatcher, []string{butter.ID}).Return([]*mealplanning.ValidMeasurementUnitConversion(nil), errors.New("blah"))
+
+		listGenerator := &groceryListCreator{
+			logger:            loggingnoop.NewLogger(),
+			tracer:            tracing.NewTracerForTest(t.Name()),
+			conversionManager: mdm,
+		}
+
+		mealPlan := buildMealPlanWithIngredientsInSeparateRecipes(
+			&mealplanning.RecipeStepIngredient{Ingredient: butter, MinQuantity: 1, MeasurementUnit: *fakes.BuildFakeValidMeasurementUnit()},
+			&mealplanning.RecipeStepIngredient{Ingredient: butter, MinQuantity: 100, MeasurementUnit: *fakes.BuildFakeValidMeasurementUnit()},
+		)
+
+		result, err := listGenerator.GenerateGroceryListInputs(t.Context(), mealPlan)
+		assert.Error(t, err)
+		assert.Nil(t, result)
+
+		mock.AssertExpectationsForObjects(t, mdm)
+	})
+}
+
+// buildMealPlanWithIngredientsInSeparateRecipes builds a meal plan where each ingredient is used by its own chosen recipe.
+func buildMealPlanWithIngredientsInSeparateRecipes(ingredients ...*mealplanning.RecipeStepIngredient) *mealplanning.MealPlan {
+	mealPlan := &mealplanning.MealPlan{ID: fakes.BuildFakeID()}
+	for _, ingredient := range ingredients {
+		mealPlan.Events = append(mealPlan.Events, &mealplanning.MealPlanEvent{
+			Options: []*mealplanning.MealPlanOption{
+				{
+					ID:        fakes.BuildFakeID(),
+					Chosen:    true,
+					MealScale: 1.0,
+					Meal: mealplanning.Meal{
+						Components: []*mealplanning.MealComponent{
+							{
+								RecipeScale: 1.0,
+								Recipe: mealplanning.Recipe{
+									ID: fakes.BuildFakeID(),
+									Steps: []*mealplanning.RecipeStep{
+										{
+											ID:          fakes.BuildFakeID(),
+											Ingredients: []*mealplanning.RecipeStepIngredient{ingredient},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		})
+	}
+
+	return mealPlan
 }
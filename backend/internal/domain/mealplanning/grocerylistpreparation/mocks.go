@@ -16,8 +16,22 @@ type MockGroceryListCreator struct {
 }
 
 // GenerateGroceryListInputs is a mock function.
-func (m *MockGroceryListCreator) GenerateGroceryListInputs(ctx context.Context, mealPlan *mealplanning.MealPlan) ([]*mealplanning.MealPlanGroceryListItemDatabaseCreationInput, error) {
+func (m *MockGroceryListCreator) GenerateGroceryListInputs(ctx context.Context, mealPlan *mealplanning.MealPlan) (*GroceryListInputs, error) {
 	returnValues := m.Called(ctx, mealPlan)
 
-	return returnValues.Get(0).([]*mealplanning.MealPlanGroceryListItemDatabaseCreationInput), returnValues.Error(1)
+	return returnValues.Get(0).(*GroceryListInputs), returnValues.Error(1)
+}
+
+// OffsetGroceryListWithPantry is a mock function.
+func (m *MockGroceryListCreator) OffsetGroceryListWithPantry(ctx context.Context, inputs *GroceryListInputs, pantryItems []*mealplanning.PantryItem) ([]*PantryItemConsumption, error) {
+	returnValues := m.Called(ctx, inputs, pantryItems)
+
+	return returnValues.Get(0).([]*PantryItemConsumption), returnValues.Error(1)
+}
+
+// PantryConsumptionForMealPlanOption is a mock function.
+func (m *MockGroceryListCreator) PantryConsumptionForMealPlanOption(ctx context.Context, mealPlan *mealplanning.MealPlan, mealPlanOption *mealplanning.MealPlanOption, pantryItems []*mealplanning.PantryItem) ([]*PantryItemConsumption, error) {
+	returnValues := m.Called(ctx, mealPlan, mealPlanOption, pantryItems)
+
+	return returnValues.Get(0).([]*PantryItemConsumption), returnValues.Error(1)
 }
@@ -0,0 +1,185 @@
+package grocerylistpreparation
+
+import (
+	"context"
+	"slices"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+	mealplanningkeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/keys"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/unitconversion"
+
+	"github.com/primandproper/platform/observability"
+
+	"github.com/shopspring/decimal"
+)
+
+// PantryItemConsumption describes how much of a pantry item is used up, in the pantry item's own measurement unit.
+type PantryItemConsumption struct {
+	PantryItem *mealplanning.PantryItem
+	Quantity   float32
+}
+
+// fetchConversionsForPantryItems fetches the measurement unit conversions for every ingredient that is both needed and on hand.
+func (g *groceryListCreator) fetchConversionsForPantryItems(ctx context.Context, items []*mealplanning.MealPlanGroceryListItemDatabaseCreationInput, pantryItems []*mealplanning.PantryItem) ([]*mealplanning.ValidMeasurementUnitConversion, error) {
+	onHand := map[string]bool{}
+	for _, pantryItem := range pantryItems {
+		onHand[pantryItem.Ingredient.ID] = true
+	}
+
+	ingredientIDs := []string{}
+	for _, item := range items {
+		if onHand[item.ValidIngredientID] && !slices.Contains(ingredientIDs, item.ValidIngredientID) {
+			ingredientIDs = append(ingredientIDs, item.ValidIngredientID)
+		}
+	}
+
+	if len(ingredientIDs) == 0 {
+		return nil, nil
+	}
+	slices.Sort(ingredientIDs)
+
+	return g.conversionManager.GetValidMeasurementUnitConversionsForIngredients(ctx, ingredientIDs)
+}
+
+// OffsetGroceryListWithPantry reduces the quantities of grocery list items by what the account already has on hand.
+// Items that are entirely covered by pantry stock keep their quantities, but are marked as already owned.
+func (g *groceryListCreator) OffsetGroceryListWithPantry(ctx context.Context, inputs *GroceryListInputs, pantryItems []*mealplanning.PantryItem) ([]*PantryItemConsumption, error) {
+	ctx, span := g.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if inputs == nil || len(inputs.Items) == 0 || len(pantryItems) == 0 {
+		return nil, nil
+	}
+
+	logger := g.logger.Clone().WithValue("pantry_items", len(pantryItems))
+
+	conversions, err := g.fetchConversionsForPantryItems(ctx, inputs.Items, pantryItems)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching measurement unit conversions for pantry items")
+	}
+
+	return allocatePantryStock(inputs.Items, pantryItems, conversions), nil
+}
+
+// PantryConsumptionForMealPlanOption determines how much of each pantry item cooking a chosen meal plan option uses up.
+func (g *groceryListCreator) PantryConsumptionForMealPlanOption(ctx context.Context, mealPlan *mealplanning.MealPlan, mealPlanOption *mealplanning.MealPlanOption, pantryItems []*mealplanning.PantryItem) ([]*PantryItemConsumption, error) {
+	ctx, span := g.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := g.logger.Clone().
+		WithValue(mealplanningkeys.MealPlanIDKey, mealPlan.ID).
+		WithValue(mealplanningkeys.MealPlanOptionIDKey, mealPlanOption.ID)
+
+	// only the one option is being cooked, so generate the ingredients it needs as though it were the whole meal plan.
+	needed, err := g.GenerateGroceryListInputs(ctx, &mealplanning.MealPlan{
+		ID:         mealPlan.ID,
+		Selections: mealPlan.Selections,
+		Events: []*mealplanning.MealPlanEvent{
+			{
+				ID:      mealPlanOption.BelongsToMealPlanEvent,
+				Options: []*mealplanning.MealPlanOption{mealPlanOption},
+			},
+		},
+	})
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "determining ingredients for meal plan option")
+	}
+
+	return g.OffsetGroceryListWithPantry(ctx, needed, pantryItems)
+}
+
+// allocatePantryStock draws down pantry items (in the order provided) against the grocery list items for the same
+// ingredient, converting between measurement units where a conversion exists. It adjusts the items in place and
+// returns how much of each pantry item was allocated.
+func allocatePantryStock(
+	items []*mealplanning.MealPlanGroceryListItemDatabaseCreationInput,
+	pantryItems []*mealplanning.PantryItem,
+	conversions []*mealplanning.ValidMeasurementUnitConversion,
+) []*PantryItemConsumption {
+	pantryByIngredient := map[string][]*mealplanning.PantryItem{}
+	remaining := map[string]decimal.Decimal{}
+	for _, pantryItem := range pantryItems {
+		if pantryItem.Quantity <= 0 {
+			continue
+		}
+		pantryByIngredient[pantryItem.Ingredient.ID] = append(pantryByIngredient[pantryItem.Ingredient.ID], pantryItem)
+		remaining[pantryItem.ID] = decimal.NewFromFloat32(pantryItem.Quantity)
+	}
+
+	graphs := map[string]unitconversion.Graph{}
+	used := map[string]decimal.Decimal{}
+
+	for _, item := range items {
+		available := pantryByIngredient[item.ValidIngredientID]
+		if len(available) == 0 || item.MinQuantityNeeded <= 0 {
+			continue
+		}
+
+		graph, ok := graphs[item.ValidIngredientID]
+		if !ok {
+			graph = unitconversion.NewGraph(item.ValidIngredientID, conversions)
+			graphs[item.ValidIngredientID] = graph
+		}
+
+		needed := decimal.NewFromFloat32(item.MinQuantityNeeded)
+		for _, pantryItem := range available {
+			if needed.IsZero() {
+				break
+			}
+
+			left := remaining[pantryItem.ID]
+			if left.IsZero() {
+				continue
+			}
+
+			factor, convertible := graph.Factor(pantryItem.MeasurementUnit.ID, item.ValidMeasurementUnitID)
+			if !convertible || factor.IsZero() {
+				continue
+			}
+
+			if inItemUnits := left.Mul(factor); inItemUnits.GreaterThanOrEqual(needed) {
+				consumed := needed.Div(factor)
+				remaining[pantryItem.ID] = left.Sub(consumed)
+				used[pantryItem.ID] = used[pantryItem.ID].Add(consumed)
+				needed = decimal.Zero
+			} else {
+				needed = needed.Sub(inItemUnits)
+				remaining[pantryItem.ID] = decimal.Zero
+				used[pantryItem.ID] = used[pantryItem.ID].Add(left)
+			}
+		}
+
+		if needed.IsZero() {
+			item.Status = mealplanning.MealPlanGroceryListItemStatusAlreadyOwned
+			continue
+		}
+
+		covered := float32(decimal.NewFromFloat32(item.MinQuantityNeeded).Sub(needed).Truncate(2).InexactFloat64())
+		item.MinQuantityNeeded = float32(needed.Truncate(2).InexactFloat64())
+		if item.MaxQuantityNeeded != nil {
+			reduced := max(*item.MaxQuantityNeeded-covered, item.MinQuantityNeeded)
+			item.MaxQuantityNeeded = &reduced
+		}
+	}
+
+	consumption := []*PantryItemConsumption{}
+	for _, pantryItem := range pantryItems {
+		amount, ok := used[pantryItem.ID]
+		if !ok || amount.IsZero() {
+			continue
+		}
+
+		quantity := float32(amount.Round(2).InexactFloat64())
+		if remaining[pantryItem.ID].IsZero() {
+			// avoid leaving rounding crumbs behind when an item is used up entirely
+			quantity = pantryItem.Quantity
+		}
+
+		consumption = append(consumption, &PantryItemConsumption{
+			PantryItem: pantryItem,
+			Quantity:   quantity,
+		})
+	}
+
+	return consumption
+}
@@ -0,0 +1,269 @@
+package grocerylistpreparation
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/fakes"
+	mealplanningmock "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/mocks"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/testutils"
+
+	loggingnoop "github.com/primandproper/platform/observability/logging/noop"
+	"github.com/primandproper/platform/observability/tracing"
+	"github.com/primandproper/platform/reflection"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func buildPantryItemForTest(ingredient *mealplanning.ValidIngredient, unit *mealplanning.ValidMeasurementUnit, quantity float32) *mealplanning.PantryItem {
+	pantryItem := fakes.BuildFakePantryItem()
+	pantryItem.Ingredient = *ingredient
+	pantryItem.MeasurementUnit = *unit
+	pantryItem.Quantity = quantity
+
+	return pantryItem
+}
+
+func buildGroceryListItemForTest(ingredient *mealplanning.ValidIngredient, unit *mealplanning.ValidMeasurementUnit, minQty float32, maxQty *float32) *mealplanning.MealPlanGroceryListItemDatabaseCreationInput {
+	return &mealplanning.MealPlanGroceryListItemDatabaseCreationInput{
+		ID:                     fakes.BuildFakeID(),
+		Status:                 mealplanning.MealPlanGroceryListItemStatusNeeds,
+		ValidIngredientID:      ingredient.ID,
+		ValidMeasurementUnitID: unit.ID,
+		MinQuantityNeeded:      minQty,
+		MaxQuantityNeeded:      maxQty,
+	}
+}
+
+func Test_allocatePantryStock(T *testing.T) {
+	T.Parallel()
+
+	T.Run("partially covered item", func(t *testing.T) {
+		t.Parallel()
+
+		onion := fakes.BuildFakeValidIngredient()
+		grams := fakes.BuildFakeValidMeasurementUnit()
+
+		item := buildGroceryListItemForTest(onion, grams, 300, new(float32(400)))
+		pantryItem := buildPantryItemForTest(onion, grams, 100)
+
+		consumption := allocatePantryStock([]*mealplanning.MealPlanGroceryListItemDatabaseCreationInput{item}, []*mealplanning.PantryItem{pantryItem}, nil)
+
+		assert.Equal(t, mealplanning.MealPlanGroceryListItemStatusNeeds, item.Status)
+		assert.Equal(t, float32(200), item.MinQuantityNeeded)
+		require.NotNil(t, item.MaxQuantityNeeded)
+		assert.Equal(t, float32(300), *item.MaxQuantityNeeded)
+
+		require.Len(t, consumption, 1)
+		assert.Equal(t, pantryItem, consumption[0].PantryItem)
+		assert.Equal(t, float32(100), consumption[0].Quantity)
+	})
+
+	T.Run("fully covered item is marked already owned", func(t *testing.T) {
+		t.Parallel()
+
+		onion := fakes.BuildFakeValidIngredient()
+		grams := fakes.BuildFakeValidMeasurementUnit()
+
+		item := buildGroceryListItemForTest(onion, grams, 100, nil)
+		pantryItem := buildPantryItemForTest(onion, grams, 250)
+
+		consumption := allocatePantryStock([]*mealplanning.MealPlanGroceryListItemDatabaseCreationInput{item}, []*mealplanning.PantryItem{pantryItem}, nil)
+
+		assert.Equal(t, mealplanning.MealPlanGroceryListItemStatusAlreadyOwned, item.Status)
+		assert.Equal(t, float32(100), item.MinQuantityNeeded)
+
+		require.Len(t, consumption, 1)
+		assert.Equal(t, float32(100), consumption[0].Quantity)
+	})
+
+	T.Run("draws down pantry items in order across units", func(t *testing.T) {
+		t.Parallel()
+
+		butter := fakes.BuildFakeValidIngredient()
+		grams := fakes.BuildFakeValidMeasurementUnit()
+		tablespoons := fakes.BuildFakeValidMeasurementUnit()
+
+		conversion := fakes.BuildFakeValidMeasurementUnitConversion()
+		conversion.From = *tablespoons
+		conversion.To = *grams
+		conversion.Modifier = 14
+		conversion.OnlyForIngredient = nil
+
+		item := buildGroceryListItemForTest(butter, grams, 100, nil)
+		first := buildPantryItemForTest(butter, tablespoons, 5)
+		second := buildPantryItemForTest(butter, grams, 500)
+
+		consumption := allocatePantryStock(
+			[]*mealplanning.MealPlanGroceryListItemDatabaseCreationInput{item},
+			[]*mealplanning.PantryItem{first, second},
+			[]*mealplanning.ValidMeasurementUnitConversion{conversion},
+		)
+
+		assert.Equal(t, mealplanning.MealPlanGroceryListItemStatusAlreadyOwned, item.Status)
+
+		require.Len(t, consumption, 2)
+		assert.Equal(t, first, consumption[0].PantryItem)
+		assert.Equal(t, float32(5), consumption[0].Quantity)
+		assert.Equal(t, second, consumption[1].PantryItem)
+		assert.Equal(t, float32(30), consumption[1].Quantity)
+	})
+
+	T.Run("skips pantry items without a conversion", func(t *testing.T) {
+		t.Parallel()
+
+		butter := fakes.BuildFakeValidIngredient()
+		grams := fakes.BuildFakeValidMeasurementUnit()
+		sticks := fakes.BuildFakeValidMeasurementUnit()
+
+		item := buildGroceryListItemForTest(butter, grams, 100, nil)
+		pantryItem := buildPantryItemForTest(butter, sticks, 2)
+
+		consumption := allocatePantryStock([]*mealplanning.MealPlanGroceryListItemDatabaseCreationInput{item}, []*mealplanning.PantryItem{pantryItem}, nil)
+
+		assert.Equal(t, mealplanning.MealPlanGroceryListItemStatusNeeds, item.Status)
+		assert.Equal(t, float32(100), item.MinQuantityNeeded)
+		assert.Empty(t, consumption)
+	})
+
+	T.Run("shares one pantry item between several grocery list items", func(t *testing.T) {
+		t.Parallel()
+
+		salt := fakes.BuildFakeValidIngredient()
+		grams := fakes.BuildFakeValidMeasurementUnit()
+
+		first := buildGroceryListItemForTest(salt, grams, 10, nil)
+		second := buildGroceryListItemForTest(salt, grams, 10, nil)
+		pantryItem := buildPantryItemForTest(salt, grams, 15)
+
+		consumption := allocatePantryStock([]*mealplanning.MealPlanGroceryListItemDatabaseCreationInput{first, second}, []*mealplanning.PantryItem{pantryItem}, nil)
+
+		assert.Equal(t, mealplanning.MealPlanGroceryListItemStatusAlreadyOwned, first.Status)
+		assert.Equal(t, mealplanning.MealPlanGroceryListItemStatusNeeds, second.Status)
+		assert.Equal(t, float32(5), second.MinQuantityNeeded)
+
+		require.Len(t, consumption, 1)
+		assert.Equal(t, float32(15), consumption[0].Quantity)
+	})
+}
+
+func Test_groceryListCreator_OffsetGroceryListWithPantry(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		onion := fakes.BuildFakeValidIngredient()
+		grams := fakes.BuildFakeValidMeasurementUnit()
+
+		mdm := &mealplanningmock.Repository{}
+		mdm.On(reflection.GetMethodName(mdm.GetValidMeasurementUnitConversionsForIngredients), testutils.ContextMatcher, []string{onion.ID}).Return([]*mealplanning.ValidMeasurementUnitConversion{}, nil)
+
+		listGenerator := &groceryListCreator{
+			logger:            loggingnoop.NewLogger(),
+			tracer:            tracing.NewTracerForTest(t.Name()),
+			conversionManager: mdm,
+		}
+
+		inputs := &GroceryListInputs{
+			Items: []*mealplanning.MealPlanGroceryListItemDatabaseCreationInput{
+				buildGroceryListItemForTest(onion, grams, 100, nil),
+				buildGroceryListItemForTest(fakes.BuildFakeValidIngredient(), grams, 100, nil),
+			},
+		}
+
+		consumption, err := listGenerator.OffsetGroceryListWithPantry(t.Context(), inputs, []*mealplanning.PantryItem{buildPantryItemForTest(onion, grams, 40)})
+		assert.NoError(t, err)
+		require.Len(t, consumption, 1)
+		assert.Equal(t, float32(60), inputs.Items[0].MinQuantityNeeded)
+		assert.Equal(t, float32(100), inputs.Items[1].MinQuantityNeeded)
+
+		mock.AssertExpectationsForObjects(t, mdm)
+	})
+
+	T.Run("with empty pantry", func(t *testing.T) {
+		t.Parallel()
+
+		listGenerator := &groceryListCreator{
+			logger: loggingnoop.NewLogger(),
+			tracer: tracing.NewTracerForTest(t.Name()),
+		}
+
+		inputs := &GroceryListInputs{
+			Items: []*mealplanning.MealPlanGroceryListItemDatabaseCreationInput{
+				buildGroceryListItemForTest(fakes.BuildFakeValidIngredient(), fakes.BuildFakeValidMeasurementUnit(), 100, nil),
+			},
+		}
+
+		consumption, err := listGenerator.OffsetGroceryListWithPantry(t.Context(), inputs, nil)
+		assert.NoError(t, err)
+		assert.Empty(t, consumption)
+		assert.Equal(t, float32(100), inputs.Items[0].MinQuantityNeeded)
+	})
+
+	T.Run("with error fetching conversions", func(t *testing.T) {
+		t.Parallel()
+
+		onion := fakes.BuildFakeValidIngredient()
+		grams := fakes.BuildFakeValidMeasurementUnit()
+
+		mdm := &mealplanningmock.Repository{}
+		mdm.On(reflection.GetMethodName(mdm.GetValidMeasurementUnitConversionsForIngredients), testutils.ContextMatcher, []string{onion.ID}).Return([]*mealplanning.ValidMeasurementUnitConversion(nil), errors.New("blah"))
+
+		listGenerator := &groceryListCreator{
+			logger:            loggingnoop.NewLogger(),
+			tracer:            tracing.NewTracerForTest(t.Name()),
+			conversionManager: mdm,
+		}
+
+		inputs := &GroceryListInputs{
+			Items: []*mealplanning.MealPlanGroceryListItemDatabaseCreationInput{
+				buildGroceryListItemForTest(onion, grams, 100, nil),
+			},
+		}
+
+		consumption, err := listGenerator.OffsetGroceryListWithPantry(t.Context(), inputs, []*mealplanning.PantryItem{buildPantryItemForTest(onion, grams, 40)})
+		assert.Error(t, err)
+		assert.Nil(t, consumption)
+
+		mock.AssertExpectationsForObjects(t, mdm)
+	})
+}
+
+func Test_groceryListCreator_PantryConsumptionForMealPlanOption(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		onion := fakes.BuildFakeValidIngredient()
+		grams := fakes.BuildFakeValidMeasurementUnit()
+
+		mdm := &mealplanningmock.Repository{}
+		mdm.On(reflection.GetMethodName(mdm.GetValidMeasurementUnitConversionsForIngredients), testutils.ContextMatcher, []string{onion.ID}).Return([]*mealplanning.ValidMeasurementUnitConversion{}, nil)
+
+		listGenerator := &groceryListCreator{
+			logger:            loggingnoop.NewLogger(),
+			tracer:            tracing.NewTracerForTest(t.Name()),
+			conversionManager: mdm,
+		}
+
+		mealPlan := buildMealPlanWithIngredientsInSeparateRecipes(
+			&mealplanning.RecipeStepIngredient{Ingredient: onion, MinQuantity: 150, MeasurementUnit: *grams},
+			&mealplanning.RecipeStepIngredient{Ingredient: onion, MinQuantity: 500, MeasurementUnit: *grams},
+		)
+		option := mealPlan.Events[0].Options[0]
+		pantryItem := buildPantryItemForTest(onion, grams, 1000)
+
+		consumption, err := listGenerator.PantryConsumptionForMealPlanOption(t.Context(), mealPlan, option, []*mealplanning.PantryItem{pantryItem})
+		assert.NoError(t, err)
+		require.Len(t, consumption, 1)
+		assert.Equal(t, pantryItem, consumption[0].PantryItem)
+		assert.Equal(t, float32(150), consumption[0].Quantity)
+
+		mock.AssertExpectationsForObjects(t, mdm)
+	})
+}
@@ -0,0 +1,132 @@
+package grocerylistpreparation
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/unitconversion"
+
+	"github.com/shopspring/decimal"
+)
+
+// preferredUnitFor picks the unit that the most items can be converted into, preferring metric units and
+// then the lowest ID when that's a tie, so that results are stable across runs.
+func preferredUnitFor(items []*mealplanning.MealPlanGroceryListItemDatabaseCreationInput, graph unitconversion.Graph, metricUnits map[string]bool) string {
+	candidates := []string{}
+	for _, item := range items {
+		if !slices.Contains(candidates, item.ValidMeasurementUnitID) {
+			candidates = append(candidates, item.ValidMeasurementUnitID)
+		}
+	}
+
+	slices.SortFunc(candidates, func(x, y string) int {
+		if metricUnits[x] != metricUnits[y] {
+			if metricUnits[x] {
+				return -1
+			}
+			return 1
+		}
+		return strings.Compare(x, y)
+	})
+
+	preferred, bestCount := "", -1
+	for _, candidate := range candidates {
+		count := 0
+		for _, item := range items {
+			if _, ok := graph.Factor(item.ValidMeasurementUnitID, candidate); ok {
+				count++
+			}
+		}
+
+		if count > bestCount {
+			preferred, bestCount = candidate, count
+		}
+	}
+
+	return preferred
+}
+
+// addQuantities adds a quantity to an existing grocery list item, the same way same-unit items are aggregated.
+func addQuantities(existing *mealplanning.MealPlanGroceryListItemDatabaseCreationInput, minQty float32, maxQty *float32) {
+	existing.MinQuantityNeeded += minQty
+	if existing.MaxQuantityNeeded != nil && maxQty != nil {
+		*existing.MaxQuantityNeeded += *maxQty
+	} else if maxQty != nil {
+		existing.MaxQuantityNeeded = maxQty
+	}
+}
+
+func convertQuantity(quantity float32, factor decimal.Decimal) float32 {
+	return float32(decimal.NewFromFloat32(quantity).Mul(factor).Truncate(2).InexactFloat64())
+}
+
+// mergeAcrossMeasurementUnits merges items for the same ingredient that are expressed in different measurement
+// units into a single item in that ingredient's preferred unit. Items that have no conversion path to the preferred
+// unit are left as separate lines, marked with UnmergedGroceryListItemStatusExplanation so the reason is stored
+// alongside them, and reported back to the caller.
+func mergeAcrossMeasurementUnits(
+	items []*mealplanning.MealPlanGroceryListItemDatabaseCreationInput,
+	conversions []*mealplanning.ValidMeasurementUnitConversion,
+) (merged []*mealplanning.MealPlanGroceryListItemDatabaseCreationInput, unmerged []*UnmergedGroceryListItem) {
+	metricUnits := map[string]bool{}
+	for _, conversion := range conversions {
+		metricUnits[conversion.From.ID] = conversion.From.Metric
+		metricUnits[conversion.To.ID] = conversion.To.Metric
+	}
+
+	ingredientIDs := []string{}
+	itemsByIngredient := map[string][]*mealplanning.MealPlanGroceryListItemDatabaseCreationInput{}
+	for _, item := range items {
+		if _, ok := itemsByIngredient[item.ValidIngredientID]; !ok {
+			ingredientIDs = append(ingredientIDs, item.ValidIngredientID)
+		}
+		itemsByIngredient[item.ValidIngredientID] = append(itemsByIngredient[item.ValidIngredientID], item)
+	}
+
+	for _, ingredientID := range ingredientIDs {
+		group := itemsByIngredient[ingredientID]
+		if len(group) == 1 {
+			merged = append(merged, group[0])
+			continue
+		}
+
+		graph := unitconversion.NewGraph(ingredientID, conversions)
+		preferredUnitID := preferredUnitFor(group, graph, metricUnits)
+
+		var base *mealplanning.MealPlanGroceryListItemDatabaseCreationInput
+		for _, item := range group {
+			if item.ValidMeasurementUnitID == preferredUnitID {
+				base = item
+				break
+			}
+		}
+		merged = append(merged, base)
+
+		for _, item := range group {
+			if item == base {
+				continue
+			}
+
+			factor, ok := graph.Factor(item.ValidMeasurementUnitID, preferredUnitID)
+			if !ok {
+				item.StatusExplanation = UnmergedGroceryListItemStatusExplanation
+				merged = append(merged, item)
+				unmerged = append(unmerged, &UnmergedGroceryListItem{
+					Item:                       item,
+					PreferredMeasurementUnitID: preferredUnitID,
+				})
+				continue
+			}
+
+			var maxQty *float32
+			if item.MaxQuantityNeeded != nil {
+				converted := convertQuantity(*item.MaxQuantityNeeded, factor)
+				maxQty = &converted
+			}
+			addQuantities(base, convertQuantity(item.MinQuantityNeeded, factor), maxQty)
+		}
+	}
+
+	return merged, unmerged
+}
@@ -0,0 +1,212 @@
+// Package icalendar renders meal plans as RFC 5545 iCalendar documents.
+package icalendar
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+const (
+	// ContentType is the media type for iCalendar documents.
+	ContentType = "text/calendar; charset=utf-8"
+
+	productID = "-//Dinner Done Better//Meal Plans//EN"
+	// uidDomain keeps our UIDs globally unique, as RFC 5545 section 3.8.4.7 recommends.
+	uidDomain = "dinnerdonebetter.com"
+
+	dateTimeFormat = "20060102T150405Z"
+	// maxLineOctets is the longest a content line may be before it must be folded (RFC 5545 section 3.1).
+	maxLineOctets = 75
+	lineBreak     = "\r\n"
+)
+
+const (
+	// TodoStatusNeedsAction indicates a to-do still needs to be done.
+	TodoStatusNeedsAction = "NEEDS-ACTION"
+	// TodoStatusCompleted indicates a to-do is done.
+	TodoStatusCompleted = "COMPLETED"
+	// TodoStatusCancelled indicates a to-do won't be done.
+	TodoStatusCancelled = "CANCELLED"
+)
+
+type (
+	// Calendar is a VCALENDAR object.
+	Calendar struct {
+		Name   string
+		Events []*Event
+		Todos  []*Todo
+	}
+
+	// Event is a VEVENT component.
+	Event struct {
+		Start       time.Time
+		End         time.Time
+		Stamp       time.Time
+		UID         string
+		Summary     string
+		Description string
+		Alarms      []*Alarm
+	}
+
+	// Todo is a VTODO component.
+	Todo struct {
+		Due         time.Time
+		Stamp       time.Time
+		Start       *time.Time
+		Completed   *time.Time
+		UID         string
+		Summary     string
+		Description string
+		Status      string
+		Alarms      []*Alarm
+	}
+
+	// Alarm is a VALARM component. It fires Before the start of its event, or Before the due time of its to-do.
+	Alarm struct {
+		Description string
+		Before      time.Duration
+	}
+)
+
+// Encode renders the calendar as an iCalendar document.
+func (c *Calendar) Encode() []byte {
+	w := &contentWriter{}
+
+	w.line("BEGIN", "VCALENDAR")
+	w.line("VERSION", "2.0")
+	w.line("PRODID", productID)
+	w.line("CALSCALE", "GREGORIAN")
+	w.line("METHOD", "PUBLISH")
+	if c.Name != "" {
+		w.line("X-WR-CALNAME", escapeText(c.Name))
+	}
+
+	for _, event := range c.Events {
+		event.encode(w)
+	}
+
+	for _, todo := range c.Todos {
+		todo.encode(w)
+	}
+
+	w.line("END", "VCALENDAR")
+
+	return w.buf.Bytes()
+}
+
+func (e *Event) encode(w *contentWriter) {
+	w.line("BEGIN", "VEVENT")
+	w.line("UID", escapeText(e.UID))
+	w.line("DTSTAMP", formatDateTime(e.Stamp))
+	w.line("DTSTART", formatDateTime(e.Start))
+	w.line("DTEND", formatDateTime(e.End))
+	w.line("SUMMARY", escapeText(e.Summary))
+	if e.Description != "" {
+		w.line("DESCRIPTION", escapeText(e.Description))
+	}
+
+	for _, alarm := range e.Alarms {
+		alarm.encode(w, "TRIGGER")
+	}
+
+	w.line("END", "VEVENT")
+}
+
+func (t *Todo) encode(w *contentWriter) {
+	w.line("BEGIN", "VTODO")
+	w.line("UID", escapeText(t.UID))
+	w.line("DTSTAMP", formatDateTime(t.Stamp))
+	if t.Start != nil {
+		w.line("DTSTART", formatDateTime(*t.Start))
+	}
+	w.line("DUE", formatDateTime(t.Due))
+	w.line("SUMMARY", escapeText(t.Summary))
+	if t.Description != "" {
+		w.line("DESCRIPTION", escapeText(t.Description))
+	}
+	if t.Status != "" {
+		w.line("STATUS", t.Status)
+	}
+	if t.Completed != nil {
+		w.line("COMPLETED", formatDateTime(*t.Completed))
+	}
+
+	// a to-do's alarms are relative to when it's due, not when it starts.
+	for _, alarm := range t.Alarms {
+		alarm.encode(w, "TRIGGER;RELATED=END")
+	}
+
+	w.line("END", "VTODO")
+}
+
+func (a *Alarm) encode(w *contentWriter, trigger string) {
+	w.line("BEGIN", "VALARM")
+	w.line("ACTION", "DISPLAY")
+	w.line(trigger, formatDurationBefore(a.Before))
+	w.line("DESCRIPTION", escapeText(a.Description))
+	w.line("END", "VALARM")
+}
+
+// contentWriter writes folded, CRLF-terminated content lines.
+type contentWriter struct {
+	buf bytes.Buffer
+}
+
+func (w *contentWriter) line(name, value string) {
+	w.buf.WriteString(foldLine(name + ":" + value))
+	w.buf.WriteString(lineBreak)
+}
+
+// foldLine splits a content line into chunks of at most maxLineOctets, each continuation starting with a space.
+// It never splits a multi-byte UTF-8 sequence.
+func foldLine(line string) string {
+	if len(line) <= maxLineOctets {
+		return line
+	}
+
+	var sb strings.Builder
+	limit := maxLineOctets
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && !utf8.RuneStart(line[cut]) {
+			cut--
+		}
+
+		sb.WriteString(line[:cut])
+		sb.WriteString(lineBreak + " ")
+		line = line[cut:]
+		// continuation lines spend one octet on the leading space.
+		limit = maxLineOctets - 1
+	}
+	sb.WriteString(line)
+
+	return sb.String()
+}
+
+// escapeText escapes a TEXT value per RFC 5545 section 3.3.11.
+func escapeText(s string) string {
+	return strings.NewReplacer(
+		`\`, `\\`,
+		";", `\;`,
+		",", `\,`,
+		"\r\n", `\n`,
+		"\n", `\n`,
+		"\r", `\n`,
+	).Replace(s)
+}
+
+func formatDateTime(t time.Time) string {
+	return t.UTC().Format(dateTimeFormat)
+}
+
+// formatDurationBefore renders a negative DURATION value, to the second.
+func formatDurationBefore(d time.Duration) string {
+	return fmt.Sprintf("-PT%dS", int64(d/time.Second))
+}
+
+func buildUID(kind, id string) string {
+	return fmt.Sprintf("%s-%s@%s", kind, id, uidDomain)
+}
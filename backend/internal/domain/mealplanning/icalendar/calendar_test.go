@@ -0,0 +1,117 @@
+package icalendar
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCalendar_Encode(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		start := time.Date(2026, time.March, 4, 18, 30, 0, 0, time.FixedZone("EST", -5*60*60))
+		completed := start.Add(-time.Hour)
+
+		calendar := &Calendar{
+			Name: "Meal Plans",
+			Events: []*Event{
+				{
+					UID:         "event@example.com",
+					Stamp:       start,
+					Start:       start,
+					End:         start.Add(time.Hour),
+					Summary:     "Dinner: Chicken, rice; beans",
+					Description: "Cook: alice\nDishwasher: bob",
+				},
+			},
+			Todos: []*Todo{
+				{
+					UID:       "todo@example.com",
+					Stamp:     start,
+					Due:       start,
+					Completed: &completed,
+					Summary:   "Marinate chicken",
+					Status:    TodoStatusCompleted,
+					Alarms: []*Alarm{
+						{Description: "Marinate chicken", Before: 90 * time.Minute},
+					},
+				},
+			},
+		}
+
+		actual := string(calendar.Encode())
+
+		assert.True(t, strings.HasPrefix(actual, "BEGIN:VCALENDAR\r\nVERSION:2.0\r\n"))
+		assert.True(t, strings.HasSuffix(actual, "END:VCALENDAR\r\n"))
+		assert.Contains(t, actual, "DTSTART:20260304T233000Z\r\n")
+		assert.Contains(t, actual, "DTEND:20260305T003000Z\r\n")
+		assert.Contains(t, actual, `SUMMARY:Dinner: Chicken\, rice\; beans`+"\r\n")
+		assert.Contains(t, actual, `DESCRIPTION:Cook: alice\nDishwasher: bob`+"\r\n")
+		assert.Contains(t, actual, "BEGIN:VTODO\r\n")
+		assert.Contains(t, actual, "DUE:20260304T233000Z\r\n")
+		assert.Contains(t, actual, "COMPLETED:20260304T223000Z\r\n")
+		assert.Contains(t, actual, "STATUS:COMPLETED\r\n")
+		assert.Contains(t, actual, "TRIGGER;RELATED=END:-PT5400S\r\n")
+
+		for line := range strings.SplitSeq(strings.TrimSuffix(actual, "\r\n"), "\r\n") {
+			assert.NotContains(t, line, "\n")
+		}
+	})
+}
+
+func TestFoldLine(T *testing.T) {
+	T.Parallel()
+
+	T.Run("short lines are left alone", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, "SUMMARY:Tacos", foldLine("SUMMARY:Tacos"))
+	})
+
+	T.Run("long lines are folded", func(t *testing.T) {
+		t.Parallel()
+
+		line := "DESCRIPTION:" + strings.Repeat("a", 200)
+		folded := foldLine(line)
+
+		segments := strings.Split(folded, "\r\n")
+		require.Len(t, segments, 3)
+		for i, segment := range segments {
+			assert.LessOrEqual(t, len(segment), maxLineOctets)
+			if i > 0 {
+				assert.True(t, strings.HasPrefix(segment, " "))
+			}
+		}
+
+		assert.Equal(t, line, strings.ReplaceAll(folded, "\r\n ", ""))
+	})
+
+	T.Run("multi-byte characters are never split", func(t *testing.T) {
+		t.Parallel()
+
+		line := "SUMMARY:" + strings.Repeat("é", 100)
+		folded := foldLine(line)
+
+		for segment := range strings.SplitSeq(folded, "\r\n ") {
+			assert.LessOrEqual(t, len(segment), maxLineOctets)
+			assert.True(t, strings.ToValidUTF8(segment, "?") == segment)
+		}
+		assert.Equal(t, line, strings.ReplaceAll(folded, "\r\n ", ""))
+	})
+}
+
+func TestEscapeText(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, `a\\b\;c\,d\ne\nf`, escapeText("a\\b;c,d\ne\r\nf"))
+	})
+}
@@ -0,0 +1,25 @@
+package icalendar
+
+import (
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+
+	"github.com/primandproper/platform/observability/logging"
+	"github.com/primandproper/platform/observability/tracing"
+	"github.com/primandproper/platform/random"
+
+	"github.com/samber/do/v2"
+)
+
+// RegisterMealPlanCalendarManager registers the meal plan calendar manager with the injector.
+func RegisterMealPlanCalendarManager(i do.Injector) {
+	do.Provide[MealPlanCalendarManager](i, func(i do.Injector) (MealPlanCalendarManager, error) {
+		return NewMealPlanCalendarManager(
+			do.MustInvoke[logging.Logger](i),
+			do.MustInvoke[tracing.TracerProvider](i),
+			do.MustInvoke[mealplanning.Repository](i),
+			do.MustInvoke[identity.Repository](i),
+			do.MustInvoke[random.Generator](i),
+		), nil
+	})
+}
@@ -0,0 +1,239 @@
+package icalendar
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"time"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity"
+	identitykeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity/keys"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+	mealplanningkeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/keys"
+
+	"github.com/primandproper/platform/database/filtering"
+	platformerrors "github.com/primandproper/platform/errors"
+	"github.com/primandproper/platform/identifiers"
+	"github.com/primandproper/platform/observability"
+	"github.com/primandproper/platform/observability/logging"
+	"github.com/primandproper/platform/observability/tracing"
+	"github.com/primandproper/platform/random"
+)
+
+const (
+	o11yName = "meal_plan_calendar_manager"
+
+	// feedTokenSize is how many random bytes go into a calendar feed token.
+	feedTokenSize = 32
+	// FeedPathPrefix is where calendar feeds are served on the HTTP API.
+	FeedPathPrefix = "/api/meal_plans/calendar/"
+	// FeedPathSuffix ends every calendar feed path, since some calendar apps go by the extension.
+	FeedPathSuffix = ".ics"
+
+	// mealPlanLookback is how far back a calendar looks for meal plans, by when they were created.
+	mealPlanLookback = 60 * 24 * time.Hour
+)
+
+var (
+	// ErrFeedNotFound indicates a calendar feed token doesn't match any active feed.
+	ErrFeedNotFound = errors.New("calendar feed not found")
+
+	_ MealPlanCalendarManager = (*calendarManager)(nil)
+)
+
+type (
+	// MealPlanCalendarManager manages calendar feeds and renders meal plan calendars.
+	MealPlanCalendarManager interface {
+		// CreateMealPlanCalendarFeed replaces any feed the user already has for the account with a new one.
+		// The returned feed's Token is the only time the token is available.
+		CreateMealPlanCalendarFeed(ctx context.Context, userID, accountID string) (*mealplanning.MealPlanCalendarFeed, error)
+		ExportMealPlanCalendar(ctx context.Context, userID, accountID string) ([]byte, error)
+		// ExportMealPlanCalendarForFeedToken returns ErrFeedNotFound when the token doesn't belong to an active feed.
+		ExportMealPlanCalendarForFeedToken(ctx context.Context, token string) ([]byte, error)
+	}
+
+	calendarManager struct {
+		tracer          tracing.Tracer
+		logger          logging.Logger
+		db              mealplanning.Repository
+		identityRepo    identity.Repository
+		secretGenerator random.Generator
+		now             func() time.Time
+	}
+)
+
+// NewMealPlanCalendarManager returns a MealPlanCalendarManager backed by the meal planning and identity repositories.
+func NewMealPlanCalendarManager(
+	logger logging.Logger,
+	tracerProvider tracing.TracerProvider,
+	db mealplanning.Repository,
+	identityRepo identity.Repository,
+	secretGenerator random.Generator,
+) MealPlanCalendarManager {
+	return &calendarManager{
+		tracer:          tracing.NewNamedTracer(tracerProvider, o11yName),
+		logger:          logging.NewNamedLogger(logger, o11yName),
+		db:              db,
+		identityRepo:    identityRepo,
+		secretGenerator: secretGenerator,
+		now:             func() time.Time { return time.Now().UTC() },
+	}
+}
+
+// CreateMealPlanCalendarFeed creates a new calendar feed for the user, archiving their previous one.
+func (m *calendarManager) CreateMealPlanCalendarFeed(ctx context.Context, userID, accountID string) (*mealplanning.MealPlanCalendarFeed, error) {
+	ctx, span := m.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if userID == "" || accountID == "" {
+		return nil, platformerrors.ErrInvalidIDProvided
+	}
+	logger := m.logger.WithSpan(span).WithValue(identitykeys.UserIDKey, userID).WithValue(identitykeys.AccountIDKey, accountID)
+	tracing.AttachToSpan(span, identitykeys.UserIDKey, userID)
+	tracing.AttachToSpan(span, identitykeys.AccountIDKey, accountID)
+
+	token, err := m.secretGenerator.GenerateBase32EncodedString(ctx, feedTokenSize)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "generating calendar feed token")
+	}
+
+	if err = m.db.ArchiveMealPlanCalendarFeedsForUser(ctx, userID, accountID); err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "archiving previous calendar feeds")
+	}
+
+	feed, err := m.db.CreateMealPlanCalendarFeed(ctx, &mealplanning.MealPlanCalendarFeedDatabaseCreationInput{
+		ID:               identifiers.New(),
+		TokenHash:        hashFeedToken(token),
+		BelongsToUser:    userID,
+		BelongsToAccount: accountID,
+	})
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "creating calendar feed")
+	}
+	feed.Token = token
+
+	return feed, nil
+}
+
+// ExportMealPlanCalendar renders the account's recent meal plans as a calendar for the user.
+func (m *calendarManager) ExportMealPlanCalendar(ctx context.Context, userID, accountID string) ([]byte, error) {
+	ctx, span := m.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if userID == "" || accountID == "" {
+		return nil, platformerrors.ErrInvalidIDProvided
+	}
+	logger := m.logger.WithSpan(span).WithValue(identitykeys.UserIDKey, userID).WithValue(identitykeys.AccountIDKey, accountID)
+	tracing.AttachToSpan(span, identitykeys.UserIDKey, userID)
+	tracing.AttachToSpan(span, identitykeys.AccountIDKey, accountID)
+
+	now := m.now()
+	filter := filtering.DefaultQueryFilter()
+	maxSize := uint8(filtering.MaxQueryFilterLimit)
+	filter.MaxResponseSize = &maxSize
+	createdAfter := now.Add(-mealPlanLookback)
+	filter.CreatedAfter = &createdAfter
+
+	mealPlans, err := m.db.GetMealPlansForAccount(ctx, accountID, filter)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching meal plans")
+	}
+
+	input := &MealPlanCalendarInput{
+		GeneratedAt:     now,
+		UserID:          userID,
+		MealPlans:       mealPlans.Data,
+		TasksByMealPlan: map[string][]*mealplanning.MealPlanTask{},
+		Usernames:       map[string]string{},
+	}
+
+	userIDs := []string{}
+	for _, mealPlan := range mealPlans.Data {
+		if mealPlan.Status != string(mealplanning.MealPlanStatusFinalized) {
+			continue
+		}
+
+		for _, event := range mealPlan.Events {
+			if chosen := chosenOption(event); chosen != nil {
+				for _, assignee := range []*string{chosen.AssignedCook, chosen.AssignedDishwasher} {
+					if assignee != nil {
+						userIDs = append(userIDs, *assignee)
+					}
+				}
+			}
+		}
+
+		if !mealPlan.TasksCreated {
+			continue
+		}
+
+		tasks, taskFetchErr := m.db.GetMealPlanTasksForMealPlan(ctx, mealPlan.ID, nil)
+		if taskFetchErr != nil {
+			return nil, observability.PrepareAndLogError(taskFetchErr, logger.WithValue(mealplanningkeys.MealPlanIDKey, mealPlan.ID), span, "fetching meal plan tasks")
+		}
+		input.TasksByMealPlan[mealPlan.ID] = tasks.Data
+	}
+
+	if len(userIDs) > 0 {
+		users, userFetchErr := m.identityRepo.GetUsersWithIDs(ctx, userIDs)
+		if userFetchErr != nil {
+			return nil, observability.PrepareAndLogError(userFetchErr, logger, span, "fetching assigned users")
+		}
+
+		for _, user := range users {
+			input.Usernames[user.ID] = user.Username
+		}
+	}
+
+	return BuildMealPlanCalendar(input).Encode(), nil
+}
+
+// ExportMealPlanCalendarForFeedToken renders the calendar for whichever user and account the feed token belongs to.
+func (m *calendarManager) ExportMealPlanCalendarForFeedToken(ctx context.Context, token string) ([]byte, error) {
+	ctx, span := m.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if token == "" {
+		return nil, ErrFeedNotFound
+	}
+	logger := m.logger.WithSpan(span)
+
+	feed, err := m.db.GetMealPlanCalendarFeedByTokenHash(ctx, hashFeedToken(token))
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, ErrFeedNotFound
+		}
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching calendar feed")
+	}
+	logger = logger.WithValue(mealplanningkeys.MealPlanCalendarFeedIDKey, feed.ID)
+	tracing.AttachToSpan(span, mealplanningkeys.MealPlanCalendarFeedIDKey, feed.ID)
+
+	// feeds outlive account memberships, so make sure the user still belongs to the account.
+	isMember, err := m.identityRepo.UserIsMemberOfAccount(ctx, feed.BelongsToUser, feed.BelongsToAccount)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "checking calendar feed account membership")
+	}
+	if !isMember {
+		return nil, ErrFeedNotFound
+	}
+
+	if err = m.db.MarkMealPlanCalendarFeedAsAccessed(ctx, feed.ID); err != nil {
+		// not worth failing the fetch over.
+		observability.AcknowledgeError(err, logger, span, "marking calendar feed as accessed")
+	}
+
+	return m.ExportMealPlanCalendar(ctx, feed.BelongsToUser, feed.BelongsToAccount)
+}
+
+// FeedPath returns the path on the HTTP API that serves the calendar feed with the given token.
+func FeedPath(token string) string {
+	return FeedPathPrefix + token + FeedPathSuffix
+}
+
+// hashFeedToken is how feed tokens are stored and looked up.
+func hashFeedToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,225 @@
+package icalendar
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity"
+	identityfakes "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity/fakes"
+	identitymock "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity/mock"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/fakes"
+	mealplanningmock "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/mocks"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/testutils"
+
+	"github.com/primandproper/platform/database/filtering"
+	loggingnoop "github.com/primandproper/platform/observability/logging/noop"
+	tracingnoop "github.com/primandproper/platform/observability/tracing/noop"
+	randommock "github.com/primandproper/platform/random/mock"
+	"github.com/primandproper/platform/reflection"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func buildCalendarManagerForTest(t *testing.T, db mealplanning.Repository, identityRepo identity.Repository, now time.Time) *calendarManager {
+	t.Helper()
+
+	m := NewMealPlanCalendarManager(
+		loggingnoop.NewLogger(),
+		tracingnoop.NewTracerProvider(),
+		db,
+		identityRepo,
+		&randommock.GeneratorMock{
+			GenerateBase32EncodedStringFunc: func(_ context.Context, _ int) (string, error) {
+				return "faketoken123", nil
+			},
+		},
+	).(*calendarManager)
+	m.now = func() time.Time { return now }
+
+	return m
+}
+
+// expectCalendarExport sets up what ExportMealPlanCalendar needs to render the given meal plan.
+func expectCalendarExport(db *mealplanningmock.Repository, identityRepo *identitymock.RepositoryMock, accountID string, mealPlan *mealplanning.MealPlan, cook *identity.User) {
+	db.On(reflection.GetMethodName(db.GetMealPlansForAccount), testutils.ContextMatcher, accountID, mock.AnythingOfType("*filtering.QueryFilter")).Return(&filtering.QueryFilteredResult[mealplanning.MealPlan]{
+		Data: []*mealplanning.MealPlan{mealPlan},
+	}, nil)
+	db.On(reflection.GetMethodName(db.GetMealPlanTasksForMealPlan), testutils.ContextMatcher, mealPlan.ID, (*filtering.QueryFilter)(nil)).Return(&filtering.QueryFilteredResult[mealplanning.MealPlanTask]{}, nil)
+	identityRepo.On(reflection.GetMethodName(identityRepo.GetUsersWithIDs), testutils.ContextMatcher, mock.AnythingOfType("[]string")).Return([]*identity.User{cook}, nil)
+}
+
+func TestCalendarManager_CreateMealPlanCalendarFeed(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		userID, accountID := fakes.BuildFakeID(), fakes.BuildFakeID()
+		expected := fakes.BuildFakeMealPlanCalendarFeed()
+
+		db := &mealplanningmock.Repository{}
+		db.On(reflection.GetMethodName(db.ArchiveMealPlanCalendarFeedsForUser), testutils.ContextMatcher, userID, accountID).Return(nil)
+		db.On(reflection.GetMethodName(db.CreateMealPlanCalendarFeed), testutils.ContextMatcher, mock.MatchedBy(func(input *mealplanning.MealPlanCalendarFeedDatabaseCreationInput) bool {
+			return input.TokenHash == hashFeedToken("faketoken123") && input.BelongsToUser == userID && input.BelongsToAccount == accountID
+		})).Return(expected, nil)
+
+		m := buildCalendarManagerForTest(t, db, &identitymock.RepositoryMock{}, time.Now())
+
+		actual, err := m.CreateMealPlanCalendarFeed(ctx, userID, accountID)
+		require.NoError(t, err)
+		assert.Equal(t, "faketoken123", actual.Token)
+
+		mock.AssertExpectationsForObjects(t, db)
+	})
+
+	T.Run("with invalid IDs", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		m := buildCalendarManagerForTest(t, &mealplanningmock.Repository{}, &identitymock.RepositoryMock{}, time.Now())
+
+		actual, err := m.CreateMealPlanCalendarFeed(ctx, "", fakes.BuildFakeID())
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+
+	T.Run("with error archiving previous feeds", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		userID, accountID := fakes.BuildFakeID(), fakes.BuildFakeID()
+
+		db := &mealplanningmock.Repository{}
+		db.On(reflection.GetMethodName(db.ArchiveMealPlanCalendarFeedsForUser), testutils.ContextMatcher, userID, accountID).Return(errors.New("blah"))
+
+		m := buildCalendarManagerForTest(t, db, &identitymock.RepositoryMock{}, time.Now())
+
+		actual, err := m.CreateMealPlanCalendarFeed(ctx, userID, accountID)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+
+		mock.AssertExpectationsForObjects(t, db)
+	})
+}
+
+func TestCalendarManager_ExportMealPlanCalendar(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		now := time.Date(2026, time.March, 1, 12, 0, 0, 0, time.UTC)
+		userID, accountID := fakes.BuildFakeID(), fakes.BuildFakeID()
+		cook := identityfakes.BuildFakeUser()
+
+		mealPlan := buildFinalizedMealPlanForTest(now.Add(72*time.Hour), cook.ID, fakes.BuildFakeID())
+		mealPlan.TasksCreated = true
+
+		db := &mealplanningmock.Repository{}
+		identityRepo := &identitymock.RepositoryMock{}
+		expectCalendarExport(db, identityRepo, accountID, mealPlan, cook)
+
+		m := buildCalendarManagerForTest(t, db, identityRepo, now)
+
+		actual, err := m.ExportMealPlanCalendar(ctx, userID, accountID)
+		require.NoError(t, err)
+		assert.Contains(t, string(actual), "BEGIN:VEVENT")
+		assert.Contains(t, string(actual), "Cook: "+cook.Username)
+
+		mock.AssertExpectationsForObjects(t, db, identityRepo)
+	})
+
+	T.Run("with error fetching meal plans", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		userID, accountID := fakes.BuildFakeID(), fakes.BuildFakeID()
+
+		db := &mealplanningmock.Repository{}
+		db.On(reflection.GetMethodName(db.GetMealPlansForAccount), testutils.ContextMatcher, accountID, mock.AnythingOfType("*filtering.QueryFilter")).Return((*filtering.QueryFilteredResult[mealplanning.MealPlan])(nil), errors.New("blah"))
+
+		m := buildCalendarManagerForTest(t, db, &identitymock.RepositoryMock{}, time.Now())
+
+		actual, err := m.ExportMealPlanCalendar(ctx, userID, accountID)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+
+		mock.AssertExpectationsForObjects(t, db)
+	})
+}
+
+func TestCalendarManager_ExportMealPlanCalendarForFeedToken(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		now := time.Date(2026, time.March, 1, 12, 0, 0, 0, time.UTC)
+		feed := fakes.BuildFakeMealPlanCalendarFeed()
+		cook := identityfakes.BuildFakeUser()
+
+		mealPlan := buildFinalizedMealPlanForTest(now.Add(72*time.Hour), cook.ID, fakes.BuildFakeID())
+		mealPlan.TasksCreated = true
+
+		db := &mealplanningmock.Repository{}
+		identityRepo := &identitymock.RepositoryMock{}
+		db.On(reflection.GetMethodName(db.GetMealPlanCalendarFeedByTokenHash), testutils.ContextMatcher, hashFeedToken("token")).Return(feed, nil)
+		identityRepo.On(reflection.GetMethodName(identityRepo.UserIsMemberOfAccount), testutils.ContextMatcher, feed.BelongsToUser, feed.BelongsToAccount).Return(true, nil)
+		db.On(reflection.GetMethodName(db.MarkMealPlanCalendarFeedAsAccessed), testutils.ContextMatcher, feed.ID).Return(nil)
+		expectCalendarExport(db, identityRepo, feed.BelongsToAccount, mealPlan, cook)
+
+		m := buildCalendarManagerForTest(t, db, identityRepo, now)
+
+		actual, err := m.ExportMealPlanCalendarForFeedToken(ctx, "token")
+		require.NoError(t, err)
+		assert.Contains(t, string(actual), "BEGIN:VCALENDAR")
+
+		mock.AssertExpectationsForObjects(t, db, identityRepo)
+	})
+
+	T.Run("with unknown token", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+
+		db := &mealplanningmock.Repository{}
+		db.On(reflection.GetMethodName(db.GetMealPlanCalendarFeedByTokenHash), testutils.ContextMatcher, hashFeedToken("token")).Return((*mealplanning.MealPlanCalendarFeed)(nil), sql.ErrNoRows)
+
+		m := buildCalendarManagerForTest(t, db, &identitymock.RepositoryMock{}, time.Now())
+
+		actual, err := m.ExportMealPlanCalendarForFeedToken(ctx, "token")
+		assert.ErrorIs(t, err, ErrFeedNotFound)
+		assert.Nil(t, actual)
+
+		mock.AssertExpectationsForObjects(t, db)
+	})
+
+	T.Run("when the user has left the account", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		feed := fakes.BuildFakeMealPlanCalendarFeed()
+
+		db := &mealplanningmock.Repository{}
+		identityRepo := &identitymock.RepositoryMock{}
+		db.On(reflection.GetMethodName(db.GetMealPlanCalendarFeedByTokenHash), testutils.ContextMatcher, hashFeedToken("token")).Return(feed, nil)
+		identityRepo.On(reflection.GetMethodName(identityRepo.UserIsMemberOfAccount), testutils.ContextMatcher, feed.BelongsToUser, feed.BelongsToAccount).Return(false, nil)
+
+		m := buildCalendarManagerForTest(t, db, identityRepo, time.Now())
+
+		actual, err := m.ExportMealPlanCalendarForFeedToken(ctx, "token")
+		assert.ErrorIs(t, err, ErrFeedNotFound)
+		assert.Nil(t, actual)
+
+		mock.AssertExpectationsForObjects(t, db, identityRepo)
+	})
+}
@@ -0,0 +1,170 @@
+package icalendar
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+)
+
+const (
+	calendarName = "Meal Plans"
+)
+
+// MealPlanCalendarInput is everything needed to render a user's meal plan calendar.
+type MealPlanCalendarInput struct {
+	GeneratedAt time.Time
+	// Usernames maps user IDs to the names shown for cooks and dishwashers.
+	Usernames map[string]string
+	// TasksByMealPlan maps meal plan IDs to their tasks.
+	TasksByMealPlan map[string][]*mealplanning.MealPlanTask
+	// UserID is the user the calendar is for; tasks assigned to anyone else are left out.
+	UserID    string
+	MealPlans []*mealplanning.MealPlan
+}
+
+// BuildMealPlanCalendar renders a VEVENT for every finalized meal plan event with a chosen option,
+// and a VTODO for each of those events' prep tasks that's unassigned or assigned to the requesting user.
+func BuildMealPlanCalendar(input *MealPlanCalendarInput) *Calendar {
+	calendar := &Calendar{
+		Name:   calendarName,
+		Events: []*Event{},
+		Todos:  []*Todo{},
+	}
+
+	for _, mealPlan := range input.MealPlans {
+		if mealPlan.Status != string(mealplanning.MealPlanStatusFinalized) || mealPlan.ArchivedAt != nil {
+			continue
+		}
+
+		eventsByID := map[string]*mealplanning.MealPlanEvent{}
+		for _, mealPlanEvent := range mealPlan.Events {
+			if mealPlanEvent.ArchivedAt != nil {
+				continue
+			}
+
+			chosen := chosenOption(mealPlanEvent)
+			if chosen == nil {
+				continue
+			}
+
+			eventsByID[mealPlanEvent.ID] = mealPlanEvent
+			calendar.Events = append(calendar.Events, &Event{
+				UID:         buildUID("meal-plan-event", mealPlanEvent.ID),
+				Stamp:       input.GeneratedAt,
+				Start:       mealPlanEvent.StartsAt,
+				End:         mealPlanEvent.EndsAt,
+				Summary:     fmt.Sprintf("%s: %s", humanizeMealName(mealPlanEvent.MealName), chosen.Meal.Name),
+				Description: describeMealPlanEvent(mealPlanEvent, chosen, input.Usernames),
+			})
+		}
+
+		for _, task := range input.TasksByMealPlan[mealPlan.ID] {
+			if task.AssignedToUser != nil && *task.AssignedToUser != input.UserID {
+				continue
+			}
+
+			mealPlanEvent, ok := eventsByID[task.MealPlanOption.BelongsToMealPlanEvent]
+			if !ok {
+				continue
+			}
+
+			calendar.Todos = append(calendar.Todos, buildTaskTodo(task, mealPlanEvent, input.GeneratedAt))
+		}
+	}
+
+	return calendar
+}
+
+func buildTaskTodo(task *mealplanning.MealPlanTask, mealPlanEvent *mealplanning.MealPlanEvent, generatedAt time.Time) *Todo {
+	prepTask := task.RecipePrepTask
+
+	todo := &Todo{
+		UID:         buildUID("meal-plan-task", task.ID),
+		Stamp:       generatedAt,
+		Due:         mealPlanEvent.StartsAt,
+		Summary:     prepTask.Name,
+		Description: joinNonEmpty("\n\n", prepTask.Description, task.CreationExplanation, task.StatusExplanation),
+		Status:      todoStatusForTask(task.Status),
+		Completed:   task.CompletedAt,
+	}
+
+	// the earliest a task can be done is bounded by how long its results keep.
+	if prepTask.MaxTimeBufferBeforeRecipeInSeconds != nil {
+		start := mealPlanEvent.StartsAt.Add(-time.Duration(*prepTask.MaxTimeBufferBeforeRecipeInSeconds) * time.Second)
+		todo.Start = &start
+	}
+
+	// the latest a task can be done is bounded by how far ahead of the meal it has to happen, so that's when to remind people.
+	if prepTask.MinTimeBufferBeforeRecipeInSeconds > 0 {
+		todo.Alarms = append(todo.Alarms, &Alarm{
+			Description: prepTask.Name,
+			Before:      time.Duration(prepTask.MinTimeBufferBeforeRecipeInSeconds) * time.Second,
+		})
+	}
+
+	return todo
+}
+
+func chosenOption(mealPlanEvent *mealplanning.MealPlanEvent) *mealplanning.MealPlanOption {
+	for _, option := range mealPlanEvent.Options {
+		if option.Chosen && option.ArchivedAt == nil {
+			return option
+		}
+	}
+
+	return nil
+}
+
+func describeMealPlanEvent(mealPlanEvent *mealplanning.MealPlanEvent, chosen *mealplanning.MealPlanOption, usernames map[string]string) string {
+	lines := []string{}
+	if chosen.AssignedCook != nil {
+		lines = append(lines, fmt.Sprintf("Cook: %s", displayName(*chosen.AssignedCook, usernames)))
+	}
+	if chosen.AssignedDishwasher != nil {
+		lines = append(lines, fmt.Sprintf("Dishwasher: %s", displayName(*chosen.AssignedDishwasher, usernames)))
+	}
+
+	return joinNonEmpty("\n\n", strings.Join(lines, "\n"), mealPlanEvent.Notes, chosen.Notes)
+}
+
+func displayName(userID string, usernames map[string]string) string {
+	if name, ok := usernames[userID]; ok && name != "" {
+		return name
+	}
+
+	return userID
+}
+
+// humanizeMealName turns a meal name like "second_breakfast" into "Second breakfast".
+func humanizeMealName(mealName string) string {
+	s := strings.ReplaceAll(mealName, "_", " ")
+	if s == "" {
+		return s
+	}
+
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+func todoStatusForTask(status string) string {
+	switch status {
+	case mealplanning.MealPlanTaskStatusFinished:
+		return TodoStatusCompleted
+	case mealplanning.MealPlanTaskStatusCanceled, mealplanning.MealPlanTaskStatusIgnored:
+		return TodoStatusCancelled
+	default:
+		return TodoStatusNeedsAction
+	}
+}
+
+func joinNonEmpty(sep string, parts ...string) string {
+	nonEmpty := []string{}
+	for _, part := range parts {
+		if part != "" {
+			nonEmpty = append(nonEmpty, part)
+		}
+	}
+
+	return strings.Join(nonEmpty, sep)
+}
@@ -0,0 +1,141 @@
+package icalendar
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/fakes"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func buildFinalizedMealPlanForTest(startsAt time.Time, cookID, dishwasherID string) *mealplanning.MealPlan {
+	mealPlanEvent := fakes.BuildFakeMealPlanEvent()
+	mealPlanEvent.ArchivedAt = nil
+	mealPlanEvent.MealName = "second_breakfast"
+	mealPlanEvent.StartsAt = startsAt
+	mealPlanEvent.EndsAt = startsAt.Add(time.Hour)
+
+	for _, option := range mealPlanEvent.Options {
+		option.Chosen = false
+		option.ArchivedAt = nil
+	}
+	chosen := mealPlanEvent.Options[0]
+	chosen.Chosen = true
+	chosen.AssignedCook = &cookID
+	chosen.AssignedDishwasher = &dishwasherID
+
+	mealPlan := fakes.BuildFakeMealPlan()
+	mealPlan.ArchivedAt = nil
+	mealPlan.Status = string(mealplanning.MealPlanStatusFinalized)
+	mealPlan.Events = []*mealplanning.MealPlanEvent{mealPlanEvent}
+
+	return mealPlan
+}
+
+func buildTaskForTest(mealPlanEvent *mealplanning.MealPlanEvent, assignedTo *string) *mealplanning.MealPlanTask {
+	task := fakes.BuildFakeMealPlanTask()
+	task.AssignedToUser = assignedTo
+	task.Status = mealplanning.MealPlanTaskStatusUnfinished
+	task.CompletedAt = nil
+	task.MealPlanOption = *mealPlanEvent.Options[0]
+	task.RecipePrepTask = *fakes.BuildFakeRecipePrepTask()
+
+	return task
+}
+
+func TestBuildMealPlanCalendar(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		startsAt := time.Date(2026, time.March, 4, 18, 0, 0, 0, time.UTC)
+		userID := fakes.BuildFakeID()
+		cookID, dishwasherID := fakes.BuildFakeID(), fakes.BuildFakeID()
+
+		mealPlan := buildFinalizedMealPlanForTest(startsAt, cookID, dishwasherID)
+		mealPlanEvent := mealPlan.Events[0]
+		chosen := mealPlanEvent.Options[0]
+
+		mine := buildTaskForTest(mealPlanEvent, &userID)
+		mine.RecipePrepTask.MinTimeBufferBeforeRecipeInSeconds = 3600
+		maxBuffer := uint32(86400)
+		mine.RecipePrepTask.MaxTimeBufferBeforeRecipeInSeconds = &maxBuffer
+		unassigned := buildTaskForTest(mealPlanEvent, nil)
+		unassigned.RecipePrepTask.MinTimeBufferBeforeRecipeInSeconds = 0
+		someoneElse := fakes.BuildFakeID()
+		theirs := buildTaskForTest(mealPlanEvent, &someoneElse)
+
+		actual := BuildMealPlanCalendar(&MealPlanCalendarInput{
+			GeneratedAt: startsAt,
+			UserID:      userID,
+			MealPlans:   []*mealplanning.MealPlan{mealPlan},
+			TasksByMealPlan: map[string][]*mealplanning.MealPlanTask{
+				mealPlan.ID: {mine, unassigned, theirs},
+			},
+			Usernames: map[string]string{cookID: "alice"},
+		})
+
+		require.Len(t, actual.Events, 1)
+		event := actual.Events[0]
+		assert.Equal(t, "Second breakfast: "+chosen.Meal.Name, event.Summary)
+		assert.Equal(t, startsAt, event.Start)
+		assert.Equal(t, startsAt.Add(time.Hour), event.End)
+		assert.Contains(t, event.Description, "Cook: alice")
+		// users we couldn't resolve fall back to their IDs.
+		assert.Contains(t, event.Description, "Dishwasher: "+dishwasherID)
+
+		require.Len(t, actual.Todos, 2)
+		todo := actual.Todos[0]
+		assert.Equal(t, mine.RecipePrepTask.Name, todo.Summary)
+		assert.Equal(t, startsAt, todo.Due)
+		require.NotNil(t, todo.Start)
+		assert.Equal(t, startsAt.Add(-24*time.Hour), *todo.Start)
+		assert.Equal(t, TodoStatusNeedsAction, todo.Status)
+		require.Len(t, todo.Alarms, 1)
+		assert.Equal(t, time.Hour, todo.Alarms[0].Before)
+
+		assert.Empty(t, actual.Todos[1].Alarms)
+	})
+
+	T.Run("skips unfinalized meal plans and events without a chosen option", func(t *testing.T) {
+		t.Parallel()
+
+		startsAt := time.Now()
+		unfinalized := buildFinalizedMealPlanForTest(startsAt, fakes.BuildFakeID(), fakes.BuildFakeID())
+		unfinalized.Status = string(mealplanning.MealPlanStatusAwaitingVotes)
+
+		undecided := buildFinalizedMealPlanForTest(startsAt, fakes.BuildFakeID(), fakes.BuildFakeID())
+		for _, option := range undecided.Events[0].Options {
+			option.Chosen = false
+		}
+
+		actual := BuildMealPlanCalendar(&MealPlanCalendarInput{
+			GeneratedAt: startsAt,
+			MealPlans:   []*mealplanning.MealPlan{unfinalized, undecided},
+			TasksByMealPlan: map[string][]*mealplanning.MealPlanTask{
+				undecided.ID: {buildTaskForTest(undecided.Events[0], nil)},
+			},
+		})
+
+		assert.Empty(t, actual.Events)
+		assert.Empty(t, actual.Todos)
+	})
+}
+
+func TestTodoStatusForTask(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, TodoStatusCompleted, todoStatusForTask(mealplanning.MealPlanTaskStatusFinished))
+		assert.Equal(t, TodoStatusCancelled, todoStatusForTask(mealplanning.MealPlanTaskStatusCanceled))
+		assert.Equal(t, TodoStatusCancelled, todoStatusForTask(mealplanning.MealPlanTaskStatusIgnored))
+		assert.Equal(t, TodoStatusNeedsAction, todoStatusForTask(mealplanning.MealPlanTaskStatusPostponed))
+		assert.Equal(t, TodoStatusNeedsAction, todoStatusForTask(mealplanning.MealPlanTaskStatusUnfinished))
+	})
+}
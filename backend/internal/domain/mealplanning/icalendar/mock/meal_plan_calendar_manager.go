@@ -0,0 +1,47 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/icalendar"
+
+	"github.com/stretchr/testify/mock"
+)
+
+var _ icalendar.MealPlanCalendarManager = (*MealPlanCalendarManager)(nil)
+
+// MealPlanCalendarManager is a mock icalendar.MealPlanCalendarManager.
+type MealPlanCalendarManager struct {
+	mock.Mock
+}
+
+// CreateMealPlanCalendarFeed is a mock method.
+func (m *MealPlanCalendarManager) CreateMealPlanCalendarFeed(ctx context.Context, userID, accountID string) (*mealplanning.MealPlanCalendarFeed, error) {
+	returnValues := m.Called(ctx, userID, accountID)
+
+	if returnValues.Get(0) == nil {
+		return nil, returnValues.Error(1)
+	}
+	return returnValues.Get(0).(*mealplanning.MealPlanCalendarFeed), returnValues.Error(1)
+}
+
+// ExportMealPlanCalendar is a mock method.
+func (m *MealPlanCalendarManager) ExportMealPlanCalendar(ctx context.Context, userID, accountID string) ([]byte, error) {
+	returnValues := m.Called(ctx, userID, accountID)
+
+	if returnValues.Get(0) == nil {
+		return nil, returnValues.Error(1)
+	}
+	return returnValues.Get(0).([]byte), returnValues.Error(1)
+}
+
+// ExportMealPlanCalendarForFeedToken is a mock method.
+func (m *MealPlanCalendarManager) ExportMealPlanCalendarForFeedToken(ctx context.Context, token string) ([]byte, error) {
+	returnValues := m.Called(ctx, token)
+
+	if returnValues.Get(0) == nil {
+		return nil, returnValues.Error(1)
+	}
+	return returnValues.Get(0).([]byte), returnValues.Error(1)
+}
@@ -33,6 +33,16 @@
 	// MealPlanIDKey is the standard key for referring to a meal plan's ID.
 	MealPlanIDKey = MealPlanKey + idSuffix
 
+	// MealPlanActivityKey is the standard key for referring to a meal plan activity.
+	MealPlanActivityKey = "meal_plan_activity"
+	// MealPlanActivityIDKey is the standard key for referring to a meal plan activity's ID.
+	MealPlanActivityIDKey = MealPlanActivityKey + idSuffix
+
+	// MealPlanCalendarFeedKey is the standard key for referring to a meal plan calendar feed.
+	MealPlanCalendarFeedKey = "meal_plan_calendar_feed"
+	// MealPlanCalendarFeedIDKey is the standard key for referring to a meal plan calendar feed's ID.
+	MealPlanCalendarFeedIDKey = MealPlanCalendarFeedKey + idSuffix
+
 	// MealPlanEventKey is the standard key for referring to a meal plan event.
 	MealPlanEventKey = "meal_plan_event"
 	// MealPlanEventIDKey is the standard key for referring to a meal plan event's ID.
@@ -62,6 +72,18 @@
 	MealPlanTaskKey = "meal_plan_task"
 	// MealPlanTaskIDKey is the standard key for referring to a meal plan task's ID.
 	MealPlanTaskIDKey = MealPlanTaskKey + idSuffix
+	// MealPlanTaskAssignedToUserKey is the standard key for referring to the user a meal plan task is assigned to.
+	MealPlanTaskAssignedToUserKey = MealPlanTaskKey + ".assigned_to_user"
+
+	// MealPlanTemplateKey is the standard key for referring to a meal plan template.
+	MealPlanTemplateKey = "meal_plan_template"
+	// MealPlanTemplateIDKey is the standard key for referring to a meal plan template's ID.
+	MealPlanTemplateIDKey = MealPlanTemplateKey + idSuffix
+
+	// PantryItemKey is the standard key for referring to a pantry item.
+	PantryItemKey = "pantry_item"
+	// PantryItemIDKey is the standard key for referring to a pantry item's ID.
+	PantryItemIDKey = PantryItemKey + idSuffix
 
 	// RecipeKey is the standard key for referring to a recipe.
 	RecipeKey = "recipe"
@@ -97,6 +119,12 @@
 	RecipeRatingKey = "recipe_rating"
 	// RecipeRatingIDKey is the standard key for referring to a recipe rating's ID.
 	RecipeRatingIDKey = RecipeRatingKey + idSuffix
+	// RecipeRevisionKey is the standard key for referring to a recipe revision.
+	RecipeRevisionKey = "recipe_revision"
+	// RecipeRevisionIDKey is the standard key for referring to a recipe revision's ID.
+	RecipeRevisionIDKey = RecipeRevisionKey + idSuffix
+	// RecipeRevisionNumberKey is the standard key for referring to a recipe revision's number.
+	RecipeRevisionNumberKey = RecipeRevisionKey + ".number"
 
 	// RecipeStepKey is the standard key for referring to a recipe step.
 	RecipeStepKey = "recipe_step"
@@ -178,6 +206,11 @@
 	// ValidMeasurementUnitIDKey is the standard key for referring to a valid measurement unit's ID.
 	ValidMeasurementUnitIDKey = ValidMeasurementUnitKey + idSuffix
 
+	// ValidIngredientNutritionFactsKey is the standard key for referring to valid ingredient nutrition facts.
+	ValidIngredientNutritionFactsKey = "valid_ingredient_nutrition_facts"
+	// ValidIngredientNutritionFactsIDKey is the standard key for referring to valid ingredient nutrition facts' ID.
+	ValidIngredientNutritionFactsIDKey = ValidIngredientNutritionFactsKey + idSuffix
+
 	// ValidMeasurementUnitConversionKey is the standard key for referring to a valid measurement unit conversion.
 	ValidMeasurementUnitConversionKey = "valid_measurement_unit_conversion"
 	// ValidMeasurementUnitConversionIDKey is the standard key for referring to a valid measurement unit conversion's ID.
@@ -4,7 +4,9 @@
 	"context"
 
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/grocerylistpreparation"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/recipeanalysis"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/payments"
 	mealplangrocerylistinitializer "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/services/mealplanning/workers/meal_plan_grocery_list_initializer"
 	mealplantaskcreator "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/services/mealplanning/workers/meal_plan_task_creator"
 
@@ -37,6 +39,8 @@ func RegisterManagers(i do.Injector) {
 			do.MustInvoke[*msgconfig.QueuesConfig](i),
 			do.MustInvoke[messagequeue.PublisherProvider](i),
 			do.MustInvoke[recipeanalysis.RecipeAnalyzer](i),
+			do.MustInvoke[grocerylistpreparation.GroceryListCreator](i),
+			do.MustInvoke[payments.EntitlementsChecker](i),
 			do.MustInvoke[*textsearchcfg.Config](i),
 			do.MustInvoke[metrics.Provider](i),
 			do.MustInvoke[mealPlanGroceryListInitializerWorker](i),
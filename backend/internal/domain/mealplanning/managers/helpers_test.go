@@ -6,9 +6,13 @@
 	"testing"
 
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/audit"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/grocerylistpreparation"
 	mealplanningmock "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/mocks"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/recipeanalysis"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/payments"
+	paymentsmock "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/payments/mock"
 	mealplanningworkers "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/services/mealplanning/workers"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/testutils"
 
 	"github.com/primandproper/platform/messagequeue"
 	msgconfig "github.com/primandproper/platform/messagequeue/config"
@@ -16,6 +20,7 @@
 	loggingnoop "github.com/primandproper/platform/observability/logging/noop"
 	metricsnoop "github.com/primandproper/platform/observability/metrics/noop"
 	tracingnoop "github.com/primandproper/platform/observability/tracing/noop"
+	"github.com/primandproper/platform/reflection"
 	textsearchcfg "github.com/primandproper/platform/search/text/config"
 
 	"github.com/stretchr/testify/mock"
@@ -60,6 +65,8 @@ func buildMealPlanManagerForTest(t *testing.T) *mealPlanningManager {
 		queueCfg,
 		mpp,
 		&recipeanalysis.MockRecipeAnalyzer{},
+		&grocerylistpreparation.MockGroceryListCreator{},
+		&paymentsmock.EntitlementsChecker{},
 		&textsearchcfg.Config{},
 		metricsnoop.NewMetricsProvider(),
 		nil,
@@ -91,6 +98,8 @@ func buildMealPlanManagerForTestWithWorkers(t *testing.T, groceryWorker, taskWor
 		queueCfg,
 		mpp,
 		&recipeanalysis.MockRecipeAnalyzer{},
+		&grocerylistpreparation.MockGroceryListCreator{},
+		&paymentsmock.EntitlementsChecker{},
 		&textsearchcfg.Config{},
 		metricsnoop.NewMetricsProvider(),
 		groceryWorker,
@@ -141,6 +150,8 @@ func buildRecipeManagerForTest(t *testing.T) *mealPlanningManager {
 		queueCfg,
 		mpp,
 		&recipeanalysis.MockRecipeAnalyzer{},
+		&grocerylistpreparation.MockGroceryListCreator{},
+		&paymentsmock.EntitlementsChecker{},
 		&textsearchcfg.Config{},
 		metricsnoop.NewMetricsProvider(),
 		nil,
@@ -151,6 +162,15 @@ func buildRecipeManagerForTest(t *testing.T) *mealPlanningManager {
 	return m.(*mealPlanningManager)
 }
 
+// setupUnlimitedEntitlementsForTest stubs the manager's entitlements checker to grant the account unlimited usage.
+func setupUnlimitedEntitlementsForTest(manager *mealPlanningManager, accountID string) *paymentsmock.EntitlementsChecker {
+	checker := &paymentsmock.EntitlementsChecker{}
+	checker.On(reflection.GetMethodName(checker.GetEntitlementsForAccount), testutils.ContextMatcher, accountID).Return(payments.UnrestrictedAccountEntitlements(accountID), nil)
+	manager.entitlementsChecker = checker
+
+	return checker
+}
+
 func setupExpectationsForRecipeManager(
 	manager *mealPlanningManager,
 	dbSetupFunc func(db *mealplanningmock.Repository),
@@ -208,6 +228,8 @@ func buildValidEnumerationsManagerForTest(t *testing.T) *mealPlanningManager {
 		queueCfg,
 		mpp,
 		&recipeanalysis.MockRecipeAnalyzer{},
+		&grocerylistpreparation.MockGroceryListCreator{},
+		&paymentsmock.EntitlementsChecker{},
 		&textsearchcfg.Config{},
 		metricsnoop.NewMetricsProvider(),
 		nil,
@@ -5,7 +5,10 @@
 	"fmt"
 
 	types "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/grocerylistpreparation"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/recipeanalysis"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/recipeimport"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/payments"
 	eatingindexing "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/services/mealplanning/indexing"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/services/mealplanning/workers"
 
@@ -41,6 +44,14 @@
 		UpdateMealPlan(ctx context.Context, mealPlanID, ownerID string, input *types.MealPlanUpdateRequestInput) error
 		ArchiveMealPlan(ctx context.Context, mealPlanID, ownerID string) error
 		FinalizeMealPlan(ctx context.Context, mealPlanID, ownerID string) (bool, error)
+		ListMealPlanActivitiesAfterCursor(ctx context.Context, mealPlanID string, afterSequence uint64, limit uint8) ([]*types.MealPlanActivity, error)
+
+		// Meal plan templates
+		ListMealPlanTemplates(ctx context.Context, ownerID string, filter *filtering.QueryFilter) (*filtering.QueryFilteredResult[types.MealPlanTemplate], error)
+		CreateMealPlanTemplate(ctx context.Context, ownerID, creatorID string, input *types.MealPlanTemplateCreationRequestInput) (*types.MealPlanTemplate, error)
+		ReadMealPlanTemplate(ctx context.Context, mealPlanTemplateID, ownerID string) (*types.MealPlanTemplate, error)
+		UpdateMealPlanTemplate(ctx context.Context, mealPlanTemplateID, ownerID string, input *types.MealPlanTemplateUpdateRequestInput) error
+		ArchiveMealPlanTemplate(ctx context.Context, mealPlanTemplateID, ownerID string) error
 
 		// Meal plan events
 		ListMealPlanEvents(ctx context.Context, mealPlanID string, filter *filtering.QueryFilter) (*filtering.QueryFilteredResult[types.MealPlanEvent], error)
@@ -49,6 +60,7 @@
 		UpdateMealPlanEvent(ctx context.Context, mealPlanID, mealPlanEventID string, input *types.MealPlanEventUpdateRequestInput) error
 		SwapMealPlanEvents(ctx context.Context, mealPlanID, mealPlanEventIDA, mealPlanEventIDB string) error
 		ArchiveMealPlanEvent(ctx context.Context, mealPlanID, mealPlanEventID string) error
+		ReadMealPlanEventTallyReport(ctx context.Context, mealPlanID, mealPlanEventID string) (*types.MealPlanEventTallyReport, error)
 
 		// Meal plan options
 		ListMealPlanOptions(ctx context.Context, mealPlanID, mealPlanEventID string, filter *filtering.QueryFilter) (*filtering.QueryFilteredResult[types.MealPlanOption], error)
@@ -57,6 +69,7 @@
 		ReadMealPlanOption(ctx context.Context, mealPlanID, mealPlanEventID, mealPlanOptionID string) (*types.MealPlanOption, error)
 		UpdateMealPlanOption(ctx context.Context, mealPlanID, mealPlanEventID, mealPlanOptionID string, input *types.MealPlanOptionUpdateRequestInput) error
 		ArchiveMealPlanOption(ctx context.Context, mealPlanID, mealPlanEventID, mealPlanOptionID string) error
+		CompleteMealPlanOption(ctx context.Context, mealPlanID, mealPlanEventID, mealPlanOptionID, ownerID string) ([]*types.PantryItem, error)
 
 		// Meal plan option votes
 		ListMealPlanOptionVotes(ctx context.Context, mealPlanID, mealPlanEventID, mealPlanOptionID string, filter *filtering.QueryFilter) (*filtering.QueryFilteredResult[types.MealPlanOptionVote], error)
@@ -100,6 +113,13 @@
 		UpdateAccountInstrumentOwnership(ctx context.Context, instrumentOwnershipID, ownerID string, input *types.AccountInstrumentOwnershipUpdateRequestInput) error
 		ArchiveAccountInstrumentOwnership(ctx context.Context, ownerID, instrumentOwnershipID string) error
 
+		// Pantry items
+		ListPantryItems(ctx context.Context, ownerID string, filter *filtering.QueryFilter) (*filtering.QueryFilteredResult[types.PantryItem], error)
+		CreatePantryItem(ctx context.Context, ownerID string, input *types.PantryItemCreationRequestInput) (*types.PantryItem, error)
+		ReadPantryItem(ctx context.Context, ownerID, pantryItemID string) (*types.PantryItem, error)
+		UpdatePantryItem(ctx context.Context, pantryItemID, ownerID string, input *types.PantryItemUpdateRequestInput) error
+		ArchivePantryItem(ctx context.Context, ownerID, pantryItemID string) error
+
 		// Meal lists
 		ListMealLists(ctx context.Context, filter *filtering.QueryFilter) (*filtering.QueryFilteredResult[types.MealList], error)
 		CreateMealList(ctx context.Context, userID string, input *types.MealListCreationRequestInput) (*types.MealList, error)
@@ -123,8 +143,17 @@
 		AddRecipeImage(ctx context.Context, recipeID, uploadedMediaID, uploadedByUser string) error
 		RecipeEstimatedPrepSteps(ctx context.Context, recipeID string) ([]*types.MealPlanTaskDatabaseCreationEstimate, error)
 		MealMermaid(ctx context.Context, meal *types.Meal) (string, error)
+		MealPlanOptionCookTimeline(ctx context.Context, mealPlanID, mealPlanEventID, mealPlanOptionID string, input *types.CookTimelineRequestInput) (*types.CookTimeline, error)
+		RecipeNutrition(ctx context.Context, recipeID string, scale float32) (*types.NutritionRollup, error)
+		MealNutrition(ctx context.Context, mealID string, scale float32) (*types.NutritionRollup, error)
+		MealPlanNutrition(ctx context.Context, mealPlanID, ownerID string) (*types.MealPlanNutritionRollup, error)
 		RecipeMermaid(ctx context.Context, recipeID string) (string, error)
 		CloneRecipe(ctx context.Context, recipeID, newOwnerID string) (*types.Recipe, error)
+		ImportRecipe(ctx context.Context, content []byte, format string) (*recipeimport.Result, error)
+		ListRecipeRevisions(ctx context.Context, recipeID string, filter *filtering.QueryFilter) (*filtering.QueryFilteredResult[types.RecipeRevision], error)
+		ReadRecipeRevision(ctx context.Context, recipeID string, revisionNumber uint32) (*types.RecipeRevision, error)
+		DiffRecipeRevisions(ctx context.Context, recipeID string, fromRevision, toRevision uint32) (*types.RecipeRevisionDiff, error)
+		RestoreRecipeRevision(ctx context.Context, recipeID string, revisionNumber uint32) (*types.Recipe, error)
 		RecipeImageUpload(ctx context.Context) error
 
 		// Recipe lists
@@ -212,6 +241,12 @@
 		SearchValidIngredientMeasurementUnitsByIngredient(ctx context.Context, validIngredientID string, filter *filtering.QueryFilter) (*filtering.QueryFilteredResult[types.ValidIngredientMeasurementUnit], error)
 		SearchValidIngredientMeasurementUnitsByMeasurementUnit(ctx context.Context, validMeasurementUnitID string, filter *filtering.QueryFilter) (*filtering.QueryFilteredResult[types.ValidIngredientMeasurementUnit], error)
 
+		// Valid ingredient nutrition facts
+		CreateValidIngredientNutritionFacts(ctx context.Context, input *types.ValidIngredientNutritionFactsCreationRequestInput) (*types.ValidIngredientNutritionFacts, error)
+		ReadValidIngredientNutritionFactsForIngredient(ctx context.Context, validIngredientID string) (*types.ValidIngredientNutritionFacts, error)
+		UpdateValidIngredientNutritionFacts(ctx context.Context, validIngredientNutritionFactsID string, input *types.ValidIngredientNutritionFactsUpdateRequestInput) (*types.ValidIngredientNutritionFacts, error)
+		ArchiveValidIngredientNutritionFacts(ctx context.Context, validIngredientNutritionFactsID string) error
+
 		// Valid ingredient preparations
 		ListValidIngredientPreparations(ctx context.Context, filter *filtering.QueryFilter) (*filtering.QueryFilteredResult[types.ValidIngredientPreparation], error)
 		CreateValidIngredientPreparation(ctx context.Context, input *types.ValidIngredientPreparationCreationRequestInput) (*types.ValidIngredientPreparation, error)
@@ -338,6 +373,8 @@
 		dataChangesPublisher             messagequeue.Publisher
 		db                               types.Repository
 		recipeAnalyzer                   recipeanalysis.RecipeAnalyzer
+		groceryListCreator               grocerylistpreparation.GroceryListCreator
+		entitlementsChecker              payments.EntitlementsChecker
 		groceryListInitializer           mealPlanGroceryListInitializerWorker
 		taskCreator                      mealPlanTaskCreatorWorker
 		mealsSearchIndex                 textsearch.IndexSearcher[eatingindexing.MealSearchSubset]
@@ -363,6 +400,8 @@ func NewMealPlanningManager(
 	cfg *msgconfig.QueuesConfig,
 	publisherProvider messagequeue.PublisherProvider,
 	recipeAnalyzer recipeanalysis.RecipeAnalyzer,
+	groceryListCreator grocerylistpreparation.GroceryListCreator,
+	entitlementsChecker payments.EntitlementsChecker,
 	searchConfig *textsearchcfg.Config,
 	metricsProvider metrics.Provider,
 	groceryListInitializer mealPlanGroceryListInitializerWorker,
@@ -419,6 +458,8 @@ func NewMealPlanningManager(
 		logger:                           logging.NewNamedLogger(logger, mealPlannerName),
 		dataChangesPublisher:             dataChangesPublisher,
 		recipeAnalyzer:                   recipeAnalyzer,
+		groceryListCreator:               groceryListCreator,
+		entitlementsChecker:              entitlementsChecker,
 		groceryListInitializer:           groceryListInitializer,
 		taskCreator:                      taskCreator,
 		mealsSearchIndex:                 mealsSearchIndex,
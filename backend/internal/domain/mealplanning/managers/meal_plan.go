@@ -2,12 +2,14 @@
 
 import (
 	"context"
+	"time"
 
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/audit"
 	identitykeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity/keys"
 	types "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/converters"
 	mealplanningkeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/keys"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/payments"
 
 	"github.com/primandproper/platform/database/filtering"
 	platformerrors "github.com/primandproper/platform/errors"
@@ -15,6 +17,11 @@
 	"github.com/primandproper/platform/observability/tracing"
 )
 
+const (
+	// mealPlanEntitlementWindow is the rolling window a plan's weekly meal plan limit applies to.
+	mealPlanEntitlementWindow = 7 * 24 * time.Hour
+)
+
 func (m *mealPlanningManager) ListMealPlans(ctx context.Context, ownerID string, filter *filtering.QueryFilter) (*filtering.QueryFilteredResult[types.MealPlan], error) {
 	ctx, span := m.tracer.StartSpan(ctx)
 	defer span.End()
@@ -34,6 +41,34 @@ func (m *mealPlanningManager) ListMealPlans(ctx context.Context, ownerID string,
 	return mealPlans, nil
 }
 
+// checkMealPlanEntitlements returns payments.ErrEntitlementLimitReached if the account has used up its weekly meal plan allowance.
+func (m *mealPlanningManager) checkMealPlanEntitlements(ctx context.Context, ownerID string) error {
+	ctx, span := m.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := m.logger.WithSpan(span).WithValue(identitykeys.AccountIDKey, ownerID)
+
+	entitlements, err := m.entitlementsChecker.GetEntitlementsForAccount(ctx, ownerID)
+	if err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "fetching entitlements for account")
+	}
+
+	if entitlements.MaxMealPlansPerWeek == payments.UnlimitedQuantity {
+		return nil
+	}
+
+	createdThisWeek, err := m.db.GetMealPlanCountForAccountSince(ctx, ownerID, time.Now().Add(-mealPlanEntitlementWindow))
+	if err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "counting meal plans created this week")
+	}
+
+	if !entitlements.AllowsMealPlans(createdThisWeek) {
+		return payments.ErrEntitlementLimitReached
+	}
+
+	return nil
+}
+
 func (m *mealPlanningManager) CreateMealPlan(ctx context.Context, ownerID, creatorID string, input *types.MealPlanCreationRequestInput) (*types.MealPlan, error) {
 	ctx, span := m.tracer.StartSpan(ctx)
 	defer span.End()
@@ -50,6 +85,10 @@ func (m *mealPlanningManager) CreateMealPlan(ctx context.Context, ownerID, creat
 		return nil, platformerrors.ErrEmptyInputParameter
 	}
 
+	if err := m.checkMealPlanEntitlements(ctx, ownerID); err != nil {
+		return nil, err
+	}
+
 	convertedInput := converters.ConvertMealPlanCreationRequestInputToMealPlanDatabaseCreationInput(input)
 	convertedInput.CreatedByUser = creatorID
 	convertedInput.BelongsToAccount = ownerID
@@ -57,11 +96,35 @@ func (m *mealPlanningManager) CreateMealPlan(ctx context.Context, ownerID, creat
 	logger := m.logger.WithSpan(span).WithValue(mealplanningkeys.MealPlanIDKey, convertedInput.ID)
 	tracing.AttachToSpan(span, mealplanningkeys.MealPlanIDKey, convertedInput.ID)
 
+	profile, err := m.db.GetAccountAllergenProfile(ctx, ownerID)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching account allergen profile")
+	}
+
+	allergenWarnings := map[string][]*types.MealPlanOptionAllergenWarning{}
+	for _, event := range convertedInput.Events {
+		for _, option := range event.Options {
+			if _, ok := allergenWarnings[option.MealID]; ok {
+				continue
+			}
+
+			if allergenWarnings[option.MealID], err = m.checkMealPlanOptionAllergens(ctx, profile, option.MealID); err != nil {
+				return nil, err
+			}
+		}
+	}
+
 	created, err := m.db.CreateMealPlan(ctx, convertedInput)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "creating meal plan")
 	}
 
+	for _, event := range created.Events {
+		for _, option := range event.Options {
+			option.AllergenWarnings = allergenWarnings[option.Meal.ID]
+		}
+	}
+
 	m.dataChangesPublisher.PublishAsync(ctx, audit.BuildDataChangeMessageFromContext(ctx, logger, types.MealPlanCreatedServiceEventType, map[string]any{
 		mealplanningkeys.MealPlanIDKey: created.ID,
 	}))
@@ -89,6 +152,12 @@ func (m *mealPlanningManager) ReadMealPlan(ctx context.Context, mealPlanID, owne
 		return nil, observability.PrepareAndLogError(err, logger, span, "fetching meal plan")
 	}
 
+	profile, err := m.db.GetAccountAllergenProfile(ctx, mealPlan.BelongsToAccount)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching account allergen profile")
+	}
+	attachAllergenWarningsToMealPlan(profile, mealPlan)
+
 	return mealPlan, nil
 }
 
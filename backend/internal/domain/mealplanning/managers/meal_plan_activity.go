@@ -0,0 +1,29 @@
+package managers
+
+import (
+	"context"
+
+	types "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+	mealplanningkeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/keys"
+
+	"github.com/primandproper/platform/observability"
+	"github.com/primandproper/platform/observability/tracing"
+)
+
+func (m *mealPlanningManager) ListMealPlanActivitiesAfterCursor(ctx context.Context, mealPlanID string, afterSequence uint64, limit uint8) ([]*types.MealPlanActivity, error) {
+	ctx, span := m.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := m.logger.WithSpan(span).WithValues(map[string]any{
+		mealplanningkeys.MealPlanIDKey: mealPlanID,
+		"after_sequence":               afterSequence,
+	})
+	tracing.AttachToSpan(span, mealplanningkeys.MealPlanIDKey, mealPlanID)
+
+	activities, err := m.db.GetMealPlanActivitiesAfterCursor(ctx, mealPlanID, afterSequence, limit)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching meal plan activities")
+	}
+
+	return activities, nil
+}
@@ -0,0 +1,66 @@
+package managers
+
+import (
+	"errors"
+	"testing"
+
+	types "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/fakes"
+	mealplanningmock "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/mocks"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/testutils"
+
+	"github.com/primandproper/platform/reflection"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestMealPlanningManager_ListMealPlanActivitiesAfterCursor(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		mpm := buildMealPlanManagerForTest(t)
+
+		exampleActivity := fakes.BuildFakeMealPlanActivity()
+		exampleSequence := uint64(123)
+		expected := []*types.MealPlanActivity{exampleActivity}
+
+		expectations := setupExpectationsForMealPlanningManager(
+			mpm,
+			func(db *mealplanningmock.Repository) {
+				db.On(reflection.GetMethodName(mpm.db.GetMealPlanActivitiesAfterCursor), testutils.ContextMatcher, exampleActivity.BelongsToMealPlan, exampleSequence, uint8(50)).Return(expected, nil)
+			},
+		)
+
+		actual, err := mpm.ListMealPlanActivitiesAfterCursor(ctx, exampleActivity.BelongsToMealPlan, exampleSequence, 50)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, actual)
+
+		mock.AssertExpectationsForObjects(t, expectations...)
+	})
+
+	T.Run("with error fetching activities", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		mpm := buildMealPlanManagerForTest(t)
+
+		exampleMealPlanID := fakes.BuildFakeID()
+
+		expectations := setupExpectationsForMealPlanningManager(
+			mpm,
+			func(db *mealplanningmock.Repository) {
+				db.On(reflection.GetMethodName(mpm.db.GetMealPlanActivitiesAfterCursor), testutils.ContextMatcher, exampleMealPlanID, uint64(0), uint8(50)).Return([]*types.MealPlanActivity(nil), errors.New("blah"))
+			},
+		)
+
+		actual, err := mpm.ListMealPlanActivitiesAfterCursor(ctx, exampleMealPlanID, 0, 50)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+
+		mock.AssertExpectationsForObjects(t, expectations...)
+	})
+}
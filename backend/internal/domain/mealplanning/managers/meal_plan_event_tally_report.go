@@ -0,0 +1,30 @@
+package managers
+
+import (
+	"context"
+
+	types "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+	mealplanningkeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/keys"
+
+	"github.com/primandproper/platform/observability"
+	"github.com/primandproper/platform/observability/tracing"
+)
+
+func (m *mealPlanningManager) ReadMealPlanEventTallyReport(ctx context.Context, mealPlanID, mealPlanEventID string) (*types.MealPlanEventTallyReport, error) {
+	ctx, span := m.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := m.logger.WithSpan(span).WithValues(map[string]any{
+		mealplanningkeys.MealPlanIDKey:      mealPlanID,
+		mealplanningkeys.MealPlanEventIDKey: mealPlanEventID,
+	})
+	tracing.AttachToSpan(span, mealplanningkeys.MealPlanIDKey, mealPlanID)
+	tracing.AttachToSpan(span, mealplanningkeys.MealPlanEventIDKey, mealPlanEventID)
+
+	report, err := m.db.GetMealPlanEventTallyReport(ctx, mealPlanID, mealPlanEventID)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching meal plan event tally report")
+	}
+
+	return report, nil
+}
@@ -0,0 +1,66 @@
+package managers
+
+import (
+	"errors"
+	"testing"
+
+	types "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/fakes"
+	mealplanningmock "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/mocks"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/testutils"
+
+	"github.com/primandproper/platform/reflection"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestMealPlanningManager_ReadMealPlanEventTallyReport(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		mpm := buildMealPlanManagerForTest(t)
+
+		exampleMealPlanID := fakes.BuildFakeID()
+		expected := fakes.BuildFakeMealPlanEventTallyReport()
+
+		expectations := setupExpectationsForMealPlanningManager(
+			mpm,
+			func(db *mealplanningmock.Repository) {
+				db.On(reflection.GetMethodName(mpm.db.GetMealPlanEventTallyReport), testutils.ContextMatcher, exampleMealPlanID, expected.BelongsToMealPlanEvent).Return(expected, nil)
+			},
+		)
+
+		actual, err := mpm.ReadMealPlanEventTallyReport(ctx, exampleMealPlanID, expected.BelongsToMealPlanEvent)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, actual)
+
+		mock.AssertExpectationsForObjects(t, expectations...)
+	})
+
+	T.Run("with error fetching report", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		mpm := buildMealPlanManagerForTest(t)
+
+		exampleMealPlanID := fakes.BuildFakeID()
+		exampleMealPlanEventID := fakes.BuildFakeID()
+
+		expectations := setupExpectationsForMealPlanningManager(
+			mpm,
+			func(db *mealplanningmock.Repository) {
+				db.On(reflection.GetMethodName(mpm.db.GetMealPlanEventTallyReport), testutils.ContextMatcher, exampleMealPlanID, exampleMealPlanEventID).Return((*types.MealPlanEventTallyReport)(nil), errors.New("blah"))
+			},
+		)
+
+		actual, err := mpm.ReadMealPlanEventTallyReport(ctx, exampleMealPlanID, exampleMealPlanEventID)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+
+		mock.AssertExpectationsForObjects(t, expectations...)
+	})
+}
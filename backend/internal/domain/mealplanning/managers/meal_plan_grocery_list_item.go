@@ -92,11 +92,26 @@ func (m *mealPlanningManager) UpdateMealPlanGroceryListItem(ctx context.Context,
 		return observability.PrepareAndLogError(err, logger, span, "fetching meal plan grocery list item to update")
 	}
 
+	previousStatus := existingMealPlanGroceryListItem.Status
 	existingMealPlanGroceryListItem.Update(input)
-	if err = m.db.UpdateMealPlanGroceryListItem(ctx, existingMealPlanGroceryListItem); err != nil {
+
+	var stocked *types.PantryItemDatabaseCreationInput
+	if previousStatus != types.MealPlanGroceryListItemStatusAcquired && existingMealPlanGroceryListItem.Status == types.MealPlanGroceryListItemStatusAcquired {
+		if stocked, err = m.buildPantryItemForAcquiredGroceryListItem(ctx, mealPlanID, existingMealPlanGroceryListItem); err != nil {
+			return observability.PrepareAndLogError(err, logger, span, "fetching account for meal plan")
+		}
+	}
+
+	if err = m.db.UpdateMealPlanGroceryListItem(ctx, existingMealPlanGroceryListItem, stocked); err != nil {
 		return observability.PrepareAndLogError(err, logger, span, "updating meal plan grocery list item")
 	}
 
+	if stocked != nil {
+		m.dataChangesPublisher.PublishAsync(ctx, audit.BuildDataChangeMessageFromContext(ctx, logger, types.PantryItemCreatedServiceEventType, map[string]any{
+			mealplanningkeys.PantryItemIDKey: stocked.ID,
+		}))
+	}
+
 	m.dataChangesPublisher.PublishAsync(ctx, audit.BuildDataChangeMessageFromContext(ctx, logger, types.MealPlanGroceryListItemUpdatedServiceEventType, map[string]any{
 		mealplanningkeys.MealPlanIDKey:                mealPlanID,
 		mealplanningkeys.MealPlanGroceryListItemIDKey: mealPlanGroceryListItemID,
@@ -116,7 +116,7 @@ func TestMealPlanningManager_UpdateMealPlanGroceryListItem(T *testing.T) {
 			mpm,
 			func(db *mealplanningmock.Repository) {
 				db.On(reflection.GetMethodName(mpm.db.GetMealPlanGroceryListItem), testutils.ContextMatcher, exampleMealPlanID, exampleMealPlanGroceryListItem.ID).Return(exampleMealPlanGroceryListItem, nil)
-				db.On(reflection.GetMethodName(mpm.db.UpdateMealPlanGroceryListItem), testutils.ContextMatcher, testutils.MatchType[*types.MealPlanGroceryListItem]()).Return(nil)
+				db.On(reflection.GetMethodName(mpm.db.UpdateMealPlanGroceryListItem), testutils.ContextMatcher, testutils.MatchType[*types.MealPlanGroceryListItem](), (*types.PantryItemDatabaseCreationInput)(nil)).Return(nil)
 			},
 			map[string][]string{
 				types.MealPlanGroceryListItemUpdatedServiceEventType: {
@@ -130,6 +130,66 @@ func(db *mealplanningmock.Repository) {
 
 		mock.AssertExpectationsForObjects(t, expectations...)
 	})
+
+	T.Run("adds newly acquired items to the pantry", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		mpm := buildMealPlanManagerForTest(t)
+
+		exampleMealPlanGroceryListItem := fakes.BuildFakeMealPlanGroceryListItem()
+		exampleMealPlanGroceryListItem.Status = types.MealPlanGroceryListItemStatusNeeds
+		exampleMealPlanID := fakes.BuildFakeID()
+		exampleAccountID := fakes.BuildFakeID()
+		exampleInput := fakes.BuildFakeMealPlanGroceryListItemUpdateRequestInput()
+		exampleInput.Status = new(types.MealPlanGroceryListItemStatusAcquired)
+		exampleInput.QuantityPurchased = new(float32(3))
+		exampleInput.PurchasedMeasurementUnitID = new(fakes.BuildFakeID())
+
+		expectations := setupExpectationsForMealPlanningManager(
+			mpm,
+			func(db *mealplanningmock.Repository) {
+				db.On(reflection.GetMethodName(mpm.db.GetMealPlanGroceryListItem), testutils.ContextMatcher, exampleMealPlanID, exampleMealPlanGroceryListItem.ID).Return(exampleMealPlanGroceryListItem, nil)
+				db.On(reflection.GetMethodName(mpm.db.GetAccountIDForMealPlan), testutils.ContextMatcher, exampleMealPlanID).Return(exampleAccountID, nil)
+				db.On(reflection.GetMethodName(mpm.db.UpdateMealPlanGroceryListItem), testutils.ContextMatcher, testutils.MatchType[*types.MealPlanGroceryListItem](), mock.MatchedBy(func(input *types.PantryItemDatabaseCreationInput) bool {
+					return input.BelongsToAccount == exampleAccountID &&
+						input.ValidIngredientID == exampleMealPlanGroceryListItem.Ingredient.ID &&
+						input.ValidMeasurementUnitID == *exampleInput.PurchasedMeasurementUnitID &&
+						input.Quantity == *exampleInput.QuantityPurchased &&
+						input.StorageLocation == types.PantryStorageLocationPantry
+				})).Return(nil)
+			},
+		)
+
+		assert.NoError(t, mpm.UpdateMealPlanGroceryListItem(ctx, exampleMealPlanID, exampleMealPlanGroceryListItem.ID, exampleInput))
+
+		mock.AssertExpectationsForObjects(t, expectations...)
+	})
+
+	T.Run("does not restock items that were already acquired", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		mpm := buildMealPlanManagerForTest(t)
+
+		exampleMealPlanGroceryListItem := fakes.BuildFakeMealPlanGroceryListItem()
+		exampleMealPlanGroceryListItem.Status = types.MealPlanGroceryListItemStatusAcquired
+		exampleMealPlanID := fakes.BuildFakeID()
+		exampleInput := fakes.BuildFakeMealPlanGroceryListItemUpdateRequestInput()
+		exampleInput.Status = new(types.MealPlanGroceryListItemStatusAcquired)
+
+		expectations := setupExpectationsForMealPlanningManager(
+			mpm,
+			func(db *mealplanningmock.Repository) {
+				db.On(reflection.GetMethodName(mpm.db.GetMealPlanGroceryListItem), testutils.ContextMatcher, exampleMealPlanID, exampleMealPlanGroceryListItem.ID).Return(exampleMealPlanGroceryListItem, nil)
+				db.On(reflection.GetMethodName(mpm.db.UpdateMealPlanGroceryListItem), testutils.ContextMatcher, testutils.MatchType[*types.MealPlanGroceryListItem](), (*types.PantryItemDatabaseCreationInput)(nil)).Return(nil)
+			},
+		)
+
+		assert.NoError(t, mpm.UpdateMealPlanGroceryListItem(ctx, exampleMealPlanID, exampleMealPlanGroceryListItem.ID, exampleInput))
+
+		mock.AssertExpectationsForObjects(t, expectations...)
+	})
 }
 
 func TestMealPlanningManager_ArchiveMealPlanGroceryListItem(T *testing.T) {
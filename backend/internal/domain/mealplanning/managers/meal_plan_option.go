@@ -2,8 +2,10 @@
 
 import (
 	"context"
+	"database/sql"
 
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/audit"
+	identitykeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity/keys"
 	types "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/converters"
 	mealplanningkeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/keys"
@@ -34,6 +36,16 @@ func (m *mealPlanningManager) ListMealPlanOptions(ctx context.Context, mealPlanI
 		return nil, observability.PrepareAndLogError(err, logger, span, "fetching meal plan options")
 	}
 
+	profile, err := m.db.GetAccountAllergenProfileForMealPlanEvent(ctx, mealPlanEventID)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching account allergen profile")
+	}
+
+	// option meals come back hydrated, so warnings are computed without fetching each meal again
+	for _, option := range results.Data {
+		option.AllergenWarnings = profile.WarningsForMeal(&option.Meal)
+	}
+
 	return results, nil
 }
 
@@ -46,6 +58,7 @@ func (m *mealPlanningManager) CreateMealPlanOption(ctx context.Context, input *t
 	}
 
 	convertedInput := converters.ConvertMealPlanOptionCreationRequestInputToMealPlanOptionDatabaseCreationInput(input)
+	allergenWarnings := []*types.MealPlanOptionAllergenWarning{}
 	if convertedInput.BelongsToMealPlanEvent != "" {
 		exists, err := m.db.MealExistsAsOptionInEvent(ctx, convertedInput.BelongsToMealPlanEvent, convertedInput.MealID)
 		if err != nil {
@@ -54,6 +67,15 @@ func (m *mealPlanningManager) CreateMealPlanOption(ctx context.Context, input *t
 		if exists {
 			return nil, types.ErrDuplicateMealPlanOption
 		}
+
+		profile, err := m.db.GetAccountAllergenProfileForMealPlanEvent(ctx, convertedInput.BelongsToMealPlanEvent)
+		if err != nil {
+			return nil, observability.PrepareAndLogError(err, m.logger.WithSpan(span), span, "fetching account allergen profile")
+		}
+
+		if allergenWarnings, err = m.checkMealPlanOptionAllergens(ctx, profile, convertedInput.MealID); err != nil {
+			return nil, err
+		}
 	}
 
 	logger := m.logger.WithSpan(span).WithValue(mealplanningkeys.MealPlanOptionIDKey, convertedInput.ID)
@@ -63,8 +85,11 @@ func (m *mealPlanningManager) CreateMealPlanOption(ctx context.Context, input *t
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "created meal plan option")
 	}
+	created.AllergenWarnings = allergenWarnings
 
 	m.dataChangesPublisher.PublishAsync(ctx, audit.BuildDataChangeMessageFromContext(ctx, logger, types.MealPlanOptionCreatedServiceEventType, map[string]any{
+		mealplanningkeys.MealPlanIDKey:       input.MealPlanID,
+		mealplanningkeys.MealPlanEventIDKey:  convertedInput.BelongsToMealPlanEvent,
 		mealplanningkeys.MealPlanOptionIDKey: convertedInput.ID,
 	}))
 
@@ -95,6 +120,16 @@ func (m *mealPlanningManager) CreateMealPlanOptionWithEventID(ctx context.Contex
 		return nil, types.ErrDuplicateMealPlanOption
 	}
 
+	profile, err := m.db.GetAccountAllergenProfileForMealPlanEvent(ctx, mealPlanEventID)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, m.logger.WithSpan(span), span, "fetching account allergen profile")
+	}
+
+	allergenWarnings, err := m.checkMealPlanOptionAllergens(ctx, profile, convertedInput.MealID)
+	if err != nil {
+		return nil, err
+	}
+
 	logger := m.logger.WithSpan(span).WithValue(mealplanningkeys.MealPlanOptionIDKey, convertedInput.ID)
 	tracing.AttachToSpan(span, mealplanningkeys.MealPlanOptionIDKey, convertedInput.ID)
 
@@ -102,8 +137,11 @@ func (m *mealPlanningManager) CreateMealPlanOptionWithEventID(ctx context.Contex
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "created meal plan option")
 	}
+	created.AllergenWarnings = allergenWarnings
 
 	m.dataChangesPublisher.PublishAsync(ctx, audit.BuildDataChangeMessageFromContext(ctx, logger, types.MealPlanOptionCreatedServiceEventType, map[string]any{
+		mealplanningkeys.MealPlanIDKey:       input.MealPlanID,
+		mealplanningkeys.MealPlanEventIDKey:  convertedInput.BelongsToMealPlanEvent,
 		mealplanningkeys.MealPlanOptionIDKey: convertedInput.ID,
 	}))
 
@@ -128,6 +166,13 @@ func (m *mealPlanningManager) ReadMealPlanOption(ctx context.Context, mealPlanID
 		return nil, observability.PrepareAndLogError(err, logger, span, "fetching meal plan option")
 	}
 
+	profile, err := m.db.GetAccountAllergenProfileForMealPlanEvent(ctx, mealPlanEventID)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching account allergen profile")
+	}
+
+	mealPlanOption.AllergenWarnings = profile.WarningsForMeal(&mealPlanOption.Meal)
+
 	return mealPlanOption, nil
 }
 
@@ -192,3 +237,89 @@ func (m *mealPlanningManager) ArchiveMealPlanOption(ctx context.Context, mealPla
 
 	return nil
 }
+
+// CompleteMealPlanOption marks a chosen meal plan option as cooked and draws down the pantry by what it used.
+// It returns the pantry items that were drawn down, which is empty when the option had already been completed.
+func (m *mealPlanningManager) CompleteMealPlanOption(ctx context.Context, mealPlanID, mealPlanEventID, mealPlanOptionID, ownerID string) ([]*types.PantryItem, error) {
+	ctx, span := m.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := m.logger.WithSpan(span).WithValues(map[string]any{
+		mealplanningkeys.MealPlanIDKey:       mealPlanID,
+		mealplanningkeys.MealPlanEventIDKey:  mealPlanEventID,
+		mealplanningkeys.MealPlanOptionIDKey: mealPlanOptionID,
+		identitykeys.AccountIDKey:            ownerID,
+	})
+	tracing.AttachToSpan(span, mealplanningkeys.MealPlanIDKey, mealPlanID)
+	tracing.AttachToSpan(span, mealplanningkeys.MealPlanEventIDKey, mealPlanEventID)
+	tracing.AttachToSpan(span, mealplanningkeys.MealPlanOptionIDKey, mealPlanOptionID)
+
+	mealPlan, err := m.db.GetMealPlan(ctx, mealPlanID, ownerID)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching meal plan")
+	}
+
+	var mealPlanOption *types.MealPlanOption
+	for _, event := range mealPlan.Events {
+		if event.ID != mealPlanEventID {
+			continue
+		}
+
+		for _, option := range event.Options {
+			if option.ID == mealPlanOptionID {
+				mealPlanOption = option
+			}
+		}
+	}
+
+	if mealPlanOption == nil {
+		return nil, observability.PrepareAndLogError(sql.ErrNoRows, logger, span, "finding meal plan option in meal plan")
+	}
+
+	if !mealPlanOption.Chosen {
+		return nil, types.ErrMealPlanOptionNotChosen
+	}
+
+	pantryItems, err := m.db.GetAvailablePantryItemsForAccount(ctx, mealPlan.BelongsToAccount)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching pantry items")
+	}
+
+	consumption, err := m.groceryListCreator.PantryConsumptionForMealPlanOption(ctx, mealPlan, mealPlanOption, pantryItems)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "determining pantry consumption for meal plan option")
+	}
+
+	drawDowns := []*types.PantryItemDrawDown{}
+	for _, used := range consumption {
+		drawDowns = append(drawDowns, &types.PantryItemDrawDown{
+			PantryItem: used.PantryItem,
+			Quantity:   used.Quantity,
+		})
+	}
+
+	// the option is completed and the pantry drawn down together, so a failure can't leave one without the other.
+	consumed, completed, err := m.db.CompleteMealPlanOption(ctx, mealPlanEventID, mealPlanOptionID, drawDowns)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "completing meal plan option")
+	}
+
+	// only draw down the pantry the first time an option is completed.
+	if !completed {
+		return []*types.PantryItem{}, nil
+	}
+
+	m.dataChangesPublisher.PublishAsync(ctx, audit.BuildDataChangeMessageFromContext(ctx, logger, types.MealPlanOptionCompletedServiceEventType, map[string]any{
+		mealplanningkeys.MealPlanIDKey:       mealPlanID,
+		mealplanningkeys.MealPlanEventIDKey:  mealPlanEventID,
+		mealplanningkeys.MealPlanOptionIDKey: mealPlanOptionID,
+	}))
+
+	for _, pantryItem := range consumed {
+		m.dataChangesPublisher.PublishAsync(ctx, audit.BuildDataChangeMessageFromContext(ctx, logger, types.PantryItemUpdatedServiceEventType, map[string]any{
+			mealplanningkeys.PantryItemIDKey: pantryItem.ID,
+		}))
+	}
+
+	return consumed, nil
+}
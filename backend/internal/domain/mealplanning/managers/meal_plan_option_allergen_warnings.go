@@ -0,0 +1,93 @@
+package managers
+
+import (
+	"context"
+
+	types "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+
+	"github.com/primandproper/platform/database/filtering"
+	platformerrors "github.com/primandproper/platform/errors"
+)
+
+// allergenWarningsForMeal fetches a meal and computes which of the profile's account members it affects.
+// Meals are only fetched when the account has members with allergies.
+func (m *mealPlanningManager) allergenWarningsForMeal(ctx context.Context, profile *types.AccountAllergenProfile, mealID string) ([]*types.MealPlanOptionAllergenWarning, error) {
+	if len(profile.Allergies) == 0 {
+		return []*types.MealPlanOptionAllergenWarning{}, nil
+	}
+
+	meal, err := m.db.GetMeal(ctx, mealID)
+	if err != nil {
+		return nil, err
+	}
+
+	return profile.WarningsForMeal(meal), nil
+}
+
+// checkMealPlanOptionAllergens computes the allergen warnings for a prospective meal plan option,
+// returning types.ErrMealPlanOptionConflictsWithAllergies when the account rejects conflicting options.
+func (m *mealPlanningManager) checkMealPlanOptionAllergens(ctx context.Context, profile *types.AccountAllergenProfile, mealID string) ([]*types.MealPlanOptionAllergenWarning, error) {
+	warnings, err := m.allergenWarningsForMeal(ctx, profile, mealID)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(warnings) > 0 && profile.RejectsConflicts() {
+		return nil, types.ErrMealPlanOptionConflictsWithAllergies
+	}
+
+	return warnings, nil
+}
+
+// checkMealPlanOptionVoteAllergens returns types.ErrMealPlanOptionConflictsWithAllergies when any non-abstaining vote is
+// for an option that conflicts with a member's allergies and the account rejects conflicting options. Options can predate
+// the account's allergies or policy, so this is checked again when votes are cast.
+func (m *mealPlanningManager) checkMealPlanOptionVoteAllergens(ctx context.Context, input *types.MealPlanOptionVoteCreationRequestInput) error {
+	profile, err := m.db.GetAccountAllergenProfileForMealPlanEvent(ctx, input.MealPlanEventID)
+	if err != nil {
+		return err
+	}
+
+	if len(profile.Allergies) == 0 || !profile.RejectsConflicts() {
+		return nil
+	}
+
+	filter := filtering.DefaultQueryFilter()
+	maxSize := uint8(filtering.MaxQueryFilterLimit)
+	filter.MaxResponseSize = &maxSize
+	options, err := m.db.GetMealPlanOptions(ctx, input.MealPlanID, input.MealPlanEventID, filter)
+	if err != nil {
+		return err
+	}
+
+	conflicting := map[string]bool{}
+	for _, option := range options.Data {
+		conflicting[option.ID] = len(profile.WarningsForMeal(&option.Meal)) > 0
+	}
+
+	for _, vote := range input.Votes {
+		if vote.Abstain {
+			continue
+		}
+
+		isConflicting, ok := conflicting[vote.BelongsToMealPlanOption]
+		if !ok {
+			return platformerrors.ErrInvalidIDProvided
+		}
+
+		if isConflicting {
+			return types.ErrMealPlanOptionConflictsWithAllergies
+		}
+	}
+
+	return nil
+}
+
+// attachAllergenWarningsToMealPlan sets the allergen warnings on every option of a meal plan whose meals are already hydrated.
+func attachAllergenWarningsToMealPlan(profile *types.AccountAllergenProfile, mealPlan *types.MealPlan) {
+	for _, event := range mealPlan.Events {
+		for _, option := range event.Options {
+			option.AllergenWarnings = profile.WarningsForMeal(&option.Meal)
+		}
+	}
+}
@@ -1,10 +1,12 @@
 package managers
 
 import (
+	"database/sql"
 	"testing"
 
 	types "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/fakes"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/grocerylistpreparation"
 	mealplanningkeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/keys"
 	mealplanningmock "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/mocks"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/testutils"
@@ -32,6 +34,7 @@ func TestMealPlanningManager_ListMealPlanOptions(T *testing.T) {
 			mpm,
 			func(db *mealplanningmock.Repository) {
 				db.On(reflection.GetMethodName(mpm.db.GetMealPlanOptions), testutils.ContextMatcher, exampleMealPlanID, exampleMealPlanEventID, testutils.QueryFilterMatcher).Return(expected, nil)
+				db.On(reflection.GetMethodName(mpm.db.GetAccountAllergenProfileForMealPlanEvent), testutils.ContextMatcher, exampleMealPlanEventID).Return(fakes.BuildFakeAccountAllergenProfile(), nil)
 			},
 		)
 
@@ -41,6 +44,43 @@ func(db *mealplanningmock.Repository) {
 
 		mock.AssertExpectationsForObjects(t, expectations...)
 	})
+
+	T.Run("computes allergen warnings from the options' meals", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		mpm := buildMealPlanManagerForTest(t)
+
+		exampleIngredient := &types.ValidIngredient{ID: fakes.BuildFakeID(), ContainsPeanut: true}
+		expected := fakes.BuildFakeMealPlanOptionsList()
+		for _, option := range expected.Data {
+			option.Meal.Components = []*types.MealComponent{{Recipe: types.Recipe{
+				ID:    fakes.BuildFakeID(),
+				Steps: []*types.RecipeStep{{ID: fakes.BuildFakeID(), Ingredients: []*types.RecipeStepIngredient{{Ingredient: exampleIngredient}}}},
+			}}}
+		}
+		exampleMealPlanID := fakes.BuildFakeID()
+		exampleMealPlanEventID := fakes.BuildFakeID()
+
+		exampleProfile := fakes.BuildFakeAccountAllergenProfile()
+		exampleProfile.Allergies = []*types.UserIngredientPreference{{CreatedByUser: fakes.BuildFakeID(), Ingredient: *exampleIngredient, Allergy: true}}
+
+		expectations := setupExpectationsForMealPlanningManager(
+			mpm,
+			func(db *mealplanningmock.Repository) {
+				db.On(reflection.GetMethodName(mpm.db.GetMealPlanOptions), testutils.ContextMatcher, exampleMealPlanID, exampleMealPlanEventID, testutils.QueryFilterMatcher).Return(expected, nil)
+				db.On(reflection.GetMethodName(mpm.db.GetAccountAllergenProfileForMealPlanEvent), testutils.ContextMatcher, exampleMealPlanEventID).Return(exampleProfile, nil)
+			},
+		)
+
+		actual, err := mpm.ListMealPlanOptions(ctx, exampleMealPlanID, exampleMealPlanEventID, nil)
+		assert.NoError(t, err)
+		for _, option := range actual.Data {
+			assert.Len(t, option.AllergenWarnings, 1)
+		}
+
+		mock.AssertExpectationsForObjects(t, expectations...)
+	})
 }
 
 func TestMealPlanningManager_CreateMealPlanOption(T *testing.T) {
@@ -61,7 +101,7 @@ func(db *mealplanningmock.Repository) {
 				db.On(reflection.GetMethodName(mpm.db.CreateMealPlanOption), testutils.ContextMatcher, testutils.MatchType[*types.MealPlanOptionDatabaseCreationInput]()).Return(expected, nil)
 			},
 			map[string][]string{
-				types.MealPlanOptionCreatedServiceEventType: {mealplanningkeys.MealPlanOptionIDKey},
+				types.MealPlanOptionCreatedServiceEventType: {mealplanningkeys.MealPlanIDKey, mealplanningkeys.MealPlanEventIDKey, mealplanningkeys.MealPlanOptionIDKey},
 			},
 		)
 
@@ -98,6 +138,83 @@ func(db *mealplanningmock.Repository) {
 
 		mock.AssertExpectationsForObjects(t, expectations...)
 	})
+
+	T.Run("attaches allergen warnings", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		mpm := buildMealPlanManagerForTest(t)
+
+		eventID := fakes.BuildFakeID()
+		fakeInput := fakes.BuildFakeMealPlanOptionCreationRequestInput()
+		expected := fakes.BuildFakeMealPlanOption()
+
+		exampleIngredient := &types.ValidIngredient{ID: fakes.BuildFakeID(), ContainsPeanut: true}
+		exampleMeal := fakes.BuildFakeMeal()
+		exampleMeal.Components = []*types.MealComponent{{Recipe: types.Recipe{
+			ID:    fakes.BuildFakeID(),
+			Steps: []*types.RecipeStep{{ID: fakes.BuildFakeID(), Ingredients: []*types.RecipeStepIngredient{{Ingredient: exampleIngredient}}}},
+		}}}
+
+		exampleProfile := fakes.BuildFakeAccountAllergenProfile()
+		exampleProfile.Allergies = []*types.UserIngredientPreference{{CreatedByUser: fakes.BuildFakeID(), Ingredient: *exampleIngredient, Allergy: true}}
+
+		expectations := setupExpectationsForMealPlanningManager(
+			mpm,
+			func(db *mealplanningmock.Repository) {
+				db.On(reflection.GetMethodName(mpm.db.MealExistsAsOptionInEvent), testutils.ContextMatcher, eventID, fakeInput.MealID).Return(false, nil)
+				db.On(reflection.GetMethodName(mpm.db.GetAccountAllergenProfileForMealPlanEvent), testutils.ContextMatcher, eventID).Return(exampleProfile, nil)
+				db.On(reflection.GetMethodName(mpm.db.GetMeal), testutils.ContextMatcher, fakeInput.MealID).Return(exampleMeal, nil)
+				db.On(reflection.GetMethodName(mpm.db.CreateMealPlanOption), testutils.ContextMatcher, testutils.MatchType[*types.MealPlanOptionDatabaseCreationInput]()).Return(expected, nil)
+			},
+			map[string][]string{
+				types.MealPlanOptionCreatedServiceEventType: {mealplanningkeys.MealPlanIDKey, mealplanningkeys.MealPlanEventIDKey, mealplanningkeys.MealPlanOptionIDKey},
+			},
+		)
+
+		actual, err := mpm.CreateMealPlanOptionWithEventID(ctx, eventID, fakeInput)
+		assert.NoError(t, err)
+		assert.Len(t, actual.AllergenWarnings, 1)
+		assert.Equal(t, exampleIngredient.ID, actual.AllergenWarnings[0].ValidIngredientID)
+
+		mock.AssertExpectationsForObjects(t, expectations...)
+	})
+
+	T.Run("rejects options conflicting with allergies when the account requires it", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		mpm := buildMealPlanManagerForTest(t)
+
+		eventID := fakes.BuildFakeID()
+		fakeInput := fakes.BuildFakeMealPlanOptionCreationRequestInput()
+
+		exampleIngredient := &types.ValidIngredient{ID: fakes.BuildFakeID(), ContainsPeanut: true}
+		exampleMeal := fakes.BuildFakeMeal()
+		exampleMeal.Components = []*types.MealComponent{{Recipe: types.Recipe{
+			ID:    fakes.BuildFakeID(),
+			Steps: []*types.RecipeStep{{ID: fakes.BuildFakeID(), Ingredients: []*types.RecipeStepIngredient{{Ingredient: exampleIngredient}}}},
+		}}}
+
+		exampleProfile := fakes.BuildFakeAccountAllergenProfile()
+		exampleProfile.Policy = types.MealPlanOptionAllergenPolicyReject
+		exampleProfile.Allergies = []*types.UserIngredientPreference{{CreatedByUser: fakes.BuildFakeID(), Ingredient: *exampleIngredient, Allergy: true}}
+
+		expectations := setupExpectationsForMealPlanningManager(
+			mpm,
+			func(db *mealplanningmock.Repository) {
+				db.On(reflection.GetMethodName(mpm.db.MealExistsAsOptionInEvent), testutils.ContextMatcher, eventID, fakeInput.MealID).Return(false, nil)
+				db.On(reflection.GetMethodName(mpm.db.GetAccountAllergenProfileForMealPlanEvent), testutils.ContextMatcher, eventID).Return(exampleProfile, nil)
+				db.On(reflection.GetMethodName(mpm.db.GetMeal), testutils.ContextMatcher, fakeInput.MealID).Return(exampleMeal, nil)
+			},
+		)
+
+		actual, err := mpm.CreateMealPlanOptionWithEventID(ctx, eventID, fakeInput)
+		assert.ErrorIs(t, err, types.ErrMealPlanOptionConflictsWithAllergies)
+		assert.Nil(t, actual)
+
+		mock.AssertExpectationsForObjects(t, expectations...)
+	})
 }
 
 func TestMealPlanningManager_ReadMealPlanOption(T *testing.T) {
@@ -117,6 +234,7 @@ func TestMealPlanningManager_ReadMealPlanOption(T *testing.T) {
 			mpm,
 			func(db *mealplanningmock.Repository) {
 				db.On(reflection.GetMethodName(mpm.db.GetMealPlanOption), testutils.ContextMatcher, exampleMealPlanID, exampleMealPlanEventID, expected.ID).Return(expected, nil)
+				db.On(reflection.GetMethodName(mpm.db.GetAccountAllergenProfileForMealPlanEvent), testutils.ContextMatcher, exampleMealPlanEventID).Return(fakes.BuildFakeAccountAllergenProfile(), nil)
 			},
 		)
 
@@ -196,3 +314,140 @@ func(db *mealplanningmock.Repository) {
 		mock.AssertExpectationsForObjects(t, expectations...)
 	})
 }
+
+func TestMealPlanningManager_CompleteMealPlanOption(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		mpm := buildMealPlanManagerForTest(t)
+
+		exampleMealPlan := fakes.BuildFakeMealPlan()
+		exampleMealPlanEvent := exampleMealPlan.Events[0]
+		exampleMealPlanOption := exampleMealPlanEvent.Options[0]
+		exampleMealPlanOption.Chosen = true
+		exampleOwnerID := fakes.BuildFakeID()
+
+		examplePantryItems := fakes.BuildFakePantryItemsList().Data
+		examplePantryItem := examplePantryItems[0]
+		examplePantryItem.Quantity = 10
+
+		// the repository draws the pantry down relative to what's stored, which may have changed since it was read.
+		drawnDownPantryItem := *examplePantryItem
+		drawnDownPantryItem.Quantity = 3
+
+		expectations := setupExpectationsForMealPlanningManager(
+			mpm,
+			func(db *mealplanningmock.Repository) {
+				db.On(reflection.GetMethodName(mpm.db.GetMealPlan), testutils.ContextMatcher, exampleMealPlan.ID, exampleOwnerID).Return(exampleMealPlan, nil)
+				db.On(reflection.GetMethodName(mpm.db.GetAvailablePantryItemsForAccount), testutils.ContextMatcher, exampleMealPlan.BelongsToAccount).Return(examplePantryItems, nil)
+				db.On(reflection.GetMethodName(mpm.db.CompleteMealPlanOption), testutils.ContextMatcher, exampleMealPlanEvent.ID, exampleMealPlanOption.ID, []*types.PantryItemDrawDown{
+					{PantryItem: examplePantryItem, Quantity: 4},
+				}).Return([]*types.PantryItem{&drawnDownPantryItem}, true, nil)
+			},
+		)
+
+		glc := &grocerylistpreparation.MockGroceryListCreator{}
+		glc.On(reflection.GetMethodName(glc.PantryConsumptionForMealPlanOption), testutils.ContextMatcher, exampleMealPlan, exampleMealPlanOption, examplePantryItems).Return([]*grocerylistpreparation.PantryItemConsumption{
+			{PantryItem: examplePantryItem, Quantity: 4},
+		}, nil)
+		mpm.groceryListCreator = glc
+
+		actual, err := mpm.CompleteMealPlanOption(ctx, exampleMealPlan.ID, exampleMealPlanEvent.ID, exampleMealPlanOption.ID, exampleOwnerID)
+		assert.NoError(t, err)
+		assert.Equal(t, []*types.PantryItem{&drawnDownPantryItem}, actual)
+
+		mock.AssertExpectationsForObjects(t, append(expectations, glc)...)
+	})
+
+	T.Run("with option already completed", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		mpm := buildMealPlanManagerForTest(t)
+
+		exampleMealPlan := fakes.BuildFakeMealPlan()
+		exampleMealPlanEvent := exampleMealPlan.Events[0]
+		exampleMealPlanOption := exampleMealPlanEvent.Options[0]
+		exampleMealPlanOption.Chosen = true
+		exampleOwnerID := fakes.BuildFakeID()
+
+		examplePantryItems := fakes.BuildFakePantryItemsList().Data
+		examplePantryItem := examplePantryItems[0]
+		examplePantryItem.Quantity = 10
+
+		expectations := setupExpectationsForMealPlanningManager(
+			mpm,
+			func(db *mealplanningmock.Repository) {
+				db.On(reflection.GetMethodName(mpm.db.GetMealPlan), testutils.ContextMatcher, exampleMealPlan.ID, exampleOwnerID).Return(exampleMealPlan, nil)
+				db.On(reflection.GetMethodName(mpm.db.GetAvailablePantryItemsForAccount), testutils.ContextMatcher, exampleMealPlan.BelongsToAccount).Return(examplePantryItems, nil)
+				db.On(reflection.GetMethodName(mpm.db.CompleteMealPlanOption), testutils.ContextMatcher, exampleMealPlanEvent.ID, exampleMealPlanOption.ID, []*types.PantryItemDrawDown{
+					{PantryItem: examplePantryItem, Quantity: 4},
+				}).Return([]*types.PantryItem(nil), false, nil)
+			},
+		)
+
+		glc := &grocerylistpreparation.MockGroceryListCreator{}
+		glc.On(reflection.GetMethodName(glc.PantryConsumptionForMealPlanOption), testutils.ContextMatcher, exampleMealPlan, exampleMealPlanOption, examplePantryItems).Return([]*grocerylistpreparation.PantryItemConsumption{
+			{PantryItem: examplePantryItem, Quantity: 4},
+		}, nil)
+		mpm.groceryListCreator = glc
+
+		actual, err := mpm.CompleteMealPlanOption(ctx, exampleMealPlan.ID, exampleMealPlanEvent.ID, exampleMealPlanOption.ID, exampleOwnerID)
+		assert.NoError(t, err)
+		assert.Empty(t, actual)
+
+		mock.AssertExpectationsForObjects(t, append(expectations, glc)...)
+	})
+
+	T.Run("with option that was not chosen", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		mpm := buildMealPlanManagerForTest(t)
+
+		exampleMealPlan := fakes.BuildFakeMealPlan()
+		exampleMealPlanEvent := exampleMealPlan.Events[0]
+		exampleMealPlanOption := exampleMealPlanEvent.Options[0]
+		exampleMealPlanOption.Chosen = false
+		exampleOwnerID := fakes.BuildFakeID()
+
+		expectations := setupExpectationsForMealPlanningManager(
+			mpm,
+			func(db *mealplanningmock.Repository) {
+				db.On(reflection.GetMethodName(mpm.db.GetMealPlan), testutils.ContextMatcher, exampleMealPlan.ID, exampleOwnerID).Return(exampleMealPlan, nil)
+			},
+		)
+
+		actual, err := mpm.CompleteMealPlanOption(ctx, exampleMealPlan.ID, exampleMealPlanEvent.ID, exampleMealPlanOption.ID, exampleOwnerID)
+		assert.ErrorIs(t, err, types.ErrMealPlanOptionNotChosen)
+		assert.Nil(t, actual)
+
+		mock.AssertExpectationsForObjects(t, expectations...)
+	})
+
+	T.Run("with option missing from meal plan", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		mpm := buildMealPlanManagerForTest(t)
+
+		exampleMealPlan := fakes.BuildFakeMealPlan()
+		exampleOwnerID := fakes.BuildFakeID()
+
+		expectations := setupExpectationsForMealPlanningManager(
+			mpm,
+			func(db *mealplanningmock.Repository) {
+				db.On(reflection.GetMethodName(mpm.db.GetMealPlan), testutils.ContextMatcher, exampleMealPlan.ID, exampleOwnerID).Return(exampleMealPlan, nil)
+			},
+		)
+
+		actual, err := mpm.CompleteMealPlanOption(ctx, exampleMealPlan.ID, exampleMealPlan.Events[0].ID, fakes.BuildFakeID(), exampleOwnerID)
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+		assert.Nil(t, actual)
+
+		mock.AssertExpectationsForObjects(t, expectations...)
+	})
+}
@@ -47,9 +47,18 @@ func (m *mealPlanningManager) CreateMealPlanOptionVotes(ctx context.Context, cre
 		return nil, platformerrors.ErrNilInputParameter
 	}
 
+	if input.MealPlanEventID == "" {
+		return nil, platformerrors.ErrEmptyInputParameter
+	}
+
 	convertedInput := converters.ConvertMealPlanOptionVoteCreationRequestInputToMealPlanOptionVotesDatabaseCreationInput(input)
-	logger := m.logger.WithSpan(span).WithValue("vote_count", len(input.Votes))
+	logger := m.logger.WithSpan(span).WithValue("vote_count", len(input.Votes)).WithValue(mealplanningkeys.MealPlanEventIDKey, input.MealPlanEventID)
 	tracing.AttachToSpan(span, "vote_count", len(input.Votes))
+	tracing.AttachToSpan(span, mealplanningkeys.MealPlanEventIDKey, input.MealPlanEventID)
+
+	if err := m.checkMealPlanOptionVoteAllergens(ctx, input); err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "checking meal plan option votes against allergies")
+	}
 
 	for i := range input.Votes {
 		convertedInput.Votes[i].ByUser = creatorID
@@ -61,8 +70,9 @@ func (m *mealPlanningManager) CreateMealPlanOptionVotes(ctx context.Context, cre
 	}
 
 	m.dataChangesPublisher.PublishAsync(ctx, audit.BuildDataChangeMessageFromContext(ctx, logger, types.MealPlanOptionVoteCreatedServiceEventType, map[string]any{
-		"vote_count": len(input.Votes),
-		"created":    len(created),
+		mealplanningkeys.MealPlanIDKey: input.MealPlanID,
+		"vote_count":                   len(input.Votes),
+		"created":                      len(created),
 	}))
 
 	return created, nil
@@ -56,14 +56,16 @@ func TestMealPlanningManager_CreateMealPlanOptionVotes(T *testing.T) {
 		creatorID := fakes.BuildFakeID()
 		expected := fakes.BuildFakeMealPlanOptionVotesList().Data
 		fakeInput := fakes.BuildFakeMealPlanOptionVoteCreationRequestInput()
+		fakeInput.MealPlanEventID = fakes.BuildFakeID()
 
 		expectations := setupExpectationsForMealPlanningManager(
 			mpm,
 			func(db *mealplanningmock.Repository) {
+				db.On(reflection.GetMethodName(mpm.db.GetAccountAllergenProfileForMealPlanEvent), testutils.ContextMatcher, fakeInput.MealPlanEventID).Return(fakes.BuildFakeAccountAllergenProfile(), nil)
 				db.On(reflection.GetMethodName(mpm.db.CreateMealPlanOptionVote), testutils.ContextMatcher, testutils.MatchType[*types.MealPlanOptionVotesDatabaseCreationInput]()).Return(expected, nil)
 			},
 			map[string][]string{
-				types.MealPlanOptionVoteCreatedServiceEventType: {"vote_count", "created"},
+				types.MealPlanOptionVoteCreatedServiceEventType: {mealplanningkeys.MealPlanIDKey, "vote_count", "created"},
 			},
 		)
 
@@ -73,6 +75,56 @@ func(db *mealplanningmock.Repository) {
 
 		mock.AssertExpectationsForObjects(t, expectations...)
 	})
+
+	T.Run("without meal plan event ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		mpm := buildMealPlanManagerForTest(t)
+
+		actual, err := mpm.CreateMealPlanOptionVotes(ctx, fakes.BuildFakeID(), fakes.BuildFakeMealPlanOptionVoteCreationRequestInput())
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+
+	T.Run("rejects votes for options conflicting with allergies when the account requires it", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		mpm := buildMealPlanManagerForTest(t)
+
+		exampleIngredient := &types.ValidIngredient{ID: fakes.BuildFakeID(), ContainsPeanut: true}
+		exampleOption := fakes.BuildFakeMealPlanOption()
+		exampleOption.Meal.Components = []*types.MealComponent{{Recipe: types.Recipe{
+			ID:    fakes.BuildFakeID(),
+			Steps: []*types.RecipeStep{{ID: fakes.BuildFakeID(), Ingredients: []*types.RecipeStepIngredient{{Ingredient: exampleIngredient}}}},
+		}}}
+		exampleOptions := fakes.BuildFakeMealPlanOptionsList()
+		exampleOptions.Data = []*types.MealPlanOption{exampleOption}
+
+		exampleProfile := fakes.BuildFakeAccountAllergenProfile()
+		exampleProfile.Policy = types.MealPlanOptionAllergenPolicyReject
+		exampleProfile.Allergies = []*types.UserIngredientPreference{{CreatedByUser: fakes.BuildFakeID(), Ingredient: *exampleIngredient, Allergy: true}}
+
+		fakeInput := fakes.BuildFakeMealPlanOptionVoteCreationRequestInput()
+		fakeInput.MealPlanEventID = fakes.BuildFakeID()
+		fakeInput.Votes[0].BelongsToMealPlanOption = exampleOption.ID
+		fakeInput.Votes[0].Abstain = false
+
+		expectations := setupExpectationsForMealPlanningManager(
+			mpm,
+			func(db *mealplanningmock.Repository) {
+				db.On(reflection.GetMethodName(mpm.db.GetAccountAllergenProfileForMealPlanEvent), testutils.ContextMatcher, fakeInput.MealPlanEventID).Return(exampleProfile, nil)
+				db.On(reflection.GetMethodName(mpm.db.GetMealPlanOptions), testutils.ContextMatcher, fakeInput.MealPlanID, fakeInput.MealPlanEventID, testutils.QueryFilterMatcher).Return(exampleOptions, nil)
+			},
+		)
+
+		actual, err := mpm.CreateMealPlanOptionVotes(ctx, fakes.BuildFakeID(), fakeInput)
+		assert.ErrorIs(t, err, types.ErrMealPlanOptionConflictsWithAllergies)
+		assert.Nil(t, actual)
+
+		mock.AssertExpectationsForObjects(t, expectations...)
+	})
 }
 
 func TestMealPlanningManager_ReadMealPlanOptionVote(T *testing.T) {
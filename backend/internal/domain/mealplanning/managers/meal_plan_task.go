@@ -66,7 +66,9 @@ func (m *mealPlanningManager) CreateMealPlanTask(ctx context.Context, input *typ
 	}
 
 	m.dataChangesPublisher.PublishAsync(ctx, audit.BuildDataChangeMessageFromContext(ctx, logger, types.MealPlanTaskCreatedServiceEventType, map[string]any{
-		mealplanningkeys.MealPlanTaskIDKey: convertedInput.ID,
+		mealplanningkeys.MealPlanIDKey:       input.MealPlanID,
+		mealplanningkeys.MealPlanOptionIDKey: convertedInput.MealPlanOptionID,
+		mealplanningkeys.MealPlanTaskIDKey:   convertedInput.ID,
 	}))
 
 	return created, nil
@@ -87,9 +89,15 @@ func (m *mealPlanningManager) MealPlanTaskStatusChange(ctx context.Context, inpu
 		return observability.PrepareAndLogError(err, logger, span, "changing meal plan task status")
 	}
 
-	m.dataChangesPublisher.PublishAsync(ctx, audit.BuildDataChangeMessageFromContext(ctx, logger, types.MealPlanTaskStatusChangedServiceEventType, map[string]any{
+	eventContext := map[string]any{
+		mealplanningkeys.MealPlanIDKey:     input.MealPlanID,
 		mealplanningkeys.MealPlanTaskIDKey: input.MealPlanTaskID,
-	}))
+	}
+	if input.AssignedToUser != nil && *input.AssignedToUser != "" {
+		eventContext[mealplanningkeys.MealPlanTaskAssignedToUserKey] = *input.AssignedToUser
+	}
+
+	m.dataChangesPublisher.PublishAsync(ctx, audit.BuildDataChangeMessageFromContext(ctx, logger, types.MealPlanTaskStatusChangedServiceEventType, eventContext))
 
 	return nil
 }
@@ -87,7 +87,7 @@ func(db *mealplanningmock.Repository) {
 				db.On(reflection.GetMethodName(mpm.db.CreateMealPlanTask), testutils.ContextMatcher, testutils.MatchType[*types.MealPlanTaskDatabaseCreationInput]()).Return(expected, nil)
 			},
 			map[string][]string{
-				types.MealPlanTaskCreatedServiceEventType: {mealplanningkeys.MealPlanTaskIDKey},
+				types.MealPlanTaskCreatedServiceEventType: {mealplanningkeys.MealPlanIDKey, mealplanningkeys.MealPlanOptionIDKey, mealplanningkeys.MealPlanTaskIDKey},
 			},
 		)
 
@@ -117,6 +117,7 @@ func(db *mealplanningmock.Repository) {
 			},
 			map[string][]string{
 				types.MealPlanTaskStatusChangedServiceEventType: {
+					mealplanningkeys.MealPlanIDKey,
 					mealplanningkeys.MealPlanTaskIDKey,
 				},
 			},
@@ -0,0 +1,164 @@
+package managers
+
+import (
+	"context"
+	"time"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/audit"
+	identitykeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity/keys"
+	types "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/converters"
+	mealplanningkeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/keys"
+
+	"github.com/primandproper/platform/database/filtering"
+	platformerrors "github.com/primandproper/platform/errors"
+	"github.com/primandproper/platform/observability"
+	"github.com/primandproper/platform/observability/tracing"
+)
+
+func (m *mealPlanningManager) ListMealPlanTemplates(ctx context.Context, ownerID string, filter *filtering.QueryFilter) (*filtering.QueryFilteredResult[types.MealPlanTemplate], error) {
+	ctx, span := m.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if filter == nil {
+		filter = filtering.DefaultQueryFilter()
+	}
+
+	logger := m.logger.WithSpan(span).WithValue(identitykeys.AccountIDKey, ownerID)
+	tracing.AttachToSpan(span, identitykeys.AccountIDKey, ownerID)
+
+	results, err := m.db.GetMealPlanTemplatesForAccount(ctx, ownerID, filter)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching meal plan templates")
+	}
+
+	return results, nil
+}
+
+func (m *mealPlanningManager) CreateMealPlanTemplate(ctx context.Context, ownerID, creatorID string, input *types.MealPlanTemplateCreationRequestInput) (*types.MealPlanTemplate, error) {
+	ctx, span := m.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if input == nil {
+		return nil, platformerrors.ErrNilInputParameter
+	}
+
+	if creatorID == "" {
+		return nil, platformerrors.ErrEmptyInputParameter
+	}
+
+	if ownerID == "" {
+		return nil, platformerrors.ErrEmptyInputParameter
+	}
+
+	convertedInput := converters.ConvertMealPlanTemplateCreationRequestInputToMealPlanTemplateDatabaseCreationInput(input)
+	convertedInput.CreatedByUser = creatorID
+	convertedInput.BelongsToAccount = ownerID
+
+	logger := m.logger.WithSpan(span).WithValue(mealplanningkeys.MealPlanTemplateIDKey, convertedInput.ID)
+	tracing.AttachToSpan(span, mealplanningkeys.MealPlanTemplateIDKey, convertedInput.ID)
+
+	template := &types.MealPlanTemplate{
+		RecurrenceRule: convertedInput.RecurrenceRule,
+		Timezone:       convertedInput.Timezone,
+	}
+
+	nextInstantiationAt, err := template.NextInstantiationAfter(time.Now())
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "determining first meal plan template instantiation")
+	}
+	convertedInput.NextInstantiationAt = nextInstantiationAt
+
+	created, err := m.db.CreateMealPlanTemplate(ctx, convertedInput)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "creating meal plan template")
+	}
+
+	m.dataChangesPublisher.PublishAsync(ctx, audit.BuildDataChangeMessageFromContext(ctx, logger, types.MealPlanTemplateCreatedServiceEventType, map[string]any{
+		mealplanningkeys.MealPlanTemplateIDKey: convertedInput.ID,
+	}))
+
+	return created, nil
+}
+
+func (m *mealPlanningManager) ReadMealPlanTemplate(ctx context.Context, mealPlanTemplateID, ownerID string) (*types.MealPlanTemplate, error) {
+	ctx, span := m.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := m.logger.WithSpan(span).WithValues(map[string]any{
+		identitykeys.AccountIDKey:              ownerID,
+		mealplanningkeys.MealPlanTemplateIDKey: mealPlanTemplateID,
+	})
+	tracing.AttachToSpan(span, identitykeys.AccountIDKey, ownerID)
+	tracing.AttachToSpan(span, mealplanningkeys.MealPlanTemplateIDKey, mealPlanTemplateID)
+
+	result, err := m.db.GetMealPlanTemplate(ctx, mealPlanTemplateID, ownerID)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching meal plan template")
+	}
+
+	return result, nil
+}
+
+func (m *mealPlanningManager) UpdateMealPlanTemplate(ctx context.Context, mealPlanTemplateID, ownerID string, input *types.MealPlanTemplateUpdateRequestInput) error {
+	ctx, span := m.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if input == nil {
+		return platformerrors.ErrNilInputParameter
+	}
+
+	logger := m.logger.WithSpan(span).WithValues(map[string]any{
+		identitykeys.AccountIDKey:              ownerID,
+		mealplanningkeys.MealPlanTemplateIDKey: mealPlanTemplateID,
+	})
+	tracing.AttachToSpan(span, identitykeys.AccountIDKey, ownerID)
+	tracing.AttachToSpan(span, mealplanningkeys.MealPlanTemplateIDKey, mealPlanTemplateID)
+
+	existingTemplate, err := m.db.GetMealPlanTemplate(ctx, mealPlanTemplateID, ownerID)
+	if err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "fetching meal plan template to update")
+	}
+
+	previousRule, previousTimezone := existingTemplate.RecurrenceRule, existingTemplate.Timezone
+	existingTemplate.Update(input)
+
+	// a new schedule takes effect from now, rather than from whenever the old one was next due.
+	if existingTemplate.RecurrenceRule != previousRule || existingTemplate.Timezone != previousTimezone {
+		if existingTemplate.NextInstantiationAt, err = existingTemplate.NextInstantiationAfter(time.Now()); err != nil {
+			return observability.PrepareAndLogError(err, logger, span, "determining next meal plan template instantiation")
+		}
+	}
+
+	if err = m.db.UpdateMealPlanTemplate(ctx, existingTemplate); err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "updating meal plan template")
+	}
+
+	m.dataChangesPublisher.PublishAsync(ctx, audit.BuildDataChangeMessageFromContext(ctx, logger, types.MealPlanTemplateUpdatedServiceEventType, map[string]any{
+		mealplanningkeys.MealPlanTemplateIDKey: mealPlanTemplateID,
+	}))
+
+	return nil
+}
+
+func (m *mealPlanningManager) ArchiveMealPlanTemplate(ctx context.Context, mealPlanTemplateID, ownerID string) error {
+	ctx, span := m.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := m.logger.WithSpan(span).WithValues(map[string]any{
+		identitykeys.AccountIDKey:              ownerID,
+		mealplanningkeys.MealPlanTemplateIDKey: mealPlanTemplateID,
+	})
+	tracing.AttachToSpan(span, identitykeys.AccountIDKey, ownerID)
+	tracing.AttachToSpan(span, mealplanningkeys.MealPlanTemplateIDKey, mealPlanTemplateID)
+
+	if err := m.db.ArchiveMealPlanTemplate(ctx, mealPlanTemplateID, ownerID); err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "archiving meal plan template")
+	}
+
+	m.dataChangesPublisher.PublishAsync(ctx, audit.BuildDataChangeMessageFromContext(ctx, logger, types.MealPlanTemplateArchivedServiceEventType, map[string]any{
+		mealplanningkeys.MealPlanTemplateIDKey: mealPlanTemplateID,
+	}))
+
+	return nil
+}
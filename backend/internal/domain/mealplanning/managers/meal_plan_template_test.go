@@ -0,0 +1,226 @@
+package managers
+
+import (
+	"testing"
+	"time"
+
+	types "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/fakes"
+	mealplanningkeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/keys"
+	mealplanningmock "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/mocks"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/testutils"
+
+	"github.com/primandproper/platform/reflection"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestMealPlanningManager_ListMealPlanTemplates(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		mpm := buildMealPlanManagerForTest(t)
+
+		expected := fakes.BuildFakeMealPlanTemplatesList()
+		exampleOwnerID := fakes.BuildFakeID()
+
+		expectations := setupExpectationsForMealPlanningManager(
+			mpm,
+			func(db *mealplanningmock.Repository) {
+				db.On(reflection.GetMethodName(mpm.db.GetMealPlanTemplatesForAccount), testutils.ContextMatcher, exampleOwnerID, testutils.QueryFilterMatcher).Return(expected, nil)
+			},
+		)
+
+		actual, err := mpm.ListMealPlanTemplates(ctx, exampleOwnerID, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, actual)
+
+		mock.AssertExpectationsForObjects(t, expectations...)
+	})
+}
+
+func TestMealPlanningManager_CreateMealPlanTemplate(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		mpm := buildMealPlanManagerForTest(t)
+
+		fakeOwnerID := fakes.BuildFakeID()
+		fakeCreatorID := fakes.BuildFakeID()
+		expected := fakes.BuildFakeMealPlanTemplate()
+		fakeInput := fakes.BuildFakeMealPlanTemplateCreationRequestInput()
+
+		expectations := setupExpectationsForMealPlanningManager(
+			mpm,
+			func(db *mealplanningmock.Repository) {
+				db.On(reflection.GetMethodName(mpm.db.CreateMealPlanTemplate), testutils.ContextMatcher, mock.MatchedBy(func(input *types.MealPlanTemplateDatabaseCreationInput) bool {
+					return input.BelongsToAccount == fakeOwnerID &&
+						input.CreatedByUser == fakeCreatorID &&
+						input.NextInstantiationAt.After(time.Now())
+				})).Return(expected, nil)
+			},
+			map[string][]string{
+				types.MealPlanTemplateCreatedServiceEventType: {mealplanningkeys.MealPlanTemplateIDKey},
+			},
+		)
+
+		actual, err := mpm.CreateMealPlanTemplate(ctx, fakeOwnerID, fakeCreatorID, fakeInput)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, actual)
+
+		mock.AssertExpectationsForObjects(t, expectations...)
+	})
+
+	T.Run("with nil input", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		mpm := buildMealPlanManagerForTest(t)
+
+		actual, err := mpm.CreateMealPlanTemplate(ctx, fakes.BuildFakeID(), fakes.BuildFakeID(), nil)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+
+	T.Run("with invalid recurrence rule", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		mpm := buildMealPlanManagerForTest(t)
+
+		fakeInput := fakes.BuildFakeMealPlanTemplateCreationRequestInput()
+		fakeInput.RecurrenceRule = "FREQ=SOMETIMES"
+
+		actual, err := mpm.CreateMealPlanTemplate(ctx, fakes.BuildFakeID(), fakes.BuildFakeID(), fakeInput)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
+
+func TestMealPlanningManager_ReadMealPlanTemplate(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		mpm := buildMealPlanManagerForTest(t)
+
+		ownerID := fakes.BuildFakeID()
+		expected := fakes.BuildFakeMealPlanTemplate()
+
+		expectations := setupExpectationsForMealPlanningManager(
+			mpm,
+			func(db *mealplanningmock.Repository) {
+				db.On(reflection.GetMethodName(mpm.db.GetMealPlanTemplate), testutils.ContextMatcher, expected.ID, ownerID).Return(expected, nil)
+			},
+		)
+
+		actual, err := mpm.ReadMealPlanTemplate(ctx, expected.ID, ownerID)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, actual)
+
+		mock.AssertExpectationsForObjects(t, expectations...)
+	})
+}
+
+func TestMealPlanningManager_UpdateMealPlanTemplate(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		mpm := buildMealPlanManagerForTest(t)
+
+		exampleTemplate := fakes.BuildFakeMealPlanTemplate()
+		ownerID := fakes.BuildFakeID()
+		exampleInput := fakes.BuildFakeMealPlanTemplateUpdateRequestInput()
+
+		expectations := setupExpectationsForMealPlanningManager(
+			mpm,
+			func(db *mealplanningmock.Repository) {
+				db.On(reflection.GetMethodName(mpm.db.GetMealPlanTemplate), testutils.ContextMatcher, exampleTemplate.ID, ownerID).Return(exampleTemplate, nil)
+				db.On(reflection.GetMethodName(mpm.db.UpdateMealPlanTemplate), testutils.ContextMatcher, testutils.MatchType[*types.MealPlanTemplate]()).Return(nil)
+			},
+			map[string][]string{
+				types.MealPlanTemplateUpdatedServiceEventType: {
+					mealplanningkeys.MealPlanTemplateIDKey,
+				},
+			},
+		)
+
+		assert.NoError(t, mpm.UpdateMealPlanTemplate(ctx, exampleTemplate.ID, ownerID, exampleInput))
+
+		mock.AssertExpectationsForObjects(t, expectations...)
+	})
+
+	T.Run("reschedules when the recurrence rule changes", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		mpm := buildMealPlanManagerForTest(t)
+
+		exampleTemplate := fakes.BuildFakeMealPlanTemplate()
+		exampleTemplate.NextInstantiationAt = time.Now().Add(-time.Hour)
+		ownerID := fakes.BuildFakeID()
+		newRule := "FREQ=WEEKLY;BYDAY=SA;BYHOUR=10"
+		exampleInput := &types.MealPlanTemplateUpdateRequestInput{RecurrenceRule: &newRule}
+
+		expectations := setupExpectationsForMealPlanningManager(
+			mpm,
+			func(db *mealplanningmock.Repository) {
+				db.On(reflection.GetMethodName(mpm.db.GetMealPlanTemplate), testutils.ContextMatcher, exampleTemplate.ID, ownerID).Return(exampleTemplate, nil)
+				db.On(reflection.GetMethodName(mpm.db.UpdateMealPlanTemplate), testutils.ContextMatcher, mock.MatchedBy(func(template *types.MealPlanTemplate) bool {
+					return template.RecurrenceRule == newRule && template.NextInstantiationAt.After(time.Now())
+				})).Return(nil)
+			},
+			map[string][]string{
+				types.MealPlanTemplateUpdatedServiceEventType: {
+					mealplanningkeys.MealPlanTemplateIDKey,
+				},
+			},
+		)
+
+		assert.NoError(t, mpm.UpdateMealPlanTemplate(ctx, exampleTemplate.ID, ownerID, exampleInput))
+
+		mock.AssertExpectationsForObjects(t, expectations...)
+	})
+}
+
+func TestMealPlanningManager_ArchiveMealPlanTemplate(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		mpm := buildMealPlanManagerForTest(t)
+
+		ownerID := fakes.BuildFakeID()
+		expected := fakes.BuildFakeMealPlanTemplate()
+
+		expectations := setupExpectationsForMealPlanningManager(
+			mpm,
+			func(db *mealplanningmock.Repository) {
+				db.On(reflection.GetMethodName(mpm.db.ArchiveMealPlanTemplate), testutils.ContextMatcher, expected.ID, ownerID).Return(nil)
+			},
+			map[string][]string{
+				types.MealPlanTemplateArchivedServiceEventType: {
+					mealplanningkeys.MealPlanTemplateIDKey,
+				},
+			},
+		)
+
+		assert.NoError(t, mpm.ArchiveMealPlanTemplate(ctx, expected.ID, ownerID))
+
+		mock.AssertExpectationsForObjects(t, expectations...)
+	})
+}
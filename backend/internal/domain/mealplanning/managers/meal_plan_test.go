@@ -2,11 +2,14 @@
 
 import (
 	"testing"
+	"time"
 
 	types "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/fakes"
 	mealplanningkeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/keys"
 	mealplanningmock "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/mocks"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/payments"
+	paymentsmock "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/payments/mock"
 	mealplanningworkers "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/services/mealplanning/workers"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/testutils"
 
@@ -60,6 +63,7 @@ func TestMealPlanningManager_CreateMealPlan(T *testing.T) {
 		expectations := setupExpectationsForMealPlanningManager(
 			mpm,
 			func(db *mealplanningmock.Repository) {
+				db.On(reflection.GetMethodName(mpm.db.GetAccountAllergenProfile), testutils.ContextMatcher, ownerID).Return(fakes.BuildFakeAccountAllergenProfile(), nil)
 				db.On(reflection.GetMethodName(mpm.db.CreateMealPlan), testutils.ContextMatcher, testutils.MatchType[*types.MealPlanDatabaseCreationInput]()).Return(expected, nil)
 			},
 			map[string][]string{
@@ -67,11 +71,72 @@ func(db *mealplanningmock.Repository) {
 			},
 		)
 
+		entitlementsChecker := setupUnlimitedEntitlementsForTest(mpm, ownerID)
+
 		actual, err := mpm.CreateMealPlan(ctx, ownerID, creatorID, fakeInput)
 		assert.NoError(t, err)
 		assert.Equal(t, expected, actual)
 
-		mock.AssertExpectationsForObjects(t, expectations...)
+		mock.AssertExpectationsForObjects(t, append(expectations, entitlementsChecker)...)
+	})
+
+	T.Run("within weekly meal plan limit", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		mpm := buildMealPlanManagerForTest(t)
+
+		ownerID := fakes.BuildFakeID()
+		creatorID := fakes.BuildFakeID()
+		expected := fakes.BuildFakeMealPlan()
+		fakeInput := fakes.BuildFakeMealPlanCreationRequestInput()
+
+		expectations := setupExpectationsForMealPlanningManager(
+			mpm,
+			func(db *mealplanningmock.Repository) {
+				db.On(reflection.GetMethodName(mpm.db.GetMealPlanCountForAccountSince), testutils.ContextMatcher, ownerID, testutils.MatchType[time.Time]()).Return(int64(1), nil)
+				db.On(reflection.GetMethodName(mpm.db.GetAccountAllergenProfile), testutils.ContextMatcher, ownerID).Return(fakes.BuildFakeAccountAllergenProfile(), nil)
+				db.On(reflection.GetMethodName(mpm.db.CreateMealPlan), testutils.ContextMatcher, testutils.MatchType[*types.MealPlanDatabaseCreationInput]()).Return(expected, nil)
+			},
+		)
+
+		entitlementsChecker := &paymentsmock.EntitlementsChecker{}
+		entitlementsChecker.On(reflection.GetMethodName(entitlementsChecker.GetEntitlementsForAccount), testutils.ContextMatcher, ownerID).Return(&payments.AccountEntitlements{AccountID: ownerID, MaxMealPlansPerWeek: 2}, nil)
+		mpm.entitlementsChecker = entitlementsChecker
+
+		actual, err := mpm.CreateMealPlan(ctx, ownerID, creatorID, fakeInput)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, actual)
+
+		mock.AssertExpectationsForObjects(t, append(expectations, entitlementsChecker)...)
+	})
+
+	T.Run("with weekly meal plan limit reached", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		mpm := buildMealPlanManagerForTest(t)
+
+		ownerID := fakes.BuildFakeID()
+		creatorID := fakes.BuildFakeID()
+		fakeInput := fakes.BuildFakeMealPlanCreationRequestInput()
+
+		expectations := setupExpectationsForMealPlanningManager(
+			mpm,
+			func(db *mealplanningmock.Repository) {
+				db.On(reflection.GetMethodName(mpm.db.GetMealPlanCountForAccountSince), testutils.ContextMatcher, ownerID, testutils.MatchType[time.Time]()).Return(int64(2), nil)
+			},
+		)
+
+		entitlementsChecker := &paymentsmock.EntitlementsChecker{}
+		entitlementsChecker.On(reflection.GetMethodName(entitlementsChecker.GetEntitlementsForAccount), testutils.ContextMatcher, ownerID).Return(&payments.AccountEntitlements{AccountID: ownerID, MaxMealPlansPerWeek: 2}, nil)
+		mpm.entitlementsChecker = entitlementsChecker
+
+		actual, err := mpm.CreateMealPlan(ctx, ownerID, creatorID, fakeInput)
+		assert.ErrorIs(t, err, payments.ErrEntitlementLimitReached)
+		assert.Nil(t, actual)
+
+		mock.AssertExpectationsForObjects(t, append(expectations, entitlementsChecker)...)
 	})
 
 	T.Run("invokes workers when meal plan is created finalized", func(t *testing.T) {
@@ -94,6 +159,7 @@ func(db *mealplanningmock.Repository) {
 		expectations := setupExpectationsForMealPlanningManager(
 			mpm,
 			func(db *mealplanningmock.Repository) {
+				db.On(reflection.GetMethodName(mpm.db.GetAccountAllergenProfile), testutils.ContextMatcher, ownerID).Return(fakes.BuildFakeAccountAllergenProfile(), nil)
 				db.On(reflection.GetMethodName(mpm.db.CreateMealPlan), testutils.ContextMatcher, testutils.MatchType[*types.MealPlanDatabaseCreationInput]()).Return(expected, nil)
 			},
 			map[string][]string{
@@ -101,11 +167,13 @@ func(db *mealplanningmock.Repository) {
 			},
 		)
 
+		entitlementsChecker := setupUnlimitedEntitlementsForTest(mpm, ownerID)
+
 		actual, err := mpm.CreateMealPlan(ctx, ownerID, creatorID, fakeInput)
 		assert.NoError(t, err)
 		assert.Equal(t, expected, actual)
 
-		mock.AssertExpectationsForObjects(t, append(expectations, groceryWorker, taskWorker)...)
+		mock.AssertExpectationsForObjects(t, append(expectations, groceryWorker, taskWorker, entitlementsChecker)...)
 	})
 }
 
@@ -125,6 +193,7 @@ func TestMealPlanningManager_ReadMealPlan(T *testing.T) {
 			mpm,
 			func(db *mealplanningmock.Repository) {
 				db.On(reflection.GetMethodName(mpm.db.GetMealPlan), testutils.ContextMatcher, exampleMealPlanID, expected.ID).Return(expected, nil)
+				db.On(reflection.GetMethodName(mpm.db.GetAccountAllergenProfile), testutils.ContextMatcher, expected.BelongsToAccount).Return(fakes.BuildFakeAccountAllergenProfile(), nil)
 			},
 		)
 
@@ -177,6 +177,20 @@ func (m *MockMealPlanningManager) CreateMealPlanEvent(ctx context.Context, mealP
 	return returnValues.Get(0).(*mealplanning.MealPlanEvent), returnValues.Error(1)
 }
 
+// ListMealPlanActivitiesAfterCursor is a mock method.
+func (m *MockMealPlanningManager) ListMealPlanActivitiesAfterCursor(ctx context.Context, mealPlanID string, afterSequence uint64, limit uint8) ([]*mealplanning.MealPlanActivity, error) {
+	returnValues := m.Called(ctx, mealPlanID, afterSequence, limit)
+
+	return returnValues.Get(0).([]*mealplanning.MealPlanActivity), returnValues.Error(1)
+}
+
+// ReadMealPlanEventTallyReport is a mock method.
+func (m *MockMealPlanningManager) ReadMealPlanEventTallyReport(ctx context.Context, mealPlanID, mealPlanEventID string) (*mealplanning.MealPlanEventTallyReport, error) {
+	returnValues := m.Called(ctx, mealPlanID, mealPlanEventID)
+
+	return returnValues.Get(0).(*mealplanning.MealPlanEventTallyReport), returnValues.Error(1)
+}
+
 // ReadMealPlanEvent is a mock method.
 func (m *MockMealPlanningManager) ReadMealPlanEvent(ctx context.Context, mealPlanID, mealPlanEventID string) (*mealplanning.MealPlanEvent, error) {
 	returnValues := m.Called(ctx, mealPlanID, mealPlanEventID)
@@ -250,6 +264,13 @@ func (m *MockMealPlanningManager) ArchiveMealPlanOption(ctx context.Context, mea
 	return returnValues.Error(0)
 }
 
+// CompleteMealPlanOption is a mock method.
+func (m *MockMealPlanningManager) CompleteMealPlanOption(ctx context.Context, mealPlanID, mealPlanEventID, mealPlanOptionID, ownerID string) ([]*mealplanning.PantryItem, error) {
+	returnValues := m.Called(ctx, mealPlanID, mealPlanEventID, mealPlanOptionID, ownerID)
+
+	return returnValues.Get(0).([]*mealplanning.PantryItem), returnValues.Error(1)
+}
+
 // ListMealPlanOptionVotes is a mock method.
 func (m *MockMealPlanningManager) ListMealPlanOptionVotes(ctx context.Context, mealPlanID, mealPlanEventID, mealPlanOptionID string, filter *filtering.QueryFilter) (*filtering.QueryFilteredResult[mealplanning.MealPlanOptionVote], error) {
 	returnValues := m.Called(ctx, mealPlanID, mealPlanEventID, mealPlanOptionID, filter)
@@ -477,3 +498,79 @@ func (m *MockMealPlanningManager) ArchiveAccountInstrumentOwnership(ctx context.
 
 	return returnValues.Error(0)
 }
+
+// ListMealPlanTemplates is a mock method.
+func (m *MockMealPlanningManager) ListMealPlanTemplates(ctx context.Context, ownerID string, filter *filtering.QueryFilter) (*filtering.QueryFilteredResult[mealplanning.MealPlanTemplate], error) {
+	returnValues := m.Called(ctx, ownerID, filter)
+
+	if returnValues.Get(0) == nil {
+		return nil, returnValues.Error(1)
+	}
+	return returnValues.Get(0).(*filtering.QueryFilteredResult[mealplanning.MealPlanTemplate]), returnValues.Error(1)
+}
+
+// CreateMealPlanTemplate is a mock method.
+func (m *MockMealPlanningManager) CreateMealPlanTemplate(ctx context.Context, ownerID, creatorID string, input *mealplanning.MealPlanTemplateCreationRequestInput) (*mealplanning.MealPlanTemplate, error) {
+	returnValues := m.Called(ctx, ownerID, creatorID, input)
+
+	return returnValues.Get(0).(*mealplanning.MealPlanTemplate), returnValues.Error(1)
+}
+
+// ReadMealPlanTemplate is a mock method.
+func (m *MockMealPlanningManager) ReadMealPlanTemplate(ctx context.Context, mealPlanTemplateID, ownerID string) (*mealplanning.MealPlanTemplate, error) {
+	returnValues := m.Called(ctx, mealPlanTemplateID, ownerID)
+
+	return returnValues.Get(0).(*mealplanning.MealPlanTemplate), returnValues.Error(1)
+}
+
+// UpdateMealPlanTemplate is a mock method.
+func (m *MockMealPlanningManager) UpdateMealPlanTemplate(ctx context.Context, mealPlanTemplateID, ownerID string, input *mealplanning.MealPlanTemplateUpdateRequestInput) error {
+	returnValues := m.Called(ctx, mealPlanTemplateID, ownerID, input)
+
+	return returnValues.Error(0)
+}
+
+// ArchiveMealPlanTemplate is a mock method.
+func (m *MockMealPlanningManager) ArchiveMealPlanTemplate(ctx context.Context, mealPlanTemplateID, ownerID string) error {
+	returnValues := m.Called(ctx, mealPlanTemplateID, ownerID)
+
+	return returnValues.Error(0)
+}
+
+// ListPantryItems is a mock method.
+func (m *MockMealPlanningManager) ListPantryItems(ctx context.Context, ownerID string, filter *filtering.QueryFilter) (*filtering.QueryFilteredResult[mealplanning.PantryItem], error) {
+	returnValues := m.Called(ctx, ownerID, filter)
+
+	if returnValues.Get(0) == nil {
+		return nil, returnValues.Error(1)
+	}
+	return returnValues.Get(0).(*filtering.QueryFilteredResult[mealplanning.PantryItem]), returnValues.Error(1)
+}
+
+// CreatePantryItem is a mock method.
+func (m *MockMealPlanningManager) CreatePantryItem(ctx context.Context, ownerID string, input *mealplanning.PantryItemCreationRequestInput) (*mealplanning.PantryItem, error) {
+	returnValues := m.Called(ctx, ownerID, input)
+
+	return returnValues.Get(0).(*mealplanning.PantryItem), returnValues.Error(1)
+}
+
+// ReadPantryItem is a mock method.
+func (m *MockMealPlanningManager) ReadPantryItem(ctx context.Context, ownerID, pantryItemID string) (*mealplanning.PantryItem, error) {
+	returnValues := m.Called(ctx, ownerID, pantryItemID)
+
+	return returnValues.Get(0).(*mealplanning.PantryItem), returnValues.Error(1)
+}
+
+// UpdatePantryItem is a mock method.
+func (m *MockMealPlanningManager) UpdatePantryItem(ctx context.Context, pantryItemID, ownerID string, input *mealplanning.PantryItemUpdateRequestInput) error {
+	returnValues := m.Called(ctx, pantryItemID, ownerID, input)
+
+	return returnValues.Error(0)
+}
+
+// ArchivePantryItem is a mock method.
+func (m *MockMealPlanningManager) ArchivePantryItem(ctx context.Context, ownerID, pantryItemID string) error {
+	returnValues := m.Called(ctx, ownerID, pantryItemID)
+
+	return returnValues.Error(0)
+}
@@ -4,6 +4,7 @@
 	"context"
 
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/recipeimport"
 
 	"github.com/primandproper/platform/database/filtering"
 )
@@ -86,6 +87,30 @@ func (m *MockMealPlanningManager) MealMermaid(ctx context.Context, meal *mealpla
 	return returnArgs.String(0), returnArgs.Error(1)
 }
 
+func (m *MockMealPlanningManager) MealPlanOptionCookTimeline(ctx context.Context, mealPlanID, mealPlanEventID, mealPlanOptionID string, input *mealplanning.CookTimelineRequestInput) (*mealplanning.CookTimeline, error) {
+	returnValues := m.Called(ctx, mealPlanID, mealPlanEventID, mealPlanOptionID, input)
+
+	return returnValues.Get(0).(*mealplanning.CookTimeline), returnValues.Error(1)
+}
+
+func (m *MockMealPlanningManager) RecipeNutrition(ctx context.Context, recipeID string, scale float32) (*mealplanning.NutritionRollup, error) {
+	returnValues := m.Called(ctx, recipeID, scale)
+
+	return returnValues.Get(0).(*mealplanning.NutritionRollup), returnValues.Error(1)
+}
+
+func (m *MockMealPlanningManager) MealNutrition(ctx context.Context, mealID string, scale float32) (*mealplanning.NutritionRollup, error) {
+	returnValues := m.Called(ctx, mealID, scale)
+
+	return returnValues.Get(0).(*mealplanning.NutritionRollup), returnValues.Error(1)
+}
+
+func (m *MockMealPlanningManager) MealPlanNutrition(ctx context.Context, mealPlanID, ownerID string) (*mealplanning.MealPlanNutritionRollup, error) {
+	returnValues := m.Called(ctx, mealPlanID, ownerID)
+
+	return returnValues.Get(0).(*mealplanning.MealPlanNutritionRollup), returnValues.Error(1)
+}
+
 func (m *MockMealPlanningManager) RecipeMermaid(ctx context.Context, recipeID string) (string, error) {
 	returnValues := m.Called(ctx, recipeID)
 
@@ -98,6 +123,36 @@ func (m *MockMealPlanningManager) CloneRecipe(ctx context.Context, recipeID, new
 	return returnValues.Get(0).(*mealplanning.Recipe), returnValues.Error(1)
 }
 
+func (m *MockMealPlanningManager) ImportRecipe(ctx context.Context, content []byte, format string) (*recipeimport.Result, error) {
+	returnValues := m.Called(ctx, content, format)
+
+	return returnValues.Get(0).(*recipeimport.Result), returnValues.Error(1)
+}
+
+func (m *MockMealPlanningManager) ListRecipeRevisions(ctx context.Context, recipeID string, filter *filtering.QueryFilter) (*filtering.QueryFilteredResult[mealplanning.RecipeRevision], error) {
+	returnValues := m.Called(ctx, recipeID, filter)
+
+	return returnValues.Get(0).(*filtering.QueryFilteredResult[mealplanning.RecipeRevision]), returnValues.Error(1)
+}
+
+func (m *MockMealPlanningManager) ReadRecipeRevision(ctx context.Context, recipeID string, revisionNumber uint32) (*mealplanning.RecipeRevision, error) {
+	returnValues := m.Called(ctx, recipeID, revisionNumber)
+
+	return returnValues.Get(0).(*mealplanning.RecipeRevision), returnValues.Error(1)
+}
+
+func (m *MockMealPlanningManager) DiffRecipeRevisions(ctx context.Context, recipeID string, fromRevision, toRevision uint32) (*mealplanning.RecipeRevisionDiff, error) {
+	returnValues := m.Called(ctx, recipeID, fromRevision, toRevision)
+
+	return returnValues.Get(0).(*mealplanning.RecipeRevisionDiff), returnValues.Error(1)
+}
+
+func (m *MockMealPlanningManager) RestoreRecipeRevision(ctx context.Context, recipeID string, revisionNumber uint32) (*mealplanning.Recipe, error) {
+	returnValues := m.Called(ctx, recipeID, revisionNumber)
+
+	return returnValues.Get(0).(*mealplanning.Recipe), returnValues.Error(1)
+}
+
 func (m *MockMealPlanningManager) RecipeImageUpload(ctx context.Context) error {
 	returnValues := m.Called(ctx)
 
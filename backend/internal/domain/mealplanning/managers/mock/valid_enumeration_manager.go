@@ -88,6 +88,30 @@ func (m *MockMealPlanningManager) SearchValidIngredientMeasurementUnitsByMeasure
 	return returnValues.Get(0).(*filtering.QueryFilteredResult[mealplanning.ValidIngredientMeasurementUnit]), returnValues.Error(1)
 }
 
+func (m *MockMealPlanningManager) CreateValidIngredientNutritionFacts(ctx context.Context, input *mealplanning.ValidIngredientNutritionFactsCreationRequestInput) (*mealplanning.ValidIngredientNutritionFacts, error) {
+	returnValues := m.Called(ctx, input)
+
+	return returnValues.Get(0).(*mealplanning.ValidIngredientNutritionFacts), returnValues.Error(1)
+}
+
+func (m *MockMealPlanningManager) ReadValidIngredientNutritionFactsForIngredient(ctx context.Context, validIngredientID string) (*mealplanning.ValidIngredientNutritionFacts, error) {
+	returnValues := m.Called(ctx, validIngredientID)
+
+	return returnValues.Get(0).(*mealplanning.ValidIngredientNutritionFacts), returnValues.Error(1)
+}
+
+func (m *MockMealPlanningManager) UpdateValidIngredientNutritionFacts(ctx context.Context, validIngredientNutritionFactsID string, input *mealplanning.ValidIngredientNutritionFactsUpdateRequestInput) (*mealplanning.ValidIngredientNutritionFacts, error) {
+	returnValues := m.Called(ctx, validIngredientNutritionFactsID, input)
+
+	return returnValues.Get(0).(*mealplanning.ValidIngredientNutritionFacts), returnValues.Error(1)
+}
+
+func (m *MockMealPlanningManager) ArchiveValidIngredientNutritionFacts(ctx context.Context, validIngredientNutritionFactsID string) error {
+	returnValues := m.Called(ctx, validIngredientNutritionFactsID)
+
+	return returnValues.Error(0)
+}
+
 func (m *MockMealPlanningManager) ListValidIngredientPreparations(ctx context.Context, filter *filtering.QueryFilter) (*filtering.QueryFilteredResult[mealplanning.ValidIngredientPreparation], error) {
 	returnValues := m.Called(ctx, filter)
 
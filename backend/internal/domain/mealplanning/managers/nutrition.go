@@ -0,0 +1,148 @@
+package managers
+
+import (
+	"context"
+	"slices"
+
+	identitykeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity/keys"
+	types "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+	mealplanningkeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/keys"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/recipeanalysis"
+
+	"github.com/primandproper/platform/observability"
+	"github.com/primandproper/platform/observability/tracing"
+)
+
+// collectRecipeIngredientIDs adds the ID of every valid ingredient used by a recipe and its associated recipes to ids.
+func collectRecipeIngredientIDs(recipe *types.Recipe, ids map[string]struct{}) {
+	for _, step := range recipe.Steps {
+		for _, ingredient := range step.Ingredients {
+			if ingredient.Ingredient != nil {
+				ids[ingredient.Ingredient.ID] = struct{}{}
+			}
+		}
+	}
+
+	for _, associatedRecipe := range recipe.AssociatedRecipes {
+		collectRecipeIngredientIDs(associatedRecipe, ids)
+	}
+}
+
+// fetchNutritionInputs fetches the nutrition facts and measurement unit conversions for the given recipes' ingredients.
+func (m *mealPlanningManager) fetchNutritionInputs(ctx context.Context, recipes ...*types.Recipe) (*recipeanalysis.NutritionInputs, error) {
+	ids := map[string]struct{}{}
+	for _, recipe := range recipes {
+		collectRecipeIngredientIDs(recipe, ids)
+	}
+
+	ingredientIDs := make([]string, 0, len(ids))
+	for id := range ids {
+		ingredientIDs = append(ingredientIDs, id)
+	}
+	slices.Sort(ingredientIDs)
+
+	if len(ingredientIDs) == 0 {
+		return &recipeanalysis.NutritionInputs{}, nil
+	}
+
+	facts, err := m.db.GetValidIngredientNutritionFactsForIngredients(ctx, ingredientIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	conversions, err := m.db.GetValidMeasurementUnitConversionsForIngredients(ctx, ingredientIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	return &recipeanalysis.NutritionInputs{
+		Facts:       facts,
+		Conversions: conversions,
+	}, nil
+}
+
+func (m *mealPlanningManager) RecipeNutrition(ctx context.Context, recipeID string, scale float32) (*types.NutritionRollup, error) {
+	ctx, span := m.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := m.logger.WithSpan(span).WithValue(mealplanningkeys.RecipeIDKey, recipeID)
+	tracing.AttachToSpan(span, mealplanningkeys.RecipeIDKey, recipeID)
+
+	recipe, err := m.db.GetRecipe(ctx, recipeID)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching recipe")
+	}
+
+	inputs, err := m.fetchNutritionInputs(ctx, recipe)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching nutrition inputs")
+	}
+
+	return m.recipeAnalyzer.CalculateNutritionForRecipe(ctx, recipe, &recipeanalysis.ConditionalStepState{Scale: scale}, inputs), nil
+}
+
+func (m *mealPlanningManager) MealNutrition(ctx context.Context, mealID string, scale float32) (*types.NutritionRollup, error) {
+	ctx, span := m.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := m.logger.WithSpan(span).WithValue(mealplanningkeys.MealIDKey, mealID)
+	tracing.AttachToSpan(span, mealplanningkeys.MealIDKey, mealID)
+
+	meal, err := m.db.GetMeal(ctx, mealID)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching meal")
+	}
+
+	recipes := make([]*types.Recipe, 0, len(meal.Components))
+	for _, component := range meal.Components {
+		recipes = append(recipes, &component.Recipe)
+	}
+
+	inputs, err := m.fetchNutritionInputs(ctx, recipes...)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching nutrition inputs")
+	}
+
+	return m.recipeAnalyzer.CalculateNutritionForMeal(ctx, meal, &recipeanalysis.ConditionalStepState{Scale: scale}, inputs), nil
+}
+
+func (m *mealPlanningManager) MealPlanNutrition(ctx context.Context, mealPlanID, ownerID string) (*types.MealPlanNutritionRollup, error) {
+	ctx, span := m.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := m.logger.WithSpan(span).WithValues(map[string]any{
+		mealplanningkeys.MealPlanIDKey: mealPlanID,
+		identitykeys.UserIDKey:         ownerID,
+	})
+	tracing.AttachToSpan(span, mealplanningkeys.MealPlanIDKey, mealPlanID)
+	tracing.AttachToSpan(span, identitykeys.UserIDKey, ownerID)
+
+	mealPlan, err := m.db.GetMealPlan(ctx, mealPlanID, ownerID)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching meal plan")
+	}
+
+	if mealPlan.Status != string(types.MealPlanStatusFinalized) {
+		return nil, types.ErrMealPlanNotFinalized
+	}
+
+	recipes := []*types.Recipe{}
+	for _, event := range mealPlan.Events {
+		for _, option := range event.Options {
+			if !option.Chosen {
+				continue
+			}
+
+			for _, component := range option.MealWithPinnedRecipes().Components {
+				recipes = append(recipes, &component.Recipe)
+			}
+		}
+	}
+
+	inputs, err := m.fetchNutritionInputs(ctx, recipes...)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching nutrition inputs")
+	}
+
+	return m.recipeAnalyzer.CalculateNutritionForMealPlan(ctx, mealPlan, inputs), nil
+}
@@ -0,0 +1,182 @@
+package managers
+
+import (
+	"errors"
+	"testing"
+
+	types "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/fakes"
+	mealplanningmock "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/mocks"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/recipeanalysis"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/testutils"
+
+	"github.com/primandproper/platform/reflection"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestRecipeManager_RecipeNutrition(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		rm := buildRecipeManagerForTest(t)
+
+		exampleRecipe := fakes.BuildFakeRecipe()
+		exampleIngredient := fakes.BuildFakeRecipeStepIngredient()
+		exampleRecipe.Steps = []*types.RecipeStep{{ID: fakes.BuildFakeID(), Ingredients: []*types.RecipeStepIngredient{exampleIngredient}}}
+		exampleFacts := []*types.ValidIngredientNutritionFacts{fakes.BuildFakeValidIngredientNutritionFacts()}
+		exampleConversions := fakes.BuildFakeValidMeasurementUnitConversionsList().Data
+		expected := fakes.BuildFakeNutritionRollup()
+
+		expectations := setupExpectationsForRecipeManagerWithAnalyzer(
+			rm,
+			func(db *mealplanningmock.Repository) {
+				db.On(reflection.GetMethodName(rm.db.GetRecipe), testutils.ContextMatcher, exampleRecipe.ID).Return(exampleRecipe, nil)
+				db.On(reflection.GetMethodName(rm.db.GetValidIngredientNutritionFactsForIngredients), testutils.ContextMatcher, []string{exampleIngredient.Ingredient.ID}).Return(exampleFacts, nil)
+				db.On(reflection.GetMethodName(rm.db.GetValidMeasurementUnitConversionsForIngredients), testutils.ContextMatcher, []string{exampleIngredient.Ingredient.ID}).Return(exampleConversions, nil)
+			},
+			func(analyzer *recipeanalysis.MockRecipeAnalyzer) {
+				analyzer.On(
+					reflection.GetMethodName(analyzer.CalculateNutritionForRecipe),
+					testutils.ContextMatcher,
+					exampleRecipe,
+					&recipeanalysis.ConditionalStepState{Scale: 2},
+					&recipeanalysis.NutritionInputs{Facts: exampleFacts, Conversions: exampleConversions},
+				).Return(expected)
+			},
+		)
+
+		actual, err := rm.RecipeNutrition(ctx, exampleRecipe.ID, 2)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, actual)
+
+		mock.AssertExpectationsForObjects(t, expectations...)
+	})
+
+	T.Run("with error fetching recipe", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		rm := buildRecipeManagerForTest(t)
+
+		exampleRecipeID := fakes.BuildFakeID()
+
+		expectations := setupExpectationsForRecipeManager(
+			rm,
+			func(db *mealplanningmock.Repository) {
+				db.On(reflection.GetMethodName(rm.db.GetRecipe), testutils.ContextMatcher, exampleRecipeID).Return((*types.Recipe)(nil), errors.New("blah"))
+			},
+		)
+
+		actual, err := rm.RecipeNutrition(ctx, exampleRecipeID, 1)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+
+		mock.AssertExpectationsForObjects(t, expectations...)
+	})
+}
+
+func TestRecipeManager_MealNutrition(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		rm := buildRecipeManagerForTest(t)
+
+		exampleMeal := fakes.BuildFakeMeal()
+		expected := fakes.BuildFakeNutritionRollup()
+
+		expectations := setupExpectationsForRecipeManagerWithAnalyzer(
+			rm,
+			func(db *mealplanningmock.Repository) {
+				db.On(reflection.GetMethodName(rm.db.GetMeal), testutils.ContextMatcher, exampleMeal.ID).Return(exampleMeal, nil)
+				db.On(reflection.GetMethodName(rm.db.GetValidIngredientNutritionFactsForIngredients), testutils.ContextMatcher, testutils.MatchType[[]string]()).Return([]*types.ValidIngredientNutritionFacts{}, nil).Maybe()
+				db.On(reflection.GetMethodName(rm.db.GetValidMeasurementUnitConversionsForIngredients), testutils.ContextMatcher, testutils.MatchType[[]string]()).Return([]*types.ValidMeasurementUnitConversion{}, nil).Maybe()
+			},
+			func(analyzer *recipeanalysis.MockRecipeAnalyzer) {
+				analyzer.On(
+					reflection.GetMethodName(analyzer.CalculateNutritionForMeal),
+					testutils.ContextMatcher,
+					exampleMeal,
+					&recipeanalysis.ConditionalStepState{Scale: 1},
+					testutils.MatchType[*recipeanalysis.NutritionInputs](),
+				).Return(expected)
+			},
+		)
+
+		actual, err := rm.MealNutrition(ctx, exampleMeal.ID, 1)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, actual)
+
+		mock.AssertExpectationsForObjects(t, expectations...)
+	})
+}
+
+func TestMealPlanManager_MealPlanNutrition(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		rm := buildRecipeManagerForTest(t)
+
+		exampleOwnerID := fakes.BuildFakeID()
+		exampleMealPlan := fakes.BuildFakeMealPlan()
+		exampleMealPlan.Status = string(types.MealPlanStatusFinalized)
+		expected := fakes.BuildFakeMealPlanNutritionRollup()
+
+		expectations := setupExpectationsForRecipeManagerWithAnalyzer(
+			rm,
+			func(db *mealplanningmock.Repository) {
+				db.On(reflection.GetMethodName(rm.db.GetMealPlan), testutils.ContextMatcher, exampleMealPlan.ID, exampleOwnerID).Return(exampleMealPlan, nil)
+				db.On(reflection.GetMethodName(rm.db.GetValidIngredientNutritionFactsForIngredients), testutils.ContextMatcher, testutils.MatchType[[]string]()).Return([]*types.ValidIngredientNutritionFacts{}, nil).Maybe()
+				db.On(reflection.GetMethodName(rm.db.GetValidMeasurementUnitConversionsForIngredients), testutils.ContextMatcher, testutils.MatchType[[]string]()).Return([]*types.ValidMeasurementUnitConversion{}, nil).Maybe()
+			},
+			func(analyzer *recipeanalysis.MockRecipeAnalyzer) {
+				analyzer.On(
+					reflection.GetMethodName(analyzer.CalculateNutritionForMealPlan),
+					testutils.ContextMatcher,
+					exampleMealPlan,
+					testutils.MatchType[*recipeanalysis.NutritionInputs](),
+				).Return(expected)
+			},
+		)
+
+		actual, err := rm.MealPlanNutrition(ctx, exampleMealPlan.ID, exampleOwnerID)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, actual)
+
+		mock.AssertExpectationsForObjects(t, expectations...)
+	})
+
+	T.Run("with unfinalized meal plan", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		rm := buildRecipeManagerForTest(t)
+
+		exampleOwnerID := fakes.BuildFakeID()
+		exampleMealPlan := fakes.BuildFakeMealPlan()
+		exampleMealPlan.Status = string(types.MealPlanStatusAwaitingVotes)
+
+		expectations := setupExpectationsForRecipeManager(
+			rm,
+			func(db *mealplanningmock.Repository) {
+				db.On(reflection.GetMethodName(rm.db.GetMealPlan), testutils.ContextMatcher, exampleMealPlan.ID, exampleOwnerID).Return(exampleMealPlan, nil)
+			},
+		)
+
+		actual, err := rm.MealPlanNutrition(ctx, exampleMealPlan.ID, exampleOwnerID)
+		assert.ErrorIs(t, err, types.ErrMealPlanNotFinalized)
+		assert.Nil(t, actual)
+
+		mock.AssertExpectationsForObjects(t, expectations...)
+	})
+}
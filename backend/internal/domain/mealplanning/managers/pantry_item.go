@@ -0,0 +1,161 @@
+package managers
+
+import (
+	"context"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/audit"
+	identitykeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity/keys"
+	types "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/converters"
+	mealplanningkeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/keys"
+
+	"github.com/primandproper/platform/database/filtering"
+	platformerrors "github.com/primandproper/platform/errors"
+	"github.com/primandproper/platform/identifiers"
+	"github.com/primandproper/platform/observability"
+	"github.com/primandproper/platform/observability/tracing"
+)
+
+func (m *mealPlanningManager) ListPantryItems(ctx context.Context, ownerID string, filter *filtering.QueryFilter) (*filtering.QueryFilteredResult[types.PantryItem], error) {
+	ctx, span := m.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if filter == nil {
+		filter = filtering.DefaultQueryFilter()
+	}
+
+	logger := m.logger.WithSpan(span).WithValue(identitykeys.AccountIDKey, ownerID)
+	tracing.AttachToSpan(span, identitykeys.AccountIDKey, ownerID)
+
+	results, err := m.db.GetPantryItems(ctx, ownerID, filter)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching pantry items")
+	}
+
+	return results, nil
+}
+
+func (m *mealPlanningManager) CreatePantryItem(ctx context.Context, ownerID string, input *types.PantryItemCreationRequestInput) (*types.PantryItem, error) {
+	ctx, span := m.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if input == nil {
+		return nil, platformerrors.ErrNilInputParameter
+	}
+
+	convertedInput := converters.ConvertPantryItemCreationRequestInputToPantryItemDatabaseCreationInput(input)
+	convertedInput.BelongsToAccount = ownerID
+
+	logger := m.logger.WithSpan(span).WithValue(mealplanningkeys.PantryItemIDKey, convertedInput.ID)
+	tracing.AttachToSpan(span, mealplanningkeys.PantryItemIDKey, convertedInput.ID)
+
+	created, err := m.db.CreatePantryItem(ctx, convertedInput)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "creating pantry item")
+	}
+
+	m.dataChangesPublisher.PublishAsync(ctx, audit.BuildDataChangeMessageFromContext(ctx, logger, types.PantryItemCreatedServiceEventType, map[string]any{
+		mealplanningkeys.PantryItemIDKey: convertedInput.ID,
+	}))
+
+	return created, nil
+}
+
+func (m *mealPlanningManager) ReadPantryItem(ctx context.Context, ownerID, pantryItemID string) (*types.PantryItem, error) {
+	ctx, span := m.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := m.logger.WithSpan(span).WithValues(map[string]any{
+		identitykeys.AccountIDKey:        ownerID,
+		mealplanningkeys.PantryItemIDKey: pantryItemID,
+	})
+	tracing.AttachToSpan(span, identitykeys.AccountIDKey, ownerID)
+	tracing.AttachToSpan(span, mealplanningkeys.PantryItemIDKey, pantryItemID)
+
+	result, err := m.db.GetPantryItem(ctx, pantryItemID, ownerID)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching pantry item")
+	}
+
+	return result, nil
+}
+
+func (m *mealPlanningManager) UpdatePantryItem(ctx context.Context, pantryItemID, ownerID string, input *types.PantryItemUpdateRequestInput) error {
+	ctx, span := m.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if input == nil {
+		return platformerrors.ErrNilInputParameter
+	}
+
+	logger := m.logger.WithSpan(span).WithValues(map[string]any{
+		identitykeys.AccountIDKey:        ownerID,
+		mealplanningkeys.PantryItemIDKey: pantryItemID,
+	})
+	tracing.AttachToSpan(span, identitykeys.AccountIDKey, ownerID)
+	tracing.AttachToSpan(span, mealplanningkeys.PantryItemIDKey, pantryItemID)
+
+	existingPantryItem, err := m.db.GetPantryItem(ctx, pantryItemID, ownerID)
+	if err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "fetching pantry item to update")
+	}
+
+	existingPantryItem.Update(input)
+	if err = m.db.UpdatePantryItem(ctx, existingPantryItem); err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "updating pantry item")
+	}
+
+	m.dataChangesPublisher.PublishAsync(ctx, audit.BuildDataChangeMessageFromContext(ctx, logger, types.PantryItemUpdatedServiceEventType, map[string]any{
+		mealplanningkeys.PantryItemIDKey: pantryItemID,
+	}))
+
+	return nil
+}
+
+func (m *mealPlanningManager) ArchivePantryItem(ctx context.Context, ownerID, pantryItemID string) error {
+	ctx, span := m.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := m.logger.WithSpan(span).WithValues(map[string]any{
+		identitykeys.AccountIDKey:        ownerID,
+		mealplanningkeys.PantryItemIDKey: pantryItemID,
+	})
+	tracing.AttachToSpan(span, identitykeys.AccountIDKey, ownerID)
+	tracing.AttachToSpan(span, mealplanningkeys.PantryItemIDKey, pantryItemID)
+
+	if err := m.db.ArchivePantryItem(ctx, pantryItemID, ownerID); err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "archiving pantry item")
+	}
+
+	m.dataChangesPublisher.PublishAsync(ctx, audit.BuildDataChangeMessageFromContext(ctx, logger, types.PantryItemArchivedServiceEventType, map[string]any{
+		mealplanningkeys.PantryItemIDKey: pantryItemID,
+	}))
+
+	return nil
+}
+
+// buildPantryItemForAcquiredGroceryListItem builds the pantry item that stocks the pantry of the account that owns the meal plan
+// with a newly acquired grocery list item. It is written alongside the grocery list item's update.
+func (m *mealPlanningManager) buildPantryItemForAcquiredGroceryListItem(ctx context.Context, mealPlanID string, item *types.MealPlanGroceryListItem) (*types.PantryItemDatabaseCreationInput, error) {
+	accountID, err := m.db.GetAccountIDForMealPlan(ctx, mealPlanID)
+	if err != nil {
+		return nil, err
+	}
+
+	input := &types.PantryItemDatabaseCreationInput{
+		ID:                     identifiers.New(),
+		StorageLocation:        types.PantryStorageLocationPantry,
+		ValidIngredientID:      item.Ingredient.ID,
+		ValidMeasurementUnitID: item.MeasurementUnit.ID,
+		Quantity:               item.MinQuantityNeeded,
+		BelongsToAccount:       accountID,
+	}
+
+	// prefer what was actually bought over what the grocery list asked for.
+	if item.PurchasedMeasurementUnit != nil && item.QuantityPurchased != nil {
+		input.ValidMeasurementUnitID = item.PurchasedMeasurementUnit.ID
+		input.Quantity = *item.QuantityPurchased
+	}
+
+	return input, nil
+}
@@ -0,0 +1,176 @@
+package managers
+
+import (
+	"testing"
+
+	types "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/fakes"
+	mealplanningkeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/keys"
+	mealplanningmock "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/mocks"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/testutils"
+
+	"github.com/primandproper/platform/reflection"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestMealPlanningManager_ListPantryItems(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		mpm := buildMealPlanManagerForTest(t)
+
+		expected := fakes.BuildFakePantryItemsList()
+		exampleOwnerID := fakes.BuildFakeID()
+
+		expectations := setupExpectationsForMealPlanningManager(
+			mpm,
+			func(db *mealplanningmock.Repository) {
+				db.On(reflection.GetMethodName(mpm.db.GetPantryItems), testutils.ContextMatcher, exampleOwnerID, testutils.QueryFilterMatcher).Return(expected, nil)
+			},
+		)
+
+		actual, err := mpm.ListPantryItems(ctx, exampleOwnerID, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, actual)
+
+		mock.AssertExpectationsForObjects(t, expectations...)
+	})
+}
+
+func TestMealPlanningManager_CreatePantryItem(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		mpm := buildMealPlanManagerForTest(t)
+
+		fakeOwnerID := fakes.BuildFakeID()
+		expected := fakes.BuildFakePantryItem()
+		fakeInput := fakes.BuildFakePantryItemCreationRequestInput()
+
+		expectations := setupExpectationsForMealPlanningManager(
+			mpm,
+			func(db *mealplanningmock.Repository) {
+				db.On(reflection.GetMethodName(mpm.db.CreatePantryItem), testutils.ContextMatcher, mock.MatchedBy(func(input *types.PantryItemDatabaseCreationInput) bool {
+					return input.BelongsToAccount == fakeOwnerID && input.ValidIngredientID == fakeInput.ValidIngredientID
+				})).Return(expected, nil)
+			},
+			map[string][]string{
+				types.PantryItemCreatedServiceEventType: {mealplanningkeys.PantryItemIDKey},
+			},
+		)
+
+		actual, err := mpm.CreatePantryItem(ctx, fakeOwnerID, fakeInput)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, actual)
+
+		mock.AssertExpectationsForObjects(t, expectations...)
+	})
+
+	T.Run("with nil input", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		mpm := buildMealPlanManagerForTest(t)
+
+		actual, err := mpm.CreatePantryItem(ctx, fakes.BuildFakeID(), nil)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
+
+func TestMealPlanningManager_ReadPantryItem(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		mpm := buildMealPlanManagerForTest(t)
+
+		ownerID := fakes.BuildFakeID()
+		expected := fakes.BuildFakePantryItem()
+
+		expectations := setupExpectationsForMealPlanningManager(
+			mpm,
+			func(db *mealplanningmock.Repository) {
+				db.On(reflection.GetMethodName(mpm.db.GetPantryItem), testutils.ContextMatcher, expected.ID, ownerID).Return(expected, nil)
+			},
+		)
+
+		actual, err := mpm.ReadPantryItem(ctx, ownerID, expected.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, actual)
+
+		mock.AssertExpectationsForObjects(t, expectations...)
+	})
+}
+
+func TestMealPlanningManager_UpdatePantryItem(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		mpm := buildMealPlanManagerForTest(t)
+
+		examplePantryItem := fakes.BuildFakePantryItem()
+		ownerID := fakes.BuildFakeID()
+		exampleInput := fakes.BuildFakePantryItemUpdateRequestInput()
+
+		expectations := setupExpectationsForMealPlanningManager(
+			mpm,
+			func(db *mealplanningmock.Repository) {
+				db.On(reflection.GetMethodName(mpm.db.GetPantryItem), testutils.ContextMatcher, examplePantryItem.ID, ownerID).Return(examplePantryItem, nil)
+				db.On(reflection.GetMethodName(mpm.db.UpdatePantryItem), testutils.ContextMatcher, testutils.MatchType[*types.PantryItem]()).Return(nil)
+			},
+			map[string][]string{
+				types.PantryItemUpdatedServiceEventType: {
+					mealplanningkeys.PantryItemIDKey,
+				},
+			},
+		)
+
+		assert.NoError(t, mpm.UpdatePantryItem(ctx, examplePantryItem.ID, ownerID, exampleInput))
+
+		mock.AssertExpectationsForObjects(t, expectations...)
+	})
+}
+
+func TestMealPlanningManager_ArchivePantryItem(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		mpm := buildMealPlanManagerForTest(t)
+
+		ownerID := fakes.BuildFakeID()
+		expected := fakes.BuildFakePantryItem()
+
+		expectations := setupExpectationsForMealPlanningManager(
+			mpm,
+			func(db *mealplanningmock.Repository) {
+				db.On(reflection.GetMethodName(mpm.db.ArchivePantryItem), testutils.ContextMatcher, expected.ID, ownerID).Return(nil)
+			},
+			map[string][]string{
+				types.PantryItemArchivedServiceEventType: {
+					mealplanningkeys.PantryItemIDKey,
+				},
+			},
+		)
+
+		assert.NoError(t, mpm.ArchivePantryItem(ctx, ownerID, expected.ID))
+
+		mock.AssertExpectationsForObjects(t, expectations...)
+	})
+}
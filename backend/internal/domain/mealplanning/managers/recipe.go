@@ -8,6 +8,8 @@
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/converters"
 	mealplanningkeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/keys"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/recipeanalysis"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/recipeimport"
 
 	"github.com/primandproper/platform/database/filtering"
 	platformerrors "github.com/primandproper/platform/errors"
@@ -296,7 +298,7 @@ func (m *mealPlanningManager) RecipeEstimatedPrepSteps(ctx context.Context, reci
 		return nil, observability.PrepareAndLogError(err, logger, span, "retrieving recipe")
 	}
 
-	stepInputs, err := m.recipeAnalyzer.GenerateMealPlanTasksForRecipe(ctx, "", x)
+	stepInputs, err := m.recipeAnalyzer.GenerateMealPlanTasksForRecipe(ctx, "", x, nil)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "generating meal plan tasks")
 	}
@@ -325,6 +327,77 @@ func (m *mealPlanningManager) MealMermaid(ctx context.Context, meal *mealplannin
 	return m.recipeAnalyzer.RenderMermaidDiagramForMeal(ctx, meal), nil
 }
 
+func (m *mealPlanningManager) MealPlanOptionCookTimeline(ctx context.Context, mealPlanID, mealPlanEventID, mealPlanOptionID string, input *mealplanning.CookTimelineRequestInput) (*mealplanning.CookTimeline, error) {
+	ctx, span := m.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if input == nil {
+		return nil, platformerrors.ErrNilInputParameter
+	}
+
+	logger := m.logger.WithSpan(span).WithValues(map[string]any{
+		mealplanningkeys.MealPlanIDKey:       mealPlanID,
+		mealplanningkeys.MealPlanEventIDKey:  mealPlanEventID,
+		mealplanningkeys.MealPlanOptionIDKey: mealPlanOptionID,
+	})
+	tracing.AttachToSpan(span, mealplanningkeys.MealPlanIDKey, mealPlanID)
+	tracing.AttachToSpan(span, mealplanningkeys.MealPlanEventIDKey, mealPlanEventID)
+	tracing.AttachToSpan(span, mealplanningkeys.MealPlanOptionIDKey, mealPlanOptionID)
+
+	if err := input.ValidateWithContext(ctx); err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "validating cook timeline input")
+	}
+
+	mealPlanEvent, err := m.db.GetMealPlanEvent(ctx, mealPlanID, mealPlanEventID)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching meal plan event")
+	}
+
+	mealPlanOption, err := m.db.GetMealPlanOption(ctx, mealPlanID, mealPlanEventID, mealPlanOptionID)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching meal plan option")
+	}
+
+	// the timeline follows the recipe revisions the option was pinned to, not whatever the recipes look like now.
+	meal := mealPlanOption.MealWithPinnedRecipes()
+
+	filter := filtering.DefaultQueryFilter()
+	maxSize := uint8(filtering.MaxQueryFilterLimit)
+	filter.MaxResponseSize = &maxSize
+	selections, err := m.db.GetSelectionsForMealPlanOption(ctx, mealPlanOptionID, filter)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching meal plan recipe option selections")
+	}
+
+	constraints := &recipeanalysis.CookTimelineConstraints{
+		ServingTime:          mealPlanEvent.StartsAt,
+		CookCount:            input.CookCount,
+		MealScale:            mealPlanOption.MealScale,
+		Selections:           selections.Data,
+		InstrumentCapacities: map[string]uint32{},
+		VesselCapacities:     map[string]uint32{},
+	}
+	if input.ServingTime != nil {
+		constraints.ServingTime = *input.ServingTime
+	}
+	for _, constraint := range input.InstrumentConstraints {
+		constraints.InstrumentCapacities[constraint.ID] = constraint.Quantity
+	}
+	for _, constraint := range input.VesselConstraints {
+		constraints.VesselCapacities[constraint.ID] = constraint.Quantity
+	}
+
+	timeline, err := m.recipeAnalyzer.ScheduleCookTimelineForMeal(ctx, meal, constraints)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "scheduling cook timeline")
+	}
+
+	timeline.MealPlanOptionID = mealPlanOptionID
+	timeline.MermaidGanttChart = m.recipeAnalyzer.RenderMermaidGanttChartForCookTimeline(ctx, timeline)
+
+	return timeline, nil
+}
+
 func (m *mealPlanningManager) RecipeMermaid(ctx context.Context, recipeID string) (string, error) {
 	ctx, span := m.tracer.StartSpan(ctx)
 	defer span.End()
@@ -368,6 +441,27 @@ func (m *mealPlanningManager) CloneRecipe(ctx context.Context, recipeID, newOwne
 	return newRecipe, nil
 }
 
+// ImportRecipe builds a draft recipe from a schema.org Recipe or plain-text recipe, resolving its ingredients,
+// measurement units, and preparations against the valid enumerations. Nothing is saved; the caller reviews the
+// draft and its report before creating it.
+func (m *mealPlanningManager) ImportRecipe(ctx context.Context, content []byte, format string) (*recipeimport.Result, error) {
+	ctx, span := m.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if format == "" {
+		format = string(recipeimport.FormatAuto)
+	}
+
+	logger := m.logger.WithSpan(span).WithValue("format", format)
+
+	result, err := recipeimport.NewImporter(m.db).Import(ctx, content, recipeimport.Format(format))
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "importing recipe")
+	}
+
+	return result, nil
+}
+
 func cloneRecipe(x *mealplanning.Recipe, userID string) *mealplanning.RecipeDatabaseCreationInput {
 	ingredientProductIndices := map[string]int{}
 	instrumentProductIndices := map[string]int{}
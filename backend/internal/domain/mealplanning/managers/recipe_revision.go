@@ -0,0 +1,138 @@
+package managers
+
+import (
+	"context"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/audit"
+	types "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+	mealplanningkeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/keys"
+
+	"github.com/primandproper/platform/database/filtering"
+	"github.com/primandproper/platform/observability"
+	"github.com/primandproper/platform/observability/tracing"
+)
+
+func (m *mealPlanningManager) ListRecipeRevisions(ctx context.Context, recipeID string, filter *filtering.QueryFilter) (*filtering.QueryFilteredResult[types.RecipeRevision], error) {
+	ctx, span := m.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := m.logger.WithSpan(span).WithValue(mealplanningkeys.RecipeIDKey, recipeID)
+	tracing.AttachToSpan(span, mealplanningkeys.RecipeIDKey, recipeID)
+
+	if filter == nil {
+		filter = filtering.DefaultQueryFilter()
+	}
+	tracing.AttachQueryFilterToSpan(span, filter)
+	logger = filter.AttachToLogger(logger)
+
+	results, err := m.db.GetRecipeRevisions(ctx, recipeID, filter)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching list of recipe revisions")
+	}
+
+	return results, nil
+}
+
+func (m *mealPlanningManager) ReadRecipeRevision(ctx context.Context, recipeID string, revisionNumber uint32) (*types.RecipeRevision, error) {
+	ctx, span := m.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := m.logger.WithSpan(span).WithValues(map[string]any{
+		mealplanningkeys.RecipeIDKey:             recipeID,
+		mealplanningkeys.RecipeRevisionNumberKey: revisionNumber,
+	})
+	tracing.AttachToSpan(span, mealplanningkeys.RecipeIDKey, recipeID)
+	tracing.AttachToSpan(span, mealplanningkeys.RecipeRevisionNumberKey, revisionNumber)
+
+	x, err := m.db.GetRecipeRevision(ctx, recipeID, revisionNumber)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "retrieving recipe revision")
+	}
+
+	return x, nil
+}
+
+func (m *mealPlanningManager) DiffRecipeRevisions(ctx context.Context, recipeID string, fromRevision, toRevision uint32) (*types.RecipeRevisionDiff, error) {
+	ctx, span := m.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := m.logger.WithSpan(span).WithValues(map[string]any{
+		mealplanningkeys.RecipeIDKey: recipeID,
+		"from_revision":              fromRevision,
+		"to_revision":                toRevision,
+	})
+	tracing.AttachToSpan(span, mealplanningkeys.RecipeIDKey, recipeID)
+
+	from, err := m.db.GetRecipeRevision(ctx, recipeID, fromRevision)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "retrieving recipe revision to diff from")
+	}
+
+	to, err := m.db.GetRecipeRevision(ctx, recipeID, toRevision)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "retrieving recipe revision to diff to")
+	}
+
+	diff := types.DiffRecipes(from.Snapshot, to.Snapshot)
+	diff.RecipeID = recipeID
+	diff.FromRevision = fromRevision
+	diff.ToRevision = toRevision
+
+	return diff, nil
+}
+
+// RestoreRecipeRevision makes an old revision's contents the live recipe again. Restoring doesn't rewind history; it
+// records a new revision whose contents match the old one.
+func (m *mealPlanningManager) RestoreRecipeRevision(ctx context.Context, recipeID string, revisionNumber uint32) (*types.Recipe, error) {
+	ctx, span := m.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := m.logger.WithSpan(span).WithValues(map[string]any{
+		mealplanningkeys.RecipeIDKey:             recipeID,
+		mealplanningkeys.RecipeRevisionNumberKey: revisionNumber,
+	})
+	tracing.AttachToSpan(span, mealplanningkeys.RecipeIDKey, recipeID)
+	tracing.AttachToSpan(span, mealplanningkeys.RecipeRevisionNumberKey, revisionNumber)
+
+	revision, err := m.db.GetRecipeRevision(ctx, recipeID, revisionNumber)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "retrieving recipe revision")
+	}
+
+	live, err := m.db.GetRecipe(ctx, recipeID)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "retrieving recipe")
+	}
+
+	snapshot := revision.Snapshot
+	live.Name = snapshot.Name
+	live.Slug = snapshot.Slug
+	live.Source = snapshot.Source
+	live.SourceISBN = snapshot.SourceISBN
+	live.Description = snapshot.Description
+	live.InspiredByRecipeID = snapshot.InspiredByRecipeID
+	live.MinEstimatedPortions = snapshot.MinEstimatedPortions
+	live.MaxEstimatedPortions = snapshot.MaxEstimatedPortions
+	live.PortionName = snapshot.PortionName
+	live.PluralPortionName = snapshot.PluralPortionName
+	live.EligibleForMeals = snapshot.EligibleForMeals
+	live.YieldsComponentType = snapshot.YieldsComponentType
+
+	// the revision's steps are recreated with fresh IDs, exactly as they would be for a clone.
+	restored := cloneRecipe(snapshot, live.CreatedByUser)
+	if err = m.db.RestoreRecipeRevision(ctx, live, restored.Steps); err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "restoring recipe revision")
+	}
+
+	recipe, err := m.db.GetRecipe(ctx, recipeID)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "retrieving restored recipe")
+	}
+
+	m.dataChangesPublisher.PublishAsync(ctx, audit.BuildDataChangeMessageFromContext(ctx, logger, types.RecipeRevisionRestoredServiceEventType, map[string]any{
+		mealplanningkeys.RecipeIDKey:             recipeID,
+		mealplanningkeys.RecipeRevisionNumberKey: revisionNumber,
+	}))
+
+	return recipe, nil
+}
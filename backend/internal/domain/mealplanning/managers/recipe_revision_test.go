@@ -0,0 +1,143 @@
+package managers
+
+import (
+	"testing"
+
+	types "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/fakes"
+	mealplanningkeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/keys"
+	mealplanningmock "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/mocks"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/testutils"
+
+	"github.com/primandproper/platform/reflection"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRecipeManager_ListRecipeRevisions(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		rm := buildRecipeManagerForTest(t)
+
+		exampleRecipeID := fakes.BuildFakeID()
+		expected := fakes.BuildFakeRecipeRevisionsList()
+
+		expectations := setupExpectationsForRecipeManager(
+			rm,
+			func(db *mealplanningmock.Repository) {
+				db.On(reflection.GetMethodName(rm.db.GetRecipeRevisions), testutils.ContextMatcher, exampleRecipeID, testutils.QueryFilterMatcher).Return(expected, nil)
+			},
+		)
+
+		actual, err := rm.ListRecipeRevisions(ctx, exampleRecipeID, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, actual)
+
+		mock.AssertExpectationsForObjects(t, expectations...)
+	})
+}
+
+func TestRecipeManager_ReadRecipeRevision(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		rm := buildRecipeManagerForTest(t)
+
+		expected := fakes.BuildFakeRecipeRevision()
+
+		expectations := setupExpectationsForRecipeManager(
+			rm,
+			func(db *mealplanningmock.Repository) {
+				db.On(reflection.GetMethodName(rm.db.GetRecipeRevision), testutils.ContextMatcher, expected.BelongsToRecipe, expected.RevisionNumber).Return(expected, nil)
+			},
+		)
+
+		actual, err := rm.ReadRecipeRevision(ctx, expected.BelongsToRecipe, expected.RevisionNumber)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, actual)
+
+		mock.AssertExpectationsForObjects(t, expectations...)
+	})
+}
+
+func TestRecipeManager_DiffRecipeRevisions(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		rm := buildRecipeManagerForTest(t)
+
+		from := fakes.BuildFakeRecipeRevision()
+		to := fakes.BuildFakeRecipeRevision()
+		to.BelongsToRecipe = from.BelongsToRecipe
+		to.RevisionNumber = 2
+		to.Snapshot.ID = from.Snapshot.ID
+
+		expectations := setupExpectationsForRecipeManager(
+			rm,
+			func(db *mealplanningmock.Repository) {
+				db.On(reflection.GetMethodName(rm.db.GetRecipeRevision), testutils.ContextMatcher, from.BelongsToRecipe, from.RevisionNumber).Return(from, nil)
+				db.On(reflection.GetMethodName(rm.db.GetRecipeRevision), testutils.ContextMatcher, from.BelongsToRecipe, to.RevisionNumber).Return(to, nil)
+			},
+		)
+
+		actual, err := rm.DiffRecipeRevisions(ctx, from.BelongsToRecipe, from.RevisionNumber, to.RevisionNumber)
+		require.NoError(t, err)
+		require.NotNil(t, actual)
+
+		assert.Equal(t, from.BelongsToRecipe, actual.RecipeID)
+		assert.Equal(t, from.RevisionNumber, actual.FromRevision)
+		assert.Equal(t, to.RevisionNumber, actual.ToRevision)
+		assert.NotEmpty(t, actual.Changes)
+
+		mock.AssertExpectationsForObjects(t, expectations...)
+	})
+}
+
+func TestRecipeManager_RestoreRecipeRevision(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		rm := buildRecipeManagerForTest(t)
+
+		exampleRecipe := fakes.BuildFakeRecipe()
+		exampleRevision := fakes.BuildFakeRecipeRevision()
+		exampleRevision.BelongsToRecipe = exampleRecipe.ID
+
+		expectations := setupExpectationsForRecipeManager(
+			rm,
+			func(db *mealplanningmock.Repository) {
+				db.On(reflection.GetMethodName(rm.db.GetRecipeRevision), testutils.ContextMatcher, exampleRecipe.ID, exampleRevision.RevisionNumber).Return(exampleRevision, nil)
+				db.On(reflection.GetMethodName(rm.db.GetRecipe), testutils.ContextMatcher, exampleRecipe.ID).Return(exampleRecipe, nil)
+				db.On(reflection.GetMethodName(rm.db.RestoreRecipeRevision), testutils.ContextMatcher, testutils.MatchType[*types.Recipe](), testutils.MatchType[[]*types.RecipeStepDatabaseCreationInput]()).Return(nil)
+			},
+			map[string][]string{
+				types.RecipeRevisionRestoredServiceEventType: {
+					mealplanningkeys.RecipeIDKey,
+					mealplanningkeys.RecipeRevisionNumberKey,
+				},
+			},
+		)
+
+		actual, err := rm.RestoreRecipeRevision(ctx, exampleRecipe.ID, exampleRevision.RevisionNumber)
+		assert.NoError(t, err)
+		assert.Equal(t, exampleRecipe, actual)
+		assert.Equal(t, exampleRevision.Snapshot.Name, actual.Name)
+
+		mock.AssertExpectationsForObjects(t, expectations...)
+	})
+}
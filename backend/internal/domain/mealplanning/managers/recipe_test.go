@@ -9,6 +9,7 @@
 	mealplanningkeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/keys"
 	mealplanningmock "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/mocks"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/recipeanalysis"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/recipeimport"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/testutils"
 
 	"github.com/primandproper/platform/reflection"
@@ -257,7 +258,7 @@ func(db *mealplanningmock.Repository) {
 				db.On(reflection.GetMethodName(rm.db.GetRecipe), testutils.ContextMatcher, exampleRecipe.ID).Return(exampleRecipe, nil)
 			},
 			func(analyzer *recipeanalysis.MockRecipeAnalyzer) {
-				analyzer.On(reflection.GetMethodName(analyzer.GenerateMealPlanTasksForRecipe), testutils.ContextMatcher, "", testutils.MatchType[*types.Recipe]()).Return(expectedResults, nil)
+				analyzer.On(reflection.GetMethodName(analyzer.GenerateMealPlanTasksForRecipe), testutils.ContextMatcher, "", testutils.MatchType[*types.Recipe](), (*recipeanalysis.ConditionalStepState)(nil)).Return(expectedResults, nil)
 			},
 		)
 
@@ -298,6 +299,101 @@ func(analyzer *recipeanalysis.MockRecipeAnalyzer) {
 	})
 }
 
+func TestRecipeManager_MealPlanOptionCookTimeline(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		rm := buildRecipeManagerForTest(t)
+
+		exampleMealPlanID := fakes.BuildFakeID()
+		exampleMealPlanEvent := fakes.BuildFakeMealPlanEvent()
+		exampleMealPlanOption := fakes.BuildFakeMealPlanOption()
+		exampleMeal := fakes.BuildFakeMeal()
+		exampleMealPlanOption.Meal = *exampleMeal
+		exampleSelections := fakes.BuildFakeMealPlanRecipeOptionSelectionsList()
+		exampleInput := fakes.BuildFakeCookTimelineRequestInput()
+		exampleTimeline := fakes.BuildFakeCookTimeline()
+		expectedChart := "gantt\n"
+
+		expectations := setupExpectationsForRecipeManagerWithAnalyzer(
+			rm,
+			func(db *mealplanningmock.Repository) {
+				db.On(reflection.GetMethodName(rm.db.GetMealPlanEvent), testutils.ContextMatcher, exampleMealPlanID, exampleMealPlanEvent.ID).Return(exampleMealPlanEvent, nil)
+				db.On(reflection.GetMethodName(rm.db.GetMealPlanOption), testutils.ContextMatcher, exampleMealPlanID, exampleMealPlanEvent.ID, exampleMealPlanOption.ID).Return(exampleMealPlanOption, nil)
+				db.On(reflection.GetMethodName(rm.db.GetSelectionsForMealPlanOption), testutils.ContextMatcher, exampleMealPlanOption.ID, testutils.QueryFilterMatcher).Return(exampleSelections, nil)
+			},
+			func(analyzer *recipeanalysis.MockRecipeAnalyzer) {
+				analyzer.On(
+					reflection.GetMethodName(analyzer.ScheduleCookTimelineForMeal),
+					testutils.ContextMatcher,
+					exampleMeal,
+					mock.MatchedBy(func(constraints *recipeanalysis.CookTimelineConstraints) bool {
+						return constraints.ServingTime.Equal(*exampleInput.ServingTime) &&
+							constraints.CookCount == exampleInput.CookCount &&
+							constraints.InstrumentCapacities[exampleInput.InstrumentConstraints[0].ID] == exampleInput.InstrumentConstraints[0].Quantity &&
+							constraints.VesselCapacities[exampleInput.VesselConstraints[0].ID] == exampleInput.VesselConstraints[0].Quantity &&
+							len(constraints.Selections) == len(exampleSelections.Data)
+					}),
+				).Return(exampleTimeline, nil)
+				analyzer.On(reflection.GetMethodName(analyzer.RenderMermaidGanttChartForCookTimeline), testutils.ContextMatcher, exampleTimeline).Return(expectedChart)
+			},
+		)
+
+		actual, err := rm.MealPlanOptionCookTimeline(ctx, exampleMealPlanID, exampleMealPlanEvent.ID, exampleMealPlanOption.ID, exampleInput)
+		assert.NoError(t, err)
+		assert.Equal(t, exampleMealPlanOption.ID, actual.MealPlanOptionID)
+		assert.Equal(t, expectedChart, actual.MermaidGanttChart)
+
+		mock.AssertExpectationsForObjects(t, expectations...)
+	})
+
+	T.Run("with nil input", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		rm := buildRecipeManagerForTest(t)
+
+		actual, err := rm.MealPlanOptionCookTimeline(ctx, fakes.BuildFakeID(), fakes.BuildFakeID(), fakes.BuildFakeID(), nil)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+
+	T.Run("with error scheduling timeline", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		rm := buildRecipeManagerForTest(t)
+
+		exampleMealPlanID := fakes.BuildFakeID()
+		exampleMealPlanEvent := fakes.BuildFakeMealPlanEvent()
+		exampleMealPlanOption := fakes.BuildFakeMealPlanOption()
+		exampleMeal := fakes.BuildFakeMeal()
+		exampleMealPlanOption.Meal = *exampleMeal
+		exampleInput := fakes.BuildFakeCookTimelineRequestInput()
+
+		expectations := setupExpectationsForRecipeManagerWithAnalyzer(
+			rm,
+			func(db *mealplanningmock.Repository) {
+				db.On(reflection.GetMethodName(rm.db.GetMealPlanEvent), testutils.ContextMatcher, exampleMealPlanID, exampleMealPlanEvent.ID).Return(exampleMealPlanEvent, nil)
+				db.On(reflection.GetMethodName(rm.db.GetMealPlanOption), testutils.ContextMatcher, exampleMealPlanID, exampleMealPlanEvent.ID, exampleMealPlanOption.ID).Return(exampleMealPlanOption, nil)
+				db.On(reflection.GetMethodName(rm.db.GetSelectionsForMealPlanOption), testutils.ContextMatcher, exampleMealPlanOption.ID, testutils.QueryFilterMatcher).Return(fakes.BuildFakeMealPlanRecipeOptionSelectionsList(), nil)
+			},
+			func(analyzer *recipeanalysis.MockRecipeAnalyzer) {
+				analyzer.On(reflection.GetMethodName(analyzer.ScheduleCookTimelineForMeal), testutils.ContextMatcher, exampleMeal, testutils.MatchType[*recipeanalysis.CookTimelineConstraints]()).Return((*types.CookTimeline)(nil), recipeanalysis.ErrCookTimelineResourceUnavailable)
+			},
+		)
+
+		actual, err := rm.MealPlanOptionCookTimeline(ctx, exampleMealPlanID, exampleMealPlanEvent.ID, exampleMealPlanOption.ID, exampleInput)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+
+		mock.AssertExpectationsForObjects(t, expectations...)
+	})
+}
+
 func TestRecipeManager_RecipeMermaid(T *testing.T) {
 	T.Parallel()
 
@@ -361,3 +457,37 @@ func(db *mealplanningmock.Repository) {
 		mock.AssertExpectationsForObjects(t, expectations...)
 	})
 }
+
+func TestRecipeManager_ImportRecipe(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with unknown format", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		rm := buildRecipeManagerForTest(t)
+
+		expectations := setupExpectationsForRecipeManager(rm, nil)
+
+		actual, err := rm.ImportRecipe(ctx, []byte("Pancakes"), "yaml")
+		assert.ErrorIs(t, err, recipeimport.ErrUnknownFormat)
+		assert.Nil(t, actual)
+
+		mock.AssertExpectationsForObjects(t, expectations...)
+	})
+
+	T.Run("with no recipe in content", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		rm := buildRecipeManagerForTest(t)
+
+		expectations := setupExpectationsForRecipeManager(rm, nil)
+
+		actual, err := rm.ImportRecipe(ctx, []byte("<html><body>no recipes here</body></html>"), string(recipeimport.FormatJSONLD))
+		assert.ErrorIs(t, err, recipeimport.ErrNoRecipeFound)
+		assert.Nil(t, actual)
+
+		mock.AssertExpectationsForObjects(t, expectations...)
+	})
+}
@@ -0,0 +1,113 @@
+package managers
+
+import (
+	"context"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/audit"
+	types "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/converters"
+	mealplanningkeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/keys"
+
+	platformerrors "github.com/primandproper/platform/errors"
+	"github.com/primandproper/platform/observability"
+	"github.com/primandproper/platform/observability/tracing"
+)
+
+func (m *mealPlanningManager) CreateValidIngredientNutritionFacts(ctx context.Context, input *types.ValidIngredientNutritionFactsCreationRequestInput) (*types.ValidIngredientNutritionFacts, error) {
+	ctx, span := m.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := m.logger.WithSpan(span)
+
+	if input == nil {
+		return nil, platformerrors.ErrNilInputParameter
+	}
+
+	if err := input.ValidateWithContext(ctx); err != nil {
+		return nil, observability.PrepareError(err, span, "validating input")
+	}
+
+	convertedInput := converters.ConvertValidIngredientNutritionFactsCreationRequestInputToValidIngredientNutritionFactsDatabaseCreationInput(input)
+	created, err := m.db.CreateValidIngredientNutritionFacts(ctx, convertedInput)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "creating valid ingredient nutrition facts")
+	}
+
+	m.dataChangesPublisher.PublishAsync(ctx, audit.BuildDataChangeMessageFromContext(ctx, logger, types.ValidIngredientNutritionFactsCreatedServiceEventType, map[string]any{
+		mealplanningkeys.ValidIngredientNutritionFactsIDKey: created.ID,
+		mealplanningkeys.ValidIngredientIDKey:               created.ValidIngredientID,
+	}))
+
+	return created, nil
+}
+
+func (m *mealPlanningManager) ReadValidIngredientNutritionFactsForIngredient(ctx context.Context, validIngredientID string) (*types.ValidIngredientNutritionFacts, error) {
+	ctx, span := m.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := m.logger.WithSpan(span).WithValue(mealplanningkeys.ValidIngredientIDKey, validIngredientID)
+	tracing.AttachToSpan(span, mealplanningkeys.ValidIngredientIDKey, validIngredientID)
+
+	result, err := m.db.GetValidIngredientNutritionFactsForIngredient(ctx, validIngredientID)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching valid ingredient nutrition facts for ingredient")
+	}
+
+	return result, nil
+}
+
+func (m *mealPlanningManager) UpdateValidIngredientNutritionFacts(ctx context.Context, validIngredientNutritionFactsID string, input *types.ValidIngredientNutritionFactsUpdateRequestInput) (*types.ValidIngredientNutritionFacts, error) {
+	ctx, span := m.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := m.logger.WithSpan(span).WithValue(mealplanningkeys.ValidIngredientNutritionFactsIDKey, validIngredientNutritionFactsID)
+	tracing.AttachToSpan(span, mealplanningkeys.ValidIngredientNutritionFactsIDKey, validIngredientNutritionFactsID)
+
+	if input == nil {
+		return nil, platformerrors.ErrNilInputParameter
+	}
+
+	if err := input.ValidateWithContext(ctx); err != nil {
+		return nil, observability.PrepareError(err, span, "validating input")
+	}
+
+	existingValidIngredientNutritionFacts, err := m.db.GetValidIngredientNutritionFacts(ctx, validIngredientNutritionFactsID)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching valid ingredient nutrition facts")
+	}
+
+	existingValidIngredientNutritionFacts.Update(input)
+	if err = m.db.UpdateValidIngredientNutritionFacts(ctx, existingValidIngredientNutritionFacts); err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "updating valid ingredient nutrition facts")
+	}
+
+	m.dataChangesPublisher.PublishAsync(ctx, audit.BuildDataChangeMessageFromContext(ctx, logger, types.ValidIngredientNutritionFactsUpdatedServiceEventType, map[string]any{
+		mealplanningkeys.ValidIngredientNutritionFactsIDKey: existingValidIngredientNutritionFacts.ID,
+		mealplanningkeys.ValidIngredientIDKey:               existingValidIngredientNutritionFacts.ValidIngredientID,
+	}))
+
+	existingValidIngredientNutritionFacts, err = m.db.GetValidIngredientNutritionFacts(ctx, validIngredientNutritionFactsID)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching updated valid ingredient nutrition facts")
+	}
+
+	return existingValidIngredientNutritionFacts, nil
+}
+
+func (m *mealPlanningManager) ArchiveValidIngredientNutritionFacts(ctx context.Context, validIngredientNutritionFactsID string) error {
+	ctx, span := m.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := m.logger.WithSpan(span).WithValue(mealplanningkeys.ValidIngredientNutritionFactsIDKey, validIngredientNutritionFactsID)
+	tracing.AttachToSpan(span, mealplanningkeys.ValidIngredientNutritionFactsIDKey, validIngredientNutritionFactsID)
+
+	if err := m.db.ArchiveValidIngredientNutritionFacts(ctx, validIngredientNutritionFactsID); err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "archiving valid ingredient nutrition facts")
+	}
+
+	m.dataChangesPublisher.PublishAsync(ctx, audit.BuildDataChangeMessageFromContext(ctx, logger, types.ValidIngredientNutritionFactsArchivedServiceEventType, map[string]any{
+		mealplanningkeys.ValidIngredientNutritionFactsIDKey: validIngredientNutritionFactsID,
+	}))
+
+	return nil
+}
@@ -0,0 +1,142 @@
+package managers
+
+import (
+	"testing"
+
+	types "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/fakes"
+	mealplanningkeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/keys"
+	mealplanningmock "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/mocks"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/testutils"
+
+	"github.com/primandproper/platform/reflection"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestValidEnumerationManager_CreateValidIngredientNutritionFacts(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		vem := buildValidEnumerationsManagerForTest(t)
+
+		expected := fakes.BuildFakeValidIngredientNutritionFacts()
+		fakeInput := fakes.BuildFakeValidIngredientNutritionFactsCreationRequestInput()
+
+		expectations := setupExpectationsForValidEnumerationManager(
+			vem,
+			func(db *mealplanningmock.Repository) {
+				db.On(reflection.GetMethodName(vem.db.CreateValidIngredientNutritionFacts), testutils.ContextMatcher, testutils.MatchType[*types.ValidIngredientNutritionFactsDatabaseCreationInput]()).Return(expected, nil)
+			},
+			map[string][]string{
+				types.ValidIngredientNutritionFactsCreatedServiceEventType: {mealplanningkeys.ValidIngredientNutritionFactsIDKey},
+			},
+		)
+
+		actual, err := vem.CreateValidIngredientNutritionFacts(ctx, fakeInput)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, actual)
+
+		mock.AssertExpectationsForObjects(t, expectations...)
+	})
+
+	T.Run("with nil input", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		vem := buildValidEnumerationsManagerForTest(t)
+
+		actual, err := vem.CreateValidIngredientNutritionFacts(ctx, nil)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
+
+func TestValidEnumerationManager_ReadValidIngredientNutritionFactsForIngredient(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		vem := buildValidEnumerationsManagerForTest(t)
+
+		expected := fakes.BuildFakeValidIngredientNutritionFacts()
+
+		expectations := setupExpectationsForValidEnumerationManager(
+			vem,
+			func(db *mealplanningmock.Repository) {
+				db.On(reflection.GetMethodName(vem.db.GetValidIngredientNutritionFactsForIngredient), testutils.ContextMatcher, expected.ValidIngredientID).Return(expected, nil)
+			},
+		)
+
+		actual, err := vem.ReadValidIngredientNutritionFactsForIngredient(ctx, expected.ValidIngredientID)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, actual)
+
+		mock.AssertExpectationsForObjects(t, expectations...)
+	})
+}
+
+func TestValidEnumerationManager_UpdateValidIngredientNutritionFacts(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		vem := buildValidEnumerationsManagerForTest(t)
+
+		exampleValidIngredientNutritionFacts := fakes.BuildFakeValidIngredientNutritionFacts()
+		exampleInput := fakes.BuildFakeValidIngredientNutritionFactsUpdateRequestInput()
+
+		expectations := setupExpectationsForValidEnumerationManager(
+			vem,
+			func(db *mealplanningmock.Repository) {
+				db.On(reflection.GetMethodName(vem.db.GetValidIngredientNutritionFacts), testutils.ContextMatcher, exampleValidIngredientNutritionFacts.ID).Return(exampleValidIngredientNutritionFacts, nil)
+				db.On(reflection.GetMethodName(vem.db.UpdateValidIngredientNutritionFacts), testutils.ContextMatcher, testutils.MatchType[*types.ValidIngredientNutritionFacts]()).Return(nil)
+			},
+			map[string][]string{
+				types.ValidIngredientNutritionFactsUpdatedServiceEventType: {mealplanningkeys.ValidIngredientNutritionFactsIDKey},
+			},
+		)
+
+		result, err := vem.UpdateValidIngredientNutritionFacts(ctx, exampleValidIngredientNutritionFacts.ID, exampleInput)
+		assert.NotNil(t, result)
+		assert.NoError(t, err)
+
+		mock.AssertExpectationsForObjects(t, expectations...)
+	})
+}
+
+func TestValidEnumerationManager_ArchiveValidIngredientNutritionFacts(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		vem := buildValidEnumerationsManagerForTest(t)
+
+		expected := fakes.BuildFakeValidIngredientNutritionFacts()
+
+		expectations := setupExpectationsForValidEnumerationManager(
+			vem,
+			func(db *mealplanningmock.Repository) {
+				db.On(reflection.GetMethodName(vem.db.ArchiveValidIngredientNutritionFacts), testutils.ContextMatcher, expected.ID).Return(nil)
+			},
+			map[string][]string{
+				types.ValidIngredientNutritionFactsArchivedServiceEventType: {mealplanningkeys.ValidIngredientNutritionFactsIDKey},
+			},
+		)
+
+		err := vem.ArchiveValidIngredientNutritionFacts(ctx, expected.ID)
+		assert.NoError(t, err)
+
+		mock.AssertExpectationsForObjects(t, expectations...)
+	})
+}
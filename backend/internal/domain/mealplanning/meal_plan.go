@@ -16,6 +16,12 @@
 	MealPlanElectionMethodSchulze = "schulze"
 	// MealPlanElectionMethodInstantRunoff is used to denote the Instant Runoff election method.
 	MealPlanElectionMethodInstantRunoff = "instant-runoff"
+	// MealPlanElectionMethodBorda is used to denote the Borda count election method.
+	MealPlanElectionMethodBorda = "borda"
+	// MealPlanElectionMethodApproval is used to denote the approval election method, where every non-abstaining vote is an approval.
+	MealPlanElectionMethodApproval = "approval"
+	// MealPlanElectionMethodScore is used to denote the score election method, where a vote's rank is read as a score and higher is better.
+	MealPlanElectionMethodScore = "score"
 
 	// MealPlanCreatedServiceEventType indicates a meal plan was created.
 	MealPlanCreatedServiceEventType = "meal_plan_created"
@@ -25,6 +31,8 @@
 	MealPlanArchivedServiceEventType = "meal_plan_archived"
 	// MealPlanFinalizedServiceEventType indicates a meal plan was finalized.
 	MealPlanFinalizedServiceEventType = "meal_plan_finalized"
+	// MealPlanVotingDeadlineApproachingServiceEventType indicates a meal plan's voting deadline is close.
+	MealPlanVotingDeadlineApproachingServiceEventType = "meal_plan_voting_deadline_approaching"
 
 	// MealPlanStatusAwaitingVotes indicates an account invitation is pending.
 	MealPlanStatusAwaitingVotes MealPlanStatus = "awaiting_votes"
@@ -119,8 +127,13 @@ func init() {
 		ArchiveMealPlan(ctx context.Context, mealPlanID, accountID string) error
 		AttemptToFinalizeMealPlan(ctx context.Context, mealPlanID, accountID string) (bool, error)
 		MarkMealPlanAsGroceryListInitialized(ctx context.Context, mealPlanID string) error
+		GetAccountIDForMealPlan(ctx context.Context, mealPlanID string) (string, error)
+		GetMealPlanCountForAccountSince(ctx context.Context, accountID string, since time.Time) (int64, error)
+		GetRecentlyChosenMealIDsForAccount(ctx context.Context, accountID string, mealPlanCount uint8) ([]string, error)
 		GetFinalizedMealPlanIDsForTheNextWeek(ctx context.Context) ([]*FinalizedMealPlanDatabaseResult, error)
 		GetUnfinalizedMealPlansWithExpiredVotingPeriods(ctx context.Context) ([]*MealPlan, error)
+		GetMealPlansWithApproachingVotingDeadlines(ctx context.Context) ([]*MealPlan, error)
+		MarkMealPlanVotingDeadlineReminderSent(ctx context.Context, mealPlanID string) error
 		GetFinalizedMealPlansWithUninitializedGroceryLists(ctx context.Context) ([]*MealPlan, error)
 	}
 )
@@ -146,7 +159,13 @@ func (x *MealPlanCreationRequestInput) ValidateWithContext(ctx context.Context)
 		x,
 		validation.Field(&x.VotingDeadline, validation.Required),
 		validation.Field(&x.Events, validation.Required),
-		validation.Field(&x.ElectionMethod, validation.In(MealPlanElectionMethodSchulze, MealPlanElectionMethodInstantRunoff)),
+		validation.Field(&x.ElectionMethod, validation.In(
+			MealPlanElectionMethodSchulze,
+			MealPlanElectionMethodInstantRunoff,
+			MealPlanElectionMethodBorda,
+			MealPlanElectionMethodApproval,
+			MealPlanElectionMethodScore,
+		)),
 	); err != nil {
 		return err
 	}
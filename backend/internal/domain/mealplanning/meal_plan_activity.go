@@ -0,0 +1,97 @@
+package mealplanning
+
+import (
+	"context"
+	"encoding/gob"
+	"slices"
+	"time"
+)
+
+const (
+	// MealPlanActivityCategoryVoting indicates an activity concerns voting, options, or finalization.
+	MealPlanActivityCategoryVoting = "voting"
+	// MealPlanActivityCategoryTasks indicates an activity concerns meal plan tasks.
+	MealPlanActivityCategoryTasks = "tasks"
+
+	// MealPlanActivityRetentionPeriod is how long recorded activity is kept for watchers to resume from.
+	MealPlanActivityRetentionPeriod = 30 * 24 * time.Hour
+)
+
+var (
+	mealPlanVotingActivityEventTypes = []string{
+		MealPlanUpdatedServiceEventType,
+		MealPlanArchivedServiceEventType,
+		MealPlanFinalizedServiceEventType,
+		MealPlanOptionCreatedServiceEventType,
+		MealPlanOptionUpdatedServiceEventType,
+		MealPlanOptionArchivedServiceEventType,
+		MealPlanOptionCompletedServiceEventType,
+		MealPlanOptionVoteCreatedServiceEventType,
+		MealPlanOptionVoteUpdatedServiceEventType,
+		MealPlanOptionVoteArchivedServiceEventType,
+	}
+
+	mealPlanTaskActivityEventTypes = []string{
+		MealPlanTaskCreatedServiceEventType,
+		MealPlanTaskStatusChangedServiceEventType,
+	}
+)
+
+func init() {
+	gob.Register(new(MealPlanActivity))
+}
+
+type (
+	// MealPlanActivity is a single change to a meal plan, as seen by watchers of that meal plan.
+	MealPlanActivity struct {
+		_ struct{} `json:"-"`
+
+		CreatedAt            time.Time `json:"createdAt"`
+		ID                   string    `json:"id"`
+		EventType            string    `json:"eventType"`
+		MealPlanEventID      string    `json:"mealPlanEventID"`
+		MealPlanOptionID     string    `json:"mealPlanOptionID"`
+		MealPlanOptionVoteID string    `json:"mealPlanOptionVoteID"`
+		MealPlanTaskID       string    `json:"mealPlanTaskID"`
+		ByUser               string    `json:"byUser"`
+		BelongsToMealPlan    string    `json:"belongsToMealPlan"`
+		Sequence             uint64    `json:"sequence"`
+	}
+
+	// MealPlanActivityDatabaseCreationInput is used for recording a meal plan activity.
+	MealPlanActivityDatabaseCreationInput struct {
+		_ struct{} `json:"-"`
+
+		ID                   string `json:"-"`
+		EventType            string `json:"-"`
+		MealPlanEventID      string `json:"-"`
+		MealPlanOptionID     string `json:"-"`
+		MealPlanOptionVoteID string `json:"-"`
+		MealPlanTaskID       string `json:"-"`
+		ByUser               string `json:"-"`
+		BelongsToMealPlan    string `json:"-"`
+	}
+
+	// MealPlanActivityDataManager describes a structure capable of storing meal plan activities permanently.
+	MealPlanActivityDataManager interface {
+		CreateMealPlanActivity(ctx context.Context, input *MealPlanActivityDatabaseCreationInput) error
+		GetMealPlanActivitiesAfterCursor(ctx context.Context, mealPlanID string, afterSequence uint64, limit uint8) ([]*MealPlanActivity, error)
+	}
+
+	// MealPlanActivityNotifier wakes meal plan watchers when activity is recorded for their meal plan.
+	MealPlanActivityNotifier interface {
+		SubscribeToMealPlanActivity(ctx context.Context, mealPlanID string) <-chan struct{}
+	}
+)
+
+// MealPlanActivityCategoryForEventType returns the watch category a data change event type belongs to, or an empty string if watchers don't care about it.
+func MealPlanActivityCategoryForEventType(eventType string) string {
+	switch {
+	case slices.Contains(mealPlanVotingActivityEventTypes, eventType):
+		return MealPlanActivityCategoryVoting
+	case slices.Contains(mealPlanTaskActivityEventTypes, eventType):
+		return MealPlanActivityCategoryTasks
+	default:
+		return ""
+	}
+}
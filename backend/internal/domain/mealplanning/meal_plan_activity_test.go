@@ -0,0 +1,30 @@
+package mealplanning
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMealPlanActivityCategoryForEventType(T *testing.T) {
+	T.Parallel()
+
+	T.Run("voting", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, MealPlanActivityCategoryVoting, MealPlanActivityCategoryForEventType(MealPlanOptionVoteCreatedServiceEventType))
+		assert.Equal(t, MealPlanActivityCategoryVoting, MealPlanActivityCategoryForEventType(MealPlanFinalizedServiceEventType))
+	})
+
+	T.Run("tasks", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, MealPlanActivityCategoryTasks, MealPlanActivityCategoryForEventType(MealPlanTaskStatusChangedServiceEventType))
+	})
+
+	T.Run("unwatched", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Empty(t, MealPlanActivityCategoryForEventType(MealPlanCreatedServiceEventType))
+	})
+}
@@ -0,0 +1,45 @@
+package mealplanning
+
+import (
+	"context"
+	"encoding/gob"
+	"time"
+)
+
+func init() {
+	gob.Register(new(MealPlanCalendarFeed))
+}
+
+type (
+	// MealPlanCalendarFeed is a user's iCalendar subscription to their account's meal plans.
+	MealPlanCalendarFeed struct {
+		_ struct{} `json:"-"`
+
+		CreatedAt        time.Time  `json:"createdAt"`
+		LastAccessedAt   *time.Time `json:"lastAccessedAt"`
+		ArchivedAt       *time.Time `json:"archivedAt"`
+		ID               string     `json:"id"`
+		BelongsToUser    string     `json:"belongsToUser"`
+		BelongsToAccount string     `json:"belongsToAccount"`
+		// Token is only populated when the feed is created; afterward only its hash is kept.
+		Token string `json:"token,omitempty"`
+	}
+
+	// MealPlanCalendarFeedDatabaseCreationInput is used for creating a meal plan calendar feed.
+	MealPlanCalendarFeedDatabaseCreationInput struct {
+		_ struct{} `json:"-"`
+
+		ID               string `json:"-"`
+		TokenHash        string `json:"-"`
+		BelongsToUser    string `json:"-"`
+		BelongsToAccount string `json:"-"`
+	}
+
+	// MealPlanCalendarFeedDataManager describes a structure capable of storing meal plan calendar feeds permanently.
+	MealPlanCalendarFeedDataManager interface {
+		CreateMealPlanCalendarFeed(ctx context.Context, input *MealPlanCalendarFeedDatabaseCreationInput) (*MealPlanCalendarFeed, error)
+		GetMealPlanCalendarFeedByTokenHash(ctx context.Context, tokenHash string) (*MealPlanCalendarFeed, error)
+		ArchiveMealPlanCalendarFeedsForUser(ctx context.Context, userID, accountID string) error
+		MarkMealPlanCalendarFeedAsAccessed(ctx context.Context, mealPlanCalendarFeedID string) error
+	}
+)
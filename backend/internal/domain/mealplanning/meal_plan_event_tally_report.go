@@ -0,0 +1,77 @@
+package mealplanning
+
+import (
+	"context"
+	"encoding/gob"
+	"time"
+)
+
+const (
+	// ElectionTieBreakStrategyRandom indicates a tie was broken by choosing uniformly at random among the tied options.
+	ElectionTieBreakStrategyRandom = "random"
+)
+
+func init() {
+	gob.Register(new(MealPlanEventTallyReport))
+}
+
+type (
+	// MealPlanEventTallyReport records how a meal plan event's votes were tallied into a chosen option.
+	MealPlanEventTallyReport struct {
+		_ struct{} `json:"-"`
+
+		CreatedAt              time.Time                     `json:"createdAt"`
+		ArchivedAt             *time.Time                    `json:"archivedAt"`
+		TieBreak               *ElectionTieBreak             `json:"tieBreak"`
+		ID                     string                        `json:"id"`
+		BelongsToMealPlanEvent string                        `json:"belongsToMealPlanEvent"`
+		ElectionMethod         string                        `json:"electionMethod"`
+		Winner                 string                        `json:"winner"`
+		Candidates             []string                      `json:"candidates"`
+		Scores                 []*ElectionCandidateScore     `json:"scores"`
+		PairwisePreferences    []*ElectionPairwisePreference `json:"pairwisePreferences,omitempty"`
+		Rounds                 []*ElectionRound              `json:"rounds,omitempty"`
+		BallotCount            int                           `json:"ballotCount"`
+		TieBroken              bool                          `json:"tieBroken"`
+	}
+
+	// ElectionCandidateScore is a candidate's tally under a given election method.
+	ElectionCandidateScore struct {
+		_ struct{} `json:"-"`
+
+		Candidate string  `json:"candidate"`
+		Score     float64 `json:"score"`
+	}
+
+	// ElectionPairwisePreference is the strength of the strongest path from one candidate to another in a Schulze election.
+	ElectionPairwisePreference struct {
+		_ struct{} `json:"-"`
+
+		Candidate string `json:"candidate"`
+		Opponent  string `json:"opponent"`
+		Strength  int    `json:"strength"`
+	}
+
+	// ElectionRound is a single round of an instant runoff election.
+	ElectionRound struct {
+		_ struct{} `json:"-"`
+
+		Counts     []*ElectionCandidateScore `json:"counts"`
+		Eliminated []string                  `json:"eliminated"`
+		Number     int                       `json:"number"`
+	}
+
+	// ElectionTieBreak records how a tie between candidates was resolved.
+	ElectionTieBreak struct {
+		_ struct{} `json:"-"`
+
+		Strategy       string   `json:"strategy"`
+		Chosen         string   `json:"chosen"`
+		TiedCandidates []string `json:"tiedCandidates"`
+	}
+
+	// MealPlanEventTallyReportDataManager describes a structure capable of storing tally reports permanently.
+	MealPlanEventTallyReportDataManager interface {
+		GetMealPlanEventTallyReport(ctx context.Context, mealPlanID, mealPlanEventID string) (*MealPlanEventTallyReport, error)
+	}
+)
@@ -124,7 +124,7 @@ func init() {
 		GetMealPlanGroceryListItem(ctx context.Context, mealPlanID, mealPlanGroceryListItemID string) (*MealPlanGroceryListItem, error)
 		GetMealPlanGroceryListItemsForMealPlan(ctx context.Context, mealPlanID string, filter *filtering.QueryFilter) (*filtering.QueryFilteredResult[MealPlanGroceryListItem], error)
 		CreateMealPlanGroceryListItem(ctx context.Context, input *MealPlanGroceryListItemDatabaseCreationInput) (*MealPlanGroceryListItem, error)
-		UpdateMealPlanGroceryListItem(ctx context.Context, updated *MealPlanGroceryListItem) error
+		UpdateMealPlanGroceryListItem(ctx context.Context, updated *MealPlanGroceryListItem, stocked *PantryItemDatabaseCreationInput) error
 		ArchiveMealPlanGroceryListItem(ctx context.Context, mealPlanGroceryListItemID string) error
 	}
 )
@@ -19,6 +19,8 @@
 	MealPlanOptionArchivedServiceEventType = "meal_plan_option_archived"
 	// MealPlanOptionFinalizedCreatedServiceEventType indicates a meal plan option was created.
 	MealPlanOptionFinalizedCreatedServiceEventType = "meal_plan_option_finalized"
+	// MealPlanOptionCompletedServiceEventType indicates a meal plan option was cooked.
+	MealPlanOptionCompletedServiceEventType = "meal_plan_option_completed"
 )
 
 func init() {
@@ -32,19 +34,22 @@ func init() {
 	MealPlanOption struct {
 		_ struct{} `json:"-"`
 
-		CreatedAt              time.Time             `json:"createdAt"`
-		LastUpdatedAt          *time.Time            `json:"lastUpdatedAt"`
-		AssignedCook           *string               `json:"assignedCook"`
-		ArchivedAt             *time.Time            `json:"archivedAt"`
-		AssignedDishwasher     *string               `json:"assignedDishwasher"`
-		Notes                  string                `json:"notes"`
-		BelongsToMealPlanEvent string                `json:"belongsToMealPlanEvent"`
-		ID                     string                `json:"id"`
-		Votes                  []*MealPlanOptionVote `json:"votes"`
-		Meal                   Meal                  `json:"meal"`
-		MealScale              float32               `json:"mealScale"`
-		Chosen                 bool                  `json:"chosen"`
-		TieBroken              bool                  `json:"tieBroken"`
+		CreatedAt              time.Time                        `json:"createdAt"`
+		LastUpdatedAt          *time.Time                       `json:"lastUpdatedAt"`
+		AssignedCook           *string                          `json:"assignedCook"`
+		ArchivedAt             *time.Time                       `json:"archivedAt"`
+		CompletedAt            *time.Time                       `json:"completedAt"`
+		AssignedDishwasher     *string                          `json:"assignedDishwasher"`
+		Notes                  string                           `json:"notes"`
+		BelongsToMealPlanEvent string                           `json:"belongsToMealPlanEvent"`
+		ID                     string                           `json:"id"`
+		Votes                  []*MealPlanOptionVote            `json:"votes"`
+		AllergenWarnings       []*MealPlanOptionAllergenWarning `json:"allergenWarnings"`
+		RecipeRevisions        []*MealPlanOptionRecipeRevision  `json:"recipeRevisions"`
+		Meal                   Meal                             `json:"meal"`
+		MealScale              float32                          `json:"mealScale"`
+		Chosen                 bool                             `json:"chosen"`
+		TieBroken              bool                             `json:"tieBroken"`
 	}
 
 	// MealPlanOptionCreationRequestInput represents what a user could set as input for creating meal plan options.
@@ -53,6 +58,7 @@ func init() {
 		AssignedCook       *string                                              `json:"assignedCook"`
 		AssignedDishwasher *string                                              `json:"assignedDishwasher"`
 		MealID             string                                               `json:"mealID"`
+		MealPlanID         string                                               `json:"-"`
 		Notes              string                                               `json:"notes"`
 		Selections         []*MealPlanRecipeOptionSelectionCreationRequestInput `json:"selections,omitempty"`
 		MealScale          float32                                              `json:"mealScale"`
@@ -93,9 +99,34 @@ func init() {
 		UpdateMealPlanOption(ctx context.Context, updated *MealPlanOption) error
 		ArchiveMealPlanOption(ctx context.Context, mealPlanID, mealPlanEventID, mealPlanOptionID string) error
 		FinalizeMealPlanOption(ctx context.Context, mealPlanID, mealPlanEventID, mealPlanOptionID, accountID string) (changed bool, err error)
+		CompleteMealPlanOption(ctx context.Context, mealPlanEventID, mealPlanOptionID string, drawDowns []*PantryItemDrawDown) (drawnDown []*PantryItem, changed bool, err error)
 	}
 )
 
+// MealWithPinnedRecipes returns a copy of the option's meal whose components use the recipe revisions the option is
+// pinned to, so that edits made to a recipe after the option was proposed don't change what gets cooked or bought.
+// Components without a pinned revision keep the recipe the meal was loaded with.
+func (x *MealPlanOption) MealWithPinnedRecipes() *Meal {
+	pinned := map[string]*Recipe{}
+	for _, revision := range x.RecipeRevisions {
+		if revision.Snapshot != nil {
+			pinned[revision.RecipeID] = revision.Snapshot
+		}
+	}
+
+	meal := x.Meal
+	meal.Components = make([]*MealComponent, 0, len(x.Meal.Components))
+	for _, component := range x.Meal.Components {
+		c := *component
+		if snapshot, ok := pinned[c.Recipe.ID]; ok {
+			c.Recipe = *snapshot
+		}
+		meal.Components = append(meal.Components, &c)
+	}
+
+	return &meal
+}
+
 // Update merges an MealPlanOptionUpdateRequestInput with a meal plan option.
 func (x *MealPlanOption) Update(input *MealPlanOptionUpdateRequestInput) {
 	if input.MealID != nil && *input.MealID != x.Meal.ID {
@@ -0,0 +1,172 @@
+package mealplanning
+
+import (
+	"context"
+	"encoding/gob"
+	"slices"
+)
+
+const (
+	// MealPlanOptionAllergenPolicySettingName is the name of the account service setting that controls how allergen conflicts are handled.
+	MealPlanOptionAllergenPolicySettingName = "meal_plan_option_allergen_policy"
+	// MealPlanOptionAllergenPolicyWarn flags conflicting meal plan options with warnings.
+	MealPlanOptionAllergenPolicyWarn = "warn"
+	// MealPlanOptionAllergenPolicyReject refuses to create conflicting meal plan options.
+	MealPlanOptionAllergenPolicyReject = "reject"
+
+	// AllergenWarningReasonIngredientAllergy indicates the member is allergic to the ingredient itself.
+	AllergenWarningReasonIngredientAllergy = "ingredient_allergy"
+	// AllergenWarningReasonSharedAllergen indicates the ingredient contains an allergen found in an ingredient the member is allergic to.
+	AllergenWarningReasonSharedAllergen = "shared_allergen"
+)
+
+// These are the allergens a ValidIngredient can be flagged as containing.
+const (
+	AllergenEgg       = "egg"
+	AllergenGluten    = "gluten"
+	AllergenTreeNut   = "tree_nut"
+	AllergenWheat     = "wheat"
+	AllergenSoy       = "soy"
+	AllergenSesame    = "sesame"
+	AllergenFish      = "fish"
+	AllergenPeanut    = "peanut"
+	AllergenDairy     = "dairy"
+	AllergenAlcohol   = "alcohol"
+	AllergenShellfish = "shellfish"
+)
+
+func init() {
+	gob.Register(new(MealPlanOptionAllergenWarning))
+}
+
+type (
+	// MealPlanOptionAllergenWarning notes that a meal plan option's meal contains something an account member is allergic to.
+	MealPlanOptionAllergenWarning struct {
+		_ struct{} `json:"-"`
+
+		UserID            string   `json:"userID"`
+		RecipeID          string   `json:"recipeID"`
+		RecipeStepID      string   `json:"recipeStepID"`
+		ValidIngredientID string   `json:"validIngredientID"`
+		IngredientName    string   `json:"ingredientName"`
+		Reason            string   `json:"reason"`
+		Allergens         []string `json:"allergens"`
+	}
+
+	// AccountAllergenProfile is the set of allergies held by an account's members, along with how the account wants conflicts handled.
+	AccountAllergenProfile struct {
+		_ struct{} `json:"-"`
+
+		AccountID string                      `json:"accountID"`
+		Policy    string                      `json:"policy"`
+		Allergies []*UserIngredientPreference `json:"allergies"`
+	}
+
+	// AccountAllergenProfileDataManager describes a structure capable of fetching account allergen profiles.
+	AccountAllergenProfileDataManager interface {
+		GetAccountAllergenProfile(ctx context.Context, accountID string) (*AccountAllergenProfile, error)
+		GetAccountAllergenProfileForMealPlanEvent(ctx context.Context, mealPlanEventID string) (*AccountAllergenProfile, error)
+	}
+)
+
+// Allergens returns the allergens a valid ingredient is flagged as containing.
+func (x *ValidIngredient) Allergens() []string {
+	allergens := []string{}
+
+	for _, flag := range []struct {
+		allergen string
+		contains bool
+	}{
+		{AllergenEgg, x.ContainsEgg},
+		{AllergenGluten, x.ContainsGluten},
+		{AllergenTreeNut, x.ContainsTreeNut},
+		{AllergenWheat, x.ContainsWheat},
+		{AllergenSoy, x.ContainsSoy},
+		{AllergenSesame, x.ContainsSesame},
+		{AllergenFish, x.ContainsFish},
+		{AllergenPeanut, x.ContainsPeanut},
+		{AllergenDairy, x.ContainsDairy},
+		{AllergenAlcohol, x.ContainsAlcohol},
+		{AllergenShellfish, x.ContainsShellfish},
+	} {
+		if flag.contains {
+			allergens = append(allergens, flag.allergen)
+		}
+	}
+
+	return allergens
+}
+
+// RejectsConflicts returns whether the account refuses meal plan options that conflict with its members' allergies.
+func (x *AccountAllergenProfile) RejectsConflicts() bool {
+	return x.Policy == MealPlanOptionAllergenPolicyReject
+}
+
+// WarningsForMeal computes which of the account's members are affected by a meal's ingredients.
+func (x *AccountAllergenProfile) WarningsForMeal(meal *Meal) []*MealPlanOptionAllergenWarning {
+	warnings := []*MealPlanOptionAllergenWarning{}
+	if x == nil || meal == nil || len(x.Allergies) == 0 {
+		return warnings
+	}
+
+	seen := map[[2]string]struct{}{}
+	for _, component := range meal.Components {
+		warnings = x.appendWarningsForRecipe(warnings, seen, &component.Recipe)
+	}
+
+	return warnings
+}
+
+func (x *AccountAllergenProfile) appendWarningsForRecipe(warnings []*MealPlanOptionAllergenWarning, seen map[[2]string]struct{}, recipe *Recipe) []*MealPlanOptionAllergenWarning {
+	for _, step := range recipe.Steps {
+		for _, ingredient := range step.Ingredients {
+			if ingredient.Ingredient == nil {
+				continue
+			}
+
+			ingredientAllergens := ingredient.Ingredient.Allergens()
+			for _, allergy := range x.Allergies {
+				key := [2]string{allergy.CreatedByUser, ingredient.Ingredient.ID}
+				if _, ok := seen[key]; ok {
+					continue
+				}
+
+				reason, allergens := "", []string{}
+				if allergy.Ingredient.ID == ingredient.Ingredient.ID {
+					reason, allergens = AllergenWarningReasonIngredientAllergy, ingredientAllergens
+				} else {
+					for _, allergen := range allergy.Ingredient.Allergens() {
+						if slices.Contains(ingredientAllergens, allergen) {
+							allergens = append(allergens, allergen)
+						}
+					}
+
+					if len(allergens) > 0 {
+						reason = AllergenWarningReasonSharedAllergen
+					}
+				}
+
+				if reason == "" {
+					continue
+				}
+
+				seen[key] = struct{}{}
+				warnings = append(warnings, &MealPlanOptionAllergenWarning{
+					UserID:            allergy.CreatedByUser,
+					RecipeID:          recipe.ID,
+					RecipeStepID:      step.ID,
+					ValidIngredientID: ingredient.Ingredient.ID,
+					IngredientName:    ingredient.Ingredient.Name,
+					Reason:            reason,
+					Allergens:         allergens,
+				})
+			}
+		}
+	}
+
+	for _, associatedRecipe := range recipe.AssociatedRecipes {
+		warnings = x.appendWarningsForRecipe(warnings, seen, associatedRecipe)
+	}
+
+	return warnings
+}
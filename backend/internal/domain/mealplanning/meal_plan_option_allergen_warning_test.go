@@ -0,0 +1,123 @@
+package mealplanning
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func buildMealWithIngredientsForAllergenTest(ingredients ...*ValidIngredient) *Meal {
+	step := &RecipeStep{ID: "step"}
+	for _, ingredient := range ingredients {
+		step.Ingredients = append(step.Ingredients, &RecipeStepIngredient{Ingredient: ingredient})
+	}
+
+	return &Meal{
+		Components: []*MealComponent{
+			{Recipe: Recipe{ID: "recipe", Steps: []*RecipeStep{step}}},
+		},
+	}
+}
+
+func TestValidIngredient_Allergens(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		x := &ValidIngredient{ContainsEgg: true, ContainsDairy: true}
+
+		assert.Equal(t, []string{AllergenEgg, AllergenDairy}, x.Allergens())
+	})
+
+	T.Run("with no allergens", func(t *testing.T) {
+		t.Parallel()
+
+		x := &ValidIngredient{}
+
+		assert.Empty(t, x.Allergens())
+	})
+}
+
+func TestAccountAllergenProfile_RejectsConflicts(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		assert.True(t, (&AccountAllergenProfile{Policy: MealPlanOptionAllergenPolicyReject}).RejectsConflicts())
+		assert.False(t, (&AccountAllergenProfile{Policy: MealPlanOptionAllergenPolicyWarn}).RejectsConflicts())
+	})
+}
+
+func TestAccountAllergenProfile_WarningsForMeal(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with explicit ingredient allergy", func(t *testing.T) {
+		t.Parallel()
+
+		peanut := &ValidIngredient{ID: "peanut", Name: "peanut", ContainsPeanut: true}
+		x := &AccountAllergenProfile{
+			Allergies: []*UserIngredientPreference{
+				{CreatedByUser: "user", Ingredient: *peanut, Allergy: true},
+			},
+		}
+
+		actual := x.WarningsForMeal(buildMealWithIngredientsForAllergenTest(peanut))
+		require.Len(t, actual, 1)
+		assert.Equal(t, &MealPlanOptionAllergenWarning{
+			UserID:            "user",
+			RecipeID:          "recipe",
+			RecipeStepID:      "step",
+			ValidIngredientID: "peanut",
+			IngredientName:    "peanut",
+			Reason:            AllergenWarningReasonIngredientAllergy,
+			Allergens:         []string{AllergenPeanut},
+		}, actual[0])
+	})
+
+	T.Run("with shared allergen", func(t *testing.T) {
+		t.Parallel()
+
+		x := &AccountAllergenProfile{
+			Allergies: []*UserIngredientPreference{
+				{CreatedByUser: "user", Ingredient: ValidIngredient{ID: "milk", ContainsDairy: true}, Allergy: true},
+			},
+		}
+
+		actual := x.WarningsForMeal(buildMealWithIngredientsForAllergenTest(
+			&ValidIngredient{ID: "butter", Name: "butter", ContainsDairy: true},
+			&ValidIngredient{ID: "flour", Name: "flour", ContainsGluten: true, ContainsWheat: true},
+		))
+		require.Len(t, actual, 1)
+		assert.Equal(t, "butter", actual[0].ValidIngredientID)
+		assert.Equal(t, AllergenWarningReasonSharedAllergen, actual[0].Reason)
+		assert.Equal(t, []string{AllergenDairy}, actual[0].Allergens)
+	})
+
+	T.Run("deduplicates repeated ingredients", func(t *testing.T) {
+		t.Parallel()
+
+		egg := &ValidIngredient{ID: "egg", ContainsEgg: true}
+		x := &AccountAllergenProfile{
+			Allergies: []*UserIngredientPreference{
+				{CreatedByUser: "user", Ingredient: *egg, Allergy: true},
+			},
+		}
+
+		associatedRecipe := buildMealWithIngredientsForAllergenTest(egg).Components[0].Recipe
+		meal := buildMealWithIngredientsForAllergenTest(egg, egg)
+		meal.Components[0].Recipe.AssociatedRecipes = []*Recipe{&associatedRecipe}
+
+		assert.Len(t, x.WarningsForMeal(meal), 1)
+	})
+
+	T.Run("with no allergies", func(t *testing.T) {
+		t.Parallel()
+
+		x := &AccountAllergenProfile{}
+
+		assert.Empty(t, x.WarningsForMeal(buildMealWithIngredientsForAllergenTest(&ValidIngredient{ID: "egg", ContainsEgg: true})))
+	})
+}
@@ -22,6 +22,35 @@ func TestMealPlanOption_Update(T *testing.T) {
 	})
 }
 
+func TestMealPlanOption_MealWithPinnedRecipes(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		x := &MealPlanOption{
+			Meal: Meal{
+				ID: "meal",
+				Components: []*MealComponent{
+					{Recipe: Recipe{ID: "pinned", Name: "current"}, RecipeScale: 2},
+					{Recipe: Recipe{ID: "unpinned", Name: "current"}},
+				},
+			},
+			RecipeRevisions: []*MealPlanOptionRecipeRevision{
+				{RecipeID: "pinned", RevisionNumber: 1, Snapshot: &Recipe{ID: "pinned", Name: "original"}},
+			},
+		}
+
+		actual := x.MealWithPinnedRecipes()
+
+		assert.Equal(t, "meal", actual.ID)
+		assert.Equal(t, "original", actual.Components[0].Recipe.Name)
+		assert.Equal(t, float32(2), actual.Components[0].RecipeScale)
+		assert.Equal(t, "current", actual.Components[1].Recipe.Name)
+		assert.Equal(t, "current", x.Meal.Components[0].Recipe.Name)
+	})
+}
+
 func TestMealPlanOptionCreationRequestInput_Validate(T *testing.T) {
 	T.Parallel()
 
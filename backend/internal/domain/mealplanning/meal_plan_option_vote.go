@@ -18,6 +18,9 @@
 	MealPlanOptionVoteUpdatedServiceEventType = "meal_plan_option_vote_updated"
 	// MealPlanOptionVoteArchivedServiceEventType indicates a meal plan option vote was archived.
 	MealPlanOptionVoteArchivedServiceEventType = "meal_plan_option_vote_archived"
+
+	// MaxMealPlanOptionVoteScore is the highest score a voter can give an option in a score election.
+	MaxMealPlanOptionVoteScore = 10
 )
 
 func init() {
@@ -39,6 +42,7 @@ func init() {
 		BelongsToMealPlanOption string     `json:"belongsToMealPlanOption"`
 		ByUser                  string     `json:"byUser"`
 		Rank                    uint8      `json:"rank"`
+		Score                   uint8      `json:"score"`
 		Abstain                 bool       `json:"abstain"`
 	}
 
@@ -47,6 +51,7 @@ func init() {
 		_ struct{} `json:"-"`
 
 		Rank                    *uint8
+		Score                   *uint8
 		ID                      *string
 		Notes                   *string
 		BelongsToMealPlanOption *string
@@ -66,6 +71,7 @@ func init() {
 		ByUser                  string `json:"-"`
 		BelongsToMealPlanOption string `json:"belongsToMealPlanOption"`
 		Rank                    uint8  `json:"rank"`
+		Score                   uint8  `json:"score"`
 		Abstain                 bool   `json:"abstain"`
 	}
 
@@ -78,6 +84,7 @@ func init() {
 		ByUser                  string `json:"-"`
 		BelongsToMealPlanOption string `json:"-"`
 		Rank                    uint8  `json:"-"`
+		Score                   uint8  `json:"-"`
 		Abstain                 bool   `json:"-"`
 	}
 
@@ -85,7 +92,9 @@ func init() {
 	MealPlanOptionVoteCreationRequestInput struct {
 		_ struct{} `json:"-"`
 
-		Votes []*MealPlanOptionVoteCreationInput `json:"votes"`
+		Votes           []*MealPlanOptionVoteCreationInput `json:"votes"`
+		MealPlanID      string                             `json:"-"`
+		MealPlanEventID string                             `json:"-"`
 	}
 
 	// MealPlanOptionVotesDatabaseCreationInput represents what a user could set as input for creating meal plan option votes.
@@ -101,6 +110,7 @@ func init() {
 
 		Notes                   *string `json:"notes,omitempty"`
 		Rank                    *uint8  `json:"rank,omitempty"`
+		Score                   *uint8  `json:"score,omitempty"`
 		Abstain                 *bool   `json:"abstain,omitempty"`
 		BelongsToMealPlanOption string  `json:"belongsToMealPlanOption"`
 	}
@@ -130,6 +140,10 @@ func (x *MealPlanOptionVote) Update(input *MealPlanOptionVoteUpdateRequestInput)
 		x.Rank = *input.Rank
 	}
 
+	if input.Score != nil && *input.Score != x.Score {
+		x.Score = *input.Score
+	}
+
 	if input.Abstain != nil && *input.Abstain != x.Abstain {
 		x.Abstain = *input.Abstain
 	}
@@ -139,11 +153,21 @@ func (x *MealPlanOptionVote) Update(input *MealPlanOptionVoteUpdateRequestInput)
 	}
 }
 
+var _ validation.ValidatableWithContext = (*MealPlanOptionVoteCreationInput)(nil)
+
+// ValidateWithContext validates a MealPlanOptionVoteCreationInput.
+func (x *MealPlanOptionVoteCreationInput) ValidateWithContext(ctx context.Context) error {
+	return validation.ValidateStructWithContext(
+		ctx,
+		x,
+		validation.Field(&x.Score, validation.Max(uint8(MaxMealPlanOptionVoteScore))),
+	)
+}
+
 var _ validation.ValidatableWithContext = (*MealPlanOptionVoteCreationRequestInput)(nil)
 
 // ValidateWithContext validates a MealPlanOptionVoteCreationRequestInput.
 func (x *MealPlanOptionVoteCreationRequestInput) ValidateWithContext(ctx context.Context) error {
-	// LATER: we should validate the contents of each individual vote
 	return validation.ValidateStructWithContext(
 		ctx,
 		x,
@@ -171,5 +195,6 @@ func (x *MealPlanOptionVoteUpdateRequestInput) ValidateWithContext(ctx context.C
 		x,
 		// we cannot validate rank because zero is a valid value.
 		validation.Field(&x.BelongsToMealPlanOption, validation.Required),
+		validation.Field(&x.Score, validation.Max(uint8(MaxMealPlanOptionVoteScore))),
 	)
 }
@@ -48,6 +48,19 @@ func TestMealPlanOptionVoteCreationRequestInput_Validate(T *testing.T) {
 		actual := x.ValidateWithContext(t.Context())
 		assert.Error(t, actual)
 	})
+
+	T.Run("with out of range score", func(t *testing.T) {
+		t.Parallel()
+
+		x := &MealPlanOptionVoteCreationRequestInput{
+			Votes: []*MealPlanOptionVoteCreationInput{
+				{Score: MaxMealPlanOptionVoteScore + 1},
+			},
+		}
+
+		actual := x.ValidateWithContext(t.Context())
+		assert.Error(t, actual)
+	})
 }
 
 func TestMealPlanOptionVotesDatabaseCreationInput_Validate(T *testing.T) {
@@ -101,4 +114,16 @@ func TestMealPlanOptionVoteUpdateRequestInput_Validate(T *testing.T) {
 		actual := x.ValidateWithContext(t.Context())
 		assert.Error(t, actual)
 	})
+
+	T.Run("with out of range score", func(t *testing.T) {
+		t.Parallel()
+
+		x := &MealPlanOptionVoteUpdateRequestInput{
+			Score:                   new(uint8(MaxMealPlanOptionVoteScore + 1)),
+			BelongsToMealPlanOption: t.Name(),
+		}
+
+		actual := x.ValidateWithContext(t.Context())
+		assert.Error(t, actual)
+	})
 }
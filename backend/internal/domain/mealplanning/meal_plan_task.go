@@ -60,6 +60,7 @@ func init() {
 		StatusExplanation   string  `json:"statusExplanation"`
 		MealPlanOptionID    string  `json:"mealPlanOptionID"`
 		RecipePrepTaskID    string  `json:"recipePrepTaskID"`
+		MealPlanID          string  `json:"-"`
 	}
 
 	// MealPlanTaskDatabaseCreationInput represents what a user could set as input for creating meal plan tasks.
@@ -82,6 +83,7 @@ func init() {
 		StatusExplanation string  `json:"statusExplanation"`
 		AssignedToUser    *string `json:"assignedToUser"`
 		MealPlanTaskID    string  `json:"-"`
+		MealPlanID        string  `json:"-"`
 	}
 
 	// MealPlanTaskDatabaseCreationEstimate represents what a user could set as input for creating meal plan tasks.
@@ -0,0 +1,570 @@
+package mealplanning
+
+import (
+	"cmp"
+	"context"
+	"encoding/gob"
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/primandproper/platform/database/filtering"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+const (
+	// MealPlanTemplateCreatedServiceEventType indicates a meal plan template was created.
+	MealPlanTemplateCreatedServiceEventType = "meal_plan_template_created"
+	// MealPlanTemplateUpdatedServiceEventType indicates a meal plan template was updated.
+	MealPlanTemplateUpdatedServiceEventType = "meal_plan_template_updated"
+	// MealPlanTemplateArchivedServiceEventType indicates a meal plan template was archived.
+	MealPlanTemplateArchivedServiceEventType = "meal_plan_template_archived"
+	// MealPlanTemplateInstantiatedServiceEventType indicates a meal plan was created from a meal plan template.
+	MealPlanTemplateInstantiatedServiceEventType = "meal_plan_template_instantiated"
+
+	// DefaultMealPlanTemplateCandidatesPerSlot is how many options a template proposes per event when it doesn't say.
+	DefaultMealPlanTemplateCandidatesPerSlot = 3
+	// DefaultMealPlanTemplateTimezone is the timezone a template's slot times are read in when it doesn't say.
+	DefaultMealPlanTemplateTimezone = "UTC"
+
+	mealPlanTemplateSlotTimeLayout = "15:04"
+	maxMealPlanTemplateInterval    = 52
+	oneWeek                        = 7 * 24 * time.Hour
+)
+
+var (
+	// ErrInvalidMealPlanTemplateRecurrenceRule is returned when a recurrence rule can't be parsed.
+	ErrInvalidMealPlanTemplateRecurrenceRule = errors.New("invalid meal plan template recurrence rule")
+
+	errMealPlanTemplateSlotHasNoCandidates = errors.New("slot must have candidate meals, a meal list, or a recipe list")
+
+	recurrenceRuleWeekdays = map[string]time.Weekday{
+		"SU": time.Sunday,
+		"MO": time.Monday,
+		"TU": time.Tuesday,
+		"WE": time.Wednesday,
+		"TH": time.Thursday,
+		"FR": time.Friday,
+		"SA": time.Saturday,
+	}
+)
+
+func init() {
+	gob.Register(new(MealPlanTemplate))
+	gob.Register(new(MealPlanTemplateCreationRequestInput))
+	gob.Register(new(MealPlanTemplateUpdateRequestInput))
+}
+
+type (
+	// MealPlanTemplate describes a meal plan an account builds over and over, so that it can be created on a schedule.
+	MealPlanTemplate struct {
+		_ struct{} `json:"-"`
+
+		CreatedAt                     time.Time               `json:"createdAt"`
+		NextInstantiationAt           time.Time               `json:"nextInstantiationAt"`
+		LastInstantiatedAt            *time.Time              `json:"lastInstantiatedAt"`
+		LastUpdatedAt                 *time.Time              `json:"lastUpdatedAt"`
+		ArchivedAt                    *time.Time              `json:"archivedAt"`
+		ID                            string                  `json:"id"`
+		Name                          string                  `json:"name"`
+		Notes                         string                  `json:"notes"`
+		ElectionMethod                string                  `json:"electionMethod"`
+		RecurrenceRule                string                  `json:"recurrenceRule"`
+		Timezone                      string                  `json:"timezone"`
+		BelongsToAccount              string                  `json:"belongsToAccount"`
+		CreatedByUser                 string                  `json:"createdByUser"`
+		Slots                         []*MealPlanTemplateSlot `json:"slots"`
+		VotingDeadlineOffsetInSeconds uint32                  `json:"votingDeadlineOffsetInSeconds"`
+		CandidatesPerSlot             uint8                   `json:"candidatesPerSlot"`
+		AvoidMealsFromLastPlans       uint8                   `json:"avoidMealsFromLastPlans"`
+	}
+
+	// MealPlanTemplateSlot is a meal plan event a template creates every time it is instantiated, along with where the
+	// event's candidate meals come from.
+	MealPlanTemplateSlot struct {
+		_ struct{} `json:"-"`
+
+		MealListID        *string  `json:"mealListID,omitempty"`
+		RecipeListID      *string  `json:"recipeListID,omitempty"`
+		MealName          string   `json:"mealName"`
+		StartTime         string   `json:"startTime"`
+		Notes             string   `json:"notes"`
+		MealIDs           []string `json:"mealIDs"`
+		DurationInSeconds uint32   `json:"durationInSeconds"`
+		DayOfWeek         uint8    `json:"dayOfWeek"`
+	}
+
+	// MealPlanTemplateCreationRequestInput represents what a user could set as input for creating meal plan templates.
+	MealPlanTemplateCreationRequestInput struct {
+		_ struct{} `json:"-"`
+
+		Name                          string                  `json:"name"`
+		Notes                         string                  `json:"notes"`
+		ElectionMethod                string                  `json:"electionMethod"`
+		RecurrenceRule                string                  `json:"recurrenceRule"`
+		Timezone                      string                  `json:"timezone"`
+		Slots                         []*MealPlanTemplateSlot `json:"slots"`
+		VotingDeadlineOffsetInSeconds uint32                  `json:"votingDeadlineOffsetInSeconds"`
+		CandidatesPerSlot             uint8                   `json:"candidatesPerSlot"`
+		AvoidMealsFromLastPlans       uint8                   `json:"avoidMealsFromLastPlans"`
+	}
+
+	// MealPlanTemplateDatabaseCreationInput represents what a user could set as input for creating meal plan templates.
+	MealPlanTemplateDatabaseCreationInput struct {
+		_ struct{} `json:"-"`
+
+		NextInstantiationAt           time.Time               `json:"-"`
+		ID                            string                  `json:"-"`
+		Name                          string                  `json:"-"`
+		Notes                         string                  `json:"-"`
+		ElectionMethod                string                  `json:"-"`
+		RecurrenceRule                string                  `json:"-"`
+		Timezone                      string                  `json:"-"`
+		BelongsToAccount              string                  `json:"-"`
+		CreatedByUser                 string                  `json:"-"`
+		Slots                         []*MealPlanTemplateSlot `json:"-"`
+		VotingDeadlineOffsetInSeconds uint32                  `json:"-"`
+		CandidatesPerSlot             uint8                   `json:"-"`
+		AvoidMealsFromLastPlans       uint8                   `json:"-"`
+	}
+
+	// MealPlanTemplateUpdateRequestInput represents what a user could set as input for updating meal plan templates.
+	// Slots, when provided, replace the template's slots entirely.
+	MealPlanTemplateUpdateRequestInput struct {
+		_ struct{} `json:"-"`
+
+		Name                          *string                 `json:"name,omitempty"`
+		Notes                         *string                 `json:"notes,omitempty"`
+		ElectionMethod                *string                 `json:"electionMethod,omitempty"`
+		RecurrenceRule                *string                 `json:"recurrenceRule,omitempty"`
+		Timezone                      *string                 `json:"timezone,omitempty"`
+		VotingDeadlineOffsetInSeconds *uint32                 `json:"votingDeadlineOffsetInSeconds,omitempty"`
+		CandidatesPerSlot             *uint8                  `json:"candidatesPerSlot,omitempty"`
+		AvoidMealsFromLastPlans       *uint8                  `json:"avoidMealsFromLastPlans,omitempty"`
+		Slots                         []*MealPlanTemplateSlot `json:"slots,omitempty"`
+	}
+
+	// MealPlanTemplateRecurrence is a parsed recurrence rule. Rules are a subset of RFC 5545's RRULE: weekly
+	// frequency, an optional interval, the days of the week, and the time of day, e.g.
+	// "FREQ=WEEKLY;INTERVAL=1;BYDAY=SU;BYHOUR=9;BYMINUTE=0".
+	MealPlanTemplateRecurrence struct {
+		Days     []time.Weekday
+		Interval int
+		Hour     int
+		Minute   int
+	}
+
+	// MealPlanTemplateEventTimes are when one of a template's slots takes place in a given instantiation.
+	MealPlanTemplateEventTimes struct {
+		StartsAt time.Time
+		EndsAt   time.Time
+	}
+
+	// MealPlanTemplateDataManager describes a structure capable of storing meal plan templates permanently.
+	MealPlanTemplateDataManager interface {
+		GetMealPlanTemplate(ctx context.Context, mealPlanTemplateID, accountID string) (*MealPlanTemplate, error)
+		GetMealPlanTemplatesForAccount(ctx context.Context, accountID string, filter *filtering.QueryFilter) (*filtering.QueryFilteredResult[MealPlanTemplate], error)
+		GetMealPlanTemplatesDueForInstantiation(ctx context.Context, asOf time.Time) ([]*MealPlanTemplate, error)
+		CreateMealPlanTemplate(ctx context.Context, input *MealPlanTemplateDatabaseCreationInput) (*MealPlanTemplate, error)
+		UpdateMealPlanTemplate(ctx context.Context, updated *MealPlanTemplate) error
+		ClaimMealPlanTemplateInstantiation(ctx context.Context, mealPlanTemplateID string, scheduledAt, claimedUntil time.Time) (bool, error)
+		ReleaseMealPlanTemplateInstantiationClaim(ctx context.Context, mealPlanTemplateID string) error
+		MarkMealPlanTemplateAsInstantiated(ctx context.Context, mealPlanTemplateID string, instantiatedAt, nextInstantiationAt time.Time) (bool, error)
+		ArchiveMealPlanTemplate(ctx context.Context, mealPlanTemplateID, accountID string) error
+	}
+)
+
+// Update merges a MealPlanTemplateUpdateRequestInput with a meal plan template.
+func (x *MealPlanTemplate) Update(input *MealPlanTemplateUpdateRequestInput) {
+	if input.Name != nil && *input.Name != x.Name {
+		x.Name = *input.Name
+	}
+
+	if input.Notes != nil && *input.Notes != x.Notes {
+		x.Notes = *input.Notes
+	}
+
+	if input.ElectionMethod != nil && *input.ElectionMethod != x.ElectionMethod {
+		x.ElectionMethod = *input.ElectionMethod
+	}
+
+	if input.RecurrenceRule != nil && *input.RecurrenceRule != x.RecurrenceRule {
+		x.RecurrenceRule = *input.RecurrenceRule
+	}
+
+	if input.Timezone != nil && *input.Timezone != x.Timezone {
+		x.Timezone = *input.Timezone
+	}
+
+	if input.VotingDeadlineOffsetInSeconds != nil && *input.VotingDeadlineOffsetInSeconds != x.VotingDeadlineOffsetInSeconds {
+		x.VotingDeadlineOffsetInSeconds = *input.VotingDeadlineOffsetInSeconds
+	}
+
+	if input.CandidatesPerSlot != nil && *input.CandidatesPerSlot != x.CandidatesPerSlot {
+		x.CandidatesPerSlot = *input.CandidatesPerSlot
+	}
+
+	if input.AvoidMealsFromLastPlans != nil && *input.AvoidMealsFromLastPlans != x.AvoidMealsFromLastPlans {
+		x.AvoidMealsFromLastPlans = *input.AvoidMealsFromLastPlans
+	}
+
+	if input.Slots != nil {
+		x.Slots = input.Slots
+	}
+}
+
+// NextInstantiationAfter returns when the template should next be instantiated, given that it's now after. The
+// template's last instantiation, if any, anchors the rule's interval.
+func (x *MealPlanTemplate) NextInstantiationAfter(after time.Time) (time.Time, error) {
+	recurrence, err := ParseMealPlanTemplateRecurrenceRule(x.RecurrenceRule)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	loc, err := time.LoadLocation(cmp.Or(x.Timezone, DefaultMealPlanTemplateTimezone))
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return recurrence.Next(after, x.LastInstantiatedAt, loc), nil
+}
+
+// EventTimes returns when each of the template's slots takes place if the template is instantiated at the given time.
+// Every slot lands in the seven days that follow the instantiation.
+func (x *MealPlanTemplate) EventTimes(instantiatedAt time.Time) ([]*MealPlanTemplateEventTimes, error) {
+	loc, err := time.LoadLocation(cmp.Or(x.Timezone, DefaultMealPlanTemplateTimezone))
+	if err != nil {
+		return nil, err
+	}
+
+	local := instantiatedAt.In(loc)
+	times := make([]*MealPlanTemplateEventTimes, 0, len(x.Slots))
+	for _, slot := range x.Slots {
+		startTime, parseErr := time.Parse(mealPlanTemplateSlotTimeLayout, slot.StartTime)
+		if parseErr != nil {
+			return nil, parseErr
+		}
+
+		for day := range 8 {
+			startsAt := time.Date(local.Year(), local.Month(), local.Day()+day, startTime.Hour(), startTime.Minute(), 0, 0, loc)
+			if startsAt.Weekday() == time.Weekday(slot.DayOfWeek) && startsAt.After(instantiatedAt) {
+				times = append(times, &MealPlanTemplateEventTimes{
+					StartsAt: startsAt,
+					EndsAt:   startsAt.Add(time.Duration(slot.DurationInSeconds) * time.Second),
+				})
+				break
+			}
+		}
+	}
+
+	return times, nil
+}
+
+// ParseMealPlanTemplateRecurrenceRule parses a meal plan template's recurrence rule.
+func ParseMealPlanTemplateRecurrenceRule(rule string) (*MealPlanTemplateRecurrence, error) {
+	x := &MealPlanTemplateRecurrence{Interval: 1}
+
+	var frequency string
+	for part := range strings.SplitSeq(strings.TrimPrefix(strings.TrimSpace(rule), "RRULE:"), ";") {
+		key, value, found := strings.Cut(part, "=")
+		if !found {
+			return nil, fmt.Errorf("%w: malformed part %q", ErrInvalidMealPlanTemplateRecurrenceRule, part)
+		}
+
+		var err error
+		switch strings.ToUpper(key) {
+		case "FREQ":
+			frequency = strings.ToUpper(value)
+		case "INTERVAL":
+			x.Interval, err = parseRecurrenceRuleInt(value, 1, maxMealPlanTemplateInterval)
+		case "BYHOUR":
+			x.Hour, err = parseRecurrenceRuleInt(value, 0, 23)
+		case "BYMINUTE":
+			x.Minute, err = parseRecurrenceRuleInt(value, 0, 59)
+		case "BYDAY":
+			for day := range strings.SplitSeq(value, ",") {
+				weekday, ok := recurrenceRuleWeekdays[strings.ToUpper(day)]
+				if !ok {
+					return nil, fmt.Errorf("%w: unknown day %q", ErrInvalidMealPlanTemplateRecurrenceRule, day)
+				}
+				if !slices.Contains(x.Days, weekday) {
+					x.Days = append(x.Days, weekday)
+				}
+			}
+		default:
+			return nil, fmt.Errorf("%w: unsupported part %q", ErrInvalidMealPlanTemplateRecurrenceRule, key)
+		}
+
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if frequency != "WEEKLY" {
+		return nil, fmt.Errorf("%w: only weekly frequencies are supported", ErrInvalidMealPlanTemplateRecurrenceRule)
+	}
+
+	if len(x.Days) == 0 {
+		return nil, fmt.Errorf("%w: BYDAY is required", ErrInvalidMealPlanTemplateRecurrenceRule)
+	}
+
+	return x, nil
+}
+
+func parseRecurrenceRuleInt(value string, minimum, maximum int) (int, error) {
+	x, err := strconv.Atoi(value)
+	if err != nil || x < minimum || x > maximum {
+		return 0, fmt.Errorf("%w: %q must be between %d and %d", ErrInvalidMealPlanTemplateRecurrenceRule, value, minimum, maximum)
+	}
+
+	return x, nil
+}
+
+// Next returns the first occurrence of the rule that's after the given time. When the rule has an interval, weeks are
+// counted from the previous occurrence.
+func (r *MealPlanTemplateRecurrence) Next(after time.Time, previous *time.Time, loc *time.Location) time.Time {
+	local := after.In(loc)
+
+	for day := range 7 * (r.Interval + 1) {
+		candidate := time.Date(local.Year(), local.Month(), local.Day()+day, r.Hour, r.Minute, 0, 0, loc)
+		if !candidate.After(after) || !slices.Contains(r.Days, candidate.Weekday()) {
+			continue
+		}
+
+		if previous != nil && r.Interval > 1 && weeksBetween(previous.In(loc), candidate)%r.Interval != 0 {
+			continue
+		}
+
+		return candidate
+	}
+
+	return time.Time{}
+}
+
+// weeksBetween counts the Monday-started weeks between two times.
+func weeksBetween(from, to time.Time) int {
+	startOfWeek := func(t time.Time) time.Time {
+		offset := (int(t.Weekday()) + 6) % 7
+		return time.Date(t.Year(), t.Month(), t.Day()-offset, 12, 0, 0, 0, t.Location())
+	}
+
+	return int(startOfWeek(to).Sub(startOfWeek(from)).Round(oneWeek) / oneWeek)
+}
+
+// SelectMealPlanTemplateCandidates picks up to limit meals from a slot's candidate pool. Meals chosen in recent meal
+// plans and meals already proposed elsewhere in the same plan are avoided, unless there's nothing else to propose.
+// The remaining meals are ranked by their score, highest first; unscored meals are treated as average.
+func SelectMealPlanTemplateCandidates(pool []string, scores map[string]float32, recent, used map[string]bool, limit int) []string {
+	unique := []string{}
+	for _, mealID := range pool {
+		if !slices.Contains(unique, mealID) {
+			unique = append(unique, mealID)
+		}
+	}
+
+	candidates := slices.DeleteFunc(slices.Clone(unique), func(mealID string) bool { return recent[mealID] || used[mealID] })
+	if len(candidates) == 0 {
+		candidates = slices.DeleteFunc(slices.Clone(unique), func(mealID string) bool { return used[mealID] })
+	}
+	if len(candidates) == 0 {
+		candidates = unique
+	}
+
+	var (
+		total float32
+		rated int
+	)
+	for _, mealID := range candidates {
+		if score, ok := scores[mealID]; ok {
+			total += score
+			rated++
+		}
+	}
+
+	var average float32
+	if rated > 0 {
+		average = total / float32(rated)
+	}
+
+	scoreFor := func(mealID string) float32 {
+		if score, ok := scores[mealID]; ok {
+			return score
+		}
+		return average
+	}
+
+	slices.SortStableFunc(candidates, func(a, b string) int {
+		return cmp.Or(cmp.Compare(scoreFor(b), scoreFor(a)), cmp.Compare(a, b))
+	})
+
+	if len(candidates) > limit {
+		candidates = candidates[:limit]
+	}
+
+	return candidates
+}
+
+// ScoreMealsByRecipeRatings scores each meal by the average overall rating of its rated recipes. Meals without any
+// rated recipes are left out.
+func ScoreMealsByRecipeRatings(meals []*Meal, summaries []*RecipeRatingSummary) map[string]float32 {
+	recipeScores := map[string]float32{}
+	for _, summary := range summaries {
+		if summary.RatingCount > 0 {
+			recipeScores[summary.RecipeID] = summary.AverageOverall
+		}
+	}
+
+	scores := map[string]float32{}
+	for _, meal := range meals {
+		var (
+			total float32
+			rated int
+		)
+		for _, component := range meal.Components {
+			if score, ok := recipeScores[component.Recipe.ID]; ok {
+				total += score
+				rated++
+			}
+		}
+
+		if rated > 0 {
+			scores[meal.ID] = total / float32(rated)
+		}
+	}
+
+	return scores
+}
+
+var _ validation.ValidatableWithContext = (*MealPlanTemplateSlot)(nil)
+
+// ValidateWithContext validates a MealPlanTemplateSlot.
+func (x *MealPlanTemplateSlot) ValidateWithContext(ctx context.Context) error {
+	if len(x.MealIDs) == 0 && x.MealListID == nil && x.RecipeListID == nil {
+		return errMealPlanTemplateSlotHasNoCandidates
+	}
+
+	return validation.ValidateStructWithContext(
+		ctx,
+		x,
+		validation.Field(&x.DayOfWeek, validation.Max(uint8(time.Saturday))),
+		validation.Field(&x.StartTime, validation.Required, validation.Date(mealPlanTemplateSlotTimeLayout)),
+		validation.Field(&x.DurationInSeconds, validation.Required),
+		validation.Field(&x.MealName, validation.In(
+			BreakfastMealName,
+			SecondBreakfastMealName,
+			BrunchMealName,
+			LunchMealName,
+			SupperMealName,
+			DinnerMealName,
+		)),
+	)
+}
+
+// validateMealPlanTemplateFields validates the fields shared by every meal plan template input.
+func validateMealPlanTemplateFields(ctx context.Context, recurrenceRule, timezone string, slots []*MealPlanTemplateSlot, votingDeadlineOffsetInSeconds uint32) error {
+	if _, err := ParseMealPlanTemplateRecurrenceRule(recurrenceRule); err != nil {
+		return err
+	}
+
+	if _, err := time.LoadLocation(cmp.Or(timezone, DefaultMealPlanTemplateTimezone)); err != nil {
+		return err
+	}
+
+	if time.Duration(votingDeadlineOffsetInSeconds)*time.Second >= oneWeek {
+		return errInvalidVotingDeadline
+	}
+
+	for _, slot := range slots {
+		if err := slot.ValidateWithContext(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+var _ validation.ValidatableWithContext = (*MealPlanTemplateCreationRequestInput)(nil)
+
+// ValidateWithContext validates a MealPlanTemplateCreationRequestInput.
+func (x *MealPlanTemplateCreationRequestInput) ValidateWithContext(ctx context.Context) error {
+	if err := validation.ValidateStructWithContext(
+		ctx,
+		x,
+		validation.Field(&x.Name, validation.Required),
+		validation.Field(&x.RecurrenceRule, validation.Required),
+		validation.Field(&x.VotingDeadlineOffsetInSeconds, validation.Required),
+		validation.Field(&x.Slots, validation.Required),
+		validation.Field(&x.ElectionMethod, validation.In(
+			MealPlanElectionMethodSchulze,
+			MealPlanElectionMethodInstantRunoff,
+			MealPlanElectionMethodBorda,
+			MealPlanElectionMethodApproval,
+			MealPlanElectionMethodScore,
+		)),
+	); err != nil {
+		return err
+	}
+
+	return validateMealPlanTemplateFields(ctx, x.RecurrenceRule, x.Timezone, x.Slots, x.VotingDeadlineOffsetInSeconds)
+}
+
+var _ validation.ValidatableWithContext = (*MealPlanTemplateDatabaseCreationInput)(nil)
+
+// ValidateWithContext validates a MealPlanTemplateDatabaseCreationInput.
+func (x *MealPlanTemplateDatabaseCreationInput) ValidateWithContext(ctx context.Context) error {
+	return validation.ValidateStructWithContext(
+		ctx,
+		x,
+		validation.Field(&x.ID, validation.Required),
+		validation.Field(&x.Name, validation.Required),
+		validation.Field(&x.RecurrenceRule, validation.Required),
+		validation.Field(&x.NextInstantiationAt, validation.Required),
+		validation.Field(&x.BelongsToAccount, validation.Required),
+		validation.Field(&x.CreatedByUser, validation.Required),
+		validation.Field(&x.Slots, validation.Required),
+	)
+}
+
+var _ validation.ValidatableWithContext = (*MealPlanTemplateUpdateRequestInput)(nil)
+
+// ValidateWithContext validates a MealPlanTemplateUpdateRequestInput.
+func (x *MealPlanTemplateUpdateRequestInput) ValidateWithContext(ctx context.Context) error {
+	if err := validation.ValidateStructWithContext(
+		ctx,
+		x,
+		validation.Field(&x.Name, validation.NilOrNotEmpty),
+		validation.Field(&x.ElectionMethod, validation.NilOrNotEmpty, validation.In(
+			MealPlanElectionMethodSchulze,
+			MealPlanElectionMethodInstantRunoff,
+			MealPlanElectionMethodBorda,
+			MealPlanElectionMethodApproval,
+			MealPlanElectionMethodScore,
+		)),
+	); err != nil {
+		return err
+	}
+
+	if x.RecurrenceRule != nil {
+		if _, err := ParseMealPlanTemplateRecurrenceRule(*x.RecurrenceRule); err != nil {
+			return err
+		}
+	}
+
+	if x.Timezone != nil {
+		if _, err := time.LoadLocation(*x.Timezone); err != nil {
+			return err
+		}
+	}
+
+	for _, slot := range x.Slots {
+		if err := slot.ValidateWithContext(ctx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
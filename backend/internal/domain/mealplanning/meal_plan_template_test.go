@@ -0,0 +1,318 @@
+package mealplanning
+
+import (
+	"testing"
+	"time"
+
+	fake "github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func buildMealPlanTemplateSlotForTest(t *testing.T) *MealPlanTemplateSlot {
+	t.Helper()
+
+	return &MealPlanTemplateSlot{
+		DayOfWeek:         uint8(time.Monday),
+		StartTime:         "18:00",
+		DurationInSeconds: 3600,
+		MealName:          DinnerMealName,
+		MealIDs:           []string{t.Name()},
+	}
+}
+
+func TestMealPlanTemplate_Update(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		x := &MealPlanTemplate{}
+		input := &MealPlanTemplateUpdateRequestInput{}
+
+		assert.NoError(t, fake.Struct(&input))
+
+		x.Update(input)
+		assert.Equal(t, *input.Name, x.Name)
+		assert.Equal(t, input.Slots, x.Slots)
+	})
+}
+
+func TestParseMealPlanTemplateRecurrenceRule(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		actual, err := ParseMealPlanTemplateRecurrenceRule("RRULE:FREQ=WEEKLY;BYDAY=SU,WE;BYHOUR=9;BYMINUTE=30")
+		require.NoError(t, err)
+
+		assert.Equal(t, []time.Weekday{time.Sunday, time.Wednesday}, actual.Days)
+		assert.Equal(t, 1, actual.Interval)
+		assert.Equal(t, 9, actual.Hour)
+		assert.Equal(t, 30, actual.Minute)
+	})
+
+	T.Run("with invalid rules", func(t *testing.T) {
+		t.Parallel()
+
+		for _, rule := range []string{
+			"",
+			"FREQ=DAILY;BYDAY=SU",
+			"FREQ=WEEKLY",
+			"FREQ=WEEKLY;BYDAY=XX",
+			"FREQ=WEEKLY;BYDAY=SU;INTERVAL=0",
+			"FREQ=WEEKLY;BYDAY=SU;BYHOUR=24",
+			"FREQ=WEEKLY;BYDAY=SU;COUNT=4",
+		} {
+			_, err := ParseMealPlanTemplateRecurrenceRule(rule)
+			assert.ErrorIs(t, err, ErrInvalidMealPlanTemplateRecurrenceRule, rule)
+		}
+	})
+}
+
+func TestMealPlanTemplateRecurrence_Next(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		recurrence, err := ParseMealPlanTemplateRecurrenceRule("FREQ=WEEKLY;BYDAY=SU;BYHOUR=9")
+		require.NoError(t, err)
+
+		saturday := time.Date(2026, time.October, 17, 12, 0, 0, 0, time.UTC)
+		assert.Equal(t, time.Date(2026, time.October, 18, 9, 0, 0, 0, time.UTC), recurrence.Next(saturday, nil, time.UTC))
+	})
+
+	T.Run("at an occurrence", func(t *testing.T) {
+		t.Parallel()
+
+		recurrence, err := ParseMealPlanTemplateRecurrenceRule("FREQ=WEEKLY;BYDAY=SU;BYHOUR=9")
+		require.NoError(t, err)
+
+		occurrence := time.Date(2026, time.October, 18, 9, 0, 0, 0, time.UTC)
+		assert.Equal(t, time.Date(2026, time.October, 25, 9, 0, 0, 0, time.UTC), recurrence.Next(occurrence, nil, time.UTC))
+	})
+
+	T.Run("with interval", func(t *testing.T) {
+		t.Parallel()
+
+		recurrence, err := ParseMealPlanTemplateRecurrenceRule("FREQ=WEEKLY;INTERVAL=2;BYDAY=SU;BYHOUR=9")
+		require.NoError(t, err)
+
+		previous := time.Date(2026, time.October, 11, 9, 0, 0, 0, time.UTC)
+		saturday := time.Date(2026, time.October, 17, 12, 0, 0, 0, time.UTC)
+		assert.Equal(t, time.Date(2026, time.October, 25, 9, 0, 0, 0, time.UTC), recurrence.Next(saturday, &previous, time.UTC))
+	})
+
+	T.Run("in another timezone", func(t *testing.T) {
+		t.Parallel()
+
+		loc, err := time.LoadLocation("America/Chicago")
+		require.NoError(t, err)
+
+		recurrence, err := ParseMealPlanTemplateRecurrenceRule("FREQ=WEEKLY;BYDAY=SU;BYHOUR=9")
+		require.NoError(t, err)
+
+		saturday := time.Date(2026, time.October, 17, 12, 0, 0, 0, time.UTC)
+		assert.Equal(t, time.Date(2026, time.October, 18, 14, 0, 0, 0, time.UTC), recurrence.Next(saturday, nil, loc).UTC())
+	})
+}
+
+func TestMealPlanTemplate_EventTimes(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		loc, err := time.LoadLocation("America/Chicago")
+		require.NoError(t, err)
+
+		monday := buildMealPlanTemplateSlotForTest(t)
+		sunday := buildMealPlanTemplateSlotForTest(t)
+		sunday.DayOfWeek = uint8(time.Sunday)
+		sunday.StartTime = "10:00"
+		earlySunday := buildMealPlanTemplateSlotForTest(t)
+		earlySunday.DayOfWeek = uint8(time.Sunday)
+		earlySunday.StartTime = "08:00"
+
+		x := &MealPlanTemplate{
+			Timezone: "America/Chicago",
+			Slots:    []*MealPlanTemplateSlot{monday, sunday, earlySunday},
+		}
+
+		// 09:00 on a Sunday in Chicago.
+		instantiatedAt := time.Date(2026, time.October, 18, 14, 0, 0, 0, time.UTC)
+
+		actual, err := x.EventTimes(instantiatedAt)
+		require.NoError(t, err)
+		require.Len(t, actual, 3)
+
+		assert.True(t, time.Date(2026, time.October, 19, 18, 0, 0, 0, loc).Equal(actual[0].StartsAt))
+		assert.True(t, time.Date(2026, time.October, 19, 19, 0, 0, 0, loc).Equal(actual[0].EndsAt))
+		assert.True(t, time.Date(2026, time.October, 18, 10, 0, 0, 0, loc).Equal(actual[1].StartsAt))
+		assert.True(t, time.Date(2026, time.October, 25, 8, 0, 0, 0, loc).Equal(actual[2].StartsAt))
+	})
+}
+
+func TestSelectMealPlanTemplateCandidates(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		pool := []string{"a", "b", "c", "d", "a"}
+		scores := map[string]float32{"a": 4, "b": 2, "c": 5}
+		recent := map[string]bool{"c": true}
+
+		assert.Equal(t, []string{"a", "d"}, SelectMealPlanTemplateCandidates(pool, scores, recent, nil, 2))
+	})
+
+	T.Run("skips meals used elsewhere in the plan", func(t *testing.T) {
+		t.Parallel()
+
+		pool := []string{"a", "b", "c"}
+		scores := map[string]float32{"a": 4, "b": 2, "c": 5}
+		used := map[string]bool{"c": true}
+
+		assert.Equal(t, []string{"a", "b"}, SelectMealPlanTemplateCandidates(pool, scores, nil, used, 3))
+	})
+
+	T.Run("falls back to recent meals when nothing else is left", func(t *testing.T) {
+		t.Parallel()
+
+		pool := []string{"a", "b"}
+		recent := map[string]bool{"a": true, "b": true}
+
+		assert.Equal(t, []string{"a", "b"}, SelectMealPlanTemplateCandidates(pool, nil, recent, nil, 3))
+	})
+}
+
+func TestScoreMealsByRecipeRatings(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		meals := []*Meal{
+			{ID: "rated", Components: []*MealComponent{{Recipe: Recipe{ID: "r1"}}, {Recipe: Recipe{ID: "r2"}}, {Recipe: Recipe{ID: "r3"}}}},
+			{ID: "unrated", Components: []*MealComponent{{Recipe: Recipe{ID: "r3"}}}},
+		}
+		summaries := []*RecipeRatingSummary{
+			{RecipeID: "r1", AverageOverall: 4, RatingCount: 2},
+			{RecipeID: "r2", AverageOverall: 2, RatingCount: 1},
+		}
+
+		actual := ScoreMealsByRecipeRatings(meals, summaries)
+		assert.Equal(t, map[string]float32{"rated": 3}, actual)
+	})
+}
+
+func TestMealPlanTemplateCreationRequestInput_Validate(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		x := &MealPlanTemplateCreationRequestInput{
+			Name:                          t.Name(),
+			ElectionMethod:                MealPlanElectionMethodSchulze,
+			RecurrenceRule:                "FREQ=WEEKLY;BYDAY=SU;BYHOUR=9",
+			Timezone:                      "America/Chicago",
+			VotingDeadlineOffsetInSeconds: 3600,
+			Slots:                         []*MealPlanTemplateSlot{buildMealPlanTemplateSlotForTest(t)},
+		}
+
+		assert.NoError(t, x.ValidateWithContext(t.Context()))
+	})
+
+	T.Run("with invalid recurrence rule", func(t *testing.T) {
+		t.Parallel()
+
+		x := &MealPlanTemplateCreationRequestInput{
+			Name:                          t.Name(),
+			RecurrenceRule:                "FREQ=DAILY",
+			VotingDeadlineOffsetInSeconds: 3600,
+			Slots:                         []*MealPlanTemplateSlot{buildMealPlanTemplateSlotForTest(t)},
+		}
+
+		assert.Error(t, x.ValidateWithContext(t.Context()))
+	})
+
+	T.Run("with slot without candidates", func(t *testing.T) {
+		t.Parallel()
+
+		slot := buildMealPlanTemplateSlotForTest(t)
+		slot.MealIDs = nil
+
+		x := &MealPlanTemplateCreationRequestInput{
+			Name:                          t.Name(),
+			RecurrenceRule:                "FREQ=WEEKLY;BYDAY=SU",
+			VotingDeadlineOffsetInSeconds: 3600,
+			Slots:                         []*MealPlanTemplateSlot{slot},
+		}
+
+		assert.Error(t, x.ValidateWithContext(t.Context()))
+	})
+
+	T.Run("with invalid structure", func(t *testing.T) {
+		t.Parallel()
+
+		x := &MealPlanTemplateCreationRequestInput{}
+
+		assert.Error(t, x.ValidateWithContext(t.Context()))
+	})
+}
+
+func TestMealPlanTemplateDatabaseCreationInput_Validate(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		x := &MealPlanTemplateDatabaseCreationInput{
+			ID:                  t.Name(),
+			Name:                t.Name(),
+			RecurrenceRule:      "FREQ=WEEKLY;BYDAY=SU",
+			NextInstantiationAt: time.Now(),
+			BelongsToAccount:    t.Name(),
+			CreatedByUser:       t.Name(),
+			Slots:               []*MealPlanTemplateSlot{buildMealPlanTemplateSlotForTest(t)},
+		}
+
+		assert.NoError(t, x.ValidateWithContext(t.Context()))
+	})
+
+	T.Run("with invalid structure", func(t *testing.T) {
+		t.Parallel()
+
+		x := &MealPlanTemplateDatabaseCreationInput{}
+
+		assert.Error(t, x.ValidateWithContext(t.Context()))
+	})
+}
+
+func TestMealPlanTemplateUpdateRequestInput_Validate(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		x := &MealPlanTemplateUpdateRequestInput{
+			Name:           new(t.Name()),
+			RecurrenceRule: new("FREQ=WEEKLY;BYDAY=MO"),
+		}
+
+		assert.NoError(t, x.ValidateWithContext(t.Context()))
+	})
+
+	T.Run("with invalid recurrence rule", func(t *testing.T) {
+		t.Parallel()
+
+		x := &MealPlanTemplateUpdateRequestInput{
+			RecurrenceRule: new("FREQ=WEEKLY"),
+		}
+
+		assert.Error(t, x.ValidateWithContext(t.Context()))
+	})
+}
@@ -12,5 +12,6 @@
 		MealPlanRecipeOptionSelectionDataManager
 		UserIngredientPreferenceDataManager
 		AccountInstrumentOwnershipDataManager
+		PantryItemDataManager
 	}
 )
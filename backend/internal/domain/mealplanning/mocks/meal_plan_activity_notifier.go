@@ -0,0 +1,20 @@
+package mocks
+
+import (
+	"context"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+
+	"github.com/stretchr/testify/mock"
+)
+
+var _ mealplanning.MealPlanActivityNotifier = (*MealPlanActivityNotifier)(nil)
+
+type MealPlanActivityNotifier struct {
+	mock.Mock
+}
+
+// SubscribeToMealPlanActivity is a mock function.
+func (m *MealPlanActivityNotifier) SubscribeToMealPlanActivity(ctx context.Context, mealPlanID string) <-chan struct{} {
+	return m.Called(ctx, mealPlanID).Get(0).(chan struct{})
+}
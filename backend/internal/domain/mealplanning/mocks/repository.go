@@ -2,6 +2,7 @@
 
 import (
 	"context"
+	"time"
 
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/uploadedmedia"
@@ -720,12 +721,41 @@ func (m *Repository) MarkMealPlanAsGroceryListInitialized(ctx context.Context, m
 	return m.Called(ctx, mealPlanID).Error(0)
 }
 
+// GetAccountIDForMealPlan is a mock function.
+func (m *Repository) GetAccountIDForMealPlan(ctx context.Context, mealPlanID string) (string, error) {
+	returnValues := m.Called(ctx, mealPlanID)
+	return returnValues.String(0), returnValues.Error(1)
+}
+
+// GetMealPlanCountForAccountSince is a mock function.
+func (m *Repository) GetMealPlanCountForAccountSince(ctx context.Context, accountID string, since time.Time) (int64, error) {
+	returnValues := m.Called(ctx, accountID, since)
+	return returnValues.Get(0).(int64), returnValues.Error(1)
+}
+
+// GetRecentlyChosenMealIDsForAccount is a mock function.
+func (m *Repository) GetRecentlyChosenMealIDsForAccount(ctx context.Context, accountID string, mealPlanCount uint8) ([]string, error) {
+	returnValues := m.Called(ctx, accountID, mealPlanCount)
+	return returnValues.Get(0).([]string), returnValues.Error(1)
+}
+
 // GetUnfinalizedMealPlansWithExpiredVotingPeriods is a mock function.
 func (m *Repository) GetUnfinalizedMealPlansWithExpiredVotingPeriods(ctx context.Context) ([]*mealplanning.MealPlan, error) {
 	returnValues := m.Called(ctx)
 	return returnValues.Get(0).([]*mealplanning.MealPlan), returnValues.Error(1)
 }
 
+// GetMealPlansWithApproachingVotingDeadlines is a mock function.
+func (m *Repository) GetMealPlansWithApproachingVotingDeadlines(ctx context.Context) ([]*mealplanning.MealPlan, error) {
+	returnValues := m.Called(ctx)
+	return returnValues.Get(0).([]*mealplanning.MealPlan), returnValues.Error(1)
+}
+
+// MarkMealPlanVotingDeadlineReminderSent is a mock function.
+func (m *Repository) MarkMealPlanVotingDeadlineReminderSent(ctx context.Context, mealPlanID string) error {
+	return m.Called(ctx, mealPlanID).Error(0)
+}
+
 // GetFinalizedMealPlanIDsForTheNextWeek is a mock function.
 func (m *Repository) GetFinalizedMealPlanIDsForTheNextWeek(ctx context.Context) ([]*mealplanning.FinalizedMealPlanDatabaseResult, error) {
 	returnValues := m.Called(ctx)
@@ -785,6 +815,12 @@ func (m *Repository) FinalizeMealPlanOption(ctx context.Context, mealPlanID, mea
 	return returnValues.Bool(0), returnValues.Error(1)
 }
 
+// CompleteMealPlanOption is a mock function.
+func (m *Repository) CompleteMealPlanOption(ctx context.Context, mealPlanEventID, mealPlanOptionID string, drawDowns []*mealplanning.PantryItemDrawDown) (drawnDown []*mealplanning.PantryItem, changed bool, err error) {
+	returnValues := m.Called(ctx, mealPlanEventID, mealPlanOptionID, drawDowns)
+	return returnValues.Get(0).([]*mealplanning.PantryItem), returnValues.Bool(1), returnValues.Error(2)
+}
+
 // MealPlanOptionVoteExists is a mock function.
 func (m *Repository) MealPlanOptionVoteExists(ctx context.Context, mealPlanID, mealPlanEventID, mealPlanOptionID, mealPlanOptionVoteID string) (bool, error) {
 	returnValues := m.Called(ctx, mealPlanID, mealPlanEventID, mealPlanOptionID, mealPlanOptionVoteID)
@@ -992,6 +1028,46 @@ func (m *Repository) GetValidIngredientMeasurementUnitsByIDs(ctx context.Context
 	return returnValues.Get(0).(map[string]*mealplanning.ValidIngredientMeasurementUnit), returnValues.Error(1)
 }
 
+// ValidIngredientNutritionFactsExists is a mock function.
+func (m *Repository) ValidIngredientNutritionFactsExists(ctx context.Context, validIngredientNutritionFactsID string) (bool, error) {
+	returnValues := m.Called(ctx, validIngredientNutritionFactsID)
+	return returnValues.Bool(0), returnValues.Error(1)
+}
+
+// GetValidIngredientNutritionFacts is a mock function.
+func (m *Repository) GetValidIngredientNutritionFacts(ctx context.Context, validIngredientNutritionFactsID string) (*mealplanning.ValidIngredientNutritionFacts, error) {
+	returnValues := m.Called(ctx, validIngredientNutritionFactsID)
+	return returnValues.Get(0).(*mealplanning.ValidIngredientNutritionFacts), returnValues.Error(1)
+}
+
+// GetValidIngredientNutritionFactsForIngredient is a mock function.
+func (m *Repository) GetValidIngredientNutritionFactsForIngredient(ctx context.Context, validIngredientID string) (*mealplanning.ValidIngredientNutritionFacts, error) {
+	returnValues := m.Called(ctx, validIngredientID)
+	return returnValues.Get(0).(*mealplanning.ValidIngredientNutritionFacts), returnValues.Error(1)
+}
+
+// GetValidIngredientNutritionFactsForIngredients is a mock function.
+func (m *Repository) GetValidIngredientNutritionFactsForIngredients(ctx context.Context, validIngredientIDs []string) ([]*mealplanning.ValidIngredientNutritionFacts, error) {
+	returnValues := m.Called(ctx, validIngredientIDs)
+	return returnValues.Get(0).([]*mealplanning.ValidIngredientNutritionFacts), returnValues.Error(1)
+}
+
+// CreateValidIngredientNutritionFacts is a mock function.
+func (m *Repository) CreateValidIngredientNutritionFacts(ctx context.Context, input *mealplanning.ValidIngredientNutritionFactsDatabaseCreationInput) (*mealplanning.ValidIngredientNutritionFacts, error) {
+	returnValues := m.Called(ctx, input)
+	return returnValues.Get(0).(*mealplanning.ValidIngredientNutritionFacts), returnValues.Error(1)
+}
+
+// UpdateValidIngredientNutritionFacts is a mock function.
+func (m *Repository) UpdateValidIngredientNutritionFacts(ctx context.Context, updated *mealplanning.ValidIngredientNutritionFacts) error {
+	return m.Called(ctx, updated).Error(0)
+}
+
+// ArchiveValidIngredientNutritionFacts is a mock function.
+func (m *Repository) ArchiveValidIngredientNutritionFacts(ctx context.Context, validIngredientNutritionFactsID string) error {
+	return m.Called(ctx, validIngredientNutritionFactsID).Error(0)
+}
+
 // MealPlanEventIsEligibleForVoting is a mock function.
 func (m *Repository) MealPlanEventIsEligibleForVoting(ctx context.Context, mealPlanID, mealPlanEventID string) (bool, error) {
 	returnValues := m.Called(ctx, mealPlanID, mealPlanEventID)
@@ -1206,8 +1282,8 @@ func (m *Repository) CreateMealPlanGroceryListItem(ctx context.Context, input *m
 }
 
 // UpdateMealPlanGroceryListItem is a mock function.
-func (m *Repository) UpdateMealPlanGroceryListItem(ctx context.Context, updated *mealplanning.MealPlanGroceryListItem) error {
-	returnValues := m.Called(ctx, updated)
+func (m *Repository) UpdateMealPlanGroceryListItem(ctx context.Context, updated *mealplanning.MealPlanGroceryListItem, stocked *mealplanning.PantryItemDatabaseCreationInput) error {
+	returnValues := m.Called(ctx, updated, stocked)
 	return returnValues.Error(0)
 }
 
@@ -1532,6 +1608,46 @@ func (m *Repository) ArchiveAccountInstrumentOwnership(ctx context.Context, acco
 	return m.Called(ctx, accountInstrumentOwnershipID, accountID).Error(0)
 }
 
+// PantryItemExists is a mock function.
+func (m *Repository) PantryItemExists(ctx context.Context, pantryItemID, accountID string) (bool, error) {
+	returnValues := m.Called(ctx, pantryItemID, accountID)
+	return returnValues.Bool(0), returnValues.Error(1)
+}
+
+// GetPantryItem is a mock function.
+func (m *Repository) GetPantryItem(ctx context.Context, pantryItemID, accountID string) (*mealplanning.PantryItem, error) {
+	returnValues := m.Called(ctx, pantryItemID, accountID)
+	return returnValues.Get(0).(*mealplanning.PantryItem), returnValues.Error(1)
+}
+
+// GetPantryItems is a mock function.
+func (m *Repository) GetPantryItems(ctx context.Context, accountID string, filter *filtering.QueryFilter) (*filtering.QueryFilteredResult[mealplanning.PantryItem], error) {
+	returnValues := m.Called(ctx, accountID, filter)
+	return returnValues.Get(0).(*filtering.QueryFilteredResult[mealplanning.PantryItem]), returnValues.Error(1)
+}
+
+// GetAvailablePantryItemsForAccount is a mock function.
+func (m *Repository) GetAvailablePantryItemsForAccount(ctx context.Context, accountID string) ([]*mealplanning.PantryItem, error) {
+	returnValues := m.Called(ctx, accountID)
+	return returnValues.Get(0).([]*mealplanning.PantryItem), returnValues.Error(1)
+}
+
+// CreatePantryItem is a mock function.
+func (m *Repository) CreatePantryItem(ctx context.Context, input *mealplanning.PantryItemDatabaseCreationInput) (*mealplanning.PantryItem, error) {
+	returnValues := m.Called(ctx, input)
+	return returnValues.Get(0).(*mealplanning.PantryItem), returnValues.Error(1)
+}
+
+// UpdatePantryItem is a mock function.
+func (m *Repository) UpdatePantryItem(ctx context.Context, updated *mealplanning.PantryItem) error {
+	return m.Called(ctx, updated).Error(0)
+}
+
+// ArchivePantryItem is a mock function.
+func (m *Repository) ArchivePantryItem(ctx context.Context, pantryItemID, accountID string) error {
+	return m.Called(ctx, pantryItemID, accountID).Error(0)
+}
+
 // RecipeRatingExists is a mock function.
 func (m *Repository) RecipeRatingExists(ctx context.Context, recipeID, recipeRatingID string) (bool, error) {
 	returnValues := m.Called(ctx, recipeID, recipeRatingID)
@@ -1556,6 +1672,12 @@ func (m *Repository) GetRecipeRatingsForUser(ctx context.Context, user string, f
 	return returnValues.Get(0).(*filtering.QueryFilteredResult[mealplanning.RecipeRating]), returnValues.Error(1)
 }
 
+// GetRecipeRatingSummariesForAccount is a mock function.
+func (m *Repository) GetRecipeRatingSummariesForAccount(ctx context.Context, accountID string, recipeIDs []string) ([]*mealplanning.RecipeRatingSummary, error) {
+	returnValues := m.Called(ctx, accountID, recipeIDs)
+	return returnValues.Get(0).([]*mealplanning.RecipeRatingSummary), returnValues.Error(1)
+}
+
 // CreateRecipeRating is a mock function.
 func (m *Repository) CreateRecipeRating(ctx context.Context, input *mealplanning.RecipeRatingDatabaseCreationInput) (*mealplanning.RecipeRating, error) {
 	returnValues := m.Called(ctx, input)
@@ -1871,3 +1993,122 @@ func (m *Repository) GetRecipeStepImagesByStep(ctx context.Context, recipeStepID
 	}
 	return returnValues.Get(0).([]*mealplanning.RecipeStepImageRow), returnValues.Error(1)
 }
+
+// GetAccountAllergenProfile is a mock function.
+func (m *Repository) GetAccountAllergenProfile(ctx context.Context, accountID string) (*mealplanning.AccountAllergenProfile, error) {
+	returnValues := m.Called(ctx, accountID)
+	return returnValues.Get(0).(*mealplanning.AccountAllergenProfile), returnValues.Error(1)
+}
+
+// GetAccountAllergenProfileForMealPlanEvent is a mock function.
+func (m *Repository) GetAccountAllergenProfileForMealPlanEvent(ctx context.Context, mealPlanEventID string) (*mealplanning.AccountAllergenProfile, error) {
+	returnValues := m.Called(ctx, mealPlanEventID)
+	return returnValues.Get(0).(*mealplanning.AccountAllergenProfile), returnValues.Error(1)
+}
+
+// GetMealPlanEventTallyReport is a mock function.
+func (m *Repository) GetMealPlanEventTallyReport(ctx context.Context, mealPlanID, mealPlanEventID string) (*mealplanning.MealPlanEventTallyReport, error) {
+	returnValues := m.Called(ctx, mealPlanID, mealPlanEventID)
+	return returnValues.Get(0).(*mealplanning.MealPlanEventTallyReport), returnValues.Error(1)
+}
+
+// CreateMealPlanActivity is a mock function.
+func (m *Repository) CreateMealPlanActivity(ctx context.Context, input *mealplanning.MealPlanActivityDatabaseCreationInput) error {
+	return m.Called(ctx, input).Error(0)
+}
+
+// GetMealPlanActivitiesAfterCursor is a mock function.
+func (m *Repository) GetMealPlanActivitiesAfterCursor(ctx context.Context, mealPlanID string, afterSequence uint64, limit uint8) ([]*mealplanning.MealPlanActivity, error) {
+	returnValues := m.Called(ctx, mealPlanID, afterSequence, limit)
+	return returnValues.Get(0).([]*mealplanning.MealPlanActivity), returnValues.Error(1)
+}
+
+// CreateMealPlanCalendarFeed is a mock function.
+func (m *Repository) CreateMealPlanCalendarFeed(ctx context.Context, input *mealplanning.MealPlanCalendarFeedDatabaseCreationInput) (*mealplanning.MealPlanCalendarFeed, error) {
+	returnValues := m.Called(ctx, input)
+	return returnValues.Get(0).(*mealplanning.MealPlanCalendarFeed), returnValues.Error(1)
+}
+
+// GetMealPlanCalendarFeedByTokenHash is a mock function.
+func (m *Repository) GetMealPlanCalendarFeedByTokenHash(ctx context.Context, tokenHash string) (*mealplanning.MealPlanCalendarFeed, error) {
+	returnValues := m.Called(ctx, tokenHash)
+	return returnValues.Get(0).(*mealplanning.MealPlanCalendarFeed), returnValues.Error(1)
+}
+
+// ArchiveMealPlanCalendarFeedsForUser is a mock function.
+func (m *Repository) ArchiveMealPlanCalendarFeedsForUser(ctx context.Context, userID, accountID string) error {
+	return m.Called(ctx, userID, accountID).Error(0)
+}
+
+// MarkMealPlanCalendarFeedAsAccessed is a mock function.
+func (m *Repository) MarkMealPlanCalendarFeedAsAccessed(ctx context.Context, mealPlanCalendarFeedID string) error {
+	return m.Called(ctx, mealPlanCalendarFeedID).Error(0)
+}
+
+// GetRecipeRevision is a mock function.
+func (m *Repository) GetRecipeRevision(ctx context.Context, recipeID string, revisionNumber uint32) (*mealplanning.RecipeRevision, error) {
+	returnValues := m.Called(ctx, recipeID, revisionNumber)
+	return returnValues.Get(0).(*mealplanning.RecipeRevision), returnValues.Error(1)
+}
+
+// GetRecipeRevisions is a mock function.
+func (m *Repository) GetRecipeRevisions(ctx context.Context, recipeID string, filter *filtering.QueryFilter) (*filtering.QueryFilteredResult[mealplanning.RecipeRevision], error) {
+	returnValues := m.Called(ctx, recipeID, filter)
+	return returnValues.Get(0).(*filtering.QueryFilteredResult[mealplanning.RecipeRevision]), returnValues.Error(1)
+}
+
+// RestoreRecipeRevision is a mock function.
+func (m *Repository) RestoreRecipeRevision(ctx context.Context, updated *mealplanning.Recipe, steps []*mealplanning.RecipeStepDatabaseCreationInput) error {
+	return m.Called(ctx, updated, steps).Error(0)
+}
+
+// GetMealPlanTemplate is a mock function.
+func (m *Repository) GetMealPlanTemplate(ctx context.Context, mealPlanTemplateID, accountID string) (*mealplanning.MealPlanTemplate, error) {
+	returnValues := m.Called(ctx, mealPlanTemplateID, accountID)
+	return returnValues.Get(0).(*mealplanning.MealPlanTemplate), returnValues.Error(1)
+}
+
+// GetMealPlanTemplatesForAccount is a mock function.
+func (m *Repository) GetMealPlanTemplatesForAccount(ctx context.Context, accountID string, filter *filtering.QueryFilter) (*filtering.QueryFilteredResult[mealplanning.MealPlanTemplate], error) {
+	returnValues := m.Called(ctx, accountID, filter)
+	return returnValues.Get(0).(*filtering.QueryFilteredResult[mealplanning.MealPlanTemplate]), returnValues.Error(1)
+}
+
+// GetMealPlanTemplatesDueForInstantiation is a mock function.
+func (m *Repository) GetMealPlanTemplatesDueForInstantiation(ctx context.Context, asOf time.Time) ([]*mealplanning.MealPlanTemplate, error) {
+	returnValues := m.Called(ctx, asOf)
+	return returnValues.Get(0).([]*mealplanning.MealPlanTemplate), returnValues.Error(1)
+}
+
+// CreateMealPlanTemplate is a mock function.
+func (m *Repository) CreateMealPlanTemplate(ctx context.Context, input *mealplanning.MealPlanTemplateDatabaseCreationInput) (*mealplanning.MealPlanTemplate, error) {
+	returnValues := m.Called(ctx, input)
+	return returnValues.Get(0).(*mealplanning.MealPlanTemplate), returnValues.Error(1)
+}
+
+// UpdateMealPlanTemplate is a mock function.
+func (m *Repository) UpdateMealPlanTemplate(ctx context.Context, updated *mealplanning.MealPlanTemplate) error {
+	return m.Called(ctx, updated).Error(0)
+}
+
+// ClaimMealPlanTemplateInstantiation is a mock function.
+func (m *Repository) ClaimMealPlanTemplateInstantiation(ctx context.Context, mealPlanTemplateID string, scheduledAt, claimedUntil time.Time) (bool, error) {
+	returnValues := m.Called(ctx, mealPlanTemplateID, scheduledAt, claimedUntil)
+	return returnValues.Bool(0), returnValues.Error(1)
+}
+
+// ReleaseMealPlanTemplateInstantiationClaim is a mock function.
+func (m *Repository) ReleaseMealPlanTemplateInstantiationClaim(ctx context.Context, mealPlanTemplateID string) error {
+	return m.Called(ctx, mealPlanTemplateID).Error(0)
+}
+
+// MarkMealPlanTemplateAsInstantiated is a mock function.
+func (m *Repository) MarkMealPlanTemplateAsInstantiated(ctx context.Context, mealPlanTemplateID string, instantiatedAt, nextInstantiationAt time.Time) (bool, error) {
+	returnValues := m.Called(ctx, mealPlanTemplateID, instantiatedAt, nextInstantiationAt)
+	return returnValues.Bool(0), returnValues.Error(1)
+}
+
+// ArchiveMealPlanTemplate is a mock function.
+func (m *Repository) ArchiveMealPlanTemplate(ctx context.Context, mealPlanTemplateID, accountID string) error {
+	return m.Called(ctx, mealPlanTemplateID, accountID).Error(0)
+}
@@ -0,0 +1,141 @@
+package mealplanning
+
+import (
+	"context"
+	"encoding/gob"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+const (
+	// NutritionMissingReasonNoNutritionFacts indicates an ingredient has no nutrition facts on record.
+	NutritionMissingReasonNoNutritionFacts = "no_nutrition_facts"
+	// NutritionMissingReasonNoConversion indicates an ingredient's measurement unit can't be converted into its nutrition facts' basis unit.
+	NutritionMissingReasonNoConversion = "no_conversion"
+)
+
+func init() {
+	gob.Register(new(NutritionRollup))
+	gob.Register(new(MealPlanNutritionRollup))
+}
+
+type (
+	// Nutrients is an amount of each of the nutrients we track.
+	Nutrients struct {
+		_ struct{} `json:"-"`
+
+		Calories                float32 `json:"calories"`
+		ProteinInGrams          float32 `json:"proteinInGrams"`
+		TotalFatInGrams         float32 `json:"totalFatInGrams"`
+		SaturatedFatInGrams     float32 `json:"saturatedFatInGrams"`
+		CarbohydratesInGrams    float32 `json:"carbohydratesInGrams"`
+		SugarInGrams            float32 `json:"sugarInGrams"`
+		FiberInGrams            float32 `json:"fiberInGrams"`
+		SodiumInMilligrams      float32 `json:"sodiumInMilligrams"`
+		CholesterolInMilligrams float32 `json:"cholesterolInMilligrams"`
+	}
+
+	// NutritionMissingIngredient is a recipe step ingredient that couldn't be counted towards a nutrition rollup.
+	NutritionMissingIngredient struct {
+		_ struct{} `json:"-"`
+
+		RecipeID               string `json:"recipeID"`
+		RecipeStepID           string `json:"recipeStepID"`
+		RecipeStepIngredientID string `json:"recipeStepIngredientID"`
+		ValidIngredientID      string `json:"validIngredientID"`
+		IngredientName         string `json:"ingredientName"`
+		MeasurementUnitID      string `json:"measurementUnitID"`
+		Reason                 string `json:"reason"`
+	}
+
+	// NutritionRollup is the nutrition of a recipe or meal at a given scale.
+	NutritionRollup struct {
+		_ struct{} `json:"-"`
+
+		// PerPortion is only provided when the number of portions is known.
+		PerPortion         *Nutrients                    `json:"perPortion"`
+		MissingIngredients []*NutritionMissingIngredient `json:"missingIngredients"`
+		Totals             Nutrients                     `json:"totals"`
+		EstimatedPortions  float32                       `json:"estimatedPortions"`
+		Scale              float32                       `json:"scale"`
+		// Complete is false when any ingredient had to be left out of the totals.
+		Complete bool `json:"complete"`
+	}
+
+	// MealPlanEventNutritionRollup is the nutrition of the chosen option for a meal plan event.
+	MealPlanEventNutritionRollup struct {
+		_ struct{} `json:"-"`
+
+		StartsAt         time.Time       `json:"startsAt"`
+		MealPlanEventID  string          `json:"mealPlanEventID"`
+		MealPlanOptionID string          `json:"mealPlanOptionID"`
+		MealID           string          `json:"mealID"`
+		MealName         string          `json:"mealName"`
+		Nutrition        NutritionRollup `json:"nutrition"`
+	}
+
+	// MealPlanNutritionRollup is the nutrition of every chosen meal in a finalized meal plan.
+	MealPlanNutritionRollup struct {
+		_ struct{} `json:"-"`
+
+		MealPlanID         string                          `json:"mealPlanID"`
+		Events             []*MealPlanEventNutritionRollup `json:"events"`
+		MissingIngredients []*NutritionMissingIngredient   `json:"missingIngredients"`
+		Totals             Nutrients                       `json:"totals"`
+		Complete           bool                            `json:"complete"`
+	}
+)
+
+// Add returns the sum of two amounts of nutrients.
+func (x Nutrients) Add(y Nutrients) Nutrients {
+	return Nutrients{
+		Calories:                x.Calories + y.Calories,
+		ProteinInGrams:          x.ProteinInGrams + y.ProteinInGrams,
+		TotalFatInGrams:         x.TotalFatInGrams + y.TotalFatInGrams,
+		SaturatedFatInGrams:     x.SaturatedFatInGrams + y.SaturatedFatInGrams,
+		CarbohydratesInGrams:    x.CarbohydratesInGrams + y.CarbohydratesInGrams,
+		SugarInGrams:            x.SugarInGrams + y.SugarInGrams,
+		FiberInGrams:            x.FiberInGrams + y.FiberInGrams,
+		SodiumInMilligrams:      x.SodiumInMilligrams + y.SodiumInMilligrams,
+		CholesterolInMilligrams: x.CholesterolInMilligrams + y.CholesterolInMilligrams,
+	}
+}
+
+// Scale returns the nutrients multiplied by a factor.
+func (x Nutrients) Scale(factor float64) Nutrients {
+	scale := func(v float32) float32 {
+		return float32(float64(v) * factor)
+	}
+
+	return Nutrients{
+		Calories:                scale(x.Calories),
+		ProteinInGrams:          scale(x.ProteinInGrams),
+		TotalFatInGrams:         scale(x.TotalFatInGrams),
+		SaturatedFatInGrams:     scale(x.SaturatedFatInGrams),
+		CarbohydratesInGrams:    scale(x.CarbohydratesInGrams),
+		SugarInGrams:            scale(x.SugarInGrams),
+		FiberInGrams:            scale(x.FiberInGrams),
+		SodiumInMilligrams:      scale(x.SodiumInMilligrams),
+		CholesterolInMilligrams: scale(x.CholesterolInMilligrams),
+	}
+}
+
+var _ validation.ValidatableWithContext = (*Nutrients)(nil)
+
+// ValidateWithContext validates a Nutrients.
+func (x Nutrients) ValidateWithContext(ctx context.Context) error {
+	return validation.ValidateStructWithContext(
+		ctx,
+		&x,
+		validation.Field(&x.Calories, validation.Min(float32(0))),
+		validation.Field(&x.ProteinInGrams, validation.Min(float32(0))),
+		validation.Field(&x.TotalFatInGrams, validation.Min(float32(0))),
+		validation.Field(&x.SaturatedFatInGrams, validation.Min(float32(0))),
+		validation.Field(&x.CarbohydratesInGrams, validation.Min(float32(0))),
+		validation.Field(&x.SugarInGrams, validation.Min(float32(0))),
+		validation.Field(&x.FiberInGrams, validation.Min(float32(0))),
+		validation.Field(&x.SodiumInMilligrams, validation.Min(float32(0))),
+		validation.Field(&x.CholesterolInMilligrams, validation.Min(float32(0))),
+	)
+}
@@ -0,0 +1,58 @@
+package mealplanning
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNutrients_Add(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		x := Nutrients{Calories: 100, ProteinInGrams: 2, SodiumInMilligrams: 10}
+		y := Nutrients{Calories: 50, TotalFatInGrams: 1, SodiumInMilligrams: 5}
+
+		expected := Nutrients{Calories: 150, ProteinInGrams: 2, TotalFatInGrams: 1, SodiumInMilligrams: 15}
+
+		assert.Equal(t, expected, x.Add(y))
+	})
+}
+
+func TestNutrients_Scale(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		x := Nutrients{Calories: 364, CarbohydratesInGrams: 76, CholesterolInMilligrams: 4}
+
+		expected := Nutrients{Calories: 91, CarbohydratesInGrams: 19, CholesterolInMilligrams: 1}
+
+		assert.Equal(t, expected, x.Scale(0.25))
+	})
+}
+
+func TestNutrients_Validate(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		x := buildNutrientsForTest()
+
+		actual := x.ValidateWithContext(t.Context())
+		assert.NoError(t, actual)
+	})
+
+	T.Run("with negative amounts", func(t *testing.T) {
+		t.Parallel()
+
+		x := Nutrients{SugarInGrams: -0.5}
+
+		actual := x.ValidateWithContext(t.Context())
+		assert.Error(t, actual)
+	})
+}
@@ -0,0 +1,187 @@
+package mealplanning
+
+import (
+	"context"
+	"encoding/gob"
+	"time"
+
+	"github.com/primandproper/platform/database/filtering"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+const (
+	// PantryItemCreatedServiceEventType indicates a pantry item was created.
+	PantryItemCreatedServiceEventType = "pantry_item_created"
+	// PantryItemUpdatedServiceEventType indicates a pantry item was updated.
+	PantryItemUpdatedServiceEventType = "pantry_item_updated"
+	// PantryItemArchivedServiceEventType indicates a pantry item was archived.
+	PantryItemArchivedServiceEventType = "pantry_item_archived"
+
+	// PantryStorageLocationPantry represents a pantry item kept in the pantry.
+	PantryStorageLocationPantry = "pantry"
+	// PantryStorageLocationRefrigerator represents a pantry item kept in the refrigerator.
+	PantryStorageLocationRefrigerator = "refrigerator"
+	// PantryStorageLocationFreezer represents a pantry item kept in the freezer.
+	PantryStorageLocationFreezer = "freezer"
+	// PantryStorageLocationOther represents a pantry item kept somewhere else.
+	PantryStorageLocationOther = "other"
+)
+
+func init() {
+	gob.Register(new(PantryItem))
+	gob.Register(new(PantryItemCreationRequestInput))
+	gob.Register(new(PantryItemUpdateRequestInput))
+}
+
+type (
+	// PantryItem represents an ingredient an account already has on hand.
+	PantryItem struct {
+		_ struct{} `json:"-"`
+
+		CreatedAt        time.Time            `json:"createdAt"`
+		ArchivedAt       *time.Time           `json:"archivedAt"`
+		LastUpdatedAt    *time.Time           `json:"lastUpdatedAt"`
+		ExpiresAt        *time.Time           `json:"expiresAt"`
+		ID               string               `json:"id"`
+		Notes            string               `json:"notes"`
+		StorageLocation  string               `json:"storageLocation"`
+		BelongsToAccount string               `json:"belongsToAccount"`
+		Ingredient       ValidIngredient      `json:"ingredient"`
+		MeasurementUnit  ValidMeasurementUnit `json:"measurementUnit"`
+		Quantity         float32              `json:"quantity"`
+	}
+
+	// PantryItemCreationRequestInput represents what a user could set as input for creating pantry items.
+	PantryItemCreationRequestInput struct {
+		_ struct{} `json:"-"`
+
+		ExpiresAt              *time.Time `json:"expiresAt"`
+		Notes                  string     `json:"notes"`
+		StorageLocation        string     `json:"storageLocation"`
+		ValidIngredientID      string     `json:"validIngredientID"`
+		ValidMeasurementUnitID string     `json:"validMeasurementUnitID"`
+		Quantity               float32    `json:"quantity"`
+	}
+
+	// PantryItemDatabaseCreationInput represents what a user could set as input for creating pantry items.
+	PantryItemDatabaseCreationInput struct {
+		_ struct{} `json:"-"`
+
+		ExpiresAt              *time.Time `json:"-"`
+		ID                     string     `json:"-"`
+		Notes                  string     `json:"-"`
+		StorageLocation        string     `json:"-"`
+		ValidIngredientID      string     `json:"-"`
+		ValidMeasurementUnitID string     `json:"-"`
+		BelongsToAccount       string     `json:"-"`
+		Quantity               float32    `json:"-"`
+	}
+
+	// PantryItemUpdateRequestInput represents what a user could set as input for updating pantry items.
+	PantryItemUpdateRequestInput struct {
+		_ struct{} `json:"-"`
+
+		ExpiresAt              *time.Time `json:"expiresAt"`
+		Notes                  *string    `json:"notes"`
+		StorageLocation        *string    `json:"storageLocation"`
+		ValidIngredientID      *string    `json:"validIngredientID"`
+		ValidMeasurementUnitID *string    `json:"validMeasurementUnitID"`
+		Quantity               *float32   `json:"quantity"`
+	}
+
+	// PantryItemDrawDown is how much of a pantry item to use up, in the pantry item's own measurement unit.
+	PantryItemDrawDown struct {
+		_ struct{} `json:"-"`
+
+		PantryItem *PantryItem `json:"-"`
+		Quantity   float32     `json:"-"`
+	}
+
+	// PantryItemDataManager describes a structure capable of storing pantry items permanently.
+	PantryItemDataManager interface {
+		PantryItemExists(ctx context.Context, pantryItemID, accountID string) (bool, error)
+		GetPantryItem(ctx context.Context, pantryItemID, accountID string) (*PantryItem, error)
+		GetPantryItems(ctx context.Context, accountID string, filter *filtering.QueryFilter) (*filtering.QueryFilteredResult[PantryItem], error)
+		GetAvailablePantryItemsForAccount(ctx context.Context, accountID string) ([]*PantryItem, error)
+		CreatePantryItem(ctx context.Context, input *PantryItemDatabaseCreationInput) (*PantryItem, error)
+		UpdatePantryItem(ctx context.Context, updated *PantryItem) error
+		ArchivePantryItem(ctx context.Context, pantryItemID, accountID string) error
+	}
+)
+
+// Update merges a PantryItemUpdateRequestInput with a pantry item.
+func (x *PantryItem) Update(input *PantryItemUpdateRequestInput) {
+	if input.Notes != nil && *input.Notes != x.Notes {
+		x.Notes = *input.Notes
+	}
+
+	if input.Quantity != nil && *input.Quantity != x.Quantity {
+		x.Quantity = *input.Quantity
+	}
+
+	if input.StorageLocation != nil && *input.StorageLocation != x.StorageLocation {
+		x.StorageLocation = *input.StorageLocation
+	}
+
+	if input.ExpiresAt != nil && (x.ExpiresAt == nil || !input.ExpiresAt.Equal(*x.ExpiresAt)) {
+		x.ExpiresAt = input.ExpiresAt
+	}
+
+	if input.ValidIngredientID != nil && *input.ValidIngredientID != x.Ingredient.ID {
+		x.Ingredient = ValidIngredient{ID: *input.ValidIngredientID}
+	}
+
+	if input.ValidMeasurementUnitID != nil && *input.ValidMeasurementUnitID != x.MeasurementUnit.ID {
+		x.MeasurementUnit = ValidMeasurementUnit{ID: *input.ValidMeasurementUnitID}
+	}
+}
+
+var validPantryStorageLocations = []any{
+	PantryStorageLocationPantry,
+	PantryStorageLocationRefrigerator,
+	PantryStorageLocationFreezer,
+	PantryStorageLocationOther,
+}
+
+var _ validation.ValidatableWithContext = (*PantryItemCreationRequestInput)(nil)
+
+// ValidateWithContext validates a PantryItemCreationRequestInput.
+func (x *PantryItemCreationRequestInput) ValidateWithContext(ctx context.Context) error {
+	return validation.ValidateStructWithContext(
+		ctx,
+		x,
+		validation.Field(&x.Quantity, validation.Min(float32(0))),
+		validation.Field(&x.StorageLocation, validation.In(validPantryStorageLocations...)),
+		validation.Field(&x.ValidIngredientID, validation.Required),
+		validation.Field(&x.ValidMeasurementUnitID, validation.Required),
+	)
+}
+
+var _ validation.ValidatableWithContext = (*PantryItemDatabaseCreationInput)(nil)
+
+// ValidateWithContext validates a PantryItemDatabaseCreationInput.
+func (x *PantryItemDatabaseCreationInput) ValidateWithContext(ctx context.Context) error {
+	return validation.ValidateStructWithContext(
+		ctx,
+		x,
+		validation.Field(&x.ID, validation.Required),
+		validation.Field(&x.Quantity, validation.Min(float32(0))),
+		validation.Field(&x.StorageLocation, validation.Required, validation.In(validPantryStorageLocations...)),
+		validation.Field(&x.ValidIngredientID, validation.Required),
+		validation.Field(&x.ValidMeasurementUnitID, validation.Required),
+		validation.Field(&x.BelongsToAccount, validation.Required),
+	)
+}
+
+var _ validation.ValidatableWithContext = (*PantryItemUpdateRequestInput)(nil)
+
+// ValidateWithContext validates a PantryItemUpdateRequestInput.
+func (x *PantryItemUpdateRequestInput) ValidateWithContext(ctx context.Context) error {
+	return validation.ValidateStructWithContext(
+		ctx,
+		x,
+		validation.Field(&x.Quantity, validation.Min(float32(0))),
+		validation.Field(&x.StorageLocation, validation.In(validPantryStorageLocations...)),
+	)
+}
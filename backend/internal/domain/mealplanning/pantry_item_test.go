@@ -0,0 +1,108 @@
+package mealplanning
+
+import (
+	"testing"
+
+	fake "github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPantryItem_Update(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		x := &PantryItem{}
+		input := &PantryItemUpdateRequestInput{}
+
+		assert.NoError(t, fake.Struct(&input))
+
+		x.Update(input)
+
+		assert.Equal(t, *input.Quantity, x.Quantity)
+		assert.Equal(t, *input.ValidIngredientID, x.Ingredient.ID)
+		assert.Equal(t, *input.ValidMeasurementUnitID, x.MeasurementUnit.ID)
+	})
+}
+
+func TestPantryItemCreationRequestInput_ValidateWithContext(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		x := &PantryItemCreationRequestInput{
+			Quantity:               1,
+			StorageLocation:        PantryStorageLocationRefrigerator,
+			ValidIngredientID:      t.Name(),
+			ValidMeasurementUnitID: t.Name(),
+		}
+
+		assert.NoError(t, x.ValidateWithContext(ctx))
+	})
+
+	T.Run("with invalid storage location", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		x := &PantryItemCreationRequestInput{
+			Quantity:               1,
+			StorageLocation:        "garage",
+			ValidIngredientID:      t.Name(),
+			ValidMeasurementUnitID: t.Name(),
+		}
+
+		assert.Error(t, x.ValidateWithContext(ctx))
+	})
+
+	T.Run("with negative quantity", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		x := &PantryItemCreationRequestInput{
+			Quantity:               -1,
+			ValidIngredientID:      t.Name(),
+			ValidMeasurementUnitID: t.Name(),
+		}
+
+		assert.Error(t, x.ValidateWithContext(ctx))
+	})
+}
+
+func TestPantryItemDatabaseCreationInput_ValidateWithContext(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		x := &PantryItemDatabaseCreationInput{
+			ID:                     t.Name(),
+			Quantity:               1,
+			StorageLocation:        PantryStorageLocationPantry,
+			ValidIngredientID:      t.Name(),
+			ValidMeasurementUnitID: t.Name(),
+			BelongsToAccount:       t.Name(),
+		}
+
+		assert.NoError(t, x.ValidateWithContext(ctx))
+	})
+}
+
+func TestPantryItemUpdateRequestInput_ValidateWithContext(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		x := &PantryItemUpdateRequestInput{
+			Quantity:        new(float32(1)),
+			StorageLocation: new(PantryStorageLocationFreezer),
+		}
+
+		assert.NoError(t, x.ValidateWithContext(ctx))
+	})
+}
@@ -94,5 +94,13 @@ func (c *Collector) CollectAccountData(ctx context.Context, collection *datapriv
 		collection.MealPlanning.AccountInstrumentOwnerships = append(collection.MealPlanning.AccountInstrumentOwnerships, *ownership)
 	}
 
+	pantryItems, err := c.repo.GetPantryItems(ctx, accountID, nil)
+	if err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "fetching pantry items")
+	}
+	for _, pantryItem := range pantryItems.Data {
+		collection.MealPlanning.PantryItems = append(collection.MealPlanning.PantryItems, *pantryItem)
+	}
+
 	return nil
 }
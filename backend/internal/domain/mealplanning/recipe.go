@@ -221,6 +221,22 @@ func (x *Recipe) FindStepForRecipeStepProductIDWithRecipe(recipeStepProductID st
 	return nil, nil
 }
 
+// GetAllPreparationIDs returns the distinct PreparationIDs used by the recipe's steps.
+func (x *RecipeDatabaseCreationInput) GetAllPreparationIDs() []string {
+	seen := make(map[string]struct{})
+	var ids []string
+	for _, step := range x.Steps {
+		if step.PreparationID == "" {
+			continue
+		}
+		if _, ok := seen[step.PreparationID]; !ok {
+			seen[step.PreparationID] = struct{}{}
+			ids = append(ids, step.PreparationID)
+		}
+	}
+	return ids
+}
+
 // GetRelatedRecipeIDs returns all recipe IDs that this recipe references as components.
 // It iterates through all steps and their ingredients to find any that reference other recipes
 // via RecipeStepProductRecipeID.
@@ -92,12 +92,22 @@ func init() {
 		Notes           *string  `json:"notes"`
 	}
 
+	// RecipeRatingSummary is the average overall rating the members of an account have given a recipe.
+	RecipeRatingSummary struct {
+		_ struct{} `json:"-"`
+
+		RecipeID       string  `json:"recipeID"`
+		AverageOverall float32 `json:"averageOverall"`
+		RatingCount    uint32  `json:"ratingCount"`
+	}
+
 	// RecipeRatingDataManager describes a structure capable of storing recipe ratings permanently.
 	RecipeRatingDataManager interface {
 		RecipeRatingExists(ctx context.Context, recipeID, recipeRatingID string) (bool, error)
 		GetRecipeRating(ctx context.Context, recipeID, recipeRatingID string) (*RecipeRating, error)
 		GetRecipeRatingsForRecipe(ctx context.Context, recipeID string, filter *filtering.QueryFilter) (*filtering.QueryFilteredResult[RecipeRating], error)
 		GetRecipeRatingsForUser(ctx context.Context, userID string, filter *filtering.QueryFilter) (*filtering.QueryFilteredResult[RecipeRating], error)
+		GetRecipeRatingSummariesForAccount(ctx context.Context, accountID string, recipeIDs []string) ([]*RecipeRatingSummary, error)
 		CreateRecipeRating(ctx context.Context, input *RecipeRatingDatabaseCreationInput) (*RecipeRating, error)
 		UpdateRecipeRating(ctx context.Context, updated *RecipeRating) error
 		ArchiveRecipeRating(ctx context.Context, recipeID, recipeRatingID string) error
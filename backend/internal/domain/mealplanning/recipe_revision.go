@@ -0,0 +1,396 @@
+package mealplanning
+
+import (
+	"context"
+	"encoding/gob"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/primandproper/platform/database/filtering"
+)
+
+const (
+	// RecipeRevisionRestoredServiceEventType indicates a recipe was restored to one of its revisions.
+	RecipeRevisionRestoredServiceEventType = "recipe_revision_restored"
+
+	// RecipeDiffChangeAdded indicates something is present in the later revision but not the earlier one.
+	RecipeDiffChangeAdded = "added"
+	// RecipeDiffChangeRemoved indicates something is present in the earlier revision but not the later one.
+	RecipeDiffChangeRemoved = "removed"
+	// RecipeDiffChangeModified indicates something is present in both revisions, but differs between them.
+	RecipeDiffChangeModified = "modified"
+)
+
+func init() {
+	gob.Register(new(RecipeRevision))
+	gob.Register(new(RecipeRevisionDiff))
+}
+
+type (
+	// RecipeRevision is an immutable, numbered snapshot of a recipe and its steps.
+	RecipeRevision struct {
+		_ struct{} `json:"-"`
+
+		CreatedAt       time.Time `json:"createdAt"`
+		CreatedByUser   *string   `json:"createdByUser"`
+		Snapshot        *Recipe   `json:"snapshot,omitempty"`
+		ID              string    `json:"id"`
+		BelongsToRecipe string    `json:"belongsToRecipe"`
+		RevisionNumber  uint32    `json:"revisionNumber"`
+	}
+
+	// RecipeRevisionDatabaseCreationInput is used for recording a recipe revision. Revision numbers are assigned
+	// by the database.
+	RecipeRevisionDatabaseCreationInput struct {
+		_ struct{} `json:"-"`
+
+		CreatedByUser   *string `json:"-"`
+		Snapshot        *Recipe `json:"-"`
+		ID              string  `json:"-"`
+		BelongsToRecipe string  `json:"-"`
+	}
+
+	// MealPlanOptionRecipeRevision is the revision of one of a meal plan option's recipes that the option is pinned to.
+	MealPlanOptionRecipeRevision struct {
+		_ struct{} `json:"-"`
+
+		Snapshot         *Recipe `json:"snapshot,omitempty"`
+		RecipeID         string  `json:"recipeID"`
+		RecipeRevisionID string  `json:"recipeRevisionID"`
+		RevisionNumber   uint32  `json:"revisionNumber"`
+	}
+
+	// RecipeRevisionDiff is a structured comparison of two revisions of a recipe.
+	RecipeRevisionDiff struct {
+		_ struct{} `json:"-"`
+
+		RecipeID     string               `json:"recipeID"`
+		Changes      []*RecipeFieldChange `json:"changes"`
+		Steps        []*RecipeStepDiff    `json:"steps"`
+		FromRevision uint32               `json:"fromRevision"`
+		ToRevision   uint32               `json:"toRevision"`
+	}
+
+	// RecipeFieldChange describes a single field whose value differs between two revisions.
+	RecipeFieldChange struct {
+		_ struct{} `json:"-"`
+
+		Field string `json:"field"`
+		From  string `json:"from"`
+		To    string `json:"to"`
+	}
+
+	// RecipeStepDiff describes how a recipe step differs between two revisions.
+	RecipeStepDiff struct {
+		_ struct{} `json:"-"`
+
+		StepID      string                 `json:"stepID"`
+		Change      string                 `json:"change"`
+		Changes     []*RecipeFieldChange   `json:"changes"`
+		Ingredients []*RecipeComponentDiff `json:"ingredients"`
+		Instruments []*RecipeComponentDiff `json:"instruments"`
+		Vessels     []*RecipeComponentDiff `json:"vessels"`
+		Products    []*RecipeComponentDiff `json:"products"`
+		Index       uint32                 `json:"index"`
+	}
+
+	// RecipeComponentDiff describes how one of a recipe step's ingredients, instruments, vessels or products differs
+	// between two revisions.
+	RecipeComponentDiff struct {
+		_ struct{} `json:"-"`
+
+		ID      string               `json:"id"`
+		Name    string               `json:"name"`
+		Change  string               `json:"change"`
+		Changes []*RecipeFieldChange `json:"changes"`
+		Index   uint16               `json:"index"`
+	}
+
+	// RecipeRevisionDataManager describes a structure capable of storing recipe revisions permanently.
+	RecipeRevisionDataManager interface {
+		GetRecipeRevision(ctx context.Context, recipeID string, revisionNumber uint32) (*RecipeRevision, error)
+		GetRecipeRevisions(ctx context.Context, recipeID string, filter *filtering.QueryFilter) (*filtering.QueryFilteredResult[RecipeRevision], error)
+		RestoreRecipeRevision(ctx context.Context, updated *Recipe, steps []*RecipeStepDatabaseCreationInput) error
+	}
+)
+
+// DiffRecipes compares two snapshots of the same recipe. Steps are matched by their index, and their components by
+// their index within the step, so that the comparison stays meaningful across restores, which recreate steps with
+// new IDs.
+func DiffRecipes(from, to *Recipe) *RecipeRevisionDiff {
+	diff := &RecipeRevisionDiff{
+		Changes: []*RecipeFieldChange{},
+		Steps:   []*RecipeStepDiff{},
+	}
+
+	if from == nil {
+		from = &Recipe{}
+	}
+	if to == nil {
+		to = &Recipe{}
+	}
+	diff.RecipeID = to.ID
+
+	changes := &fieldChanges{}
+	changes.add("name", from.Name, to.Name)
+	changes.add("slug", from.Slug, to.Slug)
+	changes.add("description", from.Description, to.Description)
+	changes.add("source", from.Source, to.Source)
+	changes.add("sourceISBN", from.SourceISBN, to.SourceISBN)
+	changes.add("portionName", from.PortionName, to.PortionName)
+	changes.add("pluralPortionName", from.PluralPortionName, to.PluralPortionName)
+	changes.add("minEstimatedPortions", fmt.Sprint(from.MinEstimatedPortions), fmt.Sprint(to.MinEstimatedPortions))
+	changes.add("maxEstimatedPortions", formatOptional(from.MaxEstimatedPortions), formatOptional(to.MaxEstimatedPortions))
+	changes.add("inspiredByRecipeID", formatOptional(from.InspiredByRecipeID), formatOptional(to.InspiredByRecipeID))
+	changes.add("yieldsComponentType", from.YieldsComponentType, to.YieldsComponentType)
+	changes.add("eligibleForMeals", fmt.Sprint(from.EligibleForMeals), fmt.Sprint(to.EligibleForMeals))
+	diff.Changes = *changes
+
+	fromSteps := map[uint32]*RecipeStep{}
+	for _, step := range from.Steps {
+		fromSteps[step.Index] = step
+	}
+
+	toSteps := map[uint32]*RecipeStep{}
+	for _, step := range to.Steps {
+		toSteps[step.Index] = step
+	}
+
+	for _, index := range sortedKeys(fromSteps, toSteps) {
+		if stepDiff := diffRecipeSteps(index, fromSteps[index], toSteps[index]); stepDiff != nil {
+			diff.Steps = append(diff.Steps, stepDiff)
+		}
+	}
+
+	return diff
+}
+
+// diffRecipeSteps compares two versions of a recipe step, either of which may be nil. It returns nil if they're the same.
+func diffRecipeSteps(index uint32, from, to *RecipeStep) *RecipeStepDiff {
+	diff := &RecipeStepDiff{
+		Index:       index,
+		Change:      RecipeDiffChangeModified,
+		Changes:     []*RecipeFieldChange{},
+		Ingredients: []*RecipeComponentDiff{},
+		Instruments: []*RecipeComponentDiff{},
+		Vessels:     []*RecipeComponentDiff{},
+		Products:    []*RecipeComponentDiff{},
+	}
+
+	switch {
+	case from == nil:
+		diff.Change = RecipeDiffChangeAdded
+		diff.StepID = to.ID
+		from = &RecipeStep{}
+	case to == nil:
+		diff.Change = RecipeDiffChangeRemoved
+		diff.StepID = from.ID
+		to = &RecipeStep{}
+	default:
+		diff.StepID = to.ID
+	}
+
+	changes := &fieldChanges{}
+	changes.add("preparation", from.Preparation.Name, to.Preparation.Name)
+	changes.add("notes", from.Notes, to.Notes)
+	changes.add("explicitInstructions", from.ExplicitInstructions, to.ExplicitInstructions)
+	changes.add("conditionExpression", from.ConditionExpression, to.ConditionExpression)
+	changes.add("minEstimatedTimeInSeconds", formatOptional(from.MinEstimatedTimeInSeconds), formatOptional(to.MinEstimatedTimeInSeconds))
+	changes.add("maxEstimatedTimeInSeconds", formatOptional(from.MaxEstimatedTimeInSeconds), formatOptional(to.MaxEstimatedTimeInSeconds))
+	changes.add("minTemperatureInCelsius", formatOptional(from.MinTemperatureInCelsius), formatOptional(to.MinTemperatureInCelsius))
+	changes.add("maxTemperatureInCelsius", formatOptional(from.MaxTemperatureInCelsius), formatOptional(to.MaxTemperatureInCelsius))
+	changes.add("optional", fmt.Sprint(from.Optional), fmt.Sprint(to.Optional))
+	changes.add("startTimerAutomatically", fmt.Sprint(from.StartTimerAutomatically), fmt.Sprint(to.StartTimerAutomatically))
+	diff.Changes = *changes
+
+	diff.Ingredients = diffRecipeComponents(from.Ingredients, to.Ingredients, func(x *RecipeStepIngredient) (id, name string, index uint16) {
+		return x.ID, x.Name, x.Index
+	}, diffRecipeStepIngredients)
+	diff.Instruments = diffRecipeComponents(from.Instruments, to.Instruments, func(x *RecipeStepInstrument) (id, name string, index uint16) {
+		return x.ID, x.Name, x.Index
+	}, diffRecipeStepInstruments)
+	diff.Vessels = diffRecipeComponents(from.Vessels, to.Vessels, func(x *RecipeStepVessel) (id, name string, index uint16) {
+		return x.ID, x.Name, x.Index
+	}, diffRecipeStepVessels)
+	diff.Products = diffRecipeComponents(from.Products, to.Products, func(x *RecipeStepProduct) (id, name string, index uint16) {
+		return x.ID, x.Name, x.Index
+	}, diffRecipeStepProducts)
+
+	if diff.Change == RecipeDiffChangeModified &&
+		len(diff.Changes) == 0 &&
+		len(diff.Ingredients) == 0 &&
+		len(diff.Instruments) == 0 &&
+		len(diff.Vessels) == 0 &&
+		len(diff.Products) == 0 {
+		return nil
+	}
+
+	return diff
+}
+
+// diffRecipeComponents compares two versions of a recipe step's components, matching them by their index.
+func diffRecipeComponents[T any](from, to []*T, identify func(*T) (id, name string, index uint16), compare func(from, to *T) []*RecipeFieldChange) []*RecipeComponentDiff {
+	fromByIndex := map[uint16]*T{}
+	for _, x := range from {
+		_, _, index := identify(x)
+		fromByIndex[index] = x
+	}
+
+	toByIndex := map[uint16]*T{}
+	for _, x := range to {
+		_, _, index := identify(x)
+		toByIndex[index] = x
+	}
+
+	diffs := []*RecipeComponentDiff{}
+	for _, index := range sortedKeys(fromByIndex, toByIndex) {
+		f, t := fromByIndex[index], toByIndex[index]
+
+		diff := &RecipeComponentDiff{Index: index, Changes: []*RecipeFieldChange{}}
+		switch {
+		case f == nil:
+			diff.ID, diff.Name, _ = identify(t)
+			diff.Change = RecipeDiffChangeAdded
+			diff.Changes = compare(new(T), t)
+		case t == nil:
+			diff.ID, diff.Name, _ = identify(f)
+			diff.Change = RecipeDiffChangeRemoved
+			diff.Changes = compare(f, new(T))
+		default:
+			diff.ID, diff.Name, _ = identify(t)
+			diff.Change = RecipeDiffChangeModified
+			diff.Changes = compare(f, t)
+			if len(diff.Changes) == 0 {
+				continue
+			}
+		}
+
+		diffs = append(diffs, diff)
+	}
+
+	return diffs
+}
+
+func diffRecipeStepIngredients(from, to *RecipeStepIngredient) []*RecipeFieldChange {
+	changes := &fieldChanges{}
+	changes.add("name", from.Name, to.Name)
+	changes.add("ingredient", validIngredientName(from.Ingredient), validIngredientName(to.Ingredient))
+	changes.add("measurementUnit", from.MeasurementUnit.Name, to.MeasurementUnit.Name)
+	changes.add("minQuantity", fmt.Sprint(from.MinQuantity), fmt.Sprint(to.MinQuantity))
+	changes.add("maxQuantity", formatOptional(from.MaxQuantity), formatOptional(to.MaxQuantity))
+	changes.add("quantityNotes", from.QuantityNotes, to.QuantityNotes)
+	changes.add("ingredientNotes", from.IngredientNotes, to.IngredientNotes)
+	changes.add("productPercentageToUse", formatOptional(from.ProductPercentageToUse), formatOptional(to.ProductPercentageToUse))
+	changes.add("optional", fmt.Sprint(from.Optional), fmt.Sprint(to.Optional))
+	changes.add("toTaste", fmt.Sprint(from.ToTaste), fmt.Sprint(to.ToTaste))
+
+	return *changes
+}
+
+func diffRecipeStepInstruments(from, to *RecipeStepInstrument) []*RecipeFieldChange {
+	changes := &fieldChanges{}
+	changes.add("name", from.Name, to.Name)
+	changes.add("instrument", validInstrumentName(from.Instrument), validInstrumentName(to.Instrument))
+	changes.add("minQuantity", fmt.Sprint(from.MinQuantity), fmt.Sprint(to.MinQuantity))
+	changes.add("maxQuantity", formatOptional(from.MaxQuantity), formatOptional(to.MaxQuantity))
+	changes.add("notes", from.Notes, to.Notes)
+	changes.add("preferenceRank", fmt.Sprint(from.PreferenceRank), fmt.Sprint(to.PreferenceRank))
+	changes.add("optional", fmt.Sprint(from.Optional), fmt.Sprint(to.Optional))
+
+	return *changes
+}
+
+func diffRecipeStepVessels(from, to *RecipeStepVessel) []*RecipeFieldChange {
+	changes := &fieldChanges{}
+	changes.add("name", from.Name, to.Name)
+	changes.add("vessel", validVesselName(from.Vessel), validVesselName(to.Vessel))
+	changes.add("vesselPreposition", from.VesselPreposition, to.VesselPreposition)
+	changes.add("minQuantity", fmt.Sprint(from.MinQuantity), fmt.Sprint(to.MinQuantity))
+	changes.add("maxQuantity", formatOptional(from.MaxQuantity), formatOptional(to.MaxQuantity))
+	changes.add("notes", from.Notes, to.Notes)
+	changes.add("unavailableAfterStep", fmt.Sprint(from.UnavailableAfterStep), fmt.Sprint(to.UnavailableAfterStep))
+
+	return *changes
+}
+
+func diffRecipeStepProducts(from, to *RecipeStepProduct) []*RecipeFieldChange {
+	changes := &fieldChanges{}
+	changes.add("name", from.Name, to.Name)
+	changes.add("type", from.Type, to.Type)
+	changes.add("measurementUnit", validMeasurementUnitName(from.MeasurementUnit), validMeasurementUnitName(to.MeasurementUnit))
+	changes.add("minMeasurementQuantity", formatOptional(from.MinMeasurementQuantity), formatOptional(to.MinMeasurementQuantity))
+	changes.add("maxMeasurementQuantity", formatOptional(from.MaxMeasurementQuantity), formatOptional(to.MaxMeasurementQuantity))
+	changes.add("quantityNotes", from.QuantityNotes, to.QuantityNotes)
+	changes.add("storageInstructions", from.StorageInstructions, to.StorageInstructions)
+	changes.add("isWaste", fmt.Sprint(from.IsWaste), fmt.Sprint(to.IsWaste))
+	changes.add("isLiquid", fmt.Sprint(from.IsLiquid), fmt.Sprint(to.IsLiquid))
+	changes.add("compostable", fmt.Sprint(from.Compostable), fmt.Sprint(to.Compostable))
+
+	return *changes
+}
+
+type fieldChanges []*RecipeFieldChange
+
+func (c *fieldChanges) add(field, from, to string) {
+	if from != to {
+		*c = append(*c, &RecipeFieldChange{Field: field, From: from, To: to})
+	}
+}
+
+func formatOptional[T any](x *T) string {
+	if x == nil {
+		return ""
+	}
+
+	return fmt.Sprint(*x)
+}
+
+func validIngredientName(x *ValidIngredient) string {
+	if x == nil {
+		return ""
+	}
+
+	return x.Name
+}
+
+func validInstrumentName(x *ValidInstrument) string {
+	if x == nil {
+		return ""
+	}
+
+	return x.Name
+}
+
+func validVesselName(x *ValidVessel) string {
+	if x == nil {
+		return ""
+	}
+
+	return x.Name
+}
+
+func validMeasurementUnitName(x *ValidMeasurementUnit) string {
+	if x == nil {
+		return ""
+	}
+
+	return x.Name
+}
+
+func sortedKeys[K uint16 | uint32, V any](maps ...map[K]V) []K {
+	seen := map[K]bool{}
+	keys := []K{}
+	for _, m := range maps {
+		for k := range m {
+			if !seen[k] {
+				seen[k] = true
+				keys = append(keys, k)
+			}
+		}
+	}
+
+	slices.Sort(keys)
+
+	return keys
+}
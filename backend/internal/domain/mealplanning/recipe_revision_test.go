@@ -0,0 +1,143 @@
+package mealplanning
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func buildRecipeForDiffing() *Recipe {
+	return &Recipe{
+		ID:                   "recipe",
+		Name:                 "pancakes",
+		MinEstimatedPortions: 2,
+		Steps: []*RecipeStep{
+			{
+				ID:          "step_one",
+				Index:       0,
+				Preparation: ValidPreparation{Name: "mix"},
+				Ingredients: []*RecipeStepIngredient{
+					{ID: "flour", Name: "flour", Index: 0, MinQuantity: 200, MeasurementUnit: ValidMeasurementUnit{Name: "gram"}},
+					{ID: "milk", Name: "milk", Index: 1, MinQuantity: 250, MeasurementUnit: ValidMeasurementUnit{Name: "milliliter"}},
+				},
+				Instruments: []*RecipeStepInstrument{
+					{ID: "whisk", Name: "whisk", Index: 0, MinQuantity: 1},
+				},
+				Products: []*RecipeStepProduct{
+					{ID: "batter", Name: "batter", Index: 0},
+				},
+			},
+			{
+				ID:          "step_two",
+				Index:       1,
+				Preparation: ValidPreparation{Name: "fry"},
+				Vessels: []*RecipeStepVessel{
+					{ID: "pan", Name: "pan", Index: 0, MinQuantity: 1},
+				},
+			},
+		},
+	}
+}
+
+func TestDiffRecipes(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with identical recipes", func(t *testing.T) {
+		t.Parallel()
+
+		actual := DiffRecipes(buildRecipeForDiffing(), buildRecipeForDiffing())
+
+		assert.Equal(t, "recipe", actual.RecipeID)
+		assert.Empty(t, actual.Changes)
+		assert.Empty(t, actual.Steps)
+	})
+
+	T.Run("with changed recipe fields", func(t *testing.T) {
+		t.Parallel()
+
+		to := buildRecipeForDiffing()
+		to.Name = "crepes"
+		to.MaxEstimatedPortions = new(float32(4))
+
+		actual := DiffRecipes(buildRecipeForDiffing(), to)
+
+		require.Len(t, actual.Changes, 2)
+		assert.Equal(t, &RecipeFieldChange{Field: "name", From: "pancakes", To: "crepes"}, actual.Changes[0])
+		assert.Equal(t, &RecipeFieldChange{Field: "maxEstimatedPortions", From: "", To: "4"}, actual.Changes[1])
+		assert.Empty(t, actual.Steps)
+	})
+
+	T.Run("with changed ingredient quantities and instruments", func(t *testing.T) {
+		t.Parallel()
+
+		to := buildRecipeForDiffing()
+		to.Steps[0].Ingredients[1].MinQuantity = 300
+		to.Steps[0].Instruments = append(to.Steps[0].Instruments, &RecipeStepInstrument{ID: "bowl", Name: "bowl", Index: 1, MinQuantity: 1})
+
+		actual := DiffRecipes(buildRecipeForDiffing(), to)
+
+		require.Len(t, actual.Steps, 1)
+		step := actual.Steps[0]
+		assert.Equal(t, "step_one", step.StepID)
+		assert.Equal(t, RecipeDiffChangeModified, step.Change)
+		assert.Empty(t, step.Changes)
+
+		require.Len(t, step.Ingredients, 1)
+		assert.Equal(t, "milk", step.Ingredients[0].ID)
+		assert.Equal(t, RecipeDiffChangeModified, step.Ingredients[0].Change)
+		assert.Equal(t, []*RecipeFieldChange{{Field: "minQuantity", From: "250", To: "300"}}, step.Ingredients[0].Changes)
+
+		require.Len(t, step.Instruments, 1)
+		assert.Equal(t, "bowl", step.Instruments[0].ID)
+		assert.Equal(t, RecipeDiffChangeAdded, step.Instruments[0].Change)
+
+		assert.Empty(t, step.Vessels)
+		assert.Empty(t, step.Products)
+	})
+
+	T.Run("with added and removed steps", func(t *testing.T) {
+		t.Parallel()
+
+		to := buildRecipeForDiffing()
+		to.Steps = to.Steps[:1]
+
+		actual := DiffRecipes(buildRecipeForDiffing(), to)
+
+		require.Len(t, actual.Steps, 1)
+		assert.Equal(t, "step_two", actual.Steps[0].StepID)
+		assert.Equal(t, RecipeDiffChangeRemoved, actual.Steps[0].Change)
+		require.Len(t, actual.Steps[0].Vessels, 1)
+		assert.Equal(t, RecipeDiffChangeRemoved, actual.Steps[0].Vessels[0].Change)
+
+		actual = DiffRecipes(to, buildRecipeForDiffing())
+
+		require.Len(t, actual.Steps, 1)
+		assert.Equal(t, RecipeDiffChangeAdded, actual.Steps[0].Change)
+		assert.Equal(t, []*RecipeFieldChange{{Field: "preparation", From: "", To: "fry"}}, actual.Steps[0].Changes)
+	})
+
+	T.Run("matches steps by index rather than ID", func(t *testing.T) {
+		t.Parallel()
+
+		to := buildRecipeForDiffing()
+		for _, step := range to.Steps {
+			step.ID += "_restored"
+		}
+
+		actual := DiffRecipes(buildRecipeForDiffing(), to)
+
+		assert.Empty(t, actual.Steps)
+	})
+
+	T.Run("with nil recipes", func(t *testing.T) {
+		t.Parallel()
+
+		actual := DiffRecipes(nil, buildRecipeForDiffing())
+
+		require.Len(t, actual.Steps, 2)
+		for _, step := range actual.Steps {
+			assert.Equal(t, RecipeDiffChangeAdded, step.Change)
+		}
+	})
+}
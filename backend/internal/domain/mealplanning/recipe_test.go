@@ -683,6 +683,26 @@ func TestRecipeDatabaseCreationInput_GetAllValidPreparationVesselIDs(T *testing.
 	})
 }
 
+func TestRecipeDatabaseCreationInput_GetAllPreparationIDs(T *testing.T) {
+	T.Parallel()
+
+	T.Run("returns distinct IDs in step order", func(t *testing.T) {
+		t.Parallel()
+
+		x := &RecipeDatabaseCreationInput{
+			Steps: []*RecipeStepDatabaseCreationInput{
+				{PreparationID: "prep-1"},
+				{PreparationID: "prep-2"},
+				{PreparationID: "prep-1"},
+				{PreparationID: ""},
+			},
+		}
+
+		ids := x.GetAllPreparationIDs()
+		assert.Equal(t, []string{"prep-1", "prep-2"}, ids)
+	})
+}
+
 func TestRecipeDatabaseCreationInput_GetRelatedRecipeIDs(T *testing.T) {
 	T.Parallel()
 
@@ -0,0 +1,178 @@
+package recipeanalysis
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+	mealplanningkeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/keys"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/stepconditions"
+
+	"github.com/primandproper/platform/observability"
+)
+
+type (
+	// ConditionalStepState is the context a recipe's step conditions are evaluated in.
+	// The zero value describes the recipe as written: unscaled, with every option group on its default option.
+	ConditionalStepState struct {
+		Selections []*mealplanning.MealPlanRecipeOptionSelection
+		Scale      float32
+	}
+
+	// ConditionalStepPlan describes which of a recipe's steps will be performed, and which were pruned
+	// because their conditions evaluated to false. Steps of associated recipes are included.
+	ConditionalStepPlan struct {
+		// InvalidConditions holds steps whose stored condition could not be parsed. These steps are kept.
+		InvalidConditions map[*mealplanning.RecipeStep]error
+		pruned            map[*mealplanning.RecipeStep]bool
+		IncludedSteps     []*mealplanning.RecipeStep
+		PrunedSteps       []*mealplanning.RecipeStep
+	}
+)
+
+// Includes reports whether a step will be performed.
+func (p *ConditionalStepPlan) Includes(step *mealplanning.RecipeStep) bool {
+	return p == nil || !p.pruned[step]
+}
+
+// recipeConditionState implements stepconditions.State for a single recipe as its steps are planned in order.
+type recipeConditionState struct {
+	ingredients    map[string]bool
+	products       map[string]bool
+	selections     map[string]uint16
+	stepIDsByIndex map[uint32]string
+	scale          float64
+}
+
+func optionSelectionKey(stepID string, ingredientIndex uint16) string {
+	return fmt.Sprintf("%s:%d", stepID, ingredientIndex)
+}
+
+func newRecipeConditionState(recipe *mealplanning.Recipe, state *ConditionalStepState) *recipeConditionState {
+	s := &recipeConditionState{
+		scale:          1,
+		ingredients:    map[string]bool{},
+		products:       map[string]bool{},
+		selections:     map[string]uint16{},
+		stepIDsByIndex: map[uint32]string{},
+	}
+
+	if state != nil {
+		if state.Scale > 0 {
+			s.scale = float64(state.Scale)
+		}
+
+		for _, selection := range state.Selections {
+			if selection.SelectionType == mealplanning.MealPlanRecipeOptionSelectionTypeIngredient {
+				s.selections[optionSelectionKey(selection.RecipeStepID, selection.IngredientIndex)] = selection.SelectedOptionIndex
+			}
+		}
+	}
+
+	// an ingredient is present if it's used by the selected option of its option group (or isn't part of one)
+	for _, step := range recipe.Steps {
+		s.stepIDsByIndex[step.Index] = step.ID
+
+		optionCounts := map[uint16]int{}
+		for _, ingredient := range step.Ingredients {
+			optionCounts[ingredient.Index]++
+		}
+
+		for _, ingredient := range step.Ingredients {
+			if ingredient.Ingredient == nil {
+				continue
+			}
+
+			if optionCounts[ingredient.Index] > 1 && ingredient.OptionIndex != s.selections[optionSelectionKey(step.ID, ingredient.Index)] {
+				continue
+			}
+
+			s.ingredients[ingredient.Ingredient.ID] = true
+		}
+	}
+
+	return s
+}
+
+func (s *recipeConditionState) Scale() float64 {
+	return s.scale
+}
+
+func (s *recipeConditionState) IngredientPresent(ingredientID string) bool {
+	return s.ingredients[ingredientID]
+}
+
+func (s *recipeConditionState) OptionSelected(stepIndex uint32, ingredientIndex, optionIndex uint16) bool {
+	stepID, ok := s.stepIDsByIndex[stepIndex]
+	if !ok {
+		return false
+	}
+
+	// option groups without a selection default to their first option.
+	return s.selections[optionSelectionKey(stepID, ingredientIndex)] == optionIndex
+}
+
+func (s *recipeConditionState) ProductProduced(productName string) bool {
+	return s.products[productName]
+}
+
+// planRecipeSteps evaluates the conditions for one recipe's steps in index order, so that
+// product_produced only sees products of earlier steps that will actually be performed.
+func planRecipeSteps(recipe *mealplanning.Recipe, state *ConditionalStepState, plan *ConditionalStepPlan) {
+	conditionState := newRecipeConditionState(recipe, state)
+
+	steps := make([]*mealplanning.RecipeStep, len(recipe.Steps))
+	copy(steps, recipe.Steps)
+	sort.SliceStable(steps, func(i, j int) bool { return steps[i].Index < steps[j].Index })
+
+	for _, step := range steps {
+		expression, err := stepconditions.Parse(step.ConditionExpression)
+		if err != nil {
+			plan.InvalidConditions[step] = err
+		}
+
+		if !expression.Evaluate(conditionState) {
+			plan.pruned[step] = true
+			plan.PrunedSteps = append(plan.PrunedSteps, step)
+			continue
+		}
+
+		plan.IncludedSteps = append(plan.IncludedSteps, step)
+		for _, product := range step.Products {
+			conditionState.products[product.Name] = true
+		}
+	}
+}
+
+// buildConditionalStepPlan plans the steps of a recipe and its associated recipes.
+func buildConditionalStepPlan(recipe *mealplanning.Recipe, state *ConditionalStepState) *ConditionalStepPlan {
+	plan := &ConditionalStepPlan{
+		InvalidConditions: map[*mealplanning.RecipeStep]error{},
+		pruned:            map[*mealplanning.RecipeStep]bool{},
+		IncludedSteps:     []*mealplanning.RecipeStep{},
+		PrunedSteps:       []*mealplanning.RecipeStep{},
+	}
+
+	planRecipeSteps(recipe, state, plan)
+	for _, associatedRecipe := range recipe.AssociatedRecipes {
+		planRecipeSteps(associatedRecipe, state, plan)
+	}
+
+	return plan
+}
+
+// PlanConditionalSteps evaluates a recipe's step conditions and reports which steps will be performed.
+func (g *recipeAnalyzer) PlanConditionalSteps(ctx context.Context, recipe *mealplanning.Recipe, state *ConditionalStepState) *ConditionalStepPlan {
+	_, span := g.tracer.StartSpan(ctx)
+	defer span.End()
+
+	plan := buildConditionalStepPlan(recipe, state)
+
+	for step, err := range plan.InvalidConditions {
+		logger := g.logger.Clone().WithValue(mealplanningkeys.RecipeIDKey, recipe.ID).WithValue(mealplanningkeys.RecipeStepIDKey, step.ID)
+		observability.AcknowledgeError(err, logger, span, "parsing recipe step condition")
+	}
+
+	return plan
+}
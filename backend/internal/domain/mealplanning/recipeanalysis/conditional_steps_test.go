@@ -0,0 +1,226 @@
+package recipeanalysis
+
+import (
+	"testing"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/fakes"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// buildConditionalRecipe builds a recipe where step 0 chooses between butter and oil, step 1 browns the butter
+// only if it was chosen, step 2 only happens for big batches, and step 3 uses the browned butter if there is any.
+func buildConditionalRecipe() (recipe *mealplanning.Recipe, butter *mealplanning.ValidIngredient) {
+	butter = fakes.BuildFakeValidIngredient()
+	oil := fakes.BuildFakeValidIngredient()
+	brownedButterID := fakes.BuildFakeID()
+
+	recipe = &mealplanning.Recipe{
+		ID: fakes.BuildFakeID(),
+		Steps: []*mealplanning.RecipeStep{
+			{
+				ID:    fakes.BuildFakeID(),
+				Index: 0,
+				Ingredients: []*mealplanning.RecipeStepIngredient{
+					{Ingredient: butter, Index: 0, OptionIndex: 0},
+					{Ingredient: oil, Index: 0, OptionIndex: 1},
+				},
+			},
+			{
+				ID:                  fakes.BuildFakeID(),
+				Index:               1,
+				ConditionExpression: `ingredient_present("` + butter.ID + `")`,
+				Products: []*mealplanning.RecipeStepProduct{
+					{ID: brownedButterID, Name: "browned butter", Type: mealplanning.RecipeStepProductIngredientType},
+				},
+			},
+			{
+				ID:                  fakes.BuildFakeID(),
+				Index:               2,
+				ConditionExpression: `scale >= 2`,
+			},
+			{
+				ID:                  fakes.BuildFakeID(),
+				Index:               3,
+				ConditionExpression: `product_produced("browned butter")`,
+				Ingredients: []*mealplanning.RecipeStepIngredient{
+					{RecipeStepProductID: &brownedButterID},
+				},
+			},
+		},
+	}
+
+	return recipe, butter
+}
+
+func TestRecipeAnalyzer_PlanConditionalSteps(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with default state", func(t *testing.T) {
+		t.Parallel()
+
+		g := newAnalyzerForTest(t)
+		recipe, _ := buildConditionalRecipe()
+
+		plan := g.PlanConditionalSteps(t.Context(), recipe, nil)
+
+		assert.Equal(t, []*mealplanning.RecipeStep{recipe.Steps[0], recipe.Steps[1], recipe.Steps[3]}, plan.IncludedSteps)
+		assert.Equal(t, []*mealplanning.RecipeStep{recipe.Steps[2]}, plan.PrunedSteps)
+		assert.False(t, plan.Includes(recipe.Steps[2]))
+		assert.Empty(t, plan.InvalidConditions)
+	})
+
+	T.Run("with option selection pruning dependent steps", func(t *testing.T) {
+		t.Parallel()
+
+		g := newAnalyzerForTest(t)
+		recipe, _ := buildConditionalRecipe()
+
+		plan := g.PlanConditionalSteps(t.Context(), recipe, &ConditionalStepState{
+			Scale: 3,
+			Selections: []*mealplanning.MealPlanRecipeOptionSelection{
+				{
+					RecipeStepID:        recipe.Steps[0].ID,
+					SelectionType:       mealplanning.MealPlanRecipeOptionSelectionTypeIngredient,
+					IngredientIndex:     0,
+					SelectedOptionIndex: 1,
+				},
+			},
+		})
+
+		// oil was chosen, so the butter is never browned, and the step that uses it is skipped too.
+		assert.Equal(t, []*mealplanning.RecipeStep{recipe.Steps[0], recipe.Steps[2]}, plan.IncludedSteps)
+		assert.Equal(t, []*mealplanning.RecipeStep{recipe.Steps[1], recipe.Steps[3]}, plan.PrunedSteps)
+	})
+
+	T.Run("keeps steps with invalid conditions", func(t *testing.T) {
+		t.Parallel()
+
+		g := newAnalyzerForTest(t)
+		recipe, _ := buildConditionalRecipe()
+		recipe.Steps[2].ConditionExpression = "not a valid condition"
+
+		plan := g.PlanConditionalSteps(t.Context(), recipe, nil)
+
+		assert.True(t, plan.Includes(recipe.Steps[2]))
+		require.Len(t, plan.InvalidConditions, 1)
+		assert.Contains(t, plan.InvalidConditions, recipe.Steps[2])
+	})
+}
+
+func TestRecipeAnalyzer_MakeGraphForRecipe_WithConditionalSteps(T *testing.T) {
+	T.Parallel()
+
+	T.Run("leaves out pruned steps", func(t *testing.T) {
+		t.Parallel()
+
+		g := newAnalyzerForTest(t)
+		recipe, _ := buildConditionalRecipe()
+
+		actual, err := g.MakeGraphForRecipe(t.Context(), recipe, nil)
+		require.NoError(t, err)
+
+		assert.Equal(t, 3, actual.Nodes().Len())
+		assert.Nil(t, actual.Node(graphIDForMainStep(recipe.Steps[2])))
+		assert.True(t, actual.HasEdgeFromTo(graphIDForMainStep(recipe.Steps[1]), graphIDForMainStep(recipe.Steps[3])))
+	})
+
+	T.Run("skips edges from pruned producers", func(t *testing.T) {
+		t.Parallel()
+
+		g := newAnalyzerForTest(t)
+		recipe, _ := buildConditionalRecipe()
+		// the consumer no longer checks for the product, so it stays while its producer is pruned.
+		recipe.Steps[1].ConditionExpression = "false"
+		recipe.Steps[3].ConditionExpression = ""
+
+		actual, err := g.MakeGraphForRecipe(t.Context(), recipe, nil)
+		require.NoError(t, err)
+
+		assert.Nil(t, actual.Node(graphIDForMainStep(recipe.Steps[1])))
+		assert.NotNil(t, actual.Node(graphIDForMainStep(recipe.Steps[3])))
+	})
+
+	T.Run("with scale", func(t *testing.T) {
+		t.Parallel()
+
+		g := newAnalyzerForTest(t)
+		recipe, _ := buildConditionalRecipe()
+
+		actual, err := g.MakeGraphForRecipe(t.Context(), recipe, &ConditionalStepState{Scale: 2})
+		require.NoError(t, err)
+
+		assert.Equal(t, 4, actual.Nodes().Len())
+		assert.NotNil(t, actual.Node(graphIDForMainStep(recipe.Steps[2])))
+	})
+}
+
+func TestRecipeAnalyzer_GenerateMealPlanTasksForRecipe_WithConditionalSteps(T *testing.T) {
+	T.Parallel()
+
+	T.Run("skips tasks for pruned steps", func(t *testing.T) {
+		t.Parallel()
+
+		g := newAnalyzerForTest(t)
+		recipe, _ := buildConditionalRecipe()
+
+		frozen := fakes.BuildFakeValidIngredient()
+		frozen.MinStorageTemperatureInCelsius = new(float32(-18))
+		recipe.Steps[2].Ingredients = []*mealplanning.RecipeStepIngredient{{Ingredient: frozen}}
+
+		recipe.PrepTasks = []*mealplanning.RecipePrepTask{
+			{
+				ID:        fakes.BuildFakeID(),
+				TaskSteps: []*mealplanning.RecipePrepTaskStep{{BelongsToRecipeStep: recipe.Steps[2].ID}},
+			},
+			{
+				ID:        fakes.BuildFakeID(),
+				TaskSteps: []*mealplanning.RecipePrepTaskStep{{BelongsToRecipeStep: recipe.Steps[1].ID}},
+			},
+		}
+
+		actual, err := g.GenerateMealPlanTasksForRecipe(t.Context(), fakes.BuildFakeID(), recipe, nil)
+		require.NoError(t, err)
+
+		// no thaw task for the pruned step's frozen ingredient, and only the second prep task.
+		require.Len(t, actual, 1)
+		assert.Equal(t, recipe.PrepTasks[1].ID, actual[0].RecipePrepTaskID)
+	})
+
+	T.Run("evaluates conditions against the option's selections and scale", func(t *testing.T) {
+		t.Parallel()
+
+		g := newAnalyzerForTest(t)
+		recipe, _ := buildConditionalRecipe()
+
+		recipe.PrepTasks = []*mealplanning.RecipePrepTask{
+			{
+				ID:        fakes.BuildFakeID(),
+				TaskSteps: []*mealplanning.RecipePrepTaskStep{{BelongsToRecipeStep: recipe.Steps[2].ID}},
+			},
+			{
+				ID:        fakes.BuildFakeID(),
+				TaskSteps: []*mealplanning.RecipePrepTaskStep{{BelongsToRecipeStep: recipe.Steps[1].ID}},
+			},
+		}
+
+		actual, err := g.GenerateMealPlanTasksForRecipe(t.Context(), fakes.BuildFakeID(), recipe, &ConditionalStepState{
+			Scale: 3,
+			Selections: []*mealplanning.MealPlanRecipeOptionSelection{
+				{
+					RecipeStepID:        recipe.Steps[0].ID,
+					SelectionType:       mealplanning.MealPlanRecipeOptionSelectionTypeIngredient,
+					IngredientIndex:     0,
+					SelectedOptionIndex: 1,
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		// the big batch step is kept, and the butter browning step is skipped because oil was chosen.
+		require.Len(t, actual, 1)
+		assert.Equal(t, recipe.PrepTasks[0].ID, actual[0].RecipePrepTaskID)
+	})
+}
@@ -0,0 +1,485 @@
+package recipeanalysis
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+
+	"github.com/primandproper/platform/observability"
+
+	"gonum.org/v1/gonum/graph/simple"
+	"gonum.org/v1/gonum/graph/topo"
+)
+
+const (
+	// defaultCookTimelineStepDuration is how long we assume a step takes when it has no time estimate.
+	defaultCookTimelineStepDuration = 5 * time.Minute
+
+	// ganttDateFormat is the Go layout matching the dateFormat we declare in Gantt charts.
+	ganttDateFormat = "2006-01-02T15:04:05"
+
+	instrumentResourceKind = "instrument"
+	vesselResourceKind     = "vessel"
+)
+
+var (
+	// ErrCookTimelineResourceUnavailable is returned when a step needs more of an instrument or vessel than the kitchen has.
+	ErrCookTimelineResourceUnavailable = errors.New("step requires more of a resource than is available")
+)
+
+// CookTimelineConstraints describes the kitchen a meal is being scheduled for.
+type CookTimelineConstraints struct {
+	ServingTime time.Time
+	// InstrumentCapacities and VesselCapacities map valid instrument and vessel IDs to how many are available.
+	// Anything not listed is assumed to be unlimited.
+	InstrumentCapacities map[string]uint32
+	VesselCapacities     map[string]uint32
+	Selections           []*mealplanning.MealPlanRecipeOptionSelection
+	MealScale            float32
+	CookCount            uint32
+}
+
+type cookTimelineResource struct {
+	kind string
+	id   string
+}
+
+type cookTimelineResourceUsage struct {
+	start, end time.Duration
+	quantity   uint32
+}
+
+// cookTimelineNode is a step being scheduled. Scheduling happens backwards from the serving time,
+// so reverseStart and reverseEnd are measured as time before serving.
+type cookTimelineNode struct {
+	resources     map[cookTimelineResource]uint32
+	item          mealStepItem
+	predecessors  []*cookTimelineNode
+	successors    []*cookTimelineNode
+	duration      time.Duration
+	earliestStart time.Duration
+	latestStart   time.Duration
+	head          time.Duration
+	reverseStart  time.Duration
+	reverseEnd    time.Duration
+	order         int
+	cook          int
+	passive       bool
+}
+
+// cookTimelineStepDuration returns how long a step is expected to take, preferring the conservative estimate.
+func cookTimelineStepDuration(step *mealplanning.RecipeStep) time.Duration {
+	switch {
+	case step.MaxEstimatedTimeInSeconds != nil:
+		return time.Duration(*step.MaxEstimatedTimeInSeconds) * time.Second
+	case step.MinEstimatedTimeInSeconds != nil:
+		return time.Duration(*step.MinEstimatedTimeInSeconds) * time.Second
+	default:
+		return defaultCookTimelineStepDuration
+	}
+}
+
+// cookTimelineStepIsPassive reports whether a step runs on its own once it's started, like baking or simmering. Passive
+// steps still occupy their instruments and vessels, but they don't need a cook's hands while they run. We take a step
+// starting its timer automatically to mean it's one of these.
+func cookTimelineStepIsPassive(step *mealplanning.RecipeStep) bool {
+	return step.StartTimerAutomatically
+}
+
+// cookTimelineResourcesForStep returns the constrained instruments and vessels a step occupies while it's performed.
+// Only the first option of each option group counts, and optional instruments and vessels are ignored.
+func cookTimelineResourcesForStep(step *mealplanning.RecipeStep, constraints *CookTimelineConstraints) map[cookTimelineResource]uint32 {
+	resources := map[cookTimelineResource]uint32{}
+
+	for _, instrument := range step.Instruments {
+		if instrument.Instrument == nil || instrument.Optional || instrument.OptionIndex != 0 {
+			continue
+		}
+
+		if _, ok := constraints.InstrumentCapacities[instrument.Instrument.ID]; ok {
+			resources[cookTimelineResource{kind: instrumentResourceKind, id: instrument.Instrument.ID}] += max(instrument.MinQuantity, 1)
+		}
+	}
+
+	for _, vessel := range step.Vessels {
+		if vessel.Vessel == nil || vessel.OptionIndex != 0 {
+			continue
+		}
+
+		if _, ok := constraints.VesselCapacities[vessel.Vessel.ID]; ok {
+			resources[cookTimelineResource{kind: vesselResourceKind, id: vessel.Vessel.ID}] += uint32(max(vessel.MinQuantity, 1))
+		}
+	}
+
+	return resources
+}
+
+func (c *CookTimelineConstraints) capacityFor(resource cookTimelineResource) uint32 {
+	if resource.kind == instrumentResourceKind {
+		return c.InstrumentCapacities[resource.id]
+	}
+	return c.VesselCapacities[resource.id]
+}
+
+// buildCookTimelineNodes turns the meal graph into scheduling nodes, ordered topologically.
+func buildCookTimelineNodes(meal *mealplanning.Meal, mealGraph *simple.DirectedGraph, constraints *CookTimelineConstraints) ([]*cookTimelineNode, error) {
+	sorted, err := topo.SortStabilized(mealGraph, nil)
+	if err != nil {
+		return nil, errNotAcyclic
+	}
+
+	itemsByGraphID := map[int64]mealStepItem{}
+	for _, item := range allMealSteps(meal) {
+		itemsByGraphID[mealGraphID(item.componentIndex, item.loc)] = item
+	}
+
+	nodesByGraphID := map[int64]*cookTimelineNode{}
+	nodes := make([]*cookTimelineNode, 0, len(sorted))
+	for i, graphNode := range sorted {
+		item := itemsByGraphID[graphNode.ID()]
+		node := &cookTimelineNode{
+			item:      item,
+			order:     i,
+			duration:  cookTimelineStepDuration(item.step),
+			resources: cookTimelineResourcesForStep(item.step, constraints),
+			passive:   cookTimelineStepIsPassive(item.step),
+		}
+
+		for resource, quantity := range node.resources {
+			if quantity > constraints.capacityFor(resource) {
+				return nil, fmt.Errorf("%w: %s step %d needs %d of %s %s", ErrCookTimelineResourceUnavailable, item.recipe.Name, item.step.Index+1, quantity, resource.kind, resource.id)
+			}
+		}
+
+		nodesByGraphID[graphNode.ID()] = node
+		nodes = append(nodes, node)
+	}
+
+	for _, graphNode := range sorted {
+		node := nodesByGraphID[graphNode.ID()]
+		successors := mealGraph.From(graphNode.ID())
+		for successors.Next() {
+			successor := nodesByGraphID[successors.Node().ID()]
+			node.successors = append(node.successors, successor)
+			successor.predecessors = append(successor.predecessors, node)
+		}
+	}
+
+	return nodes, nil
+}
+
+// computeCriticalPath runs the critical path method over the step dependencies, ignoring cooks and
+// equipment, and returns how long the meal would take with unlimited hands and equipment.
+func computeCriticalPath(nodes []*cookTimelineNode) time.Duration {
+	var length time.Duration
+	for _, node := range nodes {
+		for _, predecessor := range node.predecessors {
+			node.earliestStart = max(node.earliestStart, predecessor.earliestStart+predecessor.duration)
+		}
+		node.head = node.earliestStart + node.duration
+		length = max(length, node.head)
+	}
+
+	for i := len(nodes) - 1; i >= 0; i-- {
+		node := nodes[i]
+		latestFinish := length
+		for _, successor := range node.successors {
+			latestFinish = min(latestFinish, successor.latestStart)
+		}
+		node.latestStart = latestFinish - node.duration
+	}
+
+	return length
+}
+
+// cookTimelineScheduler places steps as late as possible while respecting cooks and equipment.
+type cookTimelineScheduler struct {
+	constraints *CookTimelineConstraints
+	resources   map[cookTimelineResource][]cookTimelineResourceUsage
+	cooks       [][]cookTimelineResourceUsage
+}
+
+func newCookTimelineScheduler(constraints *CookTimelineConstraints) *cookTimelineScheduler {
+	return &cookTimelineScheduler{
+		constraints: constraints,
+		resources:   map[cookTimelineResource][]cookTimelineResourceUsage{},
+		cooks:       make([][]cookTimelineResourceUsage, max(constraints.CookCount, 1)),
+	}
+}
+
+// peakUsage returns the most of a resource in use at any moment in [start, end), along with the earliest
+// time one of the overlapping usages ends.
+func peakUsage(usages []cookTimelineResourceUsage, start, end time.Duration) (peak uint32, firstRelease time.Duration) {
+	firstRelease = time.Duration(math.MaxInt64)
+
+	var overlapping []cookTimelineResourceUsage
+	for _, usage := range usages {
+		if usage.start < end && usage.end > start {
+			overlapping = append(overlapping, usage)
+			firstRelease = min(firstRelease, usage.end)
+		}
+	}
+
+	// usage only increases at the start of an overlapping usage (or the start of the window), so checking those points suffices.
+	for _, candidate := range overlapping {
+		at := max(candidate.start, start)
+
+		var inUse uint32
+		for _, usage := range overlapping {
+			if usage.start <= at && usage.end > at {
+				inUse += usage.quantity
+			}
+		}
+
+		peak = max(peak, inUse)
+	}
+
+	return peak, firstRelease
+}
+
+// fit checks whether a node can be performed during [start, start+duration). If it can, it returns the cook
+// to assign, or -1 for passive steps that don't need one; if not, it returns the next start worth trying.
+func (s *cookTimelineScheduler) fit(node *cookTimelineNode, start time.Duration) (cook int, next time.Duration, ok bool) {
+	end := start + node.duration
+	next = start
+
+	cook = -1
+	if !node.passive {
+		earliestCookRelease := time.Duration(math.MaxInt64)
+		for i, busy := range s.cooks {
+			inUse, release := peakUsage(busy, start, end)
+			if inUse == 0 {
+				cook = i
+				break
+			}
+			earliestCookRelease = min(earliestCookRelease, release)
+		}
+		if cook < 0 {
+			next = max(next, earliestCookRelease)
+		}
+	}
+
+	for resource, quantity := range node.resources {
+		inUse, release := peakUsage(s.resources[resource], start, end)
+		if inUse+quantity > s.constraints.capacityFor(resource) {
+			next = max(next, release)
+		}
+	}
+
+	return cook, next, next == start
+}
+
+// place assigns a node the latest slot (earliest in reverse time) that respects its successors, cooks, and equipment.
+func (s *cookTimelineScheduler) place(node *cookTimelineNode) {
+	var start time.Duration
+	for _, successor := range node.successors {
+		start = max(start, successor.reverseEnd)
+	}
+
+	if node.passive {
+		node.cook = -1
+	}
+
+	if node.duration > 0 {
+		for {
+			cook, next, ok := s.fit(node, start)
+			if ok {
+				node.cook = cook
+				if cook >= 0 {
+					s.cooks[cook] = append(s.cooks[cook], cookTimelineResourceUsage{start: start, end: start + node.duration, quantity: 1})
+				}
+				break
+			}
+			start = next
+		}
+
+		for resource, quantity := range node.resources {
+			s.resources[resource] = append(s.resources[resource], cookTimelineResourceUsage{start: start, end: start + node.duration, quantity: quantity})
+		}
+	}
+
+	node.reverseStart = start
+	node.reverseEnd = start + node.duration
+}
+
+// schedule places every node, always picking the ready step with the most work ahead of it,
+// so long chains of preparation get first pick of cooks and equipment.
+func (s *cookTimelineScheduler) schedule(nodes []*cookTimelineNode) time.Duration {
+	var ready []*cookTimelineNode
+	remainingSuccessors := map[*cookTimelineNode]int{}
+	for _, node := range nodes {
+		remainingSuccessors[node] = len(node.successors)
+		if len(node.successors) == 0 {
+			ready = append(ready, node)
+		}
+	}
+
+	var makespan time.Duration
+	for len(ready) > 0 {
+		sort.SliceStable(ready, func(i, j int) bool {
+			if ready[i].head != ready[j].head {
+				return ready[i].head > ready[j].head
+			}
+			return ready[i].order > ready[j].order
+		})
+
+		node := ready[0]
+		ready = ready[1:]
+
+		s.place(node)
+		makespan = max(makespan, node.reverseEnd)
+
+		for _, predecessor := range node.predecessors {
+			remainingSuccessors[predecessor]--
+			if remainingSuccessors[predecessor] == 0 {
+				ready = append(ready, predecessor)
+			}
+		}
+	}
+
+	return makespan
+}
+
+// cookTimelineStepLabel returns a display label for a step on a cook timeline.
+func cookTimelineStepLabel(item mealStepItem) string {
+	return fmt.Sprintf("%s (%s)", stepLabelForDiagram(item.loc, item.step, item.recipe), item.recipe.Name)
+}
+
+// ScheduleCookTimelineForMeal schedules every step of a meal so that it's done at the serving time, working
+// backwards so each step finishes as close to when it's needed as cooks and equipment allow.
+func (g *recipeAnalyzer) ScheduleCookTimelineForMeal(ctx context.Context, meal *mealplanning.Meal, constraints *CookTimelineConstraints) (*mealplanning.CookTimeline, error) {
+	ctx, span := g.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if meal == nil || constraints == nil {
+		return nil, errors.New("meal and constraints are required")
+	}
+
+	logger := g.logger.WithSpan(span)
+
+	mealGraph, _, err := g.makeGraphForMeal(ctx, meal, &ConditionalStepState{
+		Scale:      constraints.MealScale,
+		Selections: constraints.Selections,
+	})
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "building meal graph")
+	}
+
+	nodes, err := buildCookTimelineNodes(meal, mealGraph, constraints)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "building cook timeline nodes")
+	}
+
+	criticalPathLength := computeCriticalPath(nodes)
+	makespan := newCookTimelineScheduler(constraints).schedule(nodes)
+	startsAt := constraints.ServingTime.Add(-makespan)
+
+	timeline := &mealplanning.CookTimeline{
+		StartsAt:               startsAt,
+		ServingTime:            constraints.ServingTime,
+		MealID:                 meal.ID,
+		CriticalPath:           []string{},
+		Steps:                  []*mealplanning.CookTimelineStep{},
+		TotalDurationInSeconds: uint32(makespan / time.Second),
+		CriticalPathInSeconds:  uint32(criticalPathLength / time.Second),
+		CookCount:              max(constraints.CookCount, 1),
+	}
+
+	sort.SliceStable(nodes, func(i, j int) bool {
+		if nodes[i].reverseEnd != nodes[j].reverseEnd {
+			return nodes[i].reverseEnd > nodes[j].reverseEnd
+		}
+		return nodes[i].order < nodes[j].order
+	})
+
+	for _, node := range nodes {
+		startOffset := makespan - node.reverseEnd
+		endOffset := makespan - node.reverseStart
+		slack := node.latestStart - node.earliestStart
+		critical := slack == 0
+
+		if critical {
+			timeline.CriticalPath = append(timeline.CriticalPath, node.item.step.ID)
+		}
+
+		timeline.Steps = append(timeline.Steps, &mealplanning.CookTimelineStep{
+			StartsAt:             startsAt.Add(startOffset),
+			EndsAt:               startsAt.Add(endOffset),
+			RecipeStepID:         node.item.step.ID,
+			RecipeID:             node.item.recipe.ID,
+			RecipeName:           node.item.recipe.Name,
+			Label:                cookTimelineStepLabel(node.item),
+			StartOffsetInSeconds: uint32(startOffset / time.Second),
+			EndOffsetInSeconds:   uint32(endOffset / time.Second),
+			SlackInSeconds:       uint32(slack / time.Second),
+			StepIndex:            node.item.step.Index,
+			Cook:                 uint32(node.cook + 1),
+			Critical:             critical,
+		})
+	}
+
+	return timeline, nil
+}
+
+// ganttTaskName strips characters Mermaid treats as syntax from a Gantt task name.
+func ganttTaskName(label string) string {
+	return strings.NewReplacer(":", " -", ";", ",", "#", "no. ", "\n", " ").Replace(label)
+}
+
+// RenderMermaidGanttChartForCookTimeline renders a cook timeline as a Mermaid Gantt chart, with a section per recipe.
+func (g *recipeAnalyzer) RenderMermaidGanttChartForCookTimeline(ctx context.Context, timeline *mealplanning.CookTimeline) string {
+	_, span := g.tracer.StartSpan(ctx)
+	defer span.End()
+
+	var mermaid strings.Builder
+	mermaid.WriteString("gantt\n")
+	mermaid.WriteString("\tdateFormat YYYY-MM-DDTHH:mm:ss\n")
+	mermaid.WriteString("\taxisFormat %H:%M\n")
+
+	if timeline == nil {
+		return mermaid.String()
+	}
+
+	var sections []string
+	stepsBySection := map[string][]*mealplanning.CookTimelineStep{}
+	for _, step := range timeline.Steps {
+		if _, ok := stepsBySection[step.RecipeName]; !ok {
+			sections = append(sections, step.RecipeName)
+		}
+		stepsBySection[step.RecipeName] = append(stepsBySection[step.RecipeName], step)
+	}
+
+	for _, section := range sections {
+		if _, err := fmt.Fprintf(&mermaid, "\tsection %s\n", ganttTaskName(section)); err != nil {
+			observability.AcknowledgeError(err, g.logger, span, "writing mermaid gantt section")
+		}
+
+		for _, step := range stepsBySection[section] {
+			name := ganttTaskName(step.Label)
+			if timeline.CookCount > 1 && step.Cook > 0 {
+				name = fmt.Sprintf("%s [cook %d]", name, step.Cook)
+			}
+
+			var tags []string
+			if step.Critical {
+				tags = append(tags, "crit")
+			}
+			if step.StartsAt.Equal(step.EndsAt) {
+				tags = append(tags, "milestone")
+			}
+			tags = append(tags, step.StartsAt.UTC().Format(ganttDateFormat), step.EndsAt.UTC().Format(ganttDateFormat))
+
+			if _, err := fmt.Fprintf(&mermaid, "\t%s :%s\n", name, strings.Join(tags, ", ")); err != nil {
+				observability.AcknowledgeError(err, g.logger, span, "writing mermaid gantt task")
+			}
+		}
+	}
+
+	return mermaid.String()
+}
@@ -0,0 +1,269 @@
+package recipeanalysis
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/fakes"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// buildMealForCookTimeline builds a meal where the main dish is chopped (10 minutes) and then roasted
+// (30 minutes), and the side is roasted (20 minutes) on its own. Both roasting steps need the oven.
+func buildMealForCookTimeline() (meal *mealplanning.Meal, oven *mealplanning.ValidInstrument) {
+	oven = fakes.BuildFakeValidInstrument()
+	chop := fakes.BuildFakeValidPreparation()
+	chop.Name = "chop"
+	roast := fakes.BuildFakeValidPreparation()
+	roast.Name = "roast"
+
+	choppedID := fakes.BuildFakeID()
+	mainRecipe := mealplanning.Recipe{
+		ID:   fakes.BuildFakeID(),
+		Name: "Roasted Chicken",
+		Steps: []*mealplanning.RecipeStep{
+			{
+				ID:                        fakes.BuildFakeID(),
+				Index:                     0,
+				Preparation:               *chop,
+				MaxEstimatedTimeInSeconds: new(uint32(600)),
+				Products:                  []*mealplanning.RecipeStepProduct{{ID: choppedID, Name: "chopped chicken"}},
+			},
+			{
+				ID:                        fakes.BuildFakeID(),
+				Index:                     1,
+				Preparation:               *roast,
+				MaxEstimatedTimeInSeconds: new(uint32(1800)),
+				Ingredients:               []*mealplanning.RecipeStepIngredient{{RecipeStepProductID: &choppedID}},
+				Instruments:               []*mealplanning.RecipeStepInstrument{{Instrument: oven, MinQuantity: 1}},
+			},
+		},
+	}
+
+	sideRecipe := mealplanning.Recipe{
+		ID:   fakes.BuildFakeID(),
+		Name: "Roasted Broccoli",
+		Steps: []*mealplanning.RecipeStep{
+			{
+				ID:                        fakes.BuildFakeID(),
+				Index:                     0,
+				Preparation:               *roast,
+				MinEstimatedTimeInSeconds: new(uint32(1200)),
+				Instruments:               []*mealplanning.RecipeStepInstrument{{Instrument: oven, MinQuantity: 1}},
+			},
+		},
+	}
+
+	meal = &mealplanning.Meal{
+		ID:   fakes.BuildFakeID(),
+		Name: "Roast Dinner",
+		Components: []*mealplanning.MealComponent{
+			{ComponentType: mealplanning.MealComponentTypesMain, Recipe: mainRecipe, RecipeScale: 1},
+			{ComponentType: mealplanning.MealComponentTypesSide, Recipe: sideRecipe, RecipeScale: 1},
+		},
+	}
+
+	return meal, oven
+}
+
+func findCookTimelineStep(t *testing.T, timeline *mealplanning.CookTimeline, stepID string) *mealplanning.CookTimelineStep {
+	t.Helper()
+
+	for _, step := range timeline.Steps {
+		if step.RecipeStepID == stepID {
+			return step
+		}
+	}
+
+	require.FailNow(t, "step not found in timeline", stepID)
+	return nil
+}
+
+func TestRecipeAnalyzer_ScheduleCookTimelineForMeal(T *testing.T) {
+	T.Parallel()
+
+	servingTime := time.Date(2026, time.October, 17, 18, 0, 0, 0, time.UTC)
+
+	T.Run("with unlimited equipment", func(t *testing.T) {
+		t.Parallel()
+
+		g := newAnalyzerForTest(t)
+		meal, _ := buildMealForCookTimeline()
+		chop, roastChicken, roastBroccoli := meal.Components[0].Recipe.Steps[0], meal.Components[0].Recipe.Steps[1], meal.Components[1].Recipe.Steps[0]
+
+		actual, err := g.ScheduleCookTimelineForMeal(t.Context(), meal, &CookTimelineConstraints{
+			ServingTime: servingTime,
+			CookCount:   2,
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, uint32(2400), actual.TotalDurationInSeconds)
+		assert.Equal(t, uint32(2400), actual.CriticalPathInSeconds)
+		assert.Equal(t, servingTime.Add(-40*time.Minute), actual.StartsAt)
+		assert.Equal(t, []string{chop.ID, roastChicken.ID}, actual.CriticalPath)
+		require.Len(t, actual.Steps, 3)
+
+		chicken := findCookTimelineStep(t, actual, roastChicken.ID)
+		assert.Equal(t, uint32(600), chicken.StartOffsetInSeconds)
+		assert.Equal(t, uint32(2400), chicken.EndOffsetInSeconds)
+		assert.Equal(t, servingTime, chicken.EndsAt)
+		assert.True(t, chicken.Critical)
+
+		// the broccoli goes in as late as possible, so it's done right at serving time.
+		broccoli := findCookTimelineStep(t, actual, roastBroccoli.ID)
+		assert.Equal(t, servingTime, broccoli.EndsAt)
+		assert.Equal(t, uint32(1200), broccoli.StartOffsetInSeconds)
+		assert.Equal(t, uint32(1200), broccoli.SlackInSeconds)
+		assert.False(t, broccoli.Critical)
+		assert.NotEqual(t, chicken.Cook, broccoli.Cook)
+	})
+
+	T.Run("with a single oven", func(t *testing.T) {
+		t.Parallel()
+
+		g := newAnalyzerForTest(t)
+		meal, oven := buildMealForCookTimeline()
+		chop, roastChicken, roastBroccoli := meal.Components[0].Recipe.Steps[0], meal.Components[0].Recipe.Steps[1], meal.Components[1].Recipe.Steps[0]
+
+		actual, err := g.ScheduleCookTimelineForMeal(t.Context(), meal, &CookTimelineConstraints{
+			ServingTime:          servingTime,
+			CookCount:            2,
+			InstrumentCapacities: map[string]uint32{oven.ID: 1},
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, uint32(3000), actual.TotalDurationInSeconds)
+		assert.Equal(t, uint32(2400), actual.CriticalPathInSeconds)
+
+		chicken := findCookTimelineStep(t, actual, roastChicken.ID)
+		broccoli := findCookTimelineStep(t, actual, roastBroccoli.ID)
+		assert.Equal(t, servingTime, chicken.EndsAt)
+		assert.False(t, broccoli.EndsAt.After(chicken.StartsAt), "roasting steps share the oven")
+
+		chopped := findCookTimelineStep(t, actual, chop.ID)
+		assert.False(t, chopped.EndsAt.After(chicken.StartsAt))
+	})
+
+	T.Run("with a single cook", func(t *testing.T) {
+		t.Parallel()
+
+		g := newAnalyzerForTest(t)
+		meal, _ := buildMealForCookTimeline()
+
+		actual, err := g.ScheduleCookTimelineForMeal(t.Context(), meal, &CookTimelineConstraints{
+			ServingTime: servingTime,
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, uint32(1), actual.CookCount)
+		assert.Equal(t, uint32(3600), actual.TotalDurationInSeconds)
+		for i, step := range actual.Steps {
+			assert.Equal(t, uint32(1), step.Cook)
+			if i > 0 {
+				assert.False(t, actual.Steps[i-1].EndsAt.After(step.StartsAt), "a single cook can't do two things at once")
+			}
+		}
+	})
+
+	T.Run("with a single cook and passive steps", func(t *testing.T) {
+		t.Parallel()
+
+		g := newAnalyzerForTest(t)
+		meal, _ := buildMealForCookTimeline()
+		chop, roastChicken, roastBroccoli := meal.Components[0].Recipe.Steps[0], meal.Components[0].Recipe.Steps[1], meal.Components[1].Recipe.Steps[0]
+
+		// the broccoli gets chopped (10 minutes) before it's roasted, and both roasts run unattended.
+		choppedBroccoliID := fakes.BuildFakeID()
+		chopBroccoli := &mealplanning.RecipeStep{
+			ID:                        fakes.BuildFakeID(),
+			Index:                     0,
+			Preparation:               chop.Preparation,
+			MaxEstimatedTimeInSeconds: new(uint32(600)),
+			Products:                  []*mealplanning.RecipeStepProduct{{ID: choppedBroccoliID, Name: "chopped broccoli"}},
+		}
+		roastBroccoli.Index = 1
+		roastBroccoli.Ingredients = []*mealplanning.RecipeStepIngredient{{RecipeStepProductID: &choppedBroccoliID}}
+		meal.Components[1].Recipe.Steps = []*mealplanning.RecipeStep{chopBroccoli, roastBroccoli}
+		roastChicken.StartTimerAutomatically = true
+		roastBroccoli.StartTimerAutomatically = true
+
+		actual, err := g.ScheduleCookTimelineForMeal(t.Context(), meal, &CookTimelineConstraints{
+			ServingTime: servingTime,
+		})
+		require.NoError(t, err)
+
+		assert.Equal(t, uint32(2400), actual.TotalDurationInSeconds)
+
+		chicken := findCookTimelineStep(t, actual, roastChicken.ID)
+		assert.Equal(t, uint32(0), chicken.Cook)
+		assert.Equal(t, uint32(0), findCookTimelineStep(t, actual, roastBroccoli.ID).Cook)
+
+		// the broccoli gets chopped while the chicken is in the oven.
+		choppedBroccoli := findCookTimelineStep(t, actual, chopBroccoli.ID)
+		assert.Equal(t, uint32(1), choppedBroccoli.Cook)
+		assert.True(t, choppedBroccoli.StartsAt.Before(chicken.EndsAt) && choppedBroccoli.EndsAt.After(chicken.StartsAt), "prep overlaps the roast")
+
+		choppedChicken := findCookTimelineStep(t, actual, chop.ID)
+		assert.False(t, choppedChicken.EndsAt.After(choppedBroccoli.StartsAt) && choppedBroccoli.EndsAt.After(choppedChicken.StartsAt), "a single cook can't chop two things at once")
+	})
+
+	T.Run("with pruned steps", func(t *testing.T) {
+		t.Parallel()
+
+		g := newAnalyzerForTest(t)
+		meal, _ := buildMealForCookTimeline()
+		meal.Components[1].Recipe.Steps[0].ConditionExpression = "scale > 2"
+
+		actual, err := g.ScheduleCookTimelineForMeal(t.Context(), meal, &CookTimelineConstraints{
+			ServingTime: servingTime,
+		})
+		require.NoError(t, err)
+
+		assert.Len(t, actual.Steps, 2)
+		assert.Equal(t, uint32(2400), actual.TotalDurationInSeconds)
+	})
+
+	T.Run("with a step needing more equipment than is available", func(t *testing.T) {
+		t.Parallel()
+
+		g := newAnalyzerForTest(t)
+		meal, oven := buildMealForCookTimeline()
+		meal.Components[0].Recipe.Steps[1].Instruments[0].MinQuantity = 2
+
+		actual, err := g.ScheduleCookTimelineForMeal(t.Context(), meal, &CookTimelineConstraints{
+			ServingTime:          servingTime,
+			InstrumentCapacities: map[string]uint32{oven.ID: 1},
+		})
+		assert.ErrorIs(t, err, ErrCookTimelineResourceUnavailable)
+		assert.Nil(t, actual)
+	})
+}
+
+func TestRecipeAnalyzer_RenderMermaidGanttChartForCookTimeline(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		g := newAnalyzerForTest(t)
+		meal, _ := buildMealForCookTimeline()
+		servingTime := time.Date(2026, time.October, 17, 18, 0, 0, 0, time.UTC)
+
+		timeline, err := g.ScheduleCookTimelineForMeal(t.Context(), meal, &CookTimelineConstraints{
+			ServingTime: servingTime,
+			CookCount:   2,
+		})
+		require.NoError(t, err)
+
+		actual := g.RenderMermaidGanttChartForCookTimeline(t.Context(), timeline)
+
+		assert.Contains(t, actual, "gantt\n")
+		assert.Contains(t, actual, "\tsection Roasted Chicken\n")
+		assert.Contains(t, actual, "\tsection Roasted Broccoli\n")
+		assert.Contains(t, actual, "Step no. 2 (roast) (Roasted Chicken) [cook 1] :crit, 2026-10-17T17:30:00, 2026-10-17T18:00:00\n")
+		assert.NotContains(t, actual, "#")
+	})
+}
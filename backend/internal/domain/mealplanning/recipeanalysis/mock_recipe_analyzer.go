@@ -18,8 +18,8 @@ type MockRecipeAnalyzer struct {
 }
 
 // MakeGraphForRecipe implements our interface.
-func (m *MockRecipeAnalyzer) MakeGraphForRecipe(ctx context.Context, recipe *mealplanning.Recipe) (*simple.DirectedGraph, error) {
-	returnArgs := m.Called(ctx, recipe)
+func (m *MockRecipeAnalyzer) MakeGraphForRecipe(ctx context.Context, recipe *mealplanning.Recipe, state *ConditionalStepState) (*simple.DirectedGraph, error) {
+	returnArgs := m.Called(ctx, recipe, state)
 
 	return returnArgs.Get(0).(*simple.DirectedGraph), returnArgs.Error(1)
 }
@@ -39,8 +39,8 @@ func (m *MockRecipeAnalyzer) ValidateRecipeCreationRequestInputIsDAG(ctx context
 }
 
 // GenerateMealPlanTasksForRecipe implements our interface.
-func (m *MockRecipeAnalyzer) GenerateMealPlanTasksForRecipe(ctx context.Context, mealPlanOptionID string, recipe *mealplanning.Recipe) ([]*mealplanning.MealPlanTaskDatabaseCreationInput, error) {
-	returnArgs := m.Called(ctx, mealPlanOptionID, recipe)
+func (m *MockRecipeAnalyzer) GenerateMealPlanTasksForRecipe(ctx context.Context, mealPlanOptionID string, recipe *mealplanning.Recipe, state *ConditionalStepState) ([]*mealplanning.MealPlanTaskDatabaseCreationInput, error) {
+	returnArgs := m.Called(ctx, mealPlanOptionID, recipe, state)
 
 	return returnArgs.Get(0).([]*mealplanning.MealPlanTaskDatabaseCreationInput), returnArgs.Error(1)
 }
@@ -78,3 +78,35 @@ func (m *MockRecipeAnalyzer) RenderGraphvizDiagramForRecipe(ctx context.Context,
 func (m *MockRecipeAnalyzer) RenderGraphvizDiagramForMeal(ctx context.Context, meal *mealplanning.Meal) string {
 	return m.Called(ctx, meal).String(0)
 }
+
+// PlanConditionalSteps implements our interface.
+func (m *MockRecipeAnalyzer) PlanConditionalSteps(ctx context.Context, recipe *mealplanning.Recipe, state *ConditionalStepState) *ConditionalStepPlan {
+	return m.Called(ctx, recipe, state).Get(0).(*ConditionalStepPlan)
+}
+
+// ScheduleCookTimelineForMeal implements our interface.
+func (m *MockRecipeAnalyzer) ScheduleCookTimelineForMeal(ctx context.Context, meal *mealplanning.Meal, constraints *CookTimelineConstraints) (*mealplanning.CookTimeline, error) {
+	returnArgs := m.Called(ctx, meal, constraints)
+
+	return returnArgs.Get(0).(*mealplanning.CookTimeline), returnArgs.Error(1)
+}
+
+// RenderMermaidGanttChartForCookTimeline implements our interface.
+func (m *MockRecipeAnalyzer) RenderMermaidGanttChartForCookTimeline(ctx context.Context, timeline *mealplanning.CookTimeline) string {
+	return m.Called(ctx, timeline).String(0)
+}
+
+// CalculateNutritionForRecipe implements our interface.
+func (m *MockRecipeAnalyzer) CalculateNutritionForRecipe(ctx context.Context, recipe *mealplanning.Recipe, state *ConditionalStepState, inputs *NutritionInputs) *mealplanning.NutritionRollup {
+	return m.Called(ctx, recipe, state, inputs).Get(0).(*mealplanning.NutritionRollup)
+}
+
+// CalculateNutritionForMeal implements our interface.
+func (m *MockRecipeAnalyzer) CalculateNutritionForMeal(ctx context.Context, meal *mealplanning.Meal, state *ConditionalStepState, inputs *NutritionInputs) *mealplanning.NutritionRollup {
+	return m.Called(ctx, meal, state, inputs).Get(0).(*mealplanning.NutritionRollup)
+}
+
+// CalculateNutritionForMealPlan implements our interface.
+func (m *MockRecipeAnalyzer) CalculateNutritionForMealPlan(ctx context.Context, mealPlan *mealplanning.MealPlan, inputs *NutritionInputs) *mealplanning.MealPlanNutritionRollup {
+	return m.Called(ctx, mealPlan, inputs).Get(0).(*mealplanning.MealPlanNutritionRollup)
+}
@@ -0,0 +1,334 @@
+package recipeanalysis
+
+import (
+	"context"
+	"sort"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/unitconversion"
+)
+
+// NutritionInputs is the reference data nutrition is calculated from. Facts and conversions
+// are expected to cover every ingredient in whatever is being calculated; anything that isn't
+// covered is reported as missing rather than treated as an error.
+type NutritionInputs struct {
+	Facts       []*mealplanning.ValidIngredientNutritionFacts
+	Conversions []*mealplanning.ValidMeasurementUnitConversion
+}
+
+// nutritionCalculator accumulates the nutrition of one recipe (and its associated recipes).
+type nutritionCalculator struct {
+	facts       map[string]*mealplanning.ValidIngredientNutritionFacts
+	graphs      map[string]unitconversion.Graph
+	conversions []*mealplanning.ValidMeasurementUnitConversion
+	// products holds the nutrition of each recipe step product that's been produced, and remaining tracks
+	// what fraction of it hasn't yet been consumed by a later step.
+	products  map[string]mealplanning.Nutrients
+	remaining map[string]float64
+	missing   []*mealplanning.NutritionMissingIngredient
+	selected  map[string]uint16
+	totals    mealplanning.Nutrients
+	scale     float64
+}
+
+func newNutritionCalculator(inputs *NutritionInputs, selections []*mealplanning.MealPlanRecipeOptionSelection, scale float32) *nutritionCalculator {
+	c := &nutritionCalculator{
+		facts:     map[string]*mealplanning.ValidIngredientNutritionFacts{},
+		graphs:    map[string]unitconversion.Graph{},
+		products:  map[string]mealplanning.Nutrients{},
+		remaining: map[string]float64{},
+		missing:   []*mealplanning.NutritionMissingIngredient{},
+		selected:  map[string]uint16{},
+		scale:     1,
+	}
+
+	if scale > 0 {
+		c.scale = float64(scale)
+	}
+
+	if inputs != nil {
+		c.conversions = inputs.Conversions
+		for _, facts := range inputs.Facts {
+			c.facts[facts.ValidIngredientID] = facts
+		}
+	}
+
+	for _, selection := range selections {
+		if selection.SelectionType == mealplanning.MealPlanRecipeOptionSelectionTypeIngredient {
+			c.selected[optionSelectionKey(selection.RecipeStepID, selection.IngredientIndex)] = selection.SelectedOptionIndex
+		}
+	}
+
+	return c
+}
+
+func (c *nutritionCalculator) graphFor(ingredientID string) unitconversion.Graph {
+	graph, ok := c.graphs[ingredientID]
+	if !ok {
+		graph = unitconversion.NewGraph(ingredientID, c.conversions)
+		c.graphs[ingredientID] = graph
+	}
+
+	return graph
+}
+
+// ingredientNutrients returns the nutrients in a raw ingredient at the calculator's scale, recording it as missing when that can't be determined.
+func (c *nutritionCalculator) ingredientNutrients(recipe *mealplanning.Recipe, step *mealplanning.RecipeStep, ingredient *mealplanning.RecipeStepIngredient) mealplanning.Nutrients {
+	missing := func(reason string) mealplanning.Nutrients {
+		c.missing = append(c.missing, &mealplanning.NutritionMissingIngredient{
+			RecipeID:               recipe.ID,
+			RecipeStepID:           step.ID,
+			RecipeStepIngredientID: ingredient.ID,
+			ValidIngredientID:      ingredient.Ingredient.ID,
+			IngredientName:         ingredient.Ingredient.Name,
+			MeasurementUnitID:      ingredient.MeasurementUnit.ID,
+			Reason:                 reason,
+		})
+		return mealplanning.Nutrients{}
+	}
+
+	facts, ok := c.facts[ingredient.Ingredient.ID]
+	if !ok {
+		return missing(mealplanning.NutritionMissingReasonNoNutritionFacts)
+	}
+
+	factor, ok := c.graphFor(ingredient.Ingredient.ID).Factor(ingredient.MeasurementUnit.ID, facts.BasisMeasurementUnit.ID)
+	if !ok {
+		return missing(mealplanning.NutritionMissingReasonNoConversion)
+	}
+
+	scaleFactor := ingredient.ScaleFactor
+	if scaleFactor <= 0 {
+		scaleFactor = 1
+	}
+
+	quantity := float64(ingredient.MinQuantity) * float64(scaleFactor) * c.scale
+	quantityInBasisUnits := quantity * factor.InexactFloat64()
+
+	return facts.Nutrients.Scale(quantityInBasisUnits / mealplanning.NutritionFactsBasisQuantity)
+}
+
+// consumeProduct returns the share of a previously produced product that an ingredient uses up.
+func (c *nutritionCalculator) consumeProduct(ingredient *mealplanning.RecipeStepIngredient) mealplanning.Nutrients {
+	productID := *ingredient.RecipeStepProductID
+
+	produced, ok := c.products[productID]
+	if !ok {
+		// the producing step was pruned, or isn't part of this recipe.
+		return mealplanning.Nutrients{}
+	}
+
+	fraction := 1.0
+	if ingredient.ProductPercentageToUse != nil && *ingredient.ProductPercentageToUse > 0 {
+		fraction = float64(*ingredient.ProductPercentageToUse) / 100
+	}
+	fraction = min(fraction, c.remaining[productID])
+	c.remaining[productID] -= fraction
+
+	return produced.Scale(fraction)
+}
+
+// carriesNutrition reports whether a product is something that ends up being eaten.
+func carriesNutrition(product *mealplanning.RecipeStepProduct) bool {
+	return !product.IsWaste && product.Type != mealplanning.RecipeStepProductInstrumentType && product.Type != mealplanning.RecipeStepProductVesselType
+}
+
+func (c *nutritionCalculator) processStep(recipe *mealplanning.Recipe, step *mealplanning.RecipeStep) {
+	optionCounts := map[uint16]int{}
+	for _, ingredient := range step.Ingredients {
+		optionCounts[ingredient.Index]++
+	}
+
+	var stepNutrients mealplanning.Nutrients
+	for _, ingredient := range step.Ingredients {
+		// option groups without a selection default to their first option.
+		if optionCounts[ingredient.Index] > 1 && ingredient.OptionIndex != c.selected[optionSelectionKey(step.ID, ingredient.Index)] {
+			continue
+		}
+
+		switch {
+		case ingredient.RecipeStepProductID != nil && *ingredient.RecipeStepProductID != "":
+			stepNutrients = stepNutrients.Add(c.consumeProduct(ingredient))
+		case ingredient.Ingredient != nil:
+			stepNutrients = stepNutrients.Add(c.ingredientNutrients(recipe, step, ingredient))
+		}
+	}
+
+	outputs := []*mealplanning.RecipeStepProduct{}
+	allWaste := len(step.Products) > 0
+	for _, product := range step.Products {
+		if carriesNutrition(product) {
+			outputs = append(outputs, product)
+		}
+		allWaste = allWaste && product.IsWaste
+	}
+
+	switch {
+	case len(outputs) > 0:
+		share := stepNutrients.Scale(1 / float64(len(outputs)))
+		for _, product := range outputs {
+			c.products[product.ID] = share
+			c.remaining[product.ID] = 1
+		}
+	case allWaste:
+		// whatever went into this step is discarded.
+	default:
+		c.totals = c.totals.Add(stepNutrients)
+	}
+}
+
+// calculate rolls up a recipe's included steps, associated recipes first since the main recipe consumes their products.
+// Products that are never consumed by a later step are what gets served.
+func (c *nutritionCalculator) calculate(recipe *mealplanning.Recipe, plan *ConditionalStepPlan) {
+	recipes := append(append([]*mealplanning.Recipe{}, recipe.AssociatedRecipes...), recipe)
+	producedIDs := []string{}
+
+	for _, r := range recipes {
+		steps := make([]*mealplanning.RecipeStep, len(r.Steps))
+		copy(steps, r.Steps)
+		sort.SliceStable(steps, func(i, j int) bool { return steps[i].Index < steps[j].Index })
+
+		for _, step := range steps {
+			if !plan.Includes(step) {
+				continue
+			}
+
+			c.processStep(r, step)
+			for _, product := range step.Products {
+				if _, ok := c.products[product.ID]; ok {
+					producedIDs = append(producedIDs, product.ID)
+				}
+			}
+		}
+	}
+
+	for _, productID := range producedIDs {
+		c.totals = c.totals.Add(c.products[productID].Scale(c.remaining[productID]))
+	}
+}
+
+func buildNutritionRollup(totals mealplanning.Nutrients, missing []*mealplanning.NutritionMissingIngredient, portions, scale float32) *mealplanning.NutritionRollup {
+	rollup := &mealplanning.NutritionRollup{
+		Totals:             totals,
+		MissingIngredients: missing,
+		EstimatedPortions:  portions,
+		Scale:              scale,
+		Complete:           len(missing) == 0,
+	}
+
+	if portions > 0 {
+		perPortion := totals.Scale(1 / float64(portions))
+		rollup.PerPortion = &perPortion
+	}
+
+	return rollup
+}
+
+func nutritionScale(state *ConditionalStepState) float32 {
+	if state != nil && state.Scale > 0 {
+		return state.Scale
+	}
+
+	return 1
+}
+
+func (g *recipeAnalyzer) calculateNutritionForRecipe(recipe *mealplanning.Recipe, state *ConditionalStepState, inputs *NutritionInputs) *mealplanning.NutritionRollup {
+	scale := nutritionScale(state)
+
+	var selections []*mealplanning.MealPlanRecipeOptionSelection
+	if state != nil {
+		selections = state.Selections
+	}
+
+	calculator := newNutritionCalculator(inputs, selections, scale)
+	calculator.calculate(recipe, buildConditionalStepPlan(recipe, &ConditionalStepState{Scale: scale, Selections: selections}))
+
+	return buildNutritionRollup(calculator.totals, calculator.missing, recipe.MinEstimatedPortions*scale, scale)
+}
+
+// CalculateNutritionForRecipe rolls up the nutrition of a recipe at the scale and option selections described by state.
+// Steps pruned by their conditions don't contribute.
+func (g *recipeAnalyzer) CalculateNutritionForRecipe(ctx context.Context, recipe *mealplanning.Recipe, state *ConditionalStepState, inputs *NutritionInputs) *mealplanning.NutritionRollup {
+	_, span := g.tracer.StartSpan(ctx)
+	defer span.End()
+
+	return g.calculateNutritionForRecipe(recipe, state, inputs)
+}
+
+func (g *recipeAnalyzer) calculateNutritionForMeal(meal *mealplanning.Meal, state *ConditionalStepState, inputs *NutritionInputs) *mealplanning.NutritionRollup {
+	mealScale := nutritionScale(state)
+
+	var selections []*mealplanning.MealPlanRecipeOptionSelection
+	if state != nil {
+		selections = state.Selections
+	}
+
+	var totals mealplanning.Nutrients
+	missing := []*mealplanning.NutritionMissingIngredient{}
+	for _, component := range meal.Components {
+		componentRollup := g.calculateNutritionForRecipe(&component.Recipe, &ConditionalStepState{
+			Scale:      component.RecipeScale * mealScale,
+			Selections: selections,
+		}, inputs)
+
+		totals = totals.Add(componentRollup.Totals)
+		missing = append(missing, componentRollup.MissingIngredients...)
+	}
+
+	return buildNutritionRollup(totals, missing, meal.MinEstimatedPortions*mealScale, mealScale)
+}
+
+// CalculateNutritionForMeal rolls up the nutrition of each of a meal's components, each scaled by its recipe scale and the meal's scale.
+func (g *recipeAnalyzer) CalculateNutritionForMeal(ctx context.Context, meal *mealplanning.Meal, state *ConditionalStepState, inputs *NutritionInputs) *mealplanning.NutritionRollup {
+	_, span := g.tracer.StartSpan(ctx)
+	defer span.End()
+
+	return g.calculateNutritionForMeal(meal, state, inputs)
+}
+
+// CalculateNutritionForMealPlan rolls up the nutrition of the chosen option of each of a meal plan's events, scaled by the option's meal scale.
+// Each option is calculated from the recipe revisions it's pinned to.
+// Events without a chosen option are left out, so callers should only pass finalized meal plans.
+func (g *recipeAnalyzer) CalculateNutritionForMealPlan(ctx context.Context, mealPlan *mealplanning.MealPlan, inputs *NutritionInputs) *mealplanning.MealPlanNutritionRollup {
+	_, span := g.tracer.StartSpan(ctx)
+	defer span.End()
+
+	rollup := &mealplanning.MealPlanNutritionRollup{
+		MealPlanID:         mealPlan.ID,
+		Events:             []*mealplanning.MealPlanEventNutritionRollup{},
+		MissingIngredients: []*mealplanning.NutritionMissingIngredient{},
+	}
+
+	for _, event := range mealPlan.Events {
+		for _, option := range event.Options {
+			if !option.Chosen {
+				continue
+			}
+
+			selections := []*mealplanning.MealPlanRecipeOptionSelection{}
+			for _, selection := range mealPlan.Selections {
+				if selection.BelongsToMealPlanOption == option.ID {
+					selections = append(selections, selection)
+				}
+			}
+
+			// the option's pinned recipe revisions are what gets cooked, so later edits to a recipe don't change the rollup.
+			nutrition := g.calculateNutritionForMeal(option.MealWithPinnedRecipes(), &ConditionalStepState{Scale: option.MealScale, Selections: selections}, inputs)
+
+			rollup.Events = append(rollup.Events, &mealplanning.MealPlanEventNutritionRollup{
+				StartsAt:         event.StartsAt,
+				MealPlanEventID:  event.ID,
+				MealPlanOptionID: option.ID,
+				MealID:           option.Meal.ID,
+				MealName:         event.MealName,
+				Nutrition:        *nutrition,
+			})
+			rollup.Totals = rollup.Totals.Add(nutrition.Totals)
+			rollup.MissingIngredients = append(rollup.MissingIngredients, nutrition.MissingIngredients...)
+		}
+	}
+
+	rollup.Complete = len(rollup.MissingIngredients) == 0
+
+	return rollup
+}
@@ -0,0 +1,282 @@
+package recipeanalysis
+
+import (
+	"testing"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/fakes"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const nutritionTestDelta = 0.01
+
+// buildRecipeForNutrition builds a recipe where step 0 makes dough from either a cup of flour or 100 grams of sugar
+// (plus some scraps that get thrown away), and step 1 turns half of the dough into a crust that is also thrown away.
+// Flour is 364 calories and 10 grams of protein per 100 grams, and a cup of flour weighs 120 grams.
+func buildRecipeForNutrition() (recipe *mealplanning.Recipe, inputs *NutritionInputs) {
+	grams := fakes.BuildFakeValidMeasurementUnit()
+	cups := fakes.BuildFakeValidMeasurementUnit()
+	flour := fakes.BuildFakeValidIngredient()
+	sugar := fakes.BuildFakeValidIngredient()
+	doughID := fakes.BuildFakeID()
+
+	recipe = &mealplanning.Recipe{
+		ID:                   fakes.BuildFakeID(),
+		MinEstimatedPortions: 4,
+		Steps: []*mealplanning.RecipeStep{
+			{
+				ID:    fakes.BuildFakeID(),
+				Index: 0,
+				Ingredients: []*mealplanning.RecipeStepIngredient{
+					{ID: fakes.BuildFakeID(), Ingredient: flour, MeasurementUnit: *cups, MinQuantity: 1, Index: 0, OptionIndex: 0},
+					{ID: fakes.BuildFakeID(), Ingredient: sugar, MeasurementUnit: *grams, MinQuantity: 100, Index: 0, OptionIndex: 1},
+				},
+				Products: []*mealplanning.RecipeStepProduct{
+					{ID: doughID, Name: "dough", Type: mealplanning.RecipeStepProductIngredientType},
+					{ID: fakes.BuildFakeID(), Name: "scraps", Type: mealplanning.RecipeStepProductIngredientType, IsWaste: true},
+				},
+			},
+			{
+				ID:    fakes.BuildFakeID(),
+				Index: 1,
+				Ingredients: []*mealplanning.RecipeStepIngredient{
+					{ID: fakes.BuildFakeID(), RecipeStepProductID: &doughID, ProductPercentageToUse: new(float32(50))},
+				},
+				Products: []*mealplanning.RecipeStepProduct{
+					{ID: fakes.BuildFakeID(), Name: "crust", Type: mealplanning.RecipeStepProductIngredientType, IsWaste: true},
+				},
+			},
+		},
+	}
+
+	inputs = &NutritionInputs{
+		Facts: []*mealplanning.ValidIngredientNutritionFacts{
+			{
+				ValidIngredientID:    flour.ID,
+				BasisMeasurementUnit: *grams,
+				Nutrients:            mealplanning.Nutrients{Calories: 364, ProteinInGrams: 10},
+			},
+			{
+				ValidIngredientID:    sugar.ID,
+				BasisMeasurementUnit: *grams,
+				Nutrients:            mealplanning.Nutrients{Calories: 400},
+			},
+		},
+		Conversions: []*mealplanning.ValidMeasurementUnitConversion{
+			{From: *cups, To: *grams, Modifier: 120, OnlyForIngredient: flour},
+		},
+	}
+
+	return recipe, inputs
+}
+
+func TestRecipeAnalyzer_CalculateNutritionForRecipe(T *testing.T) {
+	T.Parallel()
+
+	T.Run("follows product chains and discards waste", func(t *testing.T) {
+		t.Parallel()
+
+		g := newAnalyzerForTest(t)
+		recipe, inputs := buildRecipeForNutrition()
+
+		actual := g.CalculateNutritionForRecipe(t.Context(), recipe, nil, inputs)
+
+		// 120 grams of flour, half of which ends up as crust.
+		assert.True(t, actual.Complete)
+		assert.Empty(t, actual.MissingIngredients)
+		assert.InDelta(t, 218.4, actual.Totals.Calories, nutritionTestDelta)
+		assert.InDelta(t, 6, actual.Totals.ProteinInGrams, nutritionTestDelta)
+		assert.Equal(t, float32(4), actual.EstimatedPortions)
+		require.NotNil(t, actual.PerPortion)
+		assert.InDelta(t, 54.6, actual.PerPortion.Calories, nutritionTestDelta)
+	})
+
+	T.Run("with scale and option selection", func(t *testing.T) {
+		t.Parallel()
+
+		g := newAnalyzerForTest(t)
+		recipe, inputs := buildRecipeForNutrition()
+
+		actual := g.CalculateNutritionForRecipe(t.Context(), recipe, &ConditionalStepState{
+			Scale: 2,
+			Selections: []*mealplanning.MealPlanRecipeOptionSelection{
+				{
+					RecipeStepID:        recipe.Steps[0].ID,
+					SelectionType:       mealplanning.MealPlanRecipeOptionSelectionTypeIngredient,
+					IngredientIndex:     0,
+					SelectedOptionIndex: 1,
+				},
+			},
+		}, inputs)
+
+		// 200 grams of sugar, half of which ends up as crust.
+		assert.True(t, actual.Complete)
+		assert.InDelta(t, 400, actual.Totals.Calories, nutritionTestDelta)
+		assert.Zero(t, actual.Totals.ProteinInGrams)
+		assert.Equal(t, float32(8), actual.EstimatedPortions)
+		assert.Equal(t, float32(2), actual.Scale)
+	})
+
+	T.Run("reports ingredients it can't count", func(t *testing.T) {
+		t.Parallel()
+
+		g := newAnalyzerForTest(t)
+		recipe, inputs := buildRecipeForNutrition()
+		inputs.Conversions = nil
+		salt := fakes.BuildFakeValidIngredient()
+		recipe.Steps[0].Ingredients = append(recipe.Steps[0].Ingredients, &mealplanning.RecipeStepIngredient{
+			ID:          fakes.BuildFakeID(),
+			Ingredient:  salt,
+			MinQuantity: 1,
+			Index:       1,
+		})
+
+		actual := g.CalculateNutritionForRecipe(t.Context(), recipe, nil, inputs)
+
+		assert.False(t, actual.Complete)
+		require.Len(t, actual.MissingIngredients, 2)
+		assert.Equal(t, mealplanning.NutritionMissingReasonNoConversion, actual.MissingIngredients[0].Reason)
+		assert.Equal(t, recipe.Steps[0].Ingredients[0].ID, actual.MissingIngredients[0].RecipeStepIngredientID)
+		assert.Equal(t, mealplanning.NutritionMissingReasonNoNutritionFacts, actual.MissingIngredients[1].Reason)
+		assert.Equal(t, salt.ID, actual.MissingIngredients[1].ValidIngredientID)
+		assert.Zero(t, actual.Totals.Calories)
+	})
+
+	T.Run("without known portions", func(t *testing.T) {
+		t.Parallel()
+
+		g := newAnalyzerForTest(t)
+		recipe, inputs := buildRecipeForNutrition()
+		recipe.MinEstimatedPortions = 0
+
+		actual := g.CalculateNutritionForRecipe(t.Context(), recipe, nil, inputs)
+
+		assert.Nil(t, actual.PerPortion)
+	})
+}
+
+func TestRecipeAnalyzer_CalculateNutritionForMeal(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		g := newAnalyzerForTest(t)
+		main, inputs := buildRecipeForNutrition()
+		side, sideInputs := buildRecipeForNutrition()
+		inputs.Facts = append(inputs.Facts, sideInputs.Facts...)
+		inputs.Conversions = append(inputs.Conversions, sideInputs.Conversions...)
+
+		meal := &mealplanning.Meal{
+			ID:                   fakes.BuildFakeID(),
+			MinEstimatedPortions: 2,
+			Components: []*mealplanning.MealComponent{
+				{Recipe: *main, RecipeScale: 1},
+				{Recipe: *side, RecipeScale: 0.5},
+			},
+		}
+
+		actual := g.CalculateNutritionForMeal(t.Context(), meal, &ConditionalStepState{Scale: 2}, inputs)
+
+		assert.True(t, actual.Complete)
+		assert.InDelta(t, 218.4*2+218.4, actual.Totals.Calories, nutritionTestDelta)
+		assert.Equal(t, float32(4), actual.EstimatedPortions)
+		require.NotNil(t, actual.PerPortion)
+		assert.InDelta(t, 163.8, actual.PerPortion.Calories, nutritionTestDelta)
+	})
+}
+
+func TestRecipeAnalyzer_CalculateNutritionForMealPlan(T *testing.T) {
+	T.Parallel()
+
+	T.Run("only counts chosen options", func(t *testing.T) {
+		t.Parallel()
+
+		g := newAnalyzerForTest(t)
+		recipe, inputs := buildRecipeForNutrition()
+		chosen := &mealplanning.MealPlanOption{
+			ID:        fakes.BuildFakeID(),
+			Chosen:    true,
+			MealScale: 3,
+			Meal: mealplanning.Meal{
+				ID:         fakes.BuildFakeID(),
+				Components: []*mealplanning.MealComponent{{Recipe: *recipe, RecipeScale: 1}},
+			},
+		}
+		mealPlan := &mealplanning.MealPlan{
+			ID: fakes.BuildFakeID(),
+			Events: []*mealplanning.MealPlanEvent{
+				{
+					ID:       fakes.BuildFakeID(),
+					MealName: "dinner",
+					Options: []*mealplanning.MealPlanOption{
+						{ID: fakes.BuildFakeID(), Meal: chosen.Meal},
+						chosen,
+					},
+				},
+			},
+			Selections: []*mealplanning.MealPlanRecipeOptionSelection{
+				{
+					BelongsToMealPlanOption: fakes.BuildFakeID(),
+					RecipeStepID:            recipe.Steps[0].ID,
+					SelectionType:           mealplanning.MealPlanRecipeOptionSelectionTypeIngredient,
+					SelectedOptionIndex:     1,
+				},
+			},
+		}
+
+		actual := g.CalculateNutritionForMealPlan(t.Context(), mealPlan, inputs)
+
+		assert.True(t, actual.Complete)
+		require.Len(t, actual.Events, 1)
+		assert.Equal(t, chosen.ID, actual.Events[0].MealPlanOptionID)
+		assert.Equal(t, "dinner", actual.Events[0].MealName)
+		// the sugar selection belongs to another option, so this one still uses flour.
+		assert.InDelta(t, 218.4*3, actual.Totals.Calories, nutritionTestDelta)
+		assert.Equal(t, actual.Totals, actual.Events[0].Nutrition.Totals)
+	})
+	T.Run("uses pinned recipe revisions", func(t *testing.T) {
+		t.Parallel()
+
+		g := newAnalyzerForTest(t)
+		recipe, inputs := buildRecipeForNutrition()
+
+		// the recipe was edited to use twice the flour after the option pinned it.
+		editedFlour := *recipe.Steps[0].Ingredients[0]
+		editedFlour.MinQuantity = 2
+		editedFirstStep := *recipe.Steps[0]
+		editedFirstStep.Ingredients = []*mealplanning.RecipeStepIngredient{&editedFlour, recipe.Steps[0].Ingredients[1]}
+		edited := *recipe
+		edited.Steps = []*mealplanning.RecipeStep{&editedFirstStep, recipe.Steps[1]}
+
+		mealPlan := &mealplanning.MealPlan{
+			ID: fakes.BuildFakeID(),
+			Events: []*mealplanning.MealPlanEvent{
+				{
+					ID: fakes.BuildFakeID(),
+					Options: []*mealplanning.MealPlanOption{
+						{
+							ID:        fakes.BuildFakeID(),
+							Chosen:    true,
+							MealScale: 1,
+							Meal: mealplanning.Meal{
+								ID:         fakes.BuildFakeID(),
+								Components: []*mealplanning.MealComponent{{Recipe: edited, RecipeScale: 1}},
+							},
+							RecipeRevisions: []*mealplanning.MealPlanOptionRecipeRevision{
+								{RecipeID: recipe.ID, RevisionNumber: 1, Snapshot: recipe},
+							},
+						},
+					},
+				},
+			},
+		}
+
+		actual := g.CalculateNutritionForMealPlan(t.Context(), mealPlan, inputs)
+
+		require.Len(t, actual.Events, 1)
+		assert.InDelta(t, 218.4, actual.Totals.Calories, nutritionTestDelta)
+	})
+}
@@ -156,14 +156,20 @@ func allRecipeSteps(recipe *mealplanning.Recipe) []struct {
 
 // RecipeAnalyzer analyzes recipes for insights (ugh).
 type RecipeAnalyzer interface {
-	MakeGraphForRecipe(ctx context.Context, recipe *mealplanning.Recipe) (*simple.DirectedGraph, error)
+	MakeGraphForRecipe(ctx context.Context, recipe *mealplanning.Recipe, state *ConditionalStepState) (*simple.DirectedGraph, error)
 	MakeGraphForMeal(ctx context.Context, meal *mealplanning.Meal) (*simple.DirectedGraph, error)
 	ValidateRecipeCreationRequestInputIsDAG(ctx context.Context, input *mealplanning.RecipeCreationRequestInput) error
-	GenerateMealPlanTasksForRecipe(ctx context.Context, mealPlanOptionID string, recipe *mealplanning.Recipe) ([]*mealplanning.MealPlanTaskDatabaseCreationInput, error)
+	GenerateMealPlanTasksForRecipe(ctx context.Context, mealPlanOptionID string, recipe *mealplanning.Recipe, state *ConditionalStepState) ([]*mealplanning.MealPlanTaskDatabaseCreationInput, error)
 	RenderMermaidDiagramForRecipe(ctx context.Context, recipe *mealplanning.Recipe) string
 	RenderMermaidDiagramForMeal(ctx context.Context, meal *mealplanning.Meal) string
 	RenderGraphvizDiagramForRecipe(ctx context.Context, recipe *mealplanning.Recipe) string
 	RenderGraphvizDiagramForMeal(ctx context.Context, meal *mealplanning.Meal) string
+	PlanConditionalSteps(ctx context.Context, recipe *mealplanning.Recipe, state *ConditionalStepState) *ConditionalStepPlan
+	ScheduleCookTimelineForMeal(ctx context.Context, meal *mealplanning.Meal, constraints *CookTimelineConstraints) (*mealplanning.CookTimeline, error)
+	RenderMermaidGanttChartForCookTimeline(ctx context.Context, timeline *mealplanning.CookTimeline) string
+	CalculateNutritionForRecipe(ctx context.Context, recipe *mealplanning.Recipe, state *ConditionalStepState, inputs *NutritionInputs) *mealplanning.NutritionRollup
+	CalculateNutritionForMeal(ctx context.Context, meal *mealplanning.Meal, state *ConditionalStepState, inputs *NutritionInputs) *mealplanning.NutritionRollup
+	CalculateNutritionForMealPlan(ctx context.Context, mealPlan *mealplanning.MealPlan, inputs *NutritionInputs) *mealplanning.MealPlanNutritionRollup
 }
 
 var _ RecipeAnalyzer = (*recipeAnalyzer)(nil)
@@ -182,18 +188,27 @@ func NewRecipeAnalyzer(logger logging.Logger, tracerProvider tracing.TracerProvi
 	}
 }
 
-func (g *recipeAnalyzer) MakeGraphForRecipe(ctx context.Context, recipe *mealplanning.Recipe) (*simple.DirectedGraph, error) {
-	_, span := g.tracer.StartSpan(ctx)
+// MakeGraphForRecipe builds the step graph for a recipe. Steps whose conditions are false for the given
+// selections and scale are left out; a nil state evaluates the recipe as written.
+func (g *recipeAnalyzer) MakeGraphForRecipe(ctx context.Context, recipe *mealplanning.Recipe, state *ConditionalStepState) (*simple.DirectedGraph, error) {
+	ctx, span := g.tracer.StartSpan(ctx)
 	defer span.End()
 
 	recipeGraph := simple.NewDirectedGraph()
+	plan := g.PlanConditionalSteps(ctx, recipe, state)
 
 	allSteps := allRecipeSteps(recipe)
 	for _, item := range allSteps {
-		recipeGraph.AddNode(newGraphNode(graphIDForStepLocation(item.loc)))
+		if plan.Includes(item.step) {
+			recipeGraph.AddNode(newGraphNode(graphIDForStepLocation(item.loc)))
+		}
 	}
 
 	for _, item := range allSteps {
+		if !plan.Includes(item.step) {
+			continue
+		}
+
 		toGraphID := graphIDForStepLocation(item.loc)
 		toStep := item.step
 
@@ -208,6 +223,10 @@ func (g *recipeAnalyzer) MakeGraphForRecipe(ctx context.Context, recipe *mealpla
 			}
 
 			from := recipeGraph.Node(fromStep)
+			if from == nil {
+				// the producing step was pruned
+				continue
+			}
 			to := recipeGraph.Node(toGraphID)
 			recipeGraph.SetEdge(simple.Edge{F: from, T: to})
 		}
@@ -223,6 +242,10 @@ func (g *recipeAnalyzer) MakeGraphForRecipe(ctx context.Context, recipe *mealpla
 			}
 
 			from := recipeGraph.Node(fromStep)
+			if from == nil {
+				// the producing step was pruned
+				continue
+			}
 			to := recipeGraph.Node(toGraphID)
 			recipeGraph.SetEdge(simple.Edge{F: from, T: to})
 		}
@@ -238,6 +261,10 @@ func (g *recipeAnalyzer) MakeGraphForRecipe(ctx context.Context, recipe *mealpla
 			}
 
 			from := recipeGraph.Node(fromStep)
+			if from == nil {
+				// the producing step was pruned
+				continue
+			}
 			to := recipeGraph.Node(toGraphID)
 			recipeGraph.SetEdge(simple.Edge{F: from, T: to})
 		}
@@ -248,7 +275,7 @@ func (g *recipeAnalyzer) MakeGraphForRecipe(ctx context.Context, recipe *mealpla
 		return nil, errNotAcyclic
 	}
 
-	if _, err := g.makeDAGForRecipe(ctx, recipe); err != nil {
+	if _, err := g.makeDAGForRecipe(ctx, recipe, plan); err != nil {
 		return nil, fmt.Errorf("parsing recipe as DAG: %w", err)
 	}
 
@@ -286,19 +313,55 @@ func allMealSteps(meal *mealplanning.Meal) []mealStepItem {
 	return out
 }
 
+// MakeGraphForMeal builds a combined step graph for every component of a meal, at each component's scale.
+// Steps whose conditions are false are left out.
 func (g *recipeAnalyzer) MakeGraphForMeal(ctx context.Context, meal *mealplanning.Meal) (*simple.DirectedGraph, error) {
-	_, span := g.tracer.StartSpan(ctx)
+	ctx, span := g.tracer.StartSpan(ctx)
 	defer span.End()
 
+	mealGraph, _, err := g.makeGraphForMeal(ctx, meal, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return mealGraph, nil
+}
+
+// makeGraphForMeal builds the combined step graph for a meal. The state's scale multiplies each component's
+// recipe scale, and its selections apply to every component. It also returns each component's step plan.
+func (g *recipeAnalyzer) makeGraphForMeal(ctx context.Context, meal *mealplanning.Meal, state *ConditionalStepState) (*simple.DirectedGraph, []*ConditionalStepPlan, error) {
 	mealGraph := simple.NewDirectedGraph()
 
+	mealScale := float32(1)
+	var selections []*mealplanning.MealPlanRecipeOptionSelection
+	if state != nil {
+		selections = state.Selections
+		if state.Scale > 0 {
+			mealScale = state.Scale
+		}
+	}
+
+	plans := make([]*ConditionalStepPlan, len(meal.Components))
+	for i, component := range meal.Components {
+		plans[i] = g.PlanConditionalSteps(ctx, &component.Recipe, &ConditionalStepState{
+			Scale:      component.RecipeScale * mealScale,
+			Selections: selections,
+		})
+	}
+
 	allSteps := allMealSteps(meal)
 	for i := range allSteps {
-		mealGraph.AddNode(newGraphNode(mealGraphID(allSteps[i].componentIndex, allSteps[i].loc)))
+		if plans[allSteps[i].componentIndex].Includes(allSteps[i].step) {
+			mealGraph.AddNode(newGraphNode(mealGraphID(allSteps[i].componentIndex, allSteps[i].loc)))
+		}
 	}
 
 	for i := range allSteps {
 		item := &allSteps[i]
+		if !plans[item.componentIndex].Includes(item.step) {
+			continue
+		}
+
 		toGraphID := mealGraphID(item.componentIndex, item.loc)
 		toStep := item.step
 		recipe := item.recipe
@@ -310,11 +373,15 @@ func (g *recipeAnalyzer) MakeGraphForMeal(ctx context.Context, meal *mealplannin
 
 			fromLoc, ok := findStepLocationForRecipeStepProductID(recipe, *ingredient.RecipeStepProductID, ingredient.RecipeStepProductRecipeID)
 			if !ok {
-				return nil, errRecipeStepIDNotFound
+				return nil, nil, errRecipeStepIDNotFound
 			}
 
 			fromGraphID := mealGraphID(item.componentIndex, fromLoc)
 			from := mealGraph.Node(fromGraphID)
+			if from == nil {
+				// the producing step was pruned
+				continue
+			}
 			to := mealGraph.Node(toGraphID)
 			mealGraph.SetEdge(simple.Edge{F: from, T: to})
 		}
@@ -326,11 +393,15 @@ func (g *recipeAnalyzer) MakeGraphForMeal(ctx context.Context, meal *mealplannin
 
 			fromLoc, ok := findStepLocationForRecipeStepProductID(recipe, *instrument.RecipeStepProductID, nil)
 			if !ok {
-				return nil, errRecipeStepIDNotFound
+				return nil, nil, errRecipeStepIDNotFound
 			}
 
 			fromGraphID := mealGraphID(item.componentIndex, fromLoc)
 			from := mealGraph.Node(fromGraphID)
+			if from == nil {
+				// the producing step was pruned
+				continue
+			}
 			to := mealGraph.Node(toGraphID)
 			mealGraph.SetEdge(simple.Edge{F: from, T: to})
 		}
@@ -342,11 +413,15 @@ func (g *recipeAnalyzer) MakeGraphForMeal(ctx context.Context, meal *mealplannin
 
 			fromLoc, ok := findStepLocationForRecipeStepProductID(recipe, *vessel.RecipeStepProductID, nil)
 			if !ok {
-				return nil, errRecipeStepIDNotFound
+				return nil, nil, errRecipeStepIDNotFound
 			}
 
 			fromGraphID := mealGraphID(item.componentIndex, fromLoc)
 			from := mealGraph.Node(fromGraphID)
+			if from == nil {
+				// the producing step was pruned
+				continue
+			}
 			to := mealGraph.Node(toGraphID)
 			mealGraph.SetEdge(simple.Edge{F: from, T: to})
 		}
@@ -354,10 +429,10 @@ func (g *recipeAnalyzer) MakeGraphForMeal(ctx context.Context, meal *mealplannin
 
 	directedCycles := topo.DirectedCyclesIn(mealGraph)
 	if len(directedCycles) > 0 {
-		return nil, errNotAcyclic
+		return nil, nil, errNotAcyclic
 	}
 
-	return mealGraph, nil
+	return mealGraph, plans, nil
 }
 
 // ValidateRecipeCreationRequestInputIsDAG validates that a RecipeCreationRequestInput represents a valid DAG.
@@ -481,16 +556,22 @@ func (i *RecipeStepIdentifier) ID() string {
 	return fmt.Sprintf("%d", graphIDForStep(i.recipeStep))
 }
 
-// makeDAGForRecipe makes a proper DAG for the provided Recipe.
-func (g *recipeAnalyzer) makeDAGForRecipe(ctx context.Context, recipe *mealplanning.Recipe) (*dag.DAG, error) {
+// makeDAGForRecipe makes a proper DAG for the provided Recipe, leaving out steps the plan pruned.
+func (g *recipeAnalyzer) makeDAGForRecipe(ctx context.Context, recipe *mealplanning.Recipe, plan *ConditionalStepPlan) (*dag.DAG, error) {
 	_, span := g.tracer.StartSpan(ctx)
 	defer span.End()
 
 	recipeGraph := dag.NewDAG()
 
+	includedStepIDs := map[string]bool{}
 	allSteps := allRecipeSteps(recipe)
 	for _, item := range allSteps {
+		if !plan.Includes(item.step) {
+			continue
+		}
+
 		loc := item.loc
+		includedStepIDs[fmt.Sprintf("%d", graphIDForStepLocation(loc))] = true
 		if _, err := recipeGraph.AddVertex(&RecipeStepIdentifier{recipeStep: item.step, loc: &loc}); err != nil {
 			return nil, fmt.Errorf("adding step %v to graph: %w", loc, err)
 		}
@@ -498,6 +579,9 @@ func (g *recipeAnalyzer) makeDAGForRecipe(ctx context.Context, recipe *mealplann
 
 	for _, item := range allSteps {
 		consumerID := fmt.Sprintf("%d", graphIDForStepLocation(item.loc))
+		if !includedStepIDs[consumerID] {
+			continue
+		}
 		step := item.step
 
 		for _, ingredient := range step.Ingredients {
@@ -510,6 +594,10 @@ func (g *recipeAnalyzer) makeDAGForRecipe(ctx context.Context, recipe *mealplann
 				return nil, fmt.Errorf("finding step ID for recipe step product ID: %w", err)
 			}
 
+			if !includedStepIDs[producerStepID] {
+				continue
+			}
+
 			if err = recipeGraph.AddEdge(producerStepID, consumerID); err != nil {
 				return nil, fmt.Errorf("adding recipe step edge: %w", err)
 			}
@@ -525,6 +613,10 @@ func (g *recipeAnalyzer) makeDAGForRecipe(ctx context.Context, recipe *mealplann
 				return nil, fmt.Errorf("finding step ID for recipe step instrument product ID: %w", err)
 			}
 
+			if !includedStepIDs[producerStepID] {
+				continue
+			}
+
 			if err = recipeGraph.AddEdge(producerStepID, consumerID); err != nil {
 				var dupeErr dag.EdgeDuplicateError
 				if errors.As(err, &dupeErr) {
@@ -544,6 +636,10 @@ func (g *recipeAnalyzer) makeDAGForRecipe(ctx context.Context, recipe *mealplann
 				return nil, fmt.Errorf("finding step ID for recipe step vessel product ID: %w", err)
 			}
 
+			if !includedStepIDs[producerStepID] {
+				continue
+			}
+
 			if err = recipeGraph.AddEdge(producerStepID, consumerID); err != nil {
 				var dupeErr dag.EdgeDuplicateError
 				if errors.As(err, &dupeErr) {
@@ -560,11 +656,15 @@ func (g *recipeAnalyzer) makeDAGForRecipe(ctx context.Context, recipe *mealplann
 }
 
 // frozenIngredientDefrostStepsFilter iterates through a recipe and returns
-// the list of ingredients within that are indicated as kept frozen.
-func frozenIngredientDefrostStepsFilter(recipe *mealplanning.Recipe) map[string][]int {
+// the list of ingredients within that are indicated as kept frozen, skipping pruned steps.
+func frozenIngredientDefrostStepsFilter(recipe *mealplanning.Recipe, plan *ConditionalStepPlan) map[string][]int {
 	out := map[string][]int{}
 
 	for _, recipeStep := range recipe.Steps {
+		if !plan.Includes(recipeStep) {
+			continue
+		}
+
 		ingredientIndices := []int{}
 		for i, ingredient := range recipeStep.Ingredients {
 			// if it's a valid ingredient
@@ -605,13 +705,13 @@ func buildThawStepCreationExplanation(recipeStepIndex int64, ingredientIndices .
 	return fmt.Sprintf("frozen %s (%s) for step #%d might need to be thawed ahead of time", d, strings.Join(stringIndices, ", "), recipeStepIndex)
 }
 
-func (g *recipeAnalyzer) generateMealPlanTasksForFrozenIngredients(ctx context.Context, mealPlanOptionID string, recipe *mealplanning.Recipe) []*mealplanning.MealPlanTaskDatabaseCreationInput {
+func (g *recipeAnalyzer) generateMealPlanTasksForFrozenIngredients(ctx context.Context, mealPlanOptionID string, recipe *mealplanning.Recipe, plan *ConditionalStepPlan) []*mealplanning.MealPlanTaskDatabaseCreationInput {
 	_, span := g.tracer.StartSpan(ctx)
 	defer span.End()
 
 	logger := g.logger.Clone().WithValue(mealplanningkeys.RecipeIDKey, recipe.ID)
 
-	frozenIngredientSteps := frozenIngredientDefrostStepsFilter(recipe)
+	frozenIngredientSteps := frozenIngredientDefrostStepsFilter(recipe, plan)
 	logger.WithValue("frozen_steps_qty", len(frozenIngredientSteps)).Info("creating frozen stepSet inputs")
 
 	outputs := []*mealplanning.MealPlanTaskDatabaseCreationInput{}
@@ -639,13 +739,36 @@ func (g *recipeAnalyzer) generateMealPlanTasksForFrozenIngredients(ctx context.C
 
 const recipeTaskStepCreationExplanation = "recipe prep task exists for steps"
 
-func (g *recipeAnalyzer) GenerateMealPlanTasksForRecipe(ctx context.Context, mealPlanOptionID string, recipe *mealplanning.Recipe) ([]*mealplanning.MealPlanTaskDatabaseCreationInput, error) {
+// prepTaskIsPruned reports whether every step a prep task covers was pruned.
+func prepTaskIsPruned(recipe *mealplanning.Recipe, prepTask *mealplanning.RecipePrepTask, plan *ConditionalStepPlan) bool {
+	if len(prepTask.TaskSteps) == 0 {
+		return false
+	}
+
+	for _, taskStep := range prepTask.TaskSteps {
+		stepIndex := recipe.FindStepIndexByID(taskStep.BelongsToRecipeStep)
+		if stepIndex < 0 || plan.Includes(recipe.Steps[stepIndex]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// GenerateMealPlanTasksForRecipe generates the meal plan tasks for a recipe. Steps whose conditions are false for the
+// given selections and scale produce no tasks; a nil state evaluates the recipe as written.
+func (g *recipeAnalyzer) GenerateMealPlanTasksForRecipe(ctx context.Context, mealPlanOptionID string, recipe *mealplanning.Recipe, state *ConditionalStepState) ([]*mealplanning.MealPlanTaskDatabaseCreationInput, error) {
 	ctx, span := g.tracer.StartSpan(ctx)
 	defer span.End()
 
-	inputs := g.generateMealPlanTasksForFrozenIngredients(ctx, mealPlanOptionID, recipe)
+	plan := g.PlanConditionalSteps(ctx, recipe, state)
+	inputs := g.generateMealPlanTasksForFrozenIngredients(ctx, mealPlanOptionID, recipe, plan)
 
 	for _, prepTask := range recipe.PrepTasks {
+		if prepTaskIsPruned(recipe, prepTask, plan) {
+			continue
+		}
+
 		inputs = append(inputs, &mealplanning.MealPlanTaskDatabaseCreationInput{
 			AssignedToUser:      nil,
 			CreationExplanation: recipeTaskStepCreationExplanation,
@@ -39,7 +39,7 @@ func TestRecipeGrapher_makeGraphForRecipe(T *testing.T) {
 			},
 		}
 
-		actual, err := g.MakeGraphForRecipe(ctx, r)
+		actual, err := g.MakeGraphForRecipe(ctx, r, nil)
 		assert.NoError(t, err)
 		assert.NotNil(t, actual)
 	})
@@ -60,7 +60,7 @@ func TestRecipeGrapher_makeDAGForRecipe(T *testing.T) {
 			},
 		}
 
-		actual, err := g.makeDAGForRecipe(ctx, r)
+		actual, err := g.makeDAGForRecipe(ctx, r, nil)
 		assert.NoError(t, err)
 		assert.NotNil(t, actual)
 	})
@@ -139,7 +139,7 @@ func TestRecipeAnalyzer_GenerateMealPlanTasksForRecipe(T *testing.T) {
 			},
 		}
 
-		actual, err := g.GenerateMealPlanTasksForRecipe(ctx, exampleMealPlanOption.ID, exampleRecipe)
+		actual, err := g.GenerateMealPlanTasksForRecipe(ctx, exampleMealPlanOption.ID, exampleRecipe, nil)
 		assert.NoError(t, err)
 
 		for i := range expected {
@@ -374,7 +374,7 @@ func TestRecipeAnalyzer_MakeGraphForRecipe_WithAssociatedRecipe(T *testing.T) {
 			AssociatedRecipes: []*mealplanning.Recipe{assocRecipe},
 		}
 
-		actual, err := g.MakeGraphForRecipe(ctx, recipe)
+		actual, err := g.MakeGraphForRecipe(ctx, recipe, nil)
 		assert.NoError(t, err)
 		assert.NotNil(t, actual)
 
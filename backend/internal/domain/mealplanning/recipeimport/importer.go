@@ -0,0 +1,568 @@
+package recipeimport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+
+	"github.com/primandproper/platform/database/filtering"
+)
+
+// Format identifies how an imported recipe is laid out.
+type Format string
+
+const (
+	// FormatAuto detects the format from the content.
+	FormatAuto Format = "auto"
+	// FormatJSONLD is schema.org Recipe JSON-LD, either bare or embedded in an HTML page.
+	FormatJSONLD Format = "jsonld"
+	// FormatText is a plain-text recipe with "Ingredients" and "Instructions" sections.
+	FormatText Format = "text"
+
+	// countableMeasurementUnitName is the unit searched for when an ingredient line has no unit, like "2 eggs".
+	countableMeasurementUnitName = "unit"
+)
+
+var (
+	// ErrNoRecipeFound is returned when the content doesn't contain anything recognizable as a recipe.
+	ErrNoRecipeFound = errors.New("no recipe found in content")
+	// ErrUnknownFormat is returned when asked to import an unsupported format.
+	ErrUnknownFormat = errors.New("unknown recipe import format")
+
+	// leadingClauseWords start an instruction without being its action, as in "In a large bowl, whisk the eggs".
+	leadingClauseWords = map[string]bool{
+		"in":    true,
+		"on":    true,
+		"into":  true,
+		"using": true,
+		"with":  true,
+		"once":  true,
+		"when":  true,
+		"while": true,
+		"after": true,
+		"for":   true,
+		"if":    true,
+		"to":    true,
+	}
+
+	// leadingAdverbs start an instruction without being its action, as in "Meanwhile whisk the eggs".
+	leadingAdverbs = map[string]bool{
+		"then":      true,
+		"next":      true,
+		"finally":   true,
+		"first":     true,
+		"meanwhile": true,
+		"now":       true,
+		"gently":    true,
+		"carefully": true,
+	}
+)
+
+// EntityKind is a kind of entity the importer resolves.
+type EntityKind string
+
+const (
+	// IngredientEntityKind is a ValidIngredient.
+	IngredientEntityKind EntityKind = "ingredient"
+	// MeasurementUnitEntityKind is a ValidMeasurementUnit.
+	MeasurementUnitEntityKind EntityKind = "measurement_unit"
+	// PreparationEntityKind is a ValidPreparation.
+	PreparationEntityKind EntityKind = "preparation"
+	// IngredientMeasurementUnitEntityKind is a ValidIngredientMeasurementUnit.
+	IngredientMeasurementUnitEntityKind EntityKind = "ingredient_measurement_unit"
+)
+
+type (
+	// ParsedRecipe is a recipe as it appeared in the imported content, before any entity resolution.
+	ParsedRecipe struct {
+		Yield         *Yield
+		Name          string
+		Description   string
+		Source        string
+		ComponentType string
+		Ingredients   []string
+		Instructions  []string
+	}
+
+	// Resolution records how a piece of imported text was matched to an existing entity, if at all.
+	Resolution struct {
+		Kind         EntityKind `json:"kind"`
+		Text         string     `json:"text"`
+		Context      string     `json:"context"`
+		ResolvedID   string     `json:"resolvedID,omitempty"`
+		ResolvedName string     `json:"resolvedName,omitempty"`
+		Approximate  bool       `json:"approximate"`
+	}
+
+	// Report describes everything a person should review before creating an imported recipe.
+	Report struct {
+		Resolved   []*Resolution `json:"resolved"`
+		Unresolved []*Resolution `json:"unresolved"`
+		Warnings   []string      `json:"warnings"`
+	}
+
+	// Result is a draft recipe along with the report of how it was built.
+	Result struct {
+		Recipe *mealplanning.RecipeCreationRequestInput `json:"recipe"`
+		Report *Report                                  `json:"report"`
+	}
+
+	// Importer turns external recipes into draft recipe creation inputs.
+	Importer struct {
+		searcher EntitySearcher
+	}
+)
+
+// NewImporter builds an Importer that resolves entities with the provided searcher.
+func NewImporter(searcher EntitySearcher) *Importer {
+	return &Importer{searcher: searcher}
+}
+
+// Parse extracts a recipe from content in the given format.
+func Parse(content []byte, format Format) (*ParsedRecipe, error) {
+	text := string(content)
+
+	switch format {
+	case FormatJSONLD:
+		return parseJSONLD(text)
+	case FormatText:
+		return parsePlainText(text)
+	case FormatAuto:
+		recipe, err := parseJSONLD(text)
+		if err == nil || !errors.Is(err, ErrNoRecipeFound) {
+			return recipe, err
+		}
+
+		return parsePlainText(text)
+	default:
+		return nil, fmt.Errorf("%w: %q", ErrUnknownFormat, format)
+	}
+}
+
+// Import parses content and builds a draft recipe from it.
+func (i *Importer) Import(ctx context.Context, content []byte, format Format) (*Result, error) {
+	parsed, err := Parse(content, format)
+	if err != nil {
+		return nil, err
+	}
+
+	return i.BuildDraft(ctx, parsed)
+}
+
+// BuildDraft resolves a parsed recipe's ingredients, units, and preparations, and assembles a draft recipe creation input.
+func (i *Importer) BuildDraft(ctx context.Context, parsed *ParsedRecipe) (*Result, error) {
+	if parsed == nil {
+		return nil, ErrNoRecipeFound
+	}
+
+	run := &importRun{
+		searcher:     i.searcher,
+		report:       &Report{Resolved: []*Resolution{}, Unresolved: []*Resolution{}, Warnings: []string{}},
+		ingredients:  map[string]*mealplanning.ValidIngredient{},
+		units:        map[string]*mealplanning.ValidMeasurementUnit{},
+		preparations: map[string]*mealplanning.ValidPreparation{},
+		productNames: map[*mealplanning.RecipeStepCreationRequestInput]string{},
+	}
+
+	recipe := &mealplanning.RecipeCreationRequestInput{
+		Name:                 parsed.Name,
+		Slug:                 slugify(parsed.Name),
+		Description:          parsed.Description,
+		Source:               parsed.Source,
+		YieldsComponentType:  parsed.ComponentType,
+		EligibleForMeals:     true,
+		MinEstimatedPortions: 1,
+		PortionName:          "serving",
+		PluralPortionName:    "servings",
+		Steps:                []*mealplanning.RecipeStepCreationRequestInput{},
+		PrepTasks:            []*mealplanning.RecipePrepTaskWithinRecipeCreationRequestInput{},
+	}
+
+	if recipe.YieldsComponentType == "" {
+		recipe.YieldsComponentType = mealplanning.MealComponentTypesUnspecified
+	}
+
+	if parsed.Yield != nil {
+		recipe.MinEstimatedPortions = parsed.Yield.Min
+		recipe.MaxEstimatedPortions = parsed.Yield.Max
+		recipe.PortionName = parsed.Yield.PortionName
+		recipe.PluralPortionName = parsed.Yield.PluralPortionName
+	} else {
+		run.warn("no yield found, assuming one serving")
+	}
+
+	for _, instruction := range parsed.Instructions {
+		step, err := run.buildStep(ctx, uint32(len(recipe.Steps)), instruction)
+		if err != nil {
+			return nil, err
+		}
+		recipe.Steps = append(recipe.Steps, step)
+	}
+
+	if len(parsed.Ingredients) > 0 && len(recipe.Steps) == 0 {
+		run.warn("no instructions found, all ingredients were added to a single empty step")
+		recipe.Steps = append(recipe.Steps, &mealplanning.RecipeStepCreationRequestInput{})
+	}
+
+	for _, line := range parsed.Ingredients {
+		ingredient, err := run.buildIngredient(ctx, line)
+		if err != nil {
+			return nil, err
+		}
+
+		step := run.stepForIngredient(recipe.Steps, ingredient.Name)
+		ingredient.Index = new(uint16(len(step.Ingredients)))
+		step.Ingredients = append(step.Ingredients, ingredient)
+	}
+
+	for _, step := range recipe.Steps {
+		step.Products = []*mealplanning.RecipeStepProductCreationRequestInput{run.buildProduct(step)}
+	}
+
+	if len(recipe.Steps) < 2 {
+		run.warn("recipes need at least two steps")
+	}
+
+	if len(recipe.Steps) > 0 {
+		run.warn("imported steps have no instruments or vessels, add at least one to each step before creating the recipe")
+	}
+
+	return &Result{
+		Recipe: recipe,
+		Report: run.report,
+	}, nil
+}
+
+// importRun holds the state for a single BuildDraft call, so each distinct piece of text is only searched for once.
+type importRun struct {
+	searcher     EntitySearcher
+	report       *Report
+	ingredients  map[string]*mealplanning.ValidIngredient
+	units        map[string]*mealplanning.ValidMeasurementUnit
+	preparations map[string]*mealplanning.ValidPreparation
+	productNames map[*mealplanning.RecipeStepCreationRequestInput]string
+}
+
+func (r *importRun) warn(format string, args ...any) {
+	r.report.Warnings = append(r.report.Warnings, fmt.Sprintf(format, args...))
+}
+
+func (r *importRun) resolved(kind EntityKind, text, source, id, name string, approximate bool) {
+	r.report.Resolved = append(r.report.Resolved, &Resolution{
+		Kind:         kind,
+		Text:         text,
+		Context:      source,
+		ResolvedID:   id,
+		ResolvedName: name,
+		Approximate:  approximate,
+	})
+}
+
+func (r *importRun) unresolved(kind EntityKind, text, source string) {
+	r.report.Unresolved = append(r.report.Unresolved, &Resolution{
+		Kind:    kind,
+		Text:    text,
+		Context: source,
+	})
+}
+
+// pickMatch returns the first candidate with a name equal to one of the queries, or else the first candidate at all.
+func pickMatch[T any](candidates []*T, queries []string, names func(*T) []string) (match *T, approximate bool) {
+	for _, candidate := range candidates {
+		for _, name := range names(candidate) {
+			for _, query := range queries {
+				if strings.EqualFold(name, query) {
+					return candidate, false
+				}
+			}
+		}
+	}
+
+	if len(candidates) > 0 {
+		return candidates[0], true
+	}
+
+	return nil, false
+}
+
+// searchQueries returns the queries to try for a name, from most to least specific.
+func searchQueries(name string) []string {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name == "" {
+		return nil
+	}
+
+	queries := []string{name}
+	seen := map[string]bool{name: true}
+	add := func(q string) {
+		if q != "" && !seen[q] {
+			seen[q] = true
+			queries = append(queries, q)
+		}
+	}
+
+	add(singularize(name))
+	if fields := strings.Fields(name); len(fields) > 1 {
+		// fall back to the head noun, so "large brown eggs" can find "egg".
+		head := fields[len(fields)-1]
+		add(head)
+		add(singularize(head))
+	}
+
+	return queries
+}
+
+// presentTenseCandidates guesses the base form of a past-tense verb, so "chopped" can find "chop".
+func presentTenseCandidates(verb string) []string {
+	candidates := []string{verb}
+	if base, ok := strings.CutSuffix(verb, "ed"); ok && len(base) > 1 {
+		candidates = append(candidates, base+"e", base)
+		if n := len(base); n > 2 && base[n-1] == base[n-2] {
+			candidates = append(candidates, base[:n-1])
+		}
+	}
+
+	return candidates
+}
+
+func (r *importRun) resolveIngredient(ctx context.Context, name, source string) (*mealplanning.ValidIngredient, error) {
+	key := strings.ToLower(name)
+	if found, ok := r.ingredients[key]; ok {
+		return found, nil
+	}
+
+	queries := searchQueries(name)
+	for i, query := range queries {
+		results, err := r.searcher.SearchForValidIngredients(ctx, query, filtering.DefaultQueryFilter())
+		if err != nil {
+			return nil, fmt.Errorf("searching for ingredient %q: %w", query, err)
+		}
+
+		if match, approximate := pickMatch(results.Data, queries, ingredientNames); match != nil {
+			r.ingredients[key] = match
+			r.resolved(IngredientEntityKind, name, source, match.ID, match.Name, approximate || i > 1)
+			return match, nil
+		}
+	}
+
+	r.ingredients[key] = nil
+	r.unresolved(IngredientEntityKind, name, source)
+
+	return nil, nil
+}
+
+func (r *importRun) resolveMeasurementUnit(ctx context.Context, name, source string) (*mealplanning.ValidMeasurementUnit, error) {
+	key := strings.ToLower(name)
+	if found, ok := r.units[key]; ok {
+		return found, nil
+	}
+
+	queries := searchQueries(name)
+	for _, query := range queries {
+		results, err := r.searcher.SearchForValidMeasurementUnits(ctx, query, filtering.DefaultQueryFilter())
+		if err != nil {
+			return nil, fmt.Errorf("searching for measurement unit %q: %w", query, err)
+		}
+
+		if match, approximate := pickMatch(results.Data, queries, measurementUnitNames); match != nil {
+			r.units[key] = match
+			r.resolved(MeasurementUnitEntityKind, name, source, match.ID, match.Name, approximate)
+			return match, nil
+		}
+	}
+
+	r.units[key] = nil
+	r.unresolved(MeasurementUnitEntityKind, name, source)
+
+	return nil, nil
+}
+
+func (r *importRun) resolvePreparation(ctx context.Context, verb, source string) (*mealplanning.ValidPreparation, error) {
+	key := strings.ToLower(verb)
+	if found, ok := r.preparations[key]; ok {
+		return found, nil
+	}
+
+	queries := presentTenseCandidates(key)
+	for _, query := range queries {
+		results, err := r.searcher.SearchForValidPreparations(ctx, query, filtering.DefaultQueryFilter())
+		if err != nil {
+			return nil, fmt.Errorf("searching for preparation %q: %w", query, err)
+		}
+
+		// a preparation search that isn't an exact hit is more likely to be wrong than useful.
+		if match, approximate := pickMatch(results.Data, queries, preparationNames); match != nil && !approximate {
+			r.preparations[key] = match
+			r.resolved(PreparationEntityKind, verb, source, match.ID, match.Name, false)
+			return match, nil
+		}
+	}
+
+	r.preparations[key] = nil
+	r.unresolved(PreparationEntityKind, verb, source)
+
+	return nil, nil
+}
+
+// instructionVerb picks out the word an instruction's action is most likely named by.
+func instructionVerb(instruction string) string {
+	text := strings.ToLower(instruction)
+
+	fields := strings.Fields(text)
+	if len(fields) > 0 && leadingClauseWords[strings.Trim(fields[0], ",.")] {
+		if _, after, found := strings.Cut(text, ","); found {
+			fields = strings.Fields(after)
+		}
+	}
+
+	for _, field := range fields {
+		word := strings.Trim(field, ",.;:!()")
+		if word == "" || leadingAdverbs[word] {
+			continue
+		}
+
+		return word
+	}
+
+	return ""
+}
+
+func (r *importRun) buildStep(ctx context.Context, index uint32, instruction string) (*mealplanning.RecipeStepCreationRequestInput, error) {
+	step := &mealplanning.RecipeStepCreationRequestInput{
+		Index:                index,
+		ExplicitInstructions: instruction,
+		Ingredients:          []*mealplanning.RecipeStepIngredientCreationRequestInput{},
+		Instruments:          []*mealplanning.RecipeStepInstrumentCreationRequestInput{},
+		Vessels:              []*mealplanning.RecipeStepVesselCreationRequestInput{},
+		CompletionConditions: []*mealplanning.RecipeStepCompletionConditionCreationRequestInput{},
+	}
+
+	step.MinTemperatureInCelsius, step.MaxTemperatureInCelsius = parseTemperature(instruction)
+	step.MinEstimatedTimeInSeconds, step.MaxEstimatedTimeInSeconds = parseDuration(instruction)
+
+	verb := instructionVerb(instruction)
+	if verb == "" {
+		r.warn("step %d has no instructions to take a preparation from", index+1)
+		return step, nil
+	}
+
+	preparation, err := r.resolvePreparation(ctx, verb, instruction)
+	if err != nil {
+		return nil, err
+	}
+
+	if preparation != nil {
+		step.PreparationID = preparation.ID
+		r.productNames[step] = preparation.PastTense
+	}
+
+	return step, nil
+}
+
+func (r *importRun) buildIngredient(ctx context.Context, line string) (*mealplanning.RecipeStepIngredientCreationRequestInput, error) {
+	parsed := ParseIngredientLine(line)
+
+	ingredient := &mealplanning.RecipeStepIngredientCreationRequestInput{
+		Name:        parsed.Name,
+		MinQuantity: parsed.MinQuantity,
+		MaxQuantity: parsed.MaxQuantity,
+		Optional:    parsed.Optional,
+		ToTaste:     parsed.ToTaste,
+		ScaleFactor: 1,
+	}
+
+	notes := []string{}
+	if parsed.Preparation != "" {
+		notes = append(notes, parsed.Preparation)
+	}
+	if parsed.Notes != "" {
+		notes = append(notes, parsed.Notes)
+	}
+	ingredient.IngredientNotes = strings.Join(notes, "; ")
+
+	if !parsed.HasQuantity && !parsed.ToTaste {
+		ingredient.MinQuantity = 1
+		ingredient.QuantityNotes = parsed.Raw
+		r.warn("no quantity found for %q, assuming one", parsed.Raw)
+	}
+
+	if parsed.Name == "" {
+		r.unresolved(IngredientEntityKind, parsed.Raw, line)
+		return ingredient, nil
+	}
+
+	validIngredient, err := r.resolveIngredient(ctx, parsed.Name, line)
+	if err != nil {
+		return nil, err
+	}
+
+	unitName := parsed.Unit
+	if unitName == "" {
+		unitName = countableMeasurementUnitName
+	}
+
+	validUnit, err := r.resolveMeasurementUnit(ctx, unitName, line)
+	if err != nil {
+		return nil, err
+	}
+
+	if validIngredient == nil || validUnit == nil {
+		return ingredient, nil
+	}
+
+	ingredient.Name = validIngredient.Name
+
+	pairs, err := r.searcher.GetValidIngredientMeasurementUnitsForIngredient(ctx, validIngredient.ID, filtering.DefaultQueryFilter())
+	if err != nil {
+		return nil, fmt.Errorf("fetching measurement units for ingredient %q: %w", validIngredient.ID, err)
+	}
+
+	for _, pair := range pairs.Data {
+		if pair.MeasurementUnit.ID == validUnit.ID {
+			ingredient.ValidIngredientMeasurementUnitID = &pair.ID
+			return ingredient, nil
+		}
+	}
+
+	r.unresolved(IngredientMeasurementUnitEntityKind, fmt.Sprintf("%s of %s", validUnit.Name, validIngredient.Name), line)
+
+	return ingredient, nil
+}
+
+// stepForIngredient picks the first step whose instructions mention the ingredient, or the first step otherwise.
+func (r *importRun) stepForIngredient(steps []*mealplanning.RecipeStepCreationRequestInput, name string) *mealplanning.RecipeStepCreationRequestInput {
+	queries := searchQueries(name)
+	for _, step := range steps {
+		instructions := strings.ToLower(step.ExplicitInstructions)
+		for _, query := range queries {
+			if strings.Contains(instructions, query) {
+				return step
+			}
+		}
+	}
+
+	r.warn("no instruction mentions %q, it was added to the first step", name)
+
+	return steps[0]
+}
+
+// buildProduct names a step's output after its preparation and ingredients, like "chopped onion".
+func (r *importRun) buildProduct(step *mealplanning.RecipeStepCreationRequestInput) *mealplanning.RecipeStepProductCreationRequestInput {
+	ingredientNames := []string{}
+	for _, ingredient := range step.Ingredients {
+		ingredientNames = append(ingredientNames, ingredient.Name)
+	}
+
+	name := strings.TrimSpace(fmt.Sprintf("%s %s", r.productNames[step], strings.Join(ingredientNames, " and ")))
+	if name == "" {
+		name = fmt.Sprintf("step %d product", step.Index+1)
+	}
+
+	return &mealplanning.RecipeStepProductCreationRequestInput{
+		Name: name,
+		Type: mealplanning.RecipeStepProductIngredientType,
+	}
+}
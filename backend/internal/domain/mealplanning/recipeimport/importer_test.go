@@ -0,0 +1,188 @@
+package recipeimport
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/fakes"
+	mealplanningmock "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/mocks"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/testutils"
+
+	"github.com/primandproper/platform/database/filtering"
+	"github.com/primandproper/platform/reflection"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func buildIngredientForTest(name, pluralName string) *mealplanning.ValidIngredient {
+	x := fakes.BuildFakeValidIngredient()
+	x.Name = name
+	x.PluralName = pluralName
+	x.Slug = slugify(name)
+
+	return x
+}
+
+func buildMeasurementUnitForTest(name, pluralName string) *mealplanning.ValidMeasurementUnit {
+	x := fakes.BuildFakeValidMeasurementUnit()
+	x.Name = name
+	x.PluralName = pluralName
+	x.Slug = slugify(name)
+
+	return x
+}
+
+func buildPreparationForTest(name, pastTense string) *mealplanning.ValidPreparation {
+	x := fakes.BuildFakeValidPreparation()
+	x.Name = name
+	x.PastTense = pastTense
+	x.Slug = slugify(name)
+
+	return x
+}
+
+func buildIngredientMeasurementUnitForTest(ingredient *mealplanning.ValidIngredient, unit *mealplanning.ValidMeasurementUnit) *mealplanning.ValidIngredientMeasurementUnit {
+	x := fakes.BuildFakeValidIngredientMeasurementUnit()
+	x.Ingredient = *ingredient
+	x.MeasurementUnit = *unit
+
+	return x
+}
+
+func TestImporter_Import(T *testing.T) {
+	T.Parallel()
+
+	T.Run("resolves entities and reports what it could not", func(t *testing.T) {
+		t.Parallel()
+		ctx := t.Context()
+
+		spaghetti := buildIngredientForTest("spaghetti", "spaghetti")
+		butter := buildIngredientForTest("butter", "butter")
+		garlic := buildIngredientForTest("garlic", "garlic")
+		ounce := buildMeasurementUnitForTest("ounce", "ounces")
+		tablespoon := buildMeasurementUnitForTest("tablespoon", "tablespoons")
+		clove := buildMeasurementUnitForTest("clove", "cloves")
+		boil := buildPreparationForTest("boil", "boiled")
+		melt := buildPreparationForTest("melt", "melted")
+		toss := buildPreparationForTest("toss", "tossed")
+
+		spaghettiOunces := buildIngredientMeasurementUnitForTest(spaghetti, ounce)
+		butterTablespoons := buildIngredientMeasurementUnitForTest(butter, tablespoon)
+
+		searcher := NewInMemoryEntitySearcher(
+			[]*mealplanning.ValidIngredient{spaghetti, butter, garlic},
+			[]*mealplanning.ValidMeasurementUnit{ounce, tablespoon, clove},
+			[]*mealplanning.ValidPreparation{boil, melt, toss},
+			[]*mealplanning.ValidIngredientMeasurementUnit{spaghettiOunces, butterTablespoons},
+		)
+
+		actual, err := NewImporter(searcher).Import(ctx, []byte(examplePlainTextRecipe), FormatAuto)
+		require.NoError(t, err)
+
+		recipe := actual.Recipe
+		assert.Equal(t, "Garlic Butter Noodles", recipe.Name)
+		assert.Equal(t, "garlic-butter-noodles", recipe.Slug)
+		assert.Equal(t, mealplanning.MealComponentTypesUnspecified, recipe.YieldsComponentType)
+		assert.Equal(t, float32(2), recipe.MinEstimatedPortions)
+		require.Len(t, recipe.Steps, 3)
+
+		boilStep := recipe.Steps[0]
+		assert.Equal(t, boil.ID, boilStep.PreparationID)
+		require.NotNil(t, boilStep.MinEstimatedTimeInSeconds)
+		assert.Equal(t, uint32(540), *boilStep.MinEstimatedTimeInSeconds)
+		require.Len(t, boilStep.Ingredients, 1)
+		require.NotNil(t, boilStep.Ingredients[0].ValidIngredientMeasurementUnitID)
+		assert.Equal(t, spaghettiOunces.ID, *boilStep.Ingredients[0].ValidIngredientMeasurementUnitID)
+		assert.Equal(t, float32(8), boilStep.Ingredients[0].MinQuantity)
+		require.Len(t, boilStep.Products, 1)
+		assert.Equal(t, "boiled spaghetti", boilStep.Products[0].Name)
+
+		meltStep := recipe.Steps[1]
+		assert.Equal(t, melt.ID, meltStep.PreparationID)
+		require.Len(t, meltStep.Ingredients, 2)
+		assert.Equal(t, butterTablespoons.ID, *meltStep.Ingredients[0].ValidIngredientMeasurementUnitID)
+		assert.Equal(t, "garlic", meltStep.Ingredients[1].Name)
+		assert.Equal(t, "minced", meltStep.Ingredients[1].IngredientNotes)
+		assert.Nil(t, meltStep.Ingredients[1].ValidIngredientMeasurementUnitID)
+
+		assert.Equal(t, toss.ID, recipe.Steps[2].PreparationID)
+
+		unresolved := map[EntityKind][]string{}
+		for _, x := range actual.Report.Unresolved {
+			unresolved[x.Kind] = append(unresolved[x.Kind], x.Text)
+		}
+
+		assert.Equal(t, []string{"parmesan"}, unresolved[IngredientEntityKind])
+		assert.Equal(t, []string{"clove of garlic"}, unresolved[IngredientMeasurementUnitEntityKind])
+		assert.Empty(t, unresolved[PreparationEntityKind])
+		assert.NotEmpty(t, actual.Report.Warnings)
+	})
+
+	T.Run("finds ingredients by their head noun and preparations by past tense", func(t *testing.T) {
+		t.Parallel()
+		ctx := t.Context()
+
+		egg := buildIngredientForTest("egg", "eggs")
+		unit := buildMeasurementUnitForTest("unit", "units")
+		whisk := buildPreparationForTest("whisk", "whisked")
+		fry := buildPreparationForTest("fry", "fried")
+
+		searcher := NewInMemoryEntitySearcher(
+			[]*mealplanning.ValidIngredient{egg},
+			[]*mealplanning.ValidMeasurementUnit{unit},
+			[]*mealplanning.ValidPreparation{whisk, fry},
+			[]*mealplanning.ValidIngredientMeasurementUnit{buildIngredientMeasurementUnitForTest(egg, unit)},
+		)
+
+		content := `{"@type": "Recipe", "name": "Eggs", "recipeIngredient": ["3 large eggs"], "recipeInstructions": ["In a bowl, whisk the eggs.", "Fry."]}`
+
+		actual, err := NewImporter(searcher).Import(ctx, []byte(content), FormatJSONLD)
+		require.NoError(t, err)
+
+		require.Len(t, actual.Recipe.Steps, 2)
+		assert.Equal(t, whisk.ID, actual.Recipe.Steps[0].PreparationID)
+		require.Len(t, actual.Recipe.Steps[0].Ingredients, 1)
+		assert.Equal(t, "egg", actual.Recipe.Steps[0].Ingredients[0].Name)
+		assert.NotNil(t, actual.Recipe.Steps[0].Ingredients[0].ValidIngredientMeasurementUnitID)
+		assert.Equal(t, fry.ID, actual.Recipe.Steps[1].PreparationID)
+		assert.Empty(t, actual.Report.Unresolved)
+
+		for _, x := range actual.Report.Resolved {
+			if x.Kind == IngredientEntityKind {
+				assert.True(t, x.Approximate)
+			}
+		}
+	})
+
+	T.Run("with unknown format", func(t *testing.T) {
+		t.Parallel()
+		ctx := t.Context()
+
+		_, err := NewImporter(NewInMemoryEntitySearcher(nil, nil, nil, nil)).Import(ctx, []byte(examplePlainTextRecipe), Format("pdf"))
+		assert.ErrorIs(t, err, ErrUnknownFormat)
+	})
+
+	T.Run("with unrecognizable content", func(t *testing.T) {
+		t.Parallel()
+		ctx := t.Context()
+
+		_, err := NewImporter(NewInMemoryEntitySearcher(nil, nil, nil, nil)).Import(ctx, []byte("hello"), FormatAuto)
+		assert.ErrorIs(t, err, ErrNoRecipeFound)
+	})
+
+	T.Run("with error searching", func(t *testing.T) {
+		t.Parallel()
+		ctx := t.Context()
+
+		repo := &mealplanningmock.Repository{}
+		repo.On(reflection.GetMethodName(repo.SearchForValidPreparations), testutils.ContextMatcher, "toast", testutils.QueryFilterMatcher).Return((*filtering.QueryFilteredResult[mealplanning.ValidPreparation])(nil), errors.New("blah"))
+
+		_, err := NewImporter(repo).Import(ctx, []byte("Toast\nInstructions\nToast the bread."), FormatText)
+		assert.Error(t, err)
+
+		mock.AssertExpectationsForObjects(t, repo)
+	})
+}
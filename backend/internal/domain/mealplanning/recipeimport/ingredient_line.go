@@ -0,0 +1,272 @@
+package recipeimport
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	// unicodeFractions maps vulgar fraction runes to their ASCII equivalent.
+	unicodeFractions = map[rune]string{
+		'½': "1/2",
+		'⅓': "1/3",
+		'⅔': "2/3",
+		'¼': "1/4",
+		'¾': "3/4",
+		'⅕': "1/5",
+		'⅖': "2/5",
+		'⅗': "3/5",
+		'⅘': "4/5",
+		'⅙': "1/6",
+		'⅚': "5/6",
+		'⅛': "1/8",
+		'⅜': "3/8",
+		'⅝': "5/8",
+		'⅞': "7/8",
+	}
+
+	// unitAliases maps the abbreviations and spellings recipes use to the name we search for.
+	unitAliases = map[string]string{
+		"t":            "teaspoon",
+		"tsp":          "teaspoon",
+		"tsps":         "teaspoon",
+		"teaspoon":     "teaspoon",
+		"teaspoons":    "teaspoon",
+		"T":            "tablespoon",
+		"tbs":          "tablespoon",
+		"tbsp":         "tablespoon",
+		"tbsps":        "tablespoon",
+		"tablespoon":   "tablespoon",
+		"tablespoons":  "tablespoon",
+		"c":            "cup",
+		"cup":          "cup",
+		"cups":         "cup",
+		"pt":           "pint",
+		"pint":         "pint",
+		"pints":        "pint",
+		"qt":           "quart",
+		"quart":        "quart",
+		"quarts":       "quart",
+		"gal":          "gallon",
+		"gallon":       "gallon",
+		"gallons":      "gallon",
+		"fl oz":        "fluid ounce",
+		"fluid ounce":  "fluid ounce",
+		"fluid ounces": "fluid ounce",
+		"oz":           "ounce",
+		"ounce":        "ounce",
+		"ounces":       "ounce",
+		"lb":           "pound",
+		"lbs":          "pound",
+		"pound":        "pound",
+		"pounds":       "pound",
+		"mg":           "milligram",
+		"milligram":    "milligram",
+		"milligrams":   "milligram",
+		"g":            "gram",
+		"gr":           "gram",
+		"gram":         "gram",
+		"grams":        "gram",
+		"kg":           "kilogram",
+		"kilogram":     "kilogram",
+		"kilograms":    "kilogram",
+		"ml":           "milliliter",
+		"milliliter":   "milliliter",
+		"milliliters":  "milliliter",
+		"millilitre":   "milliliter",
+		"millilitres":  "milliliter",
+		"cl":           "centiliter",
+		"dl":           "deciliter",
+		"l":            "liter",
+		"liter":        "liter",
+		"liters":       "liter",
+		"litre":        "liter",
+		"litres":       "liter",
+		"pinch":        "pinch",
+		"pinches":      "pinch",
+		"dash":         "dash",
+		"dashes":       "dash",
+		"clove":        "clove",
+		"cloves":       "clove",
+		"can":          "can",
+		"cans":         "can",
+		"stick":        "stick",
+		"sticks":       "stick",
+		"slice":        "slice",
+		"slices":       "slice",
+		"sprig":        "sprig",
+		"sprigs":       "sprig",
+		"bunch":        "bunch",
+		"bunches":      "bunch",
+		"head":         "head",
+		"heads":        "head",
+		"handful":      "handful",
+		"handfuls":     "handful",
+		"piece":        "piece",
+		"pieces":       "piece",
+	}
+
+	numberPattern        = `(?:\d+\s+\d+/\d+|\d+/\d+|\d+(?:\.\d+)?)`
+	leadingQuantityRegex = regexp.MustCompile(`^(` + numberPattern + `)(?:\s*(?:-|to)\s*(` + numberPattern + `))?\s*`)
+	parentheticalRegex   = regexp.MustCompile(`\(([^)]*)\)`)
+	toTasteRegex         = regexp.MustCompile(`(?i),?\s*\bto taste\b`)
+	optionalRegex        = regexp.MustCompile(`(?i),?\s*\boptional(?:ly)?\b`)
+	whitespaceRegex      = regexp.MustCompile(`\s+`)
+)
+
+// ParsedIngredient is a single ingredient line broken into its parts.
+type ParsedIngredient struct {
+	MaxQuantity *float32
+	Raw         string
+	Name        string
+	Unit        string
+	Preparation string
+	Notes       string
+	MinQuantity float32
+	HasQuantity bool
+	Optional    bool
+	ToTaste     bool
+}
+
+// normalizeText replaces the unicode punctuation recipes tend to use with plain ASCII and collapses whitespace.
+func normalizeText(s string) string {
+	var b strings.Builder
+	for _, r := range s {
+		if frac, ok := unicodeFractions[r]; ok {
+			b.WriteString(" " + frac)
+			continue
+		}
+
+		switch r {
+		case '–', '—', '‒':
+			b.WriteRune('-')
+		case '⁄':
+			b.WriteRune('/')
+		case '\u00a0':
+			b.WriteRune(' ')
+		default:
+			b.WriteRune(r)
+		}
+	}
+
+	return strings.TrimSpace(whitespaceRegex.ReplaceAllString(b.String(), " "))
+}
+
+// parseAmount parses "2", "1.5", "1/2" and "1 1/2" into a number.
+func parseAmount(s string) (float32, bool) {
+	var total float64
+	for field := range strings.FieldsSeq(s) {
+		if numerator, denominator, isFraction := strings.Cut(field, "/"); isFraction {
+			n, err := strconv.ParseFloat(numerator, 64)
+			if err != nil {
+				return 0, false
+			}
+
+			d, err := strconv.ParseFloat(denominator, 64)
+			if err != nil || d == 0 {
+				return 0, false
+			}
+
+			total += n / d
+			continue
+		}
+
+		n, err := strconv.ParseFloat(field, 64)
+		if err != nil {
+			return 0, false
+		}
+		total += n
+	}
+
+	return float32(total), true
+}
+
+// matchUnit returns the canonical unit for the start of s, along with whatever follows it.
+func matchUnit(s string) (unit, rest string) {
+	fields := strings.Fields(s)
+	if len(fields) == 0 {
+		return "", s
+	}
+
+	// two-word units like "fl oz" take precedence.
+	if len(fields) > 1 {
+		if canonical, ok := unitAliases[strings.ToLower(strings.TrimSuffix(fields[0]+" "+fields[1], "."))]; ok {
+			return canonical, strings.Join(fields[2:], " ")
+		}
+	}
+
+	word := strings.TrimSuffix(fields[0], ".")
+	// a lone uppercase T is the traditional tablespoon abbreviation, lowercase t is teaspoon.
+	canonical, ok := unitAliases[word]
+	if !ok {
+		canonical, ok = unitAliases[strings.ToLower(word)]
+	}
+	if !ok {
+		return "", s
+	}
+
+	return canonical, strings.Join(fields[1:], " ")
+}
+
+// ParseIngredientLine breaks a free-form ingredient line such as "1 1/2 cups flour, sifted" into its parts.
+func ParseIngredientLine(line string) *ParsedIngredient {
+	normalized := normalizeText(line)
+	parsed := &ParsedIngredient{Raw: normalized}
+
+	if toTasteRegex.MatchString(normalized) {
+		parsed.ToTaste = true
+		normalized = toTasteRegex.ReplaceAllString(normalized, "")
+	}
+
+	notes := []string{}
+	for _, match := range parentheticalRegex.FindAllStringSubmatch(normalized, -1) {
+		note := strings.TrimSpace(match[1])
+		if optionalRegex.MatchString(note) {
+			parsed.Optional = true
+			note = strings.TrimSpace(optionalRegex.ReplaceAllString(note, ""))
+		}
+		if note != "" {
+			notes = append(notes, note)
+		}
+	}
+	normalized = strings.TrimSpace(parentheticalRegex.ReplaceAllString(normalized, ""))
+
+	if optionalRegex.MatchString(normalized) {
+		parsed.Optional = true
+		normalized = optionalRegex.ReplaceAllString(normalized, "")
+	}
+	normalized = strings.TrimSpace(whitespaceRegex.ReplaceAllString(normalized, " "))
+
+	if match := leadingQuantityRegex.FindStringSubmatch(normalized); match != nil {
+		if minimum, ok := parseAmount(match[1]); ok {
+			parsed.MinQuantity = minimum
+			parsed.HasQuantity = true
+
+			if match[2] != "" {
+				if maximum, maxOK := parseAmount(match[2]); maxOK && maximum > minimum {
+					parsed.MaxQuantity = &maximum
+				}
+			}
+
+			normalized = normalized[len(match[0]):]
+		}
+	} else if fields := strings.Fields(normalized); len(fields) > 1 && (strings.EqualFold(fields[0], "a") || strings.EqualFold(fields[0], "an")) {
+		// "a pinch of salt"
+		parsed.MinQuantity = 1
+		parsed.HasQuantity = true
+		normalized = strings.Join(fields[1:], " ")
+	}
+
+	parsed.Unit, normalized = matchUnit(normalized)
+	if parsed.Unit != "" {
+		normalized = strings.TrimPrefix(normalized, "of ")
+	}
+
+	name, preparation, _ := strings.Cut(normalized, ",")
+	parsed.Name = strings.Trim(strings.TrimSpace(name), ".;:")
+	parsed.Preparation = strings.Trim(strings.TrimSpace(preparation), ".;:")
+	parsed.Notes = strings.Join(notes, "; ")
+
+	return parsed
+}
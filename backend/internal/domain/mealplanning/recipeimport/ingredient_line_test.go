@@ -0,0 +1,106 @@
+package recipeimport
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseIngredientLine(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with quantity, unit, and preparation", func(t *testing.T) {
+		t.Parallel()
+
+		actual := ParseIngredientLine("2 cups all-purpose flour, sifted")
+
+		assert.True(t, actual.HasQuantity)
+		assert.Equal(t, float32(2), actual.MinQuantity)
+		assert.Nil(t, actual.MaxQuantity)
+		assert.Equal(t, "cup", actual.Unit)
+		assert.Equal(t, "all-purpose flour", actual.Name)
+		assert.Equal(t, "sifted", actual.Preparation)
+	})
+
+	T.Run("with mixed and unicode fractions", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, float32(1.5), ParseIngredientLine("1 1/2 tsp salt").MinQuantity)
+		assert.Equal(t, float32(1.5), ParseIngredientLine("1½ tsp salt").MinQuantity)
+		assert.Equal(t, float32(0.75), ParseIngredientLine("¾ cup sugar").MinQuantity)
+	})
+
+	T.Run("with range", func(t *testing.T) {
+		t.Parallel()
+
+		actual := ParseIngredientLine("2–3 cloves garlic, minced")
+
+		assert.Equal(t, float32(2), actual.MinQuantity)
+		require.NotNil(t, actual.MaxQuantity)
+		assert.Equal(t, float32(3), *actual.MaxQuantity)
+		assert.Equal(t, "clove", actual.Unit)
+		assert.Equal(t, "garlic", actual.Name)
+		assert.Equal(t, "minced", actual.Preparation)
+	})
+
+	T.Run("distinguishes tablespoon and teaspoon abbreviations", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, "tablespoon", ParseIngredientLine("1 T butter").Unit)
+		assert.Equal(t, "teaspoon", ParseIngredientLine("1 t butter").Unit)
+		assert.Equal(t, "tablespoon", ParseIngredientLine("1 Tbsp. butter").Unit)
+	})
+
+	T.Run("with two word unit", func(t *testing.T) {
+		t.Parallel()
+
+		actual := ParseIngredientLine("4 fl oz heavy cream")
+
+		assert.Equal(t, "fluid ounce", actual.Unit)
+		assert.Equal(t, "heavy cream", actual.Name)
+	})
+
+	T.Run("without unit", func(t *testing.T) {
+		t.Parallel()
+
+		actual := ParseIngredientLine("3 large eggs")
+
+		assert.Equal(t, float32(3), actual.MinQuantity)
+		assert.Empty(t, actual.Unit)
+		assert.Equal(t, "large eggs", actual.Name)
+	})
+
+	T.Run("with article for quantity", func(t *testing.T) {
+		t.Parallel()
+
+		actual := ParseIngredientLine("a pinch of salt")
+
+		assert.True(t, actual.HasQuantity)
+		assert.Equal(t, float32(1), actual.MinQuantity)
+		assert.Equal(t, "pinch", actual.Unit)
+		assert.Equal(t, "salt", actual.Name)
+	})
+
+	T.Run("to taste", func(t *testing.T) {
+		t.Parallel()
+
+		actual := ParseIngredientLine("black pepper, to taste")
+
+		assert.True(t, actual.ToTaste)
+		assert.False(t, actual.HasQuantity)
+		assert.Equal(t, "black pepper", actual.Name)
+		assert.Empty(t, actual.Preparation)
+	})
+
+	T.Run("with parenthetical notes and optional marker", func(t *testing.T) {
+		t.Parallel()
+
+		actual := ParseIngredientLine("1 can (14 oz) diced tomatoes (optional)")
+
+		assert.True(t, actual.Optional)
+		assert.Equal(t, "can", actual.Unit)
+		assert.Equal(t, "diced tomatoes", actual.Name)
+		assert.Equal(t, "14 oz", actual.Notes)
+	})
+}
@@ -0,0 +1,216 @@
+package recipeimport
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+)
+
+var (
+	jsonLDScriptRegex = regexp.MustCompile(`(?is)<script[^>]*type\s*=\s*["']?application/ld\+json["']?[^>]*>(.*?)</script>`)
+
+	// recipeCategoryComponentTypes maps common schema.org recipeCategory values to meal component types.
+	recipeCategoryComponentTypes = map[string]string{
+		"amuse-bouche": mealplanning.MealComponentTypesAmuseBouche,
+		"appetizer":    mealplanning.MealComponentTypesAppetizer,
+		"appetizers":   mealplanning.MealComponentTypesAppetizer,
+		"starter":      mealplanning.MealComponentTypesAppetizer,
+		"starters":     mealplanning.MealComponentTypesAppetizer,
+		"soup":         mealplanning.MealComponentTypesSoup,
+		"soups":        mealplanning.MealComponentTypesSoup,
+		"main":         mealplanning.MealComponentTypesMain,
+		"main course":  mealplanning.MealComponentTypesMain,
+		"main dish":    mealplanning.MealComponentTypesMain,
+		"entree":       mealplanning.MealComponentTypesMain,
+		"entrée":       mealplanning.MealComponentTypesMain,
+		"dinner":       mealplanning.MealComponentTypesMain,
+		"salad":        mealplanning.MealComponentTypesSalad,
+		"salads":       mealplanning.MealComponentTypesSalad,
+		"side":         mealplanning.MealComponentTypesSide,
+		"side dish":    mealplanning.MealComponentTypesSide,
+		"sides":        mealplanning.MealComponentTypesSide,
+		"beverage":     mealplanning.MealComponentTypesBeverage,
+		"beverages":    mealplanning.MealComponentTypesBeverage,
+		"drink":        mealplanning.MealComponentTypesBeverage,
+		"drinks":       mealplanning.MealComponentTypesBeverage,
+		"cocktail":     mealplanning.MealComponentTypesBeverage,
+		"dessert":      mealplanning.MealComponentTypesDessert,
+		"desserts":     mealplanning.MealComponentTypesDessert,
+	}
+)
+
+// extractJSONLDDocuments returns the JSON-LD payloads embedded in an HTML page, or the content itself if it is already JSON.
+func extractJSONLDDocuments(content string) []string {
+	if trimmed := strings.TrimSpace(content); strings.HasPrefix(trimmed, "{") || strings.HasPrefix(trimmed, "[") {
+		return []string{trimmed}
+	}
+
+	documents := []string{}
+	for _, match := range jsonLDScriptRegex.FindAllStringSubmatch(content, -1) {
+		documents = append(documents, strings.TrimSpace(match[1]))
+	}
+
+	return documents
+}
+
+// parseJSONLD finds the first schema.org Recipe node in the provided HTML or JSON content.
+func parseJSONLD(content string) (*ParsedRecipe, error) {
+	documents := extractJSONLDDocuments(content)
+	if len(documents) == 0 {
+		return nil, ErrNoRecipeFound
+	}
+
+	for i, document := range documents {
+		var decoded any
+		if err := json.Unmarshal([]byte(document), &decoded); err != nil {
+			return nil, fmt.Errorf("decoding JSON-LD document %d: %w", i, err)
+		}
+
+		if node := findRecipeNode(decoded); node != nil {
+			return recipeFromJSONLDNode(node), nil
+		}
+	}
+
+	return nil, ErrNoRecipeFound
+}
+
+// isRecipeType reports whether a JSON-LD @type value names a Recipe.
+func isRecipeType(t any) bool {
+	switch v := t.(type) {
+	case string:
+		return v == "Recipe" || strings.HasSuffix(v, "/Recipe") || strings.HasSuffix(v, ":Recipe")
+	case []any:
+		for _, x := range v {
+			if isRecipeType(x) {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// findRecipeNode walks a decoded JSON-LD document, including @graph and mainEntity nesting, looking for a Recipe.
+func findRecipeNode(node any) map[string]any {
+	switch v := node.(type) {
+	case map[string]any:
+		if isRecipeType(v["@type"]) {
+			return v
+		}
+
+		for _, key := range []string{"@graph", "mainEntity", "mainEntityOfPage"} {
+			if found := findRecipeNode(v[key]); found != nil {
+				return found
+			}
+		}
+	case []any:
+		for _, x := range v {
+			if found := findRecipeNode(x); found != nil {
+				return found
+			}
+		}
+	}
+
+	return nil
+}
+
+// jsonLDText flattens a JSON-LD value that may be a string, a number, a named node, or a list of any of those.
+func jsonLDText(value any) string {
+	switch v := value.(type) {
+	case string:
+		return cleanText(v)
+	case float64:
+		return strconv.FormatFloat(v, 'f', -1, 64)
+	case map[string]any:
+		if name, ok := v["name"]; ok {
+			return jsonLDText(name)
+		}
+		if text, ok := v["text"]; ok {
+			return jsonLDText(text)
+		}
+	case []any:
+		for _, x := range v {
+			if s := jsonLDText(x); s != "" {
+				return s
+			}
+		}
+	}
+
+	return ""
+}
+
+// jsonLDStrings flattens a JSON-LD value into a list of strings.
+func jsonLDStrings(value any) []string {
+	results := []string{}
+
+	switch v := value.(type) {
+	case string:
+		for line := range strings.SplitSeq(v, "\n") {
+			if cleaned := cleanText(line); cleaned != "" {
+				results = append(results, cleaned)
+			}
+		}
+	case float64:
+		results = append(results, jsonLDText(v))
+	case []any:
+		for _, x := range v {
+			results = append(results, jsonLDStrings(x)...)
+		}
+	case map[string]any:
+		// HowToSection nests its steps in itemListElement, HowToStep carries its instruction in text.
+		if elements, ok := v["itemListElement"]; ok {
+			results = append(results, jsonLDStrings(elements)...)
+		} else if text, ok := v["text"]; ok {
+			results = append(results, jsonLDStrings(text)...)
+		} else if s := jsonLDText(v); s != "" {
+			results = append(results, s)
+		}
+	}
+
+	return results
+}
+
+// recipeFromJSONLDNode converts a schema.org Recipe node into a ParsedRecipe.
+func recipeFromJSONLDNode(node map[string]any) *ParsedRecipe {
+	recipe := &ParsedRecipe{
+		Name:         jsonLDText(node["name"]),
+		Description:  jsonLDText(node["description"]),
+		Source:       jsonLDText(node["author"]),
+		Instructions: jsonLDStrings(node["recipeInstructions"]),
+	}
+
+	if publisher := jsonLDText(node["publisher"]); publisher != "" {
+		recipe.Source = publisher
+	}
+
+	if recipe.Source == "" {
+		recipe.Source = jsonLDText(node["url"])
+	}
+
+	ingredients := node["recipeIngredient"]
+	if ingredients == nil {
+		// "ingredients" was the property name before schema.org renamed it.
+		ingredients = node["ingredients"]
+	}
+	recipe.Ingredients = jsonLDStrings(ingredients)
+
+	// sites often list both "4" and "4 servings", so prefer whichever is more descriptive.
+	for _, y := range jsonLDStrings(node["recipeYield"]) {
+		if parsed, ok := parseYield(y); ok && (recipe.Yield == nil || recipe.Yield.PortionName == "serving") {
+			recipe.Yield = parsed
+		}
+	}
+
+	for _, category := range jsonLDStrings(node["recipeCategory"]) {
+		if componentType, ok := recipeCategoryComponentTypes[strings.ToLower(category)]; ok {
+			recipe.ComponentType = componentType
+			break
+		}
+	}
+
+	return recipe
+}
@@ -0,0 +1,110 @@
+package recipeimport
+
+import (
+	"testing"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const exampleRecipeHTML = `<!DOCTYPE html>
+<html>
+<head>
+	<title>Weeknight Tomato Soup</title>
+	<script type="application/ld+json">{"@context": "https://schema.org", "@type": "WebSite", "name": "Example Kitchen"}</script>
+	<script type="application/ld+json">
+	{
+		"@context": "https://schema.org",
+		"@graph": [
+			{"@type": "WebPage", "name": "Weeknight Tomato Soup"},
+			{
+				"@type": ["Recipe", "NewsArticle"],
+				"name": "Weeknight Tomato Soup",
+				"description": "A quick soup with &amp; <b>bright</b> flavor.",
+				"author": {"@type": "Person", "name": "Jane Cook"},
+				"recipeYield": ["4", "4 servings"],
+				"recipeCategory": "Soup",
+				"recipeIngredient": [
+					"2 tablespoons olive oil",
+					"1 onion, diced",
+					"1 can (28 oz) whole tomatoes"
+				],
+				"recipeInstructions": [
+					{"@type": "HowToSection", "name": "Soup", "itemListElement": [
+						{"@type": "HowToStep", "name": "Sweat", "text": "Heat the olive oil and cook the onion for 5 minutes."},
+						{"@type": "HowToStep", "text": "Add the tomatoes and simmer for 20-25 minutes."}
+					]},
+					"Blend until smooth."
+				]
+			}
+		]
+	}
+	</script>
+</head>
+<body></body>
+</html>`
+
+func TestParseJSONLD(T *testing.T) {
+	T.Parallel()
+
+	T.Run("from HTML with @graph and sections", func(t *testing.T) {
+		t.Parallel()
+
+		actual, err := parseJSONLD(exampleRecipeHTML)
+		require.NoError(t, err)
+
+		assert.Equal(t, "Weeknight Tomato Soup", actual.Name)
+		assert.Equal(t, "A quick soup with & bright flavor.", actual.Description)
+		assert.Equal(t, "Jane Cook", actual.Source)
+		assert.Equal(t, mealplanning.MealComponentTypesSoup, actual.ComponentType)
+		require.NotNil(t, actual.Yield)
+		assert.Equal(t, float32(4), actual.Yield.Min)
+		assert.Equal(t, []string{
+			"2 tablespoons olive oil",
+			"1 onion, diced",
+			"1 can (28 oz) whole tomatoes",
+		}, actual.Ingredients)
+		assert.Equal(t, []string{
+			"Heat the olive oil and cook the onion for 5 minutes.",
+			"Add the tomatoes and simmer for 20-25 minutes.",
+			"Blend until smooth.",
+		}, actual.Instructions)
+	})
+
+	T.Run("from bare JSON with string instructions", func(t *testing.T) {
+		t.Parallel()
+
+		actual, err := parseJSONLD(`{
+			"@type": "Recipe",
+			"name": "Toast",
+			"publisher": {"@type": "Organization", "name": "Example Kitchen"},
+			"recipeYield": 2,
+			"recipeIngredient": ["2 slices bread"],
+			"recipeInstructions": "Toast the bread.\nButter the toast."
+		}`)
+		require.NoError(t, err)
+
+		assert.Equal(t, "Toast", actual.Name)
+		assert.Equal(t, "Example Kitchen", actual.Source)
+		require.NotNil(t, actual.Yield)
+		assert.Equal(t, float32(2), actual.Yield.Min)
+		assert.Equal(t, []string{"Toast the bread.", "Butter the toast."}, actual.Instructions)
+	})
+
+	T.Run("without a recipe", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := parseJSONLD(`<html><script type="application/ld+json">{"@type": "WebSite"}</script></html>`)
+		assert.ErrorIs(t, err, ErrNoRecipeFound)
+	})
+
+	T.Run("with invalid JSON", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := parseJSONLD(`<script type="application/ld+json">{"@type": </script>`)
+		assert.Error(t, err)
+		assert.NotErrorIs(t, err, ErrNoRecipeFound)
+	})
+}
@@ -0,0 +1,115 @@
+package recipeimport
+
+import (
+	"regexp"
+	"strings"
+)
+
+type plainTextSection int
+
+const (
+	preambleSection plainTextSection = iota
+	ingredientsSection
+	instructionsSection
+	ignoredSection
+)
+
+var (
+	bulletRegex         = regexp.MustCompile(`^[-*•·]\s+`)
+	stepNumberRegex     = regexp.MustCompile(`(?i)^(?:step\s*)?\d+\s*[.):]\s*`)
+	yieldLineRegex      = regexp.MustCompile(`(?i)^(?:serves|servings|yield|yields|makes)\b`)
+	sourceLineRegex     = regexp.MustCompile(`(?i)^(?:source|from|adapted from|by)\s*:?\s+`)
+	sectionHeadingRegex = regexp.MustCompile(`^#+\s*`)
+
+	// sectionHeadings maps the headings common plain-text recipe layouts use to the section they start.
+	sectionHeadings = map[string]plainTextSection{
+		"ingredients":  ingredientsSection,
+		"ingredient":   ingredientsSection,
+		"instructions": instructionsSection,
+		"directions":   instructionsSection,
+		"method":       instructionsSection,
+		"steps":        instructionsSection,
+		"preparation":  instructionsSection,
+		"notes":        ignoredSection,
+		"note":         ignoredSection,
+		"tips":         ignoredSection,
+		"nutrition":    ignoredSection,
+	}
+)
+
+// headingSection reports which section, if any, a line is the heading for.
+func headingSection(line string) (plainTextSection, bool) {
+	heading := strings.ToLower(strings.TrimSuffix(strings.TrimSpace(sectionHeadingRegex.ReplaceAllString(line, "")), ":"))
+	section, ok := sectionHeadings[heading]
+	return section, ok
+}
+
+// parsePlainText parses a recipe laid out as a title, an optional preamble, and "Ingredients" and "Instructions" sections.
+func parsePlainText(content string) (*ParsedRecipe, error) {
+	recipe := &ParsedRecipe{}
+
+	var (
+		section          = preambleSection
+		description      []string
+		numberedSteps    bool
+		sawSectionHeader bool
+	)
+
+	for rawLine := range strings.SplitSeq(content, "\n") {
+		line := normalizeText(rawLine)
+		if line == "" {
+			continue
+		}
+
+		if next, ok := headingSection(line); ok {
+			section = next
+			sawSectionHeader = true
+			continue
+		}
+
+		switch section {
+		case preambleSection:
+			switch {
+			case recipe.Name == "":
+				recipe.Name = strings.TrimSpace(sectionHeadingRegex.ReplaceAllString(line, ""))
+			case yieldLineRegex.MatchString(line):
+				recipe.Yield, _ = parseYield(line)
+			case sourceLineRegex.MatchString(line):
+				recipe.Source = sourceLineRegex.ReplaceAllString(line, "")
+			default:
+				description = append(description, line)
+			}
+		case ingredientsSection:
+			line = bulletRegex.ReplaceAllString(line, "")
+			// sub-headings like "For the sauce:" group ingredients but aren't ingredients themselves.
+			if strings.HasSuffix(line, ":") {
+				continue
+			}
+			recipe.Ingredients = append(recipe.Ingredients, line)
+		case instructionsSection:
+			numbered := stepNumberRegex.MatchString(line)
+			line = stepNumberRegex.ReplaceAllString(bulletRegex.ReplaceAllString(line, ""), "")
+			if line == "" {
+				continue
+			}
+
+			// in numbered layouts, an unnumbered line continues the previous step.
+			if numbered {
+				numberedSteps = true
+			} else if numberedSteps && len(recipe.Instructions) > 0 {
+				recipe.Instructions[len(recipe.Instructions)-1] += " " + line
+				continue
+			}
+
+			recipe.Instructions = append(recipe.Instructions, line)
+		}
+	}
+
+	if !sawSectionHeader || recipe.Name == "" || (len(recipe.Ingredients) == 0 && len(recipe.Instructions) == 0) {
+		return nil, ErrNoRecipeFound
+	}
+
+	recipe.Description = strings.Join(description, " ")
+
+	return recipe, nil
+}
@@ -0,0 +1,78 @@
+package recipeimport
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const examplePlainTextRecipe = `# Garlic Butter Noodles
+
+A pantry staple for busy nights.
+Serves 2-3
+Source: Example Kitchen
+
+## Ingredients
+- 8 oz spaghetti
+- 3 tbsp butter
+
+For the topping:
+- 2 cloves garlic, minced
+- parmesan, to taste
+
+## Instructions
+1. Boil the spaghetti for 9 minutes.
+   Reserve some of the cooking water.
+2. Melt the butter and cook the garlic.
+3. Toss everything together with the parmesan.
+
+Notes:
+Leftovers keep for a day.
+`
+
+func TestParsePlainText(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		actual, err := parsePlainText(examplePlainTextRecipe)
+		require.NoError(t, err)
+
+		assert.Equal(t, "Garlic Butter Noodles", actual.Name)
+		assert.Equal(t, "A pantry staple for busy nights.", actual.Description)
+		assert.Equal(t, "Example Kitchen", actual.Source)
+		require.NotNil(t, actual.Yield)
+		assert.Equal(t, float32(2), actual.Yield.Min)
+		require.NotNil(t, actual.Yield.Max)
+		assert.Equal(t, float32(3), *actual.Yield.Max)
+		assert.Equal(t, []string{
+			"8 oz spaghetti",
+			"3 tbsp butter",
+			"2 cloves garlic, minced",
+			"parmesan, to taste",
+		}, actual.Ingredients)
+		assert.Equal(t, []string{
+			"Boil the spaghetti for 9 minutes. Reserve some of the cooking water.",
+			"Melt the butter and cook the garlic.",
+			"Toss everything together with the parmesan.",
+		}, actual.Instructions)
+	})
+
+	T.Run("with unnumbered paragraphs", func(t *testing.T) {
+		t.Parallel()
+
+		actual, err := parsePlainText("Toast\n\nIngredients:\n2 slices bread\n\nDirections:\nToast the bread.\nButter the toast.\n")
+		require.NoError(t, err)
+
+		assert.Equal(t, []string{"Toast the bread.", "Butter the toast."}, actual.Instructions)
+	})
+
+	T.Run("without sections", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := parsePlainText("just some notes about dinner\nnothing else")
+		assert.ErrorIs(t, err, ErrNoRecipeFound)
+	})
+}
@@ -0,0 +1,119 @@
+package recipeimport
+
+import (
+	"context"
+	"strings"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+
+	"github.com/primandproper/platform/database/filtering"
+)
+
+// EntitySearcher is the subset of the meal planning repository the importer resolves names against.
+type EntitySearcher interface {
+	SearchForValidIngredients(ctx context.Context, query string, filter *filtering.QueryFilter) (*filtering.QueryFilteredResult[mealplanning.ValidIngredient], error)
+	SearchForValidMeasurementUnits(ctx context.Context, query string, filter *filtering.QueryFilter) (*filtering.QueryFilteredResult[mealplanning.ValidMeasurementUnit], error)
+	SearchForValidPreparations(ctx context.Context, query string, filter *filtering.QueryFilter) (*filtering.QueryFilteredResult[mealplanning.ValidPreparation], error)
+	GetValidIngredientMeasurementUnitsForIngredient(ctx context.Context, ingredientID string, filter *filtering.QueryFilter) (*filtering.QueryFilteredResult[mealplanning.ValidIngredientMeasurementUnit], error)
+}
+
+var (
+	_ EntitySearcher = (mealplanning.Repository)(nil)
+	_ EntitySearcher = (*InMemoryEntitySearcher)(nil)
+)
+
+// InMemoryEntitySearcher searches a fixed set of valid enumerations, such as a data export, without touching a database.
+type InMemoryEntitySearcher struct {
+	ingredients                []*mealplanning.ValidIngredient
+	measurementUnits           []*mealplanning.ValidMeasurementUnit
+	preparations               []*mealplanning.ValidPreparation
+	ingredientMeasurementUnits []*mealplanning.ValidIngredientMeasurementUnit
+}
+
+// NewInMemoryEntitySearcher builds an InMemoryEntitySearcher.
+func NewInMemoryEntitySearcher(
+	ingredients []*mealplanning.ValidIngredient,
+	measurementUnits []*mealplanning.ValidMeasurementUnit,
+	preparations []*mealplanning.ValidPreparation,
+	ingredientMeasurementUnits []*mealplanning.ValidIngredientMeasurementUnit,
+) *InMemoryEntitySearcher {
+	return &InMemoryEntitySearcher{
+		ingredients:                ingredients,
+		measurementUnits:           measurementUnits,
+		preparations:               preparations,
+		ingredientMeasurementUnits: ingredientMeasurementUnits,
+	}
+}
+
+// searchInMemory returns the items with a name containing the query, exact matches first.
+func searchInMemory[T any](items []*T, query string, names func(*T) []string) *filtering.QueryFilteredResult[T] {
+	query = strings.ToLower(strings.TrimSpace(query))
+
+	var exact, partial []*T
+	for _, item := range items {
+		matched, matchedExactly := false, false
+		for _, name := range names(item) {
+			name = strings.ToLower(name)
+			if name == "" {
+				continue
+			}
+
+			if name == query {
+				matchedExactly = true
+			}
+			if strings.Contains(name, query) {
+				matched = true
+			}
+		}
+
+		switch {
+		case matchedExactly:
+			exact = append(exact, item)
+		case matched:
+			partial = append(partial, item)
+		}
+	}
+
+	return &filtering.QueryFilteredResult[T]{
+		Data: append(exact, partial...),
+	}
+}
+
+// SearchForValidIngredients implements EntitySearcher.
+func (s *InMemoryEntitySearcher) SearchForValidIngredients(_ context.Context, query string, _ *filtering.QueryFilter) (*filtering.QueryFilteredResult[mealplanning.ValidIngredient], error) {
+	return searchInMemory(s.ingredients, query, ingredientNames), nil
+}
+
+// SearchForValidMeasurementUnits implements EntitySearcher.
+func (s *InMemoryEntitySearcher) SearchForValidMeasurementUnits(_ context.Context, query string, _ *filtering.QueryFilter) (*filtering.QueryFilteredResult[mealplanning.ValidMeasurementUnit], error) {
+	return searchInMemory(s.measurementUnits, query, measurementUnitNames), nil
+}
+
+// SearchForValidPreparations implements EntitySearcher.
+func (s *InMemoryEntitySearcher) SearchForValidPreparations(_ context.Context, query string, _ *filtering.QueryFilter) (*filtering.QueryFilteredResult[mealplanning.ValidPreparation], error) {
+	return searchInMemory(s.preparations, query, preparationNames), nil
+}
+
+// GetValidIngredientMeasurementUnitsForIngredient implements EntitySearcher.
+func (s *InMemoryEntitySearcher) GetValidIngredientMeasurementUnitsForIngredient(_ context.Context, ingredientID string, _ *filtering.QueryFilter) (*filtering.QueryFilteredResult[mealplanning.ValidIngredientMeasurementUnit], error) {
+	results := &filtering.QueryFilteredResult[mealplanning.ValidIngredientMeasurementUnit]{}
+	for _, x := range s.ingredientMeasurementUnits {
+		if x.Ingredient.ID == ingredientID {
+			results.Data = append(results.Data, x)
+		}
+	}
+
+	return results, nil
+}
+
+func ingredientNames(x *mealplanning.ValidIngredient) []string {
+	return []string{x.Name, x.PluralName, x.Slug}
+}
+
+func measurementUnitNames(x *mealplanning.ValidMeasurementUnit) []string {
+	return []string{x.Name, x.PluralName, x.Slug}
+}
+
+func preparationNames(x *mealplanning.ValidPreparation) []string {
+	return []string{x.Name, x.PastTense, x.Slug}
+}
@@ -0,0 +1,183 @@
+package recipeimport
+
+import (
+	"html"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+var (
+	htmlTagRegex     = regexp.MustCompile(`<[^>]*>`)
+	nonSlugCharRegex = regexp.MustCompile(`[^a-z0-9]+`)
+	yieldRegex       = regexp.MustCompile(`(?i)(\d+)(?:\s*(?:-|to)\s*(\d+))?\s*([a-z]+)?`)
+	temperatureRegex = regexp.MustCompile(`(?i)(\d+(?:\.\d+)?)(?:\s*(?:-|to)\s*(\d+(?:\.\d+)?))?\s*(?:°|º|degrees?)\s*([cf])\b`)
+	durationRegex    = regexp.MustCompile(`(?i)(\d+(?:\.\d+)?)(?:\s*(?:-|to)\s*(\d+(?:\.\d+)?))?\s*(seconds?|secs?|minutes?|mins?|hours?|hrs?)\b`)
+
+	// servingWords are yield units that just mean "servings".
+	servingWords = map[string]bool{
+		"serving":  true,
+		"servings": true,
+		"serves":   true,
+		"people":   true,
+		"person":   true,
+		"persons":  true,
+		"portion":  true,
+		"portions": true,
+	}
+)
+
+// cleanText strips markup and entities from text scraped out of an HTML document.
+func cleanText(s string) string {
+	return normalizeText(html.UnescapeString(htmlTagRegex.ReplaceAllString(s, " ")))
+}
+
+// slugify turns a recipe name into a URL-friendly slug.
+func slugify(s string) string {
+	return strings.Trim(nonSlugCharRegex.ReplaceAllString(strings.ToLower(s), "-"), "-")
+}
+
+// singularize is a best-effort English singular for portion names.
+func singularize(word string) string {
+	switch {
+	case strings.HasSuffix(word, "ies"):
+		// "pastries" becomes "pastry", but "cookies" and "pies" keep their "ie".
+		stem := strings.TrimSuffix(word, "ies")
+		if len(stem) < 2 || strings.ContainsRune("aeiouw", rune(stem[len(stem)-2])) {
+			return stem + "ie"
+		}
+		return stem + "y"
+	case strings.HasSuffix(word, "ches"), strings.HasSuffix(word, "shes"), strings.HasSuffix(word, "xes"), strings.HasSuffix(word, "ses"):
+		return strings.TrimSuffix(word, "es")
+	case strings.HasSuffix(word, "s") && !strings.HasSuffix(word, "ss"):
+		return strings.TrimSuffix(word, "s")
+	default:
+		return word
+	}
+}
+
+// pluralize is a best-effort English plural for portion names.
+func pluralize(word string) string {
+	switch {
+	case strings.HasSuffix(word, "y") && len(word) > 1 && !strings.ContainsRune("aeiou", rune(word[len(word)-2])):
+		return strings.TrimSuffix(word, "y") + "ies"
+	case strings.HasSuffix(word, "ch"), strings.HasSuffix(word, "sh"), strings.HasSuffix(word, "x"), strings.HasSuffix(word, "s"):
+		return word + "es"
+	default:
+		return word + "s"
+	}
+}
+
+// Yield is how much a recipe makes.
+type Yield struct {
+	Max               *float32
+	PortionName       string
+	PluralPortionName string
+	Min               float32
+}
+
+// parseYield parses strings like "4 servings", "Serves 4-6" or "Makes 12 cookies".
+func parseYield(s string) (*Yield, bool) {
+	match := yieldRegex.FindStringSubmatch(normalizeText(s))
+	if match == nil {
+		return nil, false
+	}
+
+	minimum, err := strconv.ParseFloat(match[1], 32)
+	if err != nil || minimum <= 0 {
+		return nil, false
+	}
+
+	yield := &Yield{
+		Min:               float32(minimum),
+		PortionName:       "serving",
+		PluralPortionName: "servings",
+	}
+
+	if match[2] != "" {
+		if maximum, maxErr := strconv.ParseFloat(match[2], 32); maxErr == nil && maximum > minimum {
+			yield.Max = new(float32(maximum))
+		}
+	}
+
+	if word := strings.ToLower(match[3]); word != "" && !servingWords[word] {
+		yield.PortionName = singularize(word)
+		yield.PluralPortionName = pluralize(yield.PortionName)
+	}
+
+	return yield, true
+}
+
+// parseRange parses the minimum and optional maximum out of a regex match.
+func parseRange(minimumText, maximumText string) (minimum float64, maximum *float64, ok bool) {
+	minimum, err := strconv.ParseFloat(minimumText, 64)
+	if err != nil {
+		return 0, nil, false
+	}
+
+	if maximumText != "" {
+		if parsedMaximum, maxErr := strconv.ParseFloat(maximumText, 64); maxErr == nil && parsedMaximum > minimum {
+			maximum = &parsedMaximum
+		}
+	}
+
+	return minimum, maximum, true
+}
+
+// parseTemperature finds the first temperature mentioned in an instruction, in Celsius.
+func parseTemperature(s string) (minimum, maximum *float32) {
+	match := temperatureRegex.FindStringSubmatch(s)
+	if match == nil {
+		return nil, nil
+	}
+
+	low, high, ok := parseRange(match[1], match[2])
+	if !ok {
+		return nil, nil
+	}
+
+	toCelsius := func(x float64) float32 {
+		if strings.EqualFold(match[3], "f") {
+			x = (x - 32) * 5 / 9
+		}
+		return float32(math.Round(x*10) / 10)
+	}
+
+	minimum = new(toCelsius(low))
+	if high != nil {
+		maximum = new(toCelsius(*high))
+	}
+
+	return minimum, maximum
+}
+
+// parseDuration finds the first duration mentioned in an instruction, in seconds.
+func parseDuration(s string) (minimum, maximum *uint32) {
+	match := durationRegex.FindStringSubmatch(s)
+	if match == nil {
+		return nil, nil
+	}
+
+	low, high, ok := parseRange(match[1], match[2])
+	if !ok {
+		return nil, nil
+	}
+
+	var multiplier float64
+	switch unit := strings.ToLower(match[3]); {
+	case strings.HasPrefix(unit, "h"):
+		multiplier = 3600
+	case strings.HasPrefix(unit, "m"):
+		multiplier = 60
+	default:
+		multiplier = 1
+	}
+
+	minimum = new(uint32(low * multiplier))
+	if high != nil {
+		maximum = new(uint32(*high * multiplier))
+	}
+
+	return minimum, maximum
+}
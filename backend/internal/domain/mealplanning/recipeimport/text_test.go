@@ -0,0 +1,108 @@
+package recipeimport
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseYield(T *testing.T) {
+	T.Parallel()
+
+	T.Run("servings", func(t *testing.T) {
+		t.Parallel()
+
+		actual, ok := parseYield("Serves 4-6")
+		require.True(t, ok)
+
+		assert.Equal(t, float32(4), actual.Min)
+		require.NotNil(t, actual.Max)
+		assert.Equal(t, float32(6), *actual.Max)
+		assert.Equal(t, "serving", actual.PortionName)
+		assert.Equal(t, "servings", actual.PluralPortionName)
+	})
+
+	T.Run("named portions", func(t *testing.T) {
+		t.Parallel()
+
+		actual, ok := parseYield("Makes 24 cookies")
+		require.True(t, ok)
+
+		assert.Equal(t, float32(24), actual.Min)
+		assert.Nil(t, actual.Max)
+		assert.Equal(t, "cookie", actual.PortionName)
+		assert.Equal(t, "cookies", actual.PluralPortionName)
+	})
+
+	T.Run("without a number", func(t *testing.T) {
+		t.Parallel()
+
+		_, ok := parseYield("a crowd")
+		assert.False(t, ok)
+	})
+}
+
+func TestParseTemperature(T *testing.T) {
+	T.Parallel()
+
+	T.Run("fahrenheit", func(t *testing.T) {
+		t.Parallel()
+
+		minimum, maximum := parseTemperature("Preheat the oven to 350°F.")
+		require.NotNil(t, minimum)
+		assert.Equal(t, float32(176.7), *minimum)
+		assert.Nil(t, maximum)
+	})
+
+	T.Run("celsius range", func(t *testing.T) {
+		t.Parallel()
+
+		minimum, maximum := parseTemperature("Bake at 180-200 degrees C")
+		require.NotNil(t, minimum)
+		require.NotNil(t, maximum)
+		assert.Equal(t, float32(180), *minimum)
+		assert.Equal(t, float32(200), *maximum)
+	})
+
+	T.Run("without temperature", func(t *testing.T) {
+		t.Parallel()
+
+		minimum, maximum := parseTemperature("Add 2 cups of water")
+		assert.Nil(t, minimum)
+		assert.Nil(t, maximum)
+	})
+}
+
+func TestParseDuration(T *testing.T) {
+	T.Parallel()
+
+	T.Run("minute range", func(t *testing.T) {
+		t.Parallel()
+
+		minimum, maximum := parseDuration("Bake for 20 to 25 minutes, until golden.")
+		require.NotNil(t, minimum)
+		require.NotNil(t, maximum)
+		assert.Equal(t, uint32(1200), *minimum)
+		assert.Equal(t, uint32(1500), *maximum)
+	})
+
+	T.Run("hours", func(t *testing.T) {
+		t.Parallel()
+
+		minimum, maximum := parseDuration("Let rise for 1 hour")
+		require.NotNil(t, minimum)
+		assert.Equal(t, uint32(3600), *minimum)
+		assert.Nil(t, maximum)
+	})
+}
+
+func TestSlugify(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, "grandma-s-best-chocolate-chip-cookies", slugify("Grandma's Best Chocolate-Chip Cookies!"))
+	})
+}
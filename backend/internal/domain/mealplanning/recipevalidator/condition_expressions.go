@@ -0,0 +1,101 @@
+package recipevalidator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/stepconditions"
+)
+
+// ValidateConditionExpressions parses and type checks every step's condition expression, and checks that the
+// ingredients, options, and products each one refers to exist in the recipe. Steps whose preparation (looked up
+// by ID in preparations) requires a condition expression must have one. Unlike ValidateAndPopulate, it needs no
+// bridge table data, so it's safe to run on every recipe creation input.
+func ValidateConditionExpressions(input *mealplanning.RecipeDatabaseCreationInput, preparations map[string]*mealplanning.ValidPreparation) error {
+	if input == nil {
+		return fmt.Errorf("input is nil")
+	}
+
+	ingredientIDs := map[string]bool{}
+	stepsByIndex := map[uint32]*mealplanning.RecipeStepDatabaseCreationInput{}
+	for _, step := range input.Steps {
+		if step == nil {
+			continue
+		}
+
+		stepsByIndex[step.Index] = step
+		for _, ingredient := range step.Ingredients {
+			if ingredient != nil && ingredient.IngredientID != nil && *ingredient.IngredientID != "" {
+				ingredientIDs[*ingredient.IngredientID] = true
+			}
+		}
+	}
+
+	for stepIdx, step := range input.Steps {
+		if step == nil {
+			continue
+		}
+
+		if preparation, ok := preparations[step.PreparationID]; ok && preparation.ConditionExpressionRequired && strings.TrimSpace(step.ConditionExpression) == "" {
+			return fmt.Errorf("step %d: preparation %q requires a condition expression", stepIdx, preparation.Name)
+		}
+
+		expression, err := stepconditions.Parse(step.ConditionExpression)
+		if err != nil {
+			return fmt.Errorf("step %d: invalid condition expression %q: %w", stepIdx, step.ConditionExpression, err)
+		}
+
+		refs := expression.References()
+		for _, ingredientID := range refs.IngredientIDs {
+			if !ingredientIDs[ingredientID] {
+				return fmt.Errorf("step %d: condition refers to ingredient %q, which the recipe does not use", stepIdx, ingredientID)
+			}
+		}
+
+		for _, option := range refs.Options {
+			if !stepHasIngredientOption(stepsByIndex[option.StepIndex], option) {
+				return fmt.Errorf("step %d: condition refers to option %d of ingredient %d in step %d, which does not exist",
+					stepIdx, option.OptionIndex, option.IngredientIndex, option.StepIndex)
+			}
+		}
+
+		for _, productName := range refs.ProductNames {
+			if !earlierStepProduces(input.Steps, step.Index, productName) {
+				return fmt.Errorf("step %d: condition refers to product %q, which no earlier step produces", stepIdx, productName)
+			}
+		}
+	}
+
+	return nil
+}
+
+func stepHasIngredientOption(step *mealplanning.RecipeStepDatabaseCreationInput, option stepconditions.OptionReference) bool {
+	if step == nil {
+		return false
+	}
+
+	for _, ingredient := range step.Ingredients {
+		if ingredient != nil && ingredient.Index == option.IngredientIndex && ingredient.OptionIndex == option.OptionIndex {
+			return true
+		}
+	}
+
+	return false
+}
+
+func earlierStepProduces(steps []*mealplanning.RecipeStepDatabaseCreationInput, stepIndex uint32, productName string) bool {
+	for _, step := range steps {
+		if step == nil || step.Index >= stepIndex {
+			continue
+		}
+
+		for _, product := range step.Products {
+			if product != nil && product.Name == productName {
+				return true
+			}
+		}
+	}
+
+	return false
+}
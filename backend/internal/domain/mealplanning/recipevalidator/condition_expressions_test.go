@@ -0,0 +1,133 @@
+package recipevalidator
+
+import (
+	"testing"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/fakes"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func buildConditionalRecipeInput(condition string) (*mealplanning.RecipeDatabaseCreationInput, string) {
+	butterID := fakes.BuildFakeID()
+
+	return &mealplanning.RecipeDatabaseCreationInput{
+		ID: fakes.BuildFakeID(),
+		Steps: []*mealplanning.RecipeStepDatabaseCreationInput{
+			{
+				ID:    fakes.BuildFakeID(),
+				Index: 0,
+				Ingredients: []*mealplanning.RecipeStepIngredientDatabaseCreationInput{
+					{ID: fakes.BuildFakeID(), IngredientID: &butterID, Index: 0, OptionIndex: 0},
+					{ID: fakes.BuildFakeID(), IngredientID: new(fakes.BuildFakeID()), Index: 0, OptionIndex: 1},
+				},
+				Products: []*mealplanning.RecipeStepProductDatabaseCreationInput{
+					{ID: fakes.BuildFakeID(), Name: "browned butter"},
+				},
+			},
+			{
+				ID:                  fakes.BuildFakeID(),
+				Index:               1,
+				ConditionExpression: condition,
+			},
+		},
+	}, butterID
+}
+
+func TestValidateConditionExpressions(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with nil input", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Error(t, ValidateConditionExpressions(nil, nil))
+	})
+
+	T.Run("with valid conditions", func(t *testing.T) {
+		t.Parallel()
+
+		for _, condition := range []string{
+			"",
+			`scale > 2`,
+			`option_selected(0, 0, 1)`,
+			`product_produced("browned butter")`,
+		} {
+			input, _ := buildConditionalRecipeInput(condition)
+			assert.NoError(t, ValidateConditionExpressions(input, nil), condition)
+		}
+
+		input, butterID := buildConditionalRecipeInput("")
+		input.Steps[1].ConditionExpression = `ingredient_present("` + butterID + `") && scale < 4`
+		assert.NoError(t, ValidateConditionExpressions(input, nil))
+	})
+
+	T.Run("with invalid conditions", func(t *testing.T) {
+		t.Parallel()
+
+		for condition, expectedMessage := range map[string]string{
+			`scale >`:                            "invalid condition expression",
+			`ingredient_present("not-an-id")`:    "which the recipe does not use",
+			`option_selected(0, 0, 2)`:           "which does not exist",
+			`option_selected(7, 0, 0)`:           "which does not exist",
+			`product_produced("melted cheese")`:  "which no earlier step produces",
+			`scale > 1 && ingredient_present(1)`: "invalid condition expression",
+		} {
+			input, _ := buildConditionalRecipeInput(condition)
+
+			err := ValidateConditionExpressions(input, nil)
+			require.Error(t, err, condition)
+			assert.Contains(t, err.Error(), expectedMessage, condition)
+		}
+	})
+
+	T.Run("does not allow steps to depend on their own products", func(t *testing.T) {
+		t.Parallel()
+
+		input, _ := buildConditionalRecipeInput("")
+		input.Steps[0].ConditionExpression = `product_produced("browned butter")`
+
+		assert.Error(t, ValidateConditionExpressions(input, nil))
+	})
+
+	T.Run("with preparation requiring a condition expression", func(t *testing.T) {
+		t.Parallel()
+
+		preparation := fakes.BuildFakeValidPreparation()
+		preparation.ConditionExpressionRequired = true
+		preparations := map[string]*mealplanning.ValidPreparation{preparation.ID: preparation}
+
+		input, _ := buildConditionalRecipeInput("")
+		input.Steps[1].PreparationID = preparation.ID
+
+		err := ValidateConditionExpressions(input, preparations)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "requires a condition expression")
+
+		input.Steps[1].ConditionExpression = `scale > 2`
+		assert.NoError(t, ValidateConditionExpressions(input, preparations))
+
+		preparation.ConditionExpressionRequired = false
+		input.Steps[1].ConditionExpression = ""
+		assert.NoError(t, ValidateConditionExpressions(input, preparations))
+	})
+
+	T.Run("is run by ValidateAndPopulate", func(t *testing.T) {
+		t.Parallel()
+
+		input, _ := buildConditionalRecipeInput(`unknown()`)
+
+		assert.Error(t, NewRecipeValidator(nil, nil, nil, nil, nil).ValidateAndPopulate(input))
+
+		preparation := fakes.BuildFakeValidPreparation()
+		preparation.ConditionExpressionRequired = true
+
+		input, _ = buildConditionalRecipeInput("")
+		input.Steps[1].PreparationID = preparation.ID
+
+		err := NewRecipeValidator(nil, nil, nil, nil, map[string]*mealplanning.ValidPreparation{preparation.ID: preparation}).ValidateAndPopulate(input)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "requires a condition expression")
+	})
+}
@@ -12,6 +12,7 @@ type RecipeValidator struct {
 	validIngredientMeasurementUnits map[string]*mealplanning.ValidIngredientMeasurementUnit
 	validPreparationInstruments     map[string]*mealplanning.ValidPreparationInstrument
 	validPreparationVessels         map[string]*mealplanning.ValidPreparationVessel
+	validPreparations               map[string]*mealplanning.ValidPreparation
 }
 
 // NewRecipeValidator creates a new RecipeValidator with the provided bridge table maps.
@@ -20,12 +21,14 @@ func NewRecipeValidator(
 	validIngredientMeasurementUnits map[string]*mealplanning.ValidIngredientMeasurementUnit,
 	validPreparationInstruments map[string]*mealplanning.ValidPreparationInstrument,
 	validPreparationVessels map[string]*mealplanning.ValidPreparationVessel,
+	validPreparations map[string]*mealplanning.ValidPreparation,
 ) *RecipeValidator {
 	return &RecipeValidator{
 		validIngredientPreparations:     validIngredientPreparations,
 		validIngredientMeasurementUnits: validIngredientMeasurementUnits,
 		validPreparationInstruments:     validPreparationInstruments,
 		validPreparationVessels:         validPreparationVessels,
+		validPreparations:               validPreparations,
 	}
 }
 
@@ -42,7 +45,8 @@ func (v *RecipeValidator) ValidateAndPopulate(input *mealplanning.RecipeDatabase
 		}
 	}
 
-	return nil
+	// conditions are checked last, since they can refer to ingredient IDs populated from bridge tables above
+	return ValidateConditionExpressions(input, v.validPreparations)
 }
 
 // validateStep validates all ingredients, instruments, and vessels in a step.
@@ -16,7 +16,7 @@ func TestRecipeValidator_ValidateAndPopulate(T *testing.T) {
 	T.Run("with nil input", func(t *testing.T) {
 		t.Parallel()
 
-		validator := NewRecipeValidator(nil, nil, nil, nil)
+		validator := NewRecipeValidator(nil, nil, nil, nil, nil)
 		err := validator.ValidateAndPopulate(nil)
 		assert.Error(t, err)
 		assert.Contains(t, err.Error(), "input is nil")
@@ -78,7 +78,7 @@ func TestRecipeValidator_ValidateAndPopulate(T *testing.T) {
 			},
 		}
 
-		validator := NewRecipeValidator(vipMap, vimuMap, vpiMap, vpvMap)
+		validator := NewRecipeValidator(vipMap, vimuMap, vpiMap, vpvMap, nil)
 		err := validator.ValidateAndPopulate(input)
 
 		assert.NoError(t, err)
@@ -122,7 +122,7 @@ func TestRecipeValidator_ValidateAndPopulate(T *testing.T) {
 			},
 		}
 
-		validator := NewRecipeValidator(nil, nil, nil, nil)
+		validator := NewRecipeValidator(nil, nil, nil, nil, nil)
 		err := validator.ValidateAndPopulate(input)
 
 		assert.Error(t, err)
@@ -160,7 +160,7 @@ func TestRecipeValidator_ValidateAndPopulate(T *testing.T) {
 			},
 		}
 
-		validator := NewRecipeValidator(vipMap, nil, nil, nil)
+		validator := NewRecipeValidator(vipMap, nil, nil, nil, nil)
 		err := validator.ValidateAndPopulate(input)
 
 		assert.Error(t, err)
@@ -203,7 +203,7 @@ func TestRecipeValidator_ValidateAndPopulate(T *testing.T) {
 			},
 		}
 
-		validator := NewRecipeValidator(vipMap, vimuMap, nil, nil)
+		validator := NewRecipeValidator(vipMap, vimuMap, nil, nil, nil)
 		err := validator.ValidateAndPopulate(input)
 
 		assert.Error(t, err)
@@ -234,7 +234,7 @@ func TestRecipeValidator_ValidateAndPopulate(T *testing.T) {
 			},
 		}
 
-		validator := NewRecipeValidator(nil, nil, nil, nil)
+		validator := NewRecipeValidator(nil, nil, nil, nil, nil)
 		err := validator.ValidateAndPopulate(input)
 
 		assert.Error(t, err)
@@ -271,7 +271,7 @@ func TestRecipeValidator_ValidateAndPopulate(T *testing.T) {
 			},
 		}
 
-		validator := NewRecipeValidator(nil, nil, vpiMap, nil)
+		validator := NewRecipeValidator(nil, nil, vpiMap, nil, nil)
 		err := validator.ValidateAndPopulate(input)
 
 		assert.Error(t, err)
@@ -302,7 +302,7 @@ func TestRecipeValidator_ValidateAndPopulate(T *testing.T) {
 			},
 		}
 
-		validator := NewRecipeValidator(nil, nil, nil, nil)
+		validator := NewRecipeValidator(nil, nil, nil, nil, nil)
 		err := validator.ValidateAndPopulate(input)
 
 		assert.Error(t, err)
@@ -339,7 +339,7 @@ func TestRecipeValidator_ValidateAndPopulate(T *testing.T) {
 			},
 		}
 
-		validator := NewRecipeValidator(nil, nil, nil, vpvMap)
+		validator := NewRecipeValidator(nil, nil, nil, vpvMap, nil)
 		err := validator.ValidateAndPopulate(input)
 
 		assert.Error(t, err)
@@ -388,7 +388,7 @@ func TestRecipeValidator_ValidateAndPopulate(T *testing.T) {
 			},
 		}
 
-		validator := NewRecipeValidator(nil, nil, nil, nil)
+		validator := NewRecipeValidator(nil, nil, nil, nil, nil)
 		err := validator.ValidateAndPopulate(input)
 
 		// Should succeed because all items are recipe step products and skipped
@@ -473,7 +473,7 @@ func TestRecipeValidator_ValidateAndPopulate(T *testing.T) {
 		assert.Nil(t, input.Steps[0].Instruments[0].InstrumentID)
 		assert.Nil(t, input.Steps[0].Vessels[0].VesselID)
 
-		validator := NewRecipeValidator(vipMap, vimuMap, vpiMap, vpvMap)
+		validator := NewRecipeValidator(vipMap, vimuMap, vpiMap, vpvMap, nil)
 		err := validator.ValidateAndPopulate(input)
 		require.NoError(t, err)
 
@@ -498,7 +498,7 @@ func TestRecipeValidator_ValidateAndPopulate(T *testing.T) {
 			Steps: []*mealplanning.RecipeStepDatabaseCreationInput{},
 		}
 
-		validator := NewRecipeValidator(nil, nil, nil, nil)
+		validator := NewRecipeValidator(nil, nil, nil, nil, nil)
 		err := validator.ValidateAndPopulate(input)
 
 		assert.NoError(t, err)
@@ -538,7 +538,7 @@ func TestRecipeValidator_ValidateAndPopulate(T *testing.T) {
 			},
 		}
 
-		validator := NewRecipeValidator(nil, nil, nil, nil)
+		validator := NewRecipeValidator(nil, nil, nil, nil, nil)
 		err := validator.ValidateAndPopulate(input)
 
 		// Should succeed - no bridge IDs to validate
@@ -572,7 +572,7 @@ func TestRecipeValidator_ValidateAndPopulate(T *testing.T) {
 			},
 		}
 
-		validator := NewRecipeValidator(nil, vimuMap, nil, nil)
+		validator := NewRecipeValidator(nil, vimuMap, nil, nil, nil)
 		err := validator.ValidateAndPopulate(input)
 
 		require.NoError(t, err)
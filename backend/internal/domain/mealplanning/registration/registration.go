@@ -6,15 +6,18 @@
 	domaindataprivacy "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/dataprivacy"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
 	grocerylistpreparation "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/grocerylistpreparation"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/icalendar"
 	mealplanningmgr "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/managers"
 	mealplanningprivacy "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/privacy"
 	recipeanalysis "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/recipeanalysis"
 	mealplanningrepo "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/repositories/postgres/mealplanning"
 	mealplanningsvc "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/services/mealplanning/grpc"
+	mealplanninghttp "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/services/mealplanning/http"
 	eatingindexing "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/services/mealplanning/indexing"
 	mealplanfinalizer "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/services/mealplanning/workers/meal_plan_finalizer"
 	mealplangrocerylistinitializer "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/services/mealplanning/workers/meal_plan_grocery_list_initializer"
 	mealplantaskcreator "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/services/mealplanning/workers/meal_plan_task_creator"
+	mealplantemplateinstantiator "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/services/mealplanning/workers/meal_plan_template_instantiator"
 
 	"github.com/primandproper/platform/observability/logging"
 	"github.com/primandproper/platform/observability/tracing"
@@ -43,6 +46,7 @@ func RegisterForGRPCAPI(i do.Injector) {
 	registerRepository(i)
 	registerDataPrivacyCollector(i)
 	mealplanningmgr.RegisterManagers(i)
+	icalendar.RegisterMealPlanCalendarManager(i)
 	mealplanningsvc.RegisterMealPlanningService(i)
 	mealplanfinalizer.RegisterMealPlanFinalizer(i)
 	mealplangrocerylistinitializer.RegisterMealPlanGroceryListInitializer(i)
@@ -51,6 +55,13 @@ func RegisterForGRPCAPI(i do.Injector) {
 	grocerylistpreparation.RegisterGroceryListCreator(i)
 }
 
+// RegisterForHTTPAPI registers mealplanning components needed by the HTTP API server.
+func RegisterForHTTPAPI(i do.Injector) {
+	registerRepository(i)
+	icalendar.RegisterMealPlanCalendarManager(i)
+	mealplanninghttp.RegisterMealPlanningHTTP(i)
+}
+
 // RegisterForDataChangeHandler registers mealplanning components needed by the async message handler.
 func RegisterForDataChangeHandler(i do.Injector) {
 	registerRepository(i)
@@ -63,3 +74,15 @@ func RegisterForSearchIndexScheduler(i do.Injector) {
 	registerRepository(i)
 	eatingindexing.RegisterMealPlanningDataIndexer(i)
 }
+
+// RegisterForMealPlanTemplateInstantiator registers mealplanning components needed by the meal plan template
+// instantiator, which creates meal plans through the manager so they get the same checks as user-created ones.
+func RegisterForMealPlanTemplateInstantiator(i do.Injector) {
+	registerRepository(i)
+	mealplanningmgr.RegisterManagers(i)
+	mealplangrocerylistinitializer.RegisterMealPlanGroceryListInitializer(i)
+	mealplantaskcreator.RegisterMealPlanTaskCreator(i)
+	recipeanalysis.RegisterRecipeAnalyzer(i)
+	grocerylistpreparation.RegisterGroceryListCreator(i)
+	mealplantemplateinstantiator.RegisterMealPlanTemplateInstantiator(i)
+}
@@ -1,13 +1,18 @@
 package mealplanning
 
 type Repository interface {
+	AccountAllergenProfileDataManager
 	MealDataManager
+	MealPlanActivityDataManager
+	MealPlanCalendarFeedDataManager
 	MealPlanDataManager
 	MealPlanEventDataManager
+	MealPlanEventTallyReportDataManager
 	MealPlanGroceryListItemDataManager
 	MealPlanOptionDataManager
 	MealPlanOptionVoteDataManager
 	MealPlanTaskDataManager
+	MealPlanTemplateDataManager
 	MealPlanningDataManager
 	RecipeListDataManager
 	RecipeListItemDataManager
@@ -18,6 +23,7 @@ type Repository interface {
 	RecipeMediaDataManager
 	RecipePrepTaskDataManager
 	RecipeRatingDataManager
+	RecipeRevisionDataManager
 	RecipeStepDataManager
 	RecipeStepCompletionConditionDataManager
 	ValidIngredientPreparationDataManager
@@ -29,6 +35,7 @@ type Repository interface {
 	ValidEnumerationDataManager
 	UserIngredientPreferenceDataManager
 	ValidIngredientMeasurementUnitDataManager
+	ValidIngredientNutritionFactsDataManager
 	ValidIngredientGroupDataManager
 	ValidIngredientStateDataManager
 	ValidIngredientStateIngredientDataManager
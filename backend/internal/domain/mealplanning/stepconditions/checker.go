@@ -0,0 +1,136 @@
+package stepconditions
+
+import (
+	"fmt"
+	"math"
+)
+
+type valueType int
+
+const (
+	typeBool valueType = iota
+	typeNumber
+	typeString
+)
+
+func (t valueType) String() string {
+	switch t {
+	case typeBool:
+		return "boolean"
+	case typeNumber:
+		return "number"
+	default:
+		return "string"
+	}
+}
+
+const (
+	// IngredientPresentFunction is true when the recipe uses the ingredient with the given ID.
+	IngredientPresentFunction = "ingredient_present"
+	// OptionSelectedFunction is true when, for the step with the given index, the ingredient at the given
+	// index has the given option selected. Unselected option groups default to option 0.
+	OptionSelectedFunction = "option_selected"
+	// ProductProducedFunction is true when an earlier step that will be performed produces a product with the given name.
+	ProductProducedFunction = "product_produced"
+
+	// ScaleVariable is the scale the recipe is being prepared at, where 1 is the recipe as written.
+	ScaleVariable = "scale"
+)
+
+var functionParameters = map[string][]valueType{
+	IngredientPresentFunction: {typeString},
+	OptionSelectedFunction:    {typeNumber, typeNumber, typeNumber},
+	ProductProducedFunction:   {typeString},
+}
+
+var variableTypes = map[string]valueType{
+	ScaleVariable: typeNumber,
+}
+
+// check type checks a syntax tree and returns the type it evaluates to.
+func check(n node) (valueType, error) {
+	switch x := n.(type) {
+	case *boolLiteral:
+		return typeBool, nil
+	case *numberLiteral:
+		return typeNumber, nil
+	case *stringLiteral:
+		return typeString, nil
+	case *variableReference:
+		t, ok := variableTypes[x.name]
+		if !ok {
+			return 0, newError(x.position, fmt.Sprintf("unknown variable %q", x.name))
+		}
+		return t, nil
+	case *unaryExpression:
+		if err := expectType(x.operand, typeBool, "operand of '!'"); err != nil {
+			return 0, err
+		}
+		return typeBool, nil
+	case *binaryExpression:
+		return checkBinary(x)
+	case *functionCall:
+		return checkCall(x)
+	default:
+		return 0, newError(n.pos(), "unrecognized expression")
+	}
+}
+
+func expectType(n node, expected valueType, description string) error {
+	actual, err := check(n)
+	if err != nil {
+		return err
+	}
+
+	if actual != expected {
+		return newError(n.pos(), fmt.Sprintf("%s must be a %s, not a %s", description, expected, actual))
+	}
+
+	return nil
+}
+
+func checkBinary(x *binaryExpression) (valueType, error) {
+	operandType := typeNumber
+	if x.operator == tokenAnd || x.operator == tokenOr {
+		operandType = typeBool
+	}
+
+	description := fmt.Sprintf("operand of %s", x.operator)
+	if err := expectType(x.left, operandType, description); err != nil {
+		return 0, err
+	}
+	if err := expectType(x.right, operandType, description); err != nil {
+		return 0, err
+	}
+
+	return typeBool, nil
+}
+
+func checkCall(x *functionCall) (valueType, error) {
+	parameters, ok := functionParameters[x.name]
+	if !ok {
+		return 0, newError(x.position, fmt.Sprintf("unknown function %q", x.name))
+	}
+
+	if len(x.arguments) != len(parameters) {
+		return 0, newError(x.position, fmt.Sprintf("%s takes %d argument(s), but %d were provided", x.name, len(parameters), len(x.arguments)))
+	}
+
+	for i, argument := range x.arguments {
+		// arguments must be literals, so that the things an expression refers to can be validated when the recipe is created.
+		switch literal := argument.(type) {
+		case *stringLiteral, *numberLiteral:
+			if err := expectType(argument, parameters[i], fmt.Sprintf("argument %d of %s", i+1, x.name)); err != nil {
+				return 0, err
+			}
+
+			if number, isNumber := literal.(*numberLiteral); isNumber && (number.value < 0 || number.value != math.Trunc(number.value) || number.value > math.MaxUint16) {
+				return 0, newError(number.position, fmt.Sprintf("argument %d of %s must be a whole number between 0 and %d", i+1, x.name, math.MaxUint16))
+			}
+		default:
+			return 0, newError(argument.pos(), fmt.Sprintf("argument %d of %s must be a literal %s", i+1, x.name, parameters[i]))
+		}
+	}
+
+	return typeBool, nil
+}
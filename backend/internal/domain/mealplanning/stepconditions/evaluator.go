@@ -0,0 +1,81 @@
+package stepconditions
+
+// State is the recipe state that an expression is evaluated against.
+type State interface {
+	// Scale returns the scale the recipe is being prepared at.
+	Scale() float64
+	// IngredientPresent reports whether the recipe uses the ingredient with the given ID.
+	IngredientPresent(ingredientID string) bool
+	// OptionSelected reports whether the given option is selected for an ingredient of the step with the given index.
+	OptionSelected(stepIndex uint32, ingredientIndex, optionIndex uint16) bool
+	// ProductProduced reports whether a product with the given name has been produced so far.
+	ProductProduced(productName string) bool
+}
+
+// evaluate evaluates a type-checked syntax tree. Because the tree has already been type checked,
+// the type assertions here cannot fail.
+func evaluate(n node, state State) any {
+	switch x := n.(type) {
+	case *boolLiteral:
+		return x.value
+	case *numberLiteral:
+		return x.value
+	case *stringLiteral:
+		return x.value
+	case *variableReference:
+		// scale is the only variable.
+		return state.Scale()
+	case *unaryExpression:
+		return !evaluate(x.operand, state).(bool)
+	case *binaryExpression:
+		return evaluateBinary(x, state)
+	case *functionCall:
+		return evaluateCall(x, state)
+	default:
+		return false
+	}
+}
+
+func evaluateBinary(x *binaryExpression, state State) bool {
+	switch x.operator {
+	case tokenAnd:
+		return evaluate(x.left, state).(bool) && evaluate(x.right, state).(bool)
+	case tokenOr:
+		return evaluate(x.left, state).(bool) || evaluate(x.right, state).(bool)
+	}
+
+	left, right := evaluate(x.left, state).(float64), evaluate(x.right, state).(float64)
+	switch x.operator {
+	case tokenLessThan:
+		return left < right
+	case tokenLessThanOrEqual:
+		return left <= right
+	case tokenGreaterThan:
+		return left > right
+	case tokenGreaterThanOrEqual:
+		return left >= right
+	case tokenEqual:
+		return left == right
+	case tokenNotEqual:
+		return left != right
+	default:
+		return false
+	}
+}
+
+func evaluateCall(x *functionCall, state State) bool {
+	switch x.name {
+	case IngredientPresentFunction:
+		return state.IngredientPresent(x.arguments[0].(*stringLiteral).value)
+	case OptionSelectedFunction:
+		return state.OptionSelected(
+			uint32(x.arguments[0].(*numberLiteral).value),
+			uint16(x.arguments[1].(*numberLiteral).value),
+			uint16(x.arguments[2].(*numberLiteral).value),
+		)
+	case ProductProducedFunction:
+		return state.ProductProduced(x.arguments[0].(*stringLiteral).value)
+	default:
+		return false
+	}
+}
@@ -0,0 +1,79 @@
+package stepconditions
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type testState struct {
+	ingredients map[string]bool
+	products    map[string]bool
+	selections  map[OptionReference]bool
+	scale       float64
+}
+
+func (s *testState) Scale() float64 { return s.scale }
+
+func (s *testState) IngredientPresent(ingredientID string) bool { return s.ingredients[ingredientID] }
+
+func (s *testState) ProductProduced(productName string) bool { return s.products[productName] }
+
+func (s *testState) OptionSelected(stepIndex uint32, ingredientIndex, optionIndex uint16) bool {
+	return s.selections[OptionReference{StepIndex: stepIndex, IngredientIndex: ingredientIndex, OptionIndex: optionIndex}]
+}
+
+func TestExpression_Evaluate(T *testing.T) {
+	T.Parallel()
+
+	state := &testState{
+		scale:       2,
+		ingredients: map[string]bool{"butter": true},
+		products:    map[string]bool{"dough": true},
+		selections:  map[OptionReference]bool{{StepIndex: 1, IngredientIndex: 0, OptionIndex: 1}: true},
+	}
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		for source, expected := range map[string]bool{
+			`true`:                                  true,
+			`!true`:                                 false,
+			`scale > 1.5`:                           true,
+			`scale <= 1`:                            false,
+			`scale == 2`:                            true,
+			`scale != 2`:                            false,
+			`2 < scale`:                             false,
+			`ingredient_present("butter")`:          true,
+			`ingredient_present("lard")`:            false,
+			`option_selected(1, 0, 1)`:              true,
+			`option_selected(1, 0, 0)`:              false,
+			`product_produced("dough")`:             true,
+			`!product_produced("dough") || true`:    true,
+			`ingredient_present("lard") && true`:    false,
+			`!(scale > 1 && product_produced("x"))`: true,
+		} {
+			expr, err := Parse(source)
+			require.NoError(t, err, source)
+			assert.Equal(t, expected, expr.Evaluate(state), source)
+		}
+	})
+
+	T.Run("with nil expression", func(t *testing.T) {
+		t.Parallel()
+
+		var expr *Expression
+		assert.True(t, expr.Evaluate(state))
+	})
+
+	T.Run("short circuits", func(t *testing.T) {
+		t.Parallel()
+
+		expr, err := Parse(`false && product_produced("never checked")`)
+		require.NoError(t, err)
+
+		// a nil state would panic if the right hand side were evaluated.
+		assert.False(t, expr.Evaluate((*testState)(nil)))
+	})
+}
@@ -0,0 +1,141 @@
+package stepconditions
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdentifier
+	tokenNumber
+	tokenString
+	tokenLeftParen
+	tokenRightParen
+	tokenComma
+	tokenNot
+	tokenAnd
+	tokenOr
+	tokenLessThan
+	tokenLessThanOrEqual
+	tokenGreaterThan
+	tokenGreaterThanOrEqual
+	tokenEqual
+	tokenNotEqual
+)
+
+var tokenKindNames = map[tokenKind]string{
+	tokenEOF:                "end of expression",
+	tokenIdentifier:         "identifier",
+	tokenNumber:             "number",
+	tokenString:             "string",
+	tokenLeftParen:          "'('",
+	tokenRightParen:         "')'",
+	tokenComma:              "','",
+	tokenNot:                "'!'",
+	tokenAnd:                "'&&'",
+	tokenOr:                 "'||'",
+	tokenLessThan:           "'<'",
+	tokenLessThanOrEqual:    "'<='",
+	tokenGreaterThan:        "'>'",
+	tokenGreaterThanOrEqual: "'>='",
+	tokenEqual:              "'=='",
+	tokenNotEqual:           "'!='",
+}
+
+func (k tokenKind) String() string {
+	return tokenKindNames[k]
+}
+
+type token struct {
+	value    string
+	kind     tokenKind
+	position int
+}
+
+// twoCharacterOperators are checked before single character operators, so that "<=" isn't lexed as "<" and "=".
+var twoCharacterOperators = map[string]tokenKind{
+	"&&": tokenAnd,
+	"||": tokenOr,
+	"<=": tokenLessThanOrEqual,
+	">=": tokenGreaterThanOrEqual,
+	"==": tokenEqual,
+	"!=": tokenNotEqual,
+}
+
+var singleCharacterOperators = map[rune]tokenKind{
+	'(': tokenLeftParen,
+	')': tokenRightParen,
+	',': tokenComma,
+	'!': tokenNot,
+	'<': tokenLessThan,
+	'>': tokenGreaterThan,
+}
+
+// lex splits an expression into tokens. The final token is always tokenEOF.
+func lex(source string) ([]token, error) {
+	runes := []rune(source)
+	tokens := []token{}
+
+	for i := 0; i < len(runes); {
+		r := runes[i]
+
+		switch {
+		case unicode.IsSpace(r):
+			i++
+		case i+1 < len(runes) && twoCharacterOperators[string(runes[i:i+2])] != tokenEOF:
+			tokens = append(tokens, token{kind: twoCharacterOperators[string(runes[i:i+2])], value: string(runes[i : i+2]), position: i})
+			i += 2
+		case singleCharacterOperators[r] != tokenEOF:
+			tokens = append(tokens, token{kind: singleCharacterOperators[r], value: string(r), position: i})
+			i++
+		case r == '"':
+			value, end, err := lexString(runes, i)
+			if err != nil {
+				return nil, err
+			}
+			tokens = append(tokens, token{kind: tokenString, value: value, position: i})
+			i = end
+		case unicode.IsDigit(r) || r == '.':
+			start := i
+			for i < len(runes) && (unicode.IsDigit(runes[i]) || runes[i] == '.') {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenNumber, value: string(runes[start:i]), position: start})
+		case unicode.IsLetter(r) || r == '_':
+			start := i
+			for i < len(runes) && (unicode.IsLetter(runes[i]) || unicode.IsDigit(runes[i]) || runes[i] == '_') {
+				i++
+			}
+			tokens = append(tokens, token{kind: tokenIdentifier, value: string(runes[start:i]), position: start})
+		default:
+			return nil, newError(i, fmt.Sprintf("unexpected character %q", r))
+		}
+	}
+
+	return append(tokens, token{kind: tokenEOF, position: len(runes)}), nil
+}
+
+// lexString reads a double-quoted string starting at runes[start], and returns its unescaped value and the index after the closing quote.
+func lexString(runes []rune, start int) (value string, end int, err error) {
+	var sb strings.Builder
+	for i := start + 1; i < len(runes); i++ {
+		switch runes[i] {
+		case '\\':
+			if i+1 >= len(runes) {
+				return "", 0, newError(i, "unterminated escape sequence")
+			}
+			i++
+			sb.WriteRune(runes[i])
+		case '"':
+			return sb.String(), i + 1, nil
+		default:
+			sb.WriteRune(runes[i])
+		}
+	}
+
+	return "", 0, newError(start, "unterminated string")
+}
@@ -0,0 +1,274 @@
+package stepconditions
+
+import (
+	"fmt"
+	"strconv"
+)
+
+type (
+	// node is a node in an expression's syntax tree.
+	node interface {
+		pos() int
+	}
+
+	boolLiteral struct {
+		position int
+		value    bool
+	}
+
+	numberLiteral struct {
+		position int
+		value    float64
+	}
+
+	stringLiteral struct {
+		value    string
+		position int
+	}
+
+	variableReference struct {
+		name     string
+		position int
+	}
+
+	functionCall struct {
+		name      string
+		arguments []node
+		position  int
+	}
+
+	unaryExpression struct {
+		operand  node
+		position int
+		operator tokenKind
+	}
+
+	binaryExpression struct {
+		left     node
+		right    node
+		position int
+		operator tokenKind
+	}
+)
+
+func (n *boolLiteral) pos() int       { return n.position }
+func (n *numberLiteral) pos() int     { return n.position }
+func (n *stringLiteral) pos() int     { return n.position }
+func (n *variableReference) pos() int { return n.position }
+func (n *functionCall) pos() int      { return n.position }
+func (n *unaryExpression) pos() int   { return n.position }
+func (n *binaryExpression) pos() int  { return n.position }
+
+// parser is a recursive descent parser for the following grammar:
+//
+//	expression := or
+//	or         := and ( "||" and )*
+//	and        := unary ( "&&" unary )*
+//	unary      := "!" unary | comparison
+//	comparison := primary ( ( "<" | "<=" | ">" | ">=" | "==" | "!=" ) primary )?
+//	primary    := "(" expression ")" | "true" | "false" | number | string | identifier | call
+//	call       := identifier "(" ( expression ( "," expression )* )? ")"
+type parser struct {
+	tokens  []token
+	current int
+}
+
+func parse(source string) (node, error) {
+	tokens, err := lex(source)
+	if err != nil {
+		return nil, err
+	}
+
+	p := &parser{tokens: tokens}
+	root, err := p.parseOr()
+	if err != nil {
+		return nil, err
+	}
+
+	if next := p.peek(); next.kind != tokenEOF {
+		return nil, newError(next.position, fmt.Sprintf("unexpected %s after end of expression", describeToken(next)))
+	}
+
+	return root, nil
+}
+
+func (p *parser) peek() token {
+	return p.tokens[p.current]
+}
+
+func (p *parser) advance() token {
+	t := p.tokens[p.current]
+	if t.kind != tokenEOF {
+		p.current++
+	}
+
+	return t
+}
+
+func (p *parser) expect(kind tokenKind) (token, error) {
+	t := p.advance()
+	if t.kind != kind {
+		return t, newError(t.position, fmt.Sprintf("expected %s, found %s", kind, describeToken(t)))
+	}
+
+	return t, nil
+}
+
+func (p *parser) parseOr() (node, error) {
+	left, err := p.parseAnd()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.peek().kind == tokenOr {
+		operator := p.advance()
+		right, rightErr := p.parseAnd()
+		if rightErr != nil {
+			return nil, rightErr
+		}
+		left = &binaryExpression{operator: tokenOr, left: left, right: right, position: operator.position}
+	}
+
+	return left, nil
+}
+
+func (p *parser) parseAnd() (node, error) {
+	left, err := p.parseUnary()
+	if err != nil {
+		return nil, err
+	}
+
+	for p.peek().kind == tokenAnd {
+		operator := p.advance()
+		right, rightErr := p.parseUnary()
+		if rightErr != nil {
+			return nil, rightErr
+		}
+		left = &binaryExpression{operator: tokenAnd, left: left, right: right, position: operator.position}
+	}
+
+	return left, nil
+}
+
+func (p *parser) parseUnary() (node, error) {
+	if p.peek().kind == tokenNot {
+		operator := p.advance()
+		operand, err := p.parseUnary()
+		if err != nil {
+			return nil, err
+		}
+
+		return &unaryExpression{operator: tokenNot, operand: operand, position: operator.position}, nil
+	}
+
+	return p.parseComparison()
+}
+
+func isComparisonOperator(kind tokenKind) bool {
+	switch kind {
+	case tokenLessThan, tokenLessThanOrEqual, tokenGreaterThan, tokenGreaterThanOrEqual, tokenEqual, tokenNotEqual:
+		return true
+	default:
+		return false
+	}
+}
+
+func (p *parser) parseComparison() (node, error) {
+	left, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+
+	if !isComparisonOperator(p.peek().kind) {
+		return left, nil
+	}
+
+	operator := p.advance()
+	right, err := p.parsePrimary()
+	if err != nil {
+		return nil, err
+	}
+
+	if next := p.peek(); isComparisonOperator(next.kind) {
+		return nil, newError(next.position, "comparisons cannot be chained")
+	}
+
+	return &binaryExpression{operator: operator.kind, left: left, right: right, position: operator.position}, nil
+}
+
+func (p *parser) parsePrimary() (node, error) {
+	t := p.advance()
+
+	switch t.kind {
+	case tokenLeftParen:
+		inner, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		if _, err = p.expect(tokenRightParen); err != nil {
+			return nil, err
+		}
+		return inner, nil
+	case tokenNumber:
+		value, err := strconv.ParseFloat(t.value, 64)
+		if err != nil {
+			return nil, newError(t.position, fmt.Sprintf("invalid number %q", t.value))
+		}
+		return &numberLiteral{value: value, position: t.position}, nil
+	case tokenString:
+		return &stringLiteral{value: t.value, position: t.position}, nil
+	case tokenIdentifier:
+		switch t.value {
+		case "true":
+			return &boolLiteral{value: true, position: t.position}, nil
+		case "false":
+			return &boolLiteral{value: false, position: t.position}, nil
+		}
+
+		if p.peek().kind != tokenLeftParen {
+			return &variableReference{name: t.value, position: t.position}, nil
+		}
+		return p.parseCall(t)
+	default:
+		return nil, newError(t.position, fmt.Sprintf("unexpected %s", describeToken(t)))
+	}
+}
+
+func (p *parser) parseCall(name token) (node, error) {
+	if _, err := p.expect(tokenLeftParen); err != nil {
+		return nil, err
+	}
+
+	call := &functionCall{name: name.value, position: name.position}
+	if p.peek().kind == tokenRightParen {
+		p.advance()
+		return call, nil
+	}
+
+	for {
+		argument, err := p.parseOr()
+		if err != nil {
+			return nil, err
+		}
+		call.arguments = append(call.arguments, argument)
+
+		t := p.advance()
+		if t.kind == tokenRightParen {
+			return call, nil
+		}
+		if t.kind != tokenComma {
+			return nil, newError(t.position, fmt.Sprintf("expected ',' or ')', found %s", describeToken(t)))
+		}
+	}
+}
+
+func describeToken(t token) string {
+	switch t.kind {
+	case tokenEOF:
+		return t.kind.String()
+	case tokenIdentifier, tokenNumber, tokenString:
+		return fmt.Sprintf("%s %q", t.kind, t.value)
+	default:
+		return t.kind.String()
+	}
+}
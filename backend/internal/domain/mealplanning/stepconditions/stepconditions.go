@@ -0,0 +1,129 @@
+// Package stepconditions implements the expression language used by RecipeStep.ConditionExpression.
+//
+// A condition decides whether a step is performed, based on the state of the recipe being prepared:
+//
+//	ingredient_present("<valid ingredient ID>")
+//	option_selected(<step index>, <ingredient index>, <option index>)
+//	product_produced("<recipe step product name>")
+//	scale >= 2
+//
+// Conditions may be combined with "&&", "||", "!" and parentheses. An empty condition means the step is always performed.
+package stepconditions
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Error describes a problem with an expression, and where in the expression it was found.
+type Error struct {
+	Message  string
+	Position int
+}
+
+func newError(position int, message string) *Error {
+	return &Error{Position: position, Message: message}
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("at position %d: %s", e.Position, e.Message)
+}
+
+type (
+	// Expression is a parsed and type-checked condition expression.
+	// A nil *Expression represents the empty condition, which is always true.
+	Expression struct {
+		root   node
+		source string
+	}
+
+	// OptionReference is an option referred to by option_selected.
+	OptionReference struct {
+		StepIndex       uint32
+		IngredientIndex uint16
+		OptionIndex     uint16
+	}
+
+	// References are the things an expression refers to.
+	References struct {
+		IngredientIDs []string
+		ProductNames  []string
+		Options       []OptionReference
+	}
+)
+
+// Parse parses and type checks an expression. Blank input yields a nil Expression.
+func Parse(source string) (*Expression, error) {
+	if strings.TrimSpace(source) == "" {
+		return nil, nil
+	}
+
+	root, err := parse(source)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := check(root)
+	if err != nil {
+		return nil, err
+	}
+
+	if t != typeBool {
+		return nil, newError(root.pos(), fmt.Sprintf("condition must be a boolean, not a %s", t))
+	}
+
+	return &Expression{root: root, source: source}, nil
+}
+
+// String returns the expression's source.
+func (e *Expression) String() string {
+	if e == nil {
+		return ""
+	}
+
+	return e.source
+}
+
+// Evaluate evaluates the expression against the given state.
+func (e *Expression) Evaluate(state State) bool {
+	if e == nil {
+		return true
+	}
+
+	return evaluate(e.root, state).(bool)
+}
+
+// References returns everything the expression refers to, in the order they appear.
+func (e *Expression) References() *References {
+	refs := &References{}
+	if e == nil {
+		return refs
+	}
+
+	var walk func(n node)
+	walk = func(n node) {
+		switch x := n.(type) {
+		case *unaryExpression:
+			walk(x.operand)
+		case *binaryExpression:
+			walk(x.left)
+			walk(x.right)
+		case *functionCall:
+			switch x.name {
+			case IngredientPresentFunction:
+				refs.IngredientIDs = append(refs.IngredientIDs, x.arguments[0].(*stringLiteral).value)
+			case ProductProducedFunction:
+				refs.ProductNames = append(refs.ProductNames, x.arguments[0].(*stringLiteral).value)
+			case OptionSelectedFunction:
+				refs.Options = append(refs.Options, OptionReference{
+					StepIndex:       uint32(x.arguments[0].(*numberLiteral).value),
+					IngredientIndex: uint16(x.arguments[1].(*numberLiteral).value),
+					OptionIndex:     uint16(x.arguments[2].(*numberLiteral).value),
+				})
+			}
+		}
+	}
+	walk(e.root)
+
+	return refs
+}
@@ -0,0 +1,94 @@
+package stepconditions
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with empty expression", func(t *testing.T) {
+		t.Parallel()
+
+		expr, err := Parse("  ")
+		assert.NoError(t, err)
+		assert.Nil(t, expr)
+	})
+
+	T.Run("with valid expressions", func(t *testing.T) {
+		t.Parallel()
+
+		for _, source := range []string{
+			`true`,
+			`scale > 1.5`,
+			`ingredient_present("abc123")`,
+			`option_selected(2, 0, 1)`,
+			`!product_produced("browned butter")`,
+			`(scale >= 2 || ingredient_present("abc123")) && !option_selected(0, 1, 0)`,
+			`product_produced("a \"quoted\" name")`,
+		} {
+			expr, err := Parse(source)
+			assert.NoError(t, err, source)
+			assert.Equal(t, source, expr.String())
+		}
+	})
+
+	T.Run("with invalid expressions", func(t *testing.T) {
+		t.Parallel()
+
+		for source, expectedPosition := range map[string]int{
+			`scale >`:                           7,
+			`scale`:                             0,
+			`1 < scale < 3`:                     10,
+			`unknown("x")`:                      0,
+			`ingredient_present(1)`:             19,
+			`ingredient_present("a", "b")`:      0,
+			`option_selected(1, 2, 1.5)`:        22,
+			`option_selected(-1, 0, 0)`:         16,
+			`option_selected(scale, 0, 0)`:      16,
+			`ingredient_present("unterminated`:  19,
+			`scale > 1 &&`:                      12,
+			`scale > 1 ) `:                      10,
+			`scale # 1`:                         6,
+			`!scale`:                            1,
+			`temperature > 100`:                 0,
+			`product_produced("x") && "string"`: 25,
+		} {
+			_, err := Parse(source)
+			require.Error(t, err, source)
+
+			var exprErr *Error
+			require.ErrorAs(t, err, &exprErr, source)
+			assert.Equal(t, expectedPosition, exprErr.Position, source)
+		}
+	})
+}
+
+func TestExpression_References(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		expr, err := Parse(`ingredient_present("abc") || (option_selected(3, 1, 2) && !product_produced("dough"))`)
+		require.NoError(t, err)
+
+		expected := &References{
+			IngredientIDs: []string{"abc"},
+			ProductNames:  []string{"dough"},
+			Options:       []OptionReference{{StepIndex: 3, IngredientIndex: 1, OptionIndex: 2}},
+		}
+
+		assert.Equal(t, expected, expr.References())
+	})
+
+	T.Run("with nil expression", func(t *testing.T) {
+		t.Parallel()
+
+		var expr *Expression
+		assert.Equal(t, &References{}, expr.References())
+	})
+}
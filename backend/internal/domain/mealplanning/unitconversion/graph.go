@@ -0,0 +1,95 @@
+package unitconversion
+
+import (
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+
+	"github.com/shopspring/decimal"
+)
+
+type (
+	edge struct {
+		to     string
+		factor decimal.Decimal
+	}
+
+	// Graph is an undirected graph of measurement units, where each edge carries the
+	// multiplier needed to convert a quantity from one unit into its neighbor.
+	Graph map[string][]edge
+
+	unitPair struct {
+		a, b string
+	}
+)
+
+func newUnitPair(x, y string) unitPair {
+	if x > y {
+		x, y = y, x
+	}
+
+	return unitPair{a: x, b: y}
+}
+
+// NewGraph builds the graph of conversions that apply to a given ingredient. When both a
+// universal and an ingredient-specific conversion exist between the same two units, the ingredient-specific
+// one wins (e.g. a cup of flour does not weigh what a cup of water does).
+func NewGraph(ingredientID string, conversions []*mealplanning.ValidMeasurementUnitConversion) Graph {
+	chosen := map[unitPair]*mealplanning.ValidMeasurementUnitConversion{}
+	for _, conversion := range conversions {
+		if conversion == nil || conversion.Modifier <= 0 || conversion.From.ID == conversion.To.ID {
+			continue
+		}
+
+		if conversion.OnlyForIngredient != nil && conversion.OnlyForIngredient.ID != ingredientID {
+			continue
+		}
+
+		key := newUnitPair(conversion.From.ID, conversion.To.ID)
+		if existing, ok := chosen[key]; ok && (existing.OnlyForIngredient != nil || conversion.OnlyForIngredient == nil) {
+			continue
+		}
+		chosen[key] = conversion
+	}
+
+	graph := Graph{}
+	// iterate the input rather than the map so that traversal order is deterministic.
+	for _, conversion := range conversions {
+		if conversion == nil || chosen[newUnitPair(conversion.From.ID, conversion.To.ID)] != conversion {
+			continue
+		}
+
+		modifier := decimal.NewFromFloat32(conversion.Modifier)
+		graph[conversion.From.ID] = append(graph[conversion.From.ID], edge{to: conversion.To.ID, factor: modifier})
+		graph[conversion.To.ID] = append(graph[conversion.To.ID], edge{to: conversion.From.ID, factor: decimal.NewFromInt(1).Div(modifier)})
+	}
+
+	return graph
+}
+
+// Factor returns the multiplier that converts a quantity in one unit into another, following the
+// shortest chain of conversions between them. It returns false when no such chain exists.
+func (g Graph) Factor(from, to string) (decimal.Decimal, bool) {
+	if from == to {
+		return decimal.NewFromInt(1), true
+	}
+
+	factors := map[string]decimal.Decimal{from: decimal.NewFromInt(1)}
+	queue := []string{from}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		for _, e := range g[current] {
+			if _, seen := factors[e.to]; seen {
+				continue
+			}
+
+			factors[e.to] = factors[current].Mul(e.factor)
+			if e.to == to {
+				return factors[e.to], true
+			}
+			queue = append(queue, e.to)
+		}
+	}
+
+	return decimal.Zero, false
+}
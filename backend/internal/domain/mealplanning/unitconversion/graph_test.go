@@ -0,0 +1,79 @@
+package unitconversion
+
+import (
+	"testing"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/fakes"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func buildConversion(from, to *mealplanning.ValidMeasurementUnit, modifier float32) *mealplanning.ValidMeasurementUnitConversion {
+	conversion := fakes.BuildFakeValidMeasurementUnitConversion()
+	conversion.From = *from
+	conversion.To = *to
+	conversion.Modifier = modifier
+
+	return conversion
+}
+
+func TestGraph_Factor(T *testing.T) {
+	T.Parallel()
+
+	T.Run("follows chains of conversions in either direction", func(t *testing.T) {
+		t.Parallel()
+
+		teaspoons := fakes.BuildFakeValidMeasurementUnit()
+		tablespoons := fakes.BuildFakeValidMeasurementUnit()
+		milliliters := fakes.BuildFakeValidMeasurementUnit()
+
+		graph := NewGraph(fakes.BuildFakeID(), []*mealplanning.ValidMeasurementUnitConversion{
+			buildConversion(tablespoons, teaspoons, 3),
+			buildConversion(teaspoons, milliliters, 5),
+		})
+
+		factor, ok := graph.Factor(tablespoons.ID, milliliters.ID)
+		assert.True(t, ok)
+		assert.Equal(t, "15", factor.String())
+
+		factor, ok = graph.Factor(milliliters.ID, teaspoons.ID)
+		assert.True(t, ok)
+		assert.Equal(t, "0.2", factor.String())
+	})
+
+	T.Run("ignores conversions for other ingredients", func(t *testing.T) {
+		t.Parallel()
+
+		grams := fakes.BuildFakeValidMeasurementUnit()
+		cups := fakes.BuildFakeValidMeasurementUnit()
+
+		conversion := buildConversion(cups, grams, 125)
+		conversion.OnlyForIngredient = fakes.BuildFakeValidIngredient()
+
+		graph := NewGraph(fakes.BuildFakeID(), []*mealplanning.ValidMeasurementUnitConversion{conversion})
+
+		_, ok := graph.Factor(cups.ID, grams.ID)
+		assert.False(t, ok)
+	})
+
+	T.Run("prefers ingredient-specific conversions regardless of order", func(t *testing.T) {
+		t.Parallel()
+
+		flour := fakes.BuildFakeValidIngredient()
+		grams := fakes.BuildFakeValidMeasurementUnit()
+		cups := fakes.BuildFakeValidMeasurementUnit()
+
+		specific := buildConversion(cups, grams, 125)
+		specific.OnlyForIngredient = flour
+
+		graph := NewGraph(flour.ID, []*mealplanning.ValidMeasurementUnitConversion{
+			specific,
+			buildConversion(grams, cups, 0.5),
+		})
+
+		factor, ok := graph.Factor(cups.ID, grams.ID)
+		assert.True(t, ok)
+		assert.Equal(t, "125", factor.String())
+	})
+}
@@ -0,0 +1,141 @@
+package mealplanning
+
+import (
+	"context"
+	"encoding/gob"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+const (
+	// ValidIngredientNutritionFactsCreatedServiceEventType indicates valid ingredient nutrition facts were created.
+	ValidIngredientNutritionFactsCreatedServiceEventType = "valid_ingredient_nutrition_facts_created"
+	// ValidIngredientNutritionFactsUpdatedServiceEventType indicates valid ingredient nutrition facts were updated.
+	ValidIngredientNutritionFactsUpdatedServiceEventType = "valid_ingredient_nutrition_facts_updated"
+	// ValidIngredientNutritionFactsArchivedServiceEventType indicates valid ingredient nutrition facts were archived.
+	ValidIngredientNutritionFactsArchivedServiceEventType = "valid_ingredient_nutrition_facts_archived"
+
+	// NutritionFactsBasisQuantity is how many of the basis measurement unit a set of nutrition facts describes.
+	NutritionFactsBasisQuantity = 100
+)
+
+func init() {
+	gob.Register(new(ValidIngredientNutritionFacts))
+	gob.Register(new(ValidIngredientNutritionFactsCreationRequestInput))
+	gob.Register(new(ValidIngredientNutritionFactsUpdateRequestInput))
+}
+
+type (
+	// ValidIngredientNutritionFacts represents the nutrients in 100 of a measurement unit (ordinarily grams) of a valid ingredient.
+	// Quantities in other units, including volumetric ones, are converted into the basis unit via measurement unit conversions,
+	// so ingredient-specific conversions (e.g. cups of flour to grams) double as the ingredient's density.
+	ValidIngredientNutritionFacts struct {
+		_ struct{} `json:"-"`
+
+		CreatedAt            time.Time            `json:"createdAt"`
+		LastUpdatedAt        *time.Time           `json:"lastUpdatedAt"`
+		ArchivedAt           *time.Time           `json:"archivedAt"`
+		ID                   string               `json:"id"`
+		ValidIngredientID    string               `json:"validIngredientID"`
+		Notes                string               `json:"notes"`
+		BasisMeasurementUnit ValidMeasurementUnit `json:"basisMeasurementUnit"`
+		Nutrients            Nutrients            `json:"nutrients"`
+	}
+
+	// ValidIngredientNutritionFactsCreationRequestInput represents what a user could set as input for creating valid ingredient nutrition facts.
+	ValidIngredientNutritionFactsCreationRequestInput struct {
+		_ struct{} `json:"-"`
+
+		ValidIngredientID      string    `json:"validIngredientID"`
+		BasisMeasurementUnitID string    `json:"basisMeasurementUnitID"`
+		Notes                  string    `json:"notes"`
+		Nutrients              Nutrients `json:"nutrients"`
+	}
+
+	// ValidIngredientNutritionFactsDatabaseCreationInput represents what a user could set as input for creating valid ingredient nutrition facts.
+	ValidIngredientNutritionFactsDatabaseCreationInput struct {
+		_ struct{} `json:"-"`
+
+		ID                     string    `json:"-"`
+		ValidIngredientID      string    `json:"-"`
+		BasisMeasurementUnitID string    `json:"-"`
+		Notes                  string    `json:"-"`
+		Nutrients              Nutrients `json:"-"`
+	}
+
+	// ValidIngredientNutritionFactsUpdateRequestInput represents what a user could set as input for updating valid ingredient nutrition facts.
+	// Nutrients are replaced as a whole when provided.
+	ValidIngredientNutritionFactsUpdateRequestInput struct {
+		_ struct{} `json:"-"`
+
+		BasisMeasurementUnitID *string    `json:"basisMeasurementUnitID,omitempty"`
+		Notes                  *string    `json:"notes,omitempty"`
+		Nutrients              *Nutrients `json:"nutrients,omitempty"`
+	}
+
+	// ValidIngredientNutritionFactsDataManager describes a structure capable of storing valid ingredient nutrition facts permanently.
+	ValidIngredientNutritionFactsDataManager interface {
+		ValidIngredientNutritionFactsExists(ctx context.Context, validIngredientNutritionFactsID string) (bool, error)
+		GetValidIngredientNutritionFacts(ctx context.Context, validIngredientNutritionFactsID string) (*ValidIngredientNutritionFacts, error)
+		GetValidIngredientNutritionFactsForIngredient(ctx context.Context, validIngredientID string) (*ValidIngredientNutritionFacts, error)
+		GetValidIngredientNutritionFactsForIngredients(ctx context.Context, validIngredientIDs []string) ([]*ValidIngredientNutritionFacts, error)
+		CreateValidIngredientNutritionFacts(ctx context.Context, input *ValidIngredientNutritionFactsDatabaseCreationInput) (*ValidIngredientNutritionFacts, error)
+		UpdateValidIngredientNutritionFacts(ctx context.Context, updated *ValidIngredientNutritionFacts) error
+		ArchiveValidIngredientNutritionFacts(ctx context.Context, validIngredientNutritionFactsID string) error
+	}
+)
+
+// Update merges a ValidIngredientNutritionFactsUpdateRequestInput with valid ingredient nutrition facts.
+func (x *ValidIngredientNutritionFacts) Update(input *ValidIngredientNutritionFactsUpdateRequestInput) {
+	if input.BasisMeasurementUnitID != nil && *input.BasisMeasurementUnitID != x.BasisMeasurementUnit.ID {
+		x.BasisMeasurementUnit.ID = *input.BasisMeasurementUnitID
+	}
+
+	if input.Notes != nil && *input.Notes != x.Notes {
+		x.Notes = *input.Notes
+	}
+
+	if input.Nutrients != nil && *input.Nutrients != x.Nutrients {
+		x.Nutrients = *input.Nutrients
+	}
+}
+
+var _ validation.ValidatableWithContext = (*ValidIngredientNutritionFactsCreationRequestInput)(nil)
+
+// ValidateWithContext validates a ValidIngredientNutritionFactsCreationRequestInput.
+func (x *ValidIngredientNutritionFactsCreationRequestInput) ValidateWithContext(ctx context.Context) error {
+	return validation.ValidateStructWithContext(
+		ctx,
+		x,
+		validation.Field(&x.ValidIngredientID, validation.Required),
+		validation.Field(&x.BasisMeasurementUnitID, validation.Required),
+		validation.Field(&x.Nutrients),
+	)
+}
+
+var _ validation.ValidatableWithContext = (*ValidIngredientNutritionFactsDatabaseCreationInput)(nil)
+
+// ValidateWithContext validates a ValidIngredientNutritionFactsDatabaseCreationInput.
+func (x *ValidIngredientNutritionFactsDatabaseCreationInput) ValidateWithContext(ctx context.Context) error {
+	return validation.ValidateStructWithContext(
+		ctx,
+		x,
+		validation.Field(&x.ID, validation.Required),
+		validation.Field(&x.ValidIngredientID, validation.Required),
+		validation.Field(&x.BasisMeasurementUnitID, validation.Required),
+		validation.Field(&x.Nutrients),
+	)
+}
+
+var _ validation.ValidatableWithContext = (*ValidIngredientNutritionFactsUpdateRequestInput)(nil)
+
+// ValidateWithContext validates a ValidIngredientNutritionFactsUpdateRequestInput.
+func (x *ValidIngredientNutritionFactsUpdateRequestInput) ValidateWithContext(ctx context.Context) error {
+	return validation.ValidateStructWithContext(
+		ctx,
+		x,
+		validation.Field(&x.BasisMeasurementUnitID, validation.NilOrNotEmpty),
+		validation.Field(&x.Nutrients),
+	)
+}
@@ -0,0 +1,139 @@
+package mealplanning
+
+import (
+	"testing"
+
+	fake "github.com/brianvoe/gofakeit/v7"
+	"github.com/stretchr/testify/assert"
+)
+
+func buildNutrientsForTest() Nutrients {
+	return Nutrients{
+		Calories:                364,
+		ProteinInGrams:          10.3,
+		TotalFatInGrams:         1,
+		SaturatedFatInGrams:     0.2,
+		CarbohydratesInGrams:    76.3,
+		SugarInGrams:            0.3,
+		FiberInGrams:            2.7,
+		SodiumInMilligrams:      2,
+		CholesterolInMilligrams: 0,
+	}
+}
+
+func TestValidIngredientNutritionFacts_Update(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		x := &ValidIngredientNutritionFacts{}
+		nutrients := buildNutrientsForTest()
+		input := &ValidIngredientNutritionFactsUpdateRequestInput{
+			BasisMeasurementUnitID: new(fake.UUID()),
+			Notes:                  new(t.Name()),
+			Nutrients:              &nutrients,
+		}
+
+		x.Update(input)
+
+		assert.Equal(t, *input.BasisMeasurementUnitID, x.BasisMeasurementUnit.ID)
+		assert.Equal(t, t.Name(), x.Notes)
+		assert.Equal(t, nutrients, x.Nutrients)
+	})
+}
+
+func TestValidIngredientNutritionFactsCreationRequestInput_Validate(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		x := &ValidIngredientNutritionFactsCreationRequestInput{
+			ValidIngredientID:      t.Name(),
+			BasisMeasurementUnitID: t.Name(),
+			Notes:                  t.Name(),
+			Nutrients:              buildNutrientsForTest(),
+		}
+
+		actual := x.ValidateWithContext(t.Context())
+		assert.NoError(t, actual)
+	})
+
+	T.Run("with invalid structure", func(t *testing.T) {
+		t.Parallel()
+
+		x := &ValidIngredientNutritionFactsCreationRequestInput{}
+
+		actual := x.ValidateWithContext(t.Context())
+		assert.Error(t, actual)
+	})
+
+	T.Run("with negative nutrients", func(t *testing.T) {
+		t.Parallel()
+
+		x := &ValidIngredientNutritionFactsCreationRequestInput{
+			ValidIngredientID:      t.Name(),
+			BasisMeasurementUnitID: t.Name(),
+			Nutrients:              Nutrients{Calories: -1},
+		}
+
+		actual := x.ValidateWithContext(t.Context())
+		assert.Error(t, actual)
+	})
+}
+
+func TestValidIngredientNutritionFactsDatabaseCreationInput_Validate(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		x := &ValidIngredientNutritionFactsDatabaseCreationInput{
+			ID:                     t.Name(),
+			ValidIngredientID:      t.Name(),
+			BasisMeasurementUnitID: t.Name(),
+			Nutrients:              buildNutrientsForTest(),
+		}
+
+		actual := x.ValidateWithContext(t.Context())
+		assert.NoError(t, actual)
+	})
+
+	T.Run("with invalid structure", func(t *testing.T) {
+		t.Parallel()
+
+		x := &ValidIngredientNutritionFactsDatabaseCreationInput{}
+
+		actual := x.ValidateWithContext(t.Context())
+		assert.Error(t, actual)
+	})
+}
+
+func TestValidIngredientNutritionFactsUpdateRequestInput_Validate(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		nutrients := buildNutrientsForTest()
+		x := &ValidIngredientNutritionFactsUpdateRequestInput{
+			BasisMeasurementUnitID: new(t.Name()),
+			Nutrients:              &nutrients,
+		}
+
+		actual := x.ValidateWithContext(t.Context())
+		assert.NoError(t, actual)
+	})
+
+	T.Run("with empty basis measurement unit", func(t *testing.T) {
+		t.Parallel()
+
+		x := &ValidIngredientNutritionFactsUpdateRequestInput{
+			BasisMeasurementUnitID: new(""),
+		}
+
+		actual := x.ValidateWithContext(t.Context())
+		assert.Error(t, actual)
+	})
+}
@@ -0,0 +1,34 @@
+package converters
+
+import (
+	types "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/notifications"
+)
+
+// ConvertNotificationPreferenceToNotificationPreferenceDatabaseUpsertInput builds a NotificationPreferenceDatabaseUpsertInput from a NotificationPreference.
+func ConvertNotificationPreferenceToNotificationPreferenceDatabaseUpsertInput(x *types.NotificationPreference) *types.NotificationPreferenceDatabaseUpsertInput {
+	return &types.NotificationPreferenceDatabaseUpsertInput{
+		QuietHoursStart:  x.QuietHoursStart,
+		QuietHoursEnd:    x.QuietHoursEnd,
+		ID:               x.ID,
+		BelongsToUser:    x.BelongsToUser,
+		NotificationType: x.NotificationType,
+		DigestMode:       x.DigestMode,
+		Timezone:         x.Timezone,
+		PushEnabled:      x.PushEnabled,
+		EmailEnabled:     x.EmailEnabled,
+		InAppEnabled:     x.InAppEnabled,
+	}
+}
+
+// ConvertNotificationPreferenceToNotificationPreferenceUpdateRequestInput creates a NotificationPreferenceUpdateRequestInput from a NotificationPreference.
+func ConvertNotificationPreferenceToNotificationPreferenceUpdateRequestInput(x *types.NotificationPreference) *types.NotificationPreferenceUpdateRequestInput {
+	return &types.NotificationPreferenceUpdateRequestInput{
+		QuietHoursStart: x.QuietHoursStart,
+		QuietHoursEnd:   x.QuietHoursEnd,
+		DigestMode:      &x.DigestMode,
+		Timezone:        &x.Timezone,
+		PushEnabled:     &x.PushEnabled,
+		EmailEnabled:    &x.EmailEnabled,
+		InAppEnabled:    &x.InAppEnabled,
+	}
+}
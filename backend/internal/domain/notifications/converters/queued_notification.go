@@ -0,0 +1,17 @@
+package converters
+
+import (
+	types "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/notifications"
+)
+
+// ConvertQueuedNotificationToQueuedNotificationDatabaseCreationInput builds a QueuedNotificationDatabaseCreationInput from a QueuedNotification.
+func ConvertQueuedNotificationToQueuedNotificationDatabaseCreationInput(x *types.QueuedNotification) *types.QueuedNotificationDatabaseCreationInput {
+	return &types.QueuedNotificationDatabaseCreationInput{
+		DeliverAfter:     x.DeliverAfter,
+		ID:               x.ID,
+		BelongsToUser:    x.BelongsToUser,
+		NotificationType: x.NotificationType,
+		Content:          x.Content,
+		ActionURL:        x.ActionURL,
+	}
+}
@@ -18,9 +18,13 @@ func ConvertUserNotificationToUserNotificationUpdateRequestInput(x *types.UserNo
 // ConvertUserNotificationCreationRequestInputToUserNotificationDatabaseCreationInput creates a UserNotificationDatabaseCreationInput from a UserNotificationCreationRequestInput.
 func ConvertUserNotificationCreationRequestInputToUserNotificationDatabaseCreationInput(x *types.UserNotificationCreationRequestInput) *types.UserNotificationDatabaseCreationInput {
 	out := &types.UserNotificationDatabaseCreationInput{
-		ID:            identifiers.New(),
-		Content:       x.Content,
-		BelongsToUser: x.BelongsToUser,
+		ID:               identifiers.New(),
+		Content:          x.Content,
+		NotificationType: x.NotificationType,
+		TargetType:       x.TargetType,
+		TargetID:         x.TargetID,
+		DeepLink:         x.DeepLink,
+		BelongsToUser:    x.BelongsToUser,
 	}
 
 	return out
@@ -29,17 +33,25 @@ func ConvertUserNotificationCreationRequestInputToUserNotificationDatabaseCreati
 // ConvertUserNotificationToUserNotificationCreationRequestInput builds a UserNotification from a UserNotificationCreationRequestInput.
 func ConvertUserNotificationToUserNotificationCreationRequestInput(x *types.UserNotification) *types.UserNotificationCreationRequestInput {
 	return &types.UserNotificationCreationRequestInput{
-		Content:       x.Content,
-		Status:        x.Status,
-		BelongsToUser: x.BelongsToUser,
+		Content:          x.Content,
+		Status:           x.Status,
+		NotificationType: x.NotificationType,
+		TargetType:       x.TargetType,
+		TargetID:         x.TargetID,
+		DeepLink:         x.DeepLink,
+		BelongsToUser:    x.BelongsToUser,
 	}
 }
 
 // ConvertUserNotificationToUserNotificationDatabaseCreationInput builds a UserNotificationDatabaseCreationInput from a UserNotification.
 func ConvertUserNotificationToUserNotificationDatabaseCreationInput(x *types.UserNotification) *types.UserNotificationDatabaseCreationInput {
 	return &types.UserNotificationDatabaseCreationInput{
-		ID:            x.ID,
-		Content:       x.Content,
-		BelongsToUser: x.BelongsToUser,
+		ID:               x.ID,
+		Content:          x.Content,
+		NotificationType: x.NotificationType,
+		TargetType:       x.TargetType,
+		TargetID:         x.TargetID,
+		DeepLink:         x.DeepLink,
+		BelongsToUser:    x.BelongsToUser,
 	}
 }
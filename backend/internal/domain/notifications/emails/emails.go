@@ -0,0 +1,92 @@
+package email
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/branding"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/notifications"
+
+	"github.com/primandproper/platform/email"
+
+	"github.com/matcornic/hermes/v2"
+)
+
+const (
+	digestTimestampLayout = "Mon Jan 2, 3:04 PM"
+)
+
+var (
+	ErrUnverifiedEmailRecipient = errors.New("missing email address verification for user")
+	ErrEmptyDigest              = errors.New("no queued notifications to include in digest")
+)
+
+// BuildNotificationDigestEmail builds an email collecting a user's queued notifications into one message.
+func BuildNotificationDigestEmail(recipient *identity.User, queued []*notifications.QueuedNotification, baseURL string) (*email.OutboundEmailMessage, error) {
+	if recipient.EmailAddressVerifiedAt == nil {
+		return nil, ErrUnverifiedEmailRecipient
+	}
+
+	if len(queued) == 0 {
+		return nil, ErrEmptyDigest
+	}
+
+	rows := [][]hermes.Entry{}
+	for _, x := range queued {
+		rows = append(rows, []hermes.Entry{
+			{Key: "What happened", Value: x.Content},
+			{Key: "When", Value: x.CreatedAt.Format(digestTimestampLayout)},
+		})
+	}
+
+	// a digest of one thing can link straight to it; otherwise send folks to the app to catch up.
+	link := baseURL
+	if len(queued) == 1 && queued[0].ActionURL != "" {
+		link = queued[0].ActionURL
+	}
+
+	e := hermes.Email{
+		Body: hermes.Body{
+			Name: recipient.FirstName,
+			Intros: []string{
+				"Here's what happened while you were away.",
+			},
+			Table: hermes.Table{
+				Data: rows,
+			},
+			Actions: []hermes.Action{
+				{
+					Instructions: "You can catch up on everything by clicking the button below",
+					Button: hermes.Button{
+						Text: "Catch up",
+						Link: link,
+					},
+				},
+			},
+			Outros: []string{
+				"You're getting this digest because of your notification preferences, which you can change at any time.",
+			},
+		},
+	}
+
+	htmlContent, err := branding.BuildHermes(baseURL).GenerateHTML(e)
+	if err != nil {
+		return nil, fmt.Errorf("error rendering email template: %w", err)
+	}
+
+	subject := "You have 1 new notification"
+	if len(queued) > 1 {
+		subject = fmt.Sprintf("You have %d new notifications", len(queued))
+	}
+
+	msg := &email.OutboundEmailMessage{
+		ToAddress:   recipient.EmailAddress,
+		FromAddress: branding.FromEmail,
+		FromName:    branding.CompanyName,
+		Subject:     subject,
+		HTMLContent: htmlContent,
+	}
+
+	return msg, nil
+}
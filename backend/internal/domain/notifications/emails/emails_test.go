@@ -0,0 +1,52 @@
+package email
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/branding"
+	identityfakes "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity/fakes"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/notifications/fakes"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBuildNotificationDigestEmail(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		user := identityfakes.BuildFakeUser()
+		user.EmailAddressVerifiedAt = new(time.Now())
+		queued := fakes.BuildFakeQueuedNotificationsList()
+
+		actual, err := BuildNotificationDigestEmail(user, queued, "https://example.com")
+		assert.NoError(t, err)
+		assert.NotNil(t, actual)
+		assert.Contains(t, actual.HTMLContent, branding.LogoURL)
+		assert.Contains(t, actual.HTMLContent, queued[0].Content)
+	})
+
+	T.Run("with unverified recipient", func(t *testing.T) {
+		t.Parallel()
+
+		user := identityfakes.BuildFakeUser()
+		user.EmailAddressVerifiedAt = nil
+
+		actual, err := BuildNotificationDigestEmail(user, fakes.BuildFakeQueuedNotificationsList(), "https://example.com")
+		assert.ErrorIs(t, err, ErrUnverifiedEmailRecipient)
+		assert.Nil(t, actual)
+	})
+
+	T.Run("without queued notifications", func(t *testing.T) {
+		t.Parallel()
+
+		user := identityfakes.BuildFakeUser()
+		user.EmailAddressVerifiedAt = new(time.Now())
+
+		actual, err := BuildNotificationDigestEmail(user, nil, "https://example.com")
+		assert.ErrorIs(t, err, ErrEmptyDigest)
+		assert.Nil(t, actual)
+	})
+}
@@ -0,0 +1,52 @@
+package fakes
+
+import (
+	types "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/notifications"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/notifications/converters"
+
+	fake "github.com/brianvoe/gofakeit/v7"
+)
+
+// BuildFakeNotificationPreference builds a faked notification preference.
+func BuildFakeNotificationPreference() *types.NotificationPreference {
+	return &types.NotificationPreference{
+		CreatedAt:        BuildFakeTime(),
+		QuietHoursStart:  new("22:00"),
+		QuietHoursEnd:    new("07:00"),
+		ID:               BuildFakeID(),
+		BelongsToUser:    BuildFakeID(),
+		NotificationType: fake.RandomString(types.NotificationTypes),
+		DigestMode:       types.NotificationDigestModeNone,
+		Timezone:         "America/Chicago",
+		PushEnabled:      fake.Bool(),
+		EmailEnabled:     fake.Bool(),
+		InAppEnabled:     fake.Bool(),
+	}
+}
+
+// BuildFakeNotificationPreferencesList builds a faked list of notification preferences, one per notification type.
+func BuildFakeNotificationPreferencesList() []*types.NotificationPreference {
+	userID := BuildFakeID()
+
+	var preferences []*types.NotificationPreference
+	for _, notificationType := range types.NotificationTypes {
+		preference := BuildFakeNotificationPreference()
+		preference.BelongsToUser = userID
+		preference.NotificationType = notificationType
+		preferences = append(preferences, preference)
+	}
+
+	return preferences
+}
+
+// BuildFakeNotificationPreferenceUpdateRequestInput builds a faked NotificationPreferenceUpdateRequestInput.
+func BuildFakeNotificationPreferenceUpdateRequestInput() *types.NotificationPreferenceUpdateRequestInput {
+	preference := BuildFakeNotificationPreference()
+	return converters.ConvertNotificationPreferenceToNotificationPreferenceUpdateRequestInput(preference)
+}
+
+// BuildFakeNotificationPreferenceDatabaseUpsertInput builds a faked NotificationPreferenceDatabaseUpsertInput.
+func BuildFakeNotificationPreferenceDatabaseUpsertInput() *types.NotificationPreferenceDatabaseUpsertInput {
+	preference := BuildFakeNotificationPreference()
+	return converters.ConvertNotificationPreferenceToNotificationPreferenceDatabaseUpsertInput(preference)
+}
@@ -0,0 +1,45 @@
+package fakes
+
+import (
+	"time"
+
+	types "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/notifications"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/notifications/converters"
+
+	fake "github.com/brianvoe/gofakeit/v7"
+)
+
+// BuildFakeQueuedNotification builds a faked queued notification.
+func BuildFakeQueuedNotification() *types.QueuedNotification {
+	createdAt := BuildFakeTime()
+
+	return &types.QueuedNotification{
+		CreatedAt:        createdAt,
+		DeliverAfter:     createdAt.Add(time.Hour),
+		ID:               BuildFakeID(),
+		BelongsToUser:    BuildFakeID(),
+		NotificationType: types.NotificationTypeMealPlanCreated,
+		Content:          buildUniqueString(),
+		ActionURL:        fake.URL(),
+	}
+}
+
+// BuildFakeQueuedNotificationsList builds a faked list of queued notifications for a single user.
+func BuildFakeQueuedNotificationsList() []*types.QueuedNotification {
+	userID := BuildFakeID()
+
+	var queued []*types.QueuedNotification
+	for range exampleQuantity {
+		x := BuildFakeQueuedNotification()
+		x.BelongsToUser = userID
+		queued = append(queued, x)
+	}
+
+	return queued
+}
+
+// BuildFakeQueuedNotificationDatabaseCreationInput builds a faked QueuedNotificationDatabaseCreationInput.
+func BuildFakeQueuedNotificationDatabaseCreationInput() *types.QueuedNotificationDatabaseCreationInput {
+	queued := BuildFakeQueuedNotification()
+	return converters.ConvertQueuedNotificationToQueuedNotificationDatabaseCreationInput(queued)
+}
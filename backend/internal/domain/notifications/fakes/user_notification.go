@@ -1,20 +1,30 @@
 package fakes
 
 import (
+	"fmt"
+
 	types "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/notifications"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/notifications/converters"
 
 	"github.com/primandproper/platform/database/filtering"
+
+	fake "github.com/brianvoe/gofakeit/v7"
 )
 
 // BuildFakeUserNotification builds a faked valid ingredient.
 func BuildFakeUserNotification() *types.UserNotification {
+	targetID := BuildFakeID()
+
 	return &types.UserNotification{
-		CreatedAt:     BuildFakeTime(),
-		ID:            BuildFakeID(),
-		Content:       buildUniqueString(),
-		Status:        types.UserNotificationStatusTypeUnread,
-		BelongsToUser: BuildFakeID(),
+		CreatedAt:        BuildFakeTime(),
+		ID:               BuildFakeID(),
+		Content:          buildUniqueString(),
+		Status:           types.UserNotificationStatusTypeUnread,
+		NotificationType: fake.RandomString(types.NotificationTypes),
+		TargetType:       types.UserNotificationTargetTypeMealPlans,
+		TargetID:         targetID,
+		DeepLink:         fmt.Sprintf("https://%s/meal_plans/%s", fake.DomainName(), targetID),
+		BelongsToUser:    BuildFakeID(),
 	}
 }
 
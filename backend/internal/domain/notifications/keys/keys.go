@@ -7,4 +7,12 @@
 	UserNotificationIDKey = "user_notification" + idSuffix
 	// UserDeviceTokenIDKey is the standard key for referring to a user device token ID.
 	UserDeviceTokenIDKey = "user_device_token" + idSuffix
+	// NotificationPreferenceIDKey is the standard key for referring to a notification preference ID.
+	NotificationPreferenceIDKey = "notification_preference" + idSuffix
+	// QueuedNotificationIDKey is the standard key for referring to a queued notification ID.
+	QueuedNotificationIDKey = "queued_notification" + idSuffix
+	// NotificationTypeKey is the standard key for referring to a notification type.
+	NotificationTypeKey = "notification.type"
+	// UserNotificationsMarkedAsReadCountKey is the standard key for referring to how many user notifications were marked as read.
+	UserNotificationsMarkedAsReadCountKey = "user_notifications.marked_as_read_count"
 )
@@ -3,6 +3,7 @@
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/audit"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/notifications"
@@ -128,6 +129,33 @@ func (m *notificationsManager) UpdateUserNotification(ctx context.Context, updat
 	return nil
 }
 
+func (m *notificationsManager) MarkAllUserNotificationsAsRead(ctx context.Context, userID string) (int64, error) {
+	ctx, span := m.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := m.logger.WithSpan(span)
+
+	marked, err := m.repo.MarkAllUserNotificationsAsRead(ctx, userID)
+	if err != nil {
+		return 0, err
+	}
+
+	if marked > 0 {
+		m.dataChangesPublisher.PublishAsync(ctx, audit.BuildDataChangeMessageFromContext(ctx, logger, notifications.UserNotificationsMarkedAsReadServiceEventType, map[string]any{
+			notificationkeys.UserNotificationsMarkedAsReadCountKey: marked,
+		}))
+	}
+
+	return marked, nil
+}
+
+func (m *notificationsManager) GetUnreadUserNotificationCount(ctx context.Context, userID string) (uint64, error) {
+	ctx, span := m.tracer.StartSpan(ctx)
+	defer span.End()
+
+	return m.repo.GetUnreadUserNotificationCount(ctx, userID)
+}
+
 func (m *notificationsManager) UserDeviceTokenExists(ctx context.Context, userID, tokenID string) (bool, error) {
 	ctx, span := m.tracer.StartSpan(ctx)
 	defer span.End()
@@ -216,3 +244,82 @@ func (m *notificationsManager) ArchiveUserDeviceToken(ctx context.Context, userI
 
 	return nil
 }
+
+func (m *notificationsManager) GetNotificationPreference(ctx context.Context, userID, notificationType string) (*notifications.NotificationPreference, error) {
+	ctx, span := m.tracer.StartSpan(ctx)
+	defer span.End()
+
+	return m.repo.GetNotificationPreference(ctx, userID, notificationType)
+}
+
+func (m *notificationsManager) GetNotificationPreferencesForUser(ctx context.Context, userID string) ([]*notifications.NotificationPreference, error) {
+	ctx, span := m.tracer.StartSpan(ctx)
+	defer span.End()
+
+	return m.repo.GetNotificationPreferencesForUser(ctx, userID)
+}
+
+func (m *notificationsManager) UpsertNotificationPreference(ctx context.Context, input *notifications.NotificationPreferenceDatabaseUpsertInput) (*notifications.NotificationPreference, error) {
+	ctx, span := m.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if input == nil {
+		return nil, platformerrors.ErrNilInputParameter
+	}
+	logger := m.logger.WithSpan(span).WithValue(notificationkeys.NotificationPreferenceIDKey, input.ID)
+	tracing.AttachToSpan(span, notificationkeys.NotificationPreferenceIDKey, input.ID)
+
+	if err := input.ValidateWithContext(ctx); err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "validating notification preference upsert input")
+	}
+
+	saved, err := m.repo.UpsertNotificationPreference(ctx, input)
+	if err != nil {
+		return nil, err
+	}
+
+	m.dataChangesPublisher.PublishAsync(ctx, audit.BuildDataChangeMessageFromContext(ctx, logger, notifications.NotificationPreferenceUpdatedServiceEventType, map[string]any{
+		notificationkeys.NotificationPreferenceIDKey: saved.ID,
+		notificationkeys.NotificationTypeKey:         saved.NotificationType,
+	}))
+
+	return saved, nil
+}
+
+func (m *notificationsManager) CreateQueuedNotification(ctx context.Context, input *notifications.QueuedNotificationDatabaseCreationInput) (*notifications.QueuedNotification, error) {
+	ctx, span := m.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if input == nil {
+		return nil, platformerrors.ErrNilInputParameter
+	}
+	logger := m.logger.WithSpan(span).WithValue(notificationkeys.QueuedNotificationIDKey, input.ID)
+	tracing.AttachToSpan(span, notificationkeys.QueuedNotificationIDKey, input.ID)
+
+	if err := input.ValidateWithContext(ctx); err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "validating queued notification creation input")
+	}
+
+	return m.repo.CreateQueuedNotification(ctx, input)
+}
+
+func (m *notificationsManager) GetUserIDsWithDueQueuedNotifications(ctx context.Context, now time.Time) ([]string, error) {
+	ctx, span := m.tracer.StartSpan(ctx)
+	defer span.End()
+
+	return m.repo.GetUserIDsWithDueQueuedNotifications(ctx, now)
+}
+
+func (m *notificationsManager) GetDueQueuedNotificationsForUser(ctx context.Context, userID string, now time.Time) ([]*notifications.QueuedNotification, error) {
+	ctx, span := m.tracer.StartSpan(ctx)
+	defer span.End()
+
+	return m.repo.GetDueQueuedNotificationsForUser(ctx, userID, now)
+}
+
+func (m *notificationsManager) MarkQueuedNotificationsAsDelivered(ctx context.Context, userID string, queuedNotificationIDs []string) error {
+	ctx, span := m.tracer.StartSpan(ctx)
+	defer span.End()
+
+	return m.repo.MarkQueuedNotificationsAsDelivered(ctx, userID, queuedNotificationIDs)
+}
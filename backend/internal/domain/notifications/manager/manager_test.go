@@ -130,6 +130,62 @@ func(repo *notificationsmock.Repository) {
 	})
 }
 
+func TestNotificationsManager_MarkAllUserNotificationsAsRead(t *testing.T) {
+	t.Parallel()
+
+	t.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		nm := buildNotificationsManagerForTest(t)
+
+		userID := fakes.BuildFakeID()
+
+		expectations := setupExpectationsForNotificationsManager(
+			nm,
+			func(repo *notificationsmock.Repository) {
+				repo.On(reflection.GetMethodName(repo.MarkAllUserNotificationsAsRead), testutils.ContextMatcher, userID).Return(int64(3), nil)
+			},
+			map[string][]string{
+				notifications.UserNotificationsMarkedAsReadServiceEventType: {notificationkeys.UserNotificationsMarkedAsReadCountKey},
+			},
+		)
+
+		marked, err := nm.MarkAllUserNotificationsAsRead(ctx, userID)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(3), marked)
+
+		mock.AssertExpectationsForObjects(t, expectations...)
+	})
+
+	t.Run("does not publish when nothing was unread", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		nm := buildNotificationsManagerForTest(t)
+
+		userID := fakes.BuildFakeID()
+
+		expectations := setupExpectationsForNotificationsManager(
+			nm,
+			func(repo *notificationsmock.Repository) {
+				repo.On(reflection.GetMethodName(repo.MarkAllUserNotificationsAsRead), testutils.ContextMatcher, userID).Return(int64(0), nil)
+			},
+		)
+		nm.dataChangesPublisher = &mockpublishers.PublisherMock{
+			PublishAsyncFunc: func(_ context.Context, _ any) {
+				t.Error("unexpected data change published")
+			},
+		}
+
+		marked, err := nm.MarkAllUserNotificationsAsRead(ctx, userID)
+		assert.NoError(t, err)
+		assert.Zero(t, marked)
+
+		mock.AssertExpectationsForObjects(t, expectations...)
+	})
+}
+
 func TestNotificationsManager_CreateUserDeviceToken(t *testing.T) {
 	t.Parallel()
 
@@ -208,3 +264,85 @@ func(repo *notificationsmock.Repository) {
 		assert.Error(t, err)
 	})
 }
+
+func TestNotificationsManager_UpsertNotificationPreference(t *testing.T) {
+	t.Parallel()
+
+	t.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		nm := buildNotificationsManagerForTest(t)
+
+		expected := fakes.BuildFakeNotificationPreference()
+		input := converters.ConvertNotificationPreferenceToNotificationPreferenceDatabaseUpsertInput(expected)
+
+		expectations := setupExpectationsForNotificationsManager(
+			nm,
+			func(repo *notificationsmock.Repository) {
+				repo.On(reflection.GetMethodName(repo.UpsertNotificationPreference), testutils.ContextMatcher, input).Return(expected, nil)
+			},
+			map[string][]string{
+				notifications.NotificationPreferenceUpdatedServiceEventType: {notificationkeys.NotificationPreferenceIDKey, notificationkeys.NotificationTypeKey},
+			},
+		)
+
+		actual, err := nm.UpsertNotificationPreference(ctx, input)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, actual)
+
+		mock.AssertExpectationsForObjects(t, expectations...)
+	})
+
+	t.Run("with invalid input", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		nm := buildNotificationsManagerForTest(t)
+
+		input := fakes.BuildFakeNotificationPreferenceDatabaseUpsertInput()
+		input.DigestMode = "hourly"
+
+		actual, err := nm.UpsertNotificationPreference(ctx, input)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
+
+func TestNotificationsManager_CreateQueuedNotification(t *testing.T) {
+	t.Parallel()
+
+	t.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		nm := buildNotificationsManagerForTest(t)
+
+		expected := fakes.BuildFakeQueuedNotification()
+		input := converters.ConvertQueuedNotificationToQueuedNotificationDatabaseCreationInput(expected)
+
+		expectations := setupExpectationsForNotificationsManager(
+			nm,
+			func(repo *notificationsmock.Repository) {
+				repo.On(reflection.GetMethodName(repo.CreateQueuedNotification), testutils.ContextMatcher, input).Return(expected, nil)
+			},
+		)
+
+		actual, err := nm.CreateQueuedNotification(ctx, input)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, actual)
+
+		mock.AssertExpectationsForObjects(t, expectations...)
+	})
+
+	t.Run("with nil input", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		nm := buildNotificationsManagerForTest(t)
+
+		actual, err := nm.CreateQueuedNotification(ctx, nil)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
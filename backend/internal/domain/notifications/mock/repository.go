@@ -2,6 +2,7 @@
 
 import (
 	"context"
+	"time"
 
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/notifications"
 
@@ -46,6 +47,18 @@ func (m *Repository) UpdateUserNotification(ctx context.Context, updated *notifi
 	return args.Error(0)
 }
 
+// MarkAllUserNotificationsAsRead is a mock function.
+func (m *Repository) MarkAllUserNotificationsAsRead(ctx context.Context, userID string) (int64, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(int64), args.Error(1)
+}
+
+// GetUnreadUserNotificationCount is a mock function.
+func (m *Repository) GetUnreadUserNotificationCount(ctx context.Context, userID string) (uint64, error) {
+	args := m.Called(ctx, userID)
+	return args.Get(0).(uint64), args.Error(1)
+}
+
 // UserDeviceTokenExists is a mock function.
 func (m *Repository) UserDeviceTokenExists(ctx context.Context, userID, tokenID string) (bool, error) {
 	args := m.Called(ctx, userID, tokenID)
@@ -90,3 +103,63 @@ func (m *Repository) ArchiveUserDeviceToken(ctx context.Context, userID, tokenID
 	args := m.Called(ctx, userID, tokenID)
 	return args.Error(0)
 }
+
+// GetNotificationPreference is a mock function.
+func (m *Repository) GetNotificationPreference(ctx context.Context, userID, notificationType string) (*notifications.NotificationPreference, error) {
+	args := m.Called(ctx, userID, notificationType)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*notifications.NotificationPreference), args.Error(1)
+}
+
+// GetNotificationPreferencesForUser is a mock function.
+func (m *Repository) GetNotificationPreferencesForUser(ctx context.Context, userID string) ([]*notifications.NotificationPreference, error) {
+	args := m.Called(ctx, userID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*notifications.NotificationPreference), args.Error(1)
+}
+
+// UpsertNotificationPreference is a mock function.
+func (m *Repository) UpsertNotificationPreference(ctx context.Context, input *notifications.NotificationPreferenceDatabaseUpsertInput) (*notifications.NotificationPreference, error) {
+	args := m.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*notifications.NotificationPreference), args.Error(1)
+}
+
+// CreateQueuedNotification is a mock function.
+func (m *Repository) CreateQueuedNotification(ctx context.Context, input *notifications.QueuedNotificationDatabaseCreationInput) (*notifications.QueuedNotification, error) {
+	args := m.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*notifications.QueuedNotification), args.Error(1)
+}
+
+// GetUserIDsWithDueQueuedNotifications is a mock function.
+func (m *Repository) GetUserIDsWithDueQueuedNotifications(ctx context.Context, now time.Time) ([]string, error) {
+	args := m.Called(ctx, now)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]string), args.Error(1)
+}
+
+// GetDueQueuedNotificationsForUser is a mock function.
+func (m *Repository) GetDueQueuedNotificationsForUser(ctx context.Context, userID string, now time.Time) ([]*notifications.QueuedNotification, error) {
+	args := m.Called(ctx, userID, now)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*notifications.QueuedNotification), args.Error(1)
+}
+
+// MarkQueuedNotificationsAsDelivered is a mock function.
+func (m *Repository) MarkQueuedNotificationsAsDelivered(ctx context.Context, userID string, queuedNotificationIDs []string) error {
+	args := m.Called(ctx, userID, queuedNotificationIDs)
+	return args.Error(0)
+}
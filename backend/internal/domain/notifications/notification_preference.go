@@ -0,0 +1,386 @@
+package notifications
+
+import (
+	"cmp"
+	"context"
+	"encoding/gob"
+	"errors"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+const (
+	// NotificationTypeMealPlanCreated is sent when a meal plan is created for one of the user's accounts.
+	NotificationTypeMealPlanCreated = "meal_plan_created"
+	// NotificationTypeVotingDeadlineApproaching is sent when a meal plan's voting deadline is close.
+	NotificationTypeVotingDeadlineApproaching = "voting_deadline_approaching"
+	// NotificationTypeMealPlanTaskDue is sent when a meal plan task is due.
+	NotificationTypeMealPlanTaskDue = "meal_plan_task_due"
+	// NotificationTypeAccountInvitationAccepted is sent when someone joins one of the user's accounts.
+	NotificationTypeAccountInvitationAccepted = "account_invitation_accepted"
+	// NotificationTypeMealPlanOptionAdded is sent when an option is added to one of the user's accounts' meal plans.
+	NotificationTypeMealPlanOptionAdded = "meal_plan_option_added"
+	// NotificationTypeMealPlanFinalized is sent when one of the user's accounts' meal plans is finalized.
+	NotificationTypeMealPlanFinalized = "meal_plan_finalized"
+	// NotificationTypeMealPlanTaskAssigned is sent when a meal plan task is assigned to the user.
+	NotificationTypeMealPlanTaskAssigned = "meal_plan_task_assigned"
+	// NotificationTypeCommentReply is sent when someone replies to one of the user's comments.
+	NotificationTypeCommentReply = "comment_reply"
+	// NotificationTypeAccountInvitationReceived is sent when the user is invited to join an account.
+	NotificationTypeAccountInvitationReceived = "account_invitation_received"
+
+	// NotificationChannelPush represents delivery via mobile push notification.
+	NotificationChannelPush = "push"
+	// NotificationChannelEmail represents delivery via email.
+	NotificationChannelEmail = "email"
+	// NotificationChannelInApp represents delivery to the user's notification inbox.
+	NotificationChannelInApp = "in_app"
+
+	// NotificationDigestModeNone means emails are sent as they happen.
+	NotificationDigestModeNone = "none"
+	// NotificationDigestModeDaily means emails are collected into one digest a day.
+	NotificationDigestModeDaily = "daily"
+	// NotificationDigestModeWeekly means emails are collected into one digest a week.
+	NotificationDigestModeWeekly = "weekly"
+
+	// DefaultNotificationPreferenceTimezone is the timezone quiet hours and digests are read in when a preference doesn't say.
+	DefaultNotificationPreferenceTimezone = "UTC"
+
+	// NotificationPreferenceUpdatedServiceEventType indicates a notification preference was updated.
+	NotificationPreferenceUpdatedServiceEventType = "notification_preference_updated"
+
+	// notificationDigestHour is the local hour of the day digests go out.
+	notificationDigestHour = 8
+	quietHoursLayout       = "15:04"
+)
+
+var (
+	// NotificationTypes is every notification type a user can set preferences for.
+	NotificationTypes = []string{
+		NotificationTypeMealPlanCreated,
+		NotificationTypeVotingDeadlineApproaching,
+		NotificationTypeMealPlanTaskDue,
+		NotificationTypeAccountInvitationAccepted,
+		NotificationTypeMealPlanOptionAdded,
+		NotificationTypeMealPlanFinalized,
+		NotificationTypeMealPlanTaskAssigned,
+		NotificationTypeCommentReply,
+		NotificationTypeAccountInvitationReceived,
+	}
+
+	errIncompleteQuietHours = errors.New("quiet hours require both a start and an end")
+)
+
+func init() {
+	gob.Register(new(NotificationPreference))
+	gob.Register(new(NotificationPreferenceUpdateRequestInput))
+	gob.Register(new(NotificationPreferenceDatabaseUpsertInput))
+}
+
+type (
+	// NotificationPreference represents how a user wants to receive a given type of notification.
+	NotificationPreference struct {
+		_ struct{} `json:"-"`
+
+		CreatedAt        time.Time  `json:"createdAt"`
+		LastUpdatedAt    *time.Time `json:"lastUpdatedAt"`
+		QuietHoursStart  *string    `json:"quietHoursStart"`
+		QuietHoursEnd    *string    `json:"quietHoursEnd"`
+		ID               string     `json:"id"`
+		BelongsToUser    string     `json:"belongsToUser"`
+		NotificationType string     `json:"notificationType"`
+		DigestMode       string     `json:"digestMode"`
+		Timezone         string     `json:"timezone"`
+		PushEnabled      bool       `json:"pushEnabled"`
+		EmailEnabled     bool       `json:"emailEnabled"`
+		InAppEnabled     bool       `json:"inAppEnabled"`
+	}
+
+	// NotificationPreferenceUpdateRequestInput represents what a user could set as input for updating a notification preference.
+	// An empty quiet hours start or end clears the user's quiet hours.
+	NotificationPreferenceUpdateRequestInput struct {
+		_ struct{} `json:"-"`
+
+		QuietHoursStart *string `json:"quietHoursStart,omitempty"`
+		QuietHoursEnd   *string `json:"quietHoursEnd,omitempty"`
+		DigestMode      *string `json:"digestMode,omitempty"`
+		Timezone        *string `json:"timezone,omitempty"`
+		PushEnabled     *bool   `json:"pushEnabled,omitempty"`
+		EmailEnabled    *bool   `json:"emailEnabled,omitempty"`
+		InAppEnabled    *bool   `json:"inAppEnabled,omitempty"`
+	}
+
+	// NotificationPreferenceDatabaseUpsertInput represents what is needed to save a notification preference.
+	NotificationPreferenceDatabaseUpsertInput struct {
+		_ struct{} `json:"-"`
+
+		QuietHoursStart  *string `json:"-"`
+		QuietHoursEnd    *string `json:"-"`
+		ID               string  `json:"-"`
+		BelongsToUser    string  `json:"-"`
+		NotificationType string  `json:"-"`
+		DigestMode       string  `json:"-"`
+		Timezone         string  `json:"-"`
+		PushEnabled      bool    `json:"-"`
+		EmailEnabled     bool    `json:"-"`
+		InAppEnabled     bool    `json:"-"`
+	}
+
+	// NotificationPreferenceDataManager describes a structure capable of storing notification preferences permanently.
+	NotificationPreferenceDataManager interface {
+		GetNotificationPreference(ctx context.Context, userID, notificationType string) (*NotificationPreference, error)
+		GetNotificationPreferencesForUser(ctx context.Context, userID string) ([]*NotificationPreference, error)
+		UpsertNotificationPreference(ctx context.Context, input *NotificationPreferenceDatabaseUpsertInput) (*NotificationPreference, error)
+	}
+)
+
+// DefaultNotificationPreference returns the preference a user has for a notification type before they've set one,
+// which delivers on every channel as it happens.
+func DefaultNotificationPreference(userID, notificationType string) *NotificationPreference {
+	return &NotificationPreference{
+		BelongsToUser:    userID,
+		NotificationType: notificationType,
+		DigestMode:       NotificationDigestModeNone,
+		Timezone:         DefaultNotificationPreferenceTimezone,
+		PushEnabled:      true,
+		EmailEnabled:     true,
+		InAppEnabled:     true,
+	}
+}
+
+// Update merges a NotificationPreferenceUpdateRequestInput with a notification preference.
+func (x *NotificationPreference) Update(input *NotificationPreferenceUpdateRequestInput) {
+	if input.PushEnabled != nil {
+		x.PushEnabled = *input.PushEnabled
+	}
+
+	if input.EmailEnabled != nil {
+		x.EmailEnabled = *input.EmailEnabled
+	}
+
+	if input.InAppEnabled != nil {
+		x.InAppEnabled = *input.InAppEnabled
+	}
+
+	if input.DigestMode != nil && *input.DigestMode != x.DigestMode {
+		x.DigestMode = *input.DigestMode
+	}
+
+	if input.Timezone != nil && *input.Timezone != x.Timezone {
+		x.Timezone = *input.Timezone
+	}
+
+	if input.QuietHoursStart != nil || input.QuietHoursEnd != nil {
+		if (input.QuietHoursStart != nil && *input.QuietHoursStart == "") || (input.QuietHoursEnd != nil && *input.QuietHoursEnd == "") {
+			x.QuietHoursStart, x.QuietHoursEnd = nil, nil
+		} else {
+			if input.QuietHoursStart != nil {
+				x.QuietHoursStart = input.QuietHoursStart
+			}
+			if input.QuietHoursEnd != nil {
+				x.QuietHoursEnd = input.QuietHoursEnd
+			}
+		}
+	}
+}
+
+// ChannelEnabled reports whether the user wants this type of notification on the given channel at all.
+func (x *NotificationPreference) ChannelEnabled(channel string) bool {
+	switch channel {
+	case NotificationChannelPush:
+		return x.PushEnabled
+	case NotificationChannelEmail:
+		return x.EmailEnabled
+	case NotificationChannelInApp:
+		return x.InAppEnabled
+	default:
+		return false
+	}
+}
+
+// location returns the preference's timezone, falling back to the default for unknown zones.
+func (x *NotificationPreference) location() *time.Location {
+	loc, err := time.LoadLocation(cmp.Or(x.Timezone, DefaultNotificationPreferenceTimezone))
+	if err != nil {
+		return time.UTC
+	}
+
+	return loc
+}
+
+// quietHours returns the start and end of the preference's quiet hours as minutes past midnight.
+func (x *NotificationPreference) quietHours() (start, end int, ok bool) {
+	if x.QuietHoursStart == nil || x.QuietHoursEnd == nil {
+		return 0, 0, false
+	}
+
+	s, err := time.Parse(quietHoursLayout, *x.QuietHoursStart)
+	if err != nil {
+		return 0, 0, false
+	}
+
+	e, err := time.Parse(quietHoursLayout, *x.QuietHoursEnd)
+	if err != nil {
+		return 0, 0, false
+	}
+
+	start, end = s.Hour()*60+s.Minute(), e.Hour()*60+e.Minute()
+
+	return start, end, start != end
+}
+
+// InQuietHours reports whether the given moment falls within the user's quiet hours, which may span midnight.
+func (x *NotificationPreference) InQuietHours(now time.Time) bool {
+	start, end, ok := x.quietHours()
+	if !ok {
+		return false
+	}
+
+	local := now.In(x.location())
+	minute := local.Hour()*60 + local.Minute()
+
+	if start < end {
+		return minute >= start && minute < end
+	}
+
+	return minute >= start || minute < end
+}
+
+// EmailHeldUntil reports whether an email sent now should be held for later, and if so until when. Emails are held
+// for the next digest when the user has one configured, and otherwise until the end of their quiet hours.
+func (x *NotificationPreference) EmailHeldUntil(now time.Time) (time.Time, bool) {
+	loc := x.location()
+	local := now.In(loc)
+
+	switch x.DigestMode {
+	case NotificationDigestModeDaily:
+		next := time.Date(local.Year(), local.Month(), local.Day(), notificationDigestHour, 0, 0, 0, loc)
+		if !next.After(now) {
+			next = time.Date(local.Year(), local.Month(), local.Day()+1, notificationDigestHour, 0, 0, 0, loc)
+		}
+		return next, true
+	case NotificationDigestModeWeekly:
+		days := (int(time.Monday) - int(local.Weekday()) + 7) % 7
+		next := time.Date(local.Year(), local.Month(), local.Day()+days, notificationDigestHour, 0, 0, 0, loc)
+		if !next.After(now) {
+			next = time.Date(local.Year(), local.Month(), local.Day()+days+7, notificationDigestHour, 0, 0, 0, loc)
+		}
+		return next, true
+	}
+
+	return x.quietHoursEndAfter(now)
+}
+
+// PushHeldUntil reports whether a push notification sent now should be held for later, and if so until when. Pushes
+// aren't digested, so they're only held until the end of the user's quiet hours.
+func (x *NotificationPreference) PushHeldUntil(now time.Time) (time.Time, bool) {
+	return x.quietHoursEndAfter(now)
+}
+
+// quietHoursEndAfter returns the end of the quiet hours the given moment falls within, if it falls within them.
+func (x *NotificationPreference) quietHoursEndAfter(now time.Time) (time.Time, bool) {
+	if !x.InQuietHours(now) {
+		return time.Time{}, false
+	}
+
+	loc := x.location()
+	local := now.In(loc)
+
+	_, end, _ := x.quietHours()
+	next := time.Date(local.Year(), local.Month(), local.Day(), end/60, end%60, 0, 0, loc)
+	if !next.After(now) {
+		next = time.Date(local.Year(), local.Month(), local.Day()+1, end/60, end%60, 0, 0, loc)
+	}
+
+	return next, true
+}
+
+// notificationTypesForValidation returns NotificationTypes in the shape validation.In expects.
+func notificationTypesForValidation() []any {
+	out := make([]any, 0, len(NotificationTypes))
+	for _, t := range NotificationTypes {
+		out = append(out, t)
+	}
+
+	return out
+}
+
+// validateQuietHours ensures quiet hours are either unset or a pair of valid times of day.
+func validateQuietHours(start, end *string) error {
+	if start == nil && end == nil {
+		return nil
+	}
+
+	if start == nil || end == nil {
+		return errIncompleteQuietHours
+	}
+
+	for _, t := range []string{*start, *end} {
+		if _, err := time.Parse(quietHoursLayout, t); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+var _ validation.ValidatableWithContext = (*NotificationPreferenceUpdateRequestInput)(nil)
+
+// ValidateWithContext validates a NotificationPreferenceUpdateRequestInput.
+func (x *NotificationPreferenceUpdateRequestInput) ValidateWithContext(ctx context.Context) error {
+	if err := validation.ValidateStructWithContext(
+		ctx,
+		x,
+		validation.Field(&x.DigestMode, validation.NilOrNotEmpty, validation.In(
+			NotificationDigestModeNone,
+			NotificationDigestModeDaily,
+			NotificationDigestModeWeekly,
+		)),
+	); err != nil {
+		return err
+	}
+
+	if x.Timezone != nil {
+		if _, err := time.LoadLocation(*x.Timezone); err != nil {
+			return err
+		}
+	}
+
+	for _, t := range []*string{x.QuietHoursStart, x.QuietHoursEnd} {
+		if t != nil && *t != "" {
+			if _, err := time.Parse(quietHoursLayout, *t); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+var _ validation.ValidatableWithContext = (*NotificationPreferenceDatabaseUpsertInput)(nil)
+
+// ValidateWithContext validates a NotificationPreferenceDatabaseUpsertInput.
+func (x *NotificationPreferenceDatabaseUpsertInput) ValidateWithContext(ctx context.Context) error {
+	if err := validation.ValidateStructWithContext(
+		ctx,
+		x,
+		validation.Field(&x.ID, validation.Required),
+		validation.Field(&x.BelongsToUser, validation.Required),
+		validation.Field(&x.NotificationType, validation.Required, validation.In(notificationTypesForValidation()...)),
+		validation.Field(&x.DigestMode, validation.Required, validation.In(
+			NotificationDigestModeNone,
+			NotificationDigestModeDaily,
+			NotificationDigestModeWeekly,
+		)),
+		validation.Field(&x.Timezone, validation.Required),
+	); err != nil {
+		return err
+	}
+
+	if _, err := time.LoadLocation(x.Timezone); err != nil {
+		return err
+	}
+
+	return validateQuietHours(x.QuietHoursStart, x.QuietHoursEnd)
+}
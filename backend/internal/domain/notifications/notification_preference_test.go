@@ -0,0 +1,219 @@
+package notifications
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotificationPreference_Update(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		x := DefaultNotificationPreference(t.Name(), NotificationTypeMealPlanCreated)
+		x.Update(&NotificationPreferenceUpdateRequestInput{
+			PushEnabled:     new(false),
+			DigestMode:      new(NotificationDigestModeDaily),
+			QuietHoursStart: new("22:00"),
+			QuietHoursEnd:   new("07:00"),
+		})
+
+		assert.False(t, x.PushEnabled)
+		assert.True(t, x.EmailEnabled)
+		assert.Equal(t, NotificationDigestModeDaily, x.DigestMode)
+		assert.Equal(t, "22:00", *x.QuietHoursStart)
+		assert.Equal(t, "07:00", *x.QuietHoursEnd)
+	})
+
+	T.Run("clearing quiet hours", func(t *testing.T) {
+		t.Parallel()
+
+		x := DefaultNotificationPreference(t.Name(), NotificationTypeMealPlanCreated)
+		x.QuietHoursStart, x.QuietHoursEnd = new("22:00"), new("07:00")
+
+		x.Update(&NotificationPreferenceUpdateRequestInput{QuietHoursStart: new("")})
+
+		assert.Nil(t, x.QuietHoursStart)
+		assert.Nil(t, x.QuietHoursEnd)
+	})
+}
+
+func TestNotificationPreference_InQuietHours(T *testing.T) {
+	T.Parallel()
+
+	T.Run("spanning midnight", func(t *testing.T) {
+		t.Parallel()
+
+		loc, err := time.LoadLocation("America/Chicago")
+		require.NoError(t, err)
+
+		x := DefaultNotificationPreference(t.Name(), NotificationTypeMealPlanCreated)
+		x.Timezone = loc.String()
+		x.QuietHoursStart, x.QuietHoursEnd = new("22:00"), new("07:00")
+
+		assert.True(t, x.InQuietHours(time.Date(2026, time.March, 4, 23, 30, 0, 0, loc)))
+		assert.True(t, x.InQuietHours(time.Date(2026, time.March, 4, 6, 59, 0, 0, loc)))
+		assert.False(t, x.InQuietHours(time.Date(2026, time.March, 4, 7, 0, 0, 0, loc)))
+		assert.False(t, x.InQuietHours(time.Date(2026, time.March, 4, 12, 0, 0, 0, loc)))
+	})
+
+	T.Run("within a single day", func(t *testing.T) {
+		t.Parallel()
+
+		x := DefaultNotificationPreference(t.Name(), NotificationTypeMealPlanCreated)
+		x.QuietHoursStart, x.QuietHoursEnd = new("12:00"), new("13:00")
+
+		assert.True(t, x.InQuietHours(time.Date(2026, time.March, 4, 12, 30, 0, 0, time.UTC)))
+		assert.False(t, x.InQuietHours(time.Date(2026, time.March, 4, 13, 30, 0, 0, time.UTC)))
+	})
+
+	T.Run("without quiet hours", func(t *testing.T) {
+		t.Parallel()
+
+		x := DefaultNotificationPreference(t.Name(), NotificationTypeMealPlanCreated)
+
+		assert.False(t, x.InQuietHours(time.Now()))
+	})
+}
+
+func TestNotificationPreference_EmailHeldUntil(T *testing.T) {
+	T.Parallel()
+
+	T.Run("delivers immediately by default", func(t *testing.T) {
+		t.Parallel()
+
+		x := DefaultNotificationPreference(t.Name(), NotificationTypeMealPlanCreated)
+
+		_, held := x.EmailHeldUntil(time.Now())
+		assert.False(t, held)
+	})
+
+	T.Run("holds until the end of quiet hours", func(t *testing.T) {
+		t.Parallel()
+
+		x := DefaultNotificationPreference(t.Name(), NotificationTypeMealPlanCreated)
+		x.QuietHoursStart, x.QuietHoursEnd = new("22:00"), new("07:00")
+
+		actual, held := x.EmailHeldUntil(time.Date(2026, time.March, 4, 23, 0, 0, 0, time.UTC))
+		assert.True(t, held)
+		assert.Equal(t, time.Date(2026, time.March, 5, 7, 0, 0, 0, time.UTC), actual)
+	})
+
+	T.Run("holds for the daily digest", func(t *testing.T) {
+		t.Parallel()
+
+		x := DefaultNotificationPreference(t.Name(), NotificationTypeMealPlanCreated)
+		x.DigestMode = NotificationDigestModeDaily
+
+		actual, held := x.EmailHeldUntil(time.Date(2026, time.March, 4, 9, 0, 0, 0, time.UTC))
+		assert.True(t, held)
+		assert.Equal(t, time.Date(2026, time.March, 5, notificationDigestHour, 0, 0, 0, time.UTC), actual)
+	})
+
+	T.Run("holds for the weekly digest", func(t *testing.T) {
+		t.Parallel()
+
+		x := DefaultNotificationPreference(t.Name(), NotificationTypeMealPlanCreated)
+		x.DigestMode = NotificationDigestModeWeekly
+
+		// March 4th, 2026 is a Wednesday.
+		actual, held := x.EmailHeldUntil(time.Date(2026, time.March, 4, 9, 0, 0, 0, time.UTC))
+		assert.True(t, held)
+		assert.Equal(t, time.Date(2026, time.March, 9, notificationDigestHour, 0, 0, 0, time.UTC), actual)
+	})
+}
+
+func TestNotificationPreference_PushHeldUntil(T *testing.T) {
+	T.Parallel()
+
+	T.Run("delivers immediately by default", func(t *testing.T) {
+		t.Parallel()
+
+		x := DefaultNotificationPreference(t.Name(), NotificationTypeMealPlanTaskDue)
+
+		_, held := x.PushHeldUntil(time.Now())
+		assert.False(t, held)
+	})
+
+	T.Run("holds until the end of quiet hours", func(t *testing.T) {
+		t.Parallel()
+
+		x := DefaultNotificationPreference(t.Name(), NotificationTypeMealPlanTaskDue)
+		x.QuietHoursStart, x.QuietHoursEnd = new("22:00"), new("07:00")
+
+		actual, held := x.PushHeldUntil(time.Date(2026, time.March, 4, 23, 0, 0, 0, time.UTC))
+		assert.True(t, held)
+		assert.Equal(t, time.Date(2026, time.March, 5, 7, 0, 0, 0, time.UTC), actual)
+	})
+
+	T.Run("ignores digests", func(t *testing.T) {
+		t.Parallel()
+
+		x := DefaultNotificationPreference(t.Name(), NotificationTypeMealPlanTaskDue)
+		x.DigestMode = NotificationDigestModeDaily
+
+		_, held := x.PushHeldUntil(time.Date(2026, time.March, 4, 9, 0, 0, 0, time.UTC))
+		assert.False(t, held)
+	})
+}
+
+func TestNotificationPreferenceUpdateRequestInput_ValidateWithContext(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		x := &NotificationPreferenceUpdateRequestInput{
+			DigestMode:      new(NotificationDigestModeWeekly),
+			Timezone:        new("America/Chicago"),
+			QuietHoursStart: new("22:00"),
+		}
+
+		assert.NoError(t, x.ValidateWithContext(t.Context()))
+	})
+
+	T.Run("with invalid values", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Error(t, (&NotificationPreferenceUpdateRequestInput{DigestMode: new("hourly")}).ValidateWithContext(t.Context()))
+		assert.Error(t, (&NotificationPreferenceUpdateRequestInput{Timezone: new("Mars/Olympus_Mons")}).ValidateWithContext(t.Context()))
+		assert.Error(t, (&NotificationPreferenceUpdateRequestInput{QuietHoursEnd: new("25:00")}).ValidateWithContext(t.Context()))
+	})
+}
+
+func TestNotificationPreferenceDatabaseUpsertInput_ValidateWithContext(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		x := &NotificationPreferenceDatabaseUpsertInput{
+			ID:               t.Name(),
+			BelongsToUser:    t.Name(),
+			NotificationType: NotificationTypeMealPlanTaskDue,
+			DigestMode:       NotificationDigestModeNone,
+			Timezone:         DefaultNotificationPreferenceTimezone,
+		}
+
+		assert.NoError(t, x.ValidateWithContext(t.Context()))
+	})
+
+	T.Run("with only one end of quiet hours", func(t *testing.T) {
+		t.Parallel()
+
+		x := &NotificationPreferenceDatabaseUpsertInput{
+			QuietHoursStart:  new("22:00"),
+			ID:               t.Name(),
+			BelongsToUser:    t.Name(),
+			NotificationType: NotificationTypeMealPlanTaskDue,
+			DigestMode:       NotificationDigestModeNone,
+			Timezone:         DefaultNotificationPreferenceTimezone,
+		}
+
+		assert.Error(t, x.ValidateWithContext(t.Context()))
+	})
+}
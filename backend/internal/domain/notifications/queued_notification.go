@@ -0,0 +1,71 @@
+package notifications
+
+import (
+	"context"
+	"encoding/gob"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+const (
+	// NotificationDigestSentServiceEventType indicates a digest of queued notifications was sent to a user.
+	NotificationDigestSentServiceEventType = "notification_digest_sent"
+)
+
+func init() {
+	gob.Register(new(QueuedNotification))
+	gob.Register(new(QueuedNotificationDatabaseCreationInput))
+}
+
+type (
+	// QueuedNotification represents an email notification held back by a user's digest or quiet hours preferences,
+	// waiting to be sent as part of a digest.
+	QueuedNotification struct {
+		_ struct{} `json:"-"`
+
+		CreatedAt        time.Time  `json:"createdAt"`
+		DeliverAfter     time.Time  `json:"deliverAfter"`
+		DeliveredAt      *time.Time `json:"deliveredAt"`
+		ID               string     `json:"id"`
+		BelongsToUser    string     `json:"belongsToUser"`
+		NotificationType string     `json:"notificationType"`
+		Content          string     `json:"content"`
+		ActionURL        string     `json:"actionURL"`
+	}
+
+	// QueuedNotificationDatabaseCreationInput represents what is needed to queue a notification.
+	QueuedNotificationDatabaseCreationInput struct {
+		_ struct{} `json:"-"`
+
+		DeliverAfter     time.Time `json:"-"`
+		ID               string    `json:"-"`
+		BelongsToUser    string    `json:"-"`
+		NotificationType string    `json:"-"`
+		Content          string    `json:"-"`
+		ActionURL        string    `json:"-"`
+	}
+
+	// QueuedNotificationDataManager describes a structure capable of storing queued notifications permanently.
+	QueuedNotificationDataManager interface {
+		CreateQueuedNotification(ctx context.Context, input *QueuedNotificationDatabaseCreationInput) (*QueuedNotification, error)
+		GetUserIDsWithDueQueuedNotifications(ctx context.Context, now time.Time) ([]string, error)
+		GetDueQueuedNotificationsForUser(ctx context.Context, userID string, now time.Time) ([]*QueuedNotification, error)
+		MarkQueuedNotificationsAsDelivered(ctx context.Context, userID string, queuedNotificationIDs []string) error
+	}
+)
+
+var _ validation.ValidatableWithContext = (*QueuedNotificationDatabaseCreationInput)(nil)
+
+// ValidateWithContext validates a QueuedNotificationDatabaseCreationInput.
+func (x *QueuedNotificationDatabaseCreationInput) ValidateWithContext(ctx context.Context) error {
+	return validation.ValidateStructWithContext(
+		ctx,
+		x,
+		validation.Field(&x.ID, validation.Required),
+		validation.Field(&x.BelongsToUser, validation.Required),
+		validation.Field(&x.NotificationType, validation.Required, validation.In(notificationTypesForValidation()...)),
+		validation.Field(&x.Content, validation.Required),
+		validation.Field(&x.DeliverAfter, validation.Required),
+	)
+}
@@ -3,4 +3,6 @@
 type Repository interface {
 	UserNotificationDataManager
 	UserDeviceTokenDataManager
+	NotificationPreferenceDataManager
+	QueuedNotificationDataManager
 }
@@ -6,6 +6,7 @@
 	"time"
 
 	"github.com/primandproper/platform/database/filtering"
+	platformerrors "github.com/primandproper/platform/errors"
 
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 )
@@ -24,6 +25,26 @@
 	UserNotificationStatusTypeRead = "read"
 	// UserNotificationStatusTypeDismissed represents the user notification status type for dismissed.
 	UserNotificationStatusTypeDismissed = "dismissed"
+
+	// UserNotificationsMarkedAsReadServiceEventType indicates a user marked all their notifications as read.
+	UserNotificationsMarkedAsReadServiceEventType = "user_notifications_marked_as_read"
+
+	// UserNotificationTargetTypeMealPlans is the target type of notifications about a meal plan.
+	UserNotificationTargetTypeMealPlans = "meal_plans"
+	// UserNotificationTargetTypeMealPlanTasks is the target type of notifications about a meal plan task.
+	UserNotificationTargetTypeMealPlanTasks = "meal_plan_tasks"
+	// UserNotificationTargetTypeAccountInvitations is the target type of notifications about an account invitation.
+	UserNotificationTargetTypeAccountInvitations = "account_invitations"
+
+	// ReadUserNotificationRetentionPeriod is how long read and dismissed notifications are kept.
+	ReadUserNotificationRetentionPeriod = 30 * 24 * time.Hour
+	// UserNotificationRetentionPeriod is how long any notification is kept, read or not.
+	UserNotificationRetentionPeriod = 180 * 24 * time.Hour
+)
+
+var (
+	// ErrDuplicateUserNotification is returned when a user has already been notified about a data change message.
+	ErrDuplicateUserNotification = platformerrors.New("user notification already exists for source message")
 )
 
 func init() {
@@ -37,30 +58,43 @@ func init() {
 	UserNotification struct {
 		_ struct{} `json:"-"`
 
-		CreatedAt     time.Time  `json:"createdAt"`
-		LastUpdatedAt *time.Time `json:"lastUpdatedAt"`
-		ID            string     `json:"id"`
-		Content       string     `json:"content"`
-		Status        string     `json:"status"`
-		BelongsToUser string     `json:"belongsToUser"`
+		CreatedAt        time.Time  `json:"createdAt"`
+		LastUpdatedAt    *time.Time `json:"lastUpdatedAt"`
+		ID               string     `json:"id"`
+		Content          string     `json:"content"`
+		Status           string     `json:"status"`
+		NotificationType string     `json:"notificationType"`
+		TargetType       string     `json:"targetType"`
+		TargetID         string     `json:"targetID"`
+		DeepLink         string     `json:"deepLink"`
+		BelongsToUser    string     `json:"belongsToUser"`
 	}
 
 	// UserNotificationCreationRequestInput represents what a user could set as input for creating user notifications.
 	UserNotificationCreationRequestInput struct {
 		_ struct{} `json:"-"`
 
-		Content       string `json:"content"`
-		Status        string `json:"status"`
-		BelongsToUser string `json:"belongsToUser"`
+		Content          string `json:"content"`
+		Status           string `json:"status"`
+		NotificationType string `json:"notificationType"`
+		TargetType       string `json:"targetType"`
+		TargetID         string `json:"targetID"`
+		DeepLink         string `json:"deepLink"`
+		BelongsToUser    string `json:"belongsToUser"`
 	}
 
 	// UserNotificationDatabaseCreationInput represents what a user could set as input for creating user notifications.
 	UserNotificationDatabaseCreationInput struct {
 		_ struct{} `json:"-"`
 
-		ID            string `json:"-"`
-		Content       string `json:"-"`
-		BelongsToUser string `json:"-"`
+		SourceMessageID  *string `json:"-"`
+		ID               string  `json:"-"`
+		Content          string  `json:"-"`
+		NotificationType string  `json:"-"`
+		TargetType       string  `json:"-"`
+		TargetID         string  `json:"-"`
+		DeepLink         string  `json:"-"`
+		BelongsToUser    string  `json:"-"`
 	}
 
 	// UserNotificationUpdateRequestInput represents what a user could set as input for updating user notifications.
@@ -77,6 +111,8 @@ func init() {
 		GetUserNotifications(ctx context.Context, userID string, filter *filtering.QueryFilter) (*filtering.QueryFilteredResult[UserNotification], error)
 		CreateUserNotification(ctx context.Context, input *UserNotificationDatabaseCreationInput) (*UserNotification, error)
 		UpdateUserNotification(ctx context.Context, updated *UserNotification) error
+		MarkAllUserNotificationsAsRead(ctx context.Context, userID string) (int64, error)
+		GetUnreadUserNotificationCount(ctx context.Context, userID string) (uint64, error)
 	}
 )
 
@@ -112,6 +148,8 @@ func (x *UserNotificationDatabaseCreationInput) ValidateWithContext(ctx context.
 		x,
 		validation.Field(&x.ID, validation.Required),
 		validation.Field(&x.Content, validation.Required),
+		validation.Field(&x.NotificationType, validation.In(notificationTypesForValidation()...)),
+		validation.Field(&x.TargetID, validation.When(x.TargetType != "", validation.Required)),
 	)
 }
 
@@ -62,6 +62,22 @@ func TestUserNotificationDatabaseCreationInput_Validate(T *testing.T) {
 		assert.NoError(t, actual)
 	})
 
+	T.Run("with target", func(t *testing.T) {
+		t.Parallel()
+
+		x := &UserNotificationDatabaseCreationInput{
+			ID:               t.Name(),
+			Content:          t.Name(),
+			NotificationType: NotificationTypeMealPlanFinalized,
+			TargetType:       UserNotificationTargetTypeMealPlans,
+			TargetID:         t.Name(),
+			DeepLink:         t.Name(),
+		}
+
+		actual := x.ValidateWithContext(t.Context())
+		assert.NoError(t, actual)
+	})
+
 	T.Run("with invalid structure", func(t *testing.T) {
 		t.Parallel()
 
@@ -70,6 +86,32 @@ func TestUserNotificationDatabaseCreationInput_Validate(T *testing.T) {
 		actual := x.ValidateWithContext(t.Context())
 		assert.Error(t, actual)
 	})
+
+	T.Run("with unknown notification type", func(t *testing.T) {
+		t.Parallel()
+
+		x := &UserNotificationDatabaseCreationInput{
+			ID:               t.Name(),
+			Content:          t.Name(),
+			NotificationType: t.Name(),
+		}
+
+		actual := x.ValidateWithContext(t.Context())
+		assert.Error(t, actual)
+	})
+
+	T.Run("with target type but no target ID", func(t *testing.T) {
+		t.Parallel()
+
+		x := &UserNotificationDatabaseCreationInput{
+			ID:         t.Name(),
+			Content:    t.Name(),
+			TargetType: UserNotificationTargetTypeMealPlans,
+		}
+
+		actual := x.ValidateWithContext(t.Context())
+		assert.Error(t, actual)
+	})
 }
 
 func TestUserNotificationUpdateRequestInput_Validate(T *testing.T) {
@@ -8,38 +8,51 @@
 
 func ConvertOAuth2ClientCreationRequestInputToOAuth2ClientDatabaseCreationInput(x *types.OAuth2ClientCreationRequestInput) *types.OAuth2ClientDatabaseCreationInput {
 	return &types.OAuth2ClientDatabaseCreationInput{
-		ID:           identifiers.New(),
-		Name:         x.Name,
-		Description:  x.Description,
-		ClientID:     "",
-		ClientSecret: "",
+		ID:                identifiers.New(),
+		Name:              x.Name,
+		Description:       x.Description,
+		ClientID:          "",
+		ClientSecret:      "",
+		AllowedScopes:     x.AllowedScopes,
+		RedirectURIs:      x.RedirectURIs,
+		AllowedGrantTypes: x.AllowedGrantTypes,
 	}
 }
 
 // ConvertOAuth2ClientToOAuth2ClientDatabaseCreationInput builds a faked OAuth2ClientDatabaseCreationInput.
 func ConvertOAuth2ClientToOAuth2ClientDatabaseCreationInput(client *types.OAuth2Client) *types.OAuth2ClientDatabaseCreationInput {
 	return &types.OAuth2ClientDatabaseCreationInput{
-		ID:           client.ID,
-		Name:         client.Name,
-		Description:  client.Description,
-		ClientID:     client.ClientID,
-		ClientSecret: client.ClientSecret,
+		ID:                client.ID,
+		Name:              client.Name,
+		Description:       client.Description,
+		ClientID:          client.ClientID,
+		ClientSecret:      client.ClientSecret,
+		BelongsToUser:     client.BelongsToUser,
+		AllowedScopes:     client.AllowedScopes,
+		RedirectURIs:      client.RedirectURIs,
+		AllowedGrantTypes: client.AllowedGrantTypes,
 	}
 }
 
 // ConvertOAuth2ClientToOAuth2ClientCreationInput builds a faked OAuth2ClientCreationRequestInput.
 func ConvertOAuth2ClientToOAuth2ClientCreationInput(client *types.OAuth2Client) *types.OAuth2ClientCreationRequestInput {
 	return &types.OAuth2ClientCreationRequestInput{
-		Name:        client.Name,
-		Description: client.Description,
+		Name:              client.Name,
+		Description:       client.Description,
+		AllowedScopes:     client.AllowedScopes,
+		RedirectURIs:      client.RedirectURIs,
+		AllowedGrantTypes: client.AllowedGrantTypes,
 	}
 }
 
 // ConvertOAuth2ClientToOAuth2ClientCreationResponse builds a faked OAuth2ClientCreationRequestInput.
 func ConvertOAuth2ClientToOAuth2ClientCreationResponse(client *types.OAuth2Client) *types.OAuth2ClientCreationResponse {
 	return &types.OAuth2ClientCreationResponse{
-		Name:        client.Name,
-		Description: client.Description,
+		Name:              client.Name,
+		Description:       client.Description,
+		AllowedScopes:     client.AllowedScopes,
+		RedirectURIs:      client.RedirectURIs,
+		AllowedGrantTypes: client.AllowedGrantTypes,
 	}
 }
 
@@ -49,6 +62,8 @@ func ConvertOAuth2ClientTokenToOAuth2ClientTokenDatabaseCreationInput(x *types.O
 		AccessCreatedAt:     x.AccessCreatedAt,
 		CodeCreatedAt:       x.CodeCreatedAt,
 		RedirectURI:         x.RedirectURI,
+		Scope:               x.Scope,
+		Nonce:               x.Nonce,
 		Code:                x.Code,
 		CodeChallenge:       x.CodeChallenge,
 		CodeChallengeMethod: x.CodeChallengeMethod,
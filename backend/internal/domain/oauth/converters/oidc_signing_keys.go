@@ -0,0 +1,16 @@
+package converters
+
+import (
+	types "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/oauth"
+)
+
+// ConvertOIDCSigningKeyToOIDCSigningKeyDatabaseCreationInput builds an OIDCSigningKeyDatabaseCreationInput from an OIDCSigningKey.
+func ConvertOIDCSigningKeyToOIDCSigningKeyDatabaseCreationInput(x *types.OIDCSigningKey) *types.OIDCSigningKeyDatabaseCreationInput {
+	return &types.OIDCSigningKeyDatabaseCreationInput{
+		RetiresAt:  x.RetiresAt,
+		ExpiresAt:  x.ExpiresAt,
+		ID:         x.ID,
+		Algorithm:  x.Algorithm,
+		PrivateKey: x.PrivateKey,
+	}
+}
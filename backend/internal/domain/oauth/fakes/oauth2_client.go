@@ -3,6 +3,7 @@
 import (
 	"time"
 
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/authorization"
 	types "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/oauth"
 
 	"github.com/primandproper/platform/database/filtering"
@@ -13,11 +14,14 @@
 // BuildFakeOAuth2Client builds a faked OAuth2Client.
 func BuildFakeOAuth2Client() *types.OAuth2Client {
 	return &types.OAuth2Client{
-		ID:           BuildFakeID(),
-		Name:         fake.Password(true, true, true, false, false, 32),
-		ClientID:     BuildFakeID(),
-		ClientSecret: buildFakePassword(),
-		CreatedAt:    BuildFakeTime(),
+		ID:                BuildFakeID(),
+		Name:              fake.Password(true, true, true, false, false, 32),
+		ClientID:          BuildFakeID(),
+		ClientSecret:      buildFakePassword(),
+		CreatedAt:         BuildFakeTime(),
+		AllowedScopes:     []string{authorization.ReadOAuth2Scope, authorization.WriteOAuth2Scope},
+		RedirectURIs:      []string{fake.URL()},
+		AllowedGrantTypes: []string{types.AuthorizationCodeGrantType, types.RefreshTokenGrantType},
 	}
 }
 
@@ -28,6 +32,8 @@ func BuildFakeOAuth2ClientToken() *types.OAuth2ClientToken {
 		AccessCreatedAt:     BuildFakeTime(),
 		CodeCreatedAt:       BuildFakeTime(),
 		RedirectURI:         fake.URL(),
+		Scope:               authorization.ReadOAuth2Scope,
+		Nonce:               buildUniqueString(),
 		Code:                buildUniqueString(),
 		CodeChallenge:       buildUniqueString(),
 		CodeChallengeMethod: "S256",
@@ -75,7 +81,10 @@ func BuildFakeOAuth2ClientCreationRequestInput() *types.OAuth2ClientCreationRequ
 	client := BuildFakeOAuth2Client()
 
 	return &types.OAuth2ClientCreationRequestInput{
-		Name:        client.Name,
-		Description: client.Description,
+		Name:              client.Name,
+		Description:       client.Description,
+		AllowedScopes:     client.AllowedScopes,
+		RedirectURIs:      client.RedirectURIs,
+		AllowedGrantTypes: client.AllowedGrantTypes,
 	}
 }
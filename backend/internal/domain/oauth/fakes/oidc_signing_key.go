@@ -0,0 +1,21 @@
+package fakes
+
+import (
+	"time"
+
+	types "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/oauth"
+)
+
+// BuildFakeOIDCSigningKey builds a faked OIDCSigningKey.
+func BuildFakeOIDCSigningKey() *types.OIDCSigningKey {
+	createdAt := BuildFakeTime()
+
+	return &types.OIDCSigningKey{
+		CreatedAt:  createdAt,
+		RetiresAt:  createdAt.Add(30 * 24 * time.Hour),
+		ExpiresAt:  createdAt.Add(31 * 24 * time.Hour),
+		ID:         BuildFakeID(),
+		Algorithm:  types.RS256OIDCSigningKeyAlgorithm,
+		PrivateKey: buildUniqueString(),
+	}
+}
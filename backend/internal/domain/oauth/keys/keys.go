@@ -19,4 +19,6 @@
 	// OAuth2ClientTokenRefreshKey is the standard key for referring to an OAuth2 client token's refresh.
 	/* #nosec G101 */
 	OAuth2ClientTokenRefreshKey = "oauth2_client_tokens.refresh"
+	// OIDCSigningKeyIDKey is the standard key for referring to an OIDC signing key's ID.
+	OIDCSigningKeyIDKey = "oidc_signing_keys" + idSuffix
 )
@@ -93,6 +93,8 @@ func (m *manager) CreateOAuth2Client(ctx context.Context, input *oauth.OAuth2Cli
 
 	dbInput := converters.ConvertOAuth2ClientCreationRequestInputToOAuth2ClientDatabaseCreationInput(input)
 	dbInput.ID = identifiers.New()
+	// client credentials tokens act on behalf of whoever registered the client.
+	dbInput.BelongsToUser = new(sessionContextData.GetUserID())
 
 	if dbInput.ClientID, err = m.secretGenerator.GenerateHexEncodedString(ctx, clientIDSize); err != nil {
 		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.Internal, "generating client id")
@@ -108,7 +108,7 @@ func TestOAuth2Manager_CreateOAuth2Client(t *testing.T) {
 			om,
 			func(repo *oauthmock.RepositoryMock) {
 				repo.On(reflection.GetMethodName(repo.CreateOAuth2Client), testutils.ContextMatcher, mock.MatchedBy(func(in *oauth.OAuth2ClientDatabaseCreationInput) bool {
-					return in.Name == input.Name && in.Description == input.Description && in.ClientID != "" && in.ClientSecret != ""
+					return in.Name == input.Name && in.Description == input.Description && in.ClientID != "" && in.ClientSecret != "" && in.BelongsToUser != nil
 				})).Return(expected, nil)
 			},
 			func(gen *randommock.GeneratorMock) {
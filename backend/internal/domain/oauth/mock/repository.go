@@ -77,6 +77,18 @@ func (m *RepositoryMock) DeleteOAuth2ClientTokenByRefresh(ctx context.Context, r
 	return m.Called(ctx, refresh).Error(0)
 }
 
+// GetUnexpiredOIDCSigningKeys is a mock function.
+func (m *RepositoryMock) GetUnexpiredOIDCSigningKeys(ctx context.Context) ([]*oauth.OIDCSigningKey, error) {
+	returnValues := m.Called(ctx)
+	return returnValues.Get(0).([]*oauth.OIDCSigningKey), returnValues.Error(1)
+}
+
+// CreateOIDCSigningKey is a mock function.
+func (m *RepositoryMock) CreateOIDCSigningKey(ctx context.Context, input *oauth.OIDCSigningKeyDatabaseCreationInput) (*oauth.OIDCSigningKey, error) {
+	returnValues := m.Called(ctx, input)
+	return returnValues.Get(0).(*oauth.OIDCSigningKey), returnValues.Error(1)
+}
+
 func NewRepositoryMock() *RepositoryMock {
 	return &RepositoryMock{}
 }
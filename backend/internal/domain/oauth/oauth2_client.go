@@ -2,11 +2,15 @@
 
 import (
 	"context"
+	"slices"
 	"time"
 
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/authorization"
+
 	"github.com/primandproper/platform/database/filtering"
 
 	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-ozzo/ozzo-validation/v4/is"
 )
 
 const (
@@ -17,6 +21,22 @@
 	OAuth2ClientCreatedServiceEventType = "oauth2_client_created"
 	// OAuth2ClientArchivedServiceEventType indicates an OAuth2 client was archived.
 	OAuth2ClientArchivedServiceEventType = "oauth2_client_archived"
+
+	// AuthorizationCodeGrantType is the OAuth2 authorization code grant.
+	AuthorizationCodeGrantType = "authorization_code"
+	// RefreshTokenGrantType is the OAuth2 refresh token grant.
+	RefreshTokenGrantType = "refresh_token"
+	// ClientCredentialsGrantType is the OAuth2 client credentials grant, for server-to-server integrations.
+	ClientCredentialsGrantType = "client_credentials"
+)
+
+var (
+	// OAuth2GrantTypes is every grant type an OAuth2 client may be allowed to use.
+	OAuth2GrantTypes = []string{
+		AuthorizationCodeGrantType,
+		RefreshTokenGrantType,
+		ClientCredentialsGrantType,
+	}
 )
 
 type (
@@ -24,43 +44,57 @@
 	OAuth2Client struct {
 		_ struct{} `json:"-"`
 
-		CreatedAt    time.Time  `json:"createdAt"`
-		ArchivedAt   *time.Time `json:"archivedAt"`
-		Name         string     `json:"name"`
-		Description  string     `json:"description"`
-		ClientID     string     `json:"clientID"`
-		ID           string     `json:"id"`
-		ClientSecret string     `json:"clientSecret"`
+		CreatedAt         time.Time  `json:"createdAt"`
+		ArchivedAt        *time.Time `json:"archivedAt"`
+		BelongsToUser     *string    `json:"belongsToUser"`
+		Name              string     `json:"name"`
+		Description       string     `json:"description"`
+		ClientID          string     `json:"clientID"`
+		ID                string     `json:"id"`
+		ClientSecret      string     `json:"clientSecret"`
+		AllowedScopes     []string   `json:"allowedScopes"`
+		RedirectURIs      []string   `json:"redirectURIs"`
+		AllowedGrantTypes []string   `json:"allowedGrantTypes"`
 	}
 
 	// OAuth2ClientCreationRequestInput is a struct for use when creating OAuth2 clients.
 	OAuth2ClientCreationRequestInput struct {
 		_ struct{} `json:"-"`
 
-		Name        string `json:"name"`
-		Description string `json:"description"`
+		Name              string   `json:"name"`
+		Description       string   `json:"description"`
+		AllowedScopes     []string `json:"allowedScopes"`
+		RedirectURIs      []string `json:"redirectURIs"`
+		AllowedGrantTypes []string `json:"allowedGrantTypes"`
 	}
 
 	// OAuth2ClientDatabaseCreationInput is a struct for use when creating OAuth2 clients.
 	OAuth2ClientDatabaseCreationInput struct {
 		_ struct{} `json:"-"`
 
-		ID           string `json:"-"`
-		Name         string `json:"-"`
-		Description  string `json:"-"`
-		ClientID     string `json:"-"`
-		ClientSecret string `json:"-"`
+		BelongsToUser     *string  `json:"-"`
+		ID                string   `json:"-"`
+		Name              string   `json:"-"`
+		Description       string   `json:"-"`
+		ClientID          string   `json:"-"`
+		ClientSecret      string   `json:"-"`
+		AllowedScopes     []string `json:"-"`
+		RedirectURIs      []string `json:"-"`
+		AllowedGrantTypes []string `json:"-"`
 	}
 
 	// OAuth2ClientCreationResponse is a struct for informing users of what their OAuth2 client's secret key is.
 	OAuth2ClientCreationResponse struct {
 		_ struct{} `json:"-"`
 
-		ClientID     string `json:"clientID"`
-		ClientSecret string `json:"clientSecret"`
-		Name         string `json:"name"`
-		Description  string `json:"description"`
-		ID           string `json:"id"`
+		ClientID          string   `json:"clientID"`
+		ClientSecret      string   `json:"clientSecret"`
+		Name              string   `json:"name"`
+		Description       string   `json:"description"`
+		ID                string   `json:"id"`
+		AllowedScopes     []string `json:"allowedScopes"`
+		RedirectURIs      []string `json:"redirectURIs"`
+		AllowedGrantTypes []string `json:"allowedGrantTypes"`
 	}
 
 	// OAuth2ClientDataManager handles OAuth2 clients.
@@ -73,9 +107,37 @@
 	}
 )
 
-// ValidateWithContext validates an APICreationInput.
+// ValidateWithContext validates an OAuth2ClientCreationRequestInput.
+// Clients that use the authorization code grant must register at least one redirect URI.
 func (x *OAuth2ClientCreationRequestInput) ValidateWithContext(ctx context.Context) error {
 	return validation.ValidateStructWithContext(ctx, x,
 		validation.Field(&x.Name, validation.Required),
+		validation.Field(&x.AllowedScopes, validation.Required, validation.Each(validation.In(toAny(authorization.OAuth2Scopes)...))),
+		validation.Field(&x.AllowedGrantTypes, validation.Required, validation.Each(validation.In(toAny(OAuth2GrantTypes)...))),
+		validation.Field(&x.RedirectURIs, validation.When(slices.Contains(x.AllowedGrantTypes, AuthorizationCodeGrantType), validation.Required), validation.Each(is.URL)),
 	)
 }
+
+// AllowsScope returns whether the client may be granted a scope.
+func (x *OAuth2Client) AllowsScope(scope string) bool {
+	return slices.Contains(x.AllowedScopes, scope)
+}
+
+// AllowsGrantType returns whether the client may use a grant type.
+func (x *OAuth2Client) AllowsGrantType(grantType string) bool {
+	return slices.Contains(x.AllowedGrantTypes, grantType)
+}
+
+// AllowsRedirectURI returns whether a redirect URI is one the client registered.
+func (x *OAuth2Client) AllowsRedirectURI(redirectURI string) bool {
+	return slices.Contains(x.RedirectURIs, redirectURI)
+}
+
+func toAny(values []string) []any {
+	out := make([]any, len(values))
+	for i, v := range values {
+		out[i] = v
+	}
+
+	return out
+}
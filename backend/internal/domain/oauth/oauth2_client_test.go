@@ -3,6 +3,8 @@
 import (
 	"testing"
 
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/authorization"
+
 	"github.com/stretchr/testify/assert"
 )
 
@@ -14,9 +16,99 @@ func TestOAuth2ClientCreationRequestInput_ValidateWithContext(T *testing.T) {
 
 		ctx := t.Context()
 		x := &OAuth2ClientCreationRequestInput{
-			Name: t.Name(),
+			Name:              t.Name(),
+			AllowedScopes:     []string{authorization.ReadOAuth2Scope},
+			AllowedGrantTypes: []string{AuthorizationCodeGrantType, RefreshTokenGrantType},
+			RedirectURIs:      []string{"https://example.com/callback"},
 		}
 
 		assert.NoError(t, x.ValidateWithContext(ctx))
 	})
+
+	T.Run("client credentials without redirect URIs", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		x := &OAuth2ClientCreationRequestInput{
+			Name:              t.Name(),
+			AllowedScopes:     []string{authorization.ReadOAuth2Scope, authorization.WriteOAuth2Scope},
+			AllowedGrantTypes: []string{ClientCredentialsGrantType},
+		}
+
+		assert.NoError(t, x.ValidateWithContext(ctx))
+	})
+
+	T.Run("authorization code without redirect URIs", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		x := &OAuth2ClientCreationRequestInput{
+			Name:              t.Name(),
+			AllowedScopes:     []string{authorization.ReadOAuth2Scope},
+			AllowedGrantTypes: []string{AuthorizationCodeGrantType},
+		}
+
+		assert.Error(t, x.ValidateWithContext(ctx))
+	})
+
+	T.Run("with unknown scope", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		x := &OAuth2ClientCreationRequestInput{
+			Name:              t.Name(),
+			AllowedScopes:     []string{"anything"},
+			AllowedGrantTypes: []string{ClientCredentialsGrantType},
+		}
+
+		assert.Error(t, x.ValidateWithContext(ctx))
+	})
+
+	T.Run("with unknown grant type", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		x := &OAuth2ClientCreationRequestInput{
+			Name:              t.Name(),
+			AllowedScopes:     []string{authorization.ReadOAuth2Scope},
+			AllowedGrantTypes: []string{"password"},
+		}
+
+		assert.Error(t, x.ValidateWithContext(ctx))
+	})
+
+	T.Run("with invalid redirect URI", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		x := &OAuth2ClientCreationRequestInput{
+			Name:              t.Name(),
+			AllowedScopes:     []string{authorization.ReadOAuth2Scope},
+			AllowedGrantTypes: []string{AuthorizationCodeGrantType},
+			RedirectURIs:      []string{"not a url"},
+		}
+
+		assert.Error(t, x.ValidateWithContext(ctx))
+	})
+}
+
+func TestOAuth2Client_Allows(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		x := &OAuth2Client{
+			AllowedScopes:     []string{authorization.ReadOAuth2Scope},
+			AllowedGrantTypes: []string{AuthorizationCodeGrantType},
+			RedirectURIs:      []string{"https://example.com/callback"},
+		}
+
+		assert.True(t, x.AllowsScope(authorization.ReadOAuth2Scope))
+		assert.False(t, x.AllowsScope(authorization.AdminOAuth2Scope))
+		assert.True(t, x.AllowsGrantType(AuthorizationCodeGrantType))
+		assert.False(t, x.AllowsGrantType(ClientCredentialsGrantType))
+		assert.True(t, x.AllowsRedirectURI("https://example.com/callback"))
+		assert.False(t, x.AllowsRedirectURI("https://example.com/callback/elsewhere"))
+	})
 }
@@ -14,6 +14,8 @@
 		AccessCreatedAt     time.Time     `json:"accessCreatedAt"`
 		CodeCreatedAt       time.Time     `json:"codeCreatedAt"`
 		RedirectURI         string        `json:"redirectURI"`
+		Scope               string        `json:"scope"`
+		Nonce               string        `json:"-"`
 		Code                string        `json:"code"`
 		CodeChallenge       string        `json:"codeChallenge"`
 		CodeChallengeMethod string        `json:"codeChallengeMethod"`
@@ -35,6 +37,8 @@
 		AccessCreatedAt     time.Time     `json:"-"`
 		CodeCreatedAt       time.Time     `json:"-"`
 		RedirectURI         string        `json:"-"`
+		Scope               string        `json:"-"`
+		Nonce               string        `json:"-"`
 		Code                string        `json:"-"`
 		CodeChallenge       string        `json:"-"`
 		CodeChallengeMethod string        `json:"-"`
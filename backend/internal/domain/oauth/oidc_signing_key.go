@@ -0,0 +1,48 @@
+package oauth
+
+import (
+	"context"
+	"time"
+)
+
+const (
+	// RS256OIDCSigningKeyAlgorithm is the JWS algorithm every OpenID Connect provider must support.
+	RS256OIDCSigningKeyAlgorithm = "RS256"
+)
+
+type (
+	// OIDCSigningKey represents a key ID tokens are signed with.
+	// Keys sign new ID tokens until they retire, and are published for verification until they expire.
+	OIDCSigningKey struct {
+		_ struct{} `json:"-"`
+
+		CreatedAt  time.Time `json:"createdAt"`
+		RetiresAt  time.Time `json:"retiresAt"`
+		ExpiresAt  time.Time `json:"expiresAt"`
+		ID         string    `json:"id"`
+		Algorithm  string    `json:"algorithm"`
+		PrivateKey string    `json:"-"`
+	}
+
+	// OIDCSigningKeyDatabaseCreationInput is used to create an OIDC signing key.
+	OIDCSigningKeyDatabaseCreationInput struct {
+		_ struct{} `json:"-"`
+
+		RetiresAt  time.Time `json:"-"`
+		ExpiresAt  time.Time `json:"-"`
+		ID         string    `json:"-"`
+		Algorithm  string    `json:"-"`
+		PrivateKey string    `json:"-"`
+	}
+
+	// OIDCSigningKeyDataManager describes a structure capable of storing OIDC signing keys.
+	OIDCSigningKeyDataManager interface {
+		GetUnexpiredOIDCSigningKeys(ctx context.Context) ([]*OIDCSigningKey, error)
+		CreateOIDCSigningKey(ctx context.Context, input *OIDCSigningKeyDatabaseCreationInput) (*OIDCSigningKey, error)
+	}
+)
+
+// IsRetired returns whether a key should no longer be used to sign new ID tokens.
+func (x *OIDCSigningKey) IsRetired(now time.Time) bool {
+	return !now.Before(x.RetiresAt)
+}
@@ -3,4 +3,5 @@
 type Repository interface {
 	OAuth2ClientDataManager
 	OAuth2ClientTokenDataManager
+	OIDCSigningKeyDataManager
 }
@@ -0,0 +1,151 @@
+package payments
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	platformerrors "github.com/primandproper/platform/errors"
+)
+
+const (
+	// FeatureAIRecipeImport grants access to AI-assisted recipe importing.
+	FeatureAIRecipeImport = "ai_recipe_import"
+
+	// UnlimitedQuantity indicates an entitlement limit that is not enforced.
+	UnlimitedQuantity int32 = -1
+)
+
+var (
+	// ErrFeatureNotEntitled is returned when an account's plan does not include a feature.
+	ErrFeatureNotEntitled = platformerrors.New("account is not entitled to this feature")
+	// ErrEntitlementLimitReached is returned when an account has used up a numeric plan limit.
+	ErrEntitlementLimitReached = platformerrors.New("account has reached its plan limit")
+)
+
+type (
+	// ProductEntitlements describes the features and limits a product grants to subscribed accounts.
+	// A zero limit grants nothing beyond what the account already has; UnlimitedQuantity removes the limit.
+	ProductEntitlements struct {
+		_                   struct{} `json:"-"`
+		Features            []string `json:"features"`
+		MaxMealPlansPerWeek int32    `json:"maxMealPlansPerWeek"`
+		MaxAccountMembers   int32    `json:"maxAccountMembers"`
+	}
+
+	// AccountEntitlements is the resolved set of features and limits an account currently has.
+	AccountEntitlements struct {
+		_                   struct{}   `json:"-"`
+		GracePeriodEndsAt   *time.Time `json:"gracePeriodEndsAt"`
+		AccountID           string     `json:"accountId"`
+		ProductIDs          []string   `json:"productIds"`
+		Features            []string   `json:"features"`
+		MaxMealPlansPerWeek int32      `json:"maxMealPlansPerWeek"`
+		MaxAccountMembers   int32      `json:"maxAccountMembers"`
+		InGracePeriod       bool       `json:"inGracePeriod"`
+	}
+
+	// EntitlementsChecker resolves what an account is currently entitled to.
+	EntitlementsChecker interface {
+		GetEntitlementsForAccount(ctx context.Context, accountID string) (*AccountEntitlements, error)
+	}
+
+	// EntitledSubscription pairs a subscription with the product it is for.
+	EntitledSubscription struct {
+		Subscription *Subscription
+		Product      *Product
+	}
+)
+
+// HasFeature reports whether the account is entitled to the named feature.
+func (x *AccountEntitlements) HasFeature(feature string) bool {
+	return slices.Contains(x.Features, feature)
+}
+
+// AllowsMealPlans reports whether an account that has created the given number of meal plans this week may create another.
+func (x *AccountEntitlements) AllowsMealPlans(createdThisWeek int64) bool {
+	return withinLimit(x.MaxMealPlansPerWeek, createdThisWeek)
+}
+
+// AllowsAccountMembers reports whether an account with the given number of members may add another.
+func (x *AccountEntitlements) AllowsAccountMembers(currentMembers int64) bool {
+	return withinLimit(x.MaxAccountMembers, currentMembers)
+}
+
+func withinLimit(limit int32, used int64) bool {
+	return limit == UnlimitedQuantity || used < int64(limit)
+}
+
+// UnrestrictedAccountEntitlements returns entitlements that grant every known feature with no limits.
+func UnrestrictedAccountEntitlements(accountID string) *AccountEntitlements {
+	return &AccountEntitlements{
+		AccountID:           accountID,
+		Features:            []string{FeatureAIRecipeImport},
+		MaxMealPlansPerWeek: UnlimitedQuantity,
+		MaxAccountMembers:   UnlimitedQuantity,
+	}
+}
+
+// ResolveAccountEntitlements merges the free tier with every subscription still granting access at the given time.
+// Active and trialing subscriptions grant access through the end of their period plus the grace period, past-due
+// subscriptions only within the grace period, cancelled subscriptions through the end of the period they paid for,
+// and incomplete subscriptions not at all.
+func ResolveAccountEntitlements(accountID string, now time.Time, gracePeriod time.Duration, freeTier *ProductEntitlements, subscriptions []*EntitledSubscription) *AccountEntitlements {
+	result := &AccountEntitlements{
+		AccountID: accountID,
+	}
+	if freeTier != nil {
+		result.merge(freeTier)
+	}
+
+	for _, es := range subscriptions {
+		if es == nil || es.Subscription == nil || es.Product == nil || es.Subscription.ArchivedAt != nil {
+			continue
+		}
+
+		sub := es.Subscription
+		var grantedUntil time.Time
+		switch sub.Status {
+		case SubscriptionStatusActive, SubscriptionStatusTrialing, SubscriptionStatusPastDue:
+			grantedUntil = sub.CurrentPeriodEnd.Add(gracePeriod)
+		case SubscriptionStatusCancelled:
+			grantedUntil = sub.CurrentPeriodEnd
+		default:
+			continue
+		}
+
+		if !now.Before(grantedUntil) {
+			continue
+		}
+
+		inGrace := sub.Status == SubscriptionStatusPastDue || now.After(sub.CurrentPeriodEnd)
+		if inGrace {
+			result.InGracePeriod = true
+			if result.GracePeriodEndsAt == nil || grantedUntil.Before(*result.GracePeriodEndsAt) {
+				result.GracePeriodEndsAt = &grantedUntil
+			}
+		}
+
+		result.ProductIDs = append(result.ProductIDs, es.Product.ID)
+		result.merge(&es.Product.Entitlements)
+	}
+
+	return result
+}
+
+func (x *AccountEntitlements) merge(grant *ProductEntitlements) {
+	for _, feature := range grant.Features {
+		if !slices.Contains(x.Features, feature) {
+			x.Features = append(x.Features, feature)
+		}
+	}
+	x.MaxMealPlansPerWeek = mergeLimit(x.MaxMealPlansPerWeek, grant.MaxMealPlansPerWeek)
+	x.MaxAccountMembers = mergeLimit(x.MaxAccountMembers, grant.MaxAccountMembers)
+}
+
+func mergeLimit(current, granted int32) int32 {
+	if current == UnlimitedQuantity || granted == UnlimitedQuantity {
+		return UnlimitedQuantity
+	}
+	return max(current, granted)
+}
@@ -0,0 +1,154 @@
+package payments
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func buildEntitledSubscriptionForTest(status string, periodEnd time.Time, grant ProductEntitlements) *EntitledSubscription {
+	return &EntitledSubscription{
+		Subscription: &Subscription{
+			ID:               "subscription",
+			ProductID:        "product",
+			Status:           status,
+			CurrentPeriodEnd: periodEnd,
+		},
+		Product: &Product{
+			ID:           "product",
+			Entitlements: grant,
+		},
+	}
+}
+
+func TestResolveAccountEntitlements(T *testing.T) {
+	T.Parallel()
+
+	now := time.Date(2026, time.March, 1, 12, 0, 0, 0, time.UTC)
+	grace := 72 * time.Hour
+	freeTier := &ProductEntitlements{
+		MaxMealPlansPerWeek: 1,
+		MaxAccountMembers:   2,
+	}
+	premium := ProductEntitlements{
+		Features:            []string{FeatureAIRecipeImport},
+		MaxMealPlansPerWeek: UnlimitedQuantity,
+		MaxAccountMembers:   6,
+	}
+
+	T.Run("free tier only", func(t *testing.T) {
+		t.Parallel()
+
+		actual := ResolveAccountEntitlements("account", now, grace, freeTier, nil)
+
+		assert.Equal(t, "account", actual.AccountID)
+		assert.Empty(t, actual.ProductIDs)
+		assert.False(t, actual.HasFeature(FeatureAIRecipeImport))
+		assert.Equal(t, int32(1), actual.MaxMealPlansPerWeek)
+		assert.Equal(t, int32(2), actual.MaxAccountMembers)
+		assert.False(t, actual.InGracePeriod)
+	})
+
+	T.Run("with active subscription", func(t *testing.T) {
+		t.Parallel()
+
+		actual := ResolveAccountEntitlements("account", now, grace, freeTier, []*EntitledSubscription{
+			buildEntitledSubscriptionForTest(SubscriptionStatusActive, now.Add(24*time.Hour), premium),
+		})
+
+		assert.Equal(t, []string{"product"}, actual.ProductIDs)
+		assert.True(t, actual.HasFeature(FeatureAIRecipeImport))
+		assert.Equal(t, UnlimitedQuantity, actual.MaxMealPlansPerWeek)
+		assert.Equal(t, int32(6), actual.MaxAccountMembers)
+		assert.False(t, actual.InGracePeriod)
+		assert.Nil(t, actual.GracePeriodEndsAt)
+	})
+
+	T.Run("with active subscription past its period but within grace", func(t *testing.T) {
+		t.Parallel()
+
+		periodEnd := now.Add(-24 * time.Hour)
+		actual := ResolveAccountEntitlements("account", now, grace, freeTier, []*EntitledSubscription{
+			buildEntitledSubscriptionForTest(SubscriptionStatusActive, periodEnd, premium),
+		})
+
+		assert.True(t, actual.HasFeature(FeatureAIRecipeImport))
+		assert.True(t, actual.InGracePeriod)
+		if assert.NotNil(t, actual.GracePeriodEndsAt) {
+			assert.Equal(t, periodEnd.Add(grace), *actual.GracePeriodEndsAt)
+		}
+	})
+
+	T.Run("with past due subscription", func(t *testing.T) {
+		t.Parallel()
+
+		actual := ResolveAccountEntitlements("account", now, grace, freeTier, []*EntitledSubscription{
+			buildEntitledSubscriptionForTest(SubscriptionStatusPastDue, now.Add(24*time.Hour), premium),
+		})
+
+		assert.True(t, actual.HasFeature(FeatureAIRecipeImport))
+		assert.True(t, actual.InGracePeriod)
+	})
+
+	T.Run("with subscription past grace", func(t *testing.T) {
+		t.Parallel()
+
+		actual := ResolveAccountEntitlements("account", now, grace, freeTier, []*EntitledSubscription{
+			buildEntitledSubscriptionForTest(SubscriptionStatusActive, now.Add(-grace-time.Hour), premium),
+		})
+
+		assert.Empty(t, actual.ProductIDs)
+		assert.False(t, actual.HasFeature(FeatureAIRecipeImport))
+		assert.Equal(t, int32(1), actual.MaxMealPlansPerWeek)
+	})
+
+	T.Run("with cancelled subscription", func(t *testing.T) {
+		t.Parallel()
+
+		stillPaid := ResolveAccountEntitlements("account", now, grace, freeTier, []*EntitledSubscription{
+			buildEntitledSubscriptionForTest(SubscriptionStatusCancelled, now.Add(time.Hour), premium),
+		})
+		assert.True(t, stillPaid.HasFeature(FeatureAIRecipeImport))
+
+		lapsed := ResolveAccountEntitlements("account", now, grace, freeTier, []*EntitledSubscription{
+			buildEntitledSubscriptionForTest(SubscriptionStatusCancelled, now.Add(-time.Hour), premium),
+		})
+		assert.False(t, lapsed.HasFeature(FeatureAIRecipeImport))
+	})
+
+	T.Run("with incomplete subscription", func(t *testing.T) {
+		t.Parallel()
+
+		actual := ResolveAccountEntitlements("account", now, grace, freeTier, []*EntitledSubscription{
+			buildEntitledSubscriptionForTest(SubscriptionStatusIncomplete, now.Add(24*time.Hour), premium),
+		})
+
+		assert.Empty(t, actual.ProductIDs)
+		assert.False(t, actual.HasFeature(FeatureAIRecipeImport))
+	})
+}
+
+func TestAccountEntitlements_Limits(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		x := &AccountEntitlements{MaxMealPlansPerWeek: 2, MaxAccountMembers: 0}
+
+		assert.True(t, x.AllowsMealPlans(1))
+		assert.False(t, x.AllowsMealPlans(2))
+		assert.False(t, x.AllowsAccountMembers(0))
+	})
+
+	T.Run("unlimited", func(t *testing.T) {
+		t.Parallel()
+
+		x := UnrestrictedAccountEntitlements("account")
+
+		assert.True(t, x.AllowsMealPlans(1000))
+		assert.True(t, x.AllowsAccountMembers(1000))
+		assert.True(t, x.HasFeature(FeatureAIRecipeImport))
+	})
+}
@@ -0,0 +1,92 @@
+package entitlements
+
+import (
+	"context"
+	"time"
+
+	identitykeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity/keys"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/payments"
+	paymentscfg "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/services/payments/config"
+
+	platformerrors "github.com/primandproper/platform/errors"
+	"github.com/primandproper/platform/observability"
+	"github.com/primandproper/platform/observability/logging"
+	"github.com/primandproper/platform/observability/tracing"
+)
+
+const (
+	o11yName = "entitlements_checker"
+)
+
+var _ payments.EntitlementsChecker = (*checker)(nil)
+
+type checker struct {
+	tracer   tracing.Tracer
+	logger   logging.Logger
+	repo     payments.Repository
+	now      func() time.Time
+	freeTier *payments.ProductEntitlements
+	cfg      paymentscfg.EntitlementsConfig
+}
+
+// NewEntitlementsChecker returns an EntitlementsChecker backed by the payments repository.
+func NewEntitlementsChecker(
+	tracerProvider tracing.TracerProvider,
+	logger logging.Logger,
+	repo payments.Repository,
+	cfg *paymentscfg.Config,
+) payments.EntitlementsChecker {
+	return &checker{
+		tracer: tracing.NewNamedTracer(tracerProvider, o11yName),
+		logger: logging.NewNamedLogger(logger, o11yName),
+		repo:   repo,
+		now:    func() time.Time { return time.Now().UTC() },
+		cfg:    cfg.Entitlements,
+		freeTier: &payments.ProductEntitlements{
+			Features:            cfg.Entitlements.FreeTierFeatures,
+			MaxMealPlansPerWeek: cfg.Entitlements.FreeTierMaxMealPlansPerWeek,
+			MaxAccountMembers:   cfg.Entitlements.FreeTierMaxAccountMembers,
+		},
+	}
+}
+
+// GetEntitlementsForAccount resolves an account's entitlements from its free tier and current subscriptions.
+func (c *checker) GetEntitlementsForAccount(ctx context.Context, accountID string) (*payments.AccountEntitlements, error) {
+	ctx, span := c.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if accountID == "" {
+		return nil, platformerrors.ErrInvalidIDProvided
+	}
+	logger := c.logger.WithSpan(span).WithValue(identitykeys.AccountIDKey, accountID)
+	tracing.AttachToSpan(span, identitykeys.AccountIDKey, accountID)
+
+	if !c.cfg.Enabled {
+		return payments.UnrestrictedAccountEntitlements(accountID), nil
+	}
+
+	now := c.now()
+	subscriptions, err := c.repo.GetSubscriptionsForAccountEndingAfter(ctx, accountID, now.Add(-c.cfg.GracePeriod))
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching current subscriptions")
+	}
+
+	products := map[string]*payments.Product{}
+	entitled := make([]*payments.EntitledSubscription, 0, len(subscriptions))
+	for _, sub := range subscriptions {
+		product, ok := products[sub.ProductID]
+		if !ok {
+			if product, err = c.repo.GetProduct(ctx, sub.ProductID); err != nil {
+				return nil, observability.PrepareAndLogError(err, logger, span, "fetching subscribed product")
+			}
+			products[sub.ProductID] = product
+		}
+
+		entitled = append(entitled, &payments.EntitledSubscription{
+			Subscription: sub,
+			Product:      product,
+		})
+	}
+
+	return payments.ResolveAccountEntitlements(accountID, now, c.cfg.GracePeriod, c.freeTier, entitled), nil
+}
@@ -0,0 +1,118 @@
+package entitlements
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/payments"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/payments/fakes"
+	paymentsmock "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/payments/mock"
+	paymentscfg "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/services/payments/config"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/testutils"
+
+	platformerrors "github.com/primandproper/platform/errors"
+	loggingnoop "github.com/primandproper/platform/observability/logging/noop"
+	tracingnoop "github.com/primandproper/platform/observability/tracing/noop"
+	"github.com/primandproper/platform/reflection"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func buildCheckerForTest(t *testing.T, cfg paymentscfg.EntitlementsConfig, repo payments.Repository, now time.Time) *checker {
+	t.Helper()
+
+	c := NewEntitlementsChecker(
+		tracingnoop.NewTracerProvider(),
+		loggingnoop.NewLogger(),
+		repo,
+		&paymentscfg.Config{Entitlements: cfg},
+	).(*checker)
+	c.now = func() time.Time { return now }
+
+	return c
+}
+
+func TestChecker_GetEntitlementsForAccount(T *testing.T) {
+	T.Parallel()
+
+	now := time.Now().UTC()
+	cfg := paymentscfg.EntitlementsConfig{
+		Enabled:                     true,
+		GracePeriod:                 48 * time.Hour,
+		FreeTierMaxMealPlansPerWeek: 1,
+		FreeTierMaxAccountMembers:   2,
+	}
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		accountID := fakes.BuildFakeID()
+		product := fakes.BuildFakeProduct()
+		subscription := fakes.BuildFakeSubscription(accountID, product.ID)
+		subscription.Status = payments.SubscriptionStatusActive
+		subscription.CurrentPeriodEnd = now.Add(24 * time.Hour)
+
+		repo := &paymentsmock.Repository{}
+		repo.On(reflection.GetMethodName(repo.GetSubscriptionsForAccountEndingAfter), testutils.ContextMatcher, accountID, now.Add(-cfg.GracePeriod)).Return([]*payments.Subscription{subscription}, nil)
+		repo.On(reflection.GetMethodName(repo.GetProduct), testutils.ContextMatcher, product.ID).Return(product, nil)
+
+		c := buildCheckerForTest(t, cfg, repo, now)
+
+		actual, err := c.GetEntitlementsForAccount(ctx, accountID)
+		require.NoError(t, err)
+		assert.Equal(t, []string{product.ID}, actual.ProductIDs)
+		assert.Equal(t, product.Entitlements.MaxAccountMembers, actual.MaxAccountMembers)
+		assert.True(t, actual.HasFeature(payments.FeatureAIRecipeImport))
+
+		mock.AssertExpectationsForObjects(t, repo)
+	})
+
+	T.Run("when disabled", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		accountID := fakes.BuildFakeID()
+		repo := &paymentsmock.Repository{}
+
+		c := buildCheckerForTest(t, paymentscfg.EntitlementsConfig{}, repo, now)
+
+		actual, err := c.GetEntitlementsForAccount(ctx, accountID)
+		require.NoError(t, err)
+		assert.Equal(t, payments.UnlimitedQuantity, actual.MaxMealPlansPerWeek)
+		assert.Equal(t, payments.UnlimitedQuantity, actual.MaxAccountMembers)
+
+		mock.AssertExpectationsForObjects(t, repo)
+	})
+
+	T.Run("with invalid account ID", func(t *testing.T) {
+		t.Parallel()
+
+		c := buildCheckerForTest(t, cfg, &paymentsmock.Repository{}, now)
+
+		actual, err := c.GetEntitlementsForAccount(t.Context(), "")
+		assert.ErrorIs(t, err, platformerrors.ErrInvalidIDProvided)
+		assert.Nil(t, actual)
+	})
+
+	T.Run("with error fetching subscriptions", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		accountID := fakes.BuildFakeID()
+
+		repo := &paymentsmock.Repository{}
+		repo.On(reflection.GetMethodName(repo.GetSubscriptionsForAccountEndingAfter), testutils.ContextMatcher, accountID, now.Add(-cfg.GracePeriod)).Return(([]*payments.Subscription)(nil), errors.New("blah"))
+
+		c := buildCheckerForTest(t, cfg, repo, now)
+
+		actual, err := c.GetEntitlementsForAccount(ctx, accountID)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+
+		mock.AssertExpectationsForObjects(t, repo)
+	})
+}
@@ -0,0 +1,23 @@
+package entitlements
+
+import (
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/payments"
+	paymentscfg "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/services/payments/config"
+
+	"github.com/primandproper/platform/observability/logging"
+	"github.com/primandproper/platform/observability/tracing"
+
+	"github.com/samber/do/v2"
+)
+
+// RegisterEntitlementsChecker registers the entitlements checker with the injector.
+func RegisterEntitlementsChecker(i do.Injector) {
+	do.Provide[payments.EntitlementsChecker](i, func(i do.Injector) (payments.EntitlementsChecker, error) {
+		return NewEntitlementsChecker(
+			do.MustInvoke[tracing.TracerProvider](i),
+			do.MustInvoke[logging.Logger](i),
+			do.MustInvoke[payments.Repository](i),
+			do.MustInvoke[*paymentscfg.Config](i),
+		), nil
+	})
+}
@@ -20,10 +20,20 @@ func BuildFakeProduct() *types.Product {
 		Currency:              "usd",
 		BillingIntervalMonths: &interval,
 		ExternalProductID:     buildUniqueString(),
+		Entitlements:          *BuildFakeProductEntitlements(),
 		CreatedAt:             BuildFakeTime(),
 	}
 }
 
+// BuildFakeProductEntitlements builds faked ProductEntitlements.
+func BuildFakeProductEntitlements() *types.ProductEntitlements {
+	return &types.ProductEntitlements{
+		Features:            []string{types.FeatureAIRecipeImport},
+		MaxMealPlansPerWeek: int32(fake.Number(1, 10)),
+		MaxAccountMembers:   int32(fake.Number(1, 10)),
+	}
+}
+
 // BuildFakeProductList builds a faked Product list.
 func BuildFakeProductList() *filtering.QueryFilteredResult[types.Product] {
 	var examples []*types.Product
@@ -54,5 +64,6 @@ func BuildFakeProductCreationRequestInput() *types.ProductCreationRequestInput {
 		Currency:              product.Currency,
 		BillingIntervalMonths: &interval,
 		ExternalProductID:     product.ExternalProductID,
+		Entitlements:          product.Entitlements,
 	}
 }
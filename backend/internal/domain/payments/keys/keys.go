@@ -11,4 +11,6 @@
 	PurchaseIDKey = "purchase" + idSuffix
 	// PaymentTransactionIDKey is the standard key for referring to a payment transaction's ID.
 	PaymentTransactionIDKey = "payment_transaction" + idSuffix
+	// PaymentProviderEventIDKey is the standard key for referring to a payment provider event's ID.
+	PaymentProviderEventIDKey = "payment_provider_event" + idSuffix
 )
@@ -24,6 +24,7 @@ func RegisterPaymentsDataManager(i do.Injector) {
 			do.MustInvoke[payments.Repository](i),
 			do.MustInvoke[payments.PaymentProcessorRegistry](i),
 			do.MustInvoke[identitymanager.IdentityDataManager](i),
+			do.MustInvoke[payments.EntitlementsChecker](i),
 			do.MustInvoke[*msgconfig.QueuesConfig](i),
 			do.MustInvoke[messagequeue.PublisherProvider](i),
 		)
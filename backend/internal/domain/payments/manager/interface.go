@@ -25,5 +25,9 @@ type PaymentsDataManager interface {
 	GetPurchasesForAccount(ctx context.Context, accountID string, filter *filtering.QueryFilter) (*filtering.QueryFilteredResult[payments.Purchase], error)
 	GetPaymentTransactionsForAccount(ctx context.Context, accountID string, filter *filtering.QueryFilter) (*filtering.QueryFilteredResult[payments.PaymentTransaction], error)
 
+	GetEntitlementsForAccount(ctx context.Context, accountID string) (*payments.AccountEntitlements, error)
+
 	ProcessWebhookEvent(ctx context.Context, provider string, payload []byte, signature, accountID string) error
+	GetPaymentProviderEvents(ctx context.Context, provider string, filter *filtering.QueryFilter) (*filtering.QueryFilteredResult[payments.PaymentProviderEvent], error)
+	ReplayPaymentProviderEvent(ctx context.Context, id string) (*payments.PaymentProviderEvent, error)
 }
@@ -2,6 +2,8 @@
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"fmt"
 	"time"
 
@@ -27,12 +29,16 @@
 
 var _ PaymentsDataManager = (*paymentsManager)(nil)
 
+// errProviderEventIgnored is wrapped by the reason a provider event called for no state change.
+var errProviderEventIgnored = errors.New("payment provider event ignored")
+
 type paymentsManager struct {
 	tracer               tracing.Tracer
 	logger               logging.Logger
 	repo                 payments.Repository
 	processorRegistry    payments.PaymentProcessorRegistry
 	identityMgr          identitymanager.IdentityDataManager
+	entitlementsChecker  payments.EntitlementsChecker
 	dataChangesPublisher messagequeue.Publisher
 }
 
@@ -44,6 +50,7 @@ func NewPaymentsDataManager(
 	repo payments.Repository,
 	processorRegistry payments.PaymentProcessorRegistry,
 	identityMgr identitymanager.IdentityDataManager,
+	entitlementsChecker payments.EntitlementsChecker,
 	cfg *msgconfig.QueuesConfig,
 	publisherProvider messagequeue.PublisherProvider,
 ) (PaymentsDataManager, error) {
@@ -58,6 +65,7 @@ func NewPaymentsDataManager(
 		repo:                 repo,
 		processorRegistry:    processorRegistry,
 		identityMgr:          identityMgr,
+		entitlementsChecker:  entitlementsChecker,
 		dataChangesPublisher: dataChangesPublisher,
 	}, nil
 }
@@ -82,6 +90,7 @@ func (m *paymentsManager) CreateProduct(ctx context.Context, input *payments.Pro
 		Currency:              input.Currency,
 		BillingIntervalMonths: input.BillingIntervalMonths,
 		ExternalProductID:     input.ExternalProductID,
+		Entitlements:          input.Entitlements,
 	}
 	created, err := m.repo.CreateProduct(ctx, dbInput)
 	if err != nil {
@@ -141,6 +150,12 @@ func (m *paymentsManager) UpdateProduct(ctx context.Context, id string, input *p
 	if input.ExternalProductID != nil {
 		product.ExternalProductID = *input.ExternalProductID
 	}
+	if input.Entitlements != nil {
+		if err = input.Entitlements.ValidateWithContext(ctx); err != nil {
+			return observability.PrepareError(err, span, "validating product entitlements")
+		}
+		product.Entitlements = *input.Entitlements
+	}
 
 	if err = m.repo.UpdateProduct(ctx, product); err != nil {
 		return err
@@ -283,6 +298,65 @@ func (m *paymentsManager) GetPaymentTransactionsForAccount(ctx context.Context,
 	return m.repo.GetPaymentTransactionsForAccount(ctx, accountID, filter)
 }
 
+func (m *paymentsManager) GetEntitlementsForAccount(ctx context.Context, accountID string) (*payments.AccountEntitlements, error) {
+	ctx, span := m.tracer.StartSpan(ctx)
+	defer span.End()
+
+	return m.entitlementsChecker.GetEntitlementsForAccount(ctx, accountID)
+}
+
+func (m *paymentsManager) GetPaymentProviderEvents(ctx context.Context, provider string, filter *filtering.QueryFilter) (*filtering.QueryFilteredResult[payments.PaymentProviderEvent], error) {
+	ctx, span := m.tracer.StartSpan(ctx)
+	defer span.End()
+
+	return m.repo.GetPaymentProviderEvents(ctx, provider, filter)
+}
+
+// ReplayPaymentProviderEvent re-applies a stored provider event. The payload's signature was verified when it was
+// received, so it isn't checked again; the ordering rule still applies, so replaying an event older than the
+// subscription's latest applied event marks it stale.
+func (m *paymentsManager) ReplayPaymentProviderEvent(ctx context.Context, id string) (*payments.PaymentProviderEvent, error) {
+	ctx, span := m.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := m.logger.WithSpan(span).WithValue(paymentskeys.PaymentProviderEventIDKey, id)
+	tracing.AttachToSpan(span, paymentskeys.PaymentProviderEventIDKey, id)
+
+	event, err := m.repo.GetPaymentProviderEvent(ctx, id)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching payment provider event")
+	}
+	logger = logger.WithValue("provider", event.Provider)
+
+	processor, ok := m.processorRegistry.GetProcessor(event.Provider)
+	if !ok {
+		return nil, observability.PrepareAndLogError(nil, logger, span, "unknown payment provider: %s", event.Provider)
+	}
+
+	parsed, err := processor.ParseWebhookEvent(ctx, event.Payload)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "parsing stored webhook event")
+	}
+
+	claimed, err := m.repo.ClaimPaymentProviderEvent(ctx, id, true)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "claiming payment provider event")
+	}
+	if claimed == nil {
+		return nil, observability.PrepareAndLogError(payments.ErrPaymentProviderEventInProgress, logger, span, "claiming payment provider event")
+	}
+
+	if err = m.applyProviderEvent(ctx, logger, span, claimed, parsed); err != nil {
+		return nil, err
+	}
+
+	m.dataChangesPublisher.PublishAsync(ctx, audit.BuildDataChangeMessageFromContext(ctx, logger, payments.PaymentProviderEventReplayedServiceEventType, map[string]any{
+		paymentskeys.PaymentProviderEventIDKey: id,
+	}))
+
+	return m.repo.GetPaymentProviderEvent(ctx, id)
+}
+
 func (m *paymentsManager) ProcessWebhookEvent(ctx context.Context, provider string, payload []byte, signature, accountID string) error {
 	ctx, span := m.tracer.StartSpan(ctx)
 	defer span.End()
@@ -311,6 +385,104 @@ func (m *paymentsManager) ProcessWebhookEvent(ctx context.Context, provider stri
 		accountID = parsed.AccountID
 	}
 
+	eventID, err := m.recordProviderEvent(ctx, provider, parsed, accountID, payload)
+	if err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "recording payment provider event")
+	}
+	logger = logger.WithValue(paymentskeys.PaymentProviderEventIDKey, eventID)
+
+	// a redelivery that was already applied, or is being applied by another worker, can't be claimed.
+	event, err := m.repo.ClaimPaymentProviderEvent(ctx, eventID, false)
+	if err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "claiming payment provider event")
+	}
+	if event == nil {
+		logger.WithValue("provider_event_id", parsed.EventID).Info("ignoring redelivered payment provider event")
+		return nil
+	}
+
+	return m.applyProviderEvent(ctx, logger, span, event, parsed)
+}
+
+// recordProviderEvent stores an incoming provider event in the inbox and returns its ID. When the provider has
+// delivered the event before, the ID of the previously stored record is returned instead.
+func (m *paymentsManager) recordProviderEvent(ctx context.Context, provider string, parsed *payments.ParsedWebhookEvent, accountID string, payload []byte) (string, error) {
+	id := identifiers.New()
+	providerEventID := parsed.EventID
+	if providerEventID == "" {
+		// without a provider event ID there is nothing to deduplicate against.
+		providerEventID = id
+	}
+
+	occurredAt := parsed.OccurredAt
+	if occurredAt.IsZero() {
+		occurredAt = time.Now()
+	}
+
+	input := &payments.PaymentProviderEventDatabaseCreationInput{
+		ID:                     id,
+		Provider:               provider,
+		ProviderEventID:        providerEventID,
+		EventType:              parsed.EventType,
+		ExternalSubscriptionID: parsed.SubscriptionID,
+		AccountID:              accountID,
+		Payload:                payload,
+		OccurredAt:             occurredAt,
+	}
+
+	created, err := m.repo.CreatePaymentProviderEvent(ctx, input)
+	if err != nil {
+		return "", err
+	}
+
+	if !created {
+		existing, getErr := m.repo.GetPaymentProviderEventByProviderEventID(ctx, provider, providerEventID)
+		if getErr != nil {
+			return "", getErr
+		}
+		return existing.ID, nil
+	}
+
+	return id, nil
+}
+
+// applyProviderEvent applies a claimed provider event's state transition and records the outcome. Subscription
+// status changes are checked against the subscription's latest applied event while its row is locked, so a late
+// delivery is marked stale rather than regressing the subscription's status. Events that call for no state change
+// are marked ignored rather than applied, so they can be found and replayed.
+func (m *paymentsManager) applyProviderEvent(ctx context.Context, logger logging.Logger, span tracing.Span, event *payments.PaymentProviderEvent, parsed *payments.ParsedWebhookEvent) error {
+	recorded, applyErr := m.applyWebhookEvent(ctx, logger, span, event, parsed)
+	if errors.Is(applyErr, errProviderEventIgnored) {
+		logger.WithValue("reason", applyErr.Error()).Info("ignoring payment provider event")
+		if err := m.repo.UpdatePaymentProviderEventStatus(ctx, event.ID, payments.PaymentProviderEventStatusIgnored, applyErr.Error()); err != nil {
+			return observability.PrepareAndLogError(err, logger, span, "marking payment provider event ignored")
+		}
+		return nil
+	}
+
+	if applyErr != nil {
+		if err := m.repo.UpdatePaymentProviderEventStatus(ctx, event.ID, payments.PaymentProviderEventStatusFailed, applyErr.Error()); err != nil {
+			logger.Error("marking payment provider event failed", err)
+		}
+		return applyErr
+	}
+
+	if recorded {
+		return nil
+	}
+
+	if err := m.repo.UpdatePaymentProviderEventStatus(ctx, event.ID, payments.PaymentProviderEventStatusApplied, ""); err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "marking payment provider event applied")
+	}
+
+	return nil
+}
+
+// applyWebhookEvent performs the subscription and billing changes a parsed provider event calls for. It reports
+// whether the event's final status was already recorded alongside a subscription status change, and returns an error
+// wrapping errProviderEventIgnored when the event calls for no change.
+func (m *paymentsManager) applyWebhookEvent(ctx context.Context, logger logging.Logger, span tracing.Span, event *payments.PaymentProviderEvent, parsed *payments.ParsedWebhookEvent) (bool, error) {
+	accountID := event.AccountID
 	eventType := parsed.EventType
 	subscriptionID := parsed.SubscriptionID
 	syncNow := time.Now()
@@ -318,12 +490,7 @@ func (m *paymentsManager) ProcessWebhookEvent(ctx context.Context, provider stri
 	switch eventType {
 	case "subscription.updated", "subscription.created", "customer.subscription.updated":
 		if subscriptionID == "" {
-			return nil
-		}
-		var sub *payments.Subscription
-		sub, err = m.repo.GetSubscriptionByExternalID(ctx, subscriptionID)
-		if err != nil {
-			return observability.PrepareAndLogError(err, logger, span, "fetching subscription by external ID")
+			return false, fmt.Errorf("%w: no subscription ID", errProviderEventIgnored)
 		}
 
 		status := parsed.Status
@@ -331,110 +498,128 @@ func (m *paymentsManager) ProcessWebhookEvent(ctx context.Context, provider stri
 			status = payments.SubscriptionStatusActive
 		}
 
-		if err = m.repo.UpdateSubscriptionStatus(ctx, sub.ID, status); err != nil {
-			return observability.PrepareAndLogError(err, logger, span, "updating subscription status")
+		sub, applied, err := m.repo.UpdateSubscriptionStatusForProviderEvent(ctx, event, status)
+		if err != nil {
+			return false, observability.PrepareAndLogError(err, logger, span, "updating subscription status")
+		}
+		if !applied {
+			logger.Info("skipping stale payment provider event")
+			return true, nil
 		}
 
 		billingStatus := subscriptionStatusToBillingStatus(status)
 		if err = m.identityMgr.UpdateAccountBillingFields(ctx, sub.BelongsToAccount, &billingStatus, &sub.ProductID, nil, &syncNow); err != nil {
-			return observability.PrepareAndLogError(err, logger, span, "updating account billing fields")
+			return true, observability.PrepareAndLogError(err, logger, span, "updating account billing fields")
 		}
+
+		return true, nil
 	case "subscription.deleted", "customer.subscription.deleted":
 		if subscriptionID == "" {
-			return nil
+			return false, fmt.Errorf("%w: no subscription ID", errProviderEventIgnored)
 		}
 
-		var sub *payments.Subscription
-		sub, err = m.repo.GetSubscriptionByExternalID(ctx, subscriptionID)
+		sub, applied, err := m.repo.UpdateSubscriptionStatusForProviderEvent(ctx, event, payments.SubscriptionStatusCancelled)
 		if err != nil {
-			return observability.PrepareAndLogError(err, logger, span, "fetching subscription by external ID")
+			return false, observability.PrepareAndLogError(err, logger, span, "updating subscription status")
 		}
-
-		if err = m.repo.UpdateSubscriptionStatus(ctx, sub.ID, payments.SubscriptionStatusCancelled); err != nil {
-			return observability.PrepareAndLogError(err, logger, span, "updating subscription status")
+		if !applied {
+			logger.Info("skipping stale payment provider event")
+			return true, nil
 		}
 
 		unpaid := identity.UnpaidAccountBillingStatus
 		if err = m.identityMgr.UpdateAccountBillingFields(ctx, sub.BelongsToAccount, &unpaid, nil, nil, &syncNow); err != nil {
-			return observability.PrepareAndLogError(err, logger, span, "updating account billing fields")
+			return true, observability.PrepareAndLogError(err, logger, span, "updating account billing fields")
 		}
 
+		return true, nil
+
 	// RevenueCat events (mobile in-app purchases)
 	case "INITIAL_PURCHASE", "RENEWAL", "PRODUCT_CHANGE", "UNCANCELLATION", "SUBSCRIPTION_EXTENDED":
-		if accountID == "" || parsed.ProductID == "" {
-			return nil
+		if accountID == "" {
+			return false, fmt.Errorf("%w: no account ID", errProviderEventIgnored)
 		}
-		if err = m.handleRevenueCatSubscriptionActive(ctx, logger, span, accountID, subscriptionID, parsed.ProductID, syncNow); err != nil {
-			return err
+		if parsed.ProductID == "" {
+			return false, fmt.Errorf("%w: no product ID", errProviderEventIgnored)
 		}
+		return m.handleRevenueCatSubscriptionActive(ctx, logger, span, event, parsed.ProductID, syncNow)
 	case "EXPIRATION":
 		if accountID == "" {
-			return nil
-		}
-		if err = m.handleRevenueCatSubscriptionExpired(ctx, logger, span, accountID, subscriptionID); err != nil {
-			return err
+			return false, fmt.Errorf("%w: no account ID", errProviderEventIgnored)
 		}
+		return m.handleRevenueCatSubscriptionExpired(ctx, logger, span, event)
 	case "CANCELLATION":
 		// User cancelled; access may persist until EXPIRATION. Optionally mark subscription cancelled.
-		if accountID == "" || subscriptionID == "" {
-			return nil
+		if accountID == "" {
+			return false, fmt.Errorf("%w: no account ID", errProviderEventIgnored)
 		}
-		sub, subErr := m.repo.GetSubscriptionByExternalID(ctx, subscriptionID)
-		if subErr != nil {
-			return nil // subscription may not exist yet
+		if subscriptionID == "" {
+			return false, fmt.Errorf("%w: no subscription ID", errProviderEventIgnored)
 		}
-		if err = m.repo.UpdateSubscriptionStatus(ctx, sub.ID, payments.SubscriptionStatusCancelled); err != nil {
-			return observability.PrepareAndLogError(err, logger, span, "updating subscription status")
+		_, applied, err := m.repo.UpdateSubscriptionStatusForProviderEvent(ctx, event, payments.SubscriptionStatusCancelled)
+		if err != nil {
+			if errors.Is(err, sql.ErrNoRows) {
+				// the subscription may not exist yet, so leave the event to be replayed once it does.
+				return false, fmt.Errorf("%w: subscription not found", errProviderEventIgnored)
+			}
+			return false, observability.PrepareAndLogError(err, logger, span, "updating subscription status")
+		}
+		if !applied {
+			logger.Info("skipping stale payment provider event")
 		}
+		return true, nil
 	case "BILLING_ISSUE":
 		// Log; optionally treat as at-risk. No-op for now.
 		logger.WithValue("account_id", accountID).Info("RevenueCat billing issue received")
+		return false, fmt.Errorf("%w: billing issues aren't acted on", errProviderEventIgnored)
 	default:
-		// Unknown event type - no-op
+		return false, fmt.Errorf("%w: unknown event type %q", errProviderEventIgnored, eventType)
 	}
-
-	return nil
 }
 
 func (m *paymentsManager) handleRevenueCatSubscriptionActive(
 	ctx context.Context,
 	logger logging.Logger,
 	span tracing.Span,
-	accountID, transactionID, externalProductID string,
+	event *payments.PaymentProviderEvent,
+	externalProductID string,
 	syncNow time.Time,
-) error {
+) (bool, error) {
 	product, err := m.repo.GetProductByExternalID(ctx, externalProductID)
 	if err != nil {
-		return observability.PrepareAndLogError(err, logger, span, "fetching product by external ID")
+		return false, observability.PrepareAndLogError(err, logger, span, "fetching product by external ID")
 	}
 
-	sub, err := m.repo.GetSubscriptionByExternalID(ctx, transactionID)
-	if err != nil {
+	recorded := true
+	if _, applied, updateErr := m.repo.UpdateSubscriptionStatusForProviderEvent(ctx, event, payments.SubscriptionStatusActive); updateErr != nil {
+		if !errors.Is(updateErr, sql.ErrNoRows) {
+			return false, observability.PrepareAndLogError(updateErr, logger, span, "updating subscription status")
+		}
+
 		// Create new subscription for INITIAL_PURCHASE
 		now := time.Now()
 		dbInput := &payments.SubscriptionDatabaseCreationInput{
 			ID:                     identifiers.New(),
-			BelongsToAccount:       accountID,
+			BelongsToAccount:       event.AccountID,
 			ProductID:              product.ID,
-			ExternalSubscriptionID: transactionID,
+			ExternalSubscriptionID: event.ExternalSubscriptionID,
 			Status:                 payments.SubscriptionStatusActive,
 			CurrentPeriodStart:     now,
 			CurrentPeriodEnd:       now.AddDate(0, 1, 0), // approximate
 		}
-		_, err = m.repo.CreateSubscription(ctx, dbInput)
-		if err != nil {
-			return observability.PrepareAndLogError(err, logger, span, "creating subscription")
-		}
-	} else {
-		if err = m.repo.UpdateSubscriptionStatus(ctx, sub.ID, payments.SubscriptionStatusActive); err != nil {
-			return observability.PrepareAndLogError(err, logger, span, "updating subscription status")
+		if _, err = m.repo.CreateSubscription(ctx, dbInput); err != nil {
+			return false, observability.PrepareAndLogError(err, logger, span, "creating subscription")
 		}
+		recorded = false
+	} else if !applied {
+		logger.Info("skipping stale payment provider event")
+		return true, nil
 	}
 
 	billingStatus := identity.PaidAccountBillingStatus
 	productID := product.ID
-	return observability.PrepareAndLogError(
-		m.identityMgr.UpdateAccountBillingFields(ctx, accountID, &billingStatus, &productID, nil, &syncNow),
+	return recorded, observability.PrepareAndLogError(
+		m.identityMgr.UpdateAccountBillingFields(ctx, event.AccountID, &billingStatus, &productID, nil, &syncNow),
 		logger, span, "updating account billing fields",
 	)
 }
@@ -443,26 +628,29 @@ func (m *paymentsManager) handleRevenueCatSubscriptionExpired(
 	ctx context.Context,
 	logger logging.Logger,
 	span tracing.Span,
-	accountID, transactionID string,
-) error {
-	sub, err := m.repo.GetSubscriptionByExternalID(ctx, transactionID)
+	event *payments.PaymentProviderEvent,
+) (bool, error) {
+	unpaid := identity.UnpaidAccountBillingStatus
+	syncNow := time.Now()
+
+	sub, applied, err := m.repo.UpdateSubscriptionStatusForProviderEvent(ctx, event, payments.SubscriptionStatusCancelled)
 	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return false, observability.PrepareAndLogError(err, logger, span, "updating subscription status")
+		}
+
 		// Subscription may not exist; still update account to unpaid
-		unpaid := identity.UnpaidAccountBillingStatus
-		syncNow := time.Now()
-		return observability.PrepareAndLogError(
-			m.identityMgr.UpdateAccountBillingFields(ctx, accountID, &unpaid, nil, nil, &syncNow),
+		return false, observability.PrepareAndLogError(
+			m.identityMgr.UpdateAccountBillingFields(ctx, event.AccountID, &unpaid, nil, nil, &syncNow),
 			logger, span, "updating account billing fields",
 		)
 	}
-
-	if err = m.repo.UpdateSubscriptionStatus(ctx, sub.ID, payments.SubscriptionStatusCancelled); err != nil {
-		return observability.PrepareAndLogError(err, logger, span, "updating subscription status")
+	if !applied {
+		logger.Info("skipping stale payment provider event")
+		return true, nil
 	}
 
-	unpaid := identity.UnpaidAccountBillingStatus
-	syncNow := time.Now()
-	return observability.PrepareAndLogError(
+	return true, observability.PrepareAndLogError(
 		m.identityMgr.UpdateAccountBillingFields(ctx, sub.BelongsToAccount, &unpaid, nil, nil, &syncNow),
 		logger, span, "updating account billing fields",
 	)
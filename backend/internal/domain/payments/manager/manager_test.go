@@ -2,6 +2,9 @@
 
 import (
 	"context"
+	"database/sql"
+	"errors"
+	"strings"
 	"testing"
 
 	identitymock "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity/manager/mock"
@@ -16,6 +19,7 @@
 	msgconfig "github.com/primandproper/platform/messagequeue/config"
 	mockpublishers "github.com/primandproper/platform/messagequeue/mock"
 	loggingnoop "github.com/primandproper/platform/observability/logging/noop"
+	"github.com/primandproper/platform/observability/tracing"
 	tracingnoop "github.com/primandproper/platform/observability/tracing/noop"
 	"github.com/primandproper/platform/reflection"
 
@@ -52,6 +56,7 @@ func buildPaymentsManagerForTest(t *testing.T) *paymentsManager {
 		&paymentsmock.Repository{},
 		registry,
 		&identitymock.IdentityDataManager{},
+		&paymentsmock.EntitlementsChecker{},
 		queueCfg,
 		mpp,
 	)
@@ -266,3 +271,399 @@ func(repo *paymentsmock.Repository) {
 		mock.AssertExpectationsForObjects(t, expectations...)
 	})
 }
+
+func TestPaymentsManager_GetEntitlementsForAccount(t *testing.T) {
+	t.Parallel()
+
+	t.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		pm := buildPaymentsManagerForTest(t)
+
+		accountID := fakes.BuildFakeID()
+		expected := payments.UnrestrictedAccountEntitlements(accountID)
+
+		checker := &paymentsmock.EntitlementsChecker{}
+		checker.On(reflection.GetMethodName(checker.GetEntitlementsForAccount), testutils.ContextMatcher, accountID).Return(expected, nil)
+		pm.entitlementsChecker = checker
+
+		actual, err := pm.GetEntitlementsForAccount(ctx, accountID)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, actual)
+
+		mock.AssertExpectationsForObjects(t, checker)
+	})
+}
+
+func buildPaymentProcessorForTest(pm *paymentsManager, payload []byte, parsed *payments.ParsedWebhookEvent) *paymentsmock.PaymentProcessor {
+	processor := &paymentsmock.PaymentProcessor{}
+	processor.On(reflection.GetMethodName(processor.ParseWebhookEvent), testutils.ContextMatcher, payload).Return(parsed, nil)
+	pm.processorRegistry = payments.NewMapProcessorRegistry(map[string]payments.PaymentProcessor{
+		"stripe": processor,
+	})
+
+	return processor
+}
+
+func TestPaymentsManager_ProcessWebhookEvent(t *testing.T) {
+	t.Parallel()
+
+	payload := []byte(`{"id":"evt_123"}`)
+	signature := "signature"
+
+	t.Run("records new event", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		pm := buildPaymentsManagerForTest(t)
+
+		parsed := &payments.ParsedWebhookEvent{
+			OccurredAt:     fakes.BuildFakeTime(),
+			EventID:        "evt_123",
+			EventType:      "BILLING_ISSUE",
+			SubscriptionID: fakes.BuildFakeID(),
+		}
+		processor := buildPaymentProcessorForTest(pm, payload, parsed)
+		processor.On(reflection.GetMethodName(processor.VerifyWebhookSignature), testutils.ContextMatcher, payload, signature, "").Return(true)
+
+		expectations := setupExpectationsForPaymentsManager(
+			pm,
+			func(repo *paymentsmock.Repository) {
+				repo.On(reflection.GetMethodName(repo.CreatePaymentProviderEvent), testutils.ContextMatcher, mock.MatchedBy(func(input *payments.PaymentProviderEventDatabaseCreationInput) bool {
+					return input.ProviderEventID == parsed.EventID && input.OccurredAt.Equal(parsed.OccurredAt)
+				})).Return(true, nil)
+				repo.On(reflection.GetMethodName(repo.ClaimPaymentProviderEvent), testutils.ContextMatcher, mock.AnythingOfType("string"), false).Return(&payments.PaymentProviderEvent{
+					ID:                     fakes.BuildFakeID(),
+					Provider:               "stripe",
+					ProviderEventID:        parsed.EventID,
+					EventType:              parsed.EventType,
+					ExternalSubscriptionID: parsed.SubscriptionID,
+					Status:                 payments.PaymentProviderEventStatusProcessing,
+					OccurredAt:             parsed.OccurredAt,
+				}, nil)
+				// billing issues call for no state change, so the event is left replayable.
+				repo.On(reflection.GetMethodName(repo.UpdatePaymentProviderEventStatus), testutils.ContextMatcher, mock.AnythingOfType("string"), payments.PaymentProviderEventStatusIgnored, mock.AnythingOfType("string")).Return(nil)
+			},
+		)
+
+		assert.NoError(t, pm.ProcessWebhookEvent(ctx, "stripe", payload, signature, ""))
+
+		mock.AssertExpectationsForObjects(t, append(expectations, processor)...)
+	})
+
+	t.Run("ignores redelivered event", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		pm := buildPaymentsManagerForTest(t)
+
+		parsed := &payments.ParsedWebhookEvent{
+			OccurredAt:     fakes.BuildFakeTime(),
+			EventID:        "evt_123",
+			EventType:      "BILLING_ISSUE",
+			SubscriptionID: fakes.BuildFakeID(),
+		}
+		processor := buildPaymentProcessorForTest(pm, payload, parsed)
+		processor.On(reflection.GetMethodName(processor.VerifyWebhookSignature), testutils.ContextMatcher, payload, signature, "").Return(true)
+
+		existing := &payments.PaymentProviderEvent{
+			ID:              fakes.BuildFakeID(),
+			Provider:        "stripe",
+			ProviderEventID: parsed.EventID,
+			Status:          payments.PaymentProviderEventStatusApplied,
+		}
+
+		expectations := setupExpectationsForPaymentsManager(
+			pm,
+			func(repo *paymentsmock.Repository) {
+				repo.On(reflection.GetMethodName(repo.CreatePaymentProviderEvent), testutils.ContextMatcher, testutils.MatchType[*payments.PaymentProviderEventDatabaseCreationInput]()).Return(false, nil)
+				repo.On(reflection.GetMethodName(repo.GetPaymentProviderEventByProviderEventID), testutils.ContextMatcher, "stripe", parsed.EventID).Return(existing, nil)
+				repo.On(reflection.GetMethodName(repo.ClaimPaymentProviderEvent), testutils.ContextMatcher, existing.ID, false).Return(nil, nil)
+			},
+		)
+
+		assert.NoError(t, pm.ProcessWebhookEvent(ctx, "stripe", payload, signature, ""))
+
+		mock.AssertExpectationsForObjects(t, append(expectations, processor)...)
+	})
+
+	t.Run("marks out-of-order event stale", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		pm := buildPaymentsManagerForTest(t)
+
+		parsed := &payments.ParsedWebhookEvent{
+			OccurredAt:     fakes.BuildFakeTime(),
+			EventID:        "evt_123",
+			EventType:      "customer.subscription.deleted",
+			SubscriptionID: fakes.BuildFakeID(),
+		}
+		processor := buildPaymentProcessorForTest(pm, payload, parsed)
+		processor.On(reflection.GetMethodName(processor.VerifyWebhookSignature), testutils.ContextMatcher, payload, signature, "").Return(true)
+
+		claimed := &payments.PaymentProviderEvent{
+			ID:                     fakes.BuildFakeID(),
+			Provider:               "stripe",
+			ProviderEventID:        parsed.EventID,
+			EventType:              parsed.EventType,
+			ExternalSubscriptionID: parsed.SubscriptionID,
+			Status:                 payments.PaymentProviderEventStatusProcessing,
+			OccurredAt:             parsed.OccurredAt,
+		}
+
+		expectations := setupExpectationsForPaymentsManager(
+			pm,
+			func(repo *paymentsmock.Repository) {
+				repo.On(reflection.GetMethodName(repo.CreatePaymentProviderEvent), testutils.ContextMatcher, testutils.MatchType[*payments.PaymentProviderEventDatabaseCreationInput]()).Return(true, nil)
+				repo.On(reflection.GetMethodName(repo.ClaimPaymentProviderEvent), testutils.ContextMatcher, mock.AnythingOfType("string"), false).Return(claimed, nil)
+				// the repository marks the event stale in the same transaction it checks ordering in.
+				repo.On(reflection.GetMethodName(repo.UpdateSubscriptionStatusForProviderEvent), testutils.ContextMatcher, claimed, payments.SubscriptionStatusCancelled).Return(fakes.BuildFakeSubscription(fakes.BuildFakeID(), fakes.BuildFakeID()), false, nil)
+			},
+		)
+
+		assert.NoError(t, pm.ProcessWebhookEvent(ctx, "stripe", payload, signature, ""))
+
+		mock.AssertExpectationsForObjects(t, append(expectations, processor)...)
+	})
+
+	t.Run("with invalid signature", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		pm := buildPaymentsManagerForTest(t)
+
+		processor := &paymentsmock.PaymentProcessor{}
+		processor.On(reflection.GetMethodName(processor.VerifyWebhookSignature), testutils.ContextMatcher, payload, signature, "").Return(false)
+		pm.processorRegistry = payments.NewMapProcessorRegistry(map[string]payments.PaymentProcessor{
+			"stripe": processor,
+		})
+
+		expectations := setupExpectationsForPaymentsManager(pm, nil)
+
+		assert.Error(t, pm.ProcessWebhookEvent(ctx, "stripe", payload, signature, ""))
+
+		mock.AssertExpectationsForObjects(t, append(expectations, processor)...)
+	})
+}
+
+func TestPaymentsManager_ReplayPaymentProviderEvent(t *testing.T) {
+	t.Parallel()
+
+	t.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		pm := buildPaymentsManagerForTest(t)
+
+		event := &payments.PaymentProviderEvent{
+			ID:                     fakes.BuildFakeID(),
+			Provider:               "stripe",
+			ProviderEventID:        "evt_123",
+			EventType:              "BILLING_ISSUE",
+			ExternalSubscriptionID: fakes.BuildFakeID(),
+			Payload:                []byte(`{"id":"evt_123"}`),
+			Status:                 payments.PaymentProviderEventStatusFailed,
+			OccurredAt:             fakes.BuildFakeTime(),
+		}
+		parsed := &payments.ParsedWebhookEvent{
+			OccurredAt:     event.OccurredAt,
+			EventID:        event.ProviderEventID,
+			EventType:      event.EventType,
+			SubscriptionID: event.ExternalSubscriptionID,
+		}
+		processor := buildPaymentProcessorForTest(pm, event.Payload, parsed)
+
+		expectations := setupExpectationsForPaymentsManager(
+			pm,
+			func(repo *paymentsmock.Repository) {
+				repo.On(reflection.GetMethodName(repo.GetPaymentProviderEvent), testutils.ContextMatcher, event.ID).Return(event, nil)
+				repo.On(reflection.GetMethodName(repo.ClaimPaymentProviderEvent), testutils.ContextMatcher, event.ID, true).Return(event, nil)
+				repo.On(reflection.GetMethodName(repo.UpdatePaymentProviderEventStatus), testutils.ContextMatcher, event.ID, payments.PaymentProviderEventStatusIgnored, mock.AnythingOfType("string")).Return(nil)
+			},
+		)
+
+		actual, err := pm.ReplayPaymentProviderEvent(ctx, event.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, event, actual)
+
+		mock.AssertExpectationsForObjects(t, append(expectations, processor)...)
+	})
+	t.Run("with event already being processed", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		pm := buildPaymentsManagerForTest(t)
+
+		event := &payments.PaymentProviderEvent{
+			ID:              fakes.BuildFakeID(),
+			Provider:        "stripe",
+			ProviderEventID: "evt_123",
+			EventType:       "BILLING_ISSUE",
+			Payload:         []byte(`{"id":"evt_123"}`),
+			Status:          payments.PaymentProviderEventStatusProcessing,
+			OccurredAt:      fakes.BuildFakeTime(),
+		}
+		parsed := &payments.ParsedWebhookEvent{
+			OccurredAt: event.OccurredAt,
+			EventID:    event.ProviderEventID,
+			EventType:  event.EventType,
+		}
+		processor := buildPaymentProcessorForTest(pm, event.Payload, parsed)
+
+		expectations := setupExpectationsForPaymentsManager(
+			pm,
+			func(repo *paymentsmock.Repository) {
+				repo.On(reflection.GetMethodName(repo.GetPaymentProviderEvent), testutils.ContextMatcher, event.ID).Return(event, nil)
+				repo.On(reflection.GetMethodName(repo.ClaimPaymentProviderEvent), testutils.ContextMatcher, event.ID, true).Return(nil, nil)
+			},
+		)
+
+		actual, err := pm.ReplayPaymentProviderEvent(ctx, event.ID)
+		assert.ErrorIs(t, err, payments.ErrPaymentProviderEventInProgress)
+		assert.Nil(t, actual)
+
+		mock.AssertExpectationsForObjects(t, append(expectations, processor)...)
+	})
+}
+
+func TestPaymentsManager_applyProviderEvent(t *testing.T) {
+	t.Parallel()
+
+	// each of these calls for no state change, so the event is marked ignored with why rather than applied.
+	ignoredCases := []struct {
+		setupFunc func(repo *paymentsmock.Repository, event *payments.PaymentProviderEvent)
+		parsed    *payments.ParsedWebhookEvent
+		event     *payments.PaymentProviderEvent
+		name      string
+		reason    string
+	}{
+		{
+			name:   "with subscription update missing subscription ID",
+			event:  &payments.PaymentProviderEvent{EventType: "customer.subscription.updated"},
+			reason: "no subscription ID",
+		},
+		{
+			name:   "with subscription deletion missing subscription ID",
+			event:  &payments.PaymentProviderEvent{EventType: "customer.subscription.deleted"},
+			reason: "no subscription ID",
+		},
+		{
+			name:   "with purchase missing account ID",
+			event:  &payments.PaymentProviderEvent{EventType: "INITIAL_PURCHASE", ExternalSubscriptionID: fakes.BuildFakeID()},
+			parsed: &payments.ParsedWebhookEvent{ProductID: fakes.BuildFakeID()},
+			reason: "no account ID",
+		},
+		{
+			name:   "with purchase missing product ID",
+			event:  &payments.PaymentProviderEvent{EventType: "INITIAL_PURCHASE", AccountID: fakes.BuildFakeID(), ExternalSubscriptionID: fakes.BuildFakeID()},
+			reason: "no product ID",
+		},
+		{
+			name:   "with expiration missing account ID",
+			event:  &payments.PaymentProviderEvent{EventType: "EXPIRATION", ExternalSubscriptionID: fakes.BuildFakeID()},
+			reason: "no account ID",
+		},
+		{
+			name:   "with cancellation missing account ID",
+			event:  &payments.PaymentProviderEvent{EventType: "CANCELLATION", ExternalSubscriptionID: fakes.BuildFakeID()},
+			reason: "no account ID",
+		},
+		{
+			name:   "with cancellation missing subscription ID",
+			event:  &payments.PaymentProviderEvent{EventType: "CANCELLATION", AccountID: fakes.BuildFakeID()},
+			reason: "no subscription ID",
+		},
+		{
+			name:  "with cancellation for subscription that doesn't exist yet",
+			event: &payments.PaymentProviderEvent{EventType: "CANCELLATION", AccountID: fakes.BuildFakeID(), ExternalSubscriptionID: fakes.BuildFakeID()},
+			setupFunc: func(repo *paymentsmock.Repository, event *payments.PaymentProviderEvent) {
+				repo.On(reflection.GetMethodName(repo.UpdateSubscriptionStatusForProviderEvent), testutils.ContextMatcher, event, payments.SubscriptionStatusCancelled).Return(nil, false, sql.ErrNoRows)
+			},
+			reason: "subscription not found",
+		},
+		{
+			name:   "with billing issue",
+			event:  &payments.PaymentProviderEvent{EventType: "BILLING_ISSUE", AccountID: fakes.BuildFakeID()},
+			reason: "billing issues aren't acted on",
+		},
+		{
+			name:   "with unknown event type",
+			event:  &payments.PaymentProviderEvent{EventType: "invoice.created", AccountID: fakes.BuildFakeID()},
+			reason: "unknown event type",
+		},
+	}
+
+	for _, tc := range ignoredCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := t.Context()
+			pm := buildPaymentsManagerForTest(t)
+
+			event := tc.event
+			event.ID = fakes.BuildFakeID()
+			event.Provider = "stripe"
+			event.Status = payments.PaymentProviderEventStatusProcessing
+			event.OccurredAt = fakes.BuildFakeTime()
+
+			parsed := &payments.ParsedWebhookEvent{}
+			if tc.parsed != nil {
+				parsed = tc.parsed
+			}
+			parsed.OccurredAt = event.OccurredAt
+			parsed.EventType = event.EventType
+			parsed.AccountID = event.AccountID
+			parsed.SubscriptionID = event.ExternalSubscriptionID
+
+			expectations := setupExpectationsForPaymentsManager(
+				pm,
+				func(repo *paymentsmock.Repository) {
+					if tc.setupFunc != nil {
+						tc.setupFunc(repo, event)
+					}
+					repo.On(reflection.GetMethodName(repo.UpdatePaymentProviderEventStatus), testutils.ContextMatcher, event.ID, payments.PaymentProviderEventStatusIgnored, mock.MatchedBy(func(reason string) bool {
+						return strings.Contains(reason, tc.reason)
+					})).Return(nil)
+				},
+			)
+
+			_, span := tracing.NewTracerForTest(t.Name()).StartSpan(ctx)
+			assert.NoError(t, pm.applyProviderEvent(ctx, pm.logger, span, event, parsed))
+
+			mock.AssertExpectationsForObjects(t, expectations...)
+		})
+	}
+
+	t.Run("with error marking event ignored", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		pm := buildPaymentsManagerForTest(t)
+
+		event := &payments.PaymentProviderEvent{
+			ID:         fakes.BuildFakeID(),
+			Provider:   "stripe",
+			EventType:  "BILLING_ISSUE",
+			Status:     payments.PaymentProviderEventStatusProcessing,
+			OccurredAt: fakes.BuildFakeTime(),
+		}
+		parsed := &payments.ParsedWebhookEvent{
+			OccurredAt: event.OccurredAt,
+			EventType:  event.EventType,
+		}
+
+		expectations := setupExpectationsForPaymentsManager(
+			pm,
+			func(repo *paymentsmock.Repository) {
+				repo.On(reflection.GetMethodName(repo.UpdatePaymentProviderEventStatus), testutils.ContextMatcher, event.ID, payments.PaymentProviderEventStatusIgnored, mock.AnythingOfType("string")).Return(errors.New("blah"))
+			},
+		)
+
+		_, span := tracing.NewTracerForTest(t.Name()).StartSpan(ctx)
+		assert.Error(t, pm.applyProviderEvent(ctx, pm.logger, span, event, parsed))
+
+		mock.AssertExpectationsForObjects(t, expectations...)
+	})
+}
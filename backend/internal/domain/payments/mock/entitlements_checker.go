@@ -0,0 +1,23 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/payments"
+
+	"github.com/stretchr/testify/mock"
+)
+
+var _ payments.EntitlementsChecker = (*EntitlementsChecker)(nil)
+
+type EntitlementsChecker struct {
+	mock.Mock
+}
+
+func (m *EntitlementsChecker) GetEntitlementsForAccount(ctx context.Context, accountID string) (*payments.AccountEntitlements, error) {
+	args := m.Called(ctx, accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*payments.AccountEntitlements), args.Error(1)
+}
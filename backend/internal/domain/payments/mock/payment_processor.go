@@ -0,0 +1,27 @@
+package mock
+
+import (
+	"context"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/payments"
+
+	"github.com/stretchr/testify/mock"
+)
+
+var _ payments.PaymentProcessor = (*PaymentProcessor)(nil)
+
+type PaymentProcessor struct {
+	mock.Mock
+}
+
+func (m *PaymentProcessor) VerifyWebhookSignature(ctx context.Context, payload []byte, signature, accountID string) bool {
+	return m.Called(ctx, payload, signature, accountID).Bool(0)
+}
+
+func (m *PaymentProcessor) ParseWebhookEvent(ctx context.Context, payload []byte) (*payments.ParsedWebhookEvent, error) {
+	args := m.Called(ctx, payload)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*payments.ParsedWebhookEvent), args.Error(1)
+}
@@ -2,6 +2,7 @@
 
 import (
 	"context"
+	"time"
 
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/payments"
 
@@ -93,6 +94,14 @@ func (m *Repository) GetSubscriptionsForAccount(ctx context.Context, accountID s
 	return args.Get(0).(*filtering.QueryFilteredResult[payments.Subscription]), args.Error(1)
 }
 
+func (m *Repository) GetSubscriptionsForAccountEndingAfter(ctx context.Context, accountID string, periodEndsAfter time.Time) ([]*payments.Subscription, error) {
+	args := m.Called(ctx, accountID, periodEndsAfter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*payments.Subscription), args.Error(1)
+}
+
 func (m *Repository) UpdateSubscription(ctx context.Context, sub *payments.Subscription) error {
 	return m.Called(ctx, sub).Error(0)
 }
@@ -144,3 +153,60 @@ func (m *Repository) GetPaymentTransactionsForAccount(ctx context.Context, accou
 	}
 	return args.Get(0).(*filtering.QueryFilteredResult[payments.PaymentTransaction]), args.Error(1)
 }
+
+func (m *Repository) CreatePaymentProviderEvent(ctx context.Context, input *payments.PaymentProviderEventDatabaseCreationInput) (bool, error) {
+	args := m.Called(ctx, input)
+	return args.Bool(0), args.Error(1)
+}
+
+func (m *Repository) GetPaymentProviderEvent(ctx context.Context, id string) (*payments.PaymentProviderEvent, error) {
+	args := m.Called(ctx, id)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*payments.PaymentProviderEvent), args.Error(1)
+}
+
+func (m *Repository) GetPaymentProviderEventByProviderEventID(ctx context.Context, provider, providerEventID string) (*payments.PaymentProviderEvent, error) {
+	args := m.Called(ctx, provider, providerEventID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*payments.PaymentProviderEvent), args.Error(1)
+}
+
+func (m *Repository) GetPaymentProviderEvents(ctx context.Context, provider string, filter *filtering.QueryFilter) (*filtering.QueryFilteredResult[payments.PaymentProviderEvent], error) {
+	args := m.Called(ctx, provider, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*filtering.QueryFilteredResult[payments.PaymentProviderEvent]), args.Error(1)
+}
+
+func (m *Repository) GetLatestAppliedPaymentProviderEventTime(ctx context.Context, provider, externalSubscriptionID string) (*time.Time, error) {
+	args := m.Called(ctx, provider, externalSubscriptionID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*time.Time), args.Error(1)
+}
+
+func (m *Repository) ClaimPaymentProviderEvent(ctx context.Context, id string, includeFinished bool) (*payments.PaymentProviderEvent, error) {
+	args := m.Called(ctx, id, includeFinished)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*payments.PaymentProviderEvent), args.Error(1)
+}
+
+func (m *Repository) UpdateSubscriptionStatusForProviderEvent(ctx context.Context, event *payments.PaymentProviderEvent, status string) (*payments.Subscription, bool, error) {
+	args := m.Called(ctx, event, status)
+	if args.Get(0) == nil {
+		return nil, args.Bool(1), args.Error(2)
+	}
+	return args.Get(0).(*payments.Subscription), args.Bool(1), args.Error(2)
+}
+
+func (m *Repository) UpdatePaymentProviderEventStatus(ctx context.Context, id, status, processingError string) error {
+	return m.Called(ctx, id, status, processingError).Error(0)
+}
@@ -1,14 +1,19 @@
 package payments
 
-import "context"
+import (
+	"context"
+	"time"
+)
 
 // ParsedWebhookEvent holds the result of parsing a provider webhook payload.
 type ParsedWebhookEvent struct {
-	EventType      string // e.g. "subscription.updated", "INITIAL_PURCHASE"
-	AccountID      string // app_user_id, customer ID, etc.
-	SubscriptionID string // external subscription or transaction ID
-	ProductID      string // external product ID (e.g. StoreKit product_id for RevenueCat)
-	Status         string // subscription status when known from payload (e.g. "active", "cancelled")
+	OccurredAt     time.Time // when the provider says the event happened; zero when the payload doesn't say
+	EventID        string    // provider's unique event ID, used to deduplicate redeliveries
+	EventType      string    // e.g. "subscription.updated", "INITIAL_PURCHASE"
+	AccountID      string    // app_user_id, customer ID, etc.
+	SubscriptionID string    // external subscription or transaction ID
+	ProductID      string    // external product ID (e.g. StoreKit product_id for RevenueCat)
+	Status         string    // subscription status when known from payload (e.g. "active", "cancelled")
 }
 
 // PaymentProcessor defines the interface for payment provider webhook handling.
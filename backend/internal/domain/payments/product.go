@@ -24,56 +24,60 @@
 type (
 	// Product represents a purchasable item (subscription plan or one-time offering).
 	Product struct {
-		_                     struct{}   `json:"-"`
-		CreatedAt             time.Time  `json:"createdAt"`
-		BillingIntervalMonths *int32     `json:"billingIntervalMonths"`
-		LastUpdatedAt         *time.Time `json:"lastUpdatedAt"`
-		ArchivedAt            *time.Time `json:"archivedAt"`
-		ID                    string     `json:"id"`
-		Name                  string     `json:"name"`
-		Description           string     `json:"description"`
-		Kind                  string     `json:"kind"`
-		Currency              string     `json:"currency"`
-		ExternalProductID     string     `json:"externalProductId"`
-		AmountCents           int32      `json:"amountCents"`
+		_                     struct{}            `json:"-"`
+		CreatedAt             time.Time           `json:"createdAt"`
+		BillingIntervalMonths *int32              `json:"billingIntervalMonths"`
+		LastUpdatedAt         *time.Time          `json:"lastUpdatedAt"`
+		ArchivedAt            *time.Time          `json:"archivedAt"`
+		ID                    string              `json:"id"`
+		Name                  string              `json:"name"`
+		Description           string              `json:"description"`
+		Kind                  string              `json:"kind"`
+		Currency              string              `json:"currency"`
+		ExternalProductID     string              `json:"externalProductId"`
+		Entitlements          ProductEntitlements `json:"entitlements"`
+		AmountCents           int32               `json:"amountCents"`
 	}
 
 	// ProductCreationRequestInput represents input for creating a product.
 	ProductCreationRequestInput struct {
-		_                     struct{} `json:"-"`
-		BillingIntervalMonths *int32   `json:"billingIntervalMonths"`
-		Name                  string   `json:"name"`
-		Description           string   `json:"description"`
-		Kind                  string   `json:"kind"`
-		Currency              string   `json:"currency"`
-		ExternalProductID     string   `json:"externalProductId"`
-		AmountCents           int32    `json:"amountCents"`
+		_                     struct{}            `json:"-"`
+		BillingIntervalMonths *int32              `json:"billingIntervalMonths"`
+		Name                  string              `json:"name"`
+		Description           string              `json:"description"`
+		Kind                  string              `json:"kind"`
+		Currency              string              `json:"currency"`
+		ExternalProductID     string              `json:"externalProductId"`
+		Entitlements          ProductEntitlements `json:"entitlements"`
+		AmountCents           int32               `json:"amountCents"`
 	}
 
 	// ProductUpdateRequestInput represents input for updating a product.
 	ProductUpdateRequestInput struct {
 		_ struct{} `json:"-"`
 
-		Name                  *string `json:"name,omitempty"`
-		Description           *string `json:"description,omitempty"`
-		Kind                  *string `json:"kind,omitempty"`
-		AmountCents           *int32  `json:"amountCents,omitempty"`
-		Currency              *string `json:"currency,omitempty"`
-		BillingIntervalMonths *int32  `json:"billingIntervalMonths,omitempty"`
-		ExternalProductID     *string `json:"externalProductId,omitempty"`
+		Name                  *string              `json:"name,omitempty"`
+		Description           *string              `json:"description,omitempty"`
+		Kind                  *string              `json:"kind,omitempty"`
+		AmountCents           *int32               `json:"amountCents,omitempty"`
+		Currency              *string              `json:"currency,omitempty"`
+		BillingIntervalMonths *int32               `json:"billingIntervalMonths,omitempty"`
+		ExternalProductID     *string              `json:"externalProductId,omitempty"`
+		Entitlements          *ProductEntitlements `json:"entitlements,omitempty"`
 	}
 
 	// ProductDatabaseCreationInput is used for creating a product in the database.
 	ProductDatabaseCreationInput struct {
-		_                     struct{} `json:"-"`
-		BillingIntervalMonths *int32   `json:"-"`
-		ID                    string   `json:"-"`
-		Name                  string   `json:"-"`
-		Description           string   `json:"-"`
-		Kind                  string   `json:"-"`
-		Currency              string   `json:"-"`
-		ExternalProductID     string   `json:"-"`
-		AmountCents           int32    `json:"-"`
+		_                     struct{}            `json:"-"`
+		BillingIntervalMonths *int32              `json:"-"`
+		ID                    string              `json:"-"`
+		Name                  string              `json:"-"`
+		Description           string              `json:"-"`
+		Kind                  string              `json:"-"`
+		Currency              string              `json:"-"`
+		ExternalProductID     string              `json:"-"`
+		Entitlements          ProductEntitlements `json:"-"`
+		AmountCents           int32               `json:"-"`
 	}
 )
 
@@ -81,12 +85,27 @@
 
 // ValidateWithContext validates a ProductCreationRequestInput.
 func (x *ProductCreationRequestInput) ValidateWithContext(ctx context.Context) error {
-	return validation.ValidateStructWithContext(ctx, x,
+	if err := validation.ValidateStructWithContext(ctx, x,
 		validation.Field(&x.Name, validation.Required),
 		validation.Field(&x.Description, validation.Required),
 		validation.Field(&x.Kind, validation.Required, validation.In(ProductKindRecurring, ProductKindOneTime)),
 		validation.Field(&x.AmountCents, validation.Min(0)),
 		validation.Field(&x.Currency, validation.Required),
 		validation.Field(&x.BillingIntervalMonths, validation.When(x.Kind == ProductKindRecurring, validation.Required, validation.Min(1))),
+	); err != nil {
+		return err
+	}
+
+	return x.Entitlements.ValidateWithContext(ctx)
+}
+
+var _ validation.ValidatableWithContext = (*ProductEntitlements)(nil)
+
+// ValidateWithContext validates a ProductEntitlements.
+func (x *ProductEntitlements) ValidateWithContext(ctx context.Context) error {
+	return validation.ValidateStructWithContext(ctx, x,
+		validation.Field(&x.Features, validation.Each(validation.In(FeatureAIRecipeImport))),
+		validation.Field(&x.MaxMealPlansPerWeek, validation.Min(UnlimitedQuantity)),
+		validation.Field(&x.MaxAccountMembers, validation.Min(UnlimitedQuantity)),
 	)
 }
@@ -0,0 +1,63 @@
+package payments
+
+import (
+	"time"
+
+	platformerrors "github.com/primandproper/platform/errors"
+)
+
+const (
+	// PaymentProviderEventStatusReceived indicates a provider event was stored but has not finished processing.
+	PaymentProviderEventStatusReceived = "received"
+	// PaymentProviderEventStatusProcessing indicates a provider event has been claimed by a worker that is applying it.
+	PaymentProviderEventStatusProcessing = "processing"
+	// PaymentProviderEventStatusApplied indicates a provider event's state transition was applied.
+	PaymentProviderEventStatusApplied = "applied"
+	// PaymentProviderEventStatusStale indicates a provider event was older than the last applied event for its subscription.
+	PaymentProviderEventStatusStale = "stale"
+	// PaymentProviderEventStatusFailed indicates applying a provider event returned an error.
+	PaymentProviderEventStatusFailed = "failed"
+	// PaymentProviderEventStatusIgnored indicates a provider event called for no state change when it was processed,
+	// e.g. because the subscription it refers to didn't exist yet. Ignored events can be replayed.
+	PaymentProviderEventStatusIgnored = "ignored"
+
+	// PaymentProviderEventReplayedServiceEventType indicates a stored provider event was replayed.
+	PaymentProviderEventReplayedServiceEventType = "payment_provider_event_replayed"
+)
+
+var (
+	// ErrPaymentProviderEventInProgress is returned when a provider event is already being applied by another worker.
+	ErrPaymentProviderEventInProgress = platformerrors.New("payment provider event is already being processed")
+)
+
+type (
+	// PaymentProviderEvent is a webhook event received from a payment provider, kept for deduplication and replay.
+	PaymentProviderEvent struct {
+		_                      struct{}   `json:"-"`
+		OccurredAt             time.Time  `json:"occurredAt"`
+		CreatedAt              time.Time  `json:"createdAt"`
+		ProcessedAt            *time.Time `json:"processedAt"`
+		ID                     string     `json:"id"`
+		Provider               string     `json:"provider"`
+		ProviderEventID        string     `json:"providerEventId"`
+		EventType              string     `json:"eventType"`
+		ExternalSubscriptionID string     `json:"externalSubscriptionId"`
+		AccountID              string     `json:"accountId"`
+		Status                 string     `json:"status"`
+		ProcessingError        string     `json:"processingError"`
+		Payload                []byte     `json:"payload"`
+	}
+
+	// PaymentProviderEventDatabaseCreationInput is used for storing a provider event in the database.
+	PaymentProviderEventDatabaseCreationInput struct {
+		_                      struct{}  `json:"-"`
+		OccurredAt             time.Time `json:"-"`
+		ID                     string    `json:"-"`
+		Provider               string    `json:"-"`
+		ProviderEventID        string    `json:"-"`
+		EventType              string    `json:"-"`
+		ExternalSubscriptionID string    `json:"-"`
+		AccountID              string    `json:"-"`
+		Payload                []byte    `json:"-"`
+	}
+)
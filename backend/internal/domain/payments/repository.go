@@ -2,6 +2,7 @@
 
 import (
 	"context"
+	"time"
 
 	"github.com/primandproper/platform/database/filtering"
 )
@@ -20,6 +21,7 @@ type Repository interface {
 	GetSubscription(ctx context.Context, id string) (*Subscription, error)
 	GetSubscriptionByExternalID(ctx context.Context, externalID string) (*Subscription, error)
 	GetSubscriptionsForAccount(ctx context.Context, accountID string, filter *filtering.QueryFilter) (*filtering.QueryFilteredResult[Subscription], error)
+	GetSubscriptionsForAccountEndingAfter(ctx context.Context, accountID string, periodEndsAfter time.Time) ([]*Subscription, error)
 	UpdateSubscription(ctx context.Context, sub *Subscription) error
 	UpdateSubscriptionStatus(ctx context.Context, id, status string) error
 	ArchiveSubscription(ctx context.Context, id string) error
@@ -30,4 +32,13 @@ type Repository interface {
 
 	CreatePaymentTransaction(ctx context.Context, input *PaymentTransactionDatabaseCreationInput) (*PaymentTransaction, error)
 	GetPaymentTransactionsForAccount(ctx context.Context, accountID string, filter *filtering.QueryFilter) (*filtering.QueryFilteredResult[PaymentTransaction], error)
+
+	CreatePaymentProviderEvent(ctx context.Context, input *PaymentProviderEventDatabaseCreationInput) (bool, error)
+	GetPaymentProviderEvent(ctx context.Context, id string) (*PaymentProviderEvent, error)
+	GetPaymentProviderEventByProviderEventID(ctx context.Context, provider, providerEventID string) (*PaymentProviderEvent, error)
+	GetPaymentProviderEvents(ctx context.Context, provider string, filter *filtering.QueryFilter) (*filtering.QueryFilteredResult[PaymentProviderEvent], error)
+	GetLatestAppliedPaymentProviderEventTime(ctx context.Context, provider, externalSubscriptionID string) (*time.Time, error)
+	ClaimPaymentProviderEvent(ctx context.Context, id string, includeFinished bool) (*PaymentProviderEvent, error)
+	UpdateSubscriptionStatusForProviderEvent(ctx context.Context, event *PaymentProviderEvent, status string) (*Subscription, bool, error)
+	UpdatePaymentProviderEventStatus(ctx context.Context, id, status, processingError string) error
 }
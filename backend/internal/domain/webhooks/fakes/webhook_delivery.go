@@ -0,0 +1,50 @@
+package fakes
+
+import (
+	"fmt"
+	"net/http"
+
+	types "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/webhooks"
+
+	"github.com/primandproper/platform/database/filtering"
+	"github.com/primandproper/platform/pointer"
+
+	fake "github.com/brianvoe/gofakeit/v7"
+)
+
+// BuildFakeWebhookDelivery builds a faked WebhookDelivery.
+func BuildFakeWebhookDelivery() *types.WebhookDelivery {
+	return &types.WebhookDelivery{
+		ID:                    BuildFakeID(),
+		BelongsToWebhook:      BuildFakeID(),
+		BelongsToAccount:      BuildFakeID(),
+		RequestID:             BuildFakeID(),
+		EventID:               BuildFakeID(),
+		TriggerEvent:          BuildFakeID(),
+		AttemptNumber:         1,
+		Status:                types.WebhookDeliveryStatusSucceeded,
+		RequestBody:           fmt.Sprintf(`{"id":%q}`, fake.UUID()),
+		ResponseStatusCode:    pointer.To(uint16(http.StatusOK)),
+		ResponseBody:          fake.LoremIpsumSentence(5),
+		LatencyInMilliseconds: uint64(fake.Uint16()),
+		CreatedAt:             BuildFakeTime(),
+	}
+}
+
+// BuildFakeWebhookDeliveriesList builds a faked list of WebhookDeliveries.
+func BuildFakeWebhookDeliveriesList() *filtering.QueryFilteredResult[types.WebhookDelivery] {
+	var examples []*types.WebhookDelivery
+	for range exampleQuantity {
+		examples = append(examples, BuildFakeWebhookDelivery())
+	}
+
+	return &filtering.QueryFilteredResult[types.WebhookDelivery]{
+		Pagination: filtering.Pagination{
+			Cursor:          BuildFakeID(),
+			MaxResponseSize: 50,
+			FilteredCount:   exampleQuantity / 2,
+			TotalCount:      exampleQuantity,
+		},
+		Data: examples,
+	}
+}
@@ -9,4 +9,6 @@
 	WebhookTriggerConfigIDKey = "webhook_trigger_config" + idSuffix
 	// WebhookTriggerEventIDKey is the standard key for referring to a webhook trigger event's ID.
 	WebhookTriggerEventIDKey = "webhook_trigger_event" + idSuffix
+	// WebhookDeliveryIDKey is the standard key for referring to a webhook delivery's ID.
+	WebhookDeliveryIDKey = "webhook_delivery" + idSuffix
 )
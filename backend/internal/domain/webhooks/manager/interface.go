@@ -23,5 +23,7 @@
 		UpdateWebhookTriggerEvent(ctx context.Context, id string, input *webhooks.WebhookTriggerEventUpdateRequestInput) error
 		ArchiveWebhookTriggerEvent(ctx context.Context, id string) error
 		WebhookExists(ctx context.Context, webhookID, accountID string) (bool, error)
+		GetWebhookDeliveries(ctx context.Context, webhookID, accountID string, filter *filtering.QueryFilter) (*filtering.QueryFilteredResult[webhooks.WebhookDelivery], error)
+		RedeliverWebhookDelivery(ctx context.Context, webhookID, deliveryID, accountID string) (string, error)
 	}
 )
@@ -98,3 +98,16 @@ func (m *WebhookDataManager) ArchiveWebhookTriggerEvent(ctx context.Context, id
 	args := m.Called(ctx, id)
 	return args.Error(0)
 }
+
+func (m *WebhookDataManager) GetWebhookDeliveries(ctx context.Context, webhookID, accountID string, filter *filtering.QueryFilter) (*filtering.QueryFilteredResult[webhooks.WebhookDelivery], error) {
+	args := m.Called(ctx, webhookID, accountID, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*filtering.QueryFilteredResult[webhooks.WebhookDelivery]), args.Error(1)
+}
+
+func (m *WebhookDataManager) RedeliverWebhookDelivery(ctx context.Context, webhookID, deliveryID, accountID string) (string, error) {
+	args := m.Called(ctx, webhookID, deliveryID, accountID)
+	return args.String(0), args.Error(1)
+}
@@ -0,0 +1,63 @@
+package manager
+
+import (
+	"context"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/audit"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/webhooks"
+	webhookkeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/webhooks/keys"
+
+	"github.com/primandproper/platform/database/filtering"
+	platformerrors "github.com/primandproper/platform/errors"
+	"github.com/primandproper/platform/identifiers"
+	"github.com/primandproper/platform/observability"
+	"github.com/primandproper/platform/observability/tracing"
+)
+
+func (m *webhookManager) GetWebhookDeliveries(ctx context.Context, webhookID, accountID string, filter *filtering.QueryFilter) (*filtering.QueryFilteredResult[webhooks.WebhookDelivery], error) {
+	ctx, span := m.tracer.StartSpan(ctx)
+	defer span.End()
+
+	return m.repo.GetWebhookDeliveries(ctx, webhookID, accountID, filter)
+}
+
+// RedeliverWebhookDelivery enqueues a fresh delivery of a previously attempted payload, and returns the new request ID.
+// The redelivery keeps the original event ID, so receivers can recognize an event they've already handled.
+func (m *webhookManager) RedeliverWebhookDelivery(ctx context.Context, webhookID, deliveryID, accountID string) (string, error) {
+	ctx, span := m.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if webhookID == "" || deliveryID == "" || accountID == "" {
+		return "", platformerrors.ErrInvalidIDProvided
+	}
+
+	logger := m.logger.WithSpan(span).WithValue(webhookkeys.WebhookIDKey, webhookID).WithValue(webhookkeys.WebhookDeliveryIDKey, deliveryID)
+	tracing.AttachToSpan(span, webhookkeys.WebhookIDKey, webhookID)
+	tracing.AttachToSpan(span, webhookkeys.WebhookDeliveryIDKey, deliveryID)
+
+	delivery, err := m.repo.GetWebhookDelivery(ctx, webhookID, deliveryID, accountID)
+	if err != nil {
+		return "", observability.PrepareError(err, span, "fetching webhook delivery")
+	}
+
+	executionRequest := &webhooks.WebhookExecutionRequest{
+		RequestID:     identifiers.New(),
+		EventID:       delivery.EventID,
+		WebhookID:     delivery.BelongsToWebhook,
+		AccountID:     delivery.BelongsToAccount,
+		TriggerEvent:  delivery.TriggerEvent,
+		RawPayload:    []byte(delivery.RequestBody),
+		AttemptNumber: 1,
+	}
+
+	if err = m.webhookExecutionRequestsPublisher.Publish(ctx, executionRequest); err != nil {
+		return "", observability.PrepareAndLogError(err, logger, span, "publishing webhook execution request")
+	}
+
+	m.dataChangesPublisher.PublishAsync(ctx, audit.BuildDataChangeMessageFromContext(ctx, logger, webhooks.WebhookDeliveryRedeliveryRequestedServiceEventType, map[string]any{
+		webhookkeys.WebhookIDKey:         webhookID,
+		webhookkeys.WebhookDeliveryIDKey: deliveryID,
+	}))
+
+	return executionRequest.RequestID, nil
+}
@@ -0,0 +1,125 @@
+package manager
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/webhooks"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/webhooks/fakes"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/testutils"
+
+	"github.com/primandproper/platform/database/filtering"
+	mockpublishers "github.com/primandproper/platform/messagequeue/mock"
+	"github.com/primandproper/platform/reflection"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWebhookDataManager_GetWebhookDeliveries(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		manager, repo := buildWebhookManagerForTest(t)
+
+		webhookID := "wh-1"
+		accountID := "account-1"
+		filter := filtering.DefaultQueryFilter()
+		expected := fakes.BuildFakeWebhookDeliveriesList()
+		repo.On(reflection.GetMethodName(repo.GetWebhookDeliveries), testutils.ContextMatcher, webhookID, accountID, filter).Return(expected, nil)
+
+		result, err := manager.GetWebhookDeliveries(ctx, webhookID, accountID, filter)
+
+		require.NoError(t, err)
+		assert.Equal(t, expected, result)
+		mock.AssertExpectationsForObjects(t, repo)
+	})
+}
+
+func TestWebhookDataManager_RedeliverWebhookDelivery(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		manager, repo := buildWebhookManagerForTest(t)
+
+		delivery := fakes.BuildFakeWebhookDelivery()
+		repo.On(reflection.GetMethodName(repo.GetWebhookDelivery), testutils.ContextMatcher, delivery.BelongsToWebhook, delivery.ID, delivery.BelongsToAccount).Return(delivery, nil)
+
+		var published *webhooks.WebhookExecutionRequest
+		manager.webhookExecutionRequestsPublisher = &mockpublishers.PublisherMock{
+			PublishFunc: func(_ context.Context, msg any) error {
+				published = msg.(*webhooks.WebhookExecutionRequest)
+				return nil
+			},
+		}
+
+		requestID, err := manager.RedeliverWebhookDelivery(ctx, delivery.BelongsToWebhook, delivery.ID, delivery.BelongsToAccount)
+
+		require.NoError(t, err)
+		require.NotNil(t, published)
+		assert.Equal(t, requestID, published.RequestID)
+		assert.NotEqual(t, delivery.RequestID, published.RequestID)
+		assert.Equal(t, delivery.EventID, published.EventID)
+		assert.Equal(t, delivery.BelongsToWebhook, published.WebhookID)
+		assert.Equal(t, delivery.BelongsToAccount, published.AccountID)
+		assert.Equal(t, []byte(delivery.RequestBody), published.RawPayload)
+		assert.Equal(t, uint16(1), published.AttemptNumber)
+		mock.AssertExpectationsForObjects(t, repo)
+	})
+
+	t.Run("with invalid IDs", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		manager, _ := buildWebhookManagerForTest(t)
+
+		requestID, err := manager.RedeliverWebhookDelivery(ctx, "", "", "")
+
+		assert.Error(t, err)
+		assert.Empty(t, requestID)
+	})
+
+	t.Run("with error fetching delivery", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		manager, repo := buildWebhookManagerForTest(t)
+
+		delivery := fakes.BuildFakeWebhookDelivery()
+		repo.On(reflection.GetMethodName(repo.GetWebhookDelivery), testutils.ContextMatcher, delivery.BelongsToWebhook, delivery.ID, delivery.BelongsToAccount).Return((*webhooks.WebhookDelivery)(nil), errors.New("blah"))
+
+		requestID, err := manager.RedeliverWebhookDelivery(ctx, delivery.BelongsToWebhook, delivery.ID, delivery.BelongsToAccount)
+
+		assert.Error(t, err)
+		assert.Empty(t, requestID)
+		mock.AssertExpectationsForObjects(t, repo)
+	})
+
+	t.Run("with error publishing", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		manager, repo := buildWebhookManagerForTest(t)
+
+		delivery := fakes.BuildFakeWebhookDelivery()
+		repo.On(reflection.GetMethodName(repo.GetWebhookDelivery), testutils.ContextMatcher, delivery.BelongsToWebhook, delivery.ID, delivery.BelongsToAccount).Return(delivery, nil)
+
+		manager.webhookExecutionRequestsPublisher = &mockpublishers.PublisherMock{
+			PublishFunc: func(_ context.Context, _ any) error { return errors.New("blah") },
+		}
+
+		requestID, err := manager.RedeliverWebhookDelivery(ctx, delivery.BelongsToWebhook, delivery.ID, delivery.BelongsToAccount)
+
+		assert.Error(t, err)
+		assert.Empty(t, requestID)
+		mock.AssertExpectationsForObjects(t, repo)
+	})
+}
@@ -26,10 +26,11 @@
 var _ WebhookDataManager = (*webhookManager)(nil)
 
 type webhookManager struct {
-	tracer               tracing.Tracer
-	logger               logging.Logger
-	repo                 webhooks.Repository
-	dataChangesPublisher messagequeue.Publisher
+	tracer                            tracing.Tracer
+	logger                            logging.Logger
+	repo                              webhooks.Repository
+	dataChangesPublisher              messagequeue.Publisher
+	webhookExecutionRequestsPublisher messagequeue.Publisher
 }
 
 // NewWebhookDataManager returns a new WebhookDataManager that delegates to the webhooks repository.
@@ -46,11 +47,17 @@ func NewWebhookDataManager(
 		return nil, fmt.Errorf("failed to provide publisher for data changes topic: %w", err)
 	}
 
+	webhookExecutionRequestsPublisher, err := publisherProvider.ProvidePublisher(ctx, cfg.WebhookExecutionRequestsTopicName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to provide publisher for webhook execution requests topic: %w", err)
+	}
+
 	return &webhookManager{
-		tracer:               tracing.NewNamedTracer(tracerProvider, o11yName),
-		logger:               logging.NewNamedLogger(logger, o11yName),
-		repo:                 repo,
-		dataChangesPublisher: dataChangesPublisher,
+		tracer:                            tracing.NewNamedTracer(tracerProvider, o11yName),
+		logger:                            logging.NewNamedLogger(logger, o11yName),
+		repo:                              repo,
+		dataChangesPublisher:              dataChangesPublisher,
+		webhookExecutionRequestsPublisher: webhookExecutionRequestsPublisher,
 	}, nil
 }
 
@@ -117,3 +117,39 @@ func (m *Repository) ArchiveWebhookTriggerEvent(ctx context.Context, id string)
 	args := m.Called(ctx, id)
 	return args.Error(0)
 }
+
+// CreateWebhookDelivery is a mock function.
+func (m *Repository) CreateWebhookDelivery(ctx context.Context, input *webhooks.WebhookDeliveryDatabaseCreationInput) (*webhooks.WebhookDelivery, error) {
+	args := m.Called(ctx, input)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*webhooks.WebhookDelivery), args.Error(1)
+}
+
+// GetWebhookDelivery is a mock function.
+func (m *Repository) GetWebhookDelivery(ctx context.Context, webhookID, deliveryID, accountID string) (*webhooks.WebhookDelivery, error) {
+	args := m.Called(ctx, webhookID, deliveryID, accountID)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*webhooks.WebhookDelivery), args.Error(1)
+}
+
+// GetWebhookDeliveries is a mock function.
+func (m *Repository) GetWebhookDeliveries(ctx context.Context, webhookID, accountID string, filter *filtering.QueryFilter) (*filtering.QueryFilteredResult[webhooks.WebhookDelivery], error) {
+	args := m.Called(ctx, webhookID, accountID, filter)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).(*filtering.QueryFilteredResult[webhooks.WebhookDelivery]), args.Error(1)
+}
+
+// ClaimDueWebhookDeliveries is a mock function.
+func (m *Repository) ClaimDueWebhookDeliveries(ctx context.Context, limit uint8) ([]*webhooks.WebhookDelivery, error) {
+	args := m.Called(ctx, limit)
+	if args.Get(0) == nil {
+		return nil, args.Error(1)
+	}
+	return args.Get(0).([]*webhooks.WebhookDelivery), args.Error(1)
+}
@@ -136,12 +136,15 @@
 	WebhookExecutionRequest struct {
 		_ struct{} `json:"-"`
 
-		RequestID    string `json:"id"`
-		Payload      any    `json:"payload"`
-		WebhookID    string `json:"webhookID"`
-		AccountID    string `json:"accountID"`
-		TriggerEvent string `json:"triggerEvent"` // catalog event ID
-		TestID       string `json:"testID,omitempty"`
+		Payload       any    `json:"payload"`
+		RequestID     string `json:"id"`
+		EventID       string `json:"eventID"` // shared by every delivery of the same event, including retries and redeliveries
+		WebhookID     string `json:"webhookID"`
+		AccountID     string `json:"accountID"`
+		TriggerEvent  string `json:"triggerEvent"` // catalog event ID
+		TestID        string `json:"testID,omitempty"`
+		RawPayload    []byte `json:"rawPayload,omitempty"` // pre-encoded body, set on retries and redeliveries
+		AttemptNumber uint16 `json:"attemptNumber,omitempty"`
 	}
 
 	// WebhookDataManager describes a structure capable of storing and retrieving webhooks and trigger events.
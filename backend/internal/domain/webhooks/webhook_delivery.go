@@ -0,0 +1,133 @@
+package webhooks
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/primandproper/platform/database/filtering"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+const (
+	// WebhookDeliveryStatusSucceeded indicates a delivery attempt received a 2xx response.
+	WebhookDeliveryStatusSucceeded = "succeeded"
+	// WebhookDeliveryStatusFailed indicates a delivery attempt failed and will be retried.
+	WebhookDeliveryStatusFailed = "failed"
+	// WebhookDeliveryStatusDeadLettered indicates a delivery attempt failed and no further attempts will be made.
+	WebhookDeliveryStatusDeadLettered = "dead_lettered"
+
+	// WebhookDeliveryRedeliveryRequestedServiceEventType indicates a webhook delivery was manually redelivered.
+	WebhookDeliveryRedeliveryRequestedServiceEventType = "webhook_delivery_redelivery_requested"
+
+	// DefaultWebhookDeliveryMaxAttempts is how many times a delivery is attempted before it is dead-lettered.
+	DefaultWebhookDeliveryMaxAttempts = 8
+	// WebhookDeliveryResponseBodyMaxLength is the maximum number of response body bytes persisted per attempt.
+	WebhookDeliveryResponseBodyMaxLength = 4096
+
+	// WebhookDeliveryRetryLease is how long a retry claimed by the retrier is held before it's claimed again,
+	// in case the execution request it was published as is lost.
+	WebhookDeliveryRetryLease = 15 * time.Minute
+	// WebhookDeliveryRetentionPeriod is how long delivery attempts are kept once no retry is pending.
+	WebhookDeliveryRetentionPeriod = 30 * 24 * time.Hour
+
+	webhookDeliveryBaseBackoff = 30 * time.Second
+	webhookDeliveryMaxBackoff  = 2 * time.Hour
+)
+
+type (
+	// WebhookDelivery is a record of a single attempt to deliver a webhook.
+	WebhookDelivery struct {
+		_ struct{} `json:"-"`
+
+		CreatedAt             time.Time  `json:"createdAt"`
+		NextAttemptAt         *time.Time `json:"nextAttemptAt"`
+		ResponseStatusCode    *uint16    `json:"responseStatusCode"`
+		ID                    string     `json:"id"`
+		BelongsToWebhook      string     `json:"belongsToWebhook"`
+		BelongsToAccount      string     `json:"belongsToAccount"`
+		RequestID             string     `json:"requestID"`
+		EventID               string     `json:"eventID"`
+		TriggerEvent          string     `json:"triggerEvent"`
+		Status                string     `json:"status"`
+		RequestBody           string     `json:"requestBody"`
+		ResponseBody          string     `json:"responseBody"`
+		ErrorMessage          string     `json:"errorMessage"`
+		LatencyInMilliseconds uint64     `json:"latencyInMilliseconds"`
+		AttemptNumber         uint16     `json:"attemptNumber"`
+	}
+
+	// WebhookDeliveryDatabaseCreationInput is used for recording a webhook delivery attempt.
+	WebhookDeliveryDatabaseCreationInput struct {
+		_ struct{} `json:"-"`
+
+		NextAttemptAt         *time.Time `json:"-"`
+		ResponseStatusCode    *uint16    `json:"-"`
+		ID                    string     `json:"-"`
+		BelongsToWebhook      string     `json:"-"`
+		BelongsToAccount      string     `json:"-"`
+		RequestID             string     `json:"-"`
+		EventID               string     `json:"-"`
+		TriggerEvent          string     `json:"-"`
+		Status                string     `json:"-"`
+		RequestBody           string     `json:"-"`
+		ResponseBody          string     `json:"-"`
+		ErrorMessage          string     `json:"-"`
+		LatencyInMilliseconds uint64     `json:"-"`
+		AttemptNumber         uint16     `json:"-"`
+	}
+
+	// WebhookDeliveryDataManager describes a structure capable of storing and retrieving webhook delivery attempts.
+	WebhookDeliveryDataManager interface {
+		CreateWebhookDelivery(ctx context.Context, input *WebhookDeliveryDatabaseCreationInput) (*WebhookDelivery, error)
+		GetWebhookDelivery(ctx context.Context, webhookID, deliveryID, accountID string) (*WebhookDelivery, error)
+		GetWebhookDeliveries(ctx context.Context, webhookID, accountID string, filter *filtering.QueryFilter) (*filtering.QueryFilteredResult[WebhookDelivery], error)
+		// ClaimDueWebhookDeliveries leases up to limit failed deliveries whose retry is due, so that no other
+		// caller claims them again until WebhookDeliveryRetryLease has passed.
+		ClaimDueWebhookDeliveries(ctx context.Context, limit uint8) ([]*WebhookDelivery, error)
+	}
+)
+
+var _ validation.ValidatableWithContext = (*WebhookDeliveryDatabaseCreationInput)(nil)
+
+// ValidateWithContext validates a WebhookDeliveryDatabaseCreationInput.
+func (w *WebhookDeliveryDatabaseCreationInput) ValidateWithContext(ctx context.Context) error {
+	return validation.ValidateStructWithContext(ctx, w,
+		validation.Field(&w.ID, validation.Required),
+		validation.Field(&w.BelongsToWebhook, validation.Required),
+		validation.Field(&w.BelongsToAccount, validation.Required),
+		validation.Field(&w.RequestID, validation.Required),
+		validation.Field(&w.EventID, validation.Required),
+		validation.Field(&w.AttemptNumber, validation.Required),
+		validation.Field(&w.Status, validation.Required, validation.In(WebhookDeliveryStatusSucceeded, WebhookDeliveryStatusFailed, WebhookDeliveryStatusDeadLettered)),
+	)
+}
+
+// WebhookDeliveryBackoff returns how long to wait before making the attempt after the given (failed) attempt number.
+// The delay doubles with every attempt, starting at 30 seconds and capped at two hours.
+func WebhookDeliveryBackoff(attemptNumber uint16) time.Duration {
+	if attemptNumber == 0 {
+		attemptNumber = 1
+	}
+
+	backoff := webhookDeliveryBaseBackoff
+	for range attemptNumber - 1 {
+		backoff *= 2
+		if backoff >= webhookDeliveryMaxBackoff {
+			return webhookDeliveryMaxBackoff
+		}
+	}
+
+	return backoff
+}
+
+// TruncateWebhookDeliveryResponseBody trims a response body to the persisted maximum length.
+// Invalid UTF-8 (including a rune split by the truncation) is dropped, since the column is TEXT.
+func TruncateWebhookDeliveryResponseBody(body []byte) string {
+	if len(body) > WebhookDeliveryResponseBodyMaxLength {
+		body = body[:WebhookDeliveryResponseBodyMaxLength]
+	}
+
+	return strings.ToValidUTF8(string(body), "")
+}
@@ -3,7 +3,9 @@
 import (
 	"fmt"
 	"net/http"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 )
@@ -110,3 +112,77 @@ func TestWebhookDatabaseCreationInput_ValidateWithContext(T *testing.T) {
 		assert.NoError(t, x.ValidateWithContext(ctx))
 	})
 }
+
+func TestWebhookDeliveryDatabaseCreationInput_ValidateWithContext(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		x := &WebhookDeliveryDatabaseCreationInput{
+			ID:               "delivery",
+			BelongsToWebhook: "webhook",
+			BelongsToAccount: "account",
+			RequestID:        "request",
+			EventID:          "event",
+			AttemptNumber:    1,
+			Status:           WebhookDeliveryStatusFailed,
+		}
+
+		assert.NoError(t, x.ValidateWithContext(t.Context()))
+	})
+
+	T.Run("invalid status", func(t *testing.T) {
+		t.Parallel()
+
+		x := &WebhookDeliveryDatabaseCreationInput{
+			ID:               "delivery",
+			BelongsToWebhook: "webhook",
+			BelongsToAccount: "account",
+			RequestID:        "request",
+			EventID:          "event",
+			AttemptNumber:    1,
+			Status:           "fine",
+		}
+
+		assert.Error(t, x.ValidateWithContext(t.Context()))
+	})
+}
+
+func TestWebhookDeliveryBackoff(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, 30*time.Second, WebhookDeliveryBackoff(0))
+		assert.Equal(t, 30*time.Second, WebhookDeliveryBackoff(1))
+		assert.Equal(t, time.Minute, WebhookDeliveryBackoff(2))
+		assert.Equal(t, 4*time.Minute, WebhookDeliveryBackoff(4))
+		assert.Equal(t, 2*time.Hour, WebhookDeliveryBackoff(50))
+	})
+}
+
+func TestTruncateWebhookDeliveryResponseBody(T *testing.T) {
+	T.Parallel()
+
+	T.Run("short body", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, "hello", TruncateWebhookDeliveryResponseBody([]byte("hello")))
+	})
+
+	T.Run("long body", func(t *testing.T) {
+		t.Parallel()
+
+		body := strings.Repeat("a", WebhookDeliveryResponseBodyMaxLength*2)
+		assert.Len(t, TruncateWebhookDeliveryResponseBody([]byte(body)), WebhookDeliveryResponseBodyMaxLength)
+	})
+
+	T.Run("drops runes split by truncation", func(t *testing.T) {
+		t.Parallel()
+
+		body := strings.Repeat("a", WebhookDeliveryResponseBodyMaxLength-1) + "é"
+		assert.Equal(t, strings.Repeat("a", WebhookDeliveryResponseBodyMaxLength-1), TruncateWebhookDeliveryResponseBody([]byte(body)))
+	})
+}
@@ -9,6 +9,7 @@
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/config"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/audit"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/auth"
+	domaincomments "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/comments"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/dataprivacy"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/internalops"
@@ -89,6 +90,7 @@ type AsyncDataChangeMessageHandler struct {
 	badDeviceTokensArchivedCounter            metrics.Int64Counter
 	pushNotificationsSentCounter              metrics.Int64Counter
 	mealPlanRepo                              mealplanning.Repository
+	commentsRepo                              domaincomments.Repository
 	passwordResetTokenDataManager             auth.PasswordResetTokenDataManager
 	notificationsRepo                         notificationsmanager.NotificationsDataManager
 	pushNotificationSender                    platformnotifications.PushNotificationSender
@@ -143,6 +145,7 @@ func NewAsyncDataChangeMessageHandler(
 	passwordResetTokenDataManager auth.PasswordResetTokenDataManager,
 	notificationsRepo notificationsmanager.NotificationsDataManager,
 	pushNotificationSender platformnotifications.PushNotificationSender,
+	commentsRepo domaincomments.Repository,
 ) (*AsyncDataChangeMessageHandler, error) {
 	dataChangesExecutionTimeHistogram, err := metricsProvider.NewFloat64Histogram("data_changes_execution_time")
 	if err != nil {
@@ -266,6 +269,7 @@ func NewAsyncDataChangeMessageHandler(
 		passwordResetTokenDataManager:             passwordResetTokenDataManager,
 		notificationsRepo:                         notificationsRepo,
 		pushNotificationSender:                    pushNotificationSender,
+		commentsRepo:                              commentsRepo,
 		baseURL:                                   cfg.BaseURL,
 	}
 
@@ -6,6 +6,7 @@
 
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/config"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/auth"
+	commentsmock "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/comments/mock"
 	dataprivacymock "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/dataprivacy/mock"
 	identitymock "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity/mock"
 	internalopsmock "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/internalops/mock"
@@ -97,6 +98,7 @@ func buildTestAsyncDataChangeMessageHandler(t *testing.T) (*AsyncDataChangeMessa
 	mealPlanRepo := &mealplanningmock.Repository{}
 	notificationsRepo := &notificationsmock.Repository{}
 	pushNotificationSender := noopnotifications.NewPushNotificationSender()
+	commentsRepo := &commentsmock.Repository{}
 
 	handler := &AsyncDataChangeMessageHandler{
 		identityRepo:                         identityRepo,
@@ -137,6 +139,7 @@ func buildTestAsyncDataChangeMessageHandler(t *testing.T) (*AsyncDataChangeMessa
 		passwordResetTokenDataManager:    noopPasswordResetTokenDataManager{},
 		notificationsRepo:                notificationsRepo,
 		pushNotificationSender:           pushNotificationSender,
+		commentsRepo:                     commentsRepo,
 	}
 
 	handler.searchIndexHandlers = []SearchIndexEventHandler{
@@ -207,6 +210,7 @@ func TestNewAsyncDataChangeMessageHandler(t *testing.T) {
 		prtManager := noopPasswordResetTokenDataManager{}
 		notificationsRepo := &notificationsmock.Repository{}
 		pushNotificationSender := noopnotifications.NewPushNotificationSender()
+		commentsRepo := &commentsmock.Repository{}
 
 		handler, err := NewAsyncDataChangeMessageHandler(
 			ctx,
@@ -230,6 +234,7 @@ func TestNewAsyncDataChangeMessageHandler(t *testing.T) {
 			prtManager,
 			notificationsRepo,
 			pushNotificationSender,
+			commentsRepo,
 		)
 
 		assert.NoError(t, err)
@@ -10,6 +10,7 @@
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/audit"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/webhooks"
 
+	"github.com/primandproper/platform/identifiers"
 	"github.com/primandproper/platform/observability"
 
 	"go.opentelemetry.io/otel/attribute"
@@ -97,11 +98,17 @@ func (a *AsyncDataChangeMessageHandler) handleDataChangeMessage(
 				return
 			}
 
+			// every webhook listening for this event is sent the same event ID.
+			eventID := identifiers.New()
 			for _, webhook := range relevantWebhooks {
 				if err = a.webhookExecutionRequestPublisher.Publish(ctx, &webhooks.WebhookExecutionRequest{
-					WebhookID: webhook.ID,
-					AccountID: changeMessage.AccountID,
-					Payload:   changeMessage,
+					RequestID:     identifiers.New(),
+					EventID:       eventID,
+					WebhookID:     webhook.ID,
+					AccountID:     changeMessage.AccountID,
+					TriggerEvent:  changeMessage.EventType,
+					AttemptNumber: 1,
+					Payload:       changeMessage,
 				}); err != nil {
 					observability.AcknowledgeError(err, logger, span, "publishing webhook execution request")
 				}
@@ -121,6 +128,18 @@ func (a *AsyncDataChangeMessageHandler) handleDataChangeMessage(
 		}
 	})
 
+	wg.Go(func() {
+		if err := a.handleMealPlanActivity(ctx, changeMessage); err != nil {
+			observability.AcknowledgeError(err, logger, span, "recording meal plan activity")
+		}
+	})
+
+	wg.Go(func() {
+		if err := a.handleInAppNotifications(ctx, changeMessage); err != nil {
+			observability.AcknowledgeError(err, logger, span, "creating in-app notifications")
+		}
+	})
+
 	wg.Wait()
 
 	return nil
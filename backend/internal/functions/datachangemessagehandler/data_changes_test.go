@@ -13,6 +13,9 @@
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
 	mealplanningfakes "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/fakes"
 	mealplanningkeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/keys"
+	mealplanningmock "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/mocks"
+	domainnotifications "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/notifications"
+	notificationsmock "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/notifications/mock"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/webhooks"
 	webhooksfakes "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/webhooks/fakes"
 	identityindexing "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/services/identity/indexing"
@@ -24,6 +27,7 @@
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 func TestAsyncDataChangeMessageHandler_DataChangesEventHandler(t *testing.T) {
@@ -394,3 +398,172 @@ func TestAsyncDataChangeMessageHandler_handleOutboundNotifications(T *testing.T)
 		mock.AssertExpectationsForObjects(t, identityRepo)
 	})
 }
+
+func TestAsyncDataChangeMessageHandler_handleMealPlanCreatedNotification(T *testing.T) {
+	T.Run("honors each member's email preference", func(t *testing.T) {
+		// Set environment variable needed for email configuration
+		t.Setenv("DINNER_DONE_BETTER_SERVICE_ENVIRONMENT", "testing")
+
+		handler, identityRepo, _, _, _, _, _, _, _, _, _ := buildTestAsyncDataChangeMessageHandler(t)
+		mealPlanRepo := &mealplanningmock.Repository{}
+		notificationsRepo := &notificationsmock.Repository{}
+		handler.mealPlanRepo = mealPlanRepo
+		handler.notificationsRepo = notificationsRepo
+
+		ctx := t.Context()
+
+		account := identityfakes.BuildFakeAccount()
+		account.Members = account.Members[:0]
+		for range 3 {
+			membership := identityfakes.BuildFakeAccountUserMembershipWithUser()
+			membership.BelongsToUser.EmailAddressVerifiedAt = new(membership.BelongsToUser.CreatedAt)
+			account.Members = append(account.Members, membership)
+		}
+		immediate, digested, optedOut := account.Members[0].BelongsToUser, account.Members[1].BelongsToUser, account.Members[2].BelongsToUser
+
+		mealPlan := mealplanningfakes.BuildFakeMealPlan()
+		mealPlan.BelongsToAccount = account.ID
+
+		dataChangeMessage := &audit.DataChangeMessage{
+			EventType: mealplanning.MealPlanCreatedServiceEventType,
+			UserID:    immediate.ID,
+			AccountID: account.ID,
+			Context: map[string]any{
+				mealplanningkeys.MealPlanIDKey: mealPlan.ID,
+			},
+		}
+
+		digestPreference := domainnotifications.DefaultNotificationPreference(digested.ID, domainnotifications.NotificationTypeMealPlanCreated)
+		digestPreference.DigestMode = domainnotifications.NotificationDigestModeDaily
+
+		optedOutPreference := domainnotifications.DefaultNotificationPreference(optedOut.ID, domainnotifications.NotificationTypeMealPlanCreated)
+		optedOutPreference.EmailEnabled = false
+
+		mealPlanRepo.On(reflection.GetMethodName(mealPlanRepo.GetMealPlan), mock.Anything, mealPlan.ID, account.ID).Return(mealPlan, nil).Once()
+		identityRepo.On(reflection.GetMethodName(identityRepo.GetAccount), mock.Anything, account.ID).Return(account, nil).Once()
+		notificationsRepo.On(reflection.GetMethodName(notificationsRepo.GetNotificationPreference), mock.Anything, immediate.ID, domainnotifications.NotificationTypeMealPlanCreated).Return(domainnotifications.DefaultNotificationPreference(immediate.ID, domainnotifications.NotificationTypeMealPlanCreated), nil).Once()
+		notificationsRepo.On(reflection.GetMethodName(notificationsRepo.GetNotificationPreference), mock.Anything, digested.ID, domainnotifications.NotificationTypeMealPlanCreated).Return(digestPreference, nil).Once()
+		notificationsRepo.On(reflection.GetMethodName(notificationsRepo.GetNotificationPreference), mock.Anything, optedOut.ID, domainnotifications.NotificationTypeMealPlanCreated).Return(optedOutPreference, nil).Once()
+		notificationsRepo.On(
+			reflection.GetMethodName(notificationsRepo.CreateQueuedNotification),
+			mock.Anything,
+			mock.MatchedBy(func(input *domainnotifications.QueuedNotificationDatabaseCreationInput) bool {
+				return input.BelongsToUser == digested.ID &&
+					input.NotificationType == domainnotifications.NotificationTypeMealPlanCreated &&
+					!input.DeliverAfter.IsZero()
+			}),
+		).Return(&domainnotifications.QueuedNotification{}, nil).Once()
+
+		messages, err := handler.handleMealPlanCreatedNotification(ctx, dataChangeMessage)
+		assert.NoError(t, err)
+		require.Len(t, messages, 1)
+		assert.Equal(t, immediate.EmailAddress, messages[0].ToAddress)
+
+		mock.AssertExpectationsForObjects(t, identityRepo, mealPlanRepo, notificationsRepo)
+	})
+}
+
+func TestAsyncDataChangeMessageHandler_handleMealPlanActivity(t *testing.T) {
+	t.Parallel()
+
+	t.Run("records watched meal plan events", func(t *testing.T) {
+		t.Parallel()
+
+		handler, _, _, _, _, _, _, _, _, _, _ := buildTestAsyncDataChangeMessageHandler(t)
+		mealPlanRepo := &mealplanningmock.Repository{}
+		handler.mealPlanRepo = mealPlanRepo
+
+		ctx := t.Context()
+		mealPlanID := mealplanningfakes.BuildFakeID()
+		mealPlanTaskID := mealplanningfakes.BuildFakeID()
+
+		dataChangeMessage := &audit.DataChangeMessage{
+			EventType: mealplanning.MealPlanTaskStatusChangedServiceEventType,
+			UserID:    "test-user-id",
+			AccountID: "test-account-id",
+			Context: map[string]any{
+				mealplanningkeys.MealPlanIDKey:     mealPlanID,
+				mealplanningkeys.MealPlanTaskIDKey: mealPlanTaskID,
+			},
+		}
+
+		mealPlanRepo.On(
+			reflection.GetMethodName(mealPlanRepo.CreateMealPlanActivity),
+			mock.Anything,
+			mock.MatchedBy(func(input *mealplanning.MealPlanActivityDatabaseCreationInput) bool {
+				return input.ID != "" &&
+					input.EventType == dataChangeMessage.EventType &&
+					input.BelongsToMealPlan == mealPlanID &&
+					input.MealPlanTaskID == mealPlanTaskID &&
+					input.ByUser == dataChangeMessage.UserID
+			}),
+		).Return(nil).Once()
+
+		assert.NoError(t, handler.handleMealPlanActivity(ctx, dataChangeMessage))
+
+		mock.AssertExpectationsForObjects(t, mealPlanRepo)
+	})
+
+	t.Run("skips events without a meal plan ID", func(t *testing.T) {
+		t.Parallel()
+
+		handler, _, _, _, _, _, _, _, _, _, _ := buildTestAsyncDataChangeMessageHandler(t)
+		mealPlanRepo := &mealplanningmock.Repository{}
+		handler.mealPlanRepo = mealPlanRepo
+
+		ctx := t.Context()
+
+		dataChangeMessage := &audit.DataChangeMessage{
+			EventType: mealplanning.MealPlanOptionVoteCreatedServiceEventType,
+			Context:   map[string]any{},
+		}
+
+		assert.NoError(t, handler.handleMealPlanActivity(ctx, dataChangeMessage))
+
+		mock.AssertExpectationsForObjects(t, mealPlanRepo)
+	})
+
+	t.Run("skips unwatched events", func(t *testing.T) {
+		t.Parallel()
+
+		handler, _, _, _, _, _, _, _, _, _, _ := buildTestAsyncDataChangeMessageHandler(t)
+		mealPlanRepo := &mealplanningmock.Repository{}
+		handler.mealPlanRepo = mealPlanRepo
+
+		ctx := t.Context()
+
+		dataChangeMessage := &audit.DataChangeMessage{
+			EventType: mealplanning.RecipeCreatedServiceEventType,
+			Context: map[string]any{
+				mealplanningkeys.MealPlanIDKey: mealplanningfakes.BuildFakeID(),
+			},
+		}
+
+		assert.NoError(t, handler.handleMealPlanActivity(ctx, dataChangeMessage))
+
+		mock.AssertExpectationsForObjects(t, mealPlanRepo)
+	})
+
+	t.Run("with error recording activity", func(t *testing.T) {
+		t.Parallel()
+
+		handler, _, _, _, _, _, _, _, _, _, _ := buildTestAsyncDataChangeMessageHandler(t)
+		mealPlanRepo := &mealplanningmock.Repository{}
+		handler.mealPlanRepo = mealPlanRepo
+
+		ctx := t.Context()
+
+		dataChangeMessage := &audit.DataChangeMessage{
+			EventType: mealplanning.MealPlanFinalizedServiceEventType,
+			Context: map[string]any{
+				mealplanningkeys.MealPlanIDKey: mealplanningfakes.BuildFakeID(),
+			},
+		}
+
+		mealPlanRepo.On(reflection.GetMethodName(mealPlanRepo.CreateMealPlanActivity), mock.Anything, mock.Anything).Return(errors.New("blah")).Once()
+
+		assert.Error(t, handler.handleMealPlanActivity(ctx, dataChangeMessage))
+
+		mock.AssertExpectationsForObjects(t, mealPlanRepo)
+	})
+}
@@ -5,6 +5,7 @@
 
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/config"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/auth"
+	domaincomments "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/comments"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/dataprivacy"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/internalops"
@@ -52,6 +53,7 @@ func RegisterAsyncDataChangeMessageHandler(i do.Injector) {
 			do.MustInvoke[auth.PasswordResetTokenDataManager](i),
 			do.MustInvoke[notificationsmanager.NotificationsDataManager](i),
 			do.MustInvoke[notifications.PushNotificationSender](i),
+			do.MustInvoke[domaincomments.Repository](i),
 		)
 	})
 }
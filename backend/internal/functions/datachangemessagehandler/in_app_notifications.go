@@ -0,0 +1,249 @@
+package datachangemessagehandler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/audit"
+	domaincomments "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/comments"
+	commentskeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/comments/keys"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity"
+	identitykeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity/keys"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+	mealplanningkeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/keys"
+	domainnotifications "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/notifications"
+
+	"github.com/primandproper/platform/database/filtering"
+	"github.com/primandproper/platform/identifiers"
+	"github.com/primandproper/platform/observability"
+)
+
+const (
+	mealPlanOptionAddedContent       = "A new option was added to a meal plan."
+	votingDeadlineApproachingContent = "Voting on a meal plan closes soon."
+	mealPlanFinalizedContent         = "A meal plan has been finalized!"
+	mealPlanTaskAssignedContent      = "You have been assigned a meal plan task."
+	commentReplyContent              = "Someone replied to your comment."
+	accountInvitationReceivedContent = "You have been invited to join a household."
+)
+
+// inAppNotification describes a notification to be fanned out to a set of recipients.
+type inAppNotification struct {
+	notificationType string
+	content          string
+	targetType       string
+	targetID         string
+	deepLink         string
+	recipientIDs     []string
+}
+
+// handleInAppNotifications fans a data change out into in-app notifications for the users it affects.
+// The user who caused the change is never notified, and recipients who have disabled in-app
+// notifications for the relevant type are skipped. Events without the context needed to
+// address them are ignored. Notifications remember the message they came from, so handling a
+// redelivered message won't notify anyone twice.
+func (a *AsyncDataChangeMessageHandler) handleInAppNotifications(
+	ctx context.Context,
+	changeMessage *audit.DataChangeMessage,
+) error {
+	ctx, span := a.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := a.logger.WithValue("event_type", changeMessage.EventType)
+
+	notification, err := a.buildInAppNotification(ctx, changeMessage)
+	if err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "building in-app notification")
+	}
+	if notification == nil {
+		return nil
+	}
+
+	var sourceMessageID *string
+	if changeMessage.ID != "" {
+		sourceMessageID = &changeMessage.ID
+	}
+
+	for _, recipientID := range notification.recipientIDs {
+		if recipientID == "" || recipientID == changeMessage.UserID {
+			continue
+		}
+
+		preference, prefErr := a.notificationsRepo.GetNotificationPreference(ctx, recipientID, notification.notificationType)
+		if prefErr != nil {
+			return observability.PrepareAndLogError(prefErr, logger, span, "getting notification preference")
+		}
+		if !preference.InAppEnabled {
+			continue
+		}
+
+		if _, createErr := a.notificationsRepo.CreateUserNotification(ctx, &domainnotifications.UserNotificationDatabaseCreationInput{
+			ID:               identifiers.New(),
+			Content:          notification.content,
+			BelongsToUser:    recipientID,
+			NotificationType: notification.notificationType,
+			TargetType:       notification.targetType,
+			TargetID:         notification.targetID,
+			DeepLink:         notification.deepLink,
+			SourceMessageID:  sourceMessageID,
+		}); createErr != nil && !errors.Is(createErr, domainnotifications.ErrDuplicateUserNotification) {
+			return observability.PrepareAndLogError(createErr, logger, span, "creating user notification")
+		}
+	}
+
+	return nil
+}
+
+// buildInAppNotification determines what, if anything, should be shown in-app for a given data change.
+func (a *AsyncDataChangeMessageHandler) buildInAppNotification(
+	ctx context.Context,
+	changeMessage *audit.DataChangeMessage,
+) (*inAppNotification, error) {
+	switch changeMessage.EventType {
+	case mealplanning.MealPlanCreatedServiceEventType:
+		return a.buildMealPlanInAppNotification(ctx, changeMessage, domainnotifications.NotificationTypeMealPlanCreated, mealPlanCreatedDigestContent)
+	case mealplanning.MealPlanOptionCreatedServiceEventType:
+		return a.buildMealPlanInAppNotification(ctx, changeMessage, domainnotifications.NotificationTypeMealPlanOptionAdded, mealPlanOptionAddedContent)
+	case mealplanning.MealPlanVotingDeadlineApproachingServiceEventType:
+		return a.buildMealPlanInAppNotification(ctx, changeMessage, domainnotifications.NotificationTypeVotingDeadlineApproaching, votingDeadlineApproachingContent)
+	case mealplanning.MealPlanFinalizedServiceEventType:
+		return a.buildMealPlanInAppNotification(ctx, changeMessage, domainnotifications.NotificationTypeMealPlanFinalized, mealPlanFinalizedContent)
+	case mealplanning.MealPlanTaskStatusChangedServiceEventType:
+		return a.buildMealPlanTaskAssignedInAppNotification(changeMessage), nil
+	case domaincomments.CommentCreatedServiceEventType:
+		return a.buildCommentReplyInAppNotification(ctx, changeMessage)
+	case identity.AccountInvitationCreatedServiceEventType:
+		return a.buildAccountInvitationInAppNotification(ctx, changeMessage)
+	default:
+		return nil, nil
+	}
+}
+
+// buildMealPlanInAppNotification addresses a meal plan notification to every member of the plan's account.
+func (a *AsyncDataChangeMessageHandler) buildMealPlanInAppNotification(
+	ctx context.Context,
+	changeMessage *audit.DataChangeMessage,
+	notificationType,
+	content string,
+) (*inAppNotification, error) {
+	mealPlanID := stringFromEventContext(changeMessage, mealplanningkeys.MealPlanIDKey)
+	if mealPlanID == "" || changeMessage.AccountID == "" {
+		return nil, nil
+	}
+
+	recipientIDs, err := a.accountMemberIDs(ctx, changeMessage.AccountID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &inAppNotification{
+		notificationType: notificationType,
+		content:          content,
+		targetType:       domainnotifications.UserNotificationTargetTypeMealPlans,
+		targetID:         mealPlanID,
+		deepLink:         fmt.Sprintf("%s/meal_plans/%s", a.baseURL, mealPlanID),
+		recipientIDs:     recipientIDs,
+	}, nil
+}
+
+// buildMealPlanTaskAssignedInAppNotification addresses a task status change to the task's assignee, if it was assigned.
+func (a *AsyncDataChangeMessageHandler) buildMealPlanTaskAssignedInAppNotification(changeMessage *audit.DataChangeMessage) *inAppNotification {
+	mealPlanID := stringFromEventContext(changeMessage, mealplanningkeys.MealPlanIDKey)
+	mealPlanTaskID := stringFromEventContext(changeMessage, mealplanningkeys.MealPlanTaskIDKey)
+	assignee := stringFromEventContext(changeMessage, mealplanningkeys.MealPlanTaskAssignedToUserKey)
+	if mealPlanID == "" || mealPlanTaskID == "" || assignee == "" {
+		return nil
+	}
+
+	return &inAppNotification{
+		notificationType: domainnotifications.NotificationTypeMealPlanTaskAssigned,
+		content:          mealPlanTaskAssignedContent,
+		targetType:       domainnotifications.UserNotificationTargetTypeMealPlanTasks,
+		targetID:         mealPlanTaskID,
+		deepLink:         fmt.Sprintf("%s/meal_plans/%s", a.baseURL, mealPlanID),
+		recipientIDs:     []string{assignee},
+	}
+}
+
+// buildCommentReplyInAppNotification addresses a reply to the author of the comment being replied to.
+func (a *AsyncDataChangeMessageHandler) buildCommentReplyInAppNotification(
+	ctx context.Context,
+	changeMessage *audit.DataChangeMessage,
+) (*inAppNotification, error) {
+	commentID := stringFromEventContext(changeMessage, commentskeys.CommentIDKey)
+	if commentID == "" {
+		return nil, nil
+	}
+
+	comment, err := a.commentsRepo.GetComment(ctx, commentID)
+	if err != nil {
+		return nil, fmt.Errorf("getting comment: %w", err)
+	}
+	if comment == nil || comment.ParentCommentID == nil || *comment.ParentCommentID == "" {
+		return nil, nil
+	}
+
+	parent, err := a.commentsRepo.GetComment(ctx, *comment.ParentCommentID)
+	if err != nil {
+		return nil, fmt.Errorf("getting parent comment: %w", err)
+	}
+	if parent == nil {
+		return nil, nil
+	}
+
+	return &inAppNotification{
+		notificationType: domainnotifications.NotificationTypeCommentReply,
+		content:          commentReplyContent,
+		targetType:       comment.TargetType,
+		targetID:         comment.ReferencedID,
+		deepLink:         fmt.Sprintf("%s/%s/%s", a.baseURL, comment.TargetType, comment.ReferencedID),
+		recipientIDs:     []string{parent.BelongsToUser},
+	}, nil
+}
+
+// buildAccountInvitationInAppNotification addresses an invitation to its recipient, if they already have an account.
+func (a *AsyncDataChangeMessageHandler) buildAccountInvitationInAppNotification(
+	ctx context.Context,
+	changeMessage *audit.DataChangeMessage,
+) (*inAppNotification, error) {
+	invitationID := stringFromEventContext(changeMessage, identitykeys.AccountInvitationIDKey)
+	destinationAccountID := stringFromEventContext(changeMessage, identitykeys.DestinationAccountIDKey)
+	if invitationID == "" || destinationAccountID == "" {
+		return nil, nil
+	}
+
+	invitation, err := a.identityRepo.GetAccountInvitationByAccountAndID(ctx, destinationAccountID, invitationID)
+	if err != nil {
+		return nil, fmt.Errorf("getting account invitation: %w", err)
+	}
+	if invitation == nil || invitation.ToUser == nil || *invitation.ToUser == "" {
+		return nil, nil
+	}
+
+	return &inAppNotification{
+		notificationType: domainnotifications.NotificationTypeAccountInvitationReceived,
+		content:          accountInvitationReceivedContent,
+		targetType:       domainnotifications.UserNotificationTargetTypeAccountInvitations,
+		targetID:         invitation.ID,
+		deepLink:         fmt.Sprintf("%s/accept_invitation?i=%s&t=%s", a.baseURL, invitation.ID, invitation.Token),
+		recipientIDs:     []string{*invitation.ToUser},
+	}, nil
+}
+
+// accountMemberIDs fetches the IDs of every user in a given account.
+func (a *AsyncDataChangeMessageHandler) accountMemberIDs(ctx context.Context, accountID string) ([]string, error) {
+	users, err := a.identityRepo.GetUsersForAccount(ctx, accountID, filtering.DefaultQueryFilter())
+	if err != nil {
+		return nil, fmt.Errorf("getting users for account: %w", err)
+	}
+
+	var ids []string
+	for _, u := range users.Data {
+		if u != nil {
+			ids = append(ids, u.ID)
+		}
+	}
+
+	return ids, nil
+}
@@ -0,0 +1,296 @@
+package datachangemessagehandler
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/audit"
+	domaincomments "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/comments"
+	commentsfakes "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/comments/fakes"
+	commentskeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/comments/keys"
+	commentsmock "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/comments/mock"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity"
+	identityfakes "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity/fakes"
+	identitykeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity/keys"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+	mealplanningfakes "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/fakes"
+	mealplanningkeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/keys"
+	domainnotifications "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/notifications"
+	notificationsmock "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/notifications/mock"
+
+	"github.com/primandproper/platform/reflection"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAsyncDataChangeMessageHandler_handleInAppNotifications(t *testing.T) {
+	t.Parallel()
+
+	t.Run("notifies account members of a finalized meal plan", func(t *testing.T) {
+		t.Parallel()
+
+		handler, identityRepo, _, _, _, _, _, _, _, _, _ := buildTestAsyncDataChangeMessageHandler(t)
+		notificationsRepo := &notificationsmock.Repository{}
+		handler.notificationsRepo = notificationsRepo
+
+		ctx := t.Context()
+		members := identityfakes.BuildFakeUsersList()
+		actor, optedOut := members.Data[0], members.Data[1]
+		mealPlanID := mealplanningfakes.BuildFakeID()
+
+		dataChangeMessage := &audit.DataChangeMessage{
+			ID:        mealplanningfakes.BuildFakeID(),
+			EventType: mealplanning.MealPlanFinalizedServiceEventType,
+			UserID:    actor.ID,
+			AccountID: identityfakes.BuildFakeID(),
+			Context: map[string]any{
+				mealplanningkeys.MealPlanIDKey: mealPlanID,
+			},
+		}
+
+		optedOutPreference := domainnotifications.DefaultNotificationPreference(optedOut.ID, domainnotifications.NotificationTypeMealPlanFinalized)
+		optedOutPreference.InAppEnabled = false
+
+		identityRepo.On(reflection.GetMethodName(identityRepo.GetUsersForAccount), mock.Anything, dataChangeMessage.AccountID, mock.Anything).Return(members, nil).Once()
+		notificationsRepo.On(reflection.GetMethodName(notificationsRepo.GetNotificationPreference), mock.Anything, optedOut.ID, domainnotifications.NotificationTypeMealPlanFinalized).Return(optedOutPreference, nil).Once()
+
+		for _, member := range members.Data[2:] {
+			notificationsRepo.On(reflection.GetMethodName(notificationsRepo.GetNotificationPreference), mock.Anything, member.ID, domainnotifications.NotificationTypeMealPlanFinalized).Return(domainnotifications.DefaultNotificationPreference(member.ID, domainnotifications.NotificationTypeMealPlanFinalized), nil).Once()
+			notificationsRepo.On(
+				reflection.GetMethodName(notificationsRepo.CreateUserNotification),
+				mock.Anything,
+				mock.MatchedBy(func(input *domainnotifications.UserNotificationDatabaseCreationInput) bool {
+					return input.BelongsToUser == member.ID &&
+						input.NotificationType == domainnotifications.NotificationTypeMealPlanFinalized &&
+						input.TargetType == domainnotifications.UserNotificationTargetTypeMealPlans &&
+						input.TargetID == mealPlanID &&
+						input.DeepLink != "" &&
+						input.SourceMessageID != nil && *input.SourceMessageID == dataChangeMessage.ID
+				}),
+			).Return(&domainnotifications.UserNotification{}, nil).Once()
+		}
+
+		assert.NoError(t, handler.handleInAppNotifications(ctx, dataChangeMessage))
+
+		mock.AssertExpectationsForObjects(t, identityRepo, notificationsRepo)
+	})
+
+	t.Run("ignores recipients already notified about a redelivered message", func(t *testing.T) {
+		t.Parallel()
+
+		handler, _, _, _, _, _, _, _, _, _, _ := buildTestAsyncDataChangeMessageHandler(t)
+		notificationsRepo := &notificationsmock.Repository{}
+		handler.notificationsRepo = notificationsRepo
+
+		ctx := t.Context()
+		assignee := identityfakes.BuildFakeID()
+
+		dataChangeMessage := &audit.DataChangeMessage{
+			ID:        mealplanningfakes.BuildFakeID(),
+			EventType: mealplanning.MealPlanTaskStatusChangedServiceEventType,
+			UserID:    identityfakes.BuildFakeID(),
+			Context: map[string]any{
+				mealplanningkeys.MealPlanIDKey:                 mealplanningfakes.BuildFakeID(),
+				mealplanningkeys.MealPlanTaskIDKey:             mealplanningfakes.BuildFakeID(),
+				mealplanningkeys.MealPlanTaskAssignedToUserKey: assignee,
+			},
+		}
+
+		notificationsRepo.On(reflection.GetMethodName(notificationsRepo.GetNotificationPreference), mock.Anything, assignee, domainnotifications.NotificationTypeMealPlanTaskAssigned).Return(domainnotifications.DefaultNotificationPreference(assignee, domainnotifications.NotificationTypeMealPlanTaskAssigned), nil).Once()
+		notificationsRepo.On(
+			reflection.GetMethodName(notificationsRepo.CreateUserNotification),
+			mock.Anything,
+			mock.MatchedBy(func(input *domainnotifications.UserNotificationDatabaseCreationInput) bool {
+				return input.BelongsToUser == assignee && input.SourceMessageID != nil && *input.SourceMessageID == dataChangeMessage.ID
+			}),
+		).Return((*domainnotifications.UserNotification)(nil), domainnotifications.ErrDuplicateUserNotification).Once()
+
+		assert.NoError(t, handler.handleInAppNotifications(ctx, dataChangeMessage))
+
+		mock.AssertExpectationsForObjects(t, notificationsRepo)
+	})
+
+	t.Run("notifies the assignee of a meal plan task", func(t *testing.T) {
+		t.Parallel()
+
+		handler, _, _, _, _, _, _, _, _, _, _ := buildTestAsyncDataChangeMessageHandler(t)
+		notificationsRepo := &notificationsmock.Repository{}
+		handler.notificationsRepo = notificationsRepo
+
+		ctx := t.Context()
+		assignee := identityfakes.BuildFakeID()
+		mealPlanTaskID := mealplanningfakes.BuildFakeID()
+
+		dataChangeMessage := &audit.DataChangeMessage{
+			EventType: mealplanning.MealPlanTaskStatusChangedServiceEventType,
+			UserID:    identityfakes.BuildFakeID(),
+			Context: map[string]any{
+				mealplanningkeys.MealPlanIDKey:                 mealplanningfakes.BuildFakeID(),
+				mealplanningkeys.MealPlanTaskIDKey:             mealPlanTaskID,
+				mealplanningkeys.MealPlanTaskAssignedToUserKey: assignee,
+			},
+		}
+
+		notificationsRepo.On(reflection.GetMethodName(notificationsRepo.GetNotificationPreference), mock.Anything, assignee, domainnotifications.NotificationTypeMealPlanTaskAssigned).Return(domainnotifications.DefaultNotificationPreference(assignee, domainnotifications.NotificationTypeMealPlanTaskAssigned), nil).Once()
+		notificationsRepo.On(
+			reflection.GetMethodName(notificationsRepo.CreateUserNotification),
+			mock.Anything,
+			mock.MatchedBy(func(input *domainnotifications.UserNotificationDatabaseCreationInput) bool {
+				return input.BelongsToUser == assignee &&
+					input.TargetType == domainnotifications.UserNotificationTargetTypeMealPlanTasks &&
+					input.TargetID == mealPlanTaskID
+			}),
+		).Return(&domainnotifications.UserNotification{}, nil).Once()
+
+		assert.NoError(t, handler.handleInAppNotifications(ctx, dataChangeMessage))
+
+		mock.AssertExpectationsForObjects(t, notificationsRepo)
+	})
+
+	t.Run("notifies the author of a comment that was replied to", func(t *testing.T) {
+		t.Parallel()
+
+		handler, _, _, _, _, _, _, _, _, _, _ := buildTestAsyncDataChangeMessageHandler(t)
+		notificationsRepo := &notificationsmock.Repository{}
+		handler.notificationsRepo = notificationsRepo
+		commentsRepo := &commentsmock.Repository{}
+		handler.commentsRepo = commentsRepo
+
+		ctx := t.Context()
+		parent := commentsfakes.BuildFakeComment()
+		reply := commentsfakes.BuildFakeCommentWithParent(parent.ID)
+
+		dataChangeMessage := &audit.DataChangeMessage{
+			EventType: domaincomments.CommentCreatedServiceEventType,
+			UserID:    reply.BelongsToUser,
+			Context: map[string]any{
+				commentskeys.CommentIDKey: reply.ID,
+			},
+		}
+
+		commentsRepo.On(reflection.GetMethodName(commentsRepo.GetComment), mock.Anything, reply.ID).Return(reply, nil).Once()
+		commentsRepo.On(reflection.GetMethodName(commentsRepo.GetComment), mock.Anything, parent.ID).Return(parent, nil).Once()
+		notificationsRepo.On(reflection.GetMethodName(notificationsRepo.GetNotificationPreference), mock.Anything, parent.BelongsToUser, domainnotifications.NotificationTypeCommentReply).Return(domainnotifications.DefaultNotificationPreference(parent.BelongsToUser, domainnotifications.NotificationTypeCommentReply), nil).Once()
+		notificationsRepo.On(
+			reflection.GetMethodName(notificationsRepo.CreateUserNotification),
+			mock.Anything,
+			mock.MatchedBy(func(input *domainnotifications.UserNotificationDatabaseCreationInput) bool {
+				return input.BelongsToUser == parent.BelongsToUser &&
+					input.TargetType == reply.TargetType &&
+					input.TargetID == reply.ReferencedID
+			}),
+		).Return(&domainnotifications.UserNotification{}, nil).Once()
+
+		assert.NoError(t, handler.handleInAppNotifications(ctx, dataChangeMessage))
+
+		mock.AssertExpectationsForObjects(t, commentsRepo, notificationsRepo)
+	})
+
+	t.Run("ignores top-level comments", func(t *testing.T) {
+		t.Parallel()
+
+		handler, _, _, _, _, _, _, _, _, _, _ := buildTestAsyncDataChangeMessageHandler(t)
+		notificationsRepo := &notificationsmock.Repository{}
+		handler.notificationsRepo = notificationsRepo
+		commentsRepo := &commentsmock.Repository{}
+		handler.commentsRepo = commentsRepo
+
+		ctx := t.Context()
+		comment := commentsfakes.BuildFakeComment()
+
+		dataChangeMessage := &audit.DataChangeMessage{
+			EventType: domaincomments.CommentCreatedServiceEventType,
+			UserID:    comment.BelongsToUser,
+			Context: map[string]any{
+				commentskeys.CommentIDKey: comment.ID,
+			},
+		}
+
+		commentsRepo.On(reflection.GetMethodName(commentsRepo.GetComment), mock.Anything, comment.ID).Return(comment, nil).Once()
+
+		assert.NoError(t, handler.handleInAppNotifications(ctx, dataChangeMessage))
+
+		mock.AssertExpectationsForObjects(t, commentsRepo, notificationsRepo)
+	})
+
+	t.Run("notifies the recipient of an account invitation", func(t *testing.T) {
+		t.Parallel()
+
+		handler, identityRepo, _, _, _, _, _, _, _, _, _ := buildTestAsyncDataChangeMessageHandler(t)
+		notificationsRepo := &notificationsmock.Repository{}
+		handler.notificationsRepo = notificationsRepo
+
+		ctx := t.Context()
+		invitation := identityfakes.BuildFakeAccountInvitation()
+
+		dataChangeMessage := &audit.DataChangeMessage{
+			EventType: identity.AccountInvitationCreatedServiceEventType,
+			UserID:    invitation.FromUser.ID,
+			Context: map[string]any{
+				identitykeys.AccountInvitationIDKey:  invitation.ID,
+				identitykeys.DestinationAccountIDKey: invitation.DestinationAccount.ID,
+			},
+		}
+
+		identityRepo.On(reflection.GetMethodName(identityRepo.GetAccountInvitationByAccountAndID), mock.Anything, invitation.DestinationAccount.ID, invitation.ID).Return(invitation, nil).Once()
+		notificationsRepo.On(reflection.GetMethodName(notificationsRepo.GetNotificationPreference), mock.Anything, *invitation.ToUser, domainnotifications.NotificationTypeAccountInvitationReceived).Return(domainnotifications.DefaultNotificationPreference(*invitation.ToUser, domainnotifications.NotificationTypeAccountInvitationReceived), nil).Once()
+		notificationsRepo.On(
+			reflection.GetMethodName(notificationsRepo.CreateUserNotification),
+			mock.Anything,
+			mock.MatchedBy(func(input *domainnotifications.UserNotificationDatabaseCreationInput) bool {
+				return input.BelongsToUser == *invitation.ToUser &&
+					input.TargetType == domainnotifications.UserNotificationTargetTypeAccountInvitations &&
+					input.TargetID == invitation.ID
+			}),
+		).Return(&domainnotifications.UserNotification{}, nil).Once()
+
+		assert.NoError(t, handler.handleInAppNotifications(ctx, dataChangeMessage))
+
+		mock.AssertExpectationsForObjects(t, identityRepo, notificationsRepo)
+	})
+
+	t.Run("ignores unrelated events", func(t *testing.T) {
+		t.Parallel()
+
+		handler, identityRepo, _, _, _, _, _, _, _, _, _ := buildTestAsyncDataChangeMessageHandler(t)
+
+		ctx := t.Context()
+
+		dataChangeMessage := &audit.DataChangeMessage{
+			EventType: identity.UserSignedUpServiceEventType,
+			UserID:    identityfakes.BuildFakeID(),
+		}
+
+		assert.NoError(t, handler.handleInAppNotifications(ctx, dataChangeMessage))
+
+		mock.AssertExpectationsForObjects(t, identityRepo)
+	})
+
+	t.Run("with error creating user notification", func(t *testing.T) {
+		t.Parallel()
+
+		handler, _, _, _, _, _, _, _, _, _, _ := buildTestAsyncDataChangeMessageHandler(t)
+		notificationsRepo := &notificationsmock.Repository{}
+		handler.notificationsRepo = notificationsRepo
+
+		ctx := t.Context()
+		assignee := identityfakes.BuildFakeID()
+
+		dataChangeMessage := &audit.DataChangeMessage{
+			EventType: mealplanning.MealPlanTaskStatusChangedServiceEventType,
+			Context: map[string]any{
+				mealplanningkeys.MealPlanIDKey:                 mealplanningfakes.BuildFakeID(),
+				mealplanningkeys.MealPlanTaskIDKey:             mealplanningfakes.BuildFakeID(),
+				mealplanningkeys.MealPlanTaskAssignedToUserKey: assignee,
+			},
+		}
+
+		notificationsRepo.On(reflection.GetMethodName(notificationsRepo.GetNotificationPreference), mock.Anything, assignee, domainnotifications.NotificationTypeMealPlanTaskAssigned).Return(domainnotifications.DefaultNotificationPreference(assignee, domainnotifications.NotificationTypeMealPlanTaskAssigned), nil).Once()
+		notificationsRepo.On(reflection.GetMethodName(notificationsRepo.CreateUserNotification), mock.Anything, mock.Anything).Return((*domainnotifications.UserNotification)(nil), errors.New("blah")).Once()
+
+		assert.Error(t, handler.handleInAppNotifications(ctx, dataChangeMessage))
+
+		mock.AssertExpectationsForObjects(t, notificationsRepo)
+	})
+}
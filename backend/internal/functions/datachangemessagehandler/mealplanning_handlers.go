@@ -3,6 +3,7 @@
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/audit"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity"
@@ -10,14 +11,20 @@
 	eatingemails "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/emails"
 	mealplanningkeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/keys"
 	mealplanningnotifications "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/notifications"
+	domainnotifications "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/notifications"
 	eatingindexing "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/services/mealplanning/indexing"
 
 	"github.com/primandproper/platform/email"
+	"github.com/primandproper/platform/identifiers"
 	notifications "github.com/primandproper/platform/notifications/mobile"
 	"github.com/primandproper/platform/observability"
 	textsearch "github.com/primandproper/platform/search/text"
 )
 
+const (
+	mealPlanCreatedDigestContent = "A new meal plan has been created!"
+)
+
 // handleMealPlanTaskNotification processes a meal plan task reminder notification.
 // It performs idempotency checks, sends push notifications to recipients, and marks the task as notified.
 func (a *AsyncDataChangeMessageHandler) handleMealPlanTaskNotification(ctx context.Context, req *notifications.MobileNotificationRequest) error {
@@ -46,10 +53,16 @@ func (a *AsyncDataChangeMessageHandler) handleMealPlanTaskNotification(ctx conte
 		return a.mealPlanRepo.MarkMealPlanTaskNotificationSent(ctx, mealPlanTaskID)
 	}
 
-	deviceTokens, err := a.collectDeviceTokensForUsers(ctx, req.RecipientUserIDs)
+	deviceTokens, heldUntil, err := a.collectDeviceTokensForUsers(ctx, req.RecipientUserIDs, domainnotifications.NotificationTypeMealPlanTaskDue)
 	if err != nil {
 		return observability.PrepareAndLogError(err, logger, span, "collecting device tokens")
 	}
+	if !heldUntil.IsZero() {
+		// a recipient is in their quiet hours, so hold the whole reminder rather than pushing it to some members now and
+		// again to everyone later; the task stays unnotified and the scheduler republishes it once their quiet hours end.
+		logger.WithValue("held_until", heldUntil).Info("holding meal plan task notification for quiet hours")
+		return nil
+	}
 	if len(deviceTokens) == 0 {
 		return a.mealPlanRepo.MarkMealPlanTaskNotificationSent(ctx, mealPlanTaskID)
 	}
@@ -298,17 +311,79 @@ func (a *AsyncDataChangeMessageHandler) handleMealPlanCreatedNotification(
 		return nil, observability.PrepareError(err, span, "getting account")
 	}
 
+	now := time.Now()
 	var outboundEmailMessages []*email.OutboundEmailMessage
 	for _, member := range account.Members {
-		if member.BelongsToUser.EmailAddressVerifiedAt != nil {
-			msg, emailErr := eatingemails.BuildMealPlanCreatedEmail(member.BelongsToUser, mealPlan, a.baseURL)
-			if emailErr != nil {
-				return nil, observability.PrepareAndLogError(emailErr, logger, span, "building meal plan created email")
+		if member.BelongsToUser.EmailAddressVerifiedAt == nil {
+			continue
+		}
+
+		preference, prefErr := a.notificationsRepo.GetNotificationPreference(ctx, member.BelongsToUser.ID, domainnotifications.NotificationTypeMealPlanCreated)
+		if prefErr != nil {
+			return nil, observability.PrepareAndLogError(prefErr, logger, span, "getting notification preference")
+		}
+		if !preference.EmailEnabled {
+			continue
+		}
+
+		// members on a digest, or in their quiet hours, get this in their next digest instead.
+		if deliverAfter, held := preference.EmailHeldUntil(now); held {
+			if _, queueErr := a.notificationsRepo.CreateQueuedNotification(ctx, &domainnotifications.QueuedNotificationDatabaseCreationInput{
+				DeliverAfter:     deliverAfter,
+				ID:               identifiers.New(),
+				BelongsToUser:    member.BelongsToUser.ID,
+				NotificationType: domainnotifications.NotificationTypeMealPlanCreated,
+				Content:          mealPlanCreatedDigestContent,
+				ActionURL:        fmt.Sprintf("%s/meal_plans/%s", a.baseURL, mealPlan.ID),
+			}); queueErr != nil {
+				return nil, observability.PrepareAndLogError(queueErr, logger, span, "queueing meal plan created notification")
 			}
+			continue
+		}
 
-			outboundEmailMessages = append(outboundEmailMessages, msg)
+		msg, emailErr := eatingemails.BuildMealPlanCreatedEmail(member.BelongsToUser, mealPlan, a.baseURL)
+		if emailErr != nil {
+			return nil, observability.PrepareAndLogError(emailErr, logger, span, "building meal plan created email")
 		}
+
+		outboundEmailMessages = append(outboundEmailMessages, msg)
 	}
 
 	return outboundEmailMessages, nil
 }
+
+// handleMealPlanActivity records voting, option, finalization, and task changes in the meal plan's activity feed,
+// which backs the meal plan watch streams. Events without a meal plan ID in their context are skipped.
+func (a *AsyncDataChangeMessageHandler) handleMealPlanActivity(
+	ctx context.Context,
+	changeMessage *audit.DataChangeMessage,
+) error {
+	ctx, span := a.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if mealplanning.MealPlanActivityCategoryForEventType(changeMessage.EventType) == "" {
+		return nil
+	}
+
+	mealPlanID := stringFromEventContext(changeMessage, mealplanningkeys.MealPlanIDKey)
+	if mealPlanID == "" {
+		return nil
+	}
+
+	logger := a.logger.WithValue("event_type", changeMessage.EventType).WithValue(mealplanningkeys.MealPlanIDKey, mealPlanID)
+
+	if err := a.mealPlanRepo.CreateMealPlanActivity(ctx, &mealplanning.MealPlanActivityDatabaseCreationInput{
+		ID:                   identifiers.New(),
+		EventType:            changeMessage.EventType,
+		MealPlanEventID:      stringFromEventContext(changeMessage, mealplanningkeys.MealPlanEventIDKey),
+		MealPlanOptionID:     stringFromEventContext(changeMessage, mealplanningkeys.MealPlanOptionIDKey),
+		MealPlanOptionVoteID: stringFromEventContext(changeMessage, mealplanningkeys.MealPlanOptionVoteIDKey),
+		MealPlanTaskID:       stringFromEventContext(changeMessage, mealplanningkeys.MealPlanTaskIDKey),
+		ByUser:               changeMessage.UserID,
+		BelongsToMealPlan:    mealPlanID,
+	}); err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "recording meal plan activity")
+	}
+
+	return nil
+}
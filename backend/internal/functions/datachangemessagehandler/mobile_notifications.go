@@ -98,7 +98,8 @@ func (a *AsyncDataChangeMessageHandler) handleHouseholdInvitationAcceptedNotific
 		return nil
 	}
 
-	deviceTokens, err := a.collectDeviceTokensForUsers(ctx, req.RecipientUserIDs)
+	// there's nothing to retry an invitation notification from, so members in their quiet hours simply miss it.
+	deviceTokens, _, err := a.collectDeviceTokensForUsers(ctx, req.RecipientUserIDs, domainnotifications.NotificationTypeAccountInvitationAccepted)
 	if err != nil {
 		return observability.PrepareAndLogError(err, a.logger, span, "collecting device tokens")
 	}
@@ -140,13 +141,30 @@ func (a *AsyncDataChangeMessageHandler) sendPushToDevice(ctx context.Context, lo
 	return true
 }
 
-func (a *AsyncDataChangeMessageHandler) collectDeviceTokensForUsers(ctx context.Context, userIDs []string) ([]*domainnotifications.UserDeviceToken, error) {
-	var tokens []*domainnotifications.UserDeviceToken
+// collectDeviceTokensForUsers fetches the device tokens of every user who currently wants push notifications of the given type,
+// skipping users who've turned push off for it. Users in their quiet hours are skipped too, and heldUntil reports the latest
+// moment one of their quiet hours ends, or the zero time if nobody was held.
+func (a *AsyncDataChangeMessageHandler) collectDeviceTokensForUsers(ctx context.Context, userIDs []string, notificationType string) (tokens []*domainnotifications.UserDeviceToken, heldUntil time.Time, err error) {
 	filter := filtering.DefaultQueryFilter()
+	now := time.Now()
 	for _, userID := range userIDs {
-		result, err := a.notificationsRepo.GetUserDeviceTokens(ctx, userID, filter, nil)
-		if err != nil {
-			return nil, fmt.Errorf("getting device tokens for user %s: %w", userID, err)
+		preference, prefErr := a.notificationsRepo.GetNotificationPreference(ctx, userID, notificationType)
+		if prefErr != nil {
+			return nil, time.Time{}, fmt.Errorf("getting notification preference for user %s: %w", userID, prefErr)
+		}
+		if !preference.PushEnabled {
+			continue
+		}
+		if deliverAfter, held := preference.PushHeldUntil(now); held {
+			if deliverAfter.After(heldUntil) {
+				heldUntil = deliverAfter
+			}
+			continue
+		}
+
+		result, tokensErr := a.notificationsRepo.GetUserDeviceTokens(ctx, userID, filter, nil)
+		if tokensErr != nil {
+			return nil, time.Time{}, fmt.Errorf("getting device tokens for user %s: %w", userID, tokensErr)
 		}
 		for _, t := range result.Data {
 			if t != nil && t.DeviceToken != "" {
@@ -154,5 +172,5 @@ func (a *AsyncDataChangeMessageHandler) collectDeviceTokensForUsers(ctx context.
 			}
 		}
 	}
-	return tokens, nil
+	return tokens, heldUntil, nil
 }
@@ -4,7 +4,9 @@
 	"encoding/json"
 	"strings"
 	"testing"
+	"time"
 
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity"
 	mealplanningmock "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/mocks"
 	mealplanningnotifications "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/notifications"
 	domainnotifications "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/notifications"
@@ -200,6 +202,7 @@ func TestMobileNotificationsEventHandler(t *testing.T) {
 		raw, _ := json.Marshal(req)
 
 		mealPlanRepo.On(reflection.GetMethodName(mealPlanRepo.MealPlanTaskNotificationHasBeenSent), mock.Anything, "task-123").Return(false, nil).Once()
+		notificationsRepo.On(reflection.GetMethodName(notificationsRepo.GetNotificationPreference), mock.Anything, "user-1", domainnotifications.NotificationTypeMealPlanTaskDue).Return(domainnotifications.DefaultNotificationPreference("user-1", domainnotifications.NotificationTypeMealPlanTaskDue), nil).Once()
 		notificationsRepo.On(reflection.GetMethodName(notificationsRepo.GetUserDeviceTokens), mock.Anything, "user-1", mock.Anything, (*string)(nil)).Return(&filtering.QueryFilteredResult[domainnotifications.UserDeviceToken]{Data: []*domainnotifications.UserDeviceToken{}}, nil).Once()
 		mealPlanRepo.On(reflection.GetMethodName(mealPlanRepo.MarkMealPlanTaskNotificationSent), mock.Anything, "task-123").Return(nil).Once()
 
@@ -237,6 +240,7 @@ func TestMobileNotificationsEventHandler(t *testing.T) {
 		}
 
 		mealPlanRepo.On(reflection.GetMethodName(mealPlanRepo.MealPlanTaskNotificationHasBeenSent), mock.Anything, "task-123").Return(false, nil).Once()
+		notificationsRepo.On(reflection.GetMethodName(notificationsRepo.GetNotificationPreference), mock.Anything, "user-1", domainnotifications.NotificationTypeMealPlanTaskDue).Return(domainnotifications.DefaultNotificationPreference("user-1", domainnotifications.NotificationTypeMealPlanTaskDue), nil).Once()
 		notificationsRepo.On(reflection.GetMethodName(notificationsRepo.GetUserDeviceTokens), mock.Anything, "user-1", mock.Anything, (*string)(nil)).Return(&filtering.QueryFilteredResult[domainnotifications.UserDeviceToken]{
 			Data: []*domainnotifications.UserDeviceToken{deviceToken},
 		}, nil).Once()
@@ -247,4 +251,108 @@ func TestMobileNotificationsEventHandler(t *testing.T) {
 		assert.NoError(t, err)
 		mock.AssertExpectationsForObjects(t, mealPlanRepo, notificationsRepo)
 	})
+	t.Run("skips users who turned push off for the notification type", func(t *testing.T) {
+		t.Parallel()
+
+		handler, _, _, _, _, _, _, _, _, _, _ := buildTestAsyncDataChangeMessageHandler(t)
+		mealPlanRepo := &mealplanningmock.Repository{}
+		notificationsRepo := &notificationsmock.Repository{}
+		handler.mealPlanRepo = mealPlanRepo
+		handler.notificationsRepo = notificationsRepo
+
+		req := notifications.MobileNotificationRequest{
+			RequestType:      mealplanningnotifications.MobileNotificationRequestTypeMealPlanTask,
+			RecipientUserIDs: []string{"user-1"},
+			Title:            "Meal plan task",
+			Body:             "Chop onions for Dinner on Monday",
+			Context: map[string]string{
+				mealplanningnotifications.MealPlanTaskIDContextKey: "task-123",
+			},
+		}
+		raw, _ := json.Marshal(req)
+
+		preference := domainnotifications.DefaultNotificationPreference("user-1", domainnotifications.NotificationTypeMealPlanTaskDue)
+		preference.PushEnabled = false
+
+		mealPlanRepo.On(reflection.GetMethodName(mealPlanRepo.MealPlanTaskNotificationHasBeenSent), mock.Anything, "task-123").Return(false, nil).Once()
+		notificationsRepo.On(reflection.GetMethodName(notificationsRepo.GetNotificationPreference), mock.Anything, "user-1", domainnotifications.NotificationTypeMealPlanTaskDue).Return(preference, nil).Once()
+		mealPlanRepo.On(reflection.GetMethodName(mealPlanRepo.MarkMealPlanTaskNotificationSent), mock.Anything, "task-123").Return(nil).Once()
+
+		err := handler.MobileNotificationsEventHandler("mobile_notifications")(t.Context(), raw)
+
+		assert.NoError(t, err)
+		mock.AssertExpectationsForObjects(t, mealPlanRepo, notificationsRepo)
+	})
+
+	t.Run("holds meal plan task notification during quiet hours", func(t *testing.T) {
+		t.Parallel()
+
+		handler, _, _, _, _, _, _, _, _, _, _ := buildTestAsyncDataChangeMessageHandler(t)
+		mealPlanRepo := &mealplanningmock.Repository{}
+		notificationsRepo := &notificationsmock.Repository{}
+		handler.mealPlanRepo = mealPlanRepo
+		handler.notificationsRepo = notificationsRepo
+
+		req := notifications.MobileNotificationRequest{
+			RequestType:      mealplanningnotifications.MobileNotificationRequestTypeMealPlanTask,
+			RecipientUserIDs: []string{"user-1", "user-2"},
+			Title:            "Meal plan task",
+			Body:             "Chop onions for Dinner on Monday",
+			Context: map[string]string{
+				mealplanningnotifications.MealPlanTaskIDContextKey: "task-123",
+			},
+		}
+		raw, _ := json.Marshal(req)
+
+		now := time.Now().UTC()
+		quietPreference := domainnotifications.DefaultNotificationPreference("user-2", domainnotifications.NotificationTypeMealPlanTaskDue)
+		quietPreference.QuietHoursStart, quietPreference.QuietHoursEnd = new(now.Add(-time.Hour).Format("15:04")), new(now.Add(time.Hour).Format("15:04"))
+
+		mealPlanRepo.On(reflection.GetMethodName(mealPlanRepo.MealPlanTaskNotificationHasBeenSent), mock.Anything, "task-123").Return(false, nil).Once()
+		notificationsRepo.On(reflection.GetMethodName(notificationsRepo.GetNotificationPreference), mock.Anything, "user-1", domainnotifications.NotificationTypeMealPlanTaskDue).Return(domainnotifications.DefaultNotificationPreference("user-1", domainnotifications.NotificationTypeMealPlanTaskDue), nil).Once()
+		notificationsRepo.On(reflection.GetMethodName(notificationsRepo.GetUserDeviceTokens), mock.Anything, "user-1", mock.Anything, (*string)(nil)).Return(&filtering.QueryFilteredResult[domainnotifications.UserDeviceToken]{
+			Data: []*domainnotifications.UserDeviceToken{
+				{
+					ID:            "token-1",
+					DeviceToken:   strings.Repeat("a", 64),
+					Platform:      domainnotifications.UserDeviceTokenPlatformIOS,
+					BelongsToUser: "user-1",
+				},
+			},
+		}, nil).Once()
+		notificationsRepo.On(reflection.GetMethodName(notificationsRepo.GetNotificationPreference), mock.Anything, "user-2", domainnotifications.NotificationTypeMealPlanTaskDue).Return(quietPreference, nil).Once()
+
+		// nothing is pushed and the task isn't marked notified, so the scheduler sends it once quiet hours end.
+		err := handler.MobileNotificationsEventHandler("mobile_notifications")(t.Context(), raw)
+
+		assert.NoError(t, err)
+		mock.AssertExpectationsForObjects(t, mealPlanRepo, notificationsRepo)
+	})
+
+	t.Run("skips users in their quiet hours", func(t *testing.T) {
+		t.Parallel()
+
+		handler, _, _, _, _, _, _, _, _, _, _ := buildTestAsyncDataChangeMessageHandler(t)
+		notificationsRepo := &notificationsmock.Repository{}
+		handler.notificationsRepo = notificationsRepo
+
+		req := notifications.MobileNotificationRequest{
+			RequestType:      identity.MobileNotificationRequestTypeHouseholdInvitationAccepted,
+			RecipientUserIDs: []string{"user-1"},
+			Title:            "title",
+			Body:             "body",
+		}
+		raw, _ := json.Marshal(req)
+
+		now := time.Now().UTC()
+		preference := domainnotifications.DefaultNotificationPreference("user-1", domainnotifications.NotificationTypeAccountInvitationAccepted)
+		preference.QuietHoursStart, preference.QuietHoursEnd = new(now.Add(-time.Hour).Format("15:04")), new(now.Add(time.Hour).Format("15:04"))
+
+		notificationsRepo.On(reflection.GetMethodName(notificationsRepo.GetNotificationPreference), mock.Anything, "user-1", domainnotifications.NotificationTypeAccountInvitationAccepted).Return(preference, nil).Once()
+
+		err := handler.MobileNotificationsEventHandler("mobile_notifications")(t.Context(), raw)
+
+		assert.NoError(t, err)
+		mock.AssertExpectationsForObjects(t, notificationsRepo)
+	})
 }
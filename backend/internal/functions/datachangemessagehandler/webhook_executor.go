@@ -2,28 +2,37 @@
 
 import (
 	"bytes"
+	"cmp"
 	"context"
-	"crypto/hmac"
-	"crypto/sha256"
 	"encoding/hex"
 	"encoding/json"
 	"encoding/xml"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"time"
 
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/webhooks"
+	webhookkeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/webhooks/keys"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/pkg/webhooksignature"
 
 	"github.com/primandproper/platform/encoding"
 	"github.com/primandproper/platform/httpclient"
+	"github.com/primandproper/platform/identifiers"
 	"github.com/primandproper/platform/observability"
 	platformkeys "github.com/primandproper/platform/observability/keys"
 	"github.com/primandproper/platform/observability/tracing"
+	"github.com/primandproper/platform/pointer"
 
 	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/metric"
 )
 
+var (
+	errUnexpectedWebhookResponseStatus = errors.New("unexpected webhook response status code")
+)
+
 func (a *AsyncDataChangeMessageHandler) WebhookExecutionRequestsEventHandler(topicName string) func(ctx context.Context, rawMsg []byte) error {
 	return func(ctx context.Context, rawMsg []byte) error {
 		ctx, span := a.tracer.StartSpan(ctx)
@@ -72,9 +81,9 @@ func (a *AsyncDataChangeMessageHandler) handleWebhookExecutionRequest(
 
 	logger := a.logger.WithValue(platformkeys.RequestIDKey, webhookExecutionRequest.RequestID)
 
-	account, err := a.identityRepo.GetAccount(ctx, webhookExecutionRequest.AccountID)
+	encryptionKeys, err := a.identityRepo.GetAccountWebhookEncryptionKeys(ctx, webhookExecutionRequest.AccountID)
 	if err != nil {
-		return observability.PrepareAndLogError(err, logger, span, "getting account")
+		return observability.PrepareAndLogError(err, logger, span, "getting account webhook encryption keys")
 	}
 
 	webhook, err := a.webhookRepo.GetWebhook(ctx, webhookExecutionRequest.WebhookID, webhookExecutionRequest.AccountID)
@@ -83,17 +92,19 @@ func (a *AsyncDataChangeMessageHandler) handleWebhookExecutionRequest(
 		return nil
 	}
 
-	var payloadBody []byte
-	switch webhook.ContentType {
-	case encoding.ContentTypeToString(encoding.ContentTypeJSON):
-		payloadBody, err = json.Marshal(webhookExecutionRequest.Payload)
-		if err != nil {
-			return observability.PrepareAndLogError(err, logger, span, "marshaling webhook payload")
-		}
-	case encoding.ContentTypeToString(encoding.ContentTypeXML):
-		payloadBody, err = xml.Marshal(webhookExecutionRequest.Payload)
-		if err != nil {
-			return observability.PrepareAndLogError(err, logger, span, "marshaling webhook payload")
+	payloadBody := webhookExecutionRequest.RawPayload
+	if len(payloadBody) == 0 {
+		switch webhook.ContentType {
+		case encoding.ContentTypeToString(encoding.ContentTypeJSON):
+			payloadBody, err = json.Marshal(webhookExecutionRequest.Payload)
+			if err != nil {
+				return observability.PrepareAndLogError(err, logger, span, "marshaling webhook payload")
+			}
+		case encoding.ContentTypeToString(encoding.ContentTypeXML):
+			payloadBody, err = xml.Marshal(webhookExecutionRequest.Payload)
+			if err != nil {
+				return observability.PrepareAndLogError(err, logger, span, "marshaling webhook payload")
+			}
 		}
 	}
 
@@ -104,32 +115,97 @@ func (a *AsyncDataChangeMessageHandler) handleWebhookExecutionRequest(
 
 	req.Header.Set("Content-Type", webhook.ContentType)
 
-	decryptedKey, err := hex.DecodeString(account.WebhookEncryptionKey)
-	if err != nil {
-		return observability.PrepareAndLogError(err, logger, span, "decoding webhook encryption key")
+	signingKeys := [][]byte{}
+	for _, encodedKey := range encryptionKeys.ActiveKeys(time.Now()) {
+		decodedKey, decodeErr := hex.DecodeString(encodedKey)
+		if decodeErr != nil {
+			return observability.PrepareAndLogError(decodeErr, logger, span, "decoding webhook encryption key")
+		}
+		signingKeys = append(signingKeys, decodedKey)
 	}
 
-	digest := hmac.New(sha256.New, decryptedKey)
-	digest.Write(payloadBody)
-	req.Header.Set("X-Dinner-Done-Better-Signature", hex.EncodeToString(digest.Sum(nil)))
+	// the event ID is what's signed, so receivers see the same ID on every retry and redelivery of an event.
+	// requests queued before event IDs existed fall back to their request ID, which retries also keep.
+	eventID := cmp.Or(webhookExecutionRequest.EventID, webhookExecutionRequest.RequestID)
+	req.Header.Set(webhooksignature.SignatureHeader, webhooksignature.BuildHeader(time.Now(), eventID, payloadBody, signingKeys...))
+
+	deliveryInput := &webhooks.WebhookDeliveryDatabaseCreationInput{
+		ID:               identifiers.New(),
+		BelongsToWebhook: webhook.ID,
+		BelongsToAccount: webhookExecutionRequest.AccountID,
+		RequestID:        webhookExecutionRequest.RequestID,
+		EventID:          eventID,
+		TriggerEvent:     webhookExecutionRequest.TriggerEvent,
+		AttemptNumber:    max(webhookExecutionRequest.AttemptNumber, 1),
+		RequestBody:      string(payloadBody),
+	}
 
+	requestStart := time.Now()
 	res, err := httpclient.ProvideHTTPClient(&httpclient.Config{EnableTracing: true}).Do(req) //nolint:gosec // G704: webhook URL is admin-configured; webhooks intentionally deliver to external URLs
+	deliveryInput.LatencyInMilliseconds = uint64(time.Since(requestStart).Milliseconds())
+
+	var deliveryErr error
 	if err != nil {
-		observability.AcknowledgeError(err, logger, span, "executing webhook request")
-		return nil
-	}
-	defer func() {
-		if err = res.Body.Close(); err != nil {
-			logger.Error("closing response body", err)
+		deliveryErr = err
+	} else {
+		defer func() {
+			if err = res.Body.Close(); err != nil {
+				logger.Error("closing response body", err)
+			}
+		}()
+
+		logger = logger.WithResponse(res)
+		tracing.AttachResponseToSpan(span, res)
+
+		responseBody, readErr := io.ReadAll(io.LimitReader(res.Body, webhooks.WebhookDeliveryResponseBodyMaxLength))
+		if readErr != nil {
+			observability.AcknowledgeError(readErr, logger, span, "reading webhook response body")
 		}
-	}()
 
-	logger = logger.WithResponse(res)
-	tracing.AttachResponseToSpan(span, res)
+		deliveryInput.ResponseStatusCode = pointer.To(uint16(res.StatusCode))
+		deliveryInput.ResponseBody = webhooks.TruncateWebhookDeliveryResponseBody(responseBody)
 
-	if res.StatusCode < 200 || res.StatusCode > 299 {
-		observability.AcknowledgeError(err, logger, span, "invalid response type")
-		return nil
+		if res.StatusCode < 200 || res.StatusCode > 299 {
+			deliveryErr = fmt.Errorf("%w: %d", errUnexpectedWebhookResponseStatus, res.StatusCode)
+		}
+	}
+
+	return a.recordWebhookDeliveryAttempt(ctx, webhookExecutionRequest, deliveryInput, deliveryErr)
+}
+
+// recordWebhookDeliveryAttempt persists the outcome of a delivery attempt. A failed attempt with attempts
+// remaining is recorded with the time of its retry, which the webhook delivery retrier picks up once it's due.
+func (a *AsyncDataChangeMessageHandler) recordWebhookDeliveryAttempt(
+	ctx context.Context,
+	webhookExecutionRequest *webhooks.WebhookExecutionRequest,
+	deliveryInput *webhooks.WebhookDeliveryDatabaseCreationInput,
+	deliveryErr error,
+) error {
+	ctx, span := a.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := a.logger.WithValue(platformkeys.RequestIDKey, webhookExecutionRequest.RequestID).
+		WithValue(webhookkeys.WebhookIDKey, deliveryInput.BelongsToWebhook).
+		WithValue("attempt_number", deliveryInput.AttemptNumber)
+
+	switch {
+	case deliveryErr == nil:
+		deliveryInput.Status = webhooks.WebhookDeliveryStatusSucceeded
+	case deliveryInput.AttemptNumber >= webhooks.DefaultWebhookDeliveryMaxAttempts:
+		deliveryInput.Status = webhooks.WebhookDeliveryStatusDeadLettered
+		deliveryInput.ErrorMessage = deliveryErr.Error()
+		observability.AcknowledgeError(deliveryErr, logger, span, "webhook delivery dead-lettered")
+	default:
+		nextAttemptAt := time.Now().Add(webhooks.WebhookDeliveryBackoff(deliveryInput.AttemptNumber))
+		deliveryInput.Status = webhooks.WebhookDeliveryStatusFailed
+		deliveryInput.ErrorMessage = deliveryErr.Error()
+		deliveryInput.NextAttemptAt = &nextAttemptAt
+		observability.AcknowledgeError(deliveryErr, logger, span, "executing webhook request")
+	}
+
+	// the recorded attempt is what schedules the retry, so failing to record it fails the message.
+	if _, err := a.webhookRepo.CreateWebhookDelivery(ctx, deliveryInput); err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "recording webhook delivery")
 	}
 
 	return nil
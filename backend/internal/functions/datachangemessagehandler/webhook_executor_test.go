@@ -1,19 +1,28 @@
 package datachangemessagehandler
 
 import (
+	"context"
+	"encoding/hex"
 	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
 	"testing"
+	"time"
 
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/audit"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity"
 	identityfakes "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity/fakes"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/webhooks"
 	webhooksfakes "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/webhooks/fakes"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/pkg/webhooksignature"
 
+	msgqueuemock "github.com/primandproper/platform/messagequeue/mock"
 	"github.com/primandproper/platform/reflection"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 )
 
 func TestAsyncDataChangeMessageHandler_handleWebhookExecutionRequest(t *testing.T) {
@@ -46,7 +55,7 @@ func TestAsyncDataChangeMessageHandler_handleWebhookExecutionRequest(t *testing.
 		}
 
 		expectedError := errors.New("account fetch error")
-		identityRepo.On(reflection.GetMethodName(identityRepo.GetAccount), mock.Anything, "test-account-id").Return((*identity.Account)(nil), expectedError)
+		identityRepo.On(reflection.GetMethodName(identityRepo.GetAccountWebhookEncryptionKeys), mock.Anything, "test-account-id").Return((*identity.AccountWebhookEncryptionKeys)(nil), expectedError)
 
 		err := handler.handleWebhookExecutionRequest(ctx, webhookExecutionRequest)
 		assert.Error(t, err)
@@ -72,7 +81,7 @@ func TestAsyncDataChangeMessageHandler_handleWebhookExecutionRequest(t *testing.
 		}
 
 		expectedError := errors.New("webhook fetch error")
-		identityRepo.On(reflection.GetMethodName(identityRepo.GetAccount), mock.Anything, account.ID).Return(account, nil)
+		identityRepo.On(reflection.GetMethodName(identityRepo.GetAccountWebhookEncryptionKeys), mock.Anything, account.ID).Return(&identity.AccountWebhookEncryptionKeys{Current: account.WebhookEncryptionKey}, nil)
 		webhookRepo.On(reflection.GetMethodName(webhookRepo.GetWebhook), mock.Anything, "test-webhook-id", account.ID).Return((*webhooks.Webhook)(nil), expectedError)
 
 		err := handler.handleWebhookExecutionRequest(ctx, webhookExecutionRequest)
@@ -101,7 +110,7 @@ func TestAsyncDataChangeMessageHandler_handleWebhookExecutionRequest(t *testing.
 			Payload:   &audit.DataChangeMessage{},
 		}
 
-		identityRepo.On(reflection.GetMethodName(identityRepo.GetAccount), mock.Anything, account.ID).Return(account, nil)
+		identityRepo.On(reflection.GetMethodName(identityRepo.GetAccountWebhookEncryptionKeys), mock.Anything, account.ID).Return(&identity.AccountWebhookEncryptionKeys{Current: account.WebhookEncryptionKey}, nil)
 		webhookRepo.On(reflection.GetMethodName(webhookRepo.GetWebhook), mock.Anything, webhook.ID, account.ID).Return(webhook, nil)
 
 		err := handler.handleWebhookExecutionRequest(ctx, webhookExecutionRequest)
@@ -137,11 +146,12 @@ func TestAsyncDataChangeMessageHandler_handleWebhookExecutionRequest(t *testing.
 			},
 		}
 
-		identityRepo.On(reflection.GetMethodName(identityRepo.GetAccount), mock.Anything, account.ID).Return(account, nil)
+		identityRepo.On(reflection.GetMethodName(identityRepo.GetAccountWebhookEncryptionKeys), mock.Anything, account.ID).Return(&identity.AccountWebhookEncryptionKeys{Current: account.WebhookEncryptionKey}, nil)
 		webhookRepo.On(reflection.GetMethodName(webhookRepo.GetWebhook), mock.Anything, webhook.ID, account.ID).Return(webhook, nil)
+		webhookRepo.On(reflection.GetMethodName(webhookRepo.CreateWebhookDelivery), mock.Anything, mock.AnythingOfType("*webhooks.WebhookDeliveryDatabaseCreationInput")).Return(&webhooks.WebhookDelivery{}, nil)
 
 		err := handler.handleWebhookExecutionRequest(ctx, webhookExecutionRequest)
-		// We expect no error to be returned even if HTTP request fails (it gets logged)
+		// We expect no error to be returned even if HTTP request fails (it gets recorded and retried)
 		assert.NoError(t, err)
 
 		mock.AssertExpectationsForObjects(t, identityRepo, webhookRepo)
@@ -174,7 +184,7 @@ func TestAsyncDataChangeMessageHandler_handleWebhookExecutionRequest(t *testing.
 			},
 		}
 
-		identityRepo.On(reflection.GetMethodName(identityRepo.GetAccount), mock.Anything, account.ID).Return(account, nil)
+		identityRepo.On(reflection.GetMethodName(identityRepo.GetAccountWebhookEncryptionKeys), mock.Anything, account.ID).Return(&identity.AccountWebhookEncryptionKeys{Current: account.WebhookEncryptionKey}, nil)
 		webhookRepo.On(reflection.GetMethodName(webhookRepo.GetWebhook), mock.Anything, webhook.ID, account.ID).Return(webhook, nil)
 
 		err := handler.handleWebhookExecutionRequest(ctx, webhookExecutionRequest)
@@ -184,4 +194,255 @@ func TestAsyncDataChangeMessageHandler_handleWebhookExecutionRequest(t *testing.
 
 		mock.AssertExpectationsForObjects(t, identityRepo, webhookRepo)
 	})
+
+	t.Run("records successful delivery", func(t *testing.T) {
+		t.Parallel()
+
+		handler, identityRepo, webhookRepo, _, _, _, _, _, _, _, _ := buildTestAsyncDataChangeMessageHandler(t)
+
+		ctx := t.Context()
+
+		ts := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, _ *http.Request) {
+			res.WriteHeader(http.StatusAccepted)
+			_, _ = res.Write([]byte("thanks"))
+		}))
+		defer ts.Close()
+
+		account := identityfakes.BuildFakeAccount()
+		account.WebhookEncryptionKey = "deadbeefdeadbeefdeadbeefdeadbeef"
+
+		webhook := webhooksfakes.BuildFakeWebhook()
+		webhook.ContentType = "application/json"
+		webhook.Method = http.MethodPost
+		webhook.URL = ts.URL
+
+		webhookExecutionRequest := &webhooks.WebhookExecutionRequest{
+			WebhookID:     webhook.ID,
+			AccountID:     account.ID,
+			RequestID:     "test-request-id",
+			AttemptNumber: 1,
+			Payload:       &audit.DataChangeMessage{EventType: identity.UserSignedUpServiceEventType},
+		}
+
+		identityRepo.On(reflection.GetMethodName(identityRepo.GetAccountWebhookEncryptionKeys), mock.Anything, account.ID).Return(&identity.AccountWebhookEncryptionKeys{Current: account.WebhookEncryptionKey}, nil)
+		webhookRepo.On(reflection.GetMethodName(webhookRepo.GetWebhook), mock.Anything, webhook.ID, account.ID).Return(webhook, nil)
+		webhookRepo.On(reflection.GetMethodName(webhookRepo.CreateWebhookDelivery), mock.Anything, mock.MatchedBy(func(input *webhooks.WebhookDeliveryDatabaseCreationInput) bool {
+			return input.Status == webhooks.WebhookDeliveryStatusSucceeded &&
+				input.ResponseStatusCode != nil && *input.ResponseStatusCode == http.StatusAccepted &&
+				input.ResponseBody == "thanks" &&
+				input.RequestID == webhookExecutionRequest.RequestID &&
+				input.AttemptNumber == 1 &&
+				input.NextAttemptAt == nil
+		})).Return(&webhooks.WebhookDelivery{}, nil)
+
+		handler.webhookExecutionRequestPublisher = &msgqueuemock.PublisherMock{
+			PublishFunc: func(_ context.Context, _ any) error {
+				t.Error("successful deliveries should not be retried")
+				return nil
+			},
+		}
+
+		assert.NoError(t, handler.handleWebhookExecutionRequest(ctx, webhookExecutionRequest))
+
+		mock.AssertExpectationsForObjects(t, identityRepo, webhookRepo)
+	})
+
+	t.Run("signs request with every active key", func(t *testing.T) {
+		t.Parallel()
+
+		handler, identityRepo, webhookRepo, _, _, _, _, _, _, _, _ := buildTestAsyncDataChangeMessageHandler(t)
+
+		ctx := t.Context()
+
+		var (
+			receivedBody      []byte
+			receivedSignature string
+		)
+		ts := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			receivedBody, _ = io.ReadAll(req.Body)
+			receivedSignature = req.Header.Get(webhooksignature.SignatureHeader)
+			res.WriteHeader(http.StatusOK)
+		}))
+		defer ts.Close()
+
+		account := identityfakes.BuildFakeAccount()
+		rotatedAt := time.Now().Add(-time.Hour)
+		encryptionKeys := &identity.AccountWebhookEncryptionKeys{
+			Current:   "deadbeefdeadbeefdeadbeefdeadbeef",
+			Previous:  "cafebabecafebabecafebabecafebabe",
+			RotatedAt: &rotatedAt,
+		}
+
+		webhook := webhooksfakes.BuildFakeWebhook()
+		webhook.ContentType = "application/json"
+		webhook.Method = http.MethodPost
+		webhook.URL = ts.URL
+
+		webhookExecutionRequest := &webhooks.WebhookExecutionRequest{
+			WebhookID:     webhook.ID,
+			AccountID:     account.ID,
+			RequestID:     "test-request-id",
+			EventID:       "test-event-id",
+			AttemptNumber: 1,
+			Payload:       &audit.DataChangeMessage{EventType: identity.UserSignedUpServiceEventType},
+		}
+
+		identityRepo.On(reflection.GetMethodName(identityRepo.GetAccountWebhookEncryptionKeys), mock.Anything, account.ID).Return(encryptionKeys, nil)
+		webhookRepo.On(reflection.GetMethodName(webhookRepo.GetWebhook), mock.Anything, webhook.ID, account.ID).Return(webhook, nil)
+		webhookRepo.On(reflection.GetMethodName(webhookRepo.CreateWebhookDelivery), mock.Anything, mock.MatchedBy(func(input *webhooks.WebhookDeliveryDatabaseCreationInput) bool {
+			return input.EventID == webhookExecutionRequest.EventID
+		})).Return(&webhooks.WebhookDelivery{}, nil)
+
+		require.NoError(t, handler.handleWebhookExecutionRequest(ctx, webhookExecutionRequest))
+
+		currentKey, err := hex.DecodeString(encryptionKeys.Current)
+		require.NoError(t, err)
+		previousKey, err := hex.DecodeString(encryptionKeys.Previous)
+		require.NoError(t, err)
+
+		for _, key := range [][]byte{currentKey, previousKey} {
+			parsed, verifyErr := webhooksignature.Verify(receivedSignature, receivedBody, time.Now(), webhooksignature.DefaultTolerance, key)
+			require.NoError(t, verifyErr)
+			assert.Equal(t, webhookExecutionRequest.EventID, parsed.EventID)
+		}
+
+		mock.AssertExpectationsForObjects(t, identityRepo, webhookRepo)
+	})
+
+	t.Run("schedules retry for failed delivery", func(t *testing.T) {
+		t.Parallel()
+
+		handler, identityRepo, webhookRepo, _, _, _, _, _, _, _, _ := buildTestAsyncDataChangeMessageHandler(t)
+
+		ctx := t.Context()
+
+		var receivedBody []byte
+		ts := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, req *http.Request) {
+			receivedBody, _ = io.ReadAll(req.Body)
+			res.WriteHeader(http.StatusInternalServerError)
+		}))
+		defer ts.Close()
+
+		account := identityfakes.BuildFakeAccount()
+		account.WebhookEncryptionKey = "deadbeefdeadbeefdeadbeefdeadbeef"
+
+		webhook := webhooksfakes.BuildFakeWebhook()
+		webhook.ContentType = "application/json"
+		webhook.Method = http.MethodPost
+		webhook.URL = ts.URL
+
+		rawPayload := []byte(`{"things":"stuff"}`)
+		webhookExecutionRequest := &webhooks.WebhookExecutionRequest{
+			WebhookID:     webhook.ID,
+			AccountID:     account.ID,
+			RequestID:     "test-request-id",
+			AttemptNumber: 2,
+			RawPayload:    rawPayload,
+		}
+
+		identityRepo.On(reflection.GetMethodName(identityRepo.GetAccountWebhookEncryptionKeys), mock.Anything, account.ID).Return(&identity.AccountWebhookEncryptionKeys{Current: account.WebhookEncryptionKey}, nil)
+		webhookRepo.On(reflection.GetMethodName(webhookRepo.GetWebhook), mock.Anything, webhook.ID, account.ID).Return(webhook, nil)
+		webhookRepo.On(reflection.GetMethodName(webhookRepo.CreateWebhookDelivery), mock.Anything, mock.MatchedBy(func(input *webhooks.WebhookDeliveryDatabaseCreationInput) bool {
+			return input.Status == webhooks.WebhookDeliveryStatusFailed &&
+				input.ResponseStatusCode != nil && *input.ResponseStatusCode == http.StatusInternalServerError &&
+				input.ErrorMessage != "" &&
+				input.AttemptNumber == 2 &&
+				input.NextAttemptAt != nil
+		})).Return(&webhooks.WebhookDelivery{}, nil)
+
+		handler.webhookExecutionRequestPublisher = &msgqueuemock.PublisherMock{
+			PublishFunc: func(_ context.Context, _ any) error {
+				t.Error("retries should be left to the webhook delivery retrier")
+				return nil
+			},
+		}
+
+		assert.NoError(t, handler.handleWebhookExecutionRequest(ctx, webhookExecutionRequest))
+
+		assert.Equal(t, rawPayload, receivedBody)
+
+		mock.AssertExpectationsForObjects(t, identityRepo, webhookRepo)
+	})
+
+	t.Run("dead-letters delivery after max attempts", func(t *testing.T) {
+		t.Parallel()
+
+		handler, identityRepo, webhookRepo, _, _, _, _, _, _, _, _ := buildTestAsyncDataChangeMessageHandler(t)
+
+		ctx := t.Context()
+
+		ts := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, _ *http.Request) {
+			res.WriteHeader(http.StatusBadGateway)
+		}))
+		defer ts.Close()
+
+		account := identityfakes.BuildFakeAccount()
+		account.WebhookEncryptionKey = "deadbeefdeadbeefdeadbeefdeadbeef"
+
+		webhook := webhooksfakes.BuildFakeWebhook()
+		webhook.ContentType = "application/json"
+		webhook.Method = http.MethodPost
+		webhook.URL = ts.URL
+
+		webhookExecutionRequest := &webhooks.WebhookExecutionRequest{
+			WebhookID:     webhook.ID,
+			AccountID:     account.ID,
+			RequestID:     "test-request-id",
+			AttemptNumber: webhooks.DefaultWebhookDeliveryMaxAttempts,
+			RawPayload:    []byte(`{}`),
+		}
+
+		identityRepo.On(reflection.GetMethodName(identityRepo.GetAccountWebhookEncryptionKeys), mock.Anything, account.ID).Return(&identity.AccountWebhookEncryptionKeys{Current: account.WebhookEncryptionKey}, nil)
+		webhookRepo.On(reflection.GetMethodName(webhookRepo.GetWebhook), mock.Anything, webhook.ID, account.ID).Return(webhook, nil)
+		webhookRepo.On(reflection.GetMethodName(webhookRepo.CreateWebhookDelivery), mock.Anything, mock.MatchedBy(func(input *webhooks.WebhookDeliveryDatabaseCreationInput) bool {
+			return input.Status == webhooks.WebhookDeliveryStatusDeadLettered && input.NextAttemptAt == nil
+		})).Return(&webhooks.WebhookDelivery{}, nil)
+
+		handler.webhookExecutionRequestPublisher = &msgqueuemock.PublisherMock{
+			PublishFunc: func(_ context.Context, _ any) error {
+				t.Error("dead-lettered deliveries should not be retried")
+				return nil
+			},
+		}
+
+		assert.NoError(t, handler.handleWebhookExecutionRequest(ctx, webhookExecutionRequest))
+
+		mock.AssertExpectationsForObjects(t, identityRepo, webhookRepo)
+	})
+
+	t.Run("with error recording delivery", func(t *testing.T) {
+		t.Parallel()
+
+		handler, identityRepo, webhookRepo, _, _, _, _, _, _, _, _ := buildTestAsyncDataChangeMessageHandler(t)
+
+		ctx := t.Context()
+
+		ts := httptest.NewServer(http.HandlerFunc(func(res http.ResponseWriter, _ *http.Request) {
+			res.WriteHeader(http.StatusServiceUnavailable)
+		}))
+		defer ts.Close()
+
+		account := identityfakes.BuildFakeAccount()
+		account.WebhookEncryptionKey = "deadbeefdeadbeefdeadbeefdeadbeef"
+
+		webhook := webhooksfakes.BuildFakeWebhook()
+		webhook.ContentType = "application/json"
+		webhook.Method = http.MethodPost
+		webhook.URL = ts.URL
+
+		webhookExecutionRequest := &webhooks.WebhookExecutionRequest{
+			WebhookID:  webhook.ID,
+			AccountID:  account.ID,
+			RequestID:  "test-request-id",
+			RawPayload: []byte(`{}`),
+		}
+
+		identityRepo.On(reflection.GetMethodName(identityRepo.GetAccountWebhookEncryptionKeys), mock.Anything, account.ID).Return(&identity.AccountWebhookEncryptionKeys{Current: account.WebhookEncryptionKey}, nil)
+		webhookRepo.On(reflection.GetMethodName(webhookRepo.GetWebhook), mock.Anything, webhook.ID, account.ID).Return(webhook, nil)
+		webhookRepo.On(reflection.GetMethodName(webhookRepo.CreateWebhookDelivery), mock.Anything, mock.AnythingOfType("*webhooks.WebhookDeliveryDatabaseCreationInput")).Return((*webhooks.WebhookDelivery)(nil), errors.New("blah"))
+
+		assert.Error(t, handler.handleWebhookExecutionRequest(ctx, webhookExecutionRequest))
+
+		mock.AssertExpectationsForObjects(t, identityRepo, webhookRepo)
+	})
 }
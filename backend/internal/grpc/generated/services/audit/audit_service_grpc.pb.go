@@ -20,18 +20,24 @@
 const _ = grpc.SupportPackageIsVersion9
 
 const (
+	AuditService_AdminVerifyAuditLogChain_FullMethodName     = "/audit.AuditService/AdminVerifyAuditLogChain"
 	AuditService_GetAuditLogEntriesForAccount_FullMethodName = "/audit.AuditService/GetAuditLogEntriesForAccount"
 	AuditService_GetAuditLogEntriesForUser_FullMethodName    = "/audit.AuditService/GetAuditLogEntriesForUser"
 	AuditService_GetAuditLogEntryByID_FullMethodName         = "/audit.AuditService/GetAuditLogEntryByID"
+	AuditService_SearchAuditLogEntries_FullMethodName        = "/audit.AuditService/SearchAuditLogEntries"
+	AuditService_ExportAuditLogEntries_FullMethodName        = "/audit.AuditService/ExportAuditLogEntries"
 )
 
 // AuditServiceClient is the client API for AuditService service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type AuditServiceClient interface {
+	AdminVerifyAuditLogChain(ctx context.Context, in *AdminVerifyAuditLogChainRequest, opts ...grpc.CallOption) (*AdminVerifyAuditLogChainResponse, error)
 	GetAuditLogEntriesForAccount(ctx context.Context, in *GetAuditLogEntriesForAccountRequest, opts ...grpc.CallOption) (*GetAuditLogEntriesForAccountResponse, error)
 	GetAuditLogEntriesForUser(ctx context.Context, in *GetAuditLogEntriesForUserRequest, opts ...grpc.CallOption) (*GetAuditLogEntriesForUserResponse, error)
 	GetAuditLogEntryByID(ctx context.Context, in *GetAuditLogEntryByIDRequest, opts ...grpc.CallOption) (*GetAuditLogEntryByIDResponse, error)
+	SearchAuditLogEntries(ctx context.Context, in *SearchAuditLogEntriesRequest, opts ...grpc.CallOption) (*SearchAuditLogEntriesResponse, error)
+	ExportAuditLogEntries(ctx context.Context, in *ExportAuditLogEntriesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportAuditLogEntriesResponse], error)
 }
 
 type auditServiceClient struct {
@@ -42,6 +48,16 @@ func NewAuditServiceClient(cc grpc.ClientConnInterface) AuditServiceClient {
 	return &auditServiceClient{cc}
 }
 
+func (c *auditServiceClient) AdminVerifyAuditLogChain(ctx context.Context, in *AdminVerifyAuditLogChainRequest, opts ...grpc.CallOption) (*AdminVerifyAuditLogChainResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(AdminVerifyAuditLogChainResponse)
+	err := c.cc.Invoke(ctx, AuditService_AdminVerifyAuditLogChain_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *auditServiceClient) GetAuditLogEntriesForAccount(ctx context.Context, in *GetAuditLogEntriesForAccountRequest, opts ...grpc.CallOption) (*GetAuditLogEntriesForAccountResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetAuditLogEntriesForAccountResponse)
@@ -72,13 +88,45 @@ func (c *auditServiceClient) GetAuditLogEntryByID(ctx context.Context, in *GetAu
 	return out, nil
 }
 
+func (c *auditServiceClient) SearchAuditLogEntries(ctx context.Context, in *SearchAuditLogEntriesRequest, opts ...grpc.CallOption) (*SearchAuditLogEntriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(SearchAuditLogEntriesResponse)
+	err := c.cc.Invoke(ctx, AuditService_SearchAuditLogEntries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *auditServiceClient) ExportAuditLogEntries(ctx context.Context, in *ExportAuditLogEntriesRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[ExportAuditLogEntriesResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &AuditService_ServiceDesc.Streams[0], AuditService_ExportAuditLogEntries_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ExportAuditLogEntriesRequest, ExportAuditLogEntriesResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AuditService_ExportAuditLogEntriesClient = grpc.ServerStreamingClient[ExportAuditLogEntriesResponse]
+
 // AuditServiceServer is the server API for AuditService service.
 // All implementations must embed UnimplementedAuditServiceServer
 // for forward compatibility.
 type AuditServiceServer interface {
+	AdminVerifyAuditLogChain(context.Context, *AdminVerifyAuditLogChainRequest) (*AdminVerifyAuditLogChainResponse, error)
 	GetAuditLogEntriesForAccount(context.Context, *GetAuditLogEntriesForAccountRequest) (*GetAuditLogEntriesForAccountResponse, error)
 	GetAuditLogEntriesForUser(context.Context, *GetAuditLogEntriesForUserRequest) (*GetAuditLogEntriesForUserResponse, error)
 	GetAuditLogEntryByID(context.Context, *GetAuditLogEntryByIDRequest) (*GetAuditLogEntryByIDResponse, error)
+	SearchAuditLogEntries(context.Context, *SearchAuditLogEntriesRequest) (*SearchAuditLogEntriesResponse, error)
+	ExportAuditLogEntries(*ExportAuditLogEntriesRequest, grpc.ServerStreamingServer[ExportAuditLogEntriesResponse]) error
 	mustEmbedUnimplementedAuditServiceServer()
 }
 
@@ -89,6 +137,9 @@ type AuditServiceServer interface {
 // pointer dereference when methods are called.
 type UnimplementedAuditServiceServer struct{}
 
+func (UnimplementedAuditServiceServer) AdminVerifyAuditLogChain(context.Context, *AdminVerifyAuditLogChainRequest) (*AdminVerifyAuditLogChainResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AdminVerifyAuditLogChain not implemented")
+}
 func (UnimplementedAuditServiceServer) GetAuditLogEntriesForAccount(context.Context, *GetAuditLogEntriesForAccountRequest) (*GetAuditLogEntriesForAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAuditLogEntriesForAccount not implemented")
 }
@@ -98,6 +149,12 @@ func (UnimplementedAuditServiceServer) GetAuditLogEntriesForUser(context.Context
 func (UnimplementedAuditServiceServer) GetAuditLogEntryByID(context.Context, *GetAuditLogEntryByIDRequest) (*GetAuditLogEntryByIDResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAuditLogEntryByID not implemented")
 }
+func (UnimplementedAuditServiceServer) SearchAuditLogEntries(context.Context, *SearchAuditLogEntriesRequest) (*SearchAuditLogEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchAuditLogEntries not implemented")
+}
+func (UnimplementedAuditServiceServer) ExportAuditLogEntries(*ExportAuditLogEntriesRequest, grpc.ServerStreamingServer[ExportAuditLogEntriesResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method ExportAuditLogEntries not implemented")
+}
 func (UnimplementedAuditServiceServer) mustEmbedUnimplementedAuditServiceServer() {}
 func (UnimplementedAuditServiceServer) testEmbeddedByValue()                      {}
 
@@ -119,6 +176,24 @@ func RegisterAuditServiceServer(s grpc.ServiceRegistrar, srv AuditServiceServer)
 	s.RegisterService(&AuditService_ServiceDesc, srv)
 }
 
+func _AuditService_AdminVerifyAuditLogChain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AdminVerifyAuditLogChainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuditServiceServer).AdminVerifyAuditLogChain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuditService_AdminVerifyAuditLogChain_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuditServiceServer).AdminVerifyAuditLogChain(ctx, req.(*AdminVerifyAuditLogChainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _AuditService_GetAuditLogEntriesForAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetAuditLogEntriesForAccountRequest)
 	if err := dec(in); err != nil {
@@ -173,6 +248,35 @@ func _AuditService_GetAuditLogEntryByID_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _AuditService_SearchAuditLogEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchAuditLogEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AuditServiceServer).SearchAuditLogEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: AuditService_SearchAuditLogEntries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AuditServiceServer).SearchAuditLogEntries(ctx, req.(*SearchAuditLogEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AuditService_ExportAuditLogEntries_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportAuditLogEntriesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AuditServiceServer).ExportAuditLogEntries(m, &grpc.GenericServerStream[ExportAuditLogEntriesRequest, ExportAuditLogEntriesResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type AuditService_ExportAuditLogEntriesServer = grpc.ServerStreamingServer[ExportAuditLogEntriesResponse]
+
 // AuditService_ServiceDesc is the grpc.ServiceDesc for AuditService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -180,6 +284,10 @@ func _AuditService_GetAuditLogEntryByID_Handler(srv interface{}, ctx context.Con
 	ServiceName: "audit.AuditService",
 	HandlerType: (*AuditServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "AdminVerifyAuditLogChain",
+			Handler:    _AuditService_AdminVerifyAuditLogChain_Handler,
+		},
 		{
 			MethodName: "GetAuditLogEntriesForAccount",
 			Handler:    _AuditService_GetAuditLogEntriesForAccount_Handler,
@@ -192,7 +300,17 @@ func _AuditService_GetAuditLogEntryByID_Handler(srv interface{}, ctx context.Con
 			MethodName: "GetAuditLogEntryByID",
 			Handler:    _AuditService_GetAuditLogEntryByID_Handler,
 		},
+		{
+			MethodName: "SearchAuditLogEntries",
+			Handler:    _AuditService_SearchAuditLogEntries_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ExportAuditLogEntries",
+			Handler:       _AuditService_ExportAuditLogEntries_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "audit/audit_service.proto",
 }
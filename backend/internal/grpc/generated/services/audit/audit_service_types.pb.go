@@ -345,6 +345,374 @@ func (x *GetAuditLogEntryByIDResponse) GetResult() *AuditLogEntry {
 	return nil
 }
 
+type SearchAuditLogEntriesRequest struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Filter        *filtering.QueryFilter    `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	Input         *AuditLogEntrySearchInput `protobuf:"bytes,2,opt,name=input,proto3" json:"input,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchAuditLogEntriesRequest) Reset() {
+	*x = SearchAuditLogEntriesRequest{}
+	mi := &file_audit_audit_service_types_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchAuditLogEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchAuditLogEntriesRequest) ProtoMessage() {}
+
+func (x *SearchAuditLogEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_audit_audit_service_types_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchAuditLogEntriesRequest.ProtoReflect.Descriptor instead.
+func (*SearchAuditLogEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_audit_audit_service_types_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SearchAuditLogEntriesRequest) GetFilter() *filtering.QueryFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *SearchAuditLogEntriesRequest) GetInput() *AuditLogEntrySearchInput {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+type SearchAuditLogEntriesResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ResponseDetails *types.ResponseDetails `protobuf:"bytes,1,opt,name=response_details,json=responseDetails,proto3" json:"response_details,omitempty"`
+	Pagination      *filtering.Pagination  `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Results         []*AuditLogEntry       `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SearchAuditLogEntriesResponse) Reset() {
+	*x = SearchAuditLogEntriesResponse{}
+	mi := &file_audit_audit_service_types_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchAuditLogEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchAuditLogEntriesResponse) ProtoMessage() {}
+
+func (x *SearchAuditLogEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_audit_audit_service_types_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchAuditLogEntriesResponse.ProtoReflect.Descriptor instead.
+func (*SearchAuditLogEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_audit_audit_service_types_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *SearchAuditLogEntriesResponse) GetResponseDetails() *types.ResponseDetails {
+	if x != nil {
+		return x.ResponseDetails
+	}
+	return nil
+}
+
+func (x *SearchAuditLogEntriesResponse) GetPagination() *filtering.Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *SearchAuditLogEntriesResponse) GetResults() []*AuditLogEntry {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type ExportAuditLogEntriesRequest struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Filter        *filtering.QueryFilter    `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	Input         *AuditLogEntrySearchInput `protobuf:"bytes,2,opt,name=input,proto3" json:"input,omitempty"`
+	Format        string                    `protobuf:"bytes,3,opt,name=format,proto3" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportAuditLogEntriesRequest) Reset() {
+	*x = ExportAuditLogEntriesRequest{}
+	mi := &file_audit_audit_service_types_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportAuditLogEntriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportAuditLogEntriesRequest) ProtoMessage() {}
+
+func (x *ExportAuditLogEntriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_audit_audit_service_types_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportAuditLogEntriesRequest.ProtoReflect.Descriptor instead.
+func (*ExportAuditLogEntriesRequest) Descriptor() ([]byte, []int) {
+	return file_audit_audit_service_types_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ExportAuditLogEntriesRequest) GetFilter() *filtering.QueryFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+func (x *ExportAuditLogEntriesRequest) GetInput() *AuditLogEntrySearchInput {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+func (x *ExportAuditLogEntriesRequest) GetFormat() string {
+	if x != nil {
+		return x.Format
+	}
+	return ""
+}
+
+type ExportAuditLogEntriesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ContentType   string                 `protobuf:"bytes,1,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Chunk         []byte                 `protobuf:"bytes,2,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportAuditLogEntriesResponse) Reset() {
+	*x = ExportAuditLogEntriesResponse{}
+	mi := &file_audit_audit_service_types_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportAuditLogEntriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportAuditLogEntriesResponse) ProtoMessage() {}
+
+func (x *ExportAuditLogEntriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_audit_audit_service_types_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportAuditLogEntriesResponse.ProtoReflect.Descriptor instead.
+func (*ExportAuditLogEntriesResponse) Descriptor() ([]byte, []int) {
+	return file_audit_audit_service_types_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ExportAuditLogEntriesResponse) GetContentType() string {
+	if x != nil {
+		return x.ContentType
+	}
+	return ""
+}
+
+func (x *ExportAuditLogEntriesResponse) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+type AdminVerifyAuditLogChainRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountId     string                 `protobuf:"bytes,1,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AdminVerifyAuditLogChainRequest) Reset() {
+	*x = AdminVerifyAuditLogChainRequest{}
+	mi := &file_audit_audit_service_types_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminVerifyAuditLogChainRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminVerifyAuditLogChainRequest) ProtoMessage() {}
+
+func (x *AdminVerifyAuditLogChainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_audit_audit_service_types_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminVerifyAuditLogChainRequest.ProtoReflect.Descriptor instead.
+func (*AdminVerifyAuditLogChainRequest) Descriptor() ([]byte, []int) {
+	return file_audit_audit_service_types_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AdminVerifyAuditLogChainRequest) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+type AdminVerifyAuditLogChainResponse struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	ResponseDetails      *types.ResponseDetails `protobuf:"bytes,1,opt,name=response_details,json=responseDetails,proto3" json:"response_details,omitempty"`
+	FirstBrokenLink      *AuditLogChainBreak    `protobuf:"bytes,2,opt,name=first_broken_link,json=firstBrokenLink,proto3" json:"first_broken_link,omitempty"`
+	AccountId            string                 `protobuf:"bytes,3,opt,name=account_id,json=accountId,proto3" json:"account_id,omitempty"`
+	Intact               bool                   `protobuf:"varint,4,opt,name=intact,proto3" json:"intact,omitempty"`
+	SignaturesVerified   bool                   `protobuf:"varint,5,opt,name=signatures_verified,json=signaturesVerified,proto3" json:"signatures_verified,omitempty"`
+	EntriesChecked       uint64                 `protobuf:"varint,6,opt,name=entries_checked,json=entriesChecked,proto3" json:"entries_checked,omitempty"`
+	CheckpointsChecked   uint64                 `protobuf:"varint,7,opt,name=checkpoints_checked,json=checkpointsChecked,proto3" json:"checkpoints_checked,omitempty"`
+	LastVerifiedSequence uint64                 `protobuf:"varint,8,opt,name=last_verified_sequence,json=lastVerifiedSequence,proto3" json:"last_verified_sequence,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *AdminVerifyAuditLogChainResponse) Reset() {
+	*x = AdminVerifyAuditLogChainResponse{}
+	mi := &file_audit_audit_service_types_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AdminVerifyAuditLogChainResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AdminVerifyAuditLogChainResponse) ProtoMessage() {}
+
+func (x *AdminVerifyAuditLogChainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_audit_audit_service_types_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AdminVerifyAuditLogChainResponse.ProtoReflect.Descriptor instead.
+func (*AdminVerifyAuditLogChainResponse) Descriptor() ([]byte, []int) {
+	return file_audit_audit_service_types_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *AdminVerifyAuditLogChainResponse) GetResponseDetails() *types.ResponseDetails {
+	if x != nil {
+		return x.ResponseDetails
+	}
+	return nil
+}
+
+func (x *AdminVerifyAuditLogChainResponse) GetFirstBrokenLink() *AuditLogChainBreak {
+	if x != nil {
+		return x.FirstBrokenLink
+	}
+	return nil
+}
+
+func (x *AdminVerifyAuditLogChainResponse) GetAccountId() string {
+	if x != nil {
+		return x.AccountId
+	}
+	return ""
+}
+
+func (x *AdminVerifyAuditLogChainResponse) GetIntact() bool {
+	if x != nil {
+		return x.Intact
+	}
+	return false
+}
+
+func (x *AdminVerifyAuditLogChainResponse) GetSignaturesVerified() bool {
+	if x != nil {
+		return x.SignaturesVerified
+	}
+	return false
+}
+
+func (x *AdminVerifyAuditLogChainResponse) GetEntriesChecked() uint64 {
+	if x != nil {
+		return x.EntriesChecked
+	}
+	return 0
+}
+
+func (x *AdminVerifyAuditLogChainResponse) GetCheckpointsChecked() uint64 {
+	if x != nil {
+		return x.CheckpointsChecked
+	}
+	return 0
+}
+
+func (x *AdminVerifyAuditLogChainResponse) GetLastVerifiedSequence() uint64 {
+	if x != nil {
+		return x.LastVerifiedSequence
+	}
+	return 0
+}
+
 var File_audit_audit_service_types_proto protoreflect.FileDescriptor
 
 var file_audit_audit_service_types_proto_rawDesc = string([]byte{
@@ -408,14 +776,81 @@ func (x *GetAuditLogEntryByIDResponse) GetResult() *AuditLogEntry {
 	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x2c, 0x0a, 0x06,
 	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x61,
 	0x75, 0x64, 0x69, 0x74, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x42, 0x5d, 0x5a, 0x5b, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x64,
-	0x6f, 0x6e, 0x65, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x2f, 0x64, 0x69, 0x6e, 0x6e, 0x65, 0x72,
-	0x64, 0x6f, 0x6e, 0x65, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65,
-	0x6e, 0x64, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x67, 0x72, 0x70, 0x63,
-	0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x73, 0x2f, 0x61, 0x75, 0x64, 0x69, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x72, 0x79, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x85, 0x01, 0x0a, 0x1c, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x45, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x06, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x66, 0x69,
+	0x6c, 0x74, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x35, 0x0a, 0x05, 0x69,
+	0x6e, 0x70, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x61, 0x75, 0x64,
+	0x69, 0x74, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x05, 0x69, 0x6e, 0x70,
+	0x75, 0x74, 0x22, 0xca, 0x01, 0x0a, 0x1d, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x41, 0x75, 0x64,
+	0x69, 0x74, 0x4c, 0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x35, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69,
+	0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x66,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x2e, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x14, 0x2e, 0x61, 0x75, 0x64, 0x69, 0x74, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f,
+	0x67, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22,
+	0x9d, 0x01, 0x0a, 0x1c, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c,
+	0x6f, 0x67, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x2e, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x51, 0x75, 0x65,
+	0x72, 0x79, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x12, 0x35, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x61, 0x75, 0x64, 0x69, 0x74, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x49, 0x6e, 0x70, 0x75, 0x74,
+	0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x66, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x22,
+	0x58, 0x0a, 0x1d, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f,
+	0x67, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b, 0x22, 0x40, 0x0a, 0x1f, 0x41, 0x64, 0x6d,
+	0x69, 0x6e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67,
+	0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x22, 0xa5, 0x03, 0x0a, 0x20,
+	0x41, 0x64, 0x6d, 0x69, 0x6e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x41, 0x75, 0x64, 0x69, 0x74,
+	0x4c, 0x6f, 0x67, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x42, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x73, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x12, 0x45, 0x0a, 0x11, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x62, 0x72,
+	0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x19, 0x2e, 0x61, 0x75, 0x64, 0x69, 0x74, 0x2e, 0x41, 0x75, 0x64, 0x69, 0x74, 0x4c, 0x6f, 0x67,
+	0x43, 0x68, 0x61, 0x69, 0x6e, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x52, 0x0f, 0x66, 0x69, 0x72, 0x73,
+	0x74, 0x42, 0x72, 0x6f, 0x6b, 0x65, 0x6e, 0x4c, 0x69, 0x6e, 0x6b, 0x12, 0x1d, 0x0a, 0x0a, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x6e,
+	0x74, 0x61, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x6e, 0x74, 0x61,
+	0x63, 0x74, 0x12, 0x2f, 0x0a, 0x13, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73,
+	0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x12, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75, 0x72, 0x65, 0x73, 0x56, 0x65, 0x72, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x5f, 0x63,
+	0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x65, 0x6e,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x2f, 0x0a, 0x13,
+	0x63, 0x68, 0x65, 0x63, 0x6b, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x5f, 0x63, 0x68, 0x65, 0x63,
+	0x6b, 0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x12, 0x63, 0x68, 0x65, 0x63, 0x6b,
+	0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x65, 0x64, 0x12, 0x34, 0x0a,
+	0x16, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x5f, 0x73,
+	0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x14, 0x6c,
+	0x61, 0x73, 0x74, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x53, 0x65, 0x71, 0x75, 0x65,
+	0x6e, 0x63, 0x65, 0x42, 0x5d, 0x5a, 0x5b, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x64, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x64, 0x6f, 0x6e, 0x65, 0x62, 0x65, 0x74, 0x74,
+	0x65, 0x72, 0x2f, 0x64, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x64, 0x6f, 0x6e, 0x65, 0x62, 0x65, 0x74,
+	0x74, 0x65, 0x72, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x64, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x61, 0x75, 0x64,
+	0x69, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
@@ -430,7 +865,7 @@ func file_audit_audit_service_types_proto_rawDescGZIP() []byte {
 	return file_audit_audit_service_types_proto_rawDescData
 }
 
-var file_audit_audit_service_types_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_audit_audit_service_types_proto_msgTypes = make([]protoimpl.MessageInfo, 12)
 var file_audit_audit_service_types_proto_goTypes = []any{
 	(*GetAuditLogEntriesForAccountRequest)(nil),  // 0: audit.GetAuditLogEntriesForAccountRequest
 	(*GetAuditLogEntriesForAccountResponse)(nil), // 1: audit.GetAuditLogEntriesForAccountResponse
@@ -438,27 +873,44 @@ func file_audit_audit_service_types_proto_rawDescGZIP() []byte {
 	(*GetAuditLogEntriesForUserResponse)(nil),    // 3: audit.GetAuditLogEntriesForUserResponse
 	(*GetAuditLogEntryByIDRequest)(nil),          // 4: audit.GetAuditLogEntryByIDRequest
 	(*GetAuditLogEntryByIDResponse)(nil),         // 5: audit.GetAuditLogEntryByIDResponse
-	(*filtering.QueryFilter)(nil),                // 6: filtering.QueryFilter
-	(*types.ResponseDetails)(nil),                // 7: common.ResponseDetails
-	(*filtering.Pagination)(nil),                 // 8: filtering.Pagination
-	(*AuditLogEntry)(nil),                        // 9: audit.AuditLogEntry
+	(*SearchAuditLogEntriesRequest)(nil),         // 6: audit.SearchAuditLogEntriesRequest
+	(*SearchAuditLogEntriesResponse)(nil),        // 7: audit.SearchAuditLogEntriesResponse
+	(*ExportAuditLogEntriesRequest)(nil),         // 8: audit.ExportAuditLogEntriesRequest
+	(*ExportAuditLogEntriesResponse)(nil),        // 9: audit.ExportAuditLogEntriesResponse
+	(*AdminVerifyAuditLogChainRequest)(nil),      // 10: audit.AdminVerifyAuditLogChainRequest
+	(*AdminVerifyAuditLogChainResponse)(nil),     // 11: audit.AdminVerifyAuditLogChainResponse
+	(*filtering.QueryFilter)(nil),                // 12: filtering.QueryFilter
+	(*types.ResponseDetails)(nil),                // 13: common.ResponseDetails
+	(*filtering.Pagination)(nil),                 // 14: filtering.Pagination
+	(*AuditLogEntry)(nil),                        // 15: audit.AuditLogEntry
+	(*AuditLogEntrySearchInput)(nil),             // 16: audit.AuditLogEntrySearchInput
+	(*AuditLogChainBreak)(nil),                   // 17: audit.AuditLogChainBreak
 }
 var file_audit_audit_service_types_proto_depIdxs = []int32{
-	6,  // 0: audit.GetAuditLogEntriesForAccountRequest.filter:type_name -> filtering.QueryFilter
-	7,  // 1: audit.GetAuditLogEntriesForAccountResponse.response_details:type_name -> common.ResponseDetails
-	8,  // 2: audit.GetAuditLogEntriesForAccountResponse.pagination:type_name -> filtering.Pagination
-	9,  // 3: audit.GetAuditLogEntriesForAccountResponse.results:type_name -> audit.AuditLogEntry
-	6,  // 4: audit.GetAuditLogEntriesForUserRequest.filter:type_name -> filtering.QueryFilter
-	7,  // 5: audit.GetAuditLogEntriesForUserResponse.response_details:type_name -> common.ResponseDetails
-	8,  // 6: audit.GetAuditLogEntriesForUserResponse.pagination:type_name -> filtering.Pagination
-	9,  // 7: audit.GetAuditLogEntriesForUserResponse.results:type_name -> audit.AuditLogEntry
-	7,  // 8: audit.GetAuditLogEntryByIDResponse.response_details:type_name -> common.ResponseDetails
-	9,  // 9: audit.GetAuditLogEntryByIDResponse.result:type_name -> audit.AuditLogEntry
-	10, // [10:10] is the sub-list for method output_type
-	10, // [10:10] is the sub-list for method input_type
-	10, // [10:10] is the sub-list for extension type_name
-	10, // [10:10] is the sub-list for extension extendee
-	0,  // [0:10] is the sub-list for field type_name
+	12, // 0: audit.GetAuditLogEntriesForAccountRequest.filter:type_name -> filtering.QueryFilter
+	13, // 1: audit.GetAuditLogEntriesForAccountResponse.response_details:type_name -> common.ResponseDetails
+	14, // 2: audit.GetAuditLogEntriesForAccountResponse.pagination:type_name -> filtering.Pagination
+	15, // 3: audit.GetAuditLogEntriesForAccountResponse.results:type_name -> audit.AuditLogEntry
+	12, // 4: audit.GetAuditLogEntriesForUserRequest.filter:type_name -> filtering.QueryFilter
+	13, // 5: audit.GetAuditLogEntriesForUserResponse.response_details:type_name -> common.ResponseDetails
+	14, // 6: audit.GetAuditLogEntriesForUserResponse.pagination:type_name -> filtering.Pagination
+	15, // 7: audit.GetAuditLogEntriesForUserResponse.results:type_name -> audit.AuditLogEntry
+	13, // 8: audit.GetAuditLogEntryByIDResponse.response_details:type_name -> common.ResponseDetails
+	15, // 9: audit.GetAuditLogEntryByIDResponse.result:type_name -> audit.AuditLogEntry
+	12, // 10: audit.SearchAuditLogEntriesRequest.filter:type_name -> filtering.QueryFilter
+	16, // 11: audit.SearchAuditLogEntriesRequest.input:type_name -> audit.AuditLogEntrySearchInput
+	13, // 12: audit.SearchAuditLogEntriesResponse.response_details:type_name -> common.ResponseDetails
+	14, // 13: audit.SearchAuditLogEntriesResponse.pagination:type_name -> filtering.Pagination
+	15, // 14: audit.SearchAuditLogEntriesResponse.results:type_name -> audit.AuditLogEntry
+	12, // 15: audit.ExportAuditLogEntriesRequest.filter:type_name -> filtering.QueryFilter
+	16, // 16: audit.ExportAuditLogEntriesRequest.input:type_name -> audit.AuditLogEntrySearchInput
+	13, // 17: audit.AdminVerifyAuditLogChainResponse.response_details:type_name -> common.ResponseDetails
+	17, // 18: audit.AdminVerifyAuditLogChainResponse.first_broken_link:type_name -> audit.AuditLogChainBreak
+	19, // [19:19] is the sub-list for method output_type
+	19, // [19:19] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_audit_audit_service_types_proto_init() }
@@ -473,7 +925,7 @@ type x struct{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_audit_audit_service_types_proto_rawDesc), len(file_audit_audit_service_types_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   12,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -22,35 +22,41 @@
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	IdentityService_AdminSetPasswordChangeRequired_FullMethodName = "/identity.IdentityService/AdminSetPasswordChangeRequired"
-	IdentityService_AdminUpdateUserStatus_FullMethodName          = "/identity.IdentityService/AdminUpdateUserStatus"
-	IdentityService_AcceptAccountInvitation_FullMethodName        = "/identity.IdentityService/AcceptAccountInvitation"
-	IdentityService_ArchiveAccount_FullMethodName                 = "/identity.IdentityService/ArchiveAccount"
-	IdentityService_ArchiveUserMembership_FullMethodName          = "/identity.IdentityService/ArchiveUserMembership"
-	IdentityService_ArchiveUser_FullMethodName                    = "/identity.IdentityService/ArchiveUser"
-	IdentityService_CancelAccountInvitation_FullMethodName        = "/identity.IdentityService/CancelAccountInvitation"
-	IdentityService_CreateAccount_FullMethodName                  = "/identity.IdentityService/CreateAccount"
-	IdentityService_CreateAccountInvitation_FullMethodName        = "/identity.IdentityService/CreateAccountInvitation"
-	IdentityService_CreateUser_FullMethodName                     = "/identity.IdentityService/CreateUser"
-	IdentityService_GetAccount_FullMethodName                     = "/identity.IdentityService/GetAccount"
-	IdentityService_GetAccountInvitation_FullMethodName           = "/identity.IdentityService/GetAccountInvitation"
-	IdentityService_GetAccounts_FullMethodName                    = "/identity.IdentityService/GetAccounts"
-	IdentityService_GetAccountsForUser_FullMethodName             = "/identity.IdentityService/GetAccountsForUser"
-	IdentityService_GetReceivedAccountInvitations_FullMethodName  = "/identity.IdentityService/GetReceivedAccountInvitations"
-	IdentityService_GetSentAccountInvitations_FullMethodName      = "/identity.IdentityService/GetSentAccountInvitations"
-	IdentityService_GetUser_FullMethodName                        = "/identity.IdentityService/GetUser"
-	IdentityService_RejectAccountInvitation_FullMethodName        = "/identity.IdentityService/RejectAccountInvitation"
-	IdentityService_GetUsers_FullMethodName                       = "/identity.IdentityService/GetUsers"
-	IdentityService_GetUsersForAccount_FullMethodName             = "/identity.IdentityService/GetUsersForAccount"
-	IdentityService_SearchForUsers_FullMethodName                 = "/identity.IdentityService/SearchForUsers"
-	IdentityService_SetDefaultAccount_FullMethodName              = "/identity.IdentityService/SetDefaultAccount"
-	IdentityService_TransferAccountOwnership_FullMethodName       = "/identity.IdentityService/TransferAccountOwnership"
-	IdentityService_UpdateAccount_FullMethodName                  = "/identity.IdentityService/UpdateAccount"
-	IdentityService_UpdateAccountMemberPermissions_FullMethodName = "/identity.IdentityService/UpdateAccountMemberPermissions"
-	IdentityService_UpdateUserDetails_FullMethodName              = "/identity.IdentityService/UpdateUserDetails"
-	IdentityService_UpdateUserEmailAddress_FullMethodName         = "/identity.IdentityService/UpdateUserEmailAddress"
-	IdentityService_UpdateUserUsername_FullMethodName             = "/identity.IdentityService/UpdateUserUsername"
-	IdentityService_UploadUserAvatar_FullMethodName               = "/identity.IdentityService/UploadUserAvatar"
+	IdentityService_AdminSetPasswordChangeRequired_FullMethodName    = "/identity.IdentityService/AdminSetPasswordChangeRequired"
+	IdentityService_AdminUpdateUserStatus_FullMethodName             = "/identity.IdentityService/AdminUpdateUserStatus"
+	IdentityService_AcceptAccountInvitation_FullMethodName           = "/identity.IdentityService/AcceptAccountInvitation"
+	IdentityService_ArchiveAccount_FullMethodName                    = "/identity.IdentityService/ArchiveAccount"
+	IdentityService_ArchiveAccountRole_FullMethodName                = "/identity.IdentityService/ArchiveAccountRole"
+	IdentityService_ArchiveUserMembership_FullMethodName             = "/identity.IdentityService/ArchiveUserMembership"
+	IdentityService_ArchiveUser_FullMethodName                       = "/identity.IdentityService/ArchiveUser"
+	IdentityService_CancelAccountInvitation_FullMethodName           = "/identity.IdentityService/CancelAccountInvitation"
+	IdentityService_CreateAccount_FullMethodName                     = "/identity.IdentityService/CreateAccount"
+	IdentityService_CreateAccountRole_FullMethodName                 = "/identity.IdentityService/CreateAccountRole"
+	IdentityService_CreateAccountInvitation_FullMethodName           = "/identity.IdentityService/CreateAccountInvitation"
+	IdentityService_CreateUser_FullMethodName                        = "/identity.IdentityService/CreateUser"
+	IdentityService_GetAccount_FullMethodName                        = "/identity.IdentityService/GetAccount"
+	IdentityService_GetAccountRole_FullMethodName                    = "/identity.IdentityService/GetAccountRole"
+	IdentityService_GetAccountRoles_FullMethodName                   = "/identity.IdentityService/GetAccountRoles"
+	IdentityService_GetAccountInvitation_FullMethodName              = "/identity.IdentityService/GetAccountInvitation"
+	IdentityService_GetAccounts_FullMethodName                       = "/identity.IdentityService/GetAccounts"
+	IdentityService_GetAccountsForUser_FullMethodName                = "/identity.IdentityService/GetAccountsForUser"
+	IdentityService_GetReceivedAccountInvitations_FullMethodName     = "/identity.IdentityService/GetReceivedAccountInvitations"
+	IdentityService_GetSentAccountInvitations_FullMethodName         = "/identity.IdentityService/GetSentAccountInvitations"
+	IdentityService_GetUser_FullMethodName                           = "/identity.IdentityService/GetUser"
+	IdentityService_RejectAccountInvitation_FullMethodName           = "/identity.IdentityService/RejectAccountInvitation"
+	IdentityService_RotateAccountWebhookEncryptionKey_FullMethodName = "/identity.IdentityService/RotateAccountWebhookEncryptionKey"
+	IdentityService_GetUsers_FullMethodName                          = "/identity.IdentityService/GetUsers"
+	IdentityService_GetUsersForAccount_FullMethodName                = "/identity.IdentityService/GetUsersForAccount"
+	IdentityService_SearchForUsers_FullMethodName                    = "/identity.IdentityService/SearchForUsers"
+	IdentityService_SetDefaultAccount_FullMethodName                 = "/identity.IdentityService/SetDefaultAccount"
+	IdentityService_TransferAccountOwnership_FullMethodName          = "/identity.IdentityService/TransferAccountOwnership"
+	IdentityService_UpdateAccount_FullMethodName                     = "/identity.IdentityService/UpdateAccount"
+	IdentityService_UpdateAccountRole_FullMethodName                 = "/identity.IdentityService/UpdateAccountRole"
+	IdentityService_UpdateAccountMemberPermissions_FullMethodName    = "/identity.IdentityService/UpdateAccountMemberPermissions"
+	IdentityService_UpdateUserDetails_FullMethodName                 = "/identity.IdentityService/UpdateUserDetails"
+	IdentityService_UpdateUserEmailAddress_FullMethodName            = "/identity.IdentityService/UpdateUserEmailAddress"
+	IdentityService_UpdateUserUsername_FullMethodName                = "/identity.IdentityService/UpdateUserUsername"
+	IdentityService_UploadUserAvatar_FullMethodName                  = "/identity.IdentityService/UploadUserAvatar"
 )
 
 // IdentityServiceClient is the client API for IdentityService service.
@@ -61,13 +67,17 @@ type IdentityServiceClient interface {
 	AdminUpdateUserStatus(ctx context.Context, in *AdminUpdateUserStatusRequest, opts ...grpc.CallOption) (*AdminUpdateUserStatusResponse, error)
 	AcceptAccountInvitation(ctx context.Context, in *AcceptAccountInvitationRequest, opts ...grpc.CallOption) (*AcceptAccountInvitationResponse, error)
 	ArchiveAccount(ctx context.Context, in *ArchiveAccountRequest, opts ...grpc.CallOption) (*ArchiveAccountResponse, error)
+	ArchiveAccountRole(ctx context.Context, in *ArchiveAccountRoleRequest, opts ...grpc.CallOption) (*ArchiveAccountRoleResponse, error)
 	ArchiveUserMembership(ctx context.Context, in *ArchiveUserMembershipRequest, opts ...grpc.CallOption) (*ArchiveUserMembershipResponse, error)
 	ArchiveUser(ctx context.Context, in *ArchiveUserRequest, opts ...grpc.CallOption) (*ArchiveUserResponse, error)
 	CancelAccountInvitation(ctx context.Context, in *CancelAccountInvitationRequest, opts ...grpc.CallOption) (*CancelAccountInvitationResponse, error)
 	CreateAccount(ctx context.Context, in *CreateAccountRequest, opts ...grpc.CallOption) (*CreateAccountResponse, error)
+	CreateAccountRole(ctx context.Context, in *CreateAccountRoleRequest, opts ...grpc.CallOption) (*CreateAccountRoleResponse, error)
 	CreateAccountInvitation(ctx context.Context, in *CreateAccountInvitationRequest, opts ...grpc.CallOption) (*CreateAccountInvitationResponse, error)
 	CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*CreateUserResponse, error)
 	GetAccount(ctx context.Context, in *GetAccountRequest, opts ...grpc.CallOption) (*GetAccountResponse, error)
+	GetAccountRole(ctx context.Context, in *GetAccountRoleRequest, opts ...grpc.CallOption) (*GetAccountRoleResponse, error)
+	GetAccountRoles(ctx context.Context, in *GetAccountRolesRequest, opts ...grpc.CallOption) (*GetAccountRolesResponse, error)
 	GetAccountInvitation(ctx context.Context, in *GetAccountInvitationRequest, opts ...grpc.CallOption) (*GetAccountInvitationResponse, error)
 	GetAccounts(ctx context.Context, in *GetAccountsRequest, opts ...grpc.CallOption) (*GetAccountsResponse, error)
 	GetAccountsForUser(ctx context.Context, in *GetAccountsForUserRequest, opts ...grpc.CallOption) (*GetAccountsForUserResponse, error)
@@ -75,12 +85,14 @@ type IdentityServiceClient interface {
 	GetSentAccountInvitations(ctx context.Context, in *GetSentAccountInvitationsRequest, opts ...grpc.CallOption) (*GetSentAccountInvitationsResponse, error)
 	GetUser(ctx context.Context, in *GetUserRequest, opts ...grpc.CallOption) (*GetUserResponse, error)
 	RejectAccountInvitation(ctx context.Context, in *RejectAccountInvitationRequest, opts ...grpc.CallOption) (*RejectAccountInvitationResponse, error)
+	RotateAccountWebhookEncryptionKey(ctx context.Context, in *RotateAccountWebhookEncryptionKeyRequest, opts ...grpc.CallOption) (*RotateAccountWebhookEncryptionKeyResponse, error)
 	GetUsers(ctx context.Context, in *GetUsersRequest, opts ...grpc.CallOption) (*GetUsersResponse, error)
 	GetUsersForAccount(ctx context.Context, in *GetUsersForAccountRequest, opts ...grpc.CallOption) (*GetUsersForAccountResponse, error)
 	SearchForUsers(ctx context.Context, in *SearchForUsersRequest, opts ...grpc.CallOption) (*SearchForUsersResponse, error)
 	SetDefaultAccount(ctx context.Context, in *SetDefaultAccountRequest, opts ...grpc.CallOption) (*SetDefaultAccountResponse, error)
 	TransferAccountOwnership(ctx context.Context, in *TransferAccountOwnershipRequest, opts ...grpc.CallOption) (*TransferAccountOwnershipResponse, error)
 	UpdateAccount(ctx context.Context, in *UpdateAccountRequest, opts ...grpc.CallOption) (*UpdateAccountResponse, error)
+	UpdateAccountRole(ctx context.Context, in *UpdateAccountRoleRequest, opts ...grpc.CallOption) (*UpdateAccountRoleResponse, error)
 	UpdateAccountMemberPermissions(ctx context.Context, in *UpdateAccountMemberPermissionsRequest, opts ...grpc.CallOption) (*UpdateAccountMemberPermissionsResponse, error)
 	UpdateUserDetails(ctx context.Context, in *UpdateUserDetailsRequest, opts ...grpc.CallOption) (*UpdateUserDetailsResponse, error)
 	UpdateUserEmailAddress(ctx context.Context, in *UpdateUserEmailAddressRequest, opts ...grpc.CallOption) (*UpdateUserEmailAddressResponse, error)
@@ -136,6 +148,16 @@ func (c *identityServiceClient) ArchiveAccount(ctx context.Context, in *ArchiveA
 	return out, nil
 }
 
+func (c *identityServiceClient) ArchiveAccountRole(ctx context.Context, in *ArchiveAccountRoleRequest, opts ...grpc.CallOption) (*ArchiveAccountRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ArchiveAccountRoleResponse)
+	err := c.cc.Invoke(ctx, IdentityService_ArchiveAccountRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *identityServiceClient) ArchiveUserMembership(ctx context.Context, in *ArchiveUserMembershipRequest, opts ...grpc.CallOption) (*ArchiveUserMembershipResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ArchiveUserMembershipResponse)
@@ -176,6 +198,16 @@ func (c *identityServiceClient) CreateAccount(ctx context.Context, in *CreateAcc
 	return out, nil
 }
 
+func (c *identityServiceClient) CreateAccountRole(ctx context.Context, in *CreateAccountRoleRequest, opts ...grpc.CallOption) (*CreateAccountRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateAccountRoleResponse)
+	err := c.cc.Invoke(ctx, IdentityService_CreateAccountRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *identityServiceClient) CreateAccountInvitation(ctx context.Context, in *CreateAccountInvitationRequest, opts ...grpc.CallOption) (*CreateAccountInvitationResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateAccountInvitationResponse)
@@ -206,6 +238,26 @@ func (c *identityServiceClient) GetAccount(ctx context.Context, in *GetAccountRe
 	return out, nil
 }
 
+func (c *identityServiceClient) GetAccountRole(ctx context.Context, in *GetAccountRoleRequest, opts ...grpc.CallOption) (*GetAccountRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAccountRoleResponse)
+	err := c.cc.Invoke(ctx, IdentityService_GetAccountRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *identityServiceClient) GetAccountRoles(ctx context.Context, in *GetAccountRolesRequest, opts ...grpc.CallOption) (*GetAccountRolesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAccountRolesResponse)
+	err := c.cc.Invoke(ctx, IdentityService_GetAccountRoles_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *identityServiceClient) GetAccountInvitation(ctx context.Context, in *GetAccountInvitationRequest, opts ...grpc.CallOption) (*GetAccountInvitationResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetAccountInvitationResponse)
@@ -276,6 +328,16 @@ func (c *identityServiceClient) RejectAccountInvitation(ctx context.Context, in
 	return out, nil
 }
 
+func (c *identityServiceClient) RotateAccountWebhookEncryptionKey(ctx context.Context, in *RotateAccountWebhookEncryptionKeyRequest, opts ...grpc.CallOption) (*RotateAccountWebhookEncryptionKeyResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RotateAccountWebhookEncryptionKeyResponse)
+	err := c.cc.Invoke(ctx, IdentityService_RotateAccountWebhookEncryptionKey_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *identityServiceClient) GetUsers(ctx context.Context, in *GetUsersRequest, opts ...grpc.CallOption) (*GetUsersResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetUsersResponse)
@@ -336,6 +398,16 @@ func (c *identityServiceClient) UpdateAccount(ctx context.Context, in *UpdateAcc
 	return out, nil
 }
 
+func (c *identityServiceClient) UpdateAccountRole(ctx context.Context, in *UpdateAccountRoleRequest, opts ...grpc.CallOption) (*UpdateAccountRoleResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateAccountRoleResponse)
+	err := c.cc.Invoke(ctx, IdentityService_UpdateAccountRole_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *identityServiceClient) UpdateAccountMemberPermissions(ctx context.Context, in *UpdateAccountMemberPermissionsRequest, opts ...grpc.CallOption) (*UpdateAccountMemberPermissionsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UpdateAccountMemberPermissionsResponse)
@@ -397,13 +469,17 @@ type IdentityServiceServer interface {
 	AdminUpdateUserStatus(context.Context, *AdminUpdateUserStatusRequest) (*AdminUpdateUserStatusResponse, error)
 	AcceptAccountInvitation(context.Context, *AcceptAccountInvitationRequest) (*AcceptAccountInvitationResponse, error)
 	ArchiveAccount(context.Context, *ArchiveAccountRequest) (*ArchiveAccountResponse, error)
+	ArchiveAccountRole(context.Context, *ArchiveAccountRoleRequest) (*ArchiveAccountRoleResponse, error)
 	ArchiveUserMembership(context.Context, *ArchiveUserMembershipRequest) (*ArchiveUserMembershipResponse, error)
 	ArchiveUser(context.Context, *ArchiveUserRequest) (*ArchiveUserResponse, error)
 	CancelAccountInvitation(context.Context, *CancelAccountInvitationRequest) (*CancelAccountInvitationResponse, error)
 	CreateAccount(context.Context, *CreateAccountRequest) (*CreateAccountResponse, error)
+	CreateAccountRole(context.Context, *CreateAccountRoleRequest) (*CreateAccountRoleResponse, error)
 	CreateAccountInvitation(context.Context, *CreateAccountInvitationRequest) (*CreateAccountInvitationResponse, error)
 	CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error)
 	GetAccount(context.Context, *GetAccountRequest) (*GetAccountResponse, error)
+	GetAccountRole(context.Context, *GetAccountRoleRequest) (*GetAccountRoleResponse, error)
+	GetAccountRoles(context.Context, *GetAccountRolesRequest) (*GetAccountRolesResponse, error)
 	GetAccountInvitation(context.Context, *GetAccountInvitationRequest) (*GetAccountInvitationResponse, error)
 	GetAccounts(context.Context, *GetAccountsRequest) (*GetAccountsResponse, error)
 	GetAccountsForUser(context.Context, *GetAccountsForUserRequest) (*GetAccountsForUserResponse, error)
@@ -411,12 +487,14 @@ type IdentityServiceServer interface {
 	GetSentAccountInvitations(context.Context, *GetSentAccountInvitationsRequest) (*GetSentAccountInvitationsResponse, error)
 	GetUser(context.Context, *GetUserRequest) (*GetUserResponse, error)
 	RejectAccountInvitation(context.Context, *RejectAccountInvitationRequest) (*RejectAccountInvitationResponse, error)
+	RotateAccountWebhookEncryptionKey(context.Context, *RotateAccountWebhookEncryptionKeyRequest) (*RotateAccountWebhookEncryptionKeyResponse, error)
 	GetUsers(context.Context, *GetUsersRequest) (*GetUsersResponse, error)
 	GetUsersForAccount(context.Context, *GetUsersForAccountRequest) (*GetUsersForAccountResponse, error)
 	SearchForUsers(context.Context, *SearchForUsersRequest) (*SearchForUsersResponse, error)
 	SetDefaultAccount(context.Context, *SetDefaultAccountRequest) (*SetDefaultAccountResponse, error)
 	TransferAccountOwnership(context.Context, *TransferAccountOwnershipRequest) (*TransferAccountOwnershipResponse, error)
 	UpdateAccount(context.Context, *UpdateAccountRequest) (*UpdateAccountResponse, error)
+	UpdateAccountRole(context.Context, *UpdateAccountRoleRequest) (*UpdateAccountRoleResponse, error)
 	UpdateAccountMemberPermissions(context.Context, *UpdateAccountMemberPermissionsRequest) (*UpdateAccountMemberPermissionsResponse, error)
 	UpdateUserDetails(context.Context, *UpdateUserDetailsRequest) (*UpdateUserDetailsResponse, error)
 	UpdateUserEmailAddress(context.Context, *UpdateUserEmailAddressRequest) (*UpdateUserEmailAddressResponse, error)
@@ -444,6 +522,9 @@ func (UnimplementedIdentityServiceServer) AcceptAccountInvitation(context.Contex
 func (UnimplementedIdentityServiceServer) ArchiveAccount(context.Context, *ArchiveAccountRequest) (*ArchiveAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ArchiveAccount not implemented")
 }
+func (UnimplementedIdentityServiceServer) ArchiveAccountRole(context.Context, *ArchiveAccountRoleRequest) (*ArchiveAccountRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveAccountRole not implemented")
+}
 func (UnimplementedIdentityServiceServer) ArchiveUserMembership(context.Context, *ArchiveUserMembershipRequest) (*ArchiveUserMembershipResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ArchiveUserMembership not implemented")
 }
@@ -456,6 +537,9 @@ func (UnimplementedIdentityServiceServer) CancelAccountInvitation(context.Contex
 func (UnimplementedIdentityServiceServer) CreateAccount(context.Context, *CreateAccountRequest) (*CreateAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateAccount not implemented")
 }
+func (UnimplementedIdentityServiceServer) CreateAccountRole(context.Context, *CreateAccountRoleRequest) (*CreateAccountRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAccountRole not implemented")
+}
 func (UnimplementedIdentityServiceServer) CreateAccountInvitation(context.Context, *CreateAccountInvitationRequest) (*CreateAccountInvitationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateAccountInvitation not implemented")
 }
@@ -465,6 +549,12 @@ func (UnimplementedIdentityServiceServer) CreateUser(context.Context, *CreateUse
 func (UnimplementedIdentityServiceServer) GetAccount(context.Context, *GetAccountRequest) (*GetAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAccount not implemented")
 }
+func (UnimplementedIdentityServiceServer) GetAccountRole(context.Context, *GetAccountRoleRequest) (*GetAccountRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountRole not implemented")
+}
+func (UnimplementedIdentityServiceServer) GetAccountRoles(context.Context, *GetAccountRolesRequest) (*GetAccountRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountRoles not implemented")
+}
 func (UnimplementedIdentityServiceServer) GetAccountInvitation(context.Context, *GetAccountInvitationRequest) (*GetAccountInvitationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAccountInvitation not implemented")
 }
@@ -486,6 +576,9 @@ func (UnimplementedIdentityServiceServer) GetUser(context.Context, *GetUserReque
 func (UnimplementedIdentityServiceServer) RejectAccountInvitation(context.Context, *RejectAccountInvitationRequest) (*RejectAccountInvitationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RejectAccountInvitation not implemented")
 }
+func (UnimplementedIdentityServiceServer) RotateAccountWebhookEncryptionKey(context.Context, *RotateAccountWebhookEncryptionKeyRequest) (*RotateAccountWebhookEncryptionKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateAccountWebhookEncryptionKey not implemented")
+}
 func (UnimplementedIdentityServiceServer) GetUsers(context.Context, *GetUsersRequest) (*GetUsersResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetUsers not implemented")
 }
@@ -504,6 +597,9 @@ func (UnimplementedIdentityServiceServer) TransferAccountOwnership(context.Conte
 func (UnimplementedIdentityServiceServer) UpdateAccount(context.Context, *UpdateAccountRequest) (*UpdateAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateAccount not implemented")
 }
+func (UnimplementedIdentityServiceServer) UpdateAccountRole(context.Context, *UpdateAccountRoleRequest) (*UpdateAccountRoleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAccountRole not implemented")
+}
 func (UnimplementedIdentityServiceServer) UpdateAccountMemberPermissions(context.Context, *UpdateAccountMemberPermissionsRequest) (*UpdateAccountMemberPermissionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateAccountMemberPermissions not implemented")
 }
@@ -612,6 +708,24 @@ func _IdentityService_ArchiveAccount_Handler(srv interface{}, ctx context.Contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IdentityService_ArchiveAccountRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveAccountRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdentityServiceServer).ArchiveAccountRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdentityService_ArchiveAccountRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdentityServiceServer).ArchiveAccountRole(ctx, req.(*ArchiveAccountRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _IdentityService_ArchiveUserMembership_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ArchiveUserMembershipRequest)
 	if err := dec(in); err != nil {
@@ -684,6 +798,24 @@ func _IdentityService_CreateAccount_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IdentityService_CreateAccountRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAccountRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdentityServiceServer).CreateAccountRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdentityService_CreateAccountRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdentityServiceServer).CreateAccountRole(ctx, req.(*CreateAccountRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _IdentityService_CreateAccountInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateAccountInvitationRequest)
 	if err := dec(in); err != nil {
@@ -738,6 +870,42 @@ func _IdentityService_GetAccount_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IdentityService_GetAccountRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdentityServiceServer).GetAccountRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdentityService_GetAccountRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdentityServiceServer).GetAccountRole(ctx, req.(*GetAccountRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _IdentityService_GetAccountRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountRolesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdentityServiceServer).GetAccountRoles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdentityService_GetAccountRoles_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdentityServiceServer).GetAccountRoles(ctx, req.(*GetAccountRolesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _IdentityService_GetAccountInvitation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetAccountInvitationRequest)
 	if err := dec(in); err != nil {
@@ -864,6 +1032,24 @@ func _IdentityService_RejectAccountInvitation_Handler(srv interface{}, ctx conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IdentityService_RotateAccountWebhookEncryptionKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RotateAccountWebhookEncryptionKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdentityServiceServer).RotateAccountWebhookEncryptionKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdentityService_RotateAccountWebhookEncryptionKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdentityServiceServer).RotateAccountWebhookEncryptionKey(ctx, req.(*RotateAccountWebhookEncryptionKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _IdentityService_GetUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetUsersRequest)
 	if err := dec(in); err != nil {
@@ -972,6 +1158,24 @@ func _IdentityService_UpdateAccount_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _IdentityService_UpdateAccountRole_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAccountRoleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(IdentityServiceServer).UpdateAccountRole(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: IdentityService_UpdateAccountRole_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(IdentityServiceServer).UpdateAccountRole(ctx, req.(*UpdateAccountRoleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _IdentityService_UpdateAccountMemberPermissions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateAccountMemberPermissionsRequest)
 	if err := dec(in); err != nil {
@@ -1074,6 +1278,10 @@ func _IdentityService_UploadUserAvatar_Handler(srv interface{}, stream grpc.Serv
 			MethodName: "ArchiveAccount",
 			Handler:    _IdentityService_ArchiveAccount_Handler,
 		},
+		{
+			MethodName: "ArchiveAccountRole",
+			Handler:    _IdentityService_ArchiveAccountRole_Handler,
+		},
 		{
 			MethodName: "ArchiveUserMembership",
 			Handler:    _IdentityService_ArchiveUserMembership_Handler,
@@ -1090,6 +1298,10 @@ func _IdentityService_UploadUserAvatar_Handler(srv interface{}, stream grpc.Serv
 			MethodName: "CreateAccount",
 			Handler:    _IdentityService_CreateAccount_Handler,
 		},
+		{
+			MethodName: "CreateAccountRole",
+			Handler:    _IdentityService_CreateAccountRole_Handler,
+		},
 		{
 			MethodName: "CreateAccountInvitation",
 			Handler:    _IdentityService_CreateAccountInvitation_Handler,
@@ -1102,6 +1314,14 @@ func _IdentityService_UploadUserAvatar_Handler(srv interface{}, stream grpc.Serv
 			MethodName: "GetAccount",
 			Handler:    _IdentityService_GetAccount_Handler,
 		},
+		{
+			MethodName: "GetAccountRole",
+			Handler:    _IdentityService_GetAccountRole_Handler,
+		},
+		{
+			MethodName: "GetAccountRoles",
+			Handler:    _IdentityService_GetAccountRoles_Handler,
+		},
 		{
 			MethodName: "GetAccountInvitation",
 			Handler:    _IdentityService_GetAccountInvitation_Handler,
@@ -1130,6 +1350,10 @@ func _IdentityService_UploadUserAvatar_Handler(srv interface{}, stream grpc.Serv
 			MethodName: "RejectAccountInvitation",
 			Handler:    _IdentityService_RejectAccountInvitation_Handler,
 		},
+		{
+			MethodName: "RotateAccountWebhookEncryptionKey",
+			Handler:    _IdentityService_RotateAccountWebhookEncryptionKey_Handler,
+		},
 		{
 			MethodName: "GetUsers",
 			Handler:    _IdentityService_GetUsers_Handler,
@@ -1154,6 +1378,10 @@ func _IdentityService_UploadUserAvatar_Handler(srv interface{}, stream grpc.Serv
 			MethodName: "UpdateAccount",
 			Handler:    _IdentityService_UpdateAccount_Handler,
 		},
+		{
+			MethodName: "UpdateAccountRole",
+			Handler:    _IdentityService_UpdateAccountRole_Handler,
+		},
 		{
 			MethodName: "UpdateAccountMemberPermissions",
 			Handler:    _IdentityService_UpdateAccountMemberPermissions_Handler,
@@ -2219,6 +2219,94 @@ func (x *UpdateAccountResponse) GetResponseDetails() *types.ResponseDetails {
 	return nil
 }
 
+type RotateAccountWebhookEncryptionKeyRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RotateAccountWebhookEncryptionKeyRequest) Reset() {
+	*x = RotateAccountWebhookEncryptionKeyRequest{}
+	mi := &file_identity_identity_service_types_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateAccountWebhookEncryptionKeyRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateAccountWebhookEncryptionKeyRequest) ProtoMessage() {}
+
+func (x *RotateAccountWebhookEncryptionKeyRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_identity_service_types_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateAccountWebhookEncryptionKeyRequest.ProtoReflect.Descriptor instead.
+func (*RotateAccountWebhookEncryptionKeyRequest) Descriptor() ([]byte, []int) {
+	return file_identity_identity_service_types_proto_rawDescGZIP(), []int{44}
+}
+
+type RotateAccountWebhookEncryptionKeyResponse struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	ResponseDetails      *types.ResponseDetails `protobuf:"bytes,1,opt,name=response_details,json=responseDetails,proto3" json:"response_details,omitempty"`
+	WebhookEncryptionKey string                 `protobuf:"bytes,2,opt,name=webhook_encryption_key,json=webhookEncryptionKey,proto3" json:"webhook_encryption_key,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *RotateAccountWebhookEncryptionKeyResponse) Reset() {
+	*x = RotateAccountWebhookEncryptionKeyResponse{}
+	mi := &file_identity_identity_service_types_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RotateAccountWebhookEncryptionKeyResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RotateAccountWebhookEncryptionKeyResponse) ProtoMessage() {}
+
+func (x *RotateAccountWebhookEncryptionKeyResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_identity_service_types_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RotateAccountWebhookEncryptionKeyResponse.ProtoReflect.Descriptor instead.
+func (*RotateAccountWebhookEncryptionKeyResponse) Descriptor() ([]byte, []int) {
+	return file_identity_identity_service_types_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *RotateAccountWebhookEncryptionKeyResponse) GetResponseDetails() *types.ResponseDetails {
+	if x != nil {
+		return x.ResponseDetails
+	}
+	return nil
+}
+
+func (x *RotateAccountWebhookEncryptionKeyResponse) GetWebhookEncryptionKey() string {
+	if x != nil {
+		return x.WebhookEncryptionKey
+	}
+	return ""
+}
+
 type UpdateAccountMemberPermissionsRequest struct {
 	state         protoimpl.MessageState      `protogen:"open.v1"`
 	UserId        string                      `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -2229,7 +2317,7 @@ type UpdateAccountMemberPermissionsRequest struct {
 
 func (x *UpdateAccountMemberPermissionsRequest) Reset() {
 	*x = UpdateAccountMemberPermissionsRequest{}
-	mi := &file_identity_identity_service_types_proto_msgTypes[44]
+	mi := &file_identity_identity_service_types_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2241,7 +2329,7 @@ func (x *UpdateAccountMemberPermissionsRequest) String() string {
 func (*UpdateAccountMemberPermissionsRequest) ProtoMessage() {}
 
 func (x *UpdateAccountMemberPermissionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_identity_identity_service_types_proto_msgTypes[44]
+	mi := &file_identity_identity_service_types_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2254,7 +2342,7 @@ func (x *UpdateAccountMemberPermissionsRequest) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use UpdateAccountMemberPermissionsRequest.ProtoReflect.Descriptor instead.
 func (*UpdateAccountMemberPermissionsRequest) Descriptor() ([]byte, []int) {
-	return file_identity_identity_service_types_proto_rawDescGZIP(), []int{44}
+	return file_identity_identity_service_types_proto_rawDescGZIP(), []int{46}
 }
 
 func (x *UpdateAccountMemberPermissionsRequest) GetUserId() string {
@@ -2280,7 +2368,7 @@ type UpdateAccountMemberPermissionsResponse struct {
 
 func (x *UpdateAccountMemberPermissionsResponse) Reset() {
 	*x = UpdateAccountMemberPermissionsResponse{}
-	mi := &file_identity_identity_service_types_proto_msgTypes[45]
+	mi := &file_identity_identity_service_types_proto_msgTypes[47]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2292,7 +2380,7 @@ func (x *UpdateAccountMemberPermissionsResponse) String() string {
 func (*UpdateAccountMemberPermissionsResponse) ProtoMessage() {}
 
 func (x *UpdateAccountMemberPermissionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_identity_identity_service_types_proto_msgTypes[45]
+	mi := &file_identity_identity_service_types_proto_msgTypes[47]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2305,7 +2393,7 @@ func (x *UpdateAccountMemberPermissionsResponse) ProtoReflect() protoreflect.Mes
 
 // Deprecated: Use UpdateAccountMemberPermissionsResponse.ProtoReflect.Descriptor instead.
 func (*UpdateAccountMemberPermissionsResponse) Descriptor() ([]byte, []int) {
-	return file_identity_identity_service_types_proto_rawDescGZIP(), []int{45}
+	return file_identity_identity_service_types_proto_rawDescGZIP(), []int{47}
 }
 
 func (x *UpdateAccountMemberPermissionsResponse) GetResponseDetails() *types.ResponseDetails {
@@ -2328,7 +2416,7 @@ type UserDetailsUpdateRequestInput struct {
 
 func (x *UserDetailsUpdateRequestInput) Reset() {
 	*x = UserDetailsUpdateRequestInput{}
-	mi := &file_identity_identity_service_types_proto_msgTypes[46]
+	mi := &file_identity_identity_service_types_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2340,7 +2428,7 @@ func (x *UserDetailsUpdateRequestInput) String() string {
 func (*UserDetailsUpdateRequestInput) ProtoMessage() {}
 
 func (x *UserDetailsUpdateRequestInput) ProtoReflect() protoreflect.Message {
-	mi := &file_identity_identity_service_types_proto_msgTypes[46]
+	mi := &file_identity_identity_service_types_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2353,7 +2441,7 @@ func (x *UserDetailsUpdateRequestInput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserDetailsUpdateRequestInput.ProtoReflect.Descriptor instead.
 func (*UserDetailsUpdateRequestInput) Descriptor() ([]byte, []int) {
-	return file_identity_identity_service_types_proto_rawDescGZIP(), []int{46}
+	return file_identity_identity_service_types_proto_rawDescGZIP(), []int{48}
 }
 
 func (x *UserDetailsUpdateRequestInput) GetFirstName() string {
@@ -2400,7 +2488,7 @@ type UpdateUserDetailsRequest struct {
 
 func (x *UpdateUserDetailsRequest) Reset() {
 	*x = UpdateUserDetailsRequest{}
-	mi := &file_identity_identity_service_types_proto_msgTypes[47]
+	mi := &file_identity_identity_service_types_proto_msgTypes[49]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2412,7 +2500,7 @@ func (x *UpdateUserDetailsRequest) String() string {
 func (*UpdateUserDetailsRequest) ProtoMessage() {}
 
 func (x *UpdateUserDetailsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_identity_identity_service_types_proto_msgTypes[47]
+	mi := &file_identity_identity_service_types_proto_msgTypes[49]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2425,7 +2513,7 @@ func (x *UpdateUserDetailsRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateUserDetailsRequest.ProtoReflect.Descriptor instead.
 func (*UpdateUserDetailsRequest) Descriptor() ([]byte, []int) {
-	return file_identity_identity_service_types_proto_rawDescGZIP(), []int{47}
+	return file_identity_identity_service_types_proto_rawDescGZIP(), []int{49}
 }
 
 func (x *UpdateUserDetailsRequest) GetInput() *UserDetailsUpdateRequestInput {
@@ -2444,7 +2532,7 @@ type UpdateUserDetailsResponse struct {
 
 func (x *UpdateUserDetailsResponse) Reset() {
 	*x = UpdateUserDetailsResponse{}
-	mi := &file_identity_identity_service_types_proto_msgTypes[48]
+	mi := &file_identity_identity_service_types_proto_msgTypes[50]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2456,7 +2544,7 @@ func (x *UpdateUserDetailsResponse) String() string {
 func (*UpdateUserDetailsResponse) ProtoMessage() {}
 
 func (x *UpdateUserDetailsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_identity_identity_service_types_proto_msgTypes[48]
+	mi := &file_identity_identity_service_types_proto_msgTypes[50]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2469,7 +2557,7 @@ func (x *UpdateUserDetailsResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateUserDetailsResponse.ProtoReflect.Descriptor instead.
 func (*UpdateUserDetailsResponse) Descriptor() ([]byte, []int) {
-	return file_identity_identity_service_types_proto_rawDescGZIP(), []int{48}
+	return file_identity_identity_service_types_proto_rawDescGZIP(), []int{50}
 }
 
 func (x *UpdateUserDetailsResponse) GetResponseDetails() *types.ResponseDetails {
@@ -2490,7 +2578,7 @@ type UpdateUserEmailAddressRequest struct {
 
 func (x *UpdateUserEmailAddressRequest) Reset() {
 	*x = UpdateUserEmailAddressRequest{}
-	mi := &file_identity_identity_service_types_proto_msgTypes[49]
+	mi := &file_identity_identity_service_types_proto_msgTypes[51]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2502,7 +2590,7 @@ func (x *UpdateUserEmailAddressRequest) String() string {
 func (*UpdateUserEmailAddressRequest) ProtoMessage() {}
 
 func (x *UpdateUserEmailAddressRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_identity_identity_service_types_proto_msgTypes[49]
+	mi := &file_identity_identity_service_types_proto_msgTypes[51]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2515,7 +2603,7 @@ func (x *UpdateUserEmailAddressRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateUserEmailAddressRequest.ProtoReflect.Descriptor instead.
 func (*UpdateUserEmailAddressRequest) Descriptor() ([]byte, []int) {
-	return file_identity_identity_service_types_proto_rawDescGZIP(), []int{49}
+	return file_identity_identity_service_types_proto_rawDescGZIP(), []int{51}
 }
 
 func (x *UpdateUserEmailAddressRequest) GetNewEmailAddress() string {
@@ -2548,7 +2636,7 @@ type UpdateUserEmailAddressResponse struct {
 
 func (x *UpdateUserEmailAddressResponse) Reset() {
 	*x = UpdateUserEmailAddressResponse{}
-	mi := &file_identity_identity_service_types_proto_msgTypes[50]
+	mi := &file_identity_identity_service_types_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2560,7 +2648,7 @@ func (x *UpdateUserEmailAddressResponse) String() string {
 func (*UpdateUserEmailAddressResponse) ProtoMessage() {}
 
 func (x *UpdateUserEmailAddressResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_identity_identity_service_types_proto_msgTypes[50]
+	mi := &file_identity_identity_service_types_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2573,7 +2661,7 @@ func (x *UpdateUserEmailAddressResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateUserEmailAddressResponse.ProtoReflect.Descriptor instead.
 func (*UpdateUserEmailAddressResponse) Descriptor() ([]byte, []int) {
-	return file_identity_identity_service_types_proto_rawDescGZIP(), []int{50}
+	return file_identity_identity_service_types_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *UpdateUserEmailAddressResponse) GetResponseDetails() *types.ResponseDetails {
@@ -2592,7 +2680,7 @@ type UpdateUserUsernameRequest struct {
 
 func (x *UpdateUserUsernameRequest) Reset() {
 	*x = UpdateUserUsernameRequest{}
-	mi := &file_identity_identity_service_types_proto_msgTypes[51]
+	mi := &file_identity_identity_service_types_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2604,7 +2692,7 @@ func (x *UpdateUserUsernameRequest) String() string {
 func (*UpdateUserUsernameRequest) ProtoMessage() {}
 
 func (x *UpdateUserUsernameRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_identity_identity_service_types_proto_msgTypes[51]
+	mi := &file_identity_identity_service_types_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2617,7 +2705,7 @@ func (x *UpdateUserUsernameRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateUserUsernameRequest.ProtoReflect.Descriptor instead.
 func (*UpdateUserUsernameRequest) Descriptor() ([]byte, []int) {
-	return file_identity_identity_service_types_proto_rawDescGZIP(), []int{51}
+	return file_identity_identity_service_types_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *UpdateUserUsernameRequest) GetNewUsername() string {
@@ -2636,7 +2724,7 @@ type UpdateUserUsernameResponse struct {
 
 func (x *UpdateUserUsernameResponse) Reset() {
 	*x = UpdateUserUsernameResponse{}
-	mi := &file_identity_identity_service_types_proto_msgTypes[52]
+	mi := &file_identity_identity_service_types_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2648,7 +2736,7 @@ func (x *UpdateUserUsernameResponse) String() string {
 func (*UpdateUserUsernameResponse) ProtoMessage() {}
 
 func (x *UpdateUserUsernameResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_identity_identity_service_types_proto_msgTypes[52]
+	mi := &file_identity_identity_service_types_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2661,7 +2749,7 @@ func (x *UpdateUserUsernameResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UpdateUserUsernameResponse.ProtoReflect.Descriptor instead.
 func (*UpdateUserUsernameResponse) Descriptor() ([]byte, []int) {
-	return file_identity_identity_service_types_proto_rawDescGZIP(), []int{52}
+	return file_identity_identity_service_types_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *UpdateUserUsernameResponse) GetResponseDetails() *types.ResponseDetails {
@@ -2681,7 +2769,7 @@ type UploadUserAvatarResponse struct {
 
 func (x *UploadUserAvatarResponse) Reset() {
 	*x = UploadUserAvatarResponse{}
-	mi := &file_identity_identity_service_types_proto_msgTypes[53]
+	mi := &file_identity_identity_service_types_proto_msgTypes[55]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2693,7 +2781,7 @@ func (x *UploadUserAvatarResponse) String() string {
 func (*UploadUserAvatarResponse) ProtoMessage() {}
 
 func (x *UploadUserAvatarResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_identity_identity_service_types_proto_msgTypes[53]
+	mi := &file_identity_identity_service_types_proto_msgTypes[55]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2706,7 +2794,7 @@ func (x *UploadUserAvatarResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UploadUserAvatarResponse.ProtoReflect.Descriptor instead.
 func (*UploadUserAvatarResponse) Descriptor() ([]byte, []int) {
-	return file_identity_identity_service_types_proto_rawDescGZIP(), []int{53}
+	return file_identity_identity_service_types_proto_rawDescGZIP(), []int{55}
 }
 
 func (x *UploadUserAvatarResponse) GetResponseDetails() *types.ResponseDetails {
@@ -2742,7 +2830,7 @@ type AccountCreationRequestInput struct {
 
 func (x *AccountCreationRequestInput) Reset() {
 	*x = AccountCreationRequestInput{}
-	mi := &file_identity_identity_service_types_proto_msgTypes[54]
+	mi := &file_identity_identity_service_types_proto_msgTypes[56]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2754,7 +2842,7 @@ func (x *AccountCreationRequestInput) String() string {
 func (*AccountCreationRequestInput) ProtoMessage() {}
 
 func (x *AccountCreationRequestInput) ProtoReflect() protoreflect.Message {
-	mi := &file_identity_identity_service_types_proto_msgTypes[54]
+	mi := &file_identity_identity_service_types_proto_msgTypes[56]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2767,7 +2855,7 @@ func (x *AccountCreationRequestInput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AccountCreationRequestInput.ProtoReflect.Descriptor instead.
 func (*AccountCreationRequestInput) Descriptor() ([]byte, []int) {
-	return file_identity_identity_service_types_proto_rawDescGZIP(), []int{54}
+	return file_identity_identity_service_types_proto_rawDescGZIP(), []int{56}
 }
 
 func (x *AccountCreationRequestInput) GetLatitude() float32 {
@@ -2859,7 +2947,7 @@ type AccountInvitationCreationRequestInput struct {
 
 func (x *AccountInvitationCreationRequestInput) Reset() {
 	*x = AccountInvitationCreationRequestInput{}
-	mi := &file_identity_identity_service_types_proto_msgTypes[55]
+	mi := &file_identity_identity_service_types_proto_msgTypes[57]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2871,7 +2959,7 @@ func (x *AccountInvitationCreationRequestInput) String() string {
 func (*AccountInvitationCreationRequestInput) ProtoMessage() {}
 
 func (x *AccountInvitationCreationRequestInput) ProtoReflect() protoreflect.Message {
-	mi := &file_identity_identity_service_types_proto_msgTypes[55]
+	mi := &file_identity_identity_service_types_proto_msgTypes[57]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2884,7 +2972,7 @@ func (x *AccountInvitationCreationRequestInput) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use AccountInvitationCreationRequestInput.ProtoReflect.Descriptor instead.
 func (*AccountInvitationCreationRequestInput) Descriptor() ([]byte, []int) {
-	return file_identity_identity_service_types_proto_rawDescGZIP(), []int{55}
+	return file_identity_identity_service_types_proto_rawDescGZIP(), []int{57}
 }
 
 func (x *AccountInvitationCreationRequestInput) GetExpiresAt() *timestamppb.Timestamp {
@@ -2925,7 +3013,7 @@ type AccountInvitationUpdateRequestInput struct {
 
 func (x *AccountInvitationUpdateRequestInput) Reset() {
 	*x = AccountInvitationUpdateRequestInput{}
-	mi := &file_identity_identity_service_types_proto_msgTypes[56]
+	mi := &file_identity_identity_service_types_proto_msgTypes[58]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2937,7 +3025,7 @@ func (x *AccountInvitationUpdateRequestInput) String() string {
 func (*AccountInvitationUpdateRequestInput) ProtoMessage() {}
 
 func (x *AccountInvitationUpdateRequestInput) ProtoReflect() protoreflect.Message {
-	mi := &file_identity_identity_service_types_proto_msgTypes[56]
+	mi := &file_identity_identity_service_types_proto_msgTypes[58]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2950,7 +3038,7 @@ func (x *AccountInvitationUpdateRequestInput) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use AccountInvitationUpdateRequestInput.ProtoReflect.Descriptor instead.
 func (*AccountInvitationUpdateRequestInput) Descriptor() ([]byte, []int) {
-	return file_identity_identity_service_types_proto_rawDescGZIP(), []int{56}
+	return file_identity_identity_service_types_proto_rawDescGZIP(), []int{58}
 }
 
 func (x *AccountInvitationUpdateRequestInput) GetToken() string {
@@ -2986,7 +3074,7 @@ type AccountUpdateRequestInput struct {
 
 func (x *AccountUpdateRequestInput) Reset() {
 	*x = AccountUpdateRequestInput{}
-	mi := &file_identity_identity_service_types_proto_msgTypes[57]
+	mi := &file_identity_identity_service_types_proto_msgTypes[59]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2998,7 +3086,7 @@ func (x *AccountUpdateRequestInput) String() string {
 func (*AccountUpdateRequestInput) ProtoMessage() {}
 
 func (x *AccountUpdateRequestInput) ProtoReflect() protoreflect.Message {
-	mi := &file_identity_identity_service_types_proto_msgTypes[57]
+	mi := &file_identity_identity_service_types_proto_msgTypes[59]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3011,7 +3099,7 @@ func (x *AccountUpdateRequestInput) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AccountUpdateRequestInput.ProtoReflect.Descriptor instead.
 func (*AccountUpdateRequestInput) Descriptor() ([]byte, []int) {
-	return file_identity_identity_service_types_proto_rawDescGZIP(), []int{57}
+	return file_identity_identity_service_types_proto_rawDescGZIP(), []int{59}
 }
 
 func (x *AccountUpdateRequestInput) GetName() string {
@@ -3110,7 +3198,7 @@ type UserCreationResponse struct {
 
 func (x *UserCreationResponse) Reset() {
 	*x = UserCreationResponse{}
-	mi := &file_identity_identity_service_types_proto_msgTypes[58]
+	mi := &file_identity_identity_service_types_proto_msgTypes[60]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3122,7 +3210,7 @@ func (x *UserCreationResponse) String() string {
 func (*UserCreationResponse) ProtoMessage() {}
 
 func (x *UserCreationResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_identity_identity_service_types_proto_msgTypes[58]
+	mi := &file_identity_identity_service_types_proto_msgTypes[60]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3135,7 +3223,7 @@ func (x *UserCreationResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserCreationResponse.ProtoReflect.Descriptor instead.
 func (*UserCreationResponse) Descriptor() ([]byte, []int) {
-	return file_identity_identity_service_types_proto_rawDescGZIP(), []int{58}
+	return file_identity_identity_service_types_proto_rawDescGZIP(), []int{60}
 }
 
 func (x *UserCreationResponse) GetResponseDetails() *types.ResponseDetails {
@@ -3226,7 +3314,7 @@ type UserDataAggregationRequest struct {
 
 func (x *UserDataAggregationRequest) Reset() {
 	*x = UserDataAggregationRequest{}
-	mi := &file_identity_identity_service_types_proto_msgTypes[59]
+	mi := &file_identity_identity_service_types_proto_msgTypes[61]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3238,7 +3326,7 @@ func (x *UserDataAggregationRequest) String() string {
 func (*UserDataAggregationRequest) ProtoMessage() {}
 
 func (x *UserDataAggregationRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_identity_identity_service_types_proto_msgTypes[59]
+	mi := &file_identity_identity_service_types_proto_msgTypes[61]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3251,7 +3339,7 @@ func (x *UserDataAggregationRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserDataAggregationRequest.ProtoReflect.Descriptor instead.
 func (*UserDataAggregationRequest) Descriptor() ([]byte, []int) {
-	return file_identity_identity_service_types_proto_rawDescGZIP(), []int{59}
+	return file_identity_identity_service_types_proto_rawDescGZIP(), []int{61}
 }
 
 func (x *UserDataAggregationRequest) GetRequestId() string {
@@ -3288,7 +3376,7 @@ type UserDetailsUpdateRequest struct {
 
 func (x *UserDetailsUpdateRequest) Reset() {
 	*x = UserDetailsUpdateRequest{}
-	mi := &file_identity_identity_service_types_proto_msgTypes[60]
+	mi := &file_identity_identity_service_types_proto_msgTypes[62]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3300,7 +3388,7 @@ func (x *UserDetailsUpdateRequest) String() string {
 func (*UserDetailsUpdateRequest) ProtoMessage() {}
 
 func (x *UserDetailsUpdateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_identity_identity_service_types_proto_msgTypes[60]
+	mi := &file_identity_identity_service_types_proto_msgTypes[62]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3313,7 +3401,7 @@ func (x *UserDetailsUpdateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UserDetailsUpdateRequest.ProtoReflect.Descriptor instead.
 func (*UserDetailsUpdateRequest) Descriptor() ([]byte, []int) {
-	return file_identity_identity_service_types_proto_rawDescGZIP(), []int{60}
+	return file_identity_identity_service_types_proto_rawDescGZIP(), []int{62}
 }
 
 func (x *UserDetailsUpdateRequest) GetFirstName() string {
@@ -3362,7 +3450,7 @@ type UsernameUpdateRequest struct {
 
 func (x *UsernameUpdateRequest) Reset() {
 	*x = UsernameUpdateRequest{}
-	mi := &file_identity_identity_service_types_proto_msgTypes[61]
+	mi := &file_identity_identity_service_types_proto_msgTypes[63]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3374,7 +3462,7 @@ func (x *UsernameUpdateRequest) String() string {
 func (*UsernameUpdateRequest) ProtoMessage() {}
 
 func (x *UsernameUpdateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_identity_identity_service_types_proto_msgTypes[61]
+	mi := &file_identity_identity_service_types_proto_msgTypes[63]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3387,7 +3475,7 @@ func (x *UsernameUpdateRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use UsernameUpdateRequest.ProtoReflect.Descriptor instead.
 func (*UsernameUpdateRequest) Descriptor() ([]byte, []int) {
-	return file_identity_identity_service_types_proto_rawDescGZIP(), []int{61}
+	return file_identity_identity_service_types_proto_rawDescGZIP(), []int{63}
 }
 
 func (x *UsernameUpdateRequest) GetNewUsername() string {
@@ -3421,7 +3509,7 @@ type AdminSetPasswordChangeRequiredRequest struct {
 
 func (x *AdminSetPasswordChangeRequiredRequest) Reset() {
 	*x = AdminSetPasswordChangeRequiredRequest{}
-	mi := &file_identity_identity_service_types_proto_msgTypes[62]
+	mi := &file_identity_identity_service_types_proto_msgTypes[64]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3433,7 +3521,7 @@ func (x *AdminSetPasswordChangeRequiredRequest) String() string {
 func (*AdminSetPasswordChangeRequiredRequest) ProtoMessage() {}
 
 func (x *AdminSetPasswordChangeRequiredRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_identity_identity_service_types_proto_msgTypes[62]
+	mi := &file_identity_identity_service_types_proto_msgTypes[64]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3446,7 +3534,7 @@ func (x *AdminSetPasswordChangeRequiredRequest) ProtoReflect() protoreflect.Mess
 
 // Deprecated: Use AdminSetPasswordChangeRequiredRequest.ProtoReflect.Descriptor instead.
 func (*AdminSetPasswordChangeRequiredRequest) Descriptor() ([]byte, []int) {
-	return file_identity_identity_service_types_proto_rawDescGZIP(), []int{62}
+	return file_identity_identity_service_types_proto_rawDescGZIP(), []int{64}
 }
 
 func (x *AdminSetPasswordChangeRequiredRequest) GetTargetUserId() string {
@@ -3472,7 +3560,7 @@ type AdminSetPasswordChangeRequiredResponse struct {
 
 func (x *AdminSetPasswordChangeRequiredResponse) Reset() {
 	*x = AdminSetPasswordChangeRequiredResponse{}
-	mi := &file_identity_identity_service_types_proto_msgTypes[63]
+	mi := &file_identity_identity_service_types_proto_msgTypes[65]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3484,7 +3572,7 @@ func (x *AdminSetPasswordChangeRequiredResponse) String() string {
 func (*AdminSetPasswordChangeRequiredResponse) ProtoMessage() {}
 
 func (x *AdminSetPasswordChangeRequiredResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_identity_identity_service_types_proto_msgTypes[63]
+	mi := &file_identity_identity_service_types_proto_msgTypes[65]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3497,7 +3585,7 @@ func (x *AdminSetPasswordChangeRequiredResponse) ProtoReflect() protoreflect.Mes
 
 // Deprecated: Use AdminSetPasswordChangeRequiredResponse.ProtoReflect.Descriptor instead.
 func (*AdminSetPasswordChangeRequiredResponse) Descriptor() ([]byte, []int) {
-	return file_identity_identity_service_types_proto_rawDescGZIP(), []int{63}
+	return file_identity_identity_service_types_proto_rawDescGZIP(), []int{65}
 }
 
 func (x *AdminSetPasswordChangeRequiredResponse) GetResponseDetails() *types.ResponseDetails {
@@ -3518,7 +3606,7 @@ type AdminUpdateUserStatusRequest struct {
 
 func (x *AdminUpdateUserStatusRequest) Reset() {
 	*x = AdminUpdateUserStatusRequest{}
-	mi := &file_identity_identity_service_types_proto_msgTypes[64]
+	mi := &file_identity_identity_service_types_proto_msgTypes[66]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3530,7 +3618,7 @@ func (x *AdminUpdateUserStatusRequest) String() string {
 func (*AdminUpdateUserStatusRequest) ProtoMessage() {}
 
 func (x *AdminUpdateUserStatusRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_identity_identity_service_types_proto_msgTypes[64]
+	mi := &file_identity_identity_service_types_proto_msgTypes[66]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3543,7 +3631,7 @@ func (x *AdminUpdateUserStatusRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminUpdateUserStatusRequest.ProtoReflect.Descriptor instead.
 func (*AdminUpdateUserStatusRequest) Descriptor() ([]byte, []int) {
-	return file_identity_identity_service_types_proto_rawDescGZIP(), []int{64}
+	return file_identity_identity_service_types_proto_rawDescGZIP(), []int{66}
 }
 
 func (x *AdminUpdateUserStatusRequest) GetTargetUserId() string {
@@ -3576,7 +3664,7 @@ type AdminUpdateUserStatusResponse struct {
 
 func (x *AdminUpdateUserStatusResponse) Reset() {
 	*x = AdminUpdateUserStatusResponse{}
-	mi := &file_identity_identity_service_types_proto_msgTypes[65]
+	mi := &file_identity_identity_service_types_proto_msgTypes[67]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3588,7 +3676,7 @@ func (x *AdminUpdateUserStatusResponse) String() string {
 func (*AdminUpdateUserStatusResponse) ProtoMessage() {}
 
 func (x *AdminUpdateUserStatusResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_identity_identity_service_types_proto_msgTypes[65]
+	mi := &file_identity_identity_service_types_proto_msgTypes[67]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3601,7 +3689,7 @@ func (x *AdminUpdateUserStatusResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AdminUpdateUserStatusResponse.ProtoReflect.Descriptor instead.
 func (*AdminUpdateUserStatusResponse) Descriptor() ([]byte, []int) {
-	return file_identity_identity_service_types_proto_rawDescGZIP(), []int{65}
+	return file_identity_identity_service_types_proto_rawDescGZIP(), []int{67}
 }
 
 func (x *AdminUpdateUserStatusResponse) GetResponseDetails() *types.ResponseDetails {
@@ -3611,69 +3699,669 @@ func (x *AdminUpdateUserStatusResponse) GetResponseDetails() *types.ResponseDeta
 	return nil
 }
 
-var File_identity_identity_service_types_proto protoreflect.FileDescriptor
+type AccountRoleCreationRequestInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	Permissions   []string               `protobuf:"bytes,3,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	ParentRoleIds []string               `protobuf:"bytes,4,rep,name=parent_role_ids,json=parentRoleIds,proto3" json:"parent_role_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-var file_identity_identity_service_types_proto_rawDesc = string([]byte{
-	0x0a, 0x25, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2f, 0x69, 0x64, 0x65, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65,
-	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x1a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
-	0x0f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x1a, 0x20, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2f, 0x69, 0x64, 0x65, 0x6e,
-	0x74, 0x69, 0x74, 0x79, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x1a, 0x2c, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x5f, 0x6d, 0x65,
-	0x64, 0x69, 0x61, 0x2f, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x5f, 0x6d, 0x65, 0x64,
-	0x69, 0x61, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0x2d, 0x0a, 0x12, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
-	0x22, 0x59, 0x0a, 0x13, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x56, 0x0a, 0x1c, 0x41,
-	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
-	0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
-	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
-	0x72, 0x49, 0x64, 0x22, 0x63, 0x0a, 0x1d, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x55, 0x73,
-	0x65, 0x72, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
-	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x4a, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a,
-	0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69,
-	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x67, 0x69,
-	0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x05, 0x69,
-	0x6e, 0x70, 0x75, 0x74, 0x22, 0x92, 0x01, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55,
-	0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x10, 0x72,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x0f,
-	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12,
-	0x38, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1e, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x55, 0x73, 0x65, 0x72,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x22, 0x53, 0x0a, 0x14, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x3b, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x25, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x22, 0x88,
-	0x01, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x0f, 0x72, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x2b, 0x0a, 0x07,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
-	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+func (x *AccountRoleCreationRequestInput) Reset() {
+	*x = AccountRoleCreationRequestInput{}
+	mi := &file_identity_identity_service_types_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountRoleCreationRequestInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountRoleCreationRequestInput) ProtoMessage() {}
+
+func (x *AccountRoleCreationRequestInput) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_identity_service_types_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountRoleCreationRequestInput.ProtoReflect.Descriptor instead.
+func (*AccountRoleCreationRequestInput) Descriptor() ([]byte, []int) {
+	return file_identity_identity_service_types_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *AccountRoleCreationRequestInput) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AccountRoleCreationRequestInput) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *AccountRoleCreationRequestInput) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *AccountRoleCreationRequestInput) GetParentRoleIds() []string {
+	if x != nil {
+		return x.ParentRoleIds
+	}
+	return nil
+}
+
+type AccountRoleUpdateRequestInput struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          *string                `protobuf:"bytes,1,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	Description   *string                `protobuf:"bytes,2,opt,name=description,proto3,oneof" json:"description,omitempty"`
+	Permissions   []string               `protobuf:"bytes,3,rep,name=permissions,proto3" json:"permissions,omitempty"`
+	ParentRoleIds []string               `protobuf:"bytes,4,rep,name=parent_role_ids,json=parentRoleIds,proto3" json:"parent_role_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccountRoleUpdateRequestInput) Reset() {
+	*x = AccountRoleUpdateRequestInput{}
+	mi := &file_identity_identity_service_types_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountRoleUpdateRequestInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountRoleUpdateRequestInput) ProtoMessage() {}
+
+func (x *AccountRoleUpdateRequestInput) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_identity_service_types_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountRoleUpdateRequestInput.ProtoReflect.Descriptor instead.
+func (*AccountRoleUpdateRequestInput) Descriptor() ([]byte, []int) {
+	return file_identity_identity_service_types_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *AccountRoleUpdateRequestInput) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *AccountRoleUpdateRequestInput) GetDescription() string {
+	if x != nil && x.Description != nil {
+		return *x.Description
+	}
+	return ""
+}
+
+func (x *AccountRoleUpdateRequestInput) GetPermissions() []string {
+	if x != nil {
+		return x.Permissions
+	}
+	return nil
+}
+
+func (x *AccountRoleUpdateRequestInput) GetParentRoleIds() []string {
+	if x != nil {
+		return x.ParentRoleIds
+	}
+	return nil
+}
+
+type CreateAccountRoleRequest struct {
+	state         protoimpl.MessageState           `protogen:"open.v1"`
+	Input         *AccountRoleCreationRequestInput `protobuf:"bytes,1,opt,name=input,proto3" json:"input,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAccountRoleRequest) Reset() {
+	*x = CreateAccountRoleRequest{}
+	mi := &file_identity_identity_service_types_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAccountRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAccountRoleRequest) ProtoMessage() {}
+
+func (x *CreateAccountRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_identity_service_types_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAccountRoleRequest.ProtoReflect.Descriptor instead.
+func (*CreateAccountRoleRequest) Descriptor() ([]byte, []int) {
+	return file_identity_identity_service_types_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *CreateAccountRoleRequest) GetInput() *AccountRoleCreationRequestInput {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+type CreateAccountRoleResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ResponseDetails *types.ResponseDetails `protobuf:"bytes,1,opt,name=response_details,json=responseDetails,proto3" json:"response_details,omitempty"`
+	Created         *AccountRole           `protobuf:"bytes,2,opt,name=created,proto3" json:"created,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateAccountRoleResponse) Reset() {
+	*x = CreateAccountRoleResponse{}
+	mi := &file_identity_identity_service_types_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAccountRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAccountRoleResponse) ProtoMessage() {}
+
+func (x *CreateAccountRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_identity_service_types_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAccountRoleResponse.ProtoReflect.Descriptor instead.
+func (*CreateAccountRoleResponse) Descriptor() ([]byte, []int) {
+	return file_identity_identity_service_types_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *CreateAccountRoleResponse) GetResponseDetails() *types.ResponseDetails {
+	if x != nil {
+		return x.ResponseDetails
+	}
+	return nil
+}
+
+func (x *CreateAccountRoleResponse) GetCreated() *AccountRole {
+	if x != nil {
+		return x.Created
+	}
+	return nil
+}
+
+type GetAccountRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountRoleId string                 `protobuf:"bytes,1,opt,name=account_role_id,json=accountRoleId,proto3" json:"account_role_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountRoleRequest) Reset() {
+	*x = GetAccountRoleRequest{}
+	mi := &file_identity_identity_service_types_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountRoleRequest) ProtoMessage() {}
+
+func (x *GetAccountRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_identity_service_types_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountRoleRequest.ProtoReflect.Descriptor instead.
+func (*GetAccountRoleRequest) Descriptor() ([]byte, []int) {
+	return file_identity_identity_service_types_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *GetAccountRoleRequest) GetAccountRoleId() string {
+	if x != nil {
+		return x.AccountRoleId
+	}
+	return ""
+}
+
+type GetAccountRoleResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ResponseDetails *types.ResponseDetails `protobuf:"bytes,1,opt,name=response_details,json=responseDetails,proto3" json:"response_details,omitempty"`
+	Result          *AccountRole           `protobuf:"bytes,2,opt,name=result,proto3" json:"result,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetAccountRoleResponse) Reset() {
+	*x = GetAccountRoleResponse{}
+	mi := &file_identity_identity_service_types_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountRoleResponse) ProtoMessage() {}
+
+func (x *GetAccountRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_identity_service_types_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountRoleResponse.ProtoReflect.Descriptor instead.
+func (*GetAccountRoleResponse) Descriptor() ([]byte, []int) {
+	return file_identity_identity_service_types_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *GetAccountRoleResponse) GetResponseDetails() *types.ResponseDetails {
+	if x != nil {
+		return x.ResponseDetails
+	}
+	return nil
+}
+
+func (x *GetAccountRoleResponse) GetResult() *AccountRole {
+	if x != nil {
+		return x.Result
+	}
+	return nil
+}
+
+type GetAccountRolesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountRolesRequest) Reset() {
+	*x = GetAccountRolesRequest{}
+	mi := &file_identity_identity_service_types_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountRolesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountRolesRequest) ProtoMessage() {}
+
+func (x *GetAccountRolesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_identity_service_types_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountRolesRequest.ProtoReflect.Descriptor instead.
+func (*GetAccountRolesRequest) Descriptor() ([]byte, []int) {
+	return file_identity_identity_service_types_proto_rawDescGZIP(), []int{74}
+}
+
+type GetAccountRolesResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ResponseDetails *types.ResponseDetails `protobuf:"bytes,1,opt,name=response_details,json=responseDetails,proto3" json:"response_details,omitempty"`
+	Results         []*AccountRole         `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetAccountRolesResponse) Reset() {
+	*x = GetAccountRolesResponse{}
+	mi := &file_identity_identity_service_types_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountRolesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountRolesResponse) ProtoMessage() {}
+
+func (x *GetAccountRolesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_identity_service_types_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountRolesResponse.ProtoReflect.Descriptor instead.
+func (*GetAccountRolesResponse) Descriptor() ([]byte, []int) {
+	return file_identity_identity_service_types_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *GetAccountRolesResponse) GetResponseDetails() *types.ResponseDetails {
+	if x != nil {
+		return x.ResponseDetails
+	}
+	return nil
+}
+
+func (x *GetAccountRolesResponse) GetResults() []*AccountRole {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type UpdateAccountRoleRequest struct {
+	state         protoimpl.MessageState         `protogen:"open.v1"`
+	AccountRoleId string                         `protobuf:"bytes,1,opt,name=account_role_id,json=accountRoleId,proto3" json:"account_role_id,omitempty"`
+	Input         *AccountRoleUpdateRequestInput `protobuf:"bytes,2,opt,name=input,proto3" json:"input,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAccountRoleRequest) Reset() {
+	*x = UpdateAccountRoleRequest{}
+	mi := &file_identity_identity_service_types_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAccountRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAccountRoleRequest) ProtoMessage() {}
+
+func (x *UpdateAccountRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_identity_service_types_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAccountRoleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAccountRoleRequest) Descriptor() ([]byte, []int) {
+	return file_identity_identity_service_types_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *UpdateAccountRoleRequest) GetAccountRoleId() string {
+	if x != nil {
+		return x.AccountRoleId
+	}
+	return ""
+}
+
+func (x *UpdateAccountRoleRequest) GetInput() *AccountRoleUpdateRequestInput {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+type UpdateAccountRoleResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ResponseDetails *types.ResponseDetails `protobuf:"bytes,1,opt,name=response_details,json=responseDetails,proto3" json:"response_details,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateAccountRoleResponse) Reset() {
+	*x = UpdateAccountRoleResponse{}
+	mi := &file_identity_identity_service_types_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAccountRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAccountRoleResponse) ProtoMessage() {}
+
+func (x *UpdateAccountRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_identity_service_types_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAccountRoleResponse.ProtoReflect.Descriptor instead.
+func (*UpdateAccountRoleResponse) Descriptor() ([]byte, []int) {
+	return file_identity_identity_service_types_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *UpdateAccountRoleResponse) GetResponseDetails() *types.ResponseDetails {
+	if x != nil {
+		return x.ResponseDetails
+	}
+	return nil
+}
+
+type ArchiveAccountRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	AccountRoleId string                 `protobuf:"bytes,1,opt,name=account_role_id,json=accountRoleId,proto3" json:"account_role_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ArchiveAccountRoleRequest) Reset() {
+	*x = ArchiveAccountRoleRequest{}
+	mi := &file_identity_identity_service_types_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveAccountRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveAccountRoleRequest) ProtoMessage() {}
+
+func (x *ArchiveAccountRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_identity_service_types_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveAccountRoleRequest.ProtoReflect.Descriptor instead.
+func (*ArchiveAccountRoleRequest) Descriptor() ([]byte, []int) {
+	return file_identity_identity_service_types_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *ArchiveAccountRoleRequest) GetAccountRoleId() string {
+	if x != nil {
+		return x.AccountRoleId
+	}
+	return ""
+}
+
+type ArchiveAccountRoleResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ResponseDetails *types.ResponseDetails `protobuf:"bytes,1,opt,name=response_details,json=responseDetails,proto3" json:"response_details,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ArchiveAccountRoleResponse) Reset() {
+	*x = ArchiveAccountRoleResponse{}
+	mi := &file_identity_identity_service_types_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ArchiveAccountRoleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArchiveAccountRoleResponse) ProtoMessage() {}
+
+func (x *ArchiveAccountRoleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_identity_identity_service_types_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ArchiveAccountRoleResponse.ProtoReflect.Descriptor instead.
+func (*ArchiveAccountRoleResponse) Descriptor() ([]byte, []int) {
+	return file_identity_identity_service_types_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *ArchiveAccountRoleResponse) GetResponseDetails() *types.ResponseDetails {
+	if x != nil {
+		return x.ResponseDetails
+	}
+	return nil
+}
+
+var File_identity_identity_service_types_proto protoreflect.FileDescriptor
+
+var file_identity_identity_service_types_proto_rawDesc = string([]byte{
+	0x0a, 0x25, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2f, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74,
+	0x79, 0x1a, 0x0c, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x0f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x20, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2f, 0x69, 0x64, 0x65, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x2c, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x5f, 0x6d, 0x65,
+	0x64, 0x69, 0x61, 0x2f, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x5f, 0x6d, 0x65, 0x64,
+	0x69, 0x61, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x22, 0x2d, 0x0a, 0x12, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64,
+	0x22, 0x59, 0x0a, 0x13, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x56, 0x0a, 0x1c, 0x41,
+	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72,
+	0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73,
+	0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65,
+	0x72, 0x49, 0x64, 0x22, 0x63, 0x0a, 0x1d, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x55, 0x73,
+	0x65, 0x72, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x4a, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x35, 0x0a,
+	0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x69,
+	0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x52, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x05, 0x69,
+	0x6e, 0x70, 0x75, 0x74, 0x22, 0x92, 0x01, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55,
+	0x73, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x10, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x0f,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12,
+	0x38, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x22, 0x53, 0x0a, 0x14, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x3b, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x25, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x22, 0x88,
+	0x01, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x0f, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x2b, 0x0a, 0x07,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
 	0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x22, 0x67, 0x0a, 0x1e, 0x43, 0x72, 0x65,
 	0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61,
 	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x45, 0x0a, 0x05, 0x69,
@@ -3955,255 +4643,354 @@ func (x *AdminUpdateUserStatusResponse) GetResponseDetails() *types.ResponseDeta
 	0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
 	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
 	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x7c, 0x0a, 0x25, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x50,
-	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x3a, 0x0a, 0x05, 0x69, 0x6e,
-	0x70, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x69, 0x64, 0x65, 0x6e,
-	0x74, 0x69, 0x74, 0x79, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x55, 0x73, 0x65, 0x72, 0x50,
-	0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52,
-	0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x22, 0x6c, 0x0a, 0x26, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x50, 0x65, 0x72,
-	0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x2a, 0x0a, 0x28, 0x52, 0x6f, 0x74, 0x61,
+	0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b,
+	0x45, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0xa5, 0x01, 0x0a, 0x29, 0x52, 0x6f, 0x74, 0x61, 0x74, 0x65, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x45, 0x6e, 0x63,
+	0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x42, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x64,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x5f, 0x65, 0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6b, 0x65, 0x79,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x45,
+	0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x4b, 0x65, 0x79, 0x22, 0x7c, 0x0a, 0x25,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x65, 0x6d,
+	0x62, 0x65, 0x72, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x3a,
+	0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x4d, 0x6f, 0x64, 0x69, 0x66, 0x79, 0x55,
+	0x73, 0x65, 0x72, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x49, 0x6e,
+	0x70, 0x75, 0x74, 0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x22, 0x6c, 0x0a, 0x26, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4d, 0x65, 0x6d, 0x62, 0x65,
+	0x72, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0xdd, 0x01, 0x0a, 0x1d, 0x55, 0x73, 0x65,
+	0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69,
+	0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61,
+	0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x36, 0x0a, 0x08, 0x62, 0x69, 0x72, 0x74, 0x68, 0x64,
+	0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79, 0x12, 0x29,
+	0x0a, 0x10, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
+	0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
+	0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x74,
+	0x70, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74,
+	0x6f, 0x74, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x59, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x3d, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x05, 0x69, 0x6e,
+	0x70, 0x75, 0x74, 0x22, 0x5f, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65,
+	0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
 	0x12, 0x42, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x74,
 	0x61, 0x69, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6f, 0x6d,
 	0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x74, 0x61,
 	0x69, 0x6c, 0x73, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x74,
-	0x61, 0x69, 0x6c, 0x73, 0x22, 0xdd, 0x01, 0x0a, 0x1d, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x74,
-	0x61, 0x69, 0x6c, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73,
-	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x36, 0x0a, 0x08, 0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x08, 0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x75,
-	0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x73,
-	0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x70, 0x5f, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x70, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x59, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73,
-	0x65, 0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x3d, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x27, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x44,
-	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x22,
-	0x5f, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x74,
-	0x61, 0x69, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x10,
-	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52,
-	0x0f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
-	0x22, 0x95, 0x01, 0x0a, 0x1d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x45,
-	0x6d, 0x61, 0x69, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x6e, 0x65, 0x77, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x5f,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6e,
-	0x65, 0x77, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x29,
-	0x0a, 0x10, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f,
-	0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e,
-	0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x74,
-	0x70, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74,
-	0x6f, 0x74, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x64, 0x0a, 0x1e, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x10, 0x72, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x0f, 0x72,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x3e,
-	0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72,
-	0x6e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6e,
-	0x65, 0x77, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x22, 0x60,
-	0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x55, 0x73, 0x65, 0x72,
-	0x6e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x10,
-	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52,
-	0x0f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
-	0x22, 0xa8, 0x01, 0x0a, 0x18, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x55, 0x73, 0x65, 0x72, 0x41,
-	0x76, 0x61, 0x74, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a,
-	0x10, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c,
-	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
-	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
-	0x52, 0x0f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
-	0x73, 0x12, 0x3c, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x5f, 0x6d, 0x65,
-	0x64, 0x69, 0x61, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x4d, 0x65, 0x64, 0x69,
-	0x61, 0x48, 0x00, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x88, 0x01, 0x01, 0x42,
-	0x0a, 0x0a, 0x08, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x22, 0x86, 0x03, 0x0a, 0x1b,
-	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x1f, 0x0a, 0x08, 0x6c,
-	0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x48, 0x00, 0x52,
-	0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09,
-	0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x48,
-	0x01, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12,
-	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x5f, 0x70,
-	0x68, 0x6f, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74,
-	0x61, 0x63, 0x74, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x31, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0c, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x6e, 0x65, 0x31, 0x12, 0x23, 0x0a,
-	0x0d, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x32, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x6e,
-	0x65, 0x32, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x74, 0x79, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x04, 0x63, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08,
-	0x7a, 0x69, 0x70, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x7a, 0x69, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x72, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72,
-	0x79, 0x12, 0x26, 0x0a, 0x0f, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x5f, 0x74, 0x6f, 0x5f,
-	0x75, 0x73, 0x65, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x62, 0x65, 0x6c, 0x6f,
-	0x6e, 0x67, 0x73, 0x54, 0x6f, 0x55, 0x73, 0x65, 0x72, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6c, 0x61,
-	0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6c, 0x6f, 0x6e, 0x67, 0x69,
-	0x74, 0x75, 0x64, 0x65, 0x22, 0xaa, 0x01, 0x0a, 0x25, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x39,
-	0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
-	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74,
-	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x12, 0x19, 0x0a,
-	0x08, 0x74, 0x6f, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x07, 0x74, 0x6f, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x17, 0x0a, 0x07, 0x74, 0x6f, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x6f, 0x4e, 0x61, 0x6d,
-	0x65, 0x22, 0x4f, 0x0a, 0x23, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x76, 0x69,
-	0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65,
-	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x12,
-	0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f,
-	0x74, 0x65, 0x22, 0x97, 0x04, 0x0a, 0x19, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74,
-	0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00,
-	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x63, 0x6f, 0x6e,
-	0x74, 0x61, 0x63, 0x74, 0x5f, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x48, 0x01, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x50, 0x68, 0x6f, 0x6e, 0x65,
-	0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x6c,
-	0x69, 0x6e, 0x65, 0x31, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x0c, 0x61, 0x64,
-	0x64, 0x72, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x6e, 0x65, 0x31, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a,
-	0x0d, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x32, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x0c, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4c,
-	0x69, 0x6e, 0x65, 0x32, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x63, 0x69, 0x74, 0x79, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52, 0x04, 0x63, 0x69, 0x74, 0x79, 0x88, 0x01, 0x01,
-	0x12, 0x19, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48,
-	0x05, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1e, 0x0a, 0x08, 0x7a,
-	0x69, 0x70, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x06, 0x52,
-	0x07, 0x7a, 0x69, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x07, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48, 0x07, 0x52, 0x07,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x88, 0x01, 0x01, 0x12, 0x1f, 0x0a, 0x08, 0x6c, 0x61,
-	0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x02, 0x48, 0x08, 0x52, 0x08,
-	0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x09, 0x6c,
-	0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x02, 0x48, 0x09,
-	0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12, 0x26,
-	0x0a, 0x0f, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x75, 0x73, 0x65,
-	0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73,
-	0x54, 0x6f, 0x55, 0x73, 0x65, 0x72, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42,
-	0x10, 0x0a, 0x0e, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x5f, 0x70, 0x68, 0x6f, 0x6e,
-	0x65, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x6c, 0x69,
-	0x6e, 0x65, 0x31, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f,
-	0x6c, 0x69, 0x6e, 0x65, 0x32, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x63, 0x69, 0x74, 0x79, 0x42, 0x08,
-	0x0a, 0x06, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x7a, 0x69, 0x70,
-	0x5f, 0x63, 0x6f, 0x64, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72,
-	0x79, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x42, 0x0c,
-	0x0a, 0x0a, 0x5f, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x22, 0xf2, 0x03, 0x0a,
-	0x14, 0x55, 0x73, 0x65, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x17, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x12, 0x36, 0x0a, 0x08, 0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x61, 0x69, 0x6c, 0x73, 0x22, 0x95, 0x01, 0x0a, 0x1d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55,
+	0x73, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x6e, 0x65, 0x77, 0x5f, 0x65, 0x6d,
+	0x61, 0x69, 0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x6e, 0x65, 0x77, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x61,
+	0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1d, 0x0a,
+	0x0a, 0x74, 0x6f, 0x74, 0x70, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x64, 0x0a, 0x1e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42,
+	0x0a, 0x10, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x73, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x73, 0x22, 0x3e, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
+	0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x21, 0x0a, 0x0c, 0x6e, 0x65, 0x77, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61,
+	0x6d, 0x65, 0x22, 0x60, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72,
+	0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x42, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x73, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x22, 0xa8, 0x01, 0x0a, 0x18, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x55,
+	0x73, 0x65, 0x72, 0x41, 0x76, 0x61, 0x74, 0x61, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x42, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x64, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x3c, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65,
+	0x64, 0x5f, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64,
+	0x4d, 0x65, 0x64, 0x69, 0x61, 0x48, 0x00, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x88, 0x01, 0x01, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x22,
+	0x86, 0x03, 0x0a, 0x1b, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12,
+	0x1f, 0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x02, 0x48, 0x00, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x21, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x02, 0x48, 0x01, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x61,
+	0x63, 0x74, 0x5f, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x50, 0x68, 0x6f, 0x6e, 0x65, 0x12, 0x23, 0x0a, 0x0d,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x31, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x6e, 0x65,
+	0x31, 0x12, 0x23, 0x0a, 0x0d, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x6c, 0x69, 0x6e,
+	0x65, 0x32, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x4c, 0x69, 0x6e, 0x65, 0x32, 0x12, 0x12, 0x0a, 0x04, 0x63, 0x69, 0x74, 0x79, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x69, 0x74, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x19, 0x0a, 0x08, 0x7a, 0x69, 0x70, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x7a, 0x69, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x26, 0x0a, 0x0f, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73,
+	0x5f, 0x74, 0x6f, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x54, 0x6f, 0x55, 0x73, 0x65, 0x72, 0x42, 0x0b, 0x0a,
+	0x09, 0x5f, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6c,
+	0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x22, 0xaa, 0x01, 0x0a, 0x25, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x70,
+	0x75, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x52, 0x08, 0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79, 0x12, 0x1a, 0x0a, 0x08,
-	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x6d, 0x61, 0x69,
-	0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0c, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2b, 0x0a,
-	0x12, 0x74, 0x77, 0x6f, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x71, 0x72, 0x5f, 0x63,
-	0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x77, 0x6f, 0x46, 0x61,
-	0x63, 0x74, 0x6f, 0x72, 0x51, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x26, 0x0a, 0x0f, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x55, 0x73, 0x65, 0x72,
-	0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x73, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2a, 0x0a, 0x11, 0x74, 0x77, 0x6f,
-	0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x72, 0x65, 0x74, 0x18, 0x09,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x53,
-	0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74,
+	0x6d, 0x70, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x6f, 0x74,
+	0x65, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x74, 0x6f, 0x45, 0x6d, 0x61, 0x69, 0x6c, 0x12, 0x17, 0x0a, 0x07,
+	0x74, 0x6f, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74,
+	0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x4f, 0x0a, 0x23, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x49, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x6f, 0x74, 0x65, 0x22, 0x97, 0x04, 0x0a, 0x19, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
+	0x6e, 0x70, 0x75, 0x74, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a,
+	0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x5f, 0x70, 0x68, 0x6f, 0x6e, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x50,
+	0x68, 0x6f, 0x6e, 0x65, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x31, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02,
+	0x52, 0x0c, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x4c, 0x69, 0x6e, 0x65, 0x31, 0x88, 0x01,
+	0x01, 0x12, 0x28, 0x0a, 0x0d, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x5f, 0x6c, 0x69, 0x6e,
+	0x65, 0x32, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x0c, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x4c, 0x69, 0x6e, 0x65, 0x32, 0x88, 0x01, 0x01, 0x12, 0x17, 0x0a, 0x04, 0x63,
+	0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x04, 0x52, 0x04, 0x63, 0x69, 0x74,
+	0x79, 0x88, 0x01, 0x01, 0x12, 0x19, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x05, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x88, 0x01, 0x01, 0x12,
+	0x1e, 0x0a, 0x08, 0x7a, 0x69, 0x70, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x06, 0x52, 0x07, 0x7a, 0x69, 0x70, 0x43, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12,
+	0x1d, 0x0a, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x07, 0x52, 0x07, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x72, 0x79, 0x88, 0x01, 0x01, 0x12, 0x1f,
+	0x0a, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x02,
+	0x48, 0x08, 0x52, 0x08, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75, 0x64, 0x65, 0x88, 0x01, 0x01, 0x12,
+	0x21, 0x0a, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x02, 0x48, 0x09, 0x52, 0x09, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x26, 0x0a, 0x0f, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x5f, 0x74, 0x6f,
+	0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x62, 0x65, 0x6c,
+	0x6f, 0x6e, 0x67, 0x73, 0x54, 0x6f, 0x55, 0x73, 0x65, 0x72, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x5f,
+	0x70, 0x68, 0x6f, 0x6e, 0x65, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x31, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x5f, 0x6c, 0x69, 0x6e, 0x65, 0x32, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x63, 0x69,
+	0x74, 0x79, 0x42, 0x08, 0x0a, 0x06, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x42, 0x0b, 0x0a, 0x09,
+	0x5f, 0x7a, 0x69, 0x70, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x42, 0x0a, 0x0a, 0x08, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x72, 0x79, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6c, 0x61, 0x74, 0x69, 0x74, 0x75,
+	0x64, 0x65, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x6c, 0x6f, 0x6e, 0x67, 0x69, 0x74, 0x75, 0x64, 0x65,
+	0x22, 0xf2, 0x03, 0x0a, 0x14, 0x55, 0x73, 0x65, 0x72, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x10, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x0f, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x39, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x36, 0x0a, 0x08, 0x62, 0x69, 0x72, 0x74,
+	0x68, 0x64, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79,
+	0x12, 0x1a, 0x0a, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d,
+	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x2b, 0x0a, 0x12, 0x74, 0x77, 0x6f, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f,
+	0x71, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74,
+	0x77, 0x6f, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x51, 0x72, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x26,
+	0x0a, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2a, 0x0a,
+	0x11, 0x74, 0x77, 0x6f, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x5f, 0x73, 0x65, 0x63, 0x72,
+	0x65, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x77, 0x6f, 0x46, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x53, 0x65, 0x63, 0x72, 0x65, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x72,
+	0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66,
+	0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x73,
+	0x74, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x71, 0x0a, 0x1a, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74,
+	0x61, 0x41, 0x67, 0x67, 0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x12,
+	0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0xd8, 0x01, 0x0a, 0x18, 0x55, 0x73, 0x65,
+	0x72, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74,
 	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d,
-	0x65, 0x22, 0x71, 0x0a, 0x1a, 0x55, 0x73, 0x65, 0x72, 0x44, 0x61, 0x74, 0x61, 0x41, 0x67, 0x67,
-	0x72, 0x65, 0x67, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1b,
-	0x0a, 0x09, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75,
-	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73,
-	0x65, 0x72, 0x49, 0x64, 0x22, 0xd8, 0x01, 0x0a, 0x18, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x74,
-	0x61, 0x69, 0x6c, 0x73, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x66, 0x69, 0x72, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x66, 0x69, 0x72, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x1b, 0x0a, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x36, 0x0a,
-	0x08, 0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x62, 0x69, 0x72,
-	0x74, 0x68, 0x64, 0x61, 0x79, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74,
-	0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
-	0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x70, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
-	0x84, 0x01, 0x0a, 0x15, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6e, 0x65, 0x77,
-	0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x6e, 0x65, 0x77, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x29, 0x0a, 0x10,
-	0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50,
-	0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x70, 0x5f,
-	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x6f, 0x74,
-	0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x87, 0x01, 0x0a, 0x25, 0x41, 0x64, 0x6d, 0x69, 0x6e,
-	0x53, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x24, 0x0a, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74,
-	0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x38, 0x0a, 0x18, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
-	0x65, 0x73, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x5f, 0x63, 0x68, 0x61, 0x6e,
-	0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x16, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72,
-	0x65, 0x73, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65,
-	0x22, 0x6c, 0x0a, 0x26, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73,
-	0x77, 0x6f, 0x72, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72,
-	0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x10, 0x72, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x0f, 0x72,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x7b,
-	0x0a, 0x1c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65,
-	0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x24,
-	0x0a, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x55, 0x73,
-	0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x77, 0x5f, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x65, 0x77, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0x63, 0x0a, 0x1d, 0x41,
-	0x64, 0x6d, 0x69, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x10,
-	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52,
-	0x0f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
-	0x42, 0x60, 0x5a, 0x5e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64,
-	0x69, 0x6e, 0x6e, 0x65, 0x72, 0x64, 0x6f, 0x6e, 0x65, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x2f,
-	0x64, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x64, 0x6f, 0x6e, 0x65, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72,
-	0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x6c, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64,
-	0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6c, 0x61, 0x73, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x36, 0x0a, 0x08, 0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x08, 0x62, 0x69, 0x72, 0x74, 0x68, 0x64, 0x61, 0x79, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x75, 0x72,
+	0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x50, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x70, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x6f, 0x74, 0x70, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x22, 0x84, 0x01, 0x0a, 0x15, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a,
+	0x0c, 0x6e, 0x65, 0x77, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x6e, 0x65, 0x77, 0x55, 0x73, 0x65, 0x72, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x29, 0x0a, 0x10, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x70, 0x61, 0x73, 0x73,
+	0x77, 0x6f, 0x72, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x63, 0x75, 0x72, 0x72,
+	0x65, 0x6e, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x74,
+	0x6f, 0x74, 0x70, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x74, 0x6f, 0x74, 0x70, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x87, 0x01, 0x0a, 0x25, 0x41,
+	0x64, 0x6d, 0x69, 0x6e, 0x53, 0x65, 0x74, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x75,
+	0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x38, 0x0a, 0x18, 0x72, 0x65,
+	0x71, 0x75, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x70, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x5f,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x16, 0x72, 0x65,
+	0x71, 0x75, 0x69, 0x72, 0x65, 0x73, 0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x43, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x22, 0x6c, 0x0a, 0x26, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x65, 0x74,
+	0x50, 0x61, 0x73, 0x73, 0x77, 0x6f, 0x72, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42,
+	0x0a, 0x10, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x73, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x73, 0x22, 0x7b, 0x0a, 0x1c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x55, 0x73, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6e, 0x65, 0x77, 0x5f,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6e, 0x65,
+	0x77, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22,
+	0x63, 0x0a, 0x1d, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73,
+	0x65, 0x72, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x42, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x73, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x22, 0xa1, 0x01, 0x0a, 0x1f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x6f, 0x6c, 0x65, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x20,
+	0x0a, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x26, 0x0a, 0x0f, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x5f,
+	0x69, 0x64, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x65, 0x6e,
+	0x74, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x73, 0x22, 0xc2, 0x01, 0x0a, 0x1d, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x17, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x88, 0x01, 0x01, 0x12, 0x25, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x0b, 0x70, 0x65,
+	0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0b, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x26, 0x0a, 0x0f,
+	0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x70, 0x61, 0x72, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x6c,
+	0x65, 0x49, 0x64, 0x73, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x42, 0x0e, 0x0a,
+	0x0c, 0x5f, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x5b, 0x0a,
+	0x18, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x6f,
+	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3f, 0x0a, 0x05, 0x69, 0x6e, 0x70,
+	0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x29, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6e,
+	0x70, 0x75, 0x74, 0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x22, 0x90, 0x01, 0x0a, 0x19, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x0f, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x2f, 0x0a, 0x07,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x6f, 0x6c, 0x65, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x22, 0x3f, 0x0a,
+	0x15, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x22, 0x8b,
+	0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x6f, 0x6c,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x10, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x0f, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x2d, 0x0a,
+	0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x69, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x6f, 0x6c, 0x65, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x18, 0x0a, 0x16,
+	0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x8e, 0x01, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x42, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x64,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x2f, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x69, 0x64, 0x65, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x07,
+	0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x81, 0x01, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f,
+	0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x49, 0x64, 0x12, 0x3d, 0x0a, 0x05,
+	0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x27, 0x2e, 0x69, 0x64,
+	0x65, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x2e, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x6f,
+	0x6c, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
+	0x6e, 0x70, 0x75, 0x74, 0x52, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x22, 0x5f, 0x0a, 0x19, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x0f, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x43, 0x0a, 0x19,
+	0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x6f,
+	0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x61, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x72, 0x6f, 0x6c, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x49,
+	0x64, 0x22, 0x60, 0x0a, 0x1a, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x41, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x42, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
+	0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69,
+	0x6c, 0x73, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x73, 0x42, 0x60, 0x5a, 0x5e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x64, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x64, 0x6f, 0x6e, 0x65, 0x62, 0x65, 0x74, 0x74,
+	0x65, 0x72, 0x2f, 0x64, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x64, 0x6f, 0x6e, 0x65, 0x62, 0x65, 0x74,
+	0x74, 0x65, 0x72, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x64, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x69, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
@@ -4218,165 +5005,191 @@ func file_identity_identity_service_types_proto_rawDescGZIP() []byte {
 	return file_identity_identity_service_types_proto_rawDescData
 }
 
-var file_identity_identity_service_types_proto_msgTypes = make([]protoimpl.MessageInfo, 66)
+var file_identity_identity_service_types_proto_msgTypes = make([]protoimpl.MessageInfo, 80)
 var file_identity_identity_service_types_proto_goTypes = []any{
-	(*ArchiveUserRequest)(nil),                     // 0: identity.ArchiveUserRequest
-	(*ArchiveUserResponse)(nil),                    // 1: identity.ArchiveUserResponse
-	(*ArchiveUserMembershipRequest)(nil),           // 2: identity.ArchiveUserMembershipRequest
-	(*ArchiveUserMembershipResponse)(nil),          // 3: identity.ArchiveUserMembershipResponse
-	(*CreateUserRequest)(nil),                      // 4: identity.CreateUserRequest
-	(*CreateUserResponse)(nil),                     // 5: identity.CreateUserResponse
-	(*CreateAccountRequest)(nil),                   // 6: identity.CreateAccountRequest
-	(*CreateAccountResponse)(nil),                  // 7: identity.CreateAccountResponse
-	(*CreateAccountInvitationRequest)(nil),         // 8: identity.CreateAccountInvitationRequest
-	(*CreateAccountInvitationResponse)(nil),        // 9: identity.CreateAccountInvitationResponse
-	(*CancelAccountInvitationRequest)(nil),         // 10: identity.CancelAccountInvitationRequest
-	(*CancelAccountInvitationResponse)(nil),        // 11: identity.CancelAccountInvitationResponse
-	(*ArchiveAccountRequest)(nil),                  // 12: identity.ArchiveAccountRequest
-	(*ArchiveAccountResponse)(nil),                 // 13: identity.ArchiveAccountResponse
-	(*AcceptAccountInvitationRequest)(nil),         // 14: identity.AcceptAccountInvitationRequest
-	(*AcceptAccountInvitationResponse)(nil),        // 15: identity.AcceptAccountInvitationResponse
-	(*GetAccountRequest)(nil),                      // 16: identity.GetAccountRequest
-	(*GetAccountResponse)(nil),                     // 17: identity.GetAccountResponse
-	(*GetAccountInvitationRequest)(nil),            // 18: identity.GetAccountInvitationRequest
-	(*GetAccountInvitationResponse)(nil),           // 19: identity.GetAccountInvitationResponse
-	(*GetAccountsRequest)(nil),                     // 20: identity.GetAccountsRequest
-	(*GetAccountsResponse)(nil),                    // 21: identity.GetAccountsResponse
-	(*GetAccountsForUserRequest)(nil),              // 22: identity.GetAccountsForUserRequest
-	(*GetAccountsForUserResponse)(nil),             // 23: identity.GetAccountsForUserResponse
-	(*GetReceivedAccountInvitationsRequest)(nil),   // 24: identity.GetReceivedAccountInvitationsRequest
-	(*GetReceivedAccountInvitationsResponse)(nil),  // 25: identity.GetReceivedAccountInvitationsResponse
-	(*GetSentAccountInvitationsRequest)(nil),       // 26: identity.GetSentAccountInvitationsRequest
-	(*GetSentAccountInvitationsResponse)(nil),      // 27: identity.GetSentAccountInvitationsResponse
-	(*GetUserRequest)(nil),                         // 28: identity.GetUserRequest
-	(*GetUserResponse)(nil),                        // 29: identity.GetUserResponse
-	(*GetUsersRequest)(nil),                        // 30: identity.GetUsersRequest
-	(*GetUsersResponse)(nil),                       // 31: identity.GetUsersResponse
-	(*GetUsersForAccountRequest)(nil),              // 32: identity.GetUsersForAccountRequest
-	(*GetUsersForAccountResponse)(nil),             // 33: identity.GetUsersForAccountResponse
-	(*RejectAccountInvitationRequest)(nil),         // 34: identity.RejectAccountInvitationRequest
-	(*RejectAccountInvitationResponse)(nil),        // 35: identity.RejectAccountInvitationResponse
-	(*SearchForUsersRequest)(nil),                  // 36: identity.SearchForUsersRequest
-	(*SearchForUsersResponse)(nil),                 // 37: identity.SearchForUsersResponse
-	(*SetDefaultAccountRequest)(nil),               // 38: identity.SetDefaultAccountRequest
-	(*SetDefaultAccountResponse)(nil),              // 39: identity.SetDefaultAccountResponse
-	(*TransferAccountOwnershipRequest)(nil),        // 40: identity.TransferAccountOwnershipRequest
-	(*TransferAccountOwnershipResponse)(nil),       // 41: identity.TransferAccountOwnershipResponse
-	(*UpdateAccountRequest)(nil),                   // 42: identity.UpdateAccountRequest
-	(*UpdateAccountResponse)(nil),                  // 43: identity.UpdateAccountResponse
-	(*UpdateAccountMemberPermissionsRequest)(nil),  // 44: identity.UpdateAccountMemberPermissionsRequest
-	(*UpdateAccountMemberPermissionsResponse)(nil), // 45: identity.UpdateAccountMemberPermissionsResponse
-	(*UserDetailsUpdateRequestInput)(nil),          // 46: identity.UserDetailsUpdateRequestInput
-	(*UpdateUserDetailsRequest)(nil),               // 47: identity.UpdateUserDetailsRequest
-	(*UpdateUserDetailsResponse)(nil),              // 48: identity.UpdateUserDetailsResponse
-	(*UpdateUserEmailAddressRequest)(nil),          // 49: identity.UpdateUserEmailAddressRequest
-	(*UpdateUserEmailAddressResponse)(nil),         // 50: identity.UpdateUserEmailAddressResponse
-	(*UpdateUserUsernameRequest)(nil),              // 51: identity.UpdateUserUsernameRequest
-	(*UpdateUserUsernameResponse)(nil),             // 52: identity.UpdateUserUsernameResponse
-	(*UploadUserAvatarResponse)(nil),               // 53: identity.UploadUserAvatarResponse
-	(*AccountCreationRequestInput)(nil),            // 54: identity.AccountCreationRequestInput
-	(*AccountInvitationCreationRequestInput)(nil),  // 55: identity.AccountInvitationCreationRequestInput
-	(*AccountInvitationUpdateRequestInput)(nil),    // 56: identity.AccountInvitationUpdateRequestInput
-	(*AccountUpdateRequestInput)(nil),              // 57: identity.AccountUpdateRequestInput
-	(*UserCreationResponse)(nil),                   // 58: identity.UserCreationResponse
-	(*UserDataAggregationRequest)(nil),             // 59: identity.UserDataAggregationRequest
-	(*UserDetailsUpdateRequest)(nil),               // 60: identity.UserDetailsUpdateRequest
-	(*UsernameUpdateRequest)(nil),                  // 61: identity.UsernameUpdateRequest
-	(*AdminSetPasswordChangeRequiredRequest)(nil),  // 62: identity.AdminSetPasswordChangeRequiredRequest
-	(*AdminSetPasswordChangeRequiredResponse)(nil), // 63: identity.AdminSetPasswordChangeRequiredResponse
-	(*AdminUpdateUserStatusRequest)(nil),           // 64: identity.AdminUpdateUserStatusRequest
-	(*AdminUpdateUserStatusResponse)(nil),          // 65: identity.AdminUpdateUserStatusResponse
-	(*types.ResponseDetails)(nil),                  // 66: common.ResponseDetails
-	(*UserRegistrationInput)(nil),                  // 67: identity.UserRegistrationInput
-	(*Account)(nil),                                // 68: identity.Account
-	(*AccountInvitation)(nil),                      // 69: identity.AccountInvitation
-	(*filtering.QueryFilter)(nil),                  // 70: filtering.QueryFilter
-	(*filtering.Pagination)(nil),                   // 71: filtering.Pagination
-	(*User)(nil),                                   // 72: identity.User
-	(*AccountOwnershipTransferInput)(nil),          // 73: identity.AccountOwnershipTransferInput
-	(*ModifyUserPermissionsInput)(nil),             // 74: identity.ModifyUserPermissionsInput
-	(*timestamppb.Timestamp)(nil),                  // 75: google.protobuf.Timestamp
-	(*uploaded_media.UploadedMedia)(nil),           // 76: uploaded_media.UploadedMedia
+	(*ArchiveUserRequest)(nil),                        // 0: identity.ArchiveUserRequest
+	(*ArchiveUserResponse)(nil),                       // 1: identity.ArchiveUserResponse
+	(*ArchiveUserMembershipRequest)(nil),              // 2: identity.ArchiveUserMembershipRequest
+	(*ArchiveUserMembershipResponse)(nil),             // 3: identity.ArchiveUserMembershipResponse
+	(*CreateUserRequest)(nil),                         // 4: identity.CreateUserRequest
+	(*CreateUserResponse)(nil),                        // 5: identity.CreateUserResponse
+	(*CreateAccountRequest)(nil),                      // 6: identity.CreateAccountRequest
+	(*CreateAccountResponse)(nil),                     // 7: identity.CreateAccountResponse
+	(*CreateAccountInvitationRequest)(nil),            // 8: identity.CreateAccountInvitationRequest
+	(*CreateAccountInvitationResponse)(nil),           // 9: identity.CreateAccountInvitationResponse
+	(*CancelAccountInvitationRequest)(nil),            // 10: identity.CancelAccountInvitationRequest
+	(*CancelAccountInvitationResponse)(nil),           // 11: identity.CancelAccountInvitationResponse
+	(*ArchiveAccountRequest)(nil),                     // 12: identity.ArchiveAccountRequest
+	(*ArchiveAccountResponse)(nil),                    // 13: identity.ArchiveAccountResponse
+	(*AcceptAccountInvitationRequest)(nil),            // 14: identity.AcceptAccountInvitationRequest
+	(*AcceptAccountInvitationResponse)(nil),           // 15: identity.AcceptAccountInvitationResponse
+	(*GetAccountRequest)(nil),                         // 16: identity.GetAccountRequest
+	(*GetAccountResponse)(nil),                        // 17: identity.GetAccountResponse
+	(*GetAccountInvitationRequest)(nil),               // 18: identity.GetAccountInvitationRequest
+	(*GetAccountInvitationResponse)(nil),              // 19: identity.GetAccountInvitationResponse
+	(*GetAccountsRequest)(nil),                        // 20: identity.GetAccountsRequest
+	(*GetAccountsResponse)(nil),                       // 21: identity.GetAccountsResponse
+	(*GetAccountsForUserRequest)(nil),                 // 22: identity.GetAccountsForUserRequest
+	(*GetAccountsForUserResponse)(nil),                // 23: identity.GetAccountsForUserResponse
+	(*GetReceivedAccountInvitationsRequest)(nil),      // 24: identity.GetReceivedAccountInvitationsRequest
+	(*GetReceivedAccountInvitationsResponse)(nil),     // 25: identity.GetReceivedAccountInvitationsResponse
+	(*GetSentAccountInvitationsRequest)(nil),          // 26: identity.GetSentAccountInvitationsRequest
+	(*GetSentAccountInvitationsResponse)(nil),         // 27: identity.GetSentAccountInvitationsResponse
+	(*GetUserRequest)(nil),                            // 28: identity.GetUserRequest
+	(*GetUserResponse)(nil),                           // 29: identity.GetUserResponse
+	(*GetUsersRequest)(nil),                           // 30: identity.GetUsersRequest
+	(*GetUsersResponse)(nil),                          // 31: identity.GetUsersResponse
+	(*GetUsersForAccountRequest)(nil),                 // 32: identity.GetUsersForAccountRequest
+	(*GetUsersForAccountResponse)(nil),                // 33: identity.GetUsersForAccountResponse
+	(*RejectAccountInvitationRequest)(nil),            // 34: identity.RejectAccountInvitationRequest
+	(*RejectAccountInvitationResponse)(nil),           // 35: identity.RejectAccountInvitationResponse
+	(*SearchForUsersRequest)(nil),                     // 36: identity.SearchForUsersRequest
+	(*SearchForUsersResponse)(nil),                    // 37: identity.SearchForUsersResponse
+	(*SetDefaultAccountRequest)(nil),                  // 38: identity.SetDefaultAccountRequest
+	(*SetDefaultAccountResponse)(nil),                 // 39: identity.SetDefaultAccountResponse
+	(*TransferAccountOwnershipRequest)(nil),           // 40: identity.TransferAccountOwnershipRequest
+	(*TransferAccountOwnershipResponse)(nil),          // 41: identity.TransferAccountOwnershipResponse
+	(*UpdateAccountRequest)(nil),                      // 42: identity.UpdateAccountRequest
+	(*UpdateAccountResponse)(nil),                     // 43: identity.UpdateAccountResponse
+	(*RotateAccountWebhookEncryptionKeyRequest)(nil),  // 44: identity.RotateAccountWebhookEncryptionKeyRequest
+	(*RotateAccountWebhookEncryptionKeyResponse)(nil), // 45: identity.RotateAccountWebhookEncryptionKeyResponse
+	(*UpdateAccountMemberPermissionsRequest)(nil),     // 46: identity.UpdateAccountMemberPermissionsRequest
+	(*UpdateAccountMemberPermissionsResponse)(nil),    // 47: identity.UpdateAccountMemberPermissionsResponse
+	(*UserDetailsUpdateRequestInput)(nil),             // 48: identity.UserDetailsUpdateRequestInput
+	(*UpdateUserDetailsRequest)(nil),                  // 49: identity.UpdateUserDetailsRequest
+	(*UpdateUserDetailsResponse)(nil),                 // 50: identity.UpdateUserDetailsResponse
+	(*UpdateUserEmailAddressRequest)(nil),             // 51: identity.UpdateUserEmailAddressRequest
+	(*UpdateUserEmailAddressResponse)(nil),            // 52: identity.UpdateUserEmailAddressResponse
+	(*UpdateUserUsernameRequest)(nil),                 // 53: identity.UpdateUserUsernameRequest
+	(*UpdateUserUsernameResponse)(nil),                // 54: identity.UpdateUserUsernameResponse
+	(*UploadUserAvatarResponse)(nil),                  // 55: identity.UploadUserAvatarResponse
+	(*AccountCreationRequestInput)(nil),               // 56: identity.AccountCreationRequestInput
+	(*AccountInvitationCreationRequestInput)(nil),     // 57: identity.AccountInvitationCreationRequestInput
+	(*AccountInvitationUpdateRequestInput)(nil),       // 58: identity.AccountInvitationUpdateRequestInput
+	(*AccountUpdateRequestInput)(nil),                 // 59: identity.AccountUpdateRequestInput
+	(*UserCreationResponse)(nil),                      // 60: identity.UserCreationResponse
+	(*UserDataAggregationRequest)(nil),                // 61: identity.UserDataAggregationRequest
+	(*UserDetailsUpdateRequest)(nil),                  // 62: identity.UserDetailsUpdateRequest
+	(*UsernameUpdateRequest)(nil),                     // 63: identity.UsernameUpdateRequest
+	(*AdminSetPasswordChangeRequiredRequest)(nil),     // 64: identity.AdminSetPasswordChangeRequiredRequest
+	(*AdminSetPasswordChangeRequiredResponse)(nil),    // 65: identity.AdminSetPasswordChangeRequiredResponse
+	(*AdminUpdateUserStatusRequest)(nil),              // 66: identity.AdminUpdateUserStatusRequest
+	(*AdminUpdateUserStatusResponse)(nil),             // 67: identity.AdminUpdateUserStatusResponse
+	(*AccountRoleCreationRequestInput)(nil),           // 68: identity.AccountRoleCreationRequestInput
+	(*AccountRoleUpdateRequestInput)(nil),             // 69: identity.AccountRoleUpdateRequestInput
+	(*CreateAccountRoleRequest)(nil),                  // 70: identity.CreateAccountRoleRequest
+	(*CreateAccountRoleResponse)(nil),                 // 71: identity.CreateAccountRoleResponse
+	(*GetAccountRoleRequest)(nil),                     // 72: identity.GetAccountRoleRequest
+	(*GetAccountRoleResponse)(nil),                    // 73: identity.GetAccountRoleResponse
+	(*GetAccountRolesRequest)(nil),                    // 74: identity.GetAccountRolesRequest
+	(*GetAccountRolesResponse)(nil),                   // 75: identity.GetAccountRolesResponse
+	(*UpdateAccountRoleRequest)(nil),                  // 76: identity.UpdateAccountRoleRequest
+	(*UpdateAccountRoleResponse)(nil),                 // 77: identity.UpdateAccountRoleResponse
+	(*ArchiveAccountRoleRequest)(nil),                 // 78: identity.ArchiveAccountRoleRequest
+	(*ArchiveAccountRoleResponse)(nil),                // 79: identity.ArchiveAccountRoleResponse
+	(*types.ResponseDetails)(nil),                     // 80: common.ResponseDetails
+	(*UserRegistrationInput)(nil),                     // 81: identity.UserRegistrationInput
+	(*Account)(nil),                                   // 82: identity.Account
+	(*AccountInvitation)(nil),                         // 83: identity.AccountInvitation
+	(*filtering.QueryFilter)(nil),                     // 84: filtering.QueryFilter
+	(*filtering.Pagination)(nil),                      // 85: filtering.Pagination
+	(*User)(nil),                                      // 86: identity.User
+	(*AccountOwnershipTransferInput)(nil),             // 87: identity.AccountOwnershipTransferInput
+	(*ModifyUserPermissionsInput)(nil),                // 88: identity.ModifyUserPermissionsInput
+	(*timestamppb.Timestamp)(nil),                     // 89: google.protobuf.Timestamp
+	(*uploaded_media.UploadedMedia)(nil),              // 90: uploaded_media.UploadedMedia
+	(*AccountRole)(nil),                               // 91: identity.AccountRole
 }
 var file_identity_identity_service_types_proto_depIdxs = []int32{
-	66, // 0: identity.ArchiveUserResponse.response_details:type_name -> common.ResponseDetails
-	66, // 1: identity.ArchiveUserMembershipResponse.response_details:type_name -> common.ResponseDetails
-	67, // 2: identity.CreateUserRequest.input:type_name -> identity.UserRegistrationInput
-	66, // 3: identity.CreateUserResponse.response_details:type_name -> common.ResponseDetails
-	58, // 4: identity.CreateUserResponse.created:type_name -> identity.UserCreationResponse
-	54, // 5: identity.CreateAccountRequest.input:type_name -> identity.AccountCreationRequestInput
-	66, // 6: identity.CreateAccountResponse.response_details:type_name -> common.ResponseDetails
-	68, // 7: identity.CreateAccountResponse.created:type_name -> identity.Account
-	55, // 8: identity.CreateAccountInvitationRequest.input:type_name -> identity.AccountInvitationCreationRequestInput
-	66, // 9: identity.CreateAccountInvitationResponse.response_details:type_name -> common.ResponseDetails
-	69, // 10: identity.CreateAccountInvitationResponse.created:type_name -> identity.AccountInvitation
-	56, // 11: identity.CancelAccountInvitationRequest.input:type_name -> identity.AccountInvitationUpdateRequestInput
-	66, // 12: identity.CancelAccountInvitationResponse.response_details:type_name -> common.ResponseDetails
-	66, // 13: identity.ArchiveAccountResponse.response_details:type_name -> common.ResponseDetails
-	56, // 14: identity.AcceptAccountInvitationRequest.input:type_name -> identity.AccountInvitationUpdateRequestInput
-	66, // 15: identity.AcceptAccountInvitationResponse.response_details:type_name -> common.ResponseDetails
-	66, // 16: identity.GetAccountResponse.response_details:type_name -> common.ResponseDetails
-	68, // 17: identity.GetAccountResponse.result:type_name -> identity.Account
-	66, // 18: identity.GetAccountInvitationResponse.response_details:type_name -> common.ResponseDetails
-	69, // 19: identity.GetAccountInvitationResponse.result:type_name -> identity.AccountInvitation
-	70, // 20: identity.GetAccountsRequest.filter:type_name -> filtering.QueryFilter
-	66, // 21: identity.GetAccountsResponse.response_details:type_name -> common.ResponseDetails
-	71, // 22: identity.GetAccountsResponse.pagination:type_name -> filtering.Pagination
-	68, // 23: identity.GetAccountsResponse.results:type_name -> identity.Account
-	70, // 24: identity.GetAccountsForUserRequest.filter:type_name -> filtering.QueryFilter
-	66, // 25: identity.GetAccountsForUserResponse.response_details:type_name -> common.ResponseDetails
-	71, // 26: identity.GetAccountsForUserResponse.pagination:type_name -> filtering.Pagination
-	68, // 27: identity.GetAccountsForUserResponse.results:type_name -> identity.Account
-	70, // 28: identity.GetReceivedAccountInvitationsRequest.filter:type_name -> filtering.QueryFilter
-	66, // 29: identity.GetReceivedAccountInvitationsResponse.response_details:type_name -> common.ResponseDetails
-	71, // 30: identity.GetReceivedAccountInvitationsResponse.pagination:type_name -> filtering.Pagination
-	69, // 31: identity.GetReceivedAccountInvitationsResponse.results:type_name -> identity.AccountInvitation
-	70, // 32: identity.GetSentAccountInvitationsRequest.filter:type_name -> filtering.QueryFilter
-	66, // 33: identity.GetSentAccountInvitationsResponse.response_details:type_name -> common.ResponseDetails
-	71, // 34: identity.GetSentAccountInvitationsResponse.pagination:type_name -> filtering.Pagination
-	69, // 35: identity.GetSentAccountInvitationsResponse.results:type_name -> identity.AccountInvitation
-	66, // 36: identity.GetUserResponse.response_details:type_name -> common.ResponseDetails
-	72, // 37: identity.GetUserResponse.result:type_name -> identity.User
-	70, // 38: identity.GetUsersRequest.filter:type_name -> filtering.QueryFilter
-	66, // 39: identity.GetUsersResponse.response_details:type_name -> common.ResponseDetails
-	71, // 40: identity.GetUsersResponse.pagination:type_name -> filtering.Pagination
-	72, // 41: identity.GetUsersResponse.results:type_name -> identity.User
-	70, // 42: identity.GetUsersForAccountRequest.filter:type_name -> filtering.QueryFilter
-	66, // 43: identity.GetUsersForAccountResponse.response_details:type_name -> common.ResponseDetails
-	71, // 44: identity.GetUsersForAccountResponse.pagination:type_name -> filtering.Pagination
-	72, // 45: identity.GetUsersForAccountResponse.results:type_name -> identity.User
-	56, // 46: identity.RejectAccountInvitationRequest.input:type_name -> identity.AccountInvitationUpdateRequestInput
-	66, // 47: identity.RejectAccountInvitationResponse.response_details:type_name -> common.ResponseDetails
-	70, // 48: identity.SearchForUsersRequest.filter:type_name -> filtering.QueryFilter
-	66, // 49: identity.SearchForUsersResponse.response_details:type_name -> common.ResponseDetails
-	71, // 50: identity.SearchForUsersResponse.pagination:type_name -> filtering.Pagination
-	72, // 51: identity.SearchForUsersResponse.results:type_name -> identity.User
-	66, // 52: identity.SetDefaultAccountResponse.response_details:type_name -> common.ResponseDetails
-	73, // 53: identity.TransferAccountOwnershipRequest.input:type_name -> identity.AccountOwnershipTransferInput
-	66, // 54: identity.TransferAccountOwnershipResponse.response_details:type_name -> common.ResponseDetails
-	57, // 55: identity.UpdateAccountRequest.input:type_name -> identity.AccountUpdateRequestInput
-	66, // 56: identity.UpdateAccountResponse.response_details:type_name -> common.ResponseDetails
-	74, // 57: identity.UpdateAccountMemberPermissionsRequest.input:type_name -> identity.ModifyUserPermissionsInput
-	66, // 58: identity.UpdateAccountMemberPermissionsResponse.response_details:type_name -> common.ResponseDetails
-	75, // 59: identity.UserDetailsUpdateRequestInput.birthday:type_name -> google.protobuf.Timestamp
-	46, // 60: identity.UpdateUserDetailsRequest.input:type_name -> identity.UserDetailsUpdateRequestInput
-	66, // 61: identity.UpdateUserDetailsResponse.response_details:type_name -> common.ResponseDetails
-	66, // 62: identity.UpdateUserEmailAddressResponse.response_details:type_name -> common.ResponseDetails
-	66, // 63: identity.UpdateUserUsernameResponse.response_details:type_name -> common.ResponseDetails
-	66, // 64: identity.UploadUserAvatarResponse.response_details:type_name -> common.ResponseDetails
-	76, // 65: identity.UploadUserAvatarResponse.created:type_name -> uploaded_media.UploadedMedia
-	75, // 66: identity.AccountInvitationCreationRequestInput.expires_at:type_name -> google.protobuf.Timestamp
-	66, // 67: identity.UserCreationResponse.response_details:type_name -> common.ResponseDetails
-	75, // 68: identity.UserCreationResponse.created_at:type_name -> google.protobuf.Timestamp
-	75, // 69: identity.UserCreationResponse.birthday:type_name -> google.protobuf.Timestamp
-	75, // 70: identity.UserDetailsUpdateRequest.birthday:type_name -> google.protobuf.Timestamp
-	66, // 71: identity.AdminSetPasswordChangeRequiredResponse.response_details:type_name -> common.ResponseDetails
-	66, // 72: identity.AdminUpdateUserStatusResponse.response_details:type_name -> common.ResponseDetails
-	73, // [73:73] is the sub-list for method output_type
-	73, // [73:73] is the sub-list for method input_type
-	73, // [73:73] is the sub-list for extension type_name
-	73, // [73:73] is the sub-list for extension extendee
-	0,  // [0:73] is the sub-list for field type_name
+	80, // 0: identity.ArchiveUserResponse.response_details:type_name -> common.ResponseDetails
+	80, // 1: identity.ArchiveUserMembershipResponse.response_details:type_name -> common.ResponseDetails
+	81, // 2: identity.CreateUserRequest.input:type_name -> identity.UserRegistrationInput
+	80, // 3: identity.CreateUserResponse.response_details:type_name -> common.ResponseDetails
+	60, // 4: identity.CreateUserResponse.created:type_name -> identity.UserCreationResponse
+	56, // 5: identity.CreateAccountRequest.input:type_name -> identity.AccountCreationRequestInput
+	80, // 6: identity.CreateAccountResponse.response_details:type_name -> common.ResponseDetails
+	82, // 7: identity.CreateAccountResponse.created:type_name -> identity.Account
+	57, // 8: identity.CreateAccountInvitationRequest.input:type_name -> identity.AccountInvitationCreationRequestInput
+	80, // 9: identity.CreateAccountInvitationResponse.response_details:type_name -> common.ResponseDetails
+	83, // 10: identity.CreateAccountInvitationResponse.created:type_name -> identity.AccountInvitation
+	58, // 11: identity.CancelAccountInvitationRequest.input:type_name -> identity.AccountInvitationUpdateRequestInput
+	80, // 12: identity.CancelAccountInvitationResponse.response_details:type_name -> common.ResponseDetails
+	80, // 13: identity.ArchiveAccountResponse.response_details:type_name -> common.ResponseDetails
+	58, // 14: identity.AcceptAccountInvitationRequest.input:type_name -> identity.AccountInvitationUpdateRequestInput
+	80, // 15: identity.AcceptAccountInvitationResponse.response_details:type_name -> common.ResponseDetails
+	80, // 16: identity.GetAccountResponse.response_details:type_name -> common.ResponseDetails
+	82, // 17: identity.GetAccountResponse.result:type_name -> identity.Account
+	80, // 18: identity.GetAccountInvitationResponse.response_details:type_name -> common.ResponseDetails
+	83, // 19: identity.GetAccountInvitationResponse.result:type_name -> identity.AccountInvitation
+	84, // 20: identity.GetAccountsRequest.filter:type_name -> filtering.QueryFilter
+	80, // 21: identity.GetAccountsResponse.response_details:type_name -> common.ResponseDetails
+	85, // 22: identity.GetAccountsResponse.pagination:type_name -> filtering.Pagination
+	82, // 23: identity.GetAccountsResponse.results:type_name -> identity.Account
+	84, // 24: identity.GetAccountsForUserRequest.filter:type_name -> filtering.QueryFilter
+	80, // 25: identity.GetAccountsForUserResponse.response_details:type_name -> common.ResponseDetails
+	85, // 26: identity.GetAccountsForUserResponse.pagination:type_name -> filtering.Pagination
+	82, // 27: identity.GetAccountsForUserResponse.results:type_name -> identity.Account
+	84, // 28: identity.GetReceivedAccountInvitationsRequest.filter:type_name -> filtering.QueryFilter
+	80, // 29: identity.GetReceivedAccountInvitationsResponse.response_details:type_name -> common.ResponseDetails
+	85, // 30: identity.GetReceivedAccountInvitationsResponse.pagination:type_name -> filtering.Pagination
+	83, // 31: identity.GetReceivedAccountInvitationsResponse.results:type_name -> identity.AccountInvitation
+	84, // 32: identity.GetSentAccountInvitationsRequest.filter:type_name -> filtering.QueryFilter
+	80, // 33: identity.GetSentAccountInvitationsResponse.response_details:type_name -> common.ResponseDetails
+	85, // 34: identity.GetSentAccountInvitationsResponse.pagination:type_name -> filtering.Pagination
+	83, // 35: identity.GetSentAccountInvitationsResponse.results:type_name -> identity.AccountInvitation
+	80, // 36: identity.GetUserResponse.response_details:type_name -> common.ResponseDetails
+	86, // 37: identity.GetUserResponse.result:type_name -> identity.User
+	84, // 38: identity.GetUsersRequest.filter:type_name -> filtering.QueryFilter
+	80, // 39: identity.GetUsersResponse.response_details:type_name -> common.ResponseDetails
+	85, // 40: identity.GetUsersResponse.pagination:type_name -> filtering.Pagination
+	86, // 41: identity.GetUsersResponse.results:type_name -> identity.User
+	84, // 42: identity.GetUsersForAccountRequest.filter:type_name -> filtering.QueryFilter
+	80, // 43: identity.GetUsersForAccountResponse.response_details:type_name -> common.ResponseDetails
+	85, // 44: identity.GetUsersForAccountResponse.pagination:type_name -> filtering.Pagination
+	86, // 45: identity.GetUsersForAccountResponse.results:type_name -> identity.User
+	58, // 46: identity.RejectAccountInvitationRequest.input:type_name -> identity.AccountInvitationUpdateRequestInput
+	80, // 47: identity.RejectAccountInvitationResponse.response_details:type_name -> common.ResponseDetails
+	84, // 48: identity.SearchForUsersRequest.filter:type_name -> filtering.QueryFilter
+	80, // 49: identity.SearchForUsersResponse.response_details:type_name -> common.ResponseDetails
+	85, // 50: identity.SearchForUsersResponse.pagination:type_name -> filtering.Pagination
+	86, // 51: identity.SearchForUsersResponse.results:type_name -> identity.User
+	80, // 52: identity.SetDefaultAccountResponse.response_details:type_name -> common.ResponseDetails
+	87, // 53: identity.TransferAccountOwnershipRequest.input:type_name -> identity.AccountOwnershipTransferInput
+	80, // 54: identity.TransferAccountOwnershipResponse.response_details:type_name -> common.ResponseDetails
+	59, // 55: identity.UpdateAccountRequest.input:type_name -> identity.AccountUpdateRequestInput
+	80, // 56: identity.UpdateAccountResponse.response_details:type_name -> common.ResponseDetails
+	80, // 57: identity.RotateAccountWebhookEncryptionKeyResponse.response_details:type_name -> common.ResponseDetails
+	88, // 58: identity.UpdateAccountMemberPermissionsRequest.input:type_name -> identity.ModifyUserPermissionsInput
+	80, // 59: identity.UpdateAccountMemberPermissionsResponse.response_details:type_name -> common.ResponseDetails
+	89, // 60: identity.UserDetailsUpdateRequestInput.birthday:type_name -> google.protobuf.Timestamp
+	48, // 61: identity.UpdateUserDetailsRequest.input:type_name -> identity.UserDetailsUpdateRequestInput
+	80, // 62: identity.UpdateUserDetailsResponse.response_details:type_name -> common.ResponseDetails
+	80, // 63: identity.UpdateUserEmailAddressResponse.response_details:type_name -> common.ResponseDetails
+	80, // 64: identity.UpdateUserUsernameResponse.response_details:type_name -> common.ResponseDetails
+	80, // 65: identity.UploadUserAvatarResponse.response_details:type_name -> common.ResponseDetails
+	90, // 66: identity.UploadUserAvatarResponse.created:type_name -> uploaded_media.UploadedMedia
+	89, // 67: identity.AccountInvitationCreationRequestInput.expires_at:type_name -> google.protobuf.Timestamp
+	80, // 68: identity.UserCreationResponse.response_details:type_name -> common.ResponseDetails
+	89, // 69: identity.UserCreationResponse.created_at:type_name -> google.protobuf.Timestamp
+	89, // 70: identity.UserCreationResponse.birthday:type_name -> google.protobuf.Timestamp
+	89, // 71: identity.UserDetailsUpdateRequest.birthday:type_name -> google.protobuf.Timestamp
+	80, // 72: identity.AdminSetPasswordChangeRequiredResponse.response_details:type_name -> common.ResponseDetails
+	80, // 73: identity.AdminUpdateUserStatusResponse.response_details:type_name -> common.ResponseDetails
+	68, // 74: identity.CreateAccountRoleRequest.input:type_name -> identity.AccountRoleCreationRequestInput
+	80, // 75: identity.CreateAccountRoleResponse.response_details:type_name -> common.ResponseDetails
+	91, // 76: identity.CreateAccountRoleResponse.created:type_name -> identity.AccountRole
+	80, // 77: identity.GetAccountRoleResponse.response_details:type_name -> common.ResponseDetails
+	91, // 78: identity.GetAccountRoleResponse.result:type_name -> identity.AccountRole
+	80, // 79: identity.GetAccountRolesResponse.response_details:type_name -> common.ResponseDetails
+	91, // 80: identity.GetAccountRolesResponse.results:type_name -> identity.AccountRole
+	69, // 81: identity.UpdateAccountRoleRequest.input:type_name -> identity.AccountRoleUpdateRequestInput
+	80, // 82: identity.UpdateAccountRoleResponse.response_details:type_name -> common.ResponseDetails
+	80, // 83: identity.ArchiveAccountRoleResponse.response_details:type_name -> common.ResponseDetails
+	84, // [84:84] is the sub-list for method output_type
+	84, // [84:84] is the sub-list for method input_type
+	84, // [84:84] is the sub-list for extension type_name
+	84, // [84:84] is the sub-list for extension extendee
+	0,  // [0:84] is the sub-list for field type_name
 }
 
 func init() { file_identity_identity_service_types_proto_init() }
@@ -4385,16 +5198,17 @@ func file_identity_identity_service_types_proto_init() {
 		return
 	}
 	file_identity_identity_messages_proto_init()
-	file_identity_identity_service_types_proto_msgTypes[53].OneofWrappers = []any{}
-	file_identity_identity_service_types_proto_msgTypes[54].OneofWrappers = []any{}
-	file_identity_identity_service_types_proto_msgTypes[57].OneofWrappers = []any{}
+	file_identity_identity_service_types_proto_msgTypes[55].OneofWrappers = []any{}
+	file_identity_identity_service_types_proto_msgTypes[56].OneofWrappers = []any{}
+	file_identity_identity_service_types_proto_msgTypes[59].OneofWrappers = []any{}
+	file_identity_identity_service_types_proto_msgTypes[69].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_identity_identity_service_types_proto_rawDesc), len(file_identity_identity_service_types_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   66,
+			NumMessages:   80,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
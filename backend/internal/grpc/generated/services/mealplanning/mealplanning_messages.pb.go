@@ -277,6 +277,9 @@ func (MealComponentType) EnumDescriptor() ([]byte, []int) {
 const (
 	MealPlanElectionMethod_MEAL_PLAN_ELECTION_METHOD_SCHULZE        MealPlanElectionMethod = 0
 	MealPlanElectionMethod_MEAL_PLAN_ELECTION_METHOD_INSTANT_RUNOFF MealPlanElectionMethod = 1
+	MealPlanElectionMethod_MEAL_PLAN_ELECTION_METHOD_BORDA          MealPlanElectionMethod = 2
+	MealPlanElectionMethod_MEAL_PLAN_ELECTION_METHOD_APPROVAL       MealPlanElectionMethod = 3
+	MealPlanElectionMethod_MEAL_PLAN_ELECTION_METHOD_SCORE          MealPlanElectionMethod = 4
 )
 
 // Enum value maps for MealPlanElectionMethod.
@@ -284,10 +287,16 @@ func (MealComponentType) EnumDescriptor() ([]byte, []int) {
 	MealPlanElectionMethod_name = map[int32]string{
 		0: "MEAL_PLAN_ELECTION_METHOD_SCHULZE",
 		1: "MEAL_PLAN_ELECTION_METHOD_INSTANT_RUNOFF",
+		2: "MEAL_PLAN_ELECTION_METHOD_BORDA",
+		3: "MEAL_PLAN_ELECTION_METHOD_APPROVAL",
+		4: "MEAL_PLAN_ELECTION_METHOD_SCORE",
 	}
 	MealPlanElectionMethod_value = map[string]int32{
 		"MEAL_PLAN_ELECTION_METHOD_SCHULZE":        0,
 		"MEAL_PLAN_ELECTION_METHOD_INSTANT_RUNOFF": 1,
+		"MEAL_PLAN_ELECTION_METHOD_BORDA":          2,
+		"MEAL_PLAN_ELECTION_METHOD_APPROVAL":       3,
+		"MEAL_PLAN_ELECTION_METHOD_SCORE":          4,
 	}
 )
 
@@ -477,6 +486,58 @@ func (MealPlanGroceryListItemStatus) EnumDescriptor() ([]byte, []int) {
 	return file_mealplanning_mealplanning_messages_proto_rawDescGZIP(), []int{7}
 }
 
+type PantryStorageLocation int32
+
+const (
+	PantryStorageLocation_PANTRY_STORAGE_LOCATION_PANTRY       PantryStorageLocation = 0
+	PantryStorageLocation_PANTRY_STORAGE_LOCATION_REFRIGERATOR PantryStorageLocation = 1
+	PantryStorageLocation_PANTRY_STORAGE_LOCATION_FREEZER      PantryStorageLocation = 2
+	PantryStorageLocation_PANTRY_STORAGE_LOCATION_OTHER        PantryStorageLocation = 3
+)
+
+// Enum value maps for PantryStorageLocation.
+var (
+	PantryStorageLocation_name = map[int32]string{
+		0: "PANTRY_STORAGE_LOCATION_PANTRY",
+		1: "PANTRY_STORAGE_LOCATION_REFRIGERATOR",
+		2: "PANTRY_STORAGE_LOCATION_FREEZER",
+		3: "PANTRY_STORAGE_LOCATION_OTHER",
+	}
+	PantryStorageLocation_value = map[string]int32{
+		"PANTRY_STORAGE_LOCATION_PANTRY":       0,
+		"PANTRY_STORAGE_LOCATION_REFRIGERATOR": 1,
+		"PANTRY_STORAGE_LOCATION_FREEZER":      2,
+		"PANTRY_STORAGE_LOCATION_OTHER":        3,
+	}
+)
+
+func (x PantryStorageLocation) Enum() *PantryStorageLocation {
+	p := new(PantryStorageLocation)
+	*p = x
+	return p
+}
+
+func (x PantryStorageLocation) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PantryStorageLocation) Descriptor() protoreflect.EnumDescriptor {
+	return file_mealplanning_mealplanning_messages_proto_enumTypes[8].Descriptor()
+}
+
+func (PantryStorageLocation) Type() protoreflect.EnumType {
+	return &file_mealplanning_mealplanning_messages_proto_enumTypes[8]
+}
+
+func (x PantryStorageLocation) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PantryStorageLocation.Descriptor instead.
+func (PantryStorageLocation) EnumDescriptor() ([]byte, []int) {
+	return file_mealplanning_mealplanning_messages_proto_rawDescGZIP(), []int{8}
+}
+
 type MealPlanTaskStatus int32
 
 const (
@@ -516,11 +577,11 @@ func (x MealPlanTaskStatus) String() string {
 }
 
 func (MealPlanTaskStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_mealplanning_mealplanning_messages_proto_enumTypes[8].Descriptor()
+	return file_mealplanning_mealplanning_messages_proto_enumTypes[9].Descriptor()
 }
 
 func (MealPlanTaskStatus) Type() protoreflect.EnumType {
-	return &file_mealplanning_mealplanning_messages_proto_enumTypes[8]
+	return &file_mealplanning_mealplanning_messages_proto_enumTypes[9]
 }
 
 func (x MealPlanTaskStatus) Number() protoreflect.EnumNumber {
@@ -529,7 +590,7 @@ func (x MealPlanTaskStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use MealPlanTaskStatus.Descriptor instead.
 func (MealPlanTaskStatus) EnumDescriptor() ([]byte, []int) {
-	return file_mealplanning_mealplanning_messages_proto_rawDescGZIP(), []int{8}
+	return file_mealplanning_mealplanning_messages_proto_rawDescGZIP(), []int{9}
 }
 
 type MealPlanRecipeOptionSelectionType int32
@@ -568,11 +629,11 @@ func (x MealPlanRecipeOptionSelectionType) String() string {
 }
 
 func (MealPlanRecipeOptionSelectionType) Descriptor() protoreflect.EnumDescriptor {
-	return file_mealplanning_mealplanning_messages_proto_enumTypes[9].Descriptor()
+	return file_mealplanning_mealplanning_messages_proto_enumTypes[10].Descriptor()
 }
 
 func (MealPlanRecipeOptionSelectionType) Type() protoreflect.EnumType {
-	return &file_mealplanning_mealplanning_messages_proto_enumTypes[9]
+	return &file_mealplanning_mealplanning_messages_proto_enumTypes[10]
 }
 
 func (x MealPlanRecipeOptionSelectionType) Number() protoreflect.EnumNumber {
@@ -581,7 +642,7 @@ func (x MealPlanRecipeOptionSelectionType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use MealPlanRecipeOptionSelectionType.Descriptor instead.
 func (MealPlanRecipeOptionSelectionType) EnumDescriptor() ([]byte, []int) {
-	return file_mealplanning_mealplanning_messages_proto_rawDescGZIP(), []int{9}
+	return file_mealplanning_mealplanning_messages_proto_rawDescGZIP(), []int{10}
 }
 
 type DataCollection struct {
@@ -592,6 +653,7 @@ type DataCollection struct {
 	Recipes                     []*Recipe                     `protobuf:"bytes,4,rep,name=recipes,proto3" json:"recipes,omitempty"`
 	Meals                       []*Meal                       `protobuf:"bytes,5,rep,name=meals,proto3" json:"meals,omitempty"`
 	UserIngredientPreferences   []*UserIngredientPreference   `protobuf:"bytes,6,rep,name=user_ingredient_preferences,json=userIngredientPreferences,proto3" json:"user_ingredient_preferences,omitempty"`
+	PantryItems                 []*PantryItem                 `protobuf:"bytes,7,rep,name=pantry_items,json=pantryItems,proto3" json:"pantry_items,omitempty"`
 	unknownFields               protoimpl.UnknownFields
 	sizeCache                   protoimpl.SizeCache
 }
@@ -668,6 +730,13 @@ func (x *DataCollection) GetUserIngredientPreferences() []*UserIngredientPrefere
 	return nil
 }
 
+func (x *DataCollection) GetPantryItems() []*PantryItem {
+	if x != nil {
+		return x.PantryItems
+	}
+	return nil
+}
+
 type ValidIngredient struct {
 	state                          protoimpl.MessageState          `protogen:"open.v1"`
 	CreatedAt                      *timestamppb.Timestamp          `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
@@ -3572,49 +3641,32 @@ func (x *RecipeRating) GetDifficulty() float32 {
 	return 0
 }
 
-type RecipeStep struct {
-	state                     protoimpl.MessageState           `protogen:"open.v1"`
-	CreatedAt                 *timestamppb.Timestamp           `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	MinEstimatedTimeInSeconds *uint32                          `protobuf:"varint,2,opt,name=min_estimated_time_in_seconds,json=minEstimatedTimeInSeconds,proto3,oneof" json:"min_estimated_time_in_seconds,omitempty"`
-	MaxEstimatedTimeInSeconds *uint32                          `protobuf:"varint,22,opt,name=max_estimated_time_in_seconds,json=maxEstimatedTimeInSeconds,proto3,oneof" json:"max_estimated_time_in_seconds,omitempty"`
-	MinTemperatureInCelsius   *float32                         `protobuf:"fixed32,3,opt,name=min_temperature_in_celsius,json=minTemperatureInCelsius,proto3,oneof" json:"min_temperature_in_celsius,omitempty"`
-	MaxTemperatureInCelsius   *float32                         `protobuf:"fixed32,23,opt,name=max_temperature_in_celsius,json=maxTemperatureInCelsius,proto3,oneof" json:"max_temperature_in_celsius,omitempty"`
-	ArchivedAt                *timestamppb.Timestamp           `protobuf:"bytes,4,opt,name=archived_at,json=archivedAt,proto3,oneof" json:"archived_at,omitempty"`
-	LastUpdatedAt             *timestamppb.Timestamp           `protobuf:"bytes,5,opt,name=last_updated_at,json=lastUpdatedAt,proto3,oneof" json:"last_updated_at,omitempty"`
-	BelongsToRecipe           string                           `protobuf:"bytes,6,opt,name=belongs_to_recipe,json=belongsToRecipe,proto3" json:"belongs_to_recipe,omitempty"`
-	ConditionExpression       string                           `protobuf:"bytes,7,opt,name=condition_expression,json=conditionExpression,proto3" json:"condition_expression,omitempty"`
-	Id                        string                           `protobuf:"bytes,8,opt,name=id,proto3" json:"id,omitempty"`
-	Notes                     string                           `protobuf:"bytes,9,opt,name=notes,proto3" json:"notes,omitempty"`
-	ExplicitInstructions      string                           `protobuf:"bytes,10,opt,name=explicit_instructions,json=explicitInstructions,proto3" json:"explicit_instructions,omitempty"`
-	Media                     []*RecipeMedia                   `protobuf:"bytes,11,rep,name=media,proto3" json:"media,omitempty"`
-	Products                  []*RecipeStepProduct             `protobuf:"bytes,12,rep,name=products,proto3" json:"products,omitempty"`
-	Instruments               []*RecipeStepInstrument          `protobuf:"bytes,13,rep,name=instruments,proto3" json:"instruments,omitempty"`
-	Vessels                   []*RecipeStepVessel              `protobuf:"bytes,14,rep,name=vessels,proto3" json:"vessels,omitempty"`
-	CompletionConditions      []*RecipeStepCompletionCondition `protobuf:"bytes,15,rep,name=completion_conditions,json=completionConditions,proto3" json:"completion_conditions,omitempty"`
-	Ingredients               []*RecipeStepIngredient          `protobuf:"bytes,16,rep,name=ingredients,proto3" json:"ingredients,omitempty"`
-	Preparation               *ValidPreparation                `protobuf:"bytes,17,opt,name=preparation,proto3" json:"preparation,omitempty"`
-	Index                     uint32                           `protobuf:"varint,18,opt,name=index,proto3" json:"index,omitempty"`
-	Optional                  bool                             `protobuf:"varint,19,opt,name=optional,proto3" json:"optional,omitempty"`
-	StartTimerAutomatically   bool                             `protobuf:"varint,20,opt,name=start_timer_automatically,json=startTimerAutomatically,proto3" json:"start_timer_automatically,omitempty"`
-	StepImages                []*uploaded_media.UploadedMedia  `protobuf:"bytes,21,rep,name=step_images,json=stepImages,proto3" json:"step_images,omitempty"`
-	unknownFields             protoimpl.UnknownFields
-	sizeCache                 protoimpl.SizeCache
+type RecipeRevision struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	CreatedByUser   *string                `protobuf:"bytes,2,opt,name=created_by_user,json=createdByUser,proto3,oneof" json:"created_by_user,omitempty"`
+	Snapshot        *Recipe                `protobuf:"bytes,3,opt,name=snapshot,proto3" json:"snapshot,omitempty"`
+	Id              string                 `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
+	BelongsToRecipe string                 `protobuf:"bytes,5,opt,name=belongs_to_recipe,json=belongsToRecipe,proto3" json:"belongs_to_recipe,omitempty"`
+	RevisionNumber  uint32                 `protobuf:"varint,6,opt,name=revision_number,json=revisionNumber,proto3" json:"revision_number,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
 }
 
-func (x *RecipeStep) Reset() {
-	*x = RecipeStep{}
+func (x *RecipeRevision) Reset() {
+	*x = RecipeRevision{}
 	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RecipeStep) String() string {
+func (x *RecipeRevision) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecipeStep) ProtoMessage() {}
+func (*RecipeRevision) ProtoMessage() {}
 
-func (x *RecipeStep) ProtoReflect() protoreflect.Message {
+func (x *RecipeRevision) ProtoReflect() protoreflect.Message {
 	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3626,202 +3678,218 @@ func (x *RecipeStep) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecipeStep.ProtoReflect.Descriptor instead.
-func (*RecipeStep) Descriptor() ([]byte, []int) {
+// Deprecated: Use RecipeRevision.ProtoReflect.Descriptor instead.
+func (*RecipeRevision) Descriptor() ([]byte, []int) {
 	return file_mealplanning_mealplanning_messages_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *RecipeStep) GetCreatedAt() *timestamppb.Timestamp {
+func (x *RecipeRevision) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return nil
 }
 
-func (x *RecipeStep) GetMinEstimatedTimeInSeconds() uint32 {
-	if x != nil && x.MinEstimatedTimeInSeconds != nil {
-		return *x.MinEstimatedTimeInSeconds
-	}
-	return 0
-}
-
-func (x *RecipeStep) GetMaxEstimatedTimeInSeconds() uint32 {
-	if x != nil && x.MaxEstimatedTimeInSeconds != nil {
-		return *x.MaxEstimatedTimeInSeconds
-	}
-	return 0
-}
-
-func (x *RecipeStep) GetMinTemperatureInCelsius() float32 {
-	if x != nil && x.MinTemperatureInCelsius != nil {
-		return *x.MinTemperatureInCelsius
-	}
-	return 0
-}
-
-func (x *RecipeStep) GetMaxTemperatureInCelsius() float32 {
-	if x != nil && x.MaxTemperatureInCelsius != nil {
-		return *x.MaxTemperatureInCelsius
+func (x *RecipeRevision) GetCreatedByUser() string {
+	if x != nil && x.CreatedByUser != nil {
+		return *x.CreatedByUser
 	}
-	return 0
+	return ""
 }
 
-func (x *RecipeStep) GetArchivedAt() *timestamppb.Timestamp {
+func (x *RecipeRevision) GetSnapshot() *Recipe {
 	if x != nil {
-		return x.ArchivedAt
+		return x.Snapshot
 	}
 	return nil
 }
 
-func (x *RecipeStep) GetLastUpdatedAt() *timestamppb.Timestamp {
+func (x *RecipeRevision) GetId() string {
 	if x != nil {
-		return x.LastUpdatedAt
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *RecipeStep) GetBelongsToRecipe() string {
+func (x *RecipeRevision) GetBelongsToRecipe() string {
 	if x != nil {
 		return x.BelongsToRecipe
 	}
 	return ""
 }
 
-func (x *RecipeStep) GetConditionExpression() string {
+func (x *RecipeRevision) GetRevisionNumber() uint32 {
 	if x != nil {
-		return x.ConditionExpression
+		return x.RevisionNumber
 	}
-	return ""
+	return 0
 }
 
-func (x *RecipeStep) GetId() string {
+type RecipeFieldChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Field         string                 `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	From          string                 `protobuf:"bytes,2,opt,name=from,proto3" json:"from,omitempty"`
+	To            string                 `protobuf:"bytes,3,opt,name=to,proto3" json:"to,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecipeFieldChange) Reset() {
+	*x = RecipeFieldChange{}
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecipeFieldChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecipeFieldChange) ProtoMessage() {}
+
+func (x *RecipeFieldChange) ProtoReflect() protoreflect.Message {
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[24]
 	if x != nil {
-		return x.Id
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *RecipeStep) GetNotes() string {
+// Deprecated: Use RecipeFieldChange.ProtoReflect.Descriptor instead.
+func (*RecipeFieldChange) Descriptor() ([]byte, []int) {
+	return file_mealplanning_mealplanning_messages_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *RecipeFieldChange) GetField() string {
 	if x != nil {
-		return x.Notes
+		return x.Field
 	}
 	return ""
 }
 
-func (x *RecipeStep) GetExplicitInstructions() string {
+func (x *RecipeFieldChange) GetFrom() string {
 	if x != nil {
-		return x.ExplicitInstructions
+		return x.From
 	}
 	return ""
 }
 
-func (x *RecipeStep) GetMedia() []*RecipeMedia {
+func (x *RecipeFieldChange) GetTo() string {
 	if x != nil {
-		return x.Media
+		return x.To
 	}
-	return nil
+	return ""
 }
 
-func (x *RecipeStep) GetProducts() []*RecipeStepProduct {
-	if x != nil {
-		return x.Products
-	}
-	return nil
+type RecipeComponentDiff struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Change        string                 `protobuf:"bytes,3,opt,name=change,proto3" json:"change,omitempty"`
+	Changes       []*RecipeFieldChange   `protobuf:"bytes,4,rep,name=changes,proto3" json:"changes,omitempty"`
+	Index         uint32                 `protobuf:"varint,5,opt,name=index,proto3" json:"index,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RecipeStep) GetInstruments() []*RecipeStepInstrument {
-	if x != nil {
-		return x.Instruments
-	}
-	return nil
+func (x *RecipeComponentDiff) Reset() {
+	*x = RecipeComponentDiff{}
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *RecipeStep) GetVessels() []*RecipeStepVessel {
-	if x != nil {
-		return x.Vessels
-	}
-	return nil
+func (x *RecipeComponentDiff) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *RecipeStep) GetCompletionConditions() []*RecipeStepCompletionCondition {
+func (*RecipeComponentDiff) ProtoMessage() {}
+
+func (x *RecipeComponentDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[25]
 	if x != nil {
-		return x.CompletionConditions
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *RecipeStep) GetIngredients() []*RecipeStepIngredient {
-	if x != nil {
-		return x.Ingredients
-	}
-	return nil
+// Deprecated: Use RecipeComponentDiff.ProtoReflect.Descriptor instead.
+func (*RecipeComponentDiff) Descriptor() ([]byte, []int) {
+	return file_mealplanning_mealplanning_messages_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *RecipeStep) GetPreparation() *ValidPreparation {
+func (x *RecipeComponentDiff) GetId() string {
 	if x != nil {
-		return x.Preparation
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *RecipeStep) GetIndex() uint32 {
+func (x *RecipeComponentDiff) GetName() string {
 	if x != nil {
-		return x.Index
+		return x.Name
 	}
-	return 0
+	return ""
 }
 
-func (x *RecipeStep) GetOptional() bool {
+func (x *RecipeComponentDiff) GetChange() string {
 	if x != nil {
-		return x.Optional
+		return x.Change
 	}
-	return false
+	return ""
 }
 
-func (x *RecipeStep) GetStartTimerAutomatically() bool {
+func (x *RecipeComponentDiff) GetChanges() []*RecipeFieldChange {
 	if x != nil {
-		return x.StartTimerAutomatically
+		return x.Changes
 	}
-	return false
+	return nil
 }
 
-func (x *RecipeStep) GetStepImages() []*uploaded_media.UploadedMedia {
+func (x *RecipeComponentDiff) GetIndex() uint32 {
 	if x != nil {
-		return x.StepImages
+		return x.Index
 	}
-	return nil
+	return 0
 }
 
-type RecipeStepCompletionCondition struct {
-	state               protoimpl.MessageState                     `protogen:"open.v1"`
-	CreatedAt           *timestamppb.Timestamp                     `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	ArchivedAt          *timestamppb.Timestamp                     `protobuf:"bytes,2,opt,name=archived_at,json=archivedAt,proto3,oneof" json:"archived_at,omitempty"`
-	LastUpdatedAt       *timestamppb.Timestamp                     `protobuf:"bytes,3,opt,name=last_updated_at,json=lastUpdatedAt,proto3,oneof" json:"last_updated_at,omitempty"`
-	IngredientState     *ValidIngredientState                      `protobuf:"bytes,4,opt,name=ingredient_state,json=ingredientState,proto3" json:"ingredient_state,omitempty"`
-	Id                  string                                     `protobuf:"bytes,5,opt,name=id,proto3" json:"id,omitempty"`
-	BelongsToRecipeStep string                                     `protobuf:"bytes,6,opt,name=belongs_to_recipe_step,json=belongsToRecipeStep,proto3" json:"belongs_to_recipe_step,omitempty"`
-	Notes               string                                     `protobuf:"bytes,7,opt,name=notes,proto3" json:"notes,omitempty"`
-	Ingredients         []*RecipeStepCompletionConditionIngredient `protobuf:"bytes,8,rep,name=ingredients,proto3" json:"ingredients,omitempty"`
-	Optional            bool                                       `protobuf:"varint,9,opt,name=optional,proto3" json:"optional,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+type RecipeStepDiff struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	StepId        string                 `protobuf:"bytes,1,opt,name=step_id,json=stepId,proto3" json:"step_id,omitempty"`
+	Change        string                 `protobuf:"bytes,2,opt,name=change,proto3" json:"change,omitempty"`
+	Changes       []*RecipeFieldChange   `protobuf:"bytes,3,rep,name=changes,proto3" json:"changes,omitempty"`
+	Ingredients   []*RecipeComponentDiff `protobuf:"bytes,4,rep,name=ingredients,proto3" json:"ingredients,omitempty"`
+	Instruments   []*RecipeComponentDiff `protobuf:"bytes,5,rep,name=instruments,proto3" json:"instruments,omitempty"`
+	Vessels       []*RecipeComponentDiff `protobuf:"bytes,6,rep,name=vessels,proto3" json:"vessels,omitempty"`
+	Products      []*RecipeComponentDiff `protobuf:"bytes,7,rep,name=products,proto3" json:"products,omitempty"`
+	Index         uint32                 `protobuf:"varint,8,opt,name=index,proto3" json:"index,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RecipeStepCompletionCondition) Reset() {
-	*x = RecipeStepCompletionCondition{}
-	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[24]
+func (x *RecipeStepDiff) Reset() {
+	*x = RecipeStepDiff{}
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RecipeStepCompletionCondition) String() string {
+func (x *RecipeStepDiff) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecipeStepCompletionCondition) ProtoMessage() {}
+func (*RecipeStepDiff) ProtoMessage() {}
 
-func (x *RecipeStepCompletionCondition) ProtoReflect() protoreflect.Message {
-	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[24]
+func (x *RecipeStepDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3832,101 +3900,93 @@ func (x *RecipeStepCompletionCondition) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecipeStepCompletionCondition.ProtoReflect.Descriptor instead.
-func (*RecipeStepCompletionCondition) Descriptor() ([]byte, []int) {
-	return file_mealplanning_mealplanning_messages_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use RecipeStepDiff.ProtoReflect.Descriptor instead.
+func (*RecipeStepDiff) Descriptor() ([]byte, []int) {
+	return file_mealplanning_mealplanning_messages_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *RecipeStepCompletionCondition) GetCreatedAt() *timestamppb.Timestamp {
+func (x *RecipeStepDiff) GetStepId() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.StepId
 	}
-	return nil
+	return ""
 }
 
-func (x *RecipeStepCompletionCondition) GetArchivedAt() *timestamppb.Timestamp {
+func (x *RecipeStepDiff) GetChange() string {
 	if x != nil {
-		return x.ArchivedAt
+		return x.Change
 	}
-	return nil
+	return ""
 }
 
-func (x *RecipeStepCompletionCondition) GetLastUpdatedAt() *timestamppb.Timestamp {
+func (x *RecipeStepDiff) GetChanges() []*RecipeFieldChange {
 	if x != nil {
-		return x.LastUpdatedAt
+		return x.Changes
 	}
 	return nil
 }
 
-func (x *RecipeStepCompletionCondition) GetIngredientState() *ValidIngredientState {
+func (x *RecipeStepDiff) GetIngredients() []*RecipeComponentDiff {
 	if x != nil {
-		return x.IngredientState
+		return x.Ingredients
 	}
 	return nil
 }
 
-func (x *RecipeStepCompletionCondition) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *RecipeStepCompletionCondition) GetBelongsToRecipeStep() string {
+func (x *RecipeStepDiff) GetInstruments() []*RecipeComponentDiff {
 	if x != nil {
-		return x.BelongsToRecipeStep
+		return x.Instruments
 	}
-	return ""
+	return nil
 }
 
-func (x *RecipeStepCompletionCondition) GetNotes() string {
+func (x *RecipeStepDiff) GetVessels() []*RecipeComponentDiff {
 	if x != nil {
-		return x.Notes
+		return x.Vessels
 	}
-	return ""
+	return nil
 }
 
-func (x *RecipeStepCompletionCondition) GetIngredients() []*RecipeStepCompletionConditionIngredient {
+func (x *RecipeStepDiff) GetProducts() []*RecipeComponentDiff {
 	if x != nil {
-		return x.Ingredients
+		return x.Products
 	}
 	return nil
 }
 
-func (x *RecipeStepCompletionCondition) GetOptional() bool {
+func (x *RecipeStepDiff) GetIndex() uint32 {
 	if x != nil {
-		return x.Optional
+		return x.Index
 	}
-	return false
+	return 0
 }
 
-type RecipeStepCompletionConditionIngredient struct {
-	state                                  protoimpl.MessageState `protogen:"open.v1"`
-	CreatedAt                              *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	ArchivedAt                             *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=archived_at,json=archivedAt,proto3,oneof" json:"archived_at,omitempty"`
-	LastUpdatedAt                          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_updated_at,json=lastUpdatedAt,proto3,oneof" json:"last_updated_at,omitempty"`
-	Id                                     string                 `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
-	BelongsToRecipeStepCompletionCondition string                 `protobuf:"bytes,5,opt,name=belongs_to_recipe_step_completion_condition,json=belongsToRecipeStepCompletionCondition,proto3" json:"belongs_to_recipe_step_completion_condition,omitempty"`
-	RecipeStepIngredient                   string                 `protobuf:"bytes,6,opt,name=recipe_step_ingredient,json=recipeStepIngredient,proto3" json:"recipe_step_ingredient,omitempty"`
-	unknownFields                          protoimpl.UnknownFields
-	sizeCache                              protoimpl.SizeCache
+type RecipeRevisionDiff struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RecipeId      string                 `protobuf:"bytes,1,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"`
+	Changes       []*RecipeFieldChange   `protobuf:"bytes,2,rep,name=changes,proto3" json:"changes,omitempty"`
+	Steps         []*RecipeStepDiff      `protobuf:"bytes,3,rep,name=steps,proto3" json:"steps,omitempty"`
+	FromRevision  uint32                 `protobuf:"varint,4,opt,name=from_revision,json=fromRevision,proto3" json:"from_revision,omitempty"`
+	ToRevision    uint32                 `protobuf:"varint,5,opt,name=to_revision,json=toRevision,proto3" json:"to_revision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RecipeStepCompletionConditionIngredient) Reset() {
-	*x = RecipeStepCompletionConditionIngredient{}
-	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[25]
+func (x *RecipeRevisionDiff) Reset() {
+	*x = RecipeRevisionDiff{}
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RecipeStepCompletionConditionIngredient) String() string {
+func (x *RecipeRevisionDiff) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecipeStepCompletionConditionIngredient) ProtoMessage() {}
+func (*RecipeRevisionDiff) ProtoMessage() {}
 
-func (x *RecipeStepCompletionConditionIngredient) ProtoReflect() protoreflect.Message {
-	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[25]
+func (x *RecipeRevisionDiff) ProtoReflect() protoreflect.Message {
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3937,95 +3997,90 @@ func (x *RecipeStepCompletionConditionIngredient) ProtoReflect() protoreflect.Me
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecipeStepCompletionConditionIngredient.ProtoReflect.Descriptor instead.
-func (*RecipeStepCompletionConditionIngredient) Descriptor() ([]byte, []int) {
-	return file_mealplanning_mealplanning_messages_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use RecipeRevisionDiff.ProtoReflect.Descriptor instead.
+func (*RecipeRevisionDiff) Descriptor() ([]byte, []int) {
+	return file_mealplanning_mealplanning_messages_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *RecipeStepCompletionConditionIngredient) GetCreatedAt() *timestamppb.Timestamp {
+func (x *RecipeRevisionDiff) GetRecipeId() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.RecipeId
 	}
-	return nil
+	return ""
 }
 
-func (x *RecipeStepCompletionConditionIngredient) GetArchivedAt() *timestamppb.Timestamp {
+func (x *RecipeRevisionDiff) GetChanges() []*RecipeFieldChange {
 	if x != nil {
-		return x.ArchivedAt
+		return x.Changes
 	}
 	return nil
 }
 
-func (x *RecipeStepCompletionConditionIngredient) GetLastUpdatedAt() *timestamppb.Timestamp {
+func (x *RecipeRevisionDiff) GetSteps() []*RecipeStepDiff {
 	if x != nil {
-		return x.LastUpdatedAt
+		return x.Steps
 	}
 	return nil
 }
 
-func (x *RecipeStepCompletionConditionIngredient) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
-}
-
-func (x *RecipeStepCompletionConditionIngredient) GetBelongsToRecipeStepCompletionCondition() string {
+func (x *RecipeRevisionDiff) GetFromRevision() uint32 {
 	if x != nil {
-		return x.BelongsToRecipeStepCompletionCondition
+		return x.FromRevision
 	}
-	return ""
+	return 0
 }
 
-func (x *RecipeStepCompletionConditionIngredient) GetRecipeStepIngredient() string {
+func (x *RecipeRevisionDiff) GetToRevision() uint32 {
 	if x != nil {
-		return x.RecipeStepIngredient
+		return x.ToRevision
 	}
-	return ""
+	return 0
 }
 
-type RecipeStepIngredient struct {
-	state                     protoimpl.MessageState `protogen:"open.v1"`
-	CreatedAt                 *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	RecipeStepProductRecipeId *string                `protobuf:"bytes,2,opt,name=recipe_step_product_recipe_id,json=recipeStepProductRecipeId,proto3,oneof" json:"recipe_step_product_recipe_id,omitempty"`
-	ArchivedAt                *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=archived_at,json=archivedAt,proto3,oneof" json:"archived_at,omitempty"`
-	Ingredient                *ValidIngredient       `protobuf:"bytes,4,opt,name=ingredient,proto3" json:"ingredient,omitempty"`
-	LastUpdatedAt             *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_updated_at,json=lastUpdatedAt,proto3,oneof" json:"last_updated_at,omitempty"`
-	VesselIndex               *uint32                `protobuf:"varint,6,opt,name=vessel_index,json=vesselIndex,proto3,oneof" json:"vessel_index,omitempty"`
-	ProductPercentageToUse    *float32               `protobuf:"fixed32,7,opt,name=product_percentage_to_use,json=productPercentageToUse,proto3,oneof" json:"product_percentage_to_use,omitempty"`
-	RecipeStepProductId       *string                `protobuf:"bytes,8,opt,name=recipe_step_product_id,json=recipeStepProductId,proto3,oneof" json:"recipe_step_product_id,omitempty"`
-	BelongsToRecipeStep       string                 `protobuf:"bytes,9,opt,name=belongs_to_recipe_step,json=belongsToRecipeStep,proto3" json:"belongs_to_recipe_step,omitempty"`
-	Id                        string                 `protobuf:"bytes,10,opt,name=id,proto3" json:"id,omitempty"`
-	QuantityNotes             string                 `protobuf:"bytes,11,opt,name=quantity_notes,json=quantityNotes,proto3" json:"quantity_notes,omitempty"`
-	IngredientNotes           string                 `protobuf:"bytes,12,opt,name=ingredient_notes,json=ingredientNotes,proto3" json:"ingredient_notes,omitempty"`
-	Name                      string                 `protobuf:"bytes,13,opt,name=name,proto3" json:"name,omitempty"`
-	MinQuantity               float32                `protobuf:"fixed32,14,opt,name=min_quantity,json=minQuantity,proto3" json:"min_quantity,omitempty"`
-	MaxQuantity               *float32               `protobuf:"fixed32,21,opt,name=max_quantity,json=maxQuantity,proto3,oneof" json:"max_quantity,omitempty"`
-	MeasurementUnit           *ValidMeasurementUnit  `protobuf:"bytes,15,opt,name=measurement_unit,json=measurementUnit,proto3" json:"measurement_unit,omitempty"`
-	OptionIndex               uint32                 `protobuf:"varint,16,opt,name=option_index,json=optionIndex,proto3" json:"option_index,omitempty"`
-	Optional                  bool                   `protobuf:"varint,17,opt,name=optional,proto3" json:"optional,omitempty"`
-	ToTaste                   bool                   `protobuf:"varint,18,opt,name=to_taste,json=toTaste,proto3" json:"to_taste,omitempty"`
-	Index                     uint32                 `protobuf:"varint,19,opt,name=index,proto3" json:"index,omitempty"`
-	ScaleFactor               float32                `protobuf:"fixed32,20,opt,name=scale_factor,json=scaleFactor,proto3" json:"scale_factor,omitempty"`
+type RecipeStep struct {
+	state                     protoimpl.MessageState           `protogen:"open.v1"`
+	CreatedAt                 *timestamppb.Timestamp           `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	MinEstimatedTimeInSeconds *uint32                          `protobuf:"varint,2,opt,name=min_estimated_time_in_seconds,json=minEstimatedTimeInSeconds,proto3,oneof" json:"min_estimated_time_in_seconds,omitempty"`
+	MaxEstimatedTimeInSeconds *uint32                          `protobuf:"varint,22,opt,name=max_estimated_time_in_seconds,json=maxEstimatedTimeInSeconds,proto3,oneof" json:"max_estimated_time_in_seconds,omitempty"`
+	MinTemperatureInCelsius   *float32                         `protobuf:"fixed32,3,opt,name=min_temperature_in_celsius,json=minTemperatureInCelsius,proto3,oneof" json:"min_temperature_in_celsius,omitempty"`
+	MaxTemperatureInCelsius   *float32                         `protobuf:"fixed32,23,opt,name=max_temperature_in_celsius,json=maxTemperatureInCelsius,proto3,oneof" json:"max_temperature_in_celsius,omitempty"`
+	ArchivedAt                *timestamppb.Timestamp           `protobuf:"bytes,4,opt,name=archived_at,json=archivedAt,proto3,oneof" json:"archived_at,omitempty"`
+	LastUpdatedAt             *timestamppb.Timestamp           `protobuf:"bytes,5,opt,name=last_updated_at,json=lastUpdatedAt,proto3,oneof" json:"last_updated_at,omitempty"`
+	BelongsToRecipe           string                           `protobuf:"bytes,6,opt,name=belongs_to_recipe,json=belongsToRecipe,proto3" json:"belongs_to_recipe,omitempty"`
+	ConditionExpression       string                           `protobuf:"bytes,7,opt,name=condition_expression,json=conditionExpression,proto3" json:"condition_expression,omitempty"`
+	Id                        string                           `protobuf:"bytes,8,opt,name=id,proto3" json:"id,omitempty"`
+	Notes                     string                           `protobuf:"bytes,9,opt,name=notes,proto3" json:"notes,omitempty"`
+	ExplicitInstructions      string                           `protobuf:"bytes,10,opt,name=explicit_instructions,json=explicitInstructions,proto3" json:"explicit_instructions,omitempty"`
+	Media                     []*RecipeMedia                   `protobuf:"bytes,11,rep,name=media,proto3" json:"media,omitempty"`
+	Products                  []*RecipeStepProduct             `protobuf:"bytes,12,rep,name=products,proto3" json:"products,omitempty"`
+	Instruments               []*RecipeStepInstrument          `protobuf:"bytes,13,rep,name=instruments,proto3" json:"instruments,omitempty"`
+	Vessels                   []*RecipeStepVessel              `protobuf:"bytes,14,rep,name=vessels,proto3" json:"vessels,omitempty"`
+	CompletionConditions      []*RecipeStepCompletionCondition `protobuf:"bytes,15,rep,name=completion_conditions,json=completionConditions,proto3" json:"completion_conditions,omitempty"`
+	Ingredients               []*RecipeStepIngredient          `protobuf:"bytes,16,rep,name=ingredients,proto3" json:"ingredients,omitempty"`
+	Preparation               *ValidPreparation                `protobuf:"bytes,17,opt,name=preparation,proto3" json:"preparation,omitempty"`
+	Index                     uint32                           `protobuf:"varint,18,opt,name=index,proto3" json:"index,omitempty"`
+	Optional                  bool                             `protobuf:"varint,19,opt,name=optional,proto3" json:"optional,omitempty"`
+	StartTimerAutomatically   bool                             `protobuf:"varint,20,opt,name=start_timer_automatically,json=startTimerAutomatically,proto3" json:"start_timer_automatically,omitempty"`
+	StepImages                []*uploaded_media.UploadedMedia  `protobuf:"bytes,21,rep,name=step_images,json=stepImages,proto3" json:"step_images,omitempty"`
 	unknownFields             protoimpl.UnknownFields
 	sizeCache                 protoimpl.SizeCache
 }
 
-func (x *RecipeStepIngredient) Reset() {
-	*x = RecipeStepIngredient{}
-	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[26]
+func (x *RecipeStep) Reset() {
+	*x = RecipeStep{}
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RecipeStepIngredient) String() string {
+func (x *RecipeStep) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecipeStepIngredient) ProtoMessage() {}
+func (*RecipeStep) ProtoMessage() {}
 
-func (x *RecipeStepIngredient) ProtoReflect() protoreflect.Message {
-	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[26]
+func (x *RecipeStep) ProtoReflect() protoreflect.Message {
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4036,195 +4091,202 @@ func (x *RecipeStepIngredient) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecipeStepIngredient.ProtoReflect.Descriptor instead.
-func (*RecipeStepIngredient) Descriptor() ([]byte, []int) {
-	return file_mealplanning_mealplanning_messages_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use RecipeStep.ProtoReflect.Descriptor instead.
+func (*RecipeStep) Descriptor() ([]byte, []int) {
+	return file_mealplanning_mealplanning_messages_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *RecipeStepIngredient) GetCreatedAt() *timestamppb.Timestamp {
+func (x *RecipeStep) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return nil
 }
 
-func (x *RecipeStepIngredient) GetRecipeStepProductRecipeId() string {
-	if x != nil && x.RecipeStepProductRecipeId != nil {
-		return *x.RecipeStepProductRecipeId
+func (x *RecipeStep) GetMinEstimatedTimeInSeconds() uint32 {
+	if x != nil && x.MinEstimatedTimeInSeconds != nil {
+		return *x.MinEstimatedTimeInSeconds
 	}
-	return ""
+	return 0
 }
 
-func (x *RecipeStepIngredient) GetArchivedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.ArchivedAt
+func (x *RecipeStep) GetMaxEstimatedTimeInSeconds() uint32 {
+	if x != nil && x.MaxEstimatedTimeInSeconds != nil {
+		return *x.MaxEstimatedTimeInSeconds
 	}
-	return nil
+	return 0
 }
 
-func (x *RecipeStepIngredient) GetIngredient() *ValidIngredient {
-	if x != nil {
-		return x.Ingredient
+func (x *RecipeStep) GetMinTemperatureInCelsius() float32 {
+	if x != nil && x.MinTemperatureInCelsius != nil {
+		return *x.MinTemperatureInCelsius
 	}
-	return nil
+	return 0
 }
 
-func (x *RecipeStepIngredient) GetLastUpdatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.LastUpdatedAt
+func (x *RecipeStep) GetMaxTemperatureInCelsius() float32 {
+	if x != nil && x.MaxTemperatureInCelsius != nil {
+		return *x.MaxTemperatureInCelsius
 	}
-	return nil
+	return 0
 }
 
-func (x *RecipeStepIngredient) GetVesselIndex() uint32 {
-	if x != nil && x.VesselIndex != nil {
-		return *x.VesselIndex
+func (x *RecipeStep) GetArchivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArchivedAt
 	}
-	return 0
+	return nil
 }
 
-func (x *RecipeStepIngredient) GetProductPercentageToUse() float32 {
-	if x != nil && x.ProductPercentageToUse != nil {
-		return *x.ProductPercentageToUse
+func (x *RecipeStep) GetLastUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUpdatedAt
 	}
-	return 0
+	return nil
 }
 
-func (x *RecipeStepIngredient) GetRecipeStepProductId() string {
-	if x != nil && x.RecipeStepProductId != nil {
-		return *x.RecipeStepProductId
+func (x *RecipeStep) GetBelongsToRecipe() string {
+	if x != nil {
+		return x.BelongsToRecipe
 	}
 	return ""
 }
 
-func (x *RecipeStepIngredient) GetBelongsToRecipeStep() string {
+func (x *RecipeStep) GetConditionExpression() string {
 	if x != nil {
-		return x.BelongsToRecipeStep
+		return x.ConditionExpression
 	}
 	return ""
 }
 
-func (x *RecipeStepIngredient) GetId() string {
+func (x *RecipeStep) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *RecipeStepIngredient) GetQuantityNotes() string {
+func (x *RecipeStep) GetNotes() string {
 	if x != nil {
-		return x.QuantityNotes
+		return x.Notes
 	}
 	return ""
 }
 
-func (x *RecipeStepIngredient) GetIngredientNotes() string {
+func (x *RecipeStep) GetExplicitInstructions() string {
 	if x != nil {
-		return x.IngredientNotes
+		return x.ExplicitInstructions
 	}
 	return ""
 }
 
-func (x *RecipeStepIngredient) GetName() string {
+func (x *RecipeStep) GetMedia() []*RecipeMedia {
 	if x != nil {
-		return x.Name
+		return x.Media
 	}
-	return ""
+	return nil
 }
 
-func (x *RecipeStepIngredient) GetMinQuantity() float32 {
+func (x *RecipeStep) GetProducts() []*RecipeStepProduct {
 	if x != nil {
-		return x.MinQuantity
+		return x.Products
 	}
-	return 0
+	return nil
 }
 
-func (x *RecipeStepIngredient) GetMaxQuantity() float32 {
-	if x != nil && x.MaxQuantity != nil {
-		return *x.MaxQuantity
+func (x *RecipeStep) GetInstruments() []*RecipeStepInstrument {
+	if x != nil {
+		return x.Instruments
 	}
-	return 0
+	return nil
 }
 
-func (x *RecipeStepIngredient) GetMeasurementUnit() *ValidMeasurementUnit {
+func (x *RecipeStep) GetVessels() []*RecipeStepVessel {
 	if x != nil {
-		return x.MeasurementUnit
+		return x.Vessels
 	}
 	return nil
 }
 
-func (x *RecipeStepIngredient) GetOptionIndex() uint32 {
+func (x *RecipeStep) GetCompletionConditions() []*RecipeStepCompletionCondition {
 	if x != nil {
-		return x.OptionIndex
+		return x.CompletionConditions
 	}
-	return 0
+	return nil
 }
 
-func (x *RecipeStepIngredient) GetOptional() bool {
+func (x *RecipeStep) GetIngredients() []*RecipeStepIngredient {
 	if x != nil {
-		return x.Optional
+		return x.Ingredients
 	}
-	return false
+	return nil
 }
 
-func (x *RecipeStepIngredient) GetToTaste() bool {
+func (x *RecipeStep) GetPreparation() *ValidPreparation {
 	if x != nil {
-		return x.ToTaste
+		return x.Preparation
 	}
-	return false
+	return nil
 }
 
-func (x *RecipeStepIngredient) GetIndex() uint32 {
+func (x *RecipeStep) GetIndex() uint32 {
 	if x != nil {
 		return x.Index
 	}
 	return 0
 }
 
-func (x *RecipeStepIngredient) GetScaleFactor() float32 {
+func (x *RecipeStep) GetOptional() bool {
 	if x != nil {
-		return x.ScaleFactor
+		return x.Optional
 	}
-	return 0
+	return false
 }
 
-type RecipeStepInstrument struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	CreatedAt           *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Instrument          *ValidInstrument       `protobuf:"bytes,2,opt,name=instrument,proto3" json:"instrument,omitempty"`
-	LastUpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_updated_at,json=lastUpdatedAt,proto3,oneof" json:"last_updated_at,omitempty"`
-	RecipeStepProductId *string                `protobuf:"bytes,4,opt,name=recipe_step_product_id,json=recipeStepProductId,proto3,oneof" json:"recipe_step_product_id,omitempty"`
-	ArchivedAt          *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=archived_at,json=archivedAt,proto3,oneof" json:"archived_at,omitempty"`
-	Notes               string                 `protobuf:"bytes,6,opt,name=notes,proto3" json:"notes,omitempty"`
-	Name                string                 `protobuf:"bytes,7,opt,name=name,proto3" json:"name,omitempty"`
-	BelongsToRecipeStep string                 `protobuf:"bytes,8,opt,name=belongs_to_recipe_step,json=belongsToRecipeStep,proto3" json:"belongs_to_recipe_step,omitempty"`
-	Id                  string                 `protobuf:"bytes,9,opt,name=id,proto3" json:"id,omitempty"`
-	MinQuantity         uint32                 `protobuf:"varint,10,opt,name=min_quantity,json=minQuantity,proto3" json:"min_quantity,omitempty"`
-	MaxQuantity         *uint32                `protobuf:"varint,16,opt,name=max_quantity,json=maxQuantity,proto3,oneof" json:"max_quantity,omitempty"`
-	OptionIndex         uint32                 `protobuf:"varint,11,opt,name=option_index,json=optionIndex,proto3" json:"option_index,omitempty"`
-	PreferenceRank      uint32                 `protobuf:"varint,12,opt,name=preference_rank,json=preferenceRank,proto3" json:"preference_rank,omitempty"`
-	Optional            bool                   `protobuf:"varint,13,opt,name=optional,proto3" json:"optional,omitempty"`
-	Index               uint32                 `protobuf:"varint,14,opt,name=index,proto3" json:"index,omitempty"`
-	ScaleFactor         float32                `protobuf:"fixed32,15,opt,name=scale_factor,json=scaleFactor,proto3" json:"scale_factor,omitempty"`
+func (x *RecipeStep) GetStartTimerAutomatically() bool {
+	if x != nil {
+		return x.StartTimerAutomatically
+	}
+	return false
+}
+
+func (x *RecipeStep) GetStepImages() []*uploaded_media.UploadedMedia {
+	if x != nil {
+		return x.StepImages
+	}
+	return nil
+}
+
+type RecipeStepCompletionCondition struct {
+	state               protoimpl.MessageState                     `protogen:"open.v1"`
+	CreatedAt           *timestamppb.Timestamp                     `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ArchivedAt          *timestamppb.Timestamp                     `protobuf:"bytes,2,opt,name=archived_at,json=archivedAt,proto3,oneof" json:"archived_at,omitempty"`
+	LastUpdatedAt       *timestamppb.Timestamp                     `protobuf:"bytes,3,opt,name=last_updated_at,json=lastUpdatedAt,proto3,oneof" json:"last_updated_at,omitempty"`
+	IngredientState     *ValidIngredientState                      `protobuf:"bytes,4,opt,name=ingredient_state,json=ingredientState,proto3" json:"ingredient_state,omitempty"`
+	Id                  string                                     `protobuf:"bytes,5,opt,name=id,proto3" json:"id,omitempty"`
+	BelongsToRecipeStep string                                     `protobuf:"bytes,6,opt,name=belongs_to_recipe_step,json=belongsToRecipeStep,proto3" json:"belongs_to_recipe_step,omitempty"`
+	Notes               string                                     `protobuf:"bytes,7,opt,name=notes,proto3" json:"notes,omitempty"`
+	Ingredients         []*RecipeStepCompletionConditionIngredient `protobuf:"bytes,8,rep,name=ingredients,proto3" json:"ingredients,omitempty"`
+	Optional            bool                                       `protobuf:"varint,9,opt,name=optional,proto3" json:"optional,omitempty"`
 	unknownFields       protoimpl.UnknownFields
 	sizeCache           protoimpl.SizeCache
 }
 
-func (x *RecipeStepInstrument) Reset() {
-	*x = RecipeStepInstrument{}
-	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[27]
+func (x *RecipeStepCompletionCondition) Reset() {
+	*x = RecipeStepCompletionCondition{}
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RecipeStepInstrument) String() string {
+func (x *RecipeStepCompletionCondition) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecipeStepInstrument) ProtoMessage() {}
+func (*RecipeStepCompletionCondition) ProtoMessage() {}
 
-func (x *RecipeStepInstrument) ProtoReflect() protoreflect.Message {
-	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[27]
+func (x *RecipeStepCompletionCondition) ProtoReflect() protoreflect.Message {
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4235,167 +4297,101 @@ func (x *RecipeStepInstrument) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecipeStepInstrument.ProtoReflect.Descriptor instead.
-func (*RecipeStepInstrument) Descriptor() ([]byte, []int) {
-	return file_mealplanning_mealplanning_messages_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use RecipeStepCompletionCondition.ProtoReflect.Descriptor instead.
+func (*RecipeStepCompletionCondition) Descriptor() ([]byte, []int) {
+	return file_mealplanning_mealplanning_messages_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *RecipeStepInstrument) GetCreatedAt() *timestamppb.Timestamp {
+func (x *RecipeStepCompletionCondition) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return nil
 }
 
-func (x *RecipeStepInstrument) GetInstrument() *ValidInstrument {
+func (x *RecipeStepCompletionCondition) GetArchivedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Instrument
+		return x.ArchivedAt
 	}
 	return nil
 }
 
-func (x *RecipeStepInstrument) GetLastUpdatedAt() *timestamppb.Timestamp {
+func (x *RecipeStepCompletionCondition) GetLastUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.LastUpdatedAt
 	}
 	return nil
 }
 
-func (x *RecipeStepInstrument) GetRecipeStepProductId() string {
-	if x != nil && x.RecipeStepProductId != nil {
-		return *x.RecipeStepProductId
-	}
-	return ""
-}
-
-func (x *RecipeStepInstrument) GetArchivedAt() *timestamppb.Timestamp {
+func (x *RecipeStepCompletionCondition) GetIngredientState() *ValidIngredientState {
 	if x != nil {
-		return x.ArchivedAt
+		return x.IngredientState
 	}
 	return nil
 }
 
-func (x *RecipeStepInstrument) GetNotes() string {
-	if x != nil {
-		return x.Notes
-	}
-	return ""
-}
-
-func (x *RecipeStepInstrument) GetName() string {
+func (x *RecipeStepCompletionCondition) GetId() string {
 	if x != nil {
-		return x.Name
+		return x.Id
 	}
 	return ""
 }
 
-func (x *RecipeStepInstrument) GetBelongsToRecipeStep() string {
+func (x *RecipeStepCompletionCondition) GetBelongsToRecipeStep() string {
 	if x != nil {
 		return x.BelongsToRecipeStep
 	}
 	return ""
 }
 
-func (x *RecipeStepInstrument) GetId() string {
+func (x *RecipeStepCompletionCondition) GetNotes() string {
 	if x != nil {
-		return x.Id
+		return x.Notes
 	}
 	return ""
 }
 
-func (x *RecipeStepInstrument) GetMinQuantity() uint32 {
-	if x != nil {
-		return x.MinQuantity
-	}
-	return 0
-}
-
-func (x *RecipeStepInstrument) GetMaxQuantity() uint32 {
-	if x != nil && x.MaxQuantity != nil {
-		return *x.MaxQuantity
-	}
-	return 0
-}
-
-func (x *RecipeStepInstrument) GetOptionIndex() uint32 {
-	if x != nil {
-		return x.OptionIndex
-	}
-	return 0
-}
-
-func (x *RecipeStepInstrument) GetPreferenceRank() uint32 {
+func (x *RecipeStepCompletionCondition) GetIngredients() []*RecipeStepCompletionConditionIngredient {
 	if x != nil {
-		return x.PreferenceRank
+		return x.Ingredients
 	}
-	return 0
+	return nil
 }
 
-func (x *RecipeStepInstrument) GetOptional() bool {
+func (x *RecipeStepCompletionCondition) GetOptional() bool {
 	if x != nil {
 		return x.Optional
 	}
 	return false
 }
 
-func (x *RecipeStepInstrument) GetIndex() uint32 {
-	if x != nil {
-		return x.Index
-	}
-	return 0
-}
-
-func (x *RecipeStepInstrument) GetScaleFactor() float32 {
-	if x != nil {
-		return x.ScaleFactor
-	}
-	return 0
-}
-
-type RecipeStepProduct struct {
-	state                          protoimpl.MessageState `protogen:"open.v1"`
-	CreatedAt                      *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	MinStorageTemperatureInCelsius *float32               `protobuf:"fixed32,2,opt,name=min_storage_temperature_in_celsius,json=minStorageTemperatureInCelsius,proto3,oneof" json:"min_storage_temperature_in_celsius,omitempty"`
-	MaxStorageTemperatureInCelsius *float32               `protobuf:"fixed32,20,opt,name=max_storage_temperature_in_celsius,json=maxStorageTemperatureInCelsius,proto3,oneof" json:"max_storage_temperature_in_celsius,omitempty"`
-	MinStorageDurationInSeconds    *uint32                `protobuf:"varint,3,opt,name=min_storage_duration_in_seconds,json=minStorageDurationInSeconds,proto3,oneof" json:"min_storage_duration_in_seconds,omitempty"`
-	MaxStorageDurationInSeconds    *uint32                `protobuf:"varint,21,opt,name=max_storage_duration_in_seconds,json=maxStorageDurationInSeconds,proto3,oneof" json:"max_storage_duration_in_seconds,omitempty"`
-	MinMeasurementQuantity         *float32               `protobuf:"fixed32,4,opt,name=min_measurement_quantity,json=minMeasurementQuantity,proto3,oneof" json:"min_measurement_quantity,omitempty"`
-	MaxMeasurementQuantity         *float32               `protobuf:"fixed32,22,opt,name=max_measurement_quantity,json=maxMeasurementQuantity,proto3,oneof" json:"max_measurement_quantity,omitempty"`
-	MinItemQuantity                *float32               `protobuf:"fixed32,5,opt,name=min_item_quantity,json=minItemQuantity,proto3,oneof" json:"min_item_quantity,omitempty"`
-	MaxItemQuantity                *float32               `protobuf:"fixed32,23,opt,name=max_item_quantity,json=maxItemQuantity,proto3,oneof" json:"max_item_quantity,omitempty"`
-	ArchivedAt                     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=archived_at,json=archivedAt,proto3,oneof" json:"archived_at,omitempty"`
-	LastUpdatedAt                  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_updated_at,json=lastUpdatedAt,proto3,oneof" json:"last_updated_at,omitempty"`
-	MeasurementUnit                *ValidMeasurementUnit  `protobuf:"bytes,8,opt,name=measurement_unit,json=measurementUnit,proto3" json:"measurement_unit,omitempty"`
-	ContainedInVesselIndex         *uint32                `protobuf:"varint,9,opt,name=contained_in_vessel_index,json=containedInVesselIndex,proto3,oneof" json:"contained_in_vessel_index,omitempty"`
-	Name                           string                 `protobuf:"bytes,10,opt,name=name,proto3" json:"name,omitempty"`
-	BelongsToRecipeStep            string                 `protobuf:"bytes,11,opt,name=belongs_to_recipe_step,json=belongsToRecipeStep,proto3" json:"belongs_to_recipe_step,omitempty"`
-	Type                           RecipeStepProductType  `protobuf:"varint,12,opt,name=type,proto3,enum=mealplanning.RecipeStepProductType" json:"type,omitempty"`
-	Id                             string                 `protobuf:"bytes,13,opt,name=id,proto3" json:"id,omitempty"`
-	StorageInstructions            string                 `protobuf:"bytes,14,opt,name=storage_instructions,json=storageInstructions,proto3" json:"storage_instructions,omitempty"`
-	QuantityNotes                  string                 `protobuf:"bytes,15,opt,name=quantity_notes,json=quantityNotes,proto3" json:"quantity_notes,omitempty"`
-	Index                          uint32                 `protobuf:"varint,16,opt,name=index,proto3" json:"index,omitempty"`
-	IsWaste                        bool                   `protobuf:"varint,17,opt,name=is_waste,json=isWaste,proto3" json:"is_waste,omitempty"`
-	IsLiquid                       bool                   `protobuf:"varint,18,opt,name=is_liquid,json=isLiquid,proto3" json:"is_liquid,omitempty"`
-	Compostable                    bool                   `protobuf:"varint,19,opt,name=compostable,proto3" json:"compostable,omitempty"`
-	unknownFields                  protoimpl.UnknownFields
-	sizeCache                      protoimpl.SizeCache
+type RecipeStepCompletionConditionIngredient struct {
+	state                                  protoimpl.MessageState `protogen:"open.v1"`
+	CreatedAt                              *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ArchivedAt                             *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=archived_at,json=archivedAt,proto3,oneof" json:"archived_at,omitempty"`
+	LastUpdatedAt                          *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_updated_at,json=lastUpdatedAt,proto3,oneof" json:"last_updated_at,omitempty"`
+	Id                                     string                 `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
+	BelongsToRecipeStepCompletionCondition string                 `protobuf:"bytes,5,opt,name=belongs_to_recipe_step_completion_condition,json=belongsToRecipeStepCompletionCondition,proto3" json:"belongs_to_recipe_step_completion_condition,omitempty"`
+	RecipeStepIngredient                   string                 `protobuf:"bytes,6,opt,name=recipe_step_ingredient,json=recipeStepIngredient,proto3" json:"recipe_step_ingredient,omitempty"`
+	unknownFields                          protoimpl.UnknownFields
+	sizeCache                              protoimpl.SizeCache
 }
 
-func (x *RecipeStepProduct) Reset() {
-	*x = RecipeStepProduct{}
-	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[28]
+func (x *RecipeStepCompletionConditionIngredient) Reset() {
+	*x = RecipeStepCompletionConditionIngredient{}
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RecipeStepProduct) String() string {
+func (x *RecipeStepCompletionConditionIngredient) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecipeStepProduct) ProtoMessage() {}
+func (*RecipeStepCompletionConditionIngredient) ProtoMessage() {}
 
-func (x *RecipeStepProduct) ProtoReflect() protoreflect.Message {
-	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[28]
+func (x *RecipeStepCompletionConditionIngredient) ProtoReflect() protoreflect.Message {
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4406,209 +4402,294 @@ func (x *RecipeStepProduct) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecipeStepProduct.ProtoReflect.Descriptor instead.
-func (*RecipeStepProduct) Descriptor() ([]byte, []int) {
-	return file_mealplanning_mealplanning_messages_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use RecipeStepCompletionConditionIngredient.ProtoReflect.Descriptor instead.
+func (*RecipeStepCompletionConditionIngredient) Descriptor() ([]byte, []int) {
+	return file_mealplanning_mealplanning_messages_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *RecipeStepProduct) GetCreatedAt() *timestamppb.Timestamp {
+func (x *RecipeStepCompletionConditionIngredient) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return nil
 }
 
-func (x *RecipeStepProduct) GetMinStorageTemperatureInCelsius() float32 {
-	if x != nil && x.MinStorageTemperatureInCelsius != nil {
-		return *x.MinStorageTemperatureInCelsius
+func (x *RecipeStepCompletionConditionIngredient) GetArchivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArchivedAt
 	}
-	return 0
+	return nil
 }
 
-func (x *RecipeStepProduct) GetMaxStorageTemperatureInCelsius() float32 {
-	if x != nil && x.MaxStorageTemperatureInCelsius != nil {
-		return *x.MaxStorageTemperatureInCelsius
+func (x *RecipeStepCompletionConditionIngredient) GetLastUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUpdatedAt
 	}
-	return 0
+	return nil
 }
 
-func (x *RecipeStepProduct) GetMinStorageDurationInSeconds() uint32 {
-	if x != nil && x.MinStorageDurationInSeconds != nil {
-		return *x.MinStorageDurationInSeconds
+func (x *RecipeStepCompletionConditionIngredient) GetId() string {
+	if x != nil {
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-func (x *RecipeStepProduct) GetMaxStorageDurationInSeconds() uint32 {
-	if x != nil && x.MaxStorageDurationInSeconds != nil {
-		return *x.MaxStorageDurationInSeconds
+func (x *RecipeStepCompletionConditionIngredient) GetBelongsToRecipeStepCompletionCondition() string {
+	if x != nil {
+		return x.BelongsToRecipeStepCompletionCondition
 	}
-	return 0
+	return ""
 }
 
-func (x *RecipeStepProduct) GetMinMeasurementQuantity() float32 {
-	if x != nil && x.MinMeasurementQuantity != nil {
-		return *x.MinMeasurementQuantity
+func (x *RecipeStepCompletionConditionIngredient) GetRecipeStepIngredient() string {
+	if x != nil {
+		return x.RecipeStepIngredient
 	}
-	return 0
+	return ""
 }
 
-func (x *RecipeStepProduct) GetMaxMeasurementQuantity() float32 {
-	if x != nil && x.MaxMeasurementQuantity != nil {
-		return *x.MaxMeasurementQuantity
+type RecipeStepIngredient struct {
+	state                     protoimpl.MessageState `protogen:"open.v1"`
+	CreatedAt                 *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	RecipeStepProductRecipeId *string                `protobuf:"bytes,2,opt,name=recipe_step_product_recipe_id,json=recipeStepProductRecipeId,proto3,oneof" json:"recipe_step_product_recipe_id,omitempty"`
+	ArchivedAt                *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=archived_at,json=archivedAt,proto3,oneof" json:"archived_at,omitempty"`
+	Ingredient                *ValidIngredient       `protobuf:"bytes,4,opt,name=ingredient,proto3" json:"ingredient,omitempty"`
+	LastUpdatedAt             *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_updated_at,json=lastUpdatedAt,proto3,oneof" json:"last_updated_at,omitempty"`
+	VesselIndex               *uint32                `protobuf:"varint,6,opt,name=vessel_index,json=vesselIndex,proto3,oneof" json:"vessel_index,omitempty"`
+	ProductPercentageToUse    *float32               `protobuf:"fixed32,7,opt,name=product_percentage_to_use,json=productPercentageToUse,proto3,oneof" json:"product_percentage_to_use,omitempty"`
+	RecipeStepProductId       *string                `protobuf:"bytes,8,opt,name=recipe_step_product_id,json=recipeStepProductId,proto3,oneof" json:"recipe_step_product_id,omitempty"`
+	BelongsToRecipeStep       string                 `protobuf:"bytes,9,opt,name=belongs_to_recipe_step,json=belongsToRecipeStep,proto3" json:"belongs_to_recipe_step,omitempty"`
+	Id                        string                 `protobuf:"bytes,10,opt,name=id,proto3" json:"id,omitempty"`
+	QuantityNotes             string                 `protobuf:"bytes,11,opt,name=quantity_notes,json=quantityNotes,proto3" json:"quantity_notes,omitempty"`
+	IngredientNotes           string                 `protobuf:"bytes,12,opt,name=ingredient_notes,json=ingredientNotes,proto3" json:"ingredient_notes,omitempty"`
+	Name                      string                 `protobuf:"bytes,13,opt,name=name,proto3" json:"name,omitempty"`
+	MinQuantity               float32                `protobuf:"fixed32,14,opt,name=min_quantity,json=minQuantity,proto3" json:"min_quantity,omitempty"`
+	MaxQuantity               *float32               `protobuf:"fixed32,21,opt,name=max_quantity,json=maxQuantity,proto3,oneof" json:"max_quantity,omitempty"`
+	MeasurementUnit           *ValidMeasurementUnit  `protobuf:"bytes,15,opt,name=measurement_unit,json=measurementUnit,proto3" json:"measurement_unit,omitempty"`
+	OptionIndex               uint32                 `protobuf:"varint,16,opt,name=option_index,json=optionIndex,proto3" json:"option_index,omitempty"`
+	Optional                  bool                   `protobuf:"varint,17,opt,name=optional,proto3" json:"optional,omitempty"`
+	ToTaste                   bool                   `protobuf:"varint,18,opt,name=to_taste,json=toTaste,proto3" json:"to_taste,omitempty"`
+	Index                     uint32                 `protobuf:"varint,19,opt,name=index,proto3" json:"index,omitempty"`
+	ScaleFactor               float32                `protobuf:"fixed32,20,opt,name=scale_factor,json=scaleFactor,proto3" json:"scale_factor,omitempty"`
+	unknownFields             protoimpl.UnknownFields
+	sizeCache                 protoimpl.SizeCache
+}
+
+func (x *RecipeStepIngredient) Reset() {
+	*x = RecipeStepIngredient{}
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecipeStepIngredient) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecipeStepIngredient) ProtoMessage() {}
+
+func (x *RecipeStepIngredient) ProtoReflect() protoreflect.Message {
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *RecipeStepProduct) GetMinItemQuantity() float32 {
-	if x != nil && x.MinItemQuantity != nil {
-		return *x.MinItemQuantity
+// Deprecated: Use RecipeStepIngredient.ProtoReflect.Descriptor instead.
+func (*RecipeStepIngredient) Descriptor() ([]byte, []int) {
+	return file_mealplanning_mealplanning_messages_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *RecipeStepIngredient) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
 	}
-	return 0
+	return nil
 }
 
-func (x *RecipeStepProduct) GetMaxItemQuantity() float32 {
-	if x != nil && x.MaxItemQuantity != nil {
-		return *x.MaxItemQuantity
+func (x *RecipeStepIngredient) GetRecipeStepProductRecipeId() string {
+	if x != nil && x.RecipeStepProductRecipeId != nil {
+		return *x.RecipeStepProductRecipeId
 	}
-	return 0
+	return ""
 }
 
-func (x *RecipeStepProduct) GetArchivedAt() *timestamppb.Timestamp {
+func (x *RecipeStepIngredient) GetArchivedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.ArchivedAt
 	}
 	return nil
 }
 
-func (x *RecipeStepProduct) GetLastUpdatedAt() *timestamppb.Timestamp {
+func (x *RecipeStepIngredient) GetIngredient() *ValidIngredient {
 	if x != nil {
-		return x.LastUpdatedAt
+		return x.Ingredient
 	}
 	return nil
 }
 
-func (x *RecipeStepProduct) GetMeasurementUnit() *ValidMeasurementUnit {
+func (x *RecipeStepIngredient) GetLastUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.MeasurementUnit
+		return x.LastUpdatedAt
 	}
 	return nil
 }
 
-func (x *RecipeStepProduct) GetContainedInVesselIndex() uint32 {
-	if x != nil && x.ContainedInVesselIndex != nil {
-		return *x.ContainedInVesselIndex
+func (x *RecipeStepIngredient) GetVesselIndex() uint32 {
+	if x != nil && x.VesselIndex != nil {
+		return *x.VesselIndex
 	}
 	return 0
 }
 
-func (x *RecipeStepProduct) GetName() string {
-	if x != nil {
-		return x.Name
+func (x *RecipeStepIngredient) GetProductPercentageToUse() float32 {
+	if x != nil && x.ProductPercentageToUse != nil {
+		return *x.ProductPercentageToUse
+	}
+	return 0
+}
+
+func (x *RecipeStepIngredient) GetRecipeStepProductId() string {
+	if x != nil && x.RecipeStepProductId != nil {
+		return *x.RecipeStepProductId
 	}
 	return ""
 }
 
-func (x *RecipeStepProduct) GetBelongsToRecipeStep() string {
+func (x *RecipeStepIngredient) GetBelongsToRecipeStep() string {
 	if x != nil {
 		return x.BelongsToRecipeStep
 	}
 	return ""
 }
 
-func (x *RecipeStepProduct) GetType() RecipeStepProductType {
+func (x *RecipeStepIngredient) GetId() string {
 	if x != nil {
-		return x.Type
+		return x.Id
 	}
-	return RecipeStepProductType_RECIPE_STEP_PRODUCT_TYPE_INGREDIENT
+	return ""
 }
 
-func (x *RecipeStepProduct) GetId() string {
+func (x *RecipeStepIngredient) GetQuantityNotes() string {
 	if x != nil {
-		return x.Id
+		return x.QuantityNotes
 	}
 	return ""
 }
 
-func (x *RecipeStepProduct) GetStorageInstructions() string {
+func (x *RecipeStepIngredient) GetIngredientNotes() string {
 	if x != nil {
-		return x.StorageInstructions
+		return x.IngredientNotes
 	}
 	return ""
 }
 
-func (x *RecipeStepProduct) GetQuantityNotes() string {
+func (x *RecipeStepIngredient) GetName() string {
 	if x != nil {
-		return x.QuantityNotes
+		return x.Name
 	}
 	return ""
 }
 
-func (x *RecipeStepProduct) GetIndex() uint32 {
+func (x *RecipeStepIngredient) GetMinQuantity() float32 {
 	if x != nil {
-		return x.Index
+		return x.MinQuantity
 	}
 	return 0
 }
 
-func (x *RecipeStepProduct) GetIsWaste() bool {
+func (x *RecipeStepIngredient) GetMaxQuantity() float32 {
+	if x != nil && x.MaxQuantity != nil {
+		return *x.MaxQuantity
+	}
+	return 0
+}
+
+func (x *RecipeStepIngredient) GetMeasurementUnit() *ValidMeasurementUnit {
 	if x != nil {
-		return x.IsWaste
+		return x.MeasurementUnit
 	}
-	return false
+	return nil
 }
 
-func (x *RecipeStepProduct) GetIsLiquid() bool {
+func (x *RecipeStepIngredient) GetOptionIndex() uint32 {
 	if x != nil {
-		return x.IsLiquid
+		return x.OptionIndex
+	}
+	return 0
+}
+
+func (x *RecipeStepIngredient) GetOptional() bool {
+	if x != nil {
+		return x.Optional
 	}
 	return false
 }
 
-func (x *RecipeStepProduct) GetCompostable() bool {
+func (x *RecipeStepIngredient) GetToTaste() bool {
 	if x != nil {
-		return x.Compostable
+		return x.ToTaste
 	}
 	return false
 }
 
-type RecipeStepVessel struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	CreatedAt            *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	MinQuantity          uint32                 `protobuf:"varint,2,opt,name=min_quantity,json=minQuantity,proto3" json:"min_quantity,omitempty"`
-	MaxQuantity          *uint32                `protobuf:"varint,16,opt,name=max_quantity,json=maxQuantity,proto3,oneof" json:"max_quantity,omitempty"`
-	LastUpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_updated_at,json=lastUpdatedAt,proto3,oneof" json:"last_updated_at,omitempty"`
-	ArchivedAt           *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=archived_at,json=archivedAt,proto3,oneof" json:"archived_at,omitempty"`
-	RecipeStepProductId  *string                `protobuf:"bytes,5,opt,name=recipe_step_product_id,json=recipeStepProductId,proto3,oneof" json:"recipe_step_product_id,omitempty"`
-	Vessel               *ValidVessel           `protobuf:"bytes,6,opt,name=vessel,proto3" json:"vessel,omitempty"`
-	Id                   string                 `protobuf:"bytes,7,opt,name=id,proto3" json:"id,omitempty"`
-	Notes                string                 `protobuf:"bytes,8,opt,name=notes,proto3" json:"notes,omitempty"`
-	BelongsToRecipeStep  string                 `protobuf:"bytes,9,opt,name=belongs_to_recipe_step,json=belongsToRecipeStep,proto3" json:"belongs_to_recipe_step,omitempty"`
-	VesselPreposition    string                 `protobuf:"bytes,10,opt,name=vessel_preposition,json=vesselPreposition,proto3" json:"vessel_preposition,omitempty"`
-	Name                 string                 `protobuf:"bytes,11,opt,name=name,proto3" json:"name,omitempty"`
-	UnavailableAfterStep bool                   `protobuf:"varint,12,opt,name=unavailable_after_step,json=unavailableAfterStep,proto3" json:"unavailable_after_step,omitempty"`
-	Index                uint32                 `protobuf:"varint,13,opt,name=index,proto3" json:"index,omitempty"`
-	OptionIndex          uint32                 `protobuf:"varint,14,opt,name=option_index,json=optionIndex,proto3" json:"option_index,omitempty"`
-	ScaleFactor          float32                `protobuf:"fixed32,15,opt,name=scale_factor,json=scaleFactor,proto3" json:"scale_factor,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+func (x *RecipeStepIngredient) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
 }
 
-func (x *RecipeStepVessel) Reset() {
-	*x = RecipeStepVessel{}
-	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[29]
+func (x *RecipeStepIngredient) GetScaleFactor() float32 {
+	if x != nil {
+		return x.ScaleFactor
+	}
+	return 0
+}
+
+type RecipeStepInstrument struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	CreatedAt           *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Instrument          *ValidInstrument       `protobuf:"bytes,2,opt,name=instrument,proto3" json:"instrument,omitempty"`
+	LastUpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_updated_at,json=lastUpdatedAt,proto3,oneof" json:"last_updated_at,omitempty"`
+	RecipeStepProductId *string                `protobuf:"bytes,4,opt,name=recipe_step_product_id,json=recipeStepProductId,proto3,oneof" json:"recipe_step_product_id,omitempty"`
+	ArchivedAt          *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=archived_at,json=archivedAt,proto3,oneof" json:"archived_at,omitempty"`
+	Notes               string                 `protobuf:"bytes,6,opt,name=notes,proto3" json:"notes,omitempty"`
+	Name                string                 `protobuf:"bytes,7,opt,name=name,proto3" json:"name,omitempty"`
+	BelongsToRecipeStep string                 `protobuf:"bytes,8,opt,name=belongs_to_recipe_step,json=belongsToRecipeStep,proto3" json:"belongs_to_recipe_step,omitempty"`
+	Id                  string                 `protobuf:"bytes,9,opt,name=id,proto3" json:"id,omitempty"`
+	MinQuantity         uint32                 `protobuf:"varint,10,opt,name=min_quantity,json=minQuantity,proto3" json:"min_quantity,omitempty"`
+	MaxQuantity         *uint32                `protobuf:"varint,16,opt,name=max_quantity,json=maxQuantity,proto3,oneof" json:"max_quantity,omitempty"`
+	OptionIndex         uint32                 `protobuf:"varint,11,opt,name=option_index,json=optionIndex,proto3" json:"option_index,omitempty"`
+	PreferenceRank      uint32                 `protobuf:"varint,12,opt,name=preference_rank,json=preferenceRank,proto3" json:"preference_rank,omitempty"`
+	Optional            bool                   `protobuf:"varint,13,opt,name=optional,proto3" json:"optional,omitempty"`
+	Index               uint32                 `protobuf:"varint,14,opt,name=index,proto3" json:"index,omitempty"`
+	ScaleFactor         float32                `protobuf:"fixed32,15,opt,name=scale_factor,json=scaleFactor,proto3" json:"scale_factor,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *RecipeStepInstrument) Reset() {
+	*x = RecipeStepInstrument{}
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RecipeStepVessel) String() string {
+func (x *RecipeStepInstrument) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecipeStepVessel) ProtoMessage() {}
+func (*RecipeStepInstrument) ProtoMessage() {}
 
-func (x *RecipeStepVessel) ProtoReflect() protoreflect.Message {
-	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[29]
+func (x *RecipeStepInstrument) ProtoReflect() protoreflect.Message {
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4619,155 +4700,167 @@ func (x *RecipeStepVessel) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecipeStepVessel.ProtoReflect.Descriptor instead.
-func (*RecipeStepVessel) Descriptor() ([]byte, []int) {
-	return file_mealplanning_mealplanning_messages_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use RecipeStepInstrument.ProtoReflect.Descriptor instead.
+func (*RecipeStepInstrument) Descriptor() ([]byte, []int) {
+	return file_mealplanning_mealplanning_messages_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *RecipeStepVessel) GetCreatedAt() *timestamppb.Timestamp {
+func (x *RecipeStepInstrument) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return nil
 }
 
-func (x *RecipeStepVessel) GetMinQuantity() uint32 {
-	if x != nil {
-		return x.MinQuantity
-	}
-	return 0
-}
-
-func (x *RecipeStepVessel) GetMaxQuantity() uint32 {
-	if x != nil && x.MaxQuantity != nil {
-		return *x.MaxQuantity
-	}
-	return 0
-}
-
-func (x *RecipeStepVessel) GetLastUpdatedAt() *timestamppb.Timestamp {
+func (x *RecipeStepInstrument) GetInstrument() *ValidInstrument {
 	if x != nil {
-		return x.LastUpdatedAt
+		return x.Instrument
 	}
 	return nil
 }
 
-func (x *RecipeStepVessel) GetArchivedAt() *timestamppb.Timestamp {
+func (x *RecipeStepInstrument) GetLastUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.ArchivedAt
+		return x.LastUpdatedAt
 	}
 	return nil
 }
 
-func (x *RecipeStepVessel) GetRecipeStepProductId() string {
+func (x *RecipeStepInstrument) GetRecipeStepProductId() string {
 	if x != nil && x.RecipeStepProductId != nil {
 		return *x.RecipeStepProductId
 	}
 	return ""
 }
 
-func (x *RecipeStepVessel) GetVessel() *ValidVessel {
+func (x *RecipeStepInstrument) GetArchivedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Vessel
+		return x.ArchivedAt
 	}
 	return nil
 }
 
-func (x *RecipeStepVessel) GetId() string {
+func (x *RecipeStepInstrument) GetNotes() string {
 	if x != nil {
-		return x.Id
+		return x.Notes
 	}
 	return ""
 }
 
-func (x *RecipeStepVessel) GetNotes() string {
+func (x *RecipeStepInstrument) GetName() string {
 	if x != nil {
-		return x.Notes
+		return x.Name
 	}
 	return ""
 }
 
-func (x *RecipeStepVessel) GetBelongsToRecipeStep() string {
+func (x *RecipeStepInstrument) GetBelongsToRecipeStep() string {
 	if x != nil {
 		return x.BelongsToRecipeStep
 	}
 	return ""
 }
 
-func (x *RecipeStepVessel) GetVesselPreposition() string {
+func (x *RecipeStepInstrument) GetId() string {
 	if x != nil {
-		return x.VesselPreposition
+		return x.Id
 	}
 	return ""
 }
 
-func (x *RecipeStepVessel) GetName() string {
+func (x *RecipeStepInstrument) GetMinQuantity() uint32 {
 	if x != nil {
-		return x.Name
+		return x.MinQuantity
 	}
-	return ""
+	return 0
 }
 
-func (x *RecipeStepVessel) GetUnavailableAfterStep() bool {
+func (x *RecipeStepInstrument) GetMaxQuantity() uint32 {
+	if x != nil && x.MaxQuantity != nil {
+		return *x.MaxQuantity
+	}
+	return 0
+}
+
+func (x *RecipeStepInstrument) GetOptionIndex() uint32 {
 	if x != nil {
-		return x.UnavailableAfterStep
+		return x.OptionIndex
 	}
-	return false
+	return 0
 }
 
-func (x *RecipeStepVessel) GetIndex() uint32 {
+func (x *RecipeStepInstrument) GetPreferenceRank() uint32 {
 	if x != nil {
-		return x.Index
+		return x.PreferenceRank
 	}
 	return 0
 }
 
-func (x *RecipeStepVessel) GetOptionIndex() uint32 {
+func (x *RecipeStepInstrument) GetOptional() bool {
 	if x != nil {
-		return x.OptionIndex
+		return x.Optional
+	}
+	return false
+}
+
+func (x *RecipeStepInstrument) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
 	}
 	return 0
 }
 
-func (x *RecipeStepVessel) GetScaleFactor() float32 {
+func (x *RecipeStepInstrument) GetScaleFactor() float32 {
 	if x != nil {
 		return x.ScaleFactor
 	}
 	return 0
 }
 
-type Meal struct {
-	state                protoimpl.MessageState `protogen:"open.v1"`
-	CreatedAt            *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	ArchivedAt           *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=archived_at,json=archivedAt,proto3,oneof" json:"archived_at,omitempty"`
-	LastUpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_updated_at,json=lastUpdatedAt,proto3,oneof" json:"last_updated_at,omitempty"`
-	MinEstimatedPortions float32                `protobuf:"fixed32,4,opt,name=min_estimated_portions,json=minEstimatedPortions,proto3" json:"min_estimated_portions,omitempty"`
-	MaxEstimatedPortions *float32               `protobuf:"fixed32,11,opt,name=max_estimated_portions,json=maxEstimatedPortions,proto3,oneof" json:"max_estimated_portions,omitempty"`
-	Id                   string                 `protobuf:"bytes,5,opt,name=id,proto3" json:"id,omitempty"`
-	Description          string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
-	CreatedByUser        string                 `protobuf:"bytes,7,opt,name=created_by_user,json=createdByUser,proto3" json:"created_by_user,omitempty"`
-	Name                 string                 `protobuf:"bytes,8,opt,name=name,proto3" json:"name,omitempty"`
-	Components           []*MealComponent       `protobuf:"bytes,9,rep,name=components,proto3" json:"components,omitempty"`
-	EligibleForMealPlans bool                   `protobuf:"varint,10,opt,name=eligible_for_meal_plans,json=eligibleForMealPlans,proto3" json:"eligible_for_meal_plans,omitempty"`
-	unknownFields        protoimpl.UnknownFields
-	sizeCache            protoimpl.SizeCache
+type RecipeStepProduct struct {
+	state                          protoimpl.MessageState `protogen:"open.v1"`
+	CreatedAt                      *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	MinStorageTemperatureInCelsius *float32               `protobuf:"fixed32,2,opt,name=min_storage_temperature_in_celsius,json=minStorageTemperatureInCelsius,proto3,oneof" json:"min_storage_temperature_in_celsius,omitempty"`
+	MaxStorageTemperatureInCelsius *float32               `protobuf:"fixed32,20,opt,name=max_storage_temperature_in_celsius,json=maxStorageTemperatureInCelsius,proto3,oneof" json:"max_storage_temperature_in_celsius,omitempty"`
+	MinStorageDurationInSeconds    *uint32                `protobuf:"varint,3,opt,name=min_storage_duration_in_seconds,json=minStorageDurationInSeconds,proto3,oneof" json:"min_storage_duration_in_seconds,omitempty"`
+	MaxStorageDurationInSeconds    *uint32                `protobuf:"varint,21,opt,name=max_storage_duration_in_seconds,json=maxStorageDurationInSeconds,proto3,oneof" json:"max_storage_duration_in_seconds,omitempty"`
+	MinMeasurementQuantity         *float32               `protobuf:"fixed32,4,opt,name=min_measurement_quantity,json=minMeasurementQuantity,proto3,oneof" json:"min_measurement_quantity,omitempty"`
+	MaxMeasurementQuantity         *float32               `protobuf:"fixed32,22,opt,name=max_measurement_quantity,json=maxMeasurementQuantity,proto3,oneof" json:"max_measurement_quantity,omitempty"`
+	MinItemQuantity                *float32               `protobuf:"fixed32,5,opt,name=min_item_quantity,json=minItemQuantity,proto3,oneof" json:"min_item_quantity,omitempty"`
+	MaxItemQuantity                *float32               `protobuf:"fixed32,23,opt,name=max_item_quantity,json=maxItemQuantity,proto3,oneof" json:"max_item_quantity,omitempty"`
+	ArchivedAt                     *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=archived_at,json=archivedAt,proto3,oneof" json:"archived_at,omitempty"`
+	LastUpdatedAt                  *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=last_updated_at,json=lastUpdatedAt,proto3,oneof" json:"last_updated_at,omitempty"`
+	MeasurementUnit                *ValidMeasurementUnit  `protobuf:"bytes,8,opt,name=measurement_unit,json=measurementUnit,proto3" json:"measurement_unit,omitempty"`
+	ContainedInVesselIndex         *uint32                `protobuf:"varint,9,opt,name=contained_in_vessel_index,json=containedInVesselIndex,proto3,oneof" json:"contained_in_vessel_index,omitempty"`
+	Name                           string                 `protobuf:"bytes,10,opt,name=name,proto3" json:"name,omitempty"`
+	BelongsToRecipeStep            string                 `protobuf:"bytes,11,opt,name=belongs_to_recipe_step,json=belongsToRecipeStep,proto3" json:"belongs_to_recipe_step,omitempty"`
+	Type                           RecipeStepProductType  `protobuf:"varint,12,opt,name=type,proto3,enum=mealplanning.RecipeStepProductType" json:"type,omitempty"`
+	Id                             string                 `protobuf:"bytes,13,opt,name=id,proto3" json:"id,omitempty"`
+	StorageInstructions            string                 `protobuf:"bytes,14,opt,name=storage_instructions,json=storageInstructions,proto3" json:"storage_instructions,omitempty"`
+	QuantityNotes                  string                 `protobuf:"bytes,15,opt,name=quantity_notes,json=quantityNotes,proto3" json:"quantity_notes,omitempty"`
+	Index                          uint32                 `protobuf:"varint,16,opt,name=index,proto3" json:"index,omitempty"`
+	IsWaste                        bool                   `protobuf:"varint,17,opt,name=is_waste,json=isWaste,proto3" json:"is_waste,omitempty"`
+	IsLiquid                       bool                   `protobuf:"varint,18,opt,name=is_liquid,json=isLiquid,proto3" json:"is_liquid,omitempty"`
+	Compostable                    bool                   `protobuf:"varint,19,opt,name=compostable,proto3" json:"compostable,omitempty"`
+	unknownFields                  protoimpl.UnknownFields
+	sizeCache                      protoimpl.SizeCache
 }
 
-func (x *Meal) Reset() {
-	*x = Meal{}
-	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[30]
+func (x *RecipeStepProduct) Reset() {
+	*x = RecipeStepProduct{}
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Meal) String() string {
+func (x *RecipeStepProduct) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Meal) ProtoMessage() {}
+func (*RecipeStepProduct) ProtoMessage() {}
 
-func (x *Meal) ProtoReflect() protoreflect.Message {
-	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[30]
+func (x *RecipeStepProduct) ProtoReflect() protoreflect.Message {
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4778,183 +4871,209 @@ func (x *Meal) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Meal.ProtoReflect.Descriptor instead.
-func (*Meal) Descriptor() ([]byte, []int) {
-	return file_mealplanning_mealplanning_messages_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use RecipeStepProduct.ProtoReflect.Descriptor instead.
+func (*RecipeStepProduct) Descriptor() ([]byte, []int) {
+	return file_mealplanning_mealplanning_messages_proto_rawDescGZIP(), []int{33}
 }
 
-func (x *Meal) GetCreatedAt() *timestamppb.Timestamp {
+func (x *RecipeStepProduct) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return nil
 }
 
-func (x *Meal) GetArchivedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.ArchivedAt
+func (x *RecipeStepProduct) GetMinStorageTemperatureInCelsius() float32 {
+	if x != nil && x.MinStorageTemperatureInCelsius != nil {
+		return *x.MinStorageTemperatureInCelsius
 	}
-	return nil
+	return 0
 }
 
-func (x *Meal) GetLastUpdatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.LastUpdatedAt
+func (x *RecipeStepProduct) GetMaxStorageTemperatureInCelsius() float32 {
+	if x != nil && x.MaxStorageTemperatureInCelsius != nil {
+		return *x.MaxStorageTemperatureInCelsius
 	}
-	return nil
+	return 0
 }
 
-func (x *Meal) GetMinEstimatedPortions() float32 {
-	if x != nil {
-		return x.MinEstimatedPortions
+func (x *RecipeStepProduct) GetMinStorageDurationInSeconds() uint32 {
+	if x != nil && x.MinStorageDurationInSeconds != nil {
+		return *x.MinStorageDurationInSeconds
 	}
 	return 0
 }
 
-func (x *Meal) GetMaxEstimatedPortions() float32 {
-	if x != nil && x.MaxEstimatedPortions != nil {
-		return *x.MaxEstimatedPortions
+func (x *RecipeStepProduct) GetMaxStorageDurationInSeconds() uint32 {
+	if x != nil && x.MaxStorageDurationInSeconds != nil {
+		return *x.MaxStorageDurationInSeconds
 	}
 	return 0
 }
 
-func (x *Meal) GetId() string {
-	if x != nil {
-		return x.Id
+func (x *RecipeStepProduct) GetMinMeasurementQuantity() float32 {
+	if x != nil && x.MinMeasurementQuantity != nil {
+		return *x.MinMeasurementQuantity
 	}
-	return ""
+	return 0
 }
 
-func (x *Meal) GetDescription() string {
-	if x != nil {
-		return x.Description
+func (x *RecipeStepProduct) GetMaxMeasurementQuantity() float32 {
+	if x != nil && x.MaxMeasurementQuantity != nil {
+		return *x.MaxMeasurementQuantity
 	}
-	return ""
+	return 0
 }
 
-func (x *Meal) GetCreatedByUser() string {
-	if x != nil {
-		return x.CreatedByUser
+func (x *RecipeStepProduct) GetMinItemQuantity() float32 {
+	if x != nil && x.MinItemQuantity != nil {
+		return *x.MinItemQuantity
 	}
-	return ""
+	return 0
 }
 
-func (x *Meal) GetName() string {
-	if x != nil {
-		return x.Name
+func (x *RecipeStepProduct) GetMaxItemQuantity() float32 {
+	if x != nil && x.MaxItemQuantity != nil {
+		return *x.MaxItemQuantity
 	}
-	return ""
+	return 0
 }
 
-func (x *Meal) GetComponents() []*MealComponent {
+func (x *RecipeStepProduct) GetArchivedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Components
+		return x.ArchivedAt
 	}
 	return nil
 }
 
-func (x *Meal) GetEligibleForMealPlans() bool {
+func (x *RecipeStepProduct) GetLastUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.EligibleForMealPlans
+		return x.LastUpdatedAt
 	}
-	return false
+	return nil
 }
 
-type MealComponent struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	ComponentType MealComponentType      `protobuf:"varint,1,opt,name=component_type,json=componentType,proto3,enum=mealplanning.MealComponentType" json:"component_type,omitempty"`
-	Recipe        *Recipe                `protobuf:"bytes,2,opt,name=recipe,proto3" json:"recipe,omitempty"`
-	RecipeScale   float32                `protobuf:"fixed32,3,opt,name=recipe_scale,json=recipeScale,proto3" json:"recipe_scale,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *RecipeStepProduct) GetMeasurementUnit() *ValidMeasurementUnit {
+	if x != nil {
+		return x.MeasurementUnit
+	}
+	return nil
 }
 
-func (x *MealComponent) Reset() {
-	*x = MealComponent{}
-	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[31]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *RecipeStepProduct) GetContainedInVesselIndex() uint32 {
+	if x != nil && x.ContainedInVesselIndex != nil {
+		return *x.ContainedInVesselIndex
+	}
+	return 0
 }
 
-func (x *MealComponent) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *RecipeStepProduct) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-func (*MealComponent) ProtoMessage() {}
+func (x *RecipeStepProduct) GetBelongsToRecipeStep() string {
+	if x != nil {
+		return x.BelongsToRecipeStep
+	}
+	return ""
+}
 
-func (x *MealComponent) ProtoReflect() protoreflect.Message {
-	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[31]
+func (x *RecipeStepProduct) GetType() RecipeStepProductType {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Type
 	}
-	return mi.MessageOf(x)
+	return RecipeStepProductType_RECIPE_STEP_PRODUCT_TYPE_INGREDIENT
 }
 
-// Deprecated: Use MealComponent.ProtoReflect.Descriptor instead.
-func (*MealComponent) Descriptor() ([]byte, []int) {
-	return file_mealplanning_mealplanning_messages_proto_rawDescGZIP(), []int{31}
+func (x *RecipeStepProduct) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
 }
 
-func (x *MealComponent) GetComponentType() MealComponentType {
+func (x *RecipeStepProduct) GetStorageInstructions() string {
 	if x != nil {
-		return x.ComponentType
+		return x.StorageInstructions
 	}
-	return MealComponentType_MEAL_COMPONENT_TYPE_UNSPECIFIED
+	return ""
 }
 
-func (x *MealComponent) GetRecipe() *Recipe {
+func (x *RecipeStepProduct) GetQuantityNotes() string {
 	if x != nil {
-		return x.Recipe
+		return x.QuantityNotes
 	}
-	return nil
+	return ""
 }
 
-func (x *MealComponent) GetRecipeScale() float32 {
+func (x *RecipeStepProduct) GetIndex() uint32 {
 	if x != nil {
-		return x.RecipeScale
+		return x.Index
 	}
 	return 0
 }
 
-type MealPlan struct {
-	state                  protoimpl.MessageState           `protogen:"open.v1"`
-	CreatedAt              *timestamppb.Timestamp           `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	VotingDeadline         *timestamppb.Timestamp           `protobuf:"bytes,2,opt,name=voting_deadline,json=votingDeadline,proto3" json:"voting_deadline,omitempty"`
-	ArchivedAt             *timestamppb.Timestamp           `protobuf:"bytes,3,opt,name=archived_at,json=archivedAt,proto3,oneof" json:"archived_at,omitempty"`
-	LastUpdatedAt          *timestamppb.Timestamp           `protobuf:"bytes,4,opt,name=last_updated_at,json=lastUpdatedAt,proto3,oneof" json:"last_updated_at,omitempty"`
-	Id                     string                           `protobuf:"bytes,5,opt,name=id,proto3" json:"id,omitempty"`
-	Status                 MealPlanStatus                   `protobuf:"varint,6,opt,name=status,proto3,enum=mealplanning.MealPlanStatus" json:"status,omitempty"`
-	Notes                  string                           `protobuf:"bytes,7,opt,name=notes,proto3" json:"notes,omitempty"`
-	ElectionMethod         MealPlanElectionMethod           `protobuf:"varint,8,opt,name=election_method,json=electionMethod,proto3,enum=mealplanning.MealPlanElectionMethod" json:"election_method,omitempty"`
-	BelongsToAccount       string                           `protobuf:"bytes,9,opt,name=belongs_to_account,json=belongsToAccount,proto3" json:"belongs_to_account,omitempty"`
-	CreatedByUser          string                           `protobuf:"bytes,10,opt,name=created_by_user,json=createdByUser,proto3" json:"created_by_user,omitempty"`
-	Events                 []*MealPlanEvent                 `protobuf:"bytes,11,rep,name=events,proto3" json:"events,omitempty"`
-	GroceryListInitialized bool                             `protobuf:"varint,12,opt,name=grocery_list_initialized,json=groceryListInitialized,proto3" json:"grocery_list_initialized,omitempty"`
-	TasksCreated           bool                             `protobuf:"varint,13,opt,name=tasks_created,json=tasksCreated,proto3" json:"tasks_created,omitempty"`
-	Selections             []*MealPlanRecipeOptionSelection `protobuf:"bytes,14,rep,name=selections,proto3" json:"selections,omitempty"`
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+func (x *RecipeStepProduct) GetIsWaste() bool {
+	if x != nil {
+		return x.IsWaste
+	}
+	return false
 }
 
-func (x *MealPlan) Reset() {
-	*x = MealPlan{}
-	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[32]
+func (x *RecipeStepProduct) GetIsLiquid() bool {
+	if x != nil {
+		return x.IsLiquid
+	}
+	return false
+}
+
+func (x *RecipeStepProduct) GetCompostable() bool {
+	if x != nil {
+		return x.Compostable
+	}
+	return false
+}
+
+type RecipeStepVessel struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	CreatedAt            *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	MinQuantity          uint32                 `protobuf:"varint,2,opt,name=min_quantity,json=minQuantity,proto3" json:"min_quantity,omitempty"`
+	MaxQuantity          *uint32                `protobuf:"varint,16,opt,name=max_quantity,json=maxQuantity,proto3,oneof" json:"max_quantity,omitempty"`
+	LastUpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_updated_at,json=lastUpdatedAt,proto3,oneof" json:"last_updated_at,omitempty"`
+	ArchivedAt           *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=archived_at,json=archivedAt,proto3,oneof" json:"archived_at,omitempty"`
+	RecipeStepProductId  *string                `protobuf:"bytes,5,opt,name=recipe_step_product_id,json=recipeStepProductId,proto3,oneof" json:"recipe_step_product_id,omitempty"`
+	Vessel               *ValidVessel           `protobuf:"bytes,6,opt,name=vessel,proto3" json:"vessel,omitempty"`
+	Id                   string                 `protobuf:"bytes,7,opt,name=id,proto3" json:"id,omitempty"`
+	Notes                string                 `protobuf:"bytes,8,opt,name=notes,proto3" json:"notes,omitempty"`
+	BelongsToRecipeStep  string                 `protobuf:"bytes,9,opt,name=belongs_to_recipe_step,json=belongsToRecipeStep,proto3" json:"belongs_to_recipe_step,omitempty"`
+	VesselPreposition    string                 `protobuf:"bytes,10,opt,name=vessel_preposition,json=vesselPreposition,proto3" json:"vessel_preposition,omitempty"`
+	Name                 string                 `protobuf:"bytes,11,opt,name=name,proto3" json:"name,omitempty"`
+	UnavailableAfterStep bool                   `protobuf:"varint,12,opt,name=unavailable_after_step,json=unavailableAfterStep,proto3" json:"unavailable_after_step,omitempty"`
+	Index                uint32                 `protobuf:"varint,13,opt,name=index,proto3" json:"index,omitempty"`
+	OptionIndex          uint32                 `protobuf:"varint,14,opt,name=option_index,json=optionIndex,proto3" json:"option_index,omitempty"`
+	ScaleFactor          float32                `protobuf:"fixed32,15,opt,name=scale_factor,json=scaleFactor,proto3" json:"scale_factor,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *RecipeStepVessel) Reset() {
+	*x = RecipeStepVessel{}
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MealPlan) String() string {
+func (x *RecipeStepVessel) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MealPlan) ProtoMessage() {}
+func (*RecipeStepVessel) ProtoMessage() {}
 
-func (x *MealPlan) ProtoReflect() protoreflect.Message {
-	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[32]
+func (x *RecipeStepVessel) ProtoReflect() protoreflect.Message {
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4965,140 +5084,155 @@ func (x *MealPlan) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MealPlan.ProtoReflect.Descriptor instead.
-func (*MealPlan) Descriptor() ([]byte, []int) {
-	return file_mealplanning_mealplanning_messages_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use RecipeStepVessel.ProtoReflect.Descriptor instead.
+func (*RecipeStepVessel) Descriptor() ([]byte, []int) {
+	return file_mealplanning_mealplanning_messages_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *MealPlan) GetCreatedAt() *timestamppb.Timestamp {
+func (x *RecipeStepVessel) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return nil
 }
 
-func (x *MealPlan) GetVotingDeadline() *timestamppb.Timestamp {
+func (x *RecipeStepVessel) GetMinQuantity() uint32 {
 	if x != nil {
-		return x.VotingDeadline
+		return x.MinQuantity
 	}
-	return nil
+	return 0
 }
 
-func (x *MealPlan) GetArchivedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.ArchivedAt
+func (x *RecipeStepVessel) GetMaxQuantity() uint32 {
+	if x != nil && x.MaxQuantity != nil {
+		return *x.MaxQuantity
 	}
-	return nil
+	return 0
 }
 
-func (x *MealPlan) GetLastUpdatedAt() *timestamppb.Timestamp {
+func (x *RecipeStepVessel) GetLastUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.LastUpdatedAt
 	}
 	return nil
 }
 
-func (x *MealPlan) GetId() string {
+func (x *RecipeStepVessel) GetArchivedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Id
+		return x.ArchivedAt
+	}
+	return nil
+}
+
+func (x *RecipeStepVessel) GetRecipeStepProductId() string {
+	if x != nil && x.RecipeStepProductId != nil {
+		return *x.RecipeStepProductId
 	}
 	return ""
 }
 
-func (x *MealPlan) GetStatus() MealPlanStatus {
+func (x *RecipeStepVessel) GetVessel() *ValidVessel {
 	if x != nil {
-		return x.Status
+		return x.Vessel
 	}
-	return MealPlanStatus_MEAL_PLAN_STATUS_AWAITING_VOTES
+	return nil
 }
 
-func (x *MealPlan) GetNotes() string {
+func (x *RecipeStepVessel) GetId() string {
 	if x != nil {
-		return x.Notes
+		return x.Id
 	}
 	return ""
 }
 
-func (x *MealPlan) GetElectionMethod() MealPlanElectionMethod {
+func (x *RecipeStepVessel) GetNotes() string {
 	if x != nil {
-		return x.ElectionMethod
+		return x.Notes
 	}
-	return MealPlanElectionMethod_MEAL_PLAN_ELECTION_METHOD_SCHULZE
+	return ""
 }
 
-func (x *MealPlan) GetBelongsToAccount() string {
+func (x *RecipeStepVessel) GetBelongsToRecipeStep() string {
 	if x != nil {
-		return x.BelongsToAccount
+		return x.BelongsToRecipeStep
 	}
 	return ""
 }
 
-func (x *MealPlan) GetCreatedByUser() string {
+func (x *RecipeStepVessel) GetVesselPreposition() string {
 	if x != nil {
-		return x.CreatedByUser
+		return x.VesselPreposition
 	}
 	return ""
 }
 
-func (x *MealPlan) GetEvents() []*MealPlanEvent {
+func (x *RecipeStepVessel) GetName() string {
 	if x != nil {
-		return x.Events
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-func (x *MealPlan) GetGroceryListInitialized() bool {
+func (x *RecipeStepVessel) GetUnavailableAfterStep() bool {
 	if x != nil {
-		return x.GroceryListInitialized
+		return x.UnavailableAfterStep
 	}
 	return false
 }
 
-func (x *MealPlan) GetTasksCreated() bool {
+func (x *RecipeStepVessel) GetIndex() uint32 {
 	if x != nil {
-		return x.TasksCreated
+		return x.Index
 	}
-	return false
+	return 0
 }
 
-func (x *MealPlan) GetSelections() []*MealPlanRecipeOptionSelection {
+func (x *RecipeStepVessel) GetOptionIndex() uint32 {
 	if x != nil {
-		return x.Selections
+		return x.OptionIndex
 	}
-	return nil
+	return 0
 }
 
-type MealPlanEvent struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	StartsAt          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
-	EndsAt            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
-	ArchivedAt        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=archived_at,json=archivedAt,proto3,oneof" json:"archived_at,omitempty"`
-	LastUpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_updated_at,json=lastUpdatedAt,proto3,oneof" json:"last_updated_at,omitempty"`
-	MealName          MealPlanEventName      `protobuf:"varint,6,opt,name=meal_name,json=mealName,proto3,enum=mealplanning.MealPlanEventName" json:"meal_name,omitempty"`
-	Notes             string                 `protobuf:"bytes,7,opt,name=notes,proto3" json:"notes,omitempty"`
-	BelongsToMealPlan string                 `protobuf:"bytes,8,opt,name=belongs_to_meal_plan,json=belongsToMealPlan,proto3" json:"belongs_to_meal_plan,omitempty"`
-	Id                string                 `protobuf:"bytes,9,opt,name=id,proto3" json:"id,omitempty"`
-	Options           []*MealPlanOption      `protobuf:"bytes,10,rep,name=options,proto3" json:"options,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+func (x *RecipeStepVessel) GetScaleFactor() float32 {
+	if x != nil {
+		return x.ScaleFactor
+	}
+	return 0
 }
 
-func (x *MealPlanEvent) Reset() {
-	*x = MealPlanEvent{}
-	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[33]
+type Meal struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	CreatedAt            *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ArchivedAt           *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=archived_at,json=archivedAt,proto3,oneof" json:"archived_at,omitempty"`
+	LastUpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_updated_at,json=lastUpdatedAt,proto3,oneof" json:"last_updated_at,omitempty"`
+	MinEstimatedPortions float32                `protobuf:"fixed32,4,opt,name=min_estimated_portions,json=minEstimatedPortions,proto3" json:"min_estimated_portions,omitempty"`
+	MaxEstimatedPortions *float32               `protobuf:"fixed32,11,opt,name=max_estimated_portions,json=maxEstimatedPortions,proto3,oneof" json:"max_estimated_portions,omitempty"`
+	Id                   string                 `protobuf:"bytes,5,opt,name=id,proto3" json:"id,omitempty"`
+	Description          string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	CreatedByUser        string                 `protobuf:"bytes,7,opt,name=created_by_user,json=createdByUser,proto3" json:"created_by_user,omitempty"`
+	Name                 string                 `protobuf:"bytes,8,opt,name=name,proto3" json:"name,omitempty"`
+	Components           []*MealComponent       `protobuf:"bytes,9,rep,name=components,proto3" json:"components,omitempty"`
+	EligibleForMealPlans bool                   `protobuf:"varint,10,opt,name=eligible_for_meal_plans,json=eligibleForMealPlans,proto3" json:"eligible_for_meal_plans,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *Meal) Reset() {
+	*x = Meal{}
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MealPlanEvent) String() string {
+func (x *Meal) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MealPlanEvent) ProtoMessage() {}
+func (*Meal) ProtoMessage() {}
 
-func (x *MealPlanEvent) ProtoReflect() protoreflect.Message {
-	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[33]
+func (x *Meal) ProtoReflect() protoreflect.Message {
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5109,123 +5243,112 @@ func (x *MealPlanEvent) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MealPlanEvent.ProtoReflect.Descriptor instead.
-func (*MealPlanEvent) Descriptor() ([]byte, []int) {
-	return file_mealplanning_mealplanning_messages_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use Meal.ProtoReflect.Descriptor instead.
+func (*Meal) Descriptor() ([]byte, []int) {
+	return file_mealplanning_mealplanning_messages_proto_rawDescGZIP(), []int{35}
 }
 
-func (x *MealPlanEvent) GetCreatedAt() *timestamppb.Timestamp {
+func (x *Meal) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return nil
 }
 
-func (x *MealPlanEvent) GetStartsAt() *timestamppb.Timestamp {
+func (x *Meal) GetArchivedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.StartsAt
+		return x.ArchivedAt
 	}
 	return nil
 }
 
-func (x *MealPlanEvent) GetEndsAt() *timestamppb.Timestamp {
+func (x *Meal) GetLastUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.EndsAt
+		return x.LastUpdatedAt
 	}
 	return nil
 }
 
-func (x *MealPlanEvent) GetArchivedAt() *timestamppb.Timestamp {
+func (x *Meal) GetMinEstimatedPortions() float32 {
 	if x != nil {
-		return x.ArchivedAt
+		return x.MinEstimatedPortions
 	}
-	return nil
+	return 0
 }
 
-func (x *MealPlanEvent) GetLastUpdatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.LastUpdatedAt
+func (x *Meal) GetMaxEstimatedPortions() float32 {
+	if x != nil && x.MaxEstimatedPortions != nil {
+		return *x.MaxEstimatedPortions
 	}
-	return nil
+	return 0
 }
 
-func (x *MealPlanEvent) GetMealName() MealPlanEventName {
+func (x *Meal) GetId() string {
 	if x != nil {
-		return x.MealName
+		return x.Id
 	}
-	return MealPlanEventName_MEAL_PLAN_EVENT_NAME_BREAKFAST
+	return ""
 }
 
-func (x *MealPlanEvent) GetNotes() string {
+func (x *Meal) GetDescription() string {
 	if x != nil {
-		return x.Notes
+		return x.Description
 	}
 	return ""
 }
 
-func (x *MealPlanEvent) GetBelongsToMealPlan() string {
+func (x *Meal) GetCreatedByUser() string {
 	if x != nil {
-		return x.BelongsToMealPlan
+		return x.CreatedByUser
 	}
 	return ""
 }
 
-func (x *MealPlanEvent) GetId() string {
+func (x *Meal) GetName() string {
 	if x != nil {
-		return x.Id
+		return x.Name
 	}
 	return ""
 }
 
-func (x *MealPlanEvent) GetOptions() []*MealPlanOption {
+func (x *Meal) GetComponents() []*MealComponent {
 	if x != nil {
-		return x.Options
+		return x.Components
 	}
 	return nil
 }
 
-type MealPlanGroceryListItem struct {
-	state                    protoimpl.MessageState        `protogen:"open.v1"`
-	CreatedAt                *timestamppb.Timestamp        `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	QuantityPurchased        *float32                      `protobuf:"fixed32,2,opt,name=quantity_purchased,json=quantityPurchased,proto3,oneof" json:"quantity_purchased,omitempty"`
-	PurchasePrice            *float32                      `protobuf:"fixed32,3,opt,name=purchase_price,json=purchasePrice,proto3,oneof" json:"purchase_price,omitempty"`
-	PurchasedUpc             *string                       `protobuf:"bytes,4,opt,name=purchased_upc,json=purchasedUpc,proto3,oneof" json:"purchased_upc,omitempty"`
-	ArchivedAt               *timestamppb.Timestamp        `protobuf:"bytes,5,opt,name=archived_at,json=archivedAt,proto3,oneof" json:"archived_at,omitempty"`
-	LastUpdatedAt            *timestamppb.Timestamp        `protobuf:"bytes,6,opt,name=last_updated_at,json=lastUpdatedAt,proto3,oneof" json:"last_updated_at,omitempty"`
-	PurchasedMeasurementUnit *ValidMeasurementUnit         `protobuf:"bytes,7,opt,name=purchased_measurement_unit,json=purchasedMeasurementUnit,proto3" json:"purchased_measurement_unit,omitempty"`
-	BelongsToMealPlan        string                        `protobuf:"bytes,8,opt,name=belongs_to_meal_plan,json=belongsToMealPlan,proto3" json:"belongs_to_meal_plan,omitempty"`
-	Status                   MealPlanGroceryListItemStatus `protobuf:"varint,9,opt,name=status,proto3,enum=mealplanning.MealPlanGroceryListItemStatus" json:"status,omitempty"`
-	StatusExplanation        string                        `protobuf:"bytes,10,opt,name=status_explanation,json=statusExplanation,proto3" json:"status_explanation,omitempty"`
-	Id                       string                        `protobuf:"bytes,11,opt,name=id,proto3" json:"id,omitempty"`
-	MinQuantityNeeded        float32                       `protobuf:"fixed32,12,opt,name=min_quantity_needed,json=minQuantityNeeded,proto3" json:"min_quantity_needed,omitempty"`
-	MaxQuantityNeeded        *float32                      `protobuf:"fixed32,20,opt,name=max_quantity_needed,json=maxQuantityNeeded,proto3,oneof" json:"max_quantity_needed,omitempty"`
-	MeasurementUnit          *ValidMeasurementUnit         `protobuf:"bytes,13,opt,name=measurement_unit,json=measurementUnit,proto3" json:"measurement_unit,omitempty"`
-	Ingredient               *ValidIngredient              `protobuf:"bytes,14,opt,name=ingredient,proto3" json:"ingredient,omitempty"`
-	// Recipe context (optional - only set when item is part of a choice group)
-	BelongsToMealPlanOption *string `protobuf:"bytes,15,opt,name=belongs_to_meal_plan_option,json=belongsToMealPlanOption,proto3,oneof" json:"belongs_to_meal_plan_option,omitempty"`
-	RecipeId                *string `protobuf:"bytes,16,opt,name=recipe_id,json=recipeId,proto3,oneof" json:"recipe_id,omitempty"`
-	RecipeStepId            *string `protobuf:"bytes,17,opt,name=recipe_step_id,json=recipeStepId,proto3,oneof" json:"recipe_step_id,omitempty"`
-	IngredientIndex         *uint32 `protobuf:"varint,18,opt,name=ingredient_index,json=ingredientIndex,proto3,oneof" json:"ingredient_index,omitempty"`
-	OptionIndex             *uint32 `protobuf:"varint,19,opt,name=option_index,json=optionIndex,proto3,oneof" json:"option_index,omitempty"`
-	unknownFields           protoimpl.UnknownFields
-	sizeCache               protoimpl.SizeCache
+func (x *Meal) GetEligibleForMealPlans() bool {
+	if x != nil {
+		return x.EligibleForMealPlans
+	}
+	return false
 }
 
-func (x *MealPlanGroceryListItem) Reset() {
-	*x = MealPlanGroceryListItem{}
-	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[34]
+type MealComponent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ComponentType MealComponentType      `protobuf:"varint,1,opt,name=component_type,json=componentType,proto3,enum=mealplanning.MealComponentType" json:"component_type,omitempty"`
+	Recipe        *Recipe                `protobuf:"bytes,2,opt,name=recipe,proto3" json:"recipe,omitempty"`
+	RecipeScale   float32                `protobuf:"fixed32,3,opt,name=recipe_scale,json=recipeScale,proto3" json:"recipe_scale,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MealComponent) Reset() {
+	*x = MealComponent{}
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MealPlanGroceryListItem) String() string {
+func (x *MealComponent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MealPlanGroceryListItem) ProtoMessage() {}
+func (*MealComponent) ProtoMessage() {}
 
-func (x *MealPlanGroceryListItem) ProtoReflect() protoreflect.Message {
-	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[34]
+func (x *MealComponent) ProtoReflect() protoreflect.Message {
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5236,185 +5359,210 @@ func (x *MealPlanGroceryListItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MealPlanGroceryListItem.ProtoReflect.Descriptor instead.
-func (*MealPlanGroceryListItem) Descriptor() ([]byte, []int) {
-	return file_mealplanning_mealplanning_messages_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use MealComponent.ProtoReflect.Descriptor instead.
+func (*MealComponent) Descriptor() ([]byte, []int) {
+	return file_mealplanning_mealplanning_messages_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *MealPlanGroceryListItem) GetCreatedAt() *timestamppb.Timestamp {
+func (x *MealComponent) GetComponentType() MealComponentType {
 	if x != nil {
-		return x.CreatedAt
+		return x.ComponentType
 	}
-	return nil
+	return MealComponentType_MEAL_COMPONENT_TYPE_UNSPECIFIED
 }
 
-func (x *MealPlanGroceryListItem) GetQuantityPurchased() float32 {
-	if x != nil && x.QuantityPurchased != nil {
-		return *x.QuantityPurchased
+func (x *MealComponent) GetRecipe() *Recipe {
+	if x != nil {
+		return x.Recipe
 	}
-	return 0
+	return nil
 }
 
-func (x *MealPlanGroceryListItem) GetPurchasePrice() float32 {
-	if x != nil && x.PurchasePrice != nil {
-		return *x.PurchasePrice
+func (x *MealComponent) GetRecipeScale() float32 {
+	if x != nil {
+		return x.RecipeScale
 	}
 	return 0
 }
 
-func (x *MealPlanGroceryListItem) GetPurchasedUpc() string {
-	if x != nil && x.PurchasedUpc != nil {
-		return *x.PurchasedUpc
-	}
-	return ""
+type MealPlan struct {
+	state                  protoimpl.MessageState           `protogen:"open.v1"`
+	CreatedAt              *timestamppb.Timestamp           `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	VotingDeadline         *timestamppb.Timestamp           `protobuf:"bytes,2,opt,name=voting_deadline,json=votingDeadline,proto3" json:"voting_deadline,omitempty"`
+	ArchivedAt             *timestamppb.Timestamp           `protobuf:"bytes,3,opt,name=archived_at,json=archivedAt,proto3,oneof" json:"archived_at,omitempty"`
+	LastUpdatedAt          *timestamppb.Timestamp           `protobuf:"bytes,4,opt,name=last_updated_at,json=lastUpdatedAt,proto3,oneof" json:"last_updated_at,omitempty"`
+	Id                     string                           `protobuf:"bytes,5,opt,name=id,proto3" json:"id,omitempty"`
+	Status                 MealPlanStatus                   `protobuf:"varint,6,opt,name=status,proto3,enum=mealplanning.MealPlanStatus" json:"status,omitempty"`
+	Notes                  string                           `protobuf:"bytes,7,opt,name=notes,proto3" json:"notes,omitempty"`
+	ElectionMethod         MealPlanElectionMethod           `protobuf:"varint,8,opt,name=election_method,json=electionMethod,proto3,enum=mealplanning.MealPlanElectionMethod" json:"election_method,omitempty"`
+	BelongsToAccount       string                           `protobuf:"bytes,9,opt,name=belongs_to_account,json=belongsToAccount,proto3" json:"belongs_to_account,omitempty"`
+	CreatedByUser          string                           `protobuf:"bytes,10,opt,name=created_by_user,json=createdByUser,proto3" json:"created_by_user,omitempty"`
+	Events                 []*MealPlanEvent                 `protobuf:"bytes,11,rep,name=events,proto3" json:"events,omitempty"`
+	GroceryListInitialized bool                             `protobuf:"varint,12,opt,name=grocery_list_initialized,json=groceryListInitialized,proto3" json:"grocery_list_initialized,omitempty"`
+	TasksCreated           bool                             `protobuf:"varint,13,opt,name=tasks_created,json=tasksCreated,proto3" json:"tasks_created,omitempty"`
+	Selections             []*MealPlanRecipeOptionSelection `protobuf:"bytes,14,rep,name=selections,proto3" json:"selections,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
-func (x *MealPlanGroceryListItem) GetArchivedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.ArchivedAt
-	}
-	return nil
+func (x *MealPlan) Reset() {
+	*x = MealPlan{}
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *MealPlanGroceryListItem) GetLastUpdatedAt() *timestamppb.Timestamp {
+func (x *MealPlan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MealPlan) ProtoMessage() {}
+
+func (x *MealPlan) ProtoReflect() protoreflect.Message {
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[37]
 	if x != nil {
-		return x.LastUpdatedAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *MealPlanGroceryListItem) GetPurchasedMeasurementUnit() *ValidMeasurementUnit {
+// Deprecated: Use MealPlan.ProtoReflect.Descriptor instead.
+func (*MealPlan) Descriptor() ([]byte, []int) {
+	return file_mealplanning_mealplanning_messages_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *MealPlan) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.PurchasedMeasurementUnit
+		return x.CreatedAt
 	}
 	return nil
 }
 
-func (x *MealPlanGroceryListItem) GetBelongsToMealPlan() string {
+func (x *MealPlan) GetVotingDeadline() *timestamppb.Timestamp {
 	if x != nil {
-		return x.BelongsToMealPlan
+		return x.VotingDeadline
 	}
-	return ""
+	return nil
 }
 
-func (x *MealPlanGroceryListItem) GetStatus() MealPlanGroceryListItemStatus {
+func (x *MealPlan) GetArchivedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Status
+		return x.ArchivedAt
 	}
-	return MealPlanGroceryListItemStatus_MEAL_PLAN_GROCERY_LIST_ITEM_STATUS_UNKNOWN
+	return nil
 }
 
-func (x *MealPlanGroceryListItem) GetStatusExplanation() string {
+func (x *MealPlan) GetLastUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.StatusExplanation
+		return x.LastUpdatedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *MealPlanGroceryListItem) GetId() string {
+func (x *MealPlan) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *MealPlanGroceryListItem) GetMinQuantityNeeded() float32 {
+func (x *MealPlan) GetStatus() MealPlanStatus {
 	if x != nil {
-		return x.MinQuantityNeeded
+		return x.Status
 	}
-	return 0
+	return MealPlanStatus_MEAL_PLAN_STATUS_AWAITING_VOTES
 }
 
-func (x *MealPlanGroceryListItem) GetMaxQuantityNeeded() float32 {
-	if x != nil && x.MaxQuantityNeeded != nil {
-		return *x.MaxQuantityNeeded
+func (x *MealPlan) GetNotes() string {
+	if x != nil {
+		return x.Notes
 	}
-	return 0
+	return ""
 }
 
-func (x *MealPlanGroceryListItem) GetMeasurementUnit() *ValidMeasurementUnit {
+func (x *MealPlan) GetElectionMethod() MealPlanElectionMethod {
 	if x != nil {
-		return x.MeasurementUnit
+		return x.ElectionMethod
 	}
-	return nil
+	return MealPlanElectionMethod_MEAL_PLAN_ELECTION_METHOD_SCHULZE
 }
 
-func (x *MealPlanGroceryListItem) GetIngredient() *ValidIngredient {
+func (x *MealPlan) GetBelongsToAccount() string {
 	if x != nil {
-		return x.Ingredient
+		return x.BelongsToAccount
 	}
-	return nil
+	return ""
 }
 
-func (x *MealPlanGroceryListItem) GetBelongsToMealPlanOption() string {
-	if x != nil && x.BelongsToMealPlanOption != nil {
-		return *x.BelongsToMealPlanOption
+func (x *MealPlan) GetCreatedByUser() string {
+	if x != nil {
+		return x.CreatedByUser
 	}
 	return ""
 }
 
-func (x *MealPlanGroceryListItem) GetRecipeId() string {
-	if x != nil && x.RecipeId != nil {
-		return *x.RecipeId
+func (x *MealPlan) GetEvents() []*MealPlanEvent {
+	if x != nil {
+		return x.Events
 	}
-	return ""
+	return nil
 }
 
-func (x *MealPlanGroceryListItem) GetRecipeStepId() string {
-	if x != nil && x.RecipeStepId != nil {
-		return *x.RecipeStepId
+func (x *MealPlan) GetGroceryListInitialized() bool {
+	if x != nil {
+		return x.GroceryListInitialized
 	}
-	return ""
+	return false
 }
 
-func (x *MealPlanGroceryListItem) GetIngredientIndex() uint32 {
-	if x != nil && x.IngredientIndex != nil {
-		return *x.IngredientIndex
+func (x *MealPlan) GetTasksCreated() bool {
+	if x != nil {
+		return x.TasksCreated
 	}
-	return 0
+	return false
 }
 
-func (x *MealPlanGroceryListItem) GetOptionIndex() uint32 {
-	if x != nil && x.OptionIndex != nil {
-		return *x.OptionIndex
+func (x *MealPlan) GetSelections() []*MealPlanRecipeOptionSelection {
+	if x != nil {
+		return x.Selections
 	}
-	return 0
+	return nil
 }
 
-type MealPlanOption struct {
-	state                  protoimpl.MessageState `protogen:"open.v1"`
-	CreatedAt              *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	LastUpdatedAt          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=last_updated_at,json=lastUpdatedAt,proto3,oneof" json:"last_updated_at,omitempty"`
-	AssignedCook           *string                `protobuf:"bytes,3,opt,name=assigned_cook,json=assignedCook,proto3,oneof" json:"assigned_cook,omitempty"`
-	ArchivedAt             *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=archived_at,json=archivedAt,proto3,oneof" json:"archived_at,omitempty"`
-	AssignedDishwasher     *string                `protobuf:"bytes,5,opt,name=assigned_dishwasher,json=assignedDishwasher,proto3,oneof" json:"assigned_dishwasher,omitempty"`
-	Notes                  string                 `protobuf:"bytes,6,opt,name=notes,proto3" json:"notes,omitempty"`
-	BelongsToMealPlanEvent string                 `protobuf:"bytes,7,opt,name=belongs_to_meal_plan_event,json=belongsToMealPlanEvent,proto3" json:"belongs_to_meal_plan_event,omitempty"`
-	Id                     string                 `protobuf:"bytes,8,opt,name=id,proto3" json:"id,omitempty"`
-	Votes                  []*MealPlanOptionVote  `protobuf:"bytes,9,rep,name=votes,proto3" json:"votes,omitempty"`
-	Meal                   *Meal                  `protobuf:"bytes,10,opt,name=meal,proto3" json:"meal,omitempty"`
-	MealScale              float32                `protobuf:"fixed32,11,opt,name=meal_scale,json=mealScale,proto3" json:"meal_scale,omitempty"`
-	Chosen                 bool                   `protobuf:"varint,12,opt,name=chosen,proto3" json:"chosen,omitempty"`
-	TieBroken              bool                   `protobuf:"varint,13,opt,name=tie_broken,json=tieBroken,proto3" json:"tie_broken,omitempty"`
-	unknownFields          protoimpl.UnknownFields
-	sizeCache              protoimpl.SizeCache
+type MealPlanActivity struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	CreatedAt            *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Id                   string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	EventType            string                 `protobuf:"bytes,3,opt,name=event_type,json=eventType,proto3" json:"event_type,omitempty"`
+	MealPlanEventId      string                 `protobuf:"bytes,4,opt,name=meal_plan_event_id,json=mealPlanEventId,proto3" json:"meal_plan_event_id,omitempty"`
+	MealPlanOptionId     string                 `protobuf:"bytes,5,opt,name=meal_plan_option_id,json=mealPlanOptionId,proto3" json:"meal_plan_option_id,omitempty"`
+	MealPlanOptionVoteId string                 `protobuf:"bytes,6,opt,name=meal_plan_option_vote_id,json=mealPlanOptionVoteId,proto3" json:"meal_plan_option_vote_id,omitempty"`
+	MealPlanTaskId       string                 `protobuf:"bytes,7,opt,name=meal_plan_task_id,json=mealPlanTaskId,proto3" json:"meal_plan_task_id,omitempty"`
+	ByUser               string                 `protobuf:"bytes,8,opt,name=by_user,json=byUser,proto3" json:"by_user,omitempty"`
+	BelongsToMealPlan    string                 `protobuf:"bytes,9,opt,name=belongs_to_meal_plan,json=belongsToMealPlan,proto3" json:"belongs_to_meal_plan,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
 }
 
-func (x *MealPlanOption) Reset() {
-	*x = MealPlanOption{}
-	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[35]
+func (x *MealPlanActivity) Reset() {
+	*x = MealPlanActivity{}
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MealPlanOption) String() string {
+func (x *MealPlanActivity) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MealPlanOption) ProtoMessage() {}
+func (*MealPlanActivity) ProtoMessage() {}
 
-func (x *MealPlanOption) ProtoReflect() protoreflect.Message {
-	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[35]
+func (x *MealPlanActivity) ProtoReflect() protoreflect.Message {
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5425,132 +5573,180 @@ func (x *MealPlanOption) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MealPlanOption.ProtoReflect.Descriptor instead.
-func (*MealPlanOption) Descriptor() ([]byte, []int) {
-	return file_mealplanning_mealplanning_messages_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use MealPlanActivity.ProtoReflect.Descriptor instead.
+func (*MealPlanActivity) Descriptor() ([]byte, []int) {
+	return file_mealplanning_mealplanning_messages_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *MealPlanOption) GetCreatedAt() *timestamppb.Timestamp {
+func (x *MealPlanActivity) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return nil
 }
 
-func (x *MealPlanOption) GetLastUpdatedAt() *timestamppb.Timestamp {
+func (x *MealPlanActivity) GetId() string {
 	if x != nil {
-		return x.LastUpdatedAt
+		return x.Id
 	}
-	return nil
+	return ""
 }
 
-func (x *MealPlanOption) GetAssignedCook() string {
-	if x != nil && x.AssignedCook != nil {
-		return *x.AssignedCook
+func (x *MealPlanActivity) GetEventType() string {
+	if x != nil {
+		return x.EventType
 	}
 	return ""
 }
 
-func (x *MealPlanOption) GetArchivedAt() *timestamppb.Timestamp {
+func (x *MealPlanActivity) GetMealPlanEventId() string {
 	if x != nil {
-		return x.ArchivedAt
+		return x.MealPlanEventId
 	}
-	return nil
+	return ""
 }
 
-func (x *MealPlanOption) GetAssignedDishwasher() string {
-	if x != nil && x.AssignedDishwasher != nil {
-		return *x.AssignedDishwasher
+func (x *MealPlanActivity) GetMealPlanOptionId() string {
+	if x != nil {
+		return x.MealPlanOptionId
 	}
 	return ""
 }
 
-func (x *MealPlanOption) GetNotes() string {
+func (x *MealPlanActivity) GetMealPlanOptionVoteId() string {
 	if x != nil {
-		return x.Notes
+		return x.MealPlanOptionVoteId
 	}
 	return ""
 }
 
-func (x *MealPlanOption) GetBelongsToMealPlanEvent() string {
+func (x *MealPlanActivity) GetMealPlanTaskId() string {
 	if x != nil {
-		return x.BelongsToMealPlanEvent
+		return x.MealPlanTaskId
 	}
 	return ""
 }
 
-func (x *MealPlanOption) GetId() string {
+func (x *MealPlanActivity) GetByUser() string {
 	if x != nil {
-		return x.Id
+		return x.ByUser
 	}
 	return ""
 }
 
-func (x *MealPlanOption) GetVotes() []*MealPlanOptionVote {
+func (x *MealPlanActivity) GetBelongsToMealPlan() string {
 	if x != nil {
-		return x.Votes
+		return x.BelongsToMealPlan
 	}
-	return nil
+	return ""
 }
 
-func (x *MealPlanOption) GetMeal() *Meal {
+type MealPlanCalendarFeed struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Id               string                 `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	Token            string                 `protobuf:"bytes,3,opt,name=token,proto3" json:"token,omitempty"`
+	SubscriptionPath string                 `protobuf:"bytes,4,opt,name=subscription_path,json=subscriptionPath,proto3" json:"subscription_path,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *MealPlanCalendarFeed) Reset() {
+	*x = MealPlanCalendarFeed{}
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MealPlanCalendarFeed) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MealPlanCalendarFeed) ProtoMessage() {}
+
+func (x *MealPlanCalendarFeed) ProtoReflect() protoreflect.Message {
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[39]
 	if x != nil {
-		return x.Meal
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MealPlanCalendarFeed.ProtoReflect.Descriptor instead.
+func (*MealPlanCalendarFeed) Descriptor() ([]byte, []int) {
+	return file_mealplanning_mealplanning_messages_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *MealPlanCalendarFeed) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
 	}
 	return nil
 }
 
-func (x *MealPlanOption) GetMealScale() float32 {
+func (x *MealPlanCalendarFeed) GetId() string {
 	if x != nil {
-		return x.MealScale
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-func (x *MealPlanOption) GetChosen() bool {
+func (x *MealPlanCalendarFeed) GetToken() string {
 	if x != nil {
-		return x.Chosen
+		return x.Token
 	}
-	return false
+	return ""
 }
 
-func (x *MealPlanOption) GetTieBroken() bool {
+func (x *MealPlanCalendarFeed) GetSubscriptionPath() string {
 	if x != nil {
-		return x.TieBroken
+		return x.SubscriptionPath
 	}
-	return false
+	return ""
 }
 
-type MealPlanOptionVote struct {
-	state                   protoimpl.MessageState `protogen:"open.v1"`
-	CreatedAt               *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	ArchivedAt              *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=archived_at,json=archivedAt,proto3,oneof" json:"archived_at,omitempty"`
-	LastUpdatedAt           *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_updated_at,json=lastUpdatedAt,proto3,oneof" json:"last_updated_at,omitempty"`
-	Id                      string                 `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
-	Notes                   string                 `protobuf:"bytes,5,opt,name=notes,proto3" json:"notes,omitempty"`
-	BelongsToMealPlanOption string                 `protobuf:"bytes,6,opt,name=belongs_to_meal_plan_option,json=belongsToMealPlanOption,proto3" json:"belongs_to_meal_plan_option,omitempty"`
-	ByUser                  string                 `protobuf:"bytes,7,opt,name=by_user,json=byUser,proto3" json:"by_user,omitempty"`
-	Rank                    uint32                 `protobuf:"varint,8,opt,name=rank,proto3" json:"rank,omitempty"`
-	Abstain                 bool                   `protobuf:"varint,9,opt,name=abstain,proto3" json:"abstain,omitempty"`
-	unknownFields           protoimpl.UnknownFields
-	sizeCache               protoimpl.SizeCache
+type MealPlanTemplate struct {
+	state                         protoimpl.MessageState  `protogen:"open.v1"`
+	CreatedAt                     *timestamppb.Timestamp  `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	NextInstantiationAt           *timestamppb.Timestamp  `protobuf:"bytes,2,opt,name=next_instantiation_at,json=nextInstantiationAt,proto3" json:"next_instantiation_at,omitempty"`
+	LastInstantiatedAt            *timestamppb.Timestamp  `protobuf:"bytes,3,opt,name=last_instantiated_at,json=lastInstantiatedAt,proto3,oneof" json:"last_instantiated_at,omitempty"`
+	LastUpdatedAt                 *timestamppb.Timestamp  `protobuf:"bytes,4,opt,name=last_updated_at,json=lastUpdatedAt,proto3,oneof" json:"last_updated_at,omitempty"`
+	ArchivedAt                    *timestamppb.Timestamp  `protobuf:"bytes,5,opt,name=archived_at,json=archivedAt,proto3,oneof" json:"archived_at,omitempty"`
+	Id                            string                  `protobuf:"bytes,6,opt,name=id,proto3" json:"id,omitempty"`
+	Name                          string                  `protobuf:"bytes,7,opt,name=name,proto3" json:"name,omitempty"`
+	Notes                         string                  `protobuf:"bytes,8,opt,name=notes,proto3" json:"notes,omitempty"`
+	ElectionMethod                MealPlanElectionMethod  `protobuf:"varint,9,opt,name=election_method,json=electionMethod,proto3,enum=mealplanning.MealPlanElectionMethod" json:"election_method,omitempty"`
+	RecurrenceRule                string                  `protobuf:"bytes,10,opt,name=recurrence_rule,json=recurrenceRule,proto3" json:"recurrence_rule,omitempty"`
+	Timezone                      string                  `protobuf:"bytes,11,opt,name=timezone,proto3" json:"timezone,omitempty"`
+	BelongsToAccount              string                  `protobuf:"bytes,12,opt,name=belongs_to_account,json=belongsToAccount,proto3" json:"belongs_to_account,omitempty"`
+	CreatedByUser                 string                  `protobuf:"bytes,13,opt,name=created_by_user,json=createdByUser,proto3" json:"created_by_user,omitempty"`
+	Slots                         []*MealPlanTemplateSlot `protobuf:"bytes,14,rep,name=slots,proto3" json:"slots,omitempty"`
+	VotingDeadlineOffsetInSeconds uint32                  `protobuf:"varint,15,opt,name=voting_deadline_offset_in_seconds,json=votingDeadlineOffsetInSeconds,proto3" json:"voting_deadline_offset_in_seconds,omitempty"`
+	CandidatesPerSlot             uint32                  `protobuf:"varint,16,opt,name=candidates_per_slot,json=candidatesPerSlot,proto3" json:"candidates_per_slot,omitempty"`
+	AvoidMealsFromLastPlans       uint32                  `protobuf:"varint,17,opt,name=avoid_meals_from_last_plans,json=avoidMealsFromLastPlans,proto3" json:"avoid_meals_from_last_plans,omitempty"`
+	unknownFields                 protoimpl.UnknownFields
+	sizeCache                     protoimpl.SizeCache
 }
 
-func (x *MealPlanOptionVote) Reset() {
-	*x = MealPlanOptionVote{}
-	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[36]
+func (x *MealPlanTemplate) Reset() {
+	*x = MealPlanTemplate{}
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MealPlanOptionVote) String() string {
+func (x *MealPlanTemplate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MealPlanOptionVote) ProtoMessage() {}
+func (*MealPlanTemplate) ProtoMessage() {}
 
-func (x *MealPlanOptionVote) ProtoReflect() protoreflect.Message {
-	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[36]
+func (x *MealPlanTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5561,189 +5757,159 @@ func (x *MealPlanOptionVote) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MealPlanOptionVote.ProtoReflect.Descriptor instead.
-func (*MealPlanOptionVote) Descriptor() ([]byte, []int) {
-	return file_mealplanning_mealplanning_messages_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use MealPlanTemplate.ProtoReflect.Descriptor instead.
+func (*MealPlanTemplate) Descriptor() ([]byte, []int) {
+	return file_mealplanning_mealplanning_messages_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *MealPlanOptionVote) GetCreatedAt() *timestamppb.Timestamp {
+func (x *MealPlanTemplate) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return nil
 }
 
-func (x *MealPlanOptionVote) GetArchivedAt() *timestamppb.Timestamp {
+func (x *MealPlanTemplate) GetNextInstantiationAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.ArchivedAt
+		return x.NextInstantiationAt
 	}
 	return nil
 }
 
-func (x *MealPlanOptionVote) GetLastUpdatedAt() *timestamppb.Timestamp {
+func (x *MealPlanTemplate) GetLastInstantiatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.LastUpdatedAt
+		return x.LastInstantiatedAt
 	}
 	return nil
 }
 
-func (x *MealPlanOptionVote) GetId() string {
+func (x *MealPlanTemplate) GetLastUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Id
+		return x.LastUpdatedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *MealPlanOptionVote) GetNotes() string {
+func (x *MealPlanTemplate) GetArchivedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.Notes
+		return x.ArchivedAt
 	}
-	return ""
+	return nil
 }
 
-func (x *MealPlanOptionVote) GetBelongsToMealPlanOption() string {
+func (x *MealPlanTemplate) GetId() string {
 	if x != nil {
-		return x.BelongsToMealPlanOption
+		return x.Id
 	}
 	return ""
 }
 
-func (x *MealPlanOptionVote) GetByUser() string {
+func (x *MealPlanTemplate) GetName() string {
 	if x != nil {
-		return x.ByUser
+		return x.Name
 	}
 	return ""
 }
 
-func (x *MealPlanOptionVote) GetRank() uint32 {
+func (x *MealPlanTemplate) GetNotes() string {
 	if x != nil {
-		return x.Rank
+		return x.Notes
 	}
-	return 0
+	return ""
 }
 
-func (x *MealPlanOptionVote) GetAbstain() bool {
+func (x *MealPlanTemplate) GetElectionMethod() MealPlanElectionMethod {
 	if x != nil {
-		return x.Abstain
+		return x.ElectionMethod
 	}
-	return false
-}
-
-type MealPlanOptionVoteCreationInput struct {
-	state                   protoimpl.MessageState `protogen:"open.v1"`
-	Id                      string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Notes                   string                 `protobuf:"bytes,2,opt,name=notes,proto3" json:"notes,omitempty"`
-	ByUser                  string                 `protobuf:"bytes,3,opt,name=by_user,json=byUser,proto3" json:"by_user,omitempty"`
-	BelongsToMealPlanOption string                 `protobuf:"bytes,4,opt,name=belongs_to_meal_plan_option,json=belongsToMealPlanOption,proto3" json:"belongs_to_meal_plan_option,omitempty"`
-	Rank                    uint32                 `protobuf:"varint,5,opt,name=rank,proto3" json:"rank,omitempty"`
-	Abstain                 bool                   `protobuf:"varint,6,opt,name=abstain,proto3" json:"abstain,omitempty"`
-	unknownFields           protoimpl.UnknownFields
-	sizeCache               protoimpl.SizeCache
-}
-
-func (x *MealPlanOptionVoteCreationInput) Reset() {
-	*x = MealPlanOptionVoteCreationInput{}
-	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[37]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *MealPlanOptionVoteCreationInput) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return MealPlanElectionMethod_MEAL_PLAN_ELECTION_METHOD_SCHULZE
 }
 
-func (*MealPlanOptionVoteCreationInput) ProtoMessage() {}
-
-func (x *MealPlanOptionVoteCreationInput) ProtoReflect() protoreflect.Message {
-	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[37]
+func (x *MealPlanTemplate) GetRecurrenceRule() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.RecurrenceRule
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use MealPlanOptionVoteCreationInput.ProtoReflect.Descriptor instead.
-func (*MealPlanOptionVoteCreationInput) Descriptor() ([]byte, []int) {
-	return file_mealplanning_mealplanning_messages_proto_rawDescGZIP(), []int{37}
+func (x *MealPlanTemplate) GetTimezone() string {
+	if x != nil {
+		return x.Timezone
+	}
+	return ""
 }
 
-func (x *MealPlanOptionVoteCreationInput) GetId() string {
+func (x *MealPlanTemplate) GetBelongsToAccount() string {
 	if x != nil {
-		return x.Id
+		return x.BelongsToAccount
 	}
 	return ""
 }
 
-func (x *MealPlanOptionVoteCreationInput) GetNotes() string {
+func (x *MealPlanTemplate) GetCreatedByUser() string {
 	if x != nil {
-		return x.Notes
+		return x.CreatedByUser
 	}
 	return ""
 }
 
-func (x *MealPlanOptionVoteCreationInput) GetByUser() string {
+func (x *MealPlanTemplate) GetSlots() []*MealPlanTemplateSlot {
 	if x != nil {
-		return x.ByUser
+		return x.Slots
 	}
-	return ""
+	return nil
 }
 
-func (x *MealPlanOptionVoteCreationInput) GetBelongsToMealPlanOption() string {
+func (x *MealPlanTemplate) GetVotingDeadlineOffsetInSeconds() uint32 {
 	if x != nil {
-		return x.BelongsToMealPlanOption
+		return x.VotingDeadlineOffsetInSeconds
 	}
-	return ""
+	return 0
 }
 
-func (x *MealPlanOptionVoteCreationInput) GetRank() uint32 {
+func (x *MealPlanTemplate) GetCandidatesPerSlot() uint32 {
 	if x != nil {
-		return x.Rank
+		return x.CandidatesPerSlot
 	}
 	return 0
 }
 
-func (x *MealPlanOptionVoteCreationInput) GetAbstain() bool {
+func (x *MealPlanTemplate) GetAvoidMealsFromLastPlans() uint32 {
 	if x != nil {
-		return x.Abstain
+		return x.AvoidMealsFromLastPlans
 	}
-	return false
+	return 0
 }
 
-type MealPlanRecipeOptionSelection struct {
-	state                   protoimpl.MessageState            `protogen:"open.v1"`
-	CreatedAt               *timestamppb.Timestamp            `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	LastUpdatedAt           *timestamppb.Timestamp            `protobuf:"bytes,2,opt,name=last_updated_at,json=lastUpdatedAt,proto3,oneof" json:"last_updated_at,omitempty"`
-	Id                      string                            `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
-	BelongsToMealPlanOption string                            `protobuf:"bytes,4,opt,name=belongs_to_meal_plan_option,json=belongsToMealPlanOption,proto3" json:"belongs_to_meal_plan_option,omitempty"`
-	RecipeId                string                            `protobuf:"bytes,5,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"`
-	RecipeStepId            string                            `protobuf:"bytes,6,opt,name=recipe_step_id,json=recipeStepId,proto3" json:"recipe_step_id,omitempty"`
-	IngredientIndex         uint32                            `protobuf:"varint,7,opt,name=ingredient_index,json=ingredientIndex,proto3" json:"ingredient_index,omitempty"`
-	SelectedOptionIndex     uint32                            `protobuf:"varint,8,opt,name=selected_option_index,json=selectedOptionIndex,proto3" json:"selected_option_index,omitempty"`
-	SelectionType           MealPlanRecipeOptionSelectionType `protobuf:"varint,9,opt,name=selection_type,json=selectionType,proto3,enum=mealplanning.MealPlanRecipeOptionSelectionType" json:"selection_type,omitempty"`
-	ArchivedAt              *timestamppb.Timestamp            `protobuf:"bytes,10,opt,name=archived_at,json=archivedAt,proto3,oneof" json:"archived_at,omitempty"`
-	unknownFields           protoimpl.UnknownFields
-	sizeCache               protoimpl.SizeCache
+type MealPlanTemplateSlot struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	MealListId        *string                `protobuf:"bytes,1,opt,name=meal_list_id,json=mealListId,proto3,oneof" json:"meal_list_id,omitempty"`
+	RecipeListId      *string                `protobuf:"bytes,2,opt,name=recipe_list_id,json=recipeListId,proto3,oneof" json:"recipe_list_id,omitempty"`
+	MealName          MealPlanEventName      `protobuf:"varint,3,opt,name=meal_name,json=mealName,proto3,enum=mealplanning.MealPlanEventName" json:"meal_name,omitempty"`
+	StartTime         string                 `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	Notes             string                 `protobuf:"bytes,5,opt,name=notes,proto3" json:"notes,omitempty"`
+	MealIds           []string               `protobuf:"bytes,6,rep,name=meal_ids,json=mealIds,proto3" json:"meal_ids,omitempty"`
+	DurationInSeconds uint32                 `protobuf:"varint,7,opt,name=duration_in_seconds,json=durationInSeconds,proto3" json:"duration_in_seconds,omitempty"`
+	DayOfWeek         uint32                 `protobuf:"varint,8,opt,name=day_of_week,json=dayOfWeek,proto3" json:"day_of_week,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *MealPlanRecipeOptionSelection) Reset() {
-	*x = MealPlanRecipeOptionSelection{}
-	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[38]
+func (x *MealPlanTemplateSlot) Reset() {
+	*x = MealPlanTemplateSlot{}
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MealPlanRecipeOptionSelection) String() string {
+func (x *MealPlanTemplateSlot) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MealPlanRecipeOptionSelection) ProtoMessage() {}
+func (*MealPlanTemplateSlot) ProtoMessage() {}
 
-func (x *MealPlanRecipeOptionSelection) ProtoReflect() protoreflect.Message {
-	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[38]
+func (x *MealPlanTemplateSlot) ProtoReflect() protoreflect.Message {
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5754,105 +5920,98 @@ func (x *MealPlanRecipeOptionSelection) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MealPlanRecipeOptionSelection.ProtoReflect.Descriptor instead.
-func (*MealPlanRecipeOptionSelection) Descriptor() ([]byte, []int) {
-	return file_mealplanning_mealplanning_messages_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use MealPlanTemplateSlot.ProtoReflect.Descriptor instead.
+func (*MealPlanTemplateSlot) Descriptor() ([]byte, []int) {
+	return file_mealplanning_mealplanning_messages_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *MealPlanRecipeOptionSelection) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
+func (x *MealPlanTemplateSlot) GetMealListId() string {
+	if x != nil && x.MealListId != nil {
+		return *x.MealListId
 	}
-	return nil
+	return ""
 }
 
-func (x *MealPlanRecipeOptionSelection) GetLastUpdatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.LastUpdatedAt
+func (x *MealPlanTemplateSlot) GetRecipeListId() string {
+	if x != nil && x.RecipeListId != nil {
+		return *x.RecipeListId
 	}
-	return nil
+	return ""
 }
 
-func (x *MealPlanRecipeOptionSelection) GetId() string {
+func (x *MealPlanTemplateSlot) GetMealName() MealPlanEventName {
 	if x != nil {
-		return x.Id
+		return x.MealName
 	}
-	return ""
+	return MealPlanEventName_MEAL_PLAN_EVENT_NAME_BREAKFAST
 }
 
-func (x *MealPlanRecipeOptionSelection) GetBelongsToMealPlanOption() string {
+func (x *MealPlanTemplateSlot) GetStartTime() string {
 	if x != nil {
-		return x.BelongsToMealPlanOption
+		return x.StartTime
 	}
 	return ""
 }
 
-func (x *MealPlanRecipeOptionSelection) GetRecipeId() string {
+func (x *MealPlanTemplateSlot) GetNotes() string {
 	if x != nil {
-		return x.RecipeId
+		return x.Notes
 	}
 	return ""
 }
 
-func (x *MealPlanRecipeOptionSelection) GetRecipeStepId() string {
+func (x *MealPlanTemplateSlot) GetMealIds() []string {
 	if x != nil {
-		return x.RecipeStepId
+		return x.MealIds
 	}
-	return ""
+	return nil
 }
 
-func (x *MealPlanRecipeOptionSelection) GetIngredientIndex() uint32 {
+func (x *MealPlanTemplateSlot) GetDurationInSeconds() uint32 {
 	if x != nil {
-		return x.IngredientIndex
+		return x.DurationInSeconds
 	}
 	return 0
 }
 
-func (x *MealPlanRecipeOptionSelection) GetSelectedOptionIndex() uint32 {
+func (x *MealPlanTemplateSlot) GetDayOfWeek() uint32 {
 	if x != nil {
-		return x.SelectedOptionIndex
+		return x.DayOfWeek
 	}
 	return 0
 }
 
-func (x *MealPlanRecipeOptionSelection) GetSelectionType() MealPlanRecipeOptionSelectionType {
-	if x != nil {
-		return x.SelectionType
-	}
-	return MealPlanRecipeOptionSelectionType_MEAL_PLAN_RECIPE_OPTION_SELECTION_TYPE_UNSPECIFIED
+type MealPlanEvent struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	StartsAt          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt            *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	ArchivedAt        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=archived_at,json=archivedAt,proto3,oneof" json:"archived_at,omitempty"`
+	LastUpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=last_updated_at,json=lastUpdatedAt,proto3,oneof" json:"last_updated_at,omitempty"`
+	MealName          MealPlanEventName      `protobuf:"varint,6,opt,name=meal_name,json=mealName,proto3,enum=mealplanning.MealPlanEventName" json:"meal_name,omitempty"`
+	Notes             string                 `protobuf:"bytes,7,opt,name=notes,proto3" json:"notes,omitempty"`
+	BelongsToMealPlan string                 `protobuf:"bytes,8,opt,name=belongs_to_meal_plan,json=belongsToMealPlan,proto3" json:"belongs_to_meal_plan,omitempty"`
+	Id                string                 `protobuf:"bytes,9,opt,name=id,proto3" json:"id,omitempty"`
+	Options           []*MealPlanOption      `protobuf:"bytes,10,rep,name=options,proto3" json:"options,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
-func (x *MealPlanRecipeOptionSelection) GetArchivedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.ArchivedAt
-	}
-	return nil
-}
-
-type MissingVote struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
-	OptionId      string                 `protobuf:"bytes,2,opt,name=option_id,json=optionId,proto3" json:"option_id,omitempty"`
-	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *MissingVote) Reset() {
-	*x = MissingVote{}
-	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[39]
+func (x *MealPlanEvent) Reset() {
+	*x = MealPlanEvent{}
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MissingVote) String() string {
+func (x *MealPlanEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MissingVote) ProtoMessage() {}
+func (*MealPlanEvent) ProtoMessage() {}
 
-func (x *MissingVote) ProtoReflect() protoreflect.Message {
-	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[39]
+func (x *MealPlanEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -5863,161 +6022,115 @@ func (x *MissingVote) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MissingVote.ProtoReflect.Descriptor instead.
-func (*MissingVote) Descriptor() ([]byte, []int) {
-	return file_mealplanning_mealplanning_messages_proto_rawDescGZIP(), []int{39}
-}
-
-func (x *MissingVote) GetEventId() string {
-	if x != nil {
-		return x.EventId
-	}
-	return ""
-}
-
-func (x *MissingVote) GetOptionId() string {
-	if x != nil {
-		return x.OptionId
-	}
-	return ""
+// Deprecated: Use MealPlanEvent.ProtoReflect.Descriptor instead.
+func (*MealPlanEvent) Descriptor() ([]byte, []int) {
+	return file_mealplanning_mealplanning_messages_proto_rawDescGZIP(), []int{42}
 }
 
-func (x *MissingVote) GetUserId() string {
+func (x *MealPlanEvent) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.UserId
+		return x.CreatedAt
 	}
-	return ""
-}
-
-type MealList struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	LastUpdatedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=last_updated_at,json=lastUpdatedAt,proto3,oneof" json:"last_updated_at,omitempty"`
-	ArchivedAt    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=archived_at,json=archivedAt,proto3,oneof" json:"archived_at,omitempty"`
-	Id            string                 `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
-	BelongsToUser string                 `protobuf:"bytes,7,opt,name=belongs_to_user,json=belongsToUser,proto3" json:"belongs_to_user,omitempty"`
-	Items         []*MealListItem        `protobuf:"bytes,8,rep,name=items,proto3" json:"items,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *MealList) Reset() {
-	*x = MealList{}
-	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[40]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *MealList) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return nil
 }
 
-func (*MealList) ProtoMessage() {}
-
-func (x *MealList) ProtoReflect() protoreflect.Message {
-	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[40]
+func (x *MealPlanEvent) GetStartsAt() *timestamppb.Timestamp {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.StartsAt
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use MealList.ProtoReflect.Descriptor instead.
-func (*MealList) Descriptor() ([]byte, []int) {
-	return file_mealplanning_mealplanning_messages_proto_rawDescGZIP(), []int{40}
+	return nil
 }
 
-func (x *MealList) GetCreatedAt() *timestamppb.Timestamp {
+func (x *MealPlanEvent) GetEndsAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.CreatedAt
+		return x.EndsAt
 	}
 	return nil
 }
 
-func (x *MealList) GetLastUpdatedAt() *timestamppb.Timestamp {
+func (x *MealPlanEvent) GetArchivedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.LastUpdatedAt
+		return x.ArchivedAt
 	}
 	return nil
 }
 
-func (x *MealList) GetArchivedAt() *timestamppb.Timestamp {
+func (x *MealPlanEvent) GetLastUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.ArchivedAt
+		return x.LastUpdatedAt
 	}
 	return nil
 }
 
-func (x *MealList) GetId() string {
+func (x *MealPlanEvent) GetMealName() MealPlanEventName {
 	if x != nil {
-		return x.Id
+		return x.MealName
 	}
-	return ""
+	return MealPlanEventName_MEAL_PLAN_EVENT_NAME_BREAKFAST
 }
 
-func (x *MealList) GetName() string {
+func (x *MealPlanEvent) GetNotes() string {
 	if x != nil {
-		return x.Name
+		return x.Notes
 	}
 	return ""
 }
 
-func (x *MealList) GetDescription() string {
+func (x *MealPlanEvent) GetBelongsToMealPlan() string {
 	if x != nil {
-		return x.Description
+		return x.BelongsToMealPlan
 	}
 	return ""
 }
 
-func (x *MealList) GetBelongsToUser() string {
+func (x *MealPlanEvent) GetId() string {
 	if x != nil {
-		return x.BelongsToUser
+		return x.Id
 	}
 	return ""
 }
 
-func (x *MealList) GetItems() []*MealListItem {
+func (x *MealPlanEvent) GetOptions() []*MealPlanOption {
 	if x != nil {
-		return x.Items
+		return x.Options
 	}
 	return nil
 }
 
-type MealListItem struct {
-	state             protoimpl.MessageState `protogen:"open.v1"`
-	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	ArchivedAt        *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=archived_at,json=archivedAt,proto3,oneof" json:"archived_at,omitempty"`
-	LastUpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_updated_at,json=lastUpdatedAt,proto3,oneof" json:"last_updated_at,omitempty"`
-	Id                string                 `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
-	MealId            string                 `protobuf:"bytes,5,opt,name=meal_id,json=mealId,proto3" json:"meal_id,omitempty"`
-	Notes             string                 `protobuf:"bytes,6,opt,name=notes,proto3" json:"notes,omitempty"`
-	BelongsToMealList string                 `protobuf:"bytes,7,opt,name=belongs_to_meal_list,json=belongsToMealList,proto3" json:"belongs_to_meal_list,omitempty"`
-	Meal              *Meal                  `protobuf:"bytes,8,opt,name=meal,proto3" json:"meal,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+type MealPlanEventTallyReport struct {
+	state                  protoimpl.MessageState        `protogen:"open.v1"`
+	CreatedAt              *timestamppb.Timestamp        `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ArchivedAt             *timestamppb.Timestamp        `protobuf:"bytes,2,opt,name=archived_at,json=archivedAt,proto3,oneof" json:"archived_at,omitempty"`
+	TieBreak               *ElectionTieBreak             `protobuf:"bytes,3,opt,name=tie_break,json=tieBreak,proto3,oneof" json:"tie_break,omitempty"`
+	Id                     string                        `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
+	BelongsToMealPlanEvent string                        `protobuf:"bytes,5,opt,name=belongs_to_meal_plan_event,json=belongsToMealPlanEvent,proto3" json:"belongs_to_meal_plan_event,omitempty"`
+	ElectionMethod         MealPlanElectionMethod        `protobuf:"varint,6,opt,name=election_method,json=electionMethod,proto3,enum=mealplanning.MealPlanElectionMethod" json:"election_method,omitempty"`
+	Winner                 string                        `protobuf:"bytes,7,opt,name=winner,proto3" json:"winner,omitempty"`
+	Candidates             []string                      `protobuf:"bytes,8,rep,name=candidates,proto3" json:"candidates,omitempty"`
+	Scores                 []*ElectionCandidateScore     `protobuf:"bytes,9,rep,name=scores,proto3" json:"scores,omitempty"`
+	PairwisePreferences    []*ElectionPairwisePreference `protobuf:"bytes,10,rep,name=pairwise_preferences,json=pairwisePreferences,proto3" json:"pairwise_preferences,omitempty"`
+	Rounds                 []*ElectionRound              `protobuf:"bytes,11,rep,name=rounds,proto3" json:"rounds,omitempty"`
+	BallotCount            uint32                        `protobuf:"varint,12,opt,name=ballot_count,json=ballotCount,proto3" json:"ballot_count,omitempty"`
+	TieBroken              bool                          `protobuf:"varint,13,opt,name=tie_broken,json=tieBroken,proto3" json:"tie_broken,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
 }
 
-func (x *MealListItem) Reset() {
-	*x = MealListItem{}
-	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[41]
+func (x *MealPlanEventTallyReport) Reset() {
+	*x = MealPlanEventTallyReport{}
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MealListItem) String() string {
+func (x *MealPlanEventTallyReport) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MealListItem) ProtoMessage() {}
+func (*MealPlanEventTallyReport) ProtoMessage() {}
 
-func (x *MealListItem) ProtoReflect() protoreflect.Message {
-	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[41]
+func (x *MealPlanEventTallyReport) ProtoReflect() protoreflect.Message {
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6028,96 +6141,125 @@ func (x *MealListItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MealListItem.ProtoReflect.Descriptor instead.
-func (*MealListItem) Descriptor() ([]byte, []int) {
-	return file_mealplanning_mealplanning_messages_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use MealPlanEventTallyReport.ProtoReflect.Descriptor instead.
+func (*MealPlanEventTallyReport) Descriptor() ([]byte, []int) {
+	return file_mealplanning_mealplanning_messages_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *MealListItem) GetCreatedAt() *timestamppb.Timestamp {
+func (x *MealPlanEventTallyReport) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.CreatedAt
 	}
 	return nil
 }
 
-func (x *MealListItem) GetArchivedAt() *timestamppb.Timestamp {
+func (x *MealPlanEventTallyReport) GetArchivedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.ArchivedAt
 	}
 	return nil
 }
 
-func (x *MealListItem) GetLastUpdatedAt() *timestamppb.Timestamp {
+func (x *MealPlanEventTallyReport) GetTieBreak() *ElectionTieBreak {
 	if x != nil {
-		return x.LastUpdatedAt
+		return x.TieBreak
 	}
 	return nil
 }
 
-func (x *MealListItem) GetId() string {
+func (x *MealPlanEventTallyReport) GetId() string {
 	if x != nil {
 		return x.Id
 	}
 	return ""
 }
 
-func (x *MealListItem) GetMealId() string {
+func (x *MealPlanEventTallyReport) GetBelongsToMealPlanEvent() string {
 	if x != nil {
-		return x.MealId
+		return x.BelongsToMealPlanEvent
 	}
 	return ""
 }
 
-func (x *MealListItem) GetNotes() string {
+func (x *MealPlanEventTallyReport) GetElectionMethod() MealPlanElectionMethod {
 	if x != nil {
-		return x.Notes
+		return x.ElectionMethod
 	}
-	return ""
+	return MealPlanElectionMethod_MEAL_PLAN_ELECTION_METHOD_SCHULZE
 }
 
-func (x *MealListItem) GetBelongsToMealList() string {
+func (x *MealPlanEventTallyReport) GetWinner() string {
 	if x != nil {
-		return x.BelongsToMealList
+		return x.Winner
 	}
 	return ""
 }
 
-func (x *MealListItem) GetMeal() *Meal {
+func (x *MealPlanEventTallyReport) GetCandidates() []string {
 	if x != nil {
-		return x.Meal
+		return x.Candidates
 	}
 	return nil
 }
 
-type RecipeList struct {
+func (x *MealPlanEventTallyReport) GetScores() []*ElectionCandidateScore {
+	if x != nil {
+		return x.Scores
+	}
+	return nil
+}
+
+func (x *MealPlanEventTallyReport) GetPairwisePreferences() []*ElectionPairwisePreference {
+	if x != nil {
+		return x.PairwisePreferences
+	}
+	return nil
+}
+
+func (x *MealPlanEventTallyReport) GetRounds() []*ElectionRound {
+	if x != nil {
+		return x.Rounds
+	}
+	return nil
+}
+
+func (x *MealPlanEventTallyReport) GetBallotCount() uint32 {
+	if x != nil {
+		return x.BallotCount
+	}
+	return 0
+}
+
+func (x *MealPlanEventTallyReport) GetTieBroken() bool {
+	if x != nil {
+		return x.TieBroken
+	}
+	return false
+}
+
+type ElectionCandidateScore struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	LastUpdatedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=last_updated_at,json=lastUpdatedAt,proto3,oneof" json:"last_updated_at,omitempty"`
-	ArchivedAt    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=archived_at,json=archivedAt,proto3,oneof" json:"archived_at,omitempty"`
-	Id            string                 `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
-	Name          string                 `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
-	Description   string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
-	BelongsToUser string                 `protobuf:"bytes,7,opt,name=belongs_to_user,json=belongsToUser,proto3" json:"belongs_to_user,omitempty"`
-	Items         []*RecipeListItem      `protobuf:"bytes,8,rep,name=items,proto3" json:"items,omitempty"`
+	Candidate     string                 `protobuf:"bytes,1,opt,name=candidate,proto3" json:"candidate,omitempty"`
+	Score         float64                `protobuf:"fixed64,2,opt,name=score,proto3" json:"score,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RecipeList) Reset() {
-	*x = RecipeList{}
-	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[42]
+func (x *ElectionCandidateScore) Reset() {
+	*x = ElectionCandidateScore{}
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[44]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RecipeList) String() string {
+func (x *ElectionCandidateScore) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecipeList) ProtoMessage() {}
+func (*ElectionCandidateScore) ProtoMessage() {}
 
-func (x *RecipeList) ProtoReflect() protoreflect.Message {
-	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[42]
+func (x *ElectionCandidateScore) ProtoReflect() protoreflect.Message {
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[44]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6128,96 +6270,109 @@ func (x *RecipeList) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecipeList.ProtoReflect.Descriptor instead.
-func (*RecipeList) Descriptor() ([]byte, []int) {
-	return file_mealplanning_mealplanning_messages_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use ElectionCandidateScore.ProtoReflect.Descriptor instead.
+func (*ElectionCandidateScore) Descriptor() ([]byte, []int) {
+	return file_mealplanning_mealplanning_messages_proto_rawDescGZIP(), []int{44}
 }
 
-func (x *RecipeList) GetCreatedAt() *timestamppb.Timestamp {
+func (x *ElectionCandidateScore) GetCandidate() string {
 	if x != nil {
-		return x.CreatedAt
+		return x.Candidate
 	}
-	return nil
+	return ""
 }
 
-func (x *RecipeList) GetLastUpdatedAt() *timestamppb.Timestamp {
+func (x *ElectionCandidateScore) GetScore() float64 {
 	if x != nil {
-		return x.LastUpdatedAt
+		return x.Score
 	}
-	return nil
+	return 0
 }
 
-func (x *RecipeList) GetArchivedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.ArchivedAt
-	}
-	return nil
+type ElectionPairwisePreference struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Candidate     string                 `protobuf:"bytes,1,opt,name=candidate,proto3" json:"candidate,omitempty"`
+	Opponent      string                 `protobuf:"bytes,2,opt,name=opponent,proto3" json:"opponent,omitempty"`
+	Strength      int64                  `protobuf:"varint,3,opt,name=strength,proto3" json:"strength,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RecipeList) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+func (x *ElectionPairwisePreference) Reset() {
+	*x = ElectionPairwisePreference{}
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *RecipeList) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
+func (x *ElectionPairwisePreference) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *RecipeList) GetDescription() string {
+func (*ElectionPairwisePreference) ProtoMessage() {}
+
+func (x *ElectionPairwisePreference) ProtoReflect() protoreflect.Message {
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[45]
 	if x != nil {
-		return x.Description
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ElectionPairwisePreference.ProtoReflect.Descriptor instead.
+func (*ElectionPairwisePreference) Descriptor() ([]byte, []int) {
+	return file_mealplanning_mealplanning_messages_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ElectionPairwisePreference) GetCandidate() string {
+	if x != nil {
+		return x.Candidate
 	}
 	return ""
 }
 
-func (x *RecipeList) GetBelongsToUser() string {
+func (x *ElectionPairwisePreference) GetOpponent() string {
 	if x != nil {
-		return x.BelongsToUser
+		return x.Opponent
 	}
 	return ""
 }
 
-func (x *RecipeList) GetItems() []*RecipeListItem {
+func (x *ElectionPairwisePreference) GetStrength() int64 {
 	if x != nil {
-		return x.Items
+		return x.Strength
 	}
-	return nil
+	return 0
 }
 
-type RecipeListItem struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	CreatedAt           *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	ArchivedAt          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=archived_at,json=archivedAt,proto3,oneof" json:"archived_at,omitempty"`
-	LastUpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_updated_at,json=lastUpdatedAt,proto3,oneof" json:"last_updated_at,omitempty"`
-	Id                  string                 `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
-	RecipeId            string                 `protobuf:"bytes,5,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"`
-	Notes               string                 `protobuf:"bytes,6,opt,name=notes,proto3" json:"notes,omitempty"`
-	BelongsToRecipeList string                 `protobuf:"bytes,7,opt,name=belongs_to_recipe_list,json=belongsToRecipeList,proto3" json:"belongs_to_recipe_list,omitempty"`
-	Recipe              *Recipe                `protobuf:"bytes,8,opt,name=recipe,proto3" json:"recipe,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+type ElectionRound struct {
+	state         protoimpl.MessageState    `protogen:"open.v1"`
+	Counts        []*ElectionCandidateScore `protobuf:"bytes,1,rep,name=counts,proto3" json:"counts,omitempty"`
+	Eliminated    []string                  `protobuf:"bytes,2,rep,name=eliminated,proto3" json:"eliminated,omitempty"`
+	Number        uint32                    `protobuf:"varint,3,opt,name=number,proto3" json:"number,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RecipeListItem) Reset() {
-	*x = RecipeListItem{}
-	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[43]
+func (x *ElectionRound) Reset() {
+	*x = ElectionRound{}
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[46]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RecipeListItem) String() string {
+func (x *ElectionRound) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RecipeListItem) ProtoMessage() {}
+func (*ElectionRound) ProtoMessage() {}
 
-func (x *RecipeListItem) ProtoReflect() protoreflect.Message {
-	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[43]
+func (x *ElectionRound) ProtoReflect() protoreflect.Message {
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[46]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6228,98 +6383,134 @@ func (x *RecipeListItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RecipeListItem.ProtoReflect.Descriptor instead.
-func (*RecipeListItem) Descriptor() ([]byte, []int) {
-	return file_mealplanning_mealplanning_messages_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use ElectionRound.ProtoReflect.Descriptor instead.
+func (*ElectionRound) Descriptor() ([]byte, []int) {
+	return file_mealplanning_mealplanning_messages_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *RecipeListItem) GetCreatedAt() *timestamppb.Timestamp {
+func (x *ElectionRound) GetCounts() []*ElectionCandidateScore {
 	if x != nil {
-		return x.CreatedAt
+		return x.Counts
 	}
 	return nil
 }
 
-func (x *RecipeListItem) GetArchivedAt() *timestamppb.Timestamp {
+func (x *ElectionRound) GetEliminated() []string {
 	if x != nil {
-		return x.ArchivedAt
+		return x.Eliminated
 	}
 	return nil
 }
 
-func (x *RecipeListItem) GetLastUpdatedAt() *timestamppb.Timestamp {
+func (x *ElectionRound) GetNumber() uint32 {
 	if x != nil {
-		return x.LastUpdatedAt
+		return x.Number
 	}
-	return nil
+	return 0
 }
 
-func (x *RecipeListItem) GetId() string {
-	if x != nil {
-		return x.Id
-	}
-	return ""
+type ElectionTieBreak struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Strategy       string                 `protobuf:"bytes,1,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	Chosen         string                 `protobuf:"bytes,2,opt,name=chosen,proto3" json:"chosen,omitempty"`
+	TiedCandidates []string               `protobuf:"bytes,3,rep,name=tied_candidates,json=tiedCandidates,proto3" json:"tied_candidates,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
-func (x *RecipeListItem) GetRecipeId() string {
+func (x *ElectionTieBreak) Reset() {
+	*x = ElectionTieBreak{}
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ElectionTieBreak) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ElectionTieBreak) ProtoMessage() {}
+
+func (x *ElectionTieBreak) ProtoReflect() protoreflect.Message {
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[47]
 	if x != nil {
-		return x.RecipeId
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *RecipeListItem) GetNotes() string {
+// Deprecated: Use ElectionTieBreak.ProtoReflect.Descriptor instead.
+func (*ElectionTieBreak) Descriptor() ([]byte, []int) {
+	return file_mealplanning_mealplanning_messages_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *ElectionTieBreak) GetStrategy() string {
 	if x != nil {
-		return x.Notes
+		return x.Strategy
 	}
 	return ""
 }
 
-func (x *RecipeListItem) GetBelongsToRecipeList() string {
+func (x *ElectionTieBreak) GetChosen() string {
 	if x != nil {
-		return x.BelongsToRecipeList
+		return x.Chosen
 	}
 	return ""
 }
 
-func (x *RecipeListItem) GetRecipe() *Recipe {
+func (x *ElectionTieBreak) GetTiedCandidates() []string {
 	if x != nil {
-		return x.Recipe
+		return x.TiedCandidates
 	}
 	return nil
 }
 
-type MealPlanTask struct {
-	state               protoimpl.MessageState `protogen:"open.v1"`
-	RecipePrepTask      *RecipePrepTask        `protobuf:"bytes,1,opt,name=recipe_prep_task,json=recipePrepTask,proto3" json:"recipe_prep_task,omitempty"`
-	CreatedAt           *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	LastUpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_updated_at,json=lastUpdatedAt,proto3,oneof" json:"last_updated_at,omitempty"`
-	CompletedAt         *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
-	AssignedToUser      *string                `protobuf:"bytes,5,opt,name=assigned_to_user,json=assignedToUser,proto3,oneof" json:"assigned_to_user,omitempty"`
-	Id                  string                 `protobuf:"bytes,6,opt,name=id,proto3" json:"id,omitempty"`
-	Status              MealPlanTaskStatus     `protobuf:"varint,7,opt,name=status,proto3,enum=mealplanning.MealPlanTaskStatus" json:"status,omitempty"`
-	CreationExplanation string                 `protobuf:"bytes,8,opt,name=creation_explanation,json=creationExplanation,proto3" json:"creation_explanation,omitempty"`
-	StatusExplanation   string                 `protobuf:"bytes,9,opt,name=status_explanation,json=statusExplanation,proto3" json:"status_explanation,omitempty"`
-	MealPlanOption      *MealPlanOption        `protobuf:"bytes,10,opt,name=meal_plan_option,json=mealPlanOption,proto3" json:"meal_plan_option,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+type MealPlanGroceryListItem struct {
+	state                    protoimpl.MessageState        `protogen:"open.v1"`
+	CreatedAt                *timestamppb.Timestamp        `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	QuantityPurchased        *float32                      `protobuf:"fixed32,2,opt,name=quantity_purchased,json=quantityPurchased,proto3,oneof" json:"quantity_purchased,omitempty"`
+	PurchasePrice            *float32                      `protobuf:"fixed32,3,opt,name=purchase_price,json=purchasePrice,proto3,oneof" json:"purchase_price,omitempty"`
+	PurchasedUpc             *string                       `protobuf:"bytes,4,opt,name=purchased_upc,json=purchasedUpc,proto3,oneof" json:"purchased_upc,omitempty"`
+	ArchivedAt               *timestamppb.Timestamp        `protobuf:"bytes,5,opt,name=archived_at,json=archivedAt,proto3,oneof" json:"archived_at,omitempty"`
+	LastUpdatedAt            *timestamppb.Timestamp        `protobuf:"bytes,6,opt,name=last_updated_at,json=lastUpdatedAt,proto3,oneof" json:"last_updated_at,omitempty"`
+	PurchasedMeasurementUnit *ValidMeasurementUnit         `protobuf:"bytes,7,opt,name=purchased_measurement_unit,json=purchasedMeasurementUnit,proto3" json:"purchased_measurement_unit,omitempty"`
+	BelongsToMealPlan        string                        `protobuf:"bytes,8,opt,name=belongs_to_meal_plan,json=belongsToMealPlan,proto3" json:"belongs_to_meal_plan,omitempty"`
+	Status                   MealPlanGroceryListItemStatus `protobuf:"varint,9,opt,name=status,proto3,enum=mealplanning.MealPlanGroceryListItemStatus" json:"status,omitempty"`
+	StatusExplanation        string                        `protobuf:"bytes,10,opt,name=status_explanation,json=statusExplanation,proto3" json:"status_explanation,omitempty"`
+	Id                       string                        `protobuf:"bytes,11,opt,name=id,proto3" json:"id,omitempty"`
+	MinQuantityNeeded        float32                       `protobuf:"fixed32,12,opt,name=min_quantity_needed,json=minQuantityNeeded,proto3" json:"min_quantity_needed,omitempty"`
+	MaxQuantityNeeded        *float32                      `protobuf:"fixed32,20,opt,name=max_quantity_needed,json=maxQuantityNeeded,proto3,oneof" json:"max_quantity_needed,omitempty"`
+	MeasurementUnit          *ValidMeasurementUnit         `protobuf:"bytes,13,opt,name=measurement_unit,json=measurementUnit,proto3" json:"measurement_unit,omitempty"`
+	Ingredient               *ValidIngredient              `protobuf:"bytes,14,opt,name=ingredient,proto3" json:"ingredient,omitempty"`
+	// Recipe context (optional - only set when item is part of a choice group)
+	BelongsToMealPlanOption *string `protobuf:"bytes,15,opt,name=belongs_to_meal_plan_option,json=belongsToMealPlanOption,proto3,oneof" json:"belongs_to_meal_plan_option,omitempty"`
+	RecipeId                *string `protobuf:"bytes,16,opt,name=recipe_id,json=recipeId,proto3,oneof" json:"recipe_id,omitempty"`
+	RecipeStepId            *string `protobuf:"bytes,17,opt,name=recipe_step_id,json=recipeStepId,proto3,oneof" json:"recipe_step_id,omitempty"`
+	IngredientIndex         *uint32 `protobuf:"varint,18,opt,name=ingredient_index,json=ingredientIndex,proto3,oneof" json:"ingredient_index,omitempty"`
+	OptionIndex             *uint32 `protobuf:"varint,19,opt,name=option_index,json=optionIndex,proto3,oneof" json:"option_index,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
 }
 
-func (x *MealPlanTask) Reset() {
-	*x = MealPlanTask{}
-	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[44]
+func (x *MealPlanGroceryListItem) Reset() {
+	*x = MealPlanGroceryListItem{}
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[48]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MealPlanTask) String() string {
+func (x *MealPlanGroceryListItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MealPlanTask) ProtoMessage() {}
+func (*MealPlanGroceryListItem) ProtoMessage() {}
 
-func (x *MealPlanTask) ProtoReflect() protoreflect.Message {
-	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[44]
+func (x *MealPlanGroceryListItem) ProtoReflect() protoreflect.Message {
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[48]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -6330,133 +6521,2211 @@ func (x *MealPlanTask) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MealPlanTask.ProtoReflect.Descriptor instead.
-func (*MealPlanTask) Descriptor() ([]byte, []int) {
-	return file_mealplanning_mealplanning_messages_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use MealPlanGroceryListItem.ProtoReflect.Descriptor instead.
+func (*MealPlanGroceryListItem) Descriptor() ([]byte, []int) {
+	return file_mealplanning_mealplanning_messages_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *MealPlanTask) GetRecipePrepTask() *RecipePrepTask {
+func (x *MealPlanGroceryListItem) GetCreatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.RecipePrepTask
+		return x.CreatedAt
 	}
 	return nil
 }
 
-func (x *MealPlanTask) GetCreatedAt() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedAt
+func (x *MealPlanGroceryListItem) GetQuantityPurchased() float32 {
+	if x != nil && x.QuantityPurchased != nil {
+		return *x.QuantityPurchased
 	}
-	return nil
+	return 0
 }
 
-func (x *MealPlanTask) GetLastUpdatedAt() *timestamppb.Timestamp {
+func (x *MealPlanGroceryListItem) GetPurchasePrice() float32 {
+	if x != nil && x.PurchasePrice != nil {
+		return *x.PurchasePrice
+	}
+	return 0
+}
+
+func (x *MealPlanGroceryListItem) GetPurchasedUpc() string {
+	if x != nil && x.PurchasedUpc != nil {
+		return *x.PurchasedUpc
+	}
+	return ""
+}
+
+func (x *MealPlanGroceryListItem) GetArchivedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.LastUpdatedAt
+		return x.ArchivedAt
 	}
 	return nil
 }
 
-func (x *MealPlanTask) GetCompletedAt() *timestamppb.Timestamp {
+func (x *MealPlanGroceryListItem) GetLastUpdatedAt() *timestamppb.Timestamp {
 	if x != nil {
-		return x.CompletedAt
+		return x.LastUpdatedAt
 	}
 	return nil
 }
 
-func (x *MealPlanTask) GetAssignedToUser() string {
-	if x != nil && x.AssignedToUser != nil {
-		return *x.AssignedToUser
+func (x *MealPlanGroceryListItem) GetPurchasedMeasurementUnit() *ValidMeasurementUnit {
+	if x != nil {
+		return x.PurchasedMeasurementUnit
 	}
-	return ""
+	return nil
 }
 
-func (x *MealPlanTask) GetId() string {
+func (x *MealPlanGroceryListItem) GetBelongsToMealPlan() string {
 	if x != nil {
-		return x.Id
+		return x.BelongsToMealPlan
 	}
 	return ""
 }
 
-func (x *MealPlanTask) GetStatus() MealPlanTaskStatus {
+func (x *MealPlanGroceryListItem) GetStatus() MealPlanGroceryListItemStatus {
 	if x != nil {
 		return x.Status
 	}
-	return MealPlanTaskStatus_MEAL_PLAN_TASK_STATUS_UNFINISHED
+	return MealPlanGroceryListItemStatus_MEAL_PLAN_GROCERY_LIST_ITEM_STATUS_UNKNOWN
 }
 
-func (x *MealPlanTask) GetCreationExplanation() string {
+func (x *MealPlanGroceryListItem) GetStatusExplanation() string {
 	if x != nil {
-		return x.CreationExplanation
+		return x.StatusExplanation
 	}
 	return ""
 }
 
-func (x *MealPlanTask) GetStatusExplanation() string {
+func (x *MealPlanGroceryListItem) GetId() string {
 	if x != nil {
-		return x.StatusExplanation
+		return x.Id
 	}
 	return ""
 }
 
-func (x *MealPlanTask) GetMealPlanOption() *MealPlanOption {
+func (x *MealPlanGroceryListItem) GetMinQuantityNeeded() float32 {
 	if x != nil {
-		return x.MealPlanOption
+		return x.MinQuantityNeeded
 	}
-	return nil
-}
-
-type AccountInstrumentOwnership struct {
-	state            protoimpl.MessageState `protogen:"open.v1"`
-	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	ArchivedAt       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=archived_at,json=archivedAt,proto3,oneof" json:"archived_at,omitempty"`
-	LastUpdatedAt    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_updated_at,json=lastUpdatedAt,proto3,oneof" json:"last_updated_at,omitempty"`
-	Id               string                 `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
-	Notes            string                 `protobuf:"bytes,5,opt,name=notes,proto3" json:"notes,omitempty"`
-	BelongsToAccount string                 `protobuf:"bytes,6,opt,name=belongs_to_account,json=belongsToAccount,proto3" json:"belongs_to_account,omitempty"`
-	Instrument       *ValidInstrument       `protobuf:"bytes,7,opt,name=instrument,proto3" json:"instrument,omitempty"`
-	Quantity         uint32                 `protobuf:"varint,8,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	unknownFields    protoimpl.UnknownFields
-	sizeCache        protoimpl.SizeCache
-}
-
-func (x *AccountInstrumentOwnership) Reset() {
-	*x = AccountInstrumentOwnership{}
-	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[45]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+	return 0
 }
 
-func (x *AccountInstrumentOwnership) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *MealPlanGroceryListItem) GetMaxQuantityNeeded() float32 {
+	if x != nil && x.MaxQuantityNeeded != nil {
+		return *x.MaxQuantityNeeded
+	}
+	return 0
 }
 
-func (*AccountInstrumentOwnership) ProtoMessage() {}
-
-func (x *AccountInstrumentOwnership) ProtoReflect() protoreflect.Message {
-	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[45]
+func (x *MealPlanGroceryListItem) GetMeasurementUnit() *ValidMeasurementUnit {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.MeasurementUnit
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use AccountInstrumentOwnership.ProtoReflect.Descriptor instead.
-func (*AccountInstrumentOwnership) Descriptor() ([]byte, []int) {
-	return file_mealplanning_mealplanning_messages_proto_rawDescGZIP(), []int{45}
+	return nil
 }
 
-func (x *AccountInstrumentOwnership) GetCreatedAt() *timestamppb.Timestamp {
+func (x *MealPlanGroceryListItem) GetIngredient() *ValidIngredient {
 	if x != nil {
-		return x.CreatedAt
+		return x.Ingredient
 	}
 	return nil
 }
 
-func (x *AccountInstrumentOwnership) GetArchivedAt() *timestamppb.Timestamp {
+func (x *MealPlanGroceryListItem) GetBelongsToMealPlanOption() string {
+	if x != nil && x.BelongsToMealPlanOption != nil {
+		return *x.BelongsToMealPlanOption
+	}
+	return ""
+}
+
+func (x *MealPlanGroceryListItem) GetRecipeId() string {
+	if x != nil && x.RecipeId != nil {
+		return *x.RecipeId
+	}
+	return ""
+}
+
+func (x *MealPlanGroceryListItem) GetRecipeStepId() string {
+	if x != nil && x.RecipeStepId != nil {
+		return *x.RecipeStepId
+	}
+	return ""
+}
+
+func (x *MealPlanGroceryListItem) GetIngredientIndex() uint32 {
+	if x != nil && x.IngredientIndex != nil {
+		return *x.IngredientIndex
+	}
+	return 0
+}
+
+func (x *MealPlanGroceryListItem) GetOptionIndex() uint32 {
+	if x != nil && x.OptionIndex != nil {
+		return *x.OptionIndex
+	}
+	return 0
+}
+
+type MealPlanOption struct {
+	state                  protoimpl.MessageState           `protogen:"open.v1"`
+	CreatedAt              *timestamppb.Timestamp           `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastUpdatedAt          *timestamppb.Timestamp           `protobuf:"bytes,2,opt,name=last_updated_at,json=lastUpdatedAt,proto3,oneof" json:"last_updated_at,omitempty"`
+	AssignedCook           *string                          `protobuf:"bytes,3,opt,name=assigned_cook,json=assignedCook,proto3,oneof" json:"assigned_cook,omitempty"`
+	ArchivedAt             *timestamppb.Timestamp           `protobuf:"bytes,4,opt,name=archived_at,json=archivedAt,proto3,oneof" json:"archived_at,omitempty"`
+	AssignedDishwasher     *string                          `protobuf:"bytes,5,opt,name=assigned_dishwasher,json=assignedDishwasher,proto3,oneof" json:"assigned_dishwasher,omitempty"`
+	Notes                  string                           `protobuf:"bytes,6,opt,name=notes,proto3" json:"notes,omitempty"`
+	BelongsToMealPlanEvent string                           `protobuf:"bytes,7,opt,name=belongs_to_meal_plan_event,json=belongsToMealPlanEvent,proto3" json:"belongs_to_meal_plan_event,omitempty"`
+	Id                     string                           `protobuf:"bytes,8,opt,name=id,proto3" json:"id,omitempty"`
+	Votes                  []*MealPlanOptionVote            `protobuf:"bytes,9,rep,name=votes,proto3" json:"votes,omitempty"`
+	Meal                   *Meal                            `protobuf:"bytes,10,opt,name=meal,proto3" json:"meal,omitempty"`
+	MealScale              float32                          `protobuf:"fixed32,11,opt,name=meal_scale,json=mealScale,proto3" json:"meal_scale,omitempty"`
+	Chosen                 bool                             `protobuf:"varint,12,opt,name=chosen,proto3" json:"chosen,omitempty"`
+	TieBroken              bool                             `protobuf:"varint,13,opt,name=tie_broken,json=tieBroken,proto3" json:"tie_broken,omitempty"`
+	AllergenWarnings       []*MealPlanOptionAllergenWarning `protobuf:"bytes,14,rep,name=allergen_warnings,json=allergenWarnings,proto3" json:"allergen_warnings,omitempty"`
+	CompletedAt            *timestamppb.Timestamp           `protobuf:"bytes,15,opt,name=completed_at,json=completedAt,proto3,oneof" json:"completed_at,omitempty"`
+	RecipeRevisions        []*MealPlanOptionRecipeRevision  `protobuf:"bytes,16,rep,name=recipe_revisions,json=recipeRevisions,proto3" json:"recipe_revisions,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *MealPlanOption) Reset() {
+	*x = MealPlanOption{}
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MealPlanOption) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MealPlanOption) ProtoMessage() {}
+
+func (x *MealPlanOption) ProtoReflect() protoreflect.Message {
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MealPlanOption.ProtoReflect.Descriptor instead.
+func (*MealPlanOption) Descriptor() ([]byte, []int) {
+	return file_mealplanning_mealplanning_messages_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *MealPlanOption) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *MealPlanOption) GetLastUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUpdatedAt
+	}
+	return nil
+}
+
+func (x *MealPlanOption) GetAssignedCook() string {
+	if x != nil && x.AssignedCook != nil {
+		return *x.AssignedCook
+	}
+	return ""
+}
+
+func (x *MealPlanOption) GetArchivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArchivedAt
+	}
+	return nil
+}
+
+func (x *MealPlanOption) GetAssignedDishwasher() string {
+	if x != nil && x.AssignedDishwasher != nil {
+		return *x.AssignedDishwasher
+	}
+	return ""
+}
+
+func (x *MealPlanOption) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *MealPlanOption) GetBelongsToMealPlanEvent() string {
+	if x != nil {
+		return x.BelongsToMealPlanEvent
+	}
+	return ""
+}
+
+func (x *MealPlanOption) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MealPlanOption) GetVotes() []*MealPlanOptionVote {
+	if x != nil {
+		return x.Votes
+	}
+	return nil
+}
+
+func (x *MealPlanOption) GetMeal() *Meal {
+	if x != nil {
+		return x.Meal
+	}
+	return nil
+}
+
+func (x *MealPlanOption) GetMealScale() float32 {
+	if x != nil {
+		return x.MealScale
+	}
+	return 0
+}
+
+func (x *MealPlanOption) GetChosen() bool {
+	if x != nil {
+		return x.Chosen
+	}
+	return false
+}
+
+func (x *MealPlanOption) GetTieBroken() bool {
+	if x != nil {
+		return x.TieBroken
+	}
+	return false
+}
+
+func (x *MealPlanOption) GetAllergenWarnings() []*MealPlanOptionAllergenWarning {
+	if x != nil {
+		return x.AllergenWarnings
+	}
+	return nil
+}
+
+func (x *MealPlanOption) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *MealPlanOption) GetRecipeRevisions() []*MealPlanOptionRecipeRevision {
+	if x != nil {
+		return x.RecipeRevisions
+	}
+	return nil
+}
+
+type MealPlanOptionRecipeRevision struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	RecipeId         string                 `protobuf:"bytes,1,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"`
+	RecipeRevisionId string                 `protobuf:"bytes,2,opt,name=recipe_revision_id,json=recipeRevisionId,proto3" json:"recipe_revision_id,omitempty"`
+	RevisionNumber   uint32                 `protobuf:"varint,3,opt,name=revision_number,json=revisionNumber,proto3" json:"revision_number,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *MealPlanOptionRecipeRevision) Reset() {
+	*x = MealPlanOptionRecipeRevision{}
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MealPlanOptionRecipeRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MealPlanOptionRecipeRevision) ProtoMessage() {}
+
+func (x *MealPlanOptionRecipeRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MealPlanOptionRecipeRevision.ProtoReflect.Descriptor instead.
+func (*MealPlanOptionRecipeRevision) Descriptor() ([]byte, []int) {
+	return file_mealplanning_mealplanning_messages_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *MealPlanOptionRecipeRevision) GetRecipeId() string {
+	if x != nil {
+		return x.RecipeId
+	}
+	return ""
+}
+
+func (x *MealPlanOptionRecipeRevision) GetRecipeRevisionId() string {
+	if x != nil {
+		return x.RecipeRevisionId
+	}
+	return ""
+}
+
+func (x *MealPlanOptionRecipeRevision) GetRevisionNumber() uint32 {
+	if x != nil {
+		return x.RevisionNumber
+	}
+	return 0
+}
+
+type MealPlanOptionAllergenWarning struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	UserId            string                 `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	RecipeId          string                 `protobuf:"bytes,2,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"`
+	RecipeStepId      string                 `protobuf:"bytes,3,opt,name=recipe_step_id,json=recipeStepId,proto3" json:"recipe_step_id,omitempty"`
+	ValidIngredientId string                 `protobuf:"bytes,4,opt,name=valid_ingredient_id,json=validIngredientId,proto3" json:"valid_ingredient_id,omitempty"`
+	IngredientName    string                 `protobuf:"bytes,5,opt,name=ingredient_name,json=ingredientName,proto3" json:"ingredient_name,omitempty"`
+	Reason            string                 `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	Allergens         []string               `protobuf:"bytes,7,rep,name=allergens,proto3" json:"allergens,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *MealPlanOptionAllergenWarning) Reset() {
+	*x = MealPlanOptionAllergenWarning{}
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MealPlanOptionAllergenWarning) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MealPlanOptionAllergenWarning) ProtoMessage() {}
+
+func (x *MealPlanOptionAllergenWarning) ProtoReflect() protoreflect.Message {
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MealPlanOptionAllergenWarning.ProtoReflect.Descriptor instead.
+func (*MealPlanOptionAllergenWarning) Descriptor() ([]byte, []int) {
+	return file_mealplanning_mealplanning_messages_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *MealPlanOptionAllergenWarning) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+func (x *MealPlanOptionAllergenWarning) GetRecipeId() string {
+	if x != nil {
+		return x.RecipeId
+	}
+	return ""
+}
+
+func (x *MealPlanOptionAllergenWarning) GetRecipeStepId() string {
+	if x != nil {
+		return x.RecipeStepId
+	}
+	return ""
+}
+
+func (x *MealPlanOptionAllergenWarning) GetValidIngredientId() string {
+	if x != nil {
+		return x.ValidIngredientId
+	}
+	return ""
+}
+
+func (x *MealPlanOptionAllergenWarning) GetIngredientName() string {
+	if x != nil {
+		return x.IngredientName
+	}
+	return ""
+}
+
+func (x *MealPlanOptionAllergenWarning) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *MealPlanOptionAllergenWarning) GetAllergens() []string {
+	if x != nil {
+		return x.Allergens
+	}
+	return nil
+}
+
+type MealPlanOptionVote struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	CreatedAt               *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ArchivedAt              *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=archived_at,json=archivedAt,proto3,oneof" json:"archived_at,omitempty"`
+	LastUpdatedAt           *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_updated_at,json=lastUpdatedAt,proto3,oneof" json:"last_updated_at,omitempty"`
+	Id                      string                 `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
+	Notes                   string                 `protobuf:"bytes,5,opt,name=notes,proto3" json:"notes,omitempty"`
+	BelongsToMealPlanOption string                 `protobuf:"bytes,6,opt,name=belongs_to_meal_plan_option,json=belongsToMealPlanOption,proto3" json:"belongs_to_meal_plan_option,omitempty"`
+	ByUser                  string                 `protobuf:"bytes,7,opt,name=by_user,json=byUser,proto3" json:"by_user,omitempty"`
+	Rank                    uint32                 `protobuf:"varint,8,opt,name=rank,proto3" json:"rank,omitempty"`
+	Abstain                 bool                   `protobuf:"varint,9,opt,name=abstain,proto3" json:"abstain,omitempty"`
+	Score                   uint32                 `protobuf:"varint,10,opt,name=score,proto3" json:"score,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *MealPlanOptionVote) Reset() {
+	*x = MealPlanOptionVote{}
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MealPlanOptionVote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MealPlanOptionVote) ProtoMessage() {}
+
+func (x *MealPlanOptionVote) ProtoReflect() protoreflect.Message {
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MealPlanOptionVote.ProtoReflect.Descriptor instead.
+func (*MealPlanOptionVote) Descriptor() ([]byte, []int) {
+	return file_mealplanning_mealplanning_messages_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *MealPlanOptionVote) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *MealPlanOptionVote) GetArchivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArchivedAt
+	}
+	return nil
+}
+
+func (x *MealPlanOptionVote) GetLastUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUpdatedAt
+	}
+	return nil
+}
+
+func (x *MealPlanOptionVote) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MealPlanOptionVote) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *MealPlanOptionVote) GetBelongsToMealPlanOption() string {
+	if x != nil {
+		return x.BelongsToMealPlanOption
+	}
+	return ""
+}
+
+func (x *MealPlanOptionVote) GetByUser() string {
+	if x != nil {
+		return x.ByUser
+	}
+	return ""
+}
+
+func (x *MealPlanOptionVote) GetRank() uint32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *MealPlanOptionVote) GetAbstain() bool {
+	if x != nil {
+		return x.Abstain
+	}
+	return false
+}
+
+func (x *MealPlanOptionVote) GetScore() uint32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type MealPlanOptionVoteCreationInput struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	Id                      string                 `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Notes                   string                 `protobuf:"bytes,2,opt,name=notes,proto3" json:"notes,omitempty"`
+	ByUser                  string                 `protobuf:"bytes,3,opt,name=by_user,json=byUser,proto3" json:"by_user,omitempty"`
+	BelongsToMealPlanOption string                 `protobuf:"bytes,4,opt,name=belongs_to_meal_plan_option,json=belongsToMealPlanOption,proto3" json:"belongs_to_meal_plan_option,omitempty"`
+	Rank                    uint32                 `protobuf:"varint,5,opt,name=rank,proto3" json:"rank,omitempty"`
+	Abstain                 bool                   `protobuf:"varint,6,opt,name=abstain,proto3" json:"abstain,omitempty"`
+	Score                   uint32                 `protobuf:"varint,7,opt,name=score,proto3" json:"score,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *MealPlanOptionVoteCreationInput) Reset() {
+	*x = MealPlanOptionVoteCreationInput{}
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MealPlanOptionVoteCreationInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MealPlanOptionVoteCreationInput) ProtoMessage() {}
+
+func (x *MealPlanOptionVoteCreationInput) ProtoReflect() protoreflect.Message {
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MealPlanOptionVoteCreationInput.ProtoReflect.Descriptor instead.
+func (*MealPlanOptionVoteCreationInput) Descriptor() ([]byte, []int) {
+	return file_mealplanning_mealplanning_messages_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *MealPlanOptionVoteCreationInput) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MealPlanOptionVoteCreationInput) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *MealPlanOptionVoteCreationInput) GetByUser() string {
+	if x != nil {
+		return x.ByUser
+	}
+	return ""
+}
+
+func (x *MealPlanOptionVoteCreationInput) GetBelongsToMealPlanOption() string {
+	if x != nil {
+		return x.BelongsToMealPlanOption
+	}
+	return ""
+}
+
+func (x *MealPlanOptionVoteCreationInput) GetRank() uint32 {
+	if x != nil {
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *MealPlanOptionVoteCreationInput) GetAbstain() bool {
+	if x != nil {
+		return x.Abstain
+	}
+	return false
+}
+
+func (x *MealPlanOptionVoteCreationInput) GetScore() uint32 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+type MealPlanRecipeOptionSelection struct {
+	state                   protoimpl.MessageState            `protogen:"open.v1"`
+	CreatedAt               *timestamppb.Timestamp            `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastUpdatedAt           *timestamppb.Timestamp            `protobuf:"bytes,2,opt,name=last_updated_at,json=lastUpdatedAt,proto3,oneof" json:"last_updated_at,omitempty"`
+	Id                      string                            `protobuf:"bytes,3,opt,name=id,proto3" json:"id,omitempty"`
+	BelongsToMealPlanOption string                            `protobuf:"bytes,4,opt,name=belongs_to_meal_plan_option,json=belongsToMealPlanOption,proto3" json:"belongs_to_meal_plan_option,omitempty"`
+	RecipeId                string                            `protobuf:"bytes,5,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"`
+	RecipeStepId            string                            `protobuf:"bytes,6,opt,name=recipe_step_id,json=recipeStepId,proto3" json:"recipe_step_id,omitempty"`
+	IngredientIndex         uint32                            `protobuf:"varint,7,opt,name=ingredient_index,json=ingredientIndex,proto3" json:"ingredient_index,omitempty"`
+	SelectedOptionIndex     uint32                            `protobuf:"varint,8,opt,name=selected_option_index,json=selectedOptionIndex,proto3" json:"selected_option_index,omitempty"`
+	SelectionType           MealPlanRecipeOptionSelectionType `protobuf:"varint,9,opt,name=selection_type,json=selectionType,proto3,enum=mealplanning.MealPlanRecipeOptionSelectionType" json:"selection_type,omitempty"`
+	ArchivedAt              *timestamppb.Timestamp            `protobuf:"bytes,10,opt,name=archived_at,json=archivedAt,proto3,oneof" json:"archived_at,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *MealPlanRecipeOptionSelection) Reset() {
+	*x = MealPlanRecipeOptionSelection{}
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MealPlanRecipeOptionSelection) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MealPlanRecipeOptionSelection) ProtoMessage() {}
+
+func (x *MealPlanRecipeOptionSelection) ProtoReflect() protoreflect.Message {
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MealPlanRecipeOptionSelection.ProtoReflect.Descriptor instead.
+func (*MealPlanRecipeOptionSelection) Descriptor() ([]byte, []int) {
+	return file_mealplanning_mealplanning_messages_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *MealPlanRecipeOptionSelection) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *MealPlanRecipeOptionSelection) GetLastUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUpdatedAt
+	}
+	return nil
+}
+
+func (x *MealPlanRecipeOptionSelection) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MealPlanRecipeOptionSelection) GetBelongsToMealPlanOption() string {
+	if x != nil {
+		return x.BelongsToMealPlanOption
+	}
+	return ""
+}
+
+func (x *MealPlanRecipeOptionSelection) GetRecipeId() string {
+	if x != nil {
+		return x.RecipeId
+	}
+	return ""
+}
+
+func (x *MealPlanRecipeOptionSelection) GetRecipeStepId() string {
+	if x != nil {
+		return x.RecipeStepId
+	}
+	return ""
+}
+
+func (x *MealPlanRecipeOptionSelection) GetIngredientIndex() uint32 {
+	if x != nil {
+		return x.IngredientIndex
+	}
+	return 0
+}
+
+func (x *MealPlanRecipeOptionSelection) GetSelectedOptionIndex() uint32 {
+	if x != nil {
+		return x.SelectedOptionIndex
+	}
+	return 0
+}
+
+func (x *MealPlanRecipeOptionSelection) GetSelectionType() MealPlanRecipeOptionSelectionType {
+	if x != nil {
+		return x.SelectionType
+	}
+	return MealPlanRecipeOptionSelectionType_MEAL_PLAN_RECIPE_OPTION_SELECTION_TYPE_UNSPECIFIED
+}
+
+func (x *MealPlanRecipeOptionSelection) GetArchivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArchivedAt
+	}
+	return nil
+}
+
+type MissingVote struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	EventId       string                 `protobuf:"bytes,1,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`
+	OptionId      string                 `protobuf:"bytes,2,opt,name=option_id,json=optionId,proto3" json:"option_id,omitempty"`
+	UserId        string                 `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MissingVote) Reset() {
+	*x = MissingVote{}
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MissingVote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MissingVote) ProtoMessage() {}
+
+func (x *MissingVote) ProtoReflect() protoreflect.Message {
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MissingVote.ProtoReflect.Descriptor instead.
+func (*MissingVote) Descriptor() ([]byte, []int) {
+	return file_mealplanning_mealplanning_messages_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *MissingVote) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *MissingVote) GetOptionId() string {
+	if x != nil {
+		return x.OptionId
+	}
+	return ""
+}
+
+func (x *MissingVote) GetUserId() string {
+	if x != nil {
+		return x.UserId
+	}
+	return ""
+}
+
+type MealList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastUpdatedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=last_updated_at,json=lastUpdatedAt,proto3,oneof" json:"last_updated_at,omitempty"`
+	ArchivedAt    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=archived_at,json=archivedAt,proto3,oneof" json:"archived_at,omitempty"`
+	Id            string                 `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	BelongsToUser string                 `protobuf:"bytes,7,opt,name=belongs_to_user,json=belongsToUser,proto3" json:"belongs_to_user,omitempty"`
+	Items         []*MealListItem        `protobuf:"bytes,8,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MealList) Reset() {
+	*x = MealList{}
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MealList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MealList) ProtoMessage() {}
+
+func (x *MealList) ProtoReflect() protoreflect.Message {
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MealList.ProtoReflect.Descriptor instead.
+func (*MealList) Descriptor() ([]byte, []int) {
+	return file_mealplanning_mealplanning_messages_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *MealList) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *MealList) GetLastUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUpdatedAt
+	}
+	return nil
+}
+
+func (x *MealList) GetArchivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArchivedAt
+	}
+	return nil
+}
+
+func (x *MealList) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MealList) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MealList) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *MealList) GetBelongsToUser() string {
+	if x != nil {
+		return x.BelongsToUser
+	}
+	return ""
+}
+
+func (x *MealList) GetItems() []*MealListItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type MealListItem struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	CreatedAt         *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ArchivedAt        *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=archived_at,json=archivedAt,proto3,oneof" json:"archived_at,omitempty"`
+	LastUpdatedAt     *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_updated_at,json=lastUpdatedAt,proto3,oneof" json:"last_updated_at,omitempty"`
+	Id                string                 `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
+	MealId            string                 `protobuf:"bytes,5,opt,name=meal_id,json=mealId,proto3" json:"meal_id,omitempty"`
+	Notes             string                 `protobuf:"bytes,6,opt,name=notes,proto3" json:"notes,omitempty"`
+	BelongsToMealList string                 `protobuf:"bytes,7,opt,name=belongs_to_meal_list,json=belongsToMealList,proto3" json:"belongs_to_meal_list,omitempty"`
+	Meal              *Meal                  `protobuf:"bytes,8,opt,name=meal,proto3" json:"meal,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *MealListItem) Reset() {
+	*x = MealListItem{}
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MealListItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MealListItem) ProtoMessage() {}
+
+func (x *MealListItem) ProtoReflect() protoreflect.Message {
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MealListItem.ProtoReflect.Descriptor instead.
+func (*MealListItem) Descriptor() ([]byte, []int) {
+	return file_mealplanning_mealplanning_messages_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *MealListItem) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *MealListItem) GetArchivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArchivedAt
+	}
+	return nil
+}
+
+func (x *MealListItem) GetLastUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUpdatedAt
+	}
+	return nil
+}
+
+func (x *MealListItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MealListItem) GetMealId() string {
+	if x != nil {
+		return x.MealId
+	}
+	return ""
+}
+
+func (x *MealListItem) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *MealListItem) GetBelongsToMealList() string {
+	if x != nil {
+		return x.BelongsToMealList
+	}
+	return ""
+}
+
+func (x *MealListItem) GetMeal() *Meal {
+	if x != nil {
+		return x.Meal
+	}
+	return nil
+}
+
+type RecipeList struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	CreatedAt     *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastUpdatedAt *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=last_updated_at,json=lastUpdatedAt,proto3,oneof" json:"last_updated_at,omitempty"`
+	ArchivedAt    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=archived_at,json=archivedAt,proto3,oneof" json:"archived_at,omitempty"`
+	Id            string                 `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
+	Name          string                 `protobuf:"bytes,5,opt,name=name,proto3" json:"name,omitempty"`
+	Description   string                 `protobuf:"bytes,6,opt,name=description,proto3" json:"description,omitempty"`
+	BelongsToUser string                 `protobuf:"bytes,7,opt,name=belongs_to_user,json=belongsToUser,proto3" json:"belongs_to_user,omitempty"`
+	Items         []*RecipeListItem      `protobuf:"bytes,8,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecipeList) Reset() {
+	*x = RecipeList{}
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecipeList) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecipeList) ProtoMessage() {}
+
+func (x *RecipeList) ProtoReflect() protoreflect.Message {
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecipeList.ProtoReflect.Descriptor instead.
+func (*RecipeList) Descriptor() ([]byte, []int) {
+	return file_mealplanning_mealplanning_messages_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *RecipeList) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *RecipeList) GetLastUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUpdatedAt
+	}
+	return nil
+}
+
+func (x *RecipeList) GetArchivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArchivedAt
+	}
+	return nil
+}
+
+func (x *RecipeList) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RecipeList) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *RecipeList) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *RecipeList) GetBelongsToUser() string {
+	if x != nil {
+		return x.BelongsToUser
+	}
+	return ""
+}
+
+func (x *RecipeList) GetItems() []*RecipeListItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type RecipeListItem struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	CreatedAt           *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ArchivedAt          *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=archived_at,json=archivedAt,proto3,oneof" json:"archived_at,omitempty"`
+	LastUpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_updated_at,json=lastUpdatedAt,proto3,oneof" json:"last_updated_at,omitempty"`
+	Id                  string                 `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
+	RecipeId            string                 `protobuf:"bytes,5,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"`
+	Notes               string                 `protobuf:"bytes,6,opt,name=notes,proto3" json:"notes,omitempty"`
+	BelongsToRecipeList string                 `protobuf:"bytes,7,opt,name=belongs_to_recipe_list,json=belongsToRecipeList,proto3" json:"belongs_to_recipe_list,omitempty"`
+	Recipe              *Recipe                `protobuf:"bytes,8,opt,name=recipe,proto3" json:"recipe,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *RecipeListItem) Reset() {
+	*x = RecipeListItem{}
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecipeListItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecipeListItem) ProtoMessage() {}
+
+func (x *RecipeListItem) ProtoReflect() protoreflect.Message {
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecipeListItem.ProtoReflect.Descriptor instead.
+func (*RecipeListItem) Descriptor() ([]byte, []int) {
+	return file_mealplanning_mealplanning_messages_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *RecipeListItem) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *RecipeListItem) GetArchivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArchivedAt
+	}
+	return nil
+}
+
+func (x *RecipeListItem) GetLastUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUpdatedAt
+	}
+	return nil
+}
+
+func (x *RecipeListItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *RecipeListItem) GetRecipeId() string {
+	if x != nil {
+		return x.RecipeId
+	}
+	return ""
+}
+
+func (x *RecipeListItem) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *RecipeListItem) GetBelongsToRecipeList() string {
+	if x != nil {
+		return x.BelongsToRecipeList
+	}
+	return ""
+}
+
+func (x *RecipeListItem) GetRecipe() *Recipe {
+	if x != nil {
+		return x.Recipe
+	}
+	return nil
+}
+
+type MealPlanTask struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	RecipePrepTask      *RecipePrepTask        `protobuf:"bytes,1,opt,name=recipe_prep_task,json=recipePrepTask,proto3" json:"recipe_prep_task,omitempty"`
+	CreatedAt           *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastUpdatedAt       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_updated_at,json=lastUpdatedAt,proto3,oneof" json:"last_updated_at,omitempty"`
+	CompletedAt         *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=completed_at,json=completedAt,proto3" json:"completed_at,omitempty"`
+	AssignedToUser      *string                `protobuf:"bytes,5,opt,name=assigned_to_user,json=assignedToUser,proto3,oneof" json:"assigned_to_user,omitempty"`
+	Id                  string                 `protobuf:"bytes,6,opt,name=id,proto3" json:"id,omitempty"`
+	Status              MealPlanTaskStatus     `protobuf:"varint,7,opt,name=status,proto3,enum=mealplanning.MealPlanTaskStatus" json:"status,omitempty"`
+	CreationExplanation string                 `protobuf:"bytes,8,opt,name=creation_explanation,json=creationExplanation,proto3" json:"creation_explanation,omitempty"`
+	StatusExplanation   string                 `protobuf:"bytes,9,opt,name=status_explanation,json=statusExplanation,proto3" json:"status_explanation,omitempty"`
+	MealPlanOption      *MealPlanOption        `protobuf:"bytes,10,opt,name=meal_plan_option,json=mealPlanOption,proto3" json:"meal_plan_option,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *MealPlanTask) Reset() {
+	*x = MealPlanTask{}
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MealPlanTask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MealPlanTask) ProtoMessage() {}
+
+func (x *MealPlanTask) ProtoReflect() protoreflect.Message {
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MealPlanTask.ProtoReflect.Descriptor instead.
+func (*MealPlanTask) Descriptor() ([]byte, []int) {
+	return file_mealplanning_mealplanning_messages_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *MealPlanTask) GetRecipePrepTask() *RecipePrepTask {
+	if x != nil {
+		return x.RecipePrepTask
+	}
+	return nil
+}
+
+func (x *MealPlanTask) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *MealPlanTask) GetLastUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUpdatedAt
+	}
+	return nil
+}
+
+func (x *MealPlanTask) GetCompletedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CompletedAt
+	}
+	return nil
+}
+
+func (x *MealPlanTask) GetAssignedToUser() string {
+	if x != nil && x.AssignedToUser != nil {
+		return *x.AssignedToUser
+	}
+	return ""
+}
+
+func (x *MealPlanTask) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *MealPlanTask) GetStatus() MealPlanTaskStatus {
+	if x != nil {
+		return x.Status
+	}
+	return MealPlanTaskStatus_MEAL_PLAN_TASK_STATUS_UNFINISHED
+}
+
+func (x *MealPlanTask) GetCreationExplanation() string {
+	if x != nil {
+		return x.CreationExplanation
+	}
+	return ""
+}
+
+func (x *MealPlanTask) GetStatusExplanation() string {
+	if x != nil {
+		return x.StatusExplanation
+	}
+	return ""
+}
+
+func (x *MealPlanTask) GetMealPlanOption() *MealPlanOption {
+	if x != nil {
+		return x.MealPlanOption
+	}
+	return nil
+}
+
+type CookTimelineStep struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	StartsAt             *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	EndsAt               *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=ends_at,json=endsAt,proto3" json:"ends_at,omitempty"`
+	RecipeStepId         string                 `protobuf:"bytes,3,opt,name=recipe_step_id,json=recipeStepId,proto3" json:"recipe_step_id,omitempty"`
+	RecipeId             string                 `protobuf:"bytes,4,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"`
+	RecipeName           string                 `protobuf:"bytes,5,opt,name=recipe_name,json=recipeName,proto3" json:"recipe_name,omitempty"`
+	Label                string                 `protobuf:"bytes,6,opt,name=label,proto3" json:"label,omitempty"`
+	StartOffsetInSeconds uint32                 `protobuf:"varint,7,opt,name=start_offset_in_seconds,json=startOffsetInSeconds,proto3" json:"start_offset_in_seconds,omitempty"`
+	EndOffsetInSeconds   uint32                 `protobuf:"varint,8,opt,name=end_offset_in_seconds,json=endOffsetInSeconds,proto3" json:"end_offset_in_seconds,omitempty"`
+	SlackInSeconds       uint32                 `protobuf:"varint,9,opt,name=slack_in_seconds,json=slackInSeconds,proto3" json:"slack_in_seconds,omitempty"`
+	StepIndex            uint32                 `protobuf:"varint,10,opt,name=step_index,json=stepIndex,proto3" json:"step_index,omitempty"`
+	Cook                 uint32                 `protobuf:"varint,11,opt,name=cook,proto3" json:"cook,omitempty"`
+	Critical             bool                   `protobuf:"varint,12,opt,name=critical,proto3" json:"critical,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *CookTimelineStep) Reset() {
+	*x = CookTimelineStep{}
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CookTimelineStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CookTimelineStep) ProtoMessage() {}
+
+func (x *CookTimelineStep) ProtoReflect() protoreflect.Message {
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CookTimelineStep.ProtoReflect.Descriptor instead.
+func (*CookTimelineStep) Descriptor() ([]byte, []int) {
+	return file_mealplanning_mealplanning_messages_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *CookTimelineStep) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *CookTimelineStep) GetEndsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EndsAt
+	}
+	return nil
+}
+
+func (x *CookTimelineStep) GetRecipeStepId() string {
+	if x != nil {
+		return x.RecipeStepId
+	}
+	return ""
+}
+
+func (x *CookTimelineStep) GetRecipeId() string {
+	if x != nil {
+		return x.RecipeId
+	}
+	return ""
+}
+
+func (x *CookTimelineStep) GetRecipeName() string {
+	if x != nil {
+		return x.RecipeName
+	}
+	return ""
+}
+
+func (x *CookTimelineStep) GetLabel() string {
+	if x != nil {
+		return x.Label
+	}
+	return ""
+}
+
+func (x *CookTimelineStep) GetStartOffsetInSeconds() uint32 {
+	if x != nil {
+		return x.StartOffsetInSeconds
+	}
+	return 0
+}
+
+func (x *CookTimelineStep) GetEndOffsetInSeconds() uint32 {
+	if x != nil {
+		return x.EndOffsetInSeconds
+	}
+	return 0
+}
+
+func (x *CookTimelineStep) GetSlackInSeconds() uint32 {
+	if x != nil {
+		return x.SlackInSeconds
+	}
+	return 0
+}
+
+func (x *CookTimelineStep) GetStepIndex() uint32 {
+	if x != nil {
+		return x.StepIndex
+	}
+	return 0
+}
+
+func (x *CookTimelineStep) GetCook() uint32 {
+	if x != nil {
+		return x.Cook
+	}
+	return 0
+}
+
+func (x *CookTimelineStep) GetCritical() bool {
+	if x != nil {
+		return x.Critical
+	}
+	return false
+}
+
+type CookTimeline struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	StartsAt               *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	ServingTime            *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=serving_time,json=servingTime,proto3" json:"serving_time,omitempty"`
+	MealId                 string                 `protobuf:"bytes,3,opt,name=meal_id,json=mealId,proto3" json:"meal_id,omitempty"`
+	MealPlanOptionId       string                 `protobuf:"bytes,4,opt,name=meal_plan_option_id,json=mealPlanOptionId,proto3" json:"meal_plan_option_id,omitempty"`
+	MermaidGanttChart      string                 `protobuf:"bytes,5,opt,name=mermaid_gantt_chart,json=mermaidGanttChart,proto3" json:"mermaid_gantt_chart,omitempty"`
+	CriticalPath           []string               `protobuf:"bytes,6,rep,name=critical_path,json=criticalPath,proto3" json:"critical_path,omitempty"`
+	Steps                  []*CookTimelineStep    `protobuf:"bytes,7,rep,name=steps,proto3" json:"steps,omitempty"`
+	TotalDurationInSeconds uint32                 `protobuf:"varint,8,opt,name=total_duration_in_seconds,json=totalDurationInSeconds,proto3" json:"total_duration_in_seconds,omitempty"`
+	CriticalPathInSeconds  uint32                 `protobuf:"varint,9,opt,name=critical_path_in_seconds,json=criticalPathInSeconds,proto3" json:"critical_path_in_seconds,omitempty"`
+	CookCount              uint32                 `protobuf:"varint,10,opt,name=cook_count,json=cookCount,proto3" json:"cook_count,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *CookTimeline) Reset() {
+	*x = CookTimeline{}
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CookTimeline) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CookTimeline) ProtoMessage() {}
+
+func (x *CookTimeline) ProtoReflect() protoreflect.Message {
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CookTimeline.ProtoReflect.Descriptor instead.
+func (*CookTimeline) Descriptor() ([]byte, []int) {
+	return file_mealplanning_mealplanning_messages_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *CookTimeline) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *CookTimeline) GetServingTime() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ServingTime
+	}
+	return nil
+}
+
+func (x *CookTimeline) GetMealId() string {
+	if x != nil {
+		return x.MealId
+	}
+	return ""
+}
+
+func (x *CookTimeline) GetMealPlanOptionId() string {
+	if x != nil {
+		return x.MealPlanOptionId
+	}
+	return ""
+}
+
+func (x *CookTimeline) GetMermaidGanttChart() string {
+	if x != nil {
+		return x.MermaidGanttChart
+	}
+	return ""
+}
+
+func (x *CookTimeline) GetCriticalPath() []string {
+	if x != nil {
+		return x.CriticalPath
+	}
+	return nil
+}
+
+func (x *CookTimeline) GetSteps() []*CookTimelineStep {
+	if x != nil {
+		return x.Steps
+	}
+	return nil
+}
+
+func (x *CookTimeline) GetTotalDurationInSeconds() uint32 {
+	if x != nil {
+		return x.TotalDurationInSeconds
+	}
+	return 0
+}
+
+func (x *CookTimeline) GetCriticalPathInSeconds() uint32 {
+	if x != nil {
+		return x.CriticalPathInSeconds
+	}
+	return 0
+}
+
+func (x *CookTimeline) GetCookCount() uint32 {
+	if x != nil {
+		return x.CookCount
+	}
+	return 0
+}
+
+type Nutrients struct {
+	state                   protoimpl.MessageState `protogen:"open.v1"`
+	Calories                float32                `protobuf:"fixed32,1,opt,name=calories,proto3" json:"calories,omitempty"`
+	ProteinInGrams          float32                `protobuf:"fixed32,2,opt,name=protein_in_grams,json=proteinInGrams,proto3" json:"protein_in_grams,omitempty"`
+	TotalFatInGrams         float32                `protobuf:"fixed32,3,opt,name=total_fat_in_grams,json=totalFatInGrams,proto3" json:"total_fat_in_grams,omitempty"`
+	SaturatedFatInGrams     float32                `protobuf:"fixed32,4,opt,name=saturated_fat_in_grams,json=saturatedFatInGrams,proto3" json:"saturated_fat_in_grams,omitempty"`
+	CarbohydratesInGrams    float32                `protobuf:"fixed32,5,opt,name=carbohydrates_in_grams,json=carbohydratesInGrams,proto3" json:"carbohydrates_in_grams,omitempty"`
+	SugarInGrams            float32                `protobuf:"fixed32,6,opt,name=sugar_in_grams,json=sugarInGrams,proto3" json:"sugar_in_grams,omitempty"`
+	FiberInGrams            float32                `protobuf:"fixed32,7,opt,name=fiber_in_grams,json=fiberInGrams,proto3" json:"fiber_in_grams,omitempty"`
+	SodiumInMilligrams      float32                `protobuf:"fixed32,8,opt,name=sodium_in_milligrams,json=sodiumInMilligrams,proto3" json:"sodium_in_milligrams,omitempty"`
+	CholesterolInMilligrams float32                `protobuf:"fixed32,9,opt,name=cholesterol_in_milligrams,json=cholesterolInMilligrams,proto3" json:"cholesterol_in_milligrams,omitempty"`
+	unknownFields           protoimpl.UnknownFields
+	sizeCache               protoimpl.SizeCache
+}
+
+func (x *Nutrients) Reset() {
+	*x = Nutrients{}
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Nutrients) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Nutrients) ProtoMessage() {}
+
+func (x *Nutrients) ProtoReflect() protoreflect.Message {
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Nutrients.ProtoReflect.Descriptor instead.
+func (*Nutrients) Descriptor() ([]byte, []int) {
+	return file_mealplanning_mealplanning_messages_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *Nutrients) GetCalories() float32 {
+	if x != nil {
+		return x.Calories
+	}
+	return 0
+}
+
+func (x *Nutrients) GetProteinInGrams() float32 {
+	if x != nil {
+		return x.ProteinInGrams
+	}
+	return 0
+}
+
+func (x *Nutrients) GetTotalFatInGrams() float32 {
+	if x != nil {
+		return x.TotalFatInGrams
+	}
+	return 0
+}
+
+func (x *Nutrients) GetSaturatedFatInGrams() float32 {
+	if x != nil {
+		return x.SaturatedFatInGrams
+	}
+	return 0
+}
+
+func (x *Nutrients) GetCarbohydratesInGrams() float32 {
+	if x != nil {
+		return x.CarbohydratesInGrams
+	}
+	return 0
+}
+
+func (x *Nutrients) GetSugarInGrams() float32 {
+	if x != nil {
+		return x.SugarInGrams
+	}
+	return 0
+}
+
+func (x *Nutrients) GetFiberInGrams() float32 {
+	if x != nil {
+		return x.FiberInGrams
+	}
+	return 0
+}
+
+func (x *Nutrients) GetSodiumInMilligrams() float32 {
+	if x != nil {
+		return x.SodiumInMilligrams
+	}
+	return 0
+}
+
+func (x *Nutrients) GetCholesterolInMilligrams() float32 {
+	if x != nil {
+		return x.CholesterolInMilligrams
+	}
+	return 0
+}
+
+type ValidIngredientNutritionFacts struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	CreatedAt            *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	LastUpdatedAt        *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=last_updated_at,json=lastUpdatedAt,proto3,oneof" json:"last_updated_at,omitempty"`
+	ArchivedAt           *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=archived_at,json=archivedAt,proto3,oneof" json:"archived_at,omitempty"`
+	Id                   string                 `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
+	ValidIngredientId    string                 `protobuf:"bytes,5,opt,name=valid_ingredient_id,json=validIngredientId,proto3" json:"valid_ingredient_id,omitempty"`
+	Notes                string                 `protobuf:"bytes,6,opt,name=notes,proto3" json:"notes,omitempty"`
+	BasisMeasurementUnit *ValidMeasurementUnit  `protobuf:"bytes,7,opt,name=basis_measurement_unit,json=basisMeasurementUnit,proto3" json:"basis_measurement_unit,omitempty"`
+	Nutrients            *Nutrients             `protobuf:"bytes,8,opt,name=nutrients,proto3" json:"nutrients,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *ValidIngredientNutritionFacts) Reset() {
+	*x = ValidIngredientNutritionFacts{}
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ValidIngredientNutritionFacts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ValidIngredientNutritionFacts) ProtoMessage() {}
+
+func (x *ValidIngredientNutritionFacts) ProtoReflect() protoreflect.Message {
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ValidIngredientNutritionFacts.ProtoReflect.Descriptor instead.
+func (*ValidIngredientNutritionFacts) Descriptor() ([]byte, []int) {
+	return file_mealplanning_mealplanning_messages_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ValidIngredientNutritionFacts) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *ValidIngredientNutritionFacts) GetLastUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUpdatedAt
+	}
+	return nil
+}
+
+func (x *ValidIngredientNutritionFacts) GetArchivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArchivedAt
+	}
+	return nil
+}
+
+func (x *ValidIngredientNutritionFacts) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ValidIngredientNutritionFacts) GetValidIngredientId() string {
+	if x != nil {
+		return x.ValidIngredientId
+	}
+	return ""
+}
+
+func (x *ValidIngredientNutritionFacts) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *ValidIngredientNutritionFacts) GetBasisMeasurementUnit() *ValidMeasurementUnit {
+	if x != nil {
+		return x.BasisMeasurementUnit
+	}
+	return nil
+}
+
+func (x *ValidIngredientNutritionFacts) GetNutrients() *Nutrients {
+	if x != nil {
+		return x.Nutrients
+	}
+	return nil
+}
+
+type NutritionMissingIngredient struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	RecipeId               string                 `protobuf:"bytes,1,opt,name=recipe_id,json=recipeId,proto3" json:"recipe_id,omitempty"`
+	RecipeStepId           string                 `protobuf:"bytes,2,opt,name=recipe_step_id,json=recipeStepId,proto3" json:"recipe_step_id,omitempty"`
+	RecipeStepIngredientId string                 `protobuf:"bytes,3,opt,name=recipe_step_ingredient_id,json=recipeStepIngredientId,proto3" json:"recipe_step_ingredient_id,omitempty"`
+	ValidIngredientId      string                 `protobuf:"bytes,4,opt,name=valid_ingredient_id,json=validIngredientId,proto3" json:"valid_ingredient_id,omitempty"`
+	IngredientName         string                 `protobuf:"bytes,5,opt,name=ingredient_name,json=ingredientName,proto3" json:"ingredient_name,omitempty"`
+	MeasurementUnitId      string                 `protobuf:"bytes,6,opt,name=measurement_unit_id,json=measurementUnitId,proto3" json:"measurement_unit_id,omitempty"`
+	Reason                 string                 `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *NutritionMissingIngredient) Reset() {
+	*x = NutritionMissingIngredient{}
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NutritionMissingIngredient) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NutritionMissingIngredient) ProtoMessage() {}
+
+func (x *NutritionMissingIngredient) ProtoReflect() protoreflect.Message {
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NutritionMissingIngredient.ProtoReflect.Descriptor instead.
+func (*NutritionMissingIngredient) Descriptor() ([]byte, []int) {
+	return file_mealplanning_mealplanning_messages_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *NutritionMissingIngredient) GetRecipeId() string {
+	if x != nil {
+		return x.RecipeId
+	}
+	return ""
+}
+
+func (x *NutritionMissingIngredient) GetRecipeStepId() string {
+	if x != nil {
+		return x.RecipeStepId
+	}
+	return ""
+}
+
+func (x *NutritionMissingIngredient) GetRecipeStepIngredientId() string {
+	if x != nil {
+		return x.RecipeStepIngredientId
+	}
+	return ""
+}
+
+func (x *NutritionMissingIngredient) GetValidIngredientId() string {
+	if x != nil {
+		return x.ValidIngredientId
+	}
+	return ""
+}
+
+func (x *NutritionMissingIngredient) GetIngredientName() string {
+	if x != nil {
+		return x.IngredientName
+	}
+	return ""
+}
+
+func (x *NutritionMissingIngredient) GetMeasurementUnitId() string {
+	if x != nil {
+		return x.MeasurementUnitId
+	}
+	return ""
+}
+
+func (x *NutritionMissingIngredient) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type NutritionRollup struct {
+	state              protoimpl.MessageState        `protogen:"open.v1"`
+	PerPortion         *Nutrients                    `protobuf:"bytes,1,opt,name=per_portion,json=perPortion,proto3,oneof" json:"per_portion,omitempty"`
+	MissingIngredients []*NutritionMissingIngredient `protobuf:"bytes,2,rep,name=missing_ingredients,json=missingIngredients,proto3" json:"missing_ingredients,omitempty"`
+	Totals             *Nutrients                    `protobuf:"bytes,3,opt,name=totals,proto3" json:"totals,omitempty"`
+	EstimatedPortions  float32                       `protobuf:"fixed32,4,opt,name=estimated_portions,json=estimatedPortions,proto3" json:"estimated_portions,omitempty"`
+	Scale              float32                       `protobuf:"fixed32,5,opt,name=scale,proto3" json:"scale,omitempty"`
+	Complete           bool                          `protobuf:"varint,6,opt,name=complete,proto3" json:"complete,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *NutritionRollup) Reset() {
+	*x = NutritionRollup{}
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NutritionRollup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NutritionRollup) ProtoMessage() {}
+
+func (x *NutritionRollup) ProtoReflect() protoreflect.Message {
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NutritionRollup.ProtoReflect.Descriptor instead.
+func (*NutritionRollup) Descriptor() ([]byte, []int) {
+	return file_mealplanning_mealplanning_messages_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *NutritionRollup) GetPerPortion() *Nutrients {
+	if x != nil {
+		return x.PerPortion
+	}
+	return nil
+}
+
+func (x *NutritionRollup) GetMissingIngredients() []*NutritionMissingIngredient {
+	if x != nil {
+		return x.MissingIngredients
+	}
+	return nil
+}
+
+func (x *NutritionRollup) GetTotals() *Nutrients {
+	if x != nil {
+		return x.Totals
+	}
+	return nil
+}
+
+func (x *NutritionRollup) GetEstimatedPortions() float32 {
+	if x != nil {
+		return x.EstimatedPortions
+	}
+	return 0
+}
+
+func (x *NutritionRollup) GetScale() float32 {
+	if x != nil {
+		return x.Scale
+	}
+	return 0
+}
+
+func (x *NutritionRollup) GetComplete() bool {
+	if x != nil {
+		return x.Complete
+	}
+	return false
+}
+
+type MealPlanEventNutritionRollup struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	StartsAt         *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=starts_at,json=startsAt,proto3" json:"starts_at,omitempty"`
+	MealPlanEventId  string                 `protobuf:"bytes,2,opt,name=meal_plan_event_id,json=mealPlanEventId,proto3" json:"meal_plan_event_id,omitempty"`
+	MealPlanOptionId string                 `protobuf:"bytes,3,opt,name=meal_plan_option_id,json=mealPlanOptionId,proto3" json:"meal_plan_option_id,omitempty"`
+	MealId           string                 `protobuf:"bytes,4,opt,name=meal_id,json=mealId,proto3" json:"meal_id,omitempty"`
+	MealName         string                 `protobuf:"bytes,5,opt,name=meal_name,json=mealName,proto3" json:"meal_name,omitempty"`
+	Nutrition        *NutritionRollup       `protobuf:"bytes,6,opt,name=nutrition,proto3" json:"nutrition,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *MealPlanEventNutritionRollup) Reset() {
+	*x = MealPlanEventNutritionRollup{}
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MealPlanEventNutritionRollup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MealPlanEventNutritionRollup) ProtoMessage() {}
+
+func (x *MealPlanEventNutritionRollup) ProtoReflect() protoreflect.Message {
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MealPlanEventNutritionRollup.ProtoReflect.Descriptor instead.
+func (*MealPlanEventNutritionRollup) Descriptor() ([]byte, []int) {
+	return file_mealplanning_mealplanning_messages_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *MealPlanEventNutritionRollup) GetStartsAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.StartsAt
+	}
+	return nil
+}
+
+func (x *MealPlanEventNutritionRollup) GetMealPlanEventId() string {
+	if x != nil {
+		return x.MealPlanEventId
+	}
+	return ""
+}
+
+func (x *MealPlanEventNutritionRollup) GetMealPlanOptionId() string {
+	if x != nil {
+		return x.MealPlanOptionId
+	}
+	return ""
+}
+
+func (x *MealPlanEventNutritionRollup) GetMealId() string {
+	if x != nil {
+		return x.MealId
+	}
+	return ""
+}
+
+func (x *MealPlanEventNutritionRollup) GetMealName() string {
+	if x != nil {
+		return x.MealName
+	}
+	return ""
+}
+
+func (x *MealPlanEventNutritionRollup) GetNutrition() *NutritionRollup {
+	if x != nil {
+		return x.Nutrition
+	}
+	return nil
+}
+
+type MealPlanNutritionRollup struct {
+	state              protoimpl.MessageState          `protogen:"open.v1"`
+	MealPlanId         string                          `protobuf:"bytes,1,opt,name=meal_plan_id,json=mealPlanId,proto3" json:"meal_plan_id,omitempty"`
+	Events             []*MealPlanEventNutritionRollup `protobuf:"bytes,2,rep,name=events,proto3" json:"events,omitempty"`
+	MissingIngredients []*NutritionMissingIngredient   `protobuf:"bytes,3,rep,name=missing_ingredients,json=missingIngredients,proto3" json:"missing_ingredients,omitempty"`
+	Totals             *Nutrients                      `protobuf:"bytes,4,opt,name=totals,proto3" json:"totals,omitempty"`
+	Complete           bool                            `protobuf:"varint,5,opt,name=complete,proto3" json:"complete,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *MealPlanNutritionRollup) Reset() {
+	*x = MealPlanNutritionRollup{}
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MealPlanNutritionRollup) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MealPlanNutritionRollup) ProtoMessage() {}
+
+func (x *MealPlanNutritionRollup) ProtoReflect() protoreflect.Message {
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MealPlanNutritionRollup.ProtoReflect.Descriptor instead.
+func (*MealPlanNutritionRollup) Descriptor() ([]byte, []int) {
+	return file_mealplanning_mealplanning_messages_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *MealPlanNutritionRollup) GetMealPlanId() string {
+	if x != nil {
+		return x.MealPlanId
+	}
+	return ""
+}
+
+func (x *MealPlanNutritionRollup) GetEvents() []*MealPlanEventNutritionRollup {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *MealPlanNutritionRollup) GetMissingIngredients() []*NutritionMissingIngredient {
+	if x != nil {
+		return x.MissingIngredients
+	}
+	return nil
+}
+
+func (x *MealPlanNutritionRollup) GetTotals() *Nutrients {
+	if x != nil {
+		return x.Totals
+	}
+	return nil
+}
+
+func (x *MealPlanNutritionRollup) GetComplete() bool {
+	if x != nil {
+		return x.Complete
+	}
+	return false
+}
+
+type AccountInstrumentOwnership struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ArchivedAt       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=archived_at,json=archivedAt,proto3,oneof" json:"archived_at,omitempty"`
+	LastUpdatedAt    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_updated_at,json=lastUpdatedAt,proto3,oneof" json:"last_updated_at,omitempty"`
+	Id               string                 `protobuf:"bytes,4,opt,name=id,proto3" json:"id,omitempty"`
+	Notes            string                 `protobuf:"bytes,5,opt,name=notes,proto3" json:"notes,omitempty"`
+	BelongsToAccount string                 `protobuf:"bytes,6,opt,name=belongs_to_account,json=belongsToAccount,proto3" json:"belongs_to_account,omitempty"`
+	Instrument       *ValidInstrument       `protobuf:"bytes,7,opt,name=instrument,proto3" json:"instrument,omitempty"`
+	Quantity         uint32                 `protobuf:"varint,8,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *AccountInstrumentOwnership) Reset() {
+	*x = AccountInstrumentOwnership{}
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountInstrumentOwnership) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountInstrumentOwnership) ProtoMessage() {}
+
+func (x *AccountInstrumentOwnership) ProtoReflect() protoreflect.Message {
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountInstrumentOwnership.ProtoReflect.Descriptor instead.
+func (*AccountInstrumentOwnership) Descriptor() ([]byte, []int) {
+	return file_mealplanning_mealplanning_messages_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *AccountInstrumentOwnership) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *AccountInstrumentOwnership) GetArchivedAt() *timestamppb.Timestamp {
 	if x != nil {
 		return x.ArchivedAt
 	}
@@ -6505,6 +8774,130 @@ func (x *AccountInstrumentOwnership) GetQuantity() uint32 {
 	return 0
 }
 
+type PantryItem struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,1,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	ArchivedAt       *timestamppb.Timestamp `protobuf:"bytes,2,opt,name=archived_at,json=archivedAt,proto3,oneof" json:"archived_at,omitempty"`
+	LastUpdatedAt    *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=last_updated_at,json=lastUpdatedAt,proto3,oneof" json:"last_updated_at,omitempty"`
+	ExpiresAt        *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=expires_at,json=expiresAt,proto3,oneof" json:"expires_at,omitempty"`
+	Id               string                 `protobuf:"bytes,5,opt,name=id,proto3" json:"id,omitempty"`
+	Notes            string                 `protobuf:"bytes,6,opt,name=notes,proto3" json:"notes,omitempty"`
+	StorageLocation  PantryStorageLocation  `protobuf:"varint,7,opt,name=storage_location,json=storageLocation,proto3,enum=mealplanning.PantryStorageLocation" json:"storage_location,omitempty"`
+	BelongsToAccount string                 `protobuf:"bytes,8,opt,name=belongs_to_account,json=belongsToAccount,proto3" json:"belongs_to_account,omitempty"`
+	Ingredient       *ValidIngredient       `protobuf:"bytes,9,opt,name=ingredient,proto3" json:"ingredient,omitempty"`
+	MeasurementUnit  *ValidMeasurementUnit  `protobuf:"bytes,10,opt,name=measurement_unit,json=measurementUnit,proto3" json:"measurement_unit,omitempty"`
+	Quantity         float32                `protobuf:"fixed32,11,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *PantryItem) Reset() {
+	*x = PantryItem{}
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PantryItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PantryItem) ProtoMessage() {}
+
+func (x *PantryItem) ProtoReflect() protoreflect.Message {
+	mi := &file_mealplanning_mealplanning_messages_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PantryItem.ProtoReflect.Descriptor instead.
+func (*PantryItem) Descriptor() ([]byte, []int) {
+	return file_mealplanning_mealplanning_messages_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *PantryItem) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *PantryItem) GetArchivedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ArchivedAt
+	}
+	return nil
+}
+
+func (x *PantryItem) GetLastUpdatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.LastUpdatedAt
+	}
+	return nil
+}
+
+func (x *PantryItem) GetExpiresAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return nil
+}
+
+func (x *PantryItem) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PantryItem) GetNotes() string {
+	if x != nil {
+		return x.Notes
+	}
+	return ""
+}
+
+func (x *PantryItem) GetStorageLocation() PantryStorageLocation {
+	if x != nil {
+		return x.StorageLocation
+	}
+	return PantryStorageLocation_PANTRY_STORAGE_LOCATION_PANTRY
+}
+
+func (x *PantryItem) GetBelongsToAccount() string {
+	if x != nil {
+		return x.BelongsToAccount
+	}
+	return ""
+}
+
+func (x *PantryItem) GetIngredient() *ValidIngredient {
+	if x != nil {
+		return x.Ingredient
+	}
+	return nil
+}
+
+func (x *PantryItem) GetMeasurementUnit() *ValidMeasurementUnit {
+	if x != nil {
+		return x.MeasurementUnit
+	}
+	return nil
+}
+
+func (x *PantryItem) GetQuantity() float32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
 var File_mealplanning_mealplanning_messages_proto protoreflect.FileDescriptor
 
 var file_mealplanning_mealplanning_messages_proto_rawDesc = string([]byte{
@@ -6516,7 +8909,7 @@ func (x *AccountInstrumentOwnership) GetQuantity() uint32 {
 	0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x2c, 0x75, 0x70, 0x6c, 0x6f, 0x61,
 	0x64, 0x65, 0x64, 0x5f, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x2f, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64,
 	0x65, 0x64, 0x5f, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xba, 0x03, 0x0a, 0x0e, 0x44, 0x61, 0x74, 0x61,
+	0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xf7, 0x03, 0x0a, 0x0e, 0x44, 0x61, 0x74, 0x61,
 	0x43, 0x6f, 0x6c, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x6c, 0x0a, 0x1d, 0x61, 0x63,
 	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74,
 	0x5f, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
@@ -6544,8 +8937,227 @@ func (x *AccountInstrumentOwnership) GetQuantity() uint32 {
 	0x2e, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x50,
 	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x19, 0x75, 0x73, 0x65, 0x72, 0x49,
 	0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
-	0x6e, 0x63, 0x65, 0x73, 0x22, 0x9e, 0x0d, 0x0a, 0x0f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e,
-	0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x6e, 0x63, 0x65, 0x73, 0x12, 0x3b, 0x0a, 0x0c, 0x70, 0x61, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x69,
+	0x74, 0x65, 0x6d, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x61,
+	0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x61, 0x6e, 0x74, 0x72, 0x79,
+	0x49, 0x74, 0x65, 0x6d, 0x52, 0x0b, 0x70, 0x61, 0x6e, 0x74, 0x72, 0x79, 0x49, 0x74, 0x65, 0x6d,
+	0x73, 0x22, 0x9e, 0x0d, 0x0a, 0x0f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65,
+	0x64, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x47, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x40, 0x0a, 0x0b, 0x61, 0x72, 0x63,
+	0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x0a, 0x61, 0x72,
+	0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x4f, 0x0a, 0x22, 0x6d,
+	0x69, 0x6e, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x63, 0x65, 0x6c, 0x73, 0x69, 0x75,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x48, 0x02, 0x52, 0x1e, 0x6d, 0x69, 0x6e, 0x53, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x49, 0x6e, 0x43, 0x65, 0x6c, 0x73, 0x69, 0x75, 0x73, 0x88, 0x01, 0x01, 0x12, 0x4f, 0x0a, 0x22,
+	0x6d, 0x61, 0x78, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x65, 0x6d, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x63, 0x65, 0x6c, 0x73, 0x69,
+	0x75, 0x73, 0x18, 0x27, 0x20, 0x01, 0x28, 0x02, 0x48, 0x03, 0x52, 0x1e, 0x6d, 0x61, 0x78, 0x53,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x49, 0x6e, 0x43, 0x65, 0x6c, 0x73, 0x69, 0x75, 0x73, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a,
+	0x09, 0x69, 0x63, 0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x69, 0x63, 0x6f, 0x6e, 0x50, 0x61, 0x74, 0x68, 0x12, 0x18, 0x0a, 0x07, 0x77, 0x61,
+	0x72, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x77, 0x61, 0x72,
+	0x6e, 0x69, 0x6e, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6c, 0x75, 0x72, 0x61, 0x6c, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c, 0x75, 0x72, 0x61,
+	0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x14, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x5f, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x13, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x73, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12,
+	0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6c,
+	0x75, 0x67, 0x12, 0x31, 0x0a, 0x14, 0x73, 0x68, 0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x5f, 0x73,
+	0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x13, 0x73, 0x68, 0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x53, 0x75, 0x67, 0x67, 0x65, 0x73,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
+	0x73, 0x5f, 0x73, 0x68, 0x65, 0x6c, 0x6c, 0x66, 0x69, 0x73, 0x68, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x53, 0x68, 0x65, 0x6c, 0x6c,
+	0x66, 0x69, 0x73, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x6c, 0x69, 0x71, 0x75, 0x69,
+	0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x4c, 0x69, 0x71, 0x75, 0x69,
+	0x64, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x5f, 0x70, 0x65,
+	0x61, 0x6e, 0x75, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x73, 0x50, 0x65, 0x61, 0x6e, 0x75, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x63, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x5f, 0x74, 0x72, 0x65, 0x65, 0x5f, 0x6e, 0x75, 0x74, 0x18,
+	0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x54,
+	0x72, 0x65, 0x65, 0x4e, 0x75, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69,
+	0x6e, 0x73, 0x5f, 0x65, 0x67, 0x67, 0x18, 0x12, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x63, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x45, 0x67, 0x67, 0x12, 0x25, 0x0a, 0x0e, 0x63, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x73, 0x5f, 0x77, 0x68, 0x65, 0x61, 0x74, 0x18, 0x13, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x57, 0x68, 0x65, 0x61, 0x74,
+	0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x5f, 0x73, 0x6f, 0x79,
+	0x18, 0x14, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73,
+	0x53, 0x6f, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x6e, 0x69, 0x6d, 0x61, 0x6c, 0x5f, 0x64, 0x65,
+	0x72, 0x69, 0x76, 0x65, 0x64, 0x18, 0x15, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x61, 0x6e, 0x69,
+	0x6d, 0x61, 0x6c, 0x44, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x12, 0x38, 0x0a, 0x18, 0x72, 0x65,
+	0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x5f, 0x74, 0x6f, 0x5f, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x16, 0x20, 0x01, 0x28, 0x08, 0x52, 0x16, 0x72, 0x65,
+	0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x54, 0x6f, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73,
+	0x5f, 0x73, 0x65, 0x73, 0x61, 0x6d, 0x65, 0x18, 0x17, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x63,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x53, 0x65, 0x73, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a,
+	0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x5f, 0x66, 0x69, 0x73, 0x68, 0x18, 0x18,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x46, 0x69,
+	0x73, 0x68, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x5f, 0x67,
+	0x6c, 0x75, 0x74, 0x65, 0x6e, 0x18, 0x19, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x63, 0x6f, 0x6e,
+	0x74, 0x61, 0x69, 0x6e, 0x73, 0x47, 0x6c, 0x75, 0x74, 0x65, 0x6e, 0x12, 0x25, 0x0a, 0x0e, 0x63,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x5f, 0x64, 0x61, 0x69, 0x72, 0x79, 0x18, 0x1a, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x44, 0x61, 0x69,
+	0x72, 0x79, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x5f, 0x61,
+	0x6c, 0x63, 0x6f, 0x68, 0x6f, 0x6c, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x63, 0x6f,
+	0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x41, 0x6c, 0x63, 0x6f, 0x68, 0x6f, 0x6c, 0x12, 0x21, 0x0a,
+	0x0c, 0x61, 0x6e, 0x69, 0x6d, 0x61, 0x6c, 0x5f, 0x66, 0x6c, 0x65, 0x73, 0x68, 0x18, 0x1c, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0b, 0x61, 0x6e, 0x69, 0x6d, 0x61, 0x6c, 0x46, 0x6c, 0x65, 0x73, 0x68,
+	0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x63, 0x68, 0x18, 0x1d, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x53, 0x74, 0x61, 0x72, 0x63, 0x68, 0x12, 0x1d, 0x0a,
+	0x0a, 0x69, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x69, 0x6e, 0x18, 0x1e, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x69, 0x6e, 0x12, 0x19, 0x0a, 0x08,
+	0x69, 0x73, 0x5f, 0x67, 0x72, 0x61, 0x69, 0x6e, 0x18, 0x1f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x69, 0x73, 0x47, 0x72, 0x61, 0x69, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f, 0x66, 0x72,
+	0x75, 0x69, 0x74, 0x18, 0x20, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x46, 0x72, 0x75,
+	0x69, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x73, 0x5f, 0x73, 0x61, 0x6c, 0x74, 0x18, 0x21, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x73, 0x53, 0x61, 0x6c, 0x74, 0x12, 0x15, 0x0a, 0x06, 0x69,
+	0x73, 0x5f, 0x66, 0x61, 0x74, 0x18, 0x22, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x69, 0x73, 0x46,
+	0x61, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x73, 0x5f, 0x61, 0x63, 0x69, 0x64, 0x18, 0x23, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x73, 0x41, 0x63, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x69,
+	0x73, 0x5f, 0x68, 0x65, 0x61, 0x74, 0x18, 0x24, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x73,
+	0x48, 0x65, 0x61, 0x74, 0x12, 0x35, 0x0a, 0x16, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x6d, 0x69, 0x6e,
+	0x61, 0x74, 0x65, 0x73, 0x5f, 0x65, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x25,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x6d, 0x69, 0x6e, 0x61, 0x74,
+	0x65, 0x73, 0x45, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x33, 0x0a, 0x05, 0x6d,
+	0x65, 0x64, 0x69, 0x61, 0x18, 0x26, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x75, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x65, 0x64, 0x5f, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x2e, 0x55, 0x70, 0x6c, 0x6f,
+	0x61, 0x64, 0x65, 0x64, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x05, 0x6d, 0x65, 0x64, 0x69, 0x61,
+	0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x42, 0x25, 0x0a, 0x23, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x5f, 0x69, 0x6e, 0x5f, 0x63, 0x65, 0x6c, 0x73, 0x69, 0x75, 0x73, 0x42, 0x25, 0x0a, 0x23, 0x5f,
+	0x6d, 0x61, 0x78, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x65, 0x6d, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x63, 0x65, 0x6c, 0x73, 0x69,
+	0x75, 0x73, 0x22, 0x9e, 0x03, 0x0a, 0x14, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72,
+	0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x39, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x47, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x0d, 0x6c,
+	0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12,
+	0x40, 0x0a, 0x0b, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x48, 0x01, 0x52, 0x0a, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01,
+	0x01, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x42, 0x0a, 0x07, 0x6d,
+	0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6d,
+	0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x42,
+	0x12, 0x0a, 0x10, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x22, 0xad, 0x02, 0x0a, 0x1a, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67,
+	0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62,
+	0x65, 0x72, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x40, 0x0a,
+	0x0b, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00,
+	0x52, 0x0a, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x28, 0x0a, 0x10, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x67, 0x72,
+	0x6f, 0x75, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x62, 0x65, 0x6c, 0x6f, 0x6e,
+	0x67, 0x73, 0x54, 0x6f, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x48, 0x0a, 0x10, 0x76, 0x61, 0x6c,
+	0x69, 0x64, 0x5f, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69,
+	0x6e, 0x67, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65,
+	0x6e, 0x74, 0x52, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69,
+	0x65, 0x6e, 0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x22, 0xca, 0x04, 0x0a, 0x1e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67,
+	0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x47, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x40, 0x0a, 0x0b, 0x61, 0x72,
+	0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x0a, 0x61,
+	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x14, 0x0a, 0x05,
+	0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74,
+	0x65, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x34, 0x0a, 0x16, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61,
+	0x62, 0x6c, 0x65, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x14, 0x6d, 0x69, 0x6e, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x62, 0x6c, 0x65,
+	0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x39, 0x0a, 0x16, 0x6d, 0x61, 0x78, 0x5f,
+	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x02, 0x48, 0x02, 0x52, 0x14, 0x6d, 0x61, 0x78, 0x41,
+	0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x62, 0x6c, 0x65, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x88, 0x01, 0x01, 0x12, 0x4d, 0x0a, 0x10, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e,
+	0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69,
+	0x74, 0x52, 0x0f, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e,
+	0x69, 0x74, 0x12, 0x3d, 0x0a, 0x0a, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61,
+	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65,
+	0x64, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e,
+	0x74, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x19, 0x0a, 0x17, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x6c,
+	0x6c, 0x6f, 0x77, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79,
+	0x22, 0xad, 0x03, 0x0a, 0x1a, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64,
+	0x69, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x47, 0x0a, 0x0f, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48,
+	0x00, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x88, 0x01, 0x01, 0x12, 0x40, 0x0a, 0x0b, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x0a, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64,
+	0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x40, 0x0a, 0x0b, 0x70,
+	0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0b, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3d, 0x0a,
+	0x0a, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
+	0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74,
+	0x52, 0x0a, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x42, 0x12, 0x0a, 0x10,
+	0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x22, 0xb9, 0x07, 0x0a, 0x13, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x72, 0x65, 0x70, 0x54, 0x61,
+	0x73, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
 	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
 	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
 	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
@@ -6557,128 +9169,55 @@ func (x *AccountInstrumentOwnership) GetQuantity() uint32 {
 	0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
 	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52,
-	0x0a, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x4f,
-	0x0a, 0x22, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x65,
-	0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x63, 0x65, 0x6c,
-	0x73, 0x69, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x48, 0x02, 0x52, 0x1e, 0x6d, 0x69,
-	0x6e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x75, 0x72, 0x65, 0x49, 0x6e, 0x43, 0x65, 0x6c, 0x73, 0x69, 0x75, 0x73, 0x88, 0x01, 0x01, 0x12,
-	0x4f, 0x0a, 0x22, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x74,
+	0x0a, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x44,
+	0x0a, 0x1f, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x75,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x1b, 0x6d, 0x69, 0x6e, 0x53, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x53, 0x65, 0x63,
+	0x6f, 0x6e, 0x64, 0x73, 0x12, 0x49, 0x0a, 0x1f, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x5f,
+	0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x02, 0x52,
+	0x1b, 0x6d, 0x61, 0x78, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x88, 0x01, 0x01, 0x12,
+	0x4f, 0x0a, 0x22, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x74,
 	0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x63, 0x65,
-	0x6c, 0x73, 0x69, 0x75, 0x73, 0x18, 0x27, 0x20, 0x01, 0x28, 0x02, 0x48, 0x03, 0x52, 0x1e, 0x6d,
-	0x61, 0x78, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61,
+	0x6c, 0x73, 0x69, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x48, 0x03, 0x52, 0x1e, 0x6d,
+	0x69, 0x6e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61,
 	0x74, 0x75, 0x72, 0x65, 0x49, 0x6e, 0x43, 0x65, 0x6c, 0x73, 0x69, 0x75, 0x73, 0x88, 0x01, 0x01,
-	0x12, 0x1b, 0x0a, 0x09, 0x69, 0x63, 0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x63, 0x6f, 0x6e, 0x50, 0x61, 0x74, 0x68, 0x12, 0x18, 0x0a,
-	0x07, 0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x77, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6c, 0x75, 0x72, 0x61,
-	0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c,
-	0x75, 0x72, 0x61, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x31, 0x0a, 0x14, 0x73, 0x74, 0x6f, 0x72,
-	0x61, 0x67, 0x65, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x49,
-	0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e,
-	0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x73, 0x6c, 0x75, 0x67, 0x12, 0x31, 0x0a, 0x14, 0x73, 0x68, 0x6f, 0x70, 0x70, 0x69, 0x6e,
-	0x67, 0x5f, 0x73, 0x75, 0x67, 0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0d, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x13, 0x73, 0x68, 0x6f, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x53, 0x75, 0x67,
-	0x67, 0x65, 0x73, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x63, 0x6f, 0x6e, 0x74,
-	0x61, 0x69, 0x6e, 0x73, 0x5f, 0x73, 0x68, 0x65, 0x6c, 0x6c, 0x66, 0x69, 0x73, 0x68, 0x18, 0x0e,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x53, 0x68,
-	0x65, 0x6c, 0x6c, 0x66, 0x69, 0x73, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x6c, 0x69,
-	0x71, 0x75, 0x69, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x4c, 0x69,
-	0x71, 0x75, 0x69, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73,
-	0x5f, 0x70, 0x65, 0x61, 0x6e, 0x75, 0x74, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x63,
-	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x50, 0x65, 0x61, 0x6e, 0x75, 0x74, 0x12, 0x2a, 0x0a,
-	0x11, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x5f, 0x74, 0x72, 0x65, 0x65, 0x5f, 0x6e,
-	0x75, 0x74, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69,
-	0x6e, 0x73, 0x54, 0x72, 0x65, 0x65, 0x4e, 0x75, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e,
-	0x74, 0x61, 0x69, 0x6e, 0x73, 0x5f, 0x65, 0x67, 0x67, 0x18, 0x12, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x45, 0x67, 0x67, 0x12, 0x25, 0x0a, 0x0e,
-	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x5f, 0x77, 0x68, 0x65, 0x61, 0x74, 0x18, 0x13,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x57, 0x68,
-	0x65, 0x61, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x5f,
-	0x73, 0x6f, 0x79, 0x18, 0x14, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x61,
-	0x69, 0x6e, 0x73, 0x53, 0x6f, 0x79, 0x12, 0x25, 0x0a, 0x0e, 0x61, 0x6e, 0x69, 0x6d, 0x61, 0x6c,
-	0x5f, 0x64, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x18, 0x15, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d,
-	0x61, 0x6e, 0x69, 0x6d, 0x61, 0x6c, 0x44, 0x65, 0x72, 0x69, 0x76, 0x65, 0x64, 0x12, 0x38, 0x0a,
-	0x18, 0x72, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x5f, 0x74, 0x6f, 0x5f, 0x70, 0x72, 0x65,
-	0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x16, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x16, 0x72, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x54, 0x6f, 0x50, 0x72, 0x65, 0x70, 0x61,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x61,
-	0x69, 0x6e, 0x73, 0x5f, 0x73, 0x65, 0x73, 0x61, 0x6d, 0x65, 0x18, 0x17, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x53, 0x65, 0x73, 0x61, 0x6d, 0x65,
-	0x12, 0x23, 0x0a, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x5f, 0x66, 0x69, 0x73,
-	0x68, 0x18, 0x18, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
-	0x73, 0x46, 0x69, 0x73, 0x68, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
-	0x73, 0x5f, 0x67, 0x6c, 0x75, 0x74, 0x65, 0x6e, 0x18, 0x19, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e,
-	0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x47, 0x6c, 0x75, 0x74, 0x65, 0x6e, 0x12, 0x25,
-	0x0a, 0x0e, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x5f, 0x64, 0x61, 0x69, 0x72, 0x79,
-	0x18, 0x1a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73,
-	0x44, 0x61, 0x69, 0x72, 0x79, 0x12, 0x29, 0x0a, 0x10, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e,
-	0x73, 0x5f, 0x61, 0x6c, 0x63, 0x6f, 0x68, 0x6f, 0x6c, 0x18, 0x1b, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x73, 0x41, 0x6c, 0x63, 0x6f, 0x68, 0x6f, 0x6c,
-	0x12, 0x21, 0x0a, 0x0c, 0x61, 0x6e, 0x69, 0x6d, 0x61, 0x6c, 0x5f, 0x66, 0x6c, 0x65, 0x73, 0x68,
-	0x18, 0x1c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x61, 0x6e, 0x69, 0x6d, 0x61, 0x6c, 0x46, 0x6c,
-	0x65, 0x73, 0x68, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x63, 0x68,
-	0x18, 0x1d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x53, 0x74, 0x61, 0x72, 0x63, 0x68,
-	0x12, 0x1d, 0x0a, 0x0a, 0x69, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x69, 0x6e, 0x18, 0x1e,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x65, 0x69, 0x6e, 0x12,
-	0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f, 0x67, 0x72, 0x61, 0x69, 0x6e, 0x18, 0x1f, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x07, 0x69, 0x73, 0x47, 0x72, 0x61, 0x69, 0x6e, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x73,
-	0x5f, 0x66, 0x72, 0x75, 0x69, 0x74, 0x18, 0x20, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73,
-	0x46, 0x72, 0x75, 0x69, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x73, 0x5f, 0x73, 0x61, 0x6c, 0x74,
-	0x18, 0x21, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x73, 0x53, 0x61, 0x6c, 0x74, 0x12, 0x15,
-	0x0a, 0x06, 0x69, 0x73, 0x5f, 0x66, 0x61, 0x74, 0x18, 0x22, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
-	0x69, 0x73, 0x46, 0x61, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x69, 0x73, 0x5f, 0x61, 0x63, 0x69, 0x64,
-	0x18, 0x23, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x73, 0x41, 0x63, 0x69, 0x64, 0x12, 0x17,
-	0x0a, 0x07, 0x69, 0x73, 0x5f, 0x68, 0x65, 0x61, 0x74, 0x18, 0x24, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x06, 0x69, 0x73, 0x48, 0x65, 0x61, 0x74, 0x12, 0x35, 0x0a, 0x16, 0x63, 0x6f, 0x6e, 0x74, 0x61,
-	0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x73, 0x5f, 0x65, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e,
-	0x74, 0x18, 0x25, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x6d, 0x69,
-	0x6e, 0x61, 0x74, 0x65, 0x73, 0x45, 0x71, 0x75, 0x69, 0x70, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x33,
-	0x0a, 0x05, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x18, 0x26, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
-	0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x5f, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x2e, 0x55,
-	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x05, 0x6d, 0x65,
-	0x64, 0x69, 0x61, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x61, 0x72, 0x63, 0x68,
-	0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x25, 0x0a, 0x23, 0x5f, 0x6d, 0x69, 0x6e, 0x5f,
-	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x75, 0x72, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x63, 0x65, 0x6c, 0x73, 0x69, 0x75, 0x73, 0x42, 0x25,
-	0x0a, 0x23, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x74,
+	0x12, 0x4f, 0x0a, 0x22, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f,
+	0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x63,
+	0x65, 0x6c, 0x73, 0x69, 0x75, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x02, 0x48, 0x04, 0x52, 0x1e,
+	0x6d, 0x61, 0x78, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x49, 0x6e, 0x43, 0x65, 0x6c, 0x73, 0x69, 0x75, 0x73, 0x88, 0x01,
+	0x01, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
+	0x54, 0x79, 0x70, 0x65, 0x12, 0x31, 0x0a, 0x14, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f,
+	0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x13, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x72,
+	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x16, 0x0a,
+	0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x40, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x65, 0x61,
+	0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x50,
+	0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x70, 0x72, 0x65, 0x70,
+	0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3d, 0x0a, 0x0a, 0x69, 0x6e, 0x67, 0x72, 0x65,
+	0x64, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x65,
+	0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x69, 0x6e, 0x67, 0x72,
+	0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x61,
+	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x22, 0x0a, 0x20, 0x5f, 0x6d,
+	0x61, 0x78, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x42, 0x25,
+	0x0a, 0x23, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x74,
 	0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x63, 0x65,
-	0x6c, 0x73, 0x69, 0x75, 0x73, 0x22, 0x9e, 0x03, 0x0a, 0x14, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49,
-	0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x39,
-	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x47, 0x0a, 0x0f, 0x6c, 0x61, 0x73,
-	0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00,
-	0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88,
-	0x01, 0x01, 0x12, 0x40, 0x0a, 0x0b, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x0a, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x41,
-	0x74, 0x88, 0x01, 0x01, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x12, 0x20, 0x0a, 0x0b,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x42,
-	0x0a, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x28, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x56,
-	0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x47, 0x72,
-	0x6f, 0x75, 0x70, 0x4d, 0x65, 0x6d, 0x62, 0x65, 0x72, 0x52, 0x07, 0x6d, 0x65, 0x6d, 0x62, 0x65,
-	0x72, 0x73, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69,
-	0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0xad, 0x02, 0x0a, 0x1a, 0x56, 0x61, 0x6c, 0x69, 0x64,
-	0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x4d,
-	0x65, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x6c, 0x73, 0x69, 0x75, 0x73, 0x42, 0x25, 0x0a, 0x23, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x63, 0x65, 0x6c, 0x73, 0x69, 0x75, 0x73, 0x22, 0xee, 0x03, 0x0a,
+	0x14, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
 	0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
 	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
 	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
@@ -6686,55 +9225,58 @@ func (x *AccountInstrumentOwnership) GetQuantity() uint32 {
 	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
 	0x70, 0x48, 0x00, 0x52, 0x0a, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x88,
-	0x01, 0x01, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x28, 0x0a, 0x10, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x5f, 0x74, 0x6f,
-	0x5f, 0x67, 0x72, 0x6f, 0x75, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x62, 0x65,
-	0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x54, 0x6f, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x48, 0x0a, 0x10,
-	0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61,
+	0x01, 0x01, 0x12, 0x47, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1d, 0x0a, 0x0a, 0x70,
+	0x61, 0x73, 0x74, 0x5f, 0x74, 0x65, 0x6e, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x70, 0x61, 0x73, 0x74, 0x54, 0x65, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09,
+	0x69, 0x63, 0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x69, 0x63, 0x6f, 0x6e, 0x50, 0x61, 0x74, 0x68, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x56, 0x0a,
+	0x0e, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2f, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e,
+	0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64,
+	0x69, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75,
+	0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0d, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74,
+	0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x61, 0x72,
+	0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0xbe, 0x03,
+	0x0a, 0x1e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74,
+	0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x47, 0x0a, 0x0f, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x48, 0x00, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x88, 0x01, 0x01, 0x12, 0x40, 0x0a, 0x0b, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x0a, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
+	0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x4d, 0x0a, 0x10,
+	0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61,
 	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65,
-	0x64, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x0f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72,
-	0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69,
-	0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0xca, 0x04, 0x0a, 0x1e, 0x56, 0x61, 0x6c, 0x69, 0x64,
-	0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x12, 0x47, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x0d, 0x6c, 0x61, 0x73,
-	0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x40, 0x0a,
-	0x0b, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01,
-	0x52, 0x0a, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12,
-	0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x34, 0x0a, 0x16, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x6c, 0x6c,
-	0x6f, 0x77, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x02, 0x52, 0x14, 0x6d, 0x69, 0x6e, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61,
-	0x62, 0x6c, 0x65, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x39, 0x0a, 0x16, 0x6d,
-	0x61, 0x78, 0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x71, 0x75, 0x61,
-	0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x02, 0x48, 0x02, 0x52, 0x14, 0x6d,
-	0x61, 0x78, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x62, 0x6c, 0x65, 0x51, 0x75, 0x61, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0x88, 0x01, 0x01, 0x12, 0x4d, 0x0a, 0x10, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x22, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e,
-	0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x55, 0x6e, 0x69, 0x74, 0x52, 0x0f, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e,
-	0x74, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x3d, 0x0a, 0x0a, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69,
-	0x65, 0x6e, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x65, 0x61, 0x6c,
-	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e,
-	0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64,
-	0x69, 0x65, 0x6e, 0x74, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x61, 0x72, 0x63,
-	0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x19, 0x0a, 0x17, 0x5f, 0x6d, 0x61, 0x78,
-	0x5f, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0x22, 0xad, 0x03, 0x0a, 0x1a, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67,
-	0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x64, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0f, 0x69, 0x6e, 0x67, 0x72,
+	0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3d, 0x0a, 0x0a, 0x69,
+	0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1d, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x0a,
+	0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x0e,
+	0x0a, 0x0c, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0xc5,
+	0x04, 0x0a, 0x0f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
 	0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x47, 0x0a,
@@ -6746,773 +9288,737 @@ func (x *AccountInstrumentOwnership) GetQuantity() uint32 {
 	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
 	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
 	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x0a, 0x61, 0x72, 0x63, 0x68, 0x69,
-	0x76, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65,
-	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x40,
-	0x0a, 0x0b, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69,
-	0x6e, 0x67, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x3d, 0x0a, 0x0a, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e,
-	0x69, 0x6e, 0x67, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69,
-	0x65, 0x6e, 0x74, 0x52, 0x0a, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x42,
+	0x76, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x63, 0x6f, 0x6e,
+	0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x63, 0x6f,
+	0x6e, 0x50, 0x61, 0x74, 0x68, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6c, 0x75,
+	0x72, 0x61, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x70, 0x6c, 0x75, 0x72, 0x61, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65,
+	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04,
+	0x73, 0x6c, 0x75, 0x67, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67,
+	0x12, 0x37, 0x0a, 0x18, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x69, 0x6e, 0x5f, 0x73,
+	0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x15, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x49, 0x6e, 0x53, 0x75, 0x6d,
+	0x6d, 0x61, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x73, 0x12, 0x49, 0x0a, 0x21, 0x69, 0x6e, 0x63,
+	0x6c, 0x75, 0x64, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x1e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x49, 0x6e, 0x47,
+	0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x75, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x66,
+	0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x10, 0x75, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69,
+	0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0x8a, 0x04, 0x0a, 0x14, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x12,
+	0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x47, 0x0a, 0x0f, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48,
+	0x00, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x88, 0x01, 0x01, 0x12, 0x40, 0x0a, 0x0b, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x0a, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64,
+	0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x63, 0x6f,
+	0x6e, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x63,
+	0x6f, 0x6e, 0x50, 0x61, 0x74, 0x68, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6c, 0x75, 0x72,
+	0x61, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70,
+	0x6c, 0x75, 0x72, 0x61, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x75,
+	0x67, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x12, 0x1e, 0x0a,
+	0x0a, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0a, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x1c, 0x0a,
+	0x09, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x61, 0x6c, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x09, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x61, 0x6c, 0x12, 0x16, 0x0a, 0x06, 0x6d,
+	0x65, 0x74, 0x72, 0x69, 0x63, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x6d, 0x65, 0x74,
+	0x72, 0x69, 0x63, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6d, 0x70, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x6d, 0x70, 0x65, 0x72, 0x69, 0x61, 0x6c, 0x42,
 	0x12, 0x0a, 0x10, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
 	0x5f, 0x61, 0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64,
-	0x5f, 0x61, 0x74, 0x22, 0xb9, 0x07, 0x0a, 0x13, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x72, 0x65,
-	0x70, 0x54, 0x61, 0x73, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x39, 0x0a, 0x0a, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x47, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x5f, 0x61, 0x74, 0x22, 0x87, 0x04, 0x0a, 0x1e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x65, 0x61,
+	0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x76,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x47, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x40, 0x0a, 0x0b, 0x61, 0x72,
+	0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x0d, 0x6c,
-	0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12,
-	0x40, 0x0a, 0x0b, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x48, 0x01, 0x52, 0x0a, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01,
-	0x01, 0x12, 0x44, 0x0a, 0x1f, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
-	0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x63,
-	0x6f, 0x6e, 0x64, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x1b, 0x6d, 0x69, 0x6e, 0x53,
-	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e,
-	0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x49, 0x0a, 0x1f, 0x6d, 0x61, 0x78, 0x5f, 0x73,
-	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f,
-	0x69, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d,
-	0x48, 0x02, 0x52, 0x1b, 0x6d, 0x61, 0x78, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x44, 0x75,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x88,
-	0x01, 0x01, 0x12, 0x4f, 0x0a, 0x22, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
-	0x65, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x6e,
-	0x5f, 0x63, 0x65, 0x6c, 0x73, 0x69, 0x75, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x48, 0x03,
-	0x52, 0x1e, 0x6d, 0x69, 0x6e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x54, 0x65, 0x6d, 0x70,
-	0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x49, 0x6e, 0x43, 0x65, 0x6c, 0x73, 0x69, 0x75, 0x73,
-	0x88, 0x01, 0x01, 0x12, 0x4f, 0x0a, 0x22, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61,
-	0x67, 0x65, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69,
-	0x6e, 0x5f, 0x63, 0x65, 0x6c, 0x73, 0x69, 0x75, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x02, 0x48,
-	0x04, 0x52, 0x1e, 0x6d, 0x61, 0x78, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x54, 0x65, 0x6d,
-	0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x49, 0x6e, 0x43, 0x65, 0x6c, 0x73, 0x69, 0x75,
-	0x73, 0x88, 0x01, 0x01, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f,
-	0x74, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x73, 0x74, 0x6f, 0x72,
-	0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x31, 0x0a, 0x14, 0x73, 0x74, 0x6f, 0x72, 0x61,
-	0x67, 0x65, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x49, 0x6e,
-	0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f,
-	0x74, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73,
-	0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x40, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x70,
-	0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
-	0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x61, 0x6c,
-	0x69, 0x64, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x70,
-	0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3d, 0x0a, 0x0a, 0x69, 0x6e,
-	0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
-	0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x61,
-	0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x69,
-	0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6c, 0x61,
-	0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x0e, 0x0a,
-	0x0c, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x22, 0x0a,
-	0x20, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x75,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
-	0x73, 0x42, 0x25, 0x0a, 0x23, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67,
-	0x65, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x6e,
-	0x5f, 0x63, 0x65, 0x6c, 0x73, 0x69, 0x75, 0x73, 0x42, 0x25, 0x0a, 0x23, 0x5f, 0x6d, 0x61, 0x78,
-	0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x63, 0x65, 0x6c, 0x73, 0x69, 0x75, 0x73, 0x22,
-	0xee, 0x03, 0x0a, 0x14, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69,
-	0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x41, 0x74, 0x12, 0x40, 0x0a, 0x0b, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f,
-	0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x0a, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64,
-	0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x47, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x0d, 0x6c, 0x61,
-	0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1d,
-	0x0a, 0x0a, 0x70, 0x61, 0x73, 0x74, 0x5f, 0x74, 0x65, 0x6e, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x73, 0x74, 0x54, 0x65, 0x6e, 0x73, 0x65, 0x12, 0x20, 0x0a,
-	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x1b, 0x0a, 0x09, 0x69, 0x63, 0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x69, 0x63, 0x6f, 0x6e, 0x50, 0x61, 0x74, 0x68, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x56, 0x0a, 0x0e, 0x61, 0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x5f, 0x74, 0x79,
-	0x70, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2f, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70,
-	0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67,
-	0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x41, 0x74, 0x74, 0x72,
-	0x69, 0x62, 0x75, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0d, 0x61, 0x74, 0x74, 0x72, 0x69,
-	0x62, 0x75, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67,
-	0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x42, 0x0e, 0x0a, 0x0c,
-	0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x12, 0x0a, 0x10,
-	0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
-	0x22, 0xbe, 0x03, 0x0a, 0x1e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64,
-	0x69, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69,
-	0x65, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x47,
-	0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x40, 0x0a, 0x0b, 0x61, 0x72, 0x63, 0x68, 0x69,
-	0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x0a, 0x61, 0x72, 0x63, 0x68,
-	0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74,
-	0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x4d, 0x0a, 0x10, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74,
-	0x61, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x65, 0x61, 0x6c,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x0a, 0x61,
+	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x4d, 0x0a, 0x13,
+	0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69,
+	0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x65, 0x61, 0x6c,
 	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e,
-	0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0f, 0x69,
-	0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x3d,
-	0x0a, 0x0a, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e,
-	0x67, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e,
-	0x74, 0x52, 0x0a, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x42, 0x12, 0x0a,
-	0x10, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
-	0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61,
-	0x74, 0x22, 0xc5, 0x04, 0x0a, 0x0f, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x73, 0x74, 0x72,
-	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x11, 0x6f, 0x6e, 0x6c, 0x79, 0x46, 0x6f,
+	0x72, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6e,
+	0x6f, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65,
+	0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x36, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x22, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55,
+	0x6e, 0x69, 0x74, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x32, 0x0a, 0x02, 0x74, 0x6f, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e,
+	0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x02, 0x74, 0x6f, 0x12, 0x1a, 0x0a,
+	0x08, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x08, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x0e, 0x0a,
+	0x0c, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0xe0, 0x01,
+	0x0a, 0x21, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69,
+	0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4d, 0x69, 0x73, 0x6d, 0x61,
+	0x74, 0x63, 0x68, 0x12, 0x3d, 0x0a, 0x0a, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c,
+	0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72,
+	0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65,
+	0x6e, 0x74, 0x12, 0x3f, 0x0a, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e,
+	0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x08, 0x66, 0x72, 0x6f, 0x6d, 0x55,
+	0x6e, 0x69, 0x74, 0x12, 0x3b, 0x0a, 0x07, 0x74, 0x6f, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e,
+	0x69, 0x6e, 0x67, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x06, 0x74, 0x6f, 0x55, 0x6e, 0x69, 0x74,
+	0x22, 0x98, 0x09, 0x0a, 0x10, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
 	0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
 	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
 	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
-	0x12, 0x47, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
-	0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x40, 0x0a, 0x0b, 0x61, 0x72, 0x63,
-	0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x0a, 0x61, 0x72,
-	0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x09, 0x69,
-	0x63, 0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
-	0x69, 0x63, 0x6f, 0x6e, 0x50, 0x61, 0x74, 0x68, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1f, 0x0a, 0x0b,
-	0x70, 0x6c, 0x75, 0x72, 0x61, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0a, 0x70, 0x6c, 0x75, 0x72, 0x61, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a,
-	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x12, 0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73,
-	0x6c, 0x75, 0x67, 0x12, 0x37, 0x0a, 0x18, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x69,
-	0x6e, 0x5f, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x18,
-	0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x49, 0x6e,
-	0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x73, 0x12, 0x49, 0x0a, 0x21,
-	0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x67, 0x65, 0x6e, 0x65, 0x72,
-	0x61, 0x74, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1e, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
-	0x49, 0x6e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x49, 0x6e, 0x73, 0x74, 0x72,
-	0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x75, 0x73, 0x61, 0x62, 0x6c,
-	0x65, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x18, 0x0c, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x10, 0x75, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x53, 0x74,
-	0x6f, 0x72, 0x61, 0x67, 0x65, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x61, 0x72,
-	0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0x8a, 0x04, 0x0a, 0x14, 0x56, 0x61,
-	0x6c, 0x69, 0x64, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e,
-	0x69, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x47, 0x0a,
+	0x12, 0x30, 0x0a, 0x14, 0x6d, 0x69, 0x6e, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12,
+	0x6d, 0x69, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x12, 0x35, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0d,
+	0x48, 0x00, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a, 0x14, 0x6d, 0x69, 0x6e,
+	0x5f, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x6d, 0x69, 0x6e, 0x49, 0x6e, 0x67, 0x72,
+	0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x35, 0x0a, 0x14, 0x6d,
+	0x61, 0x78, 0x5f, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x01, 0x52, 0x12, 0x6d, 0x61, 0x78,
+	0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x88,
+	0x01, 0x01, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x69, 0x6e, 0x5f, 0x76, 0x65, 0x73, 0x73, 0x65, 0x6c,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x6d, 0x69,
+	0x6e, 0x56, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2d, 0x0a, 0x10,
+	0x6d, 0x61, 0x78, 0x5f, 0x76, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x17, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x02, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x56, 0x65, 0x73,
+	0x73, 0x65, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x40, 0x0a, 0x0b, 0x61,
+	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x03, 0x52, 0x0a,
+	0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x47, 0x0a,
 	0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x48, 0x00, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x40, 0x0a, 0x0b, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76,
-	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6d, 0x70, 0x48, 0x04, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x63, 0x6f, 0x6e, 0x5f, 0x70,
+	0x61, 0x74, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x63, 0x6f, 0x6e, 0x50,
+	0x61, 0x74, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x73, 0x74, 0x5f, 0x74, 0x65, 0x6e, 0x73,
+	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x73, 0x74, 0x54, 0x65, 0x6e,
+	0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x12, 0x36, 0x0a, 0x17,
+	0x72, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x5f, 0x74, 0x6f, 0x5f, 0x69, 0x6e, 0x67, 0x72,
+	0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x72,
+	0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x54, 0x6f, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69,
+	0x65, 0x6e, 0x74, 0x73, 0x12, 0x31, 0x0a, 0x14, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x13, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x34, 0x0a, 0x16, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x74, 0x69, 0x6d, 0x65, 0x45, 0x73, 0x74,
+	0x69, 0x6d, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x42, 0x0a,
+	0x1d, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x78, 0x70, 0x72, 0x65,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18, 0x10,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x1b, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x45,
+	0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
+	0x64, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x73, 0x5f, 0x76, 0x65,
+	0x73, 0x73, 0x65, 0x6c, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x63, 0x6f, 0x6e, 0x73,
+	0x75, 0x6d, 0x65, 0x73, 0x56, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x12, 0x28, 0x0a, 0x10, 0x6f, 0x6e,
+	0x6c, 0x79, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x76, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x73, 0x18, 0x12,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x6f, 0x6e, 0x6c, 0x79, 0x46, 0x6f, 0x72, 0x56, 0x65, 0x73,
+	0x73, 0x65, 0x6c, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x79, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x5f, 0x6e,
+	0x6f, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x18, 0x13, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x79, 0x69,
+	0x65, 0x6c, 0x64, 0x73, 0x4e, 0x6f, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x12, 0x33, 0x0a, 0x05, 0x6d,
+	0x65, 0x64, 0x69, 0x61, 0x18, 0x14, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x75, 0x70, 0x6c,
+	0x6f, 0x61, 0x64, 0x65, 0x64, 0x5f, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x2e, 0x55, 0x70, 0x6c, 0x6f,
+	0x61, 0x64, 0x65, 0x64, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x05, 0x6d, 0x65, 0x64, 0x69, 0x61,
+	0x42, 0x17, 0x0a, 0x15, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x17, 0x0a, 0x15, 0x5f, 0x6d, 0x61,
+	0x78, 0x5f, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x76, 0x65, 0x73, 0x73, 0x65,
+	0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x61, 0x72, 0x63, 0x68,
+	0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6c, 0x61, 0x73, 0x74,
+	0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0xad, 0x03, 0x0a, 0x1a,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x47, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x0d, 0x6c, 0x61,
+	0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x40,
+	0x0a, 0x0b, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48,
+	0x01, 0x52, 0x0a, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01,
+	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
+	0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x3d, 0x0a, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x65, 0x61,
+	0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49,
+	0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x72,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x40, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x65, 0x61,
+	0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x50,
+	0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x70, 0x72, 0x65, 0x70,
+	0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6c, 0x61, 0x73, 0x74,
+	0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x5f,
+	0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0x9d, 0x03, 0x0a, 0x16,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x56, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x47, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x40, 0x0a, 0x0b, 0x61, 0x72,
+	0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x0a, 0x61,
+	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74,
+	0x65, 0x73, 0x12, 0x40, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c,
+	0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x72, 0x65, 0x70,
+	0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x06, 0x76, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e,
+	0x69, 0x6e, 0x67, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x56, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x52,
+	0x06, 0x76, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6c, 0x61, 0x73, 0x74,
+	0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x5f,
+	0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0xf6, 0x06, 0x0a, 0x0b,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x56, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x12, 0x39, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x40, 0x0a, 0x0b, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
 	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x0a, 0x61, 0x72, 0x63, 0x68, 0x69,
-	0x76, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1b, 0x0a, 0x09,
-	0x69, 0x63, 0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x69, 0x63, 0x6f, 0x6e, 0x50, 0x61, 0x74, 0x68, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x70,
-	0x6c, 0x75, 0x72, 0x61, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x70, 0x6c, 0x75, 0x72, 0x61, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a, 0x04,
-	0x73, 0x6c, 0x75, 0x67, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67,
-	0x12, 0x1e, 0x0a, 0x0a, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x18, 0x0a,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x76, 0x6f, 0x6c, 0x75, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63,
-	0x12, 0x1c, 0x0a, 0x09, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x61, 0x6c, 0x18, 0x0b, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x09, 0x75, 0x6e, 0x69, 0x76, 0x65, 0x72, 0x73, 0x61, 0x6c, 0x12, 0x16,
-	0x0a, 0x06, 0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06,
-	0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6d, 0x70, 0x65, 0x72, 0x69,
-	0x61, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x6d, 0x70, 0x65, 0x72, 0x69,
-	0x61, 0x6c, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69,
-	0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0x87, 0x04, 0x0a, 0x1e, 0x56, 0x61, 0x6c, 0x69, 0x64,
-	0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x43,
-	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x12, 0x47, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x0d, 0x6c, 0x61, 0x73,
-	0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x40, 0x0a,
-	0x0b, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01,
-	0x52, 0x0a, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12,
-	0x4d, 0x0a, 0x13, 0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x69, 0x6e, 0x67, 0x72,
-	0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d,
-	0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x61, 0x6c, 0x69,
-	0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x11, 0x6f, 0x6e, 0x6c,
-	0x79, 0x46, 0x6f, 0x72, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x14,
-	0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e,
-	0x6f, 0x74, 0x65, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x02, 0x69, 0x64, 0x12, 0x36, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18, 0x07, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e,
-	0x67, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x32, 0x0a, 0x02,
-	0x74, 0x6f, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x0a, 0x61, 0x72, 0x63, 0x68, 0x69,
+	0x76, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x47, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74,
+	0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52,
+	0x0d, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01,
+	0x01, 0x12, 0x47, 0x0a, 0x0d, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x5f, 0x75, 0x6e,
+	0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70,
 	0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x65, 0x61,
-	0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x02, 0x74, 0x6f,
-	0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x02, 0x52, 0x08, 0x6d, 0x6f, 0x64, 0x69, 0x66, 0x69, 0x65, 0x72, 0x42, 0x12, 0x0a, 0x10,
-	0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x0c, 0x63, 0x61,
+	0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x63,
+	0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69,
+	0x63, 0x6f, 0x6e, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6c, 0x75, 0x72, 0x61,
+	0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x70, 0x6c,
+	0x75, 0x72, 0x61, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6c,
+	0x75, 0x67, 0x12, 0x34, 0x0a, 0x05, 0x73, 0x68, 0x61, 0x70, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1e, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
+	0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x56, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x53, 0x68, 0x61, 0x70,
+	0x65, 0x52, 0x05, 0x73, 0x68, 0x61, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x77, 0x69, 0x64, 0x74,
+	0x68, 0x5f, 0x69, 0x6e, 0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x02, 0x52, 0x12, 0x77, 0x69, 0x64, 0x74, 0x68, 0x49, 0x6e, 0x4d,
+	0x69, 0x6c, 0x6c, 0x69, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x32, 0x0a, 0x15, 0x6c, 0x65,
+	0x6e, 0x67, 0x74, 0x68, 0x5f, 0x69, 0x6e, 0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x6d, 0x65, 0x74,
+	0x65, 0x72, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x02, 0x52, 0x13, 0x6c, 0x65, 0x6e, 0x67, 0x74,
+	0x68, 0x49, 0x6e, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x32,
+	0x0a, 0x15, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x69, 0x6e, 0x5f, 0x6d, 0x69, 0x6c, 0x6c,
+	0x69, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x02, 0x52, 0x13, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x49, 0x6e, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x6d, 0x65, 0x74, 0x65,
+	0x72, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x18, 0x0f,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x12, 0x49,
+	0x0a, 0x21, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x67, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1e, 0x69, 0x6e, 0x63, 0x6c, 0x75,
+	0x64, 0x65, 0x49, 0x6e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x49, 0x6e, 0x73,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x37, 0x0a, 0x18, 0x64, 0x69, 0x73,
+	0x70, 0x6c, 0x61, 0x79, 0x5f, 0x69, 0x6e, 0x5f, 0x73, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x5f,
+	0x6c, 0x69, 0x73, 0x74, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x64, 0x69, 0x73,
+	0x70, 0x6c, 0x61, 0x79, 0x49, 0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79, 0x4c, 0x69, 0x73,
+	0x74, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x75, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x66, 0x6f, 0x72,
+	0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10,
+	0x75, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65,
 	0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74,
-	0x22, 0xe0, 0x01, 0x0a, 0x21, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x55, 0x6e, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x4d, 0x69,
-	0x73, 0x6d, 0x61, 0x74, 0x63, 0x68, 0x12, 0x3d, 0x0a, 0x0a, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64,
-	0x69, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x65, 0x61,
-	0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49,
-	0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x69, 0x6e, 0x67, 0x72, 0x65,
-	0x64, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x3f, 0x0a, 0x09, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x75, 0x6e,
-	0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70,
-	0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x65, 0x61,
-	0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x08, 0x66, 0x72,
-	0x6f, 0x6d, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x3b, 0x0a, 0x07, 0x74, 0x6f, 0x5f, 0x75, 0x6e, 0x69,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c,
-	0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x65, 0x61, 0x73,
-	0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x06, 0x74, 0x6f, 0x55,
-	0x6e, 0x69, 0x74, 0x22, 0x98, 0x09, 0x0a, 0x10, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x72, 0x65,
-	0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x41, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x6d, 0x69, 0x6e, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x72,
-	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x12, 0x6d, 0x69, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74,
-	0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x35, 0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x6e, 0x73,
-	0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x15, 0x20,
-	0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x12, 0x6d, 0x61, 0x78, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75,
-	0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x30, 0x0a, 0x14,
-	0x6d, 0x69, 0x6e, 0x5f, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x63,
-	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x12, 0x6d, 0x69, 0x6e, 0x49,
-	0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x35,
-	0x0a, 0x14, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74,
-	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x01, 0x52, 0x12,
-	0x6d, 0x61, 0x78, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x10, 0x6d, 0x69, 0x6e, 0x5f, 0x76, 0x65, 0x73,
-	0x73, 0x65, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x0e, 0x6d, 0x69, 0x6e, 0x56, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
-	0x2d, 0x0a, 0x10, 0x6d, 0x61, 0x78, 0x5f, 0x76, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x5f, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x18, 0x17, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x02, 0x52, 0x0e, 0x6d, 0x61, 0x78,
-	0x56, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x88, 0x01, 0x01, 0x12, 0x40,
-	0x0a, 0x0b, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48,
-	0x03, 0x52, 0x0a, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01,
-	0x12, 0x47, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
-	0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x22, 0xc3, 0x03, 0x0a, 0x18, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x67,
+	0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x47, 0x0a, 0x0f,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x48, 0x00, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x40, 0x0a, 0x0b, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x0a, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76,
+	0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x26, 0x0a,
+	0x0f, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x75, 0x73, 0x65, 0x72,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x54,
+	0x6f, 0x55, 0x73, 0x65, 0x72, 0x12, 0x3d, 0x0a, 0x0a, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69,
+	0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x65, 0x61, 0x6c,
+	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e,
+	0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64,
+	0x69, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x6c, 0x6c, 0x65, 0x72, 0x67, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61,
+	0x6c, 0x6c, 0x65, 0x72, 0x67, 0x79, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x61,
+	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0xdc, 0x08, 0x0a, 0x06, 0x52,
+	0x65, 0x63, 0x69, 0x70, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
 	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x04, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x63, 0x6f,
-	0x6e, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x63,
-	0x6f, 0x6e, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x73, 0x74, 0x5f, 0x74,
-	0x65, 0x6e, 0x73, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x73, 0x74,
-	0x54, 0x65, 0x6e, 0x73, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0a, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
-	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x73,
-	0x6c, 0x75, 0x67, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x12,
-	0x36, 0x0a, 0x17, 0x72, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x5f, 0x74, 0x6f, 0x5f, 0x69,
-	0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x15, 0x72, 0x65, 0x73, 0x74, 0x72, 0x69, 0x63, 0x74, 0x54, 0x6f, 0x49, 0x6e, 0x67, 0x72,
-	0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x31, 0x0a, 0x14, 0x74, 0x65, 0x6d, 0x70, 0x65,
-	0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x18,
-	0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75,
-	0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x12, 0x34, 0x0a, 0x16, 0x74, 0x69,
-	0x6d, 0x65, 0x5f, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x5f, 0x72, 0x65, 0x71, 0x75,
-	0x69, 0x72, 0x65, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x74, 0x69, 0x6d, 0x65,
-	0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64,
-	0x12, 0x42, 0x0a, 0x1d, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x78,
-	0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65,
-	0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1b, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69,
-	0x6f, 0x6e, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x69, 0x72, 0x65, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x73,
-	0x5f, 0x76, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x63,
-	0x6f, 0x6e, 0x73, 0x75, 0x6d, 0x65, 0x73, 0x56, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x12, 0x28, 0x0a,
-	0x10, 0x6f, 0x6e, 0x6c, 0x79, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x76, 0x65, 0x73, 0x73, 0x65, 0x6c,
-	0x73, 0x18, 0x12, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x6f, 0x6e, 0x6c, 0x79, 0x46, 0x6f, 0x72,
-	0x56, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x79, 0x69, 0x65, 0x6c, 0x64,
-	0x73, 0x5f, 0x6e, 0x6f, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x18, 0x13, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0d, 0x79, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x4e, 0x6f, 0x74, 0x68, 0x69, 0x6e, 0x67, 0x12, 0x33,
-	0x0a, 0x05, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x18, 0x14, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
-	0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x5f, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x2e, 0x55,
-	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x05, 0x6d, 0x65,
-	0x64, 0x69, 0x61, 0x42, 0x17, 0x0a, 0x15, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x6e, 0x73, 0x74,
-	0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x17, 0x0a, 0x15,
-	0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x5f,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x76, 0x65,
-	0x73, 0x73, 0x65, 0x6c, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x61,
-	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6c,
-	0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0xad,
-	0x03, 0x0a, 0x1a, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x39, 0x0a,
-	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x47, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74,
-	0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x12, 0x36, 0x0a, 0x15, 0x69, 0x6e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x5f,
+	0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x12, 0x69, 0x6e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x64, 0x42, 0x79, 0x52, 0x65, 0x63,
+	0x69, 0x70, 0x65, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x47, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74,
+	0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52,
 	0x0d, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01,
 	0x01, 0x12, 0x40, 0x0a, 0x0b, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x48, 0x01, 0x52, 0x0a, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74,
-	0x88, 0x01, 0x01, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x3d, 0x0a, 0x0a, 0x69, 0x6e, 0x73,
-	0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
-	0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x61, 0x6c,
-	0x69, 0x64, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x69, 0x6e,
-	0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x40, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x70,
-	0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e,
-	0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x61, 0x6c,
-	0x69, 0x64, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x70,
-	0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6c,
-	0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x0e,
-	0x0a, 0x0c, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0x9d,
-	0x03, 0x0a, 0x16, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x56, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x12, 0x47, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x0d, 0x6c, 0x61, 0x73,
-	0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x40, 0x0a,
-	0x0b, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01,
-	0x52, 0x0a, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x40, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x65, 0x61,
-	0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x50,
-	0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x70, 0x72, 0x65, 0x70,
-	0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x31, 0x0a, 0x06, 0x76, 0x65, 0x73, 0x73, 0x65,
-	0x6c, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c,
-	0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x56, 0x65, 0x73, 0x73,
-	0x65, 0x6c, 0x52, 0x06, 0x76, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6c,
-	0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x0e,
-	0x0a, 0x0c, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0xf6,
-	0x06, 0x0a, 0x0b, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x56, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x12, 0x39,
-	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x40, 0x0a, 0x0b, 0x61, 0x72, 0x63,
-	0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x0a, 0x61, 0x72,
-	0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x47, 0x0a, 0x0f, 0x6c,
-	0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x48, 0x01, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41,
-	0x74, 0x88, 0x01, 0x01, 0x12, 0x47, 0x0a, 0x0d, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79,
-	0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x65,
-	0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64,
-	0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x52,
-	0x0c, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x1b, 0x0a,
-	0x09, 0x69, 0x63, 0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x69, 0x63, 0x6f, 0x6e, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x6c,
-	0x75, 0x72, 0x61, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0a, 0x70, 0x6c, 0x75, 0x72, 0x61, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a,
-	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x73, 0x6c, 0x75, 0x67, 0x12, 0x34, 0x0a, 0x05, 0x73, 0x68, 0x61, 0x70, 0x65, 0x18, 0x0a,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1e, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e,
-	0x69, 0x6e, 0x67, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x56, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x53,
-	0x68, 0x61, 0x70, 0x65, 0x52, 0x05, 0x73, 0x68, 0x61, 0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x30, 0x0a, 0x14, 0x77,
-	0x69, 0x64, 0x74, 0x68, 0x5f, 0x69, 0x6e, 0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x6d, 0x65, 0x74,
-	0x65, 0x72, 0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x02, 0x52, 0x12, 0x77, 0x69, 0x64, 0x74, 0x68,
-	0x49, 0x6e, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x32, 0x0a,
-	0x15, 0x6c, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x5f, 0x69, 0x6e, 0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69,
-	0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x02, 0x52, 0x13, 0x6c, 0x65,
-	0x6e, 0x67, 0x74, 0x68, 0x49, 0x6e, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x6d, 0x65, 0x74, 0x65, 0x72,
-	0x73, 0x12, 0x32, 0x0a, 0x15, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x69, 0x6e, 0x5f, 0x6d,
-	0x69, 0x6c, 0x6c, 0x69, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x02,
-	0x52, 0x13, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x49, 0x6e, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x6d,
-	0x65, 0x74, 0x65, 0x72, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74,
-	0x79, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74,
-	0x79, 0x12, 0x49, 0x0a, 0x21, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x5f, 0x69, 0x6e, 0x5f,
-	0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x08, 0x52, 0x1e, 0x69, 0x6e,
-	0x63, 0x6c, 0x75, 0x64, 0x65, 0x49, 0x6e, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64,
-	0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x37, 0x0a, 0x18,
-	0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x69, 0x6e, 0x5f, 0x73, 0x75, 0x6d, 0x6d, 0x61,
-	0x72, 0x79, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15,
-	0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x49, 0x6e, 0x53, 0x75, 0x6d, 0x6d, 0x61, 0x72, 0x79,
-	0x4c, 0x69, 0x73, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x75, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x5f,
-	0x66, 0x6f, 0x72, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x18, 0x12, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x10, 0x75, 0x73, 0x61, 0x62, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x53, 0x74, 0x6f, 0x72,
-	0x61, 0x67, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64,
-	0x5f, 0x61, 0x74, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0xc3, 0x03, 0x0a, 0x18, 0x55, 0x73, 0x65, 0x72,
-	0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72,
-	0x65, 0x6e, 0x63, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
-	0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
-	0x47, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f,
-	0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x40, 0x0a, 0x0b, 0x61, 0x72, 0x63, 0x68,
-	0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x0a, 0x61, 0x72, 0x63,
-	0x68, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f,
-	0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73,
-	0x12, 0x26, 0x0a, 0x0f, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x75,
-	0x73, 0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x62, 0x65, 0x6c, 0x6f, 0x6e,
-	0x67, 0x73, 0x54, 0x6f, 0x55, 0x73, 0x65, 0x72, 0x12, 0x3d, 0x0a, 0x0a, 0x69, 0x6e, 0x67, 0x72,
-	0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d,
-	0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x61, 0x6c, 0x69,
-	0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x69, 0x6e, 0x67,
-	0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e,
-	0x67, 0x18, 0x08, 0x20, 0x01, 0x28, 0x05, 0x52, 0x06, 0x72, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12,
-	0x18, 0x0a, 0x07, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x67, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x07, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x67, 0x79, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6c, 0x61,
-	0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x0e, 0x0a,
-	0x0c, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0xdc, 0x08,
-	0x0a, 0x06, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x41, 0x74, 0x12, 0x36, 0x0a, 0x15, 0x69, 0x6e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x64, 0x5f,
-	0x62, 0x79, 0x5f, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x48, 0x00, 0x52, 0x12, 0x69, 0x6e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x64, 0x42, 0x79,
-	0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x47, 0x0a, 0x0f, 0x6c,
-	0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x48, 0x01, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41,
-	0x74, 0x88, 0x01, 0x01, 0x12, 0x40, 0x0a, 0x0b, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64,
-	0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x48, 0x02, 0x52, 0x0a, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74,
+	0x88, 0x01, 0x01, 0x12, 0x34, 0x0a, 0x16, 0x6d, 0x69, 0x6e, 0x5f, 0x65, 0x73, 0x74, 0x69, 0x6d,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x14, 0x6d, 0x69, 0x6e, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65,
+	0x64, 0x50, 0x6f, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x39, 0x0a, 0x16, 0x6d, 0x61, 0x78,
+	0x5f, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x17, 0x20, 0x01, 0x28, 0x02, 0x48, 0x03, 0x52, 0x14, 0x6d, 0x61, 0x78,
+	0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x50, 0x6f, 0x72, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x13, 0x70, 0x6c, 0x75, 0x72, 0x61, 0x6c, 0x5f, 0x70,
+	0x6f, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x11, 0x70, 0x6c, 0x75, 0x72, 0x61, 0x6c, 0x50, 0x6f, 0x72, 0x74, 0x69, 0x6f, 0x6e,
+	0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
+	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x6f,
+	0x72, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0b, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x26, 0x0a,
+	0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x5f, 0x75, 0x73, 0x65, 0x72,
+	0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42,
+	0x79, 0x55, 0x73, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1f, 0x0a,
+	0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x73, 0x62, 0x6e, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x73, 0x62, 0x6e, 0x12, 0x12,
+	0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x73, 0x6c,
+	0x75, 0x67, 0x12, 0x53, 0x0a, 0x15, 0x79, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x5f, 0x63, 0x6f, 0x6d,
+	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0f, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1f, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
+	0x2e, 0x4d, 0x65, 0x61, 0x6c, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x13, 0x79, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
+	0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3b, 0x0a, 0x0a, 0x70, 0x72, 0x65, 0x70, 0x5f,
+	0x74, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x65,
+	0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x63, 0x69, 0x70,
+	0x65, 0x50, 0x72, 0x65, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x09, 0x70, 0x72, 0x65, 0x70, 0x54,
+	0x61, 0x73, 0x6b, 0x73, 0x12, 0x2e, 0x0a, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18, 0x11, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69,
+	0x6e, 0x67, 0x2e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x52, 0x05, 0x73,
+	0x74, 0x65, 0x70, 0x73, 0x12, 0x2f, 0x0a, 0x05, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x18, 0x12, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69,
+	0x6e, 0x67, 0x2e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x05,
+	0x6d, 0x65, 0x64, 0x69, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18,
+	0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2c, 0x0a,
+	0x12, 0x65, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x6c, 0x65, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x6d, 0x65,
+	0x61, 0x6c, 0x73, 0x18, 0x14, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x65, 0x6c, 0x69, 0x67, 0x69,
+	0x62, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x4d, 0x65, 0x61, 0x6c, 0x73, 0x12, 0x43, 0x0a, 0x12, 0x61,
+	0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65,
+	0x73, 0x18, 0x15, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c,
+	0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x52, 0x11, 0x61,
+	0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x73,
+	0x42, 0x18, 0x0a, 0x16, 0x5f, 0x69, 0x6e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x64, 0x5f, 0x62, 0x79,
+	0x5f, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x5f, 0x69, 0x64, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x0e,
+	0x0a, 0x0c, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x19,
+	0x0a, 0x17, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xa0, 0x04, 0x0a, 0x0b, 0x52, 0x65,
+	0x63, 0x69, 0x70, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x40, 0x0a, 0x0b, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
 	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x02, 0x52, 0x0a, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
-	0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x34, 0x0a, 0x16, 0x6d, 0x69, 0x6e, 0x5f, 0x65, 0x73,
-	0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x52, 0x14, 0x6d, 0x69, 0x6e, 0x45, 0x73, 0x74, 0x69, 0x6d,
-	0x61, 0x74, 0x65, 0x64, 0x50, 0x6f, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x39, 0x0a, 0x16,
-	0x6d, 0x61, 0x78, 0x5f, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x6f,
-	0x72, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x17, 0x20, 0x01, 0x28, 0x02, 0x48, 0x03, 0x52, 0x14,
-	0x6d, 0x61, 0x78, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x50, 0x6f, 0x72, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x13, 0x70, 0x6c, 0x75, 0x72, 0x61,
-	0x6c, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x70, 0x6c, 0x75, 0x72, 0x61, 0x6c, 0x50, 0x6f, 0x72, 0x74,
-	0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72,
-	0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65,
-	0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d,
-	0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a,
-	0x0c, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64,
-	0x12, 0x26, 0x0a, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x5f, 0x75,
-	0x73, 0x65, 0x72, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x64, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x69, 0x73, 0x62, 0x6e, 0x18,
-	0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x49, 0x73, 0x62,
-	0x6e, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x6c, 0x75, 0x67, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x04, 0x73, 0x6c, 0x75, 0x67, 0x12, 0x53, 0x0a, 0x15, 0x79, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x5f,
-	0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0f,
-	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e,
-	0x69, 0x6e, 0x67, 0x2e, 0x4d, 0x65, 0x61, 0x6c, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e,
-	0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x13, 0x79, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x43, 0x6f, 0x6d,
-	0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x3b, 0x0a, 0x0a, 0x70, 0x72,
-	0x65, 0x70, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c,
-	0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65,
-	0x63, 0x69, 0x70, 0x65, 0x50, 0x72, 0x65, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x09, 0x70, 0x72,
-	0x65, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x2e, 0x0a, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73,
-	0x18, 0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61,
-	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70,
-	0x52, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x12, 0x2f, 0x0a, 0x05, 0x6d, 0x65, 0x64, 0x69, 0x61,
-	0x18, 0x12, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61,
-	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x4d, 0x65, 0x64, 0x69,
-	0x61, 0x52, 0x05, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x18, 0x13, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73,
-	0x12, 0x2c, 0x0a, 0x12, 0x65, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x6c, 0x65, 0x5f, 0x66, 0x6f, 0x72,
-	0x5f, 0x6d, 0x65, 0x61, 0x6c, 0x73, 0x18, 0x14, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10, 0x65, 0x6c,
-	0x69, 0x67, 0x69, 0x62, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x4d, 0x65, 0x61, 0x6c, 0x73, 0x12, 0x43,
-	0x0a, 0x12, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x63,
-	0x69, 0x70, 0x65, 0x73, 0x18, 0x15, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x65, 0x61,
-	0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65,
-	0x52, 0x11, 0x61, 0x73, 0x73, 0x6f, 0x63, 0x69, 0x61, 0x74, 0x65, 0x64, 0x52, 0x65, 0x63, 0x69,
-	0x70, 0x65, 0x73, 0x42, 0x18, 0x0a, 0x16, 0x5f, 0x69, 0x6e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x64,
-	0x5f, 0x62, 0x79, 0x5f, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x5f, 0x69, 0x64, 0x42, 0x12, 0x0a,
-	0x10, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
-	0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61,
-	0x74, 0x42, 0x19, 0x0a, 0x17, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61,
-	0x74, 0x65, 0x64, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xa0, 0x04, 0x0a,
-	0x0b, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x12, 0x39, 0x0a, 0x0a,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x40, 0x0a, 0x0b, 0x61, 0x72, 0x63, 0x68, 0x69,
-	0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x0a, 0x61, 0x72, 0x63, 0x68,
-	0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x47, 0x0a, 0x0f, 0x6c, 0x61, 0x73,
-	0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x0a, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
+	0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x47, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x0d, 0x6c,
+	0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x2f, 0x0a, 0x11, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x72, 0x65,
+	0x63, 0x69, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x0f, 0x62, 0x65,
+	0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x54, 0x6f, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x38, 0x0a, 0x16, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x72,
+	0x65, 0x63, 0x69, 0x70, 0x65, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x03, 0x52, 0x13, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x54, 0x6f, 0x52, 0x65, 0x63,
+	0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x69,
+	0x6d, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d,
+	0x69, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x12, 0x23, 0x0a, 0x0d,
+	0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x50, 0x61, 0x74,
+	0x68, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x61, 0x72, 0x63, 0x68,
+	0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6c, 0x61, 0x73, 0x74,
+	0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x14, 0x0a, 0x12, 0x5f,
+	0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x63, 0x69, 0x70,
+	0x65, 0x42, 0x19, 0x0a, 0x17, 0x5f, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x5f, 0x74, 0x6f,
+	0x5f, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x22, 0x94, 0x08, 0x0a,
+	0x0e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x50, 0x72, 0x65, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x12,
+	0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x4f, 0x0a, 0x22, 0x6d, 0x69,
+	0x6e, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x63, 0x65, 0x6c, 0x73, 0x69, 0x75, 0x73,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x48, 0x00, 0x52, 0x1e, 0x6d, 0x69, 0x6e, 0x53, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x49,
+	0x6e, 0x43, 0x65, 0x6c, 0x73, 0x69, 0x75, 0x73, 0x88, 0x01, 0x01, 0x12, 0x4f, 0x0a, 0x22, 0x6d,
+	0x61, 0x78, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x65,
+	0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x63, 0x65, 0x6c, 0x73, 0x69, 0x75,
+	0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x02, 0x48, 0x01, 0x52, 0x1e, 0x6d, 0x61, 0x78, 0x53, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x49, 0x6e, 0x43, 0x65, 0x6c, 0x73, 0x69, 0x75, 0x73, 0x88, 0x01, 0x01, 0x12, 0x54, 0x0a, 0x28,
+	0x6d, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x5f,
+	0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x5f, 0x69, 0x6e,
+	0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x22,
+	0x6d, 0x69, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x42, 0x65, 0x66,
+	0x6f, 0x72, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x49, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x12, 0x59, 0x0a, 0x28, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x62,
+	0x75, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x63,
+	0x69, 0x70, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x10,
+	0x20, 0x01, 0x28, 0x0d, 0x48, 0x02, 0x52, 0x22, 0x6d, 0x61, 0x78, 0x54, 0x69, 0x6d, 0x65, 0x42,
+	0x75, 0x66, 0x66, 0x65, 0x72, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70,
+	0x65, 0x49, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x88, 0x01, 0x01, 0x12, 0x40, 0x0a,
+	0x0b, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01,
 	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01,
-	0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88,
-	0x01, 0x01, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x2f, 0x0a, 0x11, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x5f, 0x74, 0x6f,
-	0x5f, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52,
-	0x0f, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x54, 0x6f, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65,
-	0x88, 0x01, 0x01, 0x12, 0x38, 0x0a, 0x16, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x5f, 0x74,
-	0x6f, 0x5f, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x13, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x54, 0x6f,
-	0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x88, 0x01, 0x01, 0x12, 0x1b, 0x0a,
-	0x09, 0x6d, 0x69, 0x6d, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x6d, 0x69, 0x6d, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x69, 0x6e,
-	0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x08, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0c, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x12,
-	0x23, 0x0a, 0x0d, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x5f, 0x70, 0x61, 0x74, 0x68,
-	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x65, 0x78, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x50, 0x61, 0x74, 0x68, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x0a, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x61,
-	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6c,
-	0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x14,
-	0x0a, 0x12, 0x5f, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x72, 0x65,
-	0x63, 0x69, 0x70, 0x65, 0x42, 0x19, 0x0a, 0x17, 0x5f, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73,
-	0x5f, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x22,
-	0x94, 0x08, 0x0a, 0x0e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x50, 0x72, 0x65, 0x70, 0x54, 0x61,
-	0x73, 0x6b, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x03,
+	0x52, 0x0a, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12,
+	0x47, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x48, 0x04, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2a, 0x0a, 0x11, 0x62,
+	0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x54,
+	0x6f, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x12, 0x42, 0x0a, 0x1d, 0x65, 0x78, 0x70, 0x6c, 0x69,
+	0x63, 0x69, 0x74, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x6e, 0x73, 0x74,
+	0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x1b,
+	0x65, 0x78, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x49,
+	0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6e,
+	0x6f, 0x74, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65,
+	0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3f, 0x0a, 0x0a, 0x74, 0x61, 0x73, 0x6b, 0x5f,
+	0x73, 0x74, 0x65, 0x70, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6d, 0x65,
+	0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x63, 0x69, 0x70,
+	0x65, 0x50, 0x72, 0x65, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x65, 0x70, 0x52, 0x09, 0x74,
+	0x61, 0x73, 0x6b, 0x53, 0x74, 0x65, 0x70, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x61, 0x6c, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x61, 0x6c, 0x42, 0x25, 0x0a, 0x23, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65,
+	0x5f, 0x69, 0x6e, 0x5f, 0x63, 0x65, 0x6c, 0x73, 0x69, 0x75, 0x73, 0x42, 0x25, 0x0a, 0x23, 0x5f,
+	0x6d, 0x61, 0x78, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x65, 0x6d, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x63, 0x65, 0x6c, 0x73, 0x69,
+	0x75, 0x73, 0x42, 0x2b, 0x0a, 0x29, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f,
+	0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x5f, 0x72, 0x65,
+	0x63, 0x69, 0x70, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x42,
+	0x0e, 0x0a, 0x0c, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42,
+	0x12, 0x0a, 0x10, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x22, 0xcb, 0x01, 0x0a, 0x12, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x50, 0x72,
+	0x65, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x65, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x33, 0x0a, 0x16, 0x62, 0x65,
+	0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x5f,
+	0x73, 0x74, 0x65, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x62, 0x65, 0x6c, 0x6f,
+	0x6e, 0x67, 0x73, 0x54, 0x6f, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x12,
+	0x3c, 0x0a, 0x1b, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x72, 0x65,
+	0x63, 0x69, 0x70, 0x65, 0x5f, 0x70, 0x72, 0x65, 0x70, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x54, 0x6f, 0x52,
+	0x65, 0x63, 0x69, 0x70, 0x65, 0x50, 0x72, 0x65, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x32, 0x0a,
+	0x15, 0x73, 0x61, 0x74, 0x69, 0x73, 0x66, 0x69, 0x65, 0x73, 0x5f, 0x72, 0x65, 0x63, 0x69, 0x70,
+	0x65, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x13, 0x73, 0x61,
+	0x74, 0x69, 0x73, 0x66, 0x69, 0x65, 0x73, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65,
+	0x70, 0x22, 0xe2, 0x03, 0x0a, 0x0c, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x52, 0x61, 0x74, 0x69,
+	0x6e, 0x67, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x4f, 0x0a,
-	0x22, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x65, 0x6d,
-	0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x63, 0x65, 0x6c, 0x73,
-	0x69, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x48, 0x00, 0x52, 0x1e, 0x6d, 0x69, 0x6e,
-	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75,
-	0x72, 0x65, 0x49, 0x6e, 0x43, 0x65, 0x6c, 0x73, 0x69, 0x75, 0x73, 0x88, 0x01, 0x01, 0x12, 0x4f,
-	0x0a, 0x22, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x65,
-	0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x63, 0x65, 0x6c,
-	0x73, 0x69, 0x75, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x02, 0x48, 0x01, 0x52, 0x1e, 0x6d, 0x61,
-	0x78, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x75, 0x72, 0x65, 0x49, 0x6e, 0x43, 0x65, 0x6c, 0x73, 0x69, 0x75, 0x73, 0x88, 0x01, 0x01, 0x12,
-	0x54, 0x0a, 0x28, 0x6d, 0x69, 0x6e, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x62, 0x75, 0x66, 0x66,
-	0x65, 0x72, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65,
-	0x5f, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x22, 0x6d, 0x69, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72,
-	0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x49, 0x6e, 0x53, 0x65,
-	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x59, 0x0a, 0x28, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x69, 0x6d,
-	0x65, 0x5f, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x5f,
-	0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64,
-	0x73, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x02, 0x52, 0x22, 0x6d, 0x61, 0x78, 0x54, 0x69,
-	0x6d, 0x65, 0x42, 0x75, 0x66, 0x66, 0x65, 0x72, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x52, 0x65,
-	0x63, 0x69, 0x70, 0x65, 0x49, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x88, 0x01, 0x01,
+	0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x47, 0x0a,
+	0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x48, 0x00, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x40, 0x0a, 0x0b, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x0a, 0x61, 0x72, 0x63, 0x68, 0x69,
+	0x76, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b,
+	0x0a, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x62,
+	0x79, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x79,
+	0x55, 0x73, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x61, 0x73, 0x74, 0x65, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x02, 0x52, 0x05, 0x74, 0x61, 0x73, 0x74, 0x65, 0x12, 0x22, 0x0a, 0x0c, 0x69, 0x6e,
+	0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x07, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6c, 0x65, 0x61,
+	0x6e, 0x75, 0x70, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x02, 0x52, 0x07, 0x63, 0x6c, 0x65, 0x61, 0x6e,
+	0x75, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c, 0x74, 0x79,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75, 0x6c,
+	0x74, 0x79, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69,
+	0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0xa3, 0x02, 0x0a, 0x0e, 0x52, 0x65, 0x63, 0x69, 0x70,
+	0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x12, 0x2b, 0x0a, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x62, 0x79, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52,
+	0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x88, 0x01,
+	0x01, 0x12, 0x30, 0x0a, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73, 0x68, 0x6f, 0x74, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69,
+	0x6e, 0x67, 0x2e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x52, 0x08, 0x73, 0x6e, 0x61, 0x70, 0x73,
+	0x68, 0x6f, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x2a, 0x0a, 0x11, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x5f, 0x74,
+	0x6f, 0x5f, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f,
+	0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x54, 0x6f, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x12,
+	0x27, 0x0a, 0x0f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62,
+	0x65, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x22, 0x4d, 0x0a, 0x11,
+	0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x66, 0x72, 0x6f, 0x6d, 0x12, 0x0e, 0x0a, 0x02, 0x74,
+	0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x74, 0x6f, 0x22, 0xa2, 0x01, 0x0a, 0x13,
+	0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x44,
+	0x69, 0x66, 0x66, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x12,
+	0x39, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e,
+	0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x22, 0x98, 0x03, 0x0a, 0x0e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x44,
+	0x69, 0x66, 0x66, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x74, 0x65, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x74, 0x65, 0x70, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x12, 0x39, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e,
+	0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64,
+	0x43, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12,
+	0x43, 0x0a, 0x0b, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x04,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e,
+	0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
+	0x65, 0x6e, 0x74, 0x44, 0x69, 0x66, 0x66, 0x52, 0x0b, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69,
+	0x65, 0x6e, 0x74, 0x73, 0x12, 0x43, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x65, 0x61, 0x6c,
+	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x43,
+	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x44, 0x69, 0x66, 0x66, 0x52, 0x0b, 0x69, 0x6e,
+	0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x3b, 0x0a, 0x07, 0x76, 0x65, 0x73,
+	0x73, 0x65, 0x6c, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x65, 0x61,
+	0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65,
+	0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x44, 0x69, 0x66, 0x66, 0x52, 0x07, 0x76,
+	0x65, 0x73, 0x73, 0x65, 0x6c, 0x73, 0x12, 0x3d, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70,
+	0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x43, 0x6f,
+	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x44, 0x69, 0x66, 0x66, 0x52, 0x08, 0x70, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0xe6, 0x01, 0x0a, 0x12,
+	0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x44, 0x69,
+	0x66, 0x66, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x49, 0x64, 0x12,
+	0x39, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1f, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e,
+	0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x43, 0x68, 0x61, 0x6e, 0x67,
+	0x65, 0x52, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x73, 0x12, 0x32, 0x0a, 0x05, 0x73, 0x74,
+	0x65, 0x70, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x65, 0x61, 0x6c,
+	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53,
+	0x74, 0x65, 0x70, 0x44, 0x69, 0x66, 0x66, 0x52, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x12, 0x23,
+	0x0a, 0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0c, 0x66, 0x72, 0x6f, 0x6d, 0x52, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x74, 0x6f, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x22, 0xca, 0x0b, 0x0a, 0x0a, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53,
+	0x74, 0x65, 0x70, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x45,
+	0x0a, 0x1d, 0x6d, 0x69, 0x6e, 0x5f, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x19, 0x6d, 0x69, 0x6e, 0x45, 0x73, 0x74, 0x69,
+	0x6d, 0x61, 0x74, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x49, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x88, 0x01, 0x01, 0x12, 0x45, 0x0a, 0x1d, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x73, 0x74,
+	0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x73,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x16, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x01, 0x52, 0x19,
+	0x6d, 0x61, 0x78, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65,
+	0x49, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x88, 0x01, 0x01, 0x12, 0x40, 0x0a, 0x1a,
+	0x6d, 0x69, 0x6e, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f,
+	0x69, 0x6e, 0x5f, 0x63, 0x65, 0x6c, 0x73, 0x69, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02,
+	0x48, 0x02, 0x52, 0x17, 0x6d, 0x69, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x49, 0x6e, 0x43, 0x65, 0x6c, 0x73, 0x69, 0x75, 0x73, 0x88, 0x01, 0x01, 0x12, 0x40,
+	0x0a, 0x1a, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72,
+	0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x63, 0x65, 0x6c, 0x73, 0x69, 0x75, 0x73, 0x18, 0x17, 0x20, 0x01,
+	0x28, 0x02, 0x48, 0x03, 0x52, 0x17, 0x6d, 0x61, 0x78, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x49, 0x6e, 0x43, 0x65, 0x6c, 0x73, 0x69, 0x75, 0x73, 0x88, 0x01, 0x01,
 	0x12, 0x40, 0x0a, 0x0b, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
 	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x48, 0x03, 0x52, 0x0a, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x88,
+	0x70, 0x48, 0x04, 0x52, 0x0a, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x88,
 	0x01, 0x01, 0x12, 0x47, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74,
 	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
 	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x04, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73,
-	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2a,
-	0x0a, 0x11, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x63,
-	0x69, 0x70, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x62, 0x65, 0x6c, 0x6f, 0x6e,
-	0x67, 0x73, 0x54, 0x6f, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x12, 0x42, 0x0a, 0x1d, 0x65, 0x78,
-	0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x69,
-	0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x1b, 0x65, 0x78, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x53, 0x74, 0x6f, 0x72, 0x61,
-	0x67, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14,
-	0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e,
-	0x6f, 0x74, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
-	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x3f, 0x0a, 0x0a, 0x74, 0x61,
-	0x73, 0x6b, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20,
-	0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65,
-	0x63, 0x69, 0x70, 0x65, 0x50, 0x72, 0x65, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x65, 0x70,
-	0x52, 0x09, 0x74, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x65, 0x70, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x42, 0x25, 0x0a, 0x23, 0x5f, 0x6d, 0x69, 0x6e, 0x5f,
-	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x75, 0x72, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x63, 0x65, 0x6c, 0x73, 0x69, 0x75, 0x73, 0x42, 0x25,
-	0x0a, 0x23, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x74,
-	0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x63, 0x65,
-	0x6c, 0x73, 0x69, 0x75, 0x73, 0x42, 0x2b, 0x0a, 0x29, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x69,
-	0x6d, 0x65, 0x5f, 0x62, 0x75, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65,
-	0x5f, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e,
-	0x64, 0x73, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f,
-	0x61, 0x74, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0xcb, 0x01, 0x0a, 0x12, 0x52, 0x65, 0x63, 0x69, 0x70,
-	0x65, 0x50, 0x72, 0x65, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x65, 0x70, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x33, 0x0a,
-	0x16, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x63, 0x69,
-	0x70, 0x65, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x62,
-	0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x54, 0x6f, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74,
-	0x65, 0x70, 0x12, 0x3c, 0x0a, 0x1b, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x5f, 0x74, 0x6f,
-	0x5f, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x5f, 0x70, 0x72, 0x65, 0x70, 0x5f, 0x74, 0x61, 0x73,
-	0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73,
-	0x54, 0x6f, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x50, 0x72, 0x65, 0x70, 0x54, 0x61, 0x73, 0x6b,
-	0x12, 0x32, 0x0a, 0x15, 0x73, 0x61, 0x74, 0x69, 0x73, 0x66, 0x69, 0x65, 0x73, 0x5f, 0x72, 0x65,
-	0x63, 0x69, 0x70, 0x65, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x13, 0x73, 0x61, 0x74, 0x69, 0x73, 0x66, 0x69, 0x65, 0x73, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65,
-	0x53, 0x74, 0x65, 0x70, 0x22, 0xe2, 0x03, 0x0a, 0x0c, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x52,
-	0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
-	0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
-	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x05, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x11, 0x62,
+	0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x54,
+	0x6f, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x12, 0x31, 0x0a, 0x14, 0x63, 0x6f, 0x6e, 0x64, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f,
+	0x74, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73,
+	0x12, 0x33, 0x0a, 0x15, 0x65, 0x78, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x5f, 0x69, 0x6e, 0x73,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x14, 0x65, 0x78, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2f, 0x0a, 0x05, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x18, 0x0b,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e,
+	0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52,
+	0x05, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x12, 0x3b, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70,
+	0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74,
+	0x65, 0x70, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x73, 0x12, 0x44, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70,
+	0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74,
+	0x65, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x69, 0x6e,
+	0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x38, 0x0a, 0x07, 0x76, 0x65, 0x73,
+	0x73, 0x65, 0x6c, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x65, 0x61,
+	0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65,
+	0x53, 0x74, 0x65, 0x70, 0x56, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x52, 0x07, 0x76, 0x65, 0x73, 0x73,
+	0x65, 0x6c, 0x73, 0x12, 0x60, 0x0a, 0x15, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0f, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e,
+	0x67, 0x2e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x43, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x14, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x64, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x44, 0x0a, 0x0b, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x65, 0x61,
+	0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65,
+	0x53, 0x74, 0x65, 0x70, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x0b,
+	0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x40, 0x0a, 0x0b, 0x70,
+	0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x0b, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x14, 0x0a,
+	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e,
+	0x64, 0x65, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x18,
+	0x13, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x12,
+	0x3a, 0x0a, 0x19, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x72, 0x5f, 0x61,
+	0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x6c, 0x79, 0x18, 0x14, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x17, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x72, 0x41, 0x75,
+	0x74, 0x6f, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x6c, 0x79, 0x12, 0x3e, 0x0a, 0x0b, 0x73,
+	0x74, 0x65, 0x70, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x18, 0x15, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1d, 0x2e, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x5f, 0x6d, 0x65, 0x64, 0x69,
+	0x61, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52,
+	0x0a, 0x73, 0x74, 0x65, 0x70, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x42, 0x20, 0x0a, 0x1e, 0x5f,
+	0x6d, 0x69, 0x6e, 0x5f, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x69,
+	0x6d, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x42, 0x20, 0x0a,
+	0x1e, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x74, 0x69, 0x6d, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x42,
+	0x1d, 0x0a, 0x1b, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x63, 0x65, 0x6c, 0x73, 0x69, 0x75, 0x73, 0x42, 0x1d,
+	0x0a, 0x1b, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x63, 0x65, 0x6c, 0x73, 0x69, 0x75, 0x73, 0x42, 0x0e, 0x0a,
+	0x0c, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x12, 0x0a,
+	0x10, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x22, 0xa8, 0x04, 0x0a, 0x1d, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70,
+	0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x40,
+	0x0a, 0x0b, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48,
+	0x00, 0x52, 0x0a, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01,
 	0x12, 0x47, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
-	0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
 	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x40, 0x0a, 0x0b, 0x61, 0x72, 0x63,
-	0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x0a, 0x61, 0x72,
-	0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x14, 0x0a, 0x05, 0x6e,
-	0x6f, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65,
-	0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x06,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x49, 0x64, 0x12, 0x17,
-	0x0a, 0x07, 0x62, 0x79, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x06, 0x62, 0x79, 0x55, 0x73, 0x65, 0x72, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x61, 0x73, 0x74, 0x65,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x02, 0x52, 0x05, 0x74, 0x61, 0x73, 0x74, 0x65, 0x12, 0x22, 0x0a,
-	0x0c, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x02, 0x52, 0x0c, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x02, 0x52, 0x07, 0x6f, 0x76, 0x65, 0x72, 0x61, 0x6c, 0x6c, 0x12, 0x18, 0x0a, 0x07, 0x63,
-	0x6c, 0x65, 0x61, 0x6e, 0x75, 0x70, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x02, 0x52, 0x07, 0x63, 0x6c,
-	0x65, 0x61, 0x6e, 0x75, 0x70, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69, 0x63, 0x75,
-	0x6c, 0x74, 0x79, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0a, 0x64, 0x69, 0x66, 0x66, 0x69,
-	0x63, 0x75, 0x6c, 0x74, 0x79, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x61, 0x72,
-	0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0xca, 0x0b, 0x0a, 0x0a, 0x52, 0x65,
-	0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x41, 0x74, 0x12, 0x45, 0x0a, 0x1d, 0x6d, 0x69, 0x6e, 0x5f, 0x65, 0x73, 0x74, 0x69, 0x6d,
-	0x61, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x63,
-	0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x19, 0x6d, 0x69,
-	0x6e, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x49, 0x6e,
-	0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x88, 0x01, 0x01, 0x12, 0x45, 0x0a, 0x1d, 0x6d, 0x61,
-	0x78, 0x5f, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65,
-	0x5f, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x16, 0x20, 0x01, 0x28,
-	0x0d, 0x48, 0x01, 0x52, 0x19, 0x6d, 0x61, 0x78, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65,
-	0x64, 0x54, 0x69, 0x6d, 0x65, 0x49, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x88, 0x01,
-	0x01, 0x12, 0x40, 0x0a, 0x1a, 0x6d, 0x69, 0x6e, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x63, 0x65, 0x6c, 0x73, 0x69, 0x75, 0x73, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x02, 0x48, 0x02, 0x52, 0x17, 0x6d, 0x69, 0x6e, 0x54, 0x65, 0x6d, 0x70,
-	0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x49, 0x6e, 0x43, 0x65, 0x6c, 0x73, 0x69, 0x75, 0x73,
-	0x88, 0x01, 0x01, 0x12, 0x40, 0x0a, 0x1a, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x65,
-	0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x63, 0x65, 0x6c, 0x73, 0x69, 0x75,
-	0x73, 0x18, 0x17, 0x20, 0x01, 0x28, 0x02, 0x48, 0x03, 0x52, 0x17, 0x6d, 0x61, 0x78, 0x54, 0x65,
-	0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x49, 0x6e, 0x43, 0x65, 0x6c, 0x73, 0x69,
-	0x75, 0x73, 0x88, 0x01, 0x01, 0x12, 0x40, 0x0a, 0x0b, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
-	0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x04, 0x52, 0x0a, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76,
-	0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x47, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x05, 0x52, 0x0d,
-	0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01,
-	0x12, 0x2a, 0x0a, 0x11, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x72,
-	0x65, 0x63, 0x69, 0x70, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x62, 0x65, 0x6c,
-	0x6f, 0x6e, 0x67, 0x73, 0x54, 0x6f, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x12, 0x31, 0x0a, 0x14,
-	0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x78, 0x70, 0x72, 0x65, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x63, 0x6f, 0x6e, 0x64,
-	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x72, 0x65, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12,
-	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
-	0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05,
-	0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x15, 0x65, 0x78, 0x70, 0x6c, 0x69, 0x63, 0x69,
-	0x74, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0a,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x65, 0x78, 0x70, 0x6c, 0x69, 0x63, 0x69, 0x74, 0x49, 0x6e,
-	0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2f, 0x0a, 0x05, 0x6d, 0x65,
-	0x64, 0x69, 0x61, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x65, 0x61, 0x6c,
-	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x4d,
-	0x65, 0x64, 0x69, 0x61, 0x52, 0x05, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x12, 0x3b, 0x0a, 0x08, 0x70,
-	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1f, 0x2e,
-	0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x63,
-	0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x08,
-	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x12, 0x44, 0x0a, 0x0b, 0x69, 0x6e, 0x73, 0x74,
-	0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x22, 0x2e,
-	0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x63,
-	0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e,
-	0x74, 0x52, 0x0b, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x38,
-	0x0a, 0x07, 0x76, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x1e, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x52,
-	0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x56, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x52,
-	0x07, 0x76, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x73, 0x12, 0x60, 0x0a, 0x15, 0x63, 0x6f, 0x6d, 0x70,
-	0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c,
-	0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65,
-	0x70, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x64, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x14, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e,
-	0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x44, 0x0a, 0x0b, 0x69, 0x6e,
-	0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x22, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x52,
-	0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69,
-	0x65, 0x6e, 0x74, 0x52, 0x0b, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73,
-	0x12, 0x40, 0x0a, 0x0b, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x11, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e,
-	0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0b, 0x70, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x12, 0x20, 0x01, 0x28,
-	0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x61, 0x6c, 0x18, 0x13, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x61, 0x6c, 0x12, 0x3a, 0x0a, 0x19, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69,
-	0x6d, 0x65, 0x72, 0x5f, 0x61, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x6c,
-	0x79, 0x18, 0x14, 0x20, 0x01, 0x28, 0x08, 0x52, 0x17, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69,
-	0x6d, 0x65, 0x72, 0x41, 0x75, 0x74, 0x6f, 0x6d, 0x61, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x6c, 0x79,
-	0x12, 0x3e, 0x0a, 0x0b, 0x73, 0x74, 0x65, 0x70, 0x5f, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x73, 0x18,
-	0x15, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x75, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64,
-	0x5f, 0x6d, 0x65, 0x64, 0x69, 0x61, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x4d,
-	0x65, 0x64, 0x69, 0x61, 0x52, 0x0a, 0x73, 0x74, 0x65, 0x70, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x73,
-	0x42, 0x20, 0x0a, 0x1e, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74,
-	0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e,
-	0x64, 0x73, 0x42, 0x20, 0x0a, 0x1e, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x73, 0x74, 0x69, 0x6d,
-	0x61, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x63,
-	0x6f, 0x6e, 0x64, 0x73, 0x42, 0x1d, 0x0a, 0x1b, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x74, 0x65, 0x6d,
-	0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x63, 0x65, 0x6c, 0x73,
-	0x69, 0x75, 0x73, 0x42, 0x1d, 0x0a, 0x1b, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x74, 0x65, 0x6d, 0x70,
-	0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x63, 0x65, 0x6c, 0x73, 0x69,
-	0x75, 0x73, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f,
-	0x61, 0x74, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0xa8, 0x04, 0x0a, 0x1d, 0x52, 0x65, 0x63, 0x69, 0x70,
-	0x65, 0x53, 0x74, 0x65, 0x70, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x43,
-	0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x4d, 0x0a, 0x10, 0x69, 0x6e, 0x67,
+	0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69,
+	0x6e, 0x67, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65,
+	0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0f, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69,
+	0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x33, 0x0a, 0x16, 0x62, 0x65, 0x6c, 0x6f,
+	0x6e, 0x67, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x5f, 0x73, 0x74,
+	0x65, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67,
+	0x73, 0x54, 0x6f, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x12, 0x14, 0x0a,
+	0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f,
+	0x74, 0x65, 0x73, 0x12, 0x57, 0x0a, 0x0b, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e,
+	0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70,
+	0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74,
+	0x65, 0x70, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x52,
+	0x0b, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x61, 0x72, 0x63,
+	0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0xb6, 0x03, 0x0a,
+	0x27, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x43, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e,
+	0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
 	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
 	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
 	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
@@ -7524,609 +10030,810 @@ func (x *AccountInstrumentOwnership) GetQuantity() uint32 {
 	0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
 	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
 	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x0d, 0x6c, 0x61,
-	0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x4d,
-	0x0a, 0x10, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x73, 0x74, 0x61,
-	0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70,
-	0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67,
-	0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x0f, 0x69, 0x6e,
-	0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x33, 0x0a,
-	0x16, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x63, 0x69,
-	0x70, 0x65, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x62,
-	0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x54, 0x6f, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74,
-	0x65, 0x70, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x57, 0x0a, 0x0b, 0x69, 0x6e, 0x67, 0x72,
-	0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x35, 0x2e,
-	0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x63,
-	0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f,
-	0x6e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64,
-	0x69, 0x65, 0x6e, 0x74, 0x52, 0x0b, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74,
-	0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x42, 0x0e, 0x0a,
-	0x0c, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x12, 0x0a,
-	0x10, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
-	0x74, 0x22, 0xb6, 0x03, 0x0a, 0x27, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70,
-	0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74,
-	0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x39, 0x0a,
-	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x40, 0x0a, 0x0b, 0x61, 0x72, 0x63, 0x68,
-	0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x0a, 0x61, 0x72, 0x63,
-	0x68, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x47, 0x0a, 0x0f, 0x6c, 0x61,
-	0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48,
-	0x01, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74,
-	0x88, 0x01, 0x01, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x02, 0x69, 0x64, 0x12, 0x5b, 0x0a, 0x2b, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x5f, 0x74,
-	0x6f, 0x5f, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x5f, 0x63, 0x6f,
-	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69,
-	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x26, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67,
-	0x73, 0x54, 0x6f, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x43, 0x6f, 0x6d,
-	0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x34, 0x0a, 0x16, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x5f,
-	0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x14, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x49, 0x6e, 0x67, 0x72,
-	0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69,
-	0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f,
-	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0xfd, 0x08, 0x0a, 0x14, 0x52,
-	0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69,
-	0x65, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x45,
-	0x0a, 0x1d, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x5f, 0x70, 0x72,
-	0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x19, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53,
-	0x74, 0x65, 0x70, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65,
-	0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x40, 0x0a, 0x0b, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
-	0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x0a, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76,
-	0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x3d, 0x0a, 0x0a, 0x69, 0x6e, 0x67, 0x72, 0x65,
-	0x64, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x65,
-	0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64,
-	0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x69, 0x6e, 0x67, 0x72,
-	0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x47, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x02, 0x52, 0x0d, 0x6c,
-	0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12,
-	0x26, 0x0a, 0x0c, 0x76, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x03, 0x52, 0x0b, 0x76, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x49,
-	0x6e, 0x64, 0x65, 0x78, 0x88, 0x01, 0x01, 0x12, 0x3e, 0x0a, 0x19, 0x70, 0x72, 0x6f, 0x64, 0x75,
-	0x63, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f,
-	0x5f, 0x75, 0x73, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x48, 0x04, 0x52, 0x16, 0x70, 0x72,
-	0x6f, 0x64, 0x75, 0x63, 0x74, 0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x54,
-	0x6f, 0x55, 0x73, 0x65, 0x88, 0x01, 0x01, 0x12, 0x38, 0x0a, 0x16, 0x72, 0x65, 0x63, 0x69, 0x70,
-	0x65, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69,
-	0x64, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x48, 0x05, 0x52, 0x13, 0x72, 0x65, 0x63, 0x69, 0x70,
-	0x65, 0x53, 0x74, 0x65, 0x70, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x88, 0x01,
-	0x01, 0x12, 0x33, 0x0a, 0x16, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x5f, 0x74, 0x6f, 0x5f,
-	0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x13, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x54, 0x6f, 0x52, 0x65, 0x63, 0x69,
-	0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x25, 0x0a, 0x0e, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
-	0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x29, 0x0a,
-	0x10, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x6f, 0x74, 0x65,
-	0x73, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69,
-	0x65, 0x6e, 0x74, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c,
-	0x6d, 0x69, 0x6e, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x0e, 0x20, 0x01,
-	0x28, 0x02, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12,
-	0x26, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18,
-	0x15, 0x20, 0x01, 0x28, 0x02, 0x48, 0x06, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x51, 0x75, 0x61, 0x6e,
-	0x74, 0x69, 0x74, 0x79, 0x88, 0x01, 0x01, 0x12, 0x4d, 0x0a, 0x10, 0x6d, 0x65, 0x61, 0x73, 0x75,
-	0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
-	0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e,
-	0x74, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x0f, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6f, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x5f, 0x74, 0x61, 0x73, 0x74,
-	0x65, 0x18, 0x12, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x74, 0x6f, 0x54, 0x61, 0x73, 0x74, 0x65,
-	0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0d, 0x52,
-	0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x5f,
-	0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x14, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x73, 0x63,
-	0x61, 0x6c, 0x65, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x20, 0x0a, 0x1e, 0x5f, 0x72, 0x65,
-	0x63, 0x69, 0x70, 0x65, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
-	0x74, 0x5f, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x5f, 0x69, 0x64, 0x42, 0x0e, 0x0a, 0x0c, 0x5f,
-	0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x12, 0x0a, 0x10, 0x5f,
-	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42,
-	0x0f, 0x0a, 0x0d, 0x5f, 0x76, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78,
-	0x42, 0x1c, 0x0a, 0x1a, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x70, 0x65, 0x72,
-	0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x75, 0x73, 0x65, 0x42, 0x19,
-	0x0a, 0x17, 0x5f, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x5f, 0x70,
-	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x6d, 0x61,
-	0x78, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0x80, 0x06, 0x0a, 0x14, 0x52,
-	0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d,
-	0x65, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3d,
-	0x0a, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e,
-	0x67, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e,
-	0x74, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x47, 0x0a,
-	0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x48, 0x00, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x38, 0x0a, 0x16, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65,
-	0x5f, 0x73, 0x74, 0x65, 0x70, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x13, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65,
-	0x53, 0x74, 0x65, 0x70, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01,
+	0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x5b,
+	0x0a, 0x2b, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x63,
+	0x69, 0x70, 0x65, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x63, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x26, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x54, 0x6f, 0x52, 0x65,
+	0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69,
+	0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x16, 0x72,
+	0x65, 0x63, 0x69, 0x70, 0x65, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x5f, 0x69, 0x6e, 0x67, 0x72, 0x65,
+	0x64, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x14, 0x72, 0x65, 0x63,
+	0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e,
+	0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0xfd, 0x08, 0x0a, 0x14, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65,
+	0x53, 0x74, 0x65, 0x70, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x39,
+	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x45, 0x0a, 0x1d, 0x72, 0x65, 0x63,
+	0x69, 0x70, 0x65, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x5f, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x00, 0x52, 0x19, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x50, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x49, 0x64, 0x88, 0x01, 0x01,
 	0x12, 0x40, 0x0a, 0x0b, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x48, 0x02, 0x52, 0x0a, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x88,
-	0x01, 0x01, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x33, 0x0a, 0x16,
+	0x70, 0x48, 0x01, 0x52, 0x0a, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x88,
+	0x01, 0x01, 0x12, 0x3d, 0x0a, 0x0a, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61,
+	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65,
+	0x64, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e,
+	0x74, 0x12, 0x47, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x02, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x76, 0x65,
+	0x73, 0x73, 0x65, 0x6c, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d,
+	0x48, 0x03, 0x52, 0x0b, 0x76, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x88,
+	0x01, 0x01, 0x12, 0x3e, 0x0a, 0x19, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x70, 0x65,
+	0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x75, 0x73, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x02, 0x48, 0x04, 0x52, 0x16, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x50, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x55, 0x73, 0x65, 0x88,
+	0x01, 0x01, 0x12, 0x38, 0x0a, 0x16, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x5f, 0x73, 0x74, 0x65,
+	0x70, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x05, 0x52, 0x13, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70,
+	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x33, 0x0a, 0x16,
 	0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x63, 0x69, 0x70,
-	0x65, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x62, 0x65,
+	0x65, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x62, 0x65,
 	0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x54, 0x6f, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65,
-	0x70, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x51, 0x75, 0x61, 0x6e,
-	0x74, 0x69, 0x74, 0x79, 0x12, 0x26, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x71, 0x75, 0x61, 0x6e,
-	0x74, 0x69, 0x74, 0x79, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x03, 0x52, 0x0b, 0x6d, 0x61,
-	0x78, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x0c,
-	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x0b, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x0b, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12,
-	0x27, 0x0a, 0x0f, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x72, 0x61,
-	0x6e, 0x6b, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72,
-	0x65, 0x6e, 0x63, 0x65, 0x52, 0x61, 0x6e, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x61, 0x6c, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x61, 0x6c, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x0e, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x63,
-	0x61, 0x6c, 0x65, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x02,
-	0x52, 0x0b, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x12, 0x0a,
-	0x10, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
-	0x74, 0x42, 0x19, 0x0a, 0x17, 0x5f, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x5f, 0x73, 0x74, 0x65,
-	0x70, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x42, 0x0e, 0x0a, 0x0c,
-	0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x0f, 0x0a, 0x0d,
-	0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0x9a, 0x0c,
-	0x0a, 0x11, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x50, 0x72, 0x6f, 0x64,
-	0x75, 0x63, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x4f,
-	0x0a, 0x22, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x65,
-	0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x63, 0x65, 0x6c,
-	0x73, 0x69, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x02, 0x48, 0x00, 0x52, 0x1e, 0x6d, 0x69,
-	0x6e, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74,
-	0x75, 0x72, 0x65, 0x49, 0x6e, 0x43, 0x65, 0x6c, 0x73, 0x69, 0x75, 0x73, 0x88, 0x01, 0x01, 0x12,
-	0x4f, 0x0a, 0x22, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x74,
-	0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x63, 0x65,
-	0x6c, 0x73, 0x69, 0x75, 0x73, 0x18, 0x14, 0x20, 0x01, 0x28, 0x02, 0x48, 0x01, 0x52, 0x1e, 0x6d,
-	0x61, 0x78, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61,
-	0x74, 0x75, 0x72, 0x65, 0x49, 0x6e, 0x43, 0x65, 0x6c, 0x73, 0x69, 0x75, 0x73, 0x88, 0x01, 0x01,
-	0x12, 0x49, 0x0a, 0x1f, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f,
-	0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f,
-	0x6e, 0x64, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x02, 0x52, 0x1b, 0x6d, 0x69, 0x6e,
-	0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
-	0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x88, 0x01, 0x01, 0x12, 0x49, 0x0a, 0x1f, 0x6d,
-	0x61, 0x78, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x15,
-	0x20, 0x01, 0x28, 0x0d, 0x48, 0x03, 0x52, 0x1b, 0x6d, 0x61, 0x78, 0x53, 0x74, 0x6f, 0x72, 0x61,
-	0x67, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x53, 0x65, 0x63, 0x6f,
-	0x6e, 0x64, 0x73, 0x88, 0x01, 0x01, 0x12, 0x3d, 0x0a, 0x18, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x65,
-	0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x48, 0x04, 0x52, 0x16, 0x6d, 0x69, 0x6e, 0x4d,
-	0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x88, 0x01, 0x01, 0x12, 0x3d, 0x0a, 0x18, 0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x65, 0x61,
-	0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x18, 0x16, 0x20, 0x01, 0x28, 0x02, 0x48, 0x05, 0x52, 0x16, 0x6d, 0x61, 0x78, 0x4d, 0x65,
-	0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a, 0x11, 0x6d, 0x69, 0x6e, 0x5f, 0x69, 0x74, 0x65, 0x6d,
-	0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x48,
-	0x06, 0x52, 0x0f, 0x6d, 0x69, 0x6e, 0x49, 0x74, 0x65, 0x6d, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x88, 0x01, 0x01, 0x12, 0x2f, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x74, 0x65,
-	0x6d, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x17, 0x20, 0x01, 0x28, 0x02,
-	0x48, 0x07, 0x52, 0x0f, 0x6d, 0x61, 0x78, 0x49, 0x74, 0x65, 0x6d, 0x51, 0x75, 0x61, 0x6e, 0x74,
-	0x69, 0x74, 0x79, 0x88, 0x01, 0x01, 0x12, 0x40, 0x0a, 0x0b, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76,
-	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x08, 0x52, 0x0a, 0x61, 0x72, 0x63, 0x68, 0x69,
-	0x76, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x47, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74,
-	0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x70, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x25, 0x0a, 0x0e, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x6e, 0x6f,
+	0x74, 0x65, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x71, 0x75, 0x61, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x67, 0x72,
+	0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0f, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x4e, 0x6f,
+	0x74, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x5f, 0x71,
+	0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x6d,
+	0x69, 0x6e, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x26, 0x0a, 0x0c, 0x6d, 0x61,
+	0x78, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x15, 0x20, 0x01, 0x28, 0x02,
+	0x48, 0x06, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x88,
+	0x01, 0x01, 0x12, 0x4d, 0x0a, 0x10, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6d,
+	0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74,
+	0x52, 0x0f, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69,
+	0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49,
+	0x6e, 0x64, 0x65, 0x78, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c,
+	0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c,
+	0x12, 0x19, 0x0a, 0x08, 0x74, 0x6f, 0x5f, 0x74, 0x61, 0x73, 0x74, 0x65, 0x18, 0x12, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x74, 0x6f, 0x54, 0x61, 0x73, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f,
+	0x72, 0x18, 0x14, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x46, 0x61,
+	0x63, 0x74, 0x6f, 0x72, 0x42, 0x20, 0x0a, 0x1e, 0x5f, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x5f,
+	0x73, 0x74, 0x65, 0x70, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x72, 0x65, 0x63,
+	0x69, 0x70, 0x65, 0x5f, 0x69, 0x64, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69,
+	0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x76,
+	0x65, 0x73, 0x73, 0x65, 0x6c, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x42, 0x1c, 0x0a, 0x1a, 0x5f,
+	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x61,
+	0x67, 0x65, 0x5f, 0x74, 0x6f, 0x5f, 0x75, 0x73, 0x65, 0x42, 0x19, 0x0a, 0x17, 0x5f, 0x72, 0x65,
+	0x63, 0x69, 0x70, 0x65, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63,
+	0x74, 0x5f, 0x69, 0x64, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x71, 0x75, 0x61,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0x80, 0x06, 0x0a, 0x14, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65,
+	0x53, 0x74, 0x65, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x39,
+	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x3d, 0x0a, 0x0a, 0x69, 0x6e, 0x73,
+	0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x69, 0x6e,
+	0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x47, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74,
+	0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x09, 0x52,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52,
 	0x0d, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01,
-	0x01, 0x12, 0x4d, 0x0a, 0x10, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x65,
-	0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64,
-	0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x52,
-	0x0f, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74,
-	0x12, 0x3e, 0x0a, 0x19, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x64, 0x5f, 0x69, 0x6e,
-	0x5f, 0x76, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x0d, 0x48, 0x0a, 0x52, 0x16, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x64,
-	0x49, 0x6e, 0x56, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x88, 0x01, 0x01,
-	0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x33, 0x0a, 0x16, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x5f,
-	0x74, 0x6f, 0x5f, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x18, 0x0b,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x54, 0x6f, 0x52,
-	0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x12, 0x37, 0x0a, 0x04, 0x74, 0x79, 0x70,
-	0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c,
-	0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65,
-	0x70, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79,
-	0x70, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x31, 0x0a, 0x14, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x6e,
-	0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x13, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x5f, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x71,
-	0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05,
-	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64,
-	0x65, 0x78, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f, 0x77, 0x61, 0x73, 0x74, 0x65, 0x18, 0x11,
-	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x57, 0x61, 0x73, 0x74, 0x65, 0x12, 0x1b, 0x0a,
-	0x09, 0x69, 0x73, 0x5f, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x18, 0x12, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x08, 0x69, 0x73, 0x4c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f,
-	0x6d, 0x70, 0x6f, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x08, 0x52,
-	0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x25, 0x0a, 0x23,
-	0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x65, 0x6d,
-	0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x63, 0x65, 0x6c, 0x73,
-	0x69, 0x75, 0x73, 0x42, 0x25, 0x0a, 0x23, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x74, 0x6f, 0x72,
-	0x61, 0x67, 0x65, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f,
-	0x69, 0x6e, 0x5f, 0x63, 0x65, 0x6c, 0x73, 0x69, 0x75, 0x73, 0x42, 0x22, 0x0a, 0x20, 0x5f, 0x6d,
+	0x01, 0x12, 0x38, 0x0a, 0x16, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x5f, 0x73, 0x74, 0x65, 0x70,
+	0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x01, 0x52, 0x13, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x50,
+	0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x40, 0x0a, 0x0b, 0x61,
+	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x02, 0x52, 0x0a,
+	0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x14, 0x0a,
+	0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f,
+	0x74, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x33, 0x0a, 0x16, 0x62, 0x65, 0x6c, 0x6f, 0x6e,
+	0x67, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x5f, 0x73, 0x74, 0x65,
+	0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73,
+	0x54, 0x6f, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c,
+	0x6d, 0x69, 0x6e, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12,
+	0x26, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18,
+	0x10, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x03, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x51, 0x75, 0x61, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x88, 0x01, 0x01, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x72,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x0c, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x0e, 0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52,
+	0x61, 0x6e, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x18,
+	0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x61, 0x6c, 0x12,
+	0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x5f, 0x66,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x73, 0x63, 0x61,
+	0x6c, 0x65, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x19, 0x0a, 0x17,
+	0x5f, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x5f, 0x70, 0x72, 0x6f,
+	0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x61, 0x72, 0x63, 0x68,
+	0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x6d, 0x61, 0x78, 0x5f,
+	0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x22, 0x9a, 0x0c, 0x0a, 0x11, 0x52, 0x65, 0x63,
+	0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x39,
+	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x4f, 0x0a, 0x22, 0x6d, 0x69, 0x6e,
+	0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x63, 0x65, 0x6c, 0x73, 0x69, 0x75, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x02, 0x48, 0x00, 0x52, 0x1e, 0x6d, 0x69, 0x6e, 0x53, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x49, 0x6e,
+	0x43, 0x65, 0x6c, 0x73, 0x69, 0x75, 0x73, 0x88, 0x01, 0x01, 0x12, 0x4f, 0x0a, 0x22, 0x6d, 0x61,
+	0x78, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72,
+	0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x63, 0x65, 0x6c, 0x73, 0x69, 0x75, 0x73,
+	0x18, 0x14, 0x20, 0x01, 0x28, 0x02, 0x48, 0x01, 0x52, 0x1e, 0x6d, 0x61, 0x78, 0x53, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x54, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x49,
+	0x6e, 0x43, 0x65, 0x6c, 0x73, 0x69, 0x75, 0x73, 0x88, 0x01, 0x01, 0x12, 0x49, 0x0a, 0x1f, 0x6d,
 	0x69, 0x6e, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x42, 0x22,
-	0x0a, 0x20, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x64,
-	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e,
-	0x64, 0x73, 0x42, 0x1b, 0x0a, 0x19, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x65, 0x61, 0x73, 0x75,
-	0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x42,
-	0x1b, 0x0a, 0x19, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d,
-	0x65, 0x6e, 0x74, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x42, 0x14, 0x0a, 0x12,
-	0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f,
-	0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x61, 0x72, 0x63,
-	0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6c, 0x61, 0x73,
-	0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x1c, 0x0a, 0x1a,
-	0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x5f, 0x76, 0x65,
-	0x73, 0x73, 0x65, 0x6c, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x90, 0x06, 0x0a, 0x10, 0x52,
-	0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x56, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x12,
-	0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x69,
-	0x6e, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x0b, 0x6d, 0x69, 0x6e, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x26, 0x0a,
-	0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x10, 0x20,
-	0x01, 0x28, 0x0d, 0x48, 0x00, 0x52, 0x0b, 0x6d, 0x61, 0x78, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69,
-	0x74, 0x79, 0x88, 0x01, 0x01, 0x12, 0x47, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x48, 0x02, 0x52, 0x1b, 0x6d, 0x69, 0x6e, 0x53, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x88, 0x01, 0x01, 0x12, 0x49, 0x0a, 0x1f, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0d, 0x48,
+	0x03, 0x52, 0x1b, 0x6d, 0x61, 0x78, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x44, 0x75, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x88, 0x01,
+	0x01, 0x12, 0x3d, 0x0a, 0x18, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x02, 0x48, 0x04, 0x52, 0x16, 0x6d, 0x69, 0x6e, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x88, 0x01, 0x01,
+	0x12, 0x3d, 0x0a, 0x18, 0x6d, 0x61, 0x78, 0x5f, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x16, 0x20, 0x01,
+	0x28, 0x02, 0x48, 0x05, 0x52, 0x16, 0x6d, 0x61, 0x78, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x88, 0x01, 0x01, 0x12,
+	0x2f, 0x0a, 0x11, 0x6d, 0x69, 0x6e, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x71, 0x75, 0x61, 0x6e,
+	0x74, 0x69, 0x74, 0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x02, 0x48, 0x06, 0x52, 0x0f, 0x6d, 0x69,
+	0x6e, 0x49, 0x74, 0x65, 0x6d, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x88, 0x01, 0x01,
+	0x12, 0x2f, 0x0a, 0x11, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x71, 0x75, 0x61,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x17, 0x20, 0x01, 0x28, 0x02, 0x48, 0x07, 0x52, 0x0f, 0x6d,
+	0x61, 0x78, 0x49, 0x74, 0x65, 0x6d, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x88, 0x01,
+	0x01, 0x12, 0x40, 0x0a, 0x0b, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x48, 0x08, 0x52, 0x0a, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74,
+	0x88, 0x01, 0x01, 0x12, 0x47, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x09, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x4d, 0x0a, 0x10,
+	0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61,
+	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x65, 0x61, 0x73, 0x75,
+	0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x0f, 0x6d, 0x65, 0x61, 0x73,
+	0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x3e, 0x0a, 0x19, 0x63,
+	0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x5f, 0x76, 0x65, 0x73, 0x73,
+	0x65, 0x6c, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x0a,
+	0x52, 0x16, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x69, 0x6e, 0x65, 0x64, 0x49, 0x6e, 0x56, 0x65, 0x73,
+	0x73, 0x65, 0x6c, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x88, 0x01, 0x01, 0x12, 0x12, 0x0a, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12,
+	0x33, 0x0a, 0x16, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x72, 0x65,
+	0x63, 0x69, 0x70, 0x65, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x13, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x54, 0x6f, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65,
+	0x53, 0x74, 0x65, 0x70, 0x12, 0x37, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x0c, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x23, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e,
+	0x67, 0x2e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x50, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x31, 0x0a,
+	0x14, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x73, 0x74, 0x6f,
+	0x72, 0x61, 0x67, 0x65, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x25, 0x0a, 0x0e, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x6e, 0x6f, 0x74,
+	0x65, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69,
+	0x74, 0x79, 0x4e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78,
+	0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x19, 0x0a,
+	0x08, 0x69, 0x73, 0x5f, 0x77, 0x61, 0x73, 0x74, 0x65, 0x18, 0x11, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x69, 0x73, 0x57, 0x61, 0x73, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x6c,
+	0x69, 0x71, 0x75, 0x69, 0x64, 0x18, 0x12, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x69, 0x73, 0x4c,
+	0x69, 0x71, 0x75, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x73, 0x74,
+	0x61, 0x62, 0x6c, 0x65, 0x18, 0x13, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70,
+	0x6f, 0x73, 0x74, 0x61, 0x62, 0x6c, 0x65, 0x42, 0x25, 0x0a, 0x23, 0x5f, 0x6d, 0x69, 0x6e, 0x5f,
+	0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74,
+	0x75, 0x72, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x63, 0x65, 0x6c, 0x73, 0x69, 0x75, 0x73, 0x42, 0x25,
+	0x0a, 0x23, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x74,
+	0x65, 0x6d, 0x70, 0x65, 0x72, 0x61, 0x74, 0x75, 0x72, 0x65, 0x5f, 0x69, 0x6e, 0x5f, 0x63, 0x65,
+	0x6c, 0x73, 0x69, 0x75, 0x73, 0x42, 0x22, 0x0a, 0x20, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x73, 0x74,
+	0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x42, 0x22, 0x0a, 0x20, 0x5f, 0x6d, 0x61,
+	0x78, 0x5f, 0x73, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x42, 0x1b, 0x0a,
+	0x19, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x42, 0x1b, 0x0a, 0x19, 0x5f, 0x6d,
+	0x61, 0x78, 0x5f, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x71,
+	0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x6d, 0x69, 0x6e, 0x5f,
+	0x69, 0x74, 0x65, 0x6d, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x42, 0x14, 0x0a,
+	0x12, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x74, 0x65, 0x6d, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x1c, 0x0a, 0x1a, 0x5f, 0x63, 0x6f, 0x6e, 0x74,
+	0x61, 0x69, 0x6e, 0x65, 0x64, 0x5f, 0x69, 0x6e, 0x5f, 0x76, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x5f,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x90, 0x06, 0x0a, 0x10, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65,
+	0x53, 0x74, 0x65, 0x70, 0x56, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
 	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x0d, 0x6c, 0x61,
-	0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x40,
-	0x0a, 0x0b, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48,
-	0x02, 0x52, 0x0a, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01,
-	0x12, 0x38, 0x0a, 0x16, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x5f,
-	0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
-	0x48, 0x03, 0x52, 0x13, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x50, 0x72,
-	0x6f, 0x64, 0x75, 0x63, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x31, 0x0a, 0x06, 0x76, 0x65,
-	0x73, 0x73, 0x65, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x65, 0x61,
-	0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x56,
-	0x65, 0x73, 0x73, 0x65, 0x6c, 0x52, 0x06, 0x76, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a,
-	0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f,
-	0x74, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x16, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x5f, 0x74,
-	0x6f, 0x5f, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x13, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x54, 0x6f, 0x52, 0x65,
-	0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x12, 0x2d, 0x0a, 0x12, 0x76, 0x65, 0x73, 0x73,
-	0x65, 0x6c, 0x5f, 0x70, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x76, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x50, 0x72, 0x65, 0x70,
-	0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x75,
-	0x6e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72,
-	0x5f, 0x73, 0x74, 0x65, 0x70, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x75, 0x6e, 0x61,
-	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x41, 0x66, 0x74, 0x65, 0x72, 0x53, 0x74, 0x65,
-	0x70, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d,
-	0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x63,
-	0x61, 0x6c, 0x65, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x02,
-	0x52, 0x0b, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x0f, 0x0a,
-	0x0d, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x42, 0x12,
-	0x0a, 0x10, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f,
-	0x61, 0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f,
-	0x61, 0x74, 0x42, 0x19, 0x0a, 0x17, 0x5f, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x5f, 0x73, 0x74,
-	0x65, 0x70, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x22, 0xde, 0x04,
-	0x0a, 0x04, 0x4d, 0x65, 0x61, 0x6c, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
-	0x74, 0x12, 0x40, 0x0a, 0x0b, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x21, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x5f, 0x71, 0x75, 0x61,
+	0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6d, 0x69, 0x6e,
+	0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x12, 0x26, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f,
+	0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x00,
+	0x52, 0x0b, 0x6d, 0x61, 0x78, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x88, 0x01, 0x01,
+	0x12, 0x47, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x40, 0x0a, 0x0b, 0x61, 0x72, 0x63,
+	0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x02, 0x52, 0x0a, 0x61, 0x72,
+	0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x38, 0x0a, 0x16, 0x72,
+	0x65, 0x63, 0x69, 0x70, 0x65, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x5f, 0x70, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x13, 0x72,
+	0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
+	0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x31, 0x0a, 0x06, 0x76, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e,
+	0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x56, 0x65, 0x73, 0x73, 0x65, 0x6c,
+	0x52, 0x06, 0x76, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65,
+	0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x33,
+	0x0a, 0x16, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x63,
+	0x69, 0x70, 0x65, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13,
+	0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x54, 0x6f, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53,
+	0x74, 0x65, 0x70, 0x12, 0x2d, 0x0a, 0x12, 0x76, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x5f, 0x70, 0x72,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x11, 0x76, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x50, 0x72, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x75, 0x6e, 0x61, 0x76, 0x61, 0x69,
+	0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x61, 0x66, 0x74, 0x65, 0x72, 0x5f, 0x73, 0x74, 0x65, 0x70,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x75, 0x6e, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61,
+	0x62, 0x6c, 0x65, 0x41, 0x66, 0x74, 0x65, 0x72, 0x53, 0x74, 0x65, 0x70, 0x12, 0x14, 0x0a, 0x05,
+	0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x64,
+	0x65, 0x78, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x5f, 0x66,
+	0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x73, 0x63, 0x61,
+	0x6c, 0x65, 0x46, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x6d, 0x61, 0x78,
+	0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6c, 0x61,
+	0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x0e, 0x0a,
+	0x0c, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x19, 0x0a,
+	0x17, 0x5f, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x5f, 0x70, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x5f, 0x69, 0x64, 0x22, 0xde, 0x04, 0x0a, 0x04, 0x4d, 0x65, 0x61,
+	0x6c, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x40, 0x0a, 0x0b,
+	0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52,
+	0x0a, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x47,
+	0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x34, 0x0a, 0x16, 0x6d, 0x69, 0x6e, 0x5f, 0x65,
+	0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x14, 0x6d, 0x69, 0x6e, 0x45, 0x73, 0x74, 0x69,
+	0x6d, 0x61, 0x74, 0x65, 0x64, 0x50, 0x6f, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x39, 0x0a,
+	0x16, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x70,
+	0x6f, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x02, 0x48, 0x02, 0x52,
+	0x14, 0x6d, 0x61, 0x78, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x50, 0x6f, 0x72,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x88, 0x01, 0x01, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63,
+	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64,
+	0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0f, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x55, 0x73,
+	0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e,
+	0x65, 0x6e, 0x74, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6d, 0x65, 0x61,
+	0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x4d, 0x65, 0x61, 0x6c, 0x43, 0x6f,
+	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65,
+	0x6e, 0x74, 0x73, 0x12, 0x35, 0x0a, 0x17, 0x65, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x6c, 0x65, 0x5f,
+	0x66, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x61, 0x6c, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x73, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x65, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x6c, 0x65, 0x46, 0x6f,
+	0x72, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x73, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x61,
+	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x19,
+	0x0a, 0x17, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xa8, 0x01, 0x0a, 0x0d, 0x4d, 0x65,
+	0x61, 0x6c, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x46, 0x0a, 0x0e, 0x63,
+	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69,
+	0x6e, 0x67, 0x2e, 0x4d, 0x65, 0x61, 0x6c, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
+	0x54, 0x79, 0x70, 0x65, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69,
+	0x6e, 0x67, 0x2e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x52, 0x06, 0x72, 0x65, 0x63, 0x69, 0x70,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x5f, 0x73, 0x63, 0x61, 0x6c,
+	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53,
+	0x63, 0x61, 0x6c, 0x65, 0x22, 0x9b, 0x06, 0x0a, 0x08, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61,
+	0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x43, 0x0a, 0x0f,
+	0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e,
+	0x65, 0x12, 0x40, 0x0a, 0x0b, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
 	0x6d, 0x70, 0x48, 0x00, 0x52, 0x0a, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74,
 	0x88, 0x01, 0x01, 0x12, 0x47, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
 	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
 	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x34, 0x0a, 0x16,
-	0x6d, 0x69, 0x6e, 0x5f, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x6f,
-	0x72, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52, 0x14, 0x6d, 0x69,
-	0x6e, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x50, 0x6f, 0x72, 0x74, 0x69, 0x6f,
-	0x6e, 0x73, 0x12, 0x39, 0x0a, 0x16, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61,
-	0x74, 0x65, 0x64, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0b, 0x20, 0x01,
-	0x28, 0x02, 0x48, 0x02, 0x52, 0x14, 0x6d, 0x61, 0x78, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74,
-	0x65, 0x64, 0x50, 0x6f, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x88, 0x01, 0x01, 0x12, 0x0e, 0x0a,
-	0x02, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x20, 0x0a,
-	0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x26, 0x0a, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x5f, 0x75, 0x73,
-	0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3b, 0x0a, 0x0a, 0x63,
-	0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x1b, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x4d,
-	0x65, 0x61, 0x6c, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x63, 0x6f,
-	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x35, 0x0a, 0x17, 0x65, 0x6c, 0x69, 0x67,
-	0x69, 0x62, 0x6c, 0x65, 0x5f, 0x66, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x61, 0x6c, 0x5f, 0x70, 0x6c,
-	0x61, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x14, 0x65, 0x6c, 0x69, 0x67, 0x69,
-	0x62, 0x6c, 0x65, 0x46, 0x6f, 0x72, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x73, 0x42,
-	0x0e, 0x0a, 0x0c, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42,
-	0x12, 0x0a, 0x10, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
-	0x5f, 0x61, 0x74, 0x42, 0x19, 0x0a, 0x17, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x65, 0x73, 0x74, 0x69,
-	0x6d, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xa8,
-	0x01, 0x0a, 0x0d, 0x4d, 0x65, 0x61, 0x6c, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74,
-	0x12, 0x46, 0x0a, 0x0e, 0x63, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79,
-	0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70,
-	0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x4d, 0x65, 0x61, 0x6c, 0x43, 0x6f, 0x6d, 0x70,
-	0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0d, 0x63, 0x6f, 0x6d, 0x70, 0x6f,
-	0x6e, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x72, 0x65, 0x63, 0x69,
-	0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70,
-	0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x52, 0x06,
-	0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65,
-	0x5f, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0b, 0x72, 0x65,
-	0x63, 0x69, 0x70, 0x65, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x22, 0x9b, 0x06, 0x0a, 0x08, 0x4d, 0x65,
-	0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x0e, 0x0a, 0x02,
+	0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x34, 0x0a, 0x06,
+	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x6d,
+	0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x4d, 0x65, 0x61, 0x6c,
+	0x50, 0x6c, 0x61, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x4d, 0x0a, 0x0f, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x24, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
+	0x2e, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x45, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x0e, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x2c, 0x0a, 0x12, 0x62, 0x65, 0x6c, 0x6f, 0x6e,
+	0x67, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x10, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x54, 0x6f, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x5f, 0x62, 0x79, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x55, 0x73, 0x65, 0x72, 0x12, 0x33, 0x0a,
+	0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x4d, 0x65, 0x61,
+	0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x38, 0x0a, 0x18, 0x67, 0x72, 0x6f, 0x63, 0x65, 0x72, 0x79, 0x5f, 0x6c, 0x69,
+	0x73, 0x74, 0x5f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x18, 0x0c,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x16, 0x67, 0x72, 0x6f, 0x63, 0x65, 0x72, 0x79, 0x4c, 0x69, 0x73,
+	0x74, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d,
+	0x74, 0x61, 0x73, 0x6b, 0x73, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x0c, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x12, 0x4b, 0x0a, 0x0a, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
+	0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e,
+	0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x63,
+	0x69, 0x70, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0a, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x0e,
+	0x0a, 0x0c, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x12,
+	0x0a, 0x10, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x22, 0x85, 0x03, 0x0a, 0x10, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x41,
+	0x63, 0x74, 0x69, 0x76, 0x69, 0x74, 0x79, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70,
+	0x65, 0x12, 0x2b, 0x0a, 0x12, 0x6d, 0x65, 0x61, 0x6c, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x5f, 0x65,
+	0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6d,
+	0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x2d,
+	0x0a, 0x13, 0x6d, 0x65, 0x61, 0x6c, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x5f, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6d, 0x65, 0x61,
+	0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x36, 0x0a,
+	0x18, 0x6d, 0x65, 0x61, 0x6c, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x76, 0x6f, 0x74, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x14, 0x6d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x56,
+	0x6f, 0x74, 0x65, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x11, 0x6d, 0x65, 0x61, 0x6c, 0x5f, 0x70, 0x6c,
+	0x61, 0x6e, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x6d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x49, 0x64,
+	0x12, 0x17, 0x0a, 0x07, 0x62, 0x79, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x08, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x62, 0x79, 0x55, 0x73, 0x65, 0x72, 0x12, 0x2f, 0x0a, 0x14, 0x62, 0x65, 0x6c,
+	0x6f, 0x6e, 0x67, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x6d, 0x65, 0x61, 0x6c, 0x5f, 0x70, 0x6c, 0x61,
+	0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73,
+	0x54, 0x6f, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x22, 0xa4, 0x01, 0x0a, 0x14, 0x4d,
+	0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x46,
+	0x65, 0x65, 0x64, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14,
+	0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2b, 0x0a, 0x11, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x10, 0x73, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x74,
+	0x68, 0x22, 0xce, 0x07, 0x0a, 0x10, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
 	0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
 	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
 	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
-	0x74, 0x12, 0x43, 0x0a, 0x0f, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x65, 0x61, 0x64,
-	0x6c, 0x69, 0x6e, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x44, 0x65,
-	0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x12, 0x40, 0x0a, 0x0b, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76,
-	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
-	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x0a, 0x61, 0x72, 0x63, 0x68, 0x69,
-	0x76, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x47, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74,
-	0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52,
-	0x0d, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01,
-	0x01, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x34, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x0e, 0x32, 0x1c, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
-	0x2e, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x4d, 0x0a,
-	0x0f, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61,
-	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x45, 0x6c,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x0e, 0x65, 0x6c,
-	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x2c, 0x0a, 0x12,
-	0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67,
-	0x73, 0x54, 0x6f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x0a, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x55, 0x73,
-	0x65, 0x72, 0x12, 0x33, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x0b, 0x20, 0x03,
-	0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e,
-	0x67, 0x2e, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52,
-	0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x38, 0x0a, 0x18, 0x67, 0x72, 0x6f, 0x63, 0x65,
-	0x72, 0x79, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69,
-	0x7a, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x16, 0x67, 0x72, 0x6f, 0x63, 0x65,
-	0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65,
-	0x64, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x5f, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x64, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x74, 0x61, 0x73, 0x6b, 0x73, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x12, 0x4b, 0x0a, 0x0a, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6d, 0x65, 0x61,
-	0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c,
-	0x61, 0x6e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65,
-	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64,
-	0x5f, 0x61, 0x74, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0xb4, 0x04, 0x0a, 0x0d, 0x4d, 0x65, 0x61, 0x6c,
-	0x50, 0x6c, 0x61, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x74, 0x12, 0x4e, 0x0a, 0x15, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x74, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x13, 0x6e, 0x65,
+	0x78, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x69, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x41,
+	0x74, 0x12, 0x51, 0x0a, 0x14, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x69, 0x6e, 0x73, 0x74, 0x61, 0x6e,
+	0x74, 0x69, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x12, 0x6c,
+	0x61, 0x73, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x69, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x88, 0x01, 0x01, 0x12, 0x47, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
 	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x12, 0x37, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x5f, 0x61,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x52, 0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x41, 0x74, 0x12, 0x33, 0x0a,
-	0x07, 0x65, 0x6e, 0x64, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x65, 0x6e, 0x64, 0x73,
-	0x41, 0x74, 0x12, 0x40, 0x0a, 0x0b, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61,
-	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x0d, 0x6c, 0x61, 0x73,
+	0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x40, 0x0a,
+	0x0b, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x02,
+	0x52, 0x0a, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12,
+	0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e,
+	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x4d, 0x0a, 0x0f, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x09, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x24, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e,
+	0x67, 0x2e, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x45, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x0e, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x63, 0x75,
+	0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x5f, 0x72, 0x75, 0x6c, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0e, 0x72, 0x65, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x75, 0x6c,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x0b, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x12, 0x2c, 0x0a,
+	0x12, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x61, 0x63, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x62, 0x65, 0x6c, 0x6f, 0x6e,
+	0x67, 0x73, 0x54, 0x6f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x79, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x0d,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x42, 0x79, 0x55,
+	0x73, 0x65, 0x72, 0x12, 0x38, 0x0a, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x0e, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e,
+	0x67, 0x2e, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x05, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x12, 0x48, 0x0a,
+	0x21, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x64, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65,
+	0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x1d, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67,
+	0x44, 0x65, 0x61, 0x64, 0x6c, 0x69, 0x6e, 0x65, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x49, 0x6e,
+	0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x63, 0x61, 0x6e, 0x64, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x18, 0x10,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73,
+	0x50, 0x65, 0x72, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x3c, 0x0a, 0x1b, 0x61, 0x76, 0x6f, 0x69, 0x64,
+	0x5f, 0x6d, 0x65, 0x61, 0x6c, 0x73, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x6c, 0x61, 0x73, 0x74,
+	0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x73, 0x18, 0x11, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x17, 0x61, 0x76,
+	0x6f, 0x69, 0x64, 0x4d, 0x65, 0x61, 0x6c, 0x73, 0x46, 0x72, 0x6f, 0x6d, 0x4c, 0x61, 0x73, 0x74,
+	0x50, 0x6c, 0x61, 0x6e, 0x73, 0x42, 0x17, 0x0a, 0x15, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x69,
+	0x6e, 0x73, 0x74, 0x61, 0x6e, 0x74, 0x69, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x12,
+	0x0a, 0x10, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x22, 0xea, 0x02, 0x0a, 0x14, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x54,
+	0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x25, 0x0a, 0x0c, 0x6d,
+	0x65, 0x61, 0x6c, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x0a, 0x6d, 0x65, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x64, 0x88,
+	0x01, 0x01, 0x12, 0x29, 0x0a, 0x0e, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x5f, 0x6c, 0x69, 0x73,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0c, 0x72, 0x65,
+	0x63, 0x69, 0x70, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x3c, 0x0a,
+	0x09, 0x6d, 0x65, 0x61, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1f, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e,
+	0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d,
+	0x65, 0x52, 0x08, 0x6d, 0x65, 0x61, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f,
+	0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73,
+	0x12, 0x19, 0x0a, 0x08, 0x6d, 0x65, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x73, 0x18, 0x06, 0x20, 0x03,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x61, 0x6c, 0x49, 0x64, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x64,
+	0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x11, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x49, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x1e, 0x0a, 0x0b, 0x64,
+	0x61, 0x79, 0x5f, 0x6f, 0x66, 0x5f, 0x77, 0x65, 0x65, 0x6b, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x09, 0x64, 0x61, 0x79, 0x4f, 0x66, 0x57, 0x65, 0x65, 0x6b, 0x42, 0x0f, 0x0a, 0x0d, 0x5f,
+	0x6d, 0x65, 0x61, 0x6c, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x42, 0x11, 0x0a, 0x0f,
+	0x5f, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x22,
+	0xb4, 0x04, 0x0a, 0x0d, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x37, 0x0a, 0x09,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x73, 0x74, 0x61,
+	0x72, 0x74, 0x73, 0x41, 0x74, 0x12, 0x33, 0x0a, 0x07, 0x65, 0x6e, 0x64, 0x73, 0x5f, 0x61, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x06, 0x65, 0x6e, 0x64, 0x73, 0x41, 0x74, 0x12, 0x40, 0x0a, 0x0b, 0x61, 0x72,
+	0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x0a, 0x61,
+	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x47, 0x0a, 0x0f,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x48, 0x01, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x3c, 0x0a, 0x09, 0x6d, 0x65, 0x61, 0x6c, 0x5f, 0x6e, 0x61,
+	0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1f, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70,
+	0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x52, 0x08, 0x6d, 0x65, 0x61, 0x6c, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x14, 0x62, 0x65, 0x6c,
+	0x6f, 0x6e, 0x67, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x6d, 0x65, 0x61, 0x6c, 0x5f, 0x70, 0x6c, 0x61,
+	0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73,
+	0x54, 0x6f, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x36, 0x0a, 0x07, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x65,
+	0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x4d, 0x65, 0x61, 0x6c, 0x50,
+	0x6c, 0x61, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0xdc, 0x05, 0x0a, 0x18, 0x4d, 0x65, 0x61, 0x6c, 0x50,
+	0x6c, 0x61, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x6c, 0x6c, 0x79, 0x52, 0x65, 0x70,
+	0x6f, 0x72, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x0a, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x41,
-	0x74, 0x88, 0x01, 0x01, 0x12, 0x47, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
-	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x0d, 0x6c, 0x61, 0x73,
-	0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x3c, 0x0a,
-	0x09, 0x6d, 0x65, 0x61, 0x6c, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e,
-	0x32, 0x1f, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e,
-	0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d,
-	0x65, 0x52, 0x08, 0x6d, 0x65, 0x61, 0x6c, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6e,
-	0x6f, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65,
-	0x73, 0x12, 0x2f, 0x0a, 0x14, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x5f, 0x74, 0x6f, 0x5f,
-	0x6d, 0x65, 0x61, 0x6c, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x11, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x54, 0x6f, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c,
-	0x61, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
-	0x69, 0x64, 0x12, 0x36, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x0a, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69,
-	0x6e, 0x67, 0x2e, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x61,
+	0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x40,
+	0x0a, 0x0b, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48,
+	0x00, 0x52, 0x0a, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01,
+	0x12, 0x40, 0x0a, 0x09, 0x74, 0x69, 0x65, 0x5f, 0x62, 0x72, 0x65, 0x61, 0x6b, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69,
+	0x6e, 0x67, 0x2e, 0x45, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x65, 0x42, 0x72,
+	0x65, 0x61, 0x6b, 0x48, 0x01, 0x52, 0x08, 0x74, 0x69, 0x65, 0x42, 0x72, 0x65, 0x61, 0x6b, 0x88,
+	0x01, 0x01, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x3a, 0x0a, 0x1a, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x5f, 0x74, 0x6f,
+	0x5f, 0x6d, 0x65, 0x61, 0x6c, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x54,
+	0x6f, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x4d,
+	0x0a, 0x0f, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6d, 0x65, 0x74, 0x68, 0x6f,
+	0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x24, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c,
+	0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x45,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x0e, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x77, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x77,
+	0x69, 0x6e, 0x6e, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61,
+	0x74, 0x65, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x61, 0x6e, 0x64, 0x69,
+	0x64, 0x61, 0x74, 0x65, 0x73, 0x12, 0x3c, 0x0a, 0x06, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x73, 0x18,
+	0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e,
+	0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x45, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x61, 0x6e,
+	0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52, 0x06, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x73, 0x12, 0x5b, 0x0a, 0x14, 0x70, 0x61, 0x69, 0x72, 0x77, 0x69, 0x73, 0x65, 0x5f,
+	0x70, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x28, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
+	0x2e, 0x45, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x61, 0x69, 0x72, 0x77, 0x69, 0x73,
+	0x65, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x13, 0x70, 0x61, 0x69,
+	0x72, 0x77, 0x69, 0x73, 0x65, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73,
+	0x12, 0x33, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x6e, 0x64, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1b, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e,
+	0x45, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x52, 0x06, 0x72,
+	0x6f, 0x75, 0x6e, 0x64, 0x73, 0x12, 0x21, 0x0a, 0x0c, 0x62, 0x61, 0x6c, 0x6c, 0x6f, 0x74, 0x5f,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x62, 0x61, 0x6c,
+	0x6c, 0x6f, 0x74, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x74, 0x69, 0x65, 0x5f,
+	0x62, 0x72, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x74, 0x69,
+	0x65, 0x42, 0x72, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x61, 0x72, 0x63, 0x68,
+	0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x74, 0x69, 0x65, 0x5f,
+	0x62, 0x72, 0x65, 0x61, 0x6b, 0x22, 0x4c, 0x0a, 0x16, 0x45, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x12,
+	0x1c, 0x0a, 0x09, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x01, 0x52, 0x05, 0x73, 0x63,
+	0x6f, 0x72, 0x65, 0x22, 0x72, 0x0a, 0x1a, 0x45, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x61, 0x69, 0x72, 0x77, 0x69, 0x73, 0x65, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x12,
+	0x1a, 0x0a, 0x08, 0x6f, 0x70, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x6f, 0x70, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x73,
+	0x74, 0x72, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x73,
+	0x74, 0x72, 0x65, 0x6e, 0x67, 0x74, 0x68, 0x22, 0x85, 0x01, 0x0a, 0x0d, 0x45, 0x6c, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x3c, 0x0a, 0x06, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x6d, 0x65, 0x61, 0x6c,
+	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x45, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x53, 0x63, 0x6f, 0x72, 0x65, 0x52,
+	0x06, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x65, 0x6c, 0x69, 0x6d, 0x69,
+	0x6e, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x65, 0x6c, 0x69,
+	0x6d, 0x69, 0x6e, 0x61, 0x74, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22,
+	0x6f, 0x0a, 0x10, 0x45, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x65, 0x42, 0x72,
+	0x65, 0x61, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x67, 0x79, 0x12,
+	0x16, 0x0a, 0x06, 0x63, 0x68, 0x6f, 0x73, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x63, 0x68, 0x6f, 0x73, 0x65, 0x6e, 0x12, 0x27, 0x0a, 0x0f, 0x74, 0x69, 0x65, 0x64, 0x5f,
+	0x63, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09,
+	0x52, 0x0e, 0x74, 0x69, 0x65, 0x64, 0x43, 0x61, 0x6e, 0x64, 0x69, 0x64, 0x61, 0x74, 0x65, 0x73,
+	0x22, 0xba, 0x0a, 0x0a, 0x17, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x47, 0x72, 0x6f,
+	0x63, 0x65, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x39, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x32, 0x0a, 0x12, 0x71, 0x75, 0x61, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x5f, 0x70, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x02, 0x48, 0x00, 0x52, 0x11, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x50,
+	0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x0e, 0x70,
+	0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x02, 0x48, 0x01, 0x52, 0x0d, 0x70, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x70, 0x75, 0x72, 0x63, 0x68,
+	0x61, 0x73, 0x65, 0x64, 0x5f, 0x75, 0x70, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02,
+	0x52, 0x0c, 0x70, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x64, 0x55, 0x70, 0x63, 0x88, 0x01,
+	0x01, 0x12, 0x40, 0x0a, 0x0b, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x48, 0x03, 0x52, 0x0a, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74,
+	0x88, 0x01, 0x01, 0x12, 0x47, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x04, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x60, 0x0a, 0x1a,
+	0x70, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x64, 0x5f, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x55, 0x6e, 0x69, 0x74, 0x52, 0x18, 0x70, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x64, 0x4d,
+	0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x2f,
+	0x0a, 0x14, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x6d, 0x65, 0x61,
+	0x6c, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x62, 0x65,
+	0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x54, 0x6f, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x12,
+	0x43, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x2b, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x4d,
+	0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x47, 0x72, 0x6f, 0x63, 0x65, 0x72, 0x79, 0x4c, 0x69,
+	0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x65,
+	0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x11, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x02, 0x69, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x69, 0x6e, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x5f, 0x6e, 0x65, 0x65, 0x64, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x11, 0x6d, 0x69, 0x6e, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4e, 0x65, 0x65,
+	0x64, 0x65, 0x64, 0x12, 0x33, 0x0a, 0x13, 0x6d, 0x61, 0x78, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x5f, 0x6e, 0x65, 0x65, 0x64, 0x65, 0x64, 0x18, 0x14, 0x20, 0x01, 0x28, 0x02,
+	0x48, 0x05, 0x52, 0x11, 0x6d, 0x61, 0x78, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4e,
+	0x65, 0x65, 0x64, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x4d, 0x0a, 0x10, 0x6d, 0x65, 0x61, 0x73,
+	0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e,
+	0x67, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x0f, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x3d, 0x0a, 0x0a, 0x69, 0x6e, 0x67, 0x72, 0x65,
+	0x64, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x65,
+	0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x69, 0x6e, 0x67, 0x72,
+	0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x41, 0x0a, 0x1b, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67,
+	0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x6d, 0x65, 0x61, 0x6c, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x5f, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x48, 0x06, 0x52, 0x17, 0x62,
+	0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x54, 0x6f, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x72, 0x65, 0x63,
+	0x69, 0x70, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x48, 0x07, 0x52, 0x08,
+	0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x0e, 0x72,
+	0x65, 0x63, 0x69, 0x70, 0x65, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x11, 0x20,
+	0x01, 0x28, 0x09, 0x48, 0x08, 0x52, 0x0c, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65,
+	0x70, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x10, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64,
+	0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0d,
+	0x48, 0x09, 0x52, 0x0f, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x6e,
+	0x64, 0x65, 0x78, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x0a, 0x52, 0x0b,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x88, 0x01, 0x01, 0x42, 0x15,
+	0x0a, 0x13, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x75, 0x72, 0x63,
+	0x68, 0x61, 0x73, 0x65, 0x64, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x70, 0x75, 0x72, 0x63, 0x68, 0x61,
+	0x73, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x70, 0x75, 0x72,
+	0x63, 0x68, 0x61, 0x73, 0x65, 0x64, 0x5f, 0x75, 0x70, 0x63, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x61,
 	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6c,
-	0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0xba,
-	0x0a, 0x0a, 0x17, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x47, 0x72, 0x6f, 0x63, 0x65,
-	0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x32, 0x0a, 0x12, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x5f, 0x70, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x02, 0x48, 0x00, 0x52, 0x11, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x50, 0x75, 0x72,
-	0x63, 0x68, 0x61, 0x73, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x2a, 0x0a, 0x0e, 0x70, 0x75, 0x72,
-	0x63, 0x68, 0x61, 0x73, 0x65, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x02, 0x48, 0x01, 0x52, 0x0d, 0x70, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x50, 0x72, 0x69,
-	0x63, 0x65, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x70, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73,
-	0x65, 0x64, 0x5f, 0x75, 0x70, 0x63, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x0c,
-	0x70, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x64, 0x55, 0x70, 0x63, 0x88, 0x01, 0x01, 0x12,
-	0x40, 0x0a, 0x0b, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05,
+	0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x16,
+	0x0a, 0x14, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f,
+	0x6e, 0x65, 0x65, 0x64, 0x65, 0x64, 0x42, 0x1e, 0x0a, 0x1c, 0x5f, 0x62, 0x65, 0x6c, 0x6f, 0x6e,
+	0x67, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x6d, 0x65, 0x61, 0x6c, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x5f,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x72, 0x65, 0x63, 0x69, 0x70,
+	0x65, 0x5f, 0x69, 0x64, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x5f,
+	0x73, 0x74, 0x65, 0x70, 0x5f, 0x69, 0x64, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x69, 0x6e, 0x67, 0x72,
+	0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x42, 0x0f, 0x0a, 0x0d,
+	0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0xa2, 0x07,
+	0x0a, 0x0e, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
 	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x48, 0x03, 0x52, 0x0a, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01,
-	0x01, 0x12, 0x47, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x64, 0x5f, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x04, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x60, 0x0a, 0x1a, 0x70, 0x75,
-	0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x64, 0x5f, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d,
-	0x65, 0x6e, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22,
-	0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x61,
-	0x6c, 0x69, 0x64, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e,
-	0x69, 0x74, 0x52, 0x18, 0x70, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x64, 0x4d, 0x65, 0x61,
-	0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x2f, 0x0a, 0x14,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x47, 0x0a, 0x0f, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x48, 0x00, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64,
+	0x5f, 0x63, 0x6f, 0x6f, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0c, 0x61,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x43, 0x6f, 0x6f, 0x6b, 0x88, 0x01, 0x01, 0x12, 0x40,
+	0x0a, 0x0b, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48,
+	0x02, 0x52, 0x0a, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01,
+	0x12, 0x34, 0x0a, 0x13, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x64, 0x69, 0x73,
+	0x68, 0x77, 0x61, 0x73, 0x68, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52,
+	0x12, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x44, 0x69, 0x73, 0x68, 0x77, 0x61, 0x73,
+	0x68, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x3a, 0x0a, 0x1a,
 	0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x6d, 0x65, 0x61, 0x6c, 0x5f,
-	0x70, 0x6c, 0x61, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x62, 0x65, 0x6c, 0x6f,
-	0x6e, 0x67, 0x73, 0x54, 0x6f, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x43, 0x0a,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2b, 0x2e,
-	0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x4d, 0x65, 0x61,
-	0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x47, 0x72, 0x6f, 0x63, 0x65, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74,
-	0x49, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x65, 0x78, 0x70,
-	0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
-	0x64, 0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x69, 0x6e, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x5f, 0x6e, 0x65, 0x65, 0x64, 0x65, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x02, 0x52, 0x11,
-	0x6d, 0x69, 0x6e, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4e, 0x65, 0x65, 0x64, 0x65,
-	0x64, 0x12, 0x33, 0x0a, 0x13, 0x6d, 0x61, 0x78, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74,
-	0x79, 0x5f, 0x6e, 0x65, 0x65, 0x64, 0x65, 0x64, 0x18, 0x14, 0x20, 0x01, 0x28, 0x02, 0x48, 0x05,
-	0x52, 0x11, 0x6d, 0x61, 0x78, 0x51, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x4e, 0x65, 0x65,
-	0x64, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x4d, 0x0a, 0x10, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x22, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e,
-	0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74,
-	0x55, 0x6e, 0x69, 0x74, 0x52, 0x0f, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e,
-	0x74, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x3d, 0x0a, 0x0a, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69,
-	0x65, 0x6e, 0x74, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x65, 0x61, 0x6c,
-	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e,
-	0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64,
-	0x69, 0x65, 0x6e, 0x74, 0x12, 0x41, 0x0a, 0x1b, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x5f,
-	0x74, 0x6f, 0x5f, 0x6d, 0x65, 0x61, 0x6c, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x5f, 0x6f, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x48, 0x06, 0x52, 0x17, 0x62, 0x65, 0x6c,
-	0x6f, 0x6e, 0x67, 0x73, 0x54, 0x6f, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x4f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x20, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70,
-	0x65, 0x5f, 0x69, 0x64, 0x18, 0x10, 0x20, 0x01, 0x28, 0x09, 0x48, 0x07, 0x52, 0x08, 0x72, 0x65,
-	0x63, 0x69, 0x70, 0x65, 0x49, 0x64, 0x88, 0x01, 0x01, 0x12, 0x29, 0x0a, 0x0e, 0x72, 0x65, 0x63,
-	0x69, 0x70, 0x65, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x11, 0x20, 0x01, 0x28,
-	0x09, 0x48, 0x08, 0x52, 0x0c, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x49,
-	0x64, 0x88, 0x01, 0x01, 0x12, 0x2e, 0x0a, 0x10, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65,
-	0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x12, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x09,
-	0x52, 0x0f, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x64, 0x65,
-	0x78, 0x88, 0x01, 0x01, 0x12, 0x26, 0x0a, 0x0c, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
-	0x6e, 0x64, 0x65, 0x78, 0x18, 0x13, 0x20, 0x01, 0x28, 0x0d, 0x48, 0x0a, 0x52, 0x0b, 0x6f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x88, 0x01, 0x01, 0x42, 0x15, 0x0a, 0x13,
-	0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x70, 0x75, 0x72, 0x63, 0x68, 0x61,
-	0x73, 0x65, 0x64, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x70, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65,
-	0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x70, 0x75, 0x72, 0x63, 0x68,
-	0x61, 0x73, 0x65, 0x64, 0x5f, 0x75, 0x70, 0x63, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x61, 0x72, 0x63,
-	0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6c, 0x61, 0x73,
-	0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x16, 0x0a, 0x14,
-	0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x5f, 0x6e, 0x65,
-	0x65, 0x64, 0x65, 0x64, 0x42, 0x1e, 0x0a, 0x1c, 0x5f, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73,
-	0x5f, 0x74, 0x6f, 0x5f, 0x6d, 0x65, 0x61, 0x6c, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x5f, 0x6f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x5f,
-	0x69, 0x64, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x5f, 0x73, 0x74,
-	0x65, 0x70, 0x5f, 0x69, 0x64, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64,
-	0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x6f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x9c, 0x05, 0x0a, 0x0e,
-	0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39,
-	0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09,
-	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x47, 0x0a, 0x0f, 0x6c, 0x61, 0x73,
-	0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00,
-	0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88,
-	0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x63,
-	0x6f, 0x6f, 0x6b, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0c, 0x61, 0x73, 0x73,
-	0x69, 0x67, 0x6e, 0x65, 0x64, 0x43, 0x6f, 0x6f, 0x6b, 0x88, 0x01, 0x01, 0x12, 0x40, 0x0a, 0x0b,
-	0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x02, 0x52,
-	0x0a, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x34,
-	0x0a, 0x13, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x64, 0x69, 0x73, 0x68, 0x77,
-	0x61, 0x73, 0x68, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x03, 0x52, 0x12, 0x61,
-	0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x44, 0x69, 0x73, 0x68, 0x77, 0x61, 0x73, 0x68, 0x65,
-	0x72, 0x88, 0x01, 0x01, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x3a, 0x0a, 0x1a, 0x62, 0x65,
-	0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x6d, 0x65, 0x61, 0x6c, 0x5f, 0x70, 0x6c,
-	0x61, 0x6e, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16,
-	0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x54, 0x6f, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61,
-	0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x36, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x18,
-	0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e,
-	0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x4f, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x26,
-	0x0a, 0x04, 0x6d, 0x65, 0x61, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d,
-	0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x4d, 0x65, 0x61, 0x6c,
-	0x52, 0x04, 0x6d, 0x65, 0x61, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x65, 0x61, 0x6c, 0x5f, 0x73,
-	0x63, 0x61, 0x6c, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x02, 0x52, 0x09, 0x6d, 0x65, 0x61, 0x6c,
-	0x53, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x68, 0x6f, 0x73, 0x65, 0x6e, 0x18,
-	0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x63, 0x68, 0x6f, 0x73, 0x65, 0x6e, 0x12, 0x1d, 0x0a,
-	0x0a, 0x74, 0x69, 0x65, 0x5f, 0x62, 0x72, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x0d, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x09, 0x74, 0x69, 0x65, 0x42, 0x72, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x12, 0x0a, 0x10,
-	0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
-	0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x63, 0x6f,
-	0x6f, 0x6b, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f,
-	0x61, 0x74, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f,
-	0x64, 0x69, 0x73, 0x68, 0x77, 0x61, 0x73, 0x68, 0x65, 0x72, 0x22, 0xa9, 0x03, 0x0a, 0x12, 0x4d,
-	0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x6f, 0x74,
-	0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x40, 0x0a, 0x0b,
-	0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x70, 0x6c, 0x61, 0x6e, 0x5f, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x16, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x54, 0x6f, 0x4d, 0x65, 0x61, 0x6c, 0x50,
+	0x6c, 0x61, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x36, 0x0a, 0x05, 0x76, 0x6f, 0x74, 0x65,
+	0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c,
+	0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x05, 0x76, 0x6f, 0x74, 0x65, 0x73,
+	0x12, 0x26, 0x0a, 0x04, 0x6d, 0x65, 0x61, 0x6c, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x4d, 0x65,
+	0x61, 0x6c, 0x52, 0x04, 0x6d, 0x65, 0x61, 0x6c, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x65, 0x61, 0x6c,
+	0x5f, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x02, 0x52, 0x09, 0x6d, 0x65,
+	0x61, 0x6c, 0x53, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x68, 0x6f, 0x73, 0x65,
+	0x6e, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x63, 0x68, 0x6f, 0x73, 0x65, 0x6e, 0x12,
+	0x1d, 0x0a, 0x0a, 0x74, 0x69, 0x65, 0x5f, 0x62, 0x72, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x0d, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x09, 0x74, 0x69, 0x65, 0x42, 0x72, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x58,
+	0x0a, 0x11, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x5f, 0x77, 0x61, 0x72, 0x6e, 0x69,
+	0x6e, 0x67, 0x73, 0x18, 0x0e, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x6d, 0x65, 0x61, 0x6c,
+	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61,
+	0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x6c, 0x65, 0x72, 0x67, 0x65, 0x6e, 0x57,
+	0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x10, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x67, 0x65, 0x6e,
+	0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x12, 0x42, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x04, 0x52, 0x0b, 0x63, 0x6f,
+	0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x55, 0x0a, 0x10,
+	0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61,
+	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x0f, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x61, 0x73, 0x73, 0x69,
+	0x67, 0x6e, 0x65, 0x64, 0x5f, 0x63, 0x6f, 0x6f, 0x6b, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x61, 0x72,
+	0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x16, 0x0a, 0x14, 0x5f, 0x61, 0x73,
+	0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x64, 0x69, 0x73, 0x68, 0x77, 0x61, 0x73, 0x68, 0x65,
+	0x72, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f,
+	0x61, 0x74, 0x22, 0x92, 0x01, 0x0a, 0x1c, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x49, 0x64,
+	0x12, 0x2c, 0x0a, 0x12, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x5f, 0x72, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x72, 0x65,
+	0x63, 0x69, 0x70, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x27,
+	0x0a, 0x0f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65,
+	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0e, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x8a, 0x02, 0x0a, 0x1d, 0x4d, 0x65, 0x61, 0x6c,
+	0x50, 0x6c, 0x61, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x41, 0x6c, 0x6c, 0x65, 0x72, 0x67,
+	0x65, 0x6e, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65,
+	0x72, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72,
+	0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x5f, 0x69, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x49, 0x64, 0x12,
+	0x24, 0x0a, 0x0e, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53,
+	0x74, 0x65, 0x70, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x69,
+	0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69,
+	0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69,
+	0x65, 0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
+	0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x16,
+	0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6c, 0x6c, 0x65, 0x72, 0x67,
+	0x65, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x09, 0x61, 0x6c, 0x6c, 0x65, 0x72,
+	0x67, 0x65, 0x6e, 0x73, 0x22, 0xbf, 0x03, 0x0a, 0x12, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61,
+	0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x39, 0x0a, 0x0a, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x40, 0x0a, 0x0b, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x0a, 0x61, 0x72, 0x63, 0x68, 0x69,
+	0x76, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x47, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74,
+	0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
 	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52,
-	0x0a, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x47,
-	0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x3c, 0x0a,
-	0x1b, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x6d, 0x65, 0x61, 0x6c,
-	0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x17, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x54, 0x6f, 0x4d, 0x65, 0x61,
-	0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x62,
-	0x79, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x79,
-	0x55, 0x73, 0x65, 0x72, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x0d, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x62, 0x73, 0x74,
-	0x61, 0x69, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x62, 0x73, 0x74, 0x61,
-	0x69, 0x6e, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52,
+	0x0d, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01,
+	0x01, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x3c, 0x0a, 0x1b, 0x62, 0x65, 0x6c, 0x6f, 0x6e,
+	0x67, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x6d, 0x65, 0x61, 0x6c, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x5f,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x62, 0x65,
+	0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x54, 0x6f, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x17, 0x0a, 0x07, 0x62, 0x79, 0x5f, 0x75, 0x73, 0x65, 0x72,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x79, 0x55, 0x73, 0x65, 0x72, 0x12, 0x12,
+	0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x72, 0x61,
+	0x6e, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x62, 0x73, 0x74, 0x61, 0x69, 0x6e, 0x18, 0x09, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x62, 0x73, 0x74, 0x61, 0x69, 0x6e, 0x12, 0x14, 0x0a, 0x05,
+	0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x63, 0x6f,
+	0x72, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f,
 	0x61, 0x74, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0xcc, 0x01, 0x0a, 0x1f, 0x4d, 0x65, 0x61, 0x6c, 0x50,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0xe2, 0x01, 0x0a, 0x1f, 0x4d, 0x65, 0x61, 0x6c, 0x50,
 	0x6c, 0x61, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x6f, 0x74, 0x65, 0x43, 0x72, 0x65,
 	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
 	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f,
@@ -8139,51 +10846,282 @@ func (x *AccountInstrumentOwnership) GetQuantity() uint32 {
 	0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18,
 	0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x12, 0x18, 0x0a, 0x07, 0x61,
 	0x62, 0x73, 0x74, 0x61, 0x69, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61, 0x62,
-	0x73, 0x74, 0x61, 0x69, 0x6e, 0x22, 0xd1, 0x04, 0x0a, 0x1d, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c,
-	0x61, 0x6e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65,
-	0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x73, 0x74, 0x61, 0x69, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x22, 0xd1, 0x04, 0x0a, 0x1d,
+	0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x0a,
+	0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x47, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74,
+	0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52,
+	0x0d, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01,
+	0x01, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x3c, 0x0a, 0x1b, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x5f, 0x74, 0x6f, 0x5f,
+	0x6d, 0x65, 0x61, 0x6c, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x17, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x54,
+	0x6f, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x1b, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e,
+	0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70,
+	0x49, 0x64, 0x12, 0x29, 0x0a, 0x10, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74,
+	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x69, 0x6e,
+	0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x32, 0x0a,
+	0x15, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x73, 0x65,
+	0x6c, 0x65, 0x63, 0x74, 0x65, 0x64, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x64, 0x65,
+	0x78, 0x12, 0x56, 0x0a, 0x0e, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74,
+	0x79, 0x70, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x2f, 0x2e, 0x6d, 0x65, 0x61, 0x6c,
+	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61,
+	0x6e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0d, 0x73, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x40, 0x0a, 0x0b, 0x61, 0x72, 0x63,
+	0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x0a, 0x61, 0x72,
+	0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x42, 0x12, 0x0a, 0x10, 0x5f,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42,
+	0x0e, 0x0a, 0x0c, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22,
+	0x5e, 0x0a, 0x0b, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x19,
+	0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22,
+	0x94, 0x03, 0x0a, 0x08, 0x4d, 0x65, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x0a,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x47, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x0d,
+	0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01,
+	0x12, 0x40, 0x0a, 0x0b, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x48, 0x01, 0x52, 0x0a, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x88,
+	0x01, 0x01, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0f, 0x62, 0x65, 0x6c, 0x6f,
+	0x6e, 0x67, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0d, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x54, 0x6f, 0x55, 0x73, 0x65, 0x72,
+	0x12, 0x30, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x4d,
+	0x65, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65,
+	0x6d, 0x73, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69,
+	0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0x90, 0x03, 0x0a, 0x0c, 0x4d, 0x65, 0x61, 0x6c, 0x4c,
+	0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
 	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
 	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
 	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
-	0x41, 0x74, 0x12, 0x47, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x41, 0x74, 0x12, 0x40, 0x0a, 0x0b, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x0a, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x41,
+	0x74, 0x88, 0x01, 0x01, 0x12, 0x47, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x0d, 0x6c, 0x61, 0x73,
+	0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a,
+	0x07, 0x6d, 0x65, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x6d, 0x65, 0x61, 0x6c, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x14,
+	0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x6d, 0x65, 0x61, 0x6c, 0x5f,
+	0x6c, 0x69, 0x73, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x62, 0x65, 0x6c, 0x6f,
+	0x6e, 0x67, 0x73, 0x54, 0x6f, 0x4d, 0x65, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x26, 0x0a,
+	0x04, 0x6d, 0x65, 0x61, 0x6c, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x65,
+	0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x4d, 0x65, 0x61, 0x6c, 0x52,
+	0x04, 0x6d, 0x65, 0x61, 0x6c, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0x98, 0x03, 0x0a, 0x0a, 0x52, 0x65,
+	0x63, 0x69, 0x70, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x47, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x40, 0x0a, 0x0b,
+	0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52,
+	0x0a, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x0e,
+	0x0a, 0x02, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12,
+	0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61,
+	0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0f, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x5f,
+	0x74, 0x6f, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x62,
+	0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x54, 0x6f, 0x55, 0x73, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x05,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x65,
+	0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x63, 0x69, 0x70,
+	0x65, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x22, 0xa0, 0x03, 0x0a, 0x0e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x4c,
+	0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
 	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x0e, 0x0a, 0x02, 0x69,
-	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3c, 0x0a, 0x1b, 0x62,
-	0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x6d, 0x65, 0x61, 0x6c, 0x5f, 0x70,
-	0x6c, 0x61, 0x6e, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x17, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x54, 0x6f, 0x4d, 0x65, 0x61, 0x6c, 0x50,
-	0x6c, 0x61, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x63,
-	0x69, 0x70, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65,
-	0x63, 0x69, 0x70, 0x65, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65,
-	0x5f, 0x73, 0x74, 0x65, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
-	0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x49, 0x64, 0x12, 0x29, 0x0a, 0x10,
-	0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65,
-	0x6e, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x32, 0x0a, 0x15, 0x73, 0x65, 0x6c, 0x65, 0x63,
-	0x74, 0x65, 0x64, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78,
-	0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x13, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x65, 0x64,
-	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x56, 0x0a, 0x0e, 0x73,
-	0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x09, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x2f, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69,
-	0x6e, 0x67, 0x2e, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x63, 0x69, 0x70,
-	0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x54, 0x79, 0x70, 0x65, 0x52, 0x0d, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54,
-	0x79, 0x70, 0x65, 0x12, 0x40, 0x0a, 0x0b, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f,
-	0x61, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x0a, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64,
-	0x41, 0x74, 0x88, 0x01, 0x01, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x61, 0x72,
-	0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0x5e, 0x0a, 0x0b, 0x4d, 0x69, 0x73,
-	0x73, 0x69, 0x6e, 0x67, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x76, 0x65, 0x6e,
-	0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x76, 0x65, 0x6e,
-	0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
-	0x12, 0x17, 0x0a, 0x07, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x75, 0x73, 0x65, 0x72, 0x49, 0x64, 0x22, 0x94, 0x03, 0x0a, 0x08, 0x4d, 0x65,
-	0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x40, 0x0a, 0x0b, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x0a, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x41,
+	0x74, 0x88, 0x01, 0x01, 0x12, 0x47, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x0d, 0x6c, 0x61, 0x73,
+	0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a,
+	0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f,
+	0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73,
+	0x12, 0x33, 0x0a, 0x16, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x72,
+	0x65, 0x63, 0x69, 0x70, 0x65, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x13, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x54, 0x6f, 0x52, 0x65, 0x63, 0x69, 0x70,
+	0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x06, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e,
+	0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x52, 0x06, 0x72, 0x65, 0x63,
+	0x69, 0x70, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0xe5, 0x04, 0x0a, 0x0c, 0x4d, 0x65, 0x61, 0x6c,
+	0x50, 0x6c, 0x61, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x46, 0x0a, 0x10, 0x72, 0x65, 0x63, 0x69,
+	0x70, 0x65, 0x5f, 0x70, 0x72, 0x65, 0x70, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e,
+	0x67, 0x2e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x50, 0x72, 0x65, 0x70, 0x54, 0x61, 0x73, 0x6b,
+	0x52, 0x0e, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x50, 0x72, 0x65, 0x70, 0x54, 0x61, 0x73, 0x6b,
+	0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x47, 0x0a, 0x0f, 0x6c,
+	0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x48, 0x00, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41,
+	0x74, 0x88, 0x01, 0x01, 0x12, 0x3d, 0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x41, 0x74, 0x12, 0x2d, 0x0a, 0x10, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f,
+	0x74, 0x6f, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52,
+	0x0e, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x54, 0x6f, 0x55, 0x73, 0x65, 0x72, 0x88,
+	0x01, 0x01, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02,
+	0x69, 0x64, 0x12, 0x38, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x20, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e,
+	0x67, 0x2e, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x31, 0x0a, 0x14,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x63, 0x72, 0x65, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x2d, 0x0a, 0x12, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x5f, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x45, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x46,
+	0x0a, 0x10, 0x6d, 0x65, 0x61, 0x6c, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x5f, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70,
+	0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0e, 0x6d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f,
+	0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x61,
+	0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x22,
+	0xdd, 0x03, 0x0a, 0x10, 0x43, 0x6f, 0x6f, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65,
+	0x53, 0x74, 0x65, 0x70, 0x12, 0x37, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x5f, 0x61,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x52, 0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x41, 0x74, 0x12, 0x33, 0x0a,
+	0x07, 0x65, 0x6e, 0x64, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x06, 0x65, 0x6e, 0x64, 0x73,
+	0x41, 0x74, 0x12, 0x24, 0x0a, 0x0e, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x5f, 0x73, 0x74, 0x65,
+	0x70, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x63, 0x69,
+	0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x69,
+	0x70, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x63,
+	0x69, 0x70, 0x65, 0x49, 0x64, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x72, 0x65, 0x63, 0x69,
+	0x70, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6c, 0x61, 0x62, 0x65, 0x6c, 0x12, 0x35, 0x0a, 0x17,
+	0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x6e, 0x5f,
+	0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x14, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x49, 0x6e, 0x53, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x12, 0x31, 0x0a, 0x15, 0x65, 0x6e, 0x64, 0x5f, 0x6f, 0x66, 0x66, 0x73, 0x65,
+	0x74, 0x5f, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x12, 0x65, 0x6e, 0x64, 0x4f, 0x66, 0x66, 0x73, 0x65, 0x74, 0x49, 0x6e, 0x53,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x73, 0x6c, 0x61, 0x63, 0x6b, 0x5f,
+	0x69, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0e, 0x73, 0x6c, 0x61, 0x63, 0x6b, 0x49, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73,
+	0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x65, 0x70, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x73, 0x74, 0x65, 0x70, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12,
+	0x12, 0x0a, 0x04, 0x63, 0x6f, 0x6f, 0x6b, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x63,
+	0x6f, 0x6f, 0x6b, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x72, 0x69, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x63, 0x72, 0x69, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x22,
+	0xec, 0x03, 0x0a, 0x0c, 0x43, 0x6f, 0x6f, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65,
+	0x12, 0x37, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x41, 0x74, 0x12, 0x3d, 0x0a, 0x0c, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x6e, 0x67, 0x54, 0x69, 0x6d, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x65, 0x61, 0x6c,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x61, 0x6c, 0x49,
+	0x64, 0x12, 0x2d, 0x0a, 0x13, 0x6d, 0x65, 0x61, 0x6c, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x5f, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10,
+	0x6d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64,
+	0x12, 0x2e, 0x0a, 0x13, 0x6d, 0x65, 0x72, 0x6d, 0x61, 0x69, 0x64, 0x5f, 0x67, 0x61, 0x6e, 0x74,
+	0x74, 0x5f, 0x63, 0x68, 0x61, 0x72, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6d,
+	0x65, 0x72, 0x6d, 0x61, 0x69, 0x64, 0x47, 0x61, 0x6e, 0x74, 0x74, 0x43, 0x68, 0x61, 0x72, 0x74,
+	0x12, 0x23, 0x0a, 0x0d, 0x63, 0x72, 0x69, 0x74, 0x69, 0x63, 0x61, 0x6c, 0x5f, 0x70, 0x61, 0x74,
+	0x68, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x63, 0x72, 0x69, 0x74, 0x69, 0x63, 0x61,
+	0x6c, 0x50, 0x61, 0x74, 0x68, 0x12, 0x34, 0x0a, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x18, 0x07,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e,
+	0x69, 0x6e, 0x67, 0x2e, 0x43, 0x6f, 0x6f, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65,
+	0x53, 0x74, 0x65, 0x70, 0x52, 0x05, 0x73, 0x74, 0x65, 0x70, 0x73, 0x12, 0x39, 0x0a, 0x19, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x64, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x6e,
+	0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x16,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x44, 0x75, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x53,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x37, 0x0a, 0x18, 0x63, 0x72, 0x69, 0x74, 0x69, 0x63,
+	0x61, 0x6c, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x5f, 0x69, 0x6e, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e,
+	0x64, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x15, 0x63, 0x72, 0x69, 0x74, 0x69, 0x63,
+	0x61, 0x6c, 0x50, 0x61, 0x74, 0x68, 0x49, 0x6e, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12,
+	0x1d, 0x0a, 0x0a, 0x63, 0x6f, 0x6f, 0x6b, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20,
+	0x01, 0x28, 0x0d, 0x52, 0x09, 0x63, 0x6f, 0x6f, 0x6b, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xa3,
+	0x03, 0x0a, 0x09, 0x4e, 0x75, 0x74, 0x72, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x63, 0x61, 0x6c, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08,
+	0x63, 0x61, 0x6c, 0x6f, 0x72, 0x69, 0x65, 0x73, 0x12, 0x28, 0x0a, 0x10, 0x70, 0x72, 0x6f, 0x74,
+	0x65, 0x69, 0x6e, 0x5f, 0x69, 0x6e, 0x5f, 0x67, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x0e, 0x70, 0x72, 0x6f, 0x74, 0x65, 0x69, 0x6e, 0x49, 0x6e, 0x47, 0x72, 0x61,
+	0x6d, 0x73, 0x12, 0x2b, 0x0a, 0x12, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x66, 0x61, 0x74, 0x5f,
+	0x69, 0x6e, 0x5f, 0x67, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0f,
+	0x74, 0x6f, 0x74, 0x61, 0x6c, 0x46, 0x61, 0x74, 0x49, 0x6e, 0x47, 0x72, 0x61, 0x6d, 0x73, 0x12,
+	0x33, 0x0a, 0x16, 0x73, 0x61, 0x74, 0x75, 0x72, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x66, 0x61, 0x74,
+	0x5f, 0x69, 0x6e, 0x5f, 0x67, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02, 0x52,
+	0x13, 0x73, 0x61, 0x74, 0x75, 0x72, 0x61, 0x74, 0x65, 0x64, 0x46, 0x61, 0x74, 0x49, 0x6e, 0x47,
+	0x72, 0x61, 0x6d, 0x73, 0x12, 0x34, 0x0a, 0x16, 0x63, 0x61, 0x72, 0x62, 0x6f, 0x68, 0x79, 0x64,
+	0x72, 0x61, 0x74, 0x65, 0x73, 0x5f, 0x69, 0x6e, 0x5f, 0x67, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x14, 0x63, 0x61, 0x72, 0x62, 0x6f, 0x68, 0x79, 0x64, 0x72, 0x61,
+	0x74, 0x65, 0x73, 0x49, 0x6e, 0x47, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x73, 0x75,
+	0x67, 0x61, 0x72, 0x5f, 0x69, 0x6e, 0x5f, 0x67, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x0c, 0x73, 0x75, 0x67, 0x61, 0x72, 0x49, 0x6e, 0x47, 0x72, 0x61, 0x6d, 0x73,
+	0x12, 0x24, 0x0a, 0x0e, 0x66, 0x69, 0x62, 0x65, 0x72, 0x5f, 0x69, 0x6e, 0x5f, 0x67, 0x72, 0x61,
+	0x6d, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x02, 0x52, 0x0c, 0x66, 0x69, 0x62, 0x65, 0x72, 0x49,
+	0x6e, 0x47, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x30, 0x0a, 0x14, 0x73, 0x6f, 0x64, 0x69, 0x75, 0x6d,
+	0x5f, 0x69, 0x6e, 0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69, 0x67, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x02, 0x52, 0x12, 0x73, 0x6f, 0x64, 0x69, 0x75, 0x6d, 0x49, 0x6e, 0x4d, 0x69,
+	0x6c, 0x6c, 0x69, 0x67, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x3a, 0x0a, 0x19, 0x63, 0x68, 0x6f, 0x6c,
+	0x65, 0x73, 0x74, 0x65, 0x72, 0x6f, 0x6c, 0x5f, 0x69, 0x6e, 0x5f, 0x6d, 0x69, 0x6c, 0x6c, 0x69,
+	0x67, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x02, 0x52, 0x17, 0x63, 0x68, 0x6f,
+	0x6c, 0x65, 0x73, 0x74, 0x65, 0x72, 0x6f, 0x6c, 0x49, 0x6e, 0x4d, 0x69, 0x6c, 0x6c, 0x69, 0x67,
+	0x72, 0x61, 0x6d, 0x73, 0x22, 0xf0, 0x03, 0x0a, 0x1d, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e,
+	0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x4e, 0x75, 0x74, 0x72, 0x69, 0x74, 0x69, 0x6f,
+	0x6e, 0x46, 0x61, 0x63, 0x74, 0x73, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
 	0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
 	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
 	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41,
@@ -8196,316 +11134,342 @@ func (x *AccountInstrumentOwnership) GetQuantity() uint32 {
 	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
 	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x0a, 0x61,
 	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x26, 0x0a, 0x0f, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x5f, 0x74, 0x6f,
-	0x5f, 0x75, 0x73, 0x65, 0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x62, 0x65, 0x6c,
-	0x6f, 0x6e, 0x67, 0x73, 0x54, 0x6f, 0x55, 0x73, 0x65, 0x72, 0x12, 0x30, 0x0a, 0x05, 0x69, 0x74,
-	0x65, 0x6d, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x6d, 0x65, 0x61, 0x6c,
-	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x4d, 0x65, 0x61, 0x6c, 0x4c, 0x69, 0x73,
-	0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x42, 0x12, 0x0a, 0x10,
-	0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
-	0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74,
-	0x22, 0x90, 0x03, 0x0a, 0x0c, 0x4d, 0x65, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65,
-	0x6d, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x40, 0x0a, 0x0b,
-	0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52,
-	0x0a, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x47,
-	0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6d, 0x65, 0x61, 0x6c, 0x5f,
-	0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65, 0x61, 0x6c, 0x49, 0x64,
-	0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x2f, 0x0a, 0x14, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67,
-	0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x6d, 0x65, 0x61, 0x6c, 0x5f, 0x6c, 0x69, 0x73, 0x74, 0x18, 0x07,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x54, 0x6f, 0x4d,
-	0x65, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x04, 0x6d, 0x65, 0x61, 0x6c, 0x18,
-	0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e,
-	0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x4d, 0x65, 0x61, 0x6c, 0x52, 0x04, 0x6d, 0x65, 0x61, 0x6c, 0x42,
-	0x0e, 0x0a, 0x0c, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42,
-	0x12, 0x0a, 0x10, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
-	0x5f, 0x61, 0x74, 0x22, 0x98, 0x03, 0x0a, 0x0a, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x4c, 0x69,
-	0x73, 0x74, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x47, 0x0a,
-	0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x48, 0x00, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x40, 0x0a, 0x0b, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76,
-	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2e, 0x0a, 0x13,
+	0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74,
+	0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64,
+	0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05,
+	0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74,
+	0x65, 0x73, 0x12, 0x58, 0x0a, 0x16, 0x62, 0x61, 0x73, 0x69, 0x73, 0x5f, 0x6d, 0x65, 0x61, 0x73,
+	0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e,
+	0x67, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x14, 0x62, 0x61, 0x73, 0x69, 0x73, 0x4d, 0x65, 0x61,
+	0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x35, 0x0a, 0x09,
+	0x6e, 0x75, 0x74, 0x72, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x17, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x4e,
+	0x75, 0x74, 0x72, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x09, 0x6e, 0x75, 0x74, 0x72, 0x69, 0x65,
+	0x6e, 0x74, 0x73, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x61, 0x72, 0x63, 0x68,
+	0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0xbb, 0x02, 0x0a, 0x1a, 0x4e, 0x75, 0x74, 0x72,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x67, 0x72,
+	0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x63, 0x69, 0x70,
+	0x65, 0x49, 0x64, 0x12, 0x24, 0x0a, 0x0e, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x5f, 0x73, 0x74,
+	0x65, 0x70, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x72, 0x65, 0x63,
+	0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x49, 0x64, 0x12, 0x39, 0x0a, 0x19, 0x72, 0x65, 0x63,
+	0x69, 0x70, 0x65, 0x5f, 0x73, 0x74, 0x65, 0x70, 0x5f, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69,
+	0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x16, 0x72, 0x65,
+	0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x5f, 0x69, 0x6e,
+	0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x11, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65,
+	0x6e, 0x74, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65,
+	0x6e, 0x74, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x69,
+	0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x2e, 0x0a,
+	0x13, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x75, 0x6e, 0x69,
+	0x74, 0x5f, 0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6d, 0x65, 0x61, 0x73,
+	0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x49, 0x64, 0x12, 0x16, 0x0a,
+	0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72,
+	0x65, 0x61, 0x73, 0x6f, 0x6e, 0x22, 0xcd, 0x02, 0x0a, 0x0f, 0x4e, 0x75, 0x74, 0x72, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x12, 0x3d, 0x0a, 0x0b, 0x70, 0x65, 0x72,
+	0x5f, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x4e, 0x75,
+	0x74, 0x72, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x48, 0x00, 0x52, 0x0a, 0x70, 0x65, 0x72, 0x50, 0x6f,
+	0x72, 0x74, 0x69, 0x6f, 0x6e, 0x88, 0x01, 0x01, 0x12, 0x59, 0x0a, 0x13, 0x6d, 0x69, 0x73, 0x73,
+	0x69, 0x6e, 0x67, 0x5f, 0x69, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e,
+	0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x4e, 0x75, 0x74, 0x72, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x69,
+	0x73, 0x73, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x52,
+	0x12, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65,
+	0x6e, 0x74, 0x73, 0x12, 0x2f, 0x0a, 0x06, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x73, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69,
+	0x6e, 0x67, 0x2e, 0x4e, 0x75, 0x74, 0x72, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x06, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x73, 0x12, 0x2d, 0x0a, 0x12, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65,
+	0x64, 0x5f, 0x70, 0x6f, 0x72, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x02,
+	0x52, 0x11, 0x65, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x64, 0x50, 0x6f, 0x72, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x02, 0x52, 0x05, 0x73, 0x63, 0x61, 0x6c, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x63, 0x6f, 0x6d,
+	0x70, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x70, 0x6f,
+	0x72, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xa6, 0x02, 0x0a, 0x1c, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c,
+	0x61, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4e, 0x75, 0x74, 0x72, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x12, 0x37, 0x0a, 0x09, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73,
+	0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x08, 0x73, 0x74, 0x61, 0x72, 0x74, 0x73, 0x41, 0x74, 0x12,
+	0x2b, 0x0a, 0x12, 0x6d, 0x65, 0x61, 0x6c, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x5f, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x6d, 0x65, 0x61,
+	0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x2d, 0x0a, 0x13,
+	0x6d, 0x65, 0x61, 0x6c, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6d, 0x65, 0x61, 0x6c, 0x50,
+	0x6c, 0x61, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x6d,
+	0x65, 0x61, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x6d, 0x65,
+	0x61, 0x6c, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x6d, 0x65, 0x61, 0x6c, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x6d, 0x65, 0x61, 0x6c, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x3b, 0x0a, 0x09, 0x6e, 0x75, 0x74, 0x72, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e,
+	0x69, 0x6e, 0x67, 0x2e, 0x4e, 0x75, 0x74, 0x72, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x6f, 0x6c,
+	0x6c, 0x75, 0x70, 0x52, 0x09, 0x6e, 0x75, 0x74, 0x72, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xa7,
+	0x02, 0x0a, 0x17, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x4e, 0x75, 0x74, 0x72, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x65,
+	0x61, 0x6c, 0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x6d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x42, 0x0a, 0x06,
+	0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2a, 0x2e, 0x6d,
+	0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x4d, 0x65, 0x61, 0x6c,
+	0x50, 0x6c, 0x61, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4e, 0x75, 0x74, 0x72, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x6f, 0x6c, 0x6c, 0x75, 0x70, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73,
+	0x12, 0x59, 0x0a, 0x13, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x69, 0x6e, 0x67, 0x72,
+	0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x28, 0x2e,
+	0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x4e, 0x75, 0x74,
+	0x72, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x49, 0x6e, 0x67,
+	0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x12, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67,
+	0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2f, 0x0a, 0x06, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x6d, 0x65,
+	0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x4e, 0x75, 0x74, 0x72, 0x69,
+	0x65, 0x6e, 0x74, 0x73, 0x52, 0x06, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x73, 0x12, 0x1a, 0x0a, 0x08,
+	0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x22, 0xb5, 0x03, 0x0a, 0x1a, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x77,
+	0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
 	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
-	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x0a, 0x61, 0x72, 0x63, 0x68, 0x69,
-	0x76, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
-	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x20, 0x0a, 0x0b,
-	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26,
-	0x0a, 0x0f, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x75, 0x73, 0x65,
-	0x72, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73,
-	0x54, 0x6f, 0x55, 0x73, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18,
-	0x08, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e,
-	0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x49,
-	0x74, 0x65, 0x6d, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6c,
-	0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x0e,
-	0x0a, 0x0c, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x22, 0xa0,
-	0x03, 0x0a, 0x0e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65,
-	0x6d, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x40, 0x0a, 0x0b,
-	0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52,
-	0x0a, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x47,
-	0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
-	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x12, 0x40, 0x0a, 0x0b, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
 	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
-	0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70,
-	0x65, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x63, 0x69,
-	0x70, 0x65, 0x49, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x06, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x33, 0x0a, 0x16, 0x62, 0x65,
-	0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x5f,
-	0x6c, 0x69, 0x73, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x62, 0x65, 0x6c, 0x6f,
-	0x6e, 0x67, 0x73, 0x54, 0x6f, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12,
-	0x2c, 0x0a, 0x06, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x14, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x52,
-	0x65, 0x63, 0x69, 0x70, 0x65, 0x52, 0x06, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x42, 0x0e, 0x0a,
-	0x0c, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x12, 0x0a,
-	0x10, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
-	0x74, 0x22, 0xe5, 0x04, 0x0a, 0x0c, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x54, 0x61,
-	0x73, 0x6b, 0x12, 0x46, 0x0a, 0x10, 0x72, 0x65, 0x63, 0x69, 0x70, 0x65, 0x5f, 0x70, 0x72, 0x65,
-	0x70, 0x5f, 0x74, 0x61, 0x73, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d,
-	0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x63, 0x69,
-	0x70, 0x65, 0x50, 0x72, 0x65, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x0e, 0x72, 0x65, 0x63, 0x69,
-	0x70, 0x65, 0x50, 0x72, 0x65, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x47, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x0d, 0x6c, 0x61,
-	0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x3d,
-	0x0a, 0x0c, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x04,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
-	0x52, 0x0b, 0x63, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x2d, 0x0a,
-	0x10, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x5f, 0x75, 0x73, 0x65,
-	0x72, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x48, 0x01, 0x52, 0x0e, 0x61, 0x73, 0x73, 0x69, 0x67,
-	0x6e, 0x65, 0x64, 0x54, 0x6f, 0x55, 0x73, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x38, 0x0a, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x20, 0x2e, 0x6d,
-	0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x4d, 0x65, 0x61, 0x6c,
-	0x50, 0x6c, 0x61, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06,
-	0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x31, 0x0a, 0x14, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x5f, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x13, 0x63, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x78,
-	0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2d, 0x0a, 0x12, 0x73, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x5f, 0x65, 0x78, 0x70, 0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x78, 0x70,
-	0x6c, 0x61, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x46, 0x0a, 0x10, 0x6d, 0x65, 0x61, 0x6c,
-	0x5f, 0x70, 0x6c, 0x61, 0x6e, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x0a, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e,
-	0x67, 0x2e, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x0e, 0x6d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x64, 0x5f, 0x61, 0x74, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x61, 0x73, 0x73, 0x69, 0x67, 0x6e, 0x65,
-	0x64, 0x5f, 0x74, 0x6f, 0x5f, 0x75, 0x73, 0x65, 0x72, 0x22, 0xb5, 0x03, 0x0a, 0x1a, 0x41, 0x63,
-	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x4f,
-	0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x12, 0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
-	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x64, 0x41, 0x74, 0x12, 0x40, 0x0a, 0x0b, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f,
-	0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x0a, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64,
-	0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x47, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x0d, 0x6c, 0x61,
-	0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x0e,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14,
-	0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e,
-	0x6f, 0x74, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x5f,
-	0x74, 0x6f, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x10, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x54, 0x6f, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x12, 0x3d, 0x0a, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74,
-	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61,
-	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x73, 0x74, 0x72,
-	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e,
-	0x74, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x42, 0x0e, 0x0a,
-	0x0c, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x12, 0x0a,
-	0x10, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61,
-	0x74, 0x2a, 0xee, 0x03, 0x0a, 0x21, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65,
-	0x64, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x41, 0x74, 0x74, 0x72, 0x69, 0x62,
-	0x75, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x31, 0x0a, 0x2d, 0x56, 0x41, 0x4c, 0x49, 0x44,
-	0x5f, 0x49, 0x4e, 0x47, 0x52, 0x45, 0x44, 0x49, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54,
-	0x45, 0x5f, 0x41, 0x54, 0x54, 0x52, 0x49, 0x42, 0x55, 0x54, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45,
-	0x5f, 0x54, 0x45, 0x58, 0x54, 0x55, 0x52, 0x45, 0x10, 0x00, 0x12, 0x35, 0x0a, 0x31, 0x56, 0x41,
-	0x4c, 0x49, 0x44, 0x5f, 0x49, 0x4e, 0x47, 0x52, 0x45, 0x44, 0x49, 0x45, 0x4e, 0x54, 0x5f, 0x53,
-	0x54, 0x41, 0x54, 0x45, 0x5f, 0x41, 0x54, 0x54, 0x52, 0x49, 0x42, 0x55, 0x54, 0x45, 0x5f, 0x54,
-	0x59, 0x50, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x53, 0x49, 0x53, 0x54, 0x45, 0x4e, 0x43, 0x59, 0x10,
-	0x01, 0x12, 0x35, 0x0a, 0x31, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x49, 0x4e, 0x47, 0x52, 0x45,
-	0x44, 0x49, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x41, 0x54, 0x54, 0x52,
-	0x49, 0x42, 0x55, 0x54, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x45, 0x4d, 0x50, 0x45,
-	0x52, 0x41, 0x54, 0x55, 0x52, 0x45, 0x10, 0x02, 0x12, 0x2f, 0x0a, 0x2b, 0x56, 0x41, 0x4c, 0x49,
-	0x44, 0x5f, 0x49, 0x4e, 0x47, 0x52, 0x45, 0x44, 0x49, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41,
-	0x54, 0x45, 0x5f, 0x41, 0x54, 0x54, 0x52, 0x49, 0x42, 0x55, 0x54, 0x45, 0x5f, 0x54, 0x59, 0x50,
-	0x45, 0x5f, 0x43, 0x4f, 0x4c, 0x4f, 0x52, 0x10, 0x03, 0x12, 0x34, 0x0a, 0x30, 0x56, 0x41, 0x4c,
-	0x49, 0x44, 0x5f, 0x49, 0x4e, 0x47, 0x52, 0x45, 0x44, 0x49, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54,
-	0x41, 0x54, 0x45, 0x5f, 0x41, 0x54, 0x54, 0x52, 0x49, 0x42, 0x55, 0x54, 0x45, 0x5f, 0x54, 0x59,
-	0x50, 0x45, 0x5f, 0x41, 0x50, 0x50, 0x45, 0x41, 0x52, 0x41, 0x4e, 0x43, 0x45, 0x10, 0x04, 0x12,
-	0x2e, 0x0a, 0x2a, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x49, 0x4e, 0x47, 0x52, 0x45, 0x44, 0x49,
-	0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x41, 0x54, 0x54, 0x52, 0x49, 0x42,
-	0x55, 0x54, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4f, 0x44, 0x4f, 0x52, 0x10, 0x05, 0x12,
-	0x2f, 0x0a, 0x2b, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x49, 0x4e, 0x47, 0x52, 0x45, 0x44, 0x49,
+	0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x0a, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x41,
+	0x74, 0x88, 0x01, 0x01, 0x12, 0x47, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e,
+	0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x01, 0x52, 0x0d, 0x6c, 0x61, 0x73,
+	0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a,
+	0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f,
+	0x74, 0x65, 0x73, 0x12, 0x2c, 0x0a, 0x12, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x5f, 0x74,
+	0x6f, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x10, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x54, 0x6f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x3d, 0x0a, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e,
+	0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x69, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74, 0x69, 0x74, 0x79, 0x42, 0x0e, 0x0a, 0x0c,
+	0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x12, 0x0a, 0x10,
+	0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74,
+	0x22, 0x93, 0x05, 0x0a, 0x0a, 0x50, 0x61, 0x6e, 0x74, 0x72, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x12,
+	0x39, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
+	0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12, 0x40, 0x0a, 0x0b, 0x61, 0x72,
+	0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x00, 0x52, 0x0a, 0x61,
+	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x47, 0x0a, 0x0f,
+	0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x48, 0x01, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x3e, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x48, 0x02, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x41, 0x74, 0x88, 0x01, 0x01, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x4e, 0x0a, 0x10, 0x73,
+	0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x23, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e,
+	0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x61, 0x6e, 0x74, 0x72, 0x79, 0x53, 0x74, 0x6f, 0x72, 0x61,
+	0x67, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x73, 0x74, 0x6f, 0x72,
+	0x61, 0x67, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x0a, 0x12, 0x62,
+	0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x61, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73,
+	0x54, 0x6f, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3d, 0x0a, 0x0a, 0x69, 0x6e, 0x67,
+	0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e,
+	0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x0a, 0x69, 0x6e,
+	0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x4d, 0x0a, 0x10, 0x6d, 0x65, 0x61, 0x73,
+	0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x75, 0x6e, 0x69, 0x74, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e,
+	0x67, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x0f, 0x6d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x02, 0x52, 0x08, 0x71, 0x75, 0x61, 0x6e, 0x74,
+	0x69, 0x74, 0x79, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64,
+	0x5f, 0x61, 0x74, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x75, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x42, 0x0d, 0x0a, 0x0b, 0x5f, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x2a, 0xee, 0x03, 0x0a, 0x21, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x41,
+	0x74, 0x74, 0x72, 0x69, 0x62, 0x75, 0x74, 0x65, 0x54, 0x79, 0x70, 0x65, 0x12, 0x31, 0x0a, 0x2d,
+	0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x49, 0x4e, 0x47, 0x52, 0x45, 0x44, 0x49, 0x45, 0x4e, 0x54,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x41, 0x54, 0x54, 0x52, 0x49, 0x42, 0x55, 0x54, 0x45,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x45, 0x58, 0x54, 0x55, 0x52, 0x45, 0x10, 0x00, 0x12,
+	0x35, 0x0a, 0x31, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x49, 0x4e, 0x47, 0x52, 0x45, 0x44, 0x49,
 	0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x41, 0x54, 0x54, 0x52, 0x49, 0x42,
-	0x55, 0x54, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x41, 0x53, 0x54, 0x45, 0x10, 0x06,
-	0x12, 0x2f, 0x0a, 0x2b, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x49, 0x4e, 0x47, 0x52, 0x45, 0x44,
-	0x49, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x41, 0x54, 0x54, 0x52, 0x49,
-	0x42, 0x55, 0x54, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x4f, 0x55, 0x4e, 0x44, 0x10,
-	0x07, 0x12, 0x2f, 0x0a, 0x2b, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x49, 0x4e, 0x47, 0x52, 0x45,
-	0x44, 0x49, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x41, 0x54, 0x54, 0x52,
-	0x49, 0x42, 0x55, 0x54, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4f, 0x54, 0x48, 0x45, 0x52,
-	0x10, 0x08, 0x2a, 0xdf, 0x01, 0x0a, 0x10, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x56, 0x65, 0x73, 0x73,
-	0x65, 0x6c, 0x53, 0x68, 0x61, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x17, 0x56, 0x45, 0x53, 0x53, 0x45,
-	0x4c, 0x5f, 0x53, 0x48, 0x41, 0x50, 0x45, 0x5f, 0x48, 0x45, 0x4d, 0x49, 0x53, 0x50, 0x48, 0x45,
-	0x52, 0x45, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x56, 0x45, 0x53, 0x53, 0x45, 0x4c, 0x5f, 0x53,
-	0x48, 0x41, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x43, 0x54, 0x41, 0x4e, 0x47, 0x4c, 0x45, 0x10, 0x01,
-	0x12, 0x15, 0x0a, 0x11, 0x56, 0x45, 0x53, 0x53, 0x45, 0x4c, 0x5f, 0x53, 0x48, 0x41, 0x50, 0x45,
-	0x5f, 0x43, 0x4f, 0x4e, 0x45, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14, 0x56, 0x45, 0x53, 0x53, 0x45,
-	0x4c, 0x5f, 0x53, 0x48, 0x41, 0x50, 0x45, 0x5f, 0x50, 0x59, 0x52, 0x41, 0x4d, 0x49, 0x44, 0x10,
-	0x03, 0x12, 0x19, 0x0a, 0x15, 0x56, 0x45, 0x53, 0x53, 0x45, 0x4c, 0x5f, 0x53, 0x48, 0x41, 0x50,
-	0x45, 0x5f, 0x43, 0x59, 0x4c, 0x49, 0x4e, 0x44, 0x45, 0x52, 0x10, 0x04, 0x12, 0x17, 0x0a, 0x13,
-	0x56, 0x45, 0x53, 0x53, 0x45, 0x4c, 0x5f, 0x53, 0x48, 0x41, 0x50, 0x45, 0x5f, 0x53, 0x50, 0x48,
-	0x45, 0x52, 0x45, 0x10, 0x05, 0x12, 0x15, 0x0a, 0x11, 0x56, 0x45, 0x53, 0x53, 0x45, 0x4c, 0x5f,
-	0x53, 0x48, 0x41, 0x50, 0x45, 0x5f, 0x43, 0x55, 0x42, 0x45, 0x10, 0x06, 0x12, 0x16, 0x0a, 0x12,
-	0x56, 0x45, 0x53, 0x53, 0x45, 0x4c, 0x5f, 0x53, 0x48, 0x41, 0x50, 0x45, 0x5f, 0x4f, 0x54, 0x48,
-	0x45, 0x52, 0x10, 0x07, 0x2a, 0x8e, 0x01, 0x0a, 0x15, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53,
-	0x74, 0x65, 0x70, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x27,
-	0x0a, 0x23, 0x52, 0x45, 0x43, 0x49, 0x50, 0x45, 0x5f, 0x53, 0x54, 0x45, 0x50, 0x5f, 0x50, 0x52,
-	0x4f, 0x44, 0x55, 0x43, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e, 0x47, 0x52, 0x45,
-	0x44, 0x49, 0x45, 0x4e, 0x54, 0x10, 0x00, 0x12, 0x27, 0x0a, 0x23, 0x52, 0x45, 0x43, 0x49, 0x50,
-	0x45, 0x5f, 0x53, 0x54, 0x45, 0x50, 0x5f, 0x50, 0x52, 0x4f, 0x44, 0x55, 0x43, 0x54, 0x5f, 0x54,
-	0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e, 0x53, 0x54, 0x52, 0x55, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x01,
-	0x12, 0x23, 0x0a, 0x1f, 0x52, 0x45, 0x43, 0x49, 0x50, 0x45, 0x5f, 0x53, 0x54, 0x45, 0x50, 0x5f,
-	0x50, 0x52, 0x4f, 0x44, 0x55, 0x43, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x56, 0x45, 0x53,
-	0x53, 0x45, 0x4c, 0x10, 0x02, 0x2a, 0xbd, 0x02, 0x0a, 0x11, 0x4d, 0x65, 0x61, 0x6c, 0x43, 0x6f,
-	0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x23, 0x0a, 0x1f, 0x4d,
+	0x55, 0x54, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x53, 0x49, 0x53, 0x54,
+	0x45, 0x4e, 0x43, 0x59, 0x10, 0x01, 0x12, 0x35, 0x0a, 0x31, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f,
+	0x49, 0x4e, 0x47, 0x52, 0x45, 0x44, 0x49, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45,
+	0x5f, 0x41, 0x54, 0x54, 0x52, 0x49, 0x42, 0x55, 0x54, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x54, 0x45, 0x4d, 0x50, 0x45, 0x52, 0x41, 0x54, 0x55, 0x52, 0x45, 0x10, 0x02, 0x12, 0x2f, 0x0a,
+	0x2b, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x49, 0x4e, 0x47, 0x52, 0x45, 0x44, 0x49, 0x45, 0x4e,
+	0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x41, 0x54, 0x54, 0x52, 0x49, 0x42, 0x55, 0x54,
+	0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x43, 0x4f, 0x4c, 0x4f, 0x52, 0x10, 0x03, 0x12, 0x34,
+	0x0a, 0x30, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x49, 0x4e, 0x47, 0x52, 0x45, 0x44, 0x49, 0x45,
+	0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x41, 0x54, 0x54, 0x52, 0x49, 0x42, 0x55,
+	0x54, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x50, 0x50, 0x45, 0x41, 0x52, 0x41, 0x4e,
+	0x43, 0x45, 0x10, 0x04, 0x12, 0x2e, 0x0a, 0x2a, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x49, 0x4e,
+	0x47, 0x52, 0x45, 0x44, 0x49, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x41,
+	0x54, 0x54, 0x52, 0x49, 0x42, 0x55, 0x54, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4f, 0x44,
+	0x4f, 0x52, 0x10, 0x05, 0x12, 0x2f, 0x0a, 0x2b, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x49, 0x4e,
+	0x47, 0x52, 0x45, 0x44, 0x49, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f, 0x41,
+	0x54, 0x54, 0x52, 0x49, 0x42, 0x55, 0x54, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x54, 0x41,
+	0x53, 0x54, 0x45, 0x10, 0x06, 0x12, 0x2f, 0x0a, 0x2b, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f, 0x49,
+	0x4e, 0x47, 0x52, 0x45, 0x44, 0x49, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45, 0x5f,
+	0x41, 0x54, 0x54, 0x52, 0x49, 0x42, 0x55, 0x54, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53,
+	0x4f, 0x55, 0x4e, 0x44, 0x10, 0x07, 0x12, 0x2f, 0x0a, 0x2b, 0x56, 0x41, 0x4c, 0x49, 0x44, 0x5f,
+	0x49, 0x4e, 0x47, 0x52, 0x45, 0x44, 0x49, 0x45, 0x4e, 0x54, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x45,
+	0x5f, 0x41, 0x54, 0x54, 0x52, 0x49, 0x42, 0x55, 0x54, 0x45, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x4f, 0x54, 0x48, 0x45, 0x52, 0x10, 0x08, 0x2a, 0xdf, 0x01, 0x0a, 0x10, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x56, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x53, 0x68, 0x61, 0x70, 0x65, 0x12, 0x1b, 0x0a, 0x17,
+	0x56, 0x45, 0x53, 0x53, 0x45, 0x4c, 0x5f, 0x53, 0x48, 0x41, 0x50, 0x45, 0x5f, 0x48, 0x45, 0x4d,
+	0x49, 0x53, 0x50, 0x48, 0x45, 0x52, 0x45, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x56, 0x45, 0x53,
+	0x53, 0x45, 0x4c, 0x5f, 0x53, 0x48, 0x41, 0x50, 0x45, 0x5f, 0x52, 0x45, 0x43, 0x54, 0x41, 0x4e,
+	0x47, 0x4c, 0x45, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x56, 0x45, 0x53, 0x53, 0x45, 0x4c, 0x5f,
+	0x53, 0x48, 0x41, 0x50, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x45, 0x10, 0x02, 0x12, 0x18, 0x0a, 0x14,
+	0x56, 0x45, 0x53, 0x53, 0x45, 0x4c, 0x5f, 0x53, 0x48, 0x41, 0x50, 0x45, 0x5f, 0x50, 0x59, 0x52,
+	0x41, 0x4d, 0x49, 0x44, 0x10, 0x03, 0x12, 0x19, 0x0a, 0x15, 0x56, 0x45, 0x53, 0x53, 0x45, 0x4c,
+	0x5f, 0x53, 0x48, 0x41, 0x50, 0x45, 0x5f, 0x43, 0x59, 0x4c, 0x49, 0x4e, 0x44, 0x45, 0x52, 0x10,
+	0x04, 0x12, 0x17, 0x0a, 0x13, 0x56, 0x45, 0x53, 0x53, 0x45, 0x4c, 0x5f, 0x53, 0x48, 0x41, 0x50,
+	0x45, 0x5f, 0x53, 0x50, 0x48, 0x45, 0x52, 0x45, 0x10, 0x05, 0x12, 0x15, 0x0a, 0x11, 0x56, 0x45,
+	0x53, 0x53, 0x45, 0x4c, 0x5f, 0x53, 0x48, 0x41, 0x50, 0x45, 0x5f, 0x43, 0x55, 0x42, 0x45, 0x10,
+	0x06, 0x12, 0x16, 0x0a, 0x12, 0x56, 0x45, 0x53, 0x53, 0x45, 0x4c, 0x5f, 0x53, 0x48, 0x41, 0x50,
+	0x45, 0x5f, 0x4f, 0x54, 0x48, 0x45, 0x52, 0x10, 0x07, 0x2a, 0x8e, 0x01, 0x0a, 0x15, 0x52, 0x65,
+	0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x27, 0x0a, 0x23, 0x52, 0x45, 0x43, 0x49, 0x50, 0x45, 0x5f, 0x53, 0x54,
+	0x45, 0x50, 0x5f, 0x50, 0x52, 0x4f, 0x44, 0x55, 0x43, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f,
+	0x49, 0x4e, 0x47, 0x52, 0x45, 0x44, 0x49, 0x45, 0x4e, 0x54, 0x10, 0x00, 0x12, 0x27, 0x0a, 0x23,
+	0x52, 0x45, 0x43, 0x49, 0x50, 0x45, 0x5f, 0x53, 0x54, 0x45, 0x50, 0x5f, 0x50, 0x52, 0x4f, 0x44,
+	0x55, 0x43, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e, 0x53, 0x54, 0x52, 0x55, 0x4d,
+	0x45, 0x4e, 0x54, 0x10, 0x01, 0x12, 0x23, 0x0a, 0x1f, 0x52, 0x45, 0x43, 0x49, 0x50, 0x45, 0x5f,
+	0x53, 0x54, 0x45, 0x50, 0x5f, 0x50, 0x52, 0x4f, 0x44, 0x55, 0x43, 0x54, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x56, 0x45, 0x53, 0x53, 0x45, 0x4c, 0x10, 0x02, 0x2a, 0xbd, 0x02, 0x0a, 0x11, 0x4d,
+	0x65, 0x61, 0x6c, 0x43, 0x6f, 0x6d, 0x70, 0x6f, 0x6e, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65,
+	0x12, 0x23, 0x0a, 0x1f, 0x4d, 0x45, 0x41, 0x4c, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4f, 0x4e, 0x45,
+	0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46,
+	0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x24, 0x0a, 0x20, 0x4d, 0x45, 0x41, 0x4c, 0x5f, 0x43, 0x4f,
+	0x4d, 0x50, 0x4f, 0x4e, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x4d, 0x55,
+	0x53, 0x45, 0x5f, 0x42, 0x4f, 0x55, 0x43, 0x48, 0x45, 0x10, 0x01, 0x12, 0x21, 0x0a, 0x1d, 0x4d,
 	0x45, 0x41, 0x4c, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4f, 0x4e, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59,
-	0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
-	0x12, 0x24, 0x0a, 0x20, 0x4d, 0x45, 0x41, 0x4c, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4f, 0x4e, 0x45,
-	0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x4d, 0x55, 0x53, 0x45, 0x5f, 0x42, 0x4f,
-	0x55, 0x43, 0x48, 0x45, 0x10, 0x01, 0x12, 0x21, 0x0a, 0x1d, 0x4d, 0x45, 0x41, 0x4c, 0x5f, 0x43,
-	0x4f, 0x4d, 0x50, 0x4f, 0x4e, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x41, 0x50,
-	0x50, 0x45, 0x54, 0x49, 0x5a, 0x45, 0x52, 0x10, 0x02, 0x12, 0x1c, 0x0a, 0x18, 0x4d, 0x45, 0x41,
+	0x50, 0x45, 0x5f, 0x41, 0x50, 0x50, 0x45, 0x54, 0x49, 0x5a, 0x45, 0x52, 0x10, 0x02, 0x12, 0x1c,
+	0x0a, 0x18, 0x4d, 0x45, 0x41, 0x4c, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4f, 0x4e, 0x45, 0x4e, 0x54,
+	0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x4f, 0x55, 0x50, 0x10, 0x03, 0x12, 0x1c, 0x0a, 0x18,
+	0x4d, 0x45, 0x41, 0x4c, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4f, 0x4e, 0x45, 0x4e, 0x54, 0x5f, 0x54,
+	0x59, 0x50, 0x45, 0x5f, 0x4d, 0x41, 0x49, 0x4e, 0x10, 0x04, 0x12, 0x1d, 0x0a, 0x19, 0x4d, 0x45,
+	0x41, 0x4c, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4f, 0x4e, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50,
+	0x45, 0x5f, 0x53, 0x41, 0x4c, 0x41, 0x44, 0x10, 0x05, 0x12, 0x20, 0x0a, 0x1c, 0x4d, 0x45, 0x41,
 	0x4c, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4f, 0x4e, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45,
-	0x5f, 0x53, 0x4f, 0x55, 0x50, 0x10, 0x03, 0x12, 0x1c, 0x0a, 0x18, 0x4d, 0x45, 0x41, 0x4c, 0x5f,
-	0x43, 0x4f, 0x4d, 0x50, 0x4f, 0x4e, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x4d,
-	0x41, 0x49, 0x4e, 0x10, 0x04, 0x12, 0x1d, 0x0a, 0x19, 0x4d, 0x45, 0x41, 0x4c, 0x5f, 0x43, 0x4f,
-	0x4d, 0x50, 0x4f, 0x4e, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x41, 0x4c,
-	0x41, 0x44, 0x10, 0x05, 0x12, 0x20, 0x0a, 0x1c, 0x4d, 0x45, 0x41, 0x4c, 0x5f, 0x43, 0x4f, 0x4d,
-	0x50, 0x4f, 0x4e, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x42, 0x45, 0x56, 0x45,
-	0x52, 0x41, 0x47, 0x45, 0x10, 0x06, 0x12, 0x1c, 0x0a, 0x18, 0x4d, 0x45, 0x41, 0x4c, 0x5f, 0x43,
-	0x4f, 0x4d, 0x50, 0x4f, 0x4e, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x53, 0x49,
-	0x44, 0x45, 0x10, 0x07, 0x12, 0x1f, 0x0a, 0x1b, 0x4d, 0x45, 0x41, 0x4c, 0x5f, 0x43, 0x4f, 0x4d,
-	0x50, 0x4f, 0x4e, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x44, 0x45, 0x53, 0x53,
-	0x45, 0x52, 0x54, 0x10, 0x08, 0x2a, 0x6d, 0x0a, 0x16, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61,
-	0x6e, 0x45, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x12,
-	0x25, 0x0a, 0x21, 0x4d, 0x45, 0x41, 0x4c, 0x5f, 0x50, 0x4c, 0x41, 0x4e, 0x5f, 0x45, 0x4c, 0x45,
-	0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x53, 0x43, 0x48,
-	0x55, 0x4c, 0x5a, 0x45, 0x10, 0x00, 0x12, 0x2c, 0x0a, 0x28, 0x4d, 0x45, 0x41, 0x4c, 0x5f, 0x50,
-	0x4c, 0x41, 0x4e, 0x5f, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x45, 0x54,
-	0x48, 0x4f, 0x44, 0x5f, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4e, 0x54, 0x5f, 0x52, 0x55, 0x4e, 0x4f,
-	0x46, 0x46, 0x10, 0x01, 0x2a, 0x55, 0x0a, 0x0e, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x23, 0x0a, 0x1f, 0x4d, 0x45, 0x41, 0x4c, 0x5f, 0x50,
-	0x4c, 0x41, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x57, 0x41, 0x49, 0x54,
-	0x49, 0x4e, 0x47, 0x5f, 0x56, 0x4f, 0x54, 0x45, 0x53, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x4d,
-	0x45, 0x41, 0x4c, 0x5f, 0x50, 0x4c, 0x41, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
-	0x46, 0x49, 0x4e, 0x41, 0x4c, 0x49, 0x5a, 0x45, 0x44, 0x10, 0x01, 0x2a, 0xe5, 0x01, 0x0a, 0x11,
-	0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d,
-	0x65, 0x12, 0x22, 0x0a, 0x1e, 0x4d, 0x45, 0x41, 0x4c, 0x5f, 0x50, 0x4c, 0x41, 0x4e, 0x5f, 0x45,
-	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x42, 0x52, 0x45, 0x41, 0x4b, 0x46,
-	0x41, 0x53, 0x54, 0x10, 0x00, 0x12, 0x29, 0x0a, 0x25, 0x4d, 0x45, 0x41, 0x4c, 0x5f, 0x50, 0x4c,
-	0x41, 0x4e, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x53, 0x45,
-	0x43, 0x4f, 0x4e, 0x44, 0x5f, 0x42, 0x52, 0x45, 0x41, 0x4b, 0x46, 0x41, 0x53, 0x54, 0x10, 0x01,
-	0x12, 0x1f, 0x0a, 0x1b, 0x4d, 0x45, 0x41, 0x4c, 0x5f, 0x50, 0x4c, 0x41, 0x4e, 0x5f, 0x45, 0x56,
-	0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x42, 0x52, 0x55, 0x4e, 0x43, 0x48, 0x10,
-	0x02, 0x12, 0x1e, 0x0a, 0x1a, 0x4d, 0x45, 0x41, 0x4c, 0x5f, 0x50, 0x4c, 0x41, 0x4e, 0x5f, 0x45,
-	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x4c, 0x55, 0x4e, 0x43, 0x48, 0x10,
-	0x03, 0x12, 0x1f, 0x0a, 0x1b, 0x4d, 0x45, 0x41, 0x4c, 0x5f, 0x50, 0x4c, 0x41, 0x4e, 0x5f, 0x45,
-	0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x53, 0x55, 0x50, 0x50, 0x45, 0x52,
-	0x10, 0x04, 0x12, 0x1f, 0x0a, 0x1b, 0x4d, 0x45, 0x41, 0x4c, 0x5f, 0x50, 0x4c, 0x41, 0x4e, 0x5f,
-	0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x44, 0x49, 0x4e, 0x4e, 0x45,
-	0x52, 0x10, 0x05, 0x2a, 0x98, 0x02, 0x0a, 0x1d, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e,
-	0x47, 0x72, 0x6f, 0x63, 0x65, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2e, 0x0a, 0x2a, 0x4d, 0x45, 0x41, 0x4c, 0x5f, 0x50, 0x4c,
-	0x41, 0x4e, 0x5f, 0x47, 0x52, 0x4f, 0x43, 0x45, 0x52, 0x59, 0x5f, 0x4c, 0x49, 0x53, 0x54, 0x5f,
-	0x49, 0x54, 0x45, 0x4d, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x4b, 0x4e,
-	0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x34, 0x0a, 0x30, 0x4d, 0x45, 0x41, 0x4c, 0x5f, 0x50, 0x4c,
-	0x41, 0x4e, 0x5f, 0x47, 0x52, 0x4f, 0x43, 0x45, 0x52, 0x59, 0x5f, 0x4c, 0x49, 0x53, 0x54, 0x5f,
-	0x49, 0x54, 0x45, 0x4d, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x4c, 0x52, 0x45,
-	0x41, 0x44, 0x59, 0x5f, 0x4f, 0x57, 0x4e, 0x45, 0x44, 0x10, 0x01, 0x12, 0x2c, 0x0a, 0x28, 0x4d,
-	0x45, 0x41, 0x4c, 0x5f, 0x50, 0x4c, 0x41, 0x4e, 0x5f, 0x47, 0x52, 0x4f, 0x43, 0x45, 0x52, 0x59,
-	0x5f, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x49, 0x54, 0x45, 0x4d, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
-	0x53, 0x5f, 0x4e, 0x45, 0x45, 0x44, 0x53, 0x10, 0x02, 0x12, 0x32, 0x0a, 0x2e, 0x4d, 0x45, 0x41,
-	0x4c, 0x5f, 0x50, 0x4c, 0x41, 0x4e, 0x5f, 0x47, 0x52, 0x4f, 0x43, 0x45, 0x52, 0x59, 0x5f, 0x4c,
-	0x49, 0x53, 0x54, 0x5f, 0x49, 0x54, 0x45, 0x4d, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f,
-	0x55, 0x4e, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x03, 0x12, 0x2f, 0x0a,
-	0x2b, 0x4d, 0x45, 0x41, 0x4c, 0x5f, 0x50, 0x4c, 0x41, 0x4e, 0x5f, 0x47, 0x52, 0x4f, 0x43, 0x45,
+	0x5f, 0x42, 0x45, 0x56, 0x45, 0x52, 0x41, 0x47, 0x45, 0x10, 0x06, 0x12, 0x1c, 0x0a, 0x18, 0x4d,
+	0x45, 0x41, 0x4c, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4f, 0x4e, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x53, 0x49, 0x44, 0x45, 0x10, 0x07, 0x12, 0x1f, 0x0a, 0x1b, 0x4d, 0x45, 0x41,
+	0x4c, 0x5f, 0x43, 0x4f, 0x4d, 0x50, 0x4f, 0x4e, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x59, 0x50, 0x45,
+	0x5f, 0x44, 0x45, 0x53, 0x53, 0x45, 0x52, 0x54, 0x10, 0x08, 0x2a, 0xdf, 0x01, 0x0a, 0x16, 0x4d,
+	0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x45, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
+	0x65, 0x74, 0x68, 0x6f, 0x64, 0x12, 0x25, 0x0a, 0x21, 0x4d, 0x45, 0x41, 0x4c, 0x5f, 0x50, 0x4c,
+	0x41, 0x4e, 0x5f, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x45, 0x54, 0x48,
+	0x4f, 0x44, 0x5f, 0x53, 0x43, 0x48, 0x55, 0x4c, 0x5a, 0x45, 0x10, 0x00, 0x12, 0x2c, 0x0a, 0x28,
+	0x4d, 0x45, 0x41, 0x4c, 0x5f, 0x50, 0x4c, 0x41, 0x4e, 0x5f, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x49,
+	0x4f, 0x4e, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x49, 0x4e, 0x53, 0x54, 0x41, 0x4e,
+	0x54, 0x5f, 0x52, 0x55, 0x4e, 0x4f, 0x46, 0x46, 0x10, 0x01, 0x12, 0x23, 0x0a, 0x1f, 0x4d, 0x45,
+	0x41, 0x4c, 0x5f, 0x50, 0x4c, 0x41, 0x4e, 0x5f, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x42, 0x4f, 0x52, 0x44, 0x41, 0x10, 0x02, 0x12,
+	0x26, 0x0a, 0x22, 0x4d, 0x45, 0x41, 0x4c, 0x5f, 0x50, 0x4c, 0x41, 0x4e, 0x5f, 0x45, 0x4c, 0x45,
+	0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f, 0x44, 0x5f, 0x41, 0x50, 0x50,
+	0x52, 0x4f, 0x56, 0x41, 0x4c, 0x10, 0x03, 0x12, 0x23, 0x0a, 0x1f, 0x4d, 0x45, 0x41, 0x4c, 0x5f,
+	0x50, 0x4c, 0x41, 0x4e, 0x5f, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4d, 0x45,
+	0x54, 0x48, 0x4f, 0x44, 0x5f, 0x53, 0x43, 0x4f, 0x52, 0x45, 0x10, 0x04, 0x2a, 0x55, 0x0a, 0x0e,
+	0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x23,
+	0x0a, 0x1f, 0x4d, 0x45, 0x41, 0x4c, 0x5f, 0x50, 0x4c, 0x41, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54,
+	0x55, 0x53, 0x5f, 0x41, 0x57, 0x41, 0x49, 0x54, 0x49, 0x4e, 0x47, 0x5f, 0x56, 0x4f, 0x54, 0x45,
+	0x53, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x4d, 0x45, 0x41, 0x4c, 0x5f, 0x50, 0x4c, 0x41, 0x4e,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x49, 0x4e, 0x41, 0x4c, 0x49, 0x5a, 0x45,
+	0x44, 0x10, 0x01, 0x2a, 0xe5, 0x01, 0x0a, 0x11, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x22, 0x0a, 0x1e, 0x4d, 0x45, 0x41,
+	0x4c, 0x5f, 0x50, 0x4c, 0x41, 0x4e, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x41, 0x4d,
+	0x45, 0x5f, 0x42, 0x52, 0x45, 0x41, 0x4b, 0x46, 0x41, 0x53, 0x54, 0x10, 0x00, 0x12, 0x29, 0x0a,
+	0x25, 0x4d, 0x45, 0x41, 0x4c, 0x5f, 0x50, 0x4c, 0x41, 0x4e, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x4e, 0x41, 0x4d, 0x45, 0x5f, 0x53, 0x45, 0x43, 0x4f, 0x4e, 0x44, 0x5f, 0x42, 0x52, 0x45,
+	0x41, 0x4b, 0x46, 0x41, 0x53, 0x54, 0x10, 0x01, 0x12, 0x1f, 0x0a, 0x1b, 0x4d, 0x45, 0x41, 0x4c,
+	0x5f, 0x50, 0x4c, 0x41, 0x4e, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x41, 0x4d, 0x45,
+	0x5f, 0x42, 0x52, 0x55, 0x4e, 0x43, 0x48, 0x10, 0x02, 0x12, 0x1e, 0x0a, 0x1a, 0x4d, 0x45, 0x41,
+	0x4c, 0x5f, 0x50, 0x4c, 0x41, 0x4e, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x41, 0x4d,
+	0x45, 0x5f, 0x4c, 0x55, 0x4e, 0x43, 0x48, 0x10, 0x03, 0x12, 0x1f, 0x0a, 0x1b, 0x4d, 0x45, 0x41,
+	0x4c, 0x5f, 0x50, 0x4c, 0x41, 0x4e, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x41, 0x4d,
+	0x45, 0x5f, 0x53, 0x55, 0x50, 0x50, 0x45, 0x52, 0x10, 0x04, 0x12, 0x1f, 0x0a, 0x1b, 0x4d, 0x45,
+	0x41, 0x4c, 0x5f, 0x50, 0x4c, 0x41, 0x4e, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4e, 0x41,
+	0x4d, 0x45, 0x5f, 0x44, 0x49, 0x4e, 0x4e, 0x45, 0x52, 0x10, 0x05, 0x2a, 0x98, 0x02, 0x0a, 0x1d,
+	0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x47, 0x72, 0x6f, 0x63, 0x65, 0x72, 0x79, 0x4c,
+	0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x2e, 0x0a,
+	0x2a, 0x4d, 0x45, 0x41, 0x4c, 0x5f, 0x50, 0x4c, 0x41, 0x4e, 0x5f, 0x47, 0x52, 0x4f, 0x43, 0x45,
+	0x52, 0x59, 0x5f, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x49, 0x54, 0x45, 0x4d, 0x5f, 0x53, 0x54, 0x41,
+	0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x10, 0x00, 0x12, 0x34, 0x0a,
+	0x30, 0x4d, 0x45, 0x41, 0x4c, 0x5f, 0x50, 0x4c, 0x41, 0x4e, 0x5f, 0x47, 0x52, 0x4f, 0x43, 0x45,
 	0x52, 0x59, 0x5f, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x49, 0x54, 0x45, 0x4d, 0x5f, 0x53, 0x54, 0x41,
-	0x54, 0x55, 0x53, 0x5f, 0x41, 0x43, 0x51, 0x55, 0x49, 0x52, 0x45, 0x44, 0x10, 0x04, 0x2a, 0xca,
-	0x01, 0x0a, 0x12, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x24, 0x0a, 0x20, 0x4d, 0x45, 0x41, 0x4c, 0x5f, 0x50, 0x4c,
-	0x41, 0x4e, 0x5f, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55,
-	0x4e, 0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0x45, 0x44, 0x10, 0x00, 0x12, 0x23, 0x0a, 0x1f, 0x4d,
+	0x54, 0x55, 0x53, 0x5f, 0x41, 0x4c, 0x52, 0x45, 0x41, 0x44, 0x59, 0x5f, 0x4f, 0x57, 0x4e, 0x45,
+	0x44, 0x10, 0x01, 0x12, 0x2c, 0x0a, 0x28, 0x4d, 0x45, 0x41, 0x4c, 0x5f, 0x50, 0x4c, 0x41, 0x4e,
+	0x5f, 0x47, 0x52, 0x4f, 0x43, 0x45, 0x52, 0x59, 0x5f, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x49, 0x54,
+	0x45, 0x4d, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x4e, 0x45, 0x45, 0x44, 0x53, 0x10,
+	0x02, 0x12, 0x32, 0x0a, 0x2e, 0x4d, 0x45, 0x41, 0x4c, 0x5f, 0x50, 0x4c, 0x41, 0x4e, 0x5f, 0x47,
+	0x52, 0x4f, 0x43, 0x45, 0x52, 0x59, 0x5f, 0x4c, 0x49, 0x53, 0x54, 0x5f, 0x49, 0x54, 0x45, 0x4d,
+	0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x41, 0x56, 0x41, 0x49, 0x4c, 0x41,
+	0x42, 0x4c, 0x45, 0x10, 0x03, 0x12, 0x2f, 0x0a, 0x2b, 0x4d, 0x45, 0x41, 0x4c, 0x5f, 0x50, 0x4c,
+	0x41, 0x4e, 0x5f, 0x47, 0x52, 0x4f, 0x43, 0x45, 0x52, 0x59, 0x5f, 0x4c, 0x49, 0x53, 0x54, 0x5f,
+	0x49, 0x54, 0x45, 0x4d, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x41, 0x43, 0x51, 0x55,
+	0x49, 0x52, 0x45, 0x44, 0x10, 0x04, 0x2a, 0xad, 0x01, 0x0a, 0x15, 0x50, 0x61, 0x6e, 0x74, 0x72,
+	0x79, 0x53, 0x74, 0x6f, 0x72, 0x61, 0x67, 0x65, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x22, 0x0a, 0x1e, 0x50, 0x41, 0x4e, 0x54, 0x52, 0x59, 0x5f, 0x53, 0x54, 0x4f, 0x52, 0x41,
+	0x47, 0x45, 0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x50, 0x41, 0x4e, 0x54,
+	0x52, 0x59, 0x10, 0x00, 0x12, 0x28, 0x0a, 0x24, 0x50, 0x41, 0x4e, 0x54, 0x52, 0x59, 0x5f, 0x53,
+	0x54, 0x4f, 0x52, 0x41, 0x47, 0x45, 0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f,
+	0x52, 0x45, 0x46, 0x52, 0x49, 0x47, 0x45, 0x52, 0x41, 0x54, 0x4f, 0x52, 0x10, 0x01, 0x12, 0x23,
+	0x0a, 0x1f, 0x50, 0x41, 0x4e, 0x54, 0x52, 0x59, 0x5f, 0x53, 0x54, 0x4f, 0x52, 0x41, 0x47, 0x45,
+	0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x46, 0x52, 0x45, 0x45, 0x5a, 0x45,
+	0x52, 0x10, 0x02, 0x12, 0x21, 0x0a, 0x1d, 0x50, 0x41, 0x4e, 0x54, 0x52, 0x59, 0x5f, 0x53, 0x54,
+	0x4f, 0x52, 0x41, 0x47, 0x45, 0x5f, 0x4c, 0x4f, 0x43, 0x41, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x4f,
+	0x54, 0x48, 0x45, 0x52, 0x10, 0x03, 0x2a, 0xca, 0x01, 0x0a, 0x12, 0x4d, 0x65, 0x61, 0x6c, 0x50,
+	0x6c, 0x61, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x24, 0x0a,
+	0x20, 0x4d, 0x45, 0x41, 0x4c, 0x5f, 0x50, 0x4c, 0x41, 0x4e, 0x5f, 0x54, 0x41, 0x53, 0x4b, 0x5f,
+	0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x55, 0x4e, 0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x23, 0x0a, 0x1f, 0x4d, 0x45, 0x41, 0x4c, 0x5f, 0x50, 0x4c, 0x41, 0x4e,
+	0x5f, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x4f, 0x53,
+	0x54, 0x50, 0x4f, 0x4e, 0x45, 0x44, 0x10, 0x01, 0x12, 0x21, 0x0a, 0x1d, 0x4d, 0x45, 0x41, 0x4c,
+	0x5f, 0x50, 0x4c, 0x41, 0x4e, 0x5f, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55,
+	0x53, 0x5f, 0x49, 0x47, 0x4e, 0x4f, 0x52, 0x45, 0x44, 0x10, 0x02, 0x12, 0x22, 0x0a, 0x1e, 0x4d,
 	0x45, 0x41, 0x4c, 0x5f, 0x50, 0x4c, 0x41, 0x4e, 0x5f, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x53, 0x54,
-	0x41, 0x54, 0x55, 0x53, 0x5f, 0x50, 0x4f, 0x53, 0x54, 0x50, 0x4f, 0x4e, 0x45, 0x44, 0x10, 0x01,
-	0x12, 0x21, 0x0a, 0x1d, 0x4d, 0x45, 0x41, 0x4c, 0x5f, 0x50, 0x4c, 0x41, 0x4e, 0x5f, 0x54, 0x41,
-	0x53, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x49, 0x47, 0x4e, 0x4f, 0x52, 0x45,
-	0x44, 0x10, 0x02, 0x12, 0x22, 0x0a, 0x1e, 0x4d, 0x45, 0x41, 0x4c, 0x5f, 0x50, 0x4c, 0x41, 0x4e,
-	0x5f, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x41, 0x4e,
-	0x43, 0x45, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x12, 0x22, 0x0a, 0x1e, 0x4d, 0x45, 0x41, 0x4c, 0x5f,
-	0x50, 0x4c, 0x41, 0x4e, 0x5f, 0x54, 0x41, 0x53, 0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
-	0x5f, 0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0x45, 0x44, 0x10, 0x04, 0x2a, 0xfc, 0x01, 0x0a, 0x21,
-	0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x4f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70,
-	0x65, 0x12, 0x36, 0x0a, 0x32, 0x4d, 0x45, 0x41, 0x4c, 0x5f, 0x50, 0x4c, 0x41, 0x4e, 0x5f, 0x52,
-	0x45, 0x43, 0x49, 0x50, 0x45, 0x5f, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x45, 0x4c,
-	0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50,
-	0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00, 0x12, 0x35, 0x0a, 0x31, 0x4d, 0x45, 0x41,
+	0x41, 0x54, 0x55, 0x53, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x12,
+	0x22, 0x0a, 0x1e, 0x4d, 0x45, 0x41, 0x4c, 0x5f, 0x50, 0x4c, 0x41, 0x4e, 0x5f, 0x54, 0x41, 0x53,
+	0x4b, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53, 0x5f, 0x46, 0x49, 0x4e, 0x49, 0x53, 0x48, 0x45,
+	0x44, 0x10, 0x04, 0x2a, 0xfc, 0x01, 0x0a, 0x21, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e,
+	0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x79, 0x70, 0x65, 0x12, 0x36, 0x0a, 0x32, 0x4d, 0x45, 0x41,
 	0x4c, 0x5f, 0x50, 0x4c, 0x41, 0x4e, 0x5f, 0x52, 0x45, 0x43, 0x49, 0x50, 0x45, 0x5f, 0x4f, 0x50,
 	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54,
-	0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e, 0x47, 0x52, 0x45, 0x44, 0x49, 0x45, 0x4e, 0x54, 0x10, 0x01,
-	0x12, 0x35, 0x0a, 0x31, 0x4d, 0x45, 0x41, 0x4c, 0x5f, 0x50, 0x4c, 0x41, 0x4e, 0x5f, 0x52, 0x45,
-	0x43, 0x49, 0x50, 0x45, 0x5f, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x45, 0x4c, 0x45,
-	0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e, 0x53, 0x54, 0x52,
-	0x55, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x02, 0x12, 0x31, 0x0a, 0x2d, 0x4d, 0x45, 0x41, 0x4c, 0x5f,
-	0x50, 0x4c, 0x41, 0x4e, 0x5f, 0x52, 0x45, 0x43, 0x49, 0x50, 0x45, 0x5f, 0x4f, 0x50, 0x54, 0x49,
-	0x4f, 0x4e, 0x5f, 0x53, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50,
-	0x45, 0x5f, 0x56, 0x45, 0x53, 0x53, 0x45, 0x4c, 0x10, 0x03, 0x42, 0x64, 0x5a, 0x62, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x64,
-	0x6f, 0x6e, 0x65, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x2f, 0x64, 0x69, 0x6e, 0x6e, 0x65, 0x72,
-	0x64, 0x6f, 0x6e, 0x65, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65,
-	0x6e, 0x64, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x67, 0x72, 0x70, 0x63,
-	0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x73, 0x2f, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x59, 0x50, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x35, 0x0a, 0x31, 0x4d, 0x45, 0x41, 0x4c, 0x5f, 0x50, 0x4c, 0x41, 0x4e, 0x5f, 0x52,
+	0x45, 0x43, 0x49, 0x50, 0x45, 0x5f, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x45, 0x4c,
+	0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x49, 0x4e, 0x47, 0x52,
+	0x45, 0x44, 0x49, 0x45, 0x4e, 0x54, 0x10, 0x01, 0x12, 0x35, 0x0a, 0x31, 0x4d, 0x45, 0x41, 0x4c,
+	0x5f, 0x50, 0x4c, 0x41, 0x4e, 0x5f, 0x52, 0x45, 0x43, 0x49, 0x50, 0x45, 0x5f, 0x4f, 0x50, 0x54,
+	0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x45, 0x4c, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59,
+	0x50, 0x45, 0x5f, 0x49, 0x4e, 0x53, 0x54, 0x52, 0x55, 0x4d, 0x45, 0x4e, 0x54, 0x10, 0x02, 0x12,
+	0x31, 0x0a, 0x2d, 0x4d, 0x45, 0x41, 0x4c, 0x5f, 0x50, 0x4c, 0x41, 0x4e, 0x5f, 0x52, 0x45, 0x43,
+	0x49, 0x50, 0x45, 0x5f, 0x4f, 0x50, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x53, 0x45, 0x4c, 0x45, 0x43,
+	0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x54, 0x59, 0x50, 0x45, 0x5f, 0x56, 0x45, 0x53, 0x53, 0x45, 0x4c,
+	0x10, 0x03, 0x42, 0x64, 0x5a, 0x62, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x64, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x64, 0x6f, 0x6e, 0x65, 0x62, 0x65, 0x74, 0x74, 0x65,
+	0x72, 0x2f, 0x64, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x64, 0x6f, 0x6e, 0x65, 0x62, 0x65, 0x74, 0x74,
+	0x65, 0x72, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72,
+	0x6e, 0x61, 0x6c, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+	0x65, 0x64, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x6d, 0x65, 0x61, 0x6c,
+	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
@@ -8520,8 +11484,8 @@ func file_mealplanning_mealplanning_messages_proto_rawDescGZIP() []byte {
 	return file_mealplanning_mealplanning_messages_proto_rawDescData
 }
 
-var file_mealplanning_mealplanning_messages_proto_enumTypes = make([]protoimpl.EnumInfo, 10)
-var file_mealplanning_mealplanning_messages_proto_msgTypes = make([]protoimpl.MessageInfo, 46)
+var file_mealplanning_mealplanning_messages_proto_enumTypes = make([]protoimpl.EnumInfo, 11)
+var file_mealplanning_mealplanning_messages_proto_msgTypes = make([]protoimpl.MessageInfo, 71)
 var file_mealplanning_mealplanning_messages_proto_goTypes = []any{
 	(ValidIngredientStateAttributeType)(0),          // 0: mealplanning.ValidIngredientStateAttributeType
 	(ValidVesselShape)(0),                           // 1: mealplanning.ValidVesselShape
@@ -8531,263 +11495,346 @@ func file_mealplanning_mealplanning_messages_proto_rawDescGZIP() []byte {
 	(MealPlanStatus)(0),                             // 5: mealplanning.MealPlanStatus
 	(MealPlanEventName)(0),                          // 6: mealplanning.MealPlanEventName
 	(MealPlanGroceryListItemStatus)(0),              // 7: mealplanning.MealPlanGroceryListItemStatus
-	(MealPlanTaskStatus)(0),                         // 8: mealplanning.MealPlanTaskStatus
-	(MealPlanRecipeOptionSelectionType)(0),          // 9: mealplanning.MealPlanRecipeOptionSelectionType
-	(*DataCollection)(nil),                          // 10: mealplanning.DataCollection
-	(*ValidIngredient)(nil),                         // 11: mealplanning.ValidIngredient
-	(*ValidIngredientGroup)(nil),                    // 12: mealplanning.ValidIngredientGroup
-	(*ValidIngredientGroupMember)(nil),              // 13: mealplanning.ValidIngredientGroupMember
-	(*ValidIngredientMeasurementUnit)(nil),          // 14: mealplanning.ValidIngredientMeasurementUnit
-	(*ValidIngredientPreparation)(nil),              // 15: mealplanning.ValidIngredientPreparation
-	(*ValidPrepTaskConfig)(nil),                     // 16: mealplanning.ValidPrepTaskConfig
-	(*ValidIngredientState)(nil),                    // 17: mealplanning.ValidIngredientState
-	(*ValidIngredientStateIngredient)(nil),          // 18: mealplanning.ValidIngredientStateIngredient
-	(*ValidInstrument)(nil),                         // 19: mealplanning.ValidInstrument
-	(*ValidMeasurementUnit)(nil),                    // 20: mealplanning.ValidMeasurementUnit
-	(*ValidMeasurementUnitConversion)(nil),          // 21: mealplanning.ValidMeasurementUnitConversion
-	(*MeasurementUnitConversionMismatch)(nil),       // 22: mealplanning.MeasurementUnitConversionMismatch
-	(*ValidPreparation)(nil),                        // 23: mealplanning.ValidPreparation
-	(*ValidPreparationInstrument)(nil),              // 24: mealplanning.ValidPreparationInstrument
-	(*ValidPreparationVessel)(nil),                  // 25: mealplanning.ValidPreparationVessel
-	(*ValidVessel)(nil),                             // 26: mealplanning.ValidVessel
-	(*UserIngredientPreference)(nil),                // 27: mealplanning.UserIngredientPreference
-	(*Recipe)(nil),                                  // 28: mealplanning.Recipe
-	(*RecipeMedia)(nil),                             // 29: mealplanning.RecipeMedia
-	(*RecipePrepTask)(nil),                          // 30: mealplanning.RecipePrepTask
-	(*RecipePrepTaskStep)(nil),                      // 31: mealplanning.RecipePrepTaskStep
-	(*RecipeRating)(nil),                            // 32: mealplanning.RecipeRating
-	(*RecipeStep)(nil),                              // 33: mealplanning.RecipeStep
-	(*RecipeStepCompletionCondition)(nil),           // 34: mealplanning.RecipeStepCompletionCondition
-	(*RecipeStepCompletionConditionIngredient)(nil), // 35: mealplanning.RecipeStepCompletionConditionIngredient
-	(*RecipeStepIngredient)(nil),                    // 36: mealplanning.RecipeStepIngredient
-	(*RecipeStepInstrument)(nil),                    // 37: mealplanning.RecipeStepInstrument
-	(*RecipeStepProduct)(nil),                       // 38: mealplanning.RecipeStepProduct
-	(*RecipeStepVessel)(nil),                        // 39: mealplanning.RecipeStepVessel
-	(*Meal)(nil),                                    // 40: mealplanning.Meal
-	(*MealComponent)(nil),                           // 41: mealplanning.MealComponent
-	(*MealPlan)(nil),                                // 42: mealplanning.MealPlan
-	(*MealPlanEvent)(nil),                           // 43: mealplanning.MealPlanEvent
-	(*MealPlanGroceryListItem)(nil),                 // 44: mealplanning.MealPlanGroceryListItem
-	(*MealPlanOption)(nil),                          // 45: mealplanning.MealPlanOption
-	(*MealPlanOptionVote)(nil),                      // 46: mealplanning.MealPlanOptionVote
-	(*MealPlanOptionVoteCreationInput)(nil),         // 47: mealplanning.MealPlanOptionVoteCreationInput
-	(*MealPlanRecipeOptionSelection)(nil),           // 48: mealplanning.MealPlanRecipeOptionSelection
-	(*MissingVote)(nil),                             // 49: mealplanning.MissingVote
-	(*MealList)(nil),                                // 50: mealplanning.MealList
-	(*MealListItem)(nil),                            // 51: mealplanning.MealListItem
-	(*RecipeList)(nil),                              // 52: mealplanning.RecipeList
-	(*RecipeListItem)(nil),                          // 53: mealplanning.RecipeListItem
-	(*MealPlanTask)(nil),                            // 54: mealplanning.MealPlanTask
-	(*AccountInstrumentOwnership)(nil),              // 55: mealplanning.AccountInstrumentOwnership
-	(*timestamppb.Timestamp)(nil),                   // 56: google.protobuf.Timestamp
-	(*uploaded_media.UploadedMedia)(nil),            // 57: uploaded_media.UploadedMedia
+	(PantryStorageLocation)(0),                      // 8: mealplanning.PantryStorageLocation
+	(MealPlanTaskStatus)(0),                         // 9: mealplanning.MealPlanTaskStatus
+	(MealPlanRecipeOptionSelectionType)(0),          // 10: mealplanning.MealPlanRecipeOptionSelectionType
+	(*DataCollection)(nil),                          // 11: mealplanning.DataCollection
+	(*ValidIngredient)(nil),                         // 12: mealplanning.ValidIngredient
+	(*ValidIngredientGroup)(nil),                    // 13: mealplanning.ValidIngredientGroup
+	(*ValidIngredientGroupMember)(nil),              // 14: mealplanning.ValidIngredientGroupMember
+	(*ValidIngredientMeasurementUnit)(nil),          // 15: mealplanning.ValidIngredientMeasurementUnit
+	(*ValidIngredientPreparation)(nil),              // 16: mealplanning.ValidIngredientPreparation
+	(*ValidPrepTaskConfig)(nil),                     // 17: mealplanning.ValidPrepTaskConfig
+	(*ValidIngredientState)(nil),                    // 18: mealplanning.ValidIngredientState
+	(*ValidIngredientStateIngredient)(nil),          // 19: mealplanning.ValidIngredientStateIngredient
+	(*ValidInstrument)(nil),                         // 20: mealplanning.ValidInstrument
+	(*ValidMeasurementUnit)(nil),                    // 21: mealplanning.ValidMeasurementUnit
+	(*ValidMeasurementUnitConversion)(nil),          // 22: mealplanning.ValidMeasurementUnitConversion
+	(*MeasurementUnitConversionMismatch)(nil),       // 23: mealplanning.MeasurementUnitConversionMismatch
+	(*ValidPreparation)(nil),                        // 24: mealplanning.ValidPreparation
+	(*ValidPreparationInstrument)(nil),              // 25: mealplanning.ValidPreparationInstrument
+	(*ValidPreparationVessel)(nil),                  // 26: mealplanning.ValidPreparationVessel
+	(*ValidVessel)(nil),                             // 27: mealplanning.ValidVessel
+	(*UserIngredientPreference)(nil),                // 28: mealplanning.UserIngredientPreference
+	(*Recipe)(nil),                                  // 29: mealplanning.Recipe
+	(*RecipeMedia)(nil),                             // 30: mealplanning.RecipeMedia
+	(*RecipePrepTask)(nil),                          // 31: mealplanning.RecipePrepTask
+	(*RecipePrepTaskStep)(nil),                      // 32: mealplanning.RecipePrepTaskStep
+	(*RecipeRating)(nil),                            // 33: mealplanning.RecipeRating
+	(*RecipeRevision)(nil),                          // 34: mealplanning.RecipeRevision
+	(*RecipeFieldChange)(nil),                       // 35: mealplanning.RecipeFieldChange
+	(*RecipeComponentDiff)(nil),                     // 36: mealplanning.RecipeComponentDiff
+	(*RecipeStepDiff)(nil),                          // 37: mealplanning.RecipeStepDiff
+	(*RecipeRevisionDiff)(nil),                      // 38: mealplanning.RecipeRevisionDiff
+	(*RecipeStep)(nil),                              // 39: mealplanning.RecipeStep
+	(*RecipeStepCompletionCondition)(nil),           // 40: mealplanning.RecipeStepCompletionCondition
+	(*RecipeStepCompletionConditionIngredient)(nil), // 41: mealplanning.RecipeStepCompletionConditionIngredient
+	(*RecipeStepIngredient)(nil),                    // 42: mealplanning.RecipeStepIngredient
+	(*RecipeStepInstrument)(nil),                    // 43: mealplanning.RecipeStepInstrument
+	(*RecipeStepProduct)(nil),                       // 44: mealplanning.RecipeStepProduct
+	(*RecipeStepVessel)(nil),                        // 45: mealplanning.RecipeStepVessel
+	(*Meal)(nil),                                    // 46: mealplanning.Meal
+	(*MealComponent)(nil),                           // 47: mealplanning.MealComponent
+	(*MealPlan)(nil),                                // 48: mealplanning.MealPlan
+	(*MealPlanActivity)(nil),                        // 49: mealplanning.MealPlanActivity
+	(*MealPlanCalendarFeed)(nil),                    // 50: mealplanning.MealPlanCalendarFeed
+	(*MealPlanTemplate)(nil),                        // 51: mealplanning.MealPlanTemplate
+	(*MealPlanTemplateSlot)(nil),                    // 52: mealplanning.MealPlanTemplateSlot
+	(*MealPlanEvent)(nil),                           // 53: mealplanning.MealPlanEvent
+	(*MealPlanEventTallyReport)(nil),                // 54: mealplanning.MealPlanEventTallyReport
+	(*ElectionCandidateScore)(nil),                  // 55: mealplanning.ElectionCandidateScore
+	(*ElectionPairwisePreference)(nil),              // 56: mealplanning.ElectionPairwisePreference
+	(*ElectionRound)(nil),                           // 57: mealplanning.ElectionRound
+	(*ElectionTieBreak)(nil),                        // 58: mealplanning.ElectionTieBreak
+	(*MealPlanGroceryListItem)(nil),                 // 59: mealplanning.MealPlanGroceryListItem
+	(*MealPlanOption)(nil),                          // 60: mealplanning.MealPlanOption
+	(*MealPlanOptionRecipeRevision)(nil),            // 61: mealplanning.MealPlanOptionRecipeRevision
+	(*MealPlanOptionAllergenWarning)(nil),           // 62: mealplanning.MealPlanOptionAllergenWarning
+	(*MealPlanOptionVote)(nil),                      // 63: mealplanning.MealPlanOptionVote
+	(*MealPlanOptionVoteCreationInput)(nil),         // 64: mealplanning.MealPlanOptionVoteCreationInput
+	(*MealPlanRecipeOptionSelection)(nil),           // 65: mealplanning.MealPlanRecipeOptionSelection
+	(*MissingVote)(nil),                             // 66: mealplanning.MissingVote
+	(*MealList)(nil),                                // 67: mealplanning.MealList
+	(*MealListItem)(nil),                            // 68: mealplanning.MealListItem
+	(*RecipeList)(nil),                              // 69: mealplanning.RecipeList
+	(*RecipeListItem)(nil),                          // 70: mealplanning.RecipeListItem
+	(*MealPlanTask)(nil),                            // 71: mealplanning.MealPlanTask
+	(*CookTimelineStep)(nil),                        // 72: mealplanning.CookTimelineStep
+	(*CookTimeline)(nil),                            // 73: mealplanning.CookTimeline
+	(*Nutrients)(nil),                               // 74: mealplanning.Nutrients
+	(*ValidIngredientNutritionFacts)(nil),           // 75: mealplanning.ValidIngredientNutritionFacts
+	(*NutritionMissingIngredient)(nil),              // 76: mealplanning.NutritionMissingIngredient
+	(*NutritionRollup)(nil),                         // 77: mealplanning.NutritionRollup
+	(*MealPlanEventNutritionRollup)(nil),            // 78: mealplanning.MealPlanEventNutritionRollup
+	(*MealPlanNutritionRollup)(nil),                 // 79: mealplanning.MealPlanNutritionRollup
+	(*AccountInstrumentOwnership)(nil),              // 80: mealplanning.AccountInstrumentOwnership
+	(*PantryItem)(nil),                              // 81: mealplanning.PantryItem
+	(*timestamppb.Timestamp)(nil),                   // 82: google.protobuf.Timestamp
+	(*uploaded_media.UploadedMedia)(nil),            // 83: uploaded_media.UploadedMedia
 }
 var file_mealplanning_mealplanning_messages_proto_depIdxs = []int32{
-	55,  // 0: mealplanning.DataCollection.account_instrument_ownerships:type_name -> mealplanning.AccountInstrumentOwnership
-	42,  // 1: mealplanning.DataCollection.meal_plans:type_name -> mealplanning.MealPlan
-	32,  // 2: mealplanning.DataCollection.recipe_ratings:type_name -> mealplanning.RecipeRating
-	28,  // 3: mealplanning.DataCollection.recipes:type_name -> mealplanning.Recipe
-	40,  // 4: mealplanning.DataCollection.meals:type_name -> mealplanning.Meal
-	27,  // 5: mealplanning.DataCollection.user_ingredient_preferences:type_name -> mealplanning.UserIngredientPreference
-	56,  // 6: mealplanning.ValidIngredient.created_at:type_name -> google.protobuf.Timestamp
-	56,  // 7: mealplanning.ValidIngredient.last_updated_at:type_name -> google.protobuf.Timestamp
-	56,  // 8: mealplanning.ValidIngredient.archived_at:type_name -> google.protobuf.Timestamp
-	57,  // 9: mealplanning.ValidIngredient.media:type_name -> uploaded_media.UploadedMedia
-	56,  // 10: mealplanning.ValidIngredientGroup.created_at:type_name -> google.protobuf.Timestamp
-	56,  // 11: mealplanning.ValidIngredientGroup.last_updated_at:type_name -> google.protobuf.Timestamp
-	56,  // 12: mealplanning.ValidIngredientGroup.archived_at:type_name -> google.protobuf.Timestamp
-	13,  // 13: mealplanning.ValidIngredientGroup.members:type_name -> mealplanning.ValidIngredientGroupMember
-	56,  // 14: mealplanning.ValidIngredientGroupMember.created_at:type_name -> google.protobuf.Timestamp
-	56,  // 15: mealplanning.ValidIngredientGroupMember.archived_at:type_name -> google.protobuf.Timestamp
-	11,  // 16: mealplanning.ValidIngredientGroupMember.valid_ingredient:type_name -> mealplanning.ValidIngredient
-	56,  // 17: mealplanning.ValidIngredientMeasurementUnit.created_at:type_name -> google.protobuf.Timestamp
-	56,  // 18: mealplanning.ValidIngredientMeasurementUnit.last_updated_at:type_name -> google.protobuf.Timestamp
-	56,  // 19: mealplanning.ValidIngredientMeasurementUnit.archived_at:type_name -> google.protobuf.Timestamp
-	20,  // 20: mealplanning.ValidIngredientMeasurementUnit.measurement_unit:type_name -> mealplanning.ValidMeasurementUnit
-	11,  // 21: mealplanning.ValidIngredientMeasurementUnit.ingredient:type_name -> mealplanning.ValidIngredient
-	56,  // 22: mealplanning.ValidIngredientPreparation.created_at:type_name -> google.protobuf.Timestamp
-	56,  // 23: mealplanning.ValidIngredientPreparation.last_updated_at:type_name -> google.protobuf.Timestamp
-	56,  // 24: mealplanning.ValidIngredientPreparation.archived_at:type_name -> google.protobuf.Timestamp
-	23,  // 25: mealplanning.ValidIngredientPreparation.preparation:type_name -> mealplanning.ValidPreparation
-	11,  // 26: mealplanning.ValidIngredientPreparation.ingredient:type_name -> mealplanning.ValidIngredient
-	56,  // 27: mealplanning.ValidPrepTaskConfig.created_at:type_name -> google.protobuf.Timestamp
-	56,  // 28: mealplanning.ValidPrepTaskConfig.last_updated_at:type_name -> google.protobuf.Timestamp
-	56,  // 29: mealplanning.ValidPrepTaskConfig.archived_at:type_name -> google.protobuf.Timestamp
-	23,  // 30: mealplanning.ValidPrepTaskConfig.preparation:type_name -> mealplanning.ValidPreparation
-	11,  // 31: mealplanning.ValidPrepTaskConfig.ingredient:type_name -> mealplanning.ValidIngredient
-	56,  // 32: mealplanning.ValidIngredientState.created_at:type_name -> google.protobuf.Timestamp
-	56,  // 33: mealplanning.ValidIngredientState.archived_at:type_name -> google.protobuf.Timestamp
-	56,  // 34: mealplanning.ValidIngredientState.last_updated_at:type_name -> google.protobuf.Timestamp
-	0,   // 35: mealplanning.ValidIngredientState.attribute_type:type_name -> mealplanning.ValidIngredientStateAttributeType
-	56,  // 36: mealplanning.ValidIngredientStateIngredient.created_at:type_name -> google.protobuf.Timestamp
-	56,  // 37: mealplanning.ValidIngredientStateIngredient.last_updated_at:type_name -> google.protobuf.Timestamp
-	56,  // 38: mealplanning.ValidIngredientStateIngredient.archived_at:type_name -> google.protobuf.Timestamp
-	17,  // 39: mealplanning.ValidIngredientStateIngredient.ingredient_state:type_name -> mealplanning.ValidIngredientState
-	11,  // 40: mealplanning.ValidIngredientStateIngredient.ingredient:type_name -> mealplanning.ValidIngredient
-	56,  // 41: mealplanning.ValidInstrument.created_at:type_name -> google.protobuf.Timestamp
-	56,  // 42: mealplanning.ValidInstrument.last_updated_at:type_name -> google.protobuf.Timestamp
-	56,  // 43: mealplanning.ValidInstrument.archived_at:type_name -> google.protobuf.Timestamp
-	56,  // 44: mealplanning.ValidMeasurementUnit.created_at:type_name -> google.protobuf.Timestamp
-	56,  // 45: mealplanning.ValidMeasurementUnit.last_updated_at:type_name -> google.protobuf.Timestamp
-	56,  // 46: mealplanning.ValidMeasurementUnit.archived_at:type_name -> google.protobuf.Timestamp
-	56,  // 47: mealplanning.ValidMeasurementUnitConversion.created_at:type_name -> google.protobuf.Timestamp
-	56,  // 48: mealplanning.ValidMeasurementUnitConversion.last_updated_at:type_name -> google.protobuf.Timestamp
-	56,  // 49: mealplanning.ValidMeasurementUnitConversion.archived_at:type_name -> google.protobuf.Timestamp
-	11,  // 50: mealplanning.ValidMeasurementUnitConversion.only_for_ingredient:type_name -> mealplanning.ValidIngredient
-	20,  // 51: mealplanning.ValidMeasurementUnitConversion.from:type_name -> mealplanning.ValidMeasurementUnit
-	20,  // 52: mealplanning.ValidMeasurementUnitConversion.to:type_name -> mealplanning.ValidMeasurementUnit
-	11,  // 53: mealplanning.MeasurementUnitConversionMismatch.ingredient:type_name -> mealplanning.ValidIngredient
-	20,  // 54: mealplanning.MeasurementUnitConversionMismatch.from_unit:type_name -> mealplanning.ValidMeasurementUnit
-	20,  // 55: mealplanning.MeasurementUnitConversionMismatch.to_unit:type_name -> mealplanning.ValidMeasurementUnit
-	56,  // 56: mealplanning.ValidPreparation.created_at:type_name -> google.protobuf.Timestamp
-	56,  // 57: mealplanning.ValidPreparation.archived_at:type_name -> google.protobuf.Timestamp
-	56,  // 58: mealplanning.ValidPreparation.last_updated_at:type_name -> google.protobuf.Timestamp
-	57,  // 59: mealplanning.ValidPreparation.media:type_name -> uploaded_media.UploadedMedia
-	56,  // 60: mealplanning.ValidPreparationInstrument.created_at:type_name -> google.protobuf.Timestamp
-	56,  // 61: mealplanning.ValidPreparationInstrument.last_updated_at:type_name -> google.protobuf.Timestamp
-	56,  // 62: mealplanning.ValidPreparationInstrument.archived_at:type_name -> google.protobuf.Timestamp
-	19,  // 63: mealplanning.ValidPreparationInstrument.instrument:type_name -> mealplanning.ValidInstrument
-	23,  // 64: mealplanning.ValidPreparationInstrument.preparation:type_name -> mealplanning.ValidPreparation
-	56,  // 65: mealplanning.ValidPreparationVessel.created_at:type_name -> google.protobuf.Timestamp
-	56,  // 66: mealplanning.ValidPreparationVessel.last_updated_at:type_name -> google.protobuf.Timestamp
-	56,  // 67: mealplanning.ValidPreparationVessel.archived_at:type_name -> google.protobuf.Timestamp
-	23,  // 68: mealplanning.ValidPreparationVessel.preparation:type_name -> mealplanning.ValidPreparation
-	26,  // 69: mealplanning.ValidPreparationVessel.vessel:type_name -> mealplanning.ValidVessel
-	56,  // 70: mealplanning.ValidVessel.created_at:type_name -> google.protobuf.Timestamp
-	56,  // 71: mealplanning.ValidVessel.archived_at:type_name -> google.protobuf.Timestamp
-	56,  // 72: mealplanning.ValidVessel.last_updated_at:type_name -> google.protobuf.Timestamp
-	20,  // 73: mealplanning.ValidVessel.capacity_unit:type_name -> mealplanning.ValidMeasurementUnit
-	1,   // 74: mealplanning.ValidVessel.shape:type_name -> mealplanning.ValidVesselShape
-	56,  // 75: mealplanning.UserIngredientPreference.created_at:type_name -> google.protobuf.Timestamp
-	56,  // 76: mealplanning.UserIngredientPreference.last_updated_at:type_name -> google.protobuf.Timestamp
-	56,  // 77: mealplanning.UserIngredientPreference.archived_at:type_name -> google.protobuf.Timestamp
-	11,  // 78: mealplanning.UserIngredientPreference.ingredient:type_name -> mealplanning.ValidIngredient
-	56,  // 79: mealplanning.Recipe.created_at:type_name -> google.protobuf.Timestamp
-	56,  // 80: mealplanning.Recipe.last_updated_at:type_name -> google.protobuf.Timestamp
-	56,  // 81: mealplanning.Recipe.archived_at:type_name -> google.protobuf.Timestamp
-	3,   // 82: mealplanning.Recipe.yields_component_type:type_name -> mealplanning.MealComponentType
-	30,  // 83: mealplanning.Recipe.prep_tasks:type_name -> mealplanning.RecipePrepTask
-	33,  // 84: mealplanning.Recipe.steps:type_name -> mealplanning.RecipeStep
-	29,  // 85: mealplanning.Recipe.media:type_name -> mealplanning.RecipeMedia
-	28,  // 86: mealplanning.Recipe.associated_recipes:type_name -> mealplanning.Recipe
-	56,  // 87: mealplanning.RecipeMedia.created_at:type_name -> google.protobuf.Timestamp
-	56,  // 88: mealplanning.RecipeMedia.archived_at:type_name -> google.protobuf.Timestamp
-	56,  // 89: mealplanning.RecipeMedia.last_updated_at:type_name -> google.protobuf.Timestamp
-	56,  // 90: mealplanning.RecipePrepTask.created_at:type_name -> google.protobuf.Timestamp
-	56,  // 91: mealplanning.RecipePrepTask.archived_at:type_name -> google.protobuf.Timestamp
-	56,  // 92: mealplanning.RecipePrepTask.last_updated_at:type_name -> google.protobuf.Timestamp
-	31,  // 93: mealplanning.RecipePrepTask.task_steps:type_name -> mealplanning.RecipePrepTaskStep
-	56,  // 94: mealplanning.RecipeRating.created_at:type_name -> google.protobuf.Timestamp
-	56,  // 95: mealplanning.RecipeRating.last_updated_at:type_name -> google.protobuf.Timestamp
-	56,  // 96: mealplanning.RecipeRating.archived_at:type_name -> google.protobuf.Timestamp
-	56,  // 97: mealplanning.RecipeStep.created_at:type_name -> google.protobuf.Timestamp
-	56,  // 98: mealplanning.RecipeStep.archived_at:type_name -> google.protobuf.Timestamp
-	56,  // 99: mealplanning.RecipeStep.last_updated_at:type_name -> google.protobuf.Timestamp
-	29,  // 100: mealplanning.RecipeStep.media:type_name -> mealplanning.RecipeMedia
-	38,  // 101: mealplanning.RecipeStep.products:type_name -> mealplanning.RecipeStepProduct
-	37,  // 102: mealplanning.RecipeStep.instruments:type_name -> mealplanning.RecipeStepInstrument
-	39,  // 103: mealplanning.RecipeStep.vessels:type_name -> mealplanning.RecipeStepVessel
-	34,  // 104: mealplanning.RecipeStep.completion_conditions:type_name -> mealplanning.RecipeStepCompletionCondition
-	36,  // 105: mealplanning.RecipeStep.ingredients:type_name -> mealplanning.RecipeStepIngredient
-	23,  // 106: mealplanning.RecipeStep.preparation:type_name -> mealplanning.ValidPreparation
-	57,  // 107: mealplanning.RecipeStep.step_images:type_name -> uploaded_media.UploadedMedia
-	56,  // 108: mealplanning.RecipeStepCompletionCondition.created_at:type_name -> google.protobuf.Timestamp
-	56,  // 109: mealplanning.RecipeStepCompletionCondition.archived_at:type_name -> google.protobuf.Timestamp
-	56,  // 110: mealplanning.RecipeStepCompletionCondition.last_updated_at:type_name -> google.protobuf.Timestamp
-	17,  // 111: mealplanning.RecipeStepCompletionCondition.ingredient_state:type_name -> mealplanning.ValidIngredientState
-	35,  // 112: mealplanning.RecipeStepCompletionCondition.ingredients:type_name -> mealplanning.RecipeStepCompletionConditionIngredient
-	56,  // 113: mealplanning.RecipeStepCompletionConditionIngredient.created_at:type_name -> google.protobuf.Timestamp
-	56,  // 114: mealplanning.RecipeStepCompletionConditionIngredient.archived_at:type_name -> google.protobuf.Timestamp
-	56,  // 115: mealplanning.RecipeStepCompletionConditionIngredient.last_updated_at:type_name -> google.protobuf.Timestamp
-	56,  // 116: mealplanning.RecipeStepIngredient.created_at:type_name -> google.protobuf.Timestamp
-	56,  // 117: mealplanning.RecipeStepIngredient.archived_at:type_name -> google.protobuf.Timestamp
-	11,  // 118: mealplanning.RecipeStepIngredient.ingredient:type_name -> mealplanning.ValidIngredient
-	56,  // 119: mealplanning.RecipeStepIngredient.last_updated_at:type_name -> google.protobuf.Timestamp
-	20,  // 120: mealplanning.RecipeStepIngredient.measurement_unit:type_name -> mealplanning.ValidMeasurementUnit
-	56,  // 121: mealplanning.RecipeStepInstrument.created_at:type_name -> google.protobuf.Timestamp
-	19,  // 122: mealplanning.RecipeStepInstrument.instrument:type_name -> mealplanning.ValidInstrument
-	56,  // 123: mealplanning.RecipeStepInstrument.last_updated_at:type_name -> google.protobuf.Timestamp
-	56,  // 124: mealplanning.RecipeStepInstrument.archived_at:type_name -> google.protobuf.Timestamp
-	56,  // 125: mealplanning.RecipeStepProduct.created_at:type_name -> google.protobuf.Timestamp
-	56,  // 126: mealplanning.RecipeStepProduct.archived_at:type_name -> google.protobuf.Timestamp
-	56,  // 127: mealplanning.RecipeStepProduct.last_updated_at:type_name -> google.protobuf.Timestamp
-	20,  // 128: mealplanning.RecipeStepProduct.measurement_unit:type_name -> mealplanning.ValidMeasurementUnit
-	2,   // 129: mealplanning.RecipeStepProduct.type:type_name -> mealplanning.RecipeStepProductType
-	56,  // 130: mealplanning.RecipeStepVessel.created_at:type_name -> google.protobuf.Timestamp
-	56,  // 131: mealplanning.RecipeStepVessel.last_updated_at:type_name -> google.protobuf.Timestamp
-	56,  // 132: mealplanning.RecipeStepVessel.archived_at:type_name -> google.protobuf.Timestamp
-	26,  // 133: mealplanning.RecipeStepVessel.vessel:type_name -> mealplanning.ValidVessel
-	56,  // 134: mealplanning.Meal.created_at:type_name -> google.protobuf.Timestamp
-	56,  // 135: mealplanning.Meal.archived_at:type_name -> google.protobuf.Timestamp
-	56,  // 136: mealplanning.Meal.last_updated_at:type_name -> google.protobuf.Timestamp
-	41,  // 137: mealplanning.Meal.components:type_name -> mealplanning.MealComponent
-	3,   // 138: mealplanning.MealComponent.component_type:type_name -> mealplanning.MealComponentType
-	28,  // 139: mealplanning.MealComponent.recipe:type_name -> mealplanning.Recipe
-	56,  // 140: mealplanning.MealPlan.created_at:type_name -> google.protobuf.Timestamp
-	56,  // 141: mealplanning.MealPlan.voting_deadline:type_name -> google.protobuf.Timestamp
-	56,  // 142: mealplanning.MealPlan.archived_at:type_name -> google.protobuf.Timestamp
-	56,  // 143: mealplanning.MealPlan.last_updated_at:type_name -> google.protobuf.Timestamp
-	5,   // 144: mealplanning.MealPlan.status:type_name -> mealplanning.MealPlanStatus
-	4,   // 145: mealplanning.MealPlan.election_method:type_name -> mealplanning.MealPlanElectionMethod
-	43,  // 146: mealplanning.MealPlan.events:type_name -> mealplanning.MealPlanEvent
-	48,  // 147: mealplanning.MealPlan.selections:type_name -> mealplanning.MealPlanRecipeOptionSelection
-	56,  // 148: mealplanning.MealPlanEvent.created_at:type_name -> google.protobuf.Timestamp
-	56,  // 149: mealplanning.MealPlanEvent.starts_at:type_name -> google.protobuf.Timestamp
-	56,  // 150: mealplanning.MealPlanEvent.ends_at:type_name -> google.protobuf.Timestamp
-	56,  // 151: mealplanning.MealPlanEvent.archived_at:type_name -> google.protobuf.Timestamp
-	56,  // 152: mealplanning.MealPlanEvent.last_updated_at:type_name -> google.protobuf.Timestamp
-	6,   // 153: mealplanning.MealPlanEvent.meal_name:type_name -> mealplanning.MealPlanEventName
-	45,  // 154: mealplanning.MealPlanEvent.options:type_name -> mealplanning.MealPlanOption
-	56,  // 155: mealplanning.MealPlanGroceryListItem.created_at:type_name -> google.protobuf.Timestamp
-	56,  // 156: mealplanning.MealPlanGroceryListItem.archived_at:type_name -> google.protobuf.Timestamp
-	56,  // 157: mealplanning.MealPlanGroceryListItem.last_updated_at:type_name -> google.protobuf.Timestamp
-	20,  // 158: mealplanning.MealPlanGroceryListItem.purchased_measurement_unit:type_name -> mealplanning.ValidMeasurementUnit
-	7,   // 159: mealplanning.MealPlanGroceryListItem.status:type_name -> mealplanning.MealPlanGroceryListItemStatus
-	20,  // 160: mealplanning.MealPlanGroceryListItem.measurement_unit:type_name -> mealplanning.ValidMeasurementUnit
-	11,  // 161: mealplanning.MealPlanGroceryListItem.ingredient:type_name -> mealplanning.ValidIngredient
-	56,  // 162: mealplanning.MealPlanOption.created_at:type_name -> google.protobuf.Timestamp
-	56,  // 163: mealplanning.MealPlanOption.last_updated_at:type_name -> google.protobuf.Timestamp
-	56,  // 164: mealplanning.MealPlanOption.archived_at:type_name -> google.protobuf.Timestamp
-	46,  // 165: mealplanning.MealPlanOption.votes:type_name -> mealplanning.MealPlanOptionVote
-	40,  // 166: mealplanning.MealPlanOption.meal:type_name -> mealplanning.Meal
-	56,  // 167: mealplanning.MealPlanOptionVote.created_at:type_name -> google.protobuf.Timestamp
-	56,  // 168: mealplanning.MealPlanOptionVote.archived_at:type_name -> google.protobuf.Timestamp
-	56,  // 169: mealplanning.MealPlanOptionVote.last_updated_at:type_name -> google.protobuf.Timestamp
-	56,  // 170: mealplanning.MealPlanRecipeOptionSelection.created_at:type_name -> google.protobuf.Timestamp
-	56,  // 171: mealplanning.MealPlanRecipeOptionSelection.last_updated_at:type_name -> google.protobuf.Timestamp
-	9,   // 172: mealplanning.MealPlanRecipeOptionSelection.selection_type:type_name -> mealplanning.MealPlanRecipeOptionSelectionType
-	56,  // 173: mealplanning.MealPlanRecipeOptionSelection.archived_at:type_name -> google.protobuf.Timestamp
-	56,  // 174: mealplanning.MealList.created_at:type_name -> google.protobuf.Timestamp
-	56,  // 175: mealplanning.MealList.last_updated_at:type_name -> google.protobuf.Timestamp
-	56,  // 176: mealplanning.MealList.archived_at:type_name -> google.protobuf.Timestamp
-	51,  // 177: mealplanning.MealList.items:type_name -> mealplanning.MealListItem
-	56,  // 178: mealplanning.MealListItem.created_at:type_name -> google.protobuf.Timestamp
-	56,  // 179: mealplanning.MealListItem.archived_at:type_name -> google.protobuf.Timestamp
-	56,  // 180: mealplanning.MealListItem.last_updated_at:type_name -> google.protobuf.Timestamp
-	40,  // 181: mealplanning.MealListItem.meal:type_name -> mealplanning.Meal
-	56,  // 182: mealplanning.RecipeList.created_at:type_name -> google.protobuf.Timestamp
-	56,  // 183: mealplanning.RecipeList.last_updated_at:type_name -> google.protobuf.Timestamp
-	56,  // 184: mealplanning.RecipeList.archived_at:type_name -> google.protobuf.Timestamp
-	53,  // 185: mealplanning.RecipeList.items:type_name -> mealplanning.RecipeListItem
-	56,  // 186: mealplanning.RecipeListItem.created_at:type_name -> google.protobuf.Timestamp
-	56,  // 187: mealplanning.RecipeListItem.archived_at:type_name -> google.protobuf.Timestamp
-	56,  // 188: mealplanning.RecipeListItem.last_updated_at:type_name -> google.protobuf.Timestamp
-	28,  // 189: mealplanning.RecipeListItem.recipe:type_name -> mealplanning.Recipe
-	30,  // 190: mealplanning.MealPlanTask.recipe_prep_task:type_name -> mealplanning.RecipePrepTask
-	56,  // 191: mealplanning.MealPlanTask.created_at:type_name -> google.protobuf.Timestamp
-	56,  // 192: mealplanning.MealPlanTask.last_updated_at:type_name -> google.protobuf.Timestamp
-	56,  // 193: mealplanning.MealPlanTask.completed_at:type_name -> google.protobuf.Timestamp
-	8,   // 194: mealplanning.MealPlanTask.status:type_name -> mealplanning.MealPlanTaskStatus
-	45,  // 195: mealplanning.MealPlanTask.meal_plan_option:type_name -> mealplanning.MealPlanOption
-	56,  // 196: mealplanning.AccountInstrumentOwnership.created_at:type_name -> google.protobuf.Timestamp
-	56,  // 197: mealplanning.AccountInstrumentOwnership.archived_at:type_name -> google.protobuf.Timestamp
-	56,  // 198: mealplanning.AccountInstrumentOwnership.last_updated_at:type_name -> google.protobuf.Timestamp
-	19,  // 199: mealplanning.AccountInstrumentOwnership.instrument:type_name -> mealplanning.ValidInstrument
-	200, // [200:200] is the sub-list for method output_type
-	200, // [200:200] is the sub-list for method input_type
-	200, // [200:200] is the sub-list for extension type_name
-	200, // [200:200] is the sub-list for extension extendee
-	0,   // [0:200] is the sub-list for field type_name
+	80,  // 0: mealplanning.DataCollection.account_instrument_ownerships:type_name -> mealplanning.AccountInstrumentOwnership
+	48,  // 1: mealplanning.DataCollection.meal_plans:type_name -> mealplanning.MealPlan
+	33,  // 2: mealplanning.DataCollection.recipe_ratings:type_name -> mealplanning.RecipeRating
+	29,  // 3: mealplanning.DataCollection.recipes:type_name -> mealplanning.Recipe
+	46,  // 4: mealplanning.DataCollection.meals:type_name -> mealplanning.Meal
+	28,  // 5: mealplanning.DataCollection.user_ingredient_preferences:type_name -> mealplanning.UserIngredientPreference
+	81,  // 6: mealplanning.DataCollection.pantry_items:type_name -> mealplanning.PantryItem
+	82,  // 7: mealplanning.ValidIngredient.created_at:type_name -> google.protobuf.Timestamp
+	82,  // 8: mealplanning.ValidIngredient.last_updated_at:type_name -> google.protobuf.Timestamp
+	82,  // 9: mealplanning.ValidIngredient.archived_at:type_name -> google.protobuf.Timestamp
+	83,  // 10: mealplanning.ValidIngredient.media:type_name -> uploaded_media.UploadedMedia
+	82,  // 11: mealplanning.ValidIngredientGroup.created_at:type_name -> google.protobuf.Timestamp
+	82,  // 12: mealplanning.ValidIngredientGroup.last_updated_at:type_name -> google.protobuf.Timestamp
+	82,  // 13: mealplanning.ValidIngredientGroup.archived_at:type_name -> google.protobuf.Timestamp
+	14,  // 14: mealplanning.ValidIngredientGroup.members:type_name -> mealplanning.ValidIngredientGroupMember
+	82,  // 15: mealplanning.ValidIngredientGroupMember.created_at:type_name -> google.protobuf.Timestamp
+	82,  // 16: mealplanning.ValidIngredientGroupMember.archived_at:type_name -> google.protobuf.Timestamp
+	12,  // 17: mealplanning.ValidIngredientGroupMember.valid_ingredient:type_name -> mealplanning.ValidIngredient
+	82,  // 18: mealplanning.ValidIngredientMeasurementUnit.created_at:type_name -> google.protobuf.Timestamp
+	82,  // 19: mealplanning.ValidIngredientMeasurementUnit.last_updated_at:type_name -> google.protobuf.Timestamp
+	82,  // 20: mealplanning.ValidIngredientMeasurementUnit.archived_at:type_name -> google.protobuf.Timestamp
+	21,  // 21: mealplanning.ValidIngredientMeasurementUnit.measurement_unit:type_name -> mealplanning.ValidMeasurementUnit
+	12,  // 22: mealplanning.ValidIngredientMeasurementUnit.ingredient:type_name -> mealplanning.ValidIngredient
+	82,  // 23: mealplanning.ValidIngredientPreparation.created_at:type_name -> google.protobuf.Timestamp
+	82,  // 24: mealplanning.ValidIngredientPreparation.last_updated_at:type_name -> google.protobuf.Timestamp
+	82,  // 25: mealplanning.ValidIngredientPreparation.archived_at:type_name -> google.protobuf.Timestamp
+	24,  // 26: mealplanning.ValidIngredientPreparation.preparation:type_name -> mealplanning.ValidPreparation
+	12,  // 27: mealplanning.ValidIngredientPreparation.ingredient:type_name -> mealplanning.ValidIngredient
+	82,  // 28: mealplanning.ValidPrepTaskConfig.created_at:type_name -> google.protobuf.Timestamp
+	82,  // 29: mealplanning.ValidPrepTaskConfig.last_updated_at:type_name -> google.protobuf.Timestamp
+	82,  // 30: mealplanning.ValidPrepTaskConfig.archived_at:type_name -> google.protobuf.Timestamp
+	24,  // 31: mealplanning.ValidPrepTaskConfig.preparation:type_name -> mealplanning.ValidPreparation
+	12,  // 32: mealplanning.ValidPrepTaskConfig.ingredient:type_name -> mealplanning.ValidIngredient
+	82,  // 33: mealplanning.ValidIngredientState.created_at:type_name -> google.protobuf.Timestamp
+	82,  // 34: mealplanning.ValidIngredientState.archived_at:type_name -> google.protobuf.Timestamp
+	82,  // 35: mealplanning.ValidIngredientState.last_updated_at:type_name -> google.protobuf.Timestamp
+	0,   // 36: mealplanning.ValidIngredientState.attribute_type:type_name -> mealplanning.ValidIngredientStateAttributeType
+	82,  // 37: mealplanning.ValidIngredientStateIngredient.created_at:type_name -> google.protobuf.Timestamp
+	82,  // 38: mealplanning.ValidIngredientStateIngredient.last_updated_at:type_name -> google.protobuf.Timestamp
+	82,  // 39: mealplanning.ValidIngredientStateIngredient.archived_at:type_name -> google.protobuf.Timestamp
+	18,  // 40: mealplanning.ValidIngredientStateIngredient.ingredient_state:type_name -> mealplanning.ValidIngredientState
+	12,  // 41: mealplanning.ValidIngredientStateIngredient.ingredient:type_name -> mealplanning.ValidIngredient
+	82,  // 42: mealplanning.ValidInstrument.created_at:type_name -> google.protobuf.Timestamp
+	82,  // 43: mealplanning.ValidInstrument.last_updated_at:type_name -> google.protobuf.Timestamp
+	82,  // 44: mealplanning.ValidInstrument.archived_at:type_name -> google.protobuf.Timestamp
+	82,  // 45: mealplanning.ValidMeasurementUnit.created_at:type_name -> google.protobuf.Timestamp
+	82,  // 46: mealplanning.ValidMeasurementUnit.last_updated_at:type_name -> google.protobuf.Timestamp
+	82,  // 47: mealplanning.ValidMeasurementUnit.archived_at:type_name -> google.protobuf.Timestamp
+	82,  // 48: mealplanning.ValidMeasurementUnitConversion.created_at:type_name -> google.protobuf.Timestamp
+	82,  // 49: mealplanning.ValidMeasurementUnitConversion.last_updated_at:type_name -> google.protobuf.Timestamp
+	82,  // 50: mealplanning.ValidMeasurementUnitConversion.archived_at:type_name -> google.protobuf.Timestamp
+	12,  // 51: mealplanning.ValidMeasurementUnitConversion.only_for_ingredient:type_name -> mealplanning.ValidIngredient
+	21,  // 52: mealplanning.ValidMeasurementUnitConversion.from:type_name -> mealplanning.ValidMeasurementUnit
+	21,  // 53: mealplanning.ValidMeasurementUnitConversion.to:type_name -> mealplanning.ValidMeasurementUnit
+	12,  // 54: mealplanning.MeasurementUnitConversionMismatch.ingredient:type_name -> mealplanning.ValidIngredient
+	21,  // 55: mealplanning.MeasurementUnitConversionMismatch.from_unit:type_name -> mealplanning.ValidMeasurementUnit
+	21,  // 56: mealplanning.MeasurementUnitConversionMismatch.to_unit:type_name -> mealplanning.ValidMeasurementUnit
+	82,  // 57: mealplanning.ValidPreparation.created_at:type_name -> google.protobuf.Timestamp
+	82,  // 58: mealplanning.ValidPreparation.archived_at:type_name -> google.protobuf.Timestamp
+	82,  // 59: mealplanning.ValidPreparation.last_updated_at:type_name -> google.protobuf.Timestamp
+	83,  // 60: mealplanning.ValidPreparation.media:type_name -> uploaded_media.UploadedMedia
+	82,  // 61: mealplanning.ValidPreparationInstrument.created_at:type_name -> google.protobuf.Timestamp
+	82,  // 62: mealplanning.ValidPreparationInstrument.last_updated_at:type_name -> google.protobuf.Timestamp
+	82,  // 63: mealplanning.ValidPreparationInstrument.archived_at:type_name -> google.protobuf.Timestamp
+	20,  // 64: mealplanning.ValidPreparationInstrument.instrument:type_name -> mealplanning.ValidInstrument
+	24,  // 65: mealplanning.ValidPreparationInstrument.preparation:type_name -> mealplanning.ValidPreparation
+	82,  // 66: mealplanning.ValidPreparationVessel.created_at:type_name -> google.protobuf.Timestamp
+	82,  // 67: mealplanning.ValidPreparationVessel.last_updated_at:type_name -> google.protobuf.Timestamp
+	82,  // 68: mealplanning.ValidPreparationVessel.archived_at:type_name -> google.protobuf.Timestamp
+	24,  // 69: mealplanning.ValidPreparationVessel.preparation:type_name -> mealplanning.ValidPreparation
+	27,  // 70: mealplanning.ValidPreparationVessel.vessel:type_name -> mealplanning.ValidVessel
+	82,  // 71: mealplanning.ValidVessel.created_at:type_name -> google.protobuf.Timestamp
+	82,  // 72: mealplanning.ValidVessel.archived_at:type_name -> google.protobuf.Timestamp
+	82,  // 73: mealplanning.ValidVessel.last_updated_at:type_name -> google.protobuf.Timestamp
+	21,  // 74: mealplanning.ValidVessel.capacity_unit:type_name -> mealplanning.ValidMeasurementUnit
+	1,   // 75: mealplanning.ValidVessel.shape:type_name -> mealplanning.ValidVesselShape
+	82,  // 76: mealplanning.UserIngredientPreference.created_at:type_name -> google.protobuf.Timestamp
+	82,  // 77: mealplanning.UserIngredientPreference.last_updated_at:type_name -> google.protobuf.Timestamp
+	82,  // 78: mealplanning.UserIngredientPreference.archived_at:type_name -> google.protobuf.Timestamp
+	12,  // 79: mealplanning.UserIngredientPreference.ingredient:type_name -> mealplanning.ValidIngredient
+	82,  // 80: mealplanning.Recipe.created_at:type_name -> google.protobuf.Timestamp
+	82,  // 81: mealplanning.Recipe.last_updated_at:type_name -> google.protobuf.Timestamp
+	82,  // 82: mealplanning.Recipe.archived_at:type_name -> google.protobuf.Timestamp
+	3,   // 83: mealplanning.Recipe.yields_component_type:type_name -> mealplanning.MealComponentType
+	31,  // 84: mealplanning.Recipe.prep_tasks:type_name -> mealplanning.RecipePrepTask
+	39,  // 85: mealplanning.Recipe.steps:type_name -> mealplanning.RecipeStep
+	30,  // 86: mealplanning.Recipe.media:type_name -> mealplanning.RecipeMedia
+	29,  // 87: mealplanning.Recipe.associated_recipes:type_name -> mealplanning.Recipe
+	82,  // 88: mealplanning.RecipeMedia.created_at:type_name -> google.protobuf.Timestamp
+	82,  // 89: mealplanning.RecipeMedia.archived_at:type_name -> google.protobuf.Timestamp
+	82,  // 90: mealplanning.RecipeMedia.last_updated_at:type_name -> google.protobuf.Timestamp
+	82,  // 91: mealplanning.RecipePrepTask.created_at:type_name -> google.protobuf.Timestamp
+	82,  // 92: mealplanning.RecipePrepTask.archived_at:type_name -> google.protobuf.Timestamp
+	82,  // 93: mealplanning.RecipePrepTask.last_updated_at:type_name -> google.protobuf.Timestamp
+	32,  // 94: mealplanning.RecipePrepTask.task_steps:type_name -> mealplanning.RecipePrepTaskStep
+	82,  // 95: mealplanning.RecipeRating.created_at:type_name -> google.protobuf.Timestamp
+	82,  // 96: mealplanning.RecipeRating.last_updated_at:type_name -> google.protobuf.Timestamp
+	82,  // 97: mealplanning.RecipeRating.archived_at:type_name -> google.protobuf.Timestamp
+	82,  // 98: mealplanning.RecipeRevision.created_at:type_name -> google.protobuf.Timestamp
+	29,  // 99: mealplanning.RecipeRevision.snapshot:type_name -> mealplanning.Recipe
+	35,  // 100: mealplanning.RecipeComponentDiff.changes:type_name -> mealplanning.RecipeFieldChange
+	35,  // 101: mealplanning.RecipeStepDiff.changes:type_name -> mealplanning.RecipeFieldChange
+	36,  // 102: mealplanning.RecipeStepDiff.ingredients:type_name -> mealplanning.RecipeComponentDiff
+	36,  // 103: mealplanning.RecipeStepDiff.instruments:type_name -> mealplanning.RecipeComponentDiff
+	36,  // 104: mealplanning.RecipeStepDiff.vessels:type_name -> mealplanning.RecipeComponentDiff
+	36,  // 105: mealplanning.RecipeStepDiff.products:type_name -> mealplanning.RecipeComponentDiff
+	35,  // 106: mealplanning.RecipeRevisionDiff.changes:type_name -> mealplanning.RecipeFieldChange
+	37,  // 107: mealplanning.RecipeRevisionDiff.steps:type_name -> mealplanning.RecipeStepDiff
+	82,  // 108: mealplanning.RecipeStep.created_at:type_name -> google.protobuf.Timestamp
+	82,  // 109: mealplanning.RecipeStep.archived_at:type_name -> google.protobuf.Timestamp
+	82,  // 110: mealplanning.RecipeStep.last_updated_at:type_name -> google.protobuf.Timestamp
+	30,  // 111: mealplanning.RecipeStep.media:type_name -> mealplanning.RecipeMedia
+	44,  // 112: mealplanning.RecipeStep.products:type_name -> mealplanning.RecipeStepProduct
+	43,  // 113: mealplanning.RecipeStep.instruments:type_name -> mealplanning.RecipeStepInstrument
+	45,  // 114: mealplanning.RecipeStep.vessels:type_name -> mealplanning.RecipeStepVessel
+	40,  // 115: mealplanning.RecipeStep.completion_conditions:type_name -> mealplanning.RecipeStepCompletionCondition
+	42,  // 116: mealplanning.RecipeStep.ingredients:type_name -> mealplanning.RecipeStepIngredient
+	24,  // 117: mealplanning.RecipeStep.preparation:type_name -> mealplanning.ValidPreparation
+	83,  // 118: mealplanning.RecipeStep.step_images:type_name -> uploaded_media.UploadedMedia
+	82,  // 119: mealplanning.RecipeStepCompletionCondition.created_at:type_name -> google.protobuf.Timestamp
+	82,  // 120: mealplanning.RecipeStepCompletionCondition.archived_at:type_name -> google.protobuf.Timestamp
+	82,  // 121: mealplanning.RecipeStepCompletionCondition.last_updated_at:type_name -> google.protobuf.Timestamp
+	18,  // 122: mealplanning.RecipeStepCompletionCondition.ingredient_state:type_name -> mealplanning.ValidIngredientState
+	41,  // 123: mealplanning.RecipeStepCompletionCondition.ingredients:type_name -> mealplanning.RecipeStepCompletionConditionIngredient
+	82,  // 124: mealplanning.RecipeStepCompletionConditionIngredient.created_at:type_name -> google.protobuf.Timestamp
+	82,  // 125: mealplanning.RecipeStepCompletionConditionIngredient.archived_at:type_name -> google.protobuf.Timestamp
+	82,  // 126: mealplanning.RecipeStepCompletionConditionIngredient.last_updated_at:type_name -> google.protobuf.Timestamp
+	82,  // 127: mealplanning.RecipeStepIngredient.created_at:type_name -> google.protobuf.Timestamp
+	82,  // 128: mealplanning.RecipeStepIngredient.archived_at:type_name -> google.protobuf.Timestamp
+	12,  // 129: mealplanning.RecipeStepIngredient.ingredient:type_name -> mealplanning.ValidIngredient
+	82,  // 130: mealplanning.RecipeStepIngredient.last_updated_at:type_name -> google.protobuf.Timestamp
+	21,  // 131: mealplanning.RecipeStepIngredient.measurement_unit:type_name -> mealplanning.ValidMeasurementUnit
+	82,  // 132: mealplanning.RecipeStepInstrument.created_at:type_name -> google.protobuf.Timestamp
+	20,  // 133: mealplanning.RecipeStepInstrument.instrument:type_name -> mealplanning.ValidInstrument
+	82,  // 134: mealplanning.RecipeStepInstrument.last_updated_at:type_name -> google.protobuf.Timestamp
+	82,  // 135: mealplanning.RecipeStepInstrument.archived_at:type_name -> google.protobuf.Timestamp
+	82,  // 136: mealplanning.RecipeStepProduct.created_at:type_name -> google.protobuf.Timestamp
+	82,  // 137: mealplanning.RecipeStepProduct.archived_at:type_name -> google.protobuf.Timestamp
+	82,  // 138: mealplanning.RecipeStepProduct.last_updated_at:type_name -> google.protobuf.Timestamp
+	21,  // 139: mealplanning.RecipeStepProduct.measurement_unit:type_name -> mealplanning.ValidMeasurementUnit
+	2,   // 140: mealplanning.RecipeStepProduct.type:type_name -> mealplanning.RecipeStepProductType
+	82,  // 141: mealplanning.RecipeStepVessel.created_at:type_name -> google.protobuf.Timestamp
+	82,  // 142: mealplanning.RecipeStepVessel.last_updated_at:type_name -> google.protobuf.Timestamp
+	82,  // 143: mealplanning.RecipeStepVessel.archived_at:type_name -> google.protobuf.Timestamp
+	27,  // 144: mealplanning.RecipeStepVessel.vessel:type_name -> mealplanning.ValidVessel
+	82,  // 145: mealplanning.Meal.created_at:type_name -> google.protobuf.Timestamp
+	82,  // 146: mealplanning.Meal.archived_at:type_name -> google.protobuf.Timestamp
+	82,  // 147: mealplanning.Meal.last_updated_at:type_name -> google.protobuf.Timestamp
+	47,  // 148: mealplanning.Meal.components:type_name -> mealplanning.MealComponent
+	3,   // 149: mealplanning.MealComponent.component_type:type_name -> mealplanning.MealComponentType
+	29,  // 150: mealplanning.MealComponent.recipe:type_name -> mealplanning.Recipe
+	82,  // 151: mealplanning.MealPlan.created_at:type_name -> google.protobuf.Timestamp
+	82,  // 152: mealplanning.MealPlan.voting_deadline:type_name -> google.protobuf.Timestamp
+	82,  // 153: mealplanning.MealPlan.archived_at:type_name -> google.protobuf.Timestamp
+	82,  // 154: mealplanning.MealPlan.last_updated_at:type_name -> google.protobuf.Timestamp
+	5,   // 155: mealplanning.MealPlan.status:type_name -> mealplanning.MealPlanStatus
+	4,   // 156: mealplanning.MealPlan.election_method:type_name -> mealplanning.MealPlanElectionMethod
+	53,  // 157: mealplanning.MealPlan.events:type_name -> mealplanning.MealPlanEvent
+	65,  // 158: mealplanning.MealPlan.selections:type_name -> mealplanning.MealPlanRecipeOptionSelection
+	82,  // 159: mealplanning.MealPlanActivity.created_at:type_name -> google.protobuf.Timestamp
+	82,  // 160: mealplanning.MealPlanCalendarFeed.created_at:type_name -> google.protobuf.Timestamp
+	82,  // 161: mealplanning.MealPlanTemplate.created_at:type_name -> google.protobuf.Timestamp
+	82,  // 162: mealplanning.MealPlanTemplate.next_instantiation_at:type_name -> google.protobuf.Timestamp
+	82,  // 163: mealplanning.MealPlanTemplate.last_instantiated_at:type_name -> google.protobuf.Timestamp
+	82,  // 164: mealplanning.MealPlanTemplate.last_updated_at:type_name -> google.protobuf.Timestamp
+	82,  // 165: mealplanning.MealPlanTemplate.archived_at:type_name -> google.protobuf.Timestamp
+	4,   // 166: mealplanning.MealPlanTemplate.election_method:type_name -> mealplanning.MealPlanElectionMethod
+	52,  // 167: mealplanning.MealPlanTemplate.slots:type_name -> mealplanning.MealPlanTemplateSlot
+	6,   // 168: mealplanning.MealPlanTemplateSlot.meal_name:type_name -> mealplanning.MealPlanEventName
+	82,  // 169: mealplanning.MealPlanEvent.created_at:type_name -> google.protobuf.Timestamp
+	82,  // 170: mealplanning.MealPlanEvent.starts_at:type_name -> google.protobuf.Timestamp
+	82,  // 171: mealplanning.MealPlanEvent.ends_at:type_name -> google.protobuf.Timestamp
+	82,  // 172: mealplanning.MealPlanEvent.archived_at:type_name -> google.protobuf.Timestamp
+	82,  // 173: mealplanning.MealPlanEvent.last_updated_at:type_name -> google.protobuf.Timestamp
+	6,   // 174: mealplanning.MealPlanEvent.meal_name:type_name -> mealplanning.MealPlanEventName
+	60,  // 175: mealplanning.MealPlanEvent.options:type_name -> mealplanning.MealPlanOption
+	82,  // 176: mealplanning.MealPlanEventTallyReport.created_at:type_name -> google.protobuf.Timestamp
+	82,  // 177: mealplanning.MealPlanEventTallyReport.archived_at:type_name -> google.protobuf.Timestamp
+	58,  // 178: mealplanning.MealPlanEventTallyReport.tie_break:type_name -> mealplanning.ElectionTieBreak
+	4,   // 179: mealplanning.MealPlanEventTallyReport.election_method:type_name -> mealplanning.MealPlanElectionMethod
+	55,  // 180: mealplanning.MealPlanEventTallyReport.scores:type_name -> mealplanning.ElectionCandidateScore
+	56,  // 181: mealplanning.MealPlanEventTallyReport.pairwise_preferences:type_name -> mealplanning.ElectionPairwisePreference
+	57,  // 182: mealplanning.MealPlanEventTallyReport.rounds:type_name -> mealplanning.ElectionRound
+	55,  // 183: mealplanning.ElectionRound.counts:type_name -> mealplanning.ElectionCandidateScore
+	82,  // 184: mealplanning.MealPlanGroceryListItem.created_at:type_name -> google.protobuf.Timestamp
+	82,  // 185: mealplanning.MealPlanGroceryListItem.archived_at:type_name -> google.protobuf.Timestamp
+	82,  // 186: mealplanning.MealPlanGroceryListItem.last_updated_at:type_name -> google.protobuf.Timestamp
+	21,  // 187: mealplanning.MealPlanGroceryListItem.purchased_measurement_unit:type_name -> mealplanning.ValidMeasurementUnit
+	7,   // 188: mealplanning.MealPlanGroceryListItem.status:type_name -> mealplanning.MealPlanGroceryListItemStatus
+	21,  // 189: mealplanning.MealPlanGroceryListItem.measurement_unit:type_name -> mealplanning.ValidMeasurementUnit
+	12,  // 190: mealplanning.MealPlanGroceryListItem.ingredient:type_name -> mealplanning.ValidIngredient
+	82,  // 191: mealplanning.MealPlanOption.created_at:type_name -> google.protobuf.Timestamp
+	82,  // 192: mealplanning.MealPlanOption.last_updated_at:type_name -> google.protobuf.Timestamp
+	82,  // 193: mealplanning.MealPlanOption.archived_at:type_name -> google.protobuf.Timestamp
+	63,  // 194: mealplanning.MealPlanOption.votes:type_name -> mealplanning.MealPlanOptionVote
+	46,  // 195: mealplanning.MealPlanOption.meal:type_name -> mealplanning.Meal
+	62,  // 196: mealplanning.MealPlanOption.allergen_warnings:type_name -> mealplanning.MealPlanOptionAllergenWarning
+	82,  // 197: mealplanning.MealPlanOption.completed_at:type_name -> google.protobuf.Timestamp
+	61,  // 198: mealplanning.MealPlanOption.recipe_revisions:type_name -> mealplanning.MealPlanOptionRecipeRevision
+	82,  // 199: mealplanning.MealPlanOptionVote.created_at:type_name -> google.protobuf.Timestamp
+	82,  // 200: mealplanning.MealPlanOptionVote.archived_at:type_name -> google.protobuf.Timestamp
+	82,  // 201: mealplanning.MealPlanOptionVote.last_updated_at:type_name -> google.protobuf.Timestamp
+	82,  // 202: mealplanning.MealPlanRecipeOptionSelection.created_at:type_name -> google.protobuf.Timestamp
+	82,  // 203: mealplanning.MealPlanRecipeOptionSelection.last_updated_at:type_name -> google.protobuf.Timestamp
+	10,  // 204: mealplanning.MealPlanRecipeOptionSelection.selection_type:type_name -> mealplanning.MealPlanRecipeOptionSelectionType
+	82,  // 205: mealplanning.MealPlanRecipeOptionSelection.archived_at:type_name -> google.protobuf.Timestamp
+	82,  // 206: mealplanning.MealList.created_at:type_name -> google.protobuf.Timestamp
+	82,  // 207: mealplanning.MealList.last_updated_at:type_name -> google.protobuf.Timestamp
+	82,  // 208: mealplanning.MealList.archived_at:type_name -> google.protobuf.Timestamp
+	68,  // 209: mealplanning.MealList.items:type_name -> mealplanning.MealListItem
+	82,  // 210: mealplanning.MealListItem.created_at:type_name -> google.protobuf.Timestamp
+	82,  // 211: mealplanning.MealListItem.archived_at:type_name -> google.protobuf.Timestamp
+	82,  // 212: mealplanning.MealListItem.last_updated_at:type_name -> google.protobuf.Timestamp
+	46,  // 213: mealplanning.MealListItem.meal:type_name -> mealplanning.Meal
+	82,  // 214: mealplanning.RecipeList.created_at:type_name -> google.protobuf.Timestamp
+	82,  // 215: mealplanning.RecipeList.last_updated_at:type_name -> google.protobuf.Timestamp
+	82,  // 216: mealplanning.RecipeList.archived_at:type_name -> google.protobuf.Timestamp
+	70,  // 217: mealplanning.RecipeList.items:type_name -> mealplanning.RecipeListItem
+	82,  // 218: mealplanning.RecipeListItem.created_at:type_name -> google.protobuf.Timestamp
+	82,  // 219: mealplanning.RecipeListItem.archived_at:type_name -> google.protobuf.Timestamp
+	82,  // 220: mealplanning.RecipeListItem.last_updated_at:type_name -> google.protobuf.Timestamp
+	29,  // 221: mealplanning.RecipeListItem.recipe:type_name -> mealplanning.Recipe
+	31,  // 222: mealplanning.MealPlanTask.recipe_prep_task:type_name -> mealplanning.RecipePrepTask
+	82,  // 223: mealplanning.MealPlanTask.created_at:type_name -> google.protobuf.Timestamp
+	82,  // 224: mealplanning.MealPlanTask.last_updated_at:type_name -> google.protobuf.Timestamp
+	82,  // 225: mealplanning.MealPlanTask.completed_at:type_name -> google.protobuf.Timestamp
+	9,   // 226: mealplanning.MealPlanTask.status:type_name -> mealplanning.MealPlanTaskStatus
+	60,  // 227: mealplanning.MealPlanTask.meal_plan_option:type_name -> mealplanning.MealPlanOption
+	82,  // 228: mealplanning.CookTimelineStep.starts_at:type_name -> google.protobuf.Timestamp
+	82,  // 229: mealplanning.CookTimelineStep.ends_at:type_name -> google.protobuf.Timestamp
+	82,  // 230: mealplanning.CookTimeline.starts_at:type_name -> google.protobuf.Timestamp
+	82,  // 231: mealplanning.CookTimeline.serving_time:type_name -> google.protobuf.Timestamp
+	72,  // 232: mealplanning.CookTimeline.steps:type_name -> mealplanning.CookTimelineStep
+	82,  // 233: mealplanning.ValidIngredientNutritionFacts.created_at:type_name -> google.protobuf.Timestamp
+	82,  // 234: mealplanning.ValidIngredientNutritionFacts.last_updated_at:type_name -> google.protobuf.Timestamp
+	82,  // 235: mealplanning.ValidIngredientNutritionFacts.archived_at:type_name -> google.protobuf.Timestamp
+	21,  // 236: mealplanning.ValidIngredientNutritionFacts.basis_measurement_unit:type_name -> mealplanning.ValidMeasurementUnit
+	74,  // 237: mealplanning.ValidIngredientNutritionFacts.nutrients:type_name -> mealplanning.Nutrients
+	74,  // 238: mealplanning.NutritionRollup.per_portion:type_name -> mealplanning.Nutrients
+	76,  // 239: mealplanning.NutritionRollup.missing_ingredients:type_name -> mealplanning.NutritionMissingIngredient
+	74,  // 240: mealplanning.NutritionRollup.totals:type_name -> mealplanning.Nutrients
+	82,  // 241: mealplanning.MealPlanEventNutritionRollup.starts_at:type_name -> google.protobuf.Timestamp
+	77,  // 242: mealplanning.MealPlanEventNutritionRollup.nutrition:type_name -> mealplanning.NutritionRollup
+	78,  // 243: mealplanning.MealPlanNutritionRollup.events:type_name -> mealplanning.MealPlanEventNutritionRollup
+	76,  // 244: mealplanning.MealPlanNutritionRollup.missing_ingredients:type_name -> mealplanning.NutritionMissingIngredient
+	74,  // 245: mealplanning.MealPlanNutritionRollup.totals:type_name -> mealplanning.Nutrients
+	82,  // 246: mealplanning.AccountInstrumentOwnership.created_at:type_name -> google.protobuf.Timestamp
+	82,  // 247: mealplanning.AccountInstrumentOwnership.archived_at:type_name -> google.protobuf.Timestamp
+	82,  // 248: mealplanning.AccountInstrumentOwnership.last_updated_at:type_name -> google.protobuf.Timestamp
+	20,  // 249: mealplanning.AccountInstrumentOwnership.instrument:type_name -> mealplanning.ValidInstrument
+	82,  // 250: mealplanning.PantryItem.created_at:type_name -> google.protobuf.Timestamp
+	82,  // 251: mealplanning.PantryItem.archived_at:type_name -> google.protobuf.Timestamp
+	82,  // 252: mealplanning.PantryItem.last_updated_at:type_name -> google.protobuf.Timestamp
+	82,  // 253: mealplanning.PantryItem.expires_at:type_name -> google.protobuf.Timestamp
+	8,   // 254: mealplanning.PantryItem.storage_location:type_name -> mealplanning.PantryStorageLocation
+	12,  // 255: mealplanning.PantryItem.ingredient:type_name -> mealplanning.ValidIngredient
+	21,  // 256: mealplanning.PantryItem.measurement_unit:type_name -> mealplanning.ValidMeasurementUnit
+	257, // [257:257] is the sub-list for method output_type
+	257, // [257:257] is the sub-list for method input_type
+	257, // [257:257] is the sub-list for extension type_name
+	257, // [257:257] is the sub-list for extension extendee
+	0,   // [0:257] is the sub-list for field type_name
 }
 
 func init() { file_mealplanning_mealplanning_messages_proto_init() }
@@ -8816,32 +11863,39 @@ func file_mealplanning_mealplanning_messages_proto_init() {
 	file_mealplanning_mealplanning_messages_proto_msgTypes[20].OneofWrappers = []any{}
 	file_mealplanning_mealplanning_messages_proto_msgTypes[22].OneofWrappers = []any{}
 	file_mealplanning_mealplanning_messages_proto_msgTypes[23].OneofWrappers = []any{}
-	file_mealplanning_mealplanning_messages_proto_msgTypes[24].OneofWrappers = []any{}
-	file_mealplanning_mealplanning_messages_proto_msgTypes[25].OneofWrappers = []any{}
-	file_mealplanning_mealplanning_messages_proto_msgTypes[26].OneofWrappers = []any{}
-	file_mealplanning_mealplanning_messages_proto_msgTypes[27].OneofWrappers = []any{}
 	file_mealplanning_mealplanning_messages_proto_msgTypes[28].OneofWrappers = []any{}
 	file_mealplanning_mealplanning_messages_proto_msgTypes[29].OneofWrappers = []any{}
 	file_mealplanning_mealplanning_messages_proto_msgTypes[30].OneofWrappers = []any{}
+	file_mealplanning_mealplanning_messages_proto_msgTypes[31].OneofWrappers = []any{}
 	file_mealplanning_mealplanning_messages_proto_msgTypes[32].OneofWrappers = []any{}
 	file_mealplanning_mealplanning_messages_proto_msgTypes[33].OneofWrappers = []any{}
 	file_mealplanning_mealplanning_messages_proto_msgTypes[34].OneofWrappers = []any{}
 	file_mealplanning_mealplanning_messages_proto_msgTypes[35].OneofWrappers = []any{}
-	file_mealplanning_mealplanning_messages_proto_msgTypes[36].OneofWrappers = []any{}
-	file_mealplanning_mealplanning_messages_proto_msgTypes[38].OneofWrappers = []any{}
+	file_mealplanning_mealplanning_messages_proto_msgTypes[37].OneofWrappers = []any{}
 	file_mealplanning_mealplanning_messages_proto_msgTypes[40].OneofWrappers = []any{}
 	file_mealplanning_mealplanning_messages_proto_msgTypes[41].OneofWrappers = []any{}
 	file_mealplanning_mealplanning_messages_proto_msgTypes[42].OneofWrappers = []any{}
 	file_mealplanning_mealplanning_messages_proto_msgTypes[43].OneofWrappers = []any{}
-	file_mealplanning_mealplanning_messages_proto_msgTypes[44].OneofWrappers = []any{}
-	file_mealplanning_mealplanning_messages_proto_msgTypes[45].OneofWrappers = []any{}
+	file_mealplanning_mealplanning_messages_proto_msgTypes[48].OneofWrappers = []any{}
+	file_mealplanning_mealplanning_messages_proto_msgTypes[49].OneofWrappers = []any{}
+	file_mealplanning_mealplanning_messages_proto_msgTypes[52].OneofWrappers = []any{}
+	file_mealplanning_mealplanning_messages_proto_msgTypes[54].OneofWrappers = []any{}
+	file_mealplanning_mealplanning_messages_proto_msgTypes[56].OneofWrappers = []any{}
+	file_mealplanning_mealplanning_messages_proto_msgTypes[57].OneofWrappers = []any{}
+	file_mealplanning_mealplanning_messages_proto_msgTypes[58].OneofWrappers = []any{}
+	file_mealplanning_mealplanning_messages_proto_msgTypes[59].OneofWrappers = []any{}
+	file_mealplanning_mealplanning_messages_proto_msgTypes[60].OneofWrappers = []any{}
+	file_mealplanning_mealplanning_messages_proto_msgTypes[64].OneofWrappers = []any{}
+	file_mealplanning_mealplanning_messages_proto_msgTypes[66].OneofWrappers = []any{}
+	file_mealplanning_mealplanning_messages_proto_msgTypes[69].OneofWrappers = []any{}
+	file_mealplanning_mealplanning_messages_proto_msgTypes[70].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_mealplanning_mealplanning_messages_proto_rawDesc), len(file_mealplanning_mealplanning_messages_proto_rawDesc)),
-			NumEnums:      10,
-			NumMessages:   46,
+			NumEnums:      11,
+			NumMessages:   71,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
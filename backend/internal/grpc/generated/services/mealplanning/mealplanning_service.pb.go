@@ -30,7 +30,7 @@
 	0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x1a, 0x2d, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61,
 	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2f, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69,
 	0x6e, 0x67, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0xc2, 0xe0, 0x01, 0x0a, 0x13, 0x4d, 0x65, 0x61, 0x6c,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0xf5, 0xfa, 0x01, 0x0a, 0x13, 0x4d, 0x65, 0x61, 0x6c,
 	0x50, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
 	0x61, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x6f, 0x4d,
 	0x65, 0x61, 0x6c, 0x12, 0x25, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69,
@@ -103,237 +103,268 @@
 	0x3a, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x41,
 	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65,
 	0x63, 0x69, 0x70, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x0f, 0x41,
-	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4d, 0x65, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x24,
-	0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x72,
-	0x63, 0x68, 0x69, 0x76, 0x65, 0x4d, 0x65, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e,
-	0x69, 0x6e, 0x67, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4d, 0x65, 0x61, 0x6c, 0x4c,
-	0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6a, 0x0a, 0x13, 0x41,
-	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4d, 0x65, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74,
-	0x65, 0x6d, 0x12, 0x28, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e,
-	0x67, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4d, 0x65, 0x61, 0x6c, 0x4c, 0x69, 0x73,
-	0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x6d,
-	0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x72, 0x63, 0x68,
-	0x69, 0x76, 0x65, 0x4d, 0x65, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x0d, 0x41, 0x72, 0x63, 0x68, 0x69,
-	0x76, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x12, 0x22, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70,
-	0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52,
-	0x65, 0x63, 0x69, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6d,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x76, 0x0a, 0x17, 0x41,
+	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x54, 0x65,
+	0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x2c, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61,
+	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4d, 0x65, 0x61,
+	0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e,
+	0x69, 0x6e, 0x67, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4d, 0x65, 0x61, 0x6c, 0x50,
+	0x6c, 0x61, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x0f, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4d, 0x65,
+	0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x24, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61,
+	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4d, 0x65, 0x61,
+	0x6c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6d,
 	0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x72, 0x63, 0x68,
-	0x69, 0x76, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x70, 0x0a, 0x15, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x63, 0x69,
-	0x70, 0x65, 0x50, 0x72, 0x65, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x2a, 0x2e, 0x6d, 0x65, 0x61,
+	0x69, 0x76, 0x65, 0x4d, 0x65, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x6a, 0x0a, 0x13, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4d, 0x65,
+	0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x28, 0x2e, 0x6d, 0x65, 0x61,
 	0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76,
-	0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x50, 0x72, 0x65, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61,
-	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x63,
-	0x69, 0x70, 0x65, 0x50, 0x72, 0x65, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x6a, 0x0a, 0x13, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65,
-	0x63, 0x69, 0x70, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x28, 0x2e, 0x6d, 0x65, 0x61,
-	0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76,
-	0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71,
+	0x65, 0x4d, 0x65, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e,
+	0x69, 0x6e, 0x67, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x4d, 0x65, 0x61, 0x6c, 0x4c,
+	0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x58, 0x0a, 0x0d, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65,
+	0x12, 0x22, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e,
+	0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e,
 	0x69, 0x6e, 0x67, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70,
-	0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x64, 0x0a, 0x11, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65,
-	0x4c, 0x69, 0x73, 0x74, 0x12, 0x26, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e,
-	0x69, 0x6e, 0x67, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70,
-	0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6d,
-	0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x72, 0x63, 0x68,
-	0x69, 0x76, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x70, 0x0a, 0x15, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
-	0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x2a,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x70, 0x0a, 0x15, 0x41, 0x72, 0x63,
+	0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x50, 0x72, 0x65, 0x70, 0x54, 0x61,
+	0x73, 0x6b, 0x12, 0x2a, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e,
+	0x67, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x50,
+	0x72, 0x65, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b,
 	0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x72,
-	0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x49,
-	0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x6d, 0x65, 0x61,
-	0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76,
-	0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52,
+	0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x50, 0x72, 0x65, 0x70, 0x54,
+	0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6a, 0x0a, 0x13, 0x41,
+	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x52, 0x61, 0x74, 0x69,
+	0x6e, 0x67, 0x12, 0x28, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e,
+	0x67, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x52,
+	0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x6d,
+	0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x72, 0x63, 0x68,
+	0x69, 0x76, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52,
 	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x11, 0x41, 0x72, 0x63, 0x68, 0x69,
-	0x76, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x12, 0x26, 0x2e, 0x6d,
+	0x76, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x26, 0x2e, 0x6d,
 	0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x72, 0x63, 0x68,
-	0x69, 0x76, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x52, 0x65, 0x71,
+	0x69, 0x76, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e,
 	0x69, 0x6e, 0x67, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70,
-	0x65, 0x53, 0x74, 0x65, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x9d, 0x01,
-	0x0a, 0x24, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53,
-	0x74, 0x65, 0x70, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e,
-	0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61,
+	0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x70, 0x0a,
+	0x15, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x4c, 0x69,
+	0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x2a, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61,
 	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x63,
-	0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f,
-	0x6e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x3a, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
-	0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74,
-	0x65, 0x70, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x64,
-	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x82, 0x01,
-	0x0a, 0x1b, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53,
-	0x74, 0x65, 0x70, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x30, 0x2e,
-	0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x72, 0x63,
-	0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x49, 0x6e,
-	0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x31, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x41,
-	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70,
-	0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x82, 0x01, 0x0a, 0x1b, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65,
-	0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65,
-	0x6e, 0x74, 0x12, 0x30, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e,
-	0x67, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53,
-	0x74, 0x65, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e,
+	0x69, 0x70, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e,
+	0x67, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x4c,
+	0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x64, 0x0a, 0x11, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65,
+	0x53, 0x74, 0x65, 0x70, 0x12, 0x26, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e,
 	0x69, 0x6e, 0x67, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70,
-	0x65, 0x53, 0x74, 0x65, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x79, 0x0a, 0x18, 0x41, 0x72, 0x63, 0x68, 0x69,
-	0x76, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x50, 0x72, 0x6f, 0x64,
-	0x75, 0x63, 0x74, 0x12, 0x2d, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69,
-	0x6e, 0x67, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65,
-	0x53, 0x74, 0x65, 0x70, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e,
-	0x67, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53,
-	0x74, 0x65, 0x70, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x76, 0x0a, 0x17, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x63,
-	0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x56, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x12, 0x2c, 0x2e,
-	0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x72, 0x63,
-	0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x56, 0x65,
-	0x73, 0x73, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x6d, 0x65,
-	0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69,
-	0x76, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x56, 0x65, 0x73, 0x73,
-	0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x73, 0x0a, 0x16, 0x41, 0x72,
-	0x63, 0x68, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64,
-	0x69, 0x65, 0x6e, 0x74, 0x12, 0x2b, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e,
-	0x69, 0x6e, 0x67, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64,
-	0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x2c, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
-	0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67,
-	0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x82, 0x01, 0x0a, 0x1b, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64,
-	0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12,
-	0x30, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x41,
-	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65,
-	0x64, 0x69, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x31, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
-	0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67,
-	0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0xa0, 0x01, 0x0a, 0x25, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
-	0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x4d,
-	0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x3a,
+	0x65, 0x53, 0x74, 0x65, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6d,
+	0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x72, 0x63, 0x68,
+	0x69, 0x76, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x9d, 0x01, 0x0a, 0x24, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76,
+	0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x43, 0x6f, 0x6d, 0x70, 0x6c,
+	0x65, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x39,
 	0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x72,
-	0x63, 0x68, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64,
-	0x69, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55,
-	0x6e, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3b, 0x2e, 0x6d, 0x65, 0x61,
+	0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x43,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x6d, 0x65, 0x61, 0x6c,
+	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
+	0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x82, 0x01, 0x0a, 0x1b, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76,
+	0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x49, 0x6e, 0x67, 0x72, 0x65,
+	0x64, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x30, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e,
+	0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x63, 0x69,
+	0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c,
+	0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65,
+	0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x82, 0x01, 0x0a, 0x1b, 0x41,
+	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70,
+	0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x30, 0x2e, 0x6d, 0x65, 0x61,
 	0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76,
-	0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74,
-	0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x94, 0x01, 0x0a, 0x21, 0x41, 0x72, 0x63, 0x68,
+	0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x72,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x6d,
+	0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x72, 0x63, 0x68,
+	0x69, 0x76, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x49, 0x6e, 0x73,
+	0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x79, 0x0a, 0x18, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65,
+	0x53, 0x74, 0x65, 0x70, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x2d, 0x2e, 0x6d, 0x65,
+	0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69,
+	0x76, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x50, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x6d, 0x65, 0x61,
+	0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76,
+	0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x50, 0x72, 0x6f, 0x64, 0x75,
+	0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x76, 0x0a, 0x17, 0x41, 0x72,
+	0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x56,
+	0x65, 0x73, 0x73, 0x65, 0x6c, 0x12, 0x2c, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e,
+	0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x63, 0x69,
+	0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x56, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69,
+	0x6e, 0x67, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65,
+	0x53, 0x74, 0x65, 0x70, 0x56, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x73, 0x0a, 0x16, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x2b, 0x2e, 0x6d,
+	0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x72, 0x63, 0x68,
 	0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65,
-	0x6e, 0x74, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x2e,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6d, 0x65, 0x61, 0x6c,
+	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x82, 0x01, 0x0a, 0x1b, 0x41, 0x72, 0x63, 0x68,
+	0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65,
+	0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x30, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c,
+	0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f,
+	0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x6d, 0x65, 0x61, 0x6c,
+	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0xa0, 0x01, 0x0a,
+	0x25, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67,
+	0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x3a, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61,
+	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x61, 0x73,
+	0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x3b, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e,
+	0x67, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e,
+	0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d,
+	0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x9d, 0x01, 0x0a, 0x24, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x4e, 0x75, 0x74, 0x72, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x46, 0x61, 0x63, 0x74, 0x73, 0x12, 0x39, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70,
+	0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x4e, 0x75,
+	0x74, 0x72, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x61, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69,
+	0x6e, 0x67, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49,
+	0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x4e, 0x75, 0x74, 0x72, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x46, 0x61, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x94, 0x01, 0x0a, 0x21, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x36, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e,
+	0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x65, 0x70, 0x61,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e,
 	0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x72, 0x63,
 	0x68, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69,
 	0x65, 0x6e, 0x74, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7f, 0x0a, 0x1a, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76,
+	0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x72, 0x65, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x12, 0x2f, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e,
+	0x69, 0x6e, 0x67, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x50, 0x72, 0x65, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e,
 	0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69,
-	0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x65, 0x70, 0x61,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7f,
-	0x0a, 0x1a, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x72,
-	0x65, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2f, 0x2e, 0x6d,
-	0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x72, 0x63, 0x68,
-	0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x72, 0x65, 0x70, 0x54, 0x61, 0x73, 0x6b,
-	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e,
-	0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x72, 0x63,
-	0x68, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x72, 0x65, 0x70, 0x54, 0x61, 0x73,
-	0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x82, 0x01, 0x0a, 0x1b, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64,
-	0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
-	0x30, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x41,
-	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65,
-	0x64, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x31, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
-	0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67,
-	0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0xa0, 0x01, 0x0a, 0x25, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
+	0x64, 0x50, 0x72, 0x65, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x82, 0x01, 0x0a, 0x1b, 0x41, 0x72, 0x63, 0x68,
+	0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65,
+	0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x30, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c,
+	0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x6d, 0x65, 0x61, 0x6c,
+	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
 	0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x3a,
-	0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x72,
-	0x63, 0x68, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64,
-	0x69, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69,
-	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3b, 0x2e, 0x6d, 0x65, 0x61,
-	0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76,
-	0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x73, 0x0a, 0x16, 0x41, 0x72, 0x63, 0x68, 0x69,
-	0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e,
-	0x74, 0x12, 0x2b, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
-	0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x73,
-	0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c,
-	0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x72,
-	0x63, 0x68, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75,
-	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x82, 0x01, 0x0a,
-	0x1b, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x65, 0x61,
-	0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x30, 0x2e, 0x6d,
-	0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x72, 0x63, 0x68,
-	0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d,
-	0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31,
-	0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x72,
-	0x63, 0x68, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72,
-	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0xa0, 0x01, 0x0a, 0x25, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c,
+	0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0xa0, 0x01, 0x0a,
+	0x25, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67,
+	0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x67, 0x72,
+	0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x3a, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61,
+	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x3b, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e,
+	0x67, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e,
+	0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x67,
+	0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x73, 0x0a, 0x16, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49,
+	0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2b, 0x2e, 0x6d, 0x65, 0x61, 0x6c,
+	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61,
+	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x82, 0x01, 0x0a, 0x1b, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x55, 0x6e, 0x69, 0x74, 0x12, 0x30, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e,
+	0x69, 0x6e, 0x67, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61,
+	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c,
 	0x69, 0x64, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69,
-	0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x3a, 0x2e, 0x6d, 0x65,
-	0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69,
-	0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3b, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0xa0, 0x01, 0x0a, 0x25, 0x41, 0x72,
+	0x63, 0x68, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x12, 0x3a, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69,
+	0x6e, 0x67, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d,
+	0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x43, 0x6f,
+	0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x3b, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x41,
+	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x65, 0x61, 0x73, 0x75,
+	0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x76, 0x0a, 0x17,
+	0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x72, 0x65, 0x70,
+	0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2c, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c,
 	0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x56, 0x61,
-	0x6c, 0x69, 0x64, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e,
-	0x69, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x76, 0x0a, 0x17, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x56,
-	0x61, 0x6c, 0x69, 0x64, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x2c, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x41,
-	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x72, 0x65, 0x70, 0x61,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e,
-	0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x72, 0x63,
-	0x68, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x94, 0x01, 0x0a,
-	0x21, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x72, 0x65,
-	0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65,
-	0x6e, 0x74, 0x12, 0x36, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e,
-	0x67, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x72,
-	0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d,
-	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x6d, 0x65, 0x61,
-	0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76,
-	0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x88, 0x01, 0x0a, 0x1d, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x56,
-	0x61, 0x6c, 0x69, 0x64, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56,
-	0x65, 0x73, 0x73, 0x65, 0x6c, 0x12, 0x32, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e,
+	0x6c, 0x69, 0x64, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e,
 	0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69,
-	0x64, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x65, 0x73, 0x73,
-	0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x6d, 0x65, 0x61, 0x6c,
-	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
+	0x64, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x94, 0x01, 0x0a, 0x21, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
 	0x56, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x56, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67,
-	0x0a, 0x12, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x56, 0x65,
-	0x73, 0x73, 0x65, 0x6c, 0x12, 0x27, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e,
-	0x69, 0x6e, 0x67, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64,
-	0x56, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e,
+	0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x36, 0x2e, 0x6d, 0x65, 0x61,
+	0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76,
+	0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x37, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e,
+	0x67, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x72,
+	0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x88, 0x01, 0x0a, 0x1d,
+	0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x72, 0x65, 0x70,
+	0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x12, 0x32, 0x2e,
 	0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x72, 0x63,
-	0x68, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x56, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x0b, 0x43, 0x6c, 0x6f, 0x6e, 0x65,
-	0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x12, 0x20, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61,
-	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70,
-	0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x63,
-	0x69, 0x70, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x0a, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x61, 0x6c, 0x12, 0x1f, 0x2e, 0x6d, 0x65, 0x61, 0x6c,
+	0x68, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x56, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x33, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
+	0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x72, 0x65,
+	0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a, 0x12, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76,
+	0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x56, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x12, 0x27, 0x2e, 0x6d,
+	0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x72, 0x63, 0x68,
+	0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x56, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e,
+	0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x56, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x52, 0x0a, 0x0b, 0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x12, 0x20,
+	0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x6c,
+	0x6f, 0x6e, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x21, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e,
+	0x43, 0x6c, 0x6f, 0x6e, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x0c, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x63,
+	0x69, 0x70, 0x65, 0x12, 0x21, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69,
+	0x6e, 0x67, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61,
+	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x63, 0x69,
+	0x70, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x0a, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x61, 0x6c, 0x12, 0x1f, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70,
+	0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x65,
+	0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6d, 0x65, 0x61, 0x6c,
 	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d,
-	0x65, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6d, 0x65, 0x61,
-	0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x4d, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x0e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x23,
-	0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69,
-	0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6a, 0x0a, 0x13, 0x43, 0x72, 0x65,
+	0x65, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x0e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x23, 0x2e,
+	0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e,
+	0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7f, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64,
+	0x61, 0x72, 0x46, 0x65, 0x65, 0x64, 0x12, 0x2f, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61,
+	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x61, 0x6c,
+	0x50, 0x6c, 0x61, 0x6e, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x46, 0x65, 0x65, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c,
+	0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x61,
+	0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x46, 0x65, 0x65,
+	0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6a, 0x0a, 0x13, 0x43, 0x72, 0x65,
 	0x61, 0x74, 0x65, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74,
 	0x12, 0x28, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e,
 	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x45, 0x76,
@@ -371,590 +402,696 @@
 	0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6d, 0x65,
 	0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
 	0x65, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x0e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d,
-	0x65, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x23, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c,
-	0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x61,
-	0x6c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6d,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x73, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d,
+	0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12,
+	0x2b, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x54, 0x65, 0x6d,
+	0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6d,
 	0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x4d, 0x65, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x67, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x61, 0x6c,
-	0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x27, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70,
-	0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x65,
-	0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x28, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
-	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x49,
-	0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x0c, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x12, 0x21, 0x2e, 0x6d, 0x65,
-	0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22,
-	0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x6d, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69,
-	0x70, 0x65, 0x50, 0x72, 0x65, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x29, 0x2e, 0x6d, 0x65, 0x61,
+	0x74, 0x65, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x0e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x23, 0x2e, 0x6d,
+	0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x4d, 0x65, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x24, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x4d, 0x65, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x27, 0x2e,
+	0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x4d, 0x65, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61,
+	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x61, 0x6c,
+	0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x55, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65,
+	0x12, 0x21, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69,
+	0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6d, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x50, 0x72, 0x65, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x12,
+	0x29, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x50, 0x72, 0x65, 0x70, 0x54,
+	0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x6d, 0x65, 0x61,
 	0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
 	0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x50, 0x72, 0x65, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x27, 0x2e, 0x6d,
+	0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e,
 	0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70,
-	0x65, 0x50, 0x72, 0x65, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x67, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70,
-	0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x27, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c,
-	0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63,
-	0x69, 0x70, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x28, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x52, 0x61, 0x74, 0x69,
-	0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a, 0x10, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x25,
-	0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e,
+	0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x61, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x4c,
+	0x69, 0x73, 0x74, 0x12, 0x25, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69,
+	0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x4c,
+	0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6d, 0x65, 0x61,
+	0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x6d, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69,
+	0x70, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x29, 0x2e, 0x6d, 0x65, 0x61,
+	0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e,
 	0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70,
-	0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6d, 0x0a,
-	0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x4c, 0x69, 0x73,
-	0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x29, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e,
+	0x65, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x61, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70,
+	0x65, 0x53, 0x74, 0x65, 0x70, 0x12, 0x25, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e,
 	0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70,
-	0x65, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x2a, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x4c, 0x69, 0x73, 0x74,
-	0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a, 0x10,
+	0x65, 0x53, 0x74, 0x65, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6d,
+	0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x9a, 0x01, 0x0a, 0x23, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x2e, 0x6d,
+	0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x43, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x39, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61,
+	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69,
+	0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x7f, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70,
+	0x65, 0x53, 0x74, 0x65, 0x70, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x12,
+	0x2f, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x49,
+	0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x30, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e,
 	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70,
-	0x12, 0x25, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e,
+	0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x7f, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69,
+	0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x2f, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e,
 	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c,
-	0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63,
-	0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x9a, 0x01, 0x0a, 0x23, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65,
-	0x53, 0x74, 0x65, 0x70, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f,
-	0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c,
-	0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63,
-	0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f,
-	0x6e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x39, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
+	0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x30, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
 	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65,
-	0x70, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x64, 0x69,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7f, 0x0a, 0x1a,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70,
-	0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x2f, 0x2e, 0x6d, 0x65, 0x61,
-	0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64,
-	0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x6d, 0x65,
-	0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x49, 0x6e, 0x67, 0x72, 0x65,
-	0x64, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7f, 0x0a,
-	0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65,
-	0x70, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2f, 0x2e, 0x6d, 0x65,
-	0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x72,
-	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x6d,
+	0x70, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x76, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63,
+	0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x2c,
+	0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x50, 0x72,
+	0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x6d,
 	0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x49, 0x6e, 0x73, 0x74,
-	0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x76,
-	0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74,
-	0x65, 0x70, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x2c, 0x2e, 0x6d, 0x65, 0x61, 0x6c,
-	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
-	0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c,
-	0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63,
-	0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x73, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x56, 0x65, 0x73, 0x73, 0x65, 0x6c,
-	0x12, 0x2b, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70,
-	0x56, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e,
-	0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x56, 0x65, 0x73,
-	0x73, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x70, 0x0a, 0x15, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64,
-	0x69, 0x65, 0x6e, 0x74, 0x12, 0x2a, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e,
-	0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49,
-	0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x2b, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e,
+	0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x50, 0x72, 0x6f, 0x64,
+	0x75, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x73, 0x0a, 0x16, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x56,
+	0x65, 0x73, 0x73, 0x65, 0x6c, 0x12, 0x2b, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e,
+	0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70,
+	0x65, 0x53, 0x74, 0x65, 0x70, 0x56, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e,
+	0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74,
+	0x65, 0x70, 0x56, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x70, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49,
+	0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x2a, 0x2e, 0x6d, 0x65, 0x61, 0x6c,
+	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e,
+	0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x7f, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x12, 0x2f, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e,
 	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65,
-	0x64, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7f, 0x0a,
-	0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72,
-	0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x2f, 0x2e, 0x6d, 0x65,
-	0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x6d,
+	0x64, 0x69, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x30, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72,
+	0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x9d, 0x01, 0x0a, 0x24, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x61,
+	0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x39, 0x2e, 0x6d,
 	0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61,
 	0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e,
-	0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x9d,
-	0x01, 0x0a, 0x24, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e,
-	0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d,
-	0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x39, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c,
+	0x74, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c,
 	0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c,
 	0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x61, 0x73,
-	0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e,
-	0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67,
-	0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x91,
-	0x01, 0x0a, 0x20, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e,
-	0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x35, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69,
-	0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e,
-	0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x6d, 0x65, 0x61,
-	0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x50,
-	0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x7c, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69,
-	0x64, 0x50, 0x72, 0x65, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12,
-	0x2e, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x72, 0x65, 0x70, 0x54, 0x61,
-	0x73, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x2f, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x72, 0x65, 0x70, 0x54, 0x61,
-	0x73, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x7f, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49,
-	0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2f,
-	0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69,
-	0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x30, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43,
-	0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64,
-	0x69, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x9d, 0x01, 0x0a, 0x24, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69,
-	0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x39, 0x2e, 0x6d, 0x65, 0x61,
-	0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e,
-	0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64,
-	0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49,
-	0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x70, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64,
-	0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2a, 0x2e, 0x6d, 0x65, 0x61,
-	0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61,
-	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69,
-	0x64, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x7f, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c,
-	0x69, 0x64, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69,
-	0x74, 0x12, 0x2f, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
-	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x65, 0x61, 0x73,
-	0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x30, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e,
-	0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x65, 0x61,
-	0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x9d, 0x01, 0x0a, 0x24, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56,
-	0x61, 0x6c, 0x69, 0x64, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55,
-	0x6e, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x2e,
-	0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d,
-	0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70,
-	0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x61,
-	0x6c, 0x69, 0x64, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e,
-	0x69, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x73, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x61,
-	0x6c, 0x69, 0x64, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b,
-	0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6d, 0x65,
+	0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x9a, 0x01, 0x0a, 0x23, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x4e, 0x75, 0x74,
+	0x72, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x61, 0x63, 0x74, 0x73, 0x12, 0x38, 0x2e, 0x6d, 0x65,
 	0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x91, 0x01, 0x0a, 0x20, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x35,
-	0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e,
+	0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74,
+	0x4e, 0x75, 0x74, 0x72, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x61, 0x63, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x39, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e,
 	0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64,
-	0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x72,
-	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x85, 0x01,
-	0x0a, 0x1c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x72, 0x65,
-	0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x12, 0x31,
-	0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x56, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x32, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
+	0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x4e, 0x75, 0x74, 0x72, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x46, 0x61, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x91, 0x01, 0x0a, 0x20, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e,
+	0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x6d,
+	0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e,
+	0x74, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7c, 0x0a, 0x19, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x50, 0x72, 0x65, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69,
+	0x67, 0x12, 0x2e, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x72, 0x65, 0x70,
+	0x54, 0x61, 0x73, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2f, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
 	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x72, 0x65, 0x70,
-	0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56,
-	0x61, 0x6c, 0x69, 0x64, 0x56, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x12, 0x26, 0x2e, 0x6d, 0x65, 0x61,
+	0x54, 0x61, 0x73, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x7f, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x12, 0x2f, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65,
+	0x64, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x30, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72,
+	0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x9d, 0x01, 0x0a, 0x24, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x39, 0x2e, 0x6d,
+	0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c,
+	0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x70, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2a, 0x2e, 0x6d,
+	0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70,
+	0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7f, 0x0a, 0x1a, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55,
+	0x6e, 0x69, 0x74, 0x12, 0x2f, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69,
+	0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x65,
+	0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e,
+	0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d,
+	0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x9d, 0x01, 0x0a, 0x24, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e,
+	0x74, 0x55, 0x6e, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x39, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x6d, 0x65, 0x61,
 	0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x56, 0x61, 0x6c, 0x69, 0x64, 0x56, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x55, 0x6e, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x73, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x2b, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x72, 0x65, 0x70, 0x61,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e,
+	0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x91, 0x01, 0x0a, 0x20,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x72, 0x65, 0x70, 0x61,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x12, 0x35, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x72, 0x65, 0x70, 0x61,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c,
+	0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x73,
+	0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x85, 0x01, 0x0a, 0x1c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x50,
+	0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x65, 0x73, 0x73, 0x65, 0x6c,
+	0x12, 0x31, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x72, 0x65, 0x70, 0x61,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69,
+	0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x72,
+	0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x11, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x56, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x12, 0x26, 0x2e, 0x6d,
+	0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x56, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e,
+	0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x56,
+	0x65, 0x73, 0x73, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x73, 0x0a,
+	0x16, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61,
+	0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c,
+	0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x4d,
+	0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e,
+	0x69, 0x6e, 0x67, 0x2e, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x61, 0x6c,
+	0x50, 0x6c, 0x61, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x6a, 0x0a, 0x13, 0x44, 0x69, 0x66, 0x66, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65,
+	0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x28, 0x2e, 0x6d, 0x65, 0x61, 0x6c,
+	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x52, 0x65, 0x63,
+	0x69, 0x70, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69,
+	0x6e, 0x67, 0x2e, 0x44, 0x69, 0x66, 0x66, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x52, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61,
+	0x0a, 0x10, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c,
+	0x61, 0x6e, 0x12, 0x25, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e,
+	0x67, 0x2e, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c,
+	0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6d, 0x65, 0x61, 0x6c,
+	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a,
+	0x65, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x46, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x61, 0x6c, 0x12, 0x1c, 0x2e, 0x6d,
+	0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x4d,
+	0x65, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6d, 0x65, 0x61,
+	0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x61,
+	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x0b, 0x47, 0x65, 0x74,
+	0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x20, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70,
+	0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x61, 0x6c, 0x50,
+	0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6d, 0x65, 0x61,
+	0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x61,
+	0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a,
+	0x10, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x12, 0x25, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
+	0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70,
+	0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x61, 0x6c, 0x50,
+	0x6c, 0x61, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x82, 0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x61, 0x6c, 0x6c, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74,
+	0x12, 0x30, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e,
+	0x47, 0x65, 0x74, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74,
+	0x54, 0x61, 0x6c, 0x6c, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x31, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e,
+	0x67, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x54, 0x61, 0x6c, 0x6c, 0x79, 0x52, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x61, 0x6c,
+	0x50, 0x6c, 0x61, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x26, 0x2e, 0x6d, 0x65, 0x61,
+	0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x61,
+	0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
 	0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e,
-	0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x56, 0x65, 0x73,
-	0x73, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a, 0x10, 0x46,
-	0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x12,
-	0x25, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x46,
-	0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61,
-	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x4d, 0x65,
-	0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46,
-	0x0a, 0x07, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x61, 0x6c, 0x12, 0x1c, 0x2e, 0x6d, 0x65, 0x61, 0x6c,
-	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x61, 0x6c,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c,
-	0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x61, 0x6c, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x52, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x61,
-	0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x20, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e,
-	0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c,
-	0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c,
-	0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a, 0x10, 0x47, 0x65,
-	0x74, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x25,
-	0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65,
-	0x74, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e,
-	0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a,
-	0x11, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x73, 0x12, 0x26, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e,
 	0x67, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6d, 0x65, 0x61,
+	0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7f, 0x0a, 0x1a, 0x47,
+	0x65, 0x74, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x47, 0x72, 0x6f, 0x63, 0x65, 0x72,
+	0x79, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x2f, 0x2e, 0x6d, 0x65, 0x61, 0x6c,
+	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x61, 0x6c,
+	0x50, 0x6c, 0x61, 0x6e, 0x47, 0x72, 0x6f, 0x63, 0x65, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x49,
+	0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x6d, 0x65, 0x61,
 	0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x61,
-	0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x7f, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c,
+	0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x47, 0x72, 0x6f, 0x63, 0x65, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74,
+	0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0xa3, 0x01, 0x0a,
+	0x26, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x47, 0x72, 0x6f, 0x63,
+	0x65, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x46, 0x6f, 0x72, 0x4d,
+	0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x3b, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c,
+	0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c,
 	0x61, 0x6e, 0x47, 0x72, 0x6f, 0x63, 0x65, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65,
-	0x6d, 0x12, 0x2f, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
-	0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x47, 0x72, 0x6f, 0x63,
-	0x65, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x30, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e,
-	0x67, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x47, 0x72, 0x6f,
-	0x63, 0x65, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0xa3, 0x01, 0x0a, 0x26, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x61, 0x6c,
-	0x50, 0x6c, 0x61, 0x6e, 0x47, 0x72, 0x6f, 0x63, 0x65, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x49,
-	0x74, 0x65, 0x6d, 0x73, 0x46, 0x6f, 0x72, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x12,
-	0x3b, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47,
-	0x65, 0x74, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x47, 0x72, 0x6f, 0x63, 0x65, 0x72,
-	0x79, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x46, 0x6f, 0x72, 0x4d, 0x65, 0x61,
-	0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3c, 0x2e, 0x6d,
-	0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x4d,
-	0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x47, 0x72, 0x6f, 0x63, 0x65, 0x72, 0x79, 0x4c, 0x69,
-	0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x46, 0x6f, 0x72, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c,
-	0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x11, 0x47, 0x65,
-	0x74, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12,
-	0x26, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47,
-	0x65, 0x74, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c,
+	0x6d, 0x73, 0x46, 0x6f, 0x72, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x3c, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e,
+	0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x47,
+	0x72, 0x6f, 0x63, 0x65, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x46,
+	0x6f, 0x72, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x64, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61,
+	0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c,
 	0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c,
-	0x61, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x70, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x4f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x2a, 0x2e, 0x6d, 0x65, 0x61, 0x6c,
-	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x61, 0x6c,
-	0x50, 0x6c, 0x61, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e,
-	0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e,
-	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x73, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61,
-	0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x12, 0x2b, 0x2e, 0x6d,
-	0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x4d,
+	0x61, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x27, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47,
+	0x65, 0x74, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x70, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4d,
 	0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x6f, 0x74,
-	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6d, 0x65, 0x61, 0x6c,
-	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x61, 0x6c,
-	0x50, 0x6c, 0x61, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4d, 0x65,
-	0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x27, 0x2e,
+	0x65, 0x12, 0x2a, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
+	0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x56, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e,
 	0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74,
-	0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61,
-	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61,
-	0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x91, 0x01, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e,
-	0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x6c, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e,
+	0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x6f,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x73, 0x0a, 0x16, 0x47, 0x65,
+	0x74, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x56,
+	0x6f, 0x74, 0x65, 0x73, 0x12, 0x2b, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e,
+	0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2c, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
+	0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x56, 0x6f, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x67, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x27, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e,
 	0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e,
-	0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x6c, 0x65,
-	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x6d,
-	0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x4d,
-	0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x4f, 0x70, 0x74,
-	0x69, 0x6f, 0x6e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0xc7, 0x01, 0x0a, 0x32, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x61, 0x6c,
-	0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-	0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x46, 0x6f, 0x72, 0x4d, 0x65, 0x61,
-	0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x47, 0x2e, 0x6d, 0x65,
-	0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65,
-	0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x4f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x46, 0x6f, 0x72, 0x4d,
-	0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x48, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28,
+	0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65,
+	0x74, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x91, 0x01, 0x0a, 0x20, 0x47, 0x65, 0x74,
+	0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x2e,
+	0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74,
+	0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x4f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e,
 	0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x52,
 	0x65, 0x63, 0x69, 0x70, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x6c, 0x65, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x46, 0x6f, 0x72, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e,
-	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e,
-	0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x54, 0x61, 0x73,
-	0x6b, 0x12, 0x24, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
-	0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x54, 0x61, 0x73, 0x6b,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c,
-	0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c,
-	0x61, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61,
-	0x0a, 0x10, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x54, 0x61, 0x73,
-	0x6b, 0x73, 0x12, 0x25, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e,
-	0x67, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x54, 0x61, 0x73,
-	0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6d, 0x65, 0x61, 0x6c,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0xc7, 0x01, 0x0a,
+	0x32, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x63, 0x69,
+	0x70, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x46, 0x6f, 0x72, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x12, 0x47, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69,
+	0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65,
+	0x63, 0x69, 0x70, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x46, 0x6f, 0x72, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x48, 0x2e, 0x6d,
+	0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x4d,
+	0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x46, 0x6f, 0x72,
+	0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x61,
+	0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x24, 0x2e, 0x6d, 0x65, 0x61, 0x6c,
 	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x61, 0x6c,
-	0x50, 0x6c, 0x61, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x73, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e,
-	0x73, 0x46, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2b, 0x2e, 0x6d, 0x65,
+	0x50, 0x6c, 0x61, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x25, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47,
+	0x65, 0x74, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x61,
+	0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x25, 0x2e, 0x6d, 0x65, 0x61,
+	0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x61,
+	0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x26, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
+	0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x54, 0x61, 0x73, 0x6b,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6a, 0x0a, 0x13, 0x47, 0x65, 0x74,
+	0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x12, 0x28, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e,
+	0x47, 0x65, 0x74, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x6d, 0x65, 0x61,
+	0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x61,
+	0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6d, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x61, 0x6c,
+	0x50, 0x6c, 0x61, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x12, 0x29, 0x2e,
+	0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74,
+	0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70,
+	0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x61, 0x6c, 0x50,
+	0x6c, 0x61, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x73, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x61, 0x6c, 0x50,
+	0x6c, 0x61, 0x6e, 0x73, 0x46, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2b,
+	0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65,
+	0x74, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x73, 0x46, 0x6f, 0x72, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6d, 0x65,
 	0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65,
 	0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x73, 0x46, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70,
-	0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x61, 0x6c, 0x50,
-	0x6c, 0x61, 0x6e, 0x73, 0x46, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x61,
-	0x6c, 0x4c, 0x69, 0x73, 0x74, 0x73, 0x12, 0x21, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61,
-	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x61, 0x6c, 0x4c, 0x69, 0x73,
-	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6d, 0x65, 0x61, 0x6c,
-	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x61, 0x6c,
-	0x4c, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x49, 0x0a,
-	0x08, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x61, 0x6c, 0x73, 0x12, 0x1d, 0x2e, 0x6d, 0x65, 0x61, 0x6c,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x0c, 0x47, 0x65, 0x74,
+	0x4d, 0x65, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x73, 0x12, 0x21, 0x2e, 0x6d, 0x65, 0x61, 0x6c,
 	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x61, 0x6c,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70,
-	0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x61, 0x6c, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x79, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x4d,
-	0x65, 0x72, 0x6d, 0x61, 0x69, 0x64, 0x44, 0x69, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x46, 0x6f, 0x72,
-	0x4d, 0x65, 0x61, 0x6c, 0x12, 0x2d, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e,
-	0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x72, 0x6d, 0x61, 0x69, 0x64, 0x44, 0x69,
-	0x61, 0x67, 0x72, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x4d, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69,
-	0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x72, 0x6d, 0x61, 0x69, 0x64, 0x44, 0x69, 0x61,
-	0x67, 0x72, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x4d, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x7f, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x72, 0x6d, 0x61, 0x69,
-	0x64, 0x44, 0x69, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x52, 0x65, 0x63, 0x69, 0x70,
-	0x65, 0x12, 0x2f, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
+	0x4c, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6d,
+	0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x4d,
+	0x65, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x49, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x61, 0x6c, 0x73, 0x12, 0x1d, 0x2e, 0x6d,
+	0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x4d,
+	0x65, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x6d, 0x65,
+	0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65,
+	0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x91, 0x01, 0x0a, 0x20,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6f, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x46,
+	0x6f, 0x72, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x35, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e,
+	0x47, 0x65, 0x74, 0x43, 0x6f, 0x6f, 0x6b, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x46,
+	0x6f, 0x72, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c,
+	0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6f, 0x6b, 0x54, 0x69,
+	0x6d, 0x65, 0x6c, 0x69, 0x6e, 0x65, 0x46, 0x6f, 0x72, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61,
+	0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x6a, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x4e, 0x75, 0x74, 0x72, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x46,
+	0x6f, 0x72, 0x4d, 0x65, 0x61, 0x6c, 0x12, 0x28, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61,
+	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x75, 0x74, 0x72, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x46, 0x6f, 0x72, 0x4d, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x29, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e,
+	0x47, 0x65, 0x74, 0x4e, 0x75, 0x74, 0x72, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x72, 0x4d,
+	0x65, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x76, 0x0a, 0x17, 0x47,
+	0x65, 0x74, 0x4e, 0x75, 0x74, 0x72, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x72, 0x4d, 0x65,
+	0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x2c, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61,
+	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x75, 0x74, 0x72, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x46, 0x6f, 0x72, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e,
+	0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x75, 0x74, 0x72, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x46, 0x6f, 0x72, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x70, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x4e, 0x75, 0x74, 0x72, 0x69, 0x74,
+	0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x72, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x12, 0x2a, 0x2e, 0x6d,
+	0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x4e,
+	0x75, 0x74, 0x72, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x72, 0x52, 0x65, 0x63, 0x69, 0x70,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70,
+	0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x75, 0x74, 0x72, 0x69,
+	0x74, 0x69, 0x6f, 0x6e, 0x46, 0x6f, 0x72, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x79, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x72, 0x6d,
+	0x61, 0x69, 0x64, 0x44, 0x69, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x4d, 0x65, 0x61,
+	0x6c, 0x12, 0x2d, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
 	0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x72, 0x6d, 0x61, 0x69, 0x64, 0x44, 0x69, 0x61, 0x67, 0x72,
-	0x61, 0x6d, 0x46, 0x6f, 0x72, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x30, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e,
-	0x67, 0x2e, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x72, 0x6d, 0x61, 0x69, 0x64, 0x44, 0x69, 0x61, 0x67,
-	0x72, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x79, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x64, 0x6f,
-	0x6d, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74,
-	0x12, 0x2d, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e,
+	0x61, 0x6d, 0x46, 0x6f, 0x72, 0x4d, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2e, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e,
+	0x47, 0x65, 0x74, 0x4d, 0x65, 0x72, 0x6d, 0x61, 0x69, 0x64, 0x44, 0x69, 0x61, 0x67, 0x72, 0x61,
+	0x6d, 0x46, 0x6f, 0x72, 0x4d, 0x65, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x7f, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x4d, 0x65, 0x72, 0x6d, 0x61, 0x69, 0x64, 0x44, 0x69,
+	0x61, 0x67, 0x72, 0x61, 0x6d, 0x46, 0x6f, 0x72, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x12, 0x2f,
+	0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65,
+	0x74, 0x4d, 0x65, 0x72, 0x6d, 0x61, 0x69, 0x64, 0x44, 0x69, 0x61, 0x67, 0x72, 0x61, 0x6d, 0x46,
+	0x6f, 0x72, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x30, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47,
+	0x65, 0x74, 0x4d, 0x65, 0x72, 0x6d, 0x61, 0x69, 0x64, 0x44, 0x69, 0x61, 0x67, 0x72, 0x61, 0x6d,
+	0x46, 0x6f, 0x72, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x79, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x2d, 0x2e,
+	0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74,
+	0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65,
+	0x64, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x6d,
+	0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x52,
+	0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64,
+	0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x79, 0x0a, 0x18,
 	0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e,
-	0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x2e, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47,
-	0x65, 0x74, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67,
-	0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x79, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x56, 0x61, 0x6c, 0x69,
-	0x64, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2d, 0x2e, 0x6d, 0x65,
-	0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x61,
-	0x6e, 0x64, 0x6f, 0x6d, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d,
-	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x6d, 0x65, 0x61,
-	0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e,
-	0x64, 0x6f, 0x6d, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7c, 0x0a, 0x19, 0x47, 0x65,
-	0x74, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x72, 0x65, 0x70,
-	0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c,
-	0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d,
-	0x56, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c,
+	0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2d, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70,
+	0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x64, 0x6f,
+	0x6d, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c,
 	0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d,
-	0x56, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6d, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x52,
-	0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x56, 0x65, 0x73, 0x73, 0x65, 0x6c,
-	0x12, 0x29, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e,
-	0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x56, 0x65,
-	0x73, 0x73, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x6d, 0x65,
-	0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x61,
-	0x6e, 0x64, 0x6f, 0x6d, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x56, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x52, 0x65,
-	0x63, 0x69, 0x70, 0x65, 0x12, 0x1e, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e,
-	0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e,
-	0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x76, 0x0a, 0x17, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74,
-	0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x50, 0x72, 0x65, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x73,
-	0x12, 0x2c, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e,
-	0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x50, 0x72,
-	0x65, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d,
-	0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x45, 0x73,
-	0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x50, 0x72, 0x65, 0x70,
-	0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a,
-	0x11, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x50, 0x72, 0x65, 0x70, 0x54, 0x61,
-	0x73, 0x6b, 0x12, 0x26, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e,
-	0x67, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x50, 0x72, 0x65, 0x70, 0x54,
-	0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6d, 0x65, 0x61,
-	0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63,
-	0x69, 0x70, 0x65, 0x50, 0x72, 0x65, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65,
-	0x50, 0x72, 0x65, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x27, 0x2e, 0x6d, 0x65, 0x61, 0x6c,
-	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x69,
-	0x70, 0x65, 0x50, 0x72, 0x65, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e,
-	0x67, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x50, 0x72, 0x65, 0x70, 0x54,
-	0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x0f,
-	0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12,
-	0x24, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47,
-	0x65, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e,
-	0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x52, 0x61,
-	0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7c, 0x0a, 0x19,
-	0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x73,
-	0x46, 0x6f, 0x72, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x12, 0x2e, 0x2e, 0x6d, 0x65, 0x61, 0x6c,
-	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x69,
-	0x70, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x46, 0x6f, 0x72, 0x52, 0x65, 0x63, 0x69,
-	0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x6d, 0x65, 0x61, 0x6c,
-	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x69,
-	0x70, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x46, 0x6f, 0x72, 0x52, 0x65, 0x63, 0x69,
-	0x70, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x0d, 0x47, 0x65,
-	0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x12, 0x22, 0x2e, 0x6d, 0x65,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7c, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x52, 0x61,
+	0x6e, 0x64, 0x6f, 0x6d, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2e, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e,
+	0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e,
+	0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6d, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x64,
+	0x6f, 0x6d, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x56, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x12, 0x29, 0x2e,
+	0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74,
+	0x52, 0x61, 0x6e, 0x64, 0x6f, 0x6d, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x56, 0x65, 0x73, 0x73, 0x65,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70,
+	0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x61, 0x6e, 0x64, 0x6f,
+	0x6d, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x56, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4c, 0x0a, 0x09, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70,
+	0x65, 0x12, 0x1e, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
+	0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1f, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
+	0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x76, 0x0a, 0x17, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x63, 0x69, 0x70, 0x65, 0x50, 0x72, 0x65, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x2c, 0x2e,
+	0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x45, 0x73, 0x74,
+	0x69, 0x6d, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x50, 0x72, 0x65, 0x70, 0x54,
+	0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x6d, 0x65,
+	0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x45, 0x73, 0x74, 0x69, 0x6d,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x50, 0x72, 0x65, 0x70, 0x54, 0x61, 0x73,
+	0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x11, 0x47, 0x65,
+	0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x50, 0x72, 0x65, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x12,
+	0x26, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47,
+	0x65, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x50, 0x72, 0x65, 0x70, 0x54, 0x61, 0x73, 0x6b,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c,
+	0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65,
+	0x50, 0x72, 0x65, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x67, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x50, 0x72, 0x65,
+	0x70, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x27, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61,
+	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x50,
+	0x72, 0x65, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x28, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47,
+	0x65, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x50, 0x72, 0x65, 0x70, 0x54, 0x61, 0x73, 0x6b,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5e, 0x0a, 0x0f, 0x47, 0x65, 0x74,
+	0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x24, 0x2e, 0x6d,
+	0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x63, 0x69, 0x70, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e,
+	0x67, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e,
+	0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7c, 0x0a, 0x19, 0x47, 0x65, 0x74,
+	0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x46, 0x6f, 0x72,
+	0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x12, 0x2e, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61,
+	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x52,
+	0x61, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x46, 0x6f, 0x72, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61,
+	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x52,
+	0x61, 0x74, 0x69, 0x6e, 0x67, 0x73, 0x46, 0x6f, 0x72, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x11, 0x47, 0x65, 0x74, 0x52, 0x65,
+	0x63, 0x69, 0x70, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x2e, 0x6d,
+	0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x63, 0x69, 0x70, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e,
+	0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x52, 0x65, 0x76,
+	0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a,
+	0x12, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x27, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69,
+	0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x52, 0x65, 0x76, 0x69,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6d,
+	0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x63, 0x69, 0x70, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x58, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63,
+	0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x12, 0x22, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c,
+	0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65,
+	0x53, 0x74, 0x65, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6d, 0x65,
 	0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65,
-	0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x23, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47,
-	0x65, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x91, 0x01, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x69,
-	0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e,
-	0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x2e, 0x6d, 0x65, 0x61, 0x6c,
-	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x69,
+	0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x91, 0x01, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74,
+	0x65, 0x70, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e,
+	0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74,
+	0x65, 0x70, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x6d,
+	0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74,
+	0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x94, 0x01, 0x0a, 0x21, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x69,
 	0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e,
-	0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x36, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e,
-	0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x43, 0x6f, 0x6d,
-	0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x94, 0x01, 0x0a, 0x21, 0x47, 0x65, 0x74,
-	0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65,
-	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x36,
-	0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65,
-	0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x43, 0x6f, 0x6d, 0x70, 0x6c,
-	0x65, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61,
-	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53,
-	0x74, 0x65, 0x70, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e,
-	0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x76, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70,
-	0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x2c, 0x2e, 0x6d, 0x65, 0x61,
+	0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x36, 0x2e, 0x6d, 0x65, 0x61,
 	0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63,
-	0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70,
-	0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70,
-	0x65, 0x53, 0x74, 0x65, 0x70, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x79, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x52, 0x65,
-	0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65,
-	0x6e, 0x74, 0x73, 0x12, 0x2d, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69,
-	0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70,
-	0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e,
-	0x67, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x49,
-	0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x76, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53,
-	0x74, 0x65, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2c, 0x2e,
-	0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74,
-	0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75,
-	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x6d, 0x65,
-	0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65,
-	0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x79, 0x0a, 0x18, 0x47, 0x65,
-	0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x72,
-	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2d, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61,
+	0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f,
+	0x6e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x37, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e,
+	0x67, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x43,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x76, 0x0a, 0x17, 0x47,
+	0x65, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x49, 0x6e, 0x67, 0x72,
+	0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x2c, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61,
 	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53,
-	0x74, 0x65, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e,
-	0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74,
-	0x65, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6d, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x69,
-	0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x29, 0x2e,
-	0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74,
-	0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
-	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70,
-	0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70,
-	0x65, 0x53, 0x74, 0x65, 0x70, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x70, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70,
-	0x65, 0x53, 0x74, 0x65, 0x70, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x12, 0x2a, 0x2e,
-	0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74,
-	0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63,
-	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x6d, 0x65, 0x61, 0x6c,
+	0x74, 0x65, 0x70, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e,
+	0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65,
+	0x70, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x79, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65,
+	0x53, 0x74, 0x65, 0x70, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x12,
+	0x2d, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47,
+	0x65, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x49, 0x6e, 0x67, 0x72,
+	0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e,
+	0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65,
+	0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x49, 0x6e, 0x67, 0x72, 0x65,
+	0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x76,
+	0x0a, 0x17, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x49,
+	0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2c, 0x2e, 0x6d, 0x65, 0x61, 0x6c,
 	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x69,
-	0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6a, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63,
-	0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x56, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x12, 0x28, 0x2e,
-	0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74,
-	0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x56, 0x65, 0x73, 0x73, 0x65, 0x6c,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c,
+	0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c,
 	0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65,
-	0x53, 0x74, 0x65, 0x70, 0x56, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x6d, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53,
-	0x74, 0x65, 0x70, 0x56, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x73, 0x12, 0x29, 0x2e, 0x6d, 0x65, 0x61,
-	0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63,
-	0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x56, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e,
+	0x53, 0x74, 0x65, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x79, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63,
+	0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x73, 0x12, 0x2d, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e,
+	0x67, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x49,
+	0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2e, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
+	0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x49, 0x6e,
+	0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x6d, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74,
+	0x65, 0x70, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x29, 0x2e, 0x6d, 0x65, 0x61, 0x6c,
+	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x69,
+	0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e,
+	0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65,
+	0x70, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x70, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65,
+	0x70, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x12, 0x2a, 0x2e, 0x6d, 0x65, 0x61, 0x6c,
+	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x69,
+	0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e,
 	0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74,
-	0x65, 0x70, 0x56, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x5b, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74,
-	0x65, 0x70, 0x73, 0x12, 0x23, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69,
-	0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70,
-	0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70,
-	0x65, 0x53, 0x74, 0x65, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b,
-	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x73,
-	0x12, 0x23, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e,
-	0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e,
-	0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x4c, 0x69,
-	0x73, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x0a, 0x47,
-	0x65, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x73, 0x12, 0x1f, 0x2e, 0x6d, 0x65, 0x61, 0x6c,
+	0x65, 0x70, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x6a, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53,
+	0x74, 0x65, 0x70, 0x56, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x12, 0x28, 0x2e, 0x6d, 0x65, 0x61, 0x6c,
 	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x69,
-	0x70, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6d, 0x65, 0x61,
-	0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63,
-	0x69, 0x70, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a, 0x12,
-	0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65,
-	0x6e, 0x74, 0x12, 0x27, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e,
-	0x67, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64,
-	0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6d, 0x65,
-	0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61,
-	0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x76, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69,
-	0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x12, 0x2c, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e,
-	0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65,
-	0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d,
-	0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65,
-	0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74,
-	0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x79, 0x0a,
-	0x18, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69,
-	0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x2d, 0x2e, 0x6d, 0x65, 0x61, 0x6c,
-	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69,
-	0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70,
-	0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64,
-	0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x94, 0x01, 0x0a, 0x21, 0x47, 0x65, 0x74,
-	0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x4d,
-	0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x36,
+	0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x56, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69,
+	0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70,
+	0x56, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6d,
+	0x0a, 0x14, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x56,
+	0x65, 0x73, 0x73, 0x65, 0x6c, 0x73, 0x12, 0x29, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61,
+	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53,
+	0x74, 0x65, 0x70, 0x56, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2a, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
+	0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x56, 0x65,
+	0x73, 0x73, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a,
+	0x0e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x73, 0x12,
+	0x23, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47,
+	0x65, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e,
+	0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65,
+	0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x0e, 0x47, 0x65,
+	0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x73, 0x12, 0x23, 0x2e, 0x6d,
+	0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x52,
+	0x65, 0x63, 0x69, 0x70, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x24, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
+	0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4f, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x52, 0x65,
+	0x63, 0x69, 0x70, 0x65, 0x73, 0x12, 0x1f, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e,
+	0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61,
+	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x27,
 	0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65,
 	0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74,
-	0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61,
-	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e,
-	0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d,
-	0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x97, 0x01, 0x0a, 0x22, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72,
-	0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e,
-	0x74, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x12, 0x37, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61,
-	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e,
-	0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d,
-	0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x38, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47,
-	0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e,
-	0x74, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0xbb, 0x01, 0x0a, 0x2e, 0x47, 0x65,
-	0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74,
-	0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x73,
-	0x42, 0x79, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x43, 0x2e, 0x6d,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c,
+	0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49,
+	0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x76, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67,
+	0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x2c, 0x2e, 0x6d,
 	0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x56,
-	0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x65,
-	0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x42, 0x79,
-	0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x44, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
-	0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69,
-	0x65, 0x6e, 0x74, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e,
-	0x69, 0x74, 0x73, 0x42, 0x79, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0xca, 0x01, 0x0a, 0x33, 0x47, 0x65, 0x74, 0x56,
-	0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x65,
-	0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x42, 0x79,
-	0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x12,
-	0x48, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47,
-	0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e,
-	0x74, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74,
-	0x73, 0x42, 0x79, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e,
-	0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x49, 0x2e, 0x6d, 0x65, 0x61, 0x6c,
-	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69,
+	0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x47, 0x72,
+	0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x6d, 0x65, 0x61,
+	0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x79, 0x0a, 0x18, 0x47, 0x65, 0x74,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x47,
+	0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x2d, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e,
+	0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67,
+	0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e,
+	0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72,
+	0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x94, 0x01, 0x0a, 0x21, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69,
 	0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x61, 0x73, 0x75,
-	0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x42, 0x79, 0x4d, 0x65, 0x61,
-	0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x36, 0x2e, 0x6d, 0x65, 0x61,
+	0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x61, 0x73,
+	0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x37, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e,
+	0x67, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64,
+	0x69, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55,
+	0x6e, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x97, 0x01, 0x0a, 0x22,
+	0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65,
+	0x6e, 0x74, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69,
+	0x74, 0x73, 0x12, 0x37, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e,
+	0x67, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64,
+	0x69, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55,
+	0x6e, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x6d, 0x65,
+	0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x61,
+	0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0xbb, 0x01, 0x0a, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x61, 0x73,
+	0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x42, 0x79, 0x49, 0x6e,
+	0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x43, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70,
+	0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x42, 0x79, 0x49, 0x6e, 0x67, 0x72,
+	0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x44, 0x2e,
+	0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x4d,
+	0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x42,
+	0x79, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0xca, 0x01, 0x0a, 0x33, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x42, 0x79, 0x4d, 0x65, 0x61, 0x73,
+	0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x48, 0x2e, 0x6d, 0x65,
+	0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x61,
+	0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x42, 0x79, 0x4d,
+	0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x49, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e,
+	0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67,
+	0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x42, 0x79, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65,
+	0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x91, 0x01, 0x0a, 0x20, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67,
+	0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x4e, 0x75, 0x74, 0x72, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x46, 0x61, 0x63, 0x74, 0x73, 0x12, 0x35, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e,
+	0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67,
+	0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x4e, 0x75, 0x74, 0x72, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+	0x46, 0x61, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x6d,
+	0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x4e, 0x75,
+	0x74, 0x72, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x61, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70,
 	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x88, 0x01, 0x0a, 0x1d, 0x47, 0x65, 0x74, 0x56, 0x61, 0x6c, 0x69,
 	0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x65, 0x70, 0x61,
 	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x32, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61,
@@ -1281,559 +1418,633 @@
 	0x73, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6d, 0x65,
 	0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x56, 0x61,
 	0x6c, 0x69, 0x64, 0x56, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x7c, 0x0a, 0x19, 0x52, 0x75, 0x6e, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a,
-	0x65, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12,
-	0x2e, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x52,
-	0x75, 0x6e, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c,
-	0x61, 0x6e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x2f, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x52,
-	0x75, 0x6e, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c,
-	0x61, 0x6e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0xa6, 0x01, 0x0a, 0x27, 0x52, 0x75, 0x6e, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e,
-	0x47, 0x72, 0x6f, 0x63, 0x65, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x69, 0x74, 0x69,
-	0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x3c, 0x2e, 0x6d,
+	0x73, 0x65, 0x12, 0x70, 0x0a, 0x15, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65, 0x63,
+	0x69, 0x70, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x2e, 0x6d, 0x65,
+	0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c,
+	0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x52, 0x65,
+	0x63, 0x69, 0x70, 0x65, 0x52, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7c, 0x0a, 0x19, 0x52, 0x75, 0x6e, 0x46, 0x69, 0x6e, 0x61, 0x6c,
+	0x69, 0x7a, 0x65, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x57, 0x6f, 0x72, 0x6b, 0x65,
+	0x72, 0x12, 0x2e, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
+	0x2e, 0x52, 0x75, 0x6e, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x4d, 0x65, 0x61, 0x6c,
+	0x50, 0x6c, 0x61, 0x6e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2f, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
+	0x2e, 0x52, 0x75, 0x6e, 0x46, 0x69, 0x6e, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x4d, 0x65, 0x61, 0x6c,
+	0x50, 0x6c, 0x61, 0x6e, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0xa6, 0x01, 0x0a, 0x27, 0x52, 0x75, 0x6e, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c,
+	0x61, 0x6e, 0x47, 0x72, 0x6f, 0x63, 0x65, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x69,
+	0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x3c,
+	0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x75,
+	0x6e, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x47, 0x72, 0x6f, 0x63, 0x65, 0x72, 0x79,
+	0x4c, 0x69, 0x73, 0x74, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x57,
+	0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3d, 0x2e, 0x6d,
 	0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x75, 0x6e, 0x4d,
 	0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x47, 0x72, 0x6f, 0x63, 0x65, 0x72, 0x79, 0x4c, 0x69,
 	0x73, 0x74, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x57, 0x6f, 0x72,
-	0x6b, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3d, 0x2e, 0x6d, 0x65, 0x61,
-	0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x75, 0x6e, 0x4d, 0x65, 0x61,
-	0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x47, 0x72, 0x6f, 0x63, 0x65, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74,
-	0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x65,
-	0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x85, 0x01, 0x0a, 0x1c, 0x52, 0x75,
-	0x6e, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x6f, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x31, 0x2e, 0x6d, 0x65, 0x61,
-	0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x75, 0x6e, 0x4d, 0x65, 0x61,
-	0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x43, 0x72, 0x65, 0x61, 0x74, 0x6f, 0x72,
-	0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e,
-	0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x75, 0x6e,
-	0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x6f, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x5b, 0x0a, 0x0e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x6f, 0x72, 0x4d, 0x65,
-	0x61, 0x6c, 0x73, 0x12, 0x23, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69,
-	0x6e, 0x67, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x6f, 0x72, 0x4d, 0x65, 0x61, 0x6c,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70,
-	0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x6f,
-	0x72, 0x4d, 0x65, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61,
-	0x0a, 0x10, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x6f, 0x72, 0x52, 0x65, 0x63, 0x69, 0x70,
-	0x65, 0x73, 0x12, 0x25, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e,
-	0x67, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x6f, 0x72, 0x52, 0x65, 0x63, 0x69, 0x70,
-	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6d, 0x65, 0x61, 0x6c,
-	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46,
-	0x6f, 0x72, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x85, 0x01, 0x0a, 0x1c, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x6f, 0x72, 0x4d,
-	0x65, 0x61, 0x6c, 0x45, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70,
-	0x65, 0x73, 0x12, 0x31, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e,
-	0x67, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x6f, 0x72, 0x4d, 0x65, 0x61, 0x6c, 0x45,
-	0x6c, 0x69, 0x67, 0x69, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x73, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e,
+	0x6b, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x85, 0x01, 0x0a, 0x1c,
+	0x52, 0x75, 0x6e, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x6f, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x12, 0x31, 0x2e, 0x6d,
+	0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x52, 0x75, 0x6e, 0x4d,
+	0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x6f, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x32, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x52,
+	0x75, 0x6e, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x6f, 0x72, 0x57, 0x6f, 0x72, 0x6b, 0x65, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x0e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x6f, 0x72,
+	0x4d, 0x65, 0x61, 0x6c, 0x73, 0x12, 0x23, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e,
 	0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x6f, 0x72, 0x4d, 0x65,
-	0x61, 0x6c, 0x45, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0xa6, 0x01, 0x0a, 0x27, 0x53, 0x65,
-	0x61, 0x72, 0x63, 0x68, 0x46, 0x6f, 0x72, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x73, 0x57, 0x69,
-	0x74, 0x68, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x77, 0x6e, 0x65,
-	0x72, 0x73, 0x68, 0x69, 0x70, 0x12, 0x3c, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e,
+	0x61, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6d, 0x65, 0x61,
+	0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x46, 0x6f, 0x72, 0x4d, 0x65, 0x61, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x61, 0x0a, 0x10, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x6f, 0x72, 0x52, 0x65, 0x63,
+	0x69, 0x70, 0x65, 0x73, 0x12, 0x25, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e,
+	0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x6f, 0x72, 0x52, 0x65, 0x63,
+	0x69, 0x70, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6d, 0x65,
+	0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x46, 0x6f, 0x72, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x85, 0x01, 0x0a, 0x1c, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x6f,
+	0x72, 0x4d, 0x65, 0x61, 0x6c, 0x45, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x63,
+	0x69, 0x70, 0x65, 0x73, 0x12, 0x31, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e,
+	0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x6f, 0x72, 0x4d, 0x65, 0x61,
+	0x6c, 0x45, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c,
+	0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x6f, 0x72,
+	0x4d, 0x65, 0x61, 0x6c, 0x45, 0x6c, 0x69, 0x67, 0x69, 0x62, 0x6c, 0x65, 0x52, 0x65, 0x63, 0x69,
+	0x70, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0xa6, 0x01, 0x0a, 0x27,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x6f, 0x72, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x73,
+	0x57, 0x69, 0x74, 0x68, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x77,
+	0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x12, 0x3c, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c,
+	0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x6f, 0x72,
+	0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x73, 0x57, 0x69, 0x74, 0x68, 0x49, 0x6e, 0x73, 0x74, 0x72,
+	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3d, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e,
 	0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x6f, 0x72, 0x52, 0x65,
 	0x63, 0x69, 0x70, 0x65, 0x73, 0x57, 0x69, 0x74, 0x68, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d,
-	0x65, 0x6e, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x3d, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69,
-	0x6e, 0x67, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x6f, 0x72, 0x52, 0x65, 0x63, 0x69,
-	0x70, 0x65, 0x73, 0x57, 0x69, 0x74, 0x68, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e,
-	0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x8b, 0x01, 0x0a, 0x1e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x6f, 0x72,
+	0x65, 0x6e, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8b, 0x01, 0x0a, 0x1e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46,
+	0x6f, 0x72, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e,
+	0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x33, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c,
+	0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x6f, 0x72,
 	0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x47,
-	0x72, 0x6f, 0x75, 0x70, 0x73, 0x12, 0x33, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e,
+	0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x6d,
+	0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x61, 0x72,
+	0x63, 0x68, 0x46, 0x6f, 0x72, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64,
+	0x69, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x8b, 0x01, 0x0a, 0x1e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x6f, 0x72,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x53,
+	0x74, 0x61, 0x74, 0x65, 0x73, 0x12, 0x33, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e,
 	0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x6f, 0x72, 0x56, 0x61,
-	0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f,
-	0x75, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x6d, 0x65, 0x61,
+	0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61,
+	0x74, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x6d, 0x65, 0x61,
 	0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
 	0x46, 0x6f, 0x72, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65,
-	0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x8b, 0x01, 0x0a, 0x1e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x6f, 0x72, 0x56, 0x61,
-	0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x73, 0x12, 0x33, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69,
-	0x6e, 0x67, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x6f, 0x72, 0x56, 0x61, 0x6c, 0x69,
-	0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70,
-	0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x6f,
-	0x72, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74,
-	0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7c,
+	0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x7c, 0x0a, 0x19, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x6f, 0x72, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2e, 0x2e,
+	0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x46, 0x6f, 0x72, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65,
+	0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e,
+	0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x46, 0x6f, 0x72, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65,
+	0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7c,
 	0x0a, 0x19, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x6f, 0x72, 0x56, 0x61, 0x6c, 0x69, 0x64,
-	0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2e, 0x2e, 0x6d, 0x65,
+	0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2e, 0x2e, 0x6d, 0x65,
 	0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x46, 0x6f, 0x72, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69,
+	0x68, 0x46, 0x6f, 0x72, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d,
 	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x6d, 0x65,
 	0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63,
-	0x68, 0x46, 0x6f, 0x72, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69,
-	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7c, 0x0a, 0x19,
-	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x6f, 0x72, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e,
-	0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2e, 0x2e, 0x6d, 0x65, 0x61, 0x6c,
-	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46,
-	0x6f, 0x72, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e,
-	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x6d, 0x65, 0x61, 0x6c,
-	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46,
-	0x6f, 0x72, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e,
-	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8b, 0x01, 0x0a, 0x1e, 0x53,
+	0x68, 0x46, 0x6f, 0x72, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8b, 0x01, 0x0a,
+	0x1e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x6f, 0x72, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d,
+	0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x12,
+	0x33, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x53,
 	0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x6f, 0x72, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x65, 0x61,
-	0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x12, 0x33, 0x2e,
-	0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x61,
-	0x72, 0x63, 0x68, 0x46, 0x6f, 0x72, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x65, 0x61, 0x73, 0x75,
-	0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x34, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e,
-	0x67, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x6f, 0x72, 0x56, 0x61, 0x6c, 0x69, 0x64,
-	0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7f, 0x0a, 0x1a, 0x53, 0x65, 0x61, 0x72,
-	0x63, 0x68, 0x46, 0x6f, 0x72, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2f, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61,
-	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x6f, 0x72, 0x56,
-	0x61, 0x6c, 0x69, 0x64, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c,
-	0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x6f, 0x72,
-	0x56, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x70, 0x0a, 0x15, 0x53, 0x65, 0x61,
-	0x72, 0x63, 0x68, 0x46, 0x6f, 0x72, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x56, 0x65, 0x73, 0x73, 0x65,
-	0x6c, 0x73, 0x12, 0x2a, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e,
-	0x67, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x6f, 0x72, 0x56, 0x61, 0x6c, 0x69, 0x64,
-	0x56, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b,
-	0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65,
-	0x61, 0x72, 0x63, 0x68, 0x46, 0x6f, 0x72, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x56, 0x65, 0x73, 0x73,
-	0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x9a, 0x01, 0x0a, 0x23,
-	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65,
-	0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x12, 0x38, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69,
-	0x6e, 0x67, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e,
-	0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x50, 0x72, 0x65, 0x70, 0x61,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x39, 0x2e,
-	0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x61,
-	0x72, 0x63, 0x68, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65,
-	0x6e, 0x74, 0x73, 0x42, 0x79, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0xa6, 0x01, 0x0a, 0x27, 0x53, 0x65, 0x61,
-	0x72, 0x63, 0x68, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d,
-	0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x42, 0x79, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64,
-	0x69, 0x65, 0x6e, 0x74, 0x12, 0x3c, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e,
+	0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e,
+	0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x6f, 0x72, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69,
+	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7f, 0x0a, 0x1a, 0x53, 0x65,
+	0x61, 0x72, 0x63, 0x68, 0x46, 0x6f, 0x72, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x72, 0x65, 0x70,
+	0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2f, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70,
+	0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x6f,
+	0x72, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x6d, 0x65, 0x61, 0x6c,
+	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46,
+	0x6f, 0x72, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x70, 0x0a, 0x15, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x6f, 0x72, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x56, 0x65, 0x73,
+	0x73, 0x65, 0x6c, 0x73, 0x12, 0x2a, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e,
+	0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x6f, 0x72, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x56, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2b, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e,
+	0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x6f, 0x72, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x56, 0x65,
+	0x73, 0x73, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x9a, 0x01,
+	0x0a, 0x23, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67,
+	0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e,
+	0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x50, 0x72, 0x65,
+	0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x39, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64,
+	0x69, 0x65, 0x6e, 0x74, 0x73, 0x42, 0x79, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0xa6, 0x01, 0x0a, 0x27, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x42, 0x79, 0x49, 0x6e, 0x67, 0x72,
+	0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x3c, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61,
+	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74,
+	0x73, 0x42, 0x79, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x3d, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e,
 	0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d,
 	0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x42,
-	0x79, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x3d, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e,
-	0x67, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x65, 0x61,
-	0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x73, 0x42, 0x79, 0x49,
-	0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x5b, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x61, 0x6c, 0x50,
-	0x6c, 0x61, 0x6e, 0x12, 0x23, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69,
-	0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70,
-	0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65,
-	0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6a,
-	0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e,
-	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x28, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e,
+	0x79, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x61,
+	0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x23, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e,
 	0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x61, 0x6c, 0x50,
-	0x6c, 0x61, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x29, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x45, 0x76, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a, 0x12, 0x53, 0x77,
-	0x61, 0x70, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73,
-	0x12, 0x27, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e,
+	0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6d, 0x65, 0x61,
+	0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x6a, 0x0a, 0x13, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c,
+	0x61, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x28, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c,
+	0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x61,
+	0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x29, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a, 0x12,
 	0x53, 0x77, 0x61, 0x70, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x45, 0x76, 0x65, 0x6e,
-	0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6d, 0x65, 0x61, 0x6c,
-	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x4d, 0x65, 0x61,
-	0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x88, 0x01, 0x0a, 0x1d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65,
-	0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x47, 0x72, 0x6f, 0x63, 0x65, 0x72, 0x79, 0x4c, 0x69, 0x73,
-	0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x32, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e,
-	0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x61, 0x6c, 0x50,
-	0x6c, 0x61, 0x6e, 0x47, 0x72, 0x6f, 0x63, 0x65, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74,
-	0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x6d, 0x65, 0x61, 0x6c,
-	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d,
-	0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x47, 0x72, 0x6f, 0x63, 0x65, 0x72, 0x79, 0x4c, 0x69,
-	0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6d,
-	0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e,
-	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61,
-	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x61, 0x6c,
-	0x50, 0x6c, 0x61, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x2a, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
-	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x4f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x79, 0x0a,
-	0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x4f,
-	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x2d, 0x2e, 0x6d, 0x65, 0x61, 0x6c,
-	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d,
-	0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x6f, 0x74,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70,
+	0x74, 0x73, 0x12, 0x27, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e,
+	0x67, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x45, 0x76,
+	0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6d, 0x65,
+	0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x4d,
+	0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x88, 0x01, 0x0a, 0x1d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x47, 0x72, 0x6f, 0x63, 0x65, 0x72, 0x79, 0x4c,
+	0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x32, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c,
+	0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x61,
+	0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x47, 0x72, 0x6f, 0x63, 0x65, 0x72, 0x79, 0x4c, 0x69, 0x73, 0x74,
+	0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x6d, 0x65,
+	0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x47, 0x72, 0x6f, 0x63, 0x65, 0x72, 0x79,
+	0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x6d, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c,
+	0x61, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x29, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70,
 	0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65,
-	0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x6f, 0x74, 0x65,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x9a, 0x01, 0x0a, 0x23, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x63, 0x69, 0x70,
-	0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x12, 0x38, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65,
-	0x63, 0x69, 0x70, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x39, 0x2e, 0x6d, 0x65, 0x61,
+	0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69,
+	0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61,
+	0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x79, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61,
+	0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x6f, 0x74, 0x65, 0x12, 0x2d, 0x2e, 0x6d, 0x65,
+	0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x56,
+	0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x6d, 0x65, 0x61,
 	0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x4f, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x79, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d,
-	0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75,
-	0x73, 0x12, 0x2d, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
-	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x54,
-	0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x2e, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x54, 0x61,
-	0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x5b, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x61, 0x6c, 0x4c, 0x69,
-	0x73, 0x74, 0x12, 0x23, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e,
-	0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c,
-	0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x61,
-	0x6c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a,
-	0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x49,
-	0x74, 0x65, 0x6d, 0x12, 0x27, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69,
-	0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x61, 0x6c, 0x4c, 0x69, 0x73,
-	0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6d,
+	0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x6f,
+	0x74, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x9a, 0x01, 0x0a, 0x23, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x63,
+	0x69, 0x70, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x38, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e,
+	0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e,
+	0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x39, 0x2e, 0x6d,
 	0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x4d, 0x65, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x12, 0x21, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61,
-	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69,
-	0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x6d, 0x65, 0x61, 0x6c,
-	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
-	0x65, 0x63, 0x69, 0x70, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a,
-	0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x27, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69,
-	0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53,
-	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6d,
+	0x74, 0x65, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x79, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x12, 0x2d, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69,
+	0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61,
+	0x6e, 0x54, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e,
+	0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e,
+	0x54, 0x61, 0x73, 0x6b, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x73, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x61, 0x6c,
+	0x50, 0x6c, 0x61, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x12, 0x2b, 0x2e, 0x6d,
 	0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6d, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x50, 0x72, 0x65, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x29,
+	0x74, 0x65, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6d, 0x65, 0x61, 0x6c,
+	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d,
+	0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x54, 0x65, 0x6d, 0x70, 0x6c, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x0e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x4d, 0x65, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x23, 0x2e, 0x6d, 0x65, 0x61, 0x6c,
+	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d,
+	0x65, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24,
 	0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x50, 0x72, 0x65, 0x70, 0x54, 0x61,
-	0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x6d, 0x65, 0x61, 0x6c,
-	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
-	0x65, 0x63, 0x69, 0x70, 0x65, 0x50, 0x72, 0x65, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
-	0x65, 0x63, 0x69, 0x70, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x12, 0x27, 0x2e, 0x6d, 0x65,
+	0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65,
+	0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x27, 0x2e, 0x6d, 0x65, 0x61,
+	0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x4d, 0x65, 0x61, 0x6c, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69,
+	0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x61, 0x6c, 0x4c, 0x69, 0x73,
+	0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x55, 0x0a,
+	0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x12, 0x21, 0x2e,
+	0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x22, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x27, 0x2e, 0x6d, 0x65, 0x61,
+	0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69,
+	0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6d, 0x0a,
+	0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x50, 0x72, 0x65,
+	0x70, 0x54, 0x61, 0x73, 0x6b, 0x12, 0x29, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e,
+	0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70,
+	0x65, 0x50, 0x72, 0x65, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2a, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x50, 0x72, 0x65, 0x70,
+	0x54, 0x61, 0x73, 0x6b, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a, 0x12,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x52, 0x61, 0x74, 0x69,
+	0x6e, 0x67, 0x12, 0x27, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e,
+	0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x52, 0x61,
+	0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6d, 0x65,
 	0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e,
-	0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65,
-	0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61,
-	0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x4c, 0x69,
-	0x73, 0x74, 0x12, 0x25, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e,
-	0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x4c, 0x69,
-	0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6d, 0x65, 0x61, 0x6c,
+	0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x63, 0x69, 0x70, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x12, 0x25, 0x2e, 0x6d, 0x65, 0x61, 0x6c,
 	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
-	0x65, 0x63, 0x69, 0x70, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x6d, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70,
-	0x65, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x29, 0x2e, 0x6d, 0x65, 0x61, 0x6c,
-	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
-	0x65, 0x63, 0x69, 0x70, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e,
-	0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65,
-	0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x61, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65,
-	0x53, 0x74, 0x65, 0x70, 0x12, 0x25, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e,
-	0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65,
-	0x53, 0x74, 0x65, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6d, 0x65,
+	0x65, 0x63, 0x69, 0x70, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x26, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x4c, 0x69, 0x73, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6d, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d,
+	0x12, 0x29, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x4c, 0x69, 0x73, 0x74,
+	0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x6d, 0x65,
 	0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x9a, 0x01, 0x0a, 0x23, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65,
-	0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69,
-	0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x2e, 0x6d, 0x65,
+	0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x4c, 0x69, 0x73, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x12, 0x25, 0x2e, 0x6d, 0x65,
 	0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x43, 0x6f, 0x6d, 0x70, 0x6c,
-	0x65, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x39, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e,
+	0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e,
+	0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74,
+	0x65, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x9a, 0x01, 0x0a, 0x23, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x43,
+	0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x38, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e,
+	0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74,
+	0x65, 0x70, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x64,
+	0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x39, 0x2e, 0x6d,
+	0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x43, 0x6f, 0x6d, 0x70,
+	0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7f, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x49, 0x6e, 0x67, 0x72, 0x65,
+	0x64, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x2f, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e,
 	0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70,
-	0x65, 0x53, 0x74, 0x65, 0x70, 0x43, 0x6f, 0x6d, 0x70, 0x6c, 0x65, 0x74, 0x69, 0x6f, 0x6e, 0x43,
-	0x6f, 0x6e, 0x64, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x7f, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65,
-	0x53, 0x74, 0x65, 0x70, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x2f,
-	0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x49, 0x6e,
-	0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x30, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x49,
-	0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x7f, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70,
-	0x65, 0x53, 0x74, 0x65, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12,
-	0x2f, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x49,
-	0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x30, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70,
-	0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x76, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69,
-	0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x2c, 0x2e,
-	0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64,
+	0x65, 0x53, 0x74, 0x65, 0x70, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61,
+	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69,
+	0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7f, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x49, 0x6e, 0x73, 0x74,
+	0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2f, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61,
+	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69,
+	0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c,
+	0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63,
+	0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x76, 0x0a, 0x17, 0x55, 0x70, 0x64,
 	0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x50, 0x72, 0x6f,
-	0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x6d, 0x65,
-	0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x50, 0x72, 0x6f, 0x64, 0x75,
-	0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x73, 0x0a, 0x16, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x56, 0x65,
-	0x73, 0x73, 0x65, 0x6c, 0x12, 0x2b, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e,
+	0x64, 0x75, 0x63, 0x74, 0x12, 0x2c, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e,
 	0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65,
-	0x53, 0x74, 0x65, 0x70, 0x56, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x2c, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
-	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65,
-	0x70, 0x56, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x70, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e,
-	0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x2a, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70,
-	0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x61,
-	0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e,
-	0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49,
-	0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x7f, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64,
-	0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12,
-	0x2f, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55,
+	0x53, 0x74, 0x65, 0x70, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e,
+	0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74,
+	0x65, 0x70, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x73, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70,
+	0x65, 0x53, 0x74, 0x65, 0x70, 0x56, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x12, 0x2b, 0x2e, 0x6d, 0x65,
+	0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x56, 0x65, 0x73, 0x73, 0x65,
+	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70,
+	0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x56, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x70, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x12,
+	0x2a, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55,
 	0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64,
-	0x69, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x30, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65,
-	0x64, 0x69, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x9d, 0x01, 0x0a, 0x24, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c,
-	0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x61, 0x73,
-	0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x39, 0x2e, 0x6d, 0x65,
+	0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x6d, 0x65,
 	0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
 	0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74,
-	0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7f, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e,
+	0x74, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x12, 0x2f, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61,
 	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69,
-	0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x61, 0x73, 0x75,
-	0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x91, 0x01, 0x0a, 0x20, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c,
-	0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x65, 0x70,
-	0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c,
+	0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75, 0x70,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c,
 	0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c,
-	0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x65, 0x70,
-	0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36,
-	0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70,
+	0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x47, 0x72, 0x6f, 0x75,
+	0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x9d, 0x01, 0x0a, 0x24, 0x55, 0x70,
 	0x64, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69,
-	0x65, 0x6e, 0x74, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7c, 0x0a, 0x19, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x56, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x72, 0x65, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x12, 0x2e, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69,
-	0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x72,
-	0x65, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69,
-	0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x72,
-	0x65, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7f, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x61,
-	0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61,
-	0x74, 0x65, 0x12, 0x2f, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e,
+	0x65, 0x6e, 0x74, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e,
+	0x69, 0x74, 0x12, 0x39, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e,
 	0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67,
-	0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69,
-	0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e,
-	0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x9d, 0x01, 0x0a, 0x24, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x53,
-	0x74, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x39,
-	0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70,
+	0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65,
+	0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e,
+	0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65,
+	0x6e, 0x74, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x9a, 0x01, 0x0a, 0x23, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69,
+	0x65, 0x6e, 0x74, 0x4e, 0x75, 0x74, 0x72, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x61, 0x63, 0x74,
+	0x73, 0x12, 0x38, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72,
+	0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x4e, 0x75, 0x74, 0x72, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x46,
+	0x61, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x39, 0x2e, 0x6d, 0x65,
+	0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74,
+	0x4e, 0x75, 0x74, 0x72, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x46, 0x61, 0x63, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x91, 0x01, 0x0a, 0x20, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74,
+	0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x2e, 0x6d, 0x65,
+	0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74,
+	0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x36, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e,
+	0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67,
+	0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7c, 0x0a, 0x19, 0x55, 0x70,
+	0x64, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x72, 0x65, 0x70, 0x54, 0x61, 0x73,
+	0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x12, 0x2e, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c,
+	0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x50, 0x72, 0x65, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c,
+	0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x50, 0x72, 0x65, 0x70, 0x54, 0x61, 0x73, 0x6b, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7f, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e,
+	0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x2f, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61,
+	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c,
+	0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c,
+	0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x9d, 0x01, 0x0a, 0x24, 0x55, 0x70,
 	0x64, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69,
 	0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x6d, 0x65, 0x61, 0x6c,
-	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56,
-	0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x70, 0x0a, 0x15, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56,
-	0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x2a,
+	0x6e, 0x74, 0x12, 0x39, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e,
+	0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67,
+	0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x67, 0x72,
+	0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e,
+	0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65,
+	0x6e, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x70, 0x0a, 0x15, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65,
+	0x6e, 0x74, 0x12, 0x2a, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e,
+	0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x73,
+	0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b,
 	0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70,
 	0x64, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d,
-	0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x6d, 0x65, 0x61,
+	0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7f, 0x0a, 0x1a, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72,
+	0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x2f, 0x2e, 0x6d, 0x65, 0x61, 0x6c,
+	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55,
+	0x6e, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x6d, 0x65, 0x61,
 	0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7f, 0x0a, 0x1a, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e,
-	0x74, 0x55, 0x6e, 0x69, 0x74, 0x12, 0x2f, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e,
-	0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64,
-	0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61,
-	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69,
-	0x64, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x9d, 0x01, 0x0a, 0x24, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d,
-	0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x12, 0x39, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
-	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x65, 0x61, 0x73,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74,
+	0x55, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x9d, 0x01, 0x0a,
+	0x24, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x65, 0x61, 0x73,
 	0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65,
-	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3a, 0x2e, 0x6d,
-	0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65,
-	0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x73, 0x0a, 0x16, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x12, 0x2b, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e,
-	0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x72, 0x65,
-	0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x2c, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x91, 0x01,
-	0x0a, 0x20, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x72, 0x65,
-	0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65,
-	0x6e, 0x74, 0x12, 0x35, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e,
-	0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x72, 0x65,
-	0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65,
-	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x6d, 0x65, 0x61, 0x6c,
-	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56,
-	0x61, 0x6c, 0x69, 0x64, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49,
-	0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x85, 0x01, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69,
-	0x64, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x65, 0x73, 0x73,
-	0x65, 0x6c, 0x12, 0x31, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e,
-	0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x72, 0x65,
-	0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x39, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e,
 	0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64,
-	0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x65, 0x73, 0x73, 0x65,
-	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x11, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x56, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x12, 0x26,
-	0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70,
-	0x64, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x56, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61,
+	0x4d, 0x65, 0x61, 0x73, 0x75, 0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x43,
+	0x6f, 0x6e, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x3a, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x4d, 0x65, 0x61, 0x73, 0x75,
+	0x72, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x55, 0x6e, 0x69, 0x74, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x73, 0x0a, 0x16,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x72, 0x65, 0x70, 0x61,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2b, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61,
 	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69,
-	0x64, 0x56, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x94, 0x01, 0x0a, 0x21, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x77, 0x6e, 0x65,
-	0x72, 0x73, 0x68, 0x69, 0x70, 0x12, 0x36, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e,
-	0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x77, 0x6e,
-	0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e,
-	0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x72, 0x63,
-	0x68, 0x69, 0x76, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x72,
-	0x75, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8e, 0x01, 0x0a, 0x1f, 0x41, 0x72, 0x63, 0x68, 0x69,
-	0x76, 0x65, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74,
-	0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x34, 0x2e, 0x6d, 0x65, 0x61,
-	0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76,
-	0x65, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x50,
-	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x35, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e,
-	0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x67, 0x72, 0x65,
-	0x64, 0x69, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x91, 0x01, 0x0a, 0x20, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d,
-	0x65, 0x6e, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x12, 0x35, 0x2e, 0x6d,
-	0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d,
+	0x64, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69,
+	0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x72,
+	0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x91, 0x01, 0x0a, 0x20, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x73, 0x74,
+	0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x35, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61,
+	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x73, 0x74,
+	0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e,
+	0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x85, 0x01, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x56, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x12, 0x31, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61,
+	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69,
+	0x64, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56, 0x65, 0x73, 0x73,
+	0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x6d, 0x65, 0x61, 0x6c,
+	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x56,
+	0x65, 0x73, 0x73, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a,
+	0x11, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x56, 0x65, 0x73, 0x73,
+	0x65, 0x6c, 0x12, 0x26, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e,
+	0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x56, 0x65, 0x73,
+	0x73, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6d, 0x65, 0x61,
+	0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x56, 0x61, 0x6c, 0x69, 0x64, 0x56, 0x65, 0x73, 0x73, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x94, 0x01, 0x0a, 0x21, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74,
+	0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x12, 0x36, 0x2e, 0x6d, 0x65, 0x61, 0x6c,
+	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x37, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
+	0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49,
+	0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68,
+	0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8e, 0x01, 0x0a, 0x1f, 0x41,
+	0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64,
+	0x69, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x34,
+	0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x72,
+	0x63, 0x68, 0x69, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69,
+	0x65, 0x6e, 0x74, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e,
+	0x69, 0x6e, 0x67, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x91, 0x01, 0x0a, 0x20,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x73,
+	0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70,
+	0x12, 0x35, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x73,
+	0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c,
+	0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x77,
+	0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x8b, 0x01, 0x0a, 0x1e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e,
+	0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x12, 0x33, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e,
+	0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x67, 0x72,
+	0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c,
+	0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65,
+	0x72, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x88, 0x01,
+	0x0a, 0x1d, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x73, 0x74,
+	0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x12,
+	0x32, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47,
+	0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d,
 	0x65, 0x6e, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69,
-	0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
-	0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73,
-	0x68, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8b, 0x01, 0x0a, 0x1e,
-	0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64,
-	0x69, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x33,
-	0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65,
-	0x6e, 0x74, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69,
-	0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x67,
-	0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x88, 0x01, 0x0a, 0x1d, 0x47, 0x65,
-	0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65,
-	0x6e, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x12, 0x32, 0x2e, 0x6d, 0x65,
+	0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69,
+	0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x73,
+	0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8b, 0x01, 0x0a, 0x1e, 0x47, 0x65, 0x74,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x73, 0x12, 0x33, 0x2e, 0x6d, 0x65,
 	0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63,
 	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x4f,
-	0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x33, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47,
-	0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d,
-	0x65, 0x6e, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8b, 0x01, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x77, 0x6e,
-	0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x73, 0x12, 0x33, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c,
-	0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72,
-	0x73, 0x68, 0x69, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x6d,
-	0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x41,
-	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74,
-	0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0xaf, 0x01, 0x0a, 0x2a, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x6f, 0x72,
-	0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73,
-	0x4e, 0x6f, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x64, 0x42, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x3f, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
-	0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x6f, 0x72, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49,
-	0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x4e, 0x6f, 0x74, 0x4f, 0x77, 0x6e,
-	0x65, 0x64, 0x42, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x40, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e,
-	0x67, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x6f, 0x72, 0x56, 0x61, 0x6c, 0x69, 0x64,
-	0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x4e, 0x6f, 0x74, 0x4f, 0x77,
-	0x6e, 0x65, 0x64, 0x42, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x82, 0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72,
-	0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72,
-	0x65, 0x6e, 0x63, 0x65, 0x12, 0x30, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e,
-	0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x67, 0x72, 0x65,
-	0x64, 0x69, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61,
-	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x67,
-	0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x85, 0x01, 0x0a, 0x1c, 0x47, 0x65,
-	0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x50,
-	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x31, 0x2e, 0x6d, 0x65, 0x61,
+	0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x34, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e,
+	0x47, 0x65, 0x74, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75,
+	0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0xaf, 0x01, 0x0a, 0x2a, 0x53, 0x65, 0x61, 0x72, 0x63,
+	0x68, 0x46, 0x6f, 0x72, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d,
+	0x65, 0x6e, 0x74, 0x73, 0x4e, 0x6f, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x64, 0x42, 0x79, 0x41, 0x63,
+	0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x3f, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e,
+	0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x6f, 0x72, 0x56, 0x61,
+	0x6c, 0x69, 0x64, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x4e, 0x6f,
+	0x74, 0x4f, 0x77, 0x6e, 0x65, 0x64, 0x42, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x40, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61,
+	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x46, 0x6f, 0x72, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x4e,
+	0x6f, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x64, 0x42, 0x79, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x82, 0x01, 0x0a, 0x1b, 0x47, 0x65, 0x74,
+	0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x50, 0x72,
+	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x30, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70,
+	0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49,
+	0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x6d, 0x65, 0x61,
 	0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65,
 	0x72, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x65, 0x66, 0x65,
-	0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e,
-	0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74,
-	0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x50, 0x72,
-	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x91, 0x01, 0x0a, 0x20, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x77, 0x6e,
-	0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x12, 0x35, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61,
-	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f,
-	0x75, 0x6e, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x77, 0x6e,
-	0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e,
-	0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75,
-	0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8b, 0x01, 0x0a, 0x1e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
-	0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x50, 0x72,
-	0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12, 0x33, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70,
-	0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73,
-	0x65, 0x72, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x65, 0x66,
-	0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x85, 0x01,
+	0x0a, 0x1c, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69,
+	0x65, 0x6e, 0x74, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x31,
+	0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x50,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x32, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
+	0x2e, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65,
+	0x6e, 0x74, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x91, 0x01, 0x0a, 0x20, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x12, 0x35, 0x2e, 0x6d, 0x65, 0x61,
+	0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e,
+	0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x36, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e,
+	0x73, 0x74, 0x72, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x4f, 0x77, 0x6e, 0x65, 0x72, 0x73, 0x68, 0x69,
+	0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x64, 0x0a, 0x11, 0x41, 0x72, 0x63,
+	0x68, 0x69, 0x76, 0x65, 0x50, 0x61, 0x6e, 0x74, 0x72, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x26,
+	0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x72,
+	0x63, 0x68, 0x69, 0x76, 0x65, 0x50, 0x61, 0x6e, 0x74, 0x72, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61,
+	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x50, 0x61, 0x6e,
+	0x74, 0x72, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x61, 0x0a, 0x10, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x6e, 0x74, 0x72, 0x79, 0x49,
+	0x74, 0x65, 0x6d, 0x12, 0x25, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69,
+	0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x61, 0x6e, 0x74, 0x72, 0x79, 0x49,
+	0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6d, 0x65, 0x61,
+	0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x50, 0x61, 0x6e, 0x74, 0x72, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x58, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x50, 0x61, 0x6e, 0x74, 0x72, 0x79, 0x49,
+	0x74, 0x65, 0x6d, 0x12, 0x22, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69,
+	0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x6e, 0x74, 0x72, 0x79, 0x49, 0x74, 0x65, 0x6d,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c,
+	0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x6e, 0x74, 0x72, 0x79,
+	0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5b, 0x0a, 0x0e,
+	0x47, 0x65, 0x74, 0x50, 0x61, 0x6e, 0x74, 0x72, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x23,
+	0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x47, 0x65,
+	0x74, 0x50, 0x61, 0x6e, 0x74, 0x72, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69,
+	0x6e, 0x67, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x6e, 0x74, 0x72, 0x79, 0x49, 0x74, 0x65, 0x6d,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x61, 0x0a, 0x10, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x50, 0x61, 0x6e, 0x74, 0x72, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x25, 0x2e,
 	0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e,
-	0x74, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x0f, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4d, 0x65, 0x61,
-	0x6c, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x24, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61,
-	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4d, 0x65, 0x61, 0x6c,
-	0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6d,
-	0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x6c, 0x6f,
-	0x61, 0x64, 0x4d, 0x65, 0x61, 0x6c, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x28, 0x01, 0x12, 0x66, 0x0a, 0x11, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52,
-	0x65, 0x63, 0x69, 0x70, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x26, 0x2e, 0x6d, 0x65, 0x61,
-	0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64,
-	0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e,
-	0x67, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x49, 0x6d,
-	0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x12, 0x75, 0x0a,
-	0x16, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x12, 0x2b, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c,
-	0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x72, 0x65,
-	0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e,
-	0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x28, 0x01, 0x12, 0x72, 0x0a, 0x15, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6e,
-	0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x12, 0x2a, 0x2e,
-	0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x6c,
-	0x6f, 0x61, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x64,
-	0x69, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x6d, 0x65, 0x61, 0x6c,
-	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49,
-	0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x12, 0x72, 0x0a, 0x15, 0x55, 0x70, 0x6c, 0x6f,
-	0x61, 0x64, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x49, 0x6d, 0x61, 0x67,
-	0x65, 0x12, 0x2a, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
-	0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65,
-	0x70, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e,
+	0x61, 0x74, 0x65, 0x50, 0x61, 0x6e, 0x74, 0x72, 0x79, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e,
+	0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x50, 0x61, 0x6e, 0x74, 0x72, 0x79,
+	0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8b, 0x01, 0x0a,
+	0x1e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x67, 0x72, 0x65,
+	0x64, 0x69, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x12,
+	0x33, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69,
+	0x65, 0x6e, 0x74, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x34, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e,
+	0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x49, 0x6e,
+	0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x0f, 0x55, 0x70,
+	0x6c, 0x6f, 0x61, 0x64, 0x4d, 0x65, 0x61, 0x6c, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x24, 0x2e,
 	0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x6c,
-	0x6f, 0x61, 0x64, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x49, 0x6d, 0x61,
-	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x42, 0x64, 0x5a, 0x62,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x69, 0x6e, 0x6e, 0x65,
-	0x72, 0x64, 0x6f, 0x6e, 0x65, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x2f, 0x64, 0x69, 0x6e, 0x6e,
-	0x65, 0x72, 0x64, 0x6f, 0x6e, 0x65, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x2f, 0x62, 0x61, 0x63,
-	0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x67, 0x72,
-	0x70, 0x63, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2f, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69,
-	0x6e, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x61, 0x64, 0x4d, 0x65, 0x61, 0x6c, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69,
+	0x6e, 0x67, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x4d, 0x65, 0x61, 0x6c, 0x49, 0x6d, 0x61,
+	0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x12, 0x66, 0x0a, 0x11,
+	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x49, 0x6d, 0x61, 0x67,
+	0x65, 0x12, 0x26, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67,
+	0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x4d, 0x65, 0x64,
+	0x69, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x27, 0x2e, 0x6d, 0x65, 0x61, 0x6c,
+	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52,
+	0x65, 0x63, 0x69, 0x70, 0x65, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x28, 0x01, 0x12, 0x75, 0x0a, 0x16, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x50, 0x72,
+	0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x12, 0x2b,
+	0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70,
+	0x6c, 0x6f, 0x61, 0x64, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d,
+	0x65, 0x64, 0x69, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6d, 0x65,
+	0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61,
+	0x64, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x64, 0x69,
+	0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x12, 0x72, 0x0a, 0x15, 0x55,
+	0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74, 0x4d,
+	0x65, 0x64, 0x69, 0x61, 0x12, 0x2a, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e,
+	0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64,
+	0x69, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2b, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e,
+	0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x49, 0x6e, 0x67, 0x72, 0x65, 0x64, 0x69, 0x65, 0x6e, 0x74,
+	0x4d, 0x65, 0x64, 0x69, 0x61, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x28, 0x01, 0x12,
+	0x72, 0x0a, 0x15, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65, 0x53,
+	0x74, 0x65, 0x70, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x2a, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70,
+	0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65,
+	0x63, 0x69, 0x70, 0x65, 0x53, 0x74, 0x65, 0x70, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e,
+	0x69, 0x6e, 0x67, 0x2e, 0x55, 0x70, 0x6c, 0x6f, 0x61, 0x64, 0x52, 0x65, 0x63, 0x69, 0x70, 0x65,
+	0x53, 0x74, 0x65, 0x70, 0x49, 0x6d, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x28, 0x01, 0x12, 0x73, 0x0a, 0x16, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x4d, 0x65, 0x61,
+	0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72, 0x12, 0x2b, 0x2e,
+	0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x45, 0x78, 0x70,
+	0x6f, 0x72, 0x74, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x43, 0x61, 0x6c, 0x65, 0x6e,
+	0x64, 0x61, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x6d, 0x65, 0x61,
+	0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74,
+	0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x43, 0x61, 0x6c, 0x65, 0x6e, 0x64, 0x61, 0x72,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x0d, 0x57, 0x61, 0x74, 0x63,
+	0x68, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x12, 0x22, 0x2e, 0x6d, 0x65, 0x61, 0x6c,
+	0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x65,
+	0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x23, 0x2e,
+	0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x57, 0x61, 0x74,
+	0x63, 0x68, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x30, 0x01, 0x12, 0x69, 0x0a, 0x12, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x65, 0x61,
+	0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x12, 0x27, 0x2e, 0x6d, 0x65, 0x61,
+	0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4d,
+	0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e, 0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61, 0x6e, 0x6e, 0x69,
+	0x6e, 0x67, 0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x4d, 0x65, 0x61, 0x6c, 0x50, 0x6c, 0x61, 0x6e,
+	0x54, 0x61, 0x73, 0x6b, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x30, 0x01, 0x42,
+	0x64, 0x5a, 0x62, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x69,
+	0x6e, 0x6e, 0x65, 0x72, 0x64, 0x6f, 0x6e, 0x65, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x2f, 0x64,
+	0x69, 0x6e, 0x6e, 0x65, 0x72, 0x64, 0x6f, 0x6e, 0x65, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x2f,
+	0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
+	0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2f,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x6d, 0x65, 0x61, 0x6c, 0x70, 0x6c, 0x61,
+	0x6e, 0x6e, 0x69, 0x6e, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var file_mealplanning_mealplanning_service_proto_goTypes = []any{
@@ -1847,450 +2058,508 @@
 	(*ArchiveMealPlanOptionRequest)(nil),                                // 7: mealplanning.ArchiveMealPlanOptionRequest
 	(*ArchiveMealPlanOptionVoteRequest)(nil),                            // 8: mealplanning.ArchiveMealPlanOptionVoteRequest
 	(*ArchiveMealPlanRecipeOptionSelectionRequest)(nil),                 // 9: mealplanning.ArchiveMealPlanRecipeOptionSelectionRequest
-	(*ArchiveMealListRequest)(nil),                                      // 10: mealplanning.ArchiveMealListRequest
-	(*ArchiveMealListItemRequest)(nil),                                  // 11: mealplanning.ArchiveMealListItemRequest
-	(*ArchiveRecipeRequest)(nil),                                        // 12: mealplanning.ArchiveRecipeRequest
-	(*ArchiveRecipePrepTaskRequest)(nil),                                // 13: mealplanning.ArchiveRecipePrepTaskRequest
-	(*ArchiveRecipeRatingRequest)(nil),                                  // 14: mealplanning.ArchiveRecipeRatingRequest
-	(*ArchiveRecipeListRequest)(nil),                                    // 15: mealplanning.ArchiveRecipeListRequest
-	(*ArchiveRecipeListItemRequest)(nil),                                // 16: mealplanning.ArchiveRecipeListItemRequest
-	(*ArchiveRecipeStepRequest)(nil),                                    // 17: mealplanning.ArchiveRecipeStepRequest
-	(*ArchiveRecipeStepCompletionConditionRequest)(nil),                 // 18: mealplanning.ArchiveRecipeStepCompletionConditionRequest
-	(*ArchiveRecipeStepIngredientRequest)(nil),                          // 19: mealplanning.ArchiveRecipeStepIngredientRequest
-	(*ArchiveRecipeStepInstrumentRequest)(nil),                          // 20: mealplanning.ArchiveRecipeStepInstrumentRequest
-	(*ArchiveRecipeStepProductRequest)(nil),                             // 21: mealplanning.ArchiveRecipeStepProductRequest
-	(*ArchiveRecipeStepVesselRequest)(nil),                              // 22: mealplanning.ArchiveRecipeStepVesselRequest
-	(*ArchiveValidIngredientRequest)(nil),                               // 23: mealplanning.ArchiveValidIngredientRequest
-	(*ArchiveValidIngredientGroupRequest)(nil),                          // 24: mealplanning.ArchiveValidIngredientGroupRequest
-	(*ArchiveValidIngredientMeasurementUnitRequest)(nil),                // 25: mealplanning.ArchiveValidIngredientMeasurementUnitRequest
-	(*ArchiveValidIngredientPreparationRequest)(nil),                    // 26: mealplanning.ArchiveValidIngredientPreparationRequest
-	(*ArchiveValidPrepTaskConfigRequest)(nil),                           // 27: mealplanning.ArchiveValidPrepTaskConfigRequest
-	(*ArchiveValidIngredientStateRequest)(nil),                          // 28: mealplanning.ArchiveValidIngredientStateRequest
-	(*ArchiveValidIngredientStateIngredientRequest)(nil),                // 29: mealplanning.ArchiveValidIngredientStateIngredientRequest
-	(*ArchiveValidInstrumentRequest)(nil),                               // 30: mealplanning.ArchiveValidInstrumentRequest
-	(*ArchiveValidMeasurementUnitRequest)(nil),                          // 31: mealplanning.ArchiveValidMeasurementUnitRequest
-	(*ArchiveValidMeasurementUnitConversionRequest)(nil),                // 32: mealplanning.ArchiveValidMeasurementUnitConversionRequest
-	(*ArchiveValidPreparationRequest)(nil),                              // 33: mealplanning.ArchiveValidPreparationRequest
-	(*ArchiveValidPreparationInstrumentRequest)(nil),                    // 34: mealplanning.ArchiveValidPreparationInstrumentRequest
-	(*ArchiveValidPreparationVesselRequest)(nil),                        // 35: mealplanning.ArchiveValidPreparationVesselRequest
-	(*ArchiveValidVesselRequest)(nil),                                   // 36: mealplanning.ArchiveValidVesselRequest
-	(*CloneRecipeRequest)(nil),                                          // 37: mealplanning.CloneRecipeRequest
-	(*CreateMealRequest)(nil),                                           // 38: mealplanning.CreateMealRequest
-	(*CreateMealPlanRequest)(nil),                                       // 39: mealplanning.CreateMealPlanRequest
-	(*CreateMealPlanEventRequest)(nil),                                  // 40: mealplanning.CreateMealPlanEventRequest
-	(*CreateMealPlanOptionRequest)(nil),                                 // 41: mealplanning.CreateMealPlanOptionRequest
-	(*CreateMealPlanOptionVoteRequest)(nil),                             // 42: mealplanning.CreateMealPlanOptionVoteRequest
-	(*CreateMealPlanRecipeOptionSelectionRequest)(nil),                  // 43: mealplanning.CreateMealPlanRecipeOptionSelectionRequest
-	(*CreateMealPlanTaskRequest)(nil),                                   // 44: mealplanning.CreateMealPlanTaskRequest
-	(*CreateMealListRequest)(nil),                                       // 45: mealplanning.CreateMealListRequest
-	(*CreateMealListItemRequest)(nil),                                   // 46: mealplanning.CreateMealListItemRequest
-	(*CreateRecipeRequest)(nil),                                         // 47: mealplanning.CreateRecipeRequest
-	(*CreateRecipePrepTaskRequest)(nil),                                 // 48: mealplanning.CreateRecipePrepTaskRequest
-	(*CreateRecipeRatingRequest)(nil),                                   // 49: mealplanning.CreateRecipeRatingRequest
-	(*CreateRecipeListRequest)(nil),                                     // 50: mealplanning.CreateRecipeListRequest
-	(*CreateRecipeListItemRequest)(nil),                                 // 51: mealplanning.CreateRecipeListItemRequest
-	(*CreateRecipeStepRequest)(nil),                                     // 52: mealplanning.CreateRecipeStepRequest
-	(*CreateRecipeStepCompletionConditionRequest)(nil),                  // 53: mealplanning.CreateRecipeStepCompletionConditionRequest
-	(*CreateRecipeStepIngredientRequest)(nil),                           // 54: mealplanning.CreateRecipeStepIngredientRequest
-	(*CreateRecipeStepInstrumentRequest)(nil),                           // 55: mealplanning.CreateRecipeStepInstrumentRequest
-	(*CreateRecipeStepProductRequest)(nil),                              // 56: mealplanning.CreateRecipeStepProductRequest
-	(*CreateRecipeStepVesselRequest)(nil),                               // 57: mealplanning.CreateRecipeStepVesselRequest
-	(*CreateValidIngredientRequest)(nil),                                // 58: mealplanning.CreateValidIngredientRequest
-	(*CreateValidIngredientGroupRequest)(nil),                           // 59: mealplanning.CreateValidIngredientGroupRequest
-	(*CreateValidIngredientMeasurementUnitRequest)(nil),                 // 60: mealplanning.CreateValidIngredientMeasurementUnitRequest
-	(*CreateValidIngredientPreparationRequest)(nil),                     // 61: mealplanning.CreateValidIngredientPreparationRequest
-	(*CreateValidPrepTaskConfigRequest)(nil),                            // 62: mealplanning.CreateValidPrepTaskConfigRequest
-	(*CreateValidIngredientStateRequest)(nil),                           // 63: mealplanning.CreateValidIngredientStateRequest
-	(*CreateValidIngredientStateIngredientRequest)(nil),                 // 64: mealplanning.CreateValidIngredientStateIngredientRequest
-	(*CreateValidInstrumentRequest)(nil),                                // 65: mealplanning.CreateValidInstrumentRequest
-	(*CreateValidMeasurementUnitRequest)(nil),                           // 66: mealplanning.CreateValidMeasurementUnitRequest
-	(*CreateValidMeasurementUnitConversionRequest)(nil),                 // 67: mealplanning.CreateValidMeasurementUnitConversionRequest
-	(*CreateValidPreparationRequest)(nil),                               // 68: mealplanning.CreateValidPreparationRequest
-	(*CreateValidPreparationInstrumentRequest)(nil),                     // 69: mealplanning.CreateValidPreparationInstrumentRequest
-	(*CreateValidPreparationVesselRequest)(nil),                         // 70: mealplanning.CreateValidPreparationVesselRequest
-	(*CreateValidVesselRequest)(nil),                                    // 71: mealplanning.CreateValidVesselRequest
-	(*FinalizeMealPlanRequest)(nil),                                     // 72: mealplanning.FinalizeMealPlanRequest
-	(*GetMealRequest)(nil),                                              // 73: mealplanning.GetMealRequest
-	(*GetMealPlanRequest)(nil),                                          // 74: mealplanning.GetMealPlanRequest
-	(*GetMealPlanEventRequest)(nil),                                     // 75: mealplanning.GetMealPlanEventRequest
-	(*GetMealPlanEventsRequest)(nil),                                    // 76: mealplanning.GetMealPlanEventsRequest
-	(*GetMealPlanGroceryListItemRequest)(nil),                           // 77: mealplanning.GetMealPlanGroceryListItemRequest
-	(*GetMealPlanGroceryListItemsForMealPlanRequest)(nil),               // 78: mealplanning.GetMealPlanGroceryListItemsForMealPlanRequest
-	(*GetMealPlanOptionRequest)(nil),                                    // 79: mealplanning.GetMealPlanOptionRequest
-	(*GetMealPlanOptionVoteRequest)(nil),                                // 80: mealplanning.GetMealPlanOptionVoteRequest
-	(*GetMealPlanOptionVotesRequest)(nil),                               // 81: mealplanning.GetMealPlanOptionVotesRequest
-	(*GetMealPlanOptionsRequest)(nil),                                   // 82: mealplanning.GetMealPlanOptionsRequest
-	(*GetMealPlanRecipeOptionSelectionRequest)(nil),                     // 83: mealplanning.GetMealPlanRecipeOptionSelectionRequest
-	(*GetMealPlanRecipeOptionSelectionsForMealPlanOptionRequest)(nil),   // 84: mealplanning.GetMealPlanRecipeOptionSelectionsForMealPlanOptionRequest
-	(*GetMealPlanTaskRequest)(nil),                                      // 85: mealplanning.GetMealPlanTaskRequest
-	(*GetMealPlanTasksRequest)(nil),                                     // 86: mealplanning.GetMealPlanTasksRequest
-	(*GetMealPlansForAccountRequest)(nil),                               // 87: mealplanning.GetMealPlansForAccountRequest
-	(*GetMealListsRequest)(nil),                                         // 88: mealplanning.GetMealListsRequest
-	(*GetMealsRequest)(nil),                                             // 89: mealplanning.GetMealsRequest
-	(*GetMermaidDiagramForMealRequest)(nil),                             // 90: mealplanning.GetMermaidDiagramForMealRequest
-	(*GetMermaidDiagramForRecipeRequest)(nil),                           // 91: mealplanning.GetMermaidDiagramForRecipeRequest
-	(*GetRandomValidIngredientRequest)(nil),                             // 92: mealplanning.GetRandomValidIngredientRequest
-	(*GetRandomValidInstrumentRequest)(nil),                             // 93: mealplanning.GetRandomValidInstrumentRequest
-	(*GetRandomValidPreparationRequest)(nil),                            // 94: mealplanning.GetRandomValidPreparationRequest
-	(*GetRandomValidVesselRequest)(nil),                                 // 95: mealplanning.GetRandomValidVesselRequest
-	(*GetRecipeRequest)(nil),                                            // 96: mealplanning.GetRecipeRequest
-	(*EstimateRecipePrepTasksRequest)(nil),                              // 97: mealplanning.EstimateRecipePrepTasksRequest
-	(*GetRecipePrepTaskRequest)(nil),                                    // 98: mealplanning.GetRecipePrepTaskRequest
-	(*GetRecipePrepTasksRequest)(nil),                                   // 99: mealplanning.GetRecipePrepTasksRequest
-	(*GetRecipeRatingRequest)(nil),                                      // 100: mealplanning.GetRecipeRatingRequest
-	(*GetRecipeRatingsForRecipeRequest)(nil),                            // 101: mealplanning.GetRecipeRatingsForRecipeRequest
-	(*GetRecipeStepRequest)(nil),                                        // 102: mealplanning.GetRecipeStepRequest
-	(*GetRecipeStepCompletionConditionRequest)(nil),                     // 103: mealplanning.GetRecipeStepCompletionConditionRequest
-	(*GetRecipeStepCompletionConditionsRequest)(nil),                    // 104: mealplanning.GetRecipeStepCompletionConditionsRequest
-	(*GetRecipeStepIngredientRequest)(nil),                              // 105: mealplanning.GetRecipeStepIngredientRequest
-	(*GetRecipeStepIngredientsRequest)(nil),                             // 106: mealplanning.GetRecipeStepIngredientsRequest
-	(*GetRecipeStepInstrumentRequest)(nil),                              // 107: mealplanning.GetRecipeStepInstrumentRequest
-	(*GetRecipeStepInstrumentsRequest)(nil),                             // 108: mealplanning.GetRecipeStepInstrumentsRequest
-	(*GetRecipeStepProductRequest)(nil),                                 // 109: mealplanning.GetRecipeStepProductRequest
-	(*GetRecipeStepProductsRequest)(nil),                                // 110: mealplanning.GetRecipeStepProductsRequest
-	(*GetRecipeStepVesselRequest)(nil),                                  // 111: mealplanning.GetRecipeStepVesselRequest
-	(*GetRecipeStepVesselsRequest)(nil),                                 // 112: mealplanning.GetRecipeStepVesselsRequest
-	(*GetRecipeStepsRequest)(nil),                                       // 113: mealplanning.GetRecipeStepsRequest
-	(*GetRecipeListsRequest)(nil),                                       // 114: mealplanning.GetRecipeListsRequest
-	(*GetRecipesRequest)(nil),                                           // 115: mealplanning.GetRecipesRequest
-	(*GetValidIngredientRequest)(nil),                                   // 116: mealplanning.GetValidIngredientRequest
-	(*GetValidIngredientGroupRequest)(nil),                              // 117: mealplanning.GetValidIngredientGroupRequest
-	(*GetValidIngredientGroupsRequest)(nil),                             // 118: mealplanning.GetValidIngredientGroupsRequest
-	(*GetValidIngredientMeasurementUnitRequest)(nil),                    // 119: mealplanning.GetValidIngredientMeasurementUnitRequest
-	(*GetValidIngredientMeasurementUnitsRequest)(nil),                   // 120: mealplanning.GetValidIngredientMeasurementUnitsRequest
-	(*GetValidIngredientMeasurementUnitsByIngredientRequest)(nil),       // 121: mealplanning.GetValidIngredientMeasurementUnitsByIngredientRequest
-	(*GetValidIngredientMeasurementUnitsByMeasurementUnitRequest)(nil),  // 122: mealplanning.GetValidIngredientMeasurementUnitsByMeasurementUnitRequest
-	(*GetValidIngredientPreparationRequest)(nil),                        // 123: mealplanning.GetValidIngredientPreparationRequest
-	(*GetValidIngredientPreparationsRequest)(nil),                       // 124: mealplanning.GetValidIngredientPreparationsRequest
-	(*GetValidIngredientPreparationsByIngredientRequest)(nil),           // 125: mealplanning.GetValidIngredientPreparationsByIngredientRequest
-	(*GetValidIngredientPreparationsByPreparationRequest)(nil),          // 126: mealplanning.GetValidIngredientPreparationsByPreparationRequest
-	(*GetValidPrepTaskConfigRequest)(nil),                               // 127: mealplanning.GetValidPrepTaskConfigRequest
-	(*GetValidPrepTaskConfigsRequest)(nil),                              // 128: mealplanning.GetValidPrepTaskConfigsRequest
-	(*GetValidPrepTaskConfigsByIngredientRequest)(nil),                  // 129: mealplanning.GetValidPrepTaskConfigsByIngredientRequest
-	(*GetValidPrepTaskConfigsByPreparationRequest)(nil),                 // 130: mealplanning.GetValidPrepTaskConfigsByPreparationRequest
-	(*GetValidPrepTaskConfigsByIngredientAndPreparationRequest)(nil),    // 131: mealplanning.GetValidPrepTaskConfigsByIngredientAndPreparationRequest
-	(*GetValidIngredientStateRequest)(nil),                              // 132: mealplanning.GetValidIngredientStateRequest
-	(*GetValidIngredientStateIngredientRequest)(nil),                    // 133: mealplanning.GetValidIngredientStateIngredientRequest
-	(*GetValidIngredientStateIngredientsRequest)(nil),                   // 134: mealplanning.GetValidIngredientStateIngredientsRequest
-	(*GetValidIngredientStateIngredientsByIngredientRequest)(nil),       // 135: mealplanning.GetValidIngredientStateIngredientsByIngredientRequest
-	(*GetValidIngredientStateIngredientsByIngredientStateRequest)(nil),  // 136: mealplanning.GetValidIngredientStateIngredientsByIngredientStateRequest
-	(*GetValidIngredientStatesRequest)(nil),                             // 137: mealplanning.GetValidIngredientStatesRequest
-	(*GetValidIngredientsRequest)(nil),                                  // 138: mealplanning.GetValidIngredientsRequest
-	(*GetValidInstrumentRequest)(nil),                                   // 139: mealplanning.GetValidInstrumentRequest
-	(*GetValidInstrumentsRequest)(nil),                                  // 140: mealplanning.GetValidInstrumentsRequest
-	(*GetValidMeasurementUnitRequest)(nil),                              // 141: mealplanning.GetValidMeasurementUnitRequest
-	(*GetValidMeasurementUnitConversionRequest)(nil),                    // 142: mealplanning.GetValidMeasurementUnitConversionRequest
-	(*GetValidMeasurementUnitConversionsForUnitRequest)(nil),            // 143: mealplanning.GetValidMeasurementUnitConversionsForUnitRequest
-	(*GetValidMeasurementUnitConversionsForIngredientsRequest)(nil),     // 144: mealplanning.GetValidMeasurementUnitConversionsForIngredientsRequest
-	(*GetMeasurementUnitConversionMismatchesRequest)(nil),               // 145: mealplanning.GetMeasurementUnitConversionMismatchesRequest
-	(*GetValidMeasurementUnitsRequest)(nil),                             // 146: mealplanning.GetValidMeasurementUnitsRequest
-	(*GetValidPreparationRequest)(nil),                                  // 147: mealplanning.GetValidPreparationRequest
-	(*GetValidPreparationInstrumentRequest)(nil),                        // 148: mealplanning.GetValidPreparationInstrumentRequest
-	(*GetValidPreparationInstrumentsRequest)(nil),                       // 149: mealplanning.GetValidPreparationInstrumentsRequest
-	(*GetValidPreparationInstrumentsByInstrumentRequest)(nil),           // 150: mealplanning.GetValidPreparationInstrumentsByInstrumentRequest
-	(*GetValidPreparationInstrumentsByPreparationRequest)(nil),          // 151: mealplanning.GetValidPreparationInstrumentsByPreparationRequest
-	(*GetValidPreparationVesselRequest)(nil),                            // 152: mealplanning.GetValidPreparationVesselRequest
-	(*GetValidPreparationVesselsRequest)(nil),                           // 153: mealplanning.GetValidPreparationVesselsRequest
-	(*GetValidPreparationVesselsByPreparationRequest)(nil),              // 154: mealplanning.GetValidPreparationVesselsByPreparationRequest
-	(*GetValidPreparationVesselsByVesselRequest)(nil),                   // 155: mealplanning.GetValidPreparationVesselsByVesselRequest
-	(*GetValidPreparationsRequest)(nil),                                 // 156: mealplanning.GetValidPreparationsRequest
-	(*GetValidVesselRequest)(nil),                                       // 157: mealplanning.GetValidVesselRequest
-	(*GetValidVesselsRequest)(nil),                                      // 158: mealplanning.GetValidVesselsRequest
-	(*RunFinalizeMealPlanWorkerRequest)(nil),                            // 159: mealplanning.RunFinalizeMealPlanWorkerRequest
-	(*RunMealPlanGroceryListInitializerWorkerRequest)(nil),              // 160: mealplanning.RunMealPlanGroceryListInitializerWorkerRequest
-	(*RunMealPlanTaskCreatorWorkerRequest)(nil),                         // 161: mealplanning.RunMealPlanTaskCreatorWorkerRequest
-	(*SearchForMealsRequest)(nil),                                       // 162: mealplanning.SearchForMealsRequest
-	(*SearchForRecipesRequest)(nil),                                     // 163: mealplanning.SearchForRecipesRequest
-	(*SearchForMealEligibleRecipesRequest)(nil),                         // 164: mealplanning.SearchForMealEligibleRecipesRequest
-	(*SearchForRecipesWithInstrumentOwnershipRequest)(nil),              // 165: mealplanning.SearchForRecipesWithInstrumentOwnershipRequest
-	(*SearchForValidIngredientGroupsRequest)(nil),                       // 166: mealplanning.SearchForValidIngredientGroupsRequest
-	(*SearchForValidIngredientStatesRequest)(nil),                       // 167: mealplanning.SearchForValidIngredientStatesRequest
-	(*SearchForValidIngredientsRequest)(nil),                            // 168: mealplanning.SearchForValidIngredientsRequest
-	(*SearchForValidInstrumentsRequest)(nil),                            // 169: mealplanning.SearchForValidInstrumentsRequest
-	(*SearchForValidMeasurementUnitsRequest)(nil),                       // 170: mealplanning.SearchForValidMeasurementUnitsRequest
-	(*SearchForValidPreparationsRequest)(nil),                           // 171: mealplanning.SearchForValidPreparationsRequest
-	(*SearchForValidVesselsRequest)(nil),                                // 172: mealplanning.SearchForValidVesselsRequest
-	(*SearchValidIngredientsByPreparationRequest)(nil),                  // 173: mealplanning.SearchValidIngredientsByPreparationRequest
-	(*SearchValidMeasurementUnitsByIngredientRequest)(nil),              // 174: mealplanning.SearchValidMeasurementUnitsByIngredientRequest
-	(*UpdateMealPlanRequest)(nil),                                       // 175: mealplanning.UpdateMealPlanRequest
-	(*UpdateMealPlanEventRequest)(nil),                                  // 176: mealplanning.UpdateMealPlanEventRequest
-	(*SwapMealPlanEventsRequest)(nil),                                   // 177: mealplanning.SwapMealPlanEventsRequest
-	(*UpdateMealPlanGroceryListItemRequest)(nil),                        // 178: mealplanning.UpdateMealPlanGroceryListItemRequest
-	(*UpdateMealPlanOptionRequest)(nil),                                 // 179: mealplanning.UpdateMealPlanOptionRequest
-	(*UpdateMealPlanOptionVoteRequest)(nil),                             // 180: mealplanning.UpdateMealPlanOptionVoteRequest
-	(*UpdateMealPlanRecipeOptionSelectionRequest)(nil),                  // 181: mealplanning.UpdateMealPlanRecipeOptionSelectionRequest
-	(*UpdateMealPlanTaskStatusRequest)(nil),                             // 182: mealplanning.UpdateMealPlanTaskStatusRequest
-	(*UpdateMealListRequest)(nil),                                       // 183: mealplanning.UpdateMealListRequest
-	(*UpdateMealListItemRequest)(nil),                                   // 184: mealplanning.UpdateMealListItemRequest
-	(*UpdateRecipeRequest)(nil),                                         // 185: mealplanning.UpdateRecipeRequest
-	(*UpdateRecipeStatusRequest)(nil),                                   // 186: mealplanning.UpdateRecipeStatusRequest
-	(*UpdateRecipePrepTaskRequest)(nil),                                 // 187: mealplanning.UpdateRecipePrepTaskRequest
-	(*UpdateRecipeRatingRequest)(nil),                                   // 188: mealplanning.UpdateRecipeRatingRequest
-	(*UpdateRecipeListRequest)(nil),                                     // 189: mealplanning.UpdateRecipeListRequest
-	(*UpdateRecipeListItemRequest)(nil),                                 // 190: mealplanning.UpdateRecipeListItemRequest
-	(*UpdateRecipeStepRequest)(nil),                                     // 191: mealplanning.UpdateRecipeStepRequest
-	(*UpdateRecipeStepCompletionConditionRequest)(nil),                  // 192: mealplanning.UpdateRecipeStepCompletionConditionRequest
-	(*UpdateRecipeStepIngredientRequest)(nil),                           // 193: mealplanning.UpdateRecipeStepIngredientRequest
-	(*UpdateRecipeStepInstrumentRequest)(nil),                           // 194: mealplanning.UpdateRecipeStepInstrumentRequest
-	(*UpdateRecipeStepProductRequest)(nil),                              // 195: mealplanning.UpdateRecipeStepProductRequest
-	(*UpdateRecipeStepVesselRequest)(nil),                               // 196: mealplanning.UpdateRecipeStepVesselRequest
-	(*UpdateValidIngredientRequest)(nil),                                // 197: mealplanning.UpdateValidIngredientRequest
-	(*UpdateValidIngredientGroupRequest)(nil),                           // 198: mealplanning.UpdateValidIngredientGroupRequest
-	(*UpdateValidIngredientMeasurementUnitRequest)(nil),                 // 199: mealplanning.UpdateValidIngredientMeasurementUnitRequest
-	(*UpdateValidIngredientPreparationRequest)(nil),                     // 200: mealplanning.UpdateValidIngredientPreparationRequest
-	(*UpdateValidPrepTaskConfigRequest)(nil),                            // 201: mealplanning.UpdateValidPrepTaskConfigRequest
-	(*UpdateValidIngredientStateRequest)(nil),                           // 202: mealplanning.UpdateValidIngredientStateRequest
-	(*UpdateValidIngredientStateIngredientRequest)(nil),                 // 203: mealplanning.UpdateValidIngredientStateIngredientRequest
-	(*UpdateValidInstrumentRequest)(nil),                                // 204: mealplanning.UpdateValidInstrumentRequest
-	(*UpdateValidMeasurementUnitRequest)(nil),                           // 205: mealplanning.UpdateValidMeasurementUnitRequest
-	(*UpdateValidMeasurementUnitConversionRequest)(nil),                 // 206: mealplanning.UpdateValidMeasurementUnitConversionRequest
-	(*UpdateValidPreparationRequest)(nil),                               // 207: mealplanning.UpdateValidPreparationRequest
-	(*UpdateValidPreparationInstrumentRequest)(nil),                     // 208: mealplanning.UpdateValidPreparationInstrumentRequest
-	(*UpdateValidPreparationVesselRequest)(nil),                         // 209: mealplanning.UpdateValidPreparationVesselRequest
-	(*UpdateValidVesselRequest)(nil),                                    // 210: mealplanning.UpdateValidVesselRequest
-	(*ArchiveAccountInstrumentOwnershipRequest)(nil),                    // 211: mealplanning.ArchiveAccountInstrumentOwnershipRequest
-	(*ArchiveUserIngredientPreferenceRequest)(nil),                      // 212: mealplanning.ArchiveUserIngredientPreferenceRequest
-	(*CreateAccountInstrumentOwnershipRequest)(nil),                     // 213: mealplanning.CreateAccountInstrumentOwnershipRequest
-	(*CreateUserIngredientPreferenceRequest)(nil),                       // 214: mealplanning.CreateUserIngredientPreferenceRequest
-	(*GetAccountInstrumentOwnershipRequest)(nil),                        // 215: mealplanning.GetAccountInstrumentOwnershipRequest
-	(*GetAccountInstrumentOwnershipsRequest)(nil),                       // 216: mealplanning.GetAccountInstrumentOwnershipsRequest
-	(*SearchForValidInstrumentsNotOwnedByAccountRequest)(nil),           // 217: mealplanning.SearchForValidInstrumentsNotOwnedByAccountRequest
-	(*GetUserIngredientPreferenceRequest)(nil),                          // 218: mealplanning.GetUserIngredientPreferenceRequest
-	(*GetUserIngredientPreferencesRequest)(nil),                         // 219: mealplanning.GetUserIngredientPreferencesRequest
-	(*UpdateAccountInstrumentOwnershipRequest)(nil),                     // 220: mealplanning.UpdateAccountInstrumentOwnershipRequest
-	(*UpdateUserIngredientPreferenceRequest)(nil),                       // 221: mealplanning.UpdateUserIngredientPreferenceRequest
-	(*UploadMealMediaRequest)(nil),                                      // 222: mealplanning.UploadMealMediaRequest
-	(*UploadRecipeMediaRequest)(nil),                                    // 223: mealplanning.UploadRecipeMediaRequest
-	(*UploadPreparationMediaRequest)(nil),                               // 224: mealplanning.UploadPreparationMediaRequest
-	(*UploadIngredientMediaRequest)(nil),                                // 225: mealplanning.UploadIngredientMediaRequest
-	(*UploadRecipeStepImageRequest)(nil),                                // 226: mealplanning.UploadRecipeStepImageRequest
-	(*AddCommentToMealResponse)(nil),                                    // 227: mealplanning.AddCommentToMealResponse
-	(*AddCommentToMealPlanResponse)(nil),                                // 228: mealplanning.AddCommentToMealPlanResponse
-	(*AddCommentToRecipeResponse)(nil),                                  // 229: mealplanning.AddCommentToRecipeResponse
-	(*ArchiveMealResponse)(nil),                                         // 230: mealplanning.ArchiveMealResponse
-	(*ArchiveMealPlanResponse)(nil),                                     // 231: mealplanning.ArchiveMealPlanResponse
-	(*ArchiveMealPlanEventResponse)(nil),                                // 232: mealplanning.ArchiveMealPlanEventResponse
-	(*ArchiveMealPlanGroceryListItemResponse)(nil),                      // 233: mealplanning.ArchiveMealPlanGroceryListItemResponse
-	(*ArchiveMealPlanOptionResponse)(nil),                               // 234: mealplanning.ArchiveMealPlanOptionResponse
-	(*ArchiveMealPlanOptionVoteResponse)(nil),                           // 235: mealplanning.ArchiveMealPlanOptionVoteResponse
-	(*ArchiveMealPlanRecipeOptionSelectionResponse)(nil),                // 236: mealplanning.ArchiveMealPlanRecipeOptionSelectionResponse
-	(*ArchiveMealListResponse)(nil),                                     // 237: mealplanning.ArchiveMealListResponse
-	(*ArchiveMealListItemResponse)(nil),                                 // 238: mealplanning.ArchiveMealListItemResponse
-	(*ArchiveRecipeResponse)(nil),                                       // 239: mealplanning.ArchiveRecipeResponse
-	(*ArchiveRecipePrepTaskResponse)(nil),                               // 240: mealplanning.ArchiveRecipePrepTaskResponse
-	(*ArchiveRecipeRatingResponse)(nil),                                 // 241: mealplanning.ArchiveRecipeRatingResponse
-	(*ArchiveRecipeListResponse)(nil),                                   // 242: mealplanning.ArchiveRecipeListResponse
-	(*ArchiveRecipeListItemResponse)(nil),                               // 243: mealplanning.ArchiveRecipeListItemResponse
-	(*ArchiveRecipeStepResponse)(nil),                                   // 244: mealplanning.ArchiveRecipeStepResponse
-	(*ArchiveRecipeStepCompletionConditionResponse)(nil),                // 245: mealplanning.ArchiveRecipeStepCompletionConditionResponse
-	(*ArchiveRecipeStepIngredientResponse)(nil),                         // 246: mealplanning.ArchiveRecipeStepIngredientResponse
-	(*ArchiveRecipeStepInstrumentResponse)(nil),                         // 247: mealplanning.ArchiveRecipeStepInstrumentResponse
-	(*ArchiveRecipeStepProductResponse)(nil),                            // 248: mealplanning.ArchiveRecipeStepProductResponse
-	(*ArchiveRecipeStepVesselResponse)(nil),                             // 249: mealplanning.ArchiveRecipeStepVesselResponse
-	(*ArchiveValidIngredientResponse)(nil),                              // 250: mealplanning.ArchiveValidIngredientResponse
-	(*ArchiveValidIngredientGroupResponse)(nil),                         // 251: mealplanning.ArchiveValidIngredientGroupResponse
-	(*ArchiveValidIngredientMeasurementUnitResponse)(nil),               // 252: mealplanning.ArchiveValidIngredientMeasurementUnitResponse
-	(*ArchiveValidIngredientPreparationResponse)(nil),                   // 253: mealplanning.ArchiveValidIngredientPreparationResponse
-	(*ArchiveValidPrepTaskConfigResponse)(nil),                          // 254: mealplanning.ArchiveValidPrepTaskConfigResponse
-	(*ArchiveValidIngredientStateResponse)(nil),                         // 255: mealplanning.ArchiveValidIngredientStateResponse
-	(*ArchiveValidIngredientStateIngredientResponse)(nil),               // 256: mealplanning.ArchiveValidIngredientStateIngredientResponse
-	(*ArchiveValidInstrumentResponse)(nil),                              // 257: mealplanning.ArchiveValidInstrumentResponse
-	(*ArchiveValidMeasurementUnitResponse)(nil),                         // 258: mealplanning.ArchiveValidMeasurementUnitResponse
-	(*ArchiveValidMeasurementUnitConversionResponse)(nil),               // 259: mealplanning.ArchiveValidMeasurementUnitConversionResponse
-	(*ArchiveValidPreparationResponse)(nil),                             // 260: mealplanning.ArchiveValidPreparationResponse
-	(*ArchiveValidPreparationInstrumentResponse)(nil),                   // 261: mealplanning.ArchiveValidPreparationInstrumentResponse
-	(*ArchiveValidPreparationVesselResponse)(nil),                       // 262: mealplanning.ArchiveValidPreparationVesselResponse
-	(*ArchiveValidVesselResponse)(nil),                                  // 263: mealplanning.ArchiveValidVesselResponse
-	(*CloneRecipeResponse)(nil),                                         // 264: mealplanning.CloneRecipeResponse
-	(*CreateMealResponse)(nil),                                          // 265: mealplanning.CreateMealResponse
-	(*CreateMealPlanResponse)(nil),                                      // 266: mealplanning.CreateMealPlanResponse
-	(*CreateMealPlanEventResponse)(nil),                                 // 267: mealplanning.CreateMealPlanEventResponse
-	(*CreateMealPlanOptionResponse)(nil),                                // 268: mealplanning.CreateMealPlanOptionResponse
-	(*CreateMealPlanOptionVoteResponse)(nil),                            // 269: mealplanning.CreateMealPlanOptionVoteResponse
-	(*CreateMealPlanRecipeOptionSelectionResponse)(nil),                 // 270: mealplanning.CreateMealPlanRecipeOptionSelectionResponse
-	(*CreateMealPlanTaskResponse)(nil),                                  // 271: mealplanning.CreateMealPlanTaskResponse
-	(*CreateMealListResponse)(nil),                                      // 272: mealplanning.CreateMealListResponse
-	(*CreateMealListItemResponse)(nil),                                  // 273: mealplanning.CreateMealListItemResponse
-	(*CreateRecipeResponse)(nil),                                        // 274: mealplanning.CreateRecipeResponse
-	(*CreateRecipePrepTaskResponse)(nil),                                // 275: mealplanning.CreateRecipePrepTaskResponse
-	(*CreateRecipeRatingResponse)(nil),                                  // 276: mealplanning.CreateRecipeRatingResponse
-	(*CreateRecipeListResponse)(nil),                                    // 277: mealplanning.CreateRecipeListResponse
-	(*CreateRecipeListItemResponse)(nil),                                // 278: mealplanning.CreateRecipeListItemResponse
-	(*CreateRecipeStepResponse)(nil),                                    // 279: mealplanning.CreateRecipeStepResponse
-	(*CreateRecipeStepCompletionConditionResponse)(nil),                 // 280: mealplanning.CreateRecipeStepCompletionConditionResponse
-	(*CreateRecipeStepIngredientResponse)(nil),                          // 281: mealplanning.CreateRecipeStepIngredientResponse
-	(*CreateRecipeStepInstrumentResponse)(nil),                          // 282: mealplanning.CreateRecipeStepInstrumentResponse
-	(*CreateRecipeStepProductResponse)(nil),                             // 283: mealplanning.CreateRecipeStepProductResponse
-	(*CreateRecipeStepVesselResponse)(nil),                              // 284: mealplanning.CreateRecipeStepVesselResponse
-	(*CreateValidIngredientResponse)(nil),                               // 285: mealplanning.CreateValidIngredientResponse
-	(*CreateValidIngredientGroupResponse)(nil),                          // 286: mealplanning.CreateValidIngredientGroupResponse
-	(*CreateValidIngredientMeasurementUnitResponse)(nil),                // 287: mealplanning.CreateValidIngredientMeasurementUnitResponse
-	(*CreateValidIngredientPreparationResponse)(nil),                    // 288: mealplanning.CreateValidIngredientPreparationResponse
-	(*CreateValidPrepTaskConfigResponse)(nil),                           // 289: mealplanning.CreateValidPrepTaskConfigResponse
-	(*CreateValidIngredientStateResponse)(nil),                          // 290: mealplanning.CreateValidIngredientStateResponse
-	(*CreateValidIngredientStateIngredientResponse)(nil),                // 291: mealplanning.CreateValidIngredientStateIngredientResponse
-	(*CreateValidInstrumentResponse)(nil),                               // 292: mealplanning.CreateValidInstrumentResponse
-	(*CreateValidMeasurementUnitResponse)(nil),                          // 293: mealplanning.CreateValidMeasurementUnitResponse
-	(*CreateValidMeasurementUnitConversionResponse)(nil),                // 294: mealplanning.CreateValidMeasurementUnitConversionResponse
-	(*CreateValidPreparationResponse)(nil),                              // 295: mealplanning.CreateValidPreparationResponse
-	(*CreateValidPreparationInstrumentResponse)(nil),                    // 296: mealplanning.CreateValidPreparationInstrumentResponse
-	(*CreateValidPreparationVesselResponse)(nil),                        // 297: mealplanning.CreateValidPreparationVesselResponse
-	(*CreateValidVesselResponse)(nil),                                   // 298: mealplanning.CreateValidVesselResponse
-	(*FinalizeMealPlanResponse)(nil),                                    // 299: mealplanning.FinalizeMealPlanResponse
-	(*GetMealResponse)(nil),                                             // 300: mealplanning.GetMealResponse
-	(*GetMealPlanResponse)(nil),                                         // 301: mealplanning.GetMealPlanResponse
-	(*GetMealPlanEventResponse)(nil),                                    // 302: mealplanning.GetMealPlanEventResponse
-	(*GetMealPlanEventsResponse)(nil),                                   // 303: mealplanning.GetMealPlanEventsResponse
-	(*GetMealPlanGroceryListItemResponse)(nil),                          // 304: mealplanning.GetMealPlanGroceryListItemResponse
-	(*GetMealPlanGroceryListItemsForMealPlanResponse)(nil),              // 305: mealplanning.GetMealPlanGroceryListItemsForMealPlanResponse
-	(*GetMealPlanOptionResponse)(nil),                                   // 306: mealplanning.GetMealPlanOptionResponse
-	(*GetMealPlanOptionVoteResponse)(nil),                               // 307: mealplanning.GetMealPlanOptionVoteResponse
-	(*GetMealPlanOptionVotesResponse)(nil),                              // 308: mealplanning.GetMealPlanOptionVotesResponse
-	(*GetMealPlanOptionsResponse)(nil),                                  // 309: mealplanning.GetMealPlanOptionsResponse
-	(*GetMealPlanRecipeOptionSelectionResponse)(nil),                    // 310: mealplanning.GetMealPlanRecipeOptionSelectionResponse
-	(*GetMealPlanRecipeOptionSelectionsForMealPlanOptionResponse)(nil),  // 311: mealplanning.GetMealPlanRecipeOptionSelectionsForMealPlanOptionResponse
-	(*GetMealPlanTaskResponse)(nil),                                     // 312: mealplanning.GetMealPlanTaskResponse
-	(*GetMealPlanTasksResponse)(nil),                                    // 313: mealplanning.GetMealPlanTasksResponse
-	(*GetMealPlansForAccountResponse)(nil),                              // 314: mealplanning.GetMealPlansForAccountResponse
-	(*GetMealListsResponse)(nil),                                        // 315: mealplanning.GetMealListsResponse
-	(*GetMealsResponse)(nil),                                            // 316: mealplanning.GetMealsResponse
-	(*GetMermaidDiagramForMealResponse)(nil),                            // 317: mealplanning.GetMermaidDiagramForMealResponse
-	(*GetMermaidDiagramForRecipeResponse)(nil),                          // 318: mealplanning.GetMermaidDiagramForRecipeResponse
-	(*GetRandomValidIngredientResponse)(nil),                            // 319: mealplanning.GetRandomValidIngredientResponse
-	(*GetRandomValidInstrumentResponse)(nil),                            // 320: mealplanning.GetRandomValidInstrumentResponse
-	(*GetRandomValidPreparationResponse)(nil),                           // 321: mealplanning.GetRandomValidPreparationResponse
-	(*GetRandomValidVesselResponse)(nil),                                // 322: mealplanning.GetRandomValidVesselResponse
-	(*GetRecipeResponse)(nil),                                           // 323: mealplanning.GetRecipeResponse
-	(*EstimateRecipePrepTasksResponse)(nil),                             // 324: mealplanning.EstimateRecipePrepTasksResponse
-	(*GetRecipePrepTaskResponse)(nil),                                   // 325: mealplanning.GetRecipePrepTaskResponse
-	(*GetRecipePrepTasksResponse)(nil),                                  // 326: mealplanning.GetRecipePrepTasksResponse
-	(*GetRecipeRatingResponse)(nil),                                     // 327: mealplanning.GetRecipeRatingResponse
-	(*GetRecipeRatingsForRecipeResponse)(nil),                           // 328: mealplanning.GetRecipeRatingsForRecipeResponse
-	(*GetRecipeStepResponse)(nil),                                       // 329: mealplanning.GetRecipeStepResponse
-	(*GetRecipeStepCompletionConditionResponse)(nil),                    // 330: mealplanning.GetRecipeStepCompletionConditionResponse
-	(*GetRecipeStepCompletionConditionsResponse)(nil),                   // 331: mealplanning.GetRecipeStepCompletionConditionsResponse
-	(*GetRecipeStepIngredientResponse)(nil),                             // 332: mealplanning.GetRecipeStepIngredientResponse
-	(*GetRecipeStepIngredientsResponse)(nil),                            // 333: mealplanning.GetRecipeStepIngredientsResponse
-	(*GetRecipeStepInstrumentResponse)(nil),                             // 334: mealplanning.GetRecipeStepInstrumentResponse
-	(*GetRecipeStepInstrumentsResponse)(nil),                            // 335: mealplanning.GetRecipeStepInstrumentsResponse
-	(*GetRecipeStepProductResponse)(nil),                                // 336: mealplanning.GetRecipeStepProductResponse
-	(*GetRecipeStepProductsResponse)(nil),                               // 337: mealplanning.GetRecipeStepProductsResponse
-	(*GetRecipeStepVesselResponse)(nil),                                 // 338: mealplanning.GetRecipeStepVesselResponse
-	(*GetRecipeStepVesselsResponse)(nil),                                // 339: mealplanning.GetRecipeStepVesselsResponse
-	(*GetRecipeStepsResponse)(nil),                                      // 340: mealplanning.GetRecipeStepsResponse
-	(*GetRecipeListsResponse)(nil),                                      // 341: mealplanning.GetRecipeListsResponse
-	(*GetRecipesResponse)(nil),                                          // 342: mealplanning.GetRecipesResponse
-	(*GetValidIngredientResponse)(nil),                                  // 343: mealplanning.GetValidIngredientResponse
-	(*GetValidIngredientGroupResponse)(nil),                             // 344: mealplanning.GetValidIngredientGroupResponse
-	(*GetValidIngredientGroupsResponse)(nil),                            // 345: mealplanning.GetValidIngredientGroupsResponse
-	(*GetValidIngredientMeasurementUnitResponse)(nil),                   // 346: mealplanning.GetValidIngredientMeasurementUnitResponse
-	(*GetValidIngredientMeasurementUnitsResponse)(nil),                  // 347: mealplanning.GetValidIngredientMeasurementUnitsResponse
-	(*GetValidIngredientMeasurementUnitsByIngredientResponse)(nil),      // 348: mealplanning.GetValidIngredientMeasurementUnitsByIngredientResponse
-	(*GetValidIngredientMeasurementUnitsByMeasurementUnitResponse)(nil), // 349: mealplanning.GetValidIngredientMeasurementUnitsByMeasurementUnitResponse
-	(*GetValidIngredientPreparationResponse)(nil),                       // 350: mealplanning.GetValidIngredientPreparationResponse
-	(*GetValidIngredientPreparationsResponse)(nil),                      // 351: mealplanning.GetValidIngredientPreparationsResponse
-	(*GetValidIngredientPreparationsByIngredientResponse)(nil),          // 352: mealplanning.GetValidIngredientPreparationsByIngredientResponse
-	(*GetValidIngredientPreparationsByPreparationResponse)(nil),         // 353: mealplanning.GetValidIngredientPreparationsByPreparationResponse
-	(*GetValidPrepTaskConfigResponse)(nil),                              // 354: mealplanning.GetValidPrepTaskConfigResponse
-	(*GetValidPrepTaskConfigsResponse)(nil),                             // 355: mealplanning.GetValidPrepTaskConfigsResponse
-	(*GetValidPrepTaskConfigsByIngredientResponse)(nil),                 // 356: mealplanning.GetValidPrepTaskConfigsByIngredientResponse
-	(*GetValidPrepTaskConfigsByPreparationResponse)(nil),                // 357: mealplanning.GetValidPrepTaskConfigsByPreparationResponse
-	(*GetValidPrepTaskConfigsByIngredientAndPreparationResponse)(nil),   // 358: mealplanning.GetValidPrepTaskConfigsByIngredientAndPreparationResponse
-	(*GetValidIngredientStateResponse)(nil),                             // 359: mealplanning.GetValidIngredientStateResponse
-	(*GetValidIngredientStateIngredientResponse)(nil),                   // 360: mealplanning.GetValidIngredientStateIngredientResponse
-	(*GetValidIngredientStateIngredientsResponse)(nil),                  // 361: mealplanning.GetValidIngredientStateIngredientsResponse
-	(*GetValidIngredientStateIngredientsByIngredientResponse)(nil),      // 362: mealplanning.GetValidIngredientStateIngredientsByIngredientResponse
-	(*GetValidIngredientStateIngredientsByIngredientStateResponse)(nil), // 363: mealplanning.GetValidIngredientStateIngredientsByIngredientStateResponse
-	(*GetValidIngredientStatesResponse)(nil),                            // 364: mealplanning.GetValidIngredientStatesResponse
-	(*GetValidIngredientsResponse)(nil),                                 // 365: mealplanning.GetValidIngredientsResponse
-	(*GetValidInstrumentResponse)(nil),                                  // 366: mealplanning.GetValidInstrumentResponse
-	(*GetValidInstrumentsResponse)(nil),                                 // 367: mealplanning.GetValidInstrumentsResponse
-	(*GetValidMeasurementUnitResponse)(nil),                             // 368: mealplanning.GetValidMeasurementUnitResponse
-	(*GetValidMeasurementUnitConversionResponse)(nil),                   // 369: mealplanning.GetValidMeasurementUnitConversionResponse
-	(*GetValidMeasurementUnitConversionsForUnitResponse)(nil),           // 370: mealplanning.GetValidMeasurementUnitConversionsForUnitResponse
-	(*GetValidMeasurementUnitConversionsForIngredientsResponse)(nil),    // 371: mealplanning.GetValidMeasurementUnitConversionsForIngredientsResponse
-	(*GetMeasurementUnitConversionMismatchesResponse)(nil),              // 372: mealplanning.GetMeasurementUnitConversionMismatchesResponse
-	(*GetValidMeasurementUnitsResponse)(nil),                            // 373: mealplanning.GetValidMeasurementUnitsResponse
-	(*GetValidPreparationResponse)(nil),                                 // 374: mealplanning.GetValidPreparationResponse
-	(*GetValidPreparationInstrumentResponse)(nil),                       // 375: mealplanning.GetValidPreparationInstrumentResponse
-	(*GetValidPreparationInstrumentsResponse)(nil),                      // 376: mealplanning.GetValidPreparationInstrumentsResponse
-	(*GetValidPreparationInstrumentsByInstrumentResponse)(nil),          // 377: mealplanning.GetValidPreparationInstrumentsByInstrumentResponse
-	(*GetValidPreparationInstrumentsByPreparationResponse)(nil),         // 378: mealplanning.GetValidPreparationInstrumentsByPreparationResponse
-	(*GetValidPreparationVesselResponse)(nil),                           // 379: mealplanning.GetValidPreparationVesselResponse
-	(*GetValidPreparationVesselsResponse)(nil),                          // 380: mealplanning.GetValidPreparationVesselsResponse
-	(*GetValidPreparationVesselsByPreparationResponse)(nil),             // 381: mealplanning.GetValidPreparationVesselsByPreparationResponse
-	(*GetValidPreparationVesselsByVesselResponse)(nil),                  // 382: mealplanning.GetValidPreparationVesselsByVesselResponse
-	(*GetValidPreparationsResponse)(nil),                                // 383: mealplanning.GetValidPreparationsResponse
-	(*GetValidVesselResponse)(nil),                                      // 384: mealplanning.GetValidVesselResponse
-	(*GetValidVesselsResponse)(nil),                                     // 385: mealplanning.GetValidVesselsResponse
-	(*RunFinalizeMealPlanWorkerResponse)(nil),                           // 386: mealplanning.RunFinalizeMealPlanWorkerResponse
-	(*RunMealPlanGroceryListInitializerWorkerResponse)(nil),             // 387: mealplanning.RunMealPlanGroceryListInitializerWorkerResponse
-	(*RunMealPlanTaskCreatorWorkerResponse)(nil),                        // 388: mealplanning.RunMealPlanTaskCreatorWorkerResponse
-	(*SearchForMealsResponse)(nil),                                      // 389: mealplanning.SearchForMealsResponse
-	(*SearchForRecipesResponse)(nil),                                    // 390: mealplanning.SearchForRecipesResponse
-	(*SearchForMealEligibleRecipesResponse)(nil),                        // 391: mealplanning.SearchForMealEligibleRecipesResponse
-	(*SearchForRecipesWithInstrumentOwnershipResponse)(nil),             // 392: mealplanning.SearchForRecipesWithInstrumentOwnershipResponse
-	(*SearchForValidIngredientGroupsResponse)(nil),                      // 393: mealplanning.SearchForValidIngredientGroupsResponse
-	(*SearchForValidIngredientStatesResponse)(nil),                      // 394: mealplanning.SearchForValidIngredientStatesResponse
-	(*SearchForValidIngredientsResponse)(nil),                           // 395: mealplanning.SearchForValidIngredientsResponse
-	(*SearchForValidInstrumentsResponse)(nil),                           // 396: mealplanning.SearchForValidInstrumentsResponse
-	(*SearchForValidMeasurementUnitsResponse)(nil),                      // 397: mealplanning.SearchForValidMeasurementUnitsResponse
-	(*SearchForValidPreparationsResponse)(nil),                          // 398: mealplanning.SearchForValidPreparationsResponse
-	(*SearchForValidVesselsResponse)(nil),                               // 399: mealplanning.SearchForValidVesselsResponse
-	(*SearchValidIngredientsByPreparationResponse)(nil),                 // 400: mealplanning.SearchValidIngredientsByPreparationResponse
-	(*SearchValidMeasurementUnitsByIngredientResponse)(nil),             // 401: mealplanning.SearchValidMeasurementUnitsByIngredientResponse
-	(*UpdateMealPlanResponse)(nil),                                      // 402: mealplanning.UpdateMealPlanResponse
-	(*UpdateMealPlanEventResponse)(nil),                                 // 403: mealplanning.UpdateMealPlanEventResponse
-	(*SwapMealPlanEventsResponse)(nil),                                  // 404: mealplanning.SwapMealPlanEventsResponse
-	(*UpdateMealPlanGroceryListItemResponse)(nil),                       // 405: mealplanning.UpdateMealPlanGroceryListItemResponse
-	(*UpdateMealPlanOptionResponse)(nil),                                // 406: mealplanning.UpdateMealPlanOptionResponse
-	(*UpdateMealPlanOptionVoteResponse)(nil),                            // 407: mealplanning.UpdateMealPlanOptionVoteResponse
-	(*UpdateMealPlanRecipeOptionSelectionResponse)(nil),                 // 408: mealplanning.UpdateMealPlanRecipeOptionSelectionResponse
-	(*UpdateMealPlanTaskStatusResponse)(nil),                            // 409: mealplanning.UpdateMealPlanTaskStatusResponse
-	(*UpdateMealListResponse)(nil),                                      // 410: mealplanning.UpdateMealListResponse
-	(*UpdateMealListItemResponse)(nil),                                  // 411: mealplanning.UpdateMealListItemResponse
-	(*UpdateRecipeResponse)(nil),                                        // 412: mealplanning.UpdateRecipeResponse
-	(*UpdateRecipeStatusResponse)(nil),                                  // 413: mealplanning.UpdateRecipeStatusResponse
-	(*UpdateRecipePrepTaskResponse)(nil),                                // 414: mealplanning.UpdateRecipePrepTaskResponse
-	(*UpdateRecipeRatingResponse)(nil),                                  // 415: mealplanning.UpdateRecipeRatingResponse
-	(*UpdateRecipeListResponse)(nil),                                    // 416: mealplanning.UpdateRecipeListResponse
-	(*UpdateRecipeListItemResponse)(nil),                                // 417: mealplanning.UpdateRecipeListItemResponse
-	(*UpdateRecipeStepResponse)(nil),                                    // 418: mealplanning.UpdateRecipeStepResponse
-	(*UpdateRecipeStepCompletionConditionResponse)(nil),                 // 419: mealplanning.UpdateRecipeStepCompletionConditionResponse
-	(*UpdateRecipeStepIngredientResponse)(nil),                          // 420: mealplanning.UpdateRecipeStepIngredientResponse
-	(*UpdateRecipeStepInstrumentResponse)(nil),                          // 421: mealplanning.UpdateRecipeStepInstrumentResponse
-	(*UpdateRecipeStepProductResponse)(nil),                             // 422: mealplanning.UpdateRecipeStepProductResponse
-	(*UpdateRecipeStepVesselResponse)(nil),                              // 423: mealplanning.UpdateRecipeStepVesselResponse
-	(*UpdateValidIngredientResponse)(nil),                               // 424: mealplanning.UpdateValidIngredientResponse
-	(*UpdateValidIngredientGroupResponse)(nil),                          // 425: mealplanning.UpdateValidIngredientGroupResponse
-	(*UpdateValidIngredientMeasurementUnitResponse)(nil),                // 426: mealplanning.UpdateValidIngredientMeasurementUnitResponse
-	(*UpdateValidIngredientPreparationResponse)(nil),                    // 427: mealplanning.UpdateValidIngredientPreparationResponse
-	(*UpdateValidPrepTaskConfigResponse)(nil),                           // 428: mealplanning.UpdateValidPrepTaskConfigResponse
-	(*UpdateValidIngredientStateResponse)(nil),                          // 429: mealplanning.UpdateValidIngredientStateResponse
-	(*UpdateValidIngredientStateIngredientResponse)(nil),                // 430: mealplanning.UpdateValidIngredientStateIngredientResponse
-	(*UpdateValidInstrumentResponse)(nil),                               // 431: mealplanning.UpdateValidInstrumentResponse
-	(*UpdateValidMeasurementUnitResponse)(nil),                          // 432: mealplanning.UpdateValidMeasurementUnitResponse
-	(*UpdateValidMeasurementUnitConversionResponse)(nil),                // 433: mealplanning.UpdateValidMeasurementUnitConversionResponse
-	(*UpdateValidPreparationResponse)(nil),                              // 434: mealplanning.UpdateValidPreparationResponse
-	(*UpdateValidPreparationInstrumentResponse)(nil),                    // 435: mealplanning.UpdateValidPreparationInstrumentResponse
-	(*UpdateValidPreparationVesselResponse)(nil),                        // 436: mealplanning.UpdateValidPreparationVesselResponse
-	(*UpdateValidVesselResponse)(nil),                                   // 437: mealplanning.UpdateValidVesselResponse
-	(*ArchiveAccountInstrumentOwnershipResponse)(nil),                   // 438: mealplanning.ArchiveAccountInstrumentOwnershipResponse
-	(*ArchiveUserIngredientPreferenceResponse)(nil),                     // 439: mealplanning.ArchiveUserIngredientPreferenceResponse
-	(*CreateAccountInstrumentOwnershipResponse)(nil),                    // 440: mealplanning.CreateAccountInstrumentOwnershipResponse
-	(*CreateUserIngredientPreferenceResponse)(nil),                      // 441: mealplanning.CreateUserIngredientPreferenceResponse
-	(*GetAccountInstrumentOwnershipResponse)(nil),                       // 442: mealplanning.GetAccountInstrumentOwnershipResponse
-	(*GetAccountInstrumentOwnershipsResponse)(nil),                      // 443: mealplanning.GetAccountInstrumentOwnershipsResponse
-	(*SearchForValidInstrumentsNotOwnedByAccountResponse)(nil),          // 444: mealplanning.SearchForValidInstrumentsNotOwnedByAccountResponse
-	(*GetUserIngredientPreferenceResponse)(nil),                         // 445: mealplanning.GetUserIngredientPreferenceResponse
-	(*GetUserIngredientPreferencesResponse)(nil),                        // 446: mealplanning.GetUserIngredientPreferencesResponse
-	(*UpdateAccountInstrumentOwnershipResponse)(nil),                    // 447: mealplanning.UpdateAccountInstrumentOwnershipResponse
-	(*UpdateUserIngredientPreferenceResponse)(nil),                      // 448: mealplanning.UpdateUserIngredientPreferenceResponse
-	(*UploadMealImageResponse)(nil),                                     // 449: mealplanning.UploadMealImageResponse
-	(*UploadRecipeImageResponse)(nil),                                   // 450: mealplanning.UploadRecipeImageResponse
-	(*UploadPreparationMediaResponse)(nil),                              // 451: mealplanning.UploadPreparationMediaResponse
-	(*UploadIngredientMediaResponse)(nil),                               // 452: mealplanning.UploadIngredientMediaResponse
-	(*UploadRecipeStepImageResponse)(nil),                               // 453: mealplanning.UploadRecipeStepImageResponse
+	(*ArchiveMealPlanTemplateRequest)(nil),                              // 10: mealplanning.ArchiveMealPlanTemplateRequest
+	(*ArchiveMealListRequest)(nil),                                      // 11: mealplanning.ArchiveMealListRequest
+	(*ArchiveMealListItemRequest)(nil),                                  // 12: mealplanning.ArchiveMealListItemRequest
+	(*ArchiveRecipeRequest)(nil),                                        // 13: mealplanning.ArchiveRecipeRequest
+	(*ArchiveRecipePrepTaskRequest)(nil),                                // 14: mealplanning.ArchiveRecipePrepTaskRequest
+	(*ArchiveRecipeRatingRequest)(nil),                                  // 15: mealplanning.ArchiveRecipeRatingRequest
+	(*ArchiveRecipeListRequest)(nil),                                    // 16: mealplanning.ArchiveRecipeListRequest
+	(*ArchiveRecipeListItemRequest)(nil),                                // 17: mealplanning.ArchiveRecipeListItemRequest
+	(*ArchiveRecipeStepRequest)(nil),                                    // 18: mealplanning.ArchiveRecipeStepRequest
+	(*ArchiveRecipeStepCompletionConditionRequest)(nil),                 // 19: mealplanning.ArchiveRecipeStepCompletionConditionRequest
+	(*ArchiveRecipeStepIngredientRequest)(nil),                          // 20: mealplanning.ArchiveRecipeStepIngredientRequest
+	(*ArchiveRecipeStepInstrumentRequest)(nil),                          // 21: mealplanning.ArchiveRecipeStepInstrumentRequest
+	(*ArchiveRecipeStepProductRequest)(nil),                             // 22: mealplanning.ArchiveRecipeStepProductRequest
+	(*ArchiveRecipeStepVesselRequest)(nil),                              // 23: mealplanning.ArchiveRecipeStepVesselRequest
+	(*ArchiveValidIngredientRequest)(nil),                               // 24: mealplanning.ArchiveValidIngredientRequest
+	(*ArchiveValidIngredientGroupRequest)(nil),                          // 25: mealplanning.ArchiveValidIngredientGroupRequest
+	(*ArchiveValidIngredientMeasurementUnitRequest)(nil),                // 26: mealplanning.ArchiveValidIngredientMeasurementUnitRequest
+	(*ArchiveValidIngredientNutritionFactsRequest)(nil),                 // 27: mealplanning.ArchiveValidIngredientNutritionFactsRequest
+	(*ArchiveValidIngredientPreparationRequest)(nil),                    // 28: mealplanning.ArchiveValidIngredientPreparationRequest
+	(*ArchiveValidPrepTaskConfigRequest)(nil),                           // 29: mealplanning.ArchiveValidPrepTaskConfigRequest
+	(*ArchiveValidIngredientStateRequest)(nil),                          // 30: mealplanning.ArchiveValidIngredientStateRequest
+	(*ArchiveValidIngredientStateIngredientRequest)(nil),                // 31: mealplanning.ArchiveValidIngredientStateIngredientRequest
+	(*ArchiveValidInstrumentRequest)(nil),                               // 32: mealplanning.ArchiveValidInstrumentRequest
+	(*ArchiveValidMeasurementUnitRequest)(nil),                          // 33: mealplanning.ArchiveValidMeasurementUnitRequest
+	(*ArchiveValidMeasurementUnitConversionRequest)(nil),                // 34: mealplanning.ArchiveValidMeasurementUnitConversionRequest
+	(*ArchiveValidPreparationRequest)(nil),                              // 35: mealplanning.ArchiveValidPreparationRequest
+	(*ArchiveValidPreparationInstrumentRequest)(nil),                    // 36: mealplanning.ArchiveValidPreparationInstrumentRequest
+	(*ArchiveValidPreparationVesselRequest)(nil),                        // 37: mealplanning.ArchiveValidPreparationVesselRequest
+	(*ArchiveValidVesselRequest)(nil),                                   // 38: mealplanning.ArchiveValidVesselRequest
+	(*CloneRecipeRequest)(nil),                                          // 39: mealplanning.CloneRecipeRequest
+	(*ImportRecipeRequest)(nil),                                         // 40: mealplanning.ImportRecipeRequest
+	(*CreateMealRequest)(nil),                                           // 41: mealplanning.CreateMealRequest
+	(*CreateMealPlanRequest)(nil),                                       // 42: mealplanning.CreateMealPlanRequest
+	(*CreateMealPlanCalendarFeedRequest)(nil),                           // 43: mealplanning.CreateMealPlanCalendarFeedRequest
+	(*CreateMealPlanEventRequest)(nil),                                  // 44: mealplanning.CreateMealPlanEventRequest
+	(*CreateMealPlanOptionRequest)(nil),                                 // 45: mealplanning.CreateMealPlanOptionRequest
+	(*CreateMealPlanOptionVoteRequest)(nil),                             // 46: mealplanning.CreateMealPlanOptionVoteRequest
+	(*CreateMealPlanRecipeOptionSelectionRequest)(nil),                  // 47: mealplanning.CreateMealPlanRecipeOptionSelectionRequest
+	(*CreateMealPlanTaskRequest)(nil),                                   // 48: mealplanning.CreateMealPlanTaskRequest
+	(*CreateMealPlanTemplateRequest)(nil),                               // 49: mealplanning.CreateMealPlanTemplateRequest
+	(*CreateMealListRequest)(nil),                                       // 50: mealplanning.CreateMealListRequest
+	(*CreateMealListItemRequest)(nil),                                   // 51: mealplanning.CreateMealListItemRequest
+	(*CreateRecipeRequest)(nil),                                         // 52: mealplanning.CreateRecipeRequest
+	(*CreateRecipePrepTaskRequest)(nil),                                 // 53: mealplanning.CreateRecipePrepTaskRequest
+	(*CreateRecipeRatingRequest)(nil),                                   // 54: mealplanning.CreateRecipeRatingRequest
+	(*CreateRecipeListRequest)(nil),                                     // 55: mealplanning.CreateRecipeListRequest
+	(*CreateRecipeListItemRequest)(nil),                                 // 56: mealplanning.CreateRecipeListItemRequest
+	(*CreateRecipeStepRequest)(nil),                                     // 57: mealplanning.CreateRecipeStepRequest
+	(*CreateRecipeStepCompletionConditionRequest)(nil),                  // 58: mealplanning.CreateRecipeStepCompletionConditionRequest
+	(*CreateRecipeStepIngredientRequest)(nil),                           // 59: mealplanning.CreateRecipeStepIngredientRequest
+	(*CreateRecipeStepInstrumentRequest)(nil),                           // 60: mealplanning.CreateRecipeStepInstrumentRequest
+	(*CreateRecipeStepProductRequest)(nil),                              // 61: mealplanning.CreateRecipeStepProductRequest
+	(*CreateRecipeStepVesselRequest)(nil),                               // 62: mealplanning.CreateRecipeStepVesselRequest
+	(*CreateValidIngredientRequest)(nil),                                // 63: mealplanning.CreateValidIngredientRequest
+	(*CreateValidIngredientGroupRequest)(nil),                           // 64: mealplanning.CreateValidIngredientGroupRequest
+	(*CreateValidIngredientMeasurementUnitRequest)(nil),                 // 65: mealplanning.CreateValidIngredientMeasurementUnitRequest
+	(*CreateValidIngredientNutritionFactsRequest)(nil),                  // 66: mealplanning.CreateValidIngredientNutritionFactsRequest
+	(*CreateValidIngredientPreparationRequest)(nil),                     // 67: mealplanning.CreateValidIngredientPreparationRequest
+	(*CreateValidPrepTaskConfigRequest)(nil),                            // 68: mealplanning.CreateValidPrepTaskConfigRequest
+	(*CreateValidIngredientStateRequest)(nil),                           // 69: mealplanning.CreateValidIngredientStateRequest
+	(*CreateValidIngredientStateIngredientRequest)(nil),                 // 70: mealplanning.CreateValidIngredientStateIngredientRequest
+	(*CreateValidInstrumentRequest)(nil),                                // 71: mealplanning.CreateValidInstrumentRequest
+	(*CreateValidMeasurementUnitRequest)(nil),                           // 72: mealplanning.CreateValidMeasurementUnitRequest
+	(*CreateValidMeasurementUnitConversionRequest)(nil),                 // 73: mealplanning.CreateValidMeasurementUnitConversionRequest
+	(*CreateValidPreparationRequest)(nil),                               // 74: mealplanning.CreateValidPreparationRequest
+	(*CreateValidPreparationInstrumentRequest)(nil),                     // 75: mealplanning.CreateValidPreparationInstrumentRequest
+	(*CreateValidPreparationVesselRequest)(nil),                         // 76: mealplanning.CreateValidPreparationVesselRequest
+	(*CreateValidVesselRequest)(nil),                                    // 77: mealplanning.CreateValidVesselRequest
+	(*CompleteMealPlanOptionRequest)(nil),                               // 78: mealplanning.CompleteMealPlanOptionRequest
+	(*DiffRecipeRevisionsRequest)(nil),                                  // 79: mealplanning.DiffRecipeRevisionsRequest
+	(*FinalizeMealPlanRequest)(nil),                                     // 80: mealplanning.FinalizeMealPlanRequest
+	(*GetMealRequest)(nil),                                              // 81: mealplanning.GetMealRequest
+	(*GetMealPlanRequest)(nil),                                          // 82: mealplanning.GetMealPlanRequest
+	(*GetMealPlanEventRequest)(nil),                                     // 83: mealplanning.GetMealPlanEventRequest
+	(*GetMealPlanEventTallyReportRequest)(nil),                          // 84: mealplanning.GetMealPlanEventTallyReportRequest
+	(*GetMealPlanEventsRequest)(nil),                                    // 85: mealplanning.GetMealPlanEventsRequest
+	(*GetMealPlanGroceryListItemRequest)(nil),                           // 86: mealplanning.GetMealPlanGroceryListItemRequest
+	(*GetMealPlanGroceryListItemsForMealPlanRequest)(nil),               // 87: mealplanning.GetMealPlanGroceryListItemsForMealPlanRequest
+	(*GetMealPlanOptionRequest)(nil),                                    // 88: mealplanning.GetMealPlanOptionRequest
+	(*GetMealPlanOptionVoteRequest)(nil),                                // 89: mealplanning.GetMealPlanOptionVoteRequest
+	(*GetMealPlanOptionVotesRequest)(nil),                               // 90: mealplanning.GetMealPlanOptionVotesRequest
+	(*GetMealPlanOptionsRequest)(nil),                                   // 91: mealplanning.GetMealPlanOptionsRequest
+	(*GetMealPlanRecipeOptionSelectionRequest)(nil),                     // 92: mealplanning.GetMealPlanRecipeOptionSelectionRequest
+	(*GetMealPlanRecipeOptionSelectionsForMealPlanOptionRequest)(nil),   // 93: mealplanning.GetMealPlanRecipeOptionSelectionsForMealPlanOptionRequest
+	(*GetMealPlanTaskRequest)(nil),                                      // 94: mealplanning.GetMealPlanTaskRequest
+	(*GetMealPlanTasksRequest)(nil),                                     // 95: mealplanning.GetMealPlanTasksRequest
+	(*GetMealPlanTemplateRequest)(nil),                                  // 96: mealplanning.GetMealPlanTemplateRequest
+	(*GetMealPlanTemplatesRequest)(nil),                                 // 97: mealplanning.GetMealPlanTemplatesRequest
+	(*GetMealPlansForAccountRequest)(nil),                               // 98: mealplanning.GetMealPlansForAccountRequest
+	(*GetMealListsRequest)(nil),                                         // 99: mealplanning.GetMealListsRequest
+	(*GetMealsRequest)(nil),                                             // 100: mealplanning.GetMealsRequest
+	(*GetCookTimelineForMealPlanOptionRequest)(nil),                     // 101: mealplanning.GetCookTimelineForMealPlanOptionRequest
+	(*GetNutritionForMealRequest)(nil),                                  // 102: mealplanning.GetNutritionForMealRequest
+	(*GetNutritionForMealPlanRequest)(nil),                              // 103: mealplanning.GetNutritionForMealPlanRequest
+	(*GetNutritionForRecipeRequest)(nil),                                // 104: mealplanning.GetNutritionForRecipeRequest
+	(*GetMermaidDiagramForMealRequest)(nil),                             // 105: mealplanning.GetMermaidDiagramForMealRequest
+	(*GetMermaidDiagramForRecipeRequest)(nil),                           // 106: mealplanning.GetMermaidDiagramForRecipeRequest
+	(*GetRandomValidIngredientRequest)(nil),                             // 107: mealplanning.GetRandomValidIngredientRequest
+	(*GetRandomValidInstrumentRequest)(nil),                             // 108: mealplanning.GetRandomValidInstrumentRequest
+	(*GetRandomValidPreparationRequest)(nil),                            // 109: mealplanning.GetRandomValidPreparationRequest
+	(*GetRandomValidVesselRequest)(nil),                                 // 110: mealplanning.GetRandomValidVesselRequest
+	(*GetRecipeRequest)(nil),                                            // 111: mealplanning.GetRecipeRequest
+	(*EstimateRecipePrepTasksRequest)(nil),                              // 112: mealplanning.EstimateRecipePrepTasksRequest
+	(*GetRecipePrepTaskRequest)(nil),                                    // 113: mealplanning.GetRecipePrepTaskRequest
+	(*GetRecipePrepTasksRequest)(nil),                                   // 114: mealplanning.GetRecipePrepTasksRequest
+	(*GetRecipeRatingRequest)(nil),                                      // 115: mealplanning.GetRecipeRatingRequest
+	(*GetRecipeRatingsForRecipeRequest)(nil),                            // 116: mealplanning.GetRecipeRatingsForRecipeRequest
+	(*GetRecipeRevisionRequest)(nil),                                    // 117: mealplanning.GetRecipeRevisionRequest
+	(*GetRecipeRevisionsRequest)(nil),                                   // 118: mealplanning.GetRecipeRevisionsRequest
+	(*GetRecipeStepRequest)(nil),                                        // 119: mealplanning.GetRecipeStepRequest
+	(*GetRecipeStepCompletionConditionRequest)(nil),                     // 120: mealplanning.GetRecipeStepCompletionConditionRequest
+	(*GetRecipeStepCompletionConditionsRequest)(nil),                    // 121: mealplanning.GetRecipeStepCompletionConditionsRequest
+	(*GetRecipeStepIngredientRequest)(nil),                              // 122: mealplanning.GetRecipeStepIngredientRequest
+	(*GetRecipeStepIngredientsRequest)(nil),                             // 123: mealplanning.GetRecipeStepIngredientsRequest
+	(*GetRecipeStepInstrumentRequest)(nil),                              // 124: mealplanning.GetRecipeStepInstrumentRequest
+	(*GetRecipeStepInstrumentsRequest)(nil),                             // 125: mealplanning.GetRecipeStepInstrumentsRequest
+	(*GetRecipeStepProductRequest)(nil),                                 // 126: mealplanning.GetRecipeStepProductRequest
+	(*GetRecipeStepProductsRequest)(nil),                                // 127: mealplanning.GetRecipeStepProductsRequest
+	(*GetRecipeStepVesselRequest)(nil),                                  // 128: mealplanning.GetRecipeStepVesselRequest
+	(*GetRecipeStepVesselsRequest)(nil),                                 // 129: mealplanning.GetRecipeStepVesselsRequest
+	(*GetRecipeStepsRequest)(nil),                                       // 130: mealplanning.GetRecipeStepsRequest
+	(*GetRecipeListsRequest)(nil),                                       // 131: mealplanning.GetRecipeListsRequest
+	(*GetRecipesRequest)(nil),                                           // 132: mealplanning.GetRecipesRequest
+	(*GetValidIngredientRequest)(nil),                                   // 133: mealplanning.GetValidIngredientRequest
+	(*GetValidIngredientGroupRequest)(nil),                              // 134: mealplanning.GetValidIngredientGroupRequest
+	(*GetValidIngredientGroupsRequest)(nil),                             // 135: mealplanning.GetValidIngredientGroupsRequest
+	(*GetValidIngredientMeasurementUnitRequest)(nil),                    // 136: mealplanning.GetValidIngredientMeasurementUnitRequest
+	(*GetValidIngredientMeasurementUnitsRequest)(nil),                   // 137: mealplanning.GetValidIngredientMeasurementUnitsRequest
+	(*GetValidIngredientMeasurementUnitsByIngredientRequest)(nil),       // 138: mealplanning.GetValidIngredientMeasurementUnitsByIngredientRequest
+	(*GetValidIngredientMeasurementUnitsByMeasurementUnitRequest)(nil),  // 139: mealplanning.GetValidIngredientMeasurementUnitsByMeasurementUnitRequest
+	(*GetValidIngredientNutritionFactsRequest)(nil),                     // 140: mealplanning.GetValidIngredientNutritionFactsRequest
+	(*GetValidIngredientPreparationRequest)(nil),                        // 141: mealplanning.GetValidIngredientPreparationRequest
+	(*GetValidIngredientPreparationsRequest)(nil),                       // 142: mealplanning.GetValidIngredientPreparationsRequest
+	(*GetValidIngredientPreparationsByIngredientRequest)(nil),           // 143: mealplanning.GetValidIngredientPreparationsByIngredientRequest
+	(*GetValidIngredientPreparationsByPreparationRequest)(nil),          // 144: mealplanning.GetValidIngredientPreparationsByPreparationRequest
+	(*GetValidPrepTaskConfigRequest)(nil),                               // 145: mealplanning.GetValidPrepTaskConfigRequest
+	(*GetValidPrepTaskConfigsRequest)(nil),                              // 146: mealplanning.GetValidPrepTaskConfigsRequest
+	(*GetValidPrepTaskConfigsByIngredientRequest)(nil),                  // 147: mealplanning.GetValidPrepTaskConfigsByIngredientRequest
+	(*GetValidPrepTaskConfigsByPreparationRequest)(nil),                 // 148: mealplanning.GetValidPrepTaskConfigsByPreparationRequest
+	(*GetValidPrepTaskConfigsByIngredientAndPreparationRequest)(nil),    // 149: mealplanning.GetValidPrepTaskConfigsByIngredientAndPreparationRequest
+	(*GetValidIngredientStateRequest)(nil),                              // 150: mealplanning.GetValidIngredientStateRequest
+	(*GetValidIngredientStateIngredientRequest)(nil),                    // 151: mealplanning.GetValidIngredientStateIngredientRequest
+	(*GetValidIngredientStateIngredientsRequest)(nil),                   // 152: mealplanning.GetValidIngredientStateIngredientsRequest
+	(*GetValidIngredientStateIngredientsByIngredientRequest)(nil),       // 153: mealplanning.GetValidIngredientStateIngredientsByIngredientRequest
+	(*GetValidIngredientStateIngredientsByIngredientStateRequest)(nil),  // 154: mealplanning.GetValidIngredientStateIngredientsByIngredientStateRequest
+	(*GetValidIngredientStatesRequest)(nil),                             // 155: mealplanning.GetValidIngredientStatesRequest
+	(*GetValidIngredientsRequest)(nil),                                  // 156: mealplanning.GetValidIngredientsRequest
+	(*GetValidInstrumentRequest)(nil),                                   // 157: mealplanning.GetValidInstrumentRequest
+	(*GetValidInstrumentsRequest)(nil),                                  // 158: mealplanning.GetValidInstrumentsRequest
+	(*GetValidMeasurementUnitRequest)(nil),                              // 159: mealplanning.GetValidMeasurementUnitRequest
+	(*GetValidMeasurementUnitConversionRequest)(nil),                    // 160: mealplanning.GetValidMeasurementUnitConversionRequest
+	(*GetValidMeasurementUnitConversionsForUnitRequest)(nil),            // 161: mealplanning.GetValidMeasurementUnitConversionsForUnitRequest
+	(*GetValidMeasurementUnitConversionsForIngredientsRequest)(nil),     // 162: mealplanning.GetValidMeasurementUnitConversionsForIngredientsRequest
+	(*GetMeasurementUnitConversionMismatchesRequest)(nil),               // 163: mealplanning.GetMeasurementUnitConversionMismatchesRequest
+	(*GetValidMeasurementUnitsRequest)(nil),                             // 164: mealplanning.GetValidMeasurementUnitsRequest
+	(*GetValidPreparationRequest)(nil),                                  // 165: mealplanning.GetValidPreparationRequest
+	(*GetValidPreparationInstrumentRequest)(nil),                        // 166: mealplanning.GetValidPreparationInstrumentRequest
+	(*GetValidPreparationInstrumentsRequest)(nil),                       // 167: mealplanning.GetValidPreparationInstrumentsRequest
+	(*GetValidPreparationInstrumentsByInstrumentRequest)(nil),           // 168: mealplanning.GetValidPreparationInstrumentsByInstrumentRequest
+	(*GetValidPreparationInstrumentsByPreparationRequest)(nil),          // 169: mealplanning.GetValidPreparationInstrumentsByPreparationRequest
+	(*GetValidPreparationVesselRequest)(nil),                            // 170: mealplanning.GetValidPreparationVesselRequest
+	(*GetValidPreparationVesselsRequest)(nil),                           // 171: mealplanning.GetValidPreparationVesselsRequest
+	(*GetValidPreparationVesselsByPreparationRequest)(nil),              // 172: mealplanning.GetValidPreparationVesselsByPreparationRequest
+	(*GetValidPreparationVesselsByVesselRequest)(nil),                   // 173: mealplanning.GetValidPreparationVesselsByVesselRequest
+	(*GetValidPreparationsRequest)(nil),                                 // 174: mealplanning.GetValidPreparationsRequest
+	(*GetValidVesselRequest)(nil),                                       // 175: mealplanning.GetValidVesselRequest
+	(*GetValidVesselsRequest)(nil),                                      // 176: mealplanning.GetValidVesselsRequest
+	(*RestoreRecipeRevisionRequest)(nil),                                // 177: mealplanning.RestoreRecipeRevisionRequest
+	(*RunFinalizeMealPlanWorkerRequest)(nil),                            // 178: mealplanning.RunFinalizeMealPlanWorkerRequest
+	(*RunMealPlanGroceryListInitializerWorkerRequest)(nil),              // 179: mealplanning.RunMealPlanGroceryListInitializerWorkerRequest
+	(*RunMealPlanTaskCreatorWorkerRequest)(nil),                         // 180: mealplanning.RunMealPlanTaskCreatorWorkerRequest
+	(*SearchForMealsRequest)(nil),                                       // 181: mealplanning.SearchForMealsRequest
+	(*SearchForRecipesRequest)(nil),                                     // 182: mealplanning.SearchForRecipesRequest
+	(*SearchForMealEligibleRecipesRequest)(nil),                         // 183: mealplanning.SearchForMealEligibleRecipesRequest
+	(*SearchForRecipesWithInstrumentOwnershipRequest)(nil),              // 184: mealplanning.SearchForRecipesWithInstrumentOwnershipRequest
+	(*SearchForValidIngredientGroupsRequest)(nil),                       // 185: mealplanning.SearchForValidIngredientGroupsRequest
+	(*SearchForValidIngredientStatesRequest)(nil),                       // 186: mealplanning.SearchForValidIngredientStatesRequest
+	(*SearchForValidIngredientsRequest)(nil),                            // 187: mealplanning.SearchForValidIngredientsRequest
+	(*SearchForValidInstrumentsRequest)(nil),                            // 188: mealplanning.SearchForValidInstrumentsRequest
+	(*SearchForValidMeasurementUnitsRequest)(nil),                       // 189: mealplanning.SearchForValidMeasurementUnitsRequest
+	(*SearchForValidPreparationsRequest)(nil),                           // 190: mealplanning.SearchForValidPreparationsRequest
+	(*SearchForValidVesselsRequest)(nil),                                // 191: mealplanning.SearchForValidVesselsRequest
+	(*SearchValidIngredientsByPreparationRequest)(nil),                  // 192: mealplanning.SearchValidIngredientsByPreparationRequest
+	(*SearchValidMeasurementUnitsByIngredientRequest)(nil),              // 193: mealplanning.SearchValidMeasurementUnitsByIngredientRequest
+	(*UpdateMealPlanRequest)(nil),                                       // 194: mealplanning.UpdateMealPlanRequest
+	(*UpdateMealPlanEventRequest)(nil),                                  // 195: mealplanning.UpdateMealPlanEventRequest
+	(*SwapMealPlanEventsRequest)(nil),                                   // 196: mealplanning.SwapMealPlanEventsRequest
+	(*UpdateMealPlanGroceryListItemRequest)(nil),                        // 197: mealplanning.UpdateMealPlanGroceryListItemRequest
+	(*UpdateMealPlanOptionRequest)(nil),                                 // 198: mealplanning.UpdateMealPlanOptionRequest
+	(*UpdateMealPlanOptionVoteRequest)(nil),                             // 199: mealplanning.UpdateMealPlanOptionVoteRequest
+	(*UpdateMealPlanRecipeOptionSelectionRequest)(nil),                  // 200: mealplanning.UpdateMealPlanRecipeOptionSelectionRequest
+	(*UpdateMealPlanTaskStatusRequest)(nil),                             // 201: mealplanning.UpdateMealPlanTaskStatusRequest
+	(*UpdateMealPlanTemplateRequest)(nil),                               // 202: mealplanning.UpdateMealPlanTemplateRequest
+	(*UpdateMealListRequest)(nil),                                       // 203: mealplanning.UpdateMealListRequest
+	(*UpdateMealListItemRequest)(nil),                                   // 204: mealplanning.UpdateMealListItemRequest
+	(*UpdateRecipeRequest)(nil),                                         // 205: mealplanning.UpdateRecipeRequest
+	(*UpdateRecipeStatusRequest)(nil),                                   // 206: mealplanning.UpdateRecipeStatusRequest
+	(*UpdateRecipePrepTaskRequest)(nil),                                 // 207: mealplanning.UpdateRecipePrepTaskRequest
+	(*UpdateRecipeRatingRequest)(nil),                                   // 208: mealplanning.UpdateRecipeRatingRequest
+	(*UpdateRecipeListRequest)(nil),                                     // 209: mealplanning.UpdateRecipeListRequest
+	(*UpdateRecipeListItemRequest)(nil),                                 // 210: mealplanning.UpdateRecipeListItemRequest
+	(*UpdateRecipeStepRequest)(nil),                                     // 211: mealplanning.UpdateRecipeStepRequest
+	(*UpdateRecipeStepCompletionConditionRequest)(nil),                  // 212: mealplanning.UpdateRecipeStepCompletionConditionRequest
+	(*UpdateRecipeStepIngredientRequest)(nil),                           // 213: mealplanning.UpdateRecipeStepIngredientRequest
+	(*UpdateRecipeStepInstrumentRequest)(nil),                           // 214: mealplanning.UpdateRecipeStepInstrumentRequest
+	(*UpdateRecipeStepProductRequest)(nil),                              // 215: mealplanning.UpdateRecipeStepProductRequest
+	(*UpdateRecipeStepVesselRequest)(nil),                               // 216: mealplanning.UpdateRecipeStepVesselRequest
+	(*UpdateValidIngredientRequest)(nil),                                // 217: mealplanning.UpdateValidIngredientRequest
+	(*UpdateValidIngredientGroupRequest)(nil),                           // 218: mealplanning.UpdateValidIngredientGroupRequest
+	(*UpdateValidIngredientMeasurementUnitRequest)(nil),                 // 219: mealplanning.UpdateValidIngredientMeasurementUnitRequest
+	(*UpdateValidIngredientNutritionFactsRequest)(nil),                  // 220: mealplanning.UpdateValidIngredientNutritionFactsRequest
+	(*UpdateValidIngredientPreparationRequest)(nil),                     // 221: mealplanning.UpdateValidIngredientPreparationRequest
+	(*UpdateValidPrepTaskConfigRequest)(nil),                            // 222: mealplanning.UpdateValidPrepTaskConfigRequest
+	(*UpdateValidIngredientStateRequest)(nil),                           // 223: mealplanning.UpdateValidIngredientStateRequest
+	(*UpdateValidIngredientStateIngredientRequest)(nil),                 // 224: mealplanning.UpdateValidIngredientStateIngredientRequest
+	(*UpdateValidInstrumentRequest)(nil),                                // 225: mealplanning.UpdateValidInstrumentRequest
+	(*UpdateValidMeasurementUnitRequest)(nil),                           // 226: mealplanning.UpdateValidMeasurementUnitRequest
+	(*UpdateValidMeasurementUnitConversionRequest)(nil),                 // 227: mealplanning.UpdateValidMeasurementUnitConversionRequest
+	(*UpdateValidPreparationRequest)(nil),                               // 228: mealplanning.UpdateValidPreparationRequest
+	(*UpdateValidPreparationInstrumentRequest)(nil),                     // 229: mealplanning.UpdateValidPreparationInstrumentRequest
+	(*UpdateValidPreparationVesselRequest)(nil),                         // 230: mealplanning.UpdateValidPreparationVesselRequest
+	(*UpdateValidVesselRequest)(nil),                                    // 231: mealplanning.UpdateValidVesselRequest
+	(*ArchiveAccountInstrumentOwnershipRequest)(nil),                    // 232: mealplanning.ArchiveAccountInstrumentOwnershipRequest
+	(*ArchiveUserIngredientPreferenceRequest)(nil),                      // 233: mealplanning.ArchiveUserIngredientPreferenceRequest
+	(*CreateAccountInstrumentOwnershipRequest)(nil),                     // 234: mealplanning.CreateAccountInstrumentOwnershipRequest
+	(*CreateUserIngredientPreferenceRequest)(nil),                       // 235: mealplanning.CreateUserIngredientPreferenceRequest
+	(*GetAccountInstrumentOwnershipRequest)(nil),                        // 236: mealplanning.GetAccountInstrumentOwnershipRequest
+	(*GetAccountInstrumentOwnershipsRequest)(nil),                       // 237: mealplanning.GetAccountInstrumentOwnershipsRequest
+	(*SearchForValidInstrumentsNotOwnedByAccountRequest)(nil),           // 238: mealplanning.SearchForValidInstrumentsNotOwnedByAccountRequest
+	(*GetUserIngredientPreferenceRequest)(nil),                          // 239: mealplanning.GetUserIngredientPreferenceRequest
+	(*GetUserIngredientPreferencesRequest)(nil),                         // 240: mealplanning.GetUserIngredientPreferencesRequest
+	(*UpdateAccountInstrumentOwnershipRequest)(nil),                     // 241: mealplanning.UpdateAccountInstrumentOwnershipRequest
+	(*ArchivePantryItemRequest)(nil),                                    // 242: mealplanning.ArchivePantryItemRequest
+	(*CreatePantryItemRequest)(nil),                                     // 243: mealplanning.CreatePantryItemRequest
+	(*GetPantryItemRequest)(nil),                                        // 244: mealplanning.GetPantryItemRequest
+	(*GetPantryItemsRequest)(nil),                                       // 245: mealplanning.GetPantryItemsRequest
+	(*UpdatePantryItemRequest)(nil),                                     // 246: mealplanning.UpdatePantryItemRequest
+	(*UpdateUserIngredientPreferenceRequest)(nil),                       // 247: mealplanning.UpdateUserIngredientPreferenceRequest
+	(*UploadMealMediaRequest)(nil),                                      // 248: mealplanning.UploadMealMediaRequest
+	(*UploadRecipeMediaRequest)(nil),                                    // 249: mealplanning.UploadRecipeMediaRequest
+	(*UploadPreparationMediaRequest)(nil),                               // 250: mealplanning.UploadPreparationMediaRequest
+	(*UploadIngredientMediaRequest)(nil),                                // 251: mealplanning.UploadIngredientMediaRequest
+	(*UploadRecipeStepImageRequest)(nil),                                // 252: mealplanning.UploadRecipeStepImageRequest
+	(*ExportMealPlanCalendarRequest)(nil),                               // 253: mealplanning.ExportMealPlanCalendarRequest
+	(*WatchMealPlanRequest)(nil),                                        // 254: mealplanning.WatchMealPlanRequest
+	(*WatchMealPlanTasksRequest)(nil),                                   // 255: mealplanning.WatchMealPlanTasksRequest
+	(*AddCommentToMealResponse)(nil),                                    // 256: mealplanning.AddCommentToMealResponse
+	(*AddCommentToMealPlanResponse)(nil),                                // 257: mealplanning.AddCommentToMealPlanResponse
+	(*AddCommentToRecipeResponse)(nil),                                  // 258: mealplanning.AddCommentToRecipeResponse
+	(*ArchiveMealResponse)(nil),                                         // 259: mealplanning.ArchiveMealResponse
+	(*ArchiveMealPlanResponse)(nil),                                     // 260: mealplanning.ArchiveMealPlanResponse
+	(*ArchiveMealPlanEventResponse)(nil),                                // 261: mealplanning.ArchiveMealPlanEventResponse
+	(*ArchiveMealPlanGroceryListItemResponse)(nil),                      // 262: mealplanning.ArchiveMealPlanGroceryListItemResponse
+	(*ArchiveMealPlanOptionResponse)(nil),                               // 263: mealplanning.ArchiveMealPlanOptionResponse
+	(*ArchiveMealPlanOptionVoteResponse)(nil),                           // 264: mealplanning.ArchiveMealPlanOptionVoteResponse
+	(*ArchiveMealPlanRecipeOptionSelectionResponse)(nil),                // 265: mealplanning.ArchiveMealPlanRecipeOptionSelectionResponse
+	(*ArchiveMealPlanTemplateResponse)(nil),                             // 266: mealplanning.ArchiveMealPlanTemplateResponse
+	(*ArchiveMealListResponse)(nil),                                     // 267: mealplanning.ArchiveMealListResponse
+	(*ArchiveMealListItemResponse)(nil),                                 // 268: mealplanning.ArchiveMealListItemResponse
+	(*ArchiveRecipeResponse)(nil),                                       // 269: mealplanning.ArchiveRecipeResponse
+	(*ArchiveRecipePrepTaskResponse)(nil),                               // 270: mealplanning.ArchiveRecipePrepTaskResponse
+	(*ArchiveRecipeRatingResponse)(nil),                                 // 271: mealplanning.ArchiveRecipeRatingResponse
+	(*ArchiveRecipeListResponse)(nil),                                   // 272: mealplanning.ArchiveRecipeListResponse
+	(*ArchiveRecipeListItemResponse)(nil),                               // 273: mealplanning.ArchiveRecipeListItemResponse
+	(*ArchiveRecipeStepResponse)(nil),                                   // 274: mealplanning.ArchiveRecipeStepResponse
+	(*ArchiveRecipeStepCompletionConditionResponse)(nil),                // 275: mealplanning.ArchiveRecipeStepCompletionConditionResponse
+	(*ArchiveRecipeStepIngredientResponse)(nil),                         // 276: mealplanning.ArchiveRecipeStepIngredientResponse
+	(*ArchiveRecipeStepInstrumentResponse)(nil),                         // 277: mealplanning.ArchiveRecipeStepInstrumentResponse
+	(*ArchiveRecipeStepProductResponse)(nil),                            // 278: mealplanning.ArchiveRecipeStepProductResponse
+	(*ArchiveRecipeStepVesselResponse)(nil),                             // 279: mealplanning.ArchiveRecipeStepVesselResponse
+	(*ArchiveValidIngredientResponse)(nil),                              // 280: mealplanning.ArchiveValidIngredientResponse
+	(*ArchiveValidIngredientGroupResponse)(nil),                         // 281: mealplanning.ArchiveValidIngredientGroupResponse
+	(*ArchiveValidIngredientMeasurementUnitResponse)(nil),               // 282: mealplanning.ArchiveValidIngredientMeasurementUnitResponse
+	(*ArchiveValidIngredientNutritionFactsResponse)(nil),                // 283: mealplanning.ArchiveValidIngredientNutritionFactsResponse
+	(*ArchiveValidIngredientPreparationResponse)(nil),                   // 284: mealplanning.ArchiveValidIngredientPreparationResponse
+	(*ArchiveValidPrepTaskConfigResponse)(nil),                          // 285: mealplanning.ArchiveValidPrepTaskConfigResponse
+	(*ArchiveValidIngredientStateResponse)(nil),                         // 286: mealplanning.ArchiveValidIngredientStateResponse
+	(*ArchiveValidIngredientStateIngredientResponse)(nil),               // 287: mealplanning.ArchiveValidIngredientStateIngredientResponse
+	(*ArchiveValidInstrumentResponse)(nil),                              // 288: mealplanning.ArchiveValidInstrumentResponse
+	(*ArchiveValidMeasurementUnitResponse)(nil),                         // 289: mealplanning.ArchiveValidMeasurementUnitResponse
+	(*ArchiveValidMeasurementUnitConversionResponse)(nil),               // 290: mealplanning.ArchiveValidMeasurementUnitConversionResponse
+	(*ArchiveValidPreparationResponse)(nil),                             // 291: mealplanning.ArchiveValidPreparationResponse
+	(*ArchiveValidPreparationInstrumentResponse)(nil),                   // 292: mealplanning.ArchiveValidPreparationInstrumentResponse
+	(*ArchiveValidPreparationVesselResponse)(nil),                       // 293: mealplanning.ArchiveValidPreparationVesselResponse
+	(*ArchiveValidVesselResponse)(nil),                                  // 294: mealplanning.ArchiveValidVesselResponse
+	(*CloneRecipeResponse)(nil),                                         // 295: mealplanning.CloneRecipeResponse
+	(*ImportRecipeResponse)(nil),                                        // 296: mealplanning.ImportRecipeResponse
+	(*CreateMealResponse)(nil),                                          // 297: mealplanning.CreateMealResponse
+	(*CreateMealPlanResponse)(nil),                                      // 298: mealplanning.CreateMealPlanResponse
+	(*CreateMealPlanCalendarFeedResponse)(nil),                          // 299: mealplanning.CreateMealPlanCalendarFeedResponse
+	(*CreateMealPlanEventResponse)(nil),                                 // 300: mealplanning.CreateMealPlanEventResponse
+	(*CreateMealPlanOptionResponse)(nil),                                // 301: mealplanning.CreateMealPlanOptionResponse
+	(*CreateMealPlanOptionVoteResponse)(nil),                            // 302: mealplanning.CreateMealPlanOptionVoteResponse
+	(*CreateMealPlanRecipeOptionSelectionResponse)(nil),                 // 303: mealplanning.CreateMealPlanRecipeOptionSelectionResponse
+	(*CreateMealPlanTaskResponse)(nil),                                  // 304: mealplanning.CreateMealPlanTaskResponse
+	(*CreateMealPlanTemplateResponse)(nil),                              // 305: mealplanning.CreateMealPlanTemplateResponse
+	(*CreateMealListResponse)(nil),                                      // 306: mealplanning.CreateMealListResponse
+	(*CreateMealListItemResponse)(nil),                                  // 307: mealplanning.CreateMealListItemResponse
+	(*CreateRecipeResponse)(nil),                                        // 308: mealplanning.CreateRecipeResponse
+	(*CreateRecipePrepTaskResponse)(nil),                                // 309: mealplanning.CreateRecipePrepTaskResponse
+	(*CreateRecipeRatingResponse)(nil),                                  // 310: mealplanning.CreateRecipeRatingResponse
+	(*CreateRecipeListResponse)(nil),                                    // 311: mealplanning.CreateRecipeListResponse
+	(*CreateRecipeListItemResponse)(nil),                                // 312: mealplanning.CreateRecipeListItemResponse
+	(*CreateRecipeStepResponse)(nil),                                    // 313: mealplanning.CreateRecipeStepResponse
+	(*CreateRecipeStepCompletionConditionResponse)(nil),                 // 314: mealplanning.CreateRecipeStepCompletionConditionResponse
+	(*CreateRecipeStepIngredientResponse)(nil),                          // 315: mealplanning.CreateRecipeStepIngredientResponse
+	(*CreateRecipeStepInstrumentResponse)(nil),                          // 316: mealplanning.CreateRecipeStepInstrumentResponse
+	(*CreateRecipeStepProductResponse)(nil),                             // 317: mealplanning.CreateRecipeStepProductResponse
+	(*CreateRecipeStepVesselResponse)(nil),                              // 318: mealplanning.CreateRecipeStepVesselResponse
+	(*CreateValidIngredientResponse)(nil),                               // 319: mealplanning.CreateValidIngredientResponse
+	(*CreateValidIngredientGroupResponse)(nil),                          // 320: mealplanning.CreateValidIngredientGroupResponse
+	(*CreateValidIngredientMeasurementUnitResponse)(nil),                // 321: mealplanning.CreateValidIngredientMeasurementUnitResponse
+	(*CreateValidIngredientNutritionFactsResponse)(nil),                 // 322: mealplanning.CreateValidIngredientNutritionFactsResponse
+	(*CreateValidIngredientPreparationResponse)(nil),                    // 323: mealplanning.CreateValidIngredientPreparationResponse
+	(*CreateValidPrepTaskConfigResponse)(nil),                           // 324: mealplanning.CreateValidPrepTaskConfigResponse
+	(*CreateValidIngredientStateResponse)(nil),                          // 325: mealplanning.CreateValidIngredientStateResponse
+	(*CreateValidIngredientStateIngredientResponse)(nil),                // 326: mealplanning.CreateValidIngredientStateIngredientResponse
+	(*CreateValidInstrumentResponse)(nil),                               // 327: mealplanning.CreateValidInstrumentResponse
+	(*CreateValidMeasurementUnitResponse)(nil),                          // 328: mealplanning.CreateValidMeasurementUnitResponse
+	(*CreateValidMeasurementUnitConversionResponse)(nil),                // 329: mealplanning.CreateValidMeasurementUnitConversionResponse
+	(*CreateValidPreparationResponse)(nil),                              // 330: mealplanning.CreateValidPreparationResponse
+	(*CreateValidPreparationInstrumentResponse)(nil),                    // 331: mealplanning.CreateValidPreparationInstrumentResponse
+	(*CreateValidPreparationVesselResponse)(nil),                        // 332: mealplanning.CreateValidPreparationVesselResponse
+	(*CreateValidVesselResponse)(nil),                                   // 333: mealplanning.CreateValidVesselResponse
+	(*CompleteMealPlanOptionResponse)(nil),                              // 334: mealplanning.CompleteMealPlanOptionResponse
+	(*DiffRecipeRevisionsResponse)(nil),                                 // 335: mealplanning.DiffRecipeRevisionsResponse
+	(*FinalizeMealPlanResponse)(nil),                                    // 336: mealplanning.FinalizeMealPlanResponse
+	(*GetMealResponse)(nil),                                             // 337: mealplanning.GetMealResponse
+	(*GetMealPlanResponse)(nil),                                         // 338: mealplanning.GetMealPlanResponse
+	(*GetMealPlanEventResponse)(nil),                                    // 339: mealplanning.GetMealPlanEventResponse
+	(*GetMealPlanEventTallyReportResponse)(nil),                         // 340: mealplanning.GetMealPlanEventTallyReportResponse
+	(*GetMealPlanEventsResponse)(nil),                                   // 341: mealplanning.GetMealPlanEventsResponse
+	(*GetMealPlanGroceryListItemResponse)(nil),                          // 342: mealplanning.GetMealPlanGroceryListItemResponse
+	(*GetMealPlanGroceryListItemsForMealPlanResponse)(nil),              // 343: mealplanning.GetMealPlanGroceryListItemsForMealPlanResponse
+	(*GetMealPlanOptionResponse)(nil),                                   // 344: mealplanning.GetMealPlanOptionResponse
+	(*GetMealPlanOptionVoteResponse)(nil),                               // 345: mealplanning.GetMealPlanOptionVoteResponse
+	(*GetMealPlanOptionVotesResponse)(nil),                              // 346: mealplanning.GetMealPlanOptionVotesResponse
+	(*GetMealPlanOptionsResponse)(nil),                                  // 347: mealplanning.GetMealPlanOptionsResponse
+	(*GetMealPlanRecipeOptionSelectionResponse)(nil),                    // 348: mealplanning.GetMealPlanRecipeOptionSelectionResponse
+	(*GetMealPlanRecipeOptionSelectionsForMealPlanOptionResponse)(nil),  // 349: mealplanning.GetMealPlanRecipeOptionSelectionsForMealPlanOptionResponse
+	(*GetMealPlanTaskResponse)(nil),                                     // 350: mealplanning.GetMealPlanTaskResponse
+	(*GetMealPlanTasksResponse)(nil),                                    // 351: mealplanning.GetMealPlanTasksResponse
+	(*GetMealPlanTemplateResponse)(nil),                                 // 352: mealplanning.GetMealPlanTemplateResponse
+	(*GetMealPlanTemplatesResponse)(nil),                                // 353: mealplanning.GetMealPlanTemplatesResponse
+	(*GetMealPlansForAccountResponse)(nil),                              // 354: mealplanning.GetMealPlansForAccountResponse
+	(*GetMealListsResponse)(nil),                                        // 355: mealplanning.GetMealListsResponse
+	(*GetMealsResponse)(nil),                                            // 356: mealplanning.GetMealsResponse
+	(*GetCookTimelineForMealPlanOptionResponse)(nil),                    // 357: mealplanning.GetCookTimelineForMealPlanOptionResponse
+	(*GetNutritionForMealResponse)(nil),                                 // 358: mealplanning.GetNutritionForMealResponse
+	(*GetNutritionForMealPlanResponse)(nil),                             // 359: mealplanning.GetNutritionForMealPlanResponse
+	(*GetNutritionForRecipeResponse)(nil),                               // 360: mealplanning.GetNutritionForRecipeResponse
+	(*GetMermaidDiagramForMealResponse)(nil),                            // 361: mealplanning.GetMermaidDiagramForMealResponse
+	(*GetMermaidDiagramForRecipeResponse)(nil),                          // 362: mealplanning.GetMermaidDiagramForRecipeResponse
+	(*GetRandomValidIngredientResponse)(nil),                            // 363: mealplanning.GetRandomValidIngredientResponse
+	(*GetRandomValidInstrumentResponse)(nil),                            // 364: mealplanning.GetRandomValidInstrumentResponse
+	(*GetRandomValidPreparationResponse)(nil),                           // 365: mealplanning.GetRandomValidPreparationResponse
+	(*GetRandomValidVesselResponse)(nil),                                // 366: mealplanning.GetRandomValidVesselResponse
+	(*GetRecipeResponse)(nil),                                           // 367: mealplanning.GetRecipeResponse
+	(*EstimateRecipePrepTasksResponse)(nil),                             // 368: mealplanning.EstimateRecipePrepTasksResponse
+	(*GetRecipePrepTaskResponse)(nil),                                   // 369: mealplanning.GetRecipePrepTaskResponse
+	(*GetRecipePrepTasksResponse)(nil),                                  // 370: mealplanning.GetRecipePrepTasksResponse
+	(*GetRecipeRatingResponse)(nil),                                     // 371: mealplanning.GetRecipeRatingResponse
+	(*GetRecipeRatingsForRecipeResponse)(nil),                           // 372: mealplanning.GetRecipeRatingsForRecipeResponse
+	(*GetRecipeRevisionResponse)(nil),                                   // 373: mealplanning.GetRecipeRevisionResponse
+	(*GetRecipeRevisionsResponse)(nil),                                  // 374: mealplanning.GetRecipeRevisionsResponse
+	(*GetRecipeStepResponse)(nil),                                       // 375: mealplanning.GetRecipeStepResponse
+	(*GetRecipeStepCompletionConditionResponse)(nil),                    // 376: mealplanning.GetRecipeStepCompletionConditionResponse
+	(*GetRecipeStepCompletionConditionsResponse)(nil),                   // 377: mealplanning.GetRecipeStepCompletionConditionsResponse
+	(*GetRecipeStepIngredientResponse)(nil),                             // 378: mealplanning.GetRecipeStepIngredientResponse
+	(*GetRecipeStepIngredientsResponse)(nil),                            // 379: mealplanning.GetRecipeStepIngredientsResponse
+	(*GetRecipeStepInstrumentResponse)(nil),                             // 380: mealplanning.GetRecipeStepInstrumentResponse
+	(*GetRecipeStepInstrumentsResponse)(nil),                            // 381: mealplanning.GetRecipeStepInstrumentsResponse
+	(*GetRecipeStepProductResponse)(nil),                                // 382: mealplanning.GetRecipeStepProductResponse
+	(*GetRecipeStepProductsResponse)(nil),                               // 383: mealplanning.GetRecipeStepProductsResponse
+	(*GetRecipeStepVesselResponse)(nil),                                 // 384: mealplanning.GetRecipeStepVesselResponse
+	(*GetRecipeStepVesselsResponse)(nil),                                // 385: mealplanning.GetRecipeStepVesselsResponse
+	(*GetRecipeStepsResponse)(nil),                                      // 386: mealplanning.GetRecipeStepsResponse
+	(*GetRecipeListsResponse)(nil),                                      // 387: mealplanning.GetRecipeListsResponse
+	(*GetRecipesResponse)(nil),                                          // 388: mealplanning.GetRecipesResponse
+	(*GetValidIngredientResponse)(nil),                                  // 389: mealplanning.GetValidIngredientResponse
+	(*GetValidIngredientGroupResponse)(nil),                             // 390: mealplanning.GetValidIngredientGroupResponse
+	(*GetValidIngredientGroupsResponse)(nil),                            // 391: mealplanning.GetValidIngredientGroupsResponse
+	(*GetValidIngredientMeasurementUnitResponse)(nil),                   // 392: mealplanning.GetValidIngredientMeasurementUnitResponse
+	(*GetValidIngredientMeasurementUnitsResponse)(nil),                  // 393: mealplanning.GetValidIngredientMeasurementUnitsResponse
+	(*GetValidIngredientMeasurementUnitsByIngredientResponse)(nil),      // 394: mealplanning.GetValidIngredientMeasurementUnitsByIngredientResponse
+	(*GetValidIngredientMeasurementUnitsByMeasurementUnitResponse)(nil), // 395: mealplanning.GetValidIngredientMeasurementUnitsByMeasurementUnitResponse
+	(*GetValidIngredientNutritionFactsResponse)(nil),                    // 396: mealplanning.GetValidIngredientNutritionFactsResponse
+	(*GetValidIngredientPreparationResponse)(nil),                       // 397: mealplanning.GetValidIngredientPreparationResponse
+	(*GetValidIngredientPreparationsResponse)(nil),                      // 398: mealplanning.GetValidIngredientPreparationsResponse
+	(*GetValidIngredientPreparationsByIngredientResponse)(nil),          // 399: mealplanning.GetValidIngredientPreparationsByIngredientResponse
+	(*GetValidIngredientPreparationsByPreparationResponse)(nil),         // 400: mealplanning.GetValidIngredientPreparationsByPreparationResponse
+	(*GetValidPrepTaskConfigResponse)(nil),                              // 401: mealplanning.GetValidPrepTaskConfigResponse
+	(*GetValidPrepTaskConfigsResponse)(nil),                             // 402: mealplanning.GetValidPrepTaskConfigsResponse
+	(*GetValidPrepTaskConfigsByIngredientResponse)(nil),                 // 403: mealplanning.GetValidPrepTaskConfigsByIngredientResponse
+	(*GetValidPrepTaskConfigsByPreparationResponse)(nil),                // 404: mealplanning.GetValidPrepTaskConfigsByPreparationResponse
+	(*GetValidPrepTaskConfigsByIngredientAndPreparationResponse)(nil),   // 405: mealplanning.GetValidPrepTaskConfigsByIngredientAndPreparationResponse
+	(*GetValidIngredientStateResponse)(nil),                             // 406: mealplanning.GetValidIngredientStateResponse
+	(*GetValidIngredientStateIngredientResponse)(nil),                   // 407: mealplanning.GetValidIngredientStateIngredientResponse
+	(*GetValidIngredientStateIngredientsResponse)(nil),                  // 408: mealplanning.GetValidIngredientStateIngredientsResponse
+	(*GetValidIngredientStateIngredientsByIngredientResponse)(nil),      // 409: mealplanning.GetValidIngredientStateIngredientsByIngredientResponse
+	(*GetValidIngredientStateIngredientsByIngredientStateResponse)(nil), // 410: mealplanning.GetValidIngredientStateIngredientsByIngredientStateResponse
+	(*GetValidIngredientStatesResponse)(nil),                            // 411: mealplanning.GetValidIngredientStatesResponse
+	(*GetValidIngredientsResponse)(nil),                                 // 412: mealplanning.GetValidIngredientsResponse
+	(*GetValidInstrumentResponse)(nil),                                  // 413: mealplanning.GetValidInstrumentResponse
+	(*GetValidInstrumentsResponse)(nil),                                 // 414: mealplanning.GetValidInstrumentsResponse
+	(*GetValidMeasurementUnitResponse)(nil),                             // 415: mealplanning.GetValidMeasurementUnitResponse
+	(*GetValidMeasurementUnitConversionResponse)(nil),                   // 416: mealplanning.GetValidMeasurementUnitConversionResponse
+	(*GetValidMeasurementUnitConversionsForUnitResponse)(nil),           // 417: mealplanning.GetValidMeasurementUnitConversionsForUnitResponse
+	(*GetValidMeasurementUnitConversionsForIngredientsResponse)(nil),    // 418: mealplanning.GetValidMeasurementUnitConversionsForIngredientsResponse
+	(*GetMeasurementUnitConversionMismatchesResponse)(nil),              // 419: mealplanning.GetMeasurementUnitConversionMismatchesResponse
+	(*GetValidMeasurementUnitsResponse)(nil),                            // 420: mealplanning.GetValidMeasurementUnitsResponse
+	(*GetValidPreparationResponse)(nil),                                 // 421: mealplanning.GetValidPreparationResponse
+	(*GetValidPreparationInstrumentResponse)(nil),                       // 422: mealplanning.GetValidPreparationInstrumentResponse
+	(*GetValidPreparationInstrumentsResponse)(nil),                      // 423: mealplanning.GetValidPreparationInstrumentsResponse
+	(*GetValidPreparationInstrumentsByInstrumentResponse)(nil),          // 424: mealplanning.GetValidPreparationInstrumentsByInstrumentResponse
+	(*GetValidPreparationInstrumentsByPreparationResponse)(nil),         // 425: mealplanning.GetValidPreparationInstrumentsByPreparationResponse
+	(*GetValidPreparationVesselResponse)(nil),                           // 426: mealplanning.GetValidPreparationVesselResponse
+	(*GetValidPreparationVesselsResponse)(nil),                          // 427: mealplanning.GetValidPreparationVesselsResponse
+	(*GetValidPreparationVesselsByPreparationResponse)(nil),             // 428: mealplanning.GetValidPreparationVesselsByPreparationResponse
+	(*GetValidPreparationVesselsByVesselResponse)(nil),                  // 429: mealplanning.GetValidPreparationVesselsByVesselResponse
+	(*GetValidPreparationsResponse)(nil),                                // 430: mealplanning.GetValidPreparationsResponse
+	(*GetValidVesselResponse)(nil),                                      // 431: mealplanning.GetValidVesselResponse
+	(*GetValidVesselsResponse)(nil),                                     // 432: mealplanning.GetValidVesselsResponse
+	(*RestoreRecipeRevisionResponse)(nil),                               // 433: mealplanning.RestoreRecipeRevisionResponse
+	(*RunFinalizeMealPlanWorkerResponse)(nil),                           // 434: mealplanning.RunFinalizeMealPlanWorkerResponse
+	(*RunMealPlanGroceryListInitializerWorkerResponse)(nil),             // 435: mealplanning.RunMealPlanGroceryListInitializerWorkerResponse
+	(*RunMealPlanTaskCreatorWorkerResponse)(nil),                        // 436: mealplanning.RunMealPlanTaskCreatorWorkerResponse
+	(*SearchForMealsResponse)(nil),                                      // 437: mealplanning.SearchForMealsResponse
+	(*SearchForRecipesResponse)(nil),                                    // 438: mealplanning.SearchForRecipesResponse
+	(*SearchForMealEligibleRecipesResponse)(nil),                        // 439: mealplanning.SearchForMealEligibleRecipesResponse
+	(*SearchForRecipesWithInstrumentOwnershipResponse)(nil),             // 440: mealplanning.SearchForRecipesWithInstrumentOwnershipResponse
+	(*SearchForValidIngredientGroupsResponse)(nil),                      // 441: mealplanning.SearchForValidIngredientGroupsResponse
+	(*SearchForValidIngredientStatesResponse)(nil),                      // 442: mealplanning.SearchForValidIngredientStatesResponse
+	(*SearchForValidIngredientsResponse)(nil),                           // 443: mealplanning.SearchForValidIngredientsResponse
+	(*SearchForValidInstrumentsResponse)(nil),                           // 444: mealplanning.SearchForValidInstrumentsResponse
+	(*SearchForValidMeasurementUnitsResponse)(nil),                      // 445: mealplanning.SearchForValidMeasurementUnitsResponse
+	(*SearchForValidPreparationsResponse)(nil),                          // 446: mealplanning.SearchForValidPreparationsResponse
+	(*SearchForValidVesselsResponse)(nil),                               // 447: mealplanning.SearchForValidVesselsResponse
+	(*SearchValidIngredientsByPreparationResponse)(nil),                 // 448: mealplanning.SearchValidIngredientsByPreparationResponse
+	(*SearchValidMeasurementUnitsByIngredientResponse)(nil),             // 449: mealplanning.SearchValidMeasurementUnitsByIngredientResponse
+	(*UpdateMealPlanResponse)(nil),                                      // 450: mealplanning.UpdateMealPlanResponse
+	(*UpdateMealPlanEventResponse)(nil),                                 // 451: mealplanning.UpdateMealPlanEventResponse
+	(*SwapMealPlanEventsResponse)(nil),                                  // 452: mealplanning.SwapMealPlanEventsResponse
+	(*UpdateMealPlanGroceryListItemResponse)(nil),                       // 453: mealplanning.UpdateMealPlanGroceryListItemResponse
+	(*UpdateMealPlanOptionResponse)(nil),                                // 454: mealplanning.UpdateMealPlanOptionResponse
+	(*UpdateMealPlanOptionVoteResponse)(nil),                            // 455: mealplanning.UpdateMealPlanOptionVoteResponse
+	(*UpdateMealPlanRecipeOptionSelectionResponse)(nil),                 // 456: mealplanning.UpdateMealPlanRecipeOptionSelectionResponse
+	(*UpdateMealPlanTaskStatusResponse)(nil),                            // 457: mealplanning.UpdateMealPlanTaskStatusResponse
+	(*UpdateMealPlanTemplateResponse)(nil),                              // 458: mealplanning.UpdateMealPlanTemplateResponse
+	(*UpdateMealListResponse)(nil),                                      // 459: mealplanning.UpdateMealListResponse
+	(*UpdateMealListItemResponse)(nil),                                  // 460: mealplanning.UpdateMealListItemResponse
+	(*UpdateRecipeResponse)(nil),                                        // 461: mealplanning.UpdateRecipeResponse
+	(*UpdateRecipeStatusResponse)(nil),                                  // 462: mealplanning.UpdateRecipeStatusResponse
+	(*UpdateRecipePrepTaskResponse)(nil),                                // 463: mealplanning.UpdateRecipePrepTaskResponse
+	(*UpdateRecipeRatingResponse)(nil),                                  // 464: mealplanning.UpdateRecipeRatingResponse
+	(*UpdateRecipeListResponse)(nil),                                    // 465: mealplanning.UpdateRecipeListResponse
+	(*UpdateRecipeListItemResponse)(nil),                                // 466: mealplanning.UpdateRecipeListItemResponse
+	(*UpdateRecipeStepResponse)(nil),                                    // 467: mealplanning.UpdateRecipeStepResponse
+	(*UpdateRecipeStepCompletionConditionResponse)(nil),                 // 468: mealplanning.UpdateRecipeStepCompletionConditionResponse
+	(*UpdateRecipeStepIngredientResponse)(nil),                          // 469: mealplanning.UpdateRecipeStepIngredientResponse
+	(*UpdateRecipeStepInstrumentResponse)(nil),                          // 470: mealplanning.UpdateRecipeStepInstrumentResponse
+	(*UpdateRecipeStepProductResponse)(nil),                             // 471: mealplanning.UpdateRecipeStepProductResponse
+	(*UpdateRecipeStepVesselResponse)(nil),                              // 472: mealplanning.UpdateRecipeStepVesselResponse
+	(*UpdateValidIngredientResponse)(nil),                               // 473: mealplanning.UpdateValidIngredientResponse
+	(*UpdateValidIngredientGroupResponse)(nil),                          // 474: mealplanning.UpdateValidIngredientGroupResponse
+	(*UpdateValidIngredientMeasurementUnitResponse)(nil),                // 475: mealplanning.UpdateValidIngredientMeasurementUnitResponse
+	(*UpdateValidIngredientNutritionFactsResponse)(nil),                 // 476: mealplanning.UpdateValidIngredientNutritionFactsResponse
+	(*UpdateValidIngredientPreparationResponse)(nil),                    // 477: mealplanning.UpdateValidIngredientPreparationResponse
+	(*UpdateValidPrepTaskConfigResponse)(nil),                           // 478: mealplanning.UpdateValidPrepTaskConfigResponse
+	(*UpdateValidIngredientStateResponse)(nil),                          // 479: mealplanning.UpdateValidIngredientStateResponse
+	(*UpdateValidIngredientStateIngredientResponse)(nil),                // 480: mealplanning.UpdateValidIngredientStateIngredientResponse
+	(*UpdateValidInstrumentResponse)(nil),                               // 481: mealplanning.UpdateValidInstrumentResponse
+	(*UpdateValidMeasurementUnitResponse)(nil),                          // 482: mealplanning.UpdateValidMeasurementUnitResponse
+	(*UpdateValidMeasurementUnitConversionResponse)(nil),                // 483: mealplanning.UpdateValidMeasurementUnitConversionResponse
+	(*UpdateValidPreparationResponse)(nil),                              // 484: mealplanning.UpdateValidPreparationResponse
+	(*UpdateValidPreparationInstrumentResponse)(nil),                    // 485: mealplanning.UpdateValidPreparationInstrumentResponse
+	(*UpdateValidPreparationVesselResponse)(nil),                        // 486: mealplanning.UpdateValidPreparationVesselResponse
+	(*UpdateValidVesselResponse)(nil),                                   // 487: mealplanning.UpdateValidVesselResponse
+	(*ArchiveAccountInstrumentOwnershipResponse)(nil),                   // 488: mealplanning.ArchiveAccountInstrumentOwnershipResponse
+	(*ArchiveUserIngredientPreferenceResponse)(nil),                     // 489: mealplanning.ArchiveUserIngredientPreferenceResponse
+	(*CreateAccountInstrumentOwnershipResponse)(nil),                    // 490: mealplanning.CreateAccountInstrumentOwnershipResponse
+	(*CreateUserIngredientPreferenceResponse)(nil),                      // 491: mealplanning.CreateUserIngredientPreferenceResponse
+	(*GetAccountInstrumentOwnershipResponse)(nil),                       // 492: mealplanning.GetAccountInstrumentOwnershipResponse
+	(*GetAccountInstrumentOwnershipsResponse)(nil),                      // 493: mealplanning.GetAccountInstrumentOwnershipsResponse
+	(*SearchForValidInstrumentsNotOwnedByAccountResponse)(nil),          // 494: mealplanning.SearchForValidInstrumentsNotOwnedByAccountResponse
+	(*GetUserIngredientPreferenceResponse)(nil),                         // 495: mealplanning.GetUserIngredientPreferenceResponse
+	(*GetUserIngredientPreferencesResponse)(nil),                        // 496: mealplanning.GetUserIngredientPreferencesResponse
+	(*UpdateAccountInstrumentOwnershipResponse)(nil),                    // 497: mealplanning.UpdateAccountInstrumentOwnershipResponse
+	(*ArchivePantryItemResponse)(nil),                                   // 498: mealplanning.ArchivePantryItemResponse
+	(*CreatePantryItemResponse)(nil),                                    // 499: mealplanning.CreatePantryItemResponse
+	(*GetPantryItemResponse)(nil),                                       // 500: mealplanning.GetPantryItemResponse
+	(*GetPantryItemsResponse)(nil),                                      // 501: mealplanning.GetPantryItemsResponse
+	(*UpdatePantryItemResponse)(nil),                                    // 502: mealplanning.UpdatePantryItemResponse
+	(*UpdateUserIngredientPreferenceResponse)(nil),                      // 503: mealplanning.UpdateUserIngredientPreferenceResponse
+	(*UploadMealImageResponse)(nil),                                     // 504: mealplanning.UploadMealImageResponse
+	(*UploadRecipeImageResponse)(nil),                                   // 505: mealplanning.UploadRecipeImageResponse
+	(*UploadPreparationMediaResponse)(nil),                              // 506: mealplanning.UploadPreparationMediaResponse
+	(*UploadIngredientMediaResponse)(nil),                               // 507: mealplanning.UploadIngredientMediaResponse
+	(*UploadRecipeStepImageResponse)(nil),                               // 508: mealplanning.UploadRecipeStepImageResponse
+	(*ExportMealPlanCalendarResponse)(nil),                              // 509: mealplanning.ExportMealPlanCalendarResponse
+	(*WatchMealPlanResponse)(nil),                                       // 510: mealplanning.WatchMealPlanResponse
+	(*WatchMealPlanTasksResponse)(nil),                                  // 511: mealplanning.WatchMealPlanTasksResponse
 }
 var file_mealplanning_mealplanning_service_proto_depIdxs = []int32{
 	0,   // 0: mealplanning.MealPlanningService.AddCommentToMeal:input_type -> mealplanning.AddCommentToMealRequest
@@ -2303,452 +2572,510 @@
 	7,   // 7: mealplanning.MealPlanningService.ArchiveMealPlanOption:input_type -> mealplanning.ArchiveMealPlanOptionRequest
 	8,   // 8: mealplanning.MealPlanningService.ArchiveMealPlanOptionVote:input_type -> mealplanning.ArchiveMealPlanOptionVoteRequest
 	9,   // 9: mealplanning.MealPlanningService.ArchiveMealPlanRecipeOptionSelection:input_type -> mealplanning.ArchiveMealPlanRecipeOptionSelectionRequest
-	10,  // 10: mealplanning.MealPlanningService.ArchiveMealList:input_type -> mealplanning.ArchiveMealListRequest
-	11,  // 11: mealplanning.MealPlanningService.ArchiveMealListItem:input_type -> mealplanning.ArchiveMealListItemRequest
-	12,  // 12: mealplanning.MealPlanningService.ArchiveRecipe:input_type -> mealplanning.ArchiveRecipeRequest
-	13,  // 13: mealplanning.MealPlanningService.ArchiveRecipePrepTask:input_type -> mealplanning.ArchiveRecipePrepTaskRequest
-	14,  // 14: mealplanning.MealPlanningService.ArchiveRecipeRating:input_type -> mealplanning.ArchiveRecipeRatingRequest
-	15,  // 15: mealplanning.MealPlanningService.ArchiveRecipeList:input_type -> mealplanning.ArchiveRecipeListRequest
-	16,  // 16: mealplanning.MealPlanningService.ArchiveRecipeListItem:input_type -> mealplanning.ArchiveRecipeListItemRequest
-	17,  // 17: mealplanning.MealPlanningService.ArchiveRecipeStep:input_type -> mealplanning.ArchiveRecipeStepRequest
-	18,  // 18: mealplanning.MealPlanningService.ArchiveRecipeStepCompletionCondition:input_type -> mealplanning.ArchiveRecipeStepCompletionConditionRequest
-	19,  // 19: mealplanning.MealPlanningService.ArchiveRecipeStepIngredient:input_type -> mealplanning.ArchiveRecipeStepIngredientRequest
-	20,  // 20: mealplanning.MealPlanningService.ArchiveRecipeStepInstrument:input_type -> mealplanning.ArchiveRecipeStepInstrumentRequest
-	21,  // 21: mealplanning.MealPlanningService.ArchiveRecipeStepProduct:input_type -> mealplanning.ArchiveRecipeStepProductRequest
-	22,  // 22: mealplanning.MealPlanningService.ArchiveRecipeStepVessel:input_type -> mealplanning.ArchiveRecipeStepVesselRequest
-	23,  // 23: mealplanning.MealPlanningService.ArchiveValidIngredient:input_type -> mealplanning.ArchiveValidIngredientRequest
-	24,  // 24: mealplanning.MealPlanningService.ArchiveValidIngredientGroup:input_type -> mealplanning.ArchiveValidIngredientGroupRequest
-	25,  // 25: mealplanning.MealPlanningService.ArchiveValidIngredientMeasurementUnit:input_type -> mealplanning.ArchiveValidIngredientMeasurementUnitRequest
-	26,  // 26: mealplanning.MealPlanningService.ArchiveValidIngredientPreparation:input_type -> mealplanning.ArchiveValidIngredientPreparationRequest
-	27,  // 27: mealplanning.MealPlanningService.ArchiveValidPrepTaskConfig:input_type -> mealplanning.ArchiveValidPrepTaskConfigRequest
-	28,  // 28: mealplanning.MealPlanningService.ArchiveValidIngredientState:input_type -> mealplanning.ArchiveValidIngredientStateRequest
-	29,  // 29: mealplanning.MealPlanningService.ArchiveValidIngredientStateIngredient:input_type -> mealplanning.ArchiveValidIngredientStateIngredientRequest
-	30,  // 30: mealplanning.MealPlanningService.ArchiveValidInstrument:input_type -> mealplanning.ArchiveValidInstrumentRequest
-	31,  // 31: mealplanning.MealPlanningService.ArchiveValidMeasurementUnit:input_type -> mealplanning.ArchiveValidMeasurementUnitRequest
-	32,  // 32: mealplanning.MealPlanningService.ArchiveValidMeasurementUnitConversion:input_type -> mealplanning.ArchiveValidMeasurementUnitConversionRequest
-	33,  // 33: mealplanning.MealPlanningService.ArchiveValidPreparation:input_type -> mealplanning.ArchiveValidPreparationRequest
-	34,  // 34: mealplanning.MealPlanningService.ArchiveValidPreparationInstrument:input_type -> mealplanning.ArchiveValidPreparationInstrumentRequest
-	35,  // 35: mealplanning.MealPlanningService.ArchiveValidPreparationVessel:input_type -> mealplanning.ArchiveValidPreparationVesselRequest
-	36,  // 36: mealplanning.MealPlanningService.ArchiveValidVessel:input_type -> mealplanning.ArchiveValidVesselRequest
-	37,  // 37: mealplanning.MealPlanningService.CloneRecipe:input_type -> mealplanning.CloneRecipeRequest
-	38,  // 38: mealplanning.MealPlanningService.CreateMeal:input_type -> mealplanning.CreateMealRequest
-	39,  // 39: mealplanning.MealPlanningService.CreateMealPlan:input_type -> mealplanning.CreateMealPlanRequest
-	40,  // 40: mealplanning.MealPlanningService.CreateMealPlanEvent:input_type -> mealplanning.CreateMealPlanEventRequest
-	41,  // 41: mealplanning.MealPlanningService.CreateMealPlanOption:input_type -> mealplanning.CreateMealPlanOptionRequest
-	42,  // 42: mealplanning.MealPlanningService.CreateMealPlanOptionVote:input_type -> mealplanning.CreateMealPlanOptionVoteRequest
-	43,  // 43: mealplanning.MealPlanningService.CreateMealPlanRecipeOptionSelection:input_type -> mealplanning.CreateMealPlanRecipeOptionSelectionRequest
-	44,  // 44: mealplanning.MealPlanningService.CreateMealPlanTask:input_type -> mealplanning.CreateMealPlanTaskRequest
-	45,  // 45: mealplanning.MealPlanningService.CreateMealList:input_type -> mealplanning.CreateMealListRequest
-	46,  // 46: mealplanning.MealPlanningService.CreateMealListItem:input_type -> mealplanning.CreateMealListItemRequest
-	47,  // 47: mealplanning.MealPlanningService.CreateRecipe:input_type -> mealplanning.CreateRecipeRequest
-	48,  // 48: mealplanning.MealPlanningService.CreateRecipePrepTask:input_type -> mealplanning.CreateRecipePrepTaskRequest
-	49,  // 49: mealplanning.MealPlanningService.CreateRecipeRating:input_type -> mealplanning.CreateRecipeRatingRequest
-	50,  // 50: mealplanning.MealPlanningService.CreateRecipeList:input_type -> mealplanning.CreateRecipeListRequest
-	51,  // 51: mealplanning.MealPlanningService.CreateRecipeListItem:input_type -> mealplanning.CreateRecipeListItemRequest
-	52,  // 52: mealplanning.MealPlanningService.CreateRecipeStep:input_type -> mealplanning.CreateRecipeStepRequest
-	53,  // 53: mealplanning.MealPlanningService.CreateRecipeStepCompletionCondition:input_type -> mealplanning.CreateRecipeStepCompletionConditionRequest
-	54,  // 54: mealplanning.MealPlanningService.CreateRecipeStepIngredient:input_type -> mealplanning.CreateRecipeStepIngredientRequest
-	55,  // 55: mealplanning.MealPlanningService.CreateRecipeStepInstrument:input_type -> mealplanning.CreateRecipeStepInstrumentRequest
-	56,  // 56: mealplanning.MealPlanningService.CreateRecipeStepProduct:input_type -> mealplanning.CreateRecipeStepProductRequest
-	57,  // 57: mealplanning.MealPlanningService.CreateRecipeStepVessel:input_type -> mealplanning.CreateRecipeStepVesselRequest
-	58,  // 58: mealplanning.MealPlanningService.CreateValidIngredient:input_type -> mealplanning.CreateValidIngredientRequest
-	59,  // 59: mealplanning.MealPlanningService.CreateValidIngredientGroup:input_type -> mealplanning.CreateValidIngredientGroupRequest
-	60,  // 60: mealplanning.MealPlanningService.CreateValidIngredientMeasurementUnit:input_type -> mealplanning.CreateValidIngredientMeasurementUnitRequest
-	61,  // 61: mealplanning.MealPlanningService.CreateValidIngredientPreparation:input_type -> mealplanning.CreateValidIngredientPreparationRequest
-	62,  // 62: mealplanning.MealPlanningService.CreateValidPrepTaskConfig:input_type -> mealplanning.CreateValidPrepTaskConfigRequest
-	63,  // 63: mealplanning.MealPlanningService.CreateValidIngredientState:input_type -> mealplanning.CreateValidIngredientStateRequest
-	64,  // 64: mealplanning.MealPlanningService.CreateValidIngredientStateIngredient:input_type -> mealplanning.CreateValidIngredientStateIngredientRequest
-	65,  // 65: mealplanning.MealPlanningService.CreateValidInstrument:input_type -> mealplanning.CreateValidInstrumentRequest
-	66,  // 66: mealplanning.MealPlanningService.CreateValidMeasurementUnit:input_type -> mealplanning.CreateValidMeasurementUnitRequest
-	67,  // 67: mealplanning.MealPlanningService.CreateValidMeasurementUnitConversion:input_type -> mealplanning.CreateValidMeasurementUnitConversionRequest
-	68,  // 68: mealplanning.MealPlanningService.CreateValidPreparation:input_type -> mealplanning.CreateValidPreparationRequest
-	69,  // 69: mealplanning.MealPlanningService.CreateValidPreparationInstrument:input_type -> mealplanning.CreateValidPreparationInstrumentRequest
-	70,  // 70: mealplanning.MealPlanningService.CreateValidPreparationVessel:input_type -> mealplanning.CreateValidPreparationVesselRequest
-	71,  // 71: mealplanning.MealPlanningService.CreateValidVessel:input_type -> mealplanning.CreateValidVesselRequest
-	72,  // 72: mealplanning.MealPlanningService.FinalizeMealPlan:input_type -> mealplanning.FinalizeMealPlanRequest
-	73,  // 73: mealplanning.MealPlanningService.GetMeal:input_type -> mealplanning.GetMealRequest
-	74,  // 74: mealplanning.MealPlanningService.GetMealPlan:input_type -> mealplanning.GetMealPlanRequest
-	75,  // 75: mealplanning.MealPlanningService.GetMealPlanEvent:input_type -> mealplanning.GetMealPlanEventRequest
-	76,  // 76: mealplanning.MealPlanningService.GetMealPlanEvents:input_type -> mealplanning.GetMealPlanEventsRequest
-	77,  // 77: mealplanning.MealPlanningService.GetMealPlanGroceryListItem:input_type -> mealplanning.GetMealPlanGroceryListItemRequest
-	78,  // 78: mealplanning.MealPlanningService.GetMealPlanGroceryListItemsForMealPlan:input_type -> mealplanning.GetMealPlanGroceryListItemsForMealPlanRequest
-	79,  // 79: mealplanning.MealPlanningService.GetMealPlanOption:input_type -> mealplanning.GetMealPlanOptionRequest
-	80,  // 80: mealplanning.MealPlanningService.GetMealPlanOptionVote:input_type -> mealplanning.GetMealPlanOptionVoteRequest
-	81,  // 81: mealplanning.MealPlanningService.GetMealPlanOptionVotes:input_type -> mealplanning.GetMealPlanOptionVotesRequest
-	82,  // 82: mealplanning.MealPlanningService.GetMealPlanOptions:input_type -> mealplanning.GetMealPlanOptionsRequest
-	83,  // 83: mealplanning.MealPlanningService.GetMealPlanRecipeOptionSelection:input_type -> mealplanning.GetMealPlanRecipeOptionSelectionRequest
-	84,  // 84: mealplanning.MealPlanningService.GetMealPlanRecipeOptionSelectionsForMealPlanOption:input_type -> mealplanning.GetMealPlanRecipeOptionSelectionsForMealPlanOptionRequest
-	85,  // 85: mealplanning.MealPlanningService.GetMealPlanTask:input_type -> mealplanning.GetMealPlanTaskRequest
-	86,  // 86: mealplanning.MealPlanningService.GetMealPlanTasks:input_type -> mealplanning.GetMealPlanTasksRequest
-	87,  // 87: mealplanning.MealPlanningService.GetMealPlansForAccount:input_type -> mealplanning.GetMealPlansForAccountRequest
-	88,  // 88: mealplanning.MealPlanningService.GetMealLists:input_type -> mealplanning.GetMealListsRequest
-	89,  // 89: mealplanning.MealPlanningService.GetMeals:input_type -> mealplanning.GetMealsRequest
-	90,  // 90: mealplanning.MealPlanningService.GetMermaidDiagramForMeal:input_type -> mealplanning.GetMermaidDiagramForMealRequest
-	91,  // 91: mealplanning.MealPlanningService.GetMermaidDiagramForRecipe:input_type -> mealplanning.GetMermaidDiagramForRecipeRequest
-	92,  // 92: mealplanning.MealPlanningService.GetRandomValidIngredient:input_type -> mealplanning.GetRandomValidIngredientRequest
-	93,  // 93: mealplanning.MealPlanningService.GetRandomValidInstrument:input_type -> mealplanning.GetRandomValidInstrumentRequest
-	94,  // 94: mealplanning.MealPlanningService.GetRandomValidPreparation:input_type -> mealplanning.GetRandomValidPreparationRequest
-	95,  // 95: mealplanning.MealPlanningService.GetRandomValidVessel:input_type -> mealplanning.GetRandomValidVesselRequest
-	96,  // 96: mealplanning.MealPlanningService.GetRecipe:input_type -> mealplanning.GetRecipeRequest
-	97,  // 97: mealplanning.MealPlanningService.EstimateRecipePrepTasks:input_type -> mealplanning.EstimateRecipePrepTasksRequest
-	98,  // 98: mealplanning.MealPlanningService.GetRecipePrepTask:input_type -> mealplanning.GetRecipePrepTaskRequest
-	99,  // 99: mealplanning.MealPlanningService.GetRecipePrepTasks:input_type -> mealplanning.GetRecipePrepTasksRequest
-	100, // 100: mealplanning.MealPlanningService.GetRecipeRating:input_type -> mealplanning.GetRecipeRatingRequest
-	101, // 101: mealplanning.MealPlanningService.GetRecipeRatingsForRecipe:input_type -> mealplanning.GetRecipeRatingsForRecipeRequest
-	102, // 102: mealplanning.MealPlanningService.GetRecipeStep:input_type -> mealplanning.GetRecipeStepRequest
-	103, // 103: mealplanning.MealPlanningService.GetRecipeStepCompletionCondition:input_type -> mealplanning.GetRecipeStepCompletionConditionRequest
-	104, // 104: mealplanning.MealPlanningService.GetRecipeStepCompletionConditions:input_type -> mealplanning.GetRecipeStepCompletionConditionsRequest
-	105, // 105: mealplanning.MealPlanningService.GetRecipeStepIngredient:input_type -> mealplanning.GetRecipeStepIngredientRequest
-	106, // 106: mealplanning.MealPlanningService.GetRecipeStepIngredients:input_type -> mealplanning.GetRecipeStepIngredientsRequest
-	107, // 107: mealplanning.MealPlanningService.GetRecipeStepInstrument:input_type -> mealplanning.GetRecipeStepInstrumentRequest
-	108, // 108: mealplanning.MealPlanningService.GetRecipeStepInstruments:input_type -> mealplanning.GetRecipeStepInstrumentsRequest
-	109, // 109: mealplanning.MealPlanningService.GetRecipeStepProduct:input_type -> mealplanning.GetRecipeStepProductRequest
-	110, // 110: mealplanning.MealPlanningService.GetRecipeStepProducts:input_type -> mealplanning.GetRecipeStepProductsRequest
-	111, // 111: mealplanning.MealPlanningService.GetRecipeStepVessel:input_type -> mealplanning.GetRecipeStepVesselRequest
-	112, // 112: mealplanning.MealPlanningService.GetRecipeStepVessels:input_type -> mealplanning.GetRecipeStepVesselsRequest
-	113, // 113: mealplanning.MealPlanningService.GetRecipeSteps:input_type -> mealplanning.GetRecipeStepsRequest
-	114, // 114: mealplanning.MealPlanningService.GetRecipeLists:input_type -> mealplanning.GetRecipeListsRequest
-	115, // 115: mealplanning.MealPlanningService.GetRecipes:input_type -> mealplanning.GetRecipesRequest
-	116, // 116: mealplanning.MealPlanningService.GetValidIngredient:input_type -> mealplanning.GetValidIngredientRequest
-	117, // 117: mealplanning.MealPlanningService.GetValidIngredientGroup:input_type -> mealplanning.GetValidIngredientGroupRequest
-	118, // 118: mealplanning.MealPlanningService.GetValidIngredientGroups:input_type -> mealplanning.GetValidIngredientGroupsRequest
-	119, // 119: mealplanning.MealPlanningService.GetValidIngredientMeasurementUnit:input_type -> mealplanning.GetValidIngredientMeasurementUnitRequest
-	120, // 120: mealplanning.MealPlanningService.GetValidIngredientMeasurementUnits:input_type -> mealplanning.GetValidIngredientMeasurementUnitsRequest
-	121, // 121: mealplanning.MealPlanningService.GetValidIngredientMeasurementUnitsByIngredient:input_type -> mealplanning.GetValidIngredientMeasurementUnitsByIngredientRequest
-	122, // 122: mealplanning.MealPlanningService.GetValidIngredientMeasurementUnitsByMeasurementUnit:input_type -> mealplanning.GetValidIngredientMeasurementUnitsByMeasurementUnitRequest
-	123, // 123: mealplanning.MealPlanningService.GetValidIngredientPreparation:input_type -> mealplanning.GetValidIngredientPreparationRequest
-	124, // 124: mealplanning.MealPlanningService.GetValidIngredientPreparations:input_type -> mealplanning.GetValidIngredientPreparationsRequest
-	125, // 125: mealplanning.MealPlanningService.GetValidIngredientPreparationsByIngredient:input_type -> mealplanning.GetValidIngredientPreparationsByIngredientRequest
-	126, // 126: mealplanning.MealPlanningService.GetValidIngredientPreparationsByPreparation:input_type -> mealplanning.GetValidIngredientPreparationsByPreparationRequest
-	127, // 127: mealplanning.MealPlanningService.GetValidPrepTaskConfig:input_type -> mealplanning.GetValidPrepTaskConfigRequest
-	128, // 128: mealplanning.MealPlanningService.GetValidPrepTaskConfigs:input_type -> mealplanning.GetValidPrepTaskConfigsRequest
-	129, // 129: mealplanning.MealPlanningService.GetValidPrepTaskConfigsByIngredient:input_type -> mealplanning.GetValidPrepTaskConfigsByIngredientRequest
-	130, // 130: mealplanning.MealPlanningService.GetValidPrepTaskConfigsByPreparation:input_type -> mealplanning.GetValidPrepTaskConfigsByPreparationRequest
-	131, // 131: mealplanning.MealPlanningService.GetValidPrepTaskConfigsByIngredientAndPreparation:input_type -> mealplanning.GetValidPrepTaskConfigsByIngredientAndPreparationRequest
-	132, // 132: mealplanning.MealPlanningService.GetValidIngredientState:input_type -> mealplanning.GetValidIngredientStateRequest
-	133, // 133: mealplanning.MealPlanningService.GetValidIngredientStateIngredient:input_type -> mealplanning.GetValidIngredientStateIngredientRequest
-	134, // 134: mealplanning.MealPlanningService.GetValidIngredientStateIngredients:input_type -> mealplanning.GetValidIngredientStateIngredientsRequest
-	135, // 135: mealplanning.MealPlanningService.GetValidIngredientStateIngredientsByIngredient:input_type -> mealplanning.GetValidIngredientStateIngredientsByIngredientRequest
-	136, // 136: mealplanning.MealPlanningService.GetValidIngredientStateIngredientsByIngredientState:input_type -> mealplanning.GetValidIngredientStateIngredientsByIngredientStateRequest
-	137, // 137: mealplanning.MealPlanningService.GetValidIngredientStates:input_type -> mealplanning.GetValidIngredientStatesRequest
-	138, // 138: mealplanning.MealPlanningService.GetValidIngredients:input_type -> mealplanning.GetValidIngredientsRequest
-	139, // 139: mealplanning.MealPlanningService.GetValidInstrument:input_type -> mealplanning.GetValidInstrumentRequest
-	140, // 140: mealplanning.MealPlanningService.GetValidInstruments:input_type -> mealplanning.GetValidInstrumentsRequest
-	141, // 141: mealplanning.MealPlanningService.GetValidMeasurementUnit:input_type -> mealplanning.GetValidMeasurementUnitRequest
-	142, // 142: mealplanning.MealPlanningService.GetValidMeasurementUnitConversion:input_type -> mealplanning.GetValidMeasurementUnitConversionRequest
-	143, // 143: mealplanning.MealPlanningService.GetValidMeasurementUnitConversionsForUnit:input_type -> mealplanning.GetValidMeasurementUnitConversionsForUnitRequest
-	144, // 144: mealplanning.MealPlanningService.GetValidMeasurementUnitConversionsForIngredients:input_type -> mealplanning.GetValidMeasurementUnitConversionsForIngredientsRequest
-	145, // 145: mealplanning.MealPlanningService.GetMeasurementUnitConversionMismatches:input_type -> mealplanning.GetMeasurementUnitConversionMismatchesRequest
-	146, // 146: mealplanning.MealPlanningService.GetValidMeasurementUnits:input_type -> mealplanning.GetValidMeasurementUnitsRequest
-	147, // 147: mealplanning.MealPlanningService.GetValidPreparation:input_type -> mealplanning.GetValidPreparationRequest
-	148, // 148: mealplanning.MealPlanningService.GetValidPreparationInstrument:input_type -> mealplanning.GetValidPreparationInstrumentRequest
-	149, // 149: mealplanning.MealPlanningService.GetValidPreparationInstruments:input_type -> mealplanning.GetValidPreparationInstrumentsRequest
-	150, // 150: mealplanning.MealPlanningService.GetValidPreparationInstrumentsByInstrument:input_type -> mealplanning.GetValidPreparationInstrumentsByInstrumentRequest
-	151, // 151: mealplanning.MealPlanningService.GetValidPreparationInstrumentsByPreparation:input_type -> mealplanning.GetValidPreparationInstrumentsByPreparationRequest
-	152, // 152: mealplanning.MealPlanningService.GetValidPreparationVessel:input_type -> mealplanning.GetValidPreparationVesselRequest
-	153, // 153: mealplanning.MealPlanningService.GetValidPreparationVessels:input_type -> mealplanning.GetValidPreparationVesselsRequest
-	154, // 154: mealplanning.MealPlanningService.GetValidPreparationVesselsByPreparation:input_type -> mealplanning.GetValidPreparationVesselsByPreparationRequest
-	155, // 155: mealplanning.MealPlanningService.GetValidPreparationVesselsByVessel:input_type -> mealplanning.GetValidPreparationVesselsByVesselRequest
-	156, // 156: mealplanning.MealPlanningService.GetValidPreparations:input_type -> mealplanning.GetValidPreparationsRequest
-	157, // 157: mealplanning.MealPlanningService.GetValidVessel:input_type -> mealplanning.GetValidVesselRequest
-	158, // 158: mealplanning.MealPlanningService.GetValidVessels:input_type -> mealplanning.GetValidVesselsRequest
-	159, // 159: mealplanning.MealPlanningService.RunFinalizeMealPlanWorker:input_type -> mealplanning.RunFinalizeMealPlanWorkerRequest
-	160, // 160: mealplanning.MealPlanningService.RunMealPlanGroceryListInitializerWorker:input_type -> mealplanning.RunMealPlanGroceryListInitializerWorkerRequest
-	161, // 161: mealplanning.MealPlanningService.RunMealPlanTaskCreatorWorker:input_type -> mealplanning.RunMealPlanTaskCreatorWorkerRequest
-	162, // 162: mealplanning.MealPlanningService.SearchForMeals:input_type -> mealplanning.SearchForMealsRequest
-	163, // 163: mealplanning.MealPlanningService.SearchForRecipes:input_type -> mealplanning.SearchForRecipesRequest
-	164, // 164: mealplanning.MealPlanningService.SearchForMealEligibleRecipes:input_type -> mealplanning.SearchForMealEligibleRecipesRequest
-	165, // 165: mealplanning.MealPlanningService.SearchForRecipesWithInstrumentOwnership:input_type -> mealplanning.SearchForRecipesWithInstrumentOwnershipRequest
-	166, // 166: mealplanning.MealPlanningService.SearchForValidIngredientGroups:input_type -> mealplanning.SearchForValidIngredientGroupsRequest
-	167, // 167: mealplanning.MealPlanningService.SearchForValidIngredientStates:input_type -> mealplanning.SearchForValidIngredientStatesRequest
-	168, // 168: mealplanning.MealPlanningService.SearchForValidIngredients:input_type -> mealplanning.SearchForValidIngredientsRequest
-	169, // 169: mealplanning.MealPlanningService.SearchForValidInstruments:input_type -> mealplanning.SearchForValidInstrumentsRequest
-	170, // 170: mealplanning.MealPlanningService.SearchForValidMeasurementUnits:input_type -> mealplanning.SearchForValidMeasurementUnitsRequest
-	171, // 171: mealplanning.MealPlanningService.SearchForValidPreparations:input_type -> mealplanning.SearchForValidPreparationsRequest
-	172, // 172: mealplanning.MealPlanningService.SearchForValidVessels:input_type -> mealplanning.SearchForValidVesselsRequest
-	173, // 173: mealplanning.MealPlanningService.SearchValidIngredientsByPreparation:input_type -> mealplanning.SearchValidIngredientsByPreparationRequest
-	174, // 174: mealplanning.MealPlanningService.SearchValidMeasurementUnitsByIngredient:input_type -> mealplanning.SearchValidMeasurementUnitsByIngredientRequest
-	175, // 175: mealplanning.MealPlanningService.UpdateMealPlan:input_type -> mealplanning.UpdateMealPlanRequest
-	176, // 176: mealplanning.MealPlanningService.UpdateMealPlanEvent:input_type -> mealplanning.UpdateMealPlanEventRequest
-	177, // 177: mealplanning.MealPlanningService.SwapMealPlanEvents:input_type -> mealplanning.SwapMealPlanEventsRequest
-	178, // 178: mealplanning.MealPlanningService.UpdateMealPlanGroceryListItem:input_type -> mealplanning.UpdateMealPlanGroceryListItemRequest
-	179, // 179: mealplanning.MealPlanningService.UpdateMealPlanOption:input_type -> mealplanning.UpdateMealPlanOptionRequest
-	180, // 180: mealplanning.MealPlanningService.UpdateMealPlanOptionVote:input_type -> mealplanning.UpdateMealPlanOptionVoteRequest
-	181, // 181: mealplanning.MealPlanningService.UpdateMealPlanRecipeOptionSelection:input_type -> mealplanning.UpdateMealPlanRecipeOptionSelectionRequest
-	182, // 182: mealplanning.MealPlanningService.UpdateMealPlanTaskStatus:input_type -> mealplanning.UpdateMealPlanTaskStatusRequest
-	183, // 183: mealplanning.MealPlanningService.UpdateMealList:input_type -> mealplanning.UpdateMealListRequest
-	184, // 184: mealplanning.MealPlanningService.UpdateMealListItem:input_type -> mealplanning.UpdateMealListItemRequest
-	185, // 185: mealplanning.MealPlanningService.UpdateRecipe:input_type -> mealplanning.UpdateRecipeRequest
-	186, // 186: mealplanning.MealPlanningService.UpdateRecipeStatus:input_type -> mealplanning.UpdateRecipeStatusRequest
-	187, // 187: mealplanning.MealPlanningService.UpdateRecipePrepTask:input_type -> mealplanning.UpdateRecipePrepTaskRequest
-	188, // 188: mealplanning.MealPlanningService.UpdateRecipeRating:input_type -> mealplanning.UpdateRecipeRatingRequest
-	189, // 189: mealplanning.MealPlanningService.UpdateRecipeList:input_type -> mealplanning.UpdateRecipeListRequest
-	190, // 190: mealplanning.MealPlanningService.UpdateRecipeListItem:input_type -> mealplanning.UpdateRecipeListItemRequest
-	191, // 191: mealplanning.MealPlanningService.UpdateRecipeStep:input_type -> mealplanning.UpdateRecipeStepRequest
-	192, // 192: mealplanning.MealPlanningService.UpdateRecipeStepCompletionCondition:input_type -> mealplanning.UpdateRecipeStepCompletionConditionRequest
-	193, // 193: mealplanning.MealPlanningService.UpdateRecipeStepIngredient:input_type -> mealplanning.UpdateRecipeStepIngredientRequest
-	194, // 194: mealplanning.MealPlanningService.UpdateRecipeStepInstrument:input_type -> mealplanning.UpdateRecipeStepInstrumentRequest
-	195, // 195: mealplanning.MealPlanningService.UpdateRecipeStepProduct:input_type -> mealplanning.UpdateRecipeStepProductRequest
-	196, // 196: mealplanning.MealPlanningService.UpdateRecipeStepVessel:input_type -> mealplanning.UpdateRecipeStepVesselRequest
-	197, // 197: mealplanning.MealPlanningService.UpdateValidIngredient:input_type -> mealplanning.UpdateValidIngredientRequest
-	198, // 198: mealplanning.MealPlanningService.UpdateValidIngredientGroup:input_type -> mealplanning.UpdateValidIngredientGroupRequest
-	199, // 199: mealplanning.MealPlanningService.UpdateValidIngredientMeasurementUnit:input_type -> mealplanning.UpdateValidIngredientMeasurementUnitRequest
-	200, // 200: mealplanning.MealPlanningService.UpdateValidIngredientPreparation:input_type -> mealplanning.UpdateValidIngredientPreparationRequest
-	201, // 201: mealplanning.MealPlanningService.UpdateValidPrepTaskConfig:input_type -> mealplanning.UpdateValidPrepTaskConfigRequest
-	202, // 202: mealplanning.MealPlanningService.UpdateValidIngredientState:input_type -> mealplanning.UpdateValidIngredientStateRequest
-	203, // 203: mealplanning.MealPlanningService.UpdateValidIngredientStateIngredient:input_type -> mealplanning.UpdateValidIngredientStateIngredientRequest
-	204, // 204: mealplanning.MealPlanningService.UpdateValidInstrument:input_type -> mealplanning.UpdateValidInstrumentRequest
-	205, // 205: mealplanning.MealPlanningService.UpdateValidMeasurementUnit:input_type -> mealplanning.UpdateValidMeasurementUnitRequest
-	206, // 206: mealplanning.MealPlanningService.UpdateValidMeasurementUnitConversion:input_type -> mealplanning.UpdateValidMeasurementUnitConversionRequest
-	207, // 207: mealplanning.MealPlanningService.UpdateValidPreparation:input_type -> mealplanning.UpdateValidPreparationRequest
-	208, // 208: mealplanning.MealPlanningService.UpdateValidPreparationInstrument:input_type -> mealplanning.UpdateValidPreparationInstrumentRequest
-	209, // 209: mealplanning.MealPlanningService.UpdateValidPreparationVessel:input_type -> mealplanning.UpdateValidPreparationVesselRequest
-	210, // 210: mealplanning.MealPlanningService.UpdateValidVessel:input_type -> mealplanning.UpdateValidVesselRequest
-	211, // 211: mealplanning.MealPlanningService.ArchiveAccountInstrumentOwnership:input_type -> mealplanning.ArchiveAccountInstrumentOwnershipRequest
-	212, // 212: mealplanning.MealPlanningService.ArchiveUserIngredientPreference:input_type -> mealplanning.ArchiveUserIngredientPreferenceRequest
-	213, // 213: mealplanning.MealPlanningService.CreateAccountInstrumentOwnership:input_type -> mealplanning.CreateAccountInstrumentOwnershipRequest
-	214, // 214: mealplanning.MealPlanningService.CreateUserIngredientPreference:input_type -> mealplanning.CreateUserIngredientPreferenceRequest
-	215, // 215: mealplanning.MealPlanningService.GetAccountInstrumentOwnership:input_type -> mealplanning.GetAccountInstrumentOwnershipRequest
-	216, // 216: mealplanning.MealPlanningService.GetAccountInstrumentOwnerships:input_type -> mealplanning.GetAccountInstrumentOwnershipsRequest
-	217, // 217: mealplanning.MealPlanningService.SearchForValidInstrumentsNotOwnedByAccount:input_type -> mealplanning.SearchForValidInstrumentsNotOwnedByAccountRequest
-	218, // 218: mealplanning.MealPlanningService.GetUserIngredientPreference:input_type -> mealplanning.GetUserIngredientPreferenceRequest
-	219, // 219: mealplanning.MealPlanningService.GetUserIngredientPreferences:input_type -> mealplanning.GetUserIngredientPreferencesRequest
-	220, // 220: mealplanning.MealPlanningService.UpdateAccountInstrumentOwnership:input_type -> mealplanning.UpdateAccountInstrumentOwnershipRequest
-	221, // 221: mealplanning.MealPlanningService.UpdateUserIngredientPreference:input_type -> mealplanning.UpdateUserIngredientPreferenceRequest
-	222, // 222: mealplanning.MealPlanningService.UploadMealImage:input_type -> mealplanning.UploadMealMediaRequest
-	223, // 223: mealplanning.MealPlanningService.UploadRecipeImage:input_type -> mealplanning.UploadRecipeMediaRequest
-	224, // 224: mealplanning.MealPlanningService.UploadPreparationMedia:input_type -> mealplanning.UploadPreparationMediaRequest
-	225, // 225: mealplanning.MealPlanningService.UploadIngredientMedia:input_type -> mealplanning.UploadIngredientMediaRequest
-	226, // 226: mealplanning.MealPlanningService.UploadRecipeStepImage:input_type -> mealplanning.UploadRecipeStepImageRequest
-	227, // 227: mealplanning.MealPlanningService.AddCommentToMeal:output_type -> mealplanning.AddCommentToMealResponse
-	228, // 228: mealplanning.MealPlanningService.AddCommentToMealPlan:output_type -> mealplanning.AddCommentToMealPlanResponse
-	229, // 229: mealplanning.MealPlanningService.AddCommentToRecipe:output_type -> mealplanning.AddCommentToRecipeResponse
-	230, // 230: mealplanning.MealPlanningService.ArchiveMeal:output_type -> mealplanning.ArchiveMealResponse
-	231, // 231: mealplanning.MealPlanningService.ArchiveMealPlan:output_type -> mealplanning.ArchiveMealPlanResponse
-	232, // 232: mealplanning.MealPlanningService.ArchiveMealPlanEvent:output_type -> mealplanning.ArchiveMealPlanEventResponse
-	233, // 233: mealplanning.MealPlanningService.ArchiveMealPlanGroceryListItem:output_type -> mealplanning.ArchiveMealPlanGroceryListItemResponse
-	234, // 234: mealplanning.MealPlanningService.ArchiveMealPlanOption:output_type -> mealplanning.ArchiveMealPlanOptionResponse
-	235, // 235: mealplanning.MealPlanningService.ArchiveMealPlanOptionVote:output_type -> mealplanning.ArchiveMealPlanOptionVoteResponse
-	236, // 236: mealplanning.MealPlanningService.ArchiveMealPlanRecipeOptionSelection:output_type -> mealplanning.ArchiveMealPlanRecipeOptionSelectionResponse
-	237, // 237: mealplanning.MealPlanningService.ArchiveMealList:output_type -> mealplanning.ArchiveMealListResponse
-	238, // 238: mealplanning.MealPlanningService.ArchiveMealListItem:output_type -> mealplanning.ArchiveMealListItemResponse
-	239, // 239: mealplanning.MealPlanningService.ArchiveRecipe:output_type -> mealplanning.ArchiveRecipeResponse
-	240, // 240: mealplanning.MealPlanningService.ArchiveRecipePrepTask:output_type -> mealplanning.ArchiveRecipePrepTaskResponse
-	241, // 241: mealplanning.MealPlanningService.ArchiveRecipeRating:output_type -> mealplanning.ArchiveRecipeRatingResponse
-	242, // 242: mealplanning.MealPlanningService.ArchiveRecipeList:output_type -> mealplanning.ArchiveRecipeListResponse
-	243, // 243: mealplanning.MealPlanningService.ArchiveRecipeListItem:output_type -> mealplanning.ArchiveRecipeListItemResponse
-	244, // 244: mealplanning.MealPlanningService.ArchiveRecipeStep:output_type -> mealplanning.ArchiveRecipeStepResponse
-	245, // 245: mealplanning.MealPlanningService.ArchiveRecipeStepCompletionCondition:output_type -> mealplanning.ArchiveRecipeStepCompletionConditionResponse
-	246, // 246: mealplanning.MealPlanningService.ArchiveRecipeStepIngredient:output_type -> mealplanning.ArchiveRecipeStepIngredientResponse
-	247, // 247: mealplanning.MealPlanningService.ArchiveRecipeStepInstrument:output_type -> mealplanning.ArchiveRecipeStepInstrumentResponse
-	248, // 248: mealplanning.MealPlanningService.ArchiveRecipeStepProduct:output_type -> mealplanning.ArchiveRecipeStepProductResponse
-	249, // 249: mealplanning.MealPlanningService.ArchiveRecipeStepVessel:output_type -> mealplanning.ArchiveRecipeStepVesselResponse
-	250, // 250: mealplanning.MealPlanningService.ArchiveValidIngredient:output_type -> mealplanning.ArchiveValidIngredientResponse
-	251, // 251: mealplanning.MealPlanningService.ArchiveValidIngredientGroup:output_type -> mealplanning.ArchiveValidIngredientGroupResponse
-	252, // 252: mealplanning.MealPlanningService.ArchiveValidIngredientMeasurementUnit:output_type -> mealplanning.ArchiveValidIngredientMeasurementUnitResponse
-	253, // 253: mealplanning.MealPlanningService.ArchiveValidIngredientPreparation:output_type -> mealplanning.ArchiveValidIngredientPreparationResponse
-	254, // 254: mealplanning.MealPlanningService.ArchiveValidPrepTaskConfig:output_type -> mealplanning.ArchiveValidPrepTaskConfigResponse
-	255, // 255: mealplanning.MealPlanningService.ArchiveValidIngredientState:output_type -> mealplanning.ArchiveValidIngredientStateResponse
-	256, // 256: mealplanning.MealPlanningService.ArchiveValidIngredientStateIngredient:output_type -> mealplanning.ArchiveValidIngredientStateIngredientResponse
-	257, // 257: mealplanning.MealPlanningService.ArchiveValidInstrument:output_type -> mealplanning.ArchiveValidInstrumentResponse
-	258, // 258: mealplanning.MealPlanningService.ArchiveValidMeasurementUnit:output_type -> mealplanning.ArchiveValidMeasurementUnitResponse
-	259, // 259: mealplanning.MealPlanningService.ArchiveValidMeasurementUnitConversion:output_type -> mealplanning.ArchiveValidMeasurementUnitConversionResponse
-	260, // 260: mealplanning.MealPlanningService.ArchiveValidPreparation:output_type -> mealplanning.ArchiveValidPreparationResponse
-	261, // 261: mealplanning.MealPlanningService.ArchiveValidPreparationInstrument:output_type -> mealplanning.ArchiveValidPreparationInstrumentResponse
-	262, // 262: mealplanning.MealPlanningService.ArchiveValidPreparationVessel:output_type -> mealplanning.ArchiveValidPreparationVesselResponse
-	263, // 263: mealplanning.MealPlanningService.ArchiveValidVessel:output_type -> mealplanning.ArchiveValidVesselResponse
-	264, // 264: mealplanning.MealPlanningService.CloneRecipe:output_type -> mealplanning.CloneRecipeResponse
-	265, // 265: mealplanning.MealPlanningService.CreateMeal:output_type -> mealplanning.CreateMealResponse
-	266, // 266: mealplanning.MealPlanningService.CreateMealPlan:output_type -> mealplanning.CreateMealPlanResponse
-	267, // 267: mealplanning.MealPlanningService.CreateMealPlanEvent:output_type -> mealplanning.CreateMealPlanEventResponse
-	268, // 268: mealplanning.MealPlanningService.CreateMealPlanOption:output_type -> mealplanning.CreateMealPlanOptionResponse
-	269, // 269: mealplanning.MealPlanningService.CreateMealPlanOptionVote:output_type -> mealplanning.CreateMealPlanOptionVoteResponse
-	270, // 270: mealplanning.MealPlanningService.CreateMealPlanRecipeOptionSelection:output_type -> mealplanning.CreateMealPlanRecipeOptionSelectionResponse
-	271, // 271: mealplanning.MealPlanningService.CreateMealPlanTask:output_type -> mealplanning.CreateMealPlanTaskResponse
-	272, // 272: mealplanning.MealPlanningService.CreateMealList:output_type -> mealplanning.CreateMealListResponse
-	273, // 273: mealplanning.MealPlanningService.CreateMealListItem:output_type -> mealplanning.CreateMealListItemResponse
-	274, // 274: mealplanning.MealPlanningService.CreateRecipe:output_type -> mealplanning.CreateRecipeResponse
-	275, // 275: mealplanning.MealPlanningService.CreateRecipePrepTask:output_type -> mealplanning.CreateRecipePrepTaskResponse
-	276, // 276: mealplanning.MealPlanningService.CreateRecipeRating:output_type -> mealplanning.CreateRecipeRatingResponse
-	277, // 277: mealplanning.MealPlanningService.CreateRecipeList:output_type -> mealplanning.CreateRecipeListResponse
-	278, // 278: mealplanning.MealPlanningService.CreateRecipeListItem:output_type -> mealplanning.CreateRecipeListItemResponse
-	279, // 279: mealplanning.MealPlanningService.CreateRecipeStep:output_type -> mealplanning.CreateRecipeStepResponse
-	280, // 280: mealplanning.MealPlanningService.CreateRecipeStepCompletionCondition:output_type -> mealplanning.CreateRecipeStepCompletionConditionResponse
-	281, // 281: mealplanning.MealPlanningService.CreateRecipeStepIngredient:output_type -> mealplanning.CreateRecipeStepIngredientResponse
-	282, // 282: mealplanning.MealPlanningService.CreateRecipeStepInstrument:output_type -> mealplanning.CreateRecipeStepInstrumentResponse
-	283, // 283: mealplanning.MealPlanningService.CreateRecipeStepProduct:output_type -> mealplanning.CreateRecipeStepProductResponse
-	284, // 284: mealplanning.MealPlanningService.CreateRecipeStepVessel:output_type -> mealplanning.CreateRecipeStepVesselResponse
-	285, // 285: mealplanning.MealPlanningService.CreateValidIngredient:output_type -> mealplanning.CreateValidIngredientResponse
-	286, // 286: mealplanning.MealPlanningService.CreateValidIngredientGroup:output_type -> mealplanning.CreateValidIngredientGroupResponse
-	287, // 287: mealplanning.MealPlanningService.CreateValidIngredientMeasurementUnit:output_type -> mealplanning.CreateValidIngredientMeasurementUnitResponse
-	288, // 288: mealplanning.MealPlanningService.CreateValidIngredientPreparation:output_type -> mealplanning.CreateValidIngredientPreparationResponse
-	289, // 289: mealplanning.MealPlanningService.CreateValidPrepTaskConfig:output_type -> mealplanning.CreateValidPrepTaskConfigResponse
-	290, // 290: mealplanning.MealPlanningService.CreateValidIngredientState:output_type -> mealplanning.CreateValidIngredientStateResponse
-	291, // 291: mealplanning.MealPlanningService.CreateValidIngredientStateIngredient:output_type -> mealplanning.CreateValidIngredientStateIngredientResponse
-	292, // 292: mealplanning.MealPlanningService.CreateValidInstrument:output_type -> mealplanning.CreateValidInstrumentResponse
-	293, // 293: mealplanning.MealPlanningService.CreateValidMeasurementUnit:output_type -> mealplanning.CreateValidMeasurementUnitResponse
-	294, // 294: mealplanning.MealPlanningService.CreateValidMeasurementUnitConversion:output_type -> mealplanning.CreateValidMeasurementUnitConversionResponse
-	295, // 295: mealplanning.MealPlanningService.CreateValidPreparation:output_type -> mealplanning.CreateValidPreparationResponse
-	296, // 296: mealplanning.MealPlanningService.CreateValidPreparationInstrument:output_type -> mealplanning.CreateValidPreparationInstrumentResponse
-	297, // 297: mealplanning.MealPlanningService.CreateValidPreparationVessel:output_type -> mealplanning.CreateValidPreparationVesselResponse
-	298, // 298: mealplanning.MealPlanningService.CreateValidVessel:output_type -> mealplanning.CreateValidVesselResponse
-	299, // 299: mealplanning.MealPlanningService.FinalizeMealPlan:output_type -> mealplanning.FinalizeMealPlanResponse
-	300, // 300: mealplanning.MealPlanningService.GetMeal:output_type -> mealplanning.GetMealResponse
-	301, // 301: mealplanning.MealPlanningService.GetMealPlan:output_type -> mealplanning.GetMealPlanResponse
-	302, // 302: mealplanning.MealPlanningService.GetMealPlanEvent:output_type -> mealplanning.GetMealPlanEventResponse
-	303, // 303: mealplanning.MealPlanningService.GetMealPlanEvents:output_type -> mealplanning.GetMealPlanEventsResponse
-	304, // 304: mealplanning.MealPlanningService.GetMealPlanGroceryListItem:output_type -> mealplanning.GetMealPlanGroceryListItemResponse
-	305, // 305: mealplanning.MealPlanningService.GetMealPlanGroceryListItemsForMealPlan:output_type -> mealplanning.GetMealPlanGroceryListItemsForMealPlanResponse
-	306, // 306: mealplanning.MealPlanningService.GetMealPlanOption:output_type -> mealplanning.GetMealPlanOptionResponse
-	307, // 307: mealplanning.MealPlanningService.GetMealPlanOptionVote:output_type -> mealplanning.GetMealPlanOptionVoteResponse
-	308, // 308: mealplanning.MealPlanningService.GetMealPlanOptionVotes:output_type -> mealplanning.GetMealPlanOptionVotesResponse
-	309, // 309: mealplanning.MealPlanningService.GetMealPlanOptions:output_type -> mealplanning.GetMealPlanOptionsResponse
-	310, // 310: mealplanning.MealPlanningService.GetMealPlanRecipeOptionSelection:output_type -> mealplanning.GetMealPlanRecipeOptionSelectionResponse
-	311, // 311: mealplanning.MealPlanningService.GetMealPlanRecipeOptionSelectionsForMealPlanOption:output_type -> mealplanning.GetMealPlanRecipeOptionSelectionsForMealPlanOptionResponse
-	312, // 312: mealplanning.MealPlanningService.GetMealPlanTask:output_type -> mealplanning.GetMealPlanTaskResponse
-	313, // 313: mealplanning.MealPlanningService.GetMealPlanTasks:output_type -> mealplanning.GetMealPlanTasksResponse
-	314, // 314: mealplanning.MealPlanningService.GetMealPlansForAccount:output_type -> mealplanning.GetMealPlansForAccountResponse
-	315, // 315: mealplanning.MealPlanningService.GetMealLists:output_type -> mealplanning.GetMealListsResponse
-	316, // 316: mealplanning.MealPlanningService.GetMeals:output_type -> mealplanning.GetMealsResponse
-	317, // 317: mealplanning.MealPlanningService.GetMermaidDiagramForMeal:output_type -> mealplanning.GetMermaidDiagramForMealResponse
-	318, // 318: mealplanning.MealPlanningService.GetMermaidDiagramForRecipe:output_type -> mealplanning.GetMermaidDiagramForRecipeResponse
-	319, // 319: mealplanning.MealPlanningService.GetRandomValidIngredient:output_type -> mealplanning.GetRandomValidIngredientResponse
-	320, // 320: mealplanning.MealPlanningService.GetRandomValidInstrument:output_type -> mealplanning.GetRandomValidInstrumentResponse
-	321, // 321: mealplanning.MealPlanningService.GetRandomValidPreparation:output_type -> mealplanning.GetRandomValidPreparationResponse
-	322, // 322: mealplanning.MealPlanningService.GetRandomValidVessel:output_type -> mealplanning.GetRandomValidVesselResponse
-	323, // 323: mealplanning.MealPlanningService.GetRecipe:output_type -> mealplanning.GetRecipeResponse
-	324, // 324: mealplanning.MealPlanningService.EstimateRecipePrepTasks:output_type -> mealplanning.EstimateRecipePrepTasksResponse
-	325, // 325: mealplanning.MealPlanningService.GetRecipePrepTask:output_type -> mealplanning.GetRecipePrepTaskResponse
-	326, // 326: mealplanning.MealPlanningService.GetRecipePrepTasks:output_type -> mealplanning.GetRecipePrepTasksResponse
-	327, // 327: mealplanning.MealPlanningService.GetRecipeRating:output_type -> mealplanning.GetRecipeRatingResponse
-	328, // 328: mealplanning.MealPlanningService.GetRecipeRatingsForRecipe:output_type -> mealplanning.GetRecipeRatingsForRecipeResponse
-	329, // 329: mealplanning.MealPlanningService.GetRecipeStep:output_type -> mealplanning.GetRecipeStepResponse
-	330, // 330: mealplanning.MealPlanningService.GetRecipeStepCompletionCondition:output_type -> mealplanning.GetRecipeStepCompletionConditionResponse
-	331, // 331: mealplanning.MealPlanningService.GetRecipeStepCompletionConditions:output_type -> mealplanning.GetRecipeStepCompletionConditionsResponse
-	332, // 332: mealplanning.MealPlanningService.GetRecipeStepIngredient:output_type -> mealplanning.GetRecipeStepIngredientResponse
-	333, // 333: mealplanning.MealPlanningService.GetRecipeStepIngredients:output_type -> mealplanning.GetRecipeStepIngredientsResponse
-	334, // 334: mealplanning.MealPlanningService.GetRecipeStepInstrument:output_type -> mealplanning.GetRecipeStepInstrumentResponse
-	335, // 335: mealplanning.MealPlanningService.GetRecipeStepInstruments:output_type -> mealplanning.GetRecipeStepInstrumentsResponse
-	336, // 336: mealplanning.MealPlanningService.GetRecipeStepProduct:output_type -> mealplanning.GetRecipeStepProductResponse
-	337, // 337: mealplanning.MealPlanningService.GetRecipeStepProducts:output_type -> mealplanning.GetRecipeStepProductsResponse
-	338, // 338: mealplanning.MealPlanningService.GetRecipeStepVessel:output_type -> mealplanning.GetRecipeStepVesselResponse
-	339, // 339: mealplanning.MealPlanningService.GetRecipeStepVessels:output_type -> mealplanning.GetRecipeStepVesselsResponse
-	340, // 340: mealplanning.MealPlanningService.GetRecipeSteps:output_type -> mealplanning.GetRecipeStepsResponse
-	341, // 341: mealplanning.MealPlanningService.GetRecipeLists:output_type -> mealplanning.GetRecipeListsResponse
-	342, // 342: mealplanning.MealPlanningService.GetRecipes:output_type -> mealplanning.GetRecipesResponse
-	343, // 343: mealplanning.MealPlanningService.GetValidIngredient:output_type -> mealplanning.GetValidIngredientResponse
-	344, // 344: mealplanning.MealPlanningService.GetValidIngredientGroup:output_type -> mealplanning.GetValidIngredientGroupResponse
-	345, // 345: mealplanning.MealPlanningService.GetValidIngredientGroups:output_type -> mealplanning.GetValidIngredientGroupsResponse
-	346, // 346: mealplanning.MealPlanningService.GetValidIngredientMeasurementUnit:output_type -> mealplanning.GetValidIngredientMeasurementUnitResponse
-	347, // 347: mealplanning.MealPlanningService.GetValidIngredientMeasurementUnits:output_type -> mealplanning.GetValidIngredientMeasurementUnitsResponse
-	348, // 348: mealplanning.MealPlanningService.GetValidIngredientMeasurementUnitsByIngredient:output_type -> mealplanning.GetValidIngredientMeasurementUnitsByIngredientResponse
-	349, // 349: mealplanning.MealPlanningService.GetValidIngredientMeasurementUnitsByMeasurementUnit:output_type -> mealplanning.GetValidIngredientMeasurementUnitsByMeasurementUnitResponse
-	350, // 350: mealplanning.MealPlanningService.GetValidIngredientPreparation:output_type -> mealplanning.GetValidIngredientPreparationResponse
-	351, // 351: mealplanning.MealPlanningService.GetValidIngredientPreparations:output_type -> mealplanning.GetValidIngredientPreparationsResponse
-	352, // 352: mealplanning.MealPlanningService.GetValidIngredientPreparationsByIngredient:output_type -> mealplanning.GetValidIngredientPreparationsByIngredientResponse
-	353, // 353: mealplanning.MealPlanningService.GetValidIngredientPreparationsByPreparation:output_type -> mealplanning.GetValidIngredientPreparationsByPreparationResponse
-	354, // 354: mealplanning.MealPlanningService.GetValidPrepTaskConfig:output_type -> mealplanning.GetValidPrepTaskConfigResponse
-	355, // 355: mealplanning.MealPlanningService.GetValidPrepTaskConfigs:output_type -> mealplanning.GetValidPrepTaskConfigsResponse
-	356, // 356: mealplanning.MealPlanningService.GetValidPrepTaskConfigsByIngredient:output_type -> mealplanning.GetValidPrepTaskConfigsByIngredientResponse
-	357, // 357: mealplanning.MealPlanningService.GetValidPrepTaskConfigsByPreparation:output_type -> mealplanning.GetValidPrepTaskConfigsByPreparationResponse
-	358, // 358: mealplanning.MealPlanningService.GetValidPrepTaskConfigsByIngredientAndPreparation:output_type -> mealplanning.GetValidPrepTaskConfigsByIngredientAndPreparationResponse
-	359, // 359: mealplanning.MealPlanningService.GetValidIngredientState:output_type -> mealplanning.GetValidIngredientStateResponse
-	360, // 360: mealplanning.MealPlanningService.GetValidIngredientStateIngredient:output_type -> mealplanning.GetValidIngredientStateIngredientResponse
-	361, // 361: mealplanning.MealPlanningService.GetValidIngredientStateIngredients:output_type -> mealplanning.GetValidIngredientStateIngredientsResponse
-	362, // 362: mealplanning.MealPlanningService.GetValidIngredientStateIngredientsByIngredient:output_type -> mealplanning.GetValidIngredientStateIngredientsByIngredientResponse
-	363, // 363: mealplanning.MealPlanningService.GetValidIngredientStateIngredientsByIngredientState:output_type -> mealplanning.GetValidIngredientStateIngredientsByIngredientStateResponse
-	364, // 364: mealplanning.MealPlanningService.GetValidIngredientStates:output_type -> mealplanning.GetValidIngredientStatesResponse
-	365, // 365: mealplanning.MealPlanningService.GetValidIngredients:output_type -> mealplanning.GetValidIngredientsResponse
-	366, // 366: mealplanning.MealPlanningService.GetValidInstrument:output_type -> mealplanning.GetValidInstrumentResponse
-	367, // 367: mealplanning.MealPlanningService.GetValidInstruments:output_type -> mealplanning.GetValidInstrumentsResponse
-	368, // 368: mealplanning.MealPlanningService.GetValidMeasurementUnit:output_type -> mealplanning.GetValidMeasurementUnitResponse
-	369, // 369: mealplanning.MealPlanningService.GetValidMeasurementUnitConversion:output_type -> mealplanning.GetValidMeasurementUnitConversionResponse
-	370, // 370: mealplanning.MealPlanningService.GetValidMeasurementUnitConversionsForUnit:output_type -> mealplanning.GetValidMeasurementUnitConversionsForUnitResponse
-	371, // 371: mealplanning.MealPlanningService.GetValidMeasurementUnitConversionsForIngredients:output_type -> mealplanning.GetValidMeasurementUnitConversionsForIngredientsResponse
-	372, // 372: mealplanning.MealPlanningService.GetMeasurementUnitConversionMismatches:output_type -> mealplanning.GetMeasurementUnitConversionMismatchesResponse
-	373, // 373: mealplanning.MealPlanningService.GetValidMeasurementUnits:output_type -> mealplanning.GetValidMeasurementUnitsResponse
-	374, // 374: mealplanning.MealPlanningService.GetValidPreparation:output_type -> mealplanning.GetValidPreparationResponse
-	375, // 375: mealplanning.MealPlanningService.GetValidPreparationInstrument:output_type -> mealplanning.GetValidPreparationInstrumentResponse
-	376, // 376: mealplanning.MealPlanningService.GetValidPreparationInstruments:output_type -> mealplanning.GetValidPreparationInstrumentsResponse
-	377, // 377: mealplanning.MealPlanningService.GetValidPreparationInstrumentsByInstrument:output_type -> mealplanning.GetValidPreparationInstrumentsByInstrumentResponse
-	378, // 378: mealplanning.MealPlanningService.GetValidPreparationInstrumentsByPreparation:output_type -> mealplanning.GetValidPreparationInstrumentsByPreparationResponse
-	379, // 379: mealplanning.MealPlanningService.GetValidPreparationVessel:output_type -> mealplanning.GetValidPreparationVesselResponse
-	380, // 380: mealplanning.MealPlanningService.GetValidPreparationVessels:output_type -> mealplanning.GetValidPreparationVesselsResponse
-	381, // 381: mealplanning.MealPlanningService.GetValidPreparationVesselsByPreparation:output_type -> mealplanning.GetValidPreparationVesselsByPreparationResponse
-	382, // 382: mealplanning.MealPlanningService.GetValidPreparationVesselsByVessel:output_type -> mealplanning.GetValidPreparationVesselsByVesselResponse
-	383, // 383: mealplanning.MealPlanningService.GetValidPreparations:output_type -> mealplanning.GetValidPreparationsResponse
-	384, // 384: mealplanning.MealPlanningService.GetValidVessel:output_type -> mealplanning.GetValidVesselResponse
-	385, // 385: mealplanning.MealPlanningService.GetValidVessels:output_type -> mealplanning.GetValidVesselsResponse
-	386, // 386: mealplanning.MealPlanningService.RunFinalizeMealPlanWorker:output_type -> mealplanning.RunFinalizeMealPlanWorkerResponse
-	387, // 387: mealplanning.MealPlanningService.RunMealPlanGroceryListInitializerWorker:output_type -> mealplanning.RunMealPlanGroceryListInitializerWorkerResponse
-	388, // 388: mealplanning.MealPlanningService.RunMealPlanTaskCreatorWorker:output_type -> mealplanning.RunMealPlanTaskCreatorWorkerResponse
-	389, // 389: mealplanning.MealPlanningService.SearchForMeals:output_type -> mealplanning.SearchForMealsResponse
-	390, // 390: mealplanning.MealPlanningService.SearchForRecipes:output_type -> mealplanning.SearchForRecipesResponse
-	391, // 391: mealplanning.MealPlanningService.SearchForMealEligibleRecipes:output_type -> mealplanning.SearchForMealEligibleRecipesResponse
-	392, // 392: mealplanning.MealPlanningService.SearchForRecipesWithInstrumentOwnership:output_type -> mealplanning.SearchForRecipesWithInstrumentOwnershipResponse
-	393, // 393: mealplanning.MealPlanningService.SearchForValidIngredientGroups:output_type -> mealplanning.SearchForValidIngredientGroupsResponse
-	394, // 394: mealplanning.MealPlanningService.SearchForValidIngredientStates:output_type -> mealplanning.SearchForValidIngredientStatesResponse
-	395, // 395: mealplanning.MealPlanningService.SearchForValidIngredients:output_type -> mealplanning.SearchForValidIngredientsResponse
-	396, // 396: mealplanning.MealPlanningService.SearchForValidInstruments:output_type -> mealplanning.SearchForValidInstrumentsResponse
-	397, // 397: mealplanning.MealPlanningService.SearchForValidMeasurementUnits:output_type -> mealplanning.SearchForValidMeasurementUnitsResponse
-	398, // 398: mealplanning.MealPlanningService.SearchForValidPreparations:output_type -> mealplanning.SearchForValidPreparationsResponse
-	399, // 399: mealplanning.MealPlanningService.SearchForValidVessels:output_type -> mealplanning.SearchForValidVesselsResponse
-	400, // 400: mealplanning.MealPlanningService.SearchValidIngredientsByPreparation:output_type -> mealplanning.SearchValidIngredientsByPreparationResponse
-	401, // 401: mealplanning.MealPlanningService.SearchValidMeasurementUnitsByIngredient:output_type -> mealplanning.SearchValidMeasurementUnitsByIngredientResponse
-	402, // 402: mealplanning.MealPlanningService.UpdateMealPlan:output_type -> mealplanning.UpdateMealPlanResponse
-	403, // 403: mealplanning.MealPlanningService.UpdateMealPlanEvent:output_type -> mealplanning.UpdateMealPlanEventResponse
-	404, // 404: mealplanning.MealPlanningService.SwapMealPlanEvents:output_type -> mealplanning.SwapMealPlanEventsResponse
-	405, // 405: mealplanning.MealPlanningService.UpdateMealPlanGroceryListItem:output_type -> mealplanning.UpdateMealPlanGroceryListItemResponse
-	406, // 406: mealplanning.MealPlanningService.UpdateMealPlanOption:output_type -> mealplanning.UpdateMealPlanOptionResponse
-	407, // 407: mealplanning.MealPlanningService.UpdateMealPlanOptionVote:output_type -> mealplanning.UpdateMealPlanOptionVoteResponse
-	408, // 408: mealplanning.MealPlanningService.UpdateMealPlanRecipeOptionSelection:output_type -> mealplanning.UpdateMealPlanRecipeOptionSelectionResponse
-	409, // 409: mealplanning.MealPlanningService.UpdateMealPlanTaskStatus:output_type -> mealplanning.UpdateMealPlanTaskStatusResponse
-	410, // 410: mealplanning.MealPlanningService.UpdateMealList:output_type -> mealplanning.UpdateMealListResponse
-	411, // 411: mealplanning.MealPlanningService.UpdateMealListItem:output_type -> mealplanning.UpdateMealListItemResponse
-	412, // 412: mealplanning.MealPlanningService.UpdateRecipe:output_type -> mealplanning.UpdateRecipeResponse
-	413, // 413: mealplanning.MealPlanningService.UpdateRecipeStatus:output_type -> mealplanning.UpdateRecipeStatusResponse
-	414, // 414: mealplanning.MealPlanningService.UpdateRecipePrepTask:output_type -> mealplanning.UpdateRecipePrepTaskResponse
-	415, // 415: mealplanning.MealPlanningService.UpdateRecipeRating:output_type -> mealplanning.UpdateRecipeRatingResponse
-	416, // 416: mealplanning.MealPlanningService.UpdateRecipeList:output_type -> mealplanning.UpdateRecipeListResponse
-	417, // 417: mealplanning.MealPlanningService.UpdateRecipeListItem:output_type -> mealplanning.UpdateRecipeListItemResponse
-	418, // 418: mealplanning.MealPlanningService.UpdateRecipeStep:output_type -> mealplanning.UpdateRecipeStepResponse
-	419, // 419: mealplanning.MealPlanningService.UpdateRecipeStepCompletionCondition:output_type -> mealplanning.UpdateRecipeStepCompletionConditionResponse
-	420, // 420: mealplanning.MealPlanningService.UpdateRecipeStepIngredient:output_type -> mealplanning.UpdateRecipeStepIngredientResponse
-	421, // 421: mealplanning.MealPlanningService.UpdateRecipeStepInstrument:output_type -> mealplanning.UpdateRecipeStepInstrumentResponse
-	422, // 422: mealplanning.MealPlanningService.UpdateRecipeStepProduct:output_type -> mealplanning.UpdateRecipeStepProductResponse
-	423, // 423: mealplanning.MealPlanningService.UpdateRecipeStepVessel:output_type -> mealplanning.UpdateRecipeStepVesselResponse
-	424, // 424: mealplanning.MealPlanningService.UpdateValidIngredient:output_type -> mealplanning.UpdateValidIngredientResponse
-	425, // 425: mealplanning.MealPlanningService.UpdateValidIngredientGroup:output_type -> mealplanning.UpdateValidIngredientGroupResponse
-	426, // 426: mealplanning.MealPlanningService.UpdateValidIngredientMeasurementUnit:output_type -> mealplanning.UpdateValidIngredientMeasurementUnitResponse
-	427, // 427: mealplanning.MealPlanningService.UpdateValidIngredientPreparation:output_type -> mealplanning.UpdateValidIngredientPreparationResponse
-	428, // 428: mealplanning.MealPlanningService.UpdateValidPrepTaskConfig:output_type -> mealplanning.UpdateValidPrepTaskConfigResponse
-	429, // 429: mealplanning.MealPlanningService.UpdateValidIngredientState:output_type -> mealplanning.UpdateValidIngredientStateResponse
-	430, // 430: mealplanning.MealPlanningService.UpdateValidIngredientStateIngredient:output_type -> mealplanning.UpdateValidIngredientStateIngredientResponse
-	431, // 431: mealplanning.MealPlanningService.UpdateValidInstrument:output_type -> mealplanning.UpdateValidInstrumentResponse
-	432, // 432: mealplanning.MealPlanningService.UpdateValidMeasurementUnit:output_type -> mealplanning.UpdateValidMeasurementUnitResponse
-	433, // 433: mealplanning.MealPlanningService.UpdateValidMeasurementUnitConversion:output_type -> mealplanning.UpdateValidMeasurementUnitConversionResponse
-	434, // 434: mealplanning.MealPlanningService.UpdateValidPreparation:output_type -> mealplanning.UpdateValidPreparationResponse
-	435, // 435: mealplanning.MealPlanningService.UpdateValidPreparationInstrument:output_type -> mealplanning.UpdateValidPreparationInstrumentResponse
-	436, // 436: mealplanning.MealPlanningService.UpdateValidPreparationVessel:output_type -> mealplanning.UpdateValidPreparationVesselResponse
-	437, // 437: mealplanning.MealPlanningService.UpdateValidVessel:output_type -> mealplanning.UpdateValidVesselResponse
-	438, // 438: mealplanning.MealPlanningService.ArchiveAccountInstrumentOwnership:output_type -> mealplanning.ArchiveAccountInstrumentOwnershipResponse
-	439, // 439: mealplanning.MealPlanningService.ArchiveUserIngredientPreference:output_type -> mealplanning.ArchiveUserIngredientPreferenceResponse
-	440, // 440: mealplanning.MealPlanningService.CreateAccountInstrumentOwnership:output_type -> mealplanning.CreateAccountInstrumentOwnershipResponse
-	441, // 441: mealplanning.MealPlanningService.CreateUserIngredientPreference:output_type -> mealplanning.CreateUserIngredientPreferenceResponse
-	442, // 442: mealplanning.MealPlanningService.GetAccountInstrumentOwnership:output_type -> mealplanning.GetAccountInstrumentOwnershipResponse
-	443, // 443: mealplanning.MealPlanningService.GetAccountInstrumentOwnerships:output_type -> mealplanning.GetAccountInstrumentOwnershipsResponse
-	444, // 444: mealplanning.MealPlanningService.SearchForValidInstrumentsNotOwnedByAccount:output_type -> mealplanning.SearchForValidInstrumentsNotOwnedByAccountResponse
-	445, // 445: mealplanning.MealPlanningService.GetUserIngredientPreference:output_type -> mealplanning.GetUserIngredientPreferenceResponse
-	446, // 446: mealplanning.MealPlanningService.GetUserIngredientPreferences:output_type -> mealplanning.GetUserIngredientPreferencesResponse
-	447, // 447: mealplanning.MealPlanningService.UpdateAccountInstrumentOwnership:output_type -> mealplanning.UpdateAccountInstrumentOwnershipResponse
-	448, // 448: mealplanning.MealPlanningService.UpdateUserIngredientPreference:output_type -> mealplanning.UpdateUserIngredientPreferenceResponse
-	449, // 449: mealplanning.MealPlanningService.UploadMealImage:output_type -> mealplanning.UploadMealImageResponse
-	450, // 450: mealplanning.MealPlanningService.UploadRecipeImage:output_type -> mealplanning.UploadRecipeImageResponse
-	451, // 451: mealplanning.MealPlanningService.UploadPreparationMedia:output_type -> mealplanning.UploadPreparationMediaResponse
-	452, // 452: mealplanning.MealPlanningService.UploadIngredientMedia:output_type -> mealplanning.UploadIngredientMediaResponse
-	453, // 453: mealplanning.MealPlanningService.UploadRecipeStepImage:output_type -> mealplanning.UploadRecipeStepImageResponse
-	227, // [227:454] is the sub-list for method output_type
-	0,   // [0:227] is the sub-list for method input_type
+	10,  // 10: mealplanning.MealPlanningService.ArchiveMealPlanTemplate:input_type -> mealplanning.ArchiveMealPlanTemplateRequest
+	11,  // 11: mealplanning.MealPlanningService.ArchiveMealList:input_type -> mealplanning.ArchiveMealListRequest
+	12,  // 12: mealplanning.MealPlanningService.ArchiveMealListItem:input_type -> mealplanning.ArchiveMealListItemRequest
+	13,  // 13: mealplanning.MealPlanningService.ArchiveRecipe:input_type -> mealplanning.ArchiveRecipeRequest
+	14,  // 14: mealplanning.MealPlanningService.ArchiveRecipePrepTask:input_type -> mealplanning.ArchiveRecipePrepTaskRequest
+	15,  // 15: mealplanning.MealPlanningService.ArchiveRecipeRating:input_type -> mealplanning.ArchiveRecipeRatingRequest
+	16,  // 16: mealplanning.MealPlanningService.ArchiveRecipeList:input_type -> mealplanning.ArchiveRecipeListRequest
+	17,  // 17: mealplanning.MealPlanningService.ArchiveRecipeListItem:input_type -> mealplanning.ArchiveRecipeListItemRequest
+	18,  // 18: mealplanning.MealPlanningService.ArchiveRecipeStep:input_type -> mealplanning.ArchiveRecipeStepRequest
+	19,  // 19: mealplanning.MealPlanningService.ArchiveRecipeStepCompletionCondition:input_type -> mealplanning.ArchiveRecipeStepCompletionConditionRequest
+	20,  // 20: mealplanning.MealPlanningService.ArchiveRecipeStepIngredient:input_type -> mealplanning.ArchiveRecipeStepIngredientRequest
+	21,  // 21: mealplanning.MealPlanningService.ArchiveRecipeStepInstrument:input_type -> mealplanning.ArchiveRecipeStepInstrumentRequest
+	22,  // 22: mealplanning.MealPlanningService.ArchiveRecipeStepProduct:input_type -> mealplanning.ArchiveRecipeStepProductRequest
+	23,  // 23: mealplanning.MealPlanningService.ArchiveRecipeStepVessel:input_type -> mealplanning.ArchiveRecipeStepVesselRequest
+	24,  // 24: mealplanning.MealPlanningService.ArchiveValidIngredient:input_type -> mealplanning.ArchiveValidIngredientRequest
+	25,  // 25: mealplanning.MealPlanningService.ArchiveValidIngredientGroup:input_type -> mealplanning.ArchiveValidIngredientGroupRequest
+	26,  // 26: mealplanning.MealPlanningService.ArchiveValidIngredientMeasurementUnit:input_type -> mealplanning.ArchiveValidIngredientMeasurementUnitRequest
+	27,  // 27: mealplanning.MealPlanningService.ArchiveValidIngredientNutritionFacts:input_type -> mealplanning.ArchiveValidIngredientNutritionFactsRequest
+	28,  // 28: mealplanning.MealPlanningService.ArchiveValidIngredientPreparation:input_type -> mealplanning.ArchiveValidIngredientPreparationRequest
+	29,  // 29: mealplanning.MealPlanningService.ArchiveValidPrepTaskConfig:input_type -> mealplanning.ArchiveValidPrepTaskConfigRequest
+	30,  // 30: mealplanning.MealPlanningService.ArchiveValidIngredientState:input_type -> mealplanning.ArchiveValidIngredientStateRequest
+	31,  // 31: mealplanning.MealPlanningService.ArchiveValidIngredientStateIngredient:input_type -> mealplanning.ArchiveValidIngredientStateIngredientRequest
+	32,  // 32: mealplanning.MealPlanningService.ArchiveValidInstrument:input_type -> mealplanning.ArchiveValidInstrumentRequest
+	33,  // 33: mealplanning.MealPlanningService.ArchiveValidMeasurementUnit:input_type -> mealplanning.ArchiveValidMeasurementUnitRequest
+	34,  // 34: mealplanning.MealPlanningService.ArchiveValidMeasurementUnitConversion:input_type -> mealplanning.ArchiveValidMeasurementUnitConversionRequest
+	35,  // 35: mealplanning.MealPlanningService.ArchiveValidPreparation:input_type -> mealplanning.ArchiveValidPreparationRequest
+	36,  // 36: mealplanning.MealPlanningService.ArchiveValidPreparationInstrument:input_type -> mealplanning.ArchiveValidPreparationInstrumentRequest
+	37,  // 37: mealplanning.MealPlanningService.ArchiveValidPreparationVessel:input_type -> mealplanning.ArchiveValidPreparationVesselRequest
+	38,  // 38: mealplanning.MealPlanningService.ArchiveValidVessel:input_type -> mealplanning.ArchiveValidVesselRequest
+	39,  // 39: mealplanning.MealPlanningService.CloneRecipe:input_type -> mealplanning.CloneRecipeRequest
+	40,  // 40: mealplanning.MealPlanningService.ImportRecipe:input_type -> mealplanning.ImportRecipeRequest
+	41,  // 41: mealplanning.MealPlanningService.CreateMeal:input_type -> mealplanning.CreateMealRequest
+	42,  // 42: mealplanning.MealPlanningService.CreateMealPlan:input_type -> mealplanning.CreateMealPlanRequest
+	43,  // 43: mealplanning.MealPlanningService.CreateMealPlanCalendarFeed:input_type -> mealplanning.CreateMealPlanCalendarFeedRequest
+	44,  // 44: mealplanning.MealPlanningService.CreateMealPlanEvent:input_type -> mealplanning.CreateMealPlanEventRequest
+	45,  // 45: mealplanning.MealPlanningService.CreateMealPlanOption:input_type -> mealplanning.CreateMealPlanOptionRequest
+	46,  // 46: mealplanning.MealPlanningService.CreateMealPlanOptionVote:input_type -> mealplanning.CreateMealPlanOptionVoteRequest
+	47,  // 47: mealplanning.MealPlanningService.CreateMealPlanRecipeOptionSelection:input_type -> mealplanning.CreateMealPlanRecipeOptionSelectionRequest
+	48,  // 48: mealplanning.MealPlanningService.CreateMealPlanTask:input_type -> mealplanning.CreateMealPlanTaskRequest
+	49,  // 49: mealplanning.MealPlanningService.CreateMealPlanTemplate:input_type -> mealplanning.CreateMealPlanTemplateRequest
+	50,  // 50: mealplanning.MealPlanningService.CreateMealList:input_type -> mealplanning.CreateMealListRequest
+	51,  // 51: mealplanning.MealPlanningService.CreateMealListItem:input_type -> mealplanning.CreateMealListItemRequest
+	52,  // 52: mealplanning.MealPlanningService.CreateRecipe:input_type -> mealplanning.CreateRecipeRequest
+	53,  // 53: mealplanning.MealPlanningService.CreateRecipePrepTask:input_type -> mealplanning.CreateRecipePrepTaskRequest
+	54,  // 54: mealplanning.MealPlanningService.CreateRecipeRating:input_type -> mealplanning.CreateRecipeRatingRequest
+	55,  // 55: mealplanning.MealPlanningService.CreateRecipeList:input_type -> mealplanning.CreateRecipeListRequest
+	56,  // 56: mealplanning.MealPlanningService.CreateRecipeListItem:input_type -> mealplanning.CreateRecipeListItemRequest
+	57,  // 57: mealplanning.MealPlanningService.CreateRecipeStep:input_type -> mealplanning.CreateRecipeStepRequest
+	58,  // 58: mealplanning.MealPlanningService.CreateRecipeStepCompletionCondition:input_type -> mealplanning.CreateRecipeStepCompletionConditionRequest
+	59,  // 59: mealplanning.MealPlanningService.CreateRecipeStepIngredient:input_type -> mealplanning.CreateRecipeStepIngredientRequest
+	60,  // 60: mealplanning.MealPlanningService.CreateRecipeStepInstrument:input_type -> mealplanning.CreateRecipeStepInstrumentRequest
+	61,  // 61: mealplanning.MealPlanningService.CreateRecipeStepProduct:input_type -> mealplanning.CreateRecipeStepProductRequest
+	62,  // 62: mealplanning.MealPlanningService.CreateRecipeStepVessel:input_type -> mealplanning.CreateRecipeStepVesselRequest
+	63,  // 63: mealplanning.MealPlanningService.CreateValidIngredient:input_type -> mealplanning.CreateValidIngredientRequest
+	64,  // 64: mealplanning.MealPlanningService.CreateValidIngredientGroup:input_type -> mealplanning.CreateValidIngredientGroupRequest
+	65,  // 65: mealplanning.MealPlanningService.CreateValidIngredientMeasurementUnit:input_type -> mealplanning.CreateValidIngredientMeasurementUnitRequest
+	66,  // 66: mealplanning.MealPlanningService.CreateValidIngredientNutritionFacts:input_type -> mealplanning.CreateValidIngredientNutritionFactsRequest
+	67,  // 67: mealplanning.MealPlanningService.CreateValidIngredientPreparation:input_type -> mealplanning.CreateValidIngredientPreparationRequest
+	68,  // 68: mealplanning.MealPlanningService.CreateValidPrepTaskConfig:input_type -> mealplanning.CreateValidPrepTaskConfigRequest
+	69,  // 69: mealplanning.MealPlanningService.CreateValidIngredientState:input_type -> mealplanning.CreateValidIngredientStateRequest
+	70,  // 70: mealplanning.MealPlanningService.CreateValidIngredientStateIngredient:input_type -> mealplanning.CreateValidIngredientStateIngredientRequest
+	71,  // 71: mealplanning.MealPlanningService.CreateValidInstrument:input_type -> mealplanning.CreateValidInstrumentRequest
+	72,  // 72: mealplanning.MealPlanningService.CreateValidMeasurementUnit:input_type -> mealplanning.CreateValidMeasurementUnitRequest
+	73,  // 73: mealplanning.MealPlanningService.CreateValidMeasurementUnitConversion:input_type -> mealplanning.CreateValidMeasurementUnitConversionRequest
+	74,  // 74: mealplanning.MealPlanningService.CreateValidPreparation:input_type -> mealplanning.CreateValidPreparationRequest
+	75,  // 75: mealplanning.MealPlanningService.CreateValidPreparationInstrument:input_type -> mealplanning.CreateValidPreparationInstrumentRequest
+	76,  // 76: mealplanning.MealPlanningService.CreateValidPreparationVessel:input_type -> mealplanning.CreateValidPreparationVesselRequest
+	77,  // 77: mealplanning.MealPlanningService.CreateValidVessel:input_type -> mealplanning.CreateValidVesselRequest
+	78,  // 78: mealplanning.MealPlanningService.CompleteMealPlanOption:input_type -> mealplanning.CompleteMealPlanOptionRequest
+	79,  // 79: mealplanning.MealPlanningService.DiffRecipeRevisions:input_type -> mealplanning.DiffRecipeRevisionsRequest
+	80,  // 80: mealplanning.MealPlanningService.FinalizeMealPlan:input_type -> mealplanning.FinalizeMealPlanRequest
+	81,  // 81: mealplanning.MealPlanningService.GetMeal:input_type -> mealplanning.GetMealRequest
+	82,  // 82: mealplanning.MealPlanningService.GetMealPlan:input_type -> mealplanning.GetMealPlanRequest
+	83,  // 83: mealplanning.MealPlanningService.GetMealPlanEvent:input_type -> mealplanning.GetMealPlanEventRequest
+	84,  // 84: mealplanning.MealPlanningService.GetMealPlanEventTallyReport:input_type -> mealplanning.GetMealPlanEventTallyReportRequest
+	85,  // 85: mealplanning.MealPlanningService.GetMealPlanEvents:input_type -> mealplanning.GetMealPlanEventsRequest
+	86,  // 86: mealplanning.MealPlanningService.GetMealPlanGroceryListItem:input_type -> mealplanning.GetMealPlanGroceryListItemRequest
+	87,  // 87: mealplanning.MealPlanningService.GetMealPlanGroceryListItemsForMealPlan:input_type -> mealplanning.GetMealPlanGroceryListItemsForMealPlanRequest
+	88,  // 88: mealplanning.MealPlanningService.GetMealPlanOption:input_type -> mealplanning.GetMealPlanOptionRequest
+	89,  // 89: mealplanning.MealPlanningService.GetMealPlanOptionVote:input_type -> mealplanning.GetMealPlanOptionVoteRequest
+	90,  // 90: mealplanning.MealPlanningService.GetMealPlanOptionVotes:input_type -> mealplanning.GetMealPlanOptionVotesRequest
+	91,  // 91: mealplanning.MealPlanningService.GetMealPlanOptions:input_type -> mealplanning.GetMealPlanOptionsRequest
+	92,  // 92: mealplanning.MealPlanningService.GetMealPlanRecipeOptionSelection:input_type -> mealplanning.GetMealPlanRecipeOptionSelectionRequest
+	93,  // 93: mealplanning.MealPlanningService.GetMealPlanRecipeOptionSelectionsForMealPlanOption:input_type -> mealplanning.GetMealPlanRecipeOptionSelectionsForMealPlanOptionRequest
+	94,  // 94: mealplanning.MealPlanningService.GetMealPlanTask:input_type -> mealplanning.GetMealPlanTaskRequest
+	95,  // 95: mealplanning.MealPlanningService.GetMealPlanTasks:input_type -> mealplanning.GetMealPlanTasksRequest
+	96,  // 96: mealplanning.MealPlanningService.GetMealPlanTemplate:input_type -> mealplanning.GetMealPlanTemplateRequest
+	97,  // 97: mealplanning.MealPlanningService.GetMealPlanTemplates:input_type -> mealplanning.GetMealPlanTemplatesRequest
+	98,  // 98: mealplanning.MealPlanningService.GetMealPlansForAccount:input_type -> mealplanning.GetMealPlansForAccountRequest
+	99,  // 99: mealplanning.MealPlanningService.GetMealLists:input_type -> mealplanning.GetMealListsRequest
+	100, // 100: mealplanning.MealPlanningService.GetMeals:input_type -> mealplanning.GetMealsRequest
+	101, // 101: mealplanning.MealPlanningService.GetCookTimelineForMealPlanOption:input_type -> mealplanning.GetCookTimelineForMealPlanOptionRequest
+	102, // 102: mealplanning.MealPlanningService.GetNutritionForMeal:input_type -> mealplanning.GetNutritionForMealRequest
+	103, // 103: mealplanning.MealPlanningService.GetNutritionForMealPlan:input_type -> mealplanning.GetNutritionForMealPlanRequest
+	104, // 104: mealplanning.MealPlanningService.GetNutritionForRecipe:input_type -> mealplanning.GetNutritionForRecipeRequest
+	105, // 105: mealplanning.MealPlanningService.GetMermaidDiagramForMeal:input_type -> mealplanning.GetMermaidDiagramForMealRequest
+	106, // 106: mealplanning.MealPlanningService.GetMermaidDiagramForRecipe:input_type -> mealplanning.GetMermaidDiagramForRecipeRequest
+	107, // 107: mealplanning.MealPlanningService.GetRandomValidIngredient:input_type -> mealplanning.GetRandomValidIngredientRequest
+	108, // 108: mealplanning.MealPlanningService.GetRandomValidInstrument:input_type -> mealplanning.GetRandomValidInstrumentRequest
+	109, // 109: mealplanning.MealPlanningService.GetRandomValidPreparation:input_type -> mealplanning.GetRandomValidPreparationRequest
+	110, // 110: mealplanning.MealPlanningService.GetRandomValidVessel:input_type -> mealplanning.GetRandomValidVesselRequest
+	111, // 111: mealplanning.MealPlanningService.GetRecipe:input_type -> mealplanning.GetRecipeRequest
+	112, // 112: mealplanning.MealPlanningService.EstimateRecipePrepTasks:input_type -> mealplanning.EstimateRecipePrepTasksRequest
+	113, // 113: mealplanning.MealPlanningService.GetRecipePrepTask:input_type -> mealplanning.GetRecipePrepTaskRequest
+	114, // 114: mealplanning.MealPlanningService.GetRecipePrepTasks:input_type -> mealplanning.GetRecipePrepTasksRequest
+	115, // 115: mealplanning.MealPlanningService.GetRecipeRating:input_type -> mealplanning.GetRecipeRatingRequest
+	116, // 116: mealplanning.MealPlanningService.GetRecipeRatingsForRecipe:input_type -> mealplanning.GetRecipeRatingsForRecipeRequest
+	117, // 117: mealplanning.MealPlanningService.GetRecipeRevision:input_type -> mealplanning.GetRecipeRevisionRequest
+	118, // 118: mealplanning.MealPlanningService.GetRecipeRevisions:input_type -> mealplanning.GetRecipeRevisionsRequest
+	119, // 119: mealplanning.MealPlanningService.GetRecipeStep:input_type -> mealplanning.GetRecipeStepRequest
+	120, // 120: mealplanning.MealPlanningService.GetRecipeStepCompletionCondition:input_type -> mealplanning.GetRecipeStepCompletionConditionRequest
+	121, // 121: mealplanning.MealPlanningService.GetRecipeStepCompletionConditions:input_type -> mealplanning.GetRecipeStepCompletionConditionsRequest
+	122, // 122: mealplanning.MealPlanningService.GetRecipeStepIngredient:input_type -> mealplanning.GetRecipeStepIngredientRequest
+	123, // 123: mealplanning.MealPlanningService.GetRecipeStepIngredients:input_type -> mealplanning.GetRecipeStepIngredientsRequest
+	124, // 124: mealplanning.MealPlanningService.GetRecipeStepInstrument:input_type -> mealplanning.GetRecipeStepInstrumentRequest
+	125, // 125: mealplanning.MealPlanningService.GetRecipeStepInstruments:input_type -> mealplanning.GetRecipeStepInstrumentsRequest
+	126, // 126: mealplanning.MealPlanningService.GetRecipeStepProduct:input_type -> mealplanning.GetRecipeStepProductRequest
+	127, // 127: mealplanning.MealPlanningService.GetRecipeStepProducts:input_type -> mealplanning.GetRecipeStepProductsRequest
+	128, // 128: mealplanning.MealPlanningService.GetRecipeStepVessel:input_type -> mealplanning.GetRecipeStepVesselRequest
+	129, // 129: mealplanning.MealPlanningService.GetRecipeStepVessels:input_type -> mealplanning.GetRecipeStepVesselsRequest
+	130, // 130: mealplanning.MealPlanningService.GetRecipeSteps:input_type -> mealplanning.GetRecipeStepsRequest
+	131, // 131: mealplanning.MealPlanningService.GetRecipeLists:input_type -> mealplanning.GetRecipeListsRequest
+	132, // 132: mealplanning.MealPlanningService.GetRecipes:input_type -> mealplanning.GetRecipesRequest
+	133, // 133: mealplanning.MealPlanningService.GetValidIngredient:input_type -> mealplanning.GetValidIngredientRequest
+	134, // 134: mealplanning.MealPlanningService.GetValidIngredientGroup:input_type -> mealplanning.GetValidIngredientGroupRequest
+	135, // 135: mealplanning.MealPlanningService.GetValidIngredientGroups:input_type -> mealplanning.GetValidIngredientGroupsRequest
+	136, // 136: mealplanning.MealPlanningService.GetValidIngredientMeasurementUnit:input_type -> mealplanning.GetValidIngredientMeasurementUnitRequest
+	137, // 137: mealplanning.MealPlanningService.GetValidIngredientMeasurementUnits:input_type -> mealplanning.GetValidIngredientMeasurementUnitsRequest
+	138, // 138: mealplanning.MealPlanningService.GetValidIngredientMeasurementUnitsByIngredient:input_type -> mealplanning.GetValidIngredientMeasurementUnitsByIngredientRequest
+	139, // 139: mealplanning.MealPlanningService.GetValidIngredientMeasurementUnitsByMeasurementUnit:input_type -> mealplanning.GetValidIngredientMeasurementUnitsByMeasurementUnitRequest
+	140, // 140: mealplanning.MealPlanningService.GetValidIngredientNutritionFacts:input_type -> mealplanning.GetValidIngredientNutritionFactsRequest
+	141, // 141: mealplanning.MealPlanningService.GetValidIngredientPreparation:input_type -> mealplanning.GetValidIngredientPreparationRequest
+	142, // 142: mealplanning.MealPlanningService.GetValidIngredientPreparations:input_type -> mealplanning.GetValidIngredientPreparationsRequest
+	143, // 143: mealplanning.MealPlanningService.GetValidIngredientPreparationsByIngredient:input_type -> mealplanning.GetValidIngredientPreparationsByIngredientRequest
+	144, // 144: mealplanning.MealPlanningService.GetValidIngredientPreparationsByPreparation:input_type -> mealplanning.GetValidIngredientPreparationsByPreparationRequest
+	145, // 145: mealplanning.MealPlanningService.GetValidPrepTaskConfig:input_type -> mealplanning.GetValidPrepTaskConfigRequest
+	146, // 146: mealplanning.MealPlanningService.GetValidPrepTaskConfigs:input_type -> mealplanning.GetValidPrepTaskConfigsRequest
+	147, // 147: mealplanning.MealPlanningService.GetValidPrepTaskConfigsByIngredient:input_type -> mealplanning.GetValidPrepTaskConfigsByIngredientRequest
+	148, // 148: mealplanning.MealPlanningService.GetValidPrepTaskConfigsByPreparation:input_type -> mealplanning.GetValidPrepTaskConfigsByPreparationRequest
+	149, // 149: mealplanning.MealPlanningService.GetValidPrepTaskConfigsByIngredientAndPreparation:input_type -> mealplanning.GetValidPrepTaskConfigsByIngredientAndPreparationRequest
+	150, // 150: mealplanning.MealPlanningService.GetValidIngredientState:input_type -> mealplanning.GetValidIngredientStateRequest
+	151, // 151: mealplanning.MealPlanningService.GetValidIngredientStateIngredient:input_type -> mealplanning.GetValidIngredientStateIngredientRequest
+	152, // 152: mealplanning.MealPlanningService.GetValidIngredientStateIngredients:input_type -> mealplanning.GetValidIngredientStateIngredientsRequest
+	153, // 153: mealplanning.MealPlanningService.GetValidIngredientStateIngredientsByIngredient:input_type -> mealplanning.GetValidIngredientStateIngredientsByIngredientRequest
+	154, // 154: mealplanning.MealPlanningService.GetValidIngredientStateIngredientsByIngredientState:input_type -> mealplanning.GetValidIngredientStateIngredientsByIngredientStateRequest
+	155, // 155: mealplanning.MealPlanningService.GetValidIngredientStates:input_type -> mealplanning.GetValidIngredientStatesRequest
+	156, // 156: mealplanning.MealPlanningService.GetValidIngredients:input_type -> mealplanning.GetValidIngredientsRequest
+	157, // 157: mealplanning.MealPlanningService.GetValidInstrument:input_type -> mealplanning.GetValidInstrumentRequest
+	158, // 158: mealplanning.MealPlanningService.GetValidInstruments:input_type -> mealplanning.GetValidInstrumentsRequest
+	159, // 159: mealplanning.MealPlanningService.GetValidMeasurementUnit:input_type -> mealplanning.GetValidMeasurementUnitRequest
+	160, // 160: mealplanning.MealPlanningService.GetValidMeasurementUnitConversion:input_type -> mealplanning.GetValidMeasurementUnitConversionRequest
+	161, // 161: mealplanning.MealPlanningService.GetValidMeasurementUnitConversionsForUnit:input_type -> mealplanning.GetValidMeasurementUnitConversionsForUnitRequest
+	162, // 162: mealplanning.MealPlanningService.GetValidMeasurementUnitConversionsForIngredients:input_type -> mealplanning.GetValidMeasurementUnitConversionsForIngredientsRequest
+	163, // 163: mealplanning.MealPlanningService.GetMeasurementUnitConversionMismatches:input_type -> mealplanning.GetMeasurementUnitConversionMismatchesRequest
+	164, // 164: mealplanning.MealPlanningService.GetValidMeasurementUnits:input_type -> mealplanning.GetValidMeasurementUnitsRequest
+	165, // 165: mealplanning.MealPlanningService.GetValidPreparation:input_type -> mealplanning.GetValidPreparationRequest
+	166, // 166: mealplanning.MealPlanningService.GetValidPreparationInstrument:input_type -> mealplanning.GetValidPreparationInstrumentRequest
+	167, // 167: mealplanning.MealPlanningService.GetValidPreparationInstruments:input_type -> mealplanning.GetValidPreparationInstrumentsRequest
+	168, // 168: mealplanning.MealPlanningService.GetValidPreparationInstrumentsByInstrument:input_type -> mealplanning.GetValidPreparationInstrumentsByInstrumentRequest
+	169, // 169: mealplanning.MealPlanningService.GetValidPreparationInstrumentsByPreparation:input_type -> mealplanning.GetValidPreparationInstrumentsByPreparationRequest
+	170, // 170: mealplanning.MealPlanningService.GetValidPreparationVessel:input_type -> mealplanning.GetValidPreparationVesselRequest
+	171, // 171: mealplanning.MealPlanningService.GetValidPreparationVessels:input_type -> mealplanning.GetValidPreparationVesselsRequest
+	172, // 172: mealplanning.MealPlanningService.GetValidPreparationVesselsByPreparation:input_type -> mealplanning.GetValidPreparationVesselsByPreparationRequest
+	173, // 173: mealplanning.MealPlanningService.GetValidPreparationVesselsByVessel:input_type -> mealplanning.GetValidPreparationVesselsByVesselRequest
+	174, // 174: mealplanning.MealPlanningService.GetValidPreparations:input_type -> mealplanning.GetValidPreparationsRequest
+	175, // 175: mealplanning.MealPlanningService.GetValidVessel:input_type -> mealplanning.GetValidVesselRequest
+	176, // 176: mealplanning.MealPlanningService.GetValidVessels:input_type -> mealplanning.GetValidVesselsRequest
+	177, // 177: mealplanning.MealPlanningService.RestoreRecipeRevision:input_type -> mealplanning.RestoreRecipeRevisionRequest
+	178, // 178: mealplanning.MealPlanningService.RunFinalizeMealPlanWorker:input_type -> mealplanning.RunFinalizeMealPlanWorkerRequest
+	179, // 179: mealplanning.MealPlanningService.RunMealPlanGroceryListInitializerWorker:input_type -> mealplanning.RunMealPlanGroceryListInitializerWorkerRequest
+	180, // 180: mealplanning.MealPlanningService.RunMealPlanTaskCreatorWorker:input_type -> mealplanning.RunMealPlanTaskCreatorWorkerRequest
+	181, // 181: mealplanning.MealPlanningService.SearchForMeals:input_type -> mealplanning.SearchForMealsRequest
+	182, // 182: mealplanning.MealPlanningService.SearchForRecipes:input_type -> mealplanning.SearchForRecipesRequest
+	183, // 183: mealplanning.MealPlanningService.SearchForMealEligibleRecipes:input_type -> mealplanning.SearchForMealEligibleRecipesRequest
+	184, // 184: mealplanning.MealPlanningService.SearchForRecipesWithInstrumentOwnership:input_type -> mealplanning.SearchForRecipesWithInstrumentOwnershipRequest
+	185, // 185: mealplanning.MealPlanningService.SearchForValidIngredientGroups:input_type -> mealplanning.SearchForValidIngredientGroupsRequest
+	186, // 186: mealplanning.MealPlanningService.SearchForValidIngredientStates:input_type -> mealplanning.SearchForValidIngredientStatesRequest
+	187, // 187: mealplanning.MealPlanningService.SearchForValidIngredients:input_type -> mealplanning.SearchForValidIngredientsRequest
+	188, // 188: mealplanning.MealPlanningService.SearchForValidInstruments:input_type -> mealplanning.SearchForValidInstrumentsRequest
+	189, // 189: mealplanning.MealPlanningService.SearchForValidMeasurementUnits:input_type -> mealplanning.SearchForValidMeasurementUnitsRequest
+	190, // 190: mealplanning.MealPlanningService.SearchForValidPreparations:input_type -> mealplanning.SearchForValidPreparationsRequest
+	191, // 191: mealplanning.MealPlanningService.SearchForValidVessels:input_type -> mealplanning.SearchForValidVesselsRequest
+	192, // 192: mealplanning.MealPlanningService.SearchValidIngredientsByPreparation:input_type -> mealplanning.SearchValidIngredientsByPreparationRequest
+	193, // 193: mealplanning.MealPlanningService.SearchValidMeasurementUnitsByIngredient:input_type -> mealplanning.SearchValidMeasurementUnitsByIngredientRequest
+	194, // 194: mealplanning.MealPlanningService.UpdateMealPlan:input_type -> mealplanning.UpdateMealPlanRequest
+	195, // 195: mealplanning.MealPlanningService.UpdateMealPlanEvent:input_type -> mealplanning.UpdateMealPlanEventRequest
+	196, // 196: mealplanning.MealPlanningService.SwapMealPlanEvents:input_type -> mealplanning.SwapMealPlanEventsRequest
+	197, // 197: mealplanning.MealPlanningService.UpdateMealPlanGroceryListItem:input_type -> mealplanning.UpdateMealPlanGroceryListItemRequest
+	198, // 198: mealplanning.MealPlanningService.UpdateMealPlanOption:input_type -> mealplanning.UpdateMealPlanOptionRequest
+	199, // 199: mealplanning.MealPlanningService.UpdateMealPlanOptionVote:input_type -> mealplanning.UpdateMealPlanOptionVoteRequest
+	200, // 200: mealplanning.MealPlanningService.UpdateMealPlanRecipeOptionSelection:input_type -> mealplanning.UpdateMealPlanRecipeOptionSelectionRequest
+	201, // 201: mealplanning.MealPlanningService.UpdateMealPlanTaskStatus:input_type -> mealplanning.UpdateMealPlanTaskStatusRequest
+	202, // 202: mealplanning.MealPlanningService.UpdateMealPlanTemplate:input_type -> mealplanning.UpdateMealPlanTemplateRequest
+	203, // 203: mealplanning.MealPlanningService.UpdateMealList:input_type -> mealplanning.UpdateMealListRequest
+	204, // 204: mealplanning.MealPlanningService.UpdateMealListItem:input_type -> mealplanning.UpdateMealListItemRequest
+	205, // 205: mealplanning.MealPlanningService.UpdateRecipe:input_type -> mealplanning.UpdateRecipeRequest
+	206, // 206: mealplanning.MealPlanningService.UpdateRecipeStatus:input_type -> mealplanning.UpdateRecipeStatusRequest
+	207, // 207: mealplanning.MealPlanningService.UpdateRecipePrepTask:input_type -> mealplanning.UpdateRecipePrepTaskRequest
+	208, // 208: mealplanning.MealPlanningService.UpdateRecipeRating:input_type -> mealplanning.UpdateRecipeRatingRequest
+	209, // 209: mealplanning.MealPlanningService.UpdateRecipeList:input_type -> mealplanning.UpdateRecipeListRequest
+	210, // 210: mealplanning.MealPlanningService.UpdateRecipeListItem:input_type -> mealplanning.UpdateRecipeListItemRequest
+	211, // 211: mealplanning.MealPlanningService.UpdateRecipeStep:input_type -> mealplanning.UpdateRecipeStepRequest
+	212, // 212: mealplanning.MealPlanningService.UpdateRecipeStepCompletionCondition:input_type -> mealplanning.UpdateRecipeStepCompletionConditionRequest
+	213, // 213: mealplanning.MealPlanningService.UpdateRecipeStepIngredient:input_type -> mealplanning.UpdateRecipeStepIngredientRequest
+	214, // 214: mealplanning.MealPlanningService.UpdateRecipeStepInstrument:input_type -> mealplanning.UpdateRecipeStepInstrumentRequest
+	215, // 215: mealplanning.MealPlanningService.UpdateRecipeStepProduct:input_type -> mealplanning.UpdateRecipeStepProductRequest
+	216, // 216: mealplanning.MealPlanningService.UpdateRecipeStepVessel:input_type -> mealplanning.UpdateRecipeStepVesselRequest
+	217, // 217: mealplanning.MealPlanningService.UpdateValidIngredient:input_type -> mealplanning.UpdateValidIngredientRequest
+	218, // 218: mealplanning.MealPlanningService.UpdateValidIngredientGroup:input_type -> mealplanning.UpdateValidIngredientGroupRequest
+	219, // 219: mealplanning.MealPlanningService.UpdateValidIngredientMeasurementUnit:input_type -> mealplanning.UpdateValidIngredientMeasurementUnitRequest
+	220, // 220: mealplanning.MealPlanningService.UpdateValidIngredientNutritionFacts:input_type -> mealplanning.UpdateValidIngredientNutritionFactsRequest
+	221, // 221: mealplanning.MealPlanningService.UpdateValidIngredientPreparation:input_type -> mealplanning.UpdateValidIngredientPreparationRequest
+	222, // 222: mealplanning.MealPlanningService.UpdateValidPrepTaskConfig:input_type -> mealplanning.UpdateValidPrepTaskConfigRequest
+	223, // 223: mealplanning.MealPlanningService.UpdateValidIngredientState:input_type -> mealplanning.UpdateValidIngredientStateRequest
+	224, // 224: mealplanning.MealPlanningService.UpdateValidIngredientStateIngredient:input_type -> mealplanning.UpdateValidIngredientStateIngredientRequest
+	225, // 225: mealplanning.MealPlanningService.UpdateValidInstrument:input_type -> mealplanning.UpdateValidInstrumentRequest
+	226, // 226: mealplanning.MealPlanningService.UpdateValidMeasurementUnit:input_type -> mealplanning.UpdateValidMeasurementUnitRequest
+	227, // 227: mealplanning.MealPlanningService.UpdateValidMeasurementUnitConversion:input_type -> mealplanning.UpdateValidMeasurementUnitConversionRequest
+	228, // 228: mealplanning.MealPlanningService.UpdateValidPreparation:input_type -> mealplanning.UpdateValidPreparationRequest
+	229, // 229: mealplanning.MealPlanningService.UpdateValidPreparationInstrument:input_type -> mealplanning.UpdateValidPreparationInstrumentRequest
+	230, // 230: mealplanning.MealPlanningService.UpdateValidPreparationVessel:input_type -> mealplanning.UpdateValidPreparationVesselRequest
+	231, // 231: mealplanning.MealPlanningService.UpdateValidVessel:input_type -> mealplanning.UpdateValidVesselRequest
+	232, // 232: mealplanning.MealPlanningService.ArchiveAccountInstrumentOwnership:input_type -> mealplanning.ArchiveAccountInstrumentOwnershipRequest
+	233, // 233: mealplanning.MealPlanningService.ArchiveUserIngredientPreference:input_type -> mealplanning.ArchiveUserIngredientPreferenceRequest
+	234, // 234: mealplanning.MealPlanningService.CreateAccountInstrumentOwnership:input_type -> mealplanning.CreateAccountInstrumentOwnershipRequest
+	235, // 235: mealplanning.MealPlanningService.CreateUserIngredientPreference:input_type -> mealplanning.CreateUserIngredientPreferenceRequest
+	236, // 236: mealplanning.MealPlanningService.GetAccountInstrumentOwnership:input_type -> mealplanning.GetAccountInstrumentOwnershipRequest
+	237, // 237: mealplanning.MealPlanningService.GetAccountInstrumentOwnerships:input_type -> mealplanning.GetAccountInstrumentOwnershipsRequest
+	238, // 238: mealplanning.MealPlanningService.SearchForValidInstrumentsNotOwnedByAccount:input_type -> mealplanning.SearchForValidInstrumentsNotOwnedByAccountRequest
+	239, // 239: mealplanning.MealPlanningService.GetUserIngredientPreference:input_type -> mealplanning.GetUserIngredientPreferenceRequest
+	240, // 240: mealplanning.MealPlanningService.GetUserIngredientPreferences:input_type -> mealplanning.GetUserIngredientPreferencesRequest
+	241, // 241: mealplanning.MealPlanningService.UpdateAccountInstrumentOwnership:input_type -> mealplanning.UpdateAccountInstrumentOwnershipRequest
+	242, // 242: mealplanning.MealPlanningService.ArchivePantryItem:input_type -> mealplanning.ArchivePantryItemRequest
+	243, // 243: mealplanning.MealPlanningService.CreatePantryItem:input_type -> mealplanning.CreatePantryItemRequest
+	244, // 244: mealplanning.MealPlanningService.GetPantryItem:input_type -> mealplanning.GetPantryItemRequest
+	245, // 245: mealplanning.MealPlanningService.GetPantryItems:input_type -> mealplanning.GetPantryItemsRequest
+	246, // 246: mealplanning.MealPlanningService.UpdatePantryItem:input_type -> mealplanning.UpdatePantryItemRequest
+	247, // 247: mealplanning.MealPlanningService.UpdateUserIngredientPreference:input_type -> mealplanning.UpdateUserIngredientPreferenceRequest
+	248, // 248: mealplanning.MealPlanningService.UploadMealImage:input_type -> mealplanning.UploadMealMediaRequest
+	249, // 249: mealplanning.MealPlanningService.UploadRecipeImage:input_type -> mealplanning.UploadRecipeMediaRequest
+	250, // 250: mealplanning.MealPlanningService.UploadPreparationMedia:input_type -> mealplanning.UploadPreparationMediaRequest
+	251, // 251: mealplanning.MealPlanningService.UploadIngredientMedia:input_type -> mealplanning.UploadIngredientMediaRequest
+	252, // 252: mealplanning.MealPlanningService.UploadRecipeStepImage:input_type -> mealplanning.UploadRecipeStepImageRequest
+	253, // 253: mealplanning.MealPlanningService.ExportMealPlanCalendar:input_type -> mealplanning.ExportMealPlanCalendarRequest
+	254, // 254: mealplanning.MealPlanningService.WatchMealPlan:input_type -> mealplanning.WatchMealPlanRequest
+	255, // 255: mealplanning.MealPlanningService.WatchMealPlanTasks:input_type -> mealplanning.WatchMealPlanTasksRequest
+	256, // 256: mealplanning.MealPlanningService.AddCommentToMeal:output_type -> mealplanning.AddCommentToMealResponse
+	257, // 257: mealplanning.MealPlanningService.AddCommentToMealPlan:output_type -> mealplanning.AddCommentToMealPlanResponse
+	258, // 258: mealplanning.MealPlanningService.AddCommentToRecipe:output_type -> mealplanning.AddCommentToRecipeResponse
+	259, // 259: mealplanning.MealPlanningService.ArchiveMeal:output_type -> mealplanning.ArchiveMealResponse
+	260, // 260: mealplanning.MealPlanningService.ArchiveMealPlan:output_type -> mealplanning.ArchiveMealPlanResponse
+	261, // 261: mealplanning.MealPlanningService.ArchiveMealPlanEvent:output_type -> mealplanning.ArchiveMealPlanEventResponse
+	262, // 262: mealplanning.MealPlanningService.ArchiveMealPlanGroceryListItem:output_type -> mealplanning.ArchiveMealPlanGroceryListItemResponse
+	263, // 263: mealplanning.MealPlanningService.ArchiveMealPlanOption:output_type -> mealplanning.ArchiveMealPlanOptionResponse
+	264, // 264: mealplanning.MealPlanningService.ArchiveMealPlanOptionVote:output_type -> mealplanning.ArchiveMealPlanOptionVoteResponse
+	265, // 265: mealplanning.MealPlanningService.ArchiveMealPlanRecipeOptionSelection:output_type -> mealplanning.ArchiveMealPlanRecipeOptionSelectionResponse
+	266, // 266: mealplanning.MealPlanningService.ArchiveMealPlanTemplate:output_type -> mealplanning.ArchiveMealPlanTemplateResponse
+	267, // 267: mealplanning.MealPlanningService.ArchiveMealList:output_type -> mealplanning.ArchiveMealListResponse
+	268, // 268: mealplanning.MealPlanningService.ArchiveMealListItem:output_type -> mealplanning.ArchiveMealListItemResponse
+	269, // 269: mealplanning.MealPlanningService.ArchiveRecipe:output_type -> mealplanning.ArchiveRecipeResponse
+	270, // 270: mealplanning.MealPlanningService.ArchiveRecipePrepTask:output_type -> mealplanning.ArchiveRecipePrepTaskResponse
+	271, // 271: mealplanning.MealPlanningService.ArchiveRecipeRating:output_type -> mealplanning.ArchiveRecipeRatingResponse
+	272, // 272: mealplanning.MealPlanningService.ArchiveRecipeList:output_type -> mealplanning.ArchiveRecipeListResponse
+	273, // 273: mealplanning.MealPlanningService.ArchiveRecipeListItem:output_type -> mealplanning.ArchiveRecipeListItemResponse
+	274, // 274: mealplanning.MealPlanningService.ArchiveRecipeStep:output_type -> mealplanning.ArchiveRecipeStepResponse
+	275, // 275: mealplanning.MealPlanningService.ArchiveRecipeStepCompletionCondition:output_type -> mealplanning.ArchiveRecipeStepCompletionConditionResponse
+	276, // 276: mealplanning.MealPlanningService.ArchiveRecipeStepIngredient:output_type -> mealplanning.ArchiveRecipeStepIngredientResponse
+	277, // 277: mealplanning.MealPlanningService.ArchiveRecipeStepInstrument:output_type -> mealplanning.ArchiveRecipeStepInstrumentResponse
+	278, // 278: mealplanning.MealPlanningService.ArchiveRecipeStepProduct:output_type -> mealplanning.ArchiveRecipeStepProductResponse
+	279, // 279: mealplanning.MealPlanningService.ArchiveRecipeStepVessel:output_type -> mealplanning.ArchiveRecipeStepVesselResponse
+	280, // 280: mealplanning.MealPlanningService.ArchiveValidIngredient:output_type -> mealplanning.ArchiveValidIngredientResponse
+	281, // 281: mealplanning.MealPlanningService.ArchiveValidIngredientGroup:output_type -> mealplanning.ArchiveValidIngredientGroupResponse
+	282, // 282: mealplanning.MealPlanningService.ArchiveValidIngredientMeasurementUnit:output_type -> mealplanning.ArchiveValidIngredientMeasurementUnitResponse
+	283, // 283: mealplanning.MealPlanningService.ArchiveValidIngredientNutritionFacts:output_type -> mealplanning.ArchiveValidIngredientNutritionFactsResponse
+	284, // 284: mealplanning.MealPlanningService.ArchiveValidIngredientPreparation:output_type -> mealplanning.ArchiveValidIngredientPreparationResponse
+	285, // 285: mealplanning.MealPlanningService.ArchiveValidPrepTaskConfig:output_type -> mealplanning.ArchiveValidPrepTaskConfigResponse
+	286, // 286: mealplanning.MealPlanningService.ArchiveValidIngredientState:output_type -> mealplanning.ArchiveValidIngredientStateResponse
+	287, // 287: mealplanning.MealPlanningService.ArchiveValidIngredientStateIngredient:output_type -> mealplanning.ArchiveValidIngredientStateIngredientResponse
+	288, // 288: mealplanning.MealPlanningService.ArchiveValidInstrument:output_type -> mealplanning.ArchiveValidInstrumentResponse
+	289, // 289: mealplanning.MealPlanningService.ArchiveValidMeasurementUnit:output_type -> mealplanning.ArchiveValidMeasurementUnitResponse
+	290, // 290: mealplanning.MealPlanningService.ArchiveValidMeasurementUnitConversion:output_type -> mealplanning.ArchiveValidMeasurementUnitConversionResponse
+	291, // 291: mealplanning.MealPlanningService.ArchiveValidPreparation:output_type -> mealplanning.ArchiveValidPreparationResponse
+	292, // 292: mealplanning.MealPlanningService.ArchiveValidPreparationInstrument:output_type -> mealplanning.ArchiveValidPreparationInstrumentResponse
+	293, // 293: mealplanning.MealPlanningService.ArchiveValidPreparationVessel:output_type -> mealplanning.ArchiveValidPreparationVesselResponse
+	294, // 294: mealplanning.MealPlanningService.ArchiveValidVessel:output_type -> mealplanning.ArchiveValidVesselResponse
+	295, // 295: mealplanning.MealPlanningService.CloneRecipe:output_type -> mealplanning.CloneRecipeResponse
+	296, // 296: mealplanning.MealPlanningService.ImportRecipe:output_type -> mealplanning.ImportRecipeResponse
+	297, // 297: mealplanning.MealPlanningService.CreateMeal:output_type -> mealplanning.CreateMealResponse
+	298, // 298: mealplanning.MealPlanningService.CreateMealPlan:output_type -> mealplanning.CreateMealPlanResponse
+	299, // 299: mealplanning.MealPlanningService.CreateMealPlanCalendarFeed:output_type -> mealplanning.CreateMealPlanCalendarFeedResponse
+	300, // 300: mealplanning.MealPlanningService.CreateMealPlanEvent:output_type -> mealplanning.CreateMealPlanEventResponse
+	301, // 301: mealplanning.MealPlanningService.CreateMealPlanOption:output_type -> mealplanning.CreateMealPlanOptionResponse
+	302, // 302: mealplanning.MealPlanningService.CreateMealPlanOptionVote:output_type -> mealplanning.CreateMealPlanOptionVoteResponse
+	303, // 303: mealplanning.MealPlanningService.CreateMealPlanRecipeOptionSelection:output_type -> mealplanning.CreateMealPlanRecipeOptionSelectionResponse
+	304, // 304: mealplanning.MealPlanningService.CreateMealPlanTask:output_type -> mealplanning.CreateMealPlanTaskResponse
+	305, // 305: mealplanning.MealPlanningService.CreateMealPlanTemplate:output_type -> mealplanning.CreateMealPlanTemplateResponse
+	306, // 306: mealplanning.MealPlanningService.CreateMealList:output_type -> mealplanning.CreateMealListResponse
+	307, // 307: mealplanning.MealPlanningService.CreateMealListItem:output_type -> mealplanning.CreateMealListItemResponse
+	308, // 308: mealplanning.MealPlanningService.CreateRecipe:output_type -> mealplanning.CreateRecipeResponse
+	309, // 309: mealplanning.MealPlanningService.CreateRecipePrepTask:output_type -> mealplanning.CreateRecipePrepTaskResponse
+	310, // 310: mealplanning.MealPlanningService.CreateRecipeRating:output_type -> mealplanning.CreateRecipeRatingResponse
+	311, // 311: mealplanning.MealPlanningService.CreateRecipeList:output_type -> mealplanning.CreateRecipeListResponse
+	312, // 312: mealplanning.MealPlanningService.CreateRecipeListItem:output_type -> mealplanning.CreateRecipeListItemResponse
+	313, // 313: mealplanning.MealPlanningService.CreateRecipeStep:output_type -> mealplanning.CreateRecipeStepResponse
+	314, // 314: mealplanning.MealPlanningService.CreateRecipeStepCompletionCondition:output_type -> mealplanning.CreateRecipeStepCompletionConditionResponse
+	315, // 315: mealplanning.MealPlanningService.CreateRecipeStepIngredient:output_type -> mealplanning.CreateRecipeStepIngredientResponse
+	316, // 316: mealplanning.MealPlanningService.CreateRecipeStepInstrument:output_type -> mealplanning.CreateRecipeStepInstrumentResponse
+	317, // 317: mealplanning.MealPlanningService.CreateRecipeStepProduct:output_type -> mealplanning.CreateRecipeStepProductResponse
+	318, // 318: mealplanning.MealPlanningService.CreateRecipeStepVessel:output_type -> mealplanning.CreateRecipeStepVesselResponse
+	319, // 319: mealplanning.MealPlanningService.CreateValidIngredient:output_type -> mealplanning.CreateValidIngredientResponse
+	320, // 320: mealplanning.MealPlanningService.CreateValidIngredientGroup:output_type -> mealplanning.CreateValidIngredientGroupResponse
+	321, // 321: mealplanning.MealPlanningService.CreateValidIngredientMeasurementUnit:output_type -> mealplanning.CreateValidIngredientMeasurementUnitResponse
+	322, // 322: mealplanning.MealPlanningService.CreateValidIngredientNutritionFacts:output_type -> mealplanning.CreateValidIngredientNutritionFactsResponse
+	323, // 323: mealplanning.MealPlanningService.CreateValidIngredientPreparation:output_type -> mealplanning.CreateValidIngredientPreparationResponse
+	324, // 324: mealplanning.MealPlanningService.CreateValidPrepTaskConfig:output_type -> mealplanning.CreateValidPrepTaskConfigResponse
+	325, // 325: mealplanning.MealPlanningService.CreateValidIngredientState:output_type -> mealplanning.CreateValidIngredientStateResponse
+	326, // 326: mealplanning.MealPlanningService.CreateValidIngredientStateIngredient:output_type -> mealplanning.CreateValidIngredientStateIngredientResponse
+	327, // 327: mealplanning.MealPlanningService.CreateValidInstrument:output_type -> mealplanning.CreateValidInstrumentResponse
+	328, // 328: mealplanning.MealPlanningService.CreateValidMeasurementUnit:output_type -> mealplanning.CreateValidMeasurementUnitResponse
+	329, // 329: mealplanning.MealPlanningService.CreateValidMeasurementUnitConversion:output_type -> mealplanning.CreateValidMeasurementUnitConversionResponse
+	330, // 330: mealplanning.MealPlanningService.CreateValidPreparation:output_type -> mealplanning.CreateValidPreparationResponse
+	331, // 331: mealplanning.MealPlanningService.CreateValidPreparationInstrument:output_type -> mealplanning.CreateValidPreparationInstrumentResponse
+	332, // 332: mealplanning.MealPlanningService.CreateValidPreparationVessel:output_type -> mealplanning.CreateValidPreparationVesselResponse
+	333, // 333: mealplanning.MealPlanningService.CreateValidVessel:output_type -> mealplanning.CreateValidVesselResponse
+	334, // 334: mealplanning.MealPlanningService.CompleteMealPlanOption:output_type -> mealplanning.CompleteMealPlanOptionResponse
+	335, // 335: mealplanning.MealPlanningService.DiffRecipeRevisions:output_type -> mealplanning.DiffRecipeRevisionsResponse
+	336, // 336: mealplanning.MealPlanningService.FinalizeMealPlan:output_type -> mealplanning.FinalizeMealPlanResponse
+	337, // 337: mealplanning.MealPlanningService.GetMeal:output_type -> mealplanning.GetMealResponse
+	338, // 338: mealplanning.MealPlanningService.GetMealPlan:output_type -> mealplanning.GetMealPlanResponse
+	339, // 339: mealplanning.MealPlanningService.GetMealPlanEvent:output_type -> mealplanning.GetMealPlanEventResponse
+	340, // 340: mealplanning.MealPlanningService.GetMealPlanEventTallyReport:output_type -> mealplanning.GetMealPlanEventTallyReportResponse
+	341, // 341: mealplanning.MealPlanningService.GetMealPlanEvents:output_type -> mealplanning.GetMealPlanEventsResponse
+	342, // 342: mealplanning.MealPlanningService.GetMealPlanGroceryListItem:output_type -> mealplanning.GetMealPlanGroceryListItemResponse
+	343, // 343: mealplanning.MealPlanningService.GetMealPlanGroceryListItemsForMealPlan:output_type -> mealplanning.GetMealPlanGroceryListItemsForMealPlanResponse
+	344, // 344: mealplanning.MealPlanningService.GetMealPlanOption:output_type -> mealplanning.GetMealPlanOptionResponse
+	345, // 345: mealplanning.MealPlanningService.GetMealPlanOptionVote:output_type -> mealplanning.GetMealPlanOptionVoteResponse
+	346, // 346: mealplanning.MealPlanningService.GetMealPlanOptionVotes:output_type -> mealplanning.GetMealPlanOptionVotesResponse
+	347, // 347: mealplanning.MealPlanningService.GetMealPlanOptions:output_type -> mealplanning.GetMealPlanOptionsResponse
+	348, // 348: mealplanning.MealPlanningService.GetMealPlanRecipeOptionSelection:output_type -> mealplanning.GetMealPlanRecipeOptionSelectionResponse
+	349, // 349: mealplanning.MealPlanningService.GetMealPlanRecipeOptionSelectionsForMealPlanOption:output_type -> mealplanning.GetMealPlanRecipeOptionSelectionsForMealPlanOptionResponse
+	350, // 350: mealplanning.MealPlanningService.GetMealPlanTask:output_type -> mealplanning.GetMealPlanTaskResponse
+	351, // 351: mealplanning.MealPlanningService.GetMealPlanTasks:output_type -> mealplanning.GetMealPlanTasksResponse
+	352, // 352: mealplanning.MealPlanningService.GetMealPlanTemplate:output_type -> mealplanning.GetMealPlanTemplateResponse
+	353, // 353: mealplanning.MealPlanningService.GetMealPlanTemplates:output_type -> mealplanning.GetMealPlanTemplatesResponse
+	354, // 354: mealplanning.MealPlanningService.GetMealPlansForAccount:output_type -> mealplanning.GetMealPlansForAccountResponse
+	355, // 355: mealplanning.MealPlanningService.GetMealLists:output_type -> mealplanning.GetMealListsResponse
+	356, // 356: mealplanning.MealPlanningService.GetMeals:output_type -> mealplanning.GetMealsResponse
+	357, // 357: mealplanning.MealPlanningService.GetCookTimelineForMealPlanOption:output_type -> mealplanning.GetCookTimelineForMealPlanOptionResponse
+	358, // 358: mealplanning.MealPlanningService.GetNutritionForMeal:output_type -> mealplanning.GetNutritionForMealResponse
+	359, // 359: mealplanning.MealPlanningService.GetNutritionForMealPlan:output_type -> mealplanning.GetNutritionForMealPlanResponse
+	360, // 360: mealplanning.MealPlanningService.GetNutritionForRecipe:output_type -> mealplanning.GetNutritionForRecipeResponse
+	361, // 361: mealplanning.MealPlanningService.GetMermaidDiagramForMeal:output_type -> mealplanning.GetMermaidDiagramForMealResponse
+	362, // 362: mealplanning.MealPlanningService.GetMermaidDiagramForRecipe:output_type -> mealplanning.GetMermaidDiagramForRecipeResponse
+	363, // 363: mealplanning.MealPlanningService.GetRandomValidIngredient:output_type -> mealplanning.GetRandomValidIngredientResponse
+	364, // 364: mealplanning.MealPlanningService.GetRandomValidInstrument:output_type -> mealplanning.GetRandomValidInstrumentResponse
+	365, // 365: mealplanning.MealPlanningService.GetRandomValidPreparation:output_type -> mealplanning.GetRandomValidPreparationResponse
+	366, // 366: mealplanning.MealPlanningService.GetRandomValidVessel:output_type -> mealplanning.GetRandomValidVesselResponse
+	367, // 367: mealplanning.MealPlanningService.GetRecipe:output_type -> mealplanning.GetRecipeResponse
+	368, // 368: mealplanning.MealPlanningService.EstimateRecipePrepTasks:output_type -> mealplanning.EstimateRecipePrepTasksResponse
+	369, // 369: mealplanning.MealPlanningService.GetRecipePrepTask:output_type -> mealplanning.GetRecipePrepTaskResponse
+	370, // 370: mealplanning.MealPlanningService.GetRecipePrepTasks:output_type -> mealplanning.GetRecipePrepTasksResponse
+	371, // 371: mealplanning.MealPlanningService.GetRecipeRating:output_type -> mealplanning.GetRecipeRatingResponse
+	372, // 372: mealplanning.MealPlanningService.GetRecipeRatingsForRecipe:output_type -> mealplanning.GetRecipeRatingsForRecipeResponse
+	373, // 373: mealplanning.MealPlanningService.GetRecipeRevision:output_type -> mealplanning.GetRecipeRevisionResponse
+	374, // 374: mealplanning.MealPlanningService.GetRecipeRevisions:output_type -> mealplanning.GetRecipeRevisionsResponse
+	375, // 375: mealplanning.MealPlanningService.GetRecipeStep:output_type -> mealplanning.GetRecipeStepResponse
+	376, // 376: mealplanning.MealPlanningService.GetRecipeStepCompletionCondition:output_type -> mealplanning.GetRecipeStepCompletionConditionResponse
+	377, // 377: mealplanning.MealPlanningService.GetRecipeStepCompletionConditions:output_type -> mealplanning.GetRecipeStepCompletionConditionsResponse
+	378, // 378: mealplanning.MealPlanningService.GetRecipeStepIngredient:output_type -> mealplanning.GetRecipeStepIngredientResponse
+	379, // 379: mealplanning.MealPlanningService.GetRecipeStepIngredients:output_type -> mealplanning.GetRecipeStepIngredientsResponse
+	380, // 380: mealplanning.MealPlanningService.GetRecipeStepInstrument:output_type -> mealplanning.GetRecipeStepInstrumentResponse
+	381, // 381: mealplanning.MealPlanningService.GetRecipeStepInstruments:output_type -> mealplanning.GetRecipeStepInstrumentsResponse
+	382, // 382: mealplanning.MealPlanningService.GetRecipeStepProduct:output_type -> mealplanning.GetRecipeStepProductResponse
+	383, // 383: mealplanning.MealPlanningService.GetRecipeStepProducts:output_type -> mealplanning.GetRecipeStepProductsResponse
+	384, // 384: mealplanning.MealPlanningService.GetRecipeStepVessel:output_type -> mealplanning.GetRecipeStepVesselResponse
+	385, // 385: mealplanning.MealPlanningService.GetRecipeStepVessels:output_type -> mealplanning.GetRecipeStepVesselsResponse
+	386, // 386: mealplanning.MealPlanningService.GetRecipeSteps:output_type -> mealplanning.GetRecipeStepsResponse
+	387, // 387: mealplanning.MealPlanningService.GetRecipeLists:output_type -> mealplanning.GetRecipeListsResponse
+	388, // 388: mealplanning.MealPlanningService.GetRecipes:output_type -> mealplanning.GetRecipesResponse
+	389, // 389: mealplanning.MealPlanningService.GetValidIngredient:output_type -> mealplanning.GetValidIngredientResponse
+	390, // 390: mealplanning.MealPlanningService.GetValidIngredientGroup:output_type -> mealplanning.GetValidIngredientGroupResponse
+	391, // 391: mealplanning.MealPlanningService.GetValidIngredientGroups:output_type -> mealplanning.GetValidIngredientGroupsResponse
+	392, // 392: mealplanning.MealPlanningService.GetValidIngredientMeasurementUnit:output_type -> mealplanning.GetValidIngredientMeasurementUnitResponse
+	393, // 393: mealplanning.MealPlanningService.GetValidIngredientMeasurementUnits:output_type -> mealplanning.GetValidIngredientMeasurementUnitsResponse
+	394, // 394: mealplanning.MealPlanningService.GetValidIngredientMeasurementUnitsByIngredient:output_type -> mealplanning.GetValidIngredientMeasurementUnitsByIngredientResponse
+	395, // 395: mealplanning.MealPlanningService.GetValidIngredientMeasurementUnitsByMeasurementUnit:output_type -> mealplanning.GetValidIngredientMeasurementUnitsByMeasurementUnitResponse
+	396, // 396: mealplanning.MealPlanningService.GetValidIngredientNutritionFacts:output_type -> mealplanning.GetValidIngredientNutritionFactsResponse
+	397, // 397: mealplanning.MealPlanningService.GetValidIngredientPreparation:output_type -> mealplanning.GetValidIngredientPreparationResponse
+	398, // 398: mealplanning.MealPlanningService.GetValidIngredientPreparations:output_type -> mealplanning.GetValidIngredientPreparationsResponse
+	399, // 399: mealplanning.MealPlanningService.GetValidIngredientPreparationsByIngredient:output_type -> mealplanning.GetValidIngredientPreparationsByIngredientResponse
+	400, // 400: mealplanning.MealPlanningService.GetValidIngredientPreparationsByPreparation:output_type -> mealplanning.GetValidIngredientPreparationsByPreparationResponse
+	401, // 401: mealplanning.MealPlanningService.GetValidPrepTaskConfig:output_type -> mealplanning.GetValidPrepTaskConfigResponse
+	402, // 402: mealplanning.MealPlanningService.GetValidPrepTaskConfigs:output_type -> mealplanning.GetValidPrepTaskConfigsResponse
+	403, // 403: mealplanning.MealPlanningService.GetValidPrepTaskConfigsByIngredient:output_type -> mealplanning.GetValidPrepTaskConfigsByIngredientResponse
+	404, // 404: mealplanning.MealPlanningService.GetValidPrepTaskConfigsByPreparation:output_type -> mealplanning.GetValidPrepTaskConfigsByPreparationResponse
+	405, // 405: mealplanning.MealPlanningService.GetValidPrepTaskConfigsByIngredientAndPreparation:output_type -> mealplanning.GetValidPrepTaskConfigsByIngredientAndPreparationResponse
+	406, // 406: mealplanning.MealPlanningService.GetValidIngredientState:output_type -> mealplanning.GetValidIngredientStateResponse
+	407, // 407: mealplanning.MealPlanningService.GetValidIngredientStateIngredient:output_type -> mealplanning.GetValidIngredientStateIngredientResponse
+	408, // 408: mealplanning.MealPlanningService.GetValidIngredientStateIngredients:output_type -> mealplanning.GetValidIngredientStateIngredientsResponse
+	409, // 409: mealplanning.MealPlanningService.GetValidIngredientStateIngredientsByIngredient:output_type -> mealplanning.GetValidIngredientStateIngredientsByIngredientResponse
+	410, // 410: mealplanning.MealPlanningService.GetValidIngredientStateIngredientsByIngredientState:output_type -> mealplanning.GetValidIngredientStateIngredientsByIngredientStateResponse
+	411, // 411: mealplanning.MealPlanningService.GetValidIngredientStates:output_type -> mealplanning.GetValidIngredientStatesResponse
+	412, // 412: mealplanning.MealPlanningService.GetValidIngredients:output_type -> mealplanning.GetValidIngredientsResponse
+	413, // 413: mealplanning.MealPlanningService.GetValidInstrument:output_type -> mealplanning.GetValidInstrumentResponse
+	414, // 414: mealplanning.MealPlanningService.GetValidInstruments:output_type -> mealplanning.GetValidInstrumentsResponse
+	415, // 415: mealplanning.MealPlanningService.GetValidMeasurementUnit:output_type -> mealplanning.GetValidMeasurementUnitResponse
+	416, // 416: mealplanning.MealPlanningService.GetValidMeasurementUnitConversion:output_type -> mealplanning.GetValidMeasurementUnitConversionResponse
+	417, // 417: mealplanning.MealPlanningService.GetValidMeasurementUnitConversionsForUnit:output_type -> mealplanning.GetValidMeasurementUnitConversionsForUnitResponse
+	418, // 418: mealplanning.MealPlanningService.GetValidMeasurementUnitConversionsForIngredients:output_type -> mealplanning.GetValidMeasurementUnitConversionsForIngredientsResponse
+	419, // 419: mealplanning.MealPlanningService.GetMeasurementUnitConversionMismatches:output_type -> mealplanning.GetMeasurementUnitConversionMismatchesResponse
+	420, // 420: mealplanning.MealPlanningService.GetValidMeasurementUnits:output_type -> mealplanning.GetValidMeasurementUnitsResponse
+	421, // 421: mealplanning.MealPlanningService.GetValidPreparation:output_type -> mealplanning.GetValidPreparationResponse
+	422, // 422: mealplanning.MealPlanningService.GetValidPreparationInstrument:output_type -> mealplanning.GetValidPreparationInstrumentResponse
+	423, // 423: mealplanning.MealPlanningService.GetValidPreparationInstruments:output_type -> mealplanning.GetValidPreparationInstrumentsResponse
+	424, // 424: mealplanning.MealPlanningService.GetValidPreparationInstrumentsByInstrument:output_type -> mealplanning.GetValidPreparationInstrumentsByInstrumentResponse
+	425, // 425: mealplanning.MealPlanningService.GetValidPreparationInstrumentsByPreparation:output_type -> mealplanning.GetValidPreparationInstrumentsByPreparationResponse
+	426, // 426: mealplanning.MealPlanningService.GetValidPreparationVessel:output_type -> mealplanning.GetValidPreparationVesselResponse
+	427, // 427: mealplanning.MealPlanningService.GetValidPreparationVessels:output_type -> mealplanning.GetValidPreparationVesselsResponse
+	428, // 428: mealplanning.MealPlanningService.GetValidPreparationVesselsByPreparation:output_type -> mealplanning.GetValidPreparationVesselsByPreparationResponse
+	429, // 429: mealplanning.MealPlanningService.GetValidPreparationVesselsByVessel:output_type -> mealplanning.GetValidPreparationVesselsByVesselResponse
+	430, // 430: mealplanning.MealPlanningService.GetValidPreparations:output_type -> mealplanning.GetValidPreparationsResponse
+	431, // 431: mealplanning.MealPlanningService.GetValidVessel:output_type -> mealplanning.GetValidVesselResponse
+	432, // 432: mealplanning.MealPlanningService.GetValidVessels:output_type -> mealplanning.GetValidVesselsResponse
+	433, // 433: mealplanning.MealPlanningService.RestoreRecipeRevision:output_type -> mealplanning.RestoreRecipeRevisionResponse
+	434, // 434: mealplanning.MealPlanningService.RunFinalizeMealPlanWorker:output_type -> mealplanning.RunFinalizeMealPlanWorkerResponse
+	435, // 435: mealplanning.MealPlanningService.RunMealPlanGroceryListInitializerWorker:output_type -> mealplanning.RunMealPlanGroceryListInitializerWorkerResponse
+	436, // 436: mealplanning.MealPlanningService.RunMealPlanTaskCreatorWorker:output_type -> mealplanning.RunMealPlanTaskCreatorWorkerResponse
+	437, // 437: mealplanning.MealPlanningService.SearchForMeals:output_type -> mealplanning.SearchForMealsResponse
+	438, // 438: mealplanning.MealPlanningService.SearchForRecipes:output_type -> mealplanning.SearchForRecipesResponse
+	439, // 439: mealplanning.MealPlanningService.SearchForMealEligibleRecipes:output_type -> mealplanning.SearchForMealEligibleRecipesResponse
+	440, // 440: mealplanning.MealPlanningService.SearchForRecipesWithInstrumentOwnership:output_type -> mealplanning.SearchForRecipesWithInstrumentOwnershipResponse
+	441, // 441: mealplanning.MealPlanningService.SearchForValidIngredientGroups:output_type -> mealplanning.SearchForValidIngredientGroupsResponse
+	442, // 442: mealplanning.MealPlanningService.SearchForValidIngredientStates:output_type -> mealplanning.SearchForValidIngredientStatesResponse
+	443, // 443: mealplanning.MealPlanningService.SearchForValidIngredients:output_type -> mealplanning.SearchForValidIngredientsResponse
+	444, // 444: mealplanning.MealPlanningService.SearchForValidInstruments:output_type -> mealplanning.SearchForValidInstrumentsResponse
+	445, // 445: mealplanning.MealPlanningService.SearchForValidMeasurementUnits:output_type -> mealplanning.SearchForValidMeasurementUnitsResponse
+	446, // 446: mealplanning.MealPlanningService.SearchForValidPreparations:output_type -> mealplanning.SearchForValidPreparationsResponse
+	447, // 447: mealplanning.MealPlanningService.SearchForValidVessels:output_type -> mealplanning.SearchForValidVesselsResponse
+	448, // 448: mealplanning.MealPlanningService.SearchValidIngredientsByPreparation:output_type -> mealplanning.SearchValidIngredientsByPreparationResponse
+	449, // 449: mealplanning.MealPlanningService.SearchValidMeasurementUnitsByIngredient:output_type -> mealplanning.SearchValidMeasurementUnitsByIngredientResponse
+	450, // 450: mealplanning.MealPlanningService.UpdateMealPlan:output_type -> mealplanning.UpdateMealPlanResponse
+	451, // 451: mealplanning.MealPlanningService.UpdateMealPlanEvent:output_type -> mealplanning.UpdateMealPlanEventResponse
+	452, // 452: mealplanning.MealPlanningService.SwapMealPlanEvents:output_type -> mealplanning.SwapMealPlanEventsResponse
+	453, // 453: mealplanning.MealPlanningService.UpdateMealPlanGroceryListItem:output_type -> mealplanning.UpdateMealPlanGroceryListItemResponse
+	454, // 454: mealplanning.MealPlanningService.UpdateMealPlanOption:output_type -> mealplanning.UpdateMealPlanOptionResponse
+	455, // 455: mealplanning.MealPlanningService.UpdateMealPlanOptionVote:output_type -> mealplanning.UpdateMealPlanOptionVoteResponse
+	456, // 456: mealplanning.MealPlanningService.UpdateMealPlanRecipeOptionSelection:output_type -> mealplanning.UpdateMealPlanRecipeOptionSelectionResponse
+	457, // 457: mealplanning.MealPlanningService.UpdateMealPlanTaskStatus:output_type -> mealplanning.UpdateMealPlanTaskStatusResponse
+	458, // 458: mealplanning.MealPlanningService.UpdateMealPlanTemplate:output_type -> mealplanning.UpdateMealPlanTemplateResponse
+	459, // 459: mealplanning.MealPlanningService.UpdateMealList:output_type -> mealplanning.UpdateMealListResponse
+	460, // 460: mealplanning.MealPlanningService.UpdateMealListItem:output_type -> mealplanning.UpdateMealListItemResponse
+	461, // 461: mealplanning.MealPlanningService.UpdateRecipe:output_type -> mealplanning.UpdateRecipeResponse
+	462, // 462: mealplanning.MealPlanningService.UpdateRecipeStatus:output_type -> mealplanning.UpdateRecipeStatusResponse
+	463, // 463: mealplanning.MealPlanningService.UpdateRecipePrepTask:output_type -> mealplanning.UpdateRecipePrepTaskResponse
+	464, // 464: mealplanning.MealPlanningService.UpdateRecipeRating:output_type -> mealplanning.UpdateRecipeRatingResponse
+	465, // 465: mealplanning.MealPlanningService.UpdateRecipeList:output_type -> mealplanning.UpdateRecipeListResponse
+	466, // 466: mealplanning.MealPlanningService.UpdateRecipeListItem:output_type -> mealplanning.UpdateRecipeListItemResponse
+	467, // 467: mealplanning.MealPlanningService.UpdateRecipeStep:output_type -> mealplanning.UpdateRecipeStepResponse
+	468, // 468: mealplanning.MealPlanningService.UpdateRecipeStepCompletionCondition:output_type -> mealplanning.UpdateRecipeStepCompletionConditionResponse
+	469, // 469: mealplanning.MealPlanningService.UpdateRecipeStepIngredient:output_type -> mealplanning.UpdateRecipeStepIngredientResponse
+	470, // 470: mealplanning.MealPlanningService.UpdateRecipeStepInstrument:output_type -> mealplanning.UpdateRecipeStepInstrumentResponse
+	471, // 471: mealplanning.MealPlanningService.UpdateRecipeStepProduct:output_type -> mealplanning.UpdateRecipeStepProductResponse
+	472, // 472: mealplanning.MealPlanningService.UpdateRecipeStepVessel:output_type -> mealplanning.UpdateRecipeStepVesselResponse
+	473, // 473: mealplanning.MealPlanningService.UpdateValidIngredient:output_type -> mealplanning.UpdateValidIngredientResponse
+	474, // 474: mealplanning.MealPlanningService.UpdateValidIngredientGroup:output_type -> mealplanning.UpdateValidIngredientGroupResponse
+	475, // 475: mealplanning.MealPlanningService.UpdateValidIngredientMeasurementUnit:output_type -> mealplanning.UpdateValidIngredientMeasurementUnitResponse
+	476, // 476: mealplanning.MealPlanningService.UpdateValidIngredientNutritionFacts:output_type -> mealplanning.UpdateValidIngredientNutritionFactsResponse
+	477, // 477: mealplanning.MealPlanningService.UpdateValidIngredientPreparation:output_type -> mealplanning.UpdateValidIngredientPreparationResponse
+	478, // 478: mealplanning.MealPlanningService.UpdateValidPrepTaskConfig:output_type -> mealplanning.UpdateValidPrepTaskConfigResponse
+	479, // 479: mealplanning.MealPlanningService.UpdateValidIngredientState:output_type -> mealplanning.UpdateValidIngredientStateResponse
+	480, // 480: mealplanning.MealPlanningService.UpdateValidIngredientStateIngredient:output_type -> mealplanning.UpdateValidIngredientStateIngredientResponse
+	481, // 481: mealplanning.MealPlanningService.UpdateValidInstrument:output_type -> mealplanning.UpdateValidInstrumentResponse
+	482, // 482: mealplanning.MealPlanningService.UpdateValidMeasurementUnit:output_type -> mealplanning.UpdateValidMeasurementUnitResponse
+	483, // 483: mealplanning.MealPlanningService.UpdateValidMeasurementUnitConversion:output_type -> mealplanning.UpdateValidMeasurementUnitConversionResponse
+	484, // 484: mealplanning.MealPlanningService.UpdateValidPreparation:output_type -> mealplanning.UpdateValidPreparationResponse
+	485, // 485: mealplanning.MealPlanningService.UpdateValidPreparationInstrument:output_type -> mealplanning.UpdateValidPreparationInstrumentResponse
+	486, // 486: mealplanning.MealPlanningService.UpdateValidPreparationVessel:output_type -> mealplanning.UpdateValidPreparationVesselResponse
+	487, // 487: mealplanning.MealPlanningService.UpdateValidVessel:output_type -> mealplanning.UpdateValidVesselResponse
+	488, // 488: mealplanning.MealPlanningService.ArchiveAccountInstrumentOwnership:output_type -> mealplanning.ArchiveAccountInstrumentOwnershipResponse
+	489, // 489: mealplanning.MealPlanningService.ArchiveUserIngredientPreference:output_type -> mealplanning.ArchiveUserIngredientPreferenceResponse
+	490, // 490: mealplanning.MealPlanningService.CreateAccountInstrumentOwnership:output_type -> mealplanning.CreateAccountInstrumentOwnershipResponse
+	491, // 491: mealplanning.MealPlanningService.CreateUserIngredientPreference:output_type -> mealplanning.CreateUserIngredientPreferenceResponse
+	492, // 492: mealplanning.MealPlanningService.GetAccountInstrumentOwnership:output_type -> mealplanning.GetAccountInstrumentOwnershipResponse
+	493, // 493: mealplanning.MealPlanningService.GetAccountInstrumentOwnerships:output_type -> mealplanning.GetAccountInstrumentOwnershipsResponse
+	494, // 494: mealplanning.MealPlanningService.SearchForValidInstrumentsNotOwnedByAccount:output_type -> mealplanning.SearchForValidInstrumentsNotOwnedByAccountResponse
+	495, // 495: mealplanning.MealPlanningService.GetUserIngredientPreference:output_type -> mealplanning.GetUserIngredientPreferenceResponse
+	496, // 496: mealplanning.MealPlanningService.GetUserIngredientPreferences:output_type -> mealplanning.GetUserIngredientPreferencesResponse
+	497, // 497: mealplanning.MealPlanningService.UpdateAccountInstrumentOwnership:output_type -> mealplanning.UpdateAccountInstrumentOwnershipResponse
+	498, // 498: mealplanning.MealPlanningService.ArchivePantryItem:output_type -> mealplanning.ArchivePantryItemResponse
+	499, // 499: mealplanning.MealPlanningService.CreatePantryItem:output_type -> mealplanning.CreatePantryItemResponse
+	500, // 500: mealplanning.MealPlanningService.GetPantryItem:output_type -> mealplanning.GetPantryItemResponse
+	501, // 501: mealplanning.MealPlanningService.GetPantryItems:output_type -> mealplanning.GetPantryItemsResponse
+	502, // 502: mealplanning.MealPlanningService.UpdatePantryItem:output_type -> mealplanning.UpdatePantryItemResponse
+	503, // 503: mealplanning.MealPlanningService.UpdateUserIngredientPreference:output_type -> mealplanning.UpdateUserIngredientPreferenceResponse
+	504, // 504: mealplanning.MealPlanningService.UploadMealImage:output_type -> mealplanning.UploadMealImageResponse
+	505, // 505: mealplanning.MealPlanningService.UploadRecipeImage:output_type -> mealplanning.UploadRecipeImageResponse
+	506, // 506: mealplanning.MealPlanningService.UploadPreparationMedia:output_type -> mealplanning.UploadPreparationMediaResponse
+	507, // 507: mealplanning.MealPlanningService.UploadIngredientMedia:output_type -> mealplanning.UploadIngredientMediaResponse
+	508, // 508: mealplanning.MealPlanningService.UploadRecipeStepImage:output_type -> mealplanning.UploadRecipeStepImageResponse
+	509, // 509: mealplanning.MealPlanningService.ExportMealPlanCalendar:output_type -> mealplanning.ExportMealPlanCalendarResponse
+	510, // 510: mealplanning.MealPlanningService.WatchMealPlan:output_type -> mealplanning.WatchMealPlanResponse
+	511, // 511: mealplanning.MealPlanningService.WatchMealPlanTasks:output_type -> mealplanning.WatchMealPlanTasksResponse
+	256, // [256:512] is the sub-list for method output_type
+	0,   // [0:256] is the sub-list for method input_type
 	0,   // [0:0] is the sub-list for extension type_name
 	0,   // [0:0] is the sub-list for extension extendee
 	0,   // [0:0] is the sub-list for field type_name
@@ -30,6 +30,7 @@
 	MealPlanningService_ArchiveMealPlanOption_FullMethodName                               = "/mealplanning.MealPlanningService/ArchiveMealPlanOption"
 	MealPlanningService_ArchiveMealPlanOptionVote_FullMethodName                           = "/mealplanning.MealPlanningService/ArchiveMealPlanOptionVote"
 	MealPlanningService_ArchiveMealPlanRecipeOptionSelection_FullMethodName                = "/mealplanning.MealPlanningService/ArchiveMealPlanRecipeOptionSelection"
+	MealPlanningService_ArchiveMealPlanTemplate_FullMethodName                             = "/mealplanning.MealPlanningService/ArchiveMealPlanTemplate"
 	MealPlanningService_ArchiveMealList_FullMethodName                                     = "/mealplanning.MealPlanningService/ArchiveMealList"
 	MealPlanningService_ArchiveMealListItem_FullMethodName                                 = "/mealplanning.MealPlanningService/ArchiveMealListItem"
 	MealPlanningService_ArchiveRecipe_FullMethodName                                       = "/mealplanning.MealPlanningService/ArchiveRecipe"
@@ -46,6 +47,7 @@
 	MealPlanningService_ArchiveValidIngredient_FullMethodName                              = "/mealplanning.MealPlanningService/ArchiveValidIngredient"
 	MealPlanningService_ArchiveValidIngredientGroup_FullMethodName                         = "/mealplanning.MealPlanningService/ArchiveValidIngredientGroup"
 	MealPlanningService_ArchiveValidIngredientMeasurementUnit_FullMethodName               = "/mealplanning.MealPlanningService/ArchiveValidIngredientMeasurementUnit"
+	MealPlanningService_ArchiveValidIngredientNutritionFacts_FullMethodName                = "/mealplanning.MealPlanningService/ArchiveValidIngredientNutritionFacts"
 	MealPlanningService_ArchiveValidIngredientPreparation_FullMethodName                   = "/mealplanning.MealPlanningService/ArchiveValidIngredientPreparation"
 	MealPlanningService_ArchiveValidPrepTaskConfig_FullMethodName                          = "/mealplanning.MealPlanningService/ArchiveValidPrepTaskConfig"
 	MealPlanningService_ArchiveValidIngredientState_FullMethodName                         = "/mealplanning.MealPlanningService/ArchiveValidIngredientState"
@@ -58,13 +60,16 @@
 	MealPlanningService_ArchiveValidPreparationVessel_FullMethodName                       = "/mealplanning.MealPlanningService/ArchiveValidPreparationVessel"
 	MealPlanningService_ArchiveValidVessel_FullMethodName                                  = "/mealplanning.MealPlanningService/ArchiveValidVessel"
 	MealPlanningService_CloneRecipe_FullMethodName                                         = "/mealplanning.MealPlanningService/CloneRecipe"
+	MealPlanningService_ImportRecipe_FullMethodName                                        = "/mealplanning.MealPlanningService/ImportRecipe"
 	MealPlanningService_CreateMeal_FullMethodName                                          = "/mealplanning.MealPlanningService/CreateMeal"
 	MealPlanningService_CreateMealPlan_FullMethodName                                      = "/mealplanning.MealPlanningService/CreateMealPlan"
+	MealPlanningService_CreateMealPlanCalendarFeed_FullMethodName                          = "/mealplanning.MealPlanningService/CreateMealPlanCalendarFeed"
 	MealPlanningService_CreateMealPlanEvent_FullMethodName                                 = "/mealplanning.MealPlanningService/CreateMealPlanEvent"
 	MealPlanningService_CreateMealPlanOption_FullMethodName                                = "/mealplanning.MealPlanningService/CreateMealPlanOption"
 	MealPlanningService_CreateMealPlanOptionVote_FullMethodName                            = "/mealplanning.MealPlanningService/CreateMealPlanOptionVote"
 	MealPlanningService_CreateMealPlanRecipeOptionSelection_FullMethodName                 = "/mealplanning.MealPlanningService/CreateMealPlanRecipeOptionSelection"
 	MealPlanningService_CreateMealPlanTask_FullMethodName                                  = "/mealplanning.MealPlanningService/CreateMealPlanTask"
+	MealPlanningService_CreateMealPlanTemplate_FullMethodName                              = "/mealplanning.MealPlanningService/CreateMealPlanTemplate"
 	MealPlanningService_CreateMealList_FullMethodName                                      = "/mealplanning.MealPlanningService/CreateMealList"
 	MealPlanningService_CreateMealListItem_FullMethodName                                  = "/mealplanning.MealPlanningService/CreateMealListItem"
 	MealPlanningService_CreateRecipe_FullMethodName                                        = "/mealplanning.MealPlanningService/CreateRecipe"
@@ -81,6 +86,7 @@
 	MealPlanningService_CreateValidIngredient_FullMethodName                               = "/mealplanning.MealPlanningService/CreateValidIngredient"
 	MealPlanningService_CreateValidIngredientGroup_FullMethodName                          = "/mealplanning.MealPlanningService/CreateValidIngredientGroup"
 	MealPlanningService_CreateValidIngredientMeasurementUnit_FullMethodName                = "/mealplanning.MealPlanningService/CreateValidIngredientMeasurementUnit"
+	MealPlanningService_CreateValidIngredientNutritionFacts_FullMethodName                 = "/mealplanning.MealPlanningService/CreateValidIngredientNutritionFacts"
 	MealPlanningService_CreateValidIngredientPreparation_FullMethodName                    = "/mealplanning.MealPlanningService/CreateValidIngredientPreparation"
 	MealPlanningService_CreateValidPrepTaskConfig_FullMethodName                           = "/mealplanning.MealPlanningService/CreateValidPrepTaskConfig"
 	MealPlanningService_CreateValidIngredientState_FullMethodName                          = "/mealplanning.MealPlanningService/CreateValidIngredientState"
@@ -92,10 +98,13 @@
 	MealPlanningService_CreateValidPreparationInstrument_FullMethodName                    = "/mealplanning.MealPlanningService/CreateValidPreparationInstrument"
 	MealPlanningService_CreateValidPreparationVessel_FullMethodName                        = "/mealplanning.MealPlanningService/CreateValidPreparationVessel"
 	MealPlanningService_CreateValidVessel_FullMethodName                                   = "/mealplanning.MealPlanningService/CreateValidVessel"
+	MealPlanningService_CompleteMealPlanOption_FullMethodName                              = "/mealplanning.MealPlanningService/CompleteMealPlanOption"
+	MealPlanningService_DiffRecipeRevisions_FullMethodName                                 = "/mealplanning.MealPlanningService/DiffRecipeRevisions"
 	MealPlanningService_FinalizeMealPlan_FullMethodName                                    = "/mealplanning.MealPlanningService/FinalizeMealPlan"
 	MealPlanningService_GetMeal_FullMethodName                                             = "/mealplanning.MealPlanningService/GetMeal"
 	MealPlanningService_GetMealPlan_FullMethodName                                         = "/mealplanning.MealPlanningService/GetMealPlan"
 	MealPlanningService_GetMealPlanEvent_FullMethodName                                    = "/mealplanning.MealPlanningService/GetMealPlanEvent"
+	MealPlanningService_GetMealPlanEventTallyReport_FullMethodName                         = "/mealplanning.MealPlanningService/GetMealPlanEventTallyReport"
 	MealPlanningService_GetMealPlanEvents_FullMethodName                                   = "/mealplanning.MealPlanningService/GetMealPlanEvents"
 	MealPlanningService_GetMealPlanGroceryListItem_FullMethodName                          = "/mealplanning.MealPlanningService/GetMealPlanGroceryListItem"
 	MealPlanningService_GetMealPlanGroceryListItemsForMealPlan_FullMethodName              = "/mealplanning.MealPlanningService/GetMealPlanGroceryListItemsForMealPlan"
@@ -107,9 +116,15 @@
 	MealPlanningService_GetMealPlanRecipeOptionSelectionsForMealPlanOption_FullMethodName  = "/mealplanning.MealPlanningService/GetMealPlanRecipeOptionSelectionsForMealPlanOption"
 	MealPlanningService_GetMealPlanTask_FullMethodName                                     = "/mealplanning.MealPlanningService/GetMealPlanTask"
 	MealPlanningService_GetMealPlanTasks_FullMethodName                                    = "/mealplanning.MealPlanningService/GetMealPlanTasks"
+	MealPlanningService_GetMealPlanTemplate_FullMethodName                                 = "/mealplanning.MealPlanningService/GetMealPlanTemplate"
+	MealPlanningService_GetMealPlanTemplates_FullMethodName                                = "/mealplanning.MealPlanningService/GetMealPlanTemplates"
 	MealPlanningService_GetMealPlansForAccount_FullMethodName                              = "/mealplanning.MealPlanningService/GetMealPlansForAccount"
 	MealPlanningService_GetMealLists_FullMethodName                                        = "/mealplanning.MealPlanningService/GetMealLists"
 	MealPlanningService_GetMeals_FullMethodName                                            = "/mealplanning.MealPlanningService/GetMeals"
+	MealPlanningService_GetCookTimelineForMealPlanOption_FullMethodName                    = "/mealplanning.MealPlanningService/GetCookTimelineForMealPlanOption"
+	MealPlanningService_GetNutritionForMeal_FullMethodName                                 = "/mealplanning.MealPlanningService/GetNutritionForMeal"
+	MealPlanningService_GetNutritionForMealPlan_FullMethodName                             = "/mealplanning.MealPlanningService/GetNutritionForMealPlan"
+	MealPlanningService_GetNutritionForRecipe_FullMethodName                               = "/mealplanning.MealPlanningService/GetNutritionForRecipe"
 	MealPlanningService_GetMermaidDiagramForMeal_FullMethodName                            = "/mealplanning.MealPlanningService/GetMermaidDiagramForMeal"
 	MealPlanningService_GetMermaidDiagramForRecipe_FullMethodName                          = "/mealplanning.MealPlanningService/GetMermaidDiagramForRecipe"
 	MealPlanningService_GetRandomValidIngredient_FullMethodName                            = "/mealplanning.MealPlanningService/GetRandomValidIngredient"
@@ -122,6 +137,8 @@
 	MealPlanningService_GetRecipePrepTasks_FullMethodName                                  = "/mealplanning.MealPlanningService/GetRecipePrepTasks"
 	MealPlanningService_GetRecipeRating_FullMethodName                                     = "/mealplanning.MealPlanningService/GetRecipeRating"
 	MealPlanningService_GetRecipeRatingsForRecipe_FullMethodName                           = "/mealplanning.MealPlanningService/GetRecipeRatingsForRecipe"
+	MealPlanningService_GetRecipeRevision_FullMethodName                                   = "/mealplanning.MealPlanningService/GetRecipeRevision"
+	MealPlanningService_GetRecipeRevisions_FullMethodName                                  = "/mealplanning.MealPlanningService/GetRecipeRevisions"
 	MealPlanningService_GetRecipeStep_FullMethodName                                       = "/mealplanning.MealPlanningService/GetRecipeStep"
 	MealPlanningService_GetRecipeStepCompletionCondition_FullMethodName                    = "/mealplanning.MealPlanningService/GetRecipeStepCompletionCondition"
 	MealPlanningService_GetRecipeStepCompletionConditions_FullMethodName                   = "/mealplanning.MealPlanningService/GetRecipeStepCompletionConditions"
@@ -143,6 +160,7 @@
 	MealPlanningService_GetValidIngredientMeasurementUnits_FullMethodName                  = "/mealplanning.MealPlanningService/GetValidIngredientMeasurementUnits"
 	MealPlanningService_GetValidIngredientMeasurementUnitsByIngredient_FullMethodName      = "/mealplanning.MealPlanningService/GetValidIngredientMeasurementUnitsByIngredient"
 	MealPlanningService_GetValidIngredientMeasurementUnitsByMeasurementUnit_FullMethodName = "/mealplanning.MealPlanningService/GetValidIngredientMeasurementUnitsByMeasurementUnit"
+	MealPlanningService_GetValidIngredientNutritionFacts_FullMethodName                    = "/mealplanning.MealPlanningService/GetValidIngredientNutritionFacts"
 	MealPlanningService_GetValidIngredientPreparation_FullMethodName                       = "/mealplanning.MealPlanningService/GetValidIngredientPreparation"
 	MealPlanningService_GetValidIngredientPreparations_FullMethodName                      = "/mealplanning.MealPlanningService/GetValidIngredientPreparations"
 	MealPlanningService_GetValidIngredientPreparationsByIngredient_FullMethodName          = "/mealplanning.MealPlanningService/GetValidIngredientPreparationsByIngredient"
@@ -179,6 +197,7 @@
 	MealPlanningService_GetValidPreparations_FullMethodName                                = "/mealplanning.MealPlanningService/GetValidPreparations"
 	MealPlanningService_GetValidVessel_FullMethodName                                      = "/mealplanning.MealPlanningService/GetValidVessel"
 	MealPlanningService_GetValidVessels_FullMethodName                                     = "/mealplanning.MealPlanningService/GetValidVessels"
+	MealPlanningService_RestoreRecipeRevision_FullMethodName                               = "/mealplanning.MealPlanningService/RestoreRecipeRevision"
 	MealPlanningService_RunFinalizeMealPlanWorker_FullMethodName                           = "/mealplanning.MealPlanningService/RunFinalizeMealPlanWorker"
 	MealPlanningService_RunMealPlanGroceryListInitializerWorker_FullMethodName             = "/mealplanning.MealPlanningService/RunMealPlanGroceryListInitializerWorker"
 	MealPlanningService_RunMealPlanTaskCreatorWorker_FullMethodName                        = "/mealplanning.MealPlanningService/RunMealPlanTaskCreatorWorker"
@@ -203,6 +222,7 @@
 	MealPlanningService_UpdateMealPlanOptionVote_FullMethodName                            = "/mealplanning.MealPlanningService/UpdateMealPlanOptionVote"
 	MealPlanningService_UpdateMealPlanRecipeOptionSelection_FullMethodName                 = "/mealplanning.MealPlanningService/UpdateMealPlanRecipeOptionSelection"
 	MealPlanningService_UpdateMealPlanTaskStatus_FullMethodName                            = "/mealplanning.MealPlanningService/UpdateMealPlanTaskStatus"
+	MealPlanningService_UpdateMealPlanTemplate_FullMethodName                              = "/mealplanning.MealPlanningService/UpdateMealPlanTemplate"
 	MealPlanningService_UpdateMealList_FullMethodName                                      = "/mealplanning.MealPlanningService/UpdateMealList"
 	MealPlanningService_UpdateMealListItem_FullMethodName                                  = "/mealplanning.MealPlanningService/UpdateMealListItem"
 	MealPlanningService_UpdateRecipe_FullMethodName                                        = "/mealplanning.MealPlanningService/UpdateRecipe"
@@ -220,6 +240,7 @@
 	MealPlanningService_UpdateValidIngredient_FullMethodName                               = "/mealplanning.MealPlanningService/UpdateValidIngredient"
 	MealPlanningService_UpdateValidIngredientGroup_FullMethodName                          = "/mealplanning.MealPlanningService/UpdateValidIngredientGroup"
 	MealPlanningService_UpdateValidIngredientMeasurementUnit_FullMethodName                = "/mealplanning.MealPlanningService/UpdateValidIngredientMeasurementUnit"
+	MealPlanningService_UpdateValidIngredientNutritionFacts_FullMethodName                 = "/mealplanning.MealPlanningService/UpdateValidIngredientNutritionFacts"
 	MealPlanningService_UpdateValidIngredientPreparation_FullMethodName                    = "/mealplanning.MealPlanningService/UpdateValidIngredientPreparation"
 	MealPlanningService_UpdateValidPrepTaskConfig_FullMethodName                           = "/mealplanning.MealPlanningService/UpdateValidPrepTaskConfig"
 	MealPlanningService_UpdateValidIngredientState_FullMethodName                          = "/mealplanning.MealPlanningService/UpdateValidIngredientState"
@@ -241,12 +262,20 @@
 	MealPlanningService_GetUserIngredientPreference_FullMethodName                         = "/mealplanning.MealPlanningService/GetUserIngredientPreference"
 	MealPlanningService_GetUserIngredientPreferences_FullMethodName                        = "/mealplanning.MealPlanningService/GetUserIngredientPreferences"
 	MealPlanningService_UpdateAccountInstrumentOwnership_FullMethodName                    = "/mealplanning.MealPlanningService/UpdateAccountInstrumentOwnership"
+	MealPlanningService_ArchivePantryItem_FullMethodName                                   = "/mealplanning.MealPlanningService/ArchivePantryItem"
+	MealPlanningService_CreatePantryItem_FullMethodName                                    = "/mealplanning.MealPlanningService/CreatePantryItem"
+	MealPlanningService_GetPantryItem_FullMethodName                                       = "/mealplanning.MealPlanningService/GetPantryItem"
+	MealPlanningService_GetPantryItems_FullMethodName                                      = "/mealplanning.MealPlanningService/GetPantryItems"
+	MealPlanningService_UpdatePantryItem_FullMethodName                                    = "/mealplanning.MealPlanningService/UpdatePantryItem"
 	MealPlanningService_UpdateUserIngredientPreference_FullMethodName                      = "/mealplanning.MealPlanningService/UpdateUserIngredientPreference"
 	MealPlanningService_UploadMealImage_FullMethodName                                     = "/mealplanning.MealPlanningService/UploadMealImage"
 	MealPlanningService_UploadRecipeImage_FullMethodName                                   = "/mealplanning.MealPlanningService/UploadRecipeImage"
 	MealPlanningService_UploadPreparationMedia_FullMethodName                              = "/mealplanning.MealPlanningService/UploadPreparationMedia"
 	MealPlanningService_UploadIngredientMedia_FullMethodName                               = "/mealplanning.MealPlanningService/UploadIngredientMedia"
 	MealPlanningService_UploadRecipeStepImage_FullMethodName                               = "/mealplanning.MealPlanningService/UploadRecipeStepImage"
+	MealPlanningService_ExportMealPlanCalendar_FullMethodName                              = "/mealplanning.MealPlanningService/ExportMealPlanCalendar"
+	MealPlanningService_WatchMealPlan_FullMethodName                                       = "/mealplanning.MealPlanningService/WatchMealPlan"
+	MealPlanningService_WatchMealPlanTasks_FullMethodName                                  = "/mealplanning.MealPlanningService/WatchMealPlanTasks"
 )
 
 // MealPlanningServiceClient is the client API for MealPlanningService service.
@@ -263,6 +292,7 @@ type MealPlanningServiceClient interface {
 	ArchiveMealPlanOption(ctx context.Context, in *ArchiveMealPlanOptionRequest, opts ...grpc.CallOption) (*ArchiveMealPlanOptionResponse, error)
 	ArchiveMealPlanOptionVote(ctx context.Context, in *ArchiveMealPlanOptionVoteRequest, opts ...grpc.CallOption) (*ArchiveMealPlanOptionVoteResponse, error)
 	ArchiveMealPlanRecipeOptionSelection(ctx context.Context, in *ArchiveMealPlanRecipeOptionSelectionRequest, opts ...grpc.CallOption) (*ArchiveMealPlanRecipeOptionSelectionResponse, error)
+	ArchiveMealPlanTemplate(ctx context.Context, in *ArchiveMealPlanTemplateRequest, opts ...grpc.CallOption) (*ArchiveMealPlanTemplateResponse, error)
 	ArchiveMealList(ctx context.Context, in *ArchiveMealListRequest, opts ...grpc.CallOption) (*ArchiveMealListResponse, error)
 	ArchiveMealListItem(ctx context.Context, in *ArchiveMealListItemRequest, opts ...grpc.CallOption) (*ArchiveMealListItemResponse, error)
 	ArchiveRecipe(ctx context.Context, in *ArchiveRecipeRequest, opts ...grpc.CallOption) (*ArchiveRecipeResponse, error)
@@ -279,6 +309,7 @@ type MealPlanningServiceClient interface {
 	ArchiveValidIngredient(ctx context.Context, in *ArchiveValidIngredientRequest, opts ...grpc.CallOption) (*ArchiveValidIngredientResponse, error)
 	ArchiveValidIngredientGroup(ctx context.Context, in *ArchiveValidIngredientGroupRequest, opts ...grpc.CallOption) (*ArchiveValidIngredientGroupResponse, error)
 	ArchiveValidIngredientMeasurementUnit(ctx context.Context, in *ArchiveValidIngredientMeasurementUnitRequest, opts ...grpc.CallOption) (*ArchiveValidIngredientMeasurementUnitResponse, error)
+	ArchiveValidIngredientNutritionFacts(ctx context.Context, in *ArchiveValidIngredientNutritionFactsRequest, opts ...grpc.CallOption) (*ArchiveValidIngredientNutritionFactsResponse, error)
 	ArchiveValidIngredientPreparation(ctx context.Context, in *ArchiveValidIngredientPreparationRequest, opts ...grpc.CallOption) (*ArchiveValidIngredientPreparationResponse, error)
 	ArchiveValidPrepTaskConfig(ctx context.Context, in *ArchiveValidPrepTaskConfigRequest, opts ...grpc.CallOption) (*ArchiveValidPrepTaskConfigResponse, error)
 	ArchiveValidIngredientState(ctx context.Context, in *ArchiveValidIngredientStateRequest, opts ...grpc.CallOption) (*ArchiveValidIngredientStateResponse, error)
@@ -291,13 +322,16 @@ type MealPlanningServiceClient interface {
 	ArchiveValidPreparationVessel(ctx context.Context, in *ArchiveValidPreparationVesselRequest, opts ...grpc.CallOption) (*ArchiveValidPreparationVesselResponse, error)
 	ArchiveValidVessel(ctx context.Context, in *ArchiveValidVesselRequest, opts ...grpc.CallOption) (*ArchiveValidVesselResponse, error)
 	CloneRecipe(ctx context.Context, in *CloneRecipeRequest, opts ...grpc.CallOption) (*CloneRecipeResponse, error)
+	ImportRecipe(ctx context.Context, in *ImportRecipeRequest, opts ...grpc.CallOption) (*ImportRecipeResponse, error)
 	CreateMeal(ctx context.Context, in *CreateMealRequest, opts ...grpc.CallOption) (*CreateMealResponse, error)
 	CreateMealPlan(ctx context.Context, in *CreateMealPlanRequest, opts ...grpc.CallOption) (*CreateMealPlanResponse, error)
+	CreateMealPlanCalendarFeed(ctx context.Context, in *CreateMealPlanCalendarFeedRequest, opts ...grpc.CallOption) (*CreateMealPlanCalendarFeedResponse, error)
 	CreateMealPlanEvent(ctx context.Context, in *CreateMealPlanEventRequest, opts ...grpc.CallOption) (*CreateMealPlanEventResponse, error)
 	CreateMealPlanOption(ctx context.Context, in *CreateMealPlanOptionRequest, opts ...grpc.CallOption) (*CreateMealPlanOptionResponse, error)
 	CreateMealPlanOptionVote(ctx context.Context, in *CreateMealPlanOptionVoteRequest, opts ...grpc.CallOption) (*CreateMealPlanOptionVoteResponse, error)
 	CreateMealPlanRecipeOptionSelection(ctx context.Context, in *CreateMealPlanRecipeOptionSelectionRequest, opts ...grpc.CallOption) (*CreateMealPlanRecipeOptionSelectionResponse, error)
 	CreateMealPlanTask(ctx context.Context, in *CreateMealPlanTaskRequest, opts ...grpc.CallOption) (*CreateMealPlanTaskResponse, error)
+	CreateMealPlanTemplate(ctx context.Context, in *CreateMealPlanTemplateRequest, opts ...grpc.CallOption) (*CreateMealPlanTemplateResponse, error)
 	CreateMealList(ctx context.Context, in *CreateMealListRequest, opts ...grpc.CallOption) (*CreateMealListResponse, error)
 	CreateMealListItem(ctx context.Context, in *CreateMealListItemRequest, opts ...grpc.CallOption) (*CreateMealListItemResponse, error)
 	CreateRecipe(ctx context.Context, in *CreateRecipeRequest, opts ...grpc.CallOption) (*CreateRecipeResponse, error)
@@ -314,6 +348,7 @@ type MealPlanningServiceClient interface {
 	CreateValidIngredient(ctx context.Context, in *CreateValidIngredientRequest, opts ...grpc.CallOption) (*CreateValidIngredientResponse, error)
 	CreateValidIngredientGroup(ctx context.Context, in *CreateValidIngredientGroupRequest, opts ...grpc.CallOption) (*CreateValidIngredientGroupResponse, error)
 	CreateValidIngredientMeasurementUnit(ctx context.Context, in *CreateValidIngredientMeasurementUnitRequest, opts ...grpc.CallOption) (*CreateValidIngredientMeasurementUnitResponse, error)
+	CreateValidIngredientNutritionFacts(ctx context.Context, in *CreateValidIngredientNutritionFactsRequest, opts ...grpc.CallOption) (*CreateValidIngredientNutritionFactsResponse, error)
 	CreateValidIngredientPreparation(ctx context.Context, in *CreateValidIngredientPreparationRequest, opts ...grpc.CallOption) (*CreateValidIngredientPreparationResponse, error)
 	CreateValidPrepTaskConfig(ctx context.Context, in *CreateValidPrepTaskConfigRequest, opts ...grpc.CallOption) (*CreateValidPrepTaskConfigResponse, error)
 	CreateValidIngredientState(ctx context.Context, in *CreateValidIngredientStateRequest, opts ...grpc.CallOption) (*CreateValidIngredientStateResponse, error)
@@ -325,10 +360,13 @@ type MealPlanningServiceClient interface {
 	CreateValidPreparationInstrument(ctx context.Context, in *CreateValidPreparationInstrumentRequest, opts ...grpc.CallOption) (*CreateValidPreparationInstrumentResponse, error)
 	CreateValidPreparationVessel(ctx context.Context, in *CreateValidPreparationVesselRequest, opts ...grpc.CallOption) (*CreateValidPreparationVesselResponse, error)
 	CreateValidVessel(ctx context.Context, in *CreateValidVesselRequest, opts ...grpc.CallOption) (*CreateValidVesselResponse, error)
+	CompleteMealPlanOption(ctx context.Context, in *CompleteMealPlanOptionRequest, opts ...grpc.CallOption) (*CompleteMealPlanOptionResponse, error)
+	DiffRecipeRevisions(ctx context.Context, in *DiffRecipeRevisionsRequest, opts ...grpc.CallOption) (*DiffRecipeRevisionsResponse, error)
 	FinalizeMealPlan(ctx context.Context, in *FinalizeMealPlanRequest, opts ...grpc.CallOption) (*FinalizeMealPlanResponse, error)
 	GetMeal(ctx context.Context, in *GetMealRequest, opts ...grpc.CallOption) (*GetMealResponse, error)
 	GetMealPlan(ctx context.Context, in *GetMealPlanRequest, opts ...grpc.CallOption) (*GetMealPlanResponse, error)
 	GetMealPlanEvent(ctx context.Context, in *GetMealPlanEventRequest, opts ...grpc.CallOption) (*GetMealPlanEventResponse, error)
+	GetMealPlanEventTallyReport(ctx context.Context, in *GetMealPlanEventTallyReportRequest, opts ...grpc.CallOption) (*GetMealPlanEventTallyReportResponse, error)
 	GetMealPlanEvents(ctx context.Context, in *GetMealPlanEventsRequest, opts ...grpc.CallOption) (*GetMealPlanEventsResponse, error)
 	GetMealPlanGroceryListItem(ctx context.Context, in *GetMealPlanGroceryListItemRequest, opts ...grpc.CallOption) (*GetMealPlanGroceryListItemResponse, error)
 	GetMealPlanGroceryListItemsForMealPlan(ctx context.Context, in *GetMealPlanGroceryListItemsForMealPlanRequest, opts ...grpc.CallOption) (*GetMealPlanGroceryListItemsForMealPlanResponse, error)
@@ -340,9 +378,15 @@ type MealPlanningServiceClient interface {
 	GetMealPlanRecipeOptionSelectionsForMealPlanOption(ctx context.Context, in *GetMealPlanRecipeOptionSelectionsForMealPlanOptionRequest, opts ...grpc.CallOption) (*GetMealPlanRecipeOptionSelectionsForMealPlanOptionResponse, error)
 	GetMealPlanTask(ctx context.Context, in *GetMealPlanTaskRequest, opts ...grpc.CallOption) (*GetMealPlanTaskResponse, error)
 	GetMealPlanTasks(ctx context.Context, in *GetMealPlanTasksRequest, opts ...grpc.CallOption) (*GetMealPlanTasksResponse, error)
+	GetMealPlanTemplate(ctx context.Context, in *GetMealPlanTemplateRequest, opts ...grpc.CallOption) (*GetMealPlanTemplateResponse, error)
+	GetMealPlanTemplates(ctx context.Context, in *GetMealPlanTemplatesRequest, opts ...grpc.CallOption) (*GetMealPlanTemplatesResponse, error)
 	GetMealPlansForAccount(ctx context.Context, in *GetMealPlansForAccountRequest, opts ...grpc.CallOption) (*GetMealPlansForAccountResponse, error)
 	GetMealLists(ctx context.Context, in *GetMealListsRequest, opts ...grpc.CallOption) (*GetMealListsResponse, error)
 	GetMeals(ctx context.Context, in *GetMealsRequest, opts ...grpc.CallOption) (*GetMealsResponse, error)
+	GetCookTimelineForMealPlanOption(ctx context.Context, in *GetCookTimelineForMealPlanOptionRequest, opts ...grpc.CallOption) (*GetCookTimelineForMealPlanOptionResponse, error)
+	GetNutritionForMeal(ctx context.Context, in *GetNutritionForMealRequest, opts ...grpc.CallOption) (*GetNutritionForMealResponse, error)
+	GetNutritionForMealPlan(ctx context.Context, in *GetNutritionForMealPlanRequest, opts ...grpc.CallOption) (*GetNutritionForMealPlanResponse, error)
+	GetNutritionForRecipe(ctx context.Context, in *GetNutritionForRecipeRequest, opts ...grpc.CallOption) (*GetNutritionForRecipeResponse, error)
 	GetMermaidDiagramForMeal(ctx context.Context, in *GetMermaidDiagramForMealRequest, opts ...grpc.CallOption) (*GetMermaidDiagramForMealResponse, error)
 	GetMermaidDiagramForRecipe(ctx context.Context, in *GetMermaidDiagramForRecipeRequest, opts ...grpc.CallOption) (*GetMermaidDiagramForRecipeResponse, error)
 	GetRandomValidIngredient(ctx context.Context, in *GetRandomValidIngredientRequest, opts ...grpc.CallOption) (*GetRandomValidIngredientResponse, error)
@@ -355,6 +399,8 @@ type MealPlanningServiceClient interface {
 	GetRecipePrepTasks(ctx context.Context, in *GetRecipePrepTasksRequest, opts ...grpc.CallOption) (*GetRecipePrepTasksResponse, error)
 	GetRecipeRating(ctx context.Context, in *GetRecipeRatingRequest, opts ...grpc.CallOption) (*GetRecipeRatingResponse, error)
 	GetRecipeRatingsForRecipe(ctx context.Context, in *GetRecipeRatingsForRecipeRequest, opts ...grpc.CallOption) (*GetRecipeRatingsForRecipeResponse, error)
+	GetRecipeRevision(ctx context.Context, in *GetRecipeRevisionRequest, opts ...grpc.CallOption) (*GetRecipeRevisionResponse, error)
+	GetRecipeRevisions(ctx context.Context, in *GetRecipeRevisionsRequest, opts ...grpc.CallOption) (*GetRecipeRevisionsResponse, error)
 	GetRecipeStep(ctx context.Context, in *GetRecipeStepRequest, opts ...grpc.CallOption) (*GetRecipeStepResponse, error)
 	GetRecipeStepCompletionCondition(ctx context.Context, in *GetRecipeStepCompletionConditionRequest, opts ...grpc.CallOption) (*GetRecipeStepCompletionConditionResponse, error)
 	GetRecipeStepCompletionConditions(ctx context.Context, in *GetRecipeStepCompletionConditionsRequest, opts ...grpc.CallOption) (*GetRecipeStepCompletionConditionsResponse, error)
@@ -376,6 +422,7 @@ type MealPlanningServiceClient interface {
 	GetValidIngredientMeasurementUnits(ctx context.Context, in *GetValidIngredientMeasurementUnitsRequest, opts ...grpc.CallOption) (*GetValidIngredientMeasurementUnitsResponse, error)
 	GetValidIngredientMeasurementUnitsByIngredient(ctx context.Context, in *GetValidIngredientMeasurementUnitsByIngredientRequest, opts ...grpc.CallOption) (*GetValidIngredientMeasurementUnitsByIngredientResponse, error)
 	GetValidIngredientMeasurementUnitsByMeasurementUnit(ctx context.Context, in *GetValidIngredientMeasurementUnitsByMeasurementUnitRequest, opts ...grpc.CallOption) (*GetValidIngredientMeasurementUnitsByMeasurementUnitResponse, error)
+	GetValidIngredientNutritionFacts(ctx context.Context, in *GetValidIngredientNutritionFactsRequest, opts ...grpc.CallOption) (*GetValidIngredientNutritionFactsResponse, error)
 	GetValidIngredientPreparation(ctx context.Context, in *GetValidIngredientPreparationRequest, opts ...grpc.CallOption) (*GetValidIngredientPreparationResponse, error)
 	GetValidIngredientPreparations(ctx context.Context, in *GetValidIngredientPreparationsRequest, opts ...grpc.CallOption) (*GetValidIngredientPreparationsResponse, error)
 	GetValidIngredientPreparationsByIngredient(ctx context.Context, in *GetValidIngredientPreparationsByIngredientRequest, opts ...grpc.CallOption) (*GetValidIngredientPreparationsByIngredientResponse, error)
@@ -412,6 +459,7 @@ type MealPlanningServiceClient interface {
 	GetValidPreparations(ctx context.Context, in *GetValidPreparationsRequest, opts ...grpc.CallOption) (*GetValidPreparationsResponse, error)
 	GetValidVessel(ctx context.Context, in *GetValidVesselRequest, opts ...grpc.CallOption) (*GetValidVesselResponse, error)
 	GetValidVessels(ctx context.Context, in *GetValidVesselsRequest, opts ...grpc.CallOption) (*GetValidVesselsResponse, error)
+	RestoreRecipeRevision(ctx context.Context, in *RestoreRecipeRevisionRequest, opts ...grpc.CallOption) (*RestoreRecipeRevisionResponse, error)
 	RunFinalizeMealPlanWorker(ctx context.Context, in *RunFinalizeMealPlanWorkerRequest, opts ...grpc.CallOption) (*RunFinalizeMealPlanWorkerResponse, error)
 	RunMealPlanGroceryListInitializerWorker(ctx context.Context, in *RunMealPlanGroceryListInitializerWorkerRequest, opts ...grpc.CallOption) (*RunMealPlanGroceryListInitializerWorkerResponse, error)
 	RunMealPlanTaskCreatorWorker(ctx context.Context, in *RunMealPlanTaskCreatorWorkerRequest, opts ...grpc.CallOption) (*RunMealPlanTaskCreatorWorkerResponse, error)
@@ -436,6 +484,7 @@ type MealPlanningServiceClient interface {
 	UpdateMealPlanOptionVote(ctx context.Context, in *UpdateMealPlanOptionVoteRequest, opts ...grpc.CallOption) (*UpdateMealPlanOptionVoteResponse, error)
 	UpdateMealPlanRecipeOptionSelection(ctx context.Context, in *UpdateMealPlanRecipeOptionSelectionRequest, opts ...grpc.CallOption) (*UpdateMealPlanRecipeOptionSelectionResponse, error)
 	UpdateMealPlanTaskStatus(ctx context.Context, in *UpdateMealPlanTaskStatusRequest, opts ...grpc.CallOption) (*UpdateMealPlanTaskStatusResponse, error)
+	UpdateMealPlanTemplate(ctx context.Context, in *UpdateMealPlanTemplateRequest, opts ...grpc.CallOption) (*UpdateMealPlanTemplateResponse, error)
 	UpdateMealList(ctx context.Context, in *UpdateMealListRequest, opts ...grpc.CallOption) (*UpdateMealListResponse, error)
 	UpdateMealListItem(ctx context.Context, in *UpdateMealListItemRequest, opts ...grpc.CallOption) (*UpdateMealListItemResponse, error)
 	UpdateRecipe(ctx context.Context, in *UpdateRecipeRequest, opts ...grpc.CallOption) (*UpdateRecipeResponse, error)
@@ -453,6 +502,7 @@ type MealPlanningServiceClient interface {
 	UpdateValidIngredient(ctx context.Context, in *UpdateValidIngredientRequest, opts ...grpc.CallOption) (*UpdateValidIngredientResponse, error)
 	UpdateValidIngredientGroup(ctx context.Context, in *UpdateValidIngredientGroupRequest, opts ...grpc.CallOption) (*UpdateValidIngredientGroupResponse, error)
 	UpdateValidIngredientMeasurementUnit(ctx context.Context, in *UpdateValidIngredientMeasurementUnitRequest, opts ...grpc.CallOption) (*UpdateValidIngredientMeasurementUnitResponse, error)
+	UpdateValidIngredientNutritionFacts(ctx context.Context, in *UpdateValidIngredientNutritionFactsRequest, opts ...grpc.CallOption) (*UpdateValidIngredientNutritionFactsResponse, error)
 	UpdateValidIngredientPreparation(ctx context.Context, in *UpdateValidIngredientPreparationRequest, opts ...grpc.CallOption) (*UpdateValidIngredientPreparationResponse, error)
 	UpdateValidPrepTaskConfig(ctx context.Context, in *UpdateValidPrepTaskConfigRequest, opts ...grpc.CallOption) (*UpdateValidPrepTaskConfigResponse, error)
 	UpdateValidIngredientState(ctx context.Context, in *UpdateValidIngredientStateRequest, opts ...grpc.CallOption) (*UpdateValidIngredientStateResponse, error)
@@ -474,12 +524,20 @@ type MealPlanningServiceClient interface {
 	GetUserIngredientPreference(ctx context.Context, in *GetUserIngredientPreferenceRequest, opts ...grpc.CallOption) (*GetUserIngredientPreferenceResponse, error)
 	GetUserIngredientPreferences(ctx context.Context, in *GetUserIngredientPreferencesRequest, opts ...grpc.CallOption) (*GetUserIngredientPreferencesResponse, error)
 	UpdateAccountInstrumentOwnership(ctx context.Context, in *UpdateAccountInstrumentOwnershipRequest, opts ...grpc.CallOption) (*UpdateAccountInstrumentOwnershipResponse, error)
+	ArchivePantryItem(ctx context.Context, in *ArchivePantryItemRequest, opts ...grpc.CallOption) (*ArchivePantryItemResponse, error)
+	CreatePantryItem(ctx context.Context, in *CreatePantryItemRequest, opts ...grpc.CallOption) (*CreatePantryItemResponse, error)
+	GetPantryItem(ctx context.Context, in *GetPantryItemRequest, opts ...grpc.CallOption) (*GetPantryItemResponse, error)
+	GetPantryItems(ctx context.Context, in *GetPantryItemsRequest, opts ...grpc.CallOption) (*GetPantryItemsResponse, error)
+	UpdatePantryItem(ctx context.Context, in *UpdatePantryItemRequest, opts ...grpc.CallOption) (*UpdatePantryItemResponse, error)
 	UpdateUserIngredientPreference(ctx context.Context, in *UpdateUserIngredientPreferenceRequest, opts ...grpc.CallOption) (*UpdateUserIngredientPreferenceResponse, error)
 	UploadMealImage(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadMealMediaRequest, UploadMealImageResponse], error)
 	UploadRecipeImage(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadRecipeMediaRequest, UploadRecipeImageResponse], error)
 	UploadPreparationMedia(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadPreparationMediaRequest, UploadPreparationMediaResponse], error)
 	UploadIngredientMedia(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadIngredientMediaRequest, UploadIngredientMediaResponse], error)
 	UploadRecipeStepImage(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[UploadRecipeStepImageRequest, UploadRecipeStepImageResponse], error)
+	ExportMealPlanCalendar(ctx context.Context, in *ExportMealPlanCalendarRequest, opts ...grpc.CallOption) (*ExportMealPlanCalendarResponse, error)
+	WatchMealPlan(ctx context.Context, in *WatchMealPlanRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchMealPlanResponse], error)
+	WatchMealPlanTasks(ctx context.Context, in *WatchMealPlanTasksRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchMealPlanTasksResponse], error)
 }
 
 type mealPlanningServiceClient struct {
@@ -590,6 +648,16 @@ func (c *mealPlanningServiceClient) ArchiveMealPlanRecipeOptionSelection(ctx con
 	return out, nil
 }
 
+func (c *mealPlanningServiceClient) ArchiveMealPlanTemplate(ctx context.Context, in *ArchiveMealPlanTemplateRequest, opts ...grpc.CallOption) (*ArchiveMealPlanTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ArchiveMealPlanTemplateResponse)
+	err := c.cc.Invoke(ctx, MealPlanningService_ArchiveMealPlanTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mealPlanningServiceClient) ArchiveMealList(ctx context.Context, in *ArchiveMealListRequest, opts ...grpc.CallOption) (*ArchiveMealListResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ArchiveMealListResponse)
@@ -750,6 +818,16 @@ func (c *mealPlanningServiceClient) ArchiveValidIngredientMeasurementUnit(ctx co
 	return out, nil
 }
 
+func (c *mealPlanningServiceClient) ArchiveValidIngredientNutritionFacts(ctx context.Context, in *ArchiveValidIngredientNutritionFactsRequest, opts ...grpc.CallOption) (*ArchiveValidIngredientNutritionFactsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ArchiveValidIngredientNutritionFactsResponse)
+	err := c.cc.Invoke(ctx, MealPlanningService_ArchiveValidIngredientNutritionFacts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mealPlanningServiceClient) ArchiveValidIngredientPreparation(ctx context.Context, in *ArchiveValidIngredientPreparationRequest, opts ...grpc.CallOption) (*ArchiveValidIngredientPreparationResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(ArchiveValidIngredientPreparationResponse)
@@ -870,6 +948,16 @@ func (c *mealPlanningServiceClient) CloneRecipe(ctx context.Context, in *CloneRe
 	return out, nil
 }
 
+func (c *mealPlanningServiceClient) ImportRecipe(ctx context.Context, in *ImportRecipeRequest, opts ...grpc.CallOption) (*ImportRecipeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ImportRecipeResponse)
+	err := c.cc.Invoke(ctx, MealPlanningService_ImportRecipe_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mealPlanningServiceClient) CreateMeal(ctx context.Context, in *CreateMealRequest, opts ...grpc.CallOption) (*CreateMealResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateMealResponse)
@@ -890,6 +978,16 @@ func (c *mealPlanningServiceClient) CreateMealPlan(ctx context.Context, in *Crea
 	return out, nil
 }
 
+func (c *mealPlanningServiceClient) CreateMealPlanCalendarFeed(ctx context.Context, in *CreateMealPlanCalendarFeedRequest, opts ...grpc.CallOption) (*CreateMealPlanCalendarFeedResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateMealPlanCalendarFeedResponse)
+	err := c.cc.Invoke(ctx, MealPlanningService_CreateMealPlanCalendarFeed_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mealPlanningServiceClient) CreateMealPlanEvent(ctx context.Context, in *CreateMealPlanEventRequest, opts ...grpc.CallOption) (*CreateMealPlanEventResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateMealPlanEventResponse)
@@ -940,6 +1038,16 @@ func (c *mealPlanningServiceClient) CreateMealPlanTask(ctx context.Context, in *
 	return out, nil
 }
 
+func (c *mealPlanningServiceClient) CreateMealPlanTemplate(ctx context.Context, in *CreateMealPlanTemplateRequest, opts ...grpc.CallOption) (*CreateMealPlanTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateMealPlanTemplateResponse)
+	err := c.cc.Invoke(ctx, MealPlanningService_CreateMealPlanTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mealPlanningServiceClient) CreateMealList(ctx context.Context, in *CreateMealListRequest, opts ...grpc.CallOption) (*CreateMealListResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateMealListResponse)
@@ -1100,6 +1208,16 @@ func (c *mealPlanningServiceClient) CreateValidIngredientMeasurementUnit(ctx con
 	return out, nil
 }
 
+func (c *mealPlanningServiceClient) CreateValidIngredientNutritionFacts(ctx context.Context, in *CreateValidIngredientNutritionFactsRequest, opts ...grpc.CallOption) (*CreateValidIngredientNutritionFactsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateValidIngredientNutritionFactsResponse)
+	err := c.cc.Invoke(ctx, MealPlanningService_CreateValidIngredientNutritionFacts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mealPlanningServiceClient) CreateValidIngredientPreparation(ctx context.Context, in *CreateValidIngredientPreparationRequest, opts ...grpc.CallOption) (*CreateValidIngredientPreparationResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(CreateValidIngredientPreparationResponse)
@@ -1210,6 +1328,26 @@ func (c *mealPlanningServiceClient) CreateValidVessel(ctx context.Context, in *C
 	return out, nil
 }
 
+func (c *mealPlanningServiceClient) CompleteMealPlanOption(ctx context.Context, in *CompleteMealPlanOptionRequest, opts ...grpc.CallOption) (*CompleteMealPlanOptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CompleteMealPlanOptionResponse)
+	err := c.cc.Invoke(ctx, MealPlanningService_CompleteMealPlanOption_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mealPlanningServiceClient) DiffRecipeRevisions(ctx context.Context, in *DiffRecipeRevisionsRequest, opts ...grpc.CallOption) (*DiffRecipeRevisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DiffRecipeRevisionsResponse)
+	err := c.cc.Invoke(ctx, MealPlanningService_DiffRecipeRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mealPlanningServiceClient) FinalizeMealPlan(ctx context.Context, in *FinalizeMealPlanRequest, opts ...grpc.CallOption) (*FinalizeMealPlanResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(FinalizeMealPlanResponse)
@@ -1250,6 +1388,16 @@ func (c *mealPlanningServiceClient) GetMealPlanEvent(ctx context.Context, in *Ge
 	return out, nil
 }
 
+func (c *mealPlanningServiceClient) GetMealPlanEventTallyReport(ctx context.Context, in *GetMealPlanEventTallyReportRequest, opts ...grpc.CallOption) (*GetMealPlanEventTallyReportResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMealPlanEventTallyReportResponse)
+	err := c.cc.Invoke(ctx, MealPlanningService_GetMealPlanEventTallyReport_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mealPlanningServiceClient) GetMealPlanEvents(ctx context.Context, in *GetMealPlanEventsRequest, opts ...grpc.CallOption) (*GetMealPlanEventsResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetMealPlanEventsResponse)
@@ -1360,6 +1508,26 @@ func (c *mealPlanningServiceClient) GetMealPlanTasks(ctx context.Context, in *Ge
 	return out, nil
 }
 
+func (c *mealPlanningServiceClient) GetMealPlanTemplate(ctx context.Context, in *GetMealPlanTemplateRequest, opts ...grpc.CallOption) (*GetMealPlanTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMealPlanTemplateResponse)
+	err := c.cc.Invoke(ctx, MealPlanningService_GetMealPlanTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mealPlanningServiceClient) GetMealPlanTemplates(ctx context.Context, in *GetMealPlanTemplatesRequest, opts ...grpc.CallOption) (*GetMealPlanTemplatesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetMealPlanTemplatesResponse)
+	err := c.cc.Invoke(ctx, MealPlanningService_GetMealPlanTemplates_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mealPlanningServiceClient) GetMealPlansForAccount(ctx context.Context, in *GetMealPlansForAccountRequest, opts ...grpc.CallOption) (*GetMealPlansForAccountResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetMealPlansForAccountResponse)
@@ -1390,6 +1558,46 @@ func (c *mealPlanningServiceClient) GetMeals(ctx context.Context, in *GetMealsRe
 	return out, nil
 }
 
+func (c *mealPlanningServiceClient) GetCookTimelineForMealPlanOption(ctx context.Context, in *GetCookTimelineForMealPlanOptionRequest, opts ...grpc.CallOption) (*GetCookTimelineForMealPlanOptionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetCookTimelineForMealPlanOptionResponse)
+	err := c.cc.Invoke(ctx, MealPlanningService_GetCookTimelineForMealPlanOption_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mealPlanningServiceClient) GetNutritionForMeal(ctx context.Context, in *GetNutritionForMealRequest, opts ...grpc.CallOption) (*GetNutritionForMealResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetNutritionForMealResponse)
+	err := c.cc.Invoke(ctx, MealPlanningService_GetNutritionForMeal_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mealPlanningServiceClient) GetNutritionForMealPlan(ctx context.Context, in *GetNutritionForMealPlanRequest, opts ...grpc.CallOption) (*GetNutritionForMealPlanResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetNutritionForMealPlanResponse)
+	err := c.cc.Invoke(ctx, MealPlanningService_GetNutritionForMealPlan_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mealPlanningServiceClient) GetNutritionForRecipe(ctx context.Context, in *GetNutritionForRecipeRequest, opts ...grpc.CallOption) (*GetNutritionForRecipeResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetNutritionForRecipeResponse)
+	err := c.cc.Invoke(ctx, MealPlanningService_GetNutritionForRecipe_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mealPlanningServiceClient) GetMermaidDiagramForMeal(ctx context.Context, in *GetMermaidDiagramForMealRequest, opts ...grpc.CallOption) (*GetMermaidDiagramForMealResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetMermaidDiagramForMealResponse)
@@ -1510,6 +1718,26 @@ func (c *mealPlanningServiceClient) GetRecipeRatingsForRecipe(ctx context.Contex
 	return out, nil
 }
 
+func (c *mealPlanningServiceClient) GetRecipeRevision(ctx context.Context, in *GetRecipeRevisionRequest, opts ...grpc.CallOption) (*GetRecipeRevisionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRecipeRevisionResponse)
+	err := c.cc.Invoke(ctx, MealPlanningService_GetRecipeRevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mealPlanningServiceClient) GetRecipeRevisions(ctx context.Context, in *GetRecipeRevisionsRequest, opts ...grpc.CallOption) (*GetRecipeRevisionsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetRecipeRevisionsResponse)
+	err := c.cc.Invoke(ctx, MealPlanningService_GetRecipeRevisions_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mealPlanningServiceClient) GetRecipeStep(ctx context.Context, in *GetRecipeStepRequest, opts ...grpc.CallOption) (*GetRecipeStepResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetRecipeStepResponse)
@@ -1720,6 +1948,16 @@ func (c *mealPlanningServiceClient) GetValidIngredientMeasurementUnitsByMeasurem
 	return out, nil
 }
 
+func (c *mealPlanningServiceClient) GetValidIngredientNutritionFacts(ctx context.Context, in *GetValidIngredientNutritionFactsRequest, opts ...grpc.CallOption) (*GetValidIngredientNutritionFactsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetValidIngredientNutritionFactsResponse)
+	err := c.cc.Invoke(ctx, MealPlanningService_GetValidIngredientNutritionFacts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mealPlanningServiceClient) GetValidIngredientPreparation(ctx context.Context, in *GetValidIngredientPreparationRequest, opts ...grpc.CallOption) (*GetValidIngredientPreparationResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(GetValidIngredientPreparationResponse)
@@ -2080,6 +2318,16 @@ func (c *mealPlanningServiceClient) GetValidVessels(ctx context.Context, in *Get
 	return out, nil
 }
 
+func (c *mealPlanningServiceClient) RestoreRecipeRevision(ctx context.Context, in *RestoreRecipeRevisionRequest, opts ...grpc.CallOption) (*RestoreRecipeRevisionResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RestoreRecipeRevisionResponse)
+	err := c.cc.Invoke(ctx, MealPlanningService_RestoreRecipeRevision_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mealPlanningServiceClient) RunFinalizeMealPlanWorker(ctx context.Context, in *RunFinalizeMealPlanWorkerRequest, opts ...grpc.CallOption) (*RunFinalizeMealPlanWorkerResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(RunFinalizeMealPlanWorkerResponse)
@@ -2320,6 +2568,16 @@ func (c *mealPlanningServiceClient) UpdateMealPlanTaskStatus(ctx context.Context
 	return out, nil
 }
 
+func (c *mealPlanningServiceClient) UpdateMealPlanTemplate(ctx context.Context, in *UpdateMealPlanTemplateRequest, opts ...grpc.CallOption) (*UpdateMealPlanTemplateResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateMealPlanTemplateResponse)
+	err := c.cc.Invoke(ctx, MealPlanningService_UpdateMealPlanTemplate_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mealPlanningServiceClient) UpdateMealList(ctx context.Context, in *UpdateMealListRequest, opts ...grpc.CallOption) (*UpdateMealListResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UpdateMealListResponse)
@@ -2490,6 +2748,16 @@ func (c *mealPlanningServiceClient) UpdateValidIngredientMeasurementUnit(ctx con
 	return out, nil
 }
 
+func (c *mealPlanningServiceClient) UpdateValidIngredientNutritionFacts(ctx context.Context, in *UpdateValidIngredientNutritionFactsRequest, opts ...grpc.CallOption) (*UpdateValidIngredientNutritionFactsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateValidIngredientNutritionFactsResponse)
+	err := c.cc.Invoke(ctx, MealPlanningService_UpdateValidIngredientNutritionFacts_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mealPlanningServiceClient) UpdateValidIngredientPreparation(ctx context.Context, in *UpdateValidIngredientPreparationRequest, opts ...grpc.CallOption) (*UpdateValidIngredientPreparationResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UpdateValidIngredientPreparationResponse)
@@ -2700,6 +2968,56 @@ func (c *mealPlanningServiceClient) UpdateAccountInstrumentOwnership(ctx context
 	return out, nil
 }
 
+func (c *mealPlanningServiceClient) ArchivePantryItem(ctx context.Context, in *ArchivePantryItemRequest, opts ...grpc.CallOption) (*ArchivePantryItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ArchivePantryItemResponse)
+	err := c.cc.Invoke(ctx, MealPlanningService_ArchivePantryItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mealPlanningServiceClient) CreatePantryItem(ctx context.Context, in *CreatePantryItemRequest, opts ...grpc.CallOption) (*CreatePantryItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreatePantryItemResponse)
+	err := c.cc.Invoke(ctx, MealPlanningService_CreatePantryItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mealPlanningServiceClient) GetPantryItem(ctx context.Context, in *GetPantryItemRequest, opts ...grpc.CallOption) (*GetPantryItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPantryItemResponse)
+	err := c.cc.Invoke(ctx, MealPlanningService_GetPantryItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mealPlanningServiceClient) GetPantryItems(ctx context.Context, in *GetPantryItemsRequest, opts ...grpc.CallOption) (*GetPantryItemsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPantryItemsResponse)
+	err := c.cc.Invoke(ctx, MealPlanningService_GetPantryItems_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mealPlanningServiceClient) UpdatePantryItem(ctx context.Context, in *UpdatePantryItemRequest, opts ...grpc.CallOption) (*UpdatePantryItemResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdatePantryItemResponse)
+	err := c.cc.Invoke(ctx, MealPlanningService_UpdatePantryItem_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *mealPlanningServiceClient) UpdateUserIngredientPreference(ctx context.Context, in *UpdateUserIngredientPreferenceRequest, opts ...grpc.CallOption) (*UpdateUserIngredientPreferenceResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(UpdateUserIngredientPreferenceResponse)
@@ -2775,6 +3093,54 @@ func (c *mealPlanningServiceClient) UploadRecipeStepImage(ctx context.Context, o
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type MealPlanningService_UploadRecipeStepImageClient = grpc.ClientStreamingClient[UploadRecipeStepImageRequest, UploadRecipeStepImageResponse]
 
+func (c *mealPlanningServiceClient) ExportMealPlanCalendar(ctx context.Context, in *ExportMealPlanCalendarRequest, opts ...grpc.CallOption) (*ExportMealPlanCalendarResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ExportMealPlanCalendarResponse)
+	err := c.cc.Invoke(ctx, MealPlanningService_ExportMealPlanCalendar_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *mealPlanningServiceClient) WatchMealPlan(ctx context.Context, in *WatchMealPlanRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchMealPlanResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MealPlanningService_ServiceDesc.Streams[5], MealPlanningService_WatchMealPlan_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchMealPlanRequest, WatchMealPlanResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MealPlanningService_WatchMealPlanClient = grpc.ServerStreamingClient[WatchMealPlanResponse]
+
+func (c *mealPlanningServiceClient) WatchMealPlanTasks(ctx context.Context, in *WatchMealPlanTasksRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[WatchMealPlanTasksResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &MealPlanningService_ServiceDesc.Streams[6], MealPlanningService_WatchMealPlanTasks_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchMealPlanTasksRequest, WatchMealPlanTasksResponse]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MealPlanningService_WatchMealPlanTasksClient = grpc.ServerStreamingClient[WatchMealPlanTasksResponse]
+
 // MealPlanningServiceServer is the server API for MealPlanningService service.
 // All implementations must embed UnimplementedMealPlanningServiceServer
 // for forward compatibility.
@@ -2789,6 +3155,7 @@ type MealPlanningServiceServer interface {
 	ArchiveMealPlanOption(context.Context, *ArchiveMealPlanOptionRequest) (*ArchiveMealPlanOptionResponse, error)
 	ArchiveMealPlanOptionVote(context.Context, *ArchiveMealPlanOptionVoteRequest) (*ArchiveMealPlanOptionVoteResponse, error)
 	ArchiveMealPlanRecipeOptionSelection(context.Context, *ArchiveMealPlanRecipeOptionSelectionRequest) (*ArchiveMealPlanRecipeOptionSelectionResponse, error)
+	ArchiveMealPlanTemplate(context.Context, *ArchiveMealPlanTemplateRequest) (*ArchiveMealPlanTemplateResponse, error)
 	ArchiveMealList(context.Context, *ArchiveMealListRequest) (*ArchiveMealListResponse, error)
 	ArchiveMealListItem(context.Context, *ArchiveMealListItemRequest) (*ArchiveMealListItemResponse, error)
 	ArchiveRecipe(context.Context, *ArchiveRecipeRequest) (*ArchiveRecipeResponse, error)
@@ -2805,6 +3172,7 @@ type MealPlanningServiceServer interface {
 	ArchiveValidIngredient(context.Context, *ArchiveValidIngredientRequest) (*ArchiveValidIngredientResponse, error)
 	ArchiveValidIngredientGroup(context.Context, *ArchiveValidIngredientGroupRequest) (*ArchiveValidIngredientGroupResponse, error)
 	ArchiveValidIngredientMeasurementUnit(context.Context, *ArchiveValidIngredientMeasurementUnitRequest) (*ArchiveValidIngredientMeasurementUnitResponse, error)
+	ArchiveValidIngredientNutritionFacts(context.Context, *ArchiveValidIngredientNutritionFactsRequest) (*ArchiveValidIngredientNutritionFactsResponse, error)
 	ArchiveValidIngredientPreparation(context.Context, *ArchiveValidIngredientPreparationRequest) (*ArchiveValidIngredientPreparationResponse, error)
 	ArchiveValidPrepTaskConfig(context.Context, *ArchiveValidPrepTaskConfigRequest) (*ArchiveValidPrepTaskConfigResponse, error)
 	ArchiveValidIngredientState(context.Context, *ArchiveValidIngredientStateRequest) (*ArchiveValidIngredientStateResponse, error)
@@ -2817,13 +3185,16 @@ type MealPlanningServiceServer interface {
 	ArchiveValidPreparationVessel(context.Context, *ArchiveValidPreparationVesselRequest) (*ArchiveValidPreparationVesselResponse, error)
 	ArchiveValidVessel(context.Context, *ArchiveValidVesselRequest) (*ArchiveValidVesselResponse, error)
 	CloneRecipe(context.Context, *CloneRecipeRequest) (*CloneRecipeResponse, error)
+	ImportRecipe(context.Context, *ImportRecipeRequest) (*ImportRecipeResponse, error)
 	CreateMeal(context.Context, *CreateMealRequest) (*CreateMealResponse, error)
 	CreateMealPlan(context.Context, *CreateMealPlanRequest) (*CreateMealPlanResponse, error)
+	CreateMealPlanCalendarFeed(context.Context, *CreateMealPlanCalendarFeedRequest) (*CreateMealPlanCalendarFeedResponse, error)
 	CreateMealPlanEvent(context.Context, *CreateMealPlanEventRequest) (*CreateMealPlanEventResponse, error)
 	CreateMealPlanOption(context.Context, *CreateMealPlanOptionRequest) (*CreateMealPlanOptionResponse, error)
 	CreateMealPlanOptionVote(context.Context, *CreateMealPlanOptionVoteRequest) (*CreateMealPlanOptionVoteResponse, error)
 	CreateMealPlanRecipeOptionSelection(context.Context, *CreateMealPlanRecipeOptionSelectionRequest) (*CreateMealPlanRecipeOptionSelectionResponse, error)
 	CreateMealPlanTask(context.Context, *CreateMealPlanTaskRequest) (*CreateMealPlanTaskResponse, error)
+	CreateMealPlanTemplate(context.Context, *CreateMealPlanTemplateRequest) (*CreateMealPlanTemplateResponse, error)
 	CreateMealList(context.Context, *CreateMealListRequest) (*CreateMealListResponse, error)
 	CreateMealListItem(context.Context, *CreateMealListItemRequest) (*CreateMealListItemResponse, error)
 	CreateRecipe(context.Context, *CreateRecipeRequest) (*CreateRecipeResponse, error)
@@ -2840,6 +3211,7 @@ type MealPlanningServiceServer interface {
 	CreateValidIngredient(context.Context, *CreateValidIngredientRequest) (*CreateValidIngredientResponse, error)
 	CreateValidIngredientGroup(context.Context, *CreateValidIngredientGroupRequest) (*CreateValidIngredientGroupResponse, error)
 	CreateValidIngredientMeasurementUnit(context.Context, *CreateValidIngredientMeasurementUnitRequest) (*CreateValidIngredientMeasurementUnitResponse, error)
+	CreateValidIngredientNutritionFacts(context.Context, *CreateValidIngredientNutritionFactsRequest) (*CreateValidIngredientNutritionFactsResponse, error)
 	CreateValidIngredientPreparation(context.Context, *CreateValidIngredientPreparationRequest) (*CreateValidIngredientPreparationResponse, error)
 	CreateValidPrepTaskConfig(context.Context, *CreateValidPrepTaskConfigRequest) (*CreateValidPrepTaskConfigResponse, error)
 	CreateValidIngredientState(context.Context, *CreateValidIngredientStateRequest) (*CreateValidIngredientStateResponse, error)
@@ -2851,10 +3223,13 @@ type MealPlanningServiceServer interface {
 	CreateValidPreparationInstrument(context.Context, *CreateValidPreparationInstrumentRequest) (*CreateValidPreparationInstrumentResponse, error)
 	CreateValidPreparationVessel(context.Context, *CreateValidPreparationVesselRequest) (*CreateValidPreparationVesselResponse, error)
 	CreateValidVessel(context.Context, *CreateValidVesselRequest) (*CreateValidVesselResponse, error)
+	CompleteMealPlanOption(context.Context, *CompleteMealPlanOptionRequest) (*CompleteMealPlanOptionResponse, error)
+	DiffRecipeRevisions(context.Context, *DiffRecipeRevisionsRequest) (*DiffRecipeRevisionsResponse, error)
 	FinalizeMealPlan(context.Context, *FinalizeMealPlanRequest) (*FinalizeMealPlanResponse, error)
 	GetMeal(context.Context, *GetMealRequest) (*GetMealResponse, error)
 	GetMealPlan(context.Context, *GetMealPlanRequest) (*GetMealPlanResponse, error)
 	GetMealPlanEvent(context.Context, *GetMealPlanEventRequest) (*GetMealPlanEventResponse, error)
+	GetMealPlanEventTallyReport(context.Context, *GetMealPlanEventTallyReportRequest) (*GetMealPlanEventTallyReportResponse, error)
 	GetMealPlanEvents(context.Context, *GetMealPlanEventsRequest) (*GetMealPlanEventsResponse, error)
 	GetMealPlanGroceryListItem(context.Context, *GetMealPlanGroceryListItemRequest) (*GetMealPlanGroceryListItemResponse, error)
 	GetMealPlanGroceryListItemsForMealPlan(context.Context, *GetMealPlanGroceryListItemsForMealPlanRequest) (*GetMealPlanGroceryListItemsForMealPlanResponse, error)
@@ -2866,9 +3241,15 @@ type MealPlanningServiceServer interface {
 	GetMealPlanRecipeOptionSelectionsForMealPlanOption(context.Context, *GetMealPlanRecipeOptionSelectionsForMealPlanOptionRequest) (*GetMealPlanRecipeOptionSelectionsForMealPlanOptionResponse, error)
 	GetMealPlanTask(context.Context, *GetMealPlanTaskRequest) (*GetMealPlanTaskResponse, error)
 	GetMealPlanTasks(context.Context, *GetMealPlanTasksRequest) (*GetMealPlanTasksResponse, error)
+	GetMealPlanTemplate(context.Context, *GetMealPlanTemplateRequest) (*GetMealPlanTemplateResponse, error)
+	GetMealPlanTemplates(context.Context, *GetMealPlanTemplatesRequest) (*GetMealPlanTemplatesResponse, error)
 	GetMealPlansForAccount(context.Context, *GetMealPlansForAccountRequest) (*GetMealPlansForAccountResponse, error)
 	GetMealLists(context.Context, *GetMealListsRequest) (*GetMealListsResponse, error)
 	GetMeals(context.Context, *GetMealsRequest) (*GetMealsResponse, error)
+	GetCookTimelineForMealPlanOption(context.Context, *GetCookTimelineForMealPlanOptionRequest) (*GetCookTimelineForMealPlanOptionResponse, error)
+	GetNutritionForMeal(context.Context, *GetNutritionForMealRequest) (*GetNutritionForMealResponse, error)
+	GetNutritionForMealPlan(context.Context, *GetNutritionForMealPlanRequest) (*GetNutritionForMealPlanResponse, error)
+	GetNutritionForRecipe(context.Context, *GetNutritionForRecipeRequest) (*GetNutritionForRecipeResponse, error)
 	GetMermaidDiagramForMeal(context.Context, *GetMermaidDiagramForMealRequest) (*GetMermaidDiagramForMealResponse, error)
 	GetMermaidDiagramForRecipe(context.Context, *GetMermaidDiagramForRecipeRequest) (*GetMermaidDiagramForRecipeResponse, error)
 	GetRandomValidIngredient(context.Context, *GetRandomValidIngredientRequest) (*GetRandomValidIngredientResponse, error)
@@ -2881,6 +3262,8 @@ type MealPlanningServiceServer interface {
 	GetRecipePrepTasks(context.Context, *GetRecipePrepTasksRequest) (*GetRecipePrepTasksResponse, error)
 	GetRecipeRating(context.Context, *GetRecipeRatingRequest) (*GetRecipeRatingResponse, error)
 	GetRecipeRatingsForRecipe(context.Context, *GetRecipeRatingsForRecipeRequest) (*GetRecipeRatingsForRecipeResponse, error)
+	GetRecipeRevision(context.Context, *GetRecipeRevisionRequest) (*GetRecipeRevisionResponse, error)
+	GetRecipeRevisions(context.Context, *GetRecipeRevisionsRequest) (*GetRecipeRevisionsResponse, error)
 	GetRecipeStep(context.Context, *GetRecipeStepRequest) (*GetRecipeStepResponse, error)
 	GetRecipeStepCompletionCondition(context.Context, *GetRecipeStepCompletionConditionRequest) (*GetRecipeStepCompletionConditionResponse, error)
 	GetRecipeStepCompletionConditions(context.Context, *GetRecipeStepCompletionConditionsRequest) (*GetRecipeStepCompletionConditionsResponse, error)
@@ -2902,6 +3285,7 @@ type MealPlanningServiceServer interface {
 	GetValidIngredientMeasurementUnits(context.Context, *GetValidIngredientMeasurementUnitsRequest) (*GetValidIngredientMeasurementUnitsResponse, error)
 	GetValidIngredientMeasurementUnitsByIngredient(context.Context, *GetValidIngredientMeasurementUnitsByIngredientRequest) (*GetValidIngredientMeasurementUnitsByIngredientResponse, error)
 	GetValidIngredientMeasurementUnitsByMeasurementUnit(context.Context, *GetValidIngredientMeasurementUnitsByMeasurementUnitRequest) (*GetValidIngredientMeasurementUnitsByMeasurementUnitResponse, error)
+	GetValidIngredientNutritionFacts(context.Context, *GetValidIngredientNutritionFactsRequest) (*GetValidIngredientNutritionFactsResponse, error)
 	GetValidIngredientPreparation(context.Context, *GetValidIngredientPreparationRequest) (*GetValidIngredientPreparationResponse, error)
 	GetValidIngredientPreparations(context.Context, *GetValidIngredientPreparationsRequest) (*GetValidIngredientPreparationsResponse, error)
 	GetValidIngredientPreparationsByIngredient(context.Context, *GetValidIngredientPreparationsByIngredientRequest) (*GetValidIngredientPreparationsByIngredientResponse, error)
@@ -2938,6 +3322,7 @@ type MealPlanningServiceServer interface {
 	GetValidPreparations(context.Context, *GetValidPreparationsRequest) (*GetValidPreparationsResponse, error)
 	GetValidVessel(context.Context, *GetValidVesselRequest) (*GetValidVesselResponse, error)
 	GetValidVessels(context.Context, *GetValidVesselsRequest) (*GetValidVesselsResponse, error)
+	RestoreRecipeRevision(context.Context, *RestoreRecipeRevisionRequest) (*RestoreRecipeRevisionResponse, error)
 	RunFinalizeMealPlanWorker(context.Context, *RunFinalizeMealPlanWorkerRequest) (*RunFinalizeMealPlanWorkerResponse, error)
 	RunMealPlanGroceryListInitializerWorker(context.Context, *RunMealPlanGroceryListInitializerWorkerRequest) (*RunMealPlanGroceryListInitializerWorkerResponse, error)
 	RunMealPlanTaskCreatorWorker(context.Context, *RunMealPlanTaskCreatorWorkerRequest) (*RunMealPlanTaskCreatorWorkerResponse, error)
@@ -2962,6 +3347,7 @@ type MealPlanningServiceServer interface {
 	UpdateMealPlanOptionVote(context.Context, *UpdateMealPlanOptionVoteRequest) (*UpdateMealPlanOptionVoteResponse, error)
 	UpdateMealPlanRecipeOptionSelection(context.Context, *UpdateMealPlanRecipeOptionSelectionRequest) (*UpdateMealPlanRecipeOptionSelectionResponse, error)
 	UpdateMealPlanTaskStatus(context.Context, *UpdateMealPlanTaskStatusRequest) (*UpdateMealPlanTaskStatusResponse, error)
+	UpdateMealPlanTemplate(context.Context, *UpdateMealPlanTemplateRequest) (*UpdateMealPlanTemplateResponse, error)
 	UpdateMealList(context.Context, *UpdateMealListRequest) (*UpdateMealListResponse, error)
 	UpdateMealListItem(context.Context, *UpdateMealListItemRequest) (*UpdateMealListItemResponse, error)
 	UpdateRecipe(context.Context, *UpdateRecipeRequest) (*UpdateRecipeResponse, error)
@@ -2979,6 +3365,7 @@ type MealPlanningServiceServer interface {
 	UpdateValidIngredient(context.Context, *UpdateValidIngredientRequest) (*UpdateValidIngredientResponse, error)
 	UpdateValidIngredientGroup(context.Context, *UpdateValidIngredientGroupRequest) (*UpdateValidIngredientGroupResponse, error)
 	UpdateValidIngredientMeasurementUnit(context.Context, *UpdateValidIngredientMeasurementUnitRequest) (*UpdateValidIngredientMeasurementUnitResponse, error)
+	UpdateValidIngredientNutritionFacts(context.Context, *UpdateValidIngredientNutritionFactsRequest) (*UpdateValidIngredientNutritionFactsResponse, error)
 	UpdateValidIngredientPreparation(context.Context, *UpdateValidIngredientPreparationRequest) (*UpdateValidIngredientPreparationResponse, error)
 	UpdateValidPrepTaskConfig(context.Context, *UpdateValidPrepTaskConfigRequest) (*UpdateValidPrepTaskConfigResponse, error)
 	UpdateValidIngredientState(context.Context, *UpdateValidIngredientStateRequest) (*UpdateValidIngredientStateResponse, error)
@@ -3000,12 +3387,20 @@ type MealPlanningServiceServer interface {
 	GetUserIngredientPreference(context.Context, *GetUserIngredientPreferenceRequest) (*GetUserIngredientPreferenceResponse, error)
 	GetUserIngredientPreferences(context.Context, *GetUserIngredientPreferencesRequest) (*GetUserIngredientPreferencesResponse, error)
 	UpdateAccountInstrumentOwnership(context.Context, *UpdateAccountInstrumentOwnershipRequest) (*UpdateAccountInstrumentOwnershipResponse, error)
+	ArchivePantryItem(context.Context, *ArchivePantryItemRequest) (*ArchivePantryItemResponse, error)
+	CreatePantryItem(context.Context, *CreatePantryItemRequest) (*CreatePantryItemResponse, error)
+	GetPantryItem(context.Context, *GetPantryItemRequest) (*GetPantryItemResponse, error)
+	GetPantryItems(context.Context, *GetPantryItemsRequest) (*GetPantryItemsResponse, error)
+	UpdatePantryItem(context.Context, *UpdatePantryItemRequest) (*UpdatePantryItemResponse, error)
 	UpdateUserIngredientPreference(context.Context, *UpdateUserIngredientPreferenceRequest) (*UpdateUserIngredientPreferenceResponse, error)
 	UploadMealImage(grpc.ClientStreamingServer[UploadMealMediaRequest, UploadMealImageResponse]) error
 	UploadRecipeImage(grpc.ClientStreamingServer[UploadRecipeMediaRequest, UploadRecipeImageResponse]) error
 	UploadPreparationMedia(grpc.ClientStreamingServer[UploadPreparationMediaRequest, UploadPreparationMediaResponse]) error
 	UploadIngredientMedia(grpc.ClientStreamingServer[UploadIngredientMediaRequest, UploadIngredientMediaResponse]) error
 	UploadRecipeStepImage(grpc.ClientStreamingServer[UploadRecipeStepImageRequest, UploadRecipeStepImageResponse]) error
+	ExportMealPlanCalendar(context.Context, *ExportMealPlanCalendarRequest) (*ExportMealPlanCalendarResponse, error)
+	WatchMealPlan(*WatchMealPlanRequest, grpc.ServerStreamingServer[WatchMealPlanResponse]) error
+	WatchMealPlanTasks(*WatchMealPlanTasksRequest, grpc.ServerStreamingServer[WatchMealPlanTasksResponse]) error
 	mustEmbedUnimplementedMealPlanningServiceServer()
 }
 
@@ -3046,6 +3441,9 @@ func (UnimplementedMealPlanningServiceServer) ArchiveMealPlanOptionVote(context.
 func (UnimplementedMealPlanningServiceServer) ArchiveMealPlanRecipeOptionSelection(context.Context, *ArchiveMealPlanRecipeOptionSelectionRequest) (*ArchiveMealPlanRecipeOptionSelectionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ArchiveMealPlanRecipeOptionSelection not implemented")
 }
+func (UnimplementedMealPlanningServiceServer) ArchiveMealPlanTemplate(context.Context, *ArchiveMealPlanTemplateRequest) (*ArchiveMealPlanTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveMealPlanTemplate not implemented")
+}
 func (UnimplementedMealPlanningServiceServer) ArchiveMealList(context.Context, *ArchiveMealListRequest) (*ArchiveMealListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ArchiveMealList not implemented")
 }
@@ -3094,6 +3492,9 @@ func (UnimplementedMealPlanningServiceServer) ArchiveValidIngredientGroup(contex
 func (UnimplementedMealPlanningServiceServer) ArchiveValidIngredientMeasurementUnit(context.Context, *ArchiveValidIngredientMeasurementUnitRequest) (*ArchiveValidIngredientMeasurementUnitResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ArchiveValidIngredientMeasurementUnit not implemented")
 }
+func (UnimplementedMealPlanningServiceServer) ArchiveValidIngredientNutritionFacts(context.Context, *ArchiveValidIngredientNutritionFactsRequest) (*ArchiveValidIngredientNutritionFactsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchiveValidIngredientNutritionFacts not implemented")
+}
 func (UnimplementedMealPlanningServiceServer) ArchiveValidIngredientPreparation(context.Context, *ArchiveValidIngredientPreparationRequest) (*ArchiveValidIngredientPreparationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ArchiveValidIngredientPreparation not implemented")
 }
@@ -3130,12 +3531,18 @@ func (UnimplementedMealPlanningServiceServer) ArchiveValidVessel(context.Context
 func (UnimplementedMealPlanningServiceServer) CloneRecipe(context.Context, *CloneRecipeRequest) (*CloneRecipeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CloneRecipe not implemented")
 }
+func (UnimplementedMealPlanningServiceServer) ImportRecipe(context.Context, *ImportRecipeRequest) (*ImportRecipeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ImportRecipe not implemented")
+}
 func (UnimplementedMealPlanningServiceServer) CreateMeal(context.Context, *CreateMealRequest) (*CreateMealResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateMeal not implemented")
 }
 func (UnimplementedMealPlanningServiceServer) CreateMealPlan(context.Context, *CreateMealPlanRequest) (*CreateMealPlanResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateMealPlan not implemented")
 }
+func (UnimplementedMealPlanningServiceServer) CreateMealPlanCalendarFeed(context.Context, *CreateMealPlanCalendarFeedRequest) (*CreateMealPlanCalendarFeedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMealPlanCalendarFeed not implemented")
+}
 func (UnimplementedMealPlanningServiceServer) CreateMealPlanEvent(context.Context, *CreateMealPlanEventRequest) (*CreateMealPlanEventResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateMealPlanEvent not implemented")
 }
@@ -3151,6 +3558,9 @@ func (UnimplementedMealPlanningServiceServer) CreateMealPlanRecipeOptionSelectio
 func (UnimplementedMealPlanningServiceServer) CreateMealPlanTask(context.Context, *CreateMealPlanTaskRequest) (*CreateMealPlanTaskResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateMealPlanTask not implemented")
 }
+func (UnimplementedMealPlanningServiceServer) CreateMealPlanTemplate(context.Context, *CreateMealPlanTemplateRequest) (*CreateMealPlanTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateMealPlanTemplate not implemented")
+}
 func (UnimplementedMealPlanningServiceServer) CreateMealList(context.Context, *CreateMealListRequest) (*CreateMealListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateMealList not implemented")
 }
@@ -3199,6 +3609,9 @@ func (UnimplementedMealPlanningServiceServer) CreateValidIngredientGroup(context
 func (UnimplementedMealPlanningServiceServer) CreateValidIngredientMeasurementUnit(context.Context, *CreateValidIngredientMeasurementUnitRequest) (*CreateValidIngredientMeasurementUnitResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateValidIngredientMeasurementUnit not implemented")
 }
+func (UnimplementedMealPlanningServiceServer) CreateValidIngredientNutritionFacts(context.Context, *CreateValidIngredientNutritionFactsRequest) (*CreateValidIngredientNutritionFactsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateValidIngredientNutritionFacts not implemented")
+}
 func (UnimplementedMealPlanningServiceServer) CreateValidIngredientPreparation(context.Context, *CreateValidIngredientPreparationRequest) (*CreateValidIngredientPreparationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateValidIngredientPreparation not implemented")
 }
@@ -3232,6 +3645,12 @@ func (UnimplementedMealPlanningServiceServer) CreateValidPreparationVessel(conte
 func (UnimplementedMealPlanningServiceServer) CreateValidVessel(context.Context, *CreateValidVesselRequest) (*CreateValidVesselResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateValidVessel not implemented")
 }
+func (UnimplementedMealPlanningServiceServer) CompleteMealPlanOption(context.Context, *CompleteMealPlanOptionRequest) (*CompleteMealPlanOptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CompleteMealPlanOption not implemented")
+}
+func (UnimplementedMealPlanningServiceServer) DiffRecipeRevisions(context.Context, *DiffRecipeRevisionsRequest) (*DiffRecipeRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DiffRecipeRevisions not implemented")
+}
 func (UnimplementedMealPlanningServiceServer) FinalizeMealPlan(context.Context, *FinalizeMealPlanRequest) (*FinalizeMealPlanResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FinalizeMealPlan not implemented")
 }
@@ -3244,6 +3663,9 @@ func (UnimplementedMealPlanningServiceServer) GetMealPlan(context.Context, *GetM
 func (UnimplementedMealPlanningServiceServer) GetMealPlanEvent(context.Context, *GetMealPlanEventRequest) (*GetMealPlanEventResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMealPlanEvent not implemented")
 }
+func (UnimplementedMealPlanningServiceServer) GetMealPlanEventTallyReport(context.Context, *GetMealPlanEventTallyReportRequest) (*GetMealPlanEventTallyReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMealPlanEventTallyReport not implemented")
+}
 func (UnimplementedMealPlanningServiceServer) GetMealPlanEvents(context.Context, *GetMealPlanEventsRequest) (*GetMealPlanEventsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMealPlanEvents not implemented")
 }
@@ -3277,6 +3699,12 @@ func (UnimplementedMealPlanningServiceServer) GetMealPlanTask(context.Context, *
 func (UnimplementedMealPlanningServiceServer) GetMealPlanTasks(context.Context, *GetMealPlanTasksRequest) (*GetMealPlanTasksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMealPlanTasks not implemented")
 }
+func (UnimplementedMealPlanningServiceServer) GetMealPlanTemplate(context.Context, *GetMealPlanTemplateRequest) (*GetMealPlanTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMealPlanTemplate not implemented")
+}
+func (UnimplementedMealPlanningServiceServer) GetMealPlanTemplates(context.Context, *GetMealPlanTemplatesRequest) (*GetMealPlanTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMealPlanTemplates not implemented")
+}
 func (UnimplementedMealPlanningServiceServer) GetMealPlansForAccount(context.Context, *GetMealPlansForAccountRequest) (*GetMealPlansForAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMealPlansForAccount not implemented")
 }
@@ -3286,6 +3714,18 @@ func (UnimplementedMealPlanningServiceServer) GetMealLists(context.Context, *Get
 func (UnimplementedMealPlanningServiceServer) GetMeals(context.Context, *GetMealsRequest) (*GetMealsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMeals not implemented")
 }
+func (UnimplementedMealPlanningServiceServer) GetCookTimelineForMealPlanOption(context.Context, *GetCookTimelineForMealPlanOptionRequest) (*GetCookTimelineForMealPlanOptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCookTimelineForMealPlanOption not implemented")
+}
+func (UnimplementedMealPlanningServiceServer) GetNutritionForMeal(context.Context, *GetNutritionForMealRequest) (*GetNutritionForMealResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNutritionForMeal not implemented")
+}
+func (UnimplementedMealPlanningServiceServer) GetNutritionForMealPlan(context.Context, *GetNutritionForMealPlanRequest) (*GetNutritionForMealPlanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNutritionForMealPlan not implemented")
+}
+func (UnimplementedMealPlanningServiceServer) GetNutritionForRecipe(context.Context, *GetNutritionForRecipeRequest) (*GetNutritionForRecipeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNutritionForRecipe not implemented")
+}
 func (UnimplementedMealPlanningServiceServer) GetMermaidDiagramForMeal(context.Context, *GetMermaidDiagramForMealRequest) (*GetMermaidDiagramForMealResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetMermaidDiagramForMeal not implemented")
 }
@@ -3322,6 +3762,12 @@ func (UnimplementedMealPlanningServiceServer) GetRecipeRating(context.Context, *
 func (UnimplementedMealPlanningServiceServer) GetRecipeRatingsForRecipe(context.Context, *GetRecipeRatingsForRecipeRequest) (*GetRecipeRatingsForRecipeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRecipeRatingsForRecipe not implemented")
 }
+func (UnimplementedMealPlanningServiceServer) GetRecipeRevision(context.Context, *GetRecipeRevisionRequest) (*GetRecipeRevisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecipeRevision not implemented")
+}
+func (UnimplementedMealPlanningServiceServer) GetRecipeRevisions(context.Context, *GetRecipeRevisionsRequest) (*GetRecipeRevisionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecipeRevisions not implemented")
+}
 func (UnimplementedMealPlanningServiceServer) GetRecipeStep(context.Context, *GetRecipeStepRequest) (*GetRecipeStepResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetRecipeStep not implemented")
 }
@@ -3385,6 +3831,9 @@ func (UnimplementedMealPlanningServiceServer) GetValidIngredientMeasurementUnits
 func (UnimplementedMealPlanningServiceServer) GetValidIngredientMeasurementUnitsByMeasurementUnit(context.Context, *GetValidIngredientMeasurementUnitsByMeasurementUnitRequest) (*GetValidIngredientMeasurementUnitsByMeasurementUnitResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetValidIngredientMeasurementUnitsByMeasurementUnit not implemented")
 }
+func (UnimplementedMealPlanningServiceServer) GetValidIngredientNutritionFacts(context.Context, *GetValidIngredientNutritionFactsRequest) (*GetValidIngredientNutritionFactsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetValidIngredientNutritionFacts not implemented")
+}
 func (UnimplementedMealPlanningServiceServer) GetValidIngredientPreparation(context.Context, *GetValidIngredientPreparationRequest) (*GetValidIngredientPreparationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetValidIngredientPreparation not implemented")
 }
@@ -3493,6 +3942,9 @@ func (UnimplementedMealPlanningServiceServer) GetValidVessel(context.Context, *G
 func (UnimplementedMealPlanningServiceServer) GetValidVessels(context.Context, *GetValidVesselsRequest) (*GetValidVesselsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetValidVessels not implemented")
 }
+func (UnimplementedMealPlanningServiceServer) RestoreRecipeRevision(context.Context, *RestoreRecipeRevisionRequest) (*RestoreRecipeRevisionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreRecipeRevision not implemented")
+}
 func (UnimplementedMealPlanningServiceServer) RunFinalizeMealPlanWorker(context.Context, *RunFinalizeMealPlanWorkerRequest) (*RunFinalizeMealPlanWorkerResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RunFinalizeMealPlanWorker not implemented")
 }
@@ -3565,6 +4017,9 @@ func (UnimplementedMealPlanningServiceServer) UpdateMealPlanRecipeOptionSelectio
 func (UnimplementedMealPlanningServiceServer) UpdateMealPlanTaskStatus(context.Context, *UpdateMealPlanTaskStatusRequest) (*UpdateMealPlanTaskStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateMealPlanTaskStatus not implemented")
 }
+func (UnimplementedMealPlanningServiceServer) UpdateMealPlanTemplate(context.Context, *UpdateMealPlanTemplateRequest) (*UpdateMealPlanTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateMealPlanTemplate not implemented")
+}
 func (UnimplementedMealPlanningServiceServer) UpdateMealList(context.Context, *UpdateMealListRequest) (*UpdateMealListResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateMealList not implemented")
 }
@@ -3616,6 +4071,9 @@ func (UnimplementedMealPlanningServiceServer) UpdateValidIngredientGroup(context
 func (UnimplementedMealPlanningServiceServer) UpdateValidIngredientMeasurementUnit(context.Context, *UpdateValidIngredientMeasurementUnitRequest) (*UpdateValidIngredientMeasurementUnitResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateValidIngredientMeasurementUnit not implemented")
 }
+func (UnimplementedMealPlanningServiceServer) UpdateValidIngredientNutritionFacts(context.Context, *UpdateValidIngredientNutritionFactsRequest) (*UpdateValidIngredientNutritionFactsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateValidIngredientNutritionFacts not implemented")
+}
 func (UnimplementedMealPlanningServiceServer) UpdateValidIngredientPreparation(context.Context, *UpdateValidIngredientPreparationRequest) (*UpdateValidIngredientPreparationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateValidIngredientPreparation not implemented")
 }
@@ -3679,6 +4137,21 @@ func (UnimplementedMealPlanningServiceServer) GetUserIngredientPreferences(conte
 func (UnimplementedMealPlanningServiceServer) UpdateAccountInstrumentOwnership(context.Context, *UpdateAccountInstrumentOwnershipRequest) (*UpdateAccountInstrumentOwnershipResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateAccountInstrumentOwnership not implemented")
 }
+func (UnimplementedMealPlanningServiceServer) ArchivePantryItem(context.Context, *ArchivePantryItemRequest) (*ArchivePantryItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ArchivePantryItem not implemented")
+}
+func (UnimplementedMealPlanningServiceServer) CreatePantryItem(context.Context, *CreatePantryItemRequest) (*CreatePantryItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreatePantryItem not implemented")
+}
+func (UnimplementedMealPlanningServiceServer) GetPantryItem(context.Context, *GetPantryItemRequest) (*GetPantryItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPantryItem not implemented")
+}
+func (UnimplementedMealPlanningServiceServer) GetPantryItems(context.Context, *GetPantryItemsRequest) (*GetPantryItemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPantryItems not implemented")
+}
+func (UnimplementedMealPlanningServiceServer) UpdatePantryItem(context.Context, *UpdatePantryItemRequest) (*UpdatePantryItemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePantryItem not implemented")
+}
 func (UnimplementedMealPlanningServiceServer) UpdateUserIngredientPreference(context.Context, *UpdateUserIngredientPreferenceRequest) (*UpdateUserIngredientPreferenceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateUserIngredientPreference not implemented")
 }
@@ -3697,6 +4170,15 @@ func (UnimplementedMealPlanningServiceServer) UploadIngredientMedia(grpc.ClientS
 func (UnimplementedMealPlanningServiceServer) UploadRecipeStepImage(grpc.ClientStreamingServer[UploadRecipeStepImageRequest, UploadRecipeStepImageResponse]) error {
 	return status.Errorf(codes.Unimplemented, "method UploadRecipeStepImage not implemented")
 }
+func (UnimplementedMealPlanningServiceServer) ExportMealPlanCalendar(context.Context, *ExportMealPlanCalendarRequest) (*ExportMealPlanCalendarResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportMealPlanCalendar not implemented")
+}
+func (UnimplementedMealPlanningServiceServer) WatchMealPlan(*WatchMealPlanRequest, grpc.ServerStreamingServer[WatchMealPlanResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchMealPlan not implemented")
+}
+func (UnimplementedMealPlanningServiceServer) WatchMealPlanTasks(*WatchMealPlanTasksRequest, grpc.ServerStreamingServer[WatchMealPlanTasksResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchMealPlanTasks not implemented")
+}
 func (UnimplementedMealPlanningServiceServer) mustEmbedUnimplementedMealPlanningServiceServer() {}
 func (UnimplementedMealPlanningServiceServer) testEmbeddedByValue()                             {}
 
@@ -3898,6 +4380,24 @@ func _MealPlanningService_ArchiveMealPlanRecipeOptionSelection_Handler(srv inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MealPlanningService_ArchiveMealPlanTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveMealPlanTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MealPlanningServiceServer).ArchiveMealPlanTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MealPlanningService_ArchiveMealPlanTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MealPlanningServiceServer).ArchiveMealPlanTemplate(ctx, req.(*ArchiveMealPlanTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MealPlanningService_ArchiveMealList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ArchiveMealListRequest)
 	if err := dec(in); err != nil {
@@ -4186,6 +4686,24 @@ func _MealPlanningService_ArchiveValidIngredientMeasurementUnit_Handler(srv inte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MealPlanningService_ArchiveValidIngredientNutritionFacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchiveValidIngredientNutritionFactsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MealPlanningServiceServer).ArchiveValidIngredientNutritionFacts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MealPlanningService_ArchiveValidIngredientNutritionFacts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MealPlanningServiceServer).ArchiveValidIngredientNutritionFacts(ctx, req.(*ArchiveValidIngredientNutritionFactsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MealPlanningService_ArchiveValidIngredientPreparation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ArchiveValidIngredientPreparationRequest)
 	if err := dec(in); err != nil {
@@ -4402,6 +4920,24 @@ func _MealPlanningService_CloneRecipe_Handler(srv interface{}, ctx context.Conte
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MealPlanningService_ImportRecipe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportRecipeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MealPlanningServiceServer).ImportRecipe(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MealPlanningService_ImportRecipe_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MealPlanningServiceServer).ImportRecipe(ctx, req.(*ImportRecipeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MealPlanningService_CreateMeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateMealRequest)
 	if err := dec(in); err != nil {
@@ -4438,6 +4974,24 @@ func _MealPlanningService_CreateMealPlan_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MealPlanningService_CreateMealPlanCalendarFeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMealPlanCalendarFeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MealPlanningServiceServer).CreateMealPlanCalendarFeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MealPlanningService_CreateMealPlanCalendarFeed_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MealPlanningServiceServer).CreateMealPlanCalendarFeed(ctx, req.(*CreateMealPlanCalendarFeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MealPlanningService_CreateMealPlanEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateMealPlanEventRequest)
 	if err := dec(in); err != nil {
@@ -4528,6 +5082,24 @@ func _MealPlanningService_CreateMealPlanTask_Handler(srv interface{}, ctx contex
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MealPlanningService_CreateMealPlanTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateMealPlanTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MealPlanningServiceServer).CreateMealPlanTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MealPlanningService_CreateMealPlanTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MealPlanningServiceServer).CreateMealPlanTemplate(ctx, req.(*CreateMealPlanTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MealPlanningService_CreateMealList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateMealListRequest)
 	if err := dec(in); err != nil {
@@ -4816,6 +5388,24 @@ func _MealPlanningService_CreateValidIngredientMeasurementUnit_Handler(srv inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MealPlanningService_CreateValidIngredientNutritionFacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateValidIngredientNutritionFactsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MealPlanningServiceServer).CreateValidIngredientNutritionFacts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MealPlanningService_CreateValidIngredientNutritionFacts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MealPlanningServiceServer).CreateValidIngredientNutritionFacts(ctx, req.(*CreateValidIngredientNutritionFactsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MealPlanningService_CreateValidIngredientPreparation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateValidIngredientPreparationRequest)
 	if err := dec(in); err != nil {
@@ -5014,6 +5604,42 @@ func _MealPlanningService_CreateValidVessel_Handler(srv interface{}, ctx context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MealPlanningService_CompleteMealPlanOption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteMealPlanOptionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MealPlanningServiceServer).CompleteMealPlanOption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MealPlanningService_CompleteMealPlanOption_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MealPlanningServiceServer).CompleteMealPlanOption(ctx, req.(*CompleteMealPlanOptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MealPlanningService_DiffRecipeRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DiffRecipeRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MealPlanningServiceServer).DiffRecipeRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MealPlanningService_DiffRecipeRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MealPlanningServiceServer).DiffRecipeRevisions(ctx, req.(*DiffRecipeRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MealPlanningService_FinalizeMealPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(FinalizeMealPlanRequest)
 	if err := dec(in); err != nil {
@@ -5086,6 +5712,24 @@ func _MealPlanningService_GetMealPlanEvent_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MealPlanningService_GetMealPlanEventTallyReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMealPlanEventTallyReportRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MealPlanningServiceServer).GetMealPlanEventTallyReport(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MealPlanningService_GetMealPlanEventTallyReport_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MealPlanningServiceServer).GetMealPlanEventTallyReport(ctx, req.(*GetMealPlanEventTallyReportRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MealPlanningService_GetMealPlanEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetMealPlanEventsRequest)
 	if err := dec(in); err != nil {
@@ -5243,97 +5887,205 @@ func _MealPlanningService_GetMealPlanRecipeOptionSelectionsForMealPlanOption_Han
 		FullMethod: MealPlanningService_GetMealPlanRecipeOptionSelectionsForMealPlanOption_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MealPlanningServiceServer).GetMealPlanRecipeOptionSelectionsForMealPlanOption(ctx, req.(*GetMealPlanRecipeOptionSelectionsForMealPlanOptionRequest))
+		return srv.(MealPlanningServiceServer).GetMealPlanRecipeOptionSelectionsForMealPlanOption(ctx, req.(*GetMealPlanRecipeOptionSelectionsForMealPlanOptionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MealPlanningService_GetMealPlanTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMealPlanTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MealPlanningServiceServer).GetMealPlanTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MealPlanningService_GetMealPlanTask_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MealPlanningServiceServer).GetMealPlanTask(ctx, req.(*GetMealPlanTaskRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MealPlanningService_GetMealPlanTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMealPlanTasksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MealPlanningServiceServer).GetMealPlanTasks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MealPlanningService_GetMealPlanTasks_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MealPlanningServiceServer).GetMealPlanTasks(ctx, req.(*GetMealPlanTasksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MealPlanningService_GetMealPlanTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMealPlanTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MealPlanningServiceServer).GetMealPlanTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MealPlanningService_GetMealPlanTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MealPlanningServiceServer).GetMealPlanTemplate(ctx, req.(*GetMealPlanTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MealPlanningService_GetMealPlanTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMealPlanTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MealPlanningServiceServer).GetMealPlanTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MealPlanningService_GetMealPlanTemplates_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MealPlanningServiceServer).GetMealPlanTemplates(ctx, req.(*GetMealPlanTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MealPlanningService_GetMealPlansForAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMealPlansForAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MealPlanningServiceServer).GetMealPlansForAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MealPlanningService_GetMealPlansForAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MealPlanningServiceServer).GetMealPlansForAccount(ctx, req.(*GetMealPlansForAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MealPlanningService_GetMealLists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMealListsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MealPlanningServiceServer).GetMealLists(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MealPlanningService_GetMealLists_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MealPlanningServiceServer).GetMealLists(ctx, req.(*GetMealListsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MealPlanningService_GetMealPlanTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetMealPlanTaskRequest)
+func _MealPlanningService_GetMeals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMealsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MealPlanningServiceServer).GetMealPlanTask(ctx, in)
+		return srv.(MealPlanningServiceServer).GetMeals(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MealPlanningService_GetMealPlanTask_FullMethodName,
+		FullMethod: MealPlanningService_GetMeals_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MealPlanningServiceServer).GetMealPlanTask(ctx, req.(*GetMealPlanTaskRequest))
+		return srv.(MealPlanningServiceServer).GetMeals(ctx, req.(*GetMealsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MealPlanningService_GetMealPlanTasks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetMealPlanTasksRequest)
+func _MealPlanningService_GetCookTimelineForMealPlanOption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCookTimelineForMealPlanOptionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MealPlanningServiceServer).GetMealPlanTasks(ctx, in)
+		return srv.(MealPlanningServiceServer).GetCookTimelineForMealPlanOption(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MealPlanningService_GetMealPlanTasks_FullMethodName,
+		FullMethod: MealPlanningService_GetCookTimelineForMealPlanOption_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MealPlanningServiceServer).GetMealPlanTasks(ctx, req.(*GetMealPlanTasksRequest))
+		return srv.(MealPlanningServiceServer).GetCookTimelineForMealPlanOption(ctx, req.(*GetCookTimelineForMealPlanOptionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MealPlanningService_GetMealPlansForAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetMealPlansForAccountRequest)
+func _MealPlanningService_GetNutritionForMeal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNutritionForMealRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MealPlanningServiceServer).GetMealPlansForAccount(ctx, in)
+		return srv.(MealPlanningServiceServer).GetNutritionForMeal(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MealPlanningService_GetMealPlansForAccount_FullMethodName,
+		FullMethod: MealPlanningService_GetNutritionForMeal_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MealPlanningServiceServer).GetMealPlansForAccount(ctx, req.(*GetMealPlansForAccountRequest))
+		return srv.(MealPlanningServiceServer).GetNutritionForMeal(ctx, req.(*GetNutritionForMealRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MealPlanningService_GetMealLists_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetMealListsRequest)
+func _MealPlanningService_GetNutritionForMealPlan_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNutritionForMealPlanRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MealPlanningServiceServer).GetMealLists(ctx, in)
+		return srv.(MealPlanningServiceServer).GetNutritionForMealPlan(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MealPlanningService_GetMealLists_FullMethodName,
+		FullMethod: MealPlanningService_GetNutritionForMealPlan_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MealPlanningServiceServer).GetMealLists(ctx, req.(*GetMealListsRequest))
+		return srv.(MealPlanningServiceServer).GetNutritionForMealPlan(ctx, req.(*GetNutritionForMealPlanRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _MealPlanningService_GetMeals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetMealsRequest)
+func _MealPlanningService_GetNutritionForRecipe_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNutritionForRecipeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MealPlanningServiceServer).GetMeals(ctx, in)
+		return srv.(MealPlanningServiceServer).GetNutritionForRecipe(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: MealPlanningService_GetMeals_FullMethodName,
+		FullMethod: MealPlanningService_GetNutritionForRecipe_FullMethodName,
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MealPlanningServiceServer).GetMeals(ctx, req.(*GetMealsRequest))
+		return srv.(MealPlanningServiceServer).GetNutritionForRecipe(ctx, req.(*GetNutritionForRecipeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -5554,6 +6306,42 @@ func _MealPlanningService_GetRecipeRatingsForRecipe_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MealPlanningService_GetRecipeRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecipeRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MealPlanningServiceServer).GetRecipeRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MealPlanningService_GetRecipeRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MealPlanningServiceServer).GetRecipeRevision(ctx, req.(*GetRecipeRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MealPlanningService_GetRecipeRevisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRecipeRevisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MealPlanningServiceServer).GetRecipeRevisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MealPlanningService_GetRecipeRevisions_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MealPlanningServiceServer).GetRecipeRevisions(ctx, req.(*GetRecipeRevisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MealPlanningService_GetRecipeStep_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetRecipeStepRequest)
 	if err := dec(in); err != nil {
@@ -5932,6 +6720,24 @@ func _MealPlanningService_GetValidIngredientMeasurementUnitsByMeasurementUnit_Ha
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MealPlanningService_GetValidIngredientNutritionFacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetValidIngredientNutritionFactsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MealPlanningServiceServer).GetValidIngredientNutritionFacts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MealPlanningService_GetValidIngredientNutritionFacts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MealPlanningServiceServer).GetValidIngredientNutritionFacts(ctx, req.(*GetValidIngredientNutritionFactsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MealPlanningService_GetValidIngredientPreparation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(GetValidIngredientPreparationRequest)
 	if err := dec(in); err != nil {
@@ -6580,6 +7386,24 @@ func _MealPlanningService_GetValidVessels_Handler(srv interface{}, ctx context.C
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MealPlanningService_RestoreRecipeRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreRecipeRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MealPlanningServiceServer).RestoreRecipeRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MealPlanningService_RestoreRecipeRevision_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MealPlanningServiceServer).RestoreRecipeRevision(ctx, req.(*RestoreRecipeRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MealPlanningService_RunFinalizeMealPlanWorker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RunFinalizeMealPlanWorkerRequest)
 	if err := dec(in); err != nil {
@@ -7012,6 +7836,24 @@ func _MealPlanningService_UpdateMealPlanTaskStatus_Handler(srv interface{}, ctx
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MealPlanningService_UpdateMealPlanTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateMealPlanTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MealPlanningServiceServer).UpdateMealPlanTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MealPlanningService_UpdateMealPlanTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MealPlanningServiceServer).UpdateMealPlanTemplate(ctx, req.(*UpdateMealPlanTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MealPlanningService_UpdateMealList_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateMealListRequest)
 	if err := dec(in); err != nil {
@@ -7318,6 +8160,24 @@ func _MealPlanningService_UpdateValidIngredientMeasurementUnit_Handler(srv inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MealPlanningService_UpdateValidIngredientNutritionFacts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateValidIngredientNutritionFactsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MealPlanningServiceServer).UpdateValidIngredientNutritionFacts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MealPlanningService_UpdateValidIngredientNutritionFacts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MealPlanningServiceServer).UpdateValidIngredientNutritionFacts(ctx, req.(*UpdateValidIngredientNutritionFactsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MealPlanningService_UpdateValidIngredientPreparation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateValidIngredientPreparationRequest)
 	if err := dec(in); err != nil {
@@ -7696,6 +8556,96 @@ func _MealPlanningService_UpdateAccountInstrumentOwnership_Handler(srv interface
 	return interceptor(ctx, in, info, handler)
 }
 
+func _MealPlanningService_ArchivePantryItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ArchivePantryItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MealPlanningServiceServer).ArchivePantryItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MealPlanningService_ArchivePantryItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MealPlanningServiceServer).ArchivePantryItem(ctx, req.(*ArchivePantryItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MealPlanningService_CreatePantryItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreatePantryItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MealPlanningServiceServer).CreatePantryItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MealPlanningService_CreatePantryItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MealPlanningServiceServer).CreatePantryItem(ctx, req.(*CreatePantryItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MealPlanningService_GetPantryItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPantryItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MealPlanningServiceServer).GetPantryItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MealPlanningService_GetPantryItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MealPlanningServiceServer).GetPantryItem(ctx, req.(*GetPantryItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MealPlanningService_GetPantryItems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPantryItemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MealPlanningServiceServer).GetPantryItems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MealPlanningService_GetPantryItems_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MealPlanningServiceServer).GetPantryItems(ctx, req.(*GetPantryItemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MealPlanningService_UpdatePantryItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdatePantryItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MealPlanningServiceServer).UpdatePantryItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MealPlanningService_UpdatePantryItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MealPlanningServiceServer).UpdatePantryItem(ctx, req.(*UpdatePantryItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _MealPlanningService_UpdateUserIngredientPreference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(UpdateUserIngredientPreferenceRequest)
 	if err := dec(in); err != nil {
@@ -7749,6 +8699,46 @@ func _MealPlanningService_UploadRecipeStepImage_Handler(srv interface{}, stream
 // This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
 type MealPlanningService_UploadRecipeStepImageServer = grpc.ClientStreamingServer[UploadRecipeStepImageRequest, UploadRecipeStepImageResponse]
 
+func _MealPlanningService_ExportMealPlanCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportMealPlanCalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MealPlanningServiceServer).ExportMealPlanCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: MealPlanningService_ExportMealPlanCalendar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MealPlanningServiceServer).ExportMealPlanCalendar(ctx, req.(*ExportMealPlanCalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MealPlanningService_WatchMealPlan_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchMealPlanRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MealPlanningServiceServer).WatchMealPlan(m, &grpc.GenericServerStream[WatchMealPlanRequest, WatchMealPlanResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MealPlanningService_WatchMealPlanServer = grpc.ServerStreamingServer[WatchMealPlanResponse]
+
+func _MealPlanningService_WatchMealPlanTasks_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchMealPlanTasksRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MealPlanningServiceServer).WatchMealPlanTasks(m, &grpc.GenericServerStream[WatchMealPlanTasksRequest, WatchMealPlanTasksResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type MealPlanningService_WatchMealPlanTasksServer = grpc.ServerStreamingServer[WatchMealPlanTasksResponse]
+
 // MealPlanningService_ServiceDesc is the grpc.ServiceDesc for MealPlanningService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -7796,6 +8786,10 @@ func _MealPlanningService_UploadRecipeStepImage_Handler(srv interface{}, stream
 			MethodName: "ArchiveMealPlanRecipeOptionSelection",
 			Handler:    _MealPlanningService_ArchiveMealPlanRecipeOptionSelection_Handler,
 		},
+		{
+			MethodName: "ArchiveMealPlanTemplate",
+			Handler:    _MealPlanningService_ArchiveMealPlanTemplate_Handler,
+		},
 		{
 			MethodName: "ArchiveMealList",
 			Handler:    _MealPlanningService_ArchiveMealList_Handler,
@@ -7860,6 +8854,10 @@ func _MealPlanningService_UploadRecipeStepImage_Handler(srv interface{}, stream
 			MethodName: "ArchiveValidIngredientMeasurementUnit",
 			Handler:    _MealPlanningService_ArchiveValidIngredientMeasurementUnit_Handler,
 		},
+		{
+			MethodName: "ArchiveValidIngredientNutritionFacts",
+			Handler:    _MealPlanningService_ArchiveValidIngredientNutritionFacts_Handler,
+		},
 		{
 			MethodName: "ArchiveValidIngredientPreparation",
 			Handler:    _MealPlanningService_ArchiveValidIngredientPreparation_Handler,
@@ -7908,6 +8906,10 @@ func _MealPlanningService_UploadRecipeStepImage_Handler(srv interface{}, stream
 			MethodName: "CloneRecipe",
 			Handler:    _MealPlanningService_CloneRecipe_Handler,
 		},
+		{
+			MethodName: "ImportRecipe",
+			Handler:    _MealPlanningService_ImportRecipe_Handler,
+		},
 		{
 			MethodName: "CreateMeal",
 			Handler:    _MealPlanningService_CreateMeal_Handler,
@@ -7916,6 +8918,10 @@ func _MealPlanningService_UploadRecipeStepImage_Handler(srv interface{}, stream
 			MethodName: "CreateMealPlan",
 			Handler:    _MealPlanningService_CreateMealPlan_Handler,
 		},
+		{
+			MethodName: "CreateMealPlanCalendarFeed",
+			Handler:    _MealPlanningService_CreateMealPlanCalendarFeed_Handler,
+		},
 		{
 			MethodName: "CreateMealPlanEvent",
 			Handler:    _MealPlanningService_CreateMealPlanEvent_Handler,
@@ -7936,6 +8942,10 @@ func _MealPlanningService_UploadRecipeStepImage_Handler(srv interface{}, stream
 			MethodName: "CreateMealPlanTask",
 			Handler:    _MealPlanningService_CreateMealPlanTask_Handler,
 		},
+		{
+			MethodName: "CreateMealPlanTemplate",
+			Handler:    _MealPlanningService_CreateMealPlanTemplate_Handler,
+		},
 		{
 			MethodName: "CreateMealList",
 			Handler:    _MealPlanningService_CreateMealList_Handler,
@@ -8000,6 +9010,10 @@ func _MealPlanningService_UploadRecipeStepImage_Handler(srv interface{}, stream
 			MethodName: "CreateValidIngredientMeasurementUnit",
 			Handler:    _MealPlanningService_CreateValidIngredientMeasurementUnit_Handler,
 		},
+		{
+			MethodName: "CreateValidIngredientNutritionFacts",
+			Handler:    _MealPlanningService_CreateValidIngredientNutritionFacts_Handler,
+		},
 		{
 			MethodName: "CreateValidIngredientPreparation",
 			Handler:    _MealPlanningService_CreateValidIngredientPreparation_Handler,
@@ -8044,6 +9058,14 @@ func _MealPlanningService_UploadRecipeStepImage_Handler(srv interface{}, stream
 			MethodName: "CreateValidVessel",
 			Handler:    _MealPlanningService_CreateValidVessel_Handler,
 		},
+		{
+			MethodName: "CompleteMealPlanOption",
+			Handler:    _MealPlanningService_CompleteMealPlanOption_Handler,
+		},
+		{
+			MethodName: "DiffRecipeRevisions",
+			Handler:    _MealPlanningService_DiffRecipeRevisions_Handler,
+		},
 		{
 			MethodName: "FinalizeMealPlan",
 			Handler:    _MealPlanningService_FinalizeMealPlan_Handler,
@@ -8060,6 +9082,10 @@ func _MealPlanningService_UploadRecipeStepImage_Handler(srv interface{}, stream
 			MethodName: "GetMealPlanEvent",
 			Handler:    _MealPlanningService_GetMealPlanEvent_Handler,
 		},
+		{
+			MethodName: "GetMealPlanEventTallyReport",
+			Handler:    _MealPlanningService_GetMealPlanEventTallyReport_Handler,
+		},
 		{
 			MethodName: "GetMealPlanEvents",
 			Handler:    _MealPlanningService_GetMealPlanEvents_Handler,
@@ -8104,6 +9130,14 @@ func _MealPlanningService_UploadRecipeStepImage_Handler(srv interface{}, stream
 			MethodName: "GetMealPlanTasks",
 			Handler:    _MealPlanningService_GetMealPlanTasks_Handler,
 		},
+		{
+			MethodName: "GetMealPlanTemplate",
+			Handler:    _MealPlanningService_GetMealPlanTemplate_Handler,
+		},
+		{
+			MethodName: "GetMealPlanTemplates",
+			Handler:    _MealPlanningService_GetMealPlanTemplates_Handler,
+		},
 		{
 			MethodName: "GetMealPlansForAccount",
 			Handler:    _MealPlanningService_GetMealPlansForAccount_Handler,
@@ -8116,6 +9150,22 @@ func _MealPlanningService_UploadRecipeStepImage_Handler(srv interface{}, stream
 			MethodName: "GetMeals",
 			Handler:    _MealPlanningService_GetMeals_Handler,
 		},
+		{
+			MethodName: "GetCookTimelineForMealPlanOption",
+			Handler:    _MealPlanningService_GetCookTimelineForMealPlanOption_Handler,
+		},
+		{
+			MethodName: "GetNutritionForMeal",
+			Handler:    _MealPlanningService_GetNutritionForMeal_Handler,
+		},
+		{
+			MethodName: "GetNutritionForMealPlan",
+			Handler:    _MealPlanningService_GetNutritionForMealPlan_Handler,
+		},
+		{
+			MethodName: "GetNutritionForRecipe",
+			Handler:    _MealPlanningService_GetNutritionForRecipe_Handler,
+		},
 		{
 			MethodName: "GetMermaidDiagramForMeal",
 			Handler:    _MealPlanningService_GetMermaidDiagramForMeal_Handler,
@@ -8164,6 +9214,14 @@ func _MealPlanningService_UploadRecipeStepImage_Handler(srv interface{}, stream
 			MethodName: "GetRecipeRatingsForRecipe",
 			Handler:    _MealPlanningService_GetRecipeRatingsForRecipe_Handler,
 		},
+		{
+			MethodName: "GetRecipeRevision",
+			Handler:    _MealPlanningService_GetRecipeRevision_Handler,
+		},
+		{
+			MethodName: "GetRecipeRevisions",
+			Handler:    _MealPlanningService_GetRecipeRevisions_Handler,
+		},
 		{
 			MethodName: "GetRecipeStep",
 			Handler:    _MealPlanningService_GetRecipeStep_Handler,
@@ -8248,6 +9306,10 @@ func _MealPlanningService_UploadRecipeStepImage_Handler(srv interface{}, stream
 			MethodName: "GetValidIngredientMeasurementUnitsByMeasurementUnit",
 			Handler:    _MealPlanningService_GetValidIngredientMeasurementUnitsByMeasurementUnit_Handler,
 		},
+		{
+			MethodName: "GetValidIngredientNutritionFacts",
+			Handler:    _MealPlanningService_GetValidIngredientNutritionFacts_Handler,
+		},
 		{
 			MethodName: "GetValidIngredientPreparation",
 			Handler:    _MealPlanningService_GetValidIngredientPreparation_Handler,
@@ -8392,6 +9454,10 @@ func _MealPlanningService_UploadRecipeStepImage_Handler(srv interface{}, stream
 			MethodName: "GetValidVessels",
 			Handler:    _MealPlanningService_GetValidVessels_Handler,
 		},
+		{
+			MethodName: "RestoreRecipeRevision",
+			Handler:    _MealPlanningService_RestoreRecipeRevision_Handler,
+		},
 		{
 			MethodName: "RunFinalizeMealPlanWorker",
 			Handler:    _MealPlanningService_RunFinalizeMealPlanWorker_Handler,
@@ -8488,6 +9554,10 @@ func _MealPlanningService_UploadRecipeStepImage_Handler(srv interface{}, stream
 			MethodName: "UpdateMealPlanTaskStatus",
 			Handler:    _MealPlanningService_UpdateMealPlanTaskStatus_Handler,
 		},
+		{
+			MethodName: "UpdateMealPlanTemplate",
+			Handler:    _MealPlanningService_UpdateMealPlanTemplate_Handler,
+		},
 		{
 			MethodName: "UpdateMealList",
 			Handler:    _MealPlanningService_UpdateMealList_Handler,
@@ -8556,6 +9626,10 @@ func _MealPlanningService_UploadRecipeStepImage_Handler(srv interface{}, stream
 			MethodName: "UpdateValidIngredientMeasurementUnit",
 			Handler:    _MealPlanningService_UpdateValidIngredientMeasurementUnit_Handler,
 		},
+		{
+			MethodName: "UpdateValidIngredientNutritionFacts",
+			Handler:    _MealPlanningService_UpdateValidIngredientNutritionFacts_Handler,
+		},
 		{
 			MethodName: "UpdateValidIngredientPreparation",
 			Handler:    _MealPlanningService_UpdateValidIngredientPreparation_Handler,
@@ -8640,10 +9714,34 @@ func _MealPlanningService_UploadRecipeStepImage_Handler(srv interface{}, stream
 			MethodName: "UpdateAccountInstrumentOwnership",
 			Handler:    _MealPlanningService_UpdateAccountInstrumentOwnership_Handler,
 		},
+		{
+			MethodName: "ArchivePantryItem",
+			Handler:    _MealPlanningService_ArchivePantryItem_Handler,
+		},
+		{
+			MethodName: "CreatePantryItem",
+			Handler:    _MealPlanningService_CreatePantryItem_Handler,
+		},
+		{
+			MethodName: "GetPantryItem",
+			Handler:    _MealPlanningService_GetPantryItem_Handler,
+		},
+		{
+			MethodName: "GetPantryItems",
+			Handler:    _MealPlanningService_GetPantryItems_Handler,
+		},
+		{
+			MethodName: "UpdatePantryItem",
+			Handler:    _MealPlanningService_UpdatePantryItem_Handler,
+		},
 		{
 			MethodName: "UpdateUserIngredientPreference",
 			Handler:    _MealPlanningService_UpdateUserIngredientPreference_Handler,
 		},
+		{
+			MethodName: "ExportMealPlanCalendar",
+			Handler:    _MealPlanningService_ExportMealPlanCalendar_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -8671,6 +9769,16 @@ func _MealPlanningService_UploadRecipeStepImage_Handler(srv interface{}, stream
 			Handler:       _MealPlanningService_UploadRecipeStepImage_Handler,
 			ClientStreams: true,
 		},
+		{
+			StreamName:    "WatchMealPlan",
+			Handler:       _MealPlanningService_WatchMealPlan_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "WatchMealPlanTasks",
+			Handler:       _MealPlanningService_WatchMealPlanTasks_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "mealplanning/mealplanning_service.proto",
 }
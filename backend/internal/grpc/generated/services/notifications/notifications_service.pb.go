@@ -30,7 +30,7 @@
 	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x2f, 0x6e, 0x6f, 0x74, 0x69,
 	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69,
 	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f,
-	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0xae, 0x06, 0x0a, 0x18,
+	0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x32, 0xdc, 0x0a, 0x0a, 0x18,
 	0x55, 0x73, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
 	0x73, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x6c, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x55,
 	0x73, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12,
@@ -53,7 +53,25 @@
 	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
 	0x2d, 0x2e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
 	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
-	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6c,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8d,
+	0x01, 0x0a, 0x1e, 0x4d, 0x61, 0x72, 0x6b, 0x41, 0x6c, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x41, 0x73, 0x52, 0x65, 0x61,
+	0x64, 0x12, 0x34, 0x2e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x41, 0x6c, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x41, 0x73, 0x52, 0x65, 0x61, 0x64,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x4d, 0x61, 0x72, 0x6b, 0x41, 0x6c, 0x6c, 0x55,
+	0x73, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x41, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8d,
+	0x01, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x55, 0x73, 0x65, 0x72,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x34, 0x2e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x55, 0x73, 0x65, 0x72, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x35, 0x2e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x55, 0x6e, 0x72, 0x65, 0x61,
+	0x64, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6c,
 	0x0a, 0x13, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
 	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x29, 0x2e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
 	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x44, 0x65,
@@ -81,49 +99,82 @@
 	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e,
 	0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x41, 0x72,
 	0x63, 0x68, 0x69, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x65, 0x5a, 0x63,
-	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x69, 0x6e, 0x6e, 0x65,
-	0x72, 0x64, 0x6f, 0x6e, 0x65, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x2f, 0x64, 0x69, 0x6e, 0x6e,
-	0x65, 0x72, 0x64, 0x6f, 0x6e, 0x65, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x2f, 0x62, 0x61, 0x63,
-	0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x67, 0x72,
-	0x70, 0x63, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2f, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x81, 0x01, 0x0a,
+	0x1a, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x12, 0x30, 0x2e, 0x6e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e,
+	0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x47, 0x65,
+	0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x87, 0x01, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63,
+	0x65, 0x12, 0x32, 0x2e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x33, 0x2e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e,
+	0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x65, 0x5a, 0x63, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x64,
+	0x6f, 0x6e, 0x65, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x2f, 0x64, 0x69, 0x6e, 0x6e, 0x65, 0x72,
+	0x64, 0x6f, 0x6e, 0x65, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65,
+	0x6e, 0x64, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x67, 0x72, 0x70, 0x63,
+	0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var file_notifications_notifications_service_proto_goTypes = []any{
-	(*GetUserNotificationRequest)(nil),     // 0: notifications.GetUserNotificationRequest
-	(*GetUserNotificationsRequest)(nil),    // 1: notifications.GetUserNotificationsRequest
-	(*UpdateUserNotificationRequest)(nil),  // 2: notifications.UpdateUserNotificationRequest
-	(*RegisterDeviceTokenRequest)(nil),     // 3: notifications.RegisterDeviceTokenRequest
-	(*GetUserDeviceTokenRequest)(nil),      // 4: notifications.GetUserDeviceTokenRequest
-	(*GetUserDeviceTokensRequest)(nil),     // 5: notifications.GetUserDeviceTokensRequest
-	(*ArchiveUserDeviceTokenRequest)(nil),  // 6: notifications.ArchiveUserDeviceTokenRequest
-	(*GetUserNotificationResponse)(nil),    // 7: notifications.GetUserNotificationResponse
-	(*GetUserNotificationsResponse)(nil),   // 8: notifications.GetUserNotificationsResponse
-	(*UpdateUserNotificationResponse)(nil), // 9: notifications.UpdateUserNotificationResponse
-	(*RegisterDeviceTokenResponse)(nil),    // 10: notifications.RegisterDeviceTokenResponse
-	(*GetUserDeviceTokenResponse)(nil),     // 11: notifications.GetUserDeviceTokenResponse
-	(*GetUserDeviceTokensResponse)(nil),    // 12: notifications.GetUserDeviceTokensResponse
-	(*ArchiveUserDeviceTokenResponse)(nil), // 13: notifications.ArchiveUserDeviceTokenResponse
+	(*GetUserNotificationRequest)(nil),             // 0: notifications.GetUserNotificationRequest
+	(*GetUserNotificationsRequest)(nil),            // 1: notifications.GetUserNotificationsRequest
+	(*UpdateUserNotificationRequest)(nil),          // 2: notifications.UpdateUserNotificationRequest
+	(*MarkAllUserNotificationsAsReadRequest)(nil),  // 3: notifications.MarkAllUserNotificationsAsReadRequest
+	(*GetUnreadUserNotificationCountRequest)(nil),  // 4: notifications.GetUnreadUserNotificationCountRequest
+	(*RegisterDeviceTokenRequest)(nil),             // 5: notifications.RegisterDeviceTokenRequest
+	(*GetUserDeviceTokenRequest)(nil),              // 6: notifications.GetUserDeviceTokenRequest
+	(*GetUserDeviceTokensRequest)(nil),             // 7: notifications.GetUserDeviceTokensRequest
+	(*ArchiveUserDeviceTokenRequest)(nil),          // 8: notifications.ArchiveUserDeviceTokenRequest
+	(*GetNotificationPreferencesRequest)(nil),      // 9: notifications.GetNotificationPreferencesRequest
+	(*UpdateNotificationPreferenceRequest)(nil),    // 10: notifications.UpdateNotificationPreferenceRequest
+	(*GetUserNotificationResponse)(nil),            // 11: notifications.GetUserNotificationResponse
+	(*GetUserNotificationsResponse)(nil),           // 12: notifications.GetUserNotificationsResponse
+	(*UpdateUserNotificationResponse)(nil),         // 13: notifications.UpdateUserNotificationResponse
+	(*MarkAllUserNotificationsAsReadResponse)(nil), // 14: notifications.MarkAllUserNotificationsAsReadResponse
+	(*GetUnreadUserNotificationCountResponse)(nil), // 15: notifications.GetUnreadUserNotificationCountResponse
+	(*RegisterDeviceTokenResponse)(nil),            // 16: notifications.RegisterDeviceTokenResponse
+	(*GetUserDeviceTokenResponse)(nil),             // 17: notifications.GetUserDeviceTokenResponse
+	(*GetUserDeviceTokensResponse)(nil),            // 18: notifications.GetUserDeviceTokensResponse
+	(*ArchiveUserDeviceTokenResponse)(nil),         // 19: notifications.ArchiveUserDeviceTokenResponse
+	(*GetNotificationPreferencesResponse)(nil),     // 20: notifications.GetNotificationPreferencesResponse
+	(*UpdateNotificationPreferenceResponse)(nil),   // 21: notifications.UpdateNotificationPreferenceResponse
 }
 var file_notifications_notifications_service_proto_depIdxs = []int32{
 	0,  // 0: notifications.UserNotificationsService.GetUserNotification:input_type -> notifications.GetUserNotificationRequest
 	1,  // 1: notifications.UserNotificationsService.GetUserNotifications:input_type -> notifications.GetUserNotificationsRequest
 	2,  // 2: notifications.UserNotificationsService.UpdateUserNotification:input_type -> notifications.UpdateUserNotificationRequest
-	3,  // 3: notifications.UserNotificationsService.RegisterDeviceToken:input_type -> notifications.RegisterDeviceTokenRequest
-	4,  // 4: notifications.UserNotificationsService.GetUserDeviceToken:input_type -> notifications.GetUserDeviceTokenRequest
-	5,  // 5: notifications.UserNotificationsService.GetUserDeviceTokens:input_type -> notifications.GetUserDeviceTokensRequest
-	6,  // 6: notifications.UserNotificationsService.ArchiveUserDeviceToken:input_type -> notifications.ArchiveUserDeviceTokenRequest
-	7,  // 7: notifications.UserNotificationsService.GetUserNotification:output_type -> notifications.GetUserNotificationResponse
-	8,  // 8: notifications.UserNotificationsService.GetUserNotifications:output_type -> notifications.GetUserNotificationsResponse
-	9,  // 9: notifications.UserNotificationsService.UpdateUserNotification:output_type -> notifications.UpdateUserNotificationResponse
-	10, // 10: notifications.UserNotificationsService.RegisterDeviceToken:output_type -> notifications.RegisterDeviceTokenResponse
-	11, // 11: notifications.UserNotificationsService.GetUserDeviceToken:output_type -> notifications.GetUserDeviceTokenResponse
-	12, // 12: notifications.UserNotificationsService.GetUserDeviceTokens:output_type -> notifications.GetUserDeviceTokensResponse
-	13, // 13: notifications.UserNotificationsService.ArchiveUserDeviceToken:output_type -> notifications.ArchiveUserDeviceTokenResponse
-	7,  // [7:14] is the sub-list for method output_type
-	0,  // [0:7] is the sub-list for method input_type
+	3,  // 3: notifications.UserNotificationsService.MarkAllUserNotificationsAsRead:input_type -> notifications.MarkAllUserNotificationsAsReadRequest
+	4,  // 4: notifications.UserNotificationsService.GetUnreadUserNotificationCount:input_type -> notifications.GetUnreadUserNotificationCountRequest
+	5,  // 5: notifications.UserNotificationsService.RegisterDeviceToken:input_type -> notifications.RegisterDeviceTokenRequest
+	6,  // 6: notifications.UserNotificationsService.GetUserDeviceToken:input_type -> notifications.GetUserDeviceTokenRequest
+	7,  // 7: notifications.UserNotificationsService.GetUserDeviceTokens:input_type -> notifications.GetUserDeviceTokensRequest
+	8,  // 8: notifications.UserNotificationsService.ArchiveUserDeviceToken:input_type -> notifications.ArchiveUserDeviceTokenRequest
+	9,  // 9: notifications.UserNotificationsService.GetNotificationPreferences:input_type -> notifications.GetNotificationPreferencesRequest
+	10, // 10: notifications.UserNotificationsService.UpdateNotificationPreference:input_type -> notifications.UpdateNotificationPreferenceRequest
+	11, // 11: notifications.UserNotificationsService.GetUserNotification:output_type -> notifications.GetUserNotificationResponse
+	12, // 12: notifications.UserNotificationsService.GetUserNotifications:output_type -> notifications.GetUserNotificationsResponse
+	13, // 13: notifications.UserNotificationsService.UpdateUserNotification:output_type -> notifications.UpdateUserNotificationResponse
+	14, // 14: notifications.UserNotificationsService.MarkAllUserNotificationsAsRead:output_type -> notifications.MarkAllUserNotificationsAsReadResponse
+	15, // 15: notifications.UserNotificationsService.GetUnreadUserNotificationCount:output_type -> notifications.GetUnreadUserNotificationCountResponse
+	16, // 16: notifications.UserNotificationsService.RegisterDeviceToken:output_type -> notifications.RegisterDeviceTokenResponse
+	17, // 17: notifications.UserNotificationsService.GetUserDeviceToken:output_type -> notifications.GetUserDeviceTokenResponse
+	18, // 18: notifications.UserNotificationsService.GetUserDeviceTokens:output_type -> notifications.GetUserDeviceTokensResponse
+	19, // 19: notifications.UserNotificationsService.ArchiveUserDeviceToken:output_type -> notifications.ArchiveUserDeviceTokenResponse
+	20, // 20: notifications.UserNotificationsService.GetNotificationPreferences:output_type -> notifications.GetNotificationPreferencesResponse
+	21, // 21: notifications.UserNotificationsService.UpdateNotificationPreference:output_type -> notifications.UpdateNotificationPreferenceResponse
+	11, // [11:22] is the sub-list for method output_type
+	0,  // [0:11] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
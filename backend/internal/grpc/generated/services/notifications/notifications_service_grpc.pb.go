@@ -20,13 +20,17 @@
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	UserNotificationsService_GetUserNotification_FullMethodName    = "/notifications.UserNotificationsService/GetUserNotification"
-	UserNotificationsService_GetUserNotifications_FullMethodName   = "/notifications.UserNotificationsService/GetUserNotifications"
-	UserNotificationsService_UpdateUserNotification_FullMethodName = "/notifications.UserNotificationsService/UpdateUserNotification"
-	UserNotificationsService_RegisterDeviceToken_FullMethodName    = "/notifications.UserNotificationsService/RegisterDeviceToken"
-	UserNotificationsService_GetUserDeviceToken_FullMethodName     = "/notifications.UserNotificationsService/GetUserDeviceToken"
-	UserNotificationsService_GetUserDeviceTokens_FullMethodName    = "/notifications.UserNotificationsService/GetUserDeviceTokens"
-	UserNotificationsService_ArchiveUserDeviceToken_FullMethodName = "/notifications.UserNotificationsService/ArchiveUserDeviceToken"
+	UserNotificationsService_GetUserNotification_FullMethodName            = "/notifications.UserNotificationsService/GetUserNotification"
+	UserNotificationsService_GetUserNotifications_FullMethodName           = "/notifications.UserNotificationsService/GetUserNotifications"
+	UserNotificationsService_UpdateUserNotification_FullMethodName         = "/notifications.UserNotificationsService/UpdateUserNotification"
+	UserNotificationsService_MarkAllUserNotificationsAsRead_FullMethodName = "/notifications.UserNotificationsService/MarkAllUserNotificationsAsRead"
+	UserNotificationsService_GetUnreadUserNotificationCount_FullMethodName = "/notifications.UserNotificationsService/GetUnreadUserNotificationCount"
+	UserNotificationsService_RegisterDeviceToken_FullMethodName            = "/notifications.UserNotificationsService/RegisterDeviceToken"
+	UserNotificationsService_GetUserDeviceToken_FullMethodName             = "/notifications.UserNotificationsService/GetUserDeviceToken"
+	UserNotificationsService_GetUserDeviceTokens_FullMethodName            = "/notifications.UserNotificationsService/GetUserDeviceTokens"
+	UserNotificationsService_ArchiveUserDeviceToken_FullMethodName         = "/notifications.UserNotificationsService/ArchiveUserDeviceToken"
+	UserNotificationsService_GetNotificationPreferences_FullMethodName     = "/notifications.UserNotificationsService/GetNotificationPreferences"
+	UserNotificationsService_UpdateNotificationPreference_FullMethodName   = "/notifications.UserNotificationsService/UpdateNotificationPreference"
 )
 
 // UserNotificationsServiceClient is the client API for UserNotificationsService service.
@@ -36,10 +40,14 @@ type UserNotificationsServiceClient interface {
 	GetUserNotification(ctx context.Context, in *GetUserNotificationRequest, opts ...grpc.CallOption) (*GetUserNotificationResponse, error)
 	GetUserNotifications(ctx context.Context, in *GetUserNotificationsRequest, opts ...grpc.CallOption) (*GetUserNotificationsResponse, error)
 	UpdateUserNotification(ctx context.Context, in *UpdateUserNotificationRequest, opts ...grpc.CallOption) (*UpdateUserNotificationResponse, error)
+	MarkAllUserNotificationsAsRead(ctx context.Context, in *MarkAllUserNotificationsAsReadRequest, opts ...grpc.CallOption) (*MarkAllUserNotificationsAsReadResponse, error)
+	GetUnreadUserNotificationCount(ctx context.Context, in *GetUnreadUserNotificationCountRequest, opts ...grpc.CallOption) (*GetUnreadUserNotificationCountResponse, error)
 	RegisterDeviceToken(ctx context.Context, in *RegisterDeviceTokenRequest, opts ...grpc.CallOption) (*RegisterDeviceTokenResponse, error)
 	GetUserDeviceToken(ctx context.Context, in *GetUserDeviceTokenRequest, opts ...grpc.CallOption) (*GetUserDeviceTokenResponse, error)
 	GetUserDeviceTokens(ctx context.Context, in *GetUserDeviceTokensRequest, opts ...grpc.CallOption) (*GetUserDeviceTokensResponse, error)
 	ArchiveUserDeviceToken(ctx context.Context, in *ArchiveUserDeviceTokenRequest, opts ...grpc.CallOption) (*ArchiveUserDeviceTokenResponse, error)
+	GetNotificationPreferences(ctx context.Context, in *GetNotificationPreferencesRequest, opts ...grpc.CallOption) (*GetNotificationPreferencesResponse, error)
+	UpdateNotificationPreference(ctx context.Context, in *UpdateNotificationPreferenceRequest, opts ...grpc.CallOption) (*UpdateNotificationPreferenceResponse, error)
 }
 
 type userNotificationsServiceClient struct {
@@ -80,6 +88,26 @@ func (c *userNotificationsServiceClient) UpdateUserNotification(ctx context.Cont
 	return out, nil
 }
 
+func (c *userNotificationsServiceClient) MarkAllUserNotificationsAsRead(ctx context.Context, in *MarkAllUserNotificationsAsReadRequest, opts ...grpc.CallOption) (*MarkAllUserNotificationsAsReadResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(MarkAllUserNotificationsAsReadResponse)
+	err := c.cc.Invoke(ctx, UserNotificationsService_MarkAllUserNotificationsAsRead_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userNotificationsServiceClient) GetUnreadUserNotificationCount(ctx context.Context, in *GetUnreadUserNotificationCountRequest, opts ...grpc.CallOption) (*GetUnreadUserNotificationCountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetUnreadUserNotificationCountResponse)
+	err := c.cc.Invoke(ctx, UserNotificationsService_GetUnreadUserNotificationCount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *userNotificationsServiceClient) RegisterDeviceToken(ctx context.Context, in *RegisterDeviceTokenRequest, opts ...grpc.CallOption) (*RegisterDeviceTokenResponse, error) {
 	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
 	out := new(RegisterDeviceTokenResponse)
@@ -120,6 +148,26 @@ func (c *userNotificationsServiceClient) ArchiveUserDeviceToken(ctx context.Cont
 	return out, nil
 }
 
+func (c *userNotificationsServiceClient) GetNotificationPreferences(ctx context.Context, in *GetNotificationPreferencesRequest, opts ...grpc.CallOption) (*GetNotificationPreferencesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetNotificationPreferencesResponse)
+	err := c.cc.Invoke(ctx, UserNotificationsService_GetNotificationPreferences_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *userNotificationsServiceClient) UpdateNotificationPreference(ctx context.Context, in *UpdateNotificationPreferenceRequest, opts ...grpc.CallOption) (*UpdateNotificationPreferenceResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateNotificationPreferenceResponse)
+	err := c.cc.Invoke(ctx, UserNotificationsService_UpdateNotificationPreference_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // UserNotificationsServiceServer is the server API for UserNotificationsService service.
 // All implementations must embed UnimplementedUserNotificationsServiceServer
 // for forward compatibility.
@@ -127,10 +175,14 @@ type UserNotificationsServiceServer interface {
 	GetUserNotification(context.Context, *GetUserNotificationRequest) (*GetUserNotificationResponse, error)
 	GetUserNotifications(context.Context, *GetUserNotificationsRequest) (*GetUserNotificationsResponse, error)
 	UpdateUserNotification(context.Context, *UpdateUserNotificationRequest) (*UpdateUserNotificationResponse, error)
+	MarkAllUserNotificationsAsRead(context.Context, *MarkAllUserNotificationsAsReadRequest) (*MarkAllUserNotificationsAsReadResponse, error)
+	GetUnreadUserNotificationCount(context.Context, *GetUnreadUserNotificationCountRequest) (*GetUnreadUserNotificationCountResponse, error)
 	RegisterDeviceToken(context.Context, *RegisterDeviceTokenRequest) (*RegisterDeviceTokenResponse, error)
 	GetUserDeviceToken(context.Context, *GetUserDeviceTokenRequest) (*GetUserDeviceTokenResponse, error)
 	GetUserDeviceTokens(context.Context, *GetUserDeviceTokensRequest) (*GetUserDeviceTokensResponse, error)
 	ArchiveUserDeviceToken(context.Context, *ArchiveUserDeviceTokenRequest) (*ArchiveUserDeviceTokenResponse, error)
+	GetNotificationPreferences(context.Context, *GetNotificationPreferencesRequest) (*GetNotificationPreferencesResponse, error)
+	UpdateNotificationPreference(context.Context, *UpdateNotificationPreferenceRequest) (*UpdateNotificationPreferenceResponse, error)
 	mustEmbedUnimplementedUserNotificationsServiceServer()
 }
 
@@ -150,6 +202,12 @@ func (UnimplementedUserNotificationsServiceServer) GetUserNotifications(context.
 func (UnimplementedUserNotificationsServiceServer) UpdateUserNotification(context.Context, *UpdateUserNotificationRequest) (*UpdateUserNotificationResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateUserNotification not implemented")
 }
+func (UnimplementedUserNotificationsServiceServer) MarkAllUserNotificationsAsRead(context.Context, *MarkAllUserNotificationsAsReadRequest) (*MarkAllUserNotificationsAsReadResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarkAllUserNotificationsAsRead not implemented")
+}
+func (UnimplementedUserNotificationsServiceServer) GetUnreadUserNotificationCount(context.Context, *GetUnreadUserNotificationCountRequest) (*GetUnreadUserNotificationCountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetUnreadUserNotificationCount not implemented")
+}
 func (UnimplementedUserNotificationsServiceServer) RegisterDeviceToken(context.Context, *RegisterDeviceTokenRequest) (*RegisterDeviceTokenResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RegisterDeviceToken not implemented")
 }
@@ -162,6 +220,12 @@ func (UnimplementedUserNotificationsServiceServer) GetUserDeviceTokens(context.C
 func (UnimplementedUserNotificationsServiceServer) ArchiveUserDeviceToken(context.Context, *ArchiveUserDeviceTokenRequest) (*ArchiveUserDeviceTokenResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ArchiveUserDeviceToken not implemented")
 }
+func (UnimplementedUserNotificationsServiceServer) GetNotificationPreferences(context.Context, *GetNotificationPreferencesRequest) (*GetNotificationPreferencesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetNotificationPreferences not implemented")
+}
+func (UnimplementedUserNotificationsServiceServer) UpdateNotificationPreference(context.Context, *UpdateNotificationPreferenceRequest) (*UpdateNotificationPreferenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNotificationPreference not implemented")
+}
 func (UnimplementedUserNotificationsServiceServer) mustEmbedUnimplementedUserNotificationsServiceServer() {
 }
 func (UnimplementedUserNotificationsServiceServer) testEmbeddedByValue() {}
@@ -238,6 +302,42 @@ func _UserNotificationsService_UpdateUserNotification_Handler(srv interface{}, c
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserNotificationsService_MarkAllUserNotificationsAsRead_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarkAllUserNotificationsAsReadRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserNotificationsServiceServer).MarkAllUserNotificationsAsRead(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserNotificationsService_MarkAllUserNotificationsAsRead_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserNotificationsServiceServer).MarkAllUserNotificationsAsRead(ctx, req.(*MarkAllUserNotificationsAsReadRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserNotificationsService_GetUnreadUserNotificationCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUnreadUserNotificationCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserNotificationsServiceServer).GetUnreadUserNotificationCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserNotificationsService_GetUnreadUserNotificationCount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserNotificationsServiceServer).GetUnreadUserNotificationCount(ctx, req.(*GetUnreadUserNotificationCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _UserNotificationsService_RegisterDeviceToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RegisterDeviceTokenRequest)
 	if err := dec(in); err != nil {
@@ -310,6 +410,42 @@ func _UserNotificationsService_ArchiveUserDeviceToken_Handler(srv interface{}, c
 	return interceptor(ctx, in, info, handler)
 }
 
+func _UserNotificationsService_GetNotificationPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNotificationPreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserNotificationsServiceServer).GetNotificationPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserNotificationsService_GetNotificationPreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserNotificationsServiceServer).GetNotificationPreferences(ctx, req.(*GetNotificationPreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _UserNotificationsService_UpdateNotificationPreference_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateNotificationPreferenceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(UserNotificationsServiceServer).UpdateNotificationPreference(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: UserNotificationsService_UpdateNotificationPreference_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(UserNotificationsServiceServer).UpdateNotificationPreference(ctx, req.(*UpdateNotificationPreferenceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // UserNotificationsService_ServiceDesc is the grpc.ServiceDesc for UserNotificationsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -329,6 +465,14 @@ func _UserNotificationsService_ArchiveUserDeviceToken_Handler(srv interface{}, c
 			MethodName: "UpdateUserNotification",
 			Handler:    _UserNotificationsService_UpdateUserNotification_Handler,
 		},
+		{
+			MethodName: "MarkAllUserNotificationsAsRead",
+			Handler:    _UserNotificationsService_MarkAllUserNotificationsAsRead_Handler,
+		},
+		{
+			MethodName: "GetUnreadUserNotificationCount",
+			Handler:    _UserNotificationsService_GetUnreadUserNotificationCount_Handler,
+		},
 		{
 			MethodName: "RegisterDeviceToken",
 			Handler:    _UserNotificationsService_RegisterDeviceToken_Handler,
@@ -345,6 +489,14 @@ func _UserNotificationsService_ArchiveUserDeviceToken_Handler(srv interface{}, c
 			MethodName: "ArchiveUserDeviceToken",
 			Handler:    _UserNotificationsService_ArchiveUserDeviceToken_Handler,
 		},
+		{
+			MethodName: "GetNotificationPreferences",
+			Handler:    _UserNotificationsService_GetNotificationPreferences_Handler,
+		},
+		{
+			MethodName: "UpdateNotificationPreference",
+			Handler:    _UserNotificationsService_UpdateNotificationPreference_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "notifications/notifications_service.proto",
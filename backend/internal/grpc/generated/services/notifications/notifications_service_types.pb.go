@@ -329,6 +329,182 @@ func (x *UpdateUserNotificationResponse) GetUpdated() *UserNotification {
 	return nil
 }
 
+type MarkAllUserNotificationsAsReadRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MarkAllUserNotificationsAsReadRequest) Reset() {
+	*x = MarkAllUserNotificationsAsReadRequest{}
+	mi := &file_notifications_notifications_service_types_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkAllUserNotificationsAsReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkAllUserNotificationsAsReadRequest) ProtoMessage() {}
+
+func (x *MarkAllUserNotificationsAsReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notifications_notifications_service_types_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkAllUserNotificationsAsReadRequest.ProtoReflect.Descriptor instead.
+func (*MarkAllUserNotificationsAsReadRequest) Descriptor() ([]byte, []int) {
+	return file_notifications_notifications_service_types_proto_rawDescGZIP(), []int{6}
+}
+
+type MarkAllUserNotificationsAsReadResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ResponseDetails *types.ResponseDetails `protobuf:"bytes,1,opt,name=response_details,json=responseDetails,proto3" json:"response_details,omitempty"`
+	Marked          int64                  `protobuf:"varint,2,opt,name=marked,proto3" json:"marked,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MarkAllUserNotificationsAsReadResponse) Reset() {
+	*x = MarkAllUserNotificationsAsReadResponse{}
+	mi := &file_notifications_notifications_service_types_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MarkAllUserNotificationsAsReadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MarkAllUserNotificationsAsReadResponse) ProtoMessage() {}
+
+func (x *MarkAllUserNotificationsAsReadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notifications_notifications_service_types_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MarkAllUserNotificationsAsReadResponse.ProtoReflect.Descriptor instead.
+func (*MarkAllUserNotificationsAsReadResponse) Descriptor() ([]byte, []int) {
+	return file_notifications_notifications_service_types_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *MarkAllUserNotificationsAsReadResponse) GetResponseDetails() *types.ResponseDetails {
+	if x != nil {
+		return x.ResponseDetails
+	}
+	return nil
+}
+
+func (x *MarkAllUserNotificationsAsReadResponse) GetMarked() int64 {
+	if x != nil {
+		return x.Marked
+	}
+	return 0
+}
+
+type GetUnreadUserNotificationCountRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUnreadUserNotificationCountRequest) Reset() {
+	*x = GetUnreadUserNotificationCountRequest{}
+	mi := &file_notifications_notifications_service_types_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUnreadUserNotificationCountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUnreadUserNotificationCountRequest) ProtoMessage() {}
+
+func (x *GetUnreadUserNotificationCountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notifications_notifications_service_types_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUnreadUserNotificationCountRequest.ProtoReflect.Descriptor instead.
+func (*GetUnreadUserNotificationCountRequest) Descriptor() ([]byte, []int) {
+	return file_notifications_notifications_service_types_proto_rawDescGZIP(), []int{8}
+}
+
+type GetUnreadUserNotificationCountResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ResponseDetails *types.ResponseDetails `protobuf:"bytes,1,opt,name=response_details,json=responseDetails,proto3" json:"response_details,omitempty"`
+	Count           uint64                 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetUnreadUserNotificationCountResponse) Reset() {
+	*x = GetUnreadUserNotificationCountResponse{}
+	mi := &file_notifications_notifications_service_types_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUnreadUserNotificationCountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUnreadUserNotificationCountResponse) ProtoMessage() {}
+
+func (x *GetUnreadUserNotificationCountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notifications_notifications_service_types_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUnreadUserNotificationCountResponse.ProtoReflect.Descriptor instead.
+func (*GetUnreadUserNotificationCountResponse) Descriptor() ([]byte, []int) {
+	return file_notifications_notifications_service_types_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetUnreadUserNotificationCountResponse) GetResponseDetails() *types.ResponseDetails {
+	if x != nil {
+		return x.ResponseDetails
+	}
+	return nil
+}
+
+func (x *GetUnreadUserNotificationCountResponse) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
 type UserNotificationCreationRequestInput struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Content       string                 `protobuf:"bytes,1,opt,name=content,proto3" json:"content,omitempty"`
@@ -340,7 +516,7 @@ type UserNotificationCreationRequestInput struct {
 
 func (x *UserNotificationCreationRequestInput) Reset() {
 	*x = UserNotificationCreationRequestInput{}
-	mi := &file_notifications_notifications_service_types_proto_msgTypes[6]
+	mi := &file_notifications_notifications_service_types_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -352,7 +528,7 @@ func (x *UserNotificationCreationRequestInput) String() string {
 func (*UserNotificationCreationRequestInput) ProtoMessage() {}
 
 func (x *UserNotificationCreationRequestInput) ProtoReflect() protoreflect.Message {
-	mi := &file_notifications_notifications_service_types_proto_msgTypes[6]
+	mi := &file_notifications_notifications_service_types_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -365,7 +541,7 @@ func (x *UserNotificationCreationRequestInput) ProtoReflect() protoreflect.Messa
 
 // Deprecated: Use UserNotificationCreationRequestInput.ProtoReflect.Descriptor instead.
 func (*UserNotificationCreationRequestInput) Descriptor() ([]byte, []int) {
-	return file_notifications_notifications_service_types_proto_rawDescGZIP(), []int{6}
+	return file_notifications_notifications_service_types_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *UserNotificationCreationRequestInput) GetContent() string {
@@ -398,7 +574,7 @@ type UserNotificationUpdateRequestInput struct {
 
 func (x *UserNotificationUpdateRequestInput) Reset() {
 	*x = UserNotificationUpdateRequestInput{}
-	mi := &file_notifications_notifications_service_types_proto_msgTypes[7]
+	mi := &file_notifications_notifications_service_types_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -410,7 +586,7 @@ func (x *UserNotificationUpdateRequestInput) String() string {
 func (*UserNotificationUpdateRequestInput) ProtoMessage() {}
 
 func (x *UserNotificationUpdateRequestInput) ProtoReflect() protoreflect.Message {
-	mi := &file_notifications_notifications_service_types_proto_msgTypes[7]
+	mi := &file_notifications_notifications_service_types_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -423,7 +599,7 @@ func (x *UserNotificationUpdateRequestInput) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use UserNotificationUpdateRequestInput.ProtoReflect.Descriptor instead.
 func (*UserNotificationUpdateRequestInput) Descriptor() ([]byte, []int) {
-	return file_notifications_notifications_service_types_proto_rawDescGZIP(), []int{7}
+	return file_notifications_notifications_service_types_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *UserNotificationUpdateRequestInput) GetStatus() UserNotificationStatus {
@@ -442,7 +618,7 @@ type RegisterDeviceTokenRequest struct {
 
 func (x *RegisterDeviceTokenRequest) Reset() {
 	*x = RegisterDeviceTokenRequest{}
-	mi := &file_notifications_notifications_service_types_proto_msgTypes[8]
+	mi := &file_notifications_notifications_service_types_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -454,7 +630,7 @@ func (x *RegisterDeviceTokenRequest) String() string {
 func (*RegisterDeviceTokenRequest) ProtoMessage() {}
 
 func (x *RegisterDeviceTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_notifications_notifications_service_types_proto_msgTypes[8]
+	mi := &file_notifications_notifications_service_types_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -467,7 +643,7 @@ func (x *RegisterDeviceTokenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisterDeviceTokenRequest.ProtoReflect.Descriptor instead.
 func (*RegisterDeviceTokenRequest) Descriptor() ([]byte, []int) {
-	return file_notifications_notifications_service_types_proto_rawDescGZIP(), []int{8}
+	return file_notifications_notifications_service_types_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *RegisterDeviceTokenRequest) GetInput() *UserDeviceTokenCreationRequestInput {
@@ -487,7 +663,7 @@ type RegisterDeviceTokenResponse struct {
 
 func (x *RegisterDeviceTokenResponse) Reset() {
 	*x = RegisterDeviceTokenResponse{}
-	mi := &file_notifications_notifications_service_types_proto_msgTypes[9]
+	mi := &file_notifications_notifications_service_types_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -499,7 +675,7 @@ func (x *RegisterDeviceTokenResponse) String() string {
 func (*RegisterDeviceTokenResponse) ProtoMessage() {}
 
 func (x *RegisterDeviceTokenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_notifications_notifications_service_types_proto_msgTypes[9]
+	mi := &file_notifications_notifications_service_types_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -512,7 +688,7 @@ func (x *RegisterDeviceTokenResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RegisterDeviceTokenResponse.ProtoReflect.Descriptor instead.
 func (*RegisterDeviceTokenResponse) Descriptor() ([]byte, []int) {
-	return file_notifications_notifications_service_types_proto_rawDescGZIP(), []int{9}
+	return file_notifications_notifications_service_types_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *RegisterDeviceTokenResponse) GetResponseDetails() *types.ResponseDetails {
@@ -538,7 +714,7 @@ type GetUserDeviceTokenRequest struct {
 
 func (x *GetUserDeviceTokenRequest) Reset() {
 	*x = GetUserDeviceTokenRequest{}
-	mi := &file_notifications_notifications_service_types_proto_msgTypes[10]
+	mi := &file_notifications_notifications_service_types_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -550,7 +726,7 @@ func (x *GetUserDeviceTokenRequest) String() string {
 func (*GetUserDeviceTokenRequest) ProtoMessage() {}
 
 func (x *GetUserDeviceTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_notifications_notifications_service_types_proto_msgTypes[10]
+	mi := &file_notifications_notifications_service_types_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -563,7 +739,7 @@ func (x *GetUserDeviceTokenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserDeviceTokenRequest.ProtoReflect.Descriptor instead.
 func (*GetUserDeviceTokenRequest) Descriptor() ([]byte, []int) {
-	return file_notifications_notifications_service_types_proto_rawDescGZIP(), []int{10}
+	return file_notifications_notifications_service_types_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GetUserDeviceTokenRequest) GetUserDeviceTokenId() string {
@@ -583,7 +759,7 @@ type GetUserDeviceTokenResponse struct {
 
 func (x *GetUserDeviceTokenResponse) Reset() {
 	*x = GetUserDeviceTokenResponse{}
-	mi := &file_notifications_notifications_service_types_proto_msgTypes[11]
+	mi := &file_notifications_notifications_service_types_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -595,7 +771,7 @@ func (x *GetUserDeviceTokenResponse) String() string {
 func (*GetUserDeviceTokenResponse) ProtoMessage() {}
 
 func (x *GetUserDeviceTokenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_notifications_notifications_service_types_proto_msgTypes[11]
+	mi := &file_notifications_notifications_service_types_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -608,7 +784,7 @@ func (x *GetUserDeviceTokenResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserDeviceTokenResponse.ProtoReflect.Descriptor instead.
 func (*GetUserDeviceTokenResponse) Descriptor() ([]byte, []int) {
-	return file_notifications_notifications_service_types_proto_rawDescGZIP(), []int{11}
+	return file_notifications_notifications_service_types_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *GetUserDeviceTokenResponse) GetResponseDetails() *types.ResponseDetails {
@@ -635,7 +811,7 @@ type GetUserDeviceTokensRequest struct {
 
 func (x *GetUserDeviceTokensRequest) Reset() {
 	*x = GetUserDeviceTokensRequest{}
-	mi := &file_notifications_notifications_service_types_proto_msgTypes[12]
+	mi := &file_notifications_notifications_service_types_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -647,7 +823,7 @@ func (x *GetUserDeviceTokensRequest) String() string {
 func (*GetUserDeviceTokensRequest) ProtoMessage() {}
 
 func (x *GetUserDeviceTokensRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_notifications_notifications_service_types_proto_msgTypes[12]
+	mi := &file_notifications_notifications_service_types_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -660,7 +836,7 @@ func (x *GetUserDeviceTokensRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserDeviceTokensRequest.ProtoReflect.Descriptor instead.
 func (*GetUserDeviceTokensRequest) Descriptor() ([]byte, []int) {
-	return file_notifications_notifications_service_types_proto_rawDescGZIP(), []int{12}
+	return file_notifications_notifications_service_types_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *GetUserDeviceTokensRequest) GetFilter() *filtering.QueryFilter {
@@ -688,7 +864,7 @@ type GetUserDeviceTokensResponse struct {
 
 func (x *GetUserDeviceTokensResponse) Reset() {
 	*x = GetUserDeviceTokensResponse{}
-	mi := &file_notifications_notifications_service_types_proto_msgTypes[13]
+	mi := &file_notifications_notifications_service_types_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -700,7 +876,7 @@ func (x *GetUserDeviceTokensResponse) String() string {
 func (*GetUserDeviceTokensResponse) ProtoMessage() {}
 
 func (x *GetUserDeviceTokensResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_notifications_notifications_service_types_proto_msgTypes[13]
+	mi := &file_notifications_notifications_service_types_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -713,7 +889,7 @@ func (x *GetUserDeviceTokensResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserDeviceTokensResponse.ProtoReflect.Descriptor instead.
 func (*GetUserDeviceTokensResponse) Descriptor() ([]byte, []int) {
-	return file_notifications_notifications_service_types_proto_rawDescGZIP(), []int{13}
+	return file_notifications_notifications_service_types_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *GetUserDeviceTokensResponse) GetResponseDetails() *types.ResponseDetails {
@@ -746,7 +922,7 @@ type ArchiveUserDeviceTokenRequest struct {
 
 func (x *ArchiveUserDeviceTokenRequest) Reset() {
 	*x = ArchiveUserDeviceTokenRequest{}
-	mi := &file_notifications_notifications_service_types_proto_msgTypes[14]
+	mi := &file_notifications_notifications_service_types_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -758,7 +934,7 @@ func (x *ArchiveUserDeviceTokenRequest) String() string {
 func (*ArchiveUserDeviceTokenRequest) ProtoMessage() {}
 
 func (x *ArchiveUserDeviceTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_notifications_notifications_service_types_proto_msgTypes[14]
+	mi := &file_notifications_notifications_service_types_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -771,7 +947,7 @@ func (x *ArchiveUserDeviceTokenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArchiveUserDeviceTokenRequest.ProtoReflect.Descriptor instead.
 func (*ArchiveUserDeviceTokenRequest) Descriptor() ([]byte, []int) {
-	return file_notifications_notifications_service_types_proto_rawDescGZIP(), []int{14}
+	return file_notifications_notifications_service_types_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *ArchiveUserDeviceTokenRequest) GetUserDeviceTokenId() string {
@@ -790,7 +966,7 @@ type ArchiveUserDeviceTokenResponse struct {
 
 func (x *ArchiveUserDeviceTokenResponse) Reset() {
 	*x = ArchiveUserDeviceTokenResponse{}
-	mi := &file_notifications_notifications_service_types_proto_msgTypes[15]
+	mi := &file_notifications_notifications_service_types_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -802,7 +978,7 @@ func (x *ArchiveUserDeviceTokenResponse) String() string {
 func (*ArchiveUserDeviceTokenResponse) ProtoMessage() {}
 
 func (x *ArchiveUserDeviceTokenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_notifications_notifications_service_types_proto_msgTypes[15]
+	mi := &file_notifications_notifications_service_types_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -815,7 +991,7 @@ func (x *ArchiveUserDeviceTokenResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ArchiveUserDeviceTokenResponse.ProtoReflect.Descriptor instead.
 func (*ArchiveUserDeviceTokenResponse) Descriptor() ([]byte, []int) {
-	return file_notifications_notifications_service_types_proto_rawDescGZIP(), []int{15}
+	return file_notifications_notifications_service_types_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *ArchiveUserDeviceTokenResponse) GetResponseDetails() *types.ResponseDetails {
@@ -835,7 +1011,7 @@ type UserDeviceTokenCreationRequestInput struct {
 
 func (x *UserDeviceTokenCreationRequestInput) Reset() {
 	*x = UserDeviceTokenCreationRequestInput{}
-	mi := &file_notifications_notifications_service_types_proto_msgTypes[16]
+	mi := &file_notifications_notifications_service_types_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -847,7 +1023,7 @@ func (x *UserDeviceTokenCreationRequestInput) String() string {
 func (*UserDeviceTokenCreationRequestInput) ProtoMessage() {}
 
 func (x *UserDeviceTokenCreationRequestInput) ProtoReflect() protoreflect.Message {
-	mi := &file_notifications_notifications_service_types_proto_msgTypes[16]
+	mi := &file_notifications_notifications_service_types_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -860,7 +1036,7 @@ func (x *UserDeviceTokenCreationRequestInput) ProtoReflect() protoreflect.Messag
 
 // Deprecated: Use UserDeviceTokenCreationRequestInput.ProtoReflect.Descriptor instead.
 func (*UserDeviceTokenCreationRequestInput) Descriptor() ([]byte, []int) {
-	return file_notifications_notifications_service_types_proto_rawDescGZIP(), []int{16}
+	return file_notifications_notifications_service_types_proto_rawDescGZIP(), []int{20}
 }
 
 func (x *UserDeviceTokenCreationRequestInput) GetDeviceToken() string {
@@ -877,6 +1053,290 @@ func (x *UserDeviceTokenCreationRequestInput) GetPlatform() string {
 	return ""
 }
 
+type GetNotificationPreferencesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNotificationPreferencesRequest) Reset() {
+	*x = GetNotificationPreferencesRequest{}
+	mi := &file_notifications_notifications_service_types_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNotificationPreferencesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationPreferencesRequest) ProtoMessage() {}
+
+func (x *GetNotificationPreferencesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notifications_notifications_service_types_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationPreferencesRequest.ProtoReflect.Descriptor instead.
+func (*GetNotificationPreferencesRequest) Descriptor() ([]byte, []int) {
+	return file_notifications_notifications_service_types_proto_rawDescGZIP(), []int{21}
+}
+
+type GetNotificationPreferencesResponse struct {
+	state           protoimpl.MessageState    `protogen:"open.v1"`
+	ResponseDetails *types.ResponseDetails    `protobuf:"bytes,1,opt,name=response_details,json=responseDetails,proto3" json:"response_details,omitempty"`
+	Results         []*NotificationPreference `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetNotificationPreferencesResponse) Reset() {
+	*x = GetNotificationPreferencesResponse{}
+	mi := &file_notifications_notifications_service_types_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNotificationPreferencesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationPreferencesResponse) ProtoMessage() {}
+
+func (x *GetNotificationPreferencesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notifications_notifications_service_types_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationPreferencesResponse.ProtoReflect.Descriptor instead.
+func (*GetNotificationPreferencesResponse) Descriptor() ([]byte, []int) {
+	return file_notifications_notifications_service_types_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetNotificationPreferencesResponse) GetResponseDetails() *types.ResponseDetails {
+	if x != nil {
+		return x.ResponseDetails
+	}
+	return nil
+}
+
+func (x *GetNotificationPreferencesResponse) GetResults() []*NotificationPreference {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type UpdateNotificationPreferenceRequest struct {
+	state            protoimpl.MessageState                    `protogen:"open.v1"`
+	NotificationType string                                    `protobuf:"bytes,1,opt,name=notification_type,json=notificationType,proto3" json:"notification_type,omitempty"`
+	Input            *NotificationPreferenceUpdateRequestInput `protobuf:"bytes,2,opt,name=input,proto3" json:"input,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *UpdateNotificationPreferenceRequest) Reset() {
+	*x = UpdateNotificationPreferenceRequest{}
+	mi := &file_notifications_notifications_service_types_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNotificationPreferenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNotificationPreferenceRequest) ProtoMessage() {}
+
+func (x *UpdateNotificationPreferenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_notifications_notifications_service_types_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNotificationPreferenceRequest.ProtoReflect.Descriptor instead.
+func (*UpdateNotificationPreferenceRequest) Descriptor() ([]byte, []int) {
+	return file_notifications_notifications_service_types_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *UpdateNotificationPreferenceRequest) GetNotificationType() string {
+	if x != nil {
+		return x.NotificationType
+	}
+	return ""
+}
+
+func (x *UpdateNotificationPreferenceRequest) GetInput() *NotificationPreferenceUpdateRequestInput {
+	if x != nil {
+		return x.Input
+	}
+	return nil
+}
+
+type UpdateNotificationPreferenceResponse struct {
+	state           protoimpl.MessageState  `protogen:"open.v1"`
+	ResponseDetails *types.ResponseDetails  `protobuf:"bytes,1,opt,name=response_details,json=responseDetails,proto3" json:"response_details,omitempty"`
+	Updated         *NotificationPreference `protobuf:"bytes,2,opt,name=updated,proto3" json:"updated,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateNotificationPreferenceResponse) Reset() {
+	*x = UpdateNotificationPreferenceResponse{}
+	mi := &file_notifications_notifications_service_types_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNotificationPreferenceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNotificationPreferenceResponse) ProtoMessage() {}
+
+func (x *UpdateNotificationPreferenceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_notifications_notifications_service_types_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNotificationPreferenceResponse.ProtoReflect.Descriptor instead.
+func (*UpdateNotificationPreferenceResponse) Descriptor() ([]byte, []int) {
+	return file_notifications_notifications_service_types_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *UpdateNotificationPreferenceResponse) GetResponseDetails() *types.ResponseDetails {
+	if x != nil {
+		return x.ResponseDetails
+	}
+	return nil
+}
+
+func (x *UpdateNotificationPreferenceResponse) GetUpdated() *NotificationPreference {
+	if x != nil {
+		return x.Updated
+	}
+	return nil
+}
+
+type NotificationPreferenceUpdateRequestInput struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	PushEnabled     *bool                  `protobuf:"varint,1,opt,name=push_enabled,json=pushEnabled,proto3,oneof" json:"push_enabled,omitempty"`
+	EmailEnabled    *bool                  `protobuf:"varint,2,opt,name=email_enabled,json=emailEnabled,proto3,oneof" json:"email_enabled,omitempty"`
+	InAppEnabled    *bool                  `protobuf:"varint,3,opt,name=in_app_enabled,json=inAppEnabled,proto3,oneof" json:"in_app_enabled,omitempty"`
+	DigestMode      *string                `protobuf:"bytes,4,opt,name=digest_mode,json=digestMode,proto3,oneof" json:"digest_mode,omitempty"`
+	Timezone        *string                `protobuf:"bytes,5,opt,name=timezone,proto3,oneof" json:"timezone,omitempty"`
+	QuietHoursStart *string                `protobuf:"bytes,6,opt,name=quiet_hours_start,json=quietHoursStart,proto3,oneof" json:"quiet_hours_start,omitempty"`
+	QuietHoursEnd   *string                `protobuf:"bytes,7,opt,name=quiet_hours_end,json=quietHoursEnd,proto3,oneof" json:"quiet_hours_end,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *NotificationPreferenceUpdateRequestInput) Reset() {
+	*x = NotificationPreferenceUpdateRequestInput{}
+	mi := &file_notifications_notifications_service_types_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationPreferenceUpdateRequestInput) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationPreferenceUpdateRequestInput) ProtoMessage() {}
+
+func (x *NotificationPreferenceUpdateRequestInput) ProtoReflect() protoreflect.Message {
+	mi := &file_notifications_notifications_service_types_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationPreferenceUpdateRequestInput.ProtoReflect.Descriptor instead.
+func (*NotificationPreferenceUpdateRequestInput) Descriptor() ([]byte, []int) {
+	return file_notifications_notifications_service_types_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *NotificationPreferenceUpdateRequestInput) GetPushEnabled() bool {
+	if x != nil && x.PushEnabled != nil {
+		return *x.PushEnabled
+	}
+	return false
+}
+
+func (x *NotificationPreferenceUpdateRequestInput) GetEmailEnabled() bool {
+	if x != nil && x.EmailEnabled != nil {
+		return *x.EmailEnabled
+	}
+	return false
+}
+
+func (x *NotificationPreferenceUpdateRequestInput) GetInAppEnabled() bool {
+	if x != nil && x.InAppEnabled != nil {
+		return *x.InAppEnabled
+	}
+	return false
+}
+
+func (x *NotificationPreferenceUpdateRequestInput) GetDigestMode() string {
+	if x != nil && x.DigestMode != nil {
+		return *x.DigestMode
+	}
+	return ""
+}
+
+func (x *NotificationPreferenceUpdateRequestInput) GetTimezone() string {
+	if x != nil && x.Timezone != nil {
+		return *x.Timezone
+	}
+	return ""
+}
+
+func (x *NotificationPreferenceUpdateRequestInput) GetQuietHoursStart() string {
+	if x != nil && x.QuietHoursStart != nil {
+		return *x.QuietHoursStart
+	}
+	return ""
+}
+
+func (x *NotificationPreferenceUpdateRequestInput) GetQuietHoursEnd() string {
+	if x != nil && x.QuietHoursEnd != nil {
+		return *x.QuietHoursEnd
+	}
+	return ""
+}
+
 var File_notifications_notifications_service_types_proto protoreflect.FileDescriptor
 
 var file_notifications_notifications_service_types_proto_rawDesc = string([]byte{
@@ -941,103 +1401,188 @@ func (x *UserDeviceTokenCreationRequestInput) GetPlatform() string {
 	0x6c, 0x73, 0x12, 0x39, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20,
 	0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
 	0x6f, 0x6e, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x22, 0xa7, 0x01,
-	0x0a, 0x24, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
-	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
-	0x12, 0x3d, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x22, 0x27, 0x0a,
+	0x25, 0x4d, 0x61, 0x72, 0x6b, 0x41, 0x6c, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x41, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x84, 0x01, 0x0a, 0x26, 0x4d, 0x61, 0x72, 0x6b, 0x41,
+	0x6c, 0x6c, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x41, 0x73, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x42, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x64, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x6d, 0x61, 0x72, 0x6b, 0x65, 0x64, 0x22, 0x27, 0x0a,
+	0x25, 0x47, 0x65, 0x74, 0x55, 0x6e, 0x72, 0x65, 0x61, 0x64, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x82, 0x01, 0x0a, 0x26, 0x47, 0x65, 0x74, 0x55, 0x6e,
+	0x72, 0x65, 0x61, 0x64, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x42, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x64, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xa7, 0x01, 0x0a, 0x24,
+	0x55, 0x73, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49,
+	0x6e, 0x70, 0x75, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x12, 0x3d,
+	0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25,
+	0x2e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x55,
+	0x73, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x26, 0x0a,
+	0x0f, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x75, 0x73, 0x65, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x54,
+	0x6f, 0x55, 0x73, 0x65, 0x72, 0x22, 0x73, 0x0a, 0x22, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x42, 0x0a, 0x06, 0x73,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e, 0x6e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72,
+	0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x48, 0x00, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x88, 0x01, 0x01, 0x42,
+	0x09, 0x0a, 0x07, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x66, 0x0a, 0x1a, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x48, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75,
+	0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x05, 0x69, 0x6e, 0x70,
+	0x75, 0x74, 0x22, 0x9b, 0x01, 0x0a, 0x1b, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x44,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x42, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x64,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63,
+	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65,
+	0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x38, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x76, 0x69,
+	0x63, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64,
+	0x22, 0x4c, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2f, 0x0a,
+	0x14, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x75, 0x73, 0x65,
+	0x72, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64, 0x22, 0x98,
+	0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a,
+	0x10, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
+	0x52, 0x0f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x73, 0x12, 0x36, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x8e, 0x01, 0x0a, 0x1a, 0x47, 0x65,
+	0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x69, 0x6e, 0x67, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x0f, 0x70, 0x6c, 0x61, 0x74,
+	0x66, 0x6f, 0x72, 0x6d, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x48, 0x00, 0x52, 0x0e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x46, 0x69, 0x6c,
+	0x74, 0x65, 0x72, 0x88, 0x01, 0x01, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x70, 0x6c, 0x61, 0x74, 0x66,
+	0x6f, 0x72, 0x6d, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0xd2, 0x01, 0x0a, 0x1b, 0x47,
+	0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x10, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x0f, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x35,
+	0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x50,
+	0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
+	0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x76, 0x69, 0x63,
+	0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22,
+	0x50, 0x0a, 0x1d, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65,
+	0x76, 0x69, 0x63, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x2f, 0x0a, 0x14, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11,
+	0x75, 0x73, 0x65, 0x72, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49,
+	0x64, 0x22, 0x64, 0x0a, 0x1e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72,
+	0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f,
+	0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44,
+	0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x64, 0x0a, 0x23, 0x55, 0x73, 0x65, 0x72, 0x44,
+	0x65, 0x76, 0x69, 0x63, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x21,
+	0x0a, 0x0c, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x22, 0x23, 0x0a,
+	0x21, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x22, 0xa9, 0x01, 0x0a, 0x22, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x10, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x0f, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x3f, 0x0a,
+	0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x25,
+	0x2e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x4e,
+	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x65, 0x66, 0x65,
+	0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0xa1,
+	0x01, 0x0a, 0x23, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x10, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x54,
+	0x79, 0x70, 0x65, 0x12, 0x4d, 0x0a, 0x05, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x37, 0x2e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50,
+	0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x05, 0x69, 0x6e, 0x70,
+	0x75, 0x74, 0x22, 0xab, 0x01, 0x0a, 0x24, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65,
+	0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x10, 0x72,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x0f,
+	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12,
+	0x3f, 0x0a, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x25, 0x2e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x2e, 0x55, 0x73, 0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12,
-	0x26, 0x0a, 0x0f, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67, 0x73, 0x5f, 0x74, 0x6f, 0x5f, 0x75, 0x73,
-	0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x62, 0x65, 0x6c, 0x6f, 0x6e, 0x67,
-	0x73, 0x54, 0x6f, 0x55, 0x73, 0x65, 0x72, 0x22, 0x73, 0x0a, 0x22, 0x55, 0x73, 0x65, 0x72, 0x4e,
-	0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x55, 0x70, 0x64, 0x61, 0x74,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x42, 0x0a,
-	0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x25, 0x2e,
-	0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x55, 0x73,
-	0x65, 0x72, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x74,
-	0x61, 0x74, 0x75, 0x73, 0x48, 0x00, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x88, 0x01,
-	0x01, 0x42, 0x09, 0x0a, 0x07, 0x5f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x66, 0x0a, 0x1a,
-	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x48, 0x0a, 0x05, 0x69, 0x6e,
-	0x70, 0x75, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x6e, 0x6f, 0x74, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65,
-	0x76, 0x69, 0x63, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x52, 0x05, 0x69,
-	0x6e, 0x70, 0x75, 0x74, 0x22, 0x9b, 0x01, 0x0a, 0x1b, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65,
-	0x72, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
-	0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x38, 0x0a, 0x07, 0x63, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6e, 0x6f, 0x74, 0x69,
-	0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65,
-	0x76, 0x69, 0x63, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x07, 0x63, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x64, 0x22, 0x4c, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x76,
-	0x69, 0x63, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
-	0x2f, 0x0a, 0x14, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x75,
-	0x73, 0x65, 0x72, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x64,
-	0x22, 0x98, 0x01, 0x0a, 0x1a, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x76, 0x69,
-	0x63, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x42, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x74, 0x61,
-	0x69, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6f, 0x6d, 0x6d,
-	0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69,
-	0x6c, 0x73, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x74, 0x61,
-	0x69, 0x6c, 0x73, 0x12, 0x36, 0x0a, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x52, 0x06, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x22, 0x8e, 0x01, 0x0a, 0x1a,
-	0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x06, 0x66, 0x69,
-	0x6c, 0x74, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x66, 0x69, 0x6c,
-	0x74, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x46, 0x69, 0x6c, 0x74,
-	0x65, 0x72, 0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x2c, 0x0a, 0x0f, 0x70, 0x6c,
-	0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x0e, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x46,
-	0x69, 0x6c, 0x74, 0x65, 0x72, 0x88, 0x01, 0x01, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x70, 0x6c, 0x61,
-	0x74, 0x66, 0x6f, 0x72, 0x6d, 0x5f, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0xd2, 0x01, 0x0a,
-	0x1b, 0x47, 0x65, 0x74, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x10,
-	0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52,
-	0x0f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73,
-	0x12, 0x35, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x69, 0x6e, 0x67,
-	0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67,
-	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x38, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c,
-	0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x6e, 0x6f, 0x74, 0x69, 0x66,
-	0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x44, 0x65, 0x76,
-	0x69, 0x63, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74,
-	0x73, 0x22, 0x50, 0x0a, 0x1d, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x55, 0x73, 0x65, 0x72,
-	0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x2f, 0x0a, 0x14, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x64, 0x65, 0x76, 0x69, 0x63,
-	0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x11, 0x75, 0x73, 0x65, 0x72, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x49, 0x64, 0x22, 0x64, 0x0a, 0x1e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x55, 0x73,
-	0x65, 0x72, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x17, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x64, 0x0a, 0x23, 0x55, 0x73, 0x65,
-	0x72, 0x44, 0x65, 0x76, 0x69, 0x63, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74,
-	0x12, 0x21, 0x0a, 0x0c, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x76, 0x69, 0x63, 0x65, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x6c, 0x61, 0x74, 0x66, 0x6f, 0x72, 0x6d, 0x42,
-	0x65, 0x5a, 0x63, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x69,
-	0x6e, 0x6e, 0x65, 0x72, 0x64, 0x6f, 0x6e, 0x65, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x2f, 0x64,
-	0x69, 0x6e, 0x6e, 0x65, 0x72, 0x64, 0x6f, 0x6e, 0x65, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x2f,
-	0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c,
-	0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2f,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63,
-	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x72, 0x65,
+	0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x52, 0x07, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64,
+	0x22, 0xc9, 0x03, 0x0a, 0x28, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x50, 0x72, 0x65, 0x66, 0x65, 0x72, 0x65, 0x6e, 0x63, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6e, 0x70, 0x75, 0x74, 0x12, 0x26, 0x0a,
+	0x0c, 0x70, 0x75, 0x73, 0x68, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x08, 0x48, 0x00, 0x52, 0x0b, 0x70, 0x75, 0x73, 0x68, 0x45, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0d, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x5f, 0x65,
+	0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x48, 0x01, 0x52, 0x0c,
+	0x65, 0x6d, 0x61, 0x69, 0x6c, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12,
+	0x29, 0x0a, 0x0e, 0x69, 0x6e, 0x5f, 0x61, 0x70, 0x70, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65,
+	0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x48, 0x02, 0x52, 0x0c, 0x69, 0x6e, 0x41, 0x70, 0x70,
+	0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x88, 0x01, 0x01, 0x12, 0x24, 0x0a, 0x0b, 0x64, 0x69,
+	0x67, 0x65, 0x73, 0x74, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x03, 0x52, 0x0a, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74, 0x4d, 0x6f, 0x64, 0x65, 0x88, 0x01, 0x01,
+	0x12, 0x1f, 0x0a, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x48, 0x04, 0x52, 0x08, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f, 0x6e, 0x65, 0x88, 0x01,
+	0x01, 0x12, 0x2f, 0x0a, 0x11, 0x71, 0x75, 0x69, 0x65, 0x74, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x73,
+	0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x48, 0x05, 0x52, 0x0f,
+	0x71, 0x75, 0x69, 0x65, 0x74, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x53, 0x74, 0x61, 0x72, 0x74, 0x88,
+	0x01, 0x01, 0x12, 0x2b, 0x0a, 0x0f, 0x71, 0x75, 0x69, 0x65, 0x74, 0x5f, 0x68, 0x6f, 0x75, 0x72,
+	0x73, 0x5f, 0x65, 0x6e, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x48, 0x06, 0x52, 0x0d, 0x71,
+	0x75, 0x69, 0x65, 0x74, 0x48, 0x6f, 0x75, 0x72, 0x73, 0x45, 0x6e, 0x64, 0x88, 0x01, 0x01, 0x42,
+	0x0f, 0x0a, 0x0d, 0x5f, 0x70, 0x75, 0x73, 0x68, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
+	0x42, 0x10, 0x0a, 0x0e, 0x5f, 0x65, 0x6d, 0x61, 0x69, 0x6c, 0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c,
+	0x65, 0x64, 0x42, 0x11, 0x0a, 0x0f, 0x5f, 0x69, 0x6e, 0x5f, 0x61, 0x70, 0x70, 0x5f, 0x65, 0x6e,
+	0x61, 0x62, 0x6c, 0x65, 0x64, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x64, 0x69, 0x67, 0x65, 0x73, 0x74,
+	0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x7a, 0x6f,
+	0x6e, 0x65, 0x42, 0x14, 0x0a, 0x12, 0x5f, 0x71, 0x75, 0x69, 0x65, 0x74, 0x5f, 0x68, 0x6f, 0x75,
+	0x72, 0x73, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x42, 0x12, 0x0a, 0x10, 0x5f, 0x71, 0x75, 0x69,
+	0x65, 0x74, 0x5f, 0x68, 0x6f, 0x75, 0x72, 0x73, 0x5f, 0x65, 0x6e, 0x64, 0x42, 0x65, 0x5a, 0x63,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x69, 0x6e, 0x6e, 0x65,
+	0x72, 0x64, 0x6f, 0x6e, 0x65, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x2f, 0x64, 0x69, 0x6e, 0x6e,
+	0x65, 0x72, 0x64, 0x6f, 0x6e, 0x65, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x2f, 0x62, 0x61, 0x63,
+	0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x67, 0x72,
+	0x70, 0x63, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2f, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
@@ -1052,59 +1597,76 @@ func file_notifications_notifications_service_types_proto_rawDescGZIP() []byte {
 	return file_notifications_notifications_service_types_proto_rawDescData
 }
 
-var file_notifications_notifications_service_types_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_notifications_notifications_service_types_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
 var file_notifications_notifications_service_types_proto_goTypes = []any{
-	(*GetUserNotificationRequest)(nil),           // 0: notifications.GetUserNotificationRequest
-	(*GetUserNotificationResponse)(nil),          // 1: notifications.GetUserNotificationResponse
-	(*GetUserNotificationsRequest)(nil),          // 2: notifications.GetUserNotificationsRequest
-	(*GetUserNotificationsResponse)(nil),         // 3: notifications.GetUserNotificationsResponse
-	(*UpdateUserNotificationRequest)(nil),        // 4: notifications.UpdateUserNotificationRequest
-	(*UpdateUserNotificationResponse)(nil),       // 5: notifications.UpdateUserNotificationResponse
-	(*UserNotificationCreationRequestInput)(nil), // 6: notifications.UserNotificationCreationRequestInput
-	(*UserNotificationUpdateRequestInput)(nil),   // 7: notifications.UserNotificationUpdateRequestInput
-	(*RegisterDeviceTokenRequest)(nil),           // 8: notifications.RegisterDeviceTokenRequest
-	(*RegisterDeviceTokenResponse)(nil),          // 9: notifications.RegisterDeviceTokenResponse
-	(*GetUserDeviceTokenRequest)(nil),            // 10: notifications.GetUserDeviceTokenRequest
-	(*GetUserDeviceTokenResponse)(nil),           // 11: notifications.GetUserDeviceTokenResponse
-	(*GetUserDeviceTokensRequest)(nil),           // 12: notifications.GetUserDeviceTokensRequest
-	(*GetUserDeviceTokensResponse)(nil),          // 13: notifications.GetUserDeviceTokensResponse
-	(*ArchiveUserDeviceTokenRequest)(nil),        // 14: notifications.ArchiveUserDeviceTokenRequest
-	(*ArchiveUserDeviceTokenResponse)(nil),       // 15: notifications.ArchiveUserDeviceTokenResponse
-	(*UserDeviceTokenCreationRequestInput)(nil),  // 16: notifications.UserDeviceTokenCreationRequestInput
-	(*types.ResponseDetails)(nil),                // 17: common.ResponseDetails
-	(*UserNotification)(nil),                     // 18: notifications.UserNotification
-	(*filtering.QueryFilter)(nil),                // 19: filtering.QueryFilter
-	(*filtering.Pagination)(nil),                 // 20: filtering.Pagination
-	(UserNotificationStatus)(0),                  // 21: notifications.UserNotificationStatus
-	(*UserDeviceToken)(nil),                      // 22: notifications.UserDeviceToken
+	(*GetUserNotificationRequest)(nil),               // 0: notifications.GetUserNotificationRequest
+	(*GetUserNotificationResponse)(nil),              // 1: notifications.GetUserNotificationResponse
+	(*GetUserNotificationsRequest)(nil),              // 2: notifications.GetUserNotificationsRequest
+	(*GetUserNotificationsResponse)(nil),             // 3: notifications.GetUserNotificationsResponse
+	(*UpdateUserNotificationRequest)(nil),            // 4: notifications.UpdateUserNotificationRequest
+	(*UpdateUserNotificationResponse)(nil),           // 5: notifications.UpdateUserNotificationResponse
+	(*MarkAllUserNotificationsAsReadRequest)(nil),    // 6: notifications.MarkAllUserNotificationsAsReadRequest
+	(*MarkAllUserNotificationsAsReadResponse)(nil),   // 7: notifications.MarkAllUserNotificationsAsReadResponse
+	(*GetUnreadUserNotificationCountRequest)(nil),    // 8: notifications.GetUnreadUserNotificationCountRequest
+	(*GetUnreadUserNotificationCountResponse)(nil),   // 9: notifications.GetUnreadUserNotificationCountResponse
+	(*UserNotificationCreationRequestInput)(nil),     // 10: notifications.UserNotificationCreationRequestInput
+	(*UserNotificationUpdateRequestInput)(nil),       // 11: notifications.UserNotificationUpdateRequestInput
+	(*RegisterDeviceTokenRequest)(nil),               // 12: notifications.RegisterDeviceTokenRequest
+	(*RegisterDeviceTokenResponse)(nil),              // 13: notifications.RegisterDeviceTokenResponse
+	(*GetUserDeviceTokenRequest)(nil),                // 14: notifications.GetUserDeviceTokenRequest
+	(*GetUserDeviceTokenResponse)(nil),               // 15: notifications.GetUserDeviceTokenResponse
+	(*GetUserDeviceTokensRequest)(nil),               // 16: notifications.GetUserDeviceTokensRequest
+	(*GetUserDeviceTokensResponse)(nil),              // 17: notifications.GetUserDeviceTokensResponse
+	(*ArchiveUserDeviceTokenRequest)(nil),            // 18: notifications.ArchiveUserDeviceTokenRequest
+	(*ArchiveUserDeviceTokenResponse)(nil),           // 19: notifications.ArchiveUserDeviceTokenResponse
+	(*UserDeviceTokenCreationRequestInput)(nil),      // 20: notifications.UserDeviceTokenCreationRequestInput
+	(*GetNotificationPreferencesRequest)(nil),        // 21: notifications.GetNotificationPreferencesRequest
+	(*GetNotificationPreferencesResponse)(nil),       // 22: notifications.GetNotificationPreferencesResponse
+	(*UpdateNotificationPreferenceRequest)(nil),      // 23: notifications.UpdateNotificationPreferenceRequest
+	(*UpdateNotificationPreferenceResponse)(nil),     // 24: notifications.UpdateNotificationPreferenceResponse
+	(*NotificationPreferenceUpdateRequestInput)(nil), // 25: notifications.NotificationPreferenceUpdateRequestInput
+	(*types.ResponseDetails)(nil),                    // 26: common.ResponseDetails
+	(*UserNotification)(nil),                         // 27: notifications.UserNotification
+	(*filtering.QueryFilter)(nil),                    // 28: filtering.QueryFilter
+	(*filtering.Pagination)(nil),                     // 29: filtering.Pagination
+	(UserNotificationStatus)(0),                      // 30: notifications.UserNotificationStatus
+	(*UserDeviceToken)(nil),                          // 31: notifications.UserDeviceToken
+	(*NotificationPreference)(nil),                   // 32: notifications.NotificationPreference
 }
 var file_notifications_notifications_service_types_proto_depIdxs = []int32{
-	17, // 0: notifications.GetUserNotificationResponse.response_details:type_name -> common.ResponseDetails
-	18, // 1: notifications.GetUserNotificationResponse.result:type_name -> notifications.UserNotification
-	19, // 2: notifications.GetUserNotificationsRequest.filter:type_name -> filtering.QueryFilter
-	17, // 3: notifications.GetUserNotificationsResponse.response_details:type_name -> common.ResponseDetails
-	20, // 4: notifications.GetUserNotificationsResponse.pagination:type_name -> filtering.Pagination
-	18, // 5: notifications.GetUserNotificationsResponse.results:type_name -> notifications.UserNotification
-	7,  // 6: notifications.UpdateUserNotificationRequest.input:type_name -> notifications.UserNotificationUpdateRequestInput
-	17, // 7: notifications.UpdateUserNotificationResponse.response_details:type_name -> common.ResponseDetails
-	18, // 8: notifications.UpdateUserNotificationResponse.updated:type_name -> notifications.UserNotification
-	21, // 9: notifications.UserNotificationCreationRequestInput.status:type_name -> notifications.UserNotificationStatus
-	21, // 10: notifications.UserNotificationUpdateRequestInput.status:type_name -> notifications.UserNotificationStatus
-	16, // 11: notifications.RegisterDeviceTokenRequest.input:type_name -> notifications.UserDeviceTokenCreationRequestInput
-	17, // 12: notifications.RegisterDeviceTokenResponse.response_details:type_name -> common.ResponseDetails
-	22, // 13: notifications.RegisterDeviceTokenResponse.created:type_name -> notifications.UserDeviceToken
-	17, // 14: notifications.GetUserDeviceTokenResponse.response_details:type_name -> common.ResponseDetails
-	22, // 15: notifications.GetUserDeviceTokenResponse.result:type_name -> notifications.UserDeviceToken
-	19, // 16: notifications.GetUserDeviceTokensRequest.filter:type_name -> filtering.QueryFilter
-	17, // 17: notifications.GetUserDeviceTokensResponse.response_details:type_name -> common.ResponseDetails
-	20, // 18: notifications.GetUserDeviceTokensResponse.pagination:type_name -> filtering.Pagination
-	22, // 19: notifications.GetUserDeviceTokensResponse.results:type_name -> notifications.UserDeviceToken
-	17, // 20: notifications.ArchiveUserDeviceTokenResponse.response_details:type_name -> common.ResponseDetails
-	21, // [21:21] is the sub-list for method output_type
-	21, // [21:21] is the sub-list for method input_type
-	21, // [21:21] is the sub-list for extension type_name
-	21, // [21:21] is the sub-list for extension extendee
-	0,  // [0:21] is the sub-list for field type_name
+	26, // 0: notifications.GetUserNotificationResponse.response_details:type_name -> common.ResponseDetails
+	27, // 1: notifications.GetUserNotificationResponse.result:type_name -> notifications.UserNotification
+	28, // 2: notifications.GetUserNotificationsRequest.filter:type_name -> filtering.QueryFilter
+	26, // 3: notifications.GetUserNotificationsResponse.response_details:type_name -> common.ResponseDetails
+	29, // 4: notifications.GetUserNotificationsResponse.pagination:type_name -> filtering.Pagination
+	27, // 5: notifications.GetUserNotificationsResponse.results:type_name -> notifications.UserNotification
+	11, // 6: notifications.UpdateUserNotificationRequest.input:type_name -> notifications.UserNotificationUpdateRequestInput
+	26, // 7: notifications.UpdateUserNotificationResponse.response_details:type_name -> common.ResponseDetails
+	27, // 8: notifications.UpdateUserNotificationResponse.updated:type_name -> notifications.UserNotification
+	26, // 9: notifications.MarkAllUserNotificationsAsReadResponse.response_details:type_name -> common.ResponseDetails
+	26, // 10: notifications.GetUnreadUserNotificationCountResponse.response_details:type_name -> common.ResponseDetails
+	30, // 11: notifications.UserNotificationCreationRequestInput.status:type_name -> notifications.UserNotificationStatus
+	30, // 12: notifications.UserNotificationUpdateRequestInput.status:type_name -> notifications.UserNotificationStatus
+	20, // 13: notifications.RegisterDeviceTokenRequest.input:type_name -> notifications.UserDeviceTokenCreationRequestInput
+	26, // 14: notifications.RegisterDeviceTokenResponse.response_details:type_name -> common.ResponseDetails
+	31, // 15: notifications.RegisterDeviceTokenResponse.created:type_name -> notifications.UserDeviceToken
+	26, // 16: notifications.GetUserDeviceTokenResponse.response_details:type_name -> common.ResponseDetails
+	31, // 17: notifications.GetUserDeviceTokenResponse.result:type_name -> notifications.UserDeviceToken
+	28, // 18: notifications.GetUserDeviceTokensRequest.filter:type_name -> filtering.QueryFilter
+	26, // 19: notifications.GetUserDeviceTokensResponse.response_details:type_name -> common.ResponseDetails
+	29, // 20: notifications.GetUserDeviceTokensResponse.pagination:type_name -> filtering.Pagination
+	31, // 21: notifications.GetUserDeviceTokensResponse.results:type_name -> notifications.UserDeviceToken
+	26, // 22: notifications.ArchiveUserDeviceTokenResponse.response_details:type_name -> common.ResponseDetails
+	26, // 23: notifications.GetNotificationPreferencesResponse.response_details:type_name -> common.ResponseDetails
+	32, // 24: notifications.GetNotificationPreferencesResponse.results:type_name -> notifications.NotificationPreference
+	25, // 25: notifications.UpdateNotificationPreferenceRequest.input:type_name -> notifications.NotificationPreferenceUpdateRequestInput
+	26, // 26: notifications.UpdateNotificationPreferenceResponse.response_details:type_name -> common.ResponseDetails
+	32, // 27: notifications.UpdateNotificationPreferenceResponse.updated:type_name -> notifications.NotificationPreference
+	28, // [28:28] is the sub-list for method output_type
+	28, // [28:28] is the sub-list for method input_type
+	28, // [28:28] is the sub-list for extension type_name
+	28, // [28:28] is the sub-list for extension extendee
+	0,  // [0:28] is the sub-list for field type_name
 }
 
 func init() { file_notifications_notifications_service_types_proto_init() }
@@ -1113,15 +1675,16 @@ func file_notifications_notifications_service_types_proto_init() {
 		return
 	}
 	file_notifications_notifications_messages_proto_init()
-	file_notifications_notifications_service_types_proto_msgTypes[7].OneofWrappers = []any{}
-	file_notifications_notifications_service_types_proto_msgTypes[12].OneofWrappers = []any{}
+	file_notifications_notifications_service_types_proto_msgTypes[11].OneofWrappers = []any{}
+	file_notifications_notifications_service_types_proto_msgTypes[16].OneofWrappers = []any{}
+	file_notifications_notifications_service_types_proto_msgTypes[25].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_notifications_notifications_service_types_proto_rawDesc), len(file_notifications_notifications_service_types_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   17,
+			NumMessages:   26,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -29,7 +29,7 @@
 	0x6f, 0x12, 0x08, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x1a, 0x25, 0x70, 0x61, 0x79,
 	0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x5f, 0x73,
 	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x32, 0xff, 0x08, 0x0a, 0x0f, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x53,
+	0x74, 0x6f, 0x32, 0xe1, 0x0b, 0x0a, 0x0f, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x53,
 	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x50, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
 	0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74, 0x12, 0x1e, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e,
 	0x74, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x50, 0x72, 0x6f, 0x64, 0x75, 0x63, 0x74,
@@ -101,13 +101,35 @@
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73,
 	0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x69, 0x73, 0x74, 0x6f,
 	0x72, 0x79, 0x46, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x42, 0x60, 0x5a, 0x5e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
-	0x6f, 0x6d, 0x2f, 0x64, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x64, 0x6f, 0x6e, 0x65, 0x62, 0x65, 0x74,
-	0x74, 0x65, 0x72, 0x2f, 0x64, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x64, 0x6f, 0x6e, 0x65, 0x62, 0x65,
-	0x74, 0x74, 0x65, 0x72, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x69, 0x6e, 0x74,
-	0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72,
-	0x61, 0x74, 0x65, 0x64, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x70, 0x61,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x74, 0x0a, 0x19, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x69, 0x74,
+	0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x2a, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x47, 0x65, 0x74,
+	0x45, 0x6e, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x41,
+	0x63, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e,
+	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x45, 0x6e, 0x74, 0x69,
+	0x74, 0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x46, 0x6f, 0x72, 0x41, 0x63, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x71, 0x0a, 0x18, 0x47, 0x65,
+	0x74, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x29, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74,
+	0x73, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x76,
+	0x69, 0x64, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2a, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x47, 0x65, 0x74,
+	0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x77, 0x0a,
+	0x1a, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x50, 0x72,
+	0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x2b, 0x2e, 0x70, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x50, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e,
+	0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x70, 0x61, 0x79, 0x6d, 0x65,
+	0x6e, 0x74, 0x73, 0x2e, 0x52, 0x65, 0x70, 0x6c, 0x61, 0x79, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e,
+	0x74, 0x50, 0x72, 0x6f, 0x76, 0x69, 0x64, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x60, 0x5a, 0x5e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x64, 0x6f, 0x6e, 0x65, 0x62,
+	0x65, 0x74, 0x74, 0x65, 0x72, 0x2f, 0x64, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x64, 0x6f, 0x6e, 0x65,
+	0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x69,
+	0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x67, 0x65, 0x6e,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f,
+	0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var file_payments_payments_service_proto_goTypes = []any{
@@ -123,18 +145,24 @@
 	(*ArchiveSubscriptionRequest)(nil),          // 9: payments.ArchiveSubscriptionRequest
 	(*GetPurchasesForAccountRequest)(nil),       // 10: payments.GetPurchasesForAccountRequest
 	(*GetPaymentHistoryForAccountRequest)(nil),  // 11: payments.GetPaymentHistoryForAccountRequest
-	(*CreateProductResponse)(nil),               // 12: payments.CreateProductResponse
-	(*GetProductResponse)(nil),                  // 13: payments.GetProductResponse
-	(*GetProductsResponse)(nil),                 // 14: payments.GetProductsResponse
-	(*UpdateProductResponse)(nil),               // 15: payments.UpdateProductResponse
-	(*ArchiveProductResponse)(nil),              // 16: payments.ArchiveProductResponse
-	(*CreateSubscriptionResponse)(nil),          // 17: payments.CreateSubscriptionResponse
-	(*GetSubscriptionResponse)(nil),             // 18: payments.GetSubscriptionResponse
-	(*GetSubscriptionsForAccountResponse)(nil),  // 19: payments.GetSubscriptionsForAccountResponse
-	(*UpdateSubscriptionResponse)(nil),          // 20: payments.UpdateSubscriptionResponse
-	(*ArchiveSubscriptionResponse)(nil),         // 21: payments.ArchiveSubscriptionResponse
-	(*GetPurchasesForAccountResponse)(nil),      // 22: payments.GetPurchasesForAccountResponse
-	(*GetPaymentHistoryForAccountResponse)(nil), // 23: payments.GetPaymentHistoryForAccountResponse
+	(*GetEntitlementsForAccountRequest)(nil),    // 12: payments.GetEntitlementsForAccountRequest
+	(*GetPaymentProviderEventsRequest)(nil),     // 13: payments.GetPaymentProviderEventsRequest
+	(*ReplayPaymentProviderEventRequest)(nil),   // 14: payments.ReplayPaymentProviderEventRequest
+	(*CreateProductResponse)(nil),               // 15: payments.CreateProductResponse
+	(*GetProductResponse)(nil),                  // 16: payments.GetProductResponse
+	(*GetProductsResponse)(nil),                 // 17: payments.GetProductsResponse
+	(*UpdateProductResponse)(nil),               // 18: payments.UpdateProductResponse
+	(*ArchiveProductResponse)(nil),              // 19: payments.ArchiveProductResponse
+	(*CreateSubscriptionResponse)(nil),          // 20: payments.CreateSubscriptionResponse
+	(*GetSubscriptionResponse)(nil),             // 21: payments.GetSubscriptionResponse
+	(*GetSubscriptionsForAccountResponse)(nil),  // 22: payments.GetSubscriptionsForAccountResponse
+	(*UpdateSubscriptionResponse)(nil),          // 23: payments.UpdateSubscriptionResponse
+	(*ArchiveSubscriptionResponse)(nil),         // 24: payments.ArchiveSubscriptionResponse
+	(*GetPurchasesForAccountResponse)(nil),      // 25: payments.GetPurchasesForAccountResponse
+	(*GetPaymentHistoryForAccountResponse)(nil), // 26: payments.GetPaymentHistoryForAccountResponse
+	(*GetEntitlementsForAccountResponse)(nil),   // 27: payments.GetEntitlementsForAccountResponse
+	(*GetPaymentProviderEventsResponse)(nil),    // 28: payments.GetPaymentProviderEventsResponse
+	(*ReplayPaymentProviderEventResponse)(nil),  // 29: payments.ReplayPaymentProviderEventResponse
 }
 var file_payments_payments_service_proto_depIdxs = []int32{
 	0,  // 0: payments.PaymentsService.CreateProduct:input_type -> payments.CreateProductRequest
@@ -149,20 +177,26 @@
 	9,  // 9: payments.PaymentsService.ArchiveSubscription:input_type -> payments.ArchiveSubscriptionRequest
 	10, // 10: payments.PaymentsService.GetPurchasesForAccount:input_type -> payments.GetPurchasesForAccountRequest
 	11, // 11: payments.PaymentsService.GetPaymentHistoryForAccount:input_type -> payments.GetPaymentHistoryForAccountRequest
-	12, // 12: payments.PaymentsService.CreateProduct:output_type -> payments.CreateProductResponse
-	13, // 13: payments.PaymentsService.GetProduct:output_type -> payments.GetProductResponse
-	14, // 14: payments.PaymentsService.GetProducts:output_type -> payments.GetProductsResponse
-	15, // 15: payments.PaymentsService.UpdateProduct:output_type -> payments.UpdateProductResponse
-	16, // 16: payments.PaymentsService.ArchiveProduct:output_type -> payments.ArchiveProductResponse
-	17, // 17: payments.PaymentsService.CreateSubscription:output_type -> payments.CreateSubscriptionResponse
-	18, // 18: payments.PaymentsService.GetSubscription:output_type -> payments.GetSubscriptionResponse
-	19, // 19: payments.PaymentsService.GetSubscriptionsForAccount:output_type -> payments.GetSubscriptionsForAccountResponse
-	20, // 20: payments.PaymentsService.UpdateSubscription:output_type -> payments.UpdateSubscriptionResponse
-	21, // 21: payments.PaymentsService.ArchiveSubscription:output_type -> payments.ArchiveSubscriptionResponse
-	22, // 22: payments.PaymentsService.GetPurchasesForAccount:output_type -> payments.GetPurchasesForAccountResponse
-	23, // 23: payments.PaymentsService.GetPaymentHistoryForAccount:output_type -> payments.GetPaymentHistoryForAccountResponse
-	12, // [12:24] is the sub-list for method output_type
-	0,  // [0:12] is the sub-list for method input_type
+	12, // 12: payments.PaymentsService.GetEntitlementsForAccount:input_type -> payments.GetEntitlementsForAccountRequest
+	13, // 13: payments.PaymentsService.GetPaymentProviderEvents:input_type -> payments.GetPaymentProviderEventsRequest
+	14, // 14: payments.PaymentsService.ReplayPaymentProviderEvent:input_type -> payments.ReplayPaymentProviderEventRequest
+	15, // 15: payments.PaymentsService.CreateProduct:output_type -> payments.CreateProductResponse
+	16, // 16: payments.PaymentsService.GetProduct:output_type -> payments.GetProductResponse
+	17, // 17: payments.PaymentsService.GetProducts:output_type -> payments.GetProductsResponse
+	18, // 18: payments.PaymentsService.UpdateProduct:output_type -> payments.UpdateProductResponse
+	19, // 19: payments.PaymentsService.ArchiveProduct:output_type -> payments.ArchiveProductResponse
+	20, // 20: payments.PaymentsService.CreateSubscription:output_type -> payments.CreateSubscriptionResponse
+	21, // 21: payments.PaymentsService.GetSubscription:output_type -> payments.GetSubscriptionResponse
+	22, // 22: payments.PaymentsService.GetSubscriptionsForAccount:output_type -> payments.GetSubscriptionsForAccountResponse
+	23, // 23: payments.PaymentsService.UpdateSubscription:output_type -> payments.UpdateSubscriptionResponse
+	24, // 24: payments.PaymentsService.ArchiveSubscription:output_type -> payments.ArchiveSubscriptionResponse
+	25, // 25: payments.PaymentsService.GetPurchasesForAccount:output_type -> payments.GetPurchasesForAccountResponse
+	26, // 26: payments.PaymentsService.GetPaymentHistoryForAccount:output_type -> payments.GetPaymentHistoryForAccountResponse
+	27, // 27: payments.PaymentsService.GetEntitlementsForAccount:output_type -> payments.GetEntitlementsForAccountResponse
+	28, // 28: payments.PaymentsService.GetPaymentProviderEvents:output_type -> payments.GetPaymentProviderEventsResponse
+	29, // 29: payments.PaymentsService.ReplayPaymentProviderEvent:output_type -> payments.ReplayPaymentProviderEventResponse
+	15, // [15:30] is the sub-list for method output_type
+	0,  // [0:15] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
@@ -32,6 +32,9 @@
 	PaymentsService_ArchiveSubscription_FullMethodName         = "/payments.PaymentsService/ArchiveSubscription"
 	PaymentsService_GetPurchasesForAccount_FullMethodName      = "/payments.PaymentsService/GetPurchasesForAccount"
 	PaymentsService_GetPaymentHistoryForAccount_FullMethodName = "/payments.PaymentsService/GetPaymentHistoryForAccount"
+	PaymentsService_GetEntitlementsForAccount_FullMethodName   = "/payments.PaymentsService/GetEntitlementsForAccount"
+	PaymentsService_GetPaymentProviderEvents_FullMethodName    = "/payments.PaymentsService/GetPaymentProviderEvents"
+	PaymentsService_ReplayPaymentProviderEvent_FullMethodName  = "/payments.PaymentsService/ReplayPaymentProviderEvent"
 )
 
 // PaymentsServiceClient is the client API for PaymentsService service.
@@ -50,6 +53,9 @@ type PaymentsServiceClient interface {
 	ArchiveSubscription(ctx context.Context, in *ArchiveSubscriptionRequest, opts ...grpc.CallOption) (*ArchiveSubscriptionResponse, error)
 	GetPurchasesForAccount(ctx context.Context, in *GetPurchasesForAccountRequest, opts ...grpc.CallOption) (*GetPurchasesForAccountResponse, error)
 	GetPaymentHistoryForAccount(ctx context.Context, in *GetPaymentHistoryForAccountRequest, opts ...grpc.CallOption) (*GetPaymentHistoryForAccountResponse, error)
+	GetEntitlementsForAccount(ctx context.Context, in *GetEntitlementsForAccountRequest, opts ...grpc.CallOption) (*GetEntitlementsForAccountResponse, error)
+	GetPaymentProviderEvents(ctx context.Context, in *GetPaymentProviderEventsRequest, opts ...grpc.CallOption) (*GetPaymentProviderEventsResponse, error)
+	ReplayPaymentProviderEvent(ctx context.Context, in *ReplayPaymentProviderEventRequest, opts ...grpc.CallOption) (*ReplayPaymentProviderEventResponse, error)
 }
 
 type paymentsServiceClient struct {
@@ -180,6 +186,36 @@ func (c *paymentsServiceClient) GetPaymentHistoryForAccount(ctx context.Context,
 	return out, nil
 }
 
+func (c *paymentsServiceClient) GetEntitlementsForAccount(ctx context.Context, in *GetEntitlementsForAccountRequest, opts ...grpc.CallOption) (*GetEntitlementsForAccountResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetEntitlementsForAccountResponse)
+	err := c.cc.Invoke(ctx, PaymentsService_GetEntitlementsForAccount_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentsServiceClient) GetPaymentProviderEvents(ctx context.Context, in *GetPaymentProviderEventsRequest, opts ...grpc.CallOption) (*GetPaymentProviderEventsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetPaymentProviderEventsResponse)
+	err := c.cc.Invoke(ctx, PaymentsService_GetPaymentProviderEvents_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *paymentsServiceClient) ReplayPaymentProviderEvent(ctx context.Context, in *ReplayPaymentProviderEventRequest, opts ...grpc.CallOption) (*ReplayPaymentProviderEventResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReplayPaymentProviderEventResponse)
+	err := c.cc.Invoke(ctx, PaymentsService_ReplayPaymentProviderEvent_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PaymentsServiceServer is the server API for PaymentsService service.
 // All implementations must embed UnimplementedPaymentsServiceServer
 // for forward compatibility.
@@ -196,6 +232,9 @@ type PaymentsServiceServer interface {
 	ArchiveSubscription(context.Context, *ArchiveSubscriptionRequest) (*ArchiveSubscriptionResponse, error)
 	GetPurchasesForAccount(context.Context, *GetPurchasesForAccountRequest) (*GetPurchasesForAccountResponse, error)
 	GetPaymentHistoryForAccount(context.Context, *GetPaymentHistoryForAccountRequest) (*GetPaymentHistoryForAccountResponse, error)
+	GetEntitlementsForAccount(context.Context, *GetEntitlementsForAccountRequest) (*GetEntitlementsForAccountResponse, error)
+	GetPaymentProviderEvents(context.Context, *GetPaymentProviderEventsRequest) (*GetPaymentProviderEventsResponse, error)
+	ReplayPaymentProviderEvent(context.Context, *ReplayPaymentProviderEventRequest) (*ReplayPaymentProviderEventResponse, error)
 	mustEmbedUnimplementedPaymentsServiceServer()
 }
 
@@ -242,6 +281,15 @@ func (UnimplementedPaymentsServiceServer) GetPurchasesForAccount(context.Context
 func (UnimplementedPaymentsServiceServer) GetPaymentHistoryForAccount(context.Context, *GetPaymentHistoryForAccountRequest) (*GetPaymentHistoryForAccountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetPaymentHistoryForAccount not implemented")
 }
+func (UnimplementedPaymentsServiceServer) GetEntitlementsForAccount(context.Context, *GetEntitlementsForAccountRequest) (*GetEntitlementsForAccountResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEntitlementsForAccount not implemented")
+}
+func (UnimplementedPaymentsServiceServer) GetPaymentProviderEvents(context.Context, *GetPaymentProviderEventsRequest) (*GetPaymentProviderEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPaymentProviderEvents not implemented")
+}
+func (UnimplementedPaymentsServiceServer) ReplayPaymentProviderEvent(context.Context, *ReplayPaymentProviderEventRequest) (*ReplayPaymentProviderEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayPaymentProviderEvent not implemented")
+}
 func (UnimplementedPaymentsServiceServer) mustEmbedUnimplementedPaymentsServiceServer() {}
 func (UnimplementedPaymentsServiceServer) testEmbeddedByValue()                         {}
 
@@ -479,6 +527,60 @@ func _PaymentsService_GetPaymentHistoryForAccount_Handler(srv interface{}, ctx c
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PaymentsService_GetEntitlementsForAccount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEntitlementsForAccountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentsServiceServer).GetEntitlementsForAccount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentsService_GetEntitlementsForAccount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentsServiceServer).GetEntitlementsForAccount(ctx, req.(*GetEntitlementsForAccountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentsService_GetPaymentProviderEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPaymentProviderEventsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentsServiceServer).GetPaymentProviderEvents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentsService_GetPaymentProviderEvents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentsServiceServer).GetPaymentProviderEvents(ctx, req.(*GetPaymentProviderEventsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _PaymentsService_ReplayPaymentProviderEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayPaymentProviderEventRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PaymentsServiceServer).ReplayPaymentProviderEvent(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: PaymentsService_ReplayPaymentProviderEvent_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PaymentsServiceServer).ReplayPaymentProviderEvent(ctx, req.(*ReplayPaymentProviderEventRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // PaymentsService_ServiceDesc is the grpc.ServiceDesc for PaymentsService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -534,6 +636,18 @@ func _PaymentsService_GetPaymentHistoryForAccount_Handler(srv interface{}, ctx c
 			MethodName: "GetPaymentHistoryForAccount",
 			Handler:    _PaymentsService_GetPaymentHistoryForAccount_Handler,
 		},
+		{
+			MethodName: "GetEntitlementsForAccount",
+			Handler:    _PaymentsService_GetEntitlementsForAccount_Handler,
+		},
+		{
+			MethodName: "GetPaymentProviderEvents",
+			Handler:    _PaymentsService_GetPaymentProviderEvents_Handler,
+		},
+		{
+			MethodName: "ReplayPaymentProviderEvent",
+			Handler:    _PaymentsService_ReplayPaymentProviderEvent_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "payments/payments_service.proto",
@@ -29,7 +29,7 @@
 	0x6f, 0x12, 0x08, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x1a, 0x25, 0x77, 0x65, 0x62,
 	0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x2f, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x5f, 0x73,
 	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x32, 0xfb, 0x08, 0x0a, 0x0f, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x53,
+	0x74, 0x6f, 0x32, 0xd5, 0x0a, 0x0a, 0x0f, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x53,
 	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x53, 0x0a, 0x0e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76,
 	0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x12, 0x1f, 0x2e, 0x77, 0x65, 0x62, 0x68, 0x6f,
 	0x6f, 0x6b, 0x73, 0x2e, 0x41, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f,
@@ -101,13 +101,27 @@
 	0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x2e, 0x41, 0x72,
 	0x63, 0x68, 0x69, 0x76, 0x65, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x54, 0x72, 0x69, 0x67,
 	0x67, 0x65, 0x72, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x42, 0x60, 0x5a, 0x5e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64,
-	0x69, 0x6e, 0x6e, 0x65, 0x72, 0x64, 0x6f, 0x6e, 0x65, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x2f,
-	0x64, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x64, 0x6f, 0x6e, 0x65, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72,
-	0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61,
-	0x6c, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64,
-	0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f,
-	0x6b, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x12, 0x65, 0x0a, 0x14, 0x47, 0x65, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x12, 0x25, 0x2e, 0x77, 0x65, 0x62, 0x68, 0x6f,
+	0x6f, 0x6b, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65,
+	0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x26, 0x2e, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x57, 0x65,
+	0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x71, 0x0a, 0x18, 0x52, 0x65, 0x64, 0x65, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76,
+	0x65, 0x72, 0x79, 0x12, 0x29, 0x2e, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x2e, 0x52,
+	0x65, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44,
+	0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a,
+	0x2e, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x2e, 0x52, 0x65, 0x64, 0x65, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65,
+	0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x60, 0x5a, 0x5e, 0x67, 0x69,
+	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x64,
+	0x6f, 0x6e, 0x65, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x2f, 0x64, 0x69, 0x6e, 0x6e, 0x65, 0x72,
+	0x64, 0x6f, 0x6e, 0x65, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65,
+	0x6e, 0x64, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x67, 0x72, 0x70, 0x63,
+	0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2f, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x62, 0x06, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x33,
 })
 
 var file_webhooks_webhooks_service_proto_goTypes = []any{
@@ -122,17 +136,21 @@
 	(*GetWebhookTriggerEventsRequest)(nil),      // 8: webhooks.GetWebhookTriggerEventsRequest
 	(*UpdateWebhookTriggerEventRequest)(nil),    // 9: webhooks.UpdateWebhookTriggerEventRequest
 	(*ArchiveWebhookTriggerEventRequest)(nil),   // 10: webhooks.ArchiveWebhookTriggerEventRequest
-	(*ArchiveWebhookResponse)(nil),              // 11: webhooks.ArchiveWebhookResponse
-	(*ArchiveWebhookTriggerConfigResponse)(nil), // 12: webhooks.ArchiveWebhookTriggerConfigResponse
-	(*CreateWebhookResponse)(nil),               // 13: webhooks.CreateWebhookResponse
-	(*AddWebhookTriggerConfigResponse)(nil),     // 14: webhooks.AddWebhookTriggerConfigResponse
-	(*GetWebhookResponse)(nil),                  // 15: webhooks.GetWebhookResponse
-	(*GetWebhooksResponse)(nil),                 // 16: webhooks.GetWebhooksResponse
-	(*CreateWebhookTriggerEventResponse)(nil),   // 17: webhooks.CreateWebhookTriggerEventResponse
-	(*GetWebhookTriggerEventResponse)(nil),      // 18: webhooks.GetWebhookTriggerEventResponse
-	(*GetWebhookTriggerEventsResponse)(nil),     // 19: webhooks.GetWebhookTriggerEventsResponse
-	(*UpdateWebhookTriggerEventResponse)(nil),   // 20: webhooks.UpdateWebhookTriggerEventResponse
-	(*ArchiveWebhookTriggerEventResponse)(nil),  // 21: webhooks.ArchiveWebhookTriggerEventResponse
+	(*GetWebhookDeliveriesRequest)(nil),         // 11: webhooks.GetWebhookDeliveriesRequest
+	(*RedeliverWebhookDeliveryRequest)(nil),     // 12: webhooks.RedeliverWebhookDeliveryRequest
+	(*ArchiveWebhookResponse)(nil),              // 13: webhooks.ArchiveWebhookResponse
+	(*ArchiveWebhookTriggerConfigResponse)(nil), // 14: webhooks.ArchiveWebhookTriggerConfigResponse
+	(*CreateWebhookResponse)(nil),               // 15: webhooks.CreateWebhookResponse
+	(*AddWebhookTriggerConfigResponse)(nil),     // 16: webhooks.AddWebhookTriggerConfigResponse
+	(*GetWebhookResponse)(nil),                  // 17: webhooks.GetWebhookResponse
+	(*GetWebhooksResponse)(nil),                 // 18: webhooks.GetWebhooksResponse
+	(*CreateWebhookTriggerEventResponse)(nil),   // 19: webhooks.CreateWebhookTriggerEventResponse
+	(*GetWebhookTriggerEventResponse)(nil),      // 20: webhooks.GetWebhookTriggerEventResponse
+	(*GetWebhookTriggerEventsResponse)(nil),     // 21: webhooks.GetWebhookTriggerEventsResponse
+	(*UpdateWebhookTriggerEventResponse)(nil),   // 22: webhooks.UpdateWebhookTriggerEventResponse
+	(*ArchiveWebhookTriggerEventResponse)(nil),  // 23: webhooks.ArchiveWebhookTriggerEventResponse
+	(*GetWebhookDeliveriesResponse)(nil),        // 24: webhooks.GetWebhookDeliveriesResponse
+	(*RedeliverWebhookDeliveryResponse)(nil),    // 25: webhooks.RedeliverWebhookDeliveryResponse
 }
 var file_webhooks_webhooks_service_proto_depIdxs = []int32{
 	0,  // 0: webhooks.WebhooksService.ArchiveWebhook:input_type -> webhooks.ArchiveWebhookRequest
@@ -146,19 +164,23 @@
 	8,  // 8: webhooks.WebhooksService.GetWebhookTriggerEvents:input_type -> webhooks.GetWebhookTriggerEventsRequest
 	9,  // 9: webhooks.WebhooksService.UpdateWebhookTriggerEvent:input_type -> webhooks.UpdateWebhookTriggerEventRequest
 	10, // 10: webhooks.WebhooksService.ArchiveWebhookTriggerEvent:input_type -> webhooks.ArchiveWebhookTriggerEventRequest
-	11, // 11: webhooks.WebhooksService.ArchiveWebhook:output_type -> webhooks.ArchiveWebhookResponse
-	12, // 12: webhooks.WebhooksService.ArchiveWebhookTriggerConfig:output_type -> webhooks.ArchiveWebhookTriggerConfigResponse
-	13, // 13: webhooks.WebhooksService.CreateWebhook:output_type -> webhooks.CreateWebhookResponse
-	14, // 14: webhooks.WebhooksService.AddWebhookTriggerConfig:output_type -> webhooks.AddWebhookTriggerConfigResponse
-	15, // 15: webhooks.WebhooksService.GetWebhook:output_type -> webhooks.GetWebhookResponse
-	16, // 16: webhooks.WebhooksService.GetWebhooks:output_type -> webhooks.GetWebhooksResponse
-	17, // 17: webhooks.WebhooksService.CreateWebhookTriggerEvent:output_type -> webhooks.CreateWebhookTriggerEventResponse
-	18, // 18: webhooks.WebhooksService.GetWebhookTriggerEvent:output_type -> webhooks.GetWebhookTriggerEventResponse
-	19, // 19: webhooks.WebhooksService.GetWebhookTriggerEvents:output_type -> webhooks.GetWebhookTriggerEventsResponse
-	20, // 20: webhooks.WebhooksService.UpdateWebhookTriggerEvent:output_type -> webhooks.UpdateWebhookTriggerEventResponse
-	21, // 21: webhooks.WebhooksService.ArchiveWebhookTriggerEvent:output_type -> webhooks.ArchiveWebhookTriggerEventResponse
-	11, // [11:22] is the sub-list for method output_type
-	0,  // [0:11] is the sub-list for method input_type
+	11, // 11: webhooks.WebhooksService.GetWebhookDeliveries:input_type -> webhooks.GetWebhookDeliveriesRequest
+	12, // 12: webhooks.WebhooksService.RedeliverWebhookDelivery:input_type -> webhooks.RedeliverWebhookDeliveryRequest
+	13, // 13: webhooks.WebhooksService.ArchiveWebhook:output_type -> webhooks.ArchiveWebhookResponse
+	14, // 14: webhooks.WebhooksService.ArchiveWebhookTriggerConfig:output_type -> webhooks.ArchiveWebhookTriggerConfigResponse
+	15, // 15: webhooks.WebhooksService.CreateWebhook:output_type -> webhooks.CreateWebhookResponse
+	16, // 16: webhooks.WebhooksService.AddWebhookTriggerConfig:output_type -> webhooks.AddWebhookTriggerConfigResponse
+	17, // 17: webhooks.WebhooksService.GetWebhook:output_type -> webhooks.GetWebhookResponse
+	18, // 18: webhooks.WebhooksService.GetWebhooks:output_type -> webhooks.GetWebhooksResponse
+	19, // 19: webhooks.WebhooksService.CreateWebhookTriggerEvent:output_type -> webhooks.CreateWebhookTriggerEventResponse
+	20, // 20: webhooks.WebhooksService.GetWebhookTriggerEvent:output_type -> webhooks.GetWebhookTriggerEventResponse
+	21, // 21: webhooks.WebhooksService.GetWebhookTriggerEvents:output_type -> webhooks.GetWebhookTriggerEventsResponse
+	22, // 22: webhooks.WebhooksService.UpdateWebhookTriggerEvent:output_type -> webhooks.UpdateWebhookTriggerEventResponse
+	23, // 23: webhooks.WebhooksService.ArchiveWebhookTriggerEvent:output_type -> webhooks.ArchiveWebhookTriggerEventResponse
+	24, // 24: webhooks.WebhooksService.GetWebhookDeliveries:output_type -> webhooks.GetWebhookDeliveriesResponse
+	25, // 25: webhooks.WebhooksService.RedeliverWebhookDelivery:output_type -> webhooks.RedeliverWebhookDeliveryResponse
+	13, // [13:26] is the sub-list for method output_type
+	0,  // [0:13] is the sub-list for method input_type
 	0,  // [0:0] is the sub-list for extension type_name
 	0,  // [0:0] is the sub-list for extension extendee
 	0,  // [0:0] is the sub-list for field type_name
@@ -31,6 +31,8 @@
 	WebhooksService_GetWebhookTriggerEvents_FullMethodName     = "/webhooks.WebhooksService/GetWebhookTriggerEvents"
 	WebhooksService_UpdateWebhookTriggerEvent_FullMethodName   = "/webhooks.WebhooksService/UpdateWebhookTriggerEvent"
 	WebhooksService_ArchiveWebhookTriggerEvent_FullMethodName  = "/webhooks.WebhooksService/ArchiveWebhookTriggerEvent"
+	WebhooksService_GetWebhookDeliveries_FullMethodName        = "/webhooks.WebhooksService/GetWebhookDeliveries"
+	WebhooksService_RedeliverWebhookDelivery_FullMethodName    = "/webhooks.WebhooksService/RedeliverWebhookDelivery"
 )
 
 // WebhooksServiceClient is the client API for WebhooksService service.
@@ -48,6 +50,8 @@ type WebhooksServiceClient interface {
 	GetWebhookTriggerEvents(ctx context.Context, in *GetWebhookTriggerEventsRequest, opts ...grpc.CallOption) (*GetWebhookTriggerEventsResponse, error)
 	UpdateWebhookTriggerEvent(ctx context.Context, in *UpdateWebhookTriggerEventRequest, opts ...grpc.CallOption) (*UpdateWebhookTriggerEventResponse, error)
 	ArchiveWebhookTriggerEvent(ctx context.Context, in *ArchiveWebhookTriggerEventRequest, opts ...grpc.CallOption) (*ArchiveWebhookTriggerEventResponse, error)
+	GetWebhookDeliveries(ctx context.Context, in *GetWebhookDeliveriesRequest, opts ...grpc.CallOption) (*GetWebhookDeliveriesResponse, error)
+	RedeliverWebhookDelivery(ctx context.Context, in *RedeliverWebhookDeliveryRequest, opts ...grpc.CallOption) (*RedeliverWebhookDeliveryResponse, error)
 }
 
 type webhooksServiceClient struct {
@@ -168,6 +172,26 @@ func (c *webhooksServiceClient) ArchiveWebhookTriggerEvent(ctx context.Context,
 	return out, nil
 }
 
+func (c *webhooksServiceClient) GetWebhookDeliveries(ctx context.Context, in *GetWebhookDeliveriesRequest, opts ...grpc.CallOption) (*GetWebhookDeliveriesResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetWebhookDeliveriesResponse)
+	err := c.cc.Invoke(ctx, WebhooksService_GetWebhookDeliveries_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *webhooksServiceClient) RedeliverWebhookDelivery(ctx context.Context, in *RedeliverWebhookDeliveryRequest, opts ...grpc.CallOption) (*RedeliverWebhookDeliveryResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RedeliverWebhookDeliveryResponse)
+	err := c.cc.Invoke(ctx, WebhooksService_RedeliverWebhookDelivery_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // WebhooksServiceServer is the server API for WebhooksService service.
 // All implementations must embed UnimplementedWebhooksServiceServer
 // for forward compatibility.
@@ -183,6 +207,8 @@ type WebhooksServiceServer interface {
 	GetWebhookTriggerEvents(context.Context, *GetWebhookTriggerEventsRequest) (*GetWebhookTriggerEventsResponse, error)
 	UpdateWebhookTriggerEvent(context.Context, *UpdateWebhookTriggerEventRequest) (*UpdateWebhookTriggerEventResponse, error)
 	ArchiveWebhookTriggerEvent(context.Context, *ArchiveWebhookTriggerEventRequest) (*ArchiveWebhookTriggerEventResponse, error)
+	GetWebhookDeliveries(context.Context, *GetWebhookDeliveriesRequest) (*GetWebhookDeliveriesResponse, error)
+	RedeliverWebhookDelivery(context.Context, *RedeliverWebhookDeliveryRequest) (*RedeliverWebhookDeliveryResponse, error)
 	mustEmbedUnimplementedWebhooksServiceServer()
 }
 
@@ -226,6 +252,12 @@ func (UnimplementedWebhooksServiceServer) UpdateWebhookTriggerEvent(context.Cont
 func (UnimplementedWebhooksServiceServer) ArchiveWebhookTriggerEvent(context.Context, *ArchiveWebhookTriggerEventRequest) (*ArchiveWebhookTriggerEventResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ArchiveWebhookTriggerEvent not implemented")
 }
+func (UnimplementedWebhooksServiceServer) GetWebhookDeliveries(context.Context, *GetWebhookDeliveriesRequest) (*GetWebhookDeliveriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWebhookDeliveries not implemented")
+}
+func (UnimplementedWebhooksServiceServer) RedeliverWebhookDelivery(context.Context, *RedeliverWebhookDeliveryRequest) (*RedeliverWebhookDeliveryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedeliverWebhookDelivery not implemented")
+}
 func (UnimplementedWebhooksServiceServer) mustEmbedUnimplementedWebhooksServiceServer() {}
 func (UnimplementedWebhooksServiceServer) testEmbeddedByValue()                         {}
 
@@ -445,6 +477,42 @@ func _WebhooksService_ArchiveWebhookTriggerEvent_Handler(srv interface{}, ctx co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _WebhooksService_GetWebhookDeliveries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetWebhookDeliveriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhooksServiceServer).GetWebhookDeliveries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WebhooksService_GetWebhookDeliveries_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhooksServiceServer).GetWebhookDeliveries(ctx, req.(*GetWebhookDeliveriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _WebhooksService_RedeliverWebhookDelivery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RedeliverWebhookDeliveryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(WebhooksServiceServer).RedeliverWebhookDelivery(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: WebhooksService_RedeliverWebhookDelivery_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(WebhooksServiceServer).RedeliverWebhookDelivery(ctx, req.(*RedeliverWebhookDeliveryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // WebhooksService_ServiceDesc is the grpc.ServiceDesc for WebhooksService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -496,6 +564,14 @@ func _WebhooksService_ArchiveWebhookTriggerEvent_Handler(srv interface{}, ctx co
 			MethodName: "ArchiveWebhookTriggerEvent",
 			Handler:    _WebhooksService_ArchiveWebhookTriggerEvent_Handler,
 		},
+		{
+			MethodName: "GetWebhookDeliveries",
+			Handler:    _WebhooksService_GetWebhookDeliveries_Handler,
+		},
+		{
+			MethodName: "RedeliverWebhookDelivery",
+			Handler:    _WebhooksService_RedeliverWebhookDelivery_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "webhooks/webhooks_service.proto",
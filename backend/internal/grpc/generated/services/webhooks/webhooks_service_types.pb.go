@@ -1408,6 +1408,222 @@ func (x *ArchiveWebhookTriggerEventResponse) GetResponseDetails() *types.Respons
 	return nil
 }
 
+type GetWebhookDeliveriesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WebhookId     string                 `protobuf:"bytes,1,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	Filter        *filtering.QueryFilter `protobuf:"bytes,2,opt,name=filter,proto3" json:"filter,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWebhookDeliveriesRequest) Reset() {
+	*x = GetWebhookDeliveriesRequest{}
+	mi := &file_webhooks_webhooks_service_types_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWebhookDeliveriesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWebhookDeliveriesRequest) ProtoMessage() {}
+
+func (x *GetWebhookDeliveriesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_webhooks_webhooks_service_types_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWebhookDeliveriesRequest.ProtoReflect.Descriptor instead.
+func (*GetWebhookDeliveriesRequest) Descriptor() ([]byte, []int) {
+	return file_webhooks_webhooks_service_types_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *GetWebhookDeliveriesRequest) GetWebhookId() string {
+	if x != nil {
+		return x.WebhookId
+	}
+	return ""
+}
+
+func (x *GetWebhookDeliveriesRequest) GetFilter() *filtering.QueryFilter {
+	if x != nil {
+		return x.Filter
+	}
+	return nil
+}
+
+type GetWebhookDeliveriesResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ResponseDetails *types.ResponseDetails `protobuf:"bytes,1,opt,name=response_details,json=responseDetails,proto3" json:"response_details,omitempty"`
+	Pagination      *filtering.Pagination  `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	Results         []*WebhookDelivery     `protobuf:"bytes,3,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetWebhookDeliveriesResponse) Reset() {
+	*x = GetWebhookDeliveriesResponse{}
+	mi := &file_webhooks_webhooks_service_types_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWebhookDeliveriesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWebhookDeliveriesResponse) ProtoMessage() {}
+
+func (x *GetWebhookDeliveriesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_webhooks_webhooks_service_types_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWebhookDeliveriesResponse.ProtoReflect.Descriptor instead.
+func (*GetWebhookDeliveriesResponse) Descriptor() ([]byte, []int) {
+	return file_webhooks_webhooks_service_types_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *GetWebhookDeliveriesResponse) GetResponseDetails() *types.ResponseDetails {
+	if x != nil {
+		return x.ResponseDetails
+	}
+	return nil
+}
+
+func (x *GetWebhookDeliveriesResponse) GetPagination() *filtering.Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+func (x *GetWebhookDeliveriesResponse) GetResults() []*WebhookDelivery {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type RedeliverWebhookDeliveryRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	WebhookId         string                 `protobuf:"bytes,1,opt,name=webhook_id,json=webhookId,proto3" json:"webhook_id,omitempty"`
+	WebhookDeliveryId string                 `protobuf:"bytes,2,opt,name=webhook_delivery_id,json=webhookDeliveryId,proto3" json:"webhook_delivery_id,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *RedeliverWebhookDeliveryRequest) Reset() {
+	*x = RedeliverWebhookDeliveryRequest{}
+	mi := &file_webhooks_webhooks_service_types_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedeliverWebhookDeliveryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeliverWebhookDeliveryRequest) ProtoMessage() {}
+
+func (x *RedeliverWebhookDeliveryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_webhooks_webhooks_service_types_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeliverWebhookDeliveryRequest.ProtoReflect.Descriptor instead.
+func (*RedeliverWebhookDeliveryRequest) Descriptor() ([]byte, []int) {
+	return file_webhooks_webhooks_service_types_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *RedeliverWebhookDeliveryRequest) GetWebhookId() string {
+	if x != nil {
+		return x.WebhookId
+	}
+	return ""
+}
+
+func (x *RedeliverWebhookDeliveryRequest) GetWebhookDeliveryId() string {
+	if x != nil {
+		return x.WebhookDeliveryId
+	}
+	return ""
+}
+
+type RedeliverWebhookDeliveryResponse struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ResponseDetails *types.ResponseDetails `protobuf:"bytes,1,opt,name=response_details,json=responseDetails,proto3" json:"response_details,omitempty"`
+	RequestId       string                 `protobuf:"bytes,2,opt,name=request_id,json=requestId,proto3" json:"request_id,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *RedeliverWebhookDeliveryResponse) Reset() {
+	*x = RedeliverWebhookDeliveryResponse{}
+	mi := &file_webhooks_webhooks_service_types_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RedeliverWebhookDeliveryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RedeliverWebhookDeliveryResponse) ProtoMessage() {}
+
+func (x *RedeliverWebhookDeliveryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_webhooks_webhooks_service_types_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RedeliverWebhookDeliveryResponse.ProtoReflect.Descriptor instead.
+func (*RedeliverWebhookDeliveryResponse) Descriptor() ([]byte, []int) {
+	return file_webhooks_webhooks_service_types_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *RedeliverWebhookDeliveryResponse) GetResponseDetails() *types.ResponseDetails {
+	if x != nil {
+		return x.ResponseDetails
+	}
+	return nil
+}
+
+func (x *RedeliverWebhookDeliveryResponse) GetRequestId() string {
+	if x != nil {
+		return x.RequestId
+	}
+	return ""
+}
+
 var File_webhooks_webhooks_service_types_proto protoreflect.FileDescriptor
 
 var file_webhooks_webhooks_service_types_proto_rawDesc = string([]byte{
@@ -1628,14 +1844,49 @@ func (x *ArchiveWebhookTriggerEventResponse) GetResponseDetails() *types.Respons
 	0x73, 0x65, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
 	0x32, 0x17, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
 	0x73, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x42, 0x60, 0x5a, 0x5e, 0x67, 0x69,
-	0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x64, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x64,
-	0x6f, 0x6e, 0x65, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x2f, 0x64, 0x69, 0x6e, 0x6e, 0x65, 0x72,
-	0x64, 0x6f, 0x6e, 0x65, 0x62, 0x65, 0x74, 0x74, 0x65, 0x72, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65,
-	0x6e, 0x64, 0x2f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x67, 0x72, 0x70, 0x63,
-	0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x73, 0x2f, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x6e, 0x73, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x22, 0x6c, 0x0a, 0x1b, 0x47, 0x65,
+	0x74, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x77, 0x65, 0x62,
+	0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x77,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x06, 0x66, 0x69, 0x6c, 0x74,
+	0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x66, 0x69, 0x6c, 0x74, 0x65,
+	0x72, 0x69, 0x6e, 0x67, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72,
+	0x52, 0x06, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x22, 0xce, 0x01, 0x0a, 0x1c, 0x47, 0x65, 0x74,
+	0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x69, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x10, 0x72, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x0f, 0x72, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x35, 0x0a,
+	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x66, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x69, 0x6e, 0x67, 0x2e, 0x50, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x33, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18,
+	0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x73,
+	0x2e, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79,
+	0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22, 0x70, 0x0a, 0x1f, 0x52, 0x65, 0x64,
+	0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x44, 0x65, 0x6c,
+	0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
+	0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x49, 0x64, 0x12, 0x2e, 0x0a, 0x13, 0x77,
+	0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b, 0x5f, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x77, 0x65, 0x62, 0x68, 0x6f, 0x6f,
+	0x6b, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x49, 0x64, 0x22, 0x85, 0x01, 0x0a, 0x20,
+	0x52, 0x65, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x57, 0x65, 0x62, 0x68, 0x6f, 0x6f, 0x6b,
+	0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x42, 0x0a, 0x10, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x63, 0x6f, 0x6d,
+	0x6d, 0x6f, 0x6e, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x74, 0x61,
+	0x69, 0x6c, 0x73, 0x52, 0x0f, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x44, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x5f,
+	0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x49, 0x64, 0x42, 0x60, 0x5a, 0x5e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x64, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x64, 0x6f, 0x6e, 0x65, 0x62, 0x65, 0x74, 0x74,
+	0x65, 0x72, 0x2f, 0x64, 0x69, 0x6e, 0x6e, 0x65, 0x72, 0x64, 0x6f, 0x6e, 0x65, 0x62, 0x65, 0x74,
+	0x74, 0x65, 0x72, 0x2f, 0x62, 0x61, 0x63, 0x6b, 0x65, 0x6e, 0x64, 0x2f, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x6e, 0x61, 0x6c, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61,
+	0x74, 0x65, 0x64, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x77, 0x65, 0x62,
+	0x68, 0x6f, 0x6f, 0x6b, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 })
 
 var (
@@ -1650,7 +1901,7 @@ func file_webhooks_webhooks_service_types_proto_rawDescGZIP() []byte {
 	return file_webhooks_webhooks_service_types_proto_rawDescData
 }
 
-var file_webhooks_webhooks_service_types_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
+var file_webhooks_webhooks_service_types_proto_msgTypes = make([]protoimpl.MessageInfo, 31)
 var file_webhooks_webhooks_service_types_proto_goTypes = []any{
 	(*WebhookCreationRequestInput)(nil),              // 0: webhooks.WebhookCreationRequestInput
 	(*WebhookExecutionRequest)(nil),                  // 1: webhooks.WebhookExecutionRequest
@@ -1679,52 +1930,62 @@ func file_webhooks_webhooks_service_types_proto_rawDescGZIP() []byte {
 	(*UpdateWebhookTriggerEventResponse)(nil),        // 24: webhooks.UpdateWebhookTriggerEventResponse
 	(*ArchiveWebhookTriggerEventRequest)(nil),        // 25: webhooks.ArchiveWebhookTriggerEventRequest
 	(*ArchiveWebhookTriggerEventResponse)(nil),       // 26: webhooks.ArchiveWebhookTriggerEventResponse
-	(WebhookContentType)(0),                          // 27: webhooks.WebhookContentType
-	(WebhookMethod)(0),                               // 28: webhooks.WebhookMethod
-	(*anypb.Any)(nil),                                // 29: google.protobuf.Any
-	(*types.ResponseDetails)(nil),                    // 30: common.ResponseDetails
-	(*Webhook)(nil),                                  // 31: webhooks.Webhook
-	(*WebhookTriggerConfig)(nil),                     // 32: webhooks.WebhookTriggerConfig
-	(*filtering.QueryFilter)(nil),                    // 33: filtering.QueryFilter
-	(*filtering.Pagination)(nil),                     // 34: filtering.Pagination
-	(*WebhookTriggerEvent)(nil),                      // 35: webhooks.WebhookTriggerEvent
+	(*GetWebhookDeliveriesRequest)(nil),              // 27: webhooks.GetWebhookDeliveriesRequest
+	(*GetWebhookDeliveriesResponse)(nil),             // 28: webhooks.GetWebhookDeliveriesResponse
+	(*RedeliverWebhookDeliveryRequest)(nil),          // 29: webhooks.RedeliverWebhookDeliveryRequest
+	(*RedeliverWebhookDeliveryResponse)(nil),         // 30: webhooks.RedeliverWebhookDeliveryResponse
+	(WebhookContentType)(0),                          // 31: webhooks.WebhookContentType
+	(WebhookMethod)(0),                               // 32: webhooks.WebhookMethod
+	(*anypb.Any)(nil),                                // 33: google.protobuf.Any
+	(*types.ResponseDetails)(nil),                    // 34: common.ResponseDetails
+	(*Webhook)(nil),                                  // 35: webhooks.Webhook
+	(*WebhookTriggerConfig)(nil),                     // 36: webhooks.WebhookTriggerConfig
+	(*filtering.QueryFilter)(nil),                    // 37: filtering.QueryFilter
+	(*filtering.Pagination)(nil),                     // 38: filtering.Pagination
+	(*WebhookTriggerEvent)(nil),                      // 39: webhooks.WebhookTriggerEvent
+	(*WebhookDelivery)(nil),                          // 40: webhooks.WebhookDelivery
 }
 var file_webhooks_webhooks_service_types_proto_depIdxs = []int32{
-	27, // 0: webhooks.WebhookCreationRequestInput.content_type:type_name -> webhooks.WebhookContentType
-	28, // 1: webhooks.WebhookCreationRequestInput.method:type_name -> webhooks.WebhookMethod
+	31, // 0: webhooks.WebhookCreationRequestInput.content_type:type_name -> webhooks.WebhookContentType
+	32, // 1: webhooks.WebhookCreationRequestInput.method:type_name -> webhooks.WebhookMethod
 	15, // 2: webhooks.WebhookCreationRequestInput.events:type_name -> webhooks.WebhookTriggerEventCreationRequestInput
-	29, // 3: webhooks.WebhookExecutionRequest.payload:type_name -> google.protobuf.Any
+	33, // 3: webhooks.WebhookExecutionRequest.payload:type_name -> google.protobuf.Any
 	0,  // 4: webhooks.CreateWebhookRequest.input:type_name -> webhooks.WebhookCreationRequestInput
-	30, // 5: webhooks.CreateWebhookResponse.response_details:type_name -> common.ResponseDetails
-	31, // 6: webhooks.CreateWebhookResponse.created:type_name -> webhooks.Webhook
+	34, // 5: webhooks.CreateWebhookResponse.response_details:type_name -> common.ResponseDetails
+	35, // 6: webhooks.CreateWebhookResponse.created:type_name -> webhooks.Webhook
 	2,  // 7: webhooks.AddWebhookTriggerConfigRequest.input:type_name -> webhooks.WebhookTriggerConfigCreationRequestInput
-	30, // 8: webhooks.AddWebhookTriggerConfigResponse.response_details:type_name -> common.ResponseDetails
-	32, // 9: webhooks.AddWebhookTriggerConfigResponse.created:type_name -> webhooks.WebhookTriggerConfig
-	30, // 10: webhooks.ArchiveWebhookResponse.response_details:type_name -> common.ResponseDetails
-	30, // 11: webhooks.ArchiveWebhookTriggerConfigResponse.response_details:type_name -> common.ResponseDetails
-	30, // 12: webhooks.GetWebhookResponse.response_details:type_name -> common.ResponseDetails
-	31, // 13: webhooks.GetWebhookResponse.result:type_name -> webhooks.Webhook
-	33, // 14: webhooks.GetWebhooksRequest.filter:type_name -> filtering.QueryFilter
-	30, // 15: webhooks.GetWebhooksResponse.response_details:type_name -> common.ResponseDetails
-	34, // 16: webhooks.GetWebhooksResponse.pagination:type_name -> filtering.Pagination
-	31, // 17: webhooks.GetWebhooksResponse.results:type_name -> webhooks.Webhook
+	34, // 8: webhooks.AddWebhookTriggerConfigResponse.response_details:type_name -> common.ResponseDetails
+	36, // 9: webhooks.AddWebhookTriggerConfigResponse.created:type_name -> webhooks.WebhookTriggerConfig
+	34, // 10: webhooks.ArchiveWebhookResponse.response_details:type_name -> common.ResponseDetails
+	34, // 11: webhooks.ArchiveWebhookTriggerConfigResponse.response_details:type_name -> common.ResponseDetails
+	34, // 12: webhooks.GetWebhookResponse.response_details:type_name -> common.ResponseDetails
+	35, // 13: webhooks.GetWebhookResponse.result:type_name -> webhooks.Webhook
+	37, // 14: webhooks.GetWebhooksRequest.filter:type_name -> filtering.QueryFilter
+	34, // 15: webhooks.GetWebhooksResponse.response_details:type_name -> common.ResponseDetails
+	38, // 16: webhooks.GetWebhooksResponse.pagination:type_name -> filtering.Pagination
+	35, // 17: webhooks.GetWebhooksResponse.results:type_name -> webhooks.Webhook
 	15, // 18: webhooks.CreateWebhookTriggerEventRequest.input:type_name -> webhooks.WebhookTriggerEventCreationRequestInput
-	30, // 19: webhooks.CreateWebhookTriggerEventResponse.response_details:type_name -> common.ResponseDetails
-	35, // 20: webhooks.CreateWebhookTriggerEventResponse.created:type_name -> webhooks.WebhookTriggerEvent
-	30, // 21: webhooks.GetWebhookTriggerEventResponse.response_details:type_name -> common.ResponseDetails
-	35, // 22: webhooks.GetWebhookTriggerEventResponse.result:type_name -> webhooks.WebhookTriggerEvent
-	33, // 23: webhooks.GetWebhookTriggerEventsRequest.filter:type_name -> filtering.QueryFilter
-	30, // 24: webhooks.GetWebhookTriggerEventsResponse.response_details:type_name -> common.ResponseDetails
-	34, // 25: webhooks.GetWebhookTriggerEventsResponse.pagination:type_name -> filtering.Pagination
-	35, // 26: webhooks.GetWebhookTriggerEventsResponse.results:type_name -> webhooks.WebhookTriggerEvent
+	34, // 19: webhooks.CreateWebhookTriggerEventResponse.response_details:type_name -> common.ResponseDetails
+	39, // 20: webhooks.CreateWebhookTriggerEventResponse.created:type_name -> webhooks.WebhookTriggerEvent
+	34, // 21: webhooks.GetWebhookTriggerEventResponse.response_details:type_name -> common.ResponseDetails
+	39, // 22: webhooks.GetWebhookTriggerEventResponse.result:type_name -> webhooks.WebhookTriggerEvent
+	37, // 23: webhooks.GetWebhookTriggerEventsRequest.filter:type_name -> filtering.QueryFilter
+	34, // 24: webhooks.GetWebhookTriggerEventsResponse.response_details:type_name -> common.ResponseDetails
+	38, // 25: webhooks.GetWebhookTriggerEventsResponse.pagination:type_name -> filtering.Pagination
+	39, // 26: webhooks.GetWebhookTriggerEventsResponse.results:type_name -> webhooks.WebhookTriggerEvent
 	22, // 27: webhooks.UpdateWebhookTriggerEventRequest.input:type_name -> webhooks.WebhookTriggerEventUpdateRequestInput
-	30, // 28: webhooks.UpdateWebhookTriggerEventResponse.response_details:type_name -> common.ResponseDetails
-	30, // 29: webhooks.ArchiveWebhookTriggerEventResponse.response_details:type_name -> common.ResponseDetails
-	30, // [30:30] is the sub-list for method output_type
-	30, // [30:30] is the sub-list for method input_type
-	30, // [30:30] is the sub-list for extension type_name
-	30, // [30:30] is the sub-list for extension extendee
-	0,  // [0:30] is the sub-list for field type_name
+	34, // 28: webhooks.UpdateWebhookTriggerEventResponse.response_details:type_name -> common.ResponseDetails
+	34, // 29: webhooks.ArchiveWebhookTriggerEventResponse.response_details:type_name -> common.ResponseDetails
+	37, // 30: webhooks.GetWebhookDeliveriesRequest.filter:type_name -> filtering.QueryFilter
+	34, // 31: webhooks.GetWebhookDeliveriesResponse.response_details:type_name -> common.ResponseDetails
+	38, // 32: webhooks.GetWebhookDeliveriesResponse.pagination:type_name -> filtering.Pagination
+	40, // 33: webhooks.GetWebhookDeliveriesResponse.results:type_name -> webhooks.WebhookDelivery
+	34, // 34: webhooks.RedeliverWebhookDeliveryResponse.response_details:type_name -> common.ResponseDetails
+	35, // [35:35] is the sub-list for method output_type
+	35, // [35:35] is the sub-list for method input_type
+	35, // [35:35] is the sub-list for extension type_name
+	35, // [35:35] is the sub-list for extension extendee
+	0,  // [0:35] is the sub-list for field type_name
 }
 
 func init() { file_webhooks_webhooks_service_types_proto_init() }
@@ -1740,7 +2001,7 @@ type x struct{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_webhooks_webhooks_service_types_proto_rawDesc), len(file_webhooks_webhooks_service_types_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   27,
+			NumMessages:   31,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
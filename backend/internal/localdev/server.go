@@ -305,7 +305,7 @@ func BuildInsecureOAuthedGRPCClient(
 	oauth2Config := oauth2.Config{
 		ClientID:     createdClientID,
 		ClientSecret: createdClientSecret,
-		Scopes:       []string{"anything"}, // TODO: This should be nil-able
+		Scopes:       []string{authorization.ReadOAuth2Scope, authorization.WriteOAuth2Scope}, // TODO: This should be nil-able
 		RedirectURL:  httpTestServerAddress,
 		Endpoint: oauth2.Endpoint{
 			AuthStyle: oauth2.AuthStyleInParams,
@@ -314,10 +314,8 @@ func BuildInsecureOAuthedGRPCClient(
 		},
 	}
 
-	authCodeURL := oauth2Config.AuthCodeURL(
-		state,
-		oauth2.SetAuthURLParam("code_challenge_method", "plain"),
-	)
+	verifier := oauth2.GenerateVerifier()
+	authCodeURL := oauth2Config.AuthCodeURL(state, oauth2.S256ChallengeOption(verifier))
 
 	req, err := http.NewRequestWithContext(
 		ctx,
@@ -361,7 +359,7 @@ func BuildInsecureOAuthedGRPCClient(
 		return nil, fmt.Errorf("code not returned from oauth2 redirect")
 	}
 
-	oauth2Token, err := oauth2Config.Exchange(ctx, code)
+	oauth2Token, err := oauth2Config.Exchange(ctx, code, oauth2.VerifierOption(verifier))
 	if err != nil {
 		return nil, fmt.Errorf("exchanging OAuth2 code: %w", err)
 	}
@@ -427,6 +425,26 @@ func FetchOAuth2TokenForUser(
 	ctx context.Context,
 	httpServerAddress, grpcServerAddress, clientID, clientSecret string,
 	loginInput *authsvc.UserLoginInput,
+) (*oauth2.Token, error) {
+	return FetchOAuth2TokenForUserWithScopes(
+		ctx,
+		httpServerAddress,
+		grpcServerAddress,
+		clientID,
+		clientSecret,
+		loginInput,
+		[]string{authorization.ReadOAuth2Scope, authorization.WriteOAuth2Scope},
+	)
+}
+
+// FetchOAuth2TokenForUserWithScopes runs the authorization code flow for a user, requesting the given scopes.
+// Any additional options are added to the authorization request, e.g. an OpenID Connect nonce.
+func FetchOAuth2TokenForUserWithScopes(
+	ctx context.Context,
+	httpServerAddress, grpcServerAddress, clientID, clientSecret string,
+	loginInput *authsvc.UserLoginInput,
+	scopes []string,
+	authCodeOptions ...oauth2.AuthCodeOption,
 ) (*oauth2.Token, error) {
 	jwt, err := FetchLoginTokenForUser(ctx, grpcServerAddress, loginInput)
 	if err != nil {
@@ -441,7 +459,7 @@ func FetchOAuth2TokenForUser(
 	oauth2Config := oauth2.Config{
 		ClientID:     clientID,
 		ClientSecret: clientSecret,
-		Scopes:       []string{"anything"},
+		Scopes:       scopes,
 		RedirectURL:  httpServerAddress,
 		Endpoint: oauth2.Endpoint{
 			AuthStyle: oauth2.AuthStyleInParams,
@@ -450,10 +468,8 @@ func FetchOAuth2TokenForUser(
 		},
 	}
 
-	authCodeURL := oauth2Config.AuthCodeURL(
-		state,
-		oauth2.SetAuthURLParam("code_challenge_method", "plain"),
-	)
+	verifier := oauth2.GenerateVerifier()
+	authCodeURL := oauth2Config.AuthCodeURL(state, append(authCodeOptions, oauth2.S256ChallengeOption(verifier))...)
 
 	req, err := http.NewRequestWithContext(ctx, http.MethodGet, authCodeURL, http.NoBody)
 	if err != nil {
@@ -488,7 +504,7 @@ func FetchOAuth2TokenForUser(
 		return nil, fmt.Errorf("code not returned from oauth2 redirect")
 	}
 
-	oauth2Token, err := oauth2Config.Exchange(ctx, code)
+	oauth2Token, err := oauth2Config.Exchange(ctx, code, oauth2.VerifierOption(verifier))
 	if err != nil {
 		return nil, fmt.Errorf("exchanging OAuth2 code: %w", err)
 	}
@@ -0,0 +1,106 @@
+package auditlogentries
+
+import (
+	"context"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/audit"
+	identitykeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity/keys"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/repositories/postgres/auditlogentries/generated"
+
+	"github.com/primandproper/platform/database"
+	platformerrors "github.com/primandproper/platform/errors"
+	"github.com/primandproper/platform/observability"
+	"github.com/primandproper/platform/observability/tracing"
+)
+
+// GetAuditLogCheckpointsForAccount fetches every checkpoint of an account's audit log chain, in sequence order.
+func (q *repository) GetAuditLogCheckpointsForAccount(ctx context.Context, accountID string) ([]*audit.AuditLogCheckpoint, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := q.logger.Clone()
+
+	if accountID == "" {
+		return nil, platformerrors.ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(identitykeys.AccountIDKey, accountID)
+	tracing.AttachToSpan(span, identitykeys.AccountIDKey, accountID)
+
+	results, err := q.generatedQuerier.GetAuditLogCheckpointsForAccount(ctx, q.readDB, accountID)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching audit log checkpoints")
+	}
+
+	x := []*audit.AuditLogCheckpoint{}
+	for _, result := range results {
+		x = append(x, &audit.AuditLogCheckpoint{
+			CreatedAt:        result.CreatedAt,
+			ID:               result.ID,
+			BelongsToAccount: result.BelongsToAccount,
+			EntryHash:        result.EntryHash,
+			Signature:        result.Signature,
+			KeyID:            result.KeyID,
+			Sequence:         uint64(result.Sequence),
+		})
+	}
+
+	return x, nil
+}
+
+// GetUncheckpointedAuditLogChainHeads fetches the head of every audit log chain that has grown since it was last checkpointed.
+func (q *repository) GetUncheckpointedAuditLogChainHeads(ctx context.Context) ([]*audit.AuditLogChainHead, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	results, err := q.generatedQuerier.GetUncheckpointedAuditLogChainHeads(ctx, q.readDB)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, q.logger, span, "fetching uncheckpointed audit log chain heads")
+	}
+
+	x := []*audit.AuditLogChainHead{}
+	for _, result := range results {
+		x = append(x, &audit.AuditLogChainHead{
+			BelongsToAccount: database.StringFromNullString(result.BelongsToAccount),
+			Hash:             result.Hash,
+			Sequence:         uint64(result.Sequence.Int64),
+		})
+	}
+
+	return x, nil
+}
+
+// CreateAuditLogCheckpoint creates an audit log checkpoint in the database.
+func (q *repository) CreateAuditLogCheckpoint(ctx context.Context, input *audit.AuditLogCheckpointDatabaseCreationInput) (*audit.AuditLogCheckpoint, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if input == nil {
+		return nil, platformerrors.ErrNilInputProvided
+	}
+
+	logger := q.logger.WithValue(identitykeys.AccountIDKey, input.BelongsToAccount)
+	tracing.AttachToSpan(span, identitykeys.AccountIDKey, input.BelongsToAccount)
+
+	if err := q.generatedQuerier.CreateAuditLogCheckpoint(ctx, q.writeDB, &generated.CreateAuditLogCheckpointParams{
+		ID:               input.ID,
+		BelongsToAccount: input.BelongsToAccount,
+		Sequence:         int64(input.Sequence),
+		EntryHash:        input.EntryHash,
+		Signature:        input.Signature,
+		KeyID:            input.KeyID,
+	}); err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "creating audit log checkpoint")
+	}
+
+	x := &audit.AuditLogCheckpoint{
+		CreatedAt:        q.CurrentTime(),
+		ID:               input.ID,
+		BelongsToAccount: input.BelongsToAccount,
+		EntryHash:        input.EntryHash,
+		Signature:        input.Signature,
+		KeyID:            input.KeyID,
+		Sequence:         input.Sequence,
+	}
+
+	return x, nil
+}
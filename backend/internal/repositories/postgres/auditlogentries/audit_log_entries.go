@@ -4,7 +4,9 @@
 	"context"
 	"database/sql"
 	"encoding/json"
+	"errors"
 	"strings"
+	"time"
 
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/audit"
 	auditkeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/audit/keys"
@@ -20,6 +22,7 @@
 
 var (
 	_ audit.AuditLogEntryDataManager = (*repository)(nil)
+	_ audit.AuditLogChainDataManager = (*repository)(nil)
 )
 
 // GetAuditLogEntry fetches an audit log entry from the database.
@@ -43,11 +46,16 @@ func (q *repository) GetAuditLogEntry(ctx context.Context, auditLogEntryID strin
 	auditLogEntry := &audit.AuditLogEntry{
 		CreatedAt:        result.CreatedAt,
 		BelongsToAccount: database.StringPointerFromNullString(result.BelongsToAccount),
+		RedactedAt:       database.TimePointerFromNullTime(result.RedactedAt),
 		ID:               result.ID,
 		ResourceType:     result.ResourceType,
 		RelevantID:       result.RelevantID,
 		EventType:        string(result.EventType),
 		BelongsToUser:    database.StringFromNullString(result.BelongsToUser),
+		PreviousHash:     result.PreviousHash,
+		ChangesHash:      result.ChangesHash,
+		Hash:             result.Hash,
+		Sequence:         uint64(result.Sequence.Int64),
 	}
 
 	if err = json.Unmarshal(result.Changes, &auditLogEntry.Changes); err != nil {
@@ -339,7 +347,161 @@ func(t *audit.AuditLogEntry) string {
 	return x, nil
 }
 
-// CreateAuditLogEntry creates an audit log entry in a database.
+// SearchAuditLogEntriesForAccount fetches a list of audit log entries for an account that match a search input and a particular filter.
+func (q *repository) SearchAuditLogEntriesForAccount(ctx context.Context, accountID string, input *audit.AuditLogEntrySearchInput, filter *filtering.QueryFilter) (*filtering.QueryFilteredResult[audit.AuditLogEntry], error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := q.logger.Clone()
+
+	if accountID == "" {
+		return nil, platformerrors.ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(identitykeys.AccountIDKey, accountID)
+	tracing.AttachToSpan(span, identitykeys.AccountIDKey, accountID)
+
+	if input == nil {
+		return nil, platformerrors.ErrNilInputProvided
+	}
+	logger = logger.WithValue(auditkeys.AuditLogEntryResourceTypesKey, input.ResourceTypes)
+	tracing.AttachToSpan(span, auditkeys.AuditLogEntryResourceTypesKey, input.ResourceTypes)
+
+	if filter == nil {
+		filter = filtering.DefaultQueryFilter()
+	}
+	logger = filter.AttachToLogger(logger)
+	tracing.AttachQueryFilterToSpan(span, filter)
+
+	// the query treats an empty array as "match everything", but a nil slice is sent as NULL, which matches nothing.
+	resourceTypes, eventTypes := []string{}, []string{}
+	resourceTypes = append(resourceTypes, input.ResourceTypes...)
+	eventTypes = append(eventTypes, input.EventTypes...)
+
+	results, err := q.generatedQuerier.SearchAuditLogEntriesForAccount(ctx, q.readDB, &generated.SearchAuditLogEntriesForAccountParams{
+		CreatedAfter:     database.NullTimeFromTimePointer(filter.CreatedAfter),
+		CreatedBefore:    database.NullTimeFromTimePointer(filter.CreatedBefore),
+		BelongsToAccount: database.NullStringFromString(accountID),
+		ResourceTypes:    resourceTypes,
+		EventTypes:       eventTypes,
+		BelongsToUser:    sql.NullString{String: input.BelongsToUser, Valid: strings.TrimSpace(input.BelongsToUser) != ""},
+		RelevantID:       sql.NullString{String: input.RelevantID, Valid: strings.TrimSpace(input.RelevantID) != ""},
+		ChangedField:     sql.NullString{String: input.ChangedField, Valid: strings.TrimSpace(input.ChangedField) != ""},
+		Cursor:           database.NullStringFromStringPointer(filter.Cursor),
+		ResultLimit:      database.NullInt32FromUint8Pointer(filter.MaxResponseSize),
+	})
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "searching audit log entries")
+	}
+
+	var (
+		data                      []*audit.AuditLogEntry
+		filteredCount, totalCount uint64
+	)
+	for _, result := range results {
+		auditLogEntry := &audit.AuditLogEntry{
+			CreatedAt:        result.CreatedAt,
+			BelongsToAccount: database.StringPointerFromNullString(result.BelongsToAccount),
+			ID:               result.ID,
+			ResourceType:     result.ResourceType,
+			RelevantID:       result.RelevantID,
+			EventType:        string(result.EventType),
+			BelongsToUser:    database.StringFromNullString(result.BelongsToUser),
+		}
+
+		if err = json.Unmarshal(result.Changes, &auditLogEntry.Changes); err != nil {
+			return nil, observability.PrepareAndLogError(err, logger, span, "parsing audit log entry JSON data")
+		}
+
+		data = append(data, auditLogEntry)
+		filteredCount = uint64(result.FilteredCount)
+		totalCount = uint64(result.TotalCount)
+	}
+
+	x := filtering.NewQueryFilteredResult(
+		data,
+		filteredCount,
+		totalCount,
+		func(t *audit.AuditLogEntry) string {
+			return t.ID
+		},
+		filter,
+	)
+
+	return x, nil
+}
+
+// ExportAuditLogEntriesForAccount fetches the next page of an account's audit log entries that match a search input
+// and the filter's time range, ordered by ID and starting after afterID. Unlike SearchAuditLogEntriesForAccount it
+// doesn't count matches, so walking a large log doesn't rescan it for every page.
+func (q *repository) ExportAuditLogEntriesForAccount(ctx context.Context, accountID string, input *audit.AuditLogEntrySearchInput, filter *filtering.QueryFilter, afterID string, limit uint8) ([]*audit.AuditLogEntry, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := q.logger.Clone()
+
+	if accountID == "" {
+		return nil, platformerrors.ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(identitykeys.AccountIDKey, accountID)
+	tracing.AttachToSpan(span, identitykeys.AccountIDKey, accountID)
+
+	if input == nil {
+		return nil, platformerrors.ErrNilInputProvided
+	}
+	logger = logger.WithValue(auditkeys.AuditLogEntryResourceTypesKey, input.ResourceTypes)
+	tracing.AttachToSpan(span, auditkeys.AuditLogEntryResourceTypesKey, input.ResourceTypes)
+
+	if filter == nil {
+		filter = filtering.DefaultQueryFilter()
+	}
+	logger = filter.AttachToLogger(logger)
+	tracing.AttachQueryFilterToSpan(span, filter)
+
+	// the query treats an empty array as "match everything", but a nil slice is sent as NULL, which matches nothing.
+	resourceTypes, eventTypes := []string{}, []string{}
+	resourceTypes = append(resourceTypes, input.ResourceTypes...)
+	eventTypes = append(eventTypes, input.EventTypes...)
+
+	results, err := q.generatedQuerier.ExportAuditLogEntriesForAccount(ctx, q.readDB, &generated.ExportAuditLogEntriesForAccountParams{
+		BelongsToAccount: database.NullStringFromString(accountID),
+		ResourceTypes:    resourceTypes,
+		EventTypes:       eventTypes,
+		BelongsToUser:    sql.NullString{String: input.BelongsToUser, Valid: strings.TrimSpace(input.BelongsToUser) != ""},
+		RelevantID:       sql.NullString{String: input.RelevantID, Valid: strings.TrimSpace(input.RelevantID) != ""},
+		ChangedField:     sql.NullString{String: input.ChangedField, Valid: strings.TrimSpace(input.ChangedField) != ""},
+		CreatedAfter:     database.NullTimeFromTimePointer(filter.CreatedAfter),
+		CreatedBefore:    database.NullTimeFromTimePointer(filter.CreatedBefore),
+		Cursor:           afterID,
+		ResultLimit:      int32(limit),
+	})
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "exporting audit log entries")
+	}
+
+	x := []*audit.AuditLogEntry{}
+	for _, result := range results {
+		auditLogEntry := &audit.AuditLogEntry{
+			CreatedAt:        result.CreatedAt,
+			BelongsToAccount: database.StringPointerFromNullString(result.BelongsToAccount),
+			ID:               result.ID,
+			ResourceType:     result.ResourceType,
+			RelevantID:       result.RelevantID,
+			EventType:        string(result.EventType),
+			BelongsToUser:    database.StringFromNullString(result.BelongsToUser),
+		}
+
+		if err = json.Unmarshal(result.Changes, &auditLogEntry.Changes); err != nil {
+			return nil, observability.PrepareAndLogError(err, logger, span, "parsing audit log entry JSON data")
+		}
+
+		x = append(x, auditLogEntry)
+	}
+
+	return x, nil
+}
+
+// CreateAuditLogEntry creates an audit log entry in a database. Entries that belong to an account are
+// appended to that account's hash chain.
 func (q *repository) CreateAuditLogEntry(ctx context.Context, querier database.SQLQueryExecutor, input *audit.AuditLogEntryDatabaseCreationInput) (*audit.AuditLogEntry, error) {
 	ctx, span := q.tracer.StartSpan(ctx)
 	defer span.End()
@@ -361,7 +523,22 @@ func (q *repository) CreateAuditLogEntry(ctx context.Context, querier database.S
 		return nil, observability.PrepareAndLogError(err, logger, span, "serializing audit log change list")
 	}
 
-	if err = q.generatedQuerier.CreateAuditLogEntry(ctx, querier, &generated.CreateAuditLogEntryParams{
+	x := &audit.AuditLogEntry{
+		ID:               input.ID,
+		Changes:          input.Changes,
+		BelongsToAccount: input.BelongsToAccount,
+		CreatedAt:        q.CurrentTime(),
+		ResourceType:     input.ResourceType,
+		RelevantID:       input.RelevantID,
+		EventType:        input.EventType,
+		BelongsToUser:    input.BelongsToUser,
+	}
+
+	if input.BelongsToAccount != nil && *input.BelongsToAccount != "" {
+		if err = q.appendToAuditLogChain(ctx, querier, x, marshaledChanges); err != nil {
+			return nil, observability.PrepareAndLogError(err, logger, span, "appending audit log entry to chain")
+		}
+	} else if err = q.generatedQuerier.CreateAuditLogEntry(ctx, querier, &generated.CreateAuditLogEntryParams{
 		ID:               input.ID,
 		ResourceType:     input.ResourceType,
 		RelevantID:       input.RelevantID,
@@ -373,18 +550,112 @@ func (q *repository) CreateAuditLogEntry(ctx context.Context, querier database.S
 		return nil, observability.PrepareAndLogError(err, logger, span, "performing audit log creation query")
 	}
 
-	x := &audit.AuditLogEntry{
-		ID:               input.ID,
-		Changes:          input.Changes,
-		BelongsToAccount: input.BelongsToAccount,
-		CreatedAt:        q.CurrentTime(),
-		ResourceType:     input.ResourceType,
-		RelevantID:       input.RelevantID,
-		EventType:        input.EventType,
-		BelongsToUser:    input.BelongsToUser,
+	tracing.AttachToSpan(span, auditkeys.AuditLogEntryIDKey, x.ID)
+
+	return x, nil
+}
+
+// appendToAuditLogChain links an entry to the head of its account's chain and writes it. The chain is locked for
+// the rest of the transaction, so when the caller isn't already in one, this opens its own.
+func (q *repository) appendToAuditLogChain(ctx context.Context, querier database.SQLQueryExecutor, x *audit.AuditLogEntry, marshaledChanges []byte) error {
+	if db, ok := querier.(*sql.DB); ok {
+		tx, err := db.BeginTx(ctx, nil)
+		if err != nil {
+			return err
+		}
+
+		if err = q.appendToAuditLogChain(ctx, tx, x, marshaledChanges); err != nil {
+			q.RollbackTransaction(ctx, tx)
+			return err
+		}
+
+		return tx.Commit()
 	}
 
-	tracing.AttachToSpan(span, auditkeys.AuditLogEntryIDKey, x.ID)
+	accountID := *x.BelongsToAccount
+	if err := q.generatedQuerier.LockAuditLogChain(ctx, querier, accountID); err != nil {
+		return err
+	}
+
+	head, err := q.generatedQuerier.GetAuditLogChainHead(ctx, querier, database.NullStringFromString(accountID))
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		return err
+	}
+
+	x.Sequence = 1
+	if head != nil && head.Sequence.Valid {
+		x.Sequence = uint64(head.Sequence.Int64) + 1
+		x.PreviousHash = head.Hash
+	}
+
+	// postgres stores microseconds, so anything finer would change the hash on the way back out.
+	x.CreatedAt = x.CreatedAt.UTC().Truncate(time.Microsecond)
+	if x.ChangesHash, err = audit.ComputeChangesHash(x.Changes); err != nil {
+		return err
+	}
+	x.Hash = x.ComputeHash()
+
+	return q.generatedQuerier.CreateChainedAuditLogEntry(ctx, querier, &generated.CreateChainedAuditLogEntryParams{
+		ID:               x.ID,
+		ResourceType:     x.ResourceType,
+		RelevantID:       x.RelevantID,
+		EventType:        generated.AuditLogEventType(x.EventType),
+		Changes:          marshaledChanges,
+		BelongsToUser:    sql.NullString{String: x.BelongsToUser, Valid: strings.TrimSpace(x.BelongsToUser) != ""},
+		BelongsToAccount: database.NullStringFromString(accountID),
+		CreatedAt:        x.CreatedAt,
+		Sequence:         sql.NullInt64{Int64: int64(x.Sequence), Valid: true},
+		PreviousHash:     x.PreviousHash,
+		ChangesHash:      x.ChangesHash,
+		Hash:             x.Hash,
+	})
+}
+
+// GetAuditLogChainEntries fetches a page of an account's audit log chain, in order, after a given sequence number.
+func (q *repository) GetAuditLogChainEntries(ctx context.Context, accountID string, afterSequence uint64, limit uint8) ([]*audit.AuditLogEntry, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := q.logger.Clone()
+
+	if accountID == "" {
+		return nil, platformerrors.ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(identitykeys.AccountIDKey, accountID)
+	tracing.AttachToSpan(span, identitykeys.AccountIDKey, accountID)
+
+	results, err := q.generatedQuerier.GetAuditLogChainEntries(ctx, q.readDB, &generated.GetAuditLogChainEntriesParams{
+		BelongsToAccount: database.NullStringFromString(accountID),
+		AfterSequence:    sql.NullInt64{Int64: int64(afterSequence), Valid: true},
+		ResultLimit:      int32(limit),
+	})
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching audit log chain entries")
+	}
+
+	x := []*audit.AuditLogEntry{}
+	for _, result := range results {
+		auditLogEntry := &audit.AuditLogEntry{
+			CreatedAt:        result.CreatedAt,
+			BelongsToAccount: database.StringPointerFromNullString(result.BelongsToAccount),
+			RedactedAt:       database.TimePointerFromNullTime(result.RedactedAt),
+			ID:               result.ID,
+			ResourceType:     result.ResourceType,
+			RelevantID:       result.RelevantID,
+			EventType:        string(result.EventType),
+			BelongsToUser:    database.StringFromNullString(result.BelongsToUser),
+			PreviousHash:     result.PreviousHash,
+			ChangesHash:      result.ChangesHash,
+			Hash:             result.Hash,
+			Sequence:         uint64(result.Sequence.Int64),
+		}
+
+		if err = json.Unmarshal(result.Changes, &auditLogEntry.Changes); err != nil {
+			return nil, observability.PrepareAndLogError(err, logger, span, "parsing audit log entry JSON data")
+		}
+
+		x = append(x, auditLogEntry)
+	}
 
 	return x, nil
 }
@@ -2,6 +2,9 @@
 
 import (
 	"context"
+	"crypto/ed25519"
+	"crypto/rand"
+	"slices"
 	"testing"
 
 	types "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/audit"
@@ -41,6 +44,10 @@ func createAuditLogEntryForTest(t *testing.T, ctx context.Context, querier datab
 	require.NotNil(t, created)
 
 	exampleAuditLogEntry.CreatedAt = created.CreatedAt
+	exampleAuditLogEntry.Sequence = created.Sequence
+	exampleAuditLogEntry.PreviousHash = created.PreviousHash
+	exampleAuditLogEntry.ChangesHash = created.ChangesHash
+	exampleAuditLogEntry.Hash = created.Hash
 	assert.Equal(t, exampleAuditLogEntry, created)
 
 	auditLogEntry, err := dbc.GetAuditLogEntry(ctx, created.ID)
@@ -97,6 +104,106 @@ func TestQuerier_Integration_AuditLogEntries(t *testing.T) {
 	assert.NoError(t, err)
 	assert.NotEmpty(t, auditLogEntries.Data)
 	assert.Equal(t, len(createdAuditLogEntries), len(auditLogEntries.Data))
+
+	// search with no criteria matches everything
+	auditLogEntries, err = dbc.SearchAuditLogEntriesForAccount(ctx, account.ID, &types.AuditLogEntrySearchInput{}, nil)
+	assert.NoError(t, err)
+	assert.Equal(t, len(createdAuditLogEntries), len(auditLogEntries.Data))
+
+	// search by resource, event type, and changed field
+	searchedEntry := fakes.BuildFakeAuditLogEntry()
+	searchedEntry.ResourceType = "recipes"
+	searchedEntry.EventType = types.AuditLogEventTypeUpdated
+	searchedEntry.Changes = map[string]*types.ChangeLog{
+		"name": {OldValue: "before", NewValue: "after"},
+	}
+	searchedEntry = createAuditLogEntryForTest(t, ctx, dbc.writeDB, searchedEntry, user, account, dbc)
+
+	auditLogEntries, err = dbc.SearchAuditLogEntriesForAccount(ctx, account.ID, &types.AuditLogEntrySearchInput{
+		ResourceTypes: []string{searchedEntry.ResourceType},
+		EventTypes:    []string{types.AuditLogEventTypeUpdated},
+		BelongsToUser: user.ID,
+		RelevantID:    searchedEntry.RelevantID,
+		ChangedField:  "name",
+	}, nil)
+	assert.NoError(t, err)
+	require.Len(t, auditLogEntries.Data, 1)
+	assert.Equal(t, searchedEntry.ID, auditLogEntries.Data[0].ID)
+
+	auditLogEntries, err = dbc.SearchAuditLogEntriesForAccount(ctx, account.ID, &types.AuditLogEntrySearchInput{
+		ResourceTypes: []string{searchedEntry.ResourceType},
+		ChangedField:  "description",
+	}, nil)
+	assert.NoError(t, err)
+	assert.Empty(t, auditLogEntries.Data)
+
+	// export walks the log one page at a time, in ID order
+	var exportedIDs []string
+	for afterID := ""; ; {
+		page, exportErr := dbc.ExportAuditLogEntriesForAccount(ctx, account.ID, &types.AuditLogEntrySearchInput{}, nil, afterID, 1)
+		require.NoError(t, exportErr)
+		if len(page) == 0 {
+			break
+		}
+		require.Len(t, page, 1)
+
+		afterID = page[0].ID
+		exportedIDs = append(exportedIDs, afterID)
+	}
+	assert.Len(t, exportedIDs, len(createdAuditLogEntries)+1)
+	assert.True(t, slices.IsSorted(exportedIDs))
+
+	// every entry was appended to the account's chain
+	result, err := types.VerifyChain(ctx, dbc, account.ID, nil)
+	require.NoError(t, err)
+	assert.True(t, result.Intact())
+	assert.Equal(t, uint64(len(createdAuditLogEntries)+1), result.EntriesChecked)
+
+	// checkpoint the chain
+	_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	signer := types.NewCheckpointSigner(privateKey)
+
+	heads, err := dbc.GetUncheckpointedAuditLogChainHeads(ctx)
+	require.NoError(t, err)
+	require.Len(t, heads, 1)
+	assert.Equal(t, account.ID, heads[0].BelongsToAccount)
+	assert.Equal(t, searchedEntry.Hash, heads[0].Hash)
+
+	checkpoint, err := dbc.CreateAuditLogCheckpoint(ctx, signer.Sign(heads[0]))
+	require.NoError(t, err)
+
+	heads, err = dbc.GetUncheckpointedAuditLogChainHeads(ctx)
+	require.NoError(t, err)
+	assert.Empty(t, heads)
+
+	publicKey, _ := privateKey.Public().(ed25519.PublicKey)
+	result, err = types.VerifyChain(ctx, dbc, account.ID, publicKey)
+	require.NoError(t, err)
+	assert.True(t, result.Intact())
+	assert.Equal(t, uint64(1), result.CheckpointsChecked)
+
+	// tampering with an entry breaks the chain
+	_, err = dbc.writeDB.ExecContext(ctx, `UPDATE audit_log_entries SET relevant_id = 'tampered' WHERE id = $1`, createdAuditLogEntries[1].ID)
+	require.NoError(t, err)
+
+	result, err = types.VerifyChain(ctx, dbc, account.ID, publicKey)
+	require.NoError(t, err)
+	require.False(t, result.Intact())
+	assert.Equal(t, createdAuditLogEntries[1].ID, result.FirstBrokenLink.EntryID)
+	assert.Equal(t, types.ChainBreakReasonHashMismatch, result.FirstBrokenLink.Reason)
+
+	// deleting the checkpointed head is caught by its checkpoint
+	_, err = dbc.writeDB.ExecContext(ctx, `UPDATE audit_log_entries SET relevant_id = $1 WHERE id = $2`, createdAuditLogEntries[1].RelevantID, createdAuditLogEntries[1].ID)
+	require.NoError(t, err)
+	_, err = dbc.writeDB.ExecContext(ctx, `DELETE FROM audit_log_entries WHERE id = $1`, searchedEntry.ID)
+	require.NoError(t, err)
+
+	result, err = types.VerifyChain(ctx, dbc, account.ID, publicKey)
+	require.NoError(t, err)
+	require.False(t, result.Intact())
+	assert.Equal(t, checkpoint.ID, result.FirstBrokenLink.CheckpointID)
+	assert.Equal(t, types.ChainBreakReasonCheckpointEntryMissing, result.FirstBrokenLink.Reason)
 }
 
 func TestQuerier_GetAuditLogEntry(T *testing.T) {
@@ -114,6 +221,58 @@ func TestQuerier_GetAuditLogEntry(T *testing.T) {
 	})
 }
 
+func TestQuerier_SearchAuditLogEntriesForAccount(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid account ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		actual, err := c.SearchAuditLogEntriesForAccount(ctx, "", &types.AuditLogEntrySearchInput{}, nil)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+
+	T.Run("with nil input", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		actual, err := c.SearchAuditLogEntriesForAccount(ctx, fakes.BuildFakeID(), nil, nil)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
+
+func TestQuerier_ExportAuditLogEntriesForAccount(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid account ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		actual, err := c.ExportAuditLogEntriesForAccount(ctx, "", &types.AuditLogEntrySearchInput{}, nil, "", 50)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+
+	T.Run("with nil input", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		actual, err := c.ExportAuditLogEntriesForAccount(ctx, fakes.BuildFakeID(), nil, nil, "", 50)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
+
 func TestQuerier_CreateAuditLogEntry(T *testing.T) {
 	T.Parallel()
 
@@ -128,3 +287,48 @@ func TestQuerier_CreateAuditLogEntry(T *testing.T) {
 		assert.Nil(t, actual)
 	})
 }
+
+func TestQuerier_GetAuditLogChainEntries(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid account ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		actual, err := c.GetAuditLogChainEntries(ctx, "", 0, 50)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
+
+func TestQuerier_GetAuditLogCheckpointsForAccount(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid account ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		actual, err := c.GetAuditLogCheckpointsForAccount(ctx, "")
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
+
+func TestQuerier_CreateAuditLogCheckpoint(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid input", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		actual, err := c.CreateAuditLogCheckpoint(ctx, nil)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
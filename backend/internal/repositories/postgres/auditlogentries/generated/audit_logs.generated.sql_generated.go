@@ -57,6 +57,264 @@ func (q *Queries) CreateAuditLogEntry(ctx context.Context, db DBTX, arg *CreateA
 	return err
 }
 
+const createChainedAuditLogEntry = `-- name: CreateChainedAuditLogEntry :exec
+INSERT INTO audit_log_entries (
+	id,
+	resource_type,
+	relevant_id,
+	event_type,
+	changes,
+	belongs_to_user,
+	belongs_to_account,
+	created_at,
+	sequence,
+	previous_hash,
+	changes_hash,
+	hash
+) VALUES (
+	$1,
+	$2,
+	$3,
+	$4,
+	$5,
+	$6,
+	$7,
+	$8,
+	$9,
+	$10,
+	$11,
+	$12
+)
+`
+
+type CreateChainedAuditLogEntryParams struct {
+	ID               string
+	ResourceType     string
+	RelevantID       string
+	EventType        AuditLogEventType
+	Changes          json.RawMessage
+	BelongsToUser    sql.NullString
+	BelongsToAccount sql.NullString
+	CreatedAt        time.Time
+	Sequence         sql.NullInt64
+	PreviousHash     string
+	ChangesHash      string
+	Hash             string
+}
+
+func (q *Queries) CreateChainedAuditLogEntry(ctx context.Context, db DBTX, arg *CreateChainedAuditLogEntryParams) error {
+	_, err := db.ExecContext(ctx, createChainedAuditLogEntry,
+		arg.ID,
+		arg.ResourceType,
+		arg.RelevantID,
+		arg.EventType,
+		arg.Changes,
+		arg.BelongsToUser,
+		arg.BelongsToAccount,
+		arg.CreatedAt,
+		arg.Sequence,
+		arg.PreviousHash,
+		arg.ChangesHash,
+		arg.Hash,
+	)
+	return err
+}
+
+const exportAuditLogEntriesForAccount = `-- name: ExportAuditLogEntriesForAccount :many
+SELECT
+	audit_log_entries.id,
+	audit_log_entries.resource_type,
+	audit_log_entries.relevant_id,
+	audit_log_entries.event_type,
+	audit_log_entries.changes,
+	audit_log_entries.belongs_to_user,
+	audit_log_entries.belongs_to_account,
+	audit_log_entries.created_at
+FROM audit_log_entries
+WHERE audit_log_entries.belongs_to_account = $1
+	AND (cardinality($2::text[]) = 0 OR audit_log_entries.resource_type = ANY($2::text[]))
+	AND (cardinality($3::text[]) = 0 OR audit_log_entries.event_type::text = ANY($3::text[]))
+	AND ($4::text IS NULL OR audit_log_entries.belongs_to_user = $4)
+	AND ($5::text IS NULL OR audit_log_entries.relevant_id = $5)
+	AND ($6::text IS NULL OR audit_log_entries.changes ? $6)
+	AND audit_log_entries.created_at > COALESCE($7, (SELECT NOW() - '999 years'::INTERVAL))
+	AND audit_log_entries.created_at < COALESCE($8, (SELECT NOW() + '999 years'::INTERVAL))
+	AND audit_log_entries.id > $9
+ORDER BY audit_log_entries.id ASC
+LIMIT $10
+`
+
+type ExportAuditLogEntriesForAccountParams struct {
+	BelongsToAccount sql.NullString
+	ResourceTypes    []string
+	EventTypes       []string
+	BelongsToUser    sql.NullString
+	RelevantID       sql.NullString
+	ChangedField     sql.NullString
+	CreatedAfter     sql.NullTime
+	CreatedBefore    sql.NullTime
+	Cursor           string
+	ResultLimit      int32
+}
+
+type ExportAuditLogEntriesForAccountRow struct {
+	ID               string
+	ResourceType     string
+	RelevantID       string
+	EventType        AuditLogEventType
+	Changes          json.RawMessage
+	BelongsToUser    sql.NullString
+	BelongsToAccount sql.NullString
+	CreatedAt        time.Time
+}
+
+func (q *Queries) ExportAuditLogEntriesForAccount(ctx context.Context, db DBTX, arg *ExportAuditLogEntriesForAccountParams) ([]*ExportAuditLogEntriesForAccountRow, error) {
+	rows, err := db.QueryContext(ctx, exportAuditLogEntriesForAccount,
+		arg.BelongsToAccount,
+		pq.Array(arg.ResourceTypes),
+		pq.Array(arg.EventTypes),
+		arg.BelongsToUser,
+		arg.RelevantID,
+		arg.ChangedField,
+		arg.CreatedAfter,
+		arg.CreatedBefore,
+		arg.Cursor,
+		arg.ResultLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*ExportAuditLogEntriesForAccountRow{}
+	for rows.Next() {
+		var i ExportAuditLogEntriesForAccountRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ResourceType,
+			&i.RelevantID,
+			&i.EventType,
+			&i.Changes,
+			&i.BelongsToUser,
+			&i.BelongsToAccount,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAuditLogChainEntries = `-- name: GetAuditLogChainEntries :many
+SELECT
+	audit_log_entries.id,
+	audit_log_entries.resource_type,
+	audit_log_entries.relevant_id,
+	audit_log_entries.event_type,
+	audit_log_entries.changes,
+	audit_log_entries.belongs_to_user,
+	audit_log_entries.belongs_to_account,
+	audit_log_entries.created_at,
+	audit_log_entries.sequence,
+	audit_log_entries.previous_hash,
+	audit_log_entries.changes_hash,
+	audit_log_entries.hash,
+	audit_log_entries.redacted_at
+FROM audit_log_entries
+WHERE audit_log_entries.belongs_to_account = $1
+	AND audit_log_entries.sequence > $2
+ORDER BY audit_log_entries.sequence ASC
+LIMIT $3
+`
+
+type GetAuditLogChainEntriesParams struct {
+	BelongsToAccount sql.NullString
+	AfterSequence    sql.NullInt64
+	ResultLimit      int32
+}
+
+type GetAuditLogChainEntriesRow struct {
+	ID               string
+	ResourceType     string
+	RelevantID       string
+	EventType        AuditLogEventType
+	Changes          json.RawMessage
+	BelongsToUser    sql.NullString
+	BelongsToAccount sql.NullString
+	CreatedAt        time.Time
+	Sequence         sql.NullInt64
+	PreviousHash     string
+	ChangesHash      string
+	Hash             string
+	RedactedAt       sql.NullTime
+}
+
+func (q *Queries) GetAuditLogChainEntries(ctx context.Context, db DBTX, arg *GetAuditLogChainEntriesParams) ([]*GetAuditLogChainEntriesRow, error) {
+	rows, err := db.QueryContext(ctx, getAuditLogChainEntries, arg.BelongsToAccount, arg.AfterSequence, arg.ResultLimit)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*GetAuditLogChainEntriesRow{}
+	for rows.Next() {
+		var i GetAuditLogChainEntriesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ResourceType,
+			&i.RelevantID,
+			&i.EventType,
+			&i.Changes,
+			&i.BelongsToUser,
+			&i.BelongsToAccount,
+			&i.CreatedAt,
+			&i.Sequence,
+			&i.PreviousHash,
+			&i.ChangesHash,
+			&i.Hash,
+			&i.RedactedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getAuditLogChainHead = `-- name: GetAuditLogChainHead :one
+SELECT
+	audit_log_entries.sequence,
+	audit_log_entries.hash
+FROM audit_log_entries
+WHERE audit_log_entries.belongs_to_account = $1
+	AND audit_log_entries.sequence IS NOT NULL
+ORDER BY audit_log_entries.sequence DESC
+LIMIT 1
+`
+
+type GetAuditLogChainHeadRow struct {
+	Sequence sql.NullInt64
+	Hash     string
+}
+
+func (q *Queries) GetAuditLogChainHead(ctx context.Context, db DBTX, belongsToAccount sql.NullString) (*GetAuditLogChainHeadRow, error) {
+	row := db.QueryRowContext(ctx, getAuditLogChainHead, belongsToAccount)
+	var i GetAuditLogChainHeadRow
+	err := row.Scan(&i.Sequence, &i.Hash)
+	return &i, err
+}
+
 const getAuditLogEntriesForAccount = `-- name: GetAuditLogEntriesForAccount :many
 SELECT
 	audit_log_entries.id,
@@ -452,7 +710,12 @@ func (q *Queries) GetAuditLogEntriesForUserAndResourceType(ctx context.Context,
 	audit_log_entries.changes,
 	audit_log_entries.belongs_to_user,
 	audit_log_entries.belongs_to_account,
-	audit_log_entries.created_at
+	audit_log_entries.created_at,
+	audit_log_entries.sequence,
+	audit_log_entries.previous_hash,
+	audit_log_entries.changes_hash,
+	audit_log_entries.hash,
+	audit_log_entries.redacted_at
 FROM audit_log_entries
 WHERE audit_log_entries.id = $1
 `
@@ -466,6 +729,11 @@ type GetAuditLogEntryRow struct {
 	BelongsToUser    sql.NullString
 	BelongsToAccount sql.NullString
 	CreatedAt        time.Time
+	Sequence         sql.NullInt64
+	PreviousHash     string
+	ChangesHash      string
+	Hash             string
+	RedactedAt       sql.NullTime
 }
 
 func (q *Queries) GetAuditLogEntry(ctx context.Context, db DBTX, id string) (*GetAuditLogEntryRow, error) {
@@ -480,6 +748,134 @@ func (q *Queries) GetAuditLogEntry(ctx context.Context, db DBTX, id string) (*Ge
 		&i.BelongsToUser,
 		&i.BelongsToAccount,
 		&i.CreatedAt,
+		&i.Sequence,
+		&i.PreviousHash,
+		&i.ChangesHash,
+		&i.Hash,
+		&i.RedactedAt,
 	)
 	return &i, err
 }
+
+const lockAuditLogChain = `-- name: LockAuditLogChain :exec
+SELECT pg_advisory_xact_lock(hashtext('audit_log_entries'), hashtext($1::text))
+`
+
+func (q *Queries) LockAuditLogChain(ctx context.Context, db DBTX, belongsToAccount string) error {
+	_, err := db.ExecContext(ctx, lockAuditLogChain, belongsToAccount)
+	return err
+}
+
+const searchAuditLogEntriesForAccount = `-- name: SearchAuditLogEntriesForAccount :many
+SELECT
+	audit_log_entries.id,
+	audit_log_entries.resource_type,
+	audit_log_entries.relevant_id,
+	audit_log_entries.event_type,
+	audit_log_entries.changes,
+	audit_log_entries.belongs_to_user,
+	audit_log_entries.belongs_to_account,
+	audit_log_entries.created_at,
+	(
+		SELECT COUNT(audit_log_entries.id)
+		FROM audit_log_entries
+		WHERE
+			audit_log_entries.created_at > COALESCE($1, (SELECT NOW() - '999 years'::INTERVAL))
+			AND audit_log_entries.created_at < COALESCE($2, (SELECT NOW() + '999 years'::INTERVAL))
+			AND audit_log_entries.belongs_to_account = $3
+			AND (cardinality($4::text[]) = 0 OR audit_log_entries.resource_type = ANY($4::text[]))
+			AND (cardinality($5::text[]) = 0 OR audit_log_entries.event_type::text = ANY($5::text[]))
+			AND ($6::text IS NULL OR audit_log_entries.belongs_to_user = $6)
+			AND ($7::text IS NULL OR audit_log_entries.relevant_id = $7)
+			AND ($8::text IS NULL OR audit_log_entries.changes ? $8)
+	) AS filtered_count,
+	(
+		SELECT COUNT(audit_log_entries.id)
+		FROM audit_log_entries
+		WHERE
+			audit_log_entries.belongs_to_account = $3
+	) AS total_count
+FROM audit_log_entries
+WHERE audit_log_entries.created_at > COALESCE($1, (SELECT NOW() - '999 years'::INTERVAL))
+	AND audit_log_entries.created_at < COALESCE($2, (SELECT NOW() + '999 years'::INTERVAL))
+	AND audit_log_entries.belongs_to_account = $3
+	AND (cardinality($4::text[]) = 0 OR audit_log_entries.resource_type = ANY($4::text[]))
+	AND (cardinality($5::text[]) = 0 OR audit_log_entries.event_type::text = ANY($5::text[]))
+	AND ($6::text IS NULL OR audit_log_entries.belongs_to_user = $6)
+	AND ($7::text IS NULL OR audit_log_entries.relevant_id = $7)
+	AND ($8::text IS NULL OR audit_log_entries.changes ? $8)
+	AND audit_log_entries.id > COALESCE($9, '')
+ORDER BY audit_log_entries.id ASC
+LIMIT COALESCE($10, 50)
+`
+
+type SearchAuditLogEntriesForAccountParams struct {
+	CreatedAfter     sql.NullTime
+	CreatedBefore    sql.NullTime
+	BelongsToAccount sql.NullString
+	ResourceTypes    []string
+	EventTypes       []string
+	BelongsToUser    sql.NullString
+	RelevantID       sql.NullString
+	ChangedField     sql.NullString
+	Cursor           sql.NullString
+	ResultLimit      interface{}
+}
+
+type SearchAuditLogEntriesForAccountRow struct {
+	ID               string
+	ResourceType     string
+	RelevantID       string
+	EventType        AuditLogEventType
+	Changes          json.RawMessage
+	BelongsToUser    sql.NullString
+	BelongsToAccount sql.NullString
+	CreatedAt        time.Time
+	FilteredCount    int64
+	TotalCount       int64
+}
+
+func (q *Queries) SearchAuditLogEntriesForAccount(ctx context.Context, db DBTX, arg *SearchAuditLogEntriesForAccountParams) ([]*SearchAuditLogEntriesForAccountRow, error) {
+	rows, err := db.QueryContext(ctx, searchAuditLogEntriesForAccount,
+		arg.CreatedAfter,
+		arg.CreatedBefore,
+		arg.BelongsToAccount,
+		pq.Array(arg.ResourceTypes),
+		pq.Array(arg.EventTypes),
+		arg.BelongsToUser,
+		arg.RelevantID,
+		arg.ChangedField,
+		arg.Cursor,
+		arg.ResultLimit,
+	)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*SearchAuditLogEntriesForAccountRow{}
+	for rows.Next() {
+		var i SearchAuditLogEntriesForAccountRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.ResourceType,
+			&i.RelevantID,
+			&i.EventType,
+			&i.Changes,
+			&i.BelongsToUser,
+			&i.BelongsToAccount,
+			&i.CreatedAt,
+			&i.FilteredCount,
+			&i.TotalCount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
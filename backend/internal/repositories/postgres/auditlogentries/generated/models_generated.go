@@ -7,6 +7,7 @@
 import (
 	"database/sql/driver"
 	"fmt"
+	"time"
 )
 
 type AuditLogEventType string
@@ -72,3 +73,13 @@ func AllAuditLogEventTypeValues() []AuditLogEventType {
 		AuditLogEventTypeArchived,
 	}
 }
+
+type AuditLogCheckpoints struct {
+	ID               string
+	BelongsToAccount string
+	Sequence         int64
+	EntryHash        string
+	Signature        string
+	KeyID            string
+	CreatedAt        time.Time
+}
@@ -6,15 +6,25 @@
 
 import (
 	"context"
+	"database/sql"
 )
 
 type Querier interface {
 	CreateAuditLogEntry(ctx context.Context, db DBTX, arg *CreateAuditLogEntryParams) error
+	CreateAuditLogCheckpoint(ctx context.Context, db DBTX, arg *CreateAuditLogCheckpointParams) error
+	CreateChainedAuditLogEntry(ctx context.Context, db DBTX, arg *CreateChainedAuditLogEntryParams) error
+	ExportAuditLogEntriesForAccount(ctx context.Context, db DBTX, arg *ExportAuditLogEntriesForAccountParams) ([]*ExportAuditLogEntriesForAccountRow, error)
+	GetAuditLogChainEntries(ctx context.Context, db DBTX, arg *GetAuditLogChainEntriesParams) ([]*GetAuditLogChainEntriesRow, error)
+	GetAuditLogChainHead(ctx context.Context, db DBTX, belongsToAccount sql.NullString) (*GetAuditLogChainHeadRow, error)
+	GetAuditLogCheckpointsForAccount(ctx context.Context, db DBTX, belongsToAccount string) ([]*AuditLogCheckpoints, error)
 	GetAuditLogEntriesForAccount(ctx context.Context, db DBTX, arg *GetAuditLogEntriesForAccountParams) ([]*GetAuditLogEntriesForAccountRow, error)
 	GetAuditLogEntriesForAccountAndResourceType(ctx context.Context, db DBTX, arg *GetAuditLogEntriesForAccountAndResourceTypeParams) ([]*GetAuditLogEntriesForAccountAndResourceTypeRow, error)
 	GetAuditLogEntriesForUser(ctx context.Context, db DBTX, arg *GetAuditLogEntriesForUserParams) ([]*GetAuditLogEntriesForUserRow, error)
 	GetAuditLogEntriesForUserAndResourceType(ctx context.Context, db DBTX, arg *GetAuditLogEntriesForUserAndResourceTypeParams) ([]*GetAuditLogEntriesForUserAndResourceTypeRow, error)
 	GetAuditLogEntry(ctx context.Context, db DBTX, id string) (*GetAuditLogEntryRow, error)
+	GetUncheckpointedAuditLogChainHeads(ctx context.Context, db DBTX) ([]*GetUncheckpointedAuditLogChainHeadsRow, error)
+	LockAuditLogChain(ctx context.Context, db DBTX, belongsToAccount string) error
+	SearchAuditLogEntriesForAccount(ctx context.Context, db DBTX, arg *SearchAuditLogEntriesForAccountParams) ([]*SearchAuditLogEntriesForAccountRow, error)
 }
 
 var _ Querier = (*Queries)(nil)
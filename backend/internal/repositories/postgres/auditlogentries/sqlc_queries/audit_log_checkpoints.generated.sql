@@ -0,0 +1,49 @@
+-- name: CreateAuditLogCheckpoint :exec
+INSERT INTO audit_log_checkpoints (
+	id,
+	belongs_to_account,
+	sequence,
+	entry_hash,
+	signature,
+	key_id
+) VALUES (
+	sqlc.arg(id),
+	sqlc.arg(belongs_to_account),
+	sqlc.arg(sequence),
+	sqlc.arg(entry_hash),
+	sqlc.arg(signature),
+	sqlc.arg(key_id)
+);
+
+-- name: GetAuditLogCheckpointsForAccount :many
+SELECT
+	audit_log_checkpoints.id,
+	audit_log_checkpoints.belongs_to_account,
+	audit_log_checkpoints.sequence,
+	audit_log_checkpoints.entry_hash,
+	audit_log_checkpoints.signature,
+	audit_log_checkpoints.key_id,
+	audit_log_checkpoints.created_at
+FROM audit_log_checkpoints
+WHERE audit_log_checkpoints.belongs_to_account = sqlc.arg(belongs_to_account)
+ORDER BY audit_log_checkpoints.sequence ASC;
+
+-- name: GetUncheckpointedAuditLogChainHeads :many
+SELECT
+	heads.belongs_to_account,
+	heads.sequence,
+	heads.hash
+FROM (
+	SELECT DISTINCT ON (audit_log_entries.belongs_to_account)
+		audit_log_entries.belongs_to_account,
+		audit_log_entries.sequence,
+		audit_log_entries.hash
+	FROM audit_log_entries
+	WHERE audit_log_entries.sequence IS NOT NULL
+	ORDER BY audit_log_entries.belongs_to_account, audit_log_entries.sequence DESC
+) AS heads
+WHERE heads.sequence > COALESCE((
+	SELECT MAX(audit_log_checkpoints.sequence)
+	FROM audit_log_checkpoints
+	WHERE audit_log_checkpoints.belongs_to_account = heads.belongs_to_account
+), 0);
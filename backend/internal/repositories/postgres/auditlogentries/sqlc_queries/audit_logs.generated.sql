@@ -17,6 +17,69 @@ INSERT INTO audit_log_entries (
 	sqlc.narg(belongs_to_account)
 );
 
+-- name: CreateChainedAuditLogEntry :exec
+INSERT INTO audit_log_entries (
+	id,
+	resource_type,
+	relevant_id,
+	event_type,
+	changes,
+	belongs_to_user,
+	belongs_to_account,
+	created_at,
+	sequence,
+	previous_hash,
+	changes_hash,
+	hash
+) VALUES (
+	sqlc.arg(id),
+	sqlc.arg(resource_type),
+	sqlc.arg(relevant_id),
+	sqlc.arg(event_type),
+	sqlc.arg(changes),
+	sqlc.narg(belongs_to_user),
+	sqlc.arg(belongs_to_account),
+	sqlc.arg(created_at),
+	sqlc.arg(sequence),
+	sqlc.arg(previous_hash),
+	sqlc.arg(changes_hash),
+	sqlc.arg(hash)
+);
+
+-- name: LockAuditLogChain :exec
+SELECT pg_advisory_xact_lock(hashtext('audit_log_entries'), hashtext(sqlc.arg(belongs_to_account)::text));
+
+-- name: GetAuditLogChainHead :one
+SELECT
+	audit_log_entries.sequence,
+	audit_log_entries.hash
+FROM audit_log_entries
+WHERE audit_log_entries.belongs_to_account = sqlc.arg(belongs_to_account)
+	AND audit_log_entries.sequence IS NOT NULL
+ORDER BY audit_log_entries.sequence DESC
+LIMIT 1;
+
+-- name: GetAuditLogChainEntries :many
+SELECT
+	audit_log_entries.id,
+	audit_log_entries.resource_type,
+	audit_log_entries.relevant_id,
+	audit_log_entries.event_type,
+	audit_log_entries.changes,
+	audit_log_entries.belongs_to_user,
+	audit_log_entries.belongs_to_account,
+	audit_log_entries.created_at,
+	audit_log_entries.sequence,
+	audit_log_entries.previous_hash,
+	audit_log_entries.changes_hash,
+	audit_log_entries.hash,
+	audit_log_entries.redacted_at
+FROM audit_log_entries
+WHERE audit_log_entries.belongs_to_account = sqlc.arg(belongs_to_account)
+	AND audit_log_entries.sequence > sqlc.arg(after_sequence)
+ORDER BY audit_log_entries.sequence ASC
+LIMIT sqlc.arg(result_limit);
+
 -- name: GetAuditLogEntry :one
 SELECT
 	audit_log_entries.id,
@@ -26,7 +89,12 @@ SELECT
 	audit_log_entries.changes,
 	audit_log_entries.belongs_to_user,
 	audit_log_entries.belongs_to_account,
-	audit_log_entries.created_at
+	audit_log_entries.created_at,
+	audit_log_entries.sequence,
+	audit_log_entries.previous_hash,
+	audit_log_entries.changes_hash,
+	audit_log_entries.hash,
+	audit_log_entries.redacted_at
 FROM audit_log_entries
 WHERE audit_log_entries.id = sqlc.arg(id);
 
@@ -163,3 +231,68 @@ WHERE audit_log_entries.created_at > COALESCE(sqlc.narg(created_after), (SELECT
 	AND audit_log_entries.id > COALESCE(sqlc.narg(cursor), '')
 ORDER BY audit_log_entries.id ASC
 LIMIT COALESCE(sqlc.narg(result_limit), 50);
+
+-- name: SearchAuditLogEntriesForAccount :many
+SELECT
+	audit_log_entries.id,
+	audit_log_entries.resource_type,
+	audit_log_entries.relevant_id,
+	audit_log_entries.event_type,
+	audit_log_entries.changes,
+	audit_log_entries.belongs_to_user,
+	audit_log_entries.belongs_to_account,
+	audit_log_entries.created_at,
+	(
+		SELECT COUNT(audit_log_entries.id)
+		FROM audit_log_entries
+		WHERE
+			audit_log_entries.created_at > COALESCE(sqlc.narg(created_after), (SELECT NOW() - '999 years'::INTERVAL))
+			AND audit_log_entries.created_at < COALESCE(sqlc.narg(created_before), (SELECT NOW() + '999 years'::INTERVAL))
+			AND audit_log_entries.belongs_to_account = sqlc.arg(belongs_to_account)
+			AND (cardinality(sqlc.arg(resource_types)::text[]) = 0 OR audit_log_entries.resource_type = ANY(sqlc.arg(resource_types)::text[]))
+			AND (cardinality(sqlc.arg(event_types)::text[]) = 0 OR audit_log_entries.event_type::text = ANY(sqlc.arg(event_types)::text[]))
+			AND (sqlc.narg(belongs_to_user)::text IS NULL OR audit_log_entries.belongs_to_user = sqlc.narg(belongs_to_user))
+			AND (sqlc.narg(relevant_id)::text IS NULL OR audit_log_entries.relevant_id = sqlc.narg(relevant_id))
+			AND (sqlc.narg(changed_field)::text IS NULL OR audit_log_entries.changes ? sqlc.narg(changed_field))
+	) AS filtered_count,
+	(
+		SELECT COUNT(audit_log_entries.id)
+		FROM audit_log_entries
+		WHERE
+			audit_log_entries.belongs_to_account = sqlc.arg(belongs_to_account)
+	) AS total_count
+FROM audit_log_entries
+WHERE audit_log_entries.created_at > COALESCE(sqlc.narg(created_after), (SELECT NOW() - '999 years'::INTERVAL))
+	AND audit_log_entries.created_at < COALESCE(sqlc.narg(created_before), (SELECT NOW() + '999 years'::INTERVAL))
+	AND audit_log_entries.belongs_to_account = sqlc.arg(belongs_to_account)
+	AND (cardinality(sqlc.arg(resource_types)::text[]) = 0 OR audit_log_entries.resource_type = ANY(sqlc.arg(resource_types)::text[]))
+	AND (cardinality(sqlc.arg(event_types)::text[]) = 0 OR audit_log_entries.event_type::text = ANY(sqlc.arg(event_types)::text[]))
+	AND (sqlc.narg(belongs_to_user)::text IS NULL OR audit_log_entries.belongs_to_user = sqlc.narg(belongs_to_user))
+	AND (sqlc.narg(relevant_id)::text IS NULL OR audit_log_entries.relevant_id = sqlc.narg(relevant_id))
+	AND (sqlc.narg(changed_field)::text IS NULL OR audit_log_entries.changes ? sqlc.narg(changed_field))
+	AND audit_log_entries.id > COALESCE(sqlc.narg(cursor), '')
+ORDER BY audit_log_entries.id ASC
+LIMIT COALESCE(sqlc.narg(result_limit), 50);
+
+-- name: ExportAuditLogEntriesForAccount :many
+SELECT
+	audit_log_entries.id,
+	audit_log_entries.resource_type,
+	audit_log_entries.relevant_id,
+	audit_log_entries.event_type,
+	audit_log_entries.changes,
+	audit_log_entries.belongs_to_user,
+	audit_log_entries.belongs_to_account,
+	audit_log_entries.created_at
+FROM audit_log_entries
+WHERE audit_log_entries.belongs_to_account = sqlc.arg(belongs_to_account)
+	AND (cardinality(sqlc.arg(resource_types)::text[]) = 0 OR audit_log_entries.resource_type = ANY(sqlc.arg(resource_types)::text[]))
+	AND (cardinality(sqlc.arg(event_types)::text[]) = 0 OR audit_log_entries.event_type::text = ANY(sqlc.arg(event_types)::text[]))
+	AND (sqlc.narg(belongs_to_user)::text IS NULL OR audit_log_entries.belongs_to_user = sqlc.narg(belongs_to_user))
+	AND (sqlc.narg(relevant_id)::text IS NULL OR audit_log_entries.relevant_id = sqlc.narg(relevant_id))
+	AND (sqlc.narg(changed_field)::text IS NULL OR audit_log_entries.changes ? sqlc.narg(changed_field))
+	AND audit_log_entries.created_at > COALESCE(sqlc.narg(created_after), (SELECT NOW() - '999 years'::INTERVAL))
+	AND audit_log_entries.created_at < COALESCE(sqlc.narg(created_before), (SELECT NOW() + '999 years'::INTERVAL))
+	AND audit_log_entries.id > sqlc.arg(cursor)
+ORDER BY audit_log_entries.id ASC
+LIMIT sqlc.arg(result_limit);
@@ -0,0 +1,457 @@
+package identity
+
+import (
+	"context"
+	"database/sql"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/authorization"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/audit"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity"
+	identitykeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity/keys"
+	generated "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/repositories/postgres/identity/generated"
+
+	"github.com/primandproper/platform/database"
+	platformerrors "github.com/primandproper/platform/errors"
+	"github.com/primandproper/platform/identifiers"
+	"github.com/primandproper/platform/observability"
+	"github.com/primandproper/platform/observability/tracing"
+)
+
+const (
+	resourceTypeAccountRoles = "account_roles"
+
+	accountRoleScope = "account"
+)
+
+var (
+	_ identity.AccountRoleDataManager = (*repository)(nil)
+)
+
+// CreateAccountRole creates a custom role for an account, along with its permissions and parent roles.
+func (r *repository) CreateAccountRole(ctx context.Context, input *identity.AccountRoleDatabaseCreationInput) (*identity.AccountRole, error) {
+	ctx, span := r.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if input == nil {
+		return nil, platformerrors.ErrNilInputProvided
+	}
+
+	logger := r.logger.WithValues(map[string]any{
+		identitykeys.AccountIDKey:     input.BelongsToAccount,
+		identitykeys.AccountRoleIDKey: input.ID,
+	})
+	tracing.AttachToSpan(span, identitykeys.AccountIDKey, input.BelongsToAccount)
+	tracing.AttachToSpan(span, identitykeys.AccountRoleIDKey, input.ID)
+
+	tx, err := r.writeDB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "beginning transaction")
+	}
+
+	if err = r.generatedQuerier.CreateUserRole(ctx, tx, &generated.CreateUserRoleParams{
+		ID:               input.ID,
+		Name:             input.Name,
+		Description:      input.Description,
+		Scope:            accountRoleScope,
+		BelongsToAccount: database.NullStringFromString(input.BelongsToAccount),
+	}); err != nil {
+		r.RollbackTransaction(ctx, tx)
+		return nil, observability.PrepareAndLogError(err, logger, span, "creating account role")
+	}
+
+	if err = r.setAccountRolePermissions(ctx, tx, input.ID, input.Permissions); err != nil {
+		r.RollbackTransaction(ctx, tx)
+		return nil, observability.PrepareAndLogError(err, logger, span, "setting account role permissions")
+	}
+
+	if err = r.setAccountRoleParents(ctx, tx, input.BelongsToAccount, input.ID, input.ParentRoleIDs); err != nil {
+		r.RollbackTransaction(ctx, tx)
+		return nil, observability.PrepareAndLogError(err, logger, span, "setting account role parents")
+	}
+
+	if _, err = r.auditLogEntryRepo.CreateAuditLogEntry(ctx, tx, &audit.AuditLogEntryDatabaseCreationInput{
+		BelongsToAccount: &input.BelongsToAccount,
+		ID:               identifiers.New(),
+		ResourceType:     resourceTypeAccountRoles,
+		RelevantID:       input.ID,
+		EventType:        audit.AuditLogEventTypeCreated,
+	}); err != nil {
+		r.RollbackTransaction(ctx, tx)
+		return nil, observability.PrepareError(err, span, "creating audit log entry")
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "committing transaction")
+	}
+
+	x := &identity.AccountRole{
+		CreatedAt:        time.Now(),
+		BelongsToAccount: &input.BelongsToAccount,
+		ID:               input.ID,
+		Name:             input.Name,
+		Description:      input.Description,
+		Permissions:      input.Permissions,
+		ParentRoleIDs:    input.ParentRoleIDs,
+	}
+
+	logger.Info("account role created")
+
+	return x, nil
+}
+
+// GetAccountRole fetches a role that may be assigned within an account, either built-in or defined by the account.
+func (r *repository) GetAccountRole(ctx context.Context, accountID, accountRoleID string) (*identity.AccountRole, error) {
+	ctx, span := r.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if accountID == "" || accountRoleID == "" {
+		return nil, platformerrors.ErrInvalidIDProvided
+	}
+	tracing.AttachToSpan(span, identitykeys.AccountIDKey, accountID)
+	tracing.AttachToSpan(span, identitykeys.AccountRoleIDKey, accountRoleID)
+
+	result, err := r.generatedQuerier.GetAccountRole(ctx, r.readDB, &generated.GetAccountRoleParams{
+		BelongsToAccount: database.NullStringFromString(accountID),
+		ID:               accountRoleID,
+	})
+	if err != nil {
+		return nil, observability.PrepareError(err, span, "fetching account role")
+	}
+
+	x, err := r.buildAccountRole(ctx, result)
+	if err != nil {
+		return nil, observability.PrepareError(err, span, "building account role")
+	}
+
+	return x, nil
+}
+
+// GetAccountRoles fetches every role that may be assigned within an account, built-in roles first.
+func (r *repository) GetAccountRoles(ctx context.Context, accountID string) ([]*identity.AccountRole, error) {
+	ctx, span := r.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if accountID == "" {
+		return nil, platformerrors.ErrInvalidIDProvided
+	}
+	tracing.AttachToSpan(span, identitykeys.AccountIDKey, accountID)
+
+	results, err := r.generatedQuerier.GetAccountRoles(ctx, r.readDB, database.NullStringFromString(accountID))
+	if err != nil {
+		return nil, observability.PrepareError(err, span, "fetching account roles")
+	}
+
+	roles := []*identity.AccountRole{}
+	for _, result := range results {
+		x, buildErr := r.buildAccountRole(ctx, result)
+		if buildErr != nil {
+			return nil, observability.PrepareError(buildErr, span, "building account role")
+		}
+		roles = append(roles, x)
+	}
+
+	return roles, nil
+}
+
+// UpdateAccountRole updates a custom account role, replacing its permissions and parent roles.
+func (r *repository) UpdateAccountRole(ctx context.Context, updated *identity.AccountRole) error {
+	ctx, span := r.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if updated == nil {
+		return platformerrors.ErrNilInputProvided
+	}
+
+	if updated.BelongsToAccount == nil {
+		// built-in roles are shared by every account and can't be changed through the API.
+		return sql.ErrNoRows
+	}
+	accountID := *updated.BelongsToAccount
+
+	logger := r.logger.WithValues(map[string]any{
+		identitykeys.AccountIDKey:     accountID,
+		identitykeys.AccountRoleIDKey: updated.ID,
+	})
+	tracing.AttachToSpan(span, identitykeys.AccountIDKey, accountID)
+	tracing.AttachToSpan(span, identitykeys.AccountRoleIDKey, updated.ID)
+
+	existing, err := r.GetAccountRole(ctx, accountID, updated.ID)
+	if err != nil {
+		return observability.PrepareError(err, span, "fetching account role")
+	}
+
+	tx, err := r.writeDB.BeginTx(ctx, nil)
+	if err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "beginning transaction")
+	}
+
+	rowsAffected, err := r.generatedQuerier.UpdateAccountRole(ctx, tx, &generated.UpdateAccountRoleParams{
+		Name:             updated.Name,
+		Description:      updated.Description,
+		ID:               updated.ID,
+		BelongsToAccount: database.NullStringFromString(accountID),
+	})
+	if err != nil {
+		r.RollbackTransaction(ctx, tx)
+		return observability.PrepareAndLogError(err, logger, span, "updating account role")
+	}
+
+	if rowsAffected == 0 {
+		r.RollbackTransaction(ctx, tx)
+		return sql.ErrNoRows
+	}
+
+	if err = r.generatedQuerier.ArchiveUserRolePermissionsForRole(ctx, tx, updated.ID); err != nil {
+		r.RollbackTransaction(ctx, tx)
+		return observability.PrepareAndLogError(err, logger, span, "clearing account role permissions")
+	}
+
+	if err = r.setAccountRolePermissions(ctx, tx, updated.ID, updated.Permissions); err != nil {
+		r.RollbackTransaction(ctx, tx)
+		return observability.PrepareAndLogError(err, logger, span, "setting account role permissions")
+	}
+
+	if err = r.generatedQuerier.ArchiveUserRoleHierarchyForChild(ctx, tx, updated.ID); err != nil {
+		r.RollbackTransaction(ctx, tx)
+		return observability.PrepareAndLogError(err, logger, span, "clearing account role parents")
+	}
+
+	if err = r.setAccountRoleParents(ctx, tx, accountID, updated.ID, updated.ParentRoleIDs); err != nil {
+		r.RollbackTransaction(ctx, tx)
+		return observability.PrepareAndLogError(err, logger, span, "setting account role parents")
+	}
+
+	if _, err = r.auditLogEntryRepo.CreateAuditLogEntry(ctx, tx, &audit.AuditLogEntryDatabaseCreationInput{
+		BelongsToAccount: &accountID,
+		ID:               identifiers.New(),
+		ResourceType:     resourceTypeAccountRoles,
+		RelevantID:       updated.ID,
+		EventType:        audit.AuditLogEventTypeUpdated,
+		Changes:          buildChangesForAccountRole(existing, updated),
+	}); err != nil {
+		r.RollbackTransaction(ctx, tx)
+		return observability.PrepareError(err, span, "creating audit log entry")
+	}
+
+	if err = tx.Commit(); err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "committing transaction")
+	}
+
+	logger.Info("account role updated")
+
+	return nil
+}
+
+// ArchiveAccountRole archives a custom account role. Members who held it fall back to the basic member role.
+func (r *repository) ArchiveAccountRole(ctx context.Context, accountID, accountRoleID string) error {
+	ctx, span := r.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if accountID == "" || accountRoleID == "" {
+		return platformerrors.ErrInvalidIDProvided
+	}
+
+	logger := r.logger.WithValues(map[string]any{
+		identitykeys.AccountIDKey:     accountID,
+		identitykeys.AccountRoleIDKey: accountRoleID,
+	})
+	tracing.AttachToSpan(span, identitykeys.AccountIDKey, accountID)
+	tracing.AttachToSpan(span, identitykeys.AccountRoleIDKey, accountRoleID)
+
+	tx, err := r.writeDB.BeginTx(ctx, nil)
+	if err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "beginning transaction")
+	}
+
+	rowsAffected, err := r.generatedQuerier.ArchiveAccountRole(ctx, tx, &generated.ArchiveAccountRoleParams{
+		ID:               accountRoleID,
+		BelongsToAccount: database.NullStringFromString(accountID),
+	})
+	if err != nil {
+		r.RollbackTransaction(ctx, tx)
+		return observability.PrepareAndLogError(err, logger, span, "archiving account role")
+	}
+
+	if rowsAffected == 0 {
+		r.RollbackTransaction(ctx, tx)
+		return sql.ErrNoRows
+	}
+
+	if err = r.generatedQuerier.ReassignRoleAssignments(ctx, tx, &generated.ReassignRoleAssignmentsParams{
+		NewRoleID: authorization.AccountMemberRoleID,
+		RoleID:    accountRoleID,
+	}); err != nil {
+		r.RollbackTransaction(ctx, tx)
+		return observability.PrepareAndLogError(err, logger, span, "reassigning account role members")
+	}
+
+	if err = r.generatedQuerier.ArchiveUserRoleHierarchyForChild(ctx, tx, accountRoleID); err != nil {
+		r.RollbackTransaction(ctx, tx)
+		return observability.PrepareAndLogError(err, logger, span, "clearing account role parents")
+	}
+
+	if _, err = r.auditLogEntryRepo.CreateAuditLogEntry(ctx, tx, &audit.AuditLogEntryDatabaseCreationInput{
+		BelongsToAccount: &accountID,
+		ID:               identifiers.New(),
+		ResourceType:     resourceTypeAccountRoles,
+		RelevantID:       accountRoleID,
+		EventType:        audit.AuditLogEventTypeArchived,
+	}); err != nil {
+		r.RollbackTransaction(ctx, tx)
+		return observability.PrepareError(err, span, "creating audit log entry")
+	}
+
+	if err = tx.Commit(); err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "committing transaction")
+	}
+
+	logger.Info("account role archived")
+
+	return nil
+}
+
+// buildAccountRole fills in a role's permissions and parents.
+func (r *repository) buildAccountRole(ctx context.Context, result *generated.UserRoles) (*identity.AccountRole, error) {
+	permissions, err := r.generatedQuerier.GetPermissionNamesForRole(ctx, r.readDB, result.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	parentRoleIDs, err := r.generatedQuerier.GetParentRoleIDsForRole(ctx, r.readDB, result.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &identity.AccountRole{
+		CreatedAt:        result.CreatedAt,
+		LastUpdatedAt:    database.TimePointerFromNullTime(result.LastUpdatedAt),
+		ArchivedAt:       database.TimePointerFromNullTime(result.ArchivedAt),
+		BelongsToAccount: database.StringPointerFromNullString(result.BelongsToAccount),
+		ID:               result.ID,
+		Name:             result.Name,
+		Description:      result.Description,
+		Permissions:      permissions,
+		ParentRoleIDs:    parentRoleIDs,
+	}, nil
+}
+
+// setAccountRolePermissions grants a role each of the named permissions.
+func (r *repository) setAccountRolePermissions(ctx context.Context, querier database.SQLQueryExecutor, roleID string, permissions []string) error {
+	for _, permission := range permissions {
+		rowsAffected, err := r.generatedQuerier.AddPermissionToRoleByName(ctx, querier, &generated.AddPermissionToRoleByNameParams{
+			ID:             identifiers.New(),
+			RoleID:         roleID,
+			PermissionName: permission,
+		})
+		if err != nil {
+			return err
+		}
+
+		if rowsAffected == 0 {
+			return identity.ErrInvalidAccountRolePermission
+		}
+	}
+
+	return nil
+}
+
+// setAccountRoleParents makes a role inherit from each of the given roles, which must be visible to the account.
+func (r *repository) setAccountRoleParents(ctx context.Context, querier database.SQLQueryExecutor, accountID, roleID string, parentRoleIDs []string) error {
+	for _, parentRoleID := range parentRoleIDs {
+		if parentRoleID == roleID {
+			return identity.ErrAccountRoleCannotInheritFromItself
+		}
+
+		if _, err := r.generatedQuerier.GetAccountRole(ctx, querier, &generated.GetAccountRoleParams{
+			BelongsToAccount: database.NullStringFromString(accountID),
+			ID:               parentRoleID,
+		}); err != nil {
+			return err
+		}
+
+		inherits, err := r.roleInheritsFrom(ctx, querier, parentRoleID, roleID)
+		if err != nil {
+			return err
+		}
+
+		if inherits {
+			return identity.ErrAccountRoleCannotInheritFromItself
+		}
+
+		if err = r.generatedQuerier.AddParentToRole(ctx, querier, &generated.AddParentToRoleParams{
+			ID:           identifiers.New(),
+			ParentRoleID: parentRoleID,
+			ChildRoleID:  roleID,
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// roleInheritsFrom reports whether a role inherits, directly or transitively, from another.
+func (r *repository) roleInheritsFrom(ctx context.Context, querier database.SQLQueryExecutor, roleID, ancestorID string) (bool, error) {
+	visited := map[string]bool{}
+	queue := []string{roleID}
+
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+
+		if visited[current] {
+			continue
+		}
+		visited[current] = true
+
+		parents, err := r.generatedQuerier.GetParentRoleIDsForRole(ctx, querier, current)
+		if err != nil {
+			return false, err
+		}
+
+		if slices.Contains(parents, ancestorID) {
+			return true, nil
+		}
+
+		queue = append(queue, parents...)
+	}
+
+	return false, nil
+}
+
+func buildChangesForAccountRole(existing, updated *identity.AccountRole) map[string]*audit.ChangeLog {
+	changes := map[string]*audit.ChangeLog{}
+
+	if existing.Name != updated.Name {
+		changes["name"] = &audit.ChangeLog{
+			OldValue: existing.Name,
+			NewValue: updated.Name,
+		}
+	}
+
+	if existing.Description != updated.Description {
+		changes["description"] = &audit.ChangeLog{
+			OldValue: existing.Description,
+			NewValue: updated.Description,
+		}
+	}
+
+	if !slices.Equal(existing.Permissions, updated.Permissions) {
+		changes["permissions"] = &audit.ChangeLog{
+			OldValue: strings.Join(existing.Permissions, ","),
+			NewValue: strings.Join(updated.Permissions, ","),
+		}
+	}
+
+	if !slices.Equal(existing.ParentRoleIDs, updated.ParentRoleIDs) {
+		changes["parent_role_ids"] = &audit.ChangeLog{
+			OldValue: strings.Join(existing.ParentRoleIDs, ","),
+			NewValue: strings.Join(updated.ParentRoleIDs, ","),
+		}
+	}
+
+	return changes
+}
@@ -0,0 +1,245 @@
+package identity
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/authorization"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/audit"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity/converters"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity/fakes"
+	pgtesting "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/repositories/postgres/testing"
+
+	"github.com/primandproper/platform/identifiers"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuerier_Integration_AccountRoles(t *testing.T) {
+	if !pgtesting.RunContainerTests {
+		t.SkipNow()
+	}
+
+	ctx := t.Context()
+	dbc, auditRepo, container := buildDatabaseClientForTest(t)
+
+	defer func(t *testing.T) {
+		t.Helper()
+		assert.NoError(t, container.Terminate(ctx))
+	}(t)
+
+	exampleUser := createUserForTest(t, ctx, nil, dbc)
+	account := fakes.BuildFakeAccount()
+	account.BelongsToUser = exampleUser.ID
+	exampleAccount := createAccountForTest(t, ctx, account, dbc)
+
+	member := createUserForTest(t, ctx, nil, dbc)
+	require.NoError(t, dbc.addUserToAccount(ctx, dbc.writeDB, &identity.AccountUserMembershipDatabaseCreationInput{
+		ID:        identifiers.New(),
+		Reason:    "testing",
+		UserID:    member.ID,
+		AccountID: exampleAccount.ID,
+	}))
+
+	// built-in roles are visible to every account
+	roles, err := dbc.GetAccountRoles(ctx, exampleAccount.ID)
+	require.NoError(t, err)
+	require.Len(t, roles, 2)
+	assert.True(t, roles[0].IsBuiltIn())
+	assert.True(t, roles[1].IsBuiltIn())
+
+	// create
+	exampleRole := fakes.BuildFakeAccountRole()
+	exampleRole.Name = "cook"
+	exampleRole.BelongsToAccount = &exampleAccount.ID
+	exampleRole.Permissions = []string{
+		string(authorization.UpdateMealPlanGroceryListItemsPermission),
+		string(authorization.UpdateMealPlanTasksPermission),
+	}
+	exampleRole.ParentRoleIDs = []string{authorization.AccountMemberRoleID}
+
+	created, err := dbc.CreateAccountRole(ctx, converters.ConvertAccountRoleToAccountRoleDatabaseCreationInput(exampleRole))
+	require.NoError(t, err)
+	require.NotNil(t, created)
+
+	fetched, err := dbc.GetAccountRole(ctx, exampleAccount.ID, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, exampleRole.Name, fetched.Name)
+	assert.Equal(t, exampleRole.Permissions, fetched.Permissions)
+	assert.Equal(t, exampleRole.ParentRoleIDs, fetched.ParentRoleIDs)
+
+	pgtesting.AssertAuditLogContains(t, ctx, auditRepo, exampleAccount.ID, []*audit.AuditLogEntry{
+		{EventType: audit.AuditLogEventTypeCreated, ResourceType: resourceTypeAccountRoles, RelevantID: created.ID},
+	})
+
+	// another account can't see it
+	otherAccount := createAccountForTest(t, ctx, nil, dbc)
+	_, err = dbc.GetAccountRole(ctx, otherAccount.ID, created.ID)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+
+	// assign it, and the member picks up its permissions along with the inherited ones
+	require.NoError(t, dbc.ModifyUserPermissions(ctx, exampleAccount.ID, member.ID, &identity.ModifyUserPermissionsInput{
+		Reason:  "testing",
+		NewRole: exampleRole.Name,
+	}))
+
+	sessionCtxData, err := dbc.BuildSessionContextDataForUser(ctx, member.ID, exampleAccount.ID)
+	require.NoError(t, err)
+	checker := sessionCtxData.AccountPermissions[exampleAccount.ID]
+	require.NotNil(t, checker)
+	assert.True(t, checker.HasPermission(authorization.UpdateMealPlanTasksPermission))
+	assert.True(t, checker.HasPermission(authorization.ReadMealPlansPermission))
+	assert.False(t, checker.HasPermission(authorization.UpdateAccountPermission))
+
+	// update
+	fetched.Permissions = []string{string(authorization.ReadMealPlansPermission)}
+	fetched.ParentRoleIDs = []string{}
+	require.NoError(t, dbc.UpdateAccountRole(ctx, fetched))
+
+	updated, err := dbc.GetAccountRole(ctx, exampleAccount.ID, created.ID)
+	require.NoError(t, err)
+	assert.Equal(t, fetched.Permissions, updated.Permissions)
+	assert.Empty(t, updated.ParentRoleIDs)
+
+	// roles can't inherit from themselves, even indirectly
+	child, err := dbc.CreateAccountRole(ctx, &identity.AccountRoleDatabaseCreationInput{
+		ID:               identifiers.New(),
+		Name:             "sous chef",
+		BelongsToAccount: exampleAccount.ID,
+		ParentRoleIDs:    []string{created.ID},
+	})
+	require.NoError(t, err)
+
+	updated.ParentRoleIDs = []string{child.ID}
+	assert.ErrorIs(t, dbc.UpdateAccountRole(ctx, updated), identity.ErrAccountRoleCannotInheritFromItself)
+
+	// built-in roles can't be updated or archived
+	assert.Error(t, dbc.UpdateAccountRole(ctx, roles[0]))
+	assert.ErrorIs(t, dbc.ArchiveAccountRole(ctx, exampleAccount.ID, authorization.AccountAdminRoleID), sql.ErrNoRows)
+
+	// archive
+	require.NoError(t, dbc.ArchiveAccountRole(ctx, exampleAccount.ID, created.ID))
+
+	pgtesting.AssertAuditLogContains(t, ctx, auditRepo, exampleAccount.ID, []*audit.AuditLogEntry{
+		{EventType: audit.AuditLogEventTypeCreated, ResourceType: resourceTypeAccountRoles, RelevantID: created.ID},
+		{EventType: audit.AuditLogEventTypeUpdated, ResourceType: resourceTypeAccountRoles, RelevantID: created.ID},
+		{EventType: audit.AuditLogEventTypeCreated, ResourceType: resourceTypeAccountRoles, RelevantID: child.ID},
+		{EventType: audit.AuditLogEventTypeArchived, ResourceType: resourceTypeAccountRoles, RelevantID: created.ID},
+	})
+
+	_, err = dbc.GetAccountRole(ctx, exampleAccount.ID, created.ID)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+
+	// members of an archived role fall back to the basic member role
+	sessionCtxData, err = dbc.BuildSessionContextDataForUser(ctx, member.ID, exampleAccount.ID)
+	require.NoError(t, err)
+	checker = sessionCtxData.AccountPermissions[exampleAccount.ID]
+	require.NotNil(t, checker)
+	assert.True(t, checker.HasPermission(authorization.ReadMealPlansPermission))
+	assert.False(t, checker.HasPermission(authorization.UpdateMealPlanTasksPermission))
+}
+
+func TestQuerier_CreateAccountRole(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with nil input", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		actual, err := c.CreateAccountRole(ctx, nil)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
+
+func TestQuerier_GetAccountRole(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid account ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		actual, err := c.GetAccountRole(ctx, "", fakes.BuildFakeID())
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+
+	T.Run("with invalid account role ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		actual, err := c.GetAccountRole(ctx, fakes.BuildFakeID(), "")
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
+
+func TestQuerier_GetAccountRoles(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid account ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		actual, err := c.GetAccountRoles(ctx, "")
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
+
+func TestQuerier_UpdateAccountRole(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with nil input", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		assert.Error(t, c.UpdateAccountRole(ctx, nil))
+	})
+
+	T.Run("with built-in role", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		exampleRole := fakes.BuildFakeAccountRole()
+		exampleRole.BelongsToAccount = nil
+
+		assert.ErrorIs(t, c.UpdateAccountRole(ctx, exampleRole), sql.ErrNoRows)
+	})
+}
+
+func TestQuerier_ArchiveAccountRole(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid account ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		assert.Error(t, c.ArchiveAccountRole(ctx, "", fakes.BuildFakeID()))
+	})
+
+	T.Run("with invalid account role ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		assert.Error(t, c.ArchiveAccountRole(ctx, fakes.BuildFakeID(), ""))
+	})
+}
@@ -247,8 +247,11 @@ func (r *repository) ModifyUserPermissions(ctx context.Context, accountID, userI
 	tracing.AttachToSpan(span, identitykeys.UserIDKey, userID)
 	tracing.AttachToSpan(span, identitykeys.AccountIDKey, accountID)
 
-	// Look up the new role by name.
-	newRole, roleErr := r.generatedQuerier.GetUserRoleByName(ctx, r.readDB, input.NewRole)
+	// Look up the new role by name among the built-in roles and the ones this account defined.
+	newRole, roleErr := r.generatedQuerier.GetAccountRoleByName(ctx, r.readDB, &generated.GetAccountRoleByNameParams{
+		BelongsToAccount: database.NullStringFromString(accountID),
+		Name:             input.NewRole,
+	})
 	if roleErr != nil {
 		return observability.PrepareAndLogError(roleErr, logger, span, "fetching role by name")
 	}
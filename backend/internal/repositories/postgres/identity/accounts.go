@@ -327,6 +327,61 @@ func (r *repository) UpdateAccountBillingFields(ctx context.Context, accountID s
 	return nil
 }
 
+// GetAccountWebhookEncryptionKeys fetches the keys used to sign an account's webhooks.
+func (r *repository) GetAccountWebhookEncryptionKeys(ctx context.Context, accountID string) (*identity.AccountWebhookEncryptionKeys, error) {
+	ctx, span := r.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if accountID == "" {
+		return nil, platformerrors.ErrInvalidIDProvided
+	}
+	logger := r.logger.WithValue(identitykeys.AccountIDKey, accountID)
+	tracing.AttachToSpan(span, identitykeys.AccountIDKey, accountID)
+
+	result, err := r.generatedQuerier.GetAccountWebhookEncryptionKeys(ctx, r.readDB, accountID)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching account webhook encryption keys")
+	}
+
+	return &identity.AccountWebhookEncryptionKeys{
+		RotatedAt: database.TimePointerFromNullTime(result.WebhookHmacSecretRotatedAt),
+		Current:   result.WebhookHmacSecret,
+		Previous:  result.PreviousWebhookHmacSecret,
+	}, nil
+}
+
+// RotateAccountWebhookEncryptionKey replaces an account's webhook signing key, keeping the current one as the previous key.
+// It returns sql.ErrNoRows if the account doesn't exist, or if its previous rotation's grace period hasn't passed yet.
+func (r *repository) RotateAccountWebhookEncryptionKey(ctx context.Context, accountID, newKey string) error {
+	ctx, span := r.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if accountID == "" {
+		return platformerrors.ErrInvalidIDProvided
+	}
+	logger := r.logger.WithValue(identitykeys.AccountIDKey, accountID)
+	tracing.AttachToSpan(span, identitykeys.AccountIDKey, accountID)
+
+	if newKey == "" {
+		return platformerrors.ErrEmptyInputProvided
+	}
+
+	rowsAffected, err := r.generatedQuerier.RotateAccountWebhookEncryptionKey(ctx, r.writeDB, &generated.RotateAccountWebhookEncryptionKeyParams{
+		ID:                accountID,
+		WebhookHmacSecret: newKey,
+		RotatedBefore:     database.NullTimeFromTime(time.Now().Add(-identity.WebhookEncryptionKeyRotationGracePeriod)),
+	})
+	if err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "rotating account webhook encryption key")
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
 // UpdateAccount updates a particular account. Note that UpdateAccount expects the provided input to have a valid ID.
 func (r *repository) UpdateAccount(ctx context.Context, updated *identity.Account) error {
 	ctx, span := r.tracer.StartSpan(ctx)
@@ -5,6 +5,7 @@
 	"database/sql"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/audit"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity"
@@ -100,6 +101,27 @@ func TestQuerier_Integration_Accounts(t *testing.T) {
 		{EventType: audit.AuditLogEventTypeUpdated, ResourceType: resourceTypeAccounts, RelevantID: createdAccounts[0].ID},
 	})
 
+	// rotate webhook encryption key
+	originalKeys, err := dbc.GetAccountWebhookEncryptionKeys(ctx, createdAccounts[0].ID)
+	require.NoError(t, err)
+	assert.Nil(t, originalKeys.RotatedAt)
+
+	assert.NoError(t, dbc.RotateAccountWebhookEncryptionKey(ctx, createdAccounts[0].ID, "rotated-key"))
+
+	rotatedKeys, err := dbc.GetAccountWebhookEncryptionKeys(ctx, createdAccounts[0].ID)
+	require.NoError(t, err)
+	assert.Equal(t, "rotated-key", rotatedKeys.Current)
+	assert.Equal(t, originalKeys.Current, rotatedKeys.Previous)
+	assert.NotNil(t, rotatedKeys.RotatedAt)
+
+	// the previous key is still in its grace period, so it can't be displaced by another rotation.
+	assert.ErrorIs(t, dbc.RotateAccountWebhookEncryptionKey(ctx, createdAccounts[0].ID, "rotated-again-key"), sql.ErrNoRows)
+
+	// once the grace period has passed, the key can be rotated again.
+	_, err = dbc.writeDB.ExecContext(ctx, `UPDATE accounts SET webhook_hmac_secret_rotated_at = $1 WHERE id = $2`, time.Now().Add(-identity.WebhookEncryptionKeyRotationGracePeriod-time.Minute), createdAccounts[0].ID)
+	require.NoError(t, err)
+	assert.NoError(t, dbc.RotateAccountWebhookEncryptionKey(ctx, createdAccounts[0].ID, "rotated-again-key"))
+
 	// create more
 	for i := range exampleQuantity {
 		input := fakes.BuildFakeAccount()
@@ -201,6 +223,43 @@ func TestQuerier_UpdateAccount(T *testing.T) {
 	})
 }
 
+func TestQuerier_GetAccountWebhookEncryptionKeys(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid account ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		actual, err := c.GetAccountWebhookEncryptionKeys(ctx, "")
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
+
+func TestQuerier_RotateAccountWebhookEncryptionKey(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid account ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		assert.Error(t, c.RotateAccountWebhookEncryptionKey(ctx, "", t.Name()))
+	})
+
+	T.Run("with empty key", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		assert.Error(t, c.RotateAccountWebhookEncryptionKey(ctx, fakes.BuildFakeID(), ""))
+	})
+}
+
 func TestQuerier_ArchiveAccount(T *testing.T) {
 	T.Parallel()
 
@@ -334,6 +334,29 @@ func (q *Queries) GetAccountByIDWithMemberships(ctx context.Context, db DBTX, id
 	return items, nil
 }
 
+const getAccountWebhookEncryptionKeys = `-- name: GetAccountWebhookEncryptionKeys :one
+SELECT
+	accounts.webhook_hmac_secret,
+	accounts.previous_webhook_hmac_secret,
+	accounts.webhook_hmac_secret_rotated_at
+FROM accounts
+WHERE accounts.archived_at IS NULL
+	AND accounts.id = $1
+`
+
+type GetAccountWebhookEncryptionKeysRow struct {
+	WebhookHmacSecret          string
+	PreviousWebhookHmacSecret  string
+	WebhookHmacSecretRotatedAt sql.NullTime
+}
+
+func (q *Queries) GetAccountWebhookEncryptionKeys(ctx context.Context, db DBTX, id string) (*GetAccountWebhookEncryptionKeysRow, error) {
+	row := db.QueryRowContext(ctx, getAccountWebhookEncryptionKeys, id)
+	var i GetAccountWebhookEncryptionKeysRow
+	err := row.Scan(&i.WebhookHmacSecret, &i.PreviousWebhookHmacSecret, &i.WebhookHmacSecretRotatedAt)
+	return &i, err
+}
+
 const getAccountsForUser = `-- name: GetAccountsForUser :many
 SELECT
 	accounts.id,
@@ -493,6 +516,31 @@ func (q *Queries) GetAccountsForUser(ctx context.Context, db DBTX, arg *GetAccou
 	return items, nil
 }
 
+const rotateAccountWebhookEncryptionKey = `-- name: RotateAccountWebhookEncryptionKey :execrows
+UPDATE accounts SET
+	previous_webhook_hmac_secret = webhook_hmac_secret,
+	webhook_hmac_secret = $1,
+	webhook_hmac_secret_rotated_at = NOW(),
+	last_updated_at = NOW()
+WHERE archived_at IS NULL
+	AND (webhook_hmac_secret_rotated_at IS NULL OR webhook_hmac_secret_rotated_at <= $2)
+	AND id = $3
+`
+
+type RotateAccountWebhookEncryptionKeyParams struct {
+	WebhookHmacSecret string
+	RotatedBefore     sql.NullTime
+	ID                string
+}
+
+func (q *Queries) RotateAccountWebhookEncryptionKey(ctx context.Context, db DBTX, arg *RotateAccountWebhookEncryptionKeyParams) (int64, error) {
+	result, err := db.ExecContext(ctx, rotateAccountWebhookEncryptionKey, arg.WebhookHmacSecret, arg.RotatedBefore, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const updateAccount = `-- name: UpdateAccount :execrows
 UPDATE accounts SET
 	name = $1,
@@ -579,26 +627,3 @@ func (q *Queries) UpdateAccountBillingFields(ctx context.Context, db DBTX, arg *
 	}
 	return result.RowsAffected()
 }
-
-const updateAccountWebhookEncryptionKey = `-- name: UpdateAccountWebhookEncryptionKey :execrows
-UPDATE accounts SET
-	webhook_hmac_secret = $1,
-	last_updated_at = NOW()
-WHERE archived_at IS NULL
-	AND belongs_to_user = $2
-	AND id = $3
-`
-
-type UpdateAccountWebhookEncryptionKeyParams struct {
-	WebhookHmacSecret string
-	BelongsToUser     string
-	ID                string
-}
-
-func (q *Queries) UpdateAccountWebhookEncryptionKey(ctx context.Context, db DBTX, arg *UpdateAccountWebhookEncryptionKeyParams) (int64, error) {
-	result, err := db.ExecContext(ctx, updateAccountWebhookEncryptionKey, arg.WebhookHmacSecret, arg.BelongsToUser, arg.ID)
-	if err != nil {
-		return 0, err
-	}
-	return result.RowsAffected()
-}
@@ -242,11 +242,12 @@ type UserRolePermissions struct {
 }
 
 type UserRoles struct {
-	ID            string
-	Name          string
-	Description   string
-	Scope         string
-	CreatedAt     time.Time
-	LastUpdatedAt sql.NullTime
-	ArchivedAt    sql.NullTime
+	ID               string
+	Name             string
+	Description      string
+	Scope            string
+	CreatedAt        time.Time
+	LastUpdatedAt    sql.NullTime
+	ArchivedAt       sql.NullTime
+	BelongsToAccount sql.NullString
 }
@@ -12,9 +12,12 @@
 type Querier interface {
 	AcceptPrivacyPolicyForUser(ctx context.Context, db DBTX, id string) error
 	AcceptTermsOfServiceForUser(ctx context.Context, db DBTX, id string) error
+	AddParentToRole(ctx context.Context, db DBTX, arg *AddParentToRoleParams) error
+	AddPermissionToRoleByName(ctx context.Context, db DBTX, arg *AddPermissionToRoleByNameParams) (int64, error)
 	AddToAccountDuringCreation(ctx context.Context, db DBTX, arg *AddToAccountDuringCreationParams) error
 	AddUserToAccount(ctx context.Context, db DBTX, arg *AddUserToAccountParams) error
 	ArchiveAccount(ctx context.Context, db DBTX, arg *ArchiveAccountParams) (int64, error)
+	ArchiveAccountRole(ctx context.Context, db DBTX, arg *ArchiveAccountRoleParams) (int64, error)
 	ArchivePermission(ctx context.Context, db DBTX, id string) (int64, error)
 	ArchiveRoleAssignment(ctx context.Context, db DBTX, arg *ArchiveRoleAssignmentParams) error
 	ArchiveRoleAssignmentsForUserAndAccount(ctx context.Context, db DBTX, arg *ArchiveRoleAssignmentsForUserAndAccountParams) error
@@ -23,7 +26,9 @@ type Querier interface {
 	ArchiveUserMemberships(ctx context.Context, db DBTX, id string) (int64, error)
 	ArchiveUserRole(ctx context.Context, db DBTX, id string) (int64, error)
 	ArchiveUserRoleHierarchy(ctx context.Context, db DBTX, arg *ArchiveUserRoleHierarchyParams) error
+	ArchiveUserRoleHierarchyForChild(ctx context.Context, db DBTX, childRoleID string) error
 	ArchiveUserRolePermission(ctx context.Context, db DBTX, arg *ArchiveUserRolePermissionParams) error
+	ArchiveUserRolePermissionsForRole(ctx context.Context, db DBTX, roleID string) error
 	ArchiveWebAuthnCredential(ctx context.Context, db DBTX, id string) (int64, error)
 	ArchiveWebAuthnCredentialForUser(ctx context.Context, db DBTX, arg *ArchiveWebAuthnCredentialForUserParams) (int64, error)
 	AssignInvitationsToUserByEmail(ctx context.Context, db DBTX, arg *AssignInvitationsToUserByEmailParams) (int64, error)
@@ -47,15 +52,21 @@ type Querier interface {
 	GetAccountInvitationByToken(ctx context.Context, db DBTX, token string) (*GetAccountInvitationByTokenRow, error)
 	GetAccountInvitationByTokenAndID(ctx context.Context, db DBTX, arg *GetAccountInvitationByTokenAndIDParams) (*GetAccountInvitationByTokenAndIDRow, error)
 	GetAccountPermissionsForUser(ctx context.Context, db DBTX, userID string) ([]*GetAccountPermissionsForUserRow, error)
+	GetAccountRole(ctx context.Context, db DBTX, arg *GetAccountRoleParams) (*UserRoles, error)
+	GetAccountRoleByName(ctx context.Context, db DBTX, arg *GetAccountRoleByNameParams) (*UserRoles, error)
+	GetAccountRoles(ctx context.Context, db DBTX, belongsToAccount sql.NullString) ([]*UserRoles, error)
 	GetAccountUserMembershipsForUser(ctx context.Context, db DBTX, belongsToUser string) ([]*AccountUserMemberships, error)
+	GetAccountWebhookEncryptionKeys(ctx context.Context, db DBTX, id string) (*GetAccountWebhookEncryptionKeysRow, error)
 	GetAccountsForUser(ctx context.Context, db DBTX, arg *GetAccountsForUserParams) ([]*GetAccountsForUserRow, error)
 	GetAdminUserByUsername(ctx context.Context, db DBTX, username string) (*GetAdminUserByUsernameRow, error)
 	GetDefaultAccountIDForUser(ctx context.Context, db DBTX, belongsToUser string) (string, error)
 	GetEmailVerificationTokenByUserID(ctx context.Context, db DBTX, id string) (sql.NullString, error)
+	GetParentRoleIDsForRole(ctx context.Context, db DBTX, childRoleID string) ([]string, error)
 	GetPendingInvitesForUser(ctx context.Context, db DBTX, arg *GetPendingInvitesForUserParams) ([]*GetPendingInvitesForUserRow, error)
 	GetPendingInvitesFromUser(ctx context.Context, db DBTX, arg *GetPendingInvitesFromUserParams) ([]*GetPendingInvitesFromUserRow, error)
 	GetPermissionByID(ctx context.Context, db DBTX, id string) (*Permissions, error)
 	GetPermissionByName(ctx context.Context, db DBTX, name string) (*Permissions, error)
+	GetPermissionNamesForRole(ctx context.Context, db DBTX, roleID string) ([]string, error)
 	GetPermissions(ctx context.Context, db DBTX, arg *GetPermissionsParams) ([]*GetPermissionsRow, error)
 	GetServicePermissionsForUser(ctx context.Context, db DBTX, userID string) ([]string, error)
 	GetServiceRoleNamesForUser(ctx context.Context, db DBTX, userID string) ([]string, error)
@@ -82,7 +93,9 @@ type Querier interface {
 	MarkEmailAddressAsVerified(ctx context.Context, db DBTX, arg *MarkEmailAddressAsVerifiedParams) error
 	MarkTwoFactorSecretAsUnverified(ctx context.Context, db DBTX, arg *MarkTwoFactorSecretAsUnverifiedParams) error
 	MarkTwoFactorSecretAsVerified(ctx context.Context, db DBTX, id string) error
+	ReassignRoleAssignments(ctx context.Context, db DBTX, arg *ReassignRoleAssignmentsParams) error
 	RemoveUserFromAccount(ctx context.Context, db DBTX, arg *RemoveUserFromAccountParams) error
+	RotateAccountWebhookEncryptionKey(ctx context.Context, db DBTX, arg *RotateAccountWebhookEncryptionKeyParams) (int64, error)
 	SearchUsersByUsername(ctx context.Context, db DBTX, arg *SearchUsersByUsernameParams) ([]*SearchUsersByUsernameRow, error)
 	SetAccountInvitationStatus(ctx context.Context, db DBTX, arg *SetAccountInvitationStatusParams) error
 	SetUserAccountStatus(ctx context.Context, db DBTX, arg *SetUserAccountStatusParams) (int64, error)
@@ -91,8 +104,8 @@ type Querier interface {
 	TransferAccountOwnership(ctx context.Context, db DBTX, arg *TransferAccountOwnershipParams) error
 	UpdateAccount(ctx context.Context, db DBTX, arg *UpdateAccountParams) (int64, error)
 	UpdateAccountBillingFields(ctx context.Context, db DBTX, arg *UpdateAccountBillingFieldsParams) (int64, error)
+	UpdateAccountRole(ctx context.Context, db DBTX, arg *UpdateAccountRoleParams) (int64, error)
 	UpdateAccountRoleAssignment(ctx context.Context, db DBTX, arg *UpdateAccountRoleAssignmentParams) error
-	UpdateAccountWebhookEncryptionKey(ctx context.Context, db DBTX, arg *UpdateAccountWebhookEncryptionKeyParams) (int64, error)
 	UpdateUserDetails(ctx context.Context, db DBTX, arg *UpdateUserDetailsParams) (int64, error)
 	UpdateUserEmailAddress(ctx context.Context, db DBTX, arg *UpdateUserEmailAddressParams) (int64, error)
 	UpdateUserLastIndexedAt(ctx context.Context, db DBTX, id string) (int64, error)
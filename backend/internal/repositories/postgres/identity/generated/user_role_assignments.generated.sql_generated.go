@@ -209,6 +209,22 @@ func (q *Queries) GetServiceRoleNamesForUser(ctx context.Context, db DBTX, userI
 	return items, nil
 }
 
+const reassignRoleAssignments = `-- name: ReassignRoleAssignments :exec
+UPDATE user_role_assignments SET role_id = $1
+WHERE archived_at IS NULL
+	AND role_id = $2
+`
+
+type ReassignRoleAssignmentsParams struct {
+	NewRoleID string
+	RoleID    string
+}
+
+func (q *Queries) ReassignRoleAssignments(ctx context.Context, db DBTX, arg *ReassignRoleAssignmentsParams) error {
+	_, err := db.ExecContext(ctx, reassignRoleAssignments, arg.NewRoleID, arg.RoleID)
+	return err
+}
+
 const updateAccountRoleAssignment = `-- name: UpdateAccountRoleAssignment :exec
 UPDATE user_role_assignments SET role_id = $1
 WHERE archived_at IS NULL
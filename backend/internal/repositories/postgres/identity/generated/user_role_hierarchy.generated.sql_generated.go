@@ -9,6 +9,29 @@
 	"context"
 )
 
+const addParentToRole = `-- name: AddParentToRole :exec
+INSERT INTO user_role_hierarchy (
+	id,
+	parent_role_id,
+	child_role_id
+) VALUES (
+	$1,
+	$2,
+	$3
+) ON CONFLICT (parent_role_id, child_role_id) DO UPDATE SET archived_at = NULL
+`
+
+type AddParentToRoleParams struct {
+	ID           string
+	ParentRoleID string
+	ChildRoleID  string
+}
+
+func (q *Queries) AddParentToRole(ctx context.Context, db DBTX, arg *AddParentToRoleParams) error {
+	_, err := db.ExecContext(ctx, addParentToRole, arg.ID, arg.ParentRoleID, arg.ChildRoleID)
+	return err
+}
+
 const archiveUserRoleHierarchy = `-- name: ArchiveUserRoleHierarchy :exec
 UPDATE user_role_hierarchy SET archived_at = NOW()
 WHERE archived_at IS NULL
@@ -26,6 +49,17 @@ func (q *Queries) ArchiveUserRoleHierarchy(ctx context.Context, db DBTX, arg *Ar
 	return err
 }
 
+const archiveUserRoleHierarchyForChild = `-- name: ArchiveUserRoleHierarchyForChild :exec
+UPDATE user_role_hierarchy SET archived_at = NOW()
+WHERE archived_at IS NULL
+	AND child_role_id = $1
+`
+
+func (q *Queries) ArchiveUserRoleHierarchyForChild(ctx context.Context, db DBTX, childRoleID string) error {
+	_, err := db.ExecContext(ctx, archiveUserRoleHierarchyForChild, childRoleID)
+	return err
+}
+
 const createUserRoleHierarchy = `-- name: CreateUserRoleHierarchy :exec
 INSERT INTO user_role_hierarchy (
 	id,
@@ -49,6 +83,37 @@ func (q *Queries) CreateUserRoleHierarchy(ctx context.Context, db DBTX, arg *Cre
 	return err
 }
 
+const getParentRoleIDsForRole = `-- name: GetParentRoleIDsForRole :many
+SELECT user_role_hierarchy.parent_role_id
+FROM user_role_hierarchy
+WHERE user_role_hierarchy.archived_at IS NULL
+	AND user_role_hierarchy.child_role_id = $1
+ORDER BY user_role_hierarchy.parent_role_id
+`
+
+func (q *Queries) GetParentRoleIDsForRole(ctx context.Context, db DBTX, childRoleID string) ([]string, error) {
+	rows, err := db.QueryContext(ctx, getParentRoleIDsForRole, childRoleID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var parent_role_id string
+		if err := rows.Scan(&parent_role_id); err != nil {
+			return nil, err
+		}
+		items = append(items, parent_role_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getUserRoleHierarchy = `-- name: GetUserRoleHierarchy :many
 SELECT
 	user_role_hierarchy.id,
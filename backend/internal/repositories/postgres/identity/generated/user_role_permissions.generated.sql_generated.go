@@ -9,6 +9,35 @@
 	"context"
 )
 
+const addPermissionToRoleByName = `-- name: AddPermissionToRoleByName :execrows
+INSERT INTO user_role_permissions (
+	id,
+	role_id,
+	permission_id
+) SELECT
+	$1,
+	$2,
+	permissions.id
+FROM permissions
+WHERE permissions.archived_at IS NULL
+	AND permissions.name = $3
+ON CONFLICT (role_id, permission_id) DO UPDATE SET archived_at = NULL
+`
+
+type AddPermissionToRoleByNameParams struct {
+	ID             string
+	RoleID         string
+	PermissionName string
+}
+
+func (q *Queries) AddPermissionToRoleByName(ctx context.Context, db DBTX, arg *AddPermissionToRoleByNameParams) (int64, error) {
+	result, err := db.ExecContext(ctx, addPermissionToRoleByName, arg.ID, arg.RoleID, arg.PermissionName)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const archiveUserRolePermission = `-- name: ArchiveUserRolePermission :exec
 UPDATE user_role_permissions SET archived_at = NOW()
 WHERE archived_at IS NULL
@@ -26,6 +55,17 @@ func (q *Queries) ArchiveUserRolePermission(ctx context.Context, db DBTX, arg *A
 	return err
 }
 
+const archiveUserRolePermissionsForRole = `-- name: ArchiveUserRolePermissionsForRole :exec
+UPDATE user_role_permissions SET archived_at = NOW()
+WHERE archived_at IS NULL
+	AND role_id = $1
+`
+
+func (q *Queries) ArchiveUserRolePermissionsForRole(ctx context.Context, db DBTX, roleID string) error {
+	_, err := db.ExecContext(ctx, archiveUserRolePermissionsForRole, roleID)
+	return err
+}
+
 const createUserRolePermission = `-- name: CreateUserRolePermission :exec
 INSERT INTO user_role_permissions (
 	id,
@@ -49,6 +89,39 @@ func (q *Queries) CreateUserRolePermission(ctx context.Context, db DBTX, arg *Cr
 	return err
 }
 
+const getPermissionNamesForRole = `-- name: GetPermissionNamesForRole :many
+SELECT permissions.name
+FROM user_role_permissions
+JOIN permissions ON permissions.id = user_role_permissions.permission_id
+WHERE user_role_permissions.archived_at IS NULL
+	AND permissions.archived_at IS NULL
+	AND user_role_permissions.role_id = $1
+ORDER BY permissions.name
+`
+
+func (q *Queries) GetPermissionNamesForRole(ctx context.Context, db DBTX, roleID string) ([]string, error) {
+	rows, err := db.QueryContext(ctx, getPermissionNamesForRole, roleID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var name string
+		if err := rows.Scan(&name); err != nil {
+			return nil, err
+		}
+		items = append(items, name)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getUserRolePermissionsForRole = `-- name: GetUserRolePermissionsForRole :many
 SELECT
 	user_role_permissions.id,
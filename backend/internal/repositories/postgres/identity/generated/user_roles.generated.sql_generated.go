@@ -11,6 +11,23 @@
 	"time"
 )
 
+const archiveAccountRole = `-- name: ArchiveAccountRole :execrows
+UPDATE user_roles SET archived_at = NOW() WHERE archived_at IS NULL AND id = $1 AND belongs_to_account = $2
+`
+
+type ArchiveAccountRoleParams struct {
+	ID               string
+	BelongsToAccount sql.NullString
+}
+
+func (q *Queries) ArchiveAccountRole(ctx context.Context, db DBTX, arg *ArchiveAccountRoleParams) (int64, error) {
+	result, err := db.ExecContext(ctx, archiveAccountRole, arg.ID, arg.BelongsToAccount)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const archiveUserRole = `-- name: ArchiveUserRole :execrows
 UPDATE user_roles SET archived_at = NOW() WHERE archived_at IS NULL AND id = $1
 `
@@ -28,20 +45,23 @@ func (q *Queries) ArchiveUserRole(ctx context.Context, db DBTX, id string) (int6
 	id,
 	name,
 	description,
-	scope
+	scope,
+	belongs_to_account
 ) VALUES (
 	$1,
 	$2,
 	$3,
-	$4
+	$4,
+	$5
 )
 `
 
 type CreateUserRoleParams struct {
-	ID          string
-	Name        string
-	Description string
-	Scope       string
+	ID               string
+	Name             string
+	Description      string
+	Scope            string
+	BelongsToAccount sql.NullString
 }
 
 func (q *Queries) CreateUserRole(ctx context.Context, db DBTX, arg *CreateUserRoleParams) error {
@@ -50,10 +70,136 @@ func (q *Queries) CreateUserRole(ctx context.Context, db DBTX, arg *CreateUserRo
 		arg.Name,
 		arg.Description,
 		arg.Scope,
+		arg.BelongsToAccount,
 	)
 	return err
 }
 
+const getAccountRole = `-- name: GetAccountRole :one
+SELECT
+	user_roles.id,
+	user_roles.name,
+	user_roles.description,
+	user_roles.scope,
+	user_roles.created_at,
+	user_roles.last_updated_at,
+	user_roles.archived_at,
+	user_roles.belongs_to_account
+FROM user_roles
+WHERE user_roles.archived_at IS NULL
+	AND user_roles.scope = 'account'
+	AND (user_roles.belongs_to_account IS NULL OR user_roles.belongs_to_account = $1)
+	AND user_roles.id = $2
+`
+
+type GetAccountRoleParams struct {
+	BelongsToAccount sql.NullString
+	ID               string
+}
+
+func (q *Queries) GetAccountRole(ctx context.Context, db DBTX, arg *GetAccountRoleParams) (*UserRoles, error) {
+	row := db.QueryRowContext(ctx, getAccountRole, arg.BelongsToAccount, arg.ID)
+	var i UserRoles
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Description,
+		&i.Scope,
+		&i.CreatedAt,
+		&i.LastUpdatedAt,
+		&i.ArchivedAt,
+		&i.BelongsToAccount,
+	)
+	return &i, err
+}
+
+const getAccountRoleByName = `-- name: GetAccountRoleByName :one
+SELECT
+	user_roles.id,
+	user_roles.name,
+	user_roles.description,
+	user_roles.scope,
+	user_roles.created_at,
+	user_roles.last_updated_at,
+	user_roles.archived_at,
+	user_roles.belongs_to_account
+FROM user_roles
+WHERE user_roles.archived_at IS NULL
+	AND user_roles.scope = 'account'
+	AND (user_roles.belongs_to_account IS NULL OR user_roles.belongs_to_account = $1)
+	AND user_roles.name = $2
+`
+
+type GetAccountRoleByNameParams struct {
+	BelongsToAccount sql.NullString
+	Name             string
+}
+
+func (q *Queries) GetAccountRoleByName(ctx context.Context, db DBTX, arg *GetAccountRoleByNameParams) (*UserRoles, error) {
+	row := db.QueryRowContext(ctx, getAccountRoleByName, arg.BelongsToAccount, arg.Name)
+	var i UserRoles
+	err := row.Scan(
+		&i.ID,
+		&i.Name,
+		&i.Description,
+		&i.Scope,
+		&i.CreatedAt,
+		&i.LastUpdatedAt,
+		&i.ArchivedAt,
+		&i.BelongsToAccount,
+	)
+	return &i, err
+}
+
+const getAccountRoles = `-- name: GetAccountRoles :many
+SELECT
+	user_roles.id,
+	user_roles.name,
+	user_roles.description,
+	user_roles.scope,
+	user_roles.created_at,
+	user_roles.last_updated_at,
+	user_roles.archived_at,
+	user_roles.belongs_to_account
+FROM user_roles
+WHERE user_roles.archived_at IS NULL
+	AND user_roles.scope = 'account'
+	AND (user_roles.belongs_to_account IS NULL OR user_roles.belongs_to_account = $1)
+ORDER BY user_roles.belongs_to_account NULLS FIRST, user_roles.name
+`
+
+func (q *Queries) GetAccountRoles(ctx context.Context, db DBTX, belongsToAccount sql.NullString) ([]*UserRoles, error) {
+	rows, err := db.QueryContext(ctx, getAccountRoles, belongsToAccount)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*UserRoles{}
+	for rows.Next() {
+		var i UserRoles
+		if err := rows.Scan(
+			&i.ID,
+			&i.Name,
+			&i.Description,
+			&i.Scope,
+			&i.CreatedAt,
+			&i.LastUpdatedAt,
+			&i.ArchivedAt,
+			&i.BelongsToAccount,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getUserRoleByID = `-- name: GetUserRoleByID :one
 SELECT
 	user_roles.id,
@@ -62,7 +208,8 @@ func (q *Queries) CreateUserRole(ctx context.Context, db DBTX, arg *CreateUserRo
 	user_roles.scope,
 	user_roles.created_at,
 	user_roles.last_updated_at,
-	user_roles.archived_at
+	user_roles.archived_at,
+	user_roles.belongs_to_account
 FROM user_roles
 WHERE user_roles.archived_at IS NULL
 	AND user_roles.id = $1
@@ -79,6 +226,7 @@ func (q *Queries) GetUserRoleByID(ctx context.Context, db DBTX, id string) (*Use
 		&i.CreatedAt,
 		&i.LastUpdatedAt,
 		&i.ArchivedAt,
+		&i.BelongsToAccount,
 	)
 	return &i, err
 }
@@ -91,9 +239,11 @@ func (q *Queries) GetUserRoleByID(ctx context.Context, db DBTX, id string) (*Use
 	user_roles.scope,
 	user_roles.created_at,
 	user_roles.last_updated_at,
-	user_roles.archived_at
+	user_roles.archived_at,
+	user_roles.belongs_to_account
 FROM user_roles
 WHERE user_roles.archived_at IS NULL
+	AND user_roles.belongs_to_account IS NULL
 	AND user_roles.name = $1
 `
 
@@ -108,6 +258,7 @@ func (q *Queries) GetUserRoleByName(ctx context.Context, db DBTX, name string) (
 		&i.CreatedAt,
 		&i.LastUpdatedAt,
 		&i.ArchivedAt,
+		&i.BelongsToAccount,
 	)
 	return &i, err
 }
@@ -121,6 +272,7 @@ func (q *Queries) GetUserRoleByName(ctx context.Context, db DBTX, name string) (
 	user_roles.created_at,
 	user_roles.last_updated_at,
 	user_roles.archived_at,
+	user_roles.belongs_to_account,
 	(
 		SELECT COUNT(user_roles.id)
 		FROM user_roles
@@ -172,15 +324,16 @@ type GetUserRolesParams struct {
 }
 
 type GetUserRolesRow struct {
-	ID            string
-	Name          string
-	Description   string
-	Scope         string
-	CreatedAt     time.Time
-	LastUpdatedAt sql.NullTime
-	ArchivedAt    sql.NullTime
-	FilteredCount int64
-	TotalCount    int64
+	ID               string
+	Name             string
+	Description      string
+	Scope            string
+	CreatedAt        time.Time
+	LastUpdatedAt    sql.NullTime
+	ArchivedAt       sql.NullTime
+	BelongsToAccount sql.NullString
+	FilteredCount    int64
+	TotalCount       int64
 }
 
 func (q *Queries) GetUserRoles(ctx context.Context, db DBTX, arg *GetUserRolesParams) ([]*GetUserRolesRow, error) {
@@ -208,6 +361,7 @@ func (q *Queries) GetUserRoles(ctx context.Context, db DBTX, arg *GetUserRolesPa
 			&i.CreatedAt,
 			&i.LastUpdatedAt,
 			&i.ArchivedAt,
+			&i.BelongsToAccount,
 			&i.FilteredCount,
 			&i.TotalCount,
 		); err != nil {
@@ -223,3 +377,33 @@ func (q *Queries) GetUserRoles(ctx context.Context, db DBTX, arg *GetUserRolesPa
 	}
 	return items, nil
 }
+
+const updateAccountRole = `-- name: UpdateAccountRole :execrows
+UPDATE user_roles SET
+	name = $1,
+	description = $2,
+	last_updated_at = NOW()
+WHERE archived_at IS NULL
+	AND id = $3
+	AND belongs_to_account = $4
+`
+
+type UpdateAccountRoleParams struct {
+	Name             string
+	Description      string
+	ID               string
+	BelongsToAccount sql.NullString
+}
+
+func (q *Queries) UpdateAccountRole(ctx context.Context, db DBTX, arg *UpdateAccountRoleParams) (int64, error) {
+	result, err := db.ExecContext(ctx, updateAccountRole,
+		arg.Name,
+		arg.Description,
+		arg.ID,
+		arg.BelongsToAccount,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
@@ -209,10 +209,21 @@ UPDATE accounts SET
 WHERE archived_at IS NULL
 	AND id = sqlc.arg(id);
 
--- name: UpdateAccountWebhookEncryptionKey :execrows
+-- name: GetAccountWebhookEncryptionKeys :one
+SELECT
+	accounts.webhook_hmac_secret,
+	accounts.previous_webhook_hmac_secret,
+	accounts.webhook_hmac_secret_rotated_at
+FROM accounts
+WHERE accounts.archived_at IS NULL
+	AND accounts.id = sqlc.arg(id);
+
+-- name: RotateAccountWebhookEncryptionKey :execrows
 UPDATE accounts SET
+	previous_webhook_hmac_secret = webhook_hmac_secret,
 	webhook_hmac_secret = sqlc.arg(webhook_hmac_secret),
+	webhook_hmac_secret_rotated_at = NOW(),
 	last_updated_at = NOW()
 WHERE archived_at IS NULL
-	AND belongs_to_user = sqlc.arg(belongs_to_user)
+	AND (webhook_hmac_secret_rotated_at IS NULL OR webhook_hmac_secret_rotated_at <= sqlc.arg(rotated_before))
 	AND id = sqlc.arg(id);
@@ -29,6 +29,11 @@ WHERE archived_at IS NULL
 	AND user_id = sqlc.arg(user_id)
 	AND account_id = sqlc.arg(account_id);
 
+-- name: ReassignRoleAssignments :exec
+UPDATE user_role_assignments SET role_id = sqlc.arg(new_role_id)
+WHERE archived_at IS NULL
+	AND role_id = sqlc.arg(role_id);
+
 -- name: GetServicePermissionsForUser :many
 WITH RECURSIVE role_tree AS (
 	SELECT user_roles.id AS role_id, user_roles.name AS role_name
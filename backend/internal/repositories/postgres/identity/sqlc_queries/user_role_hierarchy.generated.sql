@@ -19,6 +19,29 @@ SELECT
 FROM user_role_hierarchy
 WHERE user_role_hierarchy.archived_at IS NULL;
 
+-- name: AddParentToRole :exec
+INSERT INTO user_role_hierarchy (
+	id,
+	parent_role_id,
+	child_role_id
+) VALUES (
+	sqlc.arg(id),
+	sqlc.arg(parent_role_id),
+	sqlc.arg(child_role_id)
+) ON CONFLICT (parent_role_id, child_role_id) DO UPDATE SET archived_at = NULL;
+
+-- name: GetParentRoleIDsForRole :many
+SELECT user_role_hierarchy.parent_role_id
+FROM user_role_hierarchy
+WHERE user_role_hierarchy.archived_at IS NULL
+	AND user_role_hierarchy.child_role_id = sqlc.arg(child_role_id)
+ORDER BY user_role_hierarchy.parent_role_id;
+
+-- name: ArchiveUserRoleHierarchyForChild :exec
+UPDATE user_role_hierarchy SET archived_at = NOW()
+WHERE archived_at IS NULL
+	AND child_role_id = sqlc.arg(child_role_id);
+
 -- name: ArchiveUserRoleHierarchy :exec
 UPDATE user_role_hierarchy SET archived_at = NOW()
 WHERE archived_at IS NULL
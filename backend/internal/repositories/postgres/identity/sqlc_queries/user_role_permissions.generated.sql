@@ -20,6 +20,34 @@ FROM user_role_permissions
 WHERE user_role_permissions.archived_at IS NULL
 	AND user_role_permissions.role_id = sqlc.arg(role_id);
 
+-- name: AddPermissionToRoleByName :execrows
+INSERT INTO user_role_permissions (
+	id,
+	role_id,
+	permission_id
+) SELECT
+	sqlc.arg(id),
+	sqlc.arg(role_id),
+	permissions.id
+FROM permissions
+WHERE permissions.archived_at IS NULL
+	AND permissions.name = sqlc.arg(permission_name)
+ON CONFLICT (role_id, permission_id) DO UPDATE SET archived_at = NULL;
+
+-- name: GetPermissionNamesForRole :many
+SELECT permissions.name
+FROM user_role_permissions
+JOIN permissions ON permissions.id = user_role_permissions.permission_id
+WHERE user_role_permissions.archived_at IS NULL
+	AND permissions.archived_at IS NULL
+	AND user_role_permissions.role_id = sqlc.arg(role_id)
+ORDER BY permissions.name;
+
+-- name: ArchiveUserRolePermissionsForRole :exec
+UPDATE user_role_permissions SET archived_at = NOW()
+WHERE archived_at IS NULL
+	AND role_id = sqlc.arg(role_id);
+
 -- name: ArchiveUserRolePermission :exec
 UPDATE user_role_permissions SET archived_at = NOW()
 WHERE archived_at IS NULL
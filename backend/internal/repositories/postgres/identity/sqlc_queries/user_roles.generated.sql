@@ -3,12 +3,14 @@ INSERT INTO user_roles (
 	id,
 	name,
 	description,
-	scope
+	scope,
+	belongs_to_account
 ) VALUES (
 	sqlc.arg(id),
 	sqlc.arg(name),
 	sqlc.arg(description),
-	sqlc.arg(scope)
+	sqlc.arg(scope),
+	sqlc.arg(belongs_to_account)
 );
 
 -- name: GetUserRoleByID :one
@@ -19,7 +21,8 @@ SELECT
 	user_roles.scope,
 	user_roles.created_at,
 	user_roles.last_updated_at,
-	user_roles.archived_at
+	user_roles.archived_at,
+	user_roles.belongs_to_account
 FROM user_roles
 WHERE user_roles.archived_at IS NULL
 	AND user_roles.id = sqlc.arg(id);
@@ -32,9 +35,11 @@ SELECT
 	user_roles.scope,
 	user_roles.created_at,
 	user_roles.last_updated_at,
-	user_roles.archived_at
+	user_roles.archived_at,
+	user_roles.belongs_to_account
 FROM user_roles
 WHERE user_roles.archived_at IS NULL
+	AND user_roles.belongs_to_account IS NULL
 	AND user_roles.name = sqlc.arg(name);
 
 -- name: GetUserRoles :many
@@ -46,6 +51,7 @@ SELECT
 	user_roles.created_at,
 	user_roles.last_updated_at,
 	user_roles.archived_at,
+	user_roles.belongs_to_account,
 	(
 		SELECT COUNT(user_roles.id)
 		FROM user_roles
@@ -85,5 +91,65 @@ WHERE user_roles.archived_at IS NULL
 ORDER BY user_roles.id ASC
 LIMIT COALESCE(sqlc.narg(result_limit), 50);
 
+-- name: GetAccountRoles :many
+SELECT
+	user_roles.id,
+	user_roles.name,
+	user_roles.description,
+	user_roles.scope,
+	user_roles.created_at,
+	user_roles.last_updated_at,
+	user_roles.archived_at,
+	user_roles.belongs_to_account
+FROM user_roles
+WHERE user_roles.archived_at IS NULL
+	AND user_roles.scope = 'account'
+	AND (user_roles.belongs_to_account IS NULL OR user_roles.belongs_to_account = sqlc.arg(belongs_to_account))
+ORDER BY user_roles.belongs_to_account NULLS FIRST, user_roles.name;
+
+-- name: GetAccountRole :one
+SELECT
+	user_roles.id,
+	user_roles.name,
+	user_roles.description,
+	user_roles.scope,
+	user_roles.created_at,
+	user_roles.last_updated_at,
+	user_roles.archived_at,
+	user_roles.belongs_to_account
+FROM user_roles
+WHERE user_roles.archived_at IS NULL
+	AND user_roles.scope = 'account'
+	AND (user_roles.belongs_to_account IS NULL OR user_roles.belongs_to_account = sqlc.arg(belongs_to_account))
+	AND user_roles.id = sqlc.arg(id);
+
+-- name: GetAccountRoleByName :one
+SELECT
+	user_roles.id,
+	user_roles.name,
+	user_roles.description,
+	user_roles.scope,
+	user_roles.created_at,
+	user_roles.last_updated_at,
+	user_roles.archived_at,
+	user_roles.belongs_to_account
+FROM user_roles
+WHERE user_roles.archived_at IS NULL
+	AND user_roles.scope = 'account'
+	AND (user_roles.belongs_to_account IS NULL OR user_roles.belongs_to_account = sqlc.arg(belongs_to_account))
+	AND user_roles.name = sqlc.arg(name);
+
+-- name: UpdateAccountRole :execrows
+UPDATE user_roles SET
+	name = sqlc.arg(name),
+	description = sqlc.arg(description),
+	last_updated_at = NOW()
+WHERE archived_at IS NULL
+	AND id = sqlc.arg(id)
+	AND belongs_to_account = sqlc.arg(belongs_to_account);
+
+-- name: ArchiveAccountRole :execrows
+UPDATE user_roles SET archived_at = NOW() WHERE archived_at IS NULL AND id = sqlc.arg(id) AND belongs_to_account = sqlc.arg(belongs_to_account);
+
 -- name: ArchiveUserRole :execrows
 UPDATE user_roles SET archived_at = NOW() WHERE archived_at IS NULL AND id = sqlc.arg(id);
@@ -7,6 +7,10 @@
 
 import (
 	"context"
+	"database/sql"
+	"time"
+
+	"github.com/lib/pq"
 )
 
 const acknowledgeQueueTestMessage = `-- name: AcknowledgeQueueTestMessage :exec
@@ -18,6 +22,60 @@ func (q *Queries) AcknowledgeQueueTestMessage(ctx context.Context, db DBTX, id s
 	return err
 }
 
+const claimIdempotencyKey = `-- name: ClaimIdempotencyKey :execrows
+INSERT INTO idempotency_keys (id, idempotency_key, method, scope, request_fingerprint, expires_at)
+VALUES ($1, $2, $3, $4, $5, $6)
+ON CONFLICT (scope, method, idempotency_key) DO UPDATE SET
+	id = EXCLUDED.id,
+	request_fingerprint = EXCLUDED.request_fingerprint,
+	response_type = NULL,
+	response = NULL,
+	created_at = NOW(),
+	completed_at = NULL,
+	expires_at = EXCLUDED.expires_at
+WHERE idempotency_keys.expires_at < NOW()
+	OR (idempotency_keys.completed_at IS NULL AND idempotency_keys.created_at < (NOW() - interval '5 minutes'))
+`
+
+type ClaimIdempotencyKeyParams struct {
+	ID                 string
+	IdempotencyKey     string
+	Method             string
+	Scope              string
+	RequestFingerprint string
+	ExpiresAt          time.Time
+}
+
+func (q *Queries) ClaimIdempotencyKey(ctx context.Context, db DBTX, arg *ClaimIdempotencyKeyParams) (int64, error) {
+	result, err := db.ExecContext(ctx, claimIdempotencyKey,
+		arg.ID,
+		arg.IdempotencyKey,
+		arg.Method,
+		arg.Scope,
+		arg.RequestFingerprint,
+		arg.ExpiresAt,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const completeIdempotencyKey = `-- name: CompleteIdempotencyKey :exec
+UPDATE idempotency_keys SET response_type = $1, response = $2, completed_at = NOW() WHERE id = $3 AND completed_at IS NULL
+`
+
+type CompleteIdempotencyKeyParams struct {
+	ResponseType sql.NullString
+	Response     []byte
+	ID           string
+}
+
+func (q *Queries) CompleteIdempotencyKey(ctx context.Context, db DBTX, arg *CompleteIdempotencyKeyParams) error {
+	_, err := db.ExecContext(ctx, completeIdempotencyKey, arg.ResponseType, arg.Response, arg.ID)
+	return err
+}
+
 const createQueueTestMessage = `-- name: CreateQueueTestMessage :exec
 INSERT INTO queue_test_messages (id, queue_name) VALUES ($1, $2)
 `
@@ -32,6 +90,64 @@ func (q *Queries) CreateQueueTestMessage(ctx context.Context, db DBTX, arg *Crea
 	return err
 }
 
+const deleteExpiredAuditLogEntries = `-- name: DeleteExpiredAuditLogEntries :execrows
+DELETE FROM audit_log_entries WHERE created_at < $1 AND sequence IS NULL AND NOT (resource_type = ANY($2::text[]))
+`
+
+type DeleteExpiredAuditLogEntriesParams struct {
+	Cutoff                time.Time
+	ExcludedResourceTypes []string
+}
+
+func (q *Queries) DeleteExpiredAuditLogEntries(ctx context.Context, db DBTX, arg *DeleteExpiredAuditLogEntriesParams) (int64, error) {
+	result, err := db.ExecContext(ctx, deleteExpiredAuditLogEntries, arg.Cutoff, pq.Array(arg.ExcludedResourceTypes))
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteExpiredAuditLogEntriesForResourceType = `-- name: DeleteExpiredAuditLogEntriesForResourceType :execrows
+DELETE FROM audit_log_entries WHERE created_at < $1 AND sequence IS NULL AND resource_type = $2
+`
+
+type DeleteExpiredAuditLogEntriesForResourceTypeParams struct {
+	Cutoff       time.Time
+	ResourceType string
+}
+
+func (q *Queries) DeleteExpiredAuditLogEntriesForResourceType(ctx context.Context, db DBTX, arg *DeleteExpiredAuditLogEntriesForResourceTypeParams) (int64, error) {
+	result, err := db.ExecContext(ctx, deleteExpiredAuditLogEntriesForResourceType, arg.Cutoff, arg.ResourceType)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteExpiredIdempotencyKeys = `-- name: DeleteExpiredIdempotencyKeys :execrows
+DELETE FROM idempotency_keys WHERE expires_at < NOW()
+`
+
+func (q *Queries) DeleteExpiredIdempotencyKeys(ctx context.Context, db DBTX) (int64, error) {
+	result, err := db.ExecContext(ctx, deleteExpiredIdempotencyKeys)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteExpiredMealPlanActivities = `-- name: DeleteExpiredMealPlanActivities :execrows
+DELETE FROM meal_plan_activities WHERE created_at < (NOW() - interval '30 days')
+`
+
+func (q *Queries) DeleteExpiredMealPlanActivities(ctx context.Context, db DBTX) (int64, error) {
+	result, err := db.ExecContext(ctx, deleteExpiredMealPlanActivities)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const deleteExpiredOAuth2ClientTokens = `-- name: DeleteExpiredOAuth2ClientTokens :execrows
 DELETE FROM oauth2_client_tokens WHERE code_expires_at < (NOW() - interval '1 day') AND access_expires_at < (NOW() - interval '1 day') AND refresh_expires_at < (NOW() - interval '1 day')
 `
@@ -44,8 +160,32 @@ func (q *Queries) DeleteExpiredOAuth2ClientTokens(ctx context.Context, db DBTX)
 	return result.RowsAffected()
 }
 
+const deleteExpiredUserNotifications = `-- name: DeleteExpiredUserNotifications :execrows
+DELETE FROM user_notifications WHERE (status != 'unread' AND COALESCE(last_updated_at, created_at) < (NOW() - interval '30 days')) OR created_at < (NOW() - interval '180 days')
+`
+
+func (q *Queries) DeleteExpiredUserNotifications(ctx context.Context, db DBTX) (int64, error) {
+	result, err := db.ExecContext(ctx, deleteExpiredUserNotifications)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const deleteExpiredWebhookDeliveries = `-- name: DeleteExpiredWebhookDeliveries :execrows
+DELETE FROM webhook_deliveries WHERE created_at < (NOW() - interval '30 days') AND next_attempt_at IS NULL
+`
+
+func (q *Queries) DeleteExpiredWebhookDeliveries(ctx context.Context, db DBTX) (int64, error) {
+	result, err := db.ExecContext(ctx, deleteExpiredWebhookDeliveries)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const destroyAllData = `-- name: DestroyAllData :exec
-TRUNCATE account_instrument_ownerships, account_invitations, account_user_memberships, accounts, audit_log_entries, comments, issue_reports, meal_components, meal_list_items, meal_lists, meal_plan_events, meal_plan_grocery_list_items, meal_plan_option_votes, meal_plan_options, meal_plan_recipe_option_selections, meal_plan_tasks, meal_plans, meals, oauth2_client_tokens, oauth2_clients, password_reset_tokens, payment_transactions, permissions, products, purchases, queue_test_messages, recipe_list_items, recipe_lists, recipe_media, recipe_prep_task_steps, recipe_prep_tasks, recipe_ratings, recipe_step_completion_condition_ingredients, recipe_step_completion_conditions, recipe_step_ingredients, recipe_step_instruments, recipe_step_products, recipe_step_vessels, recipe_steps, recipes, service_setting_configurations, service_settings, subscriptions, uploaded_media, user_avatars, user_data_disclosures, user_ingredient_preferences, user_notifications, user_role_assignments, user_role_hierarchy, user_role_permissions, user_roles, user_sessions, users, valid_ingredient_group_members, valid_ingredient_groups, valid_ingredient_measurement_units, valid_ingredient_preparations, valid_ingredient_state_ingredients, valid_ingredient_states, valid_ingredients, valid_instruments, valid_measurement_unit_conversions, valid_measurement_units, valid_prep_task_configs, valid_preparation_instruments, valid_preparation_vessels, valid_preparations, valid_vessels, waitlist_signups, waitlists, webhook_trigger_configs, webhook_trigger_events, webhooks CASCADE
+TRUNCATE account_instrument_ownerships, account_invitations, account_user_memberships, accounts, audit_log_checkpoints, audit_log_entries, comments, idempotency_keys, issue_reports, meal_components, meal_list_items, meal_lists, meal_plan_activities, meal_plan_calendar_feeds, meal_plan_event_tally_reports, meal_plan_events, meal_plan_grocery_list_items, meal_plan_option_recipe_revisions, meal_plan_option_votes, meal_plan_options, meal_plan_recipe_option_selections, meal_plan_tasks, meal_plan_templates, meal_plans, meals, notification_preferences, oauth2_client_tokens, oauth2_clients, oidc_signing_keys, pantry_items, password_reset_tokens, payment_provider_events, payment_transactions, permissions, products, purchases, queue_test_messages, queued_notifications, recipe_list_items, recipe_lists, recipe_media, recipe_prep_task_steps, recipe_prep_tasks, recipe_ratings, recipe_revisions, recipe_step_completion_condition_ingredients, recipe_step_completion_conditions, recipe_step_ingredients, recipe_step_instruments, recipe_step_products, recipe_step_vessels, recipe_steps, recipes, service_setting_configurations, service_settings, subscriptions, uploaded_media, user_avatars, user_data_disclosures, user_ingredient_preferences, user_notifications, user_role_assignments, user_role_hierarchy, user_role_permissions, user_roles, user_sessions, users, valid_ingredient_group_members, valid_ingredient_groups, valid_ingredient_measurement_units, valid_ingredient_nutrition_facts, valid_ingredient_preparations, valid_ingredient_state_ingredients, valid_ingredient_states, valid_ingredients, valid_instruments, valid_measurement_unit_conversions, valid_measurement_units, valid_prep_task_configs, valid_preparation_instruments, valid_preparation_vessels, valid_preparations, valid_vessels, waitlist_signups, waitlists, webhook_deliveries, webhook_trigger_configs, webhook_trigger_events, webhooks CASCADE
 `
 
 func (q *Queries) DestroyAllData(ctx context.Context, db DBTX) error {
@@ -53,6 +193,38 @@ func (q *Queries) DestroyAllData(ctx context.Context, db DBTX) error {
 	return err
 }
 
+const getIdempotencyKey = `-- name: GetIdempotencyKey :one
+SELECT id, idempotency_key, method, scope, request_fingerprint, response_type, response, created_at, completed_at, expires_at FROM idempotency_keys
+WHERE scope = $1
+	AND method = $2
+	AND idempotency_key = $3
+	AND expires_at > NOW()
+`
+
+type GetIdempotencyKeyParams struct {
+	Scope          string
+	Method         string
+	IdempotencyKey string
+}
+
+func (q *Queries) GetIdempotencyKey(ctx context.Context, db DBTX, arg *GetIdempotencyKeyParams) (*IdempotencyKeys, error) {
+	row := db.QueryRowContext(ctx, getIdempotencyKey, arg.Scope, arg.Method, arg.IdempotencyKey)
+	var i IdempotencyKeys
+	err := row.Scan(
+		&i.ID,
+		&i.IdempotencyKey,
+		&i.Method,
+		&i.Scope,
+		&i.RequestFingerprint,
+		&i.ResponseType,
+		&i.Response,
+		&i.CreatedAt,
+		&i.CompletedAt,
+		&i.ExpiresAt,
+	)
+	return &i, err
+}
+
 const getQueueTestMessage = `-- name: GetQueueTestMessage :one
 SELECT id, queue_name, created_at, acknowledged_at FROM queue_test_messages WHERE id = $1
 `
@@ -84,3 +256,46 @@ func (q *Queries) PruneQueueTestMessages(ctx context.Context, db DBTX, queueName
 	_, err := db.ExecContext(ctx, pruneQueueTestMessages, queueName)
 	return err
 }
+
+const redactExpiredAuditLogEntries = `-- name: RedactExpiredAuditLogEntries :execrows
+UPDATE audit_log_entries SET changes = '{}', redacted_at = NOW() WHERE created_at < $1 AND sequence IS NOT NULL AND redacted_at IS NULL AND NOT (resource_type = ANY($2::text[]))
+`
+
+type RedactExpiredAuditLogEntriesParams struct {
+	Cutoff                time.Time
+	ExcludedResourceTypes []string
+}
+
+func (q *Queries) RedactExpiredAuditLogEntries(ctx context.Context, db DBTX, arg *RedactExpiredAuditLogEntriesParams) (int64, error) {
+	result, err := db.ExecContext(ctx, redactExpiredAuditLogEntries, arg.Cutoff, pq.Array(arg.ExcludedResourceTypes))
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const redactExpiredAuditLogEntriesForResourceType = `-- name: RedactExpiredAuditLogEntriesForResourceType :execrows
+UPDATE audit_log_entries SET changes = '{}', redacted_at = NOW() WHERE created_at < $1 AND sequence IS NOT NULL AND redacted_at IS NULL AND resource_type = $2
+`
+
+type RedactExpiredAuditLogEntriesForResourceTypeParams struct {
+	Cutoff       time.Time
+	ResourceType string
+}
+
+func (q *Queries) RedactExpiredAuditLogEntriesForResourceType(ctx context.Context, db DBTX, arg *RedactExpiredAuditLogEntriesForResourceTypeParams) (int64, error) {
+	result, err := db.ExecContext(ctx, redactExpiredAuditLogEntriesForResourceType, arg.Cutoff, arg.ResourceType)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+const releaseIdempotencyKey = `-- name: ReleaseIdempotencyKey :exec
+DELETE FROM idempotency_keys WHERE id = $1 AND completed_at IS NULL
+`
+
+func (q *Queries) ReleaseIdempotencyKey(ctx context.Context, db DBTX, id string) error {
+	_, err := db.ExecContext(ctx, releaseIdempotencyKey, id)
+	return err
+}
@@ -9,6 +9,19 @@
 	"time"
 )
 
+type IdempotencyKeys struct {
+	ID                 string
+	IdempotencyKey     string
+	Method             string
+	Scope              string
+	RequestFingerprint string
+	ResponseType       sql.NullString
+	Response           []byte
+	CreatedAt          time.Time
+	CompletedAt        sql.NullTime
+	ExpiresAt          time.Time
+}
+
 type QueueTestMessages struct {
 	ID             string
 	QueueName      string
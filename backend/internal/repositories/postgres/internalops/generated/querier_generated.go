@@ -10,11 +10,23 @@
 
 type Querier interface {
 	AcknowledgeQueueTestMessage(ctx context.Context, db DBTX, id string) error
+	ClaimIdempotencyKey(ctx context.Context, db DBTX, arg *ClaimIdempotencyKeyParams) (int64, error)
+	CompleteIdempotencyKey(ctx context.Context, db DBTX, arg *CompleteIdempotencyKeyParams) error
 	CreateQueueTestMessage(ctx context.Context, db DBTX, arg *CreateQueueTestMessageParams) error
+	DeleteExpiredAuditLogEntries(ctx context.Context, db DBTX, arg *DeleteExpiredAuditLogEntriesParams) (int64, error)
+	DeleteExpiredAuditLogEntriesForResourceType(ctx context.Context, db DBTX, arg *DeleteExpiredAuditLogEntriesForResourceTypeParams) (int64, error)
+	DeleteExpiredIdempotencyKeys(ctx context.Context, db DBTX) (int64, error)
+	DeleteExpiredMealPlanActivities(ctx context.Context, db DBTX) (int64, error)
 	DeleteExpiredOAuth2ClientTokens(ctx context.Context, db DBTX) (int64, error)
+	DeleteExpiredUserNotifications(ctx context.Context, db DBTX) (int64, error)
+	DeleteExpiredWebhookDeliveries(ctx context.Context, db DBTX) (int64, error)
 	DestroyAllData(ctx context.Context, db DBTX) error
+	GetIdempotencyKey(ctx context.Context, db DBTX, arg *GetIdempotencyKeyParams) (*IdempotencyKeys, error)
 	GetQueueTestMessage(ctx context.Context, db DBTX, id string) (*QueueTestMessages, error)
 	PruneQueueTestMessages(ctx context.Context, db DBTX, queueName string) error
+	RedactExpiredAuditLogEntries(ctx context.Context, db DBTX, arg *RedactExpiredAuditLogEntriesParams) (int64, error)
+	RedactExpiredAuditLogEntriesForResourceType(ctx context.Context, db DBTX, arg *RedactExpiredAuditLogEntriesForResourceTypeParams) (int64, error)
+	ReleaseIdempotencyKey(ctx context.Context, db DBTX, id string) error
 }
 
 var _ Querier = (*Queries)(nil)
@@ -0,0 +1,112 @@
+package internalops
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/internalops"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/repositories/postgres/internalops/generated"
+
+	platformerrors "github.com/primandproper/platform/errors"
+	"github.com/primandproper/platform/observability"
+)
+
+var _ internalops.IdempotencyKeyDataManager = (*repository)(nil)
+
+func (q *repository) ClaimIdempotencyKey(ctx context.Context, input *internalops.IdempotencyKeyClaimInput) (bool, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if input == nil {
+		return false, platformerrors.ErrNilInputProvided
+	}
+
+	if input.ID == "" || input.Key == "" || input.Method == "" || input.Scope == "" {
+		return false, platformerrors.ErrInvalidIDProvided
+	}
+
+	claimed, err := q.generatedQuerier.ClaimIdempotencyKey(ctx, q.writeDB, &generated.ClaimIdempotencyKeyParams{
+		ID:                 input.ID,
+		IdempotencyKey:     input.Key,
+		Method:             input.Method,
+		Scope:              input.Scope,
+		RequestFingerprint: input.RequestFingerprint,
+		ExpiresAt:          input.ExpiresAt,
+	})
+	if err != nil {
+		return false, observability.PrepareError(err, span, "claiming idempotency key")
+	}
+
+	return claimed > 0, nil
+}
+
+func (q *repository) GetIdempotencyKey(ctx context.Context, scope, method, key string) (*internalops.IdempotencyKey, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if scope == "" || method == "" || key == "" {
+		return nil, platformerrors.ErrInvalidIDProvided
+	}
+
+	// read from the writer, since the key was usually claimed a moment ago.
+	row, err := q.generatedQuerier.GetIdempotencyKey(ctx, q.writeDB, &generated.GetIdempotencyKeyParams{
+		Scope:          scope,
+		Method:         method,
+		IdempotencyKey: key,
+	})
+	if err != nil {
+		return nil, observability.PrepareError(err, span, "getting idempotency key")
+	}
+
+	result := &internalops.IdempotencyKey{
+		CreatedAt:          row.CreatedAt,
+		ExpiresAt:          row.ExpiresAt,
+		ID:                 row.ID,
+		Key:                row.IdempotencyKey,
+		Method:             row.Method,
+		Scope:              row.Scope,
+		RequestFingerprint: row.RequestFingerprint,
+		ResponseType:       row.ResponseType.String,
+		Response:           row.Response,
+	}
+
+	if row.CompletedAt.Valid {
+		result.CompletedAt = &row.CompletedAt.Time
+	}
+
+	return result, nil
+}
+
+func (q *repository) CompleteIdempotencyKey(ctx context.Context, id, responseType string, response []byte) error {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if id == "" {
+		return platformerrors.ErrInvalidIDProvided
+	}
+
+	if err := q.generatedQuerier.CompleteIdempotencyKey(ctx, q.writeDB, &generated.CompleteIdempotencyKeyParams{
+		ID:           id,
+		ResponseType: sql.NullString{String: responseType, Valid: true},
+		Response:     response,
+	}); err != nil {
+		return observability.PrepareError(err, span, "completing idempotency key")
+	}
+
+	return nil
+}
+
+func (q *repository) ReleaseIdempotencyKey(ctx context.Context, id string) error {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if id == "" {
+		return platformerrors.ErrInvalidIDProvided
+	}
+
+	if err := q.generatedQuerier.ReleaseIdempotencyKey(ctx, q.writeDB, id); err != nil {
+		return observability.PrepareError(err, span, "releasing idempotency key")
+	}
+
+	return nil
+}
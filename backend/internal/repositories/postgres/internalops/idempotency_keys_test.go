@@ -0,0 +1,246 @@
+package internalops
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/internalops"
+	pgtesting "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/repositories/postgres/testing"
+
+	platformerrors "github.com/primandproper/platform/errors"
+	"github.com/primandproper/platform/identifiers"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// --- Unit tests (validation, no DB required) ---
+
+func TestClaimIdempotencyKey(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with nil input", func(t *testing.T) {
+		t.Parallel()
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		claimed, err := c.ClaimIdempotencyKey(ctx, nil)
+		assert.False(t, claimed)
+		assert.ErrorIs(t, err, platformerrors.ErrNilInputProvided)
+	})
+
+	T.Run("with empty key", func(t *testing.T) {
+		t.Parallel()
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		claimed, err := c.ClaimIdempotencyKey(ctx, &internalops.IdempotencyKeyClaimInput{
+			ID:     identifiers.New(),
+			Method: "/things.Service/CreateThing",
+			Scope:  identifiers.New(),
+		})
+		assert.False(t, claimed)
+		assert.ErrorIs(t, err, platformerrors.ErrInvalidIDProvided)
+	})
+}
+
+func TestGetIdempotencyKey(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with empty key", func(t *testing.T) {
+		t.Parallel()
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		actual, err := c.GetIdempotencyKey(ctx, identifiers.New(), "/things.Service/CreateThing", "")
+		assert.Nil(t, actual)
+		assert.ErrorIs(t, err, platformerrors.ErrInvalidIDProvided)
+	})
+}
+
+func TestCompleteIdempotencyKey(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with empty id", func(t *testing.T) {
+		t.Parallel()
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		err := c.CompleteIdempotencyKey(ctx, "", "things.Thing", nil)
+		assert.ErrorIs(t, err, platformerrors.ErrInvalidIDProvided)
+	})
+}
+
+func TestReleaseIdempotencyKey(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with empty id", func(t *testing.T) {
+		t.Parallel()
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		err := c.ReleaseIdempotencyKey(ctx, "")
+		assert.ErrorIs(t, err, platformerrors.ErrInvalidIDProvided)
+	})
+}
+
+// --- Integration tests (require DB container) ---
+
+func TestQuerier_Integration_IdempotencyKeys(t *testing.T) {
+	if !pgtesting.RunContainerTests {
+		t.SkipNow()
+	}
+
+	ctx := t.Context()
+	dbc, container := buildDatabaseClientForTest(t)
+
+	_, err := container.ConnectionString(ctx)
+	require.NoError(t, err)
+
+	defer func(t *testing.T) {
+		t.Helper()
+		assert.NoError(t, container.Terminate(ctx))
+	}(t)
+
+	input := &internalops.IdempotencyKeyClaimInput{
+		ID:                 identifiers.New(),
+		Key:                identifiers.New(),
+		Method:             "/things.Service/CreateThing",
+		Scope:              identifiers.New(),
+		RequestFingerprint: "fingerprint",
+		ExpiresAt:          time.Now().Add(time.Hour),
+	}
+
+	// Claim
+	claimed, err := dbc.ClaimIdempotencyKey(ctx, input)
+	require.NoError(t, err)
+	assert.True(t, claimed)
+
+	// Claiming again loses
+	claimed, err = dbc.ClaimIdempotencyKey(ctx, &internalops.IdempotencyKeyClaimInput{
+		ID:                 identifiers.New(),
+		Key:                input.Key,
+		Method:             input.Method,
+		Scope:              input.Scope,
+		RequestFingerprint: "other fingerprint",
+		ExpiresAt:          input.ExpiresAt,
+	})
+	require.NoError(t, err)
+	assert.False(t, claimed)
+
+	// Get while in flight
+	key, err := dbc.GetIdempotencyKey(ctx, input.Scope, input.Method, input.Key)
+	require.NoError(t, err)
+	assert.Equal(t, input.ID, key.ID)
+	assert.Equal(t, input.RequestFingerprint, key.RequestFingerprint)
+	assert.Nil(t, key.CompletedAt)
+
+	// Complete
+	require.NoError(t, dbc.CompleteIdempotencyKey(ctx, input.ID, "things.Thing", []byte("response")))
+
+	key, err = dbc.GetIdempotencyKey(ctx, input.Scope, input.Method, input.Key)
+	require.NoError(t, err)
+	assert.NotNil(t, key.CompletedAt)
+	assert.Equal(t, "things.Thing", key.ResponseType)
+	assert.Equal(t, []byte("response"), key.Response)
+
+	// Completed keys aren't released
+	require.NoError(t, dbc.ReleaseIdempotencyKey(ctx, input.ID))
+
+	key, err = dbc.GetIdempotencyKey(ctx, input.Scope, input.Method, input.Key)
+	require.NoError(t, err)
+	assert.NotNil(t, key)
+}
+
+func TestQuerier_Integration_IdempotencyKeys_Release(t *testing.T) {
+	if !pgtesting.RunContainerTests {
+		t.SkipNow()
+	}
+
+	ctx := t.Context()
+	dbc, container := buildDatabaseClientForTest(t)
+
+	_, err := container.ConnectionString(ctx)
+	require.NoError(t, err)
+
+	defer func(t *testing.T) {
+		t.Helper()
+		assert.NoError(t, container.Terminate(ctx))
+	}(t)
+
+	input := &internalops.IdempotencyKeyClaimInput{
+		ID:                 identifiers.New(),
+		Key:                identifiers.New(),
+		Method:             "/things.Service/CreateThing",
+		Scope:              identifiers.New(),
+		RequestFingerprint: "fingerprint",
+		ExpiresAt:          time.Now().Add(time.Hour),
+	}
+
+	claimed, err := dbc.ClaimIdempotencyKey(ctx, input)
+	require.NoError(t, err)
+	require.True(t, claimed)
+
+	require.NoError(t, dbc.ReleaseIdempotencyKey(ctx, input.ID))
+
+	key, err := dbc.GetIdempotencyKey(ctx, input.Scope, input.Method, input.Key)
+	assert.Nil(t, key)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+
+	// a released key can be claimed again
+	input.ID = identifiers.New()
+	claimed, err = dbc.ClaimIdempotencyKey(ctx, input)
+	require.NoError(t, err)
+	assert.True(t, claimed)
+}
+
+func TestQuerier_Integration_IdempotencyKeys_Expired(t *testing.T) {
+	if !pgtesting.RunContainerTests {
+		t.SkipNow()
+	}
+
+	ctx := t.Context()
+	dbc, container := buildDatabaseClientForTest(t)
+
+	_, err := container.ConnectionString(ctx)
+	require.NoError(t, err)
+
+	defer func(t *testing.T) {
+		t.Helper()
+		assert.NoError(t, container.Terminate(ctx))
+	}(t)
+
+	input := &internalops.IdempotencyKeyClaimInput{
+		ID:                 identifiers.New(),
+		Key:                identifiers.New(),
+		Method:             "/things.Service/CreateThing",
+		Scope:              identifiers.New(),
+		RequestFingerprint: "fingerprint",
+		ExpiresAt:          time.Now().Add(-time.Minute),
+	}
+
+	claimed, err := dbc.ClaimIdempotencyKey(ctx, input)
+	require.NoError(t, err)
+	require.True(t, claimed)
+
+	// expired keys can't be fetched, but can be reclaimed
+	key, err := dbc.GetIdempotencyKey(ctx, input.Scope, input.Method, input.Key)
+	assert.Nil(t, key)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+
+	input.ID, input.ExpiresAt = identifiers.New(), time.Now().Add(time.Hour)
+	claimed, err = dbc.ClaimIdempotencyKey(ctx, input)
+	require.NoError(t, err)
+	assert.True(t, claimed)
+
+	// pruning
+	input.ID, input.Key, input.ExpiresAt = identifiers.New(), identifiers.New(), time.Now().Add(-time.Minute)
+	claimed, err = dbc.ClaimIdempotencyKey(ctx, input)
+	require.NoError(t, err)
+	require.True(t, claimed)
+
+	deleted, err := dbc.DeleteExpiredIdempotencyKeys(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), deleted)
+}
@@ -3,6 +3,10 @@
 import (
 	"context"
 
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/audit"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/repositories/postgres/internalops/generated"
+
+	platformerrors "github.com/primandproper/platform/errors"
 	"github.com/primandproper/platform/observability"
 )
 
@@ -20,3 +24,129 @@ func (q *repository) DeleteExpiredOAuth2ClientTokens(ctx context.Context) (int64
 
 	return deleted, nil
 }
+
+// DeleteExpiredIdempotencyKeys deletes expired idempotency keys.
+func (q *repository) DeleteExpiredIdempotencyKeys(ctx context.Context) (int64, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	deleted, err := q.generatedQuerier.DeleteExpiredIdempotencyKeys(ctx, q.writeDB)
+	if err != nil {
+		return 0, observability.PrepareError(err, span, "deleting expired idempotency keys")
+	}
+
+	q.logger.Info("deleted expired idempotency keys")
+
+	return deleted, nil
+}
+
+// DeleteExpiredUserNotifications deletes user notifications that have outlived their retention period.
+func (q *repository) DeleteExpiredUserNotifications(ctx context.Context) (int64, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	deleted, err := q.generatedQuerier.DeleteExpiredUserNotifications(ctx, q.writeDB)
+	if err != nil {
+		return 0, observability.PrepareError(err, span, "deleting expired user notifications")
+	}
+
+	q.logger.Info("deleted expired user notifications")
+
+	return deleted, nil
+}
+
+// DeleteExpiredWebhookDeliveries deletes webhook delivery attempts that have outlived their retention period.
+// Attempts with a retry still pending are kept until the retry is made.
+func (q *repository) DeleteExpiredWebhookDeliveries(ctx context.Context) (int64, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	deleted, err := q.generatedQuerier.DeleteExpiredWebhookDeliveries(ctx, q.writeDB)
+	if err != nil {
+		return 0, observability.PrepareError(err, span, "deleting expired webhook deliveries")
+	}
+
+	q.logger.Info("deleted expired webhook deliveries")
+
+	return deleted, nil
+}
+
+// DeleteExpiredMealPlanActivities deletes meal plan activity that has outlived its retention period.
+func (q *repository) DeleteExpiredMealPlanActivities(ctx context.Context) (int64, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	deleted, err := q.generatedQuerier.DeleteExpiredMealPlanActivities(ctx, q.writeDB)
+	if err != nil {
+		return 0, observability.PrepareError(err, span, "deleting expired meal plan activities")
+	}
+
+	q.logger.Info("deleted expired meal plan activities")
+
+	return deleted, nil
+}
+
+// DeleteExpiredAuditLogEntries deletes audit log entries that have outlived their resource type's retention period.
+// Entries in an account's hash chain can't be deleted without breaking it, so they have their changes redacted instead.
+func (q *repository) DeleteExpiredAuditLogEntries(ctx context.Context, retention *audit.RetentionConfig) (int64, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if retention == nil {
+		return 0, platformerrors.ErrNilInputProvided
+	}
+
+	now := q.CurrentTime()
+	overriddenResourceTypes := retention.OverriddenResourceTypes()
+
+	var deleted int64
+	for _, resourceType := range overriddenResourceTypes {
+		period := retention.ResourceTypes[resourceType]
+		if period == 0 {
+			continue
+		}
+
+		count, err := q.generatedQuerier.DeleteExpiredAuditLogEntriesForResourceType(ctx, q.writeDB, &generated.DeleteExpiredAuditLogEntriesForResourceTypeParams{
+			Cutoff:       now.Add(-period),
+			ResourceType: resourceType,
+		})
+		if err != nil {
+			return 0, observability.PrepareError(err, span, "deleting expired audit log entries for resource type %q", resourceType)
+		}
+		deleted += count
+
+		count, err = q.generatedQuerier.RedactExpiredAuditLogEntriesForResourceType(ctx, q.writeDB, &generated.RedactExpiredAuditLogEntriesForResourceTypeParams{
+			Cutoff:       now.Add(-period),
+			ResourceType: resourceType,
+		})
+		if err != nil {
+			return 0, observability.PrepareError(err, span, "redacting expired audit log entries for resource type %q", resourceType)
+		}
+		deleted += count
+	}
+
+	if retention.Default > 0 {
+		// a nil slice would be sent as NULL, and nothing is NOT ANY(NULL).
+		count, err := q.generatedQuerier.DeleteExpiredAuditLogEntries(ctx, q.writeDB, &generated.DeleteExpiredAuditLogEntriesParams{
+			Cutoff:                now.Add(-retention.Default),
+			ExcludedResourceTypes: append([]string{}, overriddenResourceTypes...),
+		})
+		if err != nil {
+			return 0, observability.PrepareError(err, span, "deleting expired audit log entries")
+		}
+		deleted += count
+
+		count, err = q.generatedQuerier.RedactExpiredAuditLogEntries(ctx, q.writeDB, &generated.RedactExpiredAuditLogEntriesParams{
+			Cutoff:                now.Add(-retention.Default),
+			ExcludedResourceTypes: append([]string{}, overriddenResourceTypes...),
+		})
+		if err != nil {
+			return 0, observability.PrepareError(err, span, "redacting expired audit log entries")
+		}
+		deleted += count
+	}
+
+	q.logger.Info("deleted expired audit log entries")
+
+	return deleted, nil
+}
@@ -2,7 +2,9 @@
 
 import (
 	"testing"
+	"time"
 
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/audit"
 	pgtesting "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/repositories/postgres/testing"
 
 	"github.com/stretchr/testify/assert"
@@ -31,3 +33,134 @@ func TestQuerier_Integration_DeleteExpiredOAuth2ClientTokens(t *testing.T) {
 	assert.Zero(t, count)
 	assert.NoError(t, err)
 }
+
+func TestQuerier_Integration_DeleteExpiredIdempotencyKeys(t *testing.T) {
+	if !pgtesting.RunContainerTests {
+		t.SkipNow()
+	}
+
+	ctx := t.Context()
+	dbc, container := buildDatabaseClientForTest(t)
+
+	databaseURI, err := container.ConnectionString(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, databaseURI)
+
+	defer func(t *testing.T) {
+		t.Helper()
+		assert.NoError(t, container.Terminate(ctx))
+	}(t)
+
+	count, err := dbc.DeleteExpiredIdempotencyKeys(ctx)
+	assert.Zero(t, count)
+	assert.NoError(t, err)
+}
+
+func TestQuerier_Integration_DeleteExpiredUserNotifications(t *testing.T) {
+	if !pgtesting.RunContainerTests {
+		t.SkipNow()
+	}
+
+	ctx := t.Context()
+	dbc, container := buildDatabaseClientForTest(t)
+
+	databaseURI, err := container.ConnectionString(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, databaseURI)
+
+	defer func(t *testing.T) {
+		t.Helper()
+		assert.NoError(t, container.Terminate(ctx))
+	}(t)
+
+	count, err := dbc.DeleteExpiredUserNotifications(ctx)
+	assert.Zero(t, count)
+	assert.NoError(t, err)
+}
+
+func TestQuerier_Integration_DeleteExpiredWebhookDeliveries(t *testing.T) {
+	if !pgtesting.RunContainerTests {
+		t.SkipNow()
+	}
+
+	ctx := t.Context()
+	dbc, container := buildDatabaseClientForTest(t)
+
+	databaseURI, err := container.ConnectionString(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, databaseURI)
+
+	defer func(t *testing.T) {
+		t.Helper()
+		assert.NoError(t, container.Terminate(ctx))
+	}(t)
+
+	count, err := dbc.DeleteExpiredWebhookDeliveries(ctx)
+	assert.Zero(t, count)
+	assert.NoError(t, err)
+}
+
+func TestQuerier_Integration_DeleteExpiredMealPlanActivities(t *testing.T) {
+	if !pgtesting.RunContainerTests {
+		t.SkipNow()
+	}
+
+	ctx := t.Context()
+	dbc, container := buildDatabaseClientForTest(t)
+
+	databaseURI, err := container.ConnectionString(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, databaseURI)
+
+	defer func(t *testing.T) {
+		t.Helper()
+		assert.NoError(t, container.Terminate(ctx))
+	}(t)
+
+	count, err := dbc.DeleteExpiredMealPlanActivities(ctx)
+	assert.Zero(t, count)
+	assert.NoError(t, err)
+}
+
+func TestQuerier_Integration_DeleteExpiredAuditLogEntries(t *testing.T) {
+	if !pgtesting.RunContainerTests {
+		t.SkipNow()
+	}
+
+	ctx := t.Context()
+	dbc, container := buildDatabaseClientForTest(t)
+
+	databaseURI, err := container.ConnectionString(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, databaseURI)
+
+	defer func(t *testing.T) {
+		t.Helper()
+		assert.NoError(t, container.Terminate(ctx))
+	}(t)
+
+	count, err := dbc.DeleteExpiredAuditLogEntries(ctx, &audit.RetentionConfig{
+		Default: 365 * 24 * time.Hour,
+		ResourceTypes: map[string]time.Duration{
+			"webhook_deliveries": 30 * 24 * time.Hour,
+			"accounts":           0,
+		},
+	})
+	assert.Zero(t, count)
+	assert.NoError(t, err)
+}
+
+func TestQuerier_DeleteExpiredAuditLogEntries(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with nil retention config", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		count, err := c.DeleteExpiredAuditLogEntries(ctx, nil)
+		assert.Error(t, err)
+		assert.Zero(t, count)
+	})
+}
@@ -1,8 +1,32 @@
 -- name: DeleteExpiredOAuth2ClientTokens :execrows
 DELETE FROM oauth2_client_tokens WHERE code_expires_at < (NOW() - interval '1 day') AND access_expires_at < (NOW() - interval '1 day') AND refresh_expires_at < (NOW() - interval '1 day');
 
+-- name: DeleteExpiredIdempotencyKeys :execrows
+DELETE FROM idempotency_keys WHERE expires_at < NOW();
+
+-- name: DeleteExpiredUserNotifications :execrows
+DELETE FROM user_notifications WHERE (status != 'unread' AND COALESCE(last_updated_at, created_at) < (NOW() - interval '30 days')) OR created_at < (NOW() - interval '180 days');
+
+-- name: DeleteExpiredWebhookDeliveries :execrows
+DELETE FROM webhook_deliveries WHERE created_at < (NOW() - interval '30 days') AND next_attempt_at IS NULL;
+
+-- name: DeleteExpiredMealPlanActivities :execrows
+DELETE FROM meal_plan_activities WHERE created_at < (NOW() - interval '30 days');
+
+-- name: DeleteExpiredAuditLogEntries :execrows
+DELETE FROM audit_log_entries WHERE created_at < sqlc.arg(cutoff) AND sequence IS NULL AND NOT (resource_type = ANY(sqlc.arg(excluded_resource_types)::text[]));
+
+-- name: DeleteExpiredAuditLogEntriesForResourceType :execrows
+DELETE FROM audit_log_entries WHERE created_at < sqlc.arg(cutoff) AND sequence IS NULL AND resource_type = sqlc.arg(resource_type);
+
+-- name: RedactExpiredAuditLogEntries :execrows
+UPDATE audit_log_entries SET changes = '{}', redacted_at = NOW() WHERE created_at < sqlc.arg(cutoff) AND sequence IS NOT NULL AND redacted_at IS NULL AND NOT (resource_type = ANY(sqlc.arg(excluded_resource_types)::text[]));
+
+-- name: RedactExpiredAuditLogEntriesForResourceType :execrows
+UPDATE audit_log_entries SET changes = '{}', redacted_at = NOW() WHERE created_at < sqlc.arg(cutoff) AND sequence IS NOT NULL AND redacted_at IS NULL AND resource_type = sqlc.arg(resource_type);
+
 -- name: DestroyAllData :exec
-TRUNCATE account_instrument_ownerships, account_invitations, account_user_memberships, accounts, audit_log_entries, comments, issue_reports, meal_components, meal_list_items, meal_lists, meal_plan_events, meal_plan_grocery_list_items, meal_plan_option_votes, meal_plan_options, meal_plan_recipe_option_selections, meal_plan_tasks, meal_plans, meals, oauth2_client_tokens, oauth2_clients, password_reset_tokens, payment_transactions, permissions, products, purchases, queue_test_messages, recipe_list_items, recipe_lists, recipe_media, recipe_prep_task_steps, recipe_prep_tasks, recipe_ratings, recipe_step_completion_condition_ingredients, recipe_step_completion_conditions, recipe_step_ingredients, recipe_step_instruments, recipe_step_products, recipe_step_vessels, recipe_steps, recipes, service_setting_configurations, service_settings, subscriptions, uploaded_media, user_avatars, user_data_disclosures, user_ingredient_preferences, user_notifications, user_role_assignments, user_role_hierarchy, user_role_permissions, user_roles, user_sessions, users, valid_ingredient_group_members, valid_ingredient_groups, valid_ingredient_measurement_units, valid_ingredient_preparations, valid_ingredient_state_ingredients, valid_ingredient_states, valid_ingredients, valid_instruments, valid_measurement_unit_conversions, valid_measurement_units, valid_prep_task_configs, valid_preparation_instruments, valid_preparation_vessels, valid_preparations, valid_vessels, waitlist_signups, waitlists, webhook_trigger_configs, webhook_trigger_events, webhooks CASCADE;
+TRUNCATE account_instrument_ownerships, account_invitations, account_user_memberships, accounts, audit_log_checkpoints, audit_log_entries, comments, idempotency_keys, issue_reports, meal_components, meal_list_items, meal_lists, meal_plan_activities, meal_plan_calendar_feeds, meal_plan_event_tally_reports, meal_plan_events, meal_plan_grocery_list_items, meal_plan_option_recipe_revisions, meal_plan_option_votes, meal_plan_options, meal_plan_recipe_option_selections, meal_plan_tasks, meal_plan_templates, meal_plans, meals, notification_preferences, oauth2_client_tokens, oauth2_clients, oidc_signing_keys, pantry_items, password_reset_tokens, payment_provider_events, payment_transactions, permissions, products, purchases, queue_test_messages, queued_notifications, recipe_list_items, recipe_lists, recipe_media, recipe_prep_task_steps, recipe_prep_tasks, recipe_ratings, recipe_revisions, recipe_step_completion_condition_ingredients, recipe_step_completion_conditions, recipe_step_ingredients, recipe_step_instruments, recipe_step_products, recipe_step_vessels, recipe_steps, recipes, service_setting_configurations, service_settings, subscriptions, uploaded_media, user_avatars, user_data_disclosures, user_ingredient_preferences, user_notifications, user_role_assignments, user_role_hierarchy, user_role_permissions, user_roles, user_sessions, users, valid_ingredient_group_members, valid_ingredient_groups, valid_ingredient_measurement_units, valid_ingredient_nutrition_facts, valid_ingredient_preparations, valid_ingredient_state_ingredients, valid_ingredient_states, valid_ingredients, valid_instruments, valid_measurement_unit_conversions, valid_measurement_units, valid_prep_task_configs, valid_preparation_instruments, valid_preparation_vessels, valid_preparations, valid_vessels, waitlist_signups, waitlists, webhook_deliveries, webhook_trigger_configs, webhook_trigger_events, webhooks CASCADE;
 
 -- name: CreateQueueTestMessage :exec
 INSERT INTO queue_test_messages (id, queue_name) VALUES (sqlc.arg(id), sqlc.arg(queue_name));
@@ -22,3 +46,30 @@ WHERE qtm.queue_name = sqlc.arg(queue_name)
       ORDER BY keep.created_at DESC
       LIMIT 100
   );
+
+-- name: ClaimIdempotencyKey :execrows
+INSERT INTO idempotency_keys (id, idempotency_key, method, scope, request_fingerprint, expires_at)
+VALUES (sqlc.arg(id), sqlc.arg(idempotency_key), sqlc.arg(method), sqlc.arg(scope), sqlc.arg(request_fingerprint), sqlc.arg(expires_at))
+ON CONFLICT (scope, method, idempotency_key) DO UPDATE SET
+	id = EXCLUDED.id,
+	request_fingerprint = EXCLUDED.request_fingerprint,
+	response_type = NULL,
+	response = NULL,
+	created_at = NOW(),
+	completed_at = NULL,
+	expires_at = EXCLUDED.expires_at
+WHERE idempotency_keys.expires_at < NOW()
+	OR (idempotency_keys.completed_at IS NULL AND idempotency_keys.created_at < (NOW() - interval '5 minutes'));
+
+-- name: GetIdempotencyKey :one
+SELECT id, idempotency_key, method, scope, request_fingerprint, response_type, response, created_at, completed_at, expires_at FROM idempotency_keys
+WHERE scope = sqlc.arg(scope)
+	AND method = sqlc.arg(method)
+	AND idempotency_key = sqlc.arg(idempotency_key)
+	AND expires_at > NOW();
+
+-- name: CompleteIdempotencyKey :exec
+UPDATE idempotency_keys SET response_type = sqlc.arg(response_type), response = sqlc.arg(response), completed_at = NOW() WHERE id = sqlc.arg(id) AND completed_at IS NULL;
+
+-- name: ReleaseIdempotencyKey :exec
+DELETE FROM idempotency_keys WHERE id = sqlc.arg(id) AND completed_at IS NULL;
@@ -0,0 +1,129 @@
+package mealplanning
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	identitykeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity/keys"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+	mealplanningkeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/keys"
+
+	"github.com/primandproper/platform/database"
+	platformerrors "github.com/primandproper/platform/errors"
+	"github.com/primandproper/platform/observability"
+	"github.com/primandproper/platform/observability/tracing"
+)
+
+var (
+	_ mealplanning.AccountAllergenProfileDataManager = (*repository)(nil)
+)
+
+// GetAccountAllergenProfile fetches the allergies of an account's members and the account's allergen policy from the database.
+func (q *repository) GetAccountAllergenProfile(ctx context.Context, accountID string) (*mealplanning.AccountAllergenProfile, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if accountID == "" {
+		return nil, platformerrors.ErrInvalidIDProvided
+	}
+	logger := q.logger.WithValue(identitykeys.AccountIDKey, accountID)
+	tracing.AttachToSpan(span, identitykeys.AccountIDKey, accountID)
+
+	policy, err := q.generatedQuerier.GetMealPlanOptionAllergenPolicyForAccount(ctx, q.readDB, accountID)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			return nil, observability.PrepareAndLogError(err, logger, span, "fetching meal plan option allergen policy")
+		}
+		policy = mealplanning.MealPlanOptionAllergenPolicyWarn
+	}
+
+	results, err := q.generatedQuerier.GetAllergyUserIngredientPreferencesForAccount(ctx, q.readDB, accountID)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching account member allergies")
+	}
+
+	profile := &mealplanning.AccountAllergenProfile{
+		AccountID: accountID,
+		Policy:    policy,
+		Allergies: []*mealplanning.UserIngredientPreference{},
+	}
+
+	for _, result := range results {
+		profile.Allergies = append(profile.Allergies, &mealplanning.UserIngredientPreference{
+			CreatedAt:     result.CreatedAt,
+			LastUpdatedAt: database.TimePointerFromNullTime(result.LastUpdatedAt),
+			ArchivedAt:    database.TimePointerFromNullTime(result.ArchivedAt),
+			ID:            result.ID,
+			Notes:         result.Notes,
+			CreatedByUser: result.BelongsToUser,
+			Rating:        int8(result.Rating),
+			Allergy:       result.Allergy,
+			Ingredient: mealplanning.ValidIngredient{
+				CreatedAt:                      result.ValidIngredientCreatedAt,
+				LastUpdatedAt:                  database.TimePointerFromNullTime(result.ValidIngredientLastUpdatedAt),
+				ArchivedAt:                     database.TimePointerFromNullTime(result.ValidIngredientArchivedAt),
+				MinStorageTemperatureInCelsius: database.Float32PointerFromNullString(result.ValidIngredientMinimumIdealStorageTemperatureInCelsius),
+				MaxStorageTemperatureInCelsius: database.Float32PointerFromNullString(result.ValidIngredientMaximumIdealStorageTemperatureInCelsius),
+				IconPath:                       result.ValidIngredientIconPath,
+				Warning:                        result.ValidIngredientWarning,
+				PluralName:                     result.ValidIngredientPluralName,
+				StorageInstructions:            result.ValidIngredientStorageInstructions,
+				Name:                           result.ValidIngredientName,
+				ID:                             result.ValidIngredientID,
+				Description:                    result.ValidIngredientDescription,
+				Slug:                           result.ValidIngredientSlug,
+				ShoppingSuggestions:            result.ValidIngredientShoppingSuggestions,
+				ContainsShellfish:              result.ValidIngredientContainsShellfish,
+				IsLiquid:                       database.BoolFromNullBool(result.ValidIngredientIsLiquid),
+				ContainsPeanut:                 result.ValidIngredientContainsPeanut,
+				ContainsTreeNut:                result.ValidIngredientContainsTreeNut,
+				ContainsEgg:                    result.ValidIngredientContainsEgg,
+				ContainsWheat:                  result.ValidIngredientContainsWheat,
+				ContainsSoy:                    result.ValidIngredientContainsSoy,
+				AnimalDerived:                  result.ValidIngredientAnimalDerived,
+				RestrictToPreparations:         result.ValidIngredientRestrictToPreparations,
+				ContainsSesame:                 result.ValidIngredientContainsSesame,
+				ContainsFish:                   result.ValidIngredientContainsFish,
+				ContainsGluten:                 result.ValidIngredientContainsGluten,
+				ContainsDairy:                  result.ValidIngredientContainsDairy,
+				ContainsAlcohol:                result.ValidIngredientContainsAlcohol,
+				AnimalFlesh:                    result.ValidIngredientAnimalFlesh,
+				IsStarch:                       result.ValidIngredientIsStarch,
+				IsProtein:                      result.ValidIngredientIsProtein,
+				IsGrain:                        result.ValidIngredientIsGrain,
+				IsFruit:                        result.ValidIngredientIsFruit,
+				IsSalt:                         result.ValidIngredientIsSalt,
+				IsFat:                          result.ValidIngredientIsFat,
+				IsAcid:                         result.ValidIngredientIsAcid,
+				IsHeat:                         result.ValidIngredientIsHeat,
+			},
+		})
+	}
+
+	return profile, nil
+}
+
+// GetAccountAllergenProfileForMealPlanEvent fetches the allergen profile of the account that owns a meal plan event.
+func (q *repository) GetAccountAllergenProfileForMealPlanEvent(ctx context.Context, mealPlanEventID string) (*mealplanning.AccountAllergenProfile, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if mealPlanEventID == "" {
+		return nil, platformerrors.ErrInvalidIDProvided
+	}
+	logger := q.logger.WithValue(mealplanningkeys.MealPlanEventIDKey, mealPlanEventID)
+	tracing.AttachToSpan(span, mealplanningkeys.MealPlanEventIDKey, mealPlanEventID)
+
+	accountID, err := q.generatedQuerier.GetAccountIDForMealPlanEvent(ctx, q.readDB, mealPlanEventID)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching account for meal plan event")
+	}
+
+	profile, err := q.GetAccountAllergenProfile(ctx, accountID)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching account allergen profile")
+	}
+
+	return profile, nil
+}
@@ -0,0 +1,94 @@
+package mealplanning
+
+import (
+	"testing"
+
+	types "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/fakes"
+	pgtesting "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/repositories/postgres/testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuerier_Integration_AccountAllergenProfiles(t *testing.T) {
+	if !pgtesting.RunContainerTests {
+		t.SkipNow()
+	}
+
+	ctx := t.Context()
+	dbc, _, container := buildDatabaseClientForTest(t)
+
+	databaseURI, err := container.ConnectionString(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, databaseURI)
+
+	defer func(t *testing.T) {
+		t.Helper()
+		assert.NoError(t, container.Terminate(ctx))
+	}(t)
+
+	user := pgtesting.CreateUserForTest(t, nil, dbc.writeDB)
+	account := pgtesting.CreateAccountForTest(t, nil, user.ID, dbc.writeDB)
+
+	recipe := createRecipeForTest(t, ctx, nil, dbc, true)
+	buildMealForIntegrationTest(user.ID, recipe)
+	meal := createMealForTest(t, ctx, nil, dbc)
+
+	exampleMealPlan := buildMealPlanForIntegrationTest(user.ID, meal)
+	exampleMealPlan.BelongsToAccount = account.ID
+	mealPlan := createMealPlanForTest(t, ctx, exampleMealPlan, dbc)
+
+	allergy := fakes.BuildFakeUserIngredientPreference()
+	allergy.CreatedByUser = user.ID
+	allergy.Ingredient = *createValidIngredientForTest(t, ctx, nil, dbc)
+	allergy.Allergy = true
+	createUserIngredientPreferenceForTest(t, ctx, allergy, dbc)
+
+	nonAllergy := fakes.BuildFakeUserIngredientPreference()
+	nonAllergy.CreatedByUser = user.ID
+	nonAllergy.Ingredient = *createValidIngredientForTest(t, ctx, nil, dbc)
+	nonAllergy.Allergy = false
+	createUserIngredientPreferenceForTest(t, ctx, nonAllergy, dbc)
+
+	profile, err := dbc.GetAccountAllergenProfile(ctx, account.ID)
+	require.NoError(t, err)
+	assert.Equal(t, account.ID, profile.AccountID)
+	assert.Equal(t, types.MealPlanOptionAllergenPolicyWarn, profile.Policy)
+	require.Len(t, profile.Allergies, 1)
+	assert.Equal(t, allergy.Ingredient.ID, profile.Allergies[0].Ingredient.ID)
+
+	eventProfile, err := dbc.GetAccountAllergenProfileForMealPlanEvent(ctx, mealPlan.Events[0].ID)
+	require.NoError(t, err)
+	assert.Equal(t, profile, eventProfile)
+}
+
+func TestQuerier_GetAccountAllergenProfile(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid account ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		actual, err := c.GetAccountAllergenProfile(ctx, "")
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
+
+func TestQuerier_GetAccountAllergenProfileForMealPlanEvent(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid meal plan event ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		actual, err := c.GetAccountAllergenProfileForMealPlanEvent(ctx, "")
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
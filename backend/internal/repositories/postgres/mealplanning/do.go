@@ -24,6 +24,13 @@ func RegisterMealPlanningRepository(i do.Injector) {
 		), nil
 	})
 
+	do.Provide[domainmealplanning.MealPlanActivityNotifier](i, func(i do.Injector) (domainmealplanning.MealPlanActivityNotifier, error) {
+		return ProvideMealPlanActivityNotifier(
+			do.MustInvoke[logging.Logger](i),
+			do.MustInvoke[database.Client](i),
+		), nil
+	})
+
 	do.Provide[domainmealplanning.ValidEnumerationDataManager](i, func(i do.Injector) (domainmealplanning.ValidEnumerationDataManager, error) {
 		return ProvideValidEnumerationDataManager(do.MustInvoke[domainmealplanning.Repository](i)), nil
 	})
@@ -89,6 +89,23 @@ func (q *Queries) CreateMealPlanEvent(ctx context.Context, db DBTX, arg *CreateM
 	return err
 }
 
+const getAccountIDForMealPlanEvent = `-- name: GetAccountIDForMealPlanEvent :one
+SELECT
+	meal_plans.belongs_to_account
+FROM meal_plan_events
+	JOIN meal_plans ON meal_plan_events.belongs_to_meal_plan = meal_plans.id
+WHERE meal_plan_events.archived_at IS NULL
+	AND meal_plans.archived_at IS NULL
+	AND meal_plan_events.id = $1
+`
+
+func (q *Queries) GetAccountIDForMealPlanEvent(ctx context.Context, db DBTX, id string) (string, error) {
+	row := db.QueryRowContext(ctx, getAccountIDForMealPlanEvent, id)
+	var belongs_to_account string
+	err := row.Scan(&belongs_to_account)
+	return belongs_to_account, err
+}
+
 const getAllMealPlanEventsForMealPlan = `-- name: GetAllMealPlanEventsForMealPlan :many
 SELECT
 	meal_plan_events.id,
@@ -71,6 +71,7 @@ func (q *Queries) CheckMealPlanOptionVoteExistence(ctx context.Context, db DBTX,
 INSERT INTO meal_plan_option_votes (
 	id,
 	rank,
+	score,
 	abstain,
 	notes,
 	by_user,
@@ -81,13 +82,15 @@ func (q *Queries) CheckMealPlanOptionVoteExistence(ctx context.Context, db DBTX,
 	$3,
 	$4,
 	$5,
-	$6
+	$6,
+	$7
 )
 `
 
 type CreateMealPlanOptionVoteParams struct {
 	ID                      string
 	Rank                    int32
+	Score                   int32
 	Abstain                 bool
 	Notes                   string
 	ByUser                  string
@@ -98,6 +101,7 @@ func (q *Queries) CreateMealPlanOptionVote(ctx context.Context, db DBTX, arg *Cr
 	_, err := db.ExecContext(ctx, createMealPlanOptionVote,
 		arg.ID,
 		arg.Rank,
+		arg.Score,
 		arg.Abstain,
 		arg.Notes,
 		arg.ByUser,
@@ -110,6 +114,7 @@ func (q *Queries) CreateMealPlanOptionVote(ctx context.Context, db DBTX, arg *Cr
 SELECT
 	meal_plan_option_votes.id,
 	meal_plan_option_votes.rank,
+	meal_plan_option_votes.score,
 	meal_plan_option_votes.abstain,
 	meal_plan_option_votes.notes,
 	meal_plan_option_votes.by_user,
@@ -151,6 +156,7 @@ func (q *Queries) GetMealPlanOptionVote(ctx context.Context, db DBTX, arg *GetMe
 	err := row.Scan(
 		&i.ID,
 		&i.Rank,
+		&i.Score,
 		&i.Abstain,
 		&i.Notes,
 		&i.ByUser,
@@ -166,6 +172,7 @@ func (q *Queries) GetMealPlanOptionVote(ctx context.Context, db DBTX, arg *GetMe
 SELECT
 	meal_plan_option_votes.id,
 	meal_plan_option_votes.rank,
+	meal_plan_option_votes.score,
 	meal_plan_option_votes.abstain,
 	meal_plan_option_votes.notes,
 	meal_plan_option_votes.by_user,
@@ -246,6 +253,7 @@ type GetMealPlanOptionVotesParams struct {
 type GetMealPlanOptionVotesRow struct {
 	ID                      string
 	Rank                    int32
+	Score                   int32
 	Abstain                 bool
 	Notes                   string
 	ByUser                  string
@@ -280,6 +288,7 @@ func (q *Queries) GetMealPlanOptionVotes(ctx context.Context, db DBTX, arg *GetM
 		if err := rows.Scan(
 			&i.ID,
 			&i.Rank,
+			&i.Score,
 			&i.Abstain,
 			&i.Notes,
 			&i.ByUser,
@@ -307,6 +316,7 @@ func (q *Queries) GetMealPlanOptionVotes(ctx context.Context, db DBTX, arg *GetM
 SELECT
 	meal_plan_option_votes.id,
 	meal_plan_option_votes.rank,
+	meal_plan_option_votes.score,
 	meal_plan_option_votes.abstain,
 	meal_plan_option_votes.notes,
 	meal_plan_option_votes.by_user,
@@ -348,6 +358,7 @@ func (q *Queries) GetMealPlanOptionVotesForMealPlanOption(ctx context.Context, d
 		if err := rows.Scan(
 			&i.ID,
 			&i.Rank,
+			&i.Score,
 			&i.Abstain,
 			&i.Notes,
 			&i.ByUser,
@@ -372,17 +383,19 @@ func (q *Queries) GetMealPlanOptionVotesForMealPlanOption(ctx context.Context, d
 const updateMealPlanOptionVote = `-- name: UpdateMealPlanOptionVote :execrows
 UPDATE meal_plan_option_votes SET
 	rank = $1,
-	abstain = $2,
-	notes = $3,
-	by_user = $4,
+	score = $2,
+	abstain = $3,
+	notes = $4,
+	by_user = $5,
 	last_updated_at = NOW()
 WHERE archived_at IS NULL
-	AND belongs_to_meal_plan_option = $5
-	AND id = $6
+	AND belongs_to_meal_plan_option = $6
+	AND id = $7
 `
 
 type UpdateMealPlanOptionVoteParams struct {
 	Rank                    int32
+	Score                   int32
 	Abstain                 bool
 	Notes                   string
 	ByUser                  string
@@ -393,6 +406,7 @@ type UpdateMealPlanOptionVoteParams struct {
 func (q *Queries) UpdateMealPlanOptionVote(ctx context.Context, db DBTX, arg *UpdateMealPlanOptionVoteParams) (int64, error) {
 	result, err := db.ExecContext(ctx, updateMealPlanOptionVote,
 		arg.Rank,
+		arg.Score,
 		arg.Abstain,
 		arg.Notes,
 		arg.ByUser,
@@ -84,6 +84,30 @@ func (q *Queries) CheckMealPlanOptionExistence(ctx context.Context, db DBTX, arg
 	return exists, err
 }
 
+const completeMealPlanOption = `-- name: CompleteMealPlanOption :execrows
+UPDATE meal_plan_options SET
+	completed_at = NOW(),
+	last_updated_at = NOW()
+WHERE archived_at IS NULL
+	AND completed_at IS NULL
+	AND chosen
+	AND belongs_to_meal_plan_event = $1
+	AND id = $2
+`
+
+type CompleteMealPlanOptionParams struct {
+	MealPlanEventID  sql.NullString
+	MealPlanOptionID string
+}
+
+func (q *Queries) CompleteMealPlanOption(ctx context.Context, db DBTX, arg *CompleteMealPlanOptionParams) (int64, error) {
+	result, err := db.ExecContext(ctx, completeMealPlanOption, arg.MealPlanEventID, arg.MealPlanOptionID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const createMealPlanOption = `-- name: CreateMealPlanOption :exec
 INSERT INTO meal_plan_options (
 	id,
@@ -165,6 +189,7 @@ func (q *Queries) FinalizeMealPlanOption(ctx context.Context, db DBTX, arg *Fina
 	meal_plan_options.last_updated_at,
 	meal_plan_options.archived_at,
 	meal_plan_options.belongs_to_meal_plan_event,
+	meal_plan_options.completed_at,
 	meals.id as meal_id,
 	meals.name as meal_name,
 	meals.description as meal_description,
@@ -207,6 +232,7 @@ type GetAllMealPlanOptionsForMealPlanEventRow struct {
 	LastUpdatedAt            sql.NullTime
 	ArchivedAt               sql.NullTime
 	BelongsToMealPlanEvent   sql.NullString
+	CompletedAt              sql.NullTime
 	MealID_2                 string
 	MealName                 string
 	MealDescription          string
@@ -242,6 +268,7 @@ func (q *Queries) GetAllMealPlanOptionsForMealPlanEvent(ctx context.Context, db
 			&i.LastUpdatedAt,
 			&i.ArchivedAt,
 			&i.BelongsToMealPlanEvent,
+			&i.CompletedAt,
 			&i.MealID_2,
 			&i.MealName,
 			&i.MealDescription,
@@ -281,6 +308,7 @@ func (q *Queries) GetAllMealPlanOptionsForMealPlanEvent(ctx context.Context, db
 	meal_plan_options.last_updated_at,
 	meal_plan_options.archived_at,
 	meal_plan_options.belongs_to_meal_plan_event,
+	meal_plan_options.completed_at,
 	meals.id as meal_id,
 	meals.name as meal_name,
 	meals.description as meal_description,
@@ -324,6 +352,7 @@ type GetMealPlanOptionRow struct {
 	LastUpdatedAt            sql.NullTime
 	ArchivedAt               sql.NullTime
 	BelongsToMealPlanEvent   sql.NullString
+	CompletedAt              sql.NullTime
 	MealID_2                 string
 	MealName                 string
 	MealDescription          string
@@ -353,6 +382,7 @@ func (q *Queries) GetMealPlanOption(ctx context.Context, db DBTX, arg *GetMealPl
 		&i.LastUpdatedAt,
 		&i.ArchivedAt,
 		&i.BelongsToMealPlanEvent,
+		&i.CompletedAt,
 		&i.MealID_2,
 		&i.MealName,
 		&i.MealDescription,
@@ -368,6 +398,25 @@ func (q *Queries) GetMealPlanOption(ctx context.Context, db DBTX, arg *GetMealPl
 	return &i, err
 }
 
+const getMealPlanOptionAllergenPolicyForAccount = `-- name: GetMealPlanOptionAllergenPolicyForAccount :one
+SELECT
+	COALESCE(service_setting_configurations.value, service_settings.default_value)::TEXT AS policy
+FROM service_settings
+	LEFT JOIN service_setting_configurations ON service_setting_configurations.service_setting_id = service_settings.id
+		AND service_setting_configurations.belongs_to_account = $1
+		AND service_setting_configurations.archived_at IS NULL
+WHERE service_settings.archived_at IS NULL
+	AND service_settings.name = 'meal_plan_option_allergen_policy'
+LIMIT 1
+`
+
+func (q *Queries) GetMealPlanOptionAllergenPolicyForAccount(ctx context.Context, db DBTX, belongsToAccount string) (string, error) {
+	row := db.QueryRowContext(ctx, getMealPlanOptionAllergenPolicyForAccount, belongsToAccount)
+	var policy string
+	err := row.Scan(&policy)
+	return policy, err
+}
+
 const getMealPlanOptionByID = `-- name: GetMealPlanOptionByID :one
 SELECT
 	meal_plan_options.id,
@@ -382,6 +431,7 @@ func (q *Queries) GetMealPlanOption(ctx context.Context, db DBTX, arg *GetMealPl
 	meal_plan_options.last_updated_at,
 	meal_plan_options.archived_at,
 	meal_plan_options.belongs_to_meal_plan_event,
+	meal_plan_options.completed_at,
 	meals.id as meal_id,
 	meals.name as meal_name,
 	meals.description as meal_description,
@@ -414,6 +464,7 @@ type GetMealPlanOptionByIDRow struct {
 	LastUpdatedAt            sql.NullTime
 	ArchivedAt               sql.NullTime
 	BelongsToMealPlanEvent   sql.NullString
+	CompletedAt              sql.NullTime
 	MealID_2                 string
 	MealName                 string
 	MealDescription          string
@@ -443,6 +494,7 @@ func (q *Queries) GetMealPlanOptionByID(ctx context.Context, db DBTX, mealPlanOp
 		&i.LastUpdatedAt,
 		&i.ArchivedAt,
 		&i.BelongsToMealPlanEvent,
+		&i.CompletedAt,
 		&i.MealID_2,
 		&i.MealName,
 		&i.MealDescription,
@@ -472,6 +524,7 @@ func (q *Queries) GetMealPlanOptionByID(ctx context.Context, db DBTX, mealPlanOp
 	meal_plan_options.last_updated_at,
 	meal_plan_options.archived_at,
 	meal_plan_options.belongs_to_meal_plan_event,
+	meal_plan_options.completed_at,
 	meals.id as meal_id,
 	meals.name as meal_name,
 	meals.description as meal_description,
@@ -558,6 +611,7 @@ type GetMealPlanOptionsRow struct {
 	LastUpdatedAt            sql.NullTime
 	ArchivedAt               sql.NullTime
 	BelongsToMealPlanEvent   sql.NullString
+	CompletedAt              sql.NullTime
 	MealID_2                 string
 	MealName                 string
 	MealDescription          string
@@ -605,6 +659,7 @@ func (q *Queries) GetMealPlanOptions(ctx context.Context, db DBTX, arg *GetMealP
 			&i.LastUpdatedAt,
 			&i.ArchivedAt,
 			&i.BelongsToMealPlanEvent,
+			&i.CompletedAt,
 			&i.MealID_2,
 			&i.MealName,
 			&i.MealDescription,
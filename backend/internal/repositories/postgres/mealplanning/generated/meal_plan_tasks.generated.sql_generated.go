@@ -133,6 +133,7 @@ func (q *Queries) CreateMealPlanTask(ctx context.Context, db DBTX, arg *CreateMe
 	meal_plan_options.last_updated_at as meal_plan_option_last_updated_at,
 	meal_plan_options.archived_at as meal_plan_option_archived_at,
 	meal_plan_options.belongs_to_meal_plan_event as meal_plan_option_belongs_to_meal_plan_event,
+	meal_plan_options.completed_at as meal_plan_option_completed_at,
 	recipe_prep_tasks.id as prep_task_id,
 	recipe_prep_tasks.name as prep_task_name,
 	recipe_prep_tasks.description as prep_task_description,
@@ -192,6 +193,7 @@ type GetMealPlanTaskRow struct {
 	MealPlanOptionLastUpdatedAt                    sql.NullTime
 	MealPlanOptionArchivedAt                       sql.NullTime
 	MealPlanOptionBelongsToMealPlanEvent           sql.NullString
+	MealPlanOptionCompletedAt                      sql.NullTime
 	PrepTaskID                                     string
 	PrepTaskName                                   string
 	PrepTaskDescription                            string
@@ -240,6 +242,7 @@ func (q *Queries) GetMealPlanTask(ctx context.Context, db DBTX, mealPlanTaskID s
 		&i.MealPlanOptionLastUpdatedAt,
 		&i.MealPlanOptionArchivedAt,
 		&i.MealPlanOptionBelongsToMealPlanEvent,
+		&i.MealPlanOptionCompletedAt,
 		&i.PrepTaskID,
 		&i.PrepTaskName,
 		&i.PrepTaskDescription,
@@ -341,6 +344,7 @@ func (q *Queries) GetMealPlanTaskIDsThatNeedNotification(ctx context.Context, db
 	meal_plan_options.last_updated_at as meal_plan_option_last_updated_at,
 	meal_plan_options.archived_at as meal_plan_option_archived_at,
 	meal_plan_options.belongs_to_meal_plan_event as meal_plan_option_belongs_to_meal_plan_event,
+	meal_plan_options.completed_at as meal_plan_option_completed_at,
 	recipe_prep_tasks.id as prep_task_id,
 	recipe_prep_tasks.name as prep_task_name,
 	recipe_prep_tasks.description as prep_task_description,
@@ -400,6 +404,7 @@ type ListAllMealPlanTasksByMealPlanRow struct {
 	MealPlanOptionLastUpdatedAt                    sql.NullTime
 	MealPlanOptionArchivedAt                       sql.NullTime
 	MealPlanOptionBelongsToMealPlanEvent           sql.NullString
+	MealPlanOptionCompletedAt                      sql.NullTime
 	PrepTaskID                                     string
 	PrepTaskName                                   string
 	PrepTaskDescription                            string
@@ -454,6 +459,7 @@ func (q *Queries) ListAllMealPlanTasksByMealPlan(ctx context.Context, db DBTX, m
 			&i.MealPlanOptionLastUpdatedAt,
 			&i.MealPlanOptionArchivedAt,
 			&i.MealPlanOptionBelongsToMealPlanEvent,
+			&i.MealPlanOptionCompletedAt,
 			&i.PrepTaskID,
 			&i.PrepTaskName,
 			&i.PrepTaskDescription,
@@ -512,6 +518,7 @@ func (q *Queries) ListAllMealPlanTasksByMealPlan(ctx context.Context, db DBTX, m
 	meal_plan_options.last_updated_at as meal_plan_option_last_updated_at,
 	meal_plan_options.archived_at as meal_plan_option_archived_at,
 	meal_plan_options.belongs_to_meal_plan_event as meal_plan_option_belongs_to_meal_plan_event,
+	meal_plan_options.completed_at as meal_plan_option_completed_at,
 	recipe_steps.id as recipe_step_id,
 	recipe_steps.index as recipe_step_index,
 	valid_preparations.id as valid_preparation_id,
@@ -585,6 +592,7 @@ type ListIncompleteMealPlanTasksByMealPlanOptionRow struct {
 	MealPlanOptionLastUpdatedAt                 sql.NullTime
 	MealPlanOptionArchivedAt                    sql.NullTime
 	MealPlanOptionBelongsToMealPlanEvent        sql.NullString
+	MealPlanOptionCompletedAt                   sql.NullTime
 	RecipeStepID                                string
 	RecipeStepIndex                             int32
 	ValidPreparationID                          string
@@ -659,6 +667,7 @@ func (q *Queries) ListIncompleteMealPlanTasksByMealPlanOption(ctx context.Contex
 			&i.MealPlanOptionLastUpdatedAt,
 			&i.MealPlanOptionArchivedAt,
 			&i.MealPlanOptionBelongsToMealPlanEvent,
+			&i.MealPlanOptionCompletedAt,
 			&i.RecipeStepID,
 			&i.RecipeStepIndex,
 			&i.ValidPreparationID,
@@ -184,6 +184,20 @@ func (q *Queries) FindMealPlansForDates(ctx context.Context, db DBTX, arg *FindM
 	return items, nil
 }
 
+const getAccountIDForMealPlan = `-- name: GetAccountIDForMealPlan :one
+SELECT meal_plans.belongs_to_account
+FROM meal_plans
+WHERE meal_plans.archived_at IS NULL
+	AND meal_plans.id = $1
+`
+
+func (q *Queries) GetAccountIDForMealPlan(ctx context.Context, db DBTX, id string) (string, error) {
+	row := db.QueryRowContext(ctx, getAccountIDForMealPlan, id)
+	var belongs_to_account string
+	err := row.Scan(&belongs_to_account)
+	return belongs_to_account, err
+}
+
 const getExpiredAndUnresolvedMealPlans = `-- name: GetExpiredAndUnresolvedMealPlans :many
 SELECT
 	meal_plans.id,
@@ -420,6 +434,26 @@ func (q *Queries) GetMealPlan(ctx context.Context, db DBTX, arg *GetMealPlanPara
 	return &i, err
 }
 
+const getMealPlanCountForAccountSince = `-- name: GetMealPlanCountForAccountSince :one
+SELECT COUNT(meal_plans.id)
+FROM meal_plans
+WHERE meal_plans.archived_at IS NULL
+	AND meal_plans.belongs_to_account = $1
+	AND meal_plans.created_at >= $2
+`
+
+type GetMealPlanCountForAccountSinceParams struct {
+	BelongsToAccount string
+	Since            time.Time
+}
+
+func (q *Queries) GetMealPlanCountForAccountSince(ctx context.Context, db DBTX, arg *GetMealPlanCountForAccountSinceParams) (int64, error) {
+	row := db.QueryRowContext(ctx, getMealPlanCountForAccountSince, arg.BelongsToAccount, arg.Since)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
+}
+
 const getMealPlanPastVotingDeadline = `-- name: GetMealPlanPastVotingDeadline :one
 SELECT
 	meal_plans.id,
@@ -614,6 +648,126 @@ func (q *Queries) GetMealPlansForAccount(ctx context.Context, db DBTX, arg *GetM
 	return items, nil
 }
 
+const getMealPlansWithApproachingVotingDeadlines = `-- name: GetMealPlansWithApproachingVotingDeadlines :many
+SELECT
+	meal_plans.id,
+	meal_plans.notes,
+	meal_plans.status,
+	meal_plans.voting_deadline,
+	meal_plans.grocery_list_initialized,
+	meal_plans.tasks_created,
+	meal_plans.election_method,
+	meal_plans.created_at,
+	meal_plans.last_updated_at,
+	meal_plans.archived_at,
+	meal_plans.belongs_to_account,
+	meal_plans.created_by_user
+FROM meal_plans
+WHERE meal_plans.archived_at IS NULL
+	AND meal_plans.status = 'awaiting_votes'
+	AND meal_plans.voting_deadline_reminder_sent_at IS NULL
+	AND voting_deadline > NOW()
+	AND voting_deadline < (NOW() + interval '2 hours')
+ORDER BY meal_plans.id
+`
+
+type GetMealPlansWithApproachingVotingDeadlinesRow struct {
+	ID                     string
+	Notes                  string
+	Status                 MealPlanStatus
+	VotingDeadline         time.Time
+	GroceryListInitialized bool
+	TasksCreated           bool
+	ElectionMethod         ValidElectionMethod
+	CreatedAt              time.Time
+	LastUpdatedAt          sql.NullTime
+	ArchivedAt             sql.NullTime
+	BelongsToAccount       string
+	CreatedByUser          string
+}
+
+func (q *Queries) GetMealPlansWithApproachingVotingDeadlines(ctx context.Context, db DBTX) ([]*GetMealPlansWithApproachingVotingDeadlinesRow, error) {
+	rows, err := db.QueryContext(ctx, getMealPlansWithApproachingVotingDeadlines)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*GetMealPlansWithApproachingVotingDeadlinesRow{}
+	for rows.Next() {
+		var i GetMealPlansWithApproachingVotingDeadlinesRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.Notes,
+			&i.Status,
+			&i.VotingDeadline,
+			&i.GroceryListInitialized,
+			&i.TasksCreated,
+			&i.ElectionMethod,
+			&i.CreatedAt,
+			&i.LastUpdatedAt,
+			&i.ArchivedAt,
+			&i.BelongsToAccount,
+			&i.CreatedByUser,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
+const getRecentlyChosenMealIDsForAccount = `-- name: GetRecentlyChosenMealIDsForAccount :many
+SELECT DISTINCT meal_plan_options.meal_id
+FROM meal_plan_options
+	JOIN meal_plan_events ON meal_plan_options.belongs_to_meal_plan_event = meal_plan_events.id
+WHERE meal_plan_options.archived_at IS NULL
+	AND meal_plan_options.chosen IS TRUE
+	AND meal_plan_events.archived_at IS NULL
+	AND meal_plan_events.belongs_to_meal_plan IN (
+		SELECT meal_plans.id
+		FROM meal_plans
+		WHERE meal_plans.archived_at IS NULL
+			AND meal_plans.belongs_to_account = $1
+			AND meal_plans.status = 'finalized'
+		ORDER BY meal_plans.voting_deadline DESC
+		LIMIT $2
+	)
+`
+
+type GetRecentlyChosenMealIDsForAccountParams struct {
+	BelongsToAccount string
+	MealPlanCount    int32
+}
+
+func (q *Queries) GetRecentlyChosenMealIDsForAccount(ctx context.Context, db DBTX, arg *GetRecentlyChosenMealIDsForAccountParams) ([]string, error) {
+	rows, err := db.QueryContext(ctx, getRecentlyChosenMealIDsForAccount, arg.BelongsToAccount, arg.MealPlanCount)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []string{}
+	for rows.Next() {
+		var meal_id string
+		if err := rows.Scan(&meal_id); err != nil {
+			return nil, err
+		}
+		items = append(items, meal_id)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const markMealPlanAsGroceryListInitialized = `-- name: MarkMealPlanAsGroceryListInitialized :exec
 UPDATE meal_plans SET
 	grocery_list_initialized = TRUE,
@@ -640,6 +794,18 @@ func (q *Queries) MarkMealPlanAsPrepTasksCreated(ctx context.Context, db DBTX, i
 	return err
 }
 
+const markMealPlanVotingDeadlineReminderSent = `-- name: MarkMealPlanVotingDeadlineReminderSent :exec
+UPDATE meal_plans SET
+	voting_deadline_reminder_sent_at = NOW()
+WHERE archived_at IS NULL
+	AND id = $1
+`
+
+func (q *Queries) MarkMealPlanVotingDeadlineReminderSent(ctx context.Context, db DBTX, id string) error {
+	_, err := db.ExecContext(ctx, markMealPlanVotingDeadlineReminderSent, id)
+	return err
+}
+
 const updateMealPlan = `-- name: UpdateMealPlan :execrows
 UPDATE meal_plans SET
 	notes = $1,
@@ -7,6 +7,7 @@
 import (
 	"database/sql"
 	"database/sql/driver"
+	"encoding/json"
 	"fmt"
 	"time"
 )
@@ -364,6 +365,70 @@ func AllMealPlanStatusValues() []MealPlanStatus {
 	}
 }
 
+type PantryStorageLocation string
+
+const (
+	PantryStorageLocationPantry       PantryStorageLocation = "pantry"
+	PantryStorageLocationRefrigerator PantryStorageLocation = "refrigerator"
+	PantryStorageLocationFreezer      PantryStorageLocation = "freezer"
+	PantryStorageLocationOther        PantryStorageLocation = "other"
+)
+
+func (e *PantryStorageLocation) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = PantryStorageLocation(s)
+	case string:
+		*e = PantryStorageLocation(s)
+	default:
+		return fmt.Errorf("unsupported scan type for PantryStorageLocation: %T", src)
+	}
+	return nil
+}
+
+type NullPantryStorageLocation struct {
+	PantryStorageLocation PantryStorageLocation
+	Valid                 bool // Valid is true if PantryStorageLocation is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullPantryStorageLocation) Scan(value interface{}) error {
+	if value == nil {
+		ns.PantryStorageLocation, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.PantryStorageLocation.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullPantryStorageLocation) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.PantryStorageLocation), nil
+}
+
+func (e PantryStorageLocation) Valid() bool {
+	switch e {
+	case PantryStorageLocationPantry,
+		PantryStorageLocationRefrigerator,
+		PantryStorageLocationFreezer,
+		PantryStorageLocationOther:
+		return true
+	}
+	return false
+}
+
+func AllPantryStorageLocationValues() []PantryStorageLocation {
+	return []PantryStorageLocation{
+		PantryStorageLocationPantry,
+		PantryStorageLocationRefrigerator,
+		PantryStorageLocationFreezer,
+		PantryStorageLocationOther,
+	}
+}
+
 type PrepStepStatus string
 
 const (
@@ -686,6 +751,9 @@ func AllUploadedMediaMimeTypeValues() []UploadedMediaMimeType {
 const (
 	ValidElectionMethodSchulze       ValidElectionMethod = "schulze"
 	ValidElectionMethodInstantRunoff ValidElectionMethod = "instant-runoff"
+	ValidElectionMethodBorda         ValidElectionMethod = "borda"
+	ValidElectionMethodApproval      ValidElectionMethod = "approval"
+	ValidElectionMethodScore         ValidElectionMethod = "score"
 )
 
 func (e *ValidElectionMethod) Scan(src interface{}) error {
@@ -726,7 +794,10 @@ func (ns NullValidElectionMethod) Value() (driver.Value, error) {
 func (e ValidElectionMethod) Valid() bool {
 	switch e {
 	case ValidElectionMethodSchulze,
-		ValidElectionMethodInstantRunoff:
+		ValidElectionMethodInstantRunoff,
+		ValidElectionMethodBorda,
+		ValidElectionMethodApproval,
+		ValidElectionMethodScore:
 		return true
 	}
 	return false
@@ -736,6 +807,9 @@ func AllValidElectionMethodValues() []ValidElectionMethod {
 	return []ValidElectionMethod{
 		ValidElectionMethodSchulze,
 		ValidElectionMethodInstantRunoff,
+		ValidElectionMethodBorda,
+		ValidElectionMethodApproval,
+		ValidElectionMethodScore,
 	}
 }
 
@@ -824,6 +898,41 @@ type IngredientMedia struct {
 	ArchivedAt        sql.NullTime
 }
 
+type MealPlanActivities struct {
+	ID                   string
+	EventType            string
+	MealPlanEventID      string
+	MealPlanOptionID     string
+	MealPlanOptionVoteID string
+	MealPlanTaskID       string
+	ByUser               string
+	CreatedAt            time.Time
+	BelongsToMealPlan    string
+	Sequence             int64
+}
+
+type MealPlanCalendarFeeds struct {
+	ID               string
+	TokenHash        string
+	BelongsToUser    string
+	BelongsToAccount string
+	CreatedAt        time.Time
+	LastAccessedAt   sql.NullTime
+	ArchivedAt       sql.NullTime
+}
+
+type MealPlanEventTallyReports struct {
+	ID                     string
+	BelongsToMealPlanEvent string
+	ElectionMethod         ValidElectionMethod
+	Winner                 string
+	TieBroken              bool
+	BallotCount            int32
+	Details                json.RawMessage
+	CreatedAt              time.Time
+	ArchivedAt             sql.NullTime
+}
+
 type MealPlanEvents struct {
 	ID                string
 	Notes             string
@@ -846,6 +955,7 @@ type MealPlanOptionVotes struct {
 	LastUpdatedAt           sql.NullTime
 	ArchivedAt              sql.NullTime
 	BelongsToMealPlanOption string
+	Score                   int32
 }
 
 type MealPlanRecipeOptionSelections struct {
@@ -861,6 +971,27 @@ type MealPlanRecipeOptionSelections struct {
 	ArchivedAt              sql.NullTime
 }
 
+type MealPlanTemplates struct {
+	ID                            string
+	Name                          string
+	Notes                         string
+	ElectionMethod                ValidElectionMethod
+	RecurrenceRule                string
+	Timezone                      string
+	VotingDeadlineOffsetInSeconds int32
+	CandidatesPerSlot             int32
+	AvoidMealsFromLastPlans       int32
+	Slots                         json.RawMessage
+	NextInstantiationAt           time.Time
+	LastInstantiatedAt            sql.NullTime
+	InstantiationClaimedUntil     sql.NullTime
+	CreatedAt                     time.Time
+	LastUpdatedAt                 sql.NullTime
+	ArchivedAt                    sql.NullTime
+	BelongsToAccount              string
+	CreatedByUser                 string
+}
+
 type PreparationMedia struct {
 	ID                 string
 	ValidPreparationID string
@@ -886,6 +1017,15 @@ type RecipeRatings struct {
 	ArchivedAt      sql.NullTime
 }
 
+type RecipeRevisions struct {
+	ID              string
+	BelongsToRecipe string
+	RevisionNumber  int32
+	Snapshot        json.RawMessage
+	CreatedByUser   sql.NullString
+	CreatedAt       time.Time
+}
+
 type RecipeStepImages struct {
 	ID                  string
 	BelongsToRecipeStep string
@@ -7,6 +7,7 @@
 import (
 	"context"
 	"database/sql"
+	"time"
 )
 
 type Querier interface {
@@ -15,11 +16,14 @@ type Querier interface {
 	ArchiveMealList(ctx context.Context, db DBTX, arg *ArchiveMealListParams) (int64, error)
 	ArchiveMealListItem(ctx context.Context, db DBTX, arg *ArchiveMealListItemParams) (int64, error)
 	ArchiveMealPlan(ctx context.Context, db DBTX, arg *ArchiveMealPlanParams) (int64, error)
+	ArchiveMealPlanCalendarFeedsForUser(ctx context.Context, db DBTX, arg *ArchiveMealPlanCalendarFeedsForUserParams) (int64, error)
 	ArchiveMealPlanEvent(ctx context.Context, db DBTX, arg *ArchiveMealPlanEventParams) (int64, error)
 	ArchiveMealPlanGroceryListItem(ctx context.Context, db DBTX, id string) (int64, error)
 	ArchiveMealPlanOption(ctx context.Context, db DBTX, arg *ArchiveMealPlanOptionParams) (int64, error)
 	ArchiveMealPlanOptionVote(ctx context.Context, db DBTX, arg *ArchiveMealPlanOptionVoteParams) (int64, error)
 	ArchiveMealPlanRecipeOptionSelection(ctx context.Context, db DBTX, arg *ArchiveMealPlanRecipeOptionSelectionParams) (int64, error)
+	ArchiveMealPlanTemplate(ctx context.Context, db DBTX, arg *ArchiveMealPlanTemplateParams) (int64, error)
+	ArchivePantryItem(ctx context.Context, db DBTX, arg *ArchivePantryItemParams) (int64, error)
 	ArchiveRecipe(ctx context.Context, db DBTX, arg *ArchiveRecipeParams) (int64, error)
 	ArchiveRecipeList(ctx context.Context, db DBTX, arg *ArchiveRecipeListParams) (int64, error)
 	ArchiveRecipeListItem(ctx context.Context, db DBTX, arg *ArchiveRecipeListItemParams) (int64, error)
@@ -32,11 +36,13 @@ type Querier interface {
 	ArchiveRecipeStepInstrument(ctx context.Context, db DBTX, arg *ArchiveRecipeStepInstrumentParams) (int64, error)
 	ArchiveRecipeStepProduct(ctx context.Context, db DBTX, arg *ArchiveRecipeStepProductParams) (int64, error)
 	ArchiveRecipeStepVessel(ctx context.Context, db DBTX, arg *ArchiveRecipeStepVesselParams) (int64, error)
+	ArchiveRecipeStepsForRecipe(ctx context.Context, db DBTX, belongsToRecipe string) error
 	ArchiveUserIngredientPreference(ctx context.Context, db DBTX, arg *ArchiveUserIngredientPreferenceParams) (int64, error)
 	ArchiveValidIngredient(ctx context.Context, db DBTX, id string) (int64, error)
 	ArchiveValidIngredientGroup(ctx context.Context, db DBTX, id string) (int64, error)
 	ArchiveValidIngredientGroupMember(ctx context.Context, db DBTX, arg *ArchiveValidIngredientGroupMemberParams) (int64, error)
 	ArchiveValidIngredientMeasurementUnit(ctx context.Context, db DBTX, id string) (int64, error)
+	ArchiveValidIngredientNutritionFacts(ctx context.Context, db DBTX, id string) (int64, error)
 	ArchiveValidIngredientPreparation(ctx context.Context, db DBTX, id string) (int64, error)
 	ArchiveValidIngredientState(ctx context.Context, db DBTX, id string) (int64, error)
 	ArchiveValidIngredientStateIngredient(ctx context.Context, db DBTX, id string) (int64, error)
@@ -59,6 +65,7 @@ type Querier interface {
 	CheckMealPlanOptionExistence(ctx context.Context, db DBTX, arg *CheckMealPlanOptionExistenceParams) (bool, error)
 	CheckMealPlanOptionVoteExistence(ctx context.Context, db DBTX, arg *CheckMealPlanOptionVoteExistenceParams) (bool, error)
 	CheckMealPlanTaskExistence(ctx context.Context, db DBTX, arg *CheckMealPlanTaskExistenceParams) (bool, error)
+	CheckPantryItemExistence(ctx context.Context, db DBTX, arg *CheckPantryItemExistenceParams) (bool, error)
 	CheckRecipeExistence(ctx context.Context, db DBTX, id string) (bool, error)
 	CheckRecipeMediaExistence(ctx context.Context, db DBTX, id string) (bool, error)
 	CheckRecipePrepTaskExistence(ctx context.Context, db DBTX, arg *CheckRecipePrepTaskExistenceParams) (bool, error)
@@ -73,6 +80,7 @@ type Querier interface {
 	CheckValidIngredientExistence(ctx context.Context, db DBTX, id string) (bool, error)
 	CheckValidIngredientGroupExistence(ctx context.Context, db DBTX, id string) (bool, error)
 	CheckValidIngredientMeasurementUnitExistence(ctx context.Context, db DBTX, id string) (bool, error)
+	CheckValidIngredientNutritionFactsExistence(ctx context.Context, db DBTX, id string) (bool, error)
 	CheckValidIngredientPreparationExistence(ctx context.Context, db DBTX, id string) (bool, error)
 	CheckValidIngredientStateExistence(ctx context.Context, db DBTX, id string) (bool, error)
 	CheckValidIngredientStateIngredientExistence(ctx context.Context, db DBTX, id string) (bool, error)
@@ -85,7 +93,9 @@ type Querier interface {
 	CheckValidPreparationVesselExistence(ctx context.Context, db DBTX, id string) (bool, error)
 	CheckValidVesselExistence(ctx context.Context, db DBTX, id string) (bool, error)
 	CheckValidityOfValidIngredientStateIngredientPair(ctx context.Context, db DBTX, arg *CheckValidityOfValidIngredientStateIngredientPairParams) (bool, error)
+	ClaimMealPlanTemplateInstantiation(ctx context.Context, db DBTX, arg *ClaimMealPlanTemplateInstantiationParams) (int64, error)
 	ClearMealPlanTaskNotificationSentForEvent(ctx context.Context, db DBTX, mealPlanEventID sql.NullString) error
+	CompleteMealPlanOption(ctx context.Context, db DBTX, arg *CompleteMealPlanOptionParams) (int64, error)
 	CreateAccountInstrumentOwnership(ctx context.Context, db DBTX, arg *CreateAccountInstrumentOwnershipParams) error
 	CreateIngredientMedia(ctx context.Context, db DBTX, arg *CreateIngredientMediaParams) error
 	CreateMeal(ctx context.Context, db DBTX, arg *CreateMealParams) error
@@ -95,11 +105,16 @@ type Querier interface {
 	CreateMealListItem(ctx context.Context, db DBTX, arg *CreateMealListItemParams) error
 	CreateMealPlan(ctx context.Context, db DBTX, arg *CreateMealPlanParams) error
 	CreateMealPlanEvent(ctx context.Context, db DBTX, arg *CreateMealPlanEventParams) error
+	CreateMealPlanActivity(ctx context.Context, db DBTX, arg *CreateMealPlanActivityParams) error
+	CreateMealPlanCalendarFeed(ctx context.Context, db DBTX, arg *CreateMealPlanCalendarFeedParams) error
+	CreateMealPlanEventTallyReport(ctx context.Context, db DBTX, arg *CreateMealPlanEventTallyReportParams) error
 	CreateMealPlanGroceryListItem(ctx context.Context, db DBTX, arg *CreateMealPlanGroceryListItemParams) error
 	CreateMealPlanOption(ctx context.Context, db DBTX, arg *CreateMealPlanOptionParams) error
 	CreateMealPlanOptionVote(ctx context.Context, db DBTX, arg *CreateMealPlanOptionVoteParams) error
 	CreateMealPlanRecipeOptionSelection(ctx context.Context, db DBTX, arg *CreateMealPlanRecipeOptionSelectionParams) error
 	CreateMealPlanTask(ctx context.Context, db DBTX, arg *CreateMealPlanTaskParams) error
+	CreateMealPlanTemplate(ctx context.Context, db DBTX, arg *CreateMealPlanTemplateParams) error
+	CreatePantryItem(ctx context.Context, db DBTX, arg *CreatePantryItemParams) error
 	CreatePreparationMedia(ctx context.Context, db DBTX, arg *CreatePreparationMediaParams) error
 	CreateRecipe(ctx context.Context, db DBTX, arg *CreateRecipeParams) error
 	CreateRecipeImage(ctx context.Context, db DBTX, arg *CreateRecipeImageParams) error
@@ -109,6 +124,7 @@ type Querier interface {
 	CreateRecipePrepTask(ctx context.Context, db DBTX, arg *CreateRecipePrepTaskParams) error
 	CreateRecipePrepTaskStep(ctx context.Context, db DBTX, arg *CreateRecipePrepTaskStepParams) error
 	CreateRecipeRating(ctx context.Context, db DBTX, arg *CreateRecipeRatingParams) error
+	CreateRecipeRevision(ctx context.Context, db DBTX, arg *CreateRecipeRevisionParams) (*RecipeRevisions, error)
 	CreateRecipeStep(ctx context.Context, db DBTX, arg *CreateRecipeStepParams) error
 	CreateRecipeStepCompletionCondition(ctx context.Context, db DBTX, arg *CreateRecipeStepCompletionConditionParams) error
 	CreateRecipeStepCompletionConditionIngredient(ctx context.Context, db DBTX, arg *CreateRecipeStepCompletionConditionIngredientParams) error
@@ -122,6 +138,7 @@ type Querier interface {
 	CreateValidIngredientGroup(ctx context.Context, db DBTX, arg *CreateValidIngredientGroupParams) error
 	CreateValidIngredientGroupMember(ctx context.Context, db DBTX, arg *CreateValidIngredientGroupMemberParams) error
 	CreateValidIngredientMeasurementUnit(ctx context.Context, db DBTX, arg *CreateValidIngredientMeasurementUnitParams) error
+	CreateValidIngredientNutritionFacts(ctx context.Context, db DBTX, arg *CreateValidIngredientNutritionFactsParams) error
 	CreateValidIngredientPreparation(ctx context.Context, db DBTX, arg *CreateValidIngredientPreparationParams) error
 	CreateValidIngredientState(ctx context.Context, db DBTX, arg *CreateValidIngredientStateParams) error
 	CreateValidIngredientStateIngredient(ctx context.Context, db DBTX, arg *CreateValidIngredientStateIngredientParams) error
@@ -133,9 +150,12 @@ type Querier interface {
 	CreateValidPreparationInstrument(ctx context.Context, db DBTX, arg *CreateValidPreparationInstrumentParams) error
 	CreateValidPreparationVessel(ctx context.Context, db DBTX, arg *CreateValidPreparationVesselParams) error
 	CreateValidVessel(ctx context.Context, db DBTX, arg *CreateValidVesselParams) error
+	DrawDownPantryItem(ctx context.Context, db DBTX, arg *DrawDownPantryItemParams) (string, error)
 	FinalizeMealPlan(ctx context.Context, db DBTX, arg *FinalizeMealPlanParams) error
 	FinalizeMealPlanOption(ctx context.Context, db DBTX, arg *FinalizeMealPlanOptionParams) error
 	FindMealPlansForDates(ctx context.Context, db DBTX, arg *FindMealPlansForDatesParams) ([]*FindMealPlansForDatesRow, error)
+	GetAccountIDForMealPlan(ctx context.Context, db DBTX, id string) (string, error)
+	GetAccountIDForMealPlanEvent(ctx context.Context, db DBTX, id string) (string, error)
 	GetAccountInstrumentOwnership(ctx context.Context, db DBTX, arg *GetAccountInstrumentOwnershipParams) (*GetAccountInstrumentOwnershipRow, error)
 	GetAccountInstrumentOwnerships(ctx context.Context, db DBTX, arg *GetAccountInstrumentOwnershipsParams) ([]*GetAccountInstrumentOwnershipsRow, error)
 	GetAllMealPlanEventsForMealPlan(ctx context.Context, db DBTX, mealPlanID string) ([]*MealPlanEvents, error)
@@ -143,6 +163,8 @@ type Querier interface {
 	GetAllRecipeStepCompletionConditionIngredientsForRecipeCompletionIDs(ctx context.Context, db DBTX, ids []string) ([]*GetAllRecipeStepCompletionConditionIngredientsForRecipeCompletionIDsRow, error)
 	GetAllRecipeStepCompletionConditionsForRecipe(ctx context.Context, db DBTX, id string) ([]*GetAllRecipeStepCompletionConditionsForRecipeRow, error)
 	GetAllRecipeStepIngredientsForRecipe(ctx context.Context, db DBTX, recipeID string) ([]*GetAllRecipeStepIngredientsForRecipeRow, error)
+	GetAllergyUserIngredientPreferencesForAccount(ctx context.Context, db DBTX, belongsToAccount string) ([]*GetAllergyUserIngredientPreferencesForAccountRow, error)
+	GetAvailablePantryItemsForAccount(ctx context.Context, db DBTX, belongsToAccount string) ([]*GetAvailablePantryItemsForAccountRow, error)
 	GetExpiredAndUnresolvedMealPlans(ctx context.Context, db DBTX) ([]*GetExpiredAndUnresolvedMealPlansRow, error)
 	GetFinalizedMealPlansForPlanning(ctx context.Context, db DBTX) ([]*GetFinalizedMealPlansForPlanningRow, error)
 	GetFinalizedMealPlansWithoutGroceryListInit(ctx context.Context, db DBTX) ([]*GetFinalizedMealPlansWithoutGroceryListInitRow, error)
@@ -151,11 +173,16 @@ type Querier interface {
 	GetMealListItems(ctx context.Context, db DBTX, arg *GetMealListItemsParams) ([]*GetMealListItemsRow, error)
 	GetMealLists(ctx context.Context, db DBTX, arg *GetMealListsParams) ([]*GetMealListsRow, error)
 	GetMealPlan(ctx context.Context, db DBTX, arg *GetMealPlanParams) (*GetMealPlanRow, error)
+	GetMealPlanActivitiesAfterCursor(ctx context.Context, db DBTX, arg *GetMealPlanActivitiesAfterCursorParams) ([]*MealPlanActivities, error)
+	GetMealPlanCalendarFeedByTokenHash(ctx context.Context, db DBTX, tokenHash string) (*MealPlanCalendarFeeds, error)
+	GetMealPlanCountForAccountSince(ctx context.Context, db DBTX, arg *GetMealPlanCountForAccountSinceParams) (int64, error)
 	GetMealPlanEvent(ctx context.Context, db DBTX, arg *GetMealPlanEventParams) (*MealPlanEvents, error)
+	GetMealPlanEventTallyReport(ctx context.Context, db DBTX, arg *GetMealPlanEventTallyReportParams) (*MealPlanEventTallyReports, error)
 	GetMealPlanEvents(ctx context.Context, db DBTX, arg *GetMealPlanEventsParams) ([]*GetMealPlanEventsRow, error)
 	GetMealPlanGroceryListItem(ctx context.Context, db DBTX, arg *GetMealPlanGroceryListItemParams) (*GetMealPlanGroceryListItemRow, error)
 	GetMealPlanGroceryListItemsForMealPlan(ctx context.Context, db DBTX, arg *GetMealPlanGroceryListItemsForMealPlanParams) ([]*GetMealPlanGroceryListItemsForMealPlanRow, error)
 	GetMealPlanOption(ctx context.Context, db DBTX, arg *GetMealPlanOptionParams) (*GetMealPlanOptionRow, error)
+	GetMealPlanOptionAllergenPolicyForAccount(ctx context.Context, db DBTX, belongsToAccount string) (string, error)
 	GetMealPlanOptionByID(ctx context.Context, db DBTX, mealPlanOptionID string) (*GetMealPlanOptionByIDRow, error)
 	GetMealPlanOptionVote(ctx context.Context, db DBTX, arg *GetMealPlanOptionVoteParams) (*MealPlanOptionVotes, error)
 	GetMealPlanOptionVotes(ctx context.Context, db DBTX, arg *GetMealPlanOptionVotesParams) ([]*GetMealPlanOptionVotesRow, error)
@@ -169,13 +196,19 @@ type Querier interface {
 	GetMealPlanTaskAccountID(ctx context.Context, db DBTX, mealPlanTaskID string) (string, error)
 	GetMealPlanTaskIDsThatNeedNotification(ctx context.Context, db DBTX) ([]string, error)
 	GetMealPlanTaskNotificationContext(ctx context.Context, db DBTX, mealPlanTaskID string) (*GetMealPlanTaskNotificationContextRow, error)
+	GetMealPlanTemplate(ctx context.Context, db DBTX, arg *GetMealPlanTemplateParams) (*MealPlanTemplates, error)
+	GetMealPlanTemplatesDueForInstantiation(ctx context.Context, db DBTX, asOf time.Time) ([]*MealPlanTemplates, error)
+	GetMealPlanTemplatesForAccount(ctx context.Context, db DBTX, arg *GetMealPlanTemplatesForAccountParams) ([]*GetMealPlanTemplatesForAccountRow, error)
 	GetMealPlansForAccount(ctx context.Context, db DBTX, arg *GetMealPlansForAccountParams) ([]*GetMealPlansForAccountRow, error)
+	GetMealPlansWithApproachingVotingDeadlines(ctx context.Context, db DBTX) ([]*GetMealPlansWithApproachingVotingDeadlinesRow, error)
 	GetMeals(ctx context.Context, db DBTX, arg *GetMealsParams) ([]*GetMealsRow, error)
 	GetMealsByCreatorAndName(ctx context.Context, db DBTX, arg *GetMealsByCreatorAndNameParams) ([]*GetMealsByCreatorAndNameRow, error)
 	GetMealsCreatedByUser(ctx context.Context, db DBTX, arg *GetMealsCreatedByUserParams) ([]*GetMealsCreatedByUserRow, error)
 	GetMealsNeedingIndexing(ctx context.Context, db DBTX) ([]string, error)
 	GetMealsWithIDs(ctx context.Context, db DBTX, ids []string) ([]*GetMealsWithIDsRow, error)
 	GetMeasurementUnitConversionMismatches(ctx context.Context, db DBTX) ([]*GetMeasurementUnitConversionMismatchesRow, error)
+	GetPantryItem(ctx context.Context, db DBTX, arg *GetPantryItemParams) (*GetPantryItemRow, error)
+	GetPantryItems(ctx context.Context, db DBTX, arg *GetPantryItemsParams) ([]*GetPantryItemsRow, error)
 	GetPreparationMediaByPreparation(ctx context.Context, db DBTX, validPreparationID string) ([]*PreparationMedia, error)
 	// Returns media for a prep+ingredient: ingredient-specific media OR general (for_ingredient_id IS NULL)
 	GetPreparationMediaByPreparationAndIngredient(ctx context.Context, db DBTX, arg *GetPreparationMediaByPreparationAndIngredientParams) ([]*PreparationMedia, error)
@@ -184,6 +217,7 @@ type Querier interface {
 	GetRandomValidMeasurementUnit(ctx context.Context, db DBTX) (*GetRandomValidMeasurementUnitRow, error)
 	GetRandomValidPreparation(ctx context.Context, db DBTX) (*GetRandomValidPreparationRow, error)
 	GetRandomValidVessel(ctx context.Context, db DBTX) (*GetRandomValidVesselRow, error)
+	GetRecentlyChosenMealIDsForAccount(ctx context.Context, db DBTX, arg *GetRecentlyChosenMealIDsForAccountParams) ([]string, error)
 	GetRecipeByID(ctx context.Context, db DBTX, recipeID string) ([]*GetRecipeByIDRow, error)
 	GetRecipeByIDAndAuthorID(ctx context.Context, db DBTX, arg *GetRecipeByIDAndAuthorIDParams) ([]*GetRecipeByIDAndAuthorIDRow, error)
 	GetRecipeIDsForMeal(ctx context.Context, db DBTX, mealID string) ([]string, error)
@@ -194,8 +228,12 @@ type Querier interface {
 	GetRecipeMediaForRecipeStep(ctx context.Context, db DBTX, arg *GetRecipeMediaForRecipeStepParams) ([]*GetRecipeMediaForRecipeStepRow, error)
 	GetRecipePrepTask(ctx context.Context, db DBTX, recipePrepTaskID string) ([]*GetRecipePrepTaskRow, error)
 	GetRecipeRating(ctx context.Context, db DBTX, id string) (*RecipeRatings, error)
+	GetRecipeRatingSummariesForAccount(ctx context.Context, db DBTX, arg *GetRecipeRatingSummariesForAccountParams) ([]*GetRecipeRatingSummariesForAccountRow, error)
 	GetRecipeRatingsForRecipe(ctx context.Context, db DBTX, arg *GetRecipeRatingsForRecipeParams) ([]*GetRecipeRatingsForRecipeRow, error)
 	GetRecipeRatingsForUser(ctx context.Context, db DBTX, arg *GetRecipeRatingsForUserParams) ([]*GetRecipeRatingsForUserRow, error)
+	GetRecipeRevision(ctx context.Context, db DBTX, arg *GetRecipeRevisionParams) (*RecipeRevisions, error)
+	GetRecipeRevisionsForMealPlanOption(ctx context.Context, db DBTX, belongsToMealPlanOption string) ([]*GetRecipeRevisionsForMealPlanOptionRow, error)
+	GetRecipeRevisionsForRecipe(ctx context.Context, db DBTX, arg *GetRecipeRevisionsForRecipeParams) ([]*GetRecipeRevisionsForRecipeRow, error)
 	GetRecipeStep(ctx context.Context, db DBTX, arg *GetRecipeStepParams) (*GetRecipeStepRow, error)
 	GetRecipeStepByRecipeID(ctx context.Context, db DBTX, id string) (*GetRecipeStepByRecipeIDRow, error)
 	GetRecipeStepCompletionConditionWithIngredients(ctx context.Context, db DBTX, arg *GetRecipeStepCompletionConditionWithIngredientsParams) ([]*GetRecipeStepCompletionConditionWithIngredientsRow, error)
@@ -231,6 +269,9 @@ type Querier interface {
 	GetValidIngredientMeasurementUnitsByIDs(ctx context.Context, db DBTX, ids []string) ([]*GetValidIngredientMeasurementUnitsByIDsRow, error)
 	GetValidIngredientMeasurementUnitsForIngredient(ctx context.Context, db DBTX, arg *GetValidIngredientMeasurementUnitsForIngredientParams) ([]*GetValidIngredientMeasurementUnitsForIngredientRow, error)
 	GetValidIngredientMeasurementUnitsForMeasurementUnit(ctx context.Context, db DBTX, arg *GetValidIngredientMeasurementUnitsForMeasurementUnitParams) ([]*GetValidIngredientMeasurementUnitsForMeasurementUnitRow, error)
+	GetValidIngredientNutritionFacts(ctx context.Context, db DBTX, id string) (*GetValidIngredientNutritionFactsRow, error)
+	GetValidIngredientNutritionFactsForIngredient(ctx context.Context, db DBTX, validIngredientID string) (*GetValidIngredientNutritionFactsForIngredientRow, error)
+	GetValidIngredientNutritionFactsForIngredients(ctx context.Context, db DBTX, validIngredientIds []string) ([]*GetValidIngredientNutritionFactsForIngredientsRow, error)
 	GetValidIngredientPreparation(ctx context.Context, db DBTX, id string) (*GetValidIngredientPreparationRow, error)
 	GetValidIngredientPreparations(ctx context.Context, db DBTX, arg *GetValidIngredientPreparationsParams) ([]*GetValidIngredientPreparationsRow, error)
 	GetValidIngredientPreparationsByIDs(ctx context.Context, db DBTX, ids []string) ([]*GetValidIngredientPreparationsByIDsRow, error)
@@ -285,12 +326,21 @@ type Querier interface {
 	ListAllMealPlanTasksByMealPlan(ctx context.Context, db DBTX, mealPlanID string) ([]*ListAllMealPlanTasksByMealPlanRow, error)
 	ListAllRecipePrepTasksByRecipe(ctx context.Context, db DBTX, recipeID string) ([]*ListAllRecipePrepTasksByRecipeRow, error)
 	ListIncompleteMealPlanTasksByMealPlanOption(ctx context.Context, db DBTX, belongsToMealPlanOption string) ([]*ListIncompleteMealPlanTasksByMealPlanOptionRow, error)
+	LockMealPlanActivityFeed(ctx context.Context, db DBTX, belongsToMealPlan string) error
+	LockRecipe(ctx context.Context, db DBTX, id string) (string, error)
+	LockRecipeForRecipeStep(ctx context.Context, db DBTX, recipeStepID string) (string, error)
 	MarkMealPlanAsGroceryListInitialized(ctx context.Context, db DBTX, id string) error
 	MarkMealPlanAsPrepTasksCreated(ctx context.Context, db DBTX, id string) error
+	MarkMealPlanVotingDeadlineReminderSent(ctx context.Context, db DBTX, id string) error
+	MarkMealPlanCalendarFeedAsAccessed(ctx context.Context, db DBTX, id string) error
 	MarkMealPlanTaskNotificationSent(ctx context.Context, db DBTX, id string) error
+	MarkMealPlanTemplateAsInstantiated(ctx context.Context, db DBTX, arg *MarkMealPlanTemplateAsInstantiatedParams) (int64, error)
 	MealPlanEventIsEligibleForVoting(ctx context.Context, db DBTX, arg *MealPlanEventIsEligibleForVotingParams) (bool, error)
 	MealPlanTaskNotificationHasBeenSent(ctx context.Context, db DBTX, mealPlanTaskID string) (interface{}, error)
+	NotifyMealPlanActivity(ctx context.Context, db DBTX, belongsToMealPlan string) error
+	PinLatestRecipeRevisionsForMealPlanOption(ctx context.Context, db DBTX, arg *PinLatestRecipeRevisionsForMealPlanOptionParams) error
 	RecipeSearch(ctx context.Context, db DBTX, arg *RecipeSearchParams) ([]*RecipeSearchRow, error)
+	ReleaseMealPlanTemplateInstantiationClaim(ctx context.Context, db DBTX, id string) error
 	SearchForMealEligibleRecipes(ctx context.Context, db DBTX, arg *SearchForMealEligibleRecipesParams) ([]*SearchForMealEligibleRecipesRow, error)
 	SearchForMeals(ctx context.Context, db DBTX, arg *SearchForMealsParams) ([]*SearchForMealsRow, error)
 	SearchForRecipesWithInstrumentOwnership(ctx context.Context, db DBTX, arg *SearchForRecipesWithInstrumentOwnershipParams) ([]*SearchForRecipesWithInstrumentOwnershipRow, error)
@@ -315,6 +365,8 @@ type Querier interface {
 	UpdateMealPlanOption(ctx context.Context, db DBTX, arg *UpdateMealPlanOptionParams) (int64, error)
 	UpdateMealPlanOptionVote(ctx context.Context, db DBTX, arg *UpdateMealPlanOptionVoteParams) (int64, error)
 	UpdateMealPlanRecipeOptionSelection(ctx context.Context, db DBTX, arg *UpdateMealPlanRecipeOptionSelectionParams) (int64, error)
+	UpdateMealPlanTemplate(ctx context.Context, db DBTX, arg *UpdateMealPlanTemplateParams) (int64, error)
+	UpdatePantryItem(ctx context.Context, db DBTX, arg *UpdatePantryItemParams) (int64, error)
 	UpdateRecipe(ctx context.Context, db DBTX, arg *UpdateRecipeParams) (int64, error)
 	UpdateRecipeLastIndexedAt(ctx context.Context, db DBTX, id string) (int64, error)
 	UpdateRecipeList(ctx context.Context, db DBTX, arg *UpdateRecipeListParams) (int64, error)
@@ -334,6 +386,7 @@ type Querier interface {
 	UpdateValidIngredientGroup(ctx context.Context, db DBTX, arg *UpdateValidIngredientGroupParams) (int64, error)
 	UpdateValidIngredientLastIndexedAt(ctx context.Context, db DBTX, id string) (int64, error)
 	UpdateValidIngredientMeasurementUnit(ctx context.Context, db DBTX, arg *UpdateValidIngredientMeasurementUnitParams) (int64, error)
+	UpdateValidIngredientNutritionFacts(ctx context.Context, db DBTX, arg *UpdateValidIngredientNutritionFactsParams) (int64, error)
 	UpdateValidIngredientPreparation(ctx context.Context, db DBTX, arg *UpdateValidIngredientPreparationParams) (int64, error)
 	UpdateValidIngredientState(ctx context.Context, db DBTX, arg *UpdateValidIngredientStateParams) (int64, error)
 	UpdateValidIngredientStateIngredient(ctx context.Context, db DBTX, arg *UpdateValidIngredientStateIngredientParams) (int64, error)
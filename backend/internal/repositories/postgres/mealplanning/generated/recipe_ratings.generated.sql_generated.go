@@ -9,6 +9,8 @@
 	"context"
 	"database/sql"
 	"time"
+
+	"github.com/lib/pq"
 )
 
 const archiveRecipeRating = `-- name: ArchiveRecipeRating :execrows
@@ -129,6 +131,55 @@ func (q *Queries) GetRecipeRating(ctx context.Context, db DBTX, id string) (*Rec
 	return &i, err
 }
 
+const getRecipeRatingSummariesForAccount = `-- name: GetRecipeRatingSummariesForAccount :many
+SELECT
+	recipe_ratings.belongs_to_recipe AS recipe_id,
+	AVG(recipe_ratings.overall)::REAL AS average_overall,
+	COUNT(recipe_ratings.id) AS rating_count
+FROM recipe_ratings
+	JOIN account_user_memberships ON account_user_memberships.belongs_to_user = recipe_ratings.created_by_user
+WHERE recipe_ratings.archived_at IS NULL
+	AND account_user_memberships.archived_at IS NULL
+	AND account_user_memberships.belongs_to_account = $1
+	AND recipe_ratings.belongs_to_recipe = ANY($2::text[])
+GROUP BY recipe_ratings.belongs_to_recipe
+ORDER BY recipe_ratings.belongs_to_recipe
+`
+
+type GetRecipeRatingSummariesForAccountParams struct {
+	BelongsToAccount string
+	RecipeIds        []string
+}
+
+type GetRecipeRatingSummariesForAccountRow struct {
+	RecipeID       string
+	AverageOverall float32
+	RatingCount    int64
+}
+
+func (q *Queries) GetRecipeRatingSummariesForAccount(ctx context.Context, db DBTX, arg *GetRecipeRatingSummariesForAccountParams) ([]*GetRecipeRatingSummariesForAccountRow, error) {
+	rows, err := db.QueryContext(ctx, getRecipeRatingSummariesForAccount, arg.BelongsToAccount, pq.Array(arg.RecipeIds))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*GetRecipeRatingSummariesForAccountRow{}
+	for rows.Next() {
+		var i GetRecipeRatingSummariesForAccountRow
+		if err := rows.Scan(&i.RecipeID, &i.AverageOverall, &i.RatingCount); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const getRecipeRatingsForRecipe = `-- name: GetRecipeRatingsForRecipe :many
 SELECT
 	recipe_ratings.id,
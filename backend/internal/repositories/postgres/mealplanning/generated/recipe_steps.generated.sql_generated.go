@@ -53,6 +53,15 @@ func (q *Queries) CheckRecipeStepExistence(ctx context.Context, db DBTX, arg *Ch
 	return exists, err
 }
 
+const archiveRecipeStepsForRecipe = `-- name: ArchiveRecipeStepsForRecipe :exec
+UPDATE recipe_steps SET archived_at = NOW() WHERE archived_at IS NULL AND belongs_to_recipe = $1
+`
+
+func (q *Queries) ArchiveRecipeStepsForRecipe(ctx context.Context, db DBTX, belongsToRecipe string) error {
+	_, err := db.ExecContext(ctx, archiveRecipeStepsForRecipe, belongsToRecipe)
+	return err
+}
+
 const createRecipeStep = `-- name: CreateRecipeStep :exec
 INSERT INTO recipe_steps (
 	id,
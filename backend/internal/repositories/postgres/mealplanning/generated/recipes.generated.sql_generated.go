@@ -1137,6 +1137,34 @@ func (q *Queries) GetRecipesWithIDs(ctx context.Context, db DBTX, ids []string)
 	return items, nil
 }
 
+const lockRecipe = `-- name: LockRecipe :one
+SELECT recipes.id
+FROM recipes
+WHERE recipes.id = $1
+FOR UPDATE
+`
+
+func (q *Queries) LockRecipe(ctx context.Context, db DBTX, id string) (string, error) {
+	row := db.QueryRowContext(ctx, lockRecipe, id)
+	err := row.Scan(&id)
+	return id, err
+}
+
+const lockRecipeForRecipeStep = `-- name: LockRecipeForRecipeStep :one
+SELECT recipes.id
+FROM recipes
+	JOIN recipe_steps ON recipe_steps.belongs_to_recipe = recipes.id
+WHERE recipe_steps.id = $1
+FOR UPDATE OF recipes
+`
+
+func (q *Queries) LockRecipeForRecipeStep(ctx context.Context, db DBTX, recipeStepID string) (string, error) {
+	row := db.QueryRowContext(ctx, lockRecipeForRecipeStep, recipeStepID)
+	var id string
+	err := row.Scan(&id)
+	return id, err
+}
+
 const recipeSearch = `-- name: RecipeSearch :many
 SELECT
 	recipes.id,
@@ -0,0 +1,107 @@
+package mealplanning
+
+import (
+	"context"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+	mealplanningkeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/keys"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/repositories/postgres/mealplanning/generated"
+
+	platformerrors "github.com/primandproper/platform/errors"
+	"github.com/primandproper/platform/observability"
+	"github.com/primandproper/platform/observability/tracing"
+)
+
+var (
+	_ mealplanning.MealPlanActivityDataManager = (*repository)(nil)
+)
+
+// CreateMealPlanActivity records a change to a meal plan and notifies its watchers once the change is committed.
+func (q *repository) CreateMealPlanActivity(ctx context.Context, input *mealplanning.MealPlanActivityDatabaseCreationInput) error {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if input == nil {
+		return platformerrors.ErrNilInputProvided
+	}
+	tracing.AttachToSpan(span, mealplanningkeys.MealPlanActivityIDKey, input.ID)
+	logger := q.logger.WithValue(mealplanningkeys.MealPlanActivityIDKey, input.ID).WithValue(mealplanningkeys.MealPlanIDKey, input.BelongsToMealPlan)
+
+	tx, err := q.writeDB.BeginTx(ctx, nil)
+	if err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "beginning transaction")
+	}
+
+	// a sequence number is drawn when the row is inserted, not when it's committed, so writers to the same meal plan
+	// take turns; otherwise a watcher could read a later sequence before an earlier one commits and skip past it.
+	if err = q.generatedQuerier.LockMealPlanActivityFeed(ctx, tx, input.BelongsToMealPlan); err != nil {
+		q.RollbackTransaction(ctx, tx)
+		return observability.PrepareAndLogError(err, logger, span, "locking meal plan activity feed")
+	}
+
+	if err = q.generatedQuerier.CreateMealPlanActivity(ctx, tx, &generated.CreateMealPlanActivityParams{
+		ID:                   input.ID,
+		EventType:            input.EventType,
+		MealPlanEventID:      input.MealPlanEventID,
+		MealPlanOptionID:     input.MealPlanOptionID,
+		MealPlanOptionVoteID: input.MealPlanOptionVoteID,
+		MealPlanTaskID:       input.MealPlanTaskID,
+		ByUser:               input.ByUser,
+		BelongsToMealPlan:    input.BelongsToMealPlan,
+	}); err != nil {
+		q.RollbackTransaction(ctx, tx)
+		return observability.PrepareAndLogError(err, logger, span, "performing meal plan activity creation query")
+	}
+
+	// notifications are delivered on commit, so watchers never wake before the activity is visible.
+	if err = q.generatedQuerier.NotifyMealPlanActivity(ctx, tx, input.BelongsToMealPlan); err != nil {
+		q.RollbackTransaction(ctx, tx)
+		return observability.PrepareAndLogError(err, logger, span, "notifying meal plan activity watchers")
+	}
+
+	if err = tx.Commit(); err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "committing transaction")
+	}
+
+	return nil
+}
+
+// GetMealPlanActivitiesAfterCursor fetches a meal plan's activities recorded after the given sequence number, oldest first.
+// A sequence of zero fetches from the beginning of the feed.
+func (q *repository) GetMealPlanActivitiesAfterCursor(ctx context.Context, mealPlanID string, afterSequence uint64, limit uint8) ([]*mealplanning.MealPlanActivity, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if mealPlanID == "" {
+		return nil, platformerrors.ErrInvalidIDProvided
+	}
+	tracing.AttachToSpan(span, mealplanningkeys.MealPlanIDKey, mealPlanID)
+	logger := q.logger.WithValue(mealplanningkeys.MealPlanIDKey, mealPlanID).WithValue("after_sequence", afterSequence)
+
+	results, err := q.generatedQuerier.GetMealPlanActivitiesAfterCursor(ctx, q.readDB, &generated.GetMealPlanActivitiesAfterCursorParams{
+		MealPlanID:    mealPlanID,
+		AfterSequence: int64(afterSequence),
+		ResultLimit:   int32(limit),
+	})
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching meal plan activities")
+	}
+
+	activities := make([]*mealplanning.MealPlanActivity, 0, len(results))
+	for _, result := range results {
+		activities = append(activities, &mealplanning.MealPlanActivity{
+			CreatedAt:            result.CreatedAt,
+			ID:                   result.ID,
+			EventType:            result.EventType,
+			MealPlanEventID:      result.MealPlanEventID,
+			MealPlanOptionID:     result.MealPlanOptionID,
+			MealPlanOptionVoteID: result.MealPlanOptionVoteID,
+			MealPlanTaskID:       result.MealPlanTaskID,
+			ByUser:               result.ByUser,
+			BelongsToMealPlan:    result.BelongsToMealPlan,
+			Sequence:             uint64(result.Sequence),
+		})
+	}
+
+	return activities, nil
+}
@@ -0,0 +1,211 @@
+package mealplanning
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/converters"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/fakes"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/repositories/postgres/mealplanning/generated"
+	pgtesting "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/repositories/postgres/testing"
+
+	"github.com/primandproper/platform/identifiers"
+	loggingnoop "github.com/primandproper/platform/observability/logging/noop"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuerier_Integration_MealPlanActivities(t *testing.T) {
+	if !pgtesting.RunContainerTests {
+		t.SkipNow()
+	}
+
+	ctx := t.Context()
+	dbc, _, container := buildDatabaseClientForTest(t)
+
+	databaseURI, err := container.ConnectionString(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, databaseURI)
+
+	defer func(t *testing.T) {
+		t.Helper()
+		assert.NoError(t, container.Terminate(ctx))
+	}(t)
+
+	user := pgtesting.CreateUserForTest(t, nil, dbc.writeDB)
+	account := pgtesting.CreateAccountForTest(t, nil, user.ID, dbc.writeDB)
+
+	recipe := createRecipeForTest(t, ctx, nil, dbc, true)
+	buildMealForIntegrationTest(user.ID, recipe)
+	meal := createMealForTest(t, ctx, nil, dbc)
+
+	exampleMealPlan := buildMealPlanForIntegrationTest(user.ID, meal)
+	exampleMealPlan.BelongsToAccount = account.ID
+	mealPlan := createMealPlanForTest(t, ctx, exampleMealPlan, dbc)
+
+	created := []*mealplanning.MealPlanActivity{}
+	for _, eventType := range []string{
+		mealplanning.MealPlanOptionVoteCreatedServiceEventType,
+		mealplanning.MealPlanFinalizedServiceEventType,
+		mealplanning.MealPlanTaskStatusChangedServiceEventType,
+	} {
+		exampleActivity := fakes.BuildFakeMealPlanActivity()
+		exampleActivity.ID = identifiers.New()
+		exampleActivity.EventType = eventType
+		exampleActivity.ByUser = user.ID
+		exampleActivity.BelongsToMealPlan = mealPlan.ID
+		require.NoError(t, dbc.CreateMealPlanActivity(ctx, converters.ConvertMealPlanActivityToMealPlanActivityDatabaseCreationInput(exampleActivity)))
+		created = append(created, exampleActivity)
+	}
+
+	activities, err := dbc.GetMealPlanActivitiesAfterCursor(ctx, mealPlan.ID, 0, 50)
+	require.NoError(t, err)
+	require.Len(t, activities, len(created))
+	for i := range created {
+		assert.Equal(t, created[i].ID, activities[i].ID)
+		assert.Equal(t, created[i].EventType, activities[i].EventType)
+		if i > 0 {
+			assert.Greater(t, activities[i].Sequence, activities[i-1].Sequence)
+		}
+	}
+
+	// resuming from a sequence only returns what came after it, regardless of how the IDs sort.
+	activities, err = dbc.GetMealPlanActivitiesAfterCursor(ctx, mealPlan.ID, activities[0].Sequence, 50)
+	require.NoError(t, err)
+	require.Len(t, activities, len(created)-1)
+	assert.Equal(t, created[1].ID, activities[0].ID)
+
+	lastSequence := activities[len(activities)-1].Sequence
+	activities, err = dbc.GetMealPlanActivitiesAfterCursor(ctx, mealPlan.ID, lastSequence, 50)
+	require.NoError(t, err)
+	assert.Empty(t, activities)
+
+	// a writer holding the meal plan's feed keeps later writers from committing ahead of it.
+	tx, err := dbc.writeDB.BeginTx(ctx, nil)
+	require.NoError(t, err)
+	require.NoError(t, dbc.generatedQuerier.LockMealPlanActivityFeed(ctx, tx, mealPlan.ID))
+
+	slowActivity := fakes.BuildFakeMealPlanActivity()
+	slowActivity.ID = identifiers.New()
+	slowActivity.ByUser = user.ID
+	slowActivity.BelongsToMealPlan = mealPlan.ID
+	slowInput := converters.ConvertMealPlanActivityToMealPlanActivityDatabaseCreationInput(slowActivity)
+	require.NoError(t, dbc.generatedQuerier.CreateMealPlanActivity(ctx, tx, &generated.CreateMealPlanActivityParams{
+		ID:                   slowInput.ID,
+		EventType:            slowInput.EventType,
+		MealPlanEventID:      slowInput.MealPlanEventID,
+		MealPlanOptionID:     slowInput.MealPlanOptionID,
+		MealPlanOptionVoteID: slowInput.MealPlanOptionVoteID,
+		MealPlanTaskID:       slowInput.MealPlanTaskID,
+		ByUser:               slowInput.ByUser,
+		BelongsToMealPlan:    slowInput.BelongsToMealPlan,
+	}))
+
+	fastActivity := fakes.BuildFakeMealPlanActivity()
+	fastActivity.ID = identifiers.New()
+	fastActivity.ByUser = user.ID
+	fastActivity.BelongsToMealPlan = mealPlan.ID
+	fastDone := make(chan error, 1)
+	go func() {
+		fastDone <- dbc.CreateMealPlanActivity(ctx, converters.ConvertMealPlanActivityToMealPlanActivityDatabaseCreationInput(fastActivity))
+	}()
+
+	select {
+	case err = <-fastDone:
+		t.Fatalf("activity was recorded while another writer held the feed: %v", err)
+	case <-time.After(500 * time.Millisecond):
+	}
+
+	activities, err = dbc.GetMealPlanActivitiesAfterCursor(ctx, mealPlan.ID, lastSequence, 50)
+	require.NoError(t, err)
+	assert.Empty(t, activities)
+
+	require.NoError(t, tx.Commit())
+	require.NoError(t, <-fastDone)
+
+	activities, err = dbc.GetMealPlanActivitiesAfterCursor(ctx, mealPlan.ID, lastSequence, 50)
+	require.NoError(t, err)
+	require.Len(t, activities, 2)
+	assert.Equal(t, slowActivity.ID, activities[0].ID)
+	assert.Equal(t, fastActivity.ID, activities[1].ID)
+}
+
+func TestQuerier_Integration_MealPlanActivityNotifier(t *testing.T) {
+	if !pgtesting.RunContainerTests {
+		t.SkipNow()
+	}
+
+	ctx := t.Context()
+	dbc, _, container := buildDatabaseClientForTest(t)
+
+	databaseURI, err := container.ConnectionString(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, databaseURI)
+
+	defer func(t *testing.T) {
+		t.Helper()
+		assert.NoError(t, container.Terminate(ctx))
+	}(t)
+
+	user := pgtesting.CreateUserForTest(t, nil, dbc.writeDB)
+	account := pgtesting.CreateAccountForTest(t, nil, user.ID, dbc.writeDB)
+
+	recipe := createRecipeForTest(t, ctx, nil, dbc, true)
+	buildMealForIntegrationTest(user.ID, recipe)
+	meal := createMealForTest(t, ctx, nil, dbc)
+
+	exampleMealPlan := buildMealPlanForIntegrationTest(user.ID, meal)
+	exampleMealPlan.BelongsToAccount = account.ID
+	mealPlan := createMealPlanForTest(t, ctx, exampleMealPlan, dbc)
+
+	notifier := ProvideMealPlanActivityNotifier(loggingnoop.NewLogger(), dbc.Client)
+	notifications := notifier.SubscribeToMealPlanActivity(ctx, mealPlan.ID)
+
+	exampleActivity := fakes.BuildFakeMealPlanActivity()
+	exampleActivity.ID = identifiers.New()
+	exampleActivity.ByUser = user.ID
+	exampleActivity.BelongsToMealPlan = mealPlan.ID
+
+	// the listener connects in the background, so keep recording activity until a notification lands.
+	require.Eventually(t, func() bool {
+		exampleActivity.ID = identifiers.New()
+		require.NoError(t, dbc.CreateMealPlanActivity(ctx, converters.ConvertMealPlanActivityToMealPlanActivityDatabaseCreationInput(exampleActivity)))
+
+		select {
+		case <-notifications:
+			return true
+		case <-time.After(100 * time.Millisecond):
+			return false
+		}
+	}, 10*time.Second, 100*time.Millisecond)
+}
+
+func TestQuerier_CreateMealPlanActivity(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with nil input", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		assert.Error(t, c.CreateMealPlanActivity(ctx, nil))
+	})
+}
+
+func TestQuerier_GetMealPlanActivitiesAfterCursor(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid meal plan ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		actual, err := c.GetMealPlanActivitiesAfterCursor(ctx, "", 0, 50)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
@@ -0,0 +1,162 @@
+package mealplanning
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+
+	"github.com/primandproper/platform/database"
+	"github.com/primandproper/platform/observability/logging"
+
+	"github.com/jackc/pgx/v5/stdlib"
+)
+
+const (
+	notifierO11yName = "meal_plan_activity_notifier"
+
+	// mealPlanActivityNotificationChannel is the channel NotifyMealPlanActivity publishes meal plan IDs to.
+	mealPlanActivityNotificationChannel = "meal_plan_activity"
+	mealPlanActivityListenRetryInterval = 5 * time.Second
+)
+
+var (
+	_ mealplanning.MealPlanActivityNotifier = (*mealPlanActivityNotifier)(nil)
+
+	errUnexpectedDriverConnection = errors.New("database connection does not support notifications")
+)
+
+// mealPlanActivityNotifier fans out meal plan activity notifications from a single LISTEN connection to subscribed watchers.
+type mealPlanActivityNotifier struct {
+	logger         logging.Logger
+	db             *sql.DB
+	subscribers    map[string]map[chan struct{}]struct{}
+	stopListening  context.CancelFunc
+	subscribersHat sync.Mutex
+}
+
+// ProvideMealPlanActivityNotifier provides a new MealPlanActivityNotifier.
+func ProvideMealPlanActivityNotifier(logger logging.Logger, client database.Client) mealplanning.MealPlanActivityNotifier {
+	return &mealPlanActivityNotifier{
+		// notifications are only delivered to sessions on the server that issued them, which is the primary.
+		db:          client.WriteDB(),
+		logger:      logging.NewNamedLogger(logger, notifierO11yName),
+		subscribers: map[string]map[chan struct{}]struct{}{},
+	}
+}
+
+// SubscribeToMealPlanActivity returns a channel that receives a value whenever activity is recorded for the given meal plan.
+// The subscription ends when the context is cancelled. Notifications are coalesced, so a receive means "check for new activity".
+func (n *mealPlanActivityNotifier) SubscribeToMealPlanActivity(ctx context.Context, mealPlanID string) <-chan struct{} {
+	ch := make(chan struct{}, 1)
+
+	n.subscribersHat.Lock()
+	if n.subscribers[mealPlanID] == nil {
+		n.subscribers[mealPlanID] = map[chan struct{}]struct{}{}
+	}
+	n.subscribers[mealPlanID][ch] = struct{}{}
+
+	if n.stopListening == nil {
+		listenCtx, cancel := context.WithCancel(context.WithoutCancel(ctx))
+		n.stopListening = cancel
+		go n.listen(listenCtx)
+	}
+	n.subscribersHat.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		n.unsubscribe(mealPlanID, ch)
+	}()
+
+	return ch
+}
+
+func (n *mealPlanActivityNotifier) unsubscribe(mealPlanID string, ch chan struct{}) {
+	n.subscribersHat.Lock()
+	defer n.subscribersHat.Unlock()
+
+	delete(n.subscribers[mealPlanID], ch)
+	if len(n.subscribers[mealPlanID]) == 0 {
+		delete(n.subscribers, mealPlanID)
+	}
+
+	// stop holding a connection open once nobody is watching.
+	if len(n.subscribers) == 0 && n.stopListening != nil {
+		n.stopListening()
+		n.stopListening = nil
+	}
+}
+
+// wake signals the subscribers for a meal plan, or every subscriber when mealPlanID is empty.
+func (n *mealPlanActivityNotifier) wake(mealPlanID string) {
+	n.subscribersHat.Lock()
+	defer n.subscribersHat.Unlock()
+
+	for id, channels := range n.subscribers {
+		if mealPlanID != "" && id != mealPlanID {
+			continue
+		}
+
+		for ch := range channels {
+			select {
+			case ch <- struct{}{}:
+			default:
+			}
+		}
+	}
+}
+
+func (n *mealPlanActivityNotifier) listen(ctx context.Context) {
+	for {
+		err := n.listenOnce(ctx)
+		if ctx.Err() != nil {
+			return
+		}
+		n.logger.Error("listening for meal plan activity", err)
+
+		// notifications may have been missed while disconnected, so have every watcher check for itself.
+		n.wake("")
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(mealPlanActivityListenRetryInterval):
+		}
+	}
+}
+
+func (n *mealPlanActivityNotifier) listenOnce(ctx context.Context) error {
+	conn, err := n.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		// the connection is still subscribed to the channel, so don't return it to the pool.
+		_ = conn.Raw(func(any) error { return driver.ErrBadConn })
+		_ = conn.Close()
+	}()
+
+	if _, err = conn.ExecContext(ctx, "LISTEN "+mealPlanActivityNotificationChannel); err != nil {
+		return err
+	}
+
+	return conn.Raw(func(driverConn any) error {
+		pgxConn, ok := driverConn.(*stdlib.Conn)
+		if !ok {
+			return errUnexpectedDriverConnection
+		}
+
+		for {
+			notification, waitErr := pgxConn.Conn().WaitForNotification(ctx)
+			if waitErr != nil {
+				return waitErr
+			}
+
+			n.wake(notification.Payload)
+		}
+	})
+}
@@ -0,0 +1,116 @@
+package mealplanning
+
+import (
+	"context"
+
+	identitykeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity/keys"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+	mealplanningkeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/keys"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/repositories/postgres/mealplanning/generated"
+
+	"github.com/primandproper/platform/database"
+	platformerrors "github.com/primandproper/platform/errors"
+	"github.com/primandproper/platform/observability"
+	"github.com/primandproper/platform/observability/tracing"
+)
+
+var (
+	_ mealplanning.MealPlanCalendarFeedDataManager = (*repository)(nil)
+)
+
+// CreateMealPlanCalendarFeed creates a meal plan calendar feed in the database.
+func (q *repository) CreateMealPlanCalendarFeed(ctx context.Context, input *mealplanning.MealPlanCalendarFeedDatabaseCreationInput) (*mealplanning.MealPlanCalendarFeed, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if input == nil {
+		return nil, platformerrors.ErrNilInputProvided
+	}
+	tracing.AttachToSpan(span, mealplanningkeys.MealPlanCalendarFeedIDKey, input.ID)
+	logger := q.logger.WithValue(mealplanningkeys.MealPlanCalendarFeedIDKey, input.ID).WithValue(identitykeys.UserIDKey, input.BelongsToUser)
+
+	if err := q.generatedQuerier.CreateMealPlanCalendarFeed(ctx, q.writeDB, &generated.CreateMealPlanCalendarFeedParams{
+		ID:               input.ID,
+		TokenHash:        input.TokenHash,
+		BelongsToUser:    input.BelongsToUser,
+		BelongsToAccount: input.BelongsToAccount,
+	}); err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "performing meal plan calendar feed creation query")
+	}
+
+	x := &mealplanning.MealPlanCalendarFeed{
+		CreatedAt:        q.CurrentTime(),
+		ID:               input.ID,
+		BelongsToUser:    input.BelongsToUser,
+		BelongsToAccount: input.BelongsToAccount,
+	}
+
+	return x, nil
+}
+
+// GetMealPlanCalendarFeedByTokenHash fetches an unarchived meal plan calendar feed by the hash of its token.
+func (q *repository) GetMealPlanCalendarFeedByTokenHash(ctx context.Context, tokenHash string) (*mealplanning.MealPlanCalendarFeed, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if tokenHash == "" {
+		return nil, platformerrors.ErrEmptyInputProvided
+	}
+
+	result, err := q.generatedQuerier.GetMealPlanCalendarFeedByTokenHash(ctx, q.readDB, tokenHash)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, q.logger, span, "fetching meal plan calendar feed")
+	}
+
+	x := &mealplanning.MealPlanCalendarFeed{
+		CreatedAt:        result.CreatedAt,
+		LastAccessedAt:   database.TimePointerFromNullTime(result.LastAccessedAt),
+		ArchivedAt:       database.TimePointerFromNullTime(result.ArchivedAt),
+		ID:               result.ID,
+		BelongsToUser:    result.BelongsToUser,
+		BelongsToAccount: result.BelongsToAccount,
+	}
+
+	return x, nil
+}
+
+// ArchiveMealPlanCalendarFeedsForUser archives every active meal plan calendar feed a user has for an account.
+func (q *repository) ArchiveMealPlanCalendarFeedsForUser(ctx context.Context, userID, accountID string) error {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if userID == "" || accountID == "" {
+		return platformerrors.ErrInvalidIDProvided
+	}
+	tracing.AttachToSpan(span, identitykeys.UserIDKey, userID)
+	tracing.AttachToSpan(span, identitykeys.AccountIDKey, accountID)
+	logger := q.logger.WithValue(identitykeys.UserIDKey, userID).WithValue(identitykeys.AccountIDKey, accountID)
+
+	// having no feeds to archive is fine.
+	if _, err := q.generatedQuerier.ArchiveMealPlanCalendarFeedsForUser(ctx, q.writeDB, &generated.ArchiveMealPlanCalendarFeedsForUserParams{
+		BelongsToUser:    userID,
+		BelongsToAccount: accountID,
+	}); err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "archiving meal plan calendar feeds")
+	}
+
+	return nil
+}
+
+// MarkMealPlanCalendarFeedAsAccessed records that a meal plan calendar feed was just fetched.
+func (q *repository) MarkMealPlanCalendarFeedAsAccessed(ctx context.Context, mealPlanCalendarFeedID string) error {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if mealPlanCalendarFeedID == "" {
+		return platformerrors.ErrInvalidIDProvided
+	}
+	tracing.AttachToSpan(span, mealplanningkeys.MealPlanCalendarFeedIDKey, mealPlanCalendarFeedID)
+	logger := q.logger.WithValue(mealplanningkeys.MealPlanCalendarFeedIDKey, mealPlanCalendarFeedID)
+
+	if err := q.generatedQuerier.MarkMealPlanCalendarFeedAsAccessed(ctx, q.writeDB, mealPlanCalendarFeedID); err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "marking meal plan calendar feed as accessed")
+	}
+
+	return nil
+}
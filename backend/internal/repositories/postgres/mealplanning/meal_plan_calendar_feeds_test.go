@@ -0,0 +1,133 @@
+package mealplanning
+
+import (
+	"database/sql"
+	"testing"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+	pgtesting "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/repositories/postgres/testing"
+
+	"github.com/primandproper/platform/identifiers"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuerier_Integration_MealPlanCalendarFeeds(t *testing.T) {
+	if !pgtesting.RunContainerTests {
+		t.SkipNow()
+	}
+
+	ctx := t.Context()
+	dbc, _, container := buildDatabaseClientForTest(t)
+
+	databaseURI, err := container.ConnectionString(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, databaseURI)
+
+	defer func(t *testing.T) {
+		t.Helper()
+		assert.NoError(t, container.Terminate(ctx))
+	}(t)
+
+	user := pgtesting.CreateUserForTest(t, nil, dbc.writeDB)
+	account := pgtesting.CreateAccountForTest(t, nil, user.ID, dbc.writeDB)
+
+	input := &mealplanning.MealPlanCalendarFeedDatabaseCreationInput{
+		ID:               identifiers.New(),
+		TokenHash:        identifiers.New(),
+		BelongsToUser:    user.ID,
+		BelongsToAccount: account.ID,
+	}
+
+	created, err := dbc.CreateMealPlanCalendarFeed(ctx, input)
+	require.NoError(t, err)
+	assert.Equal(t, input.ID, created.ID)
+
+	fetched, err := dbc.GetMealPlanCalendarFeedByTokenHash(ctx, input.TokenHash)
+	require.NoError(t, err)
+	assert.Equal(t, input.ID, fetched.ID)
+	assert.Equal(t, user.ID, fetched.BelongsToUser)
+	assert.Equal(t, account.ID, fetched.BelongsToAccount)
+	assert.Nil(t, fetched.LastAccessedAt)
+
+	require.NoError(t, dbc.MarkMealPlanCalendarFeedAsAccessed(ctx, fetched.ID))
+
+	fetched, err = dbc.GetMealPlanCalendarFeedByTokenHash(ctx, input.TokenHash)
+	require.NoError(t, err)
+	assert.NotNil(t, fetched.LastAccessedAt)
+
+	require.NoError(t, dbc.ArchiveMealPlanCalendarFeedsForUser(ctx, user.ID, account.ID))
+
+	fetched, err = dbc.GetMealPlanCalendarFeedByTokenHash(ctx, input.TokenHash)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+	assert.Nil(t, fetched)
+
+	// archiving when there's nothing left to archive is fine.
+	assert.NoError(t, dbc.ArchiveMealPlanCalendarFeedsForUser(ctx, user.ID, account.ID))
+}
+
+func TestQuerier_CreateMealPlanCalendarFeed(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with nil input", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		actual, err := c.CreateMealPlanCalendarFeed(ctx, nil)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
+
+func TestQuerier_GetMealPlanCalendarFeedByTokenHash(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with empty token hash", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		actual, err := c.GetMealPlanCalendarFeedByTokenHash(ctx, "")
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
+
+func TestQuerier_ArchiveMealPlanCalendarFeedsForUser(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid user ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		assert.Error(t, c.ArchiveMealPlanCalendarFeedsForUser(ctx, "", identifiers.New()))
+	})
+
+	T.Run("with invalid account ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		assert.Error(t, c.ArchiveMealPlanCalendarFeedsForUser(ctx, identifiers.New(), ""))
+	})
+}
+
+func TestQuerier_MarkMealPlanCalendarFeedAsAccessed(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		assert.Error(t, c.MarkMealPlanCalendarFeedAsAccessed(ctx, ""))
+	})
+}
@@ -0,0 +1,145 @@
+package mealplanning
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/elections"
+	mealplanningkeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/keys"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/repositories/postgres/mealplanning/generated"
+
+	"github.com/primandproper/platform/database"
+	platformerrors "github.com/primandproper/platform/errors"
+	"github.com/primandproper/platform/identifiers"
+	"github.com/primandproper/platform/observability"
+	"github.com/primandproper/platform/observability/tracing"
+)
+
+var (
+	_ mealplanning.MealPlanEventTallyReportDataManager = (*repository)(nil)
+)
+
+// tallyReportDetails is the method-specific portion of a tally report, stored as JSON.
+type tallyReportDetails struct {
+	TieBreak            *mealplanning.ElectionTieBreak             `json:"tieBreak,omitempty"`
+	Candidates          []string                                   `json:"candidates"`
+	Scores              []*mealplanning.ElectionCandidateScore     `json:"scores"`
+	PairwisePreferences []*mealplanning.ElectionPairwisePreference `json:"pairwisePreferences,omitempty"`
+	Rounds              []*mealplanning.ElectionRound              `json:"rounds,omitempty"`
+}
+
+// tallyMealPlanEvent decides the winning option for a meal plan event. It returns a nil report when no option has votes.
+func (q *repository) tallyMealPlanEvent(ctx context.Context, electionMethod, mealPlanEventID string, options []*mealplanning.MealPlanOption) (*mealplanning.MealPlanEventTallyReport, error) {
+	_, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := q.logger.WithValue("options.count", len(options)).WithValue("election_method", electionMethod)
+
+	report, err := elections.Tally(electionMethod, options)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "tallying meal plan event votes")
+	}
+
+	if report == nil {
+		return nil, nil
+	}
+
+	report.ID = identifiers.New()
+	report.BelongsToMealPlanEvent = mealPlanEventID
+
+	return report, nil
+}
+
+// finalizeMealPlanEventWinner marks a tally report's winning option as chosen and persists the report.
+func (q *repository) finalizeMealPlanEventWinner(ctx context.Context, db generated.DBTX, report *mealplanning.MealPlanEventTallyReport) error {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := q.logger.WithValue(mealplanningkeys.MealPlanEventIDKey, report.BelongsToMealPlanEvent).WithValue("winner", report.Winner)
+	tracing.AttachToSpan(span, mealplanningkeys.MealPlanEventIDKey, report.BelongsToMealPlanEvent)
+
+	if err := q.generatedQuerier.FinalizeMealPlanOption(ctx, db, &generated.FinalizeMealPlanOptionParams{
+		MealPlanEventID: database.NullStringFromString(report.BelongsToMealPlanEvent),
+		ID:              report.Winner,
+		Tiebroken:       report.TieBroken,
+	}); err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "finalizing meal plan option")
+	}
+
+	details, err := json.Marshal(&tallyReportDetails{
+		TieBreak:            report.TieBreak,
+		Candidates:          report.Candidates,
+		Scores:              report.Scores,
+		PairwisePreferences: report.PairwisePreferences,
+		Rounds:              report.Rounds,
+	})
+	if err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "marshaling tally report details")
+	}
+
+	if err = q.generatedQuerier.CreateMealPlanEventTallyReport(ctx, db, &generated.CreateMealPlanEventTallyReportParams{
+		ID:                     report.ID,
+		BelongsToMealPlanEvent: report.BelongsToMealPlanEvent,
+		ElectionMethod:         generated.ValidElectionMethod(report.ElectionMethod),
+		Winner:                 report.Winner,
+		TieBroken:              report.TieBroken,
+		BallotCount:            int32(report.BallotCount),
+		Details:                details,
+	}); err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "creating meal plan event tally report")
+	}
+
+	return nil
+}
+
+// GetMealPlanEventTallyReport fetches the report explaining how a finalized meal plan event's option was chosen.
+func (q *repository) GetMealPlanEventTallyReport(ctx context.Context, mealPlanID, mealPlanEventID string) (*mealplanning.MealPlanEventTallyReport, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := q.logger.Clone()
+
+	if mealPlanID == "" {
+		return nil, platformerrors.ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(mealplanningkeys.MealPlanIDKey, mealPlanID)
+	tracing.AttachToSpan(span, mealplanningkeys.MealPlanIDKey, mealPlanID)
+
+	if mealPlanEventID == "" {
+		return nil, platformerrors.ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(mealplanningkeys.MealPlanEventIDKey, mealPlanEventID)
+	tracing.AttachToSpan(span, mealplanningkeys.MealPlanEventIDKey, mealPlanEventID)
+
+	result, err := q.generatedQuerier.GetMealPlanEventTallyReport(ctx, q.readDB, &generated.GetMealPlanEventTallyReportParams{
+		MealPlanID:      mealPlanID,
+		MealPlanEventID: mealPlanEventID,
+	})
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "executing meal plan event tally report retrieval query")
+	}
+
+	var details tallyReportDetails
+	if err = json.Unmarshal(result.Details, &details); err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "unmarshaling tally report details")
+	}
+
+	report := &mealplanning.MealPlanEventTallyReport{
+		CreatedAt:              result.CreatedAt,
+		ArchivedAt:             database.TimePointerFromNullTime(result.ArchivedAt),
+		TieBreak:               details.TieBreak,
+		ID:                     result.ID,
+		BelongsToMealPlanEvent: result.BelongsToMealPlanEvent,
+		ElectionMethod:         string(result.ElectionMethod),
+		Winner:                 result.Winner,
+		Candidates:             details.Candidates,
+		Scores:                 details.Scores,
+		PairwisePreferences:    details.PairwisePreferences,
+		Rounds:                 details.Rounds,
+		BallotCount:            int(result.BallotCount),
+		TieBroken:              result.TieBroken,
+	}
+
+	return report, nil
+}
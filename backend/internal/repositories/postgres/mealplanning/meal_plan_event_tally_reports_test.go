@@ -0,0 +1,88 @@
+package mealplanning
+
+import (
+	"testing"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/fakes"
+	pgtesting "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/repositories/postgres/testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuerier_Integration_MealPlanEventTallyReports(t *testing.T) {
+	if !pgtesting.RunContainerTests {
+		t.SkipNow()
+	}
+
+	ctx := t.Context()
+	dbc, _, container := buildDatabaseClientForTest(t)
+
+	databaseURI, err := container.ConnectionString(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, databaseURI)
+
+	defer func(t *testing.T) {
+		t.Helper()
+		assert.NoError(t, container.Terminate(ctx))
+	}(t)
+
+	user := pgtesting.CreateUserForTest(t, nil, dbc.writeDB)
+	account := pgtesting.CreateAccountForTest(t, nil, user.ID, dbc.writeDB)
+
+	recipe := createRecipeForTest(t, ctx, nil, dbc, true)
+	buildMealForIntegrationTest(user.ID, recipe)
+	meal := createMealForTest(t, ctx, nil, dbc)
+
+	exampleMealPlan := buildMealPlanForIntegrationTest(user.ID, meal)
+	exampleMealPlan.BelongsToAccount = account.ID
+	mealPlan := createMealPlanForTest(t, ctx, exampleMealPlan, dbc)
+	mealPlanEvent := mealPlan.Events[0]
+	mealPlanOption := mealPlanEvent.Options[0]
+
+	exampleMealPlanOptionVote := fakes.BuildFakeMealPlanOptionVote()
+	exampleMealPlanOptionVote.ByUser = user.ID
+	exampleMealPlanOptionVote.BelongsToMealPlanOption = mealPlanOption.ID
+	exampleMealPlanOptionVote.Abstain = false
+	createMealPlanOptionVoteForTest(t, ctx, mealPlan.ID, mealPlanEvent.ID, exampleMealPlanOptionVote, dbc)
+
+	finalized, err := dbc.FinalizeMealPlanOption(ctx, mealPlan.ID, mealPlanEvent.ID, mealPlanOption.ID, account.ID)
+	require.NoError(t, err)
+	require.True(t, finalized)
+
+	report, err := dbc.GetMealPlanEventTallyReport(ctx, mealPlan.ID, mealPlanEvent.ID)
+	require.NoError(t, err)
+	assert.Equal(t, mealPlanEvent.ID, report.BelongsToMealPlanEvent)
+	assert.Equal(t, mealPlan.ElectionMethod, report.ElectionMethod)
+	assert.Equal(t, mealPlanOption.ID, report.Winner)
+	assert.Equal(t, []string{mealPlanOption.ID}, report.Candidates)
+	assert.Equal(t, 1, report.BallotCount)
+	assert.False(t, report.TieBroken)
+	assert.NotEmpty(t, report.Scores)
+}
+
+func TestQuerier_GetMealPlanEventTallyReport(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid meal plan ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		actual, err := c.GetMealPlanEventTallyReport(ctx, "", fakes.BuildFakeID())
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+
+	T.Run("with invalid meal plan event ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		actual, err := c.GetMealPlanEventTallyReport(ctx, fakes.BuildFakeID(), "")
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
@@ -417,8 +417,9 @@ func (q *repository) CreateMealPlanGroceryListItem(ctx context.Context, input *m
 	return q.createMealPlanGroceryListItem(ctx, q.writeDB, input)
 }
 
-// UpdateMealPlanGroceryListItem updates a particular meal plan grocery list.
-func (q *repository) UpdateMealPlanGroceryListItem(ctx context.Context, updated *mealplanning.MealPlanGroceryListItem) error {
+// UpdateMealPlanGroceryListItem updates a particular meal plan grocery list. When stocked is provided, the pantry item
+// is created in the same transaction, so an acquired item never goes missing from the pantry.
+func (q *repository) UpdateMealPlanGroceryListItem(ctx context.Context, updated *mealplanning.MealPlanGroceryListItem, stocked *mealplanning.PantryItemDatabaseCreationInput) error {
 	ctx, span := q.tracer.StartSpan(ctx)
 	defer span.End()
 
@@ -433,7 +434,12 @@ func (q *repository) UpdateMealPlanGroceryListItem(ctx context.Context, updated
 		purchasedMeasurementUnitID = &updated.PurchasedMeasurementUnit.ID
 	}
 
-	if _, err := q.generatedQuerier.UpdateMealPlanGroceryListItem(ctx, q.writeDB, &generated.UpdateMealPlanGroceryListItemParams{
+	tx, err := q.writeDB.BeginTx(ctx, nil)
+	if err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "beginning transaction")
+	}
+
+	if _, err = q.generatedQuerier.UpdateMealPlanGroceryListItem(ctx, tx, &generated.UpdateMealPlanGroceryListItemParams{
 		BelongsToMealPlanOption:  database.NullStringFromStringPointer(updated.BelongsToMealPlanOption),
 		RecipeID:                 database.NullStringFromStringPointer(updated.RecipeID),
 		RecipeStepID:             database.NullStringFromStringPointer(updated.RecipeStepID),
@@ -452,9 +458,21 @@ func (q *repository) UpdateMealPlanGroceryListItem(ctx context.Context, updated
 		PurchasedUpc:             database.NullStringFromStringPointer(updated.PurchasedUPC),
 		PurchasePrice:            database.NullStringFromFloat32Pointer(updated.PurchasePrice),
 	}); err != nil {
+		q.RollbackTransaction(ctx, tx)
 		return observability.PrepareAndLogError(err, logger, span, "updating meal plan grocery list")
 	}
 
+	if stocked != nil {
+		if _, err = q.createPantryItem(ctx, tx, stocked); err != nil {
+			q.RollbackTransaction(ctx, tx)
+			return observability.PrepareAndLogError(err, logger, span, "adding acquired grocery list item to pantry")
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "committing transaction")
+	}
+
 	logger.Info("meal plan grocery list updated")
 
 	return nil
@@ -93,7 +93,7 @@ func TestQuerier_Integration_MealPlanGroceryListItems(t *testing.T) {
 	createdMealPlanGroceryListItems = append(createdMealPlanGroceryListItems, createMealPlanGroceryListItemForTest(t, ctx, exampleMealPlanGroceryListItem, dbc))
 
 	// update
-	assert.NoError(t, dbc.UpdateMealPlanGroceryListItem(ctx, createdMealPlanGroceryListItems[0]))
+	assert.NoError(t, dbc.UpdateMealPlanGroceryListItem(ctx, createdMealPlanGroceryListItems[0], nil))
 
 	// fetch as list
 	mealPlanGroceryListItems, err := dbc.GetMealPlanGroceryListItemsForMealPlan(ctx, mealPlan.ID, nil)
@@ -184,7 +184,7 @@ func TestQuerier_UpdateMealPlanGroceryListItem(T *testing.T) {
 		ctx := t.Context()
 		c := buildInertClientForTest(t)
 
-		assert.Error(t, c.UpdateMealPlanGroceryListItem(ctx, nil))
+		assert.Error(t, c.UpdateMealPlanGroceryListItem(ctx, nil, nil))
 	})
 }
 
@@ -107,6 +107,7 @@ func (q *repository) GetMealPlanOptionVote(ctx context.Context, mealPlanID, meal
 		BelongsToMealPlanOption: result.BelongsToMealPlanOption,
 		ByUser:                  result.ByUser,
 		Rank:                    uint8(result.Rank),
+		Score:                   uint8(result.Score),
 		Abstain:                 result.Abstain,
 	}
 
@@ -158,6 +159,7 @@ func (q *repository) GetMealPlanOptionVotesForMealPlanOption(ctx context.Context
 			BelongsToMealPlanOption: result.BelongsToMealPlanOption,
 			ByUser:                  result.ByUser,
 			Rank:                    uint8(result.Rank),
+			Score:                   uint8(result.Score),
 			Abstain:                 result.Abstain,
 		}
 	}
@@ -232,6 +234,7 @@ func (q *repository) GetMealPlanOptionVotes(ctx context.Context, mealPlanID, mea
 			BelongsToMealPlanOption: result.BelongsToMealPlanOption,
 			ByUser:                  result.ByUser,
 			Rank:                    uint8(result.Rank),
+			Score:                   uint8(result.Score),
 			Abstain:                 result.Abstain,
 		})
 	}
@@ -276,6 +279,7 @@ func (q *repository) CreateMealPlanOptionVote(ctx context.Context, input *types.
 			ByUser:                  vote.ByUser,
 			BelongsToMealPlanOption: vote.BelongsToMealPlanOption,
 			Rank:                    int32(vote.Rank),
+			Score:                   int32(vote.Score),
 			Abstain:                 vote.Abstain,
 		}); err != nil {
 			q.RollbackTransaction(ctx, tx)
@@ -285,6 +289,7 @@ func (q *repository) CreateMealPlanOptionVote(ctx context.Context, input *types.
 		x := &types.MealPlanOptionVote{
 			ID:                      vote.ID,
 			Rank:                    vote.Rank,
+			Score:                   vote.Score,
 			Abstain:                 vote.Abstain,
 			Notes:                   vote.Notes,
 			ByUser:                  vote.ByUser,
@@ -322,6 +327,7 @@ func (q *repository) UpdateMealPlanOptionVote(ctx context.Context, updated *type
 		BelongsToMealPlanOption: updated.BelongsToMealPlanOption,
 		ID:                      updated.ID,
 		Rank:                    int32(updated.Rank),
+		Score:                   int32(updated.Score),
 		Abstain:                 updated.Abstain,
 	}); err != nil {
 		return observability.PrepareAndLogError(err, logger, span, "updating meal plan option vote")
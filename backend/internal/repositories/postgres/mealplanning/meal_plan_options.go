@@ -4,7 +4,7 @@
 	"context"
 	"database/sql"
 	"fmt"
-	"math/rand/v2"
+	"slices"
 
 	identitykeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity/keys"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
@@ -16,8 +16,6 @@
 	platformerrors "github.com/primandproper/platform/errors"
 	"github.com/primandproper/platform/observability"
 	"github.com/primandproper/platform/observability/tracing"
-
-	"resenje.org/schulze"
 )
 
 var (
@@ -117,9 +115,21 @@ func (q *repository) GetMealPlanOption(ctx context.Context, mealPlanID, mealPlan
 			MaxEstimatedPortions: database.Float32PointerFromNullString(result.MealMaxEstimatedPortions),
 			EligibleForMealPlans: result.MealEligibleForMealPlans,
 		},
-		MealScale: database.Float32FromString(result.MealScale),
-		Chosen:    result.Chosen,
-		TieBroken: result.Tiebroken,
+		MealScale:   database.Float32FromString(result.MealScale),
+		Chosen:      result.Chosen,
+		TieBroken:   result.Tiebroken,
+		CompletedAt: database.TimePointerFromNullTime(result.CompletedAt),
+	}
+
+	meal, err := q.GetMeal(ctx, result.MealID)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching meal for meal plan option")
+	}
+	mealPlanOption.Meal = *meal
+
+	mealPlanOption.RecipeRevisions, err = q.getRecipeRevisionsForMealPlanOption(ctx, mealPlanOption.ID)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching recipe revisions for meal plan option")
 	}
 
 	return mealPlanOption, nil
@@ -166,9 +176,10 @@ func (q *repository) getMealPlanOptionByID(ctx context.Context, mealPlanOptionID
 			Components:           []*mealplanning.MealComponent{},
 			EligibleForMealPlans: result.MealEligibleForMealPlans,
 		},
-		MealScale: database.Float32FromString(result.MealScale),
-		Chosen:    result.Chosen,
-		TieBroken: result.Tiebroken,
+		MealScale:   database.Float32FromString(result.MealScale),
+		Chosen:      result.Chosen,
+		TieBroken:   result.Tiebroken,
+		CompletedAt: database.TimePointerFromNullTime(result.CompletedAt),
 	}
 
 	return mealPlanOption, nil
@@ -222,6 +233,7 @@ func (q *repository) getMealPlanOptionsForMealPlanEvent(ctx context.Context, mea
 			MealScale:              database.Float32FromString(result.MealScale),
 			Chosen:                 result.Chosen,
 			TieBroken:              result.Tiebroken,
+			CompletedAt:            database.TimePointerFromNullTime(result.CompletedAt),
 		})
 	}
 
@@ -232,11 +244,11 @@ func (q *repository) getMealPlanOptionsForMealPlanEvent(ctx context.Context, mea
 		}
 		x[i].Votes = votes
 
-		m, mealFetchErr := q.GetMeal(ctx, opt.Meal.ID)
-		if mealFetchErr != nil {
-			return nil, observability.PrepareAndLogError(mealFetchErr, logger, span, "scanning meal plan options for meal plan event")
+		recipeRevisions, revisionFetchErr := q.getRecipeRevisionsForMealPlanOption(ctx, opt.ID)
+		if revisionFetchErr != nil {
+			return nil, observability.PrepareError(revisionFetchErr, span, "fetching recipe revisions for meal plan option")
 		}
-		x[i].Meal = *m
+		x[i].RecipeRevisions = recipeRevisions
 	}
 
 	logger.WithValue("quantity", len(x)).Info("fetched meal plan options for meal plan event")
@@ -308,12 +320,37 @@ func (q *repository) GetMealPlanOptions(ctx context.Context, mealPlanID, mealPla
 			Meal: mealplanning.Meal{
 				ID: result.MealID,
 			},
-			MealScale: database.Float32FromString(result.MealScale),
-			Chosen:    result.Chosen,
-			TieBroken: result.Tiebroken,
+			MealScale:   database.Float32FromString(result.MealScale),
+			Chosen:      result.Chosen,
+			TieBroken:   result.Tiebroken,
+			CompletedAt: database.TimePointerFromNullTime(result.CompletedAt),
 		})
 	}
 
+	// hydrate every option's meal in one pass, so callers can inspect meal contents without fetching each one
+	mealIDs := []string{}
+	for _, option := range data {
+		if !slices.Contains(mealIDs, option.Meal.ID) {
+			mealIDs = append(mealIDs, option.Meal.ID)
+		}
+	}
+
+	meals, err := q.GetMealsWithIDs(ctx, mealIDs)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching meals for meal plan options")
+	}
+
+	mealsByID := map[string]*mealplanning.Meal{}
+	for _, meal := range meals {
+		mealsByID[meal.ID] = meal
+	}
+
+	for _, option := range data {
+		if meal, ok := mealsByID[option.Meal.ID]; ok {
+			option.Meal = *meal
+		}
+	}
+
 	x = filtering.NewQueryFilteredResult(
 		data,
 		filteredCount,
@@ -372,6 +409,10 @@ func (q *repository) createMealPlanOption(ctx context.Context, db database.SQLQu
 		return nil, observability.PrepareAndLogError(err, logger, span, "creating meal plan option")
 	}
 
+	if err := q.pinRecipeRevisionsForMealPlanOption(ctx, db, input.ID, input.MealID); err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "pinning recipe revisions for meal plan option")
+	}
+
 	x := &mealplanning.MealPlanOption{
 		ID:                     input.ID,
 		AssignedCook:           input.AssignedCook,
@@ -461,73 +502,6 @@ func (q *repository) ArchiveMealPlanOption(ctx context.Context, mealPlanID, meal
 	return nil
 }
 
-func (q *repository) determineWinner(winners []schulze.Result[string]) string {
-	var (
-		highestScore int
-		scoreWinners []string
-	)
-
-	for _, winner := range winners {
-		if winner.Wins == highestScore {
-			scoreWinners = append(scoreWinners, winner.Choice)
-		} else if winner.Wins > highestScore {
-			highestScore = winner.Wins
-			scoreWinners = []string{winner.Choice}
-		}
-	}
-
-	/* #nosec: G404 */
-	return scoreWinners[rand.N(len(scoreWinners))]
-}
-
-func (q *repository) decideOptionWinner(ctx context.Context, options []*mealplanning.MealPlanOption) (_ string, _, _ bool) {
-	_, span := q.tracer.StartSpan(ctx)
-	defer span.End()
-
-	candidateMap := map[string]struct{}{}
-	votesByUser := map[string]schulze.Ballot[string]{}
-
-	logger := q.logger.WithValue("options.count", len(options))
-
-	for _, option := range options {
-		for _, v := range option.Votes {
-			if votesByUser[v.ByUser] == nil {
-				votesByUser[v.ByUser] = schulze.Ballot[string]{}
-			}
-
-			if !v.Abstain {
-				votesByUser[v.ByUser][v.BelongsToMealPlanOption] = int(v.Rank)
-			}
-
-			candidateMap[v.BelongsToMealPlanOption] = struct{}{}
-		}
-	}
-
-	candidates := []string{}
-	for c := range candidateMap {
-		candidates = append(candidates, c)
-	}
-
-	e := schulze.NewVoting(candidates)
-	for _, vote := range votesByUser {
-		if _, err := e.Vote(vote); err != nil {
-			// this actually can never happen because we use uints for ranks, lol
-			observability.AcknowledgeError(err, logger, span, "an invalid vote was received")
-		}
-	}
-
-	winners, _, tie := e.Compute()
-	if tie {
-		return q.determineWinner(winners), true, true
-	}
-
-	if len(winners) > 0 {
-		return winners[0].Choice, false, true
-	}
-
-	return "", false, false
-}
-
 // FinalizeMealPlanOption archives a meal plan option vote from the database by its ID.
 func (q *repository) FinalizeMealPlanOption(ctx context.Context, mealPlanID, mealPlanEventID, mealPlanOptionID, accountID string) (changed bool, err error) {
 	ctx, span := q.tracer.StartSpan(ctx)
@@ -605,18 +579,94 @@ func (q *repository) FinalizeMealPlanOption(ctx context.Context, mealPlanID, mea
 		}
 	}
 
-	winner, tiebroken, chosen := q.decideOptionWinner(ctx, mealPlanEvent.Options)
-	if chosen {
-		if err = q.generatedQuerier.FinalizeMealPlanOption(ctx, q.readDB, &generated.FinalizeMealPlanOptionParams{
-			MealPlanEventID: database.NullStringFromString(mealPlanEventID),
-			ID:              winner,
-			Tiebroken:       tiebroken,
-		}); err != nil {
-			return false, observability.PrepareAndLogError(err, logger, span, "finalizing meal plan option")
+	report, err := q.tallyMealPlanEvent(ctx, mealPlan.ElectionMethod, mealPlanEventID, mealPlanEvent.Options)
+	if err != nil {
+		return false, observability.PrepareAndLogError(err, logger, span, "tallying meal plan event votes")
+	}
+
+	if report == nil {
+		return false, nil
+	}
+
+	// choosing the winner and recording the report that explains it happen together, or not at all
+	tx, err := q.writeDB.BeginTx(ctx, nil)
+	if err != nil {
+		return false, observability.PrepareAndLogError(err, logger, span, "beginning transaction")
+	}
+
+	if err = q.finalizeMealPlanEventWinner(ctx, tx, report); err != nil {
+		q.RollbackTransaction(ctx, tx)
+		return false, observability.PrepareAndLogError(err, logger, span, "finalizing meal plan option")
+	}
+
+	if err = tx.Commit(); err != nil {
+		return false, observability.PrepareAndLogError(err, logger, span, "committing transaction")
+	}
+
+	logger.Debug("finalized meal plan option")
+
+	return true, nil
+}
+
+// CompleteMealPlanOption marks a chosen meal plan option as cooked and draws down the pantry alongside it. Pantry
+// quantities are decremented in place rather than overwritten, so options completed at the same time that use the
+// same pantry item each take their share. It returns the drawn down pantry items, and reports whether the option was
+// newly completed; the pantry is left alone when it wasn't.
+func (q *repository) CompleteMealPlanOption(ctx context.Context, mealPlanEventID, mealPlanOptionID string, drawDowns []*mealplanning.PantryItemDrawDown) (drawnDown []*mealplanning.PantryItem, changed bool, err error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := q.logger.Clone()
+
+	if mealPlanEventID == "" {
+		return nil, false, platformerrors.ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(mealplanningkeys.MealPlanEventIDKey, mealPlanEventID)
+	tracing.AttachToSpan(span, mealplanningkeys.MealPlanEventIDKey, mealPlanEventID)
+
+	if mealPlanOptionID == "" {
+		return nil, false, platformerrors.ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(mealplanningkeys.MealPlanOptionIDKey, mealPlanOptionID)
+	tracing.AttachToSpan(span, mealplanningkeys.MealPlanOptionIDKey, mealPlanOptionID)
+
+	tx, err := q.writeDB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, false, observability.PrepareAndLogError(err, logger, span, "beginning transaction")
+	}
+
+	rowsAffected, err := q.generatedQuerier.CompleteMealPlanOption(ctx, tx, &generated.CompleteMealPlanOptionParams{
+		MealPlanEventID:  database.NullStringFromString(mealPlanEventID),
+		MealPlanOptionID: mealPlanOptionID,
+	})
+	if err != nil {
+		q.RollbackTransaction(ctx, tx)
+		return nil, false, observability.PrepareAndLogError(err, logger, span, "completing meal plan option")
+	}
+
+	// only draw down the pantry the first time an option is completed.
+	if rowsAffected == 0 {
+		q.RollbackTransaction(ctx, tx)
+		return nil, false, nil
+	}
+
+	drawnDown = []*mealplanning.PantryItem{}
+	for _, drawDown := range drawDowns {
+		pantryItem, drawDownErr := q.drawDownPantryItem(ctx, tx, drawDown)
+		if drawDownErr != nil {
+			q.RollbackTransaction(ctx, tx)
+			return nil, false, observability.PrepareAndLogError(drawDownErr, logger, span, "drawing down pantry item")
 		}
 
-		logger.Debug("finalized meal plan option")
+		// pantry items archived since they were read have nothing left to draw down.
+		if pantryItem != nil {
+			drawnDown = append(drawnDown, pantryItem)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, false, observability.PrepareAndLogError(err, logger, span, "committing transaction")
 	}
 
-	return chosen, nil
+	return drawnDown, true, nil
 }
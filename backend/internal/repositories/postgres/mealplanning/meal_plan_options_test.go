@@ -14,7 +14,6 @@
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
-	"resenje.org/schulze"
 )
 
 func buildMealPlanOptionForIntegrationTest(meal *types.Meal) *types.MealPlanOption {
@@ -50,9 +49,12 @@ func createMealPlanOptionForTest(t *testing.T, ctx context.Context, mealPlanID s
 
 	exampleMealPlanOption.CreatedAt = mealPlanOption.CreatedAt
 	require.Equal(t, exampleMealPlanOption.Meal.ID, mealPlanOption.Meal.ID)
+	require.NotEmpty(t, mealPlanOption.Meal.Components)
 	exampleMealPlanOption.Meal = mealPlanOption.Meal
 	require.Equal(t, len(exampleMealPlanOption.Votes), len(mealPlanOption.Votes))
 	exampleMealPlanOption.Votes = mealPlanOption.Votes
+	require.NotEmpty(t, mealPlanOption.RecipeRevisions)
+	exampleMealPlanOption.RecipeRevisions = mealPlanOption.RecipeRevisions
 	require.Equal(t, exampleMealPlanOption.CreatedAt, mealPlanOption.CreatedAt)
 	require.Equal(t, exampleMealPlanOption.LastUpdatedAt, mealPlanOption.LastUpdatedAt)
 	require.Equal(t, exampleMealPlanOption.ID, mealPlanOption.ID)
@@ -314,64 +316,39 @@ func TestQuerier_ArchiveMealPlanOption(T *testing.T) {
 	})
 }
 
-func Test_determineWinner(T *testing.T) {
+func TestQuerier_CompleteMealPlanOption(T *testing.T) {
 	T.Parallel()
 
-	T.Run("standard", func(t *testing.T) {
+	T.Run("with invalid meal plan event ID", func(t *testing.T) {
 		t.Parallel()
 
-		c := buildInertClientForTest(t)
-
-		expected := "blah blah blah"
-		exampleWinners := []schulze.Result[string]{
-			{
-				Choice: t.Name(),
-				Wins:   1,
-			},
-			{
-				Choice: "",
-				Wins:   2,
-			},
-			{
-				Choice: expected,
-				Wins:   3,
-			},
-		}
+		exampleMealPlanOption := fakes.BuildFakeMealPlanOption()
 
-		actual := c.determineWinner(exampleWinners)
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
 
-		assert.Equal(t, expected, actual)
+		drawnDown, changed, err := c.CompleteMealPlanOption(ctx, "", exampleMealPlanOption.ID, nil)
+		assert.Error(t, err)
+		assert.False(t, changed)
+		assert.Empty(t, drawnDown)
 	})
 
-	T.Run("with tie", func(t *testing.T) {
+	T.Run("with invalid meal plan option ID", func(t *testing.T) {
 		t.Parallel()
 
-		c := buildInertClientForTest(t)
-
-		expectedA := "blah blah blah"
-		expectedB := "beeble beeble"
-		exampleWinners := []schulze.Result[string]{
-			{
-				Choice: expectedA,
-				Wins:   3,
-			},
-			{
-				Choice: "",
-				Wins:   1,
-			},
-			{
-				Choice: expectedB,
-				Wins:   3,
-			},
-		}
+		exampleMealPlanEventID := fakes.BuildFakeID()
 
-		actual := c.determineWinner(exampleWinners)
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
 
-		assert.True(t, expectedA == actual || expectedB == actual)
+		drawnDown, changed, err := c.CompleteMealPlanOption(ctx, exampleMealPlanEventID, "", nil)
+		assert.Error(t, err)
+		assert.False(t, changed)
+		assert.Empty(t, drawnDown)
 	})
 }
 
-func Test_decideOptionWinner(T *testing.T) {
+func Test_tallyMealPlanEvent(T *testing.T) {
 	T.Parallel()
 
 	optionA := "eggs benedict"
@@ -381,6 +358,7 @@ func Test_decideOptionWinner(T *testing.T) {
 	userID2 := fakes.BuildFakeID()
 	userID3 := fakes.BuildFakeID()
 	userID4 := fakes.BuildFakeID()
+	exampleMealPlanEventID := fakes.BuildFakeID()
 
 	T.Run("with clear winner", func(t *testing.T) {
 		t.Parallel()
@@ -468,10 +446,13 @@ func Test_decideOptionWinner(T *testing.T) {
 			},
 		}
 
-		actual, tiebroken, chosen := c.decideOptionWinner(ctx, exampleOptions)
-		assert.Equal(t, expected, actual)
-		assert.False(t, tiebroken)
-		assert.True(t, chosen)
+		actual, err := c.tallyMealPlanEvent(ctx, types.MealPlanElectionMethodSchulze, exampleMealPlanEventID, exampleOptions)
+		require.NoError(t, err)
+		require.NotNil(t, actual)
+		assert.Equal(t, expected, actual.Winner)
+		assert.Equal(t, exampleMealPlanEventID, actual.BelongsToMealPlanEvent)
+		assert.NotEmpty(t, actual.ID)
+		assert.False(t, actual.TieBroken)
 	})
 
 	T.Run("with tie", func(t *testing.T) {
@@ -503,10 +484,12 @@ func Test_decideOptionWinner(T *testing.T) {
 			},
 		}
 
-		actual, tiebroken, chosen := c.decideOptionWinner(ctx, exampleOptions)
-		assert.NotEmpty(t, actual)
-		assert.True(t, tiebroken)
-		assert.True(t, chosen)
+		actual, err := c.tallyMealPlanEvent(ctx, types.MealPlanElectionMethodSchulze, exampleMealPlanEventID, exampleOptions)
+		require.NoError(t, err)
+		require.NotNil(t, actual)
+		assert.NotEmpty(t, actual.Winner)
+		assert.True(t, actual.TieBroken)
+		assert.NotNil(t, actual.TieBreak)
 	})
 
 	T.Run("without enough votes", func(t *testing.T) {
@@ -522,10 +505,33 @@ func Test_decideOptionWinner(T *testing.T) {
 			},
 		}
 
-		actual, tiebroken, chosen := c.decideOptionWinner(ctx, exampleOptions)
-		assert.Empty(t, actual)
-		assert.False(t, tiebroken)
-		assert.False(t, chosen)
+		actual, err := c.tallyMealPlanEvent(ctx, types.MealPlanElectionMethodSchulze, exampleMealPlanEventID, exampleOptions)
+		assert.NoError(t, err)
+		assert.Nil(t, actual)
+	})
+
+	T.Run("with unknown election method", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		exampleOptions := []*types.MealPlanOption{
+			{
+				ID: optionA,
+				Votes: []*types.MealPlanOptionVote{
+					{
+						BelongsToMealPlanOption: optionA,
+						Rank:                    0,
+						ByUser:                  userID1,
+					},
+				},
+			},
+		}
+
+		actual, err := c.tallyMealPlanEvent(ctx, t.Name(), exampleMealPlanEventID, exampleOptions)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
 	})
 }
 
@@ -97,9 +97,10 @@ func (q *repository) GetMealPlanTask(ctx context.Context, mealPlanTaskID string)
 			Meal: types.Meal{
 				ID: result.MealPlanOptionMealID,
 			},
-			MealScale: database.Float32FromString(result.MealPlanOptionMealScale),
-			Chosen:    result.MealPlanOptionChosen,
-			TieBroken: result.MealPlanOptionTiebroken,
+			MealScale:   database.Float32FromString(result.MealPlanOptionMealScale),
+			Chosen:      result.MealPlanOptionChosen,
+			TieBroken:   result.MealPlanOptionTiebroken,
+			CompletedAt: database.TimePointerFromNullTime(result.MealPlanOptionCompletedAt),
 		},
 	}
 
@@ -235,9 +236,10 @@ func (q *repository) GetMealPlanTasksForMealPlan(ctx context.Context, mealPlanID
 					Meal: types.Meal{
 						ID: result.MealPlanOptionMealID,
 					},
-					MealScale: database.Float32FromString(result.MealPlanOptionMealScale),
-					Chosen:    result.MealPlanOptionChosen,
-					TieBroken: result.MealPlanOptionTiebroken,
+					MealScale:   database.Float32FromString(result.MealPlanOptionMealScale),
+					Chosen:      result.MealPlanOptionChosen,
+					TieBroken:   result.MealPlanOptionTiebroken,
+					CompletedAt: database.TimePointerFromNullTime(result.MealPlanOptionCompletedAt),
 				},
 				RecipePrepTask: types.RecipePrepTask{
 					ID:                                 result.PrepTaskID,
@@ -0,0 +1,430 @@
+package mealplanning
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"time"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/audit"
+	identitykeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity/keys"
+	types "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+	mealplanningkeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/keys"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/repositories/postgres/mealplanning/generated"
+
+	"github.com/primandproper/platform/database"
+	"github.com/primandproper/platform/database/filtering"
+	platformerrors "github.com/primandproper/platform/errors"
+	"github.com/primandproper/platform/identifiers"
+	"github.com/primandproper/platform/observability"
+	"github.com/primandproper/platform/observability/tracing"
+)
+
+const (
+	resourceTypeMealPlanTemplates = "meal_plan_templates"
+)
+
+var (
+	_ types.MealPlanTemplateDataManager = (*repository)(nil)
+)
+
+// convertMealPlanTemplateFromDatabase converts a meal plan template row, decoding its slots along the way.
+func convertMealPlanTemplateFromDatabase(result *generated.MealPlanTemplates) (*types.MealPlanTemplate, error) {
+	var slots []*types.MealPlanTemplateSlot
+	if err := json.Unmarshal(result.Slots, &slots); err != nil {
+		return nil, err
+	}
+
+	x := &types.MealPlanTemplate{
+		CreatedAt:                     result.CreatedAt,
+		NextInstantiationAt:           result.NextInstantiationAt,
+		LastInstantiatedAt:            database.TimePointerFromNullTime(result.LastInstantiatedAt),
+		LastUpdatedAt:                 database.TimePointerFromNullTime(result.LastUpdatedAt),
+		ArchivedAt:                    database.TimePointerFromNullTime(result.ArchivedAt),
+		ID:                            result.ID,
+		Name:                          result.Name,
+		Notes:                         result.Notes,
+		ElectionMethod:                string(result.ElectionMethod),
+		RecurrenceRule:                result.RecurrenceRule,
+		Timezone:                      result.Timezone,
+		BelongsToAccount:              result.BelongsToAccount,
+		CreatedByUser:                 result.CreatedByUser,
+		Slots:                         slots,
+		VotingDeadlineOffsetInSeconds: uint32(result.VotingDeadlineOffsetInSeconds),
+		CandidatesPerSlot:             uint8(result.CandidatesPerSlot),
+		AvoidMealsFromLastPlans:       uint8(result.AvoidMealsFromLastPlans),
+	}
+
+	return x, nil
+}
+
+// GetMealPlanTemplate fetches a meal plan template from the database.
+func (q *repository) GetMealPlanTemplate(ctx context.Context, mealPlanTemplateID, accountID string) (*types.MealPlanTemplate, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := q.logger.Clone()
+
+	if mealPlanTemplateID == "" {
+		return nil, platformerrors.ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(mealplanningkeys.MealPlanTemplateIDKey, mealPlanTemplateID)
+	tracing.AttachToSpan(span, mealplanningkeys.MealPlanTemplateIDKey, mealPlanTemplateID)
+
+	if accountID == "" {
+		return nil, platformerrors.ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(identitykeys.AccountIDKey, accountID)
+	tracing.AttachToSpan(span, identitykeys.AccountIDKey, accountID)
+
+	result, err := q.generatedQuerier.GetMealPlanTemplate(ctx, q.readDB, &generated.GetMealPlanTemplateParams{
+		ID:               mealPlanTemplateID,
+		BelongsToAccount: accountID,
+	})
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching meal plan template")
+	}
+
+	x, err := convertMealPlanTemplateFromDatabase(result)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "decoding meal plan template slots")
+	}
+
+	return x, nil
+}
+
+// GetMealPlanTemplatesForAccount fetches a list of meal plan templates from the database that meet a particular filter.
+func (q *repository) GetMealPlanTemplatesForAccount(ctx context.Context, accountID string, filter *filtering.QueryFilter) (x *filtering.QueryFilteredResult[types.MealPlanTemplate], err error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := q.logger.Clone()
+
+	if accountID == "" {
+		return nil, platformerrors.ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(identitykeys.AccountIDKey, accountID)
+	tracing.AttachToSpan(span, identitykeys.AccountIDKey, accountID)
+
+	if filter == nil {
+		filter = filtering.DefaultQueryFilter()
+	}
+	logger = filter.AttachToLogger(logger)
+	tracing.AttachQueryFilterToSpan(span, filter)
+
+	var (
+		data          []*types.MealPlanTemplate
+		filteredCount uint64
+		totalCount    uint64
+	)
+
+	results, err := q.generatedQuerier.GetMealPlanTemplatesForAccount(ctx, q.readDB, &generated.GetMealPlanTemplatesForAccountParams{
+		BelongsToAccount: accountID,
+		CreatedBefore:    database.NullTimeFromTimePointer(filter.CreatedBefore),
+		CreatedAfter:     database.NullTimeFromTimePointer(filter.CreatedAfter),
+		UpdatedBefore:    database.NullTimeFromTimePointer(filter.UpdatedBefore),
+		UpdatedAfter:     database.NullTimeFromTimePointer(filter.UpdatedAfter),
+		Cursor:           database.NullStringFromStringPointer(filter.Cursor),
+		ResultLimit:      database.NullInt32FromUint8Pointer(filter.MaxResponseSize),
+		IncludeArchived:  database.NullBoolFromBoolPointer(filter.IncludeArchived),
+	})
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "executing meal plan templates list retrieval query")
+	}
+
+	for _, result := range results {
+		// Extract counts from the first result (all rows have the same counts)
+		if totalCount == 0 {
+			totalCount = uint64(result.TotalCount)
+			filteredCount = uint64(result.FilteredCount)
+		}
+
+		template, convertErr := convertMealPlanTemplateFromDatabase(&generated.MealPlanTemplates{
+			ID:                            result.ID,
+			Name:                          result.Name,
+			Notes:                         result.Notes,
+			ElectionMethod:                result.ElectionMethod,
+			RecurrenceRule:                result.RecurrenceRule,
+			Timezone:                      result.Timezone,
+			VotingDeadlineOffsetInSeconds: result.VotingDeadlineOffsetInSeconds,
+			CandidatesPerSlot:             result.CandidatesPerSlot,
+			AvoidMealsFromLastPlans:       result.AvoidMealsFromLastPlans,
+			Slots:                         result.Slots,
+			NextInstantiationAt:           result.NextInstantiationAt,
+			LastInstantiatedAt:            result.LastInstantiatedAt,
+			CreatedAt:                     result.CreatedAt,
+			LastUpdatedAt:                 result.LastUpdatedAt,
+			ArchivedAt:                    result.ArchivedAt,
+			BelongsToAccount:              result.BelongsToAccount,
+			CreatedByUser:                 result.CreatedByUser,
+		})
+		if convertErr != nil {
+			return nil, observability.PrepareAndLogError(convertErr, logger, span, "decoding meal plan template slots")
+		}
+
+		data = append(data, template)
+	}
+
+	x = filtering.NewQueryFilteredResult(
+		data,
+		filteredCount,
+		totalCount,
+		func(t *types.MealPlanTemplate) string { return t.ID },
+		filter,
+	)
+
+	return x, nil
+}
+
+// GetMealPlanTemplatesDueForInstantiation fetches every active meal plan template whose next instantiation is at or before the given time.
+func (q *repository) GetMealPlanTemplatesDueForInstantiation(ctx context.Context, asOf time.Time) ([]*types.MealPlanTemplate, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	results, err := q.generatedQuerier.GetMealPlanTemplatesDueForInstantiation(ctx, q.readDB, asOf)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, q.logger, span, "fetching meal plan templates due for instantiation")
+	}
+
+	templates := []*types.MealPlanTemplate{}
+	for _, result := range results {
+		template, convertErr := convertMealPlanTemplateFromDatabase(result)
+		if convertErr != nil {
+			return nil, observability.PrepareAndLogError(convertErr, q.logger.WithValue(mealplanningkeys.MealPlanTemplateIDKey, result.ID), span, "decoding meal plan template slots")
+		}
+
+		templates = append(templates, template)
+	}
+
+	return templates, nil
+}
+
+// CreateMealPlanTemplate creates a meal plan template in the database.
+func (q *repository) CreateMealPlanTemplate(ctx context.Context, input *types.MealPlanTemplateDatabaseCreationInput) (*types.MealPlanTemplate, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if input == nil {
+		return nil, platformerrors.ErrNilInputProvided
+	}
+	tracing.AttachToSpan(span, mealplanningkeys.MealPlanTemplateIDKey, input.ID)
+	logger := q.logger.WithValue(mealplanningkeys.MealPlanTemplateIDKey, input.ID).WithValue(identitykeys.AccountIDKey, input.BelongsToAccount)
+
+	slots, err := json.Marshal(input.Slots)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "encoding meal plan template slots")
+	}
+
+	if err = q.generatedQuerier.CreateMealPlanTemplate(ctx, q.writeDB, &generated.CreateMealPlanTemplateParams{
+		ID:                            input.ID,
+		Name:                          input.Name,
+		Notes:                         input.Notes,
+		ElectionMethod:                generated.ValidElectionMethod(input.ElectionMethod),
+		RecurrenceRule:                input.RecurrenceRule,
+		Timezone:                      input.Timezone,
+		VotingDeadlineOffsetInSeconds: int32(input.VotingDeadlineOffsetInSeconds),
+		CandidatesPerSlot:             int32(input.CandidatesPerSlot),
+		AvoidMealsFromLastPlans:       int32(input.AvoidMealsFromLastPlans),
+		Slots:                         slots,
+		NextInstantiationAt:           input.NextInstantiationAt,
+		BelongsToAccount:              input.BelongsToAccount,
+		CreatedByUser:                 input.CreatedByUser,
+	}); err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "performing meal plan template creation query")
+	}
+
+	if _, err = q.auditLogEntryRepo.CreateAuditLogEntry(ctx, q.writeDB, &audit.AuditLogEntryDatabaseCreationInput{
+		BelongsToAccount: &input.BelongsToAccount,
+		ID:               identifiers.New(),
+		ResourceType:     resourceTypeMealPlanTemplates,
+		RelevantID:       input.ID,
+		EventType:        audit.AuditLogEventTypeCreated,
+	}); err != nil {
+		return nil, observability.PrepareError(err, span, "creating audit log entry")
+	}
+
+	x := &types.MealPlanTemplate{
+		CreatedAt:                     q.CurrentTime(),
+		NextInstantiationAt:           input.NextInstantiationAt,
+		ID:                            input.ID,
+		Name:                          input.Name,
+		Notes:                         input.Notes,
+		ElectionMethod:                input.ElectionMethod,
+		RecurrenceRule:                input.RecurrenceRule,
+		Timezone:                      input.Timezone,
+		BelongsToAccount:              input.BelongsToAccount,
+		CreatedByUser:                 input.CreatedByUser,
+		Slots:                         input.Slots,
+		VotingDeadlineOffsetInSeconds: input.VotingDeadlineOffsetInSeconds,
+		CandidatesPerSlot:             input.CandidatesPerSlot,
+		AvoidMealsFromLastPlans:       input.AvoidMealsFromLastPlans,
+	}
+
+	logger.Info("meal plan template created")
+
+	return x, nil
+}
+
+// UpdateMealPlanTemplate updates a particular meal plan template.
+func (q *repository) UpdateMealPlanTemplate(ctx context.Context, updated *types.MealPlanTemplate) error {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if updated == nil {
+		return platformerrors.ErrNilInputProvided
+	}
+	logger := q.logger.WithValue(mealplanningkeys.MealPlanTemplateIDKey, updated.ID)
+	tracing.AttachToSpan(span, mealplanningkeys.MealPlanTemplateIDKey, updated.ID)
+	tracing.AttachToSpan(span, identitykeys.AccountIDKey, updated.BelongsToAccount)
+
+	slots, err := json.Marshal(updated.Slots)
+	if err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "encoding meal plan template slots")
+	}
+
+	if _, err = q.generatedQuerier.UpdateMealPlanTemplate(ctx, q.writeDB, &generated.UpdateMealPlanTemplateParams{
+		Name:                          updated.Name,
+		Notes:                         updated.Notes,
+		ElectionMethod:                generated.ValidElectionMethod(updated.ElectionMethod),
+		RecurrenceRule:                updated.RecurrenceRule,
+		Timezone:                      updated.Timezone,
+		VotingDeadlineOffsetInSeconds: int32(updated.VotingDeadlineOffsetInSeconds),
+		CandidatesPerSlot:             int32(updated.CandidatesPerSlot),
+		AvoidMealsFromLastPlans:       int32(updated.AvoidMealsFromLastPlans),
+		Slots:                         slots,
+		NextInstantiationAt:           updated.NextInstantiationAt,
+		BelongsToAccount:              updated.BelongsToAccount,
+		ID:                            updated.ID,
+	}); err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "updating meal plan template")
+	}
+
+	if _, err = q.auditLogEntryRepo.CreateAuditLogEntry(ctx, q.writeDB, &audit.AuditLogEntryDatabaseCreationInput{
+		BelongsToAccount: &updated.BelongsToAccount,
+		ID:               identifiers.New(),
+		ResourceType:     resourceTypeMealPlanTemplates,
+		RelevantID:       updated.ID,
+		EventType:        audit.AuditLogEventTypeUpdated,
+	}); err != nil {
+		return observability.PrepareError(err, span, "creating audit log entry")
+	}
+
+	logger.Info("meal plan template updated")
+
+	return nil
+}
+
+// ClaimMealPlanTemplateInstantiation leases a meal plan template's scheduled instantiation until claimedUntil. It reports
+// false if the template is no longer scheduled for scheduledAt, or another run holds an unexpired claim on it.
+func (q *repository) ClaimMealPlanTemplateInstantiation(ctx context.Context, mealPlanTemplateID string, scheduledAt, claimedUntil time.Time) (bool, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if mealPlanTemplateID == "" {
+		return false, platformerrors.ErrInvalidIDProvided
+	}
+	tracing.AttachToSpan(span, mealplanningkeys.MealPlanTemplateIDKey, mealPlanTemplateID)
+	logger := q.logger.WithValue(mealplanningkeys.MealPlanTemplateIDKey, mealPlanTemplateID)
+
+	rowsAffected, err := q.generatedQuerier.ClaimMealPlanTemplateInstantiation(ctx, q.writeDB, &generated.ClaimMealPlanTemplateInstantiationParams{
+		ClaimedUntil: database.NullTimeFromTime(claimedUntil),
+		ID:           mealPlanTemplateID,
+		ScheduledAt:  scheduledAt,
+	})
+	if err != nil {
+		return false, observability.PrepareAndLogError(err, logger, span, "claiming meal plan template instantiation")
+	}
+
+	return rowsAffected > 0, nil
+}
+
+// ReleaseMealPlanTemplateInstantiationClaim gives up a claim on a meal plan template's scheduled instantiation, so
+// the next run can retry it without waiting for the claim to expire.
+func (q *repository) ReleaseMealPlanTemplateInstantiationClaim(ctx context.Context, mealPlanTemplateID string) error {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if mealPlanTemplateID == "" {
+		return platformerrors.ErrInvalidIDProvided
+	}
+	tracing.AttachToSpan(span, mealplanningkeys.MealPlanTemplateIDKey, mealPlanTemplateID)
+	logger := q.logger.WithValue(mealplanningkeys.MealPlanTemplateIDKey, mealPlanTemplateID)
+
+	if err := q.generatedQuerier.ReleaseMealPlanTemplateInstantiationClaim(ctx, q.writeDB, mealPlanTemplateID); err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "releasing meal plan template instantiation claim")
+	}
+
+	return nil
+}
+
+// MarkMealPlanTemplateAsInstantiated records that a meal plan template's scheduled instantiation is done, advancing it
+// to its next one and clearing any claim on it. It reports false if the template is no longer scheduled for
+// instantiatedAt, which means it was already marked or has since been rescheduled.
+func (q *repository) MarkMealPlanTemplateAsInstantiated(ctx context.Context, mealPlanTemplateID string, instantiatedAt, nextInstantiationAt time.Time) (bool, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if mealPlanTemplateID == "" {
+		return false, platformerrors.ErrInvalidIDProvided
+	}
+	tracing.AttachToSpan(span, mealplanningkeys.MealPlanTemplateIDKey, mealPlanTemplateID)
+	logger := q.logger.WithValue(mealplanningkeys.MealPlanTemplateIDKey, mealPlanTemplateID)
+
+	rowsAffected, err := q.generatedQuerier.MarkMealPlanTemplateAsInstantiated(ctx, q.writeDB, &generated.MarkMealPlanTemplateAsInstantiatedParams{
+		LastInstantiatedAt:  database.NullTimeFromTime(instantiatedAt),
+		NextInstantiationAt: nextInstantiationAt,
+		ScheduledAt:         instantiatedAt,
+		ID:                  mealPlanTemplateID,
+	})
+	if err != nil {
+		return false, observability.PrepareAndLogError(err, logger, span, "marking meal plan template as instantiated")
+	}
+
+	return rowsAffected > 0, nil
+}
+
+// ArchiveMealPlanTemplate archives a meal plan template from the database by its ID.
+func (q *repository) ArchiveMealPlanTemplate(ctx context.Context, mealPlanTemplateID, accountID string) error {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := q.logger.Clone()
+
+	if mealPlanTemplateID == "" {
+		return platformerrors.ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(mealplanningkeys.MealPlanTemplateIDKey, mealPlanTemplateID)
+	tracing.AttachToSpan(span, mealplanningkeys.MealPlanTemplateIDKey, mealPlanTemplateID)
+
+	if accountID == "" {
+		return platformerrors.ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(identitykeys.AccountIDKey, accountID)
+	tracing.AttachToSpan(span, identitykeys.AccountIDKey, accountID)
+
+	rowsAffected, err := q.generatedQuerier.ArchiveMealPlanTemplate(ctx, q.writeDB, &generated.ArchiveMealPlanTemplateParams{
+		BelongsToAccount: accountID,
+		ID:               mealPlanTemplateID,
+	})
+	if err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "archiving meal plan template")
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	if _, err = q.auditLogEntryRepo.CreateAuditLogEntry(ctx, q.writeDB, &audit.AuditLogEntryDatabaseCreationInput{
+		BelongsToAccount: &accountID,
+		ID:               identifiers.New(),
+		ResourceType:     resourceTypeMealPlanTemplates,
+		RelevantID:       mealPlanTemplateID,
+		EventType:        audit.AuditLogEventTypeArchived,
+	}); err != nil {
+		return observability.PrepareError(err, span, "creating audit log entry")
+	}
+
+	logger.Info("meal plan template archived")
+
+	return nil
+}
@@ -0,0 +1,254 @@
+package mealplanning
+
+import (
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/converters"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/fakes"
+	pgtesting "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/repositories/postgres/testing"
+
+	"github.com/primandproper/platform/identifiers"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuerier_Integration_MealPlanTemplates(t *testing.T) {
+	if !pgtesting.RunContainerTests {
+		t.SkipNow()
+	}
+
+	ctx := t.Context()
+	dbc, _, container := buildDatabaseClientForTest(t)
+
+	databaseURI, err := container.ConnectionString(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, databaseURI)
+
+	defer func(t *testing.T) {
+		t.Helper()
+		assert.NoError(t, container.Terminate(ctx))
+	}(t)
+
+	user := pgtesting.CreateUserForTest(t, nil, dbc.writeDB)
+	account := pgtesting.CreateAccountForTest(t, nil, user.ID, dbc.writeDB)
+
+	exampleTemplate := fakes.BuildFakeMealPlanTemplate()
+	exampleTemplate.NextInstantiationAt = time.Now().Add(-time.Hour).Truncate(time.Second).UTC()
+	exampleTemplate.BelongsToAccount = account.ID
+	exampleTemplate.CreatedByUser = user.ID
+	input := converters.ConvertMealPlanTemplateToMealPlanTemplateDatabaseCreationInput(exampleTemplate)
+
+	created, err := dbc.CreateMealPlanTemplate(ctx, input)
+	require.NoError(t, err)
+	assert.Equal(t, input.ID, created.ID)
+
+	fetched, err := dbc.GetMealPlanTemplate(ctx, created.ID, account.ID)
+	require.NoError(t, err)
+	assert.Equal(t, exampleTemplate.Name, fetched.Name)
+	assert.Equal(t, exampleTemplate.Slots, fetched.Slots)
+	assert.Nil(t, fetched.LastInstantiatedAt)
+
+	templates, err := dbc.GetMealPlanTemplatesForAccount(ctx, account.ID, nil)
+	require.NoError(t, err)
+	require.Len(t, templates.Data, 1)
+	assert.Equal(t, created.ID, templates.Data[0].ID)
+
+	due, err := dbc.GetMealPlanTemplatesDueForInstantiation(ctx, time.Now())
+	require.NoError(t, err)
+	require.Len(t, due, 1)
+	assert.Equal(t, created.ID, due[0].ID)
+
+	instantiatedAt := created.NextInstantiationAt
+	claimed, err := dbc.ClaimMealPlanTemplateInstantiation(ctx, created.ID, instantiatedAt, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.True(t, claimed)
+
+	// a second run can't claim an occurrence while the first run's claim is live.
+	claimed, err = dbc.ClaimMealPlanTemplateInstantiation(ctx, created.ID, instantiatedAt, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.False(t, claimed)
+
+	// a failed run releases its claim, and the occurrence is still due for the next one.
+	require.NoError(t, dbc.ReleaseMealPlanTemplateInstantiationClaim(ctx, created.ID))
+
+	due, err = dbc.GetMealPlanTemplatesDueForInstantiation(ctx, time.Now())
+	require.NoError(t, err)
+	require.Len(t, due, 1)
+
+	claimed, err = dbc.ClaimMealPlanTemplateInstantiation(ctx, created.ID, instantiatedAt, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.True(t, claimed)
+
+	marked, err := dbc.MarkMealPlanTemplateAsInstantiated(ctx, created.ID, instantiatedAt, instantiatedAt.Add(7*24*time.Hour))
+	require.NoError(t, err)
+	assert.True(t, marked)
+
+	marked, err = dbc.MarkMealPlanTemplateAsInstantiated(ctx, created.ID, instantiatedAt, instantiatedAt.Add(7*24*time.Hour))
+	require.NoError(t, err)
+	assert.False(t, marked)
+
+	// once the schedule has moved on, the old occurrence can't be claimed again.
+	claimed, err = dbc.ClaimMealPlanTemplateInstantiation(ctx, created.ID, instantiatedAt, time.Now().Add(time.Hour))
+	require.NoError(t, err)
+	assert.False(t, claimed)
+
+	due, err = dbc.GetMealPlanTemplatesDueForInstantiation(ctx, time.Now())
+	require.NoError(t, err)
+	assert.Empty(t, due)
+
+	fetched, err = dbc.GetMealPlanTemplate(ctx, created.ID, account.ID)
+	require.NoError(t, err)
+	require.NotNil(t, fetched.LastInstantiatedAt)
+
+	fetched.Name = t.Name()
+	fetched.Slots = fetched.Slots[:1]
+	require.NoError(t, dbc.UpdateMealPlanTemplate(ctx, fetched))
+
+	fetched, err = dbc.GetMealPlanTemplate(ctx, created.ID, account.ID)
+	require.NoError(t, err)
+	assert.Equal(t, t.Name(), fetched.Name)
+	assert.Len(t, fetched.Slots, 1)
+
+	require.NoError(t, dbc.ArchiveMealPlanTemplate(ctx, created.ID, account.ID))
+
+	fetched, err = dbc.GetMealPlanTemplate(ctx, created.ID, account.ID)
+	assert.ErrorIs(t, err, sql.ErrNoRows)
+	assert.Nil(t, fetched)
+}
+
+func TestQuerier_GetMealPlanTemplate(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid meal plan template ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		actual, err := c.GetMealPlanTemplate(ctx, "", identifiers.New())
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+
+	T.Run("with invalid account ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		actual, err := c.GetMealPlanTemplate(ctx, identifiers.New(), "")
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
+
+func TestQuerier_GetMealPlanTemplatesForAccount(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid account ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		actual, err := c.GetMealPlanTemplatesForAccount(ctx, "", nil)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
+
+func TestQuerier_CreateMealPlanTemplate(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with nil input", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		actual, err := c.CreateMealPlanTemplate(ctx, nil)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
+
+func TestQuerier_UpdateMealPlanTemplate(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with nil input", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		assert.Error(t, c.UpdateMealPlanTemplate(ctx, nil))
+	})
+}
+
+func TestQuerier_ClaimMealPlanTemplateInstantiation(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid meal plan template ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		claimed, err := c.ClaimMealPlanTemplateInstantiation(ctx, "", time.Now(), time.Now())
+		assert.Error(t, err)
+		assert.False(t, claimed)
+	})
+}
+
+func TestQuerier_ReleaseMealPlanTemplateInstantiationClaim(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid meal plan template ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		assert.Error(t, c.ReleaseMealPlanTemplateInstantiationClaim(ctx, ""))
+	})
+}
+
+func TestQuerier_MarkMealPlanTemplateAsInstantiated(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid meal plan template ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		claimed, err := c.MarkMealPlanTemplateAsInstantiated(ctx, "", time.Now(), time.Now())
+		assert.Error(t, err)
+		assert.False(t, claimed)
+	})
+}
+
+func TestQuerier_ArchiveMealPlanTemplate(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid meal plan template ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		assert.Error(t, c.ArchiveMealPlanTemplate(ctx, "", identifiers.New()))
+	})
+
+	T.Run("with invalid account ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		assert.Error(t, c.ArchiveMealPlanTemplate(ctx, identifiers.New(), ""))
+	})
+}
@@ -4,6 +4,7 @@
 	"context"
 	"database/sql"
 	"strings"
+	"time"
 
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/audit"
 	identitykeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity/keys"
@@ -463,6 +464,75 @@ func (q *repository) MarkMealPlanAsGroceryListInitialized(ctx context.Context, m
 	return nil
 }
 
+// GetAccountIDForMealPlan fetches the ID of the account a meal plan belongs to.
+func (q *repository) GetAccountIDForMealPlan(ctx context.Context, mealPlanID string) (string, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := q.logger.Clone()
+
+	if mealPlanID == "" {
+		return "", platformerrors.ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(mealplanningkeys.MealPlanIDKey, mealPlanID)
+	tracing.AttachToSpan(span, mealplanningkeys.MealPlanIDKey, mealPlanID)
+
+	accountID, err := q.generatedQuerier.GetAccountIDForMealPlan(ctx, q.readDB, mealPlanID)
+	if err != nil {
+		return "", observability.PrepareAndLogError(err, logger, span, "fetching account ID for meal plan")
+	}
+
+	return accountID, nil
+}
+
+// GetMealPlanCountForAccountSince counts the unarchived meal plans an account has created since a given time.
+func (q *repository) GetMealPlanCountForAccountSince(ctx context.Context, accountID string, since time.Time) (int64, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := q.logger.Clone()
+
+	if accountID == "" {
+		return 0, platformerrors.ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(identitykeys.AccountIDKey, accountID)
+	tracing.AttachToSpan(span, identitykeys.AccountIDKey, accountID)
+
+	count, err := q.generatedQuerier.GetMealPlanCountForAccountSince(ctx, q.readDB, &generated.GetMealPlanCountForAccountSinceParams{
+		BelongsToAccount: accountID,
+		Since:            since,
+	})
+	if err != nil {
+		return 0, observability.PrepareAndLogError(err, logger, span, "counting meal plans for account")
+	}
+
+	return count, nil
+}
+
+// GetRecentlyChosenMealIDsForAccount fetches the IDs of the meals chosen in an account's most recent finalized meal plans.
+func (q *repository) GetRecentlyChosenMealIDsForAccount(ctx context.Context, accountID string, mealPlanCount uint8) ([]string, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := q.logger.Clone()
+
+	if accountID == "" {
+		return nil, platformerrors.ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(identitykeys.AccountIDKey, accountID)
+	tracing.AttachToSpan(span, identitykeys.AccountIDKey, accountID)
+
+	mealIDs, err := q.generatedQuerier.GetRecentlyChosenMealIDsForAccount(ctx, q.readDB, &generated.GetRecentlyChosenMealIDsForAccountParams{
+		BelongsToAccount: accountID,
+		MealPlanCount:    int32(mealPlanCount),
+	})
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching recently chosen meals for account")
+	}
+
+	return mealIDs, nil
+}
+
 // AttemptToFinalizeMealPlan finalizes a meal plan if all of its options have a selection.
 func (q *repository) AttemptToFinalizeMealPlan(ctx context.Context, mealPlanID, accountID string) (finalized bool, err error) {
 	ctx, span := q.tracer.StartSpan(ctx)
@@ -550,15 +620,17 @@ func (q *repository) AttemptToFinalizeMealPlan(ctx context.Context, mealPlanID,
 		}
 
 		// the ballot is ready to be tallied for this event
-		winner, tiebroken, chosen := q.decideOptionWinner(ctx, event.Options)
-		if chosen {
-			logger = logger.WithValue("winner", winner).WithValue("tiebroken", tiebroken)
-
-			if err = q.generatedQuerier.FinalizeMealPlanOption(ctx, q.readDB, &generated.FinalizeMealPlanOptionParams{
-				MealPlanEventID: database.NullStringFromString(event.ID),
-				ID:              winner,
-				Tiebroken:       tiebroken,
-			}); err != nil {
+		var report *types.MealPlanEventTallyReport
+		if report, err = q.tallyMealPlanEvent(ctx, mealPlan.ElectionMethod, event.ID, event.Options); err != nil {
+			q.RollbackTransaction(ctx, tx)
+			return false, observability.PrepareAndLogError(err, logger, span, "tallying meal plan event votes")
+		}
+
+		if report != nil {
+			logger = logger.WithValue("winner", report.Winner).WithValue("tiebroken", report.TieBroken)
+
+			if err = q.finalizeMealPlanEventWinner(ctx, tx, report); err != nil {
+				q.RollbackTransaction(ctx, tx)
 				return false, observability.PrepareAndLogError(err, logger, span, "finalizing meal plan option")
 			}
 
@@ -571,11 +643,12 @@ func (q *repository) AttemptToFinalizeMealPlan(ctx context.Context, mealPlanID,
 	if allVotesAreSubmitted || votingDeadlineHasPassed {
 		logger.Info("finalizing meal plan")
 
-		if err = q.generatedQuerier.FinalizeMealPlan(ctx, q.readDB, &generated.FinalizeMealPlanParams{
+		if err = q.generatedQuerier.FinalizeMealPlan(ctx, tx, &generated.FinalizeMealPlanParams{
 			Status: generated.MealPlanStatus(types.MealPlanStatusFinalized),
 			ID:     mealPlanID,
 		}); err != nil {
-			return false, observability.PrepareAndLogError(err, logger, span, "finalizing meal plan option")
+			q.RollbackTransaction(ctx, tx)
+			return false, observability.PrepareAndLogError(err, logger, span, "finalizing meal plan")
 		}
 
 		finalized = true
@@ -626,6 +699,58 @@ func (q *repository) GetUnfinalizedMealPlansWithExpiredVotingPeriods(ctx context
 	return mealPlans, nil
 }
 
+// GetMealPlansWithApproachingVotingDeadlines gets meal plans whose voting closes soon and whose members haven't been reminded yet.
+func (q *repository) GetMealPlansWithApproachingVotingDeadlines(ctx context.Context) ([]*types.MealPlan, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	results, err := q.generatedQuerier.GetMealPlansWithApproachingVotingDeadlines(ctx, q.readDB)
+	if err != nil {
+		return nil, observability.PrepareError(err, span, "executing meal plans with approaching voting deadlines retrieval query")
+	}
+
+	mealPlans := []*types.MealPlan{}
+	for _, result := range results {
+		mealPlans = append(mealPlans, &types.MealPlan{
+			CreatedAt:              result.CreatedAt,
+			VotingDeadline:         result.VotingDeadline,
+			ArchivedAt:             database.TimePointerFromNullTime(result.ArchivedAt),
+			LastUpdatedAt:          database.TimePointerFromNullTime(result.LastUpdatedAt),
+			ID:                     result.ID,
+			Status:                 string(result.Status),
+			Notes:                  result.Notes,
+			ElectionMethod:         string(result.ElectionMethod),
+			BelongsToAccount:       result.BelongsToAccount,
+			CreatedByUser:          result.CreatedByUser,
+			Events:                 nil,
+			GroceryListInitialized: result.GroceryListInitialized,
+			TasksCreated:           result.TasksCreated,
+		})
+	}
+
+	return mealPlans, nil
+}
+
+// MarkMealPlanVotingDeadlineReminderSent records that a meal plan's members were reminded its voting deadline is close.
+func (q *repository) MarkMealPlanVotingDeadlineReminderSent(ctx context.Context, mealPlanID string) error {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := q.logger.Clone()
+
+	if mealPlanID == "" {
+		return platformerrors.ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(mealplanningkeys.MealPlanIDKey, mealPlanID)
+	tracing.AttachToSpan(span, mealplanningkeys.MealPlanIDKey, mealPlanID)
+
+	if err := q.generatedQuerier.MarkMealPlanVotingDeadlineReminderSent(ctx, q.writeDB, mealPlanID); err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "marking meal plan voting deadline reminder as sent")
+	}
+
+	return nil
+}
+
 // GetFinalizedMealPlanIDsForTheNextWeek gets finalized meal plans for a given duration.
 func (q *repository) GetFinalizedMealPlanIDsForTheNextWeek(ctx context.Context) ([]*types.FinalizedMealPlanDatabaseResult, error) {
 	ctx, span := q.tracer.StartSpan(ctx)
@@ -4,6 +4,7 @@
 	"context"
 	"database/sql"
 	"testing"
+	"time"
 
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/audit"
 	types "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
@@ -174,8 +175,15 @@ func TestQuerier_Integration_MealPlans(t *testing.T) {
 	assert.NotEmpty(t, mealPlans.Data)
 	assert.Equal(t, len(createdMealPlans), len(mealPlans.Data))
 
+	createdThisWeek, err := dbc.GetMealPlanCountForAccountSince(ctx, accountID, time.Now().Add(-7*24*time.Hour))
+	assert.NoError(t, err)
+	assert.Equal(t, int64(len(createdMealPlans)), createdThisWeek)
+
 	_, err = dbc.GetUnfinalizedMealPlansWithExpiredVotingPeriods(ctx)
 	assert.NoError(t, err)
+	_, err = dbc.GetMealPlansWithApproachingVotingDeadlines(ctx)
+	assert.NoError(t, err)
+	assert.NoError(t, dbc.MarkMealPlanVotingDeadlineReminderSent(ctx, createdMealPlans[0].ID))
 	_, err = dbc.GetFinalizedMealPlanIDsForTheNextWeek(ctx)
 	assert.NoError(t, err)
 	_, err = dbc.GetFinalizedMealPlansWithUninitializedGroceryLists(ctx)
@@ -319,6 +327,49 @@ func TestQuerier_ArchiveMealPlan(T *testing.T) {
 	})
 }
 
+func TestQuerier_MarkMealPlanVotingDeadlineReminderSent(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid meal plan ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		assert.Error(t, c.MarkMealPlanVotingDeadlineReminderSent(ctx, ""))
+	})
+}
+
+func TestQuerier_GetAccountIDForMealPlan(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid meal plan ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		actual, err := c.GetAccountIDForMealPlan(ctx, "")
+		assert.Error(t, err)
+		assert.Empty(t, actual)
+	})
+}
+
+func TestQuerier_GetMealPlanCountForAccountSince(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid account ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		actual, err := c.GetMealPlanCountForAccountSince(ctx, "", time.Now())
+		assert.Error(t, err)
+		assert.Zero(t, actual)
+	})
+}
+
 func TestQuerier_AttemptToFinalizeCompleteMealPlan(T *testing.T) {
 	T.Parallel()
 
@@ -734,3 +785,18 @@ func TestQuerier_Integration_MealPlans_WithSelections(t *testing.T) {
 		assert.NoError(t, dbc.ArchiveMealPlan(ctx, created.ID, accountID))
 	})
 }
+
+func TestQuerier_GetRecentlyChosenMealIDsForAccount(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid account ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		actual, err := c.GetRecentlyChosenMealIDsForAccount(ctx, "", 2)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
@@ -167,7 +167,7 @@ func (q *repository) GetMeal(ctx context.Context, mealID string) (*mealplanning.
 			}
 		}
 
-		recipe, recipeErr := q.getRecipe(ctx, result.ComponentRecipeID)
+		recipe, recipeErr := q.getRecipe(ctx, q.readDB, result.ComponentRecipeID)
 		if recipeErr != nil {
 			return nil, observability.PrepareAndLogError(recipeErr, logger, span, "getting recipe")
 		}
@@ -185,7 +185,7 @@ func (q *repository) GetMeal(ctx context.Context, mealID string) (*mealplanning.
 
 	for i, mealComponent := range meal.Components {
 		var r *mealplanning.Recipe
-		r, err = q.getRecipe(ctx, mealComponent.Recipe.ID)
+		r, err = q.getRecipe(ctx, q.readDB, mealComponent.Recipe.ID)
 		if err != nil {
 			return nil, observability.PrepareError(err, span, "fetching recipe for meal")
 		}
@@ -252,7 +252,7 @@ func (q *repository) GetMeals(ctx context.Context, filter *filtering.QueryFilter
 		}
 
 		if result.ComponentRecipeID.Valid {
-			recipe, recipeErr := q.getRecipe(ctx, result.ComponentRecipeID.String)
+			recipe, recipeErr := q.getRecipe(ctx, q.readDB, result.ComponentRecipeID.String)
 			if recipeErr != nil {
 				if errors.Is(recipeErr, sql.ErrNoRows) {
 					// Recipe missing or archived (e.g. orphaned reference from another test).
@@ -357,7 +357,7 @@ func (q *repository) GetMealsCreatedByUser(ctx context.Context, userID string, f
 		}
 
 		if result.ComponentRecipeID.Valid {
-			recipe, recipeErr := q.getRecipe(ctx, result.ComponentRecipeID.String)
+			recipe, recipeErr := q.getRecipe(ctx, q.readDB, result.ComponentRecipeID.String)
 			if recipeErr != nil {
 				if errors.Is(recipeErr, sql.ErrNoRows) {
 					logger.WithValue(mealplanningkeys.MealIDKey, result.ID).
@@ -439,7 +439,7 @@ func (q *repository) GetMealsWithIDs(ctx context.Context, ids []string) ([]*meal
 			mealsByID[result.ID] = m
 		}
 
-		recipe, recipeErr := q.getRecipe(ctx, result.ComponentRecipeID)
+		recipe, recipeErr := q.getRecipe(ctx, q.readDB, result.ComponentRecipeID)
 		if recipeErr != nil {
 			if errors.Is(recipeErr, sql.ErrNoRows) {
 				logger.WithValue(mealplanningkeys.MealIDKey, result.ID).
@@ -534,7 +534,7 @@ func (q *repository) SearchForMeals(ctx context.Context, mealNameQuery string, f
 			}
 		}
 
-		recipe, recipeErr := q.getRecipe(ctx, result.ComponentRecipeID)
+		recipe, recipeErr := q.getRecipe(ctx, q.readDB, result.ComponentRecipeID)
 		if recipeErr != nil {
 			if errors.Is(recipeErr, sql.ErrNoRows) {
 				logger.WithValue(mealplanningkeys.MealIDKey, result.ID).
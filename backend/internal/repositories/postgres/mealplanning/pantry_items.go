@@ -0,0 +1,547 @@
+package mealplanning
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/audit"
+	identitykeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity/keys"
+	types "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+	mealplanningkeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/keys"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/repositories/postgres/mealplanning/generated"
+
+	"github.com/primandproper/platform/database"
+	"github.com/primandproper/platform/database/filtering"
+	platformerrors "github.com/primandproper/platform/errors"
+	"github.com/primandproper/platform/identifiers"
+	"github.com/primandproper/platform/observability"
+	"github.com/primandproper/platform/observability/tracing"
+)
+
+const (
+	resourceTypePantryItems = "pantry_items"
+)
+
+var (
+	_ types.PantryItemDataManager = (*repository)(nil)
+)
+
+// PantryItemExists fetches whether a pantry item exists from the database.
+func (q *repository) PantryItemExists(ctx context.Context, pantryItemID, accountID string) (exists bool, err error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := q.logger.Clone()
+
+	if pantryItemID == "" {
+		return false, platformerrors.ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(mealplanningkeys.PantryItemIDKey, pantryItemID)
+	tracing.AttachToSpan(span, mealplanningkeys.PantryItemIDKey, pantryItemID)
+
+	if accountID == "" {
+		return false, platformerrors.ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(identitykeys.AccountIDKey, accountID)
+	tracing.AttachToSpan(span, identitykeys.AccountIDKey, accountID)
+
+	result, err := q.generatedQuerier.CheckPantryItemExistence(ctx, q.readDB, &generated.CheckPantryItemExistenceParams{
+		ID:               pantryItemID,
+		BelongsToAccount: accountID,
+	})
+	if err != nil {
+		return false, observability.PrepareAndLogError(err, logger, span, "performing pantry item existence check")
+	}
+
+	return result, nil
+}
+
+// GetPantryItem fetches a pantry item from the database.
+func (q *repository) GetPantryItem(ctx context.Context, pantryItemID, accountID string) (*types.PantryItem, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := q.logger.Clone()
+
+	if pantryItemID == "" {
+		return nil, platformerrors.ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(mealplanningkeys.PantryItemIDKey, pantryItemID)
+	tracing.AttachToSpan(span, mealplanningkeys.PantryItemIDKey, pantryItemID)
+
+	if accountID == "" {
+		return nil, platformerrors.ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(identitykeys.AccountIDKey, accountID)
+	tracing.AttachToSpan(span, identitykeys.AccountIDKey, accountID)
+
+	result, err := q.generatedQuerier.GetPantryItem(ctx, q.readDB, &generated.GetPantryItemParams{
+		ID:               pantryItemID,
+		BelongsToAccount: accountID,
+	})
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching pantry item")
+	}
+
+	pantryItem := &types.PantryItem{
+		CreatedAt:        result.CreatedAt,
+		ArchivedAt:       database.TimePointerFromNullTime(result.ArchivedAt),
+		LastUpdatedAt:    database.TimePointerFromNullTime(result.LastUpdatedAt),
+		ExpiresAt:        database.TimePointerFromNullTime(result.ExpiresAt),
+		ID:               result.ID,
+		Notes:            result.Notes,
+		StorageLocation:  string(result.StorageLocation),
+		BelongsToAccount: result.BelongsToAccount,
+		Quantity:         database.Float32FromString(result.Quantity),
+		Ingredient: types.ValidIngredient{
+			CreatedAt:                      result.ValidIngredientCreatedAt,
+			LastUpdatedAt:                  database.TimePointerFromNullTime(result.ValidIngredientLastUpdatedAt),
+			ArchivedAt:                     database.TimePointerFromNullTime(result.ValidIngredientArchivedAt),
+			MinStorageTemperatureInCelsius: database.Float32PointerFromNullString(result.ValidIngredientMinimumIdealStorageTemperatureInCelsius),
+			MaxStorageTemperatureInCelsius: database.Float32PointerFromNullString(result.ValidIngredientMaximumIdealStorageTemperatureInCelsius),
+			IconPath:                       result.ValidIngredientIconPath,
+			Warning:                        result.ValidIngredientWarning,
+			PluralName:                     result.ValidIngredientPluralName,
+			StorageInstructions:            result.ValidIngredientStorageInstructions,
+			Name:                           result.ValidIngredientName,
+			ID:                             result.ValidIngredientID,
+			Description:                    result.ValidIngredientDescription,
+			Slug:                           result.ValidIngredientSlug,
+			ShoppingSuggestions:            result.ValidIngredientShoppingSuggestions,
+			ContainsShellfish:              result.ValidIngredientContainsShellfish,
+			IsLiquid:                       database.BoolFromNullBool(result.ValidIngredientIsLiquid),
+			ContainsPeanut:                 result.ValidIngredientContainsPeanut,
+			ContainsTreeNut:                result.ValidIngredientContainsTreeNut,
+			ContainsEgg:                    result.ValidIngredientContainsEgg,
+			ContainsWheat:                  result.ValidIngredientContainsWheat,
+			ContainsSoy:                    result.ValidIngredientContainsSoy,
+			AnimalDerived:                  result.ValidIngredientAnimalDerived,
+			RestrictToPreparations:         result.ValidIngredientRestrictToPreparations,
+			ContainsSesame:                 result.ValidIngredientContainsSesame,
+			ContainsFish:                   result.ValidIngredientContainsFish,
+			ContainsGluten:                 result.ValidIngredientContainsGluten,
+			ContainsDairy:                  result.ValidIngredientContainsDairy,
+			ContainsAlcohol:                result.ValidIngredientContainsAlcohol,
+			AnimalFlesh:                    result.ValidIngredientAnimalFlesh,
+			IsStarch:                       result.ValidIngredientIsStarch,
+			IsProtein:                      result.ValidIngredientIsProtein,
+			IsGrain:                        result.ValidIngredientIsGrain,
+			IsFruit:                        result.ValidIngredientIsFruit,
+			IsSalt:                         result.ValidIngredientIsSalt,
+			IsFat:                          result.ValidIngredientIsFat,
+			IsAcid:                         result.ValidIngredientIsAcid,
+			IsHeat:                         result.ValidIngredientIsHeat,
+		},
+		MeasurementUnit: types.ValidMeasurementUnit{
+			CreatedAt:     result.ValidMeasurementUnitCreatedAt,
+			LastUpdatedAt: database.TimePointerFromNullTime(result.ValidMeasurementUnitLastUpdatedAt),
+			ArchivedAt:    database.TimePointerFromNullTime(result.ValidMeasurementUnitArchivedAt),
+			Name:          result.ValidMeasurementUnitName,
+			IconPath:      result.ValidMeasurementUnitIconPath,
+			ID:            result.ValidMeasurementUnitID,
+			Description:   result.ValidMeasurementUnitDescription,
+			PluralName:    result.ValidMeasurementUnitPluralName,
+			Slug:          result.ValidMeasurementUnitSlug,
+			Volumetric:    database.BoolFromNullBool(result.ValidMeasurementUnitVolumetric),
+			Universal:     result.ValidMeasurementUnitUniversal,
+			Metric:        result.ValidMeasurementUnitMetric,
+			Imperial:      result.ValidMeasurementUnitImperial,
+		},
+	}
+
+	return pantryItem, nil
+}
+
+// GetPantryItems fetches a list of pantry items from the database that meet a particular filter.
+func (q *repository) GetPantryItems(ctx context.Context, accountID string, filter *filtering.QueryFilter) (x *filtering.QueryFilteredResult[types.PantryItem], err error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := q.logger.Clone()
+
+	if accountID == "" {
+		return nil, platformerrors.ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(identitykeys.AccountIDKey, accountID)
+	tracing.AttachToSpan(span, identitykeys.AccountIDKey, accountID)
+
+	if filter == nil {
+		filter = filtering.DefaultQueryFilter()
+	}
+	logger = filter.AttachToLogger(logger)
+	tracing.AttachQueryFilterToSpan(span, filter)
+
+	var (
+		data          []*types.PantryItem
+		filteredCount uint64
+		totalCount    uint64
+	)
+
+	results, err := q.generatedQuerier.GetPantryItems(ctx, q.readDB, &generated.GetPantryItemsParams{
+		AccountID:       accountID,
+		CreatedBefore:   database.NullTimeFromTimePointer(filter.CreatedBefore),
+		CreatedAfter:    database.NullTimeFromTimePointer(filter.CreatedAfter),
+		UpdatedBefore:   database.NullTimeFromTimePointer(filter.UpdatedBefore),
+		UpdatedAfter:    database.NullTimeFromTimePointer(filter.UpdatedAfter),
+		Cursor:          database.NullStringFromStringPointer(filter.Cursor),
+		ResultLimit:     database.NullInt32FromUint8Pointer(filter.MaxResponseSize),
+		IncludeArchived: database.NullBoolFromBoolPointer(filter.IncludeArchived),
+	})
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "executing pantry items list retrieval query")
+	}
+
+	for _, result := range results {
+		if totalCount == 0 {
+			filteredCount = uint64(result.FilteredCount)
+			totalCount = uint64(result.TotalCount)
+		}
+		data = append(data, &types.PantryItem{
+			CreatedAt:        result.CreatedAt,
+			ArchivedAt:       database.TimePointerFromNullTime(result.ArchivedAt),
+			LastUpdatedAt:    database.TimePointerFromNullTime(result.LastUpdatedAt),
+			ExpiresAt:        database.TimePointerFromNullTime(result.ExpiresAt),
+			ID:               result.ID,
+			Notes:            result.Notes,
+			StorageLocation:  string(result.StorageLocation),
+			BelongsToAccount: result.BelongsToAccount,
+			Quantity:         database.Float32FromString(result.Quantity),
+			Ingredient: types.ValidIngredient{
+				CreatedAt:                      result.ValidIngredientCreatedAt,
+				LastUpdatedAt:                  database.TimePointerFromNullTime(result.ValidIngredientLastUpdatedAt),
+				ArchivedAt:                     database.TimePointerFromNullTime(result.ValidIngredientArchivedAt),
+				MinStorageTemperatureInCelsius: database.Float32PointerFromNullString(result.ValidIngredientMinimumIdealStorageTemperatureInCelsius),
+				MaxStorageTemperatureInCelsius: database.Float32PointerFromNullString(result.ValidIngredientMaximumIdealStorageTemperatureInCelsius),
+				IconPath:                       result.ValidIngredientIconPath,
+				Warning:                        result.ValidIngredientWarning,
+				PluralName:                     result.ValidIngredientPluralName,
+				StorageInstructions:            result.ValidIngredientStorageInstructions,
+				Name:                           result.ValidIngredientName,
+				ID:                             result.ValidIngredientID,
+				Description:                    result.ValidIngredientDescription,
+				Slug:                           result.ValidIngredientSlug,
+				ShoppingSuggestions:            result.ValidIngredientShoppingSuggestions,
+				ContainsShellfish:              result.ValidIngredientContainsShellfish,
+				IsLiquid:                       database.BoolFromNullBool(result.ValidIngredientIsLiquid),
+				ContainsPeanut:                 result.ValidIngredientContainsPeanut,
+				ContainsTreeNut:                result.ValidIngredientContainsTreeNut,
+				ContainsEgg:                    result.ValidIngredientContainsEgg,
+				ContainsWheat:                  result.ValidIngredientContainsWheat,
+				ContainsSoy:                    result.ValidIngredientContainsSoy,
+				AnimalDerived:                  result.ValidIngredientAnimalDerived,
+				RestrictToPreparations:         result.ValidIngredientRestrictToPreparations,
+				ContainsSesame:                 result.ValidIngredientContainsSesame,
+				ContainsFish:                   result.ValidIngredientContainsFish,
+				ContainsGluten:                 result.ValidIngredientContainsGluten,
+				ContainsDairy:                  result.ValidIngredientContainsDairy,
+				ContainsAlcohol:                result.ValidIngredientContainsAlcohol,
+				AnimalFlesh:                    result.ValidIngredientAnimalFlesh,
+				IsStarch:                       result.ValidIngredientIsStarch,
+				IsProtein:                      result.ValidIngredientIsProtein,
+				IsGrain:                        result.ValidIngredientIsGrain,
+				IsFruit:                        result.ValidIngredientIsFruit,
+				IsSalt:                         result.ValidIngredientIsSalt,
+				IsFat:                          result.ValidIngredientIsFat,
+				IsAcid:                         result.ValidIngredientIsAcid,
+				IsHeat:                         result.ValidIngredientIsHeat,
+			},
+			MeasurementUnit: types.ValidMeasurementUnit{
+				CreatedAt:     result.ValidMeasurementUnitCreatedAt,
+				LastUpdatedAt: database.TimePointerFromNullTime(result.ValidMeasurementUnitLastUpdatedAt),
+				ArchivedAt:    database.TimePointerFromNullTime(result.ValidMeasurementUnitArchivedAt),
+				Name:          result.ValidMeasurementUnitName,
+				IconPath:      result.ValidMeasurementUnitIconPath,
+				ID:            result.ValidMeasurementUnitID,
+				Description:   result.ValidMeasurementUnitDescription,
+				PluralName:    result.ValidMeasurementUnitPluralName,
+				Slug:          result.ValidMeasurementUnitSlug,
+				Volumetric:    database.BoolFromNullBool(result.ValidMeasurementUnitVolumetric),
+				Universal:     result.ValidMeasurementUnitUniversal,
+				Metric:        result.ValidMeasurementUnitMetric,
+				Imperial:      result.ValidMeasurementUnitImperial,
+			},
+		})
+	}
+
+	x = filtering.NewQueryFilteredResult(
+		data,
+		filteredCount,
+		totalCount,
+		func(pi *types.PantryItem) string { return pi.ID },
+		filter,
+	)
+
+	return x, nil
+}
+
+// GetAvailablePantryItemsForAccount fetches the unexpired, non-empty pantry items for an account, soonest to expire first.
+func (q *repository) GetAvailablePantryItemsForAccount(ctx context.Context, accountID string) ([]*types.PantryItem, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := q.logger.Clone()
+
+	if accountID == "" {
+		return nil, platformerrors.ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(identitykeys.AccountIDKey, accountID)
+	tracing.AttachToSpan(span, identitykeys.AccountIDKey, accountID)
+
+	results, err := q.generatedQuerier.GetAvailablePantryItemsForAccount(ctx, q.readDB, accountID)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "executing available pantry items retrieval query")
+	}
+
+	pantryItems := []*types.PantryItem{}
+	for _, result := range results {
+		pantryItems = append(pantryItems, &types.PantryItem{
+			CreatedAt:        result.CreatedAt,
+			ArchivedAt:       database.TimePointerFromNullTime(result.ArchivedAt),
+			LastUpdatedAt:    database.TimePointerFromNullTime(result.LastUpdatedAt),
+			ExpiresAt:        database.TimePointerFromNullTime(result.ExpiresAt),
+			ID:               result.ID,
+			Notes:            result.Notes,
+			StorageLocation:  string(result.StorageLocation),
+			BelongsToAccount: result.BelongsToAccount,
+			Quantity:         database.Float32FromString(result.Quantity),
+			Ingredient: types.ValidIngredient{
+				CreatedAt:                      result.ValidIngredientCreatedAt,
+				LastUpdatedAt:                  database.TimePointerFromNullTime(result.ValidIngredientLastUpdatedAt),
+				ArchivedAt:                     database.TimePointerFromNullTime(result.ValidIngredientArchivedAt),
+				MinStorageTemperatureInCelsius: database.Float32PointerFromNullString(result.ValidIngredientMinimumIdealStorageTemperatureInCelsius),
+				MaxStorageTemperatureInCelsius: database.Float32PointerFromNullString(result.ValidIngredientMaximumIdealStorageTemperatureInCelsius),
+				IconPath:                       result.ValidIngredientIconPath,
+				Warning:                        result.ValidIngredientWarning,
+				PluralName:                     result.ValidIngredientPluralName,
+				StorageInstructions:            result.ValidIngredientStorageInstructions,
+				Name:                           result.ValidIngredientName,
+				ID:                             result.ValidIngredientID,
+				Description:                    result.ValidIngredientDescription,
+				Slug:                           result.ValidIngredientSlug,
+				ShoppingSuggestions:            result.ValidIngredientShoppingSuggestions,
+				ContainsShellfish:              result.ValidIngredientContainsShellfish,
+				IsLiquid:                       database.BoolFromNullBool(result.ValidIngredientIsLiquid),
+				ContainsPeanut:                 result.ValidIngredientContainsPeanut,
+				ContainsTreeNut:                result.ValidIngredientContainsTreeNut,
+				ContainsEgg:                    result.ValidIngredientContainsEgg,
+				ContainsWheat:                  result.ValidIngredientContainsWheat,
+				ContainsSoy:                    result.ValidIngredientContainsSoy,
+				AnimalDerived:                  result.ValidIngredientAnimalDerived,
+				RestrictToPreparations:         result.ValidIngredientRestrictToPreparations,
+				ContainsSesame:                 result.ValidIngredientContainsSesame,
+				ContainsFish:                   result.ValidIngredientContainsFish,
+				ContainsGluten:                 result.ValidIngredientContainsGluten,
+				ContainsDairy:                  result.ValidIngredientContainsDairy,
+				ContainsAlcohol:                result.ValidIngredientContainsAlcohol,
+				AnimalFlesh:                    result.ValidIngredientAnimalFlesh,
+				IsStarch:                       result.ValidIngredientIsStarch,
+				IsProtein:                      result.ValidIngredientIsProtein,
+				IsGrain:                        result.ValidIngredientIsGrain,
+				IsFruit:                        result.ValidIngredientIsFruit,
+				IsSalt:                         result.ValidIngredientIsSalt,
+				IsFat:                          result.ValidIngredientIsFat,
+				IsAcid:                         result.ValidIngredientIsAcid,
+				IsHeat:                         result.ValidIngredientIsHeat,
+			},
+			MeasurementUnit: types.ValidMeasurementUnit{
+				CreatedAt:     result.ValidMeasurementUnitCreatedAt,
+				LastUpdatedAt: database.TimePointerFromNullTime(result.ValidMeasurementUnitLastUpdatedAt),
+				ArchivedAt:    database.TimePointerFromNullTime(result.ValidMeasurementUnitArchivedAt),
+				Name:          result.ValidMeasurementUnitName,
+				IconPath:      result.ValidMeasurementUnitIconPath,
+				ID:            result.ValidMeasurementUnitID,
+				Description:   result.ValidMeasurementUnitDescription,
+				PluralName:    result.ValidMeasurementUnitPluralName,
+				Slug:          result.ValidMeasurementUnitSlug,
+				Volumetric:    database.BoolFromNullBool(result.ValidMeasurementUnitVolumetric),
+				Universal:     result.ValidMeasurementUnitUniversal,
+				Metric:        result.ValidMeasurementUnitMetric,
+				Imperial:      result.ValidMeasurementUnitImperial,
+			},
+		})
+	}
+
+	return pantryItems, nil
+}
+
+// CreatePantryItem creates a pantry item in the database.
+func (q *repository) CreatePantryItem(ctx context.Context, input *types.PantryItemDatabaseCreationInput) (*types.PantryItem, error) {
+	return q.createPantryItem(ctx, q.writeDB, input)
+}
+
+// createPantryItem creates a pantry item with the provided querier, so it can join a caller's transaction.
+func (q *repository) createPantryItem(ctx context.Context, querier database.SQLQueryExecutor, input *types.PantryItemDatabaseCreationInput) (*types.PantryItem, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if input == nil {
+		return nil, platformerrors.ErrNilInputProvided
+	}
+	tracing.AttachToSpan(span, mealplanningkeys.PantryItemIDKey, input.ID)
+	logger := q.logger.WithValue(mealplanningkeys.PantryItemIDKey, input.ID)
+
+	// create the pantry item.
+	if err := q.generatedQuerier.CreatePantryItem(ctx, querier, &generated.CreatePantryItemParams{
+		ID:                     input.ID,
+		Notes:                  input.Notes,
+		Quantity:               database.StringFromFloat32(input.Quantity),
+		StorageLocation:        generated.PantryStorageLocation(input.StorageLocation),
+		ExpiresAt:              database.NullTimeFromTimePointer(input.ExpiresAt),
+		ValidIngredientID:      input.ValidIngredientID,
+		ValidMeasurementUnitID: input.ValidMeasurementUnitID,
+		BelongsToAccount:       input.BelongsToAccount,
+	}); err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "performing pantry item creation query")
+	}
+
+	if _, err := q.auditLogEntryRepo.CreateAuditLogEntry(ctx, querier, &audit.AuditLogEntryDatabaseCreationInput{
+		BelongsToAccount: &input.BelongsToAccount,
+		ID:               identifiers.New(),
+		ResourceType:     resourceTypePantryItems,
+		RelevantID:       input.ID,
+		EventType:        audit.AuditLogEventTypeCreated,
+	}); err != nil {
+		return nil, observability.PrepareError(err, span, "creating audit log entry")
+	}
+
+	x := &types.PantryItem{
+		ID:               input.ID,
+		Notes:            input.Notes,
+		Quantity:         input.Quantity,
+		StorageLocation:  input.StorageLocation,
+		ExpiresAt:        input.ExpiresAt,
+		Ingredient:       types.ValidIngredient{ID: input.ValidIngredientID},
+		MeasurementUnit:  types.ValidMeasurementUnit{ID: input.ValidMeasurementUnitID},
+		BelongsToAccount: input.BelongsToAccount,
+		CreatedAt:        q.CurrentTime(),
+	}
+
+	logger.Info("pantry item created")
+
+	return x, nil
+}
+
+// UpdatePantryItem updates a particular pantry item.
+func (q *repository) UpdatePantryItem(ctx context.Context, updated *types.PantryItem) error {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if updated == nil {
+		return platformerrors.ErrNilInputProvided
+	}
+	logger := q.logger.WithValue(mealplanningkeys.PantryItemIDKey, updated.ID)
+	tracing.AttachToSpan(span, mealplanningkeys.PantryItemIDKey, updated.ID)
+
+	if _, err := q.generatedQuerier.UpdatePantryItem(ctx, q.writeDB, &generated.UpdatePantryItemParams{
+		Notes:                  updated.Notes,
+		Quantity:               database.StringFromFloat32(updated.Quantity),
+		StorageLocation:        generated.PantryStorageLocation(updated.StorageLocation),
+		ExpiresAt:              database.NullTimeFromTimePointer(updated.ExpiresAt),
+		ValidIngredientID:      updated.Ingredient.ID,
+		ValidMeasurementUnitID: updated.MeasurementUnit.ID,
+		ID:                     updated.ID,
+		BelongsToAccount:       updated.BelongsToAccount,
+	}); err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "updating pantry item")
+	}
+
+	if _, err := q.auditLogEntryRepo.CreateAuditLogEntry(ctx, q.writeDB, &audit.AuditLogEntryDatabaseCreationInput{
+		BelongsToAccount: &updated.BelongsToAccount,
+		ID:               identifiers.New(),
+		ResourceType:     resourceTypePantryItems,
+		RelevantID:       updated.ID,
+		EventType:        audit.AuditLogEventTypeUpdated,
+	}); err != nil {
+		return observability.PrepareError(err, span, "creating audit log entry")
+	}
+
+	logger.Info("pantry item updated")
+
+	return nil
+}
+
+// drawDownPantryItem subtracts a drawn down quantity from a pantry item, never going below zero, and returns the pantry
+// item with its resulting quantity. It returns nil if the pantry item has since been archived.
+func (q *repository) drawDownPantryItem(ctx context.Context, querier database.SQLQueryExecutor, drawDown *types.PantryItemDrawDown) (*types.PantryItem, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if drawDown == nil || drawDown.PantryItem == nil {
+		return nil, platformerrors.ErrNilInputProvided
+	}
+	logger := q.logger.WithValue(mealplanningkeys.PantryItemIDKey, drawDown.PantryItem.ID)
+	tracing.AttachToSpan(span, mealplanningkeys.PantryItemIDKey, drawDown.PantryItem.ID)
+
+	quantity, err := q.generatedQuerier.DrawDownPantryItem(ctx, querier, &generated.DrawDownPantryItemParams{
+		Amount:           database.StringFromFloat32(drawDown.Quantity),
+		ID:               drawDown.PantryItem.ID,
+		BelongsToAccount: drawDown.PantryItem.BelongsToAccount,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+
+		return nil, observability.PrepareAndLogError(err, logger, span, "drawing down pantry item")
+	}
+
+	if _, err = q.auditLogEntryRepo.CreateAuditLogEntry(ctx, querier, &audit.AuditLogEntryDatabaseCreationInput{
+		BelongsToAccount: &drawDown.PantryItem.BelongsToAccount,
+		ID:               identifiers.New(),
+		ResourceType:     resourceTypePantryItems,
+		RelevantID:       drawDown.PantryItem.ID,
+		EventType:        audit.AuditLogEventTypeUpdated,
+	}); err != nil {
+		return nil, observability.PrepareError(err, span, "creating audit log entry")
+	}
+
+	pantryItem := *drawDown.PantryItem
+	pantryItem.Quantity = database.Float32FromString(quantity)
+
+	return &pantryItem, nil
+}
+
+// ArchivePantryItem archives a pantry item from the database by its ID.
+func (q *repository) ArchivePantryItem(ctx context.Context, pantryItemID, accountID string) error {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := q.logger.Clone()
+
+	if pantryItemID == "" {
+		return platformerrors.ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(mealplanningkeys.PantryItemIDKey, pantryItemID)
+	tracing.AttachToSpan(span, mealplanningkeys.PantryItemIDKey, pantryItemID)
+
+	if accountID == "" {
+		return platformerrors.ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(identitykeys.AccountIDKey, accountID)
+	tracing.AttachToSpan(span, identitykeys.AccountIDKey, accountID)
+
+	rowsAffected, err := q.generatedQuerier.ArchivePantryItem(ctx, q.writeDB, &generated.ArchivePantryItemParams{
+		ID:               pantryItemID,
+		BelongsToAccount: accountID,
+	})
+	if err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "archiving pantry item")
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	if _, err = q.auditLogEntryRepo.CreateAuditLogEntry(ctx, q.writeDB, &audit.AuditLogEntryDatabaseCreationInput{
+		BelongsToAccount: &accountID,
+		ID:               identifiers.New(),
+		ResourceType:     resourceTypePantryItems,
+		RelevantID:       pantryItemID,
+		EventType:        audit.AuditLogEventTypeArchived,
+	}); err != nil {
+		return observability.PrepareError(err, span, "creating audit log entry")
+	}
+
+	return nil
+}
@@ -0,0 +1,350 @@
+package mealplanning
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/audit"
+	types "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/converters"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/fakes"
+	pgtesting "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/repositories/postgres/testing"
+
+	"github.com/primandproper/platform/database/filtering"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createPantryItemForTest(t *testing.T, ctx context.Context, examplePantryItem *types.PantryItem, dbc *repository) *types.PantryItem {
+	t.Helper()
+
+	// create
+	if examplePantryItem == nil {
+		examplePantryItem = fakes.BuildFakePantryItem()
+	}
+	dbInput := converters.ConvertPantryItemToPantryItemDatabaseCreationInput(examplePantryItem)
+
+	created, err := dbc.CreatePantryItem(ctx, dbInput)
+	assert.NoError(t, err)
+	require.NotNil(t, created)
+
+	examplePantryItem.CreatedAt = created.CreatedAt
+	assert.Equal(t, examplePantryItem.Ingredient.ID, created.Ingredient.ID)
+	assert.Equal(t, examplePantryItem.MeasurementUnit.ID, created.MeasurementUnit.ID)
+	examplePantryItem.Ingredient = created.Ingredient
+	examplePantryItem.MeasurementUnit = created.MeasurementUnit
+	assert.Equal(t, examplePantryItem, created)
+
+	pantryItem, err := dbc.GetPantryItem(ctx, created.ID, created.BelongsToAccount)
+	require.NoError(t, err)
+	examplePantryItem.CreatedAt = pantryItem.CreatedAt
+	assert.Equal(t, examplePantryItem.Ingredient.ID, pantryItem.Ingredient.ID)
+	assert.Equal(t, examplePantryItem.MeasurementUnit.ID, pantryItem.MeasurementUnit.ID)
+	examplePantryItem.Ingredient = pantryItem.Ingredient
+	examplePantryItem.MeasurementUnit = pantryItem.MeasurementUnit
+	if examplePantryItem.ExpiresAt != nil {
+		require.NotNil(t, pantryItem.ExpiresAt)
+		assert.WithinDuration(t, *examplePantryItem.ExpiresAt, *pantryItem.ExpiresAt, time.Second)
+		examplePantryItem.ExpiresAt = pantryItem.ExpiresAt
+	}
+
+	assert.Equal(t, pantryItem, examplePantryItem)
+
+	return created
+}
+
+func TestQuerier_Integration_PantryItems(t *testing.T) {
+	if !pgtesting.RunContainerTests {
+		t.SkipNow()
+	}
+
+	ctx := t.Context()
+	dbc, auditRepo, container := buildDatabaseClientForTest(t)
+
+	databaseURI, err := container.ConnectionString(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, databaseURI)
+
+	defer func(t *testing.T) {
+		t.Helper()
+		assert.NoError(t, container.Terminate(ctx))
+	}(t)
+
+	user := pgtesting.CreateUserForTest(t, nil, dbc.writeDB)
+	account := pgtesting.CreateAccountForTest(t, nil, user.ID, dbc.writeDB)
+
+	ingredient := createValidIngredientForTest(t, ctx, nil, dbc)
+	measurementUnit := createValidMeasurementUnitForTest(t, ctx, nil, dbc)
+
+	examplePantryItem := fakes.BuildFakePantryItem()
+	examplePantryItem.BelongsToAccount = account.ID
+	examplePantryItem.Ingredient = *ingredient
+	examplePantryItem.MeasurementUnit = *measurementUnit
+	createdPantryItems := []*types.PantryItem{}
+
+	// create
+	createdPantryItems = append(createdPantryItems, createPantryItemForTest(t, ctx, examplePantryItem, dbc))
+
+	pgtesting.AssertAuditLogContains(t, ctx, auditRepo, account.ID, []*audit.AuditLogEntry{
+		{EventType: audit.AuditLogEventTypeCreated, ResourceType: resourceTypePantryItems, RelevantID: createdPantryItems[0].ID},
+	})
+
+	// update
+	createdPantryItems[0].StorageLocation = types.PantryStorageLocationFreezer
+	assert.NoError(t, dbc.UpdatePantryItem(ctx, createdPantryItems[0]))
+
+	// create more
+	for range exampleQuantity {
+		newIngredient := createValidIngredientForTest(t, ctx, nil, dbc)
+		input := fakes.BuildFakePantryItem()
+		input.BelongsToAccount = account.ID
+		input.Ingredient = *newIngredient
+		input.MeasurementUnit = *measurementUnit
+		createdPantryItems = append(createdPantryItems, createPantryItemForTest(t, ctx, input, dbc))
+	}
+
+	// fetch as list
+	pantryItems, err := dbc.GetPantryItems(ctx, account.ID, nil)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, pantryItems.Data)
+	assert.Equal(t, len(createdPantryItems), len(pantryItems.Data))
+
+	pgtesting.AssertAuditLogContains(t, ctx, auditRepo, account.ID, []*audit.AuditLogEntry{
+		{EventType: audit.AuditLogEventTypeCreated, ResourceType: resourceTypePantryItems, RelevantID: createdPantryItems[0].ID},
+		{EventType: audit.AuditLogEventTypeUpdated, ResourceType: resourceTypePantryItems, RelevantID: createdPantryItems[0].ID},
+	})
+
+	// fetch available stock, which excludes expired and empty items
+	notExpired := time.Now().Add(24 * time.Hour).Truncate(time.Second).UTC()
+	expired := time.Now().Add(-24 * time.Hour).Truncate(time.Second).UTC()
+
+	freshItem := fakes.BuildFakePantryItem()
+	freshItem.BelongsToAccount = account.ID
+	freshItem.Ingredient = *ingredient
+	freshItem.MeasurementUnit = *measurementUnit
+	freshItem.ExpiresAt = &notExpired
+	freshItem = createPantryItemForTest(t, ctx, freshItem, dbc)
+
+	expiredItem := fakes.BuildFakePantryItem()
+	expiredItem.BelongsToAccount = account.ID
+	expiredItem.Ingredient = *ingredient
+	expiredItem.MeasurementUnit = *measurementUnit
+	expiredItem.ExpiresAt = &expired
+	expiredItem = createPantryItemForTest(t, ctx, expiredItem, dbc)
+
+	emptyItem := fakes.BuildFakePantryItem()
+	emptyItem.BelongsToAccount = account.ID
+	emptyItem.Ingredient = *ingredient
+	emptyItem.MeasurementUnit = *measurementUnit
+	emptyItem.ExpiresAt = nil
+	emptyItem.Quantity = 0
+	emptyItem = createPantryItemForTest(t, ctx, emptyItem, dbc)
+
+	available, err := dbc.GetAvailablePantryItemsForAccount(ctx, account.ID)
+	require.NoError(t, err)
+
+	availableIDs := map[string]bool{}
+	for _, item := range available {
+		availableIDs[item.ID] = true
+	}
+	assert.True(t, availableIDs[freshItem.ID])
+	assert.False(t, availableIDs[expiredItem.ID])
+	assert.False(t, availableIDs[emptyItem.ID])
+
+	// draw down twice from the same stale read, as two options completed at once would, and check both take their share
+	freshItem.Quantity = 10
+	require.NoError(t, dbc.UpdatePantryItem(ctx, freshItem))
+
+	for range 2 {
+		var drawnDown *types.PantryItem
+		drawnDown, err = dbc.drawDownPantryItem(ctx, dbc.writeDB, &types.PantryItemDrawDown{PantryItem: freshItem, Quantity: 4})
+		require.NoError(t, err)
+		require.NotNil(t, drawnDown)
+	}
+
+	fetchedFreshItem, err := dbc.GetPantryItem(ctx, freshItem.ID, account.ID)
+	require.NoError(t, err)
+	assert.Equal(t, float32(2), fetchedFreshItem.Quantity)
+
+	// drawing down more than is left empties the item rather than going negative
+	drawnDownFreshItem, err := dbc.drawDownPantryItem(ctx, dbc.writeDB, &types.PantryItemDrawDown{PantryItem: freshItem, Quantity: 4})
+	require.NoError(t, err)
+	assert.Equal(t, float32(0), drawnDownFreshItem.Quantity)
+
+	createdPantryItems = append(createdPantryItems, freshItem, expiredItem, emptyItem)
+
+	// delete
+	for _, pantryItem := range createdPantryItems {
+		assert.NoError(t, dbc.ArchivePantryItem(ctx, pantryItem.ID, account.ID))
+
+		pgtesting.AssertAuditLogContains(t, ctx, auditRepo, account.ID, []*audit.AuditLogEntry{
+			{EventType: audit.AuditLogEventTypeArchived, ResourceType: resourceTypePantryItems, RelevantID: pantryItem.ID},
+		})
+
+		var exists bool
+		exists, err = dbc.PantryItemExists(ctx, pantryItem.ID, account.ID)
+		assert.NoError(t, err)
+		assert.False(t, exists)
+
+		var y *types.PantryItem
+		y, err = dbc.GetPantryItem(ctx, pantryItem.ID, account.ID)
+		assert.Nil(t, y)
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	}
+}
+
+func TestQuerier_PantryItemExists(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid pantry item ID", func(t *testing.T) {
+		t.Parallel()
+
+		exampleAccountID := fakes.BuildFakeID()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		actual, err := c.PantryItemExists(ctx, "", exampleAccountID)
+		assert.Error(t, err)
+		assert.False(t, actual)
+	})
+}
+
+func TestQuerier_GetPantryItem(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid pantry item ID", func(t *testing.T) {
+		t.Parallel()
+
+		exampleAccountID := fakes.BuildFakeID()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		actual, err := c.GetPantryItem(ctx, "", exampleAccountID)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
+
+func TestQuerier_GetPantryItems(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid account ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		actual, err := c.GetPantryItems(ctx, "", nil)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
+
+func TestQuerier_GetAvailablePantryItemsForAccount(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid account ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		actual, err := c.GetAvailablePantryItemsForAccount(ctx, "")
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
+
+func TestQuerier_CreatePantryItem(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid input", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		actual, err := c.CreatePantryItem(ctx, nil)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
+
+func TestQuerier_UpdatePantryItem(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with nil input", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		assert.Error(t, c.UpdatePantryItem(ctx, nil))
+	})
+}
+
+func TestQuerier_ArchivePantryItem(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid pantry item ID", func(t *testing.T) {
+		t.Parallel()
+
+		exampleAccountID := fakes.BuildFakeID()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		assert.Error(t, c.ArchivePantryItem(ctx, "", exampleAccountID))
+	})
+}
+
+func TestQuerier_Integration_PantryItems_CursorBasedPagination(t *testing.T) {
+	if !pgtesting.RunContainerTests {
+		t.SkipNow()
+	}
+
+	ctx := t.Context()
+	dbc, _, container := buildDatabaseClientForTest(t)
+
+	databaseURI, err := container.ConnectionString(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, databaseURI)
+
+	defer func(t *testing.T) {
+		t.Helper()
+		assert.NoError(t, container.Terminate(ctx))
+	}(t)
+
+	user := pgtesting.CreateUserForTest(t, nil, dbc.writeDB)
+	account := pgtesting.CreateAccountForTest(t, nil, user.ID, dbc.writeDB)
+	measurementUnit := createValidMeasurementUnitForTest(t, ctx, nil, dbc)
+
+	pgtesting.TestCursorBasedPagination(t, ctx, pgtesting.PaginationTestConfig[types.PantryItem]{
+		TotalItems: 9,
+		PageSize:   3,
+		ItemName:   "pantry item",
+		CreateItem: func(ctx context.Context, i int) *types.PantryItem {
+			ingredient := createValidIngredientForTest(t, ctx, nil, dbc)
+			pantryItem := fakes.BuildFakePantryItem()
+			pantryItem.BelongsToAccount = account.ID
+			pantryItem.Ingredient = *ingredient
+			pantryItem.MeasurementUnit = *measurementUnit
+			return createPantryItemForTest(t, ctx, pantryItem, dbc)
+		},
+		FetchPage: func(ctx context.Context, filter *filtering.QueryFilter) (*filtering.QueryFilteredResult[types.PantryItem], error) {
+			return dbc.GetPantryItems(ctx, account.ID, filter)
+		},
+		GetID: func(pantryItem *types.PantryItem) string {
+			return pantryItem.ID
+		},
+		CleanupItem: func(ctx context.Context, pantryItem *types.PantryItem) error {
+			return dbc.ArchivePantryItem(ctx, pantryItem.ID, account.ID)
+		},
+	})
+}
@@ -74,7 +74,7 @@ func (q *repository) GetRecipeMedia(ctx context.Context, recipeMediaID string) (
 }
 
 // getRecipeMediaForRecipe fetches a list of recipe media from the database that meet a particular filter.
-func (q *repository) getRecipeMediaForRecipe(ctx context.Context, recipeID string) ([]*types.RecipeMedia, error) {
+func (q *repository) getRecipeMediaForRecipe(ctx context.Context, db database.SQLQueryExecutor, recipeID string) ([]*types.RecipeMedia, error) {
 	ctx, span := q.tracer.StartSpan(ctx)
 	defer span.End()
 
@@ -86,7 +86,7 @@ func (q *repository) getRecipeMediaForRecipe(ctx context.Context, recipeID strin
 	logger = logger.WithValue(mealplanningkeys.RecipeIDKey, recipeID)
 	tracing.AttachToSpan(span, mealplanningkeys.RecipeIDKey, recipeID)
 
-	results, err := q.generatedQuerier.GetRecipeMediaForRecipe(ctx, q.readDB, database.NullStringFromString(recipeID))
+	results, err := q.generatedQuerier.GetRecipeMediaForRecipe(ctx, db, database.NullStringFromString(recipeID))
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "executing recipe media list retrieval query")
 	}
@@ -111,7 +111,7 @@ func (q *repository) getRecipeMediaForRecipe(ctx context.Context, recipeID strin
 }
 
 // getRecipeMediaForRecipeStep fetches a list of recipe media from the database that meet a particular filter.
-func (q *repository) getRecipeMediaForRecipeStep(ctx context.Context, recipeID, recipeStepID string) ([]*types.RecipeMedia, error) {
+func (q *repository) getRecipeMediaForRecipeStep(ctx context.Context, db database.SQLQueryExecutor, recipeID, recipeStepID string) ([]*types.RecipeMedia, error) {
 	ctx, span := q.tracer.StartSpan(ctx)
 	defer span.End()
 
@@ -129,7 +129,7 @@ func (q *repository) getRecipeMediaForRecipeStep(ctx context.Context, recipeID,
 	logger = logger.WithValue(mealplanningkeys.RecipeStepIDKey, recipeStepID)
 	tracing.AttachToSpan(span, mealplanningkeys.RecipeStepIDKey, recipeStepID)
 
-	results, err := q.generatedQuerier.GetRecipeMediaForRecipeStep(ctx, q.readDB, &generated.GetRecipeMediaForRecipeStepParams{
+	results, err := q.generatedQuerier.GetRecipeMediaForRecipeStep(ctx, db, &generated.GetRecipeMediaForRecipeStepParams{
 		RecipeID:     database.NullStringFromString(recipeID),
 		RecipeStepID: database.NullStringFromString(recipeStepID),
 	})
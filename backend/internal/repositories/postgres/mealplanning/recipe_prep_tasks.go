@@ -249,7 +249,7 @@ func (q *repository) createRecipePrepTaskStep(ctx context.Context, querier datab
 }
 
 // getRecipePrepTasksForRecipe gets a recipe prep task.
-func (q *repository) getRecipePrepTasksForRecipe(ctx context.Context, recipeID string) ([]*mealplanning.RecipePrepTask, error) {
+func (q *repository) getRecipePrepTasksForRecipe(ctx context.Context, db database.SQLQueryExecutor, recipeID string) ([]*mealplanning.RecipePrepTask, error) {
 	ctx, span := q.tracer.StartSpan(ctx)
 	defer span.End()
 
@@ -261,7 +261,7 @@ func (q *repository) getRecipePrepTasksForRecipe(ctx context.Context, recipeID s
 	logger = logger.WithValue(mealplanningkeys.RecipeIDKey, recipeID)
 	tracing.AttachToSpan(span, mealplanningkeys.RecipeIDKey, recipeID)
 
-	results, err := q.generatedQuerier.ListAllRecipePrepTasksByRecipe(ctx, q.readDB, recipeID)
+	results, err := q.generatedQuerier.ListAllRecipePrepTasksByRecipe(ctx, db, recipeID)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "executing recipe prep tasks list retrieval query")
 	}
@@ -336,7 +336,7 @@ func (q *repository) GetRecipePrepTasks(ctx context.Context, recipeID string, fi
 	logger = filter.AttachToLogger(logger)
 	tracing.AttachQueryFilterToSpan(span, filter)
 
-	tasks, err := q.getRecipePrepTasksForRecipe(ctx, recipeID)
+	tasks, err := q.getRecipePrepTasksForRecipe(ctx, q.readDB, recipeID)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "fetching recipe prep tasks")
 	}
@@ -354,7 +354,7 @@ func(rpt *mealplanning.RecipePrepTask) string { return rpt.ID },
 
 // GetRecipePrepTasksForRecipe gets a recipe prep task.
 func (q *repository) GetRecipePrepTasksForRecipe(ctx context.Context, recipeID string) (x []*mealplanning.RecipePrepTask, err error) {
-	return q.getRecipePrepTasksForRecipe(ctx, recipeID)
+	return q.getRecipePrepTasksForRecipe(ctx, q.readDB, recipeID)
 }
 
 // UpdateRecipePrepTask updates a recipe prep task.
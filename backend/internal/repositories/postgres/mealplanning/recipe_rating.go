@@ -89,6 +89,44 @@ func (q *repository) GetRecipeRating(ctx context.Context, recipeID, recipeRating
 	return recipeRating, nil
 }
 
+// GetRecipeRatingSummariesForAccount fetches the average overall rating the members of an account have given each of the given recipes.
+// Recipes nobody in the account has rated are left out.
+func (q *repository) GetRecipeRatingSummariesForAccount(ctx context.Context, accountID string, recipeIDs []string) ([]*types.RecipeRatingSummary, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := q.logger.Clone()
+
+	if accountID == "" {
+		return nil, platformerrors.ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(identitykeys.AccountIDKey, accountID)
+	tracing.AttachToSpan(span, identitykeys.AccountIDKey, accountID)
+
+	if len(recipeIDs) == 0 {
+		return []*types.RecipeRatingSummary{}, nil
+	}
+
+	results, err := q.generatedQuerier.GetRecipeRatingSummariesForAccount(ctx, q.readDB, &generated.GetRecipeRatingSummariesForAccountParams{
+		BelongsToAccount: accountID,
+		RecipeIds:        recipeIDs,
+	})
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching recipe rating summaries for account")
+	}
+
+	summaries := []*types.RecipeRatingSummary{}
+	for _, result := range results {
+		summaries = append(summaries, &types.RecipeRatingSummary{
+			RecipeID:       result.RecipeID,
+			AverageOverall: result.AverageOverall,
+			RatingCount:    uint32(result.RatingCount),
+		})
+	}
+
+	return summaries, nil
+}
+
 // GetRecipeRatingsForRecipe fetches a list of recipe ratings from the database that meet a particular filter.
 func (q *repository) GetRecipeRatingsForRecipe(ctx context.Context, recipeID string, filter *filtering.QueryFilter) (x *filtering.QueryFilteredResult[types.RecipeRating], err error) {
 	ctx, span := q.tracer.StartSpan(ctx)
@@ -240,3 +240,29 @@ func TestQuerier_Integration_RecipeRatings_CursorBasedPagination(t *testing.T) {
 		},
 	})
 }
+
+func TestQuerier_GetRecipeRatingSummariesForAccount(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid account ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		actual, err := c.GetRecipeRatingSummariesForAccount(ctx, "", []string{fakes.BuildFakeID()})
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+
+	T.Run("without recipe IDs", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		actual, err := c.GetRecipeRatingSummariesForAccount(ctx, fakes.BuildFakeID(), nil)
+		assert.NoError(t, err)
+		assert.Empty(t, actual)
+	})
+}
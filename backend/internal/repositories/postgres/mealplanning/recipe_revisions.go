@@ -0,0 +1,346 @@
+package mealplanning
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/authentication/sessions"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+	mealplanningkeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/keys"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/repositories/postgres/mealplanning/generated"
+
+	"github.com/primandproper/platform/database"
+	"github.com/primandproper/platform/database/filtering"
+	platformerrors "github.com/primandproper/platform/errors"
+	"github.com/primandproper/platform/identifiers"
+	"github.com/primandproper/platform/observability"
+	"github.com/primandproper/platform/observability/tracing"
+)
+
+var (
+	_ mealplanning.RecipeRevisionDataManager = (*repository)(nil)
+)
+
+// createRecipeRevision records a recipe revision in the database.
+func (q *repository) createRecipeRevision(ctx context.Context, db database.SQLQueryExecutor, input *mealplanning.RecipeRevisionDatabaseCreationInput) (*mealplanning.RecipeRevision, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if input == nil || input.Snapshot == nil {
+		return nil, platformerrors.ErrNilInputProvided
+	}
+	tracing.AttachToSpan(span, mealplanningkeys.RecipeIDKey, input.BelongsToRecipe)
+	tracing.AttachToSpan(span, mealplanningkeys.RecipeRevisionIDKey, input.ID)
+	logger := q.logger.WithValue(mealplanningkeys.RecipeIDKey, input.BelongsToRecipe).WithValue(mealplanningkeys.RecipeRevisionIDKey, input.ID)
+
+	snapshot, err := json.Marshal(input.Snapshot)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "encoding recipe snapshot")
+	}
+
+	result, err := q.generatedQuerier.CreateRecipeRevision(ctx, db, &generated.CreateRecipeRevisionParams{
+		ID:              input.ID,
+		BelongsToRecipe: input.BelongsToRecipe,
+		Snapshot:        snapshot,
+		CreatedByUser:   database.NullStringFromStringPointer(input.CreatedByUser),
+	})
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "performing recipe revision creation query")
+	}
+
+	x := &mealplanning.RecipeRevision{
+		CreatedAt:       result.CreatedAt,
+		CreatedByUser:   database.StringPointerFromNullString(result.CreatedByUser),
+		Snapshot:        input.Snapshot,
+		ID:              result.ID,
+		BelongsToRecipe: result.BelongsToRecipe,
+		RevisionNumber:  uint32(result.RevisionNumber),
+	}
+
+	logger.WithValue(mealplanningkeys.RecipeRevisionNumberKey, x.RevisionNumber).Info("recipe revision created")
+
+	return x, nil
+}
+
+// lockRecipe locks a recipe's row until the given transaction ends. Every change to a recipe or anything beneath it
+// takes this lock before it writes, so changes and the revisions recorded for them are applied one at a time.
+func (q *repository) lockRecipe(ctx context.Context, tx database.SQLQueryExecutor, recipeID string) error {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if _, err := q.generatedQuerier.LockRecipe(ctx, tx, recipeID); err != nil {
+		return observability.PrepareError(err, span, "locking recipe")
+	}
+
+	return nil
+}
+
+// lockRecipeForRecipeStep locks the row of the recipe a recipe step belongs to until the given transaction ends, and
+// returns that recipe's ID.
+func (q *repository) lockRecipeForRecipeStep(ctx context.Context, tx database.SQLQueryExecutor, recipeStepID string) (string, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	recipeID, err := q.generatedQuerier.LockRecipeForRecipeStep(ctx, tx, recipeStepID)
+	if err != nil {
+		return "", observability.PrepareError(err, span, "locking recipe for recipe step")
+	}
+
+	return recipeID, nil
+}
+
+// recordRecipeRevision snapshots a recipe as it stands within the given transaction. Every change to a recipe or
+// anything beneath it calls this before committing, while it holds the recipe's lock, so the latest revision always
+// matches the live recipe.
+func (q *repository) recordRecipeRevision(ctx context.Context, tx database.SQLQueryExecutor, recipeID string) error {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	recipe, err := q.getRecipe(ctx, tx, recipeID, nil)
+	if err != nil {
+		return observability.PrepareError(err, span, "fetching recipe")
+	}
+
+	input := &mealplanning.RecipeRevisionDatabaseCreationInput{
+		ID:              identifiers.New(),
+		BelongsToRecipe: recipeID,
+		Snapshot:        recipe,
+	}
+
+	if sessionContext, sessionErr := sessions.FetchContextDataFromContext(ctx); sessionErr == nil && sessionContext.Requester.UserID != "" {
+		input.CreatedByUser = &sessionContext.Requester.UserID
+	}
+
+	if _, err = q.createRecipeRevision(ctx, tx, input); err != nil {
+		return observability.PrepareError(err, span, "creating recipe revision")
+	}
+
+	return nil
+}
+
+// GetRecipeRevision fetches a recipe revision, including its snapshot, from the database.
+func (q *repository) GetRecipeRevision(ctx context.Context, recipeID string, revisionNumber uint32) (*mealplanning.RecipeRevision, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := q.logger.Clone()
+
+	if recipeID == "" {
+		return nil, platformerrors.ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(mealplanningkeys.RecipeIDKey, recipeID)
+	tracing.AttachToSpan(span, mealplanningkeys.RecipeIDKey, recipeID)
+
+	if revisionNumber == 0 {
+		return nil, platformerrors.ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(mealplanningkeys.RecipeRevisionNumberKey, revisionNumber)
+	tracing.AttachToSpan(span, mealplanningkeys.RecipeRevisionNumberKey, revisionNumber)
+
+	result, err := q.generatedQuerier.GetRecipeRevision(ctx, q.readDB, &generated.GetRecipeRevisionParams{
+		BelongsToRecipe: recipeID,
+		RevisionNumber:  int32(revisionNumber),
+	})
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "performing recipe revision query")
+	}
+
+	snapshot := &mealplanning.Recipe{}
+	if err = json.Unmarshal(result.Snapshot, snapshot); err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "decoding recipe snapshot")
+	}
+
+	x := &mealplanning.RecipeRevision{
+		CreatedAt:       result.CreatedAt,
+		CreatedByUser:   database.StringPointerFromNullString(result.CreatedByUser),
+		Snapshot:        snapshot,
+		ID:              result.ID,
+		BelongsToRecipe: result.BelongsToRecipe,
+		RevisionNumber:  uint32(result.RevisionNumber),
+	}
+
+	return x, nil
+}
+
+// GetRecipeRevisions fetches a list of a recipe's revisions, without their snapshots, from the database.
+func (q *repository) GetRecipeRevisions(ctx context.Context, recipeID string, filter *filtering.QueryFilter) (x *filtering.QueryFilteredResult[mealplanning.RecipeRevision], err error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := q.logger.Clone()
+
+	if filter == nil {
+		filter = filtering.DefaultQueryFilter()
+	}
+	logger = filter.AttachToLogger(logger)
+	tracing.AttachQueryFilterToSpan(span, filter)
+
+	if recipeID == "" {
+		return nil, platformerrors.ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(mealplanningkeys.RecipeIDKey, recipeID)
+	tracing.AttachToSpan(span, mealplanningkeys.RecipeIDKey, recipeID)
+
+	var (
+		data          []*mealplanning.RecipeRevision
+		filteredCount uint64
+		totalCount    uint64
+	)
+
+	results, err := q.generatedQuerier.GetRecipeRevisionsForRecipe(ctx, q.readDB, &generated.GetRecipeRevisionsForRecipeParams{
+		BelongsToRecipe: recipeID,
+		CreatedBefore:   database.NullTimeFromTimePointer(filter.CreatedBefore),
+		CreatedAfter:    database.NullTimeFromTimePointer(filter.CreatedAfter),
+		Cursor:          database.NullStringFromStringPointer(filter.Cursor),
+		ResultLimit:     database.NullInt32FromUint8Pointer(filter.MaxResponseSize),
+	})
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "executing recipe revisions list retrieval query")
+	}
+
+	for _, result := range results {
+		if totalCount == 0 {
+			filteredCount = uint64(result.FilteredCount)
+			totalCount = uint64(result.TotalCount)
+		}
+		data = append(data, &mealplanning.RecipeRevision{
+			CreatedAt:       result.CreatedAt,
+			CreatedByUser:   database.StringPointerFromNullString(result.CreatedByUser),
+			ID:              result.ID,
+			BelongsToRecipe: result.BelongsToRecipe,
+			RevisionNumber:  uint32(result.RevisionNumber),
+		})
+	}
+
+	x = filtering.NewQueryFilteredResult(
+		data,
+		filteredCount,
+		totalCount,
+		func(rr *mealplanning.RecipeRevision) string { return rr.ID },
+		filter,
+	)
+
+	return x, nil
+}
+
+// RestoreRecipeRevision replaces a recipe's details and steps with those of one of its revisions. The recipe's current
+// steps are archived, and the revision's steps are created anew, so that nothing a revision refers to ever changes.
+func (q *repository) RestoreRecipeRevision(ctx context.Context, updated *mealplanning.Recipe, steps []*mealplanning.RecipeStepDatabaseCreationInput) error {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if updated == nil {
+		return platformerrors.ErrNilInputProvided
+	}
+	logger := q.logger.WithValue(mealplanningkeys.RecipeIDKey, updated.ID)
+	tracing.AttachToSpan(span, mealplanningkeys.RecipeIDKey, updated.ID)
+
+	// product references are resolved by index, the same way they are when a recipe is created.
+	input := &mealplanning.RecipeDatabaseCreationInput{ID: updated.ID, Steps: steps}
+	if err := q.findCreatedRecipeStepProductsForIngredients(ctx, input); err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "finding recipe step products for ingredients")
+	}
+	q.findCreatedRecipeStepProductsForInstruments(ctx, input)
+	q.findCreatedRecipeStepProductsForVessels(ctx, input)
+
+	tx, err := q.writeDB.BeginTx(ctx, nil)
+	if err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "beginning transaction")
+	}
+
+	if err = q.lockRecipe(ctx, tx, updated.ID); err != nil {
+		q.RollbackTransaction(ctx, tx)
+		return observability.PrepareAndLogError(err, logger, span, "locking recipe")
+	}
+
+	if _, err = q.generatedQuerier.UpdateRecipe(ctx, tx, &generated.UpdateRecipeParams{
+		Name:                 updated.Name,
+		Slug:                 updated.Slug,
+		Source:               updated.Source,
+		SourceIsbn:           updated.SourceISBN,
+		Description:          updated.Description,
+		InspiredByRecipeID:   database.NullStringFromStringPointer(updated.InspiredByRecipeID),
+		MinEstimatedPortions: database.StringFromFloat32(updated.MinEstimatedPortions),
+		MaxEstimatedPortions: database.NullStringFromFloat32Pointer(updated.MaxEstimatedPortions),
+		PortionName:          updated.PortionName,
+		PluralPortionName:    updated.PluralPortionName,
+		EligibleForMeals:     updated.EligibleForMeals,
+		YieldsComponentType:  generated.ComponentType(updated.YieldsComponentType),
+		CreatedByUser:        updated.CreatedByUser,
+		ID:                   updated.ID,
+	}); err != nil {
+		q.RollbackTransaction(ctx, tx)
+		return observability.PrepareAndLogError(err, logger, span, "updating recipe")
+	}
+
+	if err = q.generatedQuerier.ArchiveRecipeStepsForRecipe(ctx, tx, updated.ID); err != nil {
+		q.RollbackTransaction(ctx, tx)
+		return observability.PrepareAndLogError(err, logger, span, "archiving recipe steps")
+	}
+
+	for i, stepInput := range steps {
+		stepInput.BelongsToRecipe = updated.ID
+		if _, err = q.createRecipeStep(ctx, tx, stepInput); err != nil {
+			q.RollbackTransaction(ctx, tx)
+			return observability.PrepareAndLogError(err, logger, span, "creating recipe step #%d", i+1)
+		}
+	}
+
+	if err = q.recordRecipeRevision(ctx, tx, updated.ID); err != nil {
+		q.RollbackTransaction(ctx, tx)
+		return observability.PrepareAndLogError(err, logger, span, "recording recipe revision")
+	}
+
+	if err = tx.Commit(); err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "committing transaction")
+	}
+
+	logger.Info("recipe revision restored")
+
+	return nil
+}
+
+// pinRecipeRevisionsForMealPlanOption pins a meal plan option to the latest revisions of its meal's recipes.
+func (q *repository) pinRecipeRevisionsForMealPlanOption(ctx context.Context, db database.SQLQueryExecutor, mealPlanOptionID, mealID string) error {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := q.logger.WithValue(mealplanningkeys.MealPlanOptionIDKey, mealPlanOptionID).WithValue(mealplanningkeys.MealIDKey, mealID)
+
+	if err := q.generatedQuerier.PinLatestRecipeRevisionsForMealPlanOption(ctx, db, &generated.PinLatestRecipeRevisionsForMealPlanOptionParams{
+		BelongsToMealPlanOption: mealPlanOptionID,
+		BelongsToMeal:           mealID,
+	}); err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "pinning recipe revisions")
+	}
+
+	return nil
+}
+
+// getRecipeRevisionsForMealPlanOption fetches the recipe revisions a meal plan option is pinned to.
+func (q *repository) getRecipeRevisionsForMealPlanOption(ctx context.Context, mealPlanOptionID string) ([]*mealplanning.MealPlanOptionRecipeRevision, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	results, err := q.generatedQuerier.GetRecipeRevisionsForMealPlanOption(ctx, q.readDB, mealPlanOptionID)
+	if err != nil {
+		return nil, observability.PrepareError(err, span, "fetching recipe revisions for meal plan option")
+	}
+
+	x := []*mealplanning.MealPlanOptionRecipeRevision{}
+	for _, result := range results {
+		snapshot := &mealplanning.Recipe{}
+		if err = json.Unmarshal(result.Snapshot, snapshot); err != nil {
+			return nil, observability.PrepareError(err, span, "decoding snapshot for recipe %s", result.RecipeID)
+		}
+
+		x = append(x, &mealplanning.MealPlanOptionRecipeRevision{
+			Snapshot:         snapshot,
+			RecipeID:         result.RecipeID,
+			RecipeRevisionID: result.ID,
+			RevisionNumber:   uint32(result.RevisionNumber),
+		})
+	}
+
+	return x, nil
+}
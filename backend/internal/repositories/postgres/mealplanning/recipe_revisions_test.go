@@ -0,0 +1,171 @@
+package mealplanning
+
+import (
+	"testing"
+
+	types "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/converters"
+	pgtesting "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/repositories/postgres/testing"
+
+	"github.com/primandproper/platform/identifiers"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// buildRestoredRecipeStepsForTest converts a snapshot's steps into creation inputs with fresh IDs.
+func buildRestoredRecipeStepsForTest(snapshot *types.Recipe) []*types.RecipeStepDatabaseCreationInput {
+	steps := []*types.RecipeStepDatabaseCreationInput{}
+	for _, step := range snapshot.Steps {
+		input := converters.ConvertRecipeStepToRecipeStepDatabaseCreationInput(step)
+		input.ID = identifiers.New()
+		for _, x := range input.Ingredients {
+			x.ID = identifiers.New()
+			x.BelongsToRecipeStep = input.ID
+		}
+		for _, x := range input.Instruments {
+			x.ID = identifiers.New()
+			x.BelongsToRecipeStep = input.ID
+		}
+		for _, x := range input.Vessels {
+			x.ID = identifiers.New()
+			x.BelongsToRecipeStep = input.ID
+		}
+		for _, x := range input.Products {
+			x.ID = identifiers.New()
+			x.BelongsToRecipeStep = input.ID
+		}
+		input.CompletionConditions = nil
+		steps = append(steps, input)
+	}
+
+	return steps
+}
+
+func TestQuerier_Integration_RecipeRevisions(t *testing.T) {
+	if !pgtesting.RunContainerTests {
+		t.SkipNow()
+	}
+
+	ctx := t.Context()
+	dbc, _, container := buildDatabaseClientForTest(t)
+
+	databaseURI, err := container.ConnectionString(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, databaseURI)
+
+	defer func(t *testing.T) {
+		t.Helper()
+		assert.NoError(t, container.Terminate(ctx))
+	}(t)
+
+	user := pgtesting.CreateUserForTest(t, nil, dbc.writeDB)
+
+	exampleRecipe := buildRecipeForTestCreation(t, ctx, user.ID, dbc)
+	createdRecipe := createRecipeForTest(t, ctx, exampleRecipe, dbc, false)
+
+	// creating the recipe records its first revision
+	original, err := dbc.GetRecipe(ctx, createdRecipe.ID)
+	require.NoError(t, err)
+
+	// changing it records another
+	original.Name = t.Name()
+	require.NoError(t, dbc.UpdateRecipe(ctx, original))
+
+	updated, err := dbc.GetRecipe(ctx, createdRecipe.ID)
+	require.NoError(t, err)
+
+	// fetch as list
+	revisions, err := dbc.GetRecipeRevisions(ctx, createdRecipe.ID, nil)
+	require.NoError(t, err)
+	require.Len(t, revisions.Data, 2)
+	for _, revision := range revisions.Data {
+		assert.Nil(t, revision.Snapshot)
+	}
+
+	// fetch one
+	fetched, err := dbc.GetRecipeRevision(ctx, createdRecipe.ID, 1)
+	require.NoError(t, err)
+	require.NotNil(t, fetched.Snapshot)
+	assert.Equal(t, uint32(1), fetched.RevisionNumber)
+	assert.Equal(t, exampleRecipe.Name, fetched.Snapshot.Name)
+	assert.Equal(t, len(createdRecipe.Steps), len(fetched.Snapshot.Steps))
+
+	// restore the first revision
+	updated.Name = fetched.Snapshot.Name
+	require.NoError(t, dbc.RestoreRecipeRevision(ctx, updated, buildRestoredRecipeStepsForTest(fetched.Snapshot)))
+
+	restored, err := dbc.GetRecipe(ctx, createdRecipe.ID)
+	require.NoError(t, err)
+	assert.Equal(t, exampleRecipe.Name, restored.Name)
+	require.Equal(t, len(fetched.Snapshot.Steps), len(restored.Steps))
+	for i := range restored.Steps {
+		assert.NotEqual(t, fetched.Snapshot.Steps[i].ID, restored.Steps[i].ID)
+		assert.Equal(t, fetched.Snapshot.Steps[i].Index, restored.Steps[i].Index)
+		assert.Equal(t, len(fetched.Snapshot.Steps[i].Ingredients), len(restored.Steps[i].Ingredients))
+	}
+
+	// restoring records a revision of its own, and the earlier revisions are untouched
+	fetched, err = dbc.GetRecipeRevision(ctx, createdRecipe.ID, 2)
+	require.NoError(t, err)
+	assert.Equal(t, t.Name(), fetched.Snapshot.Name)
+
+	fetched, err = dbc.GetRecipeRevision(ctx, createdRecipe.ID, 3)
+	require.NoError(t, err)
+	assert.Equal(t, exampleRecipe.Name, fetched.Snapshot.Name)
+	assert.Equal(t, len(restored.Steps), len(fetched.Snapshot.Steps))
+}
+
+func TestQuerier_GetRecipeRevision(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid recipe ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		actual, err := c.GetRecipeRevision(ctx, "", 1)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+
+	T.Run("with invalid revision number", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		actual, err := c.GetRecipeRevision(ctx, t.Name(), 0)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
+
+func TestQuerier_createRecipeRevision(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid input", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		actual, err := c.createRecipeRevision(ctx, c.writeDB, nil)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
+
+func TestQuerier_RestoreRecipeRevision(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with nil input", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		assert.Error(t, c.RestoreRecipeRevision(ctx, nil, nil))
+	})
+}
@@ -135,7 +135,7 @@ func (q *repository) GetRecipeStepCompletionCondition(ctx context.Context, recip
 }
 
 // getRecipeStepCompletionConditionsForRecipe fetches a recipe step completion condition from the database.
-func (q *repository) getRecipeStepCompletionConditionsForRecipe(ctx context.Context, recipeID string) ([]*types.RecipeStepCompletionCondition, error) {
+func (q *repository) getRecipeStepCompletionConditionsForRecipe(ctx context.Context, db database.SQLQueryExecutor, recipeID string) ([]*types.RecipeStepCompletionCondition, error) {
 	ctx, span := q.tracer.StartSpan(ctx)
 	defer span.End()
 
@@ -147,7 +147,7 @@ func (q *repository) getRecipeStepCompletionConditionsForRecipe(ctx context.Cont
 	logger = logger.WithValue(mealplanningkeys.RecipeIDKey, recipeID)
 	tracing.AttachToSpan(span, mealplanningkeys.RecipeIDKey, recipeID)
 
-	results, err := q.generatedQuerier.GetAllRecipeStepCompletionConditionsForRecipe(ctx, q.readDB, recipeID)
+	results, err := q.generatedQuerier.GetAllRecipeStepCompletionConditionsForRecipe(ctx, db, recipeID)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "querying for recipe step completion condition")
 	}
@@ -381,7 +381,40 @@ func (q *repository) createRecipeStepCompletionConditionIngredient(ctx context.C
 
 // CreateRecipeStepCompletionCondition creates a recipe step completion condition in the database.
 func (q *repository) CreateRecipeStepCompletionCondition(ctx context.Context, input *types.RecipeStepCompletionConditionDatabaseCreationInput) (*types.RecipeStepCompletionCondition, error) {
-	return q.createRecipeStepCompletionCondition(ctx, q.writeDB, input)
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if input == nil {
+		return nil, platformerrors.ErrNilInputProvided
+	}
+
+	tx, err := q.writeDB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, observability.PrepareError(err, span, "beginning transaction")
+	}
+
+	recipeID, err := q.lockRecipeForRecipeStep(ctx, tx, input.BelongsToRecipeStep)
+	if err != nil {
+		q.RollbackTransaction(ctx, tx)
+		return nil, observability.PrepareError(err, span, "locking recipe")
+	}
+
+	x, err := q.createRecipeStepCompletionCondition(ctx, tx, input)
+	if err != nil {
+		q.RollbackTransaction(ctx, tx)
+		return nil, observability.PrepareError(err, span, "creating recipe step completion condition")
+	}
+
+	if err = q.recordRecipeRevision(ctx, tx, recipeID); err != nil {
+		q.RollbackTransaction(ctx, tx)
+		return nil, observability.PrepareError(err, span, "recording recipe revision")
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, observability.PrepareError(err, span, "committing transaction")
+	}
+
+	return x, nil
 }
 
 // UpdateRecipeStepCompletionCondition updates a particular recipe step completion condition.
@@ -395,16 +428,37 @@ func (q *repository) UpdateRecipeStepCompletionCondition(ctx context.Context, up
 	logger := q.logger.WithValue(mealplanningkeys.RecipeStepCompletionConditionIDKey, updated.ID)
 	tracing.AttachToSpan(span, mealplanningkeys.RecipeStepCompletionConditionIDKey, updated.ID)
 
-	if _, err := q.generatedQuerier.UpdateRecipeStepCompletionCondition(ctx, q.writeDB, &generated.UpdateRecipeStepCompletionConditionParams{
+	tx, err := q.writeDB.BeginTx(ctx, nil)
+	if err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "beginning transaction")
+	}
+
+	recipeID, err := q.lockRecipeForRecipeStep(ctx, tx, updated.BelongsToRecipeStep)
+	if err != nil {
+		q.RollbackTransaction(ctx, tx)
+		return observability.PrepareAndLogError(err, logger, span, "locking recipe")
+	}
+
+	if _, err = q.generatedQuerier.UpdateRecipeStepCompletionCondition(ctx, tx, &generated.UpdateRecipeStepCompletionConditionParams{
 		Optional:            updated.Optional,
 		Notes:               updated.Notes,
 		BelongsToRecipeStep: updated.BelongsToRecipeStep,
 		IngredientState:     updated.IngredientState.ID,
 		ID:                  updated.ID,
 	}); err != nil {
+		q.RollbackTransaction(ctx, tx)
 		return observability.PrepareAndLogError(err, logger, span, "updating recipe step completion condition")
 	}
 
+	if err = q.recordRecipeRevision(ctx, tx, recipeID); err != nil {
+		q.RollbackTransaction(ctx, tx)
+		return observability.PrepareAndLogError(err, logger, span, "recording recipe revision")
+	}
+
+	if err = tx.Commit(); err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "committing transaction")
+	}
+
 	logger.Info("recipe step completion condition updated")
 
 	return nil
@@ -429,17 +483,39 @@ func (q *repository) ArchiveRecipeStepCompletionCondition(ctx context.Context, r
 	logger = logger.WithValue(mealplanningkeys.RecipeStepCompletionConditionIDKey, recipeStepCompletionConditionID)
 	tracing.AttachToSpan(span, mealplanningkeys.RecipeStepCompletionConditionIDKey, recipeStepCompletionConditionID)
 
-	rowsAffected, err := q.generatedQuerier.ArchiveRecipeStepCompletionCondition(ctx, q.writeDB, &generated.ArchiveRecipeStepCompletionConditionParams{
+	tx, err := q.writeDB.BeginTx(ctx, nil)
+	if err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "beginning transaction")
+	}
+
+	recipeID, err := q.lockRecipeForRecipeStep(ctx, tx, recipeStepID)
+	if err != nil {
+		q.RollbackTransaction(ctx, tx)
+		return observability.PrepareAndLogError(err, logger, span, "locking recipe")
+	}
+
+	rowsAffected, err := q.generatedQuerier.ArchiveRecipeStepCompletionCondition(ctx, tx, &generated.ArchiveRecipeStepCompletionConditionParams{
 		BelongsToRecipeStep: recipeStepID,
 		ID:                  recipeStepCompletionConditionID,
 	})
 	if err != nil {
+		q.RollbackTransaction(ctx, tx)
 		return observability.PrepareAndLogError(err, logger, span, "updating recipe step completion condition")
 	}
 
 	if rowsAffected == 0 {
+		q.RollbackTransaction(ctx, tx)
 		return sql.ErrNoRows
 	}
 
+	if err = q.recordRecipeRevision(ctx, tx, recipeID); err != nil {
+		q.RollbackTransaction(ctx, tx)
+		return observability.PrepareAndLogError(err, logger, span, "recording recipe revision")
+	}
+
+	if err = tx.Commit(); err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "committing transaction")
+	}
+
 	return nil
 }
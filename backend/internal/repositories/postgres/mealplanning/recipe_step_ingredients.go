@@ -177,7 +177,7 @@ func (q *repository) GetRecipeStepIngredient(ctx context.Context, recipeID, reci
 }
 
 // getRecipeStepIngredientsForRecipe fetches a list of recipe step ingredients from the database that meet a particular filter.
-func (q *repository) getRecipeStepIngredientsForRecipe(ctx context.Context, recipeID string) ([]*mealplanning.RecipeStepIngredient, error) {
+func (q *repository) getRecipeStepIngredientsForRecipe(ctx context.Context, db database.SQLQueryExecutor, recipeID string) ([]*mealplanning.RecipeStepIngredient, error) {
 	ctx, span := q.tracer.StartSpan(ctx)
 	defer span.End()
 
@@ -189,7 +189,7 @@ func (q *repository) getRecipeStepIngredientsForRecipe(ctx context.Context, reci
 	logger = logger.WithValue(mealplanningkeys.RecipeIDKey, recipeID)
 	tracing.AttachToSpan(span, mealplanningkeys.RecipeIDKey, recipeID)
 
-	results, err := q.generatedQuerier.GetAllRecipeStepIngredientsForRecipe(ctx, q.readDB, recipeID)
+	results, err := q.generatedQuerier.GetAllRecipeStepIngredientsForRecipe(ctx, db, recipeID)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "executing recipe step ingredients list retrieval query")
 	}
@@ -524,7 +524,33 @@ func (q *repository) CreateRecipeStepIngredient(ctx context.Context, input *meal
 		return nil, observability.PrepareError(err, span, "validating ingredient dependencies")
 	}
 
-	return q.createRecipeStepIngredient(ctx, q.writeDB, input)
+	tx, err := q.writeDB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, observability.PrepareError(err, span, "beginning transaction")
+	}
+
+	recipeID, err := q.lockRecipeForRecipeStep(ctx, tx, input.BelongsToRecipeStep)
+	if err != nil {
+		q.RollbackTransaction(ctx, tx)
+		return nil, observability.PrepareError(err, span, "locking recipe")
+	}
+
+	x, err := q.createRecipeStepIngredient(ctx, tx, input)
+	if err != nil {
+		q.RollbackTransaction(ctx, tx)
+		return nil, observability.PrepareError(err, span, "creating recipe step ingredient")
+	}
+
+	if err = q.recordRecipeRevision(ctx, tx, recipeID); err != nil {
+		q.RollbackTransaction(ctx, tx)
+		return nil, observability.PrepareError(err, span, "recording recipe revision")
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, observability.PrepareError(err, span, "committing transaction")
+	}
+
+	return x, nil
 }
 
 // UpdateRecipeStepIngredient updates a particular recipe step ingredient.
@@ -554,7 +580,18 @@ func (q *repository) UpdateRecipeStepIngredient(ctx context.Context, updated *me
 		ingredientID = &updated.Ingredient.ID
 	}
 
-	if _, err = q.generatedQuerier.UpdateRecipeStepIngredient(ctx, q.writeDB, &generated.UpdateRecipeStepIngredientParams{
+	tx, err := q.writeDB.BeginTx(ctx, nil)
+	if err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "beginning transaction")
+	}
+
+	recipeID, err := q.lockRecipeForRecipeStep(ctx, tx, updated.BelongsToRecipeStep)
+	if err != nil {
+		q.RollbackTransaction(ctx, tx)
+		return observability.PrepareAndLogError(err, logger, span, "locking recipe")
+	}
+
+	if _, err = q.generatedQuerier.UpdateRecipeStepIngredient(ctx, tx, &generated.UpdateRecipeStepIngredientParams{
 		IngredientID:              database.NullStringFromStringPointer(ingredientID),
 		Name:                      updated.Name,
 		Optional:                  updated.Optional,
@@ -574,9 +611,19 @@ func (q *repository) UpdateRecipeStepIngredient(ctx context.Context, updated *me
 		ID:                        updated.ID,
 		ScaleFactor:               database.StringFromFloat32(updated.ScaleFactor),
 	}); err != nil {
+		q.RollbackTransaction(ctx, tx)
 		return observability.PrepareAndLogError(err, logger, span, "updating recipe step ingredient")
 	}
 
+	if err = q.recordRecipeRevision(ctx, tx, recipeID); err != nil {
+		q.RollbackTransaction(ctx, tx)
+		return observability.PrepareAndLogError(err, logger, span, "recording recipe revision")
+	}
+
+	if err = tx.Commit(); err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "committing transaction")
+	}
+
 	logger.Info("recipe step ingredient updated")
 
 	return nil
@@ -601,17 +648,39 @@ func (q *repository) ArchiveRecipeStepIngredient(ctx context.Context, recipeStep
 	logger = logger.WithValue(mealplanningkeys.RecipeStepIngredientIDKey, recipeStepIngredientID)
 	tracing.AttachToSpan(span, mealplanningkeys.RecipeStepIngredientIDKey, recipeStepIngredientID)
 
-	rowsAffected, err := q.generatedQuerier.ArchiveRecipeStepIngredient(ctx, q.writeDB, &generated.ArchiveRecipeStepIngredientParams{
+	tx, err := q.writeDB.BeginTx(ctx, nil)
+	if err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "beginning transaction")
+	}
+
+	recipeID, err := q.lockRecipeForRecipeStep(ctx, tx, recipeStepID)
+	if err != nil {
+		q.RollbackTransaction(ctx, tx)
+		return observability.PrepareAndLogError(err, logger, span, "locking recipe")
+	}
+
+	rowsAffected, err := q.generatedQuerier.ArchiveRecipeStepIngredient(ctx, tx, &generated.ArchiveRecipeStepIngredientParams{
 		BelongsToRecipeStep: recipeStepID,
 		ID:                  recipeStepIngredientID,
 	})
 	if err != nil {
+		q.RollbackTransaction(ctx, tx)
 		return observability.PrepareAndLogError(err, logger, span, "archiving recipe step ingredient")
 	}
 
 	if rowsAffected == 0 {
+		q.RollbackTransaction(ctx, tx)
 		return sql.ErrNoRows
 	}
 
+	if err = q.recordRecipeRevision(ctx, tx, recipeID); err != nil {
+		q.RollbackTransaction(ctx, tx)
+		return observability.PrepareAndLogError(err, logger, span, "recording recipe revision")
+	}
+
+	if err = tx.Commit(); err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "committing transaction")
+	}
+
 	return nil
 }
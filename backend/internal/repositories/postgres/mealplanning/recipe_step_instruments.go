@@ -239,7 +239,7 @@ func(rsi *mealplanning.RecipeStepInstrument) string { return rsi.ID },
 }
 
 // getRecipeStepInstrumentsForRecipe fetches a list of recipe step instruments from the database that meet a particular filter.
-func (q *repository) getRecipeStepInstrumentsForRecipe(ctx context.Context, recipeID string) ([]*mealplanning.RecipeStepInstrument, error) {
+func (q *repository) getRecipeStepInstrumentsForRecipe(ctx context.Context, db database.SQLQueryExecutor, recipeID string) ([]*mealplanning.RecipeStepInstrument, error) {
 	ctx, span := q.tracer.StartSpan(ctx)
 	defer span.End()
 
@@ -251,7 +251,7 @@ func (q *repository) getRecipeStepInstrumentsForRecipe(ctx context.Context, reci
 	logger = logger.WithValue(mealplanningkeys.RecipeIDKey, recipeID)
 	tracing.AttachToSpan(span, mealplanningkeys.RecipeIDKey, recipeID)
 
-	results, err := q.generatedQuerier.GetRecipeStepInstrumentsForRecipe(ctx, q.readDB, recipeID)
+	results, err := q.generatedQuerier.GetRecipeStepInstrumentsForRecipe(ctx, db, recipeID)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "performing recipe step instruments list retrieval")
 	}
@@ -363,7 +363,40 @@ func (q *repository) createRecipeStepInstrument(ctx context.Context, querier dat
 
 // CreateRecipeStepInstrument creates a recipe step instrument in the database.
 func (q *repository) CreateRecipeStepInstrument(ctx context.Context, input *mealplanning.RecipeStepInstrumentDatabaseCreationInput) (*mealplanning.RecipeStepInstrument, error) {
-	return q.createRecipeStepInstrument(ctx, q.writeDB, input)
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if input == nil {
+		return nil, platformerrors.ErrNilInputProvided
+	}
+
+	tx, err := q.writeDB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, observability.PrepareError(err, span, "beginning transaction")
+	}
+
+	recipeID, err := q.lockRecipeForRecipeStep(ctx, tx, input.BelongsToRecipeStep)
+	if err != nil {
+		q.RollbackTransaction(ctx, tx)
+		return nil, observability.PrepareError(err, span, "locking recipe")
+	}
+
+	x, err := q.createRecipeStepInstrument(ctx, tx, input)
+	if err != nil {
+		q.RollbackTransaction(ctx, tx)
+		return nil, observability.PrepareError(err, span, "creating recipe step instrument")
+	}
+
+	if err = q.recordRecipeRevision(ctx, tx, recipeID); err != nil {
+		q.RollbackTransaction(ctx, tx)
+		return nil, observability.PrepareError(err, span, "recording recipe revision")
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, observability.PrepareError(err, span, "committing transaction")
+	}
+
+	return x, nil
 }
 
 // UpdateRecipeStepInstrument updates a particular recipe step instrument.
@@ -382,7 +415,18 @@ func (q *repository) UpdateRecipeStepInstrument(ctx context.Context, updated *me
 		instrumentID = &updated.Instrument.ID
 	}
 
-	if _, err := q.generatedQuerier.UpdateRecipeStepInstrument(ctx, q.writeDB, &generated.UpdateRecipeStepInstrumentParams{
+	tx, err := q.writeDB.BeginTx(ctx, nil)
+	if err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "beginning transaction")
+	}
+
+	recipeID, err := q.lockRecipeForRecipeStep(ctx, tx, updated.BelongsToRecipeStep)
+	if err != nil {
+		q.RollbackTransaction(ctx, tx)
+		return observability.PrepareAndLogError(err, logger, span, "locking recipe")
+	}
+
+	if _, err = q.generatedQuerier.UpdateRecipeStepInstrument(ctx, tx, &generated.UpdateRecipeStepInstrumentParams{
 		InstrumentID:        database.NullStringFromStringPointer(instrumentID),
 		RecipeStepProductID: database.NullStringFromStringPointer(updated.RecipeStepProductID),
 		Name:                updated.Name,
@@ -397,9 +441,19 @@ func (q *repository) UpdateRecipeStepInstrument(ctx context.Context, updated *me
 		ID:                  updated.ID,
 		ScaleFactor:         database.StringFromFloat32(updated.ScaleFactor),
 	}); err != nil {
+		q.RollbackTransaction(ctx, tx)
 		return observability.PrepareAndLogError(err, logger, span, "updating recipe step instrument")
 	}
 
+	if err = q.recordRecipeRevision(ctx, tx, recipeID); err != nil {
+		q.RollbackTransaction(ctx, tx)
+		return observability.PrepareAndLogError(err, logger, span, "recording recipe revision")
+	}
+
+	if err = tx.Commit(); err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "committing transaction")
+	}
+
 	logger.Info("recipe step instrument updated")
 
 	return nil
@@ -424,17 +478,39 @@ func (q *repository) ArchiveRecipeStepInstrument(ctx context.Context, recipeStep
 	logger = logger.WithValue(mealplanningkeys.RecipeStepInstrumentIDKey, recipeStepInstrumentID)
 	tracing.AttachToSpan(span, mealplanningkeys.RecipeStepInstrumentIDKey, recipeStepInstrumentID)
 
-	rowsAffected, err := q.generatedQuerier.ArchiveRecipeStepInstrument(ctx, q.writeDB, &generated.ArchiveRecipeStepInstrumentParams{
+	tx, err := q.writeDB.BeginTx(ctx, nil)
+	if err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "beginning transaction")
+	}
+
+	recipeID, err := q.lockRecipeForRecipeStep(ctx, tx, recipeStepID)
+	if err != nil {
+		q.RollbackTransaction(ctx, tx)
+		return observability.PrepareAndLogError(err, logger, span, "locking recipe")
+	}
+
+	rowsAffected, err := q.generatedQuerier.ArchiveRecipeStepInstrument(ctx, tx, &generated.ArchiveRecipeStepInstrumentParams{
 		BelongsToRecipeStep: recipeStepID,
 		ID:                  recipeStepInstrumentID,
 	})
 	if err != nil {
+		q.RollbackTransaction(ctx, tx)
 		return observability.PrepareAndLogError(err, logger, span, "archiving recipe step instrument")
 	}
 
 	if rowsAffected == 0 {
+		q.RollbackTransaction(ctx, tx)
 		return sql.ErrNoRows
 	}
 
+	if err = q.recordRecipeRevision(ctx, tx, recipeID); err != nil {
+		q.RollbackTransaction(ctx, tx)
+		return observability.PrepareAndLogError(err, logger, span, "recording recipe revision")
+	}
+
+	if err = tx.Commit(); err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "committing transaction")
+	}
+
 	return nil
 }
@@ -137,7 +137,7 @@ func (q *repository) GetRecipeStepProduct(ctx context.Context, recipeID, recipeS
 }
 
 // getRecipeStepProductsForRecipe fetches a list of recipe step products from the database that meet a particular filter.
-func (q *repository) getRecipeStepProductsForRecipe(ctx context.Context, recipeID string) ([]*mealplanning.RecipeStepProduct, error) {
+func (q *repository) getRecipeStepProductsForRecipe(ctx context.Context, db database.SQLQueryExecutor, recipeID string) ([]*mealplanning.RecipeStepProduct, error) {
 	ctx, span := q.tracer.StartSpan(ctx)
 	defer span.End()
 
@@ -149,7 +149,7 @@ func (q *repository) getRecipeStepProductsForRecipe(ctx context.Context, recipeI
 	logger = logger.WithValue(mealplanningkeys.RecipeIDKey, recipeID)
 	tracing.AttachToSpan(span, mealplanningkeys.RecipeIDKey, recipeID)
 
-	results, err := q.generatedQuerier.GetRecipeStepProductsForRecipe(ctx, q.readDB, recipeID)
+	results, err := q.generatedQuerier.GetRecipeStepProductsForRecipe(ctx, db, recipeID)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "fetching recipe step products for recipe")
 	}
@@ -381,7 +381,40 @@ func (q *repository) createRecipeStepProduct(ctx context.Context, db database.SQ
 
 // CreateRecipeStepProduct creates a recipe step product in the database.
 func (q *repository) CreateRecipeStepProduct(ctx context.Context, input *mealplanning.RecipeStepProductDatabaseCreationInput) (*mealplanning.RecipeStepProduct, error) {
-	return q.createRecipeStepProduct(ctx, q.writeDB, input)
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if input == nil {
+		return nil, platformerrors.ErrNilInputProvided
+	}
+
+	tx, err := q.writeDB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, observability.PrepareError(err, span, "beginning transaction")
+	}
+
+	recipeID, err := q.lockRecipeForRecipeStep(ctx, tx, input.BelongsToRecipeStep)
+	if err != nil {
+		q.RollbackTransaction(ctx, tx)
+		return nil, observability.PrepareError(err, span, "locking recipe")
+	}
+
+	x, err := q.createRecipeStepProduct(ctx, tx, input)
+	if err != nil {
+		q.RollbackTransaction(ctx, tx)
+		return nil, observability.PrepareError(err, span, "creating recipe step product")
+	}
+
+	if err = q.recordRecipeRevision(ctx, tx, recipeID); err != nil {
+		q.RollbackTransaction(ctx, tx)
+		return nil, observability.PrepareError(err, span, "recording recipe revision")
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, observability.PrepareError(err, span, "committing transaction")
+	}
+
+	return x, nil
 }
 
 // UpdateRecipeStepProduct updates a particular recipe step product.
@@ -400,7 +433,18 @@ func (q *repository) UpdateRecipeStepProduct(ctx context.Context, updated *mealp
 		measurementUnitID = &updated.MeasurementUnit.ID
 	}
 
-	if _, err := q.generatedQuerier.UpdateRecipeStepProduct(ctx, q.writeDB, &generated.UpdateRecipeStepProductParams{
+	tx, err := q.writeDB.BeginTx(ctx, nil)
+	if err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "beginning transaction")
+	}
+
+	recipeID, err := q.lockRecipeForRecipeStep(ctx, tx, updated.BelongsToRecipeStep)
+	if err != nil {
+		q.RollbackTransaction(ctx, tx)
+		return observability.PrepareAndLogError(err, logger, span, "locking recipe")
+	}
+
+	if _, err = q.generatedQuerier.UpdateRecipeStepProduct(ctx, tx, &generated.UpdateRecipeStepProductParams{
 		Name:                               updated.Name,
 		Type:                               generated.RecipeStepProductType(updated.Type),
 		MeasurementUnit:                    database.NullStringFromStringPointer(measurementUnitID),
@@ -421,9 +465,19 @@ func (q *repository) UpdateRecipeStepProduct(ctx context.Context, updated *mealp
 		BelongsToRecipeStep:                updated.BelongsToRecipeStep,
 		ID:                                 updated.ID,
 	}); err != nil {
+		q.RollbackTransaction(ctx, tx)
 		return observability.PrepareAndLogError(err, logger, span, "updating recipe step product")
 	}
 
+	if err = q.recordRecipeRevision(ctx, tx, recipeID); err != nil {
+		q.RollbackTransaction(ctx, tx)
+		return observability.PrepareAndLogError(err, logger, span, "recording recipe revision")
+	}
+
+	if err = tx.Commit(); err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "committing transaction")
+	}
+
 	logger.Info("recipe step product updated")
 
 	return nil
@@ -448,17 +502,39 @@ func (q *repository) ArchiveRecipeStepProduct(ctx context.Context, recipeStepID,
 	logger = logger.WithValue(mealplanningkeys.RecipeStepProductIDKey, recipeStepProductID)
 	tracing.AttachToSpan(span, mealplanningkeys.RecipeStepProductIDKey, recipeStepProductID)
 
-	rowsAffected, err := q.generatedQuerier.ArchiveRecipeStepProduct(ctx, q.writeDB, &generated.ArchiveRecipeStepProductParams{
+	tx, err := q.writeDB.BeginTx(ctx, nil)
+	if err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "beginning transaction")
+	}
+
+	recipeID, err := q.lockRecipeForRecipeStep(ctx, tx, recipeStepID)
+	if err != nil {
+		q.RollbackTransaction(ctx, tx)
+		return observability.PrepareAndLogError(err, logger, span, "locking recipe")
+	}
+
+	rowsAffected, err := q.generatedQuerier.ArchiveRecipeStepProduct(ctx, tx, &generated.ArchiveRecipeStepProductParams{
 		BelongsToRecipeStep: recipeStepID,
 		ID:                  recipeStepProductID,
 	})
 	if err != nil {
+		q.RollbackTransaction(ctx, tx)
 		return observability.PrepareAndLogError(err, logger, span, "updating recipe step product")
 	}
 
 	if rowsAffected == 0 {
+		q.RollbackTransaction(ctx, tx)
 		return sql.ErrNoRows
 	}
 
+	if err = q.recordRecipeRevision(ctx, tx, recipeID); err != nil {
+		q.RollbackTransaction(ctx, tx)
+		return observability.PrepareAndLogError(err, logger, span, "recording recipe revision")
+	}
+
+	if err = tx.Commit(); err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "committing transaction")
+	}
+
 	return nil
 }
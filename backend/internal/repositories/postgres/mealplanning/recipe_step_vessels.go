@@ -287,7 +287,7 @@ func(rsv *mealplanning.RecipeStepVessel) string { return rsv.ID },
 }
 
 // getRecipeStepVesselsForRecipe fetches a list of recipe step vessels from the database that meet a particular filter.
-func (q *repository) getRecipeStepVesselsForRecipe(ctx context.Context, recipeID string) ([]*mealplanning.RecipeStepVessel, error) {
+func (q *repository) getRecipeStepVesselsForRecipe(ctx context.Context, db database.SQLQueryExecutor, recipeID string) ([]*mealplanning.RecipeStepVessel, error) {
 	ctx, span := q.tracer.StartSpan(ctx)
 	defer span.End()
 
@@ -299,7 +299,7 @@ func (q *repository) getRecipeStepVesselsForRecipe(ctx context.Context, recipeID
 	logger = logger.WithValue(mealplanningkeys.RecipeIDKey, recipeID)
 	tracing.AttachToSpan(span, mealplanningkeys.RecipeIDKey, recipeID)
 
-	results, err := q.generatedQuerier.GetRecipeStepVesselsForRecipe(ctx, q.readDB, recipeID)
+	results, err := q.generatedQuerier.GetRecipeStepVesselsForRecipe(ctx, db, recipeID)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "fetching recipe step vessels for recipe")
 	}
@@ -434,7 +434,40 @@ func (q *repository) createRecipeStepVessel(ctx context.Context, querier databas
 
 // CreateRecipeStepVessel creates a recipe step vessel in the database.
 func (q *repository) CreateRecipeStepVessel(ctx context.Context, input *mealplanning.RecipeStepVesselDatabaseCreationInput) (*mealplanning.RecipeStepVessel, error) {
-	return q.createRecipeStepVessel(ctx, q.writeDB, input)
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if input == nil {
+		return nil, platformerrors.ErrNilInputProvided
+	}
+
+	tx, err := q.writeDB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, observability.PrepareError(err, span, "beginning transaction")
+	}
+
+	recipeID, err := q.lockRecipeForRecipeStep(ctx, tx, input.BelongsToRecipeStep)
+	if err != nil {
+		q.RollbackTransaction(ctx, tx)
+		return nil, observability.PrepareError(err, span, "locking recipe")
+	}
+
+	x, err := q.createRecipeStepVessel(ctx, tx, input)
+	if err != nil {
+		q.RollbackTransaction(ctx, tx)
+		return nil, observability.PrepareError(err, span, "creating recipe step vessel")
+	}
+
+	if err = q.recordRecipeRevision(ctx, tx, recipeID); err != nil {
+		q.RollbackTransaction(ctx, tx)
+		return nil, observability.PrepareError(err, span, "recording recipe revision")
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, observability.PrepareError(err, span, "committing transaction")
+	}
+
+	return x, nil
 }
 
 // UpdateRecipeStepVessel updates a particular recipe step vessel.
@@ -453,7 +486,18 @@ func (q *repository) UpdateRecipeStepVessel(ctx context.Context, updated *mealpl
 		vesselID = &updated.Vessel.ID
 	}
 
-	if _, err := q.generatedQuerier.UpdateRecipeStepVessel(ctx, q.writeDB, &generated.UpdateRecipeStepVesselParams{
+	tx, err := q.writeDB.BeginTx(ctx, nil)
+	if err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "beginning transaction")
+	}
+
+	recipeID, err := q.lockRecipeForRecipeStep(ctx, tx, updated.BelongsToRecipeStep)
+	if err != nil {
+		q.RollbackTransaction(ctx, tx)
+		return observability.PrepareAndLogError(err, logger, span, "locking recipe")
+	}
+
+	if _, err = q.generatedQuerier.UpdateRecipeStepVessel(ctx, tx, &generated.UpdateRecipeStepVesselParams{
 		Name:                 updated.Name,
 		Notes:                updated.Notes,
 		BelongsToRecipeStep:  updated.BelongsToRecipeStep,
@@ -468,9 +512,19 @@ func (q *repository) UpdateRecipeStepVessel(ctx context.Context, updated *mealpl
 		UnavailableAfterStep: updated.UnavailableAfterStep,
 		ScaleFactor:          database.StringFromFloat32(updated.ScaleFactor),
 	}); err != nil {
+		q.RollbackTransaction(ctx, tx)
 		return observability.PrepareAndLogError(err, logger, span, "updating recipe step vessel")
 	}
 
+	if err = q.recordRecipeRevision(ctx, tx, recipeID); err != nil {
+		q.RollbackTransaction(ctx, tx)
+		return observability.PrepareAndLogError(err, logger, span, "recording recipe revision")
+	}
+
+	if err = tx.Commit(); err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "committing transaction")
+	}
+
 	logger.Info("recipe step vessel updated")
 
 	return nil
@@ -495,17 +549,39 @@ func (q *repository) ArchiveRecipeStepVessel(ctx context.Context, recipeStepID,
 	logger = logger.WithValue(mealplanningkeys.RecipeStepVesselIDKey, recipeStepVesselID)
 	tracing.AttachToSpan(span, mealplanningkeys.RecipeStepVesselIDKey, recipeStepVesselID)
 
-	rowsAffected, err := q.generatedQuerier.ArchiveRecipeStepVessel(ctx, q.writeDB, &generated.ArchiveRecipeStepVesselParams{
+	tx, err := q.writeDB.BeginTx(ctx, nil)
+	if err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "beginning transaction")
+	}
+
+	recipeID, err := q.lockRecipeForRecipeStep(ctx, tx, recipeStepID)
+	if err != nil {
+		q.RollbackTransaction(ctx, tx)
+		return observability.PrepareAndLogError(err, logger, span, "locking recipe")
+	}
+
+	rowsAffected, err := q.generatedQuerier.ArchiveRecipeStepVessel(ctx, tx, &generated.ArchiveRecipeStepVesselParams{
 		BelongsToRecipeStep: recipeStepID,
 		ID:                  recipeStepVesselID,
 	})
 	if err != nil {
+		q.RollbackTransaction(ctx, tx)
 		return observability.PrepareAndLogError(err, logger, span, "archiving recipe step vessel")
 	}
 
 	if rowsAffected == 0 {
+		q.RollbackTransaction(ctx, tx)
 		return sql.ErrNoRows
 	}
 
+	if err = q.recordRecipeRevision(ctx, tx, recipeID); err != nil {
+		q.RollbackTransaction(ctx, tx)
+		return observability.PrepareAndLogError(err, logger, span, "recording recipe revision")
+	}
+
+	if err = tx.Commit(); err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "committing transaction")
+	}
+
 	return nil
 }
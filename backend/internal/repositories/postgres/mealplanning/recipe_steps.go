@@ -126,7 +126,7 @@ func (q *repository) GetRecipeStep(ctx context.Context, recipeID, recipeStepID s
 	}
 
 	// Fetch related data for this recipe step
-	ingredients, err := q.getRecipeStepIngredientsForRecipe(ctx, recipeID)
+	ingredients, err := q.getRecipeStepIngredientsForRecipe(ctx, q.readDB, recipeID)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "fetching recipe step ingredients for recipe step")
 	}
@@ -136,7 +136,7 @@ func (q *repository) GetRecipeStep(ctx context.Context, recipeID, recipeStepID s
 		}
 	}
 
-	products, err := q.getRecipeStepProductsForRecipe(ctx, recipeID)
+	products, err := q.getRecipeStepProductsForRecipe(ctx, q.readDB, recipeID)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "fetching recipe step products for recipe step")
 	}
@@ -146,7 +146,7 @@ func (q *repository) GetRecipeStep(ctx context.Context, recipeID, recipeStepID s
 		}
 	}
 
-	instruments, err := q.getRecipeStepInstrumentsForRecipe(ctx, recipeID)
+	instruments, err := q.getRecipeStepInstrumentsForRecipe(ctx, q.readDB, recipeID)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "fetching recipe step instruments for recipe step")
 	}
@@ -156,7 +156,7 @@ func (q *repository) GetRecipeStep(ctx context.Context, recipeID, recipeStepID s
 		}
 	}
 
-	vessels, err := q.getRecipeStepVesselsForRecipe(ctx, recipeID)
+	vessels, err := q.getRecipeStepVesselsForRecipe(ctx, q.readDB, recipeID)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "fetching recipe step vessels for recipe step")
 	}
@@ -166,7 +166,7 @@ func (q *repository) GetRecipeStep(ctx context.Context, recipeID, recipeStepID s
 		}
 	}
 
-	completionConditions, err := q.getRecipeStepCompletionConditionsForRecipe(ctx, recipeID)
+	completionConditions, err := q.getRecipeStepCompletionConditionsForRecipe(ctx, q.readDB, recipeID)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "fetching recipe step completion conditions for recipe step")
 	}
@@ -176,7 +176,7 @@ func (q *repository) GetRecipeStep(ctx context.Context, recipeID, recipeStepID s
 		}
 	}
 
-	recipeMedia, err := q.getRecipeMediaForRecipeStep(ctx, recipeID, recipeStep.ID)
+	recipeMedia, err := q.getRecipeMediaForRecipeStep(ctx, q.readDB, recipeID, recipeStep.ID)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "fetching recipe media for recipe step")
 	}
@@ -271,7 +271,7 @@ func (q *repository) getRecipeStepByID(ctx context.Context, querier database.SQL
 	}
 
 	// Fetch related data for this recipe step
-	ingredients, err := q.getRecipeStepIngredientsForRecipe(ctx, result.BelongsToRecipe)
+	ingredients, err := q.getRecipeStepIngredientsForRecipe(ctx, q.readDB, result.BelongsToRecipe)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "fetching recipe step ingredients for recipe step")
 	}
@@ -281,7 +281,7 @@ func (q *repository) getRecipeStepByID(ctx context.Context, querier database.SQL
 		}
 	}
 
-	products, err := q.getRecipeStepProductsForRecipe(ctx, result.BelongsToRecipe)
+	products, err := q.getRecipeStepProductsForRecipe(ctx, q.readDB, result.BelongsToRecipe)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "fetching recipe step products for recipe step")
 	}
@@ -291,7 +291,7 @@ func (q *repository) getRecipeStepByID(ctx context.Context, querier database.SQL
 		}
 	}
 
-	instruments, err := q.getRecipeStepInstrumentsForRecipe(ctx, result.BelongsToRecipe)
+	instruments, err := q.getRecipeStepInstrumentsForRecipe(ctx, q.readDB, result.BelongsToRecipe)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "fetching recipe step instruments for recipe step")
 	}
@@ -301,7 +301,7 @@ func (q *repository) getRecipeStepByID(ctx context.Context, querier database.SQL
 		}
 	}
 
-	vessels, err := q.getRecipeStepVesselsForRecipe(ctx, result.BelongsToRecipe)
+	vessels, err := q.getRecipeStepVesselsForRecipe(ctx, q.readDB, result.BelongsToRecipe)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "fetching recipe step vessels for recipe step")
 	}
@@ -311,7 +311,7 @@ func (q *repository) getRecipeStepByID(ctx context.Context, querier database.SQL
 		}
 	}
 
-	completionConditions, err := q.getRecipeStepCompletionConditionsForRecipe(ctx, result.BelongsToRecipe)
+	completionConditions, err := q.getRecipeStepCompletionConditionsForRecipe(ctx, q.readDB, result.BelongsToRecipe)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "fetching recipe step completion conditions for recipe step")
 	}
@@ -321,7 +321,7 @@ func (q *repository) getRecipeStepByID(ctx context.Context, querier database.SQL
 		}
 	}
 
-	recipeMedia, err := q.getRecipeMediaForRecipeStep(ctx, result.BelongsToRecipe, recipeStep.ID)
+	recipeMedia, err := q.getRecipeMediaForRecipeStep(ctx, q.readDB, result.BelongsToRecipe, recipeStep.ID)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "fetching recipe media for recipe step")
 	}
@@ -428,27 +428,27 @@ func (q *repository) GetRecipeSteps(ctx context.Context, recipeID string, filter
 	}
 
 	// Fetch all related data for all recipe steps
-	ingredients, err := q.getRecipeStepIngredientsForRecipe(ctx, recipeID)
+	ingredients, err := q.getRecipeStepIngredientsForRecipe(ctx, q.readDB, recipeID)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "fetching recipe step ingredients for recipe steps")
 	}
 
-	products, err := q.getRecipeStepProductsForRecipe(ctx, recipeID)
+	products, err := q.getRecipeStepProductsForRecipe(ctx, q.readDB, recipeID)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "fetching recipe step products for recipe steps")
 	}
 
-	instruments, err := q.getRecipeStepInstrumentsForRecipe(ctx, recipeID)
+	instruments, err := q.getRecipeStepInstrumentsForRecipe(ctx, q.readDB, recipeID)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "fetching recipe step instruments for recipe steps")
 	}
 
-	vessels, err := q.getRecipeStepVesselsForRecipe(ctx, recipeID)
+	vessels, err := q.getRecipeStepVesselsForRecipe(ctx, q.readDB, recipeID)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "fetching recipe step vessels for recipe steps")
 	}
 
-	completionConditions, err := q.getRecipeStepCompletionConditionsForRecipe(ctx, recipeID)
+	completionConditions, err := q.getRecipeStepCompletionConditionsForRecipe(ctx, q.readDB, recipeID)
 	if err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "fetching recipe step completion conditions for recipe steps")
 	}
@@ -485,7 +485,7 @@ func (q *repository) GetRecipeSteps(ctx context.Context, recipeID string, filter
 			}
 		}
 
-		recipeMedia, mediaErr := q.getRecipeMediaForRecipeStep(ctx, recipeID, step.ID)
+		recipeMedia, mediaErr := q.getRecipeMediaForRecipeStep(ctx, q.readDB, recipeID, step.ID)
 		if mediaErr != nil {
 			return nil, observability.PrepareAndLogError(mediaErr, logger, span, "fetching recipe media for recipe step")
 		}
@@ -612,7 +612,41 @@ func (q *repository) createRecipeStep(ctx context.Context, db database.SQLQueryE
 
 // CreateRecipeStep creates a recipe step in the database.
 func (q *repository) CreateRecipeStep(ctx context.Context, input *mealplanning.RecipeStepDatabaseCreationInput) (*mealplanning.RecipeStep, error) {
-	return q.createRecipeStep(ctx, q.writeDB, input)
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if input == nil {
+		return nil, platformerrors.ErrNilInputProvided
+	}
+	logger := q.logger.WithValue(mealplanningkeys.RecipeIDKey, input.BelongsToRecipe)
+	tracing.AttachToSpan(span, mealplanningkeys.RecipeIDKey, input.BelongsToRecipe)
+
+	tx, err := q.writeDB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "beginning transaction")
+	}
+
+	if err = q.lockRecipe(ctx, tx, input.BelongsToRecipe); err != nil {
+		q.RollbackTransaction(ctx, tx)
+		return nil, observability.PrepareAndLogError(err, logger, span, "locking recipe")
+	}
+
+	x, err := q.createRecipeStep(ctx, tx, input)
+	if err != nil {
+		q.RollbackTransaction(ctx, tx)
+		return nil, observability.PrepareAndLogError(err, logger, span, "creating recipe step")
+	}
+
+	if err = q.recordRecipeRevision(ctx, tx, input.BelongsToRecipe); err != nil {
+		q.RollbackTransaction(ctx, tx)
+		return nil, observability.PrepareAndLogError(err, logger, span, "recording recipe revision")
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "committing transaction")
+	}
+
+	return x, nil
 }
 
 // UpdateRecipeStep updates a particular recipe step.
@@ -626,7 +660,17 @@ func (q *repository) UpdateRecipeStep(ctx context.Context, updated *mealplanning
 	logger := q.logger.WithValue(mealplanningkeys.RecipeStepIDKey, updated.ID)
 	tracing.AttachToSpan(span, mealplanningkeys.RecipeStepIDKey, updated.ID)
 
-	if _, err := q.generatedQuerier.UpdateRecipeStep(ctx, q.writeDB, &generated.UpdateRecipeStepParams{
+	tx, err := q.writeDB.BeginTx(ctx, nil)
+	if err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "beginning transaction")
+	}
+
+	if err = q.lockRecipe(ctx, tx, updated.BelongsToRecipe); err != nil {
+		q.RollbackTransaction(ctx, tx)
+		return observability.PrepareAndLogError(err, logger, span, "locking recipe")
+	}
+
+	if _, err = q.generatedQuerier.UpdateRecipeStep(ctx, tx, &generated.UpdateRecipeStepParams{
 		ConditionExpression:           updated.ConditionExpression,
 		PreparationID:                 updated.Preparation.ID,
 		ID:                            updated.ID,
@@ -641,9 +685,19 @@ func (q *repository) UpdateRecipeStep(ctx context.Context, updated *mealplanning
 		Optional:                      updated.Optional,
 		StartTimerAutomatically:       updated.StartTimerAutomatically,
 	}); err != nil {
+		q.RollbackTransaction(ctx, tx)
 		return observability.PrepareAndLogError(err, logger, span, "updating recipe step")
 	}
 
+	if err = q.recordRecipeRevision(ctx, tx, updated.BelongsToRecipe); err != nil {
+		q.RollbackTransaction(ctx, tx)
+		return observability.PrepareAndLogError(err, logger, span, "recording recipe revision")
+	}
+
+	if err = tx.Commit(); err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "committing transaction")
+	}
+
 	return nil
 }
 
@@ -666,17 +720,38 @@ func (q *repository) ArchiveRecipeStep(ctx context.Context, recipeID, recipeStep
 	logger = logger.WithValue(mealplanningkeys.RecipeStepIDKey, recipeStepID)
 	tracing.AttachToSpan(span, mealplanningkeys.RecipeStepIDKey, recipeStepID)
 
-	rowsAffected, err := q.generatedQuerier.ArchiveRecipeStep(ctx, q.writeDB, &generated.ArchiveRecipeStepParams{
+	tx, err := q.writeDB.BeginTx(ctx, nil)
+	if err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "beginning transaction")
+	}
+
+	if err = q.lockRecipe(ctx, tx, recipeID); err != nil {
+		q.RollbackTransaction(ctx, tx)
+		return observability.PrepareAndLogError(err, logger, span, "locking recipe")
+	}
+
+	rowsAffected, err := q.generatedQuerier.ArchiveRecipeStep(ctx, tx, &generated.ArchiveRecipeStepParams{
 		BelongsToRecipe: recipeID,
 		ID:              recipeStepID,
 	})
 	if err != nil {
+		q.RollbackTransaction(ctx, tx)
 		return observability.PrepareAndLogError(err, logger, span, "updating recipe step")
 	}
 
 	if rowsAffected == 0 {
+		q.RollbackTransaction(ctx, tx)
 		return sql.ErrNoRows
 	}
 
+	if err = q.recordRecipeRevision(ctx, tx, recipeID); err != nil {
+		q.RollbackTransaction(ctx, tx)
+		return observability.PrepareAndLogError(err, logger, span, "recording recipe revision")
+	}
+
+	if err = tx.Commit(); err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "committing transaction")
+	}
+
 	return nil
 }
@@ -47,7 +47,7 @@ func (q *repository) RecipeExists(ctx context.Context, recipeID string) (exists
 
 // getRecipe fetches a recipe from the database.
 // visited is an optional set of recipe IDs already visited to prevent infinite recursion in circular dependencies.
-func (q *repository) getRecipe(ctx context.Context, recipeID string, visited ...map[string]bool) (*mealplanning.Recipe, error) {
+func (q *repository) getRecipe(ctx context.Context, db database.SQLQueryExecutor, recipeID string, visited ...map[string]bool) (*mealplanning.Recipe, error) {
 	ctx, span := q.tracer.StartSpan(ctx)
 	defer span.End()
 
@@ -77,7 +77,7 @@ func (q *repository) getRecipe(ctx context.Context, recipeID string, visited ...
 	seen[recipeID] = true
 
 	var x *mealplanning.Recipe
-	results, err := q.generatedQuerier.GetRecipeByID(ctx, q.readDB, recipeID)
+	results, err := q.generatedQuerier.GetRecipeByID(ctx, db, recipeID)
 	if err != nil {
 		return nil, observability.PrepareError(err, span, "fetching recipe")
 	}
@@ -157,7 +157,7 @@ func (q *repository) getRecipe(ctx context.Context, recipeID string, visited ...
 		return nil, sql.ErrNoRows
 	}
 
-	prepTasks, err := q.getRecipePrepTasksForRecipe(ctx, recipeID)
+	prepTasks, err := q.getRecipePrepTasksForRecipe(ctx, db, recipeID)
 	if err != nil {
 		return nil, observability.PrepareError(err, span, "fetching recipe step prep tasks for recipe")
 	}
@@ -165,7 +165,7 @@ func (q *repository) getRecipe(ctx context.Context, recipeID string, visited ...
 		x.PrepTasks = prepTasks
 	}
 
-	recipeMedia, err := q.getRecipeMediaForRecipe(ctx, recipeID)
+	recipeMedia, err := q.getRecipeMediaForRecipe(ctx, db, recipeID)
 	if err != nil {
 		return nil, observability.PrepareError(err, span, "fetching recipe step media for recipe")
 	}
@@ -173,27 +173,27 @@ func (q *repository) getRecipe(ctx context.Context, recipeID string, visited ...
 		x.Media = recipeMedia
 	}
 
-	ingredients, err := q.getRecipeStepIngredientsForRecipe(ctx, recipeID)
+	ingredients, err := q.getRecipeStepIngredientsForRecipe(ctx, db, recipeID)
 	if err != nil {
 		return nil, observability.PrepareError(err, span, "fetching recipe step ingredients for recipe")
 	}
 
-	products, err := q.getRecipeStepProductsForRecipe(ctx, recipeID)
+	products, err := q.getRecipeStepProductsForRecipe(ctx, db, recipeID)
 	if err != nil {
 		return nil, observability.PrepareError(err, span, "fetching recipe step products for recipe")
 	}
 
-	instruments, err := q.getRecipeStepInstrumentsForRecipe(ctx, recipeID)
+	instruments, err := q.getRecipeStepInstrumentsForRecipe(ctx, db, recipeID)
 	if err != nil {
 		return nil, observability.PrepareError(err, span, "fetching recipe step instruments for recipe")
 	}
 
-	vessels, err := q.getRecipeStepVesselsForRecipe(ctx, recipeID)
+	vessels, err := q.getRecipeStepVesselsForRecipe(ctx, db, recipeID)
 	if err != nil {
 		return nil, observability.PrepareError(err, span, "fetching recipe step vessels for recipe")
 	}
 
-	completionConditions, err := q.getRecipeStepCompletionConditionsForRecipe(ctx, recipeID)
+	completionConditions, err := q.getRecipeStepCompletionConditionsForRecipe(ctx, db, recipeID)
 	if err != nil {
 		return nil, observability.PrepareError(err, span, "fetching recipe step completion conditions for recipe")
 	}
@@ -229,7 +229,7 @@ func (q *repository) getRecipe(ctx context.Context, recipeID string, visited ...
 			}
 		}
 
-		recipeMedia, err = q.getRecipeMediaForRecipeStep(ctx, recipeID, step.ID)
+		recipeMedia, err = q.getRecipeMediaForRecipeStep(ctx, db, recipeID, step.ID)
 		if err != nil {
 			return nil, observability.PrepareError(err, span, "fetching recipe media for recipe step")
 		}
@@ -325,7 +325,7 @@ func (q *repository) getRecipe(ctx context.Context, recipeID string, visited ...
 				seenForFetch[id] = true
 			}
 		}
-		recipe, getErr := q.getRecipe(ctx, rID, seenForFetch)
+		recipe, getErr := q.getRecipe(ctx, db, rID, seenForFetch)
 		if getErr != nil {
 			return nil, observability.PrepareError(getErr, span, "fetching associated recipe")
 		}
@@ -398,7 +398,7 @@ func (q *repository) getRecipe(ctx context.Context, recipeID string, visited ...
 
 // GetRecipe fetches a recipe from the database.
 func (q *repository) GetRecipe(ctx context.Context, recipeID string) (*mealplanning.Recipe, error) {
-	return q.getRecipe(ctx, recipeID, nil)
+	return q.getRecipe(ctx, q.readDB, recipeID, nil)
 }
 
 // GetRecipes fetches a list of recipes from the database that meet a particular filter.
@@ -890,8 +890,8 @@ func(r *mealplanning.Recipe) string { return r.ID },
 	return x, nil
 }
 
-// validateAndPopulateRecipeInput validates bridge table IDs and populates derived fields.
-// This is a no-op if no bridge table IDs are present (backward compatible).
+// validateAndPopulateRecipeInput validates bridge table IDs and step conditions, and populates derived fields.
+// Bridge table validation is skipped if no bridge table IDs are present (backward compatible).
 func (q *repository) validateAndPopulateRecipeInput(ctx context.Context, input *mealplanning.RecipeDatabaseCreationInput) error {
 	ctx, span := q.tracer.StartSpan(ctx)
 	defer span.End()
@@ -902,8 +902,23 @@ func (q *repository) validateAndPopulateRecipeInput(ctx context.Context, input *
 	vpiIDs := input.GetAllValidPreparationInstrumentIDs()
 	vpvIDs := input.GetAllValidPreparationVesselIDs()
 
-	// Only proceed with validation if any bridge table IDs are present
+	// preparations are needed for every recipe, since they decide which steps must have a condition expression
+	preparationMap := map[string]*mealplanning.ValidPreparation{}
+	if preparationIDs := input.GetAllPreparationIDs(); len(preparationIDs) > 0 {
+		preparations, err := q.GetValidPreparationsWithIDs(ctx, preparationIDs)
+		if err != nil {
+			return observability.PrepareError(err, span, "fetching valid preparations")
+		}
+		for _, preparation := range preparations {
+			preparationMap[preparation.ID] = preparation
+		}
+	}
+
+	// Only proceed with bridge table validation if any bridge table IDs are present
 	if len(vipIDs) == 0 && len(vimuIDs) == 0 && len(vpiIDs) == 0 && len(vpvIDs) == 0 {
+		if err := recipevalidator.ValidateConditionExpressions(input, preparationMap); err != nil {
+			return observability.PrepareError(err, span, "validating recipe step conditions")
+		}
 		return nil
 	}
 
@@ -929,7 +944,7 @@ func (q *repository) validateAndPopulateRecipeInput(ctx context.Context, input *
 	}
 
 	// Create validator and validate/populate the input
-	validator := recipevalidator.NewRecipeValidator(vipMap, vimuMap, vpiMap, vpvMap)
+	validator := recipevalidator.NewRecipeValidator(vipMap, vimuMap, vpiMap, vpvMap, preparationMap)
 	if err = validator.ValidateAndPopulate(input); err != nil {
 		return observability.PrepareError(err, span, "validating recipe input")
 	}
@@ -1068,6 +1083,11 @@ func (q *repository) CreateRecipe(ctx context.Context, input *mealplanning.Recip
 		}
 	}
 
+	if err = q.recordRecipeRevision(ctx, tx, x.ID); err != nil {
+		q.RollbackTransaction(ctx, tx)
+		return nil, observability.PrepareAndLogError(err, logger, span, "recording recipe revision")
+	}
+
 	if err = tx.Commit(); err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "committing transaction")
 	}
@@ -1095,7 +1115,7 @@ func (q *repository) findCreatedRecipeStepProductsForIngredients(ctx context.Con
 					continue
 				}
 				// Look up the referenced recipe
-				referencedRecipe, err := q.getRecipe(ctx, *ingredient.RecipeStepProductRecipeID, nil)
+				referencedRecipe, err := q.getRecipe(ctx, q.readDB, *ingredient.RecipeStepProductRecipeID, nil)
 				if err != nil {
 					return fmt.Errorf("failed to get referenced recipe %s: %w", *ingredient.RecipeStepProductRecipeID, err)
 				}
@@ -1200,7 +1220,17 @@ func (q *repository) UpdateRecipe(ctx context.Context, updated *mealplanning.Rec
 	tracing.AttachToSpan(span, mealplanningkeys.RecipeIDKey, updated.ID)
 	tracing.AttachToSpan(span, identitykeys.UserIDKey, updated.CreatedByUser)
 
-	if _, err := q.generatedQuerier.UpdateRecipe(ctx, q.writeDB, &generated.UpdateRecipeParams{
+	tx, err := q.writeDB.BeginTx(ctx, nil)
+	if err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "beginning transaction")
+	}
+
+	if err = q.lockRecipe(ctx, tx, updated.ID); err != nil {
+		q.RollbackTransaction(ctx, tx)
+		return observability.PrepareAndLogError(err, logger, span, "locking recipe")
+	}
+
+	if _, err = q.generatedQuerier.UpdateRecipe(ctx, tx, &generated.UpdateRecipeParams{
 		Name:                 updated.Name,
 		Slug:                 updated.Slug,
 		Source:               updated.Source,
@@ -1216,9 +1246,19 @@ func (q *repository) UpdateRecipe(ctx context.Context, updated *mealplanning.Rec
 		CreatedByUser:        updated.CreatedByUser,
 		ID:                   updated.ID,
 	}); err != nil {
+		q.RollbackTransaction(ctx, tx)
 		return observability.PrepareAndLogError(err, logger, span, "updating recipe")
 	}
 
+	if err = q.recordRecipeRevision(ctx, tx, updated.ID); err != nil {
+		q.RollbackTransaction(ctx, tx)
+		return observability.PrepareAndLogError(err, logger, span, "recording recipe revision")
+	}
+
+	if err = tx.Commit(); err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "committing transaction")
+	}
+
 	logger.Info("recipe updated")
 
 	return nil
@@ -1314,7 +1354,7 @@ func (q *repository) checkForCircularDependency(ctx context.Context, recipeID st
 			dependenciesToCheck = newDependencies
 		} else {
 			// For other recipes, fetch their current dependencies
-			recipe, err := q.getRecipe(ctx, currentRecipeID, nil)
+			recipe, err := q.getRecipe(ctx, q.readDB, currentRecipeID, nil)
 			if err != nil {
 				// If recipe doesn't exist or can't be fetched, skip it
 				// This allows validation to work even if some referenced recipes are missing
@@ -1370,7 +1410,7 @@ func (q *repository) validateNoCircularDependencyForIngredient(ctx context.Conte
 	}
 
 	// Get current recipe to find its existing dependencies
-	currentRecipe, err := q.getRecipe(ctx, recipeID, nil)
+	currentRecipe, err := q.getRecipe(ctx, q.readDB, recipeID, nil)
 	if err != nil {
 		return fmt.Errorf("fetching current recipe: %w", err)
 	}
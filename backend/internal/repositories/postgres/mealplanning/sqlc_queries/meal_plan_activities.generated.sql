@@ -0,0 +1,44 @@
+-- name: LockMealPlanActivityFeed :exec
+SELECT pg_advisory_xact_lock(hashtext('meal_plan_activities'), hashtext(sqlc.arg(belongs_to_meal_plan)::text));
+
+-- name: CreateMealPlanActivity :exec
+INSERT INTO meal_plan_activities (
+	id,
+	event_type,
+	meal_plan_event_id,
+	meal_plan_option_id,
+	meal_plan_option_vote_id,
+	meal_plan_task_id,
+	by_user,
+	belongs_to_meal_plan
+) VALUES (
+	sqlc.arg(id),
+	sqlc.arg(event_type),
+	sqlc.arg(meal_plan_event_id),
+	sqlc.arg(meal_plan_option_id),
+	sqlc.arg(meal_plan_option_vote_id),
+	sqlc.arg(meal_plan_task_id),
+	sqlc.arg(by_user),
+	sqlc.arg(belongs_to_meal_plan)
+);
+
+-- name: GetMealPlanActivitiesAfterCursor :many
+SELECT
+	meal_plan_activities.id,
+	meal_plan_activities.event_type,
+	meal_plan_activities.meal_plan_event_id,
+	meal_plan_activities.meal_plan_option_id,
+	meal_plan_activities.meal_plan_option_vote_id,
+	meal_plan_activities.meal_plan_task_id,
+	meal_plan_activities.by_user,
+	meal_plan_activities.created_at,
+	meal_plan_activities.belongs_to_meal_plan,
+	meal_plan_activities.sequence
+FROM meal_plan_activities
+WHERE meal_plan_activities.belongs_to_meal_plan = sqlc.arg(meal_plan_id)
+	AND meal_plan_activities.sequence > sqlc.arg(after_sequence)
+ORDER BY meal_plan_activities.sequence ASC
+LIMIT sqlc.arg(result_limit);
+
+-- name: NotifyMealPlanActivity :exec
+SELECT pg_notify('meal_plan_activity', sqlc.arg(belongs_to_meal_plan));
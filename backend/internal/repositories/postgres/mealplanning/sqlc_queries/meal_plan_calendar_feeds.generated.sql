@@ -0,0 +1,37 @@
+-- name: CreateMealPlanCalendarFeed :exec
+INSERT INTO meal_plan_calendar_feeds (
+	id,
+	token_hash,
+	belongs_to_user,
+	belongs_to_account
+) VALUES (
+	sqlc.arg(id),
+	sqlc.arg(token_hash),
+	sqlc.arg(belongs_to_user),
+	sqlc.arg(belongs_to_account)
+);
+
+-- name: GetMealPlanCalendarFeedByTokenHash :one
+SELECT
+	meal_plan_calendar_feeds.id,
+	meal_plan_calendar_feeds.token_hash,
+	meal_plan_calendar_feeds.belongs_to_user,
+	meal_plan_calendar_feeds.belongs_to_account,
+	meal_plan_calendar_feeds.created_at,
+	meal_plan_calendar_feeds.last_accessed_at,
+	meal_plan_calendar_feeds.archived_at
+FROM meal_plan_calendar_feeds
+WHERE meal_plan_calendar_feeds.token_hash = sqlc.arg(token_hash)
+	AND meal_plan_calendar_feeds.archived_at IS NULL;
+
+-- name: ArchiveMealPlanCalendarFeedsForUser :execrows
+UPDATE meal_plan_calendar_feeds SET
+	archived_at = NOW()
+WHERE meal_plan_calendar_feeds.belongs_to_user = sqlc.arg(belongs_to_user)
+	AND meal_plan_calendar_feeds.belongs_to_account = sqlc.arg(belongs_to_account)
+	AND meal_plan_calendar_feeds.archived_at IS NULL;
+
+-- name: MarkMealPlanCalendarFeedAsAccessed :exec
+UPDATE meal_plan_calendar_feeds SET
+	last_accessed_at = NOW()
+WHERE meal_plan_calendar_feeds.id = sqlc.arg(id);
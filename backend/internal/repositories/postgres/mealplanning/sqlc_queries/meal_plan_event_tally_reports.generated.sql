@@ -0,0 +1,38 @@
+-- name: CreateMealPlanEventTallyReport :exec
+INSERT INTO meal_plan_event_tally_reports (
+	id,
+	belongs_to_meal_plan_event,
+	election_method,
+	winner,
+	tie_broken,
+	ballot_count,
+	details
+) VALUES (
+	sqlc.arg(id),
+	sqlc.arg(belongs_to_meal_plan_event),
+	sqlc.arg(election_method),
+	sqlc.arg(winner),
+	sqlc.arg(tie_broken),
+	sqlc.arg(ballot_count),
+	sqlc.arg(details)
+);
+
+-- name: GetMealPlanEventTallyReport :one
+SELECT
+	meal_plan_event_tally_reports.id,
+	meal_plan_event_tally_reports.belongs_to_meal_plan_event,
+	meal_plan_event_tally_reports.election_method,
+	meal_plan_event_tally_reports.winner,
+	meal_plan_event_tally_reports.tie_broken,
+	meal_plan_event_tally_reports.ballot_count,
+	meal_plan_event_tally_reports.details,
+	meal_plan_event_tally_reports.created_at,
+	meal_plan_event_tally_reports.archived_at
+FROM meal_plan_event_tally_reports
+	JOIN meal_plan_events ON meal_plan_event_tally_reports.belongs_to_meal_plan_event = meal_plan_events.id
+WHERE meal_plan_event_tally_reports.archived_at IS NULL
+	AND meal_plan_events.archived_at IS NULL
+	AND meal_plan_events.belongs_to_meal_plan = sqlc.arg(meal_plan_id)
+	AND meal_plan_event_tally_reports.belongs_to_meal_plan_event = sqlc.arg(meal_plan_event_id)
+ORDER BY meal_plan_event_tally_reports.created_at DESC
+LIMIT 1;
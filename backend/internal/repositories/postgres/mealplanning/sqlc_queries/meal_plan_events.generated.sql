@@ -18,6 +18,15 @@ INSERT INTO meal_plan_events (
 	sqlc.arg(belongs_to_meal_plan)
 );
 
+-- name: GetAccountIDForMealPlanEvent :one
+SELECT
+	meal_plans.belongs_to_account
+FROM meal_plan_events
+	JOIN meal_plans ON meal_plan_events.belongs_to_meal_plan = meal_plans.id
+WHERE meal_plan_events.archived_at IS NULL
+	AND meal_plans.archived_at IS NULL
+	AND meal_plan_events.id = sqlc.arg(id);
+
 -- name: MealPlanEventIsEligibleForVoting :one
 SELECT EXISTS (
 	SELECT meal_plan_events.id
@@ -5,6 +5,7 @@ UPDATE meal_plan_option_votes SET archived_at = NOW() WHERE archived_at IS NULL
 INSERT INTO meal_plan_option_votes (
 	id,
 	rank,
+	score,
 	abstain,
 	notes,
 	by_user,
@@ -12,6 +13,7 @@ INSERT INTO meal_plan_option_votes (
 ) VALUES (
 	sqlc.arg(id),
 	sqlc.arg(rank),
+	sqlc.arg(score),
 	sqlc.arg(abstain),
 	sqlc.arg(notes),
 	sqlc.arg(by_user),
@@ -41,6 +43,7 @@ SELECT EXISTS (
 SELECT
 	meal_plan_option_votes.id,
 	meal_plan_option_votes.rank,
+	meal_plan_option_votes.score,
 	meal_plan_option_votes.abstain,
 	meal_plan_option_votes.notes,
 	meal_plan_option_votes.by_user,
@@ -67,6 +70,7 @@ WHERE meal_plan_option_votes.archived_at IS NULL
 SELECT
 	meal_plan_option_votes.id,
 	meal_plan_option_votes.rank,
+	meal_plan_option_votes.score,
 	meal_plan_option_votes.abstain,
 	meal_plan_option_votes.notes,
 	meal_plan_option_votes.by_user,
@@ -134,6 +138,7 @@ LIMIT COALESCE(sqlc.narg(result_limit), 50);
 SELECT
 	meal_plan_option_votes.id,
 	meal_plan_option_votes.rank,
+	meal_plan_option_votes.score,
 	meal_plan_option_votes.abstain,
 	meal_plan_option_votes.notes,
 	meal_plan_option_votes.by_user,
@@ -159,6 +164,7 @@ WHERE meal_plan_option_votes.archived_at IS NULL
 -- name: UpdateMealPlanOptionVote :execrows
 UPDATE meal_plan_option_votes SET
 	rank = sqlc.arg(rank),
+	score = sqlc.arg(score),
 	abstain = sqlc.arg(abstain),
 	notes = sqlc.arg(notes),
 	by_user = sqlc.arg(by_user),
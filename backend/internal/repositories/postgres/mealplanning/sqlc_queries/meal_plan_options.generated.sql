@@ -51,6 +51,16 @@ SELECT EXISTS (
 		AND meal_plans.id = sqlc.arg(meal_plan_id)
 );
 
+-- name: CompleteMealPlanOption :execrows
+UPDATE meal_plan_options SET
+	completed_at = NOW(),
+	last_updated_at = NOW()
+WHERE archived_at IS NULL
+	AND completed_at IS NULL
+	AND chosen
+	AND belongs_to_meal_plan_event = sqlc.arg(meal_plan_event_id)
+	AND id = sqlc.arg(meal_plan_option_id);
+
 -- name: FinalizeMealPlanOption :exec
 UPDATE meal_plan_options SET
 	chosen = (belongs_to_meal_plan_event = sqlc.arg(meal_plan_event_id) AND id = sqlc.arg(id)),
@@ -73,6 +83,7 @@ SELECT
 	meal_plan_options.last_updated_at,
 	meal_plan_options.archived_at,
 	meal_plan_options.belongs_to_meal_plan_event,
+	meal_plan_options.completed_at,
 	meals.id as meal_id,
 	meals.name as meal_name,
 	meals.description as meal_description,
@@ -96,6 +107,17 @@ WHERE
 	AND meal_plans.archived_at IS NULL
 	AND meal_plans.id = sqlc.arg(meal_plan_id);
 
+-- name: GetMealPlanOptionAllergenPolicyForAccount :one
+SELECT
+	COALESCE(service_setting_configurations.value, service_settings.default_value)::TEXT AS policy
+FROM service_settings
+	LEFT JOIN service_setting_configurations ON service_setting_configurations.service_setting_id = service_settings.id
+		AND service_setting_configurations.belongs_to_account = sqlc.arg(belongs_to_account)
+		AND service_setting_configurations.archived_at IS NULL
+WHERE service_settings.archived_at IS NULL
+	AND service_settings.name = 'meal_plan_option_allergen_policy'
+LIMIT 1;
+
 -- name: GetMealPlanOptions :many
 SELECT
 	meal_plan_options.id,
@@ -110,6 +132,7 @@ SELECT
 	meal_plan_options.last_updated_at,
 	meal_plan_options.archived_at,
 	meal_plan_options.belongs_to_meal_plan_event,
+	meal_plan_options.completed_at,
 	meals.id as meal_id,
 	meals.name as meal_name,
 	meals.description as meal_description,
@@ -184,6 +207,7 @@ SELECT
 	meal_plan_options.last_updated_at,
 	meal_plan_options.archived_at,
 	meal_plan_options.belongs_to_meal_plan_event,
+	meal_plan_options.completed_at,
 	meals.id as meal_id,
 	meals.name as meal_name,
 	meals.description as meal_description,
@@ -221,6 +245,7 @@ SELECT
 	meal_plan_options.last_updated_at,
 	meal_plan_options.archived_at,
 	meal_plan_options.belongs_to_meal_plan_event,
+	meal_plan_options.completed_at,
 	meals.id as meal_id,
 	meals.name as meal_name,
 	meals.description as meal_description,
@@ -52,6 +52,7 @@ SELECT
 	meal_plan_options.last_updated_at as meal_plan_option_last_updated_at,
 	meal_plan_options.archived_at as meal_plan_option_archived_at,
 	meal_plan_options.belongs_to_meal_plan_event as meal_plan_option_belongs_to_meal_plan_event,
+	meal_plan_options.completed_at as meal_plan_option_completed_at,
 	recipe_prep_tasks.id as prep_task_id,
 	recipe_prep_tasks.name as prep_task_name,
 	recipe_prep_tasks.description as prep_task_description,
@@ -111,6 +112,7 @@ SELECT
 	meal_plan_options.last_updated_at as meal_plan_option_last_updated_at,
 	meal_plan_options.archived_at as meal_plan_option_archived_at,
 	meal_plan_options.belongs_to_meal_plan_event as meal_plan_option_belongs_to_meal_plan_event,
+	meal_plan_options.completed_at as meal_plan_option_completed_at,
 	recipe_prep_tasks.id as prep_task_id,
 	recipe_prep_tasks.name as prep_task_name,
 	recipe_prep_tasks.description as prep_task_description,
@@ -170,6 +172,7 @@ SELECT
 	meal_plan_options.last_updated_at as meal_plan_option_last_updated_at,
 	meal_plan_options.archived_at as meal_plan_option_archived_at,
 	meal_plan_options.belongs_to_meal_plan_event as meal_plan_option_belongs_to_meal_plan_event,
+	meal_plan_options.completed_at as meal_plan_option_completed_at,
 	recipe_steps.id as recipe_step_id,
 	recipe_steps.index as recipe_step_index,
 	valid_preparations.id as valid_preparation_id,
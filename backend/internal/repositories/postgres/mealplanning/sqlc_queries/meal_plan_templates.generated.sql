@@ -0,0 +1,185 @@
+-- name: ArchiveMealPlanTemplate :execrows
+UPDATE meal_plan_templates SET archived_at = NOW() WHERE archived_at IS NULL AND belongs_to_account = sqlc.arg(belongs_to_account) AND id = sqlc.arg(id);
+
+-- name: CreateMealPlanTemplate :exec
+INSERT INTO meal_plan_templates (
+	id,
+	name,
+	notes,
+	election_method,
+	recurrence_rule,
+	timezone,
+	voting_deadline_offset_in_seconds,
+	candidates_per_slot,
+	avoid_meals_from_last_plans,
+	slots,
+	next_instantiation_at,
+	belongs_to_account,
+	created_by_user
+) VALUES (
+	sqlc.arg(id),
+	sqlc.arg(name),
+	sqlc.arg(notes),
+	sqlc.arg(election_method),
+	sqlc.arg(recurrence_rule),
+	sqlc.arg(timezone),
+	sqlc.arg(voting_deadline_offset_in_seconds),
+	sqlc.arg(candidates_per_slot),
+	sqlc.arg(avoid_meals_from_last_plans),
+	sqlc.arg(slots),
+	sqlc.arg(next_instantiation_at),
+	sqlc.arg(belongs_to_account),
+	sqlc.arg(created_by_user)
+);
+
+-- name: GetMealPlanTemplate :one
+SELECT
+	meal_plan_templates.id,
+	meal_plan_templates.name,
+	meal_plan_templates.notes,
+	meal_plan_templates.election_method,
+	meal_plan_templates.recurrence_rule,
+	meal_plan_templates.timezone,
+	meal_plan_templates.voting_deadline_offset_in_seconds,
+	meal_plan_templates.candidates_per_slot,
+	meal_plan_templates.avoid_meals_from_last_plans,
+	meal_plan_templates.slots,
+	meal_plan_templates.next_instantiation_at,
+	meal_plan_templates.last_instantiated_at,
+	meal_plan_templates.instantiation_claimed_until,
+	meal_plan_templates.created_at,
+	meal_plan_templates.last_updated_at,
+	meal_plan_templates.archived_at,
+	meal_plan_templates.belongs_to_account,
+	meal_plan_templates.created_by_user
+FROM meal_plan_templates
+WHERE meal_plan_templates.archived_at IS NULL
+	AND meal_plan_templates.id = sqlc.arg(id)
+	AND meal_plan_templates.belongs_to_account = sqlc.arg(belongs_to_account);
+
+-- name: GetMealPlanTemplatesForAccount :many
+SELECT
+	meal_plan_templates.id,
+	meal_plan_templates.name,
+	meal_plan_templates.notes,
+	meal_plan_templates.election_method,
+	meal_plan_templates.recurrence_rule,
+	meal_plan_templates.timezone,
+	meal_plan_templates.voting_deadline_offset_in_seconds,
+	meal_plan_templates.candidates_per_slot,
+	meal_plan_templates.avoid_meals_from_last_plans,
+	meal_plan_templates.slots,
+	meal_plan_templates.next_instantiation_at,
+	meal_plan_templates.last_instantiated_at,
+	meal_plan_templates.instantiation_claimed_until,
+	meal_plan_templates.created_at,
+	meal_plan_templates.last_updated_at,
+	meal_plan_templates.archived_at,
+	meal_plan_templates.belongs_to_account,
+	meal_plan_templates.created_by_user,
+	(
+		SELECT COUNT(meal_plan_templates.id)
+		FROM meal_plan_templates
+		WHERE meal_plan_templates.archived_at IS NULL
+			AND
+			meal_plan_templates.created_at > COALESCE(sqlc.narg(created_after), (SELECT NOW() - '999 years'::INTERVAL))
+			AND meal_plan_templates.created_at < COALESCE(sqlc.narg(created_before), (SELECT NOW() + '999 years'::INTERVAL))
+			AND (
+				meal_plan_templates.last_updated_at IS NULL
+				OR meal_plan_templates.last_updated_at > COALESCE(sqlc.narg(updated_before), (SELECT NOW() - '999 years'::INTERVAL))
+			)
+			AND (
+				meal_plan_templates.last_updated_at IS NULL
+				OR meal_plan_templates.last_updated_at < COALESCE(sqlc.narg(updated_after), (SELECT NOW() + '999 years'::INTERVAL))
+			)
+			AND (NOT COALESCE(sqlc.narg(include_archived), false)::boolean OR meal_plan_templates.archived_at = NULL)
+			AND meal_plan_templates.belongs_to_account = sqlc.arg(belongs_to_account)
+	) AS filtered_count,
+	(
+		SELECT COUNT(meal_plan_templates.id)
+		FROM meal_plan_templates
+		WHERE meal_plan_templates.archived_at IS NULL
+			AND meal_plan_templates.belongs_to_account = sqlc.arg(belongs_to_account)
+	) AS total_count
+FROM meal_plan_templates
+WHERE meal_plan_templates.archived_at IS NULL
+	AND meal_plan_templates.created_at > COALESCE(sqlc.narg(created_after), (SELECT NOW() - '999 years'::INTERVAL))
+	AND meal_plan_templates.created_at < COALESCE(sqlc.narg(created_before), (SELECT NOW() + '999 years'::INTERVAL))
+	AND (
+		meal_plan_templates.last_updated_at IS NULL
+		OR meal_plan_templates.last_updated_at > COALESCE(sqlc.narg(updated_after), (SELECT NOW() - '999 years'::INTERVAL))
+	)
+	AND (
+		meal_plan_templates.last_updated_at IS NULL
+		OR meal_plan_templates.last_updated_at < COALESCE(sqlc.narg(updated_before), (SELECT NOW() + '999 years'::INTERVAL))
+	)
+			AND (NOT COALESCE(sqlc.narg(include_archived), false)::boolean OR meal_plan_templates.archived_at = NULL)
+	AND meal_plan_templates.belongs_to_account = sqlc.arg(belongs_to_account)
+	AND meal_plan_templates.id > COALESCE(sqlc.narg(cursor), '')
+ORDER BY meal_plan_templates.id ASC
+LIMIT COALESCE(sqlc.narg(result_limit), 50);
+
+-- name: GetMealPlanTemplatesDueForInstantiation :many
+SELECT
+	meal_plan_templates.id,
+	meal_plan_templates.name,
+	meal_plan_templates.notes,
+	meal_plan_templates.election_method,
+	meal_plan_templates.recurrence_rule,
+	meal_plan_templates.timezone,
+	meal_plan_templates.voting_deadline_offset_in_seconds,
+	meal_plan_templates.candidates_per_slot,
+	meal_plan_templates.avoid_meals_from_last_plans,
+	meal_plan_templates.slots,
+	meal_plan_templates.next_instantiation_at,
+	meal_plan_templates.last_instantiated_at,
+	meal_plan_templates.instantiation_claimed_until,
+	meal_plan_templates.created_at,
+	meal_plan_templates.last_updated_at,
+	meal_plan_templates.archived_at,
+	meal_plan_templates.belongs_to_account,
+	meal_plan_templates.created_by_user
+FROM meal_plan_templates
+WHERE meal_plan_templates.archived_at IS NULL
+	AND meal_plan_templates.next_instantiation_at <= sqlc.arg(as_of)
+ORDER BY meal_plan_templates.next_instantiation_at;
+
+-- name: ClaimMealPlanTemplateInstantiation :execrows
+UPDATE meal_plan_templates SET
+	instantiation_claimed_until = sqlc.arg(claimed_until)
+WHERE archived_at IS NULL
+	AND id = sqlc.arg(id)
+	AND next_instantiation_at = sqlc.arg(scheduled_at)
+	AND (instantiation_claimed_until IS NULL OR instantiation_claimed_until < NOW());
+
+-- name: ReleaseMealPlanTemplateInstantiationClaim :exec
+UPDATE meal_plan_templates SET
+	instantiation_claimed_until = NULL
+WHERE archived_at IS NULL
+	AND id = sqlc.arg(id);
+
+-- name: MarkMealPlanTemplateAsInstantiated :execrows
+UPDATE meal_plan_templates SET
+	last_instantiated_at = sqlc.arg(last_instantiated_at),
+	next_instantiation_at = sqlc.arg(next_instantiation_at),
+	instantiation_claimed_until = NULL
+WHERE archived_at IS NULL
+	AND id = sqlc.arg(id)
+	AND next_instantiation_at = sqlc.arg(scheduled_at);
+
+-- name: UpdateMealPlanTemplate :execrows
+UPDATE meal_plan_templates SET
+	name = sqlc.arg(name),
+	notes = sqlc.arg(notes),
+	election_method = sqlc.arg(election_method),
+	recurrence_rule = sqlc.arg(recurrence_rule),
+	timezone = sqlc.arg(timezone),
+	voting_deadline_offset_in_seconds = sqlc.arg(voting_deadline_offset_in_seconds),
+	candidates_per_slot = sqlc.arg(candidates_per_slot),
+	avoid_meals_from_last_plans = sqlc.arg(avoid_meals_from_last_plans),
+	slots = sqlc.arg(slots),
+	next_instantiation_at = sqlc.arg(next_instantiation_at),
+	last_updated_at = NOW()
+WHERE archived_at IS NULL
+	AND belongs_to_account = sqlc.arg(belongs_to_account)
+	AND id = sqlc.arg(id);
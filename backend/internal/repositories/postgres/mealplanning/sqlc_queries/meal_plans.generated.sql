@@ -51,6 +51,28 @@ WHERE meal_plans.archived_at IS NULL
 GROUP BY meal_plans.id
 ORDER BY meal_plans.id;
 
+-- name: GetMealPlansWithApproachingVotingDeadlines :many
+SELECT
+	meal_plans.id,
+	meal_plans.notes,
+	meal_plans.status,
+	meal_plans.voting_deadline,
+	meal_plans.grocery_list_initialized,
+	meal_plans.tasks_created,
+	meal_plans.election_method,
+	meal_plans.created_at,
+	meal_plans.last_updated_at,
+	meal_plans.archived_at,
+	meal_plans.belongs_to_account,
+	meal_plans.created_by_user
+FROM meal_plans
+WHERE meal_plans.archived_at IS NULL
+	AND meal_plans.status = 'awaiting_votes'
+	AND meal_plans.voting_deadline_reminder_sent_at IS NULL
+	AND voting_deadline > NOW()
+	AND voting_deadline < (NOW() + interval '2 hours')
+ORDER BY meal_plans.id;
+
 -- name: GetFinalizedMealPlansForPlanning :many
 SELECT
 	meal_plans.id as meal_plan_id,
@@ -106,6 +128,12 @@ WHERE meal_plans.archived_at IS NULL
   AND meal_plans.id = sqlc.arg(id)
   AND meal_plans.belongs_to_account = sqlc.arg(belongs_to_account);
 
+-- name: GetAccountIDForMealPlan :one
+SELECT meal_plans.belongs_to_account
+FROM meal_plans
+WHERE meal_plans.archived_at IS NULL
+	AND meal_plans.id = sqlc.arg(id);
+
 -- name: GetMealPlansForAccount :many
 SELECT
 	meal_plans.id,
@@ -162,6 +190,30 @@ WHERE meal_plans.archived_at IS NULL
 ORDER BY meal_plans.id ASC
 LIMIT COALESCE(sqlc.narg(result_limit), 50);
 
+-- name: GetMealPlanCountForAccountSince :one
+SELECT COUNT(meal_plans.id)
+FROM meal_plans
+WHERE meal_plans.archived_at IS NULL
+	AND meal_plans.belongs_to_account = sqlc.arg(belongs_to_account)
+	AND meal_plans.created_at >= sqlc.arg(since);
+
+-- name: GetRecentlyChosenMealIDsForAccount :many
+SELECT DISTINCT meal_plan_options.meal_id
+FROM meal_plan_options
+	JOIN meal_plan_events ON meal_plan_options.belongs_to_meal_plan_event = meal_plan_events.id
+WHERE meal_plan_options.archived_at IS NULL
+	AND meal_plan_options.chosen IS TRUE
+	AND meal_plan_events.archived_at IS NULL
+	AND meal_plan_events.belongs_to_meal_plan IN (
+		SELECT meal_plans.id
+		FROM meal_plans
+		WHERE meal_plans.archived_at IS NULL
+			AND meal_plans.belongs_to_account = sqlc.arg(belongs_to_account)
+			AND meal_plans.status = 'finalized'
+		ORDER BY meal_plans.voting_deadline DESC
+		LIMIT sqlc.arg(meal_plan_count)
+	);
+
 -- name: GetMealPlanPastVotingDeadline :one
 SELECT
 	meal_plans.id,
@@ -220,6 +272,12 @@ UPDATE meal_plans SET
 WHERE archived_at IS NULL
 	AND id = sqlc.arg(id);
 
+-- name: MarkMealPlanVotingDeadlineReminderSent :exec
+UPDATE meal_plans SET
+	voting_deadline_reminder_sent_at = NOW()
+WHERE archived_at IS NULL
+	AND id = sqlc.arg(id);
+
 -- name: UpdateMealPlan :execrows
 UPDATE meal_plans SET
 	notes = sqlc.arg(notes),
@@ -168,6 +168,20 @@ FROM recipe_ratings
 WHERE recipe_ratings.archived_at IS NULL
 	AND recipe_ratings.id = sqlc.arg(id);
 
+-- name: GetRecipeRatingSummariesForAccount :many
+SELECT
+	recipe_ratings.belongs_to_recipe AS recipe_id,
+	AVG(recipe_ratings.overall)::REAL AS average_overall,
+	COUNT(recipe_ratings.id) AS rating_count
+FROM recipe_ratings
+	JOIN account_user_memberships ON account_user_memberships.belongs_to_user = recipe_ratings.created_by_user
+WHERE recipe_ratings.archived_at IS NULL
+	AND account_user_memberships.archived_at IS NULL
+	AND account_user_memberships.belongs_to_account = sqlc.arg(belongs_to_account)
+	AND recipe_ratings.belongs_to_recipe = ANY(sqlc.arg(recipe_ids)::text[])
+GROUP BY recipe_ratings.belongs_to_recipe
+ORDER BY recipe_ratings.belongs_to_recipe;
+
 -- name: UpdateRecipeRating :execrows
 UPDATE recipe_ratings SET
 	belongs_to_recipe = sqlc.arg(belongs_to_recipe),
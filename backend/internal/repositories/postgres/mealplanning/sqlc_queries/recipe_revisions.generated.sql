@@ -0,0 +1,84 @@
+-- name: CreateRecipeRevision :one
+INSERT INTO recipe_revisions (
+	id,
+	belongs_to_recipe,
+	revision_number,
+	snapshot,
+	created_by_user
+)
+SELECT
+	sqlc.arg(id)::TEXT,
+	sqlc.arg(belongs_to_recipe)::TEXT,
+	COALESCE(MAX(recipe_revisions.revision_number), 0) + 1,
+	sqlc.arg(snapshot)::JSONB,
+	sqlc.narg(created_by_user)::TEXT
+FROM recipe_revisions
+WHERE recipe_revisions.belongs_to_recipe = sqlc.arg(belongs_to_recipe)
+RETURNING *;
+
+-- name: GetRecipeRevision :one
+SELECT
+	recipe_revisions.id,
+	recipe_revisions.belongs_to_recipe,
+	recipe_revisions.revision_number,
+	recipe_revisions.snapshot,
+	recipe_revisions.created_by_user,
+	recipe_revisions.created_at
+FROM recipe_revisions
+WHERE recipe_revisions.belongs_to_recipe = sqlc.arg(belongs_to_recipe)
+	AND recipe_revisions.revision_number = sqlc.arg(revision_number);
+
+-- name: GetRecipeRevisionsForRecipe :many
+SELECT
+	recipe_revisions.id,
+	recipe_revisions.belongs_to_recipe,
+	recipe_revisions.revision_number,
+	recipe_revisions.created_by_user,
+	recipe_revisions.created_at,
+	(
+		SELECT COUNT(recipe_revisions.id)
+		FROM recipe_revisions
+		WHERE
+			recipe_revisions.created_at > COALESCE(sqlc.narg(created_after), (SELECT NOW() - '999 years'::INTERVAL))
+			AND recipe_revisions.created_at < COALESCE(sqlc.narg(created_before), (SELECT NOW() + '999 years'::INTERVAL))
+			AND recipe_revisions.belongs_to_recipe = sqlc.arg(belongs_to_recipe)
+	) AS filtered_count,
+	(
+		SELECT COUNT(recipe_revisions.id)
+		FROM recipe_revisions
+		WHERE
+			recipe_revisions.belongs_to_recipe = sqlc.arg(belongs_to_recipe)
+	) AS total_count
+FROM recipe_revisions
+WHERE recipe_revisions.belongs_to_recipe = sqlc.arg(belongs_to_recipe)
+	AND recipe_revisions.created_at > COALESCE(sqlc.narg(created_after), (SELECT NOW() - '999 years'::INTERVAL))
+	AND recipe_revisions.created_at < COALESCE(sqlc.narg(created_before), (SELECT NOW() + '999 years'::INTERVAL))
+	AND recipe_revisions.id > COALESCE(sqlc.narg(cursor), '')
+ORDER BY recipe_revisions.id ASC
+LIMIT COALESCE(sqlc.narg(result_limit), 50);
+
+-- name: GetRecipeRevisionsForMealPlanOption :many
+SELECT
+	recipe_revisions.belongs_to_recipe AS recipe_id,
+	recipe_revisions.id,
+	recipe_revisions.revision_number,
+	recipe_revisions.snapshot
+FROM meal_plan_option_recipe_revisions
+	JOIN recipe_revisions ON meal_plan_option_recipe_revisions.belongs_to_recipe_revision = recipe_revisions.id
+WHERE meal_plan_option_recipe_revisions.belongs_to_meal_plan_option = sqlc.arg(belongs_to_meal_plan_option)
+ORDER BY recipe_revisions.belongs_to_recipe;
+
+-- name: PinLatestRecipeRevisionsForMealPlanOption :exec
+INSERT INTO meal_plan_option_recipe_revisions (
+	belongs_to_meal_plan_option,
+	belongs_to_recipe_revision
+)
+SELECT DISTINCT ON (recipe_revisions.belongs_to_recipe)
+	sqlc.arg(belongs_to_meal_plan_option)::TEXT,
+	recipe_revisions.id
+FROM recipe_revisions
+	JOIN meal_components ON meal_components.recipe_id = recipe_revisions.belongs_to_recipe
+WHERE meal_components.belongs_to_meal = sqlc.arg(belongs_to_meal)
+	AND meal_components.archived_at IS NULL
+ORDER BY recipe_revisions.belongs_to_recipe, recipe_revisions.revision_number DESC
+ON CONFLICT DO NOTHING;
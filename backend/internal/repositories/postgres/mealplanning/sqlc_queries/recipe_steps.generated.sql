@@ -1,6 +1,9 @@
 -- name: ArchiveRecipeStep :execrows
 UPDATE recipe_steps SET archived_at = NOW() WHERE archived_at IS NULL AND belongs_to_recipe = sqlc.arg(belongs_to_recipe) AND id = sqlc.arg(id);
 
+-- name: ArchiveRecipeStepsForRecipe :exec
+UPDATE recipe_steps SET archived_at = NOW() WHERE archived_at IS NULL AND belongs_to_recipe = sqlc.arg(belongs_to_recipe);
+
 -- name: CreateRecipeStep :exec
 INSERT INTO recipe_steps (
 	id,
@@ -589,6 +589,19 @@ WHERE
 GROUP BY recipes.id
 ORDER BY recipes.id;
 
+-- name: LockRecipe :one
+SELECT recipes.id
+FROM recipes
+WHERE recipes.id = sqlc.arg(id)
+FOR UPDATE;
+
+-- name: LockRecipeForRecipeStep :one
+SELECT recipes.id
+FROM recipes
+	JOIN recipe_steps ON recipe_steps.belongs_to_recipe = recipes.id
+WHERE recipe_steps.id = sqlc.arg(recipe_step_id)
+FOR UPDATE OF recipes;
+
 -- name: UpdateRecipe :execrows
 UPDATE recipes SET
 	name = sqlc.arg(name),
@@ -0,0 +1,175 @@
+-- name: ArchiveValidIngredientNutritionFacts :execrows
+UPDATE valid_ingredient_nutrition_facts SET archived_at = NOW() WHERE archived_at IS NULL AND id = sqlc.arg(id);
+
+-- name: CreateValidIngredientNutritionFacts :exec
+INSERT INTO valid_ingredient_nutrition_facts (
+	id,
+	valid_ingredient_id,
+	basis_measurement_unit_id,
+	calories,
+	protein_in_grams,
+	total_fat_in_grams,
+	saturated_fat_in_grams,
+	carbohydrates_in_grams,
+	sugar_in_grams,
+	fiber_in_grams,
+	sodium_in_milligrams,
+	cholesterol_in_milligrams,
+	notes
+) VALUES (
+	sqlc.arg(id),
+	sqlc.arg(valid_ingredient_id),
+	sqlc.arg(basis_measurement_unit_id),
+	sqlc.arg(calories),
+	sqlc.arg(protein_in_grams),
+	sqlc.arg(total_fat_in_grams),
+	sqlc.arg(saturated_fat_in_grams),
+	sqlc.arg(carbohydrates_in_grams),
+	sqlc.arg(sugar_in_grams),
+	sqlc.arg(fiber_in_grams),
+	sqlc.arg(sodium_in_milligrams),
+	sqlc.arg(cholesterol_in_milligrams),
+	sqlc.arg(notes)
+);
+
+-- name: CheckValidIngredientNutritionFactsExistence :one
+SELECT EXISTS (
+	SELECT valid_ingredient_nutrition_facts.id
+	FROM valid_ingredient_nutrition_facts
+	WHERE valid_ingredient_nutrition_facts.archived_at IS NULL
+		AND valid_ingredient_nutrition_facts.id = sqlc.arg(id)
+);
+
+-- name: GetValidIngredientNutritionFacts :one
+SELECT
+	valid_ingredient_nutrition_facts.id as valid_ingredient_nutrition_facts_id,
+	valid_ingredient_nutrition_facts.valid_ingredient_id as valid_ingredient_nutrition_facts_valid_ingredient_id,
+	valid_ingredient_nutrition_facts.basis_measurement_unit_id as valid_ingredient_nutrition_facts_basis_measurement_unit_id,
+	valid_ingredient_nutrition_facts.calories as valid_ingredient_nutrition_facts_calories,
+	valid_ingredient_nutrition_facts.protein_in_grams as valid_ingredient_nutrition_facts_protein_in_grams,
+	valid_ingredient_nutrition_facts.total_fat_in_grams as valid_ingredient_nutrition_facts_total_fat_in_grams,
+	valid_ingredient_nutrition_facts.saturated_fat_in_grams as valid_ingredient_nutrition_facts_saturated_fat_in_grams,
+	valid_ingredient_nutrition_facts.carbohydrates_in_grams as valid_ingredient_nutrition_facts_carbohydrates_in_grams,
+	valid_ingredient_nutrition_facts.sugar_in_grams as valid_ingredient_nutrition_facts_sugar_in_grams,
+	valid_ingredient_nutrition_facts.fiber_in_grams as valid_ingredient_nutrition_facts_fiber_in_grams,
+	valid_ingredient_nutrition_facts.sodium_in_milligrams as valid_ingredient_nutrition_facts_sodium_in_milligrams,
+	valid_ingredient_nutrition_facts.cholesterol_in_milligrams as valid_ingredient_nutrition_facts_cholesterol_in_milligrams,
+	valid_ingredient_nutrition_facts.notes as valid_ingredient_nutrition_facts_notes,
+	valid_ingredient_nutrition_facts.created_at as valid_ingredient_nutrition_facts_created_at,
+	valid_ingredient_nutrition_facts.last_updated_at as valid_ingredient_nutrition_facts_last_updated_at,
+	valid_ingredient_nutrition_facts.archived_at as valid_ingredient_nutrition_facts_archived_at,
+	valid_measurement_units.id as basis_measurement_unit_id,
+	valid_measurement_units.name as basis_measurement_unit_name,
+	valid_measurement_units.description as basis_measurement_unit_description,
+	valid_measurement_units.volumetric as basis_measurement_unit_volumetric,
+	valid_measurement_units.icon_path as basis_measurement_unit_icon_path,
+	valid_measurement_units.universal as basis_measurement_unit_universal,
+	valid_measurement_units.metric as basis_measurement_unit_metric,
+	valid_measurement_units.imperial as basis_measurement_unit_imperial,
+	valid_measurement_units.slug as basis_measurement_unit_slug,
+	valid_measurement_units.plural_name as basis_measurement_unit_plural_name,
+	valid_measurement_units.last_indexed_at as basis_measurement_unit_last_indexed_at,
+	valid_measurement_units.created_at as basis_measurement_unit_created_at,
+	valid_measurement_units.last_updated_at as basis_measurement_unit_last_updated_at,
+	valid_measurement_units.archived_at as basis_measurement_unit_archived_at
+FROM valid_ingredient_nutrition_facts
+	JOIN valid_measurement_units ON valid_ingredient_nutrition_facts.basis_measurement_unit_id = valid_measurement_units.id
+WHERE
+	valid_ingredient_nutrition_facts.id = sqlc.arg(id)
+	AND valid_ingredient_nutrition_facts.archived_at IS NULL
+	AND valid_measurement_units.archived_at IS NULL;
+
+-- name: GetValidIngredientNutritionFactsForIngredient :one
+SELECT
+	valid_ingredient_nutrition_facts.id as valid_ingredient_nutrition_facts_id,
+	valid_ingredient_nutrition_facts.valid_ingredient_id as valid_ingredient_nutrition_facts_valid_ingredient_id,
+	valid_ingredient_nutrition_facts.basis_measurement_unit_id as valid_ingredient_nutrition_facts_basis_measurement_unit_id,
+	valid_ingredient_nutrition_facts.calories as valid_ingredient_nutrition_facts_calories,
+	valid_ingredient_nutrition_facts.protein_in_grams as valid_ingredient_nutrition_facts_protein_in_grams,
+	valid_ingredient_nutrition_facts.total_fat_in_grams as valid_ingredient_nutrition_facts_total_fat_in_grams,
+	valid_ingredient_nutrition_facts.saturated_fat_in_grams as valid_ingredient_nutrition_facts_saturated_fat_in_grams,
+	valid_ingredient_nutrition_facts.carbohydrates_in_grams as valid_ingredient_nutrition_facts_carbohydrates_in_grams,
+	valid_ingredient_nutrition_facts.sugar_in_grams as valid_ingredient_nutrition_facts_sugar_in_grams,
+	valid_ingredient_nutrition_facts.fiber_in_grams as valid_ingredient_nutrition_facts_fiber_in_grams,
+	valid_ingredient_nutrition_facts.sodium_in_milligrams as valid_ingredient_nutrition_facts_sodium_in_milligrams,
+	valid_ingredient_nutrition_facts.cholesterol_in_milligrams as valid_ingredient_nutrition_facts_cholesterol_in_milligrams,
+	valid_ingredient_nutrition_facts.notes as valid_ingredient_nutrition_facts_notes,
+	valid_ingredient_nutrition_facts.created_at as valid_ingredient_nutrition_facts_created_at,
+	valid_ingredient_nutrition_facts.last_updated_at as valid_ingredient_nutrition_facts_last_updated_at,
+	valid_ingredient_nutrition_facts.archived_at as valid_ingredient_nutrition_facts_archived_at,
+	valid_measurement_units.id as basis_measurement_unit_id,
+	valid_measurement_units.name as basis_measurement_unit_name,
+	valid_measurement_units.description as basis_measurement_unit_description,
+	valid_measurement_units.volumetric as basis_measurement_unit_volumetric,
+	valid_measurement_units.icon_path as basis_measurement_unit_icon_path,
+	valid_measurement_units.universal as basis_measurement_unit_universal,
+	valid_measurement_units.metric as basis_measurement_unit_metric,
+	valid_measurement_units.imperial as basis_measurement_unit_imperial,
+	valid_measurement_units.slug as basis_measurement_unit_slug,
+	valid_measurement_units.plural_name as basis_measurement_unit_plural_name,
+	valid_measurement_units.last_indexed_at as basis_measurement_unit_last_indexed_at,
+	valid_measurement_units.created_at as basis_measurement_unit_created_at,
+	valid_measurement_units.last_updated_at as basis_measurement_unit_last_updated_at,
+	valid_measurement_units.archived_at as basis_measurement_unit_archived_at
+FROM valid_ingredient_nutrition_facts
+	JOIN valid_measurement_units ON valid_ingredient_nutrition_facts.basis_measurement_unit_id = valid_measurement_units.id
+WHERE
+	valid_ingredient_nutrition_facts.valid_ingredient_id = sqlc.arg(valid_ingredient_id)
+	AND valid_ingredient_nutrition_facts.archived_at IS NULL
+	AND valid_measurement_units.archived_at IS NULL;
+
+-- name: GetValidIngredientNutritionFactsForIngredients :many
+SELECT
+	valid_ingredient_nutrition_facts.id as valid_ingredient_nutrition_facts_id,
+	valid_ingredient_nutrition_facts.valid_ingredient_id as valid_ingredient_nutrition_facts_valid_ingredient_id,
+	valid_ingredient_nutrition_facts.basis_measurement_unit_id as valid_ingredient_nutrition_facts_basis_measurement_unit_id,
+	valid_ingredient_nutrition_facts.calories as valid_ingredient_nutrition_facts_calories,
+	valid_ingredient_nutrition_facts.protein_in_grams as valid_ingredient_nutrition_facts_protein_in_grams,
+	valid_ingredient_nutrition_facts.total_fat_in_grams as valid_ingredient_nutrition_facts_total_fat_in_grams,
+	valid_ingredient_nutrition_facts.saturated_fat_in_grams as valid_ingredient_nutrition_facts_saturated_fat_in_grams,
+	valid_ingredient_nutrition_facts.carbohydrates_in_grams as valid_ingredient_nutrition_facts_carbohydrates_in_grams,
+	valid_ingredient_nutrition_facts.sugar_in_grams as valid_ingredient_nutrition_facts_sugar_in_grams,
+	valid_ingredient_nutrition_facts.fiber_in_grams as valid_ingredient_nutrition_facts_fiber_in_grams,
+	valid_ingredient_nutrition_facts.sodium_in_milligrams as valid_ingredient_nutrition_facts_sodium_in_milligrams,
+	valid_ingredient_nutrition_facts.cholesterol_in_milligrams as valid_ingredient_nutrition_facts_cholesterol_in_milligrams,
+	valid_ingredient_nutrition_facts.notes as valid_ingredient_nutrition_facts_notes,
+	valid_ingredient_nutrition_facts.created_at as valid_ingredient_nutrition_facts_created_at,
+	valid_ingredient_nutrition_facts.last_updated_at as valid_ingredient_nutrition_facts_last_updated_at,
+	valid_ingredient_nutrition_facts.archived_at as valid_ingredient_nutrition_facts_archived_at,
+	valid_measurement_units.id as basis_measurement_unit_id,
+	valid_measurement_units.name as basis_measurement_unit_name,
+	valid_measurement_units.description as basis_measurement_unit_description,
+	valid_measurement_units.volumetric as basis_measurement_unit_volumetric,
+	valid_measurement_units.icon_path as basis_measurement_unit_icon_path,
+	valid_measurement_units.universal as basis_measurement_unit_universal,
+	valid_measurement_units.metric as basis_measurement_unit_metric,
+	valid_measurement_units.imperial as basis_measurement_unit_imperial,
+	valid_measurement_units.slug as basis_measurement_unit_slug,
+	valid_measurement_units.plural_name as basis_measurement_unit_plural_name,
+	valid_measurement_units.last_indexed_at as basis_measurement_unit_last_indexed_at,
+	valid_measurement_units.created_at as basis_measurement_unit_created_at,
+	valid_measurement_units.last_updated_at as basis_measurement_unit_last_updated_at,
+	valid_measurement_units.archived_at as basis_measurement_unit_archived_at
+FROM valid_ingredient_nutrition_facts
+	JOIN valid_measurement_units ON valid_ingredient_nutrition_facts.basis_measurement_unit_id = valid_measurement_units.id
+WHERE
+	valid_ingredient_nutrition_facts.valid_ingredient_id = ANY(sqlc.arg(valid_ingredient_ids)::text[])
+	AND valid_ingredient_nutrition_facts.archived_at IS NULL
+	AND valid_measurement_units.archived_at IS NULL;
+
+-- name: UpdateValidIngredientNutritionFacts :execrows
+UPDATE valid_ingredient_nutrition_facts SET
+	basis_measurement_unit_id = sqlc.arg(basis_measurement_unit_id),
+	calories = sqlc.arg(calories),
+	protein_in_grams = sqlc.arg(protein_in_grams),
+	total_fat_in_grams = sqlc.arg(total_fat_in_grams),
+	saturated_fat_in_grams = sqlc.arg(saturated_fat_in_grams),
+	carbohydrates_in_grams = sqlc.arg(carbohydrates_in_grams),
+	sugar_in_grams = sqlc.arg(sugar_in_grams),
+	fiber_in_grams = sqlc.arg(fiber_in_grams),
+	sodium_in_milligrams = sqlc.arg(sodium_in_milligrams),
+	cholesterol_in_milligrams = sqlc.arg(cholesterol_in_milligrams),
+	notes = sqlc.arg(notes),
+	last_updated_at = NOW()
+WHERE archived_at IS NULL
+	AND id = sqlc.arg(id);
@@ -0,0 +1,252 @@
+package mealplanning
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+	mealplanningkeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/keys"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/repositories/postgres/mealplanning/generated"
+
+	"github.com/primandproper/platform/database"
+	platformerrors "github.com/primandproper/platform/errors"
+	"github.com/primandproper/platform/observability"
+	"github.com/primandproper/platform/observability/tracing"
+)
+
+var (
+	_ mealplanning.ValidIngredientNutritionFactsDataManager = (*repository)(nil)
+)
+
+// buildValidIngredientNutritionFactsFromRow converts a query row into valid ingredient nutrition facts.
+// All the nutrition facts retrieval queries select the same columns, so their rows are convertible to this one.
+func buildValidIngredientNutritionFactsFromRow(result *generated.GetValidIngredientNutritionFactsRow) *mealplanning.ValidIngredientNutritionFacts {
+	return &mealplanning.ValidIngredientNutritionFacts{
+		CreatedAt:         result.ValidIngredientNutritionFactsCreatedAt,
+		LastUpdatedAt:     database.TimePointerFromNullTime(result.ValidIngredientNutritionFactsLastUpdatedAt),
+		ArchivedAt:        database.TimePointerFromNullTime(result.ValidIngredientNutritionFactsArchivedAt),
+		ID:                result.ValidIngredientNutritionFactsID,
+		ValidIngredientID: result.ValidIngredientNutritionFactsValidIngredientID,
+		Notes:             result.ValidIngredientNutritionFactsNotes,
+		BasisMeasurementUnit: mealplanning.ValidMeasurementUnit{
+			CreatedAt:     result.BasisMeasurementUnitCreatedAt,
+			LastUpdatedAt: database.TimePointerFromNullTime(result.BasisMeasurementUnitLastUpdatedAt),
+			ArchivedAt:    database.TimePointerFromNullTime(result.BasisMeasurementUnitArchivedAt),
+			Name:          result.BasisMeasurementUnitName,
+			IconPath:      result.BasisMeasurementUnitIconPath,
+			ID:            result.BasisMeasurementUnitID,
+			Description:   result.BasisMeasurementUnitDescription,
+			PluralName:    result.BasisMeasurementUnitPluralName,
+			Slug:          result.BasisMeasurementUnitSlug,
+			Volumetric:    database.BoolFromNullBool(result.BasisMeasurementUnitVolumetric),
+			Universal:     result.BasisMeasurementUnitUniversal,
+			Metric:        result.BasisMeasurementUnitMetric,
+			Imperial:      result.BasisMeasurementUnitImperial,
+		},
+		Nutrients: mealplanning.Nutrients{
+			Calories:                database.Float32FromString(result.ValidIngredientNutritionFactsCalories),
+			ProteinInGrams:          database.Float32FromString(result.ValidIngredientNutritionFactsProteinInGrams),
+			TotalFatInGrams:         database.Float32FromString(result.ValidIngredientNutritionFactsTotalFatInGrams),
+			SaturatedFatInGrams:     database.Float32FromString(result.ValidIngredientNutritionFactsSaturatedFatInGrams),
+			CarbohydratesInGrams:    database.Float32FromString(result.ValidIngredientNutritionFactsCarbohydratesInGrams),
+			SugarInGrams:            database.Float32FromString(result.ValidIngredientNutritionFactsSugarInGrams),
+			FiberInGrams:            database.Float32FromString(result.ValidIngredientNutritionFactsFiberInGrams),
+			SodiumInMilligrams:      database.Float32FromString(result.ValidIngredientNutritionFactsSodiumInMilligrams),
+			CholesterolInMilligrams: database.Float32FromString(result.ValidIngredientNutritionFactsCholesterolInMilligrams),
+		},
+	}
+}
+
+// ValidIngredientNutritionFactsExists fetches whether valid ingredient nutrition facts exist from the database.
+func (q *repository) ValidIngredientNutritionFactsExists(ctx context.Context, validIngredientNutritionFactsID string) (exists bool, err error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := q.logger.Clone()
+
+	if validIngredientNutritionFactsID == "" {
+		return false, platformerrors.ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(mealplanningkeys.ValidIngredientNutritionFactsIDKey, validIngredientNutritionFactsID)
+	tracing.AttachToSpan(span, mealplanningkeys.ValidIngredientNutritionFactsIDKey, validIngredientNutritionFactsID)
+
+	result, err := q.generatedQuerier.CheckValidIngredientNutritionFactsExistence(ctx, q.readDB, validIngredientNutritionFactsID)
+	if err != nil {
+		return false, observability.PrepareAndLogError(err, logger, span, "performing valid ingredient nutrition facts existence check")
+	}
+
+	return result, nil
+}
+
+// GetValidIngredientNutritionFacts fetches valid ingredient nutrition facts from the database.
+func (q *repository) GetValidIngredientNutritionFacts(ctx context.Context, validIngredientNutritionFactsID string) (*mealplanning.ValidIngredientNutritionFacts, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := q.logger.Clone()
+
+	if validIngredientNutritionFactsID == "" {
+		return nil, platformerrors.ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(mealplanningkeys.ValidIngredientNutritionFactsIDKey, validIngredientNutritionFactsID)
+	tracing.AttachToSpan(span, mealplanningkeys.ValidIngredientNutritionFactsIDKey, validIngredientNutritionFactsID)
+
+	result, err := q.generatedQuerier.GetValidIngredientNutritionFacts(ctx, q.readDB, validIngredientNutritionFactsID)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching valid ingredient nutrition facts")
+	}
+
+	return buildValidIngredientNutritionFactsFromRow(result), nil
+}
+
+// GetValidIngredientNutritionFactsForIngredient fetches the active nutrition facts for a valid ingredient from the database.
+func (q *repository) GetValidIngredientNutritionFactsForIngredient(ctx context.Context, validIngredientID string) (*mealplanning.ValidIngredientNutritionFacts, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := q.logger.Clone()
+
+	if validIngredientID == "" {
+		return nil, platformerrors.ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(mealplanningkeys.ValidIngredientIDKey, validIngredientID)
+	tracing.AttachToSpan(span, mealplanningkeys.ValidIngredientIDKey, validIngredientID)
+
+	result, err := q.generatedQuerier.GetValidIngredientNutritionFactsForIngredient(ctx, q.readDB, validIngredientID)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching valid ingredient nutrition facts for ingredient")
+	}
+
+	return buildValidIngredientNutritionFactsFromRow((*generated.GetValidIngredientNutritionFactsRow)(result)), nil
+}
+
+// GetValidIngredientNutritionFactsForIngredients fetches the active nutrition facts for each of the given valid ingredients.
+// Ingredients without nutrition facts are simply absent from the results.
+func (q *repository) GetValidIngredientNutritionFactsForIngredients(ctx context.Context, validIngredientIDs []string) ([]*mealplanning.ValidIngredientNutritionFacts, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := q.logger.Clone()
+
+	results, err := q.generatedQuerier.GetValidIngredientNutritionFactsForIngredients(ctx, q.readDB, validIngredientIDs)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching valid ingredient nutrition facts for ingredients")
+	}
+
+	data := make([]*mealplanning.ValidIngredientNutritionFacts, 0, len(results))
+	for _, result := range results {
+		data = append(data, buildValidIngredientNutritionFactsFromRow((*generated.GetValidIngredientNutritionFactsRow)(result)))
+	}
+
+	return data, nil
+}
+
+// CreateValidIngredientNutritionFacts creates valid ingredient nutrition facts in the database.
+func (q *repository) CreateValidIngredientNutritionFacts(ctx context.Context, input *mealplanning.ValidIngredientNutritionFactsDatabaseCreationInput) (*mealplanning.ValidIngredientNutritionFacts, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if input == nil {
+		return nil, platformerrors.ErrNilInputProvided
+	}
+	tracing.AttachToSpan(span, mealplanningkeys.ValidIngredientNutritionFactsIDKey, input.ID)
+	logger := q.logger.WithValue(mealplanningkeys.ValidIngredientNutritionFactsIDKey, input.ID)
+
+	if err := q.generatedQuerier.CreateValidIngredientNutritionFacts(ctx, q.writeDB, &generated.CreateValidIngredientNutritionFactsParams{
+		ID:                      input.ID,
+		ValidIngredientID:       input.ValidIngredientID,
+		BasisMeasurementUnitID:  input.BasisMeasurementUnitID,
+		Calories:                database.StringFromFloat32(input.Nutrients.Calories),
+		ProteinInGrams:          database.StringFromFloat32(input.Nutrients.ProteinInGrams),
+		TotalFatInGrams:         database.StringFromFloat32(input.Nutrients.TotalFatInGrams),
+		SaturatedFatInGrams:     database.StringFromFloat32(input.Nutrients.SaturatedFatInGrams),
+		CarbohydratesInGrams:    database.StringFromFloat32(input.Nutrients.CarbohydratesInGrams),
+		SugarInGrams:            database.StringFromFloat32(input.Nutrients.SugarInGrams),
+		FiberInGrams:            database.StringFromFloat32(input.Nutrients.FiberInGrams),
+		SodiumInMilligrams:      database.StringFromFloat32(input.Nutrients.SodiumInMilligrams),
+		CholesterolInMilligrams: database.StringFromFloat32(input.Nutrients.CholesterolInMilligrams),
+		Notes:                   input.Notes,
+	}); err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "performing valid ingredient nutrition facts creation query")
+	}
+
+	x := &mealplanning.ValidIngredientNutritionFacts{
+		ID:                   input.ID,
+		ValidIngredientID:    input.ValidIngredientID,
+		Notes:                input.Notes,
+		BasisMeasurementUnit: mealplanning.ValidMeasurementUnit{ID: input.BasisMeasurementUnitID},
+		Nutrients:            input.Nutrients,
+		CreatedAt:            q.CurrentTime(),
+	}
+
+	measurementUnit, err := q.GetValidMeasurementUnit(ctx, input.BasisMeasurementUnitID)
+	if err != nil {
+		// basically impossible for this to happen and not error out earlier
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching basis measurement unit for valid ingredient nutrition facts")
+	}
+	if measurementUnit != nil {
+		x.BasisMeasurementUnit = *measurementUnit
+	}
+
+	logger.Info("valid ingredient nutrition facts created")
+
+	return x, nil
+}
+
+// UpdateValidIngredientNutritionFacts updates particular valid ingredient nutrition facts.
+func (q *repository) UpdateValidIngredientNutritionFacts(ctx context.Context, updated *mealplanning.ValidIngredientNutritionFacts) error {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if updated == nil {
+		return platformerrors.ErrNilInputProvided
+	}
+	logger := q.logger.WithValue(mealplanningkeys.ValidIngredientNutritionFactsIDKey, updated.ID)
+	tracing.AttachToSpan(span, mealplanningkeys.ValidIngredientNutritionFactsIDKey, updated.ID)
+
+	if _, err := q.generatedQuerier.UpdateValidIngredientNutritionFacts(ctx, q.writeDB, &generated.UpdateValidIngredientNutritionFactsParams{
+		BasisMeasurementUnitID:  updated.BasisMeasurementUnit.ID,
+		Calories:                database.StringFromFloat32(updated.Nutrients.Calories),
+		ProteinInGrams:          database.StringFromFloat32(updated.Nutrients.ProteinInGrams),
+		TotalFatInGrams:         database.StringFromFloat32(updated.Nutrients.TotalFatInGrams),
+		SaturatedFatInGrams:     database.StringFromFloat32(updated.Nutrients.SaturatedFatInGrams),
+		CarbohydratesInGrams:    database.StringFromFloat32(updated.Nutrients.CarbohydratesInGrams),
+		SugarInGrams:            database.StringFromFloat32(updated.Nutrients.SugarInGrams),
+		FiberInGrams:            database.StringFromFloat32(updated.Nutrients.FiberInGrams),
+		SodiumInMilligrams:      database.StringFromFloat32(updated.Nutrients.SodiumInMilligrams),
+		CholesterolInMilligrams: database.StringFromFloat32(updated.Nutrients.CholesterolInMilligrams),
+		Notes:                   updated.Notes,
+		ID:                      updated.ID,
+	}); err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "updating valid ingredient nutrition facts")
+	}
+
+	logger.Info("valid ingredient nutrition facts updated")
+
+	return nil
+}
+
+// ArchiveValidIngredientNutritionFacts archives valid ingredient nutrition facts from the database by their ID.
+func (q *repository) ArchiveValidIngredientNutritionFacts(ctx context.Context, validIngredientNutritionFactsID string) error {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := q.logger.Clone()
+
+	if validIngredientNutritionFactsID == "" {
+		return platformerrors.ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(mealplanningkeys.ValidIngredientNutritionFactsIDKey, validIngredientNutritionFactsID)
+	tracing.AttachToSpan(span, mealplanningkeys.ValidIngredientNutritionFactsIDKey, validIngredientNutritionFactsID)
+
+	rowsAffected, err := q.generatedQuerier.ArchiveValidIngredientNutritionFacts(ctx, q.writeDB, validIngredientNutritionFactsID)
+	if err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "archiving valid ingredient nutrition facts")
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
@@ -0,0 +1,202 @@
+package mealplanning
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+
+	types "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/converters"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/fakes"
+	pgtesting "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/repositories/postgres/testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createValidIngredientNutritionFactsForTest(t *testing.T, ctx context.Context, exampleValidIngredientNutritionFacts *types.ValidIngredientNutritionFacts, dbc *repository) *types.ValidIngredientNutritionFacts {
+	t.Helper()
+
+	// create
+	if exampleValidIngredientNutritionFacts == nil {
+		exampleValidIngredient := createValidIngredientForTest(t, ctx, nil, dbc)
+		exampleValidMeasurementUnit := createValidMeasurementUnitForTest(t, ctx, nil, dbc)
+		exampleValidIngredientNutritionFacts = fakes.BuildFakeValidIngredientNutritionFacts()
+		exampleValidIngredientNutritionFacts.ValidIngredientID = exampleValidIngredient.ID
+		exampleValidIngredientNutritionFacts.BasisMeasurementUnit = *exampleValidMeasurementUnit
+	}
+
+	dbInput := converters.ConvertValidIngredientNutritionFactsToValidIngredientNutritionFactsDatabaseCreationInput(exampleValidIngredientNutritionFacts)
+
+	created, err := dbc.CreateValidIngredientNutritionFacts(ctx, dbInput)
+	assert.NoError(t, err)
+	require.NotNil(t, created)
+	exampleValidIngredientNutritionFacts.CreatedAt = created.CreatedAt
+	assert.Equal(t, exampleValidIngredientNutritionFacts, created)
+
+	validIngredientNutritionFacts, err := dbc.GetValidIngredientNutritionFacts(ctx, created.ID)
+	require.NoError(t, err)
+	require.NotNil(t, validIngredientNutritionFacts)
+	exampleValidIngredientNutritionFacts.CreatedAt = validIngredientNutritionFacts.CreatedAt
+	exampleValidIngredientNutritionFacts.BasisMeasurementUnit = validIngredientNutritionFacts.BasisMeasurementUnit
+
+	assert.Equal(t, validIngredientNutritionFacts, exampleValidIngredientNutritionFacts)
+
+	return created
+}
+
+func TestQuerier_Integration_ValidIngredientNutritionFacts(t *testing.T) {
+	if !pgtesting.RunContainerTests {
+		t.SkipNow()
+	}
+
+	ctx := t.Context()
+	dbc, _, container := buildDatabaseClientForTest(t)
+
+	databaseURI, err := container.ConnectionString(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, databaseURI)
+
+	defer func(t *testing.T) {
+		t.Helper()
+		assert.NoError(t, container.Terminate(ctx))
+	}(t)
+
+	exampleValidMeasurementUnit := createValidMeasurementUnitForTest(t, ctx, nil, dbc)
+	createdValidIngredientNutritionFacts := []*types.ValidIngredientNutritionFacts{}
+	ingredientIDs := []string{}
+
+	// create (each ingredient may only have one active set of nutrition facts)
+	for range exampleQuantity {
+		exampleValidIngredient := createValidIngredientForTest(t, ctx, nil, dbc)
+		input := fakes.BuildFakeValidIngredientNutritionFacts()
+		input.ValidIngredientID = exampleValidIngredient.ID
+		input.BasisMeasurementUnit = *exampleValidMeasurementUnit
+		createdValidIngredientNutritionFacts = append(createdValidIngredientNutritionFacts, createValidIngredientNutritionFactsForTest(t, ctx, input, dbc))
+		ingredientIDs = append(ingredientIDs, exampleValidIngredient.ID)
+	}
+
+	// update
+	updatedValidIngredientNutritionFacts := fakes.BuildFakeValidIngredientNutritionFacts()
+	updatedValidIngredientNutritionFacts.ID = createdValidIngredientNutritionFacts[0].ID
+	updatedValidIngredientNutritionFacts.ValidIngredientID = createdValidIngredientNutritionFacts[0].ValidIngredientID
+	updatedValidIngredientNutritionFacts.BasisMeasurementUnit = createdValidIngredientNutritionFacts[0].BasisMeasurementUnit
+	assert.NoError(t, dbc.UpdateValidIngredientNutritionFacts(ctx, updatedValidIngredientNutritionFacts))
+
+	forIngredient, err := dbc.GetValidIngredientNutritionFactsForIngredient(ctx, createdValidIngredientNutritionFacts[0].ValidIngredientID)
+	require.NoError(t, err)
+	assert.Equal(t, updatedValidIngredientNutritionFacts.Nutrients, forIngredient.Nutrients)
+
+	// a second active set of facts for the same ingredient is rejected
+	duplicate := fakes.BuildFakeValidIngredientNutritionFacts()
+	duplicate.ValidIngredientID = createdValidIngredientNutritionFacts[0].ValidIngredientID
+	duplicate.BasisMeasurementUnit = *exampleValidMeasurementUnit
+	_, err = dbc.CreateValidIngredientNutritionFacts(ctx, converters.ConvertValidIngredientNutritionFactsToValidIngredientNutritionFactsDatabaseCreationInput(duplicate))
+	assert.Error(t, err)
+
+	forIngredients, err := dbc.GetValidIngredientNutritionFactsForIngredients(ctx, ingredientIDs)
+	assert.NoError(t, err)
+	assert.Len(t, forIngredients, len(createdValidIngredientNutritionFacts))
+
+	// delete
+	for _, validIngredientNutritionFacts := range createdValidIngredientNutritionFacts {
+		assert.NoError(t, dbc.ArchiveValidIngredientNutritionFacts(ctx, validIngredientNutritionFacts.ID))
+
+		var exists bool
+		exists, err = dbc.ValidIngredientNutritionFactsExists(ctx, validIngredientNutritionFacts.ID)
+		assert.NoError(t, err)
+		assert.False(t, exists)
+
+		var y *types.ValidIngredientNutritionFacts
+		y, err = dbc.GetValidIngredientNutritionFacts(ctx, validIngredientNutritionFacts.ID)
+		assert.Nil(t, y)
+		assert.Error(t, err)
+		assert.ErrorIs(t, err, sql.ErrNoRows)
+	}
+}
+
+func TestQuerier_ValidIngredientNutritionFactsExists(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid valid ingredient nutrition facts ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		actual, err := c.ValidIngredientNutritionFactsExists(ctx, "")
+		assert.Error(t, err)
+		assert.False(t, actual)
+	})
+}
+
+func TestQuerier_GetValidIngredientNutritionFacts(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid valid ingredient nutrition facts ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		actual, err := c.GetValidIngredientNutritionFacts(ctx, "")
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
+
+func TestQuerier_GetValidIngredientNutritionFactsForIngredient(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid valid ingredient ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		actual, err := c.GetValidIngredientNutritionFactsForIngredient(ctx, "")
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
+
+func TestQuerier_CreateValidIngredientNutritionFacts(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid input", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		actual, err := c.CreateValidIngredientNutritionFacts(ctx, nil)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
+
+func TestQuerier_UpdateValidIngredientNutritionFacts(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with nil input", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		assert.Error(t, c.UpdateValidIngredientNutritionFacts(ctx, nil))
+	})
+}
+
+func TestQuerier_ArchiveValidIngredientNutritionFacts(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid valid ingredient nutrition facts ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		assert.Error(t, c.ArchiveValidIngredientNutritionFacts(ctx, ""))
+	})
+}
@@ -64,6 +64,29 @@ func (m *Migrator) Migrate(ctx context.Context, db *sql.DB) error {
 		{Version: 19, Description: "rbac tables", Script: fetchMigration("00019_rbac")},
 		{Version: 20, Description: "per-service database users", Script: fetchMigration("00020_service_users")},
 		{Version: 21, Description: "meal planning tables", Script: fetchMigration("00021_mealplanning")},
+		{Version: 22, Description: "webhook deliveries table", Script: fetchMigration("00022_webhook_deliveries")},
+		{Version: 23, Description: "webhook encryption key rotation", Script: fetchMigration("00023_webhook_encryption_key_rotation")},
+		{Version: 24, Description: "valid ingredient nutrition facts", Script: fetchMigration("00024_valid_ingredient_nutrition_facts")},
+		{Version: 25, Description: "meal plan option allergen policy", Script: fetchMigration("00025_meal_plan_option_allergen_policy")},
+		{Version: 26, Description: "meal plan event tally reports", Script: fetchMigration("00026_meal_plan_event_tally_reports")},
+		{Version: 27, Description: "pantry items", Script: fetchMigration("00027_pantry_items")},
+		{Version: 28, Description: "oauth2 client scopes", Script: fetchMigration("00028_oauth2_client_scopes")},
+		{Version: 29, Description: "oidc signing keys", Script: fetchMigration("00029_oidc_signing_keys")},
+		{Version: 30, Description: "product entitlements", Script: fetchMigration("00030_product_entitlements")},
+		{Version: 31, Description: "payment provider events", Script: fetchMigration("00031_payment_provider_events")},
+		{Version: 32, Description: "meal plan activities", Script: fetchMigration("00032_meal_plan_activities")},
+		{Version: 33, Description: "meal plan calendar feeds", Script: fetchMigration("00033_meal_plan_calendar_feeds")},
+		{Version: 34, Description: "idempotency keys", Script: fetchMigration("00034_idempotency_keys")},
+		{Version: 35, Description: "recipe revisions", Script: fetchMigration("00035_recipe_revisions")},
+		{Version: 36, Description: "meal plan templates", Script: fetchMigration("00036_meal_plan_templates")},
+		{Version: 37, Description: "notification preferences", Script: fetchMigration("00037_notification_preferences")},
+		{Version: 38, Description: "in-app notifications", Script: fetchMigration("00038_in_app_notifications")},
+		{Version: 39, Description: "account roles", Script: fetchMigration("00039_account_roles")},
+		{Version: 40, Description: "audit log search", Script: fetchMigration("00040_audit_log_search")},
+		{Version: 41, Description: "audit log hash chain", Script: fetchMigration("00041_audit_log_hash_chain")},
+		{Version: 42, Description: "meal plan option vote scores", Script: fetchMigration("00042_meal_plan_option_vote_scores")},
+		{Version: 43, Description: "payment provider event claims", Script: fetchMigration("00043_payment_provider_event_claims")},
+		{Version: 44, Description: "meal plan activity sequence", Script: fetchMigration("00044_meal_plan_activity_sequence")},
 	}
 
 	if err := darwin.New(darwin.NewGenericDriver(db, darwin.PostgresDialect{}), migrations, nil).Migrate(); err != nil {
@@ -0,0 +1,53 @@
+-- Webhook Deliveries Migration
+-- Persisted record of every webhook delivery attempt, used for retries, dead-lettering, and manual redelivery.
+-- Failed attempts carry the time of their retry in next_attempt_at until the retry is made.
+
+CREATE TYPE webhook_delivery_status AS ENUM (
+    'succeeded',
+    'failed',
+    'dead_lettered'
+);
+
+CREATE TABLE IF NOT EXISTS webhook_deliveries (
+    id TEXT NOT NULL PRIMARY KEY,
+    belongs_to_webhook TEXT NOT NULL REFERENCES webhooks("id") ON DELETE CASCADE,
+    belongs_to_account TEXT NOT NULL REFERENCES accounts("id") ON DELETE CASCADE,
+    request_id TEXT NOT NULL,
+    trigger_event TEXT NOT NULL DEFAULT '',
+    attempt_number INTEGER NOT NULL DEFAULT 1,
+    status webhook_delivery_status NOT NULL,
+    request_body TEXT NOT NULL DEFAULT '',
+    response_status_code INTEGER,
+    response_body TEXT NOT NULL DEFAULT '',
+    latency_in_milliseconds BIGINT NOT NULL DEFAULT 0,
+    error_message TEXT NOT NULL DEFAULT '',
+    next_attempt_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+-- =============================================================================
+-- INDEXES FOR WEBHOOK DELIVERIES TABLE
+-- =============================================================================
+
+CREATE INDEX idx_webhook_deliveries_webhook ON webhook_deliveries (belongs_to_webhook, created_at);
+CREATE INDEX idx_webhook_deliveries_account ON webhook_deliveries (belongs_to_account);
+CREATE INDEX idx_webhook_deliveries_request_id ON webhook_deliveries (request_id);
+CREATE INDEX idx_webhook_deliveries_created_at ON webhook_deliveries (created_at);
+-- retries are claimed by the webhook delivery retrier as they come due.
+CREATE INDEX idx_webhook_deliveries_due ON webhook_deliveries (next_attempt_at) WHERE status = 'failed' AND next_attempt_at IS NOT NULL;
+
+-- =============================================================================
+-- SEED DATA: webhook delivery permissions
+-- =============================================================================
+
+INSERT INTO permissions (id, name, description) VALUES
+    ('db9ll5fh7ojv2ecufqsg', 'read.webhook_deliveries', 'Read webhook deliveries'),
+    ('db9ll5fh7ojv2ecufqt0', 'redeliver.webhook_deliveries', 'Redeliver webhook deliveries');
+
+-- account_admin: webhook delivery permissions
+INSERT INTO user_role_permissions (id, role_id, permission_id) VALUES
+    ('db9ll5fh7ojv2ecufqtg', 'role_account_admin', 'db9ll5fh7ojv2ecufqt0');
+
+-- account_member: webhook delivery permissions
+INSERT INTO user_role_permissions (id, role_id, permission_id) VALUES
+    ('db9ll5fh7ojv2ecufqu0', 'role_account_member', 'db9ll5fh7ojv2ecufqsg');
@@ -0,0 +1,8 @@
+-- Webhook Encryption Key Rotation Migration
+-- Keeps the previous webhook signing keys around after a rotation, so receivers can accept them during the grace period,
+-- and records the stable event ID each delivery is signed with, so receivers can deduplicate retries.
+
+ALTER TABLE accounts ADD COLUMN IF NOT EXISTS previous_webhook_hmac_secret TEXT DEFAULT ''::TEXT NOT NULL;
+ALTER TABLE accounts ADD COLUMN IF NOT EXISTS webhook_hmac_secret_rotated_at TIMESTAMP WITH TIME ZONE;
+
+ALTER TABLE webhook_deliveries ADD COLUMN IF NOT EXISTS event_id TEXT DEFAULT ''::TEXT NOT NULL;
@@ -0,0 +1,66 @@
+-- Valid Ingredient Nutrition Facts Migration
+-- Nutrients per 100 of a basis measurement unit (ordinarily grams) for each valid ingredient.
+
+CREATE TABLE IF NOT EXISTS valid_ingredient_nutrition_facts (
+    id TEXT NOT NULL PRIMARY KEY,
+    valid_ingredient_id TEXT NOT NULL REFERENCES valid_ingredients("id") ON DELETE CASCADE,
+    basis_measurement_unit_id TEXT NOT NULL REFERENCES valid_measurement_units("id") ON DELETE CASCADE,
+    calories NUMERIC(14,2) DEFAULT 0 NOT NULL,
+    protein_in_grams NUMERIC(14,2) DEFAULT 0 NOT NULL,
+    total_fat_in_grams NUMERIC(14,2) DEFAULT 0 NOT NULL,
+    saturated_fat_in_grams NUMERIC(14,2) DEFAULT 0 NOT NULL,
+    carbohydrates_in_grams NUMERIC(14,2) DEFAULT 0 NOT NULL,
+    sugar_in_grams NUMERIC(14,2) DEFAULT 0 NOT NULL,
+    fiber_in_grams NUMERIC(14,2) DEFAULT 0 NOT NULL,
+    sodium_in_milligrams NUMERIC(14,2) DEFAULT 0 NOT NULL,
+    cholesterol_in_milligrams NUMERIC(14,2) DEFAULT 0 NOT NULL,
+    notes TEXT DEFAULT ''::TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL,
+    last_updated_at TIMESTAMP WITH TIME ZONE,
+    archived_at TIMESTAMP WITH TIME ZONE,
+    CHECK (
+        calories >= 0
+        AND protein_in_grams >= 0
+        AND total_fat_in_grams >= 0
+        AND saturated_fat_in_grams >= 0
+        AND carbohydrates_in_grams >= 0
+        AND sugar_in_grams >= 0
+        AND fiber_in_grams >= 0
+        AND sodium_in_milligrams >= 0
+        AND cholesterol_in_milligrams >= 0
+    )
+);
+
+-- =============================================================================
+-- INDEXES FOR VALID INGREDIENT NUTRITION FACTS TABLE
+-- =============================================================================
+
+-- each ingredient has at most one active set of nutrition facts.
+CREATE UNIQUE INDEX idx_valid_ingredient_nutrition_facts_valid_ingredient ON valid_ingredient_nutrition_facts (valid_ingredient_id) WHERE archived_at IS NULL;
+CREATE INDEX idx_valid_ingredient_nutrition_facts_basis_measurement_unit ON valid_ingredient_nutrition_facts (basis_measurement_unit_id);
+
+-- =============================================================================
+-- SEED DATA: valid ingredient nutrition facts permissions
+-- =============================================================================
+
+INSERT INTO permissions (id, name, description) VALUES
+    ('dbbq3k8h7ojv2ecufr00', 'create.valid_ingredient_nutrition_facts', 'Create valid ingredient nutrition facts'),
+    ('dbbq3k8h7ojv2ecufr0g', 'read.valid_ingredient_nutrition_facts', 'Read valid ingredient nutrition facts'),
+    ('dbbq3k8h7ojv2ecufr10', 'update.valid_ingredient_nutrition_facts', 'Update valid ingredient nutrition facts'),
+    ('dbbq3k8h7ojv2ecufr1g', 'archive.valid_ingredient_nutrition_facts', 'Archive valid ingredient nutrition facts');
+
+-- service_admin: valid ingredient nutrition facts permissions
+INSERT INTO user_role_permissions (id, role_id, permission_id) VALUES
+    ('dbbq3k8h7ojv2ecufr20', 'role_service_admin', 'dbbq3k8h7ojv2ecufr00'),
+    ('dbbq3k8h7ojv2ecufr2g', 'role_service_admin', 'dbbq3k8h7ojv2ecufr10'),
+    ('dbbq3k8h7ojv2ecufr30', 'role_service_admin', 'dbbq3k8h7ojv2ecufr1g');
+
+-- service_data_admin: valid ingredient nutrition facts permissions
+INSERT INTO user_role_permissions (id, role_id, permission_id) VALUES
+    ('dbbq3k8h7ojv2ecufr3g', 'role_service_data_admin', 'dbbq3k8h7ojv2ecufr00'),
+    ('dbbq3k8h7ojv2ecufr40', 'role_service_data_admin', 'dbbq3k8h7ojv2ecufr10'),
+    ('dbbq3k8h7ojv2ecufr4g', 'role_service_data_admin', 'dbbq3k8h7ojv2ecufr1g');
+
+-- account_member: valid ingredient nutrition facts permissions
+INSERT INTO user_role_permissions (id, role_id, permission_id) VALUES
+    ('dbbq3k8h7ojv2ecufr50', 'role_account_member', 'dbbq3k8h7ojv2ecufr0g');
@@ -0,0 +1,13 @@
+-- Meal Plan Option Allergen Policy Migration
+-- Lets an account choose whether meal plan options that conflict with a member's allergies are flagged or rejected.
+
+INSERT INTO service_settings (id, name, type, description, default_value, enumeration, admins_only)
+VALUES (
+   'd6n2k8s4m1qa7hc3v9p0',
+   'meal_plan_option_allergen_policy',
+   'account',
+   'Whether meal plan options containing a member''s allergens are flagged with warnings or rejected outright',
+   'warn',
+   'warn|reject',
+   true
+);
@@ -0,0 +1,24 @@
+-- Meal Plan Event Tally Reports Migration
+-- Adds Borda count, approval and score voting, and persists how each meal plan event's votes were tallied.
+
+ALTER TYPE valid_election_method ADD VALUE IF NOT EXISTS 'borda';
+ALTER TYPE valid_election_method ADD VALUE IF NOT EXISTS 'approval';
+ALTER TYPE valid_election_method ADD VALUE IF NOT EXISTS 'score';
+
+CREATE TABLE IF NOT EXISTS meal_plan_event_tally_reports (
+    id TEXT NOT NULL PRIMARY KEY,
+    belongs_to_meal_plan_event TEXT NOT NULL REFERENCES meal_plan_events("id") ON DELETE CASCADE,
+    election_method valid_election_method NOT NULL,
+    winner TEXT NOT NULL REFERENCES meal_plan_options("id") ON DELETE CASCADE,
+    tie_broken BOOLEAN DEFAULT FALSE NOT NULL,
+    ballot_count INTEGER DEFAULT 0 NOT NULL,
+    details JSONB NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL,
+    archived_at TIMESTAMP WITH TIME ZONE
+);
+
+-- =============================================================================
+-- INDEXES FOR MEAL PLAN EVENT TALLY REPORTS TABLE
+-- =============================================================================
+
+CREATE INDEX idx_meal_plan_event_tally_reports_event ON meal_plan_event_tally_reports (belongs_to_meal_plan_event) WHERE archived_at IS NULL;
@@ -0,0 +1,54 @@
+-- Pantry Items Migration
+-- Tracks the ingredients an account already has on hand, so grocery lists only include what still needs buying.
+
+CREATE TYPE pantry_storage_location AS ENUM (
+    'pantry',
+    'refrigerator',
+    'freezer',
+    'other'
+);
+
+CREATE TABLE IF NOT EXISTS pantry_items (
+    id TEXT NOT NULL PRIMARY KEY,
+    notes TEXT DEFAULT ''::TEXT NOT NULL,
+    quantity NUMERIC(14,2) DEFAULT 0 NOT NULL,
+    storage_location pantry_storage_location DEFAULT 'pantry'::pantry_storage_location NOT NULL,
+    expires_at TIMESTAMP WITH TIME ZONE,
+    valid_ingredient_id TEXT NOT NULL REFERENCES valid_ingredients("id") ON DELETE CASCADE,
+    valid_measurement_unit_id TEXT NOT NULL REFERENCES valid_measurement_units("id") ON DELETE CASCADE,
+    belongs_to_account TEXT NOT NULL REFERENCES accounts("id") ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL,
+    last_updated_at TIMESTAMP WITH TIME ZONE,
+    archived_at TIMESTAMP WITH TIME ZONE,
+    CHECK (quantity >= 0)
+);
+
+-- a chosen meal plan option is completed once it has been cooked, which consumes its ingredients from the pantry.
+ALTER TABLE meal_plan_options ADD COLUMN IF NOT EXISTS completed_at TIMESTAMP WITH TIME ZONE;
+
+-- =============================================================================
+-- INDEXES FOR PANTRY ITEMS TABLE
+-- =============================================================================
+
+CREATE INDEX idx_pantry_items_account ON pantry_items (belongs_to_account) WHERE archived_at IS NULL;
+CREATE INDEX idx_pantry_items_account_ingredient ON pantry_items (belongs_to_account, valid_ingredient_id) WHERE archived_at IS NULL;
+
+-- =============================================================================
+-- SEED DATA: pantry item permissions
+-- =============================================================================
+
+INSERT INTO permissions (id, name, description) VALUES
+    ('dbpu3k8n9qd6bj8p0a00', 'create.pantry_items', 'Create pantry items'),
+    ('dbpu3k8n9qd6bj8p0a0g', 'read.pantry_items', 'Read pantry items'),
+    ('dbpu3k8n9qd6bj8p0a10', 'update.pantry_items', 'Update pantry items'),
+    ('dbpu3k8n9qd6bj8p0a1g', 'archive.pantry_items', 'Archive pantry items');
+
+-- account_admin: pantry item permissions
+INSERT INTO user_role_permissions (id, role_id, permission_id) VALUES
+    ('dbpu3k8n9qd6bpfa0a00', 'role_account_admin', 'dbpu3k8n9qd6bj8p0a00'),
+    ('dbpu3k8n9qd6bpfa0a0g', 'role_account_admin', 'dbpu3k8n9qd6bj8p0a10'),
+    ('dbpu3k8n9qd6bpfa0a10', 'role_account_admin', 'dbpu3k8n9qd6bj8p0a1g');
+
+-- account_member: pantry item permissions
+INSERT INTO user_role_permissions (id, role_id, permission_id) VALUES
+    ('dbpu3k8n9qd6bpfa0a1g', 'role_account_member', 'dbpu3k8n9qd6bj8p0a0g');
@@ -0,0 +1,18 @@
+-- OAuth2 Client Scopes Migration
+-- Lets OAuth2 clients declare the scopes, redirect URIs, and grant types they may use, and records the scope each token was granted.
+
+ALTER TABLE oauth2_clients
+    ADD COLUMN allowed_scopes TEXT DEFAULT ''::TEXT NOT NULL,
+    ADD COLUMN redirect_uris TEXT DEFAULT ''::TEXT NOT NULL,
+    ADD COLUMN allowed_grant_types TEXT DEFAULT ''::TEXT NOT NULL,
+    ADD COLUMN belongs_to_user TEXT REFERENCES users("id") ON DELETE CASCADE;
+
+-- Existing clients keep the account-level access and grants they had before scopes were enforced.
+UPDATE oauth2_clients SET
+    allowed_scopes = 'read write',
+    allowed_grant_types = 'authorization_code refresh_token';
+
+ALTER TABLE oauth2_client_tokens
+    ADD COLUMN scope TEXT DEFAULT ''::TEXT NOT NULL;
+
+UPDATE oauth2_client_tokens SET scope = 'read write';
@@ -0,0 +1,17 @@
+-- OIDC Signing Keys Migration
+-- Stores the rotating keys ID tokens are signed with, and the nonce an authorization request asked to have echoed back in its ID token.
+
+CREATE TABLE IF NOT EXISTS oidc_signing_keys (
+    id TEXT NOT NULL PRIMARY KEY,
+    algorithm TEXT NOT NULL,
+    private_key TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL,
+    retires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    CHECK (retires_at <= expires_at)
+);
+
+CREATE INDEX IF NOT EXISTS oidc_signing_keys_expires_at ON oidc_signing_keys (expires_at);
+
+ALTER TABLE oauth2_client_tokens
+    ADD COLUMN nonce TEXT DEFAULT ''::TEXT NOT NULL;
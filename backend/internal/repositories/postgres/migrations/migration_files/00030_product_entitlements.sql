@@ -0,0 +1,20 @@
+-- Product Entitlements Migration
+-- Lets products declare the features and limits a subscription to them grants an account.
+
+ALTER TABLE products
+    ADD COLUMN entitlements JSONB DEFAULT '{}'::JSONB NOT NULL;
+
+-- =============================================================================
+-- SEED DATA: entitlement permissions
+-- =============================================================================
+
+INSERT INTO permissions (id, name, description) VALUES
+    ('dc3mfe8n9qd6bk4t0a00', 'read.entitlements', 'Read entitlements');
+
+-- service_admin: entitlement permissions
+INSERT INTO user_role_permissions (id, role_id, permission_id) VALUES
+    ('dc3mfe8n9qd6bpft0a00', 'role_service_admin', 'dc3mfe8n9qd6bk4t0a00');
+
+-- account_member: entitlement permissions
+INSERT INTO user_role_permissions (id, role_id, permission_id) VALUES
+    ('dc3mfe8n9qd6bpft0a0g', 'role_account_member', 'dc3mfe8n9qd6bk4t0a00');
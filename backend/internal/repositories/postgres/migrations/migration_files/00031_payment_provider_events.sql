@@ -0,0 +1,42 @@
+-- Payment Provider Events Migration
+-- An inbox of webhook events received from payment providers, used to drop redeliveries,
+-- refuse out-of-order state transitions, and replay events after a processing failure.
+
+CREATE TYPE payment_provider_event_status AS ENUM (
+    'received',
+    'applied',
+    'stale',
+    'failed'
+);
+
+CREATE TABLE IF NOT EXISTS payment_provider_events (
+    id TEXT NOT NULL PRIMARY KEY,
+    provider TEXT NOT NULL,
+    provider_event_id TEXT NOT NULL,
+    event_type TEXT NOT NULL DEFAULT '',
+    external_subscription_id TEXT NOT NULL DEFAULT '',
+    account_id TEXT NOT NULL DEFAULT '',
+    payload BYTEA NOT NULL,
+    status payment_provider_event_status NOT NULL DEFAULT 'received',
+    processing_error TEXT NOT NULL DEFAULT '',
+    occurred_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    processed_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    UNIQUE (provider, provider_event_id)
+);
+
+CREATE INDEX idx_payment_provider_events_subscription ON payment_provider_events (provider, external_subscription_id, occurred_at) WHERE status = 'applied';
+CREATE INDEX idx_payment_provider_events_created_at ON payment_provider_events (created_at);
+
+-- =============================================================================
+-- SEED DATA: payment provider event permissions
+-- =============================================================================
+
+INSERT INTO permissions (id, name, description) VALUES
+    ('dc4h2q0n9qd6bk4t0a00', 'read.payment_provider_events', 'Read payment provider events'),
+    ('dc4h2q0n9qd6bk4t0a0g', 'replay.payment_provider_events', 'Replay payment provider events');
+
+-- service_admin: payment provider event permissions
+INSERT INTO user_role_permissions (id, role_id, permission_id) VALUES
+    ('dc4h2q0n9qd6bpft0a00', 'role_service_admin', 'dc4h2q0n9qd6bk4t0a00'),
+    ('dc4h2q0n9qd6bpft0a0g', 'role_service_admin', 'dc4h2q0n9qd6bk4t0a0g');
@@ -0,0 +1,18 @@
+-- Meal Plan Activities Migration
+-- An append-only feed of voting, option, finalization, and task changes per meal plan,
+-- written from the data changes queue and read by the meal plan watch streams.
+-- Rows are ordered by ID, which watchers use as their resume cursor.
+
+CREATE TABLE IF NOT EXISTS meal_plan_activities (
+    id TEXT NOT NULL PRIMARY KEY,
+    event_type TEXT NOT NULL,
+    meal_plan_event_id TEXT NOT NULL DEFAULT '',
+    meal_plan_option_id TEXT NOT NULL DEFAULT '',
+    meal_plan_option_vote_id TEXT NOT NULL DEFAULT '',
+    meal_plan_task_id TEXT NOT NULL DEFAULT '',
+    by_user TEXT NOT NULL DEFAULT '',
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    belongs_to_meal_plan TEXT NOT NULL REFERENCES meal_plans("id") ON DELETE CASCADE
+);
+
+CREATE INDEX idx_meal_plan_activities_belongs_to_meal_plan ON meal_plan_activities (belongs_to_meal_plan, id);
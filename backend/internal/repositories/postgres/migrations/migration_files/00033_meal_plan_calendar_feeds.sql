@@ -0,0 +1,17 @@
+-- Meal Plan Calendar Feeds Migration
+-- Per-user iCalendar subscription feeds for an account's meal plans.
+-- Only a SHA-256 hash of each feed's token is stored; the token itself is shown to the user once, at creation.
+-- Rotating a feed archives the user's existing feeds for that account.
+
+CREATE TABLE IF NOT EXISTS meal_plan_calendar_feeds (
+    id TEXT NOT NULL PRIMARY KEY,
+    token_hash TEXT NOT NULL,
+    belongs_to_user TEXT NOT NULL REFERENCES users("id") ON DELETE CASCADE,
+    belongs_to_account TEXT NOT NULL REFERENCES accounts("id") ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    last_accessed_at TIMESTAMP WITH TIME ZONE,
+    archived_at TIMESTAMP WITH TIME ZONE
+);
+
+CREATE UNIQUE INDEX idx_meal_plan_calendar_feeds_token_hash ON meal_plan_calendar_feeds (token_hash);
+CREATE INDEX idx_meal_plan_calendar_feeds_belongs_to_user ON meal_plan_calendar_feeds (belongs_to_user, belongs_to_account) WHERE archived_at IS NULL;
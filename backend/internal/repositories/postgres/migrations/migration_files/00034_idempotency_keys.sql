@@ -0,0 +1,21 @@
+-- Idempotency Keys Migration
+-- Remembers the outcome of mutating RPCs that were called with an idempotency key, so that retried calls get the
+-- original response back instead of repeating the mutation. A key is claimed before the handler runs, and its
+-- response is filled in once the handler succeeds; a key whose response is still null is in flight, and is given
+-- up on if it stays that way for a few minutes.
+
+CREATE TABLE IF NOT EXISTS idempotency_keys (
+    id TEXT NOT NULL PRIMARY KEY,
+    idempotency_key TEXT NOT NULL,
+    method TEXT NOT NULL,
+    scope TEXT NOT NULL,
+    request_fingerprint TEXT NOT NULL,
+    response_type TEXT,
+    response BYTEA,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    completed_at TIMESTAMP WITH TIME ZONE,
+    expires_at TIMESTAMP WITH TIME ZONE NOT NULL
+);
+
+CREATE UNIQUE INDEX idx_idempotency_keys_key ON idempotency_keys (scope, method, idempotency_key);
+CREATE INDEX idx_idempotency_keys_expires_at ON idempotency_keys (expires_at);
@@ -0,0 +1,497 @@
+-- Recipe Revisions Migration
+-- Immutable, numbered snapshots of a recipe, including its steps and their ingredients, instruments, vessels,
+-- products and completion conditions. A new revision is recorded every time a recipe or one of its steps changes.
+-- Meal plan options are pinned to the revisions of their meal's recipes as of when the option was proposed, so that
+-- later edits don't change what was voted on.
+
+CREATE TABLE IF NOT EXISTS recipe_revisions (
+    id TEXT NOT NULL PRIMARY KEY,
+    belongs_to_recipe TEXT NOT NULL REFERENCES recipes("id") ON DELETE CASCADE,
+    revision_number INTEGER NOT NULL,
+    snapshot JSONB NOT NULL,
+    created_by_user TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX idx_recipe_revisions_recipe_revision_number ON recipe_revisions (belongs_to_recipe, revision_number);
+
+CREATE TABLE IF NOT EXISTS meal_plan_option_recipe_revisions (
+    belongs_to_meal_plan_option TEXT NOT NULL REFERENCES meal_plan_options("id") ON DELETE CASCADE,
+    belongs_to_recipe_revision TEXT NOT NULL REFERENCES recipe_revisions("id") ON DELETE CASCADE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (belongs_to_meal_plan_option, belongs_to_recipe_revision)
+);
+
+-- Recipes that predate revisions have their current state recorded as revision 1, so that options proposed for their
+-- meals have something to be pinned to. The snapshots are shaped the way the application shapes the ones it records.
+WITH RECURSIVE recipe_snapshots AS (
+    SELECT
+        recipes.id,
+        recipes.created_by_user,
+        jsonb_build_object(
+            'createdAt', recipes.created_at,
+            'lastUpdatedAt', recipes.last_updated_at,
+            'archivedAt', recipes.archived_at,
+            'id', recipes.id,
+            'slug', recipes.slug,
+            'name', recipes.name,
+            'source', recipes.source,
+            'sourceISBN', recipes.source_isbn,
+            'description', recipes.description,
+            'status', recipes.status,
+            'inspiredByRecipeID', recipes.inspired_by_recipe_id,
+            'minEstimatedPortions', recipes.min_estimated_portions,
+            'maxEstimatedPortions', recipes.max_estimated_portions,
+            'portionName', recipes.portion_name,
+            'pluralPortionName', recipes.plural_portion_name,
+            'eligibleForMeals', recipes.eligible_for_meals,
+            'yieldsComponentType', recipes.yields_component_type,
+            'createdByUser', recipes.created_by_user,
+            'media', COALESCE((
+                SELECT jsonb_agg(jsonb_build_object(
+                    'createdAt', recipe_media.created_at,
+                    'lastUpdatedAt', recipe_media.last_updated_at,
+                    'archivedAt', recipe_media.archived_at,
+                    'id', recipe_media.id,
+                    'belongsToRecipe', recipe_media.belongs_to_recipe,
+                    'belongsToRecipeStep', recipe_media.belongs_to_recipe_step,
+                    'mimeType', recipe_media.mime_type,
+                    'internalPath', recipe_media.internal_path,
+                    'externalPath', recipe_media.external_path,
+                    'index', recipe_media.index
+                ) ORDER BY recipe_media.id)
+                FROM recipe_media
+                WHERE recipe_media.belongs_to_recipe = recipes.id
+                    AND recipe_media.belongs_to_recipe_step IS NULL
+                    AND recipe_media.archived_at IS NULL
+            ), '[]'::JSONB),
+            'prepTasks', (
+                SELECT jsonb_agg(jsonb_build_object(
+                    'createdAt', recipe_prep_tasks.created_at,
+                    'lastUpdatedAt', recipe_prep_tasks.last_updated_at,
+                    'archivedAt', recipe_prep_tasks.archived_at,
+                    'id', recipe_prep_tasks.id,
+                    'name', recipe_prep_tasks.name,
+                    'description', recipe_prep_tasks.description,
+                    'notes', recipe_prep_tasks.notes,
+                    'optional', recipe_prep_tasks.optional,
+                    'explicitStorageInstructions', recipe_prep_tasks.explicit_storage_instructions,
+                    'minTimeBufferBeforeRecipeInSeconds', recipe_prep_tasks.minimum_time_buffer_before_recipe_in_seconds,
+                    'maxTimeBufferBeforeRecipeInSeconds', recipe_prep_tasks.maximum_time_buffer_before_recipe_in_seconds,
+                    'storageType', COALESCE(recipe_prep_tasks.storage_type::TEXT, ''),
+                    'minStorageTemperatureInCelsius', recipe_prep_tasks.minimum_storage_temperature_in_celsius,
+                    'maxStorageTemperatureInCelsius', recipe_prep_tasks.maximum_storage_temperature_in_celsius,
+                    'belongsToRecipe', recipe_prep_tasks.belongs_to_recipe,
+                    'recipeSteps', (
+                        SELECT jsonb_agg(jsonb_build_object(
+                            'id', recipe_prep_task_steps.id,
+                            'belongsToRecipeStep', recipe_prep_task_steps.belongs_to_recipe_step,
+                            'belongsToRecipeStepTask', recipe_prep_task_steps.belongs_to_recipe_prep_task,
+                            'satisfiesRecipeStep', recipe_prep_task_steps.satisfies_recipe_step
+                        ))
+                        FROM recipe_prep_task_steps
+                            JOIN recipe_steps ON recipe_prep_task_steps.belongs_to_recipe_step = recipe_steps.id
+                        WHERE recipe_prep_task_steps.belongs_to_recipe_prep_task = recipe_prep_tasks.id
+                            AND recipe_steps.archived_at IS NULL
+                    )
+                ))
+                FROM recipe_prep_tasks
+                WHERE recipe_prep_tasks.belongs_to_recipe = recipes.id
+                    AND recipe_prep_tasks.archived_at IS NULL
+                    AND EXISTS (
+                        SELECT 1
+                        FROM recipe_prep_task_steps
+                            JOIN recipe_steps ON recipe_prep_task_steps.belongs_to_recipe_step = recipe_steps.id
+                        WHERE recipe_prep_task_steps.belongs_to_recipe_prep_task = recipe_prep_tasks.id
+                            AND recipe_steps.archived_at IS NULL
+                    )
+            ),
+            'steps', (
+                SELECT jsonb_agg(jsonb_build_object(
+                    'createdAt', recipe_steps.created_at,
+                    'lastUpdatedAt', recipe_steps.last_updated_at,
+                    'archivedAt', recipe_steps.archived_at,
+                    'id', recipe_steps.id,
+                    'index', recipe_steps.index,
+                    'minEstimatedTimeInSeconds', recipe_steps.minimum_estimated_time_in_seconds,
+                    'maxEstimatedTimeInSeconds', recipe_steps.maximum_estimated_time_in_seconds,
+                    'minTemperatureInCelsius', recipe_steps.minimum_temperature_in_celsius,
+                    'maxTemperatureInCelsius', recipe_steps.maximum_temperature_in_celsius,
+                    'notes', recipe_steps.notes,
+                    'explicitInstructions', recipe_steps.explicit_instructions,
+                    'conditionExpression', recipe_steps.condition_expression,
+                    'optional', recipe_steps.optional,
+                    'startTimerAutomatically', recipe_steps.start_timer_automatically,
+                    'belongsToRecipe', recipe_steps.belongs_to_recipe,
+                    'preparation', jsonb_build_object(
+                        'createdAt', valid_preparations.created_at,
+                        'lastUpdatedAt', valid_preparations.last_updated_at,
+                        'archivedAt', valid_preparations.archived_at,
+                        'id', valid_preparations.id,
+                        'name', valid_preparations.name,
+                        'description', valid_preparations.description,
+                        'iconPath', valid_preparations.icon_path,
+                        'yieldsNothing', valid_preparations.yields_nothing,
+                        'restrictToIngredients', valid_preparations.restrict_to_ingredients,
+                        'pastTense', valid_preparations.past_tense,
+                        'slug', valid_preparations.slug,
+                        'minIngredientCount', valid_preparations.minimum_ingredient_count,
+                        'maxIngredientCount', valid_preparations.maximum_ingredient_count,
+                        'minInstrumentCount', valid_preparations.minimum_instrument_count,
+                        'maxInstrumentCount', valid_preparations.maximum_instrument_count,
+                        'minVesselCount', valid_preparations.minimum_vessel_count,
+                        'maxVesselCount', valid_preparations.maximum_vessel_count,
+                        'temperatureRequired', valid_preparations.temperature_required,
+                        'timeEstimateRequired', valid_preparations.time_estimate_required,
+                        'conditionExpressionRequired', valid_preparations.condition_expression_required,
+                        'consumesVessel', valid_preparations.consumes_vessel,
+                        'onlyForVessels', valid_preparations.only_for_vessels
+                    ),
+                    'ingredients', (
+                        SELECT jsonb_agg(jsonb_build_object(
+                            'createdAt', recipe_step_ingredients.created_at,
+                            'lastUpdatedAt', recipe_step_ingredients.last_updated_at,
+                            'archivedAt', recipe_step_ingredients.archived_at,
+                            'id', recipe_step_ingredients.id,
+                            'name', recipe_step_ingredients.name,
+                            'optional', recipe_step_ingredients.optional,
+                            'minQuantity', recipe_step_ingredients.minimum_quantity_value,
+                            'maxQuantity', recipe_step_ingredients.maximum_quantity_value,
+                            'quantityNotes', recipe_step_ingredients.quantity_notes,
+                            'recipeStepProductID', recipe_step_ingredients.recipe_step_product_id,
+                            'ingredientNotes', recipe_step_ingredients.ingredient_notes,
+                            'index', recipe_step_ingredients.index,
+                            'optionIndex', recipe_step_ingredients.option_index,
+                            'toTaste', recipe_step_ingredients.to_taste,
+                            'productPercentageToUse', recipe_step_ingredients.product_percentage_to_use,
+                            'vesselIndex', recipe_step_ingredients.vessel_index,
+                            'scaleFactor', CASE WHEN recipe_step_ingredients.scale_factor > 0 THEN recipe_step_ingredients.scale_factor ELSE 1 END,
+                            'productOfRecipeID', recipe_step_ingredients.recipe_step_product_recipe_id,
+                            'belongsToRecipeStep', recipe_step_ingredients.belongs_to_recipe_step,
+                            'measurementUnit', jsonb_build_object(
+                                'createdAt', valid_measurement_units.created_at,
+                                'lastUpdatedAt', valid_measurement_units.last_updated_at,
+                                'archivedAt', valid_measurement_units.archived_at,
+                                'id', valid_measurement_units.id,
+                                'name', valid_measurement_units.name,
+                                'description', valid_measurement_units.description,
+                                'volumetric', COALESCE(valid_measurement_units.volumetric, FALSE),
+                                'iconPath', valid_measurement_units.icon_path,
+                                'universal', valid_measurement_units.universal,
+                                'metric', valid_measurement_units.metric,
+                                'imperial', valid_measurement_units.imperial,
+                                'slug', valid_measurement_units.slug,
+                                'pluralName', valid_measurement_units.plural_name
+                            ),
+                            'ingredient', CASE WHEN valid_ingredients.id IS NULL THEN NULL ELSE jsonb_build_object(
+                                'createdAt', valid_ingredients.created_at,
+                                'lastUpdatedAt', valid_ingredients.last_updated_at,
+                                'archivedAt', valid_ingredients.archived_at,
+                                'id', valid_ingredients.id,
+                                'name', valid_ingredients.name,
+                                'description', valid_ingredients.description,
+                                'warning', valid_ingredients.warning,
+                                'containsEgg', valid_ingredients.contains_egg,
+                                'containsDairy', valid_ingredients.contains_dairy,
+                                'containsPeanut', valid_ingredients.contains_peanut,
+                                'containsTreeNut', valid_ingredients.contains_tree_nut,
+                                'containsSoy', valid_ingredients.contains_soy,
+                                'containsWheat', valid_ingredients.contains_wheat,
+                                'containsShellfish', valid_ingredients.contains_shellfish,
+                                'containsSesame', valid_ingredients.contains_sesame,
+                                'containsFish', valid_ingredients.contains_fish,
+                                'containsGluten', valid_ingredients.contains_gluten,
+                                'containsAlcohol', valid_ingredients.contains_alcohol,
+                                'animalFlesh', valid_ingredients.animal_flesh,
+                                'animalDerived', valid_ingredients.animal_derived,
+                                'isLiquid', COALESCE(valid_ingredients.is_liquid, FALSE),
+                                'iconPath', valid_ingredients.icon_path,
+                                'pluralName', valid_ingredients.plural_name,
+                                'restrictToPreparations', valid_ingredients.restrict_to_preparations,
+                                'minStorageTemperatureInCelsius', valid_ingredients.minimum_ideal_storage_temperature_in_celsius,
+                                'maxStorageTemperatureInCelsius', valid_ingredients.maximum_ideal_storage_temperature_in_celsius,
+                                'storageInstructions', valid_ingredients.storage_instructions,
+                                'slug', valid_ingredients.slug,
+                                'shoppingSuggestions', valid_ingredients.shopping_suggestions,
+                                'isStarch', valid_ingredients.is_starch,
+                                'isProtein', valid_ingredients.is_protein,
+                                'isGrain', valid_ingredients.is_grain,
+                                'isFruit', valid_ingredients.is_fruit,
+                                'isSalt', valid_ingredients.is_salt,
+                                'isFat', valid_ingredients.is_fat,
+                                'isAcid', valid_ingredients.is_acid,
+                                'isHeat', valid_ingredients.is_heat
+                            ) END
+                        ) ORDER BY recipe_step_ingredients.index, recipe_step_ingredients.option_index)
+                        FROM recipe_step_ingredients
+                            JOIN valid_measurement_units ON recipe_step_ingredients.measurement_unit = valid_measurement_units.id
+                            LEFT JOIN valid_ingredients ON recipe_step_ingredients.ingredient_id = valid_ingredients.id
+                        WHERE recipe_step_ingredients.belongs_to_recipe_step = recipe_steps.id
+                            AND recipe_step_ingredients.archived_at IS NULL
+                    ),
+                    'products', (
+                        SELECT jsonb_agg(jsonb_build_object(
+                            'createdAt', recipe_step_products.created_at,
+                            'lastUpdatedAt', recipe_step_products.last_updated_at,
+                            'archivedAt', recipe_step_products.archived_at,
+                            'id', recipe_step_products.id,
+                            'name', recipe_step_products.name,
+                            'type', recipe_step_products.type,
+                            'minMeasurementQuantity', recipe_step_products.minimum_measurement_quantity_value,
+                            'maxMeasurementQuantity', recipe_step_products.maximum_measurement_quantity_value,
+                            'minItemQuantity', recipe_step_products.minimum_item_quantity_value,
+                            'maxItemQuantity', recipe_step_products.maximum_item_quantity_value,
+                            'quantityNotes', recipe_step_products.quantity_notes,
+                            'compostable', recipe_step_products.compostable,
+                            'maxStorageDurationInSeconds', recipe_step_products.maximum_storage_duration_in_seconds,
+                            'minStorageTemperatureInCelsius', recipe_step_products.minimum_storage_temperature_in_celsius,
+                            'maxStorageTemperatureInCelsius', recipe_step_products.maximum_storage_temperature_in_celsius,
+                            'storageInstructions', recipe_step_products.storage_instructions,
+                            'isLiquid', recipe_step_products.is_liquid,
+                            'isWaste', recipe_step_products.is_waste,
+                            'index', recipe_step_products.index,
+                            'containedInVesselIndex', recipe_step_products.contained_in_vessel_index,
+                            'belongsToRecipeStep', recipe_step_products.belongs_to_recipe_step,
+                            'measurementUnit', CASE WHEN COALESCE(valid_measurement_units.id, '') = '' THEN NULL ELSE jsonb_build_object(
+                                'createdAt', valid_measurement_units.created_at,
+                                'lastUpdatedAt', valid_measurement_units.last_updated_at,
+                                'archivedAt', valid_measurement_units.archived_at,
+                                'id', valid_measurement_units.id,
+                                'name', valid_measurement_units.name,
+                                'description', valid_measurement_units.description,
+                                'volumetric', COALESCE(valid_measurement_units.volumetric, FALSE),
+                                'iconPath', valid_measurement_units.icon_path,
+                                'universal', valid_measurement_units.universal,
+                                'metric', valid_measurement_units.metric,
+                                'imperial', valid_measurement_units.imperial,
+                                'slug', valid_measurement_units.slug,
+                                'pluralName', valid_measurement_units.plural_name
+                            ) END
+                        ) ORDER BY recipe_step_products.index)
+                        FROM recipe_step_products
+                            LEFT JOIN valid_measurement_units ON recipe_step_products.measurement_unit = valid_measurement_units.id
+                        WHERE recipe_step_products.belongs_to_recipe_step = recipe_steps.id
+                            AND recipe_step_products.archived_at IS NULL
+                            AND recipe_steps.archived_at IS NULL
+                    ),
+                    'instruments', (
+                        SELECT jsonb_agg(jsonb_build_object(
+                            'createdAt', recipe_step_instruments.created_at,
+                            'lastUpdatedAt', recipe_step_instruments.last_updated_at,
+                            'archivedAt', recipe_step_instruments.archived_at,
+                            'id', recipe_step_instruments.id,
+                            'recipeStepProductID', recipe_step_instruments.recipe_step_product_id,
+                            'name', recipe_step_instruments.name,
+                            'notes', recipe_step_instruments.notes,
+                            'preferenceRank', recipe_step_instruments.preference_rank,
+                            'optional', recipe_step_instruments.optional,
+                            'minQuantity', recipe_step_instruments.minimum_quantity,
+                            'maxQuantity', recipe_step_instruments.maximum_quantity,
+                            'index', recipe_step_instruments.index,
+                            'optionIndex', recipe_step_instruments.option_index,
+                            'scaleFactor', CASE WHEN recipe_step_instruments.scale_factor > 0 THEN recipe_step_instruments.scale_factor ELSE 1 END,
+                            'belongsToRecipeStep', recipe_step_instruments.belongs_to_recipe_step,
+                            'instrument', CASE WHEN valid_instruments.id IS NULL THEN NULL ELSE jsonb_build_object(
+                                'createdAt', valid_instruments.created_at,
+                                'lastUpdatedAt', valid_instruments.last_updated_at,
+                                'archivedAt', valid_instruments.archived_at,
+                                'id', valid_instruments.id,
+                                'name', valid_instruments.name,
+                                'pluralName', valid_instruments.plural_name,
+                                'description', valid_instruments.description,
+                                'iconPath', valid_instruments.icon_path,
+                                'slug', valid_instruments.slug,
+                                'usableForStorage', valid_instruments.usable_for_storage,
+                                'displayInSummaryLists', valid_instruments.display_in_summary_lists,
+                                'includeInGeneratedInstructions', valid_instruments.include_in_generated_instructions
+                            ) END
+                        ) ORDER BY recipe_step_instruments.index, recipe_step_instruments.option_index)
+                        FROM recipe_step_instruments
+                            LEFT JOIN valid_instruments ON recipe_step_instruments.instrument_id = valid_instruments.id
+                        WHERE recipe_step_instruments.belongs_to_recipe_step = recipe_steps.id
+                            AND recipe_step_instruments.archived_at IS NULL
+                            AND recipe_steps.archived_at IS NULL
+                    ),
+                    'vessels', (
+                        SELECT jsonb_agg(jsonb_build_object(
+                            'createdAt', recipe_step_vessels.created_at,
+                            'lastUpdatedAt', recipe_step_vessels.last_updated_at,
+                            'archivedAt', recipe_step_vessels.archived_at,
+                            'id', recipe_step_vessels.id,
+                            'name', recipe_step_vessels.name,
+                            'notes', recipe_step_vessels.notes,
+                            'recipeStepProductID', recipe_step_vessels.recipe_step_product_id,
+                            'vesselPreposition', recipe_step_vessels.vessel_predicate,
+                            'minQuantity', recipe_step_vessels.minimum_quantity,
+                            'maxQuantity', recipe_step_vessels.maximum_quantity,
+                            'unavailableAfterStep', recipe_step_vessels.unavailable_after_step,
+                            'index', recipe_step_vessels.index,
+                            'optionIndex', recipe_step_vessels.option_index,
+                            'scaleFactor', CASE WHEN recipe_step_vessels.scale_factor > 0 THEN recipe_step_vessels.scale_factor ELSE 1 END,
+                            'belongsToRecipeStep', recipe_step_vessels.belongs_to_recipe_step,
+                            'vessel', CASE WHEN valid_vessels.id IS NULL THEN NULL ELSE jsonb_build_object(
+                                'createdAt', valid_vessels.created_at,
+                                'lastUpdatedAt', valid_vessels.last_updated_at,
+                                'archivedAt', valid_vessels.archived_at,
+                                'id', valid_vessels.id,
+                                'name', valid_vessels.name,
+                                'pluralName', valid_vessels.plural_name,
+                                'description', valid_vessels.description,
+                                'iconPath', valid_vessels.icon_path,
+                                'slug', valid_vessels.slug,
+                                'shape', COALESCE(valid_vessels.shape::TEXT, ''),
+                                'usableForStorage', valid_vessels.usable_for_storage,
+                                'displayInSummaryLists', valid_vessels.display_in_summary_lists,
+                                'includeInGeneratedInstructions', valid_vessels.include_in_generated_instructions,
+                                'widthInMillimeters', COALESCE(valid_vessels.width_in_millimeters, 0),
+                                'lengthInMillimeters', COALESCE(valid_vessels.length_in_millimeters, 0),
+                                'heightInMillimeters', COALESCE(valid_vessels.height_in_millimeters, 0),
+                                'capacity', COALESCE(valid_vessels.capacity, 0),
+                                'capacityUnit', CASE WHEN valid_measurement_units.id IS NULL THEN NULL ELSE jsonb_build_object(
+                                    'createdAt', valid_measurement_units.created_at,
+                                    'lastUpdatedAt', valid_measurement_units.last_updated_at,
+                                    'archivedAt', valid_measurement_units.archived_at,
+                                    'id', valid_measurement_units.id,
+                                    'name', valid_measurement_units.name,
+                                    'description', valid_measurement_units.description,
+                                    'volumetric', COALESCE(valid_measurement_units.volumetric, FALSE),
+                                    'iconPath', valid_measurement_units.icon_path,
+                                    'universal', valid_measurement_units.universal,
+                                    'metric', valid_measurement_units.metric,
+                                    'imperial', valid_measurement_units.imperial,
+                                    'slug', valid_measurement_units.slug,
+                                    'pluralName', valid_measurement_units.plural_name
+                                ) END
+                            ) END
+                        ) ORDER BY recipe_step_vessels.index, recipe_step_vessels.option_index)
+                        FROM recipe_step_vessels
+                            LEFT JOIN valid_vessels ON recipe_step_vessels.valid_vessel_id = valid_vessels.id
+                            LEFT JOIN valid_measurement_units ON valid_vessels.capacity_unit = valid_measurement_units.id
+                        WHERE recipe_step_vessels.belongs_to_recipe_step = recipe_steps.id
+                            AND recipe_step_vessels.archived_at IS NULL
+                            AND recipe_steps.archived_at IS NULL
+                    ),
+                    'completionConditions', (
+                        SELECT jsonb_agg(jsonb_build_object(
+                            'createdAt', recipe_step_completion_conditions.created_at,
+                            'lastUpdatedAt', recipe_step_completion_conditions.last_updated_at,
+                            'archivedAt', recipe_step_completion_conditions.archived_at,
+                            'id', recipe_step_completion_conditions.id,
+                            'optional', recipe_step_completion_conditions.optional,
+                            'notes', recipe_step_completion_conditions.notes,
+                            'belongsToRecipeStep', recipe_step_completion_conditions.belongs_to_recipe_step,
+                            'ingredientState', jsonb_build_object(
+                                'createdAt', valid_ingredient_states.created_at,
+                                'lastUpdatedAt', valid_ingredient_states.last_updated_at,
+                                'archivedAt', valid_ingredient_states.archived_at,
+                                'id', valid_ingredient_states.id,
+                                'name', valid_ingredient_states.name,
+                                'pastTense', valid_ingredient_states.past_tense,
+                                'slug', valid_ingredient_states.slug,
+                                'description', valid_ingredient_states.description,
+                                'iconPath', valid_ingredient_states.icon_path,
+                                'attributeType', valid_ingredient_states.attribute_type
+                            ),
+                            'ingredients', (
+                                SELECT jsonb_agg(jsonb_build_object(
+                                    'createdAt', recipe_step_completion_condition_ingredients.created_at,
+                                    'id', recipe_step_completion_condition_ingredients.id,
+                                    'belongsToRecipeStepCompletionCondition', recipe_step_completion_condition_ingredients.belongs_to_recipe_step_completion_condition,
+                                    'recipeStepIngredient', recipe_step_completion_condition_ingredients.recipe_step_ingredient
+                                ))
+                                FROM recipe_step_completion_condition_ingredients
+                                WHERE recipe_step_completion_condition_ingredients.belongs_to_recipe_step_completion_condition = recipe_step_completion_conditions.id
+                                    AND recipe_step_completion_condition_ingredients.archived_at IS NULL
+                            )
+                        ))
+                        FROM recipe_step_completion_conditions
+                            JOIN valid_ingredient_states ON recipe_step_completion_conditions.ingredient_state = valid_ingredient_states.id
+                        WHERE recipe_step_completion_conditions.belongs_to_recipe_step = recipe_steps.id
+                            AND recipe_step_completion_conditions.archived_at IS NULL
+                            AND valid_ingredient_states.archived_at IS NULL
+                            AND recipe_steps.archived_at IS NULL
+                            AND EXISTS (
+                                SELECT 1
+                                FROM recipe_step_completion_condition_ingredients
+                                WHERE recipe_step_completion_condition_ingredients.belongs_to_recipe_step_completion_condition = recipe_step_completion_conditions.id
+                                    AND recipe_step_completion_condition_ingredients.archived_at IS NULL
+                            )
+                    ),
+                    'media', COALESCE((
+                        SELECT jsonb_agg(jsonb_build_object(
+                            'createdAt', recipe_media.created_at,
+                            'lastUpdatedAt', recipe_media.last_updated_at,
+                            'archivedAt', recipe_media.archived_at,
+                            'id', recipe_media.id,
+                            'belongsToRecipe', recipe_media.belongs_to_recipe,
+                            'belongsToRecipeStep', recipe_media.belongs_to_recipe_step,
+                            'mimeType', recipe_media.mime_type,
+                            'internalPath', recipe_media.internal_path,
+                            'externalPath', recipe_media.external_path,
+                            'index', recipe_media.index
+                        ) ORDER BY recipe_media.id)
+                        FROM recipe_media
+                        WHERE recipe_media.belongs_to_recipe = recipes.id
+                            AND recipe_media.belongs_to_recipe_step = recipe_steps.id
+                            AND recipe_media.archived_at IS NULL
+                    ), '[]'::JSONB),
+                    'stepImages', (
+                        SELECT jsonb_agg(jsonb_build_object(
+                            'createdAt', uploaded_media.created_at,
+                            'lastUpdatedAt', uploaded_media.last_updated_at,
+                            'archivedAt', uploaded_media.archived_at,
+                            'id', uploaded_media.id,
+                            'storagePath', uploaded_media.storage_path,
+                            'mimeType', uploaded_media.mime_type,
+                            'createdByUser', uploaded_media.created_by_user
+                        ) ORDER BY recipe_step_images.created_at)
+                        FROM recipe_step_images
+                            JOIN uploaded_media ON recipe_step_images.uploaded_media_id = uploaded_media.id
+                        WHERE recipe_step_images.belongs_to_recipe_step = recipe_steps.id
+                            AND recipe_step_images.archived_at IS NULL
+                            AND uploaded_media.archived_at IS NULL
+                    )
+                ) ORDER BY recipe_steps.index)
+                FROM recipe_steps
+                    LEFT JOIN valid_preparations ON recipe_steps.preparation_id = valid_preparations.id
+                WHERE recipe_steps.belongs_to_recipe = recipes.id
+            )
+        ) AS snapshot
+    FROM recipes
+    WHERE recipes.archived_at IS NULL
+        AND NOT EXISTS (
+            SELECT 1 FROM recipe_revisions WHERE recipe_revisions.belongs_to_recipe = recipes.id
+        )
+),
+-- recipes that use another recipe's products carry that recipe, and everything it uses in turn, as associated recipes.
+recipe_references AS (
+    SELECT DISTINCT
+        recipe_steps.belongs_to_recipe AS recipe_id,
+        recipe_step_ingredients.recipe_step_product_recipe_id AS referenced_recipe_id
+    FROM recipe_step_ingredients
+        JOIN recipe_steps ON recipe_step_ingredients.belongs_to_recipe_step = recipe_steps.id
+    WHERE recipe_step_ingredients.archived_at IS NULL
+        AND COALESCE(recipe_step_ingredients.recipe_step_product_recipe_id, '') != ''
+        AND recipe_step_ingredients.recipe_step_product_recipe_id != recipe_steps.belongs_to_recipe
+),
+associated_recipes (root_recipe_id, recipe_id) AS (
+    SELECT recipe_id, referenced_recipe_id FROM recipe_references
+    UNION
+    SELECT associated_recipes.root_recipe_id, recipe_references.referenced_recipe_id
+    FROM associated_recipes
+        JOIN recipe_references ON recipe_references.recipe_id = associated_recipes.recipe_id
+)
+INSERT INTO recipe_revisions (id, belongs_to_recipe, revision_number, snapshot, created_by_user)
+SELECT
+    gen_random_uuid()::TEXT,
+    recipe_snapshots.id,
+    1,
+    recipe_snapshots.snapshot || jsonb_build_object('associatedRecipes', (
+        SELECT jsonb_agg(associated_snapshots.snapshot)
+        FROM associated_recipes
+            JOIN recipe_snapshots AS associated_snapshots ON associated_snapshots.id = associated_recipes.recipe_id
+        WHERE associated_recipes.root_recipe_id = recipe_snapshots.id
+            AND associated_recipes.recipe_id != recipe_snapshots.id
+    )),
+    recipe_snapshots.created_by_user
+FROM recipe_snapshots;
@@ -0,0 +1,44 @@
+-- Meal Plan Templates Migration
+-- Templates describe a meal plan an account builds over and over: which events it has on which weekdays and at what
+-- times, where each event's candidate meals come from, and how voting works. A worker instantiates each template into
+-- a new meal plan whenever its recurrence rule comes due.
+-- Slots are stored as a JSONB document, since they have no identity of their own outside the template.
+-- A run leases the occurrence it's instantiating through instantiation_claimed_until, and only advances
+-- next_instantiation_at once the meal plan exists, so a failed run leaves the occurrence for the next one.
+
+CREATE TABLE IF NOT EXISTS meal_plan_templates (
+    id TEXT NOT NULL PRIMARY KEY,
+    name TEXT NOT NULL,
+    notes TEXT NOT NULL DEFAULT '',
+    election_method valid_election_method DEFAULT 'schulze'::valid_election_method NOT NULL,
+    recurrence_rule TEXT NOT NULL,
+    timezone TEXT NOT NULL DEFAULT 'UTC',
+    voting_deadline_offset_in_seconds INTEGER NOT NULL,
+    candidates_per_slot INTEGER NOT NULL DEFAULT 3,
+    avoid_meals_from_last_plans INTEGER NOT NULL DEFAULT 0,
+    slots JSONB NOT NULL,
+    next_instantiation_at TIMESTAMP WITH TIME ZONE NOT NULL,
+    last_instantiated_at TIMESTAMP WITH TIME ZONE,
+    instantiation_claimed_until TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    last_updated_at TIMESTAMP WITH TIME ZONE,
+    archived_at TIMESTAMP WITH TIME ZONE,
+    belongs_to_account TEXT NOT NULL REFERENCES accounts("id") ON DELETE CASCADE,
+    created_by_user TEXT NOT NULL REFERENCES users("id") ON DELETE CASCADE
+);
+
+CREATE INDEX idx_meal_plan_templates_belongs_to_account ON meal_plan_templates (belongs_to_account) WHERE archived_at IS NULL;
+CREATE INDEX idx_meal_plan_templates_next_instantiation_at ON meal_plan_templates (next_instantiation_at) WHERE archived_at IS NULL;
+
+-- The instantiator runs as its own database user, like the other meal planning workers.
+DO $$
+BEGIN
+  IF NOT EXISTS (SELECT FROM pg_roles WHERE rolname = 'meal_plan_template_instantiator') THEN
+    CREATE ROLE meal_plan_template_instantiator WITH LOGIN;
+  END IF;
+END $$;
+
+GRANT ALL PRIVILEGES ON ALL TABLES IN SCHEMA public TO meal_plan_template_instantiator;
+GRANT ALL PRIVILEGES ON ALL SEQUENCES IN SCHEMA public TO meal_plan_template_instantiator;
+ALTER DEFAULT PRIVILEGES IN SCHEMA public GRANT ALL PRIVILEGES ON TABLES TO meal_plan_template_instantiator;
+ALTER DEFAULT PRIVILEGES IN SCHEMA public GRANT ALL PRIVILEGES ON SEQUENCES TO meal_plan_template_instantiator;
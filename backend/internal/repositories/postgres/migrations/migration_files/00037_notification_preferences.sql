@@ -0,0 +1,54 @@
+-- Notification Preferences Migration
+-- Users choose, per notification type, which channels they hear about it on, whether emails are sent as they happen or
+-- rolled up into a daily or weekly digest, and a window of quiet hours in their own timezone. A missing row means the
+-- user hasn't chosen, and everything is delivered immediately.
+-- Emails held back by those preferences wait in queued_notifications until a worker sends them as a digest.
+
+CREATE TYPE notification_digest_mode AS ENUM (
+    'none',
+    'daily',
+    'weekly'
+);
+
+CREATE TABLE IF NOT EXISTS notification_preferences (
+    id TEXT NOT NULL PRIMARY KEY,
+    belongs_to_user TEXT NOT NULL REFERENCES users("id") ON DELETE CASCADE,
+    notification_type TEXT NOT NULL,
+    push_enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    email_enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    in_app_enabled BOOLEAN NOT NULL DEFAULT TRUE,
+    digest_mode notification_digest_mode NOT NULL DEFAULT 'none',
+    timezone TEXT NOT NULL DEFAULT 'UTC',
+    quiet_hours_start TEXT,
+    quiet_hours_end TEXT,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW(),
+    last_updated_at TIMESTAMP WITH TIME ZONE,
+    UNIQUE (belongs_to_user, notification_type)
+);
+
+CREATE TABLE IF NOT EXISTS queued_notifications (
+    id TEXT NOT NULL PRIMARY KEY,
+    belongs_to_user TEXT NOT NULL REFERENCES users("id") ON DELETE CASCADE,
+    notification_type TEXT NOT NULL,
+    content TEXT NOT NULL,
+    action_url TEXT NOT NULL DEFAULT '',
+    deliver_after TIMESTAMP WITH TIME ZONE NOT NULL,
+    delivered_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_queued_notifications_deliver_after ON queued_notifications (deliver_after) WHERE delivered_at IS NULL;
+CREATE INDEX idx_queued_notifications_belongs_to_user ON queued_notifications (belongs_to_user) WHERE delivered_at IS NULL;
+
+-- The digest sender runs as its own database user, like the other workers.
+DO $$
+BEGIN
+  IF NOT EXISTS (SELECT FROM pg_roles WHERE rolname = 'notification_digest_sender') THEN
+    CREATE ROLE notification_digest_sender WITH LOGIN;
+  END IF;
+END $$;
+
+GRANT ALL PRIVILEGES ON ALL TABLES IN SCHEMA public TO notification_digest_sender;
+GRANT ALL PRIVILEGES ON ALL SEQUENCES IN SCHEMA public TO notification_digest_sender;
+ALTER DEFAULT PRIVILEGES IN SCHEMA public GRANT ALL PRIVILEGES ON TABLES TO notification_digest_sender;
+ALTER DEFAULT PRIVILEGES IN SCHEMA public GRANT ALL PRIVILEGES ON SEQUENCES TO notification_digest_sender;
@@ -0,0 +1,21 @@
+-- In-App Notifications Migration
+-- Notifications fanned out from domain events say what kind of notification they are and point at the thing they're
+-- about, so clients can group them and deep link into the right screen. Older notifications keep empty values.
+-- They also remember the data change message they were fanned out from, so a redelivered message can't notify anyone
+-- twice; notifications created any other way have no source message.
+-- Meal plans remember when their members were reminded that voting is about to close, so the reminder goes out once.
+
+ALTER TABLE user_notifications
+    ADD COLUMN notification_type TEXT NOT NULL DEFAULT '',
+    ADD COLUMN target_type TEXT NOT NULL DEFAULT '',
+    ADD COLUMN target_id TEXT NOT NULL DEFAULT '',
+    ADD COLUMN deep_link TEXT NOT NULL DEFAULT '',
+    ADD COLUMN source_message_id TEXT;
+
+CREATE INDEX idx_user_notifications_created_at ON user_notifications (created_at);
+
+CREATE UNIQUE INDEX idx_user_notifications_source_message_id_belongs_to_user ON user_notifications (source_message_id, belongs_to_user);
+
+ALTER TABLE meal_plans ADD COLUMN voting_deadline_reminder_sent_at TIMESTAMP WITH TIME ZONE;
+
+CREATE INDEX idx_meal_plans_voting_deadline_reminders ON meal_plans (voting_deadline) WHERE archived_at IS NULL AND status = 'awaiting_votes' AND voting_deadline_reminder_sent_at IS NULL;
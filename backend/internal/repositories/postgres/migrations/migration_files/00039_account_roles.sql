@@ -0,0 +1,24 @@
+-- Account Roles Migration
+-- Accounts can define their own roles, composed from the permissions the built-in account roles grant. Custom roles
+-- belong to the account that created them, and their names only need to be unique within that account.
+
+ALTER TABLE user_roles
+    ADD COLUMN belongs_to_account TEXT REFERENCES accounts("id") ON DELETE CASCADE,
+    ADD CONSTRAINT user_roles_belongs_to_account_scope CHECK (belongs_to_account IS NULL OR scope = 'account'),
+    DROP CONSTRAINT user_roles_name_key;
+
+CREATE UNIQUE INDEX idx_user_roles_unique_built_in_name ON user_roles (name) WHERE belongs_to_account IS NULL;
+CREATE UNIQUE INDEX idx_user_roles_unique_account_name ON user_roles (belongs_to_account, name) WHERE archived_at IS NULL AND belongs_to_account IS NOT NULL;
+CREATE INDEX idx_user_roles_belongs_to_account ON user_roles (belongs_to_account) WHERE archived_at IS NULL;
+
+INSERT INTO permissions (id, name, description) VALUES
+    ('d7r2m4kn9qd8a1c0e5g0', 'create.account_roles', 'Create account roles'),
+    ('d7r2m4kn9qd8a1c0e5gg', 'read.account_roles', 'Read account roles'),
+    ('d7r2m4kn9qd8a1c0e5h0', 'update.account_roles', 'Update account roles'),
+    ('d7r2m4kn9qd8a1c0e5hg', 'archive.account_roles', 'Archive account roles');
+
+INSERT INTO user_role_permissions (id, role_id, permission_id) VALUES
+    ('d7r2m4kn9qd8a1c0e5i0', 'role_account_admin', 'd7r2m4kn9qd8a1c0e5g0'),
+    ('d7r2m4kn9qd8a1c0e5ig', 'role_account_member', 'd7r2m4kn9qd8a1c0e5gg'),
+    ('d7r2m4kn9qd8a1c0e5j0', 'role_account_admin', 'd7r2m4kn9qd8a1c0e5h0'),
+    ('d7r2m4kn9qd8a1c0e5jg', 'role_account_admin', 'd7r2m4kn9qd8a1c0e5hg');
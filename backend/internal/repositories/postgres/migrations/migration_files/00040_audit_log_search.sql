@@ -0,0 +1,6 @@
+-- Audit Log Search Migration
+-- Audit log searches can ask which entries touched a given field, which is a key lookup in the changes document.
+-- The db_cleaner job deletes entries per resource type once they've outlived their retention period.
+
+CREATE INDEX idx_audit_log_changes ON audit_log_entries USING GIN (changes);
+CREATE INDEX idx_audit_log_resource_type_created_at ON audit_log_entries (resource_type, created_at);
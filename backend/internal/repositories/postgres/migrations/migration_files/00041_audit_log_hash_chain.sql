@@ -0,0 +1,35 @@
+-- Audit Log Hash Chain Migration
+-- Every audit log entry that belongs to an account is numbered and hashed together with the previous entry's hash,
+-- so rewriting history means rewriting every later entry too. Entries written before this migration stay unchained.
+-- Expired chained entries are redacted rather than deleted: their changes are dropped, but changes_hash keeps the link intact.
+
+ALTER TABLE audit_log_entries ADD COLUMN sequence BIGINT;
+ALTER TABLE audit_log_entries ADD COLUMN previous_hash TEXT NOT NULL DEFAULT '';
+ALTER TABLE audit_log_entries ADD COLUMN changes_hash TEXT NOT NULL DEFAULT '';
+ALTER TABLE audit_log_entries ADD COLUMN hash TEXT NOT NULL DEFAULT '';
+ALTER TABLE audit_log_entries ADD COLUMN redacted_at TIMESTAMP WITH TIME ZONE;
+
+CREATE UNIQUE INDEX idx_audit_log_account_sequence ON audit_log_entries (belongs_to_account, sequence) WHERE sequence IS NOT NULL;
+
+-- Checkpoints are signed with a key the database never sees, so a rewritten chain no longer matches them.
+CREATE TABLE IF NOT EXISTS audit_log_checkpoints (
+    id TEXT NOT NULL PRIMARY KEY,
+    belongs_to_account TEXT NOT NULL REFERENCES accounts("id") ON DELETE CASCADE,
+    sequence BIGINT NOT NULL,
+    entry_hash TEXT NOT NULL,
+    signature TEXT NOT NULL,
+    key_id TEXT NOT NULL,
+    created_at TIMESTAMP WITH TIME ZONE DEFAULT NOW() NOT NULL,
+    UNIQUE (belongs_to_account, sequence)
+);
+
+-- =============================================================================
+-- SEED DATA: audit log chain permissions
+-- =============================================================================
+
+INSERT INTO permissions (id, name, description) VALUES
+    ('d8a3c5mn9qd9b2e0f6g0', 'verify.audit_log_chain', 'Verify audit log chains');
+
+-- service_admin: audit log chain permissions
+INSERT INTO user_role_permissions (id, role_id, permission_id) VALUES
+    ('d8a3c5mn9qd9b2e0f7g0', 'role_service_admin', 'd8a3c5mn9qd9b2e0f6g0');
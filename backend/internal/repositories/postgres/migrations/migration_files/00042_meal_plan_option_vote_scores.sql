@@ -0,0 +1,4 @@
+-- Meal Plan Option Vote Scores Migration
+-- Score elections read an explicit score from each vote instead of reusing its rank.
+
+ALTER TABLE meal_plan_option_votes ADD COLUMN score INTEGER NOT NULL DEFAULT 0 CHECK (score >= 0 AND score <= 10);
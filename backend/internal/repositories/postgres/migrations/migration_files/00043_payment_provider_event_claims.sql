@@ -0,0 +1,8 @@
+-- Payment Provider Event Claims Migration
+-- Events move to 'processing' while a worker applies them, so concurrent deliveries or replays of the same
+-- event can't both apply it. processed_at records when the claim was taken until processing finishes.
+-- Events that call for no state change, like a cancellation for a subscription that doesn't exist yet, are marked
+-- 'ignored' rather than 'applied', and can be claimed again when they're redelivered or replayed.
+
+ALTER TYPE payment_provider_event_status ADD VALUE IF NOT EXISTS 'processing';
+ALTER TYPE payment_provider_event_status ADD VALUE IF NOT EXISTS 'ignored';
@@ -0,0 +1,12 @@
+-- Meal Plan Activity Sequence Migration
+-- Activity IDs aren't ordered by insertion, so watchers resuming from an ID could skip rows that were
+-- committed later with a smaller ID. Watchers page on a sequence instead. Sequence values are drawn at insert time
+-- rather than at commit, so on their own they can still commit out of order; writers take a per meal plan advisory
+-- lock for the rest of their transaction, which makes each meal plan's sequence commit in order.
+-- created_at is indexed so old activity can be expired.
+
+ALTER TABLE meal_plan_activities ADD COLUMN IF NOT EXISTS sequence BIGSERIAL NOT NULL;
+
+DROP INDEX IF EXISTS idx_meal_plan_activities_belongs_to_meal_plan;
+CREATE INDEX IF NOT EXISTS idx_meal_plan_activities_belongs_to_meal_plan_sequence ON meal_plan_activities (belongs_to_meal_plan, sequence);
+CREATE INDEX IF NOT EXISTS idx_meal_plan_activities_created_at ON meal_plan_activities (created_at);
@@ -11,6 +11,67 @@
 	"time"
 )
 
+type NotificationDigestMode string
+
+const (
+	NotificationDigestModeNone   NotificationDigestMode = "none"
+	NotificationDigestModeDaily  NotificationDigestMode = "daily"
+	NotificationDigestModeWeekly NotificationDigestMode = "weekly"
+)
+
+func (e *NotificationDigestMode) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = NotificationDigestMode(s)
+	case string:
+		*e = NotificationDigestMode(s)
+	default:
+		return fmt.Errorf("unsupported scan type for NotificationDigestMode: %T", src)
+	}
+	return nil
+}
+
+type NullNotificationDigestMode struct {
+	NotificationDigestMode NotificationDigestMode
+	Valid                  bool // Valid is true if NotificationDigestMode is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullNotificationDigestMode) Scan(value interface{}) error {
+	if value == nil {
+		ns.NotificationDigestMode, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.NotificationDigestMode.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullNotificationDigestMode) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.NotificationDigestMode), nil
+}
+
+func (e NotificationDigestMode) Valid() bool {
+	switch e {
+	case NotificationDigestModeNone,
+		NotificationDigestModeDaily,
+		NotificationDigestModeWeekly:
+		return true
+	}
+	return false
+}
+
+func AllNotificationDigestModeValues() []NotificationDigestMode {
+	return []NotificationDigestMode{
+		NotificationDigestModeNone,
+		NotificationDigestModeDaily,
+		NotificationDigestModeWeekly,
+	}
+}
+
 type UserNotificationStatus string
 
 const (
@@ -72,6 +133,32 @@ func AllUserNotificationStatusValues() []UserNotificationStatus {
 	}
 }
 
+type NotificationPreferences struct {
+	ID               string
+	BelongsToUser    string
+	NotificationType string
+	PushEnabled      bool
+	EmailEnabled     bool
+	InAppEnabled     bool
+	DigestMode       NotificationDigestMode
+	Timezone         string
+	QuietHoursStart  sql.NullString
+	QuietHoursEnd    sql.NullString
+	CreatedAt        time.Time
+	LastUpdatedAt    sql.NullTime
+}
+
+type QueuedNotifications struct {
+	ID               string
+	BelongsToUser    string
+	NotificationType string
+	Content          string
+	ActionURL        string
+	DeliverAfter     time.Time
+	DeliveredAt      sql.NullTime
+	CreatedAt        time.Time
+}
+
 type UserDeviceTokens struct {
 	ID            string
 	BelongsToUser string
@@ -83,10 +170,15 @@ type UserDeviceTokens struct {
 }
 
 type UserNotifications struct {
-	ID            string
-	Content       string
-	Status        UserNotificationStatus
-	BelongsToUser string
-	CreatedAt     time.Time
-	LastUpdatedAt sql.NullTime
+	ID               string
+	Content          string
+	Status           UserNotificationStatus
+	BelongsToUser    string
+	CreatedAt        time.Time
+	LastUpdatedAt    sql.NullTime
+	NotificationType string
+	TargetType       string
+	TargetID         string
+	DeepLink         string
+	SourceMessageID  sql.NullString
 }
@@ -6,20 +6,30 @@
 
 import (
 	"context"
+	"time"
 )
 
 type Querier interface {
 	ArchiveUserDeviceToken(ctx context.Context, db DBTX, arg *ArchiveUserDeviceTokenParams) (int64, error)
 	CheckUserDeviceTokenExistence(ctx context.Context, db DBTX, arg *CheckUserDeviceTokenExistenceParams) (bool, error)
 	CheckUserNotificationExistence(ctx context.Context, db DBTX, arg *CheckUserNotificationExistenceParams) (bool, error)
+	CreateQueuedNotification(ctx context.Context, db DBTX, arg *CreateQueuedNotificationParams) error
 	CreateUserDeviceToken(ctx context.Context, db DBTX, arg *CreateUserDeviceTokenParams) error
-	CreateUserNotification(ctx context.Context, db DBTX, arg *CreateUserNotificationParams) error
+	CreateUserNotification(ctx context.Context, db DBTX, arg *CreateUserNotificationParams) (int64, error)
+	GetDueQueuedNotificationsForUser(ctx context.Context, db DBTX, arg *GetDueQueuedNotificationsForUserParams) ([]*QueuedNotifications, error)
+	GetNotificationPreference(ctx context.Context, db DBTX, arg *GetNotificationPreferenceParams) (*NotificationPreferences, error)
+	GetNotificationPreferencesForUser(ctx context.Context, db DBTX, belongsToUser string) ([]*NotificationPreferences, error)
+	GetUnreadUserNotificationCount(ctx context.Context, db DBTX, belongsToUser string) (int64, error)
 	GetUserDeviceToken(ctx context.Context, db DBTX, arg *GetUserDeviceTokenParams) (*UserDeviceTokens, error)
 	GetUserDeviceTokensForUser(ctx context.Context, db DBTX, arg *GetUserDeviceTokensForUserParams) ([]*GetUserDeviceTokensForUserRow, error)
+	GetUserIDsWithDueQueuedNotifications(ctx context.Context, db DBTX, now time.Time) ([]string, error)
 	GetUserNotification(ctx context.Context, db DBTX, arg *GetUserNotificationParams) (*UserNotifications, error)
 	GetUserNotificationsForUser(ctx context.Context, db DBTX, arg *GetUserNotificationsForUserParams) ([]*GetUserNotificationsForUserRow, error)
+	MarkAllUserNotificationsAsRead(ctx context.Context, db DBTX, belongsToUser string) (int64, error)
+	MarkQueuedNotificationsAsDelivered(ctx context.Context, db DBTX, arg *MarkQueuedNotificationsAsDeliveredParams) (int64, error)
 	UpdateUserDeviceToken(ctx context.Context, db DBTX, arg *UpdateUserDeviceTokenParams) (int64, error)
 	UpdateUserNotification(ctx context.Context, db DBTX, arg *UpdateUserNotificationParams) (int64, error)
+	UpsertNotificationPreference(ctx context.Context, db DBTX, arg *UpsertNotificationPreferenceParams) (*NotificationPreferences, error)
 	UpsertUserDeviceToken(ctx context.Context, db DBTX, arg *UpsertUserDeviceTokenParams) (*UserDeviceTokens, error)
 }
 
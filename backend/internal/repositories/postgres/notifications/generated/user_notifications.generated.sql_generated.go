@@ -32,27 +32,68 @@ func (q *Queries) CheckUserNotificationExistence(ctx context.Context, db DBTX, a
 	return exists, err
 }
 
-const createUserNotification = `-- name: CreateUserNotification :exec
+const createUserNotification = `-- name: CreateUserNotification :execrows
 INSERT INTO user_notifications (
 	id,
 	content,
-	belongs_to_user
+	belongs_to_user,
+	notification_type,
+	target_type,
+	target_id,
+	deep_link,
+	source_message_id
 ) VALUES (
 	$1,
 	$2,
-	$3
-)
+	$3,
+	$4,
+	$5,
+	$6,
+	$7,
+	$8
+) ON CONFLICT (source_message_id, belongs_to_user) DO NOTHING
 `
 
 type CreateUserNotificationParams struct {
-	ID            string
-	Content       string
-	BelongsToUser string
+	ID               string
+	Content          string
+	BelongsToUser    string
+	NotificationType string
+	TargetType       string
+	TargetID         string
+	DeepLink         string
+	SourceMessageID  sql.NullString
+}
+
+func (q *Queries) CreateUserNotification(ctx context.Context, db DBTX, arg *CreateUserNotificationParams) (int64, error) {
+	result, err := db.ExecContext(ctx, createUserNotification,
+		arg.ID,
+		arg.Content,
+		arg.BelongsToUser,
+		arg.NotificationType,
+		arg.TargetType,
+		arg.TargetID,
+		arg.DeepLink,
+		arg.SourceMessageID,
+	)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
 }
 
-func (q *Queries) CreateUserNotification(ctx context.Context, db DBTX, arg *CreateUserNotificationParams) error {
-	_, err := db.ExecContext(ctx, createUserNotification, arg.ID, arg.Content, arg.BelongsToUser)
-	return err
+const getUnreadUserNotificationCount = `-- name: GetUnreadUserNotificationCount :one
+SELECT COUNT(user_notifications.id)
+FROM user_notifications
+WHERE user_notifications.belongs_to_user = $1
+	AND user_notifications.status = 'unread'
+`
+
+func (q *Queries) GetUnreadUserNotificationCount(ctx context.Context, db DBTX, belongsToUser string) (int64, error) {
+	row := db.QueryRowContext(ctx, getUnreadUserNotificationCount, belongsToUser)
+	var count int64
+	err := row.Scan(&count)
+	return count, err
 }
 
 const getUserNotification = `-- name: GetUserNotification :one
@@ -62,7 +103,11 @@ func (q *Queries) CreateUserNotification(ctx context.Context, db DBTX, arg *Crea
 	user_notifications.status,
 	user_notifications.belongs_to_user,
 	user_notifications.created_at,
-	user_notifications.last_updated_at
+	user_notifications.last_updated_at,
+	user_notifications.notification_type,
+	user_notifications.target_type,
+	user_notifications.target_id,
+	user_notifications.deep_link
 FROM user_notifications
 WHERE belongs_to_user = $1
 AND user_notifications.id = $2
@@ -83,6 +128,10 @@ func (q *Queries) GetUserNotification(ctx context.Context, db DBTX, arg *GetUser
 		&i.BelongsToUser,
 		&i.CreatedAt,
 		&i.LastUpdatedAt,
+		&i.NotificationType,
+		&i.TargetType,
+		&i.TargetID,
+		&i.DeepLink,
 	)
 	return &i, err
 }
@@ -95,6 +144,10 @@ func (q *Queries) GetUserNotification(ctx context.Context, db DBTX, arg *GetUser
 	user_notifications.belongs_to_user,
 	user_notifications.created_at,
 	user_notifications.last_updated_at,
+	user_notifications.notification_type,
+	user_notifications.target_type,
+	user_notifications.target_id,
+	user_notifications.deep_link,
 	(
 		SELECT COUNT(user_notifications.id)
 		FROM user_notifications
@@ -148,14 +201,18 @@ type GetUserNotificationsForUserParams struct {
 }
 
 type GetUserNotificationsForUserRow struct {
-	ID            string
-	Content       string
-	Status        UserNotificationStatus
-	BelongsToUser string
-	CreatedAt     time.Time
-	LastUpdatedAt sql.NullTime
-	FilteredCount int64
-	TotalCount    int64
+	ID               string
+	Content          string
+	Status           UserNotificationStatus
+	BelongsToUser    string
+	CreatedAt        time.Time
+	LastUpdatedAt    sql.NullTime
+	NotificationType string
+	TargetType       string
+	TargetID         string
+	DeepLink         string
+	FilteredCount    int64
+	TotalCount       int64
 }
 
 func (q *Queries) GetUserNotificationsForUser(ctx context.Context, db DBTX, arg *GetUserNotificationsForUserParams) ([]*GetUserNotificationsForUserRow, error) {
@@ -182,6 +239,10 @@ func (q *Queries) GetUserNotificationsForUser(ctx context.Context, db DBTX, arg
 			&i.BelongsToUser,
 			&i.CreatedAt,
 			&i.LastUpdatedAt,
+			&i.NotificationType,
+			&i.TargetType,
+			&i.TargetID,
+			&i.DeepLink,
 			&i.FilteredCount,
 			&i.TotalCount,
 		); err != nil {
@@ -198,6 +259,22 @@ func (q *Queries) GetUserNotificationsForUser(ctx context.Context, db DBTX, arg
 	return items, nil
 }
 
+const markAllUserNotificationsAsRead = `-- name: MarkAllUserNotificationsAsRead :execrows
+UPDATE user_notifications SET
+	status = 'read',
+	last_updated_at = NOW()
+WHERE belongs_to_user = $1
+	AND status = 'unread'
+`
+
+func (q *Queries) MarkAllUserNotificationsAsRead(ctx context.Context, db DBTX, belongsToUser string) (int64, error) {
+	result, err := db.ExecContext(ctx, markAllUserNotificationsAsRead, belongsToUser)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
 const updateUserNotification = `-- name: UpdateUserNotification :execrows
 UPDATE user_notifications SET
 	status = $1,
@@ -0,0 +1,175 @@
+package notifications
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/audit"
+	identitykeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity/keys"
+	types "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/notifications"
+	notificationkeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/notifications/keys"
+	generated "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/repositories/postgres/notifications/generated"
+
+	"github.com/primandproper/platform/database"
+	platformerrors "github.com/primandproper/platform/errors"
+	"github.com/primandproper/platform/identifiers"
+	"github.com/primandproper/platform/observability"
+	"github.com/primandproper/platform/observability/tracing"
+)
+
+const (
+	resourceTypeNotificationPreferences = "notification_preferences"
+)
+
+var (
+	_ types.NotificationPreferenceDataManager = (*Repository)(nil)
+)
+
+func convertNotificationPreference(result *generated.NotificationPreferences) *types.NotificationPreference {
+	return &types.NotificationPreference{
+		CreatedAt:        result.CreatedAt,
+		LastUpdatedAt:    database.TimePointerFromNullTime(result.LastUpdatedAt),
+		QuietHoursStart:  database.StringPointerFromNullString(result.QuietHoursStart),
+		QuietHoursEnd:    database.StringPointerFromNullString(result.QuietHoursEnd),
+		ID:               result.ID,
+		BelongsToUser:    result.BelongsToUser,
+		NotificationType: result.NotificationType,
+		DigestMode:       string(result.DigestMode),
+		Timezone:         result.Timezone,
+		PushEnabled:      result.PushEnabled,
+		EmailEnabled:     result.EmailEnabled,
+		InAppEnabled:     result.InAppEnabled,
+	}
+}
+
+// GetNotificationPreference fetches a user's preference for a notification type from the database.
+// Users who haven't set a preference for the type get the default one.
+func (q *Repository) GetNotificationPreference(ctx context.Context, userID, notificationType string) (*types.NotificationPreference, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := q.logger.Clone()
+
+	if userID == "" {
+		return nil, platformerrors.ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(identitykeys.UserIDKey, userID)
+	tracing.AttachToSpan(span, identitykeys.UserIDKey, userID)
+
+	if notificationType == "" {
+		return nil, platformerrors.ErrEmptyInputProvided
+	}
+	logger = logger.WithValue(notificationkeys.NotificationTypeKey, notificationType)
+	tracing.AttachToSpan(span, notificationkeys.NotificationTypeKey, notificationType)
+
+	result, err := q.generatedQuerier.GetNotificationPreference(ctx, q.readDB, &generated.GetNotificationPreferenceParams{
+		BelongsToUser:    userID,
+		NotificationType: notificationType,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return types.DefaultNotificationPreference(userID, notificationType), nil
+		}
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching notification preference")
+	}
+
+	return convertNotificationPreference(result), nil
+}
+
+// GetNotificationPreferencesForUser fetches a user's preference for every notification type from the database,
+// filling in the default for any type they haven't set one for.
+func (q *Repository) GetNotificationPreferencesForUser(ctx context.Context, userID string) ([]*types.NotificationPreference, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := q.logger.Clone()
+
+	if userID == "" {
+		return nil, platformerrors.ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(identitykeys.UserIDKey, userID)
+	tracing.AttachToSpan(span, identitykeys.UserIDKey, userID)
+
+	results, err := q.generatedQuerier.GetNotificationPreferencesForUser(ctx, q.readDB, userID)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching notification preferences for user")
+	}
+
+	saved := map[string]*types.NotificationPreference{}
+	for _, result := range results {
+		saved[result.NotificationType] = convertNotificationPreference(result)
+	}
+
+	preferences := []*types.NotificationPreference{}
+	for _, notificationType := range types.NotificationTypes {
+		preference, ok := saved[notificationType]
+		if !ok {
+			preference = types.DefaultNotificationPreference(userID, notificationType)
+		}
+		preferences = append(preferences, preference)
+	}
+
+	return preferences, nil
+}
+
+// UpsertNotificationPreference creates or replaces a user's preference for a notification type.
+func (q *Repository) UpsertNotificationPreference(ctx context.Context, input *types.NotificationPreferenceDatabaseUpsertInput) (*types.NotificationPreference, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if input == nil {
+		return nil, platformerrors.ErrNilInputProvided
+	}
+	tracing.AttachToSpan(span, notificationkeys.NotificationPreferenceIDKey, input.ID)
+	logger := q.logger.WithValue(notificationkeys.NotificationPreferenceIDKey, input.ID).WithValue(notificationkeys.NotificationTypeKey, input.NotificationType)
+
+	tx, err := q.writeDB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "beginning transaction")
+	}
+
+	result, err := q.generatedQuerier.UpsertNotificationPreference(ctx, tx, &generated.UpsertNotificationPreferenceParams{
+		ID:               input.ID,
+		BelongsToUser:    input.BelongsToUser,
+		NotificationType: input.NotificationType,
+		PushEnabled:      input.PushEnabled,
+		EmailEnabled:     input.EmailEnabled,
+		InAppEnabled:     input.InAppEnabled,
+		DigestMode:       generated.NotificationDigestMode(input.DigestMode),
+		Timezone:         input.Timezone,
+		QuietHoursStart:  database.NullStringFromStringPointer(input.QuietHoursStart),
+		QuietHoursEnd:    database.NullStringFromStringPointer(input.QuietHoursEnd),
+	})
+	if err != nil {
+		q.RollbackTransaction(ctx, tx)
+		return nil, observability.PrepareAndLogError(err, logger, span, "performing notification preference upsert query")
+	}
+
+	x := convertNotificationPreference(result)
+
+	// the upsert may have kept an existing row's ID, so the audit log entry refers to whatever came back.
+	eventType := audit.AuditLogEventTypeCreated
+	if x.LastUpdatedAt != nil {
+		eventType = audit.AuditLogEventTypeUpdated
+	}
+
+	if _, err = q.auditLogEntryRepo.CreateAuditLogEntry(ctx, tx, &audit.AuditLogEntryDatabaseCreationInput{
+		ID:            identifiers.New(),
+		ResourceType:  resourceTypeNotificationPreferences,
+		RelevantID:    x.ID,
+		EventType:     eventType,
+		BelongsToUser: x.BelongsToUser,
+	}); err != nil {
+		q.RollbackTransaction(ctx, tx)
+		return nil, observability.PrepareError(err, span, "creating audit log entry")
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "committing transaction")
+	}
+
+	logger.Info("notification preference upserted")
+
+	return x, nil
+}
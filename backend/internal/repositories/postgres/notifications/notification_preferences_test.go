@@ -0,0 +1,137 @@
+package notifications
+
+import (
+	"testing"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/audit"
+	types "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/notifications"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/notifications/converters"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/notifications/fakes"
+	pgtesting "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/repositories/postgres/testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuerier_Integration_NotificationPreferences(t *testing.T) {
+	if !pgtesting.RunContainerTests {
+		t.SkipNow()
+	}
+
+	ctx := t.Context()
+	dbc, auditRepo, container := buildDatabaseClientForTest(t)
+
+	databaseURI, err := container.ConnectionString(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, databaseURI)
+
+	defer func(t *testing.T) {
+		t.Helper()
+		assert.NoError(t, container.Terminate(ctx))
+	}(t)
+
+	user := pgtesting.CreateUserForTest(t, nil, dbc.writeDB)
+
+	// defaults before anything is saved
+	preference, err := dbc.GetNotificationPreference(ctx, user.ID, types.NotificationTypeMealPlanCreated)
+	require.NoError(t, err)
+	assert.Equal(t, types.DefaultNotificationPreference(user.ID, types.NotificationTypeMealPlanCreated), preference)
+
+	// create
+	examplePreference := fakes.BuildFakeNotificationPreference()
+	examplePreference.BelongsToUser = user.ID
+	examplePreference.NotificationType = types.NotificationTypeMealPlanCreated
+
+	created, err := dbc.UpsertNotificationPreference(ctx, converters.ConvertNotificationPreferenceToNotificationPreferenceDatabaseUpsertInput(examplePreference))
+	require.NoError(t, err)
+	examplePreference.CreatedAt = created.CreatedAt
+	assert.Equal(t, examplePreference, created)
+
+	// update
+	updatedPreference := fakes.BuildFakeNotificationPreference()
+	updatedPreference.ID = fakes.BuildFakeID()
+	updatedPreference.BelongsToUser = user.ID
+	updatedPreference.NotificationType = types.NotificationTypeMealPlanCreated
+	updatedPreference.DigestMode = types.NotificationDigestModeWeekly
+	updatedPreference.QuietHoursStart, updatedPreference.QuietHoursEnd = nil, nil
+
+	updated, err := dbc.UpsertNotificationPreference(ctx, converters.ConvertNotificationPreferenceToNotificationPreferenceDatabaseUpsertInput(updatedPreference))
+	require.NoError(t, err)
+	assert.Equal(t, created.ID, updated.ID)
+	assert.Equal(t, types.NotificationDigestModeWeekly, updated.DigestMode)
+	assert.Nil(t, updated.QuietHoursStart)
+	assert.NotNil(t, updated.LastUpdatedAt)
+
+	pgtesting.AssertAuditLogContainsForUser(t, ctx, auditRepo, user.ID, []*audit.AuditLogEntry{
+		{EventType: audit.AuditLogEventTypeCreated, ResourceType: resourceTypeNotificationPreferences, RelevantID: created.ID},
+		{EventType: audit.AuditLogEventTypeUpdated, ResourceType: resourceTypeNotificationPreferences, RelevantID: created.ID},
+	})
+
+	// fetch all
+	preferences, err := dbc.GetNotificationPreferencesForUser(ctx, user.ID)
+	require.NoError(t, err)
+	require.Len(t, preferences, len(types.NotificationTypes))
+	for _, p := range preferences {
+		if p.NotificationType == types.NotificationTypeMealPlanCreated {
+			assert.Equal(t, updated, p)
+		} else {
+			assert.Equal(t, types.DefaultNotificationPreference(user.ID, p.NotificationType), p)
+		}
+	}
+}
+
+func TestQuerier_GetNotificationPreference(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid user ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		actual, err := c.GetNotificationPreference(ctx, "", types.NotificationTypeMealPlanCreated)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+
+	T.Run("with invalid notification type", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		actual, err := c.GetNotificationPreference(ctx, fakes.BuildFakeID(), "")
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
+
+func TestQuerier_GetNotificationPreferencesForUser(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid user ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		actual, err := c.GetNotificationPreferencesForUser(ctx, "")
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
+
+func TestQuerier_UpsertNotificationPreference(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid input", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		actual, err := c.UpsertNotificationPreference(ctx, nil)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
@@ -0,0 +1,164 @@
+package notifications
+
+import (
+	"context"
+	"time"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/audit"
+	identitykeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity/keys"
+	types "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/notifications"
+	notificationkeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/notifications/keys"
+	generated "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/repositories/postgres/notifications/generated"
+
+	"github.com/primandproper/platform/database"
+	platformerrors "github.com/primandproper/platform/errors"
+	"github.com/primandproper/platform/identifiers"
+	"github.com/primandproper/platform/observability"
+	"github.com/primandproper/platform/observability/tracing"
+)
+
+const (
+	resourceTypeQueuedNotifications = "queued_notifications"
+)
+
+var (
+	_ types.QueuedNotificationDataManager = (*Repository)(nil)
+)
+
+// CreateQueuedNotification queues a notification for a later digest.
+func (q *Repository) CreateQueuedNotification(ctx context.Context, input *types.QueuedNotificationDatabaseCreationInput) (*types.QueuedNotification, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if input == nil {
+		return nil, platformerrors.ErrNilInputProvided
+	}
+	tracing.AttachToSpan(span, notificationkeys.QueuedNotificationIDKey, input.ID)
+	logger := q.logger.WithValue(notificationkeys.QueuedNotificationIDKey, input.ID)
+
+	tx, err := q.writeDB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "beginning transaction")
+	}
+
+	if err = q.generatedQuerier.CreateQueuedNotification(ctx, tx, &generated.CreateQueuedNotificationParams{
+		ID:               input.ID,
+		BelongsToUser:    input.BelongsToUser,
+		NotificationType: input.NotificationType,
+		Content:          input.Content,
+		ActionURL:        input.ActionURL,
+		DeliverAfter:     input.DeliverAfter,
+	}); err != nil {
+		q.RollbackTransaction(ctx, tx)
+		return nil, observability.PrepareAndLogError(err, logger, span, "performing queued notification creation query")
+	}
+
+	x := &types.QueuedNotification{
+		CreatedAt:        q.CurrentTime(),
+		DeliverAfter:     input.DeliverAfter,
+		ID:               input.ID,
+		BelongsToUser:    input.BelongsToUser,
+		NotificationType: input.NotificationType,
+		Content:          input.Content,
+		ActionURL:        input.ActionURL,
+	}
+
+	if _, err = q.auditLogEntryRepo.CreateAuditLogEntry(ctx, tx, &audit.AuditLogEntryDatabaseCreationInput{
+		ID:            identifiers.New(),
+		ResourceType:  resourceTypeQueuedNotifications,
+		RelevantID:    x.ID,
+		EventType:     audit.AuditLogEventTypeCreated,
+		BelongsToUser: x.BelongsToUser,
+	}); err != nil {
+		q.RollbackTransaction(ctx, tx)
+		return nil, observability.PrepareError(err, span, "creating audit log entry")
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "committing transaction")
+	}
+
+	logger.Info("queued notification created")
+
+	return x, nil
+}
+
+// GetUserIDsWithDueQueuedNotifications fetches the IDs of users with undelivered queued notifications due by the given time.
+func (q *Repository) GetUserIDsWithDueQueuedNotifications(ctx context.Context, now time.Time) ([]string, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	results, err := q.generatedQuerier.GetUserIDsWithDueQueuedNotifications(ctx, q.readDB, now)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, q.logger, span, "fetching user IDs with due queued notifications")
+	}
+
+	return results, nil
+}
+
+// GetDueQueuedNotificationsForUser fetches a user's undelivered queued notifications due by the given time, oldest first.
+func (q *Repository) GetDueQueuedNotificationsForUser(ctx context.Context, userID string, now time.Time) ([]*types.QueuedNotification, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := q.logger.Clone()
+
+	if userID == "" {
+		return nil, platformerrors.ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(identitykeys.UserIDKey, userID)
+	tracing.AttachToSpan(span, identitykeys.UserIDKey, userID)
+
+	results, err := q.generatedQuerier.GetDueQueuedNotificationsForUser(ctx, q.readDB, &generated.GetDueQueuedNotificationsForUserParams{
+		BelongsToUser: userID,
+		Now:           now,
+	})
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching due queued notifications for user")
+	}
+
+	queuedNotifications := []*types.QueuedNotification{}
+	for _, result := range results {
+		queuedNotifications = append(queuedNotifications, &types.QueuedNotification{
+			CreatedAt:        result.CreatedAt,
+			DeliverAfter:     result.DeliverAfter,
+			DeliveredAt:      database.TimePointerFromNullTime(result.DeliveredAt),
+			ID:               result.ID,
+			BelongsToUser:    result.BelongsToUser,
+			NotificationType: result.NotificationType,
+			Content:          result.Content,
+			ActionURL:        result.ActionURL,
+		})
+	}
+
+	return queuedNotifications, nil
+}
+
+// MarkQueuedNotificationsAsDelivered marks a user's queued notifications as delivered, so they aren't sent again.
+func (q *Repository) MarkQueuedNotificationsAsDelivered(ctx context.Context, userID string, queuedNotificationIDs []string) error {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := q.logger.Clone()
+
+	if userID == "" {
+		return platformerrors.ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(identitykeys.UserIDKey, userID)
+	tracing.AttachToSpan(span, identitykeys.UserIDKey, userID)
+
+	if len(queuedNotificationIDs) == 0 {
+		return platformerrors.ErrEmptyInputProvided
+	}
+
+	if _, err := q.generatedQuerier.MarkQueuedNotificationsAsDelivered(ctx, q.writeDB, &generated.MarkQueuedNotificationsAsDeliveredParams{
+		BelongsToUser: userID,
+		IDs:           queuedNotificationIDs,
+	}); err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "marking queued notifications as delivered")
+	}
+
+	logger.WithValue("queued_notifications", len(queuedNotificationIDs)).Info("queued notifications marked as delivered")
+
+	return nil
+}
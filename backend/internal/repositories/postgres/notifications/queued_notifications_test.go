@@ -0,0 +1,127 @@
+package notifications
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/audit"
+	types "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/notifications"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/notifications/converters"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/notifications/fakes"
+	pgtesting "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/repositories/postgres/testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuerier_Integration_QueuedNotifications(t *testing.T) {
+	if !pgtesting.RunContainerTests {
+		t.SkipNow()
+	}
+
+	ctx := t.Context()
+	dbc, auditRepo, container := buildDatabaseClientForTest(t)
+
+	databaseURI, err := container.ConnectionString(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, databaseURI)
+
+	defer func(t *testing.T) {
+		t.Helper()
+		assert.NoError(t, container.Terminate(ctx))
+	}(t)
+
+	user := pgtesting.CreateUserForTest(t, nil, dbc.writeDB)
+	now := time.Now()
+
+	// one due, one not yet
+	due := fakes.BuildFakeQueuedNotification()
+	due.BelongsToUser = user.ID
+	due.DeliverAfter = now.Add(-time.Hour)
+
+	notYetDue := fakes.BuildFakeQueuedNotification()
+	notYetDue.BelongsToUser = user.ID
+	notYetDue.DeliverAfter = now.Add(time.Hour)
+
+	for _, x := range []*types.QueuedNotification{due, notYetDue} {
+		created, createErr := dbc.CreateQueuedNotification(ctx, converters.ConvertQueuedNotificationToQueuedNotificationDatabaseCreationInput(x))
+		require.NoError(t, createErr)
+		assert.Equal(t, x.ID, created.ID)
+	}
+
+	pgtesting.AssertAuditLogContainsForUser(t, ctx, auditRepo, user.ID, []*audit.AuditLogEntry{
+		{EventType: audit.AuditLogEventTypeCreated, ResourceType: resourceTypeQueuedNotifications, RelevantID: due.ID},
+		{EventType: audit.AuditLogEventTypeCreated, ResourceType: resourceTypeQueuedNotifications, RelevantID: notYetDue.ID},
+	})
+
+	userIDs, err := dbc.GetUserIDsWithDueQueuedNotifications(ctx, now)
+	require.NoError(t, err)
+	assert.Equal(t, []string{user.ID}, userIDs)
+
+	queued, err := dbc.GetDueQueuedNotificationsForUser(ctx, user.ID, now)
+	require.NoError(t, err)
+	require.Len(t, queued, 1)
+	assert.Equal(t, due.ID, queued[0].ID)
+
+	require.NoError(t, dbc.MarkQueuedNotificationsAsDelivered(ctx, user.ID, []string{due.ID}))
+
+	queued, err = dbc.GetDueQueuedNotificationsForUser(ctx, user.ID, now)
+	require.NoError(t, err)
+	assert.Empty(t, queued)
+
+	userIDs, err = dbc.GetUserIDsWithDueQueuedNotifications(ctx, now.Add(2*time.Hour))
+	require.NoError(t, err)
+	assert.Equal(t, []string{user.ID}, userIDs)
+}
+
+func TestQuerier_CreateQueuedNotification(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid input", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		actual, err := c.CreateQueuedNotification(ctx, nil)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
+
+func TestQuerier_GetDueQueuedNotificationsForUser(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid user ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		actual, err := c.GetDueQueuedNotificationsForUser(ctx, "", time.Now())
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
+
+func TestQuerier_MarkQueuedNotificationsAsDelivered(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid user ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		assert.Error(t, c.MarkQueuedNotificationsAsDelivered(ctx, "", []string{fakes.BuildFakeID()}))
+	})
+
+	T.Run("without IDs", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		assert.Error(t, c.MarkQueuedNotificationsAsDelivered(ctx, fakes.BuildFakeID(), nil))
+	})
+}
@@ -0,0 +1,71 @@
+-- name: UpsertNotificationPreference :one
+INSERT INTO notification_preferences (
+	id,
+	belongs_to_user,
+	notification_type,
+	push_enabled,
+	email_enabled,
+	in_app_enabled,
+	digest_mode,
+	timezone,
+	quiet_hours_start,
+	quiet_hours_end
+) VALUES (
+	sqlc.arg(id),
+	sqlc.arg(belongs_to_user),
+	sqlc.arg(notification_type),
+	sqlc.arg(push_enabled),
+	sqlc.arg(email_enabled),
+	sqlc.arg(in_app_enabled),
+	sqlc.arg(digest_mode),
+	sqlc.arg(timezone),
+	sqlc.arg(quiet_hours_start),
+	sqlc.arg(quiet_hours_end)
+)
+ON CONFLICT (belongs_to_user, notification_type)
+DO UPDATE SET
+	push_enabled = EXCLUDED.push_enabled,
+	email_enabled = EXCLUDED.email_enabled,
+	in_app_enabled = EXCLUDED.in_app_enabled,
+	digest_mode = EXCLUDED.digest_mode,
+	timezone = EXCLUDED.timezone,
+	quiet_hours_start = EXCLUDED.quiet_hours_start,
+	quiet_hours_end = EXCLUDED.quiet_hours_end,
+	last_updated_at = NOW()
+RETURNING id, belongs_to_user, notification_type, push_enabled, email_enabled, in_app_enabled, digest_mode, timezone, quiet_hours_start, quiet_hours_end, created_at, last_updated_at;
+
+-- name: GetNotificationPreference :one
+SELECT
+	notification_preferences.id,
+	notification_preferences.belongs_to_user,
+	notification_preferences.notification_type,
+	notification_preferences.push_enabled,
+	notification_preferences.email_enabled,
+	notification_preferences.in_app_enabled,
+	notification_preferences.digest_mode,
+	notification_preferences.timezone,
+	notification_preferences.quiet_hours_start,
+	notification_preferences.quiet_hours_end,
+	notification_preferences.created_at,
+	notification_preferences.last_updated_at
+FROM notification_preferences
+WHERE notification_preferences.belongs_to_user = sqlc.arg(belongs_to_user)
+	AND notification_preferences.notification_type = sqlc.arg(notification_type);
+
+-- name: GetNotificationPreferencesForUser :many
+SELECT
+	notification_preferences.id,
+	notification_preferences.belongs_to_user,
+	notification_preferences.notification_type,
+	notification_preferences.push_enabled,
+	notification_preferences.email_enabled,
+	notification_preferences.in_app_enabled,
+	notification_preferences.digest_mode,
+	notification_preferences.timezone,
+	notification_preferences.quiet_hours_start,
+	notification_preferences.quiet_hours_end,
+	notification_preferences.created_at,
+	notification_preferences.last_updated_at
+FROM notification_preferences
+WHERE notification_preferences.belongs_to_user = sqlc.arg(belongs_to_user)
+ORDER BY notification_preferences.notification_type ASC;
@@ -0,0 +1,45 @@
+-- name: CreateQueuedNotification :exec
+INSERT INTO queued_notifications (
+	id,
+	belongs_to_user,
+	notification_type,
+	content,
+	action_url,
+	deliver_after
+) VALUES (
+	sqlc.arg(id),
+	sqlc.arg(belongs_to_user),
+	sqlc.arg(notification_type),
+	sqlc.arg(content),
+	sqlc.arg(action_url),
+	sqlc.arg(deliver_after)
+);
+
+-- name: GetUserIDsWithDueQueuedNotifications :many
+SELECT DISTINCT queued_notifications.belongs_to_user
+FROM queued_notifications
+WHERE queued_notifications.delivered_at IS NULL
+	AND queued_notifications.deliver_after <= sqlc.arg(now);
+
+-- name: GetDueQueuedNotificationsForUser :many
+SELECT
+	queued_notifications.id,
+	queued_notifications.belongs_to_user,
+	queued_notifications.notification_type,
+	queued_notifications.content,
+	queued_notifications.action_url,
+	queued_notifications.deliver_after,
+	queued_notifications.delivered_at,
+	queued_notifications.created_at
+FROM queued_notifications
+WHERE queued_notifications.belongs_to_user = sqlc.arg(belongs_to_user)
+	AND queued_notifications.delivered_at IS NULL
+	AND queued_notifications.deliver_after <= sqlc.arg(now)
+ORDER BY queued_notifications.created_at ASC;
+
+-- name: MarkQueuedNotificationsAsDelivered :execrows
+UPDATE queued_notifications SET
+	delivered_at = NOW()
+WHERE belongs_to_user = sqlc.arg(belongs_to_user)
+	AND delivered_at IS NULL
+	AND id = ANY(sqlc.arg(ids)::text[]);
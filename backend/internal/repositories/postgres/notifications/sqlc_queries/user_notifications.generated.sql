@@ -1,13 +1,23 @@
--- name: CreateUserNotification :exec
+-- name: CreateUserNotification :execrows
 INSERT INTO user_notifications (
 	id,
 	content,
-	belongs_to_user
+	belongs_to_user,
+	notification_type,
+	target_type,
+	target_id,
+	deep_link,
+	source_message_id
 ) VALUES (
 	sqlc.arg(id),
 	sqlc.arg(content),
-	sqlc.arg(belongs_to_user)
-);
+	sqlc.arg(belongs_to_user),
+	sqlc.arg(notification_type),
+	sqlc.arg(target_type),
+	sqlc.arg(target_id),
+	sqlc.arg(deep_link),
+	sqlc.arg(source_message_id)
+) ON CONFLICT (source_message_id, belongs_to_user) DO NOTHING;
 
 -- name: GetUserNotification :one
 SELECT
@@ -16,7 +26,11 @@ SELECT
 	user_notifications.status,
 	user_notifications.belongs_to_user,
 	user_notifications.created_at,
-	user_notifications.last_updated_at
+	user_notifications.last_updated_at,
+	user_notifications.notification_type,
+	user_notifications.target_type,
+	user_notifications.target_id,
+	user_notifications.deep_link
 FROM user_notifications
 WHERE belongs_to_user = sqlc.arg(belongs_to_user)
 AND user_notifications.id = sqlc.arg(id);
@@ -37,6 +51,10 @@ SELECT
 	user_notifications.belongs_to_user,
 	user_notifications.created_at,
 	user_notifications.last_updated_at,
+	user_notifications.notification_type,
+	user_notifications.target_type,
+	user_notifications.target_id,
+	user_notifications.deep_link,
 	(
 		SELECT COUNT(user_notifications.id)
 		FROM user_notifications
@@ -83,3 +101,16 @@ UPDATE user_notifications SET
 	status = sqlc.arg(status),
 	last_updated_at = NOW()
 WHERE id = sqlc.arg(id);
+
+-- name: MarkAllUserNotificationsAsRead :execrows
+UPDATE user_notifications SET
+	status = 'read',
+	last_updated_at = NOW()
+WHERE belongs_to_user = sqlc.arg(belongs_to_user)
+	AND status = 'unread';
+
+-- name: GetUnreadUserNotificationCount :one
+SELECT COUNT(user_notifications.id)
+FROM user_notifications
+WHERE user_notifications.belongs_to_user = sqlc.arg(belongs_to_user)
+	AND user_notifications.status = 'unread';
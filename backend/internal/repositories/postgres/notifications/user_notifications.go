@@ -83,12 +83,16 @@ func (q *Repository) GetUserNotification(ctx context.Context, userID, userNotifi
 	}
 
 	userNotification := &types.UserNotification{
-		CreatedAt:     result.CreatedAt,
-		LastUpdatedAt: database.TimePointerFromNullTime(result.LastUpdatedAt),
-		ID:            result.ID,
-		Content:       result.Content,
-		Status:        string(result.Status),
-		BelongsToUser: result.BelongsToUser,
+		CreatedAt:        result.CreatedAt,
+		LastUpdatedAt:    database.TimePointerFromNullTime(result.LastUpdatedAt),
+		ID:               result.ID,
+		Content:          result.Content,
+		Status:           string(result.Status),
+		NotificationType: result.NotificationType,
+		TargetType:       result.TargetType,
+		TargetID:         result.TargetID,
+		DeepLink:         result.DeepLink,
+		BelongsToUser:    result.BelongsToUser,
 	}
 
 	return userNotification, nil
@@ -132,9 +136,16 @@ func (q *Repository) GetUserNotifications(ctx context.Context, userID string, fi
 	)
 	for _, result := range results {
 		userNotification := &types.UserNotification{
-			CreatedAt:     result.CreatedAt,
-			LastUpdatedAt: database.TimePointerFromNullTime(result.LastUpdatedAt),
-			ID:            result.ID,
+			CreatedAt:        result.CreatedAt,
+			LastUpdatedAt:    database.TimePointerFromNullTime(result.LastUpdatedAt),
+			ID:               result.ID,
+			Content:          result.Content,
+			Status:           string(result.Status),
+			NotificationType: result.NotificationType,
+			TargetType:       result.TargetType,
+			TargetID:         result.TargetID,
+			DeepLink:         result.DeepLink,
+			BelongsToUser:    result.BelongsToUser,
 		}
 
 		data = append(data, userNotification)
@@ -155,7 +166,8 @@ func(t *types.UserNotification) string {
 	return x, nil
 }
 
-// CreateUserNotification creates a user notification in the database.
+// CreateUserNotification creates a user notification in the database. It returns ErrDuplicateUserNotification if the
+// user has already been notified about the input's source message.
 func (q *Repository) CreateUserNotification(ctx context.Context, input *types.UserNotificationDatabaseCreationInput) (*types.UserNotification, error) {
 	ctx, span := q.tracer.StartSpan(ctx)
 	defer span.End()
@@ -172,21 +184,37 @@ func (q *Repository) CreateUserNotification(ctx context.Context, input *types.Us
 	}
 
 	// create the user notification.
-	if err = q.generatedQuerier.CreateUserNotification(ctx, tx, &generated.CreateUserNotificationParams{
-		ID:            input.ID,
-		Content:       input.Content,
-		BelongsToUser: input.BelongsToUser,
-	}); err != nil {
+	rowsAffected, err := q.generatedQuerier.CreateUserNotification(ctx, tx, &generated.CreateUserNotificationParams{
+		ID:               input.ID,
+		Content:          input.Content,
+		BelongsToUser:    input.BelongsToUser,
+		NotificationType: input.NotificationType,
+		TargetType:       input.TargetType,
+		TargetID:         input.TargetID,
+		DeepLink:         input.DeepLink,
+		SourceMessageID:  database.NullStringFromStringPointer(input.SourceMessageID),
+	})
+	if err != nil {
 		q.RollbackTransaction(ctx, tx)
 		return nil, observability.PrepareAndLogError(err, logger, span, "performing user notification creation query")
 	}
 
+	// the user was already notified about this source message, so there's nothing to record.
+	if rowsAffected == 0 {
+		q.RollbackTransaction(ctx, tx)
+		return nil, types.ErrDuplicateUserNotification
+	}
+
 	x := &types.UserNotification{
-		ID:            input.ID,
-		CreatedAt:     q.CurrentTime(),
-		Content:       input.Content,
-		Status:        types.UserNotificationStatusTypeUnread,
-		BelongsToUser: input.BelongsToUser,
+		ID:               input.ID,
+		CreatedAt:        q.CurrentTime(),
+		Content:          input.Content,
+		Status:           types.UserNotificationStatusTypeUnread,
+		NotificationType: input.NotificationType,
+		TargetType:       input.TargetType,
+		TargetID:         input.TargetID,
+		DeepLink:         input.DeepLink,
+		BelongsToUser:    input.BelongsToUser,
 	}
 	tracing.AttachToSpan(span, notificationkeys.UserNotificationIDKey, x.ID)
 	logger.Info("user notification created")
@@ -252,3 +280,66 @@ func (q *Repository) UpdateUserNotification(ctx context.Context, updated *types.
 
 	return nil
 }
+
+// MarkAllUserNotificationsAsRead marks every unread notification a user has as read, and returns how many there were.
+func (q *Repository) MarkAllUserNotificationsAsRead(ctx context.Context, userID string) (int64, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if userID == "" {
+		return 0, platformerrors.ErrInvalidIDProvided
+	}
+	logger := q.logger.WithValue(identitykeys.UserIDKey, userID)
+	tracing.AttachToSpan(span, identitykeys.UserIDKey, userID)
+
+	tx, err := q.writeDB.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, observability.PrepareAndLogError(err, logger, span, "beginning transaction")
+	}
+
+	marked, err := q.generatedQuerier.MarkAllUserNotificationsAsRead(ctx, tx, userID)
+	if err != nil {
+		q.RollbackTransaction(ctx, tx)
+		return 0, observability.PrepareAndLogError(err, logger, span, "marking user notifications as read")
+	}
+
+	if marked > 0 {
+		if _, err = q.auditLogEntryRepo.CreateAuditLogEntry(ctx, tx, &audit.AuditLogEntryDatabaseCreationInput{
+			ID:            identifiers.New(),
+			ResourceType:  resourceTypeUserNotifications,
+			RelevantID:    userID,
+			EventType:     audit.AuditLogEventTypeUpdated,
+			BelongsToUser: userID,
+		}); err != nil {
+			q.RollbackTransaction(ctx, tx)
+			return 0, observability.PrepareError(err, span, "creating audit log entry")
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return 0, observability.PrepareAndLogError(err, logger, span, "committing transaction")
+	}
+
+	logger.WithValue("marked", marked).Info("user notifications marked as read")
+
+	return marked, nil
+}
+
+// GetUnreadUserNotificationCount fetches how many unread notifications a user has.
+func (q *Repository) GetUnreadUserNotificationCount(ctx context.Context, userID string) (uint64, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if userID == "" {
+		return 0, platformerrors.ErrInvalidIDProvided
+	}
+	logger := q.logger.WithValue(identitykeys.UserIDKey, userID)
+	tracing.AttachToSpan(span, identitykeys.UserIDKey, userID)
+
+	count, err := q.generatedQuerier.GetUnreadUserNotificationCount(ctx, q.readDB, userID)
+	if err != nil {
+		return 0, observability.PrepareAndLogError(err, logger, span, "counting unread user notifications")
+	}
+
+	return uint64(count), nil
+}
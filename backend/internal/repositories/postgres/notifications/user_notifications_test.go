@@ -92,12 +92,40 @@ func TestQuerier_Integration_UserNotifications(t *testing.T) {
 		createdUserNotifications = append(createdUserNotifications, createUserNotificationForTest(t, ctx, user.ID, input, dbc))
 	}
 
+	// a redelivered data change message doesn't notify the same user twice
+	sourceMessageID := fakes.BuildFakeID()
+	sourcedInput := converters.ConvertUserNotificationToUserNotificationDatabaseCreationInput(fakes.BuildFakeUserNotification())
+	sourcedInput.BelongsToUser = user.ID
+	sourcedInput.SourceMessageID = &sourceMessageID
+	sourced, err := dbc.CreateUserNotification(ctx, sourcedInput)
+	require.NoError(t, err)
+	createdUserNotifications = append(createdUserNotifications, sourced)
+
+	redeliveredInput := converters.ConvertUserNotificationToUserNotificationDatabaseCreationInput(fakes.BuildFakeUserNotification())
+	redeliveredInput.BelongsToUser = user.ID
+	redeliveredInput.SourceMessageID = &sourceMessageID
+	_, err = dbc.CreateUserNotification(ctx, redeliveredInput)
+	assert.ErrorIs(t, err, types.ErrDuplicateUserNotification)
+
 	// fetch as list
 	userNotifications, err := dbc.GetUserNotifications(ctx, user.ID, nil)
 	assert.NoError(t, err)
 	assert.NotEmpty(t, userNotifications.Data)
 	assert.Equal(t, len(createdUserNotifications), len(userNotifications.Data))
 
+	// unread counts
+	unread, err := dbc.GetUnreadUserNotificationCount(ctx, user.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, uint64(len(createdUserNotifications)), unread)
+
+	marked, err := dbc.MarkAllUserNotificationsAsRead(ctx, user.ID)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(len(createdUserNotifications)), marked)
+
+	unread, err = dbc.GetUnreadUserNotificationCount(ctx, user.ID)
+	assert.NoError(t, err)
+	assert.Zero(t, unread)
+
 	// delete
 	for _, userNotification := range createdUserNotifications {
 		var exists bool
@@ -188,3 +216,33 @@ func TestQuerier_UpdateUserNotification(T *testing.T) {
 		assert.Error(t, c.UpdateUserNotification(ctx, nil))
 	})
 }
+
+func TestQuerier_MarkAllUserNotificationsAsRead(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid user ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		actual, err := c.MarkAllUserNotificationsAsRead(ctx, "")
+		assert.Error(t, err)
+		assert.Zero(t, actual)
+	})
+}
+
+func TestQuerier_GetUnreadUserNotificationCount(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid user ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		actual, err := c.GetUnreadUserNotificationCount(ctx, "")
+		assert.Error(t, err)
+		assert.Zero(t, actual)
+	})
+}
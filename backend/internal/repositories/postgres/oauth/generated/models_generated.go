@@ -5,34 +5,14 @@
 package generated
 
 import (
-	"database/sql"
 	"time"
 )
 
-type Oauth2ClientTokens struct {
-	ID                  string
-	ClientID            string
-	BelongsToUser       string
-	RedirectUri         string
-	Code                string
-	CodeChallenge       string
-	CodeChallengeMethod string
-	CodeCreatedAt       time.Time
-	CodeExpiresAt       time.Time
-	Access              string
-	AccessCreatedAt     time.Time
-	AccessExpiresAt     time.Time
-	Refresh             string
-	RefreshCreatedAt    time.Time
-	RefreshExpiresAt    time.Time
-}
-
-type Oauth2Clients struct {
-	ID           string
-	Name         string
-	Description  string
-	ClientID     string
-	ClientSecret string
-	CreatedAt    time.Time
-	ArchivedAt   sql.NullTime
+type OidcSigningKeys struct {
+	ID         string
+	Algorithm  string
+	PrivateKey string
+	CreatedAt  time.Time
+	RetiresAt  time.Time
+	ExpiresAt  time.Time
 }
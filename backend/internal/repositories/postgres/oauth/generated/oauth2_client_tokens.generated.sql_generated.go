@@ -32,6 +32,8 @@ func (q *Queries) CheckOAuth2ClientTokenExistence(ctx context.Context, db DBTX,
 	client_id,
 	belongs_to_user,
 	redirect_uri,
+	scope,
+	nonce,
 	code,
 	code_challenge,
 	code_challenge_method,
@@ -58,7 +60,9 @@ func (q *Queries) CheckOAuth2ClientTokenExistence(ctx context.Context, db DBTX,
 	$12,
 	$13,
 	$14,
-	$15
+	$15,
+	$16,
+	$17
 )
 `
 
@@ -67,6 +71,8 @@ type CreateOAuth2ClientTokenParams struct {
 	ClientID            string
 	BelongsToUser       string
 	RedirectUri         string
+	Scope               string
+	Nonce               string
 	Code                string
 	CodeChallenge       string
 	CodeChallengeMethod string
@@ -86,6 +92,8 @@ func (q *Queries) CreateOAuth2ClientToken(ctx context.Context, db DBTX, arg *Cre
 		arg.ClientID,
 		arg.BelongsToUser,
 		arg.RedirectUri,
+		arg.Scope,
+		arg.Nonce,
 		arg.Code,
 		arg.CodeChallenge,
 		arg.CodeChallengeMethod,
@@ -143,6 +151,8 @@ func (q *Queries) DeleteOAuth2ClientTokenByRefresh(ctx context.Context, db DBTX,
 	oauth2_client_tokens.client_id,
 	oauth2_client_tokens.belongs_to_user,
 	oauth2_client_tokens.redirect_uri,
+	oauth2_client_tokens.scope,
+	oauth2_client_tokens.nonce,
 	oauth2_client_tokens.code,
 	oauth2_client_tokens.code_challenge,
 	oauth2_client_tokens.code_challenge_method,
@@ -158,14 +168,36 @@ func (q *Queries) DeleteOAuth2ClientTokenByRefresh(ctx context.Context, db DBTX,
 WHERE oauth2_client_tokens.access = $1
 `
 
-func (q *Queries) GetOAuth2ClientTokenByAccess(ctx context.Context, db DBTX, access string) (*Oauth2ClientTokens, error) {
+type GetOAuth2ClientTokenByAccessRow struct {
+	ID                  string
+	ClientID            string
+	BelongsToUser       string
+	RedirectUri         string
+	Scope               string
+	Nonce               string
+	Code                string
+	CodeChallenge       string
+	CodeChallengeMethod string
+	CodeCreatedAt       time.Time
+	CodeExpiresAt       time.Time
+	Access              string
+	AccessCreatedAt     time.Time
+	AccessExpiresAt     time.Time
+	Refresh             string
+	RefreshCreatedAt    time.Time
+	RefreshExpiresAt    time.Time
+}
+
+func (q *Queries) GetOAuth2ClientTokenByAccess(ctx context.Context, db DBTX, access string) (*GetOAuth2ClientTokenByAccessRow, error) {
 	row := db.QueryRowContext(ctx, getOAuth2ClientTokenByAccess, access)
-	var i Oauth2ClientTokens
+	var i GetOAuth2ClientTokenByAccessRow
 	err := row.Scan(
 		&i.ID,
 		&i.ClientID,
 		&i.BelongsToUser,
 		&i.RedirectUri,
+		&i.Scope,
+		&i.Nonce,
 		&i.Code,
 		&i.CodeChallenge,
 		&i.CodeChallengeMethod,
@@ -187,6 +219,8 @@ func (q *Queries) GetOAuth2ClientTokenByAccess(ctx context.Context, db DBTX, acc
 	oauth2_client_tokens.client_id,
 	oauth2_client_tokens.belongs_to_user,
 	oauth2_client_tokens.redirect_uri,
+	oauth2_client_tokens.scope,
+	oauth2_client_tokens.nonce,
 	oauth2_client_tokens.code,
 	oauth2_client_tokens.code_challenge,
 	oauth2_client_tokens.code_challenge_method,
@@ -202,14 +236,36 @@ func (q *Queries) GetOAuth2ClientTokenByAccess(ctx context.Context, db DBTX, acc
 WHERE oauth2_client_tokens.code = $1
 `
 
-func (q *Queries) GetOAuth2ClientTokenByCode(ctx context.Context, db DBTX, code string) (*Oauth2ClientTokens, error) {
+type GetOAuth2ClientTokenByCodeRow struct {
+	ID                  string
+	ClientID            string
+	BelongsToUser       string
+	RedirectUri         string
+	Scope               string
+	Nonce               string
+	Code                string
+	CodeChallenge       string
+	CodeChallengeMethod string
+	CodeCreatedAt       time.Time
+	CodeExpiresAt       time.Time
+	Access              string
+	AccessCreatedAt     time.Time
+	AccessExpiresAt     time.Time
+	Refresh             string
+	RefreshCreatedAt    time.Time
+	RefreshExpiresAt    time.Time
+}
+
+func (q *Queries) GetOAuth2ClientTokenByCode(ctx context.Context, db DBTX, code string) (*GetOAuth2ClientTokenByCodeRow, error) {
 	row := db.QueryRowContext(ctx, getOAuth2ClientTokenByCode, code)
-	var i Oauth2ClientTokens
+	var i GetOAuth2ClientTokenByCodeRow
 	err := row.Scan(
 		&i.ID,
 		&i.ClientID,
 		&i.BelongsToUser,
 		&i.RedirectUri,
+		&i.Scope,
+		&i.Nonce,
 		&i.Code,
 		&i.CodeChallenge,
 		&i.CodeChallengeMethod,
@@ -231,6 +287,8 @@ func (q *Queries) GetOAuth2ClientTokenByCode(ctx context.Context, db DBTX, code
 	oauth2_client_tokens.client_id,
 	oauth2_client_tokens.belongs_to_user,
 	oauth2_client_tokens.redirect_uri,
+	oauth2_client_tokens.scope,
+	oauth2_client_tokens.nonce,
 	oauth2_client_tokens.code,
 	oauth2_client_tokens.code_challenge,
 	oauth2_client_tokens.code_challenge_method,
@@ -246,14 +304,36 @@ func (q *Queries) GetOAuth2ClientTokenByCode(ctx context.Context, db DBTX, code
 WHERE oauth2_client_tokens.refresh = $1
 `
 
-func (q *Queries) GetOAuth2ClientTokenByRefresh(ctx context.Context, db DBTX, refresh string) (*Oauth2ClientTokens, error) {
+type GetOAuth2ClientTokenByRefreshRow struct {
+	ID                  string
+	ClientID            string
+	BelongsToUser       string
+	RedirectUri         string
+	Scope               string
+	Nonce               string
+	Code                string
+	CodeChallenge       string
+	CodeChallengeMethod string
+	CodeCreatedAt       time.Time
+	CodeExpiresAt       time.Time
+	Access              string
+	AccessCreatedAt     time.Time
+	AccessExpiresAt     time.Time
+	Refresh             string
+	RefreshCreatedAt    time.Time
+	RefreshExpiresAt    time.Time
+}
+
+func (q *Queries) GetOAuth2ClientTokenByRefresh(ctx context.Context, db DBTX, refresh string) (*GetOAuth2ClientTokenByRefreshRow, error) {
 	row := db.QueryRowContext(ctx, getOAuth2ClientTokenByRefresh, refresh)
-	var i Oauth2ClientTokens
+	var i GetOAuth2ClientTokenByRefreshRow
 	err := row.Scan(
 		&i.ID,
 		&i.ClientID,
 		&i.BelongsToUser,
 		&i.RedirectUri,
+		&i.Scope,
+		&i.Nonce,
 		&i.Code,
 		&i.CodeChallenge,
 		&i.CodeChallengeMethod,
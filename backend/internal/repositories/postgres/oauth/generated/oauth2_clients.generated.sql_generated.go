@@ -32,22 +32,34 @@ func (q *Queries) ArchiveOAuth2Client(ctx context.Context, db DBTX, id string) (
 	name,
 	description,
 	client_id,
-	client_secret
+	client_secret,
+	allowed_scopes,
+	redirect_uris,
+	allowed_grant_types,
+	belongs_to_user
 ) VALUES (
 	$1,
 	$2,
 	$3,
 	$4,
-	$5
+	$5,
+	$6,
+	$7,
+	$8,
+	$9
 )
 `
 
 type CreateOAuth2ClientParams struct {
-	ID           string
-	Name         string
-	Description  string
-	ClientID     string
-	ClientSecret string
+	ID                string
+	Name              string
+	Description       string
+	ClientID          string
+	ClientSecret      string
+	AllowedScopes     string
+	RedirectUris      string
+	AllowedGrantTypes string
+	BelongsToUser     sql.NullString
 }
 
 func (q *Queries) CreateOAuth2Client(ctx context.Context, db DBTX, arg *CreateOAuth2ClientParams) error {
@@ -57,6 +69,10 @@ func (q *Queries) CreateOAuth2Client(ctx context.Context, db DBTX, arg *CreateOA
 		arg.Description,
 		arg.ClientID,
 		arg.ClientSecret,
+		arg.AllowedScopes,
+		arg.RedirectUris,
+		arg.AllowedGrantTypes,
+		arg.BelongsToUser,
 	)
 	return err
 }
@@ -68,6 +84,10 @@ func (q *Queries) CreateOAuth2Client(ctx context.Context, db DBTX, arg *CreateOA
 	oauth2_clients.description,
 	oauth2_clients.client_id,
 	oauth2_clients.client_secret,
+	oauth2_clients.allowed_scopes,
+	oauth2_clients.redirect_uris,
+	oauth2_clients.allowed_grant_types,
+	oauth2_clients.belongs_to_user,
 	oauth2_clients.created_at,
 	oauth2_clients.archived_at
 FROM oauth2_clients
@@ -75,15 +95,33 @@ func (q *Queries) CreateOAuth2Client(ctx context.Context, db DBTX, arg *CreateOA
 	AND oauth2_clients.client_id = $1
 `
 
-func (q *Queries) GetOAuth2ClientByClientID(ctx context.Context, db DBTX, clientID string) (*Oauth2Clients, error) {
+type GetOAuth2ClientByClientIDRow struct {
+	ID                string
+	Name              string
+	Description       string
+	ClientID          string
+	ClientSecret      string
+	AllowedScopes     string
+	RedirectUris      string
+	AllowedGrantTypes string
+	BelongsToUser     sql.NullString
+	CreatedAt         time.Time
+	ArchivedAt        sql.NullTime
+}
+
+func (q *Queries) GetOAuth2ClientByClientID(ctx context.Context, db DBTX, clientID string) (*GetOAuth2ClientByClientIDRow, error) {
 	row := db.QueryRowContext(ctx, getOAuth2ClientByClientID, clientID)
-	var i Oauth2Clients
+	var i GetOAuth2ClientByClientIDRow
 	err := row.Scan(
 		&i.ID,
 		&i.Name,
 		&i.Description,
 		&i.ClientID,
 		&i.ClientSecret,
+		&i.AllowedScopes,
+		&i.RedirectUris,
+		&i.AllowedGrantTypes,
+		&i.BelongsToUser,
 		&i.CreatedAt,
 		&i.ArchivedAt,
 	)
@@ -97,6 +135,10 @@ func (q *Queries) GetOAuth2ClientByClientID(ctx context.Context, db DBTX, client
 	oauth2_clients.description,
 	oauth2_clients.client_id,
 	oauth2_clients.client_secret,
+	oauth2_clients.allowed_scopes,
+	oauth2_clients.redirect_uris,
+	oauth2_clients.allowed_grant_types,
+	oauth2_clients.belongs_to_user,
 	oauth2_clients.created_at,
 	oauth2_clients.archived_at
 FROM oauth2_clients
@@ -104,15 +146,33 @@ func (q *Queries) GetOAuth2ClientByClientID(ctx context.Context, db DBTX, client
 	AND oauth2_clients.id = $1
 `
 
-func (q *Queries) GetOAuth2ClientByDatabaseID(ctx context.Context, db DBTX, id string) (*Oauth2Clients, error) {
+type GetOAuth2ClientByDatabaseIDRow struct {
+	ID                string
+	Name              string
+	Description       string
+	ClientID          string
+	ClientSecret      string
+	AllowedScopes     string
+	RedirectUris      string
+	AllowedGrantTypes string
+	BelongsToUser     sql.NullString
+	CreatedAt         time.Time
+	ArchivedAt        sql.NullTime
+}
+
+func (q *Queries) GetOAuth2ClientByDatabaseID(ctx context.Context, db DBTX, id string) (*GetOAuth2ClientByDatabaseIDRow, error) {
 	row := db.QueryRowContext(ctx, getOAuth2ClientByDatabaseID, id)
-	var i Oauth2Clients
+	var i GetOAuth2ClientByDatabaseIDRow
 	err := row.Scan(
 		&i.ID,
 		&i.Name,
 		&i.Description,
 		&i.ClientID,
 		&i.ClientSecret,
+		&i.AllowedScopes,
+		&i.RedirectUris,
+		&i.AllowedGrantTypes,
+		&i.BelongsToUser,
 		&i.CreatedAt,
 		&i.ArchivedAt,
 	)
@@ -126,6 +186,10 @@ func (q *Queries) GetOAuth2ClientByDatabaseID(ctx context.Context, db DBTX, id s
 	oauth2_clients.description,
 	oauth2_clients.client_id,
 	oauth2_clients.client_secret,
+	oauth2_clients.allowed_scopes,
+	oauth2_clients.redirect_uris,
+	oauth2_clients.allowed_grant_types,
+	oauth2_clients.belongs_to_user,
 	oauth2_clients.created_at,
 	oauth2_clients.archived_at,
 	(
@@ -161,15 +225,19 @@ type GetOAuth2ClientsParams struct {
 }
 
 type GetOAuth2ClientsRow struct {
-	ID            string
-	Name          string
-	Description   string
-	ClientID      string
-	ClientSecret  string
-	CreatedAt     time.Time
-	ArchivedAt    sql.NullTime
-	FilteredCount int64
-	TotalCount    int64
+	ID                string
+	Name              string
+	Description       string
+	ClientID          string
+	ClientSecret      string
+	AllowedScopes     string
+	RedirectUris      string
+	AllowedGrantTypes string
+	BelongsToUser     sql.NullString
+	CreatedAt         time.Time
+	ArchivedAt        sql.NullTime
+	FilteredCount     int64
+	TotalCount        int64
 }
 
 func (q *Queries) GetOAuth2Clients(ctx context.Context, db DBTX, arg *GetOAuth2ClientsParams) ([]*GetOAuth2ClientsRow, error) {
@@ -193,6 +261,10 @@ func (q *Queries) GetOAuth2Clients(ctx context.Context, db DBTX, arg *GetOAuth2C
 			&i.Description,
 			&i.ClientID,
 			&i.ClientSecret,
+			&i.AllowedScopes,
+			&i.RedirectUris,
+			&i.AllowedGrantTypes,
+			&i.BelongsToUser,
 			&i.CreatedAt,
 			&i.ArchivedAt,
 			&i.FilteredCount,
@@ -13,15 +13,17 @@ type Querier interface {
 	CheckOAuth2ClientTokenExistence(ctx context.Context, db DBTX, id string) (bool, error)
 	CreateOAuth2Client(ctx context.Context, db DBTX, arg *CreateOAuth2ClientParams) error
 	CreateOAuth2ClientToken(ctx context.Context, db DBTX, arg *CreateOAuth2ClientTokenParams) error
+	CreateOIDCSigningKey(ctx context.Context, db DBTX, arg *CreateOIDCSigningKeyParams) error
 	DeleteOAuth2ClientTokenByAccess(ctx context.Context, db DBTX, access string) (int64, error)
 	DeleteOAuth2ClientTokenByCode(ctx context.Context, db DBTX, code string) (int64, error)
 	DeleteOAuth2ClientTokenByRefresh(ctx context.Context, db DBTX, refresh string) (int64, error)
-	GetOAuth2ClientByClientID(ctx context.Context, db DBTX, clientID string) (*Oauth2Clients, error)
-	GetOAuth2ClientByDatabaseID(ctx context.Context, db DBTX, id string) (*Oauth2Clients, error)
-	GetOAuth2ClientTokenByAccess(ctx context.Context, db DBTX, access string) (*Oauth2ClientTokens, error)
-	GetOAuth2ClientTokenByCode(ctx context.Context, db DBTX, code string) (*Oauth2ClientTokens, error)
-	GetOAuth2ClientTokenByRefresh(ctx context.Context, db DBTX, refresh string) (*Oauth2ClientTokens, error)
+	GetOAuth2ClientByClientID(ctx context.Context, db DBTX, clientID string) (*GetOAuth2ClientByClientIDRow, error)
+	GetOAuth2ClientByDatabaseID(ctx context.Context, db DBTX, id string) (*GetOAuth2ClientByDatabaseIDRow, error)
+	GetOAuth2ClientTokenByAccess(ctx context.Context, db DBTX, access string) (*GetOAuth2ClientTokenByAccessRow, error)
+	GetOAuth2ClientTokenByCode(ctx context.Context, db DBTX, code string) (*GetOAuth2ClientTokenByCodeRow, error)
+	GetOAuth2ClientTokenByRefresh(ctx context.Context, db DBTX, refresh string) (*GetOAuth2ClientTokenByRefreshRow, error)
 	GetOAuth2Clients(ctx context.Context, db DBTX, arg *GetOAuth2ClientsParams) ([]*GetOAuth2ClientsRow, error)
+	GetUnexpiredOIDCSigningKeys(ctx context.Context, db DBTX) ([]*OidcSigningKeys, error)
 }
 
 var _ Querier = (*Queries)(nil)
@@ -42,6 +42,8 @@ func (q *repository) GetOAuth2ClientTokenByCode(ctx context.Context, code string
 		AccessCreatedAt:     result.AccessCreatedAt,
 		CodeCreatedAt:       result.CodeCreatedAt,
 		RedirectURI:         result.RedirectUri,
+		Scope:               result.Scope,
+		Nonce:               result.Nonce,
 		Code:                result.Code,
 		CodeChallenge:       result.CodeChallenge,
 		CodeChallengeMethod: result.CodeChallengeMethod,
@@ -108,6 +110,8 @@ func (q *repository) GetOAuth2ClientTokenByAccess(ctx context.Context, access st
 		AccessCreatedAt:     result.AccessCreatedAt,
 		CodeCreatedAt:       result.CodeCreatedAt,
 		RedirectURI:         result.RedirectUri,
+		Scope:               result.Scope,
+		Nonce:               result.Nonce,
 		Code:                result.Code,
 		CodeChallenge:       result.CodeChallenge,
 		CodeChallengeMethod: result.CodeChallengeMethod,
@@ -174,6 +178,8 @@ func (q *repository) GetOAuth2ClientTokenByRefresh(ctx context.Context, refresh
 		AccessCreatedAt:     result.AccessCreatedAt,
 		CodeCreatedAt:       result.CodeCreatedAt,
 		RedirectURI:         result.RedirectUri,
+		Scope:               result.Scope,
+		Nonce:               result.Nonce,
 		Code:                result.Code,
 		CodeChallenge:       result.CodeChallenge,
 		CodeChallengeMethod: result.CodeChallengeMethod,
@@ -255,6 +261,8 @@ func (q *repository) CreateOAuth2ClientToken(ctx context.Context, input *types.O
 		ID:                  input.ID,
 		Refresh:             encryptedRefresh,
 		RedirectUri:         input.RedirectURI,
+		Scope:               input.Scope,
+		Nonce:               input.Nonce,
 		BelongsToUser:       input.BelongsToUser,
 	}); err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "performing oauth2 client token creation query")
@@ -267,6 +275,8 @@ func (q *repository) CreateOAuth2ClientToken(ctx context.Context, input *types.O
 		AccessCreatedAt:     input.AccessCreatedAt,
 		CodeCreatedAt:       input.CodeCreatedAt,
 		RedirectURI:         input.RedirectURI,
+		Scope:               input.Scope,
+		Nonce:               input.Nonce,
 		Code:                input.Code,
 		CodeChallenge:       input.CodeChallenge,
 		CodeChallengeMethod: input.CodeChallengeMethod,
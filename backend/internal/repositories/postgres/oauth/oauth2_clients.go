@@ -4,6 +4,7 @@
 	"context"
 	"database/sql"
 	"errors"
+	"strings"
 
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/audit"
 	types "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/oauth"
@@ -20,6 +21,9 @@
 
 const (
 	resourceTypeOAuth2Clients = "oauth2_clients"
+
+	// oauth2ClientListDelimiter separates scopes, redirect URIs, and grant types in their columns, matching OAuth2's own scope encoding.
+	oauth2ClientListDelimiter = " "
 )
 
 var (
@@ -45,13 +49,17 @@ func (q *repository) GetOAuth2ClientByClientID(ctx context.Context, clientID str
 	}
 
 	client := &types.OAuth2Client{
-		CreatedAt:    result.CreatedAt,
-		ArchivedAt:   database.TimePointerFromNullTime(result.ArchivedAt),
-		Name:         result.Name,
-		Description:  result.Description,
-		ClientID:     result.ClientID,
-		ID:           result.ID,
-		ClientSecret: result.ClientSecret,
+		CreatedAt:         result.CreatedAt,
+		ArchivedAt:        database.TimePointerFromNullTime(result.ArchivedAt),
+		BelongsToUser:     database.StringPointerFromNullString(result.BelongsToUser),
+		Name:              result.Name,
+		Description:       result.Description,
+		ClientID:          result.ClientID,
+		ID:                result.ID,
+		ClientSecret:      result.ClientSecret,
+		AllowedScopes:     strings.Fields(result.AllowedScopes),
+		RedirectURIs:      strings.Fields(result.RedirectUris),
+		AllowedGrantTypes: strings.Fields(result.AllowedGrantTypes),
 	}
 
 	return client, nil
@@ -76,13 +84,17 @@ func (q *repository) GetOAuth2ClientByDatabaseID(ctx context.Context, clientID s
 	}
 
 	client := &types.OAuth2Client{
-		CreatedAt:    result.CreatedAt,
-		ArchivedAt:   database.TimePointerFromNullTime(result.ArchivedAt),
-		Name:         result.Name,
-		Description:  result.Description,
-		ClientID:     result.ClientID,
-		ID:           result.ID,
-		ClientSecret: result.ClientSecret,
+		CreatedAt:         result.CreatedAt,
+		ArchivedAt:        database.TimePointerFromNullTime(result.ArchivedAt),
+		BelongsToUser:     database.StringPointerFromNullString(result.BelongsToUser),
+		Name:              result.Name,
+		Description:       result.Description,
+		ClientID:          result.ClientID,
+		ID:                result.ID,
+		ClientSecret:      result.ClientSecret,
+		AllowedScopes:     strings.Fields(result.AllowedScopes),
+		RedirectURIs:      strings.Fields(result.RedirectUris),
+		AllowedGrantTypes: strings.Fields(result.AllowedGrantTypes),
 	}
 
 	return client, nil
@@ -118,13 +130,17 @@ func (q *repository) GetOAuth2Clients(ctx context.Context, filter *filtering.Que
 	)
 	for _, result := range results {
 		data = append(data, &types.OAuth2Client{
-			CreatedAt:    result.CreatedAt,
-			ArchivedAt:   database.TimePointerFromNullTime(result.ArchivedAt),
-			Name:         result.Name,
-			Description:  result.Description,
-			ClientID:     result.ClientID,
-			ID:           result.ID,
-			ClientSecret: result.ClientSecret,
+			CreatedAt:         result.CreatedAt,
+			ArchivedAt:        database.TimePointerFromNullTime(result.ArchivedAt),
+			BelongsToUser:     database.StringPointerFromNullString(result.BelongsToUser),
+			Name:              result.Name,
+			Description:       result.Description,
+			ClientID:          result.ClientID,
+			ID:                result.ID,
+			ClientSecret:      result.ClientSecret,
+			AllowedScopes:     strings.Fields(result.AllowedScopes),
+			RedirectURIs:      strings.Fields(result.RedirectUris),
+			AllowedGrantTypes: strings.Fields(result.AllowedGrantTypes),
 		})
 		filteredCount = uint64(result.FilteredCount)
 		totalCount = uint64(result.TotalCount)
@@ -162,11 +178,15 @@ func (q *repository) CreateOAuth2Client(ctx context.Context, input *types.OAuth2
 	}
 
 	if writeErr := q.generatedQuerier.CreateOAuth2Client(ctx, tx, &generated.CreateOAuth2ClientParams{
-		ID:           input.ID,
-		Description:  input.Description,
-		Name:         input.Name,
-		ClientID:     input.ClientID,
-		ClientSecret: input.ClientSecret,
+		ID:                input.ID,
+		Description:       input.Description,
+		Name:              input.Name,
+		ClientID:          input.ClientID,
+		ClientSecret:      input.ClientSecret,
+		AllowedScopes:     strings.Join(input.AllowedScopes, oauth2ClientListDelimiter),
+		RedirectUris:      strings.Join(input.RedirectURIs, oauth2ClientListDelimiter),
+		AllowedGrantTypes: strings.Join(input.AllowedGrantTypes, oauth2ClientListDelimiter),
+		BelongsToUser:     database.NullStringFromStringPointer(input.BelongsToUser),
 	}); writeErr != nil {
 		q.RollbackTransaction(ctx, tx)
 		return nil, observability.PrepareError(writeErr, span, "creating OAuth2 client")
@@ -189,11 +209,16 @@ func (q *repository) CreateOAuth2Client(ctx context.Context, input *types.OAuth2
 	}
 
 	client := &types.OAuth2Client{
-		ID:           input.ID,
-		Name:         input.Name,
-		ClientID:     input.ClientID,
-		ClientSecret: input.ClientSecret,
-		CreatedAt:    q.CurrentTime(),
+		ID:                input.ID,
+		Name:              input.Name,
+		Description:       input.Description,
+		ClientID:          input.ClientID,
+		ClientSecret:      input.ClientSecret,
+		BelongsToUser:     input.BelongsToUser,
+		AllowedScopes:     input.AllowedScopes,
+		RedirectURIs:      input.RedirectURIs,
+		AllowedGrantTypes: input.AllowedGrantTypes,
+		CreatedAt:         q.CurrentTime(),
 	}
 
 	logger.Info("OAuth2 client created")
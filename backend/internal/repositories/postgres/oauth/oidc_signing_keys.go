@@ -0,0 +1,88 @@
+package oauth
+
+import (
+	"context"
+
+	types "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/oauth"
+	oauthkeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/oauth/keys"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/repositories/postgres/oauth/generated"
+
+	platformerrors "github.com/primandproper/platform/errors"
+	"github.com/primandproper/platform/observability"
+	"github.com/primandproper/platform/observability/tracing"
+)
+
+var _ types.OIDCSigningKeyDataManager = (*repository)(nil)
+
+// GetUnexpiredOIDCSigningKeys fetches every OIDC signing key that has not yet expired, newest first.
+func (q *repository) GetUnexpiredOIDCSigningKeys(ctx context.Context) ([]*types.OIDCSigningKey, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := q.logger.Clone()
+
+	results, err := q.generatedQuerier.GetUnexpiredOIDCSigningKeys(ctx, q.readDB)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "getting unexpired oidc signing keys")
+	}
+
+	signingKeys := []*types.OIDCSigningKey{}
+	for _, result := range results {
+		decryptedPrivateKey, decryptErr := q.oauth2ClientTokenEncDec.Decrypt(ctx, result.PrivateKey)
+		if decryptErr != nil {
+			return nil, observability.PrepareError(decryptErr, span, "decrypting oidc signing key")
+		}
+
+		signingKeys = append(signingKeys, &types.OIDCSigningKey{
+			CreatedAt:  result.CreatedAt,
+			RetiresAt:  result.RetiresAt,
+			ExpiresAt:  result.ExpiresAt,
+			ID:         result.ID,
+			Algorithm:  result.Algorithm,
+			PrivateKey: decryptedPrivateKey,
+		})
+	}
+
+	return signingKeys, nil
+}
+
+// CreateOIDCSigningKey creates an OIDC signing key in the database.
+func (q *repository) CreateOIDCSigningKey(ctx context.Context, input *types.OIDCSigningKeyDatabaseCreationInput) (*types.OIDCSigningKey, error) {
+	ctx, span := q.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if input == nil {
+		return nil, platformerrors.ErrNilInputProvided
+	}
+
+	logger := q.logger.WithValue(oauthkeys.OIDCSigningKeyIDKey, input.ID)
+	tracing.AttachToSpan(span, oauthkeys.OIDCSigningKeyIDKey, input.ID)
+
+	encryptedPrivateKey, err := q.oauth2ClientTokenEncDec.Encrypt(ctx, input.PrivateKey)
+	if err != nil {
+		return nil, observability.PrepareError(err, span, "encrypting oidc signing key")
+	}
+
+	if err = q.generatedQuerier.CreateOIDCSigningKey(ctx, q.writeDB, &generated.CreateOIDCSigningKeyParams{
+		ID:         input.ID,
+		Algorithm:  input.Algorithm,
+		PrivateKey: encryptedPrivateKey,
+		RetiresAt:  input.RetiresAt,
+		ExpiresAt:  input.ExpiresAt,
+	}); err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "performing oidc signing key creation query")
+	}
+
+	logger.Info("oidc signing key created")
+
+	signingKey := &types.OIDCSigningKey{
+		CreatedAt:  q.CurrentTime(),
+		RetiresAt:  input.RetiresAt,
+		ExpiresAt:  input.ExpiresAt,
+		ID:         input.ID,
+		Algorithm:  input.Algorithm,
+		PrivateKey: input.PrivateKey,
+	}
+
+	return signingKey, nil
+}
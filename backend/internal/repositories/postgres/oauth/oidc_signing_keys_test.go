@@ -0,0 +1,64 @@
+package oauth
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/oauth/converters"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/oauth/fakes"
+	pgtesting "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/repositories/postgres/testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuerier_Integration_OIDCSigningKeys(t *testing.T) {
+	if !pgtesting.RunContainerTests {
+		t.SkipNow()
+	}
+
+	ctx := t.Context()
+	dbc, _, container := buildDatabaseClientForTest(t)
+
+	defer func(t *testing.T) {
+		t.Helper()
+		assert.NoError(t, container.Terminate(ctx))
+	}(t)
+
+	now := time.Now()
+
+	expiredKey := fakes.BuildFakeOIDCSigningKey()
+	expiredKey.RetiresAt = now.Add(-2 * time.Hour)
+	expiredKey.ExpiresAt = now.Add(-time.Hour)
+	_, err := dbc.CreateOIDCSigningKey(ctx, converters.ConvertOIDCSigningKeyToOIDCSigningKeyDatabaseCreationInput(expiredKey))
+	require.NoError(t, err)
+
+	exampleKey := fakes.BuildFakeOIDCSigningKey()
+	exampleKey.RetiresAt = now.Add(time.Hour)
+	exampleKey.ExpiresAt = now.Add(2 * time.Hour)
+	created, err := dbc.CreateOIDCSigningKey(ctx, converters.ConvertOIDCSigningKeyToOIDCSigningKeyDatabaseCreationInput(exampleKey))
+	require.NoError(t, err)
+	assert.Equal(t, exampleKey.PrivateKey, created.PrivateKey)
+
+	unexpired, err := dbc.GetUnexpiredOIDCSigningKeys(ctx)
+	require.NoError(t, err)
+	require.Len(t, unexpired, 1)
+	assert.Equal(t, exampleKey.ID, unexpired[0].ID)
+	assert.Equal(t, exampleKey.PrivateKey, unexpired[0].PrivateKey)
+	assert.False(t, unexpired[0].IsRetired(now))
+}
+
+func TestQuerier_CreateOIDCSigningKey(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with nil input", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		actual, err := c.CreateOIDCSigningKey(ctx, nil)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
@@ -13,6 +13,8 @@ INSERT INTO oauth2_client_tokens (
 	client_id,
 	belongs_to_user,
 	redirect_uri,
+	scope,
+	nonce,
 	code,
 	code_challenge,
 	code_challenge_method,
@@ -29,6 +31,8 @@ INSERT INTO oauth2_client_tokens (
 	sqlc.arg(client_id),
 	sqlc.arg(belongs_to_user),
 	sqlc.arg(redirect_uri),
+	sqlc.arg(scope),
+	sqlc.arg(nonce),
 	sqlc.arg(code),
 	sqlc.arg(code_challenge),
 	sqlc.arg(code_challenge_method),
@@ -56,6 +60,8 @@ SELECT
 	oauth2_client_tokens.client_id,
 	oauth2_client_tokens.belongs_to_user,
 	oauth2_client_tokens.redirect_uri,
+	oauth2_client_tokens.scope,
+	oauth2_client_tokens.nonce,
 	oauth2_client_tokens.code,
 	oauth2_client_tokens.code_challenge,
 	oauth2_client_tokens.code_challenge_method,
@@ -76,6 +82,8 @@ SELECT
 	oauth2_client_tokens.client_id,
 	oauth2_client_tokens.belongs_to_user,
 	oauth2_client_tokens.redirect_uri,
+	oauth2_client_tokens.scope,
+	oauth2_client_tokens.nonce,
 	oauth2_client_tokens.code,
 	oauth2_client_tokens.code_challenge,
 	oauth2_client_tokens.code_challenge_method,
@@ -96,6 +104,8 @@ SELECT
 	oauth2_client_tokens.client_id,
 	oauth2_client_tokens.belongs_to_user,
 	oauth2_client_tokens.redirect_uri,
+	oauth2_client_tokens.scope,
+	oauth2_client_tokens.nonce,
 	oauth2_client_tokens.code,
 	oauth2_client_tokens.code_challenge,
 	oauth2_client_tokens.code_challenge_method,
@@ -10,13 +10,21 @@ INSERT INTO oauth2_clients (
 	name,
 	description,
 	client_id,
-	client_secret
+	client_secret,
+	allowed_scopes,
+	redirect_uris,
+	allowed_grant_types,
+	belongs_to_user
 ) VALUES (
 	sqlc.arg(id),
 	sqlc.arg(name),
 	sqlc.arg(description),
 	sqlc.arg(client_id),
-	sqlc.arg(client_secret)
+	sqlc.arg(client_secret),
+	sqlc.arg(allowed_scopes),
+	sqlc.arg(redirect_uris),
+	sqlc.arg(allowed_grant_types),
+	sqlc.arg(belongs_to_user)
 );
 
 -- name: GetOAuth2ClientByClientID :one
@@ -26,6 +34,10 @@ SELECT
 	oauth2_clients.description,
 	oauth2_clients.client_id,
 	oauth2_clients.client_secret,
+	oauth2_clients.allowed_scopes,
+	oauth2_clients.redirect_uris,
+	oauth2_clients.allowed_grant_types,
+	oauth2_clients.belongs_to_user,
 	oauth2_clients.created_at,
 	oauth2_clients.archived_at
 FROM oauth2_clients
@@ -39,6 +51,10 @@ SELECT
 	oauth2_clients.description,
 	oauth2_clients.client_id,
 	oauth2_clients.client_secret,
+	oauth2_clients.allowed_scopes,
+	oauth2_clients.redirect_uris,
+	oauth2_clients.allowed_grant_types,
+	oauth2_clients.belongs_to_user,
 	oauth2_clients.created_at,
 	oauth2_clients.archived_at
 FROM oauth2_clients
@@ -52,6 +68,10 @@ SELECT
 	oauth2_clients.description,
 	oauth2_clients.client_id,
 	oauth2_clients.client_secret,
+	oauth2_clients.allowed_scopes,
+	oauth2_clients.redirect_uris,
+	oauth2_clients.allowed_grant_types,
+	oauth2_clients.belongs_to_user,
 	oauth2_clients.created_at,
 	oauth2_clients.archived_at,
 	(
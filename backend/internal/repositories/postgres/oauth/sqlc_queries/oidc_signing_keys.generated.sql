@@ -0,0 +1,26 @@
+-- name: CreateOIDCSigningKey :exec
+INSERT INTO oidc_signing_keys (
+	id,
+	algorithm,
+	private_key,
+	retires_at,
+	expires_at
+) VALUES (
+	sqlc.arg(id),
+	sqlc.arg(algorithm),
+	sqlc.arg(private_key),
+	sqlc.arg(retires_at),
+	sqlc.arg(expires_at)
+);
+
+-- name: GetUnexpiredOIDCSigningKeys :many
+SELECT
+	oidc_signing_keys.id,
+	oidc_signing_keys.algorithm,
+	oidc_signing_keys.private_key,
+	oidc_signing_keys.created_at,
+	oidc_signing_keys.retires_at,
+	oidc_signing_keys.expires_at
+FROM oidc_signing_keys
+WHERE oidc_signing_keys.expires_at > NOW()
+ORDER BY oidc_signing_keys.created_at DESC;
@@ -11,6 +11,76 @@
 	"time"
 )
 
+type PaymentProviderEventStatus string
+
+const (
+	PaymentProviderEventStatusReceived   PaymentProviderEventStatus = "received"
+	PaymentProviderEventStatusApplied    PaymentProviderEventStatus = "applied"
+	PaymentProviderEventStatusStale      PaymentProviderEventStatus = "stale"
+	PaymentProviderEventStatusFailed     PaymentProviderEventStatus = "failed"
+	PaymentProviderEventStatusProcessing PaymentProviderEventStatus = "processing"
+	PaymentProviderEventStatusIgnored    PaymentProviderEventStatus = "ignored"
+)
+
+func (e *PaymentProviderEventStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = PaymentProviderEventStatus(s)
+	case string:
+		*e = PaymentProviderEventStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for PaymentProviderEventStatus: %T", src)
+	}
+	return nil
+}
+
+type NullPaymentProviderEventStatus struct {
+	PaymentProviderEventStatus PaymentProviderEventStatus
+	Valid                      bool // Valid is true if PaymentProviderEventStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullPaymentProviderEventStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.PaymentProviderEventStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.PaymentProviderEventStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullPaymentProviderEventStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.PaymentProviderEventStatus), nil
+}
+
+func (e PaymentProviderEventStatus) Valid() bool {
+	switch e {
+	case PaymentProviderEventStatusReceived,
+		PaymentProviderEventStatusApplied,
+		PaymentProviderEventStatusStale,
+		PaymentProviderEventStatusFailed,
+		PaymentProviderEventStatusProcessing,
+		PaymentProviderEventStatusIgnored:
+		return true
+	}
+	return false
+}
+
+func AllPaymentProviderEventStatusValues() []PaymentProviderEventStatus {
+	return []PaymentProviderEventStatus{
+		PaymentProviderEventStatusReceived,
+		PaymentProviderEventStatusApplied,
+		PaymentProviderEventStatusStale,
+		PaymentProviderEventStatusFailed,
+		PaymentProviderEventStatusProcessing,
+		PaymentProviderEventStatusIgnored,
+	}
+}
+
 type PaymentTransactionStatus string
 
 const (
@@ -200,18 +270,19 @@ func AllSubscriptionStatusValues() []SubscriptionStatus {
 	}
 }
 
-type Products struct {
-	ID                    string
-	Name                  string
-	Description           string
-	Kind                  ProductKind
-	AmountCents           int32
-	Currency              string
-	BillingIntervalMonths sql.NullInt32
-	ExternalProductID     sql.NullString
-	CreatedAt             time.Time
-	LastUpdatedAt         sql.NullTime
-	ArchivedAt            sql.NullTime
+type PaymentProviderEvents struct {
+	ID                     string
+	Provider               string
+	ProviderEventID        string
+	EventType              string
+	ExternalSubscriptionID string
+	AccountID              string
+	Payload                []byte
+	Status                 PaymentProviderEventStatus
+	ProcessingError        string
+	OccurredAt             time.Time
+	ProcessedAt            sql.NullTime
+	CreatedAt              time.Time
 }
 
 type Purchases struct {
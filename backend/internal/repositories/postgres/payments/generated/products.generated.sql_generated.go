@@ -8,6 +8,7 @@
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"time"
 )
 
@@ -48,7 +49,8 @@ func (q *Queries) CheckProductExistence(ctx context.Context, db DBTX, id string)
 	amount_cents,
 	currency,
 	billing_interval_months,
-	external_product_id
+	external_product_id,
+	entitlements
 ) VALUES (
 	$1,
 	$2,
@@ -57,7 +59,8 @@ func (q *Queries) CheckProductExistence(ctx context.Context, db DBTX, id string)
 	$5,
 	$6,
 	$7,
-	$8
+	$8,
+	$9
 )
 `
 
@@ -70,6 +73,7 @@ type CreateProductParams struct {
 	Currency              string
 	BillingIntervalMonths sql.NullInt32
 	ExternalProductID     sql.NullString
+	Entitlements          json.RawMessage
 }
 
 func (q *Queries) CreateProduct(ctx context.Context, db DBTX, arg *CreateProductParams) error {
@@ -82,6 +86,7 @@ func (q *Queries) CreateProduct(ctx context.Context, db DBTX, arg *CreateProduct
 		arg.Currency,
 		arg.BillingIntervalMonths,
 		arg.ExternalProductID,
+		arg.Entitlements,
 	)
 	return err
 }
@@ -96,6 +101,7 @@ func (q *Queries) CreateProduct(ctx context.Context, db DBTX, arg *CreateProduct
 	products.currency,
 	products.billing_interval_months,
 	products.external_product_id,
+	products.entitlements,
 	products.created_at,
 	products.last_updated_at,
 	products.archived_at
@@ -104,9 +110,24 @@ func (q *Queries) CreateProduct(ctx context.Context, db DBTX, arg *CreateProduct
 AND products.id = $1
 `
 
-func (q *Queries) GetProduct(ctx context.Context, db DBTX, id string) (*Products, error) {
+type GetProductRow struct {
+	ID                    string
+	Name                  string
+	Description           string
+	Kind                  ProductKind
+	AmountCents           int32
+	Currency              string
+	BillingIntervalMonths sql.NullInt32
+	ExternalProductID     sql.NullString
+	Entitlements          json.RawMessage
+	CreatedAt             time.Time
+	LastUpdatedAt         sql.NullTime
+	ArchivedAt            sql.NullTime
+}
+
+func (q *Queries) GetProduct(ctx context.Context, db DBTX, id string) (*GetProductRow, error) {
 	row := db.QueryRowContext(ctx, getProduct, id)
-	var i Products
+	var i GetProductRow
 	err := row.Scan(
 		&i.ID,
 		&i.Name,
@@ -116,6 +137,7 @@ func (q *Queries) GetProduct(ctx context.Context, db DBTX, id string) (*Products
 		&i.Currency,
 		&i.BillingIntervalMonths,
 		&i.ExternalProductID,
+		&i.Entitlements,
 		&i.CreatedAt,
 		&i.LastUpdatedAt,
 		&i.ArchivedAt,
@@ -133,6 +155,7 @@ func (q *Queries) GetProduct(ctx context.Context, db DBTX, id string) (*Products
 	products.currency,
 	products.billing_interval_months,
 	products.external_product_id,
+	products.entitlements,
 	products.created_at,
 	products.last_updated_at,
 	products.archived_at
@@ -141,9 +164,24 @@ func (q *Queries) GetProduct(ctx context.Context, db DBTX, id string) (*Products
 AND products.external_product_id = $1
 `
 
-func (q *Queries) GetProductByExternalID(ctx context.Context, db DBTX, externalProductID sql.NullString) (*Products, error) {
+type GetProductByExternalIDRow struct {
+	ID                    string
+	Name                  string
+	Description           string
+	Kind                  ProductKind
+	AmountCents           int32
+	Currency              string
+	BillingIntervalMonths sql.NullInt32
+	ExternalProductID     sql.NullString
+	Entitlements          json.RawMessage
+	CreatedAt             time.Time
+	LastUpdatedAt         sql.NullTime
+	ArchivedAt            sql.NullTime
+}
+
+func (q *Queries) GetProductByExternalID(ctx context.Context, db DBTX, externalProductID sql.NullString) (*GetProductByExternalIDRow, error) {
 	row := db.QueryRowContext(ctx, getProductByExternalID, externalProductID)
-	var i Products
+	var i GetProductByExternalIDRow
 	err := row.Scan(
 		&i.ID,
 		&i.Name,
@@ -153,6 +191,7 @@ func (q *Queries) GetProductByExternalID(ctx context.Context, db DBTX, externalP
 		&i.Currency,
 		&i.BillingIntervalMonths,
 		&i.ExternalProductID,
+		&i.Entitlements,
 		&i.CreatedAt,
 		&i.LastUpdatedAt,
 		&i.ArchivedAt,
@@ -170,6 +209,7 @@ func (q *Queries) GetProductByExternalID(ctx context.Context, db DBTX, externalP
 	products.currency,
 	products.billing_interval_months,
 	products.external_product_id,
+	products.entitlements,
 	products.created_at,
 	products.last_updated_at,
 	products.archived_at,
@@ -232,6 +272,7 @@ type GetProductsRow struct {
 	Currency              string
 	BillingIntervalMonths sql.NullInt32
 	ExternalProductID     sql.NullString
+	Entitlements          json.RawMessage
 	CreatedAt             time.Time
 	LastUpdatedAt         sql.NullTime
 	ArchivedAt            sql.NullTime
@@ -265,6 +306,7 @@ func (q *Queries) GetProducts(ctx context.Context, db DBTX, arg *GetProductsPara
 			&i.Currency,
 			&i.BillingIntervalMonths,
 			&i.ExternalProductID,
+			&i.Entitlements,
 			&i.CreatedAt,
 			&i.LastUpdatedAt,
 			&i.ArchivedAt,
@@ -294,6 +336,7 @@ func (q *Queries) GetProducts(ctx context.Context, db DBTX, arg *GetProductsPara
 	products.currency,
 	products.billing_interval_months,
 	products.external_product_id,
+	products.entitlements,
 	products.created_at,
 	products.last_updated_at,
 	products.archived_at,
@@ -358,6 +401,7 @@ type SearchForProductsRow struct {
 	Currency              string
 	BillingIntervalMonths sql.NullInt32
 	ExternalProductID     sql.NullString
+	Entitlements          json.RawMessage
 	CreatedAt             time.Time
 	LastUpdatedAt         sql.NullTime
 	ArchivedAt            sql.NullTime
@@ -392,6 +436,7 @@ func (q *Queries) SearchForProducts(ctx context.Context, db DBTX, arg *SearchFor
 			&i.Currency,
 			&i.BillingIntervalMonths,
 			&i.ExternalProductID,
+			&i.Entitlements,
 			&i.CreatedAt,
 			&i.LastUpdatedAt,
 			&i.ArchivedAt,
@@ -420,9 +465,10 @@ func (q *Queries) SearchForProducts(ctx context.Context, db DBTX, arg *SearchFor
 	currency = $5,
 	billing_interval_months = $6,
 	external_product_id = $7,
+	entitlements = $8,
 	last_updated_at = NOW()
 WHERE archived_at IS NULL
-	AND id = $8
+	AND id = $9
 `
 
 type UpdateProductParams struct {
@@ -433,6 +479,7 @@ type UpdateProductParams struct {
 	Currency              string
 	BillingIntervalMonths sql.NullInt32
 	ExternalProductID     sql.NullString
+	Entitlements          json.RawMessage
 	ID                    string
 }
 
@@ -445,6 +492,7 @@ func (q *Queries) UpdateProduct(ctx context.Context, db DBTX, arg *UpdateProduct
 		arg.Currency,
 		arg.BillingIntervalMonths,
 		arg.ExternalProductID,
+		arg.Entitlements,
 		arg.ID,
 	)
 	if err != nil {
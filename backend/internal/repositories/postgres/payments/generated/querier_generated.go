@@ -7,26 +7,36 @@
 import (
 	"context"
 	"database/sql"
+	"time"
 )
 
 type Querier interface {
 	ArchiveProduct(ctx context.Context, db DBTX, id string) (int64, error)
 	ArchiveSubscription(ctx context.Context, db DBTX, id string) (int64, error)
 	CheckProductExistence(ctx context.Context, db DBTX, id string) (bool, error)
+	ClaimPaymentProviderEvent(ctx context.Context, db DBTX, arg *ClaimPaymentProviderEventParams) (*PaymentProviderEvents, error)
+	CreatePaymentProviderEvent(ctx context.Context, db DBTX, arg *CreatePaymentProviderEventParams) (int64, error)
 	CreatePaymentTransaction(ctx context.Context, db DBTX, arg *CreatePaymentTransactionParams) error
 	CreateProduct(ctx context.Context, db DBTX, arg *CreateProductParams) error
 	CreatePurchase(ctx context.Context, db DBTX, arg *CreatePurchaseParams) error
 	CreateSubscription(ctx context.Context, db DBTX, arg *CreateSubscriptionParams) error
+	GetLatestAppliedPaymentProviderEventTime(ctx context.Context, db DBTX, arg *GetLatestAppliedPaymentProviderEventTimeParams) (time.Time, error)
+	GetPaymentProviderEvent(ctx context.Context, db DBTX, id string) (*PaymentProviderEvents, error)
+	GetPaymentProviderEventByProviderEventID(ctx context.Context, db DBTX, arg *GetPaymentProviderEventByProviderEventIDParams) (*PaymentProviderEvents, error)
+	GetPaymentProviderEvents(ctx context.Context, db DBTX, arg *GetPaymentProviderEventsParams) ([]*GetPaymentProviderEventsRow, error)
 	GetPaymentTransactionsForAccount(ctx context.Context, db DBTX, arg *GetPaymentTransactionsForAccountParams) ([]*GetPaymentTransactionsForAccountRow, error)
-	GetProduct(ctx context.Context, db DBTX, id string) (*Products, error)
-	GetProductByExternalID(ctx context.Context, db DBTX, externalProductID sql.NullString) (*Products, error)
+	GetProduct(ctx context.Context, db DBTX, id string) (*GetProductRow, error)
+	GetProductByExternalID(ctx context.Context, db DBTX, externalProductID sql.NullString) (*GetProductByExternalIDRow, error)
 	GetProducts(ctx context.Context, db DBTX, arg *GetProductsParams) ([]*GetProductsRow, error)
 	GetPurchase(ctx context.Context, db DBTX, id string) (*Purchases, error)
 	GetPurchasesForAccount(ctx context.Context, db DBTX, arg *GetPurchasesForAccountParams) ([]*GetPurchasesForAccountRow, error)
 	GetSubscription(ctx context.Context, db DBTX, id string) (*Subscriptions, error)
 	GetSubscriptionByExternalID(ctx context.Context, db DBTX, externalSubscriptionID sql.NullString) (*Subscriptions, error)
+	GetSubscriptionByExternalIDForUpdate(ctx context.Context, db DBTX, externalSubscriptionID sql.NullString) (*Subscriptions, error)
 	GetSubscriptionsForAccount(ctx context.Context, db DBTX, arg *GetSubscriptionsForAccountParams) ([]*GetSubscriptionsForAccountRow, error)
+	GetSubscriptionsForAccountEndingAfter(ctx context.Context, db DBTX, arg *GetSubscriptionsForAccountEndingAfterParams) ([]*Subscriptions, error)
 	SearchForProducts(ctx context.Context, db DBTX, arg *SearchForProductsParams) ([]*SearchForProductsRow, error)
+	UpdatePaymentProviderEventStatus(ctx context.Context, db DBTX, arg *UpdatePaymentProviderEventStatusParams) (int64, error)
 	UpdateProduct(ctx context.Context, db DBTX, arg *UpdateProductParams) (int64, error)
 	UpdateSubscription(ctx context.Context, db DBTX, arg *UpdateSubscriptionParams) (int64, error)
 	UpdateSubscriptionStatus(ctx context.Context, db DBTX, arg *UpdateSubscriptionStatusParams) (int64, error)
@@ -136,6 +136,42 @@ func (q *Queries) GetSubscriptionByExternalID(ctx context.Context, db DBTX, exte
 	return &i, err
 }
 
+const getSubscriptionByExternalIDForUpdate = `-- name: GetSubscriptionByExternalIDForUpdate :one
+SELECT
+	subscriptions.id,
+	subscriptions.belongs_to_account,
+	subscriptions.product_id,
+	subscriptions.external_subscription_id,
+	subscriptions.status,
+	subscriptions.current_period_start,
+	subscriptions.current_period_end,
+	subscriptions.created_at,
+	subscriptions.last_updated_at,
+	subscriptions.archived_at
+FROM subscriptions
+WHERE subscriptions.archived_at IS NULL
+AND subscriptions.external_subscription_id = $1
+FOR UPDATE
+`
+
+func (q *Queries) GetSubscriptionByExternalIDForUpdate(ctx context.Context, db DBTX, externalSubscriptionID sql.NullString) (*Subscriptions, error) {
+	row := db.QueryRowContext(ctx, getSubscriptionByExternalIDForUpdate, externalSubscriptionID)
+	var i Subscriptions
+	err := row.Scan(
+		&i.ID,
+		&i.BelongsToAccount,
+		&i.ProductID,
+		&i.ExternalSubscriptionID,
+		&i.Status,
+		&i.CurrentPeriodStart,
+		&i.CurrentPeriodEnd,
+		&i.CreatedAt,
+		&i.LastUpdatedAt,
+		&i.ArchivedAt,
+	)
+	return &i, err
+}
+
 const getSubscriptionsForAccount = `-- name: GetSubscriptionsForAccount :many
 SELECT
 	subscriptions.id,
@@ -262,6 +298,64 @@ func (q *Queries) GetSubscriptionsForAccount(ctx context.Context, db DBTX, arg *
 	return items, nil
 }
 
+const getSubscriptionsForAccountEndingAfter = `-- name: GetSubscriptionsForAccountEndingAfter :many
+SELECT
+	subscriptions.id,
+	subscriptions.belongs_to_account,
+	subscriptions.product_id,
+	subscriptions.external_subscription_id,
+	subscriptions.status,
+	subscriptions.current_period_start,
+	subscriptions.current_period_end,
+	subscriptions.created_at,
+	subscriptions.last_updated_at,
+	subscriptions.archived_at
+FROM subscriptions
+WHERE subscriptions.archived_at IS NULL
+	AND subscriptions.belongs_to_account = $1
+	AND subscriptions.current_period_end > $2
+ORDER BY subscriptions.created_at
+`
+
+type GetSubscriptionsForAccountEndingAfterParams struct {
+	BelongsToAccount string
+	PeriodEndsAfter  time.Time
+}
+
+func (q *Queries) GetSubscriptionsForAccountEndingAfter(ctx context.Context, db DBTX, arg *GetSubscriptionsForAccountEndingAfterParams) ([]*Subscriptions, error) {
+	rows, err := db.QueryContext(ctx, getSubscriptionsForAccountEndingAfter, arg.BelongsToAccount, arg.PeriodEndsAfter)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	items := []*Subscriptions{}
+	for rows.Next() {
+		var i Subscriptions
+		if err := rows.Scan(
+			&i.ID,
+			&i.BelongsToAccount,
+			&i.ProductID,
+			&i.ExternalSubscriptionID,
+			&i.Status,
+			&i.CurrentPeriodStart,
+			&i.CurrentPeriodEnd,
+			&i.CreatedAt,
+			&i.LastUpdatedAt,
+			&i.ArchivedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, &i)
+	}
+	if err := rows.Close(); err != nil {
+		return nil, err
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
+
 const updateSubscription = `-- name: UpdateSubscription :execrows
 UPDATE subscriptions SET
 	external_subscription_id = $1,
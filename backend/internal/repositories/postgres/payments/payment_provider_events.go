@@ -0,0 +1,332 @@
+package payments
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"time"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/audit"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/payments"
+	paymentskeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/payments/keys"
+	generated "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/repositories/postgres/payments/generated"
+
+	"github.com/primandproper/platform/database"
+	"github.com/primandproper/platform/database/filtering"
+	platformerrors "github.com/primandproper/platform/errors"
+	"github.com/primandproper/platform/identifiers"
+	"github.com/primandproper/platform/observability"
+	"github.com/primandproper/platform/observability/tracing"
+)
+
+// CreatePaymentProviderEvent stores a provider event, reporting false if one with the same provider event ID already exists.
+func (r *repository) CreatePaymentProviderEvent(ctx context.Context, input *payments.PaymentProviderEventDatabaseCreationInput) (bool, error) {
+	ctx, span := r.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if input == nil {
+		return false, platformerrors.ErrNilInputProvided
+	}
+
+	logger := r.logger.Clone()
+	logger = logger.WithValue(paymentskeys.PaymentProviderEventIDKey, input.ID).WithValue("provider_event_id", input.ProviderEventID)
+	tracing.AttachToSpan(span, paymentskeys.PaymentProviderEventIDKey, input.ID)
+
+	arg := &generated.CreatePaymentProviderEventParams{
+		ID:                     input.ID,
+		Provider:               input.Provider,
+		ProviderEventID:        input.ProviderEventID,
+		EventType:              input.EventType,
+		ExternalSubscriptionID: input.ExternalSubscriptionID,
+		AccountID:              input.AccountID,
+		Payload:                input.Payload,
+		OccurredAt:             input.OccurredAt,
+	}
+
+	rowsAffected, err := r.generatedQuerier.CreatePaymentProviderEvent(ctx, r.writeDB, arg)
+	if err != nil {
+		return false, observability.PrepareAndLogError(err, logger, span, "creating payment provider event")
+	}
+
+	return rowsAffected > 0, nil
+}
+
+func (r *repository) GetPaymentProviderEvent(ctx context.Context, id string) (*payments.PaymentProviderEvent, error) {
+	ctx, span := r.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if id == "" {
+		return nil, platformerrors.ErrInvalidIDProvided
+	}
+
+	logger := r.logger.Clone()
+	logger = logger.WithValue(paymentskeys.PaymentProviderEventIDKey, id)
+	tracing.AttachToSpan(span, paymentskeys.PaymentProviderEventIDKey, id)
+
+	result, err := r.generatedQuerier.GetPaymentProviderEvent(ctx, r.readDB, id)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching payment provider event")
+	}
+
+	return convertPaymentProviderEventFromGenerated(result), nil
+}
+
+func (r *repository) GetPaymentProviderEventByProviderEventID(ctx context.Context, provider, providerEventID string) (*payments.PaymentProviderEvent, error) {
+	ctx, span := r.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if provider == "" || providerEventID == "" {
+		return nil, platformerrors.ErrInvalidIDProvided
+	}
+
+	logger := r.logger.Clone()
+	logger = logger.WithValue("provider", provider).WithValue("provider_event_id", providerEventID)
+	tracing.AttachToSpan(span, "provider_event_id", providerEventID)
+
+	result, err := r.generatedQuerier.GetPaymentProviderEventByProviderEventID(ctx, r.readDB, &generated.GetPaymentProviderEventByProviderEventIDParams{
+		Provider:        provider,
+		ProviderEventID: providerEventID,
+	})
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching payment provider event by provider event ID")
+	}
+
+	return convertPaymentProviderEventFromGenerated(result), nil
+}
+
+// GetPaymentProviderEvents lists stored provider events, optionally restricted to a single provider.
+func (r *repository) GetPaymentProviderEvents(ctx context.Context, provider string, filter *filtering.QueryFilter) (*filtering.QueryFilteredResult[payments.PaymentProviderEvent], error) {
+	ctx, span := r.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := r.logger.Clone()
+	if filter == nil {
+		filter = filtering.DefaultQueryFilter()
+	}
+	logger = filter.AttachToLogger(logger)
+	tracing.AttachQueryFilterToSpan(span, filter)
+
+	params := &generated.GetPaymentProviderEventsParams{
+		Provider:      sql.NullString{String: provider, Valid: provider != ""},
+		CreatedAfter:  database.NullTimeFromTimePointer(filter.CreatedAfter),
+		CreatedBefore: database.NullTimeFromTimePointer(filter.CreatedBefore),
+		Cursor:        database.NullStringFromStringPointer(filter.Cursor),
+		ResultLimit:   database.NullInt32FromUint8Pointer(filter.MaxResponseSize),
+	}
+
+	results, err := r.generatedQuerier.GetPaymentProviderEvents(ctx, r.readDB, params)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching payment provider events")
+	}
+
+	data := make([]*payments.PaymentProviderEvent, 0, len(results))
+	var filteredCount, totalCount uint64
+	for _, row := range results {
+		data = append(data, &payments.PaymentProviderEvent{
+			ID:                     row.ID,
+			Provider:               row.Provider,
+			ProviderEventID:        row.ProviderEventID,
+			EventType:              row.EventType,
+			ExternalSubscriptionID: row.ExternalSubscriptionID,
+			AccountID:              row.AccountID,
+			Payload:                row.Payload,
+			Status:                 string(row.Status),
+			ProcessingError:        row.ProcessingError,
+			OccurredAt:             row.OccurredAt,
+			ProcessedAt:            database.TimePointerFromNullTime(row.ProcessedAt),
+			CreatedAt:              row.CreatedAt,
+		})
+		filteredCount = uint64(row.FilteredCount)
+		totalCount = uint64(row.TotalCount)
+	}
+
+	return filtering.NewQueryFilteredResult(
+		data,
+		filteredCount,
+		totalCount,
+		func(p *payments.PaymentProviderEvent) string { return p.ID },
+		filter,
+	), nil
+}
+
+// GetLatestAppliedPaymentProviderEventTime returns when the most recently applied event for a subscription occurred, or nil if none has been applied.
+func (r *repository) GetLatestAppliedPaymentProviderEventTime(ctx context.Context, provider, externalSubscriptionID string) (*time.Time, error) {
+	ctx, span := r.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if provider == "" || externalSubscriptionID == "" {
+		return nil, platformerrors.ErrInvalidIDProvided
+	}
+
+	logger := r.logger.Clone()
+	logger = logger.WithValue("provider", provider).WithValue("external_subscription_id", externalSubscriptionID)
+	tracing.AttachToSpan(span, "external_subscription_id", externalSubscriptionID)
+
+	occurredAt, err := r.generatedQuerier.GetLatestAppliedPaymentProviderEventTime(ctx, r.readDB, &generated.GetLatestAppliedPaymentProviderEventTimeParams{
+		Provider:               provider,
+		ExternalSubscriptionID: externalSubscriptionID,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching latest applied payment provider event time")
+	}
+
+	return &occurredAt, nil
+}
+
+// ClaimPaymentProviderEvent marks a provider event as processing and returns it, or returns nil if the event can't be
+// claimed because another worker holds it or, unless includeFinished is set, because it was already applied or found stale.
+func (r *repository) ClaimPaymentProviderEvent(ctx context.Context, id string, includeFinished bool) (*payments.PaymentProviderEvent, error) {
+	ctx, span := r.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if id == "" {
+		return nil, platformerrors.ErrInvalidIDProvided
+	}
+
+	logger := r.logger.Clone()
+	logger = logger.WithValue(paymentskeys.PaymentProviderEventIDKey, id)
+	tracing.AttachToSpan(span, paymentskeys.PaymentProviderEventIDKey, id)
+
+	result, err := r.generatedQuerier.ClaimPaymentProviderEvent(ctx, r.writeDB, &generated.ClaimPaymentProviderEventParams{
+		ID:              id,
+		IncludeFinished: includeFinished,
+	})
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, observability.PrepareAndLogError(err, logger, span, "claiming payment provider event")
+	}
+
+	return convertPaymentProviderEventFromGenerated(result), nil
+}
+
+// UpdateSubscriptionStatusForProviderEvent applies a claimed provider event's status change to its subscription. The
+// subscription row is locked while the event is checked against the latest applied event, so concurrent events for
+// the same subscription apply one at a time. Events older than the latest applied one are marked stale instead, and
+// false is returned; otherwise the event is marked applied in the same transaction as the status change.
+func (r *repository) UpdateSubscriptionStatusForProviderEvent(ctx context.Context, event *payments.PaymentProviderEvent, status string) (*payments.Subscription, bool, error) {
+	ctx, span := r.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if event == nil {
+		return nil, false, platformerrors.ErrNilInputProvided
+	}
+
+	if event.ExternalSubscriptionID == "" {
+		return nil, false, platformerrors.ErrInvalidIDProvided
+	}
+
+	logger := r.logger.Clone()
+	logger = logger.WithValue(paymentskeys.PaymentProviderEventIDKey, event.ID).WithValue("external_subscription_id", event.ExternalSubscriptionID)
+	tracing.AttachToSpan(span, paymentskeys.PaymentProviderEventIDKey, event.ID)
+
+	tx, err := r.writeDB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, false, observability.PrepareAndLogError(err, logger, span, "beginning transaction")
+	}
+
+	result, err := r.generatedQuerier.GetSubscriptionByExternalIDForUpdate(ctx, tx, sql.NullString{String: event.ExternalSubscriptionID, Valid: true})
+	if err != nil {
+		r.RollbackTransaction(ctx, tx)
+		return nil, false, observability.PrepareAndLogError(err, logger, span, "locking subscription")
+	}
+	subscription := convertSubscriptionFromGenerated(result)
+
+	lastAppliedAt, err := r.generatedQuerier.GetLatestAppliedPaymentProviderEventTime(ctx, tx, &generated.GetLatestAppliedPaymentProviderEventTimeParams{
+		Provider:               event.Provider,
+		ExternalSubscriptionID: event.ExternalSubscriptionID,
+	})
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
+		r.RollbackTransaction(ctx, tx)
+		return nil, false, observability.PrepareAndLogError(err, logger, span, "fetching latest applied payment provider event time")
+	}
+
+	eventStatus := payments.PaymentProviderEventStatusApplied
+	stale := err == nil && event.OccurredAt.Before(lastAppliedAt)
+	if stale {
+		eventStatus = payments.PaymentProviderEventStatusStale
+	} else {
+		if _, err = r.generatedQuerier.UpdateSubscriptionStatus(ctx, tx, &generated.UpdateSubscriptionStatusParams{
+			ID:     subscription.ID,
+			Status: generated.SubscriptionStatus(status),
+		}); err != nil {
+			r.RollbackTransaction(ctx, tx)
+			return nil, false, observability.PrepareAndLogError(err, logger, span, "updating subscription status")
+		}
+
+		if _, err = r.auditLogEntryRepo.CreateAuditLogEntry(ctx, tx, &audit.AuditLogEntryDatabaseCreationInput{
+			BelongsToAccount: &subscription.BelongsToAccount,
+			ID:               identifiers.New(),
+			ResourceType:     resourceTypeSubscriptions,
+			RelevantID:       subscription.ID,
+			EventType:        audit.AuditLogEventTypeUpdated,
+		}); err != nil {
+			r.RollbackTransaction(ctx, tx)
+			return nil, false, observability.PrepareError(err, span, "creating audit log entry")
+		}
+		subscription.Status = status
+	}
+
+	if _, err = r.generatedQuerier.UpdatePaymentProviderEventStatus(ctx, tx, &generated.UpdatePaymentProviderEventStatusParams{
+		ID:     event.ID,
+		Status: generated.PaymentProviderEventStatus(eventStatus),
+	}); err != nil {
+		r.RollbackTransaction(ctx, tx)
+		return nil, false, observability.PrepareAndLogError(err, logger, span, "updating payment provider event status")
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, false, observability.PrepareAndLogError(err, logger, span, "committing transaction")
+	}
+
+	return subscription, !stale, nil
+}
+
+func (r *repository) UpdatePaymentProviderEventStatus(ctx context.Context, id, status, processingError string) error {
+	ctx, span := r.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if id == "" {
+		return platformerrors.ErrInvalidIDProvided
+	}
+
+	logger := r.logger.Clone()
+	logger = logger.WithValue(paymentskeys.PaymentProviderEventIDKey, id).WithValue("status", status)
+	tracing.AttachToSpan(span, paymentskeys.PaymentProviderEventIDKey, id)
+
+	rowsAffected, err := r.generatedQuerier.UpdatePaymentProviderEventStatus(ctx, r.writeDB, &generated.UpdatePaymentProviderEventStatusParams{
+		ID:              id,
+		Status:          generated.PaymentProviderEventStatus(status),
+		ProcessingError: processingError,
+	})
+	if err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "updating payment provider event status")
+	}
+
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+
+	return nil
+}
+
+func convertPaymentProviderEventFromGenerated(row *generated.PaymentProviderEvents) *payments.PaymentProviderEvent {
+	return &payments.PaymentProviderEvent{
+		ID:                     row.ID,
+		Provider:               row.Provider,
+		ProviderEventID:        row.ProviderEventID,
+		EventType:              row.EventType,
+		ExternalSubscriptionID: row.ExternalSubscriptionID,
+		AccountID:              row.AccountID,
+		Payload:                row.Payload,
+		Status:                 string(row.Status),
+		ProcessingError:        row.ProcessingError,
+		OccurredAt:             row.OccurredAt,
+		ProcessedAt:            database.TimePointerFromNullTime(row.ProcessedAt),
+		CreatedAt:              row.CreatedAt,
+	}
+}
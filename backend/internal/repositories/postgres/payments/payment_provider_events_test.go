@@ -0,0 +1,291 @@
+package payments
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/payments"
+	pgtesting "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/repositories/postgres/testing"
+
+	platformerrors "github.com/primandproper/platform/errors"
+	"github.com/primandproper/platform/identifiers"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// --- Unit tests ---
+
+func TestCreatePaymentProviderEvent(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with nil input", func(t *testing.T) {
+		t.Parallel()
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		created, err := c.CreatePaymentProviderEvent(ctx, nil)
+		assert.False(t, created)
+		assert.ErrorIs(t, err, platformerrors.ErrNilInputProvided)
+	})
+}
+
+func TestGetPaymentProviderEvent(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with empty id", func(t *testing.T) {
+		t.Parallel()
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		actual, err := c.GetPaymentProviderEvent(ctx, "")
+		assert.Nil(t, actual)
+		assert.ErrorIs(t, err, platformerrors.ErrInvalidIDProvided)
+	})
+}
+
+func TestGetLatestAppliedPaymentProviderEventTime(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with empty external subscription id", func(t *testing.T) {
+		t.Parallel()
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		actual, err := c.GetLatestAppliedPaymentProviderEventTime(ctx, "stripe", "")
+		assert.Nil(t, actual)
+		assert.ErrorIs(t, err, platformerrors.ErrInvalidIDProvided)
+	})
+}
+
+func TestClaimPaymentProviderEvent(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with empty id", func(t *testing.T) {
+		t.Parallel()
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		actual, err := c.ClaimPaymentProviderEvent(ctx, "", false)
+		assert.Nil(t, actual)
+		assert.ErrorIs(t, err, platformerrors.ErrInvalidIDProvided)
+	})
+}
+
+func TestUpdateSubscriptionStatusForProviderEvent(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with nil event", func(t *testing.T) {
+		t.Parallel()
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		actual, applied, err := c.UpdateSubscriptionStatusForProviderEvent(ctx, nil, payments.SubscriptionStatusActive)
+		assert.Nil(t, actual)
+		assert.False(t, applied)
+		assert.ErrorIs(t, err, platformerrors.ErrNilInputProvided)
+	})
+
+	T.Run("with empty external subscription id", func(t *testing.T) {
+		t.Parallel()
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		actual, applied, err := c.UpdateSubscriptionStatusForProviderEvent(ctx, &payments.PaymentProviderEvent{ID: identifiers.New()}, payments.SubscriptionStatusActive)
+		assert.Nil(t, actual)
+		assert.False(t, applied)
+		assert.ErrorIs(t, err, platformerrors.ErrInvalidIDProvided)
+	})
+}
+
+func TestUpdatePaymentProviderEventStatus(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with empty id", func(t *testing.T) {
+		t.Parallel()
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		err := c.UpdatePaymentProviderEventStatus(ctx, "", payments.PaymentProviderEventStatusApplied, "")
+		assert.ErrorIs(t, err, platformerrors.ErrInvalidIDProvided)
+	})
+}
+
+// --- Integration tests ---
+
+func TestQuerier_Integration_PaymentProviderEvents(t *testing.T) {
+	if !pgtesting.RunContainerTests {
+		t.SkipNow()
+	}
+
+	ctx := t.Context()
+	dbc, _, container := buildDatabaseClientForTest(t)
+
+	_, err := container.ConnectionString(ctx)
+	require.NoError(t, err)
+
+	defer func(t *testing.T) {
+		t.Helper()
+		assert.NoError(t, container.Terminate(ctx))
+	}(t)
+
+	externalSubscriptionID := "ext_sub_" + identifiers.New()
+	occurredAt := time.Now().Add(-time.Hour).UTC().Truncate(time.Second)
+
+	input := &payments.PaymentProviderEventDatabaseCreationInput{
+		ID:                     identifiers.New(),
+		Provider:               "stripe",
+		ProviderEventID:        "evt_" + identifiers.New(),
+		EventType:              "customer.subscription.updated",
+		ExternalSubscriptionID: externalSubscriptionID,
+		Payload:                []byte(`{}`),
+		OccurredAt:             occurredAt,
+	}
+
+	created, err := dbc.CreatePaymentProviderEvent(ctx, input)
+	require.NoError(t, err)
+	assert.True(t, created)
+
+	// redelivery of the same provider event is not stored twice
+	duplicate := *input
+	duplicate.ID = identifiers.New()
+	created, err = dbc.CreatePaymentProviderEvent(ctx, &duplicate)
+	require.NoError(t, err)
+	assert.False(t, created)
+
+	fetched, err := dbc.GetPaymentProviderEventByProviderEventID(ctx, input.Provider, input.ProviderEventID)
+	require.NoError(t, err)
+	assert.Equal(t, input.ID, fetched.ID)
+	assert.Equal(t, payments.PaymentProviderEventStatusReceived, fetched.Status)
+	assert.Nil(t, fetched.ProcessedAt)
+
+	latest, err := dbc.GetLatestAppliedPaymentProviderEventTime(ctx, input.Provider, externalSubscriptionID)
+	require.NoError(t, err)
+	assert.Nil(t, latest)
+
+	// only one worker can claim an event
+	claimed, err := dbc.ClaimPaymentProviderEvent(ctx, input.ID, false)
+	require.NoError(t, err)
+	require.NotNil(t, claimed)
+	assert.Equal(t, payments.PaymentProviderEventStatusProcessing, claimed.Status)
+
+	claimed, err = dbc.ClaimPaymentProviderEvent(ctx, input.ID, true)
+	require.NoError(t, err)
+	assert.Nil(t, claimed)
+
+	require.NoError(t, dbc.UpdatePaymentProviderEventStatus(ctx, input.ID, payments.PaymentProviderEventStatusApplied, ""))
+
+	fetched, err = dbc.GetPaymentProviderEvent(ctx, input.ID)
+	require.NoError(t, err)
+	assert.Equal(t, payments.PaymentProviderEventStatusApplied, fetched.Status)
+	assert.NotNil(t, fetched.ProcessedAt)
+
+	latest, err = dbc.GetLatestAppliedPaymentProviderEventTime(ctx, input.Provider, externalSubscriptionID)
+	require.NoError(t, err)
+	require.NotNil(t, latest)
+	assert.True(t, occurredAt.Equal(*latest))
+
+	// applied events are only claimed again for replays
+	claimed, err = dbc.ClaimPaymentProviderEvent(ctx, input.ID, false)
+	require.NoError(t, err)
+	assert.Nil(t, claimed)
+
+	claimed, err = dbc.ClaimPaymentProviderEvent(ctx, input.ID, true)
+	require.NoError(t, err)
+	require.NotNil(t, claimed)
+
+	// ignored events are claimed again when they're redelivered
+	require.NoError(t, dbc.UpdatePaymentProviderEventStatus(ctx, input.ID, payments.PaymentProviderEventStatusIgnored, "subscription not found"))
+
+	claimed, err = dbc.ClaimPaymentProviderEvent(ctx, input.ID, false)
+	require.NoError(t, err)
+	require.NotNil(t, claimed)
+	require.NoError(t, dbc.UpdatePaymentProviderEventStatus(ctx, input.ID, payments.PaymentProviderEventStatusApplied, ""))
+
+	events, err := dbc.GetPaymentProviderEvents(ctx, input.Provider, nil)
+	require.NoError(t, err)
+	assert.Len(t, events.Data, 1)
+
+	events, err = dbc.GetPaymentProviderEvents(ctx, "revenuecat", nil)
+	require.NoError(t, err)
+	assert.Empty(t, events.Data)
+}
+
+func TestQuerier_Integration_UpdateSubscriptionStatusForProviderEvent(t *testing.T) {
+	if !pgtesting.RunContainerTests {
+		t.SkipNow()
+	}
+
+	ctx := t.Context()
+	dbc, _, container := buildDatabaseClientForTest(t)
+
+	_, err := container.ConnectionString(ctx)
+	require.NoError(t, err)
+
+	defer func(t *testing.T) {
+		t.Helper()
+		assert.NoError(t, container.Terminate(ctx))
+	}(t)
+
+	user := pgtesting.CreateUserForTest(t, nil, dbc.writeDB)
+	account := pgtesting.CreateAccountForTest(t, nil, user.ID, dbc.writeDB)
+	product := createProductForTest(t, ctx, nil, dbc)
+
+	now := time.Now().UTC()
+	subscription, err := dbc.CreateSubscription(ctx, &payments.SubscriptionDatabaseCreationInput{
+		ID:                     identifiers.New(),
+		BelongsToAccount:       account.ID,
+		ProductID:              product.ID,
+		ExternalSubscriptionID: "ext_sub_" + identifiers.New(),
+		Status:                 payments.SubscriptionStatusActive,
+		CurrentPeriodStart:     now,
+		CurrentPeriodEnd:       now.AddDate(0, 1, 0),
+	})
+	require.NoError(t, err)
+
+	createAndClaimEvent := func(occurredAt time.Time) *payments.PaymentProviderEvent {
+		input := &payments.PaymentProviderEventDatabaseCreationInput{
+			ID:                     identifiers.New(),
+			Provider:               "stripe",
+			ProviderEventID:        "evt_" + identifiers.New(),
+			EventType:              "customer.subscription.updated",
+			ExternalSubscriptionID: subscription.ExternalSubscriptionID,
+			AccountID:              account.ID,
+			Payload:                []byte(`{}`),
+			OccurredAt:             occurredAt,
+		}
+		created, createErr := dbc.CreatePaymentProviderEvent(ctx, input)
+		require.NoError(t, createErr)
+		require.True(t, created)
+
+		claimed, claimErr := dbc.ClaimPaymentProviderEvent(ctx, input.ID, false)
+		require.NoError(t, claimErr)
+		require.NotNil(t, claimed)
+
+		return claimed
+	}
+
+	newer := createAndClaimEvent(now.Add(-time.Minute).Truncate(time.Second))
+	updated, applied, err := dbc.UpdateSubscriptionStatusForProviderEvent(ctx, newer, payments.SubscriptionStatusPastDue)
+	require.NoError(t, err)
+	assert.True(t, applied)
+	assert.Equal(t, payments.SubscriptionStatusPastDue, updated.Status)
+
+	fetchedEvent, err := dbc.GetPaymentProviderEvent(ctx, newer.ID)
+	require.NoError(t, err)
+	assert.Equal(t, payments.PaymentProviderEventStatusApplied, fetchedEvent.Status)
+
+	// an event that occurred before the applied one doesn't regress the subscription
+	older := createAndClaimEvent(now.Add(-time.Hour).Truncate(time.Second))
+	_, applied, err = dbc.UpdateSubscriptionStatusForProviderEvent(ctx, older, payments.SubscriptionStatusActive)
+	require.NoError(t, err)
+	assert.False(t, applied)
+
+	fetchedEvent, err = dbc.GetPaymentProviderEvent(ctx, older.ID)
+	require.NoError(t, err)
+	assert.Equal(t, payments.PaymentProviderEventStatusStale, fetchedEvent.Status)
+
+	fetchedSubscription, err := dbc.GetSubscription(ctx, subscription.ID)
+	require.NoError(t, err)
+	assert.Equal(t, payments.SubscriptionStatusPastDue, fetchedSubscription.Status)
+}
@@ -2,6 +2,7 @@
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/audit"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/payments"
@@ -32,6 +33,11 @@ func (r *repository) CreateProduct(ctx context.Context, input *payments.ProductD
 	logger = logger.WithValue(paymentskeys.ProductIDKey, input.ID)
 	tracing.AttachToSpan(span, paymentskeys.ProductIDKey, input.ID)
 
+	entitlements, err := json.Marshal(&input.Entitlements)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "marshaling product entitlements")
+	}
+
 	arg := &generated.CreateProductParams{
 		ID:                    input.ID,
 		Name:                  input.Name,
@@ -41,13 +47,14 @@ func (r *repository) CreateProduct(ctx context.Context, input *payments.ProductD
 		Currency:              input.Currency,
 		BillingIntervalMonths: database.NullInt32FromInt32Pointer(input.BillingIntervalMonths),
 		ExternalProductID:     database.NullStringFromString(input.ExternalProductID),
+		Entitlements:          entitlements,
 	}
 
-	if err := r.generatedQuerier.CreateProduct(ctx, r.writeDB, arg); err != nil {
+	if err = r.generatedQuerier.CreateProduct(ctx, r.writeDB, arg); err != nil {
 		return nil, observability.PrepareAndLogError(err, logger, span, "creating product")
 	}
 
-	if _, err := r.auditLogEntryRepo.CreateAuditLogEntry(ctx, r.writeDB, &audit.AuditLogEntryDatabaseCreationInput{
+	if _, err = r.auditLogEntryRepo.CreateAuditLogEntry(ctx, r.writeDB, &audit.AuditLogEntryDatabaseCreationInput{
 		ID:           identifiers.New(),
 		ResourceType: resourceTypeProducts,
 		RelevantID:   input.ID,
@@ -75,7 +82,12 @@ func (r *repository) GetProduct(ctx context.Context, id string) (*payments.Produ
 		return nil, observability.PrepareAndLogError(err, logger, span, "fetching product")
 	}
 
-	return convertProductFromGenerated(result), nil
+	product, err := convertProductFromGenerated(result)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "converting product")
+	}
+
+	return product, nil
 }
 
 func (r *repository) GetProductByExternalID(ctx context.Context, externalProductID string) (*payments.Product, error) {
@@ -94,7 +106,12 @@ func (r *repository) GetProductByExternalID(ctx context.Context, externalProduct
 		return nil, observability.PrepareAndLogError(err, logger, span, "fetching product by external ID")
 	}
 
-	return convertProductFromGenerated(result), nil
+	product, err := convertProductFromGenerated((*generated.GetProductRow)(result))
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "converting product")
+	}
+
+	return product, nil
 }
 
 func (r *repository) GetProducts(ctx context.Context, filter *filtering.QueryFilter) (*filtering.QueryFilteredResult[payments.Product], error) {
@@ -123,7 +140,12 @@ func (r *repository) GetProducts(ctx context.Context, filter *filtering.QueryFil
 		return nil, observability.PrepareAndLogError(err, logger, span, "fetching products")
 	}
 
-	return convertProductsResult(results, filter), nil
+	products, err := convertProductsResult(results, filter)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "converting products")
+	}
+
+	return products, nil
 }
 
 func (r *repository) UpdateProduct(ctx context.Context, product *payments.Product) error {
@@ -138,6 +160,11 @@ func (r *repository) UpdateProduct(ctx context.Context, product *payments.Produc
 	logger = logger.WithValue(paymentskeys.ProductIDKey, product.ID)
 	tracing.AttachToSpan(span, paymentskeys.ProductIDKey, product.ID)
 
+	entitlements, err := json.Marshal(&product.Entitlements)
+	if err != nil {
+		return observability.PrepareAndLogError(err, logger, span, "marshaling product entitlements")
+	}
+
 	arg := &generated.UpdateProductParams{
 		ID:                    product.ID,
 		Name:                  product.Name,
@@ -147,10 +174,10 @@ func (r *repository) UpdateProduct(ctx context.Context, product *payments.Produc
 		Currency:              product.Currency,
 		BillingIntervalMonths: database.NullInt32FromInt32Pointer(product.BillingIntervalMonths),
 		ExternalProductID:     database.NullStringFromString(product.ExternalProductID),
+		Entitlements:          entitlements,
 	}
 
-	_, err := r.generatedQuerier.UpdateProduct(ctx, r.writeDB, arg)
-	if err != nil {
+	if _, err = r.generatedQuerier.UpdateProduct(ctx, r.writeDB, arg); err != nil {
 		return observability.PrepareAndLogError(err, logger, span, "updating product")
 	}
 
@@ -209,10 +236,16 @@ func (r *repository) ProductExists(ctx context.Context, id string) (bool, error)
 	return result, nil
 }
 
-func convertProductFromGenerated(m *generated.Products) *payments.Product {
+func convertProductFromGenerated(m *generated.GetProductRow) (*payments.Product, error) {
 	if m == nil {
-		return nil
+		return nil, nil
+	}
+
+	var entitlements payments.ProductEntitlements
+	if err := json.Unmarshal(m.Entitlements, &entitlements); err != nil {
+		return nil, err
 	}
+
 	return &payments.Product{
 		ID:                    m.ID,
 		Name:                  m.Name,
@@ -222,16 +255,23 @@ func convertProductFromGenerated(m *generated.Products) *payments.Product {
 		Currency:              m.Currency,
 		BillingIntervalMonths: database.Int32PointerFromNullInt32(m.BillingIntervalMonths),
 		ExternalProductID:     database.StringFromNullString(m.ExternalProductID),
+		Entitlements:          entitlements,
 		CreatedAt:             m.CreatedAt,
 		LastUpdatedAt:         database.TimePointerFromNullTime(m.LastUpdatedAt),
 		ArchivedAt:            database.TimePointerFromNullTime(m.ArchivedAt),
-	}
+	}, nil
 }
 
-func convertProductFromRow(row *generated.GetProductsRow) *payments.Product {
+func convertProductFromRow(row *generated.GetProductsRow) (*payments.Product, error) {
 	if row == nil {
-		return nil
+		return nil, nil
+	}
+
+	var entitlements payments.ProductEntitlements
+	if err := json.Unmarshal(row.Entitlements, &entitlements); err != nil {
+		return nil, err
 	}
+
 	return &payments.Product{
 		ID:                    row.ID,
 		Name:                  row.Name,
@@ -241,17 +281,22 @@ func convertProductFromRow(row *generated.GetProductsRow) *payments.Product {
 		Currency:              row.Currency,
 		BillingIntervalMonths: database.Int32PointerFromNullInt32(row.BillingIntervalMonths),
 		ExternalProductID:     database.StringFromNullString(row.ExternalProductID),
+		Entitlements:          entitlements,
 		CreatedAt:             row.CreatedAt,
 		LastUpdatedAt:         database.TimePointerFromNullTime(row.LastUpdatedAt),
 		ArchivedAt:            database.TimePointerFromNullTime(row.ArchivedAt),
-	}
+	}, nil
 }
 
-func convertProductsResult(rows []*generated.GetProductsRow, filter *filtering.QueryFilter) *filtering.QueryFilteredResult[payments.Product] {
+func convertProductsResult(rows []*generated.GetProductsRow, filter *filtering.QueryFilter) (*filtering.QueryFilteredResult[payments.Product], error) {
 	data := make([]*payments.Product, 0, len(rows))
 	var filteredCount, totalCount uint64
 	for _, row := range rows {
-		data = append(data, convertProductFromRow(row))
+		product, err := convertProductFromRow(row)
+		if err != nil {
+			return nil, err
+		}
+		data = append(data, product)
 		filteredCount = uint64(row.FilteredCount)
 		totalCount = uint64(row.TotalCount)
 	}
@@ -261,5 +306,5 @@ func convertProductsResult(rows []*generated.GetProductsRow, filter *filtering.Q
 		totalCount,
 		func(p *payments.Product) string { return p.ID },
 		filter,
-	)
+	), nil
 }
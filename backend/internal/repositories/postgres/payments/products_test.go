@@ -33,6 +33,7 @@ func createProductForTest(t *testing.T, ctx context.Context, input *payments.Pro
 			Currency:              product.Currency,
 			BillingIntervalMonths: product.BillingIntervalMonths,
 			ExternalProductID:     product.ExternalProductID,
+			Entitlements:          product.Entitlements,
 		}
 	}
 
@@ -160,11 +161,13 @@ func TestQuerier_Integration_Products(t *testing.T) {
 		Currency:              product.Currency,
 		BillingIntervalMonths: product.BillingIntervalMonths,
 		ExternalProductID:     product.ExternalProductID,
+		Entitlements:          product.Entitlements,
 	}
 
 	created := createProductForTest(t, ctx, input, dbc)
 	assert.Equal(t, product.ID, created.ID)
 	assert.Equal(t, product.Name, created.Name)
+	assert.Equal(t, product.Entitlements, created.Entitlements)
 
 	// Get by ID
 	fetched, err := dbc.GetProduct(ctx, created.ID)
@@ -185,12 +188,14 @@ func TestQuerier_Integration_Products(t *testing.T) {
 
 	// Update
 	created.Name = "Updated Name"
+	created.Entitlements.MaxAccountMembers = payments.UnlimitedQuantity
 	err = dbc.UpdateProduct(ctx, created)
 	require.NoError(t, err)
 
 	updated, err := dbc.GetProduct(ctx, created.ID)
 	require.NoError(t, err)
 	assert.Equal(t, "Updated Name", updated.Name)
+	assert.Equal(t, payments.UnlimitedQuantity, updated.Entitlements.MaxAccountMembers)
 
 	// List
 	products, err := dbc.GetProducts(ctx, nil)
@@ -0,0 +1,133 @@
+-- name: CreatePaymentProviderEvent :execrows
+INSERT INTO payment_provider_events (
+	id,
+	provider,
+	provider_event_id,
+	event_type,
+	external_subscription_id,
+	account_id,
+	payload,
+	occurred_at
+) VALUES (
+	sqlc.arg(id),
+	sqlc.arg(provider),
+	sqlc.arg(provider_event_id),
+	sqlc.arg(event_type),
+	sqlc.arg(external_subscription_id),
+	sqlc.arg(account_id),
+	sqlc.arg(payload),
+	sqlc.arg(occurred_at)
+)
+ON CONFLICT (provider, provider_event_id) DO NOTHING;
+
+-- name: GetPaymentProviderEvent :one
+SELECT
+	payment_provider_events.id,
+	payment_provider_events.provider,
+	payment_provider_events.provider_event_id,
+	payment_provider_events.event_type,
+	payment_provider_events.external_subscription_id,
+	payment_provider_events.account_id,
+	payment_provider_events.payload,
+	payment_provider_events.status,
+	payment_provider_events.processing_error,
+	payment_provider_events.occurred_at,
+	payment_provider_events.processed_at,
+	payment_provider_events.created_at
+FROM payment_provider_events
+WHERE payment_provider_events.id = sqlc.arg(id);
+
+-- name: GetPaymentProviderEventByProviderEventID :one
+SELECT
+	payment_provider_events.id,
+	payment_provider_events.provider,
+	payment_provider_events.provider_event_id,
+	payment_provider_events.event_type,
+	payment_provider_events.external_subscription_id,
+	payment_provider_events.account_id,
+	payment_provider_events.payload,
+	payment_provider_events.status,
+	payment_provider_events.processing_error,
+	payment_provider_events.occurred_at,
+	payment_provider_events.processed_at,
+	payment_provider_events.created_at
+FROM payment_provider_events
+WHERE payment_provider_events.provider = sqlc.arg(provider)
+AND payment_provider_events.provider_event_id = sqlc.arg(provider_event_id);
+
+-- name: GetPaymentProviderEvents :many
+SELECT
+	payment_provider_events.id,
+	payment_provider_events.provider,
+	payment_provider_events.provider_event_id,
+	payment_provider_events.event_type,
+	payment_provider_events.external_subscription_id,
+	payment_provider_events.account_id,
+	payment_provider_events.payload,
+	payment_provider_events.status,
+	payment_provider_events.processing_error,
+	payment_provider_events.occurred_at,
+	payment_provider_events.processed_at,
+	payment_provider_events.created_at,
+	(
+		SELECT COUNT(payment_provider_events.id)
+		FROM payment_provider_events
+		WHERE
+			payment_provider_events.created_at > COALESCE(sqlc.narg(created_after), (SELECT NOW() - '999 years'::INTERVAL))
+			AND payment_provider_events.created_at < COALESCE(sqlc.narg(created_before), (SELECT NOW() + '999 years'::INTERVAL))
+			AND payment_provider_events.provider = COALESCE(sqlc.narg(provider), payment_provider_events.provider)
+	) AS filtered_count,
+	(
+		SELECT COUNT(payment_provider_events.id)
+		FROM payment_provider_events
+		WHERE
+			payment_provider_events.provider = COALESCE(sqlc.narg(provider), payment_provider_events.provider)
+	) AS total_count
+FROM payment_provider_events
+WHERE payment_provider_events.provider = COALESCE(sqlc.narg(provider), payment_provider_events.provider)
+	AND payment_provider_events.created_at > COALESCE(sqlc.narg(created_after), (SELECT NOW() - '999 years'::INTERVAL))
+	AND payment_provider_events.created_at < COALESCE(sqlc.narg(created_before), (SELECT NOW() + '999 years'::INTERVAL))
+	AND payment_provider_events.id > COALESCE(sqlc.narg(cursor), '')
+ORDER BY payment_provider_events.id ASC
+LIMIT COALESCE(sqlc.narg(result_limit), 50);
+
+-- name: GetLatestAppliedPaymentProviderEventTime :one
+SELECT
+	payment_provider_events.occurred_at
+FROM payment_provider_events
+WHERE payment_provider_events.provider = sqlc.arg(provider)
+AND payment_provider_events.external_subscription_id = sqlc.arg(external_subscription_id)
+AND payment_provider_events.status = 'applied'
+ORDER BY payment_provider_events.occurred_at DESC
+LIMIT 1;
+
+-- name: ClaimPaymentProviderEvent :one
+UPDATE payment_provider_events SET
+	status = 'processing',
+	processed_at = NOW()
+WHERE payment_provider_events.id = sqlc.arg(id)
+AND (
+	payment_provider_events.status IN ('received', 'failed', 'ignored')
+	OR (sqlc.arg(include_finished)::BOOLEAN AND payment_provider_events.status IN ('applied', 'stale'))
+	OR (payment_provider_events.status = 'processing' AND payment_provider_events.processed_at < NOW() - interval '10 minutes')
+)
+RETURNING
+	payment_provider_events.id,
+	payment_provider_events.provider,
+	payment_provider_events.provider_event_id,
+	payment_provider_events.event_type,
+	payment_provider_events.external_subscription_id,
+	payment_provider_events.account_id,
+	payment_provider_events.payload,
+	payment_provider_events.status,
+	payment_provider_events.processing_error,
+	payment_provider_events.occurred_at,
+	payment_provider_events.processed_at,
+	payment_provider_events.created_at;
+
+-- name: UpdatePaymentProviderEventStatus :execrows
+UPDATE payment_provider_events SET
+	status = sqlc.arg(status),
+	processing_error = sqlc.arg(processing_error),
+	processed_at = NOW()
+WHERE id = sqlc.arg(id);
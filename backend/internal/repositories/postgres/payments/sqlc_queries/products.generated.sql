@@ -10,7 +10,8 @@ INSERT INTO products (
 	amount_cents,
 	currency,
 	billing_interval_months,
-	external_product_id
+	external_product_id,
+	entitlements
 ) VALUES (
 	sqlc.arg(id),
 	sqlc.arg(name),
@@ -19,7 +20,8 @@ INSERT INTO products (
 	sqlc.arg(amount_cents),
 	sqlc.arg(currency),
 	sqlc.arg(billing_interval_months),
-	sqlc.arg(external_product_id)
+	sqlc.arg(external_product_id),
+	sqlc.arg(entitlements)
 );
 
 -- name: CheckProductExistence :one
@@ -40,6 +42,7 @@ SELECT
 	products.currency,
 	products.billing_interval_months,
 	products.external_product_id,
+	products.entitlements,
 	products.created_at,
 	products.last_updated_at,
 	products.archived_at
@@ -57,6 +60,7 @@ SELECT
 	products.currency,
 	products.billing_interval_months,
 	products.external_product_id,
+	products.entitlements,
 	products.created_at,
 	products.last_updated_at,
 	products.archived_at
@@ -74,6 +78,7 @@ SELECT
 	products.currency,
 	products.billing_interval_months,
 	products.external_product_id,
+	products.entitlements,
 	products.created_at,
 	products.last_updated_at,
 	products.archived_at,
@@ -126,6 +131,7 @@ SELECT
 	products.currency,
 	products.billing_interval_months,
 	products.external_product_id,
+	products.entitlements,
 	products.created_at,
 	products.last_updated_at,
 	products.archived_at,
@@ -178,6 +184,7 @@ UPDATE products SET
 	currency = sqlc.arg(currency),
 	billing_interval_months = sqlc.arg(billing_interval_months),
 	external_product_id = sqlc.arg(external_product_id),
+	entitlements = sqlc.arg(entitlements),
 	last_updated_at = NOW()
 WHERE archived_at IS NULL
 	AND id = sqlc.arg(id);
@@ -52,6 +52,41 @@ FROM subscriptions
 WHERE subscriptions.archived_at IS NULL
 AND subscriptions.external_subscription_id = sqlc.arg(external_subscription_id);
 
+-- name: GetSubscriptionByExternalIDForUpdate :one
+SELECT
+	subscriptions.id,
+	subscriptions.belongs_to_account,
+	subscriptions.product_id,
+	subscriptions.external_subscription_id,
+	subscriptions.status,
+	subscriptions.current_period_start,
+	subscriptions.current_period_end,
+	subscriptions.created_at,
+	subscriptions.last_updated_at,
+	subscriptions.archived_at
+FROM subscriptions
+WHERE subscriptions.archived_at IS NULL
+AND subscriptions.external_subscription_id = sqlc.arg(external_subscription_id)
+FOR UPDATE;
+
+-- name: GetSubscriptionsForAccountEndingAfter :many
+SELECT
+	subscriptions.id,
+	subscriptions.belongs_to_account,
+	subscriptions.product_id,
+	subscriptions.external_subscription_id,
+	subscriptions.status,
+	subscriptions.current_period_start,
+	subscriptions.current_period_end,
+	subscriptions.created_at,
+	subscriptions.last_updated_at,
+	subscriptions.archived_at
+FROM subscriptions
+WHERE subscriptions.archived_at IS NULL
+	AND subscriptions.belongs_to_account = sqlc.arg(belongs_to_account)
+	AND subscriptions.current_period_end > sqlc.arg(period_ends_after)
+ORDER BY subscriptions.created_at;
+
 -- name: GetSubscriptionsForAccount :many
 SELECT
 	subscriptions.id,
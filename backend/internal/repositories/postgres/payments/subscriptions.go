@@ -3,8 +3,10 @@
 import (
 	"context"
 	"database/sql"
+	"time"
 
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/audit"
+	identitykeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity/keys"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/payments"
 	paymentskeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/payments/keys"
 	generated "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/repositories/postgres/payments/generated"
@@ -130,6 +132,33 @@ func (r *repository) GetSubscriptionsForAccount(ctx context.Context, accountID s
 	return convertSubscriptionsResult(results, filter), nil
 }
 
+func (r *repository) GetSubscriptionsForAccountEndingAfter(ctx context.Context, accountID string, periodEndsAfter time.Time) ([]*payments.Subscription, error) {
+	ctx, span := r.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if accountID == "" {
+		return nil, platformerrors.ErrInvalidIDProvided
+	}
+
+	logger := r.logger.WithValue(identitykeys.AccountIDKey, accountID)
+	tracing.AttachToSpan(span, identitykeys.AccountIDKey, accountID)
+
+	results, err := r.generatedQuerier.GetSubscriptionsForAccountEndingAfter(ctx, r.readDB, &generated.GetSubscriptionsForAccountEndingAfterParams{
+		BelongsToAccount: accountID,
+		PeriodEndsAfter:  periodEndsAfter,
+	})
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching current subscriptions for account")
+	}
+
+	subscriptions := make([]*payments.Subscription, 0, len(results))
+	for _, result := range results {
+		subscriptions = append(subscriptions, convertSubscriptionFromGenerated(result))
+	}
+
+	return subscriptions, nil
+}
+
 func (r *repository) UpdateSubscription(ctx context.Context, sub *payments.Subscription) error {
 	ctx, span := r.tracer.StartSpan(ctx)
 	defer span.End()
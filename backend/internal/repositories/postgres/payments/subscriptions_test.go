@@ -61,6 +61,21 @@ func TestGetSubscriptionsForAccount(T *testing.T) {
 	})
 }
 
+func TestGetSubscriptionsForAccountEndingAfter(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with empty account id", func(t *testing.T) {
+		t.Parallel()
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		actual, err := c.GetSubscriptionsForAccountEndingAfter(ctx, "", time.Now())
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+		assert.ErrorIs(t, err, platformerrors.ErrInvalidIDProvided)
+	})
+}
+
 func TestUpdateSubscription(T *testing.T) {
 	T.Parallel()
 
@@ -161,6 +176,15 @@ func TestQuerier_Integration_Subscriptions(t *testing.T) {
 	require.NoError(t, err)
 	assert.Len(t, subs.Data, 1)
 
+	// List subscriptions still in their billing period
+	current, err := dbc.GetSubscriptionsForAccountEndingAfter(ctx, account.ID, now)
+	require.NoError(t, err)
+	assert.Len(t, current, 1)
+
+	expired, err := dbc.GetSubscriptionsForAccountEndingAfter(ctx, account.ID, periodEnd.Add(time.Hour))
+	require.NoError(t, err)
+	assert.Empty(t, expired)
+
 	// Update status
 	err = dbc.UpdateSubscriptionStatus(ctx, created.ID, payments.SubscriptionStatusCancelled)
 	require.NoError(t, err)
@@ -20,7 +20,7 @@
 
 const (
 	exampleQuantity              = 3
-	migratedServiceSettingsCount = 1 // user_temperature_unit from migration 00021
+	migratedServiceSettingsCount = 2 // user_temperature_unit from migration 00021, meal_plan_option_allergen_policy from migration 00025
 )
 
 func buildDatabaseClientForTest(t *testing.T) (c *Repository, auditLogEntryRepo audit.Repository, container *pgcontainers.PostgresContainer) {
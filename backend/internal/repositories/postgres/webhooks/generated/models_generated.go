@@ -69,6 +69,67 @@ func AllWebhookContentTypeValues() []WebhookContentType {
 	}
 }
 
+type WebhookDeliveryStatus string
+
+const (
+	WebhookDeliveryStatusSucceeded    WebhookDeliveryStatus = "succeeded"
+	WebhookDeliveryStatusFailed       WebhookDeliveryStatus = "failed"
+	WebhookDeliveryStatusDeadLettered WebhookDeliveryStatus = "dead_lettered"
+)
+
+func (e *WebhookDeliveryStatus) Scan(src interface{}) error {
+	switch s := src.(type) {
+	case []byte:
+		*e = WebhookDeliveryStatus(s)
+	case string:
+		*e = WebhookDeliveryStatus(s)
+	default:
+		return fmt.Errorf("unsupported scan type for WebhookDeliveryStatus: %T", src)
+	}
+	return nil
+}
+
+type NullWebhookDeliveryStatus struct {
+	WebhookDeliveryStatus WebhookDeliveryStatus
+	Valid                 bool // Valid is true if WebhookDeliveryStatus is not NULL
+}
+
+// Scan implements the Scanner interface.
+func (ns *NullWebhookDeliveryStatus) Scan(value interface{}) error {
+	if value == nil {
+		ns.WebhookDeliveryStatus, ns.Valid = "", false
+		return nil
+	}
+	ns.Valid = true
+	return ns.WebhookDeliveryStatus.Scan(value)
+}
+
+// Value implements the driver Valuer interface.
+func (ns NullWebhookDeliveryStatus) Value() (driver.Value, error) {
+	if !ns.Valid {
+		return nil, nil
+	}
+	return string(ns.WebhookDeliveryStatus), nil
+}
+
+func (e WebhookDeliveryStatus) Valid() bool {
+	switch e {
+	case WebhookDeliveryStatusSucceeded,
+		WebhookDeliveryStatusFailed,
+		WebhookDeliveryStatusDeadLettered:
+		return true
+	}
+	return false
+}
+
+func AllWebhookDeliveryStatusValues() []WebhookDeliveryStatus {
+	return []WebhookDeliveryStatus{
+		WebhookDeliveryStatusSucceeded,
+		WebhookDeliveryStatusFailed,
+		WebhookDeliveryStatusDeadLettered,
+	}
+}
+
 type WebhookMethod string
 
 const (
@@ -136,6 +197,24 @@ func AllWebhookMethodValues() []WebhookMethod {
 	}
 }
 
+type WebhookDeliveries struct {
+	ID                    string
+	BelongsToWebhook      string
+	BelongsToAccount      string
+	RequestID             string
+	TriggerEvent          string
+	AttemptNumber         int32
+	Status                WebhookDeliveryStatus
+	RequestBody           string
+	ResponseStatusCode    sql.NullInt32
+	ResponseBody          string
+	LatencyInMilliseconds int64
+	ErrorMessage          string
+	NextAttemptAt         sql.NullTime
+	CreatedAt             time.Time
+	EventID               string
+}
+
 type WebhookTriggerEvents struct {
 	ID            string
 	Name          string
@@ -14,14 +14,19 @@ type Querier interface {
 	ArchiveWebhookTriggerEvent(ctx context.Context, db DBTX, id string) (int64, error)
 	CheckWebhookExistence(ctx context.Context, db DBTX, arg *CheckWebhookExistenceParams) (bool, error)
 	CheckWebhookTriggerEventExistence(ctx context.Context, db DBTX, id string) (bool, error)
+	ClaimDueWebhookDeliveries(ctx context.Context, db DBTX, resultLimit int32) ([]*WebhookDeliveries, error)
 	CreateWebhook(ctx context.Context, db DBTX, arg *CreateWebhookParams) error
+	CreateWebhookDelivery(ctx context.Context, db DBTX, arg *CreateWebhookDeliveryParams) error
 	CreateWebhookTriggerConfig(ctx context.Context, db DBTX, arg *CreateWebhookTriggerConfigParams) error
 	CreateWebhookTriggerEvent(ctx context.Context, db DBTX, arg *CreateWebhookTriggerEventParams) error
 	GetWebhook(ctx context.Context, db DBTX, arg *GetWebhookParams) ([]*GetWebhookRow, error)
+	GetWebhookDeliveriesForWebhook(ctx context.Context, db DBTX, arg *GetWebhookDeliveriesForWebhookParams) ([]*GetWebhookDeliveriesForWebhookRow, error)
+	GetWebhookDelivery(ctx context.Context, db DBTX, arg *GetWebhookDeliveryParams) (*WebhookDeliveries, error)
 	GetWebhookTriggerEvent(ctx context.Context, db DBTX, id string) (*WebhookTriggerEvents, error)
 	GetWebhookTriggerEvents(ctx context.Context, db DBTX, arg *GetWebhookTriggerEventsParams) ([]*GetWebhookTriggerEventsRow, error)
 	GetWebhooksForAccount(ctx context.Context, db DBTX, arg *GetWebhooksForAccountParams) ([]*GetWebhooksForAccountRow, error)
 	GetWebhooksForAccountAndEvent(ctx context.Context, db DBTX, arg *GetWebhooksForAccountAndEventParams) ([]*Webhooks, error)
+	SupersedeWebhookDeliveryRetries(ctx context.Context, db DBTX, arg *SupersedeWebhookDeliveryRetriesParams) error
 	UpdateWebhookTriggerEvent(ctx context.Context, db DBTX, arg *UpdateWebhookTriggerEventParams) (int64, error)
 }
 
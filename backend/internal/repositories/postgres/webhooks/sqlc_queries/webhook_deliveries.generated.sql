@@ -0,0 +1,133 @@
+-- name: CreateWebhookDelivery :exec
+INSERT INTO webhook_deliveries (
+	id,
+	belongs_to_webhook,
+	belongs_to_account,
+	request_id,
+	event_id,
+	trigger_event,
+	attempt_number,
+	status,
+	request_body,
+	response_status_code,
+	response_body,
+	latency_in_milliseconds,
+	error_message,
+	next_attempt_at
+) VALUES (
+	sqlc.arg(id),
+	sqlc.arg(belongs_to_webhook),
+	sqlc.arg(belongs_to_account),
+	sqlc.arg(request_id),
+	sqlc.arg(event_id),
+	sqlc.arg(trigger_event),
+	sqlc.arg(attempt_number),
+	sqlc.arg(status),
+	sqlc.arg(request_body),
+	sqlc.narg(response_status_code),
+	sqlc.arg(response_body),
+	sqlc.arg(latency_in_milliseconds),
+	sqlc.arg(error_message),
+	sqlc.narg(next_attempt_at)
+);
+
+-- name: GetWebhookDelivery :one
+SELECT
+	webhook_deliveries.id,
+	webhook_deliveries.belongs_to_webhook,
+	webhook_deliveries.belongs_to_account,
+	webhook_deliveries.request_id,
+	webhook_deliveries.event_id,
+	webhook_deliveries.trigger_event,
+	webhook_deliveries.attempt_number,
+	webhook_deliveries.status,
+	webhook_deliveries.request_body,
+	webhook_deliveries.response_status_code,
+	webhook_deliveries.response_body,
+	webhook_deliveries.latency_in_milliseconds,
+	webhook_deliveries.error_message,
+	webhook_deliveries.next_attempt_at,
+	webhook_deliveries.created_at
+FROM webhook_deliveries
+WHERE webhook_deliveries.id = sqlc.arg(id)
+	AND webhook_deliveries.belongs_to_webhook = sqlc.arg(belongs_to_webhook)
+	AND webhook_deliveries.belongs_to_account = sqlc.arg(belongs_to_account);
+
+-- name: GetWebhookDeliveriesForWebhook :many
+SELECT
+	webhook_deliveries.id,
+	webhook_deliveries.belongs_to_webhook,
+	webhook_deliveries.belongs_to_account,
+	webhook_deliveries.request_id,
+	webhook_deliveries.event_id,
+	webhook_deliveries.trigger_event,
+	webhook_deliveries.attempt_number,
+	webhook_deliveries.status,
+	webhook_deliveries.request_body,
+	webhook_deliveries.response_status_code,
+	webhook_deliveries.response_body,
+	webhook_deliveries.latency_in_milliseconds,
+	webhook_deliveries.error_message,
+	webhook_deliveries.next_attempt_at,
+	webhook_deliveries.created_at,
+	(
+		SELECT COUNT(webhook_deliveries.id)
+		FROM webhook_deliveries
+		WHERE
+			webhook_deliveries.created_at > COALESCE(sqlc.narg(created_after), (SELECT NOW() - '999 years'::INTERVAL))
+			AND webhook_deliveries.created_at < COALESCE(sqlc.narg(created_before), (SELECT NOW() + '999 years'::INTERVAL))
+			AND webhook_deliveries.belongs_to_webhook = sqlc.arg(belongs_to_webhook)
+			AND webhook_deliveries.belongs_to_account = sqlc.arg(belongs_to_account)
+	) AS filtered_count,
+	(
+		SELECT COUNT(webhook_deliveries.id)
+		FROM webhook_deliveries
+		WHERE
+			webhook_deliveries.belongs_to_webhook = sqlc.arg(belongs_to_webhook)
+			AND webhook_deliveries.belongs_to_account = sqlc.arg(belongs_to_account)
+	) AS total_count
+FROM webhook_deliveries
+WHERE webhook_deliveries.created_at > COALESCE(sqlc.narg(created_after), (SELECT NOW() - '999 years'::INTERVAL))
+	AND webhook_deliveries.created_at < COALESCE(sqlc.narg(created_before), (SELECT NOW() + '999 years'::INTERVAL))
+	AND webhook_deliveries.belongs_to_webhook = sqlc.arg(belongs_to_webhook)
+	AND webhook_deliveries.belongs_to_account = sqlc.arg(belongs_to_account)
+	AND webhook_deliveries.id > COALESCE(sqlc.narg(cursor), '')
+ORDER BY webhook_deliveries.id ASC
+LIMIT COALESCE(sqlc.narg(result_limit), 50);
+
+-- name: SupersedeWebhookDeliveryRetries :exec
+UPDATE webhook_deliveries SET
+	next_attempt_at = NULL
+WHERE webhook_deliveries.belongs_to_webhook = sqlc.arg(belongs_to_webhook)
+	AND webhook_deliveries.request_id = sqlc.arg(request_id)
+	AND webhook_deliveries.attempt_number < sqlc.arg(attempt_number)
+	AND webhook_deliveries.next_attempt_at IS NOT NULL;
+
+-- name: ClaimDueWebhookDeliveries :many
+UPDATE webhook_deliveries SET
+	next_attempt_at = NOW() + interval '15 minutes'
+WHERE webhook_deliveries.id IN (
+	SELECT webhook_deliveries.id
+	FROM webhook_deliveries
+	WHERE webhook_deliveries.status = 'failed'
+		AND webhook_deliveries.next_attempt_at <= NOW()
+	ORDER BY webhook_deliveries.next_attempt_at
+	LIMIT sqlc.arg(result_limit)
+	FOR UPDATE SKIP LOCKED
+)
+RETURNING
+	webhook_deliveries.id,
+	webhook_deliveries.belongs_to_webhook,
+	webhook_deliveries.belongs_to_account,
+	webhook_deliveries.request_id,
+	webhook_deliveries.event_id,
+	webhook_deliveries.trigger_event,
+	webhook_deliveries.attempt_number,
+	webhook_deliveries.status,
+	webhook_deliveries.request_body,
+	webhook_deliveries.response_status_code,
+	webhook_deliveries.response_body,
+	webhook_deliveries.latency_in_milliseconds,
+	webhook_deliveries.error_message,
+	webhook_deliveries.next_attempt_at,
+	webhook_deliveries.created_at;
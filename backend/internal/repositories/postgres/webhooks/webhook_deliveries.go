@@ -0,0 +1,254 @@
+package webhooks
+
+import (
+	"context"
+
+	identitykeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity/keys"
+	types "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/webhooks"
+	webhookkeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/webhooks/keys"
+	generated "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/repositories/postgres/webhooks/generated"
+
+	"github.com/primandproper/platform/database"
+	"github.com/primandproper/platform/database/filtering"
+	platformerrors "github.com/primandproper/platform/errors"
+	"github.com/primandproper/platform/observability"
+	"github.com/primandproper/platform/observability/tracing"
+)
+
+var (
+	_ types.WebhookDeliveryDataManager = (*repository)(nil)
+)
+
+// CreateWebhookDelivery records a webhook delivery attempt in the database, and clears any retry still
+// pending on the attempts before it. Delivery records are themselves a log, so no audit log entry is written for them.
+func (r *repository) CreateWebhookDelivery(ctx context.Context, input *types.WebhookDeliveryDatabaseCreationInput) (*types.WebhookDelivery, error) {
+	ctx, span := r.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if input == nil {
+		return nil, platformerrors.ErrNilInputProvided
+	}
+
+	logger := r.logger.WithValue(webhookkeys.WebhookIDKey, input.BelongsToWebhook).WithValue(webhookkeys.WebhookDeliveryIDKey, input.ID)
+	tracing.AttachToSpan(span, webhookkeys.WebhookIDKey, input.BelongsToWebhook)
+	tracing.AttachToSpan(span, webhookkeys.WebhookDeliveryIDKey, input.ID)
+
+	tx, err := r.writeDB.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "beginning transaction")
+	}
+
+	if err = r.generatedQuerier.CreateWebhookDelivery(ctx, tx, &generated.CreateWebhookDeliveryParams{
+		ID:                    input.ID,
+		BelongsToWebhook:      input.BelongsToWebhook,
+		BelongsToAccount:      input.BelongsToAccount,
+		RequestID:             input.RequestID,
+		EventID:               input.EventID,
+		TriggerEvent:          input.TriggerEvent,
+		AttemptNumber:         int32(input.AttemptNumber),
+		Status:                generated.WebhookDeliveryStatus(input.Status),
+		RequestBody:           input.RequestBody,
+		ResponseStatusCode:    database.NullInt32FromUint16Pointer(input.ResponseStatusCode),
+		ResponseBody:          input.ResponseBody,
+		LatencyInMilliseconds: int64(input.LatencyInMilliseconds),
+		ErrorMessage:          input.ErrorMessage,
+		NextAttemptAt:         database.NullTimeFromTimePointer(input.NextAttemptAt),
+	}); err != nil {
+		r.RollbackTransaction(ctx, tx)
+		return nil, observability.PrepareAndLogError(err, logger, span, "performing webhook delivery creation query")
+	}
+
+	if err = r.generatedQuerier.SupersedeWebhookDeliveryRetries(ctx, tx, &generated.SupersedeWebhookDeliveryRetriesParams{
+		BelongsToWebhook: input.BelongsToWebhook,
+		RequestID:        input.RequestID,
+		AttemptNumber:    int32(input.AttemptNumber),
+	}); err != nil {
+		r.RollbackTransaction(ctx, tx)
+		return nil, observability.PrepareAndLogError(err, logger, span, "superseding earlier webhook delivery retries")
+	}
+
+	if err = tx.Commit(); err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "committing database transaction")
+	}
+
+	return &types.WebhookDelivery{
+		ID:                    input.ID,
+		BelongsToWebhook:      input.BelongsToWebhook,
+		BelongsToAccount:      input.BelongsToAccount,
+		RequestID:             input.RequestID,
+		EventID:               input.EventID,
+		TriggerEvent:          input.TriggerEvent,
+		AttemptNumber:         input.AttemptNumber,
+		Status:                input.Status,
+		RequestBody:           input.RequestBody,
+		ResponseStatusCode:    input.ResponseStatusCode,
+		ResponseBody:          input.ResponseBody,
+		LatencyInMilliseconds: input.LatencyInMilliseconds,
+		ErrorMessage:          input.ErrorMessage,
+		NextAttemptAt:         input.NextAttemptAt,
+		CreatedAt:             r.CurrentTime(),
+	}, nil
+}
+
+// GetWebhookDelivery fetches a webhook delivery attempt from the database.
+func (r *repository) GetWebhookDelivery(ctx context.Context, webhookID, deliveryID, accountID string) (*types.WebhookDelivery, error) {
+	ctx, span := r.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := r.logger.Clone()
+
+	if webhookID == "" {
+		return nil, platformerrors.ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(webhookkeys.WebhookIDKey, webhookID)
+	tracing.AttachToSpan(span, webhookkeys.WebhookIDKey, webhookID)
+
+	if deliveryID == "" {
+		return nil, platformerrors.ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(webhookkeys.WebhookDeliveryIDKey, deliveryID)
+	tracing.AttachToSpan(span, webhookkeys.WebhookDeliveryIDKey, deliveryID)
+
+	if accountID == "" {
+		return nil, platformerrors.ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(identitykeys.AccountIDKey, accountID)
+	tracing.AttachToSpan(span, identitykeys.AccountIDKey, accountID)
+
+	result, err := r.generatedQuerier.GetWebhookDelivery(ctx, r.readDB, &generated.GetWebhookDeliveryParams{
+		ID:               deliveryID,
+		BelongsToWebhook: webhookID,
+		BelongsToAccount: accountID,
+	})
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching webhook delivery")
+	}
+
+	return &types.WebhookDelivery{
+		CreatedAt:             result.CreatedAt,
+		NextAttemptAt:         database.TimePointerFromNullTime(result.NextAttemptAt),
+		ResponseStatusCode:    database.Uint16PointerFromNullInt32(result.ResponseStatusCode),
+		ID:                    result.ID,
+		BelongsToWebhook:      result.BelongsToWebhook,
+		BelongsToAccount:      result.BelongsToAccount,
+		RequestID:             result.RequestID,
+		EventID:               result.EventID,
+		TriggerEvent:          result.TriggerEvent,
+		Status:                string(result.Status),
+		RequestBody:           result.RequestBody,
+		ResponseBody:          result.ResponseBody,
+		ErrorMessage:          result.ErrorMessage,
+		LatencyInMilliseconds: uint64(result.LatencyInMilliseconds),
+		AttemptNumber:         uint16(result.AttemptNumber),
+	}, nil
+}
+
+// GetWebhookDeliveries fetches a list of delivery attempts for a webhook from the database.
+func (r *repository) GetWebhookDeliveries(ctx context.Context, webhookID, accountID string, filter *filtering.QueryFilter) (*filtering.QueryFilteredResult[types.WebhookDelivery], error) {
+	ctx, span := r.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := r.logger.Clone()
+
+	if webhookID == "" {
+		return nil, platformerrors.ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(webhookkeys.WebhookIDKey, webhookID)
+	tracing.AttachToSpan(span, webhookkeys.WebhookIDKey, webhookID)
+
+	if accountID == "" {
+		return nil, platformerrors.ErrInvalidIDProvided
+	}
+	logger = logger.WithValue(identitykeys.AccountIDKey, accountID)
+	tracing.AttachToSpan(span, identitykeys.AccountIDKey, accountID)
+
+	if filter == nil {
+		filter = filtering.DefaultQueryFilter()
+	}
+	logger = filter.AttachToLogger(logger)
+	tracing.AttachQueryFilterToSpan(span, filter)
+
+	results, err := r.generatedQuerier.GetWebhookDeliveriesForWebhook(ctx, r.readDB, &generated.GetWebhookDeliveriesForWebhookParams{
+		CreatedAfter:     database.NullTimeFromTimePointer(filter.CreatedAfter),
+		CreatedBefore:    database.NullTimeFromTimePointer(filter.CreatedBefore),
+		BelongsToWebhook: webhookID,
+		BelongsToAccount: accountID,
+		Cursor:           database.NullStringFromStringPointer(filter.Cursor),
+		ResultLimit:      database.NullInt32FromUint8Pointer(filter.MaxResponseSize),
+	})
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "fetching webhook deliveries from database")
+	}
+
+	var (
+		data                      = make([]*types.WebhookDelivery, 0, len(results))
+		filteredCount, totalCount uint64
+	)
+	for _, result := range results {
+		data = append(data, &types.WebhookDelivery{
+			CreatedAt:             result.CreatedAt,
+			NextAttemptAt:         database.TimePointerFromNullTime(result.NextAttemptAt),
+			ResponseStatusCode:    database.Uint16PointerFromNullInt32(result.ResponseStatusCode),
+			ID:                    result.ID,
+			BelongsToWebhook:      result.BelongsToWebhook,
+			BelongsToAccount:      result.BelongsToAccount,
+			RequestID:             result.RequestID,
+			EventID:               result.EventID,
+			TriggerEvent:          result.TriggerEvent,
+			Status:                string(result.Status),
+			RequestBody:           result.RequestBody,
+			ResponseBody:          result.ResponseBody,
+			ErrorMessage:          result.ErrorMessage,
+			LatencyInMilliseconds: uint64(result.LatencyInMilliseconds),
+			AttemptNumber:         uint16(result.AttemptNumber),
+		})
+		filteredCount = uint64(result.FilteredCount)
+		totalCount = uint64(result.TotalCount)
+	}
+
+	return filtering.NewQueryFilteredResult(
+		data,
+		filteredCount,
+		totalCount,
+		func(t *types.WebhookDelivery) string {
+			return t.ID
+		},
+		filter,
+	), nil
+}
+
+// ClaimDueWebhookDeliveries leases failed webhook deliveries whose retry is due.
+func (r *repository) ClaimDueWebhookDeliveries(ctx context.Context, limit uint8) ([]*types.WebhookDelivery, error) {
+	ctx, span := r.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := r.logger.WithValue("limit", limit)
+
+	results, err := r.generatedQuerier.ClaimDueWebhookDeliveries(ctx, r.writeDB, int32(limit))
+	if err != nil {
+		return nil, observability.PrepareAndLogError(err, logger, span, "claiming due webhook deliveries")
+	}
+
+	deliveries := make([]*types.WebhookDelivery, 0, len(results))
+	for _, result := range results {
+		deliveries = append(deliveries, &types.WebhookDelivery{
+			CreatedAt:             result.CreatedAt,
+			NextAttemptAt:         database.TimePointerFromNullTime(result.NextAttemptAt),
+			ResponseStatusCode:    database.Uint16PointerFromNullInt32(result.ResponseStatusCode),
+			ID:                    result.ID,
+			BelongsToWebhook:      result.BelongsToWebhook,
+			BelongsToAccount:      result.BelongsToAccount,
+			RequestID:             result.RequestID,
+			EventID:               result.EventID,
+			TriggerEvent:          result.TriggerEvent,
+			Status:                string(result.Status),
+			RequestBody:           result.RequestBody,
+			ResponseBody:          result.ResponseBody,
+			ErrorMessage:          result.ErrorMessage,
+			LatencyInMilliseconds: uint64(result.LatencyInMilliseconds),
+			AttemptNumber:         uint16(result.AttemptNumber),
+		})
+	}
+
+	return deliveries, nil
+}
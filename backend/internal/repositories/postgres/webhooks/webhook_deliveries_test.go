@@ -0,0 +1,199 @@
+package webhooks
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	types "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/webhooks"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/webhooks/fakes"
+	pgtesting "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/repositories/postgres/testing"
+
+	"github.com/primandproper/platform/database/filtering"
+	"github.com/primandproper/platform/identifiers"
+	"github.com/primandproper/platform/pointer"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestQuerier_Integration_WebhookDeliveries(t *testing.T) {
+	if !pgtesting.RunContainerTests {
+		t.SkipNow()
+	}
+
+	ctx := t.Context()
+	dbc, _, container := buildDatabaseClientForTest(t)
+
+	defer func(t *testing.T) {
+		t.Helper()
+		assert.NoError(t, container.Terminate(ctx))
+	}(t)
+
+	user := pgtesting.CreateUserForTest(t, nil, dbc.writeDB)
+	account := pgtesting.CreateAccountForTest(t, nil, user.ID, dbc.writeDB)
+
+	catalogEvent, err := dbc.CreateWebhookTriggerEvent(ctx, &types.WebhookTriggerEventDatabaseCreationInput{
+		ID:   identifiers.New(),
+		Name: "webhook_created",
+	})
+	require.NoError(t, err)
+
+	exampleWebhook := fakes.BuildFakeWebhook()
+	exampleWebhook.BelongsToAccount = account.ID
+	exampleWebhook.CreatedByUser = user.ID
+	exampleWebhook.TriggerConfigs[0].TriggerEventID = catalogEvent.ID
+	webhook := createWebhookForTest(t, ctx, exampleWebhook, dbc)
+
+	requestID := identifiers.New()
+	eventID := identifiers.New()
+	created := []*types.WebhookDelivery{}
+	for i := range exampleQuantity {
+		input := &types.WebhookDeliveryDatabaseCreationInput{
+			ID:                    identifiers.New(),
+			BelongsToWebhook:      webhook.ID,
+			BelongsToAccount:      account.ID,
+			RequestID:             requestID,
+			EventID:               eventID,
+			TriggerEvent:          catalogEvent.ID,
+			AttemptNumber:         uint16(i + 1),
+			Status:                types.WebhookDeliveryStatusFailed,
+			RequestBody:           `{"things":"stuff"}`,
+			ResponseStatusCode:    pointer.To(uint16(http.StatusBadGateway)),
+			ResponseBody:          "bad gateway",
+			LatencyInMilliseconds: 123,
+			NextAttemptAt:         pointer.To(time.Now().Add(time.Minute).Truncate(time.Second).UTC()),
+		}
+		if i == exampleQuantity-1 {
+			input.Status = types.WebhookDeliveryStatusSucceeded
+			input.ResponseStatusCode = pointer.To(uint16(http.StatusOK))
+			input.NextAttemptAt = nil
+		}
+
+		delivery, createErr := dbc.CreateWebhookDelivery(ctx, input)
+		require.NoError(t, createErr)
+		require.NotNil(t, delivery)
+		created = append(created, delivery)
+	}
+
+	fetched, err := dbc.GetWebhookDelivery(ctx, webhook.ID, created[0].ID, account.ID)
+	require.NoError(t, err)
+	assert.Equal(t, created[0].RequestBody, fetched.RequestBody)
+	assert.Equal(t, created[0].ResponseStatusCode, fetched.ResponseStatusCode)
+	assert.Equal(t, created[0].Status, fetched.Status)
+	assert.Equal(t, created[0].AttemptNumber, fetched.AttemptNumber)
+	assert.Equal(t, eventID, fetched.EventID)
+	// the retry scheduled by the first attempt was made by the second, so it's no longer pending.
+	assert.Nil(t, fetched.NextAttemptAt)
+
+	_, err = dbc.GetWebhookDelivery(ctx, webhook.ID, created[0].ID, identifiers.New())
+	assert.Error(t, err)
+
+	deliveries, err := dbc.GetWebhookDeliveries(ctx, webhook.ID, account.ID, filtering.DefaultQueryFilter())
+	require.NoError(t, err)
+	assert.Len(t, deliveries.Data, len(created))
+
+	due, err := dbc.CreateWebhookDelivery(ctx, &types.WebhookDeliveryDatabaseCreationInput{
+		ID:               identifiers.New(),
+		BelongsToWebhook: webhook.ID,
+		BelongsToAccount: account.ID,
+		RequestID:        identifiers.New(),
+		EventID:          identifiers.New(),
+		TriggerEvent:     catalogEvent.ID,
+		AttemptNumber:    1,
+		Status:           types.WebhookDeliveryStatusFailed,
+		RequestBody:      `{"things":"stuff"}`,
+		NextAttemptAt:    pointer.To(time.Now().Add(-time.Minute)),
+	})
+	require.NoError(t, err)
+
+	claimed, err := dbc.ClaimDueWebhookDeliveries(ctx, 10)
+	require.NoError(t, err)
+	require.Len(t, claimed, 1)
+	assert.Equal(t, due.ID, claimed[0].ID)
+	require.NotNil(t, claimed[0].NextAttemptAt)
+	assert.True(t, claimed[0].NextAttemptAt.After(time.Now()))
+
+	// a claimed retry is leased, so it isn't handed out again.
+	claimed, err = dbc.ClaimDueWebhookDeliveries(ctx, 10)
+	require.NoError(t, err)
+	assert.Empty(t, claimed)
+}
+
+func TestQuerier_CreateWebhookDelivery(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with nil input", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		actual, err := c.CreateWebhookDelivery(ctx, nil)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
+
+func TestQuerier_GetWebhookDelivery(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid webhook ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		actual, err := c.GetWebhookDelivery(ctx, "", fakes.BuildFakeID(), fakes.BuildFakeID())
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+
+	T.Run("with invalid delivery ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		actual, err := c.GetWebhookDelivery(ctx, fakes.BuildFakeID(), "", fakes.BuildFakeID())
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+
+	T.Run("with invalid account ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		actual, err := c.GetWebhookDelivery(ctx, fakes.BuildFakeID(), fakes.BuildFakeID(), "")
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
+
+func TestQuerier_GetWebhookDeliveries(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with invalid webhook ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		actual, err := c.GetWebhookDeliveries(ctx, "", fakes.BuildFakeID(), filtering.DefaultQueryFilter())
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+
+	T.Run("with invalid account ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		c := buildInertClientForTest(t)
+
+		actual, err := c.GetWebhookDeliveries(ctx, fakes.BuildFakeID(), "", filtering.DefaultQueryFilter())
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+	})
+}
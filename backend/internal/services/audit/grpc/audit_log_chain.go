@@ -0,0 +1,51 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/audit"
+	identitykeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity/keys"
+	auditsvc "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/grpc/generated/services/audit"
+	grpctypes "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/grpc/generated/types"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/services/audit/grpc/converters"
+
+	platformerrors "github.com/primandproper/platform/errors"
+	errorsgrpc "github.com/primandproper/platform/errors/grpc"
+	"github.com/primandproper/platform/observability/tracing"
+
+	"google.golang.org/grpc/codes"
+)
+
+// AdminVerifyAuditLogChain walks an account's audit log chain and reports the first broken link, if any.
+func (s *serviceImpl) AdminVerifyAuditLogChain(ctx context.Context, request *auditsvc.AdminVerifyAuditLogChainRequest) (*auditsvc.AdminVerifyAuditLogChainResponse, error) {
+	ctx, span := s.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := s.logger.WithSpan(span).WithValue(identitykeys.AccountIDKey, request.AccountId)
+	tracing.AttachToSpan(span, identitykeys.AccountIDKey, request.AccountId)
+
+	if request.AccountId == "" {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(platformerrors.ErrInvalidIDProvided, logger, span, codes.InvalidArgument, "account ID is required")
+	}
+
+	verificationKey, err := s.chainConfig.VerificationKey()
+	if err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.Internal, "loading checkpoint verification key")
+	}
+
+	result, err := audit.VerifyChain(ctx, s.auditManager, request.AccountId, verificationKey)
+	if err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.Internal, "verifying audit log chain")
+	}
+
+	if !result.Intact() {
+		logger.WithValue("reason", result.FirstBrokenLink.Reason).Info("audit log chain is broken")
+	}
+
+	x := converters.ConvertChainVerificationResultToGRPCAdminVerifyAuditLogChainResponse(result)
+	x.ResponseDetails = &grpctypes.ResponseDetails{
+		TraceId: span.SpanContext().TraceID().String(),
+	}
+
+	return x, nil
+}
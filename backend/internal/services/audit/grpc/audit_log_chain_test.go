@@ -0,0 +1,129 @@
+package grpc
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"testing"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/audit"
+	auditfakes "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/audit/fakes"
+	auditsvc "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/grpc/generated/services/audit"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/testutils"
+
+	"github.com/primandproper/platform/identifiers"
+	"github.com/primandproper/platform/reflection"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestServiceImpl_AdminVerifyAuditLogChain(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		service, mockRepo := buildTestService(t)
+
+		_, privateKey, err := ed25519.GenerateKey(rand.Reader)
+		require.NoError(t, err)
+		service.chainConfig = &audit.ChainConfig{CheckpointSigningKey: base64.StdEncoding.EncodeToString(privateKey.Seed())}
+
+		accountID := identifiers.New()
+		chain := auditfakes.BuildFakeAuditLogChain(accountID, 3)
+		head := chain[len(chain)-1]
+		input := audit.NewCheckpointSigner(privateKey).Sign(&audit.AuditLogChainHead{
+			BelongsToAccount: accountID,
+			Hash:             head.Hash,
+			Sequence:         head.Sequence,
+		})
+		checkpoint := &audit.AuditLogCheckpoint{
+			ID:               input.ID,
+			BelongsToAccount: input.BelongsToAccount,
+			EntryHash:        input.EntryHash,
+			Signature:        input.Signature,
+			KeyID:            input.KeyID,
+			Sequence:         input.Sequence,
+		}
+
+		mockRepo.On(reflection.GetMethodName(mockRepo.GetAuditLogCheckpointsForAccount), testutils.ContextMatcher, accountID).Return([]*audit.AuditLogCheckpoint{checkpoint}, nil)
+		mockRepo.On(reflection.GetMethodName(mockRepo.GetAuditLogChainEntries), testutils.ContextMatcher, accountID, uint64(0), mock.Anything).Return(chain, nil)
+
+		response, err := service.AdminVerifyAuditLogChain(ctx, &auditsvc.AdminVerifyAuditLogChainRequest{AccountId: accountID})
+
+		assert.NoError(t, err)
+		require.NotNil(t, response)
+		assert.NotNil(t, response.ResponseDetails)
+		assert.True(t, response.Intact)
+		assert.True(t, response.SignaturesVerified)
+		assert.Nil(t, response.FirstBrokenLink)
+		assert.Equal(t, uint64(len(chain)), response.EntriesChecked)
+		assert.Equal(t, uint64(1), response.CheckpointsChecked)
+
+		mock.AssertExpectationsForObjects(t, mockRepo)
+	})
+
+	t.Run("with broken chain", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		service, mockRepo := buildTestService(t)
+
+		accountID := identifiers.New()
+		chain := auditfakes.BuildFakeAuditLogChain(accountID, 3)
+		chain[1].RelevantID = identifiers.New()
+
+		mockRepo.On(reflection.GetMethodName(mockRepo.GetAuditLogCheckpointsForAccount), testutils.ContextMatcher, accountID).Return([]*audit.AuditLogCheckpoint{}, nil)
+		mockRepo.On(reflection.GetMethodName(mockRepo.GetAuditLogChainEntries), testutils.ContextMatcher, accountID, uint64(0), mock.Anything).Return(chain, nil)
+
+		response, err := service.AdminVerifyAuditLogChain(ctx, &auditsvc.AdminVerifyAuditLogChainRequest{AccountId: accountID})
+
+		assert.NoError(t, err)
+		require.NotNil(t, response)
+		assert.False(t, response.Intact)
+		assert.False(t, response.SignaturesVerified)
+		require.NotNil(t, response.FirstBrokenLink)
+		assert.Equal(t, chain[1].ID, response.FirstBrokenLink.EntryId)
+		assert.Equal(t, audit.ChainBreakReasonHashMismatch, response.FirstBrokenLink.Reason)
+		assert.Equal(t, uint64(1), response.LastVerifiedSequence)
+
+		mock.AssertExpectationsForObjects(t, mockRepo)
+	})
+
+	t.Run("without account ID", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		service, mockRepo := buildTestService(t)
+
+		response, err := service.AdminVerifyAuditLogChain(ctx, &auditsvc.AdminVerifyAuditLogChainRequest{})
+
+		assert.Nil(t, response)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		mock.AssertExpectationsForObjects(t, mockRepo)
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		service, mockRepo := buildTestService(t)
+
+		accountID := identifiers.New()
+		mockRepo.On(reflection.GetMethodName(mockRepo.GetAuditLogCheckpointsForAccount), testutils.ContextMatcher, accountID).Return([]*audit.AuditLogCheckpoint(nil), errors.New("repository error"))
+
+		response, err := service.AdminVerifyAuditLogChain(ctx, &auditsvc.AdminVerifyAuditLogChainRequest{AccountId: accountID})
+
+		assert.Nil(t, response)
+		assert.Equal(t, codes.Internal, status.Code(err))
+
+		mock.AssertExpectationsForObjects(t, mockRepo)
+	})
+}
@@ -0,0 +1,156 @@
+package grpc
+
+import (
+	"bytes"
+	"context"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/audit"
+	identitykeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity/keys"
+	grpcconverters "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/grpc/converters"
+	auditsvc "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/grpc/generated/services/audit"
+	grpctypes "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/grpc/generated/types"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/services/audit/grpc/converters"
+
+	"github.com/primandproper/platform/database/filtering"
+	errorsgrpc "github.com/primandproper/platform/errors/grpc"
+	"github.com/primandproper/platform/observability/tracing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	// auditLogExportPageSize is how many entries an export reads from the database at once.
+	auditLogExportPageSize = uint8(filtering.MaxQueryFilterLimit)
+	// auditLogExportChunkSize is roughly how many encoded bytes an export buffers before sending them.
+	auditLogExportChunkSize = 64 * 1024
+)
+
+// SearchAuditLogEntries searches the active account's audit log.
+func (s *serviceImpl) SearchAuditLogEntries(ctx context.Context, request *auditsvc.SearchAuditLogEntriesRequest) (*auditsvc.SearchAuditLogEntriesResponse, error) {
+	ctx, span := s.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := s.logger.WithSpan(span)
+
+	sessionContextData, err := s.sessionContextDataFetcher(ctx)
+	if err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.Unauthenticated, "unable to determine authentication")
+	}
+
+	accountID := sessionContextData.GetActiveAccountID()
+	logger = logger.WithValue(identitykeys.AccountIDKey, accountID)
+	tracing.AttachToSpan(span, identitykeys.AccountIDKey, accountID)
+
+	input := converters.ConvertGRPCAuditLogEntrySearchInputToAuditLogEntrySearchInput(request.Input)
+	if err = input.ValidateWithContext(ctx); err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.InvalidArgument, "invalid search input")
+	}
+
+	filter := grpcconverters.ConvertGRPCQueryFilterToQueryFilter(request.Filter)
+
+	auditLogEntries, err := s.auditManager.SearchAuditLogEntriesForAccount(ctx, accountID, input, filter)
+	if err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.Internal, "searching audit log entries")
+	}
+
+	x := &auditsvc.SearchAuditLogEntriesResponse{
+		ResponseDetails: &grpctypes.ResponseDetails{
+			TraceId: span.SpanContext().TraceID().String(),
+		},
+		Pagination: grpcconverters.ConvertPaginationToGRPCPagination(auditLogEntries.Pagination, filter),
+	}
+
+	for _, y := range auditLogEntries.Data {
+		x.Results = append(x.Results, converters.ConvertAuditLogEntryToGRPCAuditLogEntry(y))
+	}
+
+	return x, nil
+}
+
+// ExportAuditLogEntries streams every audit log entry in the active account matching the search input,
+// encoded as NDJSON (the default) or CSV. The encoded export is split across as many messages as needed.
+func (s *serviceImpl) ExportAuditLogEntries(request *auditsvc.ExportAuditLogEntriesRequest, stream grpc.ServerStreamingServer[auditsvc.ExportAuditLogEntriesResponse]) error {
+	ctx, span := s.tracer.StartSpan(stream.Context())
+	defer span.End()
+
+	logger := s.logger.WithSpan(span)
+
+	sessionContextData, err := s.sessionContextDataFetcher(ctx)
+	if err != nil {
+		return errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.Unauthenticated, "unable to determine authentication")
+	}
+
+	accountID := sessionContextData.GetActiveAccountID()
+	logger = logger.WithValue(identitykeys.AccountIDKey, accountID)
+	tracing.AttachToSpan(span, identitykeys.AccountIDKey, accountID)
+
+	input := converters.ConvertGRPCAuditLogEntrySearchInputToAuditLogEntrySearchInput(request.Input)
+	if err = input.ValidateWithContext(ctx); err != nil {
+		return errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.InvalidArgument, "invalid search input")
+	}
+
+	format := audit.ExportFormat(request.Format)
+	if format == "" {
+		format = audit.ExportFormatNDJSON
+	}
+
+	var buf bytes.Buffer
+	writer, err := audit.NewAuditLogEntryExportWriter(format, &buf)
+	if err != nil {
+		return errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.InvalidArgument, "invalid export format")
+	}
+
+	send := func() error {
+		if buf.Len() == 0 {
+			return nil
+		}
+
+		if sendErr := stream.Send(&auditsvc.ExportAuditLogEntriesResponse{
+			ContentType: format.ContentType(),
+			Chunk:       bytes.Clone(buf.Bytes()),
+		}); sendErr != nil {
+			return sendErr
+		}
+		buf.Reset()
+
+		return nil
+	}
+
+	filter := grpcconverters.ConvertGRPCQueryFilterToQueryFilter(request.Filter)
+
+	for afterID := ""; ; {
+		page, exportErr := s.auditManager.ExportAuditLogEntriesForAccount(ctx, accountID, input, filter, afterID, auditLogExportPageSize)
+		if exportErr != nil {
+			return errorsgrpc.PrepareAndLogGRPCStatus(exportErr, logger, span, codes.Internal, "exporting audit log entries")
+		}
+
+		for _, entry := range page {
+			if err = writer.Write(entry); err != nil {
+				return errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.Internal, "encoding audit log entry")
+			}
+
+			if buf.Len() >= auditLogExportChunkSize {
+				if err = send(); err != nil {
+					return errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.Unavailable, "sending audit log export")
+				}
+			}
+		}
+
+		if len(page) < int(auditLogExportPageSize) {
+			break
+		}
+
+		afterID = page[len(page)-1].ID
+	}
+
+	if err = writer.Flush(); err != nil {
+		return errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.Internal, "flushing audit log export")
+	}
+
+	if err = send(); err != nil {
+		return errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.Unavailable, "sending audit log export")
+	}
+
+	return nil
+}
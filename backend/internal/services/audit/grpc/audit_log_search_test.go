@@ -0,0 +1,258 @@
+package grpc
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/authentication/sessions"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/audit"
+	auditfakes "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/audit/fakes"
+	auditmock "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/audit/mock"
+	auditsvc "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/grpc/generated/services/audit"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/testutils"
+
+	"github.com/primandproper/platform/database/filtering"
+	"github.com/primandproper/platform/identifiers"
+	"github.com/primandproper/platform/reflection"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeServerStream collects sent messages.
+type fakeServerStream[T any] struct {
+	grpc.ServerStream
+	ctx     context.Context
+	sendErr error
+	sent    []*T
+}
+
+func newFakeServerStream[T any](t *testing.T) *fakeServerStream[T] {
+	t.Helper()
+
+	return &fakeServerStream[T]{ctx: t.Context()}
+}
+
+func (f *fakeServerStream[T]) Context() context.Context {
+	return f.ctx
+}
+
+func (f *fakeServerStream[T]) Send(x *T) error {
+	if f.sendErr != nil {
+		return f.sendErr
+	}
+
+	f.sent = append(f.sent, x)
+	return nil
+}
+
+func buildAuditSearchTestService(t *testing.T, accountID string) (*serviceImpl, *auditmock.Repository) {
+	t.Helper()
+
+	service, mockRepo := buildTestService(t)
+	service.sessionContextDataFetcher = func(context.Context) (*sessions.ContextData, error) {
+		return &sessions.ContextData{ActiveAccountID: accountID}, nil
+	}
+
+	return service, mockRepo
+}
+
+func exportedChunks(sent []*auditsvc.ExportAuditLogEntriesResponse) string {
+	var sb strings.Builder
+	for _, x := range sent {
+		sb.Write(x.Chunk)
+	}
+
+	return sb.String()
+}
+
+func TestServiceImpl_SearchAuditLogEntries(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		accountID := identifiers.New()
+		service, mockRepo := buildAuditSearchTestService(t, accountID)
+
+		fakeAuditLogEntries := auditfakes.BuildFakeAuditLogEntriesList()
+		expectedInput := &audit.AuditLogEntrySearchInput{
+			ResourceTypes: []string{"recipes"},
+			EventTypes:    []string{audit.AuditLogEventTypeUpdated},
+			ChangedField:  "name",
+		}
+
+		mockRepo.On(reflection.GetMethodName(mockRepo.SearchAuditLogEntriesForAccount), testutils.ContextMatcher, accountID, expectedInput, testutils.QueryFilterMatcher).Return(fakeAuditLogEntries, nil)
+
+		response, err := service.SearchAuditLogEntries(ctx, &auditsvc.SearchAuditLogEntriesRequest{
+			Input: &auditsvc.AuditLogEntrySearchInput{
+				ResourceTypes: expectedInput.ResourceTypes,
+				EventTypes:    expectedInput.EventTypes,
+				ChangedField:  expectedInput.ChangedField,
+			},
+		})
+
+		assert.NoError(t, err)
+		require.NotNil(t, response)
+		assert.NotNil(t, response.ResponseDetails)
+		assert.Len(t, response.Results, len(fakeAuditLogEntries.Data))
+
+		mock.AssertExpectationsForObjects(t, mockRepo)
+	})
+
+	t.Run("with invalid event type", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		service, mockRepo := buildAuditSearchTestService(t, identifiers.New())
+
+		response, err := service.SearchAuditLogEntries(ctx, &auditsvc.SearchAuditLogEntriesRequest{
+			Input: &auditsvc.AuditLogEntrySearchInput{
+				EventTypes: []string{"teleported"},
+			},
+		})
+
+		assert.Nil(t, response)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		mock.AssertExpectationsForObjects(t, mockRepo)
+	})
+
+	t.Run("with session error", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		service, _ := buildTestService(t)
+		service.sessionContextDataFetcher = func(context.Context) (*sessions.ContextData, error) {
+			return nil, errors.New("blah")
+		}
+
+		response, err := service.SearchAuditLogEntries(ctx, &auditsvc.SearchAuditLogEntriesRequest{})
+
+		assert.Nil(t, response)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		accountID := identifiers.New()
+		service, mockRepo := buildAuditSearchTestService(t, accountID)
+
+		mockRepo.On(reflection.GetMethodName(mockRepo.SearchAuditLogEntriesForAccount), testutils.ContextMatcher, accountID, &audit.AuditLogEntrySearchInput{}, testutils.QueryFilterMatcher).Return((*filtering.QueryFilteredResult[audit.AuditLogEntry])(nil), errors.New("repository error"))
+
+		response, err := service.SearchAuditLogEntries(ctx, &auditsvc.SearchAuditLogEntriesRequest{})
+
+		assert.Nil(t, response)
+		assert.Equal(t, codes.Internal, status.Code(err))
+
+		mock.AssertExpectationsForObjects(t, mockRepo)
+	})
+}
+
+func TestServiceImpl_ExportAuditLogEntries(t *testing.T) {
+	t.Parallel()
+
+	t.Run("ndjson", func(t *testing.T) {
+		t.Parallel()
+
+		accountID := identifiers.New()
+		service, mockRepo := buildAuditSearchTestService(t, accountID)
+
+		fakeAuditLogEntries := auditfakes.BuildFakeAuditLogEntriesList()
+		mockRepo.On(reflection.GetMethodName(mockRepo.ExportAuditLogEntriesForAccount), testutils.ContextMatcher, accountID, &audit.AuditLogEntrySearchInput{}, testutils.QueryFilterMatcher, "", auditLogExportPageSize).Return(fakeAuditLogEntries.Data, nil)
+
+		stream := newFakeServerStream[auditsvc.ExportAuditLogEntriesResponse](t)
+		require.NoError(t, service.ExportAuditLogEntries(&auditsvc.ExportAuditLogEntriesRequest{}, stream))
+
+		require.NotEmpty(t, stream.sent)
+		assert.Equal(t, audit.ExportFormatNDJSON.ContentType(), stream.sent[0].ContentType)
+		assert.Len(t, strings.Split(strings.TrimSpace(exportedChunks(stream.sent)), "\n"), len(fakeAuditLogEntries.Data))
+
+		mock.AssertExpectationsForObjects(t, mockRepo)
+	})
+
+	t.Run("csv across pages", func(t *testing.T) {
+		t.Parallel()
+
+		accountID := identifiers.New()
+		service, mockRepo := buildAuditSearchTestService(t, accountID)
+
+		firstPage := []*audit.AuditLogEntry{}
+		for range auditLogExportPageSize {
+			firstPage = append(firstPage, auditfakes.BuildFakeAuditLogEntry())
+		}
+		lastPage := auditfakes.BuildFakeAuditLogEntriesList().Data
+
+		mockRepo.On(reflection.GetMethodName(mockRepo.ExportAuditLogEntriesForAccount), testutils.ContextMatcher, accountID, &audit.AuditLogEntrySearchInput{}, testutils.QueryFilterMatcher, "", auditLogExportPageSize).Return(firstPage, nil)
+		mockRepo.On(reflection.GetMethodName(mockRepo.ExportAuditLogEntriesForAccount), testutils.ContextMatcher, accountID, &audit.AuditLogEntrySearchInput{}, testutils.QueryFilterMatcher, firstPage[len(firstPage)-1].ID, auditLogExportPageSize).Return(lastPage, nil)
+
+		stream := newFakeServerStream[auditsvc.ExportAuditLogEntriesResponse](t)
+		require.NoError(t, service.ExportAuditLogEntries(&auditsvc.ExportAuditLogEntriesRequest{Format: string(audit.ExportFormatCSV)}, stream))
+
+		records, err := csv.NewReader(strings.NewReader(exportedChunks(stream.sent))).ReadAll()
+		require.NoError(t, err)
+		assert.Len(t, records, 1+len(firstPage)+len(lastPage))
+		assert.Equal(t, "text/csv", stream.sent[0].ContentType)
+
+		mock.AssertExpectationsForObjects(t, mockRepo)
+	})
+
+	t.Run("with unsupported format", func(t *testing.T) {
+		t.Parallel()
+
+		service, mockRepo := buildAuditSearchTestService(t, identifiers.New())
+
+		stream := newFakeServerStream[auditsvc.ExportAuditLogEntriesResponse](t)
+		err := service.ExportAuditLogEntries(&auditsvc.ExportAuditLogEntriesRequest{Format: "xml"}, stream)
+
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Empty(t, stream.sent)
+
+		mock.AssertExpectationsForObjects(t, mockRepo)
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		t.Parallel()
+
+		accountID := identifiers.New()
+		service, mockRepo := buildAuditSearchTestService(t, accountID)
+
+		mockRepo.On(reflection.GetMethodName(mockRepo.ExportAuditLogEntriesForAccount), testutils.ContextMatcher, accountID, &audit.AuditLogEntrySearchInput{}, testutils.QueryFilterMatcher, "", auditLogExportPageSize).Return([]*audit.AuditLogEntry(nil), errors.New("repository error"))
+
+		stream := newFakeServerStream[auditsvc.ExportAuditLogEntriesResponse](t)
+		err := service.ExportAuditLogEntries(&auditsvc.ExportAuditLogEntriesRequest{}, stream)
+
+		assert.Equal(t, codes.Internal, status.Code(err))
+		assert.Empty(t, stream.sent)
+
+		mock.AssertExpectationsForObjects(t, mockRepo)
+	})
+
+	t.Run("with send error", func(t *testing.T) {
+		t.Parallel()
+
+		accountID := identifiers.New()
+		service, mockRepo := buildAuditSearchTestService(t, accountID)
+
+		mockRepo.On(reflection.GetMethodName(mockRepo.ExportAuditLogEntriesForAccount), testutils.ContextMatcher, accountID, &audit.AuditLogEntrySearchInput{}, testutils.QueryFilterMatcher, "", auditLogExportPageSize).Return(auditfakes.BuildFakeAuditLogEntriesList().Data, nil)
+
+		stream := newFakeServerStream[auditsvc.ExportAuditLogEntriesResponse](t)
+		stream.sendErr = errors.New("blah")
+
+		err := service.ExportAuditLogEntries(&auditsvc.ExportAuditLogEntriesRequest{}, stream)
+
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+
+		mock.AssertExpectationsForObjects(t, mockRepo)
+	})
+}
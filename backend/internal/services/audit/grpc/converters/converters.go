@@ -26,5 +26,46 @@ func ConvertAuditLogEntryToGRPCAuditLogEntry(entry *audit.AuditLogEntry) *audits
 		RelevantId:       entry.RelevantID,
 		EventType:        entry.EventType,
 		BelongsToUser:    entry.BelongsToUser,
+		Sequence:         entry.Sequence,
+		PreviousHash:     entry.PreviousHash,
+		ChangesHash:      entry.ChangesHash,
+		Hash:             entry.Hash,
+		RedactedAt:       grpcconverters.ConvertTimePointerToPBTimestamp(entry.RedactedAt),
 	}
 }
+
+func ConvertGRPCAuditLogEntrySearchInputToAuditLogEntrySearchInput(input *auditsvc.AuditLogEntrySearchInput) *audit.AuditLogEntrySearchInput {
+	if input == nil {
+		return &audit.AuditLogEntrySearchInput{}
+	}
+
+	return &audit.AuditLogEntrySearchInput{
+		ResourceTypes: input.ResourceTypes,
+		EventTypes:    input.EventTypes,
+		BelongsToUser: input.BelongsToUser,
+		RelevantID:    input.RelevantId,
+		ChangedField:  input.ChangedField,
+	}
+}
+
+func ConvertChainVerificationResultToGRPCAdminVerifyAuditLogChainResponse(result *audit.ChainVerificationResult) *auditsvc.AdminVerifyAuditLogChainResponse {
+	x := &auditsvc.AdminVerifyAuditLogChainResponse{
+		AccountId:            result.AccountID,
+		Intact:               result.Intact(),
+		SignaturesVerified:   result.SignaturesVerified,
+		EntriesChecked:       result.EntriesChecked,
+		CheckpointsChecked:   result.CheckpointsChecked,
+		LastVerifiedSequence: result.LastVerifiedSequence,
+	}
+
+	if result.FirstBrokenLink != nil {
+		x.FirstBrokenLink = &auditsvc.AuditLogChainBreak{
+			EntryId:      result.FirstBrokenLink.EntryID,
+			CheckpointId: result.FirstBrokenLink.CheckpointID,
+			Reason:       result.FirstBrokenLink.Reason,
+			Sequence:     result.FirstBrokenLink.Sequence,
+		}
+	}
+
+	return x
+}
@@ -1,6 +1,7 @@
 package grpc
 
 import (
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/audit"
 	auditmanager "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/audit/manager"
 	auditsvc "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/grpc/generated/services/audit"
 
@@ -21,6 +22,7 @@ func RegisterAuditService(i do.Injector) {
 			do.MustInvoke[logging.Logger](i),
 			do.MustInvoke[tracing.TracerProvider](i),
 			do.MustInvoke[auditmanager.AuditDataManager](i),
+			do.MustInvoke[*audit.ChainConfig](i),
 		), nil
 	})
 }
@@ -20,5 +20,14 @@ func ProvideMethodPermissions() AuditMethodPermissions {
 		auditsvc.AuditService_GetAuditLogEntryByID_FullMethodName: {
 			authorization.ReadAuditLogEntriesPermission,
 		},
+		auditsvc.AuditService_SearchAuditLogEntries_FullMethodName: {
+			authorization.ReadAuditLogEntriesPermission,
+		},
+		auditsvc.AuditService_ExportAuditLogEntries_FullMethodName: {
+			authorization.ReadAuditLogEntriesPermission,
+		},
+		auditsvc.AuditService_AdminVerifyAuditLogChain_FullMethodName: {
+			authorization.VerifyAuditLogChainPermission,
+		},
 	}
 }
@@ -3,6 +3,8 @@
 import (
 	"context"
 
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/authentication/sessions"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/audit"
 	auditkeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/audit/keys"
 	auditmanager "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/audit/manager"
 	grpcconverters "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/grpc/converters"
@@ -26,9 +28,11 @@
 type (
 	serviceImpl struct {
 		auditsvc.UnimplementedAuditServiceServer
-		tracer       tracing.Tracer
-		logger       logging.Logger
-		auditManager auditmanager.AuditDataManager
+		tracer                    tracing.Tracer
+		logger                    logging.Logger
+		sessionContextDataFetcher func(context.Context) (*sessions.ContextData, error)
+		auditManager              auditmanager.AuditDataManager
+		chainConfig               *audit.ChainConfig
 	}
 )
 
@@ -36,11 +40,14 @@ func NewService(
 	logger logging.Logger,
 	tracerProvider tracing.TracerProvider,
 	auditManager auditmanager.AuditDataManager,
+	chainConfig *audit.ChainConfig,
 ) auditsvc.AuditServiceServer {
 	return &serviceImpl{
-		logger:       logging.NewNamedLogger(logger, o11yName),
-		tracer:       tracing.NewNamedTracer(tracerProvider, o11yName),
-		auditManager: auditManager,
+		logger:                    logging.NewNamedLogger(logger, o11yName),
+		tracer:                    tracing.NewNamedTracer(tracerProvider, o11yName),
+		auditManager:              auditManager,
+		chainConfig:               chainConfig,
+		sessionContextDataFetcher: sessions.FetchContextDataFromContext,
 	}
 }
 
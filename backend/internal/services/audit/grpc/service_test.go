@@ -1,9 +1,11 @@
 package grpc
 
 import (
+	"context"
 	"errors"
 	"testing"
 
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/authentication/sessions"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/audit"
 	auditfakes "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/audit/fakes"
 	auditmock "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/audit/mock"
@@ -35,6 +37,9 @@ func buildTestService(t *testing.T) (*serviceImpl, *auditmock.Repository) {
 		tracer:       tracer,
 		logger:       logger,
 		auditManager: auditManager,
+		sessionContextDataFetcher: func(context.Context) (*sessions.ContextData, error) {
+			return &sessions.ContextData{ActiveAccountID: identifiers.New()}, nil
+		},
 	}
 
 	return service, auditManager
@@ -50,7 +55,7 @@ func TestNewService(t *testing.T) {
 		tracerProvider := tracingnoop.NewTracerProvider()
 		auditManager := &auditmock.Repository{}
 
-		service := NewService(logger, tracerProvider, auditManager)
+		service := NewService(logger, tracerProvider, auditManager, &audit.ChainConfig{})
 
 		assert.NotNil(t, service)
 		assert.Implements(t, (*auditsvc.AuditServiceServer)(nil), service)
@@ -60,6 +65,7 @@ func TestNewService(t *testing.T) {
 		assert.True(t, ok)
 		assert.NotNil(t, impl.logger)
 		assert.NotNil(t, impl.tracer)
+		assert.NotNil(t, impl.sessionContextDataFetcher)
 		assert.Equal(t, auditManager, impl.auditManager)
 	})
 }
@@ -166,6 +166,9 @@ func (s *AuthInterceptor) extractSessionContextData(ctx context.Context, metaDat
 			if sessionErr != nil {
 				return nil, observability.PrepareAndLogError(sessionErr, logger, span, "fetching user info for cookie")
 			}
+			// OAuth2 tokens only carry the permissions their granted scopes allow.
+			sessionCtxData.RestrictToOAuth2Scopes(strings.Fields(token.GetScope()))
+			sessionCtxData.OAuth2ClientID = token.GetClientID()
 			return s.applyZuckMode(ctx, metaData, sessionCtxData)
 		}
 	}
@@ -1,8 +1,11 @@
 package interceptors
 
 import (
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/authentication/ratelimiting"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/auth"
 	identitymanager "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity/manager"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/internalops"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/payments"
 
 	"github.com/primandproper/platform/authentication/tokens"
 	"github.com/primandproper/platform/observability/logging"
@@ -26,3 +29,40 @@ func RegisterAuthInterceptor(i do.Injector) {
 		), nil
 	})
 }
+
+// RegisterEntitlementsInterceptor registers the entitlements interceptor with the injector.
+func RegisterEntitlementsInterceptor(i do.Injector) {
+	do.Provide[*EntitlementsInterceptor](i, func(i do.Injector) (*EntitlementsInterceptor, error) {
+		return ProvideEntitlementsInterceptor(
+			do.MustInvoke[tracing.TracerProvider](i),
+			do.MustInvoke[logging.Logger](i),
+			do.MustInvoke[payments.EntitlementsChecker](i),
+			do.MustInvoke[MethodFeatureRequirementsMap](i),
+		), nil
+	})
+}
+
+// RegisterRateLimitInterceptor registers the rate limit interceptor with the injector.
+func RegisterRateLimitInterceptor(i do.Injector) {
+	do.Provide[*RateLimitInterceptor](i, func(i do.Injector) (*RateLimitInterceptor, error) {
+		return ProvideRateLimitInterceptor(
+			do.MustInvoke[tracing.TracerProvider](i),
+			do.MustInvoke[logging.Logger](i),
+			do.MustInvoke[ratelimiting.Store](i),
+			do.MustInvoke[MethodRateLimitPoliciesMap](i),
+			do.MustInvoke[TrustedProxiesList](i),
+		), nil
+	})
+}
+
+// RegisterIdempotencyInterceptor registers the idempotency interceptor with the injector.
+func RegisterIdempotencyInterceptor(i do.Injector) {
+	do.Provide[*IdempotencyInterceptor](i, func(i do.Injector) (*IdempotencyInterceptor, error) {
+		return ProvideIdempotencyInterceptor(
+			do.MustInvoke[tracing.TracerProvider](i),
+			do.MustInvoke[logging.Logger](i),
+			do.MustInvoke[internalops.InternalOpsDataManager](i),
+			do.MustInvoke[MethodIdempotencyTTLsMap](i),
+		), nil
+	})
+}
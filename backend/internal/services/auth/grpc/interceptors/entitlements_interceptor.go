@@ -0,0 +1,106 @@
+package interceptors
+
+import (
+	"context"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/authentication/sessions"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/payments"
+
+	"github.com/primandproper/platform/observability/logging"
+	"github.com/primandproper/platform/observability/tracing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	entitlementsO11yName = "entitlements_interceptor"
+)
+
+// MethodFeatureRequirementsMap is a map of gRPC method full names to the plan features required to call them.
+// This type is used for dependency injection of aggregated service feature requirements.
+type MethodFeatureRequirementsMap map[string][]string
+
+// EntitlementsInterceptor rejects calls to methods whose required features the active account's plan does not include.
+// It must run after the AuthInterceptor, which places session context data in the request context.
+type EntitlementsInterceptor struct {
+	tracer              tracing.Tracer
+	logger              logging.Logger
+	entitlementsChecker payments.EntitlementsChecker
+	methodFeatures      MethodFeatureRequirementsMap
+}
+
+func ProvideEntitlementsInterceptor(
+	tracerProvider tracing.TracerProvider,
+	logger logging.Logger,
+	entitlementsChecker payments.EntitlementsChecker,
+	methodFeatures MethodFeatureRequirementsMap,
+) *EntitlementsInterceptor {
+	return &EntitlementsInterceptor{
+		tracer:              tracing.NewNamedTracer(tracerProvider, entitlementsO11yName),
+		logger:              logging.NewNamedLogger(logger, entitlementsO11yName),
+		entitlementsChecker: entitlementsChecker,
+		methodFeatures:      methodFeatures,
+	}
+}
+
+// checkEntitlements returns a gRPC status error if the method requires features the session's active account lacks.
+func (s *EntitlementsInterceptor) checkEntitlements(ctx context.Context, fullMethod string) error {
+	requiredFeatures, ok := s.methodFeatures[fullMethod]
+	if !ok || len(requiredFeatures) == 0 {
+		return nil
+	}
+
+	ctx, span := s.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := s.logger.WithValue("grpc.method", fullMethod)
+
+	sessionContextData, err := sessions.FetchContextDataFromContext(ctx)
+	if err != nil {
+		// unauthenticated routes have no session to check against.
+		return nil
+	}
+
+	if sessionContextData.ServiceRolePermissionChecker().IsServiceAdmin() {
+		return nil
+	}
+
+	accountID := sessionContextData.GetActiveAccountID()
+	entitlements, err := s.entitlementsChecker.GetEntitlementsForAccount(ctx, accountID)
+	if err != nil {
+		logger.Error("fetching entitlements for account", err)
+		return status.Error(codes.Internal, "checking entitlements")
+	}
+
+	for _, feature := range requiredFeatures {
+		if !entitlements.HasFeature(feature) {
+			logger.WithValue("feature", feature).Info("account not entitled to feature")
+			return status.Error(codes.PermissionDenied, payments.ErrFeatureNotEntitled.Error())
+		}
+	}
+
+	return nil
+}
+
+func (s *EntitlementsInterceptor) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if err := s.checkEntitlements(ctx, info.FullMethod); err != nil {
+			return nil, err
+		}
+
+		return handler(ctx, req)
+	}
+}
+
+// StreamServerInterceptor returns an interceptor that enforces feature entitlements for streaming RPCs.
+func (s *EntitlementsInterceptor) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		if err := s.checkEntitlements(ss.Context(), info.FullMethod); err != nil {
+			return err
+		}
+
+		return handler(srv, ss)
+	}
+}
@@ -0,0 +1,155 @@
+package interceptors
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/authentication/sessions"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/authorization"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/payments"
+	paymentsmock "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/payments/mock"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/testutils"
+
+	loggingnoop "github.com/primandproper/platform/observability/logging/noop"
+	tracingnoop "github.com/primandproper/platform/observability/tracing/noop"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	exampleGatedMethod   = "/example.Service/GatedMethod"
+	exampleUngatedMethod = "/example.Service/UngatedMethod"
+)
+
+func buildTestEntitlementsInterceptor(t *testing.T, checker payments.EntitlementsChecker) *EntitlementsInterceptor {
+	t.Helper()
+
+	return ProvideEntitlementsInterceptor(
+		tracingnoop.NewTracerProvider(),
+		loggingnoop.NewLogger(),
+		checker,
+		MethodFeatureRequirementsMap{
+			exampleGatedMethod: {payments.FeatureAIRecipeImport},
+		},
+	)
+}
+
+func buildContextWithServiceRoles(t *testing.T, accountID string, roleNames ...string) context.Context {
+	t.Helper()
+
+	return context.WithValue(t.Context(), sessions.SessionContextDataKey, &sessions.ContextData{
+		Requester: sessions.RequesterInfo{
+			ServicePermissions: authorization.NewServiceRolePermissionChecker(roleNames, nil),
+		},
+		ActiveAccountID: accountID,
+	})
+}
+
+func TestEntitlementsInterceptor_UnaryServerInterceptor(T *testing.T) {
+	T.Parallel()
+
+	T.Run("denies accounts without the required feature", func(t *testing.T) {
+		t.Parallel()
+
+		const accountID = "account"
+		checker := &paymentsmock.EntitlementsChecker{}
+		checker.On("GetEntitlementsForAccount", testutils.ContextMatcher, accountID).Return(&payments.AccountEntitlements{}, nil)
+
+		handlerCalled := false
+		handler := func(context.Context, any) (any, error) {
+			handlerCalled = true
+			return nil, nil
+		}
+
+		ctx := buildContextWithServiceRoles(t, accountID, "service_user")
+		_, err := buildTestEntitlementsInterceptor(t, checker).UnaryServerInterceptor()(ctx, nil, &grpc.UnaryServerInfo{FullMethod: exampleGatedMethod}, handler)
+
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+		assert.False(t, handlerCalled)
+
+		mock.AssertExpectationsForObjects(t, checker)
+	})
+
+	T.Run("allows accounts with the required feature", func(t *testing.T) {
+		t.Parallel()
+
+		const accountID = "account"
+		checker := &paymentsmock.EntitlementsChecker{}
+		checker.On("GetEntitlementsForAccount", testutils.ContextMatcher, accountID).Return(&payments.AccountEntitlements{
+			Features: []string{payments.FeatureAIRecipeImport},
+		}, nil)
+
+		handler := func(context.Context, any) (any, error) {
+			return "ok", nil
+		}
+
+		ctx := buildContextWithServiceRoles(t, accountID, "service_user")
+		actual, err := buildTestEntitlementsInterceptor(t, checker).UnaryServerInterceptor()(ctx, nil, &grpc.UnaryServerInfo{FullMethod: exampleGatedMethod}, handler)
+
+		require.NoError(t, err)
+		assert.Equal(t, "ok", actual)
+
+		mock.AssertExpectationsForObjects(t, checker)
+	})
+
+	T.Run("skips ungated methods", func(t *testing.T) {
+		t.Parallel()
+
+		checker := &paymentsmock.EntitlementsChecker{}
+
+		handler := func(context.Context, any) (any, error) {
+			return "ok", nil
+		}
+
+		ctx := buildContextWithServiceRoles(t, "account", "service_user")
+		actual, err := buildTestEntitlementsInterceptor(t, checker).UnaryServerInterceptor()(ctx, nil, &grpc.UnaryServerInfo{FullMethod: exampleUngatedMethod}, handler)
+
+		require.NoError(t, err)
+		assert.Equal(t, "ok", actual)
+
+		mock.AssertExpectationsForObjects(t, checker)
+	})
+
+	T.Run("skips service admins", func(t *testing.T) {
+		t.Parallel()
+
+		checker := &paymentsmock.EntitlementsChecker{}
+
+		handler := func(context.Context, any) (any, error) {
+			return "ok", nil
+		}
+
+		ctx := buildContextWithServiceRoles(t, "account", "service_admin")
+		actual, err := buildTestEntitlementsInterceptor(t, checker).UnaryServerInterceptor()(ctx, nil, &grpc.UnaryServerInfo{FullMethod: exampleGatedMethod}, handler)
+
+		require.NoError(t, err)
+		assert.Equal(t, "ok", actual)
+
+		mock.AssertExpectationsForObjects(t, checker)
+	})
+
+	T.Run("with error fetching entitlements", func(t *testing.T) {
+		t.Parallel()
+
+		const accountID = "account"
+		checker := &paymentsmock.EntitlementsChecker{}
+		checker.On("GetEntitlementsForAccount", testutils.ContextMatcher, accountID).Return(nil, errors.New("blah"))
+
+		handler := func(context.Context, any) (any, error) {
+			return nil, nil
+		}
+
+		ctx := buildContextWithServiceRoles(t, accountID, "service_user")
+		_, err := buildTestEntitlementsInterceptor(t, checker).UnaryServerInterceptor()(ctx, nil, &grpc.UnaryServerInfo{FullMethod: exampleGatedMethod}, handler)
+
+		assert.Equal(t, codes.Internal, status.Code(err))
+
+		mock.AssertExpectationsForObjects(t, checker)
+	})
+}
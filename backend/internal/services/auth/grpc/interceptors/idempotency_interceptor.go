@@ -0,0 +1,237 @@
+package interceptors
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/authentication/sessions"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/internalops"
+
+	"github.com/primandproper/platform/identifiers"
+	"github.com/primandproper/platform/observability/logging"
+	"github.com/primandproper/platform/observability/tracing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+)
+
+const (
+	idempotencyO11yName = "idempotency_interceptor"
+
+	// IdempotencyKeyMetadataKey is the request header clients set to make a call safe to retry.
+	IdempotencyKeyMetadataKey = "idempotency-key"
+	// IdempotentReplayMetadataKey is the response header set when a call is answered with a stored response.
+	IdempotentReplayMetadataKey = "idempotent-replayed"
+
+	maxIdempotencyKeyLength = 255
+)
+
+// MethodIdempotencyTTLsMap is a map of gRPC method full names to how long their idempotency keys are remembered.
+// This type is used for dependency injection of the idempotent methods.
+type MethodIdempotencyTTLsMap map[string]time.Duration
+
+// IdempotencyInterceptor makes calls to idempotent methods safe to retry. The first call made with a given
+// idempotency key is handled as usual and its response is stored; later calls with the same key and request get the
+// stored response back without being handled again, and calls with the same key and a different request are rejected.
+// It must run after the AuthInterceptor, since keys belong to the user who made the call.
+type IdempotencyInterceptor struct {
+	tracer      tracing.Tracer
+	logger      logging.Logger
+	dataManager internalops.IdempotencyKeyDataManager
+	methods     MethodIdempotencyTTLsMap
+	now         func() time.Time
+}
+
+func ProvideIdempotencyInterceptor(
+	tracerProvider tracing.TracerProvider,
+	logger logging.Logger,
+	dataManager internalops.IdempotencyKeyDataManager,
+	methods MethodIdempotencyTTLsMap,
+) *IdempotencyInterceptor {
+	return &IdempotencyInterceptor{
+		tracer:      tracing.NewNamedTracer(tracerProvider, idempotencyO11yName),
+		logger:      logging.NewNamedLogger(logger, idempotencyO11yName),
+		dataManager: dataManager,
+		methods:     methods,
+		now:         time.Now,
+	}
+}
+
+func (s *IdempotencyInterceptor) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		ttl, ok := s.methods[info.FullMethod]
+		if !ok {
+			return handler(ctx, req)
+		}
+
+		key := idempotencyKeyFromMetadata(ctx)
+		if key == "" {
+			return handler(ctx, req)
+		}
+
+		if len(key) > maxIdempotencyKeyLength {
+			return nil, status.Errorf(codes.InvalidArgument, "idempotency key must be at most %d characters", maxIdempotencyKeyLength)
+		}
+
+		msg, isProto := req.(proto.Message)
+		sessionContextData, err := sessions.FetchContextDataFromContext(ctx)
+		if !isProto || err != nil || sessionContextData.GetUserID() == "" {
+			return handler(ctx, req)
+		}
+
+		record, replay, err := s.claim(ctx, info.FullMethod, ttl, key, sessionContextData.GetUserID(), msg)
+		if err != nil {
+			return nil, err
+		}
+
+		if replay != nil {
+			if headerErr := grpc.SetHeader(ctx, metadata.Pairs(IdempotentReplayMetadataKey, "true")); headerErr != nil {
+				s.logger.Error("setting idempotent replay header", headerErr)
+			}
+			return replay, nil
+		}
+
+		res, err := handler(ctx, req)
+		if record != nil {
+			record(res, err)
+		}
+
+		return res, err
+	}
+}
+
+// claim claims an idempotency key for a call. When the key was already claimed for the same request, it returns the
+// stored response to replay. Otherwise, it returns a function to record the handled call's outcome with.
+// Store errors let calls through unrecorded, since an unreachable store shouldn't take the API down with it.
+func (s *IdempotencyInterceptor) claim(ctx context.Context, fullMethod string, ttl time.Duration, key, scope string, req proto.Message) (record func(any, error), replay any, err error) {
+	ctx, span := s.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := s.logger.WithSpan(span).WithValue("grpc.method", fullMethod)
+
+	fingerprint, err := fingerprintRequest(req)
+	if err != nil {
+		logger.Error("fingerprinting request", err)
+		return nil, nil, nil
+	}
+
+	id := identifiers.New()
+	claimed, err := s.dataManager.ClaimIdempotencyKey(ctx, &internalops.IdempotencyKeyClaimInput{
+		ID:                 id,
+		Key:                key,
+		Method:             fullMethod,
+		Scope:              scope,
+		RequestFingerprint: fingerprint,
+		ExpiresAt:          s.now().Add(ttl),
+	})
+	if err != nil {
+		logger.Error("claiming idempotency key", err)
+		return nil, nil, nil
+	}
+
+	if !claimed {
+		replay, err = s.replay(ctx, logger, fullMethod, key, scope, fingerprint)
+		return nil, replay, err
+	}
+
+	return func(res any, callErr error) {
+		// the call's own context may be canceled by the time it's done, but the outcome still needs recording.
+		recordCtx := context.WithoutCancel(ctx)
+
+		// failed calls aren't remembered, so that the client can retry them with the same key.
+		resMsg, ok := res.(proto.Message)
+		if callErr != nil || !ok {
+			if releaseErr := s.dataManager.ReleaseIdempotencyKey(recordCtx, id); releaseErr != nil {
+				logger.Error("releasing idempotency key", releaseErr)
+			}
+			return
+		}
+
+		response, marshalErr := proto.Marshal(resMsg)
+		if marshalErr != nil {
+			logger.Error("marshaling idempotent response", marshalErr)
+			if releaseErr := s.dataManager.ReleaseIdempotencyKey(recordCtx, id); releaseErr != nil {
+				logger.Error("releasing idempotency key", releaseErr)
+			}
+			return
+		}
+
+		if completeErr := s.dataManager.CompleteIdempotencyKey(recordCtx, id, string(resMsg.ProtoReflect().Descriptor().FullName()), response); completeErr != nil {
+			logger.Error("completing idempotency key", completeErr)
+		}
+	}, nil, nil
+}
+
+// replay fetches the response stored for an already-claimed idempotency key.
+func (s *IdempotencyInterceptor) replay(ctx context.Context, logger logging.Logger, fullMethod, key, scope, fingerprint string) (proto.Message, error) {
+	existing, err := s.dataManager.GetIdempotencyKey(ctx, scope, fullMethod, key)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			// the key was released or expired between claiming and fetching it.
+			return nil, status.Error(codes.Aborted, "request with this idempotency key was interrupted, please retry")
+		}
+		logger.Error("getting idempotency key", err)
+		return nil, status.Error(codes.Internal, "checking idempotency key")
+	}
+
+	if existing.RequestFingerprint != fingerprint {
+		logger.Info("rejecting reused idempotency key")
+		return nil, status.Error(codes.InvalidArgument, "idempotency key was already used for a different request")
+	}
+
+	if existing.CompletedAt == nil {
+		return nil, status.Error(codes.Aborted, "request with this idempotency key is still in progress")
+	}
+
+	messageType, err := protoregistry.GlobalTypes.FindMessageByName(protoreflect.FullName(existing.ResponseType))
+	if err != nil {
+		logger.Error("finding idempotent response type", err)
+		return nil, status.Error(codes.Internal, "replaying idempotent response")
+	}
+
+	res := messageType.New().Interface()
+	if err = proto.Unmarshal(existing.Response, res); err != nil {
+		logger.Error("unmarshaling idempotent response", err)
+		return nil, status.Error(codes.Internal, "replaying idempotent response")
+	}
+
+	logger.Info("replaying idempotent response")
+
+	return res, nil
+}
+
+func idempotencyKeyFromMetadata(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+
+	values := md.Get(IdempotencyKeyMetadataKey)
+	if len(values) == 0 {
+		return ""
+	}
+
+	return strings.TrimSpace(values[0])
+}
+
+// fingerprintRequest hashes a request's deterministic wire encoding, so that retries of the same request match.
+func fingerprintRequest(req proto.Message) (string, error) {
+	encoded, err := proto.MarshalOptions{Deterministic: true}.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(encoded)
+
+	return hex.EncodeToString(sum[:]), nil
+}
@@ -0,0 +1,303 @@
+package interceptors
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/authentication/sessions"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/internalops"
+	internalopsmock "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/internalops/mock"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/testutils"
+
+	loggingnoop "github.com/primandproper/platform/observability/logging/noop"
+	tracingnoop "github.com/primandproper/platform/observability/tracing/noop"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const (
+	exampleIdempotentMethod    = "/example.Service/IdempotentMethod"
+	exampleIdempotencyKey      = "example-idempotency-key"
+	exampleIdempotencyUserID   = "user"
+	exampleIdempotencyTTL      = time.Hour
+	exampleIdempotentResponse  = "created"
+	exampleIdempotentRequest   = "create this"
+	exampleStringValueTypeName = "google.protobuf.StringValue"
+)
+
+// headerRecordingServerTransportStream keeps the headers a handler sets, so tests can check them.
+type headerRecordingServerTransportStream struct {
+	header metadata.MD
+}
+
+func (s *headerRecordingServerTransportStream) Method() string {
+	return exampleIdempotentMethod
+}
+
+func (s *headerRecordingServerTransportStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
+
+func (s *headerRecordingServerTransportStream) SendHeader(md metadata.MD) error {
+	return s.SetHeader(md)
+}
+
+func (s *headerRecordingServerTransportStream) SetTrailer(metadata.MD) error {
+	return nil
+}
+
+func buildTestIdempotencyInterceptor(t *testing.T, dataManager internalops.IdempotencyKeyDataManager, now time.Time) *IdempotencyInterceptor {
+	t.Helper()
+
+	interceptor := ProvideIdempotencyInterceptor(
+		tracingnoop.NewTracerProvider(),
+		loggingnoop.NewLogger(),
+		dataManager,
+		MethodIdempotencyTTLsMap{
+			exampleIdempotentMethod: exampleIdempotencyTTL,
+		},
+	)
+	interceptor.now = func() time.Time { return now }
+
+	return interceptor
+}
+
+func buildIdempotentCallContext(t *testing.T, key string) (context.Context, *headerRecordingServerTransportStream) {
+	t.Helper()
+
+	ctx := context.WithValue(t.Context(), sessions.SessionContextDataKey, &sessions.ContextData{
+		Requester: sessions.RequesterInfo{
+			UserID: exampleIdempotencyUserID,
+		},
+	})
+	if key != "" {
+		ctx = metadata.NewIncomingContext(ctx, metadata.Pairs(IdempotencyKeyMetadataKey, key))
+	}
+
+	stream := &headerRecordingServerTransportStream{}
+
+	return grpc.NewContextWithServerTransportStream(ctx, stream), stream
+}
+
+func buildStoredIdempotencyKey(t *testing.T, req proto.Message, completedAt *time.Time) *internalops.IdempotencyKey {
+	t.Helper()
+
+	fingerprint, err := fingerprintRequest(req)
+	require.NoError(t, err)
+
+	response, err := proto.Marshal(wrapperspb.String(exampleIdempotentResponse))
+	require.NoError(t, err)
+
+	return &internalops.IdempotencyKey{
+		ID:                 "stored",
+		Key:                exampleIdempotencyKey,
+		Method:             exampleIdempotentMethod,
+		Scope:              exampleIdempotencyUserID,
+		RequestFingerprint: fingerprint,
+		ResponseType:       exampleStringValueTypeName,
+		Response:           response,
+		CompletedAt:        completedAt,
+	}
+}
+
+func TestIdempotencyInterceptor_UnaryServerInterceptor(T *testing.T) {
+	T.Parallel()
+
+	T.Run("stores the first call's response", func(t *testing.T) {
+		t.Parallel()
+
+		now := time.Now()
+		req := wrapperspb.String(exampleIdempotentRequest)
+		fingerprint, err := fingerprintRequest(req)
+		require.NoError(t, err)
+
+		expectedResponse, err := proto.Marshal(wrapperspb.String(exampleIdempotentResponse))
+		require.NoError(t, err)
+
+		dataManager := &internalopsmock.InternalOpsDataManager{}
+		dataManager.On("ClaimIdempotencyKey", testutils.ContextMatcher, mock.MatchedBy(func(input *internalops.IdempotencyKeyClaimInput) bool {
+			return input.Key == exampleIdempotencyKey &&
+				input.Method == exampleIdempotentMethod &&
+				input.Scope == exampleIdempotencyUserID &&
+				input.RequestFingerprint == fingerprint &&
+				input.ExpiresAt.Equal(now.Add(exampleIdempotencyTTL))
+		})).Return(true, nil)
+		dataManager.On("CompleteIdempotencyKey", testutils.ContextMatcher, mock.AnythingOfType("string"), exampleStringValueTypeName, expectedResponse).Return(nil)
+
+		handlerCalls := 0
+		handler := func(context.Context, any) (any, error) {
+			handlerCalls++
+			return wrapperspb.String(exampleIdempotentResponse), nil
+		}
+
+		ctx, stream := buildIdempotentCallContext(t, exampleIdempotencyKey)
+		actual, err := buildTestIdempotencyInterceptor(t, dataManager, now).UnaryServerInterceptor()(ctx, req, &grpc.UnaryServerInfo{FullMethod: exampleIdempotentMethod}, handler)
+
+		require.NoError(t, err)
+		assert.True(t, proto.Equal(wrapperspb.String(exampleIdempotentResponse), actual.(proto.Message)))
+		assert.Equal(t, 1, handlerCalls)
+		assert.Empty(t, stream.header.Get(IdempotentReplayMetadataKey))
+
+		mock.AssertExpectationsForObjects(t, dataManager)
+	})
+
+	T.Run("replays the stored response", func(t *testing.T) {
+		t.Parallel()
+
+		now := time.Now()
+		req := wrapperspb.String(exampleIdempotentRequest)
+
+		dataManager := &internalopsmock.InternalOpsDataManager{}
+		dataManager.On("ClaimIdempotencyKey", testutils.ContextMatcher, testutils.MatchType[*internalops.IdempotencyKeyClaimInput]()).Return(false, nil)
+		dataManager.On("GetIdempotencyKey", testutils.ContextMatcher, exampleIdempotencyUserID, exampleIdempotentMethod, exampleIdempotencyKey).Return(buildStoredIdempotencyKey(t, req, &now), nil)
+
+		handlerCalled := false
+		handler := func(context.Context, any) (any, error) {
+			handlerCalled = true
+			return nil, nil
+		}
+
+		ctx, stream := buildIdempotentCallContext(t, exampleIdempotencyKey)
+		actual, err := buildTestIdempotencyInterceptor(t, dataManager, now).UnaryServerInterceptor()(ctx, req, &grpc.UnaryServerInfo{FullMethod: exampleIdempotentMethod}, handler)
+
+		require.NoError(t, err)
+		assert.True(t, proto.Equal(wrapperspb.String(exampleIdempotentResponse), actual.(proto.Message)))
+		assert.False(t, handlerCalled)
+		assert.Equal(t, []string{"true"}, stream.header.Get(IdempotentReplayMetadataKey))
+
+		mock.AssertExpectationsForObjects(t, dataManager)
+	})
+
+	T.Run("rejects a key reused for a different request", func(t *testing.T) {
+		t.Parallel()
+
+		now := time.Now()
+
+		dataManager := &internalopsmock.InternalOpsDataManager{}
+		dataManager.On("ClaimIdempotencyKey", testutils.ContextMatcher, testutils.MatchType[*internalops.IdempotencyKeyClaimInput]()).Return(false, nil)
+		dataManager.On("GetIdempotencyKey", testutils.ContextMatcher, exampleIdempotencyUserID, exampleIdempotentMethod, exampleIdempotencyKey).Return(buildStoredIdempotencyKey(t, wrapperspb.String("something else"), &now), nil)
+
+		handlerCalled := false
+		handler := func(context.Context, any) (any, error) {
+			handlerCalled = true
+			return nil, nil
+		}
+
+		ctx, _ := buildIdempotentCallContext(t, exampleIdempotencyKey)
+		actual, err := buildTestIdempotencyInterceptor(t, dataManager, now).UnaryServerInterceptor()(ctx, wrapperspb.String(exampleIdempotentRequest), &grpc.UnaryServerInfo{FullMethod: exampleIdempotentMethod}, handler)
+
+		assert.Nil(t, actual)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.False(t, handlerCalled)
+
+		mock.AssertExpectationsForObjects(t, dataManager)
+	})
+
+	T.Run("aborts while the key's first call is in flight", func(t *testing.T) {
+		t.Parallel()
+
+		now := time.Now()
+		req := wrapperspb.String(exampleIdempotentRequest)
+
+		dataManager := &internalopsmock.InternalOpsDataManager{}
+		dataManager.On("ClaimIdempotencyKey", testutils.ContextMatcher, testutils.MatchType[*internalops.IdempotencyKeyClaimInput]()).Return(false, nil)
+		dataManager.On("GetIdempotencyKey", testutils.ContextMatcher, exampleIdempotencyUserID, exampleIdempotentMethod, exampleIdempotencyKey).Return(buildStoredIdempotencyKey(t, req, nil), nil)
+
+		handlerCalled := false
+		handler := func(context.Context, any) (any, error) {
+			handlerCalled = true
+			return nil, nil
+		}
+
+		ctx, _ := buildIdempotentCallContext(t, exampleIdempotencyKey)
+		actual, err := buildTestIdempotencyInterceptor(t, dataManager, now).UnaryServerInterceptor()(ctx, req, &grpc.UnaryServerInfo{FullMethod: exampleIdempotentMethod}, handler)
+
+		assert.Nil(t, actual)
+		assert.Equal(t, codes.Aborted, status.Code(err))
+		assert.False(t, handlerCalled)
+
+		mock.AssertExpectationsForObjects(t, dataManager)
+	})
+
+	T.Run("releases the key when the handler fails", func(t *testing.T) {
+		t.Parallel()
+
+		now := time.Now()
+		var claimedID string
+
+		dataManager := &internalopsmock.InternalOpsDataManager{}
+		dataManager.On("ClaimIdempotencyKey", testutils.ContextMatcher, mock.MatchedBy(func(input *internalops.IdempotencyKeyClaimInput) bool {
+			claimedID = input.ID
+			return true
+		})).Return(true, nil)
+		dataManager.On("ReleaseIdempotencyKey", testutils.ContextMatcher, mock.MatchedBy(func(id string) bool {
+			return id == claimedID
+		})).Return(nil)
+
+		expectedErr := status.Error(codes.Unavailable, "blah")
+		handler := func(context.Context, any) (any, error) {
+			return nil, expectedErr
+		}
+
+		ctx, _ := buildIdempotentCallContext(t, exampleIdempotencyKey)
+		actual, err := buildTestIdempotencyInterceptor(t, dataManager, now).UnaryServerInterceptor()(ctx, wrapperspb.String(exampleIdempotentRequest), &grpc.UnaryServerInfo{FullMethod: exampleIdempotentMethod}, handler)
+
+		assert.Nil(t, actual)
+		assert.Equal(t, expectedErr, err)
+
+		mock.AssertExpectationsForObjects(t, dataManager)
+	})
+
+	T.Run("lets calls through when the store fails", func(t *testing.T) {
+		t.Parallel()
+
+		now := time.Now()
+
+		dataManager := &internalopsmock.InternalOpsDataManager{}
+		dataManager.On("ClaimIdempotencyKey", testutils.ContextMatcher, testutils.MatchType[*internalops.IdempotencyKeyClaimInput]()).Return(false, errors.New("blah"))
+
+		handlerCalls := 0
+		handler := func(context.Context, any) (any, error) {
+			handlerCalls++
+			return wrapperspb.String(exampleIdempotentResponse), nil
+		}
+
+		ctx, _ := buildIdempotentCallContext(t, exampleIdempotencyKey)
+		actual, err := buildTestIdempotencyInterceptor(t, dataManager, now).UnaryServerInterceptor()(ctx, wrapperspb.String(exampleIdempotentRequest), &grpc.UnaryServerInfo{FullMethod: exampleIdempotentMethod}, handler)
+
+		require.NoError(t, err)
+		assert.True(t, proto.Equal(wrapperspb.String(exampleIdempotentResponse), actual.(proto.Message)))
+		assert.Equal(t, 1, handlerCalls)
+
+		mock.AssertExpectationsForObjects(t, dataManager)
+	})
+
+	T.Run("skips calls without an idempotency key", func(t *testing.T) {
+		t.Parallel()
+
+		dataManager := &internalopsmock.InternalOpsDataManager{}
+
+		handler := func(context.Context, any) (any, error) {
+			return "ok", nil
+		}
+
+		ctx, _ := buildIdempotentCallContext(t, "")
+		actual, err := buildTestIdempotencyInterceptor(t, dataManager, time.Now()).UnaryServerInterceptor()(ctx, wrapperspb.String(exampleIdempotentRequest), &grpc.UnaryServerInfo{FullMethod: exampleIdempotentMethod}, handler)
+
+		require.NoError(t, err)
+		assert.Equal(t, "ok", actual)
+
+		mock.AssertExpectationsForObjects(t, dataManager)
+	})
+}
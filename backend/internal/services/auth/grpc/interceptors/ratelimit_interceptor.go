@@ -0,0 +1,290 @@
+package interceptors
+
+import (
+	"context"
+	"math"
+	"net"
+	"net/netip"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/authentication/ratelimiting"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/authentication/sessions"
+
+	"github.com/primandproper/platform/observability/logging"
+	"github.com/primandproper/platform/observability/tracing"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
+)
+
+const (
+	rateLimitO11yName = "rate_limit_interceptor"
+
+	// RetryAfterMetadataKey is the response header that tells rate limited clients how many seconds to wait before retrying.
+	RetryAfterMetadataKey = "retry-after"
+
+	forwardedForHeader = "x-forwarded-for"
+	unknownIPAddress   = "unknown"
+)
+
+// MethodRateLimitPoliciesMap is a map of gRPC method full names to their rate limit policies.
+// This type is used for dependency injection of the configured policies.
+type MethodRateLimitPoliciesMap map[string]*ratelimiting.Policy
+
+// TrustedProxiesList is the set of address ranges whose X-Forwarded-For headers are believed when limiting by IP address.
+// This type is used for dependency injection of the configured proxies.
+type TrustedProxiesList []netip.Prefix
+
+func (l TrustedProxiesList) contains(addr netip.Addr) bool {
+	return slices.ContainsFunc(l, func(prefix netip.Prefix) bool {
+		return prefix.Contains(addr)
+	})
+}
+
+// RateLimitInterceptor throttles calls to methods that have a rate limit policy, and locks out callers of
+// methods with lockout policies after repeated failures. It must run after the AuthInterceptor, which places
+// session context data in the request context; callers without a session are limited by IP address instead.
+type RateLimitInterceptor struct {
+	tracer         tracing.Tracer
+	logger         logging.Logger
+	store          ratelimiting.Store
+	policies       MethodRateLimitPoliciesMap
+	trustedProxies TrustedProxiesList
+}
+
+func ProvideRateLimitInterceptor(
+	tracerProvider tracing.TracerProvider,
+	logger logging.Logger,
+	store ratelimiting.Store,
+	policies MethodRateLimitPoliciesMap,
+	trustedProxies TrustedProxiesList,
+) *RateLimitInterceptor {
+	return &RateLimitInterceptor{
+		tracer:         tracing.NewNamedTracer(tracerProvider, rateLimitO11yName),
+		logger:         logging.NewNamedLogger(logger, rateLimitO11yName),
+		store:          store,
+		policies:       policies,
+		trustedProxies: trustedProxies,
+	}
+}
+
+// admit decides whether a call may proceed. When it may not, it returns how long the caller should wait along with
+// a ResourceExhausted status. When it may, it returns a function to report the call's outcome to, which is nil
+// unless the method has a lockout policy.
+// Store errors let calls through, since an unreachable store shouldn't take the API down with it.
+func (s *RateLimitInterceptor) admit(ctx context.Context, fullMethod string, req any) (report func(error), retryAfter time.Duration, err error) {
+	policy, ok := s.policies[fullMethod]
+	if !ok || policy == nil {
+		return nil, 0, nil
+	}
+
+	ctx, span := s.tracer.StartSpan(ctx)
+	defer span.End()
+
+	key := rateLimitKey(ctx, fullMethod, policy, req, s.trustedProxies)
+	logger := s.logger.WithSpan(span).WithValue("grpc.method", fullMethod)
+
+	if policy.Lockout != nil {
+		lockedOutFor, lockoutErr := s.store.LockedOutFor(ctx, key)
+		if lockoutErr != nil {
+			logger.Error("checking rate limit lockout", lockoutErr)
+		} else if lockedOutFor > 0 {
+			logger.Info("rejecting call from locked out caller")
+			return nil, lockedOutFor, resourceExhausted("too many failed attempts", lockedOutFor)
+		}
+	}
+
+	decision, allowErr := s.store.Allow(ctx, key, policy.Limit)
+	if allowErr != nil {
+		logger.Error("checking rate limit", allowErr)
+	} else if !decision.Allowed {
+		logger.Info("rejecting rate limited call")
+		return nil, decision.RetryAfter, resourceExhausted("rate limit exceeded", decision.RetryAfter)
+	}
+
+	if policy.Lockout == nil {
+		return nil, 0, nil
+	}
+
+	return func(callErr error) {
+		// the call's own context may be canceled by the time it's done, but the outcome still needs recording.
+		reportCtx := context.WithoutCancel(ctx)
+		if callErr == nil {
+			if resetErr := s.store.ResetFailures(reportCtx, key); resetErr != nil {
+				logger.Error("resetting rate limit failures", resetErr)
+			}
+			return
+		}
+
+		if status.Code(callErr) == codes.ResourceExhausted {
+			return
+		}
+
+		if _, recordErr := s.store.RecordFailure(reportCtx, key, policy.Lockout); recordErr != nil {
+			logger.Error("recording rate limit failure", recordErr)
+		}
+	}, 0, nil
+}
+
+func (s *RateLimitInterceptor) UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		report, retryAfter, err := s.admit(ctx, info.FullMethod, req)
+		if err != nil {
+			if headerErr := grpc.SetHeader(ctx, retryAfterMetadata(retryAfter)); headerErr != nil {
+				s.logger.Error("setting retry-after header", headerErr)
+			}
+			return nil, err
+		}
+
+		res, err := handler(ctx, req)
+		if report != nil {
+			report(err)
+		}
+
+		return res, err
+	}
+}
+
+// StreamServerInterceptor returns an interceptor that rate limits streaming RPCs when they're opened.
+func (s *RateLimitInterceptor) StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return func(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		report, retryAfter, err := s.admit(ss.Context(), info.FullMethod, nil)
+		if err != nil {
+			if headerErr := ss.SetHeader(retryAfterMetadata(retryAfter)); headerErr != nil {
+				s.logger.Error("setting retry-after header", headerErr)
+			}
+			return err
+		}
+
+		err = handler(srv, ss)
+		if report != nil {
+			report(err)
+		}
+
+		return err
+	}
+}
+
+// rateLimitKey builds the key a call is limited under. Keys that need a session fall back to the caller's IP address
+// when there isn't one, or when the session doesn't have what the key needs (e.g. no OAuth2 client).
+func rateLimitKey(ctx context.Context, fullMethod string, policy *ratelimiting.Policy, req any, trustedProxies TrustedProxiesList) string {
+	keyType, value := policy.Key, ""
+
+	if sessionContextData, err := sessions.FetchContextDataFromContext(ctx); err == nil {
+		switch policy.Key {
+		case ratelimiting.KeyByUser:
+			value = sessionContextData.GetUserID()
+		case ratelimiting.KeyByAccount:
+			value = sessionContextData.GetActiveAccountID()
+		case ratelimiting.KeyByOAuth2Client:
+			value = sessionContextData.GetOAuth2ClientID()
+		case ratelimiting.KeyByIP:
+		}
+	}
+
+	if value == "" {
+		keyType, value = ratelimiting.KeyByIP, clientIPAddress(ctx, trustedProxies)
+	}
+
+	key := fullMethod + "|" + string(keyType) + "|" + value
+	if policy.Subject != nil && req != nil {
+		if subject := strings.ToLower(strings.TrimSpace(policy.Subject(req))); subject != "" {
+			key += "|" + subject
+		}
+	}
+
+	return key
+}
+
+// clientIPAddress returns the address of the caller. X-Forwarded-For is only believed when the peer is a trusted
+// proxy, and then only as far back as the right-most hop that isn't one, since anything before that hop could have
+// been written by the caller.
+func clientIPAddress(ctx context.Context, trustedProxies TrustedProxiesList) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return unknownIPAddress
+	}
+
+	peerAddress := p.Addr.String()
+	if host, _, err := net.SplitHostPort(peerAddress); err == nil {
+		peerAddress = host
+	}
+
+	addr, err := parseIPAddress(peerAddress)
+	if err != nil || !trustedProxies.contains(addr) {
+		return peerAddress
+	}
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return peerAddress
+	}
+
+	hops := []string{}
+	for _, value := range md.Get(forwardedForHeader) {
+		for hop := range strings.SplitSeq(value, ",") {
+			if hop = strings.TrimSpace(hop); hop != "" {
+				hops = append(hops, hop)
+			}
+		}
+	}
+
+	client := peerAddress
+	for _, hop := range slices.Backward(hops) {
+		hopAddress, parseErr := parseIPAddress(hop)
+		if parseErr != nil {
+			// a hop that isn't an address can't be a trusted proxy either.
+			return hop
+		}
+
+		client = hopAddress.String()
+		if !trustedProxies.contains(hopAddress) {
+			break
+		}
+	}
+
+	return client
+}
+
+// parseIPAddress parses an address that may carry a port, as some proxies include one in X-Forwarded-For.
+func parseIPAddress(s string) (netip.Addr, error) {
+	addr, err := netip.ParseAddr(s)
+	if err != nil {
+		addrPort, addrPortErr := netip.ParseAddrPort(s)
+		if addrPortErr != nil {
+			return netip.Addr{}, err
+		}
+		addr = addrPort.Addr()
+	}
+
+	return addr.Unmap(), nil
+}
+
+// retryAfterSeconds rounds a wait up to whole seconds, since that's what the retry-after header carries.
+func retryAfterSeconds(retryAfter time.Duration) int64 {
+	return max(int64(math.Ceil(retryAfter.Seconds())), 1)
+}
+
+func retryAfterMetadata(retryAfter time.Duration) metadata.MD {
+	return metadata.Pairs(RetryAfterMetadataKey, strconv.FormatInt(retryAfterSeconds(retryAfter), 10))
+}
+
+// resourceExhausted builds a ResourceExhausted status carrying a RetryInfo detail.
+func resourceExhausted(msg string, retryAfter time.Duration) error {
+	st := status.New(codes.ResourceExhausted, msg)
+	if detailed, err := st.WithDetails(&errdetails.RetryInfo{
+		RetryDelay: durationpb.New(time.Duration(retryAfterSeconds(retryAfter)) * time.Second),
+	}); err == nil {
+		st = detailed
+	}
+
+	return st.Err()
+}
@@ -0,0 +1,288 @@
+package interceptors
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/netip"
+	"testing"
+	"time"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/authentication/ratelimiting"
+
+	loggingnoop "github.com/primandproper/platform/observability/logging/noop"
+	tracingnoop "github.com/primandproper/platform/observability/tracing/noop"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	exampleRateLimitedMethod = "/example.Service/RateLimitedMethod"
+	exampleLockoutMethod     = "/example.Service/LockoutMethod"
+	exampleUnlimitedMethod   = "/example.Service/UnlimitedMethod"
+)
+
+// erroringRateLimitStore fails every call, like an unreachable Redis would.
+type erroringRateLimitStore struct{}
+
+func (erroringRateLimitStore) Allow(context.Context, string, ratelimiting.Limit) (*ratelimiting.Decision, error) {
+	return nil, errors.New("blah")
+}
+
+func (erroringRateLimitStore) LockedOutFor(context.Context, string) (time.Duration, error) {
+	return 0, errors.New("blah")
+}
+
+func (erroringRateLimitStore) RecordFailure(context.Context, string, *ratelimiting.Lockout) (time.Duration, error) {
+	return 0, errors.New("blah")
+}
+
+func (erroringRateLimitStore) ResetFailures(context.Context, string) error {
+	return errors.New("blah")
+}
+
+func buildTestRateLimitInterceptor(t *testing.T, store ratelimiting.Store) *RateLimitInterceptor {
+	t.Helper()
+
+	return ProvideRateLimitInterceptor(
+		tracingnoop.NewTracerProvider(),
+		loggingnoop.NewLogger(),
+		store,
+		MethodRateLimitPoliciesMap{
+			exampleRateLimitedMethod: {
+				Key:   ratelimiting.KeyByIP,
+				Limit: ratelimiting.Limit{Burst: 1, Interval: time.Minute},
+			},
+			exampleLockoutMethod: {
+				Key:   ratelimiting.KeyByIP,
+				Limit: ratelimiting.Limit{Burst: 10, Interval: time.Minute},
+				Lockout: &ratelimiting.Lockout{
+					MaxFailures: 2,
+					Window:      time.Minute,
+					BaseBackoff: time.Minute,
+					MaxBackoff:  time.Hour,
+				},
+			},
+		},
+		TrustedProxiesList{netip.MustParsePrefix("10.0.0.0/8")},
+	)
+}
+
+func buildContextWithPeer(t *testing.T, peerAddress string, forwardedFor ...string) context.Context {
+	t.Helper()
+
+	ctx := peer.NewContext(t.Context(), &peer.Peer{Addr: &net.TCPAddr{IP: net.ParseIP(peerAddress), Port: 54321}})
+	if len(forwardedFor) > 0 {
+		ctx = metadata.NewIncomingContext(ctx, metadata.MD{forwardedForHeader: forwardedFor})
+	}
+
+	return ctx
+}
+
+func TestRateLimitInterceptor_UnaryServerInterceptor(T *testing.T) {
+	T.Parallel()
+
+	okHandler := func(context.Context, any) (any, error) {
+		return "ok", nil
+	}
+
+	T.Run("rejects calls over the limit", func(t *testing.T) {
+		t.Parallel()
+
+		interceptor := buildTestRateLimitInterceptor(t, ratelimiting.NewInMemoryStore())
+		info := &grpc.UnaryServerInfo{FullMethod: exampleRateLimitedMethod}
+		ctx := buildContextWithPeer(t, "203.0.113.7")
+
+		actual, err := interceptor.UnaryServerInterceptor()(ctx, nil, info, okHandler)
+		require.NoError(t, err)
+		assert.Equal(t, "ok", actual)
+
+		handlerCalled := false
+		_, err = interceptor.UnaryServerInterceptor()(ctx, nil, info, func(context.Context, any) (any, error) {
+			handlerCalled = true
+			return nil, nil
+		})
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+		assert.False(t, handlerCalled)
+	})
+
+	T.Run("limits callers separately", func(t *testing.T) {
+		t.Parallel()
+
+		interceptor := buildTestRateLimitInterceptor(t, ratelimiting.NewInMemoryStore())
+		info := &grpc.UnaryServerInfo{FullMethod: exampleRateLimitedMethod}
+
+		_, err := interceptor.UnaryServerInterceptor()(buildContextWithPeer(t, "203.0.113.7"), nil, info, okHandler)
+		require.NoError(t, err)
+
+		_, err = interceptor.UnaryServerInterceptor()(buildContextWithPeer(t, "203.0.113.8"), nil, info, okHandler)
+		assert.NoError(t, err)
+	})
+
+	T.Run("ignores forwarded addresses from untrusted peers", func(t *testing.T) {
+		t.Parallel()
+
+		interceptor := buildTestRateLimitInterceptor(t, ratelimiting.NewInMemoryStore())
+		info := &grpc.UnaryServerInfo{FullMethod: exampleRateLimitedMethod}
+
+		_, err := interceptor.UnaryServerInterceptor()(buildContextWithPeer(t, "203.0.113.7", "198.51.100.1"), nil, info, okHandler)
+		require.NoError(t, err)
+
+		// a fresh spoofed header doesn't get the caller a fresh bucket.
+		_, err = interceptor.UnaryServerInterceptor()(buildContextWithPeer(t, "203.0.113.7", "198.51.100.2"), nil, info, okHandler)
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	})
+
+	T.Run("skips methods without a policy", func(t *testing.T) {
+		t.Parallel()
+
+		interceptor := buildTestRateLimitInterceptor(t, erroringRateLimitStore{})
+		info := &grpc.UnaryServerInfo{FullMethod: exampleUnlimitedMethod}
+
+		for range 3 {
+			actual, err := interceptor.UnaryServerInterceptor()(buildContextWithPeer(t, "203.0.113.7"), nil, info, okHandler)
+			require.NoError(t, err)
+			assert.Equal(t, "ok", actual)
+		}
+	})
+
+	T.Run("lets calls through when the store fails", func(t *testing.T) {
+		t.Parallel()
+
+		interceptor := buildTestRateLimitInterceptor(t, erroringRateLimitStore{})
+		info := &grpc.UnaryServerInfo{FullMethod: exampleLockoutMethod}
+
+		actual, err := interceptor.UnaryServerInterceptor()(buildContextWithPeer(t, "203.0.113.7"), nil, info, okHandler)
+		require.NoError(t, err)
+		assert.Equal(t, "ok", actual)
+	})
+
+	T.Run("locks out callers after repeated failures", func(t *testing.T) {
+		t.Parallel()
+
+		interceptor := buildTestRateLimitInterceptor(t, ratelimiting.NewInMemoryStore())
+		info := &grpc.UnaryServerInfo{FullMethod: exampleLockoutMethod}
+		ctx := buildContextWithPeer(t, "203.0.113.7")
+
+		failingHandler := func(context.Context, any) (any, error) {
+			return nil, status.Error(codes.Unauthenticated, "nope")
+		}
+
+		for range 2 {
+			_, err := interceptor.UnaryServerInterceptor()(ctx, nil, info, failingHandler)
+			assert.Equal(t, codes.Unauthenticated, status.Code(err))
+		}
+
+		_, err := interceptor.UnaryServerInterceptor()(ctx, nil, info, okHandler)
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+	})
+
+	T.Run("successful calls reset failures", func(t *testing.T) {
+		t.Parallel()
+
+		interceptor := buildTestRateLimitInterceptor(t, ratelimiting.NewInMemoryStore())
+		info := &grpc.UnaryServerInfo{FullMethod: exampleLockoutMethod}
+		ctx := buildContextWithPeer(t, "203.0.113.7")
+
+		failingHandler := func(context.Context, any) (any, error) {
+			return nil, status.Error(codes.Unauthenticated, "nope")
+		}
+
+		_, err := interceptor.UnaryServerInterceptor()(ctx, nil, info, failingHandler)
+		require.Equal(t, codes.Unauthenticated, status.Code(err))
+
+		_, err = interceptor.UnaryServerInterceptor()(ctx, nil, info, okHandler)
+		require.NoError(t, err)
+
+		_, err = interceptor.UnaryServerInterceptor()(ctx, nil, info, failingHandler)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+
+		_, err = interceptor.UnaryServerInterceptor()(ctx, nil, info, okHandler)
+		assert.NoError(t, err)
+	})
+}
+
+func TestClientIPAddress(T *testing.T) {
+	T.Parallel()
+
+	trustedProxies := TrustedProxiesList{
+		netip.MustParsePrefix("10.0.0.0/8"),
+		netip.MustParsePrefix("192.0.2.10/32"),
+	}
+
+	T.Run("without a peer", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Equal(t, unknownIPAddress, clientIPAddress(t.Context(), trustedProxies))
+	})
+
+	T.Run("with untrusted peer", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := buildContextWithPeer(t, "203.0.113.7", "198.51.100.1")
+
+		assert.Equal(t, "203.0.113.7", clientIPAddress(ctx, trustedProxies))
+	})
+
+	T.Run("with no trusted proxies configured", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := buildContextWithPeer(t, "10.0.0.1", "198.51.100.1")
+
+		assert.Equal(t, "10.0.0.1", clientIPAddress(ctx, nil))
+	})
+
+	T.Run("with trusted peer and no forwarded header", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := buildContextWithPeer(t, "10.0.0.1")
+
+		assert.Equal(t, "10.0.0.1", clientIPAddress(ctx, trustedProxies))
+	})
+
+	T.Run("takes the right-most untrusted hop", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := buildContextWithPeer(t, "10.0.0.1", "198.51.100.99, 203.0.113.7, 192.0.2.10")
+
+		assert.Equal(t, "203.0.113.7", clientIPAddress(ctx, trustedProxies))
+	})
+
+	T.Run("reads hops across repeated headers", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := buildContextWithPeer(t, "10.0.0.1", "198.51.100.99", "203.0.113.7, 10.1.2.3")
+
+		assert.Equal(t, "203.0.113.7", clientIPAddress(ctx, trustedProxies))
+	})
+
+	T.Run("with every hop trusted", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := buildContextWithPeer(t, "10.0.0.1", "10.0.0.2, 192.0.2.10")
+
+		assert.Equal(t, "10.0.0.2", clientIPAddress(ctx, trustedProxies))
+	})
+
+	T.Run("with hops that carry ports", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := buildContextWithPeer(t, "10.0.0.1", "203.0.113.7:4711, [2001:db8::1]:443")
+
+		assert.Equal(t, "2001:db8::1", clientIPAddress(ctx, trustedProxies))
+	})
+
+	T.Run("with a hop that isn't an address", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := buildContextWithPeer(t, "10.0.0.1", "203.0.113.7, garbage")
+
+		assert.Equal(t, "garbage", clientIPAddress(ctx, trustedProxies))
+	})
+}
@@ -1,9 +1,17 @@
 package authentication
 
 import (
+	"context"
+	"encoding/json"
 	"net/http"
+	"slices"
+	"strings"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/authorization"
 
 	"github.com/primandproper/platform/observability"
+
+	"github.com/go-oauth2/oauth2/v4"
 )
 
 // AuthorizeHandler is our oauth2 auth route.
@@ -13,6 +21,11 @@ func (s *service) AuthorizeHandler(res http.ResponseWriter, req *http.Request) {
 
 	logger := s.logger.WithRequest(req)
 
+	// the nonce is stored alongside the authorization code, so the ID token it's exchanged for can echo it back.
+	if nonce := req.FormValue("nonce"); nonce != "" {
+		req = req.WithContext(context.WithValue(req.Context(), oidcNonceContextKey, nonce))
+	}
+
 	if err := s.oauth2Server.HandleAuthorizeRequest(res, req); err != nil {
 		observability.AcknowledgeError(err, logger, span, "handling authorization request")
 		http.Error(res, err.Error(), http.StatusBadRequest)
@@ -20,13 +33,63 @@ func (s *service) AuthorizeHandler(res http.ResponseWriter, req *http.Request) {
 }
 
 func (s *service) TokenHandler(res http.ResponseWriter, req *http.Request) {
-	_, span := s.tracer.StartSpan(req.Context())
+	ctx, span := s.tracer.StartSpan(req.Context())
 	defer span.End()
 
 	logger := s.logger.WithRequest(req)
 
-	if err := s.oauth2Server.HandleTokenRequest(res, req); err != nil {
-		observability.AcknowledgeError(err, logger, span, "handling token request")
-		http.Error(res, err.Error(), http.StatusInternalServerError)
+	grantType, tokenRequest, err := s.oauth2Server.ValidationTokenRequest(req)
+	if err != nil {
+		s.writeTokenError(res, err)
+		return
+	}
+
+	// the authorization code is consumed by the exchange, so fetch the nonce it was issued with first.
+	var nonce string
+	if grantType == oauth2.AuthorizationCode {
+		if code, codeErr := s.oauthRepo.GetOAuth2ClientTokenByCode(ctx, tokenRequest.Code); codeErr == nil {
+			nonce = code.Nonce
+		}
+	}
+
+	tokenInfo, err := s.oauth2Server.GetAccessToken(ctx, grantType, tokenRequest)
+	if err != nil {
+		s.writeTokenError(res, err)
+		return
+	}
+
+	data := s.oauth2Server.GetTokenData(tokenInfo)
+
+	// client credentials tokens act for the client's owner rather than a signed-in user, so they get no ID token.
+	if grantType != oauth2.ClientCredentials && slices.Contains(strings.Fields(tokenInfo.GetScope()), authorization.OpenIDOAuth2Scope) {
+		idToken, idTokenErr := s.issueIDToken(ctx, tokenInfo, nonce)
+		if idTokenErr != nil {
+			observability.AcknowledgeError(idTokenErr, logger, span, "issuing id token")
+			http.Error(res, "failed to issue id token", http.StatusInternalServerError)
+			return
+		}
+		data["id_token"] = idToken
+	}
+
+	s.writeTokenResponse(res, data, http.StatusOK, nil)
+}
+
+func (s *service) writeTokenError(res http.ResponseWriter, err error) {
+	data, statusCode, header := s.oauth2Server.GetErrorData(err)
+	s.writeTokenResponse(res, data, statusCode, header)
+}
+
+// writeTokenResponse writes a token endpoint response the way the oauth2 server itself would.
+func (s *service) writeTokenResponse(res http.ResponseWriter, data map[string]any, statusCode int, header http.Header) {
+	res.Header().Set("Content-Type", "application/json;charset=UTF-8")
+	res.Header().Set("Cache-Control", "no-store")
+	res.Header().Set("Pragma", "no-cache")
+	for key := range header {
+		res.Header().Set(key, header.Get(key))
+	}
+	res.WriteHeader(statusCode)
+
+	if err := json.NewEncoder(res).Encode(data); err != nil {
+		observability.AcknowledgeError(err, s.logger, nil, "encoding token response")
 	}
 }
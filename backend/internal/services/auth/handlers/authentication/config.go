@@ -16,6 +16,7 @@
 
 		Tokens                tokenscfg.Config `envPrefix:"TOKENS_"           json:"tokens"`
 		OAuth2                OAuth2Config     `envPrefix:"OAUTH2"            json:"oauth2"`
+		OIDC                  OIDCConfig       `envPrefix:"OIDC_"             json:"oidc"`
 		TokenLifetime         time.Duration    `env:"JWT_LIFETIME"            json:"jwtLifetime"`
 		Debug                 bool             `env:"DEBUG"                   json:"debug,omitempty"`
 		EnableUserSignup      bool             `env:"ENABLE_USER_SIGNUP"      json:"enableUserSignup,omitempty"`
@@ -55,3 +56,23 @@ func (cfg OAuth2Config) ValidateWithContext(ctx context.Context) error {
 		validation.Field(&cfg.Domain, validation.Required),
 	)
 }
+
+// OIDCConfig configures our OpenID Connect provider.
+type OIDCConfig struct {
+	_ struct{} `json:"-"`
+
+	Issuer                     string        `env:"ISSUER"                        json:"issuer"`
+	IDTokenLifespan            time.Duration `env:"ID_TOKEN_LIFESPAN"             json:"idTokenLifespan"`
+	SigningKeyRotationInterval time.Duration `env:"SIGNING_KEY_ROTATION_INTERVAL" json:"signingKeyRotationInterval"`
+}
+
+var _ validation.ValidatableWithContext = (*OIDCConfig)(nil)
+
+// ValidateWithContext validates a OIDCConfig struct.
+func (cfg OIDCConfig) ValidateWithContext(ctx context.Context) error {
+	return validation.ValidateStructWithContext(ctx, &cfg,
+		validation.Field(&cfg.Issuer, validation.Required),
+		validation.Field(&cfg.IDTokenLifespan, validation.Required),
+		validation.Field(&cfg.SigningKeyRotationInterval, validation.Required, validation.Min(cfg.IDTokenLifespan)),
+	)
+}
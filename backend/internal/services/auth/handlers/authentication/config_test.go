@@ -89,3 +89,35 @@ func TestOAuth2Config_ValidateWithContext(T *testing.T) {
 		assert.Error(t, cfg.ValidateWithContext(ctx))
 	})
 }
+
+func TestOIDCConfig_ValidateWithContext(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+
+		cfg := OIDCConfig{
+			Issuer:                     "https://example.com",
+			IDTokenLifespan:            time.Hour,
+			SigningKeyRotationInterval: 24 * time.Hour,
+		}
+
+		assert.NoError(t, cfg.ValidateWithContext(ctx))
+	})
+
+	T.Run("with rotation interval shorter than ID token lifespan", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+
+		cfg := OIDCConfig{
+			Issuer:                     "https://example.com",
+			IDTokenLifespan:            time.Hour,
+			SigningKeyRotationInterval: time.Minute,
+		}
+
+		assert.Error(t, cfg.ValidateWithContext(ctx))
+	})
+}
@@ -36,6 +36,7 @@ func RegisterAuthHTTPService(i do.Injector) {
 			do.MustInvoke[logging.Logger](i),
 			do.MustInvoke[tracing.TracerProvider](i),
 			do.MustInvoke[identitymanager.IdentityDataManager](i),
+			do.MustInvoke[oauth.Repository](i),
 			do.MustInvoke[authn.Authenticator](i),
 			do.MustInvoke[tokens.Issuer](i),
 			do.MustInvoke[*manage.Manager](i),
@@ -3,10 +3,12 @@
 import (
 	"context"
 	"net/http"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/authentication"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/authorization"
 	identitykeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity/keys"
 	identitymanager "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity/manager"
 	types "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/oauth"
@@ -55,6 +57,7 @@ func ProvideOAuth2ServerImplementation(
 	logger logging.Logger,
 	tracerProvider tracing.TracerProvider,
 	identityDataManager identitymanager.IdentityDataManager,
+	oauthDataManager types.OAuth2ClientDataManager,
 	authenticator authentication.Authenticator,
 	tokenIssuer tokens.Issuer,
 	manager *manage.Manager,
@@ -67,9 +70,11 @@ func ProvideOAuth2ServerImplementation(
 		AllowedGrantTypes: []oauth2.GrantType{
 			oauth2.AuthorizationCode,
 			oauth2.Refreshing,
+			oauth2.ClientCredentials,
 		},
 		AllowedCodeChallengeMethods: []oauth2.CodeChallengeMethod{
 			oauth2.CodeChallengePlain,
+			oauth2.CodeChallengeS256,
 		},
 	}
 
@@ -79,7 +84,9 @@ func ProvideOAuth2ServerImplementation(
 
 	oauth2Server.AuthorizeScopeHandler = AuthorizeScopeHandler(logger)
 	oauth2Server.AccessTokenExpHandler = AccessTokenExpHandler(logger)
-	oauth2Server.ClientScopeHandler = ClientScopeHandler(logger)
+	oauth2Server.ClientScopeHandler = ClientScopeHandler(logger, oauthDataManager)
+	oauth2Server.ClientAuthorizedHandler = ClientAuthorizedHandler(logger, oauthDataManager)
+	oauth2Server.RefreshingScopeHandler = RefreshingScopeHandler(logger)
 	oauth2Server.UserAuthorizationHandler = buildUserAuthorizationHandler(tracer, logger, tokenIssuer)
 	oauth2Server.PasswordAuthorizationHandler = buildPasswordAuthorizationHandler(logger, authenticator, identityDataManager)
 	// this allows GET requests to retrieve tokens
@@ -174,9 +181,10 @@ func buildUserAuthorizationHandler(tracer tracing.Tracer, logger logging.Logger,
 	}
 }
 
+// AuthorizeScopeHandler normalizes the requested scope. Whether the client may have it is decided by ClientScopeHandler.
 func AuthorizeScopeHandler(_ logging.Logger) func(http.ResponseWriter, *http.Request) (string, error) {
 	return func(_ http.ResponseWriter, req *http.Request) (scope string, err error) {
-		return req.URL.Query().Get("scope"), nil
+		return normalizeScope(req.URL.Query().Get("scope")), nil
 	}
 }
 
@@ -186,8 +194,94 @@ func AccessTokenExpHandler(_ logging.Logger) func(http.ResponseWriter, *http.Req
 	}
 }
 
-func ClientScopeHandler(_ logging.Logger) func(_ *oauth2.TokenGenerateRequest) (allowed bool, err error) {
-	return func(_ *oauth2.TokenGenerateRequest) (allowed bool, err error) {
+// ClientScopeHandler restricts token requests to the client's registered redirect URIs and allowed scopes.
+// Requests that don't ask for a scope are granted every scope the client allows. Client credentials tokens
+// act on behalf of the user the client belongs to.
+func ClientScopeHandler(logger logging.Logger, dataManager types.OAuth2ClientDataManager) func(*oauth2.TokenGenerateRequest) (allowed bool, err error) {
+	return func(tgr *oauth2.TokenGenerateRequest) (allowed bool, err error) {
+		ctx := context.Background()
+		if tgr.Request != nil {
+			ctx = tgr.Request.Context()
+		}
+
+		l := logger.WithValue(oauthkeys.OAuth2ClientClientIDKey, tgr.ClientID)
+
+		client, err := dataManager.GetOAuth2ClientByClientID(ctx, tgr.ClientID)
+		if err != nil {
+			observability.AcknowledgeError(err, l, nil, "fetching oauth2 client for scope check")
+			return false, errors.ErrInvalidClient
+		}
+
+		// clients registered before redirect URIs were tracked have none, and keep accepting any.
+		if tgr.RedirectURI != "" && len(client.RedirectURIs) > 0 && !client.AllowsRedirectURI(tgr.RedirectURI) {
+			return false, errors.ErrInvalidRedirectURI
+		}
+
+		requested := strings.Fields(tgr.Scope)
+		if len(requested) == 0 {
+			requested = client.AllowedScopes
+		}
+
+		if len(requested) == 0 {
+			return false, nil
+		}
+
+		for _, scope := range requested {
+			if !authorization.IsValidOAuth2Scope(scope) || !client.AllowsScope(scope) {
+				l.WithValue("scope", scope).Info("oauth2 client requested disallowed scope")
+				return false, nil
+			}
+		}
+		tgr.Scope = normalizeScope(strings.Join(requested, " "))
+
+		if tgr.Request != nil && oauth2.GrantType(tgr.Request.FormValue("grant_type")) == oauth2.ClientCredentials {
+			if client.BelongsToUser == nil || *client.BelongsToUser == "" {
+				return false, errors.ErrUnauthorizedClient
+			}
+			tgr.UserID = *client.BelongsToUser
+		}
+
 		return true, nil
 	}
 }
+
+// ClientAuthorizedHandler restricts clients to the grant types they were allowed when registered.
+func ClientAuthorizedHandler(logger logging.Logger, dataManager types.OAuth2ClientDataManager) func(string, oauth2.GrantType) (allowed bool, err error) {
+	return func(clientID string, grant oauth2.GrantType) (allowed bool, err error) {
+		l := logger.WithValue(oauthkeys.OAuth2ClientClientIDKey, clientID)
+
+		client, err := dataManager.GetOAuth2ClientByClientID(context.Background(), clientID)
+		if err != nil {
+			observability.AcknowledgeError(err, l, nil, "fetching oauth2 client for grant type check")
+			return false, errors.ErrInvalidClient
+		}
+
+		return client.AllowsGrantType(grant.String()), nil
+	}
+}
+
+// RefreshingScopeHandler prevents refreshed tokens from gaining scopes the original grant didn't have.
+func RefreshingScopeHandler(_ logging.Logger) func(*oauth2.TokenGenerateRequest, string) (allowed bool, err error) {
+	return func(tgr *oauth2.TokenGenerateRequest, oldScope string) (allowed bool, err error) {
+		granted := strings.Fields(oldScope)
+		for _, scope := range strings.Fields(tgr.Scope) {
+			if !slices.Contains(granted, scope) {
+				return false, nil
+			}
+		}
+
+		return true, nil
+	}
+}
+
+// normalizeScope deduplicates a space-delimited scope string, preserving the order scopes were given in.
+func normalizeScope(scope string) string {
+	out := []string{}
+	for _, s := range strings.Fields(scope) {
+		if !slices.Contains(out, s) {
+			out = append(out, s)
+		}
+	}
+
+	return strings.Join(out, " ")
+}
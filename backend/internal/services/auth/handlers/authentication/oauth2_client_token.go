@@ -41,10 +41,12 @@ func (t *tokenImpl) SetRedirectURI(s string) {
 }
 
 func (t *tokenImpl) GetScope() string {
-	return "n/a"
+	return t.Token.Scope
 }
 
-func (t *tokenImpl) SetScope(_ string) {}
+func (t *tokenImpl) SetScope(s string) {
+	t.Token.Scope = s
+}
 
 func (t *tokenImpl) GetCode() string {
 	return t.Token.Code
@@ -80,12 +80,12 @@ func TestTokenImpl_Scope(T *testing.T) {
 		t.Parallel()
 
 		token := &tokenImpl{}
+		scope := "read write"
 
-		// SetScope should be a no-op
-		token.SetScope("test-scope")
+		token.SetScope(scope)
 		result := token.GetScope()
 
-		assert.Equal(t, "n/a", result)
+		assert.Equal(t, scope, result)
 	})
 }
 
@@ -8,9 +8,12 @@
 	"time"
 
 	mockauthn "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/authentication/mock"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/authorization"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity/fakes"
 	identitymanagermock "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity/manager/mock"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/oauth"
+	oauthfakes "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/oauth/fakes"
 	oauthmock "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/oauth/mock"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/testutils"
 
@@ -19,7 +22,9 @@
 	"github.com/primandproper/platform/observability/tracing"
 	tracingnoop "github.com/primandproper/platform/observability/tracing/noop"
 	"github.com/primandproper/platform/random"
+	"github.com/primandproper/platform/reflection"
 
+	"github.com/go-oauth2/oauth2/v4"
 	oauth2errors "github.com/go-oauth2/oauth2/v4/errors"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -67,7 +72,7 @@ func TestProvideOAuth2ServerImplementation(T *testing.T) {
 		dataManager := &oauthmock.RepositoryMock{}
 		manager := ProvideOAuth2ClientManager(logger, tracerProvider, cfg, dataManager)
 
-		server := ProvideOAuth2ServerImplementation(logger, tracerProvider, identityDataManager, authenticator, tokenIssuer, manager)
+		server := ProvideOAuth2ServerImplementation(logger, tracerProvider, identityDataManager, dataManager, authenticator, tokenIssuer, manager)
 
 		assert.NotNil(t, server)
 	})
@@ -392,6 +397,22 @@ func TestAuthorizeScopeHandler(T *testing.T) {
 		assert.NoError(t, err)
 		assert.Equal(t, "read write", scope)
 	})
+	T.Run("deduplicates scopes", func(t *testing.T) {
+		t.Parallel()
+
+		handler := AuthorizeScopeHandler(loggingnoop.NewLogger())
+
+		req := &http.Request{
+			URL: &url.URL{
+				RawQuery: "scope=write%20read%20write",
+			},
+		}
+
+		scope, err := handler(nil, req)
+
+		assert.NoError(t, err)
+		assert.Equal(t, "write read", scope)
+	})
 }
 
 func TestAccessTokenExpHandler(T *testing.T) {
@@ -414,16 +435,217 @@ func TestAccessTokenExpHandler(T *testing.T) {
 func TestClientScopeHandler(T *testing.T) {
 	T.Parallel()
 
+	buildTokenRequest := func(t *testing.T, clientID, scope string, form url.Values) *oauth2.TokenGenerateRequest {
+		t.Helper()
+
+		req, err := http.NewRequestWithContext(t.Context(), http.MethodPost, "/oauth2/token", http.NoBody)
+		require.NoError(t, err)
+		req.Form = form
+
+		return &oauth2.TokenGenerateRequest{
+			ClientID: clientID,
+			Scope:    scope,
+			Request:  req,
+		}
+	}
+
 	T.Run("standard", func(t *testing.T) {
 		t.Parallel()
 
-		logger := loggingnoop.NewLogger()
-		handler := ClientScopeHandler(logger)
-		assert.NotNil(t, handler)
+		exampleClient := oauthfakes.BuildFakeOAuth2Client()
+		exampleClient.AllowedScopes = []string{authorization.ReadOAuth2Scope, authorization.WriteOAuth2Scope}
+
+		dataManager := &oauthmock.RepositoryMock{}
+		dataManager.On(reflection.GetMethodName(dataManager.GetOAuth2ClientByClientID), testutils.ContextMatcher, exampleClient.ClientID).Return(exampleClient, nil)
+
+		handler := ClientScopeHandler(loggingnoop.NewLogger(), dataManager)
+		tgr := buildTokenRequest(t, exampleClient.ClientID, "read read", url.Values{})
+		tgr.RedirectURI = exampleClient.RedirectURIs[0]
+
+		allowed, err := handler(tgr)
+		assert.NoError(t, err)
+		assert.True(t, allowed)
+		assert.Equal(t, "read", tgr.Scope)
+
+		mock.AssertExpectationsForObjects(t, dataManager)
+	})
+
+	T.Run("defaults to every allowed scope", func(t *testing.T) {
+		t.Parallel()
+
+		exampleClient := oauthfakes.BuildFakeOAuth2Client()
+		exampleClient.AllowedScopes = []string{authorization.ReadOAuth2Scope, authorization.WriteOAuth2Scope}
+
+		dataManager := &oauthmock.RepositoryMock{}
+		dataManager.On(reflection.GetMethodName(dataManager.GetOAuth2ClientByClientID), testutils.ContextMatcher, exampleClient.ClientID).Return(exampleClient, nil)
+
+		handler := ClientScopeHandler(loggingnoop.NewLogger(), dataManager)
+		tgr := buildTokenRequest(t, exampleClient.ClientID, "", url.Values{})
+
+		allowed, err := handler(tgr)
+		assert.NoError(t, err)
+		assert.True(t, allowed)
+		assert.Equal(t, "read write", tgr.Scope)
+
+		mock.AssertExpectationsForObjects(t, dataManager)
+	})
+
+	T.Run("with disallowed scope", func(t *testing.T) {
+		t.Parallel()
+
+		exampleClient := oauthfakes.BuildFakeOAuth2Client()
+		exampleClient.AllowedScopes = []string{authorization.ReadOAuth2Scope}
+
+		dataManager := &oauthmock.RepositoryMock{}
+		dataManager.On(reflection.GetMethodName(dataManager.GetOAuth2ClientByClientID), testutils.ContextMatcher, exampleClient.ClientID).Return(exampleClient, nil)
+
+		handler := ClientScopeHandler(loggingnoop.NewLogger(), dataManager)
+
+		allowed, err := handler(buildTokenRequest(t, exampleClient.ClientID, "read admin", url.Values{}))
+		assert.NoError(t, err)
+		assert.False(t, allowed)
+
+		mock.AssertExpectationsForObjects(t, dataManager)
+	})
+
+	T.Run("with unregistered redirect URI", func(t *testing.T) {
+		t.Parallel()
+
+		exampleClient := oauthfakes.BuildFakeOAuth2Client()
+
+		dataManager := &oauthmock.RepositoryMock{}
+		dataManager.On(reflection.GetMethodName(dataManager.GetOAuth2ClientByClientID), testutils.ContextMatcher, exampleClient.ClientID).Return(exampleClient, nil)
+
+		handler := ClientScopeHandler(loggingnoop.NewLogger(), dataManager)
+		tgr := buildTokenRequest(t, exampleClient.ClientID, "read", url.Values{})
+		tgr.RedirectURI = "https://attacker.example/callback"
+
+		allowed, err := handler(tgr)
+		assert.ErrorIs(t, err, oauth2errors.ErrInvalidRedirectURI)
+		assert.False(t, allowed)
+
+		mock.AssertExpectationsForObjects(t, dataManager)
+	})
+
+	T.Run("with client credentials", func(t *testing.T) {
+		t.Parallel()
+
+		exampleClient := oauthfakes.BuildFakeOAuth2Client()
+		exampleClient.BelongsToUser = new(oauthfakes.BuildFakeID())
+
+		dataManager := &oauthmock.RepositoryMock{}
+		dataManager.On(reflection.GetMethodName(dataManager.GetOAuth2ClientByClientID), testutils.ContextMatcher, exampleClient.ClientID).Return(exampleClient, nil)
 
-		allowed, err := handler(nil)
+		handler := ClientScopeHandler(loggingnoop.NewLogger(), dataManager)
+		tgr := buildTokenRequest(t, exampleClient.ClientID, "read", url.Values{"grant_type": {oauth2.ClientCredentials.String()}})
 
+		allowed, err := handler(tgr)
 		assert.NoError(t, err)
 		assert.True(t, allowed)
+		assert.Equal(t, *exampleClient.BelongsToUser, tgr.UserID)
+
+		mock.AssertExpectationsForObjects(t, dataManager)
+	})
+
+	T.Run("with client credentials for client without user", func(t *testing.T) {
+		t.Parallel()
+
+		exampleClient := oauthfakes.BuildFakeOAuth2Client()
+
+		dataManager := &oauthmock.RepositoryMock{}
+		dataManager.On(reflection.GetMethodName(dataManager.GetOAuth2ClientByClientID), testutils.ContextMatcher, exampleClient.ClientID).Return(exampleClient, nil)
+
+		handler := ClientScopeHandler(loggingnoop.NewLogger(), dataManager)
+		tgr := buildTokenRequest(t, exampleClient.ClientID, "read", url.Values{"grant_type": {oauth2.ClientCredentials.String()}})
+
+		allowed, err := handler(tgr)
+		assert.ErrorIs(t, err, oauth2errors.ErrUnauthorizedClient)
+		assert.False(t, allowed)
+
+		mock.AssertExpectationsForObjects(t, dataManager)
+	})
+
+	T.Run("with error fetching client", func(t *testing.T) {
+		t.Parallel()
+
+		exampleClient := oauthfakes.BuildFakeOAuth2Client()
+
+		dataManager := &oauthmock.RepositoryMock{}
+		dataManager.On(reflection.GetMethodName(dataManager.GetOAuth2ClientByClientID), testutils.ContextMatcher, exampleClient.ClientID).Return((*oauth.OAuth2Client)(nil), errors.New("blah"))
+
+		handler := ClientScopeHandler(loggingnoop.NewLogger(), dataManager)
+
+		allowed, err := handler(buildTokenRequest(t, exampleClient.ClientID, "read", url.Values{}))
+		assert.ErrorIs(t, err, oauth2errors.ErrInvalidClient)
+		assert.False(t, allowed)
+
+		mock.AssertExpectationsForObjects(t, dataManager)
+	})
+}
+
+func TestClientAuthorizedHandler(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		exampleClient := oauthfakes.BuildFakeOAuth2Client()
+		exampleClient.AllowedGrantTypes = []string{oauth.AuthorizationCodeGrantType}
+
+		dataManager := &oauthmock.RepositoryMock{}
+		dataManager.On(reflection.GetMethodName(dataManager.GetOAuth2ClientByClientID), testutils.ContextMatcher, exampleClient.ClientID).Return(exampleClient, nil)
+
+		handler := ClientAuthorizedHandler(loggingnoop.NewLogger(), dataManager)
+
+		allowed, err := handler(exampleClient.ClientID, oauth2.AuthorizationCode)
+		assert.NoError(t, err)
+		assert.True(t, allowed)
+
+		allowed, err = handler(exampleClient.ClientID, oauth2.ClientCredentials)
+		assert.NoError(t, err)
+		assert.False(t, allowed)
+
+		mock.AssertExpectationsForObjects(t, dataManager)
+	})
+
+	T.Run("with error fetching client", func(t *testing.T) {
+		t.Parallel()
+
+		exampleClient := oauthfakes.BuildFakeOAuth2Client()
+
+		dataManager := &oauthmock.RepositoryMock{}
+		dataManager.On(reflection.GetMethodName(dataManager.GetOAuth2ClientByClientID), testutils.ContextMatcher, exampleClient.ClientID).Return((*oauth.OAuth2Client)(nil), errors.New("blah"))
+
+		handler := ClientAuthorizedHandler(loggingnoop.NewLogger(), dataManager)
+
+		allowed, err := handler(exampleClient.ClientID, oauth2.AuthorizationCode)
+		assert.ErrorIs(t, err, oauth2errors.ErrInvalidClient)
+		assert.False(t, allowed)
+
+		mock.AssertExpectationsForObjects(t, dataManager)
+	})
+}
+
+func TestRefreshingScopeHandler(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		handler := RefreshingScopeHandler(loggingnoop.NewLogger())
+
+		allowed, err := handler(&oauth2.TokenGenerateRequest{Scope: "read"}, "read write")
+		assert.NoError(t, err)
+		assert.True(t, allowed)
+	})
+
+	T.Run("with broadened scope", func(t *testing.T) {
+		t.Parallel()
+
+		handler := RefreshingScopeHandler(loggingnoop.NewLogger())
+
+		allowed, err := handler(&oauth2.TokenGenerateRequest{Scope: "read admin"}, "read write")
+		assert.NoError(t, err)
+		assert.False(t, allowed)
 	})
 }
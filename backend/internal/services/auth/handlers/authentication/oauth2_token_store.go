@@ -37,6 +37,7 @@ func (s *oauth2TokenStoreImpl) Create(ctx context.Context, info oauth2.TokenInfo
 		AccessCreatedAt:     info.GetAccessCreateAt(),
 		CodeCreatedAt:       info.GetCodeCreateAt(),
 		RedirectURI:         info.GetRedirectURI(),
+		Scope:               info.GetScope(),
 		Code:                info.GetCode(),
 		CodeChallenge:       info.GetCodeChallenge(),
 		CodeChallengeMethod: info.GetCodeChallengeMethod().String(),
@@ -50,6 +51,10 @@ func (s *oauth2TokenStoreImpl) Create(ctx context.Context, info oauth2.TokenInfo
 		RefreshExpiresIn:    info.GetRefreshExpiresIn(),
 	}
 
+	if nonce, ok := ctx.Value(oidcNonceContextKey).(string); ok && input.Code != "" {
+		input.Nonce = nonce
+	}
+
 	if _, err := s.dataManager.CreateOAuth2ClientToken(ctx, input); err != nil {
 		return observability.PrepareAndLogError(err, logger, span, "creating oauth2 client token")
 	}
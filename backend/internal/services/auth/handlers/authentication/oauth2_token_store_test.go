@@ -1,6 +1,7 @@
 package authentication
 
 import (
+	"context"
 	"errors"
 	"testing"
 	"time"
@@ -53,6 +54,36 @@ func TestOAuth2TokenStoreImpl_Create(T *testing.T) {
 		mock.AssertExpectationsForObjects(t, dataManager)
 	})
 
+	T.Run("with oidc nonce", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := context.WithValue(t.Context(), oidcNonceContextKey, t.Name())
+		logger := loggingnoop.NewLogger()
+		tracer := tracing.NewTracerForTest("test")
+
+		token := fakes.BuildFakeOAuth2ClientToken()
+		tokenInfo := convertTokenToImpl(token)
+
+		dataManager := &oauthmock.RepositoryMock{}
+		dataManager.On(
+			"CreateOAuth2ClientToken",
+			testutils.ContextMatcher,
+			mock.MatchedBy(func(input *types.OAuth2ClientTokenDatabaseCreationInput) bool {
+				return input.Nonce == t.Name()
+			}),
+		).Return(token, nil)
+
+		store := &oauth2TokenStoreImpl{
+			tracer:      tracer,
+			logger:      logger,
+			dataManager: dataManager,
+		}
+
+		assert.NoError(t, store.Create(ctx, tokenInfo))
+
+		mock.AssertExpectationsForObjects(t, dataManager)
+	})
+
 	T.Run("with database error", func(t *testing.T) {
 		t.Parallel()
 
@@ -0,0 +1,403 @@
+package authentication
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/authorization"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity"
+	types "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/oauth"
+	oauthkeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/oauth/keys"
+
+	"github.com/primandproper/platform/identifiers"
+	"github.com/primandproper/platform/observability"
+	"github.com/primandproper/platform/observability/logging"
+	"github.com/primandproper/platform/observability/tracing"
+	"github.com/primandproper/platform/routing"
+
+	"github.com/go-oauth2/oauth2/v4"
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	// oidcNonceContextKey carries an authorization request's nonce to the token store, so it can be stored alongside the code.
+	oidcNonceContextKey routing.ContextKey = "oidc_nonce"
+
+	oidcSigningKeyBits = 2048
+	birthdateFormat    = "2006-01-02"
+)
+
+var (
+	errNoOIDCSigningKey = errors.New("no usable oidc signing key")
+)
+
+type (
+	// oidcSigningKeyRing hands out the key new ID tokens should be signed with, rotating it once it retires.
+	oidcSigningKeyRing struct {
+		tracer      tracing.Tracer
+		logger      logging.Logger
+		dataManager types.OIDCSigningKeyDataManager
+		cfg         *OIDCConfig
+		rotationMu  sync.Mutex
+	}
+
+	// jsonWebKey is the public half of an RSA signing key, per RFC 7517.
+	jsonWebKey struct {
+		KeyType   string `json:"kty"`
+		Use       string `json:"use"`
+		KeyID     string `json:"kid"`
+		Algorithm string `json:"alg"`
+		Modulus   string `json:"n"`
+		Exponent  string `json:"e"`
+	}
+
+	// jsonWebKeySet is what we serve from our JWKS endpoint.
+	jsonWebKeySet struct {
+		Keys []jsonWebKey `json:"keys"`
+	}
+
+	// openIDConfiguration is our OpenID Provider Metadata document.
+	openIDConfiguration struct {
+		Issuer                            string   `json:"issuer"`
+		AuthorizationEndpoint             string   `json:"authorization_endpoint"`
+		TokenEndpoint                     string   `json:"token_endpoint"`
+		UserInfoEndpoint                  string   `json:"userinfo_endpoint"`
+		JWKSURI                           string   `json:"jwks_uri"`
+		RevocationEndpoint                string   `json:"revocation_endpoint"`
+		ScopesSupported                   []string `json:"scopes_supported"`
+		ResponseTypesSupported            []string `json:"response_types_supported"`
+		GrantTypesSupported               []string `json:"grant_types_supported"`
+		SubjectTypesSupported             []string `json:"subject_types_supported"`
+		IDTokenSigningAlgValuesSupported  []string `json:"id_token_signing_alg_values_supported"`
+		TokenEndpointAuthMethodsSupported []string `json:"token_endpoint_auth_methods_supported"`
+		CodeChallengeMethodsSupported     []string `json:"code_challenge_methods_supported"`
+		ClaimsSupported                   []string `json:"claims_supported"`
+	}
+
+	// openIDUserClaims are the standard claims we release about a user, depending on the scopes they granted.
+	openIDUserClaims struct {
+		EmailVerified     *bool  `json:"email_verified,omitempty"`
+		Name              string `json:"name,omitempty"`
+		GivenName         string `json:"given_name,omitempty"`
+		FamilyName        string `json:"family_name,omitempty"`
+		PreferredUsername string `json:"preferred_username,omitempty"`
+		Birthdate         string `json:"birthdate,omitempty"`
+		Email             string `json:"email,omitempty"`
+		UpdatedAt         int64  `json:"updated_at,omitempty"`
+	}
+
+	// userInfoResponse is what we serve from our userinfo endpoint.
+	userInfoResponse struct {
+		Subject string `json:"sub"`
+		openIDUserClaims
+	}
+
+	// idTokenClaims are the claims an ID token carries.
+	idTokenClaims struct {
+		jwt.RegisteredClaims
+		openIDUserClaims
+		Nonce           string `json:"nonce,omitempty"`
+		AuthorizedParty string `json:"azp,omitempty"`
+	}
+)
+
+func newOIDCSigningKeyRing(logger logging.Logger, tracerProvider tracing.TracerProvider, cfg *OIDCConfig, dataManager types.OIDCSigningKeyDataManager) *oidcSigningKeyRing {
+	return &oidcSigningKeyRing{
+		tracer:      tracing.NewNamedTracer(tracerProvider, "oidc_signing_key_ring"),
+		logger:      logging.NewNamedLogger(logger, "oidc_signing_key_ring"),
+		dataManager: dataManager,
+		cfg:         cfg,
+	}
+}
+
+// currentSigningKey returns the newest key that hasn't retired, creating one if none exists.
+func (r *oidcSigningKeyRing) currentSigningKey(ctx context.Context) (*types.OIDCSigningKey, *rsa.PrivateKey, error) {
+	ctx, span := r.tracer.StartSpan(ctx)
+	defer span.End()
+
+	if key, privateKey, err := r.activeSigningKey(ctx); err == nil {
+		return key, privateKey, nil
+	} else if !errors.Is(err, errNoOIDCSigningKey) {
+		return nil, nil, observability.PrepareError(err, span, "fetching active oidc signing key")
+	}
+
+	r.rotationMu.Lock()
+	defer r.rotationMu.Unlock()
+
+	// another request may have rotated the key while we waited.
+	if key, privateKey, err := r.activeSigningKey(ctx); err == nil {
+		return key, privateKey, nil
+	} else if !errors.Is(err, errNoOIDCSigningKey) {
+		return nil, nil, observability.PrepareError(err, span, "fetching active oidc signing key")
+	}
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, oidcSigningKeyBits)
+	if err != nil {
+		return nil, nil, observability.PrepareError(err, span, "generating oidc signing key")
+	}
+
+	encodedKey, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	if err != nil {
+		return nil, nil, observability.PrepareError(err, span, "encoding oidc signing key")
+	}
+
+	// a key is published until every ID token it could have signed has expired.
+	now := time.Now()
+	retiresAt := now.Add(r.cfg.SigningKeyRotationInterval)
+
+	key, err := r.dataManager.CreateOIDCSigningKey(ctx, &types.OIDCSigningKeyDatabaseCreationInput{
+		ID:         identifiers.New(),
+		Algorithm:  types.RS256OIDCSigningKeyAlgorithm,
+		PrivateKey: string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: encodedKey})),
+		RetiresAt:  retiresAt,
+		ExpiresAt:  retiresAt.Add(r.cfg.IDTokenLifespan),
+	})
+	if err != nil {
+		return nil, nil, observability.PrepareAndLogError(err, r.logger, span, "creating oidc signing key")
+	}
+
+	r.logger.WithValue(oauthkeys.OIDCSigningKeyIDKey, key.ID).Info("rotated oidc signing key")
+
+	return key, privateKey, nil
+}
+
+func (r *oidcSigningKeyRing) activeSigningKey(ctx context.Context) (*types.OIDCSigningKey, *rsa.PrivateKey, error) {
+	keys, err := r.dataManager.GetUnexpiredOIDCSigningKeys(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	now := time.Now()
+	for _, key := range keys {
+		if key.IsRetired(now) {
+			continue
+		}
+
+		privateKey, parseErr := parseOIDCSigningKey(key)
+		if parseErr != nil {
+			return nil, nil, parseErr
+		}
+
+		return key, privateKey, nil
+	}
+
+	return nil, nil, errNoOIDCSigningKey
+}
+
+// publicKeys returns every key an unexpired ID token may have been signed with.
+func (r *oidcSigningKeyRing) publicKeys(ctx context.Context) (*jsonWebKeySet, error) {
+	ctx, span := r.tracer.StartSpan(ctx)
+	defer span.End()
+
+	keys, err := r.dataManager.GetUnexpiredOIDCSigningKeys(ctx)
+	if err != nil {
+		return nil, observability.PrepareError(err, span, "fetching unexpired oidc signing keys")
+	}
+
+	keySet := &jsonWebKeySet{Keys: []jsonWebKey{}}
+	for _, key := range keys {
+		privateKey, parseErr := parseOIDCSigningKey(key)
+		if parseErr != nil {
+			return nil, observability.PrepareError(parseErr, span, "parsing oidc signing key")
+		}
+
+		keySet.Keys = append(keySet.Keys, jsonWebKey{
+			KeyType:   "RSA",
+			Use:       "sig",
+			KeyID:     key.ID,
+			Algorithm: key.Algorithm,
+			Modulus:   base64.RawURLEncoding.EncodeToString(privateKey.N.Bytes()),
+			Exponent:  base64.RawURLEncoding.EncodeToString(big.NewInt(int64(privateKey.E)).Bytes()),
+		})
+	}
+
+	return keySet, nil
+}
+
+func parseOIDCSigningKey(key *types.OIDCSigningKey) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode([]byte(key.PrivateKey))
+	if block == nil {
+		return nil, fmt.Errorf("decoding oidc signing key %s", key.ID)
+	}
+
+	parsed, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("parsing oidc signing key %s: %w", key.ID, err)
+	}
+
+	privateKey, ok := parsed.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("oidc signing key %s is not an RSA key", key.ID)
+	}
+
+	return privateKey, nil
+}
+
+// buildOpenIDUserClaims releases the claims about a user that the given scopes ask for.
+func buildOpenIDUserClaims(user *identity.User, scopes []string) openIDUserClaims {
+	claims := openIDUserClaims{}
+
+	if slices.Contains(scopes, authorization.ProfileOAuth2Scope) {
+		claims.Name = strings.TrimSpace(fmt.Sprintf("%s %s", user.FirstName, user.LastName))
+		claims.GivenName = user.FirstName
+		claims.FamilyName = user.LastName
+		claims.PreferredUsername = user.Username
+		claims.UpdatedAt = user.CreatedAt.Unix()
+		if user.LastUpdatedAt != nil {
+			claims.UpdatedAt = user.LastUpdatedAt.Unix()
+		}
+		if user.Birthday != nil {
+			claims.Birthdate = user.Birthday.Format(birthdateFormat)
+		}
+	}
+
+	if slices.Contains(scopes, authorization.EmailOAuth2Scope) {
+		claims.Email = user.EmailAddress
+		claims.EmailVerified = new(user.EmailAddressVerifiedAt != nil)
+	}
+
+	return claims
+}
+
+// issueIDToken builds and signs an ID token for the user an OAuth2 token was granted to.
+func (s *service) issueIDToken(ctx context.Context, tokenInfo oauth2.TokenInfo, nonce string) (string, error) {
+	ctx, span := s.tracer.StartSpan(ctx)
+	defer span.End()
+
+	user, err := s.identityDataManager.GetUser(ctx, tokenInfo.GetUserID())
+	if err != nil {
+		return "", observability.PrepareError(err, span, "fetching user for id token")
+	}
+
+	key, privateKey, err := s.oidcSigningKeys.currentSigningKey(ctx)
+	if err != nil {
+		return "", observability.PrepareError(err, span, "fetching oidc signing key")
+	}
+
+	now := time.Now()
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, &idTokenClaims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Issuer:    s.oidcConfig.Issuer,
+			Subject:   user.ID,
+			Audience:  jwt.ClaimStrings{tokenInfo.GetClientID()},
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(s.oidcConfig.IDTokenLifespan)),
+		},
+		openIDUserClaims: buildOpenIDUserClaims(user, strings.Fields(tokenInfo.GetScope())),
+		Nonce:            nonce,
+		AuthorizedParty:  tokenInfo.GetClientID(),
+	})
+	token.Header["kid"] = key.ID
+
+	signed, err := token.SignedString(privateKey)
+	if err != nil {
+		return "", observability.PrepareError(err, span, "signing id token")
+	}
+
+	return signed, nil
+}
+
+// OpenIDConfigurationHandler serves our OpenID Provider Metadata.
+func (s *service) OpenIDConfigurationHandler(res http.ResponseWriter, req *http.Request) {
+	_, span := s.tracer.StartSpan(req.Context())
+	defer span.End()
+
+	issuer := strings.TrimSuffix(s.oidcConfig.Issuer, "/")
+
+	res.Header().Set("Access-Control-Allow-Origin", "*")
+	s.writeOIDCResponse(res, &openIDConfiguration{
+		Issuer:                            s.oidcConfig.Issuer,
+		AuthorizationEndpoint:             issuer + "/oauth2/authorize",
+		TokenEndpoint:                     issuer + "/oauth2/token",
+		UserInfoEndpoint:                  issuer + "/oauth2/userinfo",
+		JWKSURI:                           issuer + "/oauth2/jwks",
+		RevocationEndpoint:                issuer + "/oauth2/revoke",
+		ScopesSupported:                   authorization.OAuth2Scopes,
+		ResponseTypesSupported:            []string{oauth2.Code.String()},
+		GrantTypesSupported:               types.OAuth2GrantTypes,
+		SubjectTypesSupported:             []string{"public"},
+		IDTokenSigningAlgValuesSupported:  []string{types.RS256OIDCSigningKeyAlgorithm},
+		TokenEndpointAuthMethodsSupported: []string{"client_secret_basic", "client_secret_post"},
+		CodeChallengeMethodsSupported:     []string{oauth2.CodeChallengePlain.String(), oauth2.CodeChallengeS256.String()},
+		ClaimsSupported: []string{
+			"iss", "sub", "aud", "exp", "iat", "nonce", "azp",
+			"name", "given_name", "family_name", "preferred_username", "birthdate", "updated_at",
+			"email", "email_verified",
+		},
+	}, http.StatusOK)
+}
+
+// JWKSHandler serves the public keys ID tokens can be verified with.
+func (s *service) JWKSHandler(res http.ResponseWriter, req *http.Request) {
+	ctx, span := s.tracer.StartSpan(req.Context())
+	defer span.End()
+
+	logger := s.logger.WithRequest(req)
+
+	keySet, err := s.oidcSigningKeys.publicKeys(ctx)
+	if err != nil {
+		observability.AcknowledgeError(err, logger, span, "fetching oidc public keys")
+		http.Error(res, "failed to fetch signing keys", http.StatusInternalServerError)
+		return
+	}
+
+	res.Header().Set("Access-Control-Allow-Origin", "*")
+	s.writeOIDCResponse(res, keySet, http.StatusOK)
+}
+
+// UserInfoHandler serves the claims an OAuth2 token's scopes release about its user.
+func (s *service) UserInfoHandler(res http.ResponseWriter, req *http.Request) {
+	ctx, span := s.tracer.StartSpan(req.Context())
+	defer span.End()
+
+	logger := s.logger.WithRequest(req)
+
+	tokenInfo, err := s.oauth2Server.ValidationBearerToken(req)
+	if err != nil {
+		res.Header().Set("WWW-Authenticate", `Bearer error="invalid_token"`)
+		http.Error(res, "invalid token", http.StatusUnauthorized)
+		return
+	}
+
+	scopes := strings.Fields(tokenInfo.GetScope())
+	if !slices.Contains(scopes, authorization.OpenIDOAuth2Scope) || tokenInfo.GetUserID() == "" {
+		res.Header().Set("WWW-Authenticate", `Bearer error="insufficient_scope", scope="openid"`)
+		http.Error(res, "insufficient scope", http.StatusForbidden)
+		return
+	}
+
+	user, err := s.identityDataManager.GetUser(ctx, tokenInfo.GetUserID())
+	if err != nil {
+		observability.AcknowledgeError(err, logger, span, "fetching user for userinfo")
+		http.Error(res, "failed to fetch user", http.StatusInternalServerError)
+		return
+	}
+
+	s.writeOIDCResponse(res, &userInfoResponse{
+		Subject:          user.ID,
+		openIDUserClaims: buildOpenIDUserClaims(user, scopes),
+	}, http.StatusOK)
+}
+
+func (s *service) writeOIDCResponse(res http.ResponseWriter, data any, statusCode int) {
+	res.Header().Set("Content-Type", "application/json;charset=UTF-8")
+	res.Header().Set("Cache-Control", "no-store")
+	res.WriteHeader(statusCode)
+
+	if err := json.NewEncoder(res).Encode(data); err != nil {
+		observability.AcknowledgeError(err, s.logger, nil, "encoding oidc response")
+	}
+}
@@ -0,0 +1,275 @@
+package authentication
+
+import (
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/authorization"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity/fakes"
+	identitymanagermock "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity/manager/mock"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/oauth"
+	oauthfakes "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/oauth/fakes"
+	oauthmock "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/oauth/mock"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/testutils"
+
+	loggingnoop "github.com/primandproper/platform/observability/logging/noop"
+	tracingnoop "github.com/primandproper/platform/observability/tracing/noop"
+	"github.com/primandproper/platform/reflection"
+
+	"github.com/go-oauth2/oauth2/v4/models"
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func buildOIDCConfigForTest() *OIDCConfig {
+	return &OIDCConfig{
+		Issuer:                     "https://example.com",
+		IDTokenLifespan:            time.Hour,
+		SigningKeyRotationInterval: 24 * time.Hour,
+	}
+}
+
+func buildOIDCSigningKeyForTest(t *testing.T, retiresAt time.Time) (*oauth.OIDCSigningKey, *rsa.PrivateKey) {
+	t.Helper()
+
+	privateKey, err := rsa.GenerateKey(rand.Reader, oidcSigningKeyBits)
+	require.NoError(t, err)
+
+	encodedKey, err := x509.MarshalPKCS8PrivateKey(privateKey)
+	require.NoError(t, err)
+
+	key := oauthfakes.BuildFakeOIDCSigningKey()
+	key.RetiresAt = retiresAt
+	key.ExpiresAt = retiresAt.Add(time.Hour)
+	key.PrivateKey = string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: encodedKey}))
+
+	return key, privateKey
+}
+
+func buildTestOIDCService(t *testing.T, oauthRepo *oauthmock.RepositoryMock, identityDataManager *identitymanagermock.IdentityDataManager) *service {
+	t.Helper()
+
+	s := buildTestService(t)
+	s.oidcConfig = buildOIDCConfigForTest()
+	s.oidcSigningKeys = newOIDCSigningKeyRing(loggingnoop.NewLogger(), tracingnoop.NewTracerProvider(), s.oidcConfig, oauthRepo)
+	s.oauthRepo = oauthRepo
+	s.identityDataManager = identityDataManager
+
+	return s
+}
+
+func TestOIDCSigningKeyRing_currentSigningKey(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with active key", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		activeKey, _ := buildOIDCSigningKeyForTest(t, time.Now().Add(time.Hour))
+
+		oauthRepo := &oauthmock.RepositoryMock{}
+		oauthRepo.On(reflection.GetMethodName(oauthRepo.GetUnexpiredOIDCSigningKeys), testutils.ContextMatcher).Return([]*oauth.OIDCSigningKey{activeKey}, nil)
+
+		r := newOIDCSigningKeyRing(loggingnoop.NewLogger(), tracingnoop.NewTracerProvider(), buildOIDCConfigForTest(), oauthRepo)
+
+		key, privateKey, err := r.currentSigningKey(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, activeKey, key)
+		assert.NotNil(t, privateKey)
+
+		mock.AssertExpectationsForObjects(t, oauthRepo)
+	})
+
+	T.Run("rotates retired key", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		retiredKey, _ := buildOIDCSigningKeyForTest(t, time.Now().Add(-time.Minute))
+		cfg := buildOIDCConfigForTest()
+
+		oauthRepo := &oauthmock.RepositoryMock{}
+		oauthRepo.On(reflection.GetMethodName(oauthRepo.GetUnexpiredOIDCSigningKeys), testutils.ContextMatcher).Return([]*oauth.OIDCSigningKey{retiredKey}, nil)
+		oauthRepo.On(
+			reflection.GetMethodName(oauthRepo.CreateOIDCSigningKey),
+			testutils.ContextMatcher,
+			mock.MatchedBy(func(input *oauth.OIDCSigningKeyDatabaseCreationInput) bool {
+				return input.Algorithm == oauth.RS256OIDCSigningKeyAlgorithm && input.ExpiresAt.Sub(input.RetiresAt) == cfg.IDTokenLifespan
+			}),
+		).Return(&oauth.OIDCSigningKey{ID: t.Name(), Algorithm: oauth.RS256OIDCSigningKeyAlgorithm}, nil)
+
+		r := newOIDCSigningKeyRing(loggingnoop.NewLogger(), tracingnoop.NewTracerProvider(), cfg, oauthRepo)
+
+		key, privateKey, err := r.currentSigningKey(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, t.Name(), key.ID)
+		assert.NotNil(t, privateKey)
+
+		mock.AssertExpectationsForObjects(t, oauthRepo)
+	})
+}
+
+func TestOIDCSigningKeyRing_publicKeys(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		activeKey, _ := buildOIDCSigningKeyForTest(t, time.Now().Add(time.Hour))
+		retiredKey, _ := buildOIDCSigningKeyForTest(t, time.Now().Add(-time.Minute))
+
+		oauthRepo := &oauthmock.RepositoryMock{}
+		oauthRepo.On(reflection.GetMethodName(oauthRepo.GetUnexpiredOIDCSigningKeys), testutils.ContextMatcher).Return([]*oauth.OIDCSigningKey{activeKey, retiredKey}, nil)
+
+		r := newOIDCSigningKeyRing(loggingnoop.NewLogger(), tracingnoop.NewTracerProvider(), buildOIDCConfigForTest(), oauthRepo)
+
+		keySet, err := r.publicKeys(ctx)
+		require.NoError(t, err)
+		require.Len(t, keySet.Keys, 2)
+		assert.Equal(t, activeKey.ID, keySet.Keys[0].KeyID)
+		assert.Equal(t, retiredKey.ID, keySet.Keys[1].KeyID)
+		assert.Equal(t, "RSA", keySet.Keys[0].KeyType)
+		assert.Equal(t, "AQAB", keySet.Keys[0].Exponent)
+
+		mock.AssertExpectationsForObjects(t, oauthRepo)
+	})
+}
+
+func TestBuildOpenIDUserClaims(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with profile and email scopes", func(t *testing.T) {
+		t.Parallel()
+
+		user := fakes.BuildFakeUser()
+		user.EmailAddressVerifiedAt = nil
+
+		claims := buildOpenIDUserClaims(user, []string{authorization.OpenIDOAuth2Scope, authorization.ProfileOAuth2Scope, authorization.EmailOAuth2Scope})
+
+		assert.Equal(t, user.Username, claims.PreferredUsername)
+		assert.Equal(t, user.FirstName, claims.GivenName)
+		assert.Equal(t, user.EmailAddress, claims.Email)
+		require.NotNil(t, claims.EmailVerified)
+		assert.False(t, *claims.EmailVerified)
+	})
+
+	T.Run("with only openid scope", func(t *testing.T) {
+		t.Parallel()
+
+		claims := buildOpenIDUserClaims(fakes.BuildFakeUser(), []string{authorization.OpenIDOAuth2Scope})
+
+		assert.Equal(t, openIDUserClaims{}, claims)
+	})
+}
+
+func TestAuthenticationService_issueIDToken(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		user := fakes.BuildFakeUser()
+		activeKey, privateKey := buildOIDCSigningKeyForTest(t, time.Now().Add(time.Hour))
+
+		oauthRepo := &oauthmock.RepositoryMock{}
+		oauthRepo.On(reflection.GetMethodName(oauthRepo.GetUnexpiredOIDCSigningKeys), testutils.ContextMatcher).Return([]*oauth.OIDCSigningKey{activeKey}, nil)
+
+		identityDataManager := &identitymanagermock.IdentityDataManager{}
+		identityDataManager.On(reflection.GetMethodName(identityDataManager.GetUser), testutils.ContextMatcher, user.ID).Return(user, nil)
+
+		s := buildTestOIDCService(t, oauthRepo, identityDataManager)
+
+		tokenInfo := models.NewToken()
+		tokenInfo.SetUserID(user.ID)
+		tokenInfo.SetClientID(t.Name())
+		tokenInfo.SetScope("openid email")
+
+		idToken, err := s.issueIDToken(ctx, tokenInfo, "example_nonce")
+		require.NoError(t, err)
+
+		claims := &idTokenClaims{}
+		parsed, err := jwt.ParseWithClaims(idToken, claims, func(token *jwt.Token) (any, error) {
+			assert.Equal(t, activeKey.ID, token.Header["kid"])
+			return &privateKey.PublicKey, nil
+		}, jwt.WithValidMethods([]string{oauth.RS256OIDCSigningKeyAlgorithm}), jwt.WithIssuer(s.oidcConfig.Issuer), jwt.WithAudience(t.Name()))
+		require.NoError(t, err)
+		assert.True(t, parsed.Valid)
+
+		assert.Equal(t, user.ID, claims.Subject)
+		assert.Equal(t, "example_nonce", claims.Nonce)
+		assert.Equal(t, user.EmailAddress, claims.Email)
+		assert.Empty(t, claims.PreferredUsername)
+
+		mock.AssertExpectationsForObjects(t, oauthRepo, identityDataManager)
+	})
+}
+
+func TestAuthenticationService_OpenIDConfigurationHandler(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		s := buildTestOIDCService(t, &oauthmock.RepositoryMock{}, &identitymanagermock.IdentityDataManager{})
+
+		s.OpenIDConfigurationHandler(helper.res, helper.req)
+		assert.Equal(t, http.StatusOK, helper.res.Code)
+
+		var actual openIDConfiguration
+		require.NoError(t, json.NewDecoder(helper.res.Body).Decode(&actual))
+		assert.Equal(t, s.oidcConfig.Issuer, actual.Issuer)
+		assert.Equal(t, s.oidcConfig.Issuer+"/oauth2/jwks", actual.JWKSURI)
+		assert.Contains(t, actual.ScopesSupported, authorization.OpenIDOAuth2Scope)
+	})
+}
+
+func TestAuthenticationService_JWKSHandler(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		activeKey, _ := buildOIDCSigningKeyForTest(t, time.Now().Add(time.Hour))
+
+		oauthRepo := &oauthmock.RepositoryMock{}
+		oauthRepo.On(reflection.GetMethodName(oauthRepo.GetUnexpiredOIDCSigningKeys), testutils.ContextMatcher).Return([]*oauth.OIDCSigningKey{activeKey}, nil)
+
+		s := buildTestOIDCService(t, oauthRepo, &identitymanagermock.IdentityDataManager{})
+
+		s.JWKSHandler(helper.res, helper.req)
+		assert.Equal(t, http.StatusOK, helper.res.Code)
+
+		var actual jsonWebKeySet
+		require.NoError(t, json.NewDecoder(helper.res.Body).Decode(&actual))
+		require.Len(t, actual.Keys, 1)
+		assert.Equal(t, activeKey.ID, actual.Keys[0].KeyID)
+
+		mock.AssertExpectationsForObjects(t, oauthRepo)
+	})
+}
+
+func TestAuthenticationService_UserInfoHandler(T *testing.T) {
+	T.Parallel()
+
+	T.Run("without bearer token", func(t *testing.T) {
+		t.Parallel()
+
+		helper := buildTestHelper(t)
+		s := buildTestOIDCService(t, &oauthmock.RepositoryMock{}, &identitymanagermock.IdentityDataManager{})
+
+		s.UserInfoHandler(helper.res, helper.req)
+		assert.Equal(t, http.StatusUnauthorized, helper.res.Code)
+		assert.NotEmpty(t, helper.res.Header().Get("WWW-Authenticate"))
+	})
+}
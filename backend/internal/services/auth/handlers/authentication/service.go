@@ -33,6 +33,9 @@
 		dataChangesPublisher messagequeue.Publisher
 		oauth2Server         *server.Server
 		oauthRepo            oauth.Repository
+		identityDataManager  identitymanager.IdentityDataManager
+		oidcSigningKeys      *oidcSigningKeyRing
+		oidcConfig           *OIDCConfig
 	}
 )
 
@@ -71,8 +74,11 @@ func ProvideService(
 		totpVerifier:         totpVerifier,
 		tracer:               tracing.NewNamedTracer(tracerProvider, serviceName),
 		dataChangesPublisher: dataChangesPublisher,
-		oauth2Server:         ProvideOAuth2ServerImplementation(logger, tracerProvider, identityDataManager, authenticator, signer, manager),
+		oauth2Server:         ProvideOAuth2ServerImplementation(logger, tracerProvider, identityDataManager, oauthRepo, authenticator, signer, manager),
 		oauthRepo:            oauthRepo,
+		identityDataManager:  identityDataManager,
+		oidcSigningKeys:      newOIDCSigningKeyRing(logger, tracerProvider, &cfg.OIDC, oauthRepo),
+		oidcConfig:           &cfg.OIDC,
 	}
 
 	return svc, nil
@@ -0,0 +1,147 @@
+package grpc
+
+import (
+	"context"
+
+	identitykeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity/keys"
+	identitysvc "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/grpc/generated/services/identity"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/services/identity/grpc/converters"
+
+	errorsgrpc "github.com/primandproper/platform/errors/grpc"
+
+	"google.golang.org/grpc/codes"
+)
+
+func (s *serviceImpl) CreateAccountRole(ctx context.Context, request *identitysvc.CreateAccountRoleRequest) (*identitysvc.CreateAccountRoleResponse, error) {
+	ctx, span := s.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := s.logger.WithSpan(span)
+
+	sessionContextData, err := s.sessionContextDataFetcher(ctx)
+	if err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.Unauthenticated, "failed to get session context data")
+	}
+	logger = logger.WithValue(identitykeys.AccountIDKey, sessionContextData.GetActiveAccountID())
+
+	input := converters.ConvertGRPCAccountRoleCreationRequestInputToAccountRoleCreationRequestInput(request.Input)
+	if err = input.ValidateWithContext(ctx); err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.InvalidArgument, "failed to validate account role creation request")
+	}
+
+	created, err := s.identityDataManager.CreateAccountRole(ctx, sessionContextData.GetActiveAccountID(), input)
+	if err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.Internal, "failed to create account role")
+	}
+
+	x := &identitysvc.CreateAccountRoleResponse{
+		ResponseDetails: s.buildResponseDetails(ctx, span),
+		Created:         converters.ConvertAccountRoleToGRPCAccountRole(created),
+	}
+
+	return x, nil
+}
+
+func (s *serviceImpl) GetAccountRole(ctx context.Context, request *identitysvc.GetAccountRoleRequest) (*identitysvc.GetAccountRoleResponse, error) {
+	ctx, span := s.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := s.logger.WithSpan(span).WithValue(identitykeys.AccountRoleIDKey, request.AccountRoleId)
+
+	sessionContextData, err := s.sessionContextDataFetcher(ctx)
+	if err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.Unauthenticated, "failed to get session context data")
+	}
+	logger = logger.WithValue(identitykeys.AccountIDKey, sessionContextData.GetActiveAccountID())
+
+	role, err := s.identityDataManager.GetAccountRole(ctx, sessionContextData.GetActiveAccountID(), request.AccountRoleId)
+	if err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.Internal, "failed to get account role")
+	}
+
+	x := &identitysvc.GetAccountRoleResponse{
+		ResponseDetails: s.buildResponseDetails(ctx, span),
+		Result:          converters.ConvertAccountRoleToGRPCAccountRole(role),
+	}
+
+	return x, nil
+}
+
+func (s *serviceImpl) GetAccountRoles(ctx context.Context, _ *identitysvc.GetAccountRolesRequest) (*identitysvc.GetAccountRolesResponse, error) {
+	ctx, span := s.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := s.logger.WithSpan(span)
+
+	sessionContextData, err := s.sessionContextDataFetcher(ctx)
+	if err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.Unauthenticated, "failed to get session context data")
+	}
+	logger = logger.WithValue(identitykeys.AccountIDKey, sessionContextData.GetActiveAccountID())
+
+	roles, err := s.identityDataManager.GetAccountRoles(ctx, sessionContextData.GetActiveAccountID())
+	if err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.Internal, "failed to get account roles")
+	}
+
+	x := &identitysvc.GetAccountRolesResponse{
+		ResponseDetails: s.buildResponseDetails(ctx, span),
+	}
+
+	for _, role := range roles {
+		x.Results = append(x.Results, converters.ConvertAccountRoleToGRPCAccountRole(role))
+	}
+
+	return x, nil
+}
+
+func (s *serviceImpl) UpdateAccountRole(ctx context.Context, request *identitysvc.UpdateAccountRoleRequest) (*identitysvc.UpdateAccountRoleResponse, error) {
+	ctx, span := s.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := s.logger.WithSpan(span).WithValue(identitykeys.AccountRoleIDKey, request.AccountRoleId)
+
+	sessionContextData, err := s.sessionContextDataFetcher(ctx)
+	if err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.Unauthenticated, "failed to get session context data")
+	}
+	logger = logger.WithValue(identitykeys.AccountIDKey, sessionContextData.GetActiveAccountID())
+
+	input := converters.ConvertGRPCAccountRoleUpdateRequestInputToAccountRoleUpdateRequestInput(request.Input)
+	if err = input.ValidateWithContext(ctx); err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.InvalidArgument, "failed to validate account role update request")
+	}
+
+	if err = s.identityDataManager.UpdateAccountRole(ctx, sessionContextData.GetActiveAccountID(), request.AccountRoleId, input); err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.Internal, "failed to update account role")
+	}
+
+	x := &identitysvc.UpdateAccountRoleResponse{
+		ResponseDetails: s.buildResponseDetails(ctx, span),
+	}
+
+	return x, nil
+}
+
+func (s *serviceImpl) ArchiveAccountRole(ctx context.Context, request *identitysvc.ArchiveAccountRoleRequest) (*identitysvc.ArchiveAccountRoleResponse, error) {
+	ctx, span := s.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := s.logger.WithSpan(span).WithValue(identitykeys.AccountRoleIDKey, request.AccountRoleId)
+
+	sessionContextData, err := s.sessionContextDataFetcher(ctx)
+	if err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.Unauthenticated, "failed to get session context data")
+	}
+	logger = logger.WithValue(identitykeys.AccountIDKey, sessionContextData.GetActiveAccountID())
+
+	if err = s.identityDataManager.ArchiveAccountRole(ctx, sessionContextData.GetActiveAccountID(), request.AccountRoleId); err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.Internal, "failed to archive account role")
+	}
+
+	x := &identitysvc.ArchiveAccountRoleResponse{
+		ResponseDetails: s.buildResponseDetails(ctx, span),
+	}
+
+	return x, nil
+}
@@ -0,0 +1,397 @@
+package grpc
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/authorization"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity"
+	identityfakes "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity/fakes"
+	identitysvc "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/grpc/generated/services/identity"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/testutils"
+
+	"github.com/primandproper/platform/reflection"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func buildTestAccountRoleCreationRequestInput() *identitysvc.AccountRoleCreationRequestInput {
+	return &identitysvc.AccountRoleCreationRequestInput{
+		Name:        "cook",
+		Description: "can check off tasks and grocery list items",
+		Permissions: []string{
+			string(authorization.UpdateMealPlanTasksPermission),
+			string(authorization.UpdateMealPlanGroceryListItemsPermission),
+		},
+		ParentRoleIds: []string{authorization.AccountMemberRoleID},
+	}
+}
+
+func TestServiceImpl_CreateAccountRole(t *testing.T) {
+	t.Parallel()
+
+	t.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		service, identityDataManager := buildTestService(t)
+
+		exampleAccountRole := identityfakes.BuildFakeAccountRole()
+
+		identityDataManager.On(reflection.GetMethodName(identityDataManager.CreateAccountRole), testutils.ContextMatcher, mock.AnythingOfType("string"), mock.AnythingOfType("*identity.AccountRoleCreationRequestInput")).Return(exampleAccountRole, nil)
+
+		request := &identitysvc.CreateAccountRoleRequest{
+			Input: buildTestAccountRoleCreationRequestInput(),
+		}
+
+		result, err := service.CreateAccountRole(t.Context(), request)
+
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+		assert.NotNil(t, result.ResponseDetails)
+		assert.Equal(t, exampleAccountRole.ID, result.Created.Id)
+	})
+
+	t.Run("with session error", func(t *testing.T) {
+		t.Parallel()
+
+		service := buildTestServiceWithSessionError(t)
+
+		request := &identitysvc.CreateAccountRoleRequest{
+			Input: buildTestAccountRoleCreationRequestInput(),
+		}
+
+		result, err := service.CreateAccountRole(t.Context(), request)
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+
+		grpcErr, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.Unauthenticated, grpcErr.Code())
+	})
+
+	t.Run("with unassignable permission", func(t *testing.T) {
+		t.Parallel()
+
+		service, _ := buildTestService(t)
+
+		input := buildTestAccountRoleCreationRequestInput()
+		input.Permissions = []string{string(authorization.ImpersonateUserPermission)}
+
+		request := &identitysvc.CreateAccountRoleRequest{
+			Input: input,
+		}
+
+		result, err := service.CreateAccountRole(t.Context(), request)
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+
+		grpcErr, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, grpcErr.Code())
+	})
+
+	t.Run("with error from data manager", func(t *testing.T) {
+		t.Parallel()
+
+		service, identityDataManager := buildTestService(t)
+
+		identityDataManager.On(reflection.GetMethodName(identityDataManager.CreateAccountRole), testutils.ContextMatcher, mock.AnythingOfType("string"), mock.AnythingOfType("*identity.AccountRoleCreationRequestInput")).Return((*identity.AccountRole)(nil), errors.New("create error"))
+
+		request := &identitysvc.CreateAccountRoleRequest{
+			Input: buildTestAccountRoleCreationRequestInput(),
+		}
+
+		result, err := service.CreateAccountRole(t.Context(), request)
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+
+		grpcErr, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.Internal, grpcErr.Code())
+	})
+}
+
+func TestServiceImpl_GetAccountRole(t *testing.T) {
+	t.Parallel()
+
+	t.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		service, identityDataManager := buildTestService(t)
+
+		exampleAccountRole := identityfakes.BuildFakeAccountRole()
+
+		identityDataManager.On(reflection.GetMethodName(identityDataManager.GetAccountRole), testutils.ContextMatcher, mock.AnythingOfType("string"), exampleAccountRole.ID).Return(exampleAccountRole, nil)
+
+		request := &identitysvc.GetAccountRoleRequest{
+			AccountRoleId: exampleAccountRole.ID,
+		}
+
+		result, err := service.GetAccountRole(t.Context(), request)
+
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+		assert.NotNil(t, result.ResponseDetails)
+		assert.Equal(t, exampleAccountRole.Permissions, result.Result.Permissions)
+	})
+
+	t.Run("with session error", func(t *testing.T) {
+		t.Parallel()
+
+		service := buildTestServiceWithSessionError(t)
+
+		request := &identitysvc.GetAccountRoleRequest{
+			AccountRoleId: identityfakes.BuildFakeID(),
+		}
+
+		result, err := service.GetAccountRole(t.Context(), request)
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+
+		grpcErr, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.Unauthenticated, grpcErr.Code())
+	})
+
+	t.Run("with error from data manager", func(t *testing.T) {
+		t.Parallel()
+
+		service, identityDataManager := buildTestService(t)
+
+		exampleAccountRoleID := identityfakes.BuildFakeID()
+
+		identityDataManager.On(reflection.GetMethodName(identityDataManager.GetAccountRole), testutils.ContextMatcher, mock.AnythingOfType("string"), exampleAccountRoleID).Return((*identity.AccountRole)(nil), errors.New("get error"))
+
+		request := &identitysvc.GetAccountRoleRequest{
+			AccountRoleId: exampleAccountRoleID,
+		}
+
+		result, err := service.GetAccountRole(t.Context(), request)
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+
+		grpcErr, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.Internal, grpcErr.Code())
+	})
+}
+
+func TestServiceImpl_GetAccountRoles(t *testing.T) {
+	t.Parallel()
+
+	t.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		service, identityDataManager := buildTestService(t)
+
+		exampleAccountRoles := identityfakes.BuildFakeAccountRolesList()
+
+		identityDataManager.On(reflection.GetMethodName(identityDataManager.GetAccountRoles), testutils.ContextMatcher, mock.AnythingOfType("string")).Return(exampleAccountRoles, nil)
+
+		result, err := service.GetAccountRoles(t.Context(), &identitysvc.GetAccountRolesRequest{})
+
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+		assert.NotNil(t, result.ResponseDetails)
+		assert.Len(t, result.Results, len(exampleAccountRoles))
+	})
+
+	t.Run("with session error", func(t *testing.T) {
+		t.Parallel()
+
+		service := buildTestServiceWithSessionError(t)
+
+		result, err := service.GetAccountRoles(t.Context(), &identitysvc.GetAccountRolesRequest{})
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+
+		grpcErr, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.Unauthenticated, grpcErr.Code())
+	})
+
+	t.Run("with error from data manager", func(t *testing.T) {
+		t.Parallel()
+
+		service, identityDataManager := buildTestService(t)
+
+		identityDataManager.On(reflection.GetMethodName(identityDataManager.GetAccountRoles), testutils.ContextMatcher, mock.AnythingOfType("string")).Return([]*identity.AccountRole(nil), errors.New("get error"))
+
+		result, err := service.GetAccountRoles(t.Context(), &identitysvc.GetAccountRolesRequest{})
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+
+		grpcErr, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.Internal, grpcErr.Code())
+	})
+}
+
+func TestServiceImpl_UpdateAccountRole(t *testing.T) {
+	t.Parallel()
+
+	t.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		service, identityDataManager := buildTestService(t)
+
+		exampleAccountRoleID := identityfakes.BuildFakeID()
+
+		identityDataManager.On(reflection.GetMethodName(identityDataManager.UpdateAccountRole), testutils.ContextMatcher, mock.AnythingOfType("string"), exampleAccountRoleID, mock.AnythingOfType("*identity.AccountRoleUpdateRequestInput")).Return(nil)
+
+		request := &identitysvc.UpdateAccountRoleRequest{
+			AccountRoleId: exampleAccountRoleID,
+			Input: &identitysvc.AccountRoleUpdateRequestInput{
+				Permissions: []string{string(authorization.UpdateMealPlanTasksPermission)},
+			},
+		}
+
+		result, err := service.UpdateAccountRole(t.Context(), request)
+
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+		assert.NotNil(t, result.ResponseDetails)
+	})
+
+	t.Run("with session error", func(t *testing.T) {
+		t.Parallel()
+
+		service := buildTestServiceWithSessionError(t)
+
+		request := &identitysvc.UpdateAccountRoleRequest{
+			AccountRoleId: identityfakes.BuildFakeID(),
+			Input:         &identitysvc.AccountRoleUpdateRequestInput{},
+		}
+
+		result, err := service.UpdateAccountRole(t.Context(), request)
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+
+		grpcErr, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.Unauthenticated, grpcErr.Code())
+	})
+
+	t.Run("with reserved name", func(t *testing.T) {
+		t.Parallel()
+
+		service, _ := buildTestService(t)
+
+		request := &identitysvc.UpdateAccountRoleRequest{
+			AccountRoleId: identityfakes.BuildFakeID(),
+			Input: &identitysvc.AccountRoleUpdateRequestInput{
+				Name: new(authorization.AccountAdminRoleName),
+			},
+		}
+
+		result, err := service.UpdateAccountRole(t.Context(), request)
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+
+		grpcErr, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.InvalidArgument, grpcErr.Code())
+	})
+
+	t.Run("with error from data manager", func(t *testing.T) {
+		t.Parallel()
+
+		service, identityDataManager := buildTestService(t)
+
+		exampleAccountRoleID := identityfakes.BuildFakeID()
+
+		identityDataManager.On(reflection.GetMethodName(identityDataManager.UpdateAccountRole), testutils.ContextMatcher, mock.AnythingOfType("string"), exampleAccountRoleID, mock.AnythingOfType("*identity.AccountRoleUpdateRequestInput")).Return(errors.New("update error"))
+
+		request := &identitysvc.UpdateAccountRoleRequest{
+			AccountRoleId: exampleAccountRoleID,
+			Input:         &identitysvc.AccountRoleUpdateRequestInput{},
+		}
+
+		result, err := service.UpdateAccountRole(t.Context(), request)
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+
+		grpcErr, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.Internal, grpcErr.Code())
+	})
+}
+
+func TestServiceImpl_ArchiveAccountRole(t *testing.T) {
+	t.Parallel()
+
+	t.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		service, identityDataManager := buildTestService(t)
+
+		exampleAccountRoleID := identityfakes.BuildFakeID()
+
+		identityDataManager.On(reflection.GetMethodName(identityDataManager.ArchiveAccountRole), testutils.ContextMatcher, mock.AnythingOfType("string"), exampleAccountRoleID).Return(nil)
+
+		request := &identitysvc.ArchiveAccountRoleRequest{
+			AccountRoleId: exampleAccountRoleID,
+		}
+
+		result, err := service.ArchiveAccountRole(t.Context(), request)
+
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+		assert.NotNil(t, result.ResponseDetails)
+	})
+
+	t.Run("with session error", func(t *testing.T) {
+		t.Parallel()
+
+		service := buildTestServiceWithSessionError(t)
+
+		request := &identitysvc.ArchiveAccountRoleRequest{
+			AccountRoleId: identityfakes.BuildFakeID(),
+		}
+
+		result, err := service.ArchiveAccountRole(t.Context(), request)
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+
+		grpcErr, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.Unauthenticated, grpcErr.Code())
+	})
+
+	t.Run("with error from data manager", func(t *testing.T) {
+		t.Parallel()
+
+		service, identityDataManager := buildTestService(t)
+
+		exampleAccountRoleID := identityfakes.BuildFakeID()
+
+		identityDataManager.On(reflection.GetMethodName(identityDataManager.ArchiveAccountRole), testutils.ContextMatcher, mock.AnythingOfType("string"), exampleAccountRoleID).Return(errors.New("archive error"))
+
+		request := &identitysvc.ArchiveAccountRoleRequest{
+			AccountRoleId: exampleAccountRoleID,
+		}
+
+		result, err := service.ArchiveAccountRole(t.Context(), request)
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+
+		grpcErr, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.Internal, grpcErr.Code())
+	})
+}
@@ -2,7 +2,9 @@
 
 import (
 	"context"
+	"errors"
 
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity"
 	identitykeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity/keys"
 	grpcconverters "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/grpc/converters"
 	identitysvc "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/grpc/generated/services/identity"
@@ -236,6 +238,34 @@ func (s *serviceImpl) UpdateAccount(ctx context.Context, request *identitysvc.Up
 	return x, nil
 }
 
+func (s *serviceImpl) RotateAccountWebhookEncryptionKey(ctx context.Context, _ *identitysvc.RotateAccountWebhookEncryptionKeyRequest) (*identitysvc.RotateAccountWebhookEncryptionKeyResponse, error) {
+	ctx, span := s.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := s.logger.WithSpan(span)
+
+	sessionContextData, err := s.sessionContextDataFetcher(ctx)
+	if err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.Unauthenticated, "failed to get session context data")
+	}
+	logger = logger.WithValue(identitykeys.AccountIDKey, sessionContextData.GetActiveAccountID())
+
+	newKey, err := s.identityDataManager.RotateAccountWebhookEncryptionKey(ctx, sessionContextData.GetActiveAccountID())
+	if err != nil {
+		if errors.Is(err, identity.ErrWebhookEncryptionKeyRotationInGracePeriod) {
+			return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.FailedPrecondition, "webhook encryption key was rotated too recently")
+		}
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.Internal, "failed to rotate account webhook encryption key")
+	}
+
+	x := &identitysvc.RotateAccountWebhookEncryptionKeyResponse{
+		ResponseDetails:      s.buildResponseDetails(ctx, span),
+		WebhookEncryptionKey: newKey,
+	}
+
+	return x, nil
+}
+
 func (s *serviceImpl) UpdateAccountMemberPermissions(ctx context.Context, request *identitysvc.UpdateAccountMemberPermissionsRequest) (*identitysvc.UpdateAccountMemberPermissionsResponse, error) {
 	ctx, span := s.tracer.StartSpan(ctx)
 	defer span.End()
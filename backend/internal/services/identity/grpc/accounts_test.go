@@ -83,6 +83,23 @@ func TestServiceImpl_ArchiveAccount(t *testing.T) {
 		assert.True(t, ok)
 		assert.Equal(t, codes.Internal, grpcErr.Code())
 	})
+
+	t.Run("within previous rotation's grace period", func(t *testing.T) {
+		t.Parallel()
+
+		service, identityDataManager := buildTestService(t)
+
+		identityDataManager.On(reflection.GetMethodName(identityDataManager.RotateAccountWebhookEncryptionKey), testutils.ContextMatcher, mock.AnythingOfType("string")).Return("", identity.ErrWebhookEncryptionKeyRotationInGracePeriod)
+
+		result, err := service.RotateAccountWebhookEncryptionKey(t.Context(), &identitysvc.RotateAccountWebhookEncryptionKeyRequest{})
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+
+		grpcErr, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.FailedPrecondition, grpcErr.Code())
+	})
 }
 
 func TestServiceImpl_CreateAccount(t *testing.T) {
@@ -721,3 +738,54 @@ func TestServiceImpl_ArchiveUserMembership(t *testing.T) {
 		assert.Equal(t, codes.Internal, grpcErr.Code())
 	})
 }
+
+func TestServiceImpl_RotateAccountWebhookEncryptionKey(t *testing.T) {
+	t.Parallel()
+
+	t.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		service, identityDataManager := buildTestService(t)
+
+		identityDataManager.On(reflection.GetMethodName(identityDataManager.RotateAccountWebhookEncryptionKey), testutils.ContextMatcher, mock.AnythingOfType("string")).Return("new-key", nil)
+
+		result, err := service.RotateAccountWebhookEncryptionKey(t.Context(), &identitysvc.RotateAccountWebhookEncryptionKeyRequest{})
+
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+		assert.NotNil(t, result.ResponseDetails)
+		assert.Equal(t, "new-key", result.WebhookEncryptionKey)
+	})
+
+	t.Run("with session error", func(t *testing.T) {
+		t.Parallel()
+
+		service := buildTestServiceWithSessionError(t)
+
+		result, err := service.RotateAccountWebhookEncryptionKey(t.Context(), &identitysvc.RotateAccountWebhookEncryptionKeyRequest{})
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+
+		grpcErr, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.Unauthenticated, grpcErr.Code())
+	})
+
+	t.Run("with error from data manager", func(t *testing.T) {
+		t.Parallel()
+
+		service, identityDataManager := buildTestService(t)
+
+		identityDataManager.On(reflection.GetMethodName(identityDataManager.RotateAccountWebhookEncryptionKey), testutils.ContextMatcher, mock.AnythingOfType("string")).Return("", errors.New("rotation error"))
+
+		result, err := service.RotateAccountWebhookEncryptionKey(t.Context(), &identitysvc.RotateAccountWebhookEncryptionKeyRequest{})
+
+		assert.Error(t, err)
+		assert.Nil(t, result)
+
+		grpcErr, ok := status.FromError(err)
+		assert.True(t, ok)
+		assert.Equal(t, codes.Internal, grpcErr.Code())
+	})
+}
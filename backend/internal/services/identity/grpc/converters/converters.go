@@ -366,6 +366,40 @@ func ConvertGRPCModifyUserPermissionsInputToModifyUserPermissionsInput(input *id
 	}
 }
 
+func ConvertAccountRoleToGRPCAccountRole(input *identity.AccountRole) *identitysvc.AccountRole {
+	return &identitysvc.AccountRole{
+		CreatedAt:        grpcconverters.ConvertTimeToPBTimestamp(input.CreatedAt),
+		LastUpdatedAt:    grpcconverters.ConvertTimePointerToPBTimestamp(input.LastUpdatedAt),
+		ArchivedAt:       grpcconverters.ConvertTimePointerToPBTimestamp(input.ArchivedAt),
+		BelongsToAccount: input.BelongsToAccount,
+		Id:               input.ID,
+		Name:             input.Name,
+		Description:      input.Description,
+		Permissions:      input.Permissions,
+		ParentRoleIds:    input.ParentRoleIDs,
+	}
+}
+
+func ConvertGRPCAccountRoleCreationRequestInputToAccountRoleCreationRequestInput(input *identitysvc.AccountRoleCreationRequestInput) *identity.AccountRoleCreationRequestInput {
+	return &identity.AccountRoleCreationRequestInput{
+		Name:          input.Name,
+		Description:   input.Description,
+		Permissions:   input.Permissions,
+		ParentRoleIDs: input.ParentRoleIds,
+	}
+}
+
+// ConvertGRPCAccountRoleUpdateRequestInputToAccountRoleUpdateRequestInput converts a proto update input to a domain one.
+// Repeated fields can't be told apart from empty ones on the wire, so gRPC updates always replace permissions and parents.
+func ConvertGRPCAccountRoleUpdateRequestInputToAccountRoleUpdateRequestInput(input *identitysvc.AccountRoleUpdateRequestInput) *identity.AccountRoleUpdateRequestInput {
+	return &identity.AccountRoleUpdateRequestInput{
+		Name:          input.Name,
+		Description:   input.Description,
+		Permissions:   append([]string{}, input.Permissions...),
+		ParentRoleIDs: append([]string{}, input.ParentRoleIds...),
+	}
+}
+
 // ConvertUserDataCollectionToGRPCDataCollection converts a domain identity UserDataCollection to a proto DataCollection.
 func ConvertUserDataCollectionToGRPCDataCollection(input *identity.UserDataCollection) *identitysvc.DataCollection {
 	result := &identitysvc.DataCollection{
@@ -22,6 +22,9 @@ func ProvideMethodPermissions() IdentityMethodPermissions {
 		identitysvc.IdentityService_UpdateAccount_FullMethodName: {
 			authorization.UpdateAccountPermission,
 		},
+		identitysvc.IdentityService_RotateAccountWebhookEncryptionKey_FullMethodName: {
+			authorization.UpdateAccountPermission,
+		},
 		identitysvc.IdentityService_ArchiveAccount_FullMethodName: {
 			authorization.ArchiveAccountPermission,
 		},
@@ -37,6 +40,21 @@ func ProvideMethodPermissions() IdentityMethodPermissions {
 		identitysvc.IdentityService_UpdateAccountMemberPermissions_FullMethodName: {
 			authorization.ModifyMemberPermissionsForAccountPermission,
 		},
+		identitysvc.IdentityService_CreateAccountRole_FullMethodName: {
+			authorization.CreateAccountRolesPermission,
+		},
+		identitysvc.IdentityService_GetAccountRole_FullMethodName: {
+			authorization.ReadAccountRolesPermission,
+		},
+		identitysvc.IdentityService_GetAccountRoles_FullMethodName: {
+			authorization.ReadAccountRolesPermission,
+		},
+		identitysvc.IdentityService_UpdateAccountRole_FullMethodName: {
+			authorization.UpdateAccountRolesPermission,
+		},
+		identitysvc.IdentityService_ArchiveAccountRole_FullMethodName: {
+			authorization.ArchiveAccountRolesPermission,
+		},
 		identitysvc.IdentityService_ArchiveUserMembership_FullMethodName: {
 			authorization.RemoveMemberAccountPermission,
 		},
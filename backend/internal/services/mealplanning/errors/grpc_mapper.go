@@ -4,6 +4,7 @@
 	"errors"
 
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/recipeimport"
 	mealplanningrepo "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/repositories/postgres/mealplanning"
 
 	"github.com/primandproper/platform/errors/grpc"
@@ -26,8 +27,14 @@ func (mealPlanningGRPCMapper) Map(err error) (code codes.Code, ok bool) {
 		errors.Is(err, mealplanning.ErrDuplicateMealInList),
 		errors.Is(err, mealplanning.ErrDuplicateMealPlanOption):
 		return codes.AlreadyExists, true
-	case errors.Is(err, mealplanningrepo.ErrAlreadyFinalized):
+	case errors.Is(err, mealplanningrepo.ErrAlreadyFinalized),
+		errors.Is(err, mealplanning.ErrMealPlanNotFinalized),
+		errors.Is(err, mealplanning.ErrMealPlanOptionConflictsWithAllergies),
+		errors.Is(err, mealplanning.ErrMealPlanOptionNotChosen):
 		return codes.FailedPrecondition, true
+	case errors.Is(err, recipeimport.ErrNoRecipeFound),
+		errors.Is(err, recipeimport.ErrUnknownFormat):
+		return codes.InvalidArgument, true
 	default:
 		return codes.Unknown, false
 	}
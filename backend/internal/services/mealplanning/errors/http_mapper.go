@@ -26,6 +26,12 @@ func (mealPlanningHTTPMapper) Map(err error) (code httperrors.ErrorCode, msg str
 		return httperrors.ErrValidatingRequestInput, "duplicate entry", true
 	case errors.Is(err, mealplanningrepo.ErrAlreadyFinalized):
 		return httperrors.ErrValidatingRequestInput, "already finalized", true
+	case errors.Is(err, mealplanning.ErrMealPlanNotFinalized):
+		return httperrors.ErrValidatingRequestInput, "not finalized", true
+	case errors.Is(err, mealplanning.ErrMealPlanOptionConflictsWithAllergies):
+		return httperrors.ErrValidatingRequestInput, "conflicts with member allergies", true
+	case errors.Is(err, mealplanning.ErrMealPlanOptionNotChosen):
+		return httperrors.ErrValidatingRequestInput, "not chosen", true
 	default:
 		return "", "", false
 	}
@@ -2,8 +2,10 @@
 
 import (
 	"log"
+	"math"
 
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/icalendar"
 	grpcconverters "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/grpc/converters"
 	mealplanningsvc "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/grpc/generated/services/mealplanning"
 
@@ -75,6 +77,16 @@ func ConvertMealPlanOptionToGRPCMealPlanOption(input *mealplanning.MealPlanOptio
 		votes = append(votes, ConvertMealPlanOptionVoteToGRPCMealPlanOptionVote(vote))
 	}
 
+	var allergenWarnings []*mealplanningsvc.MealPlanOptionAllergenWarning
+	for _, warning := range input.AllergenWarnings {
+		allergenWarnings = append(allergenWarnings, ConvertMealPlanOptionAllergenWarningToGRPCMealPlanOptionAllergenWarning(warning))
+	}
+
+	var recipeRevisions []*mealplanningsvc.MealPlanOptionRecipeRevision
+	for _, revision := range input.RecipeRevisions {
+		recipeRevisions = append(recipeRevisions, ConvertMealPlanOptionRecipeRevisionToGRPCMealPlanOptionRecipeRevision(revision))
+	}
+
 	return &mealplanningsvc.MealPlanOption{
 		CreatedAt:              grpcconverters.ConvertTimeToPBTimestamp(input.CreatedAt),
 		LastUpdatedAt:          grpcconverters.ConvertTimePointerToPBTimestamp(input.LastUpdatedAt),
@@ -89,6 +101,29 @@ func ConvertMealPlanOptionToGRPCMealPlanOption(input *mealplanning.MealPlanOptio
 		MealScale:              input.MealScale,
 		Chosen:                 input.Chosen,
 		TieBroken:              input.TieBroken,
+		AllergenWarnings:       allergenWarnings,
+		CompletedAt:            grpcconverters.ConvertTimePointerToPBTimestamp(input.CompletedAt),
+		RecipeRevisions:        recipeRevisions,
+	}
+}
+
+func ConvertMealPlanOptionRecipeRevisionToGRPCMealPlanOptionRecipeRevision(input *mealplanning.MealPlanOptionRecipeRevision) *mealplanningsvc.MealPlanOptionRecipeRevision {
+	return &mealplanningsvc.MealPlanOptionRecipeRevision{
+		RecipeId:         input.RecipeID,
+		RecipeRevisionId: input.RecipeRevisionID,
+		RevisionNumber:   input.RevisionNumber,
+	}
+}
+
+func ConvertMealPlanOptionAllergenWarningToGRPCMealPlanOptionAllergenWarning(input *mealplanning.MealPlanOptionAllergenWarning) *mealplanningsvc.MealPlanOptionAllergenWarning {
+	return &mealplanningsvc.MealPlanOptionAllergenWarning{
+		UserId:            input.UserID,
+		RecipeId:          input.RecipeID,
+		RecipeStepId:      input.RecipeStepID,
+		ValidIngredientId: input.ValidIngredientID,
+		IngredientName:    input.IngredientName,
+		Reason:            input.Reason,
+		Allergens:         input.Allergens,
 	}
 }
 
@@ -102,6 +137,7 @@ func ConvertMealPlanOptionVoteToGRPCMealPlanOptionVote(input *mealplanning.MealP
 		BelongsToMealPlanOption: input.BelongsToMealPlanOption,
 		ByUser:                  input.ByUser,
 		Rank:                    uint32(input.Rank),
+		Score:                   uint32(input.Score),
 		Abstain:                 input.Abstain,
 	}
 }
@@ -320,6 +356,217 @@ func ConvertMealPlanEventToGRPCMealPlanEvent(input *mealplanning.MealPlanEvent)
 	}
 }
 
+func ConvertMealPlanActivityToGRPCMealPlanActivity(input *mealplanning.MealPlanActivity) *mealplanningsvc.MealPlanActivity {
+	return &mealplanningsvc.MealPlanActivity{
+		CreatedAt:            grpcconverters.ConvertTimeToPBTimestamp(input.CreatedAt),
+		Id:                   input.ID,
+		EventType:            input.EventType,
+		MealPlanEventId:      input.MealPlanEventID,
+		MealPlanOptionId:     input.MealPlanOptionID,
+		MealPlanOptionVoteId: input.MealPlanOptionVoteID,
+		MealPlanTaskId:       input.MealPlanTaskID,
+		ByUser:               input.ByUser,
+		BelongsToMealPlan:    input.BelongsToMealPlan,
+	}
+}
+
+func ConvertMealPlanCalendarFeedToGRPCMealPlanCalendarFeed(input *mealplanning.MealPlanCalendarFeed) *mealplanningsvc.MealPlanCalendarFeed {
+	return &mealplanningsvc.MealPlanCalendarFeed{
+		CreatedAt:        grpcconverters.ConvertTimeToPBTimestamp(input.CreatedAt),
+		Id:               input.ID,
+		Token:            input.Token,
+		SubscriptionPath: icalendar.FeedPath(input.Token),
+	}
+}
+
+// clampToUint8 narrows a protobuf uint32 to the uint8 the domain uses, saturating rather than wrapping.
+func clampToUint8(x uint32) uint8 {
+	return uint8(min(x, math.MaxUint8))
+}
+
+func ConvertMealPlanTemplateSlotToGRPCMealPlanTemplateSlot(input *mealplanning.MealPlanTemplateSlot) *mealplanningsvc.MealPlanTemplateSlot {
+	return &mealplanningsvc.MealPlanTemplateSlot{
+		MealListId:        input.MealListID,
+		RecipeListId:      input.RecipeListID,
+		MealName:          ConvertStringToMealPlanEventName(input.MealName),
+		StartTime:         input.StartTime,
+		Notes:             input.Notes,
+		MealIds:           input.MealIDs,
+		DurationInSeconds: input.DurationInSeconds,
+		DayOfWeek:         uint32(input.DayOfWeek),
+	}
+}
+
+func ConvertGRPCMealPlanTemplateSlotToMealPlanTemplateSlot(input *mealplanningsvc.MealPlanTemplateSlot) *mealplanning.MealPlanTemplateSlot {
+	return &mealplanning.MealPlanTemplateSlot{
+		MealListID:        input.MealListId,
+		RecipeListID:      input.RecipeListId,
+		MealName:          ConvertMealPlanEventNameToString(input.MealName),
+		StartTime:         input.StartTime,
+		Notes:             input.Notes,
+		MealIDs:           input.MealIds,
+		DurationInSeconds: input.DurationInSeconds,
+		DayOfWeek:         clampToUint8(input.DayOfWeek),
+	}
+}
+
+func ConvertMealPlanTemplateToGRPCMealPlanTemplate(input *mealplanning.MealPlanTemplate) *mealplanningsvc.MealPlanTemplate {
+	var slots []*mealplanningsvc.MealPlanTemplateSlot
+	for _, slot := range input.Slots {
+		slots = append(slots, ConvertMealPlanTemplateSlotToGRPCMealPlanTemplateSlot(slot))
+	}
+
+	return &mealplanningsvc.MealPlanTemplate{
+		CreatedAt:                     grpcconverters.ConvertTimeToPBTimestamp(input.CreatedAt),
+		NextInstantiationAt:           grpcconverters.ConvertTimeToPBTimestamp(input.NextInstantiationAt),
+		LastInstantiatedAt:            grpcconverters.ConvertTimePointerToPBTimestamp(input.LastInstantiatedAt),
+		LastUpdatedAt:                 grpcconverters.ConvertTimePointerToPBTimestamp(input.LastUpdatedAt),
+		ArchivedAt:                    grpcconverters.ConvertTimePointerToPBTimestamp(input.ArchivedAt),
+		Id:                            input.ID,
+		Name:                          input.Name,
+		Notes:                         input.Notes,
+		ElectionMethod:                ConvertStringToMealPlanElectionMethod(input.ElectionMethod),
+		RecurrenceRule:                input.RecurrenceRule,
+		Timezone:                      input.Timezone,
+		BelongsToAccount:              input.BelongsToAccount,
+		CreatedByUser:                 input.CreatedByUser,
+		Slots:                         slots,
+		VotingDeadlineOffsetInSeconds: input.VotingDeadlineOffsetInSeconds,
+		CandidatesPerSlot:             uint32(input.CandidatesPerSlot),
+		AvoidMealsFromLastPlans:       uint32(input.AvoidMealsFromLastPlans),
+	}
+}
+
+func ConvertGRPCMealPlanTemplateCreationRequestInputToMealPlanTemplateCreationRequestInput(input *mealplanningsvc.MealPlanTemplateCreationRequestInput) *mealplanning.MealPlanTemplateCreationRequestInput {
+	var slots []*mealplanning.MealPlanTemplateSlot
+	for _, slot := range input.Slots {
+		slots = append(slots, ConvertGRPCMealPlanTemplateSlotToMealPlanTemplateSlot(slot))
+	}
+
+	return &mealplanning.MealPlanTemplateCreationRequestInput{
+		Name:                          input.Name,
+		Notes:                         input.Notes,
+		ElectionMethod:                ConvertMealPlanElectionMethodToString(input.ElectionMethod),
+		RecurrenceRule:                input.RecurrenceRule,
+		Timezone:                      input.Timezone,
+		Slots:                         slots,
+		VotingDeadlineOffsetInSeconds: input.VotingDeadlineOffsetInSeconds,
+		CandidatesPerSlot:             clampToUint8(input.CandidatesPerSlot),
+		AvoidMealsFromLastPlans:       clampToUint8(input.AvoidMealsFromLastPlans),
+	}
+}
+
+func ConvertMealPlanTemplateCreationRequestInputToGRPCMealPlanTemplateCreationRequestInput(input *mealplanning.MealPlanTemplateCreationRequestInput) *mealplanningsvc.MealPlanTemplateCreationRequestInput {
+	var slots []*mealplanningsvc.MealPlanTemplateSlot
+	for _, slot := range input.Slots {
+		slots = append(slots, ConvertMealPlanTemplateSlotToGRPCMealPlanTemplateSlot(slot))
+	}
+
+	return &mealplanningsvc.MealPlanTemplateCreationRequestInput{
+		Name:                          input.Name,
+		Notes:                         input.Notes,
+		ElectionMethod:                ConvertStringToMealPlanElectionMethod(input.ElectionMethod),
+		RecurrenceRule:                input.RecurrenceRule,
+		Timezone:                      input.Timezone,
+		Slots:                         slots,
+		VotingDeadlineOffsetInSeconds: input.VotingDeadlineOffsetInSeconds,
+		CandidatesPerSlot:             uint32(input.CandidatesPerSlot),
+		AvoidMealsFromLastPlans:       uint32(input.AvoidMealsFromLastPlans),
+	}
+}
+
+func ConvertGRPCMealPlanTemplateUpdateRequestInputToMealPlanTemplateUpdateRequestInput(input *mealplanningsvc.MealPlanTemplateUpdateRequestInput) *mealplanning.MealPlanTemplateUpdateRequestInput {
+	var electionMethod *string
+	if input.ElectionMethod != nil {
+		electionMethod = new(ConvertMealPlanElectionMethodToString(*input.ElectionMethod))
+	}
+
+	var candidatesPerSlot *uint8
+	if input.CandidatesPerSlot != nil {
+		candidatesPerSlot = new(clampToUint8(*input.CandidatesPerSlot))
+	}
+
+	var avoidMealsFromLastPlans *uint8
+	if input.AvoidMealsFromLastPlans != nil {
+		avoidMealsFromLastPlans = new(clampToUint8(*input.AvoidMealsFromLastPlans))
+	}
+
+	// protobuf can't tell an empty list from an absent one, so no slots means the slots are left alone.
+	var slots []*mealplanning.MealPlanTemplateSlot
+	for _, slot := range input.Slots {
+		slots = append(slots, ConvertGRPCMealPlanTemplateSlotToMealPlanTemplateSlot(slot))
+	}
+
+	return &mealplanning.MealPlanTemplateUpdateRequestInput{
+		Name:                          input.Name,
+		Notes:                         input.Notes,
+		ElectionMethod:                electionMethod,
+		RecurrenceRule:                input.RecurrenceRule,
+		Timezone:                      input.Timezone,
+		VotingDeadlineOffsetInSeconds: input.VotingDeadlineOffsetInSeconds,
+		CandidatesPerSlot:             candidatesPerSlot,
+		AvoidMealsFromLastPlans:       avoidMealsFromLastPlans,
+		Slots:                         slots,
+	}
+}
+
+func ConvertMealPlanEventTallyReportToGRPCMealPlanEventTallyReport(input *mealplanning.MealPlanEventTallyReport) *mealplanningsvc.MealPlanEventTallyReport {
+	var tieBreak *mealplanningsvc.ElectionTieBreak
+	if input.TieBreak != nil {
+		tieBreak = &mealplanningsvc.ElectionTieBreak{
+			Strategy:       input.TieBreak.Strategy,
+			Chosen:         input.TieBreak.Chosen,
+			TiedCandidates: input.TieBreak.TiedCandidates,
+		}
+	}
+
+	var pairwisePreferences []*mealplanningsvc.ElectionPairwisePreference
+	for _, preference := range input.PairwisePreferences {
+		pairwisePreferences = append(pairwisePreferences, &mealplanningsvc.ElectionPairwisePreference{
+			Candidate: preference.Candidate,
+			Opponent:  preference.Opponent,
+			Strength:  int64(preference.Strength),
+		})
+	}
+
+	var rounds []*mealplanningsvc.ElectionRound
+	for _, round := range input.Rounds {
+		rounds = append(rounds, &mealplanningsvc.ElectionRound{
+			Counts:     convertElectionCandidateScoresToGRPCElectionCandidateScores(round.Counts),
+			Eliminated: round.Eliminated,
+			Number:     uint32(round.Number),
+		})
+	}
+
+	return &mealplanningsvc.MealPlanEventTallyReport{
+		CreatedAt:              grpcconverters.ConvertTimeToPBTimestamp(input.CreatedAt),
+		ArchivedAt:             grpcconverters.ConvertTimePointerToPBTimestamp(input.ArchivedAt),
+		TieBreak:               tieBreak,
+		Id:                     input.ID,
+		BelongsToMealPlanEvent: input.BelongsToMealPlanEvent,
+		ElectionMethod:         ConvertStringToMealPlanElectionMethod(input.ElectionMethod),
+		Winner:                 input.Winner,
+		Candidates:             input.Candidates,
+		Scores:                 convertElectionCandidateScoresToGRPCElectionCandidateScores(input.Scores),
+		PairwisePreferences:    pairwisePreferences,
+		Rounds:                 rounds,
+		BallotCount:            uint32(input.BallotCount),
+		TieBroken:              input.TieBroken,
+	}
+}
+
+func convertElectionCandidateScoresToGRPCElectionCandidateScores(input []*mealplanning.ElectionCandidateScore) []*mealplanningsvc.ElectionCandidateScore {
+	var scores []*mealplanningsvc.ElectionCandidateScore
+	for _, score := range input {
+		scores = append(scores, &mealplanningsvc.ElectionCandidateScore{
+			Candidate: score.Candidate,
+			Score:     score.Score,
+		})
+	}
+
+	return scores
+}
+
 func ConvertStringToMealPlanStatus(s string) mealplanningsvc.MealPlanStatus {
 	switch s {
 	case string(mealplanning.MealPlanStatusAwaitingVotes):
@@ -416,6 +663,16 @@ func ConvertGRPCMealPlanOptionToMealPlanOption(input *mealplanningsvc.MealPlanOp
 		votes = append(votes, ConvertGRPCMealPlanOptionVoteToMealPlanOptionVote(vote))
 	}
 
+	var allergenWarnings []*mealplanning.MealPlanOptionAllergenWarning
+	for _, warning := range input.AllergenWarnings {
+		allergenWarnings = append(allergenWarnings, ConvertGRPCMealPlanOptionAllergenWarningToMealPlanOptionAllergenWarning(warning))
+	}
+
+	var recipeRevisions []*mealplanning.MealPlanOptionRecipeRevision
+	for _, revision := range input.RecipeRevisions {
+		recipeRevisions = append(recipeRevisions, ConvertGRPCMealPlanOptionRecipeRevisionToMealPlanOptionRecipeRevision(revision))
+	}
+
 	return &mealplanning.MealPlanOption{
 		CreatedAt:              grpcconverters.ConvertPBTimestampToTime(input.CreatedAt),
 		LastUpdatedAt:          grpcconverters.ConvertPBTimestampToTimePointer(input.LastUpdatedAt),
@@ -430,6 +687,29 @@ func ConvertGRPCMealPlanOptionToMealPlanOption(input *mealplanningsvc.MealPlanOp
 		MealScale:              input.MealScale,
 		Chosen:                 input.Chosen,
 		TieBroken:              input.TieBroken,
+		AllergenWarnings:       allergenWarnings,
+		CompletedAt:            grpcconverters.ConvertPBTimestampToTimePointer(input.CompletedAt),
+		RecipeRevisions:        recipeRevisions,
+	}
+}
+
+func ConvertGRPCMealPlanOptionRecipeRevisionToMealPlanOptionRecipeRevision(input *mealplanningsvc.MealPlanOptionRecipeRevision) *mealplanning.MealPlanOptionRecipeRevision {
+	return &mealplanning.MealPlanOptionRecipeRevision{
+		RecipeID:         input.RecipeId,
+		RecipeRevisionID: input.RecipeRevisionId,
+		RevisionNumber:   input.RevisionNumber,
+	}
+}
+
+func ConvertGRPCMealPlanOptionAllergenWarningToMealPlanOptionAllergenWarning(input *mealplanningsvc.MealPlanOptionAllergenWarning) *mealplanning.MealPlanOptionAllergenWarning {
+	return &mealplanning.MealPlanOptionAllergenWarning{
+		UserID:            input.UserId,
+		RecipeID:          input.RecipeId,
+		RecipeStepID:      input.RecipeStepId,
+		ValidIngredientID: input.ValidIngredientId,
+		IngredientName:    input.IngredientName,
+		Reason:            input.Reason,
+		Allergens:         input.Allergens,
 	}
 }
 
@@ -443,6 +723,7 @@ func ConvertGRPCMealPlanOptionVoteToMealPlanOptionVote(input *mealplanningsvc.Me
 		BelongsToMealPlanOption: input.BelongsToMealPlanOption,
 		ByUser:                  input.ByUser,
 		Rank:                    uint8(input.Rank),
+		Score:                   uint8(input.Score),
 		Abstain:                 input.Abstain,
 	}
 }
@@ -581,6 +862,12 @@ func ConvertStringToMealPlanElectionMethod(s string) mealplanningsvc.MealPlanEle
 		return mealplanningsvc.MealPlanElectionMethod_MEAL_PLAN_ELECTION_METHOD_SCHULZE
 	case mealplanning.MealPlanElectionMethodInstantRunoff:
 		return mealplanningsvc.MealPlanElectionMethod_MEAL_PLAN_ELECTION_METHOD_INSTANT_RUNOFF
+	case mealplanning.MealPlanElectionMethodBorda:
+		return mealplanningsvc.MealPlanElectionMethod_MEAL_PLAN_ELECTION_METHOD_BORDA
+	case mealplanning.MealPlanElectionMethodApproval:
+		return mealplanningsvc.MealPlanElectionMethod_MEAL_PLAN_ELECTION_METHOD_APPROVAL
+	case mealplanning.MealPlanElectionMethodScore:
+		return mealplanningsvc.MealPlanElectionMethod_MEAL_PLAN_ELECTION_METHOD_SCORE
 	default:
 		log.Printf("UNKNOWN MEAL COMPONENT TYPE: %q", s)
 		return mealplanningsvc.MealPlanElectionMethod_MEAL_PLAN_ELECTION_METHOD_SCHULZE
@@ -593,6 +880,12 @@ func ConvertMealPlanElectionMethodToString(s mealplanningsvc.MealPlanElectionMet
 		return mealplanning.MealPlanElectionMethodSchulze
 	case mealplanningsvc.MealPlanElectionMethod_MEAL_PLAN_ELECTION_METHOD_INSTANT_RUNOFF:
 		return mealplanning.MealPlanElectionMethodInstantRunoff
+	case mealplanningsvc.MealPlanElectionMethod_MEAL_PLAN_ELECTION_METHOD_BORDA:
+		return mealplanning.MealPlanElectionMethodBorda
+	case mealplanningsvc.MealPlanElectionMethod_MEAL_PLAN_ELECTION_METHOD_APPROVAL:
+		return mealplanning.MealPlanElectionMethodApproval
+	case mealplanningsvc.MealPlanElectionMethod_MEAL_PLAN_ELECTION_METHOD_SCORE:
+		return mealplanning.MealPlanElectionMethodScore
 	default:
 		log.Printf("UNKNOWN MEAL COMPONENT TYPE: %q", s)
 		return mealplanning.MealPlanElectionMethodSchulze
@@ -714,6 +1007,7 @@ func ConvertGRPCMealPlanOptionVoteCreationInputToMealPlanOptionVoteCreationInput
 		ByUser:                  input.ByUser,
 		BelongsToMealPlanOption: input.BelongsToMealPlanOption,
 		Rank:                    uint8(input.Rank),
+		Score:                   uint8(input.Score),
 		Abstain:                 input.Abstain,
 	}
 }
@@ -875,6 +1169,7 @@ func ConvertMealPlanOptionVoteUpdateRequestInputToGRPCMealPlanOptionVoteUpdateRe
 	return &mealplanningsvc.MealPlanOptionVoteUpdateRequestInput{
 		Notes:                   input.Notes,
 		Rank:                    grpcconverters.ConvertUint8PointerToUint32Pointer(input.Rank),
+		Score:                   grpcconverters.ConvertUint8PointerToUint32Pointer(input.Score),
 		Abstain:                 input.Abstain,
 		BelongsToMealPlanOption: input.BelongsToMealPlanOption,
 	}
@@ -884,6 +1179,7 @@ func ConvertGRPCMealPlanOptionVoteUpdateRequestInputToMealPlanOptionVoteUpdateRe
 	return &mealplanning.MealPlanOptionVoteUpdateRequestInput{
 		Notes:                   input.Notes,
 		Rank:                    grpcconverters.ConvertUint32PointerToUint8Pointer(input.Rank),
+		Score:                   grpcconverters.ConvertUint32PointerToUint8Pointer(input.Score),
 		Abstain:                 input.Abstain,
 		BelongsToMealPlanOption: input.BelongsToMealPlanOption,
 	}
@@ -967,6 +1263,108 @@ func ConvertGRPCAccountInstrumentOwnershipUpdateRequestInputToAccountInstrumentO
 	}
 }
 
+func ConvertStringToPantryStorageLocation(s string) mealplanningsvc.PantryStorageLocation {
+	switch s {
+	case mealplanning.PantryStorageLocationPantry:
+		return mealplanningsvc.PantryStorageLocation_PANTRY_STORAGE_LOCATION_PANTRY
+	case mealplanning.PantryStorageLocationRefrigerator:
+		return mealplanningsvc.PantryStorageLocation_PANTRY_STORAGE_LOCATION_REFRIGERATOR
+	case mealplanning.PantryStorageLocationFreezer:
+		return mealplanningsvc.PantryStorageLocation_PANTRY_STORAGE_LOCATION_FREEZER
+	case mealplanning.PantryStorageLocationOther:
+		return mealplanningsvc.PantryStorageLocation_PANTRY_STORAGE_LOCATION_OTHER
+	default:
+		log.Printf("UNKNOWN PANTRY STORAGE LOCATION: %s", s)
+		return mealplanningsvc.PantryStorageLocation_PANTRY_STORAGE_LOCATION_PANTRY
+	}
+}
+
+func ConvertPantryStorageLocationToString(s mealplanningsvc.PantryStorageLocation) string {
+	switch s {
+	case mealplanningsvc.PantryStorageLocation_PANTRY_STORAGE_LOCATION_PANTRY:
+		return mealplanning.PantryStorageLocationPantry
+	case mealplanningsvc.PantryStorageLocation_PANTRY_STORAGE_LOCATION_REFRIGERATOR:
+		return mealplanning.PantryStorageLocationRefrigerator
+	case mealplanningsvc.PantryStorageLocation_PANTRY_STORAGE_LOCATION_FREEZER:
+		return mealplanning.PantryStorageLocationFreezer
+	case mealplanningsvc.PantryStorageLocation_PANTRY_STORAGE_LOCATION_OTHER:
+		return mealplanning.PantryStorageLocationOther
+	default:
+		log.Printf("UNKNOWN PANTRY STORAGE LOCATION: %s", s)
+		return mealplanning.PantryStorageLocationPantry
+	}
+}
+
+func ConvertPantryItemCreationRequestInputToGRPCPantryItemCreationRequestInput(input *mealplanning.PantryItemCreationRequestInput) *mealplanningsvc.PantryItemCreationRequestInput {
+	return &mealplanningsvc.PantryItemCreationRequestInput{
+		ExpiresAt:              grpcconverters.ConvertTimePointerToPBTimestamp(input.ExpiresAt),
+		Notes:                  input.Notes,
+		StorageLocation:        ConvertStringToPantryStorageLocation(input.StorageLocation),
+		ValidIngredientId:      input.ValidIngredientID,
+		ValidMeasurementUnitId: input.ValidMeasurementUnitID,
+		Quantity:               input.Quantity,
+	}
+}
+
+func ConvertGRPCPantryItemCreationRequestInputToPantryItemCreationRequestInput(input *mealplanningsvc.PantryItemCreationRequestInput) *mealplanning.PantryItemCreationRequestInput {
+	return &mealplanning.PantryItemCreationRequestInput{
+		ExpiresAt:              grpcconverters.ConvertPBTimestampToTimePointer(input.ExpiresAt),
+		Notes:                  input.Notes,
+		StorageLocation:        ConvertPantryStorageLocationToString(input.StorageLocation),
+		ValidIngredientID:      input.ValidIngredientId,
+		ValidMeasurementUnitID: input.ValidMeasurementUnitId,
+		Quantity:               input.Quantity,
+	}
+}
+
+func ConvertPantryItemToGRPCPantryItem(input *mealplanning.PantryItem) *mealplanningsvc.PantryItem {
+	return &mealplanningsvc.PantryItem{
+		CreatedAt:        grpcconverters.ConvertTimeToPBTimestamp(input.CreatedAt),
+		ArchivedAt:       grpcconverters.ConvertTimePointerToPBTimestamp(input.ArchivedAt),
+		LastUpdatedAt:    grpcconverters.ConvertTimePointerToPBTimestamp(input.LastUpdatedAt),
+		ExpiresAt:        grpcconverters.ConvertTimePointerToPBTimestamp(input.ExpiresAt),
+		Id:               input.ID,
+		Notes:            input.Notes,
+		StorageLocation:  ConvertStringToPantryStorageLocation(input.StorageLocation),
+		BelongsToAccount: input.BelongsToAccount,
+		Ingredient:       ConvertValidIngredientToGRPCValidIngredient(&input.Ingredient),
+		MeasurementUnit:  ConvertValidMeasurementUnitToGRPCValidMeasurementUnit(&input.MeasurementUnit),
+		Quantity:         input.Quantity,
+	}
+}
+
+func ConvertGRPCPantryItemToPantryItem(input *mealplanningsvc.PantryItem) *mealplanning.PantryItem {
+	return &mealplanning.PantryItem{
+		CreatedAt:        grpcconverters.ConvertPBTimestampToTime(input.CreatedAt),
+		ArchivedAt:       grpcconverters.ConvertPBTimestampToTimePointer(input.ArchivedAt),
+		LastUpdatedAt:    grpcconverters.ConvertPBTimestampToTimePointer(input.LastUpdatedAt),
+		ExpiresAt:        grpcconverters.ConvertPBTimestampToTimePointer(input.ExpiresAt),
+		ID:               input.Id,
+		Notes:            input.Notes,
+		StorageLocation:  ConvertPantryStorageLocationToString(input.StorageLocation),
+		BelongsToAccount: input.BelongsToAccount,
+		Ingredient:       *ConvertGRPCValidIngredientToValidIngredient(input.Ingredient),
+		MeasurementUnit:  *ConvertGRPCValidMeasurementUnitToValidMeasurementUnit(input.MeasurementUnit),
+		Quantity:         input.Quantity,
+	}
+}
+
+func ConvertGRPCPantryItemUpdateRequestInputToPantryItemUpdateRequestInput(input *mealplanningsvc.PantryItemUpdateRequestInput) *mealplanning.PantryItemUpdateRequestInput {
+	var storageLocation *string
+	if input.StorageLocation != nil {
+		storageLocation = new(ConvertPantryStorageLocationToString(*input.StorageLocation))
+	}
+
+	return &mealplanning.PantryItemUpdateRequestInput{
+		ExpiresAt:              grpcconverters.ConvertPBTimestampToTimePointer(input.ExpiresAt),
+		Notes:                  input.Notes,
+		StorageLocation:        storageLocation,
+		ValidIngredientID:      input.ValidIngredientId,
+		ValidMeasurementUnitID: input.ValidMeasurementUnitId,
+		Quantity:               input.Quantity,
+	}
+}
+
 func ConvertMealPlanOptionVoteCreationRequestInputToGRPCMealPlanOptionVoteCreationRequestInput(input *mealplanning.MealPlanOptionVoteCreationRequestInput) *mealplanningsvc.MealPlanOptionVoteCreationRequestInput {
 	var votes []*mealplanningsvc.MealPlanOptionVoteCreationInput
 	for _, vote := range input.Votes {
@@ -983,6 +1381,7 @@ func ConvertMealPlanOptionVoteCreationInputToGRPCMealPlanOptionVoteCreationInput
 		ByUser:                  input.ByUser,
 		BelongsToMealPlanOption: input.BelongsToMealPlanOption,
 		Rank:                    uint32(input.Rank),
+		Score:                   uint32(input.Score),
 		Abstain:                 input.Abstain,
 	}
 }
@@ -1186,3 +1585,120 @@ func ConvertGRPCMealPlanRecipeOptionSelectionUpdateRequestInputToMealPlanRecipeO
 		SelectedOptionIndex: &selectedOptionIndex,
 	}
 }
+
+func ConvertCookTimelineStepToGRPCCookTimelineStep(input *mealplanning.CookTimelineStep) *mealplanningsvc.CookTimelineStep {
+	return &mealplanningsvc.CookTimelineStep{
+		StartsAt:             grpcconverters.ConvertTimeToPBTimestamp(input.StartsAt),
+		EndsAt:               grpcconverters.ConvertTimeToPBTimestamp(input.EndsAt),
+		RecipeStepId:         input.RecipeStepID,
+		RecipeId:             input.RecipeID,
+		RecipeName:           input.RecipeName,
+		Label:                input.Label,
+		StartOffsetInSeconds: input.StartOffsetInSeconds,
+		EndOffsetInSeconds:   input.EndOffsetInSeconds,
+		SlackInSeconds:       input.SlackInSeconds,
+		StepIndex:            input.StepIndex,
+		Cook:                 input.Cook,
+		Critical:             input.Critical,
+	}
+}
+
+func ConvertCookTimelineToGRPCCookTimeline(input *mealplanning.CookTimeline) *mealplanningsvc.CookTimeline {
+	var steps []*mealplanningsvc.CookTimelineStep
+	for _, step := range input.Steps {
+		steps = append(steps, ConvertCookTimelineStepToGRPCCookTimelineStep(step))
+	}
+
+	return &mealplanningsvc.CookTimeline{
+		StartsAt:               grpcconverters.ConvertTimeToPBTimestamp(input.StartsAt),
+		ServingTime:            grpcconverters.ConvertTimeToPBTimestamp(input.ServingTime),
+		MealId:                 input.MealID,
+		MealPlanOptionId:       input.MealPlanOptionID,
+		MermaidGanttChart:      input.MermaidGanttChart,
+		CriticalPath:           input.CriticalPath,
+		Steps:                  steps,
+		TotalDurationInSeconds: input.TotalDurationInSeconds,
+		CriticalPathInSeconds:  input.CriticalPathInSeconds,
+		CookCount:              input.CookCount,
+	}
+}
+
+func ConvertNutritionMissingIngredientToGRPCNutritionMissingIngredient(input *mealplanning.NutritionMissingIngredient) *mealplanningsvc.NutritionMissingIngredient {
+	return &mealplanningsvc.NutritionMissingIngredient{
+		RecipeId:               input.RecipeID,
+		RecipeStepId:           input.RecipeStepID,
+		RecipeStepIngredientId: input.RecipeStepIngredientID,
+		ValidIngredientId:      input.ValidIngredientID,
+		IngredientName:         input.IngredientName,
+		MeasurementUnitId:      input.MeasurementUnitID,
+		Reason:                 input.Reason,
+	}
+}
+
+func ConvertNutritionRollupToGRPCNutritionRollup(input *mealplanning.NutritionRollup) *mealplanningsvc.NutritionRollup {
+	output := &mealplanningsvc.NutritionRollup{
+		Totals:            ConvertNutrientsToGRPCNutrients(&input.Totals),
+		EstimatedPortions: input.EstimatedPortions,
+		Scale:             input.Scale,
+		Complete:          input.Complete,
+	}
+
+	if input.PerPortion != nil {
+		output.PerPortion = ConvertNutrientsToGRPCNutrients(input.PerPortion)
+	}
+
+	for _, missing := range input.MissingIngredients {
+		output.MissingIngredients = append(output.MissingIngredients, ConvertNutritionMissingIngredientToGRPCNutritionMissingIngredient(missing))
+	}
+
+	return output
+}
+
+func ConvertMealPlanNutritionRollupToGRPCMealPlanNutritionRollup(input *mealplanning.MealPlanNutritionRollup) *mealplanningsvc.MealPlanNutritionRollup {
+	output := &mealplanningsvc.MealPlanNutritionRollup{
+		MealPlanId: input.MealPlanID,
+		Totals:     ConvertNutrientsToGRPCNutrients(&input.Totals),
+		Complete:   input.Complete,
+	}
+
+	for _, event := range input.Events {
+		output.Events = append(output.Events, &mealplanningsvc.MealPlanEventNutritionRollup{
+			StartsAt:         grpcconverters.ConvertTimeToPBTimestamp(event.StartsAt),
+			MealPlanEventId:  event.MealPlanEventID,
+			MealPlanOptionId: event.MealPlanOptionID,
+			MealId:           event.MealID,
+			MealName:         event.MealName,
+			Nutrition:        ConvertNutritionRollupToGRPCNutritionRollup(&event.Nutrition),
+		})
+	}
+
+	for _, missing := range input.MissingIngredients {
+		output.MissingIngredients = append(output.MissingIngredients, ConvertNutritionMissingIngredientToGRPCNutritionMissingIngredient(missing))
+	}
+
+	return output
+}
+
+func ConvertGRPCCookTimelineResourceConstraintToCookTimelineResourceConstraint(input *mealplanningsvc.CookTimelineResourceConstraint) *mealplanning.CookTimelineResourceConstraint {
+	return &mealplanning.CookTimelineResourceConstraint{
+		ID:       input.Id,
+		Quantity: input.Quantity,
+	}
+}
+
+func ConvertGRPCCookTimelineRequestInputToCookTimelineRequestInput(input *mealplanningsvc.CookTimelineRequestInput) *mealplanning.CookTimelineRequestInput {
+	output := &mealplanning.CookTimelineRequestInput{
+		ServingTime: grpcconverters.ConvertPBTimestampToTimePointer(input.GetServingTime()),
+		CookCount:   input.GetCookCount(),
+	}
+
+	for _, constraint := range input.GetInstrumentConstraints() {
+		output.InstrumentConstraints = append(output.InstrumentConstraints, ConvertGRPCCookTimelineResourceConstraintToCookTimelineResourceConstraint(constraint))
+	}
+
+	for _, constraint := range input.GetVesselConstraints() {
+		output.VesselConstraints = append(output.VesselConstraints, ConvertGRPCCookTimelineResourceConstraintToCookTimelineResourceConstraint(constraint))
+	}
+
+	return output
+}
@@ -4,6 +4,7 @@
 	"log"
 
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/recipeimport"
 	grpcconverters "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/grpc/converters"
 	mealplanningsvc "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/grpc/generated/services/mealplanning"
 	uploadedmediaconverters "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/services/uploadedmedia/grpc/converters"
@@ -74,6 +75,29 @@ func ConvertRecipeCreationRequestInputToGRPCRecipeCreationRequestInput(input *me
 	}
 }
 
+func ConvertRecipeImportReportToGRPCRecipeImportReport(input *recipeimport.Report) *mealplanningsvc.RecipeImportReport {
+	convertResolutions := func(resolutions []*recipeimport.Resolution) []*mealplanningsvc.RecipeImportResolution {
+		results := make([]*mealplanningsvc.RecipeImportResolution, 0, len(resolutions))
+		for _, resolution := range resolutions {
+			results = append(results, &mealplanningsvc.RecipeImportResolution{
+				Kind:         string(resolution.Kind),
+				Text:         resolution.Text,
+				Context:      resolution.Context,
+				ResolvedId:   resolution.ResolvedID,
+				ResolvedName: resolution.ResolvedName,
+				Approximate:  resolution.Approximate,
+			})
+		}
+		return results
+	}
+
+	return &mealplanningsvc.RecipeImportReport{
+		Resolved:   convertResolutions(input.Resolved),
+		Unresolved: convertResolutions(input.Unresolved),
+		Warnings:   input.Warnings,
+	}
+}
+
 func ConvertGRPCRecipePrepTaskWithinRecipeCreationRequestInputToRecipePrepTaskWithinRecipeCreationRequestInput(input *mealplanningsvc.RecipePrepTaskWithinRecipeCreationRequestInput) *mealplanning.RecipePrepTaskWithinRecipeCreationRequestInput {
 	var prepTaskSteps []*mealplanning.RecipePrepTaskStepWithinRecipeCreationRequestInput
 	for _, step := range input.RecipeSteps {
@@ -1328,6 +1352,74 @@ func ConvertGRPCRecipeRatingToRecipeRating(input *mealplanningsvc.RecipeRating)
 	}
 }
 
+func ConvertRecipeRevisionToGRPCRecipeRevision(input *mealplanning.RecipeRevision) *mealplanningsvc.RecipeRevision {
+	x := &mealplanningsvc.RecipeRevision{
+		CreatedAt:       grpcconverters.ConvertTimeToPBTimestamp(input.CreatedAt),
+		CreatedByUser:   input.CreatedByUser,
+		Id:              input.ID,
+		BelongsToRecipe: input.BelongsToRecipe,
+		RevisionNumber:  input.RevisionNumber,
+	}
+
+	if input.Snapshot != nil {
+		x.Snapshot = ConvertRecipeToGRPCRecipe(input.Snapshot)
+	}
+
+	return x
+}
+
+func ConvertRecipeRevisionDiffToGRPCRecipeRevisionDiff(input *mealplanning.RecipeRevisionDiff) *mealplanningsvc.RecipeRevisionDiff {
+	steps := []*mealplanningsvc.RecipeStepDiff{}
+	for _, step := range input.Steps {
+		steps = append(steps, &mealplanningsvc.RecipeStepDiff{
+			StepId:      step.StepID,
+			Change:      step.Change,
+			Changes:     convertRecipeFieldChangesToGRPCRecipeFieldChanges(step.Changes),
+			Ingredients: convertRecipeComponentDiffsToGRPCRecipeComponentDiffs(step.Ingredients),
+			Instruments: convertRecipeComponentDiffsToGRPCRecipeComponentDiffs(step.Instruments),
+			Vessels:     convertRecipeComponentDiffsToGRPCRecipeComponentDiffs(step.Vessels),
+			Products:    convertRecipeComponentDiffsToGRPCRecipeComponentDiffs(step.Products),
+			Index:       step.Index,
+		})
+	}
+
+	return &mealplanningsvc.RecipeRevisionDiff{
+		RecipeId:     input.RecipeID,
+		Changes:      convertRecipeFieldChangesToGRPCRecipeFieldChanges(input.Changes),
+		Steps:        steps,
+		FromRevision: input.FromRevision,
+		ToRevision:   input.ToRevision,
+	}
+}
+
+func convertRecipeFieldChangesToGRPCRecipeFieldChanges(input []*mealplanning.RecipeFieldChange) []*mealplanningsvc.RecipeFieldChange {
+	output := []*mealplanningsvc.RecipeFieldChange{}
+	for _, change := range input {
+		output = append(output, &mealplanningsvc.RecipeFieldChange{
+			Field: change.Field,
+			From:  change.From,
+			To:    change.To,
+		})
+	}
+
+	return output
+}
+
+func convertRecipeComponentDiffsToGRPCRecipeComponentDiffs(input []*mealplanning.RecipeComponentDiff) []*mealplanningsvc.RecipeComponentDiff {
+	output := []*mealplanningsvc.RecipeComponentDiff{}
+	for _, component := range input {
+		output = append(output, &mealplanningsvc.RecipeComponentDiff{
+			Id:      component.ID,
+			Name:    component.Name,
+			Change:  component.Change,
+			Changes: convertRecipeFieldChangesToGRPCRecipeFieldChanges(component.Changes),
+			Index:   uint32(component.Index),
+		})
+	}
+
+	return output
+}
+
 func ConvertGRPCRecipeUpdateRequestInputToRecipeUpdateRequestInput(input *mealplanningsvc.RecipeUpdateRequestInput) *mealplanning.RecipeUpdateRequestInput {
 	var componentType *string
 	if input.YieldsComponentType != nil {
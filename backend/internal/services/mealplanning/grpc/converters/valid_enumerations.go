@@ -1493,3 +1493,66 @@ func ConvertGRPCValidPrepTaskConfigToValidPrepTaskConfig(x *mealplanninggrpc.Val
 		Ingredient:                     *ConvertGRPCValidIngredientToValidIngredient(x.Ingredient),
 	}
 }
+
+func ConvertGRPCNutrientsToNutrients(x *mealplanninggrpc.Nutrients) *mealplanning.Nutrients {
+	return &mealplanning.Nutrients{
+		Calories:                x.GetCalories(),
+		ProteinInGrams:          x.GetProteinInGrams(),
+		TotalFatInGrams:         x.GetTotalFatInGrams(),
+		SaturatedFatInGrams:     x.GetSaturatedFatInGrams(),
+		CarbohydratesInGrams:    x.GetCarbohydratesInGrams(),
+		SugarInGrams:            x.GetSugarInGrams(),
+		FiberInGrams:            x.GetFiberInGrams(),
+		SodiumInMilligrams:      x.GetSodiumInMilligrams(),
+		CholesterolInMilligrams: x.GetCholesterolInMilligrams(),
+	}
+}
+
+func ConvertNutrientsToGRPCNutrients(x *mealplanning.Nutrients) *mealplanninggrpc.Nutrients {
+	return &mealplanninggrpc.Nutrients{
+		Calories:                x.Calories,
+		ProteinInGrams:          x.ProteinInGrams,
+		TotalFatInGrams:         x.TotalFatInGrams,
+		SaturatedFatInGrams:     x.SaturatedFatInGrams,
+		CarbohydratesInGrams:    x.CarbohydratesInGrams,
+		SugarInGrams:            x.SugarInGrams,
+		FiberInGrams:            x.FiberInGrams,
+		SodiumInMilligrams:      x.SodiumInMilligrams,
+		CholesterolInMilligrams: x.CholesterolInMilligrams,
+	}
+}
+
+func ConvertGRPCCreateValidIngredientNutritionFactsRequestToValidIngredientNutritionFactsCreationRequestInput(request *mealplanninggrpc.ValidIngredientNutritionFactsCreationRequestInput) *mealplanning.ValidIngredientNutritionFactsCreationRequestInput {
+	return &mealplanning.ValidIngredientNutritionFactsCreationRequestInput{
+		ValidIngredientID:      request.ValidIngredientId,
+		BasisMeasurementUnitID: request.BasisMeasurementUnitId,
+		Notes:                  request.Notes,
+		Nutrients:              *ConvertGRPCNutrientsToNutrients(request.Nutrients),
+	}
+}
+
+func ConvertGRPCValidIngredientNutritionFactsUpdateRequestInputToValidIngredientNutritionFactsUpdateRequestInput(x *mealplanninggrpc.ValidIngredientNutritionFactsUpdateRequestInput) *mealplanning.ValidIngredientNutritionFactsUpdateRequestInput {
+	output := &mealplanning.ValidIngredientNutritionFactsUpdateRequestInput{
+		BasisMeasurementUnitID: x.BasisMeasurementUnitId,
+		Notes:                  x.Notes,
+	}
+
+	if x.Nutrients != nil {
+		output.Nutrients = ConvertGRPCNutrientsToNutrients(x.Nutrients)
+	}
+
+	return output
+}
+
+func ConvertValidIngredientNutritionFactsToGRPCValidIngredientNutritionFacts(x *mealplanning.ValidIngredientNutritionFacts) *mealplanninggrpc.ValidIngredientNutritionFacts {
+	return &mealplanninggrpc.ValidIngredientNutritionFacts{
+		CreatedAt:            converters.ConvertTimeToPBTimestamp(x.CreatedAt),
+		LastUpdatedAt:        converters.ConvertTimePointerToPBTimestamp(x.LastUpdatedAt),
+		ArchivedAt:           converters.ConvertTimePointerToPBTimestamp(x.ArchivedAt),
+		Id:                   x.ID,
+		ValidIngredientId:    x.ValidIngredientID,
+		Notes:                x.Notes,
+		BasisMeasurementUnit: ConvertValidMeasurementUnitToGRPCValidMeasurementUnit(&x.BasisMeasurementUnit),
+		Nutrients:            ConvertNutrientsToGRPCNutrients(&x.Nutrients),
+	}
+}
@@ -2,6 +2,8 @@
 
 import (
 	commentsmanager "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/comments/manager"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/icalendar"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/managers"
 	uploadedmediamanager "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/uploadedmedia/manager"
 	mealplanningsvc "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/grpc/generated/services/mealplanning"
@@ -33,6 +35,8 @@ func RegisterMealPlanningService(i do.Injector) {
 			do.MustInvoke[commentsmanager.CommentsDataManager](i),
 			do.MustInvoke[uploadedmediamanager.UploadedMediaManager](i),
 			do.MustInvoke[uploads.UploadManager](i),
+			do.MustInvoke[icalendar.MealPlanCalendarManager](i),
+			do.MustInvoke[mealplanning.MealPlanActivityNotifier](i),
 		), nil
 	})
 }
@@ -0,0 +1,70 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/icalendar"
+	mealplanningsvc "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/grpc/generated/services/mealplanning"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/grpc/generated/types"
+	converters "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/services/mealplanning/grpc/converters"
+
+	errorsgrpc "github.com/primandproper/platform/errors/grpc"
+
+	"google.golang.org/grpc/codes"
+)
+
+// ExportMealPlanCalendar renders the active account's recent meal plans as an iCalendar document for the requester.
+func (s *serviceImpl) ExportMealPlanCalendar(ctx context.Context, _ *mealplanningsvc.ExportMealPlanCalendarRequest) (*mealplanningsvc.ExportMealPlanCalendarResponse, error) {
+	ctx, span := s.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := s.logger.WithSpan(span)
+
+	sessionContextData, err := s.sessionContextDataFetcher(ctx)
+	if err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.Unauthenticated, "failed to get session context data")
+	}
+
+	calendar, err := s.calendarManager.ExportMealPlanCalendar(ctx, sessionContextData.GetUserID(), sessionContextData.GetActiveAccountID())
+	if err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.Internal, "failed to export meal plan calendar")
+	}
+
+	x := &mealplanningsvc.ExportMealPlanCalendarResponse{
+		ResponseDetails: &types.ResponseDetails{
+			TraceId: span.SpanContext().TraceID().String(),
+		},
+		Calendar:    string(calendar),
+		ContentType: icalendar.ContentType,
+	}
+
+	return x, nil
+}
+
+// CreateMealPlanCalendarFeed creates a calendar subscription feed for the requester in the active account.
+// Any feed the requester already had for the account stops working.
+func (s *serviceImpl) CreateMealPlanCalendarFeed(ctx context.Context, _ *mealplanningsvc.CreateMealPlanCalendarFeedRequest) (*mealplanningsvc.CreateMealPlanCalendarFeedResponse, error) {
+	ctx, span := s.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := s.logger.WithSpan(span)
+
+	sessionContextData, err := s.sessionContextDataFetcher(ctx)
+	if err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.Unauthenticated, "failed to get session context data")
+	}
+
+	created, err := s.calendarManager.CreateMealPlanCalendarFeed(ctx, sessionContextData.GetUserID(), sessionContextData.GetActiveAccountID())
+	if err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.Internal, "failed to create meal plan calendar feed")
+	}
+
+	x := &mealplanningsvc.CreateMealPlanCalendarFeedResponse{
+		ResponseDetails: &types.ResponseDetails{
+			TraceId: span.SpanContext().TraceID().String(),
+		},
+		Created: converters.ConvertMealPlanCalendarFeedToGRPCMealPlanCalendarFeed(created),
+	}
+
+	return x, nil
+}
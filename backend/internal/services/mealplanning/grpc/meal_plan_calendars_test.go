@@ -0,0 +1,130 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/authentication/sessions"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+	mealplanningfakes "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/fakes"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/icalendar"
+	icalendarmock "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/icalendar/mock"
+	mealplanninggrpc "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/grpc/generated/services/mealplanning"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/testutils"
+
+	"github.com/primandproper/platform/reflection"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func buildServiceImplForCalendarTest(t *testing.T, userID, accountID string) *serviceImpl {
+	t.Helper()
+
+	s := buildServiceImplForMealPlanningTest(t)
+	s.sessionContextDataFetcher = func(ctx context.Context) (*sessions.ContextData, error) {
+		return &sessions.ContextData{
+			Requester: sessions.RequesterInfo{
+				UserID: userID,
+			},
+			ActiveAccountID: accountID,
+		}, nil
+	}
+
+	return s
+}
+
+func TestServiceImpl_ExportMealPlanCalendar(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		exampleUserID := mealplanningfakes.BuildFakeID()
+		exampleAccountID := mealplanningfakes.BuildFakeID()
+		s := buildServiceImplForCalendarTest(t, exampleUserID, exampleAccountID)
+
+		calendar := []byte("BEGIN:VCALENDAR\r\nEND:VCALENDAR\r\n")
+		mcm := &icalendarmock.MealPlanCalendarManager{}
+		mcm.On(reflection.GetMethodName(mcm.ExportMealPlanCalendar), testutils.ContextMatcher, exampleUserID, exampleAccountID).Return(calendar, nil)
+		s.calendarManager = mcm
+
+		res, err := s.ExportMealPlanCalendar(ctx, &mealplanninggrpc.ExportMealPlanCalendarRequest{})
+		require.NoError(t, err)
+		require.NotNil(t, res)
+		assert.Equal(t, string(calendar), res.Calendar)
+		assert.Equal(t, icalendar.ContentType, res.ContentType)
+
+		mock.AssertExpectationsForObjects(t, mcm)
+	})
+
+	T.Run("with error exporting calendar", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		exampleUserID := mealplanningfakes.BuildFakeID()
+		exampleAccountID := mealplanningfakes.BuildFakeID()
+		s := buildServiceImplForCalendarTest(t, exampleUserID, exampleAccountID)
+
+		mcm := &icalendarmock.MealPlanCalendarManager{}
+		mcm.On(reflection.GetMethodName(mcm.ExportMealPlanCalendar), testutils.ContextMatcher, exampleUserID, exampleAccountID).Return([]byte(nil), errors.New("blah"))
+		s.calendarManager = mcm
+
+		res, err := s.ExportMealPlanCalendar(ctx, &mealplanninggrpc.ExportMealPlanCalendarRequest{})
+		assert.Error(t, err)
+		assert.Nil(t, res)
+
+		mock.AssertExpectationsForObjects(t, mcm)
+	})
+}
+
+func TestServiceImpl_CreateMealPlanCalendarFeed(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		exampleUserID := mealplanningfakes.BuildFakeID()
+		exampleAccountID := mealplanningfakes.BuildFakeID()
+		s := buildServiceImplForCalendarTest(t, exampleUserID, exampleAccountID)
+
+		exampleFeed := mealplanningfakes.BuildFakeMealPlanCalendarFeed()
+		exampleFeed.Token = "token"
+
+		mcm := &icalendarmock.MealPlanCalendarManager{}
+		mcm.On(reflection.GetMethodName(mcm.CreateMealPlanCalendarFeed), testutils.ContextMatcher, exampleUserID, exampleAccountID).Return(exampleFeed, nil)
+		s.calendarManager = mcm
+
+		res, err := s.CreateMealPlanCalendarFeed(ctx, &mealplanninggrpc.CreateMealPlanCalendarFeedRequest{})
+		require.NoError(t, err)
+		require.NotNil(t, res)
+		assert.Equal(t, exampleFeed.ID, res.Created.Id)
+		assert.Equal(t, exampleFeed.Token, res.Created.Token)
+		assert.Equal(t, icalendar.FeedPath(exampleFeed.Token), res.Created.SubscriptionPath)
+
+		mock.AssertExpectationsForObjects(t, mcm)
+	})
+
+	T.Run("with error creating feed", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		exampleUserID := mealplanningfakes.BuildFakeID()
+		exampleAccountID := mealplanningfakes.BuildFakeID()
+		s := buildServiceImplForCalendarTest(t, exampleUserID, exampleAccountID)
+
+		mcm := &icalendarmock.MealPlanCalendarManager{}
+		mcm.On(reflection.GetMethodName(mcm.CreateMealPlanCalendarFeed), testutils.ContextMatcher, exampleUserID, exampleAccountID).Return((*mealplanning.MealPlanCalendarFeed)(nil), errors.New("blah"))
+		s.calendarManager = mcm
+
+		res, err := s.CreateMealPlanCalendarFeed(ctx, &mealplanninggrpc.CreateMealPlanCalendarFeedRequest{})
+		assert.Error(t, err)
+		assert.Nil(t, res)
+
+		mock.AssertExpectationsForObjects(t, mcm)
+	})
+}
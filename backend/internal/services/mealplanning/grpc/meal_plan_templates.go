@@ -0,0 +1,171 @@
+package grpc
+
+import (
+	"context"
+
+	mealplanningkeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/keys"
+	grpcconverters "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/grpc/converters"
+	mealplanningsvc "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/grpc/generated/services/mealplanning"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/grpc/generated/types"
+	converters "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/services/mealplanning/grpc/converters"
+
+	errorsgrpc "github.com/primandproper/platform/errors/grpc"
+	"github.com/primandproper/platform/observability"
+
+	"google.golang.org/grpc/codes"
+)
+
+func (s *serviceImpl) CreateMealPlanTemplate(ctx context.Context, request *mealplanningsvc.CreateMealPlanTemplateRequest) (*mealplanningsvc.CreateMealPlanTemplateResponse, error) {
+	ctx, span := s.tracer.StartSpan(ctx)
+	defer span.End()
+
+	sessionContextData, err := s.sessionContextDataFetcher(ctx)
+	if err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, s.logger, span, codes.Unauthenticated, "failed to fetch session context data")
+	}
+
+	logger := observability.ObserveValues(nil, span, s.logger)
+
+	input := converters.ConvertGRPCMealPlanTemplateCreationRequestInputToMealPlanTemplateCreationRequestInput(request.Input)
+	if err = input.ValidateWithContext(ctx); err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.InvalidArgument, "failed to validate meal plan template creation request input")
+	}
+
+	created, err := s.mealPlanningManager.CreateMealPlanTemplate(ctx, sessionContextData.GetActiveAccountID(), sessionContextData.GetUserID(), input)
+	if err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.Internal, "failed to create meal plan template")
+	}
+
+	x := &mealplanningsvc.CreateMealPlanTemplateResponse{
+		ResponseDetails: &types.ResponseDetails{
+			TraceId: span.SpanContext().TraceID().String(),
+		},
+		Created: converters.ConvertMealPlanTemplateToGRPCMealPlanTemplate(created),
+	}
+
+	return x, nil
+}
+
+func (s *serviceImpl) GetMealPlanTemplate(ctx context.Context, request *mealplanningsvc.GetMealPlanTemplateRequest) (*mealplanningsvc.GetMealPlanTemplateResponse, error) {
+	ctx, span := s.tracer.StartSpan(ctx)
+	defer span.End()
+
+	sessionContextData, err := s.sessionContextDataFetcher(ctx)
+	if err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, s.logger, span, codes.Unauthenticated, "failed to fetch session context data")
+	}
+
+	logger := observability.ObserveValues(map[string]any{
+		mealplanningkeys.MealPlanTemplateIDKey: request.MealPlanTemplateId,
+	}, span, s.logger)
+
+	result, err := s.mealPlanningManager.ReadMealPlanTemplate(ctx, request.MealPlanTemplateId, sessionContextData.GetActiveAccountID())
+	if err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.Internal, "failed to fetch meal plan template")
+	}
+
+	x := &mealplanningsvc.GetMealPlanTemplateResponse{
+		ResponseDetails: &types.ResponseDetails{
+			TraceId: span.SpanContext().TraceID().String(),
+		},
+		Result: converters.ConvertMealPlanTemplateToGRPCMealPlanTemplate(result),
+	}
+
+	return x, nil
+}
+
+func (s *serviceImpl) GetMealPlanTemplates(ctx context.Context, request *mealplanningsvc.GetMealPlanTemplatesRequest) (*mealplanningsvc.GetMealPlanTemplatesResponse, error) {
+	ctx, span := s.tracer.StartSpan(ctx)
+	defer span.End()
+
+	sessionContextData, err := s.sessionContextDataFetcher(ctx)
+	if err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, s.logger, span, codes.Unauthenticated, "failed to fetch session context data")
+	}
+
+	filter := grpcconverters.ConvertGRPCQueryFilterToQueryFilter(request.Filter)
+
+	logger := observability.ObserveValues(nil, span, s.logger)
+
+	results, err := s.mealPlanningManager.ListMealPlanTemplates(ctx, sessionContextData.GetActiveAccountID(), filter)
+	if err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.Internal, "failed to list meal plan templates")
+	}
+
+	x := &mealplanningsvc.GetMealPlanTemplatesResponse{
+		ResponseDetails: &types.ResponseDetails{
+			TraceId: span.SpanContext().TraceID().String(),
+		},
+		Pagination: grpcconverters.ConvertPaginationToGRPCPagination(results.Pagination, filter),
+	}
+
+	for _, result := range results.Data {
+		x.Results = append(x.Results, converters.ConvertMealPlanTemplateToGRPCMealPlanTemplate(result))
+	}
+
+	return x, nil
+}
+
+func (s *serviceImpl) UpdateMealPlanTemplate(ctx context.Context, request *mealplanningsvc.UpdateMealPlanTemplateRequest) (*mealplanningsvc.UpdateMealPlanTemplateResponse, error) {
+	ctx, span := s.tracer.StartSpan(ctx)
+	defer span.End()
+
+	sessionContextData, err := s.sessionContextDataFetcher(ctx)
+	if err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, s.logger, span, codes.Unauthenticated, "failed to fetch session context data")
+	}
+
+	logger := observability.ObserveValues(map[string]any{
+		mealplanningkeys.MealPlanTemplateIDKey: request.MealPlanTemplateId,
+	}, span, s.logger)
+
+	input := converters.ConvertGRPCMealPlanTemplateUpdateRequestInputToMealPlanTemplateUpdateRequestInput(request.Input)
+	if err = input.ValidateWithContext(ctx); err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.InvalidArgument, "failed to validate meal plan template update request input")
+	}
+
+	accountID := sessionContextData.GetActiveAccountID()
+	if err = s.mealPlanningManager.UpdateMealPlanTemplate(ctx, request.MealPlanTemplateId, accountID, input); err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.Internal, "failed to update meal plan template")
+	}
+
+	updated, err := s.mealPlanningManager.ReadMealPlanTemplate(ctx, request.MealPlanTemplateId, accountID)
+	if err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.Internal, "failed to fetch updated meal plan template")
+	}
+
+	x := &mealplanningsvc.UpdateMealPlanTemplateResponse{
+		ResponseDetails: &types.ResponseDetails{
+			TraceId: span.SpanContext().TraceID().String(),
+		},
+		Updated: converters.ConvertMealPlanTemplateToGRPCMealPlanTemplate(updated),
+	}
+
+	return x, nil
+}
+
+func (s *serviceImpl) ArchiveMealPlanTemplate(ctx context.Context, request *mealplanningsvc.ArchiveMealPlanTemplateRequest) (*mealplanningsvc.ArchiveMealPlanTemplateResponse, error) {
+	ctx, span := s.tracer.StartSpan(ctx)
+	defer span.End()
+
+	sessionContextData, err := s.sessionContextDataFetcher(ctx)
+	if err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, s.logger, span, codes.Unauthenticated, "failed to fetch session context data")
+	}
+
+	logger := observability.ObserveValues(map[string]any{
+		mealplanningkeys.MealPlanTemplateIDKey: request.MealPlanTemplateId,
+	}, span, s.logger)
+
+	if err = s.mealPlanningManager.ArchiveMealPlanTemplate(ctx, request.MealPlanTemplateId, sessionContextData.GetActiveAccountID()); err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.Internal, "failed to archive meal plan template")
+	}
+
+	x := &mealplanningsvc.ArchiveMealPlanTemplateResponse{
+		ResponseDetails: &types.ResponseDetails{
+			TraceId: span.SpanContext().TraceID().String(),
+		},
+	}
+
+	return x, nil
+}
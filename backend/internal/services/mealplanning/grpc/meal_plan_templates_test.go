@@ -0,0 +1,220 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/authentication/sessions"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+	mealplanningfakes "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/fakes"
+	mockmanagers "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/managers/mock"
+	mealplanninggrpc "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/grpc/generated/services/mealplanning"
+	converters "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/services/mealplanning/grpc/converters"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/testutils"
+
+	"github.com/primandproper/platform/reflection"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestServiceImpl_CreateMealPlanTemplate(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		s := buildServiceImplForMealPlanningTest(t)
+
+		exampleAccountID := mealplanningfakes.BuildFakeID()
+		exampleUserID := mealplanningfakes.BuildFakeID()
+		exampleCreated := mealplanningfakes.BuildFakeMealPlanTemplate()
+
+		mmpm := &mockmanagers.MockMealPlanningManager{}
+		mmpm.On(reflection.GetMethodName(mmpm.CreateMealPlanTemplate), testutils.ContextMatcher, exampleAccountID, exampleUserID, testutils.MatchType[*mealplanning.MealPlanTemplateCreationRequestInput]()).Return(exampleCreated, nil)
+		s.mealPlanningManager = mmpm
+
+		s.sessionContextDataFetcher = func(ctx context.Context) (*sessions.ContextData, error) {
+			return &sessions.ContextData{
+				Requester:       sessions.RequesterInfo{UserID: exampleUserID},
+				ActiveAccountID: exampleAccountID,
+			}, nil
+		}
+
+		actual, err := s.CreateMealPlanTemplate(ctx, &mealplanninggrpc.CreateMealPlanTemplateRequest{
+			Input: converters.ConvertMealPlanTemplateCreationRequestInputToGRPCMealPlanTemplateCreationRequestInput(mealplanningfakes.BuildFakeMealPlanTemplateCreationRequestInput()),
+		})
+		assert.NoError(t, err)
+		assert.NotNil(t, actual)
+		assert.Equal(t, exampleCreated.ID, actual.Created.Id)
+
+		mock.AssertExpectationsForObjects(t, mmpm)
+	})
+
+	T.Run("with invalid input", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		s := buildServiceImplForMealPlanningTest(t)
+
+		actual, err := s.CreateMealPlanTemplate(ctx, &mealplanninggrpc.CreateMealPlanTemplateRequest{
+			Input: &mealplanninggrpc.MealPlanTemplateCreationRequestInput{},
+		})
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+}
+
+func TestServiceImpl_GetMealPlanTemplate(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		s := buildServiceImplForMealPlanningTest(t)
+
+		exampleAccountID := mealplanningfakes.BuildFakeID()
+		exampleResult := mealplanningfakes.BuildFakeMealPlanTemplate()
+
+		mmpm := &mockmanagers.MockMealPlanningManager{}
+		mmpm.On(reflection.GetMethodName(mmpm.ReadMealPlanTemplate), testutils.ContextMatcher, exampleResult.ID, exampleAccountID).Return(exampleResult, nil)
+		s.mealPlanningManager = mmpm
+
+		s.sessionContextDataFetcher = func(ctx context.Context) (*sessions.ContextData, error) {
+			return &sessions.ContextData{
+				ActiveAccountID: exampleAccountID,
+			}, nil
+		}
+
+		actual, err := s.GetMealPlanTemplate(ctx, &mealplanninggrpc.GetMealPlanTemplateRequest{
+			MealPlanTemplateId: exampleResult.ID,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, exampleResult.ID, actual.Result.Id)
+
+		mock.AssertExpectationsForObjects(t, mmpm)
+	})
+
+	T.Run("with error reading template", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		s := buildServiceImplForMealPlanningTest(t)
+
+		exampleTemplateID := mealplanningfakes.BuildFakeID()
+
+		mmpm := &mockmanagers.MockMealPlanningManager{}
+		mmpm.On(reflection.GetMethodName(mmpm.ReadMealPlanTemplate), testutils.ContextMatcher, exampleTemplateID, mock.AnythingOfType("string")).Return((*mealplanning.MealPlanTemplate)(nil), errors.New("blah"))
+		s.mealPlanningManager = mmpm
+
+		actual, err := s.GetMealPlanTemplate(ctx, &mealplanninggrpc.GetMealPlanTemplateRequest{
+			MealPlanTemplateId: exampleTemplateID,
+		})
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+
+		mock.AssertExpectationsForObjects(t, mmpm)
+	})
+}
+
+func TestServiceImpl_GetMealPlanTemplates(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		s := buildServiceImplForMealPlanningTest(t)
+
+		exampleAccountID := mealplanningfakes.BuildFakeID()
+		exampleResults := mealplanningfakes.BuildFakeMealPlanTemplatesList()
+
+		mmpm := &mockmanagers.MockMealPlanningManager{}
+		mmpm.On(reflection.GetMethodName(mmpm.ListMealPlanTemplates), testutils.ContextMatcher, exampleAccountID, testutils.QueryFilterMatcher).Return(exampleResults, nil)
+		s.mealPlanningManager = mmpm
+
+		s.sessionContextDataFetcher = func(ctx context.Context) (*sessions.ContextData, error) {
+			return &sessions.ContextData{
+				ActiveAccountID: exampleAccountID,
+			}, nil
+		}
+
+		actual, err := s.GetMealPlanTemplates(ctx, &mealplanninggrpc.GetMealPlanTemplatesRequest{})
+		assert.NoError(t, err)
+		assert.Len(t, actual.Results, len(exampleResults.Data))
+
+		mock.AssertExpectationsForObjects(t, mmpm)
+	})
+}
+
+func TestServiceImpl_UpdateMealPlanTemplate(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		s := buildServiceImplForMealPlanningTest(t)
+
+		exampleAccountID := mealplanningfakes.BuildFakeID()
+		exampleTemplate := mealplanningfakes.BuildFakeMealPlanTemplate()
+		newName := "new name"
+
+		mmpm := &mockmanagers.MockMealPlanningManager{}
+		mmpm.On(reflection.GetMethodName(mmpm.UpdateMealPlanTemplate), testutils.ContextMatcher, exampleTemplate.ID, exampleAccountID, testutils.MatchType[*mealplanning.MealPlanTemplateUpdateRequestInput]()).Return(nil)
+		mmpm.On(reflection.GetMethodName(mmpm.ReadMealPlanTemplate), testutils.ContextMatcher, exampleTemplate.ID, exampleAccountID).Return(exampleTemplate, nil)
+		s.mealPlanningManager = mmpm
+
+		s.sessionContextDataFetcher = func(ctx context.Context) (*sessions.ContextData, error) {
+			return &sessions.ContextData{
+				ActiveAccountID: exampleAccountID,
+			}, nil
+		}
+
+		actual, err := s.UpdateMealPlanTemplate(ctx, &mealplanninggrpc.UpdateMealPlanTemplateRequest{
+			MealPlanTemplateId: exampleTemplate.ID,
+			Input: &mealplanninggrpc.MealPlanTemplateUpdateRequestInput{
+				Name: &newName,
+			},
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, exampleTemplate.ID, actual.Updated.Id)
+
+		mock.AssertExpectationsForObjects(t, mmpm)
+	})
+}
+
+func TestServiceImpl_ArchiveMealPlanTemplate(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		s := buildServiceImplForMealPlanningTest(t)
+
+		exampleAccountID := mealplanningfakes.BuildFakeID()
+		exampleTemplateID := mealplanningfakes.BuildFakeID()
+
+		mmpm := &mockmanagers.MockMealPlanningManager{}
+		mmpm.On(reflection.GetMethodName(mmpm.ArchiveMealPlanTemplate), testutils.ContextMatcher, exampleTemplateID, exampleAccountID).Return(nil)
+		s.mealPlanningManager = mmpm
+
+		s.sessionContextDataFetcher = func(ctx context.Context) (*sessions.ContextData, error) {
+			return &sessions.ContextData{
+				ActiveAccountID: exampleAccountID,
+			}, nil
+		}
+
+		actual, err := s.ArchiveMealPlanTemplate(ctx, &mealplanninggrpc.ArchiveMealPlanTemplateRequest{
+			MealPlanTemplateId: exampleTemplateID,
+		})
+		assert.NoError(t, err)
+		assert.NotNil(t, actual)
+
+		mock.AssertExpectationsForObjects(t, mmpm)
+	})
+}
@@ -0,0 +1,168 @@
+package grpc
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+	mealplanningkeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/keys"
+	mealplanningsvc "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/grpc/generated/services/mealplanning"
+	converters "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/services/mealplanning/grpc/converters"
+
+	errorsgrpc "github.com/primandproper/platform/errors/grpc"
+	"github.com/primandproper/platform/observability"
+	"github.com/primandproper/platform/observability/logging"
+	"github.com/primandproper/platform/observability/tracing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	// mealPlanWatchBatchSize is the most activities a watch stream reads from the feed at once.
+	mealPlanWatchBatchSize uint8 = 50
+)
+
+var (
+	// mealPlanWatchFallbackInterval is how often a watch stream checks the activity feed without being notified,
+	// in case a notification was lost.
+	mealPlanWatchFallbackInterval = 30 * time.Second
+)
+
+// WatchMealPlan streams voting, option, and finalization activity for a meal plan.
+// Clients resume after a reconnect by passing the cursor of the last response they received;
+// without a cursor, the stream starts from the beginning of the meal plan's activity.
+func (s *serviceImpl) WatchMealPlan(request *mealplanningsvc.WatchMealPlanRequest, stream grpc.ServerStreamingServer[mealplanningsvc.WatchMealPlanResponse]) error {
+	ctx, span := s.tracer.StartSpan(stream.Context())
+	defer span.End()
+
+	logger := observability.ObserveValues(map[string]any{
+		mealplanningkeys.MealPlanIDKey: request.MealPlanId,
+	}, span, s.logger)
+
+	if err := s.authorizeMealPlanWatch(ctx, request.MealPlanId, logger, span); err != nil {
+		return err
+	}
+
+	return s.watchMealPlanActivity(ctx, request.MealPlanId, request.GetCursor(), mealplanning.MealPlanActivityCategoryVoting, logger, span, func(activity *mealplanning.MealPlanActivity) error {
+		return stream.Send(&mealplanningsvc.WatchMealPlanResponse{
+			Activity: converters.ConvertMealPlanActivityToGRPCMealPlanActivity(activity),
+			Cursor:   formatMealPlanWatchCursor(activity),
+		})
+	})
+}
+
+// WatchMealPlanTasks streams task creation and status changes for a meal plan, along with each task's current state.
+// Cursors work the same way as they do for WatchMealPlan.
+func (s *serviceImpl) WatchMealPlanTasks(request *mealplanningsvc.WatchMealPlanTasksRequest, stream grpc.ServerStreamingServer[mealplanningsvc.WatchMealPlanTasksResponse]) error {
+	ctx, span := s.tracer.StartSpan(stream.Context())
+	defer span.End()
+
+	logger := observability.ObserveValues(map[string]any{
+		mealplanningkeys.MealPlanIDKey: request.MealPlanId,
+	}, span, s.logger)
+
+	if err := s.authorizeMealPlanWatch(ctx, request.MealPlanId, logger, span); err != nil {
+		return err
+	}
+
+	return s.watchMealPlanActivity(ctx, request.MealPlanId, request.GetCursor(), mealplanning.MealPlanActivityCategoryTasks, logger, span, func(activity *mealplanning.MealPlanActivity) error {
+		x := &mealplanningsvc.WatchMealPlanTasksResponse{
+			Activity: converters.ConvertMealPlanActivityToGRPCMealPlanActivity(activity),
+			Cursor:   formatMealPlanWatchCursor(activity),
+		}
+
+		task, err := s.mealPlanningManager.ReadMealPlanTask(ctx, request.MealPlanId, activity.MealPlanTaskID)
+		if err != nil {
+			// the activity is still worth sending; the client can fetch the task itself.
+			observability.AcknowledgeError(err, logger.WithValue(mealplanningkeys.MealPlanTaskIDKey, activity.MealPlanTaskID), span, "fetching watched meal plan task")
+		} else {
+			x.Task = converters.ConvertMealPlanTaskToGRPCMealPlanTask(task)
+		}
+
+		return stream.Send(x)
+	})
+}
+
+// authorizeMealPlanWatch ensures the meal plan belongs to the requester's active account, the same check GetMealPlan makes.
+func (s *serviceImpl) authorizeMealPlanWatch(ctx context.Context, mealPlanID string, logger logging.Logger, span tracing.Span) error {
+	sessionContextData, err := s.sessionContextDataFetcher(ctx)
+	if err != nil {
+		return errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.Unauthenticated, "failed to get session context data")
+	}
+
+	if _, err = s.mealPlanningManager.ReadMealPlan(ctx, mealPlanID, sessionContextData.GetActiveAccountID()); err != nil {
+		return errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.NotFound, "failed to read meal plan")
+	}
+
+	return nil
+}
+
+// formatMealPlanWatchCursor builds the cursor clients pass back to resume a watch after the given activity.
+func formatMealPlanWatchCursor(activity *mealplanning.MealPlanActivity) string {
+	return strconv.FormatUint(activity.Sequence, 10)
+}
+
+// parseMealPlanWatchCursor reads a cursor built by formatMealPlanWatchCursor. An empty cursor starts from the beginning.
+func parseMealPlanWatchCursor(cursor string) (uint64, error) {
+	if cursor == "" {
+		return 0, nil
+	}
+
+	return strconv.ParseUint(cursor, 10, 64)
+}
+
+// watchMealPlanActivity sends every activity in the given category recorded after the cursor,
+// then waits to be notified of more until the stream ends.
+func (s *serviceImpl) watchMealPlanActivity(
+	ctx context.Context,
+	mealPlanID, cursor, category string,
+	logger logging.Logger,
+	span tracing.Span,
+	send func(activity *mealplanning.MealPlanActivity) error,
+) error {
+	afterSequence, err := parseMealPlanWatchCursor(cursor)
+	if err != nil {
+		return errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.InvalidArgument, "invalid cursor")
+	}
+
+	// subscribe before the first read, so activity recorded in between still wakes the stream.
+	notifications := s.mealPlanActivityNotifier.SubscribeToMealPlanActivity(ctx, mealPlanID)
+
+	ticker := time.NewTicker(mealPlanWatchFallbackInterval)
+	defer ticker.Stop()
+
+	for {
+		activities, err := s.mealPlanningManager.ListMealPlanActivitiesAfterCursor(ctx, mealPlanID, afterSequence, mealPlanWatchBatchSize)
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			return errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.Internal, "failed to fetch meal plan activity")
+		}
+
+		for _, activity := range activities {
+			afterSequence = activity.Sequence
+			if mealplanning.MealPlanActivityCategoryForEventType(activity.EventType) != category {
+				continue
+			}
+
+			if err = send(activity); err != nil {
+				return errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.Unavailable, "failed to send meal plan activity")
+			}
+		}
+
+		// a full batch means more activity is likely already waiting.
+		if len(activities) == int(mealPlanWatchBatchSize) && ctx.Err() == nil {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-notifications:
+		case <-ticker.C:
+		}
+	}
+}
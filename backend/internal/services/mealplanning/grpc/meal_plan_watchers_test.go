@@ -0,0 +1,248 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/authentication/sessions"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+	mealplanningfakes "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/fakes"
+	mockmanagers "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/managers/mock"
+	mealplanningmocks "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/mocks"
+	mealplanninggrpc "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/grpc/generated/services/mealplanning"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/testutils"
+
+	"github.com/primandproper/platform/reflection"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// fakeServerStream collects sent messages and ends the stream once it has seen enough of them.
+type fakeServerStream[T any] struct {
+	grpc.ServerStream
+	ctx       context.Context
+	cancel    context.CancelFunc
+	sent      []*T
+	stopAfter int
+}
+
+func newFakeServerStream[T any](t *testing.T, stopAfter int) *fakeServerStream[T] {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(t.Context())
+	t.Cleanup(cancel)
+
+	return &fakeServerStream[T]{ctx: ctx, cancel: cancel, stopAfter: stopAfter}
+}
+
+func (f *fakeServerStream[T]) Context() context.Context {
+	return f.ctx
+}
+
+func (f *fakeServerStream[T]) Send(x *T) error {
+	f.sent = append(f.sent, x)
+	if len(f.sent) >= f.stopAfter {
+		f.cancel()
+	}
+	return nil
+}
+
+// buildMealPlanActivityNotifierForTest returns a notifier along with the channel it hands out, which only fires when the test sends on it.
+func buildMealPlanActivityNotifierForTest(mealPlanID string) (*mealplanningmocks.MealPlanActivityNotifier, chan struct{}) {
+	notifications := make(chan struct{}, 1)
+	notifier := &mealplanningmocks.MealPlanActivityNotifier{}
+	notifier.On(reflection.GetMethodName(notifier.SubscribeToMealPlanActivity), testutils.ContextMatcher, mealPlanID).Return(notifications)
+
+	return notifier, notifications
+}
+
+func TestServiceImpl_WatchMealPlan(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		exampleMealPlan := mealplanningfakes.BuildFakeMealPlan()
+		exampleAccountID := mealplanningfakes.BuildFakeID()
+
+		voteActivity := mealplanningfakes.BuildFakeMealPlanActivity()
+		voteActivity.EventType = mealplanning.MealPlanOptionVoteCreatedServiceEventType
+		voteActivity.BelongsToMealPlan = exampleMealPlan.ID
+		voteActivity.Sequence = 1
+		taskActivity := mealplanningfakes.BuildFakeMealPlanActivity()
+		taskActivity.BelongsToMealPlan = exampleMealPlan.ID
+		taskActivity.Sequence = 2
+		finalizedActivity := mealplanningfakes.BuildFakeMealPlanActivity()
+		finalizedActivity.EventType = mealplanning.MealPlanFinalizedServiceEventType
+		finalizedActivity.BelongsToMealPlan = exampleMealPlan.ID
+		finalizedActivity.Sequence = 3
+
+		s := buildServiceImplForMealPlanningTest(t)
+		s.sessionContextDataFetcher = func(context.Context) (*sessions.ContextData, error) {
+			return &sessions.ContextData{ActiveAccountID: exampleAccountID}, nil
+		}
+
+		mmpm := &mockmanagers.MockMealPlanningManager{}
+		mmpm.On(reflection.GetMethodName(mmpm.ReadMealPlan), testutils.ContextMatcher, exampleMealPlan.ID, exampleAccountID).Return(exampleMealPlan, nil)
+		mmpm.On(reflection.GetMethodName(mmpm.ListMealPlanActivitiesAfterCursor), testutils.ContextMatcher, exampleMealPlan.ID, uint64(0), mealPlanWatchBatchSize).Return([]*mealplanning.MealPlanActivity{voteActivity, taskActivity, finalizedActivity}, nil).Once()
+		s.mealPlanningManager = mmpm
+
+		notifier, _ := buildMealPlanActivityNotifierForTest(exampleMealPlan.ID)
+		s.mealPlanActivityNotifier = notifier
+
+		stream := newFakeServerStream[mealplanninggrpc.WatchMealPlanResponse](t, 2)
+
+		require.NoError(t, s.WatchMealPlan(&mealplanninggrpc.WatchMealPlanRequest{MealPlanId: exampleMealPlan.ID}, stream))
+
+		require.Len(t, stream.sent, 2)
+		assert.Equal(t, voteActivity.ID, stream.sent[0].Activity.Id)
+		assert.Equal(t, "1", stream.sent[0].Cursor)
+		assert.Equal(t, "3", stream.sent[1].Cursor)
+
+		mock.AssertExpectationsForObjects(t, mmpm, notifier)
+	})
+
+	T.Run("wakes when notified of new activity", func(t *testing.T) {
+		t.Parallel()
+
+		exampleMealPlan := mealplanningfakes.BuildFakeMealPlan()
+
+		voteActivity := mealplanningfakes.BuildFakeMealPlanActivity()
+		voteActivity.EventType = mealplanning.MealPlanOptionVoteCreatedServiceEventType
+		voteActivity.BelongsToMealPlan = exampleMealPlan.ID
+		voteActivity.Sequence = 7
+
+		s := buildServiceImplForMealPlanningTest(t)
+
+		notifier, notifications := buildMealPlanActivityNotifierForTest(exampleMealPlan.ID)
+		s.mealPlanActivityNotifier = notifier
+
+		mmpm := &mockmanagers.MockMealPlanningManager{}
+		mmpm.On(reflection.GetMethodName(mmpm.ReadMealPlan), testutils.ContextMatcher, exampleMealPlan.ID, mock.AnythingOfType("string")).Return(exampleMealPlan, nil)
+		mmpm.On(reflection.GetMethodName(mmpm.ListMealPlanActivitiesAfterCursor), testutils.ContextMatcher, exampleMealPlan.ID, uint64(0), mealPlanWatchBatchSize).Return([]*mealplanning.MealPlanActivity{}, nil).Once().Run(func(mock.Arguments) {
+			notifications <- struct{}{}
+		})
+		mmpm.On(reflection.GetMethodName(mmpm.ListMealPlanActivitiesAfterCursor), testutils.ContextMatcher, exampleMealPlan.ID, uint64(0), mealPlanWatchBatchSize).Return([]*mealplanning.MealPlanActivity{voteActivity}, nil).Once()
+		s.mealPlanningManager = mmpm
+
+		stream := newFakeServerStream[mealplanninggrpc.WatchMealPlanResponse](t, 1)
+
+		require.NoError(t, s.WatchMealPlan(&mealplanninggrpc.WatchMealPlanRequest{MealPlanId: exampleMealPlan.ID}, stream))
+
+		require.Len(t, stream.sent, 1)
+		assert.Equal(t, "7", stream.sent[0].Cursor)
+
+		mock.AssertExpectationsForObjects(t, mmpm, notifier)
+	})
+
+	T.Run("with invalid cursor", func(t *testing.T) {
+		t.Parallel()
+
+		exampleMealPlan := mealplanningfakes.BuildFakeMealPlan()
+		exampleCursor := "not a cursor"
+
+		s := buildServiceImplForMealPlanningTest(t)
+
+		mmpm := &mockmanagers.MockMealPlanningManager{}
+		mmpm.On(reflection.GetMethodName(mmpm.ReadMealPlan), testutils.ContextMatcher, exampleMealPlan.ID, mock.AnythingOfType("string")).Return(exampleMealPlan, nil)
+		s.mealPlanningManager = mmpm
+
+		stream := newFakeServerStream[mealplanninggrpc.WatchMealPlanResponse](t, 1)
+
+		err := s.WatchMealPlan(&mealplanninggrpc.WatchMealPlanRequest{MealPlanId: exampleMealPlan.ID, Cursor: &exampleCursor}, stream)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+		assert.Empty(t, stream.sent)
+
+		mock.AssertExpectationsForObjects(t, mmpm)
+	})
+
+	T.Run("with meal plan outside the active account", func(t *testing.T) {
+		t.Parallel()
+
+		exampleMealPlanID := mealplanningfakes.BuildFakeID()
+
+		s := buildServiceImplForMealPlanningTest(t)
+
+		mmpm := &mockmanagers.MockMealPlanningManager{}
+		mmpm.On(reflection.GetMethodName(mmpm.ReadMealPlan), testutils.ContextMatcher, exampleMealPlanID, mock.AnythingOfType("string")).Return((*mealplanning.MealPlan)(nil), errors.New("blah"))
+		s.mealPlanningManager = mmpm
+
+		stream := newFakeServerStream[mealplanninggrpc.WatchMealPlanResponse](t, 1)
+
+		assert.Error(t, s.WatchMealPlan(&mealplanninggrpc.WatchMealPlanRequest{MealPlanId: exampleMealPlanID}, stream))
+		assert.Empty(t, stream.sent)
+
+		mock.AssertExpectationsForObjects(t, mmpm)
+	})
+
+	T.Run("with error fetching activity", func(t *testing.T) {
+		t.Parallel()
+
+		exampleMealPlan := mealplanningfakes.BuildFakeMealPlan()
+
+		s := buildServiceImplForMealPlanningTest(t)
+
+		mmpm := &mockmanagers.MockMealPlanningManager{}
+		mmpm.On(reflection.GetMethodName(mmpm.ReadMealPlan), testutils.ContextMatcher, exampleMealPlan.ID, mock.AnythingOfType("string")).Return(exampleMealPlan, nil)
+		mmpm.On(reflection.GetMethodName(mmpm.ListMealPlanActivitiesAfterCursor), testutils.ContextMatcher, exampleMealPlan.ID, uint64(0), mealPlanWatchBatchSize).Return([]*mealplanning.MealPlanActivity(nil), errors.New("blah"))
+		s.mealPlanningManager = mmpm
+
+		notifier, _ := buildMealPlanActivityNotifierForTest(exampleMealPlan.ID)
+		s.mealPlanActivityNotifier = notifier
+
+		stream := newFakeServerStream[mealplanninggrpc.WatchMealPlanResponse](t, 1)
+
+		assert.Error(t, s.WatchMealPlan(&mealplanninggrpc.WatchMealPlanRequest{MealPlanId: exampleMealPlan.ID}, stream))
+
+		mock.AssertExpectationsForObjects(t, mmpm, notifier)
+	})
+}
+
+func TestServiceImpl_WatchMealPlanTasks(T *testing.T) {
+	T.Parallel()
+
+	T.Run("resuming from a cursor", func(t *testing.T) {
+		t.Parallel()
+
+		exampleMealPlan := mealplanningfakes.BuildFakeMealPlan()
+		exampleTask := mealplanningfakes.BuildFakeMealPlanTask()
+		exampleCursor := "41"
+
+		taskActivity := mealplanningfakes.BuildFakeMealPlanActivity()
+		taskActivity.BelongsToMealPlan = exampleMealPlan.ID
+		taskActivity.MealPlanTaskID = exampleTask.ID
+		taskActivity.Sequence = 42
+
+		s := buildServiceImplForMealPlanningTest(t)
+
+		mmpm := &mockmanagers.MockMealPlanningManager{}
+		mmpm.On(reflection.GetMethodName(mmpm.ReadMealPlan), testutils.ContextMatcher, exampleMealPlan.ID, mock.AnythingOfType("string")).Return(exampleMealPlan, nil)
+		mmpm.On(reflection.GetMethodName(mmpm.ListMealPlanActivitiesAfterCursor), testutils.ContextMatcher, exampleMealPlan.ID, uint64(41), mealPlanWatchBatchSize).Return([]*mealplanning.MealPlanActivity{taskActivity}, nil).Once()
+		mmpm.On(reflection.GetMethodName(mmpm.ReadMealPlanTask), testutils.ContextMatcher, exampleMealPlan.ID, exampleTask.ID).Return(exampleTask, nil)
+		s.mealPlanningManager = mmpm
+
+		notifier, _ := buildMealPlanActivityNotifierForTest(exampleMealPlan.ID)
+		s.mealPlanActivityNotifier = notifier
+
+		stream := newFakeServerStream[mealplanninggrpc.WatchMealPlanTasksResponse](t, 1)
+
+		require.NoError(t, s.WatchMealPlanTasks(&mealplanninggrpc.WatchMealPlanTasksRequest{
+			MealPlanId: exampleMealPlan.ID,
+			Cursor:     &exampleCursor,
+		}, stream))
+
+		require.Len(t, stream.sent, 1)
+		assert.Equal(t, "42", stream.sent[0].Cursor)
+		require.NotNil(t, stream.sent[0].Task)
+		assert.Equal(t, exampleTask.ID, stream.sent[0].Task.Id)
+
+		mock.AssertExpectationsForObjects(t, mmpm, notifier)
+	})
+}
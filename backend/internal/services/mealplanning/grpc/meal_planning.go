@@ -499,6 +499,7 @@ func (s *serviceImpl) CreateMealPlanOption(ctx context.Context, request *mealpla
 	}, span, s.logger)
 
 	input := converters.ConvertGRPCMealPlanOptionCreationRequestInputToMealPlanOptionCreationRequestInput(request.Input)
+	input.MealPlanID = request.MealPlanId
 
 	created, err := s.mealPlanningManager.CreateMealPlanOptionWithEventID(ctx, request.MealPlanEventId, input)
 	if err != nil {
@@ -529,6 +530,8 @@ func (s *serviceImpl) CreateMealPlanOptionVote(ctx context.Context, request *mea
 	}
 
 	input := converters.ConvertGRPCMealPlanOptionVoteCreationRequestInputToMealPlanOptionVoteCreationRequestInput(request.Input)
+	input.MealPlanID = request.MealPlanId
+	input.MealPlanEventID = request.MealPlanEventId
 	for i := range input.Votes {
 		input.Votes[i].ByUser = sessionContextData.GetUserID()
 	}
@@ -560,6 +563,7 @@ func (s *serviceImpl) CreateMealPlanTask(ctx context.Context, request *mealplann
 	}, span, s.logger)
 
 	input := converters.ConvertGRPCMealPlanTaskCreationRequestInputToMealPlanTaskCreationRequestInput(request.Input)
+	input.MealPlanID = request.MealPlanId
 
 	created, err := s.mealPlanningManager.CreateMealPlanTask(ctx, input)
 	if err != nil {
@@ -607,6 +611,39 @@ func (s *serviceImpl) CreateUserIngredientPreference(ctx context.Context, reques
 	return x, nil
 }
 
+func (s *serviceImpl) CompleteMealPlanOption(ctx context.Context, request *mealplanningsvc.CompleteMealPlanOptionRequest) (*mealplanningsvc.CompleteMealPlanOptionResponse, error) {
+	ctx, span := s.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := observability.ObserveValues(map[string]any{
+		mealplanningkeys.MealPlanIDKey:       request.MealPlanId,
+		mealplanningkeys.MealPlanEventIDKey:  request.MealPlanEventId,
+		mealplanningkeys.MealPlanOptionIDKey: request.MealPlanOptionId,
+	}, span, s.logger)
+
+	sessionContextData, err := s.sessionContextDataFetcher(ctx)
+	if err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.Unauthenticated, "failed to get session context data")
+	}
+
+	consumed, err := s.mealPlanningManager.CompleteMealPlanOption(ctx, request.MealPlanId, request.MealPlanEventId, request.MealPlanOptionId, sessionContextData.GetActiveAccountID())
+	if err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.Internal, "failed to complete meal plan option")
+	}
+
+	x := &mealplanningsvc.CompleteMealPlanOptionResponse{
+		ResponseDetails: &types.ResponseDetails{
+			TraceId: span.SpanContext().TraceID().String(),
+		},
+	}
+
+	for _, pantryItem := range consumed {
+		x.Consumed = append(x.Consumed, converters.ConvertPantryItemToGRPCPantryItem(pantryItem))
+	}
+
+	return x, nil
+}
+
 func (s *serviceImpl) FinalizeMealPlan(ctx context.Context, request *mealplanningsvc.FinalizeMealPlanRequest) (*mealplanningsvc.FinalizeMealPlanResponse, error) {
 	ctx, span := s.tracer.StartSpan(ctx)
 	defer span.End()
@@ -635,6 +672,87 @@ func (s *serviceImpl) FinalizeMealPlan(ctx context.Context, request *mealplannin
 	return x, nil
 }
 
+func (s *serviceImpl) GetCookTimelineForMealPlanOption(ctx context.Context, request *mealplanningsvc.GetCookTimelineForMealPlanOptionRequest) (*mealplanningsvc.GetCookTimelineForMealPlanOptionResponse, error) {
+	ctx, span := s.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := observability.ObserveValues(map[string]any{
+		mealplanningkeys.MealPlanIDKey:       request.MealPlanId,
+		mealplanningkeys.MealPlanEventIDKey:  request.MealPlanEventId,
+		mealplanningkeys.MealPlanOptionIDKey: request.MealPlanOptionId,
+	}, span, s.logger)
+
+	input := converters.ConvertGRPCCookTimelineRequestInputToCookTimelineRequestInput(request.Input)
+	if err := input.ValidateWithContext(ctx); err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.InvalidArgument, "failed to validate cook timeline request input")
+	}
+
+	timeline, err := s.mealPlanningManager.MealPlanOptionCookTimeline(ctx, request.MealPlanId, request.MealPlanEventId, request.MealPlanOptionId, input)
+	if err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.Internal, "failed to schedule cook timeline")
+	}
+
+	x := &mealplanningsvc.GetCookTimelineForMealPlanOptionResponse{
+		ResponseDetails: &types.ResponseDetails{
+			TraceId: span.SpanContext().TraceID().String(),
+		},
+		Result: converters.ConvertCookTimelineToGRPCCookTimeline(timeline),
+	}
+
+	return x, nil
+}
+
+func (s *serviceImpl) GetNutritionForMeal(ctx context.Context, request *mealplanningsvc.GetNutritionForMealRequest) (*mealplanningsvc.GetNutritionForMealResponse, error) {
+	ctx, span := s.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := observability.ObserveValues(map[string]any{
+		mealplanningkeys.MealIDKey: request.MealId,
+	}, span, s.logger)
+
+	rollup, err := s.mealPlanningManager.MealNutrition(ctx, request.MealId, request.GetScale())
+	if err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.Internal, "failed to calculate meal nutrition")
+	}
+
+	x := &mealplanningsvc.GetNutritionForMealResponse{
+		ResponseDetails: &types.ResponseDetails{
+			TraceId: span.SpanContext().TraceID().String(),
+		},
+		Result: converters.ConvertNutritionRollupToGRPCNutritionRollup(rollup),
+	}
+
+	return x, nil
+}
+
+func (s *serviceImpl) GetNutritionForMealPlan(ctx context.Context, request *mealplanningsvc.GetNutritionForMealPlanRequest) (*mealplanningsvc.GetNutritionForMealPlanResponse, error) {
+	ctx, span := s.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := observability.ObserveValues(map[string]any{
+		mealplanningkeys.MealPlanIDKey: request.MealPlanId,
+	}, span, s.logger)
+
+	sessionContextData, err := s.sessionContextDataFetcher(ctx)
+	if err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.Unauthenticated, "failed to get session context data")
+	}
+
+	rollup, err := s.mealPlanningManager.MealPlanNutrition(ctx, request.MealPlanId, sessionContextData.GetActiveAccountID())
+	if err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.Internal, "failed to calculate meal plan nutrition")
+	}
+
+	x := &mealplanningsvc.GetNutritionForMealPlanResponse{
+		ResponseDetails: &types.ResponseDetails{
+			TraceId: span.SpanContext().TraceID().String(),
+		},
+		Result: converters.ConvertMealPlanNutritionRollupToGRPCMealPlanNutritionRollup(rollup),
+	}
+
+	return x, nil
+}
+
 func (s *serviceImpl) GetMermaidDiagramForMeal(ctx context.Context, request *mealplanningsvc.GetMermaidDiagramForMealRequest) (*mealplanningsvc.GetMermaidDiagramForMealResponse, error) {
 	ctx, span := s.tracer.StartSpan(ctx)
 	defer span.End()
@@ -770,6 +888,30 @@ func (s *serviceImpl) GetMealPlanEvent(ctx context.Context, request *mealplannin
 	return x, nil
 }
 
+func (s *serviceImpl) GetMealPlanEventTallyReport(ctx context.Context, request *mealplanningsvc.GetMealPlanEventTallyReportRequest) (*mealplanningsvc.GetMealPlanEventTallyReportResponse, error) {
+	ctx, span := s.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := observability.ObserveValues(map[string]any{
+		mealplanningkeys.MealPlanIDKey:      request.MealPlanId,
+		mealplanningkeys.MealPlanEventIDKey: request.MealPlanEventId,
+	}, span, s.logger)
+
+	report, err := s.mealPlanningManager.ReadMealPlanEventTallyReport(ctx, request.MealPlanId, request.MealPlanEventId)
+	if err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.Internal, "failed to read meal plan event tally report")
+	}
+
+	x := &mealplanningsvc.GetMealPlanEventTallyReportResponse{
+		ResponseDetails: &types.ResponseDetails{
+			TraceId: span.SpanContext().TraceID().String(),
+		},
+		Result: converters.ConvertMealPlanEventTallyReportToGRPCMealPlanEventTallyReport(report),
+	}
+
+	return x, nil
+}
+
 func (s *serviceImpl) GetMealPlanEvents(ctx context.Context, request *mealplanningsvc.GetMealPlanEventsRequest) (*mealplanningsvc.GetMealPlanEventsResponse, error) {
 	ctx, span := s.tracer.StartSpan(ctx)
 	defer span.End()
@@ -1511,6 +1653,7 @@ func (s *serviceImpl) UpdateMealPlanTaskStatus(ctx context.Context, request *mea
 
 	input := converters.ConvertGRPCMealPlanTaskStatusChangeRequestInputToMealPlanTaskStatusChangeRequestInput(request.Input)
 	input.MealPlanTaskID = request.MealPlanTaskId
+	input.MealPlanID = request.MealPlanId
 
 	if err := s.mealPlanningManager.MealPlanTaskStatusChange(ctx, input); err != nil {
 		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.Internal, "failed to update meal plan task status")
@@ -1745,3 +1888,158 @@ func (s *serviceImpl) ArchiveAccountInstrumentOwnership(ctx context.Context, req
 
 	return x, nil
 }
+
+func (s *serviceImpl) CreatePantryItem(ctx context.Context, request *mealplanningsvc.CreatePantryItemRequest) (*mealplanningsvc.CreatePantryItemResponse, error) {
+	ctx, span := s.tracer.StartSpan(ctx)
+	defer span.End()
+
+	sessionContextData, err := s.sessionContextDataFetcher(ctx)
+	if err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, s.logger, span, codes.Unauthenticated, "failed to fetch session context data")
+	}
+
+	logger := observability.ObserveValues(nil, span, s.logger)
+
+	input := converters.ConvertGRPCPantryItemCreationRequestInputToPantryItemCreationRequestInput(request.Input)
+	if err = input.ValidateWithContext(ctx); err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.InvalidArgument, "failed to validate pantry item creation request input")
+	}
+
+	created, err := s.mealPlanningManager.CreatePantryItem(ctx, sessionContextData.GetActiveAccountID(), input)
+	if err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.Internal, "failed to create pantry item")
+	}
+
+	x := &mealplanningsvc.CreatePantryItemResponse{
+		ResponseDetails: &types.ResponseDetails{
+			TraceId: span.SpanContext().TraceID().String(),
+		},
+		Created: converters.ConvertPantryItemToGRPCPantryItem(created),
+	}
+
+	return x, nil
+}
+
+func (s *serviceImpl) GetPantryItem(ctx context.Context, request *mealplanningsvc.GetPantryItemRequest) (*mealplanningsvc.GetPantryItemResponse, error) {
+	ctx, span := s.tracer.StartSpan(ctx)
+	defer span.End()
+
+	sessionContextData, err := s.sessionContextDataFetcher(ctx)
+	if err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, s.logger, span, codes.Unauthenticated, "failed to fetch session context data")
+	}
+
+	logger := observability.ObserveValues(map[string]any{
+		mealplanningkeys.PantryItemIDKey: request.PantryItemId,
+	}, span, s.logger)
+
+	result, err := s.mealPlanningManager.ReadPantryItem(ctx, sessionContextData.GetActiveAccountID(), request.PantryItemId)
+	if err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.Internal, "failed to fetch pantry item")
+	}
+
+	x := &mealplanningsvc.GetPantryItemResponse{
+		ResponseDetails: &types.ResponseDetails{
+			TraceId: span.SpanContext().TraceID().String(),
+		},
+		Result: converters.ConvertPantryItemToGRPCPantryItem(result),
+	}
+
+	return x, nil
+}
+
+func (s *serviceImpl) GetPantryItems(ctx context.Context, request *mealplanningsvc.GetPantryItemsRequest) (*mealplanningsvc.GetPantryItemsResponse, error) {
+	ctx, span := s.tracer.StartSpan(ctx)
+	defer span.End()
+
+	sessionContextData, err := s.sessionContextDataFetcher(ctx)
+	if err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, s.logger, span, codes.Unauthenticated, "failed to fetch session context data")
+	}
+
+	filter := grpcconverters.ConvertGRPCQueryFilterToQueryFilter(request.Filter)
+
+	logger := observability.ObserveValues(nil, span, s.logger)
+
+	results, err := s.mealPlanningManager.ListPantryItems(ctx, sessionContextData.GetActiveAccountID(), filter)
+	if err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.Internal, "failed to list pantry items")
+	}
+
+	x := &mealplanningsvc.GetPantryItemsResponse{
+		ResponseDetails: &types.ResponseDetails{
+			TraceId: span.SpanContext().TraceID().String(),
+		},
+		Pagination: grpcconverters.ConvertPaginationToGRPCPagination(results.Pagination, filter),
+	}
+
+	for _, result := range results.Data {
+		x.Results = append(x.Results, converters.ConvertPantryItemToGRPCPantryItem(result))
+	}
+
+	return x, nil
+}
+
+func (s *serviceImpl) UpdatePantryItem(ctx context.Context, request *mealplanningsvc.UpdatePantryItemRequest) (*mealplanningsvc.UpdatePantryItemResponse, error) {
+	ctx, span := s.tracer.StartSpan(ctx)
+	defer span.End()
+
+	sessionContextData, err := s.sessionContextDataFetcher(ctx)
+	if err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, s.logger, span, codes.Unauthenticated, "failed to fetch session context data")
+	}
+
+	logger := observability.ObserveValues(map[string]any{
+		mealplanningkeys.PantryItemIDKey: request.PantryItemId,
+	}, span, s.logger)
+
+	input := converters.ConvertGRPCPantryItemUpdateRequestInputToPantryItemUpdateRequestInput(request.Input)
+	if err = input.ValidateWithContext(ctx); err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.InvalidArgument, "failed to validate pantry item update request input")
+	}
+
+	accountID := sessionContextData.GetActiveAccountID()
+	if err = s.mealPlanningManager.UpdatePantryItem(ctx, request.PantryItemId, accountID, input); err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.Internal, "failed to update pantry item")
+	}
+
+	updated, err := s.mealPlanningManager.ReadPantryItem(ctx, accountID, request.PantryItemId)
+	if err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.Internal, "failed to fetch updated pantry item")
+	}
+
+	x := &mealplanningsvc.UpdatePantryItemResponse{
+		ResponseDetails: &types.ResponseDetails{
+			TraceId: span.SpanContext().TraceID().String(),
+		},
+		Updated: converters.ConvertPantryItemToGRPCPantryItem(updated),
+	}
+
+	return x, nil
+}
+
+func (s *serviceImpl) ArchivePantryItem(ctx context.Context, request *mealplanningsvc.ArchivePantryItemRequest) (*mealplanningsvc.ArchivePantryItemResponse, error) {
+	ctx, span := s.tracer.StartSpan(ctx)
+	defer span.End()
+
+	sessionContextData, err := s.sessionContextDataFetcher(ctx)
+	if err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, s.logger, span, codes.Unauthenticated, "failed to fetch session context data")
+	}
+
+	logger := observability.ObserveValues(map[string]any{
+		mealplanningkeys.PantryItemIDKey: request.PantryItemId,
+	}, span, s.logger)
+
+	if err = s.mealPlanningManager.ArchivePantryItem(ctx, sessionContextData.GetActiveAccountID(), request.PantryItemId); err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.Internal, "failed to archive pantry item")
+	}
+
+	x := &mealplanningsvc.ArchivePantryItemResponse{
+		ResponseDetails: &types.ResponseDetails{
+			TraceId: span.SpanContext().TraceID().String(),
+		},
+	}
+
+	return x, nil
+}
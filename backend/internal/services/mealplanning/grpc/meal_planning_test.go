@@ -9,6 +9,7 @@
 	mealplanningfakes "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/fakes"
 	mockmanagers "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/managers/mock"
 	mealplanninggrpc "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/grpc/generated/services/mealplanning"
+	converters "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/services/mealplanning/grpc/converters"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/testutils"
 
 	"github.com/primandproper/platform/database/filtering"
@@ -771,6 +772,147 @@ func TestServiceImpl_FinalizeMealPlan(T *testing.T) {
 	})
 }
 
+func TestServiceImpl_GetCookTimelineForMealPlanOption(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		exampleMealPlanID := mealplanningfakes.BuildFakeID()
+		exampleMealPlanEventID := mealplanningfakes.BuildFakeID()
+		exampleTimeline := mealplanningfakes.BuildFakeCookTimeline()
+		exampleInstrumentID := mealplanningfakes.BuildFakeID()
+
+		ctx := t.Context()
+		s := buildServiceImplForMealPlanningTest(t)
+
+		mmpm := &mockmanagers.MockMealPlanningManager{}
+		mmpm.On(
+			reflection.GetMethodName(mmpm.MealPlanOptionCookTimeline),
+			testutils.ContextMatcher,
+			exampleMealPlanID,
+			exampleMealPlanEventID,
+			exampleTimeline.MealPlanOptionID,
+			mock.MatchedBy(func(input *mealplanning.CookTimelineRequestInput) bool {
+				return input.CookCount == 2 && len(input.InstrumentConstraints) == 1 && input.InstrumentConstraints[0].ID == exampleInstrumentID
+			}),
+		).Return(exampleTimeline, nil)
+		s.mealPlanningManager = mmpm
+
+		result, err := s.GetCookTimelineForMealPlanOption(ctx, &mealplanninggrpc.GetCookTimelineForMealPlanOptionRequest{
+			MealPlanId:       exampleMealPlanID,
+			MealPlanEventId:  exampleMealPlanEventID,
+			MealPlanOptionId: exampleTimeline.MealPlanOptionID,
+			Input: &mealplanninggrpc.CookTimelineRequestInput{
+				CookCount: 2,
+				InstrumentConstraints: []*mealplanninggrpc.CookTimelineResourceConstraint{
+					{Id: exampleInstrumentID, Quantity: 1},
+				},
+			},
+		})
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+		assert.Equal(t, exampleTimeline.MermaidGanttChart, result.Result.MermaidGanttChart)
+		assert.Len(t, result.Result.Steps, len(exampleTimeline.Steps))
+
+		mock.AssertExpectationsForObjects(t, mmpm)
+	})
+
+	T.Run("with invalid input", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		s := buildServiceImplForMealPlanningTest(t)
+
+		result, err := s.GetCookTimelineForMealPlanOption(ctx, &mealplanninggrpc.GetCookTimelineForMealPlanOptionRequest{
+			MealPlanId:       mealplanningfakes.BuildFakeID(),
+			MealPlanEventId:  mealplanningfakes.BuildFakeID(),
+			MealPlanOptionId: mealplanningfakes.BuildFakeID(),
+			Input: &mealplanninggrpc.CookTimelineRequestInput{
+				VesselConstraints: []*mealplanninggrpc.CookTimelineResourceConstraint{
+					{Id: mealplanningfakes.BuildFakeID()},
+				},
+			},
+		})
+		assert.Error(t, err)
+		assert.Nil(t, result)
+	})
+}
+
+func TestServiceImpl_GetNutritionForMeal(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		exampleMealID := mealplanningfakes.BuildFakeID()
+		exampleRollup := mealplanningfakes.BuildFakeNutritionRollup()
+
+		ctx := t.Context()
+		s := buildServiceImplForMealPlanningTest(t)
+
+		mmpm := &mockmanagers.MockMealPlanningManager{}
+		mmpm.On(reflection.GetMethodName(mmpm.MealNutrition), testutils.ContextMatcher, exampleMealID, float32(2)).Return(exampleRollup, nil)
+		s.mealPlanningManager = mmpm
+
+		result, err := s.GetNutritionForMeal(ctx, &mealplanninggrpc.GetNutritionForMealRequest{MealId: exampleMealID, Scale: new(float32(2))})
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+		assert.Equal(t, exampleRollup.Totals.Calories, result.Result.Totals.Calories)
+		assert.Len(t, result.Result.MissingIngredients, len(exampleRollup.MissingIngredients))
+
+		mock.AssertExpectationsForObjects(t, mmpm)
+	})
+}
+
+func TestServiceImpl_GetNutritionForMealPlan(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		exampleAccountID := mealplanningfakes.BuildFakeID()
+		exampleRollup := mealplanningfakes.BuildFakeMealPlanNutritionRollup()
+
+		ctx := t.Context()
+		s := buildServiceImplForMealPlanningTest(t)
+		s.sessionContextDataFetcher = func(ctx context.Context) (*sessions.ContextData, error) {
+			return &sessions.ContextData{
+				ActiveAccountID: exampleAccountID,
+			}, nil
+		}
+
+		mmpm := &mockmanagers.MockMealPlanningManager{}
+		mmpm.On(reflection.GetMethodName(mmpm.MealPlanNutrition), testutils.ContextMatcher, exampleRollup.MealPlanID, exampleAccountID).Return(exampleRollup, nil)
+		s.mealPlanningManager = mmpm
+
+		result, err := s.GetNutritionForMealPlan(ctx, &mealplanninggrpc.GetNutritionForMealPlanRequest{MealPlanId: exampleRollup.MealPlanID})
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+		assert.Equal(t, exampleRollup.MealPlanID, result.Result.MealPlanId)
+		assert.Len(t, result.Result.Events, len(exampleRollup.Events))
+
+		mock.AssertExpectationsForObjects(t, mmpm)
+	})
+
+	T.Run("with unfinalized meal plan", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		s := buildServiceImplForMealPlanningTest(t)
+
+		mmpm := &mockmanagers.MockMealPlanningManager{}
+		mmpm.On(reflection.GetMethodName(mmpm.MealPlanNutrition), testutils.ContextMatcher, testutils.MatchType[string](), testutils.MatchType[string]()).Return((*mealplanning.MealPlanNutritionRollup)(nil), mealplanning.ErrMealPlanNotFinalized)
+		s.mealPlanningManager = mmpm
+
+		result, err := s.GetNutritionForMealPlan(ctx, &mealplanninggrpc.GetNutritionForMealPlanRequest{MealPlanId: mealplanningfakes.BuildFakeID()})
+		assert.Error(t, err)
+		assert.Nil(t, result)
+
+		mock.AssertExpectationsForObjects(t, mmpm)
+	})
+}
+
 func TestServiceImpl_GetMermaidDiagramForMeal(T *testing.T) {
 	T.Parallel()
 
@@ -909,6 +1051,35 @@ func TestServiceImpl_GetMealPlanEvent(T *testing.T) {
 	})
 }
 
+func TestServiceImpl_GetMealPlanEventTallyReport(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		exampleMealPlanID := mealplanningfakes.BuildFakeID()
+		exampleResult := mealplanningfakes.BuildFakeMealPlanEventTallyReport()
+
+		ctx := t.Context()
+		s := buildServiceImplForMealPlanningTest(t)
+
+		mmpm := &mockmanagers.MockMealPlanningManager{}
+		mmpm.On(reflection.GetMethodName(mmpm.ReadMealPlanEventTallyReport), testutils.ContextMatcher, exampleMealPlanID, exampleResult.BelongsToMealPlanEvent).Return(exampleResult, nil)
+		s.mealPlanningManager = mmpm
+
+		result, err := s.GetMealPlanEventTallyReport(ctx, &mealplanninggrpc.GetMealPlanEventTallyReportRequest{
+			MealPlanId:      exampleMealPlanID,
+			MealPlanEventId: exampleResult.BelongsToMealPlanEvent,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, exampleResult.ID, result.Result.Id)
+		assert.Equal(t, exampleResult.Winner, result.Result.Winner)
+		assert.Len(t, result.Result.PairwisePreferences, len(exampleResult.PairwisePreferences))
+
+		mock.AssertExpectationsForObjects(t, mmpm)
+	})
+}
+
 func TestServiceImpl_GetMealPlanEvents(T *testing.T) {
 	T.Parallel()
 
@@ -1665,3 +1836,247 @@ func TestServiceImpl_ArchiveAccountInstrumentOwnership(T *testing.T) {
 		mock.AssertExpectationsForObjects(t, mmpm)
 	})
 }
+
+func TestServiceImpl_CompleteMealPlanOption(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		s := buildServiceImplForMealPlanningTest(t)
+
+		exampleAccountID := mealplanningfakes.BuildFakeID()
+		exampleRequest := fake.BuildFakeForTest[mealplanninggrpc.CompleteMealPlanOptionRequest](t)
+		exampleConsumed := mealplanningfakes.BuildFakePantryItemsList().Data
+
+		mmpm := &mockmanagers.MockMealPlanningManager{}
+		mmpm.On(reflection.GetMethodName(mmpm.CompleteMealPlanOption), testutils.ContextMatcher, exampleRequest.MealPlanId, exampleRequest.MealPlanEventId, exampleRequest.MealPlanOptionId, exampleAccountID).Return(exampleConsumed, nil)
+		s.mealPlanningManager = mmpm
+
+		s.sessionContextDataFetcher = func(ctx context.Context) (*sessions.ContextData, error) {
+			return &sessions.ContextData{
+				ActiveAccountID: exampleAccountID,
+			}, nil
+		}
+
+		actual, err := s.CompleteMealPlanOption(ctx, exampleRequest)
+		assert.NoError(t, err)
+		assert.NotNil(t, actual)
+		assert.Len(t, actual.Consumed, len(exampleConsumed))
+
+		mock.AssertExpectationsForObjects(t, mmpm)
+	})
+
+	T.Run("with option that was not chosen", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		s := buildServiceImplForMealPlanningTest(t)
+
+		exampleAccountID := mealplanningfakes.BuildFakeID()
+		exampleRequest := fake.BuildFakeForTest[mealplanninggrpc.CompleteMealPlanOptionRequest](t)
+
+		mmpm := &mockmanagers.MockMealPlanningManager{}
+		mmpm.On(reflection.GetMethodName(mmpm.CompleteMealPlanOption), testutils.ContextMatcher, exampleRequest.MealPlanId, exampleRequest.MealPlanEventId, exampleRequest.MealPlanOptionId, exampleAccountID).Return([]*mealplanning.PantryItem(nil), mealplanning.ErrMealPlanOptionNotChosen)
+		s.mealPlanningManager = mmpm
+
+		s.sessionContextDataFetcher = func(ctx context.Context) (*sessions.ContextData, error) {
+			return &sessions.ContextData{
+				ActiveAccountID: exampleAccountID,
+			}, nil
+		}
+
+		actual, err := s.CompleteMealPlanOption(ctx, exampleRequest)
+		assert.Error(t, err)
+		assert.Nil(t, actual)
+
+		mock.AssertExpectationsForObjects(t, mmpm)
+	})
+}
+
+func TestServiceImpl_CreatePantryItem(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		s := buildServiceImplForMealPlanningTest(t)
+
+		exampleAccountID := mealplanningfakes.BuildFakeID()
+		exampleCreatedPantryItem := mealplanningfakes.BuildFakePantryItem()
+
+		mmpm := &mockmanagers.MockMealPlanningManager{}
+		mmpm.On(reflection.GetMethodName(mmpm.CreatePantryItem), testutils.ContextMatcher, exampleAccountID, testutils.MatchType[*mealplanning.PantryItemCreationRequestInput]()).Return(exampleCreatedPantryItem, nil)
+		s.mealPlanningManager = mmpm
+
+		s.sessionContextDataFetcher = func(ctx context.Context) (*sessions.ContextData, error) {
+			return &sessions.ContextData{
+				ActiveAccountID: exampleAccountID,
+			}, nil
+		}
+
+		exampleInput := &mealplanninggrpc.CreatePantryItemRequest{
+			Input: converters.ConvertPantryItemCreationRequestInputToGRPCPantryItemCreationRequestInput(mealplanningfakes.BuildFakePantryItemCreationRequestInput()),
+		}
+
+		actual, err := s.CreatePantryItem(ctx, exampleInput)
+		assert.NotNil(t, actual)
+		assert.NoError(t, err)
+		assert.Equal(t, exampleCreatedPantryItem.ID, actual.Created.Id)
+
+		mock.AssertExpectationsForObjects(t, mmpm)
+	})
+
+	T.Run("with invalid input", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		s := buildServiceImplForMealPlanningTest(t)
+
+		s.sessionContextDataFetcher = func(ctx context.Context) (*sessions.ContextData, error) {
+			return &sessions.ContextData{
+				ActiveAccountID: mealplanningfakes.BuildFakeID(),
+			}, nil
+		}
+
+		actual, err := s.CreatePantryItem(ctx, &mealplanninggrpc.CreatePantryItemRequest{
+			Input: &mealplanninggrpc.PantryItemCreationRequestInput{},
+		})
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+	})
+}
+
+func TestServiceImpl_GetPantryItem(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		exampleResult := mealplanningfakes.BuildFakePantryItem()
+		exampleAccountID := mealplanningfakes.BuildFakeID()
+
+		ctx := t.Context()
+		s := buildServiceImplForMealPlanningTest(t)
+
+		mmpm := &mockmanagers.MockMealPlanningManager{}
+		mmpm.On(reflection.GetMethodName(mmpm.ReadPantryItem), testutils.ContextMatcher, exampleAccountID, exampleResult.ID).Return(exampleResult, nil)
+		s.mealPlanningManager = mmpm
+
+		s.sessionContextDataFetcher = func(ctx context.Context) (*sessions.ContextData, error) {
+			return &sessions.ContextData{
+				ActiveAccountID: exampleAccountID,
+			}, nil
+		}
+
+		result, err := s.GetPantryItem(ctx, &mealplanninggrpc.GetPantryItemRequest{
+			PantryItemId: exampleResult.ID,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, exampleResult.ID, result.Result.Id)
+
+		mock.AssertExpectationsForObjects(t, mmpm)
+	})
+}
+
+func TestServiceImpl_GetPantryItems(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		exampleAccountID := mealplanningfakes.BuildFakeID()
+		exampleResult := mealplanningfakes.BuildFakePantryItemsList()
+
+		ctx := t.Context()
+		s := buildServiceImplForMealPlanningTest(t)
+
+		mmpm := &mockmanagers.MockMealPlanningManager{}
+		mmpm.On(reflection.GetMethodName(mmpm.ListPantryItems), testutils.ContextMatcher, exampleAccountID, testutils.QueryFilterMatcher).Return(exampleResult, nil)
+		s.mealPlanningManager = mmpm
+
+		s.sessionContextDataFetcher = func(ctx context.Context) (*sessions.ContextData, error) {
+			return &sessions.ContextData{
+				ActiveAccountID: exampleAccountID,
+			}, nil
+		}
+
+		result, err := s.GetPantryItems(ctx, &mealplanninggrpc.GetPantryItemsRequest{})
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+		assert.Len(t, result.Results, len(exampleResult.Data))
+
+		mock.AssertExpectationsForObjects(t, mmpm)
+	})
+}
+
+func TestServiceImpl_UpdatePantryItem(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		exampleAccountID := mealplanningfakes.BuildFakeID()
+		examplePantryItem := mealplanningfakes.BuildFakePantryItem()
+		exampleRequest := &mealplanninggrpc.UpdatePantryItemRequest{
+			PantryItemId: examplePantryItem.ID,
+			Input: &mealplanninggrpc.PantryItemUpdateRequestInput{
+				Quantity: new(float32(2)),
+			},
+		}
+
+		s := buildServiceImplForMealPlanningTest(t)
+
+		mmpm := &mockmanagers.MockMealPlanningManager{}
+		mmpm.On(reflection.GetMethodName(mmpm.UpdatePantryItem), testutils.ContextMatcher, examplePantryItem.ID, exampleAccountID, testutils.MatchType[*mealplanning.PantryItemUpdateRequestInput]()).Return(nil)
+		mmpm.On(reflection.GetMethodName(mmpm.ReadPantryItem), testutils.ContextMatcher, exampleAccountID, examplePantryItem.ID).Return(examplePantryItem, nil)
+		s.mealPlanningManager = mmpm
+
+		s.sessionContextDataFetcher = func(ctx context.Context) (*sessions.ContextData, error) {
+			return &sessions.ContextData{
+				ActiveAccountID: exampleAccountID,
+			}, nil
+		}
+
+		res, err := s.UpdatePantryItem(ctx, exampleRequest)
+		assert.NoError(t, err)
+		assert.Equal(t, examplePantryItem.ID, res.Updated.Id)
+
+		mock.AssertExpectationsForObjects(t, mmpm)
+	})
+}
+
+func TestServiceImpl_ArchivePantryItem(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		s := buildServiceImplForMealPlanningTest(t)
+
+		exampleAccountID := mealplanningfakes.BuildFakeID()
+		examplePantryItemID := mealplanningfakes.BuildFakeID()
+
+		mmpm := &mockmanagers.MockMealPlanningManager{}
+		mmpm.On(reflection.GetMethodName(mmpm.ArchivePantryItem), testutils.ContextMatcher, exampleAccountID, examplePantryItemID).Return(nil)
+		s.mealPlanningManager = mmpm
+
+		s.sessionContextDataFetcher = func(ctx context.Context) (*sessions.ContextData, error) {
+			return &sessions.ContextData{
+				ActiveAccountID: exampleAccountID,
+			}, nil
+		}
+
+		res, err := s.ArchivePantryItem(ctx, &mealplanninggrpc.ArchivePantryItemRequest{
+			PantryItemId: examplePantryItemID,
+		})
+		assert.NotNil(t, res)
+		assert.NoError(t, err)
+
+		mock.AssertExpectationsForObjects(t, mmpm)
+	})
+}
@@ -119,6 +119,12 @@ func ProvideMethodPermissions() MealPlanningMethodPermissions {
 		mealplanningsvc.MealPlanningService_UpdateValidIngredientMeasurementUnit_FullMethodName:                {authorization.UpdateValidIngredientMeasurementUnitsPermission},
 		mealplanningsvc.MealPlanningService_ArchiveValidIngredientMeasurementUnit_FullMethodName:               {authorization.ArchiveValidIngredientMeasurementUnitsPermission},
 
+		// ValidIngredientNutritionFacts
+		mealplanningsvc.MealPlanningService_GetValidIngredientNutritionFacts_FullMethodName:     {authorization.ReadValidIngredientNutritionFactsPermission},
+		mealplanningsvc.MealPlanningService_CreateValidIngredientNutritionFacts_FullMethodName:  {authorization.CreateValidIngredientNutritionFactsPermission},
+		mealplanningsvc.MealPlanningService_UpdateValidIngredientNutritionFacts_FullMethodName:  {authorization.UpdateValidIngredientNutritionFactsPermission},
+		mealplanningsvc.MealPlanningService_ArchiveValidIngredientNutritionFacts_FullMethodName: {authorization.ArchiveValidIngredientNutritionFactsPermission},
+
 		// ValidPreparationInstruments
 		mealplanningsvc.MealPlanningService_GetValidPreparationInstrument_FullMethodName:               {authorization.ReadValidPreparationInstrumentsPermission},
 		mealplanningsvc.MealPlanningService_CreateValidPreparationInstrument_FullMethodName:            {authorization.CreateValidPreparationInstrumentsPermission},
@@ -152,6 +158,13 @@ func ProvideMethodPermissions() MealPlanningMethodPermissions {
 		mealplanningsvc.MealPlanningService_UpdateAccountInstrumentOwnership_FullMethodName:           {authorization.UpdateAccountInstrumentOwnershipsPermission},
 		mealplanningsvc.MealPlanningService_ArchiveAccountInstrumentOwnership_FullMethodName:          {authorization.ArchiveAccountInstrumentOwnershipsPermission},
 
+		// PantryItems
+		mealplanningsvc.MealPlanningService_CreatePantryItem_FullMethodName:  {authorization.CreatePantryItemsPermission},
+		mealplanningsvc.MealPlanningService_GetPantryItem_FullMethodName:     {authorization.ReadPantryItemsPermission},
+		mealplanningsvc.MealPlanningService_GetPantryItems_FullMethodName:    {authorization.ReadPantryItemsPermission},
+		mealplanningsvc.MealPlanningService_UpdatePantryItem_FullMethodName:  {authorization.UpdatePantryItemsPermission},
+		mealplanningsvc.MealPlanningService_ArchivePantryItem_FullMethodName: {authorization.ArchivePantryItemsPermission},
+
 		// Recipes
 		mealplanningsvc.MealPlanningService_CreateRecipe_FullMethodName:                            {authorization.CreateRecipesPermission},
 		mealplanningsvc.MealPlanningService_GetRecipe_FullMethodName:                               {authorization.ReadRecipesPermission},
@@ -161,12 +174,21 @@ func ProvideMethodPermissions() MealPlanningMethodPermissions {
 		mealplanningsvc.MealPlanningService_ArchiveRecipe_FullMethodName:                           {authorization.ArchiveRecipesPermission},
 		mealplanningsvc.MealPlanningService_SearchForRecipes_FullMethodName:                        {authorization.ReadRecipesPermission},
 		mealplanningsvc.MealPlanningService_CloneRecipe_FullMethodName:                             {authorization.ReadRecipesPermission},
+		mealplanningsvc.MealPlanningService_ImportRecipe_FullMethodName:                            {authorization.CreateRecipesPermission},
 		mealplanningsvc.MealPlanningService_UploadRecipeImage_FullMethodName:                       {authorization.UpdateRecipesPermission},
 		mealplanningsvc.MealPlanningService_SearchForMealEligibleRecipes_FullMethodName:            {authorization.ReadRecipesPermission},
 		mealplanningsvc.MealPlanningService_SearchForRecipesWithInstrumentOwnership_FullMethodName: {authorization.ReadRecipesPermission},
 		mealplanningsvc.MealPlanningService_GetMermaidDiagramForMeal_FullMethodName:                {authorization.ReadMealsPermission},
+		mealplanningsvc.MealPlanningService_GetCookTimelineForMealPlanOption_FullMethodName:        {authorization.ReadMealPlanOptionsPermission},
+		mealplanningsvc.MealPlanningService_GetNutritionForMeal_FullMethodName:                     {authorization.ReadMealsPermission},
+		mealplanningsvc.MealPlanningService_GetNutritionForMealPlan_FullMethodName:                 {authorization.ReadMealPlansPermission},
+		mealplanningsvc.MealPlanningService_GetNutritionForRecipe_FullMethodName:                   {authorization.ReadRecipesPermission},
 		mealplanningsvc.MealPlanningService_GetMermaidDiagramForRecipe_FullMethodName:              {authorization.ReadRecipesPermission},
 		mealplanningsvc.MealPlanningService_EstimateRecipePrepTasks_FullMethodName:                 {authorization.ReadRecipesPermission},
+		mealplanningsvc.MealPlanningService_GetRecipeRevision_FullMethodName:                       {authorization.ReadRecipesPermission},
+		mealplanningsvc.MealPlanningService_GetRecipeRevisions_FullMethodName:                      {authorization.ReadRecipesPermission},
+		mealplanningsvc.MealPlanningService_DiffRecipeRevisions_FullMethodName:                     {authorization.ReadRecipesPermission},
+		mealplanningsvc.MealPlanningService_RestoreRecipeRevision_FullMethodName:                   {authorization.UpdateRecipesPermission},
 
 		// Meals
 		mealplanningsvc.MealPlanningService_CreateMeal_FullMethodName:      {authorization.CreateMealsPermission},
@@ -177,33 +199,46 @@ func ProvideMethodPermissions() MealPlanningMethodPermissions {
 		mealplanningsvc.MealPlanningService_UploadMealImage_FullMethodName: {authorization.UpdateMealsPermission},
 
 		// MealPlans
-		mealplanningsvc.MealPlanningService_CreateMealPlan_FullMethodName:         {authorization.CreateMealPlansPermission},
-		mealplanningsvc.MealPlanningService_GetMealPlan_FullMethodName:            {authorization.ReadMealPlansPermission},
-		mealplanningsvc.MealPlanningService_UpdateMealPlan_FullMethodName:         {authorization.UpdateMealPlansPermission},
-		mealplanningsvc.MealPlanningService_ArchiveMealPlan_FullMethodName:        {authorization.ArchiveMealPlansPermission},
-		mealplanningsvc.MealPlanningService_GetMealPlansForAccount_FullMethodName: {authorization.ReadMealPlansPermission},
-		mealplanningsvc.MealPlanningService_FinalizeMealPlan_FullMethodName:       {authorization.UpdateMealPlansPermission},
+		mealplanningsvc.MealPlanningService_CreateMealPlan_FullMethodName:             {authorization.CreateMealPlansPermission},
+		mealplanningsvc.MealPlanningService_GetMealPlan_FullMethodName:                {authorization.ReadMealPlansPermission},
+		mealplanningsvc.MealPlanningService_UpdateMealPlan_FullMethodName:             {authorization.UpdateMealPlansPermission},
+		mealplanningsvc.MealPlanningService_ArchiveMealPlan_FullMethodName:            {authorization.ArchiveMealPlansPermission},
+		mealplanningsvc.MealPlanningService_GetMealPlansForAccount_FullMethodName:     {authorization.ReadMealPlansPermission},
+		mealplanningsvc.MealPlanningService_FinalizeMealPlan_FullMethodName:           {authorization.UpdateMealPlansPermission},
+		mealplanningsvc.MealPlanningService_WatchMealPlan_FullMethodName:              {authorization.ReadMealPlansPermission},
+		mealplanningsvc.MealPlanningService_ExportMealPlanCalendar_FullMethodName:     {authorization.ReadMealPlansPermission},
+		mealplanningsvc.MealPlanningService_CreateMealPlanCalendarFeed_FullMethodName: {authorization.ReadMealPlansPermission},
+
+		// MealPlanTemplates
+		mealplanningsvc.MealPlanningService_CreateMealPlanTemplate_FullMethodName:  {authorization.CreateMealPlansPermission},
+		mealplanningsvc.MealPlanningService_GetMealPlanTemplate_FullMethodName:     {authorization.ReadMealPlansPermission},
+		mealplanningsvc.MealPlanningService_GetMealPlanTemplates_FullMethodName:    {authorization.ReadMealPlansPermission},
+		mealplanningsvc.MealPlanningService_UpdateMealPlanTemplate_FullMethodName:  {authorization.UpdateMealPlansPermission},
+		mealplanningsvc.MealPlanningService_ArchiveMealPlanTemplate_FullMethodName: {authorization.ArchiveMealPlansPermission},
 
 		// MealPlanOptions
-		mealplanningsvc.MealPlanningService_CreateMealPlanOption_FullMethodName:  {authorization.CreateMealPlanOptionsPermission},
-		mealplanningsvc.MealPlanningService_GetMealPlanOption_FullMethodName:     {authorization.ReadMealPlanOptionsPermission},
-		mealplanningsvc.MealPlanningService_GetMealPlanOptions_FullMethodName:    {authorization.ReadMealPlanOptionsPermission},
-		mealplanningsvc.MealPlanningService_UpdateMealPlanOption_FullMethodName:  {authorization.UpdateMealPlanOptionsPermission},
-		mealplanningsvc.MealPlanningService_ArchiveMealPlanOption_FullMethodName: {authorization.ArchiveMealPlanOptionsPermission},
+		mealplanningsvc.MealPlanningService_CreateMealPlanOption_FullMethodName:   {authorization.CreateMealPlanOptionsPermission},
+		mealplanningsvc.MealPlanningService_GetMealPlanOption_FullMethodName:      {authorization.ReadMealPlanOptionsPermission},
+		mealplanningsvc.MealPlanningService_GetMealPlanOptions_FullMethodName:     {authorization.ReadMealPlanOptionsPermission},
+		mealplanningsvc.MealPlanningService_UpdateMealPlanOption_FullMethodName:   {authorization.UpdateMealPlanOptionsPermission},
+		mealplanningsvc.MealPlanningService_ArchiveMealPlanOption_FullMethodName:  {authorization.ArchiveMealPlanOptionsPermission},
+		mealplanningsvc.MealPlanningService_CompleteMealPlanOption_FullMethodName: {authorization.UpdateMealPlanOptionsPermission},
 
 		// MealPlanEvents
-		mealplanningsvc.MealPlanningService_CreateMealPlanEvent_FullMethodName:  {authorization.CreateMealPlanEventsPermission},
-		mealplanningsvc.MealPlanningService_GetMealPlanEvent_FullMethodName:     {authorization.ReadMealPlanEventsPermission},
-		mealplanningsvc.MealPlanningService_GetMealPlanEvents_FullMethodName:    {authorization.ReadMealPlanEventsPermission},
-		mealplanningsvc.MealPlanningService_UpdateMealPlanEvent_FullMethodName:  {authorization.UpdateMealPlanEventsPermission},
-		mealplanningsvc.MealPlanningService_SwapMealPlanEvents_FullMethodName:   {authorization.UpdateMealPlanEventsPermission},
-		mealplanningsvc.MealPlanningService_ArchiveMealPlanEvent_FullMethodName: {authorization.ArchiveMealPlanEventsPermission},
+		mealplanningsvc.MealPlanningService_CreateMealPlanEvent_FullMethodName:         {authorization.CreateMealPlanEventsPermission},
+		mealplanningsvc.MealPlanningService_GetMealPlanEvent_FullMethodName:            {authorization.ReadMealPlanEventsPermission},
+		mealplanningsvc.MealPlanningService_GetMealPlanEvents_FullMethodName:           {authorization.ReadMealPlanEventsPermission},
+		mealplanningsvc.MealPlanningService_GetMealPlanEventTallyReport_FullMethodName: {authorization.ReadMealPlanEventsPermission},
+		mealplanningsvc.MealPlanningService_UpdateMealPlanEvent_FullMethodName:         {authorization.UpdateMealPlanEventsPermission},
+		mealplanningsvc.MealPlanningService_SwapMealPlanEvents_FullMethodName:          {authorization.UpdateMealPlanEventsPermission},
+		mealplanningsvc.MealPlanningService_ArchiveMealPlanEvent_FullMethodName:        {authorization.ArchiveMealPlanEventsPermission},
 
 		// MealPlanTasks
 		mealplanningsvc.MealPlanningService_CreateMealPlanTask_FullMethodName:       {authorization.CreateMealPlanTasksPermission},
 		mealplanningsvc.MealPlanningService_GetMealPlanTask_FullMethodName:          {authorization.ReadMealPlanTasksPermission},
 		mealplanningsvc.MealPlanningService_GetMealPlanTasks_FullMethodName:         {authorization.ReadMealPlanTasksPermission},
 		mealplanningsvc.MealPlanningService_UpdateMealPlanTaskStatus_FullMethodName: {authorization.UpdateMealPlanTasksPermission},
+		mealplanningsvc.MealPlanningService_WatchMealPlanTasks_FullMethodName:       {authorization.ReadMealPlanTasksPermission},
 
 		// MealPlanGroceryListItems
 		mealplanningsvc.MealPlanningService_GetMealPlanGroceryListItem_FullMethodName:             {authorization.ReadMealPlanGroceryListItemsPermission},
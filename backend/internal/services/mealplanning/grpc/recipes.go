@@ -312,6 +312,32 @@ func (s *serviceImpl) CloneRecipe(ctx context.Context, request *mealplanning.Clo
 	return x, nil
 }
 
+func (s *serviceImpl) ImportRecipe(ctx context.Context, request *mealplanning.ImportRecipeRequest) (*mealplanning.ImportRecipeResponse, error) {
+	ctx, span := s.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := s.logger.WithSpan(span).WithValue("format", request.Format)
+
+	if request.Content == "" {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(platformerrors.ErrEmptyInputParameter, logger, span, codes.InvalidArgument, "content is required")
+	}
+
+	result, err := s.mealPlanningManager.ImportRecipe(ctx, []byte(request.Content), request.Format)
+	if err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.Internal, "importing recipe")
+	}
+
+	x := &mealplanning.ImportRecipeResponse{
+		ResponseDetails: &types.ResponseDetails{
+			TraceId: span.SpanContext().TraceID().String(),
+		},
+		Draft:  converters.ConvertRecipeCreationRequestInputToGRPCRecipeCreationRequestInput(result.Recipe),
+		Report: converters.ConvertRecipeImportReportToGRPCRecipeImportReport(result.Report),
+	}
+
+	return x, nil
+}
+
 func (s *serviceImpl) CreateRecipe(ctx context.Context, request *mealplanning.CreateRecipeRequest) (*mealplanning.CreateRecipeResponse, error) {
 	ctx, span := s.tracer.StartSpan(ctx)
 	defer span.End()
@@ -579,6 +605,31 @@ func (s *serviceImpl) CreateRecipeStepVessel(ctx context.Context, request *mealp
 	return x, nil
 }
 
+func (s *serviceImpl) DiffRecipeRevisions(ctx context.Context, request *mealplanning.DiffRecipeRevisionsRequest) (*mealplanning.DiffRecipeRevisionsResponse, error) {
+	ctx, span := s.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := observability.ObserveValues(map[string]any{
+		mealplanningkeys.RecipeIDKey: request.RecipeId,
+		"from_revision":              request.FromRevision,
+		"to_revision":                request.ToRevision,
+	}, span, s.logger)
+
+	diff, err := s.mealPlanningManager.DiffRecipeRevisions(ctx, request.RecipeId, request.FromRevision, request.ToRevision)
+	if err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.Internal, "failed to diff recipe revisions")
+	}
+
+	x := &mealplanning.DiffRecipeRevisionsResponse{
+		ResponseDetails: &types.ResponseDetails{
+			TraceId: span.SpanContext().TraceID().String(),
+		},
+		Result: converters.ConvertRecipeRevisionDiffToGRPCRecipeRevisionDiff(diff),
+	}
+
+	return x, nil
+}
+
 func (s *serviceImpl) GetMermaidDiagramForRecipe(ctx context.Context, request *mealplanning.GetMermaidDiagramForRecipeRequest) (*mealplanning.GetMermaidDiagramForRecipeResponse, error) {
 	ctx, span := s.tracer.StartSpan(ctx)
 	defer span.End()
@@ -602,6 +653,29 @@ func (s *serviceImpl) GetMermaidDiagramForRecipe(ctx context.Context, request *m
 	return x, nil
 }
 
+func (s *serviceImpl) GetNutritionForRecipe(ctx context.Context, request *mealplanning.GetNutritionForRecipeRequest) (*mealplanning.GetNutritionForRecipeResponse, error) {
+	ctx, span := s.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := observability.ObserveValues(map[string]any{
+		mealplanningkeys.RecipeIDKey: request.RecipeId,
+	}, span, s.logger)
+
+	rollup, err := s.mealPlanningManager.RecipeNutrition(ctx, request.RecipeId, request.GetScale())
+	if err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.Internal, "failed to calculate recipe nutrition")
+	}
+
+	x := &mealplanning.GetNutritionForRecipeResponse{
+		ResponseDetails: &types.ResponseDetails{
+			TraceId: span.SpanContext().TraceID().String(),
+		},
+		Result: converters.ConvertNutritionRollupToGRPCNutritionRollup(rollup),
+	}
+
+	return x, nil
+}
+
 func (s *serviceImpl) GetRecipe(ctx context.Context, request *mealplanning.GetRecipeRequest) (*mealplanning.GetRecipeResponse, error) {
 	ctx, span := s.tracer.StartSpan(ctx)
 	defer span.End()
@@ -759,6 +833,60 @@ func (s *serviceImpl) GetRecipeRatingsForRecipe(ctx context.Context, request *me
 	return x, nil
 }
 
+func (s *serviceImpl) GetRecipeRevision(ctx context.Context, request *mealplanning.GetRecipeRevisionRequest) (*mealplanning.GetRecipeRevisionResponse, error) {
+	ctx, span := s.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := observability.ObserveValues(map[string]any{
+		mealplanningkeys.RecipeIDKey:             request.RecipeId,
+		mealplanningkeys.RecipeRevisionNumberKey: request.RevisionNumber,
+	}, span, s.logger)
+
+	recipeRevision, err := s.mealPlanningManager.ReadRecipeRevision(ctx, request.RecipeId, request.RevisionNumber)
+	if err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.Internal, "failed to get recipe revision")
+	}
+
+	x := &mealplanning.GetRecipeRevisionResponse{
+		ResponseDetails: &types.ResponseDetails{
+			TraceId: span.SpanContext().TraceID().String(),
+		},
+		Result: converters.ConvertRecipeRevisionToGRPCRecipeRevision(recipeRevision),
+	}
+
+	return x, nil
+}
+
+func (s *serviceImpl) GetRecipeRevisions(ctx context.Context, request *mealplanning.GetRecipeRevisionsRequest) (*mealplanning.GetRecipeRevisionsResponse, error) {
+	ctx, span := s.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := observability.ObserveValues(map[string]any{
+		mealplanningkeys.RecipeIDKey: request.RecipeId,
+	}, span, s.logger)
+
+	filter := grpcconverters.ConvertGRPCQueryFilterToQueryFilter(request.Filter)
+	tracing.AttachQueryFilterToSpan(span, filter)
+
+	recipeRevisions, err := s.mealPlanningManager.ListRecipeRevisions(ctx, request.RecipeId, filter)
+	if err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.Internal, "failed to get recipe revisions")
+	}
+
+	x := &mealplanning.GetRecipeRevisionsResponse{
+		ResponseDetails: &types.ResponseDetails{
+			TraceId: span.SpanContext().TraceID().String(),
+		},
+		Pagination: grpcconverters.ConvertPaginationToGRPCPagination(recipeRevisions.Pagination, filter),
+	}
+
+	for _, recipeRevision := range recipeRevisions.Data {
+		x.Results = append(x.Results, converters.ConvertRecipeRevisionToGRPCRecipeRevision(recipeRevision))
+	}
+
+	return x, nil
+}
+
 func (s *serviceImpl) GetRecipeStep(ctx context.Context, request *mealplanning.GetRecipeStepRequest) (*mealplanning.GetRecipeStepResponse, error) {
 	ctx, span := s.tracer.StartSpan(ctx)
 	defer span.End()
@@ -1324,6 +1452,34 @@ func (s *serviceImpl) GetRecipes(ctx context.Context, request *mealplanning.GetR
 	return x, nil
 }
 
+func (s *serviceImpl) RestoreRecipeRevision(ctx context.Context, request *mealplanning.RestoreRecipeRevisionRequest) (*mealplanning.RestoreRecipeRevisionResponse, error) {
+	ctx, span := s.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := observability.ObserveValues(map[string]any{
+		mealplanningkeys.RecipeIDKey:             request.RecipeId,
+		mealplanningkeys.RecipeRevisionNumberKey: request.RevisionNumber,
+	}, span, s.logger)
+
+	if _, err := s.verifyRecipeOwnership(ctx, request.RecipeId, logger, span); err != nil {
+		return nil, err
+	}
+
+	restored, err := s.mealPlanningManager.RestoreRecipeRevision(ctx, request.RecipeId, request.RevisionNumber)
+	if err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.Internal, "failed restoring recipe revision")
+	}
+
+	x := &mealplanning.RestoreRecipeRevisionResponse{
+		ResponseDetails: &types.ResponseDetails{
+			TraceId: span.SpanContext().TraceID().String(),
+		},
+		Restored: converters.ConvertRecipeToGRPCRecipe(restored),
+	}
+
+	return x, nil
+}
+
 func (s *serviceImpl) SearchForRecipes(ctx context.Context, request *mealplanning.SearchForRecipesRequest) (*mealplanning.SearchForRecipesResponse, error) {
 	ctx, span := s.tracer.StartSpan(ctx)
 	defer span.End()
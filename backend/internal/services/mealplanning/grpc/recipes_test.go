@@ -8,6 +8,7 @@
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
 	mealplanningfakes "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/fakes"
 	mockmanagers "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/managers/mock"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/recipeimport"
 	mealplanninggrpc "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/grpc/generated/services/mealplanning"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/testutils"
 
@@ -19,6 +20,8 @@
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func buildServiceImplForRecipesTest(t *testing.T) *serviceImpl {
@@ -1549,6 +1552,53 @@ func TestServiceImpl_CreateRecipeStepVessel(T *testing.T) {
 	})
 }
 
+func TestServiceImpl_DiffRecipeRevisions(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		exampleRecipeID := mealplanningfakes.BuildFakeID()
+		exampleResult := &mealplanning.RecipeRevisionDiff{
+			RecipeID:     exampleRecipeID,
+			FromRevision: 1,
+			ToRevision:   2,
+			Changes: []*mealplanning.RecipeFieldChange{
+				{Field: "name", From: "pancakes", To: "crepes"},
+			},
+			Steps: []*mealplanning.RecipeStepDiff{
+				{
+					StepID: mealplanningfakes.BuildFakeID(),
+					Change: mealplanning.RecipeDiffChangeModified,
+					Ingredients: []*mealplanning.RecipeComponentDiff{
+						{ID: mealplanningfakes.BuildFakeID(), Name: "milk", Change: mealplanning.RecipeDiffChangeAdded},
+					},
+				},
+			},
+		}
+
+		ctx := t.Context()
+		s := buildServiceImplForRecipesTest(t)
+
+		mrm := &mockmanagers.MockMealPlanningManager{}
+		mrm.On(reflection.GetMethodName(mrm.DiffRecipeRevisions), testutils.ContextMatcher, exampleRecipeID, uint32(1), uint32(2)).Return(exampleResult, nil)
+		s.mealPlanningManager = mrm
+
+		result, err := s.DiffRecipeRevisions(ctx, &mealplanninggrpc.DiffRecipeRevisionsRequest{
+			RecipeId:     exampleRecipeID,
+			FromRevision: 1,
+			ToRevision:   2,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, exampleRecipeID, result.Result.RecipeId)
+		assert.Len(t, result.Result.Changes, 1)
+		assert.Len(t, result.Result.Steps, 1)
+		assert.Len(t, result.Result.Steps[0].Ingredients, 1)
+
+		mock.AssertExpectationsForObjects(t, mrm)
+	})
+}
+
 func TestServiceImpl_GetMermaidDiagramForRecipe(T *testing.T) {
 	T.Parallel()
 
@@ -1574,6 +1624,31 @@ func TestServiceImpl_GetMermaidDiagramForRecipe(T *testing.T) {
 	})
 }
 
+func TestServiceImpl_GetNutritionForRecipe(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		s := buildServiceImplForRecipesTest(t)
+
+		exampleRecipeID := mealplanningfakes.BuildFakeID()
+		exampleRollup := mealplanningfakes.BuildFakeNutritionRollup()
+
+		mrm := &mockmanagers.MockMealPlanningManager{}
+		mrm.On(reflection.GetMethodName(mrm.RecipeNutrition), testutils.ContextMatcher, exampleRecipeID, float32(0)).Return(exampleRollup, nil)
+		s.mealPlanningManager = mrm
+
+		result, err := s.GetNutritionForRecipe(ctx, &mealplanninggrpc.GetNutritionForRecipeRequest{RecipeId: exampleRecipeID})
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+		assert.Equal(t, exampleRollup.Complete, result.Result.Complete)
+
+		mock.AssertExpectationsForObjects(t, mrm)
+	})
+}
+
 func TestServiceImpl_GetRecipe(T *testing.T) {
 	T.Parallel()
 
@@ -1728,6 +1803,58 @@ func TestServiceImpl_GetRecipeRatingsForRecipe(T *testing.T) {
 	})
 }
 
+func TestServiceImpl_GetRecipeRevision(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		exampleResult := mealplanningfakes.BuildFakeRecipeRevision()
+
+		ctx := t.Context()
+		s := buildServiceImplForRecipesTest(t)
+
+		mrm := &mockmanagers.MockMealPlanningManager{}
+		mrm.On(reflection.GetMethodName(mrm.ReadRecipeRevision), testutils.ContextMatcher, exampleResult.BelongsToRecipe, exampleResult.RevisionNumber).Return(exampleResult, nil)
+		s.mealPlanningManager = mrm
+
+		result, err := s.GetRecipeRevision(ctx, &mealplanninggrpc.GetRecipeRevisionRequest{
+			RecipeId:       exampleResult.BelongsToRecipe,
+			RevisionNumber: exampleResult.RevisionNumber,
+		})
+		assert.NoError(t, err)
+		assert.Equal(t, exampleResult.ID, result.Result.Id)
+		assert.Equal(t, exampleResult.Snapshot.ID, result.Result.Snapshot.Id)
+
+		mock.AssertExpectationsForObjects(t, mrm)
+	})
+}
+
+func TestServiceImpl_GetRecipeRevisions(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		exampleRecipeID := mealplanningfakes.BuildFakeID()
+		exampleResult := mealplanningfakes.BuildFakeRecipeRevisionsList()
+
+		ctx := t.Context()
+		s := buildServiceImplForRecipesTest(t)
+
+		mrm := &mockmanagers.MockMealPlanningManager{}
+		mrm.On(reflection.GetMethodName(mrm.ListRecipeRevisions), testutils.ContextMatcher, exampleRecipeID, testutils.QueryFilterMatcher).Return(exampleResult, nil)
+		s.mealPlanningManager = mrm
+
+		result, err := s.GetRecipeRevisions(ctx, &mealplanninggrpc.GetRecipeRevisionsRequest{RecipeId: exampleRecipeID})
+		assert.NoError(t, err)
+		assert.NotNil(t, result)
+		assert.Len(t, result.Results, len(exampleResult.Data))
+
+		mock.AssertExpectationsForObjects(t, mrm)
+	})
+}
+
 func TestServiceImpl_GetRecipeStep(T *testing.T) {
 	T.Parallel()
 
@@ -2088,6 +2215,69 @@ func TestServiceImpl_GetRecipes(T *testing.T) {
 	})
 }
 
+func TestServiceImpl_ImportRecipe(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		s := buildServiceImplForRecipesTest(t)
+
+		exampleContent := "Pancakes\n\nIngredients\n1 cup flour\n\nInstructions\n1. Whisk the flour."
+		expected := &recipeimport.Result{
+			Recipe: mealplanningfakes.BuildFakeRecipeCreationRequestInput(),
+			Report: &recipeimport.Report{
+				Unresolved: []*recipeimport.Resolution{{Kind: recipeimport.IngredientEntityKind, Text: "flour"}},
+			},
+		}
+
+		mrm := &mockmanagers.MockMealPlanningManager{}
+		mrm.On(reflection.GetMethodName(mrm.ImportRecipe), testutils.ContextMatcher, []byte(exampleContent), string(recipeimport.FormatText)).Return(expected, nil)
+		s.mealPlanningManager = mrm
+
+		actual, err := s.ImportRecipe(ctx, &mealplanninggrpc.ImportRecipeRequest{Content: exampleContent, Format: string(recipeimport.FormatText)})
+		assert.NoError(t, err)
+		assert.Equal(t, expected.Recipe.Name, actual.Draft.Name)
+		assert.Len(t, actual.Report.Unresolved, 1)
+
+		mock.AssertExpectationsForObjects(t, mrm)
+	})
+
+	T.Run("with empty content", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		s := buildServiceImplForRecipesTest(t)
+
+		mrm := &mockmanagers.MockMealPlanningManager{}
+		s.mealPlanningManager = mrm
+
+		actual, err := s.ImportRecipe(ctx, &mealplanninggrpc.ImportRecipeRequest{})
+		assert.Nil(t, actual)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		mock.AssertExpectationsForObjects(t, mrm)
+	})
+
+	T.Run("with error importing recipe", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		s := buildServiceImplForRecipesTest(t)
+
+		mrm := &mockmanagers.MockMealPlanningManager{}
+		mrm.On(reflection.GetMethodName(mrm.ImportRecipe), testutils.ContextMatcher, []byte("nothing"), "").Return((*recipeimport.Result)(nil), recipeimport.ErrNoRecipeFound)
+		s.mealPlanningManager = mrm
+
+		actual, err := s.ImportRecipe(ctx, &mealplanninggrpc.ImportRecipeRequest{Content: "nothing"})
+		assert.Nil(t, actual)
+		assert.Error(t, err)
+
+		mock.AssertExpectationsForObjects(t, mrm)
+	})
+}
+
 func TestServiceImpl_SearchForRecipes(T *testing.T) {
 	T.Parallel()
 
@@ -2170,6 +2360,68 @@ func TestServiceImpl_SearchForRecipesWithInstrumentOwnership(T *testing.T) {
 	})
 }
 
+func TestServiceImpl_RestoreRecipeRevision(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		exampleRequest := fake.BuildFakeForTest[mealplanninggrpc.RestoreRecipeRevisionRequest](t)
+		exampleResponse := mealplanningfakes.BuildFakeRecipe()
+		exampleUserID := mealplanningfakes.BuildFakeID()
+
+		s := buildServiceImplForRecipesTest(t)
+
+		s.sessionContextDataFetcher = func(ctx context.Context) (*sessions.ContextData, error) {
+			return &sessions.ContextData{
+				Requester: sessions.RequesterInfo{UserID: exampleUserID},
+			}, nil
+		}
+
+		exampleResponse.CreatedByUser = exampleUserID
+
+		mrm := &mockmanagers.MockMealPlanningManager{}
+		mrm.On(reflection.GetMethodName(mrm.ReadRecipe), testutils.ContextMatcher, exampleRequest.RecipeId).Return(exampleResponse, nil)
+		mrm.On(reflection.GetMethodName(mrm.RestoreRecipeRevision), testutils.ContextMatcher, exampleRequest.RecipeId, exampleRequest.RevisionNumber).Return(exampleResponse, nil)
+		s.mealPlanningManager = mrm
+
+		res, err := s.RestoreRecipeRevision(ctx, exampleRequest)
+		assert.NoError(t, err)
+		assert.Equal(t, exampleResponse.ID, res.Restored.Id)
+
+		mock.AssertExpectationsForObjects(t, mrm)
+	})
+
+	T.Run("returns permission denied for non-owner", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		s := buildServiceImplForRecipesTest(t)
+
+		exampleRequest := fake.BuildFakeForTest[mealplanninggrpc.RestoreRecipeRevisionRequest](t)
+		exampleUserID := mealplanningfakes.BuildFakeID()
+
+		s.sessionContextDataFetcher = func(ctx context.Context) (*sessions.ContextData, error) {
+			return &sessions.ContextData{
+				Requester: sessions.RequesterInfo{UserID: exampleUserID},
+			}, nil
+		}
+
+		exampleRecipe := &mealplanning.Recipe{ID: exampleRequest.RecipeId, CreatedByUser: mealplanningfakes.BuildFakeID()}
+
+		mrm := &mockmanagers.MockMealPlanningManager{}
+		mrm.On(reflection.GetMethodName(mrm.ReadRecipe), testutils.ContextMatcher, exampleRequest.RecipeId).Return(exampleRecipe, nil)
+		s.mealPlanningManager = mrm
+
+		res, err := s.RestoreRecipeRevision(ctx, exampleRequest)
+		assert.Nil(t, res)
+		assert.Error(t, err)
+
+		mock.AssertExpectationsForObjects(t, mrm)
+	})
+}
+
 func TestServiceImpl_UpdateRecipe(T *testing.T) {
 	T.Parallel()
 
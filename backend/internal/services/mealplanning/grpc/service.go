@@ -5,6 +5,8 @@
 
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/authentication/sessions"
 	commentsmanager "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/comments/manager"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/icalendar"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/managers"
 	uploadedmediamanager "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/uploadedmedia/manager"
 	mealplanningsvc "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/grpc/generated/services/mealplanning"
@@ -37,6 +39,8 @@
 		commentsManager                      commentsmanager.CommentsDataManager
 		uploadedMediaManager                 uploadedmediamanager.UploadedMediaManager
 		uploadManager                        uploads.UploadManager
+		calendarManager                      icalendar.MealPlanCalendarManager
+		mealPlanActivityNotifier             mealplanning.MealPlanActivityNotifier
 	}
 )
 
@@ -50,6 +54,8 @@ func NewService(
 	commentsManager commentsmanager.CommentsDataManager,
 	uploadedMediaManager uploadedmediamanager.UploadedMediaManager,
 	uploadManager uploads.UploadManager,
+	calendarManager icalendar.MealPlanCalendarManager,
+	mealPlanActivityNotifier mealplanning.MealPlanActivityNotifier,
 ) mealplanningsvc.MealPlanningServiceServer {
 	return &serviceImpl{
 		logger:                               logging.NewNamedLogger(logger, o11yName),
@@ -61,6 +67,8 @@ func NewService(
 		commentsManager:                      commentsManager,
 		uploadedMediaManager:                 uploadedMediaManager,
 		uploadManager:                        uploadManager,
+		calendarManager:                      calendarManager,
+		mealPlanActivityNotifier:             mealPlanActivityNotifier,
 		sessionContextDataFetcher:            sessions.FetchContextDataFromContext,
 	}
 }
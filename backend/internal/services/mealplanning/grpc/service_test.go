@@ -6,7 +6,9 @@
 
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/comments"
 	commentsmanager "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/comments/manager"
+	icalendarmock "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/icalendar/mock"
 	mockmanagers "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/managers/mock"
+	mealplanningmocks "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/mocks"
 	uploadedmediamock "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/uploadedmedia/mock"
 	mealplanningsvc "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/grpc/generated/services/mealplanning"
 	mealplanfinalizer "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/services/mealplanning/workers/meal_plan_finalizer"
@@ -60,6 +62,8 @@ func TestNewService(t *testing.T) {
 		commentsManager := &noopCommentsManager{}
 		uploadedMediaManager := &uploadedmediamock.Repository{}
 		uploadManager := &mockuploads.UploadManagerMock{}
+		calendarManager := &icalendarmock.MealPlanCalendarManager{}
+		mealPlanActivityNotifier := &mealplanningmocks.MealPlanActivityNotifier{}
 
 		service := NewService(
 			logger,
@@ -71,6 +75,8 @@ func TestNewService(t *testing.T) {
 			commentsManager,
 			uploadedMediaManager,
 			uploadManager,
+			calendarManager,
+			mealPlanActivityNotifier,
 		)
 
 		assert.NotNil(t, service)
@@ -86,6 +92,8 @@ func TestNewService(t *testing.T) {
 		assert.Equal(t, mealPlanGroceryListInitializerWorker, impl.mealPlanGroceryListInitializerWorker)
 		assert.Equal(t, mealPlanTaskCreatorWorker, impl.mealPlanTaskCreatorWorker)
 		assert.Equal(t, commentsManager, impl.commentsManager)
+		assert.Equal(t, calendarManager, impl.calendarManager)
+		assert.Equal(t, mealPlanActivityNotifier, impl.mealPlanActivityNotifier)
 		assert.NotNil(t, impl.sessionContextDataFetcher)
 	})
 }
@@ -63,6 +63,22 @@ func (s *serviceImpl) ArchiveValidIngredientMeasurementUnit(ctx context.Context,
 	return res, nil
 }
 
+func (s *serviceImpl) ArchiveValidIngredientNutritionFacts(ctx context.Context, request *mealplanning.ArchiveValidIngredientNutritionFactsRequest) (*mealplanning.ArchiveValidIngredientNutritionFactsResponse, error) {
+	ctx, span := s.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := s.logger.WithSpan(span).WithValue(mealplanningkeys.ValidIngredientNutritionFactsIDKey, request.ValidIngredientNutritionFactsId)
+	tracing.AttachToSpan(span, mealplanningkeys.ValidIngredientNutritionFactsIDKey, request.ValidIngredientNutritionFactsId)
+
+	if err := s.mealPlanningManager.ArchiveValidIngredientNutritionFacts(ctx, request.ValidIngredientNutritionFactsId); err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.Internal, "archiving valid ingredient nutrition facts")
+	}
+
+	res := &mealplanning.ArchiveValidIngredientNutritionFactsResponse{}
+
+	return res, nil
+}
+
 func (s *serviceImpl) ArchiveValidIngredientPreparation(ctx context.Context, request *mealplanning.ArchiveValidIngredientPreparationRequest) (*mealplanning.ArchiveValidIngredientPreparationResponse, error) {
 	ctx, span := s.tracer.StartSpan(ctx)
 	defer span.End()
@@ -296,6 +312,25 @@ func (s *serviceImpl) CreateValidIngredientMeasurementUnit(ctx context.Context,
 	return result, nil
 }
 
+func (s *serviceImpl) CreateValidIngredientNutritionFacts(ctx context.Context, request *mealplanning.CreateValidIngredientNutritionFactsRequest) (*mealplanning.CreateValidIngredientNutritionFactsResponse, error) {
+	ctx, span := s.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := s.logger.WithSpan(span)
+
+	created, err := s.mealPlanningManager.CreateValidIngredientNutritionFacts(ctx, mealplanningconverters.ConvertGRPCCreateValidIngredientNutritionFactsRequestToValidIngredientNutritionFactsCreationRequestInput(request.Input))
+	if err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.Internal, "creating valid ingredient nutrition facts")
+	}
+	tracing.AttachToSpan(span, mealplanningkeys.ValidIngredientNutritionFactsIDKey, created.ID)
+
+	result := &mealplanning.CreateValidIngredientNutritionFactsResponse{
+		Result: mealplanningconverters.ConvertValidIngredientNutritionFactsToGRPCValidIngredientNutritionFacts(created),
+	}
+
+	return result, nil
+}
+
 func (s *serviceImpl) CreateValidIngredientPreparation(ctx context.Context, request *mealplanning.CreateValidIngredientPreparationRequest) (*mealplanning.CreateValidIngredientPreparationResponse, error) {
 	ctx, span := s.tracer.StartSpan(ctx)
 	defer span.End()
@@ -734,6 +769,25 @@ func (s *serviceImpl) GetValidIngredientMeasurementUnitsByMeasurementUnit(ctx co
 	return res, nil
 }
 
+func (s *serviceImpl) GetValidIngredientNutritionFacts(ctx context.Context, request *mealplanning.GetValidIngredientNutritionFactsRequest) (*mealplanning.GetValidIngredientNutritionFactsResponse, error) {
+	ctx, span := s.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := s.logger.WithSpan(span).WithValue(mealplanningkeys.ValidIngredientIDKey, request.ValidIngredientId)
+	tracing.AttachToSpan(span, mealplanningkeys.ValidIngredientIDKey, request.ValidIngredientId)
+
+	x, err := s.mealPlanningManager.ReadValidIngredientNutritionFactsForIngredient(ctx, request.ValidIngredientId)
+	if err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.Internal, "fetching valid ingredient nutrition facts")
+	}
+
+	res := &mealplanning.GetValidIngredientNutritionFactsResponse{
+		Result: mealplanningconverters.ConvertValidIngredientNutritionFactsToGRPCValidIngredientNutritionFacts(x),
+	}
+
+	return res, nil
+}
+
 func (s *serviceImpl) GetValidIngredientPreparation(ctx context.Context, request *mealplanning.GetValidIngredientPreparationRequest) (*mealplanning.GetValidIngredientPreparationResponse, error) {
 	ctx, span := s.tracer.StartSpan(ctx)
 	defer span.End()
@@ -1834,6 +1888,27 @@ func (s *serviceImpl) UpdateValidIngredientMeasurementUnit(ctx context.Context,
 	return res, nil
 }
 
+func (s *serviceImpl) UpdateValidIngredientNutritionFacts(ctx context.Context, request *mealplanning.UpdateValidIngredientNutritionFactsRequest) (*mealplanning.UpdateValidIngredientNutritionFactsResponse, error) {
+	ctx, span := s.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := s.logger.WithSpan(span).WithValue(mealplanningkeys.ValidIngredientNutritionFactsIDKey, request.ValidIngredientNutritionFactsId)
+	tracing.AttachToSpan(span, mealplanningkeys.ValidIngredientNutritionFactsIDKey, request.ValidIngredientNutritionFactsId)
+
+	input := mealplanningconverters.ConvertGRPCValidIngredientNutritionFactsUpdateRequestInputToValidIngredientNutritionFactsUpdateRequestInput(request.Input)
+
+	updated, err := s.mealPlanningManager.UpdateValidIngredientNutritionFacts(ctx, request.ValidIngredientNutritionFactsId, input)
+	if err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.Internal, "updating valid ingredient nutrition facts")
+	}
+
+	res := &mealplanning.UpdateValidIngredientNutritionFactsResponse{
+		Result: mealplanningconverters.ConvertValidIngredientNutritionFactsToGRPCValidIngredientNutritionFacts(updated),
+	}
+
+	return res, nil
+}
+
 func (s *serviceImpl) UpdateValidIngredientPreparation(ctx context.Context, request *mealplanning.UpdateValidIngredientPreparationRequest) (*mealplanning.UpdateValidIngredientPreparationResponse, error) {
 	ctx, span := s.tracer.StartSpan(ctx)
 	defer span.End()
@@ -96,6 +96,29 @@ func TestServiceImpl_ArchiveValidIngredientMeasurementUnit(T *testing.T) {
 	})
 }
 
+func TestServiceImpl_ArchiveValidIngredientNutritionFacts(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		s := buildServiceImplForTest(t)
+
+		exampleValidIngredientNutritionFactsID := mealplanningfakes.BuildFakeID()
+
+		mvem := &mockmanagers.MockMealPlanningManager{}
+		mvem.On(reflection.GetMethodName(mvem.ArchiveValidIngredientNutritionFacts), testutils.ContextMatcher, exampleValidIngredientNutritionFactsID).Return(nil)
+		s.mealPlanningManager = mvem
+
+		res, err := s.ArchiveValidIngredientNutritionFacts(ctx, &mealplanninggrpc.ArchiveValidIngredientNutritionFactsRequest{ValidIngredientNutritionFactsId: exampleValidIngredientNutritionFactsID})
+		assert.NotNil(t, res)
+		assert.NoError(t, err)
+
+		mock.AssertExpectationsForObjects(t, mvem)
+	})
+}
+
 func TestServiceImpl_ArchiveValidIngredientPreparation(T *testing.T) {
 	T.Parallel()
 
@@ -422,6 +445,31 @@ func TestServiceImpl_CreateValidIngredientMeasurementUnit(T *testing.T) {
 	})
 }
 
+func TestServiceImpl_CreateValidIngredientNutritionFacts(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		s := buildServiceImplForTest(t)
+
+		exampleValidIngredientNutritionFacts := mealplanningfakes.BuildFakeValidIngredientNutritionFacts()
+
+		mvem := &mockmanagers.MockMealPlanningManager{}
+		mvem.On(reflection.GetMethodName(mvem.CreateValidIngredientNutritionFacts), testutils.ContextMatcher, testutils.MatchType[*mealplanning.ValidIngredientNutritionFactsCreationRequestInput]()).Return(exampleValidIngredientNutritionFacts, nil)
+		s.mealPlanningManager = mvem
+
+		exampleInput := fake.BuildFakeForTest[mealplanninggrpc.CreateValidIngredientNutritionFactsRequest](t)
+
+		actual, err := s.CreateValidIngredientNutritionFacts(ctx, exampleInput)
+		assert.NotNil(t, actual)
+		assert.NoError(t, err)
+
+		mock.AssertExpectationsForObjects(t, mvem)
+	})
+}
+
 func TestServiceImpl_CreateValidIngredientPreparation(T *testing.T) {
 	T.Parallel()
 
@@ -881,6 +929,30 @@ func TestServiceImpl_GetValidIngredientMeasurementUnit(T *testing.T) {
 	})
 }
 
+func TestServiceImpl_GetValidIngredientNutritionFacts(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		exampleResult := mealplanningfakes.BuildFakeValidIngredientNutritionFacts()
+
+		ctx := t.Context()
+		s := buildServiceImplForTest(t)
+
+		mvem := &mockmanagers.MockMealPlanningManager{}
+		mvem.On(reflection.GetMethodName(mvem.ReadValidIngredientNutritionFactsForIngredient), testutils.ContextMatcher, exampleResult.ValidIngredientID).Return(exampleResult, nil)
+		s.mealPlanningManager = mvem
+
+		result, err := s.GetValidIngredientNutritionFacts(ctx, &mealplanninggrpc.GetValidIngredientNutritionFactsRequest{ValidIngredientId: exampleResult.ValidIngredientID})
+		assert.NoError(t, err)
+		assert.Equal(t, exampleResult.ID, result.Result.Id)
+		assert.Equal(t, exampleResult.Nutrients.Calories, result.Result.Nutrients.Calories)
+
+		mock.AssertExpectationsForObjects(t, mvem)
+	})
+}
+
 func TestServiceImpl_GetValidIngredientMeasurementUnits(T *testing.T) {
 	T.Parallel()
 
@@ -2099,6 +2171,30 @@ func TestServiceImpl_UpdateValidIngredientMeasurementUnit(T *testing.T) {
 	})
 }
 
+func TestServiceImpl_UpdateValidIngredientNutritionFacts(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		exampleRequest := fake.BuildFakeForTest[mealplanninggrpc.UpdateValidIngredientNutritionFactsRequest](t)
+		exampleResponse := mealplanningfakes.BuildFakeValidIngredientNutritionFacts()
+
+		s := buildServiceImplForTest(t)
+
+		mvem := &mockmanagers.MockMealPlanningManager{}
+		mvem.On(reflection.GetMethodName(mvem.UpdateValidIngredientNutritionFacts), testutils.ContextMatcher, exampleRequest.ValidIngredientNutritionFactsId, testutils.MatchType[*mealplanning.ValidIngredientNutritionFactsUpdateRequestInput]()).Return(exampleResponse, nil)
+		s.mealPlanningManager = mvem
+
+		res, err := s.UpdateValidIngredientNutritionFacts(ctx, exampleRequest)
+		assert.NoError(t, err)
+		assert.Equal(t, exampleResponse.ID, res.Result.Id)
+
+		mock.AssertExpectationsForObjects(t, mvem)
+	})
+}
+
 func TestServiceImpl_UpdateValidIngredientPreparation(T *testing.T) {
 	T.Parallel()
 
@@ -0,0 +1,67 @@
+package http
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/icalendar"
+
+	"github.com/primandproper/platform/observability"
+	"github.com/primandproper/platform/observability/logging"
+	"github.com/primandproper/platform/observability/tracing"
+
+	"github.com/go-chi/chi/v5"
+)
+
+const (
+	// CalendarFeedTokenURIParamKey is the route parameter holding a calendar feed's token.
+	CalendarFeedTokenURIParamKey = "calendarFeedToken"
+
+	o11yName = "meal_plan_calendar_feed"
+)
+
+// CalendarFeedHandler serves meal plan calendars to calendar apps subscribed to a user's feed URL.
+// Calendar apps can't send our auth headers, so the token in the URL is the only credential.
+type CalendarFeedHandler struct {
+	tracer          tracing.Tracer
+	logger          logging.Logger
+	calendarManager icalendar.MealPlanCalendarManager
+}
+
+// NewCalendarFeedHandler returns a new CalendarFeedHandler.
+func NewCalendarFeedHandler(
+	logger logging.Logger,
+	tracerProvider tracing.TracerProvider,
+	calendarManager icalendar.MealPlanCalendarManager,
+) *CalendarFeedHandler {
+	return &CalendarFeedHandler{
+		tracer:          tracing.NewNamedTracer(tracerProvider, o11yName),
+		logger:          logging.NewNamedLogger(logger, o11yName),
+		calendarManager: calendarManager,
+	}
+}
+
+// Handle processes an incoming calendar feed GET request.
+func (h *CalendarFeedHandler) Handle(w http.ResponseWriter, r *http.Request) {
+	ctx, span := h.tracer.StartSpan(r.Context())
+	defer span.End()
+
+	calendar, err := h.calendarManager.ExportMealPlanCalendarForFeedToken(ctx, chi.URLParam(r, CalendarFeedTokenURIParamKey))
+	if err != nil {
+		if errors.Is(err, icalendar.ErrFeedNotFound) {
+			http.Error(w, "not found", http.StatusNotFound)
+			return
+		}
+
+		observability.AcknowledgeError(err, h.logger, span, "exporting meal plan calendar")
+		http.Error(w, "failed to build calendar", http.StatusInternalServerError)
+		return
+	}
+
+	w.Header().Set("Content-Type", icalendar.ContentType)
+	w.Header().Set("Cache-Control", "private, max-age=900")
+	w.WriteHeader(http.StatusOK)
+	if _, err = w.Write(calendar); err != nil {
+		h.logger.Error("writing calendar feed response", err)
+	}
+}
@@ -0,0 +1,21 @@
+package http
+
+import (
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/icalendar"
+
+	"github.com/primandproper/platform/observability/logging"
+	"github.com/primandproper/platform/observability/tracing"
+
+	"github.com/samber/do/v2"
+)
+
+// RegisterMealPlanningHTTP registers the meal planning HTTP handlers with the injector.
+func RegisterMealPlanningHTTP(i do.Injector) {
+	do.Provide[*CalendarFeedHandler](i, func(i do.Injector) (*CalendarFeedHandler, error) {
+		return NewCalendarFeedHandler(
+			do.MustInvoke[logging.Logger](i),
+			do.MustInvoke[tracing.TracerProvider](i),
+			do.MustInvoke[icalendar.MealPlanCalendarManager](i),
+		), nil
+	})
+}
@@ -10,6 +10,7 @@
 	mealplanningrepo "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/repositories/postgres/mealplanning"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/services/mealplanning/workers"
 
+	"github.com/primandproper/platform/identifiers"
 	"github.com/primandproper/platform/messagequeue"
 	msgconfig "github.com/primandproper/platform/messagequeue/config"
 	"github.com/primandproper/platform/observability"
@@ -28,9 +29,10 @@ type Worker struct {
 	logger logging.Logger
 	tracer tracing.Tracer
 
-	dataManager             mealplanning.Repository
-	postUpdatesPublisher    messagequeue.Publisher
-	finalizedRecordsCounter metrics.Int64Counter
+	dataManager                mealplanning.Repository
+	postUpdatesPublisher       messagequeue.Publisher
+	finalizedRecordsCounter    metrics.Int64Counter
+	votingRemindersSentCounter metrics.Int64Counter
 }
 
 func NewMealPlanFinalizer(
@@ -47,15 +49,21 @@ func NewMealPlanFinalizer(
 		return nil, err
 	}
 
+	votingRemindersSentCounter, err := metricsProvider.NewInt64Counter("meal_plan_finalizer.voting_reminders_sent")
+	if err != nil {
+		return nil, err
+	}
+
 	postUpdatesPublisher, err := publisherProvider.ProvidePublisher(ctx, cfg.DataChangesTopicName)
 	if err != nil {
 		return nil, err
 	}
 
 	return &Worker{
-		dataManager:             dataManager,
-		postUpdatesPublisher:    postUpdatesPublisher,
-		finalizedRecordsCounter: finalizedRecordsCounter,
+		dataManager:                dataManager,
+		postUpdatesPublisher:       postUpdatesPublisher,
+		finalizedRecordsCounter:    finalizedRecordsCounter,
+		votingRemindersSentCounter: votingRemindersSentCounter,
 
 		logger: logging.NewNamedLogger(logger, serviceName),
 		tracer: tracing.NewNamedTracer(tracerProvider, serviceName),
@@ -93,6 +101,8 @@ func (w *Worker) Work(ctx context.Context) (int64, error) {
 		if changed {
 			changedCount++
 			if err = w.postUpdatesPublisher.Publish(ctx, &audit.DataChangeMessage{
+				ID:        identifiers.New(),
+				EventType: mealplanning.MealPlanFinalizedServiceEventType,
 				Context: map[string]any{
 					mealplanningkeys.MealPlanIDKey: mealPlan.ID,
 					"meal_plan":                    mealPlan,
@@ -107,5 +117,49 @@ func (w *Worker) Work(ctx context.Context) (int64, error) {
 	w.finalizedRecordsCounter.Add(ctx, changedCount)
 	logger.WithValue("changed_count", changedCount).Info("finalized expired meal plans")
 
+	if err = w.remindVotersOfApproachingDeadlines(ctx); err != nil {
+		return changedCount, observability.PrepareAndLogError(err, logger, span, "reminding voters of approaching deadlines")
+	}
+
 	return changedCount, nil
 }
+
+// remindVotersOfApproachingDeadlines announces every meal plan whose voting is about to close, once per meal plan,
+// so the data change handler can let its account's members know.
+func (w *Worker) remindVotersOfApproachingDeadlines(ctx context.Context) error {
+	ctx, span := w.tracer.StartSpan(ctx)
+	defer span.End()
+
+	mealPlans, err := w.dataManager.GetMealPlansWithApproachingVotingDeadlines(ctx)
+	if err != nil {
+		return observability.PrepareError(err, span, "fetching meal plans with approaching voting deadlines")
+	}
+
+	var remindedCount int64
+	for _, mealPlan := range mealPlans {
+		logger := w.logger.WithValue(mealplanningkeys.MealPlanIDKey, mealPlan.ID)
+
+		if err = w.postUpdatesPublisher.Publish(ctx, &audit.DataChangeMessage{
+			ID:        identifiers.New(),
+			EventType: mealplanning.MealPlanVotingDeadlineApproachingServiceEventType,
+			Context: map[string]any{
+				mealplanningkeys.MealPlanIDKey: mealPlan.ID,
+			},
+			AccountID: mealPlan.BelongsToAccount,
+		}); err != nil {
+			// leave it unmarked so the next run tries again.
+			logger.Error("writing data change message for approaching voting deadline", err)
+			continue
+		}
+
+		if err = w.dataManager.MarkMealPlanVotingDeadlineReminderSent(ctx, mealPlan.ID); err != nil {
+			return observability.PrepareError(err, span, "marking voting deadline reminder as sent")
+		}
+
+		remindedCount++
+	}
+
+	w.votingRemindersSentCounter.Add(ctx, remindedCount)
+
+	return nil
+}
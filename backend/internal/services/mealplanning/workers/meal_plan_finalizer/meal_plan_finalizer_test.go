@@ -2,9 +2,13 @@
 
 import (
 	"context"
+	"errors"
 	"testing"
 
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/audit"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/fakes"
+	mealplanningkeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/keys"
 	mealplanningmock "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/mocks"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/testutils"
 
@@ -64,9 +68,17 @@ func TestWorker_Work(T *testing.T) {
 			"GetUnfinalizedMealPlansWithExpiredVotingPeriods",
 			testutils.ContextMatcher,
 		).Return(exampleMealPlans, nil)
+		dbm.On(
+			"GetMealPlansWithApproachingVotingDeadlines",
+			testutils.ContextMatcher,
+		).Return([]*mealplanning.MealPlan{}, nil)
 
+		var published []*audit.DataChangeMessage
 		pup := &mockpublishers.PublisherMock{
-			PublishFunc: func(_ context.Context, _ any) error { return nil },
+			PublishFunc: func(_ context.Context, msg any) error {
+				published = append(published, msg.(*audit.DataChangeMessage))
+				return nil
+			},
 		}
 
 		for _, mealPlan := range exampleMealPlans {
@@ -88,6 +100,86 @@ func TestWorker_Work(T *testing.T) {
 		assert.Equal(t, expected, actual)
 		assert.NoError(t, err)
 
+		require.Len(t, published, len(exampleMealPlans))
+		for _, msg := range published {
+			assert.Equal(t, mealplanning.MealPlanFinalizedServiceEventType, msg.EventType)
+		}
+
+		mock.AssertExpectationsForObjects(t, dbm)
+	})
+
+	T.Run("reminds voters of approaching deadlines", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		exampleMealPlan := fakes.BuildFakeMealPlan()
+
+		dbm := &mealplanningmock.Repository{}
+		dbm.On(
+			"GetUnfinalizedMealPlansWithExpiredVotingPeriods",
+			testutils.ContextMatcher,
+		).Return([]*mealplanning.MealPlan{}, nil)
+		dbm.On(
+			"GetMealPlansWithApproachingVotingDeadlines",
+			testutils.ContextMatcher,
+		).Return([]*mealplanning.MealPlan{exampleMealPlan}, nil)
+		dbm.On(
+			"MarkMealPlanVotingDeadlineReminderSent",
+			testutils.ContextMatcher,
+			exampleMealPlan.ID,
+		).Return(nil)
+
+		var published []*audit.DataChangeMessage
+		pup := &mockpublishers.PublisherMock{
+			PublishFunc: func(_ context.Context, msg any) error {
+				published = append(published, msg.(*audit.DataChangeMessage))
+				return nil
+			},
+		}
+
+		worker := buildNewMealPlanFinalizerForTest(t)
+		worker.dataManager = dbm
+		worker.postUpdatesPublisher = pup
+
+		actual, err := worker.Work(ctx)
+		assert.Zero(t, actual)
+		assert.NoError(t, err)
+
+		require.Len(t, published, 1)
+		assert.Equal(t, mealplanning.MealPlanVotingDeadlineApproachingServiceEventType, published[0].EventType)
+		assert.Equal(t, exampleMealPlan.BelongsToAccount, published[0].AccountID)
+		assert.Equal(t, exampleMealPlan.ID, published[0].Context[mealplanningkeys.MealPlanIDKey])
+
+		mock.AssertExpectationsForObjects(t, dbm)
+	})
+
+	T.Run("does not mark reminders it failed to publish", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		exampleMealPlan := fakes.BuildFakeMealPlan()
+
+		dbm := &mealplanningmock.Repository{}
+		dbm.On(
+			"GetUnfinalizedMealPlansWithExpiredVotingPeriods",
+			testutils.ContextMatcher,
+		).Return([]*mealplanning.MealPlan{}, nil)
+		dbm.On(
+			"GetMealPlansWithApproachingVotingDeadlines",
+			testutils.ContextMatcher,
+		).Return([]*mealplanning.MealPlan{exampleMealPlan}, nil)
+
+		pup := &mockpublishers.PublisherMock{
+			PublishFunc: func(_ context.Context, _ any) error { return errors.New("blah") },
+		}
+
+		worker := buildNewMealPlanFinalizerForTest(t)
+		worker.dataManager = dbm
+		worker.postUpdatesPublisher = pup
+
+		_, err := worker.Work(ctx)
+		assert.NoError(t, err)
+
 		mock.AssertExpectationsForObjects(t, dbm)
 	})
 }
@@ -9,6 +9,7 @@
 	mealplanningkeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/keys"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/services/mealplanning/workers"
 
+	"github.com/primandproper/platform/identifiers"
 	"github.com/primandproper/platform/messagequeue"
 	msgconfig "github.com/primandproper/platform/messagequeue/config"
 	"github.com/primandproper/platform/observability"
@@ -86,14 +87,45 @@ func (w *Worker) Work(ctx context.Context) error {
 	for _, mealPlan := range mealPlans {
 		l := logger.WithValue(mealplanningkeys.MealPlanIDKey, mealPlan.ID)
 
-		var dbInputs []*mealplanning.MealPlanGroceryListItemDatabaseCreationInput
-		dbInputs, err = w.groceryListCreator.GenerateGroceryListInputs(ctx, mealPlan)
+		var generated *grocerylistpreparation.GroceryListInputs
+		generated, err = w.groceryListCreator.GenerateGroceryListInputs(ctx, mealPlan)
 		if err != nil {
 			errorResult = multierror.Append(errorResult, err)
 			l.Error("failed to generate grocery list inputs for meal plan", err)
 			continue
 		}
 
+		var pantryItems []*mealplanning.PantryItem
+		pantryItems, err = w.dataManager.GetAvailablePantryItemsForAccount(ctx, mealPlan.BelongsToAccount)
+		if err != nil {
+			errorResult = multierror.Append(errorResult, err)
+			l.Error("failed to fetch pantry items for meal plan account", err)
+			continue
+		}
+
+		var consumption []*grocerylistpreparation.PantryItemConsumption
+		consumption, err = w.groceryListCreator.OffsetGroceryListWithPantry(ctx, generated, pantryItems)
+		if err != nil {
+			errorResult = multierror.Append(errorResult, err)
+			l.Error("failed to offset grocery list inputs with pantry items", err)
+			continue
+		}
+
+		for _, used := range consumption {
+			l.WithValue(mealplanningkeys.PantryItemIDKey, used.PantryItem.ID).
+				WithValue("quantity", used.Quantity).
+				Info("pantry item covers grocery list need")
+		}
+		// unmerged items are already in Items as their own lines, carrying an explanation of why they weren't combined
+		dbInputs := generated.Items
+
+		for _, unmerged := range generated.UnmergedItems {
+			l.WithValue(mealplanningkeys.ValidIngredientIDKey, unmerged.Item.ValidIngredientID).
+				WithValue(mealplanningkeys.ValidMeasurementUnitIDKey, unmerged.Item.ValidMeasurementUnitID).
+				WithValue("preferred_measurement_unit_id", unmerged.PreferredMeasurementUnitID).
+				Info("no measurement unit conversion available, storing grocery list item as a separate line")
+		}
+
 		l = l.WithValue("to_create", len(dbInputs))
 		l.Info("creating grocery list items for meal plan")
 
@@ -109,6 +141,7 @@ func (w *Worker) Work(ctx context.Context) error {
 			createdCount++
 
 			if err = w.postUpdatesPublisher.Publish(ctx, &audit.DataChangeMessage{
+				ID:        identifiers.New(),
 				EventType: mealplanning.MealPlanGroceryListItemCreatedServiceEventType,
 				Context: map[string]any{
 					"groceryListItem": createdItem,
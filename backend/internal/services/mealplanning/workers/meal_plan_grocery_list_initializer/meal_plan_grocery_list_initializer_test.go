@@ -46,7 +46,7 @@ func buildNewMealPlanGroceryListInitializerForTest(t *testing.T) *Worker {
 		metricsnoop.NewMetricsProvider(),
 		pp,
 		&mealplanningmock.Repository{},
-		grocerylistpreparation2.NewGroceryListCreator(loggingnoop.NewLogger(), tracingnoop.NewTracerProvider()),
+		grocerylistpreparation2.NewGroceryListCreator(loggingnoop.NewLogger(), tracingnoop.NewTracerProvider(), &mealplanningmock.Repository{}),
 		cfg,
 	)
 	require.NoError(t, err)
@@ -271,7 +271,16 @@ func TestMealPlanGroceryListInitializer_HandleMessage(T *testing.T) {
 			"GenerateGroceryListInputs",
 			testutils.ContextMatcher,
 			expectedMealPlans[0],
-		).Return(firstMealPlanExpectedGroceryListItemInputs, nil)
+		).Return(&grocerylistpreparation2.GroceryListInputs{Items: firstMealPlanExpectedGroceryListItemInputs}, nil)
+
+		pantryItems := fakes.BuildFakePantryItemsList().Data
+		mdm.On(reflection.GetMethodName(mdm.GetAvailablePantryItemsForAccount), testutils.ContextMatcher, expectedMealPlans[0].BelongsToAccount).Return(pantryItems, nil)
+		mglm.On(
+			"OffsetGroceryListWithPantry",
+			testutils.ContextMatcher,
+			&grocerylistpreparation2.GroceryListInputs{Items: firstMealPlanExpectedGroceryListItemInputs},
+			pantryItems,
+		).Return([]*grocerylistpreparation2.PantryItemConsumption{}, nil)
 		w.groceryListCreator = mglm
 
 		pup := &mockpublishers.PublisherMock{
@@ -289,6 +298,6 @@ func TestMealPlanGroceryListInitializer_HandleMessage(T *testing.T) {
 		w.dataManager = mdm
 
 		assert.NoError(t, w.Work(ctx))
-		mock.AssertExpectationsForObjects(t, mdm)
+		mock.AssertExpectationsForObjects(t, mdm, mglm)
 	})
 }
@@ -9,6 +9,8 @@
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/recipeanalysis"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/services/mealplanning/workers"
 
+	"github.com/primandproper/platform/database/filtering"
+	"github.com/primandproper/platform/identifiers"
 	"github.com/primandproper/platform/messagequeue"
 	msgconfig "github.com/primandproper/platform/messagequeue/config"
 	"github.com/primandproper/platform/observability"
@@ -92,6 +94,7 @@ func (w *Worker) Work(ctx context.Context) error {
 
 		for _, createdTask := range createdMealPlanTasks {
 			if publishErr := w.postUpdatesPublisher.Publish(ctx, &audit.DataChangeMessage{
+				ID:        identifiers.New(),
 				EventType: mealplanning.MealPlanTaskCreatedServiceEventType,
 				Context: map[string]any{
 					mealplanningkeys.MealPlanIDKey:     mealPlanID,
@@ -144,15 +147,32 @@ func (w *Worker) determineCreatableMealPlanTasks(ctx context.Context) (map[strin
 			inputs[result.MealPlanID] = []*mealplanning.MealPlanTaskDatabaseCreationInput{}
 		}
 
-		for _, recipeID := range result.RecipeIDs {
-			recipe, getRecipeErr := w.dataManager.GetRecipe(ctx, recipeID)
-			if getRecipeErr != nil {
-				return nil, observability.PrepareAndLogError(getRecipeErr, l, span, "fetching recipe")
+		mealPlanOption, getOptionErr := w.dataManager.GetMealPlanOption(ctx, result.MealPlanID, result.MealPlanEventID, result.MealPlanOptionID)
+		if getOptionErr != nil {
+			return nil, observability.PrepareAndLogError(getOptionErr, l, span, "fetching meal plan option")
+		}
+
+		// tasks are built from the recipe revisions the option was pinned to, not whatever the recipes look like now.
+		meal := mealPlanOption.MealWithPinnedRecipes()
+
+		filter := filtering.DefaultQueryFilter()
+		maxSize := uint8(filtering.MaxQueryFilterLimit)
+		filter.MaxResponseSize = &maxSize
+		selections, getSelectionsErr := w.dataManager.GetSelectionsForMealPlanOption(ctx, result.MealPlanOptionID, filter)
+		if getSelectionsErr != nil {
+			return nil, observability.PrepareAndLogError(getSelectionsErr, l, span, "fetching meal plan recipe option selections")
+		}
+
+		for _, component := range meal.Components {
+			// conditional steps are evaluated against what the household chose for this option, at the scale it's cooked at
+			state := &recipeanalysis.ConditionalStepState{
+				Scale:      component.RecipeScale * mealPlanOption.MealScale,
+				Selections: selections.Data,
 			}
 
-			creatableSteps, determineStepsErr := w.analyzer.GenerateMealPlanTasksForRecipe(ctx, result.MealPlanOptionID, recipe)
+			creatableSteps, determineStepsErr := w.analyzer.GenerateMealPlanTasksForRecipe(ctx, result.MealPlanOptionID, &component.Recipe, state)
 			if determineStepsErr != nil {
-				return nil, observability.PrepareAndLogError(determineStepsErr, l, span, "fetching recipe")
+				return nil, observability.PrepareAndLogError(determineStepsErr, l, span, "generating meal plan tasks for recipe")
 			}
 
 			inputs[result.MealPlanID] = append(inputs[result.MealPlanID], creatableSteps...)
@@ -96,7 +96,6 @@ func TestWorker_Work(T *testing.T) {
 
 		exampleMealPlanOption := fakes.BuildFakeMealPlanOption()
 		exampleMealPlanOption.BelongsToMealPlanEvent = exampleMealPlanEvent.ID
-		exampleMealPlanOption.Meal = *exampleMeal
 
 		recipeStepID := fakes.BuildFakeID()
 
@@ -140,10 +139,28 @@ func TestWorker_Work(T *testing.T) {
 			},
 		}
 
-		recipeMap := map[string]*mealplanning.Recipe{
-			exampleRecipe.ID: exampleRecipe,
+		exampleMeal.Components = []*mealplanning.MealComponent{
+			{
+				Recipe:      *exampleRecipe,
+				RecipeScale: 2,
+			},
+		}
+		exampleMealPlanOption.Meal = *exampleMeal
+		exampleMealPlanOption.MealScale = 1.5
+
+		// the recipe has changed since the option was proposed, so tasks should come from the pinned revision.
+		pinnedRecipe := *exampleRecipe
+		pinnedRecipe.Name = "Recipe 1, as proposed"
+		exampleMealPlanOption.RecipeRevisions = []*mealplanning.MealPlanOptionRecipeRevision{
+			{
+				RecipeID:       exampleRecipe.ID,
+				RevisionNumber: 1,
+				Snapshot:       &pinnedRecipe,
+			},
 		}
 
+		exampleSelections := fakes.BuildFakeMealPlanRecipeOptionSelectionsList()
+
 		exampleFinalizedMealPlanResult := &mealplanning.FinalizedMealPlanDatabaseResult{
 			MealPlanID:       exampleMealPlan.ID,
 			MealPlanEventID:  exampleMealPlanEvent.ID,
@@ -172,16 +189,19 @@ func TestWorker_Work(T *testing.T) {
 
 		mockAnalyzer := &recipeanalysis.MockRecipeAnalyzer{}
 		for _, result := range exampleFinalizedMealPlanResults {
-			for _, recipeID := range result.RecipeIDs {
-				mdm.On(reflection.GetMethodName(mdm.GetRecipe), testutils.ContextMatcher, recipeID).Return(recipeMap[recipeID], nil)
-
-				mockAnalyzer.On(
-					"GenerateMealPlanTasksForRecipe",
-					testutils.ContextMatcher,
-					result.MealPlanOptionID,
-					recipeMap[recipeID],
-				).Return(expectedReturnResults, nil)
-			}
+			mdm.On(reflection.GetMethodName(mdm.GetMealPlanOption), testutils.ContextMatcher, result.MealPlanID, result.MealPlanEventID, result.MealPlanOptionID).Return(exampleMealPlanOption, nil)
+			mdm.On(reflection.GetMethodName(mdm.GetSelectionsForMealPlanOption), testutils.ContextMatcher, result.MealPlanOptionID, testutils.QueryFilterMatcher).Return(exampleSelections, nil)
+
+			mockAnalyzer.On(
+				"GenerateMealPlanTasksForRecipe",
+				testutils.ContextMatcher,
+				result.MealPlanOptionID,
+				&pinnedRecipe,
+				&recipeanalysis.ConditionalStepState{
+					Scale:      3,
+					Selections: exampleSelections.Data,
+				},
+			).Return(expectedReturnResults, nil)
 		}
 		w.analyzer = mockAnalyzer
 		w.dataManager = mdm
@@ -0,0 +1,32 @@
+package mealplantemplateinstantiator
+
+import (
+	"context"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/managers"
+
+	"github.com/primandproper/platform/messagequeue"
+	msgconfig "github.com/primandproper/platform/messagequeue/config"
+	"github.com/primandproper/platform/observability/logging"
+	"github.com/primandproper/platform/observability/metrics"
+	"github.com/primandproper/platform/observability/tracing"
+
+	"github.com/samber/do/v2"
+)
+
+// RegisterMealPlanTemplateInstantiator registers the meal plan template instantiator with the injector.
+func RegisterMealPlanTemplateInstantiator(i do.Injector) {
+	do.Provide[*Worker](i, func(i do.Injector) (*Worker, error) {
+		return NewMealPlanTemplateInstantiator(
+			do.MustInvoke[context.Context](i),
+			do.MustInvoke[logging.Logger](i),
+			do.MustInvoke[tracing.TracerProvider](i),
+			do.MustInvoke[mealplanning.Repository](i),
+			do.MustInvoke[managers.MealPlanningManager](i),
+			do.MustInvoke[messagequeue.PublisherProvider](i),
+			do.MustInvoke[metrics.Provider](i),
+			do.MustInvoke[*msgconfig.QueuesConfig](i),
+		)
+	})
+}
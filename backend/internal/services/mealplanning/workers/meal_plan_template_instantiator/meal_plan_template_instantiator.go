@@ -0,0 +1,361 @@
+package mealplantemplateinstantiator
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/audit"
+	identitykeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity/keys"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/converters"
+	mealplanningkeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/keys"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/services/mealplanning/workers"
+
+	"github.com/primandproper/platform/identifiers"
+	"github.com/primandproper/platform/messagequeue"
+	msgconfig "github.com/primandproper/platform/messagequeue/config"
+	"github.com/primandproper/platform/observability"
+	"github.com/primandproper/platform/observability/logging"
+	"github.com/primandproper/platform/observability/metrics"
+	"github.com/primandproper/platform/observability/tracing"
+)
+
+const (
+	serviceName = "meal_plan_template_instantiator"
+
+	// instantiationClaimLease is how long a run holds a template's scheduled instantiation. A run that dies without
+	// finishing or releasing its claim only keeps the occurrence from being retried for this long.
+	instantiationClaimLease = 15 * time.Minute
+)
+
+var _ workers.WorkerCounter = (*Worker)(nil)
+
+// mealPlanCreator creates meal plans on an account's behalf, applying the same entitlement and allergen checks a
+// user-created meal plan gets.
+type mealPlanCreator interface {
+	CreateMealPlan(ctx context.Context, ownerID, creatorID string, input *mealplanning.MealPlanCreationRequestInput) (*mealplanning.MealPlan, error)
+}
+
+type Worker struct {
+	logger logging.Logger
+	tracer tracing.Tracer
+
+	dataManager                 mealplanning.Repository
+	mealPlanCreator             mealPlanCreator
+	postUpdatesPublisher        messagequeue.Publisher
+	instantiatedRecordsCounter  metrics.Int64Counter
+	skippedInstantiationCounter metrics.Int64Counter
+}
+
+func NewMealPlanTemplateInstantiator(
+	ctx context.Context,
+	logger logging.Logger,
+	tracerProvider tracing.TracerProvider,
+	dataManager mealplanning.Repository,
+	mealPlanCreator mealPlanCreator,
+	publisherProvider messagequeue.PublisherProvider,
+	metricsProvider metrics.Provider,
+	cfg *msgconfig.QueuesConfig,
+) (*Worker, error) {
+	instantiatedRecordsCounter, err := metricsProvider.NewInt64Counter("meal_plan_template_instantiator.instantiated_records")
+	if err != nil {
+		return nil, err
+	}
+
+	skippedInstantiationCounter, err := metricsProvider.NewInt64Counter("meal_plan_template_instantiator.skipped_instantiations")
+	if err != nil {
+		return nil, err
+	}
+
+	postUpdatesPublisher, err := publisherProvider.ProvidePublisher(ctx, cfg.DataChangesTopicName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Worker{
+		dataManager:                 dataManager,
+		mealPlanCreator:             mealPlanCreator,
+		postUpdatesPublisher:        postUpdatesPublisher,
+		instantiatedRecordsCounter:  instantiatedRecordsCounter,
+		skippedInstantiationCounter: skippedInstantiationCounter,
+
+		logger: logging.NewNamedLogger(logger, serviceName),
+		tracer: tracing.NewNamedTracer(tracerProvider, serviceName),
+	}, nil
+}
+
+func (w *Worker) Work(ctx context.Context) (int64, error) {
+	ctx, span := w.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := w.logger.Clone()
+	logger.Info("beginning instantiation of due meal plan templates")
+
+	now := time.Now()
+	templates, err := w.dataManager.GetMealPlanTemplatesDueForInstantiation(ctx, now)
+	if err != nil {
+		return -1, observability.PrepareAndLogError(err, logger, span, "fetching meal plan templates due for instantiation")
+	}
+
+	if len(templates) > 0 {
+		logger.WithValue("quantity", len(templates)).Info("instantiating due meal plan templates")
+	}
+
+	var createdCount int64
+	for _, template := range templates {
+		l := logger.WithValues(map[string]any{
+			mealplanningkeys.MealPlanTemplateIDKey: template.ID,
+			identitykeys.AccountIDKey:              template.BelongsToAccount,
+		})
+
+		var created bool
+		created, err = w.instantiateTemplate(ctx, template, now)
+		if err != nil {
+			// one broken template shouldn't hold up everyone else's.
+			l.Error("instantiating meal plan template", err)
+			continue
+		}
+
+		if created {
+			createdCount++
+		} else {
+			w.skippedInstantiationCounter.Add(ctx, 1)
+		}
+	}
+
+	w.instantiatedRecordsCounter.Add(ctx, createdCount)
+	logger.WithValue("created_count", createdCount).Info("instantiated due meal plan templates")
+
+	return createdCount, nil
+}
+
+// instantiateTemplate creates the meal plan a template is due to produce, and schedules its next instantiation.
+// It reports whether a meal plan was actually created; templates whose voting deadline has already passed, or
+// which have no meals to propose, are rescheduled without one. The occurrence is claimed with an expiring lease
+// before the meal plan is created, so concurrent runs never instantiate it twice, and the schedule only advances
+// once the meal plan exists, so a failed run leaves the occurrence for the next one.
+func (w *Worker) instantiateTemplate(ctx context.Context, template *mealplanning.MealPlanTemplate, now time.Time) (bool, error) {
+	ctx, span := w.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := w.logger.WithSpan(span).WithValue(mealplanningkeys.MealPlanTemplateIDKey, template.ID)
+	tracing.AttachToSpan(span, mealplanningkeys.MealPlanTemplateIDKey, template.ID)
+
+	candidates, err := w.selectCandidates(ctx, template)
+	if err != nil {
+		return false, observability.PrepareError(err, span, "selecting meal plan template candidates")
+	}
+
+	input, err := converters.ConvertMealPlanTemplateToMealPlanCreationRequestInput(template, now, candidates)
+	if err != nil {
+		return false, observability.PrepareError(err, span, "building meal plan from template")
+	}
+
+	scheduledAt := template.NextInstantiationAt
+	template.LastInstantiatedAt = &scheduledAt
+	nextInstantiationAt, err := template.NextInstantiationAfter(now)
+	if err != nil {
+		return false, observability.PrepareError(err, span, "determining next meal plan template instantiation")
+	}
+
+	claimed, err := w.dataManager.ClaimMealPlanTemplateInstantiation(ctx, template.ID, scheduledAt, now.Add(instantiationClaimLease))
+	if err != nil {
+		return false, observability.PrepareError(err, span, "claiming meal plan template instantiation")
+	}
+
+	if !claimed {
+		logger.Info("meal plan template already being instantiated by another run, skipping")
+		return false, nil
+	}
+
+	var skipReason string
+	switch {
+	case len(input.Events) == 0:
+		skipReason = "meal plan template has no meals to propose, skipping"
+	case input.VotingDeadline.Before(now):
+		skipReason = "meal plan template voting deadline already passed, skipping"
+	}
+
+	if skipReason != "" {
+		logger.Info(skipReason)
+		if _, err = w.dataManager.MarkMealPlanTemplateAsInstantiated(ctx, template.ID, scheduledAt, nextInstantiationAt); err != nil {
+			return false, observability.PrepareError(err, span, "marking meal plan template as instantiated")
+		}
+
+		return false, nil
+	}
+
+	mealPlan, err := w.mealPlanCreator.CreateMealPlan(ctx, template.BelongsToAccount, template.CreatedByUser, input)
+	if err != nil {
+		if releaseErr := w.dataManager.ReleaseMealPlanTemplateInstantiationClaim(ctx, template.ID); releaseErr != nil {
+			// the claim expires on its own, so the occurrence is still retried, just not as soon.
+			logger.Error("releasing meal plan template instantiation claim", releaseErr)
+		}
+
+		return false, observability.PrepareError(err, span, "creating meal plan from template")
+	}
+
+	marked, err := w.dataManager.MarkMealPlanTemplateAsInstantiated(ctx, template.ID, scheduledAt, nextInstantiationAt)
+	if err != nil {
+		return false, observability.PrepareError(err, span, "marking meal plan template as instantiated")
+	}
+
+	if !marked {
+		logger.Info("meal plan template was rescheduled while it was being instantiated")
+	}
+
+	if err = w.postUpdatesPublisher.Publish(ctx, &audit.DataChangeMessage{
+		ID:        identifiers.New(),
+		EventType: mealplanning.MealPlanTemplateInstantiatedServiceEventType,
+		Context: map[string]any{
+			mealplanningkeys.MealPlanTemplateIDKey: template.ID,
+			mealplanningkeys.MealPlanIDKey:         mealPlan.ID,
+		},
+		AccountID: template.BelongsToAccount,
+	}); err != nil {
+		logger.Error("writing data change message for instantiated meal plan template", err)
+	}
+
+	return true, nil
+}
+
+// selectCandidates picks the meals to propose for each of the template's slots, in order.
+func (w *Worker) selectCandidates(ctx context.Context, template *mealplanning.MealPlanTemplate) ([][]string, error) {
+	recent := map[string]bool{}
+	if template.AvoidMealsFromLastPlans > 0 {
+		recentMealIDs, err := w.dataManager.GetRecentlyChosenMealIDsForAccount(ctx, template.BelongsToAccount, template.AvoidMealsFromLastPlans)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, mealID := range recentMealIDs {
+			recent[mealID] = true
+		}
+	}
+
+	pools := make([][]string, len(template.Slots))
+	poolMealIDs := []string{}
+	for i, slot := range template.Slots {
+		pool, err := w.buildSlotPool(ctx, template, slot)
+		if err != nil {
+			return nil, err
+		}
+
+		pools[i] = pool
+		poolMealIDs = append(poolMealIDs, pool...)
+	}
+
+	if len(poolMealIDs) == 0 {
+		return pools, nil
+	}
+
+	meals, err := w.dataManager.GetMealsWithIDs(ctx, poolMealIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	eligible := map[string]bool{}
+	recipeIDs := []string{}
+	for _, meal := range meals {
+		if !meal.EligibleForMealPlans {
+			continue
+		}
+
+		eligible[meal.ID] = true
+		for _, component := range meal.Components {
+			recipeIDs = append(recipeIDs, component.Recipe.ID)
+		}
+	}
+
+	summaries, err := w.dataManager.GetRecipeRatingSummariesForAccount(ctx, template.BelongsToAccount, recipeIDs)
+	if err != nil {
+		return nil, err
+	}
+	scores := mealplanning.ScoreMealsByRecipeRatings(meals, summaries)
+
+	used := map[string]bool{}
+	candidates := make([][]string, len(template.Slots))
+	for i, pool := range pools {
+		eligiblePool := []string{}
+		for _, mealID := range pool {
+			if eligible[mealID] {
+				eligiblePool = append(eligiblePool, mealID)
+			}
+		}
+
+		candidates[i] = mealplanning.SelectMealPlanTemplateCandidates(eligiblePool, scores, recent, used, int(template.CandidatesPerSlot))
+		for _, mealID := range candidates[i] {
+			used[mealID] = true
+		}
+	}
+
+	return candidates, nil
+}
+
+// buildSlotPool gathers every meal a slot could propose: the meals it names directly, the meals on its meal list, and
+// a single-recipe meal for each recipe on its recipe list.
+func (w *Worker) buildSlotPool(ctx context.Context, template *mealplanning.MealPlanTemplate, slot *mealplanning.MealPlanTemplateSlot) ([]string, error) {
+	pool := append([]string{}, slot.MealIDs...)
+
+	if slot.MealListID != nil {
+		items, err := w.dataManager.GetMealListItems(ctx, *slot.MealListID, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range items.Data {
+			pool = append(pool, item.Meal.ID)
+		}
+	}
+
+	if slot.RecipeListID != nil {
+		items, err := w.dataManager.GetRecipeListItems(ctx, *slot.RecipeListID, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, item := range items.Data {
+			meal, err := w.findOrCreateMealForRecipe(ctx, template.CreatedByUser, &item.Recipe)
+			if err != nil {
+				return nil, err
+			}
+
+			pool = append(pool, meal.ID)
+		}
+	}
+
+	return pool, nil
+}
+
+// findOrCreateMealForRecipe returns the meal consisting of only the given recipe, creating it on the template
+// creator's behalf if they don't already have one.
+func (w *Worker) findOrCreateMealForRecipe(ctx context.Context, creatorID string, recipe *mealplanning.Recipe) (*mealplanning.Meal, error) {
+	input := &mealplanning.MealCreationRequestInput{
+		Name:                 recipe.Name,
+		Description:          recipe.Description,
+		MinEstimatedPortions: recipe.MinEstimatedPortions,
+		MaxEstimatedPortions: recipe.MaxEstimatedPortions,
+		EligibleForMealPlans: true,
+		Components: []*mealplanning.MealComponentCreationRequestInput{
+			{
+				RecipeID:      recipe.ID,
+				ComponentType: mealplanning.MealComponentTypesMain,
+				RecipeScale:   1,
+			},
+		},
+	}
+
+	existing, err := w.dataManager.FindMealWithSameComponents(ctx, creatorID, input)
+	if err == nil {
+		return existing, nil
+	}
+	if !errors.Is(err, mealplanning.ErrNoMatchingMeal) {
+		return nil, err
+	}
+
+	convertedInput := converters.ConvertMealCreationRequestInputToMealDatabaseCreationInput(input)
+	convertedInput.CreatedByUser = creatorID
+
+	return w.dataManager.CreateMeal(ctx, convertedInput)
+}
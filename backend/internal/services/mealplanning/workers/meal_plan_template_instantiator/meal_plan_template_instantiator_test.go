@@ -0,0 +1,266 @@
+package mealplantemplateinstantiator
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/fakes"
+	mockmanagers "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/managers/mock"
+	mealplanningmock "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/mocks"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/testutils"
+
+	"github.com/primandproper/platform/messagequeue"
+	msgconfig "github.com/primandproper/platform/messagequeue/config"
+	mockpublishers "github.com/primandproper/platform/messagequeue/mock"
+	loggingnoop "github.com/primandproper/platform/observability/logging/noop"
+	metricsnoop "github.com/primandproper/platform/observability/metrics/noop"
+	tracingnoop "github.com/primandproper/platform/observability/tracing/noop"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func buildNewMealPlanTemplateInstantiatorForTest(t *testing.T) *Worker {
+	t.Helper()
+
+	ctx := t.Context()
+	cfg := &msgconfig.QueuesConfig{DataChangesTopicName: "data_changes"}
+
+	pp := &mockpublishers.PublisherProviderMock{
+		ProvidePublisherFunc: func(_ context.Context, topic string) (messagequeue.Publisher, error) {
+			return &mockpublishers.PublisherMock{
+				PublishFunc:      func(_ context.Context, _ any) error { return nil },
+				PublishAsyncFunc: func(_ context.Context, _ any) {},
+				StopFunc:         func() {},
+			}, nil
+		},
+	}
+
+	x, err := NewMealPlanTemplateInstantiator(
+		ctx,
+		loggingnoop.NewLogger(),
+		tracingnoop.NewTracerProvider(),
+		&mealplanningmock.Repository{},
+		&mockmanagers.MockMealPlanningManager{},
+		pp,
+		metricsnoop.NewMetricsProvider(),
+		cfg,
+	)
+	require.NoError(t, err)
+
+	return x
+}
+
+// buildDueTemplateForTest builds a template with a single slot that's due now, along with the meals it proposes.
+func buildDueTemplateForTest(t *testing.T) (*mealplanning.MealPlanTemplate, []*mealplanning.Meal) {
+	t.Helper()
+
+	meals := fakes.BuildFakeMealsList().Data
+	for _, meal := range meals {
+		meal.EligibleForMealPlans = true
+	}
+
+	slot := fakes.BuildFakeMealPlanTemplateSlot()
+	slot.MealIDs = []string{}
+	for _, meal := range meals {
+		slot.MealIDs = append(slot.MealIDs, meal.ID)
+	}
+
+	template := fakes.BuildFakeMealPlanTemplate()
+	template.Slots = []*mealplanning.MealPlanTemplateSlot{slot}
+	template.VotingDeadlineOffsetInSeconds = 0
+	template.NextInstantiationAt = time.Now().Add(-time.Minute)
+
+	return template, meals
+}
+
+func TestWorker_Work(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		template, meals := buildDueTemplateForTest(t)
+		recentMealID := meals[0].ID
+		scheduledAt := template.NextInstantiationAt
+
+		dbm := &mealplanningmock.Repository{}
+		dbm.On("GetMealPlanTemplatesDueForInstantiation", testutils.ContextMatcher, testutils.MatchType[time.Time]()).Return([]*mealplanning.MealPlanTemplate{template}, nil)
+		dbm.On("GetRecentlyChosenMealIDsForAccount", testutils.ContextMatcher, template.BelongsToAccount, template.AvoidMealsFromLastPlans).Return([]string{recentMealID}, nil)
+		dbm.On("GetMealsWithIDs", testutils.ContextMatcher, template.Slots[0].MealIDs).Return(meals, nil)
+		dbm.On("GetRecipeRatingSummariesForAccount", testutils.ContextMatcher, template.BelongsToAccount, testutils.MatchType[[]string]()).Return([]*mealplanning.RecipeRatingSummary{}, nil)
+		dbm.On("ClaimMealPlanTemplateInstantiation", testutils.ContextMatcher, template.ID, scheduledAt, testutils.MatchType[time.Time]()).Return(true, nil)
+		dbm.On("MarkMealPlanTemplateAsInstantiated", testutils.ContextMatcher, template.ID, scheduledAt, mock.MatchedBy(func(next time.Time) bool {
+			return next.After(time.Now())
+		})).Return(true, nil)
+
+		mpm := &mockmanagers.MockMealPlanningManager{}
+		mpm.On("CreateMealPlan", testutils.ContextMatcher, template.BelongsToAccount, template.CreatedByUser, mock.MatchedBy(func(input *mealplanning.MealPlanCreationRequestInput) bool {
+			if len(input.Events) != 1 || len(input.Events[0].Options) != len(meals)-1 {
+				return false
+			}
+
+			for _, option := range input.Events[0].Options {
+				if option.MealID == recentMealID {
+					return false
+				}
+			}
+
+			return true
+		})).Return(fakes.BuildFakeMealPlan(), nil)
+
+		worker := buildNewMealPlanTemplateInstantiatorForTest(t)
+		worker.dataManager = dbm
+		worker.mealPlanCreator = mpm
+
+		actual, err := worker.Work(ctx)
+		assert.Equal(t, int64(1), actual)
+		assert.NoError(t, err)
+
+		mock.AssertExpectationsForObjects(t, dbm, mpm)
+	})
+
+	T.Run("skips templates already claimed by another run", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		template, meals := buildDueTemplateForTest(t)
+		template.AvoidMealsFromLastPlans = 0
+
+		dbm := &mealplanningmock.Repository{}
+		dbm.On("GetMealPlanTemplatesDueForInstantiation", testutils.ContextMatcher, testutils.MatchType[time.Time]()).Return([]*mealplanning.MealPlanTemplate{template}, nil)
+		dbm.On("GetMealsWithIDs", testutils.ContextMatcher, template.Slots[0].MealIDs).Return(meals, nil)
+		dbm.On("GetRecipeRatingSummariesForAccount", testutils.ContextMatcher, template.BelongsToAccount, testutils.MatchType[[]string]()).Return([]*mealplanning.RecipeRatingSummary{}, nil)
+		dbm.On("ClaimMealPlanTemplateInstantiation", testutils.ContextMatcher, template.ID, template.NextInstantiationAt, testutils.MatchType[time.Time]()).Return(false, nil)
+
+		mpm := &mockmanagers.MockMealPlanningManager{}
+
+		worker := buildNewMealPlanTemplateInstantiatorForTest(t)
+		worker.dataManager = dbm
+		worker.mealPlanCreator = mpm
+
+		actual, err := worker.Work(ctx)
+		assert.Equal(t, int64(0), actual)
+		assert.NoError(t, err)
+
+		mock.AssertExpectationsForObjects(t, dbm, mpm)
+	})
+
+	T.Run("retries the occurrence on the next run after failing to create its meal plan", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		template, meals := buildDueTemplateForTest(t)
+		template.AvoidMealsFromLastPlans = 0
+		scheduledAt := template.NextInstantiationAt
+
+		dbm := &mealplanningmock.Repository{}
+		dbm.On("GetMealPlanTemplatesDueForInstantiation", testutils.ContextMatcher, testutils.MatchType[time.Time]()).Return([]*mealplanning.MealPlanTemplate{template}, nil).Twice()
+		dbm.On("GetMealsWithIDs", testutils.ContextMatcher, template.Slots[0].MealIDs).Return(meals, nil).Twice()
+		dbm.On("GetRecipeRatingSummariesForAccount", testutils.ContextMatcher, template.BelongsToAccount, testutils.MatchType[[]string]()).Return([]*mealplanning.RecipeRatingSummary{}, nil).Twice()
+		dbm.On("ClaimMealPlanTemplateInstantiation", testutils.ContextMatcher, template.ID, scheduledAt, testutils.MatchType[time.Time]()).Return(true, nil).Twice()
+		dbm.On("ReleaseMealPlanTemplateInstantiationClaim", testutils.ContextMatcher, template.ID).Return(nil).Once()
+		dbm.On("MarkMealPlanTemplateAsInstantiated", testutils.ContextMatcher, template.ID, scheduledAt, testutils.MatchType[time.Time]()).Return(true, nil).Once()
+
+		mpm := &mockmanagers.MockMealPlanningManager{}
+		mpm.On("CreateMealPlan", testutils.ContextMatcher, template.BelongsToAccount, template.CreatedByUser, testutils.MatchType[*mealplanning.MealPlanCreationRequestInput]()).Return((*mealplanning.MealPlan)(nil), errors.New("blah")).Once()
+		mpm.On("CreateMealPlan", testutils.ContextMatcher, template.BelongsToAccount, template.CreatedByUser, testutils.MatchType[*mealplanning.MealPlanCreationRequestInput]()).Return(fakes.BuildFakeMealPlan(), nil).Once()
+
+		worker := buildNewMealPlanTemplateInstantiatorForTest(t)
+		worker.dataManager = dbm
+		worker.mealPlanCreator = mpm
+
+		// the failed run releases its claim without advancing the schedule...
+		actual, err := worker.Work(ctx)
+		assert.Equal(t, int64(0), actual)
+		assert.NoError(t, err)
+		dbm.AssertNotCalled(t, "MarkMealPlanTemplateAsInstantiated", testutils.ContextMatcher, template.ID, scheduledAt, testutils.MatchType[time.Time]())
+
+		// ...so the next run instantiates the same occurrence.
+		actual, err = worker.Work(ctx)
+		assert.Equal(t, int64(1), actual)
+		assert.NoError(t, err)
+
+		mock.AssertExpectationsForObjects(t, dbm, mpm)
+	})
+
+	T.Run("with error releasing claim after failing to create meal plan", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		template, meals := buildDueTemplateForTest(t)
+		template.AvoidMealsFromLastPlans = 0
+
+		dbm := &mealplanningmock.Repository{}
+		dbm.On("GetMealPlanTemplatesDueForInstantiation", testutils.ContextMatcher, testutils.MatchType[time.Time]()).Return([]*mealplanning.MealPlanTemplate{template}, nil)
+		dbm.On("GetMealsWithIDs", testutils.ContextMatcher, template.Slots[0].MealIDs).Return(meals, nil)
+		dbm.On("GetRecipeRatingSummariesForAccount", testutils.ContextMatcher, template.BelongsToAccount, testutils.MatchType[[]string]()).Return([]*mealplanning.RecipeRatingSummary{}, nil)
+		dbm.On("ClaimMealPlanTemplateInstantiation", testutils.ContextMatcher, template.ID, template.NextInstantiationAt, mock.MatchedBy(func(claimedUntil time.Time) bool {
+			return claimedUntil.After(time.Now())
+		})).Return(true, nil)
+		dbm.On("ReleaseMealPlanTemplateInstantiationClaim", testutils.ContextMatcher, template.ID).Return(errors.New("blah"))
+
+		mpm := &mockmanagers.MockMealPlanningManager{}
+		mpm.On("CreateMealPlan", testutils.ContextMatcher, template.BelongsToAccount, template.CreatedByUser, testutils.MatchType[*mealplanning.MealPlanCreationRequestInput]()).Return((*mealplanning.MealPlan)(nil), errors.New("blah"))
+
+		worker := buildNewMealPlanTemplateInstantiatorForTest(t)
+		worker.dataManager = dbm
+		worker.mealPlanCreator = mpm
+
+		actual, err := worker.Work(ctx)
+		assert.Equal(t, int64(0), actual)
+		assert.NoError(t, err)
+
+		mock.AssertExpectationsForObjects(t, dbm, mpm)
+	})
+
+	T.Run("reschedules templates without eligible meals", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		template, meals := buildDueTemplateForTest(t)
+		template.AvoidMealsFromLastPlans = 0
+		for _, meal := range meals {
+			meal.EligibleForMealPlans = false
+		}
+
+		dbm := &mealplanningmock.Repository{}
+		dbm.On("GetMealPlanTemplatesDueForInstantiation", testutils.ContextMatcher, testutils.MatchType[time.Time]()).Return([]*mealplanning.MealPlanTemplate{template}, nil)
+		dbm.On("GetMealsWithIDs", testutils.ContextMatcher, template.Slots[0].MealIDs).Return(meals, nil)
+		dbm.On("GetRecipeRatingSummariesForAccount", testutils.ContextMatcher, template.BelongsToAccount, testutils.MatchType[[]string]()).Return([]*mealplanning.RecipeRatingSummary{}, nil)
+		dbm.On("ClaimMealPlanTemplateInstantiation", testutils.ContextMatcher, template.ID, template.NextInstantiationAt, testutils.MatchType[time.Time]()).Return(true, nil)
+		dbm.On("MarkMealPlanTemplateAsInstantiated", testutils.ContextMatcher, template.ID, template.NextInstantiationAt, testutils.MatchType[time.Time]()).Return(true, nil)
+
+		worker := buildNewMealPlanTemplateInstantiatorForTest(t)
+		worker.dataManager = dbm
+
+		actual, err := worker.Work(ctx)
+		assert.Equal(t, int64(0), actual)
+		assert.NoError(t, err)
+
+		mock.AssertExpectationsForObjects(t, dbm)
+	})
+
+	T.Run("with error fetching due templates", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+
+		dbm := &mealplanningmock.Repository{}
+		dbm.On("GetMealPlanTemplatesDueForInstantiation", testutils.ContextMatcher, testutils.MatchType[time.Time]()).Return([]*mealplanning.MealPlanTemplate(nil), errors.New("blah"))
+
+		worker := buildNewMealPlanTemplateInstantiatorForTest(t)
+		worker.dataManager = dbm
+
+		actual, err := worker.Work(ctx)
+		assert.Equal(t, int64(-1), actual)
+		assert.Error(t, err)
+
+		mock.AssertExpectationsForObjects(t, dbm)
+	})
+}
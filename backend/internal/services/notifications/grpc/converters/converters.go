@@ -38,23 +38,31 @@ func ConvertUserNotificationStatusToString(s notificationssvc.UserNotificationSt
 
 func ConvertUserNotificationToGRPCUserNotification(notification *notifications.UserNotification) *notificationssvc.UserNotification {
 	return &notificationssvc.UserNotification{
-		CreatedAt:     grpcconverters.ConvertTimeToPBTimestamp(notification.CreatedAt),
-		LastUpdatedAt: grpcconverters.ConvertTimePointerToPBTimestamp(notification.LastUpdatedAt),
-		Id:            notification.ID,
-		Content:       notification.Content,
-		Status:        ConvertStringToUserNotificationStatus(notification.Status),
-		BelongsToUser: notification.BelongsToUser,
+		CreatedAt:        grpcconverters.ConvertTimeToPBTimestamp(notification.CreatedAt),
+		LastUpdatedAt:    grpcconverters.ConvertTimePointerToPBTimestamp(notification.LastUpdatedAt),
+		Id:               notification.ID,
+		Content:          notification.Content,
+		Status:           ConvertStringToUserNotificationStatus(notification.Status),
+		BelongsToUser:    notification.BelongsToUser,
+		NotificationType: notification.NotificationType,
+		TargetType:       notification.TargetType,
+		TargetId:         notification.TargetID,
+		DeepLink:         notification.DeepLink,
 	}
 }
 
 func ConvertGRPCUserNotificationToUserNotification(notification *notificationssvc.UserNotification) *notifications.UserNotification {
 	return &notifications.UserNotification{
-		CreatedAt:     grpcconverters.ConvertPBTimestampToTime(notification.CreatedAt),
-		LastUpdatedAt: grpcconverters.ConvertPBTimestampToTimePointer(notification.LastUpdatedAt),
-		ID:            notification.Id,
-		Content:       notification.Content,
-		Status:        ConvertUserNotificationStatusToString(notification.Status),
-		BelongsToUser: notification.BelongsToUser,
+		CreatedAt:        grpcconverters.ConvertPBTimestampToTime(notification.CreatedAt),
+		LastUpdatedAt:    grpcconverters.ConvertPBTimestampToTimePointer(notification.LastUpdatedAt),
+		ID:               notification.Id,
+		Content:          notification.Content,
+		Status:           ConvertUserNotificationStatusToString(notification.Status),
+		BelongsToUser:    notification.BelongsToUser,
+		NotificationType: notification.NotificationType,
+		TargetType:       notification.TargetType,
+		TargetID:         notification.TargetId,
+		DeepLink:         notification.DeepLink,
 	}
 }
 
@@ -107,3 +115,35 @@ func ConvertGRPCUserDeviceTokenToUserDeviceToken(token *notificationssvc.UserDev
 		BelongsToUser: token.BelongsToUser,
 	}
 }
+
+func ConvertNotificationPreferenceToGRPCNotificationPreference(preference *notifications.NotificationPreference) *notificationssvc.NotificationPreference {
+	if preference == nil {
+		return nil
+	}
+	return &notificationssvc.NotificationPreference{
+		CreatedAt:        grpcconverters.ConvertTimeToPBTimestamp(preference.CreatedAt),
+		LastUpdatedAt:    grpcconverters.ConvertTimePointerToPBTimestamp(preference.LastUpdatedAt),
+		Id:               preference.ID,
+		BelongsToUser:    preference.BelongsToUser,
+		NotificationType: preference.NotificationType,
+		DigestMode:       preference.DigestMode,
+		Timezone:         preference.Timezone,
+		QuietHoursStart:  preference.QuietHoursStart,
+		QuietHoursEnd:    preference.QuietHoursEnd,
+		PushEnabled:      preference.PushEnabled,
+		EmailEnabled:     preference.EmailEnabled,
+		InAppEnabled:     preference.InAppEnabled,
+	}
+}
+
+func ConvertGRPCNotificationPreferenceUpdateRequestInputToNotificationPreferenceUpdateRequestInput(input *notificationssvc.NotificationPreferenceUpdateRequestInput) *notifications.NotificationPreferenceUpdateRequestInput {
+	return &notifications.NotificationPreferenceUpdateRequestInput{
+		QuietHoursStart: input.QuietHoursStart,
+		QuietHoursEnd:   input.QuietHoursEnd,
+		DigestMode:      input.DigestMode,
+		Timezone:        input.Timezone,
+		PushEnabled:     input.PushEnabled,
+		EmailEnabled:    input.EmailEnabled,
+		InAppEnabled:    input.InAppEnabled,
+	}
+}
@@ -0,0 +1,109 @@
+package grpc
+
+import (
+	"context"
+	"slices"
+
+	identitykeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity/keys"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/notifications"
+	notificationsconverters "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/notifications/converters"
+	notificationkeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/notifications/keys"
+	notificationssvc "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/grpc/generated/services/notifications"
+	grpctypes "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/grpc/generated/types"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/services/notifications/grpc/converters"
+
+	platformerrors "github.com/primandproper/platform/errors"
+	errorsgrpc "github.com/primandproper/platform/errors/grpc"
+	"github.com/primandproper/platform/identifiers"
+	"github.com/primandproper/platform/observability/tracing"
+
+	"google.golang.org/grpc/codes"
+)
+
+func (s *serviceImpl) GetNotificationPreferences(ctx context.Context, _ *notificationssvc.GetNotificationPreferencesRequest) (*notificationssvc.GetNotificationPreferencesResponse, error) {
+	ctx, span := s.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := s.logger.WithSpan(span)
+
+	sessionContextData, err := s.sessionContextDataFetcher(ctx)
+	if err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.Unauthenticated, "unable to determine authentication")
+	}
+
+	logger = logger.WithValue(identitykeys.UserIDKey, sessionContextData.GetUserID())
+	tracing.AttachToSpan(span, identitykeys.UserIDKey, sessionContextData.GetUserID())
+
+	preferences, err := s.notificationsManager.GetNotificationPreferencesForUser(ctx, sessionContextData.GetUserID())
+	if err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.Internal, "fetching notification preferences")
+	}
+
+	x := &notificationssvc.GetNotificationPreferencesResponse{
+		ResponseDetails: &grpctypes.ResponseDetails{
+			TraceId: span.SpanContext().TraceID().String(),
+		},
+	}
+	for _, preference := range preferences {
+		x.Results = append(x.Results, converters.ConvertNotificationPreferenceToGRPCNotificationPreference(preference))
+	}
+
+	return x, nil
+}
+
+func (s *serviceImpl) UpdateNotificationPreference(ctx context.Context, request *notificationssvc.UpdateNotificationPreferenceRequest) (*notificationssvc.UpdateNotificationPreferenceResponse, error) {
+	ctx, span := s.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := s.logger.WithSpan(span)
+
+	sessionContextData, err := s.sessionContextDataFetcher(ctx)
+	if err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.Unauthenticated, "unable to determine authentication")
+	}
+
+	if request == nil || request.Input == nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(platformerrors.New("input required"), logger, span, codes.InvalidArgument, "input required")
+	}
+
+	logger = logger.WithValue(identitykeys.UserIDKey, sessionContextData.GetUserID()).WithValue(notificationkeys.NotificationTypeKey, request.NotificationType)
+	tracing.AttachToSpan(span, notificationkeys.NotificationTypeKey, request.NotificationType)
+
+	if !slices.Contains(notifications.NotificationTypes, request.NotificationType) {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(platformerrors.New("unknown notification type"), logger, span, codes.InvalidArgument, "unknown notification type")
+	}
+
+	input := converters.ConvertGRPCNotificationPreferenceUpdateRequestInputToNotificationPreferenceUpdateRequestInput(request.Input)
+	if err = input.ValidateWithContext(ctx); err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.InvalidArgument, "validating notification preference input")
+	}
+
+	existing, err := s.notificationsManager.GetNotificationPreference(ctx, sessionContextData.GetUserID(), request.NotificationType)
+	if err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.Internal, "fetching existing notification preference")
+	}
+
+	existing.Update(input)
+	if existing.ID == "" {
+		existing.ID = identifiers.New()
+	}
+
+	upsertInput := notificationsconverters.ConvertNotificationPreferenceToNotificationPreferenceDatabaseUpsertInput(existing)
+	if err = upsertInput.ValidateWithContext(ctx); err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.InvalidArgument, "validating updated notification preference")
+	}
+
+	updated, err := s.notificationsManager.UpsertNotificationPreference(ctx, upsertInput)
+	if err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.Internal, "updating notification preference")
+	}
+
+	x := &notificationssvc.UpdateNotificationPreferenceResponse{
+		ResponseDetails: &grpctypes.ResponseDetails{
+			TraceId: span.SpanContext().TraceID().String(),
+		},
+		Updated: converters.ConvertNotificationPreferenceToGRPCNotificationPreference(updated),
+	}
+
+	return x, nil
+}
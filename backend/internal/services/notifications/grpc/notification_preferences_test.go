@@ -0,0 +1,207 @@
+package grpc
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/notifications"
+	notificationsfakes "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/notifications/fakes"
+	notificationssvc "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/grpc/generated/services/notifications"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/testutils"
+
+	"github.com/primandproper/platform/reflection"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestServiceImpl_GetNotificationPreferences(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		service, mockRepo := buildTestService(t)
+
+		userID := "test-user-id"
+		fakePreferences := notificationsfakes.BuildFakeNotificationPreferencesList()
+
+		mockRepo.On(reflection.GetMethodName(mockRepo.GetNotificationPreferencesForUser), testutils.ContextMatcher, userID).Return(fakePreferences, nil)
+
+		response, err := service.GetNotificationPreferences(ctx, &notificationssvc.GetNotificationPreferencesRequest{})
+
+		assert.NoError(t, err)
+		assert.NotNil(t, response)
+		assert.NotNil(t, response.ResponseDetails)
+		assert.Len(t, response.Results, len(fakePreferences))
+
+		mock.AssertExpectationsForObjects(t, mockRepo)
+	})
+
+	t.Run("session context error", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		service := buildTestServiceWithSessionError(t)
+
+		response, err := service.GetNotificationPreferences(ctx, &notificationssvc.GetNotificationPreferencesRequest{})
+
+		assert.Error(t, err)
+		assert.Nil(t, response)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		service, mockRepo := buildTestService(t)
+
+		mockRepo.On(reflection.GetMethodName(mockRepo.GetNotificationPreferencesForUser), testutils.ContextMatcher, "test-user-id").Return(([]*notifications.NotificationPreference)(nil), errors.New("blah"))
+
+		response, err := service.GetNotificationPreferences(ctx, &notificationssvc.GetNotificationPreferencesRequest{})
+
+		assert.Error(t, err)
+		assert.Nil(t, response)
+		assert.Equal(t, codes.Internal, status.Code(err))
+
+		mock.AssertExpectationsForObjects(t, mockRepo)
+	})
+}
+
+func TestServiceImpl_UpdateNotificationPreference(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		service, mockRepo := buildTestService(t)
+
+		userID := "test-user-id"
+		existing := notifications.DefaultNotificationPreference(userID, notifications.NotificationTypeMealPlanCreated)
+
+		mockRepo.On(reflection.GetMethodName(mockRepo.GetNotificationPreference), testutils.ContextMatcher, userID, notifications.NotificationTypeMealPlanCreated).Return(existing, nil)
+		mockRepo.On(
+			reflection.GetMethodName(mockRepo.UpsertNotificationPreference),
+			testutils.ContextMatcher,
+			mock.MatchedBy(func(input *notifications.NotificationPreferenceDatabaseUpsertInput) bool {
+				return input.ID != "" &&
+					input.BelongsToUser == userID &&
+					!input.PushEnabled &&
+					input.DigestMode == notifications.NotificationDigestModeDaily
+			}),
+		).Return(&notifications.NotificationPreference{
+			ID:               "preference-id",
+			BelongsToUser:    userID,
+			NotificationType: notifications.NotificationTypeMealPlanCreated,
+			DigestMode:       notifications.NotificationDigestModeDaily,
+			Timezone:         notifications.DefaultNotificationPreferenceTimezone,
+			EmailEnabled:     true,
+			InAppEnabled:     true,
+		}, nil)
+
+		request := &notificationssvc.UpdateNotificationPreferenceRequest{
+			NotificationType: notifications.NotificationTypeMealPlanCreated,
+			Input: &notificationssvc.NotificationPreferenceUpdateRequestInput{
+				PushEnabled: new(false),
+				DigestMode:  new(notifications.NotificationDigestModeDaily),
+			},
+		}
+
+		response, err := service.UpdateNotificationPreference(ctx, request)
+
+		assert.NoError(t, err)
+		assert.NotNil(t, response)
+		assert.NotNil(t, response.Updated)
+		assert.False(t, response.Updated.PushEnabled)
+		assert.Equal(t, notifications.NotificationDigestModeDaily, response.Updated.DigestMode)
+
+		mock.AssertExpectationsForObjects(t, mockRepo)
+	})
+
+	t.Run("session context error", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		service := buildTestServiceWithSessionError(t)
+
+		response, err := service.UpdateNotificationPreference(ctx, &notificationssvc.UpdateNotificationPreferenceRequest{})
+
+		assert.Error(t, err)
+		assert.Nil(t, response)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("with unknown notification type", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		service, mockRepo := buildTestService(t)
+
+		request := &notificationssvc.UpdateNotificationPreferenceRequest{
+			NotificationType: "not_a_real_type",
+			Input:            &notificationssvc.NotificationPreferenceUpdateRequestInput{},
+		}
+
+		response, err := service.UpdateNotificationPreference(ctx, request)
+
+		assert.Error(t, err)
+		assert.Nil(t, response)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		mock.AssertExpectationsForObjects(t, mockRepo)
+	})
+
+	t.Run("with incomplete quiet hours", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		service, mockRepo := buildTestService(t)
+
+		userID := "test-user-id"
+		existing := notifications.DefaultNotificationPreference(userID, notifications.NotificationTypeMealPlanTaskDue)
+
+		mockRepo.On(reflection.GetMethodName(mockRepo.GetNotificationPreference), testutils.ContextMatcher, userID, notifications.NotificationTypeMealPlanTaskDue).Return(existing, nil)
+
+		request := &notificationssvc.UpdateNotificationPreferenceRequest{
+			NotificationType: notifications.NotificationTypeMealPlanTaskDue,
+			Input: &notificationssvc.NotificationPreferenceUpdateRequestInput{
+				QuietHoursStart: new("22:00"),
+			},
+		}
+
+		response, err := service.UpdateNotificationPreference(ctx, request)
+
+		assert.Error(t, err)
+		assert.Nil(t, response)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+
+		mock.AssertExpectationsForObjects(t, mockRepo)
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		service, mockRepo := buildTestService(t)
+
+		mockRepo.On(reflection.GetMethodName(mockRepo.GetNotificationPreference), testutils.ContextMatcher, "test-user-id", notifications.NotificationTypeMealPlanCreated).Return((*notifications.NotificationPreference)(nil), errors.New("blah"))
+
+		request := &notificationssvc.UpdateNotificationPreferenceRequest{
+			NotificationType: notifications.NotificationTypeMealPlanCreated,
+			Input:            &notificationssvc.NotificationPreferenceUpdateRequestInput{},
+		}
+
+		response, err := service.UpdateNotificationPreference(ctx, request)
+
+		assert.Error(t, err)
+		assert.Nil(t, response)
+		assert.Equal(t, codes.Internal, status.Code(err))
+
+		mock.AssertExpectationsForObjects(t, mockRepo)
+	})
+}
@@ -20,6 +20,12 @@ func ProvideMethodPermissions() NotificationsMethodPermissions {
 		notificationssvc.UserNotificationsService_UpdateUserNotification_FullMethodName: {
 			authorization.UpdateUserNotificationsPermission,
 		},
+		notificationssvc.UserNotificationsService_MarkAllUserNotificationsAsRead_FullMethodName: {
+			authorization.UpdateUserNotificationsPermission,
+		},
+		notificationssvc.UserNotificationsService_GetUnreadUserNotificationCount_FullMethodName: {
+			authorization.ReadUserNotificationsPermission,
+		},
 		notificationssvc.UserNotificationsService_RegisterDeviceToken_FullMethodName: {
 			authorization.CreateUserDeviceTokensPermission,
 		},
@@ -32,5 +38,11 @@ func ProvideMethodPermissions() NotificationsMethodPermissions {
 		notificationssvc.UserNotificationsService_ArchiveUserDeviceToken_FullMethodName: {
 			authorization.ArchiveUserDeviceTokensPermission,
 		},
+		notificationssvc.UserNotificationsService_GetNotificationPreferences_FullMethodName: {
+			authorization.ReadUserNotificationsPermission,
+		},
+		notificationssvc.UserNotificationsService_UpdateNotificationPreference_FullMethodName: {
+			authorization.UpdateUserNotificationsPermission,
+		},
 	}
 }
@@ -117,3 +117,59 @@ func (s *serviceImpl) UpdateUserNotification(ctx context.Context, request *notif
 
 	return x, nil
 }
+
+func (s *serviceImpl) MarkAllUserNotificationsAsRead(ctx context.Context, _ *notificationssvc.MarkAllUserNotificationsAsReadRequest) (*notificationssvc.MarkAllUserNotificationsAsReadResponse, error) {
+	ctx, span := s.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := s.logger.WithSpan(span)
+
+	sessionContextData, err := s.sessionContextDataFetcher(ctx)
+	if err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.Unauthenticated, "unable to determine authentication")
+	}
+
+	logger = logger.WithValue(identitykeys.UserIDKey, sessionContextData.GetUserID())
+
+	marked, err := s.notificationsManager.MarkAllUserNotificationsAsRead(ctx, sessionContextData.GetUserID())
+	if err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.Internal, "marking user notifications as read")
+	}
+
+	x := &notificationssvc.MarkAllUserNotificationsAsReadResponse{
+		ResponseDetails: &grpctypes.ResponseDetails{
+			TraceId: span.SpanContext().TraceID().String(),
+		},
+		Marked: marked,
+	}
+
+	return x, nil
+}
+
+func (s *serviceImpl) GetUnreadUserNotificationCount(ctx context.Context, _ *notificationssvc.GetUnreadUserNotificationCountRequest) (*notificationssvc.GetUnreadUserNotificationCountResponse, error) {
+	ctx, span := s.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := s.logger.WithSpan(span)
+
+	sessionContextData, err := s.sessionContextDataFetcher(ctx)
+	if err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.Unauthenticated, "unable to determine authentication")
+	}
+
+	logger = logger.WithValue(identitykeys.UserIDKey, sessionContextData.GetUserID())
+
+	count, err := s.notificationsManager.GetUnreadUserNotificationCount(ctx, sessionContextData.GetUserID())
+	if err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.Internal, "counting unread user notifications")
+	}
+
+	x := &notificationssvc.GetUnreadUserNotificationCountResponse{
+		ResponseDetails: &grpctypes.ResponseDetails{
+			TraceId: span.SpanContext().TraceID().String(),
+		},
+		Count: count,
+	}
+
+	return x, nil
+}
@@ -342,3 +342,105 @@ func TestServiceImpl_UpdateUserNotification(t *testing.T) {
 		mock.AssertExpectationsForObjects(t, mockRepo)
 	})
 }
+
+func TestServiceImpl_MarkAllUserNotificationsAsRead(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		service, mockRepo := buildTestService(t)
+
+		mockRepo.On(reflection.GetMethodName(mockRepo.MarkAllUserNotificationsAsRead), testutils.ContextMatcher, "test-user-id").Return(int64(3), nil)
+
+		response, err := service.MarkAllUserNotificationsAsRead(ctx, &notificationssvc.MarkAllUserNotificationsAsReadRequest{})
+
+		assert.NoError(t, err)
+		assert.NotNil(t, response)
+		assert.Equal(t, int64(3), response.Marked)
+
+		mock.AssertExpectationsForObjects(t, mockRepo)
+	})
+
+	t.Run("session context error", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		service := buildTestServiceWithSessionError(t)
+
+		response, err := service.MarkAllUserNotificationsAsRead(ctx, &notificationssvc.MarkAllUserNotificationsAsReadRequest{})
+
+		assert.Error(t, err)
+		assert.Nil(t, response)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		service, mockRepo := buildTestService(t)
+
+		mockRepo.On(reflection.GetMethodName(mockRepo.MarkAllUserNotificationsAsRead), testutils.ContextMatcher, "test-user-id").Return(int64(0), errors.New("repository error"))
+
+		response, err := service.MarkAllUserNotificationsAsRead(ctx, &notificationssvc.MarkAllUserNotificationsAsReadRequest{})
+
+		assert.Error(t, err)
+		assert.Nil(t, response)
+		assert.Equal(t, codes.Internal, status.Code(err))
+
+		mock.AssertExpectationsForObjects(t, mockRepo)
+	})
+}
+
+func TestServiceImpl_GetUnreadUserNotificationCount(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		service, mockRepo := buildTestService(t)
+
+		mockRepo.On(reflection.GetMethodName(mockRepo.GetUnreadUserNotificationCount), testutils.ContextMatcher, "test-user-id").Return(uint64(7), nil)
+
+		response, err := service.GetUnreadUserNotificationCount(ctx, &notificationssvc.GetUnreadUserNotificationCountRequest{})
+
+		assert.NoError(t, err)
+		assert.NotNil(t, response)
+		assert.Equal(t, uint64(7), response.Count)
+
+		mock.AssertExpectationsForObjects(t, mockRepo)
+	})
+
+	t.Run("session context error", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		service := buildTestServiceWithSessionError(t)
+
+		response, err := service.GetUnreadUserNotificationCount(ctx, &notificationssvc.GetUnreadUserNotificationCountRequest{})
+
+		assert.Error(t, err)
+		assert.Nil(t, response)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		service, mockRepo := buildTestService(t)
+
+		mockRepo.On(reflection.GetMethodName(mockRepo.GetUnreadUserNotificationCount), testutils.ContextMatcher, "test-user-id").Return(uint64(0), errors.New("repository error"))
+
+		response, err := service.GetUnreadUserNotificationCount(ctx, &notificationssvc.GetUnreadUserNotificationCountRequest{})
+
+		assert.Error(t, err)
+		assert.Nil(t, response)
+		assert.Equal(t, codes.Internal, status.Code(err))
+
+		mock.AssertExpectationsForObjects(t, mockRepo)
+	})
+}
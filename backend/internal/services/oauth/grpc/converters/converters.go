@@ -8,38 +8,52 @@
 
 func ConvertGRPCOAuth2ClientCreationRequestInputToOAuth2ClientCreationRequestInput(input *oauthsvc.OAuth2ClientCreationRequestInput) *oauth.OAuth2ClientCreationRequestInput {
 	return &oauth.OAuth2ClientCreationRequestInput{
-		Name:        input.Name,
-		Description: input.Description,
+		Name:              input.Name,
+		Description:       input.Description,
+		AllowedScopes:     input.AllowedScopes,
+		RedirectURIs:      input.RedirectUris,
+		AllowedGrantTypes: input.AllowedGrantTypes,
 	}
 }
 
 func ConvertOAuth2ClientCreationRequestInputToGRPCOAuth2ClientCreationRequestInput(input *oauth.OAuth2ClientCreationRequestInput) *oauthsvc.OAuth2ClientCreationRequestInput {
 	return &oauthsvc.OAuth2ClientCreationRequestInput{
-		Name:        input.Name,
-		Description: input.Description,
+		Name:              input.Name,
+		Description:       input.Description,
+		AllowedScopes:     input.AllowedScopes,
+		RedirectUris:      input.RedirectURIs,
+		AllowedGrantTypes: input.AllowedGrantTypes,
 	}
 }
 
 func ConvertOAuth2ClientToGRPCOAuth2Client(client *oauth.OAuth2Client) *oauthsvc.OAuth2Client {
 	return &oauthsvc.OAuth2Client{
-		CreatedAt:    grpcconverters.ConvertTimeToPBTimestamp(client.CreatedAt),
-		ArchivedAt:   grpcconverters.ConvertTimePointerToPBTimestamp(client.ArchivedAt),
-		Name:         client.Name,
-		Description:  client.Description,
-		ClientId:     client.ClientID,
-		Id:           client.ID,
-		ClientSecret: client.ClientSecret,
+		CreatedAt:         grpcconverters.ConvertTimeToPBTimestamp(client.CreatedAt),
+		ArchivedAt:        grpcconverters.ConvertTimePointerToPBTimestamp(client.ArchivedAt),
+		BelongsToUser:     client.BelongsToUser,
+		Name:              client.Name,
+		Description:       client.Description,
+		ClientId:          client.ClientID,
+		Id:                client.ID,
+		ClientSecret:      client.ClientSecret,
+		AllowedScopes:     client.AllowedScopes,
+		RedirectUris:      client.RedirectURIs,
+		AllowedGrantTypes: client.AllowedGrantTypes,
 	}
 }
 
 func ConvertGRPCOAuth2ClientToOAuth2Client(client *oauthsvc.OAuth2Client) *oauth.OAuth2Client {
 	return &oauth.OAuth2Client{
-		CreatedAt:    grpcconverters.ConvertPBTimestampToTime(client.CreatedAt),
-		ArchivedAt:   grpcconverters.ConvertPBTimestampToTimePointer(client.ArchivedAt),
-		Name:         client.Name,
-		Description:  client.Description,
-		ClientID:     client.ClientId,
-		ID:           client.Id,
-		ClientSecret: client.ClientSecret,
+		CreatedAt:         grpcconverters.ConvertPBTimestampToTime(client.CreatedAt),
+		ArchivedAt:        grpcconverters.ConvertPBTimestampToTimePointer(client.ArchivedAt),
+		BelongsToUser:     client.BelongsToUser,
+		Name:              client.Name,
+		Description:       client.Description,
+		ClientID:          client.ClientId,
+		ID:                client.Id,
+		ClientSecret:      client.ClientSecret,
+		AllowedScopes:     client.AllowedScopes,
+		RedirectURIs:      client.RedirectUris,
+		AllowedGrantTypes: client.AllowedGrantTypes,
 	}
 }
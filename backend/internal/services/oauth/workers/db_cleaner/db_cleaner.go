@@ -3,6 +3,7 @@
 import (
 	"context"
 
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/audit"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/internalops"
 
 	"github.com/primandproper/platform/observability/logging"
@@ -22,6 +23,9 @@ type Job struct {
 	tracer                tracing.Tracer
 	handledRecordsCounter metrics.Int64Counter
 	dataManager           internalops.InternalOpsDataManager
+	auditLogRetention     *audit.RetentionConfig
+	auditLogChains        audit.AuditLogChainDataManager
+	checkpointSigner      *audit.CheckpointSigner
 }
 
 func NewDBCleaner(
@@ -29,17 +33,34 @@ func NewDBCleaner(
 	tracerProvider tracing.TracerProvider,
 	metricsProvider metrics.Provider,
 	dataManager internalops.InternalOpsDataManager,
+	auditLogRetention *audit.RetentionConfig,
+	auditLogChains audit.AuditLogChainDataManager,
+	auditLogChain *audit.ChainConfig,
 ) (*Job, error) {
 	handledRecordsCounter, err := metricsProvider.NewInt64Counter("db_cleaner.handled_records")
 	if err != nil {
 		return nil, err
 	}
 
+	signingKey, err := auditLogChain.SigningKey()
+	if err != nil {
+		return nil, err
+	}
+
+	// without a signing key, audit log chains are still written but never checkpointed.
+	var checkpointSigner *audit.CheckpointSigner
+	if signingKey != nil {
+		checkpointSigner = audit.NewCheckpointSigner(signingKey)
+	}
+
 	return &Job{
 		logger:                logging.NewNamedLogger(logger, serviceName),
 		tracer:                tracing.NewNamedTracer(tracerProvider, serviceName),
 		handledRecordsCounter: handledRecordsCounter,
 		dataManager:           dataManager,
+		auditLogRetention:     auditLogRetention,
+		auditLogChains:        auditLogChains,
+		checkpointSigner:      checkpointSigner,
 	}, nil
 }
 
@@ -60,5 +81,94 @@ func (j *Job) Do(ctx context.Context) error {
 		},
 	))
 
+	deleted, err = j.dataManager.DeleteExpiredIdempotencyKeys(ctx)
+	if err != nil {
+		j.logger.Error("deleting expired idempotency keys", err)
+		return err
+	}
+
+	j.handledRecordsCounter.Add(ctx, deleted, metric.WithAttributes(
+		attribute.KeyValue{
+			Key:   "db_table",
+			Value: attribute.StringValue("idempotency_keys"),
+		},
+	))
+
+	deleted, err = j.dataManager.DeleteExpiredUserNotifications(ctx)
+	if err != nil {
+		j.logger.Error("deleting expired user notifications", err)
+		return err
+	}
+
+	j.handledRecordsCounter.Add(ctx, deleted, metric.WithAttributes(
+		attribute.KeyValue{
+			Key:   "db_table",
+			Value: attribute.StringValue("user_notifications"),
+		},
+	))
+
+	deleted, err = j.dataManager.DeleteExpiredWebhookDeliveries(ctx)
+	if err != nil {
+		j.logger.Error("deleting expired webhook deliveries", err)
+		return err
+	}
+
+	j.handledRecordsCounter.Add(ctx, deleted, metric.WithAttributes(
+		attribute.KeyValue{
+			Key:   "db_table",
+			Value: attribute.StringValue("webhook_deliveries"),
+		},
+	))
+
+	deleted, err = j.dataManager.DeleteExpiredMealPlanActivities(ctx)
+	if err != nil {
+		j.logger.Error("deleting expired meal plan activities", err)
+		return err
+	}
+
+	j.handledRecordsCounter.Add(ctx, deleted, metric.WithAttributes(
+		attribute.KeyValue{
+			Key:   "db_table",
+			Value: attribute.StringValue("meal_plan_activities"),
+		},
+	))
+
+	deleted, err = j.dataManager.DeleteExpiredAuditLogEntries(ctx, j.auditLogRetention)
+	if err != nil {
+		j.logger.Error("deleting expired audit log entries", err)
+		return err
+	}
+
+	j.handledRecordsCounter.Add(ctx, deleted, metric.WithAttributes(
+		attribute.KeyValue{
+			Key:   "db_table",
+			Value: attribute.StringValue("audit_log_entries"),
+		},
+	))
+
+	if j.checkpointSigner == nil {
+		return nil
+	}
+
+	heads, err := j.auditLogChains.GetUncheckpointedAuditLogChainHeads(ctx)
+	if err != nil {
+		j.logger.Error("fetching uncheckpointed audit log chain heads", err)
+		return err
+	}
+
+	for _, head := range heads {
+		if _, err = j.auditLogChains.CreateAuditLogCheckpoint(ctx, j.checkpointSigner.Sign(head)); err != nil {
+			j.logger.Error("checkpointing audit log chain", err)
+			return err
+		}
+	}
+
+	j.handledRecordsCounter.Add(ctx, int64(len(heads)), metric.WithAttributes(
+		attribute.KeyValue{
+			Key:   "db_table",
+			Value: attribute.StringValue("audit_log_checkpoints"),
+		},
+	))
+
 	return nil
 }
@@ -1,6 +1,7 @@
 package dbcleaner
 
 import (
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/audit"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/internalops"
 
 	"github.com/primandproper/platform/observability/logging"
@@ -18,6 +19,9 @@ func RegisterDBCleaner(i do.Injector) {
 			do.MustInvoke[tracing.TracerProvider](i),
 			do.MustInvoke[metrics.Provider](i),
 			do.MustInvoke[internalops.InternalOpsDataManager](i),
+			do.MustInvoke[*audit.RetentionConfig](i),
+			do.MustInvoke[audit.Repository](i),
+			do.MustInvoke[*audit.ChainConfig](i),
 		)
 	})
 }
@@ -3,6 +3,7 @@
 import (
 	"context"
 	"strings"
+	"time"
 
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/payments"
 
@@ -57,6 +58,7 @@ func NewRevenueCatPaymentProcessor(
 
 // revenueCatWebhookPayload represents the structure of a RevenueCat webhook event.
 type revenueCatWebhookPayload struct {
+	EventTimestampMs *int64   `json:"event_timestamp_ms"`
 	ExpirationAtMs   *int64   `json:"expiration_at_ms"`
 	PurchasedAtMs    *int64   `json:"purchased_at_ms"`
 	ProductID        string   `json:"product_id"`
@@ -111,10 +113,23 @@ func (r *RevenueCatPaymentProcessor) ParseWebhookEvent(ctx context.Context, payl
 		status = payments.SubscriptionStatusCancelled
 	}
 
+	var occurredAt time.Time
+	if p.EventTimestampMs != nil {
+		occurredAt = time.UnixMilli(*p.EventTimestampMs).UTC()
+	}
+
+	// each renewal carries a new transaction_id, but original_transaction_id stays the same for the life of the subscription.
+	subscriptionID := p.OriginalTxnID
+	if subscriptionID == "" {
+		subscriptionID = p.TransactionID
+	}
+
 	return &payments.ParsedWebhookEvent{
+		OccurredAt:     occurredAt,
+		EventID:        p.ID,
 		EventType:      p.Type,
 		AccountID:      p.AppUserID,
-		SubscriptionID: p.TransactionID,
+		SubscriptionID: subscriptionID,
 		ProductID:      p.ProductID,
 		Status:         status,
 	}, nil
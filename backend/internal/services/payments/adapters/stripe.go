@@ -3,6 +3,7 @@
 import (
 	"context"
 	"encoding/json"
+	"time"
 
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity/keys"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/payments"
@@ -79,7 +80,9 @@ func (s *StripePaymentProcessor) ParseWebhookEvent(ctx context.Context, payload
 	}
 
 	result := &payments.ParsedWebhookEvent{
-		EventType: string(event.Type),
+		OccurredAt: time.Unix(event.Created, 0).UTC(),
+		EventID:    event.ID,
+		EventType:  string(event.Type),
 	}
 
 	switch event.Type {
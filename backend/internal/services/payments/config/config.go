@@ -1,9 +1,26 @@
 package config
 
+import (
+	"context"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
 // Config holds payments service configuration.
 type Config struct {
-	Stripe     *StripeConfig     `env:"init" envPrefix:"STRIPE_"     json:"stripe"`
-	RevenueCat *RevenueCatConfig `env:"init" envPrefix:"REVENUECAT_" json:"revenueCat"`
+	Stripe       *StripeConfig      `env:"init"                envPrefix:"STRIPE_"     json:"stripe"`
+	RevenueCat   *RevenueCatConfig  `env:"init"                envPrefix:"REVENUECAT_" json:"revenueCat"`
+	Entitlements EntitlementsConfig `envPrefix:"ENTITLEMENTS_" json:"entitlements"`
+}
+
+var _ validation.ValidatableWithContext = (*Config)(nil)
+
+// ValidateWithContext validates a Config struct.
+func (cfg *Config) ValidateWithContext(ctx context.Context) error {
+	return validation.ValidateStructWithContext(ctx, cfg,
+		validation.Field(&cfg.Entitlements),
+	)
 }
 
 // StripeConfig holds Stripe-specific configuration.
@@ -17,3 +34,26 @@ type RevenueCatConfig struct {
 	APIKey            string `env:"API_KEY"             json:"apiKey"`
 	WebhookAuthHeader string `env:"WEBHOOK_AUTH_HEADER" json:"webhookAuthHeader"`
 }
+
+// EntitlementsConfig configures how subscriptions translate into account entitlements.
+// When Enabled is false, every account is treated as entitled to everything.
+type EntitlementsConfig struct {
+	_ struct{} `json:"-"`
+
+	FreeTierFeatures            []string      `env:"FREE_TIER_FEATURES"                json:"freeTierFeatures"`
+	GracePeriod                 time.Duration `env:"GRACE_PERIOD"                      json:"gracePeriod"`
+	FreeTierMaxMealPlansPerWeek int32         `env:"FREE_TIER_MAX_MEAL_PLANS_PER_WEEK" json:"freeTierMaxMealPlansPerWeek"`
+	FreeTierMaxAccountMembers   int32         `env:"FREE_TIER_MAX_ACCOUNT_MEMBERS"     json:"freeTierMaxAccountMembers"`
+	Enabled                     bool          `env:"ENABLED"                           json:"enabled"`
+}
+
+var _ validation.ValidatableWithContext = (*EntitlementsConfig)(nil)
+
+// ValidateWithContext validates an EntitlementsConfig struct.
+func (cfg EntitlementsConfig) ValidateWithContext(ctx context.Context) error {
+	return validation.ValidateStructWithContext(ctx, &cfg,
+		validation.Field(&cfg.GracePeriod, validation.Min(time.Duration(0))),
+		validation.Field(&cfg.FreeTierMaxMealPlansPerWeek, validation.Min(-1)),
+		validation.Field(&cfg.FreeTierMaxAccountMembers, validation.Min(-1)),
+	)
+}
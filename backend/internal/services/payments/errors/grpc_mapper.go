@@ -0,0 +1,33 @@
+package errors
+
+import (
+	"errors"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/payments"
+
+	"github.com/primandproper/platform/errors/grpc"
+
+	"google.golang.org/grpc/codes"
+)
+
+func init() {
+	grpc.RegisterGRPCErrorMapper(paymentsGRPCMapper{})
+}
+
+type paymentsGRPCMapper struct{}
+
+func (paymentsGRPCMapper) Map(err error) (code codes.Code, ok bool) {
+	if err == nil {
+		return codes.Unknown, false
+	}
+	switch {
+	case errors.Is(err, payments.ErrFeatureNotEntitled):
+		return codes.PermissionDenied, true
+	case errors.Is(err, payments.ErrEntitlementLimitReached):
+		return codes.ResourceExhausted, true
+	case errors.Is(err, payments.ErrPaymentProviderEventInProgress):
+		return codes.Aborted, true
+	default:
+		return codes.Unknown, false
+	}
+}
@@ -0,0 +1,31 @@
+package errors
+
+import (
+	"errors"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/payments"
+
+	httperrors "github.com/primandproper/platform/errors/http"
+)
+
+func init() {
+	httperrors.RegisterHTTPErrorMapper(paymentsHTTPMapper{})
+}
+
+type paymentsHTTPMapper struct{}
+
+func (paymentsHTTPMapper) Map(err error) (code httperrors.ErrorCode, msg string, ok bool) {
+	if err == nil {
+		return "", "", false
+	}
+	switch {
+	case errors.Is(err, payments.ErrFeatureNotEntitled):
+		return httperrors.ErrValidatingRequestInput, "not entitled", true
+	case errors.Is(err, payments.ErrEntitlementLimitReached):
+		return httperrors.ErrValidatingRequestInput, "plan limit reached", true
+	case errors.Is(err, payments.ErrPaymentProviderEventInProgress):
+		return httperrors.ErrValidatingRequestInput, "already being processed", true
+	default:
+		return "", "", false
+	}
+}
@@ -18,6 +18,9 @@ func ConvertProductCreationRequestInputToDomain(input *paymentssvc.ProductCreati
 		Currency:          input.Currency,
 		ExternalProductID: input.ExternalProductId,
 	}
+	if input.Entitlements != nil {
+		out.Entitlements = *ConvertGRPCProductEntitlementsToProductEntitlements(input.Entitlements)
+	}
 	if input.BillingIntervalMonths != nil {
 		out.BillingIntervalMonths = input.BillingIntervalMonths
 	}
@@ -35,6 +38,7 @@ func ConvertProductCreationRequestInputToGRPC(input *payments.ProductCreationReq
 		AmountCents:       input.AmountCents,
 		Currency:          input.Currency,
 		ExternalProductId: input.ExternalProductID,
+		Entitlements:      ConvertProductEntitlementsToGRPC(&input.Entitlements),
 	}
 	if input.BillingIntervalMonths != nil {
 		out.BillingIntervalMonths = input.BillingIntervalMonths
@@ -69,6 +73,7 @@ func ConvertProductUpdateRequestInputToDomain(input *paymentssvc.ProductUpdateRe
 		Currency:              input.Currency,
 		BillingIntervalMonths: input.BillingIntervalMonths,
 		ExternalProductID:     input.ExternalProductId,
+		Entitlements:          ConvertGRPCProductEntitlementsToProductEntitlements(input.Entitlements),
 	}
 }
 
@@ -85,6 +90,7 @@ func ConvertProductToGRPC(p *payments.Product) *paymentssvc.Product {
 		Currency:              p.Currency,
 		BillingIntervalMonths: p.BillingIntervalMonths,
 		ExternalProductId:     p.ExternalProductID,
+		Entitlements:          ConvertProductEntitlementsToGRPC(&p.Entitlements),
 		CreatedAt:             grpcconverters.ConvertTimeToPBTimestamp(p.CreatedAt),
 		LastUpdatedAt:         grpcconverters.ConvertTimePointerToPBTimestamp(p.LastUpdatedAt),
 		ArchivedAt:            grpcconverters.ConvertTimePointerToPBTimestamp(p.ArchivedAt),
@@ -95,7 +101,7 @@ func ConvertGRPCProductToProduct(p *paymentssvc.Product) *payments.Product {
 	if p == nil {
 		return nil
 	}
-	return &payments.Product{
+	out := &payments.Product{
 		ID:                    p.Id,
 		Name:                  p.Name,
 		Description:           p.Description,
@@ -108,6 +114,62 @@ func ConvertGRPCProductToProduct(p *paymentssvc.Product) *payments.Product {
 		LastUpdatedAt:         grpcconverters.ConvertPBTimestampToTimePointer(p.LastUpdatedAt),
 		ArchivedAt:            grpcconverters.ConvertPBTimestampToTimePointer(p.ArchivedAt),
 	}
+	if p.Entitlements != nil {
+		out.Entitlements = *ConvertGRPCProductEntitlementsToProductEntitlements(p.Entitlements)
+	}
+	return out
+}
+
+func ConvertProductEntitlementsToGRPC(e *payments.ProductEntitlements) *paymentssvc.ProductEntitlements {
+	if e == nil {
+		return nil
+	}
+	return &paymentssvc.ProductEntitlements{
+		Features:            e.Features,
+		MaxMealPlansPerWeek: e.MaxMealPlansPerWeek,
+		MaxAccountMembers:   e.MaxAccountMembers,
+	}
+}
+
+func ConvertGRPCProductEntitlementsToProductEntitlements(e *paymentssvc.ProductEntitlements) *payments.ProductEntitlements {
+	if e == nil {
+		return nil
+	}
+	return &payments.ProductEntitlements{
+		Features:            e.Features,
+		MaxMealPlansPerWeek: e.MaxMealPlansPerWeek,
+		MaxAccountMembers:   e.MaxAccountMembers,
+	}
+}
+
+func ConvertAccountEntitlementsToGRPC(e *payments.AccountEntitlements) *paymentssvc.AccountEntitlements {
+	if e == nil {
+		return nil
+	}
+	return &paymentssvc.AccountEntitlements{
+		GracePeriodEndsAt:   grpcconverters.ConvertTimePointerToPBTimestamp(e.GracePeriodEndsAt),
+		AccountId:           e.AccountID,
+		ProductIds:          e.ProductIDs,
+		Features:            e.Features,
+		MaxMealPlansPerWeek: e.MaxMealPlansPerWeek,
+		MaxAccountMembers:   e.MaxAccountMembers,
+		InGracePeriod:       e.InGracePeriod,
+	}
+}
+
+func ConvertGRPCAccountEntitlementsToAccountEntitlements(e *paymentssvc.AccountEntitlements) *payments.AccountEntitlements {
+	if e == nil {
+		return nil
+	}
+	return &payments.AccountEntitlements{
+		GracePeriodEndsAt:   grpcconverters.ConvertPBTimestampToTimePointer(e.GracePeriodEndsAt),
+		AccountID:           e.AccountId,
+		ProductIDs:          e.ProductIds,
+		Features:            e.Features,
+		MaxMealPlansPerWeek: e.MaxMealPlansPerWeek,
+		MaxAccountMembers:   e.MaxAccountMembers,
+		InGracePeriod:       e.InGracePeriod,
+	}
 }
 
 func ConvertGRPCSubscriptionToSubscription(s *paymentssvc.Subscription) *payments.Subscription {
@@ -210,3 +272,23 @@ func ConvertPaymentTransactionToGRPC(t *payments.PaymentTransaction) *paymentssv
 	}
 	return out
 }
+
+func ConvertPaymentProviderEventToGRPC(e *payments.PaymentProviderEvent) *paymentssvc.PaymentProviderEvent {
+	if e == nil {
+		return nil
+	}
+	return &paymentssvc.PaymentProviderEvent{
+		Id:                     e.ID,
+		Provider:               e.Provider,
+		ProviderEventId:        e.ProviderEventID,
+		EventType:              e.EventType,
+		ExternalSubscriptionId: e.ExternalSubscriptionID,
+		AccountId:              e.AccountID,
+		Payload:                e.Payload,
+		Status:                 e.Status,
+		ProcessingError:        e.ProcessingError,
+		OccurredAt:             grpcconverters.ConvertTimeToPBTimestamp(e.OccurredAt),
+		ProcessedAt:            grpcconverters.ConvertTimePointerToPBTimestamp(e.ProcessedAt),
+		CreatedAt:              grpcconverters.ConvertTimeToPBTimestamp(e.CreatedAt),
+	}
+}
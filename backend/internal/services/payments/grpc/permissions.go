@@ -21,5 +21,8 @@ func ProvideMethodPermissions() PaymentsMethodPermissions {
 		paymentssvc.PaymentsService_ArchiveSubscription_FullMethodName:         {authorization.ArchiveSubscriptionsPermission},
 		paymentssvc.PaymentsService_GetPurchasesForAccount_FullMethodName:      {authorization.ReadPurchasesPermission},
 		paymentssvc.PaymentsService_GetPaymentHistoryForAccount_FullMethodName: {authorization.ReadPaymentHistoryPermission},
+		paymentssvc.PaymentsService_GetEntitlementsForAccount_FullMethodName:   {authorization.ReadEntitlementsPermission},
+		paymentssvc.PaymentsService_GetPaymentProviderEvents_FullMethodName:    {authorization.ReadPaymentProviderEventsPermission},
+		paymentssvc.PaymentsService_ReplayPaymentProviderEvent_FullMethodName:  {authorization.ReplayPaymentProviderEventsPermission},
 	}
 }
@@ -1,8 +1,12 @@
 package grpc
 
 import (
+	"context"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/authentication/sessions"
 	paymentsmanager "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/payments/manager"
 	paymentssvc "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/grpc/generated/services/payments"
+	_ "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/services/payments/errors"
 
 	"github.com/primandproper/platform/observability/logging"
 	"github.com/primandproper/platform/observability/tracing"
@@ -16,9 +20,10 @@
 
 type serviceImpl struct {
 	paymentssvc.UnimplementedPaymentsServiceServer
-	tracer          tracing.Tracer
-	logger          logging.Logger
-	paymentsManager paymentsmanager.PaymentsDataManager
+	tracer                    tracing.Tracer
+	logger                    logging.Logger
+	paymentsManager           paymentsmanager.PaymentsDataManager
+	sessionContextDataFetcher func(context.Context) (*sessions.ContextData, error)
 }
 
 func NewService(
@@ -27,8 +32,9 @@ func NewService(
 	paymentsManager paymentsmanager.PaymentsDataManager,
 ) paymentssvc.PaymentsServiceServer {
 	return &serviceImpl{
-		logger:          logging.NewNamedLogger(logger, o11yName),
-		tracer:          tracing.NewNamedTracer(tracerProvider, o11yName),
-		paymentsManager: paymentsManager,
+		logger:                    logging.NewNamedLogger(logger, o11yName),
+		tracer:                    tracing.NewNamedTracer(tracerProvider, o11yName),
+		paymentsManager:           paymentsManager,
+		sessionContextDataFetcher: sessions.FetchContextDataFromContext,
 	}
 }
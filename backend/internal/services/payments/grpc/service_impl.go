@@ -3,6 +3,7 @@
 import (
 	"context"
 
+	identitykeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity/keys"
 	paymentskeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/payments/keys"
 	grpcconverters "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/grpc/converters"
 	paymentssvc "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/grpc/generated/services/payments"
@@ -12,6 +13,7 @@
 	errorsgrpc "github.com/primandproper/platform/errors/grpc"
 
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func (s *serviceImpl) CreateProduct(ctx context.Context, request *paymentssvc.CreateProductRequest) (*paymentssvc.CreateProductResponse, error) {
@@ -209,3 +211,71 @@ func (s *serviceImpl) GetPaymentHistoryForAccount(ctx context.Context, request *
 	}
 	return x, nil
 }
+
+func (s *serviceImpl) GetEntitlementsForAccount(ctx context.Context, request *paymentssvc.GetEntitlementsForAccountRequest) (*paymentssvc.GetEntitlementsForAccountResponse, error) {
+	ctx, span := s.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := s.logger.WithSpan(span)
+
+	sessionContextData, err := s.sessionContextDataFetcher(ctx)
+	if err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.Unauthenticated, "failed to get session context data")
+	}
+
+	accountID := request.AccountId
+	if accountID == "" {
+		accountID = sessionContextData.GetActiveAccountID()
+	}
+	logger = logger.WithValue(identitykeys.AccountIDKey, accountID)
+
+	// members can see their own accounts' entitlements; only service admins may look up anyone else's.
+	if _, isMember := sessionContextData.AccountPermissions[accountID]; !isMember && !sessionContextData.ServiceRolePermissionChecker().IsServiceAdmin() {
+		return nil, status.Error(codes.PermissionDenied, "not a member of the requested account")
+	}
+
+	entitlements, err := s.paymentsManager.GetEntitlementsForAccount(ctx, accountID)
+	if err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.Internal, "failed to resolve entitlements")
+	}
+
+	return &paymentssvc.GetEntitlementsForAccountResponse{
+		ResponseDetails: &types.ResponseDetails{TraceId: span.SpanContext().TraceID().String()},
+		Result:          converters.ConvertAccountEntitlementsToGRPC(entitlements),
+	}, nil
+}
+
+func (s *serviceImpl) GetPaymentProviderEvents(ctx context.Context, request *paymentssvc.GetPaymentProviderEventsRequest) (*paymentssvc.GetPaymentProviderEventsResponse, error) {
+	ctx, span := s.tracer.StartSpan(ctx)
+	defer span.End()
+
+	filter := grpcconverters.ConvertGRPCQueryFilterToQueryFilter(request.Filter)
+	results, err := s.paymentsManager.GetPaymentProviderEvents(ctx, request.GetProvider(), filter)
+	if err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, s.logger, span, codes.Internal, "failed to retrieve payment provider events")
+	}
+
+	x := &paymentssvc.GetPaymentProviderEventsResponse{
+		ResponseDetails: &types.ResponseDetails{TraceId: span.SpanContext().TraceID().String()},
+		Pagination:      grpcconverters.ConvertPaginationToGRPCPagination(results.Pagination, filter),
+	}
+	for _, e := range results.Data {
+		x.Results = append(x.Results, converters.ConvertPaymentProviderEventToGRPC(e))
+	}
+	return x, nil
+}
+
+func (s *serviceImpl) ReplayPaymentProviderEvent(ctx context.Context, request *paymentssvc.ReplayPaymentProviderEventRequest) (*paymentssvc.ReplayPaymentProviderEventResponse, error) {
+	ctx, span := s.tracer.StartSpan(ctx)
+	defer span.End()
+
+	event, err := s.paymentsManager.ReplayPaymentProviderEvent(ctx, request.PaymentProviderEventId)
+	if err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, s.logger.WithValue(paymentskeys.PaymentProviderEventIDKey, request.PaymentProviderEventId), span, codes.Internal, "failed to replay payment provider event")
+	}
+
+	return &paymentssvc.ReplayPaymentProviderEventResponse{
+		ResponseDetails: &types.ResponseDetails{TraceId: span.SpanContext().TraceID().String()},
+		Result:          converters.ConvertPaymentProviderEventToGRPC(event),
+	}, nil
+}
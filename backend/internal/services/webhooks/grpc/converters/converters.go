@@ -120,6 +120,52 @@ func ConvertWebhookTriggerEventCatalogToGRPCWebhookTriggerEvent(z *webhooks.Webh
 	}
 }
 
+// ConvertStringToWebhookDeliveryStatus converts a domain webhook delivery status to proto.
+func ConvertStringToWebhookDeliveryStatus(s string) webhookssvc.WebhookDeliveryStatus {
+	switch s {
+	case webhooks.WebhookDeliveryStatusSucceeded:
+		return webhookssvc.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_SUCCEEDED
+	case webhooks.WebhookDeliveryStatusFailed:
+		return webhookssvc.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_FAILED
+	case webhooks.WebhookDeliveryStatusDeadLettered:
+		return webhookssvc.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_DEAD_LETTERED
+	default:
+		log.Printf("unknown webhook delivery status: %q", s)
+		return webhookssvc.WebhookDeliveryStatus_WEBHOOK_DELIVERY_STATUS_FAILED
+	}
+}
+
+// ConvertWebhookDeliveryToGRPCWebhookDelivery converts a domain WebhookDelivery to proto.
+func ConvertWebhookDeliveryToGRPCWebhookDelivery(z *webhooks.WebhookDelivery) *webhookssvc.WebhookDelivery {
+	if z == nil {
+		return nil
+	}
+
+	converted := &webhookssvc.WebhookDelivery{
+		CreatedAt:             grpcconverters.ConvertTimeToPBTimestamp(z.CreatedAt),
+		NextAttemptAt:         grpcconverters.ConvertTimePointerToPBTimestamp(z.NextAttemptAt),
+		Id:                    z.ID,
+		BelongsToWebhook:      z.BelongsToWebhook,
+		BelongsToAccount:      z.BelongsToAccount,
+		RequestId:             z.RequestID,
+		EventId:               z.EventID,
+		TriggerEvent:          z.TriggerEvent,
+		Status:                ConvertStringToWebhookDeliveryStatus(z.Status),
+		RequestBody:           z.RequestBody,
+		ResponseBody:          z.ResponseBody,
+		ErrorMessage:          z.ErrorMessage,
+		LatencyInMilliseconds: z.LatencyInMilliseconds,
+		AttemptNumber:         uint32(z.AttemptNumber),
+	}
+
+	if z.ResponseStatusCode != nil {
+		code := uint32(*z.ResponseStatusCode)
+		converted.ResponseStatusCode = &code
+	}
+
+	return converted
+}
+
 func ConvertGRPCWebhookToWebhook(webhook *webhookssvc.Webhook) *webhooks.Webhook {
 	converted := &webhooks.Webhook{
 		CreatedAt:        grpcconverters.ConvertPBTimestampToTime(webhook.CreatedAt),
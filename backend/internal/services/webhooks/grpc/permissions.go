@@ -44,5 +44,11 @@ func ProvideMethodPermissions() WebhooksMethodPermissions {
 		webhookssvc.WebhooksService_UpdateWebhookTriggerEvent_FullMethodName: {
 			authorization.UpdateWebhookTriggerEventsPermission,
 		},
+		webhookssvc.WebhooksService_GetWebhookDeliveries_FullMethodName: {
+			authorization.ReadWebhookDeliveriesPermission,
+		},
+		webhookssvc.WebhooksService_RedeliverWebhookDelivery_FullMethodName: {
+			authorization.RedeliverWebhookDeliveriesPermission,
+		},
 	}
 }
@@ -261,3 +261,62 @@ func (s *serviceImpl) UpdateWebhookTriggerEvent(ctx context.Context, request *we
 		ResponseDetails: &types.ResponseDetails{TraceId: span.SpanContext().TraceID().String()},
 	}, nil
 }
+
+func (s *serviceImpl) GetWebhookDeliveries(ctx context.Context, request *webhookssvc.GetWebhookDeliveriesRequest) (*webhookssvc.GetWebhookDeliveriesResponse, error) {
+	ctx, span := s.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := s.logger.WithSpan(span).WithValue(webhookkeys.WebhookIDKey, request.WebhookId)
+
+	sessionContextData, err := s.sessionContextDataFetcher(ctx)
+	if err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.Unauthenticated, "failed to fetch session context data")
+	}
+	logger = logger.WithValue(identitykeys.AccountIDKey, sessionContextData.ActiveAccountID)
+
+	filter := grpcconverters.ConvertGRPCQueryFilterToQueryFilter(request.Filter)
+	retrieved, err := s.webhookManager.GetWebhookDeliveries(ctx, request.WebhookId, sessionContextData.ActiveAccountID, filter)
+	if err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.Internal, "failed to fetch webhook deliveries")
+	}
+
+	x := &webhookssvc.GetWebhookDeliveriesResponse{
+		ResponseDetails: &types.ResponseDetails{
+			TraceId: span.SpanContext().TraceID().String(),
+		},
+		Pagination: grpcconverters.ConvertPaginationToGRPCPagination(retrieved.Pagination, filter),
+	}
+
+	for _, delivery := range retrieved.Data {
+		x.Results = append(x.Results, converters.ConvertWebhookDeliveryToGRPCWebhookDelivery(delivery))
+	}
+
+	return x, nil
+}
+
+func (s *serviceImpl) RedeliverWebhookDelivery(ctx context.Context, request *webhookssvc.RedeliverWebhookDeliveryRequest) (*webhookssvc.RedeliverWebhookDeliveryResponse, error) {
+	ctx, span := s.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := s.logger.WithSpan(span).WithValue(webhookkeys.WebhookIDKey, request.WebhookId).WithValue(webhookkeys.WebhookDeliveryIDKey, request.WebhookDeliveryId)
+
+	sessionContextData, err := s.sessionContextDataFetcher(ctx)
+	if err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.Unauthenticated, "failed to fetch session context data")
+	}
+	logger = logger.WithValue(identitykeys.AccountIDKey, sessionContextData.ActiveAccountID)
+
+	requestID, err := s.webhookManager.RedeliverWebhookDelivery(ctx, request.WebhookId, request.WebhookDeliveryId, sessionContextData.ActiveAccountID)
+	if err != nil {
+		return nil, errorsgrpc.PrepareAndLogGRPCStatus(err, logger, span, codes.Internal, "failed to redeliver webhook")
+	}
+
+	x := &webhookssvc.RedeliverWebhookDeliveryResponse{
+		ResponseDetails: &types.ResponseDetails{
+			TraceId: span.SpanContext().TraceID().String(),
+		},
+		RequestId: requestID,
+	}
+
+	return x, nil
+}
@@ -560,6 +560,143 @@ func TestServiceImpl_ArchiveWebhookTriggerEvent(t *testing.T) {
 	})
 }
 
+func TestServiceImpl_GetWebhookDeliveries(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		service, mockRepo := buildTestService(t)
+
+		fakeDeliveries := webhookfakes.BuildFakeWebhookDeliveriesList()
+
+		mockRepo.On(reflection.GetMethodName(mockRepo.GetWebhookDeliveries), testutils.ContextMatcher, "test-webhook-id", "test-account-id", testutils.QueryFilterMatcher).Return(fakeDeliveries, nil)
+
+		request := &webhookssvc.GetWebhookDeliveriesRequest{
+			WebhookId: "test-webhook-id",
+			Filter:    &grpcfiltering.QueryFilter{},
+		}
+
+		response, err := service.GetWebhookDeliveries(ctx, request)
+
+		assert.NoError(t, err)
+		assert.NotNil(t, response)
+		assert.NotNil(t, response.ResponseDetails)
+		assert.Len(t, response.Results, len(fakeDeliveries.Data))
+		assert.Equal(t, fakeDeliveries.Data[0].ID, response.Results[0].Id)
+
+		mock.AssertExpectationsForObjects(t, mockRepo)
+	})
+
+	t.Run("session context error", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		service := buildTestServiceWithSessionError(t)
+
+		request := &webhookssvc.GetWebhookDeliveriesRequest{
+			WebhookId: "test-webhook-id",
+			Filter:    &grpcfiltering.QueryFilter{},
+		}
+
+		response, err := service.GetWebhookDeliveries(ctx, request)
+
+		assert.Error(t, err)
+		assert.Nil(t, response)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		service, mockRepo := buildTestService(t)
+
+		mockRepo.On(reflection.GetMethodName(mockRepo.GetWebhookDeliveries), testutils.ContextMatcher, "test-webhook-id", "test-account-id", testutils.QueryFilterMatcher).Return(nil, errors.New("repository error"))
+
+		request := &webhookssvc.GetWebhookDeliveriesRequest{
+			WebhookId: "test-webhook-id",
+			Filter:    &grpcfiltering.QueryFilter{},
+		}
+
+		response, err := service.GetWebhookDeliveries(ctx, request)
+
+		assert.Error(t, err)
+		assert.Nil(t, response)
+		assert.Equal(t, codes.Internal, status.Code(err))
+
+		mock.AssertExpectationsForObjects(t, mockRepo)
+	})
+}
+
+func TestServiceImpl_RedeliverWebhookDelivery(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		service, mockRepo := buildTestService(t)
+
+		mockRepo.On(reflection.GetMethodName(mockRepo.RedeliverWebhookDelivery), testutils.ContextMatcher, "test-webhook-id", "test-delivery-id", "test-account-id").Return("test-request-id", nil)
+
+		request := &webhookssvc.RedeliverWebhookDeliveryRequest{
+			WebhookId:         "test-webhook-id",
+			WebhookDeliveryId: "test-delivery-id",
+		}
+
+		response, err := service.RedeliverWebhookDelivery(ctx, request)
+
+		assert.NoError(t, err)
+		assert.NotNil(t, response)
+		assert.NotNil(t, response.ResponseDetails)
+		assert.Equal(t, "test-request-id", response.RequestId)
+
+		mock.AssertExpectationsForObjects(t, mockRepo)
+	})
+
+	t.Run("session context error", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		service := buildTestServiceWithSessionError(t)
+
+		request := &webhookssvc.RedeliverWebhookDeliveryRequest{
+			WebhookId:         "test-webhook-id",
+			WebhookDeliveryId: "test-delivery-id",
+		}
+
+		response, err := service.RedeliverWebhookDelivery(ctx, request)
+
+		assert.Error(t, err)
+		assert.Nil(t, response)
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	})
+
+	t.Run("repository error", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		service, mockRepo := buildTestService(t)
+
+		mockRepo.On(reflection.GetMethodName(mockRepo.RedeliverWebhookDelivery), testutils.ContextMatcher, "test-webhook-id", "test-delivery-id", "test-account-id").Return("", errors.New("repository error"))
+
+		request := &webhookssvc.RedeliverWebhookDeliveryRequest{
+			WebhookId:         "test-webhook-id",
+			WebhookDeliveryId: "test-delivery-id",
+		}
+
+		response, err := service.RedeliverWebhookDelivery(ctx, request)
+
+		assert.Error(t, err)
+		assert.Nil(t, response)
+		assert.Equal(t, codes.Internal, status.Code(err))
+
+		mock.AssertExpectationsForObjects(t, mockRepo)
+	})
+}
+
 func TestServiceImpl_InterfaceCompliance(t *testing.T) {
 	t.Parallel()
 
@@ -0,0 +1,30 @@
+package webhookdeliveryretrier
+
+import (
+	"context"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/webhooks"
+
+	"github.com/primandproper/platform/messagequeue"
+	msgconfig "github.com/primandproper/platform/messagequeue/config"
+	"github.com/primandproper/platform/observability/logging"
+	"github.com/primandproper/platform/observability/metrics"
+	"github.com/primandproper/platform/observability/tracing"
+
+	"github.com/samber/do/v2"
+)
+
+// RegisterWebhookDeliveryRetrier registers the webhook delivery retrier with the injector.
+func RegisterWebhookDeliveryRetrier(i do.Injector) {
+	do.Provide[*Worker](i, func(i do.Injector) (*Worker, error) {
+		return NewWebhookDeliveryRetrier(
+			do.MustInvoke[context.Context](i),
+			do.MustInvoke[logging.Logger](i),
+			do.MustInvoke[tracing.TracerProvider](i),
+			do.MustInvoke[webhooks.Repository](i),
+			do.MustInvoke[messagequeue.PublisherProvider](i),
+			do.MustInvoke[metrics.Provider](i),
+			do.MustInvoke[*msgconfig.QueuesConfig](i),
+		)
+	})
+}
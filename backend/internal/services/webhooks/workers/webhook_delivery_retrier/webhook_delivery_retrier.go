@@ -0,0 +1,109 @@
+package webhookdeliveryretrier
+
+import (
+	"context"
+
+	identitykeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/identity/keys"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/webhooks"
+	webhookkeys "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/webhooks/keys"
+
+	"github.com/primandproper/platform/messagequeue"
+	msgconfig "github.com/primandproper/platform/messagequeue/config"
+	"github.com/primandproper/platform/observability"
+	"github.com/primandproper/platform/observability/logging"
+	"github.com/primandproper/platform/observability/metrics"
+	"github.com/primandproper/platform/observability/tracing"
+)
+
+const (
+	serviceName = "webhook_delivery_retrier"
+
+	// claimBatchSize is how many due retries are claimed at a time.
+	claimBatchSize uint8 = 100
+)
+
+type Worker struct {
+	logger logging.Logger
+	tracer tracing.Tracer
+
+	webhookRepo                      webhooks.Repository
+	webhookExecutionRequestPublisher messagequeue.Publisher
+	retriedRecordsCounter            metrics.Int64Counter
+}
+
+func NewWebhookDeliveryRetrier(
+	ctx context.Context,
+	logger logging.Logger,
+	tracerProvider tracing.TracerProvider,
+	webhookRepo webhooks.Repository,
+	publisherProvider messagequeue.PublisherProvider,
+	metricsProvider metrics.Provider,
+	cfg *msgconfig.QueuesConfig,
+) (*Worker, error) {
+	retriedRecordsCounter, err := metricsProvider.NewInt64Counter("webhook_delivery_retrier.retried_records")
+	if err != nil {
+		return nil, err
+	}
+
+	webhookExecutionRequestPublisher, err := publisherProvider.ProvidePublisher(ctx, cfg.WebhookExecutionRequestsTopicName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Worker{
+		webhookRepo:                      webhookRepo,
+		webhookExecutionRequestPublisher: webhookExecutionRequestPublisher,
+		retriedRecordsCounter:            retriedRecordsCounter,
+
+		logger: logging.NewNamedLogger(logger, serviceName),
+		tracer: tracing.NewNamedTracer(tracerProvider, serviceName),
+	}, nil
+}
+
+// Work claims every failed webhook delivery whose retry is due, and publishes its next attempt to the webhook
+// execution requests queue. A retry that fails to publish stays claimed until its lease runs out, at which
+// point a later run picks it up again. It returns how many retries were published.
+func (w *Worker) Work(ctx context.Context) (int64, error) {
+	ctx, span := w.tracer.StartSpan(ctx)
+	defer span.End()
+
+	logger := w.logger.Clone()
+	logger.Info("beginning retries of due webhook deliveries")
+
+	var retriedCount int64
+	for {
+		deliveries, err := w.webhookRepo.ClaimDueWebhookDeliveries(ctx, claimBatchSize)
+		if err != nil {
+			return retriedCount, observability.PrepareAndLogError(err, logger, span, "claiming due webhook deliveries")
+		}
+
+		for _, delivery := range deliveries {
+			if err = w.webhookExecutionRequestPublisher.Publish(ctx, &webhooks.WebhookExecutionRequest{
+				RequestID:     delivery.RequestID,
+				EventID:       delivery.EventID,
+				WebhookID:     delivery.BelongsToWebhook,
+				AccountID:     delivery.BelongsToAccount,
+				TriggerEvent:  delivery.TriggerEvent,
+				RawPayload:    []byte(delivery.RequestBody),
+				AttemptNumber: delivery.AttemptNumber + 1,
+			}); err != nil {
+				logger.WithValues(map[string]any{
+					webhookkeys.WebhookDeliveryIDKey: delivery.ID,
+					identitykeys.AccountIDKey:        delivery.BelongsToAccount,
+				}).Error("publishing webhook delivery retry", err)
+				continue
+			}
+
+			retriedCount++
+		}
+
+		if len(deliveries) < int(claimBatchSize) {
+			break
+		}
+	}
+
+	w.retriedRecordsCounter.Add(ctx, retriedCount)
+	logger.WithValue("retried_count", retriedCount).Info("retried due webhook deliveries")
+
+	return retriedCount, nil
+}
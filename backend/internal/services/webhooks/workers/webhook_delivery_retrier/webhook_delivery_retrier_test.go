@@ -0,0 +1,178 @@
+package webhookdeliveryretrier
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/webhooks"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/webhooks/fakes"
+	webhooksmock "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/webhooks/mock"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/testutils"
+
+	"github.com/primandproper/platform/messagequeue"
+	msgconfig "github.com/primandproper/platform/messagequeue/config"
+	mockpublishers "github.com/primandproper/platform/messagequeue/mock"
+	loggingnoop "github.com/primandproper/platform/observability/logging/noop"
+	metricsnoop "github.com/primandproper/platform/observability/metrics/noop"
+	tracingnoop "github.com/primandproper/platform/observability/tracing/noop"
+	"github.com/primandproper/platform/reflection"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func buildNewWebhookDeliveryRetrierForTest(t *testing.T) (*Worker, *webhooksmock.Repository) {
+	t.Helper()
+
+	ctx := t.Context()
+	cfg := &msgconfig.QueuesConfig{WebhookExecutionRequestsTopicName: "webhook_execution_requests"}
+
+	pp := &mockpublishers.PublisherProviderMock{
+		ProvidePublisherFunc: func(_ context.Context, _ string) (messagequeue.Publisher, error) {
+			return &mockpublishers.PublisherMock{
+				PublishFunc: func(_ context.Context, _ any) error { return nil },
+				StopFunc:    func() {},
+			}, nil
+		},
+	}
+
+	webhookRepo := &webhooksmock.Repository{}
+	x, err := NewWebhookDeliveryRetrier(
+		ctx,
+		loggingnoop.NewLogger(),
+		tracingnoop.NewTracerProvider(),
+		webhookRepo,
+		pp,
+		metricsnoop.NewMetricsProvider(),
+		cfg,
+	)
+	require.NoError(t, err)
+
+	return x, webhookRepo
+}
+
+func TestNewWebhookDeliveryRetrier(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		x, _ := buildNewWebhookDeliveryRetrierForTest(t)
+		assert.NotNil(t, x)
+	})
+
+	T.Run("with error providing publisher", func(t *testing.T) {
+		t.Parallel()
+
+		pp := &mockpublishers.PublisherProviderMock{
+			ProvidePublisherFunc: func(_ context.Context, _ string) (messagequeue.Publisher, error) {
+				return nil, errors.New("blah")
+			},
+		}
+
+		x, err := NewWebhookDeliveryRetrier(
+			t.Context(),
+			loggingnoop.NewLogger(),
+			tracingnoop.NewTracerProvider(),
+			&webhooksmock.Repository{},
+			pp,
+			metricsnoop.NewMetricsProvider(),
+			&msgconfig.QueuesConfig{},
+		)
+		assert.Error(t, err)
+		assert.Nil(t, x)
+	})
+}
+
+func TestWorker_Work(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		worker, webhookRepo := buildNewWebhookDeliveryRetrierForTest(t)
+
+		delivery := fakes.BuildFakeWebhookDelivery()
+		delivery.Status = webhooks.WebhookDeliveryStatusFailed
+		delivery.AttemptNumber = 2
+
+		webhookRepo.On(reflection.GetMethodName(webhookRepo.ClaimDueWebhookDeliveries), testutils.ContextMatcher, claimBatchSize).Return([]*webhooks.WebhookDelivery{delivery}, nil)
+
+		var published []*webhooks.WebhookExecutionRequest
+		worker.webhookExecutionRequestPublisher = &mockpublishers.PublisherMock{
+			PublishFunc: func(_ context.Context, msg any) error {
+				published = append(published, msg.(*webhooks.WebhookExecutionRequest))
+				return nil
+			},
+		}
+
+		count, err := worker.Work(ctx)
+		require.NoError(t, err)
+		assert.Equal(t, int64(1), count)
+
+		require.Len(t, published, 1)
+		assert.Equal(t, delivery.RequestID, published[0].RequestID)
+		assert.Equal(t, delivery.EventID, published[0].EventID)
+		assert.Equal(t, delivery.BelongsToWebhook, published[0].WebhookID)
+		assert.Equal(t, delivery.BelongsToAccount, published[0].AccountID)
+		assert.Equal(t, []byte(delivery.RequestBody), published[0].RawPayload)
+		assert.Equal(t, uint16(3), published[0].AttemptNumber)
+
+		mock.AssertExpectationsForObjects(t, webhookRepo)
+	})
+
+	T.Run("with nothing due", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		worker, webhookRepo := buildNewWebhookDeliveryRetrierForTest(t)
+
+		webhookRepo.On(reflection.GetMethodName(webhookRepo.ClaimDueWebhookDeliveries), testutils.ContextMatcher, claimBatchSize).Return([]*webhooks.WebhookDelivery{}, nil)
+
+		count, err := worker.Work(ctx)
+		require.NoError(t, err)
+		assert.Zero(t, count)
+
+		mock.AssertExpectationsForObjects(t, webhookRepo)
+	})
+
+	T.Run("with error claiming deliveries", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		worker, webhookRepo := buildNewWebhookDeliveryRetrierForTest(t)
+
+		webhookRepo.On(reflection.GetMethodName(webhookRepo.ClaimDueWebhookDeliveries), testutils.ContextMatcher, claimBatchSize).Return(nil, errors.New("blah"))
+
+		_, err := worker.Work(ctx)
+		assert.Error(t, err)
+
+		mock.AssertExpectationsForObjects(t, webhookRepo)
+	})
+
+	T.Run("with error publishing retry", func(t *testing.T) {
+		t.Parallel()
+
+		ctx := t.Context()
+		worker, webhookRepo := buildNewWebhookDeliveryRetrierForTest(t)
+
+		webhookRepo.On(reflection.GetMethodName(webhookRepo.ClaimDueWebhookDeliveries), testutils.ContextMatcher, claimBatchSize).Return([]*webhooks.WebhookDelivery{
+			fakes.BuildFakeWebhookDelivery(),
+			fakes.BuildFakeWebhookDelivery(),
+		}, nil)
+
+		worker.webhookExecutionRequestPublisher = &mockpublishers.PublisherMock{
+			PublishFunc: func(_ context.Context, _ any) error { return errors.New("blah") },
+		}
+
+		// a failed publish leaves the retry leased for a later run, so it doesn't fail the others.
+		count, err := worker.Work(ctx)
+		require.NoError(t, err)
+		assert.Zero(t, count)
+
+		mock.AssertExpectationsForObjects(t, webhookRepo)
+	})
+}
@@ -0,0 +1,170 @@
+// Package webhooksignature signs and verifies Dinner Done Better webhook requests.
+//
+// Each webhook request carries a signature header of the form:
+//
+//	X-Dinner-Done-Better-Signature: t=1700000000,id=<event ID>,v1=<hex HMAC-SHA256>[,v1=<hex HMAC-SHA256>]
+//
+// The id is the same on every retry and redelivery of an event, so receivers can use it to skip events they've
+// already handled. The HMAC is computed over "<t>.<id>.<body>" with the account's webhook encryption key (hex-decoded).
+// While a key rotation is in its grace period, one v1 signature is present per active key, so
+// receivers holding either the old or the new key can verify the request.
+package webhooksignature
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	// SignatureHeader is the header that carries the webhook signature.
+	SignatureHeader = "X-Dinner-Done-Better-Signature"
+	// DefaultTolerance is the recommended maximum age of a webhook request.
+	DefaultTolerance = 5 * time.Minute
+
+	timestampField = "t"
+	eventIDField   = "id"
+	signatureField = "v1"
+)
+
+var (
+	// ErrMissingHeader indicates the request had no signature header.
+	ErrMissingHeader = errors.New("missing webhook signature header")
+	// ErrMalformedHeader indicates the signature header could not be parsed.
+	ErrMalformedHeader = errors.New("malformed webhook signature header")
+	// ErrNoKeysProvided indicates verification was attempted without any keys.
+	ErrNoKeysProvided = errors.New("no webhook signature keys provided")
+	// ErrTimestampOutsideTolerance indicates the request is too old (or too far in the future) to be trusted.
+	ErrTimestampOutsideTolerance = errors.New("webhook timestamp outside of tolerance")
+	// ErrSignatureMismatch indicates none of the signatures matched any of the provided keys.
+	ErrSignatureMismatch = errors.New("webhook signature mismatch")
+)
+
+// SignedHeader is the parsed contents of a signature header.
+type SignedHeader struct {
+	Timestamp  time.Time
+	EventID    string
+	Signatures [][]byte
+}
+
+// ComputeSignature computes the HMAC-SHA256 signature for a webhook body.
+func ComputeSignature(key []byte, timestamp time.Time, eventID string, body []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(strconv.FormatInt(timestamp.Unix(), 10)))
+	mac.Write([]byte("."))
+	mac.Write([]byte(eventID))
+	mac.Write([]byte("."))
+	mac.Write(body)
+
+	return mac.Sum(nil)
+}
+
+// BuildHeader builds a signature header value, with one signature per key.
+func BuildHeader(timestamp time.Time, eventID string, body []byte, keys ...[]byte) string {
+	parts := []string{
+		fmt.Sprintf("%s=%d", timestampField, timestamp.Unix()),
+		fmt.Sprintf("%s=%s", eventIDField, eventID),
+	}
+
+	for _, key := range keys {
+		parts = append(parts, fmt.Sprintf("%s=%s", signatureField, hex.EncodeToString(ComputeSignature(key, timestamp, eventID, body))))
+	}
+
+	return strings.Join(parts, ",")
+}
+
+// ParseHeader parses a signature header value. Unknown fields are ignored, so that
+// receivers keep working when new signature versions are introduced.
+func ParseHeader(header string) (*SignedHeader, error) {
+	if header == "" {
+		return nil, ErrMissingHeader
+	}
+
+	parsed := &SignedHeader{}
+	for part := range strings.SplitSeq(header, ",") {
+		key, value, found := strings.Cut(strings.TrimSpace(part), "=")
+		if !found {
+			return nil, ErrMalformedHeader
+		}
+
+		switch key {
+		case timestampField:
+			seconds, err := strconv.ParseInt(value, 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("%w: invalid timestamp", ErrMalformedHeader)
+			}
+			parsed.Timestamp = time.Unix(seconds, 0)
+		case eventIDField:
+			parsed.EventID = value
+		case signatureField:
+			signature, err := hex.DecodeString(value)
+			if err != nil {
+				return nil, fmt.Errorf("%w: invalid signature encoding", ErrMalformedHeader)
+			}
+			parsed.Signatures = append(parsed.Signatures, signature)
+		}
+	}
+
+	if parsed.Timestamp.IsZero() || parsed.EventID == "" || len(parsed.Signatures) == 0 {
+		return nil, ErrMalformedHeader
+	}
+
+	return parsed, nil
+}
+
+// Verify checks a signature header against a body and a set of keys, and that the
+// timestamp is within tolerance of now. It succeeds if any signature matches any key.
+func Verify(header string, body []byte, now time.Time, tolerance time.Duration, keys ...[]byte) (*SignedHeader, error) {
+	if len(keys) == 0 {
+		return nil, ErrNoKeysProvided
+	}
+
+	parsed, err := ParseHeader(header)
+	if err != nil {
+		return nil, err
+	}
+
+	if age := now.Sub(parsed.Timestamp); age > tolerance || age < -tolerance {
+		return nil, ErrTimestampOutsideTolerance
+	}
+
+	for _, key := range keys {
+		expected := ComputeSignature(key, parsed.Timestamp, parsed.EventID, body)
+		for _, signature := range parsed.Signatures {
+			if hmac.Equal(expected, signature) {
+				return parsed, nil
+			}
+		}
+	}
+
+	return nil, ErrSignatureMismatch
+}
+
+// VerifyRequest verifies an incoming webhook request, and returns its body. The request
+// body is replaced so that it can be read again by the caller's handlers.
+func VerifyRequest(req *http.Request, tolerance time.Duration, keys ...[]byte) ([]byte, *SignedHeader, error) {
+	if req.Body == nil {
+		return nil, nil, io.ErrUnexpectedEOF
+	}
+
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, nil, fmt.Errorf("reading webhook body: %w", err)
+	}
+	req.Body = io.NopCloser(bytes.NewReader(body))
+
+	parsed, err := Verify(req.Header.Get(SignatureHeader), body, time.Now(), tolerance, keys...)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return body, parsed, nil
+}
@@ -0,0 +1,185 @@
+package webhooksignature
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuildHeader(T *testing.T) {
+	T.Parallel()
+
+	T.Run("round trips through ParseHeader", func(t *testing.T) {
+		t.Parallel()
+
+		now := time.Unix(1700000000, 0)
+		header := BuildHeader(now, "event-id", []byte(`{"things":"stuff"}`), []byte("current"), []byte("previous"))
+
+		parsed, err := ParseHeader(header)
+		require.NoError(t, err)
+
+		assert.Equal(t, now, parsed.Timestamp)
+		assert.Equal(t, "event-id", parsed.EventID)
+		assert.Len(t, parsed.Signatures, 2)
+	})
+}
+
+func TestParseHeader(T *testing.T) {
+	T.Parallel()
+
+	T.Run("with empty header", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseHeader("")
+		assert.ErrorIs(t, err, ErrMissingHeader)
+	})
+
+	T.Run("with legacy bare signature", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseHeader("deadbeef")
+		assert.ErrorIs(t, err, ErrMalformedHeader)
+	})
+
+	T.Run("with missing fields", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseHeader("t=1700000000,v1=deadbeef")
+		assert.ErrorIs(t, err, ErrMalformedHeader)
+	})
+
+	T.Run("with invalid timestamp", func(t *testing.T) {
+		t.Parallel()
+
+		_, err := ParseHeader("t=yesterday,id=x,v1=deadbeef")
+		assert.ErrorIs(t, err, ErrMalformedHeader)
+	})
+
+	T.Run("ignores unknown fields", func(t *testing.T) {
+		t.Parallel()
+
+		parsed, err := ParseHeader("t=1700000000,id=x,v1=deadbeef,v2=whatever")
+		require.NoError(t, err)
+		assert.Len(t, parsed.Signatures, 1)
+	})
+}
+
+func TestVerify(T *testing.T) {
+	T.Parallel()
+
+	body := []byte(`{"things":"stuff"}`)
+	now := time.Unix(1700000000, 0)
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		header := BuildHeader(now, "event-id", body, []byte("key"))
+
+		parsed, err := Verify(header, body, now.Add(time.Minute), DefaultTolerance, []byte("key"))
+		require.NoError(t, err)
+		assert.Equal(t, "event-id", parsed.EventID)
+	})
+
+	T.Run("accepts either key during rotation", func(t *testing.T) {
+		t.Parallel()
+
+		header := BuildHeader(now, "event-id", body, []byte("new"), []byte("old"))
+
+		_, err := Verify(header, body, now, DefaultTolerance, []byte("old"))
+		assert.NoError(t, err)
+
+		_, err = Verify(header, body, now, DefaultTolerance, []byte("new"))
+		assert.NoError(t, err)
+	})
+
+	T.Run("with stale timestamp", func(t *testing.T) {
+		t.Parallel()
+
+		header := BuildHeader(now, "event-id", body, []byte("key"))
+
+		_, err := Verify(header, body, now.Add(DefaultTolerance+time.Second), DefaultTolerance, []byte("key"))
+		assert.ErrorIs(t, err, ErrTimestampOutsideTolerance)
+	})
+
+	T.Run("with future timestamp", func(t *testing.T) {
+		t.Parallel()
+
+		header := BuildHeader(now, "event-id", body, []byte("key"))
+
+		_, err := Verify(header, body, now.Add(-DefaultTolerance-time.Second), DefaultTolerance, []byte("key"))
+		assert.ErrorIs(t, err, ErrTimestampOutsideTolerance)
+	})
+
+	T.Run("with wrong key", func(t *testing.T) {
+		t.Parallel()
+
+		header := BuildHeader(now, "event-id", body, []byte("key"))
+
+		_, err := Verify(header, body, now, DefaultTolerance, []byte("other"))
+		assert.ErrorIs(t, err, ErrSignatureMismatch)
+	})
+
+	T.Run("with tampered event ID", func(t *testing.T) {
+		t.Parallel()
+
+		header := strings.Replace(BuildHeader(now, "event-id", body, []byte("key")), "id=event-id", "id=other-id", 1)
+
+		_, err := Verify(header, body, now, DefaultTolerance, []byte("key"))
+		assert.ErrorIs(t, err, ErrSignatureMismatch)
+	})
+
+	T.Run("with tampered body", func(t *testing.T) {
+		t.Parallel()
+
+		header := BuildHeader(now, "event-id", body, []byte("key"))
+
+		_, err := Verify(header, []byte(`{"things":"other stuff"}`), now, DefaultTolerance, []byte("key"))
+		assert.ErrorIs(t, err, ErrSignatureMismatch)
+	})
+
+	T.Run("without keys", func(t *testing.T) {
+		t.Parallel()
+
+		header := BuildHeader(now, "event-id", body, []byte("key"))
+
+		_, err := Verify(header, body, now, DefaultTolerance)
+		assert.ErrorIs(t, err, ErrNoKeysProvided)
+	})
+}
+
+func TestVerifyRequest(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		body := []byte(`{"things":"stuff"}`)
+		req := httptest.NewRequest(http.MethodPost, "/webhooks", bytes.NewReader(body))
+		req.Header.Set(SignatureHeader, BuildHeader(time.Now(), "event-id", body, []byte("key")))
+
+		actual, parsed, err := VerifyRequest(req, DefaultTolerance, []byte("key"))
+		require.NoError(t, err)
+		assert.Equal(t, body, actual)
+		assert.Equal(t, "event-id", parsed.EventID)
+
+		reread, err := io.ReadAll(req.Body)
+		require.NoError(t, err)
+		assert.Equal(t, body, reread)
+	})
+
+	T.Run("without signature header", func(t *testing.T) {
+		t.Parallel()
+
+		req := httptest.NewRequest(http.MethodPost, "/webhooks", strings.NewReader("{}"))
+
+		_, _, err := VerifyRequest(req, DefaultTolerance, []byte("key"))
+		assert.ErrorIs(t, err, ErrMissingHeader)
+	})
+}
@@ -8,6 +8,7 @@
 	"net"
 	"time"
 
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/authorization"
 	apiserver "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/build/services/api"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/config"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/notifications"
@@ -81,6 +82,7 @@ func init() {
 	cfg.HTTPServer.Port = uint16(httpPort)
 	cfg.GRPCServer.Port = uint16(grpcPort)
 	httpTestServerAddress = fmt.Sprintf("http://localhost:%d", httpPort)
+	cfg.Services.Auth.OIDC.Issuer = httpTestServerAddress
 
 	apiServiceConfig = cfg
 
@@ -110,11 +112,15 @@ func init() {
 	}
 
 	createdClient, err := localdev.CreateOAuth2ClientForService(ctx, databaseClient, dbCfg, &oauth.OAuth2ClientDatabaseCreationInput{
-		ID:           identifiers.New(),
-		Name:         "integration_client",
-		Description:  "integration test client",
-		ClientID:     random.MustGenerateHexEncodedString(ctx, oauth.ClientIDSize),
-		ClientSecret: random.MustGenerateHexEncodedString(ctx, oauth.ClientSecretSize),
+		ID:                identifiers.New(),
+		Name:              "integration_client",
+		Description:       "integration test client",
+		ClientID:          random.MustGenerateHexEncodedString(ctx, oauth.ClientIDSize),
+		ClientSecret:      random.MustGenerateHexEncodedString(ctx, oauth.ClientSecretSize),
+		BelongsToUser:     &adminUser.ID,
+		AllowedScopes:     authorization.OAuth2Scopes,
+		RedirectURIs:      []string{httpTestServerAddress},
+		AllowedGrantTypes: oauth.OAuth2GrantTypes,
 	})
 	if err != nil {
 		log.Fatal(err)
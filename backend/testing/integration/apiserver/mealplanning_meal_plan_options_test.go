@@ -247,3 +247,62 @@ func TestMealPlanOptions_DuplicatePrevention(T *testing.T) {
 		_ = existingOption
 	})
 }
+
+func TestMealPlanOptions_GetCookTimeline(T *testing.T) {
+	T.Parallel()
+
+	T.Run("schedules every step before the event starts", func(t *testing.T) {
+		t.Parallel()
+		ctx := t.Context()
+
+		_, userClient := createUserAndClientForTest(t)
+		createdMealPlan := createMealPlanForTest(t, userClient, nil)
+
+		require.NotEmpty(t, createdMealPlan.Events)
+		require.NotEmpty(t, createdMealPlan.Events[0].Options)
+
+		createdMealPlanEvent := createdMealPlan.Events[0]
+		createdMealPlanOption := createdMealPlanEvent.Options[0]
+
+		res, err := userClient.GetCookTimelineForMealPlanOption(ctx, &mealplanninggrpc.GetCookTimelineForMealPlanOptionRequest{
+			MealPlanId:       createdMealPlan.ID,
+			MealPlanEventId:  createdMealPlanEvent.ID,
+			MealPlanOptionId: createdMealPlanOption.ID,
+			Input:            &mealplanninggrpc.CookTimelineRequestInput{CookCount: 2},
+		})
+		require.NoError(t, err)
+		require.NotNil(t, res)
+
+		assert.Equal(t, createdMealPlanOption.ID, res.Result.MealPlanOptionId)
+		assert.NotEmpty(t, res.Result.Steps)
+		assert.NotEmpty(t, res.Result.CriticalPath)
+		assert.Contains(t, res.Result.MermaidGanttChart, "gantt")
+		assert.True(t, res.Result.ServingTime.AsTime().Equal(createdMealPlanEvent.StartsAt))
+		for _, step := range res.Result.Steps {
+			assert.False(t, step.EndsAt.AsTime().After(createdMealPlanEvent.StartsAt))
+		}
+	})
+
+	T.Run("with an invalid constraint", func(t *testing.T) {
+		t.Parallel()
+		ctx := t.Context()
+
+		_, userClient := createUserAndClientForTest(t)
+		createdMealPlan := createMealPlanForTest(t, userClient, nil)
+
+		require.NotEmpty(t, createdMealPlan.Events)
+		require.NotEmpty(t, createdMealPlan.Events[0].Options)
+
+		res, err := userClient.GetCookTimelineForMealPlanOption(ctx, &mealplanninggrpc.GetCookTimelineForMealPlanOptionRequest{
+			MealPlanId:       createdMealPlan.ID,
+			MealPlanEventId:  createdMealPlan.Events[0].ID,
+			MealPlanOptionId: createdMealPlan.Events[0].Options[0].ID,
+			Input: &mealplanninggrpc.CookTimelineRequestInput{
+				InstrumentConstraints: []*mealplanninggrpc.CookTimelineResourceConstraint{{Id: fakes.BuildFakeID()}},
+			},
+		})
+		assert.Error(t, err)
+		assert.Nil(t, res)
+		assert.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
@@ -614,14 +614,24 @@ func TestMealPlans_FinalizeMealPlan(T *testing.T) {
 
 		// Verify a selection was made
 		for _, event := range actual.Events {
-			selectionMade := false
+			var chosen *mealplanning.MealPlanOption
 			for _, opt := range event.Options {
 				if opt.Chosen {
-					selectionMade = true
+					chosen = opt
 					break
 				}
 			}
-			require.True(t, selectionMade)
+			require.NotNil(t, chosen)
+
+			// Verify the tally report explains the selection
+			reportRes, reportErr := accountAdminUserClient.GetMealPlanEventTallyReport(ctx, &mealplanninggrpc.GetMealPlanEventTallyReportRequest{
+				MealPlanId:      createdMealPlan.ID,
+				MealPlanEventId: event.ID,
+			})
+			require.NoError(t, reportErr)
+			assert.Equal(t, chosen.ID, reportRes.Result.Winner)
+			assert.Equal(t, chosen.TieBroken, reportRes.Result.TieBroken)
+			assert.NotEmpty(t, reportRes.Result.Scores)
 		}
 	})
 
@@ -0,0 +1,290 @@
+package integration
+
+import (
+	"testing"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/converters"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/mealplanning/fakes"
+	mealplanningsvc "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/grpc/generated/services/mealplanning"
+	mealplanningconverters "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/services/mealplanning/grpc/converters"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/pkg/client"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createPantryItemForTest(t *testing.T, clientToUse client.Client) *mealplanning.PantryItem {
+	t.Helper()
+	ctx := t.Context()
+
+	validIngredient := createValidIngredientForTest(t)
+	validMeasurementUnit := createValidMeasurementUnitForTest(t)
+
+	examplePantryItem := fakes.BuildFakePantryItem()
+	examplePantryItemInput := converters.ConvertPantryItemToPantryItemCreationRequestInput(examplePantryItem)
+	examplePantryItemInput.ValidIngredientID = validIngredient.ID
+	examplePantryItemInput.ValidMeasurementUnitID = validMeasurementUnit.ID
+	createdPantryItem, err := clientToUse.CreatePantryItem(ctx, &mealplanningsvc.CreatePantryItemRequest{
+		Input: mealplanningconverters.ConvertPantryItemCreationRequestInputToGRPCPantryItemCreationRequestInput(examplePantryItemInput),
+	})
+	require.NoError(t, err)
+	converted := mealplanningconverters.ConvertGRPCPantryItemToPantryItem(createdPantryItem.Created)
+	assertRoughEquality(t, examplePantryItem, converted, defaultIgnoredFields("ID", "BelongsToAccount", "Ingredient", "MeasurementUnit", "ExpiresAt")...)
+
+	res, err := clientToUse.GetPantryItem(ctx, &mealplanningsvc.GetPantryItemRequest{PantryItemId: createdPantryItem.Created.Id})
+	require.NoError(t, err)
+	require.NotNil(t, res)
+
+	pantryItem := mealplanningconverters.ConvertGRPCPantryItemToPantryItem(res.Result)
+	assertRoughEquality(t, converted, pantryItem, defaultIgnoredFields("ID", "BelongsToAccount", "Ingredient", "MeasurementUnit", "ExpiresAt")...)
+	assert.Equal(t, validIngredient.ID, pantryItem.Ingredient.ID)
+	assert.Equal(t, validMeasurementUnit.ID, pantryItem.MeasurementUnit.ID)
+
+	return pantryItem
+}
+
+func TestPantryItems_Creating(T *testing.T) {
+	T.Parallel()
+
+	T.Run("happy path", func(t *testing.T) {
+		t.Parallel()
+		ctx := t.Context()
+
+		_, testClient := createUserAndClientForTest(t)
+		created := createPantryItemForTest(t, testClient)
+
+		AssertAuditLogContainsFuzzy(t, ctx, testClient, getAccountIDForTest(t, testClient), 10, []*ExpectedAuditEntry{
+			{EventType: "created", ResourceType: "pantry_items", RelevantID: created.ID},
+		})
+	})
+
+	T.Run("requires auth", func(t *testing.T) {
+		t.Parallel()
+		ctx := t.Context()
+
+		creationRequestInput := fakes.BuildFakePantryItemCreationRequestInput()
+		convertedInput := mealplanningconverters.ConvertPantryItemCreationRequestInputToGRPCPantryItemCreationRequestInput(creationRequestInput)
+
+		c := buildUnauthenticatedGRPCClientForTest(t)
+		created, err := c.CreatePantryItem(ctx, &mealplanningsvc.CreatePantryItemRequest{
+			Input: convertedInput,
+		})
+		assert.Error(t, err)
+		assert.Nil(t, created)
+	})
+
+	T.Run("invalid input", func(t *testing.T) {
+		t.Parallel()
+		ctx := t.Context()
+
+		_, testClient := createUserAndClientForTest(t)
+
+		creationRequestInput := fakes.BuildFakePantryItemCreationRequestInput()
+		convertedInput := mealplanningconverters.ConvertPantryItemCreationRequestInputToGRPCPantryItemCreationRequestInput(creationRequestInput)
+		// this is not allowed
+		convertedInput.ValidIngredientId = ""
+
+		created, err := testClient.CreatePantryItem(ctx, &mealplanningsvc.CreatePantryItemRequest{
+			Input: convertedInput,
+		})
+		assert.Error(t, err)
+		assert.Nil(t, created)
+	})
+}
+
+func TestPantryItems_Reading(T *testing.T) {
+	T.Parallel()
+
+	_, testClient := createUserAndClientForTest(T)
+
+	T.Run("happy path", func(t *testing.T) {
+		t.Parallel()
+		ctx := t.Context()
+
+		created := createPantryItemForTest(t, testClient)
+
+		retrieved, err := testClient.GetPantryItem(ctx, &mealplanningsvc.GetPantryItemRequest{PantryItemId: created.ID})
+		require.NoError(t, err)
+		require.NotNil(t, retrieved)
+
+		converted := mealplanningconverters.ConvertGRPCPantryItemToPantryItem(retrieved.Result)
+
+		assertRoughEquality(t, created, converted, defaultIgnoredFields("ID", "BelongsToAccount", "Ingredient", "MeasurementUnit", "ExpiresAt")...)
+	})
+
+	T.Run("requires auth", func(t *testing.T) {
+		t.Parallel()
+		ctx := t.Context()
+
+		created := createPantryItemForTest(t, testClient)
+
+		c := buildUnauthenticatedGRPCClientForTest(t)
+
+		_, err := c.GetPantryItem(ctx, &mealplanningsvc.GetPantryItemRequest{PantryItemId: created.ID})
+		assert.Error(t, err)
+	})
+
+	T.Run("invalid ID", func(t *testing.T) {
+		t.Parallel()
+		ctx := t.Context()
+
+		_, err := testClient.GetPantryItem(ctx, &mealplanningsvc.GetPantryItemRequest{PantryItemId: nonexistentID})
+		assert.Error(t, err)
+	})
+
+	T.Run("other accounts cannot read it", func(t *testing.T) {
+		t.Parallel()
+		ctx := t.Context()
+
+		created := createPantryItemForTest(t, testClient)
+		_, otherClient := createUserAndClientForTest(t)
+
+		_, err := otherClient.GetPantryItem(ctx, &mealplanningsvc.GetPantryItemRequest{PantryItemId: created.ID})
+		assert.Error(t, err)
+	})
+}
+
+func TestPantryItems_Listing(T *testing.T) {
+	T.Parallel()
+
+	_, testClient := createUserAndClientForTest(T)
+	createdPantryItems := []*mealplanning.PantryItem{}
+	for range exampleQuantity {
+		created := createPantryItemForTest(T, testClient)
+		createdPantryItems = append(createdPantryItems, created)
+	}
+
+	T.Run("happy path", func(t *testing.T) {
+		t.Parallel()
+		ctx := t.Context()
+
+		retrieved, err := testClient.GetPantryItems(ctx, &mealplanningsvc.GetPantryItemsRequest{})
+		require.NoError(t, err)
+		require.NotNil(t, retrieved)
+		assert.True(t, len(retrieved.Results) >= len(createdPantryItems))
+	})
+
+	T.Run("requires auth", func(t *testing.T) {
+		t.Parallel()
+		ctx := t.Context()
+
+		c := buildUnauthenticatedGRPCClientForTest(t)
+
+		_, err := c.GetPantryItems(ctx, &mealplanningsvc.GetPantryItemsRequest{})
+		assert.Error(t, err)
+	})
+}
+
+func TestPantryItems_Updating(T *testing.T) {
+	T.Parallel()
+
+	T.Run("happy path", func(t *testing.T) {
+		t.Parallel()
+		ctx := t.Context()
+
+		_, testClient := createUserAndClientForTest(t)
+		created := createPantryItemForTest(t, testClient)
+
+		res, err := testClient.UpdatePantryItem(ctx, &mealplanningsvc.UpdatePantryItemRequest{
+			PantryItemId: created.ID,
+			Input: &mealplanningsvc.PantryItemUpdateRequestInput{
+				Notes:           new(t.Name()),
+				Quantity:        new(created.Quantity + 1),
+				StorageLocation: new(mealplanningsvc.PantryStorageLocation_PANTRY_STORAGE_LOCATION_FREEZER),
+			},
+		})
+		require.NoError(t, err)
+
+		updated := mealplanningconverters.ConvertGRPCPantryItemToPantryItem(res.Updated)
+		require.NotNil(t, updated.LastUpdatedAt)
+		assert.Equal(t, t.Name(), updated.Notes)
+		assert.Equal(t, created.Quantity+1, updated.Quantity)
+		assert.Equal(t, mealplanning.PantryStorageLocationFreezer, updated.StorageLocation)
+
+		AssertAuditLogContainsFuzzy(t, ctx, testClient, getAccountIDForTest(t, testClient), 15, []*ExpectedAuditEntry{
+			{EventType: "created", ResourceType: "pantry_items", RelevantID: created.ID},
+			{EventType: "updated", ResourceType: "pantry_items", RelevantID: created.ID},
+		})
+	})
+
+	T.Run("requires auth", func(t *testing.T) {
+		t.Parallel()
+		ctx := t.Context()
+
+		_, testClient := createUserAndClientForTest(t)
+		created := createPantryItemForTest(t, testClient)
+
+		c := buildUnauthenticatedGRPCClientForTest(t)
+
+		_, err := c.UpdatePantryItem(ctx, &mealplanningsvc.UpdatePantryItemRequest{
+			PantryItemId: created.ID,
+			Input: &mealplanningsvc.PantryItemUpdateRequestInput{
+				Notes: new("doesn't matter"),
+			},
+		})
+		assert.Error(t, err)
+	})
+
+	T.Run("invalid ID", func(t *testing.T) {
+		t.Parallel()
+		ctx := t.Context()
+
+		_, testClient := createUserAndClientForTest(t)
+
+		_, err := testClient.UpdatePantryItem(ctx, &mealplanningsvc.UpdatePantryItemRequest{
+			PantryItemId: nonexistentID,
+			Input: &mealplanningsvc.PantryItemUpdateRequestInput{
+				Notes: new("doesn't matter"),
+			},
+		})
+		assert.Error(t, err)
+	})
+}
+
+func TestPantryItems_Archiving(T *testing.T) {
+	T.Parallel()
+
+	T.Run("happy path", func(t *testing.T) {
+		t.Parallel()
+		ctx := t.Context()
+
+		_, testClient := createUserAndClientForTest(t)
+		created := createPantryItemForTest(t, testClient)
+
+		_, err := testClient.ArchivePantryItem(ctx, &mealplanningsvc.ArchivePantryItemRequest{PantryItemId: created.ID})
+		assert.NoError(t, err)
+
+		x, err := testClient.GetPantryItem(ctx, &mealplanningsvc.GetPantryItemRequest{PantryItemId: created.ID})
+		assert.Nil(t, x)
+		assert.Error(t, err)
+
+		AssertAuditLogContainsFuzzy(t, ctx, testClient, getAccountIDForTest(t, testClient), 15, []*ExpectedAuditEntry{
+			{EventType: "created", ResourceType: "pantry_items", RelevantID: created.ID},
+			{EventType: "archived", ResourceType: "pantry_items", RelevantID: created.ID},
+		})
+	})
+
+	T.Run("requires auth", func(t *testing.T) {
+		t.Parallel()
+		ctx := t.Context()
+
+		_, testClient := createUserAndClientForTest(t)
+		created := createPantryItemForTest(t, testClient)
+
+		c := buildUnauthenticatedGRPCClientForTest(t)
+
+		_, err := c.ArchivePantryItem(ctx, &mealplanningsvc.ArchivePantryItemRequest{PantryItemId: created.ID})
+		assert.Error(t, err)
+	})
+
+	T.Run("invalid ID", func(t *testing.T) {
+		t.Parallel()
+		ctx := t.Context()
+
+		_, testClient := createUserAndClientForTest(t)
+
+		_, err := testClient.ArchivePantryItem(ctx, &mealplanningsvc.ArchivePantryItemRequest{PantryItemId: nonexistentID})
+		assert.Error(t, err)
+	})
+}
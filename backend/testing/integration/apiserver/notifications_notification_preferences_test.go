@@ -0,0 +1,98 @@
+package integration
+
+import (
+	"testing"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/notifications"
+	notificationssvc "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/grpc/generated/services/notifications"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNotificationPreferences_ReadAndUpdate(T *testing.T) {
+	T.Parallel()
+
+	T.Run("defaults to every channel for every type", func(t *testing.T) {
+		t.Parallel()
+		ctx := t.Context()
+
+		user, testClient := createUserAndClientForTest(t)
+
+		response, err := testClient.GetNotificationPreferences(ctx, &notificationssvc.GetNotificationPreferencesRequest{})
+		require.NoError(t, err)
+		require.NotNil(t, response)
+		require.Len(t, response.Results, len(notifications.NotificationTypes))
+
+		for _, preference := range response.Results {
+			assert.Equal(t, user.ID, preference.BelongsToUser)
+			assert.True(t, preference.PushEnabled)
+			assert.True(t, preference.EmailEnabled)
+			assert.True(t, preference.InAppEnabled)
+			assert.Equal(t, notifications.NotificationDigestModeNone, preference.DigestMode)
+		}
+	})
+
+	T.Run("happy path", func(t *testing.T) {
+		t.Parallel()
+		ctx := t.Context()
+
+		user, testClient := createUserAndClientForTest(t)
+
+		updated, err := testClient.UpdateNotificationPreference(ctx, &notificationssvc.UpdateNotificationPreferenceRequest{
+			NotificationType: notifications.NotificationTypeMealPlanCreated,
+			Input: &notificationssvc.NotificationPreferenceUpdateRequestInput{
+				PushEnabled:     new(false),
+				DigestMode:      new(notifications.NotificationDigestModeWeekly),
+				Timezone:        new("America/Chicago"),
+				QuietHoursStart: new("22:00"),
+				QuietHoursEnd:   new("07:00"),
+			},
+		})
+		require.NoError(t, err)
+		require.NotNil(t, updated)
+		require.NotNil(t, updated.Updated)
+		assert.NotEmpty(t, updated.Updated.Id)
+		assert.Equal(t, user.ID, updated.Updated.BelongsToUser)
+		assert.False(t, updated.Updated.PushEnabled)
+		assert.True(t, updated.Updated.EmailEnabled)
+		assert.Equal(t, notifications.NotificationDigestModeWeekly, updated.Updated.DigestMode)
+		assert.Equal(t, "America/Chicago", updated.Updated.Timezone)
+
+		response, err := testClient.GetNotificationPreferences(ctx, &notificationssvc.GetNotificationPreferencesRequest{})
+		require.NoError(t, err)
+
+		var found bool
+		for _, preference := range response.Results {
+			if preference.NotificationType == notifications.NotificationTypeMealPlanCreated {
+				found = true
+				assert.Equal(t, updated.Updated.Id, preference.Id)
+				assert.False(t, preference.PushEnabled)
+			}
+		}
+		assert.True(t, found)
+	})
+
+	T.Run("requires auth", func(t *testing.T) {
+		t.Parallel()
+		ctx := t.Context()
+
+		c := buildUnauthenticatedGRPCClientForTest(t)
+
+		_, err := c.GetNotificationPreferences(ctx, &notificationssvc.GetNotificationPreferencesRequest{})
+		assert.Error(t, err)
+	})
+
+	T.Run("invalid notification type", func(t *testing.T) {
+		t.Parallel()
+		ctx := t.Context()
+
+		_, testClient := createUserAndClientForTest(t)
+
+		_, err := testClient.UpdateNotificationPreference(ctx, &notificationssvc.UpdateNotificationPreferenceRequest{
+			NotificationType: "nonexistent",
+			Input:            &notificationssvc.NotificationPreferenceUpdateRequestInput{},
+		})
+		assert.Error(t, err)
+	})
+}
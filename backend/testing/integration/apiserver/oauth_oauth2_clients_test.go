@@ -3,6 +3,7 @@
 import (
 	"testing"
 
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/authorization"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/oauth"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/oauth/converters"
 	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/domain/oauth/fakes"
@@ -11,6 +12,10 @@
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+	"golang.org/x/oauth2/clientcredentials"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 func checkOAuth2ClientEquality(t *testing.T, expected, actual *oauth.OAuth2Client) {
@@ -225,3 +230,69 @@ func TestOAuth2Clients_Listing(T *testing.T) {
 		assert.Error(t, err)
 	})
 }
+
+func fetchClientCredentialsTokenForTest(t *testing.T, scopes ...string) (*oauth2.Token, error) {
+	t.Helper()
+
+	cfg := &clientcredentials.Config{
+		ClientID:     createdClientID,
+		ClientSecret: createdClientSecret,
+		TokenURL:     httpTestServerAddress + "/oauth2/token",
+		Scopes:       scopes,
+		AuthStyle:    oauth2.AuthStyleInParams,
+	}
+
+	return cfg.Token(t.Context())
+}
+
+func TestOAuth2Clients_ClientCredentials(T *testing.T) {
+	T.Parallel()
+
+	T.Run("tokens are limited to their granted scopes", func(t *testing.T) {
+		t.Parallel()
+		ctx := t.Context()
+
+		token, err := fetchClientCredentialsTokenForTest(t, authorization.ReadOAuth2Scope)
+		require.NoError(t, err)
+		require.NotEmpty(t, token.AccessToken)
+
+		c, err := buildAuthedGRPCClientWithBearerToken(token.AccessToken)
+		require.NoError(t, err)
+
+		_, err = c.GetOAuth2Clients(ctx, &oauthsvc.GetOAuth2ClientsRequest{})
+		assert.NoError(t, err)
+
+		creationRequestInput := fakes.BuildFakeOAuth2ClientCreationRequestInput()
+		created, err := c.CreateOAuth2Client(ctx, &oauthsvc.CreateOAuth2ClientRequest{
+			Input: grpcconverters.ConvertOAuth2ClientCreationRequestInputToGRPCOAuth2ClientCreationRequestInput(creationRequestInput),
+		})
+		assert.Nil(t, created)
+		assert.Equal(t, codes.PermissionDenied, status.Code(err))
+	})
+
+	T.Run("with admin scope", func(t *testing.T) {
+		t.Parallel()
+		ctx := t.Context()
+
+		token, err := fetchClientCredentialsTokenForTest(t, authorization.AdminOAuth2Scope)
+		require.NoError(t, err)
+
+		c, err := buildAuthedGRPCClientWithBearerToken(token.AccessToken)
+		require.NoError(t, err)
+
+		creationRequestInput := fakes.BuildFakeOAuth2ClientCreationRequestInput()
+		created, err := c.CreateOAuth2Client(ctx, &oauthsvc.CreateOAuth2ClientRequest{
+			Input: grpcconverters.ConvertOAuth2ClientCreationRequestInputToGRPCOAuth2ClientCreationRequestInput(creationRequestInput),
+		})
+		require.NoError(t, err)
+		assert.Equal(t, creationRequestInput.AllowedScopes, created.Created.AllowedScopes)
+	})
+
+	T.Run("with unknown scope", func(t *testing.T) {
+		t.Parallel()
+
+		token, err := fetchClientCredentialsTokenForTest(t, "anything")
+		assert.Error(t, err)
+		assert.Nil(t, token)
+	})
+}
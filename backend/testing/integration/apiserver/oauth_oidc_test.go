@@ -0,0 +1,147 @@
+package integration
+
+import (
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"testing"
+
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/authorization"
+	authsvc "github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/grpc/generated/services/auth"
+	"github.com/dinnerdonebetter/dinnerdonebetter/backend/internal/localdev"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/oauth2"
+)
+
+func getJSONForOIDCTest(t *testing.T, path, bearerToken string, out any) int {
+	t.Helper()
+
+	req, err := http.NewRequestWithContext(t.Context(), http.MethodGet, httpTestServerAddress+path, http.NoBody)
+	require.NoError(t, err)
+	if bearerToken != "" {
+		req.Header.Set("Authorization", "Bearer "+bearerToken)
+	}
+
+	res, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	defer func() {
+		assert.NoError(t, res.Body.Close())
+	}()
+
+	if res.StatusCode == http.StatusOK && out != nil {
+		require.NoError(t, json.NewDecoder(res.Body).Decode(out))
+	}
+
+	return res.StatusCode
+}
+
+func fetchOIDCTokenForTest(t *testing.T, scopes []string, authCodeOptions ...oauth2.AuthCodeOption) *oauth2.Token {
+	t.Helper()
+
+	user, _ := createUserAndClientForTest(t)
+
+	token, err := localdev.FetchOAuth2TokenForUserWithScopes(
+		t.Context(),
+		httpTestServerAddress,
+		fmt.Sprintf(":%d", apiServiceConfig.GRPCServer.Port),
+		createdClientID,
+		createdClientSecret,
+		&authsvc.UserLoginInput{
+			Username:  user.Username,
+			Password:  user.HashedPassword,
+			TotpToken: generateTOTPCodeForUserForTest(t, user),
+		},
+		scopes,
+		authCodeOptions...,
+	)
+	require.NoError(t, err)
+
+	return token
+}
+
+func TestOIDC_Discovery(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		var discovery map[string]any
+		require.Equal(t, http.StatusOK, getJSONForOIDCTest(t, "/.well-known/openid-configuration", "", &discovery))
+
+		assert.Equal(t, httpTestServerAddress, discovery["issuer"])
+		assert.Equal(t, httpTestServerAddress+"/oauth2/jwks", discovery["jwks_uri"])
+		assert.Equal(t, httpTestServerAddress+"/oauth2/userinfo", discovery["userinfo_endpoint"])
+	})
+}
+
+func TestOIDC_IDTokens(T *testing.T) {
+	T.Parallel()
+
+	T.Run("standard", func(t *testing.T) {
+		t.Parallel()
+
+		nonce := t.Name()
+		token := fetchOIDCTokenForTest(
+			t,
+			[]string{authorization.OpenIDOAuth2Scope, authorization.ProfileOAuth2Scope, authorization.EmailOAuth2Scope},
+			oauth2.SetAuthURLParam("nonce", nonce),
+		)
+
+		rawIDToken, ok := token.Extra("id_token").(string)
+		require.True(t, ok)
+		require.NotEmpty(t, rawIDToken)
+
+		var keySet struct {
+			Keys []struct {
+				KeyID    string `json:"kid"`
+				Modulus  string `json:"n"`
+				Exponent string `json:"e"`
+			} `json:"keys"`
+		}
+		require.Equal(t, http.StatusOK, getJSONForOIDCTest(t, "/oauth2/jwks", "", &keySet))
+
+		claims := jwt.MapClaims{}
+		_, err := jwt.ParseWithClaims(rawIDToken, claims, func(idToken *jwt.Token) (any, error) {
+			for _, key := range keySet.Keys {
+				if key.KeyID != idToken.Header["kid"] {
+					continue
+				}
+
+				n, decodeErr := base64.RawURLEncoding.DecodeString(key.Modulus)
+				require.NoError(t, decodeErr)
+				e, decodeErr := base64.RawURLEncoding.DecodeString(key.Exponent)
+				require.NoError(t, decodeErr)
+
+				return &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}, nil
+			}
+
+			return nil, fmt.Errorf("no published key %v", idToken.Header["kid"])
+		}, jwt.WithIssuer(httpTestServerAddress), jwt.WithAudience(createdClientID))
+		require.NoError(t, err)
+
+		assert.Equal(t, nonce, claims["nonce"])
+		assert.NotEmpty(t, claims["sub"])
+		assert.NotEmpty(t, claims["email"])
+		assert.NotEmpty(t, claims["preferred_username"])
+
+		var userInfo map[string]any
+		require.Equal(t, http.StatusOK, getJSONForOIDCTest(t, "/oauth2/userinfo", token.AccessToken, &userInfo))
+		assert.Equal(t, claims["sub"], userInfo["sub"])
+		assert.Equal(t, claims["email"], userInfo["email"])
+	})
+
+	T.Run("without openid scope", func(t *testing.T) {
+		t.Parallel()
+
+		token := fetchOIDCTokenForTest(t, []string{authorization.ReadOAuth2Scope})
+
+		assert.Nil(t, token.Extra("id_token"))
+		assert.Equal(t, http.StatusForbidden, getJSONForOIDCTest(t, "/oauth2/userinfo", token.AccessToken, nil))
+	})
+}
@@ -229,6 +229,58 @@ func TestWebhooks_Archiving(T *testing.T) {
 	})
 }
 
+func TestWebhookDeliveries_Listing(T *testing.T) {
+	T.Parallel()
+
+	T.Run("happy path", func(t *testing.T) {
+		t.Parallel()
+		ctx := t.Context()
+
+		_, testClient := createUserAndClientForTest(t)
+		createdWebhook := createWebhookForTest(t, testClient)
+
+		results, err := testClient.GetWebhookDeliveries(ctx, &webhookssvc.GetWebhookDeliveriesRequest{WebhookId: createdWebhook.ID})
+		assert.NoError(t, err)
+		assert.NotNil(t, results)
+	})
+
+	T.Run("requires auth", func(t *testing.T) {
+		t.Parallel()
+		ctx := t.Context()
+
+		c := buildUnauthenticatedGRPCClientForTest(t)
+		_, err := c.GetWebhookDeliveries(ctx, &webhookssvc.GetWebhookDeliveriesRequest{})
+		assert.Error(t, err)
+	})
+}
+
+func TestWebhookDeliveries_Redelivering(T *testing.T) {
+	T.Parallel()
+
+	T.Run("nonexistentID", func(t *testing.T) {
+		t.Parallel()
+		ctx := t.Context()
+
+		_, testClient := createUserAndClientForTest(t)
+		createdWebhook := createWebhookForTest(t, testClient)
+
+		_, err := testClient.RedeliverWebhookDelivery(ctx, &webhookssvc.RedeliverWebhookDeliveryRequest{
+			WebhookId:         createdWebhook.ID,
+			WebhookDeliveryId: nonexistentID,
+		})
+		assert.Error(t, err)
+	})
+
+	T.Run("requires auth", func(t *testing.T) {
+		t.Parallel()
+		ctx := t.Context()
+
+		c := buildUnauthenticatedGRPCClientForTest(t)
+		_, err := c.RedeliverWebhookDelivery(ctx, &webhookssvc.RedeliverWebhookDeliveryRequest{})
+		assert.Error(t, err)
+	})
+}
+
 func TestWebhookTriggerEvents_Adding(T *testing.T) {
 	T.Parallel()
 